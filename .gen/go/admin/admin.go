@@ -2250,8 +2250,9 @@ func (v *DescribeClusterResponse) IsSetPersistenceInfo() bool {
 }
 
 type DescribeWorkflowExecutionRequest struct {
-	Domain    *string                   `json:"domain,omitempty"`
-	Execution *shared.WorkflowExecution `json:"execution,omitempty"`
+	Domain             *string                   `json:"domain,omitempty"`
+	Execution          *shared.WorkflowExecution `json:"execution,omitempty"`
+	RebuildFromHistory *bool                     `json:"rebuildFromHistory,omitempty"`
 }
 
 // ToWire translates a DescribeWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -2271,7 +2272,7 @@ type DescribeWorkflowExecutionRequest struct {
 //	}
 func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2293,6 +2294,14 @@ func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.RebuildFromHistory != nil {
+		w, err = wire.NewValueBool(*(v.RebuildFromHistory)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -2336,6 +2345,16 @@ func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.RebuildFromHistory = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -2376,6 +2395,18 @@ func (v *DescribeWorkflowExecutionRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.RebuildFromHistory != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.RebuildFromHistory)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -2411,6 +2442,14 @@ func (v *DescribeWorkflowExecutionRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 30 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.RebuildFromHistory = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -2440,7 +2479,7 @@ func (v *DescribeWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -2450,6 +2489,10 @@ func (v *DescribeWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
 		i++
 	}
+	if v.RebuildFromHistory != nil {
+		fields[i] = fmt.Sprintf("RebuildFromHistory: %v", *(v.RebuildFromHistory))
+		i++
+	}
 
 	return fmt.Sprintf("DescribeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -2470,6 +2513,9 @@ func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecution
 	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
 		return false
 	}
+	if !_Bool_EqualsPtr(v.RebuildFromHistory, rhs.RebuildFromHistory) {
+		return false
+	}
 
 	return true
 }
@@ -2486,6 +2532,9 @@ func (v *DescribeWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEn
 	if v.Execution != nil {
 		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
 	}
+	if v.RebuildFromHistory != nil {
+		enc.AddBool("rebuildFromHistory", *v.RebuildFromHistory)
+	}
 	return err
 }
 
@@ -2519,11 +2568,27 @@ func (v *DescribeWorkflowExecutionRequest) IsSetExecution() bool {
 	return v != nil && v.Execution != nil
 }
 
+// GetRebuildFromHistory returns the value of RebuildFromHistory if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionRequest) GetRebuildFromHistory() (o bool) {
+	if v != nil && v.RebuildFromHistory != nil {
+		return *v.RebuildFromHistory
+	}
+
+	return
+}
+
+// IsSetRebuildFromHistory returns true if RebuildFromHistory is not nil.
+func (v *DescribeWorkflowExecutionRequest) IsSetRebuildFromHistory() bool {
+	return v != nil && v.RebuildFromHistory != nil
+}
+
 type DescribeWorkflowExecutionResponse struct {
-	ShardId                *string `json:"shardId,omitempty"`
-	HistoryAddr            *string `json:"historyAddr,omitempty"`
-	MutableStateInCache    *string `json:"mutableStateInCache,omitempty"`
-	MutableStateInDatabase *string `json:"mutableStateInDatabase,omitempty"`
+	ShardId                        *string `json:"shardId,omitempty"`
+	HistoryAddr                    *string `json:"historyAddr,omitempty"`
+	MutableStateInCache            *string `json:"mutableStateInCache,omitempty"`
+	MutableStateInDatabase         *string `json:"mutableStateInDatabase,omitempty"`
+	MutableStateRebuiltFromHistory *string `json:"mutableStateRebuiltFromHistory,omitempty"`
 }
 
 // ToWire translates a DescribeWorkflowExecutionResponse struct into a Thrift-level intermediate
@@ -2543,7 +2608,7 @@ type DescribeWorkflowExecutionResponse struct {
 //	}
 func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2581,6 +2646,14 @@ func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.MutableStateRebuiltFromHistory != nil {
+		w, err = wire.NewValueString(*(v.MutableStateRebuiltFromHistory)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -2646,6 +2719,16 @@ func (v *DescribeWorkflowExecutionResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.MutableStateRebuiltFromHistory = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -2710,6 +2793,18 @@ func (v *DescribeWorkflowExecutionResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.MutableStateRebuiltFromHistory != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.MutableStateRebuiltFromHistory)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -2763,6 +2858,14 @@ func (v *DescribeWorkflowExecutionResponse) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 60 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.MutableStateRebuiltFromHistory = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -2792,7 +2895,7 @@ func (v *DescribeWorkflowExecutionResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
 	if v.ShardId != nil {
 		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
@@ -2810,6 +2913,10 @@ func (v *DescribeWorkflowExecutionResponse) String() string {
 		fields[i] = fmt.Sprintf("MutableStateInDatabase: %v", *(v.MutableStateInDatabase))
 		i++
 	}
+	if v.MutableStateRebuiltFromHistory != nil {
+		fields[i] = fmt.Sprintf("MutableStateRebuiltFromHistory: %v", *(v.MutableStateRebuiltFromHistory))
+		i++
+	}
 
 	return fmt.Sprintf("DescribeWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -2836,6 +2943,9 @@ func (v *DescribeWorkflowExecutionResponse) Equals(rhs *DescribeWorkflowExecutio
 	if !_String_EqualsPtr(v.MutableStateInDatabase, rhs.MutableStateInDatabase) {
 		return false
 	}
+	if !_String_EqualsPtr(v.MutableStateRebuiltFromHistory, rhs.MutableStateRebuiltFromHistory) {
+		return false
+	}
 
 	return true
 }
@@ -2858,6 +2968,9 @@ func (v *DescribeWorkflowExecutionResponse) MarshalLogObject(enc zapcore.ObjectE
 	if v.MutableStateInDatabase != nil {
 		enc.AddString("mutableStateInDatabase", *v.MutableStateInDatabase)
 	}
+	if v.MutableStateRebuiltFromHistory != nil {
+		enc.AddString("mutableStateRebuiltFromHistory", *v.MutableStateRebuiltFromHistory)
+	}
 	return err
 }
 
@@ -2921,6 +3034,21 @@ func (v *DescribeWorkflowExecutionResponse) IsSetMutableStateInDatabase() bool {
 	return v != nil && v.MutableStateInDatabase != nil
 }
 
+// GetMutableStateRebuiltFromHistory returns the value of MutableStateRebuiltFromHistory if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetMutableStateRebuiltFromHistory() (o string) {
+	if v != nil && v.MutableStateRebuiltFromHistory != nil {
+		return *v.MutableStateRebuiltFromHistory
+	}
+
+	return
+}
+
+// IsSetMutableStateRebuiltFromHistory returns true if MutableStateRebuiltFromHistory is not nil.
+func (v *DescribeWorkflowExecutionResponse) IsSetMutableStateRebuiltFromHistory() bool {
+	return v != nil && v.MutableStateRebuiltFromHistory != nil
+}
+
 type GetDomainAsyncWorkflowConfiguratonRequest struct {
 	Domain *string `json:"domain,omitempty"`
 }
@@ -6093,11 +6221,43 @@ func (v *HostInfo) IsSetIdentity() bool {
 	return v != nil && v.Identity != nil
 }
 
-type ListDynamicConfigRequest struct {
-	ConfigName *string `json:"configName,omitempty"`
+type ImportWorkflowExecutionRequest struct {
+	Domain              *string                      `json:"domain,omitempty"`
+	WorkflowExecution   *shared.WorkflowExecution    `json:"workflowExecution,omitempty"`
+	VersionHistoryItems []*shared.VersionHistoryItem `json:"versionHistoryItems,omitempty"`
+	Events              *shared.DataBlob             `json:"events,omitempty"`
 }
 
-// ToWire translates a ListDynamicConfigRequest struct into a Thrift-level intermediate
+type _List_VersionHistoryItem_ValueList []*shared.VersionHistoryItem
+
+func (v _List_VersionHistoryItem_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*shared.VersionHistoryItem', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_VersionHistoryItem_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_VersionHistoryItem_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_VersionHistoryItem_ValueList) Close() {}
+
+// ToWire translates a ImportWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6112,31 +6272,79 @@ type ListDynamicConfigRequest struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *ListDynamicConfigRequest) ToWire() (wire.Value, error) {
+func (v *ImportWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ConfigName != nil {
-		w, err = wire.NewValueString(*(v.ConfigName)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.VersionHistoryItems != nil {
+		w, err = wire.NewValueList(_List_VersionHistoryItem_ValueList(v.VersionHistoryItems)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Events != nil {
+		w, err = v.Events.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ListDynamicConfigRequest struct from its Thrift-level
+func _VersionHistoryItem_Read(w wire.Value) (*shared.VersionHistoryItem, error) {
+	var v shared.VersionHistoryItem
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_VersionHistoryItem_Read(l wire.ValueList) ([]*shared.VersionHistoryItem, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*shared.VersionHistoryItem, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _VersionHistoryItem_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ImportWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ListDynamicConfigRequest struct
+// An error is returned if we were unable to build a ImportWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6144,12 +6352,12 @@ func (v *ListDynamicConfigRequest) ToWire() (wire.Value, error) {
 //	  return nil, err
 //	}
 //
-//	var v ListDynamicConfigRequest
+//	var v ImportWorkflowExecutionRequest
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *ListDynamicConfigRequest) FromWire(w wire.Value) error {
+func (v *ImportWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -6158,7 +6366,31 @@ func (v *ListDynamicConfigRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ConfigName = &x
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TList {
+				v.VersionHistoryItems, err = _List_VersionHistoryItem_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.Events, err = _DataBlob_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -6170,20 +6402,77 @@ func (v *ListDynamicConfigRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a ListDynamicConfigRequest struct directly into bytes, without going
+func _List_VersionHistoryItem_Encode(val []*shared.VersionHistoryItem, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*shared.VersionHistoryItem', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a ImportWorkflowExecutionRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ListDynamicConfigRequest struct could not be encoded.
-func (v *ListDynamicConfigRequest) Encode(sw stream.Writer) error {
+// An error is returned if a ImportWorkflowExecutionRequest struct could not be encoded.
+func (v *ImportWorkflowExecutionRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ConfigName != nil {
+	if v.Domain != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.ConfigName)); err != nil {
+		if err := sw.WriteString(*(v.Domain)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowExecution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecution.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.VersionHistoryItems != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_VersionHistoryItem_Encode(v.VersionHistoryItems, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Events != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Events.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -6194,12 +6483,48 @@ func (v *ListDynamicConfigRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ListDynamicConfigRequest struct directly from its Thrift-level
+func _VersionHistoryItem_Decode(sr stream.Reader) (*shared.VersionHistoryItem, error) {
+	var v shared.VersionHistoryItem
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_VersionHistoryItem_Decode(sr stream.Reader) ([]*shared.VersionHistoryItem, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*shared.VersionHistoryItem, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _VersionHistoryItem_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a ImportWorkflowExecutionRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ListDynamicConfigRequest struct could not be generated from the wire
+// An error is returned if a ImportWorkflowExecutionRequest struct could not be generated from the wire
 // representation.
-func (v *ListDynamicConfigRequest) Decode(sr stream.Reader) error {
+func (v *ImportWorkflowExecutionRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -6215,7 +6540,336 @@ func (v *ListDynamicConfigRequest) Decode(sr stream.Reader) error {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.ConfigName = &x
+			v.Domain = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TList:
+			v.VersionHistoryItems, err = _List_VersionHistoryItem_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TStruct:
+			v.Events, err = _DataBlob_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ImportWorkflowExecutionRequest
+// struct.
+func (v *ImportWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.VersionHistoryItems != nil {
+		fields[i] = fmt.Sprintf("VersionHistoryItems: %v", v.VersionHistoryItems)
+		i++
+	}
+	if v.Events != nil {
+		fields[i] = fmt.Sprintf("Events: %v", v.Events)
+		i++
+	}
+
+	return fmt.Sprintf("ImportWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_VersionHistoryItem_Equals(lhs, rhs []*shared.VersionHistoryItem) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ImportWorkflowExecutionRequest match the
+// provided ImportWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *ImportWorkflowExecutionRequest) Equals(rhs *ImportWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !((v.VersionHistoryItems == nil && rhs.VersionHistoryItems == nil) || (v.VersionHistoryItems != nil && rhs.VersionHistoryItems != nil && _List_VersionHistoryItem_Equals(v.VersionHistoryItems, rhs.VersionHistoryItems))) {
+		return false
+	}
+	if !((v.Events == nil && rhs.Events == nil) || (v.Events != nil && rhs.Events != nil && v.Events.Equals(rhs.Events))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_VersionHistoryItem_Zapper []*shared.VersionHistoryItem
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_VersionHistoryItem_Zapper.
+func (l _List_VersionHistoryItem_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ImportWorkflowExecutionRequest.
+func (v *ImportWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.VersionHistoryItems != nil {
+		err = multierr.Append(err, enc.AddArray("versionHistoryItems", (_List_VersionHistoryItem_Zapper)(v.VersionHistoryItems)))
+	}
+	if v.Events != nil {
+		err = multierr.Append(err, enc.AddObject("events", v.Events))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ImportWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *ImportWorkflowExecutionRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *ImportWorkflowExecutionRequest) GetWorkflowExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+
+	return
+}
+
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *ImportWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
+}
+
+// GetVersionHistoryItems returns the value of VersionHistoryItems if it is set or its
+// zero value if it is unset.
+func (v *ImportWorkflowExecutionRequest) GetVersionHistoryItems() (o []*shared.VersionHistoryItem) {
+	if v != nil && v.VersionHistoryItems != nil {
+		return v.VersionHistoryItems
+	}
+
+	return
+}
+
+// IsSetVersionHistoryItems returns true if VersionHistoryItems is not nil.
+func (v *ImportWorkflowExecutionRequest) IsSetVersionHistoryItems() bool {
+	return v != nil && v.VersionHistoryItems != nil
+}
+
+// GetEvents returns the value of Events if it is set or its
+// zero value if it is unset.
+func (v *ImportWorkflowExecutionRequest) GetEvents() (o *shared.DataBlob) {
+	if v != nil && v.Events != nil {
+		return v.Events
+	}
+
+	return
+}
+
+// IsSetEvents returns true if Events is not nil.
+func (v *ImportWorkflowExecutionRequest) IsSetEvents() bool {
+	return v != nil && v.Events != nil
+}
+
+type ListDynamicConfigRequest struct {
+	ConfigName *string `json:"configName,omitempty"`
+}
+
+// ToWire translates a ListDynamicConfigRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *ListDynamicConfigRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ConfigName != nil {
+		w, err = wire.NewValueString(*(v.ConfigName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ListDynamicConfigRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListDynamicConfigRequest struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v ListDynamicConfigRequest
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *ListDynamicConfigRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ConfigName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a ListDynamicConfigRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ListDynamicConfigRequest struct could not be encoded.
+func (v *ListDynamicConfigRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.ConfigName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ConfigName)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a ListDynamicConfigRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ListDynamicConfigRequest struct could not be generated from the wire
+// representation.
+func (v *ListDynamicConfigRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ConfigName = &x
 			if err != nil {
 				return err
 			}
@@ -11356,7 +12010,7 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "admin",
 	Package:  "github.com/uber/cadence/.gen/go/admin",
 	FilePath: "admin.thrift",
-	SHA1:     "cd332257c2e6f1bcc07bdfaae63cb757ecf2cba3",
+	SHA1:     "aeafcb2f2e907ce98e2389941fa2d1cd7747ee06",
 	Includes: []*thriftreflect.ThriftModule{
 		config.ThriftModule,
 		replicator.ThriftModule,
@@ -11365,7 +12019,7 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.admin\n\ninclude \"shared.thrift\"\ninclude \"replicator.thrift\"\ninclude \"config.thrift\"\n\n/**\n* AdminService provides advanced APIs for debugging and analysis with admin privilege\n**/\nservice AdminService {\n  /**\n  * DescribeWorkflowExecution returns information about the internal states of workflow execution.\n  **/\n  DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n  * DescribeShardDistribution returns information about history shards within the cluster\n  **/\n  shared.DescribeShardDistributionResponse DescribeShardDistribution(1: shared.DescribeShardDistributionRequest request)\n    throws (\n      1: shared.InternalServiceError internalServiceError,\n    )\n\n  /**\n  * DescribeHistoryHost returns information about the internal states of a history host\n  **/\n  shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void CloseShard(1: shared.CloseShardRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void RemoveTask(1: shared.RemoveTaskRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void ResetQueue(1: shared.ResetQueueRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  shared.DescribeQueueResponse DescribeQueue(1: shared.DescribeQueueRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * Returns the raw history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow\n  * execution in unknown to the service.\n  * StartEventId defines the beginning of the event to fetch. The first event is inclusive.\n  * EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.\n  **/\n  GetWorkflowExecutionRawHistoryV2Response GetWorkflowExecutionRawHistoryV2(1: GetWorkflowExecutionRawHistoryV2Request getRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  replicator.GetReplicationMessagesResponse GetReplicationMessages(1: replicator.GetReplicationMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  replicator.GetDomainReplicationMessagesResponse GetDomainReplicationMessages(1: replicator.GetDomainReplicationMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  replicator.GetDLQReplicationMessagesResponse GetDLQReplicationMessages(1: replicator.GetDLQReplicationMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * ReapplyEvents applies stale events to the current workflow and current run\n  **/\n  void ReapplyEvents(1: shared.ReapplyEventsRequest reapplyEventsRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.DomainNotActiveError domainNotActiveError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n      6: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * AddSearchAttribute whitelist search attribute in request.\n  **/\n  void AddSearchAttribute(1: AddSearchAttributeRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * DescribeCluster returns information about cadence cluster\n  **/\n  DescribeClusterResponse DescribeCluster()\n    throws (\n      1: shared.InternalServiceError internalServiceError,\n      2: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * ReadDLQMessages returns messages from DLQ\n  **/\n  replicator.ReadDLQMessagesResponse ReadDLQMessages(1: replicator.ReadDLQMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * PurgeDLQMessages purges messages from DLQ\n  **/\n  void PurgeDLQMessages(1: replicator.PurgeDLQMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * MergeDLQMessages merges messages from DLQ\n  **/\n  replicator.MergeDLQMessagesResponse MergeDLQMessages(1: replicator.MergeDLQMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * RefreshWorkflowTasks refreshes all tasks of a workflow\n  **/\n  void RefreshWorkflowTasks(1: shared.RefreshWorkflowTasksRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.DomainNotActiveError domainNotActiveError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster\n  **/\n  void ResendReplicationTasks(1: ResendReplicationTasksRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.ServiceBusyError serviceBusyError,\n      3: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * GetCrossClusterTasks fetches cross cluster tasks\n  **/\n  shared.GetCrossClusterTasksResponse GetCrossClusterTasks(1: shared.GetCrossClusterTasksRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * RespondCrossClusterTasksCompleted responds the result of processing cross cluster tasks\n  **/\n  shared.RespondCrossClusterTasksCompletedResponse RespondCrossClusterTasksCompleted(1: shared.RespondCrossClusterTasksCompletedRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * GetDynamicConfig returns values associated with a specified dynamic config parameter.\n  **/\n  GetDynamicConfigResponse GetDynamicConfig(1: GetDynamicConfigRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n    )\n\n  void UpdateDynamicConfig(1: UpdateDynamicConfigRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n    )\n\n  void RestoreDynamicConfig(1: RestoreDynamicConfigRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n    )\n\n  ListDynamicConfigResponse ListDynamicConfig(1: ListDynamicConfigRequest request)\n    throws (\n      1: shared.InternalServiceError internalServiceError,\n    )\n\n  AdminDeleteWorkflowResponse DeleteWorkflow(1: AdminDeleteWorkflowRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.EntityNotExistsError    entityNotExistError,\n      3: shared.InternalServiceError    internalServiceError,\n    )\n\n  AdminMaintainWorkflowResponse MaintainCorruptWorkflow(1: AdminMaintainWorkflowRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.EntityNotExistsError    entityNotExistError,\n      3: shared.InternalServiceError    internalServiceError,\n    )\n\n  GetGlobalIsolationGroupsResponse GetGlobalIsolationGroups(1: GetGlobalIsolationGroupsRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n    )\n\n  UpdateGlobalIsolationGroupsResponse UpdateGlobalIsolationGroups(1: UpdateGlobalIsolationGroupsRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n    )\n\n  GetDomainIsolationGroupsResponse GetDomainIsolationGroups(1: GetDomainIsolationGroupsRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n    )\n\n  UpdateDomainIsolationGroupsResponse UpdateDomainIsolationGroups(1: UpdateDomainIsolationGroupsRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n    )\n\n\n  GetDomainAsyncWorkflowConfiguratonResponse GetDomainAsyncWorkflowConfiguraton(1: GetDomainAsyncWorkflowConfiguratonRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n    )\n\n  UpdateDomainAsyncWorkflowConfiguratonResponse UpdateDomainAsyncWorkflowConfiguraton(1: UpdateDomainAsyncWorkflowConfiguratonRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n    )\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional string shardId\n  20: optional string historyAddr\n  40: optional string mutableStateInCache\n  50: optional string mutableStateInDatabase\n}\n\n/**\n  * StartEventId defines the beginning of the event to fetch. The first event is exclusive.\n  * EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.\n  **/\nstruct GetWorkflowExecutionRawHistoryV2Request {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional i64 (js.type = \"Long\") startEventId\n  40: optional i64 (js.type = \"Long\") startEventVersion\n  50: optional i64 (js.type = \"Long\") endEventId\n  60: optional i64 (js.type = \"Long\") endEventVersion\n  70: optional i32 maximumPageSize\n  80: optional binary nextPageToken\n}\n\nstruct GetWorkflowExecutionRawHistoryV2Response {\n  10: optional binary nextPageToken\n  20: optional list<shared.DataBlob> historyBatches\n  30: optional shared.VersionHistory versionHistory\n}\n\nstruct AddSearchAttributeRequest {\n  10: optional map<string, shared.IndexedValueType> searchAttribute\n  20: optional string securityToken\n}\n\nstruct HostInfo {\n  10: optional string Identity\n}\n\nstruct RingInfo {\n  10: optional string role\n  20: optional i32 memberCount\n  30: optional list<HostInfo> members\n}\n\nstruct MembershipInfo {\n  10: optional HostInfo currentHost\n  20: optional list<string> reachableMembers\n  30: optional list<RingInfo> rings\n}\n\nstruct PersistenceSetting {\n  10: optional string key\n  20: optional string value\n}\n\nstruct PersistenceFeature {\n  10: optional string key\n  20: optional bool enabled\n}\n\nstruct PersistenceInfo {\n  10: optional string backend\n  20: optional list<PersistenceSetting> settings\n  30: optional list<PersistenceFeature> features\n}\n\nstruct DescribeClusterResponse {\n  10: optional shared.SupportedClientVersions supportedClientVersions\n  20: optional MembershipInfo membershipInfo\n  30: optional map<string,PersistenceInfo> persistenceInfo\n}\n\nstruct ResendReplicationTasksRequest {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string remoteCluster\n  50: optional i64 (js.type = \"Long\") startEventID\n  60: optional i64 (js.type = \"Long\") startVersion\n  70: optional i64 (js.type = \"Long\") endEventID\n  80: optional i64 (js.type = \"Long\") endVersion\n}\n\nstruct GetDynamicConfigRequest {\n  10: optional string configName\n  20: optional list<config.DynamicConfigFilter> filters\n}\n\nstruct GetDynamicConfigResponse {\n  10: optional shared.DataBlob value\n}\n\nstruct UpdateDynamicConfigRequest {\n  10: optional string configName\n  20: optional list<config.DynamicConfigValue> configValues\n}\n\nstruct RestoreDynamicConfigRequest {\n  10: optional string configName\n  20: optional list<config.DynamicConfigFilter> filters\n}\n\nstruct AdminDeleteWorkflowRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct AdminDeleteWorkflowResponse {\n  10: optional bool historyDeleted\n  20: optional bool executionsDeleted\n  30: optional bool visibilityDeleted\n}\n\nstruct AdminMaintainWorkflowRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct AdminMaintainWorkflowResponse {\n  10: optional bool historyDeleted\n  20: optional bool executionsDeleted\n  30: optional bool visibilityDeleted\n}\n\n//Eventually remove configName and integrate this functionality into Get.\n//GetDynamicConfigResponse would need to change as well.\nstruct ListDynamicConfigRequest {\n  10: optional string configName\n}\n\nstruct ListDynamicConfigResponse {\n  10: optional list<config.DynamicConfigEntry> entries\n}\n\n// global\nstruct GetGlobalIsolationGroupsRequest{}\n\nstruct GetGlobalIsolationGroupsResponse{\n    10: optional shared.IsolationGroupConfiguration isolationGroups\n}\n\nstruct UpdateGlobalIsolationGroupsRequest{\n    10: optional shared.IsolationGroupConfiguration isolationGroups\n}\n\nstruct UpdateGlobalIsolationGroupsResponse{}\n\n\n// For domains\nstruct GetDomainIsolationGroupsRequest{\n    10: optional string domain\n}\n\nstruct GetDomainIsolationGroupsResponse{\n    10: optional shared.IsolationGroupConfiguration isolationGroups\n}\n\nstruct UpdateDomainIsolationGroupsRequest{\n    10: optional string domain\n    20: optional shared.IsolationGroupConfiguration isolationGroups\n}\n\nstruct UpdateDomainIsolationGroupsResponse{}\n\n// Async workflow configuration request/response payloads\nstruct GetDomainAsyncWorkflowConfiguratonRequest {\n    10: optional string domain\n}\n\nstruct GetDomainAsyncWorkflowConfiguratonResponse {\n    10: optional shared.AsyncWorkflowConfiguration configuration\n}\n\nstruct UpdateDomainAsyncWorkflowConfiguratonRequest {\n    10: optional string domain\n    20: optional shared.AsyncWorkflowConfiguration configuration\n}\n\nstruct UpdateDomainAsyncWorkflowConfiguratonResponse {}\n"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.admin\n\ninclude \"shared.thrift\"\ninclude \"replicator.thrift\"\ninclude \"config.thrift\"\n\n/**\n* AdminService provides advanced APIs for debugging and analysis with admin privilege\n**/\nservice AdminService {\n  /**\n  * DescribeWorkflowExecution returns information about the internal states of workflow execution.\n  **/\n  DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n  * DescribeShardDistribution returns information about history shards within the cluster\n  **/\n  shared.DescribeShardDistributionResponse DescribeShardDistribution(1: shared.DescribeShardDistributionRequest request)\n    throws (\n      1: shared.InternalServiceError internalServiceError,\n    )\n\n  /**\n  * DescribeHistoryHost returns information about the internal states of a history host\n  **/\n  shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void CloseShard(1: shared.CloseShardRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void RemoveTask(1: shared.RemoveTaskRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void ResetQueue(1: shared.ResetQueueRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  shared.DescribeQueueResponse DescribeQueue(1: shared.DescribeQueueRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * ListThrottledWorkflowIDs returns the workflow IDs recently throttled by their rate limits\n  * on the history host owning the shard\n  **/\n  shared.ListThrottledWorkflowIDsResponse ListThrottledWorkflowIDs(1: shared.ListThrottledWorkflowIDsRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * GetDomainUsage returns the workflow usage of a domain per workflow type, as reported by history hosts\n  **/\n  shared.GetDomainUsageResponse GetDomainUsage(1: shared.GetDomainUsageRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.EntityNotExistsError  entityNotExistError,\n      4: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * Returns the raw history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow\n  * execution in unknown to the service.\n  * StartEventId defines the beginning of the event to fetch. The first event is inclusive.\n  * EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.\n  **/\n  GetWorkflowExecutionRawHistoryV2Response GetWorkflowExecutionRawHistoryV2(1: GetWorkflowExecutionRawHistoryV2Request getRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  replicator.GetReplicationMessagesResponse GetReplicationMessages(1: replicator.GetReplicationMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  replicator.GetDomainReplicationMessagesResponse GetDomainReplicationMessages(1: replicator.GetDomainReplicationMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,\n    )\n\n  replicator.GetDLQReplicationMessagesResponse GetDLQReplicationMessages(1: replicator.GetDLQReplicationMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * ReapplyEvents applies stale events to the current workflow and current run\n  **/\n  void ReapplyEvents(1: shared.ReapplyEventsRequest reapplyEventsRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      3: shared.DomainNotActiveError domainNotActiveError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n      6: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * AddSearchAttribute whitelist search attribute in request.\n  **/\n  void AddSearchAttribute(1: AddSearchAttributeRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * DescribeCluster returns information about cadence cluster\n  **/\n  DescribeClusterResponse DescribeCluster()\n    throws (\n      1: shared.InternalServiceError internalServiceError,\n      2: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * ReadDLQMessages returns messages from DLQ\n  **/\n  replicator.ReadDLQMessagesResponse ReadDLQMessages(1: replicator.ReadDLQMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * PurgeDLQMessages purges messages from DLQ\n  **/\n  void PurgeDLQMessages(1: replicator.PurgeDLQMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * MergeDLQMessages merges messages from DLQ\n  **/\n  replicator.MergeDLQMessagesResponse MergeDLQMessages(1: replicator.MergeDLQMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * RefreshWorkflowTasks refreshes all tasks of a workflow\n  **/\n  void RefreshWorkflowTasks(1: shared.RefreshWorkflowTasksRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.DomainNotActiveError domainNotActiveError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * PauseActivity stops dispatching a pending activity of a workflow execution, including its retries,\n  * until it is unpaused. An attempt that is already running is not interrupted.\n  **/\n  void PauseActivity(1: shared.PauseActivityRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.DomainNotActiveError domainNotActiveError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n      5: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * UnpauseActivity resumes dispatching a paused activity of a workflow execution.\n  **/\n  void UnpauseActivity(1: shared.UnpauseActivityRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.DomainNotActiveError domainNotActiveError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n      5: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * ResetActivity sets the attempt of a pending activity back to zero so that its retry policy starts over,\n  * an activity waiting for its next retry is scheduled right away.\n  **/\n  void ResetActivity(1: shared.ResetActivityRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.DomainNotActiveError domainNotActiveError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n      5: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * UpdateActivityOptions changes the timeouts and retry policy of a pending activity in place.\n  **/\n  void UpdateActivityOptions(1: shared.UpdateActivityOptionsRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.DomainNotActiveError domainNotActiveError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.EntityNotExistsError entityNotExistError,\n      5: shared.WorkflowExecutionAlreadyCompletedError workflowExecutionAlreadyCompletedError,\n    )\n\n  /**\n  * ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster\n  **/\n  void ResendReplicationTasks(1: ResendReplicationTasksRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.ServiceBusyError serviceBusyError,\n      3: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * ImportWorkflowExecution applies a batch of history events exported from another cluster to the workflow\n  * execution, through the same path as replicated events. The batches of a run have to be imported in order.\n  **/\n  void ImportWorkflowExecution(1: ImportWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.ServiceBusyError serviceBusyError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.RetryTaskV2Error retryTaskError,\n    )\n\n  /**\n  * GetCrossClusterTasks fetches cross cluster tasks\n  **/\n  shared.GetCrossClusterTasksResponse GetCrossClusterTasks(1: shared.GetCrossClusterTasksRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * RespondCrossClusterTasksCompleted responds the result of processing cross cluster tasks\n  **/\n  shared.RespondCrossClusterTasksCompletedResponse RespondCrossClusterTasksCompleted(1: shared.RespondCrossClusterTasksCompletedRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * GetDynamicConfig returns values associated with a specified dynamic config parameter.\n  **/\n  GetDynamicConfigResponse GetDynamicConfig(1: GetDynamicConfigRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n    )\n\n  void UpdateDynamicConfig(1: UpdateDynamicConfigRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n    )\n\n  void RestoreDynamicConfig(1: RestoreDynamicConfigRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n    )\n\n  ListDynamicConfigResponse ListDynamicConfig(1: ListDynamicConfigRequest request)\n    throws (\n      1: shared.InternalServiceError internalServiceError,\n    )\n\n  AdminDeleteWorkflowResponse DeleteWorkflow(1: AdminDeleteWorkflowRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.EntityNotExistsError    entityNotExistError,\n      3: shared.InternalServiceError    internalServiceError,\n    )\n\n  AdminMaintainWorkflowResponse MaintainCorruptWorkflow(1: AdminMaintainWorkflowRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.EntityNotExistsError    entityNotExistError,\n      3: shared.InternalServiceError    internalServiceError,\n    )\n\n  GetGlobalIsolationGroupsResponse GetGlobalIsolationGroups(1: GetGlobalIsolationGroupsRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n    )\n\n  UpdateGlobalIsolationGroupsResponse UpdateGlobalIsolationGroups(1: UpdateGlobalIsolationGroupsRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n    )\n\n  GetDomainIsolationGroupsResponse GetDomainIsolationGroups(1: GetDomainIsolationGroupsRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n    )\n\n  UpdateDomainIsolationGroupsResponse UpdateDomainIsolationGroups(1: UpdateDomainIsolationGroupsRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n    )\n\n\n  GetDomainAsyncWorkflowConfiguratonResponse GetDomainAsyncWorkflowConfiguraton(1: GetDomainAsyncWorkflowConfiguratonRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n    )\n\n  UpdateDomainAsyncWorkflowConfiguratonResponse UpdateDomainAsyncWorkflowConfiguraton(1: UpdateDomainAsyncWorkflowConfiguratonRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n    )\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n  30: optional bool                         rebuildFromHistory\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional string shardId\n  20: optional string historyAddr\n  40: optional string mutableStateInCache\n  50: optional string mutableStateInDatabase\n  60: optional string mutableStateRebuiltFromHistory\n}\n\n/**\n  * StartEventId defines the beginning of the event to fetch. The first event is exclusive.\n  * EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.\n  **/\nstruct GetWorkflowExecutionRawHistoryV2Request {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional i64 (js.type = \"Long\") startEventId\n  40: optional i64 (js.type = \"Long\") startEventVersion\n  50: optional i64 (js.type = \"Long\") endEventId\n  60: optional i64 (js.type = \"Long\") endEventVersion\n  70: optional i32 maximumPageSize\n  80: optional binary nextPageToken\n}\n\nstruct GetWorkflowExecutionRawHistoryV2Response {\n  10: optional binary nextPageToken\n  20: optional list<shared.DataBlob> historyBatches\n  30: optional shared.VersionHistory versionHistory\n}\n\nstruct AddSearchAttributeRequest {\n  10: optional map<string, shared.IndexedValueType> searchAttribute\n  20: optional string securityToken\n}\n\nstruct HostInfo {\n  10: optional string Identity\n}\n\nstruct RingInfo {\n  10: optional string role\n  20: optional i32 memberCount\n  30: optional list<HostInfo> members\n}\n\nstruct MembershipInfo {\n  10: optional HostInfo currentHost\n  20: optional list<string> reachableMembers\n  30: optional list<RingInfo> rings\n}\n\nstruct PersistenceSetting {\n  10: optional string key\n  20: optional string value\n}\n\nstruct PersistenceFeature {\n  10: optional string key\n  20: optional bool enabled\n}\n\nstruct PersistenceInfo {\n  10: optional string backend\n  20: optional list<PersistenceSetting> settings\n  30: optional list<PersistenceFeature> features\n}\n\nstruct DescribeClusterResponse {\n  10: optional shared.SupportedClientVersions supportedClientVersions\n  20: optional MembershipInfo membershipInfo\n  30: optional map<string,PersistenceInfo> persistenceInfo\n}\n\nstruct ImportWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution workflowExecution\n  30: optional list<shared.VersionHistoryItem> versionHistoryItems\n  40: optional shared.DataBlob events\n}\n\nstruct ResendReplicationTasksRequest {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string remoteCluster\n  50: optional i64 (js.type = \"Long\") startEventID\n  60: optional i64 (js.type = \"Long\") startVersion\n  70: optional i64 (js.type = \"Long\") endEventID\n  80: optional i64 (js.type = \"Long\") endVersion\n}\n\nstruct GetDynamicConfigRequest {\n  10: optional string configName\n  20: optional list<config.DynamicConfigFilter> filters\n}\n\nstruct GetDynamicConfigResponse {\n  10: optional shared.DataBlob value\n}\n\nstruct UpdateDynamicConfigRequest {\n  10: optional string configName\n  20: optional list<config.DynamicConfigValue> configValues\n}\n\nstruct RestoreDynamicConfigRequest {\n  10: optional string configName\n  20: optional list<config.DynamicConfigFilter> filters\n}\n\nstruct AdminDeleteWorkflowRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct AdminDeleteWorkflowResponse {\n  10: optional bool historyDeleted\n  20: optional bool executionsDeleted\n  30: optional bool visibilityDeleted\n}\n\nstruct AdminMaintainWorkflowRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct AdminMaintainWorkflowResponse {\n  10: optional bool historyDeleted\n  20: optional bool executionsDeleted\n  30: optional bool visibilityDeleted\n}\n\n//Eventually remove configName and integrate this functionality into Get.\n//GetDynamicConfigResponse would need to change as well.\nstruct ListDynamicConfigRequest {\n  10: optional string configName\n}\n\nstruct ListDynamicConfigResponse {\n  10: optional list<config.DynamicConfigEntry> entries\n}\n\n// global\nstruct GetGlobalIsolationGroupsRequest{}\n\nstruct GetGlobalIsolationGroupsResponse{\n    10: optional shared.IsolationGroupConfiguration isolationGroups\n}\n\nstruct UpdateGlobalIsolationGroupsRequest{\n    10: optional shared.IsolationGroupConfiguration isolationGroups\n}\n\nstruct UpdateGlobalIsolationGroupsResponse{}\n\n\n// For domains\nstruct GetDomainIsolationGroupsRequest{\n    10: optional string domain\n}\n\nstruct GetDomainIsolationGroupsResponse{\n    10: optional shared.IsolationGroupConfiguration isolationGroups\n}\n\nstruct UpdateDomainIsolationGroupsRequest{\n    10: optional string domain\n    20: optional shared.IsolationGroupConfiguration isolationGroups\n}\n\nstruct UpdateDomainIsolationGroupsResponse{}\n\n// Async workflow configuration request/response payloads\nstruct GetDomainAsyncWorkflowConfiguratonRequest {\n    10: optional string domain\n}\n\nstruct GetDomainAsyncWorkflowConfiguratonResponse {\n    10: optional shared.AsyncWorkflowConfiguration configuration\n}\n\nstruct UpdateDomainAsyncWorkflowConfiguratonRequest {\n    10: optional string domain\n    20: optional shared.AsyncWorkflowConfiguration configuration\n}\n\nstruct UpdateDomainAsyncWorkflowConfiguratonResponse {}\n"
 
 // AdminService_AddSearchAttribute_Args represents the arguments for the AdminService.AddSearchAttribute function.
 //
@@ -21473,14 +22127,14 @@ func (v *AdminService_GetDomainReplicationMessages_Result) EnvelopeType() wire.E
 	return wire.Reply
 }
 
-// AdminService_GetDynamicConfig_Args represents the arguments for the AdminService.GetDynamicConfig function.
+// AdminService_GetDomainUsage_Args represents the arguments for the AdminService.GetDomainUsage function.
 //
-// The arguments for GetDynamicConfig are sent and received over the wire as this struct.
-type AdminService_GetDynamicConfig_Args struct {
-	Request *GetDynamicConfigRequest `json:"request,omitempty"`
+// The arguments for GetDomainUsage are sent and received over the wire as this struct.
+type AdminService_GetDomainUsage_Args struct {
+	Request *shared.GetDomainUsageRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_GetDynamicConfig_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetDomainUsage_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -21495,7 +22149,7 @@ type AdminService_GetDynamicConfig_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_GetDynamicConfig_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_GetDomainUsage_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -21515,17 +22169,17 @@ func (v *AdminService_GetDynamicConfig_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetDynamicConfigRequest_Read(w wire.Value) (*GetDynamicConfigRequest, error) {
-	var v GetDynamicConfigRequest
+func _GetDomainUsageRequest_Read(w wire.Value) (*shared.GetDomainUsageRequest, error) {
+	var v shared.GetDomainUsageRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetDynamicConfig_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_GetDomainUsage_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetDynamicConfig_Args struct
+// An error is returned if we were unable to build a AdminService_GetDomainUsage_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -21533,19 +22187,19 @@ func _GetDynamicConfigRequest_Read(w wire.Value) (*GetDynamicConfigRequest, erro
 //	  return nil, err
 //	}
 //
-//	var v AdminService_GetDynamicConfig_Args
+//	var v AdminService_GetDomainUsage_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_GetDynamicConfig_Args) FromWire(w wire.Value) error {
+func (v *AdminService_GetDomainUsage_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _GetDynamicConfigRequest_Read(field.Value)
+				v.Request, err = _GetDomainUsageRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -21557,11 +22211,11 @@ func (v *AdminService_GetDynamicConfig_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_GetDynamicConfig_Args struct directly into bytes, without going
+// Encode serializes a AdminService_GetDomainUsage_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetDynamicConfig_Args struct could not be encoded.
-func (v *AdminService_GetDynamicConfig_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetDomainUsage_Args struct could not be encoded.
+func (v *AdminService_GetDomainUsage_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -21581,18 +22235,18 @@ func (v *AdminService_GetDynamicConfig_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _GetDynamicConfigRequest_Decode(sr stream.Reader) (*GetDynamicConfigRequest, error) {
-	var v GetDynamicConfigRequest
+func _GetDomainUsageRequest_Decode(sr stream.Reader) (*shared.GetDomainUsageRequest, error) {
+	var v shared.GetDomainUsageRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetDynamicConfig_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetDomainUsage_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetDynamicConfig_Args struct could not be generated from the wire
+// An error is returned if a AdminService_GetDomainUsage_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetDynamicConfig_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_GetDomainUsage_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -21606,7 +22260,7 @@ func (v *AdminService_GetDynamicConfig_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _GetDynamicConfigRequest_Decode(sr)
+			v.Request, err = _GetDomainUsageRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -21633,9 +22287,9 @@ func (v *AdminService_GetDynamicConfig_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetDynamicConfig_Args
+// String returns a readable string representation of a AdminService_GetDomainUsage_Args
 // struct.
-func (v *AdminService_GetDynamicConfig_Args) String() string {
+func (v *AdminService_GetDomainUsage_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -21647,14 +22301,14 @@ func (v *AdminService_GetDynamicConfig_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetDynamicConfig_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetDomainUsage_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetDynamicConfig_Args match the
-// provided AdminService_GetDynamicConfig_Args.
+// Equals returns true if all the fields of this AdminService_GetDomainUsage_Args match the
+// provided AdminService_GetDomainUsage_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetDynamicConfig_Args) Equals(rhs *AdminService_GetDynamicConfig_Args) bool {
+func (v *AdminService_GetDomainUsage_Args) Equals(rhs *AdminService_GetDomainUsage_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -21668,8 +22322,8 @@ func (v *AdminService_GetDynamicConfig_Args) Equals(rhs *AdminService_GetDynamic
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetDynamicConfig_Args.
-func (v *AdminService_GetDynamicConfig_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetDomainUsage_Args.
+func (v *AdminService_GetDomainUsage_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -21681,7 +22335,7 @@ func (v *AdminService_GetDynamicConfig_Args) MarshalLogObject(enc zapcore.Object
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetDynamicConfig_Args) GetRequest() (o *GetDynamicConfigRequest) {
+func (v *AdminService_GetDomainUsage_Args) GetRequest() (o *shared.GetDomainUsageRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -21690,111 +22344,125 @@ func (v *AdminService_GetDynamicConfig_Args) GetRequest() (o *GetDynamicConfigRe
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_GetDynamicConfig_Args) IsSetRequest() bool {
+func (v *AdminService_GetDomainUsage_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "GetDynamicConfig" for this struct.
-func (v *AdminService_GetDynamicConfig_Args) MethodName() string {
-	return "GetDynamicConfig"
+// This will always be "GetDomainUsage" for this struct.
+func (v *AdminService_GetDomainUsage_Args) MethodName() string {
+	return "GetDomainUsage"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_GetDynamicConfig_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetDomainUsage_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_GetDynamicConfig_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.GetDynamicConfig
+// AdminService_GetDomainUsage_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.GetDomainUsage
 // function.
-var AdminService_GetDynamicConfig_Helper = struct {
-	// Args accepts the parameters of GetDynamicConfig in-order and returns
+var AdminService_GetDomainUsage_Helper = struct {
+	// Args accepts the parameters of GetDomainUsage in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *GetDynamicConfigRequest,
-	) *AdminService_GetDynamicConfig_Args
+		request *shared.GetDomainUsageRequest,
+	) *AdminService_GetDomainUsage_Args
 
 	// IsException returns true if the given error can be thrown
-	// by GetDynamicConfig.
+	// by GetDomainUsage.
 	//
-	// An error can be thrown by GetDynamicConfig only if the
+	// An error can be thrown by GetDomainUsage only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for GetDynamicConfig
+	// WrapResponse returns the result struct for GetDomainUsage
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// GetDynamicConfig into a serializable result struct.
+	// GetDomainUsage into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by GetDynamicConfig
+	// error cannot be thrown by GetDomainUsage
 	//
-	//   value, err := GetDynamicConfig(args)
-	//   result, err := AdminService_GetDynamicConfig_Helper.WrapResponse(value, err)
+	//   value, err := GetDomainUsage(args)
+	//   result, err := AdminService_GetDomainUsage_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from GetDynamicConfig: %v", err)
+	//     return fmt.Errorf("unexpected error from GetDomainUsage: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*GetDynamicConfigResponse, error) (*AdminService_GetDynamicConfig_Result, error)
+	WrapResponse func(*shared.GetDomainUsageResponse, error) (*AdminService_GetDomainUsage_Result, error)
 
-	// UnwrapResponse takes the result struct for GetDynamicConfig
+	// UnwrapResponse takes the result struct for GetDomainUsage
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if GetDynamicConfig threw an
+	// The error is non-nil only if GetDomainUsage threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_GetDynamicConfig_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_GetDynamicConfig_Result) (*GetDynamicConfigResponse, error)
+	//   value, err := AdminService_GetDomainUsage_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_GetDomainUsage_Result) (*shared.GetDomainUsageResponse, error)
 }{}
 
 func init() {
-	AdminService_GetDynamicConfig_Helper.Args = func(
-		request *GetDynamicConfigRequest,
-	) *AdminService_GetDynamicConfig_Args {
-		return &AdminService_GetDynamicConfig_Args{
+	AdminService_GetDomainUsage_Helper.Args = func(
+		request *shared.GetDomainUsageRequest,
+	) *AdminService_GetDomainUsage_Args {
+		return &AdminService_GetDomainUsage_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_GetDynamicConfig_Helper.IsException = func(err error) bool {
+	AdminService_GetDomainUsage_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_GetDynamicConfig_Helper.WrapResponse = func(success *GetDynamicConfigResponse, err error) (*AdminService_GetDynamicConfig_Result, error) {
+	AdminService_GetDomainUsage_Helper.WrapResponse = func(success *shared.GetDomainUsageResponse, err error) (*AdminService_GetDomainUsage_Result, error) {
 		if err == nil {
-			return &AdminService_GetDynamicConfig_Result{Success: success}, nil
+			return &AdminService_GetDomainUsage_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDynamicConfig_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDomainUsage_Result.BadRequestError")
 			}
-			return &AdminService_GetDynamicConfig_Result{BadRequestError: e}, nil
+			return &AdminService_GetDomainUsage_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDynamicConfig_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDomainUsage_Result.InternalServiceError")
 			}
-			return &AdminService_GetDynamicConfig_Result{InternalServiceError: e}, nil
+			return &AdminService_GetDomainUsage_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDomainUsage_Result.EntityNotExistError")
+			}
+			return &AdminService_GetDomainUsage_Result{EntityNotExistError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDomainUsage_Result.AccessDeniedError")
+			}
+			return &AdminService_GetDomainUsage_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_GetDynamicConfig_Helper.UnwrapResponse = func(result *AdminService_GetDynamicConfig_Result) (success *GetDynamicConfigResponse, err error) {
+	AdminService_GetDomainUsage_Helper.UnwrapResponse = func(result *AdminService_GetDomainUsage_Result) (success *shared.GetDomainUsageResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -21803,6 +22471,14 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -21815,19 +22491,21 @@ func init() {
 
 }
 
-// AdminService_GetDynamicConfig_Result represents the result of a AdminService.GetDynamicConfig function call.
+// AdminService_GetDomainUsage_Result represents the result of a AdminService.GetDomainUsage function call.
 //
-// The result of a GetDynamicConfig execution is sent and received over the wire as this struct.
+// The result of a GetDomainUsage execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type AdminService_GetDynamicConfig_Result struct {
-	// Value returned by GetDynamicConfig after a successful execution.
-	Success              *GetDynamicConfigResponse    `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+type AdminService_GetDomainUsage_Result struct {
+	// Value returned by GetDomainUsage after a successful execution.
+	Success              *shared.GetDomainUsageResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError        `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError   `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError   `json:"entityNotExistError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError      `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_GetDynamicConfig_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetDomainUsage_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -21842,9 +22520,9 @@ type AdminService_GetDynamicConfig_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_GetDynamicConfig_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_GetDomainUsage_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -21874,25 +22552,41 @@ func (v *AdminService_GetDynamicConfig_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_GetDynamicConfig_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_GetDomainUsage_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetDynamicConfigResponse_Read(w wire.Value) (*GetDynamicConfigResponse, error) {
-	var v GetDynamicConfigResponse
+func _GetDomainUsageResponse_Read(w wire.Value) (*shared.GetDomainUsageResponse, error) {
+	var v shared.GetDomainUsageResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetDynamicConfig_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_GetDomainUsage_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetDynamicConfig_Result struct
+// An error is returned if we were unable to build a AdminService_GetDomainUsage_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -21900,19 +22594,19 @@ func _GetDynamicConfigResponse_Read(w wire.Value) (*GetDynamicConfigResponse, er
 //	  return nil, err
 //	}
 //
-//	var v AdminService_GetDynamicConfig_Result
+//	var v AdminService_GetDomainUsage_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_GetDynamicConfig_Result) FromWire(w wire.Value) error {
+func (v *AdminService_GetDomainUsage_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _GetDynamicConfigResponse_Read(field.Value)
+				v.Success, err = _GetDomainUsageResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -21933,6 +22627,22 @@ func (v *AdminService_GetDynamicConfig_Result) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -21947,18 +22657,24 @@ func (v *AdminService_GetDynamicConfig_Result) FromWire(w wire.Value) error {
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetDynamicConfig_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetDomainUsage_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_GetDynamicConfig_Result struct directly into bytes, without going
+// Encode serializes a AdminService_GetDomainUsage_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetDynamicConfig_Result struct could not be encoded.
-func (v *AdminService_GetDynamicConfig_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetDomainUsage_Result struct could not be encoded.
+func (v *AdminService_GetDomainUsage_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -21999,6 +22715,30 @@ func (v *AdminService_GetDynamicConfig_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.EntityNotExistError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.AccessDeniedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.AccessDeniedError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	count := 0
 	if v.Success != nil {
 		count++
@@ -22009,26 +22749,32 @@ func (v *AdminService_GetDynamicConfig_Result) Encode(sw stream.Writer) error {
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
 
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetDynamicConfig_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetDomainUsage_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _GetDynamicConfigResponse_Decode(sr stream.Reader) (*GetDynamicConfigResponse, error) {
-	var v GetDynamicConfigResponse
+func _GetDomainUsageResponse_Decode(sr stream.Reader) (*shared.GetDomainUsageResponse, error) {
+	var v shared.GetDomainUsageResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetDynamicConfig_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetDomainUsage_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetDynamicConfig_Result struct could not be generated from the wire
+// An error is returned if a AdminService_GetDomainUsage_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetDynamicConfig_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_GetDomainUsage_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -22042,7 +22788,7 @@ func (v *AdminService_GetDynamicConfig_Result) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _GetDynamicConfigResponse_Decode(sr)
+			v.Success, err = _GetDomainUsageResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -22059,6 +22805,18 @@ func (v *AdminService_GetDynamicConfig_Result) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.AccessDeniedError, err = _AccessDeniedError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -22088,21 +22846,27 @@ func (v *AdminService_GetDynamicConfig_Result) Decode(sr stream.Reader) error {
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetDynamicConfig_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetDomainUsage_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetDynamicConfig_Result
+// String returns a readable string representation of a AdminService_GetDomainUsage_Result
 // struct.
-func (v *AdminService_GetDynamicConfig_Result) String() string {
+func (v *AdminService_GetDomainUsage_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [5]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -22116,15 +22880,23 @@ func (v *AdminService_GetDynamicConfig_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
 
-	return fmt.Sprintf("AdminService_GetDynamicConfig_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetDomainUsage_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetDynamicConfig_Result match the
-// provided AdminService_GetDynamicConfig_Result.
+// Equals returns true if all the fields of this AdminService_GetDomainUsage_Result match the
+// provided AdminService_GetDomainUsage_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetDynamicConfig_Result) Equals(rhs *AdminService_GetDynamicConfig_Result) bool {
+func (v *AdminService_GetDomainUsage_Result) Equals(rhs *AdminService_GetDomainUsage_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -22139,13 +22911,19 @@ func (v *AdminService_GetDynamicConfig_Result) Equals(rhs *AdminService_GetDynam
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetDynamicConfig_Result.
-func (v *AdminService_GetDynamicConfig_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetDomainUsage_Result.
+func (v *AdminService_GetDomainUsage_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -22158,12 +22936,18 @@ func (v *AdminService_GetDynamicConfig_Result) MarshalLogObject(enc zapcore.Obje
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetDynamicConfig_Result) GetSuccess() (o *GetDynamicConfigResponse) {
+func (v *AdminService_GetDomainUsage_Result) GetSuccess() (o *shared.GetDomainUsageResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -22172,13 +22956,13 @@ func (v *AdminService_GetDynamicConfig_Result) GetSuccess() (o *GetDynamicConfig
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_GetDynamicConfig_Result) IsSetSuccess() bool {
+func (v *AdminService_GetDomainUsage_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetDynamicConfig_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_GetDomainUsage_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -22187,13 +22971,13 @@ func (v *AdminService_GetDynamicConfig_Result) GetBadRequestError() (o *shared.B
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_GetDynamicConfig_Result) IsSetBadRequestError() bool {
+func (v *AdminService_GetDomainUsage_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetDynamicConfig_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_GetDomainUsage_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -22202,33 +22986,63 @@ func (v *AdminService_GetDynamicConfig_Result) GetInternalServiceError() (o *sha
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_GetDynamicConfig_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_GetDomainUsage_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetDomainUsage_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_GetDomainUsage_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetDomainUsage_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_GetDomainUsage_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "GetDynamicConfig" for this struct.
-func (v *AdminService_GetDynamicConfig_Result) MethodName() string {
-	return "GetDynamicConfig"
+// This will always be "GetDomainUsage" for this struct.
+func (v *AdminService_GetDomainUsage_Result) MethodName() string {
+	return "GetDomainUsage"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_GetDynamicConfig_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetDomainUsage_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_GetGlobalIsolationGroups_Args represents the arguments for the AdminService.GetGlobalIsolationGroups function.
+// AdminService_GetDynamicConfig_Args represents the arguments for the AdminService.GetDynamicConfig function.
 //
-// The arguments for GetGlobalIsolationGroups are sent and received over the wire as this struct.
-type AdminService_GetGlobalIsolationGroups_Args struct {
-	Request *GetGlobalIsolationGroupsRequest `json:"request,omitempty"`
+// The arguments for GetDynamicConfig are sent and received over the wire as this struct.
+type AdminService_GetDynamicConfig_Args struct {
+	Request *GetDynamicConfigRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_GetGlobalIsolationGroups_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetDynamicConfig_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -22243,7 +23057,7 @@ type AdminService_GetGlobalIsolationGroups_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_GetGlobalIsolationGroups_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_GetDynamicConfig_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -22263,17 +23077,17 @@ func (v *AdminService_GetGlobalIsolationGroups_Args) ToWire() (wire.Value, error
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetGlobalIsolationGroupsRequest_Read(w wire.Value) (*GetGlobalIsolationGroupsRequest, error) {
-	var v GetGlobalIsolationGroupsRequest
+func _GetDynamicConfigRequest_Read(w wire.Value) (*GetDynamicConfigRequest, error) {
+	var v GetDynamicConfigRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetGlobalIsolationGroups_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_GetDynamicConfig_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetGlobalIsolationGroups_Args struct
+// An error is returned if we were unable to build a AdminService_GetDynamicConfig_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -22281,19 +23095,19 @@ func _GetGlobalIsolationGroupsRequest_Read(w wire.Value) (*GetGlobalIsolationGro
 //	  return nil, err
 //	}
 //
-//	var v AdminService_GetGlobalIsolationGroups_Args
+//	var v AdminService_GetDynamicConfig_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_GetGlobalIsolationGroups_Args) FromWire(w wire.Value) error {
+func (v *AdminService_GetDynamicConfig_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _GetGlobalIsolationGroupsRequest_Read(field.Value)
+				v.Request, err = _GetDynamicConfigRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -22305,11 +23119,11 @@ func (v *AdminService_GetGlobalIsolationGroups_Args) FromWire(w wire.Value) erro
 	return nil
 }
 
-// Encode serializes a AdminService_GetGlobalIsolationGroups_Args struct directly into bytes, without going
+// Encode serializes a AdminService_GetDynamicConfig_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetGlobalIsolationGroups_Args struct could not be encoded.
-func (v *AdminService_GetGlobalIsolationGroups_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetDynamicConfig_Args struct could not be encoded.
+func (v *AdminService_GetDynamicConfig_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -22329,18 +23143,18 @@ func (v *AdminService_GetGlobalIsolationGroups_Args) Encode(sw stream.Writer) er
 	return sw.WriteStructEnd()
 }
 
-func _GetGlobalIsolationGroupsRequest_Decode(sr stream.Reader) (*GetGlobalIsolationGroupsRequest, error) {
-	var v GetGlobalIsolationGroupsRequest
+func _GetDynamicConfigRequest_Decode(sr stream.Reader) (*GetDynamicConfigRequest, error) {
+	var v GetDynamicConfigRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetGlobalIsolationGroups_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetDynamicConfig_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetGlobalIsolationGroups_Args struct could not be generated from the wire
+// An error is returned if a AdminService_GetDynamicConfig_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetGlobalIsolationGroups_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_GetDynamicConfig_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -22354,7 +23168,7 @@ func (v *AdminService_GetGlobalIsolationGroups_Args) Decode(sr stream.Reader) er
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _GetGlobalIsolationGroupsRequest_Decode(sr)
+			v.Request, err = _GetDynamicConfigRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -22381,9 +23195,9 @@ func (v *AdminService_GetGlobalIsolationGroups_Args) Decode(sr stream.Reader) er
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetGlobalIsolationGroups_Args
+// String returns a readable string representation of a AdminService_GetDynamicConfig_Args
 // struct.
-func (v *AdminService_GetGlobalIsolationGroups_Args) String() string {
+func (v *AdminService_GetDynamicConfig_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -22395,14 +23209,14 @@ func (v *AdminService_GetGlobalIsolationGroups_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetGlobalIsolationGroups_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetDynamicConfig_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetGlobalIsolationGroups_Args match the
-// provided AdminService_GetGlobalIsolationGroups_Args.
+// Equals returns true if all the fields of this AdminService_GetDynamicConfig_Args match the
+// provided AdminService_GetDynamicConfig_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetGlobalIsolationGroups_Args) Equals(rhs *AdminService_GetGlobalIsolationGroups_Args) bool {
+func (v *AdminService_GetDynamicConfig_Args) Equals(rhs *AdminService_GetDynamicConfig_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -22416,8 +23230,8 @@ func (v *AdminService_GetGlobalIsolationGroups_Args) Equals(rhs *AdminService_Ge
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetGlobalIsolationGroups_Args.
-func (v *AdminService_GetGlobalIsolationGroups_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetDynamicConfig_Args.
+func (v *AdminService_GetDynamicConfig_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -22429,7 +23243,7 @@ func (v *AdminService_GetGlobalIsolationGroups_Args) MarshalLogObject(enc zapcor
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetGlobalIsolationGroups_Args) GetRequest() (o *GetGlobalIsolationGroupsRequest) {
+func (v *AdminService_GetDynamicConfig_Args) GetRequest() (o *GetDynamicConfigRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -22438,108 +23252,119 @@ func (v *AdminService_GetGlobalIsolationGroups_Args) GetRequest() (o *GetGlobalI
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_GetGlobalIsolationGroups_Args) IsSetRequest() bool {
+func (v *AdminService_GetDynamicConfig_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "GetGlobalIsolationGroups" for this struct.
-func (v *AdminService_GetGlobalIsolationGroups_Args) MethodName() string {
-	return "GetGlobalIsolationGroups"
+// This will always be "GetDynamicConfig" for this struct.
+func (v *AdminService_GetDynamicConfig_Args) MethodName() string {
+	return "GetDynamicConfig"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_GetGlobalIsolationGroups_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetDynamicConfig_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_GetGlobalIsolationGroups_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.GetGlobalIsolationGroups
+// AdminService_GetDynamicConfig_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.GetDynamicConfig
 // function.
-var AdminService_GetGlobalIsolationGroups_Helper = struct {
-	// Args accepts the parameters of GetGlobalIsolationGroups in-order and returns
+var AdminService_GetDynamicConfig_Helper = struct {
+	// Args accepts the parameters of GetDynamicConfig in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *GetGlobalIsolationGroupsRequest,
-	) *AdminService_GetGlobalIsolationGroups_Args
+		request *GetDynamicConfigRequest,
+	) *AdminService_GetDynamicConfig_Args
 
 	// IsException returns true if the given error can be thrown
-	// by GetGlobalIsolationGroups.
+	// by GetDynamicConfig.
 	//
-	// An error can be thrown by GetGlobalIsolationGroups only if the
+	// An error can be thrown by GetDynamicConfig only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for GetGlobalIsolationGroups
+	// WrapResponse returns the result struct for GetDynamicConfig
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// GetGlobalIsolationGroups into a serializable result struct.
+	// GetDynamicConfig into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by GetGlobalIsolationGroups
+	// error cannot be thrown by GetDynamicConfig
 	//
-	//   value, err := GetGlobalIsolationGroups(args)
-	//   result, err := AdminService_GetGlobalIsolationGroups_Helper.WrapResponse(value, err)
+	//   value, err := GetDynamicConfig(args)
+	//   result, err := AdminService_GetDynamicConfig_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from GetGlobalIsolationGroups: %v", err)
+	//     return fmt.Errorf("unexpected error from GetDynamicConfig: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*GetGlobalIsolationGroupsResponse, error) (*AdminService_GetGlobalIsolationGroups_Result, error)
+	WrapResponse func(*GetDynamicConfigResponse, error) (*AdminService_GetDynamicConfig_Result, error)
 
-	// UnwrapResponse takes the result struct for GetGlobalIsolationGroups
+	// UnwrapResponse takes the result struct for GetDynamicConfig
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if GetGlobalIsolationGroups threw an
+	// The error is non-nil only if GetDynamicConfig threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_GetGlobalIsolationGroups_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_GetGlobalIsolationGroups_Result) (*GetGlobalIsolationGroupsResponse, error)
+	//   value, err := AdminService_GetDynamicConfig_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_GetDynamicConfig_Result) (*GetDynamicConfigResponse, error)
 }{}
 
 func init() {
-	AdminService_GetGlobalIsolationGroups_Helper.Args = func(
-		request *GetGlobalIsolationGroupsRequest,
-	) *AdminService_GetGlobalIsolationGroups_Args {
-		return &AdminService_GetGlobalIsolationGroups_Args{
+	AdminService_GetDynamicConfig_Helper.Args = func(
+		request *GetDynamicConfigRequest,
+	) *AdminService_GetDynamicConfig_Args {
+		return &AdminService_GetDynamicConfig_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_GetGlobalIsolationGroups_Helper.IsException = func(err error) bool {
+	AdminService_GetDynamicConfig_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
+		case *shared.InternalServiceError:
+			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_GetGlobalIsolationGroups_Helper.WrapResponse = func(success *GetGlobalIsolationGroupsResponse, err error) (*AdminService_GetGlobalIsolationGroups_Result, error) {
+	AdminService_GetDynamicConfig_Helper.WrapResponse = func(success *GetDynamicConfigResponse, err error) (*AdminService_GetDynamicConfig_Result, error) {
 		if err == nil {
-			return &AdminService_GetGlobalIsolationGroups_Result{Success: success}, nil
+			return &AdminService_GetDynamicConfig_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetGlobalIsolationGroups_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDynamicConfig_Result.BadRequestError")
 			}
-			return &AdminService_GetGlobalIsolationGroups_Result{BadRequestError: e}, nil
+			return &AdminService_GetDynamicConfig_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetDynamicConfig_Result.InternalServiceError")
+			}
+			return &AdminService_GetDynamicConfig_Result{InternalServiceError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_GetGlobalIsolationGroups_Helper.UnwrapResponse = func(result *AdminService_GetGlobalIsolationGroups_Result) (success *GetGlobalIsolationGroupsResponse, err error) {
+	AdminService_GetDynamicConfig_Helper.UnwrapResponse = func(result *AdminService_GetDynamicConfig_Result) (success *GetDynamicConfigResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
 		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -22552,18 +23377,19 @@ func init() {
 
 }
 
-// AdminService_GetGlobalIsolationGroups_Result represents the result of a AdminService.GetGlobalIsolationGroups function call.
+// AdminService_GetDynamicConfig_Result represents the result of a AdminService.GetDynamicConfig function call.
 //
-// The result of a GetGlobalIsolationGroups execution is sent and received over the wire as this struct.
+// The result of a GetDynamicConfig execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type AdminService_GetGlobalIsolationGroups_Result struct {
-	// Value returned by GetGlobalIsolationGroups after a successful execution.
-	Success         *GetGlobalIsolationGroupsResponse `json:"success,omitempty"`
-	BadRequestError *shared.BadRequestError           `json:"badRequestError,omitempty"`
+type AdminService_GetDynamicConfig_Result struct {
+	// Value returned by GetDynamicConfig after a successful execution.
+	Success              *GetDynamicConfigResponse    `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
 }
 
-// ToWire translates a AdminService_GetGlobalIsolationGroups_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetDynamicConfig_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -22578,9 +23404,9 @@ type AdminService_GetGlobalIsolationGroups_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_GetGlobalIsolationGroups_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_GetDynamicConfig_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -22602,25 +23428,33 @@ func (v *AdminService_GetGlobalIsolationGroups_Result) ToWire() (wire.Value, err
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_GetGlobalIsolationGroups_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_GetDynamicConfig_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetGlobalIsolationGroupsResponse_Read(w wire.Value) (*GetGlobalIsolationGroupsResponse, error) {
-	var v GetGlobalIsolationGroupsResponse
+func _GetDynamicConfigResponse_Read(w wire.Value) (*GetDynamicConfigResponse, error) {
+	var v GetDynamicConfigResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetGlobalIsolationGroups_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_GetDynamicConfig_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetGlobalIsolationGroups_Result struct
+// An error is returned if we were unable to build a AdminService_GetDynamicConfig_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -22628,19 +23462,19 @@ func _GetGlobalIsolationGroupsResponse_Read(w wire.Value) (*GetGlobalIsolationGr
 //	  return nil, err
 //	}
 //
-//	var v AdminService_GetGlobalIsolationGroups_Result
+//	var v AdminService_GetDynamicConfig_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_GetGlobalIsolationGroups_Result) FromWire(w wire.Value) error {
+func (v *AdminService_GetDynamicConfig_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _GetGlobalIsolationGroupsResponse_Read(field.Value)
+				v.Success, err = _GetDynamicConfigResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -22653,6 +23487,14 @@ func (v *AdminService_GetGlobalIsolationGroups_Result) FromWire(w wire.Value) er
 					return err
 				}
 
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -22664,18 +23506,21 @@ func (v *AdminService_GetGlobalIsolationGroups_Result) FromWire(w wire.Value) er
 	if v.BadRequestError != nil {
 		count++
 	}
+	if v.InternalServiceError != nil {
+		count++
+	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetGlobalIsolationGroups_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetDynamicConfig_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_GetGlobalIsolationGroups_Result struct directly into bytes, without going
+// Encode serializes a AdminService_GetDynamicConfig_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetGlobalIsolationGroups_Result struct could not be encoded.
-func (v *AdminService_GetGlobalIsolationGroups_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetDynamicConfig_Result struct could not be encoded.
+func (v *AdminService_GetDynamicConfig_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -22704,6 +23549,18 @@ func (v *AdminService_GetGlobalIsolationGroups_Result) Encode(sw stream.Writer)
 		}
 	}
 
+	if v.InternalServiceError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.InternalServiceError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	count := 0
 	if v.Success != nil {
 		count++
@@ -22711,26 +23568,29 @@ func (v *AdminService_GetGlobalIsolationGroups_Result) Encode(sw stream.Writer)
 	if v.BadRequestError != nil {
 		count++
 	}
+	if v.InternalServiceError != nil {
+		count++
+	}
 
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetGlobalIsolationGroups_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetDynamicConfig_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _GetGlobalIsolationGroupsResponse_Decode(sr stream.Reader) (*GetGlobalIsolationGroupsResponse, error) {
-	var v GetGlobalIsolationGroupsResponse
+func _GetDynamicConfigResponse_Decode(sr stream.Reader) (*GetDynamicConfigResponse, error) {
+	var v GetDynamicConfigResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetGlobalIsolationGroups_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetDynamicConfig_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetGlobalIsolationGroups_Result struct could not be generated from the wire
+// An error is returned if a AdminService_GetDynamicConfig_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetGlobalIsolationGroups_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_GetDynamicConfig_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -22744,7 +23604,7 @@ func (v *AdminService_GetGlobalIsolationGroups_Result) Decode(sr stream.Reader)
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _GetGlobalIsolationGroupsResponse_Decode(sr)
+			v.Success, err = _GetDynamicConfigResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -22755,6 +23615,12 @@ func (v *AdminService_GetGlobalIsolationGroups_Result) Decode(sr stream.Reader)
 				return err
 			}
 
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -22781,21 +23647,24 @@ func (v *AdminService_GetGlobalIsolationGroups_Result) Decode(sr stream.Reader)
 	if v.BadRequestError != nil {
 		count++
 	}
+	if v.InternalServiceError != nil {
+		count++
+	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetGlobalIsolationGroups_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_GetDynamicConfig_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetGlobalIsolationGroups_Result
+// String returns a readable string representation of a AdminService_GetDynamicConfig_Result
 // struct.
-func (v *AdminService_GetGlobalIsolationGroups_Result) String() string {
+func (v *AdminService_GetDynamicConfig_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -22805,15 +23674,19 @@ func (v *AdminService_GetGlobalIsolationGroups_Result) String() string {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
 
-	return fmt.Sprintf("AdminService_GetGlobalIsolationGroups_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetDynamicConfig_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetGlobalIsolationGroups_Result match the
-// provided AdminService_GetGlobalIsolationGroups_Result.
+// Equals returns true if all the fields of this AdminService_GetDynamicConfig_Result match the
+// provided AdminService_GetDynamicConfig_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetGlobalIsolationGroups_Result) Equals(rhs *AdminService_GetGlobalIsolationGroups_Result) bool {
+func (v *AdminService_GetDynamicConfig_Result) Equals(rhs *AdminService_GetDynamicConfig_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -22825,13 +23698,16 @@ func (v *AdminService_GetGlobalIsolationGroups_Result) Equals(rhs *AdminService_
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetGlobalIsolationGroups_Result.
-func (v *AdminService_GetGlobalIsolationGroups_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetDynamicConfig_Result.
+func (v *AdminService_GetDynamicConfig_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -22841,12 +23717,15 @@ func (v *AdminService_GetGlobalIsolationGroups_Result) MarshalLogObject(enc zapc
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetGlobalIsolationGroups_Result) GetSuccess() (o *GetGlobalIsolationGroupsResponse) {
+func (v *AdminService_GetDynamicConfig_Result) GetSuccess() (o *GetDynamicConfigResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -22855,13 +23734,13 @@ func (v *AdminService_GetGlobalIsolationGroups_Result) GetSuccess() (o *GetGloba
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_GetGlobalIsolationGroups_Result) IsSetSuccess() bool {
+func (v *AdminService_GetDynamicConfig_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetGlobalIsolationGroups_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_GetDynamicConfig_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -22870,33 +23749,48 @@ func (v *AdminService_GetGlobalIsolationGroups_Result) GetBadRequestError() (o *
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_GetGlobalIsolationGroups_Result) IsSetBadRequestError() bool {
+func (v *AdminService_GetDynamicConfig_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetDynamicConfig_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_GetDynamicConfig_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "GetGlobalIsolationGroups" for this struct.
-func (v *AdminService_GetGlobalIsolationGroups_Result) MethodName() string {
-	return "GetGlobalIsolationGroups"
+// This will always be "GetDynamicConfig" for this struct.
+func (v *AdminService_GetDynamicConfig_Result) MethodName() string {
+	return "GetDynamicConfig"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_GetGlobalIsolationGroups_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetDynamicConfig_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_GetReplicationMessages_Args represents the arguments for the AdminService.GetReplicationMessages function.
+// AdminService_GetGlobalIsolationGroups_Args represents the arguments for the AdminService.GetGlobalIsolationGroups function.
 //
-// The arguments for GetReplicationMessages are sent and received over the wire as this struct.
-type AdminService_GetReplicationMessages_Args struct {
-	Request *replicator.GetReplicationMessagesRequest `json:"request,omitempty"`
+// The arguments for GetGlobalIsolationGroups are sent and received over the wire as this struct.
+type AdminService_GetGlobalIsolationGroups_Args struct {
+	Request *GetGlobalIsolationGroupsRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_GetReplicationMessages_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetGlobalIsolationGroups_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -22911,7 +23805,7 @@ type AdminService_GetReplicationMessages_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_GetReplicationMessages_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_GetGlobalIsolationGroups_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -22931,17 +23825,17 @@ func (v *AdminService_GetReplicationMessages_Args) ToWire() (wire.Value, error)
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetReplicationMessagesRequest_Read(w wire.Value) (*replicator.GetReplicationMessagesRequest, error) {
-	var v replicator.GetReplicationMessagesRequest
+func _GetGlobalIsolationGroupsRequest_Read(w wire.Value) (*GetGlobalIsolationGroupsRequest, error) {
+	var v GetGlobalIsolationGroupsRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetReplicationMessages_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_GetGlobalIsolationGroups_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetReplicationMessages_Args struct
+// An error is returned if we were unable to build a AdminService_GetGlobalIsolationGroups_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -22949,19 +23843,19 @@ func _GetReplicationMessagesRequest_Read(w wire.Value) (*replicator.GetReplicati
 //	  return nil, err
 //	}
 //
-//	var v AdminService_GetReplicationMessages_Args
+//	var v AdminService_GetGlobalIsolationGroups_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_GetReplicationMessages_Args) FromWire(w wire.Value) error {
+func (v *AdminService_GetGlobalIsolationGroups_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _GetReplicationMessagesRequest_Read(field.Value)
+				v.Request, err = _GetGlobalIsolationGroupsRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -22973,11 +23867,11 @@ func (v *AdminService_GetReplicationMessages_Args) FromWire(w wire.Value) error
 	return nil
 }
 
-// Encode serializes a AdminService_GetReplicationMessages_Args struct directly into bytes, without going
+// Encode serializes a AdminService_GetGlobalIsolationGroups_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetReplicationMessages_Args struct could not be encoded.
-func (v *AdminService_GetReplicationMessages_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_GetGlobalIsolationGroups_Args struct could not be encoded.
+func (v *AdminService_GetGlobalIsolationGroups_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -22997,18 +23891,18 @@ func (v *AdminService_GetReplicationMessages_Args) Encode(sw stream.Writer) erro
 	return sw.WriteStructEnd()
 }
 
-func _GetReplicationMessagesRequest_Decode(sr stream.Reader) (*replicator.GetReplicationMessagesRequest, error) {
-	var v replicator.GetReplicationMessagesRequest
+func _GetGlobalIsolationGroupsRequest_Decode(sr stream.Reader) (*GetGlobalIsolationGroupsRequest, error) {
+	var v GetGlobalIsolationGroupsRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetReplicationMessages_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_GetGlobalIsolationGroups_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetReplicationMessages_Args struct could not be generated from the wire
+// An error is returned if a AdminService_GetGlobalIsolationGroups_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetReplicationMessages_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_GetGlobalIsolationGroups_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -23022,7 +23916,675 @@ func (v *AdminService_GetReplicationMessages_Args) Decode(sr stream.Reader) erro
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _GetReplicationMessagesRequest_Decode(sr)
+			v.Request, err = _GetGlobalIsolationGroupsRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_GetGlobalIsolationGroups_Args
+// struct.
+func (v *AdminService_GetGlobalIsolationGroups_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_GetGlobalIsolationGroups_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_GetGlobalIsolationGroups_Args match the
+// provided AdminService_GetGlobalIsolationGroups_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_GetGlobalIsolationGroups_Args) Equals(rhs *AdminService_GetGlobalIsolationGroups_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_GetGlobalIsolationGroups_Args.
+func (v *AdminService_GetGlobalIsolationGroups_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetGlobalIsolationGroups_Args) GetRequest() (o *GetGlobalIsolationGroupsRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_GetGlobalIsolationGroups_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "GetGlobalIsolationGroups" for this struct.
+func (v *AdminService_GetGlobalIsolationGroups_Args) MethodName() string {
+	return "GetGlobalIsolationGroups"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_GetGlobalIsolationGroups_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_GetGlobalIsolationGroups_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.GetGlobalIsolationGroups
+// function.
+var AdminService_GetGlobalIsolationGroups_Helper = struct {
+	// Args accepts the parameters of GetGlobalIsolationGroups in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *GetGlobalIsolationGroupsRequest,
+	) *AdminService_GetGlobalIsolationGroups_Args
+
+	// IsException returns true if the given error can be thrown
+	// by GetGlobalIsolationGroups.
+	//
+	// An error can be thrown by GetGlobalIsolationGroups only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for GetGlobalIsolationGroups
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// GetGlobalIsolationGroups into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by GetGlobalIsolationGroups
+	//
+	//   value, err := GetGlobalIsolationGroups(args)
+	//   result, err := AdminService_GetGlobalIsolationGroups_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from GetGlobalIsolationGroups: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*GetGlobalIsolationGroupsResponse, error) (*AdminService_GetGlobalIsolationGroups_Result, error)
+
+	// UnwrapResponse takes the result struct for GetGlobalIsolationGroups
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if GetGlobalIsolationGroups threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_GetGlobalIsolationGroups_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_GetGlobalIsolationGroups_Result) (*GetGlobalIsolationGroupsResponse, error)
+}{}
+
+func init() {
+	AdminService_GetGlobalIsolationGroups_Helper.Args = func(
+		request *GetGlobalIsolationGroupsRequest,
+	) *AdminService_GetGlobalIsolationGroups_Args {
+		return &AdminService_GetGlobalIsolationGroups_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_GetGlobalIsolationGroups_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_GetGlobalIsolationGroups_Helper.WrapResponse = func(success *GetGlobalIsolationGroupsResponse, err error) (*AdminService_GetGlobalIsolationGroups_Result, error) {
+		if err == nil {
+			return &AdminService_GetGlobalIsolationGroups_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetGlobalIsolationGroups_Result.BadRequestError")
+			}
+			return &AdminService_GetGlobalIsolationGroups_Result{BadRequestError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_GetGlobalIsolationGroups_Helper.UnwrapResponse = func(result *AdminService_GetGlobalIsolationGroups_Result) (success *GetGlobalIsolationGroupsResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_GetGlobalIsolationGroups_Result represents the result of a AdminService.GetGlobalIsolationGroups function call.
+//
+// The result of a GetGlobalIsolationGroups execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_GetGlobalIsolationGroups_Result struct {
+	// Value returned by GetGlobalIsolationGroups after a successful execution.
+	Success         *GetGlobalIsolationGroupsResponse `json:"success,omitempty"`
+	BadRequestError *shared.BadRequestError           `json:"badRequestError,omitempty"`
+}
+
+// ToWire translates a AdminService_GetGlobalIsolationGroups_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetGlobalIsolationGroups_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_GetGlobalIsolationGroups_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _GetGlobalIsolationGroupsResponse_Read(w wire.Value) (*GetGlobalIsolationGroupsResponse, error) {
+	var v GetGlobalIsolationGroupsResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_GetGlobalIsolationGroups_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_GetGlobalIsolationGroups_Result struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_GetGlobalIsolationGroups_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetGlobalIsolationGroups_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _GetGlobalIsolationGroupsResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_GetGlobalIsolationGroups_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a AdminService_GetGlobalIsolationGroups_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AdminService_GetGlobalIsolationGroups_Result struct could not be encoded.
+func (v *AdminService_GetGlobalIsolationGroups_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.BadRequestError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("AdminService_GetGlobalIsolationGroups_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _GetGlobalIsolationGroupsResponse_Decode(sr stream.Reader) (*GetGlobalIsolationGroupsResponse, error) {
+	var v GetGlobalIsolationGroupsResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_GetGlobalIsolationGroups_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AdminService_GetGlobalIsolationGroups_Result struct could not be generated from the wire
+// representation.
+func (v *AdminService_GetGlobalIsolationGroups_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _GetGlobalIsolationGroupsResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_GetGlobalIsolationGroups_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_GetGlobalIsolationGroups_Result
+// struct.
+func (v *AdminService_GetGlobalIsolationGroups_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_GetGlobalIsolationGroups_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_GetGlobalIsolationGroups_Result match the
+// provided AdminService_GetGlobalIsolationGroups_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_GetGlobalIsolationGroups_Result) Equals(rhs *AdminService_GetGlobalIsolationGroups_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_GetGlobalIsolationGroups_Result.
+func (v *AdminService_GetGlobalIsolationGroups_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetGlobalIsolationGroups_Result) GetSuccess() (o *GetGlobalIsolationGroupsResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_GetGlobalIsolationGroups_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetGlobalIsolationGroups_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_GetGlobalIsolationGroups_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "GetGlobalIsolationGroups" for this struct.
+func (v *AdminService_GetGlobalIsolationGroups_Result) MethodName() string {
+	return "GetGlobalIsolationGroups"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_GetGlobalIsolationGroups_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_GetReplicationMessages_Args represents the arguments for the AdminService.GetReplicationMessages function.
+//
+// The arguments for GetReplicationMessages are sent and received over the wire as this struct.
+type AdminService_GetReplicationMessages_Args struct {
+	Request *replicator.GetReplicationMessagesRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_GetReplicationMessages_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetReplicationMessages_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _GetReplicationMessagesRequest_Read(w wire.Value) (*replicator.GetReplicationMessagesRequest, error) {
+	var v replicator.GetReplicationMessagesRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_GetReplicationMessages_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_GetReplicationMessages_Args struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_GetReplicationMessages_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetReplicationMessages_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _GetReplicationMessagesRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a AdminService_GetReplicationMessages_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AdminService_GetReplicationMessages_Args struct could not be encoded.
+func (v *AdminService_GetReplicationMessages_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Request != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Request.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _GetReplicationMessagesRequest_Decode(sr stream.Reader) (*replicator.GetReplicationMessagesRequest, error) {
+	var v replicator.GetReplicationMessagesRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_GetReplicationMessages_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AdminService_GetReplicationMessages_Args struct could not be generated from the wire
+// representation.
+func (v *AdminService_GetReplicationMessages_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _GetReplicationMessagesRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -23051,7 +24613,4977 @@ func (v *AdminService_GetReplicationMessages_Args) Decode(sr stream.Reader) erro
 
 // String returns a readable string representation of a AdminService_GetReplicationMessages_Args
 // struct.
-func (v *AdminService_GetReplicationMessages_Args) String() string {
+func (v *AdminService_GetReplicationMessages_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_GetReplicationMessages_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_GetReplicationMessages_Args match the
+// provided AdminService_GetReplicationMessages_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_GetReplicationMessages_Args) Equals(rhs *AdminService_GetReplicationMessages_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_GetReplicationMessages_Args.
+func (v *AdminService_GetReplicationMessages_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetReplicationMessages_Args) GetRequest() (o *replicator.GetReplicationMessagesRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_GetReplicationMessages_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "GetReplicationMessages" for this struct.
+func (v *AdminService_GetReplicationMessages_Args) MethodName() string {
+	return "GetReplicationMessages"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_GetReplicationMessages_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_GetReplicationMessages_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.GetReplicationMessages
+// function.
+var AdminService_GetReplicationMessages_Helper = struct {
+	// Args accepts the parameters of GetReplicationMessages in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *replicator.GetReplicationMessagesRequest,
+	) *AdminService_GetReplicationMessages_Args
+
+	// IsException returns true if the given error can be thrown
+	// by GetReplicationMessages.
+	//
+	// An error can be thrown by GetReplicationMessages only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for GetReplicationMessages
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// GetReplicationMessages into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by GetReplicationMessages
+	//
+	//   value, err := GetReplicationMessages(args)
+	//   result, err := AdminService_GetReplicationMessages_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from GetReplicationMessages: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*replicator.GetReplicationMessagesResponse, error) (*AdminService_GetReplicationMessages_Result, error)
+
+	// UnwrapResponse takes the result struct for GetReplicationMessages
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if GetReplicationMessages threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_GetReplicationMessages_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_GetReplicationMessages_Result) (*replicator.GetReplicationMessagesResponse, error)
+}{}
+
+func init() {
+	AdminService_GetReplicationMessages_Helper.Args = func(
+		request *replicator.GetReplicationMessagesRequest,
+	) *AdminService_GetReplicationMessages_Args {
+		return &AdminService_GetReplicationMessages_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_GetReplicationMessages_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.LimitExceededError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.ClientVersionNotSupportedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_GetReplicationMessages_Helper.WrapResponse = func(success *replicator.GetReplicationMessagesResponse, err error) (*AdminService_GetReplicationMessages_Result, error) {
+		if err == nil {
+			return &AdminService_GetReplicationMessages_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationMessages_Result.BadRequestError")
+			}
+			return &AdminService_GetReplicationMessages_Result{BadRequestError: e}, nil
+		case *shared.LimitExceededError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationMessages_Result.LimitExceededError")
+			}
+			return &AdminService_GetReplicationMessages_Result{LimitExceededError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationMessages_Result.ServiceBusyError")
+			}
+			return &AdminService_GetReplicationMessages_Result{ServiceBusyError: e}, nil
+		case *shared.ClientVersionNotSupportedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationMessages_Result.ClientVersionNotSupportedError")
+			}
+			return &AdminService_GetReplicationMessages_Result{ClientVersionNotSupportedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_GetReplicationMessages_Helper.UnwrapResponse = func(result *AdminService_GetReplicationMessages_Result) (success *replicator.GetReplicationMessagesResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.LimitExceededError != nil {
+			err = result.LimitExceededError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.ClientVersionNotSupportedError != nil {
+			err = result.ClientVersionNotSupportedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_GetReplicationMessages_Result represents the result of a AdminService.GetReplicationMessages function call.
+//
+// The result of a GetReplicationMessages execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_GetReplicationMessages_Result struct {
+	// Value returned by GetReplicationMessages after a successful execution.
+	Success                        *replicator.GetReplicationMessagesResponse `json:"success,omitempty"`
+	BadRequestError                *shared.BadRequestError                    `json:"badRequestError,omitempty"`
+	LimitExceededError             *shared.LimitExceededError                 `json:"limitExceededError,omitempty"`
+	ServiceBusyError               *shared.ServiceBusyError                   `json:"serviceBusyError,omitempty"`
+	ClientVersionNotSupportedError *shared.ClientVersionNotSupportedError     `json:"clientVersionNotSupportedError,omitempty"`
+}
+
+// ToWire translates a AdminService_GetReplicationMessages_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetReplicationMessages_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.LimitExceededError != nil {
+		w, err = v.LimitExceededError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		w, err = v.ClientVersionNotSupportedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_GetReplicationMessages_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _GetReplicationMessagesResponse_Read(w wire.Value) (*replicator.GetReplicationMessagesResponse, error) {
+	var v replicator.GetReplicationMessagesResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_GetReplicationMessages_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_GetReplicationMessages_Result struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_GetReplicationMessages_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetReplicationMessages_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _GetReplicationMessagesResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_GetReplicationMessages_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a AdminService_GetReplicationMessages_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AdminService_GetReplicationMessages_Result struct could not be encoded.
+func (v *AdminService_GetReplicationMessages_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.BadRequestError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.LimitExceededError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.LimitExceededError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ClientVersionNotSupportedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ClientVersionNotSupportedError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("AdminService_GetReplicationMessages_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _GetReplicationMessagesResponse_Decode(sr stream.Reader) (*replicator.GetReplicationMessagesResponse, error) {
+	var v replicator.GetReplicationMessagesResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_GetReplicationMessages_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AdminService_GetReplicationMessages_Result struct could not be generated from the wire
+// representation.
+func (v *AdminService_GetReplicationMessages_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _GetReplicationMessagesResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_GetReplicationMessages_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_GetReplicationMessages_Result
+// struct.
+func (v *AdminService_GetReplicationMessages_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		fields[i] = fmt.Sprintf("ClientVersionNotSupportedError: %v", v.ClientVersionNotSupportedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_GetReplicationMessages_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_GetReplicationMessages_Result match the
+// provided AdminService_GetReplicationMessages_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_GetReplicationMessages_Result) Equals(rhs *AdminService_GetReplicationMessages_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.ClientVersionNotSupportedError == nil && rhs.ClientVersionNotSupportedError == nil) || (v.ClientVersionNotSupportedError != nil && rhs.ClientVersionNotSupportedError != nil && v.ClientVersionNotSupportedError.Equals(rhs.ClientVersionNotSupportedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_GetReplicationMessages_Result.
+func (v *AdminService_GetReplicationMessages_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.ClientVersionNotSupportedError != nil {
+		err = multierr.Append(err, enc.AddObject("clientVersionNotSupportedError", v.ClientVersionNotSupportedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetReplicationMessages_Result) GetSuccess() (o *replicator.GetReplicationMessagesResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_GetReplicationMessages_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetReplicationMessages_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_GetReplicationMessages_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetReplicationMessages_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
+	}
+
+	return
+}
+
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *AdminService_GetReplicationMessages_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetReplicationMessages_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_GetReplicationMessages_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetClientVersionNotSupportedError returns the value of ClientVersionNotSupportedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetReplicationMessages_Result) GetClientVersionNotSupportedError() (o *shared.ClientVersionNotSupportedError) {
+	if v != nil && v.ClientVersionNotSupportedError != nil {
+		return v.ClientVersionNotSupportedError
+	}
+
+	return
+}
+
+// IsSetClientVersionNotSupportedError returns true if ClientVersionNotSupportedError is not nil.
+func (v *AdminService_GetReplicationMessages_Result) IsSetClientVersionNotSupportedError() bool {
+	return v != nil && v.ClientVersionNotSupportedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "GetReplicationMessages" for this struct.
+func (v *AdminService_GetReplicationMessages_Result) MethodName() string {
+	return "GetReplicationMessages"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_GetReplicationMessages_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_GetWorkflowExecutionRawHistoryV2_Args represents the arguments for the AdminService.GetWorkflowExecutionRawHistoryV2 function.
+//
+// The arguments for GetWorkflowExecutionRawHistoryV2 are sent and received over the wire as this struct.
+type AdminService_GetWorkflowExecutionRawHistoryV2_Args struct {
+	GetRequest *GetWorkflowExecutionRawHistoryV2Request `json:"getRequest,omitempty"`
+}
+
+// ToWire translates a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.GetRequest != nil {
+		w, err = v.GetRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _GetWorkflowExecutionRawHistoryV2Request_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryV2Request, error) {
+	var v GetWorkflowExecutionRawHistoryV2Request
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_GetWorkflowExecutionRawHistoryV2_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.GetRequest, err = _GetWorkflowExecutionRawHistoryV2Request_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct could not be encoded.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.GetRequest != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.GetRequest.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _GetWorkflowExecutionRawHistoryV2Request_Decode(sr stream.Reader) (*GetWorkflowExecutionRawHistoryV2Request, error) {
+	var v GetWorkflowExecutionRawHistoryV2Request
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct could not be generated from the wire
+// representation.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.GetRequest, err = _GetWorkflowExecutionRawHistoryV2Request_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistoryV2_Args
+// struct.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.GetRequest != nil {
+		fields[i] = fmt.Sprintf("GetRequest: %v", v.GetRequest)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistoryV2_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistoryV2_Args match the
+// provided AdminService_GetWorkflowExecutionRawHistoryV2_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Equals(rhs *AdminService_GetWorkflowExecutionRawHistoryV2_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.GetRequest == nil && rhs.GetRequest == nil) || (v.GetRequest != nil && rhs.GetRequest != nil && v.GetRequest.Equals(rhs.GetRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_GetWorkflowExecutionRawHistoryV2_Args.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.GetRequest != nil {
+		err = multierr.Append(err, enc.AddObject("getRequest", v.GetRequest))
+	}
+	return err
+}
+
+// GetGetRequest returns the value of GetRequest if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) GetGetRequest() (o *GetWorkflowExecutionRawHistoryV2Request) {
+	if v != nil && v.GetRequest != nil {
+		return v.GetRequest
+	}
+
+	return
+}
+
+// IsSetGetRequest returns true if GetRequest is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) IsSetGetRequest() bool {
+	return v != nil && v.GetRequest != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "GetWorkflowExecutionRawHistoryV2" for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) MethodName() string {
+	return "GetWorkflowExecutionRawHistoryV2"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_GetWorkflowExecutionRawHistoryV2_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.GetWorkflowExecutionRawHistoryV2
+// function.
+var AdminService_GetWorkflowExecutionRawHistoryV2_Helper = struct {
+	// Args accepts the parameters of GetWorkflowExecutionRawHistoryV2 in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		getRequest *GetWorkflowExecutionRawHistoryV2Request,
+	) *AdminService_GetWorkflowExecutionRawHistoryV2_Args
+
+	// IsException returns true if the given error can be thrown
+	// by GetWorkflowExecutionRawHistoryV2.
+	//
+	// An error can be thrown by GetWorkflowExecutionRawHistoryV2 only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for GetWorkflowExecutionRawHistoryV2
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// GetWorkflowExecutionRawHistoryV2 into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by GetWorkflowExecutionRawHistoryV2
+	//
+	//   value, err := GetWorkflowExecutionRawHistoryV2(args)
+	//   result, err := AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from GetWorkflowExecutionRawHistoryV2: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*GetWorkflowExecutionRawHistoryV2Response, error) (*AdminService_GetWorkflowExecutionRawHistoryV2_Result, error)
+
+	// UnwrapResponse takes the result struct for GetWorkflowExecutionRawHistoryV2
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if GetWorkflowExecutionRawHistoryV2 threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_GetWorkflowExecutionRawHistoryV2_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_GetWorkflowExecutionRawHistoryV2_Result) (*GetWorkflowExecutionRawHistoryV2Response, error)
+}{}
+
+func init() {
+	AdminService_GetWorkflowExecutionRawHistoryV2_Helper.Args = func(
+		getRequest *GetWorkflowExecutionRawHistoryV2Request,
+	) *AdminService_GetWorkflowExecutionRawHistoryV2_Args {
+		return &AdminService_GetWorkflowExecutionRawHistoryV2_Args{
+			GetRequest: getRequest,
+		}
+	}
+
+	AdminService_GetWorkflowExecutionRawHistoryV2_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse = func(success *GetWorkflowExecutionRawHistoryV2Response, err error) (*AdminService_GetWorkflowExecutionRawHistoryV2_Result, error) {
+		if err == nil {
+			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistoryV2_Result.BadRequestError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistoryV2_Result.InternalServiceError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistoryV2_Result.EntityNotExistError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistoryV2_Result.ServiceBusyError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{ServiceBusyError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_GetWorkflowExecutionRawHistoryV2_Helper.UnwrapResponse = func(result *AdminService_GetWorkflowExecutionRawHistoryV2_Result) (success *GetWorkflowExecutionRawHistoryV2Response, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_GetWorkflowExecutionRawHistoryV2_Result represents the result of a AdminService.GetWorkflowExecutionRawHistoryV2 function call.
+//
+// The result of a GetWorkflowExecutionRawHistoryV2 execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_GetWorkflowExecutionRawHistoryV2_Result struct {
+	// Value returned by GetWorkflowExecutionRawHistoryV2 after a successful execution.
+	Success              *GetWorkflowExecutionRawHistoryV2Response `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError                   `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError              `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError              `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError                  `json:"serviceBusyError,omitempty"`
+}
+
+// ToWire translates a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_GetWorkflowExecutionRawHistoryV2_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _GetWorkflowExecutionRawHistoryV2Response_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryV2Response, error) {
+	var v GetWorkflowExecutionRawHistoryV2Response
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_GetWorkflowExecutionRawHistoryV2_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _GetWorkflowExecutionRawHistoryV2Response_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistoryV2_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct could not be encoded.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.BadRequestError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.InternalServiceError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.InternalServiceError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EntityNotExistError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistoryV2_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _GetWorkflowExecutionRawHistoryV2Response_Decode(sr stream.Reader) (*GetWorkflowExecutionRawHistoryV2Response, error) {
+	var v GetWorkflowExecutionRawHistoryV2Response
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct could not be generated from the wire
+// representation.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _GetWorkflowExecutionRawHistoryV2Response_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistoryV2_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistoryV2_Result
+// struct.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistoryV2_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistoryV2_Result match the
+// provided AdminService_GetWorkflowExecutionRawHistoryV2_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Equals(rhs *AdminService_GetWorkflowExecutionRawHistoryV2_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_GetWorkflowExecutionRawHistoryV2_Result.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetSuccess() (o *GetWorkflowExecutionRawHistoryV2Response) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "GetWorkflowExecutionRawHistoryV2" for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) MethodName() string {
+	return "GetWorkflowExecutionRawHistoryV2"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_ImportWorkflowExecution_Args represents the arguments for the AdminService.ImportWorkflowExecution function.
+//
+// The arguments for ImportWorkflowExecution are sent and received over the wire as this struct.
+type AdminService_ImportWorkflowExecution_Args struct {
+	Request *ImportWorkflowExecutionRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_ImportWorkflowExecution_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_ImportWorkflowExecution_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ImportWorkflowExecutionRequest_Read(w wire.Value) (*ImportWorkflowExecutionRequest, error) {
+	var v ImportWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_ImportWorkflowExecution_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_ImportWorkflowExecution_Args struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_ImportWorkflowExecution_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_ImportWorkflowExecution_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _ImportWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a AdminService_ImportWorkflowExecution_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AdminService_ImportWorkflowExecution_Args struct could not be encoded.
+func (v *AdminService_ImportWorkflowExecution_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Request != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Request.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _ImportWorkflowExecutionRequest_Decode(sr stream.Reader) (*ImportWorkflowExecutionRequest, error) {
+	var v ImportWorkflowExecutionRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_ImportWorkflowExecution_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AdminService_ImportWorkflowExecution_Args struct could not be generated from the wire
+// representation.
+func (v *AdminService_ImportWorkflowExecution_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _ImportWorkflowExecutionRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_ImportWorkflowExecution_Args
+// struct.
+func (v *AdminService_ImportWorkflowExecution_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_ImportWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_ImportWorkflowExecution_Args match the
+// provided AdminService_ImportWorkflowExecution_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_ImportWorkflowExecution_Args) Equals(rhs *AdminService_ImportWorkflowExecution_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_ImportWorkflowExecution_Args.
+func (v *AdminService_ImportWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ImportWorkflowExecution_Args) GetRequest() (o *ImportWorkflowExecutionRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_ImportWorkflowExecution_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "ImportWorkflowExecution" for this struct.
+func (v *AdminService_ImportWorkflowExecution_Args) MethodName() string {
+	return "ImportWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_ImportWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_ImportWorkflowExecution_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ImportWorkflowExecution
+// function.
+var AdminService_ImportWorkflowExecution_Helper = struct {
+	// Args accepts the parameters of ImportWorkflowExecution in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *ImportWorkflowExecutionRequest,
+	) *AdminService_ImportWorkflowExecution_Args
+
+	// IsException returns true if the given error can be thrown
+	// by ImportWorkflowExecution.
+	//
+	// An error can be thrown by ImportWorkflowExecution only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for ImportWorkflowExecution
+	// given the error returned by it. The provided error may
+	// be nil if ImportWorkflowExecution did not fail.
+	//
+	// This allows mapping errors returned by ImportWorkflowExecution into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// ImportWorkflowExecution
+	//
+	//   err := ImportWorkflowExecution(args)
+	//   result, err := AdminService_ImportWorkflowExecution_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from ImportWorkflowExecution: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*AdminService_ImportWorkflowExecution_Result, error)
+
+	// UnwrapResponse takes the result struct for ImportWorkflowExecution
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if ImportWorkflowExecution threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := AdminService_ImportWorkflowExecution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ImportWorkflowExecution_Result) error
+}{}
+
+func init() {
+	AdminService_ImportWorkflowExecution_Helper.Args = func(
+		request *ImportWorkflowExecutionRequest,
+	) *AdminService_ImportWorkflowExecution_Args {
+		return &AdminService_ImportWorkflowExecution_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_ImportWorkflowExecution_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.RetryTaskV2Error:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_ImportWorkflowExecution_Helper.WrapResponse = func(err error) (*AdminService_ImportWorkflowExecution_Result, error) {
+		if err == nil {
+			return &AdminService_ImportWorkflowExecution_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ImportWorkflowExecution_Result.BadRequestError")
+			}
+			return &AdminService_ImportWorkflowExecution_Result{BadRequestError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ImportWorkflowExecution_Result.ServiceBusyError")
+			}
+			return &AdminService_ImportWorkflowExecution_Result{ServiceBusyError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ImportWorkflowExecution_Result.EntityNotExistError")
+			}
+			return &AdminService_ImportWorkflowExecution_Result{EntityNotExistError: e}, nil
+		case *shared.RetryTaskV2Error:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ImportWorkflowExecution_Result.RetryTaskError")
+			}
+			return &AdminService_ImportWorkflowExecution_Result{RetryTaskError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_ImportWorkflowExecution_Helper.UnwrapResponse = func(result *AdminService_ImportWorkflowExecution_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.RetryTaskError != nil {
+			err = result.RetryTaskError
+			return
+		}
+		return
+	}
+
+}
+
+// AdminService_ImportWorkflowExecution_Result represents the result of a AdminService.ImportWorkflowExecution function call.
+//
+// The result of a ImportWorkflowExecution execution is sent and received over the wire as this struct.
+type AdminService_ImportWorkflowExecution_Result struct {
+	BadRequestError     *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	ServiceBusyError    *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+	EntityNotExistError *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	RetryTaskError      *shared.RetryTaskV2Error     `json:"retryTaskError,omitempty"`
+}
+
+// ToWire translates a AdminService_ImportWorkflowExecution_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_ImportWorkflowExecution_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.RetryTaskError != nil {
+		w, err = v.RetryTaskError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_ImportWorkflowExecution_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _RetryTaskV2Error_Read(w wire.Value) (*shared.RetryTaskV2Error, error) {
+	var v shared.RetryTaskV2Error
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_ImportWorkflowExecution_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_ImportWorkflowExecution_Result struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_ImportWorkflowExecution_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_ImportWorkflowExecution_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.RetryTaskError, err = _RetryTaskV2Error_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.RetryTaskError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_ImportWorkflowExecution_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a AdminService_ImportWorkflowExecution_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AdminService_ImportWorkflowExecution_Result struct could not be encoded.
+func (v *AdminService_ImportWorkflowExecution_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.BadRequestError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EntityNotExistError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RetryTaskError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.RetryTaskError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.RetryTaskError != nil {
+		count++
+	}
+
+	if count > 1 {
+		return fmt.Errorf("AdminService_ImportWorkflowExecution_Result should have at most one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _RetryTaskV2Error_Decode(sr stream.Reader) (*shared.RetryTaskV2Error, error) {
+	var v shared.RetryTaskV2Error
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_ImportWorkflowExecution_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AdminService_ImportWorkflowExecution_Result struct could not be generated from the wire
+// representation.
+func (v *AdminService_ImportWorkflowExecution_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.RetryTaskError, err = _RetryTaskV2Error_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.RetryTaskError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_ImportWorkflowExecution_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_ImportWorkflowExecution_Result
+// struct.
+func (v *AdminService_ImportWorkflowExecution_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.RetryTaskError != nil {
+		fields[i] = fmt.Sprintf("RetryTaskError: %v", v.RetryTaskError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_ImportWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_ImportWorkflowExecution_Result match the
+// provided AdminService_ImportWorkflowExecution_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_ImportWorkflowExecution_Result) Equals(rhs *AdminService_ImportWorkflowExecution_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.RetryTaskError == nil && rhs.RetryTaskError == nil) || (v.RetryTaskError != nil && rhs.RetryTaskError != nil && v.RetryTaskError.Equals(rhs.RetryTaskError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_ImportWorkflowExecution_Result.
+func (v *AdminService_ImportWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.RetryTaskError != nil {
+		err = multierr.Append(err, enc.AddObject("retryTaskError", v.RetryTaskError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ImportWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_ImportWorkflowExecution_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ImportWorkflowExecution_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_ImportWorkflowExecution_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ImportWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_ImportWorkflowExecution_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetRetryTaskError returns the value of RetryTaskError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ImportWorkflowExecution_Result) GetRetryTaskError() (o *shared.RetryTaskV2Error) {
+	if v != nil && v.RetryTaskError != nil {
+		return v.RetryTaskError
+	}
+
+	return
+}
+
+// IsSetRetryTaskError returns true if RetryTaskError is not nil.
+func (v *AdminService_ImportWorkflowExecution_Result) IsSetRetryTaskError() bool {
+	return v != nil && v.RetryTaskError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "ImportWorkflowExecution" for this struct.
+func (v *AdminService_ImportWorkflowExecution_Result) MethodName() string {
+	return "ImportWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_ImportWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_ListDynamicConfig_Args represents the arguments for the AdminService.ListDynamicConfig function.
+//
+// The arguments for ListDynamicConfig are sent and received over the wire as this struct.
+type AdminService_ListDynamicConfig_Args struct {
+	Request *ListDynamicConfigRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_ListDynamicConfig_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_ListDynamicConfig_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ListDynamicConfigRequest_Read(w wire.Value) (*ListDynamicConfigRequest, error) {
+	var v ListDynamicConfigRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_ListDynamicConfig_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_ListDynamicConfig_Args struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_ListDynamicConfig_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_ListDynamicConfig_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _ListDynamicConfigRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a AdminService_ListDynamicConfig_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AdminService_ListDynamicConfig_Args struct could not be encoded.
+func (v *AdminService_ListDynamicConfig_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Request != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Request.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _ListDynamicConfigRequest_Decode(sr stream.Reader) (*ListDynamicConfigRequest, error) {
+	var v ListDynamicConfigRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_ListDynamicConfig_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AdminService_ListDynamicConfig_Args struct could not be generated from the wire
+// representation.
+func (v *AdminService_ListDynamicConfig_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _ListDynamicConfigRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_ListDynamicConfig_Args
+// struct.
+func (v *AdminService_ListDynamicConfig_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_ListDynamicConfig_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_ListDynamicConfig_Args match the
+// provided AdminService_ListDynamicConfig_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_ListDynamicConfig_Args) Equals(rhs *AdminService_ListDynamicConfig_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_ListDynamicConfig_Args.
+func (v *AdminService_ListDynamicConfig_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListDynamicConfig_Args) GetRequest() (o *ListDynamicConfigRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_ListDynamicConfig_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "ListDynamicConfig" for this struct.
+func (v *AdminService_ListDynamicConfig_Args) MethodName() string {
+	return "ListDynamicConfig"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_ListDynamicConfig_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_ListDynamicConfig_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ListDynamicConfig
+// function.
+var AdminService_ListDynamicConfig_Helper = struct {
+	// Args accepts the parameters of ListDynamicConfig in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *ListDynamicConfigRequest,
+	) *AdminService_ListDynamicConfig_Args
+
+	// IsException returns true if the given error can be thrown
+	// by ListDynamicConfig.
+	//
+	// An error can be thrown by ListDynamicConfig only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for ListDynamicConfig
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// ListDynamicConfig into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by ListDynamicConfig
+	//
+	//   value, err := ListDynamicConfig(args)
+	//   result, err := AdminService_ListDynamicConfig_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from ListDynamicConfig: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*ListDynamicConfigResponse, error) (*AdminService_ListDynamicConfig_Result, error)
+
+	// UnwrapResponse takes the result struct for ListDynamicConfig
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if ListDynamicConfig threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_ListDynamicConfig_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ListDynamicConfig_Result) (*ListDynamicConfigResponse, error)
+}{}
+
+func init() {
+	AdminService_ListDynamicConfig_Helper.Args = func(
+		request *ListDynamicConfigRequest,
+	) *AdminService_ListDynamicConfig_Args {
+		return &AdminService_ListDynamicConfig_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_ListDynamicConfig_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.InternalServiceError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_ListDynamicConfig_Helper.WrapResponse = func(success *ListDynamicConfigResponse, err error) (*AdminService_ListDynamicConfig_Result, error) {
+		if err == nil {
+			return &AdminService_ListDynamicConfig_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListDynamicConfig_Result.InternalServiceError")
+			}
+			return &AdminService_ListDynamicConfig_Result{InternalServiceError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_ListDynamicConfig_Helper.UnwrapResponse = func(result *AdminService_ListDynamicConfig_Result) (success *ListDynamicConfigResponse, err error) {
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_ListDynamicConfig_Result represents the result of a AdminService.ListDynamicConfig function call.
+//
+// The result of a ListDynamicConfig execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_ListDynamicConfig_Result struct {
+	// Value returned by ListDynamicConfig after a successful execution.
+	Success              *ListDynamicConfigResponse   `json:"success,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+}
+
+// ToWire translates a AdminService_ListDynamicConfig_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_ListDynamicConfig_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_ListDynamicConfig_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ListDynamicConfigResponse_Read(w wire.Value) (*ListDynamicConfigResponse, error) {
+	var v ListDynamicConfigResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_ListDynamicConfig_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_ListDynamicConfig_Result struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_ListDynamicConfig_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_ListDynamicConfig_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _ListDynamicConfigResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_ListDynamicConfig_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a AdminService_ListDynamicConfig_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AdminService_ListDynamicConfig_Result struct could not be encoded.
+func (v *AdminService_ListDynamicConfig_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.InternalServiceError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.InternalServiceError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("AdminService_ListDynamicConfig_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _ListDynamicConfigResponse_Decode(sr stream.Reader) (*ListDynamicConfigResponse, error) {
+	var v ListDynamicConfigResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_ListDynamicConfig_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AdminService_ListDynamicConfig_Result struct could not be generated from the wire
+// representation.
+func (v *AdminService_ListDynamicConfig_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _ListDynamicConfigResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_ListDynamicConfig_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_ListDynamicConfig_Result
+// struct.
+func (v *AdminService_ListDynamicConfig_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_ListDynamicConfig_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_ListDynamicConfig_Result match the
+// provided AdminService_ListDynamicConfig_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_ListDynamicConfig_Result) Equals(rhs *AdminService_ListDynamicConfig_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_ListDynamicConfig_Result.
+func (v *AdminService_ListDynamicConfig_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListDynamicConfig_Result) GetSuccess() (o *ListDynamicConfigResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_ListDynamicConfig_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListDynamicConfig_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_ListDynamicConfig_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "ListDynamicConfig" for this struct.
+func (v *AdminService_ListDynamicConfig_Result) MethodName() string {
+	return "ListDynamicConfig"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_ListDynamicConfig_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_ListThrottledWorkflowIDs_Args represents the arguments for the AdminService.ListThrottledWorkflowIDs function.
+//
+// The arguments for ListThrottledWorkflowIDs are sent and received over the wire as this struct.
+type AdminService_ListThrottledWorkflowIDs_Args struct {
+	Request *shared.ListThrottledWorkflowIDsRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_ListThrottledWorkflowIDs_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_ListThrottledWorkflowIDs_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ListThrottledWorkflowIDsRequest_Read(w wire.Value) (*shared.ListThrottledWorkflowIDsRequest, error) {
+	var v shared.ListThrottledWorkflowIDsRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_ListThrottledWorkflowIDs_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_ListThrottledWorkflowIDs_Args struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_ListThrottledWorkflowIDs_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_ListThrottledWorkflowIDs_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _ListThrottledWorkflowIDsRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a AdminService_ListThrottledWorkflowIDs_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AdminService_ListThrottledWorkflowIDs_Args struct could not be encoded.
+func (v *AdminService_ListThrottledWorkflowIDs_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Request != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Request.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _ListThrottledWorkflowIDsRequest_Decode(sr stream.Reader) (*shared.ListThrottledWorkflowIDsRequest, error) {
+	var v shared.ListThrottledWorkflowIDsRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_ListThrottledWorkflowIDs_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AdminService_ListThrottledWorkflowIDs_Args struct could not be generated from the wire
+// representation.
+func (v *AdminService_ListThrottledWorkflowIDs_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _ListThrottledWorkflowIDsRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_ListThrottledWorkflowIDs_Args
+// struct.
+func (v *AdminService_ListThrottledWorkflowIDs_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_ListThrottledWorkflowIDs_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_ListThrottledWorkflowIDs_Args match the
+// provided AdminService_ListThrottledWorkflowIDs_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_ListThrottledWorkflowIDs_Args) Equals(rhs *AdminService_ListThrottledWorkflowIDs_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_ListThrottledWorkflowIDs_Args.
+func (v *AdminService_ListThrottledWorkflowIDs_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListThrottledWorkflowIDs_Args) GetRequest() (o *shared.ListThrottledWorkflowIDsRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_ListThrottledWorkflowIDs_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "ListThrottledWorkflowIDs" for this struct.
+func (v *AdminService_ListThrottledWorkflowIDs_Args) MethodName() string {
+	return "ListThrottledWorkflowIDs"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_ListThrottledWorkflowIDs_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_ListThrottledWorkflowIDs_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ListThrottledWorkflowIDs
+// function.
+var AdminService_ListThrottledWorkflowIDs_Helper = struct {
+	// Args accepts the parameters of ListThrottledWorkflowIDs in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.ListThrottledWorkflowIDsRequest,
+	) *AdminService_ListThrottledWorkflowIDs_Args
+
+	// IsException returns true if the given error can be thrown
+	// by ListThrottledWorkflowIDs.
+	//
+	// An error can be thrown by ListThrottledWorkflowIDs only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for ListThrottledWorkflowIDs
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// ListThrottledWorkflowIDs into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by ListThrottledWorkflowIDs
+	//
+	//   value, err := ListThrottledWorkflowIDs(args)
+	//   result, err := AdminService_ListThrottledWorkflowIDs_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from ListThrottledWorkflowIDs: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*shared.ListThrottledWorkflowIDsResponse, error) (*AdminService_ListThrottledWorkflowIDs_Result, error)
+
+	// UnwrapResponse takes the result struct for ListThrottledWorkflowIDs
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if ListThrottledWorkflowIDs threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_ListThrottledWorkflowIDs_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ListThrottledWorkflowIDs_Result) (*shared.ListThrottledWorkflowIDsResponse, error)
+}{}
+
+func init() {
+	AdminService_ListThrottledWorkflowIDs_Helper.Args = func(
+		request *shared.ListThrottledWorkflowIDsRequest,
+	) *AdminService_ListThrottledWorkflowIDs_Args {
+		return &AdminService_ListThrottledWorkflowIDs_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_ListThrottledWorkflowIDs_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_ListThrottledWorkflowIDs_Helper.WrapResponse = func(success *shared.ListThrottledWorkflowIDsResponse, err error) (*AdminService_ListThrottledWorkflowIDs_Result, error) {
+		if err == nil {
+			return &AdminService_ListThrottledWorkflowIDs_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListThrottledWorkflowIDs_Result.BadRequestError")
+			}
+			return &AdminService_ListThrottledWorkflowIDs_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListThrottledWorkflowIDs_Result.InternalServiceError")
+			}
+			return &AdminService_ListThrottledWorkflowIDs_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListThrottledWorkflowIDs_Result.AccessDeniedError")
+			}
+			return &AdminService_ListThrottledWorkflowIDs_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_ListThrottledWorkflowIDs_Helper.UnwrapResponse = func(result *AdminService_ListThrottledWorkflowIDs_Result) (success *shared.ListThrottledWorkflowIDsResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_ListThrottledWorkflowIDs_Result represents the result of a AdminService.ListThrottledWorkflowIDs function call.
+//
+// The result of a ListThrottledWorkflowIDs execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_ListThrottledWorkflowIDs_Result struct {
+	// Value returned by ListThrottledWorkflowIDs after a successful execution.
+	Success              *shared.ListThrottledWorkflowIDsResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError                  `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError             `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError                `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_ListThrottledWorkflowIDs_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_ListThrottledWorkflowIDs_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_ListThrottledWorkflowIDs_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ListThrottledWorkflowIDsResponse_Read(w wire.Value) (*shared.ListThrottledWorkflowIDsResponse, error) {
+	var v shared.ListThrottledWorkflowIDsResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_ListThrottledWorkflowIDs_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_ListThrottledWorkflowIDs_Result struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_ListThrottledWorkflowIDs_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_ListThrottledWorkflowIDs_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _ListThrottledWorkflowIDsResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_ListThrottledWorkflowIDs_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a AdminService_ListThrottledWorkflowIDs_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AdminService_ListThrottledWorkflowIDs_Result struct could not be encoded.
+func (v *AdminService_ListThrottledWorkflowIDs_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.BadRequestError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.InternalServiceError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.InternalServiceError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.AccessDeniedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.AccessDeniedError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("AdminService_ListThrottledWorkflowIDs_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _ListThrottledWorkflowIDsResponse_Decode(sr stream.Reader) (*shared.ListThrottledWorkflowIDsResponse, error) {
+	var v shared.ListThrottledWorkflowIDsResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_ListThrottledWorkflowIDs_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AdminService_ListThrottledWorkflowIDs_Result struct could not be generated from the wire
+// representation.
+func (v *AdminService_ListThrottledWorkflowIDs_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _ListThrottledWorkflowIDsResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.AccessDeniedError, err = _AccessDeniedError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_ListThrottledWorkflowIDs_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_ListThrottledWorkflowIDs_Result
+// struct.
+func (v *AdminService_ListThrottledWorkflowIDs_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_ListThrottledWorkflowIDs_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_ListThrottledWorkflowIDs_Result match the
+// provided AdminService_ListThrottledWorkflowIDs_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_ListThrottledWorkflowIDs_Result) Equals(rhs *AdminService_ListThrottledWorkflowIDs_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_ListThrottledWorkflowIDs_Result.
+func (v *AdminService_ListThrottledWorkflowIDs_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListThrottledWorkflowIDs_Result) GetSuccess() (o *shared.ListThrottledWorkflowIDsResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_ListThrottledWorkflowIDs_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListThrottledWorkflowIDs_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_ListThrottledWorkflowIDs_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListThrottledWorkflowIDs_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_ListThrottledWorkflowIDs_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListThrottledWorkflowIDs_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_ListThrottledWorkflowIDs_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "ListThrottledWorkflowIDs" for this struct.
+func (v *AdminService_ListThrottledWorkflowIDs_Result) MethodName() string {
+	return "ListThrottledWorkflowIDs"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_ListThrottledWorkflowIDs_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_MaintainCorruptWorkflow_Args represents the arguments for the AdminService.MaintainCorruptWorkflow function.
+//
+// The arguments for MaintainCorruptWorkflow are sent and received over the wire as this struct.
+type AdminService_MaintainCorruptWorkflow_Args struct {
+	Request *AdminMaintainWorkflowRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_MaintainCorruptWorkflow_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_MaintainCorruptWorkflow_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _AdminMaintainWorkflowRequest_Read(w wire.Value) (*AdminMaintainWorkflowRequest, error) {
+	var v AdminMaintainWorkflowRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_MaintainCorruptWorkflow_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_MaintainCorruptWorkflow_Args struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_MaintainCorruptWorkflow_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_MaintainCorruptWorkflow_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _AdminMaintainWorkflowRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a AdminService_MaintainCorruptWorkflow_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AdminService_MaintainCorruptWorkflow_Args struct could not be encoded.
+func (v *AdminService_MaintainCorruptWorkflow_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Request != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Request.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _AdminMaintainWorkflowRequest_Decode(sr stream.Reader) (*AdminMaintainWorkflowRequest, error) {
+	var v AdminMaintainWorkflowRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_MaintainCorruptWorkflow_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AdminService_MaintainCorruptWorkflow_Args struct could not be generated from the wire
+// representation.
+func (v *AdminService_MaintainCorruptWorkflow_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _AdminMaintainWorkflowRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_MaintainCorruptWorkflow_Args
+// struct.
+func (v *AdminService_MaintainCorruptWorkflow_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_MaintainCorruptWorkflow_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_MaintainCorruptWorkflow_Args match the
+// provided AdminService_MaintainCorruptWorkflow_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_MaintainCorruptWorkflow_Args) Equals(rhs *AdminService_MaintainCorruptWorkflow_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_MaintainCorruptWorkflow_Args.
+func (v *AdminService_MaintainCorruptWorkflow_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_MaintainCorruptWorkflow_Args) GetRequest() (o *AdminMaintainWorkflowRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_MaintainCorruptWorkflow_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "MaintainCorruptWorkflow" for this struct.
+func (v *AdminService_MaintainCorruptWorkflow_Args) MethodName() string {
+	return "MaintainCorruptWorkflow"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_MaintainCorruptWorkflow_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_MaintainCorruptWorkflow_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.MaintainCorruptWorkflow
+// function.
+var AdminService_MaintainCorruptWorkflow_Helper = struct {
+	// Args accepts the parameters of MaintainCorruptWorkflow in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *AdminMaintainWorkflowRequest,
+	) *AdminService_MaintainCorruptWorkflow_Args
+
+	// IsException returns true if the given error can be thrown
+	// by MaintainCorruptWorkflow.
+	//
+	// An error can be thrown by MaintainCorruptWorkflow only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for MaintainCorruptWorkflow
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// MaintainCorruptWorkflow into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by MaintainCorruptWorkflow
+	//
+	//   value, err := MaintainCorruptWorkflow(args)
+	//   result, err := AdminService_MaintainCorruptWorkflow_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from MaintainCorruptWorkflow: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*AdminMaintainWorkflowResponse, error) (*AdminService_MaintainCorruptWorkflow_Result, error)
+
+	// UnwrapResponse takes the result struct for MaintainCorruptWorkflow
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if MaintainCorruptWorkflow threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_MaintainCorruptWorkflow_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_MaintainCorruptWorkflow_Result) (*AdminMaintainWorkflowResponse, error)
+}{}
+
+func init() {
+	AdminService_MaintainCorruptWorkflow_Helper.Args = func(
+		request *AdminMaintainWorkflowRequest,
+	) *AdminService_MaintainCorruptWorkflow_Args {
+		return &AdminService_MaintainCorruptWorkflow_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_MaintainCorruptWorkflow_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_MaintainCorruptWorkflow_Helper.WrapResponse = func(success *AdminMaintainWorkflowResponse, err error) (*AdminService_MaintainCorruptWorkflow_Result, error) {
+		if err == nil {
+			return &AdminService_MaintainCorruptWorkflow_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MaintainCorruptWorkflow_Result.BadRequestError")
+			}
+			return &AdminService_MaintainCorruptWorkflow_Result{BadRequestError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MaintainCorruptWorkflow_Result.EntityNotExistError")
+			}
+			return &AdminService_MaintainCorruptWorkflow_Result{EntityNotExistError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MaintainCorruptWorkflow_Result.InternalServiceError")
+			}
+			return &AdminService_MaintainCorruptWorkflow_Result{InternalServiceError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_MaintainCorruptWorkflow_Helper.UnwrapResponse = func(result *AdminService_MaintainCorruptWorkflow_Result) (success *AdminMaintainWorkflowResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_MaintainCorruptWorkflow_Result represents the result of a AdminService.MaintainCorruptWorkflow function call.
+//
+// The result of a MaintainCorruptWorkflow execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_MaintainCorruptWorkflow_Result struct {
+	// Value returned by MaintainCorruptWorkflow after a successful execution.
+	Success              *AdminMaintainWorkflowResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError        `json:"badRequestError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError   `json:"entityNotExistError,omitempty"`
+	InternalServiceError *shared.InternalServiceError   `json:"internalServiceError,omitempty"`
+}
+
+// ToWire translates a AdminService_MaintainCorruptWorkflow_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_MaintainCorruptWorkflow_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_MaintainCorruptWorkflow_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _AdminMaintainWorkflowResponse_Read(w wire.Value) (*AdminMaintainWorkflowResponse, error) {
+	var v AdminMaintainWorkflowResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_MaintainCorruptWorkflow_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_MaintainCorruptWorkflow_Result struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_MaintainCorruptWorkflow_Result
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_MaintainCorruptWorkflow_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _AdminMaintainWorkflowResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_MaintainCorruptWorkflow_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// Encode serializes a AdminService_MaintainCorruptWorkflow_Result struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AdminService_MaintainCorruptWorkflow_Result struct could not be encoded.
+func (v *AdminService_MaintainCorruptWorkflow_Result) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.BadRequestError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EntityNotExistError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.InternalServiceError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.InternalServiceError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+
+	if count != 1 {
+		return fmt.Errorf("AdminService_MaintainCorruptWorkflow_Result should have exactly one field: got %v fields", count)
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _AdminMaintainWorkflowResponse_Decode(sr stream.Reader) (*AdminMaintainWorkflowResponse, error) {
+	var v AdminMaintainWorkflowResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_MaintainCorruptWorkflow_Result struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AdminService_MaintainCorruptWorkflow_Result struct could not be generated from the wire
+// representation.
+func (v *AdminService_MaintainCorruptWorkflow_Result) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _AdminMaintainWorkflowResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_MaintainCorruptWorkflow_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_MaintainCorruptWorkflow_Result
+// struct.
+func (v *AdminService_MaintainCorruptWorkflow_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_MaintainCorruptWorkflow_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_MaintainCorruptWorkflow_Result match the
+// provided AdminService_MaintainCorruptWorkflow_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_MaintainCorruptWorkflow_Result) Equals(rhs *AdminService_MaintainCorruptWorkflow_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_MaintainCorruptWorkflow_Result.
+func (v *AdminService_MaintainCorruptWorkflow_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_MaintainCorruptWorkflow_Result) GetSuccess() (o *AdminMaintainWorkflowResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_MaintainCorruptWorkflow_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_MaintainCorruptWorkflow_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_MaintainCorruptWorkflow_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_MaintainCorruptWorkflow_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_MaintainCorruptWorkflow_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_MaintainCorruptWorkflow_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_MaintainCorruptWorkflow_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "MaintainCorruptWorkflow" for this struct.
+func (v *AdminService_MaintainCorruptWorkflow_Result) MethodName() string {
+	return "MaintainCorruptWorkflow"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_MaintainCorruptWorkflow_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_MergeDLQMessages_Args represents the arguments for the AdminService.MergeDLQMessages function.
+//
+// The arguments for MergeDLQMessages are sent and received over the wire as this struct.
+type AdminService_MergeDLQMessages_Args struct {
+	Request *replicator.MergeDLQMessagesRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_MergeDLQMessages_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *AdminService_MergeDLQMessages_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _MergeDLQMessagesRequest_Read(w wire.Value) (*replicator.MergeDLQMessagesRequest, error) {
+	var v replicator.MergeDLQMessagesRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_MergeDLQMessages_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_MergeDLQMessages_Args struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v AdminService_MergeDLQMessages_Args
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *AdminService_MergeDLQMessages_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _MergeDLQMessagesRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a AdminService_MergeDLQMessages_Args struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AdminService_MergeDLQMessages_Args struct could not be encoded.
+func (v *AdminService_MergeDLQMessages_Args) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Request != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Request.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _MergeDLQMessagesRequest_Decode(sr stream.Reader) (*replicator.MergeDLQMessagesRequest, error) {
+	var v replicator.MergeDLQMessagesRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_MergeDLQMessages_Args struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AdminService_MergeDLQMessages_Args struct could not be generated from the wire
+// representation.
+func (v *AdminService_MergeDLQMessages_Args) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.Request, err = _MergeDLQMessagesRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_MergeDLQMessages_Args
+// struct.
+func (v *AdminService_MergeDLQMessages_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -23063,14 +29595,14 @@ func (v *AdminService_GetReplicationMessages_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetReplicationMessages_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_MergeDLQMessages_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetReplicationMessages_Args match the
-// provided AdminService_GetReplicationMessages_Args.
+// Equals returns true if all the fields of this AdminService_MergeDLQMessages_Args match the
+// provided AdminService_MergeDLQMessages_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetReplicationMessages_Args) Equals(rhs *AdminService_GetReplicationMessages_Args) bool {
+func (v *AdminService_MergeDLQMessages_Args) Equals(rhs *AdminService_MergeDLQMessages_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -23084,8 +29616,8 @@ func (v *AdminService_GetReplicationMessages_Args) Equals(rhs *AdminService_GetR
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetReplicationMessages_Args.
-func (v *AdminService_GetReplicationMessages_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_MergeDLQMessages_Args.
+func (v *AdminService_MergeDLQMessages_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -23097,7 +29629,7 @@ func (v *AdminService_GetReplicationMessages_Args) MarshalLogObject(enc zapcore.
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetReplicationMessages_Args) GetRequest() (o *replicator.GetReplicationMessagesRequest) {
+func (v *AdminService_MergeDLQMessages_Args) GetRequest() (o *replicator.MergeDLQMessagesRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -23106,139 +29638,139 @@ func (v *AdminService_GetReplicationMessages_Args) GetRequest() (o *replicator.G
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_GetReplicationMessages_Args) IsSetRequest() bool {
+func (v *AdminService_MergeDLQMessages_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "GetReplicationMessages" for this struct.
-func (v *AdminService_GetReplicationMessages_Args) MethodName() string {
-	return "GetReplicationMessages"
+// This will always be "MergeDLQMessages" for this struct.
+func (v *AdminService_MergeDLQMessages_Args) MethodName() string {
+	return "MergeDLQMessages"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_GetReplicationMessages_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_MergeDLQMessages_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_GetReplicationMessages_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.GetReplicationMessages
+// AdminService_MergeDLQMessages_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.MergeDLQMessages
 // function.
-var AdminService_GetReplicationMessages_Helper = struct {
-	// Args accepts the parameters of GetReplicationMessages in-order and returns
+var AdminService_MergeDLQMessages_Helper = struct {
+	// Args accepts the parameters of MergeDLQMessages in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *replicator.GetReplicationMessagesRequest,
-	) *AdminService_GetReplicationMessages_Args
+		request *replicator.MergeDLQMessagesRequest,
+	) *AdminService_MergeDLQMessages_Args
 
 	// IsException returns true if the given error can be thrown
-	// by GetReplicationMessages.
+	// by MergeDLQMessages.
 	//
-	// An error can be thrown by GetReplicationMessages only if the
+	// An error can be thrown by MergeDLQMessages only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for GetReplicationMessages
+	// WrapResponse returns the result struct for MergeDLQMessages
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// GetReplicationMessages into a serializable result struct.
+	// MergeDLQMessages into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by GetReplicationMessages
+	// error cannot be thrown by MergeDLQMessages
 	//
-	//   value, err := GetReplicationMessages(args)
-	//   result, err := AdminService_GetReplicationMessages_Helper.WrapResponse(value, err)
+	//   value, err := MergeDLQMessages(args)
+	//   result, err := AdminService_MergeDLQMessages_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from GetReplicationMessages: %v", err)
+	//     return fmt.Errorf("unexpected error from MergeDLQMessages: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*replicator.GetReplicationMessagesResponse, error) (*AdminService_GetReplicationMessages_Result, error)
+	WrapResponse func(*replicator.MergeDLQMessagesResponse, error) (*AdminService_MergeDLQMessages_Result, error)
 
-	// UnwrapResponse takes the result struct for GetReplicationMessages
+	// UnwrapResponse takes the result struct for MergeDLQMessages
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if GetReplicationMessages threw an
+	// The error is non-nil only if MergeDLQMessages threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_GetReplicationMessages_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_GetReplicationMessages_Result) (*replicator.GetReplicationMessagesResponse, error)
+	//   value, err := AdminService_MergeDLQMessages_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_MergeDLQMessages_Result) (*replicator.MergeDLQMessagesResponse, error)
 }{}
 
 func init() {
-	AdminService_GetReplicationMessages_Helper.Args = func(
-		request *replicator.GetReplicationMessagesRequest,
-	) *AdminService_GetReplicationMessages_Args {
-		return &AdminService_GetReplicationMessages_Args{
+	AdminService_MergeDLQMessages_Helper.Args = func(
+		request *replicator.MergeDLQMessagesRequest,
+	) *AdminService_MergeDLQMessages_Args {
+		return &AdminService_MergeDLQMessages_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_GetReplicationMessages_Helper.IsException = func(err error) bool {
+	AdminService_MergeDLQMessages_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
-		case *shared.LimitExceededError:
+		case *shared.InternalServiceError:
 			return true
 		case *shared.ServiceBusyError:
 			return true
-		case *shared.ClientVersionNotSupportedError:
+		case *shared.EntityNotExistsError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_GetReplicationMessages_Helper.WrapResponse = func(success *replicator.GetReplicationMessagesResponse, err error) (*AdminService_GetReplicationMessages_Result, error) {
+	AdminService_MergeDLQMessages_Helper.WrapResponse = func(success *replicator.MergeDLQMessagesResponse, err error) (*AdminService_MergeDLQMessages_Result, error) {
 		if err == nil {
-			return &AdminService_GetReplicationMessages_Result{Success: success}, nil
+			return &AdminService_MergeDLQMessages_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationMessages_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MergeDLQMessages_Result.BadRequestError")
 			}
-			return &AdminService_GetReplicationMessages_Result{BadRequestError: e}, nil
-		case *shared.LimitExceededError:
+			return &AdminService_MergeDLQMessages_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationMessages_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MergeDLQMessages_Result.InternalServiceError")
 			}
-			return &AdminService_GetReplicationMessages_Result{LimitExceededError: e}, nil
+			return &AdminService_MergeDLQMessages_Result{InternalServiceError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationMessages_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MergeDLQMessages_Result.ServiceBusyError")
 			}
-			return &AdminService_GetReplicationMessages_Result{ServiceBusyError: e}, nil
-		case *shared.ClientVersionNotSupportedError:
+			return &AdminService_MergeDLQMessages_Result{ServiceBusyError: e}, nil
+		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetReplicationMessages_Result.ClientVersionNotSupportedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MergeDLQMessages_Result.EntityNotExistError")
 			}
-			return &AdminService_GetReplicationMessages_Result{ClientVersionNotSupportedError: e}, nil
+			return &AdminService_MergeDLQMessages_Result{EntityNotExistError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_GetReplicationMessages_Helper.UnwrapResponse = func(result *AdminService_GetReplicationMessages_Result) (success *replicator.GetReplicationMessagesResponse, err error) {
+	AdminService_MergeDLQMessages_Helper.UnwrapResponse = func(result *AdminService_MergeDLQMessages_Result) (success *replicator.MergeDLQMessagesResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
 		}
-		if result.LimitExceededError != nil {
-			err = result.LimitExceededError
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
 			return
 		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
 		}
-		if result.ClientVersionNotSupportedError != nil {
-			err = result.ClientVersionNotSupportedError
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
 			return
 		}
 
@@ -23253,21 +29785,21 @@ func init() {
 
 }
 
-// AdminService_GetReplicationMessages_Result represents the result of a AdminService.GetReplicationMessages function call.
+// AdminService_MergeDLQMessages_Result represents the result of a AdminService.MergeDLQMessages function call.
 //
-// The result of a GetReplicationMessages execution is sent and received over the wire as this struct.
+// The result of a MergeDLQMessages execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type AdminService_GetReplicationMessages_Result struct {
-	// Value returned by GetReplicationMessages after a successful execution.
-	Success                        *replicator.GetReplicationMessagesResponse `json:"success,omitempty"`
-	BadRequestError                *shared.BadRequestError                    `json:"badRequestError,omitempty"`
-	LimitExceededError             *shared.LimitExceededError                 `json:"limitExceededError,omitempty"`
-	ServiceBusyError               *shared.ServiceBusyError                   `json:"serviceBusyError,omitempty"`
-	ClientVersionNotSupportedError *shared.ClientVersionNotSupportedError     `json:"clientVersionNotSupportedError,omitempty"`
+type AdminService_MergeDLQMessages_Result struct {
+	// Value returned by MergeDLQMessages after a successful execution.
+	Success              *replicator.MergeDLQMessagesResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError              `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError         `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError             `json:"serviceBusyError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError         `json:"entityNotExistError,omitempty"`
 }
 
-// ToWire translates a AdminService_GetReplicationMessages_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_MergeDLQMessages_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -23282,7 +29814,7 @@ type AdminService_GetReplicationMessages_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_GetReplicationMessages_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_MergeDLQMessages_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [5]wire.Field
 		i      int = 0
@@ -23306,12 +29838,12 @@ func (v *AdminService_GetReplicationMessages_Result) ToWire() (wire.Value, error
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.LimitExceededError != nil {
-		w, err = v.LimitExceededError.ToWire()
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
 	if v.ServiceBusyError != nil {
@@ -23319,36 +29851,36 @@ func (v *AdminService_GetReplicationMessages_Result) ToWire() (wire.Value, error
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 4, Value: w}
+		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.ClientVersionNotSupportedError != nil {
-		w, err = v.ClientVersionNotSupportedError.ToWire()
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 5, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_GetReplicationMessages_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_MergeDLQMessages_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetReplicationMessagesResponse_Read(w wire.Value) (*replicator.GetReplicationMessagesResponse, error) {
-	var v replicator.GetReplicationMessagesResponse
+func _MergeDLQMessagesResponse_Read(w wire.Value) (*replicator.MergeDLQMessagesResponse, error) {
+	var v replicator.MergeDLQMessagesResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetReplicationMessages_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_MergeDLQMessages_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetReplicationMessages_Result struct
+// An error is returned if we were unable to build a AdminService_MergeDLQMessages_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -23356,19 +29888,19 @@ func _GetReplicationMessagesResponse_Read(w wire.Value) (*replicator.GetReplicat
 //	  return nil, err
 //	}
 //
-//	var v AdminService_GetReplicationMessages_Result
+//	var v AdminService_MergeDLQMessages_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_GetReplicationMessages_Result) FromWire(w wire.Value) error {
+func (v *AdminService_MergeDLQMessages_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _GetReplicationMessagesResponse_Read(field.Value)
+				v.Success, err = _MergeDLQMessagesResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -23382,15 +29914,15 @@ func (v *AdminService_GetReplicationMessages_Result) FromWire(w wire.Value) erro
 				}
 
 			}
-		case 3:
+		case 2:
 			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 4:
+		case 3:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
@@ -23398,9 +29930,9 @@ func (v *AdminService_GetReplicationMessages_Result) FromWire(w wire.Value) erro
 				}
 
 			}
-		case 5:
+		case 4:
 			if field.Value.Type() == wire.TStruct {
-				v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -23416,27 +29948,27 @@ func (v *AdminService_GetReplicationMessages_Result) FromWire(w wire.Value) erro
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.ClientVersionNotSupportedError != nil {
+	if v.EntityNotExistError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetReplicationMessages_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_MergeDLQMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_GetReplicationMessages_Result struct directly into bytes, without going
+// Encode serializes a AdminService_MergeDLQMessages_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetReplicationMessages_Result struct could not be encoded.
-func (v *AdminService_GetReplicationMessages_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_MergeDLQMessages_Result struct could not be encoded.
+func (v *AdminService_MergeDLQMessages_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -23465,11 +29997,11 @@ func (v *AdminService_GetReplicationMessages_Result) Encode(sw stream.Writer) er
 		}
 	}
 
-	if v.LimitExceededError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+	if v.InternalServiceError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.LimitExceededError.Encode(sw); err != nil {
+		if err := v.InternalServiceError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -23478,7 +30010,7 @@ func (v *AdminService_GetReplicationMessages_Result) Encode(sw stream.Writer) er
 	}
 
 	if v.ServiceBusyError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.ServiceBusyError.Encode(sw); err != nil {
@@ -23489,11 +30021,11 @@ func (v *AdminService_GetReplicationMessages_Result) Encode(sw stream.Writer) er
 		}
 	}
 
-	if v.ClientVersionNotSupportedError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+	if v.EntityNotExistError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.ClientVersionNotSupportedError.Encode(sw); err != nil {
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -23508,35 +30040,35 @@ func (v *AdminService_GetReplicationMessages_Result) Encode(sw stream.Writer) er
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.ClientVersionNotSupportedError != nil {
+	if v.EntityNotExistError != nil {
 		count++
 	}
 
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetReplicationMessages_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_MergeDLQMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _GetReplicationMessagesResponse_Decode(sr stream.Reader) (*replicator.GetReplicationMessagesResponse, error) {
-	var v replicator.GetReplicationMessagesResponse
+func _MergeDLQMessagesResponse_Decode(sr stream.Reader) (*replicator.MergeDLQMessagesResponse, error) {
+	var v replicator.MergeDLQMessagesResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetReplicationMessages_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_MergeDLQMessages_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetReplicationMessages_Result struct could not be generated from the wire
+// An error is returned if a AdminService_MergeDLQMessages_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetReplicationMessages_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_MergeDLQMessages_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -23550,7 +30082,7 @@ func (v *AdminService_GetReplicationMessages_Result) Decode(sr stream.Reader) er
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _GetReplicationMessagesResponse_Decode(sr)
+			v.Success, err = _MergeDLQMessagesResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -23561,20 +30093,20 @@ func (v *AdminService_GetReplicationMessages_Result) Decode(sr stream.Reader) er
 				return err
 			}
 
-		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 4 && fh.Type == wire.TStruct:
+		case fh.ID == 3 && fh.Type == wire.TStruct:
 			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 5 && fh.Type == wire.TStruct:
-			v.ClientVersionNotSupportedError, err = _ClientVersionNotSupportedError_Decode(sr)
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -23605,25 +30137,25 @@ func (v *AdminService_GetReplicationMessages_Result) Decode(sr stream.Reader) er
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.ClientVersionNotSupportedError != nil {
+	if v.EntityNotExistError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_GetReplicationMessages_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_MergeDLQMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetReplicationMessages_Result
+// String returns a readable string representation of a AdminService_MergeDLQMessages_Result
 // struct.
-func (v *AdminService_GetReplicationMessages_Result) String() string {
+func (v *AdminService_MergeDLQMessages_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -23638,27 +30170,27 @@ func (v *AdminService_GetReplicationMessages_Result) String() string {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.LimitExceededError != nil {
-		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
-	if v.ClientVersionNotSupportedError != nil {
-		fields[i] = fmt.Sprintf("ClientVersionNotSupportedError: %v", v.ClientVersionNotSupportedError)
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetReplicationMessages_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_MergeDLQMessages_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetReplicationMessages_Result match the
-// provided AdminService_GetReplicationMessages_Result.
+// Equals returns true if all the fields of this AdminService_MergeDLQMessages_Result match the
+// provided AdminService_MergeDLQMessages_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetReplicationMessages_Result) Equals(rhs *AdminService_GetReplicationMessages_Result) bool {
+func (v *AdminService_MergeDLQMessages_Result) Equals(rhs *AdminService_MergeDLQMessages_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -23670,13 +30202,13 @@ func (v *AdminService_GetReplicationMessages_Result) Equals(rhs *AdminService_Ge
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
-	if !((v.ClientVersionNotSupportedError == nil && rhs.ClientVersionNotSupportedError == nil) || (v.ClientVersionNotSupportedError != nil && rhs.ClientVersionNotSupportedError != nil && v.ClientVersionNotSupportedError.Equals(rhs.ClientVersionNotSupportedError))) {
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
 
@@ -23684,8 +30216,8 @@ func (v *AdminService_GetReplicationMessages_Result) Equals(rhs *AdminService_Ge
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetReplicationMessages_Result.
-func (v *AdminService_GetReplicationMessages_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_MergeDLQMessages_Result.
+func (v *AdminService_MergeDLQMessages_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -23695,21 +30227,21 @@ func (v *AdminService_GetReplicationMessages_Result) MarshalLogObject(enc zapcor
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.LimitExceededError != nil {
-		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
-	if v.ClientVersionNotSupportedError != nil {
-		err = multierr.Append(err, enc.AddObject("clientVersionNotSupportedError", v.ClientVersionNotSupportedError))
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
 	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetReplicationMessages_Result) GetSuccess() (o *replicator.GetReplicationMessagesResponse) {
+func (v *AdminService_MergeDLQMessages_Result) GetSuccess() (o *replicator.MergeDLQMessagesResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -23718,13 +30250,13 @@ func (v *AdminService_GetReplicationMessages_Result) GetSuccess() (o *replicator
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_GetReplicationMessages_Result) IsSetSuccess() bool {
+func (v *AdminService_MergeDLQMessages_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetReplicationMessages_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_MergeDLQMessages_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -23733,28 +30265,28 @@ func (v *AdminService_GetReplicationMessages_Result) GetBadRequestError() (o *sh
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_GetReplicationMessages_Result) IsSetBadRequestError() bool {
+func (v *AdminService_MergeDLQMessages_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
-// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetReplicationMessages_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
-	if v != nil && v.LimitExceededError != nil {
-		return v.LimitExceededError
+func (v *AdminService_MergeDLQMessages_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
 	}
 
 	return
 }
 
-// IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *AdminService_GetReplicationMessages_Result) IsSetLimitExceededError() bool {
-	return v != nil && v.LimitExceededError != nil
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_MergeDLQMessages_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetReplicationMessages_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *AdminService_MergeDLQMessages_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -23763,48 +30295,48 @@ func (v *AdminService_GetReplicationMessages_Result) GetServiceBusyError() (o *s
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_GetReplicationMessages_Result) IsSetServiceBusyError() bool {
+func (v *AdminService_MergeDLQMessages_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
-// GetClientVersionNotSupportedError returns the value of ClientVersionNotSupportedError if it is set or its
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetReplicationMessages_Result) GetClientVersionNotSupportedError() (o *shared.ClientVersionNotSupportedError) {
-	if v != nil && v.ClientVersionNotSupportedError != nil {
-		return v.ClientVersionNotSupportedError
+func (v *AdminService_MergeDLQMessages_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
 	}
 
 	return
 }
 
-// IsSetClientVersionNotSupportedError returns true if ClientVersionNotSupportedError is not nil.
-func (v *AdminService_GetReplicationMessages_Result) IsSetClientVersionNotSupportedError() bool {
-	return v != nil && v.ClientVersionNotSupportedError != nil
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_MergeDLQMessages_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "GetReplicationMessages" for this struct.
-func (v *AdminService_GetReplicationMessages_Result) MethodName() string {
-	return "GetReplicationMessages"
+// This will always be "MergeDLQMessages" for this struct.
+func (v *AdminService_MergeDLQMessages_Result) MethodName() string {
+	return "MergeDLQMessages"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_GetReplicationMessages_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_MergeDLQMessages_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_GetWorkflowExecutionRawHistoryV2_Args represents the arguments for the AdminService.GetWorkflowExecutionRawHistoryV2 function.
+// AdminService_PauseActivity_Args represents the arguments for the AdminService.PauseActivity function.
 //
-// The arguments for GetWorkflowExecutionRawHistoryV2 are sent and received over the wire as this struct.
-type AdminService_GetWorkflowExecutionRawHistoryV2_Args struct {
-	GetRequest *GetWorkflowExecutionRawHistoryV2Request `json:"getRequest,omitempty"`
+// The arguments for PauseActivity are sent and received over the wire as this struct.
+type AdminService_PauseActivity_Args struct {
+	Request *shared.PauseActivityRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_PauseActivity_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -23819,7 +30351,7 @@ type AdminService_GetWorkflowExecutionRawHistoryV2_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_PauseActivity_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -23827,8 +30359,8 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) ToWire() (wire.Valu
 		err    error
 	)
 
-	if v.GetRequest != nil {
-		w, err = v.GetRequest.ToWire()
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -23839,17 +30371,17 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) ToWire() (wire.Valu
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetWorkflowExecutionRawHistoryV2Request_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryV2Request, error) {
-	var v GetWorkflowExecutionRawHistoryV2Request
+func _PauseActivityRequest_Read(w wire.Value) (*shared.PauseActivityRequest, error) {
+	var v shared.PauseActivityRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_PauseActivity_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct
+// An error is returned if we were unable to build a AdminService_PauseActivity_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -23857,19 +30389,19 @@ func _GetWorkflowExecutionRawHistoryV2Request_Read(w wire.Value) (*GetWorkflowEx
 //	  return nil, err
 //	}
 //
-//	var v AdminService_GetWorkflowExecutionRawHistoryV2_Args
+//	var v AdminService_PauseActivity_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) FromWire(w wire.Value) error {
+func (v *AdminService_PauseActivity_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.GetRequest, err = _GetWorkflowExecutionRawHistoryV2Request_Read(field.Value)
+				v.Request, err = _PauseActivityRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -23881,20 +30413,20 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) FromWire(w wire.Val
 	return nil
 }
 
-// Encode serializes a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct directly into bytes, without going
+// Encode serializes a AdminService_PauseActivity_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct could not be encoded.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_PauseActivity_Args struct could not be encoded.
+func (v *AdminService_PauseActivity_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.GetRequest != nil {
+	if v.Request != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.GetRequest.Encode(sw); err != nil {
+		if err := v.Request.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -23905,18 +30437,18 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Encode(sw stream.Wr
 	return sw.WriteStructEnd()
 }
 
-func _GetWorkflowExecutionRawHistoryV2Request_Decode(sr stream.Reader) (*GetWorkflowExecutionRawHistoryV2Request, error) {
-	var v GetWorkflowExecutionRawHistoryV2Request
+func _PauseActivityRequest_Decode(sr stream.Reader) (*shared.PauseActivityRequest, error) {
+	var v shared.PauseActivityRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_PauseActivity_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetWorkflowExecutionRawHistoryV2_Args struct could not be generated from the wire
+// An error is returned if a AdminService_PauseActivity_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_PauseActivity_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -23930,7 +30462,7 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Decode(sr stream.Re
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.GetRequest, err = _GetWorkflowExecutionRawHistoryV2Request_Decode(sr)
+			v.Request, err = _PauseActivityRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -23957,34 +30489,34 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Decode(sr stream.Re
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistoryV2_Args
+// String returns a readable string representation of a AdminService_PauseActivity_Args
 // struct.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) String() string {
+func (v *AdminService_PauseActivity_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.GetRequest != nil {
-		fields[i] = fmt.Sprintf("GetRequest: %v", v.GetRequest)
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistoryV2_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_PauseActivity_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistoryV2_Args match the
-// provided AdminService_GetWorkflowExecutionRawHistoryV2_Args.
+// Equals returns true if all the fields of this AdminService_PauseActivity_Args match the
+// provided AdminService_PauseActivity_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Equals(rhs *AdminService_GetWorkflowExecutionRawHistoryV2_Args) bool {
+func (v *AdminService_PauseActivity_Args) Equals(rhs *AdminService_PauseActivity_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.GetRequest == nil && rhs.GetRequest == nil) || (v.GetRequest != nil && rhs.GetRequest != nil && v.GetRequest.Equals(rhs.GetRequest))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -23992,190 +30524,192 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) Equals(rhs *AdminSe
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetWorkflowExecutionRawHistoryV2_Args.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_PauseActivity_Args.
+func (v *AdminService_PauseActivity_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.GetRequest != nil {
-		err = multierr.Append(err, enc.AddObject("getRequest", v.GetRequest))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetGetRequest returns the value of GetRequest if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) GetGetRequest() (o *GetWorkflowExecutionRawHistoryV2Request) {
-	if v != nil && v.GetRequest != nil {
-		return v.GetRequest
+func (v *AdminService_PauseActivity_Args) GetRequest() (o *shared.PauseActivityRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetGetRequest returns true if GetRequest is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) IsSetGetRequest() bool {
-	return v != nil && v.GetRequest != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_PauseActivity_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "GetWorkflowExecutionRawHistoryV2" for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) MethodName() string {
-	return "GetWorkflowExecutionRawHistoryV2"
+// This will always be "PauseActivity" for this struct.
+func (v *AdminService_PauseActivity_Args) MethodName() string {
+	return "PauseActivity"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_PauseActivity_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_GetWorkflowExecutionRawHistoryV2_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.GetWorkflowExecutionRawHistoryV2
+// AdminService_PauseActivity_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.PauseActivity
 // function.
-var AdminService_GetWorkflowExecutionRawHistoryV2_Helper = struct {
-	// Args accepts the parameters of GetWorkflowExecutionRawHistoryV2 in-order and returns
+var AdminService_PauseActivity_Helper = struct {
+	// Args accepts the parameters of PauseActivity in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		getRequest *GetWorkflowExecutionRawHistoryV2Request,
-	) *AdminService_GetWorkflowExecutionRawHistoryV2_Args
+		request *shared.PauseActivityRequest,
+	) *AdminService_PauseActivity_Args
 
 	// IsException returns true if the given error can be thrown
-	// by GetWorkflowExecutionRawHistoryV2.
+	// by PauseActivity.
 	//
-	// An error can be thrown by GetWorkflowExecutionRawHistoryV2 only if the
+	// An error can be thrown by PauseActivity only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for GetWorkflowExecutionRawHistoryV2
-	// given its return value and error.
+	// WrapResponse returns the result struct for PauseActivity
+	// given the error returned by it. The provided error may
+	// be nil if PauseActivity did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// GetWorkflowExecutionRawHistoryV2 into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by GetWorkflowExecutionRawHistoryV2
+	// This allows mapping errors returned by PauseActivity into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// PauseActivity
 	//
-	//   value, err := GetWorkflowExecutionRawHistoryV2(args)
-	//   result, err := AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse(value, err)
+	//   err := PauseActivity(args)
+	//   result, err := AdminService_PauseActivity_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from GetWorkflowExecutionRawHistoryV2: %v", err)
+	//     return fmt.Errorf("unexpected error from PauseActivity: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*GetWorkflowExecutionRawHistoryV2Response, error) (*AdminService_GetWorkflowExecutionRawHistoryV2_Result, error)
+	WrapResponse func(error) (*AdminService_PauseActivity_Result, error)
 
-	// UnwrapResponse takes the result struct for GetWorkflowExecutionRawHistoryV2
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for PauseActivity
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if GetWorkflowExecutionRawHistoryV2 threw an
+	// The error is non-nil only if PauseActivity threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_GetWorkflowExecutionRawHistoryV2_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_GetWorkflowExecutionRawHistoryV2_Result) (*GetWorkflowExecutionRawHistoryV2Response, error)
+	//   err := AdminService_PauseActivity_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_PauseActivity_Result) error
 }{}
 
 func init() {
-	AdminService_GetWorkflowExecutionRawHistoryV2_Helper.Args = func(
-		getRequest *GetWorkflowExecutionRawHistoryV2Request,
-	) *AdminService_GetWorkflowExecutionRawHistoryV2_Args {
-		return &AdminService_GetWorkflowExecutionRawHistoryV2_Args{
-			GetRequest: getRequest,
+	AdminService_PauseActivity_Helper.Args = func(
+		request *shared.PauseActivityRequest,
+	) *AdminService_PauseActivity_Args {
+		return &AdminService_PauseActivity_Args{
+			Request: request,
 		}
 	}
 
-	AdminService_GetWorkflowExecutionRawHistoryV2_Helper.IsException = func(err error) bool {
+	AdminService_PauseActivity_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
-		case *shared.InternalServiceError:
+		case *shared.DomainNotActiveError:
+			return true
+		case *shared.ServiceBusyError:
 			return true
 		case *shared.EntityNotExistsError:
 			return true
-		case *shared.ServiceBusyError:
+		case *shared.WorkflowExecutionAlreadyCompletedError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse = func(success *GetWorkflowExecutionRawHistoryV2Response, err error) (*AdminService_GetWorkflowExecutionRawHistoryV2_Result, error) {
+	AdminService_PauseActivity_Helper.WrapResponse = func(err error) (*AdminService_PauseActivity_Result, error) {
 		if err == nil {
-			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{Success: success}, nil
+			return &AdminService_PauseActivity_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistoryV2_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PauseActivity_Result.BadRequestError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{BadRequestError: e}, nil
-		case *shared.InternalServiceError:
+			return &AdminService_PauseActivity_Result{BadRequestError: e}, nil
+		case *shared.DomainNotActiveError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistoryV2_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PauseActivity_Result.DomainNotActiveError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{InternalServiceError: e}, nil
+			return &AdminService_PauseActivity_Result{DomainNotActiveError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PauseActivity_Result.ServiceBusyError")
+			}
+			return &AdminService_PauseActivity_Result{ServiceBusyError: e}, nil
 		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistoryV2_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PauseActivity_Result.EntityNotExistError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{EntityNotExistError: e}, nil
-		case *shared.ServiceBusyError:
+			return &AdminService_PauseActivity_Result{EntityNotExistError: e}, nil
+		case *shared.WorkflowExecutionAlreadyCompletedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistoryV2_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PauseActivity_Result.WorkflowExecutionAlreadyCompletedError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistoryV2_Result{ServiceBusyError: e}, nil
+			return &AdminService_PauseActivity_Result{WorkflowExecutionAlreadyCompletedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_GetWorkflowExecutionRawHistoryV2_Helper.UnwrapResponse = func(result *AdminService_GetWorkflowExecutionRawHistoryV2_Result) (success *GetWorkflowExecutionRawHistoryV2Response, err error) {
+	AdminService_PauseActivity_Helper.UnwrapResponse = func(result *AdminService_PauseActivity_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
 		}
-		if result.InternalServiceError != nil {
-			err = result.InternalServiceError
-			return
-		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
 			return
 		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.WorkflowExecutionAlreadyCompletedError != nil {
+			err = result.WorkflowExecutionAlreadyCompletedError
 			return
 		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_GetWorkflowExecutionRawHistoryV2_Result represents the result of a AdminService.GetWorkflowExecutionRawHistoryV2 function call.
-//
-// The result of a GetWorkflowExecutionRawHistoryV2 execution is sent and received over the wire as this struct.
+// AdminService_PauseActivity_Result represents the result of a AdminService.PauseActivity function call.
 //
-// Success is set only if the function did not throw an exception.
-type AdminService_GetWorkflowExecutionRawHistoryV2_Result struct {
-	// Value returned by GetWorkflowExecutionRawHistoryV2 after a successful execution.
-	Success              *GetWorkflowExecutionRawHistoryV2Response `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError                   `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError              `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError              `json:"entityNotExistError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError                  `json:"serviceBusyError,omitempty"`
+// The result of a PauseActivity execution is sent and received over the wire as this struct.
+type AdminService_PauseActivity_Result struct {
+	BadRequestError                        *shared.BadRequestError                        `json:"badRequestError,omitempty"`
+	DomainNotActiveError                   *shared.DomainNotActiveError                   `json:"domainNotActiveError,omitempty"`
+	ServiceBusyError                       *shared.ServiceBusyError                       `json:"serviceBusyError,omitempty"`
+	EntityNotExistError                    *shared.EntityNotExistsError                   `json:"entityNotExistError,omitempty"`
+	WorkflowExecutionAlreadyCompletedError *shared.WorkflowExecutionAlreadyCompletedError `json:"workflowExecutionAlreadyCompletedError,omitempty"`
 }
 
-// ToWire translates a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_PauseActivity_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -24190,7 +30724,7 @@ type AdminService_GetWorkflowExecutionRawHistoryV2_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_PauseActivity_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [5]wire.Field
 		i      int = 0
@@ -24198,28 +30732,28 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) ToWire() (wire.Va
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 0, Value: w}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.BadRequestError != nil {
-		w, err = v.BadRequestError.ToWire()
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 1, Value: w}
+		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.InternalServiceError != nil {
-		w, err = v.InternalServiceError.ToWire()
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 2, Value: w}
+		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
 	if v.EntityNotExistError != nil {
@@ -24227,36 +30761,42 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) ToWire() (wire.Va
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		w, err = v.ServiceBusyError.ToWire()
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		w, err = v.WorkflowExecutionAlreadyCompletedError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 4, Value: w}
+		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_GetWorkflowExecutionRawHistoryV2_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_PauseActivity_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetWorkflowExecutionRawHistoryV2Response_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryV2Response, error) {
-	var v GetWorkflowExecutionRawHistoryV2Response
+func _DomainNotActiveError_Read(w wire.Value) (*shared.DomainNotActiveError, error) {
+	var v shared.DomainNotActiveError
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct from its Thrift-level
+func _WorkflowExecutionAlreadyCompletedError_Read(w wire.Value) (*shared.WorkflowExecutionAlreadyCompletedError, error) {
+	var v shared.WorkflowExecutionAlreadyCompletedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_PauseActivity_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct
+// An error is returned if we were unable to build a AdminService_PauseActivity_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -24264,41 +30804,41 @@ func _GetWorkflowExecutionRawHistoryV2Response_Read(w wire.Value) (*GetWorkflowE
 //	  return nil, err
 //	}
 //
-//	var v AdminService_GetWorkflowExecutionRawHistoryV2_Result
+//	var v AdminService_PauseActivity_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) FromWire(w wire.Value) error {
+func (v *AdminService_PauseActivity_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
+		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _GetWorkflowExecutionRawHistoryV2Response_Read(field.Value)
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 1:
+		case 2:
 			if field.Value.Type() == wire.TStruct {
-				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 2:
+		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 3:
+		case 4:
 			if field.Value.Type() == wire.TStruct {
 				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
@@ -24306,9 +30846,9 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) FromWire(w wire.V
 				}
 
 			}
-		case 4:
+		case 5:
 			if field.Value.Type() == wire.TStruct {
-				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -24318,42 +30858,42 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) FromWire(w wire.V
 	}
 
 	count := 0
-	if v.Success != nil {
+	if v.BadRequestError != nil {
 		count++
 	}
-	if v.BadRequestError != nil {
+	if v.DomainNotActiveError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
 	if v.EntityNotExistError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistoryV2_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_PauseActivity_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct directly into bytes, without going
+// Encode serializes a AdminService_PauseActivity_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct could not be encoded.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_PauseActivity_Result struct could not be encoded.
+func (v *AdminService_PauseActivity_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Success != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.Success.Encode(sw); err != nil {
+		if err := v.BadRequestError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -24361,11 +30901,11 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Encode(sw stream.
 		}
 	}
 
-	if v.BadRequestError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+	if v.DomainNotActiveError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.BadRequestError.Encode(sw); err != nil {
+		if err := v.DomainNotActiveError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -24373,11 +30913,11 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Encode(sw stream.
 		}
 	}
 
-	if v.InternalServiceError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.InternalServiceError.Encode(sw); err != nil {
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -24386,7 +30926,7 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Encode(sw stream.
 	}
 
 	if v.EntityNotExistError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.EntityNotExistError.Encode(sw); err != nil {
@@ -24397,11 +30937,11 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Encode(sw stream.
 		}
 	}
 
-	if v.ServiceBusyError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.ServiceBusyError.Encode(sw); err != nil {
+		if err := v.WorkflowExecutionAlreadyCompletedError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -24410,41 +30950,47 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Encode(sw stream.
 	}
 
 	count := 0
-	if v.Success != nil {
+	if v.BadRequestError != nil {
 		count++
 	}
-	if v.BadRequestError != nil {
+	if v.DomainNotActiveError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
 	if v.EntityNotExistError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
 		count++
 	}
 
-	if count != 1 {
-		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistoryV2_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_PauseActivity_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _GetWorkflowExecutionRawHistoryV2Response_Decode(sr stream.Reader) (*GetWorkflowExecutionRawHistoryV2Response, error) {
-	var v GetWorkflowExecutionRawHistoryV2Response
+func _DomainNotActiveError_Decode(sr stream.Reader) (*shared.DomainNotActiveError, error) {
+	var v shared.DomainNotActiveError
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct directly from its Thrift-level
+func _WorkflowExecutionAlreadyCompletedError_Decode(sr stream.Reader) (*shared.WorkflowExecutionAlreadyCompletedError, error) {
+	var v shared.WorkflowExecutionAlreadyCompletedError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_PauseActivity_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_GetWorkflowExecutionRawHistoryV2_Result struct could not be generated from the wire
+// An error is returned if a AdminService_PauseActivity_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_PauseActivity_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -24457,12 +31003,6 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Decode(sr stream.
 
 	for ok {
 		switch {
-		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _GetWorkflowExecutionRawHistoryV2Response_Decode(sr)
-			if err != nil {
-				return err
-			}
-
 		case fh.ID == 1 && fh.Type == wire.TStruct:
 			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
@@ -24470,19 +31010,25 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Decode(sr stream.
 			}
 
 		case fh.ID == 2 && fh.Type == wire.TStruct:
-			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+			v.DomainNotActiveError, err = _DomainNotActiveError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 4 && fh.Type == wire.TStruct:
-			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -24507,84 +31053,84 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Decode(sr stream.
 	}
 
 	count := 0
-	if v.Success != nil {
+	if v.BadRequestError != nil {
 		count++
 	}
-	if v.BadRequestError != nil {
+	if v.DomainNotActiveError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
 	if v.EntityNotExistError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistoryV2_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_PauseActivity_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistoryV2_Result
+// String returns a readable string representation of a AdminService_PauseActivity_Result
 // struct.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) String() string {
+func (v *AdminService_PauseActivity_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [5]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.InternalServiceError != nil {
-		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 	if v.EntityNotExistError != nil {
 		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionAlreadyCompletedError: %v", v.WorkflowExecutionAlreadyCompletedError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistoryV2_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_PauseActivity_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistoryV2_Result match the
-// provided AdminService_GetWorkflowExecutionRawHistoryV2_Result.
+// Equals returns true if all the fields of this AdminService_PauseActivity_Result match the
+// provided AdminService_PauseActivity_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Equals(rhs *AdminService_GetWorkflowExecutionRawHistoryV2_Result) bool {
+func (v *AdminService_PauseActivity_Result) Equals(rhs *AdminService_PauseActivity_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
 		return false
 	}
-	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
 	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
-	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+	if !((v.WorkflowExecutionAlreadyCompletedError == nil && rhs.WorkflowExecutionAlreadyCompletedError == nil) || (v.WorkflowExecutionAlreadyCompletedError != nil && rhs.WorkflowExecutionAlreadyCompletedError != nil && v.WorkflowExecutionAlreadyCompletedError.Equals(rhs.WorkflowExecutionAlreadyCompletedError))) {
 		return false
 	}
 
@@ -24592,77 +31138,77 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) Equals(rhs *Admin
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetWorkflowExecutionRawHistoryV2_Result.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_PauseActivity_Result.
+func (v *AdminService_PauseActivity_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.InternalServiceError != nil {
-		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
 	if v.EntityNotExistError != nil {
 		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
 	}
-	if v.ServiceBusyError != nil {
-		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecutionAlreadyCompletedError", v.WorkflowExecutionAlreadyCompletedError))
 	}
 	return err
 }
 
-// GetSuccess returns the value of Success if it is set or its
+// GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetSuccess() (o *GetWorkflowExecutionRawHistoryV2Response) {
-	if v != nil && v.Success != nil {
-		return v.Success
+func (v *AdminService_PauseActivity_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
 	}
 
 	return
 }
 
-// IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_PauseActivity_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
 }
 
-// GetBadRequestError returns the value of BadRequestError if it is set or its
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetBadRequestError() (o *shared.BadRequestError) {
-	if v != nil && v.BadRequestError != nil {
-		return v.BadRequestError
+func (v *AdminService_PauseActivity_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
 	}
 
 	return
 }
 
-// IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetBadRequestError() bool {
-	return v != nil && v.BadRequestError != nil
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *AdminService_PauseActivity_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
 }
 
-// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
-	if v != nil && v.InternalServiceError != nil {
-		return v.InternalServiceError
+func (v *AdminService_PauseActivity_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
 	}
 
 	return
 }
 
-// IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetInternalServiceError() bool {
-	return v != nil && v.InternalServiceError != nil
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_PauseActivity_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
 }
 
 // GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+func (v *AdminService_PauseActivity_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
 	if v != nil && v.EntityNotExistError != nil {
 		return v.EntityNotExistError
 	}
@@ -24671,48 +31217,48 @@ func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetEntityNotExist
 }
 
 // IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetEntityNotExistError() bool {
+func (v *AdminService_PauseActivity_Result) IsSetEntityNotExistError() bool {
 	return v != nil && v.EntityNotExistError != nil
 }
 
-// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// GetWorkflowExecutionAlreadyCompletedError returns the value of WorkflowExecutionAlreadyCompletedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
-	if v != nil && v.ServiceBusyError != nil {
-		return v.ServiceBusyError
+func (v *AdminService_PauseActivity_Result) GetWorkflowExecutionAlreadyCompletedError() (o *shared.WorkflowExecutionAlreadyCompletedError) {
+	if v != nil && v.WorkflowExecutionAlreadyCompletedError != nil {
+		return v.WorkflowExecutionAlreadyCompletedError
 	}
 
 	return
 }
 
-// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) IsSetServiceBusyError() bool {
-	return v != nil && v.ServiceBusyError != nil
+// IsSetWorkflowExecutionAlreadyCompletedError returns true if WorkflowExecutionAlreadyCompletedError is not nil.
+func (v *AdminService_PauseActivity_Result) IsSetWorkflowExecutionAlreadyCompletedError() bool {
+	return v != nil && v.WorkflowExecutionAlreadyCompletedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "GetWorkflowExecutionRawHistoryV2" for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) MethodName() string {
-	return "GetWorkflowExecutionRawHistoryV2"
+// This will always be "PauseActivity" for this struct.
+func (v *AdminService_PauseActivity_Result) MethodName() string {
+	return "PauseActivity"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistoryV2_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_PauseActivity_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_ListDynamicConfig_Args represents the arguments for the AdminService.ListDynamicConfig function.
+// AdminService_PurgeDLQMessages_Args represents the arguments for the AdminService.PurgeDLQMessages function.
 //
-// The arguments for ListDynamicConfig are sent and received over the wire as this struct.
-type AdminService_ListDynamicConfig_Args struct {
-	Request *ListDynamicConfigRequest `json:"request,omitempty"`
+// The arguments for PurgeDLQMessages are sent and received over the wire as this struct.
+type AdminService_PurgeDLQMessages_Args struct {
+	Request *replicator.PurgeDLQMessagesRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_ListDynamicConfig_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_PurgeDLQMessages_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -24727,7 +31273,7 @@ type AdminService_ListDynamicConfig_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_ListDynamicConfig_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_PurgeDLQMessages_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -24747,17 +31293,17 @@ func (v *AdminService_ListDynamicConfig_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ListDynamicConfigRequest_Read(w wire.Value) (*ListDynamicConfigRequest, error) {
-	var v ListDynamicConfigRequest
+func _PurgeDLQMessagesRequest_Read(w wire.Value) (*replicator.PurgeDLQMessagesRequest, error) {
+	var v replicator.PurgeDLQMessagesRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_ListDynamicConfig_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_PurgeDLQMessages_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_ListDynamicConfig_Args struct
+// An error is returned if we were unable to build a AdminService_PurgeDLQMessages_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -24765,19 +31311,19 @@ func _ListDynamicConfigRequest_Read(w wire.Value) (*ListDynamicConfigRequest, er
 //	  return nil, err
 //	}
 //
-//	var v AdminService_ListDynamicConfig_Args
+//	var v AdminService_PurgeDLQMessages_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_ListDynamicConfig_Args) FromWire(w wire.Value) error {
+func (v *AdminService_PurgeDLQMessages_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _ListDynamicConfigRequest_Read(field.Value)
+				v.Request, err = _PurgeDLQMessagesRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -24789,11 +31335,11 @@ func (v *AdminService_ListDynamicConfig_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_ListDynamicConfig_Args struct directly into bytes, without going
+// Encode serializes a AdminService_PurgeDLQMessages_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_ListDynamicConfig_Args struct could not be encoded.
-func (v *AdminService_ListDynamicConfig_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_PurgeDLQMessages_Args struct could not be encoded.
+func (v *AdminService_PurgeDLQMessages_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -24813,18 +31359,18 @@ func (v *AdminService_ListDynamicConfig_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _ListDynamicConfigRequest_Decode(sr stream.Reader) (*ListDynamicConfigRequest, error) {
-	var v ListDynamicConfigRequest
+func _PurgeDLQMessagesRequest_Decode(sr stream.Reader) (*replicator.PurgeDLQMessagesRequest, error) {
+	var v replicator.PurgeDLQMessagesRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_ListDynamicConfig_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_PurgeDLQMessages_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_ListDynamicConfig_Args struct could not be generated from the wire
+// An error is returned if a AdminService_PurgeDLQMessages_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_ListDynamicConfig_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_PurgeDLQMessages_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -24838,7 +31384,7 @@ func (v *AdminService_ListDynamicConfig_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _ListDynamicConfigRequest_Decode(sr)
+			v.Request, err = _PurgeDLQMessagesRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -24865,9 +31411,9 @@ func (v *AdminService_ListDynamicConfig_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_ListDynamicConfig_Args
+// String returns a readable string representation of a AdminService_PurgeDLQMessages_Args
 // struct.
-func (v *AdminService_ListDynamicConfig_Args) String() string {
+func (v *AdminService_PurgeDLQMessages_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -24879,14 +31425,14 @@ func (v *AdminService_ListDynamicConfig_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_ListDynamicConfig_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_PurgeDLQMessages_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_ListDynamicConfig_Args match the
-// provided AdminService_ListDynamicConfig_Args.
+// Equals returns true if all the fields of this AdminService_PurgeDLQMessages_Args match the
+// provided AdminService_PurgeDLQMessages_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_ListDynamicConfig_Args) Equals(rhs *AdminService_ListDynamicConfig_Args) bool {
+func (v *AdminService_PurgeDLQMessages_Args) Equals(rhs *AdminService_PurgeDLQMessages_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -24900,8 +31446,8 @@ func (v *AdminService_ListDynamicConfig_Args) Equals(rhs *AdminService_ListDynam
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_ListDynamicConfig_Args.
-func (v *AdminService_ListDynamicConfig_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_PurgeDLQMessages_Args.
+func (v *AdminService_PurgeDLQMessages_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -24913,7 +31459,7 @@ func (v *AdminService_ListDynamicConfig_Args) MarshalLogObject(enc zapcore.Objec
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ListDynamicConfig_Args) GetRequest() (o *ListDynamicConfigRequest) {
+func (v *AdminService_PurgeDLQMessages_Args) GetRequest() (o *replicator.PurgeDLQMessagesRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -24922,132 +31468,158 @@ func (v *AdminService_ListDynamicConfig_Args) GetRequest() (o *ListDynamicConfig
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_ListDynamicConfig_Args) IsSetRequest() bool {
+func (v *AdminService_PurgeDLQMessages_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "ListDynamicConfig" for this struct.
-func (v *AdminService_ListDynamicConfig_Args) MethodName() string {
-	return "ListDynamicConfig"
+// This will always be "PurgeDLQMessages" for this struct.
+func (v *AdminService_PurgeDLQMessages_Args) MethodName() string {
+	return "PurgeDLQMessages"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_ListDynamicConfig_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_PurgeDLQMessages_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_ListDynamicConfig_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.ListDynamicConfig
+// AdminService_PurgeDLQMessages_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.PurgeDLQMessages
 // function.
-var AdminService_ListDynamicConfig_Helper = struct {
-	// Args accepts the parameters of ListDynamicConfig in-order and returns
+var AdminService_PurgeDLQMessages_Helper = struct {
+	// Args accepts the parameters of PurgeDLQMessages in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *ListDynamicConfigRequest,
-	) *AdminService_ListDynamicConfig_Args
+		request *replicator.PurgeDLQMessagesRequest,
+	) *AdminService_PurgeDLQMessages_Args
 
 	// IsException returns true if the given error can be thrown
-	// by ListDynamicConfig.
+	// by PurgeDLQMessages.
 	//
-	// An error can be thrown by ListDynamicConfig only if the
+	// An error can be thrown by PurgeDLQMessages only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for ListDynamicConfig
-	// given its return value and error.
+	// WrapResponse returns the result struct for PurgeDLQMessages
+	// given the error returned by it. The provided error may
+	// be nil if PurgeDLQMessages did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// ListDynamicConfig into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by ListDynamicConfig
+	// This allows mapping errors returned by PurgeDLQMessages into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// PurgeDLQMessages
 	//
-	//   value, err := ListDynamicConfig(args)
-	//   result, err := AdminService_ListDynamicConfig_Helper.WrapResponse(value, err)
+	//   err := PurgeDLQMessages(args)
+	//   result, err := AdminService_PurgeDLQMessages_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from ListDynamicConfig: %v", err)
+	//     return fmt.Errorf("unexpected error from PurgeDLQMessages: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*ListDynamicConfigResponse, error) (*AdminService_ListDynamicConfig_Result, error)
+	WrapResponse func(error) (*AdminService_PurgeDLQMessages_Result, error)
 
-	// UnwrapResponse takes the result struct for ListDynamicConfig
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for PurgeDLQMessages
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if ListDynamicConfig threw an
+	// The error is non-nil only if PurgeDLQMessages threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_ListDynamicConfig_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_ListDynamicConfig_Result) (*ListDynamicConfigResponse, error)
+	//   err := AdminService_PurgeDLQMessages_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_PurgeDLQMessages_Result) error
 }{}
 
 func init() {
-	AdminService_ListDynamicConfig_Helper.Args = func(
-		request *ListDynamicConfigRequest,
-	) *AdminService_ListDynamicConfig_Args {
-		return &AdminService_ListDynamicConfig_Args{
+	AdminService_PurgeDLQMessages_Helper.Args = func(
+		request *replicator.PurgeDLQMessagesRequest,
+	) *AdminService_PurgeDLQMessages_Args {
+		return &AdminService_PurgeDLQMessages_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_ListDynamicConfig_Helper.IsException = func(err error) bool {
+	AdminService_PurgeDLQMessages_Helper.IsException = func(err error) bool {
 		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_ListDynamicConfig_Helper.WrapResponse = func(success *ListDynamicConfigResponse, err error) (*AdminService_ListDynamicConfig_Result, error) {
+	AdminService_PurgeDLQMessages_Helper.WrapResponse = func(err error) (*AdminService_PurgeDLQMessages_Result, error) {
 		if err == nil {
-			return &AdminService_ListDynamicConfig_Result{Success: success}, nil
+			return &AdminService_PurgeDLQMessages_Result{}, nil
 		}
 
 		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PurgeDLQMessages_Result.BadRequestError")
+			}
+			return &AdminService_PurgeDLQMessages_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListDynamicConfig_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PurgeDLQMessages_Result.InternalServiceError")
 			}
-			return &AdminService_ListDynamicConfig_Result{InternalServiceError: e}, nil
+			return &AdminService_PurgeDLQMessages_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PurgeDLQMessages_Result.ServiceBusyError")
+			}
+			return &AdminService_PurgeDLQMessages_Result{ServiceBusyError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PurgeDLQMessages_Result.EntityNotExistError")
+			}
+			return &AdminService_PurgeDLQMessages_Result{EntityNotExistError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_ListDynamicConfig_Helper.UnwrapResponse = func(result *AdminService_ListDynamicConfig_Result) (success *ListDynamicConfigResponse, err error) {
+	AdminService_PurgeDLQMessages_Helper.UnwrapResponse = func(result *AdminService_PurgeDLQMessages_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
 		if result.InternalServiceError != nil {
 			err = result.InternalServiceError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
 			return
 		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_ListDynamicConfig_Result represents the result of a AdminService.ListDynamicConfig function call.
-//
-// The result of a ListDynamicConfig execution is sent and received over the wire as this struct.
+// AdminService_PurgeDLQMessages_Result represents the result of a AdminService.PurgeDLQMessages function call.
 //
-// Success is set only if the function did not throw an exception.
-type AdminService_ListDynamicConfig_Result struct {
-	// Value returned by ListDynamicConfig after a successful execution.
-	Success              *ListDynamicConfigResponse   `json:"success,omitempty"`
+// The result of a PurgeDLQMessages execution is sent and received over the wire as this struct.
+type AdminService_PurgeDLQMessages_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
 	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
 }
 
-// ToWire translates a AdminService_ListDynamicConfig_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_PurgeDLQMessages_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -25062,20 +31634,20 @@ type AdminService_ListDynamicConfig_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_ListDynamicConfig_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_PurgeDLQMessages_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 0, Value: w}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 	if v.InternalServiceError != nil {
@@ -25083,28 +31655,38 @@ func (v *AdminService_ListDynamicConfig_Result) ToWire() (wire.Value, error) {
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 1, Value: w}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_ListDynamicConfig_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_PurgeDLQMessages_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ListDynamicConfigResponse_Read(w wire.Value) (*ListDynamicConfigResponse, error) {
-	var v ListDynamicConfigResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_ListDynamicConfig_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_PurgeDLQMessages_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_ListDynamicConfig_Result struct
+// An error is returned if we were unable to build a AdminService_PurgeDLQMessages_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -25112,63 +31694,85 @@ func _ListDynamicConfigResponse_Read(w wire.Value) (*ListDynamicConfigResponse,
 //	  return nil, err
 //	}
 //
-//	var v AdminService_ListDynamicConfig_Result
+//	var v AdminService_PurgeDLQMessages_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_ListDynamicConfig_Result) FromWire(w wire.Value) error {
+func (v *AdminService_PurgeDLQMessages_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
+		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _ListDynamicConfigResponse_Read(field.Value)
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 1:
+		case 2:
 			if field.Value.Type() == wire.TStruct {
 				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
 
 	count := 0
-	if v.Success != nil {
+	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_ListDynamicConfig_Result should have exactly one field: got %v fields", count)
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_PurgeDLQMessages_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_ListDynamicConfig_Result struct directly into bytes, without going
+// Encode serializes a AdminService_PurgeDLQMessages_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_ListDynamicConfig_Result struct could not be encoded.
-func (v *AdminService_ListDynamicConfig_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_PurgeDLQMessages_Result struct could not be encoded.
+func (v *AdminService_PurgeDLQMessages_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Success != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.Success.Encode(sw); err != nil {
+		if err := v.BadRequestError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -25177,7 +31781,7 @@ func (v *AdminService_ListDynamicConfig_Result) Encode(sw stream.Writer) error {
 	}
 
 	if v.InternalServiceError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.InternalServiceError.Encode(sw); err != nil {
@@ -25188,33 +31792,57 @@ func (v *AdminService_ListDynamicConfig_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EntityNotExistError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	count := 0
-	if v.Success != nil {
+	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
 
-	if count != 1 {
-		return fmt.Errorf("AdminService_ListDynamicConfig_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_PurgeDLQMessages_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _ListDynamicConfigResponse_Decode(sr stream.Reader) (*ListDynamicConfigResponse, error) {
-	var v ListDynamicConfigResponse
-	err := v.Decode(sr)
-	return &v, err
-}
-
-// Decode deserializes a AdminService_ListDynamicConfig_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_PurgeDLQMessages_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_ListDynamicConfig_Result struct could not be generated from the wire
+// An error is returned if a AdminService_PurgeDLQMessages_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_ListDynamicConfig_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_PurgeDLQMessages_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -25227,18 +31855,30 @@ func (v *AdminService_ListDynamicConfig_Result) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _ListDynamicConfigResponse_Decode(sr)
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 1 && fh.Type == wire.TStruct:
+		case fh.ID == 2 && fh.Type == wire.TStruct:
 			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -25259,93 +31899,119 @@ func (v *AdminService_ListDynamicConfig_Result) Decode(sr stream.Reader) error {
 	}
 
 	count := 0
-	if v.Success != nil {
+	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_ListDynamicConfig_Result should have exactly one field: got %v fields", count)
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_PurgeDLQMessages_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_ListDynamicConfig_Result
+// String returns a readable string representation of a AdminService_PurgeDLQMessages_Result
 // struct.
-func (v *AdminService_ListDynamicConfig_Result) String() string {
+func (v *AdminService_PurgeDLQMessages_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [4]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
 	if v.InternalServiceError != nil {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
 
-	return fmt.Sprintf("AdminService_ListDynamicConfig_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_PurgeDLQMessages_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_ListDynamicConfig_Result match the
-// provided AdminService_ListDynamicConfig_Result.
+// Equals returns true if all the fields of this AdminService_PurgeDLQMessages_Result match the
+// provided AdminService_PurgeDLQMessages_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_ListDynamicConfig_Result) Equals(rhs *AdminService_ListDynamicConfig_Result) bool {
+func (v *AdminService_PurgeDLQMessages_Result) Equals(rhs *AdminService_PurgeDLQMessages_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_ListDynamicConfig_Result.
-func (v *AdminService_ListDynamicConfig_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_PurgeDLQMessages_Result.
+func (v *AdminService_PurgeDLQMessages_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
 	return err
 }
 
-// GetSuccess returns the value of Success if it is set or its
+// GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ListDynamicConfig_Result) GetSuccess() (o *ListDynamicConfigResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
+func (v *AdminService_PurgeDLQMessages_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
 	}
 
 	return
 }
 
-// IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_ListDynamicConfig_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_PurgeDLQMessages_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ListDynamicConfig_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_PurgeDLQMessages_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -25354,33 +32020,63 @@ func (v *AdminService_ListDynamicConfig_Result) GetInternalServiceError() (o *sh
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_ListDynamicConfig_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_PurgeDLQMessages_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_PurgeDLQMessages_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_PurgeDLQMessages_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_PurgeDLQMessages_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_PurgeDLQMessages_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "ListDynamicConfig" for this struct.
-func (v *AdminService_ListDynamicConfig_Result) MethodName() string {
-	return "ListDynamicConfig"
+// This will always be "PurgeDLQMessages" for this struct.
+func (v *AdminService_PurgeDLQMessages_Result) MethodName() string {
+	return "PurgeDLQMessages"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_ListDynamicConfig_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_PurgeDLQMessages_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_MaintainCorruptWorkflow_Args represents the arguments for the AdminService.MaintainCorruptWorkflow function.
+// AdminService_ReadDLQMessages_Args represents the arguments for the AdminService.ReadDLQMessages function.
 //
-// The arguments for MaintainCorruptWorkflow are sent and received over the wire as this struct.
-type AdminService_MaintainCorruptWorkflow_Args struct {
-	Request *AdminMaintainWorkflowRequest `json:"request,omitempty"`
+// The arguments for ReadDLQMessages are sent and received over the wire as this struct.
+type AdminService_ReadDLQMessages_Args struct {
+	Request *replicator.ReadDLQMessagesRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_MaintainCorruptWorkflow_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ReadDLQMessages_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -25395,7 +32091,7 @@ type AdminService_MaintainCorruptWorkflow_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_MaintainCorruptWorkflow_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_ReadDLQMessages_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -25415,17 +32111,17 @@ func (v *AdminService_MaintainCorruptWorkflow_Args) ToWire() (wire.Value, error)
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AdminMaintainWorkflowRequest_Read(w wire.Value) (*AdminMaintainWorkflowRequest, error) {
-	var v AdminMaintainWorkflowRequest
+func _ReadDLQMessagesRequest_Read(w wire.Value) (*replicator.ReadDLQMessagesRequest, error) {
+	var v replicator.ReadDLQMessagesRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_MaintainCorruptWorkflow_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_ReadDLQMessages_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_MaintainCorruptWorkflow_Args struct
+// An error is returned if we were unable to build a AdminService_ReadDLQMessages_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -25433,19 +32129,19 @@ func _AdminMaintainWorkflowRequest_Read(w wire.Value) (*AdminMaintainWorkflowReq
 //	  return nil, err
 //	}
 //
-//	var v AdminService_MaintainCorruptWorkflow_Args
+//	var v AdminService_ReadDLQMessages_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_MaintainCorruptWorkflow_Args) FromWire(w wire.Value) error {
+func (v *AdminService_ReadDLQMessages_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _AdminMaintainWorkflowRequest_Read(field.Value)
+				v.Request, err = _ReadDLQMessagesRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -25457,11 +32153,11 @@ func (v *AdminService_MaintainCorruptWorkflow_Args) FromWire(w wire.Value) error
 	return nil
 }
 
-// Encode serializes a AdminService_MaintainCorruptWorkflow_Args struct directly into bytes, without going
+// Encode serializes a AdminService_ReadDLQMessages_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_MaintainCorruptWorkflow_Args struct could not be encoded.
-func (v *AdminService_MaintainCorruptWorkflow_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_ReadDLQMessages_Args struct could not be encoded.
+func (v *AdminService_ReadDLQMessages_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -25481,18 +32177,18 @@ func (v *AdminService_MaintainCorruptWorkflow_Args) Encode(sw stream.Writer) err
 	return sw.WriteStructEnd()
 }
 
-func _AdminMaintainWorkflowRequest_Decode(sr stream.Reader) (*AdminMaintainWorkflowRequest, error) {
-	var v AdminMaintainWorkflowRequest
+func _ReadDLQMessagesRequest_Decode(sr stream.Reader) (*replicator.ReadDLQMessagesRequest, error) {
+	var v replicator.ReadDLQMessagesRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_MaintainCorruptWorkflow_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_ReadDLQMessages_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_MaintainCorruptWorkflow_Args struct could not be generated from the wire
+// An error is returned if a AdminService_ReadDLQMessages_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_MaintainCorruptWorkflow_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_ReadDLQMessages_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -25506,7 +32202,7 @@ func (v *AdminService_MaintainCorruptWorkflow_Args) Decode(sr stream.Reader) err
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _AdminMaintainWorkflowRequest_Decode(sr)
+			v.Request, err = _ReadDLQMessagesRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -25533,9 +32229,9 @@ func (v *AdminService_MaintainCorruptWorkflow_Args) Decode(sr stream.Reader) err
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_MaintainCorruptWorkflow_Args
+// String returns a readable string representation of a AdminService_ReadDLQMessages_Args
 // struct.
-func (v *AdminService_MaintainCorruptWorkflow_Args) String() string {
+func (v *AdminService_ReadDLQMessages_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -25547,14 +32243,14 @@ func (v *AdminService_MaintainCorruptWorkflow_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_MaintainCorruptWorkflow_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ReadDLQMessages_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_MaintainCorruptWorkflow_Args match the
-// provided AdminService_MaintainCorruptWorkflow_Args.
+// Equals returns true if all the fields of this AdminService_ReadDLQMessages_Args match the
+// provided AdminService_ReadDLQMessages_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_MaintainCorruptWorkflow_Args) Equals(rhs *AdminService_MaintainCorruptWorkflow_Args) bool {
+func (v *AdminService_ReadDLQMessages_Args) Equals(rhs *AdminService_ReadDLQMessages_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -25568,8 +32264,8 @@ func (v *AdminService_MaintainCorruptWorkflow_Args) Equals(rhs *AdminService_Mai
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_MaintainCorruptWorkflow_Args.
-func (v *AdminService_MaintainCorruptWorkflow_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ReadDLQMessages_Args.
+func (v *AdminService_ReadDLQMessages_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -25581,7 +32277,7 @@ func (v *AdminService_MaintainCorruptWorkflow_Args) MarshalLogObject(enc zapcore
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_MaintainCorruptWorkflow_Args) GetRequest() (o *AdminMaintainWorkflowRequest) {
+func (v *AdminService_ReadDLQMessages_Args) GetRequest() (o *replicator.ReadDLQMessagesRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -25590,130 +32286,141 @@ func (v *AdminService_MaintainCorruptWorkflow_Args) GetRequest() (o *AdminMainta
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_MaintainCorruptWorkflow_Args) IsSetRequest() bool {
+func (v *AdminService_ReadDLQMessages_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "MaintainCorruptWorkflow" for this struct.
-func (v *AdminService_MaintainCorruptWorkflow_Args) MethodName() string {
-	return "MaintainCorruptWorkflow"
+// This will always be "ReadDLQMessages" for this struct.
+func (v *AdminService_ReadDLQMessages_Args) MethodName() string {
+	return "ReadDLQMessages"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_MaintainCorruptWorkflow_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ReadDLQMessages_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_MaintainCorruptWorkflow_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.MaintainCorruptWorkflow
+// AdminService_ReadDLQMessages_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ReadDLQMessages
 // function.
-var AdminService_MaintainCorruptWorkflow_Helper = struct {
-	// Args accepts the parameters of MaintainCorruptWorkflow in-order and returns
+var AdminService_ReadDLQMessages_Helper = struct {
+	// Args accepts the parameters of ReadDLQMessages in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *AdminMaintainWorkflowRequest,
-	) *AdminService_MaintainCorruptWorkflow_Args
+		request *replicator.ReadDLQMessagesRequest,
+	) *AdminService_ReadDLQMessages_Args
 
 	// IsException returns true if the given error can be thrown
-	// by MaintainCorruptWorkflow.
+	// by ReadDLQMessages.
 	//
-	// An error can be thrown by MaintainCorruptWorkflow only if the
+	// An error can be thrown by ReadDLQMessages only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for MaintainCorruptWorkflow
+	// WrapResponse returns the result struct for ReadDLQMessages
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// MaintainCorruptWorkflow into a serializable result struct.
+	// ReadDLQMessages into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by MaintainCorruptWorkflow
+	// error cannot be thrown by ReadDLQMessages
 	//
-	//   value, err := MaintainCorruptWorkflow(args)
-	//   result, err := AdminService_MaintainCorruptWorkflow_Helper.WrapResponse(value, err)
+	//   value, err := ReadDLQMessages(args)
+	//   result, err := AdminService_ReadDLQMessages_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from MaintainCorruptWorkflow: %v", err)
+	//     return fmt.Errorf("unexpected error from ReadDLQMessages: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*AdminMaintainWorkflowResponse, error) (*AdminService_MaintainCorruptWorkflow_Result, error)
+	WrapResponse func(*replicator.ReadDLQMessagesResponse, error) (*AdminService_ReadDLQMessages_Result, error)
 
-	// UnwrapResponse takes the result struct for MaintainCorruptWorkflow
+	// UnwrapResponse takes the result struct for ReadDLQMessages
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if MaintainCorruptWorkflow threw an
+	// The error is non-nil only if ReadDLQMessages threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_MaintainCorruptWorkflow_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_MaintainCorruptWorkflow_Result) (*AdminMaintainWorkflowResponse, error)
+	//   value, err := AdminService_ReadDLQMessages_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ReadDLQMessages_Result) (*replicator.ReadDLQMessagesResponse, error)
 }{}
 
 func init() {
-	AdminService_MaintainCorruptWorkflow_Helper.Args = func(
-		request *AdminMaintainWorkflowRequest,
-	) *AdminService_MaintainCorruptWorkflow_Args {
-		return &AdminService_MaintainCorruptWorkflow_Args{
+	AdminService_ReadDLQMessages_Helper.Args = func(
+		request *replicator.ReadDLQMessagesRequest,
+	) *AdminService_ReadDLQMessages_Args {
+		return &AdminService_ReadDLQMessages_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_MaintainCorruptWorkflow_Helper.IsException = func(err error) bool {
+	AdminService_ReadDLQMessages_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
-		case *shared.EntityNotExistsError:
-			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_MaintainCorruptWorkflow_Helper.WrapResponse = func(success *AdminMaintainWorkflowResponse, err error) (*AdminService_MaintainCorruptWorkflow_Result, error) {
+	AdminService_ReadDLQMessages_Helper.WrapResponse = func(success *replicator.ReadDLQMessagesResponse, err error) (*AdminService_ReadDLQMessages_Result, error) {
 		if err == nil {
-			return &AdminService_MaintainCorruptWorkflow_Result{Success: success}, nil
+			return &AdminService_ReadDLQMessages_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MaintainCorruptWorkflow_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReadDLQMessages_Result.BadRequestError")
 			}
-			return &AdminService_MaintainCorruptWorkflow_Result{BadRequestError: e}, nil
-		case *shared.EntityNotExistsError:
+			return &AdminService_ReadDLQMessages_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MaintainCorruptWorkflow_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReadDLQMessages_Result.InternalServiceError")
 			}
-			return &AdminService_MaintainCorruptWorkflow_Result{EntityNotExistError: e}, nil
-		case *shared.InternalServiceError:
+			return &AdminService_ReadDLQMessages_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MaintainCorruptWorkflow_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReadDLQMessages_Result.ServiceBusyError")
 			}
-			return &AdminService_MaintainCorruptWorkflow_Result{InternalServiceError: e}, nil
+			return &AdminService_ReadDLQMessages_Result{ServiceBusyError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReadDLQMessages_Result.EntityNotExistError")
+			}
+			return &AdminService_ReadDLQMessages_Result{EntityNotExistError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_MaintainCorruptWorkflow_Helper.UnwrapResponse = func(result *AdminService_MaintainCorruptWorkflow_Result) (success *AdminMaintainWorkflowResponse, err error) {
+	AdminService_ReadDLQMessages_Helper.UnwrapResponse = func(result *AdminService_ReadDLQMessages_Result) (success *replicator.ReadDLQMessagesResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
-			return
-		}
 		if result.InternalServiceError != nil {
 			err = result.InternalServiceError
 			return
 		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -25726,20 +32433,21 @@ func init() {
 
 }
 
-// AdminService_MaintainCorruptWorkflow_Result represents the result of a AdminService.MaintainCorruptWorkflow function call.
+// AdminService_ReadDLQMessages_Result represents the result of a AdminService.ReadDLQMessages function call.
 //
-// The result of a MaintainCorruptWorkflow execution is sent and received over the wire as this struct.
+// The result of a ReadDLQMessages execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type AdminService_MaintainCorruptWorkflow_Result struct {
-	// Value returned by MaintainCorruptWorkflow after a successful execution.
-	Success              *AdminMaintainWorkflowResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError        `json:"badRequestError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError   `json:"entityNotExistError,omitempty"`
-	InternalServiceError *shared.InternalServiceError   `json:"internalServiceError,omitempty"`
+type AdminService_ReadDLQMessages_Result struct {
+	// Value returned by ReadDLQMessages after a successful execution.
+	Success              *replicator.ReadDLQMessagesResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError            `json:"serviceBusyError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError        `json:"entityNotExistError,omitempty"`
 }
 
-// ToWire translates a AdminService_MaintainCorruptWorkflow_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ReadDLQMessages_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -25754,9 +32462,9 @@ type AdminService_MaintainCorruptWorkflow_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_MaintainCorruptWorkflow_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_ReadDLQMessages_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -25778,41 +32486,49 @@ func (v *AdminService_MaintainCorruptWorkflow_Result) ToWire() (wire.Value, erro
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.InternalServiceError != nil {
-		w, err = v.InternalServiceError.ToWire()
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_MaintainCorruptWorkflow_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_ReadDLQMessages_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AdminMaintainWorkflowResponse_Read(w wire.Value) (*AdminMaintainWorkflowResponse, error) {
-	var v AdminMaintainWorkflowResponse
+func _ReadDLQMessagesResponse_Read(w wire.Value) (*replicator.ReadDLQMessagesResponse, error) {
+	var v replicator.ReadDLQMessagesResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_MaintainCorruptWorkflow_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_ReadDLQMessages_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_MaintainCorruptWorkflow_Result struct
+// An error is returned if we were unable to build a AdminService_ReadDLQMessages_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -25820,19 +32536,19 @@ func _AdminMaintainWorkflowResponse_Read(w wire.Value) (*AdminMaintainWorkflowRe
 //	  return nil, err
 //	}
 //
-//	var v AdminService_MaintainCorruptWorkflow_Result
+//	var v AdminService_ReadDLQMessages_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_MaintainCorruptWorkflow_Result) FromWire(w wire.Value) error {
+func (v *AdminService_ReadDLQMessages_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _AdminMaintainWorkflowResponse_Read(field.Value)
+				v.Success, err = _ReadDLQMessagesResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -25848,7 +32564,7 @@ func (v *AdminService_MaintainCorruptWorkflow_Result) FromWire(w wire.Value) err
 			}
 		case 2:
 			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -25856,7 +32572,15 @@ func (v *AdminService_MaintainCorruptWorkflow_Result) FromWire(w wire.Value) err
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -25872,24 +32596,27 @@ func (v *AdminService_MaintainCorruptWorkflow_Result) FromWire(w wire.Value) err
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_MaintainCorruptWorkflow_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ReadDLQMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_MaintainCorruptWorkflow_Result struct directly into bytes, without going
+// Encode serializes a AdminService_ReadDLQMessages_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_MaintainCorruptWorkflow_Result struct could not be encoded.
-func (v *AdminService_MaintainCorruptWorkflow_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_ReadDLQMessages_Result struct could not be encoded.
+func (v *AdminService_ReadDLQMessages_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -25918,11 +32645,11 @@ func (v *AdminService_MaintainCorruptWorkflow_Result) Encode(sw stream.Writer) e
 		}
 	}
 
-	if v.EntityNotExistError != nil {
+	if v.InternalServiceError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.EntityNotExistError.Encode(sw); err != nil {
+		if err := v.InternalServiceError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -25930,11 +32657,23 @@ func (v *AdminService_MaintainCorruptWorkflow_Result) Encode(sw stream.Writer) e
 		}
 	}
 
-	if v.InternalServiceError != nil {
+	if v.ServiceBusyError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.InternalServiceError.Encode(sw); err != nil {
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EntityNotExistError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -25949,32 +32688,35 @@ func (v *AdminService_MaintainCorruptWorkflow_Result) Encode(sw stream.Writer) e
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
 		count++
 	}
 
 	if count != 1 {
-		return fmt.Errorf("AdminService_MaintainCorruptWorkflow_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ReadDLQMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _AdminMaintainWorkflowResponse_Decode(sr stream.Reader) (*AdminMaintainWorkflowResponse, error) {
-	var v AdminMaintainWorkflowResponse
+func _ReadDLQMessagesResponse_Decode(sr stream.Reader) (*replicator.ReadDLQMessagesResponse, error) {
+	var v replicator.ReadDLQMessagesResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_MaintainCorruptWorkflow_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_ReadDLQMessages_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_MaintainCorruptWorkflow_Result struct could not be generated from the wire
+// An error is returned if a AdminService_ReadDLQMessages_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_MaintainCorruptWorkflow_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_ReadDLQMessages_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -25988,7 +32730,7 @@ func (v *AdminService_MaintainCorruptWorkflow_Result) Decode(sr stream.Reader) e
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _AdminMaintainWorkflowResponse_Decode(sr)
+			v.Success, err = _ReadDLQMessagesResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -26000,13 +32742,19 @@ func (v *AdminService_MaintainCorruptWorkflow_Result) Decode(sr stream.Reader) e
 			}
 
 		case fh.ID == 2 && fh.Type == wire.TStruct:
-			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -26037,27 +32785,30 @@ func (v *AdminService_MaintainCorruptWorkflow_Result) Decode(sr stream.Reader) e
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_MaintainCorruptWorkflow_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ReadDLQMessages_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_MaintainCorruptWorkflow_Result
+// String returns a readable string representation of a AdminService_ReadDLQMessages_Result
 // struct.
-func (v *AdminService_MaintainCorruptWorkflow_Result) String() string {
+func (v *AdminService_ReadDLQMessages_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -26067,23 +32818,27 @@ func (v *AdminService_MaintainCorruptWorkflow_Result) String() string {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
-		i++
-	}
 	if v.InternalServiceError != nil {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
 
-	return fmt.Sprintf("AdminService_MaintainCorruptWorkflow_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ReadDLQMessages_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_MaintainCorruptWorkflow_Result match the
-// provided AdminService_MaintainCorruptWorkflow_Result.
+// Equals returns true if all the fields of this AdminService_ReadDLQMessages_Result match the
+// provided AdminService_ReadDLQMessages_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_MaintainCorruptWorkflow_Result) Equals(rhs *AdminService_MaintainCorruptWorkflow_Result) bool {
+func (v *AdminService_ReadDLQMessages_Result) Equals(rhs *AdminService_ReadDLQMessages_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -26095,10 +32850,13 @@ func (v *AdminService_MaintainCorruptWorkflow_Result) Equals(rhs *AdminService_M
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
 
@@ -26106,8 +32864,8 @@ func (v *AdminService_MaintainCorruptWorkflow_Result) Equals(rhs *AdminService_M
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_MaintainCorruptWorkflow_Result.
-func (v *AdminService_MaintainCorruptWorkflow_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ReadDLQMessages_Result.
+func (v *AdminService_ReadDLQMessages_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -26117,18 +32875,21 @@ func (v *AdminService_MaintainCorruptWorkflow_Result) MarshalLogObject(enc zapco
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.EntityNotExistError != nil {
-		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
-	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
 	return err
 }
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_MaintainCorruptWorkflow_Result) GetSuccess() (o *AdminMaintainWorkflowResponse) {
+func (v *AdminService_ReadDLQMessages_Result) GetSuccess() (o *replicator.ReadDLQMessagesResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -26137,13 +32898,13 @@ func (v *AdminService_MaintainCorruptWorkflow_Result) GetSuccess() (o *AdminMain
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_MaintainCorruptWorkflow_Result) IsSetSuccess() bool {
+func (v *AdminService_ReadDLQMessages_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_MaintainCorruptWorkflow_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_ReadDLQMessages_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -26152,63 +32913,78 @@ func (v *AdminService_MaintainCorruptWorkflow_Result) GetBadRequestError() (o *s
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_MaintainCorruptWorkflow_Result) IsSetBadRequestError() bool {
+func (v *AdminService_ReadDLQMessages_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_MaintainCorruptWorkflow_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
+func (v *AdminService_ReadDLQMessages_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
 	}
 
 	return
 }
 
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_MaintainCorruptWorkflow_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_ReadDLQMessages_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
 }
 
-// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_MaintainCorruptWorkflow_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
-	if v != nil && v.InternalServiceError != nil {
-		return v.InternalServiceError
+func (v *AdminService_ReadDLQMessages_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
 	}
 
 	return
 }
 
-// IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_MaintainCorruptWorkflow_Result) IsSetInternalServiceError() bool {
-	return v != nil && v.InternalServiceError != nil
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_ReadDLQMessages_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ReadDLQMessages_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_ReadDLQMessages_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "MaintainCorruptWorkflow" for this struct.
-func (v *AdminService_MaintainCorruptWorkflow_Result) MethodName() string {
-	return "MaintainCorruptWorkflow"
+// This will always be "ReadDLQMessages" for this struct.
+func (v *AdminService_ReadDLQMessages_Result) MethodName() string {
+	return "ReadDLQMessages"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_MaintainCorruptWorkflow_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ReadDLQMessages_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_MergeDLQMessages_Args represents the arguments for the AdminService.MergeDLQMessages function.
+// AdminService_ReapplyEvents_Args represents the arguments for the AdminService.ReapplyEvents function.
 //
-// The arguments for MergeDLQMessages are sent and received over the wire as this struct.
-type AdminService_MergeDLQMessages_Args struct {
-	Request *replicator.MergeDLQMessagesRequest `json:"request,omitempty"`
+// The arguments for ReapplyEvents are sent and received over the wire as this struct.
+type AdminService_ReapplyEvents_Args struct {
+	ReapplyEventsRequest *shared.ReapplyEventsRequest `json:"reapplyEventsRequest,omitempty"`
 }
 
-// ToWire translates a AdminService_MergeDLQMessages_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ReapplyEvents_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -26223,7 +32999,7 @@ type AdminService_MergeDLQMessages_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_MergeDLQMessages_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_ReapplyEvents_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -26231,8 +33007,8 @@ func (v *AdminService_MergeDLQMessages_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.Request != nil {
-		w, err = v.Request.ToWire()
+	if v.ReapplyEventsRequest != nil {
+		w, err = v.ReapplyEventsRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -26243,17 +33019,17 @@ func (v *AdminService_MergeDLQMessages_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _MergeDLQMessagesRequest_Read(w wire.Value) (*replicator.MergeDLQMessagesRequest, error) {
-	var v replicator.MergeDLQMessagesRequest
+func _ReapplyEventsRequest_Read(w wire.Value) (*shared.ReapplyEventsRequest, error) {
+	var v shared.ReapplyEventsRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_MergeDLQMessages_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_ReapplyEvents_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_MergeDLQMessages_Args struct
+// An error is returned if we were unable to build a AdminService_ReapplyEvents_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -26261,19 +33037,19 @@ func _MergeDLQMessagesRequest_Read(w wire.Value) (*replicator.MergeDLQMessagesRe
 //	  return nil, err
 //	}
 //
-//	var v AdminService_MergeDLQMessages_Args
+//	var v AdminService_ReapplyEvents_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_MergeDLQMessages_Args) FromWire(w wire.Value) error {
+func (v *AdminService_ReapplyEvents_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _MergeDLQMessagesRequest_Read(field.Value)
+				v.ReapplyEventsRequest, err = _ReapplyEventsRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -26285,20 +33061,20 @@ func (v *AdminService_MergeDLQMessages_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_MergeDLQMessages_Args struct directly into bytes, without going
+// Encode serializes a AdminService_ReapplyEvents_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_MergeDLQMessages_Args struct could not be encoded.
-func (v *AdminService_MergeDLQMessages_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_ReapplyEvents_Args struct could not be encoded.
+func (v *AdminService_ReapplyEvents_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Request != nil {
+	if v.ReapplyEventsRequest != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.Request.Encode(sw); err != nil {
+		if err := v.ReapplyEventsRequest.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -26309,18 +33085,18 @@ func (v *AdminService_MergeDLQMessages_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _MergeDLQMessagesRequest_Decode(sr stream.Reader) (*replicator.MergeDLQMessagesRequest, error) {
-	var v replicator.MergeDLQMessagesRequest
+func _ReapplyEventsRequest_Decode(sr stream.Reader) (*shared.ReapplyEventsRequest, error) {
+	var v shared.ReapplyEventsRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_MergeDLQMessages_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_ReapplyEvents_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_MergeDLQMessages_Args struct could not be generated from the wire
+// An error is returned if a AdminService_ReapplyEvents_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_MergeDLQMessages_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_ReapplyEvents_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -26334,7 +33110,7 @@ func (v *AdminService_MergeDLQMessages_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _MergeDLQMessagesRequest_Decode(sr)
+			v.ReapplyEventsRequest, err = _ReapplyEventsRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -26361,34 +33137,34 @@ func (v *AdminService_MergeDLQMessages_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_MergeDLQMessages_Args
+// String returns a readable string representation of a AdminService_ReapplyEvents_Args
 // struct.
-func (v *AdminService_MergeDLQMessages_Args) String() string {
+func (v *AdminService_ReapplyEvents_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.Request != nil {
-		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+	if v.ReapplyEventsRequest != nil {
+		fields[i] = fmt.Sprintf("ReapplyEventsRequest: %v", v.ReapplyEventsRequest)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_MergeDLQMessages_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ReapplyEvents_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_MergeDLQMessages_Args match the
-// provided AdminService_MergeDLQMessages_Args.
+// Equals returns true if all the fields of this AdminService_ReapplyEvents_Args match the
+// provided AdminService_ReapplyEvents_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_MergeDLQMessages_Args) Equals(rhs *AdminService_MergeDLQMessages_Args) bool {
+func (v *AdminService_ReapplyEvents_Args) Equals(rhs *AdminService_ReapplyEvents_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+	if !((v.ReapplyEventsRequest == nil && rhs.ReapplyEventsRequest == nil) || (v.ReapplyEventsRequest != nil && rhs.ReapplyEventsRequest != nil && v.ReapplyEventsRequest.Equals(rhs.ReapplyEventsRequest))) {
 		return false
 	}
 
@@ -26396,106 +33172,109 @@ func (v *AdminService_MergeDLQMessages_Args) Equals(rhs *AdminService_MergeDLQMe
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_MergeDLQMessages_Args.
-func (v *AdminService_MergeDLQMessages_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ReapplyEvents_Args.
+func (v *AdminService_ReapplyEvents_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Request != nil {
-		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	if v.ReapplyEventsRequest != nil {
+		err = multierr.Append(err, enc.AddObject("reapplyEventsRequest", v.ReapplyEventsRequest))
 	}
 	return err
 }
 
-// GetRequest returns the value of Request if it is set or its
+// GetReapplyEventsRequest returns the value of ReapplyEventsRequest if it is set or its
 // zero value if it is unset.
-func (v *AdminService_MergeDLQMessages_Args) GetRequest() (o *replicator.MergeDLQMessagesRequest) {
-	if v != nil && v.Request != nil {
-		return v.Request
+func (v *AdminService_ReapplyEvents_Args) GetReapplyEventsRequest() (o *shared.ReapplyEventsRequest) {
+	if v != nil && v.ReapplyEventsRequest != nil {
+		return v.ReapplyEventsRequest
 	}
 
 	return
 }
 
-// IsSetRequest returns true if Request is not nil.
-func (v *AdminService_MergeDLQMessages_Args) IsSetRequest() bool {
-	return v != nil && v.Request != nil
+// IsSetReapplyEventsRequest returns true if ReapplyEventsRequest is not nil.
+func (v *AdminService_ReapplyEvents_Args) IsSetReapplyEventsRequest() bool {
+	return v != nil && v.ReapplyEventsRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "MergeDLQMessages" for this struct.
-func (v *AdminService_MergeDLQMessages_Args) MethodName() string {
-	return "MergeDLQMessages"
+// This will always be "ReapplyEvents" for this struct.
+func (v *AdminService_ReapplyEvents_Args) MethodName() string {
+	return "ReapplyEvents"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_MergeDLQMessages_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ReapplyEvents_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_MergeDLQMessages_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.MergeDLQMessages
+// AdminService_ReapplyEvents_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ReapplyEvents
 // function.
-var AdminService_MergeDLQMessages_Helper = struct {
-	// Args accepts the parameters of MergeDLQMessages in-order and returns
+var AdminService_ReapplyEvents_Helper = struct {
+	// Args accepts the parameters of ReapplyEvents in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *replicator.MergeDLQMessagesRequest,
-	) *AdminService_MergeDLQMessages_Args
+		reapplyEventsRequest *shared.ReapplyEventsRequest,
+	) *AdminService_ReapplyEvents_Args
 
 	// IsException returns true if the given error can be thrown
-	// by MergeDLQMessages.
+	// by ReapplyEvents.
 	//
-	// An error can be thrown by MergeDLQMessages only if the
+	// An error can be thrown by ReapplyEvents only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for MergeDLQMessages
-	// given its return value and error.
+	// WrapResponse returns the result struct for ReapplyEvents
+	// given the error returned by it. The provided error may
+	// be nil if ReapplyEvents did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// MergeDLQMessages into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by MergeDLQMessages
+	// This allows mapping errors returned by ReapplyEvents into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// ReapplyEvents
 	//
-	//   value, err := MergeDLQMessages(args)
-	//   result, err := AdminService_MergeDLQMessages_Helper.WrapResponse(value, err)
+	//   err := ReapplyEvents(args)
+	//   result, err := AdminService_ReapplyEvents_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from MergeDLQMessages: %v", err)
+	//     return fmt.Errorf("unexpected error from ReapplyEvents: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*replicator.MergeDLQMessagesResponse, error) (*AdminService_MergeDLQMessages_Result, error)
+	WrapResponse func(error) (*AdminService_ReapplyEvents_Result, error)
 
-	// UnwrapResponse takes the result struct for MergeDLQMessages
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for ReapplyEvents
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if MergeDLQMessages threw an
+	// The error is non-nil only if ReapplyEvents threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_MergeDLQMessages_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_MergeDLQMessages_Result) (*replicator.MergeDLQMessagesResponse, error)
+	//   err := AdminService_ReapplyEvents_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ReapplyEvents_Result) error
 }{}
 
 func init() {
-	AdminService_MergeDLQMessages_Helper.Args = func(
-		request *replicator.MergeDLQMessagesRequest,
-	) *AdminService_MergeDLQMessages_Args {
-		return &AdminService_MergeDLQMessages_Args{
-			Request: request,
+	AdminService_ReapplyEvents_Helper.Args = func(
+		reapplyEventsRequest *shared.ReapplyEventsRequest,
+	) *AdminService_ReapplyEvents_Args {
+		return &AdminService_ReapplyEvents_Args{
+			ReapplyEventsRequest: reapplyEventsRequest,
 		}
 	}
 
-	AdminService_MergeDLQMessages_Helper.IsException = func(err error) bool {
+	AdminService_ReapplyEvents_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
-		case *shared.InternalServiceError:
+		case *shared.DomainNotActiveError:
+			return true
+		case *shared.LimitExceededError:
 			return true
 		case *shared.ServiceBusyError:
 			return true
@@ -26506,43 +33285,52 @@ func init() {
 		}
 	}
 
-	AdminService_MergeDLQMessages_Helper.WrapResponse = func(success *replicator.MergeDLQMessagesResponse, err error) (*AdminService_MergeDLQMessages_Result, error) {
+	AdminService_ReapplyEvents_Helper.WrapResponse = func(err error) (*AdminService_ReapplyEvents_Result, error) {
 		if err == nil {
-			return &AdminService_MergeDLQMessages_Result{Success: success}, nil
+			return &AdminService_ReapplyEvents_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MergeDLQMessages_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReapplyEvents_Result.BadRequestError")
 			}
-			return &AdminService_MergeDLQMessages_Result{BadRequestError: e}, nil
-		case *shared.InternalServiceError:
+			return &AdminService_ReapplyEvents_Result{BadRequestError: e}, nil
+		case *shared.DomainNotActiveError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MergeDLQMessages_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReapplyEvents_Result.DomainNotActiveError")
 			}
-			return &AdminService_MergeDLQMessages_Result{InternalServiceError: e}, nil
+			return &AdminService_ReapplyEvents_Result{DomainNotActiveError: e}, nil
+		case *shared.LimitExceededError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReapplyEvents_Result.LimitExceededError")
+			}
+			return &AdminService_ReapplyEvents_Result{LimitExceededError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MergeDLQMessages_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReapplyEvents_Result.ServiceBusyError")
 			}
-			return &AdminService_MergeDLQMessages_Result{ServiceBusyError: e}, nil
+			return &AdminService_ReapplyEvents_Result{ServiceBusyError: e}, nil
 		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MergeDLQMessages_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReapplyEvents_Result.EntityNotExistError")
 			}
-			return &AdminService_MergeDLQMessages_Result{EntityNotExistError: e}, nil
+			return &AdminService_ReapplyEvents_Result{EntityNotExistError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_MergeDLQMessages_Helper.UnwrapResponse = func(result *AdminService_MergeDLQMessages_Result) (success *replicator.MergeDLQMessagesResponse, err error) {
+	AdminService_ReapplyEvents_Helper.UnwrapResponse = func(result *AdminService_ReapplyEvents_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
 		}
-		if result.InternalServiceError != nil {
-			err = result.InternalServiceError
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
+			return
+		}
+		if result.LimitExceededError != nil {
+			err = result.LimitExceededError
 			return
 		}
 		if result.ServiceBusyError != nil {
@@ -26553,33 +33341,23 @@ func init() {
 			err = result.EntityNotExistError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
-			return
-		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_MergeDLQMessages_Result represents the result of a AdminService.MergeDLQMessages function call.
-//
-// The result of a MergeDLQMessages execution is sent and received over the wire as this struct.
+// AdminService_ReapplyEvents_Result represents the result of a AdminService.ReapplyEvents function call.
 //
-// Success is set only if the function did not throw an exception.
-type AdminService_MergeDLQMessages_Result struct {
-	// Value returned by MergeDLQMessages after a successful execution.
-	Success              *replicator.MergeDLQMessagesResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError              `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError         `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError             `json:"serviceBusyError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError         `json:"entityNotExistError,omitempty"`
+// The result of a ReapplyEvents execution is sent and received over the wire as this struct.
+type AdminService_ReapplyEvents_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	DomainNotActiveError *shared.DomainNotActiveError `json:"domainNotActiveError,omitempty"`
+	LimitExceededError   *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
 }
 
-// ToWire translates a AdminService_MergeDLQMessages_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ReapplyEvents_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -26594,7 +33372,7 @@ type AdminService_MergeDLQMessages_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_MergeDLQMessages_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_ReapplyEvents_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [5]wire.Field
 		i      int = 0
@@ -26602,28 +33380,28 @@ func (v *AdminService_MergeDLQMessages_Result) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 0, Value: w}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.BadRequestError != nil {
-		w, err = v.BadRequestError.ToWire()
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 1, Value: w}
+		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.InternalServiceError != nil {
-		w, err = v.InternalServiceError.ToWire()
+	if v.LimitExceededError != nil {
+		w, err = v.LimitExceededError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 2, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 	if v.ServiceBusyError != nil {
@@ -26631,7 +33409,7 @@ func (v *AdminService_MergeDLQMessages_Result) ToWire() (wire.Value, error) {
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
 	if v.EntityNotExistError != nil {
@@ -26639,28 +33417,22 @@ func (v *AdminService_MergeDLQMessages_Result) ToWire() (wire.Value, error) {
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 4, Value: w}
+		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_MergeDLQMessages_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_ReapplyEvents_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _MergeDLQMessagesResponse_Read(w wire.Value) (*replicator.MergeDLQMessagesResponse, error) {
-	var v replicator.MergeDLQMessagesResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_MergeDLQMessages_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_ReapplyEvents_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_MergeDLQMessages_Result struct
+// An error is returned if we were unable to build a AdminService_ReapplyEvents_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -26668,41 +33440,41 @@ func _MergeDLQMessagesResponse_Read(w wire.Value) (*replicator.MergeDLQMessagesR
 //	  return nil, err
 //	}
 //
-//	var v AdminService_MergeDLQMessages_Result
+//	var v AdminService_ReapplyEvents_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_MergeDLQMessages_Result) FromWire(w wire.Value) error {
+func (v *AdminService_ReapplyEvents_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
+		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _MergeDLQMessagesResponse_Read(field.Value)
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 1:
+		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 2:
+		case 4:
 			if field.Value.Type() == wire.TStruct {
-				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 3:
+		case 5:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
@@ -26710,7 +33482,7 @@ func (v *AdminService_MergeDLQMessages_Result) FromWire(w wire.Value) error {
 				}
 
 			}
-		case 4:
+		case 6:
 			if field.Value.Type() == wire.TStruct {
 				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
@@ -26722,13 +33494,13 @@ func (v *AdminService_MergeDLQMessages_Result) FromWire(w wire.Value) error {
 	}
 
 	count := 0
-	if v.Success != nil {
+	if v.BadRequestError != nil {
 		count++
 	}
-	if v.BadRequestError != nil {
+	if v.DomainNotActiveError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.LimitExceededError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
@@ -26737,27 +33509,27 @@ func (v *AdminService_MergeDLQMessages_Result) FromWire(w wire.Value) error {
 	if v.EntityNotExistError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_MergeDLQMessages_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_ReapplyEvents_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_MergeDLQMessages_Result struct directly into bytes, without going
+// Encode serializes a AdminService_ReapplyEvents_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_MergeDLQMessages_Result struct could not be encoded.
-func (v *AdminService_MergeDLQMessages_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_ReapplyEvents_Result struct could not be encoded.
+func (v *AdminService_ReapplyEvents_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Success != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.Success.Encode(sw); err != nil {
+		if err := v.BadRequestError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -26765,11 +33537,11 @@ func (v *AdminService_MergeDLQMessages_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.BadRequestError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+	if v.DomainNotActiveError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.BadRequestError.Encode(sw); err != nil {
+		if err := v.DomainNotActiveError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -26777,11 +33549,11 @@ func (v *AdminService_MergeDLQMessages_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.InternalServiceError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+	if v.LimitExceededError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.InternalServiceError.Encode(sw); err != nil {
+		if err := v.LimitExceededError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -26790,7 +33562,7 @@ func (v *AdminService_MergeDLQMessages_Result) Encode(sw stream.Writer) error {
 	}
 
 	if v.ServiceBusyError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.ServiceBusyError.Encode(sw); err != nil {
@@ -26802,7 +33574,7 @@ func (v *AdminService_MergeDLQMessages_Result) Encode(sw stream.Writer) error {
 	}
 
 	if v.EntityNotExistError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.EntityNotExistError.Encode(sw); err != nil {
@@ -26814,13 +33586,13 @@ func (v *AdminService_MergeDLQMessages_Result) Encode(sw stream.Writer) error {
 	}
 
 	count := 0
-	if v.Success != nil {
+	if v.BadRequestError != nil {
 		count++
 	}
-	if v.BadRequestError != nil {
+	if v.DomainNotActiveError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.LimitExceededError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
@@ -26830,25 +33602,19 @@ func (v *AdminService_MergeDLQMessages_Result) Encode(sw stream.Writer) error {
 		count++
 	}
 
-	if count != 1 {
-		return fmt.Errorf("AdminService_MergeDLQMessages_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_ReapplyEvents_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _MergeDLQMessagesResponse_Decode(sr stream.Reader) (*replicator.MergeDLQMessagesResponse, error) {
-	var v replicator.MergeDLQMessagesResponse
-	err := v.Decode(sr)
-	return &v, err
-}
-
-// Decode deserializes a AdminService_MergeDLQMessages_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_ReapplyEvents_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_MergeDLQMessages_Result struct could not be generated from the wire
+// An error is returned if a AdminService_ReapplyEvents_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_MergeDLQMessages_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_ReapplyEvents_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -26861,31 +33627,31 @@ func (v *AdminService_MergeDLQMessages_Result) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _MergeDLQMessagesResponse_Decode(sr)
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.BadRequestError, err = _BadRequestError_Decode(sr)
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.DomainNotActiveError, err = _DomainNotActiveError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 2 && fh.Type == wire.TStruct:
-			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 3 && fh.Type == wire.TStruct:
+		case fh.ID == 5 && fh.Type == wire.TStruct:
 			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 4 && fh.Type == wire.TStruct:
+		case fh.ID == 6 && fh.Type == wire.TStruct:
 			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
 			if err != nil {
 				return err
@@ -26911,13 +33677,13 @@ func (v *AdminService_MergeDLQMessages_Result) Decode(sr stream.Reader) error {
 	}
 
 	count := 0
-	if v.Success != nil {
+	if v.BadRequestError != nil {
 		count++
 	}
-	if v.BadRequestError != nil {
+	if v.DomainNotActiveError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.LimitExceededError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
@@ -26926,32 +33692,32 @@ func (v *AdminService_MergeDLQMessages_Result) Decode(sr stream.Reader) error {
 	if v.EntityNotExistError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_MergeDLQMessages_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_ReapplyEvents_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_MergeDLQMessages_Result
+// String returns a readable string representation of a AdminService_ReapplyEvents_Result
 // struct.
-func (v *AdminService_MergeDLQMessages_Result) String() string {
+func (v *AdminService_ReapplyEvents_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [5]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.InternalServiceError != nil {
-		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
 		i++
 	}
 	if v.ServiceBusyError != nil {
@@ -26963,26 +33729,26 @@ func (v *AdminService_MergeDLQMessages_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_MergeDLQMessages_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ReapplyEvents_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_MergeDLQMessages_Result match the
-// provided AdminService_MergeDLQMessages_Result.
+// Equals returns true if all the fields of this AdminService_ReapplyEvents_Result match the
+// provided AdminService_ReapplyEvents_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_MergeDLQMessages_Result) Equals(rhs *AdminService_MergeDLQMessages_Result) bool {
+func (v *AdminService_ReapplyEvents_Result) Equals(rhs *AdminService_ReapplyEvents_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
 		return false
 	}
-	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
 		return false
 	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
@@ -26996,19 +33762,19 @@ func (v *AdminService_MergeDLQMessages_Result) Equals(rhs *AdminService_MergeDLQ
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_MergeDLQMessages_Result.
-func (v *AdminService_MergeDLQMessages_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ReapplyEvents_Result.
+func (v *AdminService_ReapplyEvents_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.InternalServiceError != nil {
-		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	}
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
 	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
@@ -27019,54 +33785,54 @@ func (v *AdminService_MergeDLQMessages_Result) MarshalLogObject(enc zapcore.Obje
 	return err
 }
 
-// GetSuccess returns the value of Success if it is set or its
+// GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_MergeDLQMessages_Result) GetSuccess() (o *replicator.MergeDLQMessagesResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
+func (v *AdminService_ReapplyEvents_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
 	}
 
 	return
 }
 
-// IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_MergeDLQMessages_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_ReapplyEvents_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
 }
 
-// GetBadRequestError returns the value of BadRequestError if it is set or its
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_MergeDLQMessages_Result) GetBadRequestError() (o *shared.BadRequestError) {
-	if v != nil && v.BadRequestError != nil {
-		return v.BadRequestError
+func (v *AdminService_ReapplyEvents_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
 	}
 
 	return
 }
 
-// IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_MergeDLQMessages_Result) IsSetBadRequestError() bool {
-	return v != nil && v.BadRequestError != nil
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *AdminService_ReapplyEvents_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
 }
 
-// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_MergeDLQMessages_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
-	if v != nil && v.InternalServiceError != nil {
-		return v.InternalServiceError
+func (v *AdminService_ReapplyEvents_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
 	}
 
 	return
 }
 
-// IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_MergeDLQMessages_Result) IsSetInternalServiceError() bool {
-	return v != nil && v.InternalServiceError != nil
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *AdminService_ReapplyEvents_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_MergeDLQMessages_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *AdminService_ReapplyEvents_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -27075,13 +33841,13 @@ func (v *AdminService_MergeDLQMessages_Result) GetServiceBusyError() (o *shared.
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_MergeDLQMessages_Result) IsSetServiceBusyError() bool {
+func (v *AdminService_ReapplyEvents_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_MergeDLQMessages_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+func (v *AdminService_ReapplyEvents_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
 	if v != nil && v.EntityNotExistError != nil {
 		return v.EntityNotExistError
 	}
@@ -27090,33 +33856,33 @@ func (v *AdminService_MergeDLQMessages_Result) GetEntityNotExistError() (o *shar
 }
 
 // IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_MergeDLQMessages_Result) IsSetEntityNotExistError() bool {
+func (v *AdminService_ReapplyEvents_Result) IsSetEntityNotExistError() bool {
 	return v != nil && v.EntityNotExistError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "MergeDLQMessages" for this struct.
-func (v *AdminService_MergeDLQMessages_Result) MethodName() string {
-	return "MergeDLQMessages"
+// This will always be "ReapplyEvents" for this struct.
+func (v *AdminService_ReapplyEvents_Result) MethodName() string {
+	return "ReapplyEvents"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_MergeDLQMessages_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ReapplyEvents_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_PurgeDLQMessages_Args represents the arguments for the AdminService.PurgeDLQMessages function.
+// AdminService_RefreshWorkflowTasks_Args represents the arguments for the AdminService.RefreshWorkflowTasks function.
 //
-// The arguments for PurgeDLQMessages are sent and received over the wire as this struct.
-type AdminService_PurgeDLQMessages_Args struct {
-	Request *replicator.PurgeDLQMessagesRequest `json:"request,omitempty"`
+// The arguments for RefreshWorkflowTasks are sent and received over the wire as this struct.
+type AdminService_RefreshWorkflowTasks_Args struct {
+	Request *shared.RefreshWorkflowTasksRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_PurgeDLQMessages_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RefreshWorkflowTasks_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -27131,7 +33897,7 @@ type AdminService_PurgeDLQMessages_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_PurgeDLQMessages_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_RefreshWorkflowTasks_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -27151,17 +33917,17 @@ func (v *AdminService_PurgeDLQMessages_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PurgeDLQMessagesRequest_Read(w wire.Value) (*replicator.PurgeDLQMessagesRequest, error) {
-	var v replicator.PurgeDLQMessagesRequest
+func _RefreshWorkflowTasksRequest_Read(w wire.Value) (*shared.RefreshWorkflowTasksRequest, error) {
+	var v shared.RefreshWorkflowTasksRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_PurgeDLQMessages_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_RefreshWorkflowTasks_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_PurgeDLQMessages_Args struct
+// An error is returned if we were unable to build a AdminService_RefreshWorkflowTasks_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -27169,19 +33935,19 @@ func _PurgeDLQMessagesRequest_Read(w wire.Value) (*replicator.PurgeDLQMessagesRe
 //	  return nil, err
 //	}
 //
-//	var v AdminService_PurgeDLQMessages_Args
+//	var v AdminService_RefreshWorkflowTasks_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_PurgeDLQMessages_Args) FromWire(w wire.Value) error {
+func (v *AdminService_RefreshWorkflowTasks_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _PurgeDLQMessagesRequest_Read(field.Value)
+				v.Request, err = _RefreshWorkflowTasksRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -27193,11 +33959,11 @@ func (v *AdminService_PurgeDLQMessages_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_PurgeDLQMessages_Args struct directly into bytes, without going
+// Encode serializes a AdminService_RefreshWorkflowTasks_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_PurgeDLQMessages_Args struct could not be encoded.
-func (v *AdminService_PurgeDLQMessages_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_RefreshWorkflowTasks_Args struct could not be encoded.
+func (v *AdminService_RefreshWorkflowTasks_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -27217,18 +33983,18 @@ func (v *AdminService_PurgeDLQMessages_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _PurgeDLQMessagesRequest_Decode(sr stream.Reader) (*replicator.PurgeDLQMessagesRequest, error) {
-	var v replicator.PurgeDLQMessagesRequest
+func _RefreshWorkflowTasksRequest_Decode(sr stream.Reader) (*shared.RefreshWorkflowTasksRequest, error) {
+	var v shared.RefreshWorkflowTasksRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_PurgeDLQMessages_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_RefreshWorkflowTasks_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_PurgeDLQMessages_Args struct could not be generated from the wire
+// An error is returned if a AdminService_RefreshWorkflowTasks_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_PurgeDLQMessages_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_RefreshWorkflowTasks_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -27242,7 +34008,7 @@ func (v *AdminService_PurgeDLQMessages_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _PurgeDLQMessagesRequest_Decode(sr)
+			v.Request, err = _RefreshWorkflowTasksRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -27269,9 +34035,9 @@ func (v *AdminService_PurgeDLQMessages_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_PurgeDLQMessages_Args
+// String returns a readable string representation of a AdminService_RefreshWorkflowTasks_Args
 // struct.
-func (v *AdminService_PurgeDLQMessages_Args) String() string {
+func (v *AdminService_RefreshWorkflowTasks_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -27283,14 +34049,14 @@ func (v *AdminService_PurgeDLQMessages_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_PurgeDLQMessages_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RefreshWorkflowTasks_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_PurgeDLQMessages_Args match the
-// provided AdminService_PurgeDLQMessages_Args.
+// Equals returns true if all the fields of this AdminService_RefreshWorkflowTasks_Args match the
+// provided AdminService_RefreshWorkflowTasks_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_PurgeDLQMessages_Args) Equals(rhs *AdminService_PurgeDLQMessages_Args) bool {
+func (v *AdminService_RefreshWorkflowTasks_Args) Equals(rhs *AdminService_RefreshWorkflowTasks_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -27304,8 +34070,8 @@ func (v *AdminService_PurgeDLQMessages_Args) Equals(rhs *AdminService_PurgeDLQMe
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_PurgeDLQMessages_Args.
-func (v *AdminService_PurgeDLQMessages_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RefreshWorkflowTasks_Args.
+func (v *AdminService_RefreshWorkflowTasks_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -27317,7 +34083,7 @@ func (v *AdminService_PurgeDLQMessages_Args) MarshalLogObject(enc zapcore.Object
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_PurgeDLQMessages_Args) GetRequest() (o *replicator.PurgeDLQMessagesRequest) {
+func (v *AdminService_RefreshWorkflowTasks_Args) GetRequest() (o *shared.RefreshWorkflowTasksRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -27326,85 +34092,85 @@ func (v *AdminService_PurgeDLQMessages_Args) GetRequest() (o *replicator.PurgeDL
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_PurgeDLQMessages_Args) IsSetRequest() bool {
+func (v *AdminService_RefreshWorkflowTasks_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "PurgeDLQMessages" for this struct.
-func (v *AdminService_PurgeDLQMessages_Args) MethodName() string {
-	return "PurgeDLQMessages"
+// This will always be "RefreshWorkflowTasks" for this struct.
+func (v *AdminService_RefreshWorkflowTasks_Args) MethodName() string {
+	return "RefreshWorkflowTasks"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_PurgeDLQMessages_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RefreshWorkflowTasks_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_PurgeDLQMessages_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.PurgeDLQMessages
+// AdminService_RefreshWorkflowTasks_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.RefreshWorkflowTasks
 // function.
-var AdminService_PurgeDLQMessages_Helper = struct {
-	// Args accepts the parameters of PurgeDLQMessages in-order and returns
+var AdminService_RefreshWorkflowTasks_Helper = struct {
+	// Args accepts the parameters of RefreshWorkflowTasks in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *replicator.PurgeDLQMessagesRequest,
-	) *AdminService_PurgeDLQMessages_Args
+		request *shared.RefreshWorkflowTasksRequest,
+	) *AdminService_RefreshWorkflowTasks_Args
 
 	// IsException returns true if the given error can be thrown
-	// by PurgeDLQMessages.
+	// by RefreshWorkflowTasks.
 	//
-	// An error can be thrown by PurgeDLQMessages only if the
+	// An error can be thrown by RefreshWorkflowTasks only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for PurgeDLQMessages
+	// WrapResponse returns the result struct for RefreshWorkflowTasks
 	// given the error returned by it. The provided error may
-	// be nil if PurgeDLQMessages did not fail.
+	// be nil if RefreshWorkflowTasks did not fail.
 	//
-	// This allows mapping errors returned by PurgeDLQMessages into a
+	// This allows mapping errors returned by RefreshWorkflowTasks into a
 	// serializable result struct. WrapResponse returns a
 	// non-nil error if the provided error cannot be thrown by
-	// PurgeDLQMessages
+	// RefreshWorkflowTasks
 	//
-	//   err := PurgeDLQMessages(args)
-	//   result, err := AdminService_PurgeDLQMessages_Helper.WrapResponse(err)
+	//   err := RefreshWorkflowTasks(args)
+	//   result, err := AdminService_RefreshWorkflowTasks_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from PurgeDLQMessages: %v", err)
+	//     return fmt.Errorf("unexpected error from RefreshWorkflowTasks: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*AdminService_PurgeDLQMessages_Result, error)
+	WrapResponse func(error) (*AdminService_RefreshWorkflowTasks_Result, error)
 
-	// UnwrapResponse takes the result struct for PurgeDLQMessages
+	// UnwrapResponse takes the result struct for RefreshWorkflowTasks
 	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if PurgeDLQMessages threw an
+	// The error is non-nil only if RefreshWorkflowTasks threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := AdminService_PurgeDLQMessages_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_PurgeDLQMessages_Result) error
+	//   err := AdminService_RefreshWorkflowTasks_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_RefreshWorkflowTasks_Result) error
 }{}
 
 func init() {
-	AdminService_PurgeDLQMessages_Helper.Args = func(
-		request *replicator.PurgeDLQMessagesRequest,
-	) *AdminService_PurgeDLQMessages_Args {
-		return &AdminService_PurgeDLQMessages_Args{
+	AdminService_RefreshWorkflowTasks_Helper.Args = func(
+		request *shared.RefreshWorkflowTasksRequest,
+	) *AdminService_RefreshWorkflowTasks_Args {
+		return &AdminService_RefreshWorkflowTasks_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_PurgeDLQMessages_Helper.IsException = func(err error) bool {
+	AdminService_RefreshWorkflowTasks_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
-		case *shared.InternalServiceError:
+		case *shared.DomainNotActiveError:
 			return true
 		case *shared.ServiceBusyError:
 			return true
@@ -27415,43 +34181,43 @@ func init() {
 		}
 	}
 
-	AdminService_PurgeDLQMessages_Helper.WrapResponse = func(err error) (*AdminService_PurgeDLQMessages_Result, error) {
+	AdminService_RefreshWorkflowTasks_Helper.WrapResponse = func(err error) (*AdminService_RefreshWorkflowTasks_Result, error) {
 		if err == nil {
-			return &AdminService_PurgeDLQMessages_Result{}, nil
+			return &AdminService_RefreshWorkflowTasks_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PurgeDLQMessages_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RefreshWorkflowTasks_Result.BadRequestError")
 			}
-			return &AdminService_PurgeDLQMessages_Result{BadRequestError: e}, nil
-		case *shared.InternalServiceError:
+			return &AdminService_RefreshWorkflowTasks_Result{BadRequestError: e}, nil
+		case *shared.DomainNotActiveError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PurgeDLQMessages_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RefreshWorkflowTasks_Result.DomainNotActiveError")
 			}
-			return &AdminService_PurgeDLQMessages_Result{InternalServiceError: e}, nil
+			return &AdminService_RefreshWorkflowTasks_Result{DomainNotActiveError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PurgeDLQMessages_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RefreshWorkflowTasks_Result.ServiceBusyError")
 			}
-			return &AdminService_PurgeDLQMessages_Result{ServiceBusyError: e}, nil
+			return &AdminService_RefreshWorkflowTasks_Result{ServiceBusyError: e}, nil
 		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PurgeDLQMessages_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RefreshWorkflowTasks_Result.EntityNotExistError")
 			}
-			return &AdminService_PurgeDLQMessages_Result{EntityNotExistError: e}, nil
+			return &AdminService_RefreshWorkflowTasks_Result{EntityNotExistError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_PurgeDLQMessages_Helper.UnwrapResponse = func(result *AdminService_PurgeDLQMessages_Result) (err error) {
+	AdminService_RefreshWorkflowTasks_Helper.UnwrapResponse = func(result *AdminService_RefreshWorkflowTasks_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
 		}
-		if result.InternalServiceError != nil {
-			err = result.InternalServiceError
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
 			return
 		}
 		if result.ServiceBusyError != nil {
@@ -27467,17 +34233,17 @@ func init() {
 
 }
 
-// AdminService_PurgeDLQMessages_Result represents the result of a AdminService.PurgeDLQMessages function call.
+// AdminService_RefreshWorkflowTasks_Result represents the result of a AdminService.RefreshWorkflowTasks function call.
 //
-// The result of a PurgeDLQMessages execution is sent and received over the wire as this struct.
-type AdminService_PurgeDLQMessages_Result struct {
+// The result of a RefreshWorkflowTasks execution is sent and received over the wire as this struct.
+type AdminService_RefreshWorkflowTasks_Result struct {
 	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	DomainNotActiveError *shared.DomainNotActiveError `json:"domainNotActiveError,omitempty"`
 	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
 }
 
-// ToWire translates a AdminService_PurgeDLQMessages_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RefreshWorkflowTasks_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -27492,7 +34258,7 @@ type AdminService_PurgeDLQMessages_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_PurgeDLQMessages_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_RefreshWorkflowTasks_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [4]wire.Field
 		i      int = 0
@@ -27508,8 +34274,8 @@ func (v *AdminService_PurgeDLQMessages_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.InternalServiceError != nil {
-		w, err = v.InternalServiceError.ToWire()
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -27534,17 +34300,17 @@ func (v *AdminService_PurgeDLQMessages_Result) ToWire() (wire.Value, error) {
 	}
 
 	if i > 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_PurgeDLQMessages_Result should have at most one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_RefreshWorkflowTasks_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a AdminService_PurgeDLQMessages_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_RefreshWorkflowTasks_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_PurgeDLQMessages_Result struct
+// An error is returned if we were unable to build a AdminService_RefreshWorkflowTasks_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -27552,12 +34318,12 @@ func (v *AdminService_PurgeDLQMessages_Result) ToWire() (wire.Value, error) {
 //	  return nil, err
 //	}
 //
-//	var v AdminService_PurgeDLQMessages_Result
+//	var v AdminService_RefreshWorkflowTasks_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_PurgeDLQMessages_Result) FromWire(w wire.Value) error {
+func (v *AdminService_RefreshWorkflowTasks_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -27572,7 +34338,7 @@ func (v *AdminService_PurgeDLQMessages_Result) FromWire(w wire.Value) error {
 			}
 		case 2:
 			if field.Value.Type() == wire.TStruct {
-				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -27601,7 +34367,7 @@ func (v *AdminService_PurgeDLQMessages_Result) FromWire(w wire.Value) error {
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.DomainNotActiveError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
@@ -27611,17 +34377,17 @@ func (v *AdminService_PurgeDLQMessages_Result) FromWire(w wire.Value) error {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("AdminService_PurgeDLQMessages_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_RefreshWorkflowTasks_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_PurgeDLQMessages_Result struct directly into bytes, without going
+// Encode serializes a AdminService_RefreshWorkflowTasks_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_PurgeDLQMessages_Result struct could not be encoded.
-func (v *AdminService_PurgeDLQMessages_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_RefreshWorkflowTasks_Result struct could not be encoded.
+func (v *AdminService_RefreshWorkflowTasks_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -27638,11 +34404,11 @@ func (v *AdminService_PurgeDLQMessages_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.InternalServiceError != nil {
+	if v.DomainNotActiveError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.InternalServiceError.Encode(sw); err != nil {
+		if err := v.DomainNotActiveError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -27678,7 +34444,7 @@ func (v *AdminService_PurgeDLQMessages_Result) Encode(sw stream.Writer) error {
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.DomainNotActiveError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
@@ -27689,18 +34455,18 @@ func (v *AdminService_PurgeDLQMessages_Result) Encode(sw stream.Writer) error {
 	}
 
 	if count > 1 {
-		return fmt.Errorf("AdminService_PurgeDLQMessages_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_RefreshWorkflowTasks_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a AdminService_PurgeDLQMessages_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_RefreshWorkflowTasks_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_PurgeDLQMessages_Result struct could not be generated from the wire
+// An error is returned if a AdminService_RefreshWorkflowTasks_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_PurgeDLQMessages_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_RefreshWorkflowTasks_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -27720,7 +34486,7 @@ func (v *AdminService_PurgeDLQMessages_Result) Decode(sr stream.Reader) error {
 			}
 
 		case fh.ID == 2 && fh.Type == wire.TStruct:
-			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+			v.DomainNotActiveError, err = _DomainNotActiveError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -27760,7 +34526,7 @@ func (v *AdminService_PurgeDLQMessages_Result) Decode(sr stream.Reader) error {
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.DomainNotActiveError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
@@ -27770,15 +34536,15 @@ func (v *AdminService_PurgeDLQMessages_Result) Decode(sr stream.Reader) error {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("AdminService_PurgeDLQMessages_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_RefreshWorkflowTasks_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_PurgeDLQMessages_Result
+// String returns a readable string representation of a AdminService_RefreshWorkflowTasks_Result
 // struct.
-func (v *AdminService_PurgeDLQMessages_Result) String() string {
+func (v *AdminService_RefreshWorkflowTasks_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -27789,8 +34555,8 @@ func (v *AdminService_PurgeDLQMessages_Result) String() string {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.InternalServiceError != nil {
-		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
 		i++
 	}
 	if v.ServiceBusyError != nil {
@@ -27802,14 +34568,14 @@ func (v *AdminService_PurgeDLQMessages_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_PurgeDLQMessages_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RefreshWorkflowTasks_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_PurgeDLQMessages_Result match the
-// provided AdminService_PurgeDLQMessages_Result.
+// Equals returns true if all the fields of this AdminService_RefreshWorkflowTasks_Result match the
+// provided AdminService_RefreshWorkflowTasks_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_PurgeDLQMessages_Result) Equals(rhs *AdminService_PurgeDLQMessages_Result) bool {
+func (v *AdminService_RefreshWorkflowTasks_Result) Equals(rhs *AdminService_RefreshWorkflowTasks_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -27818,7 +34584,7 @@ func (v *AdminService_PurgeDLQMessages_Result) Equals(rhs *AdminService_PurgeDLQ
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
 		return false
 	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
@@ -27832,16 +34598,16 @@ func (v *AdminService_PurgeDLQMessages_Result) Equals(rhs *AdminService_PurgeDLQ
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_PurgeDLQMessages_Result.
-func (v *AdminService_PurgeDLQMessages_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RefreshWorkflowTasks_Result.
+func (v *AdminService_RefreshWorkflowTasks_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.InternalServiceError != nil {
-		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
 	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
@@ -27854,7 +34620,7 @@ func (v *AdminService_PurgeDLQMessages_Result) MarshalLogObject(enc zapcore.Obje
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_PurgeDLQMessages_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_RefreshWorkflowTasks_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -27863,28 +34629,28 @@ func (v *AdminService_PurgeDLQMessages_Result) GetBadRequestError() (o *shared.B
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_PurgeDLQMessages_Result) IsSetBadRequestError() bool {
+func (v *AdminService_RefreshWorkflowTasks_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
-// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_PurgeDLQMessages_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
-	if v != nil && v.InternalServiceError != nil {
-		return v.InternalServiceError
+func (v *AdminService_RefreshWorkflowTasks_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
 	}
 
 	return
 }
 
-// IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_PurgeDLQMessages_Result) IsSetInternalServiceError() bool {
-	return v != nil && v.InternalServiceError != nil
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *AdminService_RefreshWorkflowTasks_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_PurgeDLQMessages_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *AdminService_RefreshWorkflowTasks_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -27893,13 +34659,13 @@ func (v *AdminService_PurgeDLQMessages_Result) GetServiceBusyError() (o *shared.
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_PurgeDLQMessages_Result) IsSetServiceBusyError() bool {
+func (v *AdminService_RefreshWorkflowTasks_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_PurgeDLQMessages_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+func (v *AdminService_RefreshWorkflowTasks_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
 	if v != nil && v.EntityNotExistError != nil {
 		return v.EntityNotExistError
 	}
@@ -27908,33 +34674,33 @@ func (v *AdminService_PurgeDLQMessages_Result) GetEntityNotExistError() (o *shar
 }
 
 // IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_PurgeDLQMessages_Result) IsSetEntityNotExistError() bool {
+func (v *AdminService_RefreshWorkflowTasks_Result) IsSetEntityNotExistError() bool {
 	return v != nil && v.EntityNotExistError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "PurgeDLQMessages" for this struct.
-func (v *AdminService_PurgeDLQMessages_Result) MethodName() string {
-	return "PurgeDLQMessages"
+// This will always be "RefreshWorkflowTasks" for this struct.
+func (v *AdminService_RefreshWorkflowTasks_Result) MethodName() string {
+	return "RefreshWorkflowTasks"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_PurgeDLQMessages_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RefreshWorkflowTasks_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_ReadDLQMessages_Args represents the arguments for the AdminService.ReadDLQMessages function.
+// AdminService_RemoveTask_Args represents the arguments for the AdminService.RemoveTask function.
 //
-// The arguments for ReadDLQMessages are sent and received over the wire as this struct.
-type AdminService_ReadDLQMessages_Args struct {
-	Request *replicator.ReadDLQMessagesRequest `json:"request,omitempty"`
+// The arguments for RemoveTask are sent and received over the wire as this struct.
+type AdminService_RemoveTask_Args struct {
+	Request *shared.RemoveTaskRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_ReadDLQMessages_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RemoveTask_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -27949,7 +34715,7 @@ type AdminService_ReadDLQMessages_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_ReadDLQMessages_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_RemoveTask_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -27969,17 +34735,17 @@ func (v *AdminService_ReadDLQMessages_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ReadDLQMessagesRequest_Read(w wire.Value) (*replicator.ReadDLQMessagesRequest, error) {
-	var v replicator.ReadDLQMessagesRequest
+func _RemoveTaskRequest_Read(w wire.Value) (*shared.RemoveTaskRequest, error) {
+	var v shared.RemoveTaskRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_ReadDLQMessages_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_RemoveTask_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_ReadDLQMessages_Args struct
+// An error is returned if we were unable to build a AdminService_RemoveTask_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -27987,19 +34753,19 @@ func _ReadDLQMessagesRequest_Read(w wire.Value) (*replicator.ReadDLQMessagesRequ
 //	  return nil, err
 //	}
 //
-//	var v AdminService_ReadDLQMessages_Args
+//	var v AdminService_RemoveTask_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_ReadDLQMessages_Args) FromWire(w wire.Value) error {
+func (v *AdminService_RemoveTask_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _ReadDLQMessagesRequest_Read(field.Value)
+				v.Request, err = _RemoveTaskRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -28011,11 +34777,11 @@ func (v *AdminService_ReadDLQMessages_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_ReadDLQMessages_Args struct directly into bytes, without going
+// Encode serializes a AdminService_RemoveTask_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_ReadDLQMessages_Args struct could not be encoded.
-func (v *AdminService_ReadDLQMessages_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_RemoveTask_Args struct could not be encoded.
+func (v *AdminService_RemoveTask_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -28035,18 +34801,18 @@ func (v *AdminService_ReadDLQMessages_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _ReadDLQMessagesRequest_Decode(sr stream.Reader) (*replicator.ReadDLQMessagesRequest, error) {
-	var v replicator.ReadDLQMessagesRequest
+func _RemoveTaskRequest_Decode(sr stream.Reader) (*shared.RemoveTaskRequest, error) {
+	var v shared.RemoveTaskRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_ReadDLQMessages_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_RemoveTask_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_ReadDLQMessages_Args struct could not be generated from the wire
+// An error is returned if a AdminService_RemoveTask_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_ReadDLQMessages_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_RemoveTask_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -28060,7 +34826,7 @@ func (v *AdminService_ReadDLQMessages_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _ReadDLQMessagesRequest_Decode(sr)
+			v.Request, err = _RemoveTaskRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -28087,9 +34853,9 @@ func (v *AdminService_ReadDLQMessages_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_ReadDLQMessages_Args
+// String returns a readable string representation of a AdminService_RemoveTask_Args
 // struct.
-func (v *AdminService_ReadDLQMessages_Args) String() string {
+func (v *AdminService_RemoveTask_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -28101,14 +34867,14 @@ func (v *AdminService_ReadDLQMessages_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_ReadDLQMessages_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RemoveTask_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_ReadDLQMessages_Args match the
-// provided AdminService_ReadDLQMessages_Args.
+// Equals returns true if all the fields of this AdminService_RemoveTask_Args match the
+// provided AdminService_RemoveTask_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_ReadDLQMessages_Args) Equals(rhs *AdminService_ReadDLQMessages_Args) bool {
+func (v *AdminService_RemoveTask_Args) Equals(rhs *AdminService_RemoveTask_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -28122,8 +34888,8 @@ func (v *AdminService_ReadDLQMessages_Args) Equals(rhs *AdminService_ReadDLQMess
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_ReadDLQMessages_Args.
-func (v *AdminService_ReadDLQMessages_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RemoveTask_Args.
+func (v *AdminService_RemoveTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -28135,7 +34901,7 @@ func (v *AdminService_ReadDLQMessages_Args) MarshalLogObject(enc zapcore.ObjectE
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ReadDLQMessages_Args) GetRequest() (o *replicator.ReadDLQMessagesRequest) {
+func (v *AdminService_RemoveTask_Args) GetRequest() (o *shared.RemoveTaskRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -28144,125 +34910,119 @@ func (v *AdminService_ReadDLQMessages_Args) GetRequest() (o *replicator.ReadDLQM
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_ReadDLQMessages_Args) IsSetRequest() bool {
+func (v *AdminService_RemoveTask_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "ReadDLQMessages" for this struct.
-func (v *AdminService_ReadDLQMessages_Args) MethodName() string {
-	return "ReadDLQMessages"
+// This will always be "RemoveTask" for this struct.
+func (v *AdminService_RemoveTask_Args) MethodName() string {
+	return "RemoveTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_ReadDLQMessages_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RemoveTask_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_ReadDLQMessages_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.ReadDLQMessages
+// AdminService_RemoveTask_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.RemoveTask
 // function.
-var AdminService_ReadDLQMessages_Helper = struct {
-	// Args accepts the parameters of ReadDLQMessages in-order and returns
+var AdminService_RemoveTask_Helper = struct {
+	// Args accepts the parameters of RemoveTask in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *replicator.ReadDLQMessagesRequest,
-	) *AdminService_ReadDLQMessages_Args
+		request *shared.RemoveTaskRequest,
+	) *AdminService_RemoveTask_Args
 
 	// IsException returns true if the given error can be thrown
-	// by ReadDLQMessages.
+	// by RemoveTask.
 	//
-	// An error can be thrown by ReadDLQMessages only if the
+	// An error can be thrown by RemoveTask only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for ReadDLQMessages
-	// given its return value and error.
+	// WrapResponse returns the result struct for RemoveTask
+	// given the error returned by it. The provided error may
+	// be nil if RemoveTask did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// ReadDLQMessages into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by ReadDLQMessages
+	// This allows mapping errors returned by RemoveTask into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// RemoveTask
 	//
-	//   value, err := ReadDLQMessages(args)
-	//   result, err := AdminService_ReadDLQMessages_Helper.WrapResponse(value, err)
+	//   err := RemoveTask(args)
+	//   result, err := AdminService_RemoveTask_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from ReadDLQMessages: %v", err)
+	//     return fmt.Errorf("unexpected error from RemoveTask: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*replicator.ReadDLQMessagesResponse, error) (*AdminService_ReadDLQMessages_Result, error)
+	WrapResponse func(error) (*AdminService_RemoveTask_Result, error)
 
-	// UnwrapResponse takes the result struct for ReadDLQMessages
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for RemoveTask
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if ReadDLQMessages threw an
+	// The error is non-nil only if RemoveTask threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_ReadDLQMessages_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_ReadDLQMessages_Result) (*replicator.ReadDLQMessagesResponse, error)
+	//   err := AdminService_RemoveTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_RemoveTask_Result) error
 }{}
 
 func init() {
-	AdminService_ReadDLQMessages_Helper.Args = func(
-		request *replicator.ReadDLQMessagesRequest,
-	) *AdminService_ReadDLQMessages_Args {
-		return &AdminService_ReadDLQMessages_Args{
+	AdminService_RemoveTask_Helper.Args = func(
+		request *shared.RemoveTaskRequest,
+	) *AdminService_RemoveTask_Args {
+		return &AdminService_RemoveTask_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_ReadDLQMessages_Helper.IsException = func(err error) bool {
+	AdminService_RemoveTask_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.ServiceBusyError:
-			return true
-		case *shared.EntityNotExistsError:
+		case *shared.AccessDeniedError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_ReadDLQMessages_Helper.WrapResponse = func(success *replicator.ReadDLQMessagesResponse, err error) (*AdminService_ReadDLQMessages_Result, error) {
+	AdminService_RemoveTask_Helper.WrapResponse = func(err error) (*AdminService_RemoveTask_Result, error) {
 		if err == nil {
-			return &AdminService_ReadDLQMessages_Result{Success: success}, nil
+			return &AdminService_RemoveTask_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReadDLQMessages_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.BadRequestError")
 			}
-			return &AdminService_ReadDLQMessages_Result{BadRequestError: e}, nil
+			return &AdminService_RemoveTask_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReadDLQMessages_Result.InternalServiceError")
-			}
-			return &AdminService_ReadDLQMessages_Result{InternalServiceError: e}, nil
-		case *shared.ServiceBusyError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReadDLQMessages_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.InternalServiceError")
 			}
-			return &AdminService_ReadDLQMessages_Result{ServiceBusyError: e}, nil
-		case *shared.EntityNotExistsError:
+			return &AdminService_RemoveTask_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReadDLQMessages_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.AccessDeniedError")
 			}
-			return &AdminService_ReadDLQMessages_Result{EntityNotExistError: e}, nil
+			return &AdminService_RemoveTask_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_ReadDLQMessages_Helper.UnwrapResponse = func(result *AdminService_ReadDLQMessages_Result) (success *replicator.ReadDLQMessagesResponse, err error) {
+	AdminService_RemoveTask_Helper.UnwrapResponse = func(result *AdminService_RemoveTask_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -28271,41 +35031,25 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.ServiceBusyError != nil {
-			err = result.ServiceBusyError
-			return
-		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
-			return
-		}
-
-		if result.Success != nil {
-			success = result.Success
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
 			return
 		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_ReadDLQMessages_Result represents the result of a AdminService.ReadDLQMessages function call.
-//
-// The result of a ReadDLQMessages execution is sent and received over the wire as this struct.
+// AdminService_RemoveTask_Result represents the result of a AdminService.RemoveTask function call.
 //
-// Success is set only if the function did not throw an exception.
-type AdminService_ReadDLQMessages_Result struct {
-	// Value returned by ReadDLQMessages after a successful execution.
-	Success              *replicator.ReadDLQMessagesResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError            `json:"serviceBusyError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError        `json:"entityNotExistError,omitempty"`
+// The result of a RemoveTask execution is sent and received over the wire as this struct.
+type AdminService_RemoveTask_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_ReadDLQMessages_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RemoveTask_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -28320,22 +35064,14 @@ type AdminService_ReadDLQMessages_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_ReadDLQMessages_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_RemoveTask_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -28352,41 +35088,27 @@ func (v *AdminService_ReadDLQMessages_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		w, err = v.ServiceBusyError.ToWire()
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_ReadDLQMessages_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_RemoveTask_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ReadDLQMessagesResponse_Read(w wire.Value) (*replicator.ReadDLQMessagesResponse, error) {
-	var v replicator.ReadDLQMessagesResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_ReadDLQMessages_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_RemoveTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_ReadDLQMessages_Result struct
+// An error is returned if we were unable to build a AdminService_RemoveTask_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -28394,24 +35116,16 @@ func _ReadDLQMessagesResponse_Read(w wire.Value) (*replicator.ReadDLQMessagesRes
 //	  return nil, err
 //	}
 //
-//	var v AdminService_ReadDLQMessages_Result
+//	var v AdminService_RemoveTask_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_ReadDLQMessages_Result) FromWire(w wire.Value) error {
+func (v *AdminService_RemoveTask_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _ReadDLQMessagesResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -28430,15 +35144,7 @@ func (v *AdminService_ReadDLQMessages_Result) FromWire(w wire.Value) error {
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 4:
-			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -28448,49 +35154,31 @@ func (v *AdminService_ReadDLQMessages_Result) FromWire(w wire.Value) error {
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
-		count++
-	}
-	if v.EntityNotExistError != nil {
+	if v.AccessDeniedError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_ReadDLQMessages_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_RemoveTask_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_ReadDLQMessages_Result struct directly into bytes, without going
+// Encode serializes a AdminService_RemoveTask_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_ReadDLQMessages_Result struct could not be encoded.
-func (v *AdminService_ReadDLQMessages_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_RemoveTask_Result struct could not be encoded.
+func (v *AdminService_RemoveTask_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Success != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.Success.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
 	if v.BadRequestError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
@@ -28515,23 +35203,11 @@ func (v *AdminService_ReadDLQMessages_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ServiceBusyError != nil {
+	if v.AccessDeniedError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.ServiceBusyError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.EntityNotExistError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.EntityNotExistError.Encode(sw); err != nil {
+		if err := v.AccessDeniedError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -28540,41 +35216,29 @@ func (v *AdminService_ReadDLQMessages_Result) Encode(sw stream.Writer) error {
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
-		count++
-	}
-	if v.EntityNotExistError != nil {
+	if v.AccessDeniedError != nil {
 		count++
 	}
 
-	if count != 1 {
-		return fmt.Errorf("AdminService_ReadDLQMessages_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_RemoveTask_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _ReadDLQMessagesResponse_Decode(sr stream.Reader) (*replicator.ReadDLQMessagesResponse, error) {
-	var v replicator.ReadDLQMessagesResponse
-	err := v.Decode(sr)
-	return &v, err
-}
-
-// Decode deserializes a AdminService_ReadDLQMessages_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_RemoveTask_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_ReadDLQMessages_Result struct could not be generated from the wire
+// An error is returned if a AdminService_RemoveTask_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_ReadDLQMessages_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_RemoveTask_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -28587,12 +35251,6 @@ func (v *AdminService_ReadDLQMessages_Result) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _ReadDLQMessagesResponse_Decode(sr)
-			if err != nil {
-				return err
-			}
-
 		case fh.ID == 1 && fh.Type == wire.TStruct:
 			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
@@ -28606,13 +35264,7 @@ func (v *AdminService_ReadDLQMessages_Result) Decode(sr stream.Reader) error {
 			}
 
 		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 4 && fh.Type == wire.TStruct:
-			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			v.AccessDeniedError, err = _AccessDeniedError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -28637,41 +35289,31 @@ func (v *AdminService_ReadDLQMessages_Result) Decode(sr stream.Reader) error {
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
-		count++
-	}
-	if v.EntityNotExistError != nil {
+	if v.AccessDeniedError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_ReadDLQMessages_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_RemoveTask_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_ReadDLQMessages_Result
+// String returns a readable string representation of a AdminService_RemoveTask_Result
 // struct.
-func (v *AdminService_ReadDLQMessages_Result) String() string {
+func (v *AdminService_RemoveTask_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [3]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -28680,41 +35322,31 @@ func (v *AdminService_ReadDLQMessages_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
-		i++
-	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_ReadDLQMessages_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RemoveTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_ReadDLQMessages_Result match the
-// provided AdminService_ReadDLQMessages_Result.
+// Equals returns true if all the fields of this AdminService_RemoveTask_Result match the
+// provided AdminService_RemoveTask_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_ReadDLQMessages_Result) Equals(rhs *AdminService_ReadDLQMessages_Result) bool {
+func (v *AdminService_RemoveTask_Result) Equals(rhs *AdminService_RemoveTask_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
-		return false
-	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
 		return false
 	}
 
@@ -28722,47 +35354,26 @@ func (v *AdminService_ReadDLQMessages_Result) Equals(rhs *AdminService_ReadDLQMe
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_ReadDLQMessages_Result.
-func (v *AdminService_ReadDLQMessages_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RemoveTask_Result.
+func (v *AdminService_RemoveTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.ServiceBusyError != nil {
-		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
-	}
-	if v.EntityNotExistError != nil {
-		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
 	}
 	return err
 }
 
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *AdminService_ReadDLQMessages_Result) GetSuccess() (o *replicator.ReadDLQMessagesResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
-	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_ReadDLQMessages_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
-}
-
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ReadDLQMessages_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_RemoveTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -28771,13 +35382,13 @@ func (v *AdminService_ReadDLQMessages_Result) GetBadRequestError() (o *shared.Ba
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_ReadDLQMessages_Result) IsSetBadRequestError() bool {
+func (v *AdminService_RemoveTask_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ReadDLQMessages_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_RemoveTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -28786,63 +35397,48 @@ func (v *AdminService_ReadDLQMessages_Result) GetInternalServiceError() (o *shar
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_ReadDLQMessages_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_RemoveTask_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
-// zero value if it is unset.
-func (v *AdminService_ReadDLQMessages_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
-	if v != nil && v.ServiceBusyError != nil {
-		return v.ServiceBusyError
-	}
-
-	return
-}
-
-// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_ReadDLQMessages_Result) IsSetServiceBusyError() bool {
-	return v != nil && v.ServiceBusyError != nil
-}
-
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ReadDLQMessages_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
+func (v *AdminService_RemoveTask_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
 	}
 
 	return
 }
 
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_ReadDLQMessages_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_RemoveTask_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "ReadDLQMessages" for this struct.
-func (v *AdminService_ReadDLQMessages_Result) MethodName() string {
-	return "ReadDLQMessages"
+// This will always be "RemoveTask" for this struct.
+func (v *AdminService_RemoveTask_Result) MethodName() string {
+	return "RemoveTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_ReadDLQMessages_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RemoveTask_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_ReapplyEvents_Args represents the arguments for the AdminService.ReapplyEvents function.
+// AdminService_ResendReplicationTasks_Args represents the arguments for the AdminService.ResendReplicationTasks function.
 //
-// The arguments for ReapplyEvents are sent and received over the wire as this struct.
-type AdminService_ReapplyEvents_Args struct {
-	ReapplyEventsRequest *shared.ReapplyEventsRequest `json:"reapplyEventsRequest,omitempty"`
+// The arguments for ResendReplicationTasks are sent and received over the wire as this struct.
+type AdminService_ResendReplicationTasks_Args struct {
+	Request *ResendReplicationTasksRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_ReapplyEvents_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ResendReplicationTasks_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -28857,7 +35453,7 @@ type AdminService_ReapplyEvents_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_ReapplyEvents_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_ResendReplicationTasks_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -28865,8 +35461,8 @@ func (v *AdminService_ReapplyEvents_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.ReapplyEventsRequest != nil {
-		w, err = v.ReapplyEventsRequest.ToWire()
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -28877,17 +35473,17 @@ func (v *AdminService_ReapplyEvents_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ReapplyEventsRequest_Read(w wire.Value) (*shared.ReapplyEventsRequest, error) {
-	var v shared.ReapplyEventsRequest
+func _ResendReplicationTasksRequest_Read(w wire.Value) (*ResendReplicationTasksRequest, error) {
+	var v ResendReplicationTasksRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_ReapplyEvents_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_ResendReplicationTasks_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_ReapplyEvents_Args struct
+// An error is returned if we were unable to build a AdminService_ResendReplicationTasks_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -28895,19 +35491,19 @@ func _ReapplyEventsRequest_Read(w wire.Value) (*shared.ReapplyEventsRequest, err
 //	  return nil, err
 //	}
 //
-//	var v AdminService_ReapplyEvents_Args
+//	var v AdminService_ResendReplicationTasks_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_ReapplyEvents_Args) FromWire(w wire.Value) error {
+func (v *AdminService_ResendReplicationTasks_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.ReapplyEventsRequest, err = _ReapplyEventsRequest_Read(field.Value)
+				v.Request, err = _ResendReplicationTasksRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -28919,20 +35515,20 @@ func (v *AdminService_ReapplyEvents_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_ReapplyEvents_Args struct directly into bytes, without going
+// Encode serializes a AdminService_ResendReplicationTasks_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_ReapplyEvents_Args struct could not be encoded.
-func (v *AdminService_ReapplyEvents_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_ResendReplicationTasks_Args struct could not be encoded.
+func (v *AdminService_ResendReplicationTasks_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ReapplyEventsRequest != nil {
+	if v.Request != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.ReapplyEventsRequest.Encode(sw); err != nil {
+		if err := v.Request.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -28943,18 +35539,18 @@ func (v *AdminService_ReapplyEvents_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _ReapplyEventsRequest_Decode(sr stream.Reader) (*shared.ReapplyEventsRequest, error) {
-	var v shared.ReapplyEventsRequest
+func _ResendReplicationTasksRequest_Decode(sr stream.Reader) (*ResendReplicationTasksRequest, error) {
+	var v ResendReplicationTasksRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_ReapplyEvents_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_ResendReplicationTasks_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_ReapplyEvents_Args struct could not be generated from the wire
+// An error is returned if a AdminService_ResendReplicationTasks_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_ReapplyEvents_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_ResendReplicationTasks_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -28968,7 +35564,7 @@ func (v *AdminService_ReapplyEvents_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.ReapplyEventsRequest, err = _ReapplyEventsRequest_Decode(sr)
+			v.Request, err = _ResendReplicationTasksRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -28995,34 +35591,34 @@ func (v *AdminService_ReapplyEvents_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_ReapplyEvents_Args
+// String returns a readable string representation of a AdminService_ResendReplicationTasks_Args
 // struct.
-func (v *AdminService_ReapplyEvents_Args) String() string {
+func (v *AdminService_ResendReplicationTasks_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.ReapplyEventsRequest != nil {
-		fields[i] = fmt.Sprintf("ReapplyEventsRequest: %v", v.ReapplyEventsRequest)
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_ReapplyEvents_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ResendReplicationTasks_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_ReapplyEvents_Args match the
-// provided AdminService_ReapplyEvents_Args.
+// Equals returns true if all the fields of this AdminService_ResendReplicationTasks_Args match the
+// provided AdminService_ResendReplicationTasks_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_ReapplyEvents_Args) Equals(rhs *AdminService_ReapplyEvents_Args) bool {
+func (v *AdminService_ResendReplicationTasks_Args) Equals(rhs *AdminService_ResendReplicationTasks_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.ReapplyEventsRequest == nil && rhs.ReapplyEventsRequest == nil) || (v.ReapplyEventsRequest != nil && rhs.ReapplyEventsRequest != nil && v.ReapplyEventsRequest.Equals(rhs.ReapplyEventsRequest))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -29030,110 +35626,106 @@ func (v *AdminService_ReapplyEvents_Args) Equals(rhs *AdminService_ReapplyEvents
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_ReapplyEvents_Args.
-func (v *AdminService_ReapplyEvents_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ResendReplicationTasks_Args.
+func (v *AdminService_ResendReplicationTasks_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ReapplyEventsRequest != nil {
-		err = multierr.Append(err, enc.AddObject("reapplyEventsRequest", v.ReapplyEventsRequest))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetReapplyEventsRequest returns the value of ReapplyEventsRequest if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ReapplyEvents_Args) GetReapplyEventsRequest() (o *shared.ReapplyEventsRequest) {
-	if v != nil && v.ReapplyEventsRequest != nil {
-		return v.ReapplyEventsRequest
+func (v *AdminService_ResendReplicationTasks_Args) GetRequest() (o *ResendReplicationTasksRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetReapplyEventsRequest returns true if ReapplyEventsRequest is not nil.
-func (v *AdminService_ReapplyEvents_Args) IsSetReapplyEventsRequest() bool {
-	return v != nil && v.ReapplyEventsRequest != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_ResendReplicationTasks_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "ReapplyEvents" for this struct.
-func (v *AdminService_ReapplyEvents_Args) MethodName() string {
-	return "ReapplyEvents"
+// This will always be "ResendReplicationTasks" for this struct.
+func (v *AdminService_ResendReplicationTasks_Args) MethodName() string {
+	return "ResendReplicationTasks"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_ReapplyEvents_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ResendReplicationTasks_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_ReapplyEvents_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.ReapplyEvents
+// AdminService_ResendReplicationTasks_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ResendReplicationTasks
 // function.
-var AdminService_ReapplyEvents_Helper = struct {
-	// Args accepts the parameters of ReapplyEvents in-order and returns
+var AdminService_ResendReplicationTasks_Helper = struct {
+	// Args accepts the parameters of ResendReplicationTasks in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		reapplyEventsRequest *shared.ReapplyEventsRequest,
-	) *AdminService_ReapplyEvents_Args
+		request *ResendReplicationTasksRequest,
+	) *AdminService_ResendReplicationTasks_Args
 
 	// IsException returns true if the given error can be thrown
-	// by ReapplyEvents.
+	// by ResendReplicationTasks.
 	//
-	// An error can be thrown by ReapplyEvents only if the
+	// An error can be thrown by ResendReplicationTasks only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for ReapplyEvents
+	// WrapResponse returns the result struct for ResendReplicationTasks
 	// given the error returned by it. The provided error may
-	// be nil if ReapplyEvents did not fail.
+	// be nil if ResendReplicationTasks did not fail.
 	//
-	// This allows mapping errors returned by ReapplyEvents into a
+	// This allows mapping errors returned by ResendReplicationTasks into a
 	// serializable result struct. WrapResponse returns a
 	// non-nil error if the provided error cannot be thrown by
-	// ReapplyEvents
+	// ResendReplicationTasks
 	//
-	//   err := ReapplyEvents(args)
-	//   result, err := AdminService_ReapplyEvents_Helper.WrapResponse(err)
+	//   err := ResendReplicationTasks(args)
+	//   result, err := AdminService_ResendReplicationTasks_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from ReapplyEvents: %v", err)
+	//     return fmt.Errorf("unexpected error from ResendReplicationTasks: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*AdminService_ReapplyEvents_Result, error)
+	WrapResponse func(error) (*AdminService_ResendReplicationTasks_Result, error)
 
-	// UnwrapResponse takes the result struct for ReapplyEvents
+	// UnwrapResponse takes the result struct for ResendReplicationTasks
 	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if ReapplyEvents threw an
+	// The error is non-nil only if ResendReplicationTasks threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := AdminService_ReapplyEvents_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_ReapplyEvents_Result) error
+	//   err := AdminService_ResendReplicationTasks_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ResendReplicationTasks_Result) error
 }{}
 
 func init() {
-	AdminService_ReapplyEvents_Helper.Args = func(
-		reapplyEventsRequest *shared.ReapplyEventsRequest,
-	) *AdminService_ReapplyEvents_Args {
-		return &AdminService_ReapplyEvents_Args{
-			ReapplyEventsRequest: reapplyEventsRequest,
+	AdminService_ResendReplicationTasks_Helper.Args = func(
+		request *ResendReplicationTasksRequest,
+	) *AdminService_ResendReplicationTasks_Args {
+		return &AdminService_ResendReplicationTasks_Args{
+			Request: request,
 		}
 	}
 
-	AdminService_ReapplyEvents_Helper.IsException = func(err error) bool {
+	AdminService_ResendReplicationTasks_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
-		case *shared.DomainNotActiveError:
-			return true
-		case *shared.LimitExceededError:
-			return true
 		case *shared.ServiceBusyError:
 			return true
 		case *shared.EntityNotExistsError:
@@ -29143,54 +35735,36 @@ func init() {
 		}
 	}
 
-	AdminService_ReapplyEvents_Helper.WrapResponse = func(err error) (*AdminService_ReapplyEvents_Result, error) {
+	AdminService_ResendReplicationTasks_Helper.WrapResponse = func(err error) (*AdminService_ResendReplicationTasks_Result, error) {
 		if err == nil {
-			return &AdminService_ReapplyEvents_Result{}, nil
+			return &AdminService_ResendReplicationTasks_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReapplyEvents_Result.BadRequestError")
-			}
-			return &AdminService_ReapplyEvents_Result{BadRequestError: e}, nil
-		case *shared.DomainNotActiveError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReapplyEvents_Result.DomainNotActiveError")
-			}
-			return &AdminService_ReapplyEvents_Result{DomainNotActiveError: e}, nil
-		case *shared.LimitExceededError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReapplyEvents_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResendReplicationTasks_Result.BadRequestError")
 			}
-			return &AdminService_ReapplyEvents_Result{LimitExceededError: e}, nil
+			return &AdminService_ResendReplicationTasks_Result{BadRequestError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReapplyEvents_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResendReplicationTasks_Result.ServiceBusyError")
 			}
-			return &AdminService_ReapplyEvents_Result{ServiceBusyError: e}, nil
+			return &AdminService_ResendReplicationTasks_Result{ServiceBusyError: e}, nil
 		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReapplyEvents_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResendReplicationTasks_Result.EntityNotExistError")
 			}
-			return &AdminService_ReapplyEvents_Result{EntityNotExistError: e}, nil
+			return &AdminService_ResendReplicationTasks_Result{EntityNotExistError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_ReapplyEvents_Helper.UnwrapResponse = func(result *AdminService_ReapplyEvents_Result) (err error) {
+	AdminService_ResendReplicationTasks_Helper.UnwrapResponse = func(result *AdminService_ResendReplicationTasks_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
 		}
-		if result.DomainNotActiveError != nil {
-			err = result.DomainNotActiveError
-			return
-		}
-		if result.LimitExceededError != nil {
-			err = result.LimitExceededError
-			return
-		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
@@ -29204,18 +35778,16 @@ func init() {
 
 }
 
-// AdminService_ReapplyEvents_Result represents the result of a AdminService.ReapplyEvents function call.
+// AdminService_ResendReplicationTasks_Result represents the result of a AdminService.ResendReplicationTasks function call.
 //
-// The result of a ReapplyEvents execution is sent and received over the wire as this struct.
-type AdminService_ReapplyEvents_Result struct {
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	DomainNotActiveError *shared.DomainNotActiveError `json:"domainNotActiveError,omitempty"`
-	LimitExceededError   *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+// The result of a ResendReplicationTasks execution is sent and received over the wire as this struct.
+type AdminService_ResendReplicationTasks_Result struct {
+	BadRequestError     *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	ServiceBusyError    *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+	EntityNotExistError *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
 }
 
-// ToWire translates a AdminService_ReapplyEvents_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ResendReplicationTasks_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -29230,9 +35802,9 @@ type AdminService_ReapplyEvents_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_ReapplyEvents_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_ResendReplicationTasks_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -29246,28 +35818,12 @@ func (v *AdminService_ReapplyEvents_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.DomainNotActiveError != nil {
-		w, err = v.DomainNotActiveError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
-	}
-	if v.LimitExceededError != nil {
-		w, err = v.LimitExceededError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
 	if v.ServiceBusyError != nil {
 		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 5, Value: w}
+		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
 	if v.EntityNotExistError != nil {
@@ -29275,28 +35831,22 @@ func (v *AdminService_ReapplyEvents_Result) ToWire() (wire.Value, error) {
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 6, Value: w}
+		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
 
 	if i > 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_ReapplyEvents_Result should have at most one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_ResendReplicationTasks_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DomainNotActiveError_Read(w wire.Value) (*shared.DomainNotActiveError, error) {
-	var v shared.DomainNotActiveError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_ReapplyEvents_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_ResendReplicationTasks_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_ReapplyEvents_Result struct
+// An error is returned if we were unable to build a AdminService_ResendReplicationTasks_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -29304,12 +35854,12 @@ func _DomainNotActiveError_Read(w wire.Value) (*shared.DomainNotActiveError, err
 //	  return nil, err
 //	}
 //
-//	var v AdminService_ReapplyEvents_Result
+//	var v AdminService_ResendReplicationTasks_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_ReapplyEvents_Result) FromWire(w wire.Value) error {
+func (v *AdminService_ResendReplicationTasks_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -29322,23 +35872,7 @@ func (v *AdminService_ReapplyEvents_Result) FromWire(w wire.Value) error {
 				}
 
 			}
-		case 3:
-			if field.Value.Type() == wire.TStruct {
-				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 4:
-			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 5:
+		case 2:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
@@ -29346,7 +35880,7 @@ func (v *AdminService_ReapplyEvents_Result) FromWire(w wire.Value) error {
 				}
 
 			}
-		case 6:
+		case 3:
 			if field.Value.Type() == wire.TStruct {
 				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
@@ -29361,12 +35895,6 @@ func (v *AdminService_ReapplyEvents_Result) FromWire(w wire.Value) error {
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.DomainNotActiveError != nil {
-		count++
-	}
-	if v.LimitExceededError != nil {
-		count++
-	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
@@ -29374,17 +35902,17 @@ func (v *AdminService_ReapplyEvents_Result) FromWire(w wire.Value) error {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("AdminService_ReapplyEvents_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ResendReplicationTasks_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_ReapplyEvents_Result struct directly into bytes, without going
+// Encode serializes a AdminService_ResendReplicationTasks_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_ReapplyEvents_Result struct could not be encoded.
-func (v *AdminService_ReapplyEvents_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_ResendReplicationTasks_Result struct could not be encoded.
+func (v *AdminService_ResendReplicationTasks_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -29401,32 +35929,8 @@ func (v *AdminService_ReapplyEvents_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.DomainNotActiveError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.DomainNotActiveError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.LimitExceededError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.LimitExceededError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
 	if v.ServiceBusyError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.ServiceBusyError.Encode(sw); err != nil {
@@ -29438,7 +35942,7 @@ func (v *AdminService_ReapplyEvents_Result) Encode(sw stream.Writer) error {
 	}
 
 	if v.EntityNotExistError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.EntityNotExistError.Encode(sw); err != nil {
@@ -29453,12 +35957,6 @@ func (v *AdminService_ReapplyEvents_Result) Encode(sw stream.Writer) error {
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.DomainNotActiveError != nil {
-		count++
-	}
-	if v.LimitExceededError != nil {
-		count++
-	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
@@ -29467,24 +35965,18 @@ func (v *AdminService_ReapplyEvents_Result) Encode(sw stream.Writer) error {
 	}
 
 	if count > 1 {
-		return fmt.Errorf("AdminService_ReapplyEvents_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ResendReplicationTasks_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _DomainNotActiveError_Decode(sr stream.Reader) (*shared.DomainNotActiveError, error) {
-	var v shared.DomainNotActiveError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-// Decode deserializes a AdminService_ReapplyEvents_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_ResendReplicationTasks_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_ReapplyEvents_Result struct could not be generated from the wire
+// An error is returned if a AdminService_ResendReplicationTasks_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_ReapplyEvents_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_ResendReplicationTasks_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -29503,25 +35995,13 @@ func (v *AdminService_ReapplyEvents_Result) Decode(sr stream.Reader) error {
 				return err
 			}
 
-		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.DomainNotActiveError, err = _DomainNotActiveError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 4 && fh.Type == wire.TStruct:
-			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 5 && fh.Type == wire.TStruct:
+		case fh.ID == 2 && fh.Type == wire.TStruct:
 			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 6 && fh.Type == wire.TStruct:
+		case fh.ID == 3 && fh.Type == wire.TStruct:
 			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
 			if err != nil {
 				return err
@@ -29550,12 +36030,6 @@ func (v *AdminService_ReapplyEvents_Result) Decode(sr stream.Reader) error {
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.DomainNotActiveError != nil {
-		count++
-	}
-	if v.LimitExceededError != nil {
-		count++
-	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
@@ -29563,33 +36037,25 @@ func (v *AdminService_ReapplyEvents_Result) Decode(sr stream.Reader) error {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("AdminService_ReapplyEvents_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ResendReplicationTasks_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_ReapplyEvents_Result
+// String returns a readable string representation of a AdminService_ResendReplicationTasks_Result
 // struct.
-func (v *AdminService_ReapplyEvents_Result) String() string {
+func (v *AdminService_ResendReplicationTasks_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [3]string
 	i := 0
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.DomainNotActiveError != nil {
-		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
-		i++
-	}
-	if v.LimitExceededError != nil {
-		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
-		i++
-	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
@@ -29599,14 +36065,14 @@ func (v *AdminService_ReapplyEvents_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_ReapplyEvents_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ResendReplicationTasks_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_ReapplyEvents_Result match the
-// provided AdminService_ReapplyEvents_Result.
+// Equals returns true if all the fields of this AdminService_ResendReplicationTasks_Result match the
+// provided AdminService_ResendReplicationTasks_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_ReapplyEvents_Result) Equals(rhs *AdminService_ReapplyEvents_Result) bool {
+func (v *AdminService_ResendReplicationTasks_Result) Equals(rhs *AdminService_ResendReplicationTasks_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -29615,12 +36081,6 @@ func (v *AdminService_ReapplyEvents_Result) Equals(rhs *AdminService_ReapplyEven
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
-		return false
-	}
-	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
-		return false
-	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
@@ -29632,20 +36092,14 @@ func (v *AdminService_ReapplyEvents_Result) Equals(rhs *AdminService_ReapplyEven
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_ReapplyEvents_Result.
-func (v *AdminService_ReapplyEvents_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ResendReplicationTasks_Result.
+func (v *AdminService_ResendReplicationTasks_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.DomainNotActiveError != nil {
-		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
-	}
-	if v.LimitExceededError != nil {
-		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
-	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
@@ -29657,7 +36111,7 @@ func (v *AdminService_ReapplyEvents_Result) MarshalLogObject(enc zapcore.ObjectE
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ReapplyEvents_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_ResendReplicationTasks_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -29666,43 +36120,13 @@ func (v *AdminService_ReapplyEvents_Result) GetBadRequestError() (o *shared.BadR
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_ReapplyEvents_Result) IsSetBadRequestError() bool {
+func (v *AdminService_ResendReplicationTasks_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
-// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
-// zero value if it is unset.
-func (v *AdminService_ReapplyEvents_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
-	if v != nil && v.DomainNotActiveError != nil {
-		return v.DomainNotActiveError
-	}
-
-	return
-}
-
-// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
-func (v *AdminService_ReapplyEvents_Result) IsSetDomainNotActiveError() bool {
-	return v != nil && v.DomainNotActiveError != nil
-}
-
-// GetLimitExceededError returns the value of LimitExceededError if it is set or its
-// zero value if it is unset.
-func (v *AdminService_ReapplyEvents_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
-	if v != nil && v.LimitExceededError != nil {
-		return v.LimitExceededError
-	}
-
-	return
-}
-
-// IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *AdminService_ReapplyEvents_Result) IsSetLimitExceededError() bool {
-	return v != nil && v.LimitExceededError != nil
-}
-
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ReapplyEvents_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *AdminService_ResendReplicationTasks_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -29711,13 +36135,13 @@ func (v *AdminService_ReapplyEvents_Result) GetServiceBusyError() (o *shared.Ser
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_ReapplyEvents_Result) IsSetServiceBusyError() bool {
+func (v *AdminService_ResendReplicationTasks_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ReapplyEvents_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+func (v *AdminService_ResendReplicationTasks_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
 	if v != nil && v.EntityNotExistError != nil {
 		return v.EntityNotExistError
 	}
@@ -29726,33 +36150,33 @@ func (v *AdminService_ReapplyEvents_Result) GetEntityNotExistError() (o *shared.
 }
 
 // IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_ReapplyEvents_Result) IsSetEntityNotExistError() bool {
+func (v *AdminService_ResendReplicationTasks_Result) IsSetEntityNotExistError() bool {
 	return v != nil && v.EntityNotExistError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "ReapplyEvents" for this struct.
-func (v *AdminService_ReapplyEvents_Result) MethodName() string {
-	return "ReapplyEvents"
+// This will always be "ResendReplicationTasks" for this struct.
+func (v *AdminService_ResendReplicationTasks_Result) MethodName() string {
+	return "ResendReplicationTasks"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_ReapplyEvents_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ResendReplicationTasks_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_RefreshWorkflowTasks_Args represents the arguments for the AdminService.RefreshWorkflowTasks function.
+// AdminService_ResetActivity_Args represents the arguments for the AdminService.ResetActivity function.
 //
-// The arguments for RefreshWorkflowTasks are sent and received over the wire as this struct.
-type AdminService_RefreshWorkflowTasks_Args struct {
-	Request *shared.RefreshWorkflowTasksRequest `json:"request,omitempty"`
+// The arguments for ResetActivity are sent and received over the wire as this struct.
+type AdminService_ResetActivity_Args struct {
+	Request *shared.ResetActivityRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_RefreshWorkflowTasks_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ResetActivity_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -29767,7 +36191,7 @@ type AdminService_RefreshWorkflowTasks_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_RefreshWorkflowTasks_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_ResetActivity_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -29787,17 +36211,17 @@ func (v *AdminService_RefreshWorkflowTasks_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RefreshWorkflowTasksRequest_Read(w wire.Value) (*shared.RefreshWorkflowTasksRequest, error) {
-	var v shared.RefreshWorkflowTasksRequest
+func _ResetActivityRequest_Read(w wire.Value) (*shared.ResetActivityRequest, error) {
+	var v shared.ResetActivityRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_RefreshWorkflowTasks_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_ResetActivity_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_RefreshWorkflowTasks_Args struct
+// An error is returned if we were unable to build a AdminService_ResetActivity_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -29805,19 +36229,19 @@ func _RefreshWorkflowTasksRequest_Read(w wire.Value) (*shared.RefreshWorkflowTas
 //	  return nil, err
 //	}
 //
-//	var v AdminService_RefreshWorkflowTasks_Args
+//	var v AdminService_ResetActivity_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_RefreshWorkflowTasks_Args) FromWire(w wire.Value) error {
+func (v *AdminService_ResetActivity_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _RefreshWorkflowTasksRequest_Read(field.Value)
+				v.Request, err = _ResetActivityRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -29829,11 +36253,11 @@ func (v *AdminService_RefreshWorkflowTasks_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_RefreshWorkflowTasks_Args struct directly into bytes, without going
+// Encode serializes a AdminService_ResetActivity_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_RefreshWorkflowTasks_Args struct could not be encoded.
-func (v *AdminService_RefreshWorkflowTasks_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_ResetActivity_Args struct could not be encoded.
+func (v *AdminService_ResetActivity_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -29853,18 +36277,18 @@ func (v *AdminService_RefreshWorkflowTasks_Args) Encode(sw stream.Writer) error
 	return sw.WriteStructEnd()
 }
 
-func _RefreshWorkflowTasksRequest_Decode(sr stream.Reader) (*shared.RefreshWorkflowTasksRequest, error) {
-	var v shared.RefreshWorkflowTasksRequest
+func _ResetActivityRequest_Decode(sr stream.Reader) (*shared.ResetActivityRequest, error) {
+	var v shared.ResetActivityRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_RefreshWorkflowTasks_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_ResetActivity_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_RefreshWorkflowTasks_Args struct could not be generated from the wire
+// An error is returned if a AdminService_ResetActivity_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_RefreshWorkflowTasks_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_ResetActivity_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -29878,7 +36302,7 @@ func (v *AdminService_RefreshWorkflowTasks_Args) Decode(sr stream.Reader) error
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _RefreshWorkflowTasksRequest_Decode(sr)
+			v.Request, err = _ResetActivityRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -29905,9 +36329,9 @@ func (v *AdminService_RefreshWorkflowTasks_Args) Decode(sr stream.Reader) error
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_RefreshWorkflowTasks_Args
+// String returns a readable string representation of a AdminService_ResetActivity_Args
 // struct.
-func (v *AdminService_RefreshWorkflowTasks_Args) String() string {
+func (v *AdminService_ResetActivity_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -29919,14 +36343,14 @@ func (v *AdminService_RefreshWorkflowTasks_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_RefreshWorkflowTasks_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ResetActivity_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_RefreshWorkflowTasks_Args match the
-// provided AdminService_RefreshWorkflowTasks_Args.
+// Equals returns true if all the fields of this AdminService_ResetActivity_Args match the
+// provided AdminService_ResetActivity_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_RefreshWorkflowTasks_Args) Equals(rhs *AdminService_RefreshWorkflowTasks_Args) bool {
+func (v *AdminService_ResetActivity_Args) Equals(rhs *AdminService_ResetActivity_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -29940,8 +36364,8 @@ func (v *AdminService_RefreshWorkflowTasks_Args) Equals(rhs *AdminService_Refres
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_RefreshWorkflowTasks_Args.
-func (v *AdminService_RefreshWorkflowTasks_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ResetActivity_Args.
+func (v *AdminService_ResetActivity_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -29953,7 +36377,7 @@ func (v *AdminService_RefreshWorkflowTasks_Args) MarshalLogObject(enc zapcore.Ob
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RefreshWorkflowTasks_Args) GetRequest() (o *shared.RefreshWorkflowTasksRequest) {
+func (v *AdminService_ResetActivity_Args) GetRequest() (o *shared.ResetActivityRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -29962,81 +36386,81 @@ func (v *AdminService_RefreshWorkflowTasks_Args) GetRequest() (o *shared.Refresh
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_RefreshWorkflowTasks_Args) IsSetRequest() bool {
+func (v *AdminService_ResetActivity_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "RefreshWorkflowTasks" for this struct.
-func (v *AdminService_RefreshWorkflowTasks_Args) MethodName() string {
-	return "RefreshWorkflowTasks"
+// This will always be "ResetActivity" for this struct.
+func (v *AdminService_ResetActivity_Args) MethodName() string {
+	return "ResetActivity"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_RefreshWorkflowTasks_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ResetActivity_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_RefreshWorkflowTasks_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.RefreshWorkflowTasks
+// AdminService_ResetActivity_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ResetActivity
 // function.
-var AdminService_RefreshWorkflowTasks_Helper = struct {
-	// Args accepts the parameters of RefreshWorkflowTasks in-order and returns
+var AdminService_ResetActivity_Helper = struct {
+	// Args accepts the parameters of ResetActivity in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.RefreshWorkflowTasksRequest,
-	) *AdminService_RefreshWorkflowTasks_Args
+		request *shared.ResetActivityRequest,
+	) *AdminService_ResetActivity_Args
 
 	// IsException returns true if the given error can be thrown
-	// by RefreshWorkflowTasks.
+	// by ResetActivity.
 	//
-	// An error can be thrown by RefreshWorkflowTasks only if the
+	// An error can be thrown by ResetActivity only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for RefreshWorkflowTasks
+	// WrapResponse returns the result struct for ResetActivity
 	// given the error returned by it. The provided error may
-	// be nil if RefreshWorkflowTasks did not fail.
+	// be nil if ResetActivity did not fail.
 	//
-	// This allows mapping errors returned by RefreshWorkflowTasks into a
+	// This allows mapping errors returned by ResetActivity into a
 	// serializable result struct. WrapResponse returns a
 	// non-nil error if the provided error cannot be thrown by
-	// RefreshWorkflowTasks
+	// ResetActivity
 	//
-	//   err := RefreshWorkflowTasks(args)
-	//   result, err := AdminService_RefreshWorkflowTasks_Helper.WrapResponse(err)
+	//   err := ResetActivity(args)
+	//   result, err := AdminService_ResetActivity_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from RefreshWorkflowTasks: %v", err)
+	//     return fmt.Errorf("unexpected error from ResetActivity: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*AdminService_RefreshWorkflowTasks_Result, error)
+	WrapResponse func(error) (*AdminService_ResetActivity_Result, error)
 
-	// UnwrapResponse takes the result struct for RefreshWorkflowTasks
+	// UnwrapResponse takes the result struct for ResetActivity
 	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if RefreshWorkflowTasks threw an
+	// The error is non-nil only if ResetActivity threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := AdminService_RefreshWorkflowTasks_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_RefreshWorkflowTasks_Result) error
+	//   err := AdminService_ResetActivity_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ResetActivity_Result) error
 }{}
 
 func init() {
-	AdminService_RefreshWorkflowTasks_Helper.Args = func(
-		request *shared.RefreshWorkflowTasksRequest,
-	) *AdminService_RefreshWorkflowTasks_Args {
-		return &AdminService_RefreshWorkflowTasks_Args{
+	AdminService_ResetActivity_Helper.Args = func(
+		request *shared.ResetActivityRequest,
+	) *AdminService_ResetActivity_Args {
+		return &AdminService_ResetActivity_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_RefreshWorkflowTasks_Helper.IsException = func(err error) bool {
+	AdminService_ResetActivity_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -30046,42 +36470,49 @@ func init() {
 			return true
 		case *shared.EntityNotExistsError:
 			return true
+		case *shared.WorkflowExecutionAlreadyCompletedError:
+			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_RefreshWorkflowTasks_Helper.WrapResponse = func(err error) (*AdminService_RefreshWorkflowTasks_Result, error) {
+	AdminService_ResetActivity_Helper.WrapResponse = func(err error) (*AdminService_ResetActivity_Result, error) {
 		if err == nil {
-			return &AdminService_RefreshWorkflowTasks_Result{}, nil
+			return &AdminService_ResetActivity_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RefreshWorkflowTasks_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetActivity_Result.BadRequestError")
 			}
-			return &AdminService_RefreshWorkflowTasks_Result{BadRequestError: e}, nil
+			return &AdminService_ResetActivity_Result{BadRequestError: e}, nil
 		case *shared.DomainNotActiveError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RefreshWorkflowTasks_Result.DomainNotActiveError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetActivity_Result.DomainNotActiveError")
 			}
-			return &AdminService_RefreshWorkflowTasks_Result{DomainNotActiveError: e}, nil
+			return &AdminService_ResetActivity_Result{DomainNotActiveError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RefreshWorkflowTasks_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetActivity_Result.ServiceBusyError")
 			}
-			return &AdminService_RefreshWorkflowTasks_Result{ServiceBusyError: e}, nil
+			return &AdminService_ResetActivity_Result{ServiceBusyError: e}, nil
 		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RefreshWorkflowTasks_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetActivity_Result.EntityNotExistError")
 			}
-			return &AdminService_RefreshWorkflowTasks_Result{EntityNotExistError: e}, nil
+			return &AdminService_ResetActivity_Result{EntityNotExistError: e}, nil
+		case *shared.WorkflowExecutionAlreadyCompletedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetActivity_Result.WorkflowExecutionAlreadyCompletedError")
+			}
+			return &AdminService_ResetActivity_Result{WorkflowExecutionAlreadyCompletedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_RefreshWorkflowTasks_Helper.UnwrapResponse = func(result *AdminService_RefreshWorkflowTasks_Result) (err error) {
+	AdminService_ResetActivity_Helper.UnwrapResponse = func(result *AdminService_ResetActivity_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -30098,22 +36529,27 @@ func init() {
 			err = result.EntityNotExistError
 			return
 		}
+		if result.WorkflowExecutionAlreadyCompletedError != nil {
+			err = result.WorkflowExecutionAlreadyCompletedError
+			return
+		}
 		return
 	}
 
 }
 
-// AdminService_RefreshWorkflowTasks_Result represents the result of a AdminService.RefreshWorkflowTasks function call.
+// AdminService_ResetActivity_Result represents the result of a AdminService.ResetActivity function call.
 //
-// The result of a RefreshWorkflowTasks execution is sent and received over the wire as this struct.
-type AdminService_RefreshWorkflowTasks_Result struct {
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	DomainNotActiveError *shared.DomainNotActiveError `json:"domainNotActiveError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+// The result of a ResetActivity execution is sent and received over the wire as this struct.
+type AdminService_ResetActivity_Result struct {
+	BadRequestError                        *shared.BadRequestError                        `json:"badRequestError,omitempty"`
+	DomainNotActiveError                   *shared.DomainNotActiveError                   `json:"domainNotActiveError,omitempty"`
+	ServiceBusyError                       *shared.ServiceBusyError                       `json:"serviceBusyError,omitempty"`
+	EntityNotExistError                    *shared.EntityNotExistsError                   `json:"entityNotExistError,omitempty"`
+	WorkflowExecutionAlreadyCompletedError *shared.WorkflowExecutionAlreadyCompletedError `json:"workflowExecutionAlreadyCompletedError,omitempty"`
 }
 
-// ToWire translates a AdminService_RefreshWorkflowTasks_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ResetActivity_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -30128,9 +36564,9 @@ type AdminService_RefreshWorkflowTasks_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_RefreshWorkflowTasks_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_ResetActivity_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -30168,19 +36604,27 @@ func (v *AdminService_RefreshWorkflowTasks_Result) ToWire() (wire.Value, error)
 		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		w, err = v.WorkflowExecutionAlreadyCompletedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
 
 	if i > 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_RefreshWorkflowTasks_Result should have at most one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_ResetActivity_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a AdminService_RefreshWorkflowTasks_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_ResetActivity_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_RefreshWorkflowTasks_Result struct
+// An error is returned if we were unable to build a AdminService_ResetActivity_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -30188,12 +36632,12 @@ func (v *AdminService_RefreshWorkflowTasks_Result) ToWire() (wire.Value, error)
 //	  return nil, err
 //	}
 //
-//	var v AdminService_RefreshWorkflowTasks_Result
+//	var v AdminService_ResetActivity_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_RefreshWorkflowTasks_Result) FromWire(w wire.Value) error {
+func (v *AdminService_ResetActivity_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -30229,6 +36673,14 @@ func (v *AdminService_RefreshWorkflowTasks_Result) FromWire(w wire.Value) error
 					return err
 				}
 
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -30246,18 +36698,21 @@ func (v *AdminService_RefreshWorkflowTasks_Result) FromWire(w wire.Value) error
 	if v.EntityNotExistError != nil {
 		count++
 	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		count++
+	}
 	if count > 1 {
-		return fmt.Errorf("AdminService_RefreshWorkflowTasks_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ResetActivity_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_RefreshWorkflowTasks_Result struct directly into bytes, without going
+// Encode serializes a AdminService_ResetActivity_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_RefreshWorkflowTasks_Result struct could not be encoded.
-func (v *AdminService_RefreshWorkflowTasks_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_ResetActivity_Result struct could not be encoded.
+func (v *AdminService_ResetActivity_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -30310,6 +36765,18 @@ func (v *AdminService_RefreshWorkflowTasks_Result) Encode(sw stream.Writer) erro
 		}
 	}
 
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecutionAlreadyCompletedError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	count := 0
 	if v.BadRequestError != nil {
 		count++
@@ -30323,20 +36790,23 @@ func (v *AdminService_RefreshWorkflowTasks_Result) Encode(sw stream.Writer) erro
 	if v.EntityNotExistError != nil {
 		count++
 	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		count++
+	}
 
 	if count > 1 {
-		return fmt.Errorf("AdminService_RefreshWorkflowTasks_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ResetActivity_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a AdminService_RefreshWorkflowTasks_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_ResetActivity_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_RefreshWorkflowTasks_Result struct could not be generated from the wire
+// An error is returned if a AdminService_ResetActivity_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_RefreshWorkflowTasks_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_ResetActivity_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -30373,6 +36843,12 @@ func (v *AdminService_RefreshWorkflowTasks_Result) Decode(sr stream.Reader) erro
 				return err
 			}
 
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -30405,21 +36881,24 @@ func (v *AdminService_RefreshWorkflowTasks_Result) Decode(sr stream.Reader) erro
 	if v.EntityNotExistError != nil {
 		count++
 	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		count++
+	}
 	if count > 1 {
-		return fmt.Errorf("AdminService_RefreshWorkflowTasks_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ResetActivity_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_RefreshWorkflowTasks_Result
+// String returns a readable string representation of a AdminService_ResetActivity_Result
 // struct.
-func (v *AdminService_RefreshWorkflowTasks_Result) String() string {
+func (v *AdminService_ResetActivity_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
@@ -30437,15 +36916,19 @@ func (v *AdminService_RefreshWorkflowTasks_Result) String() string {
 		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
 		i++
 	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionAlreadyCompletedError: %v", v.WorkflowExecutionAlreadyCompletedError)
+		i++
+	}
 
-	return fmt.Sprintf("AdminService_RefreshWorkflowTasks_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ResetActivity_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_RefreshWorkflowTasks_Result match the
-// provided AdminService_RefreshWorkflowTasks_Result.
+// Equals returns true if all the fields of this AdminService_ResetActivity_Result match the
+// provided AdminService_ResetActivity_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_RefreshWorkflowTasks_Result) Equals(rhs *AdminService_RefreshWorkflowTasks_Result) bool {
+func (v *AdminService_ResetActivity_Result) Equals(rhs *AdminService_ResetActivity_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -30463,13 +36946,16 @@ func (v *AdminService_RefreshWorkflowTasks_Result) Equals(rhs *AdminService_Refr
 	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
+	if !((v.WorkflowExecutionAlreadyCompletedError == nil && rhs.WorkflowExecutionAlreadyCompletedError == nil) || (v.WorkflowExecutionAlreadyCompletedError != nil && rhs.WorkflowExecutionAlreadyCompletedError != nil && v.WorkflowExecutionAlreadyCompletedError.Equals(rhs.WorkflowExecutionAlreadyCompletedError))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_RefreshWorkflowTasks_Result.
-func (v *AdminService_RefreshWorkflowTasks_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ResetActivity_Result.
+func (v *AdminService_ResetActivity_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -30485,12 +36971,15 @@ func (v *AdminService_RefreshWorkflowTasks_Result) MarshalLogObject(enc zapcore.
 	if v.EntityNotExistError != nil {
 		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
 	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecutionAlreadyCompletedError", v.WorkflowExecutionAlreadyCompletedError))
+	}
 	return err
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RefreshWorkflowTasks_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_ResetActivity_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -30499,13 +36988,13 @@ func (v *AdminService_RefreshWorkflowTasks_Result) GetBadRequestError() (o *shar
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_RefreshWorkflowTasks_Result) IsSetBadRequestError() bool {
+func (v *AdminService_ResetActivity_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RefreshWorkflowTasks_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+func (v *AdminService_ResetActivity_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
 	if v != nil && v.DomainNotActiveError != nil {
 		return v.DomainNotActiveError
 	}
@@ -30514,13 +37003,13 @@ func (v *AdminService_RefreshWorkflowTasks_Result) GetDomainNotActiveError() (o
 }
 
 // IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
-func (v *AdminService_RefreshWorkflowTasks_Result) IsSetDomainNotActiveError() bool {
+func (v *AdminService_ResetActivity_Result) IsSetDomainNotActiveError() bool {
 	return v != nil && v.DomainNotActiveError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RefreshWorkflowTasks_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *AdminService_ResetActivity_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -30529,13 +37018,13 @@ func (v *AdminService_RefreshWorkflowTasks_Result) GetServiceBusyError() (o *sha
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_RefreshWorkflowTasks_Result) IsSetServiceBusyError() bool {
+func (v *AdminService_ResetActivity_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RefreshWorkflowTasks_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+func (v *AdminService_ResetActivity_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
 	if v != nil && v.EntityNotExistError != nil {
 		return v.EntityNotExistError
 	}
@@ -30544,33 +37033,48 @@ func (v *AdminService_RefreshWorkflowTasks_Result) GetEntityNotExistError() (o *
 }
 
 // IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_RefreshWorkflowTasks_Result) IsSetEntityNotExistError() bool {
+func (v *AdminService_ResetActivity_Result) IsSetEntityNotExistError() bool {
 	return v != nil && v.EntityNotExistError != nil
 }
 
+// GetWorkflowExecutionAlreadyCompletedError returns the value of WorkflowExecutionAlreadyCompletedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ResetActivity_Result) GetWorkflowExecutionAlreadyCompletedError() (o *shared.WorkflowExecutionAlreadyCompletedError) {
+	if v != nil && v.WorkflowExecutionAlreadyCompletedError != nil {
+		return v.WorkflowExecutionAlreadyCompletedError
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionAlreadyCompletedError returns true if WorkflowExecutionAlreadyCompletedError is not nil.
+func (v *AdminService_ResetActivity_Result) IsSetWorkflowExecutionAlreadyCompletedError() bool {
+	return v != nil && v.WorkflowExecutionAlreadyCompletedError != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "RefreshWorkflowTasks" for this struct.
-func (v *AdminService_RefreshWorkflowTasks_Result) MethodName() string {
-	return "RefreshWorkflowTasks"
+// This will always be "ResetActivity" for this struct.
+func (v *AdminService_ResetActivity_Result) MethodName() string {
+	return "ResetActivity"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_RefreshWorkflowTasks_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ResetActivity_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_RemoveTask_Args represents the arguments for the AdminService.RemoveTask function.
+// AdminService_ResetQueue_Args represents the arguments for the AdminService.ResetQueue function.
 //
-// The arguments for RemoveTask are sent and received over the wire as this struct.
-type AdminService_RemoveTask_Args struct {
-	Request *shared.RemoveTaskRequest `json:"request,omitempty"`
+// The arguments for ResetQueue are sent and received over the wire as this struct.
+type AdminService_ResetQueue_Args struct {
+	Request *shared.ResetQueueRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_RemoveTask_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ResetQueue_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -30585,7 +37089,7 @@ type AdminService_RemoveTask_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_RemoveTask_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_ResetQueue_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -30605,17 +37109,17 @@ func (v *AdminService_RemoveTask_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RemoveTaskRequest_Read(w wire.Value) (*shared.RemoveTaskRequest, error) {
-	var v shared.RemoveTaskRequest
+func _ResetQueueRequest_Read(w wire.Value) (*shared.ResetQueueRequest, error) {
+	var v shared.ResetQueueRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_RemoveTask_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_ResetQueue_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_RemoveTask_Args struct
+// An error is returned if we were unable to build a AdminService_ResetQueue_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -30623,19 +37127,19 @@ func _RemoveTaskRequest_Read(w wire.Value) (*shared.RemoveTaskRequest, error) {
 //	  return nil, err
 //	}
 //
-//	var v AdminService_RemoveTask_Args
+//	var v AdminService_ResetQueue_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_RemoveTask_Args) FromWire(w wire.Value) error {
+func (v *AdminService_ResetQueue_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _RemoveTaskRequest_Read(field.Value)
+				v.Request, err = _ResetQueueRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -30647,11 +37151,11 @@ func (v *AdminService_RemoveTask_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_RemoveTask_Args struct directly into bytes, without going
+// Encode serializes a AdminService_ResetQueue_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_RemoveTask_Args struct could not be encoded.
-func (v *AdminService_RemoveTask_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_ResetQueue_Args struct could not be encoded.
+func (v *AdminService_ResetQueue_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -30671,18 +37175,18 @@ func (v *AdminService_RemoveTask_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _RemoveTaskRequest_Decode(sr stream.Reader) (*shared.RemoveTaskRequest, error) {
-	var v shared.RemoveTaskRequest
+func _ResetQueueRequest_Decode(sr stream.Reader) (*shared.ResetQueueRequest, error) {
+	var v shared.ResetQueueRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_RemoveTask_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_ResetQueue_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_RemoveTask_Args struct could not be generated from the wire
+// An error is returned if a AdminService_ResetQueue_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_RemoveTask_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_ResetQueue_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -30696,7 +37200,7 @@ func (v *AdminService_RemoveTask_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _RemoveTaskRequest_Decode(sr)
+			v.Request, err = _ResetQueueRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -30723,9 +37227,9 @@ func (v *AdminService_RemoveTask_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_RemoveTask_Args
+// String returns a readable string representation of a AdminService_ResetQueue_Args
 // struct.
-func (v *AdminService_RemoveTask_Args) String() string {
+func (v *AdminService_ResetQueue_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -30737,14 +37241,14 @@ func (v *AdminService_RemoveTask_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_RemoveTask_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ResetQueue_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_RemoveTask_Args match the
-// provided AdminService_RemoveTask_Args.
+// Equals returns true if all the fields of this AdminService_ResetQueue_Args match the
+// provided AdminService_ResetQueue_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_RemoveTask_Args) Equals(rhs *AdminService_RemoveTask_Args) bool {
+func (v *AdminService_ResetQueue_Args) Equals(rhs *AdminService_ResetQueue_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -30758,8 +37262,8 @@ func (v *AdminService_RemoveTask_Args) Equals(rhs *AdminService_RemoveTask_Args)
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_RemoveTask_Args.
-func (v *AdminService_RemoveTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ResetQueue_Args.
+func (v *AdminService_ResetQueue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -30771,7 +37275,7 @@ func (v *AdminService_RemoveTask_Args) MarshalLogObject(enc zapcore.ObjectEncode
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RemoveTask_Args) GetRequest() (o *shared.RemoveTaskRequest) {
+func (v *AdminService_ResetQueue_Args) GetRequest() (o *shared.ResetQueueRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -30780,81 +37284,81 @@ func (v *AdminService_RemoveTask_Args) GetRequest() (o *shared.RemoveTaskRequest
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_RemoveTask_Args) IsSetRequest() bool {
+func (v *AdminService_ResetQueue_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "RemoveTask" for this struct.
-func (v *AdminService_RemoveTask_Args) MethodName() string {
-	return "RemoveTask"
+// This will always be "ResetQueue" for this struct.
+func (v *AdminService_ResetQueue_Args) MethodName() string {
+	return "ResetQueue"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_RemoveTask_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ResetQueue_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_RemoveTask_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.RemoveTask
+// AdminService_ResetQueue_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ResetQueue
 // function.
-var AdminService_RemoveTask_Helper = struct {
-	// Args accepts the parameters of RemoveTask in-order and returns
+var AdminService_ResetQueue_Helper = struct {
+	// Args accepts the parameters of ResetQueue in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.RemoveTaskRequest,
-	) *AdminService_RemoveTask_Args
+		request *shared.ResetQueueRequest,
+	) *AdminService_ResetQueue_Args
 
 	// IsException returns true if the given error can be thrown
-	// by RemoveTask.
+	// by ResetQueue.
 	//
-	// An error can be thrown by RemoveTask only if the
+	// An error can be thrown by ResetQueue only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for RemoveTask
+	// WrapResponse returns the result struct for ResetQueue
 	// given the error returned by it. The provided error may
-	// be nil if RemoveTask did not fail.
+	// be nil if ResetQueue did not fail.
 	//
-	// This allows mapping errors returned by RemoveTask into a
+	// This allows mapping errors returned by ResetQueue into a
 	// serializable result struct. WrapResponse returns a
 	// non-nil error if the provided error cannot be thrown by
-	// RemoveTask
+	// ResetQueue
 	//
-	//   err := RemoveTask(args)
-	//   result, err := AdminService_RemoveTask_Helper.WrapResponse(err)
+	//   err := ResetQueue(args)
+	//   result, err := AdminService_ResetQueue_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from RemoveTask: %v", err)
+	//     return fmt.Errorf("unexpected error from ResetQueue: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*AdminService_RemoveTask_Result, error)
+	WrapResponse func(error) (*AdminService_ResetQueue_Result, error)
 
-	// UnwrapResponse takes the result struct for RemoveTask
+	// UnwrapResponse takes the result struct for ResetQueue
 	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if RemoveTask threw an
+	// The error is non-nil only if ResetQueue threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := AdminService_RemoveTask_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_RemoveTask_Result) error
+	//   err := AdminService_ResetQueue_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ResetQueue_Result) error
 }{}
 
 func init() {
-	AdminService_RemoveTask_Helper.Args = func(
-		request *shared.RemoveTaskRequest,
-	) *AdminService_RemoveTask_Args {
-		return &AdminService_RemoveTask_Args{
+	AdminService_ResetQueue_Helper.Args = func(
+		request *shared.ResetQueueRequest,
+	) *AdminService_ResetQueue_Args {
+		return &AdminService_ResetQueue_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_RemoveTask_Helper.IsException = func(err error) bool {
+	AdminService_ResetQueue_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -30867,32 +37371,32 @@ func init() {
 		}
 	}
 
-	AdminService_RemoveTask_Helper.WrapResponse = func(err error) (*AdminService_RemoveTask_Result, error) {
+	AdminService_ResetQueue_Helper.WrapResponse = func(err error) (*AdminService_ResetQueue_Result, error) {
 		if err == nil {
-			return &AdminService_RemoveTask_Result{}, nil
+			return &AdminService_ResetQueue_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetQueue_Result.BadRequestError")
 			}
-			return &AdminService_RemoveTask_Result{BadRequestError: e}, nil
+			return &AdminService_ResetQueue_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetQueue_Result.InternalServiceError")
 			}
-			return &AdminService_RemoveTask_Result{InternalServiceError: e}, nil
+			return &AdminService_ResetQueue_Result{InternalServiceError: e}, nil
 		case *shared.AccessDeniedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetQueue_Result.AccessDeniedError")
 			}
-			return &AdminService_RemoveTask_Result{AccessDeniedError: e}, nil
+			return &AdminService_ResetQueue_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_RemoveTask_Helper.UnwrapResponse = func(result *AdminService_RemoveTask_Result) (err error) {
+	AdminService_ResetQueue_Helper.UnwrapResponse = func(result *AdminService_ResetQueue_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -30910,16 +37414,16 @@ func init() {
 
 }
 
-// AdminService_RemoveTask_Result represents the result of a AdminService.RemoveTask function call.
+// AdminService_ResetQueue_Result represents the result of a AdminService.ResetQueue function call.
 //
-// The result of a RemoveTask execution is sent and received over the wire as this struct.
-type AdminService_RemoveTask_Result struct {
+// The result of a ResetQueue execution is sent and received over the wire as this struct.
+type AdminService_ResetQueue_Result struct {
 	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
 	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
 	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_RemoveTask_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ResetQueue_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -30934,7 +37438,7 @@ type AdminService_RemoveTask_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_RemoveTask_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_ResetQueue_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [3]wire.Field
 		i      int = 0
@@ -30968,17 +37472,17 @@ func (v *AdminService_RemoveTask_Result) ToWire() (wire.Value, error) {
 	}
 
 	if i > 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_RemoveTask_Result should have at most one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_ResetQueue_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a AdminService_RemoveTask_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_ResetQueue_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_RemoveTask_Result struct
+// An error is returned if we were unable to build a AdminService_ResetQueue_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -30986,12 +37490,12 @@ func (v *AdminService_RemoveTask_Result) ToWire() (wire.Value, error) {
 //	  return nil, err
 //	}
 //
-//	var v AdminService_RemoveTask_Result
+//	var v AdminService_ResetQueue_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_RemoveTask_Result) FromWire(w wire.Value) error {
+func (v *AdminService_ResetQueue_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -31034,17 +37538,17 @@ func (v *AdminService_RemoveTask_Result) FromWire(w wire.Value) error {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("AdminService_RemoveTask_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ResetQueue_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_RemoveTask_Result struct directly into bytes, without going
+// Encode serializes a AdminService_ResetQueue_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_RemoveTask_Result struct could not be encoded.
-func (v *AdminService_RemoveTask_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_ResetQueue_Result struct could not be encoded.
+func (v *AdminService_ResetQueue_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -31097,18 +37601,18 @@ func (v *AdminService_RemoveTask_Result) Encode(sw stream.Writer) error {
 	}
 
 	if count > 1 {
-		return fmt.Errorf("AdminService_RemoveTask_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ResetQueue_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a AdminService_RemoveTask_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_ResetQueue_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_RemoveTask_Result struct could not be generated from the wire
+// An error is returned if a AdminService_ResetQueue_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_RemoveTask_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_ResetQueue_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -31169,15 +37673,15 @@ func (v *AdminService_RemoveTask_Result) Decode(sr stream.Reader) error {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("AdminService_RemoveTask_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ResetQueue_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_RemoveTask_Result
+// String returns a readable string representation of a AdminService_ResetQueue_Result
 // struct.
-func (v *AdminService_RemoveTask_Result) String() string {
+func (v *AdminService_ResetQueue_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -31197,14 +37701,14 @@ func (v *AdminService_RemoveTask_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_RemoveTask_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ResetQueue_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_RemoveTask_Result match the
-// provided AdminService_RemoveTask_Result.
+// Equals returns true if all the fields of this AdminService_ResetQueue_Result match the
+// provided AdminService_ResetQueue_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_RemoveTask_Result) Equals(rhs *AdminService_RemoveTask_Result) bool {
+func (v *AdminService_ResetQueue_Result) Equals(rhs *AdminService_ResetQueue_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -31224,8 +37728,8 @@ func (v *AdminService_RemoveTask_Result) Equals(rhs *AdminService_RemoveTask_Res
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_RemoveTask_Result.
-func (v *AdminService_RemoveTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ResetQueue_Result.
+func (v *AdminService_ResetQueue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -31243,7 +37747,7 @@ func (v *AdminService_RemoveTask_Result) MarshalLogObject(enc zapcore.ObjectEnco
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RemoveTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_ResetQueue_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -31252,13 +37756,13 @@ func (v *AdminService_RemoveTask_Result) GetBadRequestError() (o *shared.BadRequ
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_RemoveTask_Result) IsSetBadRequestError() bool {
+func (v *AdminService_ResetQueue_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RemoveTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_ResetQueue_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -31267,13 +37771,13 @@ func (v *AdminService_RemoveTask_Result) GetInternalServiceError() (o *shared.In
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_RemoveTask_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_ResetQueue_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RemoveTask_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+func (v *AdminService_ResetQueue_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
 	if v != nil && v.AccessDeniedError != nil {
 		return v.AccessDeniedError
 	}
@@ -31282,33 +37786,33 @@ func (v *AdminService_RemoveTask_Result) GetAccessDeniedError() (o *shared.Acces
 }
 
 // IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_RemoveTask_Result) IsSetAccessDeniedError() bool {
+func (v *AdminService_ResetQueue_Result) IsSetAccessDeniedError() bool {
 	return v != nil && v.AccessDeniedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "RemoveTask" for this struct.
-func (v *AdminService_RemoveTask_Result) MethodName() string {
-	return "RemoveTask"
+// This will always be "ResetQueue" for this struct.
+func (v *AdminService_ResetQueue_Result) MethodName() string {
+	return "ResetQueue"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_RemoveTask_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ResetQueue_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_ResendReplicationTasks_Args represents the arguments for the AdminService.ResendReplicationTasks function.
+// AdminService_RespondCrossClusterTasksCompleted_Args represents the arguments for the AdminService.RespondCrossClusterTasksCompleted function.
 //
-// The arguments for ResendReplicationTasks are sent and received over the wire as this struct.
-type AdminService_ResendReplicationTasks_Args struct {
-	Request *ResendReplicationTasksRequest `json:"request,omitempty"`
+// The arguments for RespondCrossClusterTasksCompleted are sent and received over the wire as this struct.
+type AdminService_RespondCrossClusterTasksCompleted_Args struct {
+	Request *shared.RespondCrossClusterTasksCompletedRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_ResendReplicationTasks_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RespondCrossClusterTasksCompleted_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -31323,7 +37827,7 @@ type AdminService_ResendReplicationTasks_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_ResendReplicationTasks_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_RespondCrossClusterTasksCompleted_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -31343,17 +37847,17 @@ func (v *AdminService_ResendReplicationTasks_Args) ToWire() (wire.Value, error)
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ResendReplicationTasksRequest_Read(w wire.Value) (*ResendReplicationTasksRequest, error) {
-	var v ResendReplicationTasksRequest
+func _RespondCrossClusterTasksCompletedRequest_Read(w wire.Value) (*shared.RespondCrossClusterTasksCompletedRequest, error) {
+	var v shared.RespondCrossClusterTasksCompletedRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_ResendReplicationTasks_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_RespondCrossClusterTasksCompleted_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_ResendReplicationTasks_Args struct
+// An error is returned if we were unable to build a AdminService_RespondCrossClusterTasksCompleted_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -31361,19 +37865,19 @@ func _ResendReplicationTasksRequest_Read(w wire.Value) (*ResendReplicationTasksR
 //	  return nil, err
 //	}
 //
-//	var v AdminService_ResendReplicationTasks_Args
+//	var v AdminService_RespondCrossClusterTasksCompleted_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_ResendReplicationTasks_Args) FromWire(w wire.Value) error {
+func (v *AdminService_RespondCrossClusterTasksCompleted_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _ResendReplicationTasksRequest_Read(field.Value)
+				v.Request, err = _RespondCrossClusterTasksCompletedRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -31385,11 +37889,11 @@ func (v *AdminService_ResendReplicationTasks_Args) FromWire(w wire.Value) error
 	return nil
 }
 
-// Encode serializes a AdminService_ResendReplicationTasks_Args struct directly into bytes, without going
+// Encode serializes a AdminService_RespondCrossClusterTasksCompleted_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_ResendReplicationTasks_Args struct could not be encoded.
-func (v *AdminService_ResendReplicationTasks_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_RespondCrossClusterTasksCompleted_Args struct could not be encoded.
+func (v *AdminService_RespondCrossClusterTasksCompleted_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -31409,18 +37913,18 @@ func (v *AdminService_ResendReplicationTasks_Args) Encode(sw stream.Writer) erro
 	return sw.WriteStructEnd()
 }
 
-func _ResendReplicationTasksRequest_Decode(sr stream.Reader) (*ResendReplicationTasksRequest, error) {
-	var v ResendReplicationTasksRequest
+func _RespondCrossClusterTasksCompletedRequest_Decode(sr stream.Reader) (*shared.RespondCrossClusterTasksCompletedRequest, error) {
+	var v shared.RespondCrossClusterTasksCompletedRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_ResendReplicationTasks_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_RespondCrossClusterTasksCompleted_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_ResendReplicationTasks_Args struct could not be generated from the wire
+// An error is returned if a AdminService_RespondCrossClusterTasksCompleted_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_ResendReplicationTasks_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_RespondCrossClusterTasksCompleted_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -31434,7 +37938,7 @@ func (v *AdminService_ResendReplicationTasks_Args) Decode(sr stream.Reader) erro
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _ResendReplicationTasksRequest_Decode(sr)
+			v.Request, err = _RespondCrossClusterTasksCompletedRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -31461,9 +37965,9 @@ func (v *AdminService_ResendReplicationTasks_Args) Decode(sr stream.Reader) erro
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_ResendReplicationTasks_Args
+// String returns a readable string representation of a AdminService_RespondCrossClusterTasksCompleted_Args
 // struct.
-func (v *AdminService_ResendReplicationTasks_Args) String() string {
+func (v *AdminService_RespondCrossClusterTasksCompleted_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -31475,14 +37979,14 @@ func (v *AdminService_ResendReplicationTasks_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_ResendReplicationTasks_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RespondCrossClusterTasksCompleted_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_ResendReplicationTasks_Args match the
-// provided AdminService_ResendReplicationTasks_Args.
+// Equals returns true if all the fields of this AdminService_RespondCrossClusterTasksCompleted_Args match the
+// provided AdminService_RespondCrossClusterTasksCompleted_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_ResendReplicationTasks_Args) Equals(rhs *AdminService_ResendReplicationTasks_Args) bool {
+func (v *AdminService_RespondCrossClusterTasksCompleted_Args) Equals(rhs *AdminService_RespondCrossClusterTasksCompleted_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -31496,8 +38000,8 @@ func (v *AdminService_ResendReplicationTasks_Args) Equals(rhs *AdminService_Rese
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_ResendReplicationTasks_Args.
-func (v *AdminService_ResendReplicationTasks_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RespondCrossClusterTasksCompleted_Args.
+func (v *AdminService_RespondCrossClusterTasksCompleted_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -31509,7 +38013,7 @@ func (v *AdminService_ResendReplicationTasks_Args) MarshalLogObject(enc zapcore.
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ResendReplicationTasks_Args) GetRequest() (o *ResendReplicationTasksRequest) {
+func (v *AdminService_RespondCrossClusterTasksCompleted_Args) GetRequest() (o *shared.RespondCrossClusterTasksCompletedRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -31518,146 +38022,156 @@ func (v *AdminService_ResendReplicationTasks_Args) GetRequest() (o *ResendReplic
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_ResendReplicationTasks_Args) IsSetRequest() bool {
+func (v *AdminService_RespondCrossClusterTasksCompleted_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "ResendReplicationTasks" for this struct.
-func (v *AdminService_ResendReplicationTasks_Args) MethodName() string {
-	return "ResendReplicationTasks"
+// This will always be "RespondCrossClusterTasksCompleted" for this struct.
+func (v *AdminService_RespondCrossClusterTasksCompleted_Args) MethodName() string {
+	return "RespondCrossClusterTasksCompleted"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_ResendReplicationTasks_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RespondCrossClusterTasksCompleted_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_ResendReplicationTasks_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.ResendReplicationTasks
+// AdminService_RespondCrossClusterTasksCompleted_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.RespondCrossClusterTasksCompleted
 // function.
-var AdminService_ResendReplicationTasks_Helper = struct {
-	// Args accepts the parameters of ResendReplicationTasks in-order and returns
+var AdminService_RespondCrossClusterTasksCompleted_Helper = struct {
+	// Args accepts the parameters of RespondCrossClusterTasksCompleted in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *ResendReplicationTasksRequest,
-	) *AdminService_ResendReplicationTasks_Args
+		request *shared.RespondCrossClusterTasksCompletedRequest,
+	) *AdminService_RespondCrossClusterTasksCompleted_Args
 
 	// IsException returns true if the given error can be thrown
-	// by ResendReplicationTasks.
+	// by RespondCrossClusterTasksCompleted.
 	//
-	// An error can be thrown by ResendReplicationTasks only if the
+	// An error can be thrown by RespondCrossClusterTasksCompleted only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for ResendReplicationTasks
-	// given the error returned by it. The provided error may
-	// be nil if ResendReplicationTasks did not fail.
+	// WrapResponse returns the result struct for RespondCrossClusterTasksCompleted
+	// given its return value and error.
 	//
-	// This allows mapping errors returned by ResendReplicationTasks into a
-	// serializable result struct. WrapResponse returns a
-	// non-nil error if the provided error cannot be thrown by
-	// ResendReplicationTasks
+	// This allows mapping values and errors returned by
+	// RespondCrossClusterTasksCompleted into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by RespondCrossClusterTasksCompleted
 	//
-	//   err := ResendReplicationTasks(args)
-	//   result, err := AdminService_ResendReplicationTasks_Helper.WrapResponse(err)
+	//   value, err := RespondCrossClusterTasksCompleted(args)
+	//   result, err := AdminService_RespondCrossClusterTasksCompleted_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from ResendReplicationTasks: %v", err)
+	//     return fmt.Errorf("unexpected error from RespondCrossClusterTasksCompleted: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*AdminService_ResendReplicationTasks_Result, error)
+	WrapResponse func(*shared.RespondCrossClusterTasksCompletedResponse, error) (*AdminService_RespondCrossClusterTasksCompleted_Result, error)
 
-	// UnwrapResponse takes the result struct for ResendReplicationTasks
-	// and returns the erorr returned by it (if any).
+	// UnwrapResponse takes the result struct for RespondCrossClusterTasksCompleted
+	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if ResendReplicationTasks threw an
+	// The error is non-nil only if RespondCrossClusterTasksCompleted threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := AdminService_ResendReplicationTasks_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_ResendReplicationTasks_Result) error
+	//   value, err := AdminService_RespondCrossClusterTasksCompleted_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_RespondCrossClusterTasksCompleted_Result) (*shared.RespondCrossClusterTasksCompletedResponse, error)
 }{}
 
 func init() {
-	AdminService_ResendReplicationTasks_Helper.Args = func(
-		request *ResendReplicationTasksRequest,
-	) *AdminService_ResendReplicationTasks_Args {
-		return &AdminService_ResendReplicationTasks_Args{
+	AdminService_RespondCrossClusterTasksCompleted_Helper.Args = func(
+		request *shared.RespondCrossClusterTasksCompletedRequest,
+	) *AdminService_RespondCrossClusterTasksCompleted_Args {
+		return &AdminService_RespondCrossClusterTasksCompleted_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_ResendReplicationTasks_Helper.IsException = func(err error) bool {
+	AdminService_RespondCrossClusterTasksCompleted_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
-		case *shared.ServiceBusyError:
+		case *shared.InternalServiceError:
 			return true
-		case *shared.EntityNotExistsError:
+		case *shared.ServiceBusyError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_ResendReplicationTasks_Helper.WrapResponse = func(err error) (*AdminService_ResendReplicationTasks_Result, error) {
+	AdminService_RespondCrossClusterTasksCompleted_Helper.WrapResponse = func(success *shared.RespondCrossClusterTasksCompletedResponse, err error) (*AdminService_RespondCrossClusterTasksCompleted_Result, error) {
 		if err == nil {
-			return &AdminService_ResendReplicationTasks_Result{}, nil
+			return &AdminService_RespondCrossClusterTasksCompleted_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResendReplicationTasks_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RespondCrossClusterTasksCompleted_Result.BadRequestError")
 			}
-			return &AdminService_ResendReplicationTasks_Result{BadRequestError: e}, nil
-		case *shared.ServiceBusyError:
+			return &AdminService_RespondCrossClusterTasksCompleted_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResendReplicationTasks_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RespondCrossClusterTasksCompleted_Result.InternalServiceError")
 			}
-			return &AdminService_ResendReplicationTasks_Result{ServiceBusyError: e}, nil
-		case *shared.EntityNotExistsError:
+			return &AdminService_RespondCrossClusterTasksCompleted_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResendReplicationTasks_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RespondCrossClusterTasksCompleted_Result.ServiceBusyError")
 			}
-			return &AdminService_ResendReplicationTasks_Result{EntityNotExistError: e}, nil
+			return &AdminService_RespondCrossClusterTasksCompleted_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_ResendReplicationTasks_Helper.UnwrapResponse = func(result *AdminService_ResendReplicationTasks_Result) (err error) {
+	AdminService_RespondCrossClusterTasksCompleted_Helper.UnwrapResponse = func(result *AdminService_RespondCrossClusterTasksCompleted_Result) (success *shared.RespondCrossClusterTasksCompletedResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
 		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
+
+		if result.Success != nil {
+			success = result.Success
 			return
 		}
+
+		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_ResendReplicationTasks_Result represents the result of a AdminService.ResendReplicationTasks function call.
+// AdminService_RespondCrossClusterTasksCompleted_Result represents the result of a AdminService.RespondCrossClusterTasksCompleted function call.
 //
-// The result of a ResendReplicationTasks execution is sent and received over the wire as this struct.
-type AdminService_ResendReplicationTasks_Result struct {
-	BadRequestError     *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	ServiceBusyError    *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
-	EntityNotExistError *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+// The result of a RespondCrossClusterTasksCompleted execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_RespondCrossClusterTasksCompleted_Result struct {
+	// Value returned by RespondCrossClusterTasksCompleted after a successful execution.
+	Success              *shared.RespondCrossClusterTasksCompletedResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError                           `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError                      `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError                          `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a AdminService_ResendReplicationTasks_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RespondCrossClusterTasksCompleted_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -31672,14 +38186,22 @@ type AdminService_ResendReplicationTasks_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_ResendReplicationTasks_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_RespondCrossClusterTasksCompleted_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -31688,16 +38210,16 @@ func (v *AdminService_ResendReplicationTasks_Result) ToWire() (wire.Value, error
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		w, err = v.ServiceBusyError.ToWire()
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -31705,18 +38227,24 @@ func (v *AdminService_ResendReplicationTasks_Result) ToWire() (wire.Value, error
 		i++
 	}
 
-	if i > 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_ResendReplicationTasks_Result should have at most one field: got %v fields", i)
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_RespondCrossClusterTasksCompleted_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a AdminService_ResendReplicationTasks_Result struct from its Thrift-level
+func _RespondCrossClusterTasksCompletedResponse_Read(w wire.Value) (*shared.RespondCrossClusterTasksCompletedResponse, error) {
+	var v shared.RespondCrossClusterTasksCompletedResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_RespondCrossClusterTasksCompleted_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_ResendReplicationTasks_Result struct
+// An error is returned if we were unable to build a AdminService_RespondCrossClusterTasksCompleted_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -31724,16 +38252,24 @@ func (v *AdminService_ResendReplicationTasks_Result) ToWire() (wire.Value, error
 //	  return nil, err
 //	}
 //
-//	var v AdminService_ResendReplicationTasks_Result
+//	var v AdminService_RespondCrossClusterTasksCompleted_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_ResendReplicationTasks_Result) FromWire(w wire.Value) error {
+func (v *AdminService_RespondCrossClusterTasksCompleted_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _RespondCrossClusterTasksCompletedResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -31744,7 +38280,7 @@ func (v *AdminService_ResendReplicationTasks_Result) FromWire(w wire.Value) erro
 			}
 		case 2:
 			if field.Value.Type() == wire.TStruct {
-				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -31752,7 +38288,7 @@ func (v *AdminService_ResendReplicationTasks_Result) FromWire(w wire.Value) erro
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -31762,31 +38298,46 @@ func (v *AdminService_ResendReplicationTasks_Result) FromWire(w wire.Value) erro
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("AdminService_ResendReplicationTasks_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("AdminService_RespondCrossClusterTasksCompleted_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_ResendReplicationTasks_Result struct directly into bytes, without going
+// Encode serializes a AdminService_RespondCrossClusterTasksCompleted_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_ResendReplicationTasks_Result struct could not be encoded.
-func (v *AdminService_ResendReplicationTasks_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_RespondCrossClusterTasksCompleted_Result struct could not be encoded.
+func (v *AdminService_RespondCrossClusterTasksCompleted_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	if v.BadRequestError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
@@ -31799,11 +38350,11 @@ func (v *AdminService_ResendReplicationTasks_Result) Encode(sw stream.Writer) er
 		}
 	}
 
-	if v.ServiceBusyError != nil {
+	if v.InternalServiceError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.ServiceBusyError.Encode(sw); err != nil {
+		if err := v.InternalServiceError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -31811,11 +38362,11 @@ func (v *AdminService_ResendReplicationTasks_Result) Encode(sw stream.Writer) er
 		}
 	}
 
-	if v.EntityNotExistError != nil {
+	if v.ServiceBusyError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.EntityNotExistError.Encode(sw); err != nil {
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -31824,29 +38375,38 @@ func (v *AdminService_ResendReplicationTasks_Result) Encode(sw stream.Writer) er
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
 
-	if count > 1 {
-		return fmt.Errorf("AdminService_ResendReplicationTasks_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("AdminService_RespondCrossClusterTasksCompleted_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a AdminService_ResendReplicationTasks_Result struct directly from its Thrift-level
+func _RespondCrossClusterTasksCompletedResponse_Decode(sr stream.Reader) (*shared.RespondCrossClusterTasksCompletedResponse, error) {
+	var v shared.RespondCrossClusterTasksCompletedResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a AdminService_RespondCrossClusterTasksCompleted_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_ResendReplicationTasks_Result struct could not be generated from the wire
+// An error is returned if a AdminService_RespondCrossClusterTasksCompleted_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_ResendReplicationTasks_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_RespondCrossClusterTasksCompleted_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -31859,6 +38419,12 @@ func (v *AdminService_ResendReplicationTasks_Result) Decode(sr stream.Reader) er
 
 	for ok {
 		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _RespondCrossClusterTasksCompletedResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		case fh.ID == 1 && fh.Type == wire.TStruct:
 			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
@@ -31866,13 +38432,13 @@ func (v *AdminService_ResendReplicationTasks_Result) Decode(sr stream.Reader) er
 			}
 
 		case fh.ID == 2 && fh.Type == wire.TStruct:
-			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -31897,64 +38463,74 @@ func (v *AdminService_ResendReplicationTasks_Result) Decode(sr stream.Reader) er
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("AdminService_ResendReplicationTasks_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("AdminService_RespondCrossClusterTasksCompleted_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_ResendReplicationTasks_Result
+// String returns a readable string representation of a AdminService_RespondCrossClusterTasksCompleted_Result
 // struct.
-func (v *AdminService_ResendReplicationTasks_Result) String() string {
+func (v *AdminService_RespondCrossClusterTasksCompleted_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_ResendReplicationTasks_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RespondCrossClusterTasksCompleted_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_ResendReplicationTasks_Result match the
-// provided AdminService_ResendReplicationTasks_Result.
+// Equals returns true if all the fields of this AdminService_RespondCrossClusterTasksCompleted_Result match the
+// provided AdminService_RespondCrossClusterTasksCompleted_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_ResendReplicationTasks_Result) Equals(rhs *AdminService_ResendReplicationTasks_Result) bool {
+func (v *AdminService_RespondCrossClusterTasksCompleted_Result) Equals(rhs *AdminService_RespondCrossClusterTasksCompleted_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
 
@@ -31962,26 +38538,44 @@ func (v *AdminService_ResendReplicationTasks_Result) Equals(rhs *AdminService_Re
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_ResendReplicationTasks_Result.
-func (v *AdminService_ResendReplicationTasks_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RespondCrossClusterTasksCompleted_Result.
+func (v *AdminService_RespondCrossClusterTasksCompleted_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
-	if v.EntityNotExistError != nil {
-		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
-	}
 	return err
 }
 
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_RespondCrossClusterTasksCompleted_Result) GetSuccess() (o *shared.RespondCrossClusterTasksCompletedResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_RespondCrossClusterTasksCompleted_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ResendReplicationTasks_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_RespondCrossClusterTasksCompleted_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -31990,63 +38584,63 @@ func (v *AdminService_ResendReplicationTasks_Result) GetBadRequestError() (o *sh
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_ResendReplicationTasks_Result) IsSetBadRequestError() bool {
+func (v *AdminService_RespondCrossClusterTasksCompleted_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
-// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ResendReplicationTasks_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
-	if v != nil && v.ServiceBusyError != nil {
-		return v.ServiceBusyError
+func (v *AdminService_RespondCrossClusterTasksCompleted_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
 	}
 
 	return
 }
 
-// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_ResendReplicationTasks_Result) IsSetServiceBusyError() bool {
-	return v != nil && v.ServiceBusyError != nil
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_RespondCrossClusterTasksCompleted_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
 }
 
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ResendReplicationTasks_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
+func (v *AdminService_RespondCrossClusterTasksCompleted_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
 	}
 
 	return
 }
 
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_ResendReplicationTasks_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_RespondCrossClusterTasksCompleted_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "ResendReplicationTasks" for this struct.
-func (v *AdminService_ResendReplicationTasks_Result) MethodName() string {
-	return "ResendReplicationTasks"
+// This will always be "RespondCrossClusterTasksCompleted" for this struct.
+func (v *AdminService_RespondCrossClusterTasksCompleted_Result) MethodName() string {
+	return "RespondCrossClusterTasksCompleted"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_ResendReplicationTasks_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RespondCrossClusterTasksCompleted_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_ResetQueue_Args represents the arguments for the AdminService.ResetQueue function.
+// AdminService_RestoreDynamicConfig_Args represents the arguments for the AdminService.RestoreDynamicConfig function.
 //
-// The arguments for ResetQueue are sent and received over the wire as this struct.
-type AdminService_ResetQueue_Args struct {
-	Request *shared.ResetQueueRequest `json:"request,omitempty"`
+// The arguments for RestoreDynamicConfig are sent and received over the wire as this struct.
+type AdminService_RestoreDynamicConfig_Args struct {
+	Request *RestoreDynamicConfigRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_ResetQueue_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RestoreDynamicConfig_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -32061,7 +38655,7 @@ type AdminService_ResetQueue_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_ResetQueue_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_RestoreDynamicConfig_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -32081,17 +38675,17 @@ func (v *AdminService_ResetQueue_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ResetQueueRequest_Read(w wire.Value) (*shared.ResetQueueRequest, error) {
-	var v shared.ResetQueueRequest
+func _RestoreDynamicConfigRequest_Read(w wire.Value) (*RestoreDynamicConfigRequest, error) {
+	var v RestoreDynamicConfigRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_ResetQueue_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_RestoreDynamicConfig_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_ResetQueue_Args struct
+// An error is returned if we were unable to build a AdminService_RestoreDynamicConfig_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -32099,19 +38693,19 @@ func _ResetQueueRequest_Read(w wire.Value) (*shared.ResetQueueRequest, error) {
 //	  return nil, err
 //	}
 //
-//	var v AdminService_ResetQueue_Args
+//	var v AdminService_RestoreDynamicConfig_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_ResetQueue_Args) FromWire(w wire.Value) error {
+func (v *AdminService_RestoreDynamicConfig_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _ResetQueueRequest_Read(field.Value)
+				v.Request, err = _RestoreDynamicConfigRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -32123,11 +38717,11 @@ func (v *AdminService_ResetQueue_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_ResetQueue_Args struct directly into bytes, without going
+// Encode serializes a AdminService_RestoreDynamicConfig_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_ResetQueue_Args struct could not be encoded.
-func (v *AdminService_ResetQueue_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_RestoreDynamicConfig_Args struct could not be encoded.
+func (v *AdminService_RestoreDynamicConfig_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -32147,18 +38741,18 @@ func (v *AdminService_ResetQueue_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _ResetQueueRequest_Decode(sr stream.Reader) (*shared.ResetQueueRequest, error) {
-	var v shared.ResetQueueRequest
+func _RestoreDynamicConfigRequest_Decode(sr stream.Reader) (*RestoreDynamicConfigRequest, error) {
+	var v RestoreDynamicConfigRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_ResetQueue_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_RestoreDynamicConfig_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_ResetQueue_Args struct could not be generated from the wire
+// An error is returned if a AdminService_RestoreDynamicConfig_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_ResetQueue_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_RestoreDynamicConfig_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -32172,7 +38766,7 @@ func (v *AdminService_ResetQueue_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _ResetQueueRequest_Decode(sr)
+			v.Request, err = _RestoreDynamicConfigRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -32199,9 +38793,9 @@ func (v *AdminService_ResetQueue_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_ResetQueue_Args
+// String returns a readable string representation of a AdminService_RestoreDynamicConfig_Args
 // struct.
-func (v *AdminService_ResetQueue_Args) String() string {
+func (v *AdminService_RestoreDynamicConfig_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -32213,14 +38807,14 @@ func (v *AdminService_ResetQueue_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_ResetQueue_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RestoreDynamicConfig_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_ResetQueue_Args match the
-// provided AdminService_ResetQueue_Args.
+// Equals returns true if all the fields of this AdminService_RestoreDynamicConfig_Args match the
+// provided AdminService_RestoreDynamicConfig_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_ResetQueue_Args) Equals(rhs *AdminService_ResetQueue_Args) bool {
+func (v *AdminService_RestoreDynamicConfig_Args) Equals(rhs *AdminService_RestoreDynamicConfig_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -32234,8 +38828,8 @@ func (v *AdminService_ResetQueue_Args) Equals(rhs *AdminService_ResetQueue_Args)
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_ResetQueue_Args.
-func (v *AdminService_ResetQueue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RestoreDynamicConfig_Args.
+func (v *AdminService_RestoreDynamicConfig_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -32247,7 +38841,7 @@ func (v *AdminService_ResetQueue_Args) MarshalLogObject(enc zapcore.ObjectEncode
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ResetQueue_Args) GetRequest() (o *shared.ResetQueueRequest) {
+func (v *AdminService_RestoreDynamicConfig_Args) GetRequest() (o *RestoreDynamicConfigRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -32256,119 +38850,112 @@ func (v *AdminService_ResetQueue_Args) GetRequest() (o *shared.ResetQueueRequest
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_ResetQueue_Args) IsSetRequest() bool {
+func (v *AdminService_RestoreDynamicConfig_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "ResetQueue" for this struct.
-func (v *AdminService_ResetQueue_Args) MethodName() string {
-	return "ResetQueue"
+// This will always be "RestoreDynamicConfig" for this struct.
+func (v *AdminService_RestoreDynamicConfig_Args) MethodName() string {
+	return "RestoreDynamicConfig"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_ResetQueue_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RestoreDynamicConfig_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_ResetQueue_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.ResetQueue
+// AdminService_RestoreDynamicConfig_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.RestoreDynamicConfig
 // function.
-var AdminService_ResetQueue_Helper = struct {
-	// Args accepts the parameters of ResetQueue in-order and returns
+var AdminService_RestoreDynamicConfig_Helper = struct {
+	// Args accepts the parameters of RestoreDynamicConfig in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.ResetQueueRequest,
-	) *AdminService_ResetQueue_Args
+		request *RestoreDynamicConfigRequest,
+	) *AdminService_RestoreDynamicConfig_Args
 
 	// IsException returns true if the given error can be thrown
-	// by ResetQueue.
+	// by RestoreDynamicConfig.
 	//
-	// An error can be thrown by ResetQueue only if the
+	// An error can be thrown by RestoreDynamicConfig only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for ResetQueue
+	// WrapResponse returns the result struct for RestoreDynamicConfig
 	// given the error returned by it. The provided error may
-	// be nil if ResetQueue did not fail.
+	// be nil if RestoreDynamicConfig did not fail.
 	//
-	// This allows mapping errors returned by ResetQueue into a
+	// This allows mapping errors returned by RestoreDynamicConfig into a
 	// serializable result struct. WrapResponse returns a
 	// non-nil error if the provided error cannot be thrown by
-	// ResetQueue
+	// RestoreDynamicConfig
 	//
-	//   err := ResetQueue(args)
-	//   result, err := AdminService_ResetQueue_Helper.WrapResponse(err)
+	//   err := RestoreDynamicConfig(args)
+	//   result, err := AdminService_RestoreDynamicConfig_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from ResetQueue: %v", err)
+	//     return fmt.Errorf("unexpected error from RestoreDynamicConfig: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*AdminService_ResetQueue_Result, error)
+	WrapResponse func(error) (*AdminService_RestoreDynamicConfig_Result, error)
 
-	// UnwrapResponse takes the result struct for ResetQueue
+	// UnwrapResponse takes the result struct for RestoreDynamicConfig
 	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if ResetQueue threw an
+	// The error is non-nil only if RestoreDynamicConfig threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := AdminService_ResetQueue_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_ResetQueue_Result) error
+	//   err := AdminService_RestoreDynamicConfig_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_RestoreDynamicConfig_Result) error
 }{}
 
 func init() {
-	AdminService_ResetQueue_Helper.Args = func(
-		request *shared.ResetQueueRequest,
-	) *AdminService_ResetQueue_Args {
-		return &AdminService_ResetQueue_Args{
+	AdminService_RestoreDynamicConfig_Helper.Args = func(
+		request *RestoreDynamicConfigRequest,
+	) *AdminService_RestoreDynamicConfig_Args {
+		return &AdminService_RestoreDynamicConfig_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_ResetQueue_Helper.IsException = func(err error) bool {
+	AdminService_RestoreDynamicConfig_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.AccessDeniedError:
-			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_ResetQueue_Helper.WrapResponse = func(err error) (*AdminService_ResetQueue_Result, error) {
+	AdminService_RestoreDynamicConfig_Helper.WrapResponse = func(err error) (*AdminService_RestoreDynamicConfig_Result, error) {
 		if err == nil {
-			return &AdminService_ResetQueue_Result{}, nil
+			return &AdminService_RestoreDynamicConfig_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetQueue_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RestoreDynamicConfig_Result.BadRequestError")
 			}
-			return &AdminService_ResetQueue_Result{BadRequestError: e}, nil
+			return &AdminService_RestoreDynamicConfig_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetQueue_Result.InternalServiceError")
-			}
-			return &AdminService_ResetQueue_Result{InternalServiceError: e}, nil
-		case *shared.AccessDeniedError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetQueue_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RestoreDynamicConfig_Result.InternalServiceError")
 			}
-			return &AdminService_ResetQueue_Result{AccessDeniedError: e}, nil
+			return &AdminService_RestoreDynamicConfig_Result{InternalServiceError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_ResetQueue_Helper.UnwrapResponse = func(result *AdminService_ResetQueue_Result) (err error) {
+	AdminService_RestoreDynamicConfig_Helper.UnwrapResponse = func(result *AdminService_RestoreDynamicConfig_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -32377,25 +38964,20 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.AccessDeniedError != nil {
-			err = result.AccessDeniedError
-			return
-		}
 		return
 	}
 
 }
 
-// AdminService_ResetQueue_Result represents the result of a AdminService.ResetQueue function call.
+// AdminService_RestoreDynamicConfig_Result represents the result of a AdminService.RestoreDynamicConfig function call.
 //
-// The result of a ResetQueue execution is sent and received over the wire as this struct.
-type AdminService_ResetQueue_Result struct {
+// The result of a RestoreDynamicConfig execution is sent and received over the wire as this struct.
+type AdminService_RestoreDynamicConfig_Result struct {
 	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
 	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_ResetQueue_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RestoreDynamicConfig_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -32410,9 +38992,9 @@ type AdminService_ResetQueue_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_ResetQueue_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_RestoreDynamicConfig_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -32434,27 +39016,19 @@ func (v *AdminService_ResetQueue_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.AccessDeniedError != nil {
-		w, err = v.AccessDeniedError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
-	}
 
 	if i > 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_ResetQueue_Result should have at most one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_RestoreDynamicConfig_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a AdminService_ResetQueue_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_RestoreDynamicConfig_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_ResetQueue_Result struct
+// An error is returned if we were unable to build a AdminService_RestoreDynamicConfig_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -32462,12 +39036,12 @@ func (v *AdminService_ResetQueue_Result) ToWire() (wire.Value, error) {
 //	  return nil, err
 //	}
 //
-//	var v AdminService_ResetQueue_Result
+//	var v AdminService_RestoreDynamicConfig_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_ResetQueue_Result) FromWire(w wire.Value) error {
+func (v *AdminService_RestoreDynamicConfig_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -32487,14 +39061,6 @@ func (v *AdminService_ResetQueue_Result) FromWire(w wire.Value) error {
 					return err
 				}
 
-			}
-		case 3:
-			if field.Value.Type() == wire.TStruct {
-				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
 			}
 		}
 	}
@@ -32506,21 +39072,18 @@ func (v *AdminService_ResetQueue_Result) FromWire(w wire.Value) error {
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.AccessDeniedError != nil {
-		count++
-	}
 	if count > 1 {
-		return fmt.Errorf("AdminService_ResetQueue_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_RestoreDynamicConfig_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_ResetQueue_Result struct directly into bytes, without going
+// Encode serializes a AdminService_RestoreDynamicConfig_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_ResetQueue_Result struct could not be encoded.
-func (v *AdminService_ResetQueue_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_RestoreDynamicConfig_Result struct could not be encoded.
+func (v *AdminService_RestoreDynamicConfig_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -32549,18 +39112,6 @@ func (v *AdminService_ResetQueue_Result) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.AccessDeniedError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.AccessDeniedError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
 	count := 0
 	if v.BadRequestError != nil {
 		count++
@@ -32568,23 +39119,20 @@ func (v *AdminService_ResetQueue_Result) Encode(sw stream.Writer) error {
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.AccessDeniedError != nil {
-		count++
-	}
 
 	if count > 1 {
-		return fmt.Errorf("AdminService_ResetQueue_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_RestoreDynamicConfig_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a AdminService_ResetQueue_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_RestoreDynamicConfig_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_ResetQueue_Result struct could not be generated from the wire
+// An error is returned if a AdminService_RestoreDynamicConfig_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_ResetQueue_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_RestoreDynamicConfig_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -32609,12 +39157,6 @@ func (v *AdminService_ResetQueue_Result) Decode(sr stream.Reader) error {
 				return err
 			}
 
-		case fh.ID == 3 && fh.Type == wire.TStruct:
-			v.AccessDeniedError, err = _AccessDeniedError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -32641,24 +39183,21 @@ func (v *AdminService_ResetQueue_Result) Decode(sr stream.Reader) error {
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.AccessDeniedError != nil {
-		count++
-	}
 	if count > 1 {
-		return fmt.Errorf("AdminService_ResetQueue_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_RestoreDynamicConfig_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_ResetQueue_Result
+// String returns a readable string representation of a AdminService_RestoreDynamicConfig_Result
 // struct.
-func (v *AdminService_ResetQueue_Result) String() string {
+func (v *AdminService_RestoreDynamicConfig_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [2]string
 	i := 0
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
@@ -32668,19 +39207,15 @@ func (v *AdminService_ResetQueue_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.AccessDeniedError != nil {
-		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
-		i++
-	}
 
-	return fmt.Sprintf("AdminService_ResetQueue_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RestoreDynamicConfig_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_ResetQueue_Result match the
-// provided AdminService_ResetQueue_Result.
+// Equals returns true if all the fields of this AdminService_RestoreDynamicConfig_Result match the
+// provided AdminService_RestoreDynamicConfig_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_ResetQueue_Result) Equals(rhs *AdminService_ResetQueue_Result) bool {
+func (v *AdminService_RestoreDynamicConfig_Result) Equals(rhs *AdminService_RestoreDynamicConfig_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -32692,16 +39227,13 @@ func (v *AdminService_ResetQueue_Result) Equals(rhs *AdminService_ResetQueue_Res
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
-		return false
-	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_ResetQueue_Result.
-func (v *AdminService_ResetQueue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RestoreDynamicConfig_Result.
+func (v *AdminService_RestoreDynamicConfig_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -32711,15 +39243,12 @@ func (v *AdminService_ResetQueue_Result) MarshalLogObject(enc zapcore.ObjectEnco
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.AccessDeniedError != nil {
-		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
-	}
 	return err
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ResetQueue_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_RestoreDynamicConfig_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -32728,13 +39257,13 @@ func (v *AdminService_ResetQueue_Result) GetBadRequestError() (o *shared.BadRequ
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_ResetQueue_Result) IsSetBadRequestError() bool {
+func (v *AdminService_RestoreDynamicConfig_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_ResetQueue_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_RestoreDynamicConfig_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -32743,48 +39272,33 @@ func (v *AdminService_ResetQueue_Result) GetInternalServiceError() (o *shared.In
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_ResetQueue_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_RestoreDynamicConfig_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
-// zero value if it is unset.
-func (v *AdminService_ResetQueue_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
-	if v != nil && v.AccessDeniedError != nil {
-		return v.AccessDeniedError
-	}
-
-	return
-}
-
-// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_ResetQueue_Result) IsSetAccessDeniedError() bool {
-	return v != nil && v.AccessDeniedError != nil
-}
-
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "ResetQueue" for this struct.
-func (v *AdminService_ResetQueue_Result) MethodName() string {
-	return "ResetQueue"
+// This will always be "RestoreDynamicConfig" for this struct.
+func (v *AdminService_RestoreDynamicConfig_Result) MethodName() string {
+	return "RestoreDynamicConfig"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_ResetQueue_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RestoreDynamicConfig_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_RespondCrossClusterTasksCompleted_Args represents the arguments for the AdminService.RespondCrossClusterTasksCompleted function.
+// AdminService_UnpauseActivity_Args represents the arguments for the AdminService.UnpauseActivity function.
 //
-// The arguments for RespondCrossClusterTasksCompleted are sent and received over the wire as this struct.
-type AdminService_RespondCrossClusterTasksCompleted_Args struct {
-	Request *shared.RespondCrossClusterTasksCompletedRequest `json:"request,omitempty"`
+// The arguments for UnpauseActivity are sent and received over the wire as this struct.
+type AdminService_UnpauseActivity_Args struct {
+	Request *shared.UnpauseActivityRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_RespondCrossClusterTasksCompleted_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_UnpauseActivity_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -32799,7 +39313,7 @@ type AdminService_RespondCrossClusterTasksCompleted_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_RespondCrossClusterTasksCompleted_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_UnpauseActivity_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -32819,17 +39333,17 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Args) ToWire() (wire.Val
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondCrossClusterTasksCompletedRequest_Read(w wire.Value) (*shared.RespondCrossClusterTasksCompletedRequest, error) {
-	var v shared.RespondCrossClusterTasksCompletedRequest
+func _UnpauseActivityRequest_Read(w wire.Value) (*shared.UnpauseActivityRequest, error) {
+	var v shared.UnpauseActivityRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_RespondCrossClusterTasksCompleted_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_UnpauseActivity_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_RespondCrossClusterTasksCompleted_Args struct
+// An error is returned if we were unable to build a AdminService_UnpauseActivity_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -32837,19 +39351,19 @@ func _RespondCrossClusterTasksCompletedRequest_Read(w wire.Value) (*shared.Respo
 //	  return nil, err
 //	}
 //
-//	var v AdminService_RespondCrossClusterTasksCompleted_Args
+//	var v AdminService_UnpauseActivity_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_RespondCrossClusterTasksCompleted_Args) FromWire(w wire.Value) error {
+func (v *AdminService_UnpauseActivity_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _RespondCrossClusterTasksCompletedRequest_Read(field.Value)
+				v.Request, err = _UnpauseActivityRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -32861,11 +39375,11 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Args) FromWire(w wire.Va
 	return nil
 }
 
-// Encode serializes a AdminService_RespondCrossClusterTasksCompleted_Args struct directly into bytes, without going
+// Encode serializes a AdminService_UnpauseActivity_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_RespondCrossClusterTasksCompleted_Args struct could not be encoded.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_UnpauseActivity_Args struct could not be encoded.
+func (v *AdminService_UnpauseActivity_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -32885,18 +39399,18 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Args) Encode(sw stream.W
 	return sw.WriteStructEnd()
 }
 
-func _RespondCrossClusterTasksCompletedRequest_Decode(sr stream.Reader) (*shared.RespondCrossClusterTasksCompletedRequest, error) {
-	var v shared.RespondCrossClusterTasksCompletedRequest
+func _UnpauseActivityRequest_Decode(sr stream.Reader) (*shared.UnpauseActivityRequest, error) {
+	var v shared.UnpauseActivityRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_RespondCrossClusterTasksCompleted_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_UnpauseActivity_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_RespondCrossClusterTasksCompleted_Args struct could not be generated from the wire
+// An error is returned if a AdminService_UnpauseActivity_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_UnpauseActivity_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -32910,7 +39424,7 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Args) Decode(sr stream.R
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _RespondCrossClusterTasksCompletedRequest_Decode(sr)
+			v.Request, err = _UnpauseActivityRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -32937,9 +39451,9 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Args) Decode(sr stream.R
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_RespondCrossClusterTasksCompleted_Args
+// String returns a readable string representation of a AdminService_UnpauseActivity_Args
 // struct.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Args) String() string {
+func (v *AdminService_UnpauseActivity_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -32951,14 +39465,14 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_RespondCrossClusterTasksCompleted_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_UnpauseActivity_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_RespondCrossClusterTasksCompleted_Args match the
-// provided AdminService_RespondCrossClusterTasksCompleted_Args.
+// Equals returns true if all the fields of this AdminService_UnpauseActivity_Args match the
+// provided AdminService_UnpauseActivity_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Args) Equals(rhs *AdminService_RespondCrossClusterTasksCompleted_Args) bool {
+func (v *AdminService_UnpauseActivity_Args) Equals(rhs *AdminService_UnpauseActivity_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -32972,8 +39486,8 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Args) Equals(rhs *AdminS
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_RespondCrossClusterTasksCompleted_Args.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_UnpauseActivity_Args.
+func (v *AdminService_UnpauseActivity_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -32985,7 +39499,7 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Args) MarshalLogObject(e
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Args) GetRequest() (o *shared.RespondCrossClusterTasksCompletedRequest) {
+func (v *AdminService_UnpauseActivity_Args) GetRequest() (o *shared.UnpauseActivityRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -32994,156 +39508,170 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Args) GetRequest() (o *s
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Args) IsSetRequest() bool {
+func (v *AdminService_UnpauseActivity_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "RespondCrossClusterTasksCompleted" for this struct.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Args) MethodName() string {
-	return "RespondCrossClusterTasksCompleted"
+// This will always be "UnpauseActivity" for this struct.
+func (v *AdminService_UnpauseActivity_Args) MethodName() string {
+	return "UnpauseActivity"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_UnpauseActivity_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_RespondCrossClusterTasksCompleted_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.RespondCrossClusterTasksCompleted
+// AdminService_UnpauseActivity_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.UnpauseActivity
 // function.
-var AdminService_RespondCrossClusterTasksCompleted_Helper = struct {
-	// Args accepts the parameters of RespondCrossClusterTasksCompleted in-order and returns
+var AdminService_UnpauseActivity_Helper = struct {
+	// Args accepts the parameters of UnpauseActivity in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.RespondCrossClusterTasksCompletedRequest,
-	) *AdminService_RespondCrossClusterTasksCompleted_Args
+		request *shared.UnpauseActivityRequest,
+	) *AdminService_UnpauseActivity_Args
 
 	// IsException returns true if the given error can be thrown
-	// by RespondCrossClusterTasksCompleted.
+	// by UnpauseActivity.
 	//
-	// An error can be thrown by RespondCrossClusterTasksCompleted only if the
+	// An error can be thrown by UnpauseActivity only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for RespondCrossClusterTasksCompleted
-	// given its return value and error.
+	// WrapResponse returns the result struct for UnpauseActivity
+	// given the error returned by it. The provided error may
+	// be nil if UnpauseActivity did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// RespondCrossClusterTasksCompleted into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by RespondCrossClusterTasksCompleted
+	// This allows mapping errors returned by UnpauseActivity into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// UnpauseActivity
 	//
-	//   value, err := RespondCrossClusterTasksCompleted(args)
-	//   result, err := AdminService_RespondCrossClusterTasksCompleted_Helper.WrapResponse(value, err)
+	//   err := UnpauseActivity(args)
+	//   result, err := AdminService_UnpauseActivity_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from RespondCrossClusterTasksCompleted: %v", err)
+	//     return fmt.Errorf("unexpected error from UnpauseActivity: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.RespondCrossClusterTasksCompletedResponse, error) (*AdminService_RespondCrossClusterTasksCompleted_Result, error)
+	WrapResponse func(error) (*AdminService_UnpauseActivity_Result, error)
 
-	// UnwrapResponse takes the result struct for RespondCrossClusterTasksCompleted
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for UnpauseActivity
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if RespondCrossClusterTasksCompleted threw an
+	// The error is non-nil only if UnpauseActivity threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_RespondCrossClusterTasksCompleted_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_RespondCrossClusterTasksCompleted_Result) (*shared.RespondCrossClusterTasksCompletedResponse, error)
+	//   err := AdminService_UnpauseActivity_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_UnpauseActivity_Result) error
 }{}
 
 func init() {
-	AdminService_RespondCrossClusterTasksCompleted_Helper.Args = func(
-		request *shared.RespondCrossClusterTasksCompletedRequest,
-	) *AdminService_RespondCrossClusterTasksCompleted_Args {
-		return &AdminService_RespondCrossClusterTasksCompleted_Args{
+	AdminService_UnpauseActivity_Helper.Args = func(
+		request *shared.UnpauseActivityRequest,
+	) *AdminService_UnpauseActivity_Args {
+		return &AdminService_UnpauseActivity_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_RespondCrossClusterTasksCompleted_Helper.IsException = func(err error) bool {
+	AdminService_UnpauseActivity_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
-		case *shared.InternalServiceError:
+		case *shared.DomainNotActiveError:
 			return true
 		case *shared.ServiceBusyError:
 			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.WorkflowExecutionAlreadyCompletedError:
+			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_RespondCrossClusterTasksCompleted_Helper.WrapResponse = func(success *shared.RespondCrossClusterTasksCompletedResponse, err error) (*AdminService_RespondCrossClusterTasksCompleted_Result, error) {
+	AdminService_UnpauseActivity_Helper.WrapResponse = func(err error) (*AdminService_UnpauseActivity_Result, error) {
 		if err == nil {
-			return &AdminService_RespondCrossClusterTasksCompleted_Result{Success: success}, nil
+			return &AdminService_UnpauseActivity_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RespondCrossClusterTasksCompleted_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UnpauseActivity_Result.BadRequestError")
 			}
-			return &AdminService_RespondCrossClusterTasksCompleted_Result{BadRequestError: e}, nil
-		case *shared.InternalServiceError:
+			return &AdminService_UnpauseActivity_Result{BadRequestError: e}, nil
+		case *shared.DomainNotActiveError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RespondCrossClusterTasksCompleted_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UnpauseActivity_Result.DomainNotActiveError")
 			}
-			return &AdminService_RespondCrossClusterTasksCompleted_Result{InternalServiceError: e}, nil
+			return &AdminService_UnpauseActivity_Result{DomainNotActiveError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RespondCrossClusterTasksCompleted_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UnpauseActivity_Result.ServiceBusyError")
 			}
-			return &AdminService_RespondCrossClusterTasksCompleted_Result{ServiceBusyError: e}, nil
+			return &AdminService_UnpauseActivity_Result{ServiceBusyError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UnpauseActivity_Result.EntityNotExistError")
+			}
+			return &AdminService_UnpauseActivity_Result{EntityNotExistError: e}, nil
+		case *shared.WorkflowExecutionAlreadyCompletedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UnpauseActivity_Result.WorkflowExecutionAlreadyCompletedError")
+			}
+			return &AdminService_UnpauseActivity_Result{WorkflowExecutionAlreadyCompletedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_RespondCrossClusterTasksCompleted_Helper.UnwrapResponse = func(result *AdminService_RespondCrossClusterTasksCompleted_Result) (success *shared.RespondCrossClusterTasksCompletedResponse, err error) {
+	AdminService_UnpauseActivity_Helper.UnwrapResponse = func(result *AdminService_UnpauseActivity_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
 		}
-		if result.InternalServiceError != nil {
-			err = result.InternalServiceError
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
 			return
 		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.WorkflowExecutionAlreadyCompletedError != nil {
+			err = result.WorkflowExecutionAlreadyCompletedError
 			return
 		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_RespondCrossClusterTasksCompleted_Result represents the result of a AdminService.RespondCrossClusterTasksCompleted function call.
+// AdminService_UnpauseActivity_Result represents the result of a AdminService.UnpauseActivity function call.
 //
-// The result of a RespondCrossClusterTasksCompleted execution is sent and received over the wire as this struct.
-//
-// Success is set only if the function did not throw an exception.
-type AdminService_RespondCrossClusterTasksCompleted_Result struct {
-	// Value returned by RespondCrossClusterTasksCompleted after a successful execution.
-	Success              *shared.RespondCrossClusterTasksCompletedResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError                           `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError                      `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError                          `json:"serviceBusyError,omitempty"`
+// The result of a UnpauseActivity execution is sent and received over the wire as this struct.
+type AdminService_UnpauseActivity_Result struct {
+	BadRequestError                        *shared.BadRequestError                        `json:"badRequestError,omitempty"`
+	DomainNotActiveError                   *shared.DomainNotActiveError                   `json:"domainNotActiveError,omitempty"`
+	ServiceBusyError                       *shared.ServiceBusyError                       `json:"serviceBusyError,omitempty"`
+	EntityNotExistError                    *shared.EntityNotExistsError                   `json:"entityNotExistError,omitempty"`
+	WorkflowExecutionAlreadyCompletedError *shared.WorkflowExecutionAlreadyCompletedError `json:"workflowExecutionAlreadyCompletedError,omitempty"`
 }
 
-// ToWire translates a AdminService_RespondCrossClusterTasksCompleted_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_UnpauseActivity_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -33158,22 +39686,14 @@ type AdminService_RespondCrossClusterTasksCompleted_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_RespondCrossClusterTasksCompleted_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_UnpauseActivity_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -33182,8 +39702,8 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Result) ToWire() (wire.V
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.InternalServiceError != nil {
-		w, err = v.InternalServiceError.ToWire()
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -33198,25 +39718,35 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Result) ToWire() (wire.V
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		w, err = v.WorkflowExecutionAlreadyCompletedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_RespondCrossClusterTasksCompleted_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_UnpauseActivity_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondCrossClusterTasksCompletedResponse_Read(w wire.Value) (*shared.RespondCrossClusterTasksCompletedResponse, error) {
-	var v shared.RespondCrossClusterTasksCompletedResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_RespondCrossClusterTasksCompleted_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_UnpauseActivity_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_RespondCrossClusterTasksCompleted_Result struct
+// An error is returned if we were unable to build a AdminService_UnpauseActivity_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -33224,43 +39754,51 @@ func _RespondCrossClusterTasksCompletedResponse_Read(w wire.Value) (*shared.Resp
 //	  return nil, err
 //	}
 //
-//	var v AdminService_RespondCrossClusterTasksCompleted_Result
+//	var v AdminService_UnpauseActivity_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_RespondCrossClusterTasksCompleted_Result) FromWire(w wire.Value) error {
+func (v *AdminService_UnpauseActivity_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
+		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _RespondCrossClusterTasksCompletedResponse_Read(field.Value)
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 1:
+		case 2:
 			if field.Value.Type() == wire.TStruct {
-				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 2:
+		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 3:
+		case 4:
 			if field.Value.Type() == wire.TStruct {
-				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -33270,39 +39808,42 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Result) FromWire(w wire.
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.DomainNotActiveError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_RespondCrossClusterTasksCompleted_Result should have exactly one field: got %v fields", count)
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_UnpauseActivity_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_RespondCrossClusterTasksCompleted_Result struct directly into bytes, without going
+// Encode serializes a AdminService_UnpauseActivity_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_RespondCrossClusterTasksCompleted_Result struct could not be encoded.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_UnpauseActivity_Result struct could not be encoded.
+func (v *AdminService_UnpauseActivity_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Success != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.Success.Encode(sw); err != nil {
+		if err := v.BadRequestError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -33310,11 +39851,11 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Result) Encode(sw stream
 		}
 	}
 
-	if v.BadRequestError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
+	if v.DomainNotActiveError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.BadRequestError.Encode(sw); err != nil {
+		if err := v.DomainNotActiveError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -33322,11 +39863,11 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Result) Encode(sw stream
 		}
 	}
 
-	if v.InternalServiceError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.InternalServiceError.Encode(sw); err != nil {
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -33334,11 +39875,23 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Result) Encode(sw stream
 		}
 	}
 
-	if v.ServiceBusyError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+	if v.EntityNotExistError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.ServiceBusyError.Encode(sw); err != nil {
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecutionAlreadyCompletedError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -33347,38 +39900,35 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Result) Encode(sw stream
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.DomainNotActiveError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		count++
+	}
 
-	if count != 1 {
-		return fmt.Errorf("AdminService_RespondCrossClusterTasksCompleted_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_UnpauseActivity_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _RespondCrossClusterTasksCompletedResponse_Decode(sr stream.Reader) (*shared.RespondCrossClusterTasksCompletedResponse, error) {
-	var v shared.RespondCrossClusterTasksCompletedResponse
-	err := v.Decode(sr)
-	return &v, err
-}
-
-// Decode deserializes a AdminService_RespondCrossClusterTasksCompleted_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_UnpauseActivity_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_RespondCrossClusterTasksCompleted_Result struct could not be generated from the wire
+// An error is returned if a AdminService_UnpauseActivity_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_UnpauseActivity_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -33391,12 +39941,6 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Result) Decode(sr stream
 
 	for ok {
 		switch {
-		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _RespondCrossClusterTasksCompletedResponse_Decode(sr)
-			if err != nil {
-				return err
-			}
-
 		case fh.ID == 1 && fh.Type == wire.TStruct:
 			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
@@ -33404,7 +39948,7 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Result) Decode(sr stream
 			}
 
 		case fh.ID == 2 && fh.Type == wire.TStruct:
-			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+			v.DomainNotActiveError, err = _DomainNotActiveError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -33415,6 +39959,18 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Result) Decode(sr stream
 				return err
 			}
 
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -33435,119 +39991,117 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Result) Decode(sr stream
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.DomainNotActiveError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_RespondCrossClusterTasksCompleted_Result should have exactly one field: got %v fields", count)
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_UnpauseActivity_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_RespondCrossClusterTasksCompleted_Result
+// String returns a readable string representation of a AdminService_UnpauseActivity_Result
 // struct.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Result) String() string {
+func (v *AdminService_UnpauseActivity_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.InternalServiceError != nil {
-		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
 		i++
 	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionAlreadyCompletedError: %v", v.WorkflowExecutionAlreadyCompletedError)
+		i++
+	}
 
-	return fmt.Sprintf("AdminService_RespondCrossClusterTasksCompleted_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_UnpauseActivity_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_RespondCrossClusterTasksCompleted_Result match the
-// provided AdminService_RespondCrossClusterTasksCompleted_Result.
+// Equals returns true if all the fields of this AdminService_UnpauseActivity_Result match the
+// provided AdminService_UnpauseActivity_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Result) Equals(rhs *AdminService_RespondCrossClusterTasksCompleted_Result) bool {
+func (v *AdminService_UnpauseActivity_Result) Equals(rhs *AdminService_UnpauseActivity_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
 		return false
 	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.WorkflowExecutionAlreadyCompletedError == nil && rhs.WorkflowExecutionAlreadyCompletedError == nil) || (v.WorkflowExecutionAlreadyCompletedError != nil && rhs.WorkflowExecutionAlreadyCompletedError != nil && v.WorkflowExecutionAlreadyCompletedError.Equals(rhs.WorkflowExecutionAlreadyCompletedError))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_RespondCrossClusterTasksCompleted_Result.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_UnpauseActivity_Result.
+func (v *AdminService_UnpauseActivity_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.InternalServiceError != nil {
-		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
 	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
-	return err
-}
-
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Result) GetSuccess() (o *shared.RespondCrossClusterTasksCompletedResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
 	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecutionAlreadyCompletedError", v.WorkflowExecutionAlreadyCompletedError))
+	}
+	return err
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_UnpauseActivity_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -33556,28 +40110,28 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Result) GetBadRequestErr
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Result) IsSetBadRequestError() bool {
+func (v *AdminService_UnpauseActivity_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
-// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
-	if v != nil && v.InternalServiceError != nil {
-		return v.InternalServiceError
+func (v *AdminService_UnpauseActivity_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
 	}
 
 	return
 }
 
-// IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Result) IsSetInternalServiceError() bool {
-	return v != nil && v.InternalServiceError != nil
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *AdminService_UnpauseActivity_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *AdminService_UnpauseActivity_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -33586,33 +40140,63 @@ func (v *AdminService_RespondCrossClusterTasksCompleted_Result) GetServiceBusyEr
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Result) IsSetServiceBusyError() bool {
+func (v *AdminService_UnpauseActivity_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_UnpauseActivity_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_UnpauseActivity_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetWorkflowExecutionAlreadyCompletedError returns the value of WorkflowExecutionAlreadyCompletedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_UnpauseActivity_Result) GetWorkflowExecutionAlreadyCompletedError() (o *shared.WorkflowExecutionAlreadyCompletedError) {
+	if v != nil && v.WorkflowExecutionAlreadyCompletedError != nil {
+		return v.WorkflowExecutionAlreadyCompletedError
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionAlreadyCompletedError returns true if WorkflowExecutionAlreadyCompletedError is not nil.
+func (v *AdminService_UnpauseActivity_Result) IsSetWorkflowExecutionAlreadyCompletedError() bool {
+	return v != nil && v.WorkflowExecutionAlreadyCompletedError != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "RespondCrossClusterTasksCompleted" for this struct.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Result) MethodName() string {
-	return "RespondCrossClusterTasksCompleted"
+// This will always be "UnpauseActivity" for this struct.
+func (v *AdminService_UnpauseActivity_Result) MethodName() string {
+	return "UnpauseActivity"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_RespondCrossClusterTasksCompleted_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_UnpauseActivity_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_RestoreDynamicConfig_Args represents the arguments for the AdminService.RestoreDynamicConfig function.
+// AdminService_UpdateActivityOptions_Args represents the arguments for the AdminService.UpdateActivityOptions function.
 //
-// The arguments for RestoreDynamicConfig are sent and received over the wire as this struct.
-type AdminService_RestoreDynamicConfig_Args struct {
-	Request *RestoreDynamicConfigRequest `json:"request,omitempty"`
+// The arguments for UpdateActivityOptions are sent and received over the wire as this struct.
+type AdminService_UpdateActivityOptions_Args struct {
+	Request *shared.UpdateActivityOptionsRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_RestoreDynamicConfig_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_UpdateActivityOptions_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -33627,7 +40211,7 @@ type AdminService_RestoreDynamicConfig_Args struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_RestoreDynamicConfig_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_UpdateActivityOptions_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -33647,17 +40231,17 @@ func (v *AdminService_RestoreDynamicConfig_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RestoreDynamicConfigRequest_Read(w wire.Value) (*RestoreDynamicConfigRequest, error) {
-	var v RestoreDynamicConfigRequest
+func _UpdateActivityOptionsRequest_Read(w wire.Value) (*shared.UpdateActivityOptionsRequest, error) {
+	var v shared.UpdateActivityOptionsRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_RestoreDynamicConfig_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_UpdateActivityOptions_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_RestoreDynamicConfig_Args struct
+// An error is returned if we were unable to build a AdminService_UpdateActivityOptions_Args struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -33665,19 +40249,19 @@ func _RestoreDynamicConfigRequest_Read(w wire.Value) (*RestoreDynamicConfigReque
 //	  return nil, err
 //	}
 //
-//	var v AdminService_RestoreDynamicConfig_Args
+//	var v AdminService_UpdateActivityOptions_Args
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_RestoreDynamicConfig_Args) FromWire(w wire.Value) error {
+func (v *AdminService_UpdateActivityOptions_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _RestoreDynamicConfigRequest_Read(field.Value)
+				v.Request, err = _UpdateActivityOptionsRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -33689,11 +40273,11 @@ func (v *AdminService_RestoreDynamicConfig_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a AdminService_RestoreDynamicConfig_Args struct directly into bytes, without going
+// Encode serializes a AdminService_UpdateActivityOptions_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_RestoreDynamicConfig_Args struct could not be encoded.
-func (v *AdminService_RestoreDynamicConfig_Args) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_UpdateActivityOptions_Args struct could not be encoded.
+func (v *AdminService_UpdateActivityOptions_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -33713,18 +40297,18 @@ func (v *AdminService_RestoreDynamicConfig_Args) Encode(sw stream.Writer) error
 	return sw.WriteStructEnd()
 }
 
-func _RestoreDynamicConfigRequest_Decode(sr stream.Reader) (*RestoreDynamicConfigRequest, error) {
-	var v RestoreDynamicConfigRequest
+func _UpdateActivityOptionsRequest_Decode(sr stream.Reader) (*shared.UpdateActivityOptionsRequest, error) {
+	var v shared.UpdateActivityOptionsRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a AdminService_RestoreDynamicConfig_Args struct directly from its Thrift-level
+// Decode deserializes a AdminService_UpdateActivityOptions_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_RestoreDynamicConfig_Args struct could not be generated from the wire
+// An error is returned if a AdminService_UpdateActivityOptions_Args struct could not be generated from the wire
 // representation.
-func (v *AdminService_RestoreDynamicConfig_Args) Decode(sr stream.Reader) error {
+func (v *AdminService_UpdateActivityOptions_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -33738,7 +40322,7 @@ func (v *AdminService_RestoreDynamicConfig_Args) Decode(sr stream.Reader) error
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _RestoreDynamicConfigRequest_Decode(sr)
+			v.Request, err = _UpdateActivityOptionsRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -33765,9 +40349,9 @@ func (v *AdminService_RestoreDynamicConfig_Args) Decode(sr stream.Reader) error
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_RestoreDynamicConfig_Args
+// String returns a readable string representation of a AdminService_UpdateActivityOptions_Args
 // struct.
-func (v *AdminService_RestoreDynamicConfig_Args) String() string {
+func (v *AdminService_UpdateActivityOptions_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -33779,14 +40363,14 @@ func (v *AdminService_RestoreDynamicConfig_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_RestoreDynamicConfig_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_UpdateActivityOptions_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_RestoreDynamicConfig_Args match the
-// provided AdminService_RestoreDynamicConfig_Args.
+// Equals returns true if all the fields of this AdminService_UpdateActivityOptions_Args match the
+// provided AdminService_UpdateActivityOptions_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_RestoreDynamicConfig_Args) Equals(rhs *AdminService_RestoreDynamicConfig_Args) bool {
+func (v *AdminService_UpdateActivityOptions_Args) Equals(rhs *AdminService_UpdateActivityOptions_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -33800,8 +40384,8 @@ func (v *AdminService_RestoreDynamicConfig_Args) Equals(rhs *AdminService_Restor
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_RestoreDynamicConfig_Args.
-func (v *AdminService_RestoreDynamicConfig_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_UpdateActivityOptions_Args.
+func (v *AdminService_UpdateActivityOptions_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -33813,7 +40397,7 @@ func (v *AdminService_RestoreDynamicConfig_Args) MarshalLogObject(enc zapcore.Ob
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RestoreDynamicConfig_Args) GetRequest() (o *RestoreDynamicConfigRequest) {
+func (v *AdminService_UpdateActivityOptions_Args) GetRequest() (o *shared.UpdateActivityOptionsRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -33822,118 +40406,151 @@ func (v *AdminService_RestoreDynamicConfig_Args) GetRequest() (o *RestoreDynamic
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_RestoreDynamicConfig_Args) IsSetRequest() bool {
+func (v *AdminService_UpdateActivityOptions_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "RestoreDynamicConfig" for this struct.
-func (v *AdminService_RestoreDynamicConfig_Args) MethodName() string {
-	return "RestoreDynamicConfig"
+// This will always be "UpdateActivityOptions" for this struct.
+func (v *AdminService_UpdateActivityOptions_Args) MethodName() string {
+	return "UpdateActivityOptions"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_RestoreDynamicConfig_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_UpdateActivityOptions_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_RestoreDynamicConfig_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.RestoreDynamicConfig
+// AdminService_UpdateActivityOptions_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.UpdateActivityOptions
 // function.
-var AdminService_RestoreDynamicConfig_Helper = struct {
-	// Args accepts the parameters of RestoreDynamicConfig in-order and returns
+var AdminService_UpdateActivityOptions_Helper = struct {
+	// Args accepts the parameters of UpdateActivityOptions in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *RestoreDynamicConfigRequest,
-	) *AdminService_RestoreDynamicConfig_Args
+		request *shared.UpdateActivityOptionsRequest,
+	) *AdminService_UpdateActivityOptions_Args
 
 	// IsException returns true if the given error can be thrown
-	// by RestoreDynamicConfig.
+	// by UpdateActivityOptions.
 	//
-	// An error can be thrown by RestoreDynamicConfig only if the
+	// An error can be thrown by UpdateActivityOptions only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for RestoreDynamicConfig
+	// WrapResponse returns the result struct for UpdateActivityOptions
 	// given the error returned by it. The provided error may
-	// be nil if RestoreDynamicConfig did not fail.
+	// be nil if UpdateActivityOptions did not fail.
 	//
-	// This allows mapping errors returned by RestoreDynamicConfig into a
+	// This allows mapping errors returned by UpdateActivityOptions into a
 	// serializable result struct. WrapResponse returns a
 	// non-nil error if the provided error cannot be thrown by
-	// RestoreDynamicConfig
+	// UpdateActivityOptions
 	//
-	//   err := RestoreDynamicConfig(args)
-	//   result, err := AdminService_RestoreDynamicConfig_Helper.WrapResponse(err)
+	//   err := UpdateActivityOptions(args)
+	//   result, err := AdminService_UpdateActivityOptions_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from RestoreDynamicConfig: %v", err)
+	//     return fmt.Errorf("unexpected error from UpdateActivityOptions: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*AdminService_RestoreDynamicConfig_Result, error)
+	WrapResponse func(error) (*AdminService_UpdateActivityOptions_Result, error)
 
-	// UnwrapResponse takes the result struct for RestoreDynamicConfig
+	// UnwrapResponse takes the result struct for UpdateActivityOptions
 	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if RestoreDynamicConfig threw an
+	// The error is non-nil only if UpdateActivityOptions threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := AdminService_RestoreDynamicConfig_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_RestoreDynamicConfig_Result) error
+	//   err := AdminService_UpdateActivityOptions_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_UpdateActivityOptions_Result) error
 }{}
 
 func init() {
-	AdminService_RestoreDynamicConfig_Helper.Args = func(
-		request *RestoreDynamicConfigRequest,
-	) *AdminService_RestoreDynamicConfig_Args {
-		return &AdminService_RestoreDynamicConfig_Args{
+	AdminService_UpdateActivityOptions_Helper.Args = func(
+		request *shared.UpdateActivityOptionsRequest,
+	) *AdminService_UpdateActivityOptions_Args {
+		return &AdminService_UpdateActivityOptions_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_RestoreDynamicConfig_Helper.IsException = func(err error) bool {
+	AdminService_UpdateActivityOptions_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
-		case *shared.InternalServiceError:
+		case *shared.DomainNotActiveError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.WorkflowExecutionAlreadyCompletedError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_RestoreDynamicConfig_Helper.WrapResponse = func(err error) (*AdminService_RestoreDynamicConfig_Result, error) {
+	AdminService_UpdateActivityOptions_Helper.WrapResponse = func(err error) (*AdminService_UpdateActivityOptions_Result, error) {
 		if err == nil {
-			return &AdminService_RestoreDynamicConfig_Result{}, nil
+			return &AdminService_UpdateActivityOptions_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RestoreDynamicConfig_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UpdateActivityOptions_Result.BadRequestError")
 			}
-			return &AdminService_RestoreDynamicConfig_Result{BadRequestError: e}, nil
-		case *shared.InternalServiceError:
+			return &AdminService_UpdateActivityOptions_Result{BadRequestError: e}, nil
+		case *shared.DomainNotActiveError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RestoreDynamicConfig_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UpdateActivityOptions_Result.DomainNotActiveError")
 			}
-			return &AdminService_RestoreDynamicConfig_Result{InternalServiceError: e}, nil
+			return &AdminService_UpdateActivityOptions_Result{DomainNotActiveError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UpdateActivityOptions_Result.ServiceBusyError")
+			}
+			return &AdminService_UpdateActivityOptions_Result{ServiceBusyError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UpdateActivityOptions_Result.EntityNotExistError")
+			}
+			return &AdminService_UpdateActivityOptions_Result{EntityNotExistError: e}, nil
+		case *shared.WorkflowExecutionAlreadyCompletedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UpdateActivityOptions_Result.WorkflowExecutionAlreadyCompletedError")
+			}
+			return &AdminService_UpdateActivityOptions_Result{WorkflowExecutionAlreadyCompletedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_RestoreDynamicConfig_Helper.UnwrapResponse = func(result *AdminService_RestoreDynamicConfig_Result) (err error) {
+	AdminService_UpdateActivityOptions_Helper.UnwrapResponse = func(result *AdminService_UpdateActivityOptions_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
 		}
-		if result.InternalServiceError != nil {
-			err = result.InternalServiceError
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.WorkflowExecutionAlreadyCompletedError != nil {
+			err = result.WorkflowExecutionAlreadyCompletedError
 			return
 		}
 		return
@@ -33941,15 +40558,18 @@ func init() {
 
 }
 
-// AdminService_RestoreDynamicConfig_Result represents the result of a AdminService.RestoreDynamicConfig function call.
+// AdminService_UpdateActivityOptions_Result represents the result of a AdminService.UpdateActivityOptions function call.
 //
-// The result of a RestoreDynamicConfig execution is sent and received over the wire as this struct.
-type AdminService_RestoreDynamicConfig_Result struct {
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+// The result of a UpdateActivityOptions execution is sent and received over the wire as this struct.
+type AdminService_UpdateActivityOptions_Result struct {
+	BadRequestError                        *shared.BadRequestError                        `json:"badRequestError,omitempty"`
+	DomainNotActiveError                   *shared.DomainNotActiveError                   `json:"domainNotActiveError,omitempty"`
+	ServiceBusyError                       *shared.ServiceBusyError                       `json:"serviceBusyError,omitempty"`
+	EntityNotExistError                    *shared.EntityNotExistsError                   `json:"entityNotExistError,omitempty"`
+	WorkflowExecutionAlreadyCompletedError *shared.WorkflowExecutionAlreadyCompletedError `json:"workflowExecutionAlreadyCompletedError,omitempty"`
 }
 
-// ToWire translates a AdminService_RestoreDynamicConfig_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_UpdateActivityOptions_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -33964,9 +40584,9 @@ type AdminService_RestoreDynamicConfig_Result struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *AdminService_RestoreDynamicConfig_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_UpdateActivityOptions_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -33980,27 +40600,51 @@ func (v *AdminService_RestoreDynamicConfig_Result) ToWire() (wire.Value, error)
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.InternalServiceError != nil {
-		w, err = v.InternalServiceError.ToWire()
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		w, err = v.WorkflowExecutionAlreadyCompletedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
 
 	if i > 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_RestoreDynamicConfig_Result should have at most one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_UpdateActivityOptions_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a AdminService_RestoreDynamicConfig_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_UpdateActivityOptions_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_RestoreDynamicConfig_Result struct
+// An error is returned if we were unable to build a AdminService_UpdateActivityOptions_Result struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -34008,12 +40652,12 @@ func (v *AdminService_RestoreDynamicConfig_Result) ToWire() (wire.Value, error)
 //	  return nil, err
 //	}
 //
-//	var v AdminService_RestoreDynamicConfig_Result
+//	var v AdminService_UpdateActivityOptions_Result
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *AdminService_RestoreDynamicConfig_Result) FromWire(w wire.Value) error {
+func (v *AdminService_UpdateActivityOptions_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -34028,7 +40672,31 @@ func (v *AdminService_RestoreDynamicConfig_Result) FromWire(w wire.Value) error
 			}
 		case 2:
 			if field.Value.Type() == wire.TStruct {
-				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -34041,21 +40709,30 @@ func (v *AdminService_RestoreDynamicConfig_Result) FromWire(w wire.Value) error
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("AdminService_RestoreDynamicConfig_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_UpdateActivityOptions_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a AdminService_RestoreDynamicConfig_Result struct directly into bytes, without going
+// Encode serializes a AdminService_UpdateActivityOptions_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a AdminService_RestoreDynamicConfig_Result struct could not be encoded.
-func (v *AdminService_RestoreDynamicConfig_Result) Encode(sw stream.Writer) error {
+// An error is returned if a AdminService_UpdateActivityOptions_Result struct could not be encoded.
+func (v *AdminService_UpdateActivityOptions_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -34072,11 +40749,47 @@ func (v *AdminService_RestoreDynamicConfig_Result) Encode(sw stream.Writer) erro
 		}
 	}
 
-	if v.InternalServiceError != nil {
+	if v.DomainNotActiveError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.InternalServiceError.Encode(sw); err != nil {
+		if err := v.DomainNotActiveError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EntityNotExistError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecutionAlreadyCompletedError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -34088,23 +40801,32 @@ func (v *AdminService_RestoreDynamicConfig_Result) Encode(sw stream.Writer) erro
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
 		count++
 	}
 
 	if count > 1 {
-		return fmt.Errorf("AdminService_RestoreDynamicConfig_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_UpdateActivityOptions_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a AdminService_RestoreDynamicConfig_Result struct directly from its Thrift-level
+// Decode deserializes a AdminService_UpdateActivityOptions_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a AdminService_RestoreDynamicConfig_Result struct could not be generated from the wire
+// An error is returned if a AdminService_UpdateActivityOptions_Result struct could not be generated from the wire
 // representation.
-func (v *AdminService_RestoreDynamicConfig_Result) Decode(sr stream.Reader) error {
+func (v *AdminService_UpdateActivityOptions_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -34124,7 +40846,25 @@ func (v *AdminService_RestoreDynamicConfig_Result) Decode(sr stream.Reader) erro
 			}
 
 		case fh.ID == 2 && fh.Type == wire.TStruct:
-			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+			v.DomainNotActiveError, err = _DomainNotActiveError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.WorkflowExecutionAlreadyCompletedError, err = _WorkflowExecutionAlreadyCompletedError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -34152,42 +40892,63 @@ func (v *AdminService_RestoreDynamicConfig_Result) Decode(sr stream.Reader) erro
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("AdminService_RestoreDynamicConfig_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_UpdateActivityOptions_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_RestoreDynamicConfig_Result
+// String returns a readable string representation of a AdminService_UpdateActivityOptions_Result
 // struct.
-func (v *AdminService_RestoreDynamicConfig_Result) String() string {
+func (v *AdminService_UpdateActivityOptions_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [5]string
 	i := 0
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.InternalServiceError != nil {
-		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionAlreadyCompletedError: %v", v.WorkflowExecutionAlreadyCompletedError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_RestoreDynamicConfig_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_UpdateActivityOptions_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_RestoreDynamicConfig_Result match the
-// provided AdminService_RestoreDynamicConfig_Result.
+// Equals returns true if all the fields of this AdminService_UpdateActivityOptions_Result match the
+// provided AdminService_UpdateActivityOptions_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_RestoreDynamicConfig_Result) Equals(rhs *AdminService_RestoreDynamicConfig_Result) bool {
+func (v *AdminService_UpdateActivityOptions_Result) Equals(rhs *AdminService_UpdateActivityOptions_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -34196,7 +40957,16 @@ func (v *AdminService_RestoreDynamicConfig_Result) Equals(rhs *AdminService_Rest
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.WorkflowExecutionAlreadyCompletedError == nil && rhs.WorkflowExecutionAlreadyCompletedError == nil) || (v.WorkflowExecutionAlreadyCompletedError != nil && rhs.WorkflowExecutionAlreadyCompletedError != nil && v.WorkflowExecutionAlreadyCompletedError.Equals(rhs.WorkflowExecutionAlreadyCompletedError))) {
 		return false
 	}
 
@@ -34204,23 +40974,32 @@ func (v *AdminService_RestoreDynamicConfig_Result) Equals(rhs *AdminService_Rest
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_RestoreDynamicConfig_Result.
-func (v *AdminService_RestoreDynamicConfig_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_UpdateActivityOptions_Result.
+func (v *AdminService_UpdateActivityOptions_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.InternalServiceError != nil {
-		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.WorkflowExecutionAlreadyCompletedError != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecutionAlreadyCompletedError", v.WorkflowExecutionAlreadyCompletedError))
 	}
 	return err
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RestoreDynamicConfig_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_UpdateActivityOptions_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -34229,37 +41008,82 @@ func (v *AdminService_RestoreDynamicConfig_Result) GetBadRequestError() (o *shar
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_RestoreDynamicConfig_Result) IsSetBadRequestError() bool {
+func (v *AdminService_UpdateActivityOptions_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
-// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RestoreDynamicConfig_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
-	if v != nil && v.InternalServiceError != nil {
-		return v.InternalServiceError
+func (v *AdminService_UpdateActivityOptions_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
 	}
 
 	return
 }
 
-// IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_RestoreDynamicConfig_Result) IsSetInternalServiceError() bool {
-	return v != nil && v.InternalServiceError != nil
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *AdminService_UpdateActivityOptions_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_UpdateActivityOptions_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_UpdateActivityOptions_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_UpdateActivityOptions_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_UpdateActivityOptions_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetWorkflowExecutionAlreadyCompletedError returns the value of WorkflowExecutionAlreadyCompletedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_UpdateActivityOptions_Result) GetWorkflowExecutionAlreadyCompletedError() (o *shared.WorkflowExecutionAlreadyCompletedError) {
+	if v != nil && v.WorkflowExecutionAlreadyCompletedError != nil {
+		return v.WorkflowExecutionAlreadyCompletedError
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionAlreadyCompletedError returns true if WorkflowExecutionAlreadyCompletedError is not nil.
+func (v *AdminService_UpdateActivityOptions_Result) IsSetWorkflowExecutionAlreadyCompletedError() bool {
+	return v != nil && v.WorkflowExecutionAlreadyCompletedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "RestoreDynamicConfig" for this struct.
-func (v *AdminService_RestoreDynamicConfig_Result) MethodName() string {
-	return "RestoreDynamicConfig"
+// This will always be "UpdateActivityOptions" for this struct.
+func (v *AdminService_UpdateActivityOptions_Result) MethodName() string {
+	return "UpdateActivityOptions"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_RestoreDynamicConfig_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_UpdateActivityOptions_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
@@ -118,6 +118,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.GetDomainReplicationMessagesResponse, error)
 
+	GetDomainUsage(
+		ctx context.Context,
+		Request *shared.GetDomainUsageRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetDomainUsageResponse, error)
+
 	GetDynamicConfig(
 		ctx context.Context,
 		Request *admin.GetDynamicConfigRequest,
@@ -142,12 +148,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*admin.GetWorkflowExecutionRawHistoryV2Response, error)
 
+	ImportWorkflowExecution(
+		ctx context.Context,
+		Request *admin.ImportWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ListDynamicConfig(
 		ctx context.Context,
 		Request *admin.ListDynamicConfigRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.ListDynamicConfigResponse, error)
 
+	ListThrottledWorkflowIDs(
+		ctx context.Context,
+		Request *shared.ListThrottledWorkflowIDsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListThrottledWorkflowIDsResponse, error)
+
 	MaintainCorruptWorkflow(
 		ctx context.Context,
 		Request *admin.AdminMaintainWorkflowRequest,
@@ -160,6 +178,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.MergeDLQMessagesResponse, error)
 
+	PauseActivity(
+		ctx context.Context,
+		Request *shared.PauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	PurgeDLQMessages(
 		ctx context.Context,
 		Request *replicator.PurgeDLQMessagesRequest,
@@ -196,6 +220,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ResetActivity(
+		ctx context.Context,
+		Request *shared.ResetActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ResetQueue(
 		ctx context.Context,
 		Request *shared.ResetQueueRequest,
@@ -214,6 +244,18 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	UnpauseActivity(
+		ctx context.Context,
+		Request *shared.UnpauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateActivityOptions(
+		ctx context.Context,
+		Request *shared.UpdateActivityOptionsRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	UpdateDomainAsyncWorkflowConfiguraton(
 		ctx context.Context,
 		Request *admin.UpdateDomainAsyncWorkflowConfiguratonRequest,
@@ -631,6 +673,34 @@ func (c client) GetDomainReplicationMessages(
 	return
 }
 
+func (c client) GetDomainUsage(
+	ctx context.Context,
+	_Request *shared.GetDomainUsageRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetDomainUsageResponse, err error) {
+
+	var result admin.AdminService_GetDomainUsage_Result
+	args := admin.AdminService_GetDomainUsage_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_GetDomainUsage_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetDynamicConfig(
 	ctx context.Context,
 	_Request *admin.GetDynamicConfigRequest,
@@ -743,6 +813,34 @@ func (c client) GetWorkflowExecutionRawHistoryV2(
 	return
 }
 
+func (c client) ImportWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.ImportWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_ImportWorkflowExecution_Result
+	args := admin.AdminService_ImportWorkflowExecution_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_ImportWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ListDynamicConfig(
 	ctx context.Context,
 	_Request *admin.ListDynamicConfigRequest,
@@ -771,6 +869,34 @@ func (c client) ListDynamicConfig(
 	return
 }
 
+func (c client) ListThrottledWorkflowIDs(
+	ctx context.Context,
+	_Request *shared.ListThrottledWorkflowIDsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListThrottledWorkflowIDsResponse, err error) {
+
+	var result admin.AdminService_ListThrottledWorkflowIDs_Result
+	args := admin.AdminService_ListThrottledWorkflowIDs_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_ListThrottledWorkflowIDs_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) MaintainCorruptWorkflow(
 	ctx context.Context,
 	_Request *admin.AdminMaintainWorkflowRequest,
@@ -827,6 +953,34 @@ func (c client) MergeDLQMessages(
 	return
 }
 
+func (c client) PauseActivity(
+	ctx context.Context,
+	_Request *shared.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_PauseActivity_Result
+	args := admin.AdminService_PauseActivity_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_PauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PurgeDLQMessages(
 	ctx context.Context,
 	_Request *replicator.PurgeDLQMessagesRequest,
@@ -995,6 +1149,34 @@ func (c client) ResendReplicationTasks(
 	return
 }
 
+func (c client) ResetActivity(
+	ctx context.Context,
+	_Request *shared.ResetActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_ResetActivity_Result
+	args := admin.AdminService_ResetActivity_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_ResetActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ResetQueue(
 	ctx context.Context,
 	_Request *shared.ResetQueueRequest,
@@ -1079,6 +1261,62 @@ func (c client) RestoreDynamicConfig(
 	return
 }
 
+func (c client) UnpauseActivity(
+	ctx context.Context,
+	_Request *shared.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_UnpauseActivity_Result
+	args := admin.AdminService_UnpauseActivity_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_UnpauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateActivityOptions(
+	ctx context.Context,
+	_Request *shared.UpdateActivityOptionsRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_UpdateActivityOptions_Result
+	args := admin.AdminService_UpdateActivityOptions_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_UpdateActivityOptions_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) UpdateDomainAsyncWorkflowConfiguraton(
 	ctx context.Context,
 	_Request *admin.UpdateDomainAsyncWorkflowConfiguratonRequest,
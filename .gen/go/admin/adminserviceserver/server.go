@@ -105,6 +105,11 @@ type Interface interface {
 		Request *replicator.GetDomainReplicationMessagesRequest,
 	) (*replicator.GetDomainReplicationMessagesResponse, error)
 
+	GetDomainUsage(
+		ctx context.Context,
+		Request *shared.GetDomainUsageRequest,
+	) (*shared.GetDomainUsageResponse, error)
+
 	GetDynamicConfig(
 		ctx context.Context,
 		Request *admin.GetDynamicConfigRequest,
@@ -125,11 +130,21 @@ type Interface interface {
 		GetRequest *admin.GetWorkflowExecutionRawHistoryV2Request,
 	) (*admin.GetWorkflowExecutionRawHistoryV2Response, error)
 
+	ImportWorkflowExecution(
+		ctx context.Context,
+		Request *admin.ImportWorkflowExecutionRequest,
+	) error
+
 	ListDynamicConfig(
 		ctx context.Context,
 		Request *admin.ListDynamicConfigRequest,
 	) (*admin.ListDynamicConfigResponse, error)
 
+	ListThrottledWorkflowIDs(
+		ctx context.Context,
+		Request *shared.ListThrottledWorkflowIDsRequest,
+	) (*shared.ListThrottledWorkflowIDsResponse, error)
+
 	MaintainCorruptWorkflow(
 		ctx context.Context,
 		Request *admin.AdminMaintainWorkflowRequest,
@@ -140,6 +155,11 @@ type Interface interface {
 		Request *replicator.MergeDLQMessagesRequest,
 	) (*replicator.MergeDLQMessagesResponse, error)
 
+	PauseActivity(
+		ctx context.Context,
+		Request *shared.PauseActivityRequest,
+	) error
+
 	PurgeDLQMessages(
 		ctx context.Context,
 		Request *replicator.PurgeDLQMessagesRequest,
@@ -170,6 +190,11 @@ type Interface interface {
 		Request *admin.ResendReplicationTasksRequest,
 	) error
 
+	ResetActivity(
+		ctx context.Context,
+		Request *shared.ResetActivityRequest,
+	) error
+
 	ResetQueue(
 		ctx context.Context,
 		Request *shared.ResetQueueRequest,
@@ -185,6 +210,16 @@ type Interface interface {
 		Request *admin.RestoreDynamicConfigRequest,
 	) error
 
+	UnpauseActivity(
+		ctx context.Context,
+		Request *shared.UnpauseActivityRequest,
+	) error
+
+	UpdateActivityOptions(
+		ctx context.Context,
+		Request *shared.UpdateActivityOptionsRequest,
+	) error
+
 	UpdateDomainAsyncWorkflowConfiguraton(
 		ctx context.Context,
 		Request *admin.UpdateDomainAsyncWorkflowConfiguratonRequest,
@@ -373,6 +408,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetDomainUsage",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetDomainUsage),
+					NoWire: getdomainusage_NoWireHandler{impl},
+				},
+				Signature:    "GetDomainUsage(Request *shared.GetDomainUsageRequest) (*shared.GetDomainUsageResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetDynamicConfig",
 				HandlerSpec: thrift.HandlerSpec{
@@ -421,6 +468,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ImportWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ImportWorkflowExecution),
+					NoWire: importworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "ImportWorkflowExecution(Request *admin.ImportWorkflowExecutionRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ListDynamicConfig",
 				HandlerSpec: thrift.HandlerSpec{
@@ -433,6 +492,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListThrottledWorkflowIDs",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ListThrottledWorkflowIDs),
+					NoWire: listthrottledworkflowids_NoWireHandler{impl},
+				},
+				Signature:    "ListThrottledWorkflowIDs(Request *shared.ListThrottledWorkflowIDsRequest) (*shared.ListThrottledWorkflowIDsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "MaintainCorruptWorkflow",
 				HandlerSpec: thrift.HandlerSpec{
@@ -457,6 +528,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "PauseActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.PauseActivity),
+					NoWire: pauseactivity_NoWireHandler{impl},
+				},
+				Signature:    "PauseActivity(Request *shared.PauseActivityRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PurgeDLQMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -529,6 +612,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ResetActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ResetActivity),
+					NoWire: resetactivity_NoWireHandler{impl},
+				},
+				Signature:    "ResetActivity(Request *shared.ResetActivityRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ResetQueue",
 				HandlerSpec: thrift.HandlerSpec{
@@ -565,6 +660,30 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "UnpauseActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UnpauseActivity),
+					NoWire: unpauseactivity_NoWireHandler{impl},
+				},
+				Signature:    "UnpauseActivity(Request *shared.UnpauseActivityRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateActivityOptions",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UpdateActivityOptions),
+					NoWire: updateactivityoptions_NoWireHandler{impl},
+				},
+				Signature:    "UpdateActivityOptions(Request *shared.UpdateActivityOptionsRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "UpdateDomainAsyncWorkflowConfiguraton",
 				HandlerSpec: thrift.HandlerSpec{
@@ -615,7 +734,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 33)
+	procedures := make([]transport.Procedure, 0, 40)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -1016,6 +1135,36 @@ func (h handler) GetDomainReplicationMessages(ctx context.Context, body wire.Val
 	return response, err
 }
 
+func (h handler) GetDomainUsage(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetDomainUsage_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'GetDomainUsage': %w", err)
+	}
+
+	success, appErr := h.impl.GetDomainUsage(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_GetDomainUsage_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) GetDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_GetDynamicConfig_Args
 	if err := args.FromWire(body); err != nil {
@@ -1136,6 +1285,36 @@ func (h handler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, body wire
 	return response, err
 }
 
+func (h handler) ImportWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ImportWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ImportWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.ImportWorkflowExecution(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ImportWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ListDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_ListDynamicConfig_Args
 	if err := args.FromWire(body); err != nil {
@@ -1166,6 +1345,36 @@ func (h handler) ListDynamicConfig(ctx context.Context, body wire.Value) (thrift
 	return response, err
 }
 
+func (h handler) ListThrottledWorkflowIDs(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListThrottledWorkflowIDs_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ListThrottledWorkflowIDs': %w", err)
+	}
+
+	success, appErr := h.impl.ListThrottledWorkflowIDs(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ListThrottledWorkflowIDs_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) MaintainCorruptWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_MaintainCorruptWorkflow_Args
 	if err := args.FromWire(body); err != nil {
@@ -1226,6 +1435,36 @@ func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
+func (h handler) PauseActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PauseActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'PauseActivity': %w", err)
+	}
+
+	appErr := h.impl.PauseActivity(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_PauseActivity_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_PurgeDLQMessages_Args
 	if err := args.FromWire(body); err != nil {
@@ -1406,6 +1645,36 @@ func (h handler) ResendReplicationTasks(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
+func (h handler) ResetActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResetActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ResetActivity': %w", err)
+	}
+
+	appErr := h.impl.ResetActivity(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ResetActivity_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_ResetQueue_Args
 	if err := args.FromWire(body); err != nil {
@@ -1496,6 +1765,66 @@ func (h handler) RestoreDynamicConfig(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
+func (h handler) UnpauseActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UnpauseActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'UnpauseActivity': %w", err)
+	}
+
+	appErr := h.impl.UnpauseActivity(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UnpauseActivity_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UpdateActivityOptions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpdateActivityOptions_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'UpdateActivityOptions': %w", err)
+	}
+
+	appErr := h.impl.UpdateActivityOptions(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UpdateActivityOptions_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_UpdateDomainAsyncWorkflowConfiguraton_Args
 	if err := args.FromWire(body); err != nil {
@@ -2097,6 +2426,43 @@ func (h getdomainreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Con
 
 }
 
+type getdomainusage_NoWireHandler struct{ impl Interface }
+
+func (h getdomainusage_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_GetDomainUsage_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetDomainUsage': %w", err)
+	}
+
+	success, appErr := h.impl.GetDomainUsage(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_GetDomainUsage_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type getdynamicconfig_NoWireHandler struct{ impl Interface }
 
 func (h getdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2245,6 +2611,43 @@ func (h getworkflowexecutionrawhistoryv2_NoWireHandler) HandleNoWire(ctx context
 
 }
 
+type importworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h importworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_ImportWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ImportWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.ImportWorkflowExecution(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ImportWorkflowExecution_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type listdynamicconfig_NoWireHandler struct{ impl Interface }
 
 func (h listdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2282,6 +2685,43 @@ func (h listdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *
 
 }
 
+type listthrottledworkflowids_NoWireHandler struct{ impl Interface }
+
+func (h listthrottledworkflowids_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_ListThrottledWorkflowIDs_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ListThrottledWorkflowIDs': %w", err)
+	}
+
+	success, appErr := h.impl.ListThrottledWorkflowIDs(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ListThrottledWorkflowIDs_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type maintaincorruptworkflow_NoWireHandler struct{ impl Interface }
 
 func (h maintaincorruptworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2356,6 +2796,43 @@ func (h mergedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 
 }
 
+type pauseactivity_NoWireHandler struct{ impl Interface }
+
+func (h pauseactivity_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_PauseActivity_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'PauseActivity': %w", err)
+	}
+
+	appErr := h.impl.PauseActivity(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_PauseActivity_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type purgedlqmessages_NoWireHandler struct{ impl Interface }
 
 func (h purgedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2578,6 +3055,43 @@ func (h resendreplicationtasks_NoWireHandler) HandleNoWire(ctx context.Context,
 
 }
 
+type resetactivity_NoWireHandler struct{ impl Interface }
+
+func (h resetactivity_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_ResetActivity_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ResetActivity': %w", err)
+	}
+
+	appErr := h.impl.ResetActivity(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ResetActivity_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type resetqueue_NoWireHandler struct{ impl Interface }
 
 func (h resetqueue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2689,6 +3203,80 @@ func (h restoredynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nw
 
 }
 
+type unpauseactivity_NoWireHandler struct{ impl Interface }
+
+func (h unpauseactivity_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_UnpauseActivity_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'UnpauseActivity': %w", err)
+	}
+
+	appErr := h.impl.UnpauseActivity(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UnpauseActivity_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type updateactivityoptions_NoWireHandler struct{ impl Interface }
+
+func (h updateactivityoptions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_UpdateActivityOptions_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'UpdateActivityOptions': %w", err)
+	}
+
+	appErr := h.impl.UpdateActivityOptions(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UpdateActivityOptions_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type updatedomainasyncworkflowconfiguraton_NoWireHandler struct{ impl Interface }
 
 func (h updatedomainasyncworkflowconfiguraton_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -491,6 +491,39 @@ func (mr *_MockClientRecorder) GetDomainReplicationMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetDomainReplicationMessages", args...)
 }
 
+// GetDomainUsage responds to a GetDomainUsage call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetDomainUsage(gomock.Any(), ...).Return(...)
+//	... := client.GetDomainUsage(...)
+func (m *MockClient) GetDomainUsage(
+	ctx context.Context,
+	_Request *shared.GetDomainUsageRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetDomainUsageResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetDomainUsage", args...)
+	success, _ = ret[i].(*shared.GetDomainUsageResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetDomainUsage(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetDomainUsage", args...)
+}
+
 // GetDynamicConfig responds to a GetDynamicConfig call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -623,6 +656,37 @@ func (mr *_MockClientRecorder) GetWorkflowExecutionRawHistoryV2(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetWorkflowExecutionRawHistoryV2", args...)
 }
 
+// ImportWorkflowExecution responds to a ImportWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ImportWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.ImportWorkflowExecution(...)
+func (m *MockClient) ImportWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.ImportWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ImportWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ImportWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ImportWorkflowExecution", args...)
+}
+
 // ListDynamicConfig responds to a ListDynamicConfig call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -656,6 +720,39 @@ func (mr *_MockClientRecorder) ListDynamicConfig(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListDynamicConfig", args...)
 }
 
+// ListThrottledWorkflowIDs responds to a ListThrottledWorkflowIDs call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ListThrottledWorkflowIDs(gomock.Any(), ...).Return(...)
+//	... := client.ListThrottledWorkflowIDs(...)
+func (m *MockClient) ListThrottledWorkflowIDs(
+	ctx context.Context,
+	_Request *shared.ListThrottledWorkflowIDsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListThrottledWorkflowIDsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListThrottledWorkflowIDs", args...)
+	success, _ = ret[i].(*shared.ListThrottledWorkflowIDsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListThrottledWorkflowIDs(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListThrottledWorkflowIDs", args...)
+}
+
 // MaintainCorruptWorkflow responds to a MaintainCorruptWorkflow call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -722,6 +819,37 @@ func (mr *_MockClientRecorder) MergeDLQMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "MergeDLQMessages", args...)
 }
 
+// PauseActivity responds to a PauseActivity call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().PauseActivity(gomock.Any(), ...).Return(...)
+//	... := client.PauseActivity(...)
+func (m *MockClient) PauseActivity(
+	ctx context.Context,
+	_Request *shared.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PauseActivity", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PauseActivity(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PauseActivity", args...)
+}
+
 // PurgeDLQMessages responds to a PurgeDLQMessages call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -910,6 +1038,37 @@ func (mr *_MockClientRecorder) ResendReplicationTasks(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ResendReplicationTasks", args...)
 }
 
+// ResetActivity responds to a ResetActivity call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ResetActivity(gomock.Any(), ...).Return(...)
+//	... := client.ResetActivity(...)
+func (m *MockClient) ResetActivity(
+	ctx context.Context,
+	_Request *shared.ResetActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResetActivity", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResetActivity(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResetActivity", args...)
+}
+
 // ResetQueue responds to a ResetQueue call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1005,6 +1164,68 @@ func (mr *_MockClientRecorder) RestoreDynamicConfig(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RestoreDynamicConfig", args...)
 }
 
+// UnpauseActivity responds to a UnpauseActivity call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UnpauseActivity(gomock.Any(), ...).Return(...)
+//	... := client.UnpauseActivity(...)
+func (m *MockClient) UnpauseActivity(
+	ctx context.Context,
+	_Request *shared.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UnpauseActivity", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UnpauseActivity(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UnpauseActivity", args...)
+}
+
+// UpdateActivityOptions responds to a UpdateActivityOptions call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UpdateActivityOptions(gomock.Any(), ...).Return(...)
+//	... := client.UpdateActivityOptions(...)
+func (m *MockClient) UpdateActivityOptions(
+	ctx context.Context,
+	_Request *shared.UpdateActivityOptionsRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateActivityOptions(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateActivityOptions", args...)
+}
+
 // UpdateDomainAsyncWorkflowConfiguraton responds to a UpdateDomainAsyncWorkflowConfiguraton call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -40,11 +40,26 @@ import (
 
 // Interface is the server-side interface for the WorkflowService service.
 type Interface interface {
+	BackfillSchedule(
+		ctx context.Context,
+		Request *shared.BackfillScheduleRequest,
+	) error
+
 	CountWorkflowExecutions(
 		ctx context.Context,
 		CountRequest *shared.CountWorkflowExecutionsRequest,
 	) (*shared.CountWorkflowExecutionsResponse, error)
 
+	CreateSchedule(
+		ctx context.Context,
+		Request *shared.CreateScheduleRequest,
+	) error
+
+	DeleteSchedule(
+		ctx context.Context,
+		Request *shared.DeleteScheduleRequest,
+	) error
+
 	DeprecateDomain(
 		ctx context.Context,
 		DeprecateRequest *shared.DeprecateDomainRequest,
@@ -55,6 +70,11 @@ type Interface interface {
 		DescribeRequest *shared.DescribeDomainRequest,
 	) (*shared.DescribeDomainResponse, error)
 
+	DescribeSchedule(
+		ctx context.Context,
+		Request *shared.DescribeScheduleRequest,
+	) (*shared.DescribeScheduleResponse, error)
+
 	DescribeTaskList(
 		ctx context.Context,
 		Request *shared.DescribeTaskListRequest,
@@ -65,6 +85,11 @@ type Interface interface {
 		DescribeRequest *shared.DescribeWorkflowExecutionRequest,
 	) (*shared.DescribeWorkflowExecutionResponse, error)
 
+	ExecuteWorkflowExecution(
+		ctx context.Context,
+		ExecuteRequest *shared.ExecuteWorkflowExecutionRequest,
+	) (*shared.ExecuteWorkflowExecutionResponse, error)
+
 	GetClusterInfo(
 		ctx context.Context,
 	) (*shared.ClusterInfo, error)
@@ -103,6 +128,11 @@ type Interface interface {
 		ListRequest *shared.ListOpenWorkflowExecutionsRequest,
 	) (*shared.ListOpenWorkflowExecutionsResponse, error)
 
+	ListSchedules(
+		ctx context.Context,
+		Request *shared.ListSchedulesRequest,
+	) (*shared.ListSchedulesResponse, error)
+
 	ListTaskListPartitions(
 		ctx context.Context,
 		Request *shared.ListTaskListPartitionsRequest,
@@ -113,6 +143,21 @@ type Interface interface {
 		ListRequest *shared.ListWorkflowExecutionsRequest,
 	) (*shared.ListWorkflowExecutionsResponse, error)
 
+	PauseActivity(
+		ctx context.Context,
+		PauseRequest *shared.PauseActivityRequest,
+	) error
+
+	PauseSchedule(
+		ctx context.Context,
+		Request *shared.PauseScheduleRequest,
+	) error
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *shared.PauseWorkflowExecutionRequest,
+	) error
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *shared.PollForActivityTaskRequest,
@@ -153,6 +198,11 @@ type Interface interface {
 		CancelRequest *shared.RequestCancelWorkflowExecutionRequest,
 	) error
 
+	ResetActivity(
+		ctx context.Context,
+		ResetRequest *shared.ResetActivityRequest,
+	) error
+
 	ResetStickyTaskList(
 		ctx context.Context,
 		ResetRequest *shared.ResetStickyTaskListRequest,
@@ -248,10 +298,40 @@ type Interface interface {
 		TerminateRequest *shared.TerminateWorkflowExecutionRequest,
 	) error
 
+	TriggerSchedule(
+		ctx context.Context,
+		Request *shared.TriggerScheduleRequest,
+	) error
+
+	UnpauseActivity(
+		ctx context.Context,
+		UnpauseRequest *shared.UnpauseActivityRequest,
+	) error
+
+	UnpauseSchedule(
+		ctx context.Context,
+		Request *shared.UnpauseScheduleRequest,
+	) error
+
+	UnpauseWorkflowExecution(
+		ctx context.Context,
+		UnpauseRequest *shared.UnpauseWorkflowExecutionRequest,
+	) error
+
+	UpdateActivityOptions(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateActivityOptionsRequest,
+	) error
+
 	UpdateDomain(
 		ctx context.Context,
 		UpdateRequest *shared.UpdateDomainRequest,
 	) (*shared.UpdateDomainResponse, error)
+
+	UpdateSchedule(
+		ctx context.Context,
+		Request *shared.UpdateScheduleRequest,
+	) error
 }
 
 // New prepares an implementation of the WorkflowService service for
@@ -265,6 +345,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		Name: "WorkflowService",
 		Methods: []thrift.Method{
 
+			thrift.Method{
+				Name: "BackfillSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.BackfillSchedule),
+					NoWire: backfillschedule_NoWireHandler{impl},
+				},
+				Signature:    "BackfillSchedule(Request *shared.BackfillScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "CountWorkflowExecutions",
 				HandlerSpec: thrift.HandlerSpec{
@@ -277,6 +369,30 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "CreateSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.CreateSchedule),
+					NoWire: createschedule_NoWireHandler{impl},
+				},
+				Signature:    "CreateSchedule(Request *shared.CreateScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "DeleteSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.DeleteSchedule),
+					NoWire: deleteschedule_NoWireHandler{impl},
+				},
+				Signature:    "DeleteSchedule(Request *shared.DeleteScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DeprecateDomain",
 				HandlerSpec: thrift.HandlerSpec{
@@ -301,6 +417,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.DescribeSchedule),
+					NoWire: describeschedule_NoWireHandler{impl},
+				},
+				Signature:    "DescribeSchedule(Request *shared.DescribeScheduleRequest) (*shared.DescribeScheduleResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeTaskList",
 				HandlerSpec: thrift.HandlerSpec{
@@ -325,6 +453,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ExecuteWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ExecuteWorkflowExecution),
+					NoWire: executeworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "ExecuteWorkflowExecution(ExecuteRequest *shared.ExecuteWorkflowExecutionRequest) (*shared.ExecuteWorkflowExecutionResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetClusterInfo",
 				HandlerSpec: thrift.HandlerSpec{
@@ -421,6 +561,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListSchedules",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ListSchedules),
+					NoWire: listschedules_NoWireHandler{impl},
+				},
+				Signature:    "ListSchedules(Request *shared.ListSchedulesRequest) (*shared.ListSchedulesResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ListTaskListPartitions",
 				HandlerSpec: thrift.HandlerSpec{
@@ -445,6 +597,42 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "PauseActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.PauseActivity),
+					NoWire: pauseactivity_NoWireHandler{impl},
+				},
+				Signature:    "PauseActivity(PauseRequest *shared.PauseActivityRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PauseSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.PauseSchedule),
+					NoWire: pauseschedule_NoWireHandler{impl},
+				},
+				Signature:    "PauseSchedule(Request *shared.PauseScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PauseWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.PauseWorkflowExecution),
+					NoWire: pauseworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "PauseWorkflowExecution(PauseRequest *shared.PauseWorkflowExecutionRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PollForActivityTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -541,6 +729,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ResetActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ResetActivity),
+					NoWire: resetactivity_NoWireHandler{impl},
+				},
+				Signature:    "ResetActivity(ResetRequest *shared.ResetActivityRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ResetStickyTaskList",
 				HandlerSpec: thrift.HandlerSpec{
@@ -769,6 +969,66 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "TriggerSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.TriggerSchedule),
+					NoWire: triggerschedule_NoWireHandler{impl},
+				},
+				Signature:    "TriggerSchedule(Request *shared.TriggerScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UnpauseActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UnpauseActivity),
+					NoWire: unpauseactivity_NoWireHandler{impl},
+				},
+				Signature:    "UnpauseActivity(UnpauseRequest *shared.UnpauseActivityRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UnpauseSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UnpauseSchedule),
+					NoWire: unpauseschedule_NoWireHandler{impl},
+				},
+				Signature:    "UnpauseSchedule(Request *shared.UnpauseScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UnpauseWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UnpauseWorkflowExecution),
+					NoWire: unpauseworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "UnpauseWorkflowExecution(UnpauseRequest *shared.UnpauseWorkflowExecutionRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateActivityOptions",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UpdateActivityOptions),
+					NoWire: updateactivityoptions_NoWireHandler{impl},
+				},
+				Signature:    "UpdateActivityOptions(UpdateRequest *shared.UpdateActivityOptionsRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "UpdateDomain",
 				HandlerSpec: thrift.HandlerSpec{
@@ -780,10 +1040,22 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "UpdateDomain(UpdateRequest *shared.UpdateDomainRequest) (*shared.UpdateDomainResponse)",
 				ThriftModule: cadence.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UpdateSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UpdateSchedule),
+					NoWire: updateschedule_NoWireHandler{impl},
+				},
+				Signature:    "UpdateSchedule(Request *shared.UpdateScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 43)
+	procedures := make([]transport.Procedure, 0, 59)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -794,17 +1066,17 @@ type yarpcErrorNamer interface{ YARPCErrorName() string }
 
 type yarpcErrorCoder interface{ YARPCErrorCode() *yarpcerrors.Code }
 
-func (h handler) CountWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_CountWorkflowExecutions_Args
+func (h handler) BackfillSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_BackfillSchedule_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'CountWorkflowExecutions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'BackfillSchedule': %w", err)
 	}
 
-	success, appErr := h.impl.CountWorkflowExecutions(ctx, args.CountRequest)
+	appErr := h.impl.BackfillSchedule(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_CountWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_BackfillSchedule_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -824,17 +1096,17 @@ func (h handler) CountWorkflowExecutions(ctx context.Context, body wire.Value) (
 	return response, err
 }
 
-func (h handler) DeprecateDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_DeprecateDomain_Args
+func (h handler) CountWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_CountWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'DeprecateDomain': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'CountWorkflowExecutions': %w", err)
 	}
 
-	appErr := h.impl.DeprecateDomain(ctx, args.DeprecateRequest)
+	success, appErr := h.impl.CountWorkflowExecutions(ctx, args.CountRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_DeprecateDomain_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_CountWorkflowExecutions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -854,17 +1126,17 @@ func (h handler) DeprecateDomain(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
-func (h handler) DescribeDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_DescribeDomain_Args
+func (h handler) CreateSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_CreateSchedule_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'DescribeDomain': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'CreateSchedule': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeDomain(ctx, args.DescribeRequest)
+	appErr := h.impl.CreateSchedule(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_DescribeDomain_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_CreateSchedule_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -884,17 +1156,17 @@ func (h handler) DescribeDomain(ctx context.Context, body wire.Value) (thrift.Re
 	return response, err
 }
 
-func (h handler) DescribeTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_DescribeTaskList_Args
+func (h handler) DeleteSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_DeleteSchedule_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'DescribeTaskList': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'DeleteSchedule': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeTaskList(ctx, args.Request)
+	appErr := h.impl.DeleteSchedule(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_DescribeTaskList_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_DeleteSchedule_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -914,17 +1186,17 @@ func (h handler) DescribeTaskList(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
-func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_DescribeWorkflowExecution_Args
+func (h handler) DeprecateDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_DeprecateDomain_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'DescribeWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'DeprecateDomain': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeWorkflowExecution(ctx, args.DescribeRequest)
+	appErr := h.impl.DeprecateDomain(ctx, args.DeprecateRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_DescribeWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_DeprecateDomain_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -944,17 +1216,17 @@ func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) GetClusterInfo(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_GetClusterInfo_Args
+func (h handler) DescribeDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_DescribeDomain_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'GetClusterInfo': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'DescribeDomain': %w", err)
 	}
 
-	success, appErr := h.impl.GetClusterInfo(ctx)
+	success, appErr := h.impl.DescribeDomain(ctx, args.DescribeRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_GetClusterInfo_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_DescribeDomain_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -974,17 +1246,17 @@ func (h handler) GetClusterInfo(ctx context.Context, body wire.Value) (thrift.Re
 	return response, err
 }
 
-func (h handler) GetSearchAttributes(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_GetSearchAttributes_Args
+func (h handler) DescribeSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_DescribeSchedule_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'GetSearchAttributes': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'DescribeSchedule': %w", err)
 	}
 
-	success, appErr := h.impl.GetSearchAttributes(ctx)
+	success, appErr := h.impl.DescribeSchedule(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_GetSearchAttributes_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_DescribeSchedule_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1004,17 +1276,17 @@ func (h handler) GetSearchAttributes(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
-func (h handler) GetTaskListsByDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_GetTaskListsByDomain_Args
+func (h handler) DescribeTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_DescribeTaskList_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'GetTaskListsByDomain': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'DescribeTaskList': %w", err)
 	}
 
-	success, appErr := h.impl.GetTaskListsByDomain(ctx, args.Request)
+	success, appErr := h.impl.DescribeTaskList(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_GetTaskListsByDomain_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_DescribeTaskList_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1034,17 +1306,17 @@ func (h handler) GetTaskListsByDomain(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) GetWorkflowExecutionHistory(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_GetWorkflowExecutionHistory_Args
+func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_DescribeWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'GetWorkflowExecutionHistory': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'DescribeWorkflowExecution': %w", err)
 	}
 
-	success, appErr := h.impl.GetWorkflowExecutionHistory(ctx, args.GetRequest)
+	success, appErr := h.impl.DescribeWorkflowExecution(ctx, args.DescribeRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_GetWorkflowExecutionHistory_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_DescribeWorkflowExecution_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1064,17 +1336,17 @@ func (h handler) GetWorkflowExecutionHistory(ctx context.Context, body wire.Valu
 	return response, err
 }
 
-func (h handler) ListArchivedWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListArchivedWorkflowExecutions_Args
+func (h handler) ExecuteWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ExecuteWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListArchivedWorkflowExecutions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ExecuteWorkflowExecution': %w", err)
 	}
 
-	success, appErr := h.impl.ListArchivedWorkflowExecutions(ctx, args.ListRequest)
+	success, appErr := h.impl.ExecuteWorkflowExecution(ctx, args.ExecuteRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListArchivedWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ExecuteWorkflowExecution_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1094,17 +1366,17 @@ func (h handler) ListArchivedWorkflowExecutions(ctx context.Context, body wire.V
 	return response, err
 }
 
-func (h handler) ListClosedWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListClosedWorkflowExecutions_Args
+func (h handler) GetClusterInfo(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetClusterInfo_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListClosedWorkflowExecutions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetClusterInfo': %w", err)
 	}
 
-	success, appErr := h.impl.ListClosedWorkflowExecutions(ctx, args.ListRequest)
+	success, appErr := h.impl.GetClusterInfo(ctx)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListClosedWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetClusterInfo_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1124,17 +1396,17 @@ func (h handler) ListClosedWorkflowExecutions(ctx context.Context, body wire.Val
 	return response, err
 }
 
-func (h handler) ListDomains(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListDomains_Args
+func (h handler) GetSearchAttributes(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetSearchAttributes_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListDomains': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetSearchAttributes': %w", err)
 	}
 
-	success, appErr := h.impl.ListDomains(ctx, args.ListRequest)
+	success, appErr := h.impl.GetSearchAttributes(ctx)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListDomains_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetSearchAttributes_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1154,17 +1426,17 @@ func (h handler) ListDomains(ctx context.Context, body wire.Value) (thrift.Respo
 	return response, err
 }
 
-func (h handler) ListOpenWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListOpenWorkflowExecutions_Args
+func (h handler) GetTaskListsByDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetTaskListsByDomain_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListOpenWorkflowExecutions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetTaskListsByDomain': %w", err)
 	}
 
-	success, appErr := h.impl.ListOpenWorkflowExecutions(ctx, args.ListRequest)
+	success, appErr := h.impl.GetTaskListsByDomain(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListOpenWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetTaskListsByDomain_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1184,17 +1456,17 @@ func (h handler) ListOpenWorkflowExecutions(ctx context.Context, body wire.Value
 	return response, err
 }
 
-func (h handler) ListTaskListPartitions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListTaskListPartitions_Args
+func (h handler) GetWorkflowExecutionHistory(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetWorkflowExecutionHistory_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListTaskListPartitions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetWorkflowExecutionHistory': %w", err)
 	}
 
-	success, appErr := h.impl.ListTaskListPartitions(ctx, args.Request)
+	success, appErr := h.impl.GetWorkflowExecutionHistory(ctx, args.GetRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListTaskListPartitions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_GetWorkflowExecutionHistory_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1214,17 +1486,17 @@ func (h handler) ListTaskListPartitions(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) ListWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ListWorkflowExecutions_Args
+func (h handler) ListArchivedWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListArchivedWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ListWorkflowExecutions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListArchivedWorkflowExecutions': %w", err)
 	}
 
-	success, appErr := h.impl.ListWorkflowExecutions(ctx, args.ListRequest)
+	success, appErr := h.impl.ListArchivedWorkflowExecutions(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListArchivedWorkflowExecutions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1244,17 +1516,17 @@ func (h handler) ListWorkflowExecutions(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) PollForActivityTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_PollForActivityTask_Args
+func (h handler) ListClosedWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListClosedWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'PollForActivityTask': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListClosedWorkflowExecutions': %w", err)
 	}
 
-	success, appErr := h.impl.PollForActivityTask(ctx, args.PollRequest)
+	success, appErr := h.impl.ListClosedWorkflowExecutions(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_PollForActivityTask_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListClosedWorkflowExecutions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1274,17 +1546,17 @@ func (h handler) PollForActivityTask(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
-func (h handler) PollForDecisionTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_PollForDecisionTask_Args
+func (h handler) ListDomains(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListDomains_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'PollForDecisionTask': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListDomains': %w", err)
 	}
 
-	success, appErr := h.impl.PollForDecisionTask(ctx, args.PollRequest)
+	success, appErr := h.impl.ListDomains(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_PollForDecisionTask_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListDomains_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1304,17 +1576,17 @@ func (h handler) PollForDecisionTask(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
-func (h handler) QueryWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_QueryWorkflow_Args
+func (h handler) ListOpenWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListOpenWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'QueryWorkflow': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListOpenWorkflowExecutions': %w", err)
 	}
 
-	success, appErr := h.impl.QueryWorkflow(ctx, args.QueryRequest)
+	success, appErr := h.impl.ListOpenWorkflowExecutions(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_QueryWorkflow_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListOpenWorkflowExecutions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1334,17 +1606,17 @@ func (h handler) QueryWorkflow(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
-func (h handler) RecordActivityTaskHeartbeat(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RecordActivityTaskHeartbeat_Args
+func (h handler) ListSchedules(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListSchedules_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RecordActivityTaskHeartbeat': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListSchedules': %w", err)
 	}
 
-	success, appErr := h.impl.RecordActivityTaskHeartbeat(ctx, args.HeartbeatRequest)
+	success, appErr := h.impl.ListSchedules(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RecordActivityTaskHeartbeat_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListSchedules_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1364,17 +1636,17 @@ func (h handler) RecordActivityTaskHeartbeat(ctx context.Context, body wire.Valu
 	return response, err
 }
 
-func (h handler) RecordActivityTaskHeartbeatByID(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RecordActivityTaskHeartbeatByID_Args
+func (h handler) ListTaskListPartitions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListTaskListPartitions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RecordActivityTaskHeartbeatByID': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListTaskListPartitions': %w", err)
 	}
 
-	success, appErr := h.impl.RecordActivityTaskHeartbeatByID(ctx, args.HeartbeatRequest)
+	success, appErr := h.impl.ListTaskListPartitions(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RecordActivityTaskHeartbeatByID_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListTaskListPartitions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1394,17 +1666,17 @@ func (h handler) RecordActivityTaskHeartbeatByID(ctx context.Context, body wire.
 	return response, err
 }
 
-func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RefreshWorkflowTasks_Args
+func (h handler) ListWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RefreshWorkflowTasks': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListWorkflowExecutions': %w", err)
 	}
 
-	appErr := h.impl.RefreshWorkflowTasks(ctx, args.Request)
+	success, appErr := h.impl.ListWorkflowExecutions(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RefreshWorkflowTasks_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_ListWorkflowExecutions_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1424,17 +1696,17 @@ func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) RegisterDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RegisterDomain_Args
+func (h handler) PauseActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_PauseActivity_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RegisterDomain': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'PauseActivity': %w", err)
 	}
 
-	appErr := h.impl.RegisterDomain(ctx, args.RegisterRequest)
+	appErr := h.impl.PauseActivity(ctx, args.PauseRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RegisterDomain_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_PauseActivity_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1454,17 +1726,17 @@ func (h handler) RegisterDomain(ctx context.Context, body wire.Value) (thrift.Re
 	return response, err
 }
 
-func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RequestCancelWorkflowExecution_Args
+func (h handler) PauseSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_PauseSchedule_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RequestCancelWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'PauseSchedule': %w", err)
 	}
 
-	appErr := h.impl.RequestCancelWorkflowExecution(ctx, args.CancelRequest)
+	appErr := h.impl.PauseSchedule(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RequestCancelWorkflowExecution_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_PauseSchedule_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1484,17 +1756,17 @@ func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.V
 	return response, err
 }
 
-func (h handler) ResetStickyTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ResetStickyTaskList_Args
+func (h handler) PauseWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_PauseWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ResetStickyTaskList': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'PauseWorkflowExecution': %w", err)
 	}
 
-	success, appErr := h.impl.ResetStickyTaskList(ctx, args.ResetRequest)
+	appErr := h.impl.PauseWorkflowExecution(ctx, args.PauseRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ResetStickyTaskList_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_PauseWorkflowExecution_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1514,17 +1786,17 @@ func (h handler) ResetStickyTaskList(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
-func (h handler) ResetWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ResetWorkflowExecution_Args
+func (h handler) PollForActivityTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_PollForActivityTask_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ResetWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'PollForActivityTask': %w", err)
 	}
 
-	success, appErr := h.impl.ResetWorkflowExecution(ctx, args.ResetRequest)
+	success, appErr := h.impl.PollForActivityTask(ctx, args.PollRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ResetWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_PollForActivityTask_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1544,17 +1816,17 @@ func (h handler) ResetWorkflowExecution(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) RespondActivityTaskCanceled(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondActivityTaskCanceled_Args
+func (h handler) PollForDecisionTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_PollForDecisionTask_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskCanceled': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'PollForDecisionTask': %w", err)
 	}
 
-	appErr := h.impl.RespondActivityTaskCanceled(ctx, args.CanceledRequest)
+	success, appErr := h.impl.PollForDecisionTask(ctx, args.PollRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondActivityTaskCanceled_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_PollForDecisionTask_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1574,17 +1846,17 @@ func (h handler) RespondActivityTaskCanceled(ctx context.Context, body wire.Valu
 	return response, err
 }
 
-func (h handler) RespondActivityTaskCanceledByID(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondActivityTaskCanceledByID_Args
+func (h handler) QueryWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_QueryWorkflow_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskCanceledByID': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'QueryWorkflow': %w", err)
 	}
 
-	appErr := h.impl.RespondActivityTaskCanceledByID(ctx, args.CanceledRequest)
+	success, appErr := h.impl.QueryWorkflow(ctx, args.QueryRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondActivityTaskCanceledByID_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_QueryWorkflow_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1604,17 +1876,17 @@ func (h handler) RespondActivityTaskCanceledByID(ctx context.Context, body wire.
 	return response, err
 }
 
-func (h handler) RespondActivityTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondActivityTaskCompleted_Args
+func (h handler) RecordActivityTaskHeartbeat(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RecordActivityTaskHeartbeat_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskCompleted': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RecordActivityTaskHeartbeat': %w", err)
 	}
 
-	appErr := h.impl.RespondActivityTaskCompleted(ctx, args.CompleteRequest)
+	success, appErr := h.impl.RecordActivityTaskHeartbeat(ctx, args.HeartbeatRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondActivityTaskCompleted_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_RecordActivityTaskHeartbeat_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1634,17 +1906,17 @@ func (h handler) RespondActivityTaskCompleted(ctx context.Context, body wire.Val
 	return response, err
 }
 
-func (h handler) RespondActivityTaskCompletedByID(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondActivityTaskCompletedByID_Args
+func (h handler) RecordActivityTaskHeartbeatByID(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RecordActivityTaskHeartbeatByID_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskCompletedByID': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RecordActivityTaskHeartbeatByID': %w", err)
 	}
 
-	appErr := h.impl.RespondActivityTaskCompletedByID(ctx, args.CompleteRequest)
+	success, appErr := h.impl.RecordActivityTaskHeartbeatByID(ctx, args.HeartbeatRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondActivityTaskCompletedByID_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_RecordActivityTaskHeartbeatByID_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1664,17 +1936,17 @@ func (h handler) RespondActivityTaskCompletedByID(ctx context.Context, body wire
 	return response, err
 }
 
-func (h handler) RespondActivityTaskFailed(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondActivityTaskFailed_Args
+func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RefreshWorkflowTasks_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskFailed': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RefreshWorkflowTasks': %w", err)
 	}
 
-	appErr := h.impl.RespondActivityTaskFailed(ctx, args.FailRequest)
+	appErr := h.impl.RefreshWorkflowTasks(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondActivityTaskFailed_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_RefreshWorkflowTasks_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1694,17 +1966,17 @@ func (h handler) RespondActivityTaskFailed(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) RespondActivityTaskFailedByID(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondActivityTaskFailedByID_Args
+func (h handler) RegisterDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RegisterDomain_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskFailedByID': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RegisterDomain': %w", err)
 	}
 
-	appErr := h.impl.RespondActivityTaskFailedByID(ctx, args.FailRequest)
+	appErr := h.impl.RegisterDomain(ctx, args.RegisterRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondActivityTaskFailedByID_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_RegisterDomain_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1724,17 +1996,17 @@ func (h handler) RespondActivityTaskFailedByID(ctx context.Context, body wire.Va
 	return response, err
 }
 
-func (h handler) RespondDecisionTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondDecisionTaskCompleted_Args
+func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RequestCancelWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondDecisionTaskCompleted': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RequestCancelWorkflowExecution': %w", err)
 	}
 
-	success, appErr := h.impl.RespondDecisionTaskCompleted(ctx, args.CompleteRequest)
+	appErr := h.impl.RequestCancelWorkflowExecution(ctx, args.CancelRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondDecisionTaskCompleted_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_RequestCancelWorkflowExecution_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1754,17 +2026,17 @@ func (h handler) RespondDecisionTaskCompleted(ctx context.Context, body wire.Val
 	return response, err
 }
 
-func (h handler) RespondDecisionTaskFailed(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondDecisionTaskFailed_Args
+func (h handler) ResetActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ResetActivity_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondDecisionTaskFailed': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ResetActivity': %w", err)
 	}
 
-	appErr := h.impl.RespondDecisionTaskFailed(ctx, args.FailedRequest)
+	appErr := h.impl.ResetActivity(ctx, args.ResetRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondDecisionTaskFailed_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_ResetActivity_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1784,17 +2056,17 @@ func (h handler) RespondDecisionTaskFailed(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) RespondQueryTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RespondQueryTaskCompleted_Args
+func (h handler) ResetStickyTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ResetStickyTaskList_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondQueryTaskCompleted': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ResetStickyTaskList': %w", err)
 	}
 
-	appErr := h.impl.RespondQueryTaskCompleted(ctx, args.CompleteRequest)
+	success, appErr := h.impl.ResetStickyTaskList(ctx, args.ResetRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RespondQueryTaskCompleted_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_ResetStickyTaskList_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1814,17 +2086,17 @@ func (h handler) RespondQueryTaskCompleted(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) RestartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_RestartWorkflowExecution_Args
+func (h handler) ResetWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ResetWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'RestartWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ResetWorkflowExecution': %w", err)
 	}
 
-	success, appErr := h.impl.RestartWorkflowExecution(ctx, args.RestartRequest)
+	success, appErr := h.impl.ResetWorkflowExecution(ctx, args.ResetRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_RestartWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ResetWorkflowExecution_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1844,17 +2116,17 @@ func (h handler) RestartWorkflowExecution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) ScanWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_ScanWorkflowExecutions_Args
+func (h handler) RespondActivityTaskCanceled(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondActivityTaskCanceled_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'ScanWorkflowExecutions': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskCanceled': %w", err)
 	}
 
-	success, appErr := h.impl.ScanWorkflowExecutions(ctx, args.ListRequest)
+	appErr := h.impl.RespondActivityTaskCanceled(ctx, args.CanceledRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ScanWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_RespondActivityTaskCanceled_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1874,17 +2146,17 @@ func (h handler) ScanWorkflowExecutions(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) SignalWithStartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_SignalWithStartWorkflowExecution_Args
+func (h handler) RespondActivityTaskCanceledByID(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondActivityTaskCanceledByID_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'SignalWithStartWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskCanceledByID': %w", err)
 	}
 
-	success, appErr := h.impl.SignalWithStartWorkflowExecution(ctx, args.SignalWithStartRequest)
+	appErr := h.impl.RespondActivityTaskCanceledByID(ctx, args.CanceledRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_SignalWithStartWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_RespondActivityTaskCanceledByID_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1904,17 +2176,17 @@ func (h handler) SignalWithStartWorkflowExecution(ctx context.Context, body wire
 	return response, err
 }
 
-func (h handler) SignalWithStartWorkflowExecutionAsync(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_SignalWithStartWorkflowExecutionAsync_Args
+func (h handler) RespondActivityTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondActivityTaskCompleted_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'SignalWithStartWorkflowExecutionAsync': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskCompleted': %w", err)
 	}
 
-	success, appErr := h.impl.SignalWithStartWorkflowExecutionAsync(ctx, args.SignalWithStartRequest)
+	appErr := h.impl.RespondActivityTaskCompleted(ctx, args.CompleteRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_SignalWithStartWorkflowExecutionAsync_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_RespondActivityTaskCompleted_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1934,17 +2206,377 @@ func (h handler) SignalWithStartWorkflowExecutionAsync(ctx context.Context, body
 	return response, err
 }
 
-func (h handler) SignalWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_SignalWorkflowExecution_Args
+func (h handler) RespondActivityTaskCompletedByID(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondActivityTaskCompletedByID_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'SignalWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskCompletedByID': %w", err)
 	}
 
-	appErr := h.impl.SignalWorkflowExecution(ctx, args.SignalRequest)
+	appErr := h.impl.RespondActivityTaskCompletedByID(ctx, args.CompleteRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_SignalWorkflowExecution_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_RespondActivityTaskCompletedByID_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondActivityTaskFailed(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondActivityTaskFailed_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskFailed': %w", err)
+	}
+
+	appErr := h.impl.RespondActivityTaskFailed(ctx, args.FailRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_RespondActivityTaskFailed_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondActivityTaskFailedByID(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondActivityTaskFailedByID_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondActivityTaskFailedByID': %w", err)
+	}
+
+	appErr := h.impl.RespondActivityTaskFailedByID(ctx, args.FailRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_RespondActivityTaskFailedByID_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondDecisionTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondDecisionTaskCompleted_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondDecisionTaskCompleted': %w", err)
+	}
+
+	success, appErr := h.impl.RespondDecisionTaskCompleted(ctx, args.CompleteRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_RespondDecisionTaskCompleted_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondDecisionTaskFailed(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondDecisionTaskFailed_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondDecisionTaskFailed': %w", err)
+	}
+
+	appErr := h.impl.RespondDecisionTaskFailed(ctx, args.FailedRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_RespondDecisionTaskFailed_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RespondQueryTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RespondQueryTaskCompleted_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RespondQueryTaskCompleted': %w", err)
+	}
+
+	appErr := h.impl.RespondQueryTaskCompleted(ctx, args.CompleteRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_RespondQueryTaskCompleted_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RestartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RestartWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RestartWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.RestartWorkflowExecution(ctx, args.RestartRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_RestartWorkflowExecution_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ScanWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ScanWorkflowExecutions_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ScanWorkflowExecutions': %w", err)
+	}
+
+	success, appErr := h.impl.ScanWorkflowExecutions(ctx, args.ListRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ScanWorkflowExecutions_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) SignalWithStartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_SignalWithStartWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'SignalWithStartWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.SignalWithStartWorkflowExecution(ctx, args.SignalWithStartRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_SignalWithStartWorkflowExecution_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) SignalWithStartWorkflowExecutionAsync(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_SignalWithStartWorkflowExecutionAsync_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'SignalWithStartWorkflowExecutionAsync': %w", err)
+	}
+
+	success, appErr := h.impl.SignalWithStartWorkflowExecutionAsync(ctx, args.SignalWithStartRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_SignalWithStartWorkflowExecutionAsync_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) SignalWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_SignalWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'SignalWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.SignalWorkflowExecution(ctx, args.SignalRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_SignalWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) StartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_StartWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'StartWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.StartWorkflowExecution(ctx, args.StartRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_StartWorkflowExecution_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) StartWorkflowExecutionAsync(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_StartWorkflowExecutionAsync_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'StartWorkflowExecutionAsync': %w", err)
+	}
+
+	success, appErr := h.impl.StartWorkflowExecutionAsync(ctx, args.StartRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_StartWorkflowExecutionAsync_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1964,17 +2596,17 @@ func (h handler) SignalWorkflowExecution(ctx context.Context, body wire.Value) (
 	return response, err
 }
 
-func (h handler) StartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_StartWorkflowExecution_Args
+func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_TerminateWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'StartWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'TerminateWorkflowExecution': %w", err)
 	}
 
-	success, appErr := h.impl.StartWorkflowExecution(ctx, args.StartRequest)
+	appErr := h.impl.TerminateWorkflowExecution(ctx, args.TerminateRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_StartWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_TerminateWorkflowExecution_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1994,19 +2626,273 @@ func (h handler) StartWorkflowExecution(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) StartWorkflowExecutionAsync(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_StartWorkflowExecutionAsync_Args
+func (h handler) TriggerSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_TriggerSchedule_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'StartWorkflowExecutionAsync': %w", err)
+			"could not decode Thrift request for service 'WorkflowService' procedure 'TriggerSchedule': %w", err)
+	}
+
+	appErr := h.impl.TriggerSchedule(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_TriggerSchedule_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UnpauseActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UnpauseActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'UnpauseActivity': %w", err)
+	}
+
+	appErr := h.impl.UnpauseActivity(ctx, args.UnpauseRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UnpauseActivity_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UnpauseSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UnpauseSchedule_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'UnpauseSchedule': %w", err)
+	}
+
+	appErr := h.impl.UnpauseSchedule(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UnpauseSchedule_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UnpauseWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UnpauseWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'UnpauseWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.UnpauseWorkflowExecution(ctx, args.UnpauseRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UnpauseWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UpdateActivityOptions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UpdateActivityOptions_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'UpdateActivityOptions': %w", err)
+	}
+
+	appErr := h.impl.UpdateActivityOptions(ctx, args.UpdateRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UpdateActivityOptions_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UpdateDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UpdateDomain_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'UpdateDomain': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateDomain(ctx, args.UpdateRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UpdateDomain_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UpdateSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UpdateSchedule_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'UpdateSchedule': %w", err)
+	}
+
+	appErr := h.impl.UpdateSchedule(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UpdateSchedule_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+type backfillschedule_NoWireHandler struct{ impl Interface }
+
+func (h backfillschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_BackfillSchedule_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'BackfillSchedule': %w", err)
+	}
+
+	appErr := h.impl.BackfillSchedule(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_BackfillSchedule_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type countworkflowexecutions_NoWireHandler struct{ impl Interface }
+
+func (h countworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_CountWorkflowExecutions_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'CountWorkflowExecutions': %w", err)
 	}
 
-	success, appErr := h.impl.StartWorkflowExecutionAsync(ctx, args.StartRequest)
+	success, appErr := h.impl.CountWorkflowExecutions(ctx, args.CountRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_StartWorkflowExecutionAsync_Helper.WrapResponse(success, appErr)
-
-	var response thrift.Response
+	result, err := cadence.WorkflowService_CountWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
 		response.Body = result
@@ -2020,23 +2906,30 @@ func (h handler) StartWorkflowExecutionAsync(ctx context.Context, body wire.Valu
 			response.ApplicationErrorDetails = appErr.Error()
 		}
 	}
-
 	return response, err
+
 }
 
-func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_TerminateWorkflowExecution_Args
-	if err := args.FromWire(body); err != nil {
-		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'TerminateWorkflowExecution': %w", err)
+type createschedule_NoWireHandler struct{ impl Interface }
+
+func (h createschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_CreateSchedule_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'CreateSchedule': %w", err)
 	}
 
-	appErr := h.impl.TerminateWorkflowExecution(ctx, args.TerminateRequest)
+	appErr := h.impl.CreateSchedule(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_TerminateWorkflowExecution_Helper.WrapResponse(appErr)
-
-	var response thrift.Response
+	result, err := cadence.WorkflowService_CreateSchedule_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
 		response.Body = result
@@ -2050,23 +2943,30 @@ func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value
 			response.ApplicationErrorDetails = appErr.Error()
 		}
 	}
-
 	return response, err
+
 }
 
-func (h handler) UpdateDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args cadence.WorkflowService_UpdateDomain_Args
-	if err := args.FromWire(body); err != nil {
-		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'WorkflowService' procedure 'UpdateDomain': %w", err)
+type deleteschedule_NoWireHandler struct{ impl Interface }
+
+func (h deleteschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_DeleteSchedule_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DeleteSchedule': %w", err)
 	}
 
-	success, appErr := h.impl.UpdateDomain(ctx, args.UpdateRequest)
+	appErr := h.impl.DeleteSchedule(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_UpdateDomain_Helper.WrapResponse(success, appErr)
-
-	var response thrift.Response
+	result, err := cadence.WorkflowService_DeleteSchedule_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
 		response.Body = result
@@ -2080,15 +2980,15 @@ func (h handler) UpdateDomain(ctx context.Context, body wire.Value) (thrift.Resp
 			response.ApplicationErrorDetails = appErr.Error()
 		}
 	}
-
 	return response, err
+
 }
 
-type countworkflowexecutions_NoWireHandler struct{ impl Interface }
+type deprecatedomain_NoWireHandler struct{ impl Interface }
 
-func (h countworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h deprecatedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_CountWorkflowExecutions_Args
+		args cadence.WorkflowService_DeprecateDomain_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2096,13 +2996,13 @@ func (h countworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context,
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'CountWorkflowExecutions': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DeprecateDomain': %w", err)
 	}
 
-	success, appErr := h.impl.CountWorkflowExecutions(ctx, args.CountRequest)
+	appErr := h.impl.DeprecateDomain(ctx, args.DeprecateRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_CountWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_DeprecateDomain_Helper.WrapResponse(appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2121,11 +3021,11 @@ func (h countworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context,
 
 }
 
-type deprecatedomain_NoWireHandler struct{ impl Interface }
+type describedomain_NoWireHandler struct{ impl Interface }
 
-func (h deprecatedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h describedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_DeprecateDomain_Args
+		args cadence.WorkflowService_DescribeDomain_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2133,13 +3033,13 @@ func (h deprecatedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *th
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DeprecateDomain': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DescribeDomain': %w", err)
 	}
 
-	appErr := h.impl.DeprecateDomain(ctx, args.DeprecateRequest)
+	success, appErr := h.impl.DescribeDomain(ctx, args.DescribeRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_DeprecateDomain_Helper.WrapResponse(appErr)
+	result, err := cadence.WorkflowService_DescribeDomain_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2158,11 +3058,11 @@ func (h deprecatedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *th
 
 }
 
-type describedomain_NoWireHandler struct{ impl Interface }
+type describeschedule_NoWireHandler struct{ impl Interface }
 
-func (h describedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h describeschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_DescribeDomain_Args
+		args cadence.WorkflowService_DescribeSchedule_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2170,13 +3070,13 @@ func (h describedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thr
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DescribeDomain': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DescribeSchedule': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeDomain(ctx, args.DescribeRequest)
+	success, appErr := h.impl.DescribeSchedule(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_DescribeDomain_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_DescribeSchedule_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2269,6 +3169,43 @@ func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Contex
 
 }
 
+type executeworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h executeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_ExecuteWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ExecuteWorkflowExecution': %w", err)
+	}
+
+	success, appErr := h.impl.ExecuteWorkflowExecution(ctx, args.ExecuteRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ExecuteWorkflowExecution_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type getclusterinfo_NoWireHandler struct{ impl Interface }
 
 func (h getclusterinfo_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2458,7 +3395,155 @@ type listclosedworkflowexecutions_NoWireHandler struct{ impl Interface }
 
 func (h listclosedworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_ListClosedWorkflowExecutions_Args
+		args cadence.WorkflowService_ListClosedWorkflowExecutions_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListClosedWorkflowExecutions': %w", err)
+	}
+
+	success, appErr := h.impl.ListClosedWorkflowExecutions(ctx, args.ListRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ListClosedWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type listdomains_NoWireHandler struct{ impl Interface }
+
+func (h listdomains_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_ListDomains_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListDomains': %w", err)
+	}
+
+	success, appErr := h.impl.ListDomains(ctx, args.ListRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ListDomains_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type listopenworkflowexecutions_NoWireHandler struct{ impl Interface }
+
+func (h listopenworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_ListOpenWorkflowExecutions_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListOpenWorkflowExecutions': %w", err)
+	}
+
+	success, appErr := h.impl.ListOpenWorkflowExecutions(ctx, args.ListRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ListOpenWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type listschedules_NoWireHandler struct{ impl Interface }
+
+func (h listschedules_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_ListSchedules_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListSchedules': %w", err)
+	}
+
+	success, appErr := h.impl.ListSchedules(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ListSchedules_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type listtasklistpartitions_NoWireHandler struct{ impl Interface }
+
+func (h listtasklistpartitions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_ListTaskListPartitions_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2466,13 +3551,13 @@ func (h listclosedworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Con
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListClosedWorkflowExecutions': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListTaskListPartitions': %w", err)
 	}
 
-	success, appErr := h.impl.ListClosedWorkflowExecutions(ctx, args.ListRequest)
+	success, appErr := h.impl.ListTaskListPartitions(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListClosedWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListTaskListPartitions_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2491,11 +3576,11 @@ func (h listclosedworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Con
 
 }
 
-type listdomains_NoWireHandler struct{ impl Interface }
+type listworkflowexecutions_NoWireHandler struct{ impl Interface }
 
-func (h listdomains_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h listworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_ListDomains_Args
+		args cadence.WorkflowService_ListWorkflowExecutions_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2503,13 +3588,13 @@ func (h listdomains_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListDomains': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListWorkflowExecutions': %w", err)
 	}
 
-	success, appErr := h.impl.ListDomains(ctx, args.ListRequest)
+	success, appErr := h.impl.ListWorkflowExecutions(ctx, args.ListRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListDomains_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_ListWorkflowExecutions_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2528,11 +3613,11 @@ func (h listdomains_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift
 
 }
 
-type listopenworkflowexecutions_NoWireHandler struct{ impl Interface }
+type pauseactivity_NoWireHandler struct{ impl Interface }
 
-func (h listopenworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h pauseactivity_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_ListOpenWorkflowExecutions_Args
+		args cadence.WorkflowService_PauseActivity_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2540,13 +3625,13 @@ func (h listopenworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Conte
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListOpenWorkflowExecutions': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'PauseActivity': %w", err)
 	}
 
-	success, appErr := h.impl.ListOpenWorkflowExecutions(ctx, args.ListRequest)
+	appErr := h.impl.PauseActivity(ctx, args.PauseRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListOpenWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_PauseActivity_Helper.WrapResponse(appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2565,11 +3650,11 @@ func (h listopenworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Conte
 
 }
 
-type listtasklistpartitions_NoWireHandler struct{ impl Interface }
+type pauseschedule_NoWireHandler struct{ impl Interface }
 
-func (h listtasklistpartitions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h pauseschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_ListTaskListPartitions_Args
+		args cadence.WorkflowService_PauseSchedule_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2577,13 +3662,13 @@ func (h listtasklistpartitions_NoWireHandler) HandleNoWire(ctx context.Context,
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListTaskListPartitions': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'PauseSchedule': %w", err)
 	}
 
-	success, appErr := h.impl.ListTaskListPartitions(ctx, args.Request)
+	appErr := h.impl.PauseSchedule(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListTaskListPartitions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_PauseSchedule_Helper.WrapResponse(appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2602,11 +3687,11 @@ func (h listtasklistpartitions_NoWireHandler) HandleNoWire(ctx context.Context,
 
 }
 
-type listworkflowexecutions_NoWireHandler struct{ impl Interface }
+type pauseworkflowexecution_NoWireHandler struct{ impl Interface }
 
-func (h listworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h pauseworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args cadence.WorkflowService_ListWorkflowExecutions_Args
+		args cadence.WorkflowService_PauseWorkflowExecution_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -2614,13 +3699,13 @@ func (h listworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context,
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListWorkflowExecutions': %w", err)
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'PauseWorkflowExecution': %w", err)
 	}
 
-	success, appErr := h.impl.ListWorkflowExecutions(ctx, args.ListRequest)
+	appErr := h.impl.PauseWorkflowExecution(ctx, args.PauseRequest)
 
 	hadError := appErr != nil
-	result, err := cadence.WorkflowService_ListWorkflowExecutions_Helper.WrapResponse(success, appErr)
+	result, err := cadence.WorkflowService_PauseWorkflowExecution_Helper.WrapResponse(appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2935,6 +4020,43 @@ func (h requestcancelworkflowexecution_NoWireHandler) HandleNoWire(ctx context.C
 
 }
 
+type resetactivity_NoWireHandler struct{ impl Interface }
+
+func (h resetactivity_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_ResetActivity_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ResetActivity': %w", err)
+	}
+
+	appErr := h.impl.ResetActivity(ctx, args.ResetRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ResetActivity_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type resetstickytasklist_NoWireHandler struct{ impl Interface }
 
 func (h resetstickytasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3638,6 +4760,191 @@ func (h terminateworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Conte
 
 }
 
+type triggerschedule_NoWireHandler struct{ impl Interface }
+
+func (h triggerschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_TriggerSchedule_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'TriggerSchedule': %w", err)
+	}
+
+	appErr := h.impl.TriggerSchedule(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_TriggerSchedule_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type unpauseactivity_NoWireHandler struct{ impl Interface }
+
+func (h unpauseactivity_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_UnpauseActivity_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'UnpauseActivity': %w", err)
+	}
+
+	appErr := h.impl.UnpauseActivity(ctx, args.UnpauseRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UnpauseActivity_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type unpauseschedule_NoWireHandler struct{ impl Interface }
+
+func (h unpauseschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_UnpauseSchedule_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'UnpauseSchedule': %w", err)
+	}
+
+	appErr := h.impl.UnpauseSchedule(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UnpauseSchedule_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type unpauseworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h unpauseworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_UnpauseWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'UnpauseWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.UnpauseWorkflowExecution(ctx, args.UnpauseRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UnpauseWorkflowExecution_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type updateactivityoptions_NoWireHandler struct{ impl Interface }
+
+func (h updateactivityoptions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_UpdateActivityOptions_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'UpdateActivityOptions': %w", err)
+	}
+
+	appErr := h.impl.UpdateActivityOptions(ctx, args.UpdateRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UpdateActivityOptions_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type updatedomain_NoWireHandler struct{ impl Interface }
 
 func (h updatedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3674,3 +4981,40 @@ func (h updatedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrif
 	return response, err
 
 }
+
+type updateschedule_NoWireHandler struct{ impl Interface }
+
+func (h updateschedule_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_UpdateSchedule_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'UpdateSchedule': %w", err)
+	}
+
+	appErr := h.impl.UpdateSchedule(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UpdateSchedule_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
@@ -43,6 +43,440 @@ import (
 	shared "github.com/uber/cadence/.gen/go/shared"
 )
 
+type DLQFilter struct {
+	DomainID   *string              `json:"domainID,omitempty"`
+	WorkflowID *string              `json:"workflowID,omitempty"`
+	TaskType   *ReplicationTaskType `json:"taskType,omitempty"`
+	ErrorClass *string              `json:"errorClass,omitempty"`
+}
+
+// ToWire translates a DLQFilter struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *DLQFilter) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainID != nil {
+		w, err = wire.NewValueString(*(v.DomainID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowID != nil {
+		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TaskType != nil {
+		w, err = v.TaskType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ErrorClass != nil {
+		w, err = wire.NewValueString(*(v.ErrorClass)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationTaskType_Read(w wire.Value) (ReplicationTaskType, error) {
+	var v ReplicationTaskType
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a DLQFilter struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DLQFilter struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v DLQFilter
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *DLQFilter) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x ReplicationTaskType
+				x, err = _ReplicationTaskType_Read(field.Value)
+				v.TaskType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ErrorClass = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a DLQFilter struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a DLQFilter struct could not be encoded.
+func (v *DLQFilter) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.DomainID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.DomainID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.WorkflowID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TaskType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.TaskType.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ErrorClass != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ErrorClass)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _ReplicationTaskType_Decode(sr stream.Reader) (ReplicationTaskType, error) {
+	var v ReplicationTaskType
+	err := v.Decode(sr)
+	return v, err
+}
+
+// Decode deserializes a DLQFilter struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a DLQFilter struct could not be generated from the wire
+// representation.
+func (v *DLQFilter) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.DomainID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.WorkflowID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI32:
+			var x ReplicationTaskType
+			x, err = _ReplicationTaskType_Decode(sr)
+			v.TaskType = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ErrorClass = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DLQFilter
+// struct.
+func (v *DLQFilter) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.DomainID != nil {
+		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
+		i++
+	}
+	if v.WorkflowID != nil {
+		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
+		i++
+	}
+	if v.TaskType != nil {
+		fields[i] = fmt.Sprintf("TaskType: %v", *(v.TaskType))
+		i++
+	}
+	if v.ErrorClass != nil {
+		fields[i] = fmt.Sprintf("ErrorClass: %v", *(v.ErrorClass))
+		i++
+	}
+
+	return fmt.Sprintf("DLQFilter{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _ReplicationTaskType_EqualsPtr(lhs, rhs *ReplicationTaskType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this DLQFilter match the
+// provided DLQFilter.
+//
+// This function performs a deep comparison.
+func (v *DLQFilter) Equals(rhs *DLQFilter) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainID, rhs.DomainID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
+		return false
+	}
+	if !_ReplicationTaskType_EqualsPtr(v.TaskType, rhs.TaskType) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ErrorClass, rhs.ErrorClass) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DLQFilter.
+func (v *DLQFilter) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainID != nil {
+		enc.AddString("domainID", *v.DomainID)
+	}
+	if v.WorkflowID != nil {
+		enc.AddString("workflowID", *v.WorkflowID)
+	}
+	if v.TaskType != nil {
+		err = multierr.Append(err, enc.AddObject("taskType", *v.TaskType))
+	}
+	if v.ErrorClass != nil {
+		enc.AddString("errorClass", *v.ErrorClass)
+	}
+	return err
+}
+
+// GetDomainID returns the value of DomainID if it is set or its
+// zero value if it is unset.
+func (v *DLQFilter) GetDomainID() (o string) {
+	if v != nil && v.DomainID != nil {
+		return *v.DomainID
+	}
+
+	return
+}
+
+// IsSetDomainID returns true if DomainID is not nil.
+func (v *DLQFilter) IsSetDomainID() bool {
+	return v != nil && v.DomainID != nil
+}
+
+// GetWorkflowID returns the value of WorkflowID if it is set or its
+// zero value if it is unset.
+func (v *DLQFilter) GetWorkflowID() (o string) {
+	if v != nil && v.WorkflowID != nil {
+		return *v.WorkflowID
+	}
+
+	return
+}
+
+// IsSetWorkflowID returns true if WorkflowID is not nil.
+func (v *DLQFilter) IsSetWorkflowID() bool {
+	return v != nil && v.WorkflowID != nil
+}
+
+// GetTaskType returns the value of TaskType if it is set or its
+// zero value if it is unset.
+func (v *DLQFilter) GetTaskType() (o ReplicationTaskType) {
+	if v != nil && v.TaskType != nil {
+		return *v.TaskType
+	}
+
+	return
+}
+
+// IsSetTaskType returns true if TaskType is not nil.
+func (v *DLQFilter) IsSetTaskType() bool {
+	return v != nil && v.TaskType != nil
+}
+
+// GetErrorClass returns the value of ErrorClass if it is set or its
+// zero value if it is unset.
+func (v *DLQFilter) GetErrorClass() (o string) {
+	if v != nil && v.ErrorClass != nil {
+		return *v.ErrorClass
+	}
+
+	return
+}
+
+// IsSetErrorClass returns true if ErrorClass is not nil.
+func (v *DLQFilter) IsSetErrorClass() bool {
+	return v != nil && v.ErrorClass != nil
+}
+
 type DLQType int32
 
 const (
@@ -954,16 +1388,6 @@ func _DomainOperation_EqualsPtr(lhs, rhs *DomainOperation) bool {
 	return lhs == nil && rhs == nil
 }
 
-func _String_EqualsPtr(lhs, rhs *string) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
 func _I64_EqualsPtr(lhs, rhs *int64) bool {
 	if lhs != nil && rhs != nil {
 
@@ -4601,12 +5025,13 @@ func (v *HistoryTaskV2Attributes) IsSetNewRunEvents() bool {
 }
 
 type MergeDLQMessagesRequest struct {
-	Type                  *DLQType `json:"type,omitempty"`
-	ShardID               *int32   `json:"shardID,omitempty"`
-	SourceCluster         *string  `json:"sourceCluster,omitempty"`
-	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
-	MaximumPageSize       *int32   `json:"maximumPageSize,omitempty"`
-	NextPageToken         []byte   `json:"nextPageToken,omitempty"`
+	Type                  *DLQType   `json:"type,omitempty"`
+	ShardID               *int32     `json:"shardID,omitempty"`
+	SourceCluster         *string    `json:"sourceCluster,omitempty"`
+	InclusiveEndMessageID *int64     `json:"inclusiveEndMessageID,omitempty"`
+	MaximumPageSize       *int32     `json:"maximumPageSize,omitempty"`
+	NextPageToken         []byte     `json:"nextPageToken,omitempty"`
+	Filter                *DLQFilter `json:"filter,omitempty"`
 }
 
 // ToWire translates a MergeDLQMessagesRequest struct into a Thrift-level intermediate
@@ -4626,7 +5051,7 @@ type MergeDLQMessagesRequest struct {
 //	}
 func (v *MergeDLQMessagesRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -4680,6 +5105,14 @@ func (v *MergeDLQMessagesRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.Filter != nil {
+		w, err = v.Filter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -4690,6 +5123,12 @@ func _DLQType_Read(w wire.Value) (DLQType, error) {
 	return v, err
 }
 
+func _DLQFilter_Read(w wire.Value) (*DLQFilter, error) {
+	var v DLQFilter
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a MergeDLQMessagesRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -4769,6 +5208,14 @@ func (v *MergeDLQMessagesRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.Filter, err = _DLQFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -4857,6 +5304,18 @@ func (v *MergeDLQMessagesRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Filter != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Filter.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -4866,6 +5325,12 @@ func _DLQType_Decode(sr stream.Reader) (DLQType, error) {
 	return v, err
 }
 
+func _DLQFilter_Decode(sr stream.Reader) (*DLQFilter, error) {
+	var v DLQFilter
+	err := v.Decode(sr)
+	return &v, err
+}
+
 // Decode deserializes a MergeDLQMessagesRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -4930,6 +5395,12 @@ func (v *MergeDLQMessagesRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 70 && fh.Type == wire.TStruct:
+			v.Filter, err = _DLQFilter_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -4959,7 +5430,7 @@ func (v *MergeDLQMessagesRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [7]string
 	i := 0
 	if v.Type != nil {
 		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
@@ -4985,6 +5456,10 @@ func (v *MergeDLQMessagesRequest) String() string {
 		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
 		i++
 	}
+	if v.Filter != nil {
+		fields[i] = fmt.Sprintf("Filter: %v", v.Filter)
+		i++
+	}
 
 	return fmt.Sprintf("MergeDLQMessagesRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -5037,6 +5512,9 @@ func (v *MergeDLQMessagesRequest) Equals(rhs *MergeDLQMessagesRequest) bool {
 	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
+	if !((v.Filter == nil && rhs.Filter == nil) || (v.Filter != nil && rhs.Filter != nil && v.Filter.Equals(rhs.Filter))) {
+		return false
+	}
 
 	return true
 }
@@ -5065,6 +5543,9 @@ func (v *MergeDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (e
 	if v.NextPageToken != nil {
 		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
 	}
+	if v.Filter != nil {
+		err = multierr.Append(err, enc.AddObject("filter", v.Filter))
+	}
 	return err
 }
 
@@ -5158,6 +5639,21 @@ func (v *MergeDLQMessagesRequest) IsSetNextPageToken() bool {
 	return v != nil && v.NextPageToken != nil
 }
 
+// GetFilter returns the value of Filter if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetFilter() (o *DLQFilter) {
+	if v != nil && v.Filter != nil {
+		return v.Filter
+	}
+
+	return
+}
+
+// IsSetFilter returns true if Filter is not nil.
+func (v *MergeDLQMessagesRequest) IsSetFilter() bool {
+	return v != nil && v.Filter != nil
+}
+
 type MergeDLQMessagesResponse struct {
 	NextPageToken []byte `json:"nextPageToken,omitempty"`
 }
@@ -5767,12 +6263,13 @@ func (v *PurgeDLQMessagesRequest) IsSetInclusiveEndMessageID() bool {
 }
 
 type ReadDLQMessagesRequest struct {
-	Type                  *DLQType `json:"type,omitempty"`
-	ShardID               *int32   `json:"shardID,omitempty"`
-	SourceCluster         *string  `json:"sourceCluster,omitempty"`
-	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
-	MaximumPageSize       *int32   `json:"maximumPageSize,omitempty"`
-	NextPageToken         []byte   `json:"nextPageToken,omitempty"`
+	Type                  *DLQType   `json:"type,omitempty"`
+	ShardID               *int32     `json:"shardID,omitempty"`
+	SourceCluster         *string    `json:"sourceCluster,omitempty"`
+	InclusiveEndMessageID *int64     `json:"inclusiveEndMessageID,omitempty"`
+	MaximumPageSize       *int32     `json:"maximumPageSize,omitempty"`
+	NextPageToken         []byte     `json:"nextPageToken,omitempty"`
+	Filter                *DLQFilter `json:"filter,omitempty"`
 }
 
 // ToWire translates a ReadDLQMessagesRequest struct into a Thrift-level intermediate
@@ -5792,7 +6289,7 @@ type ReadDLQMessagesRequest struct {
 //	}
 func (v *ReadDLQMessagesRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -5846,6 +6343,14 @@ func (v *ReadDLQMessagesRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.Filter != nil {
+		w, err = v.Filter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -5929,6 +6434,14 @@ func (v *ReadDLQMessagesRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.Filter, err = _DLQFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -6017,6 +6530,18 @@ func (v *ReadDLQMessagesRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Filter != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Filter.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -6084,6 +6609,12 @@ func (v *ReadDLQMessagesRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 70 && fh.Type == wire.TStruct:
+			v.Filter, err = _DLQFilter_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -6113,7 +6644,7 @@ func (v *ReadDLQMessagesRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [7]string
 	i := 0
 	if v.Type != nil {
 		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
@@ -6139,6 +6670,10 @@ func (v *ReadDLQMessagesRequest) String() string {
 		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
 		i++
 	}
+	if v.Filter != nil {
+		fields[i] = fmt.Sprintf("Filter: %v", v.Filter)
+		i++
+	}
 
 	return fmt.Sprintf("ReadDLQMessagesRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -6171,6 +6706,9 @@ func (v *ReadDLQMessagesRequest) Equals(rhs *ReadDLQMessagesRequest) bool {
 	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
+	if !((v.Filter == nil && rhs.Filter == nil) || (v.Filter != nil && rhs.Filter != nil && v.Filter.Equals(rhs.Filter))) {
+		return false
+	}
 
 	return true
 }
@@ -6199,6 +6737,9 @@ func (v *ReadDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (er
 	if v.NextPageToken != nil {
 		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
 	}
+	if v.Filter != nil {
+		err = multierr.Append(err, enc.AddObject("filter", v.Filter))
+	}
 	return err
 }
 
@@ -6292,6 +6833,21 @@ func (v *ReadDLQMessagesRequest) IsSetNextPageToken() bool {
 	return v != nil && v.NextPageToken != nil
 }
 
+// GetFilter returns the value of Filter if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetFilter() (o *DLQFilter) {
+	if v != nil && v.Filter != nil {
+		return v.Filter
+	}
+
+	return
+}
+
+// IsSetFilter returns true if Filter is not nil.
+func (v *ReadDLQMessagesRequest) IsSetFilter() bool {
+	return v != nil && v.Filter != nil
+}
+
 type ReadDLQMessagesResponse struct {
 	Type                 *DLQType               `json:"type,omitempty"`
 	ReplicationTasks     []*ReplicationTask     `json:"replicationTasks,omitempty"`
@@ -7200,12 +7756,6 @@ func (v *ReplicationTask) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ReplicationTaskType_Read(w wire.Value) (ReplicationTaskType, error) {
-	var v ReplicationTaskType
-	err := v.FromWire(w)
-	return v, err
-}
-
 func _DomainTaskAttributes_Read(w wire.Value) (*DomainTaskAttributes, error) {
 	var v DomainTaskAttributes
 	err := v.FromWire(w)
@@ -7436,12 +7986,6 @@ func (v *ReplicationTask) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _ReplicationTaskType_Decode(sr stream.Reader) (ReplicationTaskType, error) {
-	var v ReplicationTaskType
-	err := v.Decode(sr)
-	return v, err
-}
-
 func _DomainTaskAttributes_Decode(sr stream.Reader) (*DomainTaskAttributes, error) {
 	var v DomainTaskAttributes
 	err := v.Decode(sr)
@@ -7605,16 +8149,6 @@ func (v *ReplicationTask) String() string {
 	return fmt.Sprintf("ReplicationTask{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _ReplicationTaskType_EqualsPtr(lhs, rhs *ReplicationTaskType) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
-	}
-	return lhs == nil && rhs == nil
-}
-
 // Equals returns true if all the fields of this ReplicationTask match the
 // provided ReplicationTask.
 //
@@ -7816,6 +8350,7 @@ type ReplicationTaskInfo struct {
 	FirstEventID *int64  `json:"firstEventID,omitempty"`
 	NextEventID  *int64  `json:"nextEventID,omitempty"`
 	ScheduledID  *int64  `json:"scheduledID,omitempty"`
+	ErrorClass   *string `json:"errorClass,omitempty"`
 }
 
 // ToWire translates a ReplicationTaskInfo struct into a Thrift-level intermediate
@@ -7835,7 +8370,7 @@ type ReplicationTaskInfo struct {
 //	}
 func (v *ReplicationTaskInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [9]wire.Field
+		fields [10]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -7913,6 +8448,14 @@ func (v *ReplicationTaskInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 90, Value: w}
 		i++
 	}
+	if v.ErrorClass != nil {
+		w, err = wire.NewValueString(*(v.ErrorClass)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -8028,6 +8571,16 @@ func (v *ReplicationTaskInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 100:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ErrorClass = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -8152,6 +8705,18 @@ func (v *ReplicationTaskInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.ErrorClass != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ErrorClass)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -8245,6 +8810,14 @@ func (v *ReplicationTaskInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 100 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ErrorClass = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -8274,7 +8847,7 @@ func (v *ReplicationTaskInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [9]string
+	var fields [10]string
 	i := 0
 	if v.DomainID != nil {
 		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
@@ -8312,6 +8885,10 @@ func (v *ReplicationTaskInfo) String() string {
 		fields[i] = fmt.Sprintf("ScheduledID: %v", *(v.ScheduledID))
 		i++
 	}
+	if v.ErrorClass != nil {
+		fields[i] = fmt.Sprintf("ErrorClass: %v", *(v.ErrorClass))
+		i++
+	}
 
 	return fmt.Sprintf("ReplicationTaskInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -8363,6 +8940,9 @@ func (v *ReplicationTaskInfo) Equals(rhs *ReplicationTaskInfo) bool {
 	if !_I64_EqualsPtr(v.ScheduledID, rhs.ScheduledID) {
 		return false
 	}
+	if !_String_EqualsPtr(v.ErrorClass, rhs.ErrorClass) {
+		return false
+	}
 
 	return true
 }
@@ -8400,6 +8980,9 @@ func (v *ReplicationTaskInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err e
 	if v.ScheduledID != nil {
 		enc.AddInt64("scheduledID", *v.ScheduledID)
 	}
+	if v.ErrorClass != nil {
+		enc.AddString("errorClass", *v.ErrorClass)
+	}
 	return err
 }
 
@@ -8538,6 +9121,21 @@ func (v *ReplicationTaskInfo) IsSetScheduledID() bool {
 	return v != nil && v.ScheduledID != nil
 }
 
+// GetErrorClass returns the value of ErrorClass if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetErrorClass() (o string) {
+	if v != nil && v.ErrorClass != nil {
+		return *v.ErrorClass
+	}
+
+	return
+}
+
+// IsSetErrorClass returns true if ErrorClass is not nil.
+func (v *ReplicationTaskInfo) IsSetErrorClass() bool {
+	return v != nil && v.ErrorClass != nil
+}
+
 type ReplicationTaskType int32
 
 const (
@@ -9156,6 +9754,7 @@ type SyncActivityTaskAttributes struct {
 	LastWorkerIdentity *string                `json:"lastWorkerIdentity,omitempty"`
 	LastFailureDetails []byte                 `json:"lastFailureDetails,omitempty"`
 	VersionHistory     *shared.VersionHistory `json:"versionHistory,omitempty"`
+	Paused             *bool                  `json:"paused,omitempty"`
 }
 
 // ToWire translates a SyncActivityTaskAttributes struct into a Thrift-level intermediate
@@ -9175,7 +9774,7 @@ type SyncActivityTaskAttributes struct {
 //	}
 func (v *SyncActivityTaskAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [15]wire.Field
+		fields [16]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -9301,6 +9900,14 @@ func (v *SyncActivityTaskAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 150, Value: w}
 		i++
 	}
+	if v.Paused != nil {
+		w, err = wire.NewValueBool(*(v.Paused)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 160, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -9476,6 +10083,16 @@ func (v *SyncActivityTaskAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 160:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Paused = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -9672,6 +10289,18 @@ func (v *SyncActivityTaskAttributes) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Paused != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 160, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Paused)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -9813,6 +10442,14 @@ func (v *SyncActivityTaskAttributes) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 160 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Paused = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -9842,7 +10479,7 @@ func (v *SyncActivityTaskAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [15]string
+	var fields [16]string
 	i := 0
 	if v.DomainId != nil {
 		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
@@ -9904,6 +10541,10 @@ func (v *SyncActivityTaskAttributes) String() string {
 		fields[i] = fmt.Sprintf("VersionHistory: %v", v.VersionHistory)
 		i++
 	}
+	if v.Paused != nil {
+		fields[i] = fmt.Sprintf("Paused: %v", *(v.Paused))
+		i++
+	}
 
 	return fmt.Sprintf("SyncActivityTaskAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -9963,6 +10604,9 @@ func (v *SyncActivityTaskAttributes) Equals(rhs *SyncActivityTaskAttributes) boo
 	if !((v.VersionHistory == nil && rhs.VersionHistory == nil) || (v.VersionHistory != nil && rhs.VersionHistory != nil && v.VersionHistory.Equals(rhs.VersionHistory))) {
 		return false
 	}
+	if !_Bool_EqualsPtr(v.Paused, rhs.Paused) {
+		return false
+	}
 
 	return true
 }
@@ -10018,6 +10662,9 @@ func (v *SyncActivityTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder)
 	if v.VersionHistory != nil {
 		err = multierr.Append(err, enc.AddObject("versionHistory", v.VersionHistory))
 	}
+	if v.Paused != nil {
+		enc.AddBool("paused", *v.Paused)
+	}
 	return err
 }
 
@@ -10246,6 +10893,21 @@ func (v *SyncActivityTaskAttributes) IsSetVersionHistory() bool {
 	return v != nil && v.VersionHistory != nil
 }
 
+// GetPaused returns the value of Paused if it is set or its
+// zero value if it is unset.
+func (v *SyncActivityTaskAttributes) GetPaused() (o bool) {
+	if v != nil && v.Paused != nil {
+		return *v.Paused
+	}
+
+	return
+}
+
+// IsSetPaused returns true if Paused is not nil.
+func (v *SyncActivityTaskAttributes) IsSetPaused() bool {
+	return v != nil && v.Paused != nil
+}
+
 type SyncShardStatus struct {
 	Timestamp *int64 `json:"timestamp,omitempty"`
 }
@@ -10799,11 +11461,11 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "replicator",
 	Package:  "github.com/uber/cadence/.gen/go/replicator",
 	FilePath: "replicator.thrift",
-	SHA1:     "85475c6529845fd2ee1b039bfa9eda1128146139",
+	SHA1:     "8cf37233d6a7c505d4999165fb198a0036c6e841",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.replicator\n\ninclude \"shared.thrift\"\n\nenum ReplicationTaskType {\n  Domain\n  History\n  SyncShardStatus\n  SyncActivity\n  HistoryMetadata\n  HistoryV2\n  FailoverMarker\n}\n\nenum DomainOperation {\n  Create\n  Update\n}\n\nstruct DomainTaskAttributes {\n  05: optional DomainOperation domainOperation\n  10: optional string id\n  20: optional shared.DomainInfo info\n  30: optional shared.DomainConfiguration config\n  40: optional shared.DomainReplicationConfiguration replicationConfig\n  50: optional i64 (js.type = \"Long\") configVersion\n  60: optional i64 (js.type = \"Long\") failoverVersion\n  70: optional i64 (js.type = \"Long\") previousFailoverVersion\n}\n\nstruct SyncShardStatusTaskAttributes {\n  10: optional string sourceCluster\n  20: optional i64 (js.type = \"Long\") shardId\n  30: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct SyncActivityTaskAttributes {\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional i64 (js.type = \"Long\") version\n  50: optional i64 (js.type = \"Long\") scheduledId\n  60: optional i64 (js.type = \"Long\") scheduledTime\n  70: optional i64 (js.type = \"Long\") startedId\n  80: optional i64 (js.type = \"Long\") startedTime\n  90: optional i64 (js.type = \"Long\") lastHeartbeatTime\n  100: optional binary details\n  110: optional i32 attempt\n  120: optional string lastFailureReason\n  130: optional string lastWorkerIdentity\n  140: optional binary lastFailureDetails\n  150: optional shared.VersionHistory versionHistory\n}\n\nstruct HistoryTaskV2Attributes {\n  05: optional i64 (js.type = \"Long\") taskId\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional list<shared.VersionHistoryItem> versionHistoryItems\n  50: optional shared.DataBlob events\n  // new run events does not need version history since there is no prior events\n  70: optional shared.DataBlob newRunEvents\n}\n\nstruct FailoverMarkerAttributes{\n\t10: optional string domainID\n\t20: optional i64 (js.type = \"Long\") failoverVersion\n\t30: optional i64 (js.type = \"Long\") creationTime\n}\n\nstruct FailoverMarkers{\n\t10: optional list<FailoverMarkerAttributes> failoverMarkers\n}\n\nstruct ReplicationTask {\n  10: optional ReplicationTaskType taskType\n  11: optional i64 (js.type = \"Long\") sourceTaskId\n  20: optional DomainTaskAttributes domainTaskAttributes\n  40: optional SyncShardStatusTaskAttributes syncShardStatusTaskAttributes\n  50: optional SyncActivityTaskAttributes syncActivityTaskAttributes\n  70: optional HistoryTaskV2Attributes historyTaskV2Attributes\n  80: optional FailoverMarkerAttributes failoverMarkerAttributes\n  90: optional i64 (js.type = \"Long\") creationTime\n}\n\nstruct ReplicationToken {\n  10: optional i32 shardID\n  // lastRetrivedMessageId is where the next fetch should begin with\n  20: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrievedMessageId if passive side supports prefetching messages.\n  30: optional i64 (js.type = \"Long\") lastProcessedMessageId\n}\n\nstruct SyncShardStatus {\n  10: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct ReplicationMessages {\n  10: optional list<ReplicationTask> replicationTasks\n  // This can be different than the last taskId in the above list, because sender can decide to skip tasks (e.g. for completed workflows).\n  20: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  30: optional bool hasMore // Hint for flow control\n  40: optional SyncShardStatus syncShardStatus\n}\n\nstruct ReplicationTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional i16 taskType\n  50: optional i64 (js.type = \"Long\") taskID\n  60: optional i64 (js.type = \"Long\") version\n  70: optional i64 (js.type = \"Long\") firstEventID\n  80: optional i64 (js.type = \"Long\") nextEventID\n  90: optional i64 (js.type = \"Long\") scheduledID\n}\n\nstruct GetReplicationMessagesRequest {\n  10: optional list<ReplicationToken> tokens\n  20: optional string clusterName\n}\n\nstruct GetReplicationMessagesResponse {\n  10: optional map<i32, ReplicationMessages> messagesByShard\n}\n\nstruct GetDomainReplicationMessagesRequest {\n  // lastRetrievedMessageId is where the next fetch should begin with\n  10: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrievedMessageId if passive side supports prefetching messages.\n  20: optional i64 (js.type = \"Long\") lastProcessedMessageId\n  // clusterName is the name of the pulling cluster\n  30: optional string clusterName\n}\n\nstruct GetDomainReplicationMessagesResponse {\n  10: optional ReplicationMessages messages\n}\n\nstruct GetDLQReplicationMessagesRequest {\n  10: optional list<ReplicationTaskInfo> taskInfos\n}\n\nstruct GetDLQReplicationMessagesResponse {\n  10: optional list<ReplicationTask> replicationTasks\n}\n\nenum DLQType {\n  Replication,\n  Domain,\n}\n\nstruct ReadDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct ReadDLQMessagesResponse{\n  10: optional DLQType type\n  20: optional list<ReplicationTask> replicationTasks\n  30: optional binary nextPageToken\n  40: optional list<ReplicationTaskInfo> replicationTasksInfo\n}\n\nstruct PurgeDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n}\n\nstruct MergeDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct MergeDLQMessagesResponse{\n  10: optional binary nextPageToken\n}\n"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.replicator\n\ninclude \"shared.thrift\"\n\nenum ReplicationTaskType {\n  Domain\n  History\n  SyncShardStatus\n  SyncActivity\n  HistoryMetadata\n  HistoryV2\n  FailoverMarker\n}\n\nenum DomainOperation {\n  Create\n  Update\n}\n\nstruct DomainTaskAttributes {\n  05: optional DomainOperation domainOperation\n  10: optional string id\n  20: optional shared.DomainInfo info\n  30: optional shared.DomainConfiguration config\n  40: optional shared.DomainReplicationConfiguration replicationConfig\n  50: optional i64 (js.type = \"Long\") configVersion\n  60: optional i64 (js.type = \"Long\") failoverVersion\n  70: optional i64 (js.type = \"Long\") previousFailoverVersion\n}\n\nstruct SyncShardStatusTaskAttributes {\n  10: optional string sourceCluster\n  20: optional i64 (js.type = \"Long\") shardId\n  30: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct SyncActivityTaskAttributes {\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional i64 (js.type = \"Long\") version\n  50: optional i64 (js.type = \"Long\") scheduledId\n  60: optional i64 (js.type = \"Long\") scheduledTime\n  70: optional i64 (js.type = \"Long\") startedId\n  80: optional i64 (js.type = \"Long\") startedTime\n  90: optional i64 (js.type = \"Long\") lastHeartbeatTime\n  100: optional binary details\n  110: optional i32 attempt\n  120: optional string lastFailureReason\n  130: optional string lastWorkerIdentity\n  140: optional binary lastFailureDetails\n  150: optional shared.VersionHistory versionHistory\n  160: optional bool paused\n}\n\nstruct HistoryTaskV2Attributes {\n  05: optional i64 (js.type = \"Long\") taskId\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional list<shared.VersionHistoryItem> versionHistoryItems\n  50: optional shared.DataBlob events\n  // new run events does not need version history since there is no prior events\n  70: optional shared.DataBlob newRunEvents\n}\n\nstruct FailoverMarkerAttributes{\n\t10: optional string domainID\n\t20: optional i64 (js.type = \"Long\") failoverVersion\n\t30: optional i64 (js.type = \"Long\") creationTime\n}\n\nstruct FailoverMarkers{\n\t10: optional list<FailoverMarkerAttributes> failoverMarkers\n}\n\nstruct ReplicationTask {\n  10: optional ReplicationTaskType taskType\n  11: optional i64 (js.type = \"Long\") sourceTaskId\n  20: optional DomainTaskAttributes domainTaskAttributes\n  40: optional SyncShardStatusTaskAttributes syncShardStatusTaskAttributes\n  50: optional SyncActivityTaskAttributes syncActivityTaskAttributes\n  70: optional HistoryTaskV2Attributes historyTaskV2Attributes\n  80: optional FailoverMarkerAttributes failoverMarkerAttributes\n  90: optional i64 (js.type = \"Long\") creationTime\n}\n\nstruct ReplicationToken {\n  10: optional i32 shardID\n  // lastRetrivedMessageId is where the next fetch should begin with\n  20: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrievedMessageId if passive side supports prefetching messages.\n  30: optional i64 (js.type = \"Long\") lastProcessedMessageId\n}\n\nstruct SyncShardStatus {\n  10: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct ReplicationMessages {\n  10: optional list<ReplicationTask> replicationTasks\n  // This can be different than the last taskId in the above list, because sender can decide to skip tasks (e.g. for completed workflows).\n  20: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  30: optional bool hasMore // Hint for flow control\n  40: optional SyncShardStatus syncShardStatus\n}\n\nstruct ReplicationTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional i16 taskType\n  50: optional i64 (js.type = \"Long\") taskID\n  60: optional i64 (js.type = \"Long\") version\n  70: optional i64 (js.type = \"Long\") firstEventID\n  80: optional i64 (js.type = \"Long\") nextEventID\n  90: optional i64 (js.type = \"Long\") scheduledID\n  100: optional string errorClass\n}\n\nstruct GetReplicationMessagesRequest {\n  10: optional list<ReplicationToken> tokens\n  20: optional string clusterName\n}\n\nstruct GetReplicationMessagesResponse {\n  10: optional map<i32, ReplicationMessages> messagesByShard\n}\n\nstruct GetDomainReplicationMessagesRequest {\n  // lastRetrievedMessageId is where the next fetch should begin with\n  10: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrievedMessageId if passive side supports prefetching messages.\n  20: optional i64 (js.type = \"Long\") lastProcessedMessageId\n  // clusterName is the name of the pulling cluster\n  30: optional string clusterName\n}\n\nstruct GetDomainReplicationMessagesResponse {\n  10: optional ReplicationMessages messages\n}\n\nstruct GetDLQReplicationMessagesRequest {\n  10: optional list<ReplicationTaskInfo> taskInfos\n}\n\nstruct GetDLQReplicationMessagesResponse {\n  10: optional list<ReplicationTask> replicationTasks\n}\n\nenum DLQType {\n  Replication,\n  Domain,\n}\n\nstruct DLQFilter {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional ReplicationTaskType taskType\n  40: optional string errorClass\n}\n\nstruct ReadDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n  70: optional DLQFilter filter\n}\n\nstruct ReadDLQMessagesResponse{\n  10: optional DLQType type\n  20: optional list<ReplicationTask> replicationTasks\n  30: optional binary nextPageToken\n  40: optional list<ReplicationTaskInfo> replicationTasksInfo\n}\n\nstruct PurgeDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n}\n\nstruct MergeDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n  70: optional DLQFilter filter\n}\n\nstruct MergeDLQMessagesResponse{\n  10: optional binary nextPageToken\n}\n"
@@ -75,6 +75,7 @@ type ActivityInfo struct {
 	RetryLastFailureReason        *string  `json:"retryLastFailureReason,omitempty"`
 	RetryLastWorkerIdentity       *string  `json:"retryLastWorkerIdentity,omitempty"`
 	RetryLastFailureDetails       []byte   `json:"retryLastFailureDetails,omitempty"`
+	Paused                        *bool    `json:"paused,omitempty"`
 }
 
 type _List_String_ValueList []string
@@ -120,7 +121,7 @@ func (_List_String_ValueList) Close() {}
 //	}
 func (v *ActivityInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [31]wire.Field
+		fields [32]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -374,6 +375,14 @@ func (v *ActivityInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.Paused != nil {
+		w, err = wire.NewValueBool(*(v.Paused)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 72, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -719,6 +728,16 @@ func (v *ActivityInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 72:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Paused = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -1125,6 +1144,18 @@ func (v *ActivityInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Paused != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 72, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Paused)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -1416,6 +1447,14 @@ func (v *ActivityInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 72 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Paused = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -1445,7 +1484,7 @@ func (v *ActivityInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [31]string
+	var fields [32]string
 	i := 0
 	if v.Version != nil {
 		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
@@ -1571,6 +1610,10 @@ func (v *ActivityInfo) String() string {
 		fields[i] = fmt.Sprintf("RetryLastFailureDetails: %v", v.RetryLastFailureDetails)
 		i++
 	}
+	if v.Paused != nil {
+		fields[i] = fmt.Sprintf("Paused: %v", *(v.Paused))
+		i++
+	}
 
 	return fmt.Sprintf("ActivityInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -1743,6 +1786,9 @@ func (v *ActivityInfo) Equals(rhs *ActivityInfo) bool {
 	if !((v.RetryLastFailureDetails == nil && rhs.RetryLastFailureDetails == nil) || (v.RetryLastFailureDetails != nil && rhs.RetryLastFailureDetails != nil && bytes.Equal(v.RetryLastFailureDetails, rhs.RetryLastFailureDetails))) {
 		return false
 	}
+	if !_Bool_EqualsPtr(v.Paused, rhs.Paused) {
+		return false
+	}
 
 	return true
 }
@@ -1857,6 +1903,9 @@ func (v *ActivityInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.RetryLastFailureDetails != nil {
 		enc.AddString("retryLastFailureDetails", base64.StdEncoding.EncodeToString(v.RetryLastFailureDetails))
 	}
+	if v.Paused != nil {
+		enc.AddBool("paused", *v.Paused)
+	}
 	return err
 }
 
@@ -2325,6 +2374,21 @@ func (v *ActivityInfo) IsSetRetryLastFailureDetails() bool {
 	return v != nil && v.RetryLastFailureDetails != nil
 }
 
+// GetPaused returns the value of Paused if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetPaused() (o bool) {
+	if v != nil && v.Paused != nil {
+		return *v.Paused
+	}
+
+	return
+}
+
+// IsSetPaused returns true if Paused is not nil.
+func (v *ActivityInfo) IsSetPaused() bool {
+	return v != nil && v.Paused != nil
+}
+
 type AsyncRequestMessage struct {
 	PartitionKey *string           `json:"partitionKey,omitempty"`
 	Type         *AsyncRequestType `json:"type,omitempty"`
@@ -6612,6 +6676,7 @@ type ReplicationTaskInfo struct {
 	BranchToken             []byte  `json:"branch_token,omitempty"`
 	NewRunBranchToken       []byte  `json:"newRunBranchToken,omitempty"`
 	CreationTime            *int64  `json:"creationTime,omitempty"`
+	ErrorClass              *string `json:"errorClass,omitempty"`
 }
 
 // ToWire translates a ReplicationTaskInfo struct into a Thrift-level intermediate
@@ -6631,7 +6696,7 @@ type ReplicationTaskInfo struct {
 //	}
 func (v *ReplicationTaskInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [13]wire.Field
+		fields [14]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -6741,6 +6806,14 @@ func (v *ReplicationTaskInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 38, Value: w}
 		i++
 	}
+	if v.ErrorClass != nil {
+		w, err = wire.NewValueString(*(v.ErrorClass)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -6888,6 +6961,16 @@ func (v *ReplicationTaskInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ErrorClass = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -7060,6 +7143,18 @@ func (v *ReplicationTaskInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.ErrorClass != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ErrorClass)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -7177,6 +7272,14 @@ func (v *ReplicationTaskInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ErrorClass = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -7206,7 +7309,7 @@ func (v *ReplicationTaskInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [13]string
+	var fields [14]string
 	i := 0
 	if v.DomainID != nil {
 		fields[i] = fmt.Sprintf("DomainID: %v", v.DomainID)
@@ -7260,6 +7363,10 @@ func (v *ReplicationTaskInfo) String() string {
 		fields[i] = fmt.Sprintf("CreationTime: %v", *(v.CreationTime))
 		i++
 	}
+	if v.ErrorClass != nil {
+		fields[i] = fmt.Sprintf("ErrorClass: %v", *(v.ErrorClass))
+		i++
+	}
 
 	return fmt.Sprintf("ReplicationTaskInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -7313,6 +7420,9 @@ func (v *ReplicationTaskInfo) Equals(rhs *ReplicationTaskInfo) bool {
 	if !_I64_EqualsPtr(v.CreationTime, rhs.CreationTime) {
 		return false
 	}
+	if !_String_EqualsPtr(v.ErrorClass, rhs.ErrorClass) {
+		return false
+	}
 
 	return true
 }
@@ -7362,6 +7472,9 @@ func (v *ReplicationTaskInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err e
 	if v.CreationTime != nil {
 		enc.AddInt64("creationTime", *v.CreationTime)
 	}
+	if v.ErrorClass != nil {
+		enc.AddString("errorClass", *v.ErrorClass)
+	}
 	return err
 }
 
@@ -7560,6 +7673,21 @@ func (v *ReplicationTaskInfo) IsSetCreationTime() bool {
 	return v != nil && v.CreationTime != nil
 }
 
+// GetErrorClass returns the value of ErrorClass if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetErrorClass() (o string) {
+	if v != nil && v.ErrorClass != nil {
+		return *v.ErrorClass
+	}
+
+	return
+}
+
+// IsSetErrorClass returns true if ErrorClass is not nil.
+func (v *ReplicationTaskInfo) IsSetErrorClass() bool {
+	return v != nil && v.ErrorClass != nil
+}
+
 type RequestCancelInfo struct {
 	Version               *int64  `json:"version,omitempty"`
 	InitiatedEventBatchID *int64  `json:"initiatedEventBatchID,omitempty"`
@@ -11894,6 +12022,7 @@ type TransferTaskInfo struct {
 	Version                  *int64   `json:"version,omitempty"`
 	VisibilityTimestampNanos *int64   `json:"visibilityTimestampNanos,omitempty"`
 	TargetDomainIDs          [][]byte `json:"targetDomainIDs,omitempty"`
+	LifecycleEvent           []byte   `json:"lifecycleEvent,omitempty"`
 }
 
 type _Set_Binary_sliceType_ValueList [][]byte
@@ -11942,7 +12071,7 @@ func (_Set_Binary_sliceType_ValueList) Close() {}
 //	}
 func (v *TransferTaskInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [13]wire.Field
+		fields [14]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -12052,6 +12181,14 @@ func (v *TransferTaskInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 34, Value: w}
 		i++
 	}
+	if v.LifecycleEvent != nil {
+		w, err = wire.NewValueBinary(v.LifecycleEvent), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 36, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -12216,6 +12353,14 @@ func (v *TransferTaskInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 36:
+			if field.Value.Type() == wire.TBinary {
+				v.LifecycleEvent, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -12411,6 +12556,18 @@ func (v *TransferTaskInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.LifecycleEvent != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 36, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.LifecycleEvent); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -12557,6 +12714,12 @@ func (v *TransferTaskInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 36 && fh.Type == wire.TBinary:
+			v.LifecycleEvent, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -12586,7 +12749,7 @@ func (v *TransferTaskInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [13]string
+	var fields [14]string
 	i := 0
 	if v.DomainID != nil {
 		fields[i] = fmt.Sprintf("DomainID: %v", v.DomainID)
@@ -12640,6 +12803,10 @@ func (v *TransferTaskInfo) String() string {
 		fields[i] = fmt.Sprintf("TargetDomainIDs: %v", v.TargetDomainIDs)
 		i++
 	}
+	if v.LifecycleEvent != nil {
+		fields[i] = fmt.Sprintf("LifecycleEvent: %v", v.LifecycleEvent)
+		i++
+	}
 
 	return fmt.Sprintf("TransferTaskInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -12714,6 +12881,9 @@ func (v *TransferTaskInfo) Equals(rhs *TransferTaskInfo) bool {
 	if !((v.TargetDomainIDs == nil && rhs.TargetDomainIDs == nil) || (v.TargetDomainIDs != nil && rhs.TargetDomainIDs != nil && _Set_Binary_sliceType_Equals(v.TargetDomainIDs, rhs.TargetDomainIDs))) {
 		return false
 	}
+	if !((v.LifecycleEvent == nil && rhs.LifecycleEvent == nil) || (v.LifecycleEvent != nil && rhs.LifecycleEvent != nil && bytes.Equal(v.LifecycleEvent, rhs.LifecycleEvent))) {
+		return false
+	}
 
 	return true
 }
@@ -12774,6 +12944,9 @@ func (v *TransferTaskInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err erro
 	if v.TargetDomainIDs != nil {
 		err = multierr.Append(err, enc.AddArray("targetDomainIDs", (_Set_Binary_sliceType_Zapper)(v.TargetDomainIDs)))
 	}
+	if v.LifecycleEvent != nil {
+		enc.AddString("lifecycleEvent", base64.StdEncoding.EncodeToString(v.LifecycleEvent))
+	}
 	return err
 }
 
@@ -12972,6 +13145,21 @@ func (v *TransferTaskInfo) IsSetTargetDomainIDs() bool {
 	return v != nil && v.TargetDomainIDs != nil
 }
 
+// GetLifecycleEvent returns the value of LifecycleEvent if it is set or its
+// zero value if it is unset.
+func (v *TransferTaskInfo) GetLifecycleEvent() (o []byte) {
+	if v != nil && v.LifecycleEvent != nil {
+		return v.LifecycleEvent
+	}
+
+	return
+}
+
+// IsSetLifecycleEvent returns true if LifecycleEvent is not nil.
+func (v *TransferTaskInfo) IsSetLifecycleEvent() bool {
+	return v != nil && v.LifecycleEvent != nil
+}
+
 type WorkflowExecutionInfo struct {
 	ParentDomainID                          []byte            `json:"parentDomainID,omitempty"`
 	ParentWorkflowID                        *string           `json:"parentWorkflowID,omitempty"`
@@ -13035,6 +13223,9 @@ type WorkflowExecutionInfo struct {
 	PartitionConfig                         map[string]string `json:"partitionConfig,omitempty"`
 	Checksum                                []byte            `json:"checksum,omitempty"`
 	ChecksumEncoding                        *string           `json:"checksumEncoding,omitempty"`
+	ConflictResolutions                     []byte            `json:"conflictResolutions,omitempty"`
+	PendingSignalCount                      *int64            `json:"pendingSignalCount,omitempty"`
+	ClosedWorkflowQueryResults              []byte            `json:"closedWorkflowQueryResults,omitempty"`
 }
 
 type _Map_String_Binary_MapItemList map[string][]byte
@@ -13092,7 +13283,7 @@ func (_Map_String_Binary_MapItemList) Close() {}
 //	}
 func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [62]wire.Field
+		fields [65]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -13594,6 +13785,30 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 132, Value: w}
 		i++
 	}
+	if v.ConflictResolutions != nil {
+		w, err = wire.NewValueBinary(v.ConflictResolutions), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 134, Value: w}
+		i++
+	}
+	if v.PendingSignalCount != nil {
+		w, err = wire.NewValueI64(*(v.PendingSignalCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 136, Value: w}
+		i++
+	}
+	if v.ClosedWorkflowQueryResults != nil {
+		w, err = wire.NewValueBinary(v.ClosedWorkflowQueryResults), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 137, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -14241,6 +14456,32 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 134:
+			if field.Value.Type() == wire.TBinary {
+				v.ConflictResolutions, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 136:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PendingSignalCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 137:
+			if field.Value.Type() == wire.TBinary {
+				v.ClosedWorkflowQueryResults, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -15027,6 +15268,42 @@ func (v *WorkflowExecutionInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.ConflictResolutions != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 134, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.ConflictResolutions); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PendingSignalCount != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 136, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.PendingSignalCount)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ClosedWorkflowQueryResults != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 137, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.ClosedWorkflowQueryResults); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -15558,6 +15835,26 @@ func (v *WorkflowExecutionInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 134 && fh.Type == wire.TBinary:
+			v.ConflictResolutions, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 136 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.PendingSignalCount = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 137 && fh.Type == wire.TBinary:
+			v.ClosedWorkflowQueryResults, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -15587,7 +15884,7 @@ func (v *WorkflowExecutionInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [62]string
+	var fields [65]string
 	i := 0
 	if v.ParentDomainID != nil {
 		fields[i] = fmt.Sprintf("ParentDomainID: %v", v.ParentDomainID)
@@ -15837,6 +16134,18 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("ChecksumEncoding: %v", *(v.ChecksumEncoding))
 		i++
 	}
+	if v.ConflictResolutions != nil {
+		fields[i] = fmt.Sprintf("ConflictResolutions: %v", v.ConflictResolutions)
+		i++
+	}
+	if v.PendingSignalCount != nil {
+		fields[i] = fmt.Sprintf("PendingSignalCount: %v", *(v.PendingSignalCount))
+		i++
+	}
+	if v.ClosedWorkflowQueryResults != nil {
+		fields[i] = fmt.Sprintf("ClosedWorkflowQueryResults: %v", v.ClosedWorkflowQueryResults)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -16054,6 +16363,15 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !_String_EqualsPtr(v.ChecksumEncoding, rhs.ChecksumEncoding) {
 		return false
 	}
+	if !((v.ConflictResolutions == nil && rhs.ConflictResolutions == nil) || (v.ConflictResolutions != nil && rhs.ConflictResolutions != nil && bytes.Equal(v.ConflictResolutions, rhs.ConflictResolutions))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.PendingSignalCount, rhs.PendingSignalCount) {
+		return false
+	}
+	if !((v.ClosedWorkflowQueryResults == nil && rhs.ClosedWorkflowQueryResults == nil) || (v.ClosedWorkflowQueryResults != nil && rhs.ClosedWorkflowQueryResults != nil && bytes.Equal(v.ClosedWorkflowQueryResults, rhs.ClosedWorkflowQueryResults))) {
+		return false
+	}
 
 	return true
 }
@@ -16261,6 +16579,15 @@ func (v *WorkflowExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.ChecksumEncoding != nil {
 		enc.AddString("checksumEncoding", *v.ChecksumEncoding)
 	}
+	if v.ConflictResolutions != nil {
+		enc.AddString("conflictResolutions", base64.StdEncoding.EncodeToString(v.ConflictResolutions))
+	}
+	if v.PendingSignalCount != nil {
+		enc.AddInt64("pendingSignalCount", *v.PendingSignalCount)
+	}
+	if v.ClosedWorkflowQueryResults != nil {
+		enc.AddString("closedWorkflowQueryResults", base64.StdEncoding.EncodeToString(v.ClosedWorkflowQueryResults))
+	}
 	return err
 }
 
@@ -17194,16 +17521,61 @@ func (v *WorkflowExecutionInfo) IsSetChecksumEncoding() bool {
 	return v != nil && v.ChecksumEncoding != nil
 }
 
+// GetConflictResolutions returns the value of ConflictResolutions if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetConflictResolutions() (o []byte) {
+	if v != nil && v.ConflictResolutions != nil {
+		return v.ConflictResolutions
+	}
+
+	return
+}
+
+// IsSetConflictResolutions returns true if ConflictResolutions is not nil.
+func (v *WorkflowExecutionInfo) IsSetConflictResolutions() bool {
+	return v != nil && v.ConflictResolutions != nil
+}
+
+// GetPendingSignalCount returns the value of PendingSignalCount if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetPendingSignalCount() (o int64) {
+	if v != nil && v.PendingSignalCount != nil {
+		return *v.PendingSignalCount
+	}
+
+	return
+}
+
+// IsSetPendingSignalCount returns true if PendingSignalCount is not nil.
+func (v *WorkflowExecutionInfo) IsSetPendingSignalCount() bool {
+	return v != nil && v.PendingSignalCount != nil
+}
+
+// GetClosedWorkflowQueryResults returns the value of ClosedWorkflowQueryResults if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetClosedWorkflowQueryResults() (o []byte) {
+	if v != nil && v.ClosedWorkflowQueryResults != nil {
+		return v.ClosedWorkflowQueryResults
+	}
+
+	return
+}
+
+// IsSetClosedWorkflowQueryResults returns true if ClosedWorkflowQueryResults is not nil.
+func (v *WorkflowExecutionInfo) IsSetClosedWorkflowQueryResults() bool {
+	return v != nil && v.ClosedWorkflowQueryResults != nil
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "sqlblobs",
 	Package:  "github.com/uber/cadence/.gen/go/sqlblobs",
 	FilePath: "sqlblobs.thrift",
-	SHA1:     "a03e6c0aa72213ea16c9c27d55445dc5849210f6",
+	SHA1:     "1d543bc5f2e0c4e83081e3d9f253083deb127ea9",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n  42: optional binary pendingFailoverMarkers\n  44: optional string pendingFailoverMarkersEncoding\n  46: optional map<string, i64> replicationDlqAckLevel\n  50: optional binary transferProcessingQueueStates\n  51: optional string transferProcessingQueueStatesEncoding\n  55: optional binary timerProcessingQueueStates\n  56: optional string timerProcessingQueueStatesEncoding\n  60: optional binary crossClusterProcessingQueueStates\n  61: optional string crossClusterProcessingQueueStatesEncoding\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional i64 (js.type = \"Long\") failoverEndTime\n  52: optional i64 (js.type = \"Long\") previousFailoverVersion\n  54: optional i64 (js.type = \"Long\") lastUpdatedTime\n  56: optional binary isolationGroupsConfiguration\n  58: optional string isolationGroupsConfigurationEncoding\n  60: optional binary asyncWorkflowConfiguration\n  62: optional string asyncWorkflowConfigurationEncoding\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n  126: optional binary firstExecutionRunID\n  128: optional map<string, string> partitionConfig\n  130: optional binary checksum\n  132: optional string checksumEncoding\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  29: optional string domainID\n  30: optional string domainName // deprecated\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  // TaskID is a misleading variable, it actually serves\n  // the purpose of indicating whether a timer task is\n  // generated for this timer info\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n  17: optional map<string, string> partitionConfig\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n  34: optional set<binary> targetDomainIDs\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  34: optional binary newRunBranchToken\n  38: optional i64 (js.type = \"Long\") creationTime\n}\n\nenum AsyncRequestType {\n  StartWorkflowExecutionAsyncRequest\n  SignalWithStartWorkflowExecutionAsyncRequest\n}\n\nstruct AsyncRequestMessage {\n  10: optional string partitionKey\n  12: optional AsyncRequestType type\n  14: optional shared.Header header\n  16: optional string encoding\n  18: optional binary payload\n}\n"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n  42: optional binary pendingFailoverMarkers\n  44: optional string pendingFailoverMarkersEncoding\n  46: optional map<string, i64> replicationDlqAckLevel\n  50: optional binary transferProcessingQueueStates\n  51: optional string transferProcessingQueueStatesEncoding\n  55: optional binary timerProcessingQueueStates\n  56: optional string timerProcessingQueueStatesEncoding\n  60: optional binary crossClusterProcessingQueueStates\n  61: optional string crossClusterProcessingQueueStatesEncoding\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional i64 (js.type = \"Long\") failoverEndTime\n  52: optional i64 (js.type = \"Long\") previousFailoverVersion\n  54: optional i64 (js.type = \"Long\") lastUpdatedTime\n  56: optional binary isolationGroupsConfiguration\n  58: optional string isolationGroupsConfigurationEncoding\n  60: optional binary asyncWorkflowConfiguration\n  62: optional string asyncWorkflowConfigurationEncoding\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n  126: optional binary firstExecutionRunID\n  128: optional map<string, string> partitionConfig\n  130: optional binary checksum\n  132: optional string checksumEncoding\n  134: optional binary conflictResolutions\n  136: optional i64 (js.type = \"Long\") pendingSignalCount\n  137: optional binary closedWorkflowQueryResults\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n  72: optional bool paused\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  29: optional string domainID\n  30: optional string domainName // deprecated\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  // TaskID is a misleading variable, it actually serves\n  // the purpose of indicating whether a timer task is\n  // generated for this timer info\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n  17: optional map<string, string> partitionConfig\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n  34: optional set<binary> targetDomainIDs\n  36: optional binary lifecycleEvent\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  34: optional binary newRunBranchToken\n  38: optional i64 (js.type = \"Long\") creationTime\n  40: optional string errorClass\n}\n\nenum AsyncRequestType {\n  StartWorkflowExecutionAsyncRequest\n  SignalWithStartWorkflowExecutionAsyncRequest\n}\n\nstruct AsyncRequestMessage {\n  10: optional string partitionKey\n  12: optional AsyncRequestType type\n  14: optional shared.Header header\n  16: optional string encoding\n  18: optional binary payload\n}\n"
@@ -33,9 +33,9 @@ import (
 
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
+
 	v12 "github.com/uber/cadence-idl/go/proto/admin/v1"
 	v1 "github.com/uber/cadence-idl/go/proto/api/v1"
-
 	v11 "github.com/uber/cadence/.gen/proto/shared/v1"
 )
 
@@ -658,26 +658,26 @@ func (m *TerminateWorkflowExecutionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TerminateWorkflowExecutionResponse proto.InternalMessageInfo
 
-type DescribeWorkflowExecutionRequest struct {
-	Request              *v1.DescribeWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	DomainId             string                               `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
-	XXX_unrecognized     []byte                               `json:"-"`
-	XXX_sizecache        int32                                `json:"-"`
+type PauseWorkflowExecutionRequest struct {
+	Request              *v1.PauseWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                            `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
-func (m *DescribeWorkflowExecutionRequest) Reset()         { *m = DescribeWorkflowExecutionRequest{} }
-func (m *DescribeWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
-func (*DescribeWorkflowExecutionRequest) ProtoMessage()    {}
-func (*DescribeWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+func (m *PauseWorkflowExecutionRequest) Reset()         { *m = PauseWorkflowExecutionRequest{} }
+func (m *PauseWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseWorkflowExecutionRequest) ProtoMessage()    {}
+func (*PauseWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{10}
 }
-func (m *DescribeWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+func (m *PauseWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DescribeWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PauseWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DescribeWorkflowExecutionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PauseWorkflowExecutionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -687,55 +687,50 @@ func (m *DescribeWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *DescribeWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeWorkflowExecutionRequest.Merge(m, src)
+func (m *PauseWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowExecutionRequest.Merge(m, src)
 }
-func (m *DescribeWorkflowExecutionRequest) XXX_Size() int {
+func (m *PauseWorkflowExecutionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DescribeWorkflowExecutionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeWorkflowExecutionRequest.DiscardUnknown(m)
+func (m *PauseWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowExecutionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeWorkflowExecutionRequest proto.InternalMessageInfo
+var xxx_messageInfo_PauseWorkflowExecutionRequest proto.InternalMessageInfo
 
-func (m *DescribeWorkflowExecutionRequest) GetRequest() *v1.DescribeWorkflowExecutionRequest {
+func (m *PauseWorkflowExecutionRequest) GetRequest() *v1.PauseWorkflowExecutionRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-func (m *DescribeWorkflowExecutionRequest) GetDomainId() string {
+func (m *PauseWorkflowExecutionRequest) GetDomainId() string {
 	if m != nil {
 		return m.DomainId
 	}
 	return ""
 }
 
-type DescribeWorkflowExecutionResponse struct {
-	ExecutionConfiguration *v1.WorkflowExecutionConfiguration `protobuf:"bytes,1,opt,name=execution_configuration,json=executionConfiguration,proto3" json:"execution_configuration,omitempty"`
-	WorkflowExecutionInfo  *v1.WorkflowExecutionInfo          `protobuf:"bytes,2,opt,name=workflow_execution_info,json=workflowExecutionInfo,proto3" json:"workflow_execution_info,omitempty"`
-	PendingActivities      []*v1.PendingActivityInfo          `protobuf:"bytes,3,rep,name=pending_activities,json=pendingActivities,proto3" json:"pending_activities,omitempty"`
-	PendingChildren        []*v1.PendingChildExecutionInfo    `protobuf:"bytes,4,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
-	PendingDecision        *v1.PendingDecisionInfo            `protobuf:"bytes,5,opt,name=pending_decision,json=pendingDecision,proto3" json:"pending_decision,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}                           `json:"-"`
-	XXX_unrecognized       []byte                             `json:"-"`
-	XXX_sizecache          int32                              `json:"-"`
+type PauseWorkflowExecutionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DescribeWorkflowExecutionResponse) Reset()         { *m = DescribeWorkflowExecutionResponse{} }
-func (m *DescribeWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
-func (*DescribeWorkflowExecutionResponse) ProtoMessage()    {}
-func (*DescribeWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+func (m *PauseWorkflowExecutionResponse) Reset()         { *m = PauseWorkflowExecutionResponse{} }
+func (m *PauseWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseWorkflowExecutionResponse) ProtoMessage()    {}
+func (*PauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{11}
 }
-func (m *DescribeWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+func (m *PauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DescribeWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DescribeWorkflowExecutionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -745,73 +740,132 @@ func (m *DescribeWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *DescribeWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeWorkflowExecutionResponse.Merge(m, src)
+func (m *PauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.Merge(m, src)
 }
-func (m *DescribeWorkflowExecutionResponse) XXX_Size() int {
+func (m *PauseWorkflowExecutionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DescribeWorkflowExecutionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeWorkflowExecutionResponse.DiscardUnknown(m)
+func (m *PauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeWorkflowExecutionResponse proto.InternalMessageInfo
+var xxx_messageInfo_PauseWorkflowExecutionResponse proto.InternalMessageInfo
 
-func (m *DescribeWorkflowExecutionResponse) GetExecutionConfiguration() *v1.WorkflowExecutionConfiguration {
-	if m != nil {
-		return m.ExecutionConfiguration
-	}
-	return nil
+type UnpauseWorkflowExecutionRequest struct {
+	Request              *v1.UnpauseWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                              `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
 }
 
-func (m *DescribeWorkflowExecutionResponse) GetWorkflowExecutionInfo() *v1.WorkflowExecutionInfo {
-	if m != nil {
-		return m.WorkflowExecutionInfo
+func (m *UnpauseWorkflowExecutionRequest) Reset()         { *m = UnpauseWorkflowExecutionRequest{} }
+func (m *UnpauseWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*UnpauseWorkflowExecutionRequest) ProtoMessage()    {}
+func (*UnpauseWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{12}
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.DiscardUnknown(m)
 }
 
-func (m *DescribeWorkflowExecutionResponse) GetPendingActivities() []*v1.PendingActivityInfo {
+var xxx_messageInfo_UnpauseWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *UnpauseWorkflowExecutionRequest) GetRequest() *v1.UnpauseWorkflowExecutionRequest {
 	if m != nil {
-		return m.PendingActivities
+		return m.Request
 	}
 	return nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) GetPendingChildren() []*v1.PendingChildExecutionInfo {
+func (m *UnpauseWorkflowExecutionRequest) GetDomainId() string {
 	if m != nil {
-		return m.PendingChildren
+		return m.DomainId
 	}
-	return nil
+	return ""
 }
 
-func (m *DescribeWorkflowExecutionResponse) GetPendingDecision() *v1.PendingDecisionInfo {
-	if m != nil {
-		return m.PendingDecision
+type UnpauseWorkflowExecutionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Reset()         { *m = UnpauseWorkflowExecutionResponse{} }
+func (m *UnpauseWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*UnpauseWorkflowExecutionResponse) ProtoMessage()    {}
+func (*UnpauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{13}
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.DiscardUnknown(m)
 }
 
-type QueryWorkflowRequest struct {
-	Request              *v1.QueryWorkflowRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+var xxx_messageInfo_UnpauseWorkflowExecutionResponse proto.InternalMessageInfo
+
+type PauseActivityRequest struct {
+	Request              *v1.PauseActivityRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                   `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *QueryWorkflowRequest) Reset()         { *m = QueryWorkflowRequest{} }
-func (m *QueryWorkflowRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryWorkflowRequest) ProtoMessage()    {}
-func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{12}
+func (m *PauseActivityRequest) Reset()         { *m = PauseActivityRequest{} }
+func (m *PauseActivityRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseActivityRequest) ProtoMessage()    {}
+func (*PauseActivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{14}
 }
-func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
+func (m *PauseActivityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PauseActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWorkflowRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PauseActivityRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -821,52 +875,50 @@ func (m *QueryWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryWorkflowRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWorkflowRequest.Merge(m, src)
+func (m *PauseActivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseActivityRequest.Merge(m, src)
 }
-func (m *QueryWorkflowRequest) XXX_Size() int {
+func (m *PauseActivityRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWorkflowRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWorkflowRequest.DiscardUnknown(m)
+func (m *PauseActivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseActivityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWorkflowRequest proto.InternalMessageInfo
+var xxx_messageInfo_PauseActivityRequest proto.InternalMessageInfo
 
-func (m *QueryWorkflowRequest) GetRequest() *v1.QueryWorkflowRequest {
+func (m *PauseActivityRequest) GetRequest() *v1.PauseActivityRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-func (m *QueryWorkflowRequest) GetDomainId() string {
+func (m *PauseActivityRequest) GetDomainId() string {
 	if m != nil {
 		return m.DomainId
 	}
 	return ""
 }
 
-type QueryWorkflowResponse struct {
-	QueryResult          *v1.Payload       `protobuf:"bytes,1,opt,name=query_result,json=queryResult,proto3" json:"query_result,omitempty"`
-	QueryRejected        *v1.QueryRejected `protobuf:"bytes,2,opt,name=query_rejected,json=queryRejected,proto3" json:"query_rejected,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type PauseActivityResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *QueryWorkflowResponse) Reset()         { *m = QueryWorkflowResponse{} }
-func (m *QueryWorkflowResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryWorkflowResponse) ProtoMessage()    {}
-func (*QueryWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{13}
+func (m *PauseActivityResponse) Reset()         { *m = PauseActivityResponse{} }
+func (m *PauseActivityResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseActivityResponse) ProtoMessage()    {}
+func (*PauseActivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{15}
 }
-func (m *QueryWorkflowResponse) XXX_Unmarshal(b []byte) error {
+func (m *PauseActivityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWorkflowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PauseActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWorkflowResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PauseActivityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -876,52 +928,38 @@ func (m *QueryWorkflowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryWorkflowResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWorkflowResponse.Merge(m, src)
+func (m *PauseActivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseActivityResponse.Merge(m, src)
 }
-func (m *QueryWorkflowResponse) XXX_Size() int {
+func (m *PauseActivityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWorkflowResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWorkflowResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryWorkflowResponse proto.InternalMessageInfo
-
-func (m *QueryWorkflowResponse) GetQueryResult() *v1.Payload {
-	if m != nil {
-		return m.QueryResult
-	}
-	return nil
+func (m *PauseActivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseActivityResponse.DiscardUnknown(m)
 }
 
-func (m *QueryWorkflowResponse) GetQueryRejected() *v1.QueryRejected {
-	if m != nil {
-		return m.QueryRejected
-	}
-	return nil
-}
+var xxx_messageInfo_PauseActivityResponse proto.InternalMessageInfo
 
-type ResetStickyTaskListRequest struct {
-	Request              *v1.ResetStickyTaskListRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	DomainId             string                         `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+type UnpauseActivityRequest struct {
+	Request              *v1.UnpauseActivityRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                     `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-func (m *ResetStickyTaskListRequest) Reset()         { *m = ResetStickyTaskListRequest{} }
-func (m *ResetStickyTaskListRequest) String() string { return proto.CompactTextString(m) }
-func (*ResetStickyTaskListRequest) ProtoMessage()    {}
-func (*ResetStickyTaskListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{14}
+func (m *UnpauseActivityRequest) Reset()         { *m = UnpauseActivityRequest{} }
+func (m *UnpauseActivityRequest) String() string { return proto.CompactTextString(m) }
+func (*UnpauseActivityRequest) ProtoMessage()    {}
+func (*UnpauseActivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{16}
 }
-func (m *ResetStickyTaskListRequest) XXX_Unmarshal(b []byte) error {
+func (m *UnpauseActivityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ResetStickyTaskListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnpauseActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ResetStickyTaskListRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnpauseActivityRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -931,50 +969,50 @@ func (m *ResetStickyTaskListRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *ResetStickyTaskListRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResetStickyTaskListRequest.Merge(m, src)
+func (m *UnpauseActivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseActivityRequest.Merge(m, src)
 }
-func (m *ResetStickyTaskListRequest) XXX_Size() int {
+func (m *UnpauseActivityRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ResetStickyTaskListRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResetStickyTaskListRequest.DiscardUnknown(m)
+func (m *UnpauseActivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseActivityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResetStickyTaskListRequest proto.InternalMessageInfo
+var xxx_messageInfo_UnpauseActivityRequest proto.InternalMessageInfo
 
-func (m *ResetStickyTaskListRequest) GetRequest() *v1.ResetStickyTaskListRequest {
+func (m *UnpauseActivityRequest) GetRequest() *v1.UnpauseActivityRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-func (m *ResetStickyTaskListRequest) GetDomainId() string {
+func (m *UnpauseActivityRequest) GetDomainId() string {
 	if m != nil {
 		return m.DomainId
 	}
 	return ""
 }
 
-type ResetStickyTaskListResponse struct {
+type UnpauseActivityResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResetStickyTaskListResponse) Reset()         { *m = ResetStickyTaskListResponse{} }
-func (m *ResetStickyTaskListResponse) String() string { return proto.CompactTextString(m) }
-func (*ResetStickyTaskListResponse) ProtoMessage()    {}
-func (*ResetStickyTaskListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{15}
+func (m *UnpauseActivityResponse) Reset()         { *m = UnpauseActivityResponse{} }
+func (m *UnpauseActivityResponse) String() string { return proto.CompactTextString(m) }
+func (*UnpauseActivityResponse) ProtoMessage()    {}
+func (*UnpauseActivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{17}
 }
-func (m *ResetStickyTaskListResponse) XXX_Unmarshal(b []byte) error {
+func (m *UnpauseActivityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ResetStickyTaskListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnpauseActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ResetStickyTaskListResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnpauseActivityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -984,40 +1022,38 @@ func (m *ResetStickyTaskListResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ResetStickyTaskListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResetStickyTaskListResponse.Merge(m, src)
+func (m *UnpauseActivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseActivityResponse.Merge(m, src)
 }
-func (m *ResetStickyTaskListResponse) XXX_Size() int {
+func (m *UnpauseActivityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ResetStickyTaskListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResetStickyTaskListResponse.DiscardUnknown(m)
+func (m *UnpauseActivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseActivityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResetStickyTaskListResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnpauseActivityResponse proto.InternalMessageInfo
 
-type GetMutableStateRequest struct {
-	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	ExpectedNextEventId  int64                 `protobuf:"varint,3,opt,name=expected_next_event_id,json=expectedNextEventId,proto3" json:"expected_next_event_id,omitempty"`
-	CurrentBranchToken   []byte                `protobuf:"bytes,4,opt,name=current_branch_token,json=currentBranchToken,proto3" json:"current_branch_token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type ResetActivityRequest struct {
+	Request              *v1.ResetActivityRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                   `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *GetMutableStateRequest) Reset()         { *m = GetMutableStateRequest{} }
-func (m *GetMutableStateRequest) String() string { return proto.CompactTextString(m) }
-func (*GetMutableStateRequest) ProtoMessage()    {}
-func (*GetMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{16}
+func (m *ResetActivityRequest) Reset()         { *m = ResetActivityRequest{} }
+func (m *ResetActivityRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetActivityRequest) ProtoMessage()    {}
+func (*ResetActivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{18}
 }
-func (m *GetMutableStateRequest) XXX_Unmarshal(b []byte) error {
+func (m *ResetActivityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetMutableStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResetActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetMutableStateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResetActivityRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1027,82 +1063,91 @@ func (m *GetMutableStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *GetMutableStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMutableStateRequest.Merge(m, src)
+func (m *ResetActivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetActivityRequest.Merge(m, src)
 }
-func (m *GetMutableStateRequest) XXX_Size() int {
+func (m *ResetActivityRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetMutableStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMutableStateRequest.DiscardUnknown(m)
+func (m *ResetActivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetActivityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMutableStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_ResetActivityRequest proto.InternalMessageInfo
 
-func (m *GetMutableStateRequest) GetDomainId() string {
+func (m *ResetActivityRequest) GetRequest() *v1.ResetActivityRequest {
 	if m != nil {
-		return m.DomainId
+		return m.Request
 	}
-	return ""
+	return nil
 }
 
-func (m *GetMutableStateRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+func (m *ResetActivityRequest) GetDomainId() string {
 	if m != nil {
-		return m.WorkflowExecution
+		return m.DomainId
 	}
-	return nil
+	return ""
 }
 
-func (m *GetMutableStateRequest) GetExpectedNextEventId() int64 {
-	if m != nil {
-		return m.ExpectedNextEventId
-	}
-	return 0
+type ResetActivityResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMutableStateRequest) GetCurrentBranchToken() []byte {
-	if m != nil {
-		return m.CurrentBranchToken
+func (m *ResetActivityResponse) Reset()         { *m = ResetActivityResponse{} }
+func (m *ResetActivityResponse) String() string { return proto.CompactTextString(m) }
+func (*ResetActivityResponse) ProtoMessage()    {}
+func (*ResetActivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{19}
+}
+func (m *ResetActivityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetActivityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *ResetActivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetActivityResponse.Merge(m, src)
+}
+func (m *ResetActivityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetActivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetActivityResponse.DiscardUnknown(m)
 }
 
-type GetMutableStateResponse struct {
-	WorkflowExecution                    *v1.WorkflowExecution           `protobuf:"bytes,1,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	WorkflowType                         *v1.WorkflowType                `protobuf:"bytes,2,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
-	NextEventId                          int64                           `protobuf:"varint,3,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
-	PreviousStartedEventId               *types.Int64Value               `protobuf:"bytes,4,opt,name=previous_started_event_id,json=previousStartedEventId,proto3" json:"previous_started_event_id,omitempty"`
-	LastFirstEventId                     int64                           `protobuf:"varint,5,opt,name=last_first_event_id,json=lastFirstEventId,proto3" json:"last_first_event_id,omitempty"`
-	TaskList                             *v1.TaskList                    `protobuf:"bytes,6,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
-	StickyTaskList                       *v1.TaskList                    `protobuf:"bytes,7,opt,name=sticky_task_list,json=stickyTaskList,proto3" json:"sticky_task_list,omitempty"`
-	ClientLibraryVersion                 string                          `protobuf:"bytes,8,opt,name=client_library_version,json=clientLibraryVersion,proto3" json:"client_library_version,omitempty"`
-	ClientFeatureVersion                 string                          `protobuf:"bytes,9,opt,name=client_feature_version,json=clientFeatureVersion,proto3" json:"client_feature_version,omitempty"`
-	ClientImpl                           string                          `protobuf:"bytes,10,opt,name=client_impl,json=clientImpl,proto3" json:"client_impl,omitempty"`
-	StickyTaskListScheduleToStartTimeout *types.Duration                 `protobuf:"bytes,11,opt,name=sticky_task_list_schedule_to_start_timeout,json=stickyTaskListScheduleToStartTimeout,proto3" json:"sticky_task_list_schedule_to_start_timeout,omitempty"`
-	EventStoreVersion                    int32                           `protobuf:"varint,12,opt,name=event_store_version,json=eventStoreVersion,proto3" json:"event_store_version,omitempty"`
-	CurrentBranchToken                   []byte                          `protobuf:"bytes,13,opt,name=current_branch_token,json=currentBranchToken,proto3" json:"current_branch_token,omitempty"`
-	WorkflowState                        v11.WorkflowState               `protobuf:"varint,14,opt,name=workflow_state,json=workflowState,proto3,enum=uber.cadence.shared.v1.WorkflowState" json:"workflow_state,omitempty"`
-	WorkflowCloseState                   v1.WorkflowExecutionCloseStatus `protobuf:"varint,15,opt,name=workflow_close_state,json=workflowCloseState,proto3,enum=uber.cadence.api.v1.WorkflowExecutionCloseStatus" json:"workflow_close_state,omitempty"`
-	VersionHistories                     *v11.VersionHistories           `protobuf:"bytes,16,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
-	IsStickyTaskListEnabled              bool                            `protobuf:"varint,17,opt,name=is_sticky_task_list_enabled,json=isStickyTaskListEnabled,proto3" json:"is_sticky_task_list_enabled,omitempty"`
-	HistorySize                          int64                           `protobuf:"varint,18,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
-	XXX_NoUnkeyedLiteral                 struct{}                        `json:"-"`
-	XXX_unrecognized                     []byte                          `json:"-"`
-	XXX_sizecache                        int32                           `json:"-"`
+var xxx_messageInfo_ResetActivityResponse proto.InternalMessageInfo
+
+type UpdateActivityOptionsRequest struct {
+	Request              *v1.UpdateActivityOptionsRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                           `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
-func (m *GetMutableStateResponse) Reset()         { *m = GetMutableStateResponse{} }
-func (m *GetMutableStateResponse) String() string { return proto.CompactTextString(m) }
-func (*GetMutableStateResponse) ProtoMessage()    {}
-func (*GetMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{17}
+func (m *UpdateActivityOptionsRequest) Reset()         { *m = UpdateActivityOptionsRequest{} }
+func (m *UpdateActivityOptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateActivityOptionsRequest) ProtoMessage()    {}
+func (*UpdateActivityOptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{20}
 }
-func (m *GetMutableStateResponse) XXX_Unmarshal(b []byte) error {
+func (m *UpdateActivityOptionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetMutableStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateActivityOptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetMutableStateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateActivityOptionsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1112,166 +1157,149 @@ func (m *GetMutableStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *GetMutableStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMutableStateResponse.Merge(m, src)
+func (m *UpdateActivityOptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateActivityOptionsRequest.Merge(m, src)
 }
-func (m *GetMutableStateResponse) XXX_Size() int {
+func (m *UpdateActivityOptionsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetMutableStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMutableStateResponse.DiscardUnknown(m)
+func (m *UpdateActivityOptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateActivityOptionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMutableStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateActivityOptionsRequest proto.InternalMessageInfo
 
-func (m *GetMutableStateResponse) GetWorkflowExecution() *v1.WorkflowExecution {
+func (m *UpdateActivityOptionsRequest) GetRequest() *v1.UpdateActivityOptionsRequest {
 	if m != nil {
-		return m.WorkflowExecution
+		return m.Request
 	}
 	return nil
 }
 
-func (m *GetMutableStateResponse) GetWorkflowType() *v1.WorkflowType {
+func (m *UpdateActivityOptionsRequest) GetDomainId() string {
 	if m != nil {
-		return m.WorkflowType
+		return m.DomainId
 	}
-	return nil
+	return ""
 }
 
-func (m *GetMutableStateResponse) GetNextEventId() int64 {
-	if m != nil {
-		return m.NextEventId
-	}
-	return 0
+type UpdateActivityOptionsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMutableStateResponse) GetPreviousStartedEventId() *types.Int64Value {
-	if m != nil {
-		return m.PreviousStartedEventId
-	}
-	return nil
+func (m *UpdateActivityOptionsResponse) Reset()         { *m = UpdateActivityOptionsResponse{} }
+func (m *UpdateActivityOptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateActivityOptionsResponse) ProtoMessage()    {}
+func (*UpdateActivityOptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{21}
 }
-
-func (m *GetMutableStateResponse) GetLastFirstEventId() int64 {
-	if m != nil {
-		return m.LastFirstEventId
-	}
-	return 0
+func (m *UpdateActivityOptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *GetMutableStateResponse) GetTaskList() *v1.TaskList {
-	if m != nil {
-		return m.TaskList
+func (m *UpdateActivityOptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateActivityOptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *GetMutableStateResponse) GetStickyTaskList() *v1.TaskList {
-	if m != nil {
-		return m.StickyTaskList
-	}
-	return nil
+func (m *UpdateActivityOptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateActivityOptionsResponse.Merge(m, src)
 }
-
-func (m *GetMutableStateResponse) GetClientLibraryVersion() string {
-	if m != nil {
-		return m.ClientLibraryVersion
-	}
-	return ""
+func (m *UpdateActivityOptionsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *GetMutableStateResponse) GetClientFeatureVersion() string {
-	if m != nil {
-		return m.ClientFeatureVersion
-	}
-	return ""
+func (m *UpdateActivityOptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateActivityOptionsResponse.DiscardUnknown(m)
 }
 
-func (m *GetMutableStateResponse) GetClientImpl() string {
-	if m != nil {
-		return m.ClientImpl
-	}
-	return ""
-}
+var xxx_messageInfo_UpdateActivityOptionsResponse proto.InternalMessageInfo
 
-func (m *GetMutableStateResponse) GetStickyTaskListScheduleToStartTimeout() *types.Duration {
-	if m != nil {
-		return m.StickyTaskListScheduleToStartTimeout
-	}
-	return nil
+type DescribeWorkflowExecutionRequest struct {
+	Request              *v1.DescribeWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                               `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
 }
 
-func (m *GetMutableStateResponse) GetEventStoreVersion() int32 {
-	if m != nil {
-		return m.EventStoreVersion
-	}
-	return 0
+func (m *DescribeWorkflowExecutionRequest) Reset()         { *m = DescribeWorkflowExecutionRequest{} }
+func (m *DescribeWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeWorkflowExecutionRequest) ProtoMessage()    {}
+func (*DescribeWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{22}
 }
-
-func (m *GetMutableStateResponse) GetCurrentBranchToken() []byte {
-	if m != nil {
-		return m.CurrentBranchToken
-	}
-	return nil
+func (m *DescribeWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *GetMutableStateResponse) GetWorkflowState() v11.WorkflowState {
-	if m != nil {
-		return m.WorkflowState
+func (m *DescribeWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return v11.WorkflowState_WORKFLOW_STATE_INVALID
 }
-
-func (m *GetMutableStateResponse) GetWorkflowCloseState() v1.WorkflowExecutionCloseStatus {
-	if m != nil {
-		return m.WorkflowCloseState
-	}
-	return v1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID
+func (m *DescribeWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeWorkflowExecutionRequest.Merge(m, src)
 }
-
-func (m *GetMutableStateResponse) GetVersionHistories() *v11.VersionHistories {
-	if m != nil {
-		return m.VersionHistories
-	}
-	return nil
+func (m *DescribeWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeWorkflowExecutionRequest.DiscardUnknown(m)
 }
 
-func (m *GetMutableStateResponse) GetIsStickyTaskListEnabled() bool {
+var xxx_messageInfo_DescribeWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *DescribeWorkflowExecutionRequest) GetRequest() *v1.DescribeWorkflowExecutionRequest {
 	if m != nil {
-		return m.IsStickyTaskListEnabled
+		return m.Request
 	}
-	return false
+	return nil
 }
 
-func (m *GetMutableStateResponse) GetHistorySize() int64 {
+func (m *DescribeWorkflowExecutionRequest) GetDomainId() string {
 	if m != nil {
-		return m.HistorySize
+		return m.DomainId
 	}
-	return 0
+	return ""
 }
 
-type PollMutableStateRequest struct {
-	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	ExpectedNextEventId  int64                 `protobuf:"varint,3,opt,name=expected_next_event_id,json=expectedNextEventId,proto3" json:"expected_next_event_id,omitempty"`
-	CurrentBranchToken   []byte                `protobuf:"bytes,4,opt,name=current_branch_token,json=currentBranchToken,proto3" json:"current_branch_token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type DescribeWorkflowExecutionResponse struct {
+	ExecutionConfiguration *v1.WorkflowExecutionConfiguration `protobuf:"bytes,1,opt,name=execution_configuration,json=executionConfiguration,proto3" json:"execution_configuration,omitempty"`
+	WorkflowExecutionInfo  *v1.WorkflowExecutionInfo          `protobuf:"bytes,2,opt,name=workflow_execution_info,json=workflowExecutionInfo,proto3" json:"workflow_execution_info,omitempty"`
+	PendingActivities      []*v1.PendingActivityInfo          `protobuf:"bytes,3,rep,name=pending_activities,json=pendingActivities,proto3" json:"pending_activities,omitempty"`
+	PendingChildren        []*v1.PendingChildExecutionInfo    `protobuf:"bytes,4,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
+	PendingDecision        *v1.PendingDecisionInfo            `protobuf:"bytes,5,opt,name=pending_decision,json=pendingDecision,proto3" json:"pending_decision,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                           `json:"-"`
+	XXX_unrecognized       []byte                             `json:"-"`
+	XXX_sizecache          int32                              `json:"-"`
 }
 
-func (m *PollMutableStateRequest) Reset()         { *m = PollMutableStateRequest{} }
-func (m *PollMutableStateRequest) String() string { return proto.CompactTextString(m) }
-func (*PollMutableStateRequest) ProtoMessage()    {}
-func (*PollMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{18}
+func (m *DescribeWorkflowExecutionResponse) Reset()         { *m = DescribeWorkflowExecutionResponse{} }
+func (m *DescribeWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeWorkflowExecutionResponse) ProtoMessage()    {}
+func (*DescribeWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{23}
 }
-func (m *PollMutableStateRequest) XXX_Unmarshal(b []byte) error {
+func (m *DescribeWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PollMutableStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DescribeWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PollMutableStateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DescribeWorkflowExecutionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1281,79 +1309,73 @@ func (m *PollMutableStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *PollMutableStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PollMutableStateRequest.Merge(m, src)
+func (m *DescribeWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeWorkflowExecutionResponse.Merge(m, src)
 }
-func (m *PollMutableStateRequest) XXX_Size() int {
+func (m *DescribeWorkflowExecutionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *PollMutableStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PollMutableStateRequest.DiscardUnknown(m)
+func (m *DescribeWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeWorkflowExecutionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PollMutableStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_DescribeWorkflowExecutionResponse proto.InternalMessageInfo
 
-func (m *PollMutableStateRequest) GetDomainId() string {
+func (m *DescribeWorkflowExecutionResponse) GetExecutionConfiguration() *v1.WorkflowExecutionConfiguration {
 	if m != nil {
-		return m.DomainId
+		return m.ExecutionConfiguration
 	}
-	return ""
+	return nil
 }
 
-func (m *PollMutableStateRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+func (m *DescribeWorkflowExecutionResponse) GetWorkflowExecutionInfo() *v1.WorkflowExecutionInfo {
 	if m != nil {
-		return m.WorkflowExecution
+		return m.WorkflowExecutionInfo
 	}
 	return nil
 }
 
-func (m *PollMutableStateRequest) GetExpectedNextEventId() int64 {
+func (m *DescribeWorkflowExecutionResponse) GetPendingActivities() []*v1.PendingActivityInfo {
 	if m != nil {
-		return m.ExpectedNextEventId
+		return m.PendingActivities
 	}
-	return 0
+	return nil
 }
 
-func (m *PollMutableStateRequest) GetCurrentBranchToken() []byte {
+func (m *DescribeWorkflowExecutionResponse) GetPendingChildren() []*v1.PendingChildExecutionInfo {
 	if m != nil {
-		return m.CurrentBranchToken
+		return m.PendingChildren
 	}
 	return nil
 }
 
-type PollMutableStateResponse struct {
-	WorkflowExecution                    *v1.WorkflowExecution           `protobuf:"bytes,1,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	WorkflowType                         *v1.WorkflowType                `protobuf:"bytes,2,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
-	NextEventId                          int64                           `protobuf:"varint,3,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
-	PreviousStartedEventId               *types.Int64Value               `protobuf:"bytes,4,opt,name=previous_started_event_id,json=previousStartedEventId,proto3" json:"previous_started_event_id,omitempty"`
-	LastFirstEventId                     int64                           `protobuf:"varint,5,opt,name=last_first_event_id,json=lastFirstEventId,proto3" json:"last_first_event_id,omitempty"`
-	TaskList                             *v1.TaskList                    `protobuf:"bytes,6,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
-	StickyTaskList                       *v1.TaskList                    `protobuf:"bytes,7,opt,name=sticky_task_list,json=stickyTaskList,proto3" json:"sticky_task_list,omitempty"`
-	ClientLibraryVersion                 string                          `protobuf:"bytes,8,opt,name=client_library_version,json=clientLibraryVersion,proto3" json:"client_library_version,omitempty"`
-	ClientFeatureVersion                 string                          `protobuf:"bytes,9,opt,name=client_feature_version,json=clientFeatureVersion,proto3" json:"client_feature_version,omitempty"`
-	ClientImpl                           string                          `protobuf:"bytes,10,opt,name=client_impl,json=clientImpl,proto3" json:"client_impl,omitempty"`
-	StickyTaskListScheduleToStartTimeout *types.Duration                 `protobuf:"bytes,11,opt,name=sticky_task_list_schedule_to_start_timeout,json=stickyTaskListScheduleToStartTimeout,proto3" json:"sticky_task_list_schedule_to_start_timeout,omitempty"`
-	CurrentBranchToken                   []byte                          `protobuf:"bytes,12,opt,name=current_branch_token,json=currentBranchToken,proto3" json:"current_branch_token,omitempty"`
-	VersionHistories                     *v11.VersionHistories           `protobuf:"bytes,13,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
-	WorkflowState                        v11.WorkflowState               `protobuf:"varint,14,opt,name=workflow_state,json=workflowState,proto3,enum=uber.cadence.shared.v1.WorkflowState" json:"workflow_state,omitempty"`
-	WorkflowCloseState                   v1.WorkflowExecutionCloseStatus `protobuf:"varint,15,opt,name=workflow_close_state,json=workflowCloseState,proto3,enum=uber.cadence.api.v1.WorkflowExecutionCloseStatus" json:"workflow_close_state,omitempty"`
-	XXX_NoUnkeyedLiteral                 struct{}                        `json:"-"`
-	XXX_unrecognized                     []byte                          `json:"-"`
-	XXX_sizecache                        int32                           `json:"-"`
+func (m *DescribeWorkflowExecutionResponse) GetPendingDecision() *v1.PendingDecisionInfo {
+	if m != nil {
+		return m.PendingDecision
+	}
+	return nil
 }
 
-func (m *PollMutableStateResponse) Reset()         { *m = PollMutableStateResponse{} }
-func (m *PollMutableStateResponse) String() string { return proto.CompactTextString(m) }
-func (*PollMutableStateResponse) ProtoMessage()    {}
-func (*PollMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{19}
+type QueryWorkflowRequest struct {
+	Request              *v1.QueryWorkflowRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                   `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
-func (m *PollMutableStateResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryWorkflowRequest) Reset()         { *m = QueryWorkflowRequest{} }
+func (m *QueryWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWorkflowRequest) ProtoMessage()    {}
+func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{24}
+}
+func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PollMutableStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PollMutableStateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryWorkflowRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1363,148 +1385,107 @@ func (m *PollMutableStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *PollMutableStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PollMutableStateResponse.Merge(m, src)
+func (m *QueryWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorkflowRequest.Merge(m, src)
 }
-func (m *PollMutableStateResponse) XXX_Size() int {
+func (m *QueryWorkflowRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PollMutableStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PollMutableStateResponse.DiscardUnknown(m)
+func (m *QueryWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorkflowRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PollMutableStateResponse proto.InternalMessageInfo
-
-func (m *PollMutableStateResponse) GetWorkflowExecution() *v1.WorkflowExecution {
-	if m != nil {
-		return m.WorkflowExecution
-	}
-	return nil
-}
+var xxx_messageInfo_QueryWorkflowRequest proto.InternalMessageInfo
 
-func (m *PollMutableStateResponse) GetWorkflowType() *v1.WorkflowType {
+func (m *QueryWorkflowRequest) GetRequest() *v1.QueryWorkflowRequest {
 	if m != nil {
-		return m.WorkflowType
+		return m.Request
 	}
 	return nil
 }
 
-func (m *PollMutableStateResponse) GetNextEventId() int64 {
+func (m *QueryWorkflowRequest) GetDomainId() string {
 	if m != nil {
-		return m.NextEventId
+		return m.DomainId
 	}
-	return 0
+	return ""
 }
 
-func (m *PollMutableStateResponse) GetPreviousStartedEventId() *types.Int64Value {
-	if m != nil {
-		return m.PreviousStartedEventId
-	}
-	return nil
+type QueryWorkflowResponse struct {
+	QueryResult          *v1.Payload       `protobuf:"bytes,1,opt,name=query_result,json=queryResult,proto3" json:"query_result,omitempty"`
+	QueryRejected        *v1.QueryRejected `protobuf:"bytes,2,opt,name=query_rejected,json=queryRejected,proto3" json:"query_rejected,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *PollMutableStateResponse) GetLastFirstEventId() int64 {
-	if m != nil {
-		return m.LastFirstEventId
-	}
-	return 0
+func (m *QueryWorkflowResponse) Reset()         { *m = QueryWorkflowResponse{} }
+func (m *QueryWorkflowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWorkflowResponse) ProtoMessage()    {}
+func (*QueryWorkflowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{25}
 }
-
-func (m *PollMutableStateResponse) GetTaskList() *v1.TaskList {
-	if m != nil {
-		return m.TaskList
-	}
-	return nil
+func (m *QueryWorkflowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *PollMutableStateResponse) GetStickyTaskList() *v1.TaskList {
-	if m != nil {
-		return m.StickyTaskList
+func (m *QueryWorkflowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWorkflowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *PollMutableStateResponse) GetClientLibraryVersion() string {
-	if m != nil {
-		return m.ClientLibraryVersion
-	}
-	return ""
+func (m *QueryWorkflowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorkflowResponse.Merge(m, src)
 }
-
-func (m *PollMutableStateResponse) GetClientFeatureVersion() string {
-	if m != nil {
-		return m.ClientFeatureVersion
-	}
-	return ""
+func (m *QueryWorkflowResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *PollMutableStateResponse) GetClientImpl() string {
-	if m != nil {
-		return m.ClientImpl
-	}
-	return ""
+func (m *QueryWorkflowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorkflowResponse.DiscardUnknown(m)
 }
 
-func (m *PollMutableStateResponse) GetStickyTaskListScheduleToStartTimeout() *types.Duration {
-	if m != nil {
-		return m.StickyTaskListScheduleToStartTimeout
-	}
-	return nil
-}
+var xxx_messageInfo_QueryWorkflowResponse proto.InternalMessageInfo
 
-func (m *PollMutableStateResponse) GetCurrentBranchToken() []byte {
+func (m *QueryWorkflowResponse) GetQueryResult() *v1.Payload {
 	if m != nil {
-		return m.CurrentBranchToken
+		return m.QueryResult
 	}
 	return nil
 }
 
-func (m *PollMutableStateResponse) GetVersionHistories() *v11.VersionHistories {
+func (m *QueryWorkflowResponse) GetQueryRejected() *v1.QueryRejected {
 	if m != nil {
-		return m.VersionHistories
+		return m.QueryRejected
 	}
 	return nil
 }
 
-func (m *PollMutableStateResponse) GetWorkflowState() v11.WorkflowState {
-	if m != nil {
-		return m.WorkflowState
-	}
-	return v11.WorkflowState_WORKFLOW_STATE_INVALID
-}
-
-func (m *PollMutableStateResponse) GetWorkflowCloseState() v1.WorkflowExecutionCloseStatus {
-	if m != nil {
-		return m.WorkflowCloseState
-	}
-	return v1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID
-}
-
-type RecordDecisionTaskStartedRequest struct {
-	DomainId          string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	WorkflowExecution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	ScheduleId        int64                 `protobuf:"varint,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	TaskId            int64                 `protobuf:"varint,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	// Unique id of each poll request. Used to ensure at most once delivery of tasks.
-	RequestId            string                         `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	PollRequest          *v1.PollForDecisionTaskRequest `protobuf:"bytes,6,opt,name=poll_request,json=pollRequest,proto3" json:"poll_request,omitempty"`
+type ResetStickyTaskListRequest struct {
+	Request              *v1.ResetStickyTaskListRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                         `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
 	XXX_unrecognized     []byte                         `json:"-"`
 	XXX_sizecache        int32                          `json:"-"`
 }
 
-func (m *RecordDecisionTaskStartedRequest) Reset()         { *m = RecordDecisionTaskStartedRequest{} }
-func (m *RecordDecisionTaskStartedRequest) String() string { return proto.CompactTextString(m) }
-func (*RecordDecisionTaskStartedRequest) ProtoMessage()    {}
-func (*RecordDecisionTaskStartedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{20}
+func (m *ResetStickyTaskListRequest) Reset()         { *m = ResetStickyTaskListRequest{} }
+func (m *ResetStickyTaskListRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetStickyTaskListRequest) ProtoMessage()    {}
+func (*ResetStickyTaskListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{26}
 }
-func (m *RecordDecisionTaskStartedRequest) XXX_Unmarshal(b []byte) error {
+func (m *ResetStickyTaskListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordDecisionTaskStartedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResetStickyTaskListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordDecisionTaskStartedRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResetStickyTaskListRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1514,93 +1495,178 @@ func (m *RecordDecisionTaskStartedRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *RecordDecisionTaskStartedRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordDecisionTaskStartedRequest.Merge(m, src)
+func (m *ResetStickyTaskListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetStickyTaskListRequest.Merge(m, src)
 }
-func (m *RecordDecisionTaskStartedRequest) XXX_Size() int {
+func (m *ResetStickyTaskListRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordDecisionTaskStartedRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordDecisionTaskStartedRequest.DiscardUnknown(m)
+func (m *ResetStickyTaskListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetStickyTaskListRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordDecisionTaskStartedRequest proto.InternalMessageInfo
-
-func (m *RecordDecisionTaskStartedRequest) GetDomainId() string {
-	if m != nil {
-		return m.DomainId
-	}
-	return ""
-}
+var xxx_messageInfo_ResetStickyTaskListRequest proto.InternalMessageInfo
 
-func (m *RecordDecisionTaskStartedRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+func (m *ResetStickyTaskListRequest) GetRequest() *v1.ResetStickyTaskListRequest {
 	if m != nil {
-		return m.WorkflowExecution
+		return m.Request
 	}
 	return nil
 }
 
-func (m *RecordDecisionTaskStartedRequest) GetScheduleId() int64 {
+func (m *ResetStickyTaskListRequest) GetDomainId() string {
 	if m != nil {
-		return m.ScheduleId
+		return m.DomainId
 	}
-	return 0
+	return ""
 }
 
-func (m *RecordDecisionTaskStartedRequest) GetTaskId() int64 {
-	if m != nil {
-		return m.TaskId
-	}
-	return 0
+type ResetStickyTaskListResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RecordDecisionTaskStartedRequest) GetRequestId() string {
-	if m != nil {
-		return m.RequestId
-	}
-	return ""
-}
+func (m *ResetStickyTaskListResponse) Reset()         { *m = ResetStickyTaskListResponse{} }
+func (m *ResetStickyTaskListResponse) String() string { return proto.CompactTextString(m) }
+func (*ResetStickyTaskListResponse) ProtoMessage()    {}
+func (*ResetStickyTaskListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{27}
+}
+func (m *ResetStickyTaskListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetStickyTaskListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetStickyTaskListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResetStickyTaskListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetStickyTaskListResponse.Merge(m, src)
+}
+func (m *ResetStickyTaskListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetStickyTaskListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetStickyTaskListResponse.DiscardUnknown(m)
+}
 
-func (m *RecordDecisionTaskStartedRequest) GetPollRequest() *v1.PollForDecisionTaskRequest {
+var xxx_messageInfo_ResetStickyTaskListResponse proto.InternalMessageInfo
+
+type GetMutableStateRequest struct {
+	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	ExpectedNextEventId  int64                 `protobuf:"varint,3,opt,name=expected_next_event_id,json=expectedNextEventId,proto3" json:"expected_next_event_id,omitempty"`
+	CurrentBranchToken   []byte                `protobuf:"bytes,4,opt,name=current_branch_token,json=currentBranchToken,proto3" json:"current_branch_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetMutableStateRequest) Reset()         { *m = GetMutableStateRequest{} }
+func (m *GetMutableStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMutableStateRequest) ProtoMessage()    {}
+func (*GetMutableStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{28}
+}
+func (m *GetMutableStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetMutableStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMutableStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetMutableStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMutableStateRequest.Merge(m, src)
+}
+func (m *GetMutableStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetMutableStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMutableStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMutableStateRequest proto.InternalMessageInfo
+
+func (m *GetMutableStateRequest) GetDomainId() string {
 	if m != nil {
-		return m.PollRequest
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *GetMutableStateRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
 	}
 	return nil
 }
 
-type RecordDecisionTaskStartedResponse struct {
-	WorkflowType              *v1.WorkflowType             `protobuf:"bytes,1,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
-	PreviousStartedEventId    *types.Int64Value            `protobuf:"bytes,2,opt,name=previous_started_event_id,json=previousStartedEventId,proto3" json:"previous_started_event_id,omitempty"`
-	ScheduledEventId          int64                        `protobuf:"varint,3,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
-	StartedEventId            int64                        `protobuf:"varint,4,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
-	NextEventId               int64                        `protobuf:"varint,5,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
-	Attempt                   int32                        `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
-	StickyExecutionEnabled    bool                         `protobuf:"varint,7,opt,name=sticky_execution_enabled,json=stickyExecutionEnabled,proto3" json:"sticky_execution_enabled,omitempty"`
-	DecisionInfo              *v11.TransientDecisionInfo   `protobuf:"bytes,8,opt,name=decision_info,json=decisionInfo,proto3" json:"decision_info,omitempty"`
-	WorkflowExecutionTaskList *v1.TaskList                 `protobuf:"bytes,9,opt,name=workflow_execution_task_list,json=workflowExecutionTaskList,proto3" json:"workflow_execution_task_list,omitempty"`
-	EventStoreVersion         int32                        `protobuf:"varint,10,opt,name=event_store_version,json=eventStoreVersion,proto3" json:"event_store_version,omitempty"`
-	BranchToken               []byte                       `protobuf:"bytes,11,opt,name=branch_token,json=branchToken,proto3" json:"branch_token,omitempty"`
-	ScheduledTime             *types.Timestamp             `protobuf:"bytes,12,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
-	StartedTime               *types.Timestamp             `protobuf:"bytes,13,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
-	Queries                   map[string]*v1.WorkflowQuery `protobuf:"bytes,14,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	HistorySize               int64                        `protobuf:"varint,15,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{}                     `json:"-"`
-	XXX_unrecognized          []byte                       `json:"-"`
-	XXX_sizecache             int32                        `json:"-"`
+func (m *GetMutableStateRequest) GetExpectedNextEventId() int64 {
+	if m != nil {
+		return m.ExpectedNextEventId
+	}
+	return 0
 }
 
-func (m *RecordDecisionTaskStartedResponse) Reset()         { *m = RecordDecisionTaskStartedResponse{} }
-func (m *RecordDecisionTaskStartedResponse) String() string { return proto.CompactTextString(m) }
-func (*RecordDecisionTaskStartedResponse) ProtoMessage()    {}
-func (*RecordDecisionTaskStartedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{21}
+func (m *GetMutableStateRequest) GetCurrentBranchToken() []byte {
+	if m != nil {
+		return m.CurrentBranchToken
+	}
+	return nil
 }
-func (m *RecordDecisionTaskStartedResponse) XXX_Unmarshal(b []byte) error {
+
+type GetMutableStateResponse struct {
+	WorkflowExecution                    *v1.WorkflowExecution           `protobuf:"bytes,1,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	WorkflowType                         *v1.WorkflowType                `protobuf:"bytes,2,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	NextEventId                          int64                           `protobuf:"varint,3,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
+	PreviousStartedEventId               *types.Int64Value               `protobuf:"bytes,4,opt,name=previous_started_event_id,json=previousStartedEventId,proto3" json:"previous_started_event_id,omitempty"`
+	LastFirstEventId                     int64                           `protobuf:"varint,5,opt,name=last_first_event_id,json=lastFirstEventId,proto3" json:"last_first_event_id,omitempty"`
+	TaskList                             *v1.TaskList                    `protobuf:"bytes,6,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	StickyTaskList                       *v1.TaskList                    `protobuf:"bytes,7,opt,name=sticky_task_list,json=stickyTaskList,proto3" json:"sticky_task_list,omitempty"`
+	ClientLibraryVersion                 string                          `protobuf:"bytes,8,opt,name=client_library_version,json=clientLibraryVersion,proto3" json:"client_library_version,omitempty"`
+	ClientFeatureVersion                 string                          `protobuf:"bytes,9,opt,name=client_feature_version,json=clientFeatureVersion,proto3" json:"client_feature_version,omitempty"`
+	ClientImpl                           string                          `protobuf:"bytes,10,opt,name=client_impl,json=clientImpl,proto3" json:"client_impl,omitempty"`
+	StickyTaskListScheduleToStartTimeout *types.Duration                 `protobuf:"bytes,11,opt,name=sticky_task_list_schedule_to_start_timeout,json=stickyTaskListScheduleToStartTimeout,proto3" json:"sticky_task_list_schedule_to_start_timeout,omitempty"`
+	EventStoreVersion                    int32                           `protobuf:"varint,12,opt,name=event_store_version,json=eventStoreVersion,proto3" json:"event_store_version,omitempty"`
+	CurrentBranchToken                   []byte                          `protobuf:"bytes,13,opt,name=current_branch_token,json=currentBranchToken,proto3" json:"current_branch_token,omitempty"`
+	WorkflowState                        v11.WorkflowState               `protobuf:"varint,14,opt,name=workflow_state,json=workflowState,proto3,enum=uber.cadence.shared.v1.WorkflowState" json:"workflow_state,omitempty"`
+	WorkflowCloseState                   v1.WorkflowExecutionCloseStatus `protobuf:"varint,15,opt,name=workflow_close_state,json=workflowCloseState,proto3,enum=uber.cadence.api.v1.WorkflowExecutionCloseStatus" json:"workflow_close_state,omitempty"`
+	VersionHistories                     *v11.VersionHistories           `protobuf:"bytes,16,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
+	IsStickyTaskListEnabled              bool                            `protobuf:"varint,17,opt,name=is_sticky_task_list_enabled,json=isStickyTaskListEnabled,proto3" json:"is_sticky_task_list_enabled,omitempty"`
+	HistorySize                          int64                           `protobuf:"varint,18,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
+	XXX_NoUnkeyedLiteral                 struct{}                        `json:"-"`
+	XXX_unrecognized                     []byte                          `json:"-"`
+	XXX_sizecache                        int32                           `json:"-"`
+}
+
+func (m *GetMutableStateResponse) Reset()         { *m = GetMutableStateResponse{} }
+func (m *GetMutableStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMutableStateResponse) ProtoMessage()    {}
+func (*GetMutableStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{29}
+}
+func (m *GetMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordDecisionTaskStartedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetMutableStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordDecisionTaskStartedResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetMutableStateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1610,148 +1676,166 @@ func (m *RecordDecisionTaskStartedResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *RecordDecisionTaskStartedResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordDecisionTaskStartedResponse.Merge(m, src)
+func (m *GetMutableStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMutableStateResponse.Merge(m, src)
 }
-func (m *RecordDecisionTaskStartedResponse) XXX_Size() int {
+func (m *GetMutableStateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordDecisionTaskStartedResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordDecisionTaskStartedResponse.DiscardUnknown(m)
+func (m *GetMutableStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMutableStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordDecisionTaskStartedResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetMutableStateResponse proto.InternalMessageInfo
 
-func (m *RecordDecisionTaskStartedResponse) GetWorkflowType() *v1.WorkflowType {
+func (m *GetMutableStateResponse) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *GetMutableStateResponse) GetWorkflowType() *v1.WorkflowType {
 	if m != nil {
 		return m.WorkflowType
 	}
 	return nil
 }
 
-func (m *RecordDecisionTaskStartedResponse) GetPreviousStartedEventId() *types.Int64Value {
+func (m *GetMutableStateResponse) GetNextEventId() int64 {
+	if m != nil {
+		return m.NextEventId
+	}
+	return 0
+}
+
+func (m *GetMutableStateResponse) GetPreviousStartedEventId() *types.Int64Value {
 	if m != nil {
 		return m.PreviousStartedEventId
 	}
 	return nil
 }
 
-func (m *RecordDecisionTaskStartedResponse) GetScheduledEventId() int64 {
+func (m *GetMutableStateResponse) GetLastFirstEventId() int64 {
 	if m != nil {
-		return m.ScheduledEventId
+		return m.LastFirstEventId
 	}
 	return 0
 }
 
-func (m *RecordDecisionTaskStartedResponse) GetStartedEventId() int64 {
+func (m *GetMutableStateResponse) GetTaskList() *v1.TaskList {
 	if m != nil {
-		return m.StartedEventId
+		return m.TaskList
 	}
-	return 0
+	return nil
 }
 
-func (m *RecordDecisionTaskStartedResponse) GetNextEventId() int64 {
+func (m *GetMutableStateResponse) GetStickyTaskList() *v1.TaskList {
 	if m != nil {
-		return m.NextEventId
+		return m.StickyTaskList
 	}
-	return 0
+	return nil
 }
 
-func (m *RecordDecisionTaskStartedResponse) GetAttempt() int32 {
+func (m *GetMutableStateResponse) GetClientLibraryVersion() string {
 	if m != nil {
-		return m.Attempt
+		return m.ClientLibraryVersion
 	}
-	return 0
+	return ""
 }
 
-func (m *RecordDecisionTaskStartedResponse) GetStickyExecutionEnabled() bool {
+func (m *GetMutableStateResponse) GetClientFeatureVersion() string {
 	if m != nil {
-		return m.StickyExecutionEnabled
+		return m.ClientFeatureVersion
 	}
-	return false
+	return ""
 }
 
-func (m *RecordDecisionTaskStartedResponse) GetDecisionInfo() *v11.TransientDecisionInfo {
+func (m *GetMutableStateResponse) GetClientImpl() string {
 	if m != nil {
-		return m.DecisionInfo
+		return m.ClientImpl
 	}
-	return nil
+	return ""
 }
 
-func (m *RecordDecisionTaskStartedResponse) GetWorkflowExecutionTaskList() *v1.TaskList {
+func (m *GetMutableStateResponse) GetStickyTaskListScheduleToStartTimeout() *types.Duration {
 	if m != nil {
-		return m.WorkflowExecutionTaskList
+		return m.StickyTaskListScheduleToStartTimeout
 	}
 	return nil
 }
 
-func (m *RecordDecisionTaskStartedResponse) GetEventStoreVersion() int32 {
+func (m *GetMutableStateResponse) GetEventStoreVersion() int32 {
 	if m != nil {
 		return m.EventStoreVersion
 	}
 	return 0
 }
 
-func (m *RecordDecisionTaskStartedResponse) GetBranchToken() []byte {
+func (m *GetMutableStateResponse) GetCurrentBranchToken() []byte {
 	if m != nil {
-		return m.BranchToken
+		return m.CurrentBranchToken
 	}
 	return nil
 }
 
-func (m *RecordDecisionTaskStartedResponse) GetScheduledTime() *types.Timestamp {
+func (m *GetMutableStateResponse) GetWorkflowState() v11.WorkflowState {
 	if m != nil {
-		return m.ScheduledTime
+		return m.WorkflowState
 	}
-	return nil
+	return v11.WorkflowState_WORKFLOW_STATE_INVALID
 }
 
-func (m *RecordDecisionTaskStartedResponse) GetStartedTime() *types.Timestamp {
+func (m *GetMutableStateResponse) GetWorkflowCloseState() v1.WorkflowExecutionCloseStatus {
 	if m != nil {
-		return m.StartedTime
+		return m.WorkflowCloseState
 	}
-	return nil
+	return v1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID
 }
 
-func (m *RecordDecisionTaskStartedResponse) GetQueries() map[string]*v1.WorkflowQuery {
+func (m *GetMutableStateResponse) GetVersionHistories() *v11.VersionHistories {
 	if m != nil {
-		return m.Queries
+		return m.VersionHistories
 	}
 	return nil
 }
 
-func (m *RecordDecisionTaskStartedResponse) GetHistorySize() int64 {
+func (m *GetMutableStateResponse) GetIsStickyTaskListEnabled() bool {
+	if m != nil {
+		return m.IsStickyTaskListEnabled
+	}
+	return false
+}
+
+func (m *GetMutableStateResponse) GetHistorySize() int64 {
 	if m != nil {
 		return m.HistorySize
 	}
 	return 0
 }
 
-type RecordActivityTaskStartedRequest struct {
-	DomainId          string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	WorkflowExecution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	ScheduleId        int64                 `protobuf:"varint,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	TaskId            int64                 `protobuf:"varint,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	// Unique id of each poll request. Used to ensure at most once delivery of tasks.
-	RequestId            string                         `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	PollRequest          *v1.PollForActivityTaskRequest `protobuf:"bytes,6,opt,name=poll_request,json=pollRequest,proto3" json:"poll_request,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+type PollMutableStateRequest struct {
+	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	ExpectedNextEventId  int64                 `protobuf:"varint,3,opt,name=expected_next_event_id,json=expectedNextEventId,proto3" json:"expected_next_event_id,omitempty"`
+	CurrentBranchToken   []byte                `protobuf:"bytes,4,opt,name=current_branch_token,json=currentBranchToken,proto3" json:"current_branch_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *RecordActivityTaskStartedRequest) Reset()         { *m = RecordActivityTaskStartedRequest{} }
-func (m *RecordActivityTaskStartedRequest) String() string { return proto.CompactTextString(m) }
-func (*RecordActivityTaskStartedRequest) ProtoMessage()    {}
-func (*RecordActivityTaskStartedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{22}
+func (m *PollMutableStateRequest) Reset()         { *m = PollMutableStateRequest{} }
+func (m *PollMutableStateRequest) String() string { return proto.CompactTextString(m) }
+func (*PollMutableStateRequest) ProtoMessage()    {}
+func (*PollMutableStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{30}
 }
-func (m *RecordActivityTaskStartedRequest) XXX_Unmarshal(b []byte) error {
+func (m *PollMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordActivityTaskStartedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PollMutableStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordActivityTaskStartedRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PollMutableStateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1761,85 +1845,79 @@ func (m *RecordActivityTaskStartedRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *RecordActivityTaskStartedRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordActivityTaskStartedRequest.Merge(m, src)
+func (m *PollMutableStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollMutableStateRequest.Merge(m, src)
 }
-func (m *RecordActivityTaskStartedRequest) XXX_Size() int {
+func (m *PollMutableStateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordActivityTaskStartedRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordActivityTaskStartedRequest.DiscardUnknown(m)
+func (m *PollMutableStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollMutableStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordActivityTaskStartedRequest proto.InternalMessageInfo
+var xxx_messageInfo_PollMutableStateRequest proto.InternalMessageInfo
 
-func (m *RecordActivityTaskStartedRequest) GetDomainId() string {
+func (m *PollMutableStateRequest) GetDomainId() string {
 	if m != nil {
 		return m.DomainId
 	}
 	return ""
 }
 
-func (m *RecordActivityTaskStartedRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+func (m *PollMutableStateRequest) GetWorkflowExecution() *v1.WorkflowExecution {
 	if m != nil {
 		return m.WorkflowExecution
 	}
 	return nil
 }
 
-func (m *RecordActivityTaskStartedRequest) GetScheduleId() int64 {
-	if m != nil {
-		return m.ScheduleId
-	}
-	return 0
-}
-
-func (m *RecordActivityTaskStartedRequest) GetTaskId() int64 {
+func (m *PollMutableStateRequest) GetExpectedNextEventId() int64 {
 	if m != nil {
-		return m.TaskId
+		return m.ExpectedNextEventId
 	}
 	return 0
 }
 
-func (m *RecordActivityTaskStartedRequest) GetRequestId() string {
-	if m != nil {
-		return m.RequestId
-	}
-	return ""
-}
-
-func (m *RecordActivityTaskStartedRequest) GetPollRequest() *v1.PollForActivityTaskRequest {
+func (m *PollMutableStateRequest) GetCurrentBranchToken() []byte {
 	if m != nil {
-		return m.PollRequest
+		return m.CurrentBranchToken
 	}
 	return nil
 }
 
-type RecordActivityTaskStartedResponse struct {
-	ScheduledEvent             *v1.HistoryEvent `protobuf:"bytes,1,opt,name=scheduled_event,json=scheduledEvent,proto3" json:"scheduled_event,omitempty"`
-	StartedTime                *types.Timestamp `protobuf:"bytes,2,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
-	Attempt                    int32            `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
-	ScheduledTimeOfThisAttempt *types.Timestamp `protobuf:"bytes,4,opt,name=scheduled_time_of_this_attempt,json=scheduledTimeOfThisAttempt,proto3" json:"scheduled_time_of_this_attempt,omitempty"`
-	HeartbeatDetails           *v1.Payload      `protobuf:"bytes,5,opt,name=heartbeat_details,json=heartbeatDetails,proto3" json:"heartbeat_details,omitempty"`
-	WorkflowType               *v1.WorkflowType `protobuf:"bytes,6,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
-	WorkflowDomain             string           `protobuf:"bytes,7,opt,name=workflow_domain,json=workflowDomain,proto3" json:"workflow_domain,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}         `json:"-"`
-	XXX_unrecognized           []byte           `json:"-"`
-	XXX_sizecache              int32            `json:"-"`
+type PollMutableStateResponse struct {
+	WorkflowExecution                    *v1.WorkflowExecution           `protobuf:"bytes,1,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	WorkflowType                         *v1.WorkflowType                `protobuf:"bytes,2,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	NextEventId                          int64                           `protobuf:"varint,3,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
+	PreviousStartedEventId               *types.Int64Value               `protobuf:"bytes,4,opt,name=previous_started_event_id,json=previousStartedEventId,proto3" json:"previous_started_event_id,omitempty"`
+	LastFirstEventId                     int64                           `protobuf:"varint,5,opt,name=last_first_event_id,json=lastFirstEventId,proto3" json:"last_first_event_id,omitempty"`
+	TaskList                             *v1.TaskList                    `protobuf:"bytes,6,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	StickyTaskList                       *v1.TaskList                    `protobuf:"bytes,7,opt,name=sticky_task_list,json=stickyTaskList,proto3" json:"sticky_task_list,omitempty"`
+	ClientLibraryVersion                 string                          `protobuf:"bytes,8,opt,name=client_library_version,json=clientLibraryVersion,proto3" json:"client_library_version,omitempty"`
+	ClientFeatureVersion                 string                          `protobuf:"bytes,9,opt,name=client_feature_version,json=clientFeatureVersion,proto3" json:"client_feature_version,omitempty"`
+	ClientImpl                           string                          `protobuf:"bytes,10,opt,name=client_impl,json=clientImpl,proto3" json:"client_impl,omitempty"`
+	StickyTaskListScheduleToStartTimeout *types.Duration                 `protobuf:"bytes,11,opt,name=sticky_task_list_schedule_to_start_timeout,json=stickyTaskListScheduleToStartTimeout,proto3" json:"sticky_task_list_schedule_to_start_timeout,omitempty"`
+	CurrentBranchToken                   []byte                          `protobuf:"bytes,12,opt,name=current_branch_token,json=currentBranchToken,proto3" json:"current_branch_token,omitempty"`
+	VersionHistories                     *v11.VersionHistories           `protobuf:"bytes,13,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
+	WorkflowState                        v11.WorkflowState               `protobuf:"varint,14,opt,name=workflow_state,json=workflowState,proto3,enum=uber.cadence.shared.v1.WorkflowState" json:"workflow_state,omitempty"`
+	WorkflowCloseState                   v1.WorkflowExecutionCloseStatus `protobuf:"varint,15,opt,name=workflow_close_state,json=workflowCloseState,proto3,enum=uber.cadence.api.v1.WorkflowExecutionCloseStatus" json:"workflow_close_state,omitempty"`
+	XXX_NoUnkeyedLiteral                 struct{}                        `json:"-"`
+	XXX_unrecognized                     []byte                          `json:"-"`
+	XXX_sizecache                        int32                           `json:"-"`
 }
 
-func (m *RecordActivityTaskStartedResponse) Reset()         { *m = RecordActivityTaskStartedResponse{} }
-func (m *RecordActivityTaskStartedResponse) String() string { return proto.CompactTextString(m) }
-func (*RecordActivityTaskStartedResponse) ProtoMessage()    {}
-func (*RecordActivityTaskStartedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{23}
+func (m *PollMutableStateResponse) Reset()         { *m = PollMutableStateResponse{} }
+func (m *PollMutableStateResponse) String() string { return proto.CompactTextString(m) }
+func (*PollMutableStateResponse) ProtoMessage()    {}
+func (*PollMutableStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{31}
 }
-func (m *RecordActivityTaskStartedResponse) XXX_Unmarshal(b []byte) error {
+func (m *PollMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordActivityTaskStartedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PollMutableStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordActivityTaskStartedResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PollMutableStateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1849,197 +1927,148 @@ func (m *RecordActivityTaskStartedResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *RecordActivityTaskStartedResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordActivityTaskStartedResponse.Merge(m, src)
+func (m *PollMutableStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollMutableStateResponse.Merge(m, src)
 }
-func (m *RecordActivityTaskStartedResponse) XXX_Size() int {
+func (m *PollMutableStateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordActivityTaskStartedResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordActivityTaskStartedResponse.DiscardUnknown(m)
+func (m *PollMutableStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollMutableStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordActivityTaskStartedResponse proto.InternalMessageInfo
+var xxx_messageInfo_PollMutableStateResponse proto.InternalMessageInfo
 
-func (m *RecordActivityTaskStartedResponse) GetScheduledEvent() *v1.HistoryEvent {
+func (m *PollMutableStateResponse) GetWorkflowExecution() *v1.WorkflowExecution {
 	if m != nil {
-		return m.ScheduledEvent
+		return m.WorkflowExecution
 	}
 	return nil
 }
 
-func (m *RecordActivityTaskStartedResponse) GetStartedTime() *types.Timestamp {
+func (m *PollMutableStateResponse) GetWorkflowType() *v1.WorkflowType {
 	if m != nil {
-		return m.StartedTime
+		return m.WorkflowType
 	}
 	return nil
 }
 
-func (m *RecordActivityTaskStartedResponse) GetAttempt() int32 {
+func (m *PollMutableStateResponse) GetNextEventId() int64 {
 	if m != nil {
-		return m.Attempt
+		return m.NextEventId
 	}
 	return 0
 }
 
-func (m *RecordActivityTaskStartedResponse) GetScheduledTimeOfThisAttempt() *types.Timestamp {
+func (m *PollMutableStateResponse) GetPreviousStartedEventId() *types.Int64Value {
 	if m != nil {
-		return m.ScheduledTimeOfThisAttempt
+		return m.PreviousStartedEventId
 	}
 	return nil
 }
 
-func (m *RecordActivityTaskStartedResponse) GetHeartbeatDetails() *v1.Payload {
+func (m *PollMutableStateResponse) GetLastFirstEventId() int64 {
 	if m != nil {
-		return m.HeartbeatDetails
+		return m.LastFirstEventId
+	}
+	return 0
+}
+
+func (m *PollMutableStateResponse) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
 	}
 	return nil
 }
 
-func (m *RecordActivityTaskStartedResponse) GetWorkflowType() *v1.WorkflowType {
+func (m *PollMutableStateResponse) GetStickyTaskList() *v1.TaskList {
 	if m != nil {
-		return m.WorkflowType
+		return m.StickyTaskList
 	}
 	return nil
 }
 
-func (m *RecordActivityTaskStartedResponse) GetWorkflowDomain() string {
+func (m *PollMutableStateResponse) GetClientLibraryVersion() string {
 	if m != nil {
-		return m.WorkflowDomain
+		return m.ClientLibraryVersion
 	}
 	return ""
 }
 
-type RespondDecisionTaskCompletedRequest struct {
-	Request              *v1.RespondDecisionTaskCompletedRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	DomainId             string                                  `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
-	XXX_unrecognized     []byte                                  `json:"-"`
-	XXX_sizecache        int32                                   `json:"-"`
+func (m *PollMutableStateResponse) GetClientFeatureVersion() string {
+	if m != nil {
+		return m.ClientFeatureVersion
+	}
+	return ""
 }
 
-func (m *RespondDecisionTaskCompletedRequest) Reset()         { *m = RespondDecisionTaskCompletedRequest{} }
-func (m *RespondDecisionTaskCompletedRequest) String() string { return proto.CompactTextString(m) }
-func (*RespondDecisionTaskCompletedRequest) ProtoMessage()    {}
-func (*RespondDecisionTaskCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{24}
-}
-func (m *RespondDecisionTaskCompletedRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *RespondDecisionTaskCompletedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_RespondDecisionTaskCompletedRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *PollMutableStateResponse) GetClientImpl() string {
+	if m != nil {
+		return m.ClientImpl
 	}
+	return ""
 }
-func (m *RespondDecisionTaskCompletedRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespondDecisionTaskCompletedRequest.Merge(m, src)
-}
-func (m *RespondDecisionTaskCompletedRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *RespondDecisionTaskCompletedRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RespondDecisionTaskCompletedRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_RespondDecisionTaskCompletedRequest proto.InternalMessageInfo
 
-func (m *RespondDecisionTaskCompletedRequest) GetRequest() *v1.RespondDecisionTaskCompletedRequest {
+func (m *PollMutableStateResponse) GetStickyTaskListScheduleToStartTimeout() *types.Duration {
 	if m != nil {
-		return m.Request
+		return m.StickyTaskListScheduleToStartTimeout
 	}
 	return nil
 }
 
-func (m *RespondDecisionTaskCompletedRequest) GetDomainId() string {
+func (m *PollMutableStateResponse) GetCurrentBranchToken() []byte {
 	if m != nil {
-		return m.DomainId
+		return m.CurrentBranchToken
 	}
-	return ""
+	return nil
 }
 
-type RespondDecisionTaskCompletedResponse struct {
-	StartedResponse             *RecordDecisionTaskStartedResponse       `protobuf:"bytes,1,opt,name=started_response,json=startedResponse,proto3" json:"started_response,omitempty"`
-	ActivitiesToDispatchLocally map[string]*v1.ActivityLocalDispatchInfo `protobuf:"bytes,2,rep,name=activities_to_dispatch_locally,json=activitiesToDispatchLocally,proto3" json:"activities_to_dispatch_locally,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral        struct{}                                 `json:"-"`
-	XXX_unrecognized            []byte                                   `json:"-"`
-	XXX_sizecache               int32                                    `json:"-"`
+func (m *PollMutableStateResponse) GetVersionHistories() *v11.VersionHistories {
+	if m != nil {
+		return m.VersionHistories
+	}
+	return nil
 }
 
-func (m *RespondDecisionTaskCompletedResponse) Reset()         { *m = RespondDecisionTaskCompletedResponse{} }
-func (m *RespondDecisionTaskCompletedResponse) String() string { return proto.CompactTextString(m) }
-func (*RespondDecisionTaskCompletedResponse) ProtoMessage()    {}
-func (*RespondDecisionTaskCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{25}
-}
-func (m *RespondDecisionTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *RespondDecisionTaskCompletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_RespondDecisionTaskCompletedResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *PollMutableStateResponse) GetWorkflowState() v11.WorkflowState {
+	if m != nil {
+		return m.WorkflowState
 	}
-}
-func (m *RespondDecisionTaskCompletedResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespondDecisionTaskCompletedResponse.Merge(m, src)
-}
-func (m *RespondDecisionTaskCompletedResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *RespondDecisionTaskCompletedResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RespondDecisionTaskCompletedResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_RespondDecisionTaskCompletedResponse proto.InternalMessageInfo
-
-func (m *RespondDecisionTaskCompletedResponse) GetStartedResponse() *RecordDecisionTaskStartedResponse {
-	if m != nil {
-		return m.StartedResponse
-	}
-	return nil
+	return v11.WorkflowState_WORKFLOW_STATE_INVALID
 }
 
-func (m *RespondDecisionTaskCompletedResponse) GetActivitiesToDispatchLocally() map[string]*v1.ActivityLocalDispatchInfo {
+func (m *PollMutableStateResponse) GetWorkflowCloseState() v1.WorkflowExecutionCloseStatus {
 	if m != nil {
-		return m.ActivitiesToDispatchLocally
+		return m.WorkflowCloseState
 	}
-	return nil
+	return v1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID
 }
 
-type RespondDecisionTaskFailedRequest struct {
-	Request              *v1.RespondDecisionTaskFailedRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	DomainId             string                               `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
-	XXX_unrecognized     []byte                               `json:"-"`
-	XXX_sizecache        int32                                `json:"-"`
+type RecordDecisionTaskStartedRequest struct {
+	DomainId          string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	ScheduleId        int64                 `protobuf:"varint,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	TaskId            int64                 `protobuf:"varint,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Unique id of each poll request. Used to ensure at most once delivery of tasks.
+	RequestId            string                         `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	PollRequest          *v1.PollForDecisionTaskRequest `protobuf:"bytes,6,opt,name=poll_request,json=pollRequest,proto3" json:"poll_request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
-func (m *RespondDecisionTaskFailedRequest) Reset()         { *m = RespondDecisionTaskFailedRequest{} }
-func (m *RespondDecisionTaskFailedRequest) String() string { return proto.CompactTextString(m) }
-func (*RespondDecisionTaskFailedRequest) ProtoMessage()    {}
-func (*RespondDecisionTaskFailedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{26}
+func (m *RecordDecisionTaskStartedRequest) Reset()         { *m = RecordDecisionTaskStartedRequest{} }
+func (m *RecordDecisionTaskStartedRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordDecisionTaskStartedRequest) ProtoMessage()    {}
+func (*RecordDecisionTaskStartedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{32}
 }
-func (m *RespondDecisionTaskFailedRequest) XXX_Unmarshal(b []byte) error {
+func (m *RecordDecisionTaskStartedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RespondDecisionTaskFailedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordDecisionTaskStartedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RespondDecisionTaskFailedRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordDecisionTaskStartedRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2049,91 +2078,94 @@ func (m *RespondDecisionTaskFailedRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *RespondDecisionTaskFailedRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespondDecisionTaskFailedRequest.Merge(m, src)
+func (m *RecordDecisionTaskStartedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordDecisionTaskStartedRequest.Merge(m, src)
 }
-func (m *RespondDecisionTaskFailedRequest) XXX_Size() int {
+func (m *RecordDecisionTaskStartedRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RespondDecisionTaskFailedRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RespondDecisionTaskFailedRequest.DiscardUnknown(m)
+func (m *RecordDecisionTaskStartedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordDecisionTaskStartedRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RespondDecisionTaskFailedRequest proto.InternalMessageInfo
+var xxx_messageInfo_RecordDecisionTaskStartedRequest proto.InternalMessageInfo
 
-func (m *RespondDecisionTaskFailedRequest) GetRequest() *v1.RespondDecisionTaskFailedRequest {
+func (m *RecordDecisionTaskStartedRequest) GetDomainId() string {
 	if m != nil {
-		return m.Request
+		return m.DomainId
 	}
-	return nil
+	return ""
 }
 
-func (m *RespondDecisionTaskFailedRequest) GetDomainId() string {
+func (m *RecordDecisionTaskStartedRequest) GetWorkflowExecution() *v1.WorkflowExecution {
 	if m != nil {
-		return m.DomainId
+		return m.WorkflowExecution
 	}
-	return ""
+	return nil
 }
 
-type RespondDecisionTaskFailedResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *RecordDecisionTaskStartedRequest) GetScheduleId() int64 {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return 0
 }
 
-func (m *RespondDecisionTaskFailedResponse) Reset()         { *m = RespondDecisionTaskFailedResponse{} }
-func (m *RespondDecisionTaskFailedResponse) String() string { return proto.CompactTextString(m) }
-func (*RespondDecisionTaskFailedResponse) ProtoMessage()    {}
-func (*RespondDecisionTaskFailedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{27}
-}
-func (m *RespondDecisionTaskFailedResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *RespondDecisionTaskFailedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_RespondDecisionTaskFailedResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *RecordDecisionTaskStartedRequest) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
 	}
+	return 0
 }
-func (m *RespondDecisionTaskFailedResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespondDecisionTaskFailedResponse.Merge(m, src)
-}
-func (m *RespondDecisionTaskFailedResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *RespondDecisionTaskFailedResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RespondDecisionTaskFailedResponse.DiscardUnknown(m)
+
+func (m *RecordDecisionTaskStartedRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
 }
 
-var xxx_messageInfo_RespondDecisionTaskFailedResponse proto.InternalMessageInfo
+func (m *RecordDecisionTaskStartedRequest) GetPollRequest() *v1.PollForDecisionTaskRequest {
+	if m != nil {
+		return m.PollRequest
+	}
+	return nil
+}
 
-type RecordActivityTaskHeartbeatRequest struct {
-	Request              *v1.RecordActivityTaskHeartbeatRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	DomainId             string                                 `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
-	XXX_unrecognized     []byte                                 `json:"-"`
-	XXX_sizecache        int32                                  `json:"-"`
+type RecordDecisionTaskStartedResponse struct {
+	WorkflowType              *v1.WorkflowType             `protobuf:"bytes,1,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	PreviousStartedEventId    *types.Int64Value            `protobuf:"bytes,2,opt,name=previous_started_event_id,json=previousStartedEventId,proto3" json:"previous_started_event_id,omitempty"`
+	ScheduledEventId          int64                        `protobuf:"varint,3,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
+	StartedEventId            int64                        `protobuf:"varint,4,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
+	NextEventId               int64                        `protobuf:"varint,5,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
+	Attempt                   int32                        `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	StickyExecutionEnabled    bool                         `protobuf:"varint,7,opt,name=sticky_execution_enabled,json=stickyExecutionEnabled,proto3" json:"sticky_execution_enabled,omitempty"`
+	DecisionInfo              *v11.TransientDecisionInfo   `protobuf:"bytes,8,opt,name=decision_info,json=decisionInfo,proto3" json:"decision_info,omitempty"`
+	WorkflowExecutionTaskList *v1.TaskList                 `protobuf:"bytes,9,opt,name=workflow_execution_task_list,json=workflowExecutionTaskList,proto3" json:"workflow_execution_task_list,omitempty"`
+	EventStoreVersion         int32                        `protobuf:"varint,10,opt,name=event_store_version,json=eventStoreVersion,proto3" json:"event_store_version,omitempty"`
+	BranchToken               []byte                       `protobuf:"bytes,11,opt,name=branch_token,json=branchToken,proto3" json:"branch_token,omitempty"`
+	ScheduledTime             *types.Timestamp             `protobuf:"bytes,12,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
+	StartedTime               *types.Timestamp             `protobuf:"bytes,13,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
+	Queries                   map[string]*v1.WorkflowQuery `protobuf:"bytes,14,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	HistorySize               int64                        `protobuf:"varint,15,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
+	ContinueAsNewSuggested    bool                         `protobuf:"varint,16,opt,name=continue_as_new_suggested,json=continueAsNewSuggested,proto3" json:"continue_as_new_suggested,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}                     `json:"-"`
+	XXX_unrecognized          []byte                       `json:"-"`
+	XXX_sizecache             int32                        `json:"-"`
 }
 
-func (m *RecordActivityTaskHeartbeatRequest) Reset()         { *m = RecordActivityTaskHeartbeatRequest{} }
-func (m *RecordActivityTaskHeartbeatRequest) String() string { return proto.CompactTextString(m) }
-func (*RecordActivityTaskHeartbeatRequest) ProtoMessage()    {}
-func (*RecordActivityTaskHeartbeatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{28}
+func (m *RecordDecisionTaskStartedResponse) Reset()         { *m = RecordDecisionTaskStartedResponse{} }
+func (m *RecordDecisionTaskStartedResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordDecisionTaskStartedResponse) ProtoMessage()    {}
+func (*RecordDecisionTaskStartedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{33}
 }
-func (m *RecordActivityTaskHeartbeatRequest) XXX_Unmarshal(b []byte) error {
+func (m *RecordDecisionTaskStartedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordActivityTaskHeartbeatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordDecisionTaskStartedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordActivityTaskHeartbeatRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordDecisionTaskStartedResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2143,152 +2175,155 @@ func (m *RecordActivityTaskHeartbeatRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *RecordActivityTaskHeartbeatRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordActivityTaskHeartbeatRequest.Merge(m, src)
+func (m *RecordDecisionTaskStartedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordDecisionTaskStartedResponse.Merge(m, src)
 }
-func (m *RecordActivityTaskHeartbeatRequest) XXX_Size() int {
+func (m *RecordDecisionTaskStartedResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordActivityTaskHeartbeatRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordActivityTaskHeartbeatRequest.DiscardUnknown(m)
+func (m *RecordDecisionTaskStartedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordDecisionTaskStartedResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordActivityTaskHeartbeatRequest proto.InternalMessageInfo
+var xxx_messageInfo_RecordDecisionTaskStartedResponse proto.InternalMessageInfo
 
-func (m *RecordActivityTaskHeartbeatRequest) GetRequest() *v1.RecordActivityTaskHeartbeatRequest {
+func (m *RecordDecisionTaskStartedResponse) GetWorkflowType() *v1.WorkflowType {
 	if m != nil {
-		return m.Request
+		return m.WorkflowType
 	}
 	return nil
 }
 
-func (m *RecordActivityTaskHeartbeatRequest) GetDomainId() string {
+func (m *RecordDecisionTaskStartedResponse) GetPreviousStartedEventId() *types.Int64Value {
 	if m != nil {
-		return m.DomainId
+		return m.PreviousStartedEventId
 	}
-	return ""
+	return nil
 }
 
-type RecordActivityTaskHeartbeatResponse struct {
-	CancelRequested      bool     `protobuf:"varint,1,opt,name=cancel_requested,json=cancelRequested,proto3" json:"cancel_requested,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *RecordDecisionTaskStartedResponse) GetScheduledEventId() int64 {
+	if m != nil {
+		return m.ScheduledEventId
+	}
+	return 0
 }
 
-func (m *RecordActivityTaskHeartbeatResponse) Reset()         { *m = RecordActivityTaskHeartbeatResponse{} }
-func (m *RecordActivityTaskHeartbeatResponse) String() string { return proto.CompactTextString(m) }
-func (*RecordActivityTaskHeartbeatResponse) ProtoMessage()    {}
-func (*RecordActivityTaskHeartbeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{29}
-}
-func (m *RecordActivityTaskHeartbeatResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *RecordActivityTaskHeartbeatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_RecordActivityTaskHeartbeatResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *RecordDecisionTaskStartedResponse) GetStartedEventId() int64 {
+	if m != nil {
+		return m.StartedEventId
 	}
+	return 0
 }
-func (m *RecordActivityTaskHeartbeatResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordActivityTaskHeartbeatResponse.Merge(m, src)
-}
-func (m *RecordActivityTaskHeartbeatResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *RecordActivityTaskHeartbeatResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordActivityTaskHeartbeatResponse.DiscardUnknown(m)
+
+func (m *RecordDecisionTaskStartedResponse) GetNextEventId() int64 {
+	if m != nil {
+		return m.NextEventId
+	}
+	return 0
 }
 
-var xxx_messageInfo_RecordActivityTaskHeartbeatResponse proto.InternalMessageInfo
+func (m *RecordDecisionTaskStartedResponse) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
 
-func (m *RecordActivityTaskHeartbeatResponse) GetCancelRequested() bool {
+func (m *RecordDecisionTaskStartedResponse) GetStickyExecutionEnabled() bool {
 	if m != nil {
-		return m.CancelRequested
+		return m.StickyExecutionEnabled
 	}
 	return false
 }
 
-type RespondActivityTaskCompletedRequest struct {
-	Request              *v1.RespondActivityTaskCompletedRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	DomainId             string                                  `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
-	XXX_unrecognized     []byte                                  `json:"-"`
-	XXX_sizecache        int32                                   `json:"-"`
+func (m *RecordDecisionTaskStartedResponse) GetDecisionInfo() *v11.TransientDecisionInfo {
+	if m != nil {
+		return m.DecisionInfo
+	}
+	return nil
 }
 
-func (m *RespondActivityTaskCompletedRequest) Reset()         { *m = RespondActivityTaskCompletedRequest{} }
-func (m *RespondActivityTaskCompletedRequest) String() string { return proto.CompactTextString(m) }
-func (*RespondActivityTaskCompletedRequest) ProtoMessage()    {}
-func (*RespondActivityTaskCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{30}
-}
-func (m *RespondActivityTaskCompletedRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *RespondActivityTaskCompletedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_RespondActivityTaskCompletedRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *RecordDecisionTaskStartedResponse) GetWorkflowExecutionTaskList() *v1.TaskList {
+	if m != nil {
+		return m.WorkflowExecutionTaskList
 	}
+	return nil
 }
-func (m *RespondActivityTaskCompletedRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespondActivityTaskCompletedRequest.Merge(m, src)
+
+func (m *RecordDecisionTaskStartedResponse) GetEventStoreVersion() int32 {
+	if m != nil {
+		return m.EventStoreVersion
+	}
+	return 0
 }
-func (m *RespondActivityTaskCompletedRequest) XXX_Size() int {
-	return m.Size()
+
+func (m *RecordDecisionTaskStartedResponse) GetBranchToken() []byte {
+	if m != nil {
+		return m.BranchToken
+	}
+	return nil
 }
-func (m *RespondActivityTaskCompletedRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RespondActivityTaskCompletedRequest.DiscardUnknown(m)
+
+func (m *RecordDecisionTaskStartedResponse) GetScheduledTime() *types.Timestamp {
+	if m != nil {
+		return m.ScheduledTime
+	}
+	return nil
 }
 
-var xxx_messageInfo_RespondActivityTaskCompletedRequest proto.InternalMessageInfo
+func (m *RecordDecisionTaskStartedResponse) GetStartedTime() *types.Timestamp {
+	if m != nil {
+		return m.StartedTime
+	}
+	return nil
+}
 
-func (m *RespondActivityTaskCompletedRequest) GetRequest() *v1.RespondActivityTaskCompletedRequest {
+func (m *RecordDecisionTaskStartedResponse) GetQueries() map[string]*v1.WorkflowQuery {
 	if m != nil {
-		return m.Request
+		return m.Queries
 	}
 	return nil
 }
 
-func (m *RespondActivityTaskCompletedRequest) GetDomainId() string {
+func (m *RecordDecisionTaskStartedResponse) GetHistorySize() int64 {
 	if m != nil {
-		return m.DomainId
+		return m.HistorySize
 	}
-	return ""
+	return 0
 }
 
-type RespondActivityTaskCompletedResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *RecordDecisionTaskStartedResponse) GetContinueAsNewSuggested() bool {
+	if m != nil {
+		return m.ContinueAsNewSuggested
+	}
+	return false
 }
 
-func (m *RespondActivityTaskCompletedResponse) Reset()         { *m = RespondActivityTaskCompletedResponse{} }
-func (m *RespondActivityTaskCompletedResponse) String() string { return proto.CompactTextString(m) }
-func (*RespondActivityTaskCompletedResponse) ProtoMessage()    {}
-func (*RespondActivityTaskCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{31}
+type RecordActivityTaskStartedRequest struct {
+	DomainId          string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	ScheduleId        int64                 `protobuf:"varint,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	TaskId            int64                 `protobuf:"varint,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Unique id of each poll request. Used to ensure at most once delivery of tasks.
+	RequestId            string                         `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	PollRequest          *v1.PollForActivityTaskRequest `protobuf:"bytes,6,opt,name=poll_request,json=pollRequest,proto3" json:"poll_request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
-func (m *RespondActivityTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *RecordActivityTaskStartedRequest) Reset()         { *m = RecordActivityTaskStartedRequest{} }
+func (m *RecordActivityTaskStartedRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordActivityTaskStartedRequest) ProtoMessage()    {}
+func (*RecordActivityTaskStartedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{34}
+}
+func (m *RecordActivityTaskStartedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RespondActivityTaskCompletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordActivityTaskStartedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RespondActivityTaskCompletedResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordActivityTaskStartedRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2298,91 +2333,85 @@ func (m *RespondActivityTaskCompletedResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *RespondActivityTaskCompletedResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespondActivityTaskCompletedResponse.Merge(m, src)
+func (m *RecordActivityTaskStartedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordActivityTaskStartedRequest.Merge(m, src)
 }
-func (m *RespondActivityTaskCompletedResponse) XXX_Size() int {
+func (m *RecordActivityTaskStartedRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RespondActivityTaskCompletedResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RespondActivityTaskCompletedResponse.DiscardUnknown(m)
+func (m *RecordActivityTaskStartedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordActivityTaskStartedRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RespondActivityTaskCompletedResponse proto.InternalMessageInfo
+var xxx_messageInfo_RecordActivityTaskStartedRequest proto.InternalMessageInfo
 
-type RespondActivityTaskFailedRequest struct {
-	Request              *v1.RespondActivityTaskFailedRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	DomainId             string                               `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
-	XXX_unrecognized     []byte                               `json:"-"`
-	XXX_sizecache        int32                                `json:"-"`
+func (m *RecordActivityTaskStartedRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
 }
 
-func (m *RespondActivityTaskFailedRequest) Reset()         { *m = RespondActivityTaskFailedRequest{} }
-func (m *RespondActivityTaskFailedRequest) String() string { return proto.CompactTextString(m) }
-func (*RespondActivityTaskFailedRequest) ProtoMessage()    {}
-func (*RespondActivityTaskFailedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{32}
-}
-func (m *RespondActivityTaskFailedRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *RespondActivityTaskFailedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_RespondActivityTaskFailedRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *RecordActivityTaskStartedRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
 	}
-}
-func (m *RespondActivityTaskFailedRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespondActivityTaskFailedRequest.Merge(m, src)
-}
-func (m *RespondActivityTaskFailedRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *RespondActivityTaskFailedRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RespondActivityTaskFailedRequest.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_RespondActivityTaskFailedRequest proto.InternalMessageInfo
+func (m *RecordActivityTaskStartedRequest) GetScheduleId() int64 {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return 0
+}
 
-func (m *RespondActivityTaskFailedRequest) GetRequest() *v1.RespondActivityTaskFailedRequest {
+func (m *RecordActivityTaskStartedRequest) GetTaskId() int64 {
 	if m != nil {
-		return m.Request
+		return m.TaskId
 	}
-	return nil
+	return 0
 }
 
-func (m *RespondActivityTaskFailedRequest) GetDomainId() string {
+func (m *RecordActivityTaskStartedRequest) GetRequestId() string {
 	if m != nil {
-		return m.DomainId
+		return m.RequestId
 	}
 	return ""
 }
 
-type RespondActivityTaskFailedResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *RecordActivityTaskStartedRequest) GetPollRequest() *v1.PollForActivityTaskRequest {
+	if m != nil {
+		return m.PollRequest
+	}
+	return nil
 }
 
-func (m *RespondActivityTaskFailedResponse) Reset()         { *m = RespondActivityTaskFailedResponse{} }
-func (m *RespondActivityTaskFailedResponse) String() string { return proto.CompactTextString(m) }
-func (*RespondActivityTaskFailedResponse) ProtoMessage()    {}
-func (*RespondActivityTaskFailedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{33}
+type RecordActivityTaskStartedResponse struct {
+	ScheduledEvent             *v1.HistoryEvent `protobuf:"bytes,1,opt,name=scheduled_event,json=scheduledEvent,proto3" json:"scheduled_event,omitempty"`
+	StartedTime                *types.Timestamp `protobuf:"bytes,2,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
+	Attempt                    int32            `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	ScheduledTimeOfThisAttempt *types.Timestamp `protobuf:"bytes,4,opt,name=scheduled_time_of_this_attempt,json=scheduledTimeOfThisAttempt,proto3" json:"scheduled_time_of_this_attempt,omitempty"`
+	HeartbeatDetails           *v1.Payload      `protobuf:"bytes,5,opt,name=heartbeat_details,json=heartbeatDetails,proto3" json:"heartbeat_details,omitempty"`
+	WorkflowType               *v1.WorkflowType `protobuf:"bytes,6,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	WorkflowDomain             string           `protobuf:"bytes,7,opt,name=workflow_domain,json=workflowDomain,proto3" json:"workflow_domain,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}         `json:"-"`
+	XXX_unrecognized           []byte           `json:"-"`
+	XXX_sizecache              int32            `json:"-"`
 }
-func (m *RespondActivityTaskFailedResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *RecordActivityTaskStartedResponse) Reset()         { *m = RecordActivityTaskStartedResponse{} }
+func (m *RecordActivityTaskStartedResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordActivityTaskStartedResponse) ProtoMessage()    {}
+func (*RecordActivityTaskStartedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{35}
+}
+func (m *RecordActivityTaskStartedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RespondActivityTaskFailedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordActivityTaskStartedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RespondActivityTaskFailedResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordActivityTaskStartedResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2392,38 +2421,87 @@ func (m *RespondActivityTaskFailedResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *RespondActivityTaskFailedResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespondActivityTaskFailedResponse.Merge(m, src)
+func (m *RecordActivityTaskStartedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordActivityTaskStartedResponse.Merge(m, src)
 }
-func (m *RespondActivityTaskFailedResponse) XXX_Size() int {
+func (m *RecordActivityTaskStartedResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RespondActivityTaskFailedResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RespondActivityTaskFailedResponse.DiscardUnknown(m)
+func (m *RecordActivityTaskStartedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordActivityTaskStartedResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RespondActivityTaskFailedResponse proto.InternalMessageInfo
+var xxx_messageInfo_RecordActivityTaskStartedResponse proto.InternalMessageInfo
 
-type RespondActivityTaskCanceledRequest struct {
-	Request              *v1.RespondActivityTaskCanceledRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	DomainId             string                                 `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
-	XXX_unrecognized     []byte                                 `json:"-"`
-	XXX_sizecache        int32                                  `json:"-"`
+func (m *RecordActivityTaskStartedResponse) GetScheduledEvent() *v1.HistoryEvent {
+	if m != nil {
+		return m.ScheduledEvent
+	}
+	return nil
 }
 
-func (m *RespondActivityTaskCanceledRequest) Reset()         { *m = RespondActivityTaskCanceledRequest{} }
-func (m *RespondActivityTaskCanceledRequest) String() string { return proto.CompactTextString(m) }
-func (*RespondActivityTaskCanceledRequest) ProtoMessage()    {}
-func (*RespondActivityTaskCanceledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{34}
-}
-func (m *RespondActivityTaskCanceledRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *RecordActivityTaskStartedResponse) GetStartedTime() *types.Timestamp {
+	if m != nil {
+		return m.StartedTime
+	}
+	return nil
 }
-func (m *RespondActivityTaskCanceledRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+
+func (m *RecordActivityTaskStartedResponse) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *RecordActivityTaskStartedResponse) GetScheduledTimeOfThisAttempt() *types.Timestamp {
+	if m != nil {
+		return m.ScheduledTimeOfThisAttempt
+	}
+	return nil
+}
+
+func (m *RecordActivityTaskStartedResponse) GetHeartbeatDetails() *v1.Payload {
+	if m != nil {
+		return m.HeartbeatDetails
+	}
+	return nil
+}
+
+func (m *RecordActivityTaskStartedResponse) GetWorkflowType() *v1.WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
+func (m *RecordActivityTaskStartedResponse) GetWorkflowDomain() string {
+	if m != nil {
+		return m.WorkflowDomain
+	}
+	return ""
+}
+
+type RespondDecisionTaskCompletedRequest struct {
+	Request              *v1.RespondDecisionTaskCompletedRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                                  `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
+}
+
+func (m *RespondDecisionTaskCompletedRequest) Reset()         { *m = RespondDecisionTaskCompletedRequest{} }
+func (m *RespondDecisionTaskCompletedRequest) String() string { return proto.CompactTextString(m) }
+func (*RespondDecisionTaskCompletedRequest) ProtoMessage()    {}
+func (*RespondDecisionTaskCompletedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{36}
+}
+func (m *RespondDecisionTaskCompletedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondDecisionTaskCompletedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RespondActivityTaskCanceledRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RespondDecisionTaskCompletedRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2433,50 +2511,52 @@ func (m *RespondActivityTaskCanceledRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *RespondActivityTaskCanceledRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespondActivityTaskCanceledRequest.Merge(m, src)
+func (m *RespondDecisionTaskCompletedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondDecisionTaskCompletedRequest.Merge(m, src)
 }
-func (m *RespondActivityTaskCanceledRequest) XXX_Size() int {
+func (m *RespondDecisionTaskCompletedRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RespondActivityTaskCanceledRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RespondActivityTaskCanceledRequest.DiscardUnknown(m)
+func (m *RespondDecisionTaskCompletedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondDecisionTaskCompletedRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RespondActivityTaskCanceledRequest proto.InternalMessageInfo
+var xxx_messageInfo_RespondDecisionTaskCompletedRequest proto.InternalMessageInfo
 
-func (m *RespondActivityTaskCanceledRequest) GetRequest() *v1.RespondActivityTaskCanceledRequest {
+func (m *RespondDecisionTaskCompletedRequest) GetRequest() *v1.RespondDecisionTaskCompletedRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-func (m *RespondActivityTaskCanceledRequest) GetDomainId() string {
+func (m *RespondDecisionTaskCompletedRequest) GetDomainId() string {
 	if m != nil {
 		return m.DomainId
 	}
 	return ""
 }
 
-type RespondActivityTaskCanceledResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type RespondDecisionTaskCompletedResponse struct {
+	StartedResponse             *RecordDecisionTaskStartedResponse       `protobuf:"bytes,1,opt,name=started_response,json=startedResponse,proto3" json:"started_response,omitempty"`
+	ActivitiesToDispatchLocally map[string]*v1.ActivityLocalDispatchInfo `protobuf:"bytes,2,rep,name=activities_to_dispatch_locally,json=activitiesToDispatchLocally,proto3" json:"activities_to_dispatch_locally,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral        struct{}                                 `json:"-"`
+	XXX_unrecognized            []byte                                   `json:"-"`
+	XXX_sizecache               int32                                    `json:"-"`
 }
 
-func (m *RespondActivityTaskCanceledResponse) Reset()         { *m = RespondActivityTaskCanceledResponse{} }
-func (m *RespondActivityTaskCanceledResponse) String() string { return proto.CompactTextString(m) }
-func (*RespondActivityTaskCanceledResponse) ProtoMessage()    {}
-func (*RespondActivityTaskCanceledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{35}
+func (m *RespondDecisionTaskCompletedResponse) Reset()         { *m = RespondDecisionTaskCompletedResponse{} }
+func (m *RespondDecisionTaskCompletedResponse) String() string { return proto.CompactTextString(m) }
+func (*RespondDecisionTaskCompletedResponse) ProtoMessage()    {}
+func (*RespondDecisionTaskCompletedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{37}
 }
-func (m *RespondActivityTaskCanceledResponse) XXX_Unmarshal(b []byte) error {
+func (m *RespondDecisionTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RespondActivityTaskCanceledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RespondDecisionTaskCompletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RespondActivityTaskCanceledResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RespondDecisionTaskCompletedResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2486,39 +2566,52 @@ func (m *RespondActivityTaskCanceledResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *RespondActivityTaskCanceledResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespondActivityTaskCanceledResponse.Merge(m, src)
+func (m *RespondDecisionTaskCompletedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondDecisionTaskCompletedResponse.Merge(m, src)
 }
-func (m *RespondActivityTaskCanceledResponse) XXX_Size() int {
+func (m *RespondDecisionTaskCompletedResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RespondActivityTaskCanceledResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RespondActivityTaskCanceledResponse.DiscardUnknown(m)
+func (m *RespondDecisionTaskCompletedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondDecisionTaskCompletedResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RespondActivityTaskCanceledResponse proto.InternalMessageInfo
+var xxx_messageInfo_RespondDecisionTaskCompletedResponse proto.InternalMessageInfo
 
-type RemoveSignalMutableStateRequest struct {
-	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	RequestId            string                `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+func (m *RespondDecisionTaskCompletedResponse) GetStartedResponse() *RecordDecisionTaskStartedResponse {
+	if m != nil {
+		return m.StartedResponse
+	}
+	return nil
 }
 
-func (m *RemoveSignalMutableStateRequest) Reset()         { *m = RemoveSignalMutableStateRequest{} }
-func (m *RemoveSignalMutableStateRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveSignalMutableStateRequest) ProtoMessage()    {}
-func (*RemoveSignalMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{36}
+func (m *RespondDecisionTaskCompletedResponse) GetActivitiesToDispatchLocally() map[string]*v1.ActivityLocalDispatchInfo {
+	if m != nil {
+		return m.ActivitiesToDispatchLocally
+	}
+	return nil
 }
-func (m *RemoveSignalMutableStateRequest) XXX_Unmarshal(b []byte) error {
+
+type RespondDecisionTaskFailedRequest struct {
+	Request              *v1.RespondDecisionTaskFailedRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                               `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
+}
+
+func (m *RespondDecisionTaskFailedRequest) Reset()         { *m = RespondDecisionTaskFailedRequest{} }
+func (m *RespondDecisionTaskFailedRequest) String() string { return proto.CompactTextString(m) }
+func (*RespondDecisionTaskFailedRequest) ProtoMessage()    {}
+func (*RespondDecisionTaskFailedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{38}
+}
+func (m *RespondDecisionTaskFailedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveSignalMutableStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RespondDecisionTaskFailedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveSignalMutableStateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RespondDecisionTaskFailedRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2528,57 +2621,50 @@ func (m *RemoveSignalMutableStateRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *RemoveSignalMutableStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveSignalMutableStateRequest.Merge(m, src)
+func (m *RespondDecisionTaskFailedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondDecisionTaskFailedRequest.Merge(m, src)
 }
-func (m *RemoveSignalMutableStateRequest) XXX_Size() int {
+func (m *RespondDecisionTaskFailedRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveSignalMutableStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveSignalMutableStateRequest.DiscardUnknown(m)
+func (m *RespondDecisionTaskFailedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondDecisionTaskFailedRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveSignalMutableStateRequest proto.InternalMessageInfo
-
-func (m *RemoveSignalMutableStateRequest) GetDomainId() string {
-	if m != nil {
-		return m.DomainId
-	}
-	return ""
-}
+var xxx_messageInfo_RespondDecisionTaskFailedRequest proto.InternalMessageInfo
 
-func (m *RemoveSignalMutableStateRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+func (m *RespondDecisionTaskFailedRequest) GetRequest() *v1.RespondDecisionTaskFailedRequest {
 	if m != nil {
-		return m.WorkflowExecution
+		return m.Request
 	}
 	return nil
 }
 
-func (m *RemoveSignalMutableStateRequest) GetRequestId() string {
+func (m *RespondDecisionTaskFailedRequest) GetDomainId() string {
 	if m != nil {
-		return m.RequestId
+		return m.DomainId
 	}
 	return ""
 }
 
-type RemoveSignalMutableStateResponse struct {
+type RespondDecisionTaskFailedResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveSignalMutableStateResponse) Reset()         { *m = RemoveSignalMutableStateResponse{} }
-func (m *RemoveSignalMutableStateResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveSignalMutableStateResponse) ProtoMessage()    {}
-func (*RemoveSignalMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{37}
+func (m *RespondDecisionTaskFailedResponse) Reset()         { *m = RespondDecisionTaskFailedResponse{} }
+func (m *RespondDecisionTaskFailedResponse) String() string { return proto.CompactTextString(m) }
+func (*RespondDecisionTaskFailedResponse) ProtoMessage()    {}
+func (*RespondDecisionTaskFailedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{39}
 }
-func (m *RemoveSignalMutableStateResponse) XXX_Unmarshal(b []byte) error {
+func (m *RespondDecisionTaskFailedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveSignalMutableStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RespondDecisionTaskFailedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveSignalMutableStateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RespondDecisionTaskFailedResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2588,43 +2674,38 @@ func (m *RemoveSignalMutableStateResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *RemoveSignalMutableStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveSignalMutableStateResponse.Merge(m, src)
+func (m *RespondDecisionTaskFailedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondDecisionTaskFailedResponse.Merge(m, src)
 }
-func (m *RemoveSignalMutableStateResponse) XXX_Size() int {
+func (m *RespondDecisionTaskFailedResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveSignalMutableStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveSignalMutableStateResponse.DiscardUnknown(m)
+func (m *RespondDecisionTaskFailedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondDecisionTaskFailedResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveSignalMutableStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_RespondDecisionTaskFailedResponse proto.InternalMessageInfo
 
-type RequestCancelWorkflowExecutionRequest struct {
-	DomainId      string                                    `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	CancelRequest *v1.RequestCancelWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=cancel_request,json=cancelRequest,proto3" json:"cancel_request,omitempty"`
-	// workflow execution that requests this cancellation, for making sure
-	// the workflow being cancelled is actually a child of the workflow
-	// making the request
-	ExternalExecutionInfo *v1.ExternalExecutionInfo `protobuf:"bytes,3,opt,name=external_execution_info,json=externalExecutionInfo,proto3" json:"external_execution_info,omitempty"`
-	ChildWorkflowOnly     bool                      `protobuf:"varint,4,opt,name=child_workflow_only,json=childWorkflowOnly,proto3" json:"child_workflow_only,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}                  `json:"-"`
-	XXX_unrecognized      []byte                    `json:"-"`
-	XXX_sizecache         int32                     `json:"-"`
+type RecordActivityTaskHeartbeatRequest struct {
+	Request              *v1.RecordActivityTaskHeartbeatRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                                 `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) Reset()         { *m = RequestCancelWorkflowExecutionRequest{} }
-func (m *RequestCancelWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
-func (*RequestCancelWorkflowExecutionRequest) ProtoMessage()    {}
-func (*RequestCancelWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{38}
+func (m *RecordActivityTaskHeartbeatRequest) Reset()         { *m = RecordActivityTaskHeartbeatRequest{} }
+func (m *RecordActivityTaskHeartbeatRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordActivityTaskHeartbeatRequest) ProtoMessage()    {}
+func (*RecordActivityTaskHeartbeatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{40}
 }
-func (m *RequestCancelWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+func (m *RecordActivityTaskHeartbeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RequestCancelWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordActivityTaskHeartbeatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RequestCancelWorkflowExecutionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordActivityTaskHeartbeatRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2634,66 +2715,51 @@ func (m *RequestCancelWorkflowExecutionRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *RequestCancelWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RequestCancelWorkflowExecutionRequest.Merge(m, src)
+func (m *RecordActivityTaskHeartbeatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordActivityTaskHeartbeatRequest.Merge(m, src)
 }
-func (m *RequestCancelWorkflowExecutionRequest) XXX_Size() int {
+func (m *RecordActivityTaskHeartbeatRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RequestCancelWorkflowExecutionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RequestCancelWorkflowExecutionRequest.DiscardUnknown(m)
+func (m *RecordActivityTaskHeartbeatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordActivityTaskHeartbeatRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RequestCancelWorkflowExecutionRequest proto.InternalMessageInfo
+var xxx_messageInfo_RecordActivityTaskHeartbeatRequest proto.InternalMessageInfo
 
-func (m *RequestCancelWorkflowExecutionRequest) GetDomainId() string {
-	if m != nil {
-		return m.DomainId
-	}
-	return ""
-}
-
-func (m *RequestCancelWorkflowExecutionRequest) GetCancelRequest() *v1.RequestCancelWorkflowExecutionRequest {
-	if m != nil {
-		return m.CancelRequest
-	}
-	return nil
-}
-
-func (m *RequestCancelWorkflowExecutionRequest) GetExternalExecutionInfo() *v1.ExternalExecutionInfo {
+func (m *RecordActivityTaskHeartbeatRequest) GetRequest() *v1.RecordActivityTaskHeartbeatRequest {
 	if m != nil {
-		return m.ExternalExecutionInfo
+		return m.Request
 	}
 	return nil
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) GetChildWorkflowOnly() bool {
+func (m *RecordActivityTaskHeartbeatRequest) GetDomainId() string {
 	if m != nil {
-		return m.ChildWorkflowOnly
+		return m.DomainId
 	}
-	return false
+	return ""
 }
 
-type RequestCancelWorkflowExecutionResponse struct {
+type RecordActivityTaskHeartbeatResponse struct {
+	CancelRequested      bool     `protobuf:"varint,1,opt,name=cancel_requested,json=cancelRequested,proto3" json:"cancel_requested,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) Reset() {
-	*m = RequestCancelWorkflowExecutionResponse{}
-}
-func (m *RequestCancelWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
-func (*RequestCancelWorkflowExecutionResponse) ProtoMessage()    {}
-func (*RequestCancelWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{39}
+func (m *RecordActivityTaskHeartbeatResponse) Reset()         { *m = RecordActivityTaskHeartbeatResponse{} }
+func (m *RecordActivityTaskHeartbeatResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordActivityTaskHeartbeatResponse) ProtoMessage()    {}
+func (*RecordActivityTaskHeartbeatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{41}
 }
-func (m *RequestCancelWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+func (m *RecordActivityTaskHeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RequestCancelWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordActivityTaskHeartbeatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RequestCancelWorkflowExecutionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordActivityTaskHeartbeatResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2703,39 +2769,45 @@ func (m *RequestCancelWorkflowExecutionResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *RequestCancelWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RequestCancelWorkflowExecutionResponse.Merge(m, src)
+func (m *RecordActivityTaskHeartbeatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordActivityTaskHeartbeatResponse.Merge(m, src)
 }
-func (m *RequestCancelWorkflowExecutionResponse) XXX_Size() int {
+func (m *RecordActivityTaskHeartbeatResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RequestCancelWorkflowExecutionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RequestCancelWorkflowExecutionResponse.DiscardUnknown(m)
+func (m *RecordActivityTaskHeartbeatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordActivityTaskHeartbeatResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RequestCancelWorkflowExecutionResponse proto.InternalMessageInfo
+var xxx_messageInfo_RecordActivityTaskHeartbeatResponse proto.InternalMessageInfo
 
-type ScheduleDecisionTaskRequest struct {
-	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	IsFirstDecision      bool                  `protobuf:"varint,3,opt,name=is_first_decision,json=isFirstDecision,proto3" json:"is_first_decision,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+func (m *RecordActivityTaskHeartbeatResponse) GetCancelRequested() bool {
+	if m != nil {
+		return m.CancelRequested
+	}
+	return false
 }
 
-func (m *ScheduleDecisionTaskRequest) Reset()         { *m = ScheduleDecisionTaskRequest{} }
-func (m *ScheduleDecisionTaskRequest) String() string { return proto.CompactTextString(m) }
-func (*ScheduleDecisionTaskRequest) ProtoMessage()    {}
-func (*ScheduleDecisionTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{40}
+type RespondActivityTaskCompletedRequest struct {
+	Request              *v1.RespondActivityTaskCompletedRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                                  `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
 }
-func (m *ScheduleDecisionTaskRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *RespondActivityTaskCompletedRequest) Reset()         { *m = RespondActivityTaskCompletedRequest{} }
+func (m *RespondActivityTaskCompletedRequest) String() string { return proto.CompactTextString(m) }
+func (*RespondActivityTaskCompletedRequest) ProtoMessage()    {}
+func (*RespondActivityTaskCompletedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{42}
+}
+func (m *RespondActivityTaskCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ScheduleDecisionTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RespondActivityTaskCompletedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ScheduleDecisionTaskRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RespondActivityTaskCompletedRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2745,57 +2817,50 @@ func (m *ScheduleDecisionTaskRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ScheduleDecisionTaskRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScheduleDecisionTaskRequest.Merge(m, src)
+func (m *RespondActivityTaskCompletedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskCompletedRequest.Merge(m, src)
 }
-func (m *ScheduleDecisionTaskRequest) XXX_Size() int {
+func (m *RespondActivityTaskCompletedRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ScheduleDecisionTaskRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScheduleDecisionTaskRequest.DiscardUnknown(m)
+func (m *RespondActivityTaskCompletedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskCompletedRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScheduleDecisionTaskRequest proto.InternalMessageInfo
-
-func (m *ScheduleDecisionTaskRequest) GetDomainId() string {
-	if m != nil {
-		return m.DomainId
-	}
-	return ""
-}
+var xxx_messageInfo_RespondActivityTaskCompletedRequest proto.InternalMessageInfo
 
-func (m *ScheduleDecisionTaskRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+func (m *RespondActivityTaskCompletedRequest) GetRequest() *v1.RespondActivityTaskCompletedRequest {
 	if m != nil {
-		return m.WorkflowExecution
+		return m.Request
 	}
 	return nil
 }
 
-func (m *ScheduleDecisionTaskRequest) GetIsFirstDecision() bool {
+func (m *RespondActivityTaskCompletedRequest) GetDomainId() string {
 	if m != nil {
-		return m.IsFirstDecision
+		return m.DomainId
 	}
-	return false
+	return ""
 }
 
-type ScheduleDecisionTaskResponse struct {
+type RespondActivityTaskCompletedResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ScheduleDecisionTaskResponse) Reset()         { *m = ScheduleDecisionTaskResponse{} }
-func (m *ScheduleDecisionTaskResponse) String() string { return proto.CompactTextString(m) }
-func (*ScheduleDecisionTaskResponse) ProtoMessage()    {}
-func (*ScheduleDecisionTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{41}
+func (m *RespondActivityTaskCompletedResponse) Reset()         { *m = RespondActivityTaskCompletedResponse{} }
+func (m *RespondActivityTaskCompletedResponse) String() string { return proto.CompactTextString(m) }
+func (*RespondActivityTaskCompletedResponse) ProtoMessage()    {}
+func (*RespondActivityTaskCompletedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{43}
 }
-func (m *ScheduleDecisionTaskResponse) XXX_Unmarshal(b []byte) error {
+func (m *RespondActivityTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ScheduleDecisionTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RespondActivityTaskCompletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ScheduleDecisionTaskResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RespondActivityTaskCompletedResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2805,47 +2870,38 @@ func (m *ScheduleDecisionTaskResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ScheduleDecisionTaskResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScheduleDecisionTaskResponse.Merge(m, src)
+func (m *RespondActivityTaskCompletedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskCompletedResponse.Merge(m, src)
 }
-func (m *ScheduleDecisionTaskResponse) XXX_Size() int {
+func (m *RespondActivityTaskCompletedResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ScheduleDecisionTaskResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScheduleDecisionTaskResponse.DiscardUnknown(m)
+func (m *RespondActivityTaskCompletedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskCompletedResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScheduleDecisionTaskResponse proto.InternalMessageInfo
+var xxx_messageInfo_RespondActivityTaskCompletedResponse proto.InternalMessageInfo
 
-// RecordChildExecutionCompletedRequest is used for reporting the completion of child execution to parent workflow
-// execution which started it.  When a child execution is completed it creates this request and calls the
-// RecordChildExecutionCompleted API with the workflowExecution of parent.  It also sets the completedExecution of the
-// child as it could potentially be different than the ChildExecutionStartedEvent of parent in the situation when
-// child creates multiple runs through ContinueAsNew before finally completing.
-type RecordChildExecutionCompletedRequest struct {
-	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	InitiatedId          int64                 `protobuf:"varint,3,opt,name=initiated_id,json=initiatedId,proto3" json:"initiated_id,omitempty"`
-	CompletedExecution   *v1.WorkflowExecution `protobuf:"bytes,4,opt,name=completed_execution,json=completedExecution,proto3" json:"completed_execution,omitempty"`
-	CompletionEvent      *v1.HistoryEvent      `protobuf:"bytes,5,opt,name=completion_event,json=completionEvent,proto3" json:"completion_event,omitempty"`
-	StartedId            int64                 `protobuf:"varint,6,opt,name=started_id,json=startedId,proto3" json:"started_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type RespondActivityTaskFailedRequest struct {
+	Request              *v1.RespondActivityTaskFailedRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                               `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
 }
 
-func (m *RecordChildExecutionCompletedRequest) Reset()         { *m = RecordChildExecutionCompletedRequest{} }
-func (m *RecordChildExecutionCompletedRequest) String() string { return proto.CompactTextString(m) }
-func (*RecordChildExecutionCompletedRequest) ProtoMessage()    {}
-func (*RecordChildExecutionCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{42}
+func (m *RespondActivityTaskFailedRequest) Reset()         { *m = RespondActivityTaskFailedRequest{} }
+func (m *RespondActivityTaskFailedRequest) String() string { return proto.CompactTextString(m) }
+func (*RespondActivityTaskFailedRequest) ProtoMessage()    {}
+func (*RespondActivityTaskFailedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{44}
 }
-func (m *RecordChildExecutionCompletedRequest) XXX_Unmarshal(b []byte) error {
+func (m *RespondActivityTaskFailedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordChildExecutionCompletedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RespondActivityTaskFailedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordChildExecutionCompletedRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RespondActivityTaskFailedRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2855,78 +2911,50 @@ func (m *RecordChildExecutionCompletedRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *RecordChildExecutionCompletedRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordChildExecutionCompletedRequest.Merge(m, src)
+func (m *RespondActivityTaskFailedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskFailedRequest.Merge(m, src)
 }
-func (m *RecordChildExecutionCompletedRequest) XXX_Size() int {
+func (m *RespondActivityTaskFailedRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordChildExecutionCompletedRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordChildExecutionCompletedRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_RecordChildExecutionCompletedRequest proto.InternalMessageInfo
-
-func (m *RecordChildExecutionCompletedRequest) GetDomainId() string {
-	if m != nil {
-		return m.DomainId
-	}
-	return ""
-}
-
-func (m *RecordChildExecutionCompletedRequest) GetWorkflowExecution() *v1.WorkflowExecution {
-	if m != nil {
-		return m.WorkflowExecution
-	}
-	return nil
-}
-
-func (m *RecordChildExecutionCompletedRequest) GetInitiatedId() int64 {
-	if m != nil {
-		return m.InitiatedId
-	}
-	return 0
+func (m *RespondActivityTaskFailedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskFailedRequest.DiscardUnknown(m)
 }
 
-func (m *RecordChildExecutionCompletedRequest) GetCompletedExecution() *v1.WorkflowExecution {
-	if m != nil {
-		return m.CompletedExecution
-	}
-	return nil
-}
+var xxx_messageInfo_RespondActivityTaskFailedRequest proto.InternalMessageInfo
 
-func (m *RecordChildExecutionCompletedRequest) GetCompletionEvent() *v1.HistoryEvent {
+func (m *RespondActivityTaskFailedRequest) GetRequest() *v1.RespondActivityTaskFailedRequest {
 	if m != nil {
-		return m.CompletionEvent
+		return m.Request
 	}
 	return nil
 }
 
-func (m *RecordChildExecutionCompletedRequest) GetStartedId() int64 {
+func (m *RespondActivityTaskFailedRequest) GetDomainId() string {
 	if m != nil {
-		return m.StartedId
+		return m.DomainId
 	}
-	return 0
+	return ""
 }
 
-type RecordChildExecutionCompletedResponse struct {
+type RespondActivityTaskFailedResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RecordChildExecutionCompletedResponse) Reset()         { *m = RecordChildExecutionCompletedResponse{} }
-func (m *RecordChildExecutionCompletedResponse) String() string { return proto.CompactTextString(m) }
-func (*RecordChildExecutionCompletedResponse) ProtoMessage()    {}
-func (*RecordChildExecutionCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{43}
+func (m *RespondActivityTaskFailedResponse) Reset()         { *m = RespondActivityTaskFailedResponse{} }
+func (m *RespondActivityTaskFailedResponse) String() string { return proto.CompactTextString(m) }
+func (*RespondActivityTaskFailedResponse) ProtoMessage()    {}
+func (*RespondActivityTaskFailedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{45}
 }
-func (m *RecordChildExecutionCompletedResponse) XXX_Unmarshal(b []byte) error {
+func (m *RespondActivityTaskFailedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordChildExecutionCompletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RespondActivityTaskFailedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordChildExecutionCompletedResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RespondActivityTaskFailedResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2936,42 +2964,38 @@ func (m *RecordChildExecutionCompletedResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *RecordChildExecutionCompletedResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordChildExecutionCompletedResponse.Merge(m, src)
+func (m *RespondActivityTaskFailedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskFailedResponse.Merge(m, src)
 }
-func (m *RecordChildExecutionCompletedResponse) XXX_Size() int {
+func (m *RespondActivityTaskFailedResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordChildExecutionCompletedResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordChildExecutionCompletedResponse.DiscardUnknown(m)
+func (m *RespondActivityTaskFailedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskFailedResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordChildExecutionCompletedResponse proto.InternalMessageInfo
+var xxx_messageInfo_RespondActivityTaskFailedResponse proto.InternalMessageInfo
 
-type ReplicateEventsV2Request struct {
-	DomainId            string                    `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	WorkflowExecution   *v1.WorkflowExecution     `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	VersionHistoryItems []*v12.VersionHistoryItem `protobuf:"bytes,3,rep,name=version_history_items,json=versionHistoryItems,proto3" json:"version_history_items,omitempty"`
-	Events              *v1.DataBlob              `protobuf:"bytes,4,opt,name=events,proto3" json:"events,omitempty"`
-	// New run events does not need version history since there is no prior events.
-	NewRunEvents         *v1.DataBlob `protobuf:"bytes,5,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type RespondActivityTaskCanceledRequest struct {
+	Request              *v1.RespondActivityTaskCanceledRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                                 `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
 }
 
-func (m *ReplicateEventsV2Request) Reset()         { *m = ReplicateEventsV2Request{} }
-func (m *ReplicateEventsV2Request) String() string { return proto.CompactTextString(m) }
-func (*ReplicateEventsV2Request) ProtoMessage()    {}
-func (*ReplicateEventsV2Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{44}
+func (m *RespondActivityTaskCanceledRequest) Reset()         { *m = RespondActivityTaskCanceledRequest{} }
+func (m *RespondActivityTaskCanceledRequest) String() string { return proto.CompactTextString(m) }
+func (*RespondActivityTaskCanceledRequest) ProtoMessage()    {}
+func (*RespondActivityTaskCanceledRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{46}
 }
-func (m *ReplicateEventsV2Request) XXX_Unmarshal(b []byte) error {
+func (m *RespondActivityTaskCanceledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReplicateEventsV2Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RespondActivityTaskCanceledRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReplicateEventsV2Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RespondActivityTaskCanceledRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2981,71 +3005,50 @@ func (m *ReplicateEventsV2Request) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ReplicateEventsV2Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplicateEventsV2Request.Merge(m, src)
+func (m *RespondActivityTaskCanceledRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskCanceledRequest.Merge(m, src)
 }
-func (m *ReplicateEventsV2Request) XXX_Size() int {
+func (m *RespondActivityTaskCanceledRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReplicateEventsV2Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplicateEventsV2Request.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ReplicateEventsV2Request proto.InternalMessageInfo
-
-func (m *ReplicateEventsV2Request) GetDomainId() string {
-	if m != nil {
-		return m.DomainId
-	}
-	return ""
-}
-
-func (m *ReplicateEventsV2Request) GetWorkflowExecution() *v1.WorkflowExecution {
-	if m != nil {
-		return m.WorkflowExecution
-	}
-	return nil
+func (m *RespondActivityTaskCanceledRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskCanceledRequest.DiscardUnknown(m)
 }
 
-func (m *ReplicateEventsV2Request) GetVersionHistoryItems() []*v12.VersionHistoryItem {
-	if m != nil {
-		return m.VersionHistoryItems
-	}
-	return nil
-}
+var xxx_messageInfo_RespondActivityTaskCanceledRequest proto.InternalMessageInfo
 
-func (m *ReplicateEventsV2Request) GetEvents() *v1.DataBlob {
+func (m *RespondActivityTaskCanceledRequest) GetRequest() *v1.RespondActivityTaskCanceledRequest {
 	if m != nil {
-		return m.Events
+		return m.Request
 	}
 	return nil
 }
 
-func (m *ReplicateEventsV2Request) GetNewRunEvents() *v1.DataBlob {
+func (m *RespondActivityTaskCanceledRequest) GetDomainId() string {
 	if m != nil {
-		return m.NewRunEvents
+		return m.DomainId
 	}
-	return nil
+	return ""
 }
 
-type ReplicateEventsV2Response struct {
+type RespondActivityTaskCanceledResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReplicateEventsV2Response) Reset()         { *m = ReplicateEventsV2Response{} }
-func (m *ReplicateEventsV2Response) String() string { return proto.CompactTextString(m) }
-func (*ReplicateEventsV2Response) ProtoMessage()    {}
-func (*ReplicateEventsV2Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{45}
+func (m *RespondActivityTaskCanceledResponse) Reset()         { *m = RespondActivityTaskCanceledResponse{} }
+func (m *RespondActivityTaskCanceledResponse) String() string { return proto.CompactTextString(m) }
+func (*RespondActivityTaskCanceledResponse) ProtoMessage()    {}
+func (*RespondActivityTaskCanceledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{47}
 }
-func (m *ReplicateEventsV2Response) XXX_Unmarshal(b []byte) error {
+func (m *RespondActivityTaskCanceledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReplicateEventsV2Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RespondActivityTaskCanceledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReplicateEventsV2Response.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RespondActivityTaskCanceledResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3055,39 +3058,39 @@ func (m *ReplicateEventsV2Response) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ReplicateEventsV2Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplicateEventsV2Response.Merge(m, src)
+func (m *RespondActivityTaskCanceledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskCanceledResponse.Merge(m, src)
 }
-func (m *ReplicateEventsV2Response) XXX_Size() int {
+func (m *RespondActivityTaskCanceledResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReplicateEventsV2Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplicateEventsV2Response.DiscardUnknown(m)
+func (m *RespondActivityTaskCanceledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskCanceledResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplicateEventsV2Response proto.InternalMessageInfo
+var xxx_messageInfo_RespondActivityTaskCanceledResponse proto.InternalMessageInfo
 
-type SyncShardStatusRequest struct {
-	SourceCluster        string           `protobuf:"bytes,1,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
-	ShardId              int32            `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	Time                 *types.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type RemoveSignalMutableStateRequest struct {
+	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	RequestId            string                `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *SyncShardStatusRequest) Reset()         { *m = SyncShardStatusRequest{} }
-func (m *SyncShardStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*SyncShardStatusRequest) ProtoMessage()    {}
-func (*SyncShardStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{46}
+func (m *RemoveSignalMutableStateRequest) Reset()         { *m = RemoveSignalMutableStateRequest{} }
+func (m *RemoveSignalMutableStateRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveSignalMutableStateRequest) ProtoMessage()    {}
+func (*RemoveSignalMutableStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{48}
 }
-func (m *SyncShardStatusRequest) XXX_Unmarshal(b []byte) error {
+func (m *RemoveSignalMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SyncShardStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveSignalMutableStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SyncShardStatusRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveSignalMutableStateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3097,57 +3100,57 @@ func (m *SyncShardStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *SyncShardStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncShardStatusRequest.Merge(m, src)
+func (m *RemoveSignalMutableStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveSignalMutableStateRequest.Merge(m, src)
 }
-func (m *SyncShardStatusRequest) XXX_Size() int {
+func (m *RemoveSignalMutableStateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SyncShardStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SyncShardStatusRequest.DiscardUnknown(m)
+func (m *RemoveSignalMutableStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveSignalMutableStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SyncShardStatusRequest proto.InternalMessageInfo
+var xxx_messageInfo_RemoveSignalMutableStateRequest proto.InternalMessageInfo
 
-func (m *SyncShardStatusRequest) GetSourceCluster() string {
+func (m *RemoveSignalMutableStateRequest) GetDomainId() string {
 	if m != nil {
-		return m.SourceCluster
+		return m.DomainId
 	}
 	return ""
 }
 
-func (m *SyncShardStatusRequest) GetShardId() int32 {
+func (m *RemoveSignalMutableStateRequest) GetWorkflowExecution() *v1.WorkflowExecution {
 	if m != nil {
-		return m.ShardId
+		return m.WorkflowExecution
 	}
-	return 0
+	return nil
 }
 
-func (m *SyncShardStatusRequest) GetTime() *types.Timestamp {
+func (m *RemoveSignalMutableStateRequest) GetRequestId() string {
 	if m != nil {
-		return m.Time
+		return m.RequestId
 	}
-	return nil
+	return ""
 }
 
-type SyncShardStatusResponse struct {
+type RemoveSignalMutableStateResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SyncShardStatusResponse) Reset()         { *m = SyncShardStatusResponse{} }
-func (m *SyncShardStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*SyncShardStatusResponse) ProtoMessage()    {}
-func (*SyncShardStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{47}
+func (m *RemoveSignalMutableStateResponse) Reset()         { *m = RemoveSignalMutableStateResponse{} }
+func (m *RemoveSignalMutableStateResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveSignalMutableStateResponse) ProtoMessage()    {}
+func (*RemoveSignalMutableStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{49}
 }
-func (m *SyncShardStatusResponse) XXX_Unmarshal(b []byte) error {
+func (m *RemoveSignalMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SyncShardStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveSignalMutableStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SyncShardStatusResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveSignalMutableStateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3157,49 +3160,43 @@ func (m *SyncShardStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *SyncShardStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncShardStatusResponse.Merge(m, src)
+func (m *RemoveSignalMutableStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveSignalMutableStateResponse.Merge(m, src)
 }
-func (m *SyncShardStatusResponse) XXX_Size() int {
+func (m *RemoveSignalMutableStateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *SyncShardStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SyncShardStatusResponse.DiscardUnknown(m)
+func (m *RemoveSignalMutableStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveSignalMutableStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SyncShardStatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_RemoveSignalMutableStateResponse proto.InternalMessageInfo
 
-type SyncActivityRequest struct {
-	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	Version              int64                 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	ScheduledId          int64                 `protobuf:"varint,4,opt,name=scheduled_id,json=scheduledId,proto3" json:"scheduled_id,omitempty"`
-	ScheduledTime        *types.Timestamp      `protobuf:"bytes,5,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
-	StartedId            int64                 `protobuf:"varint,6,opt,name=started_id,json=startedId,proto3" json:"started_id,omitempty"`
-	StartedTime          *types.Timestamp      `protobuf:"bytes,7,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
-	LastHeartbeatTime    *types.Timestamp      `protobuf:"bytes,8,opt,name=last_heartbeat_time,json=lastHeartbeatTime,proto3" json:"last_heartbeat_time,omitempty"`
-	Details              *v1.Payload           `protobuf:"bytes,9,opt,name=details,proto3" json:"details,omitempty"`
-	Attempt              int32                 `protobuf:"varint,10,opt,name=attempt,proto3" json:"attempt,omitempty"`
-	LastFailure          *v1.Failure           `protobuf:"bytes,11,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
-	LastWorkerIdentity   string                `protobuf:"bytes,12,opt,name=last_worker_identity,json=lastWorkerIdentity,proto3" json:"last_worker_identity,omitempty"`
-	VersionHistory       *v12.VersionHistory   `protobuf:"bytes,13,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type RequestCancelWorkflowExecutionRequest struct {
+	DomainId      string                                    `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	CancelRequest *v1.RequestCancelWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=cancel_request,json=cancelRequest,proto3" json:"cancel_request,omitempty"`
+	// workflow execution that requests this cancellation, for making sure
+	// the workflow being cancelled is actually a child of the workflow
+	// making the request
+	ExternalExecutionInfo *v1.ExternalExecutionInfo `protobuf:"bytes,3,opt,name=external_execution_info,json=externalExecutionInfo,proto3" json:"external_execution_info,omitempty"`
+	ChildWorkflowOnly     bool                      `protobuf:"varint,4,opt,name=child_workflow_only,json=childWorkflowOnly,proto3" json:"child_workflow_only,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                  `json:"-"`
+	XXX_unrecognized      []byte                    `json:"-"`
+	XXX_sizecache         int32                     `json:"-"`
 }
 
-func (m *SyncActivityRequest) Reset()         { *m = SyncActivityRequest{} }
-func (m *SyncActivityRequest) String() string { return proto.CompactTextString(m) }
-func (*SyncActivityRequest) ProtoMessage()    {}
-func (*SyncActivityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{48}
+func (m *RequestCancelWorkflowExecutionRequest) Reset()         { *m = RequestCancelWorkflowExecutionRequest{} }
+func (m *RequestCancelWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestCancelWorkflowExecutionRequest) ProtoMessage()    {}
+func (*RequestCancelWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{50}
 }
-func (m *SyncActivityRequest) XXX_Unmarshal(b []byte) error {
+func (m *RequestCancelWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SyncActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RequestCancelWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SyncActivityRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RequestCancelWorkflowExecutionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3209,127 +3206,66 @@ func (m *SyncActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *SyncActivityRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncActivityRequest.Merge(m, src)
+func (m *RequestCancelWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestCancelWorkflowExecutionRequest.Merge(m, src)
 }
-func (m *SyncActivityRequest) XXX_Size() int {
+func (m *RequestCancelWorkflowExecutionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SyncActivityRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SyncActivityRequest.DiscardUnknown(m)
+func (m *RequestCancelWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestCancelWorkflowExecutionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SyncActivityRequest proto.InternalMessageInfo
+var xxx_messageInfo_RequestCancelWorkflowExecutionRequest proto.InternalMessageInfo
 
-func (m *SyncActivityRequest) GetDomainId() string {
+func (m *RequestCancelWorkflowExecutionRequest) GetDomainId() string {
 	if m != nil {
 		return m.DomainId
 	}
 	return ""
 }
 
-func (m *SyncActivityRequest) GetWorkflowExecution() *v1.WorkflowExecution {
-	if m != nil {
-		return m.WorkflowExecution
-	}
-	return nil
-}
-
-func (m *SyncActivityRequest) GetVersion() int64 {
-	if m != nil {
-		return m.Version
-	}
-	return 0
-}
-
-func (m *SyncActivityRequest) GetScheduledId() int64 {
-	if m != nil {
-		return m.ScheduledId
-	}
-	return 0
-}
-
-func (m *SyncActivityRequest) GetScheduledTime() *types.Timestamp {
-	if m != nil {
-		return m.ScheduledTime
-	}
-	return nil
-}
-
-func (m *SyncActivityRequest) GetStartedId() int64 {
-	if m != nil {
-		return m.StartedId
-	}
-	return 0
-}
-
-func (m *SyncActivityRequest) GetStartedTime() *types.Timestamp {
-	if m != nil {
-		return m.StartedTime
-	}
-	return nil
-}
-
-func (m *SyncActivityRequest) GetLastHeartbeatTime() *types.Timestamp {
-	if m != nil {
-		return m.LastHeartbeatTime
-	}
-	return nil
-}
-
-func (m *SyncActivityRequest) GetDetails() *v1.Payload {
+func (m *RequestCancelWorkflowExecutionRequest) GetCancelRequest() *v1.RequestCancelWorkflowExecutionRequest {
 	if m != nil {
-		return m.Details
+		return m.CancelRequest
 	}
 	return nil
 }
 
-func (m *SyncActivityRequest) GetAttempt() int32 {
-	if m != nil {
-		return m.Attempt
-	}
-	return 0
-}
-
-func (m *SyncActivityRequest) GetLastFailure() *v1.Failure {
+func (m *RequestCancelWorkflowExecutionRequest) GetExternalExecutionInfo() *v1.ExternalExecutionInfo {
 	if m != nil {
-		return m.LastFailure
+		return m.ExternalExecutionInfo
 	}
 	return nil
 }
 
-func (m *SyncActivityRequest) GetLastWorkerIdentity() string {
-	if m != nil {
-		return m.LastWorkerIdentity
-	}
-	return ""
-}
-
-func (m *SyncActivityRequest) GetVersionHistory() *v12.VersionHistory {
+func (m *RequestCancelWorkflowExecutionRequest) GetChildWorkflowOnly() bool {
 	if m != nil {
-		return m.VersionHistory
+		return m.ChildWorkflowOnly
 	}
-	return nil
+	return false
 }
 
-type SyncActivityResponse struct {
+type RequestCancelWorkflowExecutionResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SyncActivityResponse) Reset()         { *m = SyncActivityResponse{} }
-func (m *SyncActivityResponse) String() string { return proto.CompactTextString(m) }
-func (*SyncActivityResponse) ProtoMessage()    {}
-func (*SyncActivityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{49}
+func (m *RequestCancelWorkflowExecutionResponse) Reset() {
+	*m = RequestCancelWorkflowExecutionResponse{}
 }
-func (m *SyncActivityResponse) XXX_Unmarshal(b []byte) error {
+func (m *RequestCancelWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestCancelWorkflowExecutionResponse) ProtoMessage()    {}
+func (*RequestCancelWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{51}
+}
+func (m *RequestCancelWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SyncActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RequestCancelWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SyncActivityResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RequestCancelWorkflowExecutionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3339,38 +3275,39 @@ func (m *SyncActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *SyncActivityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncActivityResponse.Merge(m, src)
+func (m *RequestCancelWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestCancelWorkflowExecutionResponse.Merge(m, src)
 }
-func (m *SyncActivityResponse) XXX_Size() int {
+func (m *RequestCancelWorkflowExecutionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *SyncActivityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SyncActivityResponse.DiscardUnknown(m)
+func (m *RequestCancelWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestCancelWorkflowExecutionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SyncActivityResponse proto.InternalMessageInfo
+var xxx_messageInfo_RequestCancelWorkflowExecutionResponse proto.InternalMessageInfo
 
-type DescribeMutableStateRequest struct {
+type ScheduleDecisionTaskRequest struct {
 	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	IsFirstDecision      bool                  `protobuf:"varint,3,opt,name=is_first_decision,json=isFirstDecision,proto3" json:"is_first_decision,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *DescribeMutableStateRequest) Reset()         { *m = DescribeMutableStateRequest{} }
-func (m *DescribeMutableStateRequest) String() string { return proto.CompactTextString(m) }
-func (*DescribeMutableStateRequest) ProtoMessage()    {}
-func (*DescribeMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{50}
+func (m *ScheduleDecisionTaskRequest) Reset()         { *m = ScheduleDecisionTaskRequest{} }
+func (m *ScheduleDecisionTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*ScheduleDecisionTaskRequest) ProtoMessage()    {}
+func (*ScheduleDecisionTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{52}
 }
-func (m *DescribeMutableStateRequest) XXX_Unmarshal(b []byte) error {
+func (m *ScheduleDecisionTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DescribeMutableStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScheduleDecisionTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DescribeMutableStateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScheduleDecisionTaskRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3380,52 +3317,57 @@ func (m *DescribeMutableStateRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *DescribeMutableStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeMutableStateRequest.Merge(m, src)
+func (m *ScheduleDecisionTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleDecisionTaskRequest.Merge(m, src)
 }
-func (m *DescribeMutableStateRequest) XXX_Size() int {
+func (m *ScheduleDecisionTaskRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DescribeMutableStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeMutableStateRequest.DiscardUnknown(m)
+func (m *ScheduleDecisionTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleDecisionTaskRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeMutableStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_ScheduleDecisionTaskRequest proto.InternalMessageInfo
 
-func (m *DescribeMutableStateRequest) GetDomainId() string {
+func (m *ScheduleDecisionTaskRequest) GetDomainId() string {
 	if m != nil {
 		return m.DomainId
 	}
 	return ""
 }
 
-func (m *DescribeMutableStateRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+func (m *ScheduleDecisionTaskRequest) GetWorkflowExecution() *v1.WorkflowExecution {
 	if m != nil {
 		return m.WorkflowExecution
 	}
 	return nil
 }
 
-type DescribeMutableStateResponse struct {
-	MutableStateInCache    string   `protobuf:"bytes,1,opt,name=mutable_state_in_cache,json=mutableStateInCache,proto3" json:"mutable_state_in_cache,omitempty"`
-	MutableStateInDatabase string   `protobuf:"bytes,2,opt,name=mutable_state_in_database,json=mutableStateInDatabase,proto3" json:"mutable_state_in_database,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+func (m *ScheduleDecisionTaskRequest) GetIsFirstDecision() bool {
+	if m != nil {
+		return m.IsFirstDecision
+	}
+	return false
 }
 
-func (m *DescribeMutableStateResponse) Reset()         { *m = DescribeMutableStateResponse{} }
-func (m *DescribeMutableStateResponse) String() string { return proto.CompactTextString(m) }
-func (*DescribeMutableStateResponse) ProtoMessage()    {}
-func (*DescribeMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{51}
+type ScheduleDecisionTaskResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *DescribeMutableStateResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *ScheduleDecisionTaskResponse) Reset()         { *m = ScheduleDecisionTaskResponse{} }
+func (m *ScheduleDecisionTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*ScheduleDecisionTaskResponse) ProtoMessage()    {}
+func (*ScheduleDecisionTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{53}
+}
+func (m *ScheduleDecisionTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DescribeMutableStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScheduleDecisionTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DescribeMutableStateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScheduleDecisionTaskResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3435,50 +3377,47 @@ func (m *DescribeMutableStateResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *DescribeMutableStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeMutableStateResponse.Merge(m, src)
+func (m *ScheduleDecisionTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleDecisionTaskResponse.Merge(m, src)
 }
-func (m *DescribeMutableStateResponse) XXX_Size() int {
+func (m *ScheduleDecisionTaskResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DescribeMutableStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeMutableStateResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DescribeMutableStateResponse proto.InternalMessageInfo
-
-func (m *DescribeMutableStateResponse) GetMutableStateInCache() string {
-	if m != nil {
-		return m.MutableStateInCache
-	}
-	return ""
+func (m *ScheduleDecisionTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleDecisionTaskResponse.DiscardUnknown(m)
 }
 
-func (m *DescribeMutableStateResponse) GetMutableStateInDatabase() string {
-	if m != nil {
-		return m.MutableStateInDatabase
-	}
-	return ""
-}
+var xxx_messageInfo_ScheduleDecisionTaskResponse proto.InternalMessageInfo
 
-type DescribeHistoryHostRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// RecordChildExecutionCompletedRequest is used for reporting the completion of child execution to parent workflow
+// execution which started it.  When a child execution is completed it creates this request and calls the
+// RecordChildExecutionCompleted API with the workflowExecution of parent.  It also sets the completedExecution of the
+// child as it could potentially be different than the ChildExecutionStartedEvent of parent in the situation when
+// child creates multiple runs through ContinueAsNew before finally completing.
+type RecordChildExecutionCompletedRequest struct {
+	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	InitiatedId          int64                 `protobuf:"varint,3,opt,name=initiated_id,json=initiatedId,proto3" json:"initiated_id,omitempty"`
+	CompletedExecution   *v1.WorkflowExecution `protobuf:"bytes,4,opt,name=completed_execution,json=completedExecution,proto3" json:"completed_execution,omitempty"`
+	CompletionEvent      *v1.HistoryEvent      `protobuf:"bytes,5,opt,name=completion_event,json=completionEvent,proto3" json:"completion_event,omitempty"`
+	StartedId            int64                 `protobuf:"varint,6,opt,name=started_id,json=startedId,proto3" json:"started_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *DescribeHistoryHostRequest) Reset()         { *m = DescribeHistoryHostRequest{} }
-func (m *DescribeHistoryHostRequest) String() string { return proto.CompactTextString(m) }
-func (*DescribeHistoryHostRequest) ProtoMessage()    {}
-func (*DescribeHistoryHostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{52}
+func (m *RecordChildExecutionCompletedRequest) Reset()         { *m = RecordChildExecutionCompletedRequest{} }
+func (m *RecordChildExecutionCompletedRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordChildExecutionCompletedRequest) ProtoMessage()    {}
+func (*RecordChildExecutionCompletedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{54}
 }
-func (m *DescribeHistoryHostRequest) XXX_Unmarshal(b []byte) error {
+func (m *RecordChildExecutionCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DescribeHistoryHostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordChildExecutionCompletedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DescribeHistoryHostRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordChildExecutionCompletedRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3488,162 +3427,78 @@ func (m *DescribeHistoryHostRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *DescribeHistoryHostRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeHistoryHostRequest.Merge(m, src)
+func (m *RecordChildExecutionCompletedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordChildExecutionCompletedRequest.Merge(m, src)
 }
-func (m *DescribeHistoryHostRequest) XXX_Size() int {
+func (m *RecordChildExecutionCompletedRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DescribeHistoryHostRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeHistoryHostRequest.DiscardUnknown(m)
+func (m *RecordChildExecutionCompletedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordChildExecutionCompletedRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeHistoryHostRequest proto.InternalMessageInfo
+var xxx_messageInfo_RecordChildExecutionCompletedRequest proto.InternalMessageInfo
 
-type DescribeHistoryHostResponse struct {
-	NumberOfShards        int32                `protobuf:"varint,1,opt,name=number_of_shards,json=numberOfShards,proto3" json:"number_of_shards,omitempty"`
-	ShardIds              []int32              `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
-	DomainCache           *v12.DomainCacheInfo `protobuf:"bytes,3,opt,name=domain_cache,json=domainCache,proto3" json:"domain_cache,omitempty"`
-	ShardControllerStatus string               `protobuf:"bytes,4,opt,name=shard_controller_status,json=shardControllerStatus,proto3" json:"shard_controller_status,omitempty"`
-	Address               string               `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}             `json:"-"`
-	XXX_unrecognized      []byte               `json:"-"`
-	XXX_sizecache         int32                `json:"-"`
-}
-
-func (m *DescribeHistoryHostResponse) Reset()         { *m = DescribeHistoryHostResponse{} }
-func (m *DescribeHistoryHostResponse) String() string { return proto.CompactTextString(m) }
-func (*DescribeHistoryHostResponse) ProtoMessage()    {}
-func (*DescribeHistoryHostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{53}
-}
-func (m *DescribeHistoryHostResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *DescribeHistoryHostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_DescribeHistoryHostResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *DescribeHistoryHostResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeHistoryHostResponse.Merge(m, src)
-}
-func (m *DescribeHistoryHostResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *DescribeHistoryHostResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeHistoryHostResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DescribeHistoryHostResponse proto.InternalMessageInfo
-
-func (m *DescribeHistoryHostResponse) GetNumberOfShards() int32 {
+func (m *RecordChildExecutionCompletedRequest) GetDomainId() string {
 	if m != nil {
-		return m.NumberOfShards
+		return m.DomainId
 	}
-	return 0
+	return ""
 }
 
-func (m *DescribeHistoryHostResponse) GetShardIds() []int32 {
+func (m *RecordChildExecutionCompletedRequest) GetWorkflowExecution() *v1.WorkflowExecution {
 	if m != nil {
-		return m.ShardIds
+		return m.WorkflowExecution
 	}
 	return nil
 }
 
-func (m *DescribeHistoryHostResponse) GetDomainCache() *v12.DomainCacheInfo {
+func (m *RecordChildExecutionCompletedRequest) GetInitiatedId() int64 {
 	if m != nil {
-		return m.DomainCache
+		return m.InitiatedId
 	}
-	return nil
+	return 0
 }
 
-func (m *DescribeHistoryHostResponse) GetShardControllerStatus() string {
+func (m *RecordChildExecutionCompletedRequest) GetCompletedExecution() *v1.WorkflowExecution {
 	if m != nil {
-		return m.ShardControllerStatus
+		return m.CompletedExecution
 	}
-	return ""
+	return nil
 }
 
-func (m *DescribeHistoryHostResponse) GetAddress() string {
+func (m *RecordChildExecutionCompletedRequest) GetCompletionEvent() *v1.HistoryEvent {
 	if m != nil {
-		return m.Address
-	}
-	return ""
-}
-
-type CloseShardRequest struct {
-	ShardId              int32    `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *CloseShardRequest) Reset()         { *m = CloseShardRequest{} }
-func (m *CloseShardRequest) String() string { return proto.CompactTextString(m) }
-func (*CloseShardRequest) ProtoMessage()    {}
-func (*CloseShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{54}
-}
-func (m *CloseShardRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *CloseShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_CloseShardRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+		return m.CompletionEvent
 	}
+	return nil
 }
-func (m *CloseShardRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CloseShardRequest.Merge(m, src)
-}
-func (m *CloseShardRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *CloseShardRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CloseShardRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_CloseShardRequest proto.InternalMessageInfo
 
-func (m *CloseShardRequest) GetShardId() int32 {
+func (m *RecordChildExecutionCompletedRequest) GetStartedId() int64 {
 	if m != nil {
-		return m.ShardId
+		return m.StartedId
 	}
 	return 0
 }
 
-type CloseShardResponse struct {
+type RecordChildExecutionCompletedResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CloseShardResponse) Reset()         { *m = CloseShardResponse{} }
-func (m *CloseShardResponse) String() string { return proto.CompactTextString(m) }
-func (*CloseShardResponse) ProtoMessage()    {}
-func (*CloseShardResponse) Descriptor() ([]byte, []int) {
+func (m *RecordChildExecutionCompletedResponse) Reset()         { *m = RecordChildExecutionCompletedResponse{} }
+func (m *RecordChildExecutionCompletedResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordChildExecutionCompletedResponse) ProtoMessage()    {}
+func (*RecordChildExecutionCompletedResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{55}
 }
-func (m *CloseShardResponse) XXX_Unmarshal(b []byte) error {
+func (m *RecordChildExecutionCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CloseShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordChildExecutionCompletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CloseShardResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordChildExecutionCompletedResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3653,41 +3508,42 @@ func (m *CloseShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CloseShardResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CloseShardResponse.Merge(m, src)
+func (m *RecordChildExecutionCompletedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordChildExecutionCompletedResponse.Merge(m, src)
 }
-func (m *CloseShardResponse) XXX_Size() int {
+func (m *RecordChildExecutionCompletedResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CloseShardResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CloseShardResponse.DiscardUnknown(m)
+func (m *RecordChildExecutionCompletedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordChildExecutionCompletedResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CloseShardResponse proto.InternalMessageInfo
+var xxx_messageInfo_RecordChildExecutionCompletedResponse proto.InternalMessageInfo
 
-type RemoveTaskRequest struct {
-	ShardId              int32            `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	TaskType             v12.TaskType     `protobuf:"varint,2,opt,name=task_type,json=taskType,proto3,enum=uber.cadence.admin.v1.TaskType" json:"task_type,omitempty"`
-	TaskId               int64            `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	VisibilityTime       *types.Timestamp `protobuf:"bytes,4,opt,name=visibility_time,json=visibilityTime,proto3" json:"visibility_time,omitempty"`
-	ClusterName          string           `protobuf:"bytes,5,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type ReplicateEventsV2Request struct {
+	DomainId            string                    `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution   *v1.WorkflowExecution     `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	VersionHistoryItems []*v12.VersionHistoryItem `protobuf:"bytes,3,rep,name=version_history_items,json=versionHistoryItems,proto3" json:"version_history_items,omitempty"`
+	Events              *v1.DataBlob              `protobuf:"bytes,4,opt,name=events,proto3" json:"events,omitempty"`
+	// New run events does not need version history since there is no prior events.
+	NewRunEvents         *v1.DataBlob `protobuf:"bytes,5,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *RemoveTaskRequest) Reset()         { *m = RemoveTaskRequest{} }
-func (m *RemoveTaskRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveTaskRequest) ProtoMessage()    {}
-func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
+func (m *ReplicateEventsV2Request) Reset()         { *m = ReplicateEventsV2Request{} }
+func (m *ReplicateEventsV2Request) String() string { return proto.CompactTextString(m) }
+func (*ReplicateEventsV2Request) ProtoMessage()    {}
+func (*ReplicateEventsV2Request) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{56}
 }
-func (m *RemoveTaskRequest) XXX_Unmarshal(b []byte) error {
+func (m *ReplicateEventsV2Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReplicateEventsV2Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveTaskRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReplicateEventsV2Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3697,71 +3553,71 @@ func (m *RemoveTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *RemoveTaskRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveTaskRequest.Merge(m, src)
+func (m *ReplicateEventsV2Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicateEventsV2Request.Merge(m, src)
 }
-func (m *RemoveTaskRequest) XXX_Size() int {
+func (m *ReplicateEventsV2Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveTaskRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveTaskRequest.DiscardUnknown(m)
+func (m *ReplicateEventsV2Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicateEventsV2Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveTaskRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReplicateEventsV2Request proto.InternalMessageInfo
 
-func (m *RemoveTaskRequest) GetShardId() int32 {
+func (m *ReplicateEventsV2Request) GetDomainId() string {
 	if m != nil {
-		return m.ShardId
+		return m.DomainId
 	}
-	return 0
+	return ""
 }
 
-func (m *RemoveTaskRequest) GetTaskType() v12.TaskType {
+func (m *ReplicateEventsV2Request) GetWorkflowExecution() *v1.WorkflowExecution {
 	if m != nil {
-		return m.TaskType
+		return m.WorkflowExecution
 	}
-	return v12.TaskType_TASK_TYPE_INVALID
+	return nil
 }
 
-func (m *RemoveTaskRequest) GetTaskId() int64 {
+func (m *ReplicateEventsV2Request) GetVersionHistoryItems() []*v12.VersionHistoryItem {
 	if m != nil {
-		return m.TaskId
+		return m.VersionHistoryItems
 	}
-	return 0
+	return nil
 }
 
-func (m *RemoveTaskRequest) GetVisibilityTime() *types.Timestamp {
+func (m *ReplicateEventsV2Request) GetEvents() *v1.DataBlob {
 	if m != nil {
-		return m.VisibilityTime
+		return m.Events
 	}
 	return nil
 }
 
-func (m *RemoveTaskRequest) GetClusterName() string {
+func (m *ReplicateEventsV2Request) GetNewRunEvents() *v1.DataBlob {
 	if m != nil {
-		return m.ClusterName
+		return m.NewRunEvents
 	}
-	return ""
+	return nil
 }
 
-type RemoveTaskResponse struct {
+type ReplicateEventsV2Response struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveTaskResponse) Reset()         { *m = RemoveTaskResponse{} }
-func (m *RemoveTaskResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveTaskResponse) ProtoMessage()    {}
-func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
+func (m *ReplicateEventsV2Response) Reset()         { *m = ReplicateEventsV2Response{} }
+func (m *ReplicateEventsV2Response) String() string { return proto.CompactTextString(m) }
+func (*ReplicateEventsV2Response) ProtoMessage()    {}
+func (*ReplicateEventsV2Response) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{57}
 }
-func (m *RemoveTaskResponse) XXX_Unmarshal(b []byte) error {
+func (m *ReplicateEventsV2Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReplicateEventsV2Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveTaskResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReplicateEventsV2Response.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3771,39 +3627,39 @@ func (m *RemoveTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RemoveTaskResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveTaskResponse.Merge(m, src)
+func (m *ReplicateEventsV2Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicateEventsV2Response.Merge(m, src)
 }
-func (m *RemoveTaskResponse) XXX_Size() int {
+func (m *ReplicateEventsV2Response) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveTaskResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveTaskResponse.DiscardUnknown(m)
+func (m *ReplicateEventsV2Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicateEventsV2Response.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveTaskResponse proto.InternalMessageInfo
+var xxx_messageInfo_ReplicateEventsV2Response proto.InternalMessageInfo
 
-type ResetQueueRequest struct {
-	ShardId              int32        `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	ClusterName          string       `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	TaskType             v12.TaskType `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3,enum=uber.cadence.admin.v1.TaskType" json:"task_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type SyncShardStatusRequest struct {
+	SourceCluster        string           `protobuf:"bytes,1,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	ShardId              int32            `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	Time                 *types.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *ResetQueueRequest) Reset()         { *m = ResetQueueRequest{} }
-func (m *ResetQueueRequest) String() string { return proto.CompactTextString(m) }
-func (*ResetQueueRequest) ProtoMessage()    {}
-func (*ResetQueueRequest) Descriptor() ([]byte, []int) {
+func (m *SyncShardStatusRequest) Reset()         { *m = SyncShardStatusRequest{} }
+func (m *SyncShardStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncShardStatusRequest) ProtoMessage()    {}
+func (*SyncShardStatusRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{58}
 }
-func (m *ResetQueueRequest) XXX_Unmarshal(b []byte) error {
+func (m *SyncShardStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ResetQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SyncShardStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ResetQueueRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SyncShardStatusRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3813,57 +3669,57 @@ func (m *ResetQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *ResetQueueRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResetQueueRequest.Merge(m, src)
+func (m *SyncShardStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncShardStatusRequest.Merge(m, src)
 }
-func (m *ResetQueueRequest) XXX_Size() int {
+func (m *SyncShardStatusRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ResetQueueRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResetQueueRequest.DiscardUnknown(m)
+func (m *SyncShardStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncShardStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResetQueueRequest proto.InternalMessageInfo
+var xxx_messageInfo_SyncShardStatusRequest proto.InternalMessageInfo
 
-func (m *ResetQueueRequest) GetShardId() int32 {
+func (m *SyncShardStatusRequest) GetSourceCluster() string {
 	if m != nil {
-		return m.ShardId
+		return m.SourceCluster
 	}
-	return 0
+	return ""
 }
 
-func (m *ResetQueueRequest) GetClusterName() string {
+func (m *SyncShardStatusRequest) GetShardId() int32 {
 	if m != nil {
-		return m.ClusterName
+		return m.ShardId
 	}
-	return ""
+	return 0
 }
 
-func (m *ResetQueueRequest) GetTaskType() v12.TaskType {
+func (m *SyncShardStatusRequest) GetTime() *types.Timestamp {
 	if m != nil {
-		return m.TaskType
+		return m.Time
 	}
-	return v12.TaskType_TASK_TYPE_INVALID
+	return nil
 }
 
-type ResetQueueResponse struct {
+type SyncShardStatusResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResetQueueResponse) Reset()         { *m = ResetQueueResponse{} }
-func (m *ResetQueueResponse) String() string { return proto.CompactTextString(m) }
-func (*ResetQueueResponse) ProtoMessage()    {}
-func (*ResetQueueResponse) Descriptor() ([]byte, []int) {
+func (m *SyncShardStatusResponse) Reset()         { *m = SyncShardStatusResponse{} }
+func (m *SyncShardStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncShardStatusResponse) ProtoMessage()    {}
+func (*SyncShardStatusResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{59}
 }
-func (m *ResetQueueResponse) XXX_Unmarshal(b []byte) error {
+func (m *SyncShardStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ResetQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SyncShardStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ResetQueueResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SyncShardStatusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3873,39 +3729,50 @@ func (m *ResetQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ResetQueueResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResetQueueResponse.Merge(m, src)
+func (m *SyncShardStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncShardStatusResponse.Merge(m, src)
 }
-func (m *ResetQueueResponse) XXX_Size() int {
+func (m *SyncShardStatusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ResetQueueResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResetQueueResponse.DiscardUnknown(m)
+func (m *SyncShardStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncShardStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResetQueueResponse proto.InternalMessageInfo
+var xxx_messageInfo_SyncShardStatusResponse proto.InternalMessageInfo
 
-type DescribeQueueRequest struct {
-	ShardId              int32        `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	ClusterName          string       `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	TaskType             v12.TaskType `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3,enum=uber.cadence.admin.v1.TaskType" json:"task_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type SyncActivityRequest struct {
+	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Version              int64                 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	ScheduledId          int64                 `protobuf:"varint,4,opt,name=scheduled_id,json=scheduledId,proto3" json:"scheduled_id,omitempty"`
+	ScheduledTime        *types.Timestamp      `protobuf:"bytes,5,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
+	StartedId            int64                 `protobuf:"varint,6,opt,name=started_id,json=startedId,proto3" json:"started_id,omitempty"`
+	StartedTime          *types.Timestamp      `protobuf:"bytes,7,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
+	LastHeartbeatTime    *types.Timestamp      `protobuf:"bytes,8,opt,name=last_heartbeat_time,json=lastHeartbeatTime,proto3" json:"last_heartbeat_time,omitempty"`
+	Details              *v1.Payload           `protobuf:"bytes,9,opt,name=details,proto3" json:"details,omitempty"`
+	Attempt              int32                 `protobuf:"varint,10,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	LastFailure          *v1.Failure           `protobuf:"bytes,11,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+	LastWorkerIdentity   string                `protobuf:"bytes,12,opt,name=last_worker_identity,json=lastWorkerIdentity,proto3" json:"last_worker_identity,omitempty"`
+	VersionHistory       *v12.VersionHistory   `protobuf:"bytes,13,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	Paused               bool                  `protobuf:"varint,14,opt,name=paused,proto3" json:"paused,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *DescribeQueueRequest) Reset()         { *m = DescribeQueueRequest{} }
-func (m *DescribeQueueRequest) String() string { return proto.CompactTextString(m) }
-func (*DescribeQueueRequest) ProtoMessage()    {}
-func (*DescribeQueueRequest) Descriptor() ([]byte, []int) {
+func (m *SyncActivityRequest) Reset()         { *m = SyncActivityRequest{} }
+func (m *SyncActivityRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncActivityRequest) ProtoMessage()    {}
+func (*SyncActivityRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{60}
 }
-func (m *DescribeQueueRequest) XXX_Unmarshal(b []byte) error {
+func (m *SyncActivityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DescribeQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SyncActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DescribeQueueRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SyncActivityRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3915,106 +3782,134 @@ func (m *DescribeQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *DescribeQueueRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeQueueRequest.Merge(m, src)
+func (m *SyncActivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncActivityRequest.Merge(m, src)
 }
-func (m *DescribeQueueRequest) XXX_Size() int {
+func (m *SyncActivityRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DescribeQueueRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeQueueRequest.DiscardUnknown(m)
+func (m *SyncActivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncActivityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeQueueRequest proto.InternalMessageInfo
+var xxx_messageInfo_SyncActivityRequest proto.InternalMessageInfo
 
-func (m *DescribeQueueRequest) GetShardId() int32 {
+func (m *SyncActivityRequest) GetDomainId() string {
 	if m != nil {
-		return m.ShardId
+		return m.DomainId
 	}
-	return 0
+	return ""
 }
 
-func (m *DescribeQueueRequest) GetClusterName() string {
+func (m *SyncActivityRequest) GetWorkflowExecution() *v1.WorkflowExecution {
 	if m != nil {
-		return m.ClusterName
+		return m.WorkflowExecution
 	}
-	return ""
+	return nil
 }
 
-func (m *DescribeQueueRequest) GetTaskType() v12.TaskType {
+func (m *SyncActivityRequest) GetVersion() int64 {
 	if m != nil {
-		return m.TaskType
+		return m.Version
 	}
-	return v12.TaskType_TASK_TYPE_INVALID
+	return 0
 }
 
-type DescribeQueueResponse struct {
-	ProcessingQueueStates []string `protobuf:"bytes,1,rep,name=processing_queue_states,json=processingQueueStates,proto3" json:"processing_queue_states,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
-	XXX_unrecognized      []byte   `json:"-"`
-	XXX_sizecache         int32    `json:"-"`
+func (m *SyncActivityRequest) GetScheduledId() int64 {
+	if m != nil {
+		return m.ScheduledId
+	}
+	return 0
 }
 
-func (m *DescribeQueueResponse) Reset()         { *m = DescribeQueueResponse{} }
-func (m *DescribeQueueResponse) String() string { return proto.CompactTextString(m) }
-func (*DescribeQueueResponse) ProtoMessage()    {}
-func (*DescribeQueueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{61}
+func (m *SyncActivityRequest) GetScheduledTime() *types.Timestamp {
+	if m != nil {
+		return m.ScheduledTime
+	}
+	return nil
 }
-func (m *DescribeQueueResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *SyncActivityRequest) GetStartedId() int64 {
+	if m != nil {
+		return m.StartedId
+	}
+	return 0
 }
-func (m *DescribeQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_DescribeQueueResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *SyncActivityRequest) GetStartedTime() *types.Timestamp {
+	if m != nil {
+		return m.StartedTime
 	}
+	return nil
 }
-func (m *DescribeQueueResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeQueueResponse.Merge(m, src)
+
+func (m *SyncActivityRequest) GetLastHeartbeatTime() *types.Timestamp {
+	if m != nil {
+		return m.LastHeartbeatTime
+	}
+	return nil
 }
-func (m *DescribeQueueResponse) XXX_Size() int {
-	return m.Size()
+
+func (m *SyncActivityRequest) GetDetails() *v1.Payload {
+	if m != nil {
+		return m.Details
+	}
+	return nil
 }
-func (m *DescribeQueueResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeQueueResponse.DiscardUnknown(m)
+
+func (m *SyncActivityRequest) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
 }
 
-var xxx_messageInfo_DescribeQueueResponse proto.InternalMessageInfo
+func (m *SyncActivityRequest) GetLastFailure() *v1.Failure {
+	if m != nil {
+		return m.LastFailure
+	}
+	return nil
+}
 
-func (m *DescribeQueueResponse) GetProcessingQueueStates() []string {
+func (m *SyncActivityRequest) GetLastWorkerIdentity() string {
 	if m != nil {
-		return m.ProcessingQueueStates
+		return m.LastWorkerIdentity
+	}
+	return ""
+}
+
+func (m *SyncActivityRequest) GetVersionHistory() *v12.VersionHistory {
+	if m != nil {
+		return m.VersionHistory
 	}
 	return nil
 }
 
-type GetReplicationMessagesRequest struct {
-	Tokens               []*v12.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
-	ClusterName          string                  `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+func (m *SyncActivityRequest) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
 }
 
-func (m *GetReplicationMessagesRequest) Reset()         { *m = GetReplicationMessagesRequest{} }
-func (m *GetReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetReplicationMessagesRequest) ProtoMessage()    {}
-func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{62}
+type SyncActivityResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *GetReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *SyncActivityResponse) Reset()         { *m = SyncActivityResponse{} }
+func (m *SyncActivityResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncActivityResponse) ProtoMessage()    {}
+func (*SyncActivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{61}
+}
+func (m *SyncActivityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetReplicationMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SyncActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetReplicationMessagesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SyncActivityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4024,51 +3919,39 @@ func (m *GetReplicationMessagesRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *GetReplicationMessagesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetReplicationMessagesRequest.Merge(m, src)
+func (m *SyncActivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncActivityResponse.Merge(m, src)
 }
-func (m *GetReplicationMessagesRequest) XXX_Size() int {
+func (m *SyncActivityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetReplicationMessagesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetReplicationMessagesRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetReplicationMessagesRequest proto.InternalMessageInfo
-
-func (m *GetReplicationMessagesRequest) GetTokens() []*v12.ReplicationToken {
-	if m != nil {
-		return m.Tokens
-	}
-	return nil
+func (m *SyncActivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncActivityResponse.DiscardUnknown(m)
 }
 
-func (m *GetReplicationMessagesRequest) GetClusterName() string {
-	if m != nil {
-		return m.ClusterName
-	}
-	return ""
-}
+var xxx_messageInfo_SyncActivityResponse proto.InternalMessageInfo
 
-type GetReplicationMessagesResponse struct {
-	ShardMessages        map[int32]*v12.ReplicationMessages `protobuf:"bytes,1,rep,name=shard_messages,json=shardMessages,proto3" json:"shard_messages,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
-	XXX_unrecognized     []byte                             `json:"-"`
-	XXX_sizecache        int32                              `json:"-"`
+type DescribeMutableStateRequest struct {
+	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	RebuildFromHistory   bool                  `protobuf:"varint,3,opt,name=rebuild_from_history,json=rebuildFromHistory,proto3" json:"rebuild_from_history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *GetReplicationMessagesResponse) Reset()         { *m = GetReplicationMessagesResponse{} }
-func (m *GetReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetReplicationMessagesResponse) ProtoMessage()    {}
-func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{63}
+func (m *DescribeMutableStateRequest) Reset()         { *m = DescribeMutableStateRequest{} }
+func (m *DescribeMutableStateRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeMutableStateRequest) ProtoMessage()    {}
+func (*DescribeMutableStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{62}
 }
-func (m *GetReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
+func (m *DescribeMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetReplicationMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DescribeMutableStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetReplicationMessagesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DescribeMutableStateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4078,44 +3961,60 @@ func (m *GetReplicationMessagesResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *GetReplicationMessagesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetReplicationMessagesResponse.Merge(m, src)
+func (m *DescribeMutableStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeMutableStateRequest.Merge(m, src)
 }
-func (m *GetReplicationMessagesResponse) XXX_Size() int {
+func (m *DescribeMutableStateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetReplicationMessagesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetReplicationMessagesResponse.DiscardUnknown(m)
+func (m *DescribeMutableStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeMutableStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetReplicationMessagesResponse proto.InternalMessageInfo
+var xxx_messageInfo_DescribeMutableStateRequest proto.InternalMessageInfo
 
-func (m *GetReplicationMessagesResponse) GetShardMessages() map[int32]*v12.ReplicationMessages {
+func (m *DescribeMutableStateRequest) GetDomainId() string {
 	if m != nil {
-		return m.ShardMessages
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *DescribeMutableStateRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
 	}
 	return nil
 }
 
-type GetDLQReplicationMessagesRequest struct {
-	TaskInfos            []*v12.ReplicationTaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+func (m *DescribeMutableStateRequest) GetRebuildFromHistory() bool {
+	if m != nil {
+		return m.RebuildFromHistory
+	}
+	return false
 }
 
-func (m *GetDLQReplicationMessagesRequest) Reset()         { *m = GetDLQReplicationMessagesRequest{} }
-func (m *GetDLQReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetDLQReplicationMessagesRequest) ProtoMessage()    {}
-func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{64}
+type DescribeMutableStateResponse struct {
+	MutableStateInCache            string   `protobuf:"bytes,1,opt,name=mutable_state_in_cache,json=mutableStateInCache,proto3" json:"mutable_state_in_cache,omitempty"`
+	MutableStateInDatabase         string   `protobuf:"bytes,2,opt,name=mutable_state_in_database,json=mutableStateInDatabase,proto3" json:"mutable_state_in_database,omitempty"`
+	MutableStateRebuiltFromHistory string   `protobuf:"bytes,3,opt,name=mutable_state_rebuilt_from_history,json=mutableStateRebuiltFromHistory,proto3" json:"mutable_state_rebuilt_from_history,omitempty"`
+	XXX_NoUnkeyedLiteral           struct{} `json:"-"`
+	XXX_unrecognized               []byte   `json:"-"`
+	XXX_sizecache                  int32    `json:"-"`
 }
-func (m *GetDLQReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *DescribeMutableStateResponse) Reset()         { *m = DescribeMutableStateResponse{} }
+func (m *DescribeMutableStateResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeMutableStateResponse) ProtoMessage()    {}
+func (*DescribeMutableStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{63}
+}
+func (m *DescribeMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetDLQReplicationMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DescribeMutableStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetDLQReplicationMessagesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DescribeMutableStateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4125,44 +4024,57 @@ func (m *GetDLQReplicationMessagesRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *GetDLQReplicationMessagesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDLQReplicationMessagesRequest.Merge(m, src)
+func (m *DescribeMutableStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeMutableStateResponse.Merge(m, src)
 }
-func (m *GetDLQReplicationMessagesRequest) XXX_Size() int {
+func (m *DescribeMutableStateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetDLQReplicationMessagesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDLQReplicationMessagesRequest.DiscardUnknown(m)
+func (m *DescribeMutableStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeMutableStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDLQReplicationMessagesRequest proto.InternalMessageInfo
+var xxx_messageInfo_DescribeMutableStateResponse proto.InternalMessageInfo
 
-func (m *GetDLQReplicationMessagesRequest) GetTaskInfos() []*v12.ReplicationTaskInfo {
+func (m *DescribeMutableStateResponse) GetMutableStateInCache() string {
 	if m != nil {
-		return m.TaskInfos
+		return m.MutableStateInCache
 	}
-	return nil
+	return ""
 }
 
-type GetDLQReplicationMessagesResponse struct {
-	ReplicationTasks     []*v12.ReplicationTask `protobuf:"bytes,1,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+func (m *DescribeMutableStateResponse) GetMutableStateInDatabase() string {
+	if m != nil {
+		return m.MutableStateInDatabase
+	}
+	return ""
 }
 
-func (m *GetDLQReplicationMessagesResponse) Reset()         { *m = GetDLQReplicationMessagesResponse{} }
-func (m *GetDLQReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetDLQReplicationMessagesResponse) ProtoMessage()    {}
-func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{65}
+func (m *DescribeMutableStateResponse) GetMutableStateRebuiltFromHistory() string {
+	if m != nil {
+		return m.MutableStateRebuiltFromHistory
+	}
+	return ""
 }
-func (m *GetDLQReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
+
+type DescribeHistoryHostRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeHistoryHostRequest) Reset()         { *m = DescribeHistoryHostRequest{} }
+func (m *DescribeHistoryHostRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeHistoryHostRequest) ProtoMessage()    {}
+func (*DescribeHistoryHostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{64}
+}
+func (m *DescribeHistoryHostRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetDLQReplicationMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DescribeHistoryHostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetDLQReplicationMessagesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DescribeHistoryHostRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4172,47 +4084,41 @@ func (m *GetDLQReplicationMessagesResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *GetDLQReplicationMessagesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDLQReplicationMessagesResponse.Merge(m, src)
+func (m *DescribeHistoryHostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeHistoryHostRequest.Merge(m, src)
 }
-func (m *GetDLQReplicationMessagesResponse) XXX_Size() int {
+func (m *DescribeHistoryHostRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetDLQReplicationMessagesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDLQReplicationMessagesResponse.DiscardUnknown(m)
+func (m *DescribeHistoryHostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeHistoryHostRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDLQReplicationMessagesResponse proto.InternalMessageInfo
-
-func (m *GetDLQReplicationMessagesResponse) GetReplicationTasks() []*v12.ReplicationTask {
-	if m != nil {
-		return m.ReplicationTasks
-	}
-	return nil
-}
+var xxx_messageInfo_DescribeHistoryHostRequest proto.InternalMessageInfo
 
-type ReapplyEventsRequest struct {
-	Domain               string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	DomainId             string                `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,3,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	Events               *v1.DataBlob          `protobuf:"bytes,4,opt,name=events,proto3" json:"events,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type DescribeHistoryHostResponse struct {
+	NumberOfShards        int32                `protobuf:"varint,1,opt,name=number_of_shards,json=numberOfShards,proto3" json:"number_of_shards,omitempty"`
+	ShardIds              []int32              `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
+	DomainCache           *v12.DomainCacheInfo `protobuf:"bytes,3,opt,name=domain_cache,json=domainCache,proto3" json:"domain_cache,omitempty"`
+	ShardControllerStatus string               `protobuf:"bytes,4,opt,name=shard_controller_status,json=shardControllerStatus,proto3" json:"shard_controller_status,omitempty"`
+	Address               string               `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}             `json:"-"`
+	XXX_unrecognized      []byte               `json:"-"`
+	XXX_sizecache         int32                `json:"-"`
 }
 
-func (m *ReapplyEventsRequest) Reset()         { *m = ReapplyEventsRequest{} }
-func (m *ReapplyEventsRequest) String() string { return proto.CompactTextString(m) }
-func (*ReapplyEventsRequest) ProtoMessage()    {}
-func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{66}
+func (m *DescribeHistoryHostResponse) Reset()         { *m = DescribeHistoryHostResponse{} }
+func (m *DescribeHistoryHostResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeHistoryHostResponse) ProtoMessage()    {}
+func (*DescribeHistoryHostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{65}
 }
-func (m *ReapplyEventsRequest) XXX_Unmarshal(b []byte) error {
+func (m *DescribeHistoryHostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReapplyEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DescribeHistoryHostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReapplyEventsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DescribeHistoryHostResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4222,64 +4128,118 @@ func (m *ReapplyEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ReapplyEventsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReapplyEventsRequest.Merge(m, src)
+func (m *DescribeHistoryHostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeHistoryHostResponse.Merge(m, src)
 }
-func (m *ReapplyEventsRequest) XXX_Size() int {
+func (m *DescribeHistoryHostResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReapplyEventsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReapplyEventsRequest.DiscardUnknown(m)
+func (m *DescribeHistoryHostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeHistoryHostResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReapplyEventsRequest proto.InternalMessageInfo
+var xxx_messageInfo_DescribeHistoryHostResponse proto.InternalMessageInfo
 
-func (m *ReapplyEventsRequest) GetDomain() string {
+func (m *DescribeHistoryHostResponse) GetNumberOfShards() int32 {
 	if m != nil {
-		return m.Domain
+		return m.NumberOfShards
 	}
-	return ""
+	return 0
 }
 
-func (m *ReapplyEventsRequest) GetDomainId() string {
+func (m *DescribeHistoryHostResponse) GetShardIds() []int32 {
 	if m != nil {
-		return m.DomainId
+		return m.ShardIds
 	}
-	return ""
+	return nil
 }
 
-func (m *ReapplyEventsRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+func (m *DescribeHistoryHostResponse) GetDomainCache() *v12.DomainCacheInfo {
 	if m != nil {
-		return m.WorkflowExecution
+		return m.DomainCache
 	}
 	return nil
 }
 
-func (m *ReapplyEventsRequest) GetEvents() *v1.DataBlob {
+func (m *DescribeHistoryHostResponse) GetShardControllerStatus() string {
 	if m != nil {
-		return m.Events
+		return m.ShardControllerStatus
 	}
-	return nil
+	return ""
 }
 
-type ReapplyEventsResponse struct {
+func (m *DescribeHistoryHostResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type CloseShardRequest struct {
+	ShardId              int32    `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReapplyEventsResponse) Reset()         { *m = ReapplyEventsResponse{} }
-func (m *ReapplyEventsResponse) String() string { return proto.CompactTextString(m) }
-func (*ReapplyEventsResponse) ProtoMessage()    {}
-func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
+func (m *CloseShardRequest) Reset()         { *m = CloseShardRequest{} }
+func (m *CloseShardRequest) String() string { return proto.CompactTextString(m) }
+func (*CloseShardRequest) ProtoMessage()    {}
+func (*CloseShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{66}
+}
+func (m *CloseShardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CloseShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CloseShardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CloseShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseShardRequest.Merge(m, src)
+}
+func (m *CloseShardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CloseShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseShardRequest proto.InternalMessageInfo
+
+func (m *CloseShardRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+type CloseShardResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloseShardResponse) Reset()         { *m = CloseShardResponse{} }
+func (m *CloseShardResponse) String() string { return proto.CompactTextString(m) }
+func (*CloseShardResponse) ProtoMessage()    {}
+func (*CloseShardResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{67}
 }
-func (m *ReapplyEventsResponse) XXX_Unmarshal(b []byte) error {
+func (m *CloseShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReapplyEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CloseShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReapplyEventsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CloseShardResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4289,39 +4249,41 @@ func (m *ReapplyEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *ReapplyEventsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReapplyEventsResponse.Merge(m, src)
+func (m *CloseShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseShardResponse.Merge(m, src)
 }
-func (m *ReapplyEventsResponse) XXX_Size() int {
+func (m *CloseShardResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReapplyEventsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReapplyEventsResponse.DiscardUnknown(m)
+func (m *CloseShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseShardResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReapplyEventsResponse proto.InternalMessageInfo
+var xxx_messageInfo_CloseShardResponse proto.InternalMessageInfo
 
-type RefreshWorkflowTasksRequest struct {
-	Domain               string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	DomainId             string                `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,3,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type RemoveTaskRequest struct {
+	ShardId              int32            `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	TaskType             v12.TaskType     `protobuf:"varint,2,opt,name=task_type,json=taskType,proto3,enum=uber.cadence.admin.v1.TaskType" json:"task_type,omitempty"`
+	TaskId               int64            `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	VisibilityTime       *types.Timestamp `protobuf:"bytes,4,opt,name=visibility_time,json=visibilityTime,proto3" json:"visibility_time,omitempty"`
+	ClusterName          string           `protobuf:"bytes,5,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *RefreshWorkflowTasksRequest) Reset()         { *m = RefreshWorkflowTasksRequest{} }
-func (m *RefreshWorkflowTasksRequest) String() string { return proto.CompactTextString(m) }
-func (*RefreshWorkflowTasksRequest) ProtoMessage()    {}
-func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
+func (m *RemoveTaskRequest) Reset()         { *m = RemoveTaskRequest{} }
+func (m *RemoveTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveTaskRequest) ProtoMessage()    {}
+func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{68}
 }
-func (m *RefreshWorkflowTasksRequest) XXX_Unmarshal(b []byte) error {
+func (m *RemoveTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RefreshWorkflowTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RefreshWorkflowTasksRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveTaskRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4331,57 +4293,71 @@ func (m *RefreshWorkflowTasksRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *RefreshWorkflowTasksRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RefreshWorkflowTasksRequest.Merge(m, src)
+func (m *RemoveTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveTaskRequest.Merge(m, src)
 }
-func (m *RefreshWorkflowTasksRequest) XXX_Size() int {
+func (m *RemoveTaskRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RefreshWorkflowTasksRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RefreshWorkflowTasksRequest.DiscardUnknown(m)
+func (m *RemoveTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveTaskRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RefreshWorkflowTasksRequest proto.InternalMessageInfo
+var xxx_messageInfo_RemoveTaskRequest proto.InternalMessageInfo
 
-func (m *RefreshWorkflowTasksRequest) GetDomain() string {
+func (m *RemoveTaskRequest) GetShardId() int32 {
 	if m != nil {
-		return m.Domain
+		return m.ShardId
 	}
-	return ""
+	return 0
 }
 
-func (m *RefreshWorkflowTasksRequest) GetDomainId() string {
+func (m *RemoveTaskRequest) GetTaskType() v12.TaskType {
 	if m != nil {
-		return m.DomainId
+		return m.TaskType
 	}
-	return ""
+	return v12.TaskType_TASK_TYPE_INVALID
 }
 
-func (m *RefreshWorkflowTasksRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+func (m *RemoveTaskRequest) GetTaskId() int64 {
 	if m != nil {
-		return m.WorkflowExecution
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *RemoveTaskRequest) GetVisibilityTime() *types.Timestamp {
+	if m != nil {
+		return m.VisibilityTime
 	}
 	return nil
 }
 
-type RefreshWorkflowTasksResponse struct {
+func (m *RemoveTaskRequest) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+type RemoveTaskResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RefreshWorkflowTasksResponse) Reset()         { *m = RefreshWorkflowTasksResponse{} }
-func (m *RefreshWorkflowTasksResponse) String() string { return proto.CompactTextString(m) }
-func (*RefreshWorkflowTasksResponse) ProtoMessage()    {}
-func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
+func (m *RemoveTaskResponse) Reset()         { *m = RemoveTaskResponse{} }
+func (m *RemoveTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveTaskResponse) ProtoMessage()    {}
+func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{69}
 }
-func (m *RefreshWorkflowTasksResponse) XXX_Unmarshal(b []byte) error {
+func (m *RemoveTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RefreshWorkflowTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RefreshWorkflowTasksResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveTaskResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4391,37 +4367,39 @@ func (m *RefreshWorkflowTasksResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *RefreshWorkflowTasksResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RefreshWorkflowTasksResponse.Merge(m, src)
+func (m *RemoveTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveTaskResponse.Merge(m, src)
 }
-func (m *RefreshWorkflowTasksResponse) XXX_Size() int {
+func (m *RemoveTaskResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RefreshWorkflowTasksResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RefreshWorkflowTasksResponse.DiscardUnknown(m)
+func (m *RemoveTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveTaskResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RefreshWorkflowTasksResponse proto.InternalMessageInfo
+var xxx_messageInfo_RemoveTaskResponse proto.InternalMessageInfo
 
-type CountDLQMessagesRequest struct {
-	ForceFetch           bool     `protobuf:"varint,1,opt,name=forceFetch,proto3" json:"forceFetch,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ResetQueueRequest struct {
+	ShardId              int32        `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	ClusterName          string       `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	TaskType             v12.TaskType `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3,enum=uber.cadence.admin.v1.TaskType" json:"task_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *CountDLQMessagesRequest) Reset()         { *m = CountDLQMessagesRequest{} }
-func (m *CountDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
-func (*CountDLQMessagesRequest) ProtoMessage()    {}
-func (*CountDLQMessagesRequest) Descriptor() ([]byte, []int) {
+func (m *ResetQueueRequest) Reset()         { *m = ResetQueueRequest{} }
+func (m *ResetQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetQueueRequest) ProtoMessage()    {}
+func (*ResetQueueRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{70}
 }
-func (m *CountDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
+func (m *ResetQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CountDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResetQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CountDLQMessagesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResetQueueRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4431,44 +4409,57 @@ func (m *CountDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *CountDLQMessagesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CountDLQMessagesRequest.Merge(m, src)
+func (m *ResetQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetQueueRequest.Merge(m, src)
 }
-func (m *CountDLQMessagesRequest) XXX_Size() int {
+func (m *ResetQueueRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CountDLQMessagesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CountDLQMessagesRequest.DiscardUnknown(m)
+func (m *ResetQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetQueueRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CountDLQMessagesRequest proto.InternalMessageInfo
+var xxx_messageInfo_ResetQueueRequest proto.InternalMessageInfo
 
-func (m *CountDLQMessagesRequest) GetForceFetch() bool {
+func (m *ResetQueueRequest) GetShardId() int32 {
 	if m != nil {
-		return m.ForceFetch
+		return m.ShardId
 	}
-	return false
+	return 0
 }
 
-type CountDLQMessagesResponse struct {
-	Entries              []*v12.HistoryDLQCountEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+func (m *ResetQueueRequest) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
 }
 
-func (m *CountDLQMessagesResponse) Reset()         { *m = CountDLQMessagesResponse{} }
-func (m *CountDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
-func (*CountDLQMessagesResponse) ProtoMessage()    {}
-func (*CountDLQMessagesResponse) Descriptor() ([]byte, []int) {
+func (m *ResetQueueRequest) GetTaskType() v12.TaskType {
+	if m != nil {
+		return m.TaskType
+	}
+	return v12.TaskType_TASK_TYPE_INVALID
+}
+
+type ResetQueueResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetQueueResponse) Reset()         { *m = ResetQueueResponse{} }
+func (m *ResetQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*ResetQueueResponse) ProtoMessage()    {}
+func (*ResetQueueResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{71}
 }
-func (m *CountDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
+func (m *ResetQueueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CountDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResetQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CountDLQMessagesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResetQueueResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4478,49 +4469,39 @@ func (m *CountDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *CountDLQMessagesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CountDLQMessagesResponse.Merge(m, src)
+func (m *ResetQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetQueueResponse.Merge(m, src)
 }
-func (m *CountDLQMessagesResponse) XXX_Size() int {
+func (m *ResetQueueResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CountDLQMessagesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CountDLQMessagesResponse.DiscardUnknown(m)
+func (m *ResetQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetQueueResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CountDLQMessagesResponse proto.InternalMessageInfo
-
-func (m *CountDLQMessagesResponse) GetEntries() []*v12.HistoryDLQCountEntry {
-	if m != nil {
-		return m.Entries
-	}
-	return nil
-}
+var xxx_messageInfo_ResetQueueResponse proto.InternalMessageInfo
 
-type ReadDLQMessagesRequest struct {
-	Type                  v12.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
-	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
-	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
-	PageSize              int32             `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken         []byte            `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
-	XXX_unrecognized      []byte            `json:"-"`
-	XXX_sizecache         int32             `json:"-"`
+type DescribeQueueRequest struct {
+	ShardId              int32        `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	ClusterName          string       `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	TaskType             v12.TaskType `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3,enum=uber.cadence.admin.v1.TaskType" json:"task_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *ReadDLQMessagesRequest) Reset()         { *m = ReadDLQMessagesRequest{} }
-func (m *ReadDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
-func (*ReadDLQMessagesRequest) ProtoMessage()    {}
-func (*ReadDLQMessagesRequest) Descriptor() ([]byte, []int) {
+func (m *DescribeQueueRequest) Reset()         { *m = DescribeQueueRequest{} }
+func (m *DescribeQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeQueueRequest) ProtoMessage()    {}
+func (*DescribeQueueRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{72}
 }
-func (m *ReadDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
+func (m *DescribeQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReadDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DescribeQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReadDLQMessagesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DescribeQueueRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4530,82 +4511,58 @@ func (m *ReadDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *ReadDLQMessagesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadDLQMessagesRequest.Merge(m, src)
+func (m *DescribeQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeQueueRequest.Merge(m, src)
 }
-func (m *ReadDLQMessagesRequest) XXX_Size() int {
+func (m *DescribeQueueRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReadDLQMessagesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadDLQMessagesRequest.DiscardUnknown(m)
+func (m *DescribeQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeQueueRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadDLQMessagesRequest proto.InternalMessageInfo
-
-func (m *ReadDLQMessagesRequest) GetType() v12.DLQType {
-	if m != nil {
-		return m.Type
-	}
-	return v12.DLQType_DLQ_TYPE_INVALID
-}
+var xxx_messageInfo_DescribeQueueRequest proto.InternalMessageInfo
 
-func (m *ReadDLQMessagesRequest) GetShardId() int32 {
+func (m *DescribeQueueRequest) GetShardId() int32 {
 	if m != nil {
 		return m.ShardId
 	}
 	return 0
 }
 
-func (m *ReadDLQMessagesRequest) GetSourceCluster() string {
+func (m *DescribeQueueRequest) GetClusterName() string {
 	if m != nil {
-		return m.SourceCluster
+		return m.ClusterName
 	}
 	return ""
 }
 
-func (m *ReadDLQMessagesRequest) GetInclusiveEndMessageId() *types.Int64Value {
-	if m != nil {
-		return m.InclusiveEndMessageId
-	}
-	return nil
-}
-
-func (m *ReadDLQMessagesRequest) GetPageSize() int32 {
-	if m != nil {
-		return m.PageSize
-	}
-	return 0
-}
-
-func (m *ReadDLQMessagesRequest) GetNextPageToken() []byte {
+func (m *DescribeQueueRequest) GetTaskType() v12.TaskType {
 	if m != nil {
-		return m.NextPageToken
+		return m.TaskType
 	}
-	return nil
+	return v12.TaskType_TASK_TYPE_INVALID
 }
 
-type ReadDLQMessagesResponse struct {
-	Type                 v12.DLQType                `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
-	ReplicationTasks     []*v12.ReplicationTask     `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
-	ReplicationTasksInfo []*v12.ReplicationTaskInfo `protobuf:"bytes,3,rep,name=replication_tasks_info,json=replicationTasksInfo,proto3" json:"replication_tasks_info,omitempty"`
-	NextPageToken        []byte                     `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+type DescribeQueueResponse struct {
+	ProcessingQueueStates []string `protobuf:"bytes,1,rep,name=processing_queue_states,json=processingQueueStates,proto3" json:"processing_queue_states,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
-func (m *ReadDLQMessagesResponse) Reset()         { *m = ReadDLQMessagesResponse{} }
-func (m *ReadDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
-func (*ReadDLQMessagesResponse) ProtoMessage()    {}
-func (*ReadDLQMessagesResponse) Descriptor() ([]byte, []int) {
+func (m *DescribeQueueResponse) Reset()         { *m = DescribeQueueResponse{} }
+func (m *DescribeQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeQueueResponse) ProtoMessage()    {}
+func (*DescribeQueueResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{73}
 }
-func (m *ReadDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
+func (m *DescribeQueueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReadDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DescribeQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReadDLQMessagesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DescribeQueueResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4615,68 +4572,44 @@ func (m *ReadDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *ReadDLQMessagesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadDLQMessagesResponse.Merge(m, src)
+func (m *DescribeQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeQueueResponse.Merge(m, src)
 }
-func (m *ReadDLQMessagesResponse) XXX_Size() int {
+func (m *DescribeQueueResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReadDLQMessagesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadDLQMessagesResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ReadDLQMessagesResponse proto.InternalMessageInfo
-
-func (m *ReadDLQMessagesResponse) GetType() v12.DLQType {
-	if m != nil {
-		return m.Type
-	}
-	return v12.DLQType_DLQ_TYPE_INVALID
-}
-
-func (m *ReadDLQMessagesResponse) GetReplicationTasks() []*v12.ReplicationTask {
-	if m != nil {
-		return m.ReplicationTasks
-	}
-	return nil
+func (m *DescribeQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeQueueResponse.DiscardUnknown(m)
 }
 
-func (m *ReadDLQMessagesResponse) GetReplicationTasksInfo() []*v12.ReplicationTaskInfo {
-	if m != nil {
-		return m.ReplicationTasksInfo
-	}
-	return nil
-}
+var xxx_messageInfo_DescribeQueueResponse proto.InternalMessageInfo
 
-func (m *ReadDLQMessagesResponse) GetNextPageToken() []byte {
+func (m *DescribeQueueResponse) GetProcessingQueueStates() []string {
 	if m != nil {
-		return m.NextPageToken
+		return m.ProcessingQueueStates
 	}
 	return nil
 }
 
-type PurgeDLQMessagesRequest struct {
-	Type                  v12.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
-	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
-	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
-	XXX_unrecognized      []byte            `json:"-"`
-	XXX_sizecache         int32             `json:"-"`
+type ListThrottledWorkflowIDsRequest struct {
+	ShardId              int32    `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PurgeDLQMessagesRequest) Reset()         { *m = PurgeDLQMessagesRequest{} }
-func (m *PurgeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
-func (*PurgeDLQMessagesRequest) ProtoMessage()    {}
-func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
+func (m *ListThrottledWorkflowIDsRequest) Reset()         { *m = ListThrottledWorkflowIDsRequest{} }
+func (m *ListThrottledWorkflowIDsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListThrottledWorkflowIDsRequest) ProtoMessage()    {}
+func (*ListThrottledWorkflowIDsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{74}
 }
-func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListThrottledWorkflowIDsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PurgeDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListThrottledWorkflowIDsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PurgeDLQMessagesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListThrottledWorkflowIDsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4686,64 +4619,44 @@ func (m *PurgeDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *PurgeDLQMessagesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PurgeDLQMessagesRequest.Merge(m, src)
+func (m *ListThrottledWorkflowIDsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListThrottledWorkflowIDsRequest.Merge(m, src)
 }
-func (m *PurgeDLQMessagesRequest) XXX_Size() int {
+func (m *ListThrottledWorkflowIDsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PurgeDLQMessagesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PurgeDLQMessagesRequest.DiscardUnknown(m)
+func (m *ListThrottledWorkflowIDsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListThrottledWorkflowIDsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PurgeDLQMessagesRequest proto.InternalMessageInfo
-
-func (m *PurgeDLQMessagesRequest) GetType() v12.DLQType {
-	if m != nil {
-		return m.Type
-	}
-	return v12.DLQType_DLQ_TYPE_INVALID
-}
+var xxx_messageInfo_ListThrottledWorkflowIDsRequest proto.InternalMessageInfo
 
-func (m *PurgeDLQMessagesRequest) GetShardId() int32 {
+func (m *ListThrottledWorkflowIDsRequest) GetShardId() int32 {
 	if m != nil {
 		return m.ShardId
 	}
 	return 0
 }
 
-func (m *PurgeDLQMessagesRequest) GetSourceCluster() string {
-	if m != nil {
-		return m.SourceCluster
-	}
-	return ""
-}
-
-func (m *PurgeDLQMessagesRequest) GetInclusiveEndMessageId() *types.Int64Value {
-	if m != nil {
-		return m.InclusiveEndMessageId
-	}
-	return nil
-}
-
-type PurgeDLQMessagesResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ListThrottledWorkflowIDsResponse struct {
+	ThrottledWorkflowIds []*v12.ThrottledWorkflowID `protobuf:"bytes,1,rep,name=throttled_workflow_ids,json=throttledWorkflowIds,proto3" json:"throttled_workflow_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-func (m *PurgeDLQMessagesResponse) Reset()         { *m = PurgeDLQMessagesResponse{} }
-func (m *PurgeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
-func (*PurgeDLQMessagesResponse) ProtoMessage()    {}
-func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
+func (m *ListThrottledWorkflowIDsResponse) Reset()         { *m = ListThrottledWorkflowIDsResponse{} }
+func (m *ListThrottledWorkflowIDsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListThrottledWorkflowIDsResponse) ProtoMessage()    {}
+func (*ListThrottledWorkflowIDsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{75}
 }
-func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
+func (m *ListThrottledWorkflowIDsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PurgeDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListThrottledWorkflowIDsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PurgeDLQMessagesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListThrottledWorkflowIDsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4753,42 +4666,45 @@ func (m *PurgeDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *PurgeDLQMessagesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PurgeDLQMessagesResponse.Merge(m, src)
+func (m *ListThrottledWorkflowIDsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListThrottledWorkflowIDsResponse.Merge(m, src)
 }
-func (m *PurgeDLQMessagesResponse) XXX_Size() int {
+func (m *ListThrottledWorkflowIDsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *PurgeDLQMessagesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PurgeDLQMessagesResponse.DiscardUnknown(m)
+func (m *ListThrottledWorkflowIDsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListThrottledWorkflowIDsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PurgeDLQMessagesResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListThrottledWorkflowIDsResponse proto.InternalMessageInfo
 
-type MergeDLQMessagesRequest struct {
-	Type                  v12.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
-	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
-	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
-	PageSize              int32             `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken         []byte            `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
-	XXX_unrecognized      []byte            `json:"-"`
-	XXX_sizecache         int32             `json:"-"`
+func (m *ListThrottledWorkflowIDsResponse) GetThrottledWorkflowIds() []*v12.ThrottledWorkflowID {
+	if m != nil {
+		return m.ThrottledWorkflowIds
+	}
+	return nil
 }
 
-func (m *MergeDLQMessagesRequest) Reset()         { *m = MergeDLQMessagesRequest{} }
-func (m *MergeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
-func (*MergeDLQMessagesRequest) ProtoMessage()    {}
-func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
+type GetReplicationMessagesRequest struct {
+	Tokens               []*v12.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	ClusterName          string                  `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetReplicationMessagesRequest) Reset()         { *m = GetReplicationMessagesRequest{} }
+func (m *GetReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationMessagesRequest) ProtoMessage()    {}
+func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{76}
 }
-func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MergeDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetReplicationMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MergeDLQMessagesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetReplicationMessagesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4798,79 +4714,51 @@ func (m *MergeDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MergeDLQMessagesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MergeDLQMessagesRequest.Merge(m, src)
+func (m *GetReplicationMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationMessagesRequest.Merge(m, src)
 }
-func (m *MergeDLQMessagesRequest) XXX_Size() int {
+func (m *GetReplicationMessagesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MergeDLQMessagesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MergeDLQMessagesRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MergeDLQMessagesRequest proto.InternalMessageInfo
-
-func (m *MergeDLQMessagesRequest) GetType() v12.DLQType {
-	if m != nil {
-		return m.Type
-	}
-	return v12.DLQType_DLQ_TYPE_INVALID
-}
-
-func (m *MergeDLQMessagesRequest) GetShardId() int32 {
-	if m != nil {
-		return m.ShardId
-	}
-	return 0
+func (m *GetReplicationMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationMessagesRequest.DiscardUnknown(m)
 }
 
-func (m *MergeDLQMessagesRequest) GetSourceCluster() string {
-	if m != nil {
-		return m.SourceCluster
-	}
-	return ""
-}
+var xxx_messageInfo_GetReplicationMessagesRequest proto.InternalMessageInfo
 
-func (m *MergeDLQMessagesRequest) GetInclusiveEndMessageId() *types.Int64Value {
+func (m *GetReplicationMessagesRequest) GetTokens() []*v12.ReplicationToken {
 	if m != nil {
-		return m.InclusiveEndMessageId
+		return m.Tokens
 	}
 	return nil
 }
 
-func (m *MergeDLQMessagesRequest) GetPageSize() int32 {
-	if m != nil {
-		return m.PageSize
-	}
-	return 0
-}
-
-func (m *MergeDLQMessagesRequest) GetNextPageToken() []byte {
+func (m *GetReplicationMessagesRequest) GetClusterName() string {
 	if m != nil {
-		return m.NextPageToken
+		return m.ClusterName
 	}
-	return nil
+	return ""
 }
 
-type MergeDLQMessagesResponse struct {
-	NextPageToken        []byte   `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetReplicationMessagesResponse struct {
+	ShardMessages        map[int32]*v12.ReplicationMessages `protobuf:"bytes,1,rep,name=shard_messages,json=shardMessages,proto3" json:"shard_messages,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
 }
 
-func (m *MergeDLQMessagesResponse) Reset()         { *m = MergeDLQMessagesResponse{} }
-func (m *MergeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
-func (*MergeDLQMessagesResponse) ProtoMessage()    {}
-func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
+func (m *GetReplicationMessagesResponse) Reset()         { *m = GetReplicationMessagesResponse{} }
+func (m *GetReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationMessagesResponse) ProtoMessage()    {}
+func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{77}
 }
-func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MergeDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetReplicationMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MergeDLQMessagesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetReplicationMessagesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4880,44 +4768,44 @@ func (m *MergeDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MergeDLQMessagesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MergeDLQMessagesResponse.Merge(m, src)
+func (m *GetReplicationMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationMessagesResponse.Merge(m, src)
 }
-func (m *MergeDLQMessagesResponse) XXX_Size() int {
+func (m *GetReplicationMessagesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MergeDLQMessagesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MergeDLQMessagesResponse.DiscardUnknown(m)
+func (m *GetReplicationMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationMessagesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MergeDLQMessagesResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetReplicationMessagesResponse proto.InternalMessageInfo
 
-func (m *MergeDLQMessagesResponse) GetNextPageToken() []byte {
+func (m *GetReplicationMessagesResponse) GetShardMessages() map[int32]*v12.ReplicationMessages {
 	if m != nil {
-		return m.NextPageToken
+		return m.ShardMessages
 	}
 	return nil
 }
 
-type NotifyFailoverMarkersRequest struct {
-	FailoverMarkerTokens []*v12.FailoverMarkerToken `protobuf:"bytes,1,rep,name=failover_marker_tokens,json=failoverMarkerTokens,proto3" json:"failover_marker_tokens,omitempty"`
+type GetDLQReplicationMessagesRequest struct {
+	TaskInfos            []*v12.ReplicationTaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
 }
 
-func (m *NotifyFailoverMarkersRequest) Reset()         { *m = NotifyFailoverMarkersRequest{} }
-func (m *NotifyFailoverMarkersRequest) String() string { return proto.CompactTextString(m) }
-func (*NotifyFailoverMarkersRequest) ProtoMessage()    {}
-func (*NotifyFailoverMarkersRequest) Descriptor() ([]byte, []int) {
+func (m *GetDLQReplicationMessagesRequest) Reset()         { *m = GetDLQReplicationMessagesRequest{} }
+func (m *GetDLQReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDLQReplicationMessagesRequest) ProtoMessage()    {}
+func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{78}
 }
-func (m *NotifyFailoverMarkersRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetDLQReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NotifyFailoverMarkersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetDLQReplicationMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NotifyFailoverMarkersRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetDLQReplicationMessagesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4927,43 +4815,44 @@ func (m *NotifyFailoverMarkersRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *NotifyFailoverMarkersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NotifyFailoverMarkersRequest.Merge(m, src)
+func (m *GetDLQReplicationMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDLQReplicationMessagesRequest.Merge(m, src)
 }
-func (m *NotifyFailoverMarkersRequest) XXX_Size() int {
+func (m *GetDLQReplicationMessagesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *NotifyFailoverMarkersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_NotifyFailoverMarkersRequest.DiscardUnknown(m)
+func (m *GetDLQReplicationMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDLQReplicationMessagesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NotifyFailoverMarkersRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetDLQReplicationMessagesRequest proto.InternalMessageInfo
 
-func (m *NotifyFailoverMarkersRequest) GetFailoverMarkerTokens() []*v12.FailoverMarkerToken {
+func (m *GetDLQReplicationMessagesRequest) GetTaskInfos() []*v12.ReplicationTaskInfo {
 	if m != nil {
-		return m.FailoverMarkerTokens
+		return m.TaskInfos
 	}
 	return nil
 }
 
-type NotifyFailoverMarkersResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetDLQReplicationMessagesResponse struct {
+	ReplicationTasks     []*v12.ReplicationTask `protobuf:"bytes,1,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *NotifyFailoverMarkersResponse) Reset()         { *m = NotifyFailoverMarkersResponse{} }
-func (m *NotifyFailoverMarkersResponse) String() string { return proto.CompactTextString(m) }
-func (*NotifyFailoverMarkersResponse) ProtoMessage()    {}
-func (*NotifyFailoverMarkersResponse) Descriptor() ([]byte, []int) {
+func (m *GetDLQReplicationMessagesResponse) Reset()         { *m = GetDLQReplicationMessagesResponse{} }
+func (m *GetDLQReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDLQReplicationMessagesResponse) ProtoMessage()    {}
+func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{79}
 }
-func (m *NotifyFailoverMarkersResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetDLQReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NotifyFailoverMarkersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetDLQReplicationMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NotifyFailoverMarkersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetDLQReplicationMessagesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4973,38 +4862,47 @@ func (m *NotifyFailoverMarkersResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *NotifyFailoverMarkersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NotifyFailoverMarkersResponse.Merge(m, src)
+func (m *GetDLQReplicationMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDLQReplicationMessagesResponse.Merge(m, src)
 }
-func (m *NotifyFailoverMarkersResponse) XXX_Size() int {
+func (m *GetDLQReplicationMessagesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *NotifyFailoverMarkersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_NotifyFailoverMarkersResponse.DiscardUnknown(m)
+func (m *GetDLQReplicationMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDLQReplicationMessagesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NotifyFailoverMarkersResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetDLQReplicationMessagesResponse proto.InternalMessageInfo
 
-type GetCrossClusterTasksRequest struct {
-	ShardIds             []int32  `protobuf:"varint,1,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
-	TargetCluster        string   `protobuf:"bytes,2,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetDLQReplicationMessagesResponse) GetReplicationTasks() []*v12.ReplicationTask {
+	if m != nil {
+		return m.ReplicationTasks
+	}
+	return nil
 }
 
-func (m *GetCrossClusterTasksRequest) Reset()         { *m = GetCrossClusterTasksRequest{} }
-func (m *GetCrossClusterTasksRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCrossClusterTasksRequest) ProtoMessage()    {}
-func (*GetCrossClusterTasksRequest) Descriptor() ([]byte, []int) {
+type ReapplyEventsRequest struct {
+	Domain               string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	DomainId             string                `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,3,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Events               *v1.DataBlob          `protobuf:"bytes,4,opt,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReapplyEventsRequest) Reset()         { *m = ReapplyEventsRequest{} }
+func (m *ReapplyEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReapplyEventsRequest) ProtoMessage()    {}
+func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{80}
 }
-func (m *GetCrossClusterTasksRequest) XXX_Unmarshal(b []byte) error {
+func (m *ReapplyEventsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetCrossClusterTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReapplyEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetCrossClusterTasksRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReapplyEventsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5014,52 +4912,64 @@ func (m *GetCrossClusterTasksRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *GetCrossClusterTasksRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCrossClusterTasksRequest.Merge(m, src)
+func (m *ReapplyEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReapplyEventsRequest.Merge(m, src)
 }
-func (m *GetCrossClusterTasksRequest) XXX_Size() int {
+func (m *ReapplyEventsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetCrossClusterTasksRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCrossClusterTasksRequest.DiscardUnknown(m)
+func (m *ReapplyEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReapplyEventsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCrossClusterTasksRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReapplyEventsRequest proto.InternalMessageInfo
 
-func (m *GetCrossClusterTasksRequest) GetShardIds() []int32 {
+func (m *ReapplyEventsRequest) GetDomain() string {
 	if m != nil {
-		return m.ShardIds
+		return m.Domain
 	}
-	return nil
+	return ""
 }
 
-func (m *GetCrossClusterTasksRequest) GetTargetCluster() string {
+func (m *ReapplyEventsRequest) GetDomainId() string {
 	if m != nil {
-		return m.TargetCluster
+		return m.DomainId
 	}
 	return ""
 }
 
-type GetCrossClusterTasksResponse struct {
-	TasksByShard         map[int32]*v12.CrossClusterTaskRequests `protobuf:"bytes,1,rep,name=tasks_by_shard,json=tasksByShard,proto3" json:"tasks_by_shard,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	FailedCauseByShard   map[int32]v12.GetTaskFailedCause        `protobuf:"bytes,2,rep,name=failed_cause_by_shard,json=failedCauseByShard,proto3" json:"failed_cause_by_shard,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=uber.cadence.admin.v1.GetTaskFailedCause"`
-	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
-	XXX_unrecognized     []byte                                  `json:"-"`
-	XXX_sizecache        int32                                   `json:"-"`
+func (m *ReapplyEventsRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
 }
 
-func (m *GetCrossClusterTasksResponse) Reset()         { *m = GetCrossClusterTasksResponse{} }
-func (m *GetCrossClusterTasksResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCrossClusterTasksResponse) ProtoMessage()    {}
-func (*GetCrossClusterTasksResponse) Descriptor() ([]byte, []int) {
+func (m *ReapplyEventsRequest) GetEvents() *v1.DataBlob {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type ReapplyEventsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReapplyEventsResponse) Reset()         { *m = ReapplyEventsResponse{} }
+func (m *ReapplyEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ReapplyEventsResponse) ProtoMessage()    {}
+func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{81}
 }
-func (m *GetCrossClusterTasksResponse) XXX_Unmarshal(b []byte) error {
+func (m *ReapplyEventsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetCrossClusterTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReapplyEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetCrossClusterTasksResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReapplyEventsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5069,56 +4979,39 @@ func (m *GetCrossClusterTasksResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *GetCrossClusterTasksResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCrossClusterTasksResponse.Merge(m, src)
-}
-func (m *GetCrossClusterTasksResponse) XXX_Size() int {
-	return m.Size()
+func (m *ReapplyEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReapplyEventsResponse.Merge(m, src)
 }
-func (m *GetCrossClusterTasksResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCrossClusterTasksResponse.DiscardUnknown(m)
+func (m *ReapplyEventsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-var xxx_messageInfo_GetCrossClusterTasksResponse proto.InternalMessageInfo
-
-func (m *GetCrossClusterTasksResponse) GetTasksByShard() map[int32]*v12.CrossClusterTaskRequests {
-	if m != nil {
-		return m.TasksByShard
-	}
-	return nil
+func (m *ReapplyEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReapplyEventsResponse.DiscardUnknown(m)
 }
 
-func (m *GetCrossClusterTasksResponse) GetFailedCauseByShard() map[int32]v12.GetTaskFailedCause {
-	if m != nil {
-		return m.FailedCauseByShard
-	}
-	return nil
-}
+var xxx_messageInfo_ReapplyEventsResponse proto.InternalMessageInfo
 
-type RespondCrossClusterTasksCompletedRequest struct {
-	ShardId              int32                           `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	TargetCluster        string                          `protobuf:"bytes,2,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
-	TaskResponses        []*v12.CrossClusterTaskResponse `protobuf:"bytes,3,rep,name=task_responses,json=taskResponses,proto3" json:"task_responses,omitempty"`
-	FetchNewTasks        bool                            `protobuf:"varint,4,opt,name=fetchNewTasks,proto3" json:"fetchNewTasks,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+type RefreshWorkflowTasksRequest struct {
+	Domain               string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	DomainId             string                `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,3,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) Reset() {
-	*m = RespondCrossClusterTasksCompletedRequest{}
-}
-func (m *RespondCrossClusterTasksCompletedRequest) String() string { return proto.CompactTextString(m) }
-func (*RespondCrossClusterTasksCompletedRequest) ProtoMessage()    {}
-func (*RespondCrossClusterTasksCompletedRequest) Descriptor() ([]byte, []int) {
+func (m *RefreshWorkflowTasksRequest) Reset()         { *m = RefreshWorkflowTasksRequest{} }
+func (m *RefreshWorkflowTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshWorkflowTasksRequest) ProtoMessage()    {}
+func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{82}
 }
-func (m *RespondCrossClusterTasksCompletedRequest) XXX_Unmarshal(b []byte) error {
+func (m *RefreshWorkflowTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RespondCrossClusterTasksCompletedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RefreshWorkflowTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RefreshWorkflowTasksRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5128,69 +5021,57 @@ func (m *RespondCrossClusterTasksCompletedRequest) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *RespondCrossClusterTasksCompletedRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.Merge(m, src)
+func (m *RefreshWorkflowTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshWorkflowTasksRequest.Merge(m, src)
 }
-func (m *RespondCrossClusterTasksCompletedRequest) XXX_Size() int {
+func (m *RefreshWorkflowTasksRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RespondCrossClusterTasksCompletedRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.DiscardUnknown(m)
+func (m *RefreshWorkflowTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshWorkflowTasksRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RespondCrossClusterTasksCompletedRequest proto.InternalMessageInfo
+var xxx_messageInfo_RefreshWorkflowTasksRequest proto.InternalMessageInfo
 
-func (m *RespondCrossClusterTasksCompletedRequest) GetShardId() int32 {
+func (m *RefreshWorkflowTasksRequest) GetDomain() string {
 	if m != nil {
-		return m.ShardId
+		return m.Domain
 	}
-	return 0
+	return ""
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) GetTargetCluster() string {
+func (m *RefreshWorkflowTasksRequest) GetDomainId() string {
 	if m != nil {
-		return m.TargetCluster
+		return m.DomainId
 	}
 	return ""
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) GetTaskResponses() []*v12.CrossClusterTaskResponse {
+func (m *RefreshWorkflowTasksRequest) GetWorkflowExecution() *v1.WorkflowExecution {
 	if m != nil {
-		return m.TaskResponses
+		return m.WorkflowExecution
 	}
 	return nil
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) GetFetchNewTasks() bool {
-	if m != nil {
-		return m.FetchNewTasks
-	}
-	return false
-}
-
-type RespondCrossClusterTasksCompletedResponse struct {
-	Tasks                *v12.CrossClusterTaskRequests `protobuf:"bytes,1,opt,name=tasks,proto3" json:"tasks,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+type RefreshWorkflowTasksResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RespondCrossClusterTasksCompletedResponse) Reset() {
-	*m = RespondCrossClusterTasksCompletedResponse{}
-}
-func (m *RespondCrossClusterTasksCompletedResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*RespondCrossClusterTasksCompletedResponse) ProtoMessage() {}
-func (*RespondCrossClusterTasksCompletedResponse) Descriptor() ([]byte, []int) {
+func (m *RefreshWorkflowTasksResponse) Reset()         { *m = RefreshWorkflowTasksResponse{} }
+func (m *RefreshWorkflowTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*RefreshWorkflowTasksResponse) ProtoMessage()    {}
+func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{83}
 }
-func (m *RespondCrossClusterTasksCompletedResponse) XXX_Unmarshal(b []byte) error {
+func (m *RefreshWorkflowTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RespondCrossClusterTasksCompletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RefreshWorkflowTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RefreshWorkflowTasksResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5200,44 +5081,37 @@ func (m *RespondCrossClusterTasksCompletedResponse) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *RespondCrossClusterTasksCompletedResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.Merge(m, src)
+func (m *RefreshWorkflowTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshWorkflowTasksResponse.Merge(m, src)
 }
-func (m *RespondCrossClusterTasksCompletedResponse) XXX_Size() int {
+func (m *RefreshWorkflowTasksResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RespondCrossClusterTasksCompletedResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.DiscardUnknown(m)
+func (m *RefreshWorkflowTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshWorkflowTasksResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RespondCrossClusterTasksCompletedResponse proto.InternalMessageInfo
-
-func (m *RespondCrossClusterTasksCompletedResponse) GetTasks() *v12.CrossClusterTaskRequests {
-	if m != nil {
-		return m.Tasks
-	}
-	return nil
-}
+var xxx_messageInfo_RefreshWorkflowTasksResponse proto.InternalMessageInfo
 
-type GetFailoverInfoRequest struct {
-	DomainId             string   `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+type CountDLQMessagesRequest struct {
+	ForceFetch           bool     `protobuf:"varint,1,opt,name=forceFetch,proto3" json:"forceFetch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetFailoverInfoRequest) Reset()         { *m = GetFailoverInfoRequest{} }
-func (m *GetFailoverInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetFailoverInfoRequest) ProtoMessage()    {}
-func (*GetFailoverInfoRequest) Descriptor() ([]byte, []int) {
+func (m *CountDLQMessagesRequest) Reset()         { *m = CountDLQMessagesRequest{} }
+func (m *CountDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*CountDLQMessagesRequest) ProtoMessage()    {}
+func (*CountDLQMessagesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{84}
 }
-func (m *GetFailoverInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *CountDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetFailoverInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CountDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetFailoverInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CountDLQMessagesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5247,45 +5121,44 @@ func (m *GetFailoverInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *GetFailoverInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFailoverInfoRequest.Merge(m, src)
+func (m *CountDLQMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountDLQMessagesRequest.Merge(m, src)
 }
-func (m *GetFailoverInfoRequest) XXX_Size() int {
+func (m *CountDLQMessagesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetFailoverInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFailoverInfoRequest.DiscardUnknown(m)
+func (m *CountDLQMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountDLQMessagesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFailoverInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_CountDLQMessagesRequest proto.InternalMessageInfo
 
-func (m *GetFailoverInfoRequest) GetDomainId() string {
+func (m *CountDLQMessagesRequest) GetForceFetch() bool {
 	if m != nil {
-		return m.DomainId
+		return m.ForceFetch
 	}
-	return ""
+	return false
 }
 
-type GetFailoverInfoResponse struct {
-	CompletedShardCount  int32    `protobuf:"varint,1,opt,name=completed_shard_count,json=completedShardCount,proto3" json:"completed_shard_count,omitempty"`
-	PendingShards        []int32  `protobuf:"varint,2,rep,packed,name=pending_shards,json=pendingShards,proto3" json:"pending_shards,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CountDLQMessagesResponse struct {
+	Entries              []*v12.HistoryDLQCountEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
-func (m *GetFailoverInfoResponse) Reset()         { *m = GetFailoverInfoResponse{} }
-func (m *GetFailoverInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetFailoverInfoResponse) ProtoMessage()    {}
-func (*GetFailoverInfoResponse) Descriptor() ([]byte, []int) {
+func (m *CountDLQMessagesResponse) Reset()         { *m = CountDLQMessagesResponse{} }
+func (m *CountDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*CountDLQMessagesResponse) ProtoMessage()    {}
+func (*CountDLQMessagesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{85}
 }
-func (m *GetFailoverInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *CountDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetFailoverInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CountDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetFailoverInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CountDLQMessagesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5295,59 +5168,50 @@ func (m *GetFailoverInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *GetFailoverInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFailoverInfoResponse.Merge(m, src)
+func (m *CountDLQMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountDLQMessagesResponse.Merge(m, src)
 }
-func (m *GetFailoverInfoResponse) XXX_Size() int {
+func (m *CountDLQMessagesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetFailoverInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFailoverInfoResponse.DiscardUnknown(m)
+func (m *CountDLQMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountDLQMessagesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFailoverInfoResponse proto.InternalMessageInfo
-
-func (m *GetFailoverInfoResponse) GetCompletedShardCount() int32 {
-	if m != nil {
-		return m.CompletedShardCount
-	}
-	return 0
-}
+var xxx_messageInfo_CountDLQMessagesResponse proto.InternalMessageInfo
 
-func (m *GetFailoverInfoResponse) GetPendingShards() []int32 {
+func (m *CountDLQMessagesResponse) GetEntries() []*v12.HistoryDLQCountEntry {
 	if m != nil {
-		return m.PendingShards
+		return m.Entries
 	}
 	return nil
 }
 
-type RatelimitUpdateRequest struct {
-	// impl-specific data.
-	// likely some simple top-level keys and then either:
-	// - map<ratelimit-key-string, something>
-	// - list<something>
-	//
-	// this is a single blob rather than a collection to save on
-	// repeated serialization of the type name, and to allow impls
-	// to choose whatever structures are most-convenient for them.
-	Data                 *v11.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ReadDLQMessagesRequest struct {
+	Type                  v12.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
+	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	PageSize              int32             `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken         []byte            `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Filter                *v12.DLQFilter    `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
+	XXX_unrecognized      []byte            `json:"-"`
+	XXX_sizecache         int32             `json:"-"`
 }
 
-func (m *RatelimitUpdateRequest) Reset()         { *m = RatelimitUpdateRequest{} }
-func (m *RatelimitUpdateRequest) String() string { return proto.CompactTextString(m) }
-func (*RatelimitUpdateRequest) ProtoMessage()    {}
-func (*RatelimitUpdateRequest) Descriptor() ([]byte, []int) {
+func (m *ReadDLQMessagesRequest) Reset()         { *m = ReadDLQMessagesRequest{} }
+func (m *ReadDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadDLQMessagesRequest) ProtoMessage()    {}
+func (*ReadDLQMessagesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{86}
 }
-func (m *RatelimitUpdateRequest) XXX_Unmarshal(b []byte) error {
+func (m *ReadDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RatelimitUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReadDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RatelimitUpdateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReadDLQMessagesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5357,53 +5221,89 @@ func (m *RatelimitUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *RatelimitUpdateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RatelimitUpdateRequest.Merge(m, src)
+func (m *ReadDLQMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadDLQMessagesRequest.Merge(m, src)
 }
-func (m *RatelimitUpdateRequest) XXX_Size() int {
+func (m *ReadDLQMessagesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RatelimitUpdateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RatelimitUpdateRequest.DiscardUnknown(m)
+func (m *ReadDLQMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadDLQMessagesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RatelimitUpdateRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReadDLQMessagesRequest proto.InternalMessageInfo
 
-func (m *RatelimitUpdateRequest) GetData() *v11.Any {
+func (m *ReadDLQMessagesRequest) GetType() v12.DLQType {
 	if m != nil {
-		return m.Data
+		return m.Type
+	}
+	return v12.DLQType_DLQ_TYPE_INVALID
+}
+
+func (m *ReadDLQMessagesRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *ReadDLQMessagesRequest) GetSourceCluster() string {
+	if m != nil {
+		return m.SourceCluster
+	}
+	return ""
+}
+
+func (m *ReadDLQMessagesRequest) GetInclusiveEndMessageId() *types.Int64Value {
+	if m != nil {
+		return m.InclusiveEndMessageId
 	}
 	return nil
 }
 
-type RatelimitUpdateResponse struct {
-	// impl-specific data.
-	//
-	// likely some simple top-level keys and then either:
-	// - map<ratelimit-key-string, something>
-	// - list<something>
-	//
-	// this is a single blob rather than a collection to save on
-	// repeated serialization of the type name, and to allow impls
-	// to choose whatever structures are most-convenient for them.
-	Data                 *v11.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ReadDLQMessagesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
 }
 
-func (m *RatelimitUpdateResponse) Reset()         { *m = RatelimitUpdateResponse{} }
-func (m *RatelimitUpdateResponse) String() string { return proto.CompactTextString(m) }
-func (*RatelimitUpdateResponse) ProtoMessage()    {}
-func (*RatelimitUpdateResponse) Descriptor() ([]byte, []int) {
+func (m *ReadDLQMessagesRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+func (m *ReadDLQMessagesRequest) GetFilter() *v12.DLQFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type ReadDLQMessagesResponse struct {
+	Type                 v12.DLQType                `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
+	ReplicationTasks     []*v12.ReplicationTask     `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
+	ReplicationTasksInfo []*v12.ReplicationTaskInfo `protobuf:"bytes,3,rep,name=replication_tasks_info,json=replicationTasksInfo,proto3" json:"replication_tasks_info,omitempty"`
+	NextPageToken        []byte                     `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ReadDLQMessagesResponse) Reset()         { *m = ReadDLQMessagesResponse{} }
+func (m *ReadDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadDLQMessagesResponse) ProtoMessage()    {}
+func (*ReadDLQMessagesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fee8ff76963a38ed, []int{87}
 }
-func (m *RatelimitUpdateResponse) XXX_Unmarshal(b []byte) error {
+func (m *ReadDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RatelimitUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReadDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RatelimitUpdateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReadDLQMessagesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5413,1823 +5313,1285 @@ func (m *RatelimitUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *RatelimitUpdateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RatelimitUpdateResponse.Merge(m, src)
+func (m *ReadDLQMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadDLQMessagesResponse.Merge(m, src)
 }
-func (m *RatelimitUpdateResponse) XXX_Size() int {
+func (m *ReadDLQMessagesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RatelimitUpdateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RatelimitUpdateResponse.DiscardUnknown(m)
+func (m *ReadDLQMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadDLQMessagesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RatelimitUpdateResponse proto.InternalMessageInfo
+var xxx_messageInfo_ReadDLQMessagesResponse proto.InternalMessageInfo
 
-func (m *RatelimitUpdateResponse) GetData() *v11.Any {
+func (m *ReadDLQMessagesResponse) GetType() v12.DLQType {
 	if m != nil {
-		return m.Data
+		return m.Type
 	}
-	return nil
-}
-
-func init() {
-	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest")
-	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest.PartitionConfigEntry")
-	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.StartWorkflowExecutionResponse")
-	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.SignalWorkflowExecutionRequest")
-	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.SignalWorkflowExecutionResponse")
-	proto.RegisterType((*SignalWithStartWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.SignalWithStartWorkflowExecutionRequest")
-	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.history.v1.SignalWithStartWorkflowExecutionRequest.PartitionConfigEntry")
-	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.SignalWithStartWorkflowExecutionResponse")
-	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.ResetWorkflowExecutionRequest")
-	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.ResetWorkflowExecutionResponse")
-	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionRequest")
-	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionResponse")
-	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionRequest")
-	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionResponse")
-	proto.RegisterType((*QueryWorkflowRequest)(nil), "uber.cadence.history.v1.QueryWorkflowRequest")
-	proto.RegisterType((*QueryWorkflowResponse)(nil), "uber.cadence.history.v1.QueryWorkflowResponse")
-	proto.RegisterType((*ResetStickyTaskListRequest)(nil), "uber.cadence.history.v1.ResetStickyTaskListRequest")
-	proto.RegisterType((*ResetStickyTaskListResponse)(nil), "uber.cadence.history.v1.ResetStickyTaskListResponse")
-	proto.RegisterType((*GetMutableStateRequest)(nil), "uber.cadence.history.v1.GetMutableStateRequest")
-	proto.RegisterType((*GetMutableStateResponse)(nil), "uber.cadence.history.v1.GetMutableStateResponse")
-	proto.RegisterType((*PollMutableStateRequest)(nil), "uber.cadence.history.v1.PollMutableStateRequest")
-	proto.RegisterType((*PollMutableStateResponse)(nil), "uber.cadence.history.v1.PollMutableStateResponse")
-	proto.RegisterType((*RecordDecisionTaskStartedRequest)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedRequest")
-	proto.RegisterType((*RecordDecisionTaskStartedResponse)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedResponse")
-	proto.RegisterMapType((map[string]*v1.WorkflowQuery)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedResponse.QueriesEntry")
-	proto.RegisterType((*RecordActivityTaskStartedRequest)(nil), "uber.cadence.history.v1.RecordActivityTaskStartedRequest")
-	proto.RegisterType((*RecordActivityTaskStartedResponse)(nil), "uber.cadence.history.v1.RecordActivityTaskStartedResponse")
-	proto.RegisterType((*RespondDecisionTaskCompletedRequest)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedRequest")
-	proto.RegisterType((*RespondDecisionTaskCompletedResponse)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedResponse")
-	proto.RegisterMapType((map[string]*v1.ActivityLocalDispatchInfo)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedResponse.ActivitiesToDispatchLocallyEntry")
-	proto.RegisterType((*RespondDecisionTaskFailedRequest)(nil), "uber.cadence.history.v1.RespondDecisionTaskFailedRequest")
-	proto.RegisterType((*RespondDecisionTaskFailedResponse)(nil), "uber.cadence.history.v1.RespondDecisionTaskFailedResponse")
-	proto.RegisterType((*RecordActivityTaskHeartbeatRequest)(nil), "uber.cadence.history.v1.RecordActivityTaskHeartbeatRequest")
-	proto.RegisterType((*RecordActivityTaskHeartbeatResponse)(nil), "uber.cadence.history.v1.RecordActivityTaskHeartbeatResponse")
-	proto.RegisterType((*RespondActivityTaskCompletedRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskCompletedRequest")
-	proto.RegisterType((*RespondActivityTaskCompletedResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskCompletedResponse")
-	proto.RegisterType((*RespondActivityTaskFailedRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskFailedRequest")
-	proto.RegisterType((*RespondActivityTaskFailedResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskFailedResponse")
-	proto.RegisterType((*RespondActivityTaskCanceledRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskCanceledRequest")
-	proto.RegisterType((*RespondActivityTaskCanceledResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskCanceledResponse")
-	proto.RegisterType((*RemoveSignalMutableStateRequest)(nil), "uber.cadence.history.v1.RemoveSignalMutableStateRequest")
-	proto.RegisterType((*RemoveSignalMutableStateResponse)(nil), "uber.cadence.history.v1.RemoveSignalMutableStateResponse")
-	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.RequestCancelWorkflowExecutionRequest")
-	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.RequestCancelWorkflowExecutionResponse")
-	proto.RegisterType((*ScheduleDecisionTaskRequest)(nil), "uber.cadence.history.v1.ScheduleDecisionTaskRequest")
-	proto.RegisterType((*ScheduleDecisionTaskResponse)(nil), "uber.cadence.history.v1.ScheduleDecisionTaskResponse")
-	proto.RegisterType((*RecordChildExecutionCompletedRequest)(nil), "uber.cadence.history.v1.RecordChildExecutionCompletedRequest")
-	proto.RegisterType((*RecordChildExecutionCompletedResponse)(nil), "uber.cadence.history.v1.RecordChildExecutionCompletedResponse")
-	proto.RegisterType((*ReplicateEventsV2Request)(nil), "uber.cadence.history.v1.ReplicateEventsV2Request")
-	proto.RegisterType((*ReplicateEventsV2Response)(nil), "uber.cadence.history.v1.ReplicateEventsV2Response")
-	proto.RegisterType((*SyncShardStatusRequest)(nil), "uber.cadence.history.v1.SyncShardStatusRequest")
-	proto.RegisterType((*SyncShardStatusResponse)(nil), "uber.cadence.history.v1.SyncShardStatusResponse")
-	proto.RegisterType((*SyncActivityRequest)(nil), "uber.cadence.history.v1.SyncActivityRequest")
-	proto.RegisterType((*SyncActivityResponse)(nil), "uber.cadence.history.v1.SyncActivityResponse")
-	proto.RegisterType((*DescribeMutableStateRequest)(nil), "uber.cadence.history.v1.DescribeMutableStateRequest")
-	proto.RegisterType((*DescribeMutableStateResponse)(nil), "uber.cadence.history.v1.DescribeMutableStateResponse")
-	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "uber.cadence.history.v1.DescribeHistoryHostRequest")
-	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "uber.cadence.history.v1.DescribeHistoryHostResponse")
-	proto.RegisterType((*CloseShardRequest)(nil), "uber.cadence.history.v1.CloseShardRequest")
-	proto.RegisterType((*CloseShardResponse)(nil), "uber.cadence.history.v1.CloseShardResponse")
-	proto.RegisterType((*RemoveTaskRequest)(nil), "uber.cadence.history.v1.RemoveTaskRequest")
-	proto.RegisterType((*RemoveTaskResponse)(nil), "uber.cadence.history.v1.RemoveTaskResponse")
-	proto.RegisterType((*ResetQueueRequest)(nil), "uber.cadence.history.v1.ResetQueueRequest")
-	proto.RegisterType((*ResetQueueResponse)(nil), "uber.cadence.history.v1.ResetQueueResponse")
-	proto.RegisterType((*DescribeQueueRequest)(nil), "uber.cadence.history.v1.DescribeQueueRequest")
-	proto.RegisterType((*DescribeQueueResponse)(nil), "uber.cadence.history.v1.DescribeQueueResponse")
-	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "uber.cadence.history.v1.GetReplicationMessagesRequest")
-	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "uber.cadence.history.v1.GetReplicationMessagesResponse")
-	proto.RegisterMapType((map[int32]*v12.ReplicationMessages)(nil), "uber.cadence.history.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
-	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "uber.cadence.history.v1.GetDLQReplicationMessagesRequest")
-	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "uber.cadence.history.v1.GetDLQReplicationMessagesResponse")
-	proto.RegisterType((*ReapplyEventsRequest)(nil), "uber.cadence.history.v1.ReapplyEventsRequest")
-	proto.RegisterType((*ReapplyEventsResponse)(nil), "uber.cadence.history.v1.ReapplyEventsResponse")
-	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "uber.cadence.history.v1.RefreshWorkflowTasksRequest")
-	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "uber.cadence.history.v1.RefreshWorkflowTasksResponse")
-	proto.RegisterType((*CountDLQMessagesRequest)(nil), "uber.cadence.history.v1.CountDLQMessagesRequest")
-	proto.RegisterType((*CountDLQMessagesResponse)(nil), "uber.cadence.history.v1.CountDLQMessagesResponse")
-	proto.RegisterType((*ReadDLQMessagesRequest)(nil), "uber.cadence.history.v1.ReadDLQMessagesRequest")
-	proto.RegisterType((*ReadDLQMessagesResponse)(nil), "uber.cadence.history.v1.ReadDLQMessagesResponse")
-	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "uber.cadence.history.v1.PurgeDLQMessagesRequest")
-	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "uber.cadence.history.v1.PurgeDLQMessagesResponse")
-	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "uber.cadence.history.v1.MergeDLQMessagesRequest")
-	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "uber.cadence.history.v1.MergeDLQMessagesResponse")
-	proto.RegisterType((*NotifyFailoverMarkersRequest)(nil), "uber.cadence.history.v1.NotifyFailoverMarkersRequest")
-	proto.RegisterType((*NotifyFailoverMarkersResponse)(nil), "uber.cadence.history.v1.NotifyFailoverMarkersResponse")
-	proto.RegisterType((*GetCrossClusterTasksRequest)(nil), "uber.cadence.history.v1.GetCrossClusterTasksRequest")
-	proto.RegisterType((*GetCrossClusterTasksResponse)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse")
-	proto.RegisterMapType((map[int32]v12.GetTaskFailedCause)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse.FailedCauseByShardEntry")
-	proto.RegisterMapType((map[int32]*v12.CrossClusterTaskRequests)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse.TasksByShardEntry")
-	proto.RegisterType((*RespondCrossClusterTasksCompletedRequest)(nil), "uber.cadence.history.v1.RespondCrossClusterTasksCompletedRequest")
-	proto.RegisterType((*RespondCrossClusterTasksCompletedResponse)(nil), "uber.cadence.history.v1.RespondCrossClusterTasksCompletedResponse")
-	proto.RegisterType((*GetFailoverInfoRequest)(nil), "uber.cadence.history.v1.GetFailoverInfoRequest")
-	proto.RegisterType((*GetFailoverInfoResponse)(nil), "uber.cadence.history.v1.GetFailoverInfoResponse")
-	proto.RegisterType((*RatelimitUpdateRequest)(nil), "uber.cadence.history.v1.RatelimitUpdateRequest")
-	proto.RegisterType((*RatelimitUpdateResponse)(nil), "uber.cadence.history.v1.RatelimitUpdateResponse")
+	return v12.DLQType_DLQ_TYPE_INVALID
 }
 
-func init() {
-	proto.RegisterFile("uber/cadence/history/v1/service.proto", fileDescriptor_fee8ff76963a38ed)
+func (m *ReadDLQMessagesResponse) GetReplicationTasks() []*v12.ReplicationTask {
+	if m != nil {
+		return m.ReplicationTasks
+	}
+	return nil
 }
 
-var fileDescriptor_fee8ff76963a38ed = []byte{
-	// 4967 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x4d, 0x6c, 0x1c, 0x47,
-	0x76, 0x30, 0x9a, 0x23, 0xfe, 0x3d, 0x92, 0x43, 0xb2, 0xc4, 0x9f, 0xe1, 0x50, 0xa2, 0xc8, 0xb6,
-	0x65, 0xd3, 0xf2, 0x7a, 0x68, 0xd1, 0xb6, 0x2c, 0xdb, 0xf2, 0x6a, 0x25, 0x52, 0x92, 0xc7, 0x9f,
-	0x7e, 0x9b, 0xb4, 0xfc, 0x25, 0x48, 0xdc, 0xdb, 0xec, 0xae, 0x21, 0x3b, 0xea, 0xe9, 0x1e, 0x75,
-	0xf7, 0x90, 0x1a, 0x1f, 0x02, 0x27, 0x0e, 0x02, 0xec, 0x22, 0xc8, 0x6e, 0x16, 0x49, 0x10, 0x20,
-	0x40, 0x80, 0x60, 0x03, 0x2c, 0xd6, 0xc8, 0x2d, 0x01, 0x72, 0x48, 0x72, 0xca, 0x65, 0x8f, 0xb9,
-	0xe6, 0x16, 0x18, 0xbb, 0x87, 0x04, 0xc8, 0x29, 0x7b, 0x0e, 0x82, 0xfa, 0xe9, 0x9e, 0xfe, 0xa9,
-	0xae, 0x1e, 0x92, 0x41, 0xe4, 0x75, 0x7c, 0xe3, 0x54, 0xd5, 0x7b, 0xf5, 0xea, 0xd5, 0x7b, 0xaf,
-	0xdf, 0x5f, 0x11, 0x2e, 0x76, 0xf7, 0xb0, 0xbf, 0x61, 0x1a, 0x16, 0x76, 0x4d, 0xbc, 0x71, 0x60,
-	0x07, 0xa1, 0xe7, 0xf7, 0x36, 0x0e, 0x2f, 0x6f, 0x04, 0xd8, 0x3f, 0xb4, 0x4d, 0xdc, 0xe8, 0xf8,
-	0x5e, 0xe8, 0xa1, 0x45, 0xb2, 0xac, 0xc1, 0x97, 0x35, 0xf8, 0xb2, 0xc6, 0xe1, 0xe5, 0xfa, 0xca,
-	0xbe, 0xe7, 0xed, 0x3b, 0x78, 0x83, 0x2e, 0xdb, 0xeb, 0xb6, 0x36, 0xac, 0xae, 0x6f, 0x84, 0xb6,
-	0xe7, 0x32, 0xc0, 0xfa, 0x85, 0xec, 0x7c, 0x68, 0xb7, 0x71, 0x10, 0x1a, 0xed, 0x0e, 0x5f, 0x90,
-	0x43, 0x70, 0xe4, 0x1b, 0x9d, 0x0e, 0xf6, 0x03, 0x3e, 0xbf, 0x9a, 0x22, 0xd0, 0xe8, 0xd8, 0x84,
-	0x38, 0xd3, 0x6b, 0xb7, 0xe3, 0x2d, 0xd6, 0x44, 0x2b, 0x22, 0x12, 0x39, 0x15, 0xa2, 0x25, 0x4f,
-	0xbb, 0x38, 0x5e, 0xa0, 0x8a, 0x16, 0x84, 0x46, 0xf0, 0xc4, 0xb1, 0x83, 0x50, 0xb6, 0xe6, 0xc8,
-	0xf3, 0x9f, 0xb4, 0x1c, 0xef, 0x88, 0xaf, 0xb9, 0x24, 0x5a, 0xc3, 0x59, 0xa9, 0x67, 0xd6, 0xae,
-	0x97, 0xad, 0xc5, 0x3e, 0x5f, 0xf9, 0x42, 0x7a, 0xa5, 0xd5, 0xb6, 0x5d, 0xca, 0x05, 0xa7, 0x1b,
-	0x84, 0x65, 0x8b, 0xd2, 0x8c, 0x58, 0x13, 0x2f, 0x7a, 0xda, 0xc5, 0x5d, 0x7e, 0xd5, 0xf5, 0x97,
-	0xc5, 0x4b, 0x7c, 0xdc, 0x71, 0x6c, 0x33, 0x79, 0xb5, 0xe9, 0x9b, 0x09, 0x0e, 0x0c, 0x1f, 0x5b,
-	0x64, 0xa5, 0xe1, 0x46, 0xbb, 0xbd, 0x58, 0xb0, 0x22, 0x4d, 0xd3, 0xc5, 0x82, 0x55, 0x69, 0x76,
-	0xa9, 0x3f, 0x1f, 0x81, 0xf3, 0x3b, 0xa1, 0xe1, 0x87, 0x1f, 0xf3, 0xf1, 0x5b, 0xcf, 0xb0, 0xd9,
-	0x25, 0xf4, 0x68, 0xf8, 0x69, 0x17, 0x07, 0x21, 0xba, 0x0b, 0xa3, 0x3e, 0xfb, 0xb3, 0xa6, 0xac,
-	0x2a, 0xeb, 0x13, 0x9b, 0x9b, 0x8d, 0x94, 0xd8, 0x1a, 0x1d, 0xbb, 0x71, 0x78, 0xb9, 0x21, 0x45,
-	0xa2, 0x45, 0x28, 0xd0, 0x32, 0x8c, 0x5b, 0x5e, 0xdb, 0xb0, 0x5d, 0xdd, 0xb6, 0x6a, 0x43, 0xab,
-	0xca, 0xfa, 0xb8, 0x36, 0xc6, 0x06, 0x9a, 0x16, 0xfa, 0x0d, 0x98, 0xef, 0x18, 0x3e, 0x76, 0x43,
-	0x1d, 0x47, 0x08, 0x74, 0xdb, 0x6d, 0x79, 0xb5, 0x0a, 0xdd, 0x78, 0x5d, 0xb8, 0xf1, 0x43, 0x0a,
-	0x11, 0xef, 0xd8, 0x74, 0x5b, 0x9e, 0x76, 0xb6, 0x93, 0x1f, 0x44, 0x35, 0x18, 0x35, 0xc2, 0x10,
-	0xb7, 0x3b, 0x61, 0xed, 0xcc, 0xaa, 0xb2, 0x3e, 0xac, 0x45, 0x3f, 0xd1, 0x16, 0x4c, 0xe3, 0x67,
-	0x1d, 0x9b, 0xa9, 0x98, 0x4e, 0x74, 0xa9, 0x36, 0x4c, 0x77, 0xac, 0x37, 0x98, 0x1e, 0x35, 0x22,
-	0x3d, 0x6a, 0xec, 0x46, 0x8a, 0xa6, 0x55, 0xfb, 0x20, 0x64, 0x10, 0xb5, 0x60, 0xc9, 0xf4, 0xdc,
-	0xd0, 0x76, 0xbb, 0x58, 0x37, 0x02, 0xdd, 0xc5, 0x47, 0xba, 0xed, 0xda, 0xa1, 0x6d, 0x84, 0x9e,
-	0x5f, 0x1b, 0x59, 0x55, 0xd6, 0xab, 0x9b, 0xaf, 0x0a, 0x0f, 0xb0, 0xc5, 0xa1, 0x6e, 0x04, 0xf7,
-	0xf1, 0x51, 0x33, 0x02, 0xd1, 0x16, 0x4c, 0xe1, 0x38, 0x6a, 0xc2, 0x6c, 0x34, 0x63, 0xe9, 0x2d,
-	0xc3, 0x76, 0xba, 0x3e, 0xae, 0x8d, 0x52, 0x72, 0xcf, 0x09, 0xf1, 0xdf, 0x66, 0x6b, 0xb4, 0x99,
-	0x18, 0x8c, 0x8f, 0x20, 0x0d, 0x16, 0x1c, 0x23, 0x08, 0x75, 0xd3, 0x6b, 0x77, 0x1c, 0x4c, 0x0f,
-	0xef, 0xe3, 0xa0, 0xeb, 0x84, 0xb5, 0x31, 0x09, 0xbe, 0x87, 0x46, 0xcf, 0xf1, 0x0c, 0x4b, 0x9b,
-	0x23, 0xb0, 0x5b, 0x31, 0xa8, 0x46, 0x21, 0xd1, 0xff, 0x87, 0xe5, 0x96, 0xed, 0x07, 0xa1, 0x6e,
-	0x61, 0xd3, 0x0e, 0x28, 0x3f, 0x8d, 0xe0, 0x89, 0xbe, 0x67, 0x98, 0x4f, 0xbc, 0x56, 0xab, 0x36,
-	0x4e, 0x11, 0x2f, 0xe5, 0xf8, 0xba, 0xcd, 0x0d, 0x9c, 0x56, 0xa3, 0xd0, 0xdb, 0x1c, 0x78, 0xd7,
-	0x08, 0x9e, 0xdc, 0x64, 0xa0, 0xe8, 0x10, 0x66, 0x3a, 0x86, 0x1f, 0xda, 0x94, 0x4e, 0xd3, 0x73,
-	0x5b, 0xf6, 0x7e, 0x0d, 0x56, 0x2b, 0xeb, 0x13, 0x9b, 0xff, 0xaf, 0x51, 0x60, 0x48, 0xe5, 0x52,
-	0x49, 0x44, 0x87, 0xa1, 0xdb, 0xa2, 0xd8, 0x6e, 0xb9, 0xa1, 0xdf, 0xd3, 0xa6, 0x3b, 0xe9, 0xd1,
-	0xfa, 0x4d, 0x98, 0x13, 0x2d, 0x44, 0x33, 0x50, 0x79, 0x82, 0x7b, 0x54, 0x29, 0xc6, 0x35, 0xf2,
-	0x27, 0x9a, 0x83, 0xe1, 0x43, 0xc3, 0xe9, 0x62, 0x2e, 0xd8, 0xec, 0xc7, 0xbb, 0x43, 0x57, 0x15,
-	0xf5, 0x6d, 0x58, 0x29, 0x22, 0x25, 0xe8, 0x78, 0x6e, 0x80, 0xd1, 0x3c, 0x8c, 0xf8, 0x5d, 0xaa,
-	0x15, 0x0c, 0xe1, 0xb0, 0xdf, 0x75, 0x9b, 0x96, 0xfa, 0x57, 0x43, 0xb0, 0xb2, 0x63, 0xef, 0xbb,
-	0x86, 0x53, 0xa8, 0xa0, 0xf7, 0xb2, 0x0a, 0xfa, 0x86, 0x58, 0x41, 0xa5, 0x58, 0x06, 0xd4, 0xd0,
-	0x16, 0x2c, 0xe3, 0x67, 0x21, 0xf6, 0x5d, 0xc3, 0x89, 0x0d, 0x6f, 0x5f, 0x59, 0xb9, 0x9e, 0xbe,
-	0x24, 0xdc, 0x3f, 0xbf, 0xf3, 0x52, 0x84, 0x2a, 0x37, 0x85, 0x1a, 0x70, 0xd6, 0x3c, 0xb0, 0x1d,
-	0xab, 0xbf, 0x89, 0xe7, 0x3a, 0x3d, 0xaa, 0xb7, 0x63, 0xda, 0x2c, 0x9d, 0x8a, 0x80, 0x1e, 0xb8,
-	0x4e, 0x4f, 0x5d, 0x83, 0x0b, 0x85, 0xe7, 0x63, 0x0c, 0x56, 0x7f, 0x31, 0x04, 0x2f, 0xf3, 0x35,
-	0x76, 0x78, 0x20, 0xb7, 0x79, 0x8f, 0xb3, 0x2c, 0xbd, 0x26, 0x63, 0x69, 0x19, 0xba, 0x01, 0x79,
-	0xfb, 0x99, 0x22, 0x10, 0xf0, 0x0a, 0x15, 0xf0, 0x8f, 0x8a, 0x05, 0x7c, 0x30, 0x12, 0xfe, 0x17,
-	0x45, 0xfd, 0x06, 0xac, 0x97, 0x13, 0x25, 0x17, 0xfa, 0xef, 0x2b, 0x70, 0x5e, 0xc3, 0x01, 0x3e,
-	0xf5, 0x47, 0x49, 0x8a, 0x64, 0xb0, 0x6b, 0x21, 0xaa, 0x5b, 0x84, 0x46, 0x7e, 0x8a, 0x2f, 0x86,
-	0x60, 0x6d, 0x17, 0xfb, 0x6d, 0xdb, 0x35, 0x42, 0x5c, 0x78, 0x92, 0x87, 0xd9, 0x93, 0x5c, 0x11,
-	0x9e, 0xa4, 0x14, 0xd1, 0xaf, 0xb8, 0x02, 0xbf, 0x08, 0xaa, 0xec, 0x88, 0x5c, 0x87, 0x7f, 0xa8,
-	0xc0, 0xea, 0x36, 0x0e, 0x4c, 0xdf, 0xde, 0x2b, 0xe6, 0xe8, 0x83, 0x2c, 0x47, 0xdf, 0x12, 0x1e,
-	0xa7, 0x0c, 0xcf, 0x80, 0xe2, 0xf1, 0x5f, 0x15, 0x58, 0x93, 0xa0, 0xe2, 0x22, 0xe2, 0xc0, 0x62,
-	0xdf, 0xa5, 0x61, 0xaa, 0xcd, 0x3f, 0x78, 0x52, 0x9b, 0x9d, 0x43, 0xb8, 0x95, 0x04, 0xd5, 0x16,
-	0xb0, 0x70, 0x1c, 0xed, 0xc1, 0x62, 0xfe, 0x6e, 0x99, 0x27, 0x35, 0x44, 0x77, 0xbb, 0x34, 0xd8,
-	0x6e, 0xd4, 0x97, 0x9a, 0x3f, 0x12, 0x0d, 0xa3, 0x8f, 0x01, 0x75, 0xb0, 0x6b, 0xd9, 0xee, 0xbe,
-	0x6e, 0x98, 0xa1, 0x7d, 0x68, 0x87, 0x36, 0x0e, 0xb8, 0xb9, 0x2a, 0x70, 0xd4, 0xd8, 0xf2, 0x1b,
-	0x6c, 0x75, 0x8f, 0x22, 0x9f, 0xed, 0xa4, 0x06, 0x6d, 0x1c, 0xa0, 0x5f, 0x83, 0x99, 0x08, 0x31,
-	0x15, 0x13, 0x1f, 0xbb, 0xb5, 0x33, 0x14, 0x6d, 0x43, 0x86, 0x76, 0x8b, 0xac, 0x4d, 0x53, 0x3e,
-	0xdd, 0x49, 0x4c, 0xf9, 0xd8, 0x45, 0x3b, 0x7d, 0xd4, 0x91, 0x77, 0xc2, 0x1d, 0x3d, 0x29, 0xc5,
-	0x91, 0x33, 0x92, 0x42, 0x1a, 0x0d, 0xaa, 0xcf, 0x60, 0xee, 0x11, 0x89, 0x79, 0x22, 0xee, 0x45,
-	0x62, 0xb8, 0x95, 0x15, 0xc3, 0x57, 0x84, 0x7b, 0x88, 0x60, 0x07, 0x14, 0xbd, 0x1f, 0x2b, 0x30,
-	0x9f, 0x01, 0xe7, 0xe2, 0x76, 0x1d, 0x26, 0x69, 0x1c, 0x16, 0xb9, 0x73, 0xca, 0x00, 0xee, 0xdc,
-	0x04, 0x85, 0xe0, 0x5e, 0x5c, 0x13, 0xaa, 0x11, 0x82, 0xdf, 0xc2, 0x66, 0x88, 0x2d, 0x2e, 0x38,
-	0x6a, 0xf1, 0x19, 0x34, 0xbe, 0x52, 0x9b, 0x7a, 0x9a, 0xfc, 0xa9, 0xfe, 0x9e, 0x02, 0x75, 0x6a,
-	0x40, 0x77, 0x42, 0xdb, 0x7c, 0xd2, 0x23, 0x1e, 0xdd, 0x5d, 0x3b, 0x08, 0x23, 0x36, 0x35, 0xb3,
-	0x6c, 0xda, 0x28, 0xb6, 0xe4, 0x42, 0x0c, 0x03, 0x32, 0xeb, 0x3c, 0x2c, 0x0b, 0x71, 0x70, 0xcb,
-	0xf2, 0x9f, 0x0a, 0x2c, 0xdc, 0xc1, 0xe1, 0xbd, 0x6e, 0x68, 0xec, 0x39, 0x78, 0x27, 0x34, 0x42,
-	0xac, 0x89, 0xd0, 0x2a, 0x19, 0x7b, 0xfa, 0x11, 0x20, 0x81, 0x19, 0x1d, 0x3a, 0x96, 0x19, 0x9d,
-	0xcd, 0x69, 0x18, 0x7a, 0x03, 0x16, 0xf0, 0xb3, 0x0e, 0x65, 0xa0, 0xee, 0xe2, 0x67, 0xa1, 0x8e,
-	0x0f, 0x49, 0x58, 0x64, 0x5b, 0xd4, 0x42, 0x57, 0xb4, 0xb3, 0xd1, 0xec, 0x7d, 0xfc, 0x2c, 0xbc,
-	0x45, 0xe6, 0x9a, 0x16, 0x7a, 0x1d, 0xe6, 0xcc, 0xae, 0x4f, 0xe3, 0xa7, 0x3d, 0xdf, 0x70, 0xcd,
-	0x03, 0x3d, 0xf4, 0x9e, 0x50, 0xed, 0x51, 0xd6, 0x27, 0x35, 0xc4, 0xe7, 0x6e, 0xd2, 0xa9, 0x5d,
-	0x32, 0xa3, 0xfe, 0xdd, 0x38, 0x2c, 0xe6, 0x4e, 0xcd, 0x65, 0x48, 0x7c, 0x32, 0xe5, 0xb4, 0x27,
-	0xbb, 0x0d, 0x53, 0x31, 0xda, 0xb0, 0xd7, 0xc1, 0x9c, 0x57, 0x6b, 0x52, 0x8c, 0xbb, 0xbd, 0x0e,
-	0xd6, 0x26, 0x8f, 0x12, 0xbf, 0x90, 0x0a, 0x53, 0x22, 0xc6, 0x4c, 0xb8, 0x09, 0x86, 0x3c, 0x86,
-	0xa5, 0x8e, 0x8f, 0x0f, 0x6d, 0xaf, 0x1b, 0xe8, 0x01, 0xf1, 0x44, 0xb0, 0xd5, 0x5f, 0x7f, 0x86,
-	0xee, 0xbb, 0x9c, 0x8b, 0x44, 0x9a, 0x6e, 0x78, 0xe5, 0xcd, 0xc7, 0xc4, 0x9d, 0xd1, 0x16, 0x22,
-	0xe8, 0x1d, 0x06, 0x1c, 0xe1, 0x7d, 0x0d, 0xce, 0xd2, 0xb8, 0x89, 0x05, 0x3a, 0x31, 0xc6, 0x61,
-	0x4a, 0xc1, 0x0c, 0x99, 0xba, 0x4d, 0x66, 0xa2, 0xe5, 0xef, 0xc2, 0x38, 0x8d, 0x81, 0x1c, 0x3b,
-	0x08, 0x69, 0x24, 0x38, 0xb1, 0x79, 0x5e, 0xfc, 0x91, 0x8f, 0xa4, 0x72, 0x2c, 0xe4, 0x7f, 0xa1,
-	0x3b, 0x30, 0x13, 0x50, 0x89, 0xd5, 0xfb, 0x28, 0x46, 0x07, 0x41, 0x51, 0x0d, 0x52, 0x82, 0x8e,
-	0xde, 0x84, 0x05, 0xd3, 0xb1, 0x09, 0xa5, 0x8e, 0xbd, 0xe7, 0x1b, 0x7e, 0x4f, 0x3f, 0xc4, 0x3e,
-	0xb5, 0x80, 0x63, 0x54, 0xa4, 0xe7, 0xd8, 0xec, 0x5d, 0x36, 0xf9, 0x98, 0xcd, 0x25, 0xa0, 0x5a,
-	0xd8, 0x08, 0xbb, 0x3e, 0x8e, 0xa1, 0xc6, 0x93, 0x50, 0xb7, 0xd9, 0x64, 0x04, 0x75, 0x01, 0x26,
-	0x38, 0x94, 0xdd, 0xee, 0x38, 0x35, 0xa0, 0x4b, 0x81, 0x0d, 0x35, 0xdb, 0x1d, 0x07, 0x05, 0x70,
-	0x29, 0x7b, 0x2a, 0x3d, 0x30, 0x0f, 0xb0, 0xd5, 0x75, 0xb0, 0x1e, 0x7a, 0xec, 0xb2, 0x68, 0x20,
-	0xee, 0x75, 0xc3, 0xda, 0x44, 0x59, 0xcc, 0xf8, 0x62, 0xfa, 0xac, 0x3b, 0x1c, 0xd3, 0xae, 0x47,
-	0xef, 0x6d, 0x97, 0xa1, 0x21, 0x2e, 0x09, 0xbb, 0x2a, 0xe2, 0x3c, 0xf7, 0x0f, 0x32, 0x49, 0x73,
-	0x01, 0xb3, 0x74, 0x6a, 0x87, 0xcc, 0x44, 0xa7, 0x28, 0x52, 0xa7, 0xa9, 0x22, 0x75, 0x42, 0x77,
-	0xa1, 0x1a, 0xcb, 0x76, 0x40, 0x94, 0xa9, 0x56, 0xa5, 0x71, 0xff, 0xc5, 0xf4, 0x55, 0xb1, 0x64,
-	0x4c, 0x52, 0xbe, 0x99, 0xe6, 0xc5, 0x8a, 0x41, 0x7f, 0x22, 0x13, 0xe6, 0x62, 0x6c, 0xa6, 0xe3,
-	0x05, 0x98, 0xe3, 0x9c, 0xa6, 0x38, 0x2f, 0x0f, 0xe8, 0x30, 0x10, 0x40, 0x82, 0xaf, 0x1b, 0x68,
-	0xb1, 0x3e, 0xc7, 0x83, 0x44, 0xcb, 0x67, 0x39, 0x23, 0x74, 0x16, 0x55, 0x90, 0xaf, 0xf8, 0x8c,
-	0xe8, 0x9b, 0xd8, 0xa7, 0x9a, 0x33, 0xe8, 0x83, 0x68, 0xbd, 0x36, 0x73, 0x98, 0x19, 0x41, 0xd7,
-	0x60, 0xd9, 0x26, 0x3a, 0x97, 0xb9, 0x63, 0xec, 0x12, 0x3b, 0x63, 0xd5, 0x66, 0xa9, 0x1b, 0xb8,
-	0x68, 0x07, 0x69, 0x6b, 0x7c, 0x8b, 0x4d, 0xa3, 0x35, 0x98, 0xe4, 0x21, 0x8e, 0x1e, 0xd8, 0x9f,
-	0xe2, 0x1a, 0x62, 0xaa, 0xcd, 0xc7, 0x76, 0xec, 0x4f, 0xb1, 0xfa, 0x4b, 0x05, 0x16, 0x1f, 0x7a,
-	0x8e, 0xf3, 0x7f, 0xcc, 0x60, 0xff, 0x64, 0x0c, 0x6a, 0xf9, 0x63, 0x7f, 0x63, 0xb1, 0xbf, 0xb1,
-	0xd8, 0x5f, 0x47, 0x8b, 0x5d, 0xa4, 0x1f, 0x93, 0x85, 0x16, 0x58, 0x68, 0xce, 0xa6, 0x4e, 0x6d,
-	0xce, 0x7e, 0xf5, 0x0c, 0xbb, 0xfa, 0x4f, 0x43, 0xb0, 0xaa, 0x61, 0xd3, 0xf3, 0xad, 0x64, 0x2e,
-	0x95, 0xab, 0xc5, 0xf3, 0xb4, 0x94, 0x17, 0x60, 0x22, 0x16, 0x9c, 0xd8, 0x08, 0x40, 0x34, 0xd4,
-	0xb4, 0xd0, 0x22, 0x8c, 0x52, 0x19, 0xe3, 0x1a, 0x5f, 0xd1, 0x46, 0xc8, 0xcf, 0xa6, 0x85, 0xce,
-	0x03, 0x70, 0x57, 0x3f, 0xd2, 0xdd, 0x71, 0x6d, 0x9c, 0x8f, 0x34, 0x2d, 0xa4, 0xc1, 0x64, 0xc7,
-	0x73, 0x1c, 0x3d, 0x0a, 0x27, 0x46, 0x24, 0xe1, 0x04, 0xb1, 0xa1, 0xb7, 0x3d, 0x3f, 0xc9, 0x9a,
-	0x28, 0x9c, 0x98, 0x20, 0x48, 0xf8, 0x0f, 0xf5, 0x77, 0xc7, 0x60, 0x4d, 0xc2, 0x45, 0x6e, 0x78,
-	0x73, 0x16, 0x52, 0x39, 0x99, 0x85, 0x94, 0x5a, 0xbf, 0xa1, 0x93, 0x5b, 0xbf, 0x6f, 0x01, 0x8a,
-	0xf8, 0x6b, 0x65, 0xcd, 0xef, 0x4c, 0x3c, 0x13, 0xad, 0x5e, 0x27, 0x06, 0x4c, 0x60, 0x7a, 0x2b,
-	0xc4, 0x42, 0xa5, 0xf0, 0xe6, 0x2c, 0xfa, 0x70, 0xde, 0xa2, 0x27, 0xaa, 0x2e, 0x23, 0xe9, 0xaa,
-	0xcb, 0x55, 0xa8, 0x71, 0x93, 0xd2, 0xcf, 0x51, 0x44, 0x0e, 0xc2, 0x28, 0x75, 0x10, 0x16, 0xd8,
-	0x7c, 0x2c, 0x3b, 0x91, 0x7f, 0xa0, 0xc1, 0x54, 0x5c, 0x5d, 0xa0, 0x59, 0x0d, 0x56, 0xae, 0x78,
-	0xad, 0x48, 0x1b, 0x77, 0x7d, 0xc3, 0x0d, 0x88, 0x29, 0x4b, 0x45, 0xf2, 0x93, 0x56, 0xe2, 0x17,
-	0xfa, 0x04, 0xce, 0x09, 0x72, 0x26, 0x7d, 0x13, 0x3e, 0x3e, 0x88, 0x09, 0x5f, 0xca, 0x89, 0x7b,
-	0x6c, 0xcd, 0x0b, 0xbc, 0x4f, 0x28, 0xf2, 0x3e, 0xd7, 0x60, 0x32, 0x65, 0xf3, 0x26, 0xa8, 0xcd,
-	0x9b, 0xd8, 0x4b, 0x18, 0xbb, 0x1b, 0x50, 0xed, 0x5f, 0x2b, 0xad, 0x5a, 0x4d, 0x96, 0x56, 0xad,
-	0xa6, 0x62, 0x08, 0x5a, 0xb4, 0x7a, 0x1f, 0x26, 0xa3, 0xbb, 0xa6, 0x08, 0xa6, 0x4a, 0x11, 0x4c,
-	0xf0, 0xf5, 0x14, 0xdc, 0x80, 0x51, 0x12, 0xec, 0x13, 0x23, 0x5b, 0xa5, 0x29, 0x9a, 0x3b, 0x85,
-	0x89, 0xea, 0x52, 0x2d, 0xa2, 0x59, 0x04, 0x1b, 0x07, 0x2c, 0x35, 0x1d, 0xe1, 0xcd, 0xf9, 0x82,
-	0xd3, 0x39, 0x5f, 0xb0, 0xfe, 0x09, 0x4c, 0x26, 0x61, 0x05, 0xd9, 0xea, 0xab, 0xc9, 0x6c, 0x75,
-	0x51, 0x16, 0x23, 0x52, 0x4c, 0x96, 0xcd, 0x48, 0x64, 0xb4, 0xfb, 0xa6, 0x34, 0xca, 0x5d, 0x7d,
-	0x63, 0x4a, 0x73, 0xa6, 0x34, 0xc9, 0x1a, 0xa1, 0x29, 0xfd, 0x79, 0x25, 0x32, 0xa5, 0x42, 0x2e,
-	0x72, 0x53, 0xfa, 0x21, 0x4c, 0x67, 0x4c, 0x95, 0xd4, 0x98, 0xb2, 0x4f, 0x74, 0x8f, 0x1a, 0x1b,
-	0xad, 0x9a, 0x36, 0x65, 0x39, 0xe1, 0x1e, 0x3a, 0x9e, 0x70, 0x27, 0x2c, 0x57, 0x25, 0x6d, 0xb9,
-	0x3e, 0x81, 0x95, 0xb4, 0xe2, 0xe9, 0x5e, 0x4b, 0x0f, 0x0f, 0xec, 0x40, 0x4f, 0x16, 0x98, 0xe5,
-	0x5b, 0xd5, 0x53, 0x8a, 0xf8, 0xa0, 0xb5, 0x7b, 0x60, 0x07, 0x37, 0x38, 0xfe, 0x26, 0xcc, 0x1e,
-	0x60, 0xc3, 0x0f, 0xf7, 0xb0, 0x11, 0xea, 0x16, 0x0e, 0x0d, 0xdb, 0x09, 0x78, 0xa2, 0x52, 0x9e,
-	0xc3, 0x9b, 0x89, 0xc1, 0xb6, 0x19, 0x54, 0xfe, 0xd3, 0x34, 0x72, 0xb2, 0x4f, 0xd3, 0xcb, 0x30,
-	0x1d, 0xe3, 0x61, 0x62, 0x4d, 0x6d, 0xf4, 0xb8, 0x16, 0x3b, 0x46, 0xdb, 0x74, 0x54, 0xfd, 0x53,
-	0x05, 0x5e, 0x60, 0xb7, 0x99, 0x52, 0x76, 0x5e, 0x27, 0xee, 0xeb, 0x8b, 0x96, 0xcd, 0xfb, 0x5d,
-	0x2d, 0xca, 0xfb, 0x95, 0xa1, 0x1a, 0x30, 0x01, 0xf8, 0x37, 0x15, 0x78, 0x51, 0x8e, 0x8d, 0x8b,
-	0x20, 0xee, 0x7f, 0xff, 0x7c, 0x3e, 0xc6, 0x49, 0x7c, 0xf7, 0xe4, 0xd6, 0x4d, 0x9b, 0x0e, 0x32,
-	0x92, 0xfe, 0x63, 0x05, 0x56, 0xfa, 0x99, 0x73, 0xe2, 0x43, 0x5b, 0x76, 0xd0, 0x31, 0x42, 0xf3,
-	0x40, 0x77, 0x3c, 0xd3, 0x70, 0x9c, 0x5e, 0x6d, 0x88, 0xda, 0xd4, 0x4f, 0x24, 0xbb, 0x96, 0x1f,
-	0xa7, 0xd1, 0x4f, 0xad, 0xef, 0x7a, 0xdb, 0x7c, 0x87, 0xbb, 0x6c, 0x03, 0x66, 0x6a, 0x97, 0x8d,
-	0xe2, 0x15, 0xf5, 0xdf, 0x86, 0xd5, 0x32, 0x04, 0x02, 0x7b, 0xbb, 0x9d, 0xb6, 0xb7, 0xe2, 0xc4,
-	0x7d, 0x64, 0x06, 0x28, 0xae, 0x08, 0x31, 0xfd, 0x32, 0x27, 0x6c, 0xef, 0x0f, 0x15, 0x62, 0x7b,
-	0x73, 0xc7, 0xbc, 0x6d, 0xd8, 0x4e, 0x5f, 0x96, 0x06, 0xac, 0xf8, 0x94, 0xe1, 0x19, 0x50, 0x90,
-	0x5e, 0x20, 0x76, 0xac, 0x10, 0x13, 0xcf, 0x27, 0xff, 0xb1, 0x02, 0x6a, 0xde, 0xda, 0x7d, 0x10,
-	0xa9, 0x67, 0x44, 0xf9, 0xa3, 0x2c, 0xe5, 0x6f, 0x17, 0x50, 0x5e, 0x86, 0x69, 0x40, 0xda, 0x1f,
-	0x12, 0xe5, 0x94, 0xe0, 0xe2, 0xb2, 0xf9, 0x0a, 0xcc, 0x98, 0x86, 0x6b, 0xe2, 0xf8, 0x0b, 0x80,
-	0xd9, 0x37, 0x6d, 0x4c, 0x9b, 0x66, 0xe3, 0x5a, 0x34, 0x9c, 0xd4, 0xf7, 0x24, 0xce, 0x53, 0xea,
-	0xbb, 0x0c, 0xd5, 0x80, 0x47, 0x7d, 0x29, 0x56, 0xf7, 0x02, 0x64, 0x89, 0x9a, 0xa2, 0x60, 0xe1,
-	0x69, 0x24, 0xac, 0x10, 0xcf, 0xb1, 0x25, 0x4c, 0x84, 0x29, 0x25, 0x61, 0xf9, 0x03, 0xd2, 0xfb,
-	0xe9, 0x53, 0x3e, 0xb0, 0x84, 0x95, 0x61, 0x1a, 0x90, 0xf6, 0x8b, 0x62, 0x71, 0x88, 0x71, 0x71,
-	0xea, 0xff, 0x56, 0x81, 0x0b, 0x1a, 0x6e, 0x7b, 0x87, 0x98, 0x35, 0x0b, 0x7c, 0x55, 0xf2, 0x78,
-	0x69, 0xc7, 0xa8, 0x92, 0x71, 0x8c, 0x54, 0x95, 0xc8, 0x4a, 0x11, 0xd5, 0xfc, 0x68, 0x7f, 0x3f,
-	0x04, 0x17, 0xf9, 0x11, 0xd8, 0xb1, 0x0b, 0x2b, 0xd5, 0xd2, 0x03, 0x1a, 0x50, 0x4d, 0xeb, 0x20,
-	0x3f, 0xdc, 0xbb, 0x05, 0xf7, 0x37, 0xc0, 0x86, 0xda, 0x54, 0x4a, 0x7b, 0xd1, 0x1e, 0x2c, 0xc6,
-	0xcd, 0x00, 0xc2, 0x8e, 0x3b, 0x71, 0x9d, 0xf8, 0x16, 0x87, 0xc9, 0xd4, 0x89, 0xb1, 0x68, 0xf8,
-	0xd8, 0x8d, 0x00, 0xeb, 0xf0, 0x52, 0xd9, 0x59, 0x38, 0x9f, 0xff, 0x51, 0x81, 0xe5, 0x28, 0x71,
-	0x24, 0x08, 0xe4, 0x9f, 0x8b, 0xf8, 0x5c, 0x82, 0x59, 0x3b, 0xd0, 0xd3, 0x0d, 0x70, 0x94, 0x97,
-	0x63, 0xda, 0xb4, 0x1d, 0xdc, 0x4e, 0xb6, 0xb6, 0xa9, 0x2b, 0x70, 0x4e, 0x4c, 0x3e, 0x3f, 0xdf,
-	0xe7, 0xd4, 0x61, 0x21, 0xc6, 0x3a, 0x5d, 0xdb, 0xce, 0x99, 0xd6, 0xe7, 0x71, 0xd0, 0x35, 0x98,
-	0xe4, 0xdd, 0x8d, 0xd8, 0x4a, 0xe4, 0x72, 0xe3, 0xb1, 0xa6, 0x85, 0x3e, 0x86, 0xb3, 0x66, 0x44,
-	0x6a, 0x62, 0xeb, 0x33, 0xc7, 0xda, 0x1a, 0xc5, 0x28, 0xfa, 0x7b, 0xdf, 0x85, 0x99, 0x44, 0xc7,
-	0x22, 0x0b, 0x12, 0x86, 0x07, 0x0d, 0x12, 0xa6, 0xfb, 0xa0, 0x2c, 0x4a, 0x38, 0x0f, 0x10, 0xb9,
-	0x7b, 0xb6, 0x45, 0xdd, 0xe3, 0x8a, 0x36, 0xce, 0x47, 0x9a, 0x96, 0xfa, 0x32, 0x51, 0x66, 0xe9,
-	0x25, 0xf0, 0xeb, 0xfa, 0xb7, 0x21, 0xa8, 0x69, 0xbc, 0x9d, 0x17, 0x53, 0xd4, 0xc1, 0xe3, 0xcd,
-	0xe7, 0x79, 0x45, 0xbf, 0x09, 0xf3, 0xe9, 0x5c, 0x68, 0x4f, 0xb7, 0x43, 0xdc, 0x8e, 0x9a, 0x34,
-	0xb2, 0xed, 0x08, 0x56, 0xdb, 0x76, 0x73, 0xe9, 0xd0, 0x5e, 0x33, 0xc4, 0x6d, 0xed, 0xec, 0x61,
-	0x6e, 0x2c, 0x40, 0x6f, 0xc1, 0x08, 0x65, 0x7d, 0xc0, 0x6f, 0x54, 0x9c, 0x1a, 0xd9, 0x36, 0x42,
-	0xe3, 0xa6, 0xe3, 0xed, 0x69, 0x7c, 0x31, 0xda, 0x82, 0xaa, 0x8b, 0x8f, 0x74, 0xbf, 0xcb, 0x6f,
-	0x2e, 0x0a, 0x6c, 0x4a, 0xc0, 0x27, 0x5d, 0x7c, 0xa4, 0x75, 0xd9, 0x95, 0x05, 0xea, 0x32, 0x2c,
-	0x09, 0x58, 0xcd, 0x2f, 0xe2, 0xfb, 0x0a, 0x2c, 0xec, 0xf4, 0x5c, 0x73, 0xe7, 0xc0, 0xf0, 0x2d,
-	0x9e, 0x21, 0xe5, 0xd7, 0x70, 0x11, 0xaa, 0x81, 0xd7, 0xf5, 0x4d, 0xac, 0xf3, 0x2e, 0x6f, 0x7e,
-	0x17, 0x53, 0x6c, 0x74, 0x8b, 0x0d, 0xa2, 0x25, 0x18, 0x0b, 0x08, 0x70, 0xf4, 0x7d, 0x1b, 0xd6,
-	0x46, 0xe9, 0xef, 0xa6, 0x85, 0x1a, 0x70, 0x86, 0xc6, 0x92, 0x95, 0xd2, 0x00, 0x8f, 0xae, 0x53,
-	0x97, 0x60, 0x31, 0x47, 0x0b, 0xa7, 0xf3, 0x67, 0xc3, 0x70, 0x96, 0xcc, 0x45, 0xdf, 0xc9, 0xe7,
-	0x29, 0x2b, 0x35, 0x18, 0x8d, 0x32, 0x52, 0x4c, 0x93, 0xa3, 0x9f, 0x44, 0xd1, 0xfb, 0xb1, 0x6e,
-	0x9c, 0x47, 0x88, 0xf3, 0x0e, 0x84, 0x27, 0xf9, 0x3c, 0xd4, 0xf0, 0x71, 0xf3, 0x50, 0x72, 0x25,
-	0xcc, 0x45, 0xf2, 0xa3, 0xc7, 0x8b, 0xe4, 0x3f, 0xe4, 0xd5, 0x9f, 0x7e, 0x50, 0x4d, 0xb1, 0x8c,
-	0x95, 0x62, 0x99, 0x25, 0x60, 0xb1, 0x7b, 0x4c, 0x71, 0x5d, 0x81, 0xd1, 0x28, 0x22, 0x1f, 0x1f,
-	0x20, 0x22, 0x8f, 0x16, 0x27, 0xb3, 0x09, 0x90, 0xce, 0x26, 0x5c, 0x87, 0x49, 0x56, 0x9b, 0xe2,
-	0xbd, 0xdc, 0x13, 0x03, 0xf4, 0x72, 0x4f, 0xd0, 0x92, 0x15, 0x6f, 0xe3, 0x7e, 0x1d, 0x68, 0x2b,
-	0x36, 0x7f, 0xdd, 0xa0, 0xdb, 0x16, 0x76, 0x43, 0x3b, 0xec, 0xd1, 0x6c, 0xe0, 0xb8, 0x86, 0xc8,
-	0xdc, 0xc7, 0x74, 0xaa, 0xc9, 0x67, 0xd0, 0x7d, 0x98, 0xce, 0x98, 0x06, 0x9e, 0xf9, 0xbb, 0x38,
-	0x90, 0x51, 0xd0, 0xaa, 0x69, 0x83, 0xa0, 0x2e, 0xc0, 0x5c, 0x5a, 0x92, 0xb9, 0x88, 0xff, 0x91,
-	0x02, 0xcb, 0x51, 0x73, 0xdc, 0x57, 0xc4, 0xc3, 0x53, 0xff, 0x50, 0x81, 0x73, 0x62, 0x9a, 0x78,
-	0xf0, 0xf3, 0x06, 0x2c, 0xb4, 0xd9, 0x38, 0xab, 0xcb, 0xe8, 0xb6, 0xab, 0x9b, 0x86, 0x79, 0x80,
-	0x39, 0x85, 0x67, 0xdb, 0x09, 0xa8, 0xa6, 0xbb, 0x45, 0xa6, 0xd0, 0x3b, 0xb0, 0x94, 0x03, 0xb2,
-	0x8c, 0xd0, 0xd8, 0x33, 0x82, 0xa8, 0x47, 0x76, 0x21, 0x0d, 0xb7, 0xcd, 0x67, 0xd5, 0x73, 0x50,
-	0x8f, 0xe8, 0xe1, 0xfc, 0xfc, 0xc0, 0x8b, 0xbb, 0x9b, 0xd4, 0xdf, 0x19, 0xea, 0xb3, 0x30, 0x35,
-	0xcd, 0xa9, 0x5d, 0x87, 0x19, 0xb7, 0xdb, 0xde, 0xc3, 0xbe, 0xee, 0xb5, 0x74, 0x6a, 0xa5, 0x02,
-	0x4a, 0xe7, 0xb0, 0x56, 0x65, 0xe3, 0x0f, 0x5a, 0xd4, 0xf8, 0x04, 0x84, 0xd9, 0x91, 0x55, 0x0b,
-	0x68, 0x6a, 0x61, 0x58, 0x1b, 0xe3, 0x66, 0x2d, 0x40, 0x4d, 0x98, 0xe4, 0x37, 0xc1, 0x8e, 0x2a,
-	0x6e, 0x04, 0x8d, 0xc4, 0x81, 0xe5, 0x7a, 0xe8, 0xc9, 0xa9, 0xef, 0x37, 0x61, 0xf5, 0x07, 0xd0,
-	0x15, 0x58, 0x64, 0xfb, 0x98, 0x9e, 0x1b, 0xfa, 0x9e, 0xe3, 0x60, 0x9f, 0xf2, 0xa4, 0xcb, 0xbe,
-	0x14, 0xe3, 0xda, 0x3c, 0x9d, 0xde, 0x8a, 0x67, 0x99, 0x5d, 0xa4, 0x1a, 0x62, 0x59, 0x3e, 0x0e,
-	0x02, 0x9e, 0x90, 0x8c, 0x7e, 0xaa, 0x0d, 0x98, 0x65, 0x95, 0x2d, 0x02, 0x17, 0xc9, 0x4e, 0xd2,
-	0x48, 0x2b, 0x29, 0x23, 0xad, 0xce, 0x01, 0x4a, 0xae, 0xe7, 0xc2, 0xf8, 0x1f, 0x0a, 0xcc, 0x32,
-	0xe7, 0x3d, 0xe9, 0x25, 0x16, 0xa3, 0x41, 0xd7, 0x78, 0x15, 0x38, 0x2e, 0x7a, 0x57, 0x37, 0x2f,
-	0x14, 0x30, 0x84, 0x60, 0xa4, 0x59, 0x33, 0x5a, 0x07, 0xa6, 0x19, 0xb3, 0x44, 0xee, 0xb5, 0x92,
-	0xca, 0xbd, 0x6e, 0xc1, 0xf4, 0xa1, 0x1d, 0xd8, 0x7b, 0xb6, 0x63, 0x87, 0x3d, 0x66, 0x89, 0xca,
-	0xd3, 0x85, 0xd5, 0x3e, 0x08, 0x35, 0x43, 0x6b, 0x30, 0xc9, 0x3f, 0x61, 0xba, 0x6b, 0x70, 0x8b,
-	0x3b, 0xae, 0x4d, 0xf0, 0xb1, 0xfb, 0x46, 0x1b, 0x13, 0x2e, 0x24, 0x8f, 0xcb, 0xb9, 0xf0, 0x03,
-	0xca, 0x85, 0x00, 0x87, 0x8f, 0xba, 0xb8, 0x8b, 0x07, 0xe0, 0x42, 0x76, 0xa7, 0xa1, 0xdc, 0x4e,
-	0x69, 0x46, 0x55, 0x8e, 0xc9, 0x28, 0x46, 0x67, 0x9f, 0x20, 0x4e, 0xe7, 0x8f, 0x14, 0x98, 0x8b,
-	0xe4, 0xfe, 0x2b, 0x43, 0xea, 0x03, 0x98, 0xcf, 0xd0, 0xc4, 0xb5, 0xf0, 0x0a, 0x2c, 0x76, 0x7c,
-	0xcf, 0xc4, 0x41, 0x60, 0xbb, 0xfb, 0x3a, 0x7d, 0xf8, 0xc5, 0xec, 0x00, 0x51, 0xc6, 0x0a, 0x91,
-	0xf9, 0xfe, 0x34, 0x85, 0xa4, 0x46, 0x20, 0x50, 0x3f, 0x57, 0xe0, 0xfc, 0x1d, 0x1c, 0x6a, 0xfd,
-	0x67, 0x60, 0xf7, 0x70, 0x10, 0x18, 0xfb, 0x38, 0x76, 0x59, 0xae, 0xc3, 0x08, 0x2d, 0x00, 0x31,
-	0x44, 0x13, 0x9b, 0x2f, 0x17, 0x50, 0x9b, 0x40, 0x41, 0xab, 0x43, 0x1a, 0x07, 0x1b, 0x80, 0x29,
-	0xc4, 0xc6, 0xac, 0x14, 0x51, 0xc1, 0x0f, 0xf8, 0x14, 0xaa, 0x8c, 0xeb, 0x6d, 0x3e, 0xc3, 0xc9,
-	0xf9, 0xb0, 0x30, 0x39, 0x29, 0x47, 0xd8, 0xa0, 0xba, 0x19, 0x8d, 0xb2, 0x44, 0xe4, 0x54, 0x90,
-	0x1c, 0xab, 0x3b, 0x80, 0xf2, 0x8b, 0x92, 0xc9, 0xc6, 0x61, 0x96, 0x6c, 0xfc, 0x4e, 0x3a, 0xd9,
-	0x78, 0xa9, 0x9c, 0x41, 0x31, 0x31, 0x89, 0x44, 0x63, 0x1b, 0x56, 0xef, 0xe0, 0x70, 0xfb, 0xee,
-	0x23, 0xc9, 0x5d, 0x34, 0x01, 0x98, 0x4a, 0xbb, 0x2d, 0x2f, 0x62, 0xc0, 0x00, 0xdb, 0x11, 0x41,
-	0xa2, 0x66, 0x92, 0x8a, 0x1e, 0xf9, 0x2b, 0x50, 0x9f, 0xc1, 0x9a, 0x64, 0x3b, 0xce, 0xf4, 0x1d,
-	0x98, 0x4d, 0x3c, 0x10, 0xa4, 0xc5, 0xc8, 0x68, 0xdb, 0x97, 0x06, 0xdb, 0x56, 0x9b, 0xf1, 0xd3,
-	0x03, 0x81, 0xfa, 0x2f, 0x0a, 0xcc, 0x69, 0xd8, 0xe8, 0x74, 0x1c, 0x16, 0x11, 0xc5, 0xa7, 0x5b,
-	0x80, 0x11, 0x9e, 0xd9, 0x67, 0xdf, 0x39, 0xfe, 0x4b, 0xfe, 0x9e, 0x40, 0xfc, 0x91, 0xae, 0x9c,
-	0xd6, 0x1f, 0x3d, 0x59, 0x70, 0xa1, 0x2e, 0xc2, 0x7c, 0xe6, 0x68, 0xdc, 0x9a, 0xfc, 0x54, 0x81,
-	0x65, 0x0d, 0xb7, 0x7c, 0x1c, 0x1c, 0xc4, 0x45, 0x0e, 0xc2, 0x8d, 0xaf, 0xe0, 0xd9, 0xd5, 0x15,
-	0x38, 0x27, 0x26, 0x95, 0x9f, 0xe5, 0x1d, 0x58, 0xdc, 0xf2, 0xba, 0x2e, 0x11, 0x9e, 0xac, 0x80,
-	0xae, 0x00, 0xb4, 0x3c, 0xdf, 0xc4, 0xb7, 0x71, 0x68, 0x1e, 0xf0, 0x8c, 0x6d, 0x62, 0x44, 0x35,
-	0xa0, 0x96, 0x07, 0xe5, 0xc2, 0x76, 0x0b, 0x46, 0xb1, 0x1b, 0xd2, 0x5a, 0x2e, 0x13, 0xb1, 0x57,
-	0x0b, 0x44, 0x8c, 0x7b, 0x21, 0xdb, 0x77, 0x1f, 0x51, 0x5c, 0xbc, 0x5e, 0xcb, 0x61, 0xd5, 0x9f,
-	0x0e, 0xc1, 0x82, 0x86, 0x0d, 0x4b, 0x40, 0xdd, 0x26, 0x9c, 0x89, 0xbb, 0x23, 0xaa, 0x9b, 0x2b,
-	0x45, 0xbe, 0xc5, 0xdd, 0x47, 0xd4, 0xea, 0xd2, 0xb5, 0xb2, 0x50, 0x2c, 0x1f, 0xcc, 0x55, 0x44,
-	0xc1, 0xdc, 0x2e, 0xd4, 0x6c, 0x97, 0xac, 0xb0, 0x0f, 0xb1, 0x8e, 0xdd, 0xd8, 0x82, 0x0d, 0xd8,
-	0x51, 0x36, 0x1f, 0x03, 0xdf, 0x72, 0x23, 0x53, 0xd4, 0xb4, 0x88, 0x60, 0x74, 0x08, 0x12, 0x5a,
-	0x93, 0x1e, 0xa6, 0x84, 0x8d, 0x91, 0x81, 0x1d, 0xfb, 0x53, 0x8c, 0x5e, 0x82, 0x69, 0xda, 0x17,
-	0x41, 0x57, 0xb0, 0xf2, 0xfd, 0x08, 0x2d, 0xdf, 0xd3, 0x76, 0x89, 0x87, 0xc6, 0x3e, 0x66, 0xdd,
-	0x7c, 0x7f, 0x3d, 0x04, 0x8b, 0x39, 0x5e, 0xf1, 0xeb, 0x38, 0x09, 0xb3, 0x84, 0xf6, 0x62, 0xe8,
-	0x74, 0xf6, 0x02, 0x7d, 0x17, 0x16, 0x72, 0x48, 0xa3, 0x1c, 0xe1, 0x71, 0x0d, 0xe0, 0x5c, 0x16,
-	0x3b, 0x4d, 0x11, 0x0a, 0xd8, 0x75, 0x46, 0xc4, 0xae, 0x5f, 0x28, 0xb0, 0xf8, 0xb0, 0xeb, 0xef,
-	0xe3, 0xaf, 0xb7, 0x6c, 0xa9, 0x75, 0xa8, 0xe5, 0x8f, 0xc9, 0x95, 0xff, 0x8b, 0x21, 0x58, 0xbc,
-	0x87, 0xbf, 0xf6, 0x3c, 0xf8, 0x9f, 0xd1, 0xaf, 0x9b, 0x50, 0xcb, 0xf3, 0x8a, 0xeb, 0x97, 0x00,
-	0x87, 0x22, 0xc2, 0xf1, 0x99, 0x02, 0xe7, 0xee, 0x7b, 0xa1, 0xdd, 0xea, 0x91, 0x70, 0xdb, 0x3b,
-	0xc4, 0xfe, 0x3d, 0x83, 0xc4, 0xd2, 0x31, 0xd7, 0xbf, 0x0b, 0x0b, 0x2d, 0x3e, 0xa3, 0xb7, 0xe9,
-	0x94, 0x9e, 0x72, 0xd8, 0x8a, 0xf4, 0x23, 0x8d, 0x8e, 0xf9, 0x6c, 0x73, 0xad, 0xfc, 0x60, 0xa0,
-	0x5e, 0x80, 0xf3, 0x05, 0x14, 0x70, 0xa1, 0x30, 0x60, 0xf9, 0x0e, 0x0e, 0xb7, 0x7c, 0x2f, 0x08,
-	0xf8, 0xad, 0xa4, 0x3e, 0x6e, 0xa9, 0xc0, 0x4f, 0xc9, 0x04, 0x7e, 0x17, 0xa1, 0x1a, 0x1a, 0xfe,
-	0x3e, 0x0e, 0xe3, 0x5b, 0x66, 0x9f, 0xb9, 0x29, 0x36, 0xca, 0xf1, 0xa9, 0xbf, 0xac, 0xc0, 0x39,
-	0xf1, 0x1e, 0x9c, 0x9f, 0x6d, 0x82, 0x87, 0x98, 0x86, 0xbd, 0x1e, 0x0b, 0x43, 0xf9, 0xf1, 0xef,
-	0xc8, 0x1c, 0xc4, 0x42, 0x74, 0xd4, 0xf9, 0x0e, 0x6e, 0xf6, 0xa8, 0x03, 0xc8, 0xbe, 0x30, 0x93,
-	0x61, 0x62, 0x08, 0x7d, 0xa6, 0xc0, 0x7c, 0x8b, 0x16, 0xc4, 0x74, 0xd3, 0xe8, 0x06, 0xb8, 0xbf,
-	0x2d, 0xb3, 0x77, 0xf7, 0x4e, 0xb6, 0x2d, 0xab, 0xb1, 0x6d, 0x11, 0x8c, 0xa9, 0xcd, 0x51, 0x2b,
-	0x37, 0x51, 0xef, 0xc0, 0x6c, 0x8e, 0x4a, 0x81, 0x7b, 0x7a, 0x2b, 0xed, 0x9e, 0x6e, 0x14, 0x88,
-	0x43, 0x96, 0x26, 0x7e, 0x79, 0x49, 0x1f, 0xb5, 0xde, 0x81, 0xc5, 0x02, 0x02, 0x05, 0xfb, 0x5e,
-	0x4f, 0xee, 0x5b, 0x2d, 0x4c, 0xf7, 0xde, 0xc1, 0x61, 0xbf, 0xb8, 0x48, 0xf1, 0x26, 0xbd, 0xe2,
-	0x7f, 0x57, 0x60, 0x9d, 0x97, 0xf3, 0x72, 0x4c, 0xcb, 0xd5, 0x21, 0x24, 0x91, 0xd9, 0x60, 0x52,
-	0x86, 0x1e, 0x33, 0x21, 0x8a, 0xfb, 0x2e, 0xa2, 0x5c, 0xf5, 0xe0, 0x4c, 0xe3, 0xdd, 0x16, 0x53,
-	0x61, 0xe2, 0x57, 0x80, 0x5e, 0x84, 0xa9, 0x16, 0x71, 0x80, 0xee, 0x63, 0xe6, 0x4b, 0xf1, 0xf2,
-	0x53, 0x7a, 0x50, 0xf5, 0xe1, 0x95, 0x01, 0xce, 0x1a, 0xbb, 0x4b, 0xc3, 0x91, 0x3f, 0x7e, 0xb2,
-	0x6b, 0xa5, 0xd0, 0xea, 0x5b, 0xf4, 0xd9, 0x59, 0xa4, 0xd8, 0xf4, 0x23, 0x39, 0x40, 0x6e, 0x4c,
-	0x0d, 0xe9, 0xbb, 0xad, 0x34, 0x58, 0xec, 0x38, 0xcc, 0xf7, 0xcb, 0x2e, 0x51, 0x22, 0xa6, 0xcb,
-	0xfb, 0xa8, 0x86, 0xb5, 0x7e, 0x4d, 0x66, 0x87, 0x65, 0x61, 0xba, 0x2e, 0xcd, 0x8b, 0x47, 0x0f,
-	0x23, 0x79, 0x0a, 0x89, 0xe5, 0x87, 0xa6, 0xf8, 0x28, 0xcb, 0x20, 0xa9, 0x4d, 0x58, 0xd0, 0x8c,
-	0x10, 0x3b, 0x76, 0xdb, 0x0e, 0x3f, 0xea, 0x58, 0x89, 0x44, 0xde, 0x06, 0x9c, 0xb1, 0x8c, 0xd0,
-	0xe0, 0xcc, 0x58, 0x2e, 0x6a, 0xc4, 0xbc, 0xe1, 0xf6, 0x34, 0xba, 0x50, 0xfd, 0x10, 0x16, 0x73,
-	0xa8, 0xf8, 0x01, 0x8e, 0x8b, 0x6b, 0xf3, 0x1f, 0x1a, 0x00, 0xdc, 0x29, 0xbd, 0xf1, 0xb0, 0x89,
-	0xbe, 0xa7, 0xc0, 0x82, 0xf8, 0xdd, 0x39, 0xba, 0x72, 0xb2, 0x7f, 0x14, 0x51, 0x7f, 0xfb, 0xd8,
-	0x70, 0xfc, 0x2c, 0x7f, 0xa0, 0xc0, 0x62, 0xc1, 0x3f, 0x26, 0x40, 0x6f, 0x97, 0x3d, 0xea, 0x2f,
-	0xa2, 0xe6, 0xea, 0xf1, 0x01, 0x39, 0x39, 0x3f, 0x51, 0x60, 0xb5, 0xec, 0x71, 0x3e, 0xfa, 0xce,
-	0x69, 0xff, 0xd9, 0x40, 0xfd, 0xc6, 0x29, 0x30, 0x70, 0x4a, 0xc9, 0x25, 0x8a, 0x9f, 0xdd, 0x4b,
-	0x2e, 0x51, 0xfa, 0xdc, 0x5f, 0x72, 0x89, 0x25, 0xef, 0xfb, 0xff, 0x44, 0x81, 0x7a, 0xf1, 0xe3,
-	0x74, 0x54, 0xdc, 0x15, 0x56, 0xfa, 0x68, 0xbf, 0xfe, 0xde, 0x89, 0x60, 0x39, 0x5d, 0x3f, 0x52,
-	0x60, 0xa9, 0xf0, 0xe9, 0x39, 0x7a, 0xa7, 0x10, 0x75, 0xd9, 0xcb, 0xf7, 0xfa, 0xbb, 0x27, 0x01,
-	0xe5, 0x44, 0xb9, 0x30, 0x95, 0x7a, 0x93, 0x8c, 0x5e, 0x2b, 0x44, 0x26, 0x7a, 0xfa, 0x5c, 0x6f,
-	0x0c, 0xba, 0x9c, 0xef, 0xf7, 0x99, 0x02, 0x67, 0x05, 0x0f, 0x7b, 0xd1, 0x1b, 0xf2, 0xdb, 0x16,
-	0x3e, 0x25, 0xae, 0xbf, 0x79, 0x3c, 0x20, 0x4e, 0x42, 0x08, 0xd3, 0x99, 0x47, 0xb4, 0x68, 0x43,
-	0xe6, 0x7e, 0x08, 0x2a, 0x21, 0xf5, 0xd7, 0x07, 0x07, 0xe0, 0xbb, 0x1e, 0xc1, 0x4c, 0xf6, 0x25,
-	0x18, 0x2a, 0xc6, 0x52, 0xf0, 0x56, 0xae, 0x7e, 0xf9, 0x18, 0x10, 0x09, 0xb1, 0x2b, 0xec, 0x77,
-	0x94, 0x88, 0x5d, 0xd9, 0x6b, 0x94, 0xfa, 0x29, 0xda, 0x2b, 0xd1, 0x9f, 0x2b, 0x70, 0x4e, 0xd6,
-	0x0e, 0x89, 0xae, 0x9d, 0xb0, 0x8b, 0x92, 0x91, 0xf6, 0xfe, 0xa9, 0x7a, 0x30, 0x39, 0xcb, 0x0a,
-	0x7a, 0x06, 0xa5, 0x2c, 0x93, 0x77, 0x2c, 0x4a, 0x59, 0x56, 0xd2, 0xa2, 0x98, 0xb8, 0x47, 0x41,
-	0x43, 0x76, 0xe9, 0x3d, 0x16, 0xb7, 0xc2, 0x97, 0xde, 0xa3, 0xac, 0xff, 0x3b, 0x71, 0x8f, 0xc2,
-	0xb6, 0xbd, 0xf2, 0x7b, 0x94, 0xb5, 0x0e, 0x96, 0xdf, 0xa3, 0xb4, 0x57, 0x30, 0x79, 0x8f, 0xf9,
-	0xce, 0xbc, 0xf2, 0x7b, 0x2c, 0xec, 0x0b, 0x2c, 0xbf, 0xc7, 0xe2, 0x46, 0x40, 0xf4, 0x67, 0x34,
-	0xb7, 0x59, 0xd8, 0x72, 0x87, 0xde, 0x3b, 0xd6, 0x99, 0xd3, 0x4d, 0x7f, 0xf5, 0x6b, 0x27, 0x03,
-	0x4e, 0x91, 0x56, 0xd8, 0x6f, 0x2a, 0x25, 0xad, 0xac, 0xe3, 0x55, 0x4a, 0x5a, 0x79, 0x8b, 0xeb,
-	0x5f, 0x2a, 0xb0, 0x22, 0x6f, 0x34, 0x43, 0xdf, 0x96, 0x6c, 0x30, 0x40, 0xb7, 0x5d, 0xfd, 0xfa,
-	0x89, 0xe1, 0x39, 0x8d, 0x3f, 0x50, 0xa0, 0x56, 0xd4, 0x6e, 0x88, 0xae, 0x4a, 0xb0, 0x4b, 0xfb,
-	0x2a, 0xeb, 0xef, 0x9c, 0x00, 0x92, 0x53, 0xf4, 0xb9, 0x02, 0x73, 0xa2, 0xa6, 0x35, 0x54, 0xfc,
-	0xe5, 0x94, 0xb4, 0xe8, 0xd5, 0xdf, 0x3a, 0x26, 0x14, 0xa7, 0xe2, 0x2f, 0xe8, 0xff, 0x87, 0x92,
-	0x34, 0x65, 0xa1, 0xf7, 0x4b, 0x64, 0x43, 0xde, 0x51, 0x57, 0xff, 0xf6, 0x49, 0xc1, 0x39, 0x81,
-	0x9f, 0xc2, 0x6c, 0xae, 0x3f, 0x09, 0x5d, 0x96, 0x20, 0x15, 0xb7, 0x8d, 0xd5, 0x37, 0x8f, 0x03,
-	0xd2, 0xf7, 0x46, 0x32, 0x1d, 0x47, 0x12, 0x6f, 0x44, 0xdc, 0x27, 0x25, 0xf1, 0x46, 0x0a, 0x9a,
-	0x99, 0xd0, 0x13, 0x98, 0x4c, 0x76, 0x80, 0xa0, 0x6f, 0x49, 0x31, 0x64, 0x5a, 0x9e, 0xea, 0xaf,
-	0x0d, 0xb8, 0x3a, 0x21, 0x85, 0xa2, 0x16, 0x0e, 0x89, 0x14, 0x4a, 0xba, 0x50, 0x24, 0x52, 0x28,
-	0xed, 0x13, 0x21, 0x9e, 0xa7, 0xa0, 0x33, 0x43, 0xe2, 0x79, 0x16, 0xb7, 0x79, 0xd4, 0xdf, 0x3c,
-	0x1e, 0x50, 0xfc, 0x54, 0x05, 0xfa, 0x8d, 0x0e, 0xe8, 0x52, 0x21, 0x8e, 0x5c, 0xf7, 0x44, 0xfd,
-	0xd5, 0x81, 0xd6, 0xf6, 0xb7, 0xe9, 0x77, 0x12, 0x48, 0xb6, 0xc9, 0x75, 0x57, 0x48, 0xb6, 0xc9,
-	0xb7, 0x26, 0xb0, 0x6d, 0xa2, 0x46, 0x00, 0xe9, 0x36, 0x99, 0xf6, 0x05, 0xe9, 0x36, 0xd9, 0xce,
-	0x02, 0x12, 0xa1, 0xa4, 0x8a, 0xf8, 0x92, 0x08, 0x45, 0xd4, 0x80, 0x20, 0x89, 0x50, 0xc4, 0xbd,
-	0x01, 0xdf, 0x63, 0xff, 0x5a, 0x48, 0x50, 0xe8, 0x95, 0x84, 0xb2, 0xd2, 0xa6, 0x00, 0x49, 0x28,
-	0x5b, 0x52, 0xc6, 0x27, 0x0e, 0x4c, 0x61, 0xdd, 0x59, 0xe2, 0xc0, 0x94, 0x95, 0xc6, 0x25, 0x0e,
-	0x4c, 0x79, 0x99, 0xdb, 0x85, 0xa9, 0x54, 0xd5, 0x56, 0x72, 0x21, 0xa2, 0xc2, 0xb5, 0xe4, 0x42,
-	0x84, 0xc5, 0x60, 0x6a, 0x3e, 0x44, 0x15, 0x56, 0x24, 0x0b, 0xff, 0x0a, 0x6b, 0xc7, 0x12, 0xf3,
-	0x21, 0x2b, 0xe3, 0x92, 0xf8, 0x2d, 0x5b, 0x8b, 0x95, 0xc4, 0x6f, 0x05, 0x15, 0x5f, 0x49, 0xfc,
-	0x56, 0x58, 0xe8, 0x0d, 0x61, 0x3a, 0x53, 0x74, 0x94, 0x7c, 0x20, 0xc4, 0xa5, 0x5c, 0xc9, 0x07,
-	0xa2, 0xa8, 0x9e, 0x49, 0xc2, 0xd5, 0x4c, 0x51, 0x4b, 0x16, 0xae, 0x8a, 0xcb, 0x7c, 0xb2, 0x70,
-	0xb5, 0xa0, 0x62, 0x46, 0x36, 0xce, 0x16, 0x81, 0x24, 0x1b, 0x17, 0xd4, 0xd6, 0x24, 0x1b, 0x17,
-	0x56, 0x98, 0x7e, 0x5f, 0x81, 0x79, 0x61, 0xdd, 0x06, 0x15, 0x4b, 0x8c, 0xac, 0xd2, 0x54, 0xbf,
-	0x72, 0x5c, 0xb0, 0x84, 0xbc, 0x8b, 0xaa, 0x1e, 0x12, 0x79, 0x97, 0x94, 0x93, 0x24, 0xf2, 0x2e,
-	0x2d, 0x10, 0x7d, 0xa1, 0xc4, 0xaf, 0x9a, 0x8a, 0xd3, 0xeb, 0xe8, 0x46, 0x59, 0xbc, 0x51, 0x5a,
-	0x86, 0xa8, 0xdf, 0x3c, 0x0d, 0x8a, 0x54, 0x4a, 0x27, 0x99, 0x5f, 0x97, 0xa7, 0x74, 0x04, 0x09,
-	0x7c, 0x79, 0x4a, 0x47, 0x98, 0xba, 0x27, 0x9a, 0x99, 0x4e, 0x8a, 0xcb, 0x34, 0x53, 0x98, 0x89,
-	0x97, 0x69, 0xa6, 0x38, 0xdf, 0x7e, 0xf3, 0xd6, 0xcf, 0xbe, 0x5c, 0x51, 0xfe, 0xf9, 0xcb, 0x15,
-	0xe5, 0x5f, 0xbf, 0x5c, 0x51, 0x7e, 0xfd, 0xed, 0x7d, 0x3b, 0x3c, 0xe8, 0xee, 0x35, 0x4c, 0xaf,
-	0xbd, 0x91, 0xfa, 0x17, 0xe2, 0x8d, 0x7d, 0xec, 0xb2, 0xff, 0x27, 0x9f, 0xf8, 0x87, 0xf6, 0xef,
-	0xf1, 0x3f, 0x0f, 0x2f, 0xef, 0x8d, 0xd0, 0xb9, 0x37, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x62,
-	0xe2, 0x64, 0x6e, 0xfc, 0x5e, 0x00, 0x00,
+func (m *ReadDLQMessagesResponse) GetReplicationTasksInfo() []*v12.ReplicationTaskInfo {
+	if m != nil {
+		return m.ReplicationTasksInfo
+	}
+	return nil
 }
 
-func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReadDLQMessagesResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *StartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PurgeDLQMessagesRequest struct {
+	Type                  v12.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
+	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
+	XXX_unrecognized      []byte            `json:"-"`
+	XXX_sizecache         int32             `json:"-"`
 }
 
-func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.PartitionConfig) > 0 {
-		for k := range m.PartitionConfig {
-			v := m.PartitionConfig[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintService(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintService(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if m.FirstDecisionTaskBackoff != nil {
-		{
-			size, err := m.FirstDecisionTaskBackoff.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.LastCompletionResult != nil {
-		{
-			size, err := m.LastCompletionResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.ContinuedFailure != nil {
-		{
-			size, err := m.ContinuedFailure.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.ContinueAsNewInitiator != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ContinueAsNewInitiator))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.ExpirationTime != nil {
-		{
-			size, err := m.ExpirationTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Attempt != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ParentExecutionInfo != nil {
-		{
-			size, err := m.ParentExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *PurgeDLQMessagesRequest) Reset()         { *m = PurgeDLQMessagesRequest{} }
+func (m *PurgeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*PurgeDLQMessagesRequest) ProtoMessage()    {}
+func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{88}
+}
+func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurgeDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurgeDLQMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *StartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PurgeDLQMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeDLQMessagesRequest.Merge(m, src)
 }
-
-func (m *StartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PurgeDLQMessagesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *StartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *PurgeDLQMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeDLQMessagesRequest.DiscardUnknown(m)
 }
 
-func (m *SignalWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PurgeDLQMessagesRequest proto.InternalMessageInfo
+
+func (m *PurgeDLQMessagesRequest) GetType() v12.DLQType {
+	if m != nil {
+		return m.Type
 	}
-	return dAtA[:n], nil
+	return v12.DLQType_DLQ_TYPE_INVALID
 }
 
-func (m *SignalWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PurgeDLQMessagesRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
 }
 
-func (m *SignalWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChildWorkflowOnly {
-		i--
-		if m.ChildWorkflowOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ExternalWorkflowExecution != nil {
-		{
-			size, err := m.ExternalWorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *PurgeDLQMessagesRequest) GetSourceCluster() string {
+	if m != nil {
+		return m.SourceCluster
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SignalWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PurgeDLQMessagesRequest) GetInclusiveEndMessageId() *types.Int64Value {
+	if m != nil {
+		return m.InclusiveEndMessageId
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SignalWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PurgeDLQMessagesResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SignalWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return len(dAtA) - i, nil
+func (m *PurgeDLQMessagesResponse) Reset()         { *m = PurgeDLQMessagesResponse{} }
+func (m *PurgeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*PurgeDLQMessagesResponse) ProtoMessage()    {}
+func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{89}
 }
-
-func (m *SignalWithStartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurgeDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurgeDLQMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *PurgeDLQMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeDLQMessagesResponse.Merge(m, src)
+}
+func (m *PurgeDLQMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurgeDLQMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeDLQMessagesResponse.DiscardUnknown(m)
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PurgeDLQMessagesResponse proto.InternalMessageInfo
+
+type MergeDLQMessagesRequest struct {
+	Type                  v12.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
+	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
+	PageSize              int32             `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken         []byte            `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Filter                *v12.DLQFilter    `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
+	XXX_unrecognized      []byte            `json:"-"`
+	XXX_sizecache         int32             `json:"-"`
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.PartitionConfig) > 0 {
-		for k := range m.PartitionConfig {
-			v := m.PartitionConfig[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintService(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintService(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *MergeDLQMessagesRequest) Reset()         { *m = MergeDLQMessagesRequest{} }
+func (m *MergeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*MergeDLQMessagesRequest) ProtoMessage()    {}
+func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{90}
+}
+func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeDLQMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeDLQMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SignalWithStartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MergeDLQMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeDLQMessagesRequest.Merge(m, src)
 }
-
-func (m *SignalWithStartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MergeDLQMessagesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *SignalWithStartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MergeDLQMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeDLQMessagesRequest.DiscardUnknown(m)
 }
 
-func (m *ResetWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MergeDLQMessagesRequest proto.InternalMessageInfo
+
+func (m *MergeDLQMessagesRequest) GetType() v12.DLQType {
+	if m != nil {
+		return m.Type
 	}
-	return dAtA[:n], nil
+	return v12.DLQType_DLQ_TYPE_INVALID
 }
 
-func (m *ResetWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MergeDLQMessagesRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
 }
 
-func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *MergeDLQMessagesRequest) GetSourceCluster() string {
+	if m != nil {
+		return m.SourceCluster
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ResetWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MergeDLQMessagesRequest) GetInclusiveEndMessageId() *types.Int64Value {
+	if m != nil {
+		return m.InclusiveEndMessageId
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ResetWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MergeDLQMessagesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
 }
 
-func (m *ResetWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MergeDLQMessagesRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *TerminateWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MergeDLQMessagesRequest) GetFilter() *v12.DLQFilter {
+	if m != nil {
+		return m.Filter
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TerminateWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MergeDLQMessagesResponse struct {
+	NextPageToken        []byte   `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TerminateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChildWorkflowOnly {
-		i--
-		if m.ChildWorkflowOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ExternalWorkflowExecution != nil {
-		{
-			size, err := m.ExternalWorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *MergeDLQMessagesResponse) Reset()         { *m = MergeDLQMessagesResponse{} }
+func (m *MergeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*MergeDLQMessagesResponse) ProtoMessage()    {}
+func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{91}
+}
+func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeDLQMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeDLQMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MergeDLQMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeDLQMessagesResponse.Merge(m, src)
+}
+func (m *MergeDLQMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeDLQMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeDLQMessagesResponse.DiscardUnknown(m)
 }
 
-func (m *TerminateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MergeDLQMessagesResponse proto.InternalMessageInfo
+
+func (m *MergeDLQMessagesResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TerminateWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type NotifyFailoverMarkersRequest struct {
+	FailoverMarkerTokens []*v12.FailoverMarkerToken `protobuf:"bytes,1,rep,name=failover_marker_tokens,json=failoverMarkerTokens,proto3" json:"failover_marker_tokens,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-func (m *TerminateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *NotifyFailoverMarkersRequest) Reset()         { *m = NotifyFailoverMarkersRequest{} }
+func (m *NotifyFailoverMarkersRequest) String() string { return proto.CompactTextString(m) }
+func (*NotifyFailoverMarkersRequest) ProtoMessage()    {}
+func (*NotifyFailoverMarkersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{92}
+}
+func (m *NotifyFailoverMarkersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotifyFailoverMarkersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotifyFailoverMarkersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *NotifyFailoverMarkersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotifyFailoverMarkersRequest.Merge(m, src)
+}
+func (m *NotifyFailoverMarkersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotifyFailoverMarkersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotifyFailoverMarkersRequest.DiscardUnknown(m)
 }
 
-func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_NotifyFailoverMarkersRequest proto.InternalMessageInfo
+
+func (m *NotifyFailoverMarkersRequest) GetFailoverMarkerTokens() []*v12.FailoverMarkerToken {
+	if m != nil {
+		return m.FailoverMarkerTokens
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DescribeWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type NotifyFailoverMarkersResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *NotifyFailoverMarkersResponse) Reset()         { *m = NotifyFailoverMarkersResponse{} }
+func (m *NotifyFailoverMarkersResponse) String() string { return proto.CompactTextString(m) }
+func (*NotifyFailoverMarkersResponse) ProtoMessage()    {}
+func (*NotifyFailoverMarkersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{93}
+}
+func (m *NotifyFailoverMarkersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotifyFailoverMarkersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotifyFailoverMarkersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *NotifyFailoverMarkersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotifyFailoverMarkersResponse.Merge(m, src)
+}
+func (m *NotifyFailoverMarkersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotifyFailoverMarkersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotifyFailoverMarkersResponse.DiscardUnknown(m)
 }
 
-func (m *DescribeWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_NotifyFailoverMarkersResponse proto.InternalMessageInfo
+
+type GetCrossClusterTasksRequest struct {
+	ShardIds             []int32  `protobuf:"varint,1,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
+	TargetCluster        string   `protobuf:"bytes,2,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *GetCrossClusterTasksRequest) Reset()         { *m = GetCrossClusterTasksRequest{} }
+func (m *GetCrossClusterTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCrossClusterTasksRequest) ProtoMessage()    {}
+func (*GetCrossClusterTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{94}
+}
+func (m *GetCrossClusterTasksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCrossClusterTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCrossClusterTasksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PendingDecision != nil {
-		{
-			size, err := m.PendingDecision.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PendingChildren) > 0 {
-		for iNdEx := len(m.PendingChildren) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingChildren[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.PendingActivities) > 0 {
-		for iNdEx := len(m.PendingActivities) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.WorkflowExecutionInfo != nil {
-		{
-			size, err := m.WorkflowExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ExecutionConfiguration != nil {
-		{
-			size, err := m.ExecutionConfiguration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *GetCrossClusterTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCrossClusterTasksRequest.Merge(m, src)
 }
-
-func (m *QueryWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetCrossClusterTasksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCrossClusterTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCrossClusterTasksRequest.DiscardUnknown(m)
 }
 
-func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_GetCrossClusterTasksRequest proto.InternalMessageInfo
+
+func (m *GetCrossClusterTasksRequest) GetShardIds() []int32 {
+	if m != nil {
+		return m.ShardIds
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryWorkflowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetCrossClusterTasksRequest) GetTargetCluster() string {
+	if m != nil {
+		return m.TargetCluster
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetCrossClusterTasksResponse struct {
+	TasksByShard         map[int32]*v12.CrossClusterTaskRequests `protobuf:"bytes,1,rep,name=tasks_by_shard,json=tasksByShard,proto3" json:"tasks_by_shard,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FailedCauseByShard   map[int32]v12.GetTaskFailedCause        `protobuf:"bytes,2,rep,name=failed_cause_by_shard,json=failedCauseByShard,proto3" json:"failed_cause_by_shard,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=uber.cadence.admin.v1.GetTaskFailedCause"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
 }
 
-func (m *QueryWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.QueryRejected != nil {
-		{
-			size, err := m.QueryRejected.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.QueryResult != nil {
-		{
-			size, err := m.QueryResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *GetCrossClusterTasksResponse) Reset()         { *m = GetCrossClusterTasksResponse{} }
+func (m *GetCrossClusterTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCrossClusterTasksResponse) ProtoMessage()    {}
+func (*GetCrossClusterTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{95}
+}
+func (m *GetCrossClusterTasksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCrossClusterTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCrossClusterTasksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ResetStickyTaskListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *GetCrossClusterTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCrossClusterTasksResponse.Merge(m, src)
 }
-
-func (m *ResetStickyTaskListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetCrossClusterTasksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCrossClusterTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCrossClusterTasksResponse.DiscardUnknown(m)
 }
 
-func (m *ResetStickyTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_GetCrossClusterTasksResponse proto.InternalMessageInfo
+
+func (m *GetCrossClusterTasksResponse) GetTasksByShard() map[int32]*v12.CrossClusterTaskRequests {
+	if m != nil {
+		return m.TasksByShard
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ResetStickyTaskListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetCrossClusterTasksResponse) GetFailedCauseByShard() map[int32]v12.GetTaskFailedCause {
+	if m != nil {
+		return m.FailedCauseByShard
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ResetStickyTaskListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RespondCrossClusterTasksCompletedRequest struct {
+	ShardId              int32                           `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	TargetCluster        string                          `protobuf:"bytes,2,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
+	TaskResponses        []*v12.CrossClusterTaskResponse `protobuf:"bytes,3,rep,name=task_responses,json=taskResponses,proto3" json:"task_responses,omitempty"`
+	FetchNewTasks        bool                            `protobuf:"varint,4,opt,name=fetchNewTasks,proto3" json:"fetchNewTasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
-func (m *ResetStickyTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *RespondCrossClusterTasksCompletedRequest) Reset() {
+	*m = RespondCrossClusterTasksCompletedRequest{}
+}
+func (m *RespondCrossClusterTasksCompletedRequest) String() string { return proto.CompactTextString(m) }
+func (*RespondCrossClusterTasksCompletedRequest) ProtoMessage()    {}
+func (*RespondCrossClusterTasksCompletedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{96}
+}
+func (m *RespondCrossClusterTasksCompletedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondCrossClusterTasksCompletedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RespondCrossClusterTasksCompletedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.Merge(m, src)
+}
+func (m *RespondCrossClusterTasksCompletedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondCrossClusterTasksCompletedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondCrossClusterTasksCompletedRequest.DiscardUnknown(m)
 }
 
-func (m *GetMutableStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RespondCrossClusterTasksCompletedRequest proto.InternalMessageInfo
+
+func (m *RespondCrossClusterTasksCompletedRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RespondCrossClusterTasksCompletedRequest) GetTargetCluster() string {
+	if m != nil {
+		return m.TargetCluster
+	}
+	return ""
 }
 
-func (m *GetMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.CurrentBranchToken) > 0 {
-		i -= len(m.CurrentBranchToken)
-		copy(dAtA[i:], m.CurrentBranchToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.CurrentBranchToken)))
-		i--
-		dAtA[i] = 0x22
+func (m *RespondCrossClusterTasksCompletedRequest) GetTaskResponses() []*v12.CrossClusterTaskResponse {
+	if m != nil {
+		return m.TaskResponses
 	}
-	if m.ExpectedNextEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ExpectedNextEventId))
-		i--
-		dAtA[i] = 0x18
+	return nil
+}
+
+func (m *RespondCrossClusterTasksCompletedRequest) GetFetchNewTasks() bool {
+	if m != nil {
+		return m.FetchNewTasks
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	return false
+}
+
+type RespondCrossClusterTasksCompletedResponse struct {
+	Tasks                *v12.CrossClusterTaskRequests `protobuf:"bytes,1,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *RespondCrossClusterTasksCompletedResponse) Reset() {
+	*m = RespondCrossClusterTasksCompletedResponse{}
+}
+func (m *RespondCrossClusterTasksCompletedResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RespondCrossClusterTasksCompletedResponse) ProtoMessage() {}
+func (*RespondCrossClusterTasksCompletedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{97}
+}
+func (m *RespondCrossClusterTasksCompletedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondCrossClusterTasksCompletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RespondCrossClusterTasksCompletedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.Merge(m, src)
+}
+func (m *RespondCrossClusterTasksCompletedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondCrossClusterTasksCompletedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondCrossClusterTasksCompletedResponse.DiscardUnknown(m)
 }
 
-func (m *GetMutableStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RespondCrossClusterTasksCompletedResponse proto.InternalMessageInfo
+
+func (m *RespondCrossClusterTasksCompletedResponse) GetTasks() *v12.CrossClusterTaskRequests {
+	if m != nil {
+		return m.Tasks
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetFailoverInfoRequest struct {
+	DomainId             string   `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.HistorySize != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.HistorySize))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
-	}
-	if m.IsStickyTaskListEnabled {
-		i--
-		if m.IsStickyTaskListEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
-	}
-	if m.VersionHistories != nil {
-		{
-			size, err := m.VersionHistories.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *GetFailoverInfoRequest) Reset()         { *m = GetFailoverInfoRequest{} }
+func (m *GetFailoverInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFailoverInfoRequest) ProtoMessage()    {}
+func (*GetFailoverInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{98}
+}
+func (m *GetFailoverInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFailoverInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFailoverInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	if m.WorkflowCloseState != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.WorkflowCloseState))
-		i--
-		dAtA[i] = 0x78
+		return b[:n], nil
 	}
-	if m.WorkflowState != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.WorkflowState))
-		i--
-		dAtA[i] = 0x70
+}
+func (m *GetFailoverInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFailoverInfoRequest.Merge(m, src)
+}
+func (m *GetFailoverInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFailoverInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFailoverInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFailoverInfoRequest proto.InternalMessageInfo
+
+func (m *GetFailoverInfoRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
 	}
-	if len(m.CurrentBranchToken) > 0 {
-		i -= len(m.CurrentBranchToken)
-		copy(dAtA[i:], m.CurrentBranchToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.CurrentBranchToken)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.EventStoreVersion != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.EventStoreVersion))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.StickyTaskListScheduleToStartTimeout != nil {
-		{
-			size, err := m.StickyTaskListScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.ClientImpl) > 0 {
-		i -= len(m.ClientImpl)
-		copy(dAtA[i:], m.ClientImpl)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClientImpl)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.ClientFeatureVersion) > 0 {
-		i -= len(m.ClientFeatureVersion)
-		copy(dAtA[i:], m.ClientFeatureVersion)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClientFeatureVersion)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.ClientLibraryVersion) > 0 {
-		i -= len(m.ClientLibraryVersion)
-		copy(dAtA[i:], m.ClientLibraryVersion)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClientLibraryVersion)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.StickyTaskList != nil {
-		{
-			size, err := m.StickyTaskList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.TaskList != nil {
-		{
-			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.LastFirstEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.LastFirstEventId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.PreviousStartedEventId != nil {
-		{
-			size, err := m.PreviousStartedEventId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.NextEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.NextEventId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.WorkflowType != nil {
-		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PollMutableStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type GetFailoverInfoResponse struct {
+	CompletedShardCount  int32    `protobuf:"varint,1,opt,name=completed_shard_count,json=completedShardCount,proto3" json:"completed_shard_count,omitempty"`
+	PendingShards        []int32  `protobuf:"varint,2,rep,packed,name=pending_shards,json=pendingShards,proto3" json:"pending_shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PollMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetFailoverInfoResponse) Reset()         { *m = GetFailoverInfoResponse{} }
+func (m *GetFailoverInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFailoverInfoResponse) ProtoMessage()    {}
+func (*GetFailoverInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{99}
 }
-
-func (m *PollMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.CurrentBranchToken) > 0 {
-		i -= len(m.CurrentBranchToken)
-		copy(dAtA[i:], m.CurrentBranchToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.CurrentBranchToken)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ExpectedNextEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ExpectedNextEventId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *GetFailoverInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFailoverInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFailoverInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *GetFailoverInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFailoverInfoResponse.Merge(m, src)
+}
+func (m *GetFailoverInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFailoverInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFailoverInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFailoverInfoResponse proto.InternalMessageInfo
+
+func (m *GetFailoverInfoResponse) GetCompletedShardCount() int32 {
+	if m != nil {
+		return m.CompletedShardCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PollMutableStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetFailoverInfoResponse) GetPendingShards() []int32 {
+	if m != nil {
+		return m.PendingShards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PollMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RatelimitUpdateRequest struct {
+	// impl-specific data.
+	// likely some simple top-level keys and then either:
+	// - map<ratelimit-key-string, something>
+	// - list<something>
+	//
+	// this is a single blob rather than a collection to save on
+	// repeated serialization of the type name, and to allow impls
+	// to choose whatever structures are most-convenient for them.
+	Data                 *v11.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PollMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.WorkflowCloseState != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.WorkflowCloseState))
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.WorkflowState != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.WorkflowState))
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.VersionHistories != nil {
-		{
-			size, err := m.VersionHistories.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.CurrentBranchToken) > 0 {
-		i -= len(m.CurrentBranchToken)
-		copy(dAtA[i:], m.CurrentBranchToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.CurrentBranchToken)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.StickyTaskListScheduleToStartTimeout != nil {
-		{
-			size, err := m.StickyTaskListScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.ClientImpl) > 0 {
-		i -= len(m.ClientImpl)
-		copy(dAtA[i:], m.ClientImpl)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClientImpl)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.ClientFeatureVersion) > 0 {
-		i -= len(m.ClientFeatureVersion)
-		copy(dAtA[i:], m.ClientFeatureVersion)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClientFeatureVersion)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.ClientLibraryVersion) > 0 {
-		i -= len(m.ClientLibraryVersion)
-		copy(dAtA[i:], m.ClientLibraryVersion)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClientLibraryVersion)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.StickyTaskList != nil {
-		{
-			size, err := m.StickyTaskList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.TaskList != nil {
-		{
-			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.LastFirstEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.LastFirstEventId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.PreviousStartedEventId != nil {
-		{
-			size, err := m.PreviousStartedEventId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.NextEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.NextEventId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.WorkflowType != nil {
-		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *RatelimitUpdateRequest) Reset()         { *m = RatelimitUpdateRequest{} }
+func (m *RatelimitUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*RatelimitUpdateRequest) ProtoMessage()    {}
+func (*RatelimitUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{100}
+}
+func (m *RatelimitUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RatelimitUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RatelimitUpdateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RatelimitUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatelimitUpdateRequest.Merge(m, src)
+}
+func (m *RatelimitUpdateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RatelimitUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatelimitUpdateRequest.DiscardUnknown(m)
 }
 
-func (m *RecordDecisionTaskStartedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RatelimitUpdateRequest proto.InternalMessageInfo
+
+func (m *RatelimitUpdateRequest) GetData() *v11.Any {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RecordDecisionTaskStartedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RatelimitUpdateResponse struct {
+	// impl-specific data.
+	//
+	// likely some simple top-level keys and then either:
+	// - map<ratelimit-key-string, something>
+	// - list<something>
+	//
+	// this is a single blob rather than a collection to save on
+	// repeated serialization of the type name, and to allow impls
+	// to choose whatever structures are most-convenient for them.
+	Data                 *v11.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RecordDecisionTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.PollRequest != nil {
-		{
-			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.RequestId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.TaskId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ScheduleId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ScheduleId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *RatelimitUpdateResponse) Reset()         { *m = RatelimitUpdateResponse{} }
+func (m *RatelimitUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*RatelimitUpdateResponse) ProtoMessage()    {}
+func (*RatelimitUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{101}
+}
+func (m *RatelimitUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RatelimitUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RatelimitUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RatelimitUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatelimitUpdateResponse.Merge(m, src)
+}
+func (m *RatelimitUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RatelimitUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatelimitUpdateResponse.DiscardUnknown(m)
 }
 
-func (m *RecordDecisionTaskStartedResponse) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_RatelimitUpdateResponse proto.InternalMessageInfo
+
+func (m *RatelimitUpdateResponse) GetData() *v11.Any {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest")
+	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest.PartitionConfigEntry")
+	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.StartWorkflowExecutionResponse")
+	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.SignalWorkflowExecutionRequest")
+	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.SignalWorkflowExecutionResponse")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.SignalWithStartWorkflowExecutionRequest")
+	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.history.v1.SignalWithStartWorkflowExecutionRequest.PartitionConfigEntry")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.SignalWithStartWorkflowExecutionResponse")
+	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.ResetWorkflowExecutionRequest")
+	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.ResetWorkflowExecutionResponse")
+	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionRequest")
+	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionResponse")
+	proto.RegisterType((*PauseWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.PauseWorkflowExecutionRequest")
+	proto.RegisterType((*PauseWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.PauseWorkflowExecutionResponse")
+	proto.RegisterType((*UnpauseWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.UnpauseWorkflowExecutionRequest")
+	proto.RegisterType((*UnpauseWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.UnpauseWorkflowExecutionResponse")
+	proto.RegisterType((*PauseActivityRequest)(nil), "uber.cadence.history.v1.PauseActivityRequest")
+	proto.RegisterType((*PauseActivityResponse)(nil), "uber.cadence.history.v1.PauseActivityResponse")
+	proto.RegisterType((*UnpauseActivityRequest)(nil), "uber.cadence.history.v1.UnpauseActivityRequest")
+	proto.RegisterType((*UnpauseActivityResponse)(nil), "uber.cadence.history.v1.UnpauseActivityResponse")
+	proto.RegisterType((*ResetActivityRequest)(nil), "uber.cadence.history.v1.ResetActivityRequest")
+	proto.RegisterType((*ResetActivityResponse)(nil), "uber.cadence.history.v1.ResetActivityResponse")
+	proto.RegisterType((*UpdateActivityOptionsRequest)(nil), "uber.cadence.history.v1.UpdateActivityOptionsRequest")
+	proto.RegisterType((*UpdateActivityOptionsResponse)(nil), "uber.cadence.history.v1.UpdateActivityOptionsResponse")
+	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionRequest")
+	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionResponse")
+	proto.RegisterType((*QueryWorkflowRequest)(nil), "uber.cadence.history.v1.QueryWorkflowRequest")
+	proto.RegisterType((*QueryWorkflowResponse)(nil), "uber.cadence.history.v1.QueryWorkflowResponse")
+	proto.RegisterType((*ResetStickyTaskListRequest)(nil), "uber.cadence.history.v1.ResetStickyTaskListRequest")
+	proto.RegisterType((*ResetStickyTaskListResponse)(nil), "uber.cadence.history.v1.ResetStickyTaskListResponse")
+	proto.RegisterType((*GetMutableStateRequest)(nil), "uber.cadence.history.v1.GetMutableStateRequest")
+	proto.RegisterType((*GetMutableStateResponse)(nil), "uber.cadence.history.v1.GetMutableStateResponse")
+	proto.RegisterType((*PollMutableStateRequest)(nil), "uber.cadence.history.v1.PollMutableStateRequest")
+	proto.RegisterType((*PollMutableStateResponse)(nil), "uber.cadence.history.v1.PollMutableStateResponse")
+	proto.RegisterType((*RecordDecisionTaskStartedRequest)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedRequest")
+	proto.RegisterType((*RecordDecisionTaskStartedResponse)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedResponse")
+	proto.RegisterMapType((map[string]*v1.WorkflowQuery)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedResponse.QueriesEntry")
+	proto.RegisterType((*RecordActivityTaskStartedRequest)(nil), "uber.cadence.history.v1.RecordActivityTaskStartedRequest")
+	proto.RegisterType((*RecordActivityTaskStartedResponse)(nil), "uber.cadence.history.v1.RecordActivityTaskStartedResponse")
+	proto.RegisterType((*RespondDecisionTaskCompletedRequest)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedRequest")
+	proto.RegisterType((*RespondDecisionTaskCompletedResponse)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedResponse")
+	proto.RegisterMapType((map[string]*v1.ActivityLocalDispatchInfo)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedResponse.ActivitiesToDispatchLocallyEntry")
+	proto.RegisterType((*RespondDecisionTaskFailedRequest)(nil), "uber.cadence.history.v1.RespondDecisionTaskFailedRequest")
+	proto.RegisterType((*RespondDecisionTaskFailedResponse)(nil), "uber.cadence.history.v1.RespondDecisionTaskFailedResponse")
+	proto.RegisterType((*RecordActivityTaskHeartbeatRequest)(nil), "uber.cadence.history.v1.RecordActivityTaskHeartbeatRequest")
+	proto.RegisterType((*RecordActivityTaskHeartbeatResponse)(nil), "uber.cadence.history.v1.RecordActivityTaskHeartbeatResponse")
+	proto.RegisterType((*RespondActivityTaskCompletedRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskCompletedRequest")
+	proto.RegisterType((*RespondActivityTaskCompletedResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskCompletedResponse")
+	proto.RegisterType((*RespondActivityTaskFailedRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskFailedRequest")
+	proto.RegisterType((*RespondActivityTaskFailedResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskFailedResponse")
+	proto.RegisterType((*RespondActivityTaskCanceledRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskCanceledRequest")
+	proto.RegisterType((*RespondActivityTaskCanceledResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskCanceledResponse")
+	proto.RegisterType((*RemoveSignalMutableStateRequest)(nil), "uber.cadence.history.v1.RemoveSignalMutableStateRequest")
+	proto.RegisterType((*RemoveSignalMutableStateResponse)(nil), "uber.cadence.history.v1.RemoveSignalMutableStateResponse")
+	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.RequestCancelWorkflowExecutionRequest")
+	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.RequestCancelWorkflowExecutionResponse")
+	proto.RegisterType((*ScheduleDecisionTaskRequest)(nil), "uber.cadence.history.v1.ScheduleDecisionTaskRequest")
+	proto.RegisterType((*ScheduleDecisionTaskResponse)(nil), "uber.cadence.history.v1.ScheduleDecisionTaskResponse")
+	proto.RegisterType((*RecordChildExecutionCompletedRequest)(nil), "uber.cadence.history.v1.RecordChildExecutionCompletedRequest")
+	proto.RegisterType((*RecordChildExecutionCompletedResponse)(nil), "uber.cadence.history.v1.RecordChildExecutionCompletedResponse")
+	proto.RegisterType((*ReplicateEventsV2Request)(nil), "uber.cadence.history.v1.ReplicateEventsV2Request")
+	proto.RegisterType((*ReplicateEventsV2Response)(nil), "uber.cadence.history.v1.ReplicateEventsV2Response")
+	proto.RegisterType((*SyncShardStatusRequest)(nil), "uber.cadence.history.v1.SyncShardStatusRequest")
+	proto.RegisterType((*SyncShardStatusResponse)(nil), "uber.cadence.history.v1.SyncShardStatusResponse")
+	proto.RegisterType((*SyncActivityRequest)(nil), "uber.cadence.history.v1.SyncActivityRequest")
+	proto.RegisterType((*SyncActivityResponse)(nil), "uber.cadence.history.v1.SyncActivityResponse")
+	proto.RegisterType((*DescribeMutableStateRequest)(nil), "uber.cadence.history.v1.DescribeMutableStateRequest")
+	proto.RegisterType((*DescribeMutableStateResponse)(nil), "uber.cadence.history.v1.DescribeMutableStateResponse")
+	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "uber.cadence.history.v1.DescribeHistoryHostRequest")
+	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "uber.cadence.history.v1.DescribeHistoryHostResponse")
+	proto.RegisterType((*CloseShardRequest)(nil), "uber.cadence.history.v1.CloseShardRequest")
+	proto.RegisterType((*CloseShardResponse)(nil), "uber.cadence.history.v1.CloseShardResponse")
+	proto.RegisterType((*RemoveTaskRequest)(nil), "uber.cadence.history.v1.RemoveTaskRequest")
+	proto.RegisterType((*RemoveTaskResponse)(nil), "uber.cadence.history.v1.RemoveTaskResponse")
+	proto.RegisterType((*ResetQueueRequest)(nil), "uber.cadence.history.v1.ResetQueueRequest")
+	proto.RegisterType((*ResetQueueResponse)(nil), "uber.cadence.history.v1.ResetQueueResponse")
+	proto.RegisterType((*DescribeQueueRequest)(nil), "uber.cadence.history.v1.DescribeQueueRequest")
+	proto.RegisterType((*DescribeQueueResponse)(nil), "uber.cadence.history.v1.DescribeQueueResponse")
+	proto.RegisterType((*ListThrottledWorkflowIDsRequest)(nil), "uber.cadence.history.v1.ListThrottledWorkflowIDsRequest")
+	proto.RegisterType((*ListThrottledWorkflowIDsResponse)(nil), "uber.cadence.history.v1.ListThrottledWorkflowIDsResponse")
+	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "uber.cadence.history.v1.GetReplicationMessagesRequest")
+	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "uber.cadence.history.v1.GetReplicationMessagesResponse")
+	proto.RegisterMapType((map[int32]*v12.ReplicationMessages)(nil), "uber.cadence.history.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
+	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "uber.cadence.history.v1.GetDLQReplicationMessagesRequest")
+	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "uber.cadence.history.v1.GetDLQReplicationMessagesResponse")
+	proto.RegisterType((*ReapplyEventsRequest)(nil), "uber.cadence.history.v1.ReapplyEventsRequest")
+	proto.RegisterType((*ReapplyEventsResponse)(nil), "uber.cadence.history.v1.ReapplyEventsResponse")
+	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "uber.cadence.history.v1.RefreshWorkflowTasksRequest")
+	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "uber.cadence.history.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*CountDLQMessagesRequest)(nil), "uber.cadence.history.v1.CountDLQMessagesRequest")
+	proto.RegisterType((*CountDLQMessagesResponse)(nil), "uber.cadence.history.v1.CountDLQMessagesResponse")
+	proto.RegisterType((*ReadDLQMessagesRequest)(nil), "uber.cadence.history.v1.ReadDLQMessagesRequest")
+	proto.RegisterType((*ReadDLQMessagesResponse)(nil), "uber.cadence.history.v1.ReadDLQMessagesResponse")
+	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "uber.cadence.history.v1.PurgeDLQMessagesRequest")
+	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "uber.cadence.history.v1.PurgeDLQMessagesResponse")
+	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "uber.cadence.history.v1.MergeDLQMessagesRequest")
+	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "uber.cadence.history.v1.MergeDLQMessagesResponse")
+	proto.RegisterType((*NotifyFailoverMarkersRequest)(nil), "uber.cadence.history.v1.NotifyFailoverMarkersRequest")
+	proto.RegisterType((*NotifyFailoverMarkersResponse)(nil), "uber.cadence.history.v1.NotifyFailoverMarkersResponse")
+	proto.RegisterType((*GetCrossClusterTasksRequest)(nil), "uber.cadence.history.v1.GetCrossClusterTasksRequest")
+	proto.RegisterType((*GetCrossClusterTasksResponse)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse")
+	proto.RegisterMapType((map[int32]v12.GetTaskFailedCause)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse.FailedCauseByShardEntry")
+	proto.RegisterMapType((map[int32]*v12.CrossClusterTaskRequests)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse.TasksByShardEntry")
+	proto.RegisterType((*RespondCrossClusterTasksCompletedRequest)(nil), "uber.cadence.history.v1.RespondCrossClusterTasksCompletedRequest")
+	proto.RegisterType((*RespondCrossClusterTasksCompletedResponse)(nil), "uber.cadence.history.v1.RespondCrossClusterTasksCompletedResponse")
+	proto.RegisterType((*GetFailoverInfoRequest)(nil), "uber.cadence.history.v1.GetFailoverInfoRequest")
+	proto.RegisterType((*GetFailoverInfoResponse)(nil), "uber.cadence.history.v1.GetFailoverInfoResponse")
+	proto.RegisterType((*RatelimitUpdateRequest)(nil), "uber.cadence.history.v1.RatelimitUpdateRequest")
+	proto.RegisterType((*RatelimitUpdateResponse)(nil), "uber.cadence.history.v1.RatelimitUpdateResponse")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/history/v1/service.proto", fileDescriptor_fee8ff76963a38ed)
+}
+
+var fileDescriptor_fee8ff76963a38ed = []byte{
+	// 5327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x5b, 0x6c, 0x1c, 0x47,
+	0x72, 0x18, 0x52, 0x7c, 0x15, 0xc9, 0x25, 0x39, 0xe2, 0x63, 0x35, 0x94, 0x28, 0x72, 0x2d, 0xd9,
+	0x3c, 0xe9, 0xbc, 0x7a, 0xd8, 0x7a, 0x58, 0x96, 0xcf, 0x27, 0x91, 0x92, 0xbc, 0x3e, 0x3d, 0x87,
+	0xb4, 0x9c, 0x04, 0x89, 0xf7, 0x86, 0x3b, 0xbd, 0xe4, 0x44, 0xbb, 0x33, 0xeb, 0xe9, 0x5e, 0x4a,
+	0x6b, 0x04, 0x81, 0x03, 0x1f, 0x82, 0x24, 0x08, 0x72, 0xc1, 0x21, 0x09, 0x02, 0x04, 0x08, 0x10,
+	0x5c, 0x80, 0x43, 0x0e, 0xf9, 0x4b, 0x80, 0x7c, 0x04, 0xf7, 0x75, 0x3f, 0x01, 0xf2, 0x93, 0xdf,
+	0xe4, 0xeb, 0xe0, 0xf8, 0x3e, 0x12, 0x20, 0x5f, 0xb9, 0xef, 0x20, 0xe8, 0xc7, 0xbc, 0x7b, 0x7a,
+	0x67, 0x97, 0x40, 0xe4, 0x73, 0xfc, 0xc7, 0xed, 0xae, 0xaa, 0xae, 0xae, 0xae, 0xaa, 0xee, 0xae,
+	0xaa, 0x69, 0xc2, 0xd9, 0xee, 0x1e, 0xf2, 0x2f, 0x34, 0x2c, 0x1b, 0xb9, 0x0d, 0x74, 0xe1, 0xc0,
+	0xc1, 0xc4, 0xf3, 0x7b, 0x17, 0x0e, 0x2f, 0x5d, 0xc0, 0xc8, 0x3f, 0x74, 0x1a, 0xa8, 0xda, 0xf1,
+	0x3d, 0xe2, 0xe9, 0x2b, 0x14, 0xac, 0x2a, 0xc0, 0xaa, 0x02, 0xac, 0x7a, 0x78, 0xc9, 0x58, 0xdb,
+	0xf7, 0xbc, 0xfd, 0x16, 0xba, 0xc0, 0xc0, 0xf6, 0xba, 0xcd, 0x0b, 0x76, 0xd7, 0xb7, 0x88, 0xe3,
+	0xb9, 0x1c, 0xd1, 0x38, 0x9d, 0xee, 0x27, 0x4e, 0x1b, 0x61, 0x62, 0xb5, 0x3b, 0x02, 0x20, 0x43,
+	0xe0, 0xb9, 0x6f, 0x75, 0x3a, 0xc8, 0xc7, 0xa2, 0x7f, 0x3d, 0xc1, 0xa0, 0xd5, 0x71, 0x28, 0x73,
+	0x0d, 0xaf, 0xdd, 0x0e, 0x87, 0xd8, 0x90, 0x41, 0x04, 0x2c, 0x0a, 0x2e, 0x64, 0x20, 0x1f, 0x77,
+	0x51, 0x08, 0x50, 0x91, 0x01, 0x10, 0x0b, 0x3f, 0x6b, 0x39, 0x98, 0xa8, 0x60, 0x9e, 0x7b, 0xfe,
+	0xb3, 0x66, 0xcb, 0x7b, 0x2e, 0x60, 0xce, 0xc9, 0x60, 0x84, 0x28, 0xeb, 0x29, 0xd8, 0xcd, 0x7e,
+	0xb0, 0xc8, 0x17, 0x90, 0xaf, 0x24, 0x21, 0xed, 0xb6, 0xe3, 0x32, 0x29, 0xb4, 0xba, 0x98, 0xf4,
+	0x03, 0x4a, 0x0a, 0x62, 0x43, 0x0e, 0xf4, 0x71, 0x17, 0x75, 0xc5, 0x52, 0x1b, 0xaf, 0xc9, 0x41,
+	0x7c, 0xd4, 0x69, 0x39, 0x8d, 0xf8, 0xd2, 0x26, 0x57, 0x06, 0x1f, 0x58, 0x3e, 0xb2, 0x29, 0xa4,
+	0xe5, 0x06, 0xa3, 0x9d, 0xc9, 0x81, 0x48, 0xf2, 0x74, 0x36, 0x07, 0x2a, 0x29, 0xae, 0xca, 0x17,
+	0xe3, 0x70, 0x6a, 0x87, 0x58, 0x3e, 0xf9, 0x50, 0xb4, 0xdf, 0x79, 0x81, 0x1a, 0x5d, 0xca, 0x8f,
+	0x89, 0x3e, 0xee, 0x22, 0x4c, 0xf4, 0xfb, 0x30, 0xe1, 0xf3, 0x3f, 0xcb, 0xda, 0xba, 0xb6, 0x39,
+	0x7d, 0xf9, 0x72, 0x35, 0xa1, 0xb6, 0x56, 0xc7, 0xa9, 0x1e, 0x5e, 0xaa, 0x2a, 0x89, 0x98, 0x01,
+	0x09, 0x7d, 0x15, 0xa6, 0x6c, 0xaf, 0x6d, 0x39, 0x6e, 0xdd, 0xb1, 0xcb, 0x23, 0xeb, 0xda, 0xe6,
+	0x94, 0x39, 0xc9, 0x1b, 0x6a, 0xb6, 0xfe, 0xeb, 0xb0, 0xd4, 0xb1, 0x7c, 0xe4, 0x92, 0x3a, 0x0a,
+	0x08, 0xd4, 0x1d, 0xb7, 0xe9, 0x95, 0x47, 0xd9, 0xc0, 0x9b, 0xd2, 0x81, 0x1f, 0x33, 0x8c, 0x70,
+	0xc4, 0x9a, 0xdb, 0xf4, 0xcc, 0xe3, 0x9d, 0x6c, 0xa3, 0x5e, 0x86, 0x09, 0x8b, 0x10, 0xd4, 0xee,
+	0x90, 0xf2, 0xb1, 0x75, 0x6d, 0x73, 0xcc, 0x0c, 0x7e, 0xea, 0x5b, 0x30, 0x87, 0x5e, 0x74, 0x1c,
+	0x6e, 0x62, 0x75, 0x6a, 0x4b, 0xe5, 0x31, 0x36, 0xa2, 0x51, 0xe5, 0x76, 0x54, 0x0d, 0xec, 0xa8,
+	0xba, 0x1b, 0x18, 0x9a, 0x59, 0x8a, 0x50, 0x68, 0xa3, 0xde, 0x84, 0x13, 0x0d, 0xcf, 0x25, 0x8e,
+	0xdb, 0x45, 0x75, 0x0b, 0xd7, 0x5d, 0xf4, 0xbc, 0xee, 0xb8, 0x0e, 0x71, 0x2c, 0xe2, 0xf9, 0xe5,
+	0xf1, 0x75, 0x6d, 0xb3, 0x74, 0xf9, 0xbc, 0x74, 0x02, 0x5b, 0x02, 0xeb, 0x16, 0x7e, 0x88, 0x9e,
+	0xd7, 0x02, 0x14, 0x73, 0xb9, 0x21, 0x6d, 0xd7, 0x6b, 0xb0, 0x10, 0xf4, 0xd8, 0xf5, 0xa6, 0xe5,
+	0xb4, 0xba, 0x3e, 0x2a, 0x4f, 0x30, 0x76, 0x4f, 0x4a, 0xe9, 0xdf, 0xe5, 0x30, 0xe6, 0x7c, 0x88,
+	0x26, 0x5a, 0x74, 0x13, 0x96, 0x5b, 0x16, 0x26, 0xf5, 0x86, 0xd7, 0xee, 0xb4, 0x10, 0x9b, 0xbc,
+	0x8f, 0x70, 0xb7, 0x45, 0xca, 0x93, 0x0a, 0x7a, 0x8f, 0xad, 0x5e, 0xcb, 0xb3, 0x6c, 0x73, 0x91,
+	0xe2, 0x6e, 0x85, 0xa8, 0x26, 0xc3, 0xd4, 0x7f, 0x05, 0x56, 0x9b, 0x8e, 0x8f, 0x49, 0xdd, 0x46,
+	0x0d, 0x07, 0x33, 0x79, 0x5a, 0xf8, 0x59, 0x7d, 0xcf, 0x6a, 0x3c, 0xf3, 0x9a, 0xcd, 0xf2, 0x14,
+	0x23, 0x7c, 0x22, 0x23, 0xd7, 0x6d, 0xe1, 0xe0, 0xcc, 0x32, 0xc3, 0xde, 0x16, 0xc8, 0xbb, 0x16,
+	0x7e, 0x76, 0x9b, 0xa3, 0xea, 0x87, 0x30, 0xdf, 0xb1, 0x7c, 0xe2, 0x30, 0x3e, 0x1b, 0x9e, 0xdb,
+	0x74, 0xf6, 0xcb, 0xb0, 0x3e, 0xba, 0x39, 0x7d, 0xf9, 0x3b, 0xd5, 0x1c, 0x47, 0xaa, 0xd6, 0xca,
+	0xea, 0xe3, 0x80, 0xdc, 0x16, 0xa3, 0x76, 0xc7, 0x25, 0x7e, 0xcf, 0x9c, 0xeb, 0x24, 0x5b, 0x8d,
+	0xdb, 0xb0, 0x28, 0x03, 0xd4, 0xe7, 0x61, 0xf4, 0x19, 0xea, 0x31, 0xa3, 0x98, 0x32, 0xe9, 0x9f,
+	0xfa, 0x22, 0x8c, 0x1d, 0x5a, 0xad, 0x2e, 0x12, 0x8a, 0xcd, 0x7f, 0xdc, 0x18, 0xb9, 0xae, 0x55,
+	0xae, 0xc1, 0x5a, 0x1e, 0x2b, 0xb8, 0xe3, 0xb9, 0x18, 0xe9, 0x4b, 0x30, 0xee, 0x77, 0x99, 0x55,
+	0x70, 0x82, 0x63, 0x7e, 0xd7, 0xad, 0xd9, 0x95, 0xbf, 0x1e, 0x81, 0xb5, 0x1d, 0x67, 0xdf, 0xb5,
+	0x5a, 0xb9, 0x06, 0xfa, 0x20, 0x6d, 0xa0, 0x6f, 0xc8, 0x0d, 0x54, 0x49, 0xa5, 0xa0, 0x85, 0x36,
+	0x61, 0x15, 0xbd, 0x20, 0xc8, 0x77, 0xad, 0x56, 0xe8, 0x78, 0x23, 0x63, 0x15, 0x76, 0xfa, 0xaa,
+	0x74, 0xfc, 0xec, 0xc8, 0x27, 0x02, 0x52, 0x99, 0x2e, 0xbd, 0x0a, 0xc7, 0x1b, 0x07, 0x4e, 0xcb,
+	0x8e, 0x06, 0xf1, 0xdc, 0x56, 0x8f, 0xd9, 0xed, 0xa4, 0xb9, 0xc0, 0xba, 0x02, 0xa4, 0x47, 0x6e,
+	0xab, 0x57, 0xd9, 0x80, 0xd3, 0xb9, 0xf3, 0xe3, 0x02, 0xae, 0xfc, 0x7c, 0x04, 0x5e, 0x13, 0x30,
+	0x0e, 0x39, 0x50, 0xfb, 0xbc, 0xa7, 0x69, 0x91, 0xde, 0x54, 0x89, 0xb4, 0x1f, 0xb9, 0x82, 0xb2,
+	0xfd, 0x54, 0x93, 0x28, 0xf8, 0x28, 0x53, 0xf0, 0x0f, 0xf2, 0x15, 0xbc, 0x18, 0x0b, 0xff, 0x87,
+	0xaa, 0x7e, 0x0b, 0x36, 0xfb, 0x33, 0xa5, 0x56, 0xfa, 0x3f, 0xd0, 0xe0, 0x94, 0x89, 0x30, 0x3a,
+	0xf2, 0xa6, 0xa4, 0x24, 0x52, 0x6c, 0x59, 0xa8, 0xe9, 0xe6, 0x91, 0x51, 0xcf, 0xe2, 0xc7, 0x23,
+	0xb0, 0xb1, 0x8b, 0xfc, 0xb6, 0xe3, 0x5a, 0x04, 0xe5, 0xce, 0xe4, 0x71, 0x7a, 0x26, 0x57, 0xa5,
+	0x33, 0xe9, 0x4b, 0xe8, 0x97, 0xdc, 0x80, 0xcf, 0x40, 0x45, 0x35, 0x45, 0x61, 0xc3, 0x54, 0x31,
+	0x1e, 0x5b, 0x5d, 0x8c, 0x8e, 0xaa, 0x18, 0x4a, 0x22, 0x05, 0x15, 0x63, 0x1d, 0xd6, 0xf2, 0xc8,
+	0x08, 0x76, 0xff, 0x48, 0x83, 0xd3, 0x1f, 0xb8, 0x1d, 0x25, 0xc3, 0x0f, 0xd3, 0x0c, 0xbf, 0x29,
+	0x65, 0xb8, 0x0f, 0x99, 0x82, 0x2c, 0x57, 0x60, 0x3d, 0x9f, 0x90, 0x60, 0xfa, 0x05, 0xf5, 0x01,
+	0x5d, 0x8c, 0x6e, 0x35, 0x88, 0x73, 0xe8, 0x90, 0x5e, 0xc0, 0xe8, 0x56, 0x9a, 0xd1, 0x6f, 0xe4,
+	0x4b, 0x36, 0x85, 0x5b, 0x90, 0xbb, 0x15, 0x58, 0x4a, 0x61, 0x0b, 0x96, 0x7e, 0x0b, 0x96, 0x05,
+	0xdb, 0x69, 0xa6, 0xee, 0xa4, 0x99, 0x3a, 0xaf, 0x92, 0xde, 0x70, 0x6c, 0x9d, 0x80, 0x95, 0x0c,
+	0x7e, 0x24, 0x2b, 0xe6, 0x1b, 0x86, 0x94, 0x95, 0x0c, 0xb7, 0xb8, 0xac, 0x52, 0xd8, 0x82, 0xa5,
+	0xdf, 0xd3, 0xe0, 0xe4, 0x07, 0x1d, 0xdb, 0x22, 0x21, 0xb7, 0x8f, 0x3a, 0x74, 0x7d, 0x71, 0xc0,
+	0xdb, 0x77, 0xd2, 0xbc, 0x5d, 0x92, 0x8b, 0x4c, 0x41, 0xa3, 0x20, 0x8f, 0xa7, 0xe1, 0x54, 0x0e,
+	0x15, 0xc1, 0xeb, 0x1f, 0x6b, 0xb0, 0xbe, 0x8d, 0x70, 0xc3, 0x77, 0xf6, 0xf2, 0x0d, 0xe4, 0x51,
+	0x9a, 0xdf, 0x2b, 0x52, 0x7e, 0xfb, 0xd1, 0x29, 0xc8, 0xf3, 0xff, 0x8c, 0xc2, 0x86, 0x82, 0x94,
+	0xf0, 0xf8, 0x2d, 0x58, 0x89, 0x6e, 0x28, 0x7c, 0xa7, 0x16, 0xe7, 0x57, 0xe5, 0x11, 0x2c, 0x43,
+	0x70, 0x2b, 0x8e, 0x6a, 0x2e, 0x23, 0x69, 0xbb, 0xbe, 0x07, 0x2b, 0x59, 0x57, 0xcd, 0x2f, 0x46,
+	0x23, 0x6c, 0xb4, 0x73, 0xc5, 0x46, 0x63, 0x57, 0xa3, 0xa5, 0xe7, 0xb2, 0x66, 0xfd, 0x43, 0xd0,
+	0x3b, 0xc8, 0xb5, 0x1d, 0x77, 0xbf, 0x6e, 0xf1, 0xd5, 0x72, 0x10, 0x16, 0xa7, 0x8f, 0x9c, 0x7b,
+	0x17, 0x07, 0x0f, 0xd6, 0x96, 0x11, 0x5f, 0xe8, 0x24, 0x1a, 0x1d, 0x84, 0xf5, 0x5f, 0x85, 0xf9,
+	0x80, 0x30, 0xf3, 0xfa, 0x3e, 0x72, 0xcb, 0xc7, 0x18, 0xd9, 0xaa, 0x8a, 0xec, 0x16, 0x85, 0x4d,
+	0x72, 0x3e, 0xd7, 0x89, 0x75, 0xf9, 0xc8, 0xd5, 0x77, 0x22, 0xd2, 0xc1, 0x65, 0x43, 0xdc, 0xdb,
+	0x94, 0x1c, 0x07, 0x77, 0x8b, 0x04, 0xd1, 0xa0, 0x91, 0x9a, 0xf4, 0x13, 0x1a, 0xc2, 0x08, 0xa4,
+	0x37, 0xa0, 0x49, 0xcb, 0x70, 0x0b, 0xaa, 0xde, 0x0f, 0x35, 0x58, 0x4a, 0xa1, 0x0b, 0x75, 0x7b,
+	0x17, 0x66, 0x58, 0x58, 0x25, 0xb8, 0x9d, 0x69, 0x05, 0x6e, 0x67, 0xd3, 0x0c, 0x43, 0x5c, 0xca,
+	0x6a, 0x50, 0x0a, 0x08, 0xfc, 0x26, 0x6a, 0x10, 0x64, 0x0b, 0xc5, 0xa9, 0xe4, 0xcf, 0xc1, 0x14,
+	0x90, 0xe6, 0xec, 0xc7, 0xf1, 0x9f, 0x95, 0xef, 0x69, 0x60, 0x30, 0xcf, 0xb3, 0x43, 0x9c, 0xc6,
+	0xb3, 0x1e, 0xbd, 0xa0, 0xdd, 0x77, 0x30, 0x09, 0xc4, 0x54, 0x4b, 0x8b, 0xe9, 0x42, 0xbe, 0xe7,
+	0x93, 0x52, 0x28, 0x28, 0xac, 0x53, 0xb0, 0x2a, 0xa5, 0x21, 0x3c, 0xcb, 0x7f, 0x6b, 0xb0, 0x7c,
+	0x0f, 0x91, 0x07, 0x5d, 0x62, 0xed, 0xb5, 0xd0, 0x0e, 0xb1, 0x08, 0x32, 0x65, 0x64, 0xb5, 0xd4,
+	0xf1, 0xe8, 0x03, 0xd0, 0x25, 0xa7, 0xa2, 0x91, 0x81, 0x4e, 0x45, 0x0b, 0x19, 0x0b, 0xd3, 0xdf,
+	0x80, 0x65, 0xf4, 0xa2, 0xc3, 0x04, 0x58, 0x77, 0xd1, 0x0b, 0x52, 0x47, 0x87, 0xc8, 0x25, 0x94,
+	0x01, 0x7a, 0xe0, 0x1a, 0x35, 0x8f, 0x07, 0xbd, 0x0f, 0xd1, 0x0b, 0x72, 0x87, 0xf6, 0xd5, 0x6c,
+	0xfd, 0x22, 0x2c, 0x36, 0xba, 0x3e, 0x0b, 0x87, 0xec, 0xf9, 0x96, 0xdb, 0x38, 0xa8, 0x13, 0xef,
+	0x19, 0xb3, 0x1e, 0x6d, 0x73, 0xc6, 0xd4, 0x45, 0xdf, 0x6d, 0xd6, 0xb5, 0x4b, 0x7b, 0x2a, 0xff,
+	0x30, 0x05, 0x2b, 0x99, 0x59, 0x0b, 0x1d, 0x92, 0xcf, 0x4c, 0x3b, 0xea, 0xcc, 0xee, 0xc2, 0x6c,
+	0x48, 0x96, 0xf4, 0x3a, 0x48, 0xc8, 0x6a, 0x43, 0x49, 0x71, 0xb7, 0xd7, 0x41, 0xe6, 0xcc, 0xf3,
+	0xd8, 0x2f, 0xbd, 0x02, 0xb3, 0x32, 0xc1, 0x4c, 0xbb, 0x31, 0x81, 0x3c, 0x85, 0x13, 0x1d, 0x1f,
+	0x1d, 0x3a, 0x5e, 0x17, 0xd7, 0x31, 0xbd, 0x58, 0x20, 0x3b, 0x82, 0x3f, 0xc6, 0xc6, 0x5d, 0xcd,
+	0x04, 0x16, 0x6a, 0x2e, 0xb9, 0xfa, 0xe6, 0x53, 0x7a, 0x3b, 0x31, 0x97, 0x03, 0xec, 0x1d, 0x8e,
+	0x1c, 0xd0, 0x7d, 0x1d, 0x8e, 0xb3, 0x30, 0x08, 0x8f, 0x5b, 0x84, 0x14, 0xc7, 0x18, 0x07, 0xf3,
+	0xb4, 0xeb, 0x2e, 0xed, 0x09, 0xc0, 0x6f, 0xc0, 0x14, 0x0b, 0x69, 0xb4, 0x1c, 0x4c, 0x58, 0x60,
+	0x67, 0xfa, 0xf2, 0x29, 0xf9, 0x99, 0x3d, 0xd0, 0xca, 0x49, 0x22, 0xfe, 0xd2, 0xef, 0xc1, 0x3c,
+	0x66, 0x1a, 0x5b, 0x8f, 0x48, 0x4c, 0x14, 0x21, 0x51, 0xc2, 0x09, 0x45, 0xd7, 0xdf, 0x84, 0xe5,
+	0x46, 0xcb, 0xa1, 0x9c, 0xb6, 0x9c, 0x3d, 0xdf, 0xf2, 0x7b, 0xf5, 0x43, 0xe4, 0x33, 0x0f, 0x38,
+	0xc9, 0x54, 0x7a, 0x91, 0xf7, 0xde, 0xe7, 0x9d, 0x4f, 0x79, 0x5f, 0x0c, 0xab, 0x89, 0x2c, 0xd2,
+	0xf5, 0x51, 0x88, 0x35, 0x15, 0xc7, 0xba, 0xcb, 0x3b, 0x03, 0xac, 0xd3, 0x30, 0x2d, 0xb0, 0x9c,
+	0x76, 0xa7, 0x55, 0x06, 0x06, 0x0a, 0xbc, 0xa9, 0xd6, 0xee, 0xb4, 0x74, 0x0c, 0xe7, 0xd2, 0xb3,
+	0xaa, 0xe3, 0xc6, 0x01, 0xb2, 0xbb, 0x2d, 0x54, 0x27, 0x1e, 0x5f, 0x2c, 0x16, 0x57, 0xf3, 0xba,
+	0xa4, 0x3c, 0xdd, 0x2f, 0x04, 0x74, 0x26, 0x39, 0xd7, 0x1d, 0x41, 0x69, 0xd7, 0x63, 0xeb, 0xb6,
+	0xcb, 0xc9, 0xd0, 0x1b, 0x06, 0x5f, 0x2a, 0x4c, 0xbc, 0xd8, 0x44, 0x66, 0x58, 0x68, 0x6f, 0x81,
+	0x75, 0xed, 0x10, 0x2f, 0x9a, 0x45, 0x9e, 0x39, 0xcd, 0xe6, 0x99, 0x93, 0x7e, 0x1f, 0x4a, 0xa1,
+	0x6e, 0x63, 0x6a, 0x4c, 0xe5, 0x12, 0x0b, 0xe3, 0x9d, 0x4d, 0x2e, 0x15, 0x8f, 0xad, 0xc6, 0xf5,
+	0x9b, 0x5b, 0xde, 0xec, 0xf3, 0xf8, 0x4f, 0xbd, 0x01, 0x8b, 0x21, 0xb5, 0x46, 0xcb, 0xc3, 0x48,
+	0xd0, 0x9c, 0x63, 0x34, 0x2f, 0x15, 0x3c, 0x30, 0x50, 0x44, 0x4a, 0xaf, 0x8b, 0xcd, 0xd0, 0x9e,
+	0xc3, 0x46, 0x6a, 0xe5, 0x0b, 0x42, 0x10, 0x75, 0x1e, 0x24, 0xa0, 0xbb, 0xf8, 0xbc, 0x6c, 0x4f,
+	0x8c, 0xb8, 0x16, 0x02, 0x7a, 0x2f, 0x80, 0x37, 0xe7, 0x0f, 0x53, 0x2d, 0xfa, 0x4d, 0x58, 0x75,
+	0x70, 0x9d, 0x2f, 0x4b, 0x6c, 0x8d, 0x91, 0x4b, 0xfd, 0x8c, 0x5d, 0x5e, 0x60, 0xb7, 0xba, 0x15,
+	0x07, 0x27, 0xbd, 0xf1, 0x1d, 0xde, 0xad, 0x6f, 0xc0, 0x8c, 0x88, 0x58, 0xd4, 0xb1, 0xf3, 0x09,
+	0x2a, 0xeb, 0xdc, 0xb4, 0x45, 0xdb, 0x8e, 0xf3, 0x09, 0xaa, 0xfc, 0x42, 0x83, 0x95, 0xc7, 0x5e,
+	0xab, 0xf5, 0xff, 0xcc, 0x61, 0xff, 0x68, 0x12, 0xca, 0xd9, 0x69, 0x7f, 0xed, 0xb1, 0xbf, 0xf6,
+	0xd8, 0x5f, 0x45, 0x8f, 0x9d, 0x67, 0x1f, 0x33, 0xb9, 0x1e, 0x58, 0xea, 0xce, 0x66, 0x8f, 0xec,
+	0xce, 0x7e, 0xf9, 0x1c, 0x7b, 0xe5, 0xa7, 0x23, 0xb0, 0x6e, 0xa2, 0x86, 0xe7, 0xdb, 0xf1, 0xd4,
+	0x88, 0x30, 0x8b, 0x97, 0xe9, 0x29, 0x4f, 0xc3, 0x74, 0xa8, 0x38, 0xa1, 0x13, 0x80, 0xa0, 0xa9,
+	0x66, 0xeb, 0x2b, 0x30, 0xc1, 0x74, 0x4c, 0x58, 0xfc, 0xa8, 0x39, 0x4e, 0x7f, 0xd6, 0x6c, 0xfd,
+	0x14, 0x80, 0x38, 0xea, 0x07, 0xb6, 0x3b, 0x65, 0x4e, 0x89, 0x96, 0x9a, 0xad, 0x9b, 0x30, 0xd3,
+	0xf1, 0x5a, 0xad, 0xba, 0x68, 0x29, 0x8f, 0x2b, 0xae, 0x13, 0xd4, 0x87, 0xde, 0xf5, 0xfc, 0xb8,
+	0x68, 0x82, 0xeb, 0xc4, 0x34, 0x25, 0x22, 0x7e, 0x54, 0x7e, 0x32, 0x09, 0x1b, 0x0a, 0x29, 0x0a,
+	0xc7, 0x9b, 0xf1, 0x90, 0xda, 0x70, 0x1e, 0x52, 0xe9, 0xfd, 0x46, 0x86, 0xf7, 0x7e, 0xdf, 0x04,
+	0x3d, 0x90, 0xaf, 0x9d, 0x76, 0xbf, 0xf3, 0x61, 0x4f, 0x00, 0xbd, 0x49, 0x1d, 0x98, 0xc4, 0xf5,
+	0x8e, 0x9a, 0x25, 0xd1, 0x1e, 0x40, 0x66, 0x3c, 0xfa, 0x58, 0xd6, 0xa3, 0xc7, 0x92, 0xa8, 0xe3,
+	0xc9, 0x24, 0xea, 0x75, 0x28, 0x0b, 0x97, 0x12, 0xc5, 0x28, 0x82, 0x03, 0xc2, 0x04, 0x3b, 0x20,
+	0x2c, 0xf3, 0xfe, 0x50, 0x77, 0x82, 0xf3, 0x81, 0x09, 0xb3, 0x61, 0xb2, 0x90, 0x45, 0x35, 0x78,
+	0xf6, 0xf1, 0xf5, 0x3c, 0x6b, 0xdc, 0xf5, 0x2d, 0x17, 0x3b, 0xc8, 0x25, 0x89, 0x9b, 0xfc, 0x8c,
+	0x1d, 0xfb, 0xa5, 0x7f, 0x04, 0x27, 0x25, 0x31, 0x93, 0xc8, 0x85, 0x4f, 0x15, 0x71, 0xe1, 0x27,
+	0x32, 0xea, 0x1e, 0x74, 0xe5, 0x9d, 0x3e, 0x21, 0xef, 0xf4, 0xb9, 0x01, 0x33, 0x09, 0x9f, 0x37,
+	0xcd, 0x7c, 0xde, 0xf4, 0x5e, 0xcc, 0xd9, 0xdd, 0x82, 0x52, 0xb4, 0xac, 0x2c, 0x09, 0x3d, 0xd3,
+	0x37, 0x09, 0x3d, 0x1b, 0x62, 0xd0, 0x36, 0xfd, 0x1d, 0x98, 0x09, 0xd6, 0x9a, 0x11, 0x98, 0xed,
+	0x4b, 0x60, 0x5a, 0xc0, 0x33, 0x74, 0x0b, 0x26, 0xe8, 0x65, 0x9f, 0x3a, 0xd9, 0x12, 0x0b, 0xd1,
+	0xdc, 0xcb, 0xcd, 0x3b, 0xf5, 0xb5, 0x22, 0x16, 0x45, 0x70, 0x10, 0xe6, 0x99, 0xa6, 0x80, 0x6e,
+	0xe6, 0x2c, 0x38, 0x97, 0x39, 0x0b, 0xea, 0x6f, 0x65, 0x13, 0xe9, 0xb8, 0xbb, 0xbf, 0x8f, 0x30,
+	0x8d, 0x5b, 0xcc, 0x73, 0x4d, 0x4a, 0xe4, 0xc6, 0x77, 0x82, 0x5e, 0xe3, 0x23, 0x98, 0x89, 0x0f,
+	0x2b, 0xc9, 0x5b, 0x5d, 0x8f, 0xe7, 0xad, 0xf2, 0x02, 0x20, 0x81, 0x4d, 0xf3, 0x40, 0x48, 0x2c,
+	0xb7, 0x15, 0x79, 0xe1, 0x20, 0xec, 0xf5, 0xb5, 0x17, 0xce, 0x78, 0xe1, 0xb8, 0x68, 0xa4, 0x5e,
+	0xf8, 0x8b, 0xd1, 0xc0, 0x0b, 0x4b, 0xa5, 0x28, 0xbc, 0xf0, 0xfb, 0x30, 0x97, 0xf2, 0x72, 0x4a,
+	0x3f, 0xcc, 0x77, 0xf7, 0x1e, 0xf3, 0x53, 0x66, 0x29, 0xe9, 0x05, 0x33, 0x76, 0x31, 0x32, 0x98,
+	0x5d, 0xc4, 0x9c, 0xde, 0x68, 0xd2, 0xe9, 0x7d, 0x04, 0x6b, 0x49, 0x9b, 0xad, 0x7b, 0xcd, 0x3a,
+	0x39, 0x70, 0x70, 0x3d, 0x5e, 0x6a, 0xa2, 0x1e, 0xca, 0x48, 0xd8, 0xf0, 0xa3, 0xe6, 0xee, 0x81,
+	0x83, 0x6f, 0x09, 0xfa, 0x35, 0x58, 0x38, 0x40, 0x96, 0x4f, 0xf6, 0x90, 0x45, 0xea, 0x36, 0x22,
+	0x96, 0xd3, 0xc2, 0xe5, 0xb1, 0x02, 0xe1, 0xbf, 0xf9, 0x10, 0x6d, 0x9b, 0x63, 0x65, 0x77, 0xb5,
+	0xf1, 0xe1, 0x76, 0xb5, 0xd7, 0x60, 0x2e, 0xa4, 0xc3, 0xd5, 0x9a, 0xb9, 0xf7, 0x29, 0x33, 0x3c,
+	0x53, 0x6d, 0xb3, 0xd6, 0xca, 0x9f, 0x69, 0xf0, 0x0a, 0x5f, 0xcd, 0x84, 0x9f, 0x10, 0x15, 0x23,
+	0x91, 0xbd, 0x98, 0xe9, 0x90, 0xe1, 0xf5, 0xbc, 0x90, 0x61, 0x3f, 0x52, 0x05, 0x63, 0x87, 0x7f,
+	0x37, 0x0a, 0x67, 0xd4, 0xd4, 0x84, 0x0a, 0xa2, 0x68, 0xeb, 0xf4, 0x45, 0x9b, 0x60, 0xf1, 0xc6,
+	0xf0, 0x8e, 0xd1, 0x9c, 0xc3, 0x29, 0x4d, 0xff, 0xa1, 0x06, 0x6b, 0x51, 0xd0, 0x9d, 0x1e, 0xbf,
+	0x6d, 0x07, 0x77, 0x2c, 0xd2, 0x38, 0xa8, 0xb7, 0xbc, 0x86, 0xd5, 0x6a, 0xf5, 0xca, 0x23, 0xcc,
+	0x1d, 0x7f, 0xa4, 0x18, 0xb5, 0xff, 0x74, 0xaa, 0x51, 0x54, 0x7e, 0xd7, 0xdb, 0x16, 0x23, 0xdc,
+	0xe7, 0x03, 0x70, 0x2f, 0xbd, 0x6a, 0xe5, 0x43, 0x18, 0xbf, 0x0d, 0xeb, 0xfd, 0x08, 0x48, 0xfc,
+	0xed, 0x76, 0xd2, 0xdf, 0xca, 0x63, 0xfe, 0x81, 0x1b, 0x60, 0xb4, 0x02, 0xc2, 0x6c, 0x53, 0x8f,
+	0xf9, 0x5e, 0x9a, 0x2c, 0x92, 0x4c, 0x93, 0xd6, 0x32, 0x21, 0x7b, 0xc0, 0x64, 0x51, 0x3f, 0x3a,
+	0x05, 0x15, 0xe9, 0x15, 0xd8, 0x50, 0x50, 0x12, 0xa1, 0xe8, 0x3f, 0xd1, 0xa0, 0x92, 0xf5, 0x76,
+	0xef, 0x05, 0xe6, 0x19, 0x70, 0xfe, 0x24, 0xcd, 0xf9, 0xb5, 0x1c, 0xce, 0xfb, 0x51, 0x2a, 0xc8,
+	0xfb, 0x63, 0x78, 0x45, 0x49, 0x4b, 0xe8, 0xe6, 0x37, 0x60, 0xbe, 0x61, 0xb9, 0x0d, 0x14, 0xee,
+	0x00, 0x88, 0xef, 0x69, 0x93, 0xe6, 0x1c, 0x6f, 0x37, 0x83, 0xe6, 0xb8, 0xbd, 0xc7, 0x69, 0x1e,
+	0xd1, 0xde, 0x55, 0xa4, 0x0a, 0x4e, 0xf5, 0x55, 0x38, 0xa3, 0x26, 0x16, 0x4b, 0x47, 0x4a, 0x00,
+	0x8f, 0xa2, 0x61, 0xb9, 0x74, 0x06, 0xd6, 0x30, 0x19, 0xa5, 0x84, 0x86, 0x65, 0x27, 0xc8, 0xd6,
+	0x07, 0xd9, 0x03, 0x6b, 0x58, 0x3f, 0x4a, 0x05, 0x79, 0x3f, 0x0b, 0xaf, 0x28, 0x69, 0x09, 0xee,
+	0xff, 0x5e, 0x83, 0xd3, 0x26, 0x6a, 0x7b, 0x87, 0x88, 0x97, 0x0d, 0x7d, 0x59, 0x42, 0x80, 0xc9,
+	0x83, 0xd1, 0x68, 0xea, 0x60, 0x44, 0x4b, 0x29, 0xf2, 0xb9, 0x16, 0x53, 0xfb, 0xc7, 0x11, 0x38,
+	0x2b, 0xa6, 0xc0, 0xa7, 0x9d, 0x9b, 0xe4, 0x56, 0x4e, 0xd0, 0x82, 0x52, 0xd2, 0x06, 0xcb, 0x23,
+	0xb2, 0x4d, 0x28, 0x5c, 0xbf, 0x02, 0x03, 0x9a, 0xb3, 0x09, 0xeb, 0xa5, 0x29, 0xe6, 0xb0, 0x2c,
+	0x48, 0x5a, 0x7b, 0x2b, 0x4f, 0x31, 0xdf, 0x11, 0x38, 0xa9, 0x14, 0x33, 0x92, 0x35, 0x0f, 0x5c,
+	0x12, 0xb4, 0x09, 0xaf, 0xf6, 0x9b, 0x8b, 0x90, 0xf3, 0x4f, 0x34, 0x58, 0x0d, 0x62, 0x4e, 0x92,
+	0x18, 0xc0, 0x4b, 0x51, 0x9f, 0x73, 0xb0, 0xe0, 0xe0, 0x7a, 0xb2, 0x14, 0x96, 0xc9, 0x72, 0xd2,
+	0x9c, 0x73, 0xf0, 0xdd, 0x78, 0x91, 0x6b, 0x65, 0x0d, 0x4e, 0xca, 0xd9, 0x17, 0xf3, 0xfb, 0x8c,
+	0x1d, 0x58, 0xa8, 0xb3, 0x4e, 0xa6, 0xc5, 0x33, 0xae, 0xf5, 0x65, 0x4c, 0x74, 0x03, 0x66, 0x44,
+	0x9d, 0x33, 0xb2, 0x63, 0x61, 0xe0, 0xb0, 0xad, 0x66, 0xeb, 0x1f, 0xc2, 0xf1, 0x46, 0xc0, 0x6a,
+	0x6c, 0xe8, 0x63, 0x03, 0x0d, 0xad, 0x87, 0x24, 0xa2, 0xb1, 0xef, 0xc3, 0x7c, 0xac, 0x76, 0x99,
+	0x5f, 0x12, 0xc6, 0x8a, 0x5e, 0x12, 0xe6, 0x22, 0x54, 0xd6, 0x40, 0x2d, 0x3e, 0x38, 0xee, 0x39,
+	0x36, 0x3b, 0x1e, 0x8f, 0x9a, 0x53, 0xa2, 0xa5, 0x66, 0x57, 0x5e, 0x83, 0xb3, 0x7d, 0x16, 0x41,
+	0x2c, 0xd7, 0x7f, 0x8c, 0x40, 0xd9, 0x14, 0x85, 0xfd, 0x88, 0x91, 0xc6, 0x4f, 0x2f, 0xbf, 0xcc,
+	0x25, 0xfa, 0x0d, 0x58, 0x4a, 0x86, 0x51, 0x7b, 0x75, 0x87, 0xa0, 0x76, 0x50, 0xdf, 0x91, 0xae,
+	0x64, 0xa0, 0x1f, 0x27, 0x64, 0x22, 0xa9, 0xbd, 0x1a, 0x41, 0x6d, 0xf3, 0xf8, 0x61, 0xa6, 0x0d,
+	0xeb, 0x57, 0x60, 0x9c, 0x89, 0x1e, 0x97, 0x8f, 0x29, 0xa2, 0x2a, 0xdb, 0x16, 0xb1, 0x6e, 0xb7,
+	0xbc, 0x3d, 0x53, 0x00, 0xeb, 0x5b, 0x50, 0xa2, 0x77, 0x7b, 0x5a, 0x3a, 0x29, 0xd0, 0xc7, 0x8a,
+	0xa0, 0xcf, 0xb8, 0xe8, 0xb9, 0xd9, 0xe5, 0x4b, 0x86, 0x2b, 0xab, 0x70, 0x42, 0x22, 0xea, 0xa8,
+	0x5c, 0x70, 0x79, 0xa7, 0xe7, 0x36, 0x76, 0x0e, 0x2c, 0xdf, 0x16, 0xc1, 0x55, 0xb1, 0x0c, 0x67,
+	0xa1, 0x84, 0xbd, 0xae, 0xdf, 0x40, 0x75, 0xf1, 0xbd, 0x87, 0x58, 0x8b, 0x59, 0xde, 0xba, 0xc5,
+	0x1b, 0xf5, 0x13, 0x30, 0x49, 0xe3, 0x4e, 0x76, 0xb0, 0xbf, 0x8d, 0x99, 0x13, 0xec, 0x77, 0xcd,
+	0xd6, 0xab, 0x70, 0x8c, 0xdd, 0x25, 0x47, 0xfb, 0x5e, 0xf0, 0x18, 0x1c, 0x2d, 0x23, 0xcb, 0xf0,
+	0x22, 0xf8, 0xfc, 0xd9, 0x18, 0x1c, 0xa7, 0x7d, 0xe9, 0x32, 0xb2, 0x97, 0xa1, 0x2b, 0x65, 0x98,
+	0x08, 0x82, 0x59, 0xdc, 0x92, 0x83, 0x9f, 0xd4, 0xd0, 0xa3, 0xbb, 0x6e, 0x18, 0x47, 0x08, 0xe3,
+	0x0e, 0x54, 0x26, 0xd9, 0x10, 0xd6, 0xd8, 0xa0, 0x21, 0x2c, 0xb5, 0x11, 0x66, 0x6e, 0xf2, 0x13,
+	0x83, 0xdd, 0xe4, 0xdf, 0x17, 0x89, 0xa3, 0xe8, 0x52, 0xcd, 0xa8, 0x4c, 0xf6, 0xa5, 0xb2, 0x40,
+	0xd1, 0xc2, 0xe3, 0x31, 0xa3, 0x75, 0x15, 0x26, 0x82, 0x1b, 0xf9, 0x54, 0x81, 0x1b, 0x79, 0x00,
+	0x1c, 0x8f, 0x26, 0x40, 0x32, 0x9a, 0xf0, 0x2e, 0xcc, 0xf0, 0xb4, 0x96, 0xf8, 0xaa, 0x63, 0xba,
+	0xc0, 0x57, 0x1d, 0xd3, 0x2c, 0xdb, 0xc5, 0x7f, 0xd0, 0x0c, 0x0b, 0x23, 0xc0, 0xbf, 0x73, 0xaa,
+	0x3b, 0x36, 0x72, 0x89, 0x43, 0x7a, 0x2c, 0x90, 0x38, 0x65, 0xea, 0xb4, 0xef, 0x43, 0xd6, 0x55,
+	0x13, 0x3d, 0xfa, 0x43, 0x98, 0x4b, 0xb9, 0x06, 0x11, 0x34, 0x3c, 0x5b, 0xc8, 0x29, 0x98, 0xa5,
+	0xa4, 0x43, 0xd0, 0x97, 0x61, 0x9c, 0x95, 0x4a, 0xda, 0x2c, 0xa5, 0x32, 0x69, 0x8a, 0x5f, 0x95,
+	0x65, 0x58, 0x4c, 0x6a, 0xb8, 0x50, 0xfd, 0x9f, 0x6a, 0xb0, 0x1a, 0xd4, 0xdb, 0x7d, 0x59, 0x4e,
+	0x7e, 0x17, 0x61, 0xd1, 0x47, 0x7b, 0x5d, 0x7a, 0x54, 0x69, 0xfa, 0x5e, 0x3b, 0x14, 0x0c, 0xdf,
+	0xbd, 0x75, 0xd1, 0x77, 0xd7, 0xf7, 0xda, 0x62, 0xd6, 0x95, 0x7f, 0xd3, 0xe0, 0xa4, 0x7c, 0x16,
+	0xe2, 0x1a, 0xf5, 0x06, 0x2c, 0xb7, 0x79, 0x3b, 0x4f, 0x0e, 0xd5, 0x1d, 0xb7, 0xde, 0xb0, 0x1a,
+	0x07, 0x48, 0xcc, 0xe9, 0x78, 0x3b, 0x86, 0x55, 0x73, 0xb7, 0x68, 0x17, 0x0d, 0x84, 0x66, 0x90,
+	0x6c, 0x8b, 0x58, 0x7b, 0x16, 0x0e, 0xea, 0xee, 0x97, 0x93, 0x78, 0xdb, 0xa2, 0x57, 0x7f, 0x1f,
+	0x2a, 0x49, 0x54, 0xce, 0x34, 0xc9, 0x4e, 0x68, 0xca, 0x5c, 0x6b, 0x27, 0x38, 0x66, 0x70, 0xf1,
+	0xc9, 0x9d, 0x04, 0x23, 0x98, 0x9b, 0x68, 0x7a, 0xcf, 0x0b, 0xcb, 0xb5, 0x2a, 0xbf, 0x33, 0x02,
+	0xab, 0xd2, 0x6e, 0x31, 0xf3, 0x4d, 0x98, 0x77, 0xbb, 0xed, 0x3d, 0xe4, 0xd3, 0xc8, 0x18, 0xf3,
+	0x9d, 0x98, 0xcd, 0x79, 0xcc, 0x2c, 0xf1, 0xf6, 0x47, 0x4d, 0xe6, 0x12, 0x31, 0x5d, 0xea, 0xc0,
+	0xd7, 0x62, 0x16, 0xf0, 0x18, 0x33, 0x27, 0x85, 0xb3, 0xc5, 0x7a, 0x0d, 0x66, 0x84, 0x1e, 0x70,
+	0xb1, 0xc9, 0x0b, 0xd5, 0x03, 0x25, 0xe5, 0x11, 0x28, 0x26, 0x45, 0x76, 0x22, 0x9d, 0xb6, 0xa3,
+	0x06, 0xfd, 0x2a, 0xac, 0xf0, 0x71, 0x1a, 0x9e, 0x4b, 0x7c, 0xaf, 0xd5, 0x42, 0x3e, 0x13, 0x52,
+	0x97, 0xef, 0x5f, 0x53, 0xe6, 0x12, 0xeb, 0xde, 0x0a, 0x7b, 0xb9, 0xb7, 0x66, 0x76, 0x6b, 0xdb,
+	0x3e, 0xc2, 0x58, 0x84, 0x49, 0x83, 0x9f, 0x95, 0x2a, 0x2c, 0xf0, 0x54, 0x1d, 0xc5, 0x0b, 0x34,
+	0x37, 0xbe, 0x75, 0x68, 0x89, 0xad, 0xa3, 0xb2, 0x08, 0x7a, 0x1c, 0x5e, 0x98, 0xc2, 0x7f, 0x69,
+	0xb0, 0xc0, 0xaf, 0x14, 0xf1, 0xb3, 0x6b, 0x3e, 0x19, 0xfd, 0xa6, 0x48, 0x6b, 0x87, 0x59, 0xfc,
+	0xd2, 0xe5, 0xd3, 0x39, 0x02, 0xa1, 0x14, 0x59, 0x2c, 0x6f, 0x92, 0x88, 0xbf, 0xe2, 0x11, 0xe1,
+	0xd1, 0x44, 0x44, 0x78, 0x0b, 0xe6, 0x0e, 0x1d, 0xec, 0xec, 0x39, 0x2d, 0x87, 0xf4, 0xb8, 0x7f,
+	0xec, 0x1f, 0xc4, 0x2c, 0x45, 0x28, 0xb4, 0x91, 0x6e, 0x16, 0x62, 0x63, 0xad, 0xbb, 0x96, 0xd8,
+	0x07, 0xa6, 0xcc, 0x69, 0xd1, 0xf6, 0xd0, 0x6a, 0x23, 0x2a, 0x85, 0xf8, 0x74, 0x85, 0x14, 0xbe,
+	0xcf, 0xa4, 0x80, 0x11, 0x79, 0xd2, 0x45, 0x5d, 0x54, 0x40, 0x0a, 0xe9, 0x91, 0x46, 0x32, 0x23,
+	0x25, 0x05, 0x35, 0x3a, 0xa0, 0xa0, 0x38, 0x9f, 0x11, 0x43, 0x82, 0xcf, 0x1f, 0x68, 0xb0, 0x18,
+	0xe8, 0xfd, 0x97, 0x86, 0xd5, 0x47, 0xb0, 0x94, 0xe2, 0x49, 0x58, 0xe1, 0x55, 0x58, 0xe9, 0xf8,
+	0x5e, 0x03, 0x61, 0x4c, 0xab, 0x65, 0xd9, 0x87, 0xa9, 0xdc, 0x31, 0x50, 0x63, 0x1c, 0xa5, 0x3a,
+	0x1f, 0x75, 0x33, 0x4c, 0xe6, 0x0c, 0x70, 0xe5, 0x26, 0x9c, 0xa6, 0xe9, 0xae, 0xdd, 0x03, 0xdf,
+	0x23, 0xa4, 0x85, 0xc2, 0x0b, 0x5a, 0x6d, 0x1b, 0x17, 0xd0, 0xf3, 0xef, 0x69, 0xb0, 0x9e, 0x8f,
+	0x2e, 0x58, 0xfb, 0x2e, 0x2c, 0x93, 0xa0, 0x3f, 0xba, 0x1a, 0x3a, 0x36, 0xe7, 0x2c, 0x7b, 0xf3,
+	0x0c, 0xa7, 0x9f, 0x25, 0x6a, 0x2e, 0x92, 0x4c, 0xa3, 0x8d, 0x2b, 0x9f, 0x69, 0x70, 0xea, 0x1e,
+	0x22, 0x66, 0xf4, 0xad, 0xed, 0x03, 0x84, 0xb1, 0xb5, 0x8f, 0xc2, 0x39, 0xbc, 0x0b, 0xe3, 0x2c,
+	0x2d, 0x17, 0x8c, 0xf9, 0x5a, 0xce, 0x98, 0x31, 0x12, 0x2c, 0x67, 0x67, 0x0a, 0xb4, 0x02, 0x2b,
+	0x4b, 0x1d, 0xe5, 0x5a, 0x1e, 0x17, 0x42, 0x14, 0x1f, 0x43, 0x89, 0x8b, 0xb2, 0x2d, 0x7a, 0x04,
+	0x3b, 0xef, 0xe7, 0xc6, 0x7d, 0xd5, 0x04, 0xab, 0xcc, 0xc1, 0x04, 0xad, 0x3c, 0xc6, 0x3b, 0x8b,
+	0xe3, 0x6d, 0x46, 0x0b, 0xf4, 0x2c, 0x50, 0x3c, 0x8e, 0x3b, 0xc6, 0xe3, 0xb8, 0xdf, 0x4e, 0xc6,
+	0x71, 0xcf, 0xf5, 0x17, 0x50, 0xc8, 0x4c, 0x2c, 0x86, 0xdb, 0x86, 0xf5, 0x7b, 0x88, 0x6c, 0xdf,
+	0x7f, 0xa2, 0x58, 0x8b, 0x1a, 0x00, 0xf7, 0x4b, 0x6e, 0xd3, 0xeb, 0xa7, 0x03, 0xf1, 0xf5, 0xa0,
+	0xce, 0x8b, 0xfa, 0xfa, 0x29, 0x22, 0xfe, 0xc2, 0x95, 0x17, 0xb0, 0xa1, 0x18, 0x4e, 0x08, 0x7d,
+	0x07, 0x16, 0x62, 0x5f, 0x61, 0xb3, 0x14, 0x71, 0x30, 0xec, 0xab, 0xc5, 0x86, 0x35, 0xe7, 0xfd,
+	0x64, 0x03, 0xae, 0xfc, 0xab, 0x46, 0xbf, 0x0c, 0xb1, 0x3a, 0x9d, 0x16, 0xbf, 0x6c, 0x86, 0xb3,
+	0x5b, 0x86, 0x71, 0x91, 0x34, 0xe1, 0x1b, 0xbf, 0xf8, 0xa5, 0xfe, 0x68, 0x4b, 0x7e, 0xce, 0x19,
+	0x3d, 0xea, 0x39, 0x67, 0xb8, 0x7b, 0x1b, 0xff, 0xf4, 0x24, 0x31, 0x35, 0xe1, 0x12, 0xff, 0x46,
+	0xa3, 0x45, 0xd9, 0x4d, 0x1f, 0xe1, 0x83, 0x30, 0x7f, 0x44, 0xa5, 0xf1, 0x25, 0x9c, 0x3b, 0x0d,
+	0xb9, 0xc8, 0x59, 0x15, 0x73, 0x79, 0x0b, 0x56, 0xb6, 0xbc, 0xae, 0x4b, 0x95, 0x27, 0xad, 0xa0,
+	0x6b, 0x00, 0x4d, 0xcf, 0x6f, 0xa0, 0xbb, 0x88, 0x34, 0x0e, 0x44, 0x30, 0x3c, 0xd6, 0x52, 0xb1,
+	0xa0, 0x9c, 0x45, 0x15, 0xca, 0x76, 0x07, 0x26, 0x90, 0x4b, 0x58, 0x86, 0x9d, 0xab, 0xd8, 0xf9,
+	0x1c, 0x15, 0x13, 0x47, 0xa9, 0xed, 0xfb, 0x4f, 0x18, 0x2d, 0x91, 0x45, 0x17, 0xb8, 0x95, 0x7f,
+	0x1f, 0x81, 0x65, 0x13, 0x59, 0xb6, 0x84, 0xbb, 0xcb, 0x70, 0x2c, 0xac, 0x59, 0x29, 0x5d, 0x5e,
+	0xcb, 0x3b, 0x20, 0xdd, 0x7f, 0xc2, 0xb6, 0x0e, 0x06, 0xab, 0xba, 0xe5, 0x66, 0xef, 0xc9, 0xa3,
+	0xb2, 0x7b, 0xf2, 0x2e, 0x94, 0x1d, 0x97, 0x42, 0x38, 0x87, 0xa8, 0x8e, 0xdc, 0xd0, 0x83, 0x15,
+	0xac, 0xf3, 0x5b, 0x0a, 0x91, 0xef, 0xb8, 0x81, 0x2b, 0xaa, 0xd9, 0x54, 0x31, 0x3a, 0x94, 0x08,
+	0xab, 0x14, 0x18, 0x63, 0x8c, 0x4d, 0xd2, 0x06, 0x56, 0x26, 0xf0, 0x2a, 0xcc, 0xb1, 0x6a, 0x15,
+	0x06, 0xc1, 0x8b, 0x2a, 0xc6, 0x59, 0x51, 0x05, 0x2b, 0x62, 0x79, 0x6c, 0xed, 0x23, 0x5e, 0x56,
+	0x71, 0x1d, 0xc6, 0x9b, 0x4e, 0x8b, 0x72, 0xce, 0xef, 0x8a, 0xeb, 0xf9, 0x22, 0xb9, 0xcb, 0xe0,
+	0x4c, 0x01, 0x5f, 0xf9, 0xdb, 0x11, 0x58, 0xc9, 0x48, 0x59, 0x2c, 0xe4, 0x30, 0x62, 0x96, 0x7a,
+	0x9a, 0x91, 0xa3, 0x79, 0x1a, 0xba, 0x7d, 0x66, 0x88, 0x06, 0x81, 0xdb, 0x41, 0x5d, 0xe7, 0x62,
+	0x9a, 0x3a, 0x6d, 0x95, 0x09, 0xfa, 0x98, 0x44, 0xd0, 0x95, 0x9f, 0xd3, 0x1a, 0xde, 0xae, 0xbf,
+	0x8f, 0xbe, 0xda, 0x5a, 0x59, 0x31, 0xa0, 0x9c, 0x9d, 0xa6, 0x70, 0x1b, 0x5f, 0x8c, 0xc0, 0xca,
+	0x03, 0xf4, 0x95, 0x97, 0xc1, 0xcb, 0xb6, 0xcc, 0xdb, 0x50, 0x7e, 0x80, 0xe4, 0x4b, 0x20, 0x1b,
+	0x5d, 0x93, 0xa9, 0xeb, 0xa7, 0x1a, 0x9c, 0x7c, 0xe8, 0x11, 0xa7, 0xd9, 0xa3, 0xd1, 0x13, 0xef,
+	0x10, 0xf9, 0x0f, 0x2c, 0x1a, 0x1a, 0x09, 0xd7, 0xeb, 0xbb, 0xb0, 0xdc, 0x14, 0x3d, 0xf5, 0x36,
+	0xeb, 0xaa, 0x27, 0x0e, 0x89, 0x79, 0x96, 0x95, 0x24, 0xc7, 0x06, 0x33, 0x17, 0x9b, 0xd9, 0x46,
+	0x4c, 0x3f, 0x90, 0xcc, 0xe1, 0x40, 0xa8, 0x93, 0x05, 0xab, 0xf7, 0x10, 0xd9, 0xf2, 0x3d, 0x8c,
+	0xc5, 0x7a, 0x26, 0x36, 0xd4, 0xc4, 0x8d, 0x59, 0x4b, 0xdd, 0x98, 0xcf, 0x42, 0x89, 0x58, 0xfe,
+	0x3e, 0x22, 0xa1, 0x7e, 0xf0, 0xad, 0x75, 0x96, 0xb7, 0x0a, 0x7a, 0x95, 0x5f, 0x8c, 0xc2, 0x49,
+	0xf9, 0x18, 0x42, 0x9e, 0x6d, 0x28, 0x71, 0xa7, 0xb2, 0xd7, 0xe3, 0xf7, 0xf7, 0xb2, 0xd6, 0xa7,
+	0x36, 0x4c, 0x45, 0x8e, 0xdd, 0x5a, 0xf0, 0xed, 0x1e, 0x3b, 0x74, 0xf2, 0x5d, 0x6d, 0x86, 0xc4,
+	0x9a, 0xe8, 0x2b, 0x08, 0x4b, 0x4d, 0x96, 0xdf, 0xac, 0x37, 0xac, 0x2e, 0x46, 0xd1, 0xb0, 0xdc,
+	0x53, 0x3e, 0x18, 0x6e, 0x58, 0x9e, 0x32, 0xdd, 0xa2, 0x14, 0x13, 0x83, 0xeb, 0xcd, 0x4c, 0x87,
+	0xd1, 0x81, 0x85, 0x0c, 0x97, 0x92, 0x23, 0xf1, 0x9d, 0xe4, 0x91, 0xf8, 0x42, 0x8e, 0x3a, 0xa4,
+	0x79, 0x12, 0x8b, 0x17, 0x3f, 0x17, 0x1b, 0x1d, 0x58, 0xc9, 0x61, 0x50, 0x32, 0xee, 0xbb, 0xf1,
+	0x71, 0x4b, 0xb9, 0xd1, 0xfb, 0x7b, 0x88, 0x44, 0xb9, 0x62, 0x46, 0x37, 0x7e, 0x12, 0xff, 0x4f,
+	0x0d, 0x36, 0x45, 0x76, 0x36, 0x23, 0xb4, 0x4c, 0x5a, 0x49, 0x71, 0xa5, 0x2d, 0xa6, 0x65, 0xfa,
+	0x53, 0xae, 0x44, 0x61, 0x19, 0x4d, 0x90, 0x7a, 0x28, 0x2e, 0x34, 0x8e, 0x47, 0xe9, 0x46, 0xbf,
+	0xb0, 0x7e, 0x06, 0x66, 0x9b, 0xf4, 0xd0, 0xf5, 0x10, 0xf1, 0xf3, 0x9b, 0xc8, 0x26, 0x26, 0x1b,
+	0x2b, 0x3e, 0x7c, 0xa3, 0xc0, 0x5c, 0xc3, 0x23, 0xda, 0x58, 0x70, 0x07, 0x18, 0x6e, 0x59, 0x19,
+	0x76, 0xe5, 0x0a, 0xfb, 0x00, 0x31, 0x30, 0x6c, 0xb6, 0xbd, 0x16, 0x08, 0x69, 0x56, 0x08, 0xac,
+	0x64, 0xd0, 0xc2, 0x23, 0xc7, 0x52, 0x94, 0x45, 0x0b, 0x22, 0x58, 0x5d, 0x51, 0x16, 0x37, 0x66,
+	0x46, 0x29, 0xb6, 0x1d, 0x1e, 0xbe, 0xea, 0xba, 0x2c, 0xcd, 0x11, 0x7c, 0x22, 0x2b, 0x62, 0x6f,
+	0x3c, 0xb0, 0x36, 0x2b, 0x5a, 0x19, 0x28, 0xae, 0xd4, 0x60, 0xd9, 0xb4, 0x08, 0x6a, 0x39, 0x6d,
+	0x87, 0xf0, 0x4f, 0xb6, 0x03, 0x66, 0x2f, 0xc0, 0x31, 0x1a, 0x72, 0x14, 0xc2, 0x58, 0xcd, 0x2b,
+	0xc9, 0xbd, 0xe5, 0xf6, 0x4c, 0x06, 0x58, 0x79, 0x1f, 0x56, 0x32, 0xa4, 0xc4, 0x04, 0x06, 0xa5,
+	0x75, 0xf9, 0x9f, 0xaf, 0x00, 0x88, 0x83, 0xf0, 0xad, 0xc7, 0x35, 0xfd, 0xf7, 0x69, 0x3a, 0x47,
+	0xfa, 0xa0, 0x88, 0x7e, 0x75, 0xb8, 0x17, 0x80, 0x8c, 0x6b, 0x03, 0xe3, 0x89, 0xb9, 0xfc, 0xa1,
+	0x06, 0x2b, 0x39, 0x2f, 0xce, 0xe8, 0xd7, 0xfa, 0xbd, 0xd6, 0x92, 0xc7, 0xcd, 0xf5, 0xc1, 0x11,
+	0x05, 0x3b, 0x3f, 0xd2, 0x60, 0xbd, 0xdf, 0xab, 0x2b, 0xfa, 0xb7, 0x8f, 0xfa, 0x8a, 0x8c, 0x71,
+	0xeb, 0x08, 0x14, 0x04, 0xa7, 0x74, 0x11, 0xe5, 0xef, 0xa9, 0x28, 0x16, 0x51, 0xf9, 0x8e, 0x8b,
+	0x71, 0x6d, 0x60, 0x3c, 0xc1, 0xcb, 0x9f, 0x6a, 0x60, 0xe4, 0xbf, 0x3a, 0xa2, 0xe7, 0x17, 0xf9,
+	0xf5, 0x7d, 0x8d, 0xc5, 0x78, 0x7b, 0x28, 0xdc, 0x98, 0x8c, 0xe4, 0x4f, 0x8b, 0x28, 0x64, 0xa4,
+	0x7c, 0xd2, 0xc4, 0xb8, 0x36, 0x30, 0x9e, 0xe0, 0xe5, 0xfb, 0x1a, 0x94, 0xf3, 0xde, 0x0c, 0xd1,
+	0xf3, 0x15, 0xb6, 0xcf, 0x7b, 0x25, 0xc6, 0x5b, 0x43, 0x60, 0x0a, 0x8e, 0x5c, 0x98, 0x4d, 0x3c,
+	0x13, 0xa2, 0xbf, 0xae, 0x9e, 0x5b, 0x2a, 0xab, 0x6a, 0x54, 0x8b, 0x82, 0x8b, 0xf1, 0x08, 0xcc,
+	0xa5, 0xde, 0xff, 0xd0, 0x2f, 0xf4, 0xe3, 0x3e, 0x3d, 0xe6, 0xc5, 0xe2, 0x08, 0xd1, 0x2c, 0x13,
+	0x0f, 0x7c, 0x28, 0x66, 0x29, 0x7b, 0x46, 0xc4, 0xa8, 0x16, 0x05, 0x17, 0xe3, 0xfd, 0xae, 0x06,
+	0x4b, 0xd2, 0xd7, 0x3a, 0xf4, 0x2b, 0xf9, 0xbc, 0x2b, 0xde, 0x08, 0x31, 0xae, 0x0e, 0x8a, 0x26,
+	0x18, 0xf9, 0x81, 0x06, 0x27, 0x72, 0x5f, 0xe0, 0xd0, 0xf3, 0xf5, 0xa6, 0xdf, 0x03, 0x20, 0xc6,
+	0x8d, 0x61, 0x50, 0xa3, 0xd5, 0x48, 0x3c, 0xcd, 0xa0, 0x58, 0x0d, 0xd9, 0x0b, 0x10, 0x46, 0xb5,
+	0x28, 0xb8, 0x18, 0xef, 0x53, 0x0d, 0x8e, 0x4b, 0xde, 0x37, 0xd0, 0xdf, 0x50, 0xaf, 0xaa, 0xf4,
+	0x45, 0x05, 0xe3, 0xcd, 0xc1, 0x90, 0x22, 0xb5, 0x4f, 0xbd, 0x25, 0xa0, 0x50, 0x7b, 0xf9, 0x5b,
+	0x0b, 0xc6, 0xc5, 0xe2, 0x08, 0x62, 0xd4, 0xe7, 0x30, 0x9f, 0xfe, 0x20, 0x56, 0xcf, 0xa7, 0x92,
+	0xf3, 0xc9, 0xb0, 0x71, 0x69, 0x00, 0x8c, 0x98, 0xda, 0xe5, 0xd6, 0x6e, 0x2b, 0xd4, 0xae, 0xdf,
+	0x47, 0x79, 0xc6, 0x11, 0x4a, 0xc5, 0xf5, 0xbf, 0xd0, 0xe0, 0x24, 0xff, 0x21, 0x2f, 0xed, 0xd6,
+	0x6f, 0x0e, 0x59, 0x11, 0xce, 0x59, 0x7b, 0xe7, 0x48, 0xf5, 0xe4, 0x42, 0x64, 0x39, 0xf5, 0xcf,
+	0x4a, 0x91, 0xa9, 0xab, 0xaf, 0x8d, 0x1b, 0xc3, 0xa0, 0x66, 0xd6, 0x51, 0xf2, 0x71, 0x49, 0xdf,
+	0x75, 0xcc, 0xff, 0xac, 0xc7, 0xb8, 0x31, 0x0c, 0x6a, 0x76, 0x1d, 0xa5, 0x25, 0xc8, 0xfd, 0xd7,
+	0x51, 0x55, 0x06, 0x6d, 0xbc, 0x33, 0x24, 0x76, 0x76, 0x1d, 0xb3, 0x55, 0xc6, 0xfd, 0xd7, 0x31,
+	0xb7, 0xc6, 0xd9, 0xb8, 0x31, 0x0c, 0xaa, 0x60, 0xea, 0xcf, 0x59, 0x32, 0x21, 0xb7, 0x7c, 0x58,
+	0x7f, 0x7b, 0xa0, 0x39, 0x27, 0x0b, 0x98, 0x8d, 0x9b, 0xc3, 0x21, 0x27, 0x58, 0xcb, 0xad, 0x9d,
+	0x57, 0xb2, 0xd6, 0xaf, 0x7a, 0xdf, 0xb8, 0x39, 0x1c, 0xb2, 0x60, 0xed, 0xaf, 0x34, 0x58, 0x13,
+	0x94, 0x72, 0x8a, 0x66, 0xf5, 0x6f, 0x29, 0x06, 0x28, 0x50, 0x39, 0x6c, 0xbc, 0x3b, 0x34, 0x7e,
+	0xec, 0x44, 0x99, 0x57, 0x3a, 0xad, 0x38, 0x51, 0xf6, 0xa9, 0x11, 0x37, 0xde, 0x1a, 0x02, 0x53,
+	0x70, 0xf4, 0x99, 0x06, 0x8b, 0xb2, 0x02, 0x5c, 0x3d, 0x7f, 0xe7, 0x54, 0x94, 0x1b, 0x1b, 0x57,
+	0x06, 0xc4, 0x12, 0x5c, 0xfc, 0x25, 0x7b, 0xf5, 0x52, 0x51, 0x60, 0xaa, 0xbf, 0xd3, 0x47, 0x37,
+	0xd4, 0xd5, 0xc1, 0xc6, 0xb7, 0x86, 0x45, 0x17, 0x0c, 0x7e, 0x42, 0x2b, 0x33, 0x52, 0xb5, 0x96,
+	0xfa, 0x25, 0x05, 0x51, 0x79, 0x09, 0xac, 0x71, 0x79, 0x10, 0x94, 0xe8, 0x34, 0x92, 0xaa, 0x9e,
+	0x54, 0x9c, 0x46, 0xe4, 0x35, 0x9f, 0xc6, 0xc5, 0xe2, 0x08, 0x62, 0xd4, 0x67, 0x30, 0x13, 0xaf,
+	0x5a, 0xd3, 0xbf, 0xa9, 0xa4, 0x90, 0x3e, 0x82, 0xbf, 0x5e, 0x10, 0x3a, 0xa6, 0x85, 0xb2, 0x22,
+	0x32, 0x85, 0x16, 0x2a, 0x2a, 0xe7, 0x8c, 0x2b, 0x03, 0x62, 0xc5, 0x4e, 0x9e, 0x92, 0x7a, 0x2e,
+	0xc5, 0xc9, 0x33, 0xbf, 0x38, 0xcc, 0x78, 0x73, 0x30, 0xa4, 0xf0, 0xb3, 0x3b, 0x88, 0xca, 0xa3,
+	0xf4, 0x73, 0xb9, 0x34, 0x32, 0x35, 0x57, 0xc6, 0xf9, 0x42, 0xb0, 0xd1, 0x30, 0x51, 0xfd, 0x91,
+	0x62, 0x98, 0x4c, 0x4d, 0x96, 0x71, 0xbe, 0x10, 0x6c, 0x7c, 0x98, 0xa0, 0x7c, 0x48, 0x39, 0x4c,
+	0xaa, 0xe8, 0xc9, 0x38, 0x5f, 0x08, 0x36, 0xba, 0xa1, 0x24, 0x4a, 0x7f, 0x14, 0x37, 0x14, 0x59,
+	0xd9, 0x92, 0x51, 0x2d, 0x0a, 0x1e, 0xf3, 0xe2, 0x79, 0xb5, 0x3d, 0x0a, 0x2f, 0xde, 0xa7, 0x9a,
+	0xc8, 0x78, 0x6b, 0x08, 0xcc, 0x58, 0xd4, 0x44, 0x5e, 0x0f, 0xa3, 0x88, 0x9a, 0x28, 0xeb, 0x82,
+	0x8c, 0x6b, 0x03, 0xe3, 0xc5, 0x8e, 0x54, 0xb9, 0xa5, 0x27, 0x8a, 0x23, 0x55, 0xbf, 0xea, 0x18,
+	0xe3, 0xc6, 0x30, 0xa8, 0xf1, 0x90, 0x42, 0xac, 0x70, 0x43, 0x19, 0x52, 0xc8, 0xd6, 0xae, 0x18,
+	0xd5, 0xa2, 0xe0, 0x31, 0x87, 0x26, 0x2b, 0xb2, 0xd0, 0x55, 0x17, 0xd2, 0xdc, 0xf2, 0x11, 0xe3,
+	0xca, 0x80, 0x58, 0xd1, 0x8d, 0x32, 0x5d, 0x8e, 0xa1, 0xb8, 0x51, 0xe6, 0x14, 0x7d, 0x18, 0x97,
+	0x06, 0xc0, 0x88, 0xb6, 0xac, 0x54, 0xf5, 0x80, 0x62, 0xcb, 0x92, 0x57, 0x73, 0x18, 0x17, 0x8b,
+	0x23, 0xc4, 0x2e, 0xd0, 0xa9, 0xec, 0xb4, 0xea, 0x02, 0x2d, 0xcf, 0xd7, 0x1b, 0x97, 0x06, 0xc0,
+	0x88, 0x06, 0x7e, 0x80, 0x0a, 0x0f, 0xfc, 0x00, 0x0d, 0x3a, 0x70, 0x6e, 0xc2, 0x97, 0x46, 0xae,
+	0xa4, 0x69, 0x54, 0x45, 0xe4, 0x4a, 0x95, 0xf8, 0x35, 0xae, 0x0e, 0x8a, 0x16, 0xd3, 0x77, 0x59,
+	0x12, 0x52, 0xa1, 0xef, 0x8a, 0xec, 0xae, 0x71, 0x65, 0x40, 0x2c, 0xc1, 0xc5, 0x8f, 0xb5, 0xf0,
+	0x9b, 0xd1, 0xfc, 0x6c, 0x97, 0x7e, 0xab, 0xdf, 0x0d, 0xa8, 0x6f, 0x56, 0xd0, 0xb8, 0x7d, 0x14,
+	0x12, 0x89, 0x20, 0x53, 0x3c, 0xdd, 0xa5, 0x0e, 0x32, 0x49, 0xf2, 0x69, 0xc6, 0xc5, 0xe2, 0x08,
+	0x31, 0xcb, 0x4c, 0xe6, 0xa8, 0x54, 0x96, 0x29, 0x4d, 0x8c, 0x19, 0x17, 0x8b, 0x23, 0xf0, 0x51,
+	0x6f, 0xdf, 0xf9, 0xa7, 0xcf, 0xd7, 0xb4, 0x7f, 0xf9, 0x7c, 0x4d, 0xfb, 0xd9, 0xe7, 0x6b, 0xda,
+	0xaf, 0x5d, 0xdb, 0x77, 0xc8, 0x41, 0x77, 0xaf, 0xda, 0xf0, 0xda, 0x17, 0x12, 0xff, 0xaa, 0xa5,
+	0xba, 0x8f, 0x5c, 0xfe, 0x7f, 0x7b, 0x62, 0xff, 0x38, 0xe8, 0x6d, 0xf1, 0xe7, 0xe1, 0xa5, 0xbd,
+	0x71, 0xd6, 0xf7, 0xc6, 0xff, 0x0e, 0x00, 0x57, 0xda, 0x44, 0x10, 0x64, 0x68, 0x00, 0x00,
+}
+
+func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7239,12 +6601,12 @@ func (m *RecordDecisionTaskStartedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordDecisionTaskStartedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *StartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7253,27 +6615,15 @@ func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.HistorySize != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.HistorySize))
-		i--
-		dAtA[i] = 0x78
-	}
-	if len(m.Queries) > 0 {
-		for k := range m.Queries {
-			v := m.Queries[k]
+	if len(m.PartitionConfig) > 0 {
+		for k := range m.PartitionConfig {
+			v := m.PartitionConfig[k]
 			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintService(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintService(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
 			i -= len(k)
 			copy(dAtA[i:], k)
 			i = encodeVarintService(dAtA, i, uint64(len(k)))
@@ -7281,12 +6631,12 @@ func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			dAtA[i] = 0xa
 			i = encodeVarintService(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x72
+			dAtA[i] = 0x52
 		}
 	}
-	if m.StartedTime != nil {
+	if m.FirstDecisionTaskBackoff != nil {
 		{
-			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FirstDecisionTaskBackoff.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7294,11 +6644,11 @@ func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0x4a
 	}
-	if m.ScheduledTime != nil {
+	if m.LastCompletionResult != nil {
 		{
-			size, err := m.ScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LastCompletionResult.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7306,23 +6656,11 @@ func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.BranchToken) > 0 {
-		i -= len(m.BranchToken)
-		copy(dAtA[i:], m.BranchToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.BranchToken)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.EventStoreVersion != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.EventStoreVersion))
-		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x42
 	}
-	if m.WorkflowExecutionTaskList != nil {
+	if m.ContinuedFailure != nil {
 		{
-			size, err := m.WorkflowExecutionTaskList.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ContinuedFailure.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7330,11 +6668,16 @@ func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x3a
 	}
-	if m.DecisionInfo != nil {
+	if m.ContinueAsNewInitiator != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ContinueAsNewInitiator))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpirationTime != nil {
 		{
-			size, err := m.DecisionInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExpirationTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7342,41 +6685,16 @@ func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
-	}
-	if m.StickyExecutionEnabled {
-		i--
-		if m.StickyExecutionEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x2a
 	}
 	if m.Attempt != 0 {
 		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
 		i--
-		dAtA[i] = 0x30
-	}
-	if m.NextEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.NextEventId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.StartedEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.StartedEventId))
-		i--
 		dAtA[i] = 0x20
 	}
-	if m.ScheduledEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ScheduledEventId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PreviousStartedEventId != nil {
+	if m.ParentExecutionInfo != nil {
 		{
-			size, err := m.PreviousStartedEventId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ParentExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7384,11 +6702,18 @@ func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
 		dAtA[i] = 0x12
 	}
-	if m.WorkflowType != nil {
+	if m.Request != nil {
 		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7401,7 +6726,7 @@ func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordActivityTaskStartedRequest) Marshal() (dAtA []byte, err error) {
+func (m *StartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7411,12 +6736,12 @@ func (m *RecordActivityTaskStartedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordActivityTaskStartedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordActivityTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7425,58 +6750,17 @@ func (m *RecordActivityTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PollRequest != nil {
-		{
-			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RunId)))
 		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.RequestId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.TaskId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ScheduleId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ScheduleId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordActivityTaskStartedResponse) Marshal() (dAtA []byte, err error) {
+func (m *SignalWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7486,12 +6770,12 @@ func (m *RecordActivityTaskStartedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordActivityTaskStartedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7500,40 +6784,19 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.WorkflowDomain) > 0 {
-		i -= len(m.WorkflowDomain)
-		copy(dAtA[i:], m.WorkflowDomain)
-		i = encodeVarintService(dAtA, i, uint64(len(m.WorkflowDomain)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.WorkflowType != nil {
-		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if m.ChildWorkflowOnly {
 		i--
-		dAtA[i] = 0x32
-	}
-	if m.HeartbeatDetails != nil {
-		{
-			size, err := m.HeartbeatDetails.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+		if m.ChildWorkflowOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x20
 	}
-	if m.ScheduledTimeOfThisAttempt != nil {
+	if m.ExternalWorkflowExecution != nil {
 		{
-			size, err := m.ScheduledTimeOfThisAttempt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExternalWorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7541,28 +6804,18 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if m.Attempt != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
-		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.StartedTime != nil {
-		{
-			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ScheduledEvent != nil {
+	if m.Request != nil {
 		{
-			size, err := m.ScheduledEvent.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7575,7 +6828,7 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondDecisionTaskCompletedRequest) Marshal() (dAtA []byte, err error) {
+func (m *SignalWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7585,12 +6838,12 @@ func (m *RespondDecisionTaskCompletedRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *RespondDecisionTaskCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondDecisionTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7599,29 +6852,10 @@ func (m *RespondDecisionTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondDecisionTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
+func (m *SignalWithStartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7631,12 +6865,12 @@ func (m *RespondDecisionTaskCompletedResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *RespondDecisionTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalWithStartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondDecisionTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7645,22 +6879,15 @@ func (m *RespondDecisionTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ActivitiesToDispatchLocally) > 0 {
-		for k := range m.ActivitiesToDispatchLocally {
-			v := m.ActivitiesToDispatchLocally[k]
+	if len(m.PartitionConfig) > 0 {
+		for k := range m.PartitionConfig {
+			v := m.PartitionConfig[k]
 			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintService(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintService(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
 			i -= len(k)
 			copy(dAtA[i:], k)
 			i = encodeVarintService(dAtA, i, uint64(len(k)))
@@ -7668,47 +6895,8 @@ func (m *RespondDecisionTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte)
 			dAtA[i] = 0xa
 			i = encodeVarintService(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.StartedResponse != nil {
-		{
-			size, err := m.StartedResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RespondDecisionTaskFailedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RespondDecisionTaskFailedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RespondDecisionTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.DomainId) > 0 {
 		i -= len(m.DomainId)
@@ -7732,7 +6920,7 @@ func (m *RespondDecisionTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondDecisionTaskFailedResponse) Marshal() (dAtA []byte, err error) {
+func (m *SignalWithStartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7742,12 +6930,12 @@ func (m *RespondDecisionTaskFailedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RespondDecisionTaskFailedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalWithStartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondDecisionTaskFailedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalWithStartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7756,10 +6944,17 @@ func (m *RespondDecisionTaskFailedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordActivityTaskHeartbeatRequest) Marshal() (dAtA []byte, err error) {
+func (m *ResetWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7769,12 +6964,12 @@ func (m *RecordActivityTaskHeartbeatRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *RecordActivityTaskHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResetWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordActivityTaskHeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7805,7 +7000,7 @@ func (m *RecordActivityTaskHeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordActivityTaskHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+func (m *ResetWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7815,12 +7010,12 @@ func (m *RecordActivityTaskHeartbeatResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *RecordActivityTaskHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResetWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordActivityTaskHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResetWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7829,20 +7024,17 @@ func (m *RecordActivityTaskHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CancelRequested {
-		i--
-		if m.CancelRequested {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RunId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskCompletedRequest) Marshal() (dAtA []byte, err error) {
+func (m *TerminateWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7852,12 +7044,12 @@ func (m *RespondActivityTaskCompletedRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TerminateWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondActivityTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TerminateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7866,16 +7058,19 @@ func (m *RespondActivityTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+	if m.ChildWorkflowOnly {
 		i--
-		dAtA[i] = 0x12
+		if m.ChildWorkflowOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Request != nil {
+	if m.ExternalWorkflowExecution != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExternalWorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7883,12 +7078,31 @@ func (m *RespondActivityTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
+func (m *TerminateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7898,12 +7112,12 @@ func (m *RespondActivityTaskCompletedResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TerminateWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondActivityTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TerminateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7915,7 +7129,7 @@ func (m *RespondActivityTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskFailedRequest) Marshal() (dAtA []byte, err error) {
+func (m *PauseWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7925,12 +7139,12 @@ func (m *RespondActivityTaskFailedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskFailedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PauseWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondActivityTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PauseWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7961,7 +7175,7 @@ func (m *RespondActivityTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskFailedResponse) Marshal() (dAtA []byte, err error) {
+func (m *PauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7971,12 +7185,12 @@ func (m *RespondActivityTaskFailedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskFailedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondActivityTaskFailedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PauseWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7988,7 +7202,7 @@ func (m *RespondActivityTaskFailedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskCanceledRequest) Marshal() (dAtA []byte, err error) {
+func (m *UnpauseWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7998,12 +7212,12 @@ func (m *RespondActivityTaskCanceledRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskCanceledRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnpauseWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondActivityTaskCanceledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnpauseWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8034,7 +7248,7 @@ func (m *RespondActivityTaskCanceledRequest) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskCanceledResponse) Marshal() (dAtA []byte, err error) {
+func (m *UnpauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8044,12 +7258,12 @@ func (m *RespondActivityTaskCanceledResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskCanceledResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnpauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondActivityTaskCanceledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnpauseWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8061,7 +7275,7 @@ func (m *RespondActivityTaskCanceledResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveSignalMutableStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *PauseActivityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8071,12 +7285,12 @@ func (m *RemoveSignalMutableStateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveSignalMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PauseActivityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveSignalMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PauseActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8085,16 +7299,16 @@ func (m *RemoveSignalMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.RequestId)))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if m.WorkflowExecution != nil {
+	if m.Request != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8102,19 +7316,12 @@ func (m *RemoveSignalMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveSignalMutableStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *PauseActivityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8124,12 +7331,12 @@ func (m *RemoveSignalMutableStateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveSignalMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PauseActivityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveSignalMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PauseActivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8141,7 +7348,7 @@ func (m *RemoveSignalMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *UnpauseActivityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8151,12 +7358,12 @@ func (m *RequestCancelWorkflowExecutionRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnpauseActivityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnpauseActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8165,31 +7372,16 @@ func (m *RequestCancelWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ChildWorkflowOnly {
-		i--
-		if m.ChildWorkflowOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ExternalExecutionInfo != nil {
-		{
-			size, err := m.ExternalExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if m.CancelRequest != nil {
+	if m.Request != nil {
 		{
-			size, err := m.CancelRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8197,19 +7389,12 @@ func (m *RequestCancelWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+func (m *UnpauseActivityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8219,12 +7404,12 @@ func (m *RequestCancelWorkflowExecutionResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnpauseActivityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnpauseActivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8236,7 +7421,7 @@ func (m *RequestCancelWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *ScheduleDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
+func (m *ResetActivityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8246,12 +7431,12 @@ func (m *ScheduleDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScheduleDecisionTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResetActivityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScheduleDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResetActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8260,19 +7445,16 @@ func (m *ScheduleDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.IsFirstDecision {
-		i--
-		if m.IsFirstDecision {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x12
 	}
-	if m.WorkflowExecution != nil {
+	if m.Request != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8280,19 +7462,12 @@ func (m *ScheduleDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ScheduleDecisionTaskResponse) Marshal() (dAtA []byte, err error) {
+func (m *ResetActivityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8302,12 +7477,12 @@ func (m *ScheduleDecisionTaskResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScheduleDecisionTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResetActivityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScheduleDecisionTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResetActivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8319,7 +7494,7 @@ func (m *ScheduleDecisionTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordChildExecutionCompletedRequest) Marshal() (dAtA []byte, err error) {
+func (m *UpdateActivityOptionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8329,12 +7504,12 @@ func (m *RecordChildExecutionCompletedRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *RecordChildExecutionCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateActivityOptionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordChildExecutionCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateActivityOptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8343,43 +7518,16 @@ func (m *RecordChildExecutionCompletedRequest) MarshalToSizedBuffer(dAtA []byte)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.StartedId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.StartedId))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.CompletionEvent != nil {
-		{
-			size, err := m.CompletionEvent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.CompletedExecution != nil {
-		{
-			size, err := m.CompletedExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.InitiatedId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.InitiatedId))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x12
 	}
-	if m.WorkflowExecution != nil {
+	if m.Request != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8387,19 +7535,12 @@ func (m *RecordChildExecutionCompletedRequest) MarshalToSizedBuffer(dAtA []byte)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordChildExecutionCompletedResponse) Marshal() (dAtA []byte, err error) {
+func (m *UpdateActivityOptionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8409,12 +7550,12 @@ func (m *RecordChildExecutionCompletedResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *RecordChildExecutionCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateActivityOptionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordChildExecutionCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateActivityOptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8426,7 +7567,7 @@ func (m *RecordChildExecutionCompletedResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *ReplicateEventsV2Request) Marshal() (dAtA []byte, err error) {
+func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8436,12 +7577,12 @@ func (m *ReplicateEventsV2Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReplicateEventsV2Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReplicateEventsV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8450,47 +7591,16 @@ func (m *ReplicateEventsV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.NewRunEvents != nil {
-		{
-			size, err := m.NewRunEvents.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Events != nil {
-		{
-			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.VersionHistoryItems) > 0 {
-		for iNdEx := len(m.VersionHistoryItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VersionHistoryItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+		dAtA[i] = 0x12
 	}
-	if m.WorkflowExecution != nil {
+	if m.Request != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8498,19 +7608,12 @@ func (m *ReplicateEventsV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReplicateEventsV2Response) Marshal() (dAtA []byte, err error) {
+func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8520,12 +7623,12 @@ func (m *ReplicateEventsV2Response) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReplicateEventsV2Response) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReplicateEventsV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8534,36 +7637,9 @@ func (m *ReplicateEventsV2Response) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SyncShardStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *SyncShardStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SyncShardStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Time != nil {
+	if m.PendingDecision != nil {
 		{
-			size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PendingDecision.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8571,24 +7647,64 @@ func (m *SyncShardStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+	if len(m.PendingChildren) > 0 {
+		for iNdEx := len(m.PendingChildren) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChildren[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PendingActivities) > 0 {
+		for iNdEx := len(m.PendingActivities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.WorkflowExecutionInfo != nil {
+		{
+			size, err := m.WorkflowExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
+	if m.ExecutionConfiguration != nil {
+		{
+			size, err := m.ExecutionConfiguration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncShardStatusResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8598,12 +7714,12 @@ func (m *SyncShardStatusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncShardStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SyncShardStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8612,10 +7728,29 @@ func (m *SyncShardStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncActivityRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryWorkflowResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8625,12 +7760,12 @@ func (m *SyncActivityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncActivityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8639,57 +7774,9 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.VersionHistory != nil {
-		{
-			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.LastWorkerIdentity) > 0 {
-		i -= len(m.LastWorkerIdentity)
-		copy(dAtA[i:], m.LastWorkerIdentity)
-		i = encodeVarintService(dAtA, i, uint64(len(m.LastWorkerIdentity)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.LastFailure != nil {
-		{
-			size, err := m.LastFailure.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.Attempt != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.LastHeartbeatTime != nil {
+	if m.QueryRejected != nil {
 		{
-			size, err := m.LastHeartbeatTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.QueryRejected.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8697,11 +7784,11 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x12
 	}
-	if m.StartedTime != nil {
+	if m.QueryResult != nil {
 		{
-			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.QueryResult.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8709,38 +7796,45 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
-	}
-	if m.StartedId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.StartedId))
-		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0xa
 	}
-	if m.ScheduledTime != nil {
-		{
-			size, err := m.ScheduledTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetStickyTaskListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ScheduledId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ScheduledId))
-		i--
-		dAtA[i] = 0x20
+	return dAtA[:n], nil
+}
+
+func (m *ResetStickyTaskListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetStickyTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Version != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Version))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x12
 	}
-	if m.WorkflowExecution != nil {
+	if m.Request != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8748,19 +7842,12 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncActivityResponse) Marshal() (dAtA []byte, err error) {
+func (m *ResetStickyTaskListResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8770,12 +7857,12 @@ func (m *SyncActivityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncActivityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResetStickyTaskListResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SyncActivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResetStickyTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8787,7 +7874,7 @@ func (m *SyncActivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeMutableStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetMutableStateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8797,12 +7884,12 @@ func (m *DescribeMutableStateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8811,10 +7898,22 @@ func (m *DescribeMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+	if len(m.CurrentBranchToken) > 0 {
+		i -= len(m.CurrentBranchToken)
+		copy(dAtA[i:], m.CurrentBranchToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.CurrentBranchToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExpectedNextEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ExpectedNextEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
 			i -= size
@@ -8833,7 +7932,7 @@ func (m *DescribeMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeMutableStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetMutableStateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8843,12 +7942,12 @@ func (m *DescribeMutableStateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8857,24 +7956,168 @@ func (m *DescribeMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.MutableStateInDatabase) > 0 {
-		i -= len(m.MutableStateInDatabase)
-		copy(dAtA[i:], m.MutableStateInDatabase)
-		i = encodeVarintService(dAtA, i, uint64(len(m.MutableStateInDatabase)))
+	if m.HistorySize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.HistorySize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.IsStickyTaskListEnabled {
+		i--
+		if m.IsStickyTaskListEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.VersionHistories != nil {
+		{
+			size, err := m.VersionHistories.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.WorkflowCloseState != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.WorkflowCloseState))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.WorkflowState != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.WorkflowState))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.CurrentBranchToken) > 0 {
+		i -= len(m.CurrentBranchToken)
+		copy(dAtA[i:], m.CurrentBranchToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.CurrentBranchToken)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.EventStoreVersion != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.EventStoreVersion))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.StickyTaskListScheduleToStartTimeout != nil {
+		{
+			size, err := m.StickyTaskListScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ClientImpl) > 0 {
+		i -= len(m.ClientImpl)
+		copy(dAtA[i:], m.ClientImpl)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClientImpl)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ClientFeatureVersion) > 0 {
+		i -= len(m.ClientFeatureVersion)
+		copy(dAtA[i:], m.ClientFeatureVersion)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClientFeatureVersion)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ClientLibraryVersion) > 0 {
+		i -= len(m.ClientLibraryVersion)
+		copy(dAtA[i:], m.ClientLibraryVersion)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClientLibraryVersion)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.StickyTaskList != nil {
+		{
+			size, err := m.StickyTaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastFirstEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.LastFirstEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PreviousStartedEventId != nil {
+		{
+			size, err := m.PreviousStartedEventId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NextEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.MutableStateInCache) > 0 {
-		i -= len(m.MutableStateInCache)
-		copy(dAtA[i:], m.MutableStateInCache)
-		i = encodeVarintService(dAtA, i, uint64(len(m.MutableStateInCache)))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeHistoryHostRequest) Marshal() (dAtA []byte, err error) {
+func (m *PollMutableStateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8884,12 +8127,12 @@ func (m *DescribeHistoryHostRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeHistoryHostRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PollMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeHistoryHostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PollMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8898,10 +8141,41 @@ func (m *DescribeHistoryHostRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.CurrentBranchToken) > 0 {
+		i -= len(m.CurrentBranchToken)
+		copy(dAtA[i:], m.CurrentBranchToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.CurrentBranchToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExpectedNextEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ExpectedNextEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeHistoryHostResponse) Marshal() (dAtA []byte, err error) {
+func (m *PollMutableStateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8911,12 +8185,12 @@ func (m *DescribeHistoryHostResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeHistoryHostResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PollMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PollMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8925,23 +8199,19 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Address)))
+	if m.WorkflowCloseState != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.WorkflowCloseState))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x78
 	}
-	if len(m.ShardControllerStatus) > 0 {
-		i -= len(m.ShardControllerStatus)
-		copy(dAtA[i:], m.ShardControllerStatus)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ShardControllerStatus)))
+	if m.WorkflowState != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.WorkflowState))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x70
 	}
-	if m.DomainCache != nil {
+	if m.VersionHistories != nil {
 		{
-			size, err := m.DomainCache.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.VersionHistories.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8949,128 +8219,80 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x6a
 	}
-	if len(m.ShardIds) > 0 {
-		dAtA82 := make([]byte, len(m.ShardIds)*10)
-		var j81 int
-		for _, num1 := range m.ShardIds {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA82[j81] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j81++
-			}
-			dAtA82[j81] = uint8(num)
-			j81++
-		}
-		i -= j81
-		copy(dAtA[i:], dAtA82[:j81])
-		i = encodeVarintService(dAtA, i, uint64(j81))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.NumberOfShards != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.NumberOfShards))
+	if len(m.CurrentBranchToken) > 0 {
+		i -= len(m.CurrentBranchToken)
+		copy(dAtA[i:], m.CurrentBranchToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.CurrentBranchToken)))
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CloseShardRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x62
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CloseShardRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CloseShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.StickyTaskListScheduleToStartTimeout != nil {
+		{
+			size, err := m.StickyTaskListScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+	if len(m.ClientImpl) > 0 {
+		i -= len(m.ClientImpl)
+		copy(dAtA[i:], m.ClientImpl)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClientImpl)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x52
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CloseShardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.ClientFeatureVersion) > 0 {
+		i -= len(m.ClientFeatureVersion)
+		copy(dAtA[i:], m.ClientFeatureVersion)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClientFeatureVersion)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CloseShardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CloseShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.ClientLibraryVersion) > 0 {
+		i -= len(m.ClientLibraryVersion)
+		copy(dAtA[i:], m.ClientLibraryVersion)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClientLibraryVersion)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RemoveTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.StickyTaskList != nil {
+		{
+			size, err := m.StickyTaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
+	if m.LastFirstEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.LastFirstEventId))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x28
 	}
-	if m.VisibilityTime != nil {
+	if m.PreviousStartedEventId != nil {
 		{
-			size, err := m.VisibilityTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PreviousStartedEventId.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9080,25 +8302,39 @@ func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.TaskId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.TaskId))
+	if m.NextEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NextEventId))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.TaskType != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.TaskType))
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveTaskResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordDecisionTaskStartedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9108,12 +8344,12 @@ func (m *RemoveTaskResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordDecisionTaskStartedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordDecisionTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9122,10 +8358,58 @@ func (m *RemoveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.PollRequest != nil {
+		{
+			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ResetQueueRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordDecisionTaskStartedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9135,12 +8419,12 @@ func (m *ResetQueueRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResetQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordDecisionTaskStartedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResetQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9149,54 +8433,167 @@ func (m *ResetQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TaskType != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.TaskType))
+	if m.ContinueAsNewSuggested {
 		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
+		if m.ContinueAsNewSuggested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+	if m.HistorySize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.HistorySize))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x78
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ResetQueueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Queries) > 0 {
+		for k := range m.Queries {
+			v := m.Queries[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ResetQueueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ResetQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.StartedTime != nil {
+		{
+			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ScheduledTime != nil {
+		{
+			size, err := m.ScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.BranchToken) > 0 {
+		i -= len(m.BranchToken)
+		copy(dAtA[i:], m.BranchToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.BranchToken)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.EventStoreVersion != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.EventStoreVersion))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.WorkflowExecutionTaskList != nil {
+		{
+			size, err := m.WorkflowExecutionTaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DecisionInfo != nil {
+		{
+			size, err := m.DecisionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.StickyExecutionEnabled {
+		i--
+		if m.StickyExecutionEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScheduledEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ScheduledEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PreviousStartedEventId != nil {
+		{
+			size, err := m.PreviousStartedEventId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeQueueRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordActivityTaskStartedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9206,12 +8603,12 @@ func (m *DescribeQueueRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskStartedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9220,27 +8617,58 @@ func (m *DescribeQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TaskType != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.TaskType))
+	if m.PollRequest != nil {
+		{
+			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ScheduleId))
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeQueueResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordActivityTaskStartedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9250,12 +8678,12 @@ func (m *DescribeQueueResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskStartedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9264,19 +8692,82 @@ func (m *DescribeQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ProcessingQueueStates) > 0 {
-		for iNdEx := len(m.ProcessingQueueStates) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ProcessingQueueStates[iNdEx])
-			copy(dAtA[i:], m.ProcessingQueueStates[iNdEx])
-			i = encodeVarintService(dAtA, i, uint64(len(m.ProcessingQueueStates[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if len(m.WorkflowDomain) > 0 {
+		i -= len(m.WorkflowDomain)
+		copy(dAtA[i:], m.WorkflowDomain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.WorkflowDomain)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+	if m.HeartbeatDetails != nil {
+		{
+			size, err := m.HeartbeatDetails.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ScheduledTimeOfThisAttempt != nil {
+		{
+			size, err := m.ScheduledTimeOfThisAttempt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartedTime != nil {
+		{
+			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScheduledEvent != nil {
+		{
+			size, err := m.ScheduledEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RespondDecisionTaskCompletedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9286,12 +8777,12 @@ func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondDecisionTaskCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondDecisionTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9300,31 +8791,29 @@ func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *RespondDecisionTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9334,12 +8823,12 @@ func (m *GetReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondDecisionTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondDecisionTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9348,9 +8837,9 @@ func (m *GetReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ShardMessages) > 0 {
-		for k := range m.ShardMessages {
-			v := m.ShardMessages[k]
+	if len(m.ActivitiesToDispatchLocally) > 0 {
+		for k := range m.ActivitiesToDispatchLocally {
+			v := m.ActivitiesToDispatchLocally[k]
 			baseI := i
 			if v != nil {
 				{
@@ -9364,18 +8853,32 @@ func (m *GetReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 				i--
 				dAtA[i] = 0x12
 			}
-			i = encodeVarintService(dAtA, i, uint64(k))
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 			i = encodeVarintService(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+	}
+	if m.StartedResponse != nil {
+		{
+			size, err := m.StartedResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetDLQReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *RespondDecisionTaskFailedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9385,12 +8888,12 @@ func (m *GetDLQReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetDLQReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondDecisionTaskFailedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetDLQReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondDecisionTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9399,24 +8902,29 @@ func (m *GetDLQReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (in
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.TaskInfos) > 0 {
-		for iNdEx := len(m.TaskInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaskInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetDLQReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *RespondDecisionTaskFailedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9426,12 +8934,12 @@ func (m *GetDLQReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetDLQReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondDecisionTaskFailedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetDLQReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondDecisionTaskFailedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9440,24 +8948,10 @@ func (m *GetDLQReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ReplicationTasks) > 0 {
-		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReapplyEventsRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordActivityTaskHeartbeatRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9467,12 +8961,12 @@ func (m *ReapplyEventsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReapplyEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReapplyEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskHeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9481,21 +8975,16 @@ func (m *ReapplyEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Events != nil {
-		{
-			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x12
 	}
-	if m.WorkflowExecution != nil {
+	if m.Request != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9503,26 +8992,12 @@ func (m *ReapplyEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReapplyEventsResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordActivityTaskHeartbeatResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9532,12 +9007,12 @@ func (m *ReapplyEventsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReapplyEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReapplyEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9546,10 +9021,20 @@ func (m *ReapplyEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.CancelRequested {
+		i--
+		if m.CancelRequested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RefreshWorkflowTasksRequest) Marshal() (dAtA []byte, err error) {
+func (m *RespondActivityTaskCompletedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9559,12 +9044,12 @@ func (m *RefreshWorkflowTasksRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RefreshWorkflowTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RefreshWorkflowTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9573,9 +9058,16 @@ func (m *RefreshWorkflowTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WorkflowExecution != nil {
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9583,26 +9075,12 @@ func (m *RefreshWorkflowTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RefreshWorkflowTasksResponse) Marshal() (dAtA []byte, err error) {
+func (m *RespondActivityTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9612,12 +9090,12 @@ func (m *RefreshWorkflowTasksResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RefreshWorkflowTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9629,7 +9107,7 @@ func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *CountDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *RespondActivityTaskFailedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9639,12 +9117,12 @@ func (m *CountDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CountDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskFailedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CountDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9653,20 +9131,29 @@ func (m *CountDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ForceFetch {
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		if m.ForceFetch {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CountDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *RespondActivityTaskFailedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9676,12 +9163,12 @@ func (m *CountDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CountDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskFailedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CountDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskFailedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9690,24 +9177,10 @@ func (m *CountDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReadDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *RespondActivityTaskCanceledRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9717,12 +9190,12 @@ func (m *ReadDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReadDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCanceledRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReadDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCanceledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9731,21 +9204,16 @@ func (m *ReadDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.PageSize != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x12
 	}
-	if m.InclusiveEndMessageId != nil {
+	if m.Request != nil {
 		{
-			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9753,29 +9221,12 @@ func (m *ReadDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Type != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReadDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *RespondActivityTaskCanceledResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9785,12 +9236,12 @@ func (m *ReadDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReadDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCanceledResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReadDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCanceledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9799,50 +9250,10 @@ func (m *ReadDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ReplicationTasksInfo) > 0 {
-		for iNdEx := len(m.ReplicationTasksInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasksInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.ReplicationTasks) > 0 {
-		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Type != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *RemoveSignalMutableStateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9852,12 +9263,12 @@ func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PurgeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveSignalMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveSignalMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9866,9 +9277,16 @@ func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.InclusiveEndMessageId != nil {
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
 		{
-			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9876,29 +9294,19 @@ func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.Type != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Type))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *RemoveSignalMutableStateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9908,12 +9316,12 @@ func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PurgeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveSignalMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveSignalMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9925,7 +9333,7 @@ func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *RequestCancelWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9935,12 +9343,12 @@ func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MergeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestCancelWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RequestCancelWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9949,21 +9357,19 @@ func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+	if m.ChildWorkflowOnly {
 		i--
-		dAtA[i] = 0x32
-	}
-	if m.PageSize != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
+		if m.ChildWorkflowOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x20
 	}
-	if m.InclusiveEndMessageId != nil {
+	if m.ExternalExecutionInfo != nil {
 		{
-			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExternalExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9971,29 +9377,31 @@ func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
 		dAtA[i] = 0x1a
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+	if m.CancelRequest != nil {
+		{
+			size, err := m.CancelRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.Type != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Type))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *RequestCancelWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10003,12 +9411,12 @@ func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MergeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestCancelWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RequestCancelWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10017,17 +9425,10 @@ func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *NotifyFailoverMarkersRequest) Marshal() (dAtA []byte, err error) {
+func (m *ScheduleDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10037,12 +9438,12 @@ func (m *NotifyFailoverMarkersRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NotifyFailoverMarkersRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScheduleDecisionTaskRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NotifyFailoverMarkersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScheduleDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10051,24 +9452,39 @@ func (m *NotifyFailoverMarkersRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.FailoverMarkerTokens) > 0 {
-		for iNdEx := len(m.FailoverMarkerTokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FailoverMarkerTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
+	if m.IsFirstDecision {
+		i--
+		if m.IsFirstDecision {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *NotifyFailoverMarkersResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScheduleDecisionTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10078,12 +9494,12 @@ func (m *NotifyFailoverMarkersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NotifyFailoverMarkersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScheduleDecisionTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NotifyFailoverMarkersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScheduleDecisionTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10095,7 +9511,7 @@ func (m *NotifyFailoverMarkersResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *GetCrossClusterTasksRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordChildExecutionCompletedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10105,12 +9521,12 @@ func (m *GetCrossClusterTasksRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetCrossClusterTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordChildExecutionCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetCrossClusterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordChildExecutionCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10119,36 +9535,63 @@ func (m *GetCrossClusterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.TargetCluster) > 0 {
-		i -= len(m.TargetCluster)
-		copy(dAtA[i:], m.TargetCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.TargetCluster)))
+	if m.StartedId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.StartedId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x30
 	}
-	if len(m.ShardIds) > 0 {
-		dAtA92 := make([]byte, len(m.ShardIds)*10)
-		var j91 int
-		for _, num1 := range m.ShardIds {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA92[j91] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j91++
+	if m.CompletionEvent != nil {
+		{
+			size, err := m.CompletionEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CompletedExecution != nil {
+		{
+			size, err := m.CompletedExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.InitiatedId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.InitiatedId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			dAtA92[j91] = uint8(num)
-			j91++
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
-		i -= j91
-		copy(dAtA[i:], dAtA92[:j91])
-		i = encodeVarintService(dAtA, i, uint64(j91))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetCrossClusterTasksResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordChildExecutionCompletedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10158,12 +9601,12 @@ func (m *GetCrossClusterTasksResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetCrossClusterTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordChildExecutionCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetCrossClusterTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordChildExecutionCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10172,49 +9615,10 @@ func (m *GetCrossClusterTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.FailedCauseByShard) > 0 {
-		for k := range m.FailedCauseByShard {
-			v := m.FailedCauseByShard[k]
-			baseI := i
-			i = encodeVarintService(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarintService(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.TasksByShard) > 0 {
-		for k := range m.TasksByShard {
-			v := m.TasksByShard[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintService(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintService(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) Marshal() (dAtA []byte, err error) {
+func (m *ReplicateEventsV2Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10224,12 +9628,12 @@ func (m *RespondCrossClusterTasksCompletedRequest) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReplicateEventsV2Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReplicateEventsV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10238,20 +9642,34 @@ func (m *RespondCrossClusterTasksCompletedRequest) MarshalToSizedBuffer(dAtA []b
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.FetchNewTasks {
+	if m.NewRunEvents != nil {
+		{
+			size, err := m.NewRunEvents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
-		if m.FetchNewTasks {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x2a
+	}
+	if m.Events != nil {
+		{
+			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if len(m.TaskResponses) > 0 {
-		for iNdEx := len(m.TaskResponses) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.VersionHistoryItems) > 0 {
+		for iNdEx := len(m.VersionHistoryItems) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TaskResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.VersionHistoryItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -10262,22 +9680,29 @@ func (m *RespondCrossClusterTasksCompletedRequest) MarshalToSizedBuffer(dAtA []b
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.TargetCluster) > 0 {
-		i -= len(m.TargetCluster)
-		copy(dAtA[i:], m.TargetCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.TargetCluster)))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondCrossClusterTasksCompletedResponse) Marshal() (dAtA []byte, err error) {
+func (m *ReplicateEventsV2Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10287,12 +9712,12 @@ func (m *RespondCrossClusterTasksCompletedResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *RespondCrossClusterTasksCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReplicateEventsV2Response) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondCrossClusterTasksCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReplicateEventsV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10301,22 +9726,10 @@ func (m *RespondCrossClusterTasksCompletedResponse) MarshalToSizedBuffer(dAtA []
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Tasks != nil {
-		{
-			size, err := m.Tasks.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetFailoverInfoRequest) Marshal() (dAtA []byte, err error) {
+func (m *SyncShardStatusRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10326,12 +9739,12 @@ func (m *GetFailoverInfoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetFailoverInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncShardStatusRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetFailoverInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncShardStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10340,17 +9753,34 @@ func (m *GetFailoverInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetFailoverInfoResponse) Marshal() (dAtA []byte, err error) {
+	if m.Time != nil {
+		{
+			size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncShardStatusResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10360,12 +9790,12 @@ func (m *GetFailoverInfoResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetFailoverInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncShardStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncShardStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10374,34 +9804,10 @@ func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PendingShards) > 0 {
-		dAtA96 := make([]byte, len(m.PendingShards)*10)
-		var j95 int
-		for _, num1 := range m.PendingShards {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA96[j95] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j95++
-			}
-			dAtA96[j95] = uint8(num)
-			j95++
-		}
-		i -= j95
-		copy(dAtA[i:], dAtA96[:j95])
-		i = encodeVarintService(dAtA, i, uint64(j95))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CompletedShardCount != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.CompletedShardCount))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RatelimitUpdateRequest) Marshal() (dAtA []byte, err error) {
+func (m *SyncActivityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10411,12 +9817,12 @@ func (m *RatelimitUpdateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RatelimitUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncActivityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RatelimitUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10425,9 +9831,118 @@ func (m *RatelimitUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Data != nil {
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.VersionHistory != nil {
 		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.LastWorkerIdentity) > 0 {
+		i -= len(m.LastWorkerIdentity)
+		copy(dAtA[i:], m.LastWorkerIdentity)
+		i = encodeVarintService(dAtA, i, uint64(len(m.LastWorkerIdentity)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.LastFailure != nil {
+		{
+			size, err := m.LastFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.LastHeartbeatTime != nil {
+		{
+			size, err := m.LastHeartbeatTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.StartedTime != nil {
+		{
+			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.StartedId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.StartedId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ScheduledTime != nil {
+		{
+			size, err := m.ScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ScheduledId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ScheduledId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Version != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10435,12 +9950,19 @@ func (m *RatelimitUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RatelimitUpdateResponse) Marshal() (dAtA []byte, err error) {
+func (m *SyncActivityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10450,12 +9972,12 @@ func (m *RatelimitUpdateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RatelimitUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncActivityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RatelimitUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncActivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10464,9 +9986,46 @@ func (m *RatelimitUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Data != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeMutableStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RebuildFromHistory {
+		i--
+		if m.RebuildFromHistory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowExecution != nil {
 		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10474,1107 +10033,1826 @@ func (m *RatelimitUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DescribeMutableStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StartWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DescribeMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.ParentExecutionInfo != nil {
-		l = m.ParentExecutionInfo.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovService(uint64(m.Attempt))
-	}
-	if m.ExpirationTime != nil {
-		l = m.ExpirationTime.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.ContinueAsNewInitiator != 0 {
-		n += 1 + sovService(uint64(m.ContinueAsNewInitiator))
-	}
-	if m.ContinuedFailure != nil {
-		l = m.ContinuedFailure.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.LastCompletionResult != nil {
-		l = m.LastCompletionResult.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.FirstDecisionTaskBackoff != nil {
-		l = m.FirstDecisionTaskBackoff.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.MutableStateRebuiltFromHistory) > 0 {
+		i -= len(m.MutableStateRebuiltFromHistory)
+		copy(dAtA[i:], m.MutableStateRebuiltFromHistory)
+		i = encodeVarintService(dAtA, i, uint64(len(m.MutableStateRebuiltFromHistory)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.PartitionConfig) > 0 {
-		for k, v := range m.PartitionConfig {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
-		}
+	if len(m.MutableStateInDatabase) > 0 {
+		i -= len(m.MutableStateInDatabase)
+		copy(dAtA[i:], m.MutableStateInDatabase)
+		i = encodeVarintService(dAtA, i, uint64(len(m.MutableStateInDatabase)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.MutableStateInCache) > 0 {
+		i -= len(m.MutableStateInCache)
+		copy(dAtA[i:], m.MutableStateInCache)
+		i = encodeVarintService(dAtA, i, uint64(len(m.MutableStateInCache)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StartWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DescribeHistoryHostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeHistoryHostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeHistoryHostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SignalWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DescribeHistoryHostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeHistoryHostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.ExternalWorkflowExecution != nil {
-		l = m.ExternalWorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.ShardControllerStatus) > 0 {
+		i -= len(m.ShardControllerStatus)
+		copy(dAtA[i:], m.ShardControllerStatus)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ShardControllerStatus)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.ChildWorkflowOnly {
-		n += 2
+	if m.DomainCache != nil {
+		{
+			size, err := m.DomainCache.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ShardIds) > 0 {
+		dAtA88 := make([]byte, len(m.ShardIds)*10)
+		var j87 int
+		for _, num1 := range m.ShardIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA88[j87] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j87++
+			}
+			dAtA88[j87] = uint8(num)
+			j87++
+		}
+		i -= j87
+		copy(dAtA[i:], dAtA88[:j87])
+		i = encodeVarintService(dAtA, i, uint64(j87))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.NumberOfShards != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NumberOfShards))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SignalWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CloseShardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CloseShardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CloseShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CloseShardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CloseShardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CloseShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if len(m.PartitionConfig) > 0 {
-		for k, v := range m.PartitionConfig {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *ResetWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.VisibilityTime != nil {
+		{
+			size, err := m.VisibilityTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.TaskId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.TaskType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ResetWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TerminateWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ResetQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ExternalWorkflowExecution != nil {
-		l = m.ExternalWorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.TaskType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.ChildWorkflowOnly {
-		n += 2
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ResetQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ResetQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DescribeQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ExecutionConfiguration != nil {
-		l = m.ExecutionConfiguration.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowExecutionInfo != nil {
-		l = m.WorkflowExecutionInfo.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if len(m.PendingActivities) > 0 {
-		for _, e := range m.PendingActivities {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PendingChildren) > 0 {
-		for _, e := range m.PendingChildren {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	if m.TaskType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.PendingDecision != nil {
-		l = m.PendingDecision.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWorkflowRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DescribeQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.ProcessingQueueStates) > 0 {
+		for iNdEx := len(m.ProcessingQueueStates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProcessingQueueStates[iNdEx])
+			copy(dAtA[i:], m.ProcessingQueueStates[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.ProcessingQueueStates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWorkflowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListThrottledWorkflowIDsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListThrottledWorkflowIDsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListThrottledWorkflowIDsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.QueryResult != nil {
-		l = m.QueryResult.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.QueryRejected != nil {
-		l = m.QueryRejected.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ResetStickyTaskListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListThrottledWorkflowIDsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListThrottledWorkflowIDsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListThrottledWorkflowIDsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.ThrottledWorkflowIds) > 0 {
+		for iNdEx := len(m.ThrottledWorkflowIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ThrottledWorkflowIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ResetStickyTaskListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetMutableStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.ExpectedNextEventId != 0 {
-		n += 1 + sovService(uint64(m.ExpectedNextEventId))
-	}
-	l = len(m.CurrentBranchToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.ShardMessages) > 0 {
+		for k := range m.ShardMessages {
+			v := m.ShardMessages[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintService(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetMutableStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetDLQReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDLQReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDLQReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.NextEventId != 0 {
-		n += 1 + sovService(uint64(m.NextEventId))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PreviousStartedEventId != nil {
-		l = m.PreviousStartedEventId.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.TaskInfos) > 0 {
+		for iNdEx := len(m.TaskInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.LastFirstEventId != 0 {
-		n += 1 + sovService(uint64(m.LastFirstEventId))
-	}
-	if m.TaskList != nil {
-		l = m.TaskList.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.StickyTaskList != nil {
-		l = m.StickyTaskList.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.ClientLibraryVersion)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.ClientFeatureVersion)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDLQReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ClientImpl)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *GetDLQReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDLQReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.StickyTaskListScheduleToStartTimeout != nil {
-		l = m.StickyTaskListScheduleToStartTimeout.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.ReplicationTasks) > 0 {
+		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.EventStoreVersion != 0 {
-		n += 1 + sovService(uint64(m.EventStoreVersion))
+	return len(dAtA) - i, nil
+}
+
+func (m *ReapplyEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.CurrentBranchToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ReapplyEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReapplyEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WorkflowState != 0 {
-		n += 1 + sovService(uint64(m.WorkflowState))
+	if m.Events != nil {
+		{
+			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.WorkflowCloseState != 0 {
-		n += 1 + sovService(uint64(m.WorkflowCloseState))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.VersionHistories != nil {
-		l = m.VersionHistories.Size()
-		n += 2 + l + sovService(uint64(l))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.IsStickyTaskListEnabled {
-		n += 3
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.HistorySize != 0 {
-		n += 2 + sovService(uint64(m.HistorySize))
+	return len(dAtA) - i, nil
+}
+
+func (m *ReapplyEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReapplyEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReapplyEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PollMutableStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RefreshWorkflowTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshWorkflowTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshWorkflowTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.ExpectedNextEventId != 0 {
-		n += 1 + sovService(uint64(m.ExpectedNextEventId))
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.CurrentBranchToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PollMutableStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RefreshWorkflowTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshWorkflowTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.NextEventId != 0 {
-		n += 1 + sovService(uint64(m.NextEventId))
-	}
-	if m.PreviousStartedEventId != nil {
-		l = m.PreviousStartedEventId.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.LastFirstEventId != 0 {
-		n += 1 + sovService(uint64(m.LastFirstEventId))
-	}
-	if m.TaskList != nil {
-		l = m.TaskList.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.StickyTaskList != nil {
-		l = m.StickyTaskList.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.ClientLibraryVersion)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.ClientFeatureVersion)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.ClientImpl)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.StickyTaskListScheduleToStartTimeout != nil {
-		l = m.StickyTaskListScheduleToStartTimeout.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.CurrentBranchToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *CountDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.VersionHistories != nil {
-		l = m.VersionHistories.Size()
-		n += 1 + l + sovService(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *CountDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CountDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WorkflowState != 0 {
-		n += 1 + sovService(uint64(m.WorkflowState))
+	if m.ForceFetch {
+		i--
+		if m.ForceFetch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.WorkflowCloseState != 0 {
-		n += 1 + sovService(uint64(m.WorkflowCloseState))
+	return len(dAtA) - i, nil
+}
+
+func (m *CountDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CountDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CountDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordDecisionTaskStartedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReadDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.ScheduleId != 0 {
-		n += 1 + sovService(uint64(m.ScheduleId))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.TaskId != 0 {
-		n += 1 + sovService(uint64(m.TaskId))
+	if m.PageSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.InclusiveEndMessageId != nil {
+		{
+			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.PollRequest != nil {
-		l = m.PollRequest.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Type != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordDecisionTaskStartedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReadDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PreviousStartedEventId != nil {
-		l = m.PreviousStartedEventId.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.ScheduledEventId != 0 {
-		n += 1 + sovService(uint64(m.ScheduledEventId))
+	if len(m.ReplicationTasksInfo) > 0 {
+		for iNdEx := len(m.ReplicationTasksInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasksInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.StartedEventId != 0 {
-		n += 1 + sovService(uint64(m.StartedEventId))
-	}
-	if m.NextEventId != 0 {
-		n += 1 + sovService(uint64(m.NextEventId))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovService(uint64(m.Attempt))
-	}
-	if m.StickyExecutionEnabled {
-		n += 2
-	}
-	if m.DecisionInfo != nil {
-		l = m.DecisionInfo.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowExecutionTaskList != nil {
-		l = m.WorkflowExecutionTaskList.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.EventStoreVersion != 0 {
-		n += 1 + sovService(uint64(m.EventStoreVersion))
-	}
-	l = len(m.BranchToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.ScheduledTime != nil {
-		l = m.ScheduledTime.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.StartedTime != nil {
-		l = m.StartedTime.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if len(m.Queries) > 0 {
-		for k, v := range m.Queries {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovService(uint64(l))
+	if len(m.ReplicationTasks) > 0 {
+		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
 			}
-			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.HistorySize != 0 {
-		n += 1 + sovService(uint64(m.HistorySize))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Type != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordActivityTaskStartedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.ScheduleId != 0 {
-		n += 1 + sovService(uint64(m.ScheduleId))
-	}
-	if m.TaskId != 0 {
-		n += 1 + sovService(uint64(m.TaskId))
-	}
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.PollRequest != nil {
-		l = m.PollRequest.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordActivityTaskStartedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PurgeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ScheduledEvent != nil {
-		l = m.ScheduledEvent.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.StartedTime != nil {
-		l = m.StartedTime.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovService(uint64(m.Attempt))
-	}
-	if m.ScheduledTimeOfThisAttempt != nil {
-		l = m.ScheduledTimeOfThisAttempt.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.HeartbeatDetails != nil {
-		l = m.HeartbeatDetails.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.InclusiveEndMessageId != nil {
+		{
+			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.WorkflowDomain)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Type != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondDecisionTaskCompletedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondDecisionTaskCompletedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StartedResponse != nil {
-		l = m.StartedResponse.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ActivitiesToDispatchLocally) > 0 {
-		for k, v := range m.ActivitiesToDispatchLocally {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovService(uint64(l))
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-
-func (m *RespondDecisionTaskFailedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.PageSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.InclusiveEndMessageId != nil {
+		{
+			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Type != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondDecisionTaskFailedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordActivityTaskHeartbeatRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *MergeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordActivityTaskHeartbeatResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CancelRequested {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskCompletedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NotifyFailoverMarkersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NotifyFailoverMarkersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotifyFailoverMarkersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.FailoverMarkerTokens) > 0 {
+		for iNdEx := len(m.FailoverMarkerTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailoverMarkerTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskCompletedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NotifyFailoverMarkersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NotifyFailoverMarkersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotifyFailoverMarkersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskFailedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetCrossClusterTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCrossClusterTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCrossClusterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.TargetCluster) > 0 {
+		i -= len(m.TargetCluster)
+		copy(dAtA[i:], m.TargetCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TargetCluster)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ShardIds) > 0 {
+		dAtA100 := make([]byte, len(m.ShardIds)*10)
+		var j99 int
+		for _, num1 := range m.ShardIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA100[j99] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j99++
+			}
+			dAtA100[j99] = uint8(num)
+			j99++
+		}
+		i -= j99
+		copy(dAtA[i:], dAtA100[:j99])
+		i = encodeVarintService(dAtA, i, uint64(j99))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskFailedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetCrossClusterTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskCanceledRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetCrossClusterTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCrossClusterTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *RespondActivityTaskCanceledResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.FailedCauseByShard) > 0 {
+		for k := range m.FailedCauseByShard {
+			v := m.FailedCauseByShard[k]
+			baseI := i
+			i = encodeVarintService(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintService(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.TasksByShard) > 0 {
+		for k := range m.TasksByShard {
+			v := m.TasksByShard[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintService(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveSignalMutableStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RespondCrossClusterTasksCompletedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RemoveSignalMutableStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *RespondCrossClusterTasksCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RespondCrossClusterTasksCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CancelRequest != nil {
-		l = m.CancelRequest.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.FetchNewTasks {
+		i--
+		if m.FetchNewTasks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.ExternalExecutionInfo != nil {
-		l = m.ExternalExecutionInfo.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.TaskResponses) > 0 {
+		for iNdEx := len(m.TaskResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.ChildWorkflowOnly {
-		n += 2
+	if len(m.TargetCluster) > 0 {
+		i -= len(m.TargetCluster)
+		copy(dAtA[i:], m.TargetCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TargetCluster)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RespondCrossClusterTasksCompletedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondCrossClusterTasksCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RespondCrossClusterTasksCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Tasks != nil {
+		{
+			size, err := m.Tasks.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ScheduleDecisionTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetFailoverInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFailoverInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFailoverInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.IsFirstDecision {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ScheduleDecisionTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetFailoverInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFailoverInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.PendingShards) > 0 {
+		dAtA104 := make([]byte, len(m.PendingShards)*10)
+		var j103 int
+		for _, num1 := range m.PendingShards {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA104[j103] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j103++
+			}
+			dAtA104[j103] = uint8(num)
+			j103++
+		}
+		i -= j103
+		copy(dAtA[i:], dAtA104[:j103])
+		i = encodeVarintService(dAtA, i, uint64(j103))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CompletedShardCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.CompletedShardCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordChildExecutionCompletedRequest) Size() (n int) {
+func (m *RatelimitUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RatelimitUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RatelimitUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RatelimitUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RatelimitUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RatelimitUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StartWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
 	l = len(m.DomainId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
+	if m.ParentExecutionInfo != nil {
+		l = m.ParentExecutionInfo.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.InitiatedId != 0 {
-		n += 1 + sovService(uint64(m.InitiatedId))
+	if m.Attempt != 0 {
+		n += 1 + sovService(uint64(m.Attempt))
 	}
-	if m.CompletedExecution != nil {
-		l = m.CompletedExecution.Size()
+	if m.ExpirationTime != nil {
+		l = m.ExpirationTime.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.CompletionEvent != nil {
-		l = m.CompletionEvent.Size()
+	if m.ContinueAsNewInitiator != 0 {
+		n += 1 + sovService(uint64(m.ContinueAsNewInitiator))
+	}
+	if m.ContinuedFailure != nil {
+		l = m.ContinuedFailure.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.StartedId != 0 {
-		n += 1 + sovService(uint64(m.StartedId))
+	if m.LastCompletionResult != nil {
+		l = m.LastCompletionResult.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.FirstDecisionTaskBackoff != nil {
+		l = m.FirstDecisionTaskBackoff.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.PartitionConfig) > 0 {
+		for k, v := range m.PartitionConfig {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11582,45 +11860,42 @@ func (m *RecordChildExecutionCompletedRequest) Size() (n int) {
 	return n
 }
 
-func (m *RecordChildExecutionCompletedResponse) Size() (n int) {
+func (m *StartWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ReplicateEventsV2Request) Size() (n int) {
+func (m *SignalWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
+	if m.Request != nil {
+		l = m.Request.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
+	l = len(m.DomainId)
+	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if len(m.VersionHistoryItems) > 0 {
-		for _, e := range m.VersionHistoryItems {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
-	}
-	if m.Events != nil {
-		l = m.Events.Size()
+	if m.ExternalWorkflowExecution != nil {
+		l = m.ExternalWorkflowExecution.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.NewRunEvents != nil {
-		l = m.NewRunEvents.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.ChildWorkflowOnly {
+		n += 2
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11628,7 +11903,7 @@ func (m *ReplicateEventsV2Request) Size() (n int) {
 	return n
 }
 
-func (m *ReplicateEventsV2Response) Size() (n int) {
+func (m *SignalWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11640,144 +11915,136 @@ func (m *ReplicateEventsV2Response) Size() (n int) {
 	return n
 }
 
-func (m *SyncShardStatusRequest) Size() (n int) {
+func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.SourceCluster)
-	if l > 0 {
+	if m.Request != nil {
+		l = m.Request.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
-	}
-	if m.Time != nil {
-		l = m.Time.Size()
+	l = len(m.DomainId)
+	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if len(m.PartitionConfig) > 0 {
+		for k, v := range m.PartitionConfig {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *SyncShardStatusResponse) Size() (n int) {
+func (m *SignalWithStartWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *SyncActivityRequest) Size() (n int) {
+func (m *ResetWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
+	if m.Request != nil {
+		l = m.Request.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
+	l = len(m.DomainId)
+	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.Version != 0 {
-		n += 1 + sovService(uint64(m.Version))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if m.ScheduledId != 0 {
-		n += 1 + sovService(uint64(m.ScheduledId))
+	return n
+}
+
+func (m *ResetWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.ScheduledTime != nil {
-		l = m.ScheduledTime.Size()
+	var l int
+	_ = l
+	l = len(m.RunId)
+	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.StartedId != 0 {
-		n += 1 + sovService(uint64(m.StartedId))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if m.StartedTime != nil {
-		l = m.StartedTime.Size()
+	return n
+}
+
+func (m *TerminateWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.LastHeartbeatTime != nil {
-		l = m.LastHeartbeatTime.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovService(uint64(m.Attempt))
-	}
-	if m.LastFailure != nil {
-		l = m.LastFailure.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.LastWorkerIdentity)
+	l = len(m.DomainId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.VersionHistory != nil {
-		l = m.VersionHistory.Size()
+	if m.ExternalWorkflowExecution != nil {
+		l = m.ExternalWorkflowExecution.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *SyncActivityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ChildWorkflowOnly {
+		n += 2
 	}
-	var l int
-	_ = l
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *DescribeMutableStateRequest) Size() (n int) {
+func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *DescribeMutableStateResponse) Size() (n int) {
+func (m *PauseWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.MutableStateInCache)
-	if l > 0 {
+	if m.Request != nil {
+		l = m.Request.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	l = len(m.MutableStateInDatabase)
+	l = len(m.DomainId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
@@ -11787,7 +12054,7 @@ func (m *DescribeMutableStateResponse) Size() (n int) {
 	return n
 }
 
-func (m *DescribeHistoryHostRequest) Size() (n int) {
+func (m *PauseWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11799,31 +12066,17 @@ func (m *DescribeHistoryHostRequest) Size() (n int) {
 	return n
 }
 
-func (m *DescribeHistoryHostResponse) Size() (n int) {
+func (m *UnpauseWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.NumberOfShards != 0 {
-		n += 1 + sovService(uint64(m.NumberOfShards))
-	}
-	if len(m.ShardIds) > 0 {
-		l = 0
-		for _, e := range m.ShardIds {
-			l += sovService(uint64(e))
-		}
-		n += 1 + sovService(uint64(l)) + l
-	}
-	if m.DomainCache != nil {
-		l = m.DomainCache.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.ShardControllerStatus)
-	if l > 0 {
+	if m.Request != nil {
+		l = m.Request.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	l = len(m.Address)
+	l = len(m.DomainId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
@@ -11833,22 +12086,7 @@ func (m *DescribeHistoryHostResponse) Size() (n int) {
 	return n
 }
 
-func (m *CloseShardRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *CloseShardResponse) Size() (n int) {
+func (m *UnpauseWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11860,26 +12098,17 @@ func (m *CloseShardResponse) Size() (n int) {
 	return n
 }
 
-func (m *RemoveTaskRequest) Size() (n int) {
+func (m *PauseActivityRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
-	}
-	if m.TaskType != 0 {
-		n += 1 + sovService(uint64(m.TaskType))
-	}
-	if m.TaskId != 0 {
-		n += 1 + sovService(uint64(m.TaskId))
-	}
-	if m.VisibilityTime != nil {
-		l = m.VisibilityTime.Size()
+	if m.Request != nil {
+		l = m.Request.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	l = len(m.ClusterName)
+	l = len(m.DomainId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
@@ -11889,7 +12118,7 @@ func (m *RemoveTaskRequest) Size() (n int) {
 	return n
 }
 
-func (m *RemoveTaskResponse) Size() (n int) {
+func (m *PauseActivityResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11901,29 +12130,27 @@ func (m *RemoveTaskResponse) Size() (n int) {
 	return n
 }
 
-func (m *ResetQueueRequest) Size() (n int) {
+func (m *UnpauseActivityRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	l = len(m.ClusterName)
+	l = len(m.DomainId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.TaskType != 0 {
-		n += 1 + sovService(uint64(m.TaskType))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ResetQueueResponse) Size() (n int) {
+func (m *UnpauseActivityResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11935,59 +12162,49 @@ func (m *ResetQueueResponse) Size() (n int) {
 	return n
 }
 
-func (m *DescribeQueueRequest) Size() (n int) {
+func (m *ResetActivityRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	l = len(m.ClusterName)
+	l = len(m.DomainId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.TaskType != 0 {
-		n += 1 + sovService(uint64(m.TaskType))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *DescribeQueueResponse) Size() (n int) {
+func (m *ResetActivityResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ProcessingQueueStates) > 0 {
-		for _, s := range m.ProcessingQueueStates {
-			l = len(s)
-			n += 1 + l + sovService(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GetReplicationMessagesRequest) Size() (n int) {
+func (m *UpdateActivityOptionsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	l = len(m.ClusterName)
+	l = len(m.DomainId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
@@ -11997,42 +12214,31 @@ func (m *GetReplicationMessagesRequest) Size() (n int) {
 	return n
 }
 
-func (m *GetReplicationMessagesResponse) Size() (n int) {
+func (m *UpdateActivityOptionsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ShardMessages) > 0 {
-		for k, v := range m.ShardMessages {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovService(uint64(l))
-			}
-			mapEntrySize := 1 + sovService(uint64(k)) + l
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
-		}
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GetDLQReplicationMessagesRequest) Size() (n int) {
+func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.TaskInfos) > 0 {
-		for _, e := range m.TaskInfos {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12040,89 +12246,103 @@ func (m *GetDLQReplicationMessagesRequest) Size() (n int) {
 	return n
 }
 
-func (m *GetDLQReplicationMessagesResponse) Size() (n int) {
+func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ReplicationTasks) > 0 {
-		for _, e := range m.ReplicationTasks {
+	if m.ExecutionConfiguration != nil {
+		l = m.ExecutionConfiguration.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecutionInfo != nil {
+		l = m.WorkflowExecutionInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.PendingActivities) > 0 {
+		for _, e := range m.PendingActivities {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.PendingChildren) > 0 {
+		for _, e := range m.PendingChildren {
 			l = e.Size()
 			n += 1 + l + sovService(uint64(l))
 		}
 	}
+	if m.PendingDecision != nil {
+		l = m.PendingDecision.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ReapplyEventsRequest) Size() (n int) {
+func (m *QueryWorkflowRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Domain)
-	if l > 0 {
+	if m.Request != nil {
+		l = m.Request.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
 	l = len(m.DomainId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.Events != nil {
-		l = m.Events.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ReapplyEventsResponse) Size() (n int) {
+func (m *QueryWorkflowResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.QueryResult != nil {
+		l = m.QueryResult.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.QueryRejected != nil {
+		l = m.QueryRejected.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *RefreshWorkflowTasksRequest) Size() (n int) {
+func (m *ResetStickyTaskListRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Domain)
-	if l > 0 {
+	if m.Request != nil {
+		l = m.Request.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
 	l = len(m.DomainId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *RefreshWorkflowTasksResponse) Size() (n int) {
+func (m *ResetStickyTaskListResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12134,14 +12354,26 @@ func (m *RefreshWorkflowTasksResponse) Size() (n int) {
 	return n
 }
 
-func (m *CountDLQMessagesRequest) Size() (n int) {
+func (m *GetMutableStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ForceFetch {
-		n += 2
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ExpectedNextEventId != 0 {
+		n += 1 + sovService(uint64(m.ExpectedNextEventId))
+	}
+	l = len(m.CurrentBranchToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12149,79 +12381,101 @@ func (m *CountDLQMessagesRequest) Size() (n int) {
 	return n
 }
 
-func (m *CountDLQMessagesResponse) Size() (n int) {
+func (m *GetMutableStateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	return n
-}
-
-func (m *ReadDLQMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.NextEventId != 0 {
+		n += 1 + sovService(uint64(m.NextEventId))
 	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovService(uint64(m.Type))
+	if m.PreviousStartedEventId != nil {
+		l = m.PreviousStartedEventId.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
+	if m.LastFirstEventId != 0 {
+		n += 1 + sovService(uint64(m.LastFirstEventId))
 	}
-	l = len(m.SourceCluster)
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StickyTaskList != nil {
+		l = m.StickyTaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.ClientLibraryVersion)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.InclusiveEndMessageId != nil {
-		l = m.InclusiveEndMessageId.Size()
+	l = len(m.ClientFeatureVersion)
+	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.PageSize != 0 {
-		n += 1 + sovService(uint64(m.PageSize))
+	l = len(m.ClientImpl)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
 	}
-	l = len(m.NextPageToken)
+	if m.StickyTaskListScheduleToStartTimeout != nil {
+		l = m.StickyTaskListScheduleToStartTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.EventStoreVersion != 0 {
+		n += 1 + sovService(uint64(m.EventStoreVersion))
+	}
+	l = len(m.CurrentBranchToken)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.WorkflowState != 0 {
+		n += 1 + sovService(uint64(m.WorkflowState))
+	}
+	if m.WorkflowCloseState != 0 {
+		n += 1 + sovService(uint64(m.WorkflowCloseState))
+	}
+	if m.VersionHistories != nil {
+		l = m.VersionHistories.Size()
+		n += 2 + l + sovService(uint64(l))
+	}
+	if m.IsStickyTaskListEnabled {
+		n += 3
+	}
+	if m.HistorySize != 0 {
+		n += 2 + sovService(uint64(m.HistorySize))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ReadDLQMessagesResponse) Size() (n int) {
+func (m *PollMutableStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovService(uint64(m.Type))
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
 	}
-	if len(m.ReplicationTasks) > 0 {
-		for _, e := range m.ReplicationTasks {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	if len(m.ReplicationTasksInfo) > 0 {
-		for _, e := range m.ReplicationTasksInfo {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	if m.ExpectedNextEventId != 0 {
+		n += 1 + sovService(uint64(m.ExpectedNextEventId))
 	}
-	l = len(m.NextPageToken)
+	l = len(m.CurrentBranchToken)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
@@ -12231,170 +12485,298 @@ func (m *ReadDLQMessagesResponse) Size() (n int) {
 	return n
 }
 
-func (m *PurgeDLQMessagesRequest) Size() (n int) {
+func (m *PollMutableStateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovService(uint64(m.Type))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
-	}
-	l = len(m.SourceCluster)
-	if l > 0 {
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.InclusiveEndMessageId != nil {
-		l = m.InclusiveEndMessageId.Size()
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.NextEventId != 0 {
+		n += 1 + sovService(uint64(m.NextEventId))
 	}
-	return n
-}
-
-func (m *PurgeDLQMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.PreviousStartedEventId != nil {
+		l = m.PreviousStartedEventId.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.LastFirstEventId != 0 {
+		n += 1 + sovService(uint64(m.LastFirstEventId))
 	}
-	return n
-}
-
-func (m *MergeDLQMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovService(uint64(m.Type))
+	if m.StickyTaskList != nil {
+		l = m.StickyTaskList.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
+	l = len(m.ClientLibraryVersion)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
 	}
-	l = len(m.SourceCluster)
+	l = len(m.ClientFeatureVersion)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.InclusiveEndMessageId != nil {
-		l = m.InclusiveEndMessageId.Size()
+	l = len(m.ClientImpl)
+	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.PageSize != 0 {
-		n += 1 + sovService(uint64(m.PageSize))
+	if m.StickyTaskListScheduleToStartTimeout != nil {
+		l = m.StickyTaskListScheduleToStartTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	l = len(m.NextPageToken)
+	l = len(m.CurrentBranchToken)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.VersionHistories != nil {
+		l = m.VersionHistories.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowState != 0 {
+		n += 1 + sovService(uint64(m.WorkflowState))
+	}
+	if m.WorkflowCloseState != 0 {
+		n += 1 + sovService(uint64(m.WorkflowCloseState))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *MergeDLQMessagesResponse) Size() (n int) {
+func (m *RecordDecisionTaskStartedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.NextPageToken)
+	l = len(m.DomainId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	return n
-}
-
-func (m *NotifyFailoverMarkersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ScheduleId != 0 {
+		n += 1 + sovService(uint64(m.ScheduleId))
 	}
-	var l int
-	_ = l
-	if len(m.FailoverMarkerTokens) > 0 {
-		for _, e := range m.FailoverMarkerTokens {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	if m.TaskId != 0 {
+		n += 1 + sovService(uint64(m.TaskId))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
 	}
-	return n
-}
-
-func (m *NotifyFailoverMarkersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.PollRequest != nil {
+		l = m.PollRequest.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	var l int
-	_ = l
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GetCrossClusterTasksRequest) Size() (n int) {
+func (m *RecordDecisionTaskStartedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ShardIds) > 0 {
-		l = 0
-		for _, e := range m.ShardIds {
-			l += sovService(uint64(e))
-		}
-		n += 1 + sovService(uint64(l)) + l
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	l = len(m.TargetCluster)
-	if l > 0 {
+	if m.PreviousStartedEventId != nil {
+		l = m.PreviousStartedEventId.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovService(uint64(m.ScheduledEventId))
 	}
-	return n
-}
-
-func (m *GetCrossClusterTasksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.StartedEventId != 0 {
+		n += 1 + sovService(uint64(m.StartedEventId))
 	}
-	var l int
-	_ = l
-	if len(m.TasksByShard) > 0 {
-		for k, v := range m.TasksByShard {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
+	if m.NextEventId != 0 {
+		n += 1 + sovService(uint64(m.NextEventId))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovService(uint64(m.Attempt))
+	}
+	if m.StickyExecutionEnabled {
+		n += 2
+	}
+	if m.DecisionInfo != nil {
+		l = m.DecisionInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecutionTaskList != nil {
+		l = m.WorkflowExecutionTaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.EventStoreVersion != 0 {
+		n += 1 + sovService(uint64(m.EventStoreVersion))
+	}
+	l = len(m.BranchToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ScheduledTime != nil {
+		l = m.ScheduledTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartedTime != nil {
+		l = m.StartedTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Queries) > 0 {
+		for k, v := range m.Queries {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
 				l += 1 + sovService(uint64(l))
 			}
-			mapEntrySize := 1 + sovService(uint64(k)) + l
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + l
 			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
 		}
 	}
-	if len(m.FailedCauseByShard) > 0 {
-		for k, v := range m.FailedCauseByShard {
+	if m.HistorySize != 0 {
+		n += 1 + sovService(uint64(m.HistorySize))
+	}
+	if m.ContinueAsNewSuggested {
+		n += 3
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RecordActivityTaskStartedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ScheduleId != 0 {
+		n += 1 + sovService(uint64(m.ScheduleId))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovService(uint64(m.TaskId))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PollRequest != nil {
+		l = m.PollRequest.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RecordActivityTaskStartedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledEvent != nil {
+		l = m.ScheduledEvent.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartedTime != nil {
+		l = m.StartedTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovService(uint64(m.Attempt))
+	}
+	if m.ScheduledTimeOfThisAttempt != nil {
+		l = m.ScheduledTimeOfThisAttempt.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.HeartbeatDetails != nil {
+		l = m.HeartbeatDetails.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.WorkflowDomain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RespondDecisionTaskCompletedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RespondDecisionTaskCompletedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartedResponse != nil {
+		l = m.StartedResponse.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.ActivitiesToDispatchLocally) > 0 {
+		for k, v := range m.ActivitiesToDispatchLocally {
 			_ = k
 			_ = v
-			mapEntrySize := 1 + sovService(uint64(k)) + 1 + sovService(uint64(v))
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovService(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + l
 			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
 		}
 	}
@@ -12404,42 +12786,50 @@ func (m *GetCrossClusterTasksResponse) Size() (n int) {
 	return n
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) Size() (n int) {
+func (m *RespondDecisionTaskFailedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
 	}
-	l = len(m.TargetCluster)
+	l = len(m.DomainId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if len(m.TaskResponses) > 0 {
-		for _, e := range m.TaskResponses {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if m.FetchNewTasks {
-		n += 2
+	return n
+}
+
+func (m *RespondDecisionTaskFailedResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *RespondCrossClusterTasksCompletedResponse) Size() (n int) {
+func (m *RecordActivityTaskHeartbeatRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Tasks != nil {
-		l = m.Tasks.Size()
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -12448,12 +12838,31 @@ func (m *RespondCrossClusterTasksCompletedResponse) Size() (n int) {
 	return n
 }
 
-func (m *GetFailoverInfoRequest) Size() (n int) {
+func (m *RecordActivityTaskHeartbeatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CancelRequested {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RespondActivityTaskCompletedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
 	l = len(m.DomainId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
@@ -12464,67 +12873,2322 @@ func (m *GetFailoverInfoRequest) Size() (n int) {
 	return n
 }
 
-func (m *GetFailoverInfoResponse) Size() (n int) {
+func (m *RespondActivityTaskCompletedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CompletedShardCount != 0 {
-		n += 1 + sovService(uint64(m.CompletedShardCount))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if len(m.PendingShards) > 0 {
-		l = 0
-		for _, e := range m.PendingShards {
-			l += sovService(uint64(e))
+	return n
+}
+
+func (m *RespondActivityTaskFailedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RespondActivityTaskFailedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RespondActivityTaskCanceledRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RespondActivityTaskCanceledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveSignalMutableStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveSignalMutableStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestCancelWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.CancelRequest != nil {
+		l = m.CancelRequest.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ExternalExecutionInfo != nil {
+		l = m.ExternalExecutionInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ChildWorkflowOnly {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleDecisionTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.IsFirstDecision {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleDecisionTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RecordChildExecutionCompletedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.InitiatedId != 0 {
+		n += 1 + sovService(uint64(m.InitiatedId))
+	}
+	if m.CompletedExecution != nil {
+		l = m.CompletedExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.CompletionEvent != nil {
+		l = m.CompletionEvent.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartedId != 0 {
+		n += 1 + sovService(uint64(m.StartedId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RecordChildExecutionCompletedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicateEventsV2Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.VersionHistoryItems) > 0 {
+		for _, e := range m.VersionHistoryItems {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.Events != nil {
+		l = m.Events.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.NewRunEvents != nil {
+		l = m.NewRunEvents.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicateEventsV2Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncShardStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncShardStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncActivityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovService(uint64(m.Version))
+	}
+	if m.ScheduledId != 0 {
+		n += 1 + sovService(uint64(m.ScheduledId))
+	}
+	if m.ScheduledTime != nil {
+		l = m.ScheduledTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartedId != 0 {
+		n += 1 + sovService(uint64(m.StartedId))
+	}
+	if m.StartedTime != nil {
+		l = m.StartedTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.LastHeartbeatTime != nil {
+		l = m.LastHeartbeatTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovService(uint64(m.Attempt))
+	}
+	if m.LastFailure != nil {
+		l = m.LastFailure.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.LastWorkerIdentity)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.VersionHistory != nil {
+		l = m.VersionHistory.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncActivityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeMutableStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.RebuildFromHistory {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeMutableStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MutableStateInCache)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.MutableStateInDatabase)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.MutableStateRebuiltFromHistory)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeHistoryHostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeHistoryHostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumberOfShards != 0 {
+		n += 1 + sovService(uint64(m.NumberOfShards))
+	}
+	if len(m.ShardIds) > 0 {
+		l = 0
+		for _, e := range m.ShardIds {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	if m.DomainCache != nil {
+		l = m.DomainCache.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.ShardControllerStatus)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CloseShardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CloseShardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovService(uint64(m.TaskType))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovService(uint64(m.TaskId))
+	}
+	if m.VisibilityTime != nil {
+		l = m.VisibilityTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResetQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovService(uint64(m.TaskType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResetQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovService(uint64(m.TaskType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProcessingQueueStates) > 0 {
+		for _, s := range m.ProcessingQueueStates {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListThrottledWorkflowIDsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListThrottledWorkflowIDsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ThrottledWorkflowIds) > 0 {
+		for _, e := range m.ThrottledWorkflowIds {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShardMessages) > 0 {
+		for k, v := range m.ShardMessages {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovService(uint64(l))
+			}
+			mapEntrySize := 1 + sovService(uint64(k)) + l
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDLQReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskInfos) > 0 {
+		for _, e := range m.TaskInfos {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDLQReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReplicationTasks) > 0 {
+		for _, e := range m.ReplicationTasks {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReapplyEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Events != nil {
+		l = m.Events.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReapplyEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RefreshWorkflowTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RefreshWorkflowTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CountDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForceFetch {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CountDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovService(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.InclusiveEndMessageId != nil {
+		l = m.InclusiveEndMessageId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovService(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovService(uint64(m.Type))
+	}
+	if len(m.ReplicationTasks) > 0 {
+		for _, e := range m.ReplicationTasks {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.ReplicationTasksInfo) > 0 {
+		for _, e := range m.ReplicationTasksInfo {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurgeDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovService(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.InclusiveEndMessageId != nil {
+		l = m.InclusiveEndMessageId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurgeDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovService(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.InclusiveEndMessageId != nil {
+		l = m.InclusiveEndMessageId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovService(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NotifyFailoverMarkersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailoverMarkerTokens) > 0 {
+		for _, e := range m.FailoverMarkerTokens {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NotifyFailoverMarkersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetCrossClusterTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShardIds) > 0 {
+		l = 0
+		for _, e := range m.ShardIds {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	l = len(m.TargetCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetCrossClusterTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TasksByShard) > 0 {
+		for k, v := range m.TasksByShard {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovService(uint64(l))
+			}
+			mapEntrySize := 1 + sovService(uint64(k)) + l
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if len(m.FailedCauseByShard) > 0 {
+		for k, v := range m.FailedCauseByShard {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovService(uint64(k)) + 1 + sovService(uint64(v))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RespondCrossClusterTasksCompletedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.TargetCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.TaskResponses) > 0 {
+		for _, e := range m.TaskResponses {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.FetchNewTasks {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RespondCrossClusterTasksCompletedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tasks != nil {
+		l = m.Tasks.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetFailoverInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetFailoverInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompletedShardCount != 0 {
+		n += 1 + sovService(uint64(m.CompletedShardCount))
+	}
+	if len(m.PendingShards) > 0 {
+		l = 0
+		for _, e := range m.PendingShards {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RatelimitUpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RatelimitUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.StartWorkflowExecutionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentExecutionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentExecutionInfo == nil {
+				m.ParentExecutionInfo = &v1.ParentExecutionInfo{}
+			}
+			if err := m.ParentExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = &types.Timestamp{}
+			}
+			if err := m.ExpirationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueAsNewInitiator", wireType)
+			}
+			m.ContinueAsNewInitiator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContinueAsNewInitiator |= v1.ContinueAsNewInitiator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuedFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContinuedFailure == nil {
+				m.ContinuedFailure = &v1.Failure{}
+			}
+			if err := m.ContinuedFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCompletionResult == nil {
+				m.LastCompletionResult = &v1.Payload{}
+			}
+			if err := m.LastCompletionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstDecisionTaskBackoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FirstDecisionTaskBackoff == nil {
+				m.FirstDecisionTaskBackoff = &types.Duration{}
+			}
+			if err := m.FirstDecisionTaskBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PartitionConfig[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.SignalWorkflowExecutionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalWorkflowExecution == nil {
+				m.ExternalWorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChildWorkflowOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.SignalWithStartWorkflowExecutionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PartitionConfig[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
-		n += 1 + sovService(uint64(l)) + l
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *RatelimitUpdateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovService(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *RatelimitUpdateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovService(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozService(x uint64) (n int) {
-	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12547,10 +15211,10 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12583,7 +15247,7 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.StartWorkflowExecutionRequest{}
+				m.Request = &v1.ResetWorkflowExecutionRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -12621,11 +15285,62 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentExecutionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -12635,50 +15350,78 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentExecutionInfo == nil {
-				m.ParentExecutionInfo = &v1.ParentExecutionInfo{}
-			}
-			if err := m.ParentExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
 			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12705,37 +15448,18 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationTime == nil {
-				m.ExpirationTime = &types.Timestamp{}
-			}
-			if err := m.ExpirationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueAsNewInitiator", wireType)
-			}
-			m.ContinueAsNewInitiator = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ContinueAsNewInitiator |= v1.ContinueAsNewInitiator(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Request == nil {
+				m.Request = &v1.TerminateWorkflowExecutionRequest{}
 			}
-		case 7:
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinuedFailure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -12745,31 +15469,27 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContinuedFailure == nil {
-				m.ContinuedFailure = &v1.Failure{}
-			}
-			if err := m.ContinuedFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12796,18 +15516,18 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionResult == nil {
-				m.LastCompletionResult = &v1.Payload{}
+			if m.ExternalWorkflowExecution == nil {
+				m.ExternalWorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.LastCompletionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstDecisionTaskBackoff", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -12817,31 +15537,117 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
+			m.ChildWorkflowOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FirstDecisionTaskBackoff == nil {
-				m.FirstDecisionTaskBackoff = &types.Duration{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			if err := m.FirstDecisionTaskBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12868,103 +15674,44 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PartitionConfig == nil {
-				m.PartitionConfig = make(map[string]string)
+			if m.Request == nil {
+				m.Request = &v1.PauseWorkflowExecutionRequest{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.PartitionConfig[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12988,7 +15735,7 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *PauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13011,44 +15758,12 @@ func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -13071,7 +15786,7 @@ func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *UnpauseWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13094,10 +15809,10 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13130,7 +15845,7 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.SignalWorkflowExecutionRequest{}
+				m.Request = &v1.UnpauseWorkflowExecutionRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13168,62 +15883,6 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExternalWorkflowExecution == nil {
-				m.ExternalWorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ChildWorkflowOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -13246,7 +15905,7 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *UnpauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13269,10 +15928,10 @@ func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13297,7 +15956,7 @@ func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *PauseActivityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13320,10 +15979,10 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseActivityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13356,7 +16015,7 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.SignalWithStartWorkflowExecutionRequest{}
+				m.Request = &v1.PauseActivityRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13387,139 +16046,12 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			}
 			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PartitionConfig == nil {
-				m.PartitionConfig = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+				return ErrInvalidLengthService
 			}
-			m.PartitionConfig[mapkey] = mapvalue
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13543,7 +16075,7 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *PauseActivityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13566,44 +16098,12 @@ func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseActivityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -13626,7 +16126,7 @@ func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *UnpauseActivityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13649,10 +16149,10 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseActivityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13685,7 +16185,7 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.ResetWorkflowExecutionRequest{}
+				m.Request = &v1.UnpauseActivityRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13745,7 +16245,7 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *UnpauseActivityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13768,44 +16268,12 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseActivityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -13828,7 +16296,7 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetActivityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13851,10 +16319,10 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetActivityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13887,7 +16355,7 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.TerminateWorkflowExecutionRequest{}
+				m.Request = &v1.ResetActivityRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13925,9 +16393,111 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetActivityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetActivityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateActivityOptionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateActivityOptionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateActivityOptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13954,18 +16524,18 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalWorkflowExecution == nil {
-				m.ExternalWorkflowExecution = &v1.WorkflowExecution{}
+			if m.Request == nil {
+				m.Request = &v1.UpdateActivityOptionsRequest{}
 			}
-			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13975,12 +16545,24 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ChildWorkflowOnly = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -14003,7 +16585,7 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateActivityOptionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14026,10 +16608,10 @@ func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateActivityOptionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateActivityOptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16999,6 +19581,26 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueAsNewSuggested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ContinueAsNewSuggested = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -20517,6 +23119,26 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -20686,7 +23308,27 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebuildFromHistory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RebuildFromHistory = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -20802,6 +23444,38 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.MutableStateInDatabase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MutableStateRebuiltFromHistory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MutableStateRebuiltFromHistory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -21845,6 +24519,161 @@ func (m *DescribeQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ListThrottledWorkflowIDsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListThrottledWorkflowIDsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListThrottledWorkflowIDsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListThrottledWorkflowIDsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListThrottledWorkflowIDsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListThrottledWorkflowIDsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottledWorkflowIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThrottledWorkflowIds = append(m.ThrottledWorkflowIds, &v12.ThrottledWorkflowID{})
+			if err := m.ThrottledWorkflowIds[len(m.ThrottledWorkflowIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -23082,6 +25911,42 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &v12.DLQFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -23672,6 +26537,42 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &v12.DLQFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -33,8 +33,8 @@ import (
 
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
-	v1 "github.com/uber/cadence-idl/go/proto/api/v1"
 
+	v1 "github.com/uber/cadence-idl/go/proto/api/v1"
 	v11 "github.com/uber/cadence/.gen/proto/shared/v1"
 )
 
@@ -147,6 +147,7 @@ type PollForDecisionTaskResponse struct {
 	StartedTime               *types.Timestamp             `protobuf:"bytes,16,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
 	Queries                   map[string]*v1.WorkflowQuery `protobuf:"bytes,17,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	TotalHistoryBytes         int64                        `protobuf:"varint,18,opt,name=total_history_bytes,json=totalHistoryBytes,proto3" json:"total_history_bytes,omitempty"`
+	ContinueAsNewSuggested    bool                         `protobuf:"varint,19,opt,name=continue_as_new_suggested,json=continueAsNewSuggested,proto3" json:"continue_as_new_suggested,omitempty"`
 	XXX_NoUnkeyedLiteral      struct{}                     `json:"-"`
 	XXX_unrecognized          []byte                       `json:"-"`
 	XXX_sizecache             int32                        `json:"-"`
@@ -311,6 +312,13 @@ func (m *PollForDecisionTaskResponse) GetTotalHistoryBytes() int64 {
 	return 0
 }
 
+func (m *PollForDecisionTaskResponse) GetContinueAsNewSuggested() bool {
+	if m != nil {
+		return m.ContinueAsNewSuggested
+	}
+	return false
+}
+
 type PollForActivityTaskRequest struct {
 	Request              *v1.PollForActivityTaskRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                         `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -1654,139 +1662,141 @@ func init() {
 }
 
 var fileDescriptor_826e827d3aabf7fc = []byte{
-	// 2106 bytes of a gzipped FileDescriptorProto
+	// 2137 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x5b, 0x6f, 0x1b, 0xc7,
-	0x15, 0xc6, 0xea, 0x46, 0xf1, 0x50, 0xa2, 0xa4, 0xb1, 0xa3, 0xac, 0x28, 0xeb, 0x62, 0xa6, 0x49,
-	0xd4, 0x22, 0x5d, 0x45, 0x4a, 0xec, 0x3a, 0x0e, 0x8a, 0x42, 0x57, 0x9b, 0x45, 0x5d, 0x3b, 0x6b,
-	0xd5, 0x05, 0x8a, 0xc2, 0x8b, 0xe1, 0xee, 0x48, 0xdc, 0x8a, 0xdc, 0x5d, 0xef, 0x0c, 0xa9, 0xb0,
-	0x0f, 0x7d, 0x28, 0xda, 0xa2, 0x40, 0x5e, 0xfb, 0x0f, 0x9a, 0xc7, 0x3e, 0xf6, 0x47, 0xf4, 0xb1,
-	0x8f, 0x05, 0x82, 0x02, 0x85, 0x81, 0xfe, 0x80, 0xf6, 0x17, 0x14, 0x33, 0x3b, 0xbb, 0xdc, 0x25,
-	0x67, 0x79, 0x91, 0x9c, 0xa4, 0x6f, 0x9c, 0x99, 0x73, 0xbe, 0x73, 0xe6, 0xdc, 0x67, 0x41, 0x78,
-	0xaf, 0x5d, 0x27, 0xe1, 0xae, 0x8d, 0x1d, 0xe2, 0xd9, 0x64, 0xb7, 0x85, 0x99, 0xdd, 0x70, 0xbd,
-	0x8b, 0xdd, 0xce, 0xde, 0x2e, 0x25, 0x61, 0xc7, 0xb5, 0x89, 0x11, 0x84, 0x3e, 0xf3, 0x91, 0xce,
-	0xe9, 0x0c, 0x49, 0x67, 0xc4, 0x74, 0x46, 0x67, 0xaf, 0xb2, 0x79, 0xe1, 0xfb, 0x17, 0x4d, 0xb2,
-	0x2b, 0xe8, 0xea, 0xed, 0xf3, 0x5d, 0xa7, 0x1d, 0x62, 0xe6, 0xfa, 0x5e, 0xc4, 0x59, 0xd9, 0xea,
-	0x3f, 0x67, 0x6e, 0x8b, 0x50, 0x86, 0x5b, 0x81, 0x24, 0x18, 0x00, 0xb8, 0x0a, 0x71, 0x10, 0x90,
-	0x90, 0xca, 0xf3, 0xed, 0x8c, 0x8a, 0x38, 0x70, 0xb9, 0x76, 0xb6, 0xdf, 0x6a, 0xf5, 0x44, 0xa8,
-	0x28, 0x5e, 0xb5, 0x49, 0xd8, 0x95, 0x04, 0x55, 0x15, 0x01, 0xc3, 0xf4, 0xb2, 0xe9, 0x52, 0x26,
-	0x69, 0x76, 0x54, 0x34, 0xd2, 0x08, 0xd6, 0x95, 0x1f, 0x5e, 0x92, 0x50, 0x52, 0x7e, 0x6f, 0x14,
-	0xe5, 0x79, 0xd3, 0xbf, 0x92, 0xb4, 0x77, 0x55, 0xb4, 0x0d, 0x97, 0x32, 0x3f, 0x51, 0xee, 0x3b,
-	0x19, 0x12, 0xda, 0xc0, 0x21, 0x71, 0x06, 0xa9, 0xde, 0xcd, 0xa1, 0xca, 0xde, 0xa2, 0xfa, 0x1f,
-	0x0d, 0x2a, 0xcf, 0xfc, 0x66, 0xf3, 0xd4, 0x0f, 0x8f, 0x89, 0xed, 0x52, 0xd7, 0xf7, 0xce, 0x30,
-	0xbd, 0x34, 0xc9, 0xab, 0x36, 0xa1, 0x0c, 0xd5, 0xa0, 0x10, 0x46, 0x3f, 0x75, 0x6d, 0x5b, 0xdb,
-	0x29, 0xed, 0xef, 0x1a, 0x19, 0xc7, 0xe2, 0xc0, 0x35, 0x3a, 0x7b, 0x46, 0x3e, 0x82, 0x19, 0xf3,
-	0xa3, 0x75, 0x28, 0x3a, 0x7e, 0x0b, 0xbb, 0x9e, 0xe5, 0x3a, 0xfa, 0xd4, 0xb6, 0xb6, 0x53, 0x34,
-	0xe7, 0xa3, 0x8d, 0x9a, 0xc3, 0x0f, 0x03, 0xbf, 0xd9, 0x24, 0x21, 0x3f, 0x9c, 0x8e, 0x0e, 0xa3,
-	0x8d, 0x9a, 0x83, 0xde, 0x85, 0xf2, 0xb9, 0x1f, 0x5e, 0xe1, 0xd0, 0x21, 0x8e, 0x75, 0x1e, 0xfa,
-	0x2d, 0x7d, 0x46, 0x50, 0x2c, 0x26, 0xbb, 0xa7, 0xa1, 0xdf, 0x42, 0xef, 0xc3, 0x92, 0x4b, 0xfd,
-	0xa6, 0x88, 0x25, 0xeb, 0x22, 0xf4, 0xdb, 0x81, 0x3e, 0x2b, 0xe8, 0xca, 0xc9, 0xf6, 0x23, 0xbe,
-	0x5b, 0xfd, 0x6b, 0x11, 0xd6, 0x95, 0x1a, 0xd3, 0xc0, 0xf7, 0x28, 0x41, 0x1b, 0x00, 0xdc, 0x4a,
-	0x16, 0xf3, 0x2f, 0x89, 0x27, 0xee, 0xbd, 0x60, 0x16, 0xf9, 0xce, 0x19, 0xdf, 0x40, 0x3f, 0x03,
-	0x14, 0x3b, 0xcd, 0x22, 0x9f, 0x13, 0xbb, 0xcd, 0x91, 0xc5, 0x8d, 0x4a, 0xfb, 0xef, 0x29, 0xcd,
-	0xf3, 0x73, 0x49, 0x7e, 0x12, 0x53, 0x9b, 0x2b, 0x57, 0xfd, 0x5b, 0xe8, 0x14, 0x16, 0x13, 0x58,
-	0xd6, 0x0d, 0x88, 0x30, 0x43, 0x69, 0xff, 0xee, 0x50, 0xc4, 0xb3, 0x6e, 0x40, 0xcc, 0x85, 0xab,
-	0xd4, 0x0a, 0xbd, 0x80, 0xb5, 0x20, 0x24, 0x1d, 0xd7, 0x6f, 0x53, 0x8b, 0x32, 0x1c, 0x32, 0xe2,
-	0x58, 0xa4, 0x43, 0x3c, 0xc6, 0x4d, 0x3b, 0x23, 0x30, 0xd7, 0x8d, 0x28, 0x85, 0x8c, 0x38, 0x85,
-	0x8c, 0x9a, 0xc7, 0xee, 0x7f, 0xfc, 0x02, 0x37, 0xdb, 0xc4, 0x5c, 0x8d, 0xb9, 0x9f, 0x47, 0xcc,
-	0x27, 0x9c, 0xb7, 0xe6, 0xa0, 0x1d, 0x58, 0x1e, 0x80, 0xe3, 0xf6, 0x9d, 0x36, 0xcb, 0x34, 0x4b,
-	0xa9, 0x43, 0x01, 0x33, 0x46, 0x5a, 0x01, 0xd3, 0xe7, 0xb6, 0xb5, 0x9d, 0x59, 0x33, 0x5e, 0xa2,
-	0x2a, 0x2c, 0x7a, 0xe4, 0x73, 0xd6, 0x03, 0x28, 0x08, 0x80, 0x12, 0xdf, 0x8c, 0xb9, 0x3f, 0x00,
-	0x54, 0xc7, 0xf6, 0x65, 0xd3, 0xbf, 0xb0, 0x6c, 0xbf, 0xed, 0x31, 0xab, 0xe1, 0x7a, 0x4c, 0x9f,
-	0x17, 0x84, 0xcb, 0xf2, 0xe4, 0x88, 0x1f, 0x3c, 0x76, 0x3d, 0x86, 0x1e, 0x80, 0x4e, 0x99, 0x6b,
-	0x5f, 0x76, 0x7b, 0xae, 0xb0, 0x88, 0x87, 0xeb, 0x4d, 0xe2, 0xe8, 0xc5, 0x6d, 0x6d, 0x67, 0xde,
-	0x5c, 0x8d, 0xce, 0x13, 0x43, 0x9f, 0x44, 0xa7, 0xe8, 0x01, 0xcc, 0x8a, 0x94, 0xd7, 0x41, 0xd8,
-	0xa4, 0x3a, 0xd4, 0xce, 0x9f, 0x71, 0x4a, 0x33, 0x62, 0x40, 0x26, 0x2c, 0x3a, 0x32, 0x6e, 0x2c,
-	0xd7, 0x3b, 0xf7, 0xf5, 0x92, 0x40, 0xf8, 0x7e, 0x16, 0x21, 0x4a, 0x39, 0x0e, 0x72, 0x16, 0x62,
-	0x8f, 0xba, 0xc4, 0x63, 0x71, 0xb4, 0xd5, 0xbc, 0x73, 0xdf, 0x5c, 0x70, 0x52, 0x2b, 0xf4, 0x12,
-	0xee, 0x0c, 0x06, 0x95, 0x25, 0xc2, 0x90, 0x67, 0xab, 0xbe, 0x20, 0x44, 0x6c, 0x28, 0x95, 0xe4,
-	0xc1, 0xfb, 0x13, 0x97, 0x32, 0x73, 0x6d, 0x20, 0xaa, 0xe2, 0x23, 0x64, 0xc0, 0xad, 0xc8, 0xe8,
-	0xbc, 0x46, 0x10, 0xab, 0x43, 0x42, 0x2e, 0x5a, 0x5f, 0x14, 0xfe, 0x59, 0x11, 0x47, 0xcf, 0xf9,
-	0xc9, 0x8b, 0xe8, 0x00, 0xdd, 0x85, 0x85, 0x7a, 0x88, 0x3d, 0xbb, 0x21, 0xb3, 0xa0, 0x2c, 0xb2,
-	0xa0, 0x14, 0xed, 0x45, 0x79, 0x70, 0x00, 0x65, 0x6a, 0x37, 0x88, 0xd3, 0x6e, 0x12, 0xc7, 0xe2,
-	0x45, 0x5a, 0x5f, 0x12, 0x4a, 0x56, 0x06, 0xa2, 0xeb, 0x2c, 0xae, 0xe0, 0xe6, 0x62, 0xc2, 0xc1,
-	0xf7, 0xd0, 0x0f, 0x61, 0x21, 0x8e, 0x29, 0x01, 0xb0, 0x3c, 0x12, 0xa0, 0x24, 0xe9, 0x05, 0xfb,
-	0x2f, 0xa1, 0xc0, 0x3d, 0xe2, 0x12, 0xaa, 0xaf, 0x6c, 0x4f, 0xef, 0x94, 0xf6, 0x0f, 0x8d, 0xbc,
-	0xb6, 0x63, 0x0c, 0x49, 0x78, 0xe3, 0xb3, 0x08, 0xe4, 0xc4, 0x63, 0x61, 0xd7, 0x8c, 0x21, 0xb9,
-	0xc9, 0x98, 0xcf, 0x70, 0xd3, 0x92, 0x85, 0xd5, 0xaa, 0x77, 0x19, 0xa1, 0x3a, 0x12, 0x91, 0xb8,
-	0x22, 0x8e, 0x1e, 0x47, 0x27, 0x87, 0xfc, 0xa0, 0xf2, 0x12, 0x16, 0xd2, 0x40, 0x68, 0x19, 0xa6,
-	0x2f, 0x49, 0x57, 0xd4, 0x8f, 0xa2, 0xc9, 0x7f, 0xf2, 0x90, 0xeb, 0xf0, 0x1c, 0x93, 0xc5, 0x62,
-	0xac, 0x90, 0x13, 0x0c, 0x0f, 0xa7, 0x1e, 0x68, 0xe9, 0x52, 0x7d, 0x60, 0x33, 0xb7, 0xe3, 0xb2,
-	0xee, 0xf5, 0x4b, 0xb5, 0x02, 0xe1, 0xff, 0xb1, 0x54, 0x7f, 0x31, 0x9f, 0x94, 0xea, 0xac, 0xc6,
-	0xdf, 0x6a, 0xa9, 0xde, 0x82, 0x12, 0x96, 0xda, 0xf4, 0x8c, 0x00, 0xf1, 0x56, 0xcd, 0xe1, 0xb5,
-	0x3c, 0x21, 0x10, 0xb5, 0x7c, 0x66, 0x48, 0x2d, 0x4f, 0x2e, 0x26, 0x6a, 0x39, 0x4e, 0xad, 0xd0,
-	0x3e, 0xcc, 0xba, 0x5e, 0xd0, 0x66, 0xc2, 0x3a, 0xa5, 0xfd, 0x3b, 0x6a, 0x8f, 0xe2, 0x6e, 0xd3,
-	0xc7, 0x8e, 0x19, 0x91, 0x2a, 0xd2, 0x72, 0xee, 0xa6, 0x69, 0x59, 0x98, 0x2c, 0x2d, 0xcf, 0x60,
-	0x2d, 0xc6, 0xb3, 0x98, 0x6f, 0xd9, 0x4d, 0x9f, 0x12, 0x01, 0xe4, 0xb7, 0xa3, 0x42, 0x5e, 0xda,
-	0x5f, 0x1b, 0xc0, 0x3a, 0x96, 0x53, 0xa0, 0xb9, 0x1a, 0xf3, 0x9e, 0xf9, 0x47, 0x9c, 0xf3, 0x2c,
-	0x62, 0x44, 0x3f, 0x85, 0x55, 0x21, 0x64, 0x10, 0xb2, 0x38, 0x0a, 0xf2, 0x96, 0x60, 0xec, 0xc3,
-	0x3b, 0x85, 0x95, 0x06, 0xc1, 0x21, 0xab, 0x13, 0xcc, 0x12, 0x28, 0x18, 0x05, 0xb5, 0x9c, 0xf0,
-	0xc4, 0x38, 0xa9, 0x6e, 0x57, 0xca, 0x76, 0xbb, 0x97, 0xb0, 0x99, 0xf5, 0x84, 0xe5, 0x9f, 0x5b,
-	0xac, 0xe1, 0x52, 0x2b, 0x66, 0x58, 0x18, 0x69, 0xd8, 0x4a, 0xc6, 0x33, 0x4f, 0xcf, 0xcf, 0x1a,
-	0x2e, 0x3d, 0x90, 0xf8, 0xb5, 0xf4, 0x0d, 0x1c, 0xc2, 0xb0, 0xdb, 0xa4, 0xa2, 0xa2, 0x8f, 0x8a,
-	0x94, 0xde, 0x25, 0x8e, 0x23, 0xae, 0xc1, 0xe1, 0xa3, 0x7c, 0xbd, 0xe1, 0xe3, 0x7d, 0x58, 0x4a,
-	0x70, 0xa2, 0x8a, 0x21, 0x9a, 0x42, 0xd1, 0x2c, 0xc7, 0xdb, 0xc7, 0x62, 0x17, 0x7d, 0x04, 0x73,
-	0x0d, 0x82, 0x1d, 0x12, 0xca, 0x9a, 0xbf, 0xae, 0x94, 0xf4, 0x58, 0x90, 0x98, 0x92, 0xb4, 0xfa,
-	0x8f, 0x19, 0x58, 0x3d, 0x70, 0x1c, 0xd5, 0xa0, 0x9a, 0x29, 0x59, 0x5a, 0x5f, 0xc9, 0xfa, 0x9a,
-	0xca, 0xc0, 0x43, 0x28, 0xf6, 0x1a, 0xf4, 0xf4, 0x38, 0x0d, 0x7a, 0x9e, 0xc5, 0xfd, 0x78, 0x0b,
-	0x4a, 0x49, 0x8e, 0xc8, 0xb9, 0x6c, 0xda, 0x84, 0x78, 0xab, 0xe6, 0xf4, 0x27, 0x91, 0x0c, 0x7d,
-	0x19, 0xa6, 0xb3, 0x13, 0x24, 0x91, 0x18, 0xe3, 0xe2, 0x60, 0x7d, 0x08, 0x73, 0xd4, 0x6f, 0x87,
-	0x76, 0x54, 0x14, 0xca, 0xfd, 0x2d, 0x28, 0x35, 0xb3, 0x60, 0x7a, 0xf9, 0x5c, 0x50, 0x9a, 0x92,
-	0x43, 0x51, 0xdb, 0x0b, 0xaa, 0xda, 0x1e, 0xc0, 0x72, 0x80, 0x43, 0xe6, 0x8a, 0xda, 0x6e, 0xfb,
-	0xde, 0xb9, 0x7b, 0xa1, 0xcf, 0x8b, 0xee, 0x7c, 0x92, 0xdf, 0x9d, 0xd5, 0x5e, 0x35, 0x9e, 0xc5,
-	0x40, 0x47, 0x02, 0x27, 0x6a, 0xd0, 0x4b, 0x41, 0x76, 0xb7, 0x72, 0x08, 0xb7, 0x55, 0x84, 0x8a,
-	0x06, 0x7c, 0x3b, 0xdd, 0x80, 0x8b, 0xe9, 0xe6, 0xba, 0x06, 0x6f, 0x0f, 0xe8, 0x10, 0xf5, 0x98,
-	0xea, 0x7f, 0x67, 0x45, 0xd4, 0xa9, 0x7a, 0xee, 0xb7, 0x11, 0x75, 0x7c, 0x0e, 0x17, 0x0e, 0xb1,
-	0x7a, 0xa2, 0xa3, 0x0e, 0x54, 0x8e, 0xf6, 0x8f, 0x63, 0x05, 0x32, 0xf1, 0x39, 0x73, 0xa3, 0xf8,
-	0x9c, 0x9d, 0x2c, 0x3e, 0xe7, 0x6e, 0x1e, 0x9f, 0x85, 0x37, 0x10, 0x9f, 0xf3, 0xaa, 0xf8, 0xf4,
-	0x40, 0xc7, 0x29, 0x57, 0x1e, 0xbb, 0x34, 0xe0, 0x81, 0xc8, 0xa7, 0x70, 0xd9, 0x49, 0xf6, 0x87,
-	0xc4, 0x69, 0x0e, 0xa7, 0x99, 0x8b, 0xa9, 0xcc, 0x07, 0x18, 0x23, 0x1f, 0x14, 0xf1, 0xf6, 0x0d,
-	0xe6, 0xc3, 0x57, 0xd3, 0xa0, 0xe7, 0x5d, 0x16, 0xfd, 0x18, 0x96, 0x7a, 0x8d, 0x4d, 0xbc, 0x1d,
-	0xe4, 0xc8, 0xa9, 0xee, 0x17, 0x72, 0x4a, 0x16, 0x0f, 0x3c, 0xb3, 0x37, 0x9c, 0x88, 0xf5, 0xc0,
-	0xac, 0x31, 0x35, 0xd9, 0xac, 0x91, 0xea, 0xbe, 0xd3, 0x93, 0x76, 0xdf, 0x99, 0x37, 0xdf, 0x7d,
-	0x67, 0xdf, 0x4c, 0xf7, 0x9d, 0x7b, 0x63, 0xdd, 0xb7, 0xa0, 0xea, 0xbe, 0xb2, 0xda, 0xa9, 0x26,
-	0xea, 0xea, 0x57, 0x1a, 0xdc, 0x16, 0x4f, 0x8f, 0x58, 0x4e, 0x5c, 0xeb, 0x8e, 0xfa, 0xdf, 0x17,
-	0xdf, 0x55, 0xaa, 0xa7, 0xe2, 0x1d, 0xf3, 0x65, 0x71, 0x93, 0x7e, 0x3a, 0xde, 0xc3, 0xa3, 0xfa,
-	0x67, 0x0d, 0xde, 0xea, 0xd3, 0x50, 0xbe, 0x24, 0x7e, 0x04, 0x0b, 0xe2, 0x75, 0x6f, 0x85, 0x84,
-	0xb6, 0x9b, 0xf1, 0x1d, 0x87, 0x7b, 0xb2, 0x24, 0x38, 0x4c, 0xc1, 0x80, 0x6a, 0x50, 0x8e, 0x01,
-	0x7e, 0x45, 0x6c, 0x46, 0x9c, 0xa1, 0xaf, 0xbc, 0xe8, 0x75, 0x27, 0x29, 0xcd, 0xc5, 0x57, 0xe9,
-	0x65, 0xf5, 0xdf, 0x1a, 0x6c, 0x47, 0x8a, 0x39, 0x82, 0x8e, 0xdf, 0xf7, 0xc8, 0x6f, 0x05, 0x4d,
-	0xc2, 0x89, 0xa5, 0x29, 0x9f, 0xf6, 0xfb, 0xe3, 0x9e, 0x52, 0xd0, 0x28, 0x9c, 0x6f, 0xc0, 0x37,
-	0x6f, 0x43, 0x41, 0xf0, 0xca, 0x39, 0xa7, 0x68, 0xce, 0xf1, 0x65, 0xcd, 0xa9, 0xbe, 0x03, 0x77,
-	0x87, 0xa8, 0x27, 0x03, 0xf2, 0x9f, 0x1a, 0xdc, 0x39, 0xc2, 0x9e, 0x4d, 0x9a, 0x4f, 0xdb, 0x8c,
-	0x32, 0xec, 0x39, 0xae, 0x77, 0xc1, 0xdf, 0x84, 0x63, 0x35, 0xe1, 0xcc, 0x6b, 0x75, 0xaa, 0xef,
-	0xb5, 0xfa, 0x08, 0xca, 0xc9, 0xa5, 0x7a, 0xdf, 0xdc, 0xca, 0x39, 0x89, 0x17, 0xdf, 0x2c, 0x4a,
-	0x3c, 0x96, 0x5a, 0xdd, 0xa4, 0xd3, 0x56, 0xb7, 0x60, 0x23, 0xe7, 0x7a, 0xd2, 0x00, 0xbf, 0x81,
-	0xb7, 0x8f, 0x09, 0xb5, 0x43, 0xb7, 0x4e, 0x12, 0x76, 0x79, 0xf5, 0xd3, 0xfe, 0x18, 0xf8, 0x40,
-	0x29, 0x35, 0x87, 0x7d, 0x3c, 0xd7, 0x57, 0xbf, 0xd4, 0x40, 0x1f, 0x44, 0x90, 0x69, 0xf3, 0x09,
-	0x14, 0x22, 0x73, 0x52, 0x5d, 0x13, 0x4d, 0x6d, 0x2b, 0xf7, 0xab, 0x03, 0x09, 0x45, 0xa7, 0x8c,
-	0xe9, 0xd1, 0x13, 0x58, 0xee, 0x59, 0x9f, 0x32, 0xcc, 0xda, 0x54, 0xa6, 0xcc, 0x3b, 0x43, 0x6d,
-	0xf7, 0x5c, 0x90, 0x9a, 0x65, 0x96, 0x59, 0x57, 0x29, 0x6c, 0x08, 0x7f, 0xc8, 0xdd, 0xa4, 0x03,
-	0xd2, 0xd8, 0x58, 0xab, 0x30, 0x27, 0x8b, 0x62, 0x14, 0x24, 0x72, 0x95, 0x75, 0xde, 0xd4, 0x64,
-	0xce, 0xfb, 0xc3, 0x14, 0x6c, 0xe6, 0x49, 0x95, 0x16, 0x7a, 0x05, 0x1b, 0xbd, 0x6f, 0x01, 0xc9,
-	0x7d, 0x93, 0x9e, 0x1d, 0xdb, 0xcd, 0x18, 0x2a, 0x32, 0xc1, 0x7d, 0x42, 0x18, 0x76, 0x30, 0xc3,
-	0x66, 0x25, 0x3d, 0x70, 0x64, 0x45, 0x73, 0x91, 0xc9, 0x07, 0x4a, 0xa5, 0xc8, 0xa9, 0xeb, 0x89,
-	0x74, 0x52, 0xe3, 0x71, 0x56, 0x64, 0xf5, 0x1e, 0xac, 0x3f, 0x22, 0x89, 0x19, 0xe8, 0x61, 0x37,
-	0xea, 0x34, 0x23, 0x6c, 0x5f, 0xfd, 0x72, 0x06, 0xee, 0xa8, 0xf9, 0xa4, 0xf5, 0x7e, 0xa7, 0xc1,
-	0xaa, 0xe2, 0x2e, 0x2d, 0x1c, 0x48, 0xbb, 0x3d, 0xcd, 0x1f, 0xa2, 0x86, 0x01, 0x1b, 0xc7, 0x7d,
-	0x77, 0x79, 0x82, 0x83, 0x68, 0x9c, 0xba, 0xe5, 0x0c, 0x9e, 0x08, 0x35, 0x14, 0x5e, 0xe4, 0x6a,
-	0x4c, 0xdd, 0x48, 0x8d, 0x83, 0x3e, 0x2f, 0xf6, 0xd4, 0xc0, 0x83, 0x27, 0x95, 0x5f, 0xf3, 0x4c,
-	0x54, 0xeb, 0xad, 0x98, 0xee, 0x1e, 0x67, 0x3f, 0x37, 0x0e, 0x19, 0x6b, 0xf3, 0xd2, 0x3b, 0x35,
-	0x11, 0x72, 0xd9, 0x79, 0xca, 0x7e, 0xdd, 0xb2, 0xf7, 0xff, 0x02, 0x50, 0x7a, 0x22, 0x79, 0x0e,
-	0x9e, 0xd5, 0xd0, 0x6f, 0x35, 0xb8, 0xa5, 0xf8, 0xa0, 0x8b, 0x3e, 0x9e, 0xf0, 0xfb, 0xaf, 0x08,
-	0xce, 0xca, 0xbd, 0x6b, 0x7d, 0x35, 0x4e, 0x2b, 0x91, 0x36, 0xcc, 0x18, 0x4a, 0x28, 0x46, 0xfb,
-	0x31, 0x94, 0x50, 0x7e, 0x00, 0xed, 0xc0, 0x52, 0xdf, 0xbb, 0x15, 0x7d, 0x38, 0xe9, 0x33, 0xbb,
-	0xb2, 0x37, 0x01, 0x47, 0x46, 0x6e, 0xe6, 0xde, 0x1f, 0x4e, 0xfa, 0x9c, 0x19, 0x21, 0x57, 0x79,
-	0xdf, 0x00, 0x16, 0x33, 0xf3, 0x1b, 0x32, 0xf2, 0x31, 0x54, 0xa3, 0x68, 0x65, 0x77, 0x6c, 0x7a,
-	0x29, 0xf1, 0x4f, 0x1a, 0xac, 0xe5, 0x4e, 0x29, 0xe8, 0x61, 0x3e, 0xdc, 0xa8, 0xc9, 0xab, 0xf2,
-	0xe9, 0xb5, 0x78, 0xa5, 0x5a, 0x7f, 0xd4, 0xe0, 0x2d, 0xe5, 0xdc, 0x80, 0xee, 0xe7, 0xc3, 0x0e,
-	0x9b, 0xa3, 0x2a, 0x3f, 0x98, 0x98, 0x4f, 0xaa, 0xd2, 0x85, 0xe5, 0xfe, 0x24, 0x46, 0x7b, 0x93,
-	0x24, 0x7c, 0x24, 0xff, 0x1a, 0x35, 0x02, 0x7d, 0xa1, 0xc1, 0xaa, 0xba, 0xff, 0xa2, 0x21, 0xd7,
-	0x19, 0x3a, 0x27, 0x54, 0x1e, 0x4c, 0xce, 0x28, 0xb5, 0xf9, 0xbd, 0x06, 0xb7, 0x55, 0xd5, 0x1e,
-	0xdd, 0x9b, 0xb4, 0x3b, 0x44, 0x9a, 0xdc, 0xbf, 0x5e, 0x53, 0x39, 0x7c, 0xf4, 0xb7, 0xd7, 0x9b,
-	0xda, 0xdf, 0x5f, 0x6f, 0x6a, 0xff, 0x7a, 0xbd, 0xa9, 0xfd, 0xe2, 0x93, 0x0b, 0x97, 0x35, 0xda,
-	0x75, 0xc3, 0xf6, 0x5b, 0xbb, 0x99, 0x3f, 0x05, 0x18, 0x17, 0xc4, 0x8b, 0xfe, 0x45, 0x91, 0xfe,
-	0x23, 0xc7, 0xa7, 0xf1, 0xef, 0xce, 0x5e, 0x7d, 0x4e, 0x9c, 0x7e, 0xf4, 0xbf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xd6, 0x96, 0x18, 0xb5, 0xf6, 0x21, 0x00, 0x00,
+	0xf5, 0xc7, 0xea, 0x46, 0xf1, 0x50, 0xa2, 0xe4, 0xb5, 0x23, 0xaf, 0x28, 0x5b, 0x96, 0x99, 0x7f,
+	0x12, 0xfd, 0x8b, 0x74, 0x15, 0x31, 0xb1, 0x6b, 0x3b, 0x28, 0x0a, 0x59, 0x17, 0x9b, 0x45, 0x1d,
+	0x3b, 0x2b, 0xd5, 0x05, 0x8a, 0xc2, 0x8b, 0xe1, 0xee, 0x88, 0xdc, 0x8a, 0xdc, 0x5d, 0xef, 0xcc,
+	0x52, 0x61, 0x1f, 0xfa, 0x50, 0xb4, 0x45, 0x81, 0xbc, 0xf6, 0x1b, 0x34, 0x8f, 0xfd, 0x20, 0x7d,
+	0xec, 0x63, 0x81, 0xa0, 0x40, 0x61, 0xa0, 0xcf, 0x45, 0xfb, 0x09, 0x8a, 0xb9, 0xec, 0x92, 0x4b,
+	0xce, 0x52, 0xa4, 0xe4, 0x24, 0x7d, 0xe3, 0xcc, 0x9c, 0xdb, 0x9c, 0xdb, 0xef, 0xcc, 0x82, 0xf0,
+	0x7e, 0xdc, 0xc0, 0xd1, 0x8e, 0x83, 0x5c, 0xec, 0x3b, 0x78, 0xa7, 0x83, 0xa8, 0xd3, 0xf2, 0xfc,
+	0xe6, 0x4e, 0x77, 0x77, 0x87, 0xe0, 0xa8, 0xeb, 0x39, 0xd8, 0x0c, 0xa3, 0x80, 0x06, 0xba, 0xc1,
+	0xe8, 0x4c, 0x49, 0x67, 0x26, 0x74, 0x66, 0x77, 0xb7, 0xb2, 0xd9, 0x0c, 0x82, 0x66, 0x1b, 0xef,
+	0x70, 0xba, 0x46, 0x7c, 0xba, 0xe3, 0xc6, 0x11, 0xa2, 0x5e, 0xe0, 0x0b, 0xce, 0xca, 0x9d, 0xe1,
+	0x73, 0xea, 0x75, 0x30, 0xa1, 0xa8, 0x13, 0x4a, 0x82, 0x11, 0x01, 0xe7, 0x11, 0x0a, 0x43, 0x1c,
+	0x11, 0x79, 0xbe, 0x95, 0x31, 0x11, 0x85, 0x1e, 0xb3, 0xce, 0x09, 0x3a, 0x9d, 0xbe, 0x0a, 0x15,
+	0xc5, 0xeb, 0x18, 0x47, 0x3d, 0x49, 0x50, 0x55, 0x11, 0x50, 0x44, 0xce, 0xda, 0x1e, 0xa1, 0x92,
+	0x66, 0x5b, 0x45, 0x23, 0x9d, 0x60, 0x9f, 0x07, 0xd1, 0x19, 0x8e, 0x24, 0xe5, 0xf7, 0x2e, 0xa2,
+	0x3c, 0x6d, 0x07, 0xe7, 0x92, 0xf6, 0xae, 0x8a, 0xb6, 0xe5, 0x11, 0x1a, 0xa4, 0xc6, 0xfd, 0x5f,
+	0x86, 0x84, 0xb4, 0x50, 0x84, 0xdd, 0x51, 0xaa, 0xf7, 0x72, 0xa8, 0xb2, 0xb7, 0xa8, 0xfe, 0x5b,
+	0x83, 0xca, 0x8b, 0xa0, 0xdd, 0x3e, 0x0a, 0xa2, 0x03, 0xec, 0x78, 0xc4, 0x0b, 0xfc, 0x13, 0x44,
+	0xce, 0x2c, 0xfc, 0x3a, 0xc6, 0x84, 0xea, 0x75, 0x28, 0x44, 0xe2, 0xa7, 0xa1, 0x6d, 0x69, 0xdb,
+	0xa5, 0xda, 0x8e, 0x99, 0x09, 0x2c, 0x0a, 0x3d, 0xb3, 0xbb, 0x6b, 0xe6, 0x4b, 0xb0, 0x12, 0x7e,
+	0x7d, 0x03, 0x8a, 0x6e, 0xd0, 0x41, 0x9e, 0x6f, 0x7b, 0xae, 0x31, 0xb3, 0xa5, 0x6d, 0x17, 0xad,
+	0x45, 0xb1, 0x51, 0x77, 0xd9, 0x61, 0x18, 0xb4, 0xdb, 0x38, 0x62, 0x87, 0xb3, 0xe2, 0x50, 0x6c,
+	0xd4, 0x5d, 0xfd, 0x3d, 0x28, 0x9f, 0x06, 0xd1, 0x39, 0x8a, 0x5c, 0xec, 0xda, 0xa7, 0x51, 0xd0,
+	0x31, 0xe6, 0x38, 0xc5, 0x72, 0xba, 0x7b, 0x14, 0x05, 0x1d, 0xfd, 0x03, 0x58, 0xf1, 0x48, 0xd0,
+	0xe6, 0xb9, 0x64, 0x37, 0xa3, 0x20, 0x0e, 0x8d, 0x79, 0x4e, 0x57, 0x4e, 0xb7, 0x9f, 0xb0, 0xdd,
+	0xea, 0xbf, 0x8a, 0xb0, 0xa1, 0xb4, 0x98, 0x84, 0x81, 0x4f, 0xb0, 0x7e, 0x1b, 0x80, 0x79, 0xc9,
+	0xa6, 0xc1, 0x19, 0xf6, 0xf9, 0xbd, 0x97, 0xac, 0x22, 0xdb, 0x39, 0x61, 0x1b, 0xfa, 0x4f, 0x41,
+	0x4f, 0x82, 0x66, 0xe3, 0x2f, 0xb0, 0x13, 0x33, 0xc9, 0xfc, 0x46, 0xa5, 0xda, 0xfb, 0x4a, 0xf7,
+	0xfc, 0x4c, 0x92, 0x1f, 0x26, 0xd4, 0xd6, 0xb5, 0xf3, 0xe1, 0x2d, 0xfd, 0x08, 0x96, 0x53, 0xb1,
+	0xb4, 0x17, 0x62, 0xee, 0x86, 0x52, 0xed, 0xee, 0x58, 0x89, 0x27, 0xbd, 0x10, 0x5b, 0x4b, 0xe7,
+	0x03, 0x2b, 0xfd, 0x25, 0xac, 0x87, 0x11, 0xee, 0x7a, 0x41, 0x4c, 0x6c, 0x42, 0x51, 0x44, 0xb1,
+	0x6b, 0xe3, 0x2e, 0xf6, 0x29, 0x73, 0xed, 0x1c, 0x97, 0xb9, 0x61, 0x8a, 0x12, 0x32, 0x93, 0x12,
+	0x32, 0xeb, 0x3e, 0xbd, 0xff, 0xc9, 0x4b, 0xd4, 0x8e, 0xb1, 0xb5, 0x96, 0x70, 0x1f, 0x0b, 0xe6,
+	0x43, 0xc6, 0x5b, 0x77, 0xf5, 0x6d, 0x58, 0x1d, 0x11, 0xc7, 0xfc, 0x3b, 0x6b, 0x95, 0x49, 0x96,
+	0xd2, 0x80, 0x02, 0xa2, 0x14, 0x77, 0x42, 0x6a, 0x2c, 0x6c, 0x69, 0xdb, 0xf3, 0x56, 0xb2, 0xd4,
+	0xab, 0xb0, 0xec, 0xe3, 0x2f, 0x68, 0x5f, 0x40, 0x81, 0x0b, 0x28, 0xb1, 0xcd, 0x84, 0xfb, 0x43,
+	0xd0, 0x1b, 0xc8, 0x39, 0x6b, 0x07, 0x4d, 0xdb, 0x09, 0x62, 0x9f, 0xda, 0x2d, 0xcf, 0xa7, 0xc6,
+	0x22, 0x27, 0x5c, 0x95, 0x27, 0xfb, 0xec, 0xe0, 0xa9, 0xe7, 0x53, 0xfd, 0x01, 0x18, 0x84, 0x7a,
+	0xce, 0x59, 0xaf, 0x1f, 0x0a, 0x1b, 0xfb, 0xa8, 0xd1, 0xc6, 0xae, 0x51, 0xdc, 0xd2, 0xb6, 0x17,
+	0xad, 0x35, 0x71, 0x9e, 0x3a, 0xfa, 0x50, 0x9c, 0xea, 0x0f, 0x60, 0x9e, 0x97, 0xbc, 0x01, 0xdc,
+	0x27, 0xd5, 0xb1, 0x7e, 0xfe, 0x9c, 0x51, 0x5a, 0x82, 0x41, 0xb7, 0x60, 0xd9, 0x95, 0x79, 0x63,
+	0x7b, 0xfe, 0x69, 0x60, 0x94, 0xb8, 0x84, 0xef, 0x67, 0x25, 0x88, 0x92, 0x63, 0x42, 0x4e, 0x22,
+	0xe4, 0x13, 0x0f, 0xfb, 0x34, 0xc9, 0xb6, 0xba, 0x7f, 0x1a, 0x58, 0x4b, 0xee, 0xc0, 0x4a, 0x7f,
+	0x05, 0xb7, 0x46, 0x93, 0xca, 0xe6, 0x69, 0xc8, 0xaa, 0xd5, 0x58, 0xe2, 0x2a, 0x6e, 0x2b, 0x8d,
+	0x64, 0xc9, 0xfb, 0x13, 0x8f, 0x50, 0x6b, 0x7d, 0x24, 0xab, 0x92, 0x23, 0xdd, 0x84, 0xeb, 0xc2,
+	0xe9, 0xac, 0x47, 0x60, 0xbb, 0x8b, 0x23, 0xa6, 0xda, 0x58, 0xe6, 0xf1, 0xb9, 0xc6, 0x8f, 0x8e,
+	0xd9, 0xc9, 0x4b, 0x71, 0xa0, 0xdf, 0x85, 0xa5, 0x46, 0x84, 0x7c, 0xa7, 0x25, 0xab, 0xa0, 0xcc,
+	0xab, 0xa0, 0x24, 0xf6, 0x44, 0x1d, 0xec, 0x41, 0x99, 0x38, 0x2d, 0xec, 0xc6, 0x6d, 0xec, 0xda,
+	0xac, 0x49, 0x1b, 0x2b, 0xdc, 0xc8, 0xca, 0x48, 0x76, 0x9d, 0x24, 0x1d, 0xdc, 0x5a, 0x4e, 0x39,
+	0xd8, 0x9e, 0xfe, 0x43, 0x58, 0x4a, 0x72, 0x8a, 0x0b, 0x58, 0xbd, 0x50, 0x40, 0x49, 0xd2, 0x73,
+	0xf6, 0x5f, 0x40, 0x81, 0x45, 0xc4, 0xc3, 0xc4, 0xb8, 0xb6, 0x35, 0xbb, 0x5d, 0xaa, 0x3d, 0x36,
+	0xf3, 0x60, 0xc7, 0x1c, 0x53, 0xf0, 0xe6, 0xe7, 0x42, 0xc8, 0xa1, 0x4f, 0xa3, 0x9e, 0x95, 0x88,
+	0x64, 0x2e, 0xa3, 0x01, 0x45, 0x6d, 0x5b, 0x36, 0x56, 0xbb, 0xd1, 0xa3, 0x98, 0x18, 0x3a, 0xcf,
+	0xc4, 0x6b, 0xfc, 0xe8, 0xa9, 0x38, 0x79, 0xcc, 0x0e, 0xf4, 0x87, 0xb0, 0xee, 0x04, 0x3e, 0xf5,
+	0xfc, 0x18, 0xdb, 0x88, 0xd8, 0x3e, 0x3e, 0xb7, 0x49, 0xdc, 0x6c, 0x62, 0x42, 0xb1, 0x6b, 0x5c,
+	0x17, 0xb9, 0x98, 0x10, 0xec, 0x91, 0xcf, 0xf0, 0xf9, 0x71, 0x72, 0x5a, 0x79, 0x05, 0x4b, 0x83,
+	0x36, 0xe8, 0xab, 0x30, 0x7b, 0x86, 0x7b, 0xbc, 0xf5, 0x14, 0x2d, 0xf6, 0x93, 0x65, 0x6b, 0x97,
+	0x95, 0xa7, 0x31, 0x33, 0x79, 0xb6, 0x72, 0x86, 0x47, 0x33, 0x0f, 0xb4, 0xc1, 0x2e, 0xbf, 0xe7,
+	0x50, 0xaf, 0xeb, 0xd1, 0xde, 0xe5, 0xbb, 0xbc, 0x42, 0xc2, 0xff, 0x62, 0x97, 0xff, 0x72, 0x11,
+	0x36, 0x94, 0x16, 0x7f, 0xa7, 0x5d, 0xfe, 0x0e, 0x94, 0x90, 0xb4, 0xa6, 0xef, 0x04, 0x48, 0xb6,
+	0xea, 0x2e, 0x83, 0x81, 0x94, 0x80, 0xc3, 0xc0, 0xdc, 0x18, 0x18, 0x48, 0x2f, 0xc6, 0x61, 0x00,
+	0x0d, 0xac, 0xf4, 0x1a, 0xcc, 0x7b, 0x7e, 0x18, 0x53, 0xee, 0x9d, 0x52, 0xed, 0x96, 0x3a, 0xa2,
+	0xa8, 0xd7, 0x0e, 0x90, 0x6b, 0x09, 0x52, 0x45, 0x45, 0x2f, 0x5c, 0xb5, 0xa2, 0x0b, 0xd3, 0x55,
+	0xf4, 0x09, 0xac, 0x27, 0xf2, 0x6c, 0x1a, 0xd8, 0x4e, 0x3b, 0x20, 0x98, 0x0b, 0x0a, 0x62, 0x81,
+	0x01, 0xa5, 0xda, 0xfa, 0x88, 0xac, 0x03, 0x39, 0x40, 0x5a, 0x6b, 0x09, 0xef, 0x49, 0xb0, 0xcf,
+	0x38, 0x4f, 0x04, 0xa3, 0xfe, 0x19, 0xac, 0x71, 0x25, 0xa3, 0x22, 0x8b, 0x17, 0x89, 0xbc, 0xce,
+	0x19, 0x87, 0xe4, 0x1d, 0xc1, 0xb5, 0x16, 0x46, 0x11, 0x6d, 0x60, 0x44, 0x53, 0x51, 0x70, 0x91,
+	0xa8, 0xd5, 0x94, 0x27, 0x91, 0x33, 0x00, 0x94, 0xa5, 0x2c, 0x50, 0xbe, 0x82, 0xcd, 0x6c, 0x24,
+	0xec, 0xe0, 0xd4, 0xa6, 0x2d, 0x8f, 0xd8, 0x09, 0xc3, 0xd2, 0x85, 0x8e, 0xad, 0x64, 0x22, 0xf3,
+	0xfc, 0xf4, 0xa4, 0xe5, 0x91, 0x3d, 0x29, 0xbf, 0x3e, 0x78, 0x03, 0x17, 0x53, 0xe4, 0xb5, 0x89,
+	0xb1, 0x3c, 0x41, 0xa6, 0xf4, 0x2f, 0x71, 0x20, 0xb8, 0x46, 0xe7, 0x96, 0xf2, 0xe5, 0xe6, 0x96,
+	0x0f, 0x60, 0x25, 0x95, 0x23, 0x3a, 0x06, 0xc7, 0x93, 0xa2, 0x55, 0x4e, 0xb6, 0x0f, 0xf8, 0xae,
+	0xfe, 0x31, 0x2c, 0xb4, 0x30, 0x72, 0x71, 0x24, 0xe1, 0x62, 0x43, 0xa9, 0xe9, 0x29, 0x27, 0xb1,
+	0x24, 0x69, 0xf5, 0x6f, 0x73, 0xb0, 0xb6, 0xe7, 0xba, 0xaa, 0x19, 0x37, 0xd3, 0xb2, 0xb4, 0xa1,
+	0x96, 0xf5, 0x0d, 0xb5, 0x81, 0x47, 0x50, 0xec, 0x63, 0xfb, 0xec, 0x24, 0xd8, 0xbe, 0x48, 0xe5,
+	0x2f, 0xd6, 0x42, 0xd2, 0x1a, 0x91, 0x23, 0xdd, 0xac, 0x05, 0xc9, 0x56, 0xdd, 0x1d, 0x2e, 0x22,
+	0x99, 0xfa, 0x32, 0x4d, 0xe7, 0xa7, 0x28, 0x22, 0x3e, 0x01, 0x26, 0xc9, 0xfa, 0x08, 0x16, 0x48,
+	0x10, 0x47, 0x8e, 0x68, 0x0a, 0xe5, 0x5a, 0x35, 0x77, 0xdc, 0x41, 0xe4, 0xec, 0x98, 0x53, 0x5a,
+	0x92, 0x43, 0xd1, 0xdb, 0x0b, 0xaa, 0xde, 0x1e, 0xc2, 0x6a, 0x88, 0x22, 0xea, 0xf1, 0xde, 0xee,
+	0x04, 0xfe, 0xa9, 0xd7, 0x34, 0x16, 0x39, 0xb0, 0x1f, 0xe6, 0x03, 0xbb, 0x3a, 0xaa, 0xe6, 0x8b,
+	0x44, 0xd0, 0x3e, 0x97, 0x23, 0xb0, 0x7d, 0x25, 0xcc, 0xee, 0x56, 0x1e, 0xc3, 0x0d, 0x15, 0xa1,
+	0x02, 0x80, 0x6f, 0x0c, 0x02, 0x70, 0x71, 0x10, 0x5c, 0xd7, 0xe1, 0xe6, 0x88, 0x0d, 0x02, 0x63,
+	0xaa, 0xff, 0x99, 0xe7, 0x59, 0xa7, 0xc2, 0xdc, 0xef, 0x22, 0xeb, 0xd8, 0x08, 0xcf, 0x03, 0x62,
+	0xf7, 0x55, 0x0b, 0x04, 0x2a, 0x8b, 0xfd, 0x83, 0xc4, 0x80, 0x4c, 0x7e, 0xce, 0x5d, 0x29, 0x3f,
+	0xe7, 0xa7, 0xcb, 0xcf, 0x85, 0xab, 0xe7, 0x67, 0xe1, 0x2d, 0xe4, 0xe7, 0xa2, 0x2a, 0x3f, 0x7d,
+	0x30, 0xd0, 0x40, 0x28, 0x0f, 0x3c, 0x12, 0xb2, 0x44, 0x64, 0x03, 0xbc, 0x44, 0x92, 0xda, 0x98,
+	0x3c, 0xcd, 0xe1, 0xb4, 0x72, 0x65, 0x2a, 0xeb, 0x01, 0x26, 0xa8, 0x07, 0x45, 0xbe, 0x7d, 0x8b,
+	0xf5, 0xf0, 0xf5, 0x2c, 0x18, 0x79, 0x97, 0xd5, 0x7f, 0x0c, 0x2b, 0x7d, 0x60, 0xe3, 0xcf, 0x0e,
+	0x43, 0x1b, 0x83, 0x17, 0x72, 0xc0, 0xe6, 0x6f, 0x43, 0xab, 0x3f, 0x9c, 0xf0, 0xf5, 0xc8, 0xac,
+	0x31, 0x33, 0xdd, 0xac, 0x31, 0x80, 0xbe, 0xb3, 0xd3, 0xa2, 0xef, 0xdc, 0xdb, 0x47, 0xdf, 0xf9,
+	0xb7, 0x83, 0xbe, 0x0b, 0x6f, 0x0d, 0x7d, 0x0b, 0x2a, 0xf4, 0x95, 0xdd, 0x4e, 0x35, 0x51, 0x57,
+	0xbf, 0xd6, 0xe0, 0x06, 0x7f, 0x7a, 0x24, 0x7a, 0x92, 0x5e, 0xb7, 0x3f, 0xfc, 0xbe, 0xf8, 0x7f,
+	0xa5, 0x79, 0x2a, 0xde, 0x09, 0x5f, 0x16, 0x57, 0xc1, 0xd3, 0xc9, 0x1e, 0x1e, 0xd5, 0x3f, 0x69,
+	0xf0, 0xce, 0x90, 0x85, 0xf2, 0x25, 0xf1, 0x23, 0x58, 0xe2, 0x1f, 0x06, 0xec, 0x08, 0x93, 0xb8,
+	0x9d, 0xdc, 0x71, 0x7c, 0x24, 0x4b, 0x9c, 0xc3, 0xe2, 0x0c, 0x7a, 0x1d, 0xca, 0x89, 0x80, 0x5f,
+	0x62, 0x87, 0x62, 0x71, 0xbf, 0xbc, 0x57, 0x9e, 0x78, 0xdd, 0x49, 0x4a, 0x6b, 0xf9, 0xf5, 0xe0,
+	0xb2, 0xfa, 0x4f, 0x0d, 0xb6, 0x84, 0x61, 0x2e, 0xa7, 0x63, 0xf7, 0xdd, 0x0f, 0x3a, 0x61, 0x1b,
+	0x33, 0x62, 0xe9, 0xca, 0xe7, 0xc3, 0xf1, 0xb8, 0xa7, 0x54, 0x74, 0x91, 0x9c, 0x6f, 0x21, 0x36,
+	0x37, 0xa1, 0xc0, 0x79, 0xe5, 0x9c, 0x53, 0xb4, 0x16, 0xd8, 0xb2, 0xee, 0x56, 0xdf, 0x85, 0xbb,
+	0x63, 0xcc, 0x93, 0x09, 0xf9, 0x77, 0x0d, 0x6e, 0xed, 0x23, 0xdf, 0xc1, 0xed, 0xe7, 0x31, 0x25,
+	0x14, 0xf9, 0xae, 0xe7, 0x37, 0xd9, 0x9b, 0x70, 0x22, 0x10, 0xce, 0xbc, 0x56, 0x67, 0x86, 0x5e,
+	0xab, 0x4f, 0xa0, 0x9c, 0x5e, 0xaa, 0xff, 0xb9, 0xae, 0x9c, 0x53, 0x78, 0xc9, 0xcd, 0x44, 0xe1,
+	0xd1, 0x81, 0xd5, 0x55, 0x90, 0xb6, 0x7a, 0x07, 0x6e, 0xe7, 0x5c, 0x4f, 0x3a, 0xe0, 0xd7, 0x70,
+	0xf3, 0x00, 0x13, 0x27, 0xf2, 0x1a, 0x38, 0x65, 0x97, 0x57, 0x3f, 0x1a, 0xce, 0x81, 0x0f, 0x95,
+	0x5a, 0x73, 0xd8, 0x27, 0x0b, 0x7d, 0xf5, 0x2b, 0x0d, 0x8c, 0x51, 0x09, 0xb2, 0x6c, 0x1e, 0x42,
+	0x41, 0xb8, 0x93, 0x18, 0x1a, 0x07, 0xb5, 0x3b, 0xb9, 0x5f, 0x1d, 0x70, 0xc4, 0x91, 0x32, 0xa1,
+	0xd7, 0x9f, 0xc1, 0x6a, 0xdf, 0xfb, 0x84, 0x22, 0x1a, 0x13, 0x59, 0x32, 0xef, 0x8e, 0xf5, 0xdd,
+	0x31, 0x27, 0xb5, 0xca, 0x34, 0xb3, 0xae, 0x12, 0xb8, 0xcd, 0xe3, 0x21, 0x77, 0x53, 0x04, 0x24,
+	0x89, 0xb3, 0xd6, 0x60, 0x41, 0x36, 0x45, 0x91, 0x24, 0x72, 0x95, 0x0d, 0xde, 0xcc, 0x74, 0xc1,
+	0xfb, 0xfd, 0x0c, 0x6c, 0xe6, 0x69, 0x95, 0x1e, 0x7a, 0x0d, 0xb7, 0xfb, 0xdf, 0x02, 0xd2, 0xfb,
+	0xa6, 0x98, 0x9d, 0xf8, 0xcd, 0x1c, 0xab, 0x32, 0x95, 0xfb, 0x0c, 0x53, 0xe4, 0x22, 0x8a, 0xac,
+	0xca, 0xe0, 0xc0, 0x91, 0x55, 0xcd, 0x54, 0xa6, 0xdf, 0x36, 0x95, 0x2a, 0x67, 0x2e, 0xa7, 0xd2,
+	0x1d, 0x18, 0x8f, 0xb3, 0x2a, 0xab, 0xf7, 0x60, 0xe3, 0x09, 0x4e, 0xdd, 0x40, 0x1e, 0xf7, 0x04,
+	0xd2, 0x5c, 0xe0, 0xfb, 0xea, 0x57, 0x73, 0x70, 0x4b, 0xcd, 0x27, 0xbd, 0xf7, 0x5b, 0x0d, 0xd6,
+	0x14, 0x77, 0xe9, 0xa0, 0x50, 0xfa, 0xed, 0x79, 0xfe, 0x10, 0x35, 0x4e, 0xb0, 0x79, 0x30, 0x74,
+	0x97, 0x67, 0x28, 0x14, 0xe3, 0xd4, 0x75, 0x77, 0xf4, 0x84, 0x9b, 0xa1, 0x88, 0x22, 0x33, 0x63,
+	0xe6, 0x4a, 0x66, 0xec, 0x0d, 0x45, 0xb1, 0x6f, 0x06, 0x1a, 0x3d, 0xa9, 0xfc, 0x8a, 0x55, 0xa2,
+	0xda, 0x6e, 0xc5, 0x74, 0xf7, 0x34, 0xfb, 0xb9, 0x71, 0xcc, 0x58, 0x9b, 0x57, 0xde, 0x03, 0x13,
+	0x21, 0xd3, 0x9d, 0x67, 0xec, 0x37, 0xad, 0xbb, 0xf6, 0x67, 0x80, 0xd2, 0x33, 0xc9, 0xb3, 0xf7,
+	0xa2, 0xae, 0xff, 0x46, 0x83, 0xeb, 0x8a, 0x6f, 0xc1, 0xfa, 0x27, 0x53, 0x7e, 0x3a, 0xe6, 0xc9,
+	0x59, 0xb9, 0x77, 0xa9, 0x0f, 0xce, 0x83, 0x46, 0x0c, 0x3a, 0x66, 0x02, 0x23, 0x14, 0xa3, 0x7d,
+	0xe5, 0xde, 0x94, 0x5c, 0xd2, 0x88, 0x2e, 0xac, 0x0c, 0xbd, 0x5b, 0xf5, 0x8f, 0xa6, 0x7d, 0x66,
+	0x57, 0x76, 0xa7, 0xe0, 0xc8, 0xe8, 0xcd, 0xdc, 0xfb, 0xa3, 0x69, 0x9f, 0x33, 0x95, 0xdd, 0x29,
+	0x38, 0xa4, 0xde, 0x10, 0x96, 0x33, 0xf3, 0x9b, 0x6e, 0xe6, 0xcb, 0x50, 0x8d, 0xa2, 0x95, 0x9d,
+	0x89, 0xe9, 0xa5, 0xc6, 0x3f, 0x6a, 0xb0, 0x9e, 0x3b, 0xa5, 0xe8, 0x8f, 0xf2, 0xc5, 0x5d, 0x34,
+	0x79, 0x55, 0x3e, 0xbd, 0x14, 0xaf, 0x34, 0xeb, 0x0f, 0x1a, 0xbc, 0xa3, 0x9c, 0x1b, 0xf4, 0xfb,
+	0xf9, 0x62, 0xc7, 0xcd, 0x51, 0x95, 0x1f, 0x4c, 0xcd, 0x27, 0x4d, 0xe9, 0xc1, 0xea, 0x70, 0x11,
+	0xeb, 0xbb, 0xd3, 0x14, 0xbc, 0xd0, 0x7f, 0x89, 0x1e, 0xa1, 0x7f, 0xa9, 0xc1, 0x9a, 0x1a, 0x7f,
+	0xf5, 0x31, 0xd7, 0x19, 0x3b, 0x27, 0x54, 0x1e, 0x4c, 0xcf, 0x28, 0xad, 0xf9, 0x9d, 0x06, 0x37,
+	0x54, 0xdd, 0x5e, 0xbf, 0x37, 0x2d, 0x3a, 0x08, 0x4b, 0xee, 0x5f, 0x0e, 0x54, 0x1e, 0x3f, 0xf9,
+	0xcb, 0x9b, 0x4d, 0xed, 0xaf, 0x6f, 0x36, 0xb5, 0x7f, 0xbc, 0xd9, 0xd4, 0x7e, 0xfe, 0xb0, 0xe9,
+	0xd1, 0x56, 0xdc, 0x30, 0x9d, 0xa0, 0xb3, 0x93, 0xf9, 0x3f, 0x81, 0xd9, 0xc4, 0xbe, 0xf8, 0x03,
+	0xc6, 0xe0, 0x7f, 0x40, 0x3e, 0x4d, 0x7e, 0x77, 0x77, 0x1b, 0x0b, 0xfc, 0xf4, 0xe3, 0xff, 0x0e,
+	0x00, 0x54, 0x5f, 0xa5, 0x80, 0x31, 0x22, 0x00, 0x00,
 }
 
 func (m *PollForDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
@@ -1880,6 +1890,18 @@ func (m *PollForDecisionTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ContinueAsNewSuggested {
+		i--
+		if m.ContinueAsNewSuggested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
 	if m.TotalHistoryBytes != 0 {
 		i = encodeVarintService(dAtA, i, uint64(m.TotalHistoryBytes))
 		i--
@@ -3444,6 +3466,9 @@ func (m *PollForDecisionTaskResponse) Size() (n int) {
 	if m.TotalHistoryBytes != 0 {
 		n += 2 + sovService(uint64(m.TotalHistoryBytes))
 	}
+	if m.ContinueAsNewSuggested {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4856,6 +4881,26 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueAsNewSuggested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ContinueAsNewSuggested = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
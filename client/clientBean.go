@@ -45,17 +45,19 @@ type (
 		GetFrontendClient() frontend.Client
 		GetRemoteAdminClient(cluster string) admin.Client
 		SetRemoteAdminClient(cluster string, client admin.Client)
+		GetRemoteAdminStreamClient(cluster string) admin.StreamClient
 		GetRemoteFrontendClient(cluster string) frontend.Client
 	}
 
 	clientBeanImpl struct {
 		sync.Mutex
-		historyClient         history.Client
-		matchingClient        atomic.Value
-		frontendClient        frontend.Client
-		remoteAdminClients    map[string]admin.Client
-		remoteFrontendClients map[string]frontend.Client
-		factory               Factory
+		historyClient            history.Client
+		matchingClient           atomic.Value
+		frontendClient           frontend.Client
+		remoteAdminClients       map[string]admin.Client
+		remoteAdminStreamClients map[string]admin.StreamClient
+		remoteFrontendClients    map[string]frontend.Client
+		factory                  Factory
 	}
 )
 
@@ -68,6 +70,7 @@ func NewClientBean(factory Factory, dispatcher *yarpc.Dispatcher, clusterMetadat
 	}
 
 	remoteAdminClients := map[string]admin.Client{}
+	remoteAdminStreamClients := map[string]admin.StreamClient{}
 	remoteFrontendClients := map[string]frontend.Client{}
 	for clusterName := range clusterMetadata.GetEnabledClusterInfo() {
 		clientConfig := dispatcher.ClientConfig(clusterName)
@@ -91,15 +94,19 @@ func NewClientBean(factory Factory, dispatcher *yarpc.Dispatcher, clusterMetadat
 		}
 
 		remoteAdminClients[clusterName] = adminClient
+		if streamClient := factory.NewAdminStreamClientWithConfig(clientConfig); streamClient != nil {
+			remoteAdminStreamClients[clusterName] = streamClient
+		}
 		remoteFrontendClients[clusterName] = frontendClient
 	}
 
 	return &clientBeanImpl{
-		factory:               factory,
-		historyClient:         historyClient,
-		frontendClient:        remoteFrontendClients[clusterMetadata.GetCurrentClusterName()],
-		remoteAdminClients:    remoteAdminClients,
-		remoteFrontendClients: remoteFrontendClients,
+		factory:                  factory,
+		historyClient:            historyClient,
+		frontendClient:           remoteFrontendClients[clusterMetadata.GetCurrentClusterName()],
+		remoteAdminClients:       remoteAdminClients,
+		remoteAdminStreamClients: remoteAdminStreamClients,
+		remoteFrontendClients:    remoteFrontendClients,
 	}, nil
 }
 
@@ -138,6 +145,11 @@ func (h *clientBeanImpl) SetRemoteAdminClient(
 	h.remoteAdminClients[cluster] = client
 }
 
+// GetRemoteAdminStreamClient returns nil when the cluster is not connected over gRPC
+func (h *clientBeanImpl) GetRemoteAdminStreamClient(cluster string) admin.StreamClient {
+	return h.remoteAdminStreamClients[cluster]
+}
+
 func (h *clientBeanImpl) GetRemoteFrontendClient(cluster string) frontend.Client {
 	client, ok := h.remoteFrontendClients[cluster]
 	if !ok {
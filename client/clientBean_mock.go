@@ -117,6 +117,20 @@ func (mr *MockBeanMockRecorder) GetRemoteAdminClient(cluster interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteAdminClient", reflect.TypeOf((*MockBean)(nil).GetRemoteAdminClient), cluster)
 }
 
+// GetRemoteAdminStreamClient mocks base method.
+func (m *MockBean) GetRemoteAdminStreamClient(cluster string) admin.StreamClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemoteAdminStreamClient", cluster)
+	ret0, _ := ret[0].(admin.StreamClient)
+	return ret0
+}
+
+// GetRemoteAdminStreamClient indicates an expected call of GetRemoteAdminStreamClient.
+func (mr *MockBeanMockRecorder) GetRemoteAdminStreamClient(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteAdminStreamClient", reflect.TypeOf((*MockBean)(nil).GetRemoteAdminStreamClient), cluster)
+}
+
 // GetRemoteFrontendClient mocks base method.
 func (m *MockBean) GetRemoteFrontendClient(cluster string) frontend.Client {
 	m.ctrl.T.Helper()
@@ -61,6 +61,7 @@ type (
 		NewMatchingClientWithTimeout(domainIDToName DomainIDToNameFunc, timeout time.Duration, longPollTimeout time.Duration) (matching.Client, error)
 
 		NewAdminClientWithTimeoutAndConfig(config transport.ClientConfig, timeout time.Duration, largeTimeout time.Duration) (admin.Client, error)
+		NewAdminStreamClientWithConfig(config transport.ClientConfig) admin.StreamClient
 		NewFrontendClientWithTimeoutAndConfig(config transport.ClientConfig, timeout time.Duration, longPollTimeout time.Duration) (frontend.Client, error)
 	}
 
@@ -189,6 +190,16 @@ func (cf *rpcClientFactory) NewAdminClientWithTimeoutAndConfig(
 	return client, nil
 }
 
+// NewAdminStreamClientWithConfig returns nil when the outbound is not gRPC, as other transports do not support streaming
+func (cf *rpcClientFactory) NewAdminStreamClientWithConfig(
+	config transport.ClientConfig,
+) admin.StreamClient {
+	if !rpc.IsGRPCOutbound(config) {
+		return nil
+	}
+	return grpc.NewAdminStreamClient(adminv1.NewAdminReplicationStreamAPIYARPCClient(config))
+}
+
 func (cf *rpcClientFactory) NewFrontendClientWithTimeoutAndConfig(
 	config transport.ClientConfig,
 	timeout time.Duration,
@@ -38,11 +38,16 @@ import (
 
 // Client is the interface exposed by frontend service client
 type Client interface {
+	BackfillSchedule(context.Context, *types.BackfillScheduleRequest, ...yarpc.CallOption) error
 	CountWorkflowExecutions(context.Context, *types.CountWorkflowExecutionsRequest, ...yarpc.CallOption) (*types.CountWorkflowExecutionsResponse, error)
+	CreateSchedule(context.Context, *types.CreateScheduleRequest, ...yarpc.CallOption) error
+	DeleteSchedule(context.Context, *types.DeleteScheduleRequest, ...yarpc.CallOption) error
 	DeprecateDomain(context.Context, *types.DeprecateDomainRequest, ...yarpc.CallOption) error
 	DescribeDomain(context.Context, *types.DescribeDomainRequest, ...yarpc.CallOption) (*types.DescribeDomainResponse, error)
+	DescribeSchedule(context.Context, *types.DescribeScheduleRequest, ...yarpc.CallOption) (*types.DescribeScheduleResponse, error)
 	DescribeTaskList(context.Context, *types.DescribeTaskListRequest, ...yarpc.CallOption) (*types.DescribeTaskListResponse, error)
 	DescribeWorkflowExecution(context.Context, *types.DescribeWorkflowExecutionRequest, ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error)
+	ExecuteWorkflowExecution(context.Context, *types.ExecuteWorkflowExecutionRequest, ...yarpc.CallOption) (*types.ExecuteWorkflowExecutionResponse, error)
 	GetClusterInfo(context.Context, ...yarpc.CallOption) (*types.ClusterInfo, error)
 	GetSearchAttributes(context.Context, ...yarpc.CallOption) (*types.GetSearchAttributesResponse, error)
 	GetWorkflowExecutionHistory(context.Context, *types.GetWorkflowExecutionHistoryRequest, ...yarpc.CallOption) (*types.GetWorkflowExecutionHistoryResponse, error)
@@ -50,10 +55,14 @@ type Client interface {
 	ListClosedWorkflowExecutions(context.Context, *types.ListClosedWorkflowExecutionsRequest, ...yarpc.CallOption) (*types.ListClosedWorkflowExecutionsResponse, error)
 	ListDomains(context.Context, *types.ListDomainsRequest, ...yarpc.CallOption) (*types.ListDomainsResponse, error)
 	ListOpenWorkflowExecutions(context.Context, *types.ListOpenWorkflowExecutionsRequest, ...yarpc.CallOption) (*types.ListOpenWorkflowExecutionsResponse, error)
+	ListSchedules(context.Context, *types.ListSchedulesRequest, ...yarpc.CallOption) (*types.ListSchedulesResponse, error)
 	ListTaskListPartitions(context.Context, *types.ListTaskListPartitionsRequest, ...yarpc.CallOption) (*types.ListTaskListPartitionsResponse, error)
 	GetTaskListsByDomain(context.Context, *types.GetTaskListsByDomainRequest, ...yarpc.CallOption) (*types.GetTaskListsByDomainResponse, error)
 	RefreshWorkflowTasks(context.Context, *types.RefreshWorkflowTasksRequest, ...yarpc.CallOption) error
 	ListWorkflowExecutions(context.Context, *types.ListWorkflowExecutionsRequest, ...yarpc.CallOption) (*types.ListWorkflowExecutionsResponse, error)
+	PauseActivity(context.Context, *types.PauseActivityRequest, ...yarpc.CallOption) error
+	PauseSchedule(context.Context, *types.PauseScheduleRequest, ...yarpc.CallOption) error
+	PauseWorkflowExecution(context.Context, *types.PauseWorkflowExecutionRequest, ...yarpc.CallOption) error
 	PollForActivityTask(context.Context, *types.PollForActivityTaskRequest, ...yarpc.CallOption) (*types.PollForActivityTaskResponse, error)
 	PollForDecisionTask(context.Context, *types.PollForDecisionTaskRequest, ...yarpc.CallOption) (*types.PollForDecisionTaskResponse, error)
 	QueryWorkflow(context.Context, *types.QueryWorkflowRequest, ...yarpc.CallOption) (*types.QueryWorkflowResponse, error)
@@ -61,6 +70,7 @@ type Client interface {
 	RecordActivityTaskHeartbeatByID(context.Context, *types.RecordActivityTaskHeartbeatByIDRequest, ...yarpc.CallOption) (*types.RecordActivityTaskHeartbeatResponse, error)
 	RegisterDomain(context.Context, *types.RegisterDomainRequest, ...yarpc.CallOption) error
 	RequestCancelWorkflowExecution(context.Context, *types.RequestCancelWorkflowExecutionRequest, ...yarpc.CallOption) error
+	ResetActivity(context.Context, *types.ResetActivityRequest, ...yarpc.CallOption) error
 	ResetStickyTaskList(context.Context, *types.ResetStickyTaskListRequest, ...yarpc.CallOption) (*types.ResetStickyTaskListResponse, error)
 	ResetWorkflowExecution(context.Context, *types.ResetWorkflowExecutionRequest, ...yarpc.CallOption) (*types.ResetWorkflowExecutionResponse, error)
 	RespondActivityTaskCanceled(context.Context, *types.RespondActivityTaskCanceledRequest, ...yarpc.CallOption) error
@@ -80,5 +90,11 @@ type Client interface {
 	StartWorkflowExecution(context.Context, *types.StartWorkflowExecutionRequest, ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error)
 	StartWorkflowExecutionAsync(context.Context, *types.StartWorkflowExecutionAsyncRequest, ...yarpc.CallOption) (*types.StartWorkflowExecutionAsyncResponse, error)
 	TerminateWorkflowExecution(context.Context, *types.TerminateWorkflowExecutionRequest, ...yarpc.CallOption) error
+	TriggerSchedule(context.Context, *types.TriggerScheduleRequest, ...yarpc.CallOption) error
+	UnpauseActivity(context.Context, *types.UnpauseActivityRequest, ...yarpc.CallOption) error
+	UnpauseSchedule(context.Context, *types.UnpauseScheduleRequest, ...yarpc.CallOption) error
+	UnpauseWorkflowExecution(context.Context, *types.UnpauseWorkflowExecutionRequest, ...yarpc.CallOption) error
+	UpdateActivityOptions(context.Context, *types.UpdateActivityOptionsRequest, ...yarpc.CallOption) error
 	UpdateDomain(context.Context, *types.UpdateDomainRequest, ...yarpc.CallOption) (*types.UpdateDomainResponse, error)
+	UpdateSchedule(context.Context, *types.UpdateScheduleRequest, ...yarpc.CallOption) error
 }
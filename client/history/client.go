@@ -259,6 +259,29 @@ func (c *clientImpl) DescribeQueue(
 	return response, nil
 }
 
+func (c *clientImpl) ListThrottledWorkflowIDs(
+	ctx context.Context,
+	request *types.ListThrottledWorkflowIDsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListThrottledWorkflowIDsResponse, error) {
+	peer, err := c.peerResolver.FromShardID(int(request.GetShardID()))
+	if err != nil {
+		return nil, err
+	}
+	var response *types.ListThrottledWorkflowIDsResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		response, err = c.client.ListThrottledWorkflowIDs(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) DescribeMutableState(
 	ctx context.Context,
 	request *types.DescribeMutableStateRequest,
@@ -585,6 +608,102 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryPauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.PauseRequest.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.PauseWorkflowExecution(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryUnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.UnpauseRequest.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.UnpauseWorkflowExecution(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) PauseActivity(
+	ctx context.Context,
+	request *types.HistoryPauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.PauseRequest.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.PauseActivity(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) UnpauseActivity(
+	ctx context.Context,
+	request *types.HistoryUnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.UnpauseRequest.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.UnpauseActivity(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) ResetActivity(
+	ctx context.Context,
+	request *types.HistoryResetActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.ResetRequest.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.ResetActivity(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
+func (c *clientImpl) UpdateActivityOptions(
+	ctx context.Context,
+	request *types.HistoryUpdateActivityOptionsRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.UpdateRequest.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.UpdateActivityOptions(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
 func (c *clientImpl) ResetWorkflowExecution(
 	ctx context.Context,
 	request *types.HistoryResetWorkflowExecutionRequest,
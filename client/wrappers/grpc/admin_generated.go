@@ -106,6 +106,11 @@ func (g adminClient) GetDomainReplicationMessages(ctx context.Context, gp1 *type
 	return proto.ToAdminGetDomainReplicationMessagesResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) GetDomainUsage(ctx context.Context, gp1 *types.GetDomainUsageRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDomainUsageResponse, err error) {
+	response, err := g.c.GetDomainUsage(ctx, proto.FromAdminGetDomainUsageRequest(gp1), p1...)
+	return proto.ToAdminGetDomainUsageResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) GetDynamicConfig(ctx context.Context, gp1 *types.GetDynamicConfigRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDynamicConfigResponse, err error) {
 	response, err := g.c.GetDynamicConfig(ctx, proto.FromAdminGetDynamicConfigRequest(gp1), p1...)
 	return proto.ToAdminGetDynamicConfigResponse(response), proto.ToError(err)
@@ -126,11 +131,21 @@ func (g adminClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, gp1 *
 	return proto.ToAdminGetWorkflowExecutionRawHistoryV2Response(response), proto.ToError(err)
 }
 
+func (g adminClient) ImportWorkflowExecution(ctx context.Context, ip1 *types.ImportWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.ImportWorkflowExecution(ctx, proto.FromAdminImportWorkflowExecutionRequest(ip1), p1...)
+	return proto.ToError(err)
+}
+
 func (g adminClient) ListDynamicConfig(ctx context.Context, lp1 *types.ListDynamicConfigRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDynamicConfigResponse, err error) {
 	response, err := g.c.ListDynamicConfig(ctx, proto.FromAdminListDynamicConfigRequest(lp1), p1...)
 	return proto.ToAdminListDynamicConfigResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) ListThrottledWorkflowIDs(ctx context.Context, lp1 *types.ListThrottledWorkflowIDsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListThrottledWorkflowIDsResponse, err error) {
+	response, err := g.c.ListThrottledWorkflowIDs(ctx, proto.FromAdminListThrottledWorkflowIDsRequest(lp1), p1...)
+	return proto.ToAdminListThrottledWorkflowIDsResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) MaintainCorruptWorkflow(ctx context.Context, ap1 *types.AdminMaintainWorkflowRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminMaintainWorkflowResponse, err error) {
 	response, err := g.c.MaintainCorruptWorkflow(ctx, proto.FromAdminMaintainCorruptWorkflowRequest(ap1), p1...)
 	return proto.ToAdminMaintainCorruptWorkflowResponse(response), proto.ToError(err)
@@ -141,6 +156,11 @@ func (g adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMe
 	return proto.ToAdminMergeDLQMessagesResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.PauseActivity(ctx, proto.FromAdminPauseActivityRequest(pp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.PurgeDLQMessages(ctx, proto.FromAdminPurgeDLQMessagesRequest(pp1), p1...)
 	return proto.ToError(err)
@@ -171,6 +191,11 @@ func (g adminClient) ResendReplicationTasks(ctx context.Context, rp1 *types.Rese
 	return proto.ToError(err)
 }
 
+func (g adminClient) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.ResetActivity(ctx, proto.FromAdminResetActivityRequest(rp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g adminClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.ResetQueue(ctx, proto.FromAdminResetQueueRequest(rp1), p1...)
 	return proto.ToError(err)
@@ -186,6 +211,16 @@ func (g adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.Restor
 	return proto.ToError(err)
 }
 
+func (g adminClient) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UnpauseActivity(ctx, proto.FromAdminUnpauseActivityRequest(up1), p1...)
+	return proto.ToError(err)
+}
+
+func (g adminClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UpdateActivityOptions(ctx, proto.FromAdminUpdateActivityOptionsRequest(up1), p1...)
+	return proto.ToError(err)
+}
+
 func (g adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	response, err := g.c.UpdateDomainAsyncWorkflowConfiguraton(ctx, proto.FromAdminUpdateDomainAsyncWorkflowConfiguratonRequest(request), opts...)
 	return proto.ToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), proto.ToError(err)
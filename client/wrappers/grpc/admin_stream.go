@@ -0,0 +1,73 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpc
+
+import (
+	"context"
+	"io"
+
+	adminv1 "github.com/uber/cadence-idl/go/proto/admin/v1"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/proto"
+)
+
+type (
+	adminStreamClient struct {
+		c adminv1.AdminReplicationStreamAPIYARPCClient
+	}
+
+	replicationMessagesStream struct {
+		stream adminv1.AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient
+	}
+)
+
+func NewAdminStreamClient(c adminv1.AdminReplicationStreamAPIYARPCClient) admin.StreamClient {
+	return adminStreamClient{c}
+}
+
+func (g adminStreamClient) StreamReplicationMessages(ctx context.Context, opts ...yarpc.CallOption) (admin.ReplicationMessagesStream, error) {
+	stream, err := g.c.StreamReplicationMessages(ctx, opts...)
+	if err != nil {
+		return nil, proto.ToError(err)
+	}
+	return replicationMessagesStream{stream}, nil
+}
+
+func (s replicationMessagesStream) Send(request *types.StreamReplicationMessagesRequest) error {
+	return proto.ToError(s.stream.Send(proto.FromAdminStreamReplicationMessagesRequest(request)))
+}
+
+func (s replicationMessagesStream) Recv() (*types.StreamReplicationMessagesResponse, error) {
+	response, err := s.stream.Recv()
+	if err == io.EOF {
+		return nil, err
+	}
+	return proto.ToAdminStreamReplicationMessagesResponse(response), proto.ToError(err)
+}
+
+func (s replicationMessagesStream) CloseSend() error {
+	return proto.ToError(s.stream.CloseSend())
+}
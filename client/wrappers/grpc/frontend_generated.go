@@ -36,11 +36,26 @@ import (
 	"github.com/uber/cadence/common/types/mapper/proto"
 )
 
+func (g frontendClient) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.BackfillSchedule(ctx, proto.FromBackfillScheduleRequest(bp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g frontendClient) CountWorkflowExecutions(ctx context.Context, cp1 *types.CountWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (cp2 *types.CountWorkflowExecutionsResponse, err error) {
 	response, err := g.c.CountWorkflowExecutions(ctx, proto.FromCountWorkflowExecutionsRequest(cp1), p1...)
 	return proto.ToCountWorkflowExecutionsResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) CreateSchedule(ctx context.Context, cp1 *types.CreateScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.CreateSchedule(ctx, proto.FromCreateScheduleRequest(cp1), p1...)
+	return proto.ToError(err)
+}
+
+func (g frontendClient) DeleteSchedule(ctx context.Context, dp1 *types.DeleteScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.DeleteSchedule(ctx, proto.FromDeleteScheduleRequest(dp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g frontendClient) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.DeprecateDomain(ctx, proto.FromDeprecateDomainRequest(dp1), p1...)
 	return proto.ToError(err)
@@ -51,6 +66,11 @@ func (g frontendClient) DescribeDomain(ctx context.Context, dp1 *types.DescribeD
 	return proto.ToDescribeDomainResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) DescribeSchedule(ctx context.Context, dp1 *types.DescribeScheduleRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeScheduleResponse, err error) {
+	response, err := g.c.DescribeSchedule(ctx, proto.FromDescribeScheduleRequest(dp1), p1...)
+	return proto.ToDescribeScheduleResponse(response), proto.ToError(err)
+}
+
 func (g frontendClient) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeTaskListResponse, err error) {
 	response, err := g.c.DescribeTaskList(ctx, proto.FromDescribeTaskListRequest(dp1), p1...)
 	return proto.ToDescribeTaskListResponse(response), proto.ToError(err)
@@ -61,6 +81,11 @@ func (g frontendClient) DescribeWorkflowExecution(ctx context.Context, dp1 *type
 	return proto.ToDescribeWorkflowExecutionResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) ExecuteWorkflowExecution(ctx context.Context, ep1 *types.ExecuteWorkflowExecutionRequest, p1 ...yarpc.CallOption) (ep2 *types.ExecuteWorkflowExecutionResponse, err error) {
+	response, err := g.c.ExecuteWorkflowExecution(ctx, proto.FromExecuteWorkflowExecutionRequest(ep1), p1...)
+	return proto.ToExecuteWorkflowExecutionResponse(response), proto.ToError(err)
+}
+
 func (g frontendClient) GetClusterInfo(ctx context.Context, p1 ...yarpc.CallOption) (cp1 *types.ClusterInfo, err error) {
 	response, err := g.c.GetClusterInfo(ctx, &apiv1.GetClusterInfoRequest{}, p1...)
 	return proto.ToGetClusterInfoResponse(response), proto.ToError(err)
@@ -101,6 +126,11 @@ func (g frontendClient) ListOpenWorkflowExecutions(ctx context.Context, lp1 *typ
 	return proto.ToListOpenWorkflowExecutionsResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) ListSchedules(ctx context.Context, lp1 *types.ListSchedulesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListSchedulesResponse, err error) {
+	response, err := g.c.ListSchedules(ctx, proto.FromListSchedulesRequest(lp1), p1...)
+	return proto.ToListSchedulesResponse(response), proto.ToError(err)
+}
+
 func (g frontendClient) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	response, err := g.c.ListTaskListPartitions(ctx, proto.FromListTaskListPartitionsRequest(lp1), p1...)
 	return proto.ToListTaskListPartitionsResponse(response), proto.ToError(err)
@@ -111,6 +141,21 @@ func (g frontendClient) ListWorkflowExecutions(ctx context.Context, lp1 *types.L
 	return proto.ToListWorkflowExecutionsResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.PauseActivity(ctx, proto.FromPauseActivityRequest(pp1), p1...)
+	return proto.ToError(err)
+}
+
+func (g frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.PauseSchedule(ctx, proto.FromPauseScheduleRequest(pp1), p1...)
+	return proto.ToError(err)
+}
+
+func (g frontendClient) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.PauseWorkflowExecution(ctx, proto.FromPauseWorkflowExecutionRequest(pp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g frontendClient) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest, p1 ...yarpc.CallOption) (pp2 *types.PollForActivityTaskResponse, err error) {
 	response, err := g.c.PollForActivityTask(ctx, proto.FromPollForActivityTaskRequest(pp1), p1...)
 	return proto.ToPollForActivityTaskResponse(response), proto.ToError(err)
@@ -151,6 +196,11 @@ func (g frontendClient) RequestCancelWorkflowExecution(ctx context.Context, rp1
 	return proto.ToError(err)
 }
 
+func (g frontendClient) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.ResetActivity(ctx, proto.FromResetActivityRequest(rp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g frontendClient) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	response, err := g.c.ResetStickyTaskList(ctx, proto.FromResetStickyTaskListRequest(rp1), p1...)
 	return proto.ToResetStickyTaskListResponse(response), proto.ToError(err)
@@ -246,7 +296,37 @@ func (g frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *typ
 	return proto.ToError(err)
 }
 
+func (g frontendClient) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.TriggerSchedule(ctx, proto.FromTriggerScheduleRequest(tp1), p1...)
+	return proto.ToError(err)
+}
+
+func (g frontendClient) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UnpauseActivity(ctx, proto.FromUnpauseActivityRequest(up1), p1...)
+	return proto.ToError(err)
+}
+
+func (g frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UnpauseSchedule(ctx, proto.FromUnpauseScheduleRequest(up1), p1...)
+	return proto.ToError(err)
+}
+
+func (g frontendClient) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UnpauseWorkflowExecution(ctx, proto.FromUnpauseWorkflowExecutionRequest(up1), p1...)
+	return proto.ToError(err)
+}
+
+func (g frontendClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UpdateActivityOptions(ctx, proto.FromUpdateActivityOptionsRequest(up1), p1...)
+	return proto.ToError(err)
+}
+
 func (g frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	response, err := g.c.UpdateDomain(ctx, proto.FromUpdateDomainRequest(up1), p1...)
 	return proto.ToUpdateDomainResponse(response), proto.ToError(err)
 }
+
+func (g frontendClient) UpdateSchedule(ctx context.Context, up1 *types.UpdateScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UpdateSchedule(ctx, proto.FromUpdateScheduleRequest(up1), p1...)
+	return proto.ToError(err)
+}
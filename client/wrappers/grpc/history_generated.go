@@ -90,6 +90,11 @@ func (g historyClient) GetReplicationMessages(ctx context.Context, gp1 *types.Ge
 	return proto.ToHistoryGetReplicationMessagesResponse(response), proto.ToError(err)
 }
 
+func (g historyClient) ListThrottledWorkflowIDs(ctx context.Context, lp1 *types.ListThrottledWorkflowIDsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListThrottledWorkflowIDsResponse, err error) {
+	response, err := g.c.ListThrottledWorkflowIDs(ctx, proto.FromHistoryListThrottledWorkflowIDsRequest(lp1), p1...)
+	return proto.ToHistoryListThrottledWorkflowIDsResponse(response), proto.ToError(err)
+}
+
 func (g historyClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMessagesRequest, p1 ...yarpc.CallOption) (mp2 *types.MergeDLQMessagesResponse, err error) {
 	response, err := g.c.MergeDLQMessages(ctx, proto.FromHistoryMergeDLQMessagesRequest(mp1), p1...)
 	return proto.ToHistoryMergeDLQMessagesResponse(response), proto.ToError(err)
@@ -100,6 +105,16 @@ func (g historyClient) NotifyFailoverMarkers(ctx context.Context, np1 *types.Not
 	return proto.ToError(err)
 }
 
+func (g historyClient) PauseActivity(ctx context.Context, hp1 *types.HistoryPauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.PauseActivity(ctx, proto.FromHistoryPauseActivityRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
+func (g historyClient) PauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryPauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.PauseWorkflowExecution(ctx, proto.FromHistoryPauseWorkflowExecutionRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g historyClient) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest, p1 ...yarpc.CallOption) (pp2 *types.PollMutableStateResponse, err error) {
 	response, err := g.c.PollMutableState(ctx, proto.FromHistoryPollMutableStateRequest(pp1), p1...)
 	return proto.ToHistoryPollMutableStateResponse(response), proto.ToError(err)
@@ -170,6 +185,11 @@ func (g historyClient) RequestCancelWorkflowExecution(ctx context.Context, hp1 *
 	return proto.ToError(err)
 }
 
+func (g historyClient) ResetActivity(ctx context.Context, hp1 *types.HistoryResetActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.ResetActivity(ctx, proto.FromHistoryResetActivityRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g historyClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.ResetQueue(ctx, proto.FromHistoryResetQueueRequest(rp1), p1...)
 	return proto.ToError(err)
@@ -249,3 +269,18 @@ func (g historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *type
 	_, err = g.c.TerminateWorkflowExecution(ctx, proto.FromHistoryTerminateWorkflowExecutionRequest(hp1), p1...)
 	return proto.ToError(err)
 }
+
+func (g historyClient) UnpauseActivity(ctx context.Context, hp1 *types.HistoryUnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UnpauseActivity(ctx, proto.FromHistoryUnpauseActivityRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
+func (g historyClient) UnpauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryUnpauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UnpauseWorkflowExecution(ctx, proto.FromHistoryUnpauseWorkflowExecutionRequest(hp1), p1...)
+	return proto.ToError(err)
+}
+
+func (g historyClient) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UpdateActivityOptions(ctx, proto.FromHistoryUpdateActivityOptionsRequest(hp1), p1...)
+	return proto.ToError(err)
+}
@@ -232,6 +232,19 @@ func (c *adminClient) GetDomainReplicationMessages(ctx context.Context, gp1 *typ
 	return gp2, err
 }
 
+func (c *adminClient) GetDomainUsage(ctx context.Context, gp1 *types.GetDomainUsageRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDomainUsageResponse, err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientGetDomainUsageScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetDomainUsageScope, metrics.CadenceClientLatency)
+	gp2, err = c.client.GetDomainUsage(ctx, gp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetDomainUsageScope, metrics.CadenceClientFailures)
+	}
+	return gp2, err
+}
+
 func (c *adminClient) GetDynamicConfig(ctx context.Context, gp1 *types.GetDynamicConfigRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDynamicConfigResponse, err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientGetDynamicConfigScope, metrics.CadenceClientRequests)
 
@@ -284,6 +297,19 @@ func (c *adminClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, gp1
 	return gp2, err
 }
 
+func (c *adminClient) ImportWorkflowExecution(ctx context.Context, ip1 *types.ImportWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientImportWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientImportWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err = c.client.ImportWorkflowExecution(ctx, ip1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientImportWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *adminClient) ListDynamicConfig(ctx context.Context, lp1 *types.ListDynamicConfigRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDynamicConfigResponse, err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigScope, metrics.CadenceClientRequests)
 
@@ -297,6 +323,19 @@ func (c *adminClient) ListDynamicConfig(ctx context.Context, lp1 *types.ListDyna
 	return lp2, err
 }
 
+func (c *adminClient) ListThrottledWorkflowIDs(ctx context.Context, lp1 *types.ListThrottledWorkflowIDsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListThrottledWorkflowIDsResponse, err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientListThrottledWorkflowIDsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListThrottledWorkflowIDsScope, metrics.CadenceClientLatency)
+	lp2, err = c.client.ListThrottledWorkflowIDs(ctx, lp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListThrottledWorkflowIDsScope, metrics.CadenceClientFailures)
+	}
+	return lp2, err
+}
+
 func (c *adminClient) MaintainCorruptWorkflow(ctx context.Context, ap1 *types.AdminMaintainWorkflowRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminMaintainWorkflowResponse, err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientMaintainCorruptWorkflowScope, metrics.CadenceClientRequests)
 
@@ -323,6 +362,19 @@ func (c *adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQM
 	return mp2, err
 }
 
+func (c *adminClient) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientPauseActivityScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPauseActivityScope, metrics.CadenceClientLatency)
+	err = c.client.PauseActivity(ctx, pp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPauseActivityScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientPurgeDLQMessagesScope, metrics.CadenceClientRequests)
 
@@ -401,6 +453,19 @@ func (c *adminClient) ResendReplicationTasks(ctx context.Context, rp1 *types.Res
 	return err
 }
 
+func (c *adminClient) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientResetActivityScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientResetActivityScope, metrics.CadenceClientLatency)
+	err = c.client.ResetActivity(ctx, rp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientResetActivityScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *adminClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientResetQueueScope, metrics.CadenceClientRequests)
 
@@ -440,6 +505,32 @@ func (c *adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.Resto
 	return err
 }
 
+func (c *adminClient) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientUnpauseActivityScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUnpauseActivityScope, metrics.CadenceClientLatency)
+	err = c.client.UnpauseActivity(ctx, up1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUnpauseActivityScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *adminClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (err error) {
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateActivityOptionsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateActivityOptionsScope, metrics.CadenceClientLatency)
+	err = c.client.UpdateActivityOptions(ctx, up1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateActivityOptionsScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	c.metricsClient.IncCounter(metrics.AdminClientUpdateDomainAsyncWorkflowConfiguratonScope, metrics.CadenceClientRequests)
 
@@ -199,6 +199,17 @@ func (c *adminClient) GetDomainReplicationMessages(ctx context.Context, gp1 *typ
 	return resp, err
 }
 
+func (c *adminClient) GetDomainUsage(ctx context.Context, gp1 *types.GetDomainUsageRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDomainUsageResponse, err error) {
+	var resp *types.GetDomainUsageResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetDomainUsage(ctx, gp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) GetDynamicConfig(ctx context.Context, gp1 *types.GetDynamicConfigRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDynamicConfigResponse, err error) {
 	var resp *types.GetDynamicConfigResponse
 	op := func() error {
@@ -243,6 +254,13 @@ func (c *adminClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, gp1
 	return resp, err
 }
 
+func (c *adminClient) ImportWorkflowExecution(ctx context.Context, ip1 *types.ImportWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.ImportWorkflowExecution(ctx, ip1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *adminClient) ListDynamicConfig(ctx context.Context, lp1 *types.ListDynamicConfigRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDynamicConfigResponse, err error) {
 	var resp *types.ListDynamicConfigResponse
 	op := func() error {
@@ -254,6 +272,17 @@ func (c *adminClient) ListDynamicConfig(ctx context.Context, lp1 *types.ListDyna
 	return resp, err
 }
 
+func (c *adminClient) ListThrottledWorkflowIDs(ctx context.Context, lp1 *types.ListThrottledWorkflowIDsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListThrottledWorkflowIDsResponse, err error) {
+	var resp *types.ListThrottledWorkflowIDsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListThrottledWorkflowIDs(ctx, lp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) MaintainCorruptWorkflow(ctx context.Context, ap1 *types.AdminMaintainWorkflowRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminMaintainWorkflowResponse, err error) {
 	var resp *types.AdminMaintainWorkflowResponse
 	op := func() error {
@@ -276,6 +305,13 @@ func (c *adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQM
 	return resp, err
 }
 
+func (c *adminClient) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.PauseActivity(ctx, pp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func() error {
 		return c.client.PurgeDLQMessages(ctx, pp1, p1...)
@@ -322,6 +358,13 @@ func (c *adminClient) ResendReplicationTasks(ctx context.Context, rp1 *types.Res
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *adminClient) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.ResetActivity(ctx, rp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *adminClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func() error {
 		return c.client.ResetQueue(ctx, rp1, p1...)
@@ -347,6 +390,20 @@ func (c *adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.Resto
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *adminClient) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.UnpauseActivity(ctx, up1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *adminClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.UpdateActivityOptions(ctx, up1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	var resp *types.UpdateDomainAsyncWorkflowConfiguratonResponse
 	op := func() error {
@@ -53,6 +53,13 @@ func NewFrontendClient(client frontend.Client, policy backoff.RetryPolicy, isRet
 	}
 }
 
+func (c *frontendClient) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.BackfillSchedule(ctx, bp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *frontendClient) CountWorkflowExecutions(ctx context.Context, cp1 *types.CountWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (cp2 *types.CountWorkflowExecutionsResponse, err error) {
 	var resp *types.CountWorkflowExecutionsResponse
 	op := func() error {
@@ -64,6 +71,20 @@ func (c *frontendClient) CountWorkflowExecutions(ctx context.Context, cp1 *types
 	return resp, err
 }
 
+func (c *frontendClient) CreateSchedule(ctx context.Context, cp1 *types.CreateScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.CreateSchedule(ctx, cp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *frontendClient) DeleteSchedule(ctx context.Context, dp1 *types.DeleteScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.DeleteSchedule(ctx, dp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *frontendClient) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func() error {
 		return c.client.DeprecateDomain(ctx, dp1, p1...)
@@ -82,6 +103,17 @@ func (c *frontendClient) DescribeDomain(ctx context.Context, dp1 *types.Describe
 	return resp, err
 }
 
+func (c *frontendClient) DescribeSchedule(ctx context.Context, dp1 *types.DescribeScheduleRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeScheduleResponse, err error) {
+	var resp *types.DescribeScheduleResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeSchedule(ctx, dp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeTaskListResponse, err error) {
 	var resp *types.DescribeTaskListResponse
 	op := func() error {
@@ -104,6 +136,17 @@ func (c *frontendClient) DescribeWorkflowExecution(ctx context.Context, dp1 *typ
 	return resp, err
 }
 
+func (c *frontendClient) ExecuteWorkflowExecution(ctx context.Context, ep1 *types.ExecuteWorkflowExecutionRequest, p1 ...yarpc.CallOption) (ep2 *types.ExecuteWorkflowExecutionResponse, err error) {
+	var resp *types.ExecuteWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ExecuteWorkflowExecution(ctx, ep1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) GetClusterInfo(ctx context.Context, p1 ...yarpc.CallOption) (cp1 *types.ClusterInfo, err error) {
 	var resp *types.ClusterInfo
 	op := func() error {
@@ -192,6 +235,17 @@ func (c *frontendClient) ListOpenWorkflowExecutions(ctx context.Context, lp1 *ty
 	return resp, err
 }
 
+func (c *frontendClient) ListSchedules(ctx context.Context, lp1 *types.ListSchedulesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListSchedulesResponse, err error) {
+	var resp *types.ListSchedulesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListSchedules(ctx, lp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	var resp *types.ListTaskListPartitionsResponse
 	op := func() error {
@@ -214,6 +268,27 @@ func (c *frontendClient) ListWorkflowExecutions(ctx context.Context, lp1 *types.
 	return resp, err
 }
 
+func (c *frontendClient) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.PauseActivity(ctx, pp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.PauseSchedule(ctx, pp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *frontendClient) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.PauseWorkflowExecution(ctx, pp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *frontendClient) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest, p1 ...yarpc.CallOption) (pp2 *types.PollForActivityTaskResponse, err error) {
 	var resp *types.PollForActivityTaskResponse
 	op := func() error {
@@ -290,6 +365,13 @@ func (c *frontendClient) RequestCancelWorkflowExecution(ctx context.Context, rp1
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *frontendClient) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.ResetActivity(ctx, rp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *frontendClient) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	var resp *types.ResetStickyTaskListResponse
 	op := func() error {
@@ -459,6 +541,41 @@ func (c *frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *ty
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *frontendClient) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.TriggerSchedule(ctx, tp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *frontendClient) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.UnpauseActivity(ctx, up1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.UnpauseSchedule(ctx, up1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *frontendClient) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.UnpauseWorkflowExecution(ctx, up1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *frontendClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.UpdateActivityOptions(ctx, up1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	var resp *types.UpdateDomainResponse
 	op := func() error {
@@ -469,3 +586,10 @@ func (c *frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDoma
 	err = c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *frontendClient) UpdateSchedule(ctx context.Context, up1 *types.UpdateScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.UpdateSchedule(ctx, up1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
@@ -170,6 +170,17 @@ func (c *historyClient) GetReplicationMessages(ctx context.Context, gp1 *types.G
 	return resp, err
 }
 
+func (c *historyClient) ListThrottledWorkflowIDs(ctx context.Context, lp1 *types.ListThrottledWorkflowIDsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListThrottledWorkflowIDsResponse, err error) {
+	var resp *types.ListThrottledWorkflowIDsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListThrottledWorkflowIDs(ctx, lp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *historyClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMessagesRequest, p1 ...yarpc.CallOption) (mp2 *types.MergeDLQMessagesResponse, err error) {
 	var resp *types.MergeDLQMessagesResponse
 	op := func() error {
@@ -188,6 +199,20 @@ func (c *historyClient) NotifyFailoverMarkers(ctx context.Context, np1 *types.No
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *historyClient) PauseActivity(ctx context.Context, hp1 *types.HistoryPauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.PauseActivity(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *historyClient) PauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryPauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.PauseWorkflowExecution(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *historyClient) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest, p1 ...yarpc.CallOption) (pp2 *types.PollMutableStateResponse, err error) {
 	var resp *types.PollMutableStateResponse
 	op := func() error {
@@ -310,6 +335,13 @@ func (c *historyClient) RequestCancelWorkflowExecution(ctx context.Context, hp1
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *historyClient) ResetActivity(ctx context.Context, hp1 *types.HistoryResetActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.ResetActivity(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *historyClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func() error {
 		return c.client.ResetQueue(ctx, rp1, p1...)
@@ -445,3 +477,24 @@ func (c *historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *typ
 	}
 	return c.throttleRetry.Do(ctx, op)
 }
+
+func (c *historyClient) UnpauseActivity(ctx context.Context, hp1 *types.HistoryUnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.UnpauseActivity(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *historyClient) UnpauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryUnpauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.UnpauseWorkflowExecution(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *historyClient) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func() error {
+		return c.client.UpdateActivityOptions(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
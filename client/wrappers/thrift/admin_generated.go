@@ -104,6 +104,11 @@ func (g adminClient) GetDomainReplicationMessages(ctx context.Context, gp1 *type
 	return thrift.ToAdminGetDomainReplicationMessagesResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) GetDomainUsage(ctx context.Context, gp1 *types.GetDomainUsageRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDomainUsageResponse, err error) {
+	response, err := g.c.GetDomainUsage(ctx, thrift.FromAdminGetDomainUsageRequest(gp1), p1...)
+	return thrift.ToAdminGetDomainUsageResponse(response), thrift.ToError(err)
+}
+
 func (g adminClient) GetDynamicConfig(ctx context.Context, gp1 *types.GetDynamicConfigRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDynamicConfigResponse, err error) {
 	response, err := g.c.GetDynamicConfig(ctx, thrift.FromAdminGetDynamicConfigRequest(gp1), p1...)
 	return thrift.ToAdminGetDynamicConfigResponse(response), thrift.ToError(err)
@@ -124,11 +129,21 @@ func (g adminClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, gp1 *
 	return thrift.ToAdminGetWorkflowExecutionRawHistoryV2Response(response), thrift.ToError(err)
 }
 
+func (g adminClient) ImportWorkflowExecution(ctx context.Context, ip1 *types.ImportWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.ImportWorkflowExecution(ctx, thrift.FromAdminImportWorkflowExecutionRequest(ip1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g adminClient) ListDynamicConfig(ctx context.Context, lp1 *types.ListDynamicConfigRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDynamicConfigResponse, err error) {
 	response, err := g.c.ListDynamicConfig(ctx, thrift.FromAdminListDynamicConfigRequest(lp1), p1...)
 	return thrift.ToAdminListDynamicConfigResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) ListThrottledWorkflowIDs(ctx context.Context, lp1 *types.ListThrottledWorkflowIDsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListThrottledWorkflowIDsResponse, err error) {
+	response, err := g.c.ListThrottledWorkflowIDs(ctx, thrift.FromAdminListThrottledWorkflowIDsRequest(lp1), p1...)
+	return thrift.ToAdminListThrottledWorkflowIDsResponse(response), thrift.ToError(err)
+}
+
 func (g adminClient) MaintainCorruptWorkflow(ctx context.Context, ap1 *types.AdminMaintainWorkflowRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminMaintainWorkflowResponse, err error) {
 	response, err := g.c.MaintainCorruptWorkflow(ctx, thrift.FromAdminMaintainCorruptWorkflowRequest(ap1), p1...)
 	return thrift.ToAdminMaintainCorruptWorkflowResponse(response), thrift.ToError(err)
@@ -139,6 +154,11 @@ func (g adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMe
 	return thrift.ToAdminMergeDLQMessagesResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.PauseActivity(ctx, thrift.FromAdminPauseActivityRequest(pp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.PurgeDLQMessages(ctx, thrift.FromAdminPurgeDLQMessagesRequest(pp1), p1...)
 	return thrift.ToError(err)
@@ -169,6 +189,11 @@ func (g adminClient) ResendReplicationTasks(ctx context.Context, rp1 *types.Rese
 	return thrift.ToError(err)
 }
 
+func (g adminClient) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.ResetActivity(ctx, thrift.FromAdminResetActivityRequest(rp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g adminClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.ResetQueue(ctx, thrift.FromAdminResetQueueRequest(rp1), p1...)
 	return thrift.ToError(err)
@@ -184,6 +209,16 @@ func (g adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.Restor
 	return thrift.ToError(err)
 }
 
+func (g adminClient) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UnpauseActivity(ctx, thrift.FromAdminUnpauseActivityRequest(up1), p1...)
+	return thrift.ToError(err)
+}
+
+func (g adminClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UpdateActivityOptions(ctx, thrift.FromAdminUpdateActivityOptionsRequest(up1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	response, err := g.c.UpdateDomainAsyncWorkflowConfiguraton(ctx, thrift.FromAdminUpdateDomainAsyncWorkflowConfiguratonRequest(request), opts...)
 	return thrift.ToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), thrift.ToError(err)
@@ -35,11 +35,26 @@ import (
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
 
+func (g frontendClient) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.BackfillSchedule(ctx, thrift.FromBackfillScheduleRequest(bp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) CountWorkflowExecutions(ctx context.Context, cp1 *types.CountWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (cp2 *types.CountWorkflowExecutionsResponse, err error) {
 	response, err := g.c.CountWorkflowExecutions(ctx, thrift.FromCountWorkflowExecutionsRequest(cp1), p1...)
 	return thrift.ToCountWorkflowExecutionsResponse(response), thrift.ToError(err)
 }
 
+func (g frontendClient) CreateSchedule(ctx context.Context, cp1 *types.CreateScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.CreateSchedule(ctx, thrift.FromCreateScheduleRequest(cp1), p1...)
+	return thrift.ToError(err)
+}
+
+func (g frontendClient) DeleteSchedule(ctx context.Context, dp1 *types.DeleteScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.DeleteSchedule(ctx, thrift.FromDeleteScheduleRequest(dp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.DeprecateDomain(ctx, thrift.FromDeprecateDomainRequest(dp1), p1...)
 	return thrift.ToError(err)
@@ -50,6 +65,11 @@ func (g frontendClient) DescribeDomain(ctx context.Context, dp1 *types.DescribeD
 	return thrift.ToDescribeDomainResponse(response), thrift.ToError(err)
 }
 
+func (g frontendClient) DescribeSchedule(ctx context.Context, dp1 *types.DescribeScheduleRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeScheduleResponse, err error) {
+	response, err := g.c.DescribeSchedule(ctx, thrift.FromDescribeScheduleRequest(dp1), p1...)
+	return thrift.ToDescribeScheduleResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeTaskListResponse, err error) {
 	response, err := g.c.DescribeTaskList(ctx, thrift.FromDescribeTaskListRequest(dp1), p1...)
 	return thrift.ToDescribeTaskListResponse(response), thrift.ToError(err)
@@ -60,6 +80,11 @@ func (g frontendClient) DescribeWorkflowExecution(ctx context.Context, dp1 *type
 	return thrift.ToDescribeWorkflowExecutionResponse(response), thrift.ToError(err)
 }
 
+func (g frontendClient) ExecuteWorkflowExecution(ctx context.Context, ep1 *types.ExecuteWorkflowExecutionRequest, p1 ...yarpc.CallOption) (ep2 *types.ExecuteWorkflowExecutionResponse, err error) {
+	response, err := g.c.ExecuteWorkflowExecution(ctx, thrift.FromExecuteWorkflowExecutionRequest(ep1), p1...)
+	return thrift.ToExecuteWorkflowExecutionResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) GetClusterInfo(ctx context.Context, p1 ...yarpc.CallOption) (cp1 *types.ClusterInfo, err error) {
 	response, err := g.c.GetClusterInfo(ctx, p1...)
 	return thrift.ToGetClusterInfoResponse(response), thrift.ToError(err)
@@ -100,6 +125,11 @@ func (g frontendClient) ListOpenWorkflowExecutions(ctx context.Context, lp1 *typ
 	return thrift.ToListOpenWorkflowExecutionsResponse(response), thrift.ToError(err)
 }
 
+func (g frontendClient) ListSchedules(ctx context.Context, lp1 *types.ListSchedulesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListSchedulesResponse, err error) {
+	response, err := g.c.ListSchedules(ctx, thrift.FromListSchedulesRequest(lp1), p1...)
+	return thrift.ToListSchedulesResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	response, err := g.c.ListTaskListPartitions(ctx, thrift.FromListTaskListPartitionsRequest(lp1), p1...)
 	return thrift.ToListTaskListPartitionsResponse(response), thrift.ToError(err)
@@ -110,6 +140,21 @@ func (g frontendClient) ListWorkflowExecutions(ctx context.Context, lp1 *types.L
 	return thrift.ToListWorkflowExecutionsResponse(response), thrift.ToError(err)
 }
 
+func (g frontendClient) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.PauseActivity(ctx, thrift.FromPauseActivityRequest(pp1), p1...)
+	return thrift.ToError(err)
+}
+
+func (g frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.PauseSchedule(ctx, thrift.FromPauseScheduleRequest(pp1), p1...)
+	return thrift.ToError(err)
+}
+
+func (g frontendClient) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.PauseWorkflowExecution(ctx, thrift.FromPauseWorkflowExecutionRequest(pp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest, p1 ...yarpc.CallOption) (pp2 *types.PollForActivityTaskResponse, err error) {
 	response, err := g.c.PollForActivityTask(ctx, thrift.FromPollForActivityTaskRequest(pp1), p1...)
 	return thrift.ToPollForActivityTaskResponse(response), thrift.ToError(err)
@@ -150,6 +195,11 @@ func (g frontendClient) RequestCancelWorkflowExecution(ctx context.Context, rp1
 	return thrift.ToError(err)
 }
 
+func (g frontendClient) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.ResetActivity(ctx, thrift.FromResetActivityRequest(rp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	response, err := g.c.ResetStickyTaskList(ctx, thrift.FromResetStickyTaskListRequest(rp1), p1...)
 	return thrift.ToResetStickyTaskListResponse(response), thrift.ToError(err)
@@ -245,7 +295,37 @@ func (g frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *typ
 	return thrift.ToError(err)
 }
 
+func (g frontendClient) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.TriggerSchedule(ctx, thrift.FromTriggerScheduleRequest(tp1), p1...)
+	return thrift.ToError(err)
+}
+
+func (g frontendClient) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UnpauseActivity(ctx, thrift.FromUnpauseActivityRequest(up1), p1...)
+	return thrift.ToError(err)
+}
+
+func (g frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UnpauseSchedule(ctx, thrift.FromUnpauseScheduleRequest(up1), p1...)
+	return thrift.ToError(err)
+}
+
+func (g frontendClient) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UnpauseWorkflowExecution(ctx, thrift.FromUnpauseWorkflowExecutionRequest(up1), p1...)
+	return thrift.ToError(err)
+}
+
+func (g frontendClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UpdateActivityOptions(ctx, thrift.FromUpdateActivityOptionsRequest(up1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	response, err := g.c.UpdateDomain(ctx, thrift.FromUpdateDomainRequest(up1), p1...)
 	return thrift.ToUpdateDomainResponse(response), thrift.ToError(err)
 }
+
+func (g frontendClient) UpdateSchedule(ctx context.Context, up1 *types.UpdateScheduleRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UpdateSchedule(ctx, thrift.FromUpdateScheduleRequest(up1), p1...)
+	return thrift.ToError(err)
+}
@@ -89,6 +89,11 @@ func (g historyClient) GetReplicationMessages(ctx context.Context, gp1 *types.Ge
 	return thrift.ToHistoryGetReplicationMessagesResponse(response), thrift.ToError(err)
 }
 
+func (g historyClient) ListThrottledWorkflowIDs(ctx context.Context, lp1 *types.ListThrottledWorkflowIDsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListThrottledWorkflowIDsResponse, err error) {
+	response, err := g.c.ListThrottledWorkflowIDs(ctx, thrift.FromHistoryListThrottledWorkflowIDsRequest(lp1), p1...)
+	return thrift.ToHistoryListThrottledWorkflowIDsResponse(response), thrift.ToError(err)
+}
+
 func (g historyClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMessagesRequest, p1 ...yarpc.CallOption) (mp2 *types.MergeDLQMessagesResponse, err error) {
 	response, err := g.c.MergeDLQMessages(ctx, thrift.FromHistoryMergeDLQMessagesRequest(mp1), p1...)
 	return thrift.ToHistoryMergeDLQMessagesResponse(response), thrift.ToError(err)
@@ -99,6 +104,16 @@ func (g historyClient) NotifyFailoverMarkers(ctx context.Context, np1 *types.Not
 	return thrift.ToError(err)
 }
 
+func (g historyClient) PauseActivity(ctx context.Context, hp1 *types.HistoryPauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.PauseActivity(ctx, thrift.FromHistoryPauseActivityRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
+func (g historyClient) PauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryPauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.PauseWorkflowExecution(ctx, thrift.FromHistoryPauseWorkflowExecutionRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g historyClient) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest, p1 ...yarpc.CallOption) (pp2 *types.PollMutableStateResponse, err error) {
 	response, err := g.c.PollMutableState(ctx, thrift.FromHistoryPollMutableStateRequest(pp1), p1...)
 	return thrift.ToHistoryPollMutableStateResponse(response), thrift.ToError(err)
@@ -169,6 +184,11 @@ func (g historyClient) RequestCancelWorkflowExecution(ctx context.Context, hp1 *
 	return thrift.ToError(err)
 }
 
+func (g historyClient) ResetActivity(ctx context.Context, hp1 *types.HistoryResetActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.ResetActivity(ctx, thrift.FromHistoryResetActivityRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g historyClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.ResetQueue(ctx, thrift.FromHistoryResetQueueRequest(rp1), p1...)
 	return thrift.ToError(err)
@@ -248,3 +268,18 @@ func (g historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *type
 	err = g.c.TerminateWorkflowExecution(ctx, thrift.FromHistoryTerminateWorkflowExecutionRequest(hp1), p1...)
 	return thrift.ToError(err)
 }
+
+func (g historyClient) UnpauseActivity(ctx context.Context, hp1 *types.HistoryUnpauseActivityRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UnpauseActivity(ctx, thrift.FromHistoryUnpauseActivityRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
+func (g historyClient) UnpauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryUnpauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UnpauseWorkflowExecution(ctx, thrift.FromHistoryUnpauseWorkflowExecutionRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
+
+func (g historyClient) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UpdateActivityOptions(ctx, thrift.FromHistoryUpdateActivityOptionsRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
@@ -208,8 +208,12 @@ func (s *server) startService() common.Daemon {
 		dynamicconfig.AdvancedVisibilityWritingMode,
 	)()
 	isAdvancedVisEnabled := common.IsAdvancedVisibilityWritingEnabled(advancedVisMode, params.PersistenceConfig.IsAdvancedVisibilityConfigExist())
-	if isAdvancedVisEnabled {
-		params.MessagingClient = kafka.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, params.Logger, params.MetricScope, isAdvancedVisEnabled)
+	// the workflow lifecycle stream only needs kafka, so it can be used without advanced visibility.
+	// NewKafkaClient validates the kafka config of every application and panics if it is incomplete,
+	// so a lifecycle application with a missing topic or cluster prevents the service from starting.
+	_, isLifecycleStreamConfigured := s.cfg.Kafka.Applications[common.WorkflowLifecycleAppName]
+	if isAdvancedVisEnabled || isLifecycleStreamConfigured {
+		params.MessagingClient = kafka.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, params.Logger, params.MetricScope, true)
 	} else {
 		params.MessagingClient = nil
 	}
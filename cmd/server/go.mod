@@ -11,6 +11,8 @@ replace github.com/uber/cadence => ../..
 
 replace github.com/uber/cadence/common/archiver/gcloud => ../../common/archiver/gcloud
 
+replace github.com/uber/cadence-idl => ../../idls
+
 require (
 	github.com/Shopify/sarama v1.33.0 // indirect
 	github.com/VividCortex/mysqlerr v1.0.0 // indirect
@@ -87,6 +87,8 @@ const (
 	// VisibilityAppName is used to find kafka topics and ES indexName for visibility
 	VisibilityAppName      = "visibility"
 	PinotVisibilityAppName = "pinot-visibility"
+	// WorkflowLifecycleAppName is used to find the kafka topic for the workflow lifecycle stream
+	WorkflowLifecycleAppName = "workflow-lifecycle"
 )
 
 const (
@@ -226,6 +228,10 @@ const MemoKeyForOperator = "operator"
 // ReservedTaskListPrefix is the required naming prefix for any task list partition other than partition 0
 const ReservedTaskListPrefix = "/__cadence_sys/"
 
+// ClosedWorkflowQueryResultPrefix is the naming prefix of the query results keys a decision closing a workflow
+// uses to pre-compute query results by query type, e.g. "__cadence_query_result/state" for the "state" query
+const ClosedWorkflowQueryResultPrefix = "__cadence_query_result/"
+
 type (
 	// VisibilityOperation is an enum that represents visibility message types
 	VisibilityOperation string
@@ -268,4 +274,7 @@ const (
 const (
 	// WorkflowIDRateLimitReason is the reason set in ServiceBusyError when workflow ID rate limit is exceeded
 	WorkflowIDRateLimitReason = "external-workflow-id-rate-limit"
+	// WorkflowPendingSignalsLimitReason is the reason set in ServiceBusyError when a workflow has too many signals
+	// waiting to be processed by a decision
+	WorkflowPendingSignalsLimitReason = "workflow-pending-signals-limit"
 )
@@ -36,6 +36,7 @@ const (
 	Encoding        = "Encoding"
 	KafkaKey        = "KafkaKey"
 	BinaryChecksums = "BinaryChecksums"
+	CadencePaused   = "CadencePaused"
 	TaskList        = "TaskList"
 	IsCron          = "IsCron"
 	NumClusters     = "NumClusters"
@@ -73,6 +74,7 @@ func createDefaultIndexedKeys() map[string]interface{} {
 		CustomDatetimeField:  types.IndexedValueTypeDatetime,
 		CadenceChangeVersion: types.IndexedValueTypeKeyword,
 		BinaryChecksums:      types.IndexedValueTypeKeyword,
+		CadencePaused:        types.IndexedValueTypeBool,
 		CustomDomain:         types.IndexedValueTypeString,
 		Operator:             types.IndexedValueTypeString,
 	}
@@ -104,6 +106,18 @@ var systemIndexedKeys = map[string]interface{}{
 	UpdateTime:    types.IndexedValueTypeInt,
 }
 
+// serverManagedKeys are search attributes stored with the workflow's own search attributes
+// but only ever written by the server
+var serverManagedKeys = map[string]struct{}{
+	CadencePaused: {},
+}
+
+// IsServerManagedKey return true if key is written by the server on behalf of the workflow
+func IsServerManagedKey(key string) bool {
+	_, ok := serverManagedKeys[key]
+	return ok
+}
+
 // IsSystemIndexedKey return true is key is system added
 func IsSystemIndexedKey(key string) bool {
 	_, ok := systemIndexedKeys[key]
@@ -0,0 +1,119 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package dlq has the filters shared by the history and domain replication DLQ handlers.
+package dlq
+
+import (
+	"context"
+	"errors"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// Error classes of replication DLQ messages
+const (
+	// ErrorClassMissingInSource is for messages whose task can't be read from the source cluster anymore
+	ErrorClassMissingInSource = "missing-in-source"
+	// ErrorClassEntityNotExists is for tasks which failed because the workflow or domain doesn't exist
+	ErrorClassEntityNotExists = "entity-not-exists"
+	// ErrorClassMissingEvents is for tasks which failed because preceding history events are missing
+	ErrorClassMissingEvents = "missing-events"
+	// ErrorClassBadRequest is for tasks rejected as invalid
+	ErrorClassBadRequest = "bad-request"
+	// ErrorClassServiceBusy is for tasks which failed because of throttling
+	ErrorClassServiceBusy = "service-busy"
+	// ErrorClassTimeout is for tasks which failed with a timeout
+	ErrorClassTimeout = "timeout"
+	// ErrorClassInternal is for tasks which failed with an internal error
+	ErrorClassInternal = "internal"
+	// ErrorClassOther is for tasks which failed with any other error
+	ErrorClassOther = "other"
+	// ErrorClassUnknown is for messages which were put into the DLQ without an error class
+	ErrorClassUnknown = "unknown"
+)
+
+// AllErrorClasses are the error classes a replication DLQ message can have
+var AllErrorClasses = []string{
+	ErrorClassMissingInSource,
+	ErrorClassEntityNotExists,
+	ErrorClassMissingEvents,
+	ErrorClassBadRequest,
+	ErrorClassServiceBusy,
+	ErrorClassTimeout,
+	ErrorClassInternal,
+	ErrorClassOther,
+	ErrorClassUnknown,
+}
+
+// IsEmptyFilter returns true if the filter matches every message
+func IsEmptyFilter(filter *types.DLQFilter) bool {
+	return filter == nil ||
+		(filter.DomainID == "" && filter.WorkflowID == "" && filter.TaskType == nil && filter.ErrorClass == "")
+}
+
+// MatchesFilter returns true if a replication DLQ message matches the filter, empty filter fields match everything
+func MatchesFilter(
+	filter *types.DLQFilter,
+	domainID string,
+	workflowID string,
+	taskType *types.ReplicationTaskType,
+	errorClass string,
+) bool {
+	if IsEmptyFilter(filter) {
+		return true
+	}
+	if filter.DomainID != "" && filter.DomainID != domainID {
+		return false
+	}
+	if filter.WorkflowID != "" && filter.WorkflowID != workflowID {
+		return false
+	}
+	if filter.TaskType != nil && (taskType == nil || *taskType != *filter.TaskType) {
+		return false
+	}
+	if filter.ErrorClass != "" && filter.ErrorClass != errorClass {
+		return false
+	}
+	return true
+}
+
+// ClassifyError returns the error class of the error a replication task failed with
+func ClassifyError(err error) string {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrorClassTimeout
+	}
+	switch err.(type) {
+	case *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyCompletedError:
+		return ErrorClassEntityNotExists
+	case *types.RetryTaskV2Error:
+		return ErrorClassMissingEvents
+	case *types.BadRequestError:
+		return ErrorClassBadRequest
+	case *types.ServiceBusyError:
+		return ErrorClassServiceBusy
+	case *types.InternalServiceError:
+		return ErrorClassInternal
+	default:
+		return ErrorClassOther
+	}
+}
@@ -0,0 +1,77 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dlq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestMatchesFilter(t *testing.T) {
+	historyType := types.ReplicationTaskTypeHistoryV2.Ptr()
+	activityType := types.ReplicationTaskTypeSyncActivity.Ptr()
+
+	tests := []struct {
+		name     string
+		filter   *types.DLQFilter
+		expected bool
+	}{
+		{name: "nil filter", filter: nil, expected: true},
+		{name: "empty filter", filter: &types.DLQFilter{}, expected: true},
+		{name: "matching filter", filter: &types.DLQFilter{DomainID: "d", WorkflowID: "w", TaskType: historyType, ErrorClass: ErrorClassTimeout}, expected: true},
+		{name: "other domain", filter: &types.DLQFilter{DomainID: "other"}, expected: false},
+		{name: "other workflow", filter: &types.DLQFilter{WorkflowID: "other"}, expected: false},
+		{name: "other task type", filter: &types.DLQFilter{TaskType: activityType}, expected: false},
+		{name: "other error class", filter: &types.DLQFilter{ErrorClass: ErrorClassInternal}, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, MatchesFilter(tt.filter, "d", "w", historyType, ErrorClassTimeout))
+		})
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected string
+	}{
+		{err: fmt.Errorf("wrapped: %w", context.DeadlineExceeded), expected: ErrorClassTimeout},
+		{err: &types.EntityNotExistsError{}, expected: ErrorClassEntityNotExists},
+		{err: &types.WorkflowExecutionAlreadyCompletedError{}, expected: ErrorClassEntityNotExists},
+		{err: &types.RetryTaskV2Error{}, expected: ErrorClassMissingEvents},
+		{err: &types.BadRequestError{}, expected: ErrorClassBadRequest},
+		{err: &types.ServiceBusyError{}, expected: ErrorClassServiceBusy},
+		{err: &types.InternalServiceError{}, expected: ErrorClassInternal},
+		{err: errors.New("other"), expected: ErrorClassOther},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, ClassifyError(tt.err))
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dlq"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -41,9 +42,9 @@ type (
 		common.Daemon
 
 		Count(ctx context.Context, forceFetch bool) (int64, error)
-		Read(ctx context.Context, lastMessageID int64, pageSize int, pageToken []byte) ([]*types.ReplicationTask, []byte, error)
+		Read(ctx context.Context, lastMessageID int64, pageSize int, pageToken []byte, filter *types.DLQFilter) ([]*types.ReplicationTask, []byte, error)
 		Purge(ctx context.Context, lastMessageID int64) error
-		Merge(ctx context.Context, lastMessageID int64, pageSize int, pageToken []byte) ([]byte, error)
+		Merge(ctx context.Context, lastMessageID int64, pageSize int, pageToken []byte, filter *types.DLQFilter) ([]byte, error)
 	}
 
 	dlqMessageHandlerImpl struct {
@@ -106,26 +107,35 @@ func (d *dlqMessageHandlerImpl) Count(ctx context.Context, forceFetch bool) (int
 	return d.lastCount, nil
 }
 
-// ReadMessages reads domain replication DLQ messages
+// ReadMessages reads domain replication DLQ messages, only those of the filtered domain if a filter is given
 func (d *dlqMessageHandlerImpl) Read(
 	ctx context.Context,
 	lastMessageID int64,
 	pageSize int,
 	pageToken []byte,
+	filter *types.DLQFilter,
 ) ([]*types.ReplicationTask, []byte, error) {
 
+	if err := validateDLQFilter(filter); err != nil {
+		return nil, nil, err
+	}
+
 	ackLevel, err := d.replicationQueue.GetDLQAckLevel(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return d.replicationQueue.GetMessagesFromDLQ(
+	messages, token, err := d.replicationQueue.GetMessagesFromDLQ(
 		ctx,
 		ackLevel,
 		lastMessageID,
 		pageSize,
 		pageToken,
 	)
+	if err != nil || dlq.IsEmptyFilter(filter) {
+		return messages, token, err
+	}
+	return filterDLQMessages(filter, messages), token, nil
 }
 
 // PurgeMessages purges domain replication DLQ messages
@@ -163,12 +173,20 @@ func (d *dlqMessageHandlerImpl) Merge(
 	lastMessageID int64,
 	pageSize int,
 	pageToken []byte,
+	filter *types.DLQFilter,
 ) ([]byte, error) {
 
+	if err := validateDLQFilter(filter); err != nil {
+		return nil, err
+	}
+
 	ackLevel, err := d.replicationQueue.GetDLQAckLevel(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if !dlq.IsEmptyFilter(filter) {
+		return d.mergeFiltered(ctx, filter, ackLevel, lastMessageID, pageSize, pageToken)
+	}
 
 	messages, token, err := d.replicationQueue.GetMessagesFromDLQ(
 		ctx,
@@ -212,6 +230,61 @@ func (d *dlqMessageHandlerImpl) Merge(
 	return token, nil
 }
 
+// mergeFiltered applies the messages of one DLQ page which match the filter and deletes them one by one.
+// The ack level is not moved because messages which don't match stay in the DLQ.
+func (d *dlqMessageHandlerImpl) mergeFiltered(
+	ctx context.Context,
+	filter *types.DLQFilter,
+	ackLevel int64,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]byte, error) {
+
+	messages, token, err := d.replicationQueue.GetMessagesFromDLQ(
+		ctx,
+		ackLevel,
+		lastMessageID,
+		pageSize,
+		pageToken,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, message := range filterDLQMessages(filter, messages) {
+		if err := d.replicationHandler.Execute(message.GetDomainTaskAttributes()); err != nil {
+			return nil, err
+		}
+		if err := d.replicationQueue.DeleteMessageFromDLQ(ctx, message.SourceTaskID); err != nil {
+			d.logger.Error("failed to delete merged task on merging domain DLQ message", tag.Error(err))
+			return nil, err
+		}
+	}
+
+	return token, nil
+}
+
+// validateDLQFilter rejects filters domain replication tasks can't be matched against,
+// they can only be filtered by domain
+func validateDLQFilter(filter *types.DLQFilter) error {
+	if filter != nil && (filter.WorkflowID != "" || filter.TaskType != nil || filter.ErrorClass != "") {
+		return &types.BadRequestError{Message: "Domain DLQ messages can only be filtered by domain."}
+	}
+	return nil
+}
+
+func filterDLQMessages(filter *types.DLQFilter, messages []*types.ReplicationTask) []*types.ReplicationTask {
+	var matched []*types.ReplicationTask
+	for _, message := range messages {
+		domainTask := message.GetDomainTaskAttributes()
+		if domainTask != nil && dlq.MatchesFilter(filter, domainTask.ID, "", nil, "") {
+			matched = append(matched, message)
+		}
+	}
+	return matched
+}
+
 func (d *dlqMessageHandlerImpl) emitDLQSizeMetricsLoop() {
 	ticker := time.NewTicker(queueSizeQueryInterval)
 	defer ticker.Stop()
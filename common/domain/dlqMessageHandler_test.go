@@ -95,7 +95,7 @@ func (s *dlqMessageHandlerSuite) TestReadMessages() {
 	s.mockReplicationQueue.EXPECT().GetMessagesFromDLQ(gomock.Any(), ackLevel, lastMessageID, pageSize, pageToken).
 		Return(tasks, nil, nil).Times(1)
 
-	resp, token, err := s.dlqMessageHandler.Read(context.Background(), lastMessageID, pageSize, pageToken)
+	resp, token, err := s.dlqMessageHandler.Read(context.Background(), lastMessageID, pageSize, pageToken, nil)
 
 	s.NoError(err)
 	s.Equal(tasks, resp)
@@ -118,7 +118,7 @@ func (s *dlqMessageHandlerSuite) TestReadMessages_ThrowErrorOnGetDLQAckLevel() {
 	s.mockReplicationQueue.EXPECT().GetMessagesFromDLQ(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(tasks, nil, nil).Times(0)
 
-	_, _, err := s.dlqMessageHandler.Read(context.Background(), lastMessageID, pageSize, pageToken)
+	_, _, err := s.dlqMessageHandler.Read(context.Background(), lastMessageID, pageSize, pageToken, nil)
 
 	s.Equal(testError, err)
 }
@@ -134,7 +134,7 @@ func (s *dlqMessageHandlerSuite) TestReadMessages_ThrowErrorOnReadMessages() {
 	s.mockReplicationQueue.EXPECT().GetMessagesFromDLQ(gomock.Any(), ackLevel, lastMessageID, pageSize, pageToken).
 		Return(nil, nil, testError).Times(1)
 
-	_, _, err := s.dlqMessageHandler.Read(context.Background(), lastMessageID, pageSize, pageToken)
+	_, _, err := s.dlqMessageHandler.Read(context.Background(), lastMessageID, pageSize, pageToken, nil)
 
 	s.Equal(testError, err)
 }
@@ -201,7 +201,7 @@ func (s *dlqMessageHandlerSuite) TestMergeMessages() {
 	s.mockReplicationQueue.EXPECT().UpdateDLQAckLevel(gomock.Any(), messageID).Return(nil).Times(1)
 	s.mockReplicationQueue.EXPECT().RangeDeleteMessagesFromDLQ(gomock.Any(), ackLevel, messageID).Return(nil).Times(1)
 
-	token, err := s.dlqMessageHandler.Merge(context.Background(), lastMessageID, pageSize, pageToken)
+	token, err := s.dlqMessageHandler.Merge(context.Background(), lastMessageID, pageSize, pageToken, nil)
 	s.NoError(err)
 	s.Nil(token)
 }
@@ -230,7 +230,7 @@ func (s *dlqMessageHandlerSuite) TestMergeMessages_ThrowErrorOnGetDLQAckLevel()
 	s.mockReplicationQueue.EXPECT().DeleteMessageFromDLQ(gomock.Any(), gomock.Any()).Times(0)
 	s.mockReplicationQueue.EXPECT().UpdateDLQAckLevel(gomock.Any(), gomock.Any()).Times(0)
 
-	token, err := s.dlqMessageHandler.Merge(context.Background(), lastMessageID, pageSize, pageToken)
+	token, err := s.dlqMessageHandler.Merge(context.Background(), lastMessageID, pageSize, pageToken, nil)
 	s.Equal(testError, err)
 	s.Nil(token)
 }
@@ -249,7 +249,7 @@ func (s *dlqMessageHandlerSuite) TestMergeMessages_ThrowErrorOnGetDLQMessages()
 	s.mockReplicationQueue.EXPECT().DeleteMessageFromDLQ(gomock.Any(), gomock.Any()).Times(0)
 	s.mockReplicationQueue.EXPECT().UpdateDLQAckLevel(gomock.Any(), gomock.Any()).Times(0)
 
-	token, err := s.dlqMessageHandler.Merge(context.Background(), lastMessageID, pageSize, pageToken)
+	token, err := s.dlqMessageHandler.Merge(context.Background(), lastMessageID, pageSize, pageToken, nil)
 	s.Equal(testError, err)
 	s.Nil(token)
 }
@@ -287,7 +287,7 @@ func (s *dlqMessageHandlerSuite) TestMergeMessages_ThrowErrorOnHandleReceivingTa
 	s.mockReplicationTaskExecutor.EXPECT().Execute(domainAttribute2).Return(testError).Times(1)
 	s.mockReplicationQueue.EXPECT().DeleteMessageFromDLQ(gomock.Any(), messageID2).Times(0)
 
-	token, err := s.dlqMessageHandler.Merge(context.Background(), lastMessageID, pageSize, pageToken)
+	token, err := s.dlqMessageHandler.Merge(context.Background(), lastMessageID, pageSize, pageToken, nil)
 	s.Equal(testError, err)
 	s.Nil(token)
 }
@@ -325,7 +325,7 @@ func (s *dlqMessageHandlerSuite) TestMergeMessages_ThrowErrorOnDeleteMessages()
 	s.mockReplicationTaskExecutor.EXPECT().Execute(domainAttribute2).Return(nil).Times(1)
 	s.mockReplicationQueue.EXPECT().RangeDeleteMessagesFromDLQ(gomock.Any(), ackLevel, messageID2).Return(testError).Times(1)
 
-	token, err := s.dlqMessageHandler.Merge(context.Background(), lastMessageID, pageSize, pageToken)
+	token, err := s.dlqMessageHandler.Merge(context.Background(), lastMessageID, pageSize, pageToken, nil)
 	s.Error(err)
 	s.Nil(token)
 }
@@ -355,7 +355,69 @@ func (s *dlqMessageHandlerSuite) TestMergeMessages_IgnoreErrorOnUpdateDLQAckLeve
 	s.mockReplicationQueue.EXPECT().RangeDeleteMessagesFromDLQ(gomock.Any(), ackLevel, messageID).Return(nil).Times(1)
 	s.mockReplicationQueue.EXPECT().UpdateDLQAckLevel(gomock.Any(), messageID).Return(testError).Times(1)
 
-	token, err := s.dlqMessageHandler.Merge(context.Background(), lastMessageID, pageSize, pageToken)
+	token, err := s.dlqMessageHandler.Merge(context.Background(), lastMessageID, pageSize, pageToken, nil)
+	s.NoError(err)
+	s.Nil(token)
+}
+
+func (s *dlqMessageHandlerSuite) TestReadMessages_Filtered() {
+	ackLevel := int64(10)
+	lastMessageID := int64(20)
+	pageSize := 100
+	filter := &types.DLQFilter{DomainID: "domain-1"}
+
+	tasks := []*types.ReplicationTask{
+		{
+			TaskType:             types.ReplicationTaskTypeDomain.Ptr(),
+			SourceTaskID:         11,
+			DomainTaskAttributes: &types.DomainTaskAttributes{ID: "domain-1"},
+		},
+		{
+			TaskType:             types.ReplicationTaskTypeDomain.Ptr(),
+			SourceTaskID:         12,
+			DomainTaskAttributes: &types.DomainTaskAttributes{ID: "domain-2"},
+		},
+	}
+	s.mockReplicationQueue.EXPECT().GetDLQAckLevel(gomock.Any()).Return(ackLevel, nil).Times(1)
+	s.mockReplicationQueue.EXPECT().GetMessagesFromDLQ(gomock.Any(), ackLevel, lastMessageID, pageSize, nil).
+		Return(tasks, []byte("next"), nil).Times(1)
+
+	resp, token, err := s.dlqMessageHandler.Read(context.Background(), lastMessageID, pageSize, nil, filter)
+	s.NoError(err)
+	s.Equal(tasks[:1], resp)
+	s.Equal([]byte("next"), token)
+}
+
+func (s *dlqMessageHandlerSuite) TestReadMessages_UnsupportedFilter() {
+	_, _, err := s.dlqMessageHandler.Read(context.Background(), 20, 100, nil, &types.DLQFilter{WorkflowID: "wid"})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *dlqMessageHandlerSuite) TestMergeMessages_Filtered() {
+	ackLevel := int64(10)
+	lastMessageID := int64(20)
+	pageSize := 100
+
+	domainAttribute := &types.DomainTaskAttributes{ID: "domain-1"}
+	tasks := []*types.ReplicationTask{
+		{
+			TaskType:             types.ReplicationTaskTypeDomain.Ptr(),
+			SourceTaskID:         11,
+			DomainTaskAttributes: domainAttribute,
+		},
+		{
+			TaskType:             types.ReplicationTaskTypeDomain.Ptr(),
+			SourceTaskID:         12,
+			DomainTaskAttributes: &types.DomainTaskAttributes{ID: "domain-2"},
+		},
+	}
+	s.mockReplicationQueue.EXPECT().GetDLQAckLevel(gomock.Any()).Return(ackLevel, nil).Times(1)
+	s.mockReplicationQueue.EXPECT().GetMessagesFromDLQ(gomock.Any(), ackLevel, lastMessageID, pageSize, nil).
+		Return(tasks, nil, nil).Times(1)
+	s.mockReplicationTaskExecutor.EXPECT().Execute(domainAttribute).Return(nil).Times(1)
+	s.mockReplicationQueue.EXPECT().DeleteMessageFromDLQ(gomock.Any(), int64(11)).Return(nil).Times(1)
+
+	token, err := s.dlqMessageHandler.Merge(context.Background(), lastMessageID, pageSize, nil, &types.DLQFilter{DomainID: "domain-1"})
 	s.NoError(err)
 	s.Nil(token)
 }
@@ -164,6 +164,7 @@ func (s *failoverWatcherSuite) TestCleanPendingActiveState() {
 		Info:                        info,
 		Config:                      domainConfig,
 		ReplicationConfig:           replicationConfig,
+		IsGlobalDomain:              true,
 		ConfigVersion:               1,
 		FailoverVersion:             2,
 		FailoverNotificationVersion: 2,
@@ -227,6 +228,7 @@ func (s *failoverWatcherSuite) TestHandleFailoverTimeout() {
 		Info:                        info,
 		Config:                      domainConfig,
 		ReplicationConfig:           replicationConfig,
+		IsGlobalDomain:              true,
 		ConfigVersion:               1,
 		FailoverVersion:             1,
 		FailoverNotificationVersion: 1,
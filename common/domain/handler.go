@@ -470,6 +470,16 @@ func (d *handlerImpl) UpdateDomain(
 		return nil, err
 	}
 
+	// Promote a local domain to a global domain
+	promotedToGlobalDomain, err := d.updateIsGlobalDomain(isGlobalDomain, updateRequest)
+	if err != nil {
+		return nil, err
+	}
+	if promotedToGlobalDomain {
+		isGlobalDomain = true
+		failoverVersion = d.clusterMetadata.GetNextFailoverVersion(replicationConfig.ActiveClusterName, 0, updateRequest.Name)
+	}
+
 	// Handle graceful failover request
 	if updateRequest.FailoverTimeoutInSeconds != nil {
 		// must update active cluster on a global domain
@@ -492,7 +502,7 @@ func (d *handlerImpl) UpdateDomain(
 		previousFailoverVersion = failoverVersion
 	}
 
-	configurationChanged = historyArchivalConfigChanged || visibilityArchivalConfigChanged || domainInfoChanged || domainConfigChanged || deleteBinaryChanged || replicationConfigChanged
+	configurationChanged = historyArchivalConfigChanged || visibilityArchivalConfigChanged || domainInfoChanged || domainConfigChanged || deleteBinaryChanged || replicationConfigChanged || promotedToGlobalDomain
 
 	if err := d.domainAttrValidator.validateDomainConfig(config); err != nil {
 		return nil, err
@@ -550,6 +560,7 @@ func (d *handlerImpl) UpdateDomain(
 			info,
 			config,
 			replicationConfig,
+			isGlobalDomain,
 			configVersion,
 			failoverVersion,
 			failoverNotificationVersion,
@@ -625,6 +636,7 @@ func (d *handlerImpl) DeprecateDomain(
 		getResponse.Info,
 		getResponse.Config,
 		getResponse.ReplicationConfig,
+		isGlobalDomain,
 		getResponse.ConfigVersion,
 		getResponse.FailoverVersion,
 		getResponse.FailoverNotificationVersion,
@@ -720,6 +732,7 @@ func (d *handlerImpl) UpdateIsolationGroups(
 		currentDomainConfig.Info,
 		currentDomainConfig.Config,
 		currentDomainConfig.ReplicationConfig,
+		currentDomainConfig.IsGlobalDomain,
 		configVersion,
 		currentDomainConfig.FailoverVersion,
 		currentDomainConfig.FailoverNotificationVersion,
@@ -818,6 +831,7 @@ func (d *handlerImpl) UpdateAsyncWorkflowConfiguraton(
 		currentDomainConfig.Info,
 		currentDomainConfig.Config,
 		currentDomainConfig.ReplicationConfig,
+		currentDomainConfig.IsGlobalDomain,
 		configVersion,
 		currentDomainConfig.FailoverVersion,
 		currentDomainConfig.FailoverNotificationVersion,
@@ -1153,6 +1167,20 @@ func (d *handlerImpl) updateReplicationConfig(
 	return config, clusterUpdated, activeClusterUpdated, nil
 }
 
+func (d *handlerImpl) updateIsGlobalDomain(
+	isGlobalDomain bool,
+	updateRequest *types.UpdateDomainRequest,
+) (bool, error) {
+
+	if updateRequest.IsGlobalDomain == nil || updateRequest.GetIsGlobalDomain() == isGlobalDomain {
+		return false, nil
+	}
+	if isGlobalDomain {
+		return false, errCannotMakeGlobalDomainLocal
+	}
+	return true, nil
+}
+
 func getDomainStatus(info *persistence.DomainInfo) *types.DomainStatus {
 	switch info.Status {
 	case persistence.DomainStatusRegistered:
@@ -1175,6 +1203,7 @@ func createUpdateRequest(
 	info *persistence.DomainInfo,
 	config *persistence.DomainConfig,
 	replicationConfig *persistence.DomainReplicationConfig,
+	isGlobalDomain bool,
 	configVersion int64,
 	failoverVersion int64,
 	failoverNotificationVersion int64,
@@ -1187,6 +1216,7 @@ func createUpdateRequest(
 		Info:                        info,
 		Config:                      config,
 		ReplicationConfig:           replicationConfig,
+		IsGlobalDomain:              isGlobalDomain,
 		ConfigVersion:               configVersion,
 		FailoverVersion:             failoverVersion,
 		FailoverNotificationVersion: failoverNotificationVersion,
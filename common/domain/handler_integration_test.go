@@ -623,6 +623,65 @@ func (s *domainHandlerCommonSuite) TestUpdateDomain_ForceFailover_SameActiveClus
 	s.NoError(err)
 }
 
+func (s *domainHandlerCommonSuite) TestUpdateDomain_PromoteLocalDomain() {
+	s.mockProducer.On("Publish", mock.Anything, mock.Anything).Return(nil).Once()
+	domain := uuid.New()
+	registerRequest := &types.RegisterDomainRequest{
+		Name:                                   domain,
+		Description:                            domain,
+		WorkflowExecutionRetentionPeriodInDays: int32(10),
+		IsGlobalDomain:                         false,
+		ActiveClusterName:                      s.ClusterMetadata.GetCurrentClusterName(),
+	}
+	err := s.handler.RegisterDomain(context.Background(), registerRequest)
+	s.NoError(err)
+
+	updateRequest := &types.UpdateDomainRequest{
+		Name:           domain,
+		IsGlobalDomain: common.BoolPtr(true),
+	}
+	resp, err := s.handler.UpdateDomain(context.Background(), updateRequest)
+	s.NoError(err)
+	s.True(resp.GetIsGlobalDomain())
+	s.Equal(
+		s.ClusterMetadata.GetNextFailoverVersion(s.ClusterMetadata.GetCurrentClusterName(), 0, domain),
+		resp.GetFailoverVersion(),
+	)
+
+	resp2, err := s.domainManager.GetDomain(context.Background(), &persistence.GetDomainRequest{
+		ID: resp.GetDomainInfo().GetUUID(),
+	})
+	s.NoError(err)
+	s.True(resp2.IsGlobalDomain)
+	s.Equal(resp.GetFailoverVersion(), resp2.FailoverVersion)
+	s.Equal(int64(1), resp2.ConfigVersion)
+}
+
+func (s *domainHandlerCommonSuite) TestUpdateDomain_CannotMakeGlobalDomainLocal() {
+	s.mockProducer.On("Publish", mock.Anything, mock.Anything).Return(nil).Once()
+	domain := uuid.New()
+	registerRequest := &types.RegisterDomainRequest{
+		Name:                                   domain,
+		Description:                            domain,
+		WorkflowExecutionRetentionPeriodInDays: int32(10),
+		IsGlobalDomain:                         true,
+		ActiveClusterName:                      s.ClusterMetadata.GetCurrentClusterName(),
+		Clusters: []*types.ClusterReplicationConfiguration{
+			{ClusterName: s.ClusterMetadata.GetCurrentClusterName()},
+			{ClusterName: "standby"},
+		},
+	}
+	err := s.handler.RegisterDomain(context.Background(), registerRequest)
+	s.NoError(err)
+
+	updateRequest := &types.UpdateDomainRequest{
+		Name:           domain,
+		IsGlobalDomain: common.BoolPtr(false),
+	}
+	_, err = s.handler.UpdateDomain(context.Background(), updateRequest)
+	s.Equal(errCannotMakeGlobalDomainLocal, err)
+}
+
 func (s *domainHandlerCommonSuite) getRandomDomainName() string {
 	return "domain" + uuid.New()
 }
@@ -708,6 +708,105 @@ func TestHandler_DeprecateDomain(t *testing.T) {
 	}
 }
 
+func TestHandler_UpdateDomain_IsGlobalDomain(t *testing.T) {
+	localDomain := func() *persistence.GetDomainResponse {
+		return &persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: "domain-id", Name: "test-domain", Status: persistence.DomainStatusRegistered},
+			Config: &persistence.DomainConfig{Retention: 3},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters:          []*persistence.ClusterReplicationConfig{{ClusterName: cluster.TestCurrentClusterName}},
+			},
+			FailoverVersion: common.EmptyVersion,
+			IsGlobalDomain:  false,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		isGlobalDomain bool
+		primaryCluster bool
+		setupMocks     func(m *persistence.MockDomainManager, r *MockReplicator)
+		expectedErr    error
+	}{
+		{
+			name:           "success - promote local domain",
+			isGlobalDomain: true,
+			primaryCluster: true,
+			setupMocks: func(m *persistence.MockDomainManager, r *MockReplicator) {
+				expectedVersion := cluster.GetTestClusterMetadata(true).GetNextFailoverVersion(cluster.TestCurrentClusterName, 0, "test-domain")
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{Name: "test-domain"}).Return(localDomain(), nil)
+				m.EXPECT().UpdateDomain(gomock.Any(), gomock.AssignableToTypeOf(&persistence.UpdateDomainRequest{})).DoAndReturn(
+					func(_ context.Context, req *persistence.UpdateDomainRequest) error {
+						if !req.IsGlobalDomain || req.FailoverVersion != expectedVersion || req.ConfigVersion != 1 {
+							return errors.New("unexpected UpdateDomainRequest")
+						}
+						return nil
+					},
+				)
+				r.EXPECT().HandleTransmissionTask(gomock.Any(), types.DomainOperationUpdate, gomock.Any(), gomock.Any(), gomock.Any(), int64(1), expectedVersion, gomock.Any(), true).Return(nil)
+			},
+		},
+		{
+			name:           "noop - local domain stays local",
+			isGlobalDomain: false,
+			primaryCluster: true,
+			setupMocks: func(m *persistence.MockDomainManager, r *MockReplicator) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{Name: "test-domain"}).Return(localDomain(), nil)
+			},
+		},
+		{
+			name:           "failure - promote from non-primary cluster",
+			isGlobalDomain: true,
+			primaryCluster: false,
+			setupMocks: func(m *persistence.MockDomainManager, r *MockReplicator) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{Name: "test-domain"}).Return(localDomain(), nil)
+			},
+			expectedErr: errNotPrimaryCluster,
+		},
+		{
+			name:           "failure - global domain cannot be made local",
+			isGlobalDomain: false,
+			primaryCluster: true,
+			setupMocks: func(m *persistence.MockDomainManager, r *MockReplicator) {
+				domain := localDomain()
+				domain.IsGlobalDomain = true
+				domain.FailoverVersion = 1
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{Name: "test-domain"}).Return(domain, nil)
+			},
+			expectedErr: errCannotMakeGlobalDomainLocal,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			mockDomainManager := persistence.NewMockDomainManager(controller)
+			mockReplicator := NewMockReplicator(controller)
+
+			handler := newTestHandler(mockDomainManager, tc.primaryCluster, mockReplicator)
+			tc.setupMocks(mockDomainManager, mockReplicator)
+
+			resp, err := handler.UpdateDomain(context.Background(), &types.UpdateDomainRequest{
+				Name:           "test-domain",
+				IsGlobalDomain: common.BoolPtr(tc.isGlobalDomain),
+			})
+
+			if tc.expectedErr != nil {
+				assert.Equal(t, tc.expectedErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.isGlobalDomain, resp.GetIsGlobalDomain())
+			}
+		})
+	}
+}
+
 func TestHandler_UpdateIsolationGroups(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -224,6 +224,7 @@ func (h *domainReplicationTaskExecutorImpl) handleDomainUpdateReplicationTask(ct
 		Info:                        resp.Info,
 		Config:                      resp.Config,
 		ReplicationConfig:           resp.ReplicationConfig,
+		IsGlobalDomain:              resp.IsGlobalDomain,
 		ConfigVersion:               resp.ConfigVersion,
 		FailoverVersion:             resp.FailoverVersion,
 		FailoverNotificationVersion: resp.FailoverNotificationVersion,
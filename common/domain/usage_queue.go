@@ -0,0 +1,307 @@
+// Copyright (c) 2017-2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination usage_queue_mock.go -self_package github.com/uber/cadence/common/domain
+
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	usageQueueReadPageSize = 1000
+)
+
+var _ UsageQueue = (*usageQueueImpl)(nil)
+
+type (
+	// WorkflowUsage is the capacity consumed by the workflows of a domain and workflow type,
+	// counted from the history events written by the active cluster
+	WorkflowUsage struct {
+		DomainID        string `json:"domainID"`
+		DomainName      string `json:"domainName"`
+		WorkflowType    string `json:"workflowType"`
+		DecisionTasks   int64  `json:"decisionTasks"`
+		ActivityTasks   int64  `json:"activityTasks"`
+		LocalActivities int64  `json:"localActivities"`
+		Signals         int64  `json:"signals"`
+		Timers          int64  `json:"timers"`
+		HistoryBytes    int64  `json:"historyBytes"`
+	}
+
+	// UsageReport is the workflow usage a history host recorded between StartTime and EndTime,
+	// both in unix nanoseconds
+	UsageReport struct {
+		Host      string           `json:"host"`
+		StartTime int64            `json:"startTime"`
+		EndTime   int64            `json:"endTime"`
+		Usage     []*WorkflowUsage `json:"usage"`
+	}
+
+	// UsageQueue is used to publish and read the workflow usage reports of history hosts
+	UsageQueue interface {
+		Publish(ctx context.Context, report *UsageReport) error
+		// GetDomainUsage returns the usage of the domain per workflow type, summed over the reports
+		// which overlap the time range
+		GetDomainUsage(ctx context.Context, domainID string, startTime time.Time, endTime time.Time) ([]*WorkflowUsage, error)
+		// Purge deletes the reports which ended before the cutoff
+		Purge(ctx context.Context, cutoff time.Time) error
+	}
+
+	// domainUsage is the part of a report which belongs to a single domain
+	domainUsage struct {
+		messageID int64
+		startTime int64
+		endTime   int64
+		usage     []*WorkflowUsage
+	}
+
+	usageQueueImpl struct {
+		queue       persistence.QueueManager
+		clusterName string
+		retry       *backoff.ThrottleRetry
+
+		sync.Mutex
+		// readLevel is the ID of the last message split into domainUsages
+		readLevel int64
+		// domainUsages holds the reports read so far per domain ID, in message ID order,
+		// so that a query only goes over the reports of its domain
+		domainUsages map[string][]*domainUsage
+	}
+)
+
+// NewUsageQueue creates a new UsageQueue instance
+func NewUsageQueue(
+	queue persistence.QueueManager,
+	clusterName string,
+) UsageQueue {
+	return &usageQueueImpl{
+		queue:       queue,
+		clusterName: clusterName,
+		retry: backoff.NewThrottleRetry(
+			backoff.WithRetryPolicy(common.CreatePersistenceRetryPolicy()),
+			backoff.WithRetryableError(isUsageQueueRetryableError),
+		),
+		readLevel:    -1,
+		domainUsages: make(map[string][]*domainUsage),
+	}
+}
+
+// Add adds the counters of other to the usage
+func (u *WorkflowUsage) Add(other *WorkflowUsage) {
+	u.DecisionTasks += other.DecisionTasks
+	u.ActivityTasks += other.ActivityTasks
+	u.LocalActivities += other.LocalActivities
+	u.Signals += other.Signals
+	u.Timers += other.Timers
+	u.HistoryBytes += other.HistoryBytes
+}
+
+func (q *usageQueueImpl) Publish(
+	ctx context.Context,
+	report *UsageReport,
+) error {
+	bytes, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("failed to encode usage report: %v", err)
+	}
+	// hosts enqueue concurrently, so the message ID of a report can be taken by another host
+	return q.retry.Do(ctx, func() error {
+		return q.queue.EnqueueMessage(ctx, bytes)
+	})
+}
+
+func (q *usageQueueImpl) GetDomainUsage(
+	ctx context.Context,
+	domainID string,
+	startTime time.Time,
+	endTime time.Time,
+) ([]*WorkflowUsage, error) {
+	q.Lock()
+	defer q.Unlock()
+
+	if err := q.refreshLocked(ctx); err != nil {
+		return nil, err
+	}
+
+	usageByWorkflowType := make(map[string]*WorkflowUsage)
+	for _, reported := range q.domainUsages[domainID] {
+		if reported.endTime <= startTime.UnixNano() || reported.startTime >= endTime.UnixNano() {
+			continue
+		}
+		for _, usage := range reported.usage {
+			total, ok := usageByWorkflowType[usage.WorkflowType]
+			if !ok {
+				total = &WorkflowUsage{
+					DomainID:     usage.DomainID,
+					DomainName:   usage.DomainName,
+					WorkflowType: usage.WorkflowType,
+				}
+				usageByWorkflowType[usage.WorkflowType] = total
+			}
+			total.Add(usage)
+		}
+	}
+
+	result := make([]*WorkflowUsage, 0, len(usageByWorkflowType))
+	for _, usage := range usageByWorkflowType {
+		result = append(result, usage)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].WorkflowType < result[j].WorkflowType
+	})
+	return result, nil
+}
+
+func (q *usageQueueImpl) Purge(
+	ctx context.Context,
+	cutoff time.Time,
+) error {
+	ackLevel, err := q.getAckLevel(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to purge usage reports: %v", err)
+	}
+
+	// hosts report at different times, so a report which is still retained can be followed by expired ones.
+	// The ack level only moves past expired reports and stops at the first retained one, the expired reports
+	// after it are purged once it expires.
+	newAckLevel := ackLevel
+	err = q.scan(ctx, ackLevel, func(messageID int64, report *UsageReport) bool {
+		if report.EndTime >= cutoff.UnixNano() {
+			return false
+		}
+		newAckLevel = messageID
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to purge usage reports: %v", err)
+	}
+	if newAckLevel == ackLevel {
+		return nil
+	}
+
+	// the ack level keeps the message IDs increasing once the queue is emptied,
+	// and tells readers which reports were purged
+	if err := q.queue.UpdateAckLevel(ctx, newAckLevel, q.clusterName); err != nil {
+		return fmt.Errorf("failed to purge usage reports: %v", err)
+	}
+	if err := q.queue.DeleteMessagesBefore(ctx, newAckLevel+1); err != nil {
+		return fmt.Errorf("failed to purge usage reports: %v", err)
+	}
+	return nil
+}
+
+// refreshLocked drops the reports purged since the last call and splits the reports published since then per domain
+func (q *usageQueueImpl) refreshLocked(
+	ctx context.Context,
+) error {
+	ackLevel, err := q.getAckLevel(ctx)
+	if err != nil {
+		return err
+	}
+	for domainID, usages := range q.domainUsages {
+		retained := sort.Search(len(usages), func(i int) bool {
+			return usages[i].messageID > ackLevel
+		})
+		if retained == len(usages) {
+			delete(q.domainUsages, domainID)
+		} else {
+			q.domainUsages[domainID] = usages[retained:]
+		}
+	}
+	if q.readLevel < ackLevel {
+		q.readLevel = ackLevel
+	}
+
+	return q.scan(ctx, q.readLevel, func(messageID int64, report *UsageReport) bool {
+		q.readLevel = messageID
+		usageByDomainID := make(map[string][]*WorkflowUsage)
+		for _, usage := range report.Usage {
+			usageByDomainID[usage.DomainID] = append(usageByDomainID[usage.DomainID], usage)
+		}
+		for domainID, usage := range usageByDomainID {
+			q.domainUsages[domainID] = append(q.domainUsages[domainID], &domainUsage{
+				messageID: messageID,
+				startTime: report.StartTime,
+				endTime:   report.EndTime,
+				usage:     usage,
+			})
+		}
+		return true
+	})
+}
+
+// getAckLevel returns the ID of the last purged report, or -1 if no report was purged yet
+func (q *usageQueueImpl) getAckLevel(
+	ctx context.Context,
+) (int64, error) {
+	ackLevels, err := q.queue.GetAckLevels(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if ackLevel, ok := ackLevels[q.clusterName]; ok {
+		return ackLevel, nil
+	}
+	return -1, nil
+}
+
+// scan calls fn with every report after lastMessageID, in message ID order, until fn returns false
+func (q *usageQueueImpl) scan(
+	ctx context.Context,
+	lastMessageID int64,
+	fn func(messageID int64, report *UsageReport) bool,
+) error {
+	for {
+		messages, err := q.queue.ReadMessages(ctx, lastMessageID, usageQueueReadPageSize)
+		if err != nil {
+			return err
+		}
+		for _, message := range messages {
+			lastMessageID = message.ID
+			var report UsageReport
+			if err := json.Unmarshal(message.Payload, &report); err != nil {
+				return fmt.Errorf("failed to decode usage report %v: %v", message.ID, err)
+			}
+			if !fn(message.ID, &report) {
+				return nil
+			}
+		}
+		if len(messages) < usageQueueReadPageSize {
+			return nil
+		}
+	}
+}
+
+func isUsageQueueRetryableError(err error) bool {
+	if _, ok := err.(*persistence.ConditionFailedError); ok {
+		return true
+	}
+	return persistence.IsTransientError(err)
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2017-2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+func newUsageQueueMessage(t *testing.T, id int64, report *UsageReport) *persistence.QueueMessage {
+	payload, err := json.Marshal(report)
+	require.NoError(t, err)
+	return &persistence.QueueMessage{ID: id, QueueType: persistence.DomainUsageQueueType, Payload: payload}
+}
+
+func TestUsageQueueImpl_Publish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockQueue := persistence.NewMockQueueManager(ctrl)
+	q := NewUsageQueue(mockQueue, "testCluster")
+
+	report := &UsageReport{
+		Host:      "host",
+		StartTime: 1,
+		EndTime:   2,
+		Usage:     []*WorkflowUsage{{DomainID: "domainID", WorkflowType: "wfType", Signals: 3}},
+	}
+	// another host took the message ID, so the enqueue is retried
+	gomock.InOrder(
+		mockQueue.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).Return(&persistence.ConditionFailedError{}),
+		mockQueue.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, payload []byte) error {
+				var published UsageReport
+				require.NoError(t, json.Unmarshal(payload, &published))
+				assert.Equal(t, report, &published)
+				return nil
+			},
+		),
+	)
+	assert.NoError(t, q.Publish(context.Background(), report))
+
+	mockQueue.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).Return(errors.New("enqueue error"))
+	assert.Error(t, q.Publish(context.Background(), report))
+}
+
+func TestUsageQueueImpl_GetDomainUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockQueue := persistence.NewMockQueueManager(ctrl)
+	q := NewUsageQueue(mockQueue, "testCluster")
+
+	start := time.Unix(0, 100)
+	end := time.Unix(0, 200)
+	mockQueue.EXPECT().GetAckLevels(gomock.Any()).Return(map[string]int64{}, nil)
+	mockQueue.EXPECT().ReadMessages(gomock.Any(), int64(-1), usageQueueReadPageSize).Return(persistence.QueueMessageList{
+		// ended before the range
+		newUsageQueueMessage(t, 0, &UsageReport{StartTime: 50, EndTime: 100, Usage: []*WorkflowUsage{
+			{DomainID: "domainID", WorkflowType: "a", Signals: 100},
+		}}),
+		newUsageQueueMessage(t, 1, &UsageReport{StartTime: 90, EndTime: 150, Usage: []*WorkflowUsage{
+			{DomainID: "domainID", DomainName: "domain", WorkflowType: "b", Signals: 1, HistoryBytes: 10},
+			{DomainID: "otherDomainID", WorkflowType: "b", Signals: 100},
+		}}),
+		newUsageQueueMessage(t, 2, &UsageReport{StartTime: 150, EndTime: 210, Usage: []*WorkflowUsage{
+			{DomainID: "domainID", DomainName: "domain", WorkflowType: "b", Signals: 2, Timers: 1},
+			{DomainID: "domainID", DomainName: "domain", WorkflowType: "a", DecisionTasks: 4},
+		}}),
+		// started after the range
+		newUsageQueueMessage(t, 3, &UsageReport{StartTime: 200, EndTime: 250, Usage: []*WorkflowUsage{
+			{DomainID: "domainID", WorkflowType: "a", Signals: 100},
+		}}),
+	}, nil)
+
+	usage, err := q.GetDomainUsage(context.Background(), "domainID", start, end)
+	require.NoError(t, err)
+	assert.Equal(t, []*WorkflowUsage{
+		{DomainID: "domainID", DomainName: "domain", WorkflowType: "a", DecisionTasks: 4},
+		{DomainID: "domainID", DomainName: "domain", WorkflowType: "b", Signals: 3, Timers: 1, HistoryBytes: 10},
+	}, usage)
+
+	// the reports up to the ack level were purged, and only the reports published since the last query are read
+	mockQueue.EXPECT().GetAckLevels(gomock.Any()).Return(map[string]int64{"testCluster": 1}, nil)
+	mockQueue.EXPECT().ReadMessages(gomock.Any(), int64(3), usageQueueReadPageSize).Return(persistence.QueueMessageList{
+		newUsageQueueMessage(t, 4, &UsageReport{StartTime: 100, EndTime: 200, Usage: []*WorkflowUsage{
+			{DomainID: "otherDomainID", DomainName: "otherDomain", WorkflowType: "b", Signals: 1},
+		}}),
+	}, nil)
+	usage, err = q.GetDomainUsage(context.Background(), "otherDomainID", start, end)
+	require.NoError(t, err)
+	assert.Equal(t, []*WorkflowUsage{
+		{DomainID: "otherDomainID", DomainName: "otherDomain", WorkflowType: "b", Signals: 1},
+	}, usage)
+
+	mockQueue.EXPECT().GetAckLevels(gomock.Any()).Return(nil, errors.New("ack level error"))
+	_, err = q.GetDomainUsage(context.Background(), "domainID", start, end)
+	assert.Error(t, err)
+
+	mockQueue.EXPECT().GetAckLevels(gomock.Any()).Return(map[string]int64{"testCluster": 1}, nil)
+	mockQueue.EXPECT().ReadMessages(gomock.Any(), int64(4), usageQueueReadPageSize).Return(nil, errors.New("read error"))
+	_, err = q.GetDomainUsage(context.Background(), "domainID", start, end)
+	assert.Error(t, err)
+}
+
+func TestUsageQueueImpl_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockQueue := persistence.NewMockQueueManager(ctrl)
+	q := NewUsageQueue(mockQueue, "testCluster")
+
+	messages := persistence.QueueMessageList{
+		newUsageQueueMessage(t, 5, &UsageReport{StartTime: 50, EndTime: 100}),
+		newUsageQueueMessage(t, 6, &UsageReport{StartTime: 100, EndTime: 150}),
+		newUsageQueueMessage(t, 7, &UsageReport{StartTime: 150, EndTime: 200}),
+		// published late by another host, it is only purged once the report before it expired
+		newUsageQueueMessage(t, 8, &UsageReport{StartTime: 50, EndTime: 100}),
+	}
+	mockQueue.EXPECT().GetAckLevels(gomock.Any()).Return(map[string]int64{"testCluster": 4}, nil)
+	mockQueue.EXPECT().ReadMessages(gomock.Any(), int64(4), usageQueueReadPageSize).Return(messages, nil)
+	mockQueue.EXPECT().UpdateAckLevel(gomock.Any(), int64(6), "testCluster").Return(nil)
+	mockQueue.EXPECT().DeleteMessagesBefore(gomock.Any(), int64(7)).Return(nil)
+	assert.NoError(t, q.Purge(context.Background(), time.Unix(0, 160)))
+
+	// nothing expired
+	mockQueue.EXPECT().GetAckLevels(gomock.Any()).Return(map[string]int64{}, nil)
+	mockQueue.EXPECT().ReadMessages(gomock.Any(), int64(-1), usageQueueReadPageSize).Return(messages, nil)
+	assert.NoError(t, q.Purge(context.Background(), time.Unix(0, 50)))
+
+	mockQueue.EXPECT().GetAckLevels(gomock.Any()).Return(nil, errors.New("ack level error"))
+	assert.Error(t, q.Purge(context.Background(), time.Unix(0, 160)))
+}
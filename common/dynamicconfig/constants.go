@@ -361,25 +361,25 @@ const (
 	// KeyName: limit.historySize.error
 	// Value type: Int
 	// Default value: 209715200 (200*1024*1024)
-	// Allowed filters: DomainName
+	// Allowed filters: DomainName, WorkflowType
 	HistorySizeLimitError
 	// HistorySizeLimitWarn is the per workflow execution history size limit for warning
 	// KeyName: limit.historySize.warn
 	// Value type: Int
 	// Default value: 52428800 (50*1024*1024)
-	// Allowed filters: DomainName
+	// Allowed filters: DomainName, WorkflowType
 	HistorySizeLimitWarn
 	// HistoryCountLimitError is the per workflow execution history event count limit
 	// KeyName: limit.historyCount.error
 	// Value type: Int
 	// Default value: 204800 (200*1024)
-	// Allowed filters: DomainName
+	// Allowed filters: DomainName, WorkflowType
 	HistoryCountLimitError
 	// HistoryCountLimitWarn is the per workflow execution history event count limit for warning
 	// KeyName: limit.historyCount.warn
 	// Value type: Int
 	// Default value: 51200 (50*1024)
-	// Allowed filters: DomainName
+	// Allowed filters: DomainName, WorkflowType
 	HistoryCountLimitWarn
 	// PendingActivitiesCountLimitError is the limit of how many pending activities a workflow can have at a point in time
 	// KeyName: limit.pendingActivityCount.error
@@ -830,10 +830,22 @@ const (
 	TaskProcessRPS
 	// TaskSchedulerType is the task scheduler type for priority task processor
 	// KeyName: history.taskSchedulerType
-	// Value type: Int enum(1 for SchedulerTypeFIFO, 2 for SchedulerTypeWRR(weighted round robin scheduler implementation))
+	// Value type: Int enum(1 for SchedulerTypeFIFO, 2 for SchedulerTypeWRR(weighted round robin scheduler implementation), 3 for SchedulerTypeDRR(per domain deficit round robin scheduler implementation))
 	// Default value: 2 (task.SchedulerTypeWRR)
 	// Allowed filters: N/A
 	TaskSchedulerType
+	// TaskSchedulerDomainRoundRobinWeight is the number of tasks a domain can dispatch in each round of the deficit round robin task scheduler, relative to other domains
+	// KeyName: history.taskSchedulerDomainRoundRobinWeight
+	// Value type: Int
+	// Default value: 1
+	// Allowed filters: DomainName
+	TaskSchedulerDomainRoundRobinWeight
+	// TaskSchedulerDomainMaxConcurrency is the max number of tasks of a domain processed at the same time by the deficit round robin task scheduler, 0 means no limit
+	// KeyName: history.taskSchedulerDomainMaxConcurrency
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	TaskSchedulerDomainMaxConcurrency
 	// TaskSchedulerWorkerCount is the number of workers per host in task scheduler
 	// KeyName: history.taskSchedulerWorkerCount
 	// Value type: Int
@@ -1079,6 +1091,13 @@ const (
 	// Default value: 10000
 	// Allowed filters: DomainName
 	MaximumSignalsPerExecution
+	// MaximumPendingSignalsPerExecution is max number of signals a workflow can have waiting to be processed by a decision.
+	// Pending signals are the signals added after the last completed decision task was started, including buffered ones.
+	// KeyName: history.maximumPendingSignalsPerExecution
+	// Value type: Int
+	// Default value: 0 (no limit)
+	// Allowed filters: DomainName
+	MaximumPendingSignalsPerExecution
 	// NumArchiveSystemWorkflows is key for number of archive system workflows running in total
 	// KeyName: history.numArchiveSystemWorkflows
 	// Value type: Int
@@ -1164,6 +1183,12 @@ const (
 	// Default value: 1
 	// Allowed filters: N/A
 	MaxBufferedQueryCount
+	// ClosedWorkflowQueryCacheMaxSize is the max total size in bytes of the query results cached for a closed workflow
+	// KeyName: history.closedWorkflowQueryCacheMaxSize
+	// Value type: Int
+	// Default value: 262144 (256KB)
+	// Allowed filters: DomainName
+	ClosedWorkflowQueryCacheMaxSize
 	// MutableStateChecksumGenProbability is the probability [0-100] that checksum will be generated for mutable state
 	// KeyName: history.mutableStateChecksumGenProbability
 	// Value type: Int
@@ -1197,6 +1222,18 @@ const (
 	// Default value: 10
 	// Allowed filters: ShardID
 	ReplicationTaskProcessorErrorRetryMaxAttempts
+	// ReplicationDLQRedriveBatchSize is the number of DLQ messages read per shard in each re-drive round
+	// KeyName: history.ReplicationDLQRedriveBatchSize
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: ShardID
+	ReplicationDLQRedriveBatchSize
+	// ReplicationDLQRedriveDomainRPS is the per domain rate limit of re-driving replication DLQ messages on a shard
+	// KeyName: history.ReplicationDLQRedriveDomainRPS
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName
+	ReplicationDLQRedriveDomainRPS
 
 	// WorkflowIDExternalRPS is the rate limit per workflowID for external calls
 	// KeyName: history.workflowIDExternalRPS
@@ -1212,6 +1249,41 @@ const (
 	// Allowed filters: DomainName
 	WorkflowIDInternalRPS
 
+	// WorkflowIDSignalRPS is the rate limit per workflowID for SignalWorkflowExecution calls, applied on top of WorkflowIDExternalRPS
+	// KeyName: history.workflowIDSignalRPS
+	// Value type: Int
+	// Default value: UnlimitedRPS
+	// Allowed filters: DomainName
+	WorkflowIDSignalRPS
+
+	// WorkflowIDSignalWithStartRPS is the rate limit per workflowID for SignalWithStartWorkflowExecution calls, applied on top of WorkflowIDExternalRPS
+	// KeyName: history.workflowIDSignalWithStartRPS
+	// Value type: Int
+	// Default value: UnlimitedRPS
+	// Allowed filters: DomainName
+	WorkflowIDSignalWithStartRPS
+
+	// WorkflowIDStartRPS is the rate limit per workflowID for StartWorkflowExecution calls, applied on top of WorkflowIDExternalRPS
+	// KeyName: history.workflowIDStartRPS
+	// Value type: Int
+	// Default value: UnlimitedRPS
+	// Allowed filters: DomainName
+	WorkflowIDStartRPS
+
+	// WorkflowIDQueryRPS is the rate limit per workflowID for QueryWorkflow calls, applied on top of WorkflowIDExternalRPS
+	// KeyName: history.workflowIDQueryRPS
+	// Value type: Int
+	// Default value: UnlimitedRPS
+	// Allowed filters: DomainName
+	WorkflowIDQueryRPS
+
+	// WorkflowIDDescribeRPS is the rate limit per workflowID for DescribeWorkflowExecution calls, applied on top of WorkflowIDExternalRPS
+	// KeyName: history.workflowIDDescribeRPS
+	// Value type: Int
+	// Default value: UnlimitedRPS
+	// Allowed filters: DomainName
+	WorkflowIDDescribeRPS
+
 	// key for worker
 
 	// WorkerPersistenceMaxQPS is the max qps worker host can query DB
@@ -1635,6 +1707,13 @@ const (
 	// Default value: false
 	// Allowed filters: DomainID
 	QueueProcessorEnableStuckTaskSplitByDomainID
+	// QueueProcessorThrottleSplitDomainByDomainID indicates whether the queue level a domain is split into by
+	// history.queueProcessorSplitDomainLevels should only be polled at the poll backoff interval
+	// KeyName: history.queueProcessorThrottleSplitDomainByDomainID
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainID
+	QueueProcessorThrottleSplitDomainByDomainID
 	// QueueProcessorEnablePersistQueueStates indicates whether processing queue states should be persisted
 	// KeyName: history.queueProcessorEnablePersistQueueStates
 	// Value type: Bool
@@ -1659,6 +1738,13 @@ const (
 	// Default value: false
 	// Allowed filters: N/A
 	ReplicationTaskFetcherEnableGracefulSyncShutdown
+	// ReplicationTaskFetcherStreamEnabled indicates whether task fetcher should stream replication tasks from remote
+	// clusters instead of polling them. Only clusters connected over gRPC can be streamed from.
+	// KeyName: history.replicationTaskFetcherStreamEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	ReplicationTaskFetcherStreamEnabled
 	// TransferProcessorEnableValidator is whether validator should be enabled for transferQueueProcessor
 	// KeyName: history.transferProcessorEnableValidator
 	// Value type: Bool
@@ -1695,6 +1781,13 @@ const (
 	// Default value: false
 	// Allowed filters: DomainName
 	EnableConsistentQueryByDomain
+	// EnableClosedWorkflowQueryCache indicates if the query results pre-computed by the decision closing a workflow
+	// are kept in its mutable state and used to answer queries of the closed workflow without dispatching them to a worker
+	// KeyName: history.enableClosedWorkflowQueryCache
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableClosedWorkflowQueryCache
 	// EnableCrossClusterEngine is used as an overall switch for the cross-cluster feature, a feature which, if not enabled
 	// can be quite expensive in terms of resources
 	// KeyName: history.enableCrossClusterEngine
@@ -1732,6 +1825,12 @@ const (
 	// Default value: true
 	// Allowed filters: DomainID, WorkflowID
 	EnableReplicationTaskGeneration
+	// EnableReplicationDLQRedrive enables the background re-drive of replication DLQ messages
+	// KeyName: history.enableReplicationDLQRedrive
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableReplicationDLQRedrive
 	// UseNewInitialFailoverVersion is a switch to issue a failover version based on the minFailoverVersion
 	// rather than the default initialFailoverVersion. USed as a per-domain migration switch
 	// KeyName: history.useNewInitialFailoverVersion
@@ -1763,12 +1862,32 @@ const (
 	// Default value: false
 	// Allowed filters: DomainName
 	WorkflowIDExternalRateLimitEnabled
+	// WorkflowIDQueryRateLimitEnabled is the key to enable/disable rate limiting of QueryWorkflow calls per workflowID,
+	// queries are not subject to the workflowID rate limits while it is disabled
+	// KeyName: history.workflowIDQueryRateLimitEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	WorkflowIDQueryRateLimitEnabled
 	// WorkflowIDInternalRateLimitEnabled is the key to enable/disable rate limiting for workflowID specific information for internal requests
 	// KeyName: history.workflowIDInternalRateLimitEnabled
 	// Value type: Bool
 	// Default value: false
 	// Allowed filters: DomainName
 	WorkflowIDInternalRateLimitEnabled
+	// EnableWorkflowLifecycleEvents is whether workflow started, closed, signaled and activity failed events of a domain are published to the workflow lifecycle kafka topic
+	// KeyName: history.enableWorkflowLifecycleEvents
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableWorkflowLifecycleEvents
+	// EnableWorkflowUsageMetrics is whether history emits decision task, activity, signal, timer and history bytes
+	// counters of a domain tagged by workflow type and records them in the domain usage queue, used for capacity accounting
+	// KeyName: history.enableWorkflowUsageMetrics
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableWorkflowUsageMetrics
 	// AllowArchivingIncompleteHistory will continue on when seeing some error like history mutated(usually caused by database consistency issues)
 	// KeyName: worker.AllowArchivingIncompleteHistory
 	// Value type: Bool
@@ -1878,6 +1997,19 @@ const (
 	// Default value: false
 	// Allowed filters: N/A
 	EnableAsyncWorkflowConsumption
+	// EnableScheduler decides whether workflow schedules are enabled for a domain. Frontend rejects the schedule
+	// APIs and worker does not run the scheduler workflows of domains for which it is disabled
+	// KeyName: system.enableScheduler
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableScheduler
+	// EnableVisibilityReconciler decides whether to enable system workers for reconciling standby visibility records
+	// KeyName: worker.enableVisibilityReconciler
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableVisibilityReconciler
 
 	// EnableStickyQuery indicates if sticky query should be enabled per domain
 	// KeyName: system.enableStickyQuery
@@ -2312,6 +2444,20 @@ const (
 	// Default value: 10s (10*time.Second)
 	// Allowed filters: N/A
 	DomainFailoverRefreshInterval
+	// FrontendReplicationStreamIdleWait is the time a replication message stream waits before polling history
+	// again when none of the streamed shards had new tasks. It is on top of history.replicationMessagesLongPollTimeout.
+	// KeyName: frontend.replicationStreamIdleWait
+	// Value type: Duration
+	// Default value: 1s (1*time.Second)
+	// Allowed filters: N/A
+	FrontendReplicationStreamIdleWait
+	// FrontendReplicationStreamHeartbeatInterval is how often a replication message stream sends an empty batch
+	// for a shard without new tasks, so that the remote cluster keeps receiving the shard status.
+	// KeyName: frontend.replicationStreamHeartbeatInterval
+	// Value type: Duration
+	// Default value: 10s (10*time.Second)
+	// Allowed filters: N/A
+	FrontendReplicationStreamHeartbeatInterval
 
 	// MatchingLongPollExpirationInterval is the long poll expiration interval in the matching service
 	// KeyName: matching.longPollExpirationInterval
@@ -2362,6 +2508,13 @@ const (
 	// Default value: 1h (time.Hour)
 	// Allowed filters: N/A
 	HistoryCacheTTL
+	// HistoryCacheLongSleepThreshold is the minimum time until the next user timer fires or the run times out for the mutable state of
+	// an otherwise idle workflow to be dropped from the history cache when it's released. 0 disables the behavior
+	// KeyName: history.cacheLongSleepThreshold
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: N/A
+	HistoryCacheLongSleepThreshold
 	// HistoryShutdownDrainDuration is the duration of traffic drain during shutdown
 	// KeyName: history.shutdownDrainDuration
 	// Value type: Duration
@@ -2480,6 +2633,13 @@ const (
 	// Default value: 1s (1*time.Second)
 	// Allowed filters: N/A
 	TimerProcessorMaxTimeShift
+	// TimerProcessorFarFutureTimerHorizon is how far beyond the timer processor's max read level a timer has to be
+	// for the processor to stop looking it up on every poll and only remember when it fires. 0 disables the behavior
+	// KeyName: history.timerProcessorFarFutureTimerHorizon
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: N/A
+	TimerProcessorFarFutureTimerHorizon
 	// TransferProcessorFailoverMaxStartJitterInterval is the max jitter interval for starting transfer
 	// failover queue processing. The actual jitter interval used will be a random duration between
 	// 0 and the max interval so that timer failover queue across different shards won't start at
@@ -2636,6 +2796,21 @@ const (
 	// Default value: 60s (60 * time.Second)
 	// Allowed filters: N/A
 	ReplicationTaskFetcherServiceBusyWait
+	// ReplicationTaskFetcherStreamRetryWait is the time task fetcher polls remote cluster after its replication stream failed,
+	// before opening a new stream
+	// KeyName: history.ReplicationTaskFetcherStreamRetryWait
+	// Value type: Duration
+	// Default value: 1m (60 * time.Second)
+	// Allowed filters: N/A
+	ReplicationTaskFetcherStreamRetryWait
+	// ReplicationMessagesLongPollTimeout is the max time GetReplicationMessages waits for new replication tasks
+	// when none of the requested shards has tasks to return. Zero disables long polling.
+	// Pollers should lower history.ReplicationTaskProcessorNoTaskInitialWait when this is enabled.
+	// KeyName: history.replicationMessagesLongPollTimeout
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: N/A
+	ReplicationMessagesLongPollTimeout
 	// ReplicationTaskProcessorErrorRetryWait is the initial retry wait when we see errors in applying replication tasks
 	// KeyName: history.ReplicationTaskProcessorErrorRetryWait
 	// Value type: Duration
@@ -2678,6 +2853,37 @@ const (
 	// Default value: 5s (5* time.Second)
 	// Allowed filters: ShardID
 	ReplicationTaskProcessorStartWait
+	// ReplicationDLQRedriveInterval is the interval between two re-drive rounds of the replication DLQ of a shard
+	// KeyName: history.ReplicationDLQRedriveInterval
+	// Value type: Duration
+	// Default value: 1m (1* time.Minute)
+	// Allowed filters: ShardID
+	ReplicationDLQRedriveInterval
+	// ReplicationDLQRedriveInitialBackoff is the wait before retrying a DLQ message again after a transient failure
+	// KeyName: history.ReplicationDLQRedriveInitialBackoff
+	// Value type: Duration
+	// Default value: 1m (1* time.Minute)
+	// Allowed filters: ShardID
+	ReplicationDLQRedriveInitialBackoff
+	// ReplicationDLQRedriveMaxBackoff is the max wait before retrying a DLQ message after transient failures
+	// KeyName: history.ReplicationDLQRedriveMaxBackoff
+	// Value type: Duration
+	// Default value: 1h (1* time.Hour)
+	// Allowed filters: ShardID
+	ReplicationDLQRedriveMaxBackoff
+	// WorkflowUsageFlushInterval is the interval at which a history host appends the workflow usage it recorded
+	// to the domain usage queue
+	// KeyName: history.workflowUsageFlushInterval
+	// Value type: Duration
+	// Default value: 5m (5* time.Minute)
+	// Allowed filters: N/A
+	WorkflowUsageFlushInterval
+	// WorkflowUsageRetention is how long the workflow usage reports are kept in the domain usage queue
+	// KeyName: history.workflowUsageRetention
+	// Value type: Duration
+	// Default value: 168h (7* 24* time.Hour)
+	// Allowed filters: N/A
+	WorkflowUsageRetention
 	// WorkerESProcessorFlushInterval is flush interval for esProcessor
 	// KeyName: worker.ESProcessorFlushInterval
 	// Value type: Duration
@@ -2777,6 +2983,12 @@ const (
 	// Default value: see common.ConvertIntMapToDynamicConfigMapProperty(DefaultStuckTaskSplitThreshold) in code base
 	// Allowed filters: N/A
 	QueueProcessorStuckTaskSplitThreshold
+	// QueueProcessorSplitDomainLevels is the processing queue level specific domains should be split into
+	// KeyName: history.queueProcessorSplitDomainLevels
+	// Value type: Map (domainID -> queue level)
+	// Default value: empty map
+	// Allowed filters: N/A
+	QueueProcessorSplitDomainLevels
 
 	// LastMapKey must be the last one in this const group
 	LastMapKey
@@ -2871,25 +3083,25 @@ var IntKeys = map[IntKey]DynamicInt{
 	},
 	HistorySizeLimitError: {
 		KeyName:      "limit.historySize.error",
-		Filters:      []Filter{DomainName},
+		Filters:      []Filter{DomainName, WorkflowType},
 		Description:  "HistorySizeLimitError is the per workflow execution history size limit",
 		DefaultValue: 200 * 1024 * 1024,
 	},
 	HistorySizeLimitWarn: {
 		KeyName:      "limit.historySize.warn",
-		Filters:      []Filter{DomainName},
+		Filters:      []Filter{DomainName, WorkflowType},
 		Description:  "HistorySizeLimitWarn is the per workflow execution history size limit for warning",
 		DefaultValue: 50 * 1024 * 1024,
 	},
 	HistoryCountLimitError: {
 		KeyName:      "limit.historyCount.error",
-		Filters:      []Filter{DomainName},
+		Filters:      []Filter{DomainName, WorkflowType},
 		Description:  "HistoryCountLimitError is the per workflow execution history event count limit",
 		DefaultValue: 200 * 1024,
 	},
 	HistoryCountLimitWarn: {
 		KeyName:      "limit.historyCount.warn",
-		Filters:      []Filter{DomainName},
+		Filters:      []Filter{DomainName, WorkflowType},
 		Description:  "HistoryCountLimitWarn is the per workflow execution history event count limit for warning",
 		DefaultValue: 50 * 1024,
 	},
@@ -3305,6 +3517,18 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "TaskSchedulerType is the task scheduler type for priority task processor",
 		DefaultValue: 2, // int(task.SchedulerTypeWRR),
 	},
+	TaskSchedulerDomainRoundRobinWeight: {
+		KeyName:      "history.taskSchedulerDomainRoundRobinWeight",
+		Filters:      []Filter{DomainName},
+		Description:  "TaskSchedulerDomainRoundRobinWeight is the number of tasks a domain can dispatch in each round of the deficit round robin task scheduler, relative to other domains",
+		DefaultValue: 1,
+	},
+	TaskSchedulerDomainMaxConcurrency: {
+		KeyName:      "history.taskSchedulerDomainMaxConcurrency",
+		Filters:      []Filter{DomainName},
+		Description:  "TaskSchedulerDomainMaxConcurrency is the max number of tasks of a domain processed at the same time by the deficit round robin task scheduler, 0 means no limit",
+		DefaultValue: 0,
+	},
 	TaskSchedulerWorkerCount: {
 		KeyName:      "history.taskSchedulerWorkerCount",
 		Description:  "TaskSchedulerWorkerCount is the number of workers per host in task scheduler",
@@ -3502,6 +3726,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MaximumSignalsPerExecution is max number of signals supported by single execution",
 		DefaultValue: 10000, // 10K signals should big enough given workflow execution has 200K history lengh limit. It needs to be non-zero to protect continueAsNew from infinit loop
 	},
+	MaximumPendingSignalsPerExecution: {
+		KeyName:      "history.maximumPendingSignalsPerExecution",
+		Filters:      []Filter{DomainName},
+		Description:  "MaximumPendingSignalsPerExecution is max number of signals a workflow can have waiting to be processed by a decision, signals added after the last completed decision task was started",
+		DefaultValue: 0,
+	},
 	NumArchiveSystemWorkflows: {
 		KeyName:      "history.numArchiveSystemWorkflows",
 		Description:  "NumArchiveSystemWorkflows is key for number of archive system workflows running in total",
@@ -3576,6 +3806,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MaxBufferedQueryCount indicates the maximum number of queries which can be buffered at a given time for a single workflow",
 		DefaultValue: 1,
 	},
+	ClosedWorkflowQueryCacheMaxSize: {
+		KeyName:      "history.closedWorkflowQueryCacheMaxSize",
+		Filters:      []Filter{DomainName},
+		Description:  "ClosedWorkflowQueryCacheMaxSize is the max total size in bytes of the query results cached for a closed workflow",
+		DefaultValue: 256 * 1024,
+	},
 	MutableStateChecksumGenProbability: {
 		KeyName:      "history.mutableStateChecksumGenProbability",
 		Filters:      []Filter{DomainName},
@@ -3604,6 +3840,18 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "ReplicationTaskProcessorErrorRetryMaxAttempts is the max retry attempts for applying replication tasks",
 		DefaultValue: 10,
 	},
+	ReplicationDLQRedriveBatchSize: {
+		KeyName:      "history.ReplicationDLQRedriveBatchSize",
+		Filters:      []Filter{ShardID},
+		Description:  "ReplicationDLQRedriveBatchSize is the number of DLQ messages read per shard in each re-drive round",
+		DefaultValue: 100,
+	},
+	ReplicationDLQRedriveDomainRPS: {
+		KeyName:      "history.ReplicationDLQRedriveDomainRPS",
+		Filters:      []Filter{DomainName},
+		Description:  "ReplicationDLQRedriveDomainRPS is the per domain rate limit of re-driving replication DLQ messages on a shard",
+		DefaultValue: 10,
+	},
 	WorkflowIDExternalRPS: {
 		KeyName:      "history.workflowIDExternalRPS",
 		Filters:      []Filter{DomainName},
@@ -3616,6 +3864,36 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "WorkflowIDInternalRPS is the rate limit per workflowID for internal calls",
 		DefaultValue: UnlimitedRPS,
 	},
+	WorkflowIDSignalRPS: {
+		KeyName:      "history.workflowIDSignalRPS",
+		Filters:      []Filter{DomainName},
+		Description:  "WorkflowIDSignalRPS is the rate limit per workflowID for SignalWorkflowExecution calls, applied on top of WorkflowIDExternalRPS",
+		DefaultValue: UnlimitedRPS,
+	},
+	WorkflowIDSignalWithStartRPS: {
+		KeyName:      "history.workflowIDSignalWithStartRPS",
+		Filters:      []Filter{DomainName},
+		Description:  "WorkflowIDSignalWithStartRPS is the rate limit per workflowID for SignalWithStartWorkflowExecution calls, applied on top of WorkflowIDExternalRPS",
+		DefaultValue: UnlimitedRPS,
+	},
+	WorkflowIDStartRPS: {
+		KeyName:      "history.workflowIDStartRPS",
+		Filters:      []Filter{DomainName},
+		Description:  "WorkflowIDStartRPS is the rate limit per workflowID for StartWorkflowExecution calls, applied on top of WorkflowIDExternalRPS",
+		DefaultValue: UnlimitedRPS,
+	},
+	WorkflowIDQueryRPS: {
+		KeyName:      "history.workflowIDQueryRPS",
+		Filters:      []Filter{DomainName},
+		Description:  "WorkflowIDQueryRPS is the rate limit per workflowID for QueryWorkflow calls, applied on top of WorkflowIDExternalRPS",
+		DefaultValue: UnlimitedRPS,
+	},
+	WorkflowIDDescribeRPS: {
+		KeyName:      "history.workflowIDDescribeRPS",
+		Filters:      []Filter{DomainName},
+		Description:  "WorkflowIDDescribeRPS is the rate limit per workflowID for DescribeWorkflowExecution calls, applied on top of WorkflowIDExternalRPS",
+		DefaultValue: UnlimitedRPS,
+	},
 	WorkerPersistenceMaxQPS: {
 		KeyName:      "worker.persistenceMaxQPS",
 		Description:  "WorkerPersistenceMaxQPS is the max qps worker host can query DB",
@@ -4001,6 +4279,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "QueueProcessorEnableStuckTaskSplitByDomainID indicates whether stuck task split policy should be enabled",
 		DefaultValue: false,
 	},
+	QueueProcessorThrottleSplitDomainByDomainID: {
+		KeyName:      "history.queueProcessorThrottleSplitDomainByDomainID",
+		Filters:      []Filter{DomainID},
+		Description:  "QueueProcessorThrottleSplitDomainByDomainID indicates whether the queue level a domain is split into by history.queueProcessorSplitDomainLevels should only be polled at the poll backoff interval",
+		DefaultValue: false,
+	},
 	QueueProcessorEnablePersistQueueStates: {
 		KeyName:      "history.queueProcessorEnablePersistQueueStates",
 		Description:  "QueueProcessorEnablePersistQueueStates indicates whether processing queue states should be persisted",
@@ -4021,6 +4305,11 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "ReplicationTaskFetcherEnableGracefulSyncShutdown is whether we should gracefully drain replication task fetcher on shutdown",
 		DefaultValue: false,
 	},
+	ReplicationTaskFetcherStreamEnabled: {
+		KeyName:      "history.replicationTaskFetcherStreamEnabled",
+		Description:  "ReplicationTaskFetcherStreamEnabled is whether task fetcher should stream replication tasks from remote clusters instead of polling them",
+		DefaultValue: false,
+	},
 	TransferProcessorEnableValidator: {
 		KeyName:      "history.transferProcessorEnableValidator",
 		Description:  "TransferProcessorEnableValidator is whether validator should be enabled for transferQueueProcessor",
@@ -4054,6 +4343,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableConsistentQueryByDomain indicates if consistent query is enabled for a domain",
 		DefaultValue: false,
 	},
+	EnableClosedWorkflowQueryCache: {
+		KeyName:      "history.enableClosedWorkflowQueryCache",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableClosedWorkflowQueryCache indicates if the query results pre-computed by the decision closing a workflow are kept in its mutable state and used to answer queries of the closed workflow without dispatching them to a worker",
+		DefaultValue: false,
+	},
 	EnableCrossClusterEngine: {
 		KeyName:      "history.enableCrossClusterEngine",
 		Description:  "an overall toggle for the cross-cluster domain feature",
@@ -4089,6 +4384,11 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableReplicationTaskGeneration is the flag to control replication generation",
 		DefaultValue: true,
 	},
+	EnableReplicationDLQRedrive: {
+		KeyName:      "history.enableReplicationDLQRedrive",
+		Description:  "EnableReplicationDLQRedrive enables the background re-drive of replication DLQ messages",
+		DefaultValue: false,
+	},
 	UseNewInitialFailoverVersion: {
 		KeyName:      "history.useNewInitialFailoverVersion",
 		Description:  "use the minInitialFailover version",
@@ -4184,6 +4484,17 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableAsyncWorkflowConsumption decides whether to enable async workflows",
 		DefaultValue: false,
 	},
+	EnableScheduler: {
+		KeyName:      "system.enableScheduler",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableScheduler decides whether workflow schedules are enabled for a domain",
+		DefaultValue: false,
+	},
+	EnableVisibilityReconciler: {
+		KeyName:      "worker.enableVisibilityReconciler",
+		Description:  "EnableVisibilityReconciler decides whether to enable system workers for reconciling standby visibility records",
+		DefaultValue: false,
+	},
 	EnableStickyQuery: {
 		KeyName:      "system.enableStickyQuery",
 		Filters:      []Filter{DomainName},
@@ -4317,12 +4628,30 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "WorkflowIDExternalRateLimitEnabled is the key to enable/disable rate limiting of specific workflowIDs for external requests",
 		DefaultValue: false,
 	},
+	WorkflowIDQueryRateLimitEnabled: {
+		KeyName:      "history.workflowIDQueryRateLimitEnabled",
+		Filters:      []Filter{DomainName},
+		Description:  "WorkflowIDQueryRateLimitEnabled is the key to enable/disable rate limiting of QueryWorkflow calls per workflowID, queries are not subject to the workflowID rate limits while it is disabled",
+		DefaultValue: false,
+	},
 	WorkflowIDInternalRateLimitEnabled: {
 		KeyName:      "history.workflowIDInternalRateLimitEnabled",
 		Filters:      []Filter{DomainName},
 		Description:  "WorkflowIDInternalRateLimitEnabled is the key to enable/disable rate limiting of specific workflowIDs for internal requests",
 		DefaultValue: false,
 	},
+	EnableWorkflowLifecycleEvents: {
+		KeyName:      "history.enableWorkflowLifecycleEvents",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableWorkflowLifecycleEvents is whether workflow started, closed, signaled and activity failed events of a domain are published to the workflow lifecycle kafka topic",
+		DefaultValue: false,
+	},
+	EnableWorkflowUsageMetrics: {
+		KeyName:      "history.enableWorkflowUsageMetrics",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableWorkflowUsageMetrics is whether history emits decision task, activity, signal, timer and history bytes counters of a domain tagged by workflow type and records them in the domain usage queue, used for capacity accounting",
+		DefaultValue: false,
+	},
 	EnableRetryForChecksumFailure: {
 		KeyName:      "history.enableMutableStateChecksumFailureRetry",
 		Filters:      []Filter{DomainName},
@@ -4607,6 +4936,16 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "DomainFailoverRefreshInterval is the domain failover refresh timer",
 		DefaultValue: time.Second * 10,
 	},
+	FrontendReplicationStreamIdleWait: {
+		KeyName:      "frontend.replicationStreamIdleWait",
+		Description:  "FrontendReplicationStreamIdleWait is the time a replication message stream waits before polling history again when none of the streamed shards had new tasks",
+		DefaultValue: time.Second,
+	},
+	FrontendReplicationStreamHeartbeatInterval: {
+		KeyName:      "frontend.replicationStreamHeartbeatInterval",
+		Description:  "FrontendReplicationStreamHeartbeatInterval is how often a replication message stream sends an empty batch for a shard without new tasks",
+		DefaultValue: time.Second * 10,
+	},
 	MatchingLongPollExpirationInterval: {
 		KeyName:      "matching.longPollExpirationInterval",
 		Filters:      []Filter{DomainName, TaskListName, TaskType},
@@ -4653,6 +4992,11 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "HistoryCacheTTL is TTL of history cache",
 		DefaultValue: time.Hour,
 	},
+	HistoryCacheLongSleepThreshold: {
+		KeyName:      "history.cacheLongSleepThreshold",
+		Description:  "HistoryCacheLongSleepThreshold is the minimum time until the next user timer fires or the run times out for the mutable state of an otherwise idle workflow to be dropped from the history cache when it's released. 0 disables the behavior",
+		DefaultValue: 0,
+	},
 	HistoryShutdownDrainDuration: {
 		KeyName:      "history.shutdownDrainDuration",
 		Description:  "HistoryShutdownDrainDuration is the duration of traffic drain during shutdown",
@@ -4751,6 +5095,11 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "TimerProcessorMaxTimeShift is the max shift timer processor can have",
 		DefaultValue: time.Second,
 	},
+	TimerProcessorFarFutureTimerHorizon: {
+		KeyName:      "history.timerProcessorFarFutureTimerHorizon",
+		Description:  "TimerProcessorFarFutureTimerHorizon is how far beyond the timer processor's max read level a timer has to be for the processor to stop looking it up on every poll and only remember when it fires. 0 disables the behavior",
+		DefaultValue: 0,
+	},
 	TransferProcessorFailoverMaxStartJitterInterval: {
 		KeyName:      "history.transferProcessorFailoverMaxStartJitterInterval",
 		Description:  "TransferProcessorFailoverMaxStartJitterInterval is the max jitter interval for starting transfer failover queue processing. The actual jitter interval used will be a random duration between 0 and the max interval so that timer failover queue across different shards won't start at the same time",
@@ -4880,6 +5229,16 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "ReplicationTaskFetcherServiceBusyWait is the wait time when fetcher encounters service busy error",
 		DefaultValue: time.Minute,
 	},
+	ReplicationTaskFetcherStreamRetryWait: {
+		KeyName:      "history.ReplicationTaskFetcherStreamRetryWait",
+		Description:  "ReplicationTaskFetcherStreamRetryWait is the time task fetcher polls remote cluster after its replication stream failed, before opening a new stream",
+		DefaultValue: time.Minute,
+	},
+	ReplicationMessagesLongPollTimeout: {
+		KeyName:      "history.replicationMessagesLongPollTimeout",
+		Description:  "ReplicationMessagesLongPollTimeout is the max time GetReplicationMessages waits for new replication tasks when none of the requested shards has tasks to return. Zero disables long polling.",
+		DefaultValue: time.Duration(0),
+	},
 	ReplicationTaskProcessorErrorRetryWait: {
 		KeyName:      "history.ReplicationTaskProcessorErrorRetryWait",
 		Filters:      []Filter{ShardID},
@@ -4922,6 +5281,34 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "ReplicationTaskProcessorStartWait is the wait time before each task processing batch",
 		DefaultValue: time.Second * 5,
 	},
+	ReplicationDLQRedriveInterval: {
+		KeyName:      "history.ReplicationDLQRedriveInterval",
+		Filters:      []Filter{ShardID},
+		Description:  "ReplicationDLQRedriveInterval is the interval between two re-drive rounds of the replication DLQ of a shard",
+		DefaultValue: time.Minute,
+	},
+	ReplicationDLQRedriveInitialBackoff: {
+		KeyName:      "history.ReplicationDLQRedriveInitialBackoff",
+		Filters:      []Filter{ShardID},
+		Description:  "ReplicationDLQRedriveInitialBackoff is the wait before retrying a DLQ message again after a transient failure",
+		DefaultValue: time.Minute,
+	},
+	ReplicationDLQRedriveMaxBackoff: {
+		KeyName:      "history.ReplicationDLQRedriveMaxBackoff",
+		Filters:      []Filter{ShardID},
+		Description:  "ReplicationDLQRedriveMaxBackoff is the max wait before retrying a DLQ message after transient failures",
+		DefaultValue: time.Hour,
+	},
+	WorkflowUsageFlushInterval: {
+		KeyName:      "history.workflowUsageFlushInterval",
+		Description:  "WorkflowUsageFlushInterval is the interval at which a history host appends the workflow usage it recorded to the domain usage queue",
+		DefaultValue: time.Minute * 5,
+	},
+	WorkflowUsageRetention: {
+		KeyName:      "history.workflowUsageRetention",
+		Description:  "WorkflowUsageRetention is how long the workflow usage reports are kept in the domain usage queue",
+		DefaultValue: time.Hour * 24 * 7,
+	},
 	WorkerESProcessorFlushInterval: {
 		KeyName:      "worker.ESProcessorFlushInterval",
 		Description:  "WorkerESProcessorFlushInterval is flush interval for esProcessor",
@@ -5005,6 +5392,11 @@ var MapKeys = map[MapKey]DynamicMap{
 		Description:  "QueueProcessorStuckTaskSplitThreshold is the threshold for the number of attempts of a task",
 		DefaultValue: common.ConvertIntMapToDynamicConfigMapProperty(map[int]int{0: 100, 1: 10000}),
 	},
+	QueueProcessorSplitDomainLevels: {
+		KeyName:      "history.queueProcessorSplitDomainLevels",
+		Description:  "QueueProcessorSplitDomainLevels is the processing queue level specific domains should be split into",
+		DefaultValue: map[string]interface{}{},
+	},
 }
 
 var ListKeys = map[ListKey]DynamicList{
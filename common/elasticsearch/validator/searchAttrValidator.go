@@ -100,7 +100,7 @@ func (sv *SearchAttributesValidator) ValidateSearchAttributes(input *types.Searc
 			}
 		}
 		// verify: key is not system reserved
-		if definition.IsSystemIndexedKey(key) {
+		if definition.IsSystemIndexedKey(key) || definition.IsServerManagedKey(key) {
 			sv.logger.WithTags(tag.ESKey(key), tag.WorkflowDomainName(domain)).
 				Error("illegal update of system reserved attribute")
 			return &types.BadRequestError{Message: fmt.Sprintf("%s is read-only Cadence reservered attribute", key)}
@@ -0,0 +1,104 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package lifecycle defines the workflow lifecycle stream: a change data capture feed of workflow
+// started, closed, activity failed and signaled events which history publishes to the kafka topic
+// configured for the "workflow-lifecycle" application, for domains that enable
+// history.enableWorkflowLifecycleEvents.
+//
+// Every event is published by a transfer task of the cluster where it happened: started and closed
+// events by the tasks which record the workflow in visibility, activity failed and signaled events
+// by a RecordWorkflowLifecycleEvent task persisted together with the request which caused them.
+// A task is only acked once its event is published, so delivery is at-least-once: a failed publish
+// retries the task and an event can be published more than once.
+// Consumers should dedupe on (RunID, EventType, EventID, Attempt).
+//
+// Messages are keyed by WorkflowID, so all events of a workflow land on the same partition, and
+// the payload is the JSON encoding of Event.
+package lifecycle
+
+const (
+	// SchemaVersion is the version of the Event payload. It is bumped on every incompatible change
+	// of the schema; new fields can be added without bumping it.
+	SchemaVersion = 1
+)
+
+const (
+	// EventTypeWorkflowStarted is published when a workflow run is started
+	EventTypeWorkflowStarted EventType = "WorkflowStarted"
+	// EventTypeWorkflowClosed is published when a workflow run is closed, whatever the close status
+	EventTypeWorkflowClosed EventType = "WorkflowClosed"
+	// EventTypeActivityFailed is published when a worker reports an activity attempt as failed,
+	// whether or not the activity is retried
+	EventTypeActivityFailed EventType = "ActivityFailed"
+	// EventTypeWorkflowSignaled is published when a signal is added to a workflow run
+	EventTypeWorkflowSignaled EventType = "WorkflowSignaled"
+)
+
+type (
+	// EventType is the type of a workflow lifecycle event
+	EventType string
+
+	// Event is the payload published to the workflow lifecycle stream.
+	// All timestamps are unix nanoseconds. ExecutionTime is 0 unless the first decision of the run
+	// is delayed, e.g. by a cron schedule or a retry backoff.
+	Event struct {
+		// Version is the SchemaVersion the event was encoded with
+		Version int `json:"version"`
+		// EventType is one of the EventType constants
+		EventType EventType `json:"eventType"`
+		// TaskID is the ID of the transfer task which published the event
+		TaskID int64 `json:"taskID,omitempty"`
+		// EventID identifies an activity failed or signaled event within the run, together with the Attempt
+		// of the activity. It is the scheduled event ID of the activity or the sequence number of the signal.
+		EventID int64 `json:"eventID,omitempty"`
+
+		DomainID      string `json:"domainID"`
+		DomainName    string `json:"domainName"`
+		WorkflowID    string `json:"workflowID"`
+		RunID         string `json:"runID"`
+		WorkflowType  string `json:"workflowType"`
+		TaskList      string `json:"taskList"`
+		IsCron        bool   `json:"isCron"`
+		StartTime     int64  `json:"startTime"`
+		ExecutionTime int64  `json:"executionTime"`
+
+		// CloseTime, CloseStatus and HistoryLength are only set for EventTypeWorkflowClosed.
+		// CloseStatus is one of the types.WorkflowExecutionCloseStatus names, e.g. COMPLETED.
+		CloseTime     int64  `json:"closeTime,omitempty"`
+		CloseStatus   string `json:"closeStatus,omitempty"`
+		HistoryLength int64  `json:"historyLength,omitempty"`
+
+		// ActivityID, ActivityType, Attempt, FailureReason and WillRetry are only set for EventTypeActivityFailed.
+		// ActivityType is empty when the activity is failed by its ID rather than its task token.
+		ActivityID    string `json:"activityID,omitempty"`
+		ActivityType  string `json:"activityType,omitempty"`
+		Attempt       int32  `json:"attempt,omitempty"`
+		FailureReason string `json:"failureReason,omitempty"`
+		WillRetry     bool   `json:"willRetry,omitempty"`
+
+		// SignalName is only set for EventTypeWorkflowSignaled, Identity is the identity of the caller
+		// which failed the activity or signaled the workflow
+		SignalName string `json:"signalName,omitempty"`
+		Identity   string `json:"identity,omitempty"`
+	}
+)
@@ -0,0 +1,75 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -package=$GOPACKAGE -destination=publisher_mock.go github.com/uber/cadence/common/lifecycle Publisher
+
+package lifecycle
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/messaging"
+)
+
+type (
+	// Publisher publishes workflow lifecycle events of the domains which opted in to the stream
+	Publisher interface {
+		Publish(ctx context.Context, event *Event) error
+	}
+
+	publisherImpl struct {
+		producer messaging.Producer
+		enabled  dynamicconfig.BoolPropertyFnWithDomainFilter
+	}
+
+	noopPublisher struct{}
+)
+
+// NewPublisher creates a Publisher which sends the events of the domains enabled by the given
+// property to the producer
+func NewPublisher(
+	producer messaging.Producer,
+	enabled dynamicconfig.BoolPropertyFnWithDomainFilter,
+) Publisher {
+	return &publisherImpl{
+		producer: producer,
+		enabled:  enabled,
+	}
+}
+
+// NewNoopPublisher creates a Publisher which drops all events
+func NewNoopPublisher() Publisher {
+	return &noopPublisher{}
+}
+
+func (p *publisherImpl) Publish(ctx context.Context, event *Event) error {
+	if !p.enabled(event.DomainName) {
+		return nil
+	}
+	event.Version = SchemaVersion
+	return p.producer.Publish(ctx, event)
+}
+
+func (p *noopPublisher) Publish(_ context.Context, _ *Event) error {
+	return nil
+}
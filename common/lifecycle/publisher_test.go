@@ -0,0 +1,66 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeProducer struct {
+	published []interface{}
+	err       error
+}
+
+func (p *fakeProducer) Publish(_ context.Context, msg interface{}) error {
+	p.published = append(p.published, msg)
+	return p.err
+}
+
+func TestPublisher_Publish(t *testing.T) {
+	producer := &fakeProducer{}
+	publisher := NewPublisher(producer, func(domainName string) bool {
+		return domainName == "enabled-domain"
+	})
+
+	err := publisher.Publish(context.Background(), &Event{EventType: EventTypeWorkflowStarted, DomainName: "disabled-domain"})
+	assert.NoError(t, err)
+	assert.Empty(t, producer.published)
+
+	event := &Event{EventType: EventTypeWorkflowStarted, DomainName: "enabled-domain", WorkflowID: "wid"}
+	err = publisher.Publish(context.Background(), event)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{event}, producer.published)
+	assert.Equal(t, SchemaVersion, event.Version)
+
+	producer.err = errors.New("publish failed")
+	err = publisher.Publish(context.Background(), &Event{EventType: EventTypeWorkflowClosed, DomainName: "enabled-domain"})
+	assert.Equal(t, producer.err, err)
+}
+
+func TestNoopPublisher_Publish(t *testing.T) {
+	assert.NoError(t, NewNoopPublisher().Publish(context.Background(), &Event{DomainName: "some-domain"}))
+}
@@ -118,6 +118,11 @@ func WorkflowType(wfType string) Tag {
 	return newStringTag("wf-type", wfType)
 }
 
+// ScheduleID returns tag for the ID of a workflow schedule
+func ScheduleID(scheduleID string) Tag {
+	return newStringTag("schedule-id", scheduleID)
+}
+
 // WorkflowSignalName returns tag for WorkflowSignalName
 func WorkflowSignalName(signalName string) Tag {
 	return newStringTag("wf-signal-name", signalName)
@@ -284,6 +289,21 @@ func WorkflowBranchID(branchID string) Tag {
 	return newStringTag("wf-branch-id", branchID)
 }
 
+// WorkflowBranchToken returns tag for WorkflowBranchToken
+func WorkflowBranchToken(branchToken []byte) Tag {
+	return newObjectTag("wf-branch-token", branchToken)
+}
+
+// WorkflowNewBranchToken returns tag for WorkflowNewBranchToken
+func WorkflowNewBranchToken(branchToken []byte) Tag {
+	return newObjectTag("wf-new-branch-token", branchToken)
+}
+
+// WorkflowBranchSwitchReason returns tag for WorkflowBranchSwitchReason
+func WorkflowBranchSwitchReason(reason string) Tag {
+	return newStringTag("wf-branch-switch-reason", reason)
+}
+
 // workflow task
 
 // WorkflowDecisionType returns tag for WorkflowDecisionType
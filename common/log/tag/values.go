@@ -36,6 +36,8 @@ var (
 	WorkflowActionWorkflowSignaled               = workflowAction("add-workflow-signaled-event")
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
+	WorkflowActionWorkflowPaused                 = workflowAction("add-workflow-paused-event")
+	WorkflowActionWorkflowUnpaused               = workflowAction("add-workflow-unpaused-event")
 
 	// decision
 	WorkflowActionDecisionTaskScheduled = workflowAction("add-decisiontask-scheduled-event")
@@ -58,6 +60,10 @@ var (
 	WorkflowActionActivityTaskCancelRequested = workflowAction("add-activitytask-cancel-requested-event")
 	WorkflowActionActivityTaskCancelFailed    = workflowAction("add-activitytask-cancel-failed-event")
 	WorkflowActionActivityTaskRetry           = workflowAction("add-activitytask-retry-event")
+	WorkflowActionActivityTaskPaused          = workflowAction("pause-activitytask")
+	WorkflowActionActivityTaskUnpaused        = workflowAction("unpause-activitytask")
+	WorkflowActionActivityTaskAttemptReset    = workflowAction("reset-activitytask-attempt")
+	WorkflowActionActivityTaskOptionsUpdated  = workflowAction("update-activitytask-options")
 
 	// timer
 	WorkflowActionTimerStarted      = workflowAction("add-timer-started-event")
@@ -134,6 +140,8 @@ var (
 	ComponentShardFixer                 = component("shardscanner-fixer")
 	ComponentPinotVisibilityManager     = component("pinot-visibility-manager")
 	ComponentAsyncWFConsumptionManager  = component("async-wf-consumption-manager")
+	ComponentScheduler                  = component("scheduler")
+	ComponentWorkflowUsageRecorder      = component("workflow-usage-recorder")
 )
 
 // Pre-defined values for TagSysLifecycle
@@ -288,6 +296,8 @@ var (
 	AdminClientOperationCloseShard                            = clientOperation("admin-close-shard")
 	AdminClientOperationResetQueue                            = clientOperation("admin-reset-queue")
 	AdminClientOperationDescribeQueue                         = clientOperation("admin-describe-queue")
+	AdminClientOperationListThrottledWorkflowIDs              = clientOperation("admin-list-throttled-workflow-ids")
+	AdminClientOperationGetDomainUsage                        = clientOperation("admin-get-domain-usage")
 	AdminClientOperationDescribeWorkflowExecution             = clientOperation("admin-describe-wf-execution")
 	AdminClientOperationGetWorkflowExecutionRawHistoryV2      = clientOperation("admin-get-wf-execution-raw-history-v2")
 	AdminClientOperationDescribeCluster                       = clientOperation("admin-describe-cluster")
@@ -301,7 +311,12 @@ var (
 	AdminClientOperationPurgeDLQMessages                      = clientOperation("admin-purge-dlq-messsages")
 	AdminClientOperationMergeDLQMessages                      = clientOperation("admin-merge-dlq-messsages")
 	AdminClientOperationRefreshWorkflowTasks                  = clientOperation("admin-refresh-wf-tasks")
+	AdminClientOperationPauseActivity                         = clientOperation("admin-pause-activity")
+	AdminClientOperationUnpauseActivity                       = clientOperation("admin-unpause-activity")
+	AdminClientOperationResetActivity                         = clientOperation("admin-reset-activity")
+	AdminClientOperationUpdateActivityOptions                 = clientOperation("admin-update-activity-options")
 	AdminClientOperationResendReplicationTasks                = clientOperation("admin-resend-replication-tasks")
+	AdminClientOperationImportWorkflowExecution               = clientOperation("admin-import-workflow-execution")
 	AdminClientOperationGetCrossClusterTasks                  = clientOperation("admin-get-cross-cluster-tasks")
 	AdminClientOperationRespondCrossClusterTasksCompleted     = clientOperation("admin-respond-cross-cluster-tasks-completed")
 	AdminClientOperationGetDynamicConfig                      = clientOperation("admin-get-dynamic-config")
@@ -322,6 +337,7 @@ var (
 	FrontendClientOperationDescribeDomain                        = clientOperation("frontend-describe-domain")
 	FrontendClientOperationDescribeTaskList                      = clientOperation("frontend-describe-task-list")
 	FrontendClientOperationDescribeWorkflowExecution             = clientOperation("frontend-describe-wf-execution")
+	FrontendClientOperationExecuteWorkflowExecution              = clientOperation("frontend-execute-wf-execution")
 	FrontendClientOperationGetWorkflowExecutionHistory           = clientOperation("frontend-get-wf-execution-history")
 	FrontendClientOperationListArchivedWorkflowExecutions        = clientOperation("frontend-list-archived-wf-executions")
 	FrontendClientOperationListClosedWorkflowExecutions          = clientOperation("frontend-list-closed-wf-executions")
@@ -357,7 +373,22 @@ var (
 	FrontendClientOperationStartWorkflowExecution                = clientOperation("frontend-start-wf-execution")
 	FrontendClientOperationStartWorkflowExecutionAsync           = clientOperation("frontend-start-wf-execution-async")
 	FrontendClientOperationTerminateWorkflowExecution            = clientOperation("frontend-terminate-wf-execution")
+	FrontendClientOperationPauseWorkflowExecution                = clientOperation("frontend-pause-wf-execution")
+	FrontendClientOperationUnpauseWorkflowExecution              = clientOperation("frontend-unpause-wf-execution")
+	FrontendClientOperationPauseActivity                         = clientOperation("frontend-pause-activity")
+	FrontendClientOperationUnpauseActivity                       = clientOperation("frontend-unpause-activity")
+	FrontendClientOperationResetActivity                         = clientOperation("frontend-reset-activity")
+	FrontendClientOperationUpdateActivityOptions                 = clientOperation("frontend-update-activity-options")
 	FrontendClientOperationUpdateDomain                          = clientOperation("frontend-update-domain")
+	FrontendClientOperationCreateSchedule                        = clientOperation("frontend-create-schedule")
+	FrontendClientOperationDescribeSchedule                      = clientOperation("frontend-describe-schedule")
+	FrontendClientOperationUpdateSchedule                        = clientOperation("frontend-update-schedule")
+	FrontendClientOperationPauseSchedule                         = clientOperation("frontend-pause-schedule")
+	FrontendClientOperationUnpauseSchedule                       = clientOperation("frontend-unpause-schedule")
+	FrontendClientOperationTriggerSchedule                       = clientOperation("frontend-trigger-schedule")
+	FrontendClientOperationBackfillSchedule                      = clientOperation("frontend-backfill-schedule")
+	FrontendClientOperationDeleteSchedule                        = clientOperation("frontend-delete-schedule")
+	FrontendClientOperationListSchedules                         = clientOperation("frontend-list-schedules")
 	FrontendClientOperationGetClusterInfo                        = clientOperation("frontend-get-cluster-info")
 	FrontendClientOperationListTaskListPartitions                = clientOperation("frontend-list-task-list-partitions")
 	FrontendClientOperationGetTaskListsByDomain                  = clientOperation("frontend-get-task-list-for-domain")
@@ -367,6 +398,7 @@ var (
 	HistoryClientOperationCloseShard                        = clientOperation("history-close-shard")
 	HistoryClientOperationResetQueue                        = clientOperation("history-reset-queue")
 	HistoryClientOperationDescribeQueue                     = clientOperation("history-describe-queue")
+	HistoryClientOperationListThrottledWorkflowIDs          = clientOperation("history-list-throttled-workflow-ids")
 	HistoryClientOperationRemoveTask                        = clientOperation("history-remove-task")
 	HistoryClientOperationDescribeMutableState              = clientOperation("history-describe-mutable-state")
 	HistoryClientOperationGetMutableState                   = clientOperation("history-get-mutable-state")
@@ -386,6 +418,12 @@ var (
 	HistoryClientOperationSignalWithStartWorkflowExecution  = clientOperation("history-signal-with-start-wf-execution")
 	HistoryClientOperationRemoveSignalMutableState          = clientOperation("history-remove-signal-mutable-state")
 	HistoryClientOperationTerminateWorkflowExecution        = clientOperation("history-terminate-wf-execution")
+	HistoryClientOperationPauseWorkflowExecution            = clientOperation("history-pause-wf-execution")
+	HistoryClientOperationUnpauseWorkflowExecution          = clientOperation("history-unpause-wf-execution")
+	HistoryClientOperationPauseActivity                     = clientOperation("history-pause-activity")
+	HistoryClientOperationUnpauseActivity                   = clientOperation("history-unpause-activity")
+	HistoryClientOperationResetActivity                     = clientOperation("history-reset-activity")
+	HistoryClientOperationUpdateActivityOptions             = clientOperation("history-update-activity-options")
 	HistoryClientOperationResetWorkflowExecution            = clientOperation("history-reset-wf-execution")
 	HistoryClientOperationScheduleDecisionTask              = clientOperation("history-schedule-decision-task")
 	HistoryClientOperationRecordChildExecutionCompleted     = clientOperation("history-record-child-execution-completed")
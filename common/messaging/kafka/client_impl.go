@@ -120,6 +120,9 @@ func (c *clientImpl) NewConsumer(app, consumerName string) (messaging.Consumer,
 // NewProducer is used to create a Kafka producer
 func (c *clientImpl) NewProducer(app string) (messaging.Producer, error) {
 	topics := c.config.GetTopicsForApplication(app)
+	if topics.Topic == "" {
+		return nil, fmt.Errorf("no kafka topic is configured for application %v", app)
+	}
 	return c.newProducerByTopic(topics.Topic)
 }
 
@@ -145,3 +145,33 @@ func TestNewKafkaClient(t *testing.T) {
 		})
 	}
 }
+
+func TestNewProducer_ApplicationNotConfigured(t *testing.T) {
+	kafkaClient := NewKafkaClient(
+		&config.KafkaConfig{
+			Clusters: map[string]config.ClusterConfig{
+				"test-cluster": {
+					Brokers: []string{"test-brokers"},
+				},
+			},
+			Topics: map[string]config.TopicConfig{
+				"test-topic": {
+					Cluster: "test-cluster",
+				},
+			},
+			Applications: map[string]config.TopicList{
+				"test-app": {
+					Topic: "test-topic",
+				},
+			},
+		},
+		metrics.NewClient(tally.NoopScope, metrics.History),
+		testlogger.New(t),
+		nil,
+		false,
+	)
+
+	producer, err := kafkaClient.NewProducer("unknown-app")
+	assert.Nil(t, producer)
+	assert.EqualError(t, err, "no kafka topic is configured for application unknown-app")
+}
@@ -22,6 +22,7 @@ package kafka
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 
 	"github.com/Shopify/sarama"
@@ -29,6 +30,7 @@ import (
 	"github.com/uber/cadence/.gen/go/indexer"
 	"github.com/uber/cadence/.gen/go/sqlblobs"
 	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/lifecycle"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
@@ -130,6 +132,18 @@ func (p *producerImpl) getProducerMessage(message interface{}) (*sarama.Producer
 			Value: sarama.ByteEncoder(payload),
 		}
 		return msg, nil
+	case *lifecycle.Event:
+		payload, err := json.Marshal(message)
+		if err != nil {
+			p.logger.Error("Failed to serialize workflow lifecycle event", tag.Error(err))
+			return nil, err
+		}
+		msg := &sarama.ProducerMessage{
+			Topic: p.topic,
+			Key:   sarama.StringEncoder(message.WorkflowID),
+			Value: sarama.ByteEncoder(payload),
+		}
+		return msg, nil
 	default:
 		return nil, errors.New("unknown producer message type")
 	}
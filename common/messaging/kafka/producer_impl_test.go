@@ -31,6 +31,7 @@ import (
 
 	"github.com/uber/cadence/.gen/go/indexer"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/lifecycle"
 	"github.com/uber/cadence/common/log/testlogger"
 )
 
@@ -70,6 +71,17 @@ func TestPublish(t *testing.T) {
 			},
 			hasErr: false,
 		},
+		{
+			name: "Publish workflow lifecycle event succeeded",
+			message: &lifecycle.Event{
+				Version:    lifecycle.SchemaVersion,
+				EventType:  lifecycle.EventTypeWorkflowStarted,
+				DomainID:   "test-domain-id",
+				WorkflowID: "test-workflow-id",
+				RunID:      "test-workflow-run-id",
+			},
+			hasErr: false,
+		},
 		{
 			name:    "Unrecognized message type",
 			message: "This is not a recognized message type",
@@ -321,6 +321,8 @@ const (
 	HistoryClientResetQueueScope
 	// HistoryClientDescribeQueueScope tracks RPC calls to history service
 	HistoryClientDescribeQueueScope
+	// HistoryClientListThrottledWorkflowIDsScope tracks RPC calls to history service
+	HistoryClientListThrottledWorkflowIDsScope
 	// HistoryClientRecordActivityTaskHeartbeatScope tracks RPC calls to history service
 	HistoryClientRecordActivityTaskHeartbeatScope
 	// HistoryClientRespondDecisionTaskCompletedScope tracks RPC calls to history service
@@ -357,6 +359,18 @@ const (
 	HistoryClientRemoveSignalMutableStateScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope
+	// HistoryClientUnpauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUnpauseWorkflowExecutionScope
+	// HistoryClientPauseActivityScope tracks RPC calls to history service
+	HistoryClientPauseActivityScope
+	// HistoryClientUnpauseActivityScope tracks RPC calls to history service
+	HistoryClientUnpauseActivityScope
+	// HistoryClientResetActivityScope tracks RPC calls to history service
+	HistoryClientResetActivityScope
+	// HistoryClientUpdateActivityOptionsScope tracks RPC calls to history service
+	HistoryClientUpdateActivityOptionsScope
 	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientResetWorkflowExecutionScope
 	// HistoryClientScheduleDecisionTaskScope tracks RPC calls to history service
@@ -492,12 +506,44 @@ const (
 	FrontendClientStartWorkflowExecutionScope
 	// FrontendClientStartWorkflowExecutionAsyncScope tracks RPC calls to frontend service
 	FrontendClientStartWorkflowExecutionAsyncScope
+	// FrontendClientExecuteWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientExecuteWorkflowExecutionScope
 	// FrontendClientRestartWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientRestartWorkflowExecutionScope
 	// FrontendClientTerminateWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientTerminateWorkflowExecutionScope
+	// FrontendClientPauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientPauseWorkflowExecutionScope
+	// FrontendClientUnpauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientUnpauseWorkflowExecutionScope
+	// FrontendClientPauseActivityScope tracks RPC calls to frontend service
+	FrontendClientPauseActivityScope
+	// FrontendClientUnpauseActivityScope tracks RPC calls to frontend service
+	FrontendClientUnpauseActivityScope
+	// FrontendClientResetActivityScope tracks RPC calls to frontend service
+	FrontendClientResetActivityScope
+	// FrontendClientUpdateActivityOptionsScope tracks RPC calls to frontend service
+	FrontendClientUpdateActivityOptionsScope
 	// FrontendClientUpdateDomainScope tracks RPC calls to frontend service
 	FrontendClientUpdateDomainScope
+	// FrontendClientCreateScheduleScope tracks RPC calls to frontend service
+	FrontendClientCreateScheduleScope
+	// FrontendClientDescribeScheduleScope tracks RPC calls to frontend service
+	FrontendClientDescribeScheduleScope
+	// FrontendClientUpdateScheduleScope tracks RPC calls to frontend service
+	FrontendClientUpdateScheduleScope
+	// FrontendClientPauseScheduleScope tracks RPC calls to frontend service
+	FrontendClientPauseScheduleScope
+	// FrontendClientUnpauseScheduleScope tracks RPC calls to frontend service
+	FrontendClientUnpauseScheduleScope
+	// FrontendClientTriggerScheduleScope tracks RPC calls to frontend service
+	FrontendClientTriggerScheduleScope
+	// FrontendClientBackfillScheduleScope tracks RPC calls to frontend service
+	FrontendClientBackfillScheduleScope
+	// FrontendClientDeleteScheduleScope tracks RPC calls to frontend service
+	FrontendClientDeleteScheduleScope
+	// FrontendClientListSchedulesScope tracks RPC calls to frontend service
+	FrontendClientListSchedulesScope
 	// FrontendClientListWorkflowExecutionsScope tracks RPC calls to frontend service
 	FrontendClientListWorkflowExecutionsScope
 	// FrontendClientScanWorkflowExecutionsScope tracks RPC calls to frontend service
@@ -531,6 +577,10 @@ const (
 	AdminClientResetQueueScope
 	// AdminClientDescribeQueueScope tracks RPC calls to admin service
 	AdminClientDescribeQueueScope
+	// AdminClientListThrottledWorkflowIDsScope tracks RPC calls to admin service
+	AdminClientListThrottledWorkflowIDsScope
+	// AdminClientGetDomainUsageScope tracks RPC calls to admin service
+	AdminClientGetDomainUsageScope
 	// AdminClientDescribeHistoryHostScope tracks RPC calls to admin service
 	AdminClientDescribeHistoryHostScope
 	// AdminClientDescribeShardDistributionScope tracks RPC calls to admin service
@@ -551,8 +601,18 @@ const (
 	AdminClientMergeDLQMessagesScope
 	// AdminClientRefreshWorkflowTasksScope tracks RPC calls to admin service
 	AdminClientRefreshWorkflowTasksScope
+	// AdminClientPauseActivityScope tracks RPC calls to admin service
+	AdminClientPauseActivityScope
+	// AdminClientUnpauseActivityScope tracks RPC calls to admin service
+	AdminClientUnpauseActivityScope
+	// AdminClientResetActivityScope tracks RPC calls to admin service
+	AdminClientResetActivityScope
+	// AdminClientUpdateActivityOptionsScope tracks RPC calls to admin service
+	AdminClientUpdateActivityOptionsScope
 	// AdminClientResendReplicationTasksScope tracks RPC calls to admin service
 	AdminClientResendReplicationTasksScope
+	// AdminClientImportWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientImportWorkflowExecutionScope
 	// AdminClientGetCrossClusterTasksScope tracks RPC calls to Admin service
 	AdminClientGetCrossClusterTasksScope
 	// AdminClientRespondCrossClusterTasksCompletedScope tracks RPC calls to Admin service
@@ -668,8 +728,22 @@ const (
 	DCRedirectionStartWorkflowExecutionScope
 	// DCRedirectionStartWorkflowExecutionAsyncScope tracks RPC calls for dc redirection
 	DCRedirectionStartWorkflowExecutionAsyncScope
+	// DCRedirectionExecuteWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionExecuteWorkflowExecutionScope
 	// DCRedirectionTerminateWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionTerminateWorkflowExecutionScope
+	// DCRedirectionPauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionPauseWorkflowExecutionScope
+	// DCRedirectionUnpauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseWorkflowExecutionScope
+	// DCRedirectionPauseActivityScope tracks RPC calls for dc redirection
+	DCRedirectionPauseActivityScope
+	// DCRedirectionUnpauseActivityScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseActivityScope
+	// DCRedirectionResetActivityScope tracks RPC calls for dc redirection
+	DCRedirectionResetActivityScope
+	// DCRedirectionUpdateActivityOptionsScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateActivityOptionsScope
 	// DCRedirectionUpdateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionUpdateDomainScope
 	// DCRedirectionListTaskListPartitionsScope tracks RPC calls for dc redirection
@@ -680,6 +754,24 @@ const (
 	DCRedirectionRefreshWorkflowTasksScope
 	// DCRedirectionRestartWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionRestartWorkflowExecutionScope
+	// DCRedirectionCreateScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionCreateScheduleScope
+	// DCRedirectionDescribeScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionDescribeScheduleScope
+	// DCRedirectionUpdateScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateScheduleScope
+	// DCRedirectionPauseScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionPauseScheduleScope
+	// DCRedirectionUnpauseScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseScheduleScope
+	// DCRedirectionTriggerScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionTriggerScheduleScope
+	// DCRedirectionBackfillScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionBackfillScheduleScope
+	// DCRedirectionDeleteScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionDeleteScheduleScope
+	// DCRedirectionListSchedulesScope tracks RPC calls for dc redirection
+	DCRedirectionListSchedulesScope
 
 	// MessagingPublishScope tracks Publish calls made by service to messaging layer
 	MessagingClientPublishScope
@@ -858,8 +950,18 @@ const (
 	AdminReapplyEventsScope
 	// AdminRefreshWorkflowTasksScope is the metric scope for admin.RefreshWorkflowTasks
 	AdminRefreshWorkflowTasksScope
+	// AdminPauseActivityScope is the metric scope for admin.PauseActivity
+	AdminPauseActivityScope
+	// AdminUnpauseActivityScope is the metric scope for admin.UnpauseActivity
+	AdminUnpauseActivityScope
+	// AdminResetActivityScope is the metric scope for admin.ResetActivity
+	AdminResetActivityScope
+	// AdminUpdateActivityOptionsScope is the metric scope for admin.UpdateActivityOptions
+	AdminUpdateActivityOptionsScope
 	// AdminResendReplicationTasksScope is the metric scope for admin.ResendReplicationTasks
 	AdminResendReplicationTasksScope
+	// AdminImportWorkflowExecutionScope is the metric scope for admin.ImportWorkflowExecution
+	AdminImportWorkflowExecutionScope
 	// AdminRemoveTaskScope is the metric scope for admin.AdminRemoveTaskScope
 	AdminRemoveTaskScope
 	// AdminCloseShardScope is the metric scope for admin.AdminCloseShardScope
@@ -868,6 +970,10 @@ const (
 	AdminResetQueueScope
 	// AdminDescribeQueueScope is the metrics scope for admin.AdminDescribeQueueScope
 	AdminDescribeQueueScope
+	// AdminListThrottledWorkflowIDsScope is the metric scope for admin.ListThrottledWorkflowIDs
+	AdminListThrottledWorkflowIDsScope
+	// AdminGetDomainUsageScope is the metric scope for admin.GetDomainUsage
+	AdminGetDomainUsageScope
 	// AdminCountDLQMessagesScope is the metric scope for admin.AdminCountDLQMessagesScope
 	AdminCountDLQMessagesScope
 	// AdminReadDLQMessagesScope is the metric scope for admin.AdminReadDLQMessagesScope
@@ -916,6 +1022,8 @@ const (
 	FrontendStartWorkflowExecutionScope
 	// FrontendStartWorkflowExecutionAsyncScope is the metric scope for frontend.StartWorkflowExecutionAsync
 	FrontendStartWorkflowExecutionAsyncScope
+	// FrontendExecuteWorkflowExecutionScope is the metric scope for frontend.ExecuteWorkflowExecution
+	FrontendExecuteWorkflowExecutionScope
 	// PollForDecisionTaskScope is the metric scope for frontend.PollForDecisionTask
 	FrontendPollForDecisionTaskScope
 	// FrontendPollForActivityTaskScope is the metric scope for frontend.PollForActivityTask
@@ -956,6 +1064,18 @@ const (
 	FrontendSignalWithStartWorkflowExecutionAsyncScope
 	// FrontendTerminateWorkflowExecutionScope is the metric scope for frontend.TerminateWorkflowExecution
 	FrontendTerminateWorkflowExecutionScope
+	// FrontendPauseWorkflowExecutionScope is the metric scope for frontend.PauseWorkflowExecution
+	FrontendPauseWorkflowExecutionScope
+	// FrontendUnpauseWorkflowExecutionScope is the metric scope for frontend.UnpauseWorkflowExecution
+	FrontendUnpauseWorkflowExecutionScope
+	// FrontendPauseActivityScope is the metric scope for frontend.PauseActivity
+	FrontendPauseActivityScope
+	// FrontendUnpauseActivityScope is the metric scope for frontend.UnpauseActivity
+	FrontendUnpauseActivityScope
+	// FrontendResetActivityScope is the metric scope for frontend.ResetActivity
+	FrontendResetActivityScope
+	// FrontendUpdateActivityOptionsScope is the metric scope for frontend.UpdateActivityOptions
+	FrontendUpdateActivityOptionsScope
 	// FrontendRequestCancelWorkflowExecutionScope is the metric scope for frontend.RequestCancelWorkflowExecution
 	FrontendRequestCancelWorkflowExecutionScope
 	// FrontendListArchivedWorkflowExecutionsScope is the metric scope for frontend.ListArchivedWorkflowExecutions
@@ -1000,6 +1120,24 @@ const (
 	FrontendGetSearchAttributesScope
 	// FrontendGetClusterInfoScope is the metric scope for frontend.GetClusterInfo
 	FrontendGetClusterInfoScope
+	// FrontendCreateScheduleScope is the metric scope for frontend.CreateSchedule
+	FrontendCreateScheduleScope
+	// FrontendDescribeScheduleScope is the metric scope for frontend.DescribeSchedule
+	FrontendDescribeScheduleScope
+	// FrontendUpdateScheduleScope is the metric scope for frontend.UpdateSchedule
+	FrontendUpdateScheduleScope
+	// FrontendPauseScheduleScope is the metric scope for frontend.PauseSchedule
+	FrontendPauseScheduleScope
+	// FrontendUnpauseScheduleScope is the metric scope for frontend.UnpauseSchedule
+	FrontendUnpauseScheduleScope
+	// FrontendTriggerScheduleScope is the metric scope for frontend.TriggerSchedule
+	FrontendTriggerScheduleScope
+	// FrontendBackfillScheduleScope is the metric scope for frontend.BackfillSchedule
+	FrontendBackfillScheduleScope
+	// FrontendDeleteScheduleScope is the metric scope for frontend.DeleteSchedule
+	FrontendDeleteScheduleScope
+	// FrontendListSchedulesScope is the metric scope for frontend.ListSchedules
+	FrontendListSchedulesScope
 
 	NumFrontendScopes
 )
@@ -1024,6 +1162,8 @@ const (
 	HistoryResetQueueScope
 	// HistoryDescribeQueueScope tracks DescribeQueue API calls received by service
 	HistoryDescribeQueueScope
+	// HistoryListThrottledWorkflowIDsScope tracks ListThrottledWorkflowIDs API calls received by service
+	HistoryListThrottledWorkflowIDsScope
 	// HistoryDescribeMutabelStateScope tracks DescribeMutableState API calls received by service
 	HistoryDescribeMutabelStateScope
 	// HistoryGetMutableStateScope tracks GetMutableState API calls received by service
@@ -1046,6 +1186,18 @@ const (
 	HistoryRemoveSignalMutableStateScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
+	// HistoryPauseWorkflowExecutionScope tracks PauseWorkflowExecution API calls received by service
+	HistoryPauseWorkflowExecutionScope
+	// HistoryUnpauseWorkflowExecutionScope tracks UnpauseWorkflowExecution API calls received by service
+	HistoryUnpauseWorkflowExecutionScope
+	// HistoryPauseActivityScope tracks PauseActivity API calls received by service
+	HistoryPauseActivityScope
+	// HistoryUnpauseActivityScope tracks UnpauseActivity API calls received by service
+	HistoryUnpauseActivityScope
+	// HistoryResetActivityScope tracks ResetActivity API calls received by service
+	HistoryResetActivityScope
+	// HistoryUpdateActivityOptionsScope tracks UpdateActivityOptions API calls received by service
+	HistoryUpdateActivityOptionsScope
 	// HistoryScheduleDecisionTaskScope tracks ScheduleDecisionTask API calls received by service
 	HistoryScheduleDecisionTaskScope
 	// HistoryRecordChildExecutionCompletedScope tracks CompleteChildExecution API calls received by service
@@ -1122,6 +1274,8 @@ const (
 	TransferActiveTaskRecordChildExecutionCompletedScope
 	// TransferActiveTaskApplyParentClosePolicyScope is the scope used for apply parent close policy task processing by transfer queue processor
 	TransferActiveTaskApplyParentClosePolicyScope
+	// TransferActiveTaskRecordWorkflowLifecycleEventScope is the scope used for record workflow lifecycle event task processing by transfer queue processor
+	TransferActiveTaskRecordWorkflowLifecycleEventScope
 	// TransferStandbyTaskResetWorkflowScope is the scope used for record workflow started task processing by transfer queue processor
 	TransferStandbyTaskResetWorkflowScope
 	// TransferStandbyTaskActivityScope is the scope used for activity task processing by transfer queue processor
@@ -1146,6 +1300,8 @@ const (
 	TransferStandbyTaskRecordChildExecutionCompletedScope
 	// TransferActiveTaskApplyParentClosePolicyScope is the scope used for apply parent close policy task processing by transfer queue processor
 	TransferStandbyTaskApplyParentClosePolicyScope
+	// TransferStandbyTaskRecordWorkflowLifecycleEventScope is the scope used for record workflow lifecycle event task processing by transfer queue processor
+	TransferStandbyTaskRecordWorkflowLifecycleEventScope
 	// TimerQueueProcessorScope is the scope used by all metric emitted by timer queue processor
 	TimerQueueProcessorScope
 	// TimerActiveQueueProcessorScope is the scope used by all metric emitted by timer queue processor
@@ -1254,6 +1410,8 @@ const (
 	HistoryProcessDeleteHistoryEventScope
 	// WorkflowCompletionStatsScope tracks workflow completion updates
 	WorkflowCompletionStatsScope
+	// WorkflowUsageStatsScope tracks the capacity consumed by workflows of a domain and workflow type
+	WorkflowUsageStatsScope
 	// ArchiverClientScope is scope used by all metrics emitted by archiver.Client
 	ArchiverClientScope
 	// ReplicationTaskFetcherScope is scope used by all metrics emitted by ReplicationTaskFetcher
@@ -1350,6 +1508,8 @@ const (
 	ESAnalyzerScope
 	// AsyncWorkflowConsumerScope is scope used by async workflow consumer
 	AsyncWorkflowConsumerScope
+	// VisibilityReconcilerScope is scope used by the visibility reconciler workflow
+	VisibilityReconcilerScope
 
 	NumWorkerScopes
 )
@@ -1461,6 +1621,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientCloseShardScope:                        {operation: "HistoryClientCloseShard", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientResetQueueScope:                        {operation: "HistoryClientResetQueue", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientDescribeQueueScope:                     {operation: "HistoryClientDescribeQueue", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientListThrottledWorkflowIDsScope:          {operation: "HistoryClientListThrottledWorkflowIDs", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRecordActivityTaskHeartbeatScope:       {operation: "HistoryClientRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRespondDecisionTaskCompletedScope:      {operation: "HistoryClientRespondDecisionTaskCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRespondDecisionTaskFailedScope:         {operation: "HistoryClientRespondDecisionTaskFailed", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1479,6 +1640,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientSignalWithStartWorkflowExecutionScope:  {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRemoveSignalMutableStateScope:          {operation: "HistoryClientRemoveSignalMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientTerminateWorkflowExecutionScope:        {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientPauseWorkflowExecutionScope:            {operation: "HistoryClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUnpauseWorkflowExecutionScope:          {operation: "HistoryClientUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientPauseActivityScope:                     {operation: "HistoryClientPauseActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUnpauseActivityScope:                   {operation: "HistoryClientUnpauseActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientResetActivityScope:                     {operation: "HistoryClientResetActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUpdateActivityOptionsScope:             {operation: "HistoryClientUpdateActivityOptions", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientResetWorkflowExecutionScope:            {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientScheduleDecisionTaskScope:              {operation: "HistoryClientScheduleDecisionTask", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRecordChildExecutionCompletedScope:     {operation: "HistoryClientRecordChildExecutionCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1547,8 +1714,24 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientSignalWorkflowExecutionScope:               {operation: "FrontendClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientStartWorkflowExecutionScope:                {operation: "FrontendClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientStartWorkflowExecutionAsyncScope:           {operation: "FrontendClientStartWorkflowExecutionAsync", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientExecuteWorkflowExecutionScope:              {operation: "FrontendClientExecuteWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientTerminateWorkflowExecutionScope:            {operation: "FrontendClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientPauseWorkflowExecutionScope:                {operation: "FrontendClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUnpauseWorkflowExecutionScope:              {operation: "FrontendClientUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientPauseActivityScope:                         {operation: "FrontendClientPauseActivity", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUnpauseActivityScope:                       {operation: "FrontendClientUnpauseActivity", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientResetActivityScope:                         {operation: "FrontendClientResetActivity", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUpdateActivityOptionsScope:                 {operation: "FrontendClientUpdateActivityOptions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientUpdateDomainScope:                          {operation: "FrontendClientUpdateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientCreateScheduleScope:                        {operation: "FrontendClientCreateSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientDescribeScheduleScope:                      {operation: "FrontendClientDescribeSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUpdateScheduleScope:                        {operation: "FrontendClientUpdateSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientPauseScheduleScope:                         {operation: "FrontendClientPauseSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUnpauseScheduleScope:                       {operation: "FrontendClientUnpauseSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientTriggerScheduleScope:                       {operation: "FrontendClientTriggerSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientBackfillScheduleScope:                      {operation: "FrontendClientBackfillSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientDeleteScheduleScope:                        {operation: "FrontendClientDeleteSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientListSchedulesScope:                         {operation: "FrontendClientListSchedules", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientListWorkflowExecutionsScope:                {operation: "FrontendClientListWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientScanWorkflowExecutionsScope:                {operation: "FrontendClientScanWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientCountWorkflowExecutionsScope:               {operation: "FrontendClientCountWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1570,11 +1753,18 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientGetWorkflowExecutionRawHistoryV2Scope:      {operation: "AdminClientGetWorkflowExecutionRawHistoryV2", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientDescribeClusterScope:                       {operation: "AdminClientDescribeCluster", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRefreshWorkflowTasksScope:                  {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientPauseActivityScope:                         {operation: "AdminClientPauseActivity", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientUnpauseActivityScope:                       {operation: "AdminClientUnpauseActivity", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientResetActivityScope:                         {operation: "AdminClientResetActivity", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientUpdateActivityOptionsScope:                 {operation: "AdminClientUpdateActivityOptions", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientResendReplicationTasksScope:                {operation: "AdminClientResendReplicationTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientImportWorkflowExecutionScope:               {operation: "AdminClientImportWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientCloseShardScope:                            {operation: "AdminClientCloseShard", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRemoveTaskScope:                            {operation: "AdminClientRemoveTask", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientResetQueueScope:                            {operation: "AdminClientResetQueue", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientDescribeQueueScope:                         {operation: "AdminClientDescribeQueue", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientListThrottledWorkflowIDsScope:              {operation: "AdminClientListThrottledWorkflowIDs", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientGetDomainUsageScope:                        {operation: "AdminClientGetDomainUsage", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientCountDLQMessagesScope:                      {operation: "AdminClientCountDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientReadDLQMessagesScope:                       {operation: "AdminClientReadDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientPurgeDLQMessagesScope:                      {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
@@ -1636,12 +1826,28 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		DCRedirectionSignalWorkflowExecutionScope:               {operation: "DCRedirectionSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionStartWorkflowExecutionScope:                {operation: "DCRedirectionStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionStartWorkflowExecutionAsyncScope:           {operation: "DCRedirectionStartWorkflowExecutionAsync", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionExecuteWorkflowExecutionScope:              {operation: "DCRedirectionExecuteWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionTerminateWorkflowExecutionScope:            {operation: "DCRedirectionTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseWorkflowExecutionScope:                {operation: "DCRedirectionPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseWorkflowExecutionScope:              {operation: "DCRedirectionUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseActivityScope:                         {operation: "DCRedirectionPauseActivity", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseActivityScope:                       {operation: "DCRedirectionUnpauseActivity", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionResetActivityScope:                         {operation: "DCRedirectionResetActivity", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateActivityOptionsScope:                 {operation: "DCRedirectionUpdateActivityOptions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionUpdateDomainScope:                          {operation: "DCRedirectionUpdateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionListTaskListPartitionsScope:                {operation: "DCRedirectionListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionGetTaskListsByDomainScope:                  {operation: "DCRedirectionGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRefreshWorkflowTasksScope:                  {operation: "DCRedirectionRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRestartWorkflowExecutionScope:              {operation: "DCRedirectionRestartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionCreateScheduleScope:                        {operation: "DCRedirectionCreateSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDescribeScheduleScope:                      {operation: "DCRedirectionDescribeSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateScheduleScope:                        {operation: "DCRedirectionUpdateSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseScheduleScope:                         {operation: "DCRedirectionPauseSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseScheduleScope:                       {operation: "DCRedirectionUnpauseSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionTriggerScheduleScope:                       {operation: "DCRedirectionTriggerSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionBackfillScheduleScope:                      {operation: "DCRedirectionBackfillSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDeleteScheduleScope:                        {operation: "DCRedirectionDeleteSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionListSchedulesScope:                         {operation: "DCRedirectionListSchedules", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 
 		MessagingClientPublishScope:      {operation: "MessagingClientPublish"},
 		MessagingClientPublishBatchScope: {operation: "MessagingClientPublishBatch"},
@@ -1717,6 +1923,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminCloseShardScope:                        {operation: "AdminCloseShard"},
 		AdminResetQueueScope:                        {operation: "AdminResetQueue"},
 		AdminDescribeQueueScope:                     {operation: "AdminDescribeQueue"},
+		AdminListThrottledWorkflowIDsScope:          {operation: "AdminListThrottledWorkflowIDs"},
+		AdminGetDomainUsageScope:                    {operation: "AdminGetDomainUsage"},
 		AdminCountDLQMessagesScope:                  {operation: "AdminCountDLQMessages"},
 		AdminReadDLQMessagesScope:                   {operation: "AdminReadDLQMessages"},
 		AdminPurgeDLQMessagesScope:                  {operation: "AdminPurgeDLQMessages"},
@@ -1733,7 +1941,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminGetDLQReplicationMessagesScope:         {operation: "AdminGetDLQReplicationMessages"},
 		AdminReapplyEventsScope:                     {operation: "ReapplyEvents"},
 		AdminRefreshWorkflowTasksScope:              {operation: "RefreshWorkflowTasks"},
+		AdminPauseActivityScope:                     {operation: "AdminPauseActivity"},
+		AdminUnpauseActivityScope:                   {operation: "AdminUnpauseActivity"},
+		AdminResetActivityScope:                     {operation: "AdminResetActivity"},
+		AdminUpdateActivityOptionsScope:             {operation: "AdminUpdateActivityOptions"},
 		AdminResendReplicationTasksScope:            {operation: "ResendReplicationTasks"},
+		AdminImportWorkflowExecutionScope:           {operation: "ImportWorkflowExecution"},
 		AdminGetCrossClusterTasksScope:              {operation: "AdminGetCrossClusterTasks"},
 		AdminRespondCrossClusterTasksCompletedScope: {operation: "AdminRespondCrossClusterTasksCompleted"},
 		AdminGetDynamicConfigScope:                  {operation: "AdminGetDynamicConfig"},
@@ -1751,6 +1964,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendRestartWorkflowExecutionScope:              {operation: "RestartWorkflowExecution"},
 		FrontendStartWorkflowExecutionScope:                {operation: "StartWorkflowExecution"},
 		FrontendStartWorkflowExecutionAsyncScope:           {operation: "StartWorkflowExecutionAsync"},
+		FrontendExecuteWorkflowExecutionScope:              {operation: "ExecuteWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:                   {operation: "PollForDecisionTask"},
 		FrontendPollForActivityTaskScope:                   {operation: "PollForActivityTask"},
 		FrontendRecordActivityTaskHeartbeatScope:           {operation: "RecordActivityTaskHeartbeat"},
@@ -1771,6 +1985,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendSignalWithStartWorkflowExecutionScope:      {operation: "SignalWithStartWorkflowExecution"},
 		FrontendSignalWithStartWorkflowExecutionAsyncScope: {operation: "SignalWithStartWorkflowExecutionAsync"},
 		FrontendTerminateWorkflowExecutionScope:            {operation: "TerminateWorkflowExecution"},
+		FrontendPauseWorkflowExecutionScope:                {operation: "PauseWorkflowExecution"},
+		FrontendUnpauseWorkflowExecutionScope:              {operation: "UnpauseWorkflowExecution"},
+		FrontendPauseActivityScope:                         {operation: "PauseActivity"},
+		FrontendUnpauseActivityScope:                       {operation: "UnpauseActivity"},
+		FrontendResetActivityScope:                         {operation: "ResetActivity"},
+		FrontendUpdateActivityOptionsScope:                 {operation: "UpdateActivityOptions"},
 		FrontendResetWorkflowExecutionScope:                {operation: "ResetWorkflowExecution"},
 		FrontendRequestCancelWorkflowExecutionScope:        {operation: "RequestCancelWorkflowExecution"},
 		FrontendListArchivedWorkflowExecutionsScope:        {operation: "ListArchivedWorkflowExecutions"},
@@ -1793,6 +2013,15 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendResetStickyTaskListScope:                   {operation: "ResetStickyTaskList"},
 		FrontendGetSearchAttributesScope:                   {operation: "GetSearchAttributes"},
 		FrontendGetClusterInfoScope:                        {operation: "GetClusterInfo"},
+		FrontendCreateScheduleScope:                        {operation: "CreateSchedule"},
+		FrontendDescribeScheduleScope:                      {operation: "DescribeSchedule"},
+		FrontendUpdateScheduleScope:                        {operation: "UpdateSchedule"},
+		FrontendPauseScheduleScope:                         {operation: "PauseSchedule"},
+		FrontendUnpauseScheduleScope:                       {operation: "UnpauseSchedule"},
+		FrontendTriggerScheduleScope:                       {operation: "TriggerSchedule"},
+		FrontendBackfillScheduleScope:                      {operation: "BackfillSchedule"},
+		FrontendDeleteScheduleScope:                        {operation: "DeleteSchedule"},
+		FrontendListSchedulesScope:                         {operation: "ListSchedules"},
 	},
 	// History Scope Names
 	History: {
@@ -1805,6 +2034,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryRespondActivityTaskCanceledScope:                         {operation: "RespondActivityTaskCanceled"},
 		HistoryResetQueueScope:                                          {operation: "ResetQueue"},
 		HistoryDescribeQueueScope:                                       {operation: "DescribeQueue"},
+		HistoryListThrottledWorkflowIDsScope:                            {operation: "ListThrottledWorkflowIDs"},
 		HistoryDescribeMutabelStateScope:                                {operation: "DescribeMutableState"},
 		HistoryGetMutableStateScope:                                     {operation: "GetMutableState"},
 		HistoryPollMutableStateScope:                                    {operation: "PollMutableState"},
@@ -1816,6 +2046,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistorySignalWithStartWorkflowExecutionScope:                    {operation: "SignalWithStartWorkflowExecution"},
 		HistoryRemoveSignalMutableStateScope:                            {operation: "RemoveSignalMutableState"},
 		HistoryTerminateWorkflowExecutionScope:                          {operation: "TerminateWorkflowExecution"},
+		HistoryPauseWorkflowExecutionScope:                              {operation: "PauseWorkflowExecution"},
+		HistoryUnpauseWorkflowExecutionScope:                            {operation: "UnpauseWorkflowExecution"},
+		HistoryPauseActivityScope:                                       {operation: "PauseActivity"},
+		HistoryUnpauseActivityScope:                                     {operation: "UnpauseActivity"},
+		HistoryResetActivityScope:                                       {operation: "ResetActivity"},
+		HistoryUpdateActivityOptionsScope:                               {operation: "UpdateActivityOptions"},
 		HistoryResetWorkflowExecutionScope:                              {operation: "ResetWorkflowExecution"},
 		HistoryQueryWorkflowScope:                                       {operation: "QueryWorkflow"},
 		HistoryProcessDeleteHistoryEventScope:                           {operation: "ProcessDeleteHistoryEvent"},
@@ -1857,6 +2093,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TransferActiveTaskRecordWorkflowClosedScope:                     {operation: "TransferActiveTaskRecordWorkflowClosed"},
 		TransferActiveTaskRecordChildExecutionCompletedScope:            {operation: "TransferActiveTaskRecordChildExecutionCompleted"},
 		TransferActiveTaskApplyParentClosePolicyScope:                   {operation: "TransferActiveTaskApplyParentClosePolicy"},
+		TransferActiveTaskRecordWorkflowLifecycleEventScope:             {operation: "TransferActiveTaskRecordWorkflowLifecycleEvent"},
 		TransferStandbyTaskActivityScope:                                {operation: "TransferStandbyTaskActivity"},
 		TransferStandbyTaskDecisionScope:                                {operation: "TransferStandbyTaskDecision"},
 		TransferStandbyTaskCloseExecutionScope:                          {operation: "TransferStandbyTaskCloseExecution"},
@@ -1869,6 +2106,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TransferStandbyTaskRecordWorkflowClosedScope:                    {operation: "TransferStandbyTaskRecordWorkflowClosed"},
 		TransferStandbyTaskRecordChildExecutionCompletedScope:           {operation: "TransferStandbyTaskRecordChildExecutionCompleted"},
 		TransferStandbyTaskApplyParentClosePolicyScope:                  {operation: "TransferStandbyTaskApplyParentClosePolicy"},
+		TransferStandbyTaskRecordWorkflowLifecycleEventScope:            {operation: "TransferStandbyTaskRecordWorkflowLifecycleEvent"},
 		TimerQueueProcessorScope:                                        {operation: "TimerQueueProcessor"},
 		TimerActiveQueueProcessorScope:                                  {operation: "TimerActiveQueueProcessor"},
 		TimerStandbyQueueProcessorScope:                                 {operation: "TimerStandbyQueueProcessor"},
@@ -1920,6 +2158,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		SessionSizeStatsScope:                                           {operation: "SessionStats", tags: map[string]string{StatsTypeTagName: SizeStatsTypeTagValue}},
 		SessionCountStatsScope:                                          {operation: "SessionStats", tags: map[string]string{StatsTypeTagName: CountStatsTypeTagValue}},
 		WorkflowCompletionStatsScope:                                    {operation: "CompletionStats", tags: map[string]string{StatsTypeTagName: CountStatsTypeTagValue}},
+		WorkflowUsageStatsScope:                                         {operation: "UsageStats", tags: map[string]string{StatsTypeTagName: CountStatsTypeTagValue}},
 		ArchiverClientScope:                                             {operation: "ArchiverClient"},
 		ReplicationTaskFetcherScope:                                     {operation: "ReplicationTaskFetcher"},
 		ReplicationTaskCleanupScope:                                     {operation: "ReplicationTaskCleanup"},
@@ -1968,6 +2207,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
 		ESAnalyzerScope:                        {operation: "ESAnalyzer"},
 		AsyncWorkflowConsumerScope:             {operation: "AsyncWorkflowConsumer"},
+		VisibilityReconcilerScope:              {operation: "VisibilityReconciler"},
 	},
 }
 
@@ -2104,6 +2344,8 @@ const (
 
 	PriorityTaskSubmitRequest
 	PriorityTaskSubmitLatency
+	PriorityTaskDomainQueueDepth
+	PriorityTaskDomainConcurrencyLimited
 
 	KafkaConsumerMessageIn
 	KafkaConsumerMessageAck
@@ -2378,6 +2620,12 @@ const (
 	WorkflowTerminateCount
 	WorkflowContinuedAsNew
 	WorkflowCompletedUnknownType
+	WorkflowUsageDecisionTaskCount
+	WorkflowUsageActivityTaskCount
+	WorkflowUsageLocalActivityCount
+	WorkflowUsageSignalCount
+	WorkflowUsageTimerCount
+	WorkflowUsageHistoryBytes
 	ArchiverClientSendSignalCount
 	ArchiverClientSendSignalFailureCount
 	ArchiverClientHistoryRequestCount
@@ -2414,9 +2662,15 @@ const (
 	ReplicationDLQProbeFailed
 	ReplicationDLQSize
 	ReplicationDLQValidationFailed
+	ReplicationDLQRedriveSuccess
+	ReplicationDLQRedriveTransientFailure
+	ReplicationDLQRedrivePermanentFailure
 	GetReplicationMessagesForShardLatency
 	GetDLQReplicationMessagesLatency
 	EventReapplySkippedCount
+	EventReappliedCount
+	NDCBranchSwitchedCount
+	NDCBranchCreatedCount
 	DirectQueryDispatchLatency
 	DirectQueryDispatchStickyLatency
 	DirectQueryDispatchNonStickyLatency
@@ -2429,6 +2683,8 @@ const (
 	ConsistentQueryPerShard
 	ConsistentQueryTimeoutCount
 	QueryBeforeFirstDecisionCount
+	ClosedWorkflowQueryCacheHitCount
+	ClosedWorkflowQueryResultDroppedCount
 	QueryBufferExceededCount
 	QueryRegistryInvalidStateCount
 	WorkerNotSupportsConsistentQueryCount
@@ -2455,6 +2711,7 @@ const (
 	WorkflowIDCacheSizeGauge
 	WorkflowIDCacheRequestsExternalRatelimitedCounter
 	WorkflowIDCacheRequestsInternalRatelimitedCounter
+	WorkflowIDCacheRequestsOperationRatelimitedCounter
 	NumHistoryMetrics
 )
 
@@ -2586,6 +2843,9 @@ const (
 	AsyncWorkflowFailureCorruptMsgCount
 	AsyncWorkflowFailureByFrontendCount
 	AsyncWorkflowSuccessCount
+	VisibilityReconcilerComparedCount
+	VisibilityReconcilerMismatchCount
+	VisibilityReconcilerRepairedCount
 
 	NumWorkerMetrics
 )
@@ -2710,6 +2970,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ParallelTaskTaskProcessingLatency:                            {metricName: "paralleltask_task_processing_latency", metricType: Timer},
 		PriorityTaskSubmitRequest:                                    {metricName: "prioritytask_submit_request", metricType: Counter},
 		PriorityTaskSubmitLatency:                                    {metricName: "prioritytask_submit_latency", metricType: Timer},
+		PriorityTaskDomainQueueDepth:                                 {metricName: "prioritytask_domain_queue_depth", metricType: Gauge},
+		PriorityTaskDomainConcurrencyLimited:                         {metricName: "prioritytask_domain_concurrency_limited", metricType: Counter},
 		KafkaConsumerMessageIn:                                       {metricName: "kafka_consumer_message_in", metricType: Counter},
 		KafkaConsumerMessageAck:                                      {metricName: "kafka_consumer_message_ack", metricType: Counter},
 		KafkaConsumerMessageNack:                                     {metricName: "kafka_consumer_message_nack", metricType: Counter},
@@ -3005,6 +3267,12 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		WorkflowTerminateCount:                                       {metricName: "workflow_terminate", metricType: Counter},
 		WorkflowContinuedAsNew:                                       {metricName: "workflow_continued_as_new", metricType: Counter},
 		WorkflowCompletedUnknownType:                                 {metricName: "workflow_completed_unknown_type", metricType: Counter},
+		WorkflowUsageDecisionTaskCount:                               {metricName: "workflow_usage_decision_tasks", metricType: Counter},
+		WorkflowUsageActivityTaskCount:                               {metricName: "workflow_usage_activity_tasks", metricType: Counter},
+		WorkflowUsageLocalActivityCount:                              {metricName: "workflow_usage_local_activities", metricType: Counter},
+		WorkflowUsageSignalCount:                                     {metricName: "workflow_usage_signals", metricType: Counter},
+		WorkflowUsageTimerCount:                                      {metricName: "workflow_usage_timers", metricType: Counter},
+		WorkflowUsageHistoryBytes:                                    {metricName: "workflow_usage_history_bytes", metricType: Counter},
 		ArchiverClientSendSignalCount:                                {metricName: "archiver_client_sent_signal", metricType: Counter},
 		ArchiverClientSendSignalFailureCount:                         {metricName: "archiver_client_send_signal_error", metricType: Counter},
 		ArchiverClientHistoryRequestCount:                            {metricName: "archiver_client_history_request", metricType: Counter},
@@ -3041,9 +3309,15 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicationDLQProbeFailed:                                    {metricName: "replication_dlq_probe_failed", metricType: Counter},
 		ReplicationDLQSize:                                           {metricName: "replication_dlq_size", metricType: Gauge},
 		ReplicationDLQValidationFailed:                               {metricName: "replication_dlq_validation_failed", metricType: Counter},
+		ReplicationDLQRedriveSuccess:                                 {metricName: "replication_dlq_redrive_success", metricType: Counter},
+		ReplicationDLQRedriveTransientFailure:                        {metricName: "replication_dlq_redrive_transient_failure", metricType: Counter},
+		ReplicationDLQRedrivePermanentFailure:                        {metricName: "replication_dlq_redrive_permanent_failure", metricType: Counter},
 		GetReplicationMessagesForShardLatency:                        {metricName: "get_replication_messages_for_shard", metricType: Timer},
 		GetDLQReplicationMessagesLatency:                             {metricName: "get_dlq_replication_messages", metricType: Timer},
 		EventReapplySkippedCount:                                     {metricName: "event_reapply_skipped_count", metricType: Counter},
+		EventReappliedCount:                                          {metricName: "event_reapplied_count", metricType: Counter},
+		NDCBranchSwitchedCount:                                       {metricName: "ndc_branch_switched_count", metricType: Counter},
+		NDCBranchCreatedCount:                                        {metricName: "ndc_branch_created_count", metricType: Counter},
 		DirectQueryDispatchLatency:                                   {metricName: "direct_query_dispatch_latency", metricType: Timer},
 		DirectQueryDispatchStickyLatency:                             {metricName: "direct_query_dispatch_sticky_latency", metricType: Timer},
 		DirectQueryDispatchNonStickyLatency:                          {metricName: "direct_query_dispatch_non_sticky_latency", metricType: Timer},
@@ -3056,6 +3330,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ConsistentQueryPerShard:                                      {metricName: "consistent_query_per_shard", metricType: Counter},
 		ConsistentQueryTimeoutCount:                                  {metricName: "consistent_query_timeout", metricType: Counter},
 		QueryBeforeFirstDecisionCount:                                {metricName: "query_before_first_decision", metricType: Counter},
+		ClosedWorkflowQueryCacheHitCount:                             {metricName: "closed_workflow_query_cache_hit", metricType: Counter},
+		ClosedWorkflowQueryResultDroppedCount:                        {metricName: "closed_workflow_query_result_dropped", metricType: Counter},
 		QueryBufferExceededCount:                                     {metricName: "query_buffer_exceeded", metricType: Counter},
 		QueryRegistryInvalidStateCount:                               {metricName: "query_registry_invalid_state", metricType: Counter},
 		WorkerNotSupportsConsistentQueryCount:                        {metricName: "worker_not_supports_consistent_query", metricType: Counter},
@@ -3086,6 +3362,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		WorkflowIDCacheSizeGauge:                                     {metricName: "workflow_id_cache_size", metricType: Gauge},
 		WorkflowIDCacheRequestsExternalRatelimitedCounter:            {metricName: "workflow_id_external_requests_ratelimited", metricType: Counter},
 		WorkflowIDCacheRequestsInternalRatelimitedCounter:            {metricName: "workflow_id_internal_requests_ratelimited", metricType: Counter},
+		WorkflowIDCacheRequestsOperationRatelimitedCounter:           {metricName: "workflow_id_operation_requests_ratelimited", metricType: Counter},
 	},
 	Matching: {
 		PollSuccessPerTaskListCounter:               {metricName: "poll_success_per_tl", metricRollupName: "poll_success"},
@@ -3210,6 +3487,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		AsyncWorkflowFailureCorruptMsgCount:           {metricName: "async_workflow_failure_corrupt_msg", metricType: Counter},
 		AsyncWorkflowFailureByFrontendCount:           {metricName: "async_workflow_failure_by_frontend", metricType: Counter},
 		AsyncWorkflowSuccessCount:                     {metricName: "async_workflow_success", metricType: Counter},
+		VisibilityReconcilerComparedCount:             {metricName: "visibility_reconciler_compared", metricType: Counter},
+		VisibilityReconcilerMismatchCount:             {metricName: "visibility_reconciler_mismatch", metricType: Counter},
+		VisibilityReconcilerRepairedCount:             {metricName: "visibility_reconciler_repaired", metricType: Counter},
 	},
 }
 
@@ -0,0 +1,95 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package ndc
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// ConflictResolutionForked is the reason recorded when a new branch is forked from the lowest common ancestor
+	ConflictResolutionForked = "forked from lowest common ancestor"
+	// ConflictResolutionSwitched is the reason recorded when the current branch is switched to a branch with a higher version
+	ConflictResolutionSwitched = "incoming branch has higher version"
+	// ConflictResolutionReapplied is the reason recorded when signals of a losing branch are reapplied to the current branch
+	ConflictResolutionReapplied = "signals reapplied"
+
+	// maxConflictResolutionRecords is the number of most recent records kept per workflow
+	maxConflictResolutionRecords = 20
+)
+
+type (
+	// ConflictResolutionRecord is an entry of the conflict resolution audit trail of a workflow
+	ConflictResolutionRecord struct {
+		Timestamp       time.Time        `json:"timestamp"`
+		Reason          string           `json:"reason"`
+		BranchToken     []byte           `json:"branchToken,omitempty"`
+		NewBranchToken  []byte           `json:"newBranchToken,omitempty"`
+		CurrentVersion  int64            `json:"currentVersion,omitempty"`
+		IncomingVersion int64            `json:"incomingVersion,omitempty"`
+		ForkEventID     int64            `json:"forkEventID,omitempty"`
+		ReappliedEvents []ReappliedEvent `json:"reappliedEvents,omitempty"`
+	}
+
+	// ReappliedEvent identifies an event of a losing branch that was reapplied to the current branch
+	ReappliedEvent struct {
+		RunID      string `json:"runID"`
+		EventID    int64  `json:"eventID"`
+		Version    int64  `json:"version"`
+		SignalName string `json:"signalName"`
+	}
+)
+
+// GetConflictResolutionRecords decodes the conflict resolution records of a workflow
+func GetConflictResolutionRecords(
+	data []byte,
+) ([]*ConflictResolutionRecord, error) {
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var records []*ConflictResolutionRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// AppendConflictResolutionRecord adds record to the encoded conflict resolution records of a workflow,
+// dropping the oldest records beyond maxConflictResolutionRecords
+func AppendConflictResolutionRecord(
+	data []byte,
+	record *ConflictResolutionRecord,
+) ([]byte, error) {
+
+	records, err := GetConflictResolutionRecords(data)
+	if err != nil {
+		return nil, err
+	}
+	records = append(records, record)
+	if len(records) > maxConflictResolutionRecords {
+		records = records[len(records)-maxConflictResolutionRecords:]
+	}
+	return json.Marshal(records)
+}
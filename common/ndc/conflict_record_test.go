@@ -0,0 +1,56 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package ndc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendConflictResolutionRecord(t *testing.T) {
+	var data []byte
+	for i := 0; i < maxConflictResolutionRecords+2; i++ {
+		var err error
+		data, err = AppendConflictResolutionRecord(data, &ConflictResolutionRecord{
+			Reason:          ConflictResolutionSwitched,
+			IncomingVersion: int64(i),
+		})
+		assert.NoError(t, err)
+	}
+
+	records, err := GetConflictResolutionRecords(data)
+	assert.NoError(t, err)
+	assert.Len(t, records, maxConflictResolutionRecords)
+	assert.Equal(t, int64(2), records[0].IncomingVersion)
+	assert.Equal(t, int64(maxConflictResolutionRecords+1), records[len(records)-1].IncomingVersion)
+}
+
+func TestGetConflictResolutionRecords(t *testing.T) {
+	records, err := GetConflictResolutionRecords(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+
+	_, err = GetConflictResolutionRecords([]byte("{"))
+	assert.Error(t, err)
+}
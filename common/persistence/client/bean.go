@@ -51,6 +51,9 @@ type (
 		GetDomainReplicationQueueManager() persistence.QueueManager
 		SetDomainReplicationQueueManager(persistence.QueueManager)
 
+		GetDomainUsageQueueManager() persistence.QueueManager
+		SetDomainUsageQueueManager(persistence.QueueManager)
+
 		GetShardManager() persistence.ShardManager
 		SetShardManager(persistence.ShardManager)
 
@@ -70,6 +73,7 @@ type (
 		taskManager                   persistence.TaskManager
 		visibilityManager             persistence.VisibilityManager
 		domainReplicationQueueManager persistence.QueueManager
+		domainUsageQueueManager       persistence.QueueManager
 		shardManager                  persistence.ShardManager
 		historyManager                persistence.HistoryManager
 		configStoreManager            persistence.ConfigStoreManager
@@ -118,6 +122,11 @@ func NewBeanFromFactory(
 		return nil, err
 	}
 
+	domainUsageQueue, err := factory.NewDomainUsageQueueManager()
+	if err != nil {
+		return nil, err
+	}
+
 	shardMgr, err := factory.NewShardManager()
 	if err != nil {
 		return nil, err
@@ -138,6 +147,7 @@ func NewBeanFromFactory(
 		taskMgr,
 		visibilityMgr,
 		domainReplicationQueue,
+		domainUsageQueue,
 		shardMgr,
 		historyMgr,
 		configStoreMgr,
@@ -151,6 +161,7 @@ func NewBean(
 	taskManager persistence.TaskManager,
 	visibilityManager persistence.VisibilityManager,
 	domainReplicationQueueManager persistence.QueueManager,
+	domainUsageQueueManager persistence.QueueManager,
 	shardManager persistence.ShardManager,
 	historyManager persistence.HistoryManager,
 	configStoreManager persistence.ConfigStoreManager,
@@ -161,6 +172,7 @@ func NewBean(
 		taskManager:                   taskManager,
 		visibilityManager:             visibilityManager,
 		domainReplicationQueueManager: domainReplicationQueueManager,
+		domainUsageQueueManager:       domainUsageQueueManager,
 		shardManager:                  shardManager,
 		historyManager:                historyManager,
 		configStoreManager:            configStoreManager,
@@ -250,6 +262,26 @@ func (s *BeanImpl) SetDomainReplicationQueueManager(
 	s.domainReplicationQueueManager = domainReplicationQueueManager
 }
 
+// GetDomainUsageQueueManager gets domain usage QueueManager
+func (s *BeanImpl) GetDomainUsageQueueManager() persistence.QueueManager {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.domainUsageQueueManager
+}
+
+// SetDomainUsageQueueManager sets domain usage QueueManager
+func (s *BeanImpl) SetDomainUsageQueueManager(
+	domainUsageQueueManager persistence.QueueManager,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.domainUsageQueueManager = domainUsageQueueManager
+}
+
 // GetShardManager get ShardManager
 func (s *BeanImpl) GetShardManager() persistence.ShardManager {
 
@@ -365,6 +397,7 @@ func (s *BeanImpl) Close() {
 		s.visibilityManager.Close()
 	}
 	s.domainReplicationQueueManager.Close()
+	s.domainUsageQueueManager.Close()
 	s.shardManager.Close()
 	s.historyManager.Close()
 	s.executionManagerFactory.Close()
@@ -111,6 +111,20 @@ func (mr *MockBeanMockRecorder) GetDomainReplicationQueueManager() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainReplicationQueueManager", reflect.TypeOf((*MockBean)(nil).GetDomainReplicationQueueManager))
 }
 
+// GetDomainUsageQueueManager mocks base method.
+func (m *MockBean) GetDomainUsageQueueManager() persistence.QueueManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainUsageQueueManager")
+	ret0, _ := ret[0].(persistence.QueueManager)
+	return ret0
+}
+
+// GetDomainUsageQueueManager indicates an expected call of GetDomainUsageQueueManager.
+func (mr *MockBeanMockRecorder) GetDomainUsageQueueManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainUsageQueueManager", reflect.TypeOf((*MockBean)(nil).GetDomainUsageQueueManager))
+}
+
 // GetExecutionManager mocks base method.
 func (m *MockBean) GetExecutionManager(arg0 int) (persistence.ExecutionManager, error) {
 	m.ctrl.T.Helper()
@@ -218,6 +232,18 @@ func (mr *MockBeanMockRecorder) SetDomainReplicationQueueManager(arg0 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDomainReplicationQueueManager", reflect.TypeOf((*MockBean)(nil).SetDomainReplicationQueueManager), arg0)
 }
 
+// SetDomainUsageQueueManager mocks base method.
+func (m *MockBean) SetDomainUsageQueueManager(arg0 persistence.QueueManager) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDomainUsageQueueManager", arg0)
+}
+
+// SetDomainUsageQueueManager indicates an expected call of SetDomainUsageQueueManager.
+func (mr *MockBeanMockRecorder) SetDomainUsageQueueManager(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDomainUsageQueueManager", reflect.TypeOf((*MockBean)(nil).SetDomainUsageQueueManager), arg0)
+}
+
 // SetExecutionManager mocks base method.
 func (m *MockBean) SetExecutionManager(arg0 int, arg1 persistence.ExecutionManager) {
 	m.ctrl.T.Helper()
@@ -115,6 +115,21 @@ func (mr *MockFactoryMockRecorder) NewDomainReplicationQueueManager() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDomainReplicationQueueManager", reflect.TypeOf((*MockFactory)(nil).NewDomainReplicationQueueManager))
 }
 
+// NewDomainUsageQueueManager mocks base method.
+func (m *MockFactory) NewDomainUsageQueueManager() (persistence.QueueManager, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDomainUsageQueueManager")
+	ret0, _ := ret[0].(persistence.QueueManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewDomainUsageQueueManager indicates an expected call of NewDomainUsageQueueManager.
+func (mr *MockFactoryMockRecorder) NewDomainUsageQueueManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDomainUsageQueueManager", reflect.TypeOf((*MockFactory)(nil).NewDomainUsageQueueManager))
+}
+
 // NewExecutionManager mocks base method.
 func (m *MockFactory) NewExecutionManager(shardID int) (persistence.ExecutionManager, error) {
 	m.ctrl.T.Helper()
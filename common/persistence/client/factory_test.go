@@ -173,6 +173,13 @@ func TestFactoryMethods(t *testing.T) {
 		ds.EXPECT().NewQueue(persistence.DomainReplicationQueueType).Return(nil, nil).MinTimes(1)
 		check(t, fact.NewDomainReplicationQueueManager)
 	})
+	t.Run("NewDomainUsageQueueManager", func(t *testing.T) {
+		fact := makeFactory(t)
+		ds := mockDatastore(t, fact, storeTypeQueue)
+
+		ds.EXPECT().NewQueue(persistence.DomainUsageQueueType).Return(nil, nil).MinTimes(1)
+		check(t, fact.NewDomainUsageQueueManager)
+	})
 	t.Run("NewConfigStoreManager", func(t *testing.T) {
 		fact := makeFactory(t)
 		ds := mockDatastore(t, fact, storeTypeConfigStore)
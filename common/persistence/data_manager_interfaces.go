@@ -92,6 +92,8 @@ type QueueType int
 // Negative numbers are reserved for DLQ
 const (
 	DomainReplicationQueueType QueueType = iota + 1
+	// DomainUsageQueueType is the queue history hosts append the workflow usage of domains to
+	DomainUsageQueueType
 )
 
 // Create Workflow Execution Mode
@@ -188,6 +190,7 @@ const (
 	TransferTaskTypeRecordWorkflowClosed
 	TransferTaskTypeRecordChildExecutionCompleted
 	TransferTaskTypeApplyParentClosePolicy
+	TransferTaskTypeRecordWorkflowLifecycleEvent
 )
 
 // Types of cross-cluster tasks
@@ -348,6 +351,13 @@ type (
 		Memo                               map[string][]byte
 		SearchAttributes                   map[string][]byte
 		PartitionConfig                    map[string]string
+		// ConflictResolutions is the JSON encoded NDC conflict resolution record, see common/ndc
+		ConflictResolutions []byte
+		// PendingSignalCount is the number of signals the workflow hasn't processed with a decision yet
+		PendingSignalCount int32
+		// ClosedWorkflowQueryResults is the JSON encoded query results pre-computed by the decision which closed
+		// the workflow, see service/history/query
+		ClosedWorkflowQueryResults []byte
 		// for retry
 		Attempt            int32
 		HasRetryPolicy     bool
@@ -406,6 +416,7 @@ type (
 		ScheduleID              int64
 		Version                 int64
 		RecordVisibility        bool
+		LifecycleEvent          []byte // used for RecordWorkflowLifecycleEvent task
 	}
 
 	// CrossClusterTaskInfo describes a cross-cluster task
@@ -428,6 +439,8 @@ type (
 		BranchToken       []byte
 		NewRunBranchToken []byte
 		CreationTime      int64
+		// ErrorClass is set on replication DLQ messages, it classifies the error the task failed with
+		ErrorClass string
 	}
 
 	// TimerTaskInfo describes a timer task.
@@ -553,6 +566,8 @@ type (
 		LastFailureReason  string
 		LastWorkerIdentity string
 		LastFailureDetails []byte
+		// Paused holds back dispatching the activity until it is unpaused
+		Paused bool
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
 	}
@@ -1218,6 +1233,7 @@ type (
 		Info                        *DomainInfo
 		Config                      *DomainConfig
 		ReplicationConfig           *DomainReplicationConfig
+		IsGlobalDomain              bool
 		ConfigVersion               int64
 		FailoverVersion             int64
 		FailoverNotificationVersion int64
@@ -273,6 +273,7 @@ type (
 		BranchToken       []byte
 		NewRunBranchToken []byte
 		CreationTime      time.Time
+		ErrorClass        string
 	}
 
 	// InternalWorkflowExecutionInfo describes a workflow execution for Persistence Interface
@@ -334,6 +335,13 @@ type (
 		Memo               map[string][]byte
 		SearchAttributes   map[string][]byte
 		PartitionConfig    map[string]string
+		// ConflictResolutions is the JSON encoded NDC conflict resolution record, see common/ndc
+		ConflictResolutions []byte
+		// PendingSignalCount is the number of signals the workflow hasn't processed with a decision yet
+		PendingSignalCount int32
+		// ClosedWorkflowQueryResults is the JSON encoded query results pre-computed by the decision which closed
+		// the workflow, see service/history/query
+		ClosedWorkflowQueryResults []byte
 
 		// attributes which are not related to mutable state at all
 		HistorySize int64
@@ -396,6 +404,8 @@ type (
 		LastFailureReason  string
 		LastWorkerIdentity string
 		LastFailureDetails []byte
+		// Paused holds back dispatching the activity until it is unpaused
+		Paused bool
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
 	}
@@ -842,6 +852,7 @@ type (
 		Info                        *DomainInfo
 		Config                      *InternalDomainConfig
 		ReplicationConfig           *DomainReplicationConfig
+		IsGlobalDomain              bool
 		ConfigVersion               int64
 		FailoverVersion             int64
 		FailoverNotificationVersion int64
@@ -197,6 +197,9 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		SearchAttributes:                   info.SearchAttributes,
 		Memo:                               info.Memo,
 		PartitionConfig:                    info.PartitionConfig,
+		ConflictResolutions:                info.ConflictResolutions,
+		PendingSignalCount:                 info.PendingSignalCount,
+		ClosedWorkflowQueryResults:         info.ClosedWorkflowQueryResults,
 	}
 	newStats := &ExecutionStats{
 		HistorySize: info.HistorySize,
@@ -315,6 +318,7 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			LastFailureReason:                       v.LastFailureReason,
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
 			LastFailureDetails:                      v.LastFailureDetails,
+			Paused:                                  v.Paused,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos[k] = a
@@ -442,6 +446,7 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			LastFailureReason:                       v.LastFailureReason,
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
 			LastFailureDetails:                      v.LastFailureDetails,
+			Paused:                                  v.Paused,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos = append(newInfos, i)
@@ -525,6 +530,9 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		Memo:                               info.Memo,
 		SearchAttributes:                   info.SearchAttributes,
 		PartitionConfig:                    info.PartitionConfig,
+		ConflictResolutions:                info.ConflictResolutions,
+		PendingSignalCount:                 info.PendingSignalCount,
+		ClosedWorkflowQueryResults:         info.ClosedWorkflowQueryResults,
 
 		// attributes which are not related to mutable state
 		HistorySize: stats.HistorySize,
@@ -1025,6 +1033,7 @@ func (m *executionManagerImpl) fromInternalReplicationTaskInfo(internalInfo *Int
 		BranchToken:       internalInfo.BranchToken,
 		NewRunBranchToken: internalInfo.NewRunBranchToken,
 		CreationTime:      internalInfo.CreationTime.UnixNano(),
+		ErrorClass:        internalInfo.ErrorClass,
 	}
 }
 
@@ -1045,6 +1054,7 @@ func (m *executionManagerImpl) toInternalReplicationTaskInfo(info *ReplicationTa
 		BranchToken:       info.BranchToken,
 		NewRunBranchToken: info.NewRunBranchToken,
 		CreationTime:      time.Unix(0, info.CreationTime),
+		ErrorClass:        info.ErrorClass,
 	}
 }
 
@@ -106,6 +106,7 @@ func (m *nosqlDomainStore) UpdateDomain(
 		Info:                        request.Info,
 		Config:                      config,
 		ReplicationConfig:           request.ReplicationConfig,
+		IsGlobalDomain:              request.IsGlobalDomain,
 		ConfigVersion:               request.ConfigVersion,
 		FailoverVersion:             request.FailoverVersion,
 		FailoverNotificationVersion: request.FailoverNotificationVersion,
@@ -450,6 +450,7 @@ func (d *nosqlExecutionStore) prepareTransferTasksForWorkflowTxn(domainID, workf
 		targetRunID := persistence.TransferTaskTransferTargetRunID
 		targetChildWorkflowOnly := false
 		recordVisibility := false
+		var lifecycleEvent []byte
 
 		switch task.GetType() {
 		case persistence.TransferTaskTypeActivityTask:
@@ -499,6 +500,9 @@ func (d *nosqlExecutionStore) prepareTransferTasksForWorkflowTxn(domainID, workf
 		case persistence.TransferTaskTypeApplyParentClosePolicy:
 			targetDomainIDs = task.(*persistence.ApplyParentClosePolicyTask).TargetDomainIDs
 
+		case persistence.TransferTaskTypeRecordWorkflowLifecycleEvent:
+			lifecycleEvent = task.(*persistence.RecordWorkflowLifecycleEventTask).LifecycleEvent
+
 		case persistence.TransferTaskTypeCloseExecution,
 			persistence.TransferTaskTypeRecordWorkflowStarted,
 			persistence.TransferTaskTypeResetWorkflow,
@@ -527,6 +531,7 @@ func (d *nosqlExecutionStore) prepareTransferTasksForWorkflowTxn(domainID, workf
 			ScheduleID:              scheduleID,
 			RecordVisibility:        recordVisibility,
 			Version:                 task.GetVersion(),
+			LifecycleEvent:          lifecycleEvent,
 		}
 		tasks = append(tasks, t)
 	}
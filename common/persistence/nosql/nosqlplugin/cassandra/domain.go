@@ -193,6 +193,7 @@ func (db *cdb) UpdateDomain(ctx context.Context, row *nosqlplugin.DomainRow) err
 		asyncWFConfigEncoding,
 		row.ReplicationConfig.ActiveClusterName,
 		persistence.SerializeClusterConfigs(row.ReplicationConfig.Clusters),
+		row.IsGlobalDomain,
 		row.ConfigVersion,
 		row.FailoverVersion,
 		row.FailoverNotificationVersion,
@@ -95,6 +95,7 @@ const (
 		`SET domain = ` + templateDomainInfoType + `, ` +
 		`config = ` + templateDomainConfigType + `, ` +
 		`replication_config = ` + templateDomainReplicationConfigType + `, ` +
+		`is_global_domain = ?, ` +
 		`config_version = ? ,` +
 		`failover_version = ? ,` +
 		`failover_notification_version = ? , ` +
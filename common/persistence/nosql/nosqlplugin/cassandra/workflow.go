@@ -646,6 +646,7 @@ func (db *cdb) InsertReplicationDLQTask(ctx context.Context, shardID int, source
 		persistence.EventStoreVersion,
 		task.NewRunBranchToken,
 		defaultVisibilityTimestamp,
+		task.ErrorClass,
 		defaultVisibilityTimestamp,
 		task.TaskID,
 	).WithContext(ctx)
@@ -82,7 +82,10 @@ const (
 		`expiration_seconds: ?, ` +
 		`search_attributes: ?, ` +
 		`memo: ?, ` +
-		`partition_config: ? ` +
+		`partition_config: ?, ` +
+		`conflict_resolutions: ?, ` +
+		`pending_signal_count: ?, ` +
+		`closed_workflow_query_results: ? ` +
 		`}`
 
 	templateTransferTaskType = `{` +
@@ -100,7 +103,8 @@ const (
 		`type: ?, ` +
 		`schedule_id: ?, ` +
 		`record_visibility: ?, ` +
-		`version: ?` +
+		`version: ?, ` +
+		`lifecycle_event: ?` +
 		`}`
 
 	templateCrossClusterTaskType = templateTransferTaskType
@@ -119,7 +123,8 @@ const (
 		`branch_token: ?, ` +
 		`new_run_event_store_version: ?, ` +
 		`new_run_branch_token: ?, ` +
-		`created_time: ? ` +
+		`created_time: ?, ` +
+		`error_class: ? ` +
 		`}`
 
 	templateTimerTaskType = `{` +
@@ -168,7 +173,8 @@ const (
 		`last_failure_reason: ?, ` +
 		`last_worker_identity: ?, ` +
 		`last_failure_details: ?, ` +
-		`event_data_encoding: ?` +
+		`event_data_encoding: ?, ` +
+		`paused: ?` +
 		`}`
 
 	templateTimerInfoType = `{` +
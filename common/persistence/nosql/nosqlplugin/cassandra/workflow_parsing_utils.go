@@ -175,6 +175,12 @@ func parseWorkflowExecutionInfo(result map[string]interface{}) *persistence.Inte
 			info.Memo = v.(map[string][]byte)
 		case "partition_config":
 			info.PartitionConfig = v.(map[string]string)
+		case "conflict_resolutions":
+			info.ConflictResolutions = v.([]byte)
+		case "pending_signal_count":
+			info.PendingSignalCount = int32(v.(int))
+		case "closed_workflow_query_results":
+			info.ClosedWorkflowQueryResults = v.([]byte)
 		}
 	}
 	info.CompletionEvent = persistence.NewDataBlob(completionEventData, completionEventEncoding)
@@ -305,6 +311,8 @@ func parseActivityInfo(
 			info.LastWorkerIdentity = v.(string)
 		case "last_failure_details":
 			info.LastFailureDetails = v.([]byte)
+		case "paused":
+			info.Paused = v.(bool)
 		case "event_data_encoding":
 			sharedEncoding = common.EncodingType(v.(string))
 		}
@@ -533,6 +541,8 @@ func parseTransferTaskInfo(
 			info.RecordVisibility = v.(bool)
 		case "version":
 			info.Version = v.(int64)
+		case "lifecycle_event":
+			info.LifecycleEvent = v.([]byte)
 		}
 	}
 
@@ -576,6 +586,8 @@ func parseReplicationTaskInfo(
 			info.NewRunBranchToken = v.([]byte)
 		case "created_time":
 			info.CreationTime = time.Unix(0, v.(int64))
+		case "error_class":
+			info.ErrorClass = v.(string)
 		}
 	}
 
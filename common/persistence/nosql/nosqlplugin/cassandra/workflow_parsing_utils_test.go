@@ -114,6 +114,9 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 				"search_attributes":                     searchAttributes,
 				"memo":                                  memo,
 				"partition_config":                      partitionConfig,
+				"conflict_resolutions":                  []byte(`[{"reason":"forked"}]`),
+				"pending_signal_count":                  3,
+				"closed_workflow_query_results":         []byte(`{"state":"InN0YXRlIg=="}`),
 				"completion_event":                      completionEventData,
 				"completion_event_data_encoding":        "Proto3",
 				"auto_reset_points":                     autoResetPointsData,
@@ -168,6 +171,9 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 				NonRetriableErrors:                 []string{"error1", "error2"},
 				Memo:                               memo,
 				PartitionConfig:                    partitionConfig,
+				ConflictResolutions:                []byte(`[{"reason":"forked"}]`),
+				PendingSignalCount:                 3,
+				ClosedWorkflowQueryResults:         []byte(`{"state":"InN0YXRlIg=="}`),
 			},
 		},
 		{
@@ -541,6 +547,7 @@ func Test_parseTransferTaskInfo(t *testing.T) {
 		"schedule_id":                int64(3),
 		"record_visibility":          true,
 		"version":                    int64(4),
+		"lifecycle_event":            []byte("lifecycle_event"),
 	}
 	expected := &persistence.TransferTaskInfo{
 		DomainID:                "domain_id",
@@ -558,6 +565,7 @@ func Test_parseTransferTaskInfo(t *testing.T) {
 		ScheduleID:              int64(3),
 		RecordVisibility:        true,
 		Version:                 int64(4),
+		LifecycleEvent:          []byte("lifecycle_event"),
 	}
 	assert.Equal(t, expected, parseTransferTaskInfo(testInput))
 
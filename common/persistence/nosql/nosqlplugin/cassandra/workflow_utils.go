@@ -480,6 +480,7 @@ func createReplicationTasks(
 			persistence.EventStoreVersion,
 			task.NewRunBranchToken,
 			task.CreationTime.UnixNano(),
+			task.ErrorClass,
 			// NOTE: use a constant here instead of task.VisibilityTimestamp so that we can query tasks with the same visibilityTimestamp
 			defaultVisibilityTimestamp,
 			task.TaskID)
@@ -515,6 +516,7 @@ func createTransferTasks(
 			task.ScheduleID,
 			task.RecordVisibility,
 			task.Version,
+			task.LifecycleEvent,
 			// NOTE: use a constant here instead of task.VisibilityTimestamp so that we can query tasks with the same visibilityTimestamp
 			defaultVisibilityTimestamp,
 			task.TaskID)
@@ -550,6 +552,7 @@ func createCrossClusterTasks(
 			task.ScheduleID,
 			task.RecordVisibility,
 			task.Version,
+			task.LifecycleEvent,
 			// NOTE: use a constant here instead of task.VisibilityTimestamp so that we can query tasks with the same visibilityTimestamp
 			defaultVisibilityTimestamp,
 			task.TaskID,
@@ -1010,6 +1013,7 @@ func resetActivityInfoMap(activityInfos map[int64]*persistence.InternalActivityI
 		aInfo["last_failure_reason"] = a.LastFailureReason
 		aInfo["last_worker_identity"] = a.LastWorkerIdentity
 		aInfo["last_failure_details"] = a.LastFailureDetails
+		aInfo["paused"] = a.Paused
 
 		aMap[a.ScheduleID] = aInfo
 	}
@@ -1062,6 +1066,7 @@ func updateActivityInfos(
 			a.LastWorkerIdentity,
 			a.LastFailureDetails,
 			a.ScheduledEvent.GetEncodingString(),
+			a.Paused,
 			shardID,
 			rowTypeExecution,
 			domainID,
@@ -1360,6 +1365,9 @@ func updateWorkflowExecution(
 		execution.SearchAttributes,
 		execution.Memo,
 		execution.PartitionConfig,
+		execution.ConflictResolutions,
+		execution.PendingSignalCount,
+		execution.ClosedWorkflowQueryResults,
 		execution.NextEventID,
 		execution.VersionHistories.Data,
 		execution.VersionHistories.GetEncodingString(),
@@ -1456,6 +1464,9 @@ func createWorkflowExecution(
 		execution.SearchAttributes,
 		execution.Memo,
 		execution.PartitionConfig,
+		execution.ConflictResolutions,
+		execution.PendingSignalCount,
+		execution.ClosedWorkflowQueryResults,
 		execution.NextEventID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
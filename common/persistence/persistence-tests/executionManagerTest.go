@@ -2901,6 +2901,14 @@ func (s *ExecutionManagerSuite) TestTransferTasksComplete() {
 			},
 			TargetDomainIDs: map[string]struct{}{targetDomainID: {}},
 		},
+		&p.RecordWorkflowLifecycleEventTask{
+			TaskData: p.TaskData{
+				VisibilityTimestamp: now,
+				TaskID:              currentTransferID + 10010,
+				Version:             1110,
+			},
+			LifecycleEvent: []byte(`{"eventType":"signaled"}`),
+		},
 	}
 	versionHistory := p.NewVersionHistory([]byte{}, []*p.VersionHistoryItem{
 		{
@@ -2928,6 +2936,8 @@ func (s *ExecutionManagerSuite) TestTransferTasksComplete() {
 	s.Equal(p.TransferTaskTypeRecordWorkflowClosed, txTasks[6].TaskType)
 	s.Equal(p.TransferTaskTypeRecordChildExecutionCompleted, txTasks[7].TaskType)
 	s.Equal(p.TransferTaskTypeApplyParentClosePolicy, txTasks[8].TaskType)
+	s.Equal(p.TransferTaskTypeRecordWorkflowLifecycleEvent, txTasks[9].TaskType)
+	s.Equal([]byte(`{"eventType":"signaled"}`), txTasks[9].LifecycleEvent)
 
 	for idx := range txTasks {
 		// TODO: add a check similar to validateCrossClusterTasks
@@ -637,6 +637,7 @@ func (m *MetadataPersistenceSuiteV2) TestConcurrentUpdateDomain() {
 					ActiveClusterName: resp2.ReplicationConfig.ActiveClusterName,
 					Clusters:          resp2.ReplicationConfig.Clusters,
 				},
+				resp2.IsGlobalDomain,
 				resp2.ConfigVersion,
 				resp2.FailoverVersion,
 				resp2.FailoverNotificationVersion,
@@ -851,6 +852,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 			ActiveClusterName: updateClusterActive,
 			Clusters:          updateClusters,
 		},
+		isGlobalDomain,
 		updateConfigVersion,
 		updateFailoverVersion,
 		updateFailoverNotificationVersion,
@@ -949,6 +951,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 			ActiveClusterName: updateClusterActive,
 			Clusters:          updateClusters,
 		},
+		isGlobalDomain,
 		updateConfigVersion,
 		updateFailoverVersion,
 		updateFailoverNotificationVersion,
@@ -1311,6 +1314,7 @@ func (m *MetadataPersistenceSuiteV2) UpdateDomain(
 	info *p.DomainInfo,
 	config *p.DomainConfig,
 	replicationConfig *p.DomainReplicationConfig,
+	isGlobalDomain bool,
 	configVersion int64,
 	failoverVersion int64,
 	failoverNotificationVersion int64,
@@ -1324,6 +1328,7 @@ func (m *MetadataPersistenceSuiteV2) UpdateDomain(
 		Info:                        info,
 		Config:                      config,
 		ReplicationConfig:           replicationConfig,
+		IsGlobalDomain:              isGlobalDomain,
 		FailoverEndTime:             failoverEndTime,
 		ConfigVersion:               configVersion,
 		FailoverVersion:             failoverVersion,
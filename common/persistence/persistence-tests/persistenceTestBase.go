@@ -1072,6 +1072,7 @@ func (s *TestBase) UpdateWorkflowExecutionWithReplication(
 			*persistence.RecordWorkflowClosedTask,
 			*persistence.RecordChildExecutionCompletedTask,
 			*persistence.ApplyParentClosePolicyTask,
+			*persistence.RecordWorkflowLifecycleEventTask,
 			*persistence.CancelExecutionTask,
 			*persistence.StartChildExecutionTask,
 			*persistence.SignalExecutionTask,
@@ -900,6 +900,30 @@ func (w *WorkflowExecutionInfo) GetChecksumEncoding() (o string) {
 	return
 }
 
+// GetConflictResolutions internal sql blob getter
+func (w *WorkflowExecutionInfo) GetConflictResolutions() (o []byte) {
+	if w != nil {
+		return w.ConflictResolutions
+	}
+	return
+}
+
+// GetPendingSignalCount internal sql blob getter
+func (w *WorkflowExecutionInfo) GetPendingSignalCount() (o int64) {
+	if w != nil {
+		return w.PendingSignalCount
+	}
+	return
+}
+
+// GetClosedWorkflowQueryResults internal sql blob getter
+func (w *WorkflowExecutionInfo) GetClosedWorkflowQueryResults() (o []byte) {
+	if w != nil {
+		return w.ClosedWorkflowQueryResults
+	}
+	return
+}
+
 // GetVersion internal sql blob getter
 func (a *ActivityInfo) GetVersion() (o int64) {
 	if a != nil {
@@ -1124,6 +1148,14 @@ func (a *ActivityInfo) GetCancelRequested() (o bool) {
 	return
 }
 
+// GetPaused internal sql blob getter
+func (a *ActivityInfo) GetPaused() (o bool) {
+	if a != nil {
+		return a.Paused
+	}
+	return
+}
+
 // GetHasRetryPolicy internal sql blob getter
 func (a *ActivityInfo) GetHasRetryPolicy() (o bool) {
 	if a != nil {
@@ -1552,6 +1584,14 @@ func (t *TransferTaskInfo) GetVisibilityTimestamp() time.Time {
 	return time.Unix(0, 0)
 }
 
+// GetLifecycleEvent internal sql blob getter
+func (t *TransferTaskInfo) GetLifecycleEvent() (o []byte) {
+	if t != nil {
+		return t.LifecycleEvent
+	}
+	return
+}
+
 // GetDomainID internal sql blob getter
 func (t *TimerTaskInfo) GetDomainID() (o []byte) {
 	if t != nil && t.DomainID != nil {
@@ -1719,3 +1759,11 @@ func (t *ReplicationTaskInfo) GetCreationTimestamp() time.Time {
 	}
 	return time.Unix(0, 0)
 }
+
+// GetErrorClass internal sql blob getter
+func (t *ReplicationTaskInfo) GetErrorClass() (o string) {
+	if t != nil {
+		return t.ErrorClass
+	}
+	return
+}
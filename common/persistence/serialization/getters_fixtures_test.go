@@ -97,6 +97,9 @@ var expectedNil = map[string]map[string]any{
 		"GetWorkflowTypeName":                   "",
 		"GetChecksum":                           []uint8(nil),
 		"GetChecksumEncoding":                   "",
+		"GetConflictResolutions":                []uint8(nil),
+		"GetPendingSignalCount":                 int64(0),
+		"GetClosedWorkflowQueryResults":         []uint8(nil),
 	},
 	"*serialization.TimerTaskInfo": {
 		"GetDomainID":        []uint8(nil),
@@ -112,6 +115,7 @@ var expectedNil = map[string]map[string]any{
 		"GetBranchToken":             []uint8(nil),
 		"GetCreationTimestamp":       zeroUnix,
 		"GetDomainID":                []uint8(nil),
+		"GetErrorClass":              "",
 		"GetEventStoreVersion":       int32(0),
 		"GetFirstEventID":            int64(0),
 		"GetNewRunBranchToken":       []uint8(nil),
@@ -179,6 +183,7 @@ var expectedNil = map[string]map[string]any{
 		"GetCancelRequested":          false,
 		"GetHasRetryPolicy":           false,
 		"GetHeartbeatTimeout":         time.Duration(0),
+		"GetPaused":                   false,
 		"GetRequestID":                "",
 		"GetRetryBackoffCoefficient":  float64(0),
 		"GetRetryExpirationTimestamp": zeroUnix,
@@ -324,6 +329,9 @@ var expectedEmpty = map[string]map[string]any{
 		"GetWorkflowTypeName":                   "",
 		"GetChecksum":                           []uint8(nil),
 		"GetChecksumEncoding":                   "",
+		"GetConflictResolutions":                []uint8(nil),
+		"GetPendingSignalCount":                 int64(0),
+		"GetClosedWorkflowQueryResults":         []uint8(nil),
 	},
 	"*serialization.TimerTaskInfo": {
 		"GetDomainID":        []uint8(nil),
@@ -339,6 +347,7 @@ var expectedEmpty = map[string]map[string]any{
 		"GetBranchToken":             []uint8(nil),
 		"GetCreationTimestamp":       time.Time{},
 		"GetDomainID":                []uint8(nil),
+		"GetErrorClass":              "",
 		"GetEventStoreVersion":       int32(0),
 		"GetFirstEventID":            int64(0),
 		"GetNewRunBranchToken":       []uint8(nil),
@@ -406,6 +415,7 @@ var expectedEmpty = map[string]map[string]any{
 		"GetCancelRequested":          false,
 		"GetHasRetryPolicy":           false,
 		"GetHeartbeatTimeout":         time.Duration(0),
+		"GetPaused":                   false,
 		"GetRequestID":                "",
 		"GetRetryBackoffCoefficient":  float64(0),
 		"GetRetryExpirationTimestamp": time.Time{},
@@ -553,6 +563,9 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetWorkflowTypeName":             "workflowTypeName",
 		"GetChecksum":                     []uint8(nil),
 		"GetChecksumEncoding":             "",
+		"GetConflictResolutions":          []uint8(nil),
+		"GetPendingSignalCount":           int64(0),
+		"GetClosedWorkflowQueryResults":   []uint8(nil),
 	},
 	"*serialization.TimerTaskInfo": {
 		"GetDomainID":        []byte(taskDomainID),
@@ -568,6 +581,7 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetBranchToken":             []byte("branchToken"),
 		"GetCreationTimestamp":       replicationCreationTimestamp,
 		"GetDomainID":                []uint8(replicationTaskDomainID),
+		"GetErrorClass":              "errorClass",
 		"GetEventStoreVersion":       int32(6),
 		"GetFirstEventID":            int64(3),
 		"GetNewRunBranchToken":       []byte("newRunBranchToken"),
@@ -635,6 +649,7 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetCancelRequested":          true,
 		"GetHasRetryPolicy":           true,
 		"GetHeartbeatTimeout":         time.Duration(4),
+		"GetPaused":                   true,
 		"GetRequestID":                "requestID",
 		"GetRetryBackoffCoefficient":  float64(8),
 		"GetRetryExpirationTimestamp": activeInfoRetryExpirationTime,
@@ -172,6 +172,7 @@ func TestGettersForInfos(t *testing.T) {
 			BranchToken:             []byte("branchToken"),
 			NewRunBranchToken:       []byte("newRunBranchToken"),
 			CreationTimestamp:       replicationCreationTimestamp,
+			ErrorClass:              "errorClass",
 		},
 		&TaskListInfo{
 			Kind:            1,
@@ -255,6 +256,7 @@ func TestGettersForInfos(t *testing.T) {
 			RetryLastWorkerIdentity:  "retryLastWorkerIdentity",
 			RetryLastFailureReason:   "retryLastFailureReason",
 			RetryLastFailureDetails:  []byte("retryLastFailureDetails"),
+			Paused:                   true,
 		},
 		&HistoryTreeInfo{
 			CreatedTimestamp: historyTreeEventCreatedTime,
@@ -171,6 +171,9 @@ type (
 		PartitionConfig                    map[string]string
 		Checksum                           []byte
 		ChecksumEncoding                   string
+		ConflictResolutions                []byte
+		PendingSignalCount                 int64
+		ClosedWorkflowQueryResults         []byte
 	}
 
 	// ActivityInfo blob in a serialization agnostic format
@@ -206,6 +209,7 @@ type (
 		RetryLastFailureReason   string
 		RetryLastWorkerIdentity  string
 		RetryLastFailureDetails  []byte
+		Paused                   bool
 	}
 
 	// ChildExecutionInfo blob in a serialization agnostic format
@@ -284,6 +288,7 @@ type (
 		ScheduleID              int64
 		Version                 int64
 		VisibilityTimestamp     time.Time
+		LifecycleEvent          []byte
 	}
 
 	// CrossClusterTaskInfo blob in a serialization agnostic format
@@ -320,6 +325,7 @@ type (
 		BranchToken             []byte
 		NewRunBranchToken       []byte
 		CreationTimestamp       time.Time
+		ErrorClass              string
 	}
 )
 
@@ -232,6 +232,7 @@ func TestParserRoundTrip(t *testing.T) {
 			ScheduleID:              1,
 			Version:                 2,
 			VisibilityTimestamp:     now,
+			LifecycleEvent:          []byte("test_lifecycle_event"),
 		},
 		&TimerTaskInfo{
 			DomainID:        MustParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
@@ -75,6 +75,9 @@ func ToInternalWorkflowExecutionInfo(info *WorkflowExecutionInfo) *persistence.I
 		FirstExecutionRunID:                info.FirstExecutionRunID.String(),
 		PartitionConfig:                    info.PartitionConfig,
 		IsCron:                             info.IsCron,
+		ConflictResolutions:                info.GetConflictResolutions(),
+		PendingSignalCount:                 int32(info.GetPendingSignalCount()),
+		ClosedWorkflowQueryResults:         info.GetClosedWorkflowQueryResults(),
 	}
 	if info.ParentDomainID != nil {
 		result.ParentDomainID = info.ParentDomainID.String()
@@ -158,6 +161,9 @@ func FromInternalWorkflowExecutionInfo(executionInfo *persistence.InternalWorkfl
 		FirstExecutionRunID:                MustParseUUID(executionInfo.FirstExecutionRunID),
 		PartitionConfig:                    executionInfo.PartitionConfig,
 		IsCron:                             executionInfo.IsCron,
+		ConflictResolutions:                executionInfo.ConflictResolutions,
+		PendingSignalCount:                 int64(executionInfo.PendingSignalCount),
+		ClosedWorkflowQueryResults:         executionInfo.ClosedWorkflowQueryResults,
 	}
 
 	if executionInfo.CompletionEvent != nil {
@@ -90,6 +90,9 @@ func TestInternalWorkflowExecutionInfo(t *testing.T) {
 		HistorySize:                        int64(rand.Intn(1000)),
 		PartitionConfig:                    map[string]string{"zone": "dca1"},
 		IsCron:                             true,
+		ConflictResolutions:                []byte("ConflictResolutions"),
+		PendingSignalCount:                 int32(rand.Intn(1000)),
+		ClosedWorkflowQueryResults:         []byte("ClosedWorkflowQueryResults"),
 	}
 	actual := ToInternalWorkflowExecutionInfo(FromInternalWorkflowExecutionInfo(expected))
 	assert.Equal(t, expected.ParentDomainID, actual.ParentDomainID)
@@ -146,4 +149,7 @@ func TestInternalWorkflowExecutionInfo(t *testing.T) {
 	assert.Equal(t, expected.HistorySize, actual.HistorySize)
 	assert.Equal(t, expected.PartitionConfig, actual.PartitionConfig)
 	assert.Equal(t, expected.IsCron, actual.IsCron)
+	assert.Equal(t, expected.ConflictResolutions, actual.ConflictResolutions)
+	assert.Equal(t, expected.PendingSignalCount, actual.PendingSignalCount)
+	assert.Equal(t, expected.ClosedWorkflowQueryResults, actual.ClosedWorkflowQueryResults)
 }
@@ -258,6 +258,9 @@ func workflowExecutionInfoToThrift(info *WorkflowExecutionInfo) *sqlblobs.Workfl
 		PartitionConfig:                         info.PartitionConfig,
 		Checksum:                                info.Checksum,
 		ChecksumEncoding:                        &info.ChecksumEncoding,
+		ConflictResolutions:                     info.ConflictResolutions,
+		PendingSignalCount:                      &info.PendingSignalCount,
+		ClosedWorkflowQueryResults:              info.ClosedWorkflowQueryResults,
 	}
 }
 
@@ -329,6 +332,9 @@ func workflowExecutionInfoFromThrift(info *sqlblobs.WorkflowExecutionInfo) *Work
 		IsCron:                             info.GetCronSchedule() != "",
 		Checksum:                           info.Checksum,
 		ChecksumEncoding:                   info.GetChecksumEncoding(),
+		ConflictResolutions:                info.ConflictResolutions,
+		PendingSignalCount:                 info.GetPendingSignalCount(),
+		ClosedWorkflowQueryResults:         info.ClosedWorkflowQueryResults,
 	}
 }
 
@@ -368,6 +374,7 @@ func activityInfoToThrift(info *ActivityInfo) *sqlblobs.ActivityInfo {
 		RetryLastFailureReason:        &info.RetryLastFailureReason,
 		RetryLastWorkerIdentity:       &info.RetryLastWorkerIdentity,
 		RetryLastFailureDetails:       info.RetryLastFailureDetails,
+		Paused:                        &info.Paused,
 	}
 }
 
@@ -407,6 +414,7 @@ func activityInfoFromThrift(info *sqlblobs.ActivityInfo) *ActivityInfo {
 		RetryLastFailureReason:   info.GetRetryLastFailureReason(),
 		RetryLastWorkerIdentity:  info.GetRetryLastWorkerIdentity(),
 		RetryLastFailureDetails:  info.RetryLastFailureDetails,
+		Paused:                   info.GetPaused(),
 	}
 }
 
@@ -598,6 +606,7 @@ func transferTaskInfoToThrift(info *TransferTaskInfo) *sqlblobs.TransferTaskInfo
 		ScheduleID:               &info.ScheduleID,
 		Version:                  &info.Version,
 		VisibilityTimestampNanos: timeToUnixNanoPtr(info.VisibilityTimestamp),
+		LifecycleEvent:           info.LifecycleEvent,
 	}
 	if len(info.TargetDomainIDs) > 0 {
 		thriftTaskInfo.TargetDomainIDs = [][]byte{}
@@ -626,6 +635,7 @@ func transferTaskInfoFromThrift(info *sqlblobs.TransferTaskInfo) *TransferTaskIn
 		ScheduleID:              info.GetScheduleID(),
 		Version:                 info.GetVersion(),
 		VisibilityTimestamp:     timeFromUnixNano(info.GetVisibilityTimestampNanos()),
+		LifecycleEvent:          info.GetLifecycleEvent(),
 	}
 	if len(info.GetTargetDomainIDs()) > 0 {
 		transferTaskInfo.TargetDomainIDs = []UUID{}
@@ -694,6 +704,7 @@ func replicationTaskInfoToThrift(info *ReplicationTaskInfo) *sqlblobs.Replicatio
 		BranchToken:             info.BranchToken,
 		NewRunBranchToken:       info.NewRunBranchToken,
 		CreationTime:            timeToUnixNanoPtr(info.CreationTimestamp),
+		ErrorClass:              &info.ErrorClass,
 	}
 }
 
@@ -715,6 +726,7 @@ func replicationTaskInfoFromThrift(info *sqlblobs.ReplicationTaskInfo) *Replicat
 		BranchToken:             info.BranchToken,
 		NewRunBranchToken:       info.NewRunBranchToken,
 		CreationTimestamp:       timeFromUnixNano(info.GetCreationTime()),
+		ErrorClass:              info.GetErrorClass(),
 	}
 }
 
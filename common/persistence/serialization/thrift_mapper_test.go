@@ -234,6 +234,9 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 		PartitionConfig:                    map[string]string{"zone": "dca1"},
 		Checksum:                           []byte("Checksum"),
 		ChecksumEncoding:                   "ChecksumEncoding",
+		ConflictResolutions:                []byte("ConflictResolutions"),
+		PendingSignalCount:                 int64(rand.Intn(1000)),
+		ClosedWorkflowQueryResults:         []byte("ClosedWorkflowQueryResults"),
 	}
 	actual := workflowExecutionInfoFromThrift(workflowExecutionInfoToThrift(expected))
 	assert.Equal(t, expected.ParentDomainID, actual.ParentDomainID)
@@ -297,6 +300,9 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 	assert.Equal(t, expected.PartitionConfig, actual.PartitionConfig)
 	assert.Equal(t, expected.Checksum, actual.Checksum)
 	assert.Equal(t, expected.ChecksumEncoding, actual.ChecksumEncoding)
+	assert.Equal(t, expected.ConflictResolutions, actual.ConflictResolutions)
+	assert.Equal(t, expected.PendingSignalCount, actual.PendingSignalCount)
+	assert.Equal(t, expected.ClosedWorkflowQueryResults, actual.ClosedWorkflowQueryResults)
 }
 
 func TestActivityInfo(t *testing.T) {
@@ -332,6 +338,7 @@ func TestActivityInfo(t *testing.T) {
 		RetryLastFailureReason:   "RetryLastFailureReason",
 		RetryLastWorkerIdentity:  "RetryLastWorkerIdentity",
 		RetryLastFailureDetails:  []byte("RetryLastFailureDetails"),
+		Paused:                   true,
 	}
 	actual := activityInfoFromThrift(activityInfoToThrift(expected))
 	assert.Equal(t, expected.Version, actual.Version)
@@ -356,6 +363,7 @@ func TestActivityInfo(t *testing.T) {
 	assert.Equal(t, expected.RetryLastFailureReason, actual.RetryLastFailureReason)
 	assert.Equal(t, expected.RetryLastWorkerIdentity, actual.RetryLastWorkerIdentity)
 	assert.Equal(t, expected.RetryLastFailureDetails, actual.RetryLastFailureDetails)
+	assert.Equal(t, expected.Paused, actual.Paused)
 	assert.True(t, (expected.ScheduleToStartTimeout-actual.ScheduleToStartTimeout) < time.Second)
 	assert.True(t, (expected.ScheduleToCloseTimeout-actual.ScheduleToCloseTimeout) < time.Second)
 	assert.True(t, (expected.StartToCloseTimeout-actual.StartToCloseTimeout) < time.Second)
@@ -471,6 +479,7 @@ func TestTransferTaskInfo(t *testing.T) {
 		TargetChildWorkflowOnly: true,
 		ScheduleID:              int64(rand.Intn(1000)),
 		Version:                 int64(rand.Intn(1000)),
+		LifecycleEvent:          []byte("LifecycleEvent"),
 	}
 	actual := transferTaskInfoFromThrift(transferTaskInfoToThrift(expected))
 	assert.Equal(t, expected, actual)
@@ -505,6 +514,7 @@ func TestReplicationTaskInfo(t *testing.T) {
 		NewRunEventStoreVersion: int32(rand.Intn(1000)),
 		BranchToken:             []byte("BranchToken"),
 		NewRunBranchToken:       []byte("NewRunBranchToken"),
+		ErrorClass:              "ErrorClass",
 	}
 	actual := replicationTaskInfoFromThrift(replicationTaskInfoToThrift(expected))
 	assert.Equal(t, expected, actual)
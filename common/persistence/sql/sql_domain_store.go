@@ -361,6 +361,7 @@ func (m *sqlDomainStore) UpdateDomain(
 		result, err := tx.UpdateDomain(ctx, &sqlplugin.DomainRow{
 			Name:         request.Info.Name,
 			ID:           serialization.MustParseUUID(request.Info.ID),
+			IsGlobal:     request.IsGlobalDomain,
 			Data:         blob.Data,
 			DataEncoding: string(blob.Encoding),
 		})
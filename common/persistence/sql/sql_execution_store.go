@@ -878,6 +878,7 @@ func (m *sqlExecutionStore) GetTransferTasks(
 			TaskType:                int(info.GetTaskType()),
 			ScheduleID:              info.GetScheduleID(),
 			Version:                 info.GetVersion(),
+			LifecycleEvent:          info.GetLifecycleEvent(),
 		}
 	}
 	if len(rows) > 0 {
@@ -1085,6 +1086,7 @@ func (m *sqlExecutionStore) populateGetReplicationTasksResponse(
 			BranchToken:       info.GetBranchToken(),
 			NewRunBranchToken: info.GetNewRunBranchToken(),
 			CreationTime:      info.GetCreationTimestamp(),
+			ErrorClass:        info.GetErrorClass(),
 		}
 	}
 	var nextPageToken []byte
@@ -1406,6 +1408,7 @@ func (m *sqlExecutionStore) PutReplicationTaskToDLQ(
 		BranchToken:             replicationTask.BranchToken,
 		NewRunBranchToken:       replicationTask.NewRunBranchToken,
 		CreationTimestamp:       replicationTask.CreationTime,
+		ErrorClass:              replicationTask.ErrorClass,
 	})
 	if err != nil {
 		return err
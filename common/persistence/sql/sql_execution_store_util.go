@@ -927,6 +927,9 @@ func createTransferTasks(
 				info.TargetDomainIDs = append(info.TargetDomainIDs, serialization.MustParseUUID(targetDomainID))
 			}
 
+		case p.TransferTaskTypeRecordWorkflowLifecycleEvent:
+			info.LifecycleEvent = task.(*p.RecordWorkflowLifecycleEventTask).LifecycleEvent
+
 		case p.TransferTaskTypeCloseExecution,
 			p.TransferTaskTypeRecordWorkflowStarted,
 			p.TransferTaskTypeResetWorkflow,
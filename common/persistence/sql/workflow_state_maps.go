@@ -82,6 +82,7 @@ func updateActivityInfos(
 				RetryLastFailureReason:   activityInfo.LastFailureReason,
 				RetryLastWorkerIdentity:  activityInfo.LastWorkerIdentity,
 				RetryLastFailureDetails:  activityInfo.LastFailureDetails,
+				Paused:                   activityInfo.Paused,
 			}
 			blob, err := parser.ActivityInfoToBlob(info)
 			if err != nil {
@@ -179,6 +180,7 @@ func getActivityInfoMap(
 			LastFailureReason:        decoded.GetRetryLastFailureReason(),
 			LastWorkerIdentity:       decoded.GetRetryLastWorkerIdentity(),
 			LastFailureDetails:       decoded.GetRetryLastFailureDetails(),
+			Paused:                   decoded.GetPaused(),
 		}
 		if decoded.StartedEvent != nil {
 			info.StartedEvent = persistence.NewDataBlob(decoded.StartedEvent, common.EncodingType(decoded.GetStartedEventEncoding()))
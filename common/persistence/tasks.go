@@ -145,6 +145,13 @@ type (
 		TargetDomainIDs map[string]struct{}
 	}
 
+	// RecordWorkflowLifecycleEventTask identifies a transfer task for publishing a workflow lifecycle event,
+	// the event is JSON encoded when the task is created
+	RecordWorkflowLifecycleEventTask struct {
+		TaskData
+		LifecycleEvent []byte
+	}
+
 	// CrossClusterStartChildExecutionTask is the cross-cluster version of StartChildExecutionTask
 	CrossClusterStartChildExecutionTask struct {
 		StartChildExecutionTask
@@ -247,6 +254,7 @@ var (
 	_ Task = (*UpsertWorkflowSearchAttributesTask)(nil)
 	_ Task = (*StartChildExecutionTask)(nil)
 	_ Task = (*RecordWorkflowClosedTask)(nil)
+	_ Task = (*RecordWorkflowLifecycleEventTask)(nil)
 	_ Task = (*CrossClusterStartChildExecutionTask)(nil)
 	_ Task = (*CrossClusterCancelExecutionTask)(nil)
 	_ Task = (*CrossClusterSignalExecutionTask)(nil)
@@ -391,6 +399,11 @@ func (u *RecordWorkflowClosedTask) GetType() int {
 	return TransferTaskTypeRecordWorkflowClosed
 }
 
+// GetType returns the type of the record workflow lifecycle event task
+func (u *RecordWorkflowLifecycleEventTask) GetType() int {
+	return TransferTaskTypeRecordWorkflowLifecycleEvent
+}
+
 // GetType returns of type of the cross-cluster start child task
 func (c *CrossClusterStartChildExecutionTask) GetType() int {
 	return CrossClusterTaskTypeStartChildExecution
@@ -51,6 +51,7 @@ func TestTaskCommonMethods(t *testing.T) {
 		&UpsertWorkflowSearchAttributesTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&StartChildExecutionTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&RecordWorkflowClosedTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
+		&RecordWorkflowLifecycleEventTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&HistoryReplicationTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&SyncActivityTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&FailoverMarkerTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
@@ -101,6 +102,8 @@ func TestTaskCommonMethods(t *testing.T) {
 			assert.Equal(t, TransferTaskTypeStartChildExecution, ty.GetType())
 		case *RecordWorkflowClosedTask:
 			assert.Equal(t, TransferTaskTypeRecordWorkflowClosed, ty.GetType())
+		case *RecordWorkflowLifecycleEventTask:
+			assert.Equal(t, TransferTaskTypeRecordWorkflowLifecycleEvent, ty.GetType())
 		case *HistoryReplicationTask:
 			assert.Equal(t, ReplicationTaskTypeHistory, ty.GetType())
 		case *SyncActivityTask:
@@ -72,6 +72,7 @@ type (
 		GetMessagingClient() messaging.Client
 		GetBlobstoreClient() blobstore.Client
 		GetDomainReplicationQueue() domain.ReplicationQueue
+		GetDomainUsageQueue() domain.UsageQueue
 
 		// membership infos
 		GetMembershipResolver() membership.Resolver
@@ -94,6 +94,7 @@ type (
 		archivalMetadata        archiver.ArchivalMetadata
 		archiverProvider        provider.ArchiverProvider
 		domainReplicationQueue  domain.ReplicationQueue
+		domainUsageQueue        domain.UsageQueue
 
 		// membership infos
 
@@ -218,6 +219,10 @@ func New(
 		params.MetricsClient,
 		logger,
 	)
+	domainUsageQueue := domain.NewUsageQueue(
+		persistenceBean.GetDomainUsageQueueManager(),
+		params.ClusterMetadata.GetCurrentClusterName(),
+	)
 
 	frontendRawClient := clientBean.GetFrontendClient()
 	frontendClient := retryable.NewFrontendClient(
@@ -299,6 +304,7 @@ func New(
 		archivalMetadata:        params.ArchivalMetadata,
 		archiverProvider:        params.ArchiverProvider,
 		domainReplicationQueue:  domainReplicationQueue,
+		domainUsageQueue:        domainUsageQueue,
 
 		// membership infos
 		membershipResolver: membershipResolver,
@@ -477,6 +483,11 @@ func (h *Impl) GetDomainReplicationQueue() domain.ReplicationQueue {
 	return h.domainReplicationQueue
 }
 
+// GetDomainUsageQueue return domain usage queue
+func (h *Impl) GetDomainUsageQueue() domain.UsageQueue {
+	return h.domainUsageQueue
+}
+
 // GetMembershipResolver return the membership resolver
 func (h *Impl) GetMembershipResolver() membership.Resolver {
 	return h.membershipResolver
@@ -68,6 +68,7 @@ type (
 		DomainCache             *cache.MockDomainCache
 		DomainMetricsScopeCache cache.DomainMetricsScopeCache
 		DomainReplicationQueue  *domain.MockReplicationQueue
+		DomainUsageQueue        *domain.MockUsageQueue
 		TimeSource              clock.TimeSource
 		PayloadSerializer       persistence.PayloadSerializer
 		MetricsClient           metrics.Client
@@ -137,6 +138,7 @@ func NewTest(
 	clientBean.EXPECT().GetMatchingClient(gomock.Any()).Return(matchingClient, nil).AnyTimes()
 	clientBean.EXPECT().GetHistoryClient().Return(historyClient).AnyTimes()
 	clientBean.EXPECT().GetRemoteAdminClient(gomock.Any()).Return(remoteAdminClient).AnyTimes()
+	clientBean.EXPECT().GetRemoteAdminStreamClient(gomock.Any()).Return(nil).AnyTimes()
 	clientBean.EXPECT().GetRemoteFrontendClient(gomock.Any()).Return(remoteFrontendClient).AnyTimes()
 
 	metadataMgr := &mocks.MetadataManager{}
@@ -178,6 +180,7 @@ func NewTest(
 		DomainCache:             cache.NewMockDomainCache(controller),
 		DomainMetricsScopeCache: cache.NewDomainMetricsScopeCache(),
 		DomainReplicationQueue:  domainReplicationQueue,
+		DomainUsageQueue:        domain.NewMockUsageQueue(controller),
 		TimeSource:              clock.NewRealTimeSource(),
 		PayloadSerializer:       persistence.NewPayloadSerializer(),
 		MetricsClient:           metrics.NewClient(scope, serviceMetricsIndex),
@@ -263,6 +266,11 @@ func (s *Test) GetDomainReplicationQueue() domain.ReplicationQueue {
 	return s.DomainReplicationQueue
 }
 
+// GetDomainUsageQueue for testing
+func (s *Test) GetDomainUsageQueue() domain.UsageQueue {
+	return s.DomainUsageQueue
+}
+
 // GetTimeSource for testing
 func (s *Test) GetTimeSource() clock.TimeSource {
 	return s.TimeSource
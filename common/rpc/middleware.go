@@ -55,6 +55,21 @@ func (m *authOutboundMiddleware) Call(ctx context.Context, request *transport.Re
 	return out.Call(ctx, request)
 }
 
+func (m *authOutboundMiddleware) CallStream(ctx context.Context, request *transport.StreamRequest, out transport.StreamOutbound) (*transport.ClientStream, error) {
+	if m.authProvider == nil {
+		return out.CallStream(ctx, request)
+	}
+
+	token, err := m.authProvider.GetAuthToken()
+	if err != nil {
+		return nil, err
+	}
+	request.Meta.Headers = request.Meta.Headers.
+		With(common.AuthorizationTokenHeaderName, string(token))
+
+	return out.CallStream(ctx, request)
+}
+
 type contextKey string
 
 const _responseInfoContextKey = contextKey("response-info")
@@ -147,6 +162,11 @@ func (m *overrideCallerMiddleware) Call(ctx context.Context, request *transport.
 	return out.Call(ctx, request)
 }
 
+func (m *overrideCallerMiddleware) CallStream(ctx context.Context, request *transport.StreamRequest, out transport.StreamOutbound) (*transport.ClientStream, error) {
+	request.Meta.Caller = m.caller
+	return out.CallStream(ctx, request)
+}
+
 // HeaderForwardingMiddleware forwards headers from current inbound RPC call that is being handled to new outbound calls being made.
 // As this does NOT differentiate between transports or purposes, it generally assumes we are not acting as a true proxy,
 // so things like content lengths and encodings should not be forwarded - they will be provided by the outbound RPC library as needed.
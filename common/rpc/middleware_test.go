@@ -58,6 +58,24 @@ func TestAuthOubboundMiddleware(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestAuthOubboundMiddleware_Stream(t *testing.T) {
+	m := authOutboundMiddleware{}
+	_, err := m.CallStream(context.Background(), &transport.StreamRequest{Meta: &transport.RequestMeta{}}, &fakeStreamOutbound{verify: func(request *transport.StreamRequest) {
+		assert.Empty(t, request.Meta.Headers)
+	}})
+	assert.NoError(t, err)
+
+	m = authOutboundMiddleware{fakeAuthProvider{err: assert.AnError}}
+	_, err = m.CallStream(context.Background(), &transport.StreamRequest{Meta: &transport.RequestMeta{}}, &fakeStreamOutbound{})
+	assert.Error(t, err)
+
+	m = authOutboundMiddleware{fakeAuthProvider{token: []byte("token")}}
+	_, err = m.CallStream(context.Background(), &transport.StreamRequest{Meta: &transport.RequestMeta{}}, &fakeStreamOutbound{verify: func(request *transport.StreamRequest) {
+		assert.Equal(t, "token", request.Meta.Headers.Items()[common.AuthorizationTokenHeaderName])
+	}})
+	assert.NoError(t, err)
+}
+
 func TestResponseInfoMiddleware(t *testing.T) {
 	m := ResponseInfoMiddleware{}
 	ctx, responseInfo := ContextWithResponseInfo(context.Background())
@@ -93,6 +111,11 @@ func TestOverrideCallerMiddleware(t *testing.T) {
 		assert.Equal(t, "x-caller", r.Caller)
 	}})
 	assert.NoError(t, err)
+
+	_, err = m.CallStream(context.Background(), &transport.StreamRequest{Meta: &transport.RequestMeta{Caller: "service"}}, &fakeStreamOutbound{verify: func(r *transport.StreamRequest) {
+		assert.Equal(t, "x-caller", r.Meta.Caller)
+	}})
+	assert.NoError(t, err)
 }
 
 func TestHeaderForwardingMiddleware(t *testing.T) {
@@ -355,6 +378,21 @@ func (o fakeOutbound) Stop() error                       { return nil }
 func (o fakeOutbound) IsRunning() bool                   { return true }
 func (o fakeOutbound) Transports() []transport.Transport { return nil }
 
+type fakeStreamOutbound struct {
+	verify func(*transport.StreamRequest)
+}
+
+func (o fakeStreamOutbound) CallStream(ctx context.Context, request *transport.StreamRequest) (*transport.ClientStream, error) {
+	if o.verify != nil {
+		o.verify(request)
+	}
+	return nil, nil
+}
+func (o fakeStreamOutbound) Start() error                      { return nil }
+func (o fakeStreamOutbound) Stop() error                       { return nil }
+func (o fakeStreamOutbound) IsRunning() bool                   { return true }
+func (o fakeStreamOutbound) Transports() []transport.Transport { return nil }
+
 type fakeAuthProvider struct {
 	token []byte
 	err   error
@@ -138,6 +138,8 @@ func (b crossDCOutbounds) Build(grpcTransport *grpc.Transport, tchannelTransport
 		}
 
 		var outbound transport.UnaryOutbound
+		// streaming is only supported by gRPC, replication falls back to polling over other transports
+		var streamOutbound transport.StreamOutbound
 		switch clusterInfo.RPCTransport {
 		case tchannel.TransportName:
 			peerChooser, err := b.pcf.CreatePeerChooser(tchannelTransport, clusterInfo.RPCAddress)
@@ -154,27 +156,38 @@ func (b crossDCOutbounds) Build(grpcTransport *grpc.Transport, tchannelTransport
 			if err != nil {
 				return nil, err
 			}
-			outbound = grpcTransport.NewOutbound(peerChooser)
+			grpcOutbound := grpcTransport.NewOutbound(peerChooser)
+			outbound = grpcOutbound
+			streamOutbound = grpcOutbound
 		default:
 			return nil, fmt.Errorf("unknown cross DC transport type: %s", clusterInfo.RPCTransport)
 		}
 
 		var authMiddleware middleware.UnaryOutbound
+		var authStreamMiddleware middleware.StreamOutbound
 		if clusterInfo.AuthorizationProvider.Enable {
 			authProvider, err := authorization.GetAuthProviderClient(clusterInfo.AuthorizationProvider.PrivateKey)
 			if err != nil {
 				return nil, fmt.Errorf("create AuthProvider: %v", err)
 			}
 			authMiddleware = &authOutboundMiddleware{authProvider}
+			authStreamMiddleware = &authOutboundMiddleware{authProvider}
 		}
 
-		outbounds[clusterName] = transport.Outbounds{
+		clusterOutbounds := transport.Outbounds{
 			ServiceName: clusterInfo.RPCName,
 			Unary: middleware.ApplyUnaryOutbound(outbound, yarpc.UnaryOutboundMiddleware(
 				authMiddleware,
 				&overrideCallerMiddleware{crossDCCaller},
 			)),
 		}
+		if streamOutbound != nil {
+			clusterOutbounds.Stream = middleware.ApplyStreamOutbound(streamOutbound, yarpc.StreamOutboundMiddleware(
+				authStreamMiddleware,
+				&overrideCallerMiddleware{crossDCCaller},
+			))
+		}
+		outbounds[clusterName] = clusterOutbounds
 	}
 	return outbounds, nil
 }
@@ -153,6 +153,8 @@ func TestCrossDCOutbounds(t *testing.T) {
 	assert.Equal(t, "cadence-frontend", outbounds["cluster-B"].ServiceName)
 	assert.NotNil(t, outbounds["cluster-A"].Unary)
 	assert.NotNil(t, outbounds["cluster-B"].Unary)
+	assert.NotNil(t, outbounds["cluster-A"].Stream)
+	assert.Nil(t, outbounds["cluster-B"].Stream)
 }
 
 func TestDirectOutbound(t *testing.T) {
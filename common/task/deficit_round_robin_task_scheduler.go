@@ -0,0 +1,441 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package task
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// deficitRoundRobinTaskSchedulerImpl is a hierarchical scheduler. Priority classes share the processor
+	// by weight, same as in the WRR scheduler. Within a priority class, domains share the weight of the class
+	// with deficit round robin, so that a domain with a large backlog can not starve the other domains.
+	deficitRoundRobinTaskSchedulerImpl struct {
+		sync.RWMutex
+
+		status       int32
+		weights      atomic.Value // store the currently used weights
+		domainQueues map[int]map[string]*drrDomainQueue
+		inflight     map[string]*int32
+		shutdownCh   chan struct{}
+		notifyCh     chan struct{}
+		dispatcherWG sync.WaitGroup
+		logger       log.Logger
+		metricsScope metrics.Scope
+		options      *DeficitRoundRobinTaskSchedulerOptions
+
+		processor Processor
+	}
+
+	drrDomainQueue struct {
+		domain string
+		taskCh chan PriorityTask
+		// deficit is only accessed by the dispatcher
+		deficit int
+	}
+
+	// drrTask releases the concurrency slot of its domain once processed
+	drrTask struct {
+		PriorityTask
+
+		released int32
+		release  func()
+	}
+)
+
+const (
+	dRRTaskProcessorQueueSize = 1
+)
+
+// NewDeficitRoundRobinTaskScheduler creates a new DRR task scheduler
+func NewDeficitRoundRobinTaskScheduler(
+	logger log.Logger,
+	metricsClient metrics.Client,
+	options *DeficitRoundRobinTaskSchedulerOptions,
+) (Scheduler, error) {
+	weights, err := common.ConvertDynamicConfigMapPropertyToIntMap(options.Weights())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(weights) == 0 {
+		return nil, errors.New("weight is not specified in the scheduler option")
+	}
+
+	if options.TaskDomainFn == nil {
+		return nil, errors.New("task domain function is not specified in the scheduler option")
+	}
+
+	scheduler := &deficitRoundRobinTaskSchedulerImpl{
+		status:       common.DaemonStatusInitialized,
+		domainQueues: make(map[int]map[string]*drrDomainQueue),
+		inflight:     make(map[string]*int32),
+		shutdownCh:   make(chan struct{}),
+		notifyCh:     make(chan struct{}, 1),
+		logger:       logger,
+		metricsScope: metricsClient.Scope(metrics.TaskSchedulerScope),
+		options:      options,
+		processor: NewParallelTaskProcessor(
+			logger,
+			metricsClient,
+			&ParallelTaskProcessorOptions{
+				QueueSize:   dRRTaskProcessorQueueSize,
+				WorkerCount: options.WorkerCount,
+				RetryPolicy: options.RetryPolicy,
+			},
+		),
+	}
+	scheduler.weights.Store(weights)
+
+	return scheduler, nil
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	d.processor.Start()
+
+	// deficits are kept by the dispatcher, so there is only one
+	d.dispatcherWG.Add(1)
+	go d.dispatcher()
+	go d.updateWeightsAndEmitMetrics()
+
+	d.logger.Info("Deficit round robin task scheduler started.")
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(d.shutdownCh)
+
+	d.processor.Stop()
+
+	d.RLock()
+	for _, queues := range d.domainQueues {
+		for _, queue := range queues {
+			drainAndNackPriorityTask(queue.taskCh)
+		}
+	}
+	d.RUnlock()
+
+	if success := common.AwaitWaitGroup(&d.dispatcherWG, time.Minute); !success {
+		d.logger.Warn("Deficit round robin task scheduler timedout on shutdown.")
+	}
+
+	d.logger.Info("Deficit round robin task scheduler shutdown.")
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) Submit(task PriorityTask) error {
+	d.metricsScope.IncCounter(metrics.PriorityTaskSubmitRequest)
+	sw := d.metricsScope.StartTimer(metrics.PriorityTaskSubmitLatency)
+	defer sw.Stop()
+
+	if d.isStopped() {
+		return ErrTaskSchedulerClosed
+	}
+
+	queue, err := d.getOrCreateDomainQueue(task.Priority(), d.options.TaskDomainFn(task))
+	if err != nil {
+		return err
+	}
+
+	select {
+	case queue.taskCh <- task:
+		d.notifyDispatcher()
+		if d.isStopped() {
+			drainAndNackPriorityTask(queue.taskCh)
+		}
+		return nil
+	case <-d.shutdownCh:
+		return ErrTaskSchedulerClosed
+	}
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) TrySubmit(
+	task PriorityTask,
+) (bool, error) {
+	if d.isStopped() {
+		return false, ErrTaskSchedulerClosed
+	}
+
+	queue, err := d.getOrCreateDomainQueue(task.Priority(), d.options.TaskDomainFn(task))
+	if err != nil {
+		return false, err
+	}
+
+	select {
+	case queue.taskCh <- task:
+		d.metricsScope.IncCounter(metrics.PriorityTaskSubmitRequest)
+		if d.isStopped() {
+			drainAndNackPriorityTask(queue.taskCh)
+		} else {
+			d.notifyDispatcher()
+		}
+		return true, nil
+	case <-d.shutdownCh:
+		return false, ErrTaskSchedulerClosed
+	default:
+		return false, nil
+	}
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) dispatcher() {
+	defer d.dispatcherWG.Done()
+
+	outstandingTasks := false
+	// the first domain visited in each priority class rotates across rounds
+	cursors := make(map[int]int)
+
+	for {
+		if !outstandingTasks {
+			// if no task is dispatched in the last round, wait for a new task or for a domain to
+			// go below its concurrency limit
+			select {
+			case <-d.notifyCh:
+			case <-d.shutdownCh:
+				return
+			}
+		}
+
+		outstandingTasks = false
+		weights := d.getWeights()
+		for priority, count := range weights {
+			queues := d.getDomainQueues(priority)
+			if len(queues) == 0 {
+				continue
+			}
+			cursor := cursors[priority] % len(queues)
+			cursors[priority] = cursor + 1
+
+			dispatched, stopped := d.dispatchPriority(queues, cursor, count)
+			if stopped {
+				return
+			}
+			outstandingTasks = outstandingTasks || dispatched
+		}
+	}
+}
+
+// dispatchPriority dispatches up to count tasks from the queues of one priority class, visiting
+// domains in order starting from cursor. Each visited domain earns its weight in deficit and can
+// dispatch one task per deficit unit.
+func (d *deficitRoundRobinTaskSchedulerImpl) dispatchPriority(
+	queues []*drrDomainQueue,
+	cursor int,
+	count int,
+) (dispatched bool, stopped bool) {
+	for i := 0; i != len(queues) && count > 0; i++ {
+		queue := queues[(cursor+i)%len(queues)]
+		if len(queue.taskCh) == 0 {
+			// idle domains do not accumulate deficit
+			queue.deficit = 0
+			continue
+		}
+
+		weight := d.options.DomainWeight(queue.domain)
+		if weight <= 0 {
+			weight = 1
+		}
+		queue.deficit += weight
+
+	Dispatch_Loop:
+		for queue.deficit > 0 && count > 0 {
+			inflight, ok := d.acquire(queue.domain)
+			if !ok {
+				// cap the deficit to one quantum, so that a domain held back by its concurrency
+				// limit does not burst once it gets below it
+				queue.deficit = common.MinInt(queue.deficit, weight)
+				d.metricsScope.Tagged(metrics.DomainTag(queue.domain)).IncCounter(metrics.PriorityTaskDomainConcurrencyLimited)
+				break Dispatch_Loop
+			}
+
+			select {
+			case task := <-queue.taskCh:
+				dispatched = true
+				queue.deficit--
+				count--
+				wrapped := &drrTask{
+					PriorityTask: task,
+					release: func() {
+						atomic.AddInt32(inflight, -1)
+						d.notifyDispatcher()
+					},
+				}
+				if err := d.processor.Submit(wrapped); err != nil {
+					d.logger.Error("fail to submit task to processor", tag.Error(err))
+					wrapped.Nack()
+				}
+			case <-d.shutdownCh:
+				atomic.AddInt32(inflight, -1)
+				return dispatched, true
+			default:
+				atomic.AddInt32(inflight, -1)
+				queue.deficit = 0
+				break Dispatch_Loop
+			}
+		}
+	}
+	return dispatched, false
+}
+
+// acquire takes a concurrency slot of the domain, it returns false if the domain is at its limit
+func (d *deficitRoundRobinTaskSchedulerImpl) acquire(domain string) (*int32, bool) {
+	d.RLock()
+	inflight := d.inflight[domain]
+	d.RUnlock()
+
+	maxConcurrency := int32(d.options.DomainMaxConcurrency(domain))
+	if maxConcurrency > 0 && atomic.LoadInt32(inflight) >= maxConcurrency {
+		return nil, false
+	}
+	atomic.AddInt32(inflight, 1)
+	return inflight, true
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) getOrCreateDomainQueue(priority int, domain string) (*drrDomainQueue, error) {
+	if _, ok := d.getWeights()[priority]; !ok {
+		return nil, fmt.Errorf("unknown task priority: %v", priority)
+	}
+
+	d.RLock()
+	if queue, ok := d.domainQueues[priority][domain]; ok {
+		d.RUnlock()
+		return queue, nil
+	}
+	d.RUnlock()
+
+	d.Lock()
+	defer d.Unlock()
+	if queue, ok := d.domainQueues[priority][domain]; ok {
+		return queue, nil
+	}
+	if _, ok := d.domainQueues[priority]; !ok {
+		d.domainQueues[priority] = make(map[string]*drrDomainQueue)
+	}
+	if _, ok := d.inflight[domain]; !ok {
+		d.inflight[domain] = new(int32)
+	}
+	queue := &drrDomainQueue{
+		domain: domain,
+		taskCh: make(chan PriorityTask, d.options.QueueSize),
+	}
+	d.domainQueues[priority][domain] = queue
+	return queue, nil
+}
+
+// getDomainQueues returns the queues of a priority class sorted by domain, so that the visiting order is stable
+func (d *deficitRoundRobinTaskSchedulerImpl) getDomainQueues(priority int) []*drrDomainQueue {
+	d.RLock()
+	queues := make([]*drrDomainQueue, 0, len(d.domainQueues[priority]))
+	for _, queue := range d.domainQueues[priority] {
+		queues = append(queues, queue)
+	}
+	d.RUnlock()
+
+	sort.Slice(queues, func(i, j int) bool {
+		return queues[i].domain < queues[j].domain
+	})
+	return queues
+}
+
+// domainQueueDepths returns the number of queued tasks of each domain across all priority classes
+func (d *deficitRoundRobinTaskSchedulerImpl) domainQueueDepths() map[string]int {
+	d.RLock()
+	defer d.RUnlock()
+
+	depths := make(map[string]int)
+	for _, queues := range d.domainQueues {
+		for domain, queue := range queues {
+			depths[domain] += len(queue.taskCh)
+		}
+	}
+	return depths
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) notifyDispatcher() {
+	select {
+	case d.notifyCh <- struct{}{}:
+		// sent a notification to the dispatcher
+	default:
+		// do not block if there's already a notification
+	}
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) getWeights() map[int]int {
+	return d.weights.Load().(map[int]int)
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) updateWeightsAndEmitMetrics() {
+	ticker := time.NewTicker(defaultUpdateWeightsInterval)
+	for {
+		select {
+		case <-ticker.C:
+			weights, err := common.ConvertDynamicConfigMapPropertyToIntMap(d.options.Weights())
+			if err != nil {
+				d.logger.Error("failed to update weight for deficit round robin task scheduler", tag.Error(err))
+			} else {
+				d.weights.Store(weights)
+			}
+			for domain, depth := range d.domainQueueDepths() {
+				d.metricsScope.Tagged(metrics.DomainTag(domain)).UpdateGauge(metrics.PriorityTaskDomainQueueDepth, float64(depth))
+			}
+		case <-d.shutdownCh:
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+func (d *deficitRoundRobinTaskSchedulerImpl) isStopped() bool {
+	return atomic.LoadInt32(&d.status) == common.DaemonStatusStopped
+}
+
+func (t *drrTask) Ack() {
+	t.PriorityTask.Ack()
+	t.releaseOnce()
+}
+
+func (t *drrTask) Nack() {
+	t.PriorityTask.Nack()
+	t.releaseOnce()
+}
+
+func (t *drrTask) releaseOnce() {
+	if atomic.CompareAndSwapInt32(&t.released, 0, 1) {
+		t.release()
+	}
+}
@@ -0,0 +1,50 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/dynamicconfig"
+)
+
+// DeficitRoundRobinTaskSchedulerOptions configs DRR task scheduler
+type DeficitRoundRobinTaskSchedulerOptions struct {
+	// Weights is the weight of each task priority class, same as for the WRR scheduler
+	Weights dynamicconfig.MapPropertyFn
+	// DomainWeight is the number of tasks a domain can dispatch in each round, relative to other domains
+	DomainWeight dynamicconfig.IntPropertyFnWithDomainFilter
+	// DomainMaxConcurrency caps the number of tasks of a domain being processed at the same time, 0 means no limit
+	DomainMaxConcurrency dynamicconfig.IntPropertyFnWithDomainFilter
+	// QueueSize is the size of the queue of each domain in each priority class
+	QueueSize   int
+	WorkerCount dynamicconfig.IntPropertyFn
+	RetryPolicy backoff.RetryPolicy
+	// TaskDomainFn returns the domain name of a task
+	TaskDomainFn func(PriorityTask) string
+}
+
+func (o *DeficitRoundRobinTaskSchedulerOptions) String() string {
+	return fmt.Sprintf("{QueueSize: %v, WorkerCount: %v, Weights: %v}", o.QueueSize, o.WorkerCount(), o.Weights())
+}
@@ -0,0 +1,233 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	deficitRoundRobinTaskSchedulerSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		controller    *gomock.Controller
+		mockProcessor *MockProcessor
+
+		taskDomains map[PriorityTask]string
+		scheduler   *deficitRoundRobinTaskSchedulerImpl
+	}
+)
+
+func TestDeficitRoundRobinTaskSchedulerSuite(t *testing.T) {
+	s := new(deficitRoundRobinTaskSchedulerSuite)
+	suite.Run(t, s)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockProcessor = NewMockProcessor(s.controller)
+
+	s.taskDomains = make(map[PriorityTask]string)
+	s.scheduler = s.newTestDeficitRoundRobinTaskScheduler(
+		dynamicconfig.GetIntPropertyFilteredByDomain(1),
+		dynamicconfig.GetIntPropertyFilteredByDomain(0),
+	)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestSubmit_Success() {
+	mockTask := s.newMockTask(1, "domain")
+
+	err := s.scheduler.Submit(mockTask)
+	s.NoError(err)
+
+	s.Equal(map[string]int{"domain": 1}, s.scheduler.domainQueueDepths())
+	task := <-s.scheduler.domainQueues[1]["domain"].taskCh
+	s.Equal(mockTask, task)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestSubmit_Fail_UnknownPriority() {
+	mockTask := s.newMockTask(100, "domain")
+
+	err := s.scheduler.Submit(mockTask)
+	s.Error(err)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestTrySubmit_QueueFull() {
+	scheduler := s.newTestDeficitRoundRobinTaskScheduler(
+		dynamicconfig.GetIntPropertyFilteredByDomain(1),
+		dynamicconfig.GetIntPropertyFilteredByDomain(0),
+	)
+	scheduler.options.QueueSize = 1
+
+	submitted, err := scheduler.TrySubmit(s.newMockTask(0, "domain"))
+	s.NoError(err)
+	s.True(submitted)
+
+	submitted, err = scheduler.TrySubmit(s.newMockTask(0, "domain"))
+	s.NoError(err)
+	s.False(submitted)
+
+	// other domains have their own queue
+	submitted, err = scheduler.TrySubmit(s.newMockTask(0, "other-domain"))
+	s.NoError(err)
+	s.True(submitted)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestDispatchPriority_FairShareAcrossDomains() {
+	for i := 0; i != 10; i++ {
+		s.NoError(s.scheduler.Submit(s.newMockTask(0, "busy-domain")))
+	}
+	s.NoError(s.scheduler.Submit(s.newMockTask(0, "quiet-domain")))
+
+	dispatchedDomains := s.expectDispatch()
+	dispatched, stopped := s.scheduler.dispatchPriority(s.scheduler.getDomainQueues(0), 0, 10)
+	s.True(dispatched)
+	s.False(stopped)
+	s.Equal([]string{"busy-domain", "quiet-domain"}, *dispatchedDomains)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestDispatchPriority_DomainWeight() {
+	s.scheduler.options.DomainWeight = func(domain string) int {
+		if domain == "heavy-domain" {
+			return 3
+		}
+		return 1
+	}
+	for i := 0; i != 10; i++ {
+		s.NoError(s.scheduler.Submit(s.newMockTask(0, "heavy-domain")))
+		s.NoError(s.scheduler.Submit(s.newMockTask(0, "light-domain")))
+	}
+
+	dispatchedDomains := s.expectDispatch()
+	s.scheduler.dispatchPriority(s.scheduler.getDomainQueues(0), 0, 10)
+	s.Equal([]string{"heavy-domain", "heavy-domain", "heavy-domain", "light-domain"}, *dispatchedDomains)
+	s.Equal(map[string]int{"heavy-domain": 7, "light-domain": 9}, s.scheduler.domainQueueDepths())
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestDispatchPriority_PriorityBudget() {
+	for i := 0; i != 10; i++ {
+		s.NoError(s.scheduler.Submit(s.newMockTask(0, "domain")))
+	}
+	s.scheduler.options.DomainWeight = dynamicconfig.GetIntPropertyFilteredByDomain(10)
+
+	dispatchedDomains := s.expectDispatch()
+	s.scheduler.dispatchPriority(s.scheduler.getDomainQueues(0), 0, 4)
+	s.Len(*dispatchedDomains, 4)
+	// the unused deficit is kept for the next round
+	s.Equal(6, s.scheduler.domainQueues[0]["domain"].deficit)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestDispatchPriority_DomainMaxConcurrency() {
+	s.scheduler.options.DomainMaxConcurrency = dynamicconfig.GetIntPropertyFilteredByDomain(1)
+	for i := 0; i != 3; i++ {
+		s.NoError(s.scheduler.Submit(s.newMockTask(0, "domain")))
+	}
+
+	var submitted []Task
+	s.scheduler.processor = s.mockProcessor
+	s.mockProcessor.EXPECT().Submit(gomock.Any()).DoAndReturn(func(task Task) error {
+		submitted = append(submitted, task)
+		return nil
+	}).AnyTimes()
+
+	dispatched, _ := s.scheduler.dispatchPriority(s.scheduler.getDomainQueues(0), 0, 10)
+	s.True(dispatched)
+	s.Len(submitted, 1)
+
+	// the domain is at its concurrency limit
+	dispatched, _ = s.scheduler.dispatchPriority(s.scheduler.getDomainQueues(0), 0, 10)
+	s.False(dispatched)
+	s.Len(submitted, 1)
+
+	// completing the task frees the slot
+	submitted[0].(*drrTask).PriorityTask.(*MockPriorityTask).EXPECT().Ack().Times(1)
+	submitted[0].Ack()
+	dispatched, _ = s.scheduler.dispatchPriority(s.scheduler.getDomainQueues(0), 0, 10)
+	s.True(dispatched)
+	s.Len(submitted, 2)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) TestSchedulerContract() {
+	testSchedulerContract(s.Assertions, s.controller, s.scheduler)
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) expectDispatch() *[]string {
+	var domains []string
+	s.scheduler.processor = s.mockProcessor
+	s.mockProcessor.EXPECT().Submit(gomock.Any()).DoAndReturn(func(task Task) error {
+		domains = append(domains, s.taskDomains[task.(*drrTask).PriorityTask])
+		return nil
+	}).AnyTimes()
+	return &domains
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) newMockTask(priority int, domain string) *MockPriorityTask {
+	mockTask := NewMockPriorityTask(s.controller)
+	mockTask.EXPECT().Priority().Return(priority).AnyTimes()
+	s.taskDomains[mockTask] = domain
+	return mockTask
+}
+
+func (s *deficitRoundRobinTaskSchedulerSuite) newTestDeficitRoundRobinTaskScheduler(
+	domainWeight dynamicconfig.IntPropertyFnWithDomainFilter,
+	domainMaxConcurrency dynamicconfig.IntPropertyFnWithDomainFilter,
+) *deficitRoundRobinTaskSchedulerImpl {
+	scheduler, err := NewDeficitRoundRobinTaskScheduler(
+		testlogger.New(s.Suite.T()),
+		metrics.NewClient(tally.NoopScope, metrics.Common),
+		&DeficitRoundRobinTaskSchedulerOptions{
+			Weights:              testSchedulerWeights,
+			DomainWeight:         domainWeight,
+			DomainMaxConcurrency: domainMaxConcurrency,
+			QueueSize:            1000,
+			WorkerCount:          dynamicconfig.GetIntPropertyFn(1),
+			RetryPolicy:          backoff.NewExponentialRetryPolicy(time.Millisecond),
+			TaskDomainFn: func(task PriorityTask) string {
+				if domain, ok := s.taskDomains[task]; ok {
+					return domain
+				}
+				return "default-domain"
+			},
+		},
+	)
+	s.NoError(err)
+	return scheduler.(*deficitRoundRobinTaskSchedulerImpl)
+}
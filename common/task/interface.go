@@ -96,6 +96,9 @@ const (
 	SchedulerTypeFIFO SchedulerType = iota + 1
 	// SchedulerTypeWRR is the scheduler type for weighted round robin scheduler implementation
 	SchedulerTypeWRR
+	// SchedulerTypeDRR is the scheduler type for the scheduler implementation which shares each priority class
+	// across domains with deficit round robin
+	SchedulerTypeDRR
 )
 
 const (
@@ -31,6 +31,7 @@ type SchedulerOptions struct {
 	SchedulerType        SchedulerType
 	FIFOSchedulerOptions *FIFOTaskSchedulerOptions
 	WRRSchedulerOptions  *WeightedRoundRobinTaskSchedulerOptions
+	DRRSchedulerOptions  *DeficitRoundRobinTaskSchedulerOptions
 }
 
 func NewSchedulerOptions(
@@ -39,6 +40,9 @@ func NewSchedulerOptions(
 	workerCount dynamicconfig.IntPropertyFn,
 	dispatcherCount int,
 	weights dynamicconfig.MapPropertyFn,
+	domainWeight dynamicconfig.IntPropertyFnWithDomainFilter,
+	domainMaxConcurrency dynamicconfig.IntPropertyFnWithDomainFilter,
+	taskDomainFn func(PriorityTask) string,
 ) (*SchedulerOptions, error) {
 	options := &SchedulerOptions{
 		SchedulerType: SchedulerType(schedulerType),
@@ -59,6 +63,16 @@ func NewSchedulerOptions(
 			DispatcherCount: dispatcherCount,
 			RetryPolicy:     common.CreateTaskProcessingRetryPolicy(),
 		}
+	case SchedulerTypeDRR:
+		options.DRRSchedulerOptions = &DeficitRoundRobinTaskSchedulerOptions{
+			Weights:              weights,
+			DomainWeight:         domainWeight,
+			DomainMaxConcurrency: domainMaxConcurrency,
+			QueueSize:            queueSize,
+			WorkerCount:          workerCount,
+			RetryPolicy:          common.CreateTaskProcessingRetryPolicy(),
+			TaskDomainFn:         taskDomainFn,
+		}
 	default:
 		return nil, fmt.Errorf("unknown task scheduler type: %v", schedulerType)
 	}
@@ -66,6 +80,6 @@ func NewSchedulerOptions(
 }
 
 func (o *SchedulerOptions) String() string {
-	return fmt.Sprintf("{schedulerType:%v, fifoSchedulerOptions:%s, wrrSchedulerOptions:%s}",
-		o.SchedulerType, o.FIFOSchedulerOptions, o.WRRSchedulerOptions)
+	return fmt.Sprintf("{schedulerType:%v, fifoSchedulerOptions:%s, wrrSchedulerOptions:%s, drrSchedulerOptions:%s}",
+		o.SchedulerType, o.FIFOSchedulerOptions, o.WRRSchedulerOptions, o.DRRSchedulerOptions)
 }
@@ -43,7 +43,7 @@ func TestSchedulerOptionsString(t *testing.T) {
 			queueSize:       1,
 			workerCount:     dynamicconfig.GetIntPropertyFn(3),
 			dispatcherCount: 1,
-			want:            "{schedulerType:1, fifoSchedulerOptions:{QueueSize: 1, WorkerCount: 3, DispatcherCount: 1}, wrrSchedulerOptions:<nil>}",
+			want:            "{schedulerType:1, fifoSchedulerOptions:{QueueSize: 1, WorkerCount: 3, DispatcherCount: 1}, wrrSchedulerOptions:<nil>, drrSchedulerOptions:<nil>}",
 		},
 		{
 			desc:            "WRR",
@@ -55,18 +55,30 @@ func TestSchedulerOptionsString(t *testing.T) {
 				"1": 500,
 				"9": 20,
 			}),
-			want: "{schedulerType:2, fifoSchedulerOptions:<nil>, wrrSchedulerOptions:{QueueSize: 3, WorkerCount: 4, DispatcherCount: 5, Weights: map[1:500 9:20]}}",
+			want: "{schedulerType:2, fifoSchedulerOptions:<nil>, wrrSchedulerOptions:{QueueSize: 3, WorkerCount: 4, DispatcherCount: 5, Weights: map[1:500 9:20]}, drrSchedulerOptions:<nil>}",
+		},
+		{
+			desc:            "DRR",
+			schedulerType:   int(SchedulerTypeDRR),
+			queueSize:       3,
+			workerCount:     dynamicconfig.GetIntPropertyFn(4),
+			dispatcherCount: 5,
+			weights: dynamicconfig.GetMapPropertyFn(map[string]interface{}{
+				"1": 500,
+				"9": 20,
+			}),
+			want: "{schedulerType:3, fifoSchedulerOptions:<nil>, wrrSchedulerOptions:<nil>, drrSchedulerOptions:{QueueSize: 3, WorkerCount: 4, Weights: map[1:500 9:20]}}",
 		},
 		{
 			desc:          "InvalidSchedulerType",
-			schedulerType: 3,
+			schedulerType: 4,
 			wantErr:       true,
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
-			o, err := NewSchedulerOptions(tc.schedulerType, tc.queueSize, tc.workerCount, tc.dispatcherCount, tc.weights)
+			o, err := NewSchedulerOptions(tc.schedulerType, tc.queueSize, tc.workerCount, tc.dispatcherCount, tc.weights, nil, nil, nil)
 			if (err != nil) != tc.wantErr {
 				t.Errorf("Got error: %v, wantErr: %v", err, tc.wantErr)
 			}
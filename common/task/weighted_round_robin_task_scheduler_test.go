@@ -361,6 +361,8 @@ func testSchedulerContract(
 		<-schedulerImpl.shutdownCh
 	case *weightedRoundRobinTaskSchedulerImpl:
 		<-schedulerImpl.shutdownCh
+	case *deficitRoundRobinTaskSchedulerImpl:
+		<-schedulerImpl.shutdownCh
 	default:
 		s.Fail("unknown task scheduler type")
 	}
@@ -52,8 +52,9 @@ type DescribeClusterResponse struct {
 
 // AdminDescribeWorkflowExecutionRequest is an internal type (TBD...)
 type AdminDescribeWorkflowExecutionRequest struct {
-	Domain    string             `json:"domain,omitempty"`
-	Execution *WorkflowExecution `json:"execution,omitempty"`
+	Domain             string             `json:"domain,omitempty"`
+	Execution          *WorkflowExecution `json:"execution,omitempty"`
+	RebuildFromHistory bool               `json:"rebuildFromHistory,omitempty"`
 }
 
 func (v *AdminDescribeWorkflowExecutionRequest) SerializeForLogging() (string, error) {
@@ -71,12 +72,22 @@ func (v *AdminDescribeWorkflowExecutionRequest) GetDomain() (o string) {
 	return
 }
 
+// GetRebuildFromHistory is an internal getter (TBD...)
+func (v *AdminDescribeWorkflowExecutionRequest) GetRebuildFromHistory() (o bool) {
+	if v != nil {
+		return v.RebuildFromHistory
+	}
+	return
+}
+
 // AdminDescribeWorkflowExecutionResponse is an internal type (TBD...)
 type AdminDescribeWorkflowExecutionResponse struct {
 	ShardID                string `json:"shardId,omitempty"`
 	HistoryAddr            string `json:"historyAddr,omitempty"`
 	MutableStateInCache    string `json:"mutableStateInCache,omitempty"`
 	MutableStateInDatabase string `json:"mutableStateInDatabase,omitempty"`
+	// MutableStateRebuiltFromHistory is only set when RebuildFromHistory is requested
+	MutableStateRebuiltFromHistory string `json:"mutableStateRebuiltFromHistory,omitempty"`
 }
 
 // GetShardID is an internal getter (TBD...)
@@ -95,6 +106,14 @@ func (v *AdminDescribeWorkflowExecutionResponse) GetMutableStateInDatabase() (o
 	return
 }
 
+// GetMutableStateRebuiltFromHistory is an internal getter (TBD...)
+func (v *AdminDescribeWorkflowExecutionResponse) GetMutableStateRebuiltFromHistory() (o string) {
+	if v != nil {
+		return v.MutableStateRebuiltFromHistory
+	}
+	return
+}
+
 // GetWorkflowExecutionRawHistoryV2Request is an internal type (TBD...)
 type GetWorkflowExecutionRawHistoryV2Request struct {
 	Domain            string             `json:"domain,omitempty"`
@@ -260,6 +279,53 @@ func (v *ResendReplicationTasksRequest) GetRemoteCluster() (o string) {
 	return
 }
 
+// ImportWorkflowExecutionRequest is an internal type (TBD...)
+type ImportWorkflowExecutionRequest struct {
+	Domain              string                `json:"domain,omitempty"`
+	WorkflowExecution   *WorkflowExecution    `json:"workflowExecution,omitempty"`
+	VersionHistoryItems []*VersionHistoryItem `json:"versionHistoryItems,omitempty"`
+	Events              *DataBlob             `json:"events,omitempty"`
+}
+
+func (v *ImportWorkflowExecutionRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ImportWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ImportWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetVersionHistoryItems is an internal getter (TBD...)
+func (v *ImportWorkflowExecutionRequest) GetVersionHistoryItems() (o []*VersionHistoryItem) {
+	if v != nil && v.VersionHistoryItems != nil {
+		return v.VersionHistoryItems
+	}
+	return
+}
+
+// GetEvents is an internal getter (TBD...)
+func (v *ImportWorkflowExecutionRequest) GetEvents() (o *DataBlob) {
+	if v != nil && v.Events != nil {
+		return v.Events
+	}
+	return
+}
+
 // RingInfo is an internal type (TBD...)
 type RingInfo struct {
 	Role        string      `json:"role,omitempty"`
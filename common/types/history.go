@@ -22,8 +22,9 @@ package types
 
 // DescribeMutableStateRequest is an internal type (TBD...)
 type DescribeMutableStateRequest struct {
-	DomainUUID string             `json:"domainUUID,omitempty"`
-	Execution  *WorkflowExecution `json:"execution,omitempty"`
+	DomainUUID         string             `json:"domainUUID,omitempty"`
+	Execution          *WorkflowExecution `json:"execution,omitempty"`
+	RebuildFromHistory bool               `json:"rebuildFromHistory,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -38,6 +39,8 @@ func (v *DescribeMutableStateRequest) GetDomainUUID() (o string) {
 type DescribeMutableStateResponse struct {
 	MutableStateInCache    string `json:"mutableStateInCache,omitempty"`
 	MutableStateInDatabase string `json:"mutableStateInDatabase,omitempty"`
+	// MutableStateRebuiltFromHistory is only set when RebuildFromHistory is requested
+	MutableStateRebuiltFromHistory string `json:"mutableStateRebuiltFromHistory,omitempty"`
 }
 
 // HistoryDescribeWorkflowExecutionRequest is an internal type (TBD...)
@@ -374,6 +377,50 @@ type ProcessingQueueStates struct {
 	StatesByCluster map[string][]*ProcessingQueueState `json:"statesByCluster,omitempty"`
 }
 
+// HistoryPauseActivityRequest is an internal type (TBD...)
+type HistoryPauseActivityRequest struct {
+	DomainUUID   string                `json:"domainUUID,omitempty"`
+	PauseRequest *PauseActivityRequest `json:"pauseRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryPauseActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetPauseRequest is an internal getter (TBD...)
+func (v *HistoryPauseActivityRequest) GetPauseRequest() (o *PauseActivityRequest) {
+	if v != nil && v.PauseRequest != nil {
+		return v.PauseRequest
+	}
+	return
+}
+
+// HistoryPauseWorkflowExecutionRequest is an internal type (TBD...)
+type HistoryPauseWorkflowExecutionRequest struct {
+	DomainUUID   string                         `json:"domainUUID,omitempty"`
+	PauseRequest *PauseWorkflowExecutionRequest `json:"pauseRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryPauseWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetPauseRequest is an internal getter (TBD...)
+func (v *HistoryPauseWorkflowExecutionRequest) GetPauseRequest() (o *PauseWorkflowExecutionRequest) {
+	if v != nil && v.PauseRequest != nil {
+		return v.PauseRequest
+	}
+	return
+}
+
 // HistoryQueryWorkflowRequest is an internal type (TBD...)
 type HistoryQueryWorkflowRequest struct {
 	DomainUUID string                `json:"domainUUID,omitempty"`
@@ -583,6 +630,7 @@ type RecordDecisionTaskStartedResponse struct {
 	StartedTimestamp          *int64                    `json:"startedTimestamp,omitempty"`
 	Queries                   map[string]*WorkflowQuery `json:"queries,omitempty"`
 	HistorySize               int64                     `json:"historySize,omitempty"`
+	ContinueAsNewSuggested    bool                      `json:"continueAsNewSuggested,omitempty"`
 }
 
 // GetPreviousStartedEventID is an internal getter (TBD...)
@@ -609,6 +657,14 @@ func (v *RecordDecisionTaskStartedResponse) GetAttempt() (o int64) {
 	return
 }
 
+// GetContinueAsNewSuggested is an internal getter (TBD...)
+func (v *RecordDecisionTaskStartedResponse) GetContinueAsNewSuggested() (o bool) {
+	if v != nil {
+		return v.ContinueAsNewSuggested
+	}
+	return
+}
+
 // HistoryRefreshWorkflowTasksRequest is an internal type (TBD...)
 type HistoryRefreshWorkflowTasksRequest struct {
 	DomainUIID string                       `json:"domainUIID,omitempty"`
@@ -704,6 +760,28 @@ func (v *HistoryRequestCancelWorkflowExecutionRequest) GetChildWorkflowOnly() (o
 	return
 }
 
+// HistoryResetActivityRequest is an internal type (TBD...)
+type HistoryResetActivityRequest struct {
+	DomainUUID   string                `json:"domainUUID,omitempty"`
+	ResetRequest *ResetActivityRequest `json:"resetRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryResetActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetResetRequest is an internal getter (TBD...)
+func (v *HistoryResetActivityRequest) GetResetRequest() (o *ResetActivityRequest) {
+	if v != nil && v.ResetRequest != nil {
+		return v.ResetRequest
+	}
+	return
+}
+
 // HistoryResetStickyTaskListRequest is an internal type (TBD...)
 type HistoryResetStickyTaskListRequest struct {
 	DomainUUID string             `json:"domainUUID,omitempty"`
@@ -968,6 +1046,7 @@ type SyncActivityRequest struct {
 	LastWorkerIdentity string          `json:"lastWorkerIdentity,omitempty"`
 	LastFailureDetails []byte          `json:"lastFailureDetails,omitempty"`
 	VersionHistory     *VersionHistory `json:"versionHistory,omitempty"`
+	Paused             bool            `json:"paused,omitempty"`
 }
 
 // GetDomainID is an internal getter (TBD...)
@@ -1090,6 +1169,14 @@ func (v *SyncActivityRequest) GetVersionHistory() (o *VersionHistory) {
 	return
 }
 
+// GetPaused is an internal getter (TBD...)
+func (v *SyncActivityRequest) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
+}
+
 // SyncShardStatusRequest is an internal type (TBD...)
 type SyncShardStatusRequest struct {
 	SourceCluster string `json:"sourceCluster,omitempty"`
@@ -1161,6 +1248,72 @@ func (v *HistoryTerminateWorkflowExecutionRequest) GetChildWorkflowOnly() (o boo
 	return
 }
 
+// HistoryUnpauseActivityRequest is an internal type (TBD...)
+type HistoryUnpauseActivityRequest struct {
+	DomainUUID     string                  `json:"domainUUID,omitempty"`
+	UnpauseRequest *UnpauseActivityRequest `json:"unpauseRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryUnpauseActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetUnpauseRequest is an internal getter (TBD...)
+func (v *HistoryUnpauseActivityRequest) GetUnpauseRequest() (o *UnpauseActivityRequest) {
+	if v != nil && v.UnpauseRequest != nil {
+		return v.UnpauseRequest
+	}
+	return
+}
+
+// HistoryUnpauseWorkflowExecutionRequest is an internal type (TBD...)
+type HistoryUnpauseWorkflowExecutionRequest struct {
+	DomainUUID     string                           `json:"domainUUID,omitempty"`
+	UnpauseRequest *UnpauseWorkflowExecutionRequest `json:"unpauseRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryUnpauseWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetUnpauseRequest is an internal getter (TBD...)
+func (v *HistoryUnpauseWorkflowExecutionRequest) GetUnpauseRequest() (o *UnpauseWorkflowExecutionRequest) {
+	if v != nil && v.UnpauseRequest != nil {
+		return v.UnpauseRequest
+	}
+	return
+}
+
+// HistoryUpdateActivityOptionsRequest is an internal type (TBD...)
+type HistoryUpdateActivityOptionsRequest struct {
+	DomainUUID    string                        `json:"domainUUID,omitempty"`
+	UpdateRequest *UpdateActivityOptionsRequest `json:"updateRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryUpdateActivityOptionsRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetUpdateRequest is an internal getter (TBD...)
+func (v *HistoryUpdateActivityOptionsRequest) GetUpdateRequest() (o *UpdateActivityOptionsRequest) {
+	if v != nil && v.UpdateRequest != nil {
+		return v.UpdateRequest
+	}
+	return
+}
+
 // GetFailoverInfoRequest is an internal type (TBD...)
 type GetFailoverInfoRequest struct {
 	DomainID string `json:"domainID,omitempty"`
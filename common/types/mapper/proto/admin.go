@@ -232,13 +232,190 @@ func ToAdminDescribeQueueResponse(t *adminv1.DescribeQueueResponse) *types.Descr
 	}
 }
 
+func FromAdminListThrottledWorkflowIDsRequest(t *types.ListThrottledWorkflowIDsRequest) *adminv1.ListThrottledWorkflowIDsRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ListThrottledWorkflowIDsRequest{
+		ShardId: t.ShardID,
+	}
+}
+
+func ToAdminListThrottledWorkflowIDsRequest(t *adminv1.ListThrottledWorkflowIDsRequest) *types.ListThrottledWorkflowIDsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListThrottledWorkflowIDsRequest{
+		ShardID: t.ShardId,
+	}
+}
+
+func FromAdminListThrottledWorkflowIDsResponse(t *types.ListThrottledWorkflowIDsResponse) *adminv1.ListThrottledWorkflowIDsResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ListThrottledWorkflowIDsResponse{
+		ThrottledWorkflowIds: FromThrottledWorkflowIDArray(t.ThrottledWorkflowIDs),
+	}
+}
+
+func ToAdminListThrottledWorkflowIDsResponse(t *adminv1.ListThrottledWorkflowIDsResponse) *types.ListThrottledWorkflowIDsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListThrottledWorkflowIDsResponse{
+		ThrottledWorkflowIDs: ToThrottledWorkflowIDArray(t.ThrottledWorkflowIds),
+	}
+}
+
+func FromThrottledWorkflowID(t *types.ThrottledWorkflowID) *adminv1.ThrottledWorkflowID {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ThrottledWorkflowID{
+		DomainName:        t.DomainName,
+		WorkflowId:        t.WorkflowID,
+		Limit:             t.Limit,
+		Count:             t.Count,
+		LastThrottledTime: unixNanoToTime(t.LastThrottledTimestamp),
+	}
+}
+
+func ToThrottledWorkflowID(t *adminv1.ThrottledWorkflowID) *types.ThrottledWorkflowID {
+	if t == nil {
+		return nil
+	}
+	return &types.ThrottledWorkflowID{
+		DomainName:             t.DomainName,
+		WorkflowID:             t.WorkflowId,
+		Limit:                  t.Limit,
+		Count:                  t.Count,
+		LastThrottledTimestamp: timeToUnixNano(t.LastThrottledTime),
+	}
+}
+
+func FromThrottledWorkflowIDArray(t []*types.ThrottledWorkflowID) []*adminv1.ThrottledWorkflowID {
+	if t == nil {
+		return nil
+	}
+	v := make([]*adminv1.ThrottledWorkflowID, len(t))
+	for i := range t {
+		v[i] = FromThrottledWorkflowID(t[i])
+	}
+	return v
+}
+
+func ToThrottledWorkflowIDArray(t []*adminv1.ThrottledWorkflowID) []*types.ThrottledWorkflowID {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ThrottledWorkflowID, len(t))
+	for i := range t {
+		v[i] = ToThrottledWorkflowID(t[i])
+	}
+	return v
+}
+
+func FromAdminGetDomainUsageRequest(t *types.GetDomainUsageRequest) *adminv1.GetDomainUsageRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.GetDomainUsageRequest{
+		Domain:    t.Domain,
+		StartTime: unixNanoToTime(t.StartTimestamp),
+		EndTime:   unixNanoToTime(t.EndTimestamp),
+	}
+}
+
+func ToAdminGetDomainUsageRequest(t *adminv1.GetDomainUsageRequest) *types.GetDomainUsageRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDomainUsageRequest{
+		Domain:         t.Domain,
+		StartTimestamp: timeToUnixNano(t.StartTime),
+		EndTimestamp:   timeToUnixNano(t.EndTime),
+	}
+}
+
+func FromAdminGetDomainUsageResponse(t *types.GetDomainUsageResponse) *adminv1.GetDomainUsageResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.GetDomainUsageResponse{
+		Usage: FromWorkflowUsageArray(t.Usage),
+	}
+}
+
+func ToAdminGetDomainUsageResponse(t *adminv1.GetDomainUsageResponse) *types.GetDomainUsageResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDomainUsageResponse{
+		Usage: ToWorkflowUsageArray(t.Usage),
+	}
+}
+
+func FromWorkflowUsage(t *types.WorkflowUsage) *adminv1.WorkflowUsage {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.WorkflowUsage{
+		WorkflowType:    t.WorkflowType,
+		DecisionTasks:   t.DecisionTasks,
+		ActivityTasks:   t.ActivityTasks,
+		LocalActivities: t.LocalActivities,
+		Signals:         t.Signals,
+		Timers:          t.Timers,
+		HistoryBytes:    t.HistoryBytes,
+	}
+}
+
+func ToWorkflowUsage(t *adminv1.WorkflowUsage) *types.WorkflowUsage {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowUsage{
+		WorkflowType:    t.WorkflowType,
+		DecisionTasks:   t.DecisionTasks,
+		ActivityTasks:   t.ActivityTasks,
+		LocalActivities: t.LocalActivities,
+		Signals:         t.Signals,
+		Timers:          t.Timers,
+		HistoryBytes:    t.HistoryBytes,
+	}
+}
+
+func FromWorkflowUsageArray(t []*types.WorkflowUsage) []*adminv1.WorkflowUsage {
+	if t == nil {
+		return nil
+	}
+	v := make([]*adminv1.WorkflowUsage, len(t))
+	for i := range t {
+		v[i] = FromWorkflowUsage(t[i])
+	}
+	return v
+}
+
+func ToWorkflowUsageArray(t []*adminv1.WorkflowUsage) []*types.WorkflowUsage {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkflowUsage, len(t))
+	for i := range t {
+		v[i] = ToWorkflowUsage(t[i])
+	}
+	return v
+}
+
 func FromAdminDescribeWorkflowExecutionRequest(t *types.AdminDescribeWorkflowExecutionRequest) *adminv1.DescribeWorkflowExecutionRequest {
 	if t == nil {
 		return nil
 	}
 	return &adminv1.DescribeWorkflowExecutionRequest{
-		Domain:            t.Domain,
-		WorkflowExecution: FromWorkflowExecution(t.Execution),
+		Domain:             t.Domain,
+		WorkflowExecution:  FromWorkflowExecution(t.Execution),
+		RebuildFromHistory: t.RebuildFromHistory,
 	}
 }
 
@@ -247,8 +424,9 @@ func ToAdminDescribeWorkflowExecutionRequest(t *adminv1.DescribeWorkflowExecutio
 		return nil
 	}
 	return &types.AdminDescribeWorkflowExecutionRequest{
-		Domain:    t.Domain,
-		Execution: ToWorkflowExecution(t.WorkflowExecution),
+		Domain:             t.Domain,
+		Execution:          ToWorkflowExecution(t.WorkflowExecution),
+		RebuildFromHistory: t.RebuildFromHistory,
 	}
 }
 
@@ -257,10 +435,11 @@ func FromAdminDescribeWorkflowExecutionResponse(t *types.AdminDescribeWorkflowEx
 		return nil
 	}
 	return &adminv1.DescribeWorkflowExecutionResponse{
-		ShardId:                stringToInt32(t.ShardID),
-		HistoryAddr:            t.HistoryAddr,
-		MutableStateInCache:    t.MutableStateInCache,
-		MutableStateInDatabase: t.MutableStateInDatabase,
+		ShardId:                        stringToInt32(t.ShardID),
+		HistoryAddr:                    t.HistoryAddr,
+		MutableStateInCache:            t.MutableStateInCache,
+		MutableStateInDatabase:         t.MutableStateInDatabase,
+		MutableStateRebuiltFromHistory: t.MutableStateRebuiltFromHistory,
 	}
 }
 
@@ -269,10 +448,11 @@ func ToAdminDescribeWorkflowExecutionResponse(t *adminv1.DescribeWorkflowExecuti
 		return nil
 	}
 	return &types.AdminDescribeWorkflowExecutionResponse{
-		ShardID:                int32ToString(t.ShardId),
-		HistoryAddr:            t.HistoryAddr,
-		MutableStateInCache:    t.MutableStateInCache,
-		MutableStateInDatabase: t.MutableStateInDatabase,
+		ShardID:                        int32ToString(t.ShardId),
+		HistoryAddr:                    t.HistoryAddr,
+		MutableStateInCache:            t.MutableStateInCache,
+		MutableStateInDatabase:         t.MutableStateInDatabase,
+		MutableStateRebuiltFromHistory: t.MutableStateRebuiltFromHistory,
 	}
 }
 
@@ -390,6 +570,44 @@ func ToAdminGetReplicationMessagesResponse(t *adminv1.GetReplicationMessagesResp
 	}
 }
 
+func FromAdminStreamReplicationMessagesRequest(t *types.StreamReplicationMessagesRequest) *adminv1.StreamReplicationMessagesRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.StreamReplicationMessagesRequest{
+		Tokens:      FromReplicationTokenArray(t.Tokens),
+		ClusterName: t.ClusterName,
+	}
+}
+
+func ToAdminStreamReplicationMessagesRequest(t *adminv1.StreamReplicationMessagesRequest) *types.StreamReplicationMessagesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.StreamReplicationMessagesRequest{
+		Tokens:      ToReplicationTokenArray(t.Tokens),
+		ClusterName: t.ClusterName,
+	}
+}
+
+func FromAdminStreamReplicationMessagesResponse(t *types.StreamReplicationMessagesResponse) *adminv1.StreamReplicationMessagesResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.StreamReplicationMessagesResponse{
+		ShardMessages: FromReplicationMessagesMap(t.MessagesByShard),
+	}
+}
+
+func ToAdminStreamReplicationMessagesResponse(t *adminv1.StreamReplicationMessagesResponse) *types.StreamReplicationMessagesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.StreamReplicationMessagesResponse{
+		MessagesByShard: ToReplicationMessagesMap(t.ShardMessages),
+	}
+}
+
 func FromAdminGetWorkflowExecutionRawHistoryV2Request(t *types.GetWorkflowExecutionRawHistoryV2Request) *adminv1.GetWorkflowExecutionRawHistoryV2Request {
 	if t == nil {
 		return nil
@@ -491,6 +709,7 @@ func FromAdminMergeDLQMessagesRequest(t *types.MergeDLQMessagesRequest) *adminv1
 		InclusiveEndMessageId: fromInt64Value(t.InclusiveEndMessageID),
 		PageSize:              t.MaximumPageSize,
 		NextPageToken:         t.NextPageToken,
+		Filter:                FromDLQFilter(t.Filter),
 	}
 }
 
@@ -505,6 +724,7 @@ func ToAdminMergeDLQMessagesRequest(t *adminv1.MergeDLQMessagesRequest) *types.M
 		InclusiveEndMessageID: toInt64Value(t.InclusiveEndMessageId),
 		MaximumPageSize:       t.PageSize,
 		NextPageToken:         t.NextPageToken,
+		Filter:                ToDLQFilter(t.Filter),
 	}
 }
 
@@ -526,6 +746,30 @@ func ToAdminMergeDLQMessagesResponse(t *adminv1.MergeDLQMessagesResponse) *types
 	}
 }
 
+func FromAdminPauseActivityRequest(t *types.PauseActivityRequest) *adminv1.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.PauseActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.ActivityID,
+		Identity:          t.Identity,
+	}
+}
+
+func ToAdminPauseActivityRequest(t *adminv1.PauseActivityRequest) *types.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.ActivityId,
+		Identity:          t.Identity,
+	}
+}
+
 func FromAdminPurgeDLQMessagesRequest(t *types.PurgeDLQMessagesRequest) *adminv1.PurgeDLQMessagesRequest {
 	if t == nil {
 		return nil
@@ -561,6 +805,7 @@ func FromAdminReadDLQMessagesRequest(t *types.ReadDLQMessagesRequest) *adminv1.R
 		InclusiveEndMessageId: fromInt64Value(t.InclusiveEndMessageID),
 		PageSize:              t.MaximumPageSize,
 		NextPageToken:         t.NextPageToken,
+		Filter:                FromDLQFilter(t.Filter),
 	}
 }
 
@@ -575,6 +820,7 @@ func ToAdminReadDLQMessagesRequest(t *adminv1.ReadDLQMessagesRequest) *types.Rea
 		InclusiveEndMessageID: toInt64Value(t.InclusiveEndMessageId),
 		MaximumPageSize:       t.PageSize,
 		NextPageToken:         t.NextPageToken,
+		Filter:                ToDLQFilter(t.Filter),
 	}
 }
 
@@ -699,6 +945,54 @@ func ToAdminResendReplicationTasksRequest(t *adminv1.ResendReplicationTasksReque
 	}
 }
 
+func FromAdminImportWorkflowExecutionRequest(t *types.ImportWorkflowExecutionRequest) *adminv1.ImportWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ImportWorkflowExecutionRequest{
+		Domain:              t.Domain,
+		WorkflowExecution:   FromWorkflowExecution(t.WorkflowExecution),
+		VersionHistoryItems: FromVersionHistoryItemArray(t.VersionHistoryItems),
+		Events:              FromDataBlob(t.Events),
+	}
+}
+
+func ToAdminImportWorkflowExecutionRequest(t *adminv1.ImportWorkflowExecutionRequest) *types.ImportWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ImportWorkflowExecutionRequest{
+		Domain:              t.Domain,
+		WorkflowExecution:   ToWorkflowExecution(t.WorkflowExecution),
+		VersionHistoryItems: ToVersionHistoryItemArray(t.VersionHistoryItems),
+		Events:              ToDataBlob(t.Events),
+	}
+}
+
+func FromAdminResetActivityRequest(t *types.ResetActivityRequest) *adminv1.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ResetActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.ActivityID,
+		Identity:          t.Identity,
+	}
+}
+
+func ToAdminResetActivityRequest(t *adminv1.ResetActivityRequest) *types.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.ActivityId,
+		Identity:          t.Identity,
+	}
+}
+
 func FromAdminResetQueueRequest(t *types.ResetQueueRequest) *adminv1.ResetQueueRequest {
 	if t == nil {
 		return nil
@@ -853,6 +1147,64 @@ func ToAdminGetDynamicConfigResponse(t *adminv1.GetDynamicConfigResponse) *types
 	}
 }
 
+func FromAdminUnpauseActivityRequest(t *types.UnpauseActivityRequest) *adminv1.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.UnpauseActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.ActivityID,
+		Identity:          t.Identity,
+	}
+}
+
+func ToAdminUnpauseActivityRequest(t *adminv1.UnpauseActivityRequest) *types.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.ActivityId,
+		Identity:          t.Identity,
+	}
+}
+
+func FromAdminUpdateActivityOptionsRequest(t *types.UpdateActivityOptionsRequest) *adminv1.UpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.UpdateActivityOptionsRequest{
+		Domain:                 t.Domain,
+		WorkflowExecution:      FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:             t.ActivityID,
+		ScheduleToCloseTimeout: secondsToDuration(t.ScheduleToCloseTimeoutSeconds),
+		ScheduleToStartTimeout: secondsToDuration(t.ScheduleToStartTimeoutSeconds),
+		StartToCloseTimeout:    secondsToDuration(t.StartToCloseTimeoutSeconds),
+		HeartbeatTimeout:       secondsToDuration(t.HeartbeatTimeoutSeconds),
+		RetryPolicy:            FromRetryPolicy(t.RetryPolicy),
+		Identity:               t.Identity,
+	}
+}
+
+func ToAdminUpdateActivityOptionsRequest(t *adminv1.UpdateActivityOptionsRequest) *types.UpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateActivityOptionsRequest{
+		Domain:                        t.Domain,
+		WorkflowExecution:             ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:                    t.ActivityId,
+		ScheduleToCloseTimeoutSeconds: durationToSeconds(t.ScheduleToCloseTimeout),
+		ScheduleToStartTimeoutSeconds: durationToSeconds(t.ScheduleToStartTimeout),
+		StartToCloseTimeoutSeconds:    durationToSeconds(t.StartToCloseTimeout),
+		HeartbeatTimeoutSeconds:       durationToSeconds(t.HeartbeatTimeout),
+		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
+		Identity:                      t.Identity,
+	}
+}
+
 // FromAdminUpdateDynamicConfigRequest converts internal UpdateDynamicConfigRequest type to proto
 func FromAdminUpdateDynamicConfigRequest(t *types.UpdateDynamicConfigRequest) *adminv1.UpdateDynamicConfigRequest {
 	if t == nil {
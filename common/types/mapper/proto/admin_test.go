@@ -74,6 +74,26 @@ func TestAdminDescribeQueueResponse(t *testing.T) {
 		assert.Equal(t, item, ToAdminDescribeQueueResponse(FromAdminDescribeQueueResponse(item)))
 	}
 }
+func TestAdminListThrottledWorkflowIDsRequest(t *testing.T) {
+	for _, item := range []*types.ListThrottledWorkflowIDsRequest{nil, {}, &testdata.AdminListThrottledWorkflowIDsRequest} {
+		assert.Equal(t, item, ToAdminListThrottledWorkflowIDsRequest(FromAdminListThrottledWorkflowIDsRequest(item)))
+	}
+}
+func TestAdminListThrottledWorkflowIDsResponse(t *testing.T) {
+	for _, item := range []*types.ListThrottledWorkflowIDsResponse{nil, {}, &testdata.AdminListThrottledWorkflowIDsResponse} {
+		assert.Equal(t, item, ToAdminListThrottledWorkflowIDsResponse(FromAdminListThrottledWorkflowIDsResponse(item)))
+	}
+}
+func TestAdminGetDomainUsageRequest(t *testing.T) {
+	for _, item := range []*types.GetDomainUsageRequest{nil, {}, &testdata.AdminGetDomainUsageRequest} {
+		assert.Equal(t, item, ToAdminGetDomainUsageRequest(FromAdminGetDomainUsageRequest(item)))
+	}
+}
+func TestAdminGetDomainUsageResponse(t *testing.T) {
+	for _, item := range []*types.GetDomainUsageResponse{nil, {}, &testdata.AdminGetDomainUsageResponse} {
+		assert.Equal(t, item, ToAdminGetDomainUsageResponse(FromAdminGetDomainUsageResponse(item)))
+	}
+}
 func TestAdminDescribeWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.AdminDescribeWorkflowExecutionRequest{nil, {}, &testdata.AdminDescribeWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToAdminDescribeWorkflowExecutionRequest(FromAdminDescribeWorkflowExecutionRequest(item)))
@@ -109,6 +129,16 @@ func TestAdminGetReplicationMessagesRequest(t *testing.T) {
 		assert.Equal(t, item, ToAdminGetReplicationMessagesRequest(FromAdminGetReplicationMessagesRequest(item)))
 	}
 }
+func TestAdminStreamReplicationMessagesRequest(t *testing.T) {
+	for _, item := range []*types.StreamReplicationMessagesRequest{nil, {}, &testdata.AdminStreamReplicationMessagesRequest} {
+		assert.Equal(t, item, ToAdminStreamReplicationMessagesRequest(FromAdminStreamReplicationMessagesRequest(item)))
+	}
+}
+func TestAdminStreamReplicationMessagesResponse(t *testing.T) {
+	for _, item := range []*types.StreamReplicationMessagesResponse{nil, {}, &testdata.AdminStreamReplicationMessagesResponse} {
+		assert.Equal(t, item, ToAdminStreamReplicationMessagesResponse(FromAdminStreamReplicationMessagesResponse(item)))
+	}
+}
 func TestAdminGetReplicationMessagesResponse(t *testing.T) {
 	for _, item := range []*types.GetReplicationMessagesResponse{nil, {}, &testdata.AdminGetReplicationMessagesResponse} {
 		assert.Equal(t, item, ToAdminGetReplicationMessagesResponse(FromAdminGetReplicationMessagesResponse(item)))
@@ -169,6 +199,30 @@ func TestAdminRefreshWorkflowTasksRequest(t *testing.T) {
 		assert.Equal(t, item, ToAdminRefreshWorkflowTasksRequest(FromAdminRefreshWorkflowTasksRequest(item)))
 	}
 }
+
+func TestAdminPauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.PauseActivityRequest{nil, {}, &testdata.PauseActivityRequest} {
+		assert.Equal(t, item, ToAdminPauseActivityRequest(FromAdminPauseActivityRequest(item)))
+	}
+}
+
+func TestAdminUnpauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.UnpauseActivityRequest{nil, {}, &testdata.UnpauseActivityRequest} {
+		assert.Equal(t, item, ToAdminUnpauseActivityRequest(FromAdminUnpauseActivityRequest(item)))
+	}
+}
+
+func TestAdminResetActivityRequest(t *testing.T) {
+	for _, item := range []*types.ResetActivityRequest{nil, {}, &testdata.ResetActivityRequest} {
+		assert.Equal(t, item, ToAdminResetActivityRequest(FromAdminResetActivityRequest(item)))
+	}
+}
+
+func TestAdminUpdateActivityOptionsRequest(t *testing.T) {
+	for _, item := range []*types.UpdateActivityOptionsRequest{nil, {}, &testdata.UpdateActivityOptionsRequest} {
+		assert.Equal(t, item, ToAdminUpdateActivityOptionsRequest(FromAdminUpdateActivityOptionsRequest(item)))
+	}
+}
 func TestAdminRemoveTaskRequest(t *testing.T) {
 	for _, item := range []*types.RemoveTaskRequest{nil, {}, &testdata.AdminRemoveTaskRequest} {
 		assert.Equal(t, item, ToAdminRemoveTaskRequest(FromAdminRemoveTaskRequest(item)))
@@ -179,6 +233,11 @@ func TestAdminResendReplicationTasksRequest(t *testing.T) {
 		assert.Equal(t, item, ToAdminResendReplicationTasksRequest(FromAdminResendReplicationTasksRequest(item)))
 	}
 }
+func TestAdminImportWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.ImportWorkflowExecutionRequest{nil, {}, &testdata.AdminImportWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToAdminImportWorkflowExecutionRequest(FromAdminImportWorkflowExecutionRequest(item)))
+	}
+}
 func TestAdminResetQueueRequest(t *testing.T) {
 	for _, item := range []*types.ResetQueueRequest{nil, {}, &testdata.AdminResetQueueRequest} {
 		assert.Equal(t, item, ToAdminResetQueueRequest(FromAdminResetQueueRequest(item)))
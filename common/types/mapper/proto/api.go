@@ -1936,6 +1936,56 @@ func ToParentClosePolicy(t apiv1.ParentClosePolicy) *types.ParentClosePolicy {
 	panic("unexpected enum value")
 }
 
+func FromPauseActivityRequest(t *types.PauseActivityRequest) *apiv1.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PauseActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.ActivityID,
+		Identity:          t.Identity,
+	}
+}
+
+func ToPauseActivityRequest(t *apiv1.PauseActivityRequest) *types.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.ActivityId,
+		Identity:          t.Identity,
+	}
+}
+
+func FromPauseWorkflowExecutionRequest(t *types.PauseWorkflowExecutionRequest) *apiv1.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+		RequestId:         t.RequestID,
+	}
+}
+
+func ToPauseWorkflowExecutionRequest(t *apiv1.PauseWorkflowExecutionRequest) *types.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+		RequestID:         t.RequestId,
+	}
+}
+
 func FromPendingActivityInfo(t *types.PendingActivityInfo) *apiv1.PendingActivityInfo {
 	if t == nil {
 		return nil
@@ -1954,6 +2004,7 @@ func FromPendingActivityInfo(t *types.PendingActivityInfo) *apiv1.PendingActivit
 		LastFailure:           FromFailure(t.LastFailureReason, t.LastFailureDetails),
 		LastWorkerIdentity:    t.LastWorkerIdentity,
 		StartedWorkerIdentity: t.StartedWorkerIdentity,
+		Paused:                t.Paused,
 	}
 }
 
@@ -1976,6 +2027,7 @@ func ToPendingActivityInfo(t *apiv1.PendingActivityInfo) *types.PendingActivityI
 		LastFailureDetails:     ToFailureDetails(t.LastFailure),
 		LastWorkerIdentity:     t.LastWorkerIdentity,
 		StartedWorkerIdentity:  t.StartedWorkerIdentity,
+		Paused:                 t.Paused,
 	}
 }
 
@@ -2203,6 +2255,7 @@ func FromPollForDecisionTaskResponse(t *types.PollForDecisionTaskResponse) *apiv
 		Queries:                   FromWorkflowQueryMap(t.Queries),
 		NextEventId:               t.NextEventID,
 		TotalHistoryBytes:         t.TotalHistoryBytes,
+		ContinueAsNewSuggested:    t.ContinueAsNewSuggested,
 	}
 }
 
@@ -2227,6 +2280,7 @@ func ToPollForDecisionTaskResponse(t *apiv1.PollForDecisionTaskResponse) *types.
 		Queries:                   ToWorkflowQueryMap(t.Queries),
 		NextEventID:               t.NextEventId,
 		TotalHistoryBytes:         t.TotalHistoryBytes,
+		ContinueAsNewSuggested:    t.ContinueAsNewSuggested,
 	}
 }
 
@@ -2688,6 +2742,30 @@ func ToRequestCancelWorkflowExecutionRequest(t *apiv1.RequestCancelWorkflowExecu
 	}
 }
 
+func FromResetActivityRequest(t *types.ResetActivityRequest) *apiv1.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.ActivityID,
+		Identity:          t.Identity,
+	}
+}
+
+func ToResetActivityRequest(t *apiv1.ResetActivityRequest) *types.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.ActivityId,
+		Identity:          t.Identity,
+	}
+}
+
 func FromResetPointInfo(t *types.ResetPointInfo) *apiv1.ResetPointInfo {
 	if t == nil {
 		return nil
@@ -3689,6 +3767,53 @@ func ToSignalWithStartWorkflowExecutionAsyncResponse(t *apiv1.SignalWithStartWor
 	return &types.SignalWithStartWorkflowExecutionAsyncResponse{}
 }
 
+func FromExecuteWorkflowExecutionRequest(t *types.ExecuteWorkflowExecutionRequest) *apiv1.ExecuteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ExecuteWorkflowExecutionRequest{
+		StartRequest:    FromStartWorkflowExecutionRequest(t.StartRequest),
+		AttachToRunning: t.AttachToRunning,
+	}
+}
+
+func ToExecuteWorkflowExecutionRequest(t *apiv1.ExecuteWorkflowExecutionRequest) *types.ExecuteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ExecuteWorkflowExecutionRequest{
+		StartRequest:    ToStartWorkflowExecutionRequest(t.StartRequest),
+		AttachToRunning: t.AttachToRunning,
+	}
+}
+
+func FromExecuteWorkflowExecutionResponse(t *types.ExecuteWorkflowExecutionResponse) *apiv1.ExecuteWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ExecuteWorkflowExecutionResponse{
+		RunId:       t.RunID,
+		Attached:    t.Attached,
+		CloseStatus: FromWorkflowExecutionCloseStatus(t.CloseStatus),
+		Result:      FromPayload(t.Result),
+		Failure:     FromFailure(t.FailureReason, t.FailureDetails),
+	}
+}
+
+func ToExecuteWorkflowExecutionResponse(t *apiv1.ExecuteWorkflowExecutionResponse) *types.ExecuteWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ExecuteWorkflowExecutionResponse{
+		RunID:          t.RunId,
+		Attached:       t.Attached,
+		CloseStatus:    ToWorkflowExecutionCloseStatus(t.CloseStatus),
+		Result:         ToPayload(t.Result),
+		FailureReason:  ToFailureReason(t.Failure),
+		FailureDetails: ToFailureDetails(t.Failure),
+	}
+}
+
 func FromStartWorkflowExecutionAsyncRequest(t *types.StartWorkflowExecutionAsyncRequest) *apiv1.StartWorkflowExecutionAsyncRequest {
 	if t == nil {
 		return nil
@@ -4165,8 +4290,93 @@ const (
 	DomainUpdateClustersField                 = "clusters"
 	DomainUpdateDeleteBadBinaryField          = "delete_bad_binary"
 	DomainUpdateFailoverTimeoutField          = "failover_timeout"
+	DomainUpdateIsGlobalDomainField           = "is_global_domain"
 )
 
+func FromUnpauseActivityRequest(t *types.UnpauseActivityRequest) *apiv1.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UnpauseActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.ActivityID,
+		Identity:          t.Identity,
+	}
+}
+
+func ToUnpauseActivityRequest(t *apiv1.UnpauseActivityRequest) *types.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.ActivityId,
+		Identity:          t.Identity,
+	}
+}
+
+func FromUnpauseWorkflowExecutionRequest(t *types.UnpauseWorkflowExecutionRequest) *apiv1.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UnpauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+		RequestId:         t.RequestID,
+	}
+}
+
+func ToUnpauseWorkflowExecutionRequest(t *apiv1.UnpauseWorkflowExecutionRequest) *types.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+		RequestID:         t.RequestId,
+	}
+}
+
+func FromUpdateActivityOptionsRequest(t *types.UpdateActivityOptionsRequest) *apiv1.UpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateActivityOptionsRequest{
+		Domain:                 t.Domain,
+		WorkflowExecution:      FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:             t.ActivityID,
+		ScheduleToCloseTimeout: secondsToDuration(t.ScheduleToCloseTimeoutSeconds),
+		ScheduleToStartTimeout: secondsToDuration(t.ScheduleToStartTimeoutSeconds),
+		StartToCloseTimeout:    secondsToDuration(t.StartToCloseTimeoutSeconds),
+		HeartbeatTimeout:       secondsToDuration(t.HeartbeatTimeoutSeconds),
+		RetryPolicy:            FromRetryPolicy(t.RetryPolicy),
+		Identity:               t.Identity,
+	}
+}
+
+func ToUpdateActivityOptionsRequest(t *apiv1.UpdateActivityOptionsRequest) *types.UpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateActivityOptionsRequest{
+		Domain:                        t.Domain,
+		WorkflowExecution:             ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:                    t.ActivityId,
+		ScheduleToCloseTimeoutSeconds: durationToSeconds(t.ScheduleToCloseTimeout),
+		ScheduleToStartTimeoutSeconds: durationToSeconds(t.ScheduleToStartTimeout),
+		StartToCloseTimeoutSeconds:    durationToSeconds(t.StartToCloseTimeout),
+		HeartbeatTimeoutSeconds:       durationToSeconds(t.HeartbeatTimeout),
+		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
+		Identity:                      t.Identity,
+	}
+}
+
 func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *apiv1.UpdateDomainRequest {
 	if t == nil {
 		return nil
@@ -4230,6 +4440,10 @@ func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *apiv1.UpdateDomainRe
 		request.FailoverTimeout = secondsToDuration(t.FailoverTimeoutInSeconds)
 		fields = append(fields, DomainUpdateFailoverTimeoutField)
 	}
+	if t.IsGlobalDomain != nil {
+		request.IsGlobalDomain = *t.IsGlobalDomain
+		fields = append(fields, DomainUpdateIsGlobalDomainField)
+	}
 
 	request.UpdateMask = newFieldMask(fields)
 
@@ -4285,6 +4499,9 @@ func ToUpdateDomainRequest(t *apiv1.UpdateDomainRequest) *types.UpdateDomainRequ
 	if fs.isSet(DomainUpdateFailoverTimeoutField) {
 		request.FailoverTimeoutInSeconds = durationToSeconds(t.FailoverTimeout)
 	}
+	if fs.isSet(DomainUpdateIsGlobalDomainField) {
+		request.IsGlobalDomain = common.BoolPtr(t.IsGlobalDomain)
+	}
 
 	return &request
 }
@@ -4783,6 +5000,7 @@ func FromWorkflowExecutionInfo(t *types.WorkflowExecutionInfo) *apiv1.WorkflowEx
 		TaskList:            t.TaskList,
 		PartitionConfig:     t.PartitionConfig,
 		IsCron:              t.IsCron,
+		IsPaused:            t.IsPaused,
 	}
 }
 
@@ -4808,6 +5026,7 @@ func ToWorkflowExecutionInfo(t *apiv1.WorkflowExecutionInfo) *types.WorkflowExec
 		TaskList:          t.TaskList,
 		PartitionConfig:   t.PartitionConfig,
 		IsCron:            t.IsCron,
+		IsPaused:          t.IsPaused,
 	}
 }
 
@@ -4906,6 +5125,28 @@ func ToWorkflowExecutionStartedEventAttributes(t *apiv1.WorkflowExecutionStarted
 	}
 }
 
+func FromWorkflowExecutionPausedEventAttributes(t *types.WorkflowExecutionPausedEventAttributes) *apiv1.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionPausedEventAttributes{
+		Reason:    t.Reason,
+		Identity:  t.Identity,
+		RequestId: t.RequestID,
+	}
+}
+
+func ToWorkflowExecutionPausedEventAttributes(t *apiv1.WorkflowExecutionPausedEventAttributes) *types.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionPausedEventAttributes{
+		Reason:    t.Reason,
+		Identity:  t.Identity,
+		RequestID: t.RequestId,
+	}
+}
+
 func FromWorkflowExecutionTerminatedEventAttributes(t *types.WorkflowExecutionTerminatedEventAttributes) *apiv1.WorkflowExecutionTerminatedEventAttributes {
 	if t == nil {
 		return nil
@@ -4946,6 +5187,28 @@ func ToWorkflowExecutionTimedOutEventAttributes(t *apiv1.WorkflowExecutionTimedO
 	}
 }
 
+func FromWorkflowExecutionUnpausedEventAttributes(t *types.WorkflowExecutionUnpausedEventAttributes) *apiv1.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionUnpausedEventAttributes{
+		Reason:    t.Reason,
+		Identity:  t.Identity,
+		RequestId: t.RequestID,
+	}
+}
+
+func ToWorkflowExecutionUnpausedEventAttributes(t *apiv1.WorkflowExecutionUnpausedEventAttributes) *types.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:    t.Reason,
+		Identity:  t.Identity,
+		RequestID: t.RequestId,
+	}
+}
+
 func FromWorkflowIDReusePolicy(t *types.WorkflowIDReusePolicy) apiv1.WorkflowIdReusePolicy {
 	if t == nil {
 		return apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_INVALID
@@ -5657,6 +5920,14 @@ func FromHistoryEvent(e *types.HistoryEvent) *apiv1.HistoryEvent {
 		event.Attributes = &apiv1.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes{
 			UpsertWorkflowSearchAttributesEventAttributes: FromUpsertWorkflowSearchAttributesEventAttributes(e.UpsertWorkflowSearchAttributesEventAttributes),
 		}
+	case types.EventTypeWorkflowExecutionPaused:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionPausedEventAttributes{
+			WorkflowExecutionPausedEventAttributes: FromWorkflowExecutionPausedEventAttributes(e.WorkflowExecutionPausedEventAttributes),
+		}
+	case types.EventTypeWorkflowExecutionUnpaused:
+		event.Attributes = &apiv1.HistoryEvent_WorkflowExecutionUnpausedEventAttributes{
+			WorkflowExecutionUnpausedEventAttributes: FromWorkflowExecutionUnpausedEventAttributes(e.WorkflowExecutionUnpausedEventAttributes),
+		}
 	}
 	return &event
 }
@@ -5798,6 +6069,12 @@ func ToHistoryEvent(e *apiv1.HistoryEvent) *types.HistoryEvent {
 	case *apiv1.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes:
 		event.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		event.UpsertWorkflowSearchAttributesEventAttributes = ToUpsertWorkflowSearchAttributesEventAttributes(attr.UpsertWorkflowSearchAttributesEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionPausedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionPaused.Ptr()
+		event.WorkflowExecutionPausedEventAttributes = ToWorkflowExecutionPausedEventAttributes(attr.WorkflowExecutionPausedEventAttributes)
+	case *apiv1.HistoryEvent_WorkflowExecutionUnpausedEventAttributes:
+		event.EventType = types.EventTypeWorkflowExecutionUnpaused.Ptr()
+		event.WorkflowExecutionUnpausedEventAttributes = ToWorkflowExecutionUnpausedEventAttributes(attr.WorkflowExecutionUnpausedEventAttributes)
 	}
 	return &event
 }
@@ -6020,3 +6297,558 @@ func ToResetStickyTaskListResponse(t *apiv1.ResetStickyTaskListResponse) *types.
 	}
 	return &types.ResetStickyTaskListResponse{}
 }
+
+func FromScheduleOverlapPolicy(t *types.ScheduleOverlapPolicy) apiv1.ScheduleOverlapPolicy {
+	if t == nil {
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_INVALID
+	}
+	switch *t {
+	case types.ScheduleOverlapPolicySkip:
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP
+	case types.ScheduleOverlapPolicyBuffer:
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_BUFFER
+	case types.ScheduleOverlapPolicyCancelOther:
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER
+	case types.ScheduleOverlapPolicyAllowAll:
+		return apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_ALLOW_ALL
+	}
+	panic("unexpected enum value")
+}
+
+func ToScheduleOverlapPolicy(t apiv1.ScheduleOverlapPolicy) *types.ScheduleOverlapPolicy {
+	switch t {
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_INVALID:
+		return nil
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP:
+		return types.ScheduleOverlapPolicySkip.Ptr()
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_BUFFER:
+		return types.ScheduleOverlapPolicyBuffer.Ptr()
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER:
+		return types.ScheduleOverlapPolicyCancelOther.Ptr()
+	case apiv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_ALLOW_ALL:
+		return types.ScheduleOverlapPolicyAllowAll.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+func FromScheduleCalendarSpec(t *types.ScheduleCalendarSpec) *apiv1.ScheduleCalendarSpec {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleCalendarSpec{
+		Second:     t.Second,
+		Minute:     t.Minute,
+		Hour:       t.Hour,
+		DayOfMonth: t.DayOfMonth,
+		Month:      t.Month,
+		DayOfWeek:  t.DayOfWeek,
+	}
+}
+
+func ToScheduleCalendarSpec(t *apiv1.ScheduleCalendarSpec) *types.ScheduleCalendarSpec {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleCalendarSpec{
+		Second:     t.Second,
+		Minute:     t.Minute,
+		Hour:       t.Hour,
+		DayOfMonth: t.DayOfMonth,
+		Month:      t.Month,
+		DayOfWeek:  t.DayOfWeek,
+	}
+}
+
+func FromScheduleSpec(t *types.ScheduleSpec) *apiv1.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleSpec{
+		CronExpression: t.CronExpression,
+		Calendars:      FromScheduleCalendarSpecArray(t.Calendars),
+		Interval:       secondsToDuration(t.IntervalInSeconds),
+		StartTime:      unixNanoToTime(t.StartTimestamp),
+		EndTime:        unixNanoToTime(t.EndTimestamp),
+		Jitter:         secondsToDuration(t.JitterInSeconds),
+	}
+}
+
+func ToScheduleSpec(t *apiv1.ScheduleSpec) *types.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleSpec{
+		CronExpression:    t.CronExpression,
+		Calendars:         ToScheduleCalendarSpecArray(t.Calendars),
+		IntervalInSeconds: durationToSeconds(t.Interval),
+		StartTimestamp:    timeToUnixNano(t.StartTime),
+		EndTimestamp:      timeToUnixNano(t.EndTime),
+		JitterInSeconds:   durationToSeconds(t.Jitter),
+	}
+}
+
+func FromScheduleStartWorkflowAction(t *types.ScheduleStartWorkflowAction) *apiv1.ScheduleStartWorkflowAction {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleStartWorkflowAction{
+		WorkflowType:                 FromWorkflowType(t.WorkflowType),
+		TaskList:                     FromTaskList(t.TaskList),
+		WorkflowIdPrefix:             t.WorkflowIDPrefix,
+		Input:                        FromPayload(t.Input),
+		ExecutionStartToCloseTimeout: secondsToDuration(t.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeout:      secondsToDuration(t.TaskStartToCloseTimeoutSeconds),
+	}
+}
+
+func ToScheduleStartWorkflowAction(t *apiv1.ScheduleStartWorkflowAction) *types.ScheduleStartWorkflowAction {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleStartWorkflowAction{
+		WorkflowType:                        ToWorkflowType(t.WorkflowType),
+		TaskList:                            ToTaskList(t.TaskList),
+		WorkflowIDPrefix:                    t.WorkflowIdPrefix,
+		Input:                               ToPayload(t.Input),
+		ExecutionStartToCloseTimeoutSeconds: durationToSeconds(t.ExecutionStartToCloseTimeout),
+		TaskStartToCloseTimeoutSeconds:      durationToSeconds(t.TaskStartToCloseTimeout),
+	}
+}
+
+func FromSchedulePolicies(t *types.SchedulePolicies) *apiv1.SchedulePolicies {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SchedulePolicies{
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+		CatchUpWindow: secondsToDuration(t.CatchUpWindowInSeconds),
+		BufferLimit:   t.BufferLimit,
+	}
+}
+
+func ToSchedulePolicies(t *apiv1.SchedulePolicies) *types.SchedulePolicies {
+	if t == nil {
+		return nil
+	}
+	return &types.SchedulePolicies{
+		OverlapPolicy:          ToScheduleOverlapPolicy(t.OverlapPolicy),
+		CatchUpWindowInSeconds: durationToSeconds(t.CatchUpWindow),
+		BufferLimit:            t.BufferLimit,
+	}
+}
+
+func FromScheduleRun(t *types.ScheduleRun) *apiv1.ScheduleRun {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleRun{
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		NominalTime:       unixNanoToTime(t.NominalTimestamp),
+		ActualTime:        unixNanoToTime(t.ActualTimestamp),
+	}
+}
+
+func ToScheduleRun(t *apiv1.ScheduleRun) *types.ScheduleRun {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleRun{
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		NominalTimestamp:  timeToUnixNano(t.NominalTime),
+		ActualTimestamp:   timeToUnixNano(t.ActualTime),
+	}
+}
+
+func FromScheduleState(t *types.ScheduleState) *apiv1.ScheduleState {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleState{
+		Paused:            t.Paused,
+		Note:              t.Note,
+		LastProcessedTime: unixNanoToTime(t.LastProcessedTimestamp),
+		RunningRuns:       FromScheduleRunArray(t.RunningRuns),
+		LastRun:           FromScheduleRun(t.LastRun),
+		BufferedRuns:      t.BufferedRuns,
+		PendingBackfills:  t.PendingBackfills,
+		TotalRuns:         t.TotalRuns,
+		SkippedRuns:       t.SkippedRuns,
+		MissedRuns:        t.MissedRuns,
+		FailedStarts:      t.FailedStarts,
+		BufferOverruns:    t.BufferOverruns,
+	}
+}
+
+func ToScheduleState(t *apiv1.ScheduleState) *types.ScheduleState {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleState{
+		Paused:                 t.Paused,
+		Note:                   t.Note,
+		LastProcessedTimestamp: timeToUnixNano(t.LastProcessedTime),
+		RunningRuns:            ToScheduleRunArray(t.RunningRuns),
+		LastRun:                ToScheduleRun(t.LastRun),
+		BufferedRuns:           t.BufferedRuns,
+		PendingBackfills:       t.PendingBackfills,
+		TotalRuns:              t.TotalRuns,
+		SkippedRuns:            t.SkippedRuns,
+		MissedRuns:             t.MissedRuns,
+		FailedStarts:           t.FailedStarts,
+		BufferOverruns:         t.BufferOverruns,
+	}
+}
+
+func FromScheduleListEntry(t *types.ScheduleListEntry) *apiv1.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleListEntry{
+		ScheduleId:        t.ScheduleID,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+func ToScheduleListEntry(t *apiv1.ScheduleListEntry) *types.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleListEntry{
+		ScheduleID:        t.ScheduleId,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+func FromCreateScheduleRequest(t *types.CreateScheduleRequest) *apiv1.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CreateScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+		Spec:       FromScheduleSpec(t.Spec),
+		Action:     FromScheduleStartWorkflowAction(t.Action),
+		Policies:   FromSchedulePolicies(t.Policies),
+		Identity:   t.Identity,
+		RequestId:  t.RequestID,
+	}
+}
+
+func ToCreateScheduleRequest(t *apiv1.CreateScheduleRequest) *types.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CreateScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+		Spec:       ToScheduleSpec(t.Spec),
+		Action:     ToScheduleStartWorkflowAction(t.Action),
+		Policies:   ToSchedulePolicies(t.Policies),
+		Identity:   t.Identity,
+		RequestID:  t.RequestId,
+	}
+}
+
+func FromDescribeScheduleRequest(t *types.DescribeScheduleRequest) *apiv1.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+	}
+}
+
+func ToDescribeScheduleRequest(t *apiv1.DescribeScheduleRequest) *types.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+	}
+}
+
+func FromDescribeScheduleResponse(t *types.DescribeScheduleResponse) *apiv1.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeScheduleResponse{
+		Spec:             FromScheduleSpec(t.Spec),
+		Action:           FromScheduleStartWorkflowAction(t.Action),
+		Policies:         FromSchedulePolicies(t.Policies),
+		State:            FromScheduleState(t.State),
+		UpcomingRunTimes: fromUnixNanoArray(t.UpcomingRunTimestamps),
+	}
+}
+
+func ToDescribeScheduleResponse(t *apiv1.DescribeScheduleResponse) *types.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleResponse{
+		Spec:                  ToScheduleSpec(t.Spec),
+		Action:                ToScheduleStartWorkflowAction(t.Action),
+		Policies:              ToSchedulePolicies(t.Policies),
+		State:                 ToScheduleState(t.State),
+		UpcomingRunTimestamps: toUnixNanoArray(t.UpcomingRunTimes),
+	}
+}
+
+func FromUpdateScheduleRequest(t *types.UpdateScheduleRequest) *apiv1.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+		Spec:       FromScheduleSpec(t.Spec),
+		Action:     FromScheduleStartWorkflowAction(t.Action),
+		Policies:   FromSchedulePolicies(t.Policies),
+		Identity:   t.Identity,
+	}
+}
+
+func ToUpdateScheduleRequest(t *apiv1.UpdateScheduleRequest) *types.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+		Spec:       ToScheduleSpec(t.Spec),
+		Action:     ToScheduleStartWorkflowAction(t.Action),
+		Policies:   ToSchedulePolicies(t.Policies),
+		Identity:   t.Identity,
+	}
+}
+
+func FromPauseScheduleRequest(t *types.PauseScheduleRequest) *apiv1.PauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PauseScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+		Reason:     t.Reason,
+		Identity:   t.Identity,
+	}
+}
+
+func ToPauseScheduleRequest(t *apiv1.PauseScheduleRequest) *types.PauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+		Reason:     t.Reason,
+		Identity:   t.Identity,
+	}
+}
+
+func FromUnpauseScheduleRequest(t *types.UnpauseScheduleRequest) *apiv1.UnpauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UnpauseScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+		Reason:     t.Reason,
+		Identity:   t.Identity,
+	}
+}
+
+func ToUnpauseScheduleRequest(t *apiv1.UnpauseScheduleRequest) *types.UnpauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+		Reason:     t.Reason,
+		Identity:   t.Identity,
+	}
+}
+
+func FromTriggerScheduleRequest(t *types.TriggerScheduleRequest) *apiv1.TriggerScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TriggerScheduleRequest{
+		Domain:        t.Domain,
+		ScheduleId:    t.ScheduleID,
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:      t.Identity,
+	}
+}
+
+func ToTriggerScheduleRequest(t *apiv1.TriggerScheduleRequest) *types.TriggerScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.TriggerScheduleRequest{
+		Domain:        t.Domain,
+		ScheduleID:    t.ScheduleId,
+		OverlapPolicy: ToScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:      t.Identity,
+	}
+}
+
+func FromBackfillScheduleRequest(t *types.BackfillScheduleRequest) *apiv1.BackfillScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.BackfillScheduleRequest{
+		Domain:        t.Domain,
+		ScheduleId:    t.ScheduleID,
+		StartTime:     unixNanoToTime(t.StartTimestamp),
+		EndTime:       unixNanoToTime(t.EndTimestamp),
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:      t.Identity,
+	}
+}
+
+func ToBackfillScheduleRequest(t *apiv1.BackfillScheduleRequest) *types.BackfillScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.BackfillScheduleRequest{
+		Domain:         t.Domain,
+		ScheduleID:     t.ScheduleId,
+		StartTimestamp: timeToUnixNano(t.StartTime),
+		EndTimestamp:   timeToUnixNano(t.EndTime),
+		OverlapPolicy:  ToScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:       t.Identity,
+	}
+}
+
+func FromDeleteScheduleRequest(t *types.DeleteScheduleRequest) *apiv1.DeleteScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DeleteScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+		Identity:   t.Identity,
+	}
+}
+
+func ToDeleteScheduleRequest(t *apiv1.DeleteScheduleRequest) *types.DeleteScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+		Identity:   t.Identity,
+	}
+}
+
+func FromListSchedulesRequest(t *types.ListSchedulesRequest) *apiv1.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListSchedulesRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToListSchedulesRequest(t *apiv1.ListSchedulesRequest) *types.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromListSchedulesResponse(t *types.ListSchedulesResponse) *apiv1.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListSchedulesResponse{
+		Schedules:     FromScheduleListEntryArray(t.Schedules),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToListSchedulesResponse(t *apiv1.ListSchedulesResponse) *types.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesResponse{
+		Schedules:     ToScheduleListEntryArray(t.Schedules),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromScheduleCalendarSpecArray(t []*types.ScheduleCalendarSpec) []*apiv1.ScheduleCalendarSpec {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.ScheduleCalendarSpec, len(t))
+	for i := range t {
+		v[i] = FromScheduleCalendarSpec(t[i])
+	}
+	return v
+}
+
+func ToScheduleCalendarSpecArray(t []*apiv1.ScheduleCalendarSpec) []*types.ScheduleCalendarSpec {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ScheduleCalendarSpec, len(t))
+	for i := range t {
+		v[i] = ToScheduleCalendarSpec(t[i])
+	}
+	return v
+}
+
+func FromScheduleListEntryArray(t []*types.ScheduleListEntry) []*apiv1.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.ScheduleListEntry, len(t))
+	for i := range t {
+		v[i] = FromScheduleListEntry(t[i])
+	}
+	return v
+}
+
+func ToScheduleListEntryArray(t []*apiv1.ScheduleListEntry) []*types.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ScheduleListEntry, len(t))
+	for i := range t {
+		v[i] = ToScheduleListEntry(t[i])
+	}
+	return v
+}
+
+func FromScheduleRunArray(t []*types.ScheduleRun) []*apiv1.ScheduleRun {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.ScheduleRun, len(t))
+	for i := range t {
+		v[i] = FromScheduleRun(t[i])
+	}
+	return v
+}
+
+func ToScheduleRunArray(t []*apiv1.ScheduleRun) []*types.ScheduleRun {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ScheduleRun, len(t))
+	for i := range t {
+		v[i] = ToScheduleRun(t[i])
+	}
+	return v
+}
@@ -625,6 +625,16 @@ func TestStartWorkflowExecutionResponse(t *testing.T) {
 		assert.Equal(t, item, ToStartWorkflowExecutionResponse(FromStartWorkflowExecutionResponse(item)))
 	}
 }
+func TestExecuteWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.ExecuteWorkflowExecutionRequest{nil, {}, &testdata.ExecuteWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToExecuteWorkflowExecutionRequest(FromExecuteWorkflowExecutionRequest(item)))
+	}
+}
+func TestExecuteWorkflowExecutionResponse(t *testing.T) {
+	for _, item := range []*types.ExecuteWorkflowExecutionResponse{nil, {}, &testdata.ExecuteWorkflowExecutionResponse} {
+		assert.Equal(t, item, ToExecuteWorkflowExecutionResponse(FromExecuteWorkflowExecutionResponse(item)))
+	}
+}
 func TestStartWorkflowExecutionAsyncRequest(t *testing.T) {
 	for _, item := range []*types.StartWorkflowExecutionAsyncRequest{nil, {}, &testdata.StartWorkflowExecutionAsyncRequest} {
 		assert.Equal(t, item, ToStartWorkflowExecutionAsyncRequest(FromStartWorkflowExecutionAsyncRequest(item)))
@@ -685,6 +695,40 @@ func TestTaskListStatus(t *testing.T) {
 		assert.Equal(t, item, ToTaskListStatus(FromTaskListStatus(item)))
 	}
 }
+func TestPauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.PauseWorkflowExecutionRequest{nil, {}, &testdata.PauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToPauseWorkflowExecutionRequest(FromPauseWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestPauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.PauseActivityRequest{nil, {}, &testdata.PauseActivityRequest} {
+		assert.Equal(t, item, ToPauseActivityRequest(FromPauseActivityRequest(item)))
+	}
+}
+
+func TestUnpauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.UnpauseActivityRequest{nil, {}, &testdata.UnpauseActivityRequest} {
+		assert.Equal(t, item, ToUnpauseActivityRequest(FromUnpauseActivityRequest(item)))
+	}
+}
+
+func TestResetActivityRequest(t *testing.T) {
+	for _, item := range []*types.ResetActivityRequest{nil, {}, &testdata.ResetActivityRequest} {
+		assert.Equal(t, item, ToResetActivityRequest(FromResetActivityRequest(item)))
+	}
+}
+
+func TestUpdateActivityOptionsRequest(t *testing.T) {
+	for _, item := range []*types.UpdateActivityOptionsRequest{nil, {}, &testdata.UpdateActivityOptionsRequest} {
+		assert.Equal(t, item, ToUpdateActivityOptionsRequest(FromUpdateActivityOptionsRequest(item)))
+	}
+}
+func TestUnpauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.UnpauseWorkflowExecutionRequest{nil, {}, &testdata.UnpauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToUnpauseWorkflowExecutionRequest(FromUnpauseWorkflowExecutionRequest(item)))
+	}
+}
 func TestTerminateWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.TerminateWorkflowExecutionRequest{nil, {}, &testdata.TerminateWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToTerminateWorkflowExecutionRequest(FromTerminateWorkflowExecutionRequest(item)))
@@ -826,6 +870,16 @@ func TestWorkflowExecutionStartedEventAttributes(t *testing.T) {
 		assert.Equal(t, item, ToWorkflowExecutionStartedEventAttributes(FromWorkflowExecutionStartedEventAttributes(item)))
 	}
 }
+func TestWorkflowExecutionPausedEventAttributes(t *testing.T) {
+	for _, item := range []*types.WorkflowExecutionPausedEventAttributes{nil, {}, &testdata.WorkflowExecutionPausedEventAttributes} {
+		assert.Equal(t, item, ToWorkflowExecutionPausedEventAttributes(FromWorkflowExecutionPausedEventAttributes(item)))
+	}
+}
+func TestWorkflowExecutionUnpausedEventAttributes(t *testing.T) {
+	for _, item := range []*types.WorkflowExecutionUnpausedEventAttributes{nil, {}, &testdata.WorkflowExecutionUnpausedEventAttributes} {
+		assert.Equal(t, item, ToWorkflowExecutionUnpausedEventAttributes(FromWorkflowExecutionUnpausedEventAttributes(item)))
+	}
+}
 func TestWorkflowExecutionTerminatedEventAttributes(t *testing.T) {
 	for _, item := range []*types.WorkflowExecutionTerminatedEventAttributes{nil, {}, &testdata.WorkflowExecutionTerminatedEventAttributes} {
 		assert.Equal(t, item, ToWorkflowExecutionTerminatedEventAttributes(FromWorkflowExecutionTerminatedEventAttributes(item)))
@@ -1003,6 +1057,8 @@ func TestHistoryEvent(t *testing.T) {
 		&testdata.HistoryEvent_SignalExternalWorkflowExecutionFailed,
 		&testdata.HistoryEvent_ExternalWorkflowExecutionSignaled,
 		&testdata.HistoryEvent_UpsertWorkflowSearchAttributes,
+		&testdata.HistoryEvent_WorkflowExecutionPaused,
+		&testdata.HistoryEvent_WorkflowExecutionUnpaused,
 	} {
 		assert.Equal(t, item, ToHistoryEvent(FromHistoryEvent(item)))
 	}
@@ -1073,3 +1129,69 @@ func TestDescribeTaskListResponseMap(t *testing.T) {
 		assert.Equal(t, item, ToDescribeTaskListResponseMap(FromDescribeTaskListResponseMap(item)))
 	}
 }
+
+func TestCreateScheduleRequest(t *testing.T) {
+	for _, item := range []*types.CreateScheduleRequest{nil, {}, &testdata.CreateScheduleRequest} {
+		assert.Equal(t, item, ToCreateScheduleRequest(FromCreateScheduleRequest(item)))
+	}
+}
+
+func TestDescribeScheduleRequest(t *testing.T) {
+	for _, item := range []*types.DescribeScheduleRequest{nil, {}, &testdata.DescribeScheduleRequest} {
+		assert.Equal(t, item, ToDescribeScheduleRequest(FromDescribeScheduleRequest(item)))
+	}
+}
+
+func TestDescribeScheduleResponse(t *testing.T) {
+	for _, item := range []*types.DescribeScheduleResponse{nil, {}, &testdata.DescribeScheduleResponse} {
+		assert.Equal(t, item, ToDescribeScheduleResponse(FromDescribeScheduleResponse(item)))
+	}
+}
+
+func TestUpdateScheduleRequest(t *testing.T) {
+	for _, item := range []*types.UpdateScheduleRequest{nil, {}, &testdata.UpdateScheduleRequest} {
+		assert.Equal(t, item, ToUpdateScheduleRequest(FromUpdateScheduleRequest(item)))
+	}
+}
+
+func TestPauseScheduleRequest(t *testing.T) {
+	for _, item := range []*types.PauseScheduleRequest{nil, {}, &testdata.PauseScheduleRequest} {
+		assert.Equal(t, item, ToPauseScheduleRequest(FromPauseScheduleRequest(item)))
+	}
+}
+
+func TestUnpauseScheduleRequest(t *testing.T) {
+	for _, item := range []*types.UnpauseScheduleRequest{nil, {}, &testdata.UnpauseScheduleRequest} {
+		assert.Equal(t, item, ToUnpauseScheduleRequest(FromUnpauseScheduleRequest(item)))
+	}
+}
+
+func TestTriggerScheduleRequest(t *testing.T) {
+	for _, item := range []*types.TriggerScheduleRequest{nil, {}, &testdata.TriggerScheduleRequest} {
+		assert.Equal(t, item, ToTriggerScheduleRequest(FromTriggerScheduleRequest(item)))
+	}
+}
+
+func TestBackfillScheduleRequest(t *testing.T) {
+	for _, item := range []*types.BackfillScheduleRequest{nil, {}, &testdata.BackfillScheduleRequest} {
+		assert.Equal(t, item, ToBackfillScheduleRequest(FromBackfillScheduleRequest(item)))
+	}
+}
+
+func TestDeleteScheduleRequest(t *testing.T) {
+	for _, item := range []*types.DeleteScheduleRequest{nil, {}, &testdata.DeleteScheduleRequest} {
+		assert.Equal(t, item, ToDeleteScheduleRequest(FromDeleteScheduleRequest(item)))
+	}
+}
+
+func TestListSchedulesRequest(t *testing.T) {
+	for _, item := range []*types.ListSchedulesRequest{nil, {}, &testdata.ListSchedulesRequest} {
+		assert.Equal(t, item, ToListSchedulesRequest(FromListSchedulesRequest(item)))
+	}
+}
+
+func TestListSchedulesResponse(t *testing.T) {
+	for _, item := range []*types.ListSchedulesResponse{nil, {}, &testdata.ListSchedulesResponse} {
+		assert.Equal(t, item, ToListSchedulesResponse(FromListSchedulesResponse(item)))
+	}
+}
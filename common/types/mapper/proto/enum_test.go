@@ -272,6 +272,19 @@ func TestQueryRejectCondition(t *testing.T) {
 	assert.Panics(t, func() { ToQueryRejectCondition(apiv1.QueryRejectCondition(UnknownValue)) })
 	assert.Panics(t, func() { FromQueryRejectCondition(types.QueryRejectCondition(UnknownValue).Ptr()) })
 }
+func TestScheduleOverlapPolicy(t *testing.T) {
+	for _, item := range []*types.ScheduleOverlapPolicy{
+		nil,
+		types.ScheduleOverlapPolicySkip.Ptr(),
+		types.ScheduleOverlapPolicyBuffer.Ptr(),
+		types.ScheduleOverlapPolicyCancelOther.Ptr(),
+		types.ScheduleOverlapPolicyAllowAll.Ptr(),
+	} {
+		assert.Equal(t, item, ToScheduleOverlapPolicy(FromScheduleOverlapPolicy(item)))
+	}
+	assert.Panics(t, func() { ToScheduleOverlapPolicy(apiv1.ScheduleOverlapPolicy(UnknownValue)) })
+	assert.Panics(t, func() { FromScheduleOverlapPolicy(types.ScheduleOverlapPolicy(UnknownValue).Ptr()) })
+}
 func TestQueryResultType(t *testing.T) {
 	for _, item := range []*types.QueryResultType{
 		nil,
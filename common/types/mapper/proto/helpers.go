@@ -97,6 +97,28 @@ func timestampToTime(t *gogo.Timestamp) *time.Time {
 	return &time
 }
 
+func fromUnixNanoArray(t []int64) []*gogo.Timestamp {
+	if t == nil {
+		return nil
+	}
+	v := make([]*gogo.Timestamp, len(t))
+	for i := range t {
+		v[i] = unixNanoToTime(&t[i])
+	}
+	return v
+}
+
+func toUnixNanoArray(t []*gogo.Timestamp) []int64 {
+	if t == nil {
+		return nil
+	}
+	v := make([]int64, len(t))
+	for i := range t {
+		v[i] = *timeToUnixNano(t[i])
+	}
+	return v
+}
+
 func daysToDuration(d *int32) *gogo.Duration {
 	if d == nil {
 		return nil
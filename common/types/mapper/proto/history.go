@@ -93,8 +93,9 @@ func FromHistoryDescribeMutableStateRequest(t *types.DescribeMutableStateRequest
 		return nil
 	}
 	return &historyv1.DescribeMutableStateRequest{
-		DomainId:          t.DomainUUID,
-		WorkflowExecution: FromWorkflowExecution(t.Execution),
+		DomainId:           t.DomainUUID,
+		WorkflowExecution:  FromWorkflowExecution(t.Execution),
+		RebuildFromHistory: t.RebuildFromHistory,
 	}
 }
 
@@ -103,8 +104,9 @@ func ToHistoryDescribeMutableStateRequest(t *historyv1.DescribeMutableStateReque
 		return nil
 	}
 	return &types.DescribeMutableStateRequest{
-		DomainUUID: t.DomainId,
-		Execution:  ToWorkflowExecution(t.WorkflowExecution),
+		DomainUUID:         t.DomainId,
+		Execution:          ToWorkflowExecution(t.WorkflowExecution),
+		RebuildFromHistory: t.RebuildFromHistory,
 	}
 }
 
@@ -113,8 +115,9 @@ func FromHistoryDescribeMutableStateResponse(t *types.DescribeMutableStateRespon
 		return nil
 	}
 	return &historyv1.DescribeMutableStateResponse{
-		MutableStateInCache:    t.MutableStateInCache,
-		MutableStateInDatabase: t.MutableStateInDatabase,
+		MutableStateInCache:            t.MutableStateInCache,
+		MutableStateInDatabase:         t.MutableStateInDatabase,
+		MutableStateRebuiltFromHistory: t.MutableStateRebuiltFromHistory,
 	}
 }
 
@@ -123,8 +126,9 @@ func ToHistoryDescribeMutableStateResponse(t *historyv1.DescribeMutableStateResp
 		return nil
 	}
 	return &types.DescribeMutableStateResponse{
-		MutableStateInCache:    t.MutableStateInCache,
-		MutableStateInDatabase: t.MutableStateInDatabase,
+		MutableStateInCache:            t.MutableStateInCache,
+		MutableStateInDatabase:         t.MutableStateInDatabase,
+		MutableStateRebuiltFromHistory: t.MutableStateRebuiltFromHistory,
 	}
 }
 
@@ -168,6 +172,42 @@ func ToHistoryDescribeQueueResponse(t *historyv1.DescribeQueueResponse) *types.D
 	}
 }
 
+func FromHistoryListThrottledWorkflowIDsRequest(t *types.ListThrottledWorkflowIDsRequest) *historyv1.ListThrottledWorkflowIDsRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ListThrottledWorkflowIDsRequest{
+		ShardId: t.ShardID,
+	}
+}
+
+func ToHistoryListThrottledWorkflowIDsRequest(t *historyv1.ListThrottledWorkflowIDsRequest) *types.ListThrottledWorkflowIDsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListThrottledWorkflowIDsRequest{
+		ShardID: t.ShardId,
+	}
+}
+
+func FromHistoryListThrottledWorkflowIDsResponse(t *types.ListThrottledWorkflowIDsResponse) *historyv1.ListThrottledWorkflowIDsResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ListThrottledWorkflowIDsResponse{
+		ThrottledWorkflowIds: FromThrottledWorkflowIDArray(t.ThrottledWorkflowIDs),
+	}
+}
+
+func ToHistoryListThrottledWorkflowIDsResponse(t *historyv1.ListThrottledWorkflowIDsResponse) *types.ListThrottledWorkflowIDsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListThrottledWorkflowIDsResponse{
+		ThrottledWorkflowIDs: ToThrottledWorkflowIDArray(t.ThrottledWorkflowIds),
+	}
+}
+
 func FromHistoryDescribeWorkflowExecutionRequest(t *types.HistoryDescribeWorkflowExecutionRequest) *historyv1.DescribeWorkflowExecutionRequest {
 	if t == nil {
 		return nil
@@ -454,6 +494,7 @@ func FromHistoryMergeDLQMessagesRequest(t *types.MergeDLQMessagesRequest) *histo
 		InclusiveEndMessageId: fromInt64Value(t.InclusiveEndMessageID),
 		PageSize:              t.MaximumPageSize,
 		NextPageToken:         t.NextPageToken,
+		Filter:                FromDLQFilter(t.Filter),
 	}
 }
 
@@ -468,6 +509,7 @@ func ToHistoryMergeDLQMessagesRequest(t *historyv1.MergeDLQMessagesRequest) *typ
 		InclusiveEndMessageID: toInt64Value(t.InclusiveEndMessageId),
 		MaximumPageSize:       t.PageSize,
 		NextPageToken:         t.NextPageToken,
+		Filter:                ToDLQFilter(t.Filter),
 	}
 }
 
@@ -507,6 +549,46 @@ func ToHistoryNotifyFailoverMarkersRequest(t *historyv1.NotifyFailoverMarkersReq
 	}
 }
 
+func FromHistoryPauseActivityRequest(t *types.HistoryPauseActivityRequest) *historyv1.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.PauseActivityRequest{
+		Request:  FromPauseActivityRequest(t.PauseRequest),
+		DomainId: t.DomainUUID,
+	}
+}
+
+func ToHistoryPauseActivityRequest(t *historyv1.PauseActivityRequest) *types.HistoryPauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseActivityRequest{
+		PauseRequest: ToPauseActivityRequest(t.Request),
+		DomainUUID:   t.DomainId,
+	}
+}
+
+func FromHistoryPauseWorkflowExecutionRequest(t *types.HistoryPauseWorkflowExecutionRequest) *historyv1.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.PauseWorkflowExecutionRequest{
+		Request:  FromPauseWorkflowExecutionRequest(t.PauseRequest),
+		DomainId: t.DomainUUID,
+	}
+}
+
+func ToHistoryPauseWorkflowExecutionRequest(t *historyv1.PauseWorkflowExecutionRequest) *types.HistoryPauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseWorkflowExecutionRequest{
+		PauseRequest: ToPauseWorkflowExecutionRequest(t.Request),
+		DomainUUID:   t.DomainId,
+	}
+}
+
 func FromHistoryPollMutableStateRequest(t *types.PollMutableStateRequest) *historyv1.PollMutableStateRequest {
 	if t == nil {
 		return nil
@@ -660,6 +742,7 @@ func FromHistoryReadDLQMessagesRequest(t *types.ReadDLQMessagesRequest) *history
 		InclusiveEndMessageId: fromInt64Value(t.InclusiveEndMessageID),
 		PageSize:              t.MaximumPageSize,
 		NextPageToken:         t.NextPageToken,
+		Filter:                FromDLQFilter(t.Filter),
 	}
 }
 
@@ -674,6 +757,7 @@ func ToHistoryReadDLQMessagesRequest(t *historyv1.ReadDLQMessagesRequest) *types
 		InclusiveEndMessageID: toInt64Value(t.InclusiveEndMessageId),
 		MaximumPageSize:       t.PageSize,
 		NextPageToken:         t.NextPageToken,
+		Filter:                ToDLQFilter(t.Filter),
 	}
 }
 
@@ -899,6 +983,7 @@ func FromHistoryRecordDecisionTaskStartedResponse(t *types.RecordDecisionTaskSta
 		StartedTime:               unixNanoToTime(t.StartedTimestamp),
 		Queries:                   FromWorkflowQueryMap(t.Queries),
 		HistorySize:               t.HistorySize,
+		ContinueAsNewSuggested:    t.ContinueAsNewSuggested,
 	}
 }
 
@@ -922,6 +1007,7 @@ func ToHistoryRecordDecisionTaskStartedResponse(t *historyv1.RecordDecisionTaskS
 		StartedTimestamp:          timeToUnixNano(t.StartedTime),
 		Queries:                   ToWorkflowQueryMap(t.Queries),
 		HistorySize:               t.HistorySize,
+		ContinueAsNewSuggested:    t.ContinueAsNewSuggested,
 	}
 }
 
@@ -1070,6 +1156,26 @@ func ToHistoryResetQueueRequest(t *historyv1.ResetQueueRequest) *types.ResetQueu
 	}
 }
 
+func FromHistoryResetActivityRequest(t *types.HistoryResetActivityRequest) *historyv1.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ResetActivityRequest{
+		Request:  FromResetActivityRequest(t.ResetRequest),
+		DomainId: t.DomainUUID,
+	}
+}
+
+func ToHistoryResetActivityRequest(t *historyv1.ResetActivityRequest) *types.HistoryResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryResetActivityRequest{
+		ResetRequest: ToResetActivityRequest(t.Request),
+		DomainUUID:   t.DomainId,
+	}
+}
+
 func FromHistoryResetStickyTaskListRequest(t *types.HistoryResetStickyTaskListRequest) *historyv1.ResetStickyTaskListRequest {
 	if t == nil {
 		return nil
@@ -1410,6 +1516,7 @@ func FromHistorySyncActivityRequest(t *types.SyncActivityRequest) *historyv1.Syn
 		LastFailure:        FromFailure(t.LastFailureReason, t.LastFailureDetails),
 		LastWorkerIdentity: t.LastWorkerIdentity,
 		VersionHistory:     FromVersionHistory(t.VersionHistory),
+		Paused:             t.Paused,
 	}
 }
 
@@ -1433,6 +1540,7 @@ func ToHistorySyncActivityRequest(t *historyv1.SyncActivityRequest) *types.SyncA
 		LastFailureDetails: ToFailureDetails(t.LastFailure),
 		LastWorkerIdentity: t.LastWorkerIdentity,
 		VersionHistory:     ToVersionHistory(t.VersionHistory),
+		Paused:             t.Paused,
 	}
 }
 
@@ -1482,6 +1590,66 @@ func ToHistoryTerminateWorkflowExecutionRequest(t *historyv1.TerminateWorkflowEx
 	}
 }
 
+func FromHistoryUnpauseActivityRequest(t *types.HistoryUnpauseActivityRequest) *historyv1.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UnpauseActivityRequest{
+		Request:  FromUnpauseActivityRequest(t.UnpauseRequest),
+		DomainId: t.DomainUUID,
+	}
+}
+
+func ToHistoryUnpauseActivityRequest(t *historyv1.UnpauseActivityRequest) *types.HistoryUnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseActivityRequest{
+		UnpauseRequest: ToUnpauseActivityRequest(t.Request),
+		DomainUUID:     t.DomainId,
+	}
+}
+
+func FromHistoryUnpauseWorkflowExecutionRequest(t *types.HistoryUnpauseWorkflowExecutionRequest) *historyv1.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UnpauseWorkflowExecutionRequest{
+		Request:  FromUnpauseWorkflowExecutionRequest(t.UnpauseRequest),
+		DomainId: t.DomainUUID,
+	}
+}
+
+func ToHistoryUnpauseWorkflowExecutionRequest(t *historyv1.UnpauseWorkflowExecutionRequest) *types.HistoryUnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseWorkflowExecutionRequest{
+		UnpauseRequest: ToUnpauseWorkflowExecutionRequest(t.Request),
+		DomainUUID:     t.DomainId,
+	}
+}
+
+func FromHistoryUpdateActivityOptionsRequest(t *types.HistoryUpdateActivityOptionsRequest) *historyv1.UpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UpdateActivityOptionsRequest{
+		Request:  FromUpdateActivityOptionsRequest(t.UpdateRequest),
+		DomainId: t.DomainUUID,
+	}
+}
+
+func ToHistoryUpdateActivityOptionsRequest(t *historyv1.UpdateActivityOptionsRequest) *types.HistoryUpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateActivityOptionsRequest{
+		UpdateRequest: ToUpdateActivityOptionsRequest(t.Request),
+		DomainUUID:    t.DomainId,
+	}
+}
+
 // FromHistoryGetCrossClusterTasksRequest converts internal GetCrossClusterTasksRequest type to proto
 func FromHistoryGetCrossClusterTasksRequest(t *types.GetCrossClusterTasksRequest) *historyv1.GetCrossClusterTasksRequest {
 	if t == nil {
@@ -66,6 +66,16 @@ func TestHistoryDescribeQueueResponse(t *testing.T) {
 		assert.Equal(t, item, ToHistoryDescribeQueueResponse(FromHistoryDescribeQueueResponse(item)))
 	}
 }
+func TestHistoryListThrottledWorkflowIDsRequest(t *testing.T) {
+	for _, item := range []*types.ListThrottledWorkflowIDsRequest{nil, {}, &testdata.HistoryListThrottledWorkflowIDsRequest} {
+		assert.Equal(t, item, ToHistoryListThrottledWorkflowIDsRequest(FromHistoryListThrottledWorkflowIDsRequest(item)))
+	}
+}
+func TestHistoryListThrottledWorkflowIDsResponse(t *testing.T) {
+	for _, item := range []*types.ListThrottledWorkflowIDsResponse{nil, {}, &testdata.HistoryListThrottledWorkflowIDsResponse} {
+		assert.Equal(t, item, ToHistoryListThrottledWorkflowIDsResponse(FromHistoryListThrottledWorkflowIDsResponse(item)))
+	}
+}
 func TestHistoryDescribeWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.HistoryDescribeWorkflowExecutionRequest{nil, {}, &testdata.HistoryDescribeWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToHistoryDescribeWorkflowExecutionRequest(FromHistoryDescribeWorkflowExecutionRequest(item)))
@@ -324,6 +334,40 @@ func TestHistorySyncShardStatusRequest(t *testing.T) {
 		assert.Equal(t, item, ToHistorySyncShardStatusRequest(FromHistorySyncShardStatusRequest(item)))
 	}
 }
+func TestHistoryPauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryPauseWorkflowExecutionRequest{nil, {}, &testdata.HistoryPauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryPauseWorkflowExecutionRequest(FromHistoryPauseWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestHistoryPauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.HistoryPauseActivityRequest{nil, {}, &testdata.HistoryPauseActivityRequest} {
+		assert.Equal(t, item, ToHistoryPauseActivityRequest(FromHistoryPauseActivityRequest(item)))
+	}
+}
+
+func TestHistoryUnpauseActivityRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUnpauseActivityRequest{nil, {}, &testdata.HistoryUnpauseActivityRequest} {
+		assert.Equal(t, item, ToHistoryUnpauseActivityRequest(FromHistoryUnpauseActivityRequest(item)))
+	}
+}
+
+func TestHistoryResetActivityRequest(t *testing.T) {
+	for _, item := range []*types.HistoryResetActivityRequest{nil, {}, &testdata.HistoryResetActivityRequest} {
+		assert.Equal(t, item, ToHistoryResetActivityRequest(FromHistoryResetActivityRequest(item)))
+	}
+}
+
+func TestHistoryUpdateActivityOptionsRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUpdateActivityOptionsRequest{nil, {}, &testdata.HistoryUpdateActivityOptionsRequest} {
+		assert.Equal(t, item, ToHistoryUpdateActivityOptionsRequest(FromHistoryUpdateActivityOptionsRequest(item)))
+	}
+}
+func TestHistoryUnpauseWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUnpauseWorkflowExecutionRequest{nil, {}, &testdata.HistoryUnpauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryUnpauseWorkflowExecutionRequest(FromHistoryUnpauseWorkflowExecutionRequest(item)))
+	}
+}
 func TestHistoryTerminateWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.HistoryTerminateWorkflowExecutionRequest{nil, {}, &testdata.HistoryTerminateWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToHistoryTerminateWorkflowExecutionRequest(FromHistoryTerminateWorkflowExecutionRequest(item)))
@@ -421,6 +421,7 @@ func FromMatchingPollForDecisionTaskResponse(t *types.MatchingPollForDecisionTas
 		StartedTime:               unixNanoToTime(t.StartedTimestamp),
 		Queries:                   FromWorkflowQueryMap(t.Queries),
 		TotalHistoryBytes:         t.TotalHistoryBytes,
+		ContinueAsNewSuggested:    t.ContinueAsNewSuggested,
 	}
 }
 
@@ -447,6 +448,7 @@ func ToMatchingPollForDecisionTaskResponse(t *matchingv1.PollForDecisionTaskResp
 		StartedTimestamp:          timeToUnixNano(t.StartedTime),
 		Queries:                   ToWorkflowQueryMap(t.Queries),
 		TotalHistoryBytes:         t.TotalHistoryBytes,
+		ContinueAsNewSuggested:    t.ContinueAsNewSuggested,
 	}
 }
 
@@ -486,6 +486,30 @@ func ToDLQType(t adminv1.DLQType) *types.DLQType {
 	panic("unexpected enum value")
 }
 
+func FromDLQFilter(t *types.DLQFilter) *adminv1.DLQFilter {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DLQFilter{
+		DomainId:   t.DomainID,
+		WorkflowId: t.WorkflowID,
+		TaskType:   FromReplicationTaskType(t.TaskType),
+		ErrorClass: t.ErrorClass,
+	}
+}
+
+func ToDLQFilter(t *adminv1.DLQFilter) *types.DLQFilter {
+	if t == nil {
+		return nil
+	}
+	return &types.DLQFilter{
+		DomainID:   t.DomainId,
+		WorkflowID: t.WorkflowId,
+		TaskType:   ToReplicationTaskType(t.TaskType),
+		ErrorClass: t.ErrorClass,
+	}
+}
+
 func FromDomainOperation(t *types.DomainOperation) adminv1.DomainOperation {
 	if t == nil {
 		return adminv1.DomainOperation_DOMAIN_OPERATION_INVALID
@@ -652,6 +676,7 @@ func FromReplicationTaskInfo(t *types.ReplicationTaskInfo) *adminv1.ReplicationT
 		FirstEventId:      t.FirstEventID,
 		NextEventId:       t.NextEventID,
 		ScheduledId:       t.ScheduledID,
+		ErrorClass:        t.ErrorClass,
 	}
 }
 
@@ -669,6 +694,7 @@ func ToReplicationTaskInfo(t *adminv1.ReplicationTaskInfo) *types.ReplicationTas
 		FirstEventID: t.FirstEventId,
 		NextEventID:  t.NextEventId,
 		ScheduledID:  t.ScheduledId,
+		ErrorClass:   t.ErrorClass,
 	}
 }
 
@@ -757,6 +783,7 @@ func FromSyncActivityTaskAttributes(t *types.SyncActivityTaskAttributes) *adminv
 		LastFailure:        FromFailure(t.LastFailureReason, t.LastFailureDetails),
 		LastWorkerIdentity: t.LastWorkerIdentity,
 		VersionHistory:     FromVersionHistory(t.VersionHistory),
+		Paused:             t.Paused,
 	}
 }
 
@@ -780,6 +807,7 @@ func ToSyncActivityTaskAttributes(t *adminv1.SyncActivityTaskAttributes) *types.
 		LastFailureDetails: ToFailureDetails(t.LastFailure),
 		LastWorkerIdentity: t.LastWorkerIdentity,
 		VersionHistory:     ToVersionHistory(t.VersionHistory),
+		Paused:             t.Paused,
 	}
 }
 
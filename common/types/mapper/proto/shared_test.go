@@ -117,6 +117,11 @@ func TestReplicationMessages(t *testing.T) {
 		assert.Equal(t, item, ToReplicationMessages(FromReplicationMessages(item)))
 	}
 }
+func TestDLQFilter(t *testing.T) {
+	for _, item := range []*types.DLQFilter{nil, {}, &testdata.DLQFilter} {
+		assert.Equal(t, item, ToDLQFilter(FromDLQFilter(item)))
+	}
+}
 func TestReplicationTaskInfo(t *testing.T) {
 	for _, item := range []*types.ReplicationTaskInfo{nil, {}, &testdata.ReplicationTaskInfo} {
 		assert.Equal(t, item, ToReplicationTaskInfo(FromReplicationTaskInfo(item)))
@@ -29,8 +29,16 @@ import (
 )
 
 var (
-	FromAdminRefreshWorkflowTasksRequest = FromRefreshWorkflowTasksRequest
-	ToAdminRefreshWorkflowTasksRequest   = ToRefreshWorkflowTasksRequest
+	FromAdminRefreshWorkflowTasksRequest  = FromRefreshWorkflowTasksRequest
+	ToAdminRefreshWorkflowTasksRequest    = ToRefreshWorkflowTasksRequest
+	FromAdminPauseActivityRequest         = FromPauseActivityRequest
+	ToAdminPauseActivityRequest           = ToPauseActivityRequest
+	FromAdminUnpauseActivityRequest       = FromUnpauseActivityRequest
+	ToAdminUnpauseActivityRequest         = ToUnpauseActivityRequest
+	FromAdminResetActivityRequest         = FromResetActivityRequest
+	ToAdminResetActivityRequest           = ToResetActivityRequest
+	FromAdminUpdateActivityOptionsRequest = FromUpdateActivityOptionsRequest
+	ToAdminUpdateActivityOptionsRequest   = ToUpdateActivityOptionsRequest
 )
 
 // FromAdminAddSearchAttributeRequest converts internal AddSearchAttributeRequest type to thrift
@@ -85,8 +93,9 @@ func FromAdminDescribeWorkflowExecutionRequest(t *types.AdminDescribeWorkflowExe
 		return nil
 	}
 	return &admin.DescribeWorkflowExecutionRequest{
-		Domain:    &t.Domain,
-		Execution: FromWorkflowExecution(t.Execution),
+		Domain:             &t.Domain,
+		Execution:          FromWorkflowExecution(t.Execution),
+		RebuildFromHistory: &t.RebuildFromHistory,
 	}
 }
 
@@ -96,8 +105,9 @@ func ToAdminDescribeWorkflowExecutionRequest(t *admin.DescribeWorkflowExecutionR
 		return nil
 	}
 	return &types.AdminDescribeWorkflowExecutionRequest{
-		Domain:    t.GetDomain(),
-		Execution: ToWorkflowExecution(t.Execution),
+		Domain:             t.GetDomain(),
+		Execution:          ToWorkflowExecution(t.Execution),
+		RebuildFromHistory: t.GetRebuildFromHistory(),
 	}
 }
 
@@ -107,10 +117,11 @@ func FromAdminDescribeWorkflowExecutionResponse(t *types.AdminDescribeWorkflowEx
 		return nil
 	}
 	return &admin.DescribeWorkflowExecutionResponse{
-		ShardId:                &t.ShardID,
-		HistoryAddr:            &t.HistoryAddr,
-		MutableStateInCache:    &t.MutableStateInCache,
-		MutableStateInDatabase: &t.MutableStateInDatabase,
+		ShardId:                        &t.ShardID,
+		HistoryAddr:                    &t.HistoryAddr,
+		MutableStateInCache:            &t.MutableStateInCache,
+		MutableStateInDatabase:         &t.MutableStateInDatabase,
+		MutableStateRebuiltFromHistory: &t.MutableStateRebuiltFromHistory,
 	}
 }
 
@@ -120,10 +131,11 @@ func ToAdminDescribeWorkflowExecutionResponse(t *admin.DescribeWorkflowExecution
 		return nil
 	}
 	return &types.AdminDescribeWorkflowExecutionResponse{
-		ShardID:                t.GetShardId(),
-		HistoryAddr:            t.GetHistoryAddr(),
-		MutableStateInCache:    t.GetMutableStateInCache(),
-		MutableStateInDatabase: t.GetMutableStateInDatabase(),
+		ShardID:                        t.GetShardId(),
+		HistoryAddr:                    t.GetHistoryAddr(),
+		MutableStateInCache:            t.GetMutableStateInCache(),
+		MutableStateInDatabase:         t.GetMutableStateInDatabase(),
+		MutableStateRebuiltFromHistory: t.GetMutableStateRebuiltFromHistory(),
 	}
 }
 
@@ -403,6 +415,32 @@ func ToAdminResendReplicationTasksRequest(t *admin.ResendReplicationTasksRequest
 	}
 }
 
+// FromAdminImportWorkflowExecutionRequest converts internal ImportWorkflowExecutionRequest type to thrift
+func FromAdminImportWorkflowExecutionRequest(t *types.ImportWorkflowExecutionRequest) *admin.ImportWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ImportWorkflowExecutionRequest{
+		Domain:              &t.Domain,
+		WorkflowExecution:   FromWorkflowExecution(t.WorkflowExecution),
+		VersionHistoryItems: FromVersionHistoryItemArray(t.VersionHistoryItems),
+		Events:              FromDataBlob(t.Events),
+	}
+}
+
+// ToAdminImportWorkflowExecutionRequest converts thrift ImportWorkflowExecutionRequest type to internal
+func ToAdminImportWorkflowExecutionRequest(t *admin.ImportWorkflowExecutionRequest) *types.ImportWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ImportWorkflowExecutionRequest{
+		Domain:              t.GetDomain(),
+		WorkflowExecution:   ToWorkflowExecution(t.WorkflowExecution),
+		VersionHistoryItems: ToVersionHistoryItemArray(t.VersionHistoryItems),
+		Events:              ToDataBlob(t.Events),
+	}
+}
+
 // FromRingInfo converts internal RingInfo type to thrift
 func FromRingInfo(t *types.RingInfo) *admin.RingInfo {
 	if t == nil {
@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/testdata"
 )
 
 func TestFromGetGlobalIsolationGroupsResponse(t *testing.T) {
@@ -754,3 +755,9 @@ func TestToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminImportWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.ImportWorkflowExecutionRequest{nil, {}, &testdata.AdminImportWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToAdminImportWorkflowExecutionRequest(FromAdminImportWorkflowExecutionRequest(item)))
+	}
+}
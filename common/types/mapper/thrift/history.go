@@ -36,6 +36,10 @@ var (
 	ToHistoryDescribeQueueRequest                        = ToAdminDescribeQueueRequest
 	FromHistoryDescribeQueueResponse                     = FromAdminDescribeQueueResponse
 	ToHistoryDescribeQueueResponse                       = ToAdminDescribeQueueResponse
+	FromHistoryListThrottledWorkflowIDsRequest           = FromAdminListThrottledWorkflowIDsRequest
+	ToHistoryListThrottledWorkflowIDsRequest             = ToAdminListThrottledWorkflowIDsRequest
+	FromHistoryListThrottledWorkflowIDsResponse          = FromAdminListThrottledWorkflowIDsResponse
+	ToHistoryListThrottledWorkflowIDsResponse            = ToAdminListThrottledWorkflowIDsResponse
 	FromHistoryDescribeWorkflowExecutionResponse         = FromDescribeWorkflowExecutionResponse
 	ToHistoryDescribeWorkflowExecutionResponse           = ToDescribeWorkflowExecutionResponse
 	FromHistoryGetCrossClusterTasksRequest               = FromAdminGetCrossClusterTasksRequest
@@ -98,8 +102,9 @@ func FromHistoryDescribeMutableStateRequest(t *types.DescribeMutableStateRequest
 		return nil
 	}
 	return &history.DescribeMutableStateRequest{
-		DomainUUID: &t.DomainUUID,
-		Execution:  FromWorkflowExecution(t.Execution),
+		DomainUUID:         &t.DomainUUID,
+		Execution:          FromWorkflowExecution(t.Execution),
+		RebuildFromHistory: &t.RebuildFromHistory,
 	}
 }
 
@@ -109,8 +114,9 @@ func ToHistoryDescribeMutableStateRequest(t *history.DescribeMutableStateRequest
 		return nil
 	}
 	return &types.DescribeMutableStateRequest{
-		DomainUUID: t.GetDomainUUID(),
-		Execution:  ToWorkflowExecution(t.Execution),
+		DomainUUID:         t.GetDomainUUID(),
+		Execution:          ToWorkflowExecution(t.Execution),
+		RebuildFromHistory: t.GetRebuildFromHistory(),
 	}
 }
 
@@ -120,8 +126,9 @@ func FromHistoryDescribeMutableStateResponse(t *types.DescribeMutableStateRespon
 		return nil
 	}
 	return &history.DescribeMutableStateResponse{
-		MutableStateInCache:    &t.MutableStateInCache,
-		MutableStateInDatabase: &t.MutableStateInDatabase,
+		MutableStateInCache:            &t.MutableStateInCache,
+		MutableStateInDatabase:         &t.MutableStateInDatabase,
+		MutableStateRebuiltFromHistory: &t.MutableStateRebuiltFromHistory,
 	}
 }
 
@@ -131,8 +138,9 @@ func ToHistoryDescribeMutableStateResponse(t *history.DescribeMutableStateRespon
 		return nil
 	}
 	return &types.DescribeMutableStateResponse{
-		MutableStateInCache:    t.GetMutableStateInCache(),
-		MutableStateInDatabase: t.GetMutableStateInDatabase(),
+		MutableStateInCache:            t.GetMutableStateInCache(),
+		MutableStateInDatabase:         t.GetMutableStateInDatabase(),
+		MutableStateRebuiltFromHistory: t.GetMutableStateRebuiltFromHistory(),
 	}
 }
 
@@ -350,6 +358,50 @@ func ToParentExecutionInfo(t *history.ParentExecutionInfo) *types.ParentExecutio
 	}
 }
 
+// FromHistoryPauseActivityRequest converts internal PauseActivityRequest type to thrift
+func FromHistoryPauseActivityRequest(t *types.HistoryPauseActivityRequest) *history.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.PauseActivityRequest{
+		DomainUUID:   &t.DomainUUID,
+		PauseRequest: FromPauseActivityRequest(t.PauseRequest),
+	}
+}
+
+// ToHistoryPauseActivityRequest converts thrift PauseActivityRequest type to internal
+func ToHistoryPauseActivityRequest(t *history.PauseActivityRequest) *types.HistoryPauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseActivityRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		PauseRequest: ToPauseActivityRequest(t.PauseRequest),
+	}
+}
+
+// FromHistoryPauseWorkflowExecutionRequest converts internal PauseWorkflowExecutionRequest type to thrift
+func FromHistoryPauseWorkflowExecutionRequest(t *types.HistoryPauseWorkflowExecutionRequest) *history.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.PauseWorkflowExecutionRequest{
+		DomainUUID:   &t.DomainUUID,
+		PauseRequest: FromPauseWorkflowExecutionRequest(t.PauseRequest),
+	}
+}
+
+// ToHistoryPauseWorkflowExecutionRequest converts thrift PauseWorkflowExecutionRequest type to internal
+func ToHistoryPauseWorkflowExecutionRequest(t *history.PauseWorkflowExecutionRequest) *types.HistoryPauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		PauseRequest: ToPauseWorkflowExecutionRequest(t.PauseRequest),
+	}
+}
+
 // FromHistoryPollMutableStateRequest converts internal PollMutableStateRequest type to thrift
 func FromHistoryPollMutableStateRequest(t *types.PollMutableStateRequest) *history.PollMutableStateRequest {
 	if t == nil {
@@ -699,6 +751,7 @@ func FromRecordDecisionTaskStartedResponse(t *types.RecordDecisionTaskStartedRes
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   FromWorkflowQueryMap(t.Queries),
 		HistorySize:               &t.HistorySize,
+		ContinueAsNewSuggested:    &t.ContinueAsNewSuggested,
 	}
 }
 
@@ -723,6 +776,7 @@ func ToRecordDecisionTaskStartedResponse(t *history.RecordDecisionTaskStartedRes
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   ToWorkflowQueryMap(t.Queries),
 		HistorySize:               t.GetHistorySize(),
+		ContinueAsNewSuggested:    t.GetContinueAsNewSuggested(),
 	}
 }
 
@@ -828,6 +882,28 @@ func ToHistoryRequestCancelWorkflowExecutionRequest(t *history.RequestCancelWork
 	}
 }
 
+// FromHistoryResetActivityRequest converts internal ResetActivityRequest type to thrift
+func FromHistoryResetActivityRequest(t *types.HistoryResetActivityRequest) *history.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.ResetActivityRequest{
+		DomainUUID:   &t.DomainUUID,
+		ResetRequest: FromResetActivityRequest(t.ResetRequest),
+	}
+}
+
+// ToHistoryResetActivityRequest converts thrift ResetActivityRequest type to internal
+func ToHistoryResetActivityRequest(t *history.ResetActivityRequest) *types.HistoryResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryResetActivityRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		ResetRequest: ToResetActivityRequest(t.ResetRequest),
+	}
+}
+
 // FromHistoryResetStickyTaskListRequest converts internal ResetStickyTaskListRequest type to thrift
 func FromHistoryResetStickyTaskListRequest(t *types.HistoryResetStickyTaskListRequest) *history.ResetStickyTaskListRequest {
 	if t == nil {
@@ -1177,6 +1253,7 @@ func FromHistorySyncActivityRequest(t *types.SyncActivityRequest) *history.SyncA
 		LastWorkerIdentity: &t.LastWorkerIdentity,
 		LastFailureDetails: t.LastFailureDetails,
 		VersionHistory:     FromVersionHistory(t.VersionHistory),
+		Paused:             &t.Paused,
 	}
 }
 
@@ -1201,6 +1278,7 @@ func ToHistorySyncActivityRequest(t *history.SyncActivityRequest) *types.SyncAct
 		LastWorkerIdentity: t.GetLastWorkerIdentity(),
 		LastFailureDetails: t.LastFailureDetails,
 		VersionHistory:     ToVersionHistory(t.VersionHistory),
+		Paused:             t.GetPaused(),
 	}
 }
 
@@ -1254,6 +1332,72 @@ func ToHistoryTerminateWorkflowExecutionRequest(t *history.TerminateWorkflowExec
 	}
 }
 
+// FromHistoryUnpauseActivityRequest converts internal UnpauseActivityRequest type to thrift
+func FromHistoryUnpauseActivityRequest(t *types.HistoryUnpauseActivityRequest) *history.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UnpauseActivityRequest{
+		DomainUUID:     &t.DomainUUID,
+		UnpauseRequest: FromUnpauseActivityRequest(t.UnpauseRequest),
+	}
+}
+
+// ToHistoryUnpauseActivityRequest converts thrift UnpauseActivityRequest type to internal
+func ToHistoryUnpauseActivityRequest(t *history.UnpauseActivityRequest) *types.HistoryUnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseActivityRequest{
+		DomainUUID:     t.GetDomainUUID(),
+		UnpauseRequest: ToUnpauseActivityRequest(t.UnpauseRequest),
+	}
+}
+
+// FromHistoryUnpauseWorkflowExecutionRequest converts internal UnpauseWorkflowExecutionRequest type to thrift
+func FromHistoryUnpauseWorkflowExecutionRequest(t *types.HistoryUnpauseWorkflowExecutionRequest) *history.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UnpauseWorkflowExecutionRequest{
+		DomainUUID:     &t.DomainUUID,
+		UnpauseRequest: FromUnpauseWorkflowExecutionRequest(t.UnpauseRequest),
+	}
+}
+
+// ToHistoryUnpauseWorkflowExecutionRequest converts thrift UnpauseWorkflowExecutionRequest type to internal
+func ToHistoryUnpauseWorkflowExecutionRequest(t *history.UnpauseWorkflowExecutionRequest) *types.HistoryUnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     t.GetDomainUUID(),
+		UnpauseRequest: ToUnpauseWorkflowExecutionRequest(t.UnpauseRequest),
+	}
+}
+
+// FromHistoryUpdateActivityOptionsRequest converts internal UpdateActivityOptionsRequest type to thrift
+func FromHistoryUpdateActivityOptionsRequest(t *types.HistoryUpdateActivityOptionsRequest) *history.UpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UpdateActivityOptionsRequest{
+		DomainUUID:    &t.DomainUUID,
+		UpdateRequest: FromUpdateActivityOptionsRequest(t.UpdateRequest),
+	}
+}
+
+// ToHistoryUpdateActivityOptionsRequest converts thrift UpdateActivityOptionsRequest type to internal
+func ToHistoryUpdateActivityOptionsRequest(t *history.UpdateActivityOptionsRequest) *types.HistoryUpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID:    t.GetDomainUUID(),
+		UpdateRequest: ToUpdateActivityOptionsRequest(t.UpdateRequest),
+	}
+}
+
 // FromFailoverMarkerTokenArray converts internal FailoverMarkerToken type array to thrift
 func FromFailoverMarkerTokenArray(t []*types.FailoverMarkerToken) []*history.FailoverMarkerToken {
 	if t == nil {
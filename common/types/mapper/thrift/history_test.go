@@ -34,6 +34,42 @@ import (
 	"github.com/uber/cadence/common/types/testdata"
 )
 
+func TestHistoryPauseWorkflowExecutionRequestConversion(t *testing.T) {
+	for _, item := range []*types.HistoryPauseWorkflowExecutionRequest{nil, {}, &testdata.HistoryPauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryPauseWorkflowExecutionRequest(FromHistoryPauseWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestHistoryUnpauseWorkflowExecutionRequestConversion(t *testing.T) {
+	for _, item := range []*types.HistoryUnpauseWorkflowExecutionRequest{nil, {}, &testdata.HistoryUnpauseWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistoryUnpauseWorkflowExecutionRequest(FromHistoryUnpauseWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestHistoryPauseActivityRequestConversion(t *testing.T) {
+	for _, item := range []*types.HistoryPauseActivityRequest{nil, {}, &testdata.HistoryPauseActivityRequest} {
+		assert.Equal(t, item, ToHistoryPauseActivityRequest(FromHistoryPauseActivityRequest(item)))
+	}
+}
+
+func TestHistoryUnpauseActivityRequestConversion(t *testing.T) {
+	for _, item := range []*types.HistoryUnpauseActivityRequest{nil, {}, &testdata.HistoryUnpauseActivityRequest} {
+		assert.Equal(t, item, ToHistoryUnpauseActivityRequest(FromHistoryUnpauseActivityRequest(item)))
+	}
+}
+
+func TestHistoryResetActivityRequestConversion(t *testing.T) {
+	for _, item := range []*types.HistoryResetActivityRequest{nil, {}, &testdata.HistoryResetActivityRequest} {
+		assert.Equal(t, item, ToHistoryResetActivityRequest(FromHistoryResetActivityRequest(item)))
+	}
+}
+
+func TestHistoryUpdateActivityOptionsRequestConversion(t *testing.T) {
+	for _, item := range []*types.HistoryUpdateActivityOptionsRequest{nil, {}, &testdata.HistoryUpdateActivityOptionsRequest} {
+		assert.Equal(t, item, ToHistoryUpdateActivityOptionsRequest(FromHistoryUpdateActivityOptionsRequest(item)))
+	}
+}
+
 func TestHistoryTerminateWorkflowExecutionRequestConversion(t *testing.T) {
 	for _, item := range []*types.HistoryTerminateWorkflowExecutionRequest{nil, {}, &testdata.HistoryTerminateWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToHistoryTerminateWorkflowExecutionRequest(FromHistoryTerminateWorkflowExecutionRequest(item)))
@@ -294,6 +294,7 @@ func FromMatchingPollForDecisionTaskResponse(t *types.MatchingPollForDecisionTas
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   FromWorkflowQueryMap(t.Queries),
 		TotalHistoryBytes:         &t.TotalHistoryBytes,
+		ContinueAsNewSuggested:    &t.ContinueAsNewSuggested,
 	}
 }
 
@@ -321,6 +322,7 @@ func ToMatchingPollForDecisionTaskResponse(t *matching.PollForDecisionTaskRespon
 		StartedTimestamp:          t.StartedTimestamp,
 		Queries:                   ToWorkflowQueryMap(t.Queries),
 		TotalHistoryBytes:         t.GetTotalHistoryBytes(),
+		ContinueAsNewSuggested:    t.GetContinueAsNewSuggested(),
 	}
 }
 
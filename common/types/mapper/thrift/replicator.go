@@ -57,6 +57,32 @@ func ToDLQType(t *replicator.DLQType) *types.DLQType {
 	panic("unexpected enum value")
 }
 
+// FromDLQFilter converts internal DLQFilter type to thrift
+func FromDLQFilter(t *types.DLQFilter) *replicator.DLQFilter {
+	if t == nil {
+		return nil
+	}
+	return &replicator.DLQFilter{
+		DomainID:   &t.DomainID,
+		WorkflowID: &t.WorkflowID,
+		TaskType:   FromReplicationTaskType(t.TaskType),
+		ErrorClass: &t.ErrorClass,
+	}
+}
+
+// ToDLQFilter converts thrift DLQFilter type to internal
+func ToDLQFilter(t *replicator.DLQFilter) *types.DLQFilter {
+	if t == nil {
+		return nil
+	}
+	return &types.DLQFilter{
+		DomainID:   t.GetDomainID(),
+		WorkflowID: t.GetWorkflowID(),
+		TaskType:   ToReplicationTaskType(t.TaskType),
+		ErrorClass: t.GetErrorClass(),
+	}
+}
+
 // FromDomainOperation converts internal DomainOperation type to thrift
 func FromDomainOperation(t *types.DomainOperation) *replicator.DomainOperation {
 	if t == nil {
@@ -335,6 +361,7 @@ func FromAdminMergeDLQMessagesRequest(t *types.MergeDLQMessagesRequest) *replica
 		InclusiveEndMessageID: t.InclusiveEndMessageID,
 		MaximumPageSize:       &t.MaximumPageSize,
 		NextPageToken:         t.NextPageToken,
+		Filter:                FromDLQFilter(t.Filter),
 	}
 }
 
@@ -350,6 +377,7 @@ func ToAdminMergeDLQMessagesRequest(t *replicator.MergeDLQMessagesRequest) *type
 		InclusiveEndMessageID: t.InclusiveEndMessageID,
 		MaximumPageSize:       t.GetMaximumPageSize(),
 		NextPageToken:         t.NextPageToken,
+		Filter:                ToDLQFilter(t.Filter),
 	}
 }
 
@@ -411,6 +439,7 @@ func FromAdminReadDLQMessagesRequest(t *types.ReadDLQMessagesRequest) *replicato
 		InclusiveEndMessageID: t.InclusiveEndMessageID,
 		MaximumPageSize:       &t.MaximumPageSize,
 		NextPageToken:         t.NextPageToken,
+		Filter:                FromDLQFilter(t.Filter),
 	}
 }
 
@@ -426,6 +455,7 @@ func ToAdminReadDLQMessagesRequest(t *replicator.ReadDLQMessagesRequest) *types.
 		InclusiveEndMessageID: t.InclusiveEndMessageID,
 		MaximumPageSize:       t.GetMaximumPageSize(),
 		NextPageToken:         t.NextPageToken,
+		Filter:                ToDLQFilter(t.Filter),
 	}
 }
 
@@ -530,6 +560,7 @@ func FromReplicationTaskInfo(t *types.ReplicationTaskInfo) *replicator.Replicati
 		FirstEventID: &t.FirstEventID,
 		NextEventID:  &t.NextEventID,
 		ScheduledID:  &t.ScheduledID,
+		ErrorClass:   &t.ErrorClass,
 	}
 }
 
@@ -548,6 +579,7 @@ func ToReplicationTaskInfo(t *replicator.ReplicationTaskInfo) *types.Replication
 		FirstEventID: t.GetFirstEventID(),
 		NextEventID:  t.GetNextEventID(),
 		ScheduledID:  t.GetScheduledID(),
+		ErrorClass:   t.GetErrorClass(),
 	}
 }
 
@@ -658,6 +690,7 @@ func FromSyncActivityTaskAttributes(t *types.SyncActivityTaskAttributes) *replic
 		LastWorkerIdentity: &t.LastWorkerIdentity,
 		LastFailureDetails: t.LastFailureDetails,
 		VersionHistory:     FromVersionHistory(t.VersionHistory),
+		Paused:             &t.Paused,
 	}
 }
 
@@ -682,6 +715,7 @@ func ToSyncActivityTaskAttributes(t *replicator.SyncActivityTaskAttributes) *typ
 		LastWorkerIdentity: t.GetLastWorkerIdentity(),
 		LastFailureDetails: t.LastFailureDetails,
 		VersionHistory:     ToVersionHistory(t.VersionHistory),
+		Paused:             t.GetPaused(),
 	}
 }
 
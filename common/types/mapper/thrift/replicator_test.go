@@ -52,6 +52,12 @@ func TestDLQType(t *testing.T) {
 	}
 }
 
+func TestDLQFilter(t *testing.T) {
+	for _, item := range []*types.DLQFilter{nil, {}, &testdata.DLQFilter} {
+		assert.Equal(t, item, ToDLQFilter(FromDLQFilter(item)))
+	}
+}
+
 func TestDomainOperation(t *testing.T) {
 	testCases := []struct {
 		desc  string
@@ -1726,6 +1726,198 @@ func ToAdminDescribeQueueResponse(t *shared.DescribeQueueResponse) *types.Descri
 	}
 }
 
+// FromAdminListThrottledWorkflowIDsRequest converts internal ListThrottledWorkflowIDsRequest type to thrift
+func FromAdminListThrottledWorkflowIDsRequest(t *types.ListThrottledWorkflowIDsRequest) *shared.ListThrottledWorkflowIDsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListThrottledWorkflowIDsRequest{
+		ShardID: &t.ShardID,
+	}
+}
+
+// ToAdminListThrottledWorkflowIDsRequest converts thrift ListThrottledWorkflowIDsRequest type to internal
+func ToAdminListThrottledWorkflowIDsRequest(t *shared.ListThrottledWorkflowIDsRequest) *types.ListThrottledWorkflowIDsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListThrottledWorkflowIDsRequest{
+		ShardID: t.GetShardID(),
+	}
+}
+
+// FromAdminListThrottledWorkflowIDsResponse converts internal ListThrottledWorkflowIDsResponse type to thrift
+func FromAdminListThrottledWorkflowIDsResponse(t *types.ListThrottledWorkflowIDsResponse) *shared.ListThrottledWorkflowIDsResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListThrottledWorkflowIDsResponse{
+		ThrottledWorkflowIDs: FromThrottledWorkflowIDArray(t.ThrottledWorkflowIDs),
+	}
+}
+
+// ToAdminListThrottledWorkflowIDsResponse converts thrift ListThrottledWorkflowIDsResponse type to internal
+func ToAdminListThrottledWorkflowIDsResponse(t *shared.ListThrottledWorkflowIDsResponse) *types.ListThrottledWorkflowIDsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListThrottledWorkflowIDsResponse{
+		ThrottledWorkflowIDs: ToThrottledWorkflowIDArray(t.ThrottledWorkflowIDs),
+	}
+}
+
+// FromThrottledWorkflowID converts internal ThrottledWorkflowID type to thrift
+func FromThrottledWorkflowID(t *types.ThrottledWorkflowID) *shared.ThrottledWorkflowID {
+	if t == nil {
+		return nil
+	}
+	return &shared.ThrottledWorkflowID{
+		DomainName:             &t.DomainName,
+		WorkflowID:             &t.WorkflowID,
+		Limit:                  &t.Limit,
+		Count:                  &t.Count,
+		LastThrottledTimestamp: t.LastThrottledTimestamp,
+	}
+}
+
+// ToThrottledWorkflowID converts thrift ThrottledWorkflowID type to internal
+func ToThrottledWorkflowID(t *shared.ThrottledWorkflowID) *types.ThrottledWorkflowID {
+	if t == nil {
+		return nil
+	}
+	return &types.ThrottledWorkflowID{
+		DomainName:             t.GetDomainName(),
+		WorkflowID:             t.GetWorkflowID(),
+		Limit:                  t.GetLimit(),
+		Count:                  t.GetCount(),
+		LastThrottledTimestamp: t.LastThrottledTimestamp,
+	}
+}
+
+// FromThrottledWorkflowIDArray converts internal ThrottledWorkflowID type array to thrift
+func FromThrottledWorkflowIDArray(t []*types.ThrottledWorkflowID) []*shared.ThrottledWorkflowID {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ThrottledWorkflowID, len(t))
+	for i := range t {
+		v[i] = FromThrottledWorkflowID(t[i])
+	}
+	return v
+}
+
+// ToThrottledWorkflowIDArray converts thrift ThrottledWorkflowID type array to internal
+func ToThrottledWorkflowIDArray(t []*shared.ThrottledWorkflowID) []*types.ThrottledWorkflowID {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ThrottledWorkflowID, len(t))
+	for i := range t {
+		v[i] = ToThrottledWorkflowID(t[i])
+	}
+	return v
+}
+
+// FromAdminGetDomainUsageRequest converts internal GetDomainUsageRequest type to thrift
+func FromAdminGetDomainUsageRequest(t *types.GetDomainUsageRequest) *shared.GetDomainUsageRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetDomainUsageRequest{
+		Domain:         &t.Domain,
+		StartTimestamp: t.StartTimestamp,
+		EndTimestamp:   t.EndTimestamp,
+	}
+}
+
+// ToAdminGetDomainUsageRequest converts thrift GetDomainUsageRequest type to internal
+func ToAdminGetDomainUsageRequest(t *shared.GetDomainUsageRequest) *types.GetDomainUsageRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDomainUsageRequest{
+		Domain:         t.GetDomain(),
+		StartTimestamp: t.StartTimestamp,
+		EndTimestamp:   t.EndTimestamp,
+	}
+}
+
+// FromAdminGetDomainUsageResponse converts internal GetDomainUsageResponse type to thrift
+func FromAdminGetDomainUsageResponse(t *types.GetDomainUsageResponse) *shared.GetDomainUsageResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetDomainUsageResponse{
+		Usage: FromWorkflowUsageArray(t.Usage),
+	}
+}
+
+// ToAdminGetDomainUsageResponse converts thrift GetDomainUsageResponse type to internal
+func ToAdminGetDomainUsageResponse(t *shared.GetDomainUsageResponse) *types.GetDomainUsageResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDomainUsageResponse{
+		Usage: ToWorkflowUsageArray(t.Usage),
+	}
+}
+
+// FromWorkflowUsage converts internal WorkflowUsage type to thrift
+func FromWorkflowUsage(t *types.WorkflowUsage) *shared.WorkflowUsage {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowUsage{
+		WorkflowType:    &t.WorkflowType,
+		DecisionTasks:   &t.DecisionTasks,
+		ActivityTasks:   &t.ActivityTasks,
+		LocalActivities: &t.LocalActivities,
+		Signals:         &t.Signals,
+		Timers:          &t.Timers,
+		HistoryBytes:    &t.HistoryBytes,
+	}
+}
+
+// ToWorkflowUsage converts thrift WorkflowUsage type to internal
+func ToWorkflowUsage(t *shared.WorkflowUsage) *types.WorkflowUsage {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowUsage{
+		WorkflowType:    t.GetWorkflowType(),
+		DecisionTasks:   t.GetDecisionTasks(),
+		ActivityTasks:   t.GetActivityTasks(),
+		LocalActivities: t.GetLocalActivities(),
+		Signals:         t.GetSignals(),
+		Timers:          t.GetTimers(),
+		HistoryBytes:    t.GetHistoryBytes(),
+	}
+}
+
+// FromWorkflowUsageArray converts internal WorkflowUsage type array to thrift
+func FromWorkflowUsageArray(t []*types.WorkflowUsage) []*shared.WorkflowUsage {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowUsage, len(t))
+	for i := range t {
+		v[i] = FromWorkflowUsage(t[i])
+	}
+	return v
+}
+
+// ToWorkflowUsageArray converts thrift WorkflowUsage type array to internal
+func ToWorkflowUsageArray(t []*shared.WorkflowUsage) []*types.WorkflowUsage {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkflowUsage, len(t))
+	for i := range t {
+		v[i] = ToWorkflowUsage(t[i])
+	}
+	return v
+}
+
 // FromDescribeTaskListRequest converts internal DescribeTaskListRequest type to thrift
 func FromDescribeTaskListRequest(t *types.DescribeTaskListRequest) *shared.DescribeTaskListRequest {
 	if t == nil {
@@ -2254,6 +2446,12 @@ func FromEventType(t *types.EventType) *shared.EventType {
 	case types.EventTypeUpsertWorkflowSearchAttributes:
 		v := shared.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case types.EventTypeWorkflowExecutionPaused:
+		v := shared.EventTypeWorkflowExecutionPaused
+		return &v
+	case types.EventTypeWorkflowExecutionUnpaused:
+		v := shared.EventTypeWorkflowExecutionUnpaused
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2390,6 +2588,12 @@ func ToEventType(t *shared.EventType) *types.EventType {
 	case shared.EventTypeUpsertWorkflowSearchAttributes:
 		v := types.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case shared.EventTypeWorkflowExecutionPaused:
+		v := types.EventTypeWorkflowExecutionPaused
+		return &v
+	case shared.EventTypeWorkflowExecutionUnpaused:
+		v := types.EventTypeWorkflowExecutionUnpaused
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2685,6 +2889,8 @@ func FromHistoryEvent(t *types.HistoryEvent) *shared.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           FromSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               FromExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  FromUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionPausedEventAttributes:                         FromWorkflowExecutionPausedEventAttributes(t.WorkflowExecutionPausedEventAttributes),
+		WorkflowExecutionUnpausedEventAttributes:                       FromWorkflowExecutionUnpausedEventAttributes(t.WorkflowExecutionUnpausedEventAttributes),
 	}
 }
 
@@ -2741,6 +2947,8 @@ func ToHistoryEvent(t *shared.HistoryEvent) *types.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           ToSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               ToExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  ToUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionPausedEventAttributes:                         ToWorkflowExecutionPausedEventAttributes(t.WorkflowExecutionPausedEventAttributes),
+		WorkflowExecutionUnpausedEventAttributes:                       ToWorkflowExecutionUnpausedEventAttributes(t.WorkflowExecutionUnpausedEventAttributes),
 	}
 }
 
@@ -3318,6 +3526,60 @@ func ToParentClosePolicy(t *shared.ParentClosePolicy) *types.ParentClosePolicy {
 	panic("unexpected enum value")
 }
 
+// FromPauseActivityRequest converts internal PauseActivityRequest type to thrift
+func FromPauseActivityRequest(t *types.PauseActivityRequest) *shared.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseActivityRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        &t.ActivityID,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToPauseActivityRequest converts thrift PauseActivityRequest type to internal
+func ToPauseActivityRequest(t *shared.PauseActivityRequest) *types.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseActivityRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityID(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromPauseWorkflowExecutionRequest converts internal PauseWorkflowExecutionRequest type to thrift
+func FromPauseWorkflowExecutionRequest(t *types.PauseWorkflowExecutionRequest) *shared.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            &t.Reason,
+		Identity:          &t.Identity,
+		RequestId:         &t.RequestID,
+	}
+}
+
+// ToPauseWorkflowExecutionRequest converts thrift PauseWorkflowExecutionRequest type to internal
+func ToPauseWorkflowExecutionRequest(t *shared.PauseWorkflowExecutionRequest) *types.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+		RequestID:         t.GetRequestId(),
+	}
+}
+
 // FromPendingActivityInfo converts internal PendingActivityInfo type to thrift
 func FromPendingActivityInfo(t *types.PendingActivityInfo) *shared.PendingActivityInfo {
 	if t == nil {
@@ -3338,6 +3600,7 @@ func FromPendingActivityInfo(t *types.PendingActivityInfo) *shared.PendingActivi
 		LastWorkerIdentity:     &t.LastWorkerIdentity,
 		LastFailureDetails:     t.LastFailureDetails,
 		StartedWorkerIdentity:  &t.StartedWorkerIdentity,
+		Paused:                 &t.Paused,
 	}
 }
 
@@ -3361,6 +3624,7 @@ func ToPendingActivityInfo(t *shared.PendingActivityInfo) *types.PendingActivity
 		LastWorkerIdentity:     t.GetLastWorkerIdentity(),
 		LastFailureDetails:     t.LastFailureDetails,
 		StartedWorkerIdentity:  t.GetStartedWorkerIdentity(),
+		Paused:                 t.GetPaused(),
 	}
 }
 
@@ -3616,6 +3880,7 @@ func FromPollForDecisionTaskResponse(t *types.PollForDecisionTaskResponse) *shar
 		Queries:                   FromWorkflowQueryMap(t.Queries),
 		NextEventId:               &t.NextEventID,
 		TotalHistoryBytes:         &t.TotalHistoryBytes,
+		ContinueAsNewSuggested:    &t.ContinueAsNewSuggested,
 	}
 }
 
@@ -3641,6 +3906,7 @@ func ToPollForDecisionTaskResponse(t *shared.PollForDecisionTaskResponse) *types
 		Queries:                   ToWorkflowQueryMap(t.Queries),
 		NextEventID:               t.GetNextEventId(),
 		TotalHistoryBytes:         t.GetTotalHistoryBytes(),
+		ContinueAsNewSuggested:    t.GetContinueAsNewSuggested(),
 	}
 }
 
@@ -4284,6 +4550,32 @@ func ToRequestCancelWorkflowExecutionRequest(t *shared.RequestCancelWorkflowExec
 	}
 }
 
+// FromResetActivityRequest converts internal ResetActivityRequest type to thrift
+func FromResetActivityRequest(t *types.ResetActivityRequest) *shared.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ResetActivityRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        &t.ActivityID,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToResetActivityRequest converts thrift ResetActivityRequest type to internal
+func ToResetActivityRequest(t *shared.ResetActivityRequest) *types.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetActivityRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityID(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
 // FromResetPointInfo converts internal ResetPointInfo type to thrift
 func FromResetPointInfo(t *types.ResetPointInfo) *shared.ResetPointInfo {
 	if t == nil {
@@ -5314,6 +5606,58 @@ func ToSignalWithStartWorkflowExecutionAsyncResponse(t *shared.SignalWithStartWo
 	return &types.SignalWithStartWorkflowExecutionAsyncResponse{}
 }
 
+// FromExecuteWorkflowExecutionRequest converts internal ExecuteWorkflowExecutionRequest type to thrift
+func FromExecuteWorkflowExecutionRequest(t *types.ExecuteWorkflowExecutionRequest) *shared.ExecuteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ExecuteWorkflowExecutionRequest{
+		StartRequest:    FromStartWorkflowExecutionRequest(t.StartRequest),
+		AttachToRunning: &t.AttachToRunning,
+	}
+}
+
+// ToExecuteWorkflowExecutionRequest converts thrift ExecuteWorkflowExecutionRequest type to internal
+func ToExecuteWorkflowExecutionRequest(t *shared.ExecuteWorkflowExecutionRequest) *types.ExecuteWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ExecuteWorkflowExecutionRequest{
+		StartRequest:    ToStartWorkflowExecutionRequest(t.StartRequest),
+		AttachToRunning: t.GetAttachToRunning(),
+	}
+}
+
+// FromExecuteWorkflowExecutionResponse converts internal ExecuteWorkflowExecutionResponse type to thrift
+func FromExecuteWorkflowExecutionResponse(t *types.ExecuteWorkflowExecutionResponse) *shared.ExecuteWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ExecuteWorkflowExecutionResponse{
+		RunId:          &t.RunID,
+		Attached:       &t.Attached,
+		CloseStatus:    FromWorkflowExecutionCloseStatus(t.CloseStatus),
+		Result:         t.Result,
+		FailureReason:  t.FailureReason,
+		FailureDetails: t.FailureDetails,
+	}
+}
+
+// ToExecuteWorkflowExecutionResponse converts thrift ExecuteWorkflowExecutionResponse type to internal
+func ToExecuteWorkflowExecutionResponse(t *shared.ExecuteWorkflowExecutionResponse) *types.ExecuteWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ExecuteWorkflowExecutionResponse{
+		RunID:          t.GetRunId(),
+		Attached:       t.GetAttached(),
+		CloseStatus:    ToWorkflowExecutionCloseStatus(t.CloseStatus),
+		Result:         t.Result,
+		FailureReason:  t.FailureReason,
+		FailureDetails: t.FailureDetails,
+	}
+}
+
 func FromStartWorkflowExecutionAsyncRequest(t *types.StartWorkflowExecutionAsyncRequest) *shared.StartWorkflowExecutionAsyncRequest {
 	if t == nil {
 		return nil
@@ -5828,6 +6172,96 @@ func ToTransientDecisionInfo(t *shared.TransientDecisionInfo) *types.TransientDe
 	}
 }
 
+// FromUnpauseActivityRequest converts internal UnpauseActivityRequest type to thrift
+func FromUnpauseActivityRequest(t *types.UnpauseActivityRequest) *shared.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UnpauseActivityRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        &t.ActivityID,
+		Identity:          &t.Identity,
+	}
+}
+
+// ToUnpauseActivityRequest converts thrift UnpauseActivityRequest type to internal
+func ToUnpauseActivityRequest(t *shared.UnpauseActivityRequest) *types.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseActivityRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.GetActivityID(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromUnpauseWorkflowExecutionRequest converts internal UnpauseWorkflowExecutionRequest type to thrift
+func FromUnpauseWorkflowExecutionRequest(t *types.UnpauseWorkflowExecutionRequest) *shared.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UnpauseWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            &t.Reason,
+		Identity:          &t.Identity,
+		RequestId:         &t.RequestID,
+	}
+}
+
+// ToUnpauseWorkflowExecutionRequest converts thrift UnpauseWorkflowExecutionRequest type to internal
+func ToUnpauseWorkflowExecutionRequest(t *shared.UnpauseWorkflowExecutionRequest) *types.UnpauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+		RequestID:         t.GetRequestId(),
+	}
+}
+
+// FromUpdateActivityOptionsRequest converts internal UpdateActivityOptionsRequest type to thrift
+func FromUpdateActivityOptionsRequest(t *types.UpdateActivityOptionsRequest) *shared.UpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateActivityOptionsRequest{
+		Domain:                        &t.Domain,
+		WorkflowExecution:             FromWorkflowExecution(t.WorkflowExecution),
+		ActivityID:                    &t.ActivityID,
+		ScheduleToCloseTimeoutSeconds: t.ScheduleToCloseTimeoutSeconds,
+		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
+		StartToCloseTimeoutSeconds:    t.StartToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:       t.HeartbeatTimeoutSeconds,
+		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
+		Identity:                      &t.Identity,
+	}
+}
+
+// ToUpdateActivityOptionsRequest converts thrift UpdateActivityOptionsRequest type to internal
+func ToUpdateActivityOptionsRequest(t *shared.UpdateActivityOptionsRequest) *types.UpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateActivityOptionsRequest{
+		Domain:                        t.GetDomain(),
+		WorkflowExecution:             ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:                    t.GetActivityID(),
+		ScheduleToCloseTimeoutSeconds: t.ScheduleToCloseTimeoutSeconds,
+		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
+		StartToCloseTimeoutSeconds:    t.StartToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:       t.HeartbeatTimeoutSeconds,
+		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
+		Identity:                      t.GetIdentity(),
+	}
+}
+
 // FromUpdateDomainRequest converts internal UpdateDomainRequest type to thrift
 func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *shared.UpdateDomainRequest {
 	if t == nil {
@@ -5838,6 +6272,7 @@ func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *shared.UpdateDomainR
 		SecurityToken:            &t.SecurityToken,
 		DeleteBadBinary:          t.DeleteBadBinary,
 		FailoverTimeoutInSeconds: t.FailoverTimeoutInSeconds,
+		IsGlobalDomain:           t.IsGlobalDomain,
 	}
 	if t.Description != nil || t.OwnerEmail != nil || t.Data != nil {
 		request.UpdatedInfo = &shared.UpdateDomainInfo{
@@ -5882,6 +6317,7 @@ func ToUpdateDomainRequest(t *shared.UpdateDomainRequest) *types.UpdateDomainReq
 		SecurityToken:            t.GetSecurityToken(),
 		DeleteBadBinary:          t.DeleteBadBinary,
 		FailoverTimeoutInSeconds: t.FailoverTimeoutInSeconds,
+		IsGlobalDomain:           t.IsGlobalDomain,
 	}
 	if t.UpdatedInfo != nil {
 		request.Description = t.UpdatedInfo.Description
@@ -6378,6 +6814,7 @@ func FromWorkflowExecutionInfo(t *types.WorkflowExecutionInfo) *shared.WorkflowE
 		IsCron:           &t.IsCron,
 		UpdateTime:       t.UpdateTime,
 		PartitionConfig:  t.PartitionConfig,
+		IsPaused:         &t.IsPaused,
 	}
 }
 
@@ -6405,6 +6842,7 @@ func ToWorkflowExecutionInfo(t *shared.WorkflowExecutionInfo) *types.WorkflowExe
 		IsCron:            t.GetIsCron(),
 		UpdateTime:        t.UpdateTime,
 		PartitionConfig:   t.PartitionConfig,
+		IsPaused:          t.GetIsPaused(),
 	}
 }
 
@@ -6510,6 +6948,30 @@ func ToWorkflowExecutionStartedEventAttributes(t *shared.WorkflowExecutionStarte
 	}
 }
 
+// FromWorkflowExecutionPausedEventAttributes converts internal WorkflowExecutionPausedEventAttributes type to thrift
+func FromWorkflowExecutionPausedEventAttributes(t *types.WorkflowExecutionPausedEventAttributes) *shared.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionPausedEventAttributes{
+		Reason:    &t.Reason,
+		Identity:  &t.Identity,
+		RequestId: &t.RequestID,
+	}
+}
+
+// ToWorkflowExecutionPausedEventAttributes converts thrift WorkflowExecutionPausedEventAttributes type to internal
+func ToWorkflowExecutionPausedEventAttributes(t *shared.WorkflowExecutionPausedEventAttributes) *types.WorkflowExecutionPausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionPausedEventAttributes{
+		Reason:    t.GetReason(),
+		Identity:  t.GetIdentity(),
+		RequestID: t.GetRequestId(),
+	}
+}
+
 // FromWorkflowExecutionTerminatedEventAttributes converts internal WorkflowExecutionTerminatedEventAttributes type to thrift
 func FromWorkflowExecutionTerminatedEventAttributes(t *types.WorkflowExecutionTerminatedEventAttributes) *shared.WorkflowExecutionTerminatedEventAttributes {
 	if t == nil {
@@ -6554,6 +7016,30 @@ func ToWorkflowExecutionTimedOutEventAttributes(t *shared.WorkflowExecutionTimed
 	}
 }
 
+// FromWorkflowExecutionUnpausedEventAttributes converts internal WorkflowExecutionUnpausedEventAttributes type to thrift
+func FromWorkflowExecutionUnpausedEventAttributes(t *types.WorkflowExecutionUnpausedEventAttributes) *shared.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionUnpausedEventAttributes{
+		Reason:    &t.Reason,
+		Identity:  &t.Identity,
+		RequestId: &t.RequestID,
+	}
+}
+
+// ToWorkflowExecutionUnpausedEventAttributes converts thrift WorkflowExecutionUnpausedEventAttributes type to internal
+func ToWorkflowExecutionUnpausedEventAttributes(t *shared.WorkflowExecutionUnpausedEventAttributes) *types.WorkflowExecutionUnpausedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:    t.GetReason(),
+		Identity:  t.GetIdentity(),
+		RequestID: t.GetRequestId(),
+	}
+}
+
 // FromWorkflowIDReusePolicy converts internal WorkflowIDReusePolicy type to thrift
 func FromWorkflowIDReusePolicy(t *types.WorkflowIDReusePolicy) *shared.WorkflowIdReusePolicy {
 	if t == nil {
@@ -8072,3 +8558,611 @@ func FromAny(t *types.Any) *shared.Any {
 		Value:     t.Value,
 	}
 }
+
+// FromScheduleOverlapPolicy converts internal ScheduleOverlapPolicy type to thrift
+func FromScheduleOverlapPolicy(t *types.ScheduleOverlapPolicy) *shared.ScheduleOverlapPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.ScheduleOverlapPolicySkip:
+		v := shared.ScheduleOverlapPolicySkip
+		return &v
+	case types.ScheduleOverlapPolicyBuffer:
+		v := shared.ScheduleOverlapPolicyBuffer
+		return &v
+	case types.ScheduleOverlapPolicyCancelOther:
+		v := shared.ScheduleOverlapPolicyCancelOther
+		return &v
+	case types.ScheduleOverlapPolicyAllowAll:
+		v := shared.ScheduleOverlapPolicyAllowAll
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToScheduleOverlapPolicy converts thrift ScheduleOverlapPolicy type to internal
+func ToScheduleOverlapPolicy(t *shared.ScheduleOverlapPolicy) *types.ScheduleOverlapPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.ScheduleOverlapPolicySkip:
+		v := types.ScheduleOverlapPolicySkip
+		return &v
+	case shared.ScheduleOverlapPolicyBuffer:
+		v := types.ScheduleOverlapPolicyBuffer
+		return &v
+	case shared.ScheduleOverlapPolicyCancelOther:
+		v := types.ScheduleOverlapPolicyCancelOther
+		return &v
+	case shared.ScheduleOverlapPolicyAllowAll:
+		v := types.ScheduleOverlapPolicyAllowAll
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// FromScheduleCalendarSpec converts internal ScheduleCalendarSpec type to thrift
+func FromScheduleCalendarSpec(t *types.ScheduleCalendarSpec) *shared.ScheduleCalendarSpec {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleCalendarSpec{
+		Second:     &t.Second,
+		Minute:     &t.Minute,
+		Hour:       &t.Hour,
+		DayOfMonth: &t.DayOfMonth,
+		Month:      &t.Month,
+		DayOfWeek:  &t.DayOfWeek,
+	}
+}
+
+// ToScheduleCalendarSpec converts thrift ScheduleCalendarSpec type to internal
+func ToScheduleCalendarSpec(t *shared.ScheduleCalendarSpec) *types.ScheduleCalendarSpec {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleCalendarSpec{
+		Second:     t.GetSecond(),
+		Minute:     t.GetMinute(),
+		Hour:       t.GetHour(),
+		DayOfMonth: t.GetDayOfMonth(),
+		Month:      t.GetMonth(),
+		DayOfWeek:  t.GetDayOfWeek(),
+	}
+}
+
+// FromScheduleSpec converts internal ScheduleSpec type to thrift
+func FromScheduleSpec(t *types.ScheduleSpec) *shared.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleSpec{
+		CronExpression:    &t.CronExpression,
+		Calendars:         FromScheduleCalendarSpecArray(t.Calendars),
+		IntervalInSeconds: t.IntervalInSeconds,
+		StartTimestamp:    t.StartTimestamp,
+		EndTimestamp:      t.EndTimestamp,
+		JitterInSeconds:   t.JitterInSeconds,
+	}
+}
+
+// ToScheduleSpec converts thrift ScheduleSpec type to internal
+func ToScheduleSpec(t *shared.ScheduleSpec) *types.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleSpec{
+		CronExpression:    t.GetCronExpression(),
+		Calendars:         ToScheduleCalendarSpecArray(t.Calendars),
+		IntervalInSeconds: t.IntervalInSeconds,
+		StartTimestamp:    t.StartTimestamp,
+		EndTimestamp:      t.EndTimestamp,
+		JitterInSeconds:   t.JitterInSeconds,
+	}
+}
+
+// FromScheduleStartWorkflowAction converts internal ScheduleStartWorkflowAction type to thrift
+func FromScheduleStartWorkflowAction(t *types.ScheduleStartWorkflowAction) *shared.ScheduleStartWorkflowAction {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleStartWorkflowAction{
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		WorkflowIdPrefix:                    &t.WorkflowIDPrefix,
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      t.TaskStartToCloseTimeoutSeconds,
+	}
+}
+
+// ToScheduleStartWorkflowAction converts thrift ScheduleStartWorkflowAction type to internal
+func ToScheduleStartWorkflowAction(t *shared.ScheduleStartWorkflowAction) *types.ScheduleStartWorkflowAction {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleStartWorkflowAction{
+		WorkflowType:                        ToWorkflowType(t.WorkflowType),
+		TaskList:                            ToTaskList(t.TaskList),
+		WorkflowIDPrefix:                    t.GetWorkflowIdPrefix(),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      t.TaskStartToCloseTimeoutSeconds,
+	}
+}
+
+// FromSchedulePolicies converts internal SchedulePolicies type to thrift
+func FromSchedulePolicies(t *types.SchedulePolicies) *shared.SchedulePolicies {
+	if t == nil {
+		return nil
+	}
+	return &shared.SchedulePolicies{
+		OverlapPolicy:          FromScheduleOverlapPolicy(t.OverlapPolicy),
+		CatchUpWindowInSeconds: t.CatchUpWindowInSeconds,
+		BufferLimit:            &t.BufferLimit,
+	}
+}
+
+// ToSchedulePolicies converts thrift SchedulePolicies type to internal
+func ToSchedulePolicies(t *shared.SchedulePolicies) *types.SchedulePolicies {
+	if t == nil {
+		return nil
+	}
+	return &types.SchedulePolicies{
+		OverlapPolicy:          ToScheduleOverlapPolicy(t.OverlapPolicy),
+		CatchUpWindowInSeconds: t.CatchUpWindowInSeconds,
+		BufferLimit:            t.GetBufferLimit(),
+	}
+}
+
+// FromScheduleRun converts internal ScheduleRun type to thrift
+func FromScheduleRun(t *types.ScheduleRun) *shared.ScheduleRun {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleRun{
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		NominalTimestamp:  t.NominalTimestamp,
+		ActualTimestamp:   t.ActualTimestamp,
+	}
+}
+
+// ToScheduleRun converts thrift ScheduleRun type to internal
+func ToScheduleRun(t *shared.ScheduleRun) *types.ScheduleRun {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleRun{
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		NominalTimestamp:  t.NominalTimestamp,
+		ActualTimestamp:   t.ActualTimestamp,
+	}
+}
+
+// FromScheduleState converts internal ScheduleState type to thrift
+func FromScheduleState(t *types.ScheduleState) *shared.ScheduleState {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleState{
+		Paused:                 &t.Paused,
+		Note:                   &t.Note,
+		LastProcessedTimestamp: t.LastProcessedTimestamp,
+		RunningRuns:            FromScheduleRunArray(t.RunningRuns),
+		LastRun:                FromScheduleRun(t.LastRun),
+		BufferedRuns:           &t.BufferedRuns,
+		PendingBackfills:       &t.PendingBackfills,
+		TotalRuns:              &t.TotalRuns,
+		SkippedRuns:            &t.SkippedRuns,
+		MissedRuns:             &t.MissedRuns,
+		FailedStarts:           &t.FailedStarts,
+		BufferOverruns:         &t.BufferOverruns,
+	}
+}
+
+// ToScheduleState converts thrift ScheduleState type to internal
+func ToScheduleState(t *shared.ScheduleState) *types.ScheduleState {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleState{
+		Paused:                 t.GetPaused(),
+		Note:                   t.GetNote(),
+		LastProcessedTimestamp: t.LastProcessedTimestamp,
+		RunningRuns:            ToScheduleRunArray(t.RunningRuns),
+		LastRun:                ToScheduleRun(t.LastRun),
+		BufferedRuns:           t.GetBufferedRuns(),
+		PendingBackfills:       t.GetPendingBackfills(),
+		TotalRuns:              t.GetTotalRuns(),
+		SkippedRuns:            t.GetSkippedRuns(),
+		MissedRuns:             t.GetMissedRuns(),
+		FailedStarts:           t.GetFailedStarts(),
+		BufferOverruns:         t.GetBufferOverruns(),
+	}
+}
+
+// FromScheduleListEntry converts internal ScheduleListEntry type to thrift
+func FromScheduleListEntry(t *types.ScheduleListEntry) *shared.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleListEntry{
+		ScheduleId:        &t.ScheduleID,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+// ToScheduleListEntry converts thrift ScheduleListEntry type to internal
+func ToScheduleListEntry(t *shared.ScheduleListEntry) *types.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleListEntry{
+		ScheduleID:        t.GetScheduleId(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+// FromCreateScheduleRequest converts internal CreateScheduleRequest type to thrift
+func FromCreateScheduleRequest(t *types.CreateScheduleRequest) *shared.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.CreateScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Spec:       FromScheduleSpec(t.Spec),
+		Action:     FromScheduleStartWorkflowAction(t.Action),
+		Policies:   FromSchedulePolicies(t.Policies),
+		Identity:   &t.Identity,
+		RequestId:  &t.RequestID,
+	}
+}
+
+// ToCreateScheduleRequest converts thrift CreateScheduleRequest type to internal
+func ToCreateScheduleRequest(t *shared.CreateScheduleRequest) *types.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CreateScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Spec:       ToScheduleSpec(t.Spec),
+		Action:     ToScheduleStartWorkflowAction(t.Action),
+		Policies:   ToSchedulePolicies(t.Policies),
+		Identity:   t.GetIdentity(),
+		RequestID:  t.GetRequestId(),
+	}
+}
+
+// FromDescribeScheduleRequest converts internal DescribeScheduleRequest type to thrift
+func FromDescribeScheduleRequest(t *types.DescribeScheduleRequest) *shared.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+	}
+}
+
+// ToDescribeScheduleRequest converts thrift DescribeScheduleRequest type to internal
+func ToDescribeScheduleRequest(t *shared.DescribeScheduleRequest) *types.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+	}
+}
+
+// FromDescribeScheduleResponse converts internal DescribeScheduleResponse type to thrift
+func FromDescribeScheduleResponse(t *types.DescribeScheduleResponse) *shared.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeScheduleResponse{
+		Spec:                  FromScheduleSpec(t.Spec),
+		Action:                FromScheduleStartWorkflowAction(t.Action),
+		Policies:              FromSchedulePolicies(t.Policies),
+		State:                 FromScheduleState(t.State),
+		UpcomingRunTimestamps: t.UpcomingRunTimestamps,
+	}
+}
+
+// ToDescribeScheduleResponse converts thrift DescribeScheduleResponse type to internal
+func ToDescribeScheduleResponse(t *shared.DescribeScheduleResponse) *types.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleResponse{
+		Spec:                  ToScheduleSpec(t.Spec),
+		Action:                ToScheduleStartWorkflowAction(t.Action),
+		Policies:              ToSchedulePolicies(t.Policies),
+		State:                 ToScheduleState(t.State),
+		UpcomingRunTimestamps: t.UpcomingRunTimestamps,
+	}
+}
+
+// FromUpdateScheduleRequest converts internal UpdateScheduleRequest type to thrift
+func FromUpdateScheduleRequest(t *types.UpdateScheduleRequest) *shared.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Spec:       FromScheduleSpec(t.Spec),
+		Action:     FromScheduleStartWorkflowAction(t.Action),
+		Policies:   FromSchedulePolicies(t.Policies),
+		Identity:   &t.Identity,
+	}
+}
+
+// ToUpdateScheduleRequest converts thrift UpdateScheduleRequest type to internal
+func ToUpdateScheduleRequest(t *shared.UpdateScheduleRequest) *types.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Spec:       ToScheduleSpec(t.Spec),
+		Action:     ToScheduleStartWorkflowAction(t.Action),
+		Policies:   ToSchedulePolicies(t.Policies),
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// FromPauseScheduleRequest converts internal PauseScheduleRequest type to thrift
+func FromPauseScheduleRequest(t *types.PauseScheduleRequest) *shared.PauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Reason:     &t.Reason,
+		Identity:   &t.Identity,
+	}
+}
+
+// ToPauseScheduleRequest converts thrift PauseScheduleRequest type to internal
+func ToPauseScheduleRequest(t *shared.PauseScheduleRequest) *types.PauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Reason:     t.GetReason(),
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// FromUnpauseScheduleRequest converts internal UnpauseScheduleRequest type to thrift
+func FromUnpauseScheduleRequest(t *types.UnpauseScheduleRequest) *shared.UnpauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UnpauseScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Reason:     &t.Reason,
+		Identity:   &t.Identity,
+	}
+}
+
+// ToUnpauseScheduleRequest converts thrift UnpauseScheduleRequest type to internal
+func ToUnpauseScheduleRequest(t *shared.UnpauseScheduleRequest) *types.UnpauseScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Reason:     t.GetReason(),
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// FromTriggerScheduleRequest converts internal TriggerScheduleRequest type to thrift
+func FromTriggerScheduleRequest(t *types.TriggerScheduleRequest) *shared.TriggerScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.TriggerScheduleRequest{
+		Domain:        &t.Domain,
+		ScheduleId:    &t.ScheduleID,
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:      &t.Identity,
+	}
+}
+
+// ToTriggerScheduleRequest converts thrift TriggerScheduleRequest type to internal
+func ToTriggerScheduleRequest(t *shared.TriggerScheduleRequest) *types.TriggerScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.TriggerScheduleRequest{
+		Domain:        t.GetDomain(),
+		ScheduleID:    t.GetScheduleId(),
+		OverlapPolicy: ToScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:      t.GetIdentity(),
+	}
+}
+
+// FromBackfillScheduleRequest converts internal BackfillScheduleRequest type to thrift
+func FromBackfillScheduleRequest(t *types.BackfillScheduleRequest) *shared.BackfillScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.BackfillScheduleRequest{
+		Domain:         &t.Domain,
+		ScheduleId:     &t.ScheduleID,
+		StartTimestamp: t.StartTimestamp,
+		EndTimestamp:   t.EndTimestamp,
+		OverlapPolicy:  FromScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:       &t.Identity,
+	}
+}
+
+// ToBackfillScheduleRequest converts thrift BackfillScheduleRequest type to internal
+func ToBackfillScheduleRequest(t *shared.BackfillScheduleRequest) *types.BackfillScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.BackfillScheduleRequest{
+		Domain:         t.GetDomain(),
+		ScheduleID:     t.GetScheduleId(),
+		StartTimestamp: t.StartTimestamp,
+		EndTimestamp:   t.EndTimestamp,
+		OverlapPolicy:  ToScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:       t.GetIdentity(),
+	}
+}
+
+// FromDeleteScheduleRequest converts internal DeleteScheduleRequest type to thrift
+func FromDeleteScheduleRequest(t *types.DeleteScheduleRequest) *shared.DeleteScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DeleteScheduleRequest{
+		Domain:     &t.Domain,
+		ScheduleId: &t.ScheduleID,
+		Identity:   &t.Identity,
+	}
+}
+
+// ToDeleteScheduleRequest converts thrift DeleteScheduleRequest type to internal
+func ToDeleteScheduleRequest(t *shared.DeleteScheduleRequest) *types.DeleteScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteScheduleRequest{
+		Domain:     t.GetDomain(),
+		ScheduleID: t.GetScheduleId(),
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// FromListSchedulesRequest converts internal ListSchedulesRequest type to thrift
+func FromListSchedulesRequest(t *types.ListSchedulesRequest) *shared.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListSchedulesRequest{
+		Domain:        &t.Domain,
+		PageSize:      &t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListSchedulesRequest converts thrift ListSchedulesRequest type to internal
+func ToListSchedulesRequest(t *shared.ListSchedulesRequest) *types.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesRequest{
+		Domain:        t.GetDomain(),
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromListSchedulesResponse converts internal ListSchedulesResponse type to thrift
+func FromListSchedulesResponse(t *types.ListSchedulesResponse) *shared.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListSchedulesResponse{
+		Schedules:     FromScheduleListEntryArray(t.Schedules),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListSchedulesResponse converts thrift ListSchedulesResponse type to internal
+func ToListSchedulesResponse(t *shared.ListSchedulesResponse) *types.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesResponse{
+		Schedules:     ToScheduleListEntryArray(t.Schedules),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromScheduleCalendarSpecArray converts internal ScheduleCalendarSpec type array to thrift
+func FromScheduleCalendarSpecArray(t []*types.ScheduleCalendarSpec) []*shared.ScheduleCalendarSpec {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ScheduleCalendarSpec, len(t))
+	for i := range t {
+		v[i] = FromScheduleCalendarSpec(t[i])
+	}
+	return v
+}
+
+// ToScheduleCalendarSpecArray converts thrift ScheduleCalendarSpec type array to internal
+func ToScheduleCalendarSpecArray(t []*shared.ScheduleCalendarSpec) []*types.ScheduleCalendarSpec {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ScheduleCalendarSpec, len(t))
+	for i := range t {
+		v[i] = ToScheduleCalendarSpec(t[i])
+	}
+	return v
+}
+
+// FromScheduleListEntryArray converts internal ScheduleListEntry type array to thrift
+func FromScheduleListEntryArray(t []*types.ScheduleListEntry) []*shared.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ScheduleListEntry, len(t))
+	for i := range t {
+		v[i] = FromScheduleListEntry(t[i])
+	}
+	return v
+}
+
+// ToScheduleListEntryArray converts thrift ScheduleListEntry type array to internal
+func ToScheduleListEntryArray(t []*shared.ScheduleListEntry) []*types.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ScheduleListEntry, len(t))
+	for i := range t {
+		v[i] = ToScheduleListEntry(t[i])
+	}
+	return v
+}
+
+// FromScheduleRunArray converts internal ScheduleRun type array to thrift
+func FromScheduleRunArray(t []*types.ScheduleRun) []*shared.ScheduleRun {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ScheduleRun, len(t))
+	for i := range t {
+		v[i] = FromScheduleRun(t[i])
+	}
+	return v
+}
+
+// ToScheduleRunArray converts thrift ScheduleRun type array to internal
+func ToScheduleRunArray(t []*shared.ScheduleRun) []*types.ScheduleRun {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ScheduleRun, len(t))
+	for i := range t {
+		v[i] = ToScheduleRun(t[i])
+	}
+	return v
+}
@@ -1082,6 +1082,62 @@ func TestDescribeQueueResponseConversion(t *testing.T) {
 	}
 }
 
+func TestListThrottledWorkflowIDsRequestConversion(t *testing.T) {
+	testCases := []*types.ListThrottledWorkflowIDsRequest{
+		nil,
+		{},
+		&testdata.AdminListThrottledWorkflowIDsRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromAdminListThrottledWorkflowIDsRequest(original)
+		roundTripObj := ToAdminListThrottledWorkflowIDsRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestListThrottledWorkflowIDsResponseConversion(t *testing.T) {
+	testCases := []*types.ListThrottledWorkflowIDsResponse{
+		nil,
+		{},
+		&testdata.AdminListThrottledWorkflowIDsResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromAdminListThrottledWorkflowIDsResponse(original)
+		roundTripObj := ToAdminListThrottledWorkflowIDsResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetDomainUsageRequestConversion(t *testing.T) {
+	testCases := []*types.GetDomainUsageRequest{
+		nil,
+		{},
+		&testdata.AdminGetDomainUsageRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromAdminGetDomainUsageRequest(original)
+		roundTripObj := ToAdminGetDomainUsageRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetDomainUsageResponseConversion(t *testing.T) {
+	testCases := []*types.GetDomainUsageResponse{
+		nil,
+		{},
+		&testdata.AdminGetDomainUsageResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromAdminGetDomainUsageResponse(original)
+		roundTripObj := ToAdminGetDomainUsageResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestDescribeTaskListRequestConversion(t *testing.T) {
 	testCases := []*types.DescribeTaskListRequest{
 		nil,
@@ -1337,6 +1393,8 @@ func TestEventTypeConversion(t *testing.T) {
 		types.EventTypeSignalExternalWorkflowExecutionFailed.Ptr(),
 		types.EventTypeExternalWorkflowExecutionSignaled.Ptr(),
 		types.EventTypeUpsertWorkflowSearchAttributes.Ptr(),
+		types.EventTypeWorkflowExecutionPaused.Ptr(),
+		types.EventTypeWorkflowExecutionUnpaused.Ptr(),
 	}
 
 	for _, original := range testCases {
@@ -2754,6 +2812,34 @@ func TestStartWorkflowExecutionRequestConversion(t *testing.T) {
 	}
 }
 
+func TestExecuteWorkflowExecutionRequestConversion(t *testing.T) {
+	testCases := []*types.ExecuteWorkflowExecutionRequest{
+		nil,
+		{},
+		&testdata.ExecuteWorkflowExecutionRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromExecuteWorkflowExecutionRequest(original)
+		roundTripObj := ToExecuteWorkflowExecutionRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestExecuteWorkflowExecutionResponseConversion(t *testing.T) {
+	testCases := []*types.ExecuteWorkflowExecutionResponse{
+		nil,
+		{},
+		&testdata.ExecuteWorkflowExecutionResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromExecuteWorkflowExecutionResponse(original)
+		roundTripObj := ToExecuteWorkflowExecutionResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestStartWorkflowExecutionAsyncRequestConversion(t *testing.T) {
 	testCases := []*types.StartWorkflowExecutionAsyncRequest{
 		nil,
@@ -3081,6 +3167,118 @@ func TestUpsertWorkflowSearchAttributesEventAttributesConversion(t *testing.T) {
 	}
 }
 
+func TestPauseWorkflowExecutionRequestConversion(t *testing.T) {
+	testCases := []*types.PauseWorkflowExecutionRequest{
+		nil,
+		{},
+		&testdata.PauseWorkflowExecutionRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromPauseWorkflowExecutionRequest(original)
+		roundTripObj := ToPauseWorkflowExecutionRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUnpauseWorkflowExecutionRequestConversion(t *testing.T) {
+	testCases := []*types.UnpauseWorkflowExecutionRequest{
+		nil,
+		{},
+		&testdata.UnpauseWorkflowExecutionRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUnpauseWorkflowExecutionRequest(original)
+		roundTripObj := ToUnpauseWorkflowExecutionRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestPauseActivityRequestConversion(t *testing.T) {
+	testCases := []*types.PauseActivityRequest{
+		nil,
+		{},
+		&testdata.PauseActivityRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromPauseActivityRequest(original)
+		roundTripObj := ToPauseActivityRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUnpauseActivityRequestConversion(t *testing.T) {
+	testCases := []*types.UnpauseActivityRequest{
+		nil,
+		{},
+		&testdata.UnpauseActivityRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUnpauseActivityRequest(original)
+		roundTripObj := ToUnpauseActivityRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestResetActivityRequestConversion(t *testing.T) {
+	testCases := []*types.ResetActivityRequest{
+		nil,
+		{},
+		&testdata.ResetActivityRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromResetActivityRequest(original)
+		roundTripObj := ToResetActivityRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUpdateActivityOptionsRequestConversion(t *testing.T) {
+	testCases := []*types.UpdateActivityOptionsRequest{
+		nil,
+		{},
+		&testdata.UpdateActivityOptionsRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUpdateActivityOptionsRequest(original)
+		roundTripObj := ToUpdateActivityOptionsRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestWorkflowExecutionPausedEventAttributesConversion(t *testing.T) {
+	testCases := []*types.WorkflowExecutionPausedEventAttributes{
+		nil,
+		{},
+		&testdata.WorkflowExecutionPausedEventAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromWorkflowExecutionPausedEventAttributes(original)
+		roundTripObj := ToWorkflowExecutionPausedEventAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestWorkflowExecutionUnpausedEventAttributesConversion(t *testing.T) {
+	testCases := []*types.WorkflowExecutionUnpausedEventAttributes{
+		nil,
+		{},
+		&testdata.WorkflowExecutionUnpausedEventAttributes,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromWorkflowExecutionUnpausedEventAttributes(original)
+		roundTripObj := ToWorkflowExecutionUnpausedEventAttributes(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestVersionHistoriesConversion(t *testing.T) {
 	testCases := []*types.VersionHistories{
 		nil,
@@ -3481,3 +3679,229 @@ type thriftObject interface {
 	FromWire(w wire.Value) error
 	ToWire() (wire.Value, error)
 }
+
+func TestScheduleOverlapPolicyConversion(t *testing.T) {
+	testCases := []*types.ScheduleOverlapPolicy{
+		nil,
+		types.ScheduleOverlapPolicySkip.Ptr(),
+		types.ScheduleOverlapPolicyBuffer.Ptr(),
+		types.ScheduleOverlapPolicyCancelOther.Ptr(),
+		types.ScheduleOverlapPolicyAllowAll.Ptr(),
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromScheduleOverlapPolicy(original)
+		roundTripObj := ToScheduleOverlapPolicy(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestScheduleSpecConversion(t *testing.T) {
+	testCases := []*types.ScheduleSpec{
+		nil,
+		{},
+		&testdata.ScheduleSpec,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromScheduleSpec(original)
+		roundTripObj := ToScheduleSpec(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestScheduleStartWorkflowActionConversion(t *testing.T) {
+	testCases := []*types.ScheduleStartWorkflowAction{
+		nil,
+		{},
+		&testdata.ScheduleStartWorkflowAction,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromScheduleStartWorkflowAction(original)
+		roundTripObj := ToScheduleStartWorkflowAction(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestSchedulePoliciesConversion(t *testing.T) {
+	testCases := []*types.SchedulePolicies{
+		nil,
+		{},
+		&testdata.SchedulePolicies,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromSchedulePolicies(original)
+		roundTripObj := ToSchedulePolicies(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestScheduleRunConversion(t *testing.T) {
+	testCases := []*types.ScheduleRun{
+		nil,
+		{},
+		&testdata.ScheduleRun,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromScheduleRun(original)
+		roundTripObj := ToScheduleRun(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestCreateScheduleRequestConversion(t *testing.T) {
+	testCases := []*types.CreateScheduleRequest{
+		nil,
+		{},
+		&testdata.CreateScheduleRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromCreateScheduleRequest(original)
+		roundTripObj := ToCreateScheduleRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestDescribeScheduleRequestConversion(t *testing.T) {
+	testCases := []*types.DescribeScheduleRequest{
+		nil,
+		{},
+		&testdata.DescribeScheduleRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromDescribeScheduleRequest(original)
+		roundTripObj := ToDescribeScheduleRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestDescribeScheduleResponseConversion(t *testing.T) {
+	testCases := []*types.DescribeScheduleResponse{
+		nil,
+		{},
+		&testdata.DescribeScheduleResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromDescribeScheduleResponse(original)
+		roundTripObj := ToDescribeScheduleResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUpdateScheduleRequestConversion(t *testing.T) {
+	testCases := []*types.UpdateScheduleRequest{
+		nil,
+		{},
+		&testdata.UpdateScheduleRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUpdateScheduleRequest(original)
+		roundTripObj := ToUpdateScheduleRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestPauseScheduleRequestConversion(t *testing.T) {
+	testCases := []*types.PauseScheduleRequest{
+		nil,
+		{},
+		&testdata.PauseScheduleRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromPauseScheduleRequest(original)
+		roundTripObj := ToPauseScheduleRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestUnpauseScheduleRequestConversion(t *testing.T) {
+	testCases := []*types.UnpauseScheduleRequest{
+		nil,
+		{},
+		&testdata.UnpauseScheduleRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUnpauseScheduleRequest(original)
+		roundTripObj := ToUnpauseScheduleRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestTriggerScheduleRequestConversion(t *testing.T) {
+	testCases := []*types.TriggerScheduleRequest{
+		nil,
+		{},
+		&testdata.TriggerScheduleRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromTriggerScheduleRequest(original)
+		roundTripObj := ToTriggerScheduleRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestBackfillScheduleRequestConversion(t *testing.T) {
+	testCases := []*types.BackfillScheduleRequest{
+		nil,
+		{},
+		&testdata.BackfillScheduleRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromBackfillScheduleRequest(original)
+		roundTripObj := ToBackfillScheduleRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestDeleteScheduleRequestConversion(t *testing.T) {
+	testCases := []*types.DeleteScheduleRequest{
+		nil,
+		{},
+		&testdata.DeleteScheduleRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromDeleteScheduleRequest(original)
+		roundTripObj := ToDeleteScheduleRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestListSchedulesRequestConversion(t *testing.T) {
+	testCases := []*types.ListSchedulesRequest{
+		nil,
+		{},
+		&testdata.ListSchedulesRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromListSchedulesRequest(original)
+		roundTripObj := ToListSchedulesRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestListSchedulesResponseConversion(t *testing.T) {
+	testCases := []*types.ListSchedulesResponse{
+		nil,
+		{},
+		&testdata.ListSchedulesResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromListSchedulesResponse(original)
+		roundTripObj := ToListSchedulesResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
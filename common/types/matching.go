@@ -430,6 +430,7 @@ type MatchingPollForDecisionTaskResponse struct {
 	StartedTimestamp          *int64                    `json:"startedTimestamp,omitempty"`
 	Queries                   map[string]*WorkflowQuery `json:"queries,omitempty"`
 	TotalHistoryBytes         int64                     `json:"currentHistorySize,omitempty"`
+	ContinueAsNewSuggested    bool                      `json:"continueAsNewSuggested,omitempty"`
 }
 
 // GetWorkflowExecution is an internal getter (TBD...)
@@ -480,6 +481,14 @@ func (v *MatchingPollForDecisionTaskResponse) GetTotalHistoryBytes() (o int64) {
 	return
 }
 
+// GetContinueAsNewSuggested is an internal getter (TBD...)
+func (v *MatchingPollForDecisionTaskResponse) GetContinueAsNewSuggested() (o bool) {
+	if v != nil {
+		return v.ContinueAsNewSuggested
+	}
+	return
+}
+
 // MatchingQueryWorkflowRequest is an internal type (TBD...)
 type MatchingQueryWorkflowRequest struct {
 	DomainUUID    string                `json:"domainUUID,omitempty"`
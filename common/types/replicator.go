@@ -326,6 +326,41 @@ func (v *GetReplicationMessagesResponse) GetMessagesByShard() (o map[int32]*Repl
 	return
 }
 
+// StreamReplicationMessagesRequest is an internal type (TBD...)
+type StreamReplicationMessagesRequest struct {
+	Tokens      []*ReplicationToken `json:"tokens,omitempty"`
+	ClusterName string              `json:"clusterName,omitempty"`
+}
+
+// GetTokens is an internal getter (TBD...)
+func (v *StreamReplicationMessagesRequest) GetTokens() (o []*ReplicationToken) {
+	if v != nil && v.Tokens != nil {
+		return v.Tokens
+	}
+	return
+}
+
+// GetClusterName is an internal getter (TBD...)
+func (v *StreamReplicationMessagesRequest) GetClusterName() (o string) {
+	if v != nil {
+		return v.ClusterName
+	}
+	return
+}
+
+// StreamReplicationMessagesResponse is an internal type (TBD...)
+type StreamReplicationMessagesResponse struct {
+	MessagesByShard map[int32]*ReplicationMessages `json:"messagesByShard,omitempty"`
+}
+
+// GetMessagesByShard is an internal getter (TBD...)
+func (v *StreamReplicationMessagesResponse) GetMessagesByShard() (o map[int32]*ReplicationMessages) {
+	if v != nil && v.MessagesByShard != nil {
+		return v.MessagesByShard
+	}
+	return
+}
+
 // HistoryTaskV2Attributes is an internal type (TBD...)
 type HistoryTaskV2Attributes struct {
 	DomainID            string                `json:"domainId,omitempty"`
@@ -411,14 +446,55 @@ type HistoryCountDLQMessagesResponse struct {
 	Entries map[HistoryDLQCountKey]int64
 }
 
+// DLQFilter is an internal type (TBD...)
+type DLQFilter struct {
+	DomainID   string               `json:"domainID,omitempty"`
+	WorkflowID string               `json:"workflowID,omitempty"`
+	TaskType   *ReplicationTaskType `json:"taskType,omitempty"`
+	ErrorClass string               `json:"errorClass,omitempty"`
+}
+
+// GetDomainID is an internal getter (TBD...)
+func (v *DLQFilter) GetDomainID() (o string) {
+	if v != nil {
+		return v.DomainID
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *DLQFilter) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetTaskType is an internal getter (TBD...)
+func (v *DLQFilter) GetTaskType() (o ReplicationTaskType) {
+	if v != nil && v.TaskType != nil {
+		return *v.TaskType
+	}
+	return
+}
+
+// GetErrorClass is an internal getter (TBD...)
+func (v *DLQFilter) GetErrorClass() (o string) {
+	if v != nil {
+		return v.ErrorClass
+	}
+	return
+}
+
 // MergeDLQMessagesRequest is an internal type (TBD...)
 type MergeDLQMessagesRequest struct {
-	Type                  *DLQType `json:"type,omitempty"`
-	ShardID               int32    `json:"shardID,omitempty"`
-	SourceCluster         string   `json:"sourceCluster,omitempty"`
-	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
-	MaximumPageSize       int32    `json:"maximumPageSize,omitempty"`
-	NextPageToken         []byte   `json:"nextPageToken,omitempty"`
+	Type                  *DLQType   `json:"type,omitempty"`
+	ShardID               int32      `json:"shardID,omitempty"`
+	SourceCluster         string     `json:"sourceCluster,omitempty"`
+	InclusiveEndMessageID *int64     `json:"inclusiveEndMessageID,omitempty"`
+	MaximumPageSize       int32      `json:"maximumPageSize,omitempty"`
+	NextPageToken         []byte     `json:"nextPageToken,omitempty"`
+	Filter                *DLQFilter `json:"filter,omitempty"`
 }
 
 func (v *MergeDLQMessagesRequest) SerializeForLogging() (string, error) {
@@ -476,6 +552,14 @@ func (v *MergeDLQMessagesRequest) GetNextPageToken() (o []byte) {
 	return
 }
 
+// GetFilter is an internal getter (TBD...)
+func (v *MergeDLQMessagesRequest) GetFilter() (o *DLQFilter) {
+	if v != nil && v.Filter != nil {
+		return v.Filter
+	}
+	return
+}
+
 // MergeDLQMessagesResponse is an internal type (TBD...)
 type MergeDLQMessagesResponse struct {
 	NextPageToken []byte `json:"nextPageToken,omitempty"`
@@ -530,12 +614,13 @@ func (v *PurgeDLQMessagesRequest) GetInclusiveEndMessageID() (o int64) {
 
 // ReadDLQMessagesRequest is an internal type (TBD...)
 type ReadDLQMessagesRequest struct {
-	Type                  *DLQType `json:"type,omitempty"`
-	ShardID               int32    `json:"shardID,omitempty"`
-	SourceCluster         string   `json:"sourceCluster,omitempty"`
-	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
-	MaximumPageSize       int32    `json:"maximumPageSize,omitempty"`
-	NextPageToken         []byte   `json:"nextPageToken,omitempty"`
+	Type                  *DLQType   `json:"type,omitempty"`
+	ShardID               int32      `json:"shardID,omitempty"`
+	SourceCluster         string     `json:"sourceCluster,omitempty"`
+	InclusiveEndMessageID *int64     `json:"inclusiveEndMessageID,omitempty"`
+	MaximumPageSize       int32      `json:"maximumPageSize,omitempty"`
+	NextPageToken         []byte     `json:"nextPageToken,omitempty"`
+	Filter                *DLQFilter `json:"filter,omitempty"`
 }
 
 func (v *ReadDLQMessagesRequest) SerializeForLogging() (string, error) {
@@ -593,6 +678,14 @@ func (v *ReadDLQMessagesRequest) GetNextPageToken() (o []byte) {
 	return
 }
 
+// GetFilter is an internal getter (TBD...)
+func (v *ReadDLQMessagesRequest) GetFilter() (o *DLQFilter) {
+	if v != nil && v.Filter != nil {
+		return v.Filter
+	}
+	return
+}
+
 // ReadDLQMessagesResponse is an internal type (TBD...)
 type ReadDLQMessagesResponse struct {
 	Type                 *DLQType               `json:"type,omitempty"`
@@ -720,6 +813,7 @@ type ReplicationTaskInfo struct {
 	FirstEventID int64  `json:"firstEventID,omitempty"`
 	NextEventID  int64  `json:"nextEventID,omitempty"`
 	ScheduledID  int64  `json:"scheduledID,omitempty"`
+	ErrorClass   string `json:"errorClass,omitempty"`
 }
 
 // GetDomainID is an internal getter (TBD...)
@@ -794,6 +888,14 @@ func (v *ReplicationTaskInfo) GetScheduledID() (o int64) {
 	return
 }
 
+// GetErrorClass is an internal getter (TBD...)
+func (v *ReplicationTaskInfo) GetErrorClass() (o string) {
+	if v != nil {
+		return v.ErrorClass
+	}
+	return
+}
+
 // ReplicationTaskType is an internal type (TBD...)
 type ReplicationTaskType int32
 
@@ -928,6 +1030,7 @@ type SyncActivityTaskAttributes struct {
 	LastWorkerIdentity string          `json:"lastWorkerIdentity,omitempty"`
 	LastFailureDetails []byte          `json:"lastFailureDetails,omitempty"`
 	VersionHistory     *VersionHistory `json:"versionHistory,omitempty"`
+	Paused             bool            `json:"paused,omitempty"`
 }
 
 // GetDomainID is an internal getter (TBD...)
@@ -1010,6 +1113,14 @@ func (v *SyncActivityTaskAttributes) GetVersionHistory() (o *VersionHistory) {
 	return
 }
 
+// GetPaused is an internal getter (TBD...)
+func (v *SyncActivityTaskAttributes) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
+}
+
 // SyncShardStatus is an internal type (TBD...)
 type SyncShardStatus struct {
 	Timestamp *int64 `json:"timestamp,omitempty"`
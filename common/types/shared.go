@@ -1698,6 +1698,208 @@ type DescribeQueueResponse struct {
 	ProcessingQueueStates []string `json:"processingQueueStates,omitempty"`
 }
 
+// ListThrottledWorkflowIDsRequest is an internal type (TBD...)
+type ListThrottledWorkflowIDsRequest struct {
+	ShardID int32 `json:"shardID,omitempty"`
+}
+
+func (v *ListThrottledWorkflowIDsRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *ListThrottledWorkflowIDsRequest) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// ListThrottledWorkflowIDsResponse is an internal type (TBD...)
+type ListThrottledWorkflowIDsResponse struct {
+	ThrottledWorkflowIDs []*ThrottledWorkflowID `json:"throttledWorkflowIDs,omitempty"`
+}
+
+// GetThrottledWorkflowIDs is an internal getter (TBD...)
+func (v *ListThrottledWorkflowIDsResponse) GetThrottledWorkflowIDs() (o []*ThrottledWorkflowID) {
+	if v != nil {
+		return v.ThrottledWorkflowIDs
+	}
+	return
+}
+
+// ThrottledWorkflowID is a workflow ID recently denied by one of its rate limits on a history host
+type ThrottledWorkflowID struct {
+	DomainName string `json:"domainName,omitempty"`
+	WorkflowID string `json:"workflowID,omitempty"`
+	// Limit is external, internal or the name of the operation whose rate limit denied the requests
+	Limit                  string `json:"limit,omitempty"`
+	Count                  int64  `json:"count,omitempty"`
+	LastThrottledTimestamp *int64 `json:"lastThrottledTimestamp,omitempty"`
+}
+
+// GetDomainName is an internal getter (TBD...)
+func (v *ThrottledWorkflowID) GetDomainName() (o string) {
+	if v != nil {
+		return v.DomainName
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *ThrottledWorkflowID) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetLimit is an internal getter (TBD...)
+func (v *ThrottledWorkflowID) GetLimit() (o string) {
+	if v != nil {
+		return v.Limit
+	}
+	return
+}
+
+// GetCount is an internal getter (TBD...)
+func (v *ThrottledWorkflowID) GetCount() (o int64) {
+	if v != nil {
+		return v.Count
+	}
+	return
+}
+
+// GetLastThrottledTimestamp is an internal getter (TBD...)
+func (v *ThrottledWorkflowID) GetLastThrottledTimestamp() (o int64) {
+	if v != nil && v.LastThrottledTimestamp != nil {
+		return *v.LastThrottledTimestamp
+	}
+	return
+}
+
+// GetDomainUsageRequest is an internal type (TBD...)
+type GetDomainUsageRequest struct {
+	Domain string `json:"domain,omitempty"`
+	// the usage reported between StartTimestamp and EndTimestamp is summed, both in unix nanoseconds
+	StartTimestamp *int64 `json:"startTimestamp,omitempty"`
+	EndTimestamp   *int64 `json:"endTimestamp,omitempty"`
+}
+
+func (v *GetDomainUsageRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *GetDomainUsageRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetStartTimestamp is an internal getter (TBD...)
+func (v *GetDomainUsageRequest) GetStartTimestamp() (o int64) {
+	if v != nil && v.StartTimestamp != nil {
+		return *v.StartTimestamp
+	}
+	return
+}
+
+// GetEndTimestamp is an internal getter (TBD...)
+func (v *GetDomainUsageRequest) GetEndTimestamp() (o int64) {
+	if v != nil && v.EndTimestamp != nil {
+		return *v.EndTimestamp
+	}
+	return
+}
+
+// GetDomainUsageResponse is an internal type (TBD...)
+type GetDomainUsageResponse struct {
+	Usage []*WorkflowUsage `json:"usage,omitempty"`
+}
+
+// GetUsage is an internal getter (TBD...)
+func (v *GetDomainUsageResponse) GetUsage() (o []*WorkflowUsage) {
+	if v != nil {
+		return v.Usage
+	}
+	return
+}
+
+// WorkflowUsage is the capacity consumed by the workflows of a domain and workflow type
+type WorkflowUsage struct {
+	WorkflowType    string `json:"workflowType,omitempty"`
+	DecisionTasks   int64  `json:"decisionTasks,omitempty"`
+	ActivityTasks   int64  `json:"activityTasks,omitempty"`
+	LocalActivities int64  `json:"localActivities,omitempty"`
+	Signals         int64  `json:"signals,omitempty"`
+	Timers          int64  `json:"timers,omitempty"`
+	HistoryBytes    int64  `json:"historyBytes,omitempty"`
+}
+
+// GetWorkflowType is an internal getter (TBD...)
+func (v *WorkflowUsage) GetWorkflowType() (o string) {
+	if v != nil {
+		return v.WorkflowType
+	}
+	return
+}
+
+// GetDecisionTasks is an internal getter (TBD...)
+func (v *WorkflowUsage) GetDecisionTasks() (o int64) {
+	if v != nil {
+		return v.DecisionTasks
+	}
+	return
+}
+
+// GetActivityTasks is an internal getter (TBD...)
+func (v *WorkflowUsage) GetActivityTasks() (o int64) {
+	if v != nil {
+		return v.ActivityTasks
+	}
+	return
+}
+
+// GetLocalActivities is an internal getter (TBD...)
+func (v *WorkflowUsage) GetLocalActivities() (o int64) {
+	if v != nil {
+		return v.LocalActivities
+	}
+	return
+}
+
+// GetSignals is an internal getter (TBD...)
+func (v *WorkflowUsage) GetSignals() (o int64) {
+	if v != nil {
+		return v.Signals
+	}
+	return
+}
+
+// GetTimers is an internal getter (TBD...)
+func (v *WorkflowUsage) GetTimers() (o int64) {
+	if v != nil {
+		return v.Timers
+	}
+	return
+}
+
+// GetHistoryBytes is an internal getter (TBD...)
+func (v *WorkflowUsage) GetHistoryBytes() (o int64) {
+	if v != nil {
+		return v.HistoryBytes
+	}
+	return
+}
+
 // DescribeTaskListRequest is an internal type (TBD...)
 type DescribeTaskListRequest struct {
 	Domain                string        `json:"domain,omitempty"`
@@ -2237,6 +2439,10 @@ func (e EventType) String() string {
 		return "ExternalWorkflowExecutionSignaled"
 	case 41:
 		return "UpsertWorkflowSearchAttributes"
+	case 42:
+		return "WorkflowExecutionPaused"
+	case 43:
+		return "WorkflowExecutionUnpaused"
 	}
 	return fmt.Sprintf("EventType(%d)", w)
 }
@@ -2370,6 +2576,12 @@ func (e *EventType) UnmarshalText(value []byte) error {
 	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
 		*e = EventTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "WORKFLOWEXECUTIONPAUSED":
+		*e = EventTypeWorkflowExecutionPaused
+		return nil
+	case "WORKFLOWEXECUTIONUNPAUSED":
+		*e = EventTypeWorkflowExecutionUnpaused
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -2470,8 +2682,115 @@ const (
 	EventTypeExternalWorkflowExecutionSignaled
 	// EventTypeUpsertWorkflowSearchAttributes is an option for EventType
 	EventTypeUpsertWorkflowSearchAttributes
+	// EventTypeWorkflowExecutionPaused is an option for EventType
+	EventTypeWorkflowExecutionPaused
+	// EventTypeWorkflowExecutionUnpaused is an option for EventType
+	EventTypeWorkflowExecutionUnpaused
 )
 
+// ExecuteWorkflowExecutionRequest is an internal type (TBD...)
+type ExecuteWorkflowExecutionRequest struct {
+	StartRequest    *StartWorkflowExecutionRequest `json:"startRequest,omitempty"`
+	AttachToRunning bool                           `json:"attachToRunning,omitempty"`
+}
+
+func (v *ExecuteWorkflowExecutionRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetStartRequest is an internal getter (TBD...)
+func (v *ExecuteWorkflowExecutionRequest) GetStartRequest() (o *StartWorkflowExecutionRequest) {
+	if v != nil && v.StartRequest != nil {
+		return v.StartRequest
+	}
+	return
+}
+
+// GetAttachToRunning is an internal getter (TBD...)
+func (v *ExecuteWorkflowExecutionRequest) GetAttachToRunning() (o bool) {
+	if v != nil {
+		return v.AttachToRunning
+	}
+	return
+}
+
+// GetDomain returns the domain of the wrapped start request
+func (v *ExecuteWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil && v.StartRequest != nil {
+		return v.StartRequest.Domain
+	}
+	return
+}
+
+// GetWorkflowType returns the workflow type of the wrapped start request
+func (v *ExecuteWorkflowExecutionRequest) GetWorkflowType() (o *WorkflowType) {
+	if v != nil && v.StartRequest != nil {
+		return v.StartRequest.WorkflowType
+	}
+	return
+}
+
+// ExecuteWorkflowExecutionResponse is an internal type (TBD...)
+type ExecuteWorkflowExecutionResponse struct {
+	RunID          string                        `json:"runId,omitempty"`
+	Attached       bool                          `json:"attached,omitempty"`
+	CloseStatus    *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+	Result         []byte                        `json:"result,omitempty"`
+	FailureReason  *string                       `json:"failureReason,omitempty"`
+	FailureDetails []byte                        `json:"failureDetails,omitempty"`
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *ExecuteWorkflowExecutionResponse) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+// GetAttached is an internal getter (TBD...)
+func (v *ExecuteWorkflowExecutionResponse) GetAttached() (o bool) {
+	if v != nil {
+		return v.Attached
+	}
+	return
+}
+
+// GetCloseStatus is an internal getter (TBD...)
+func (v *ExecuteWorkflowExecutionResponse) GetCloseStatus() (o WorkflowExecutionCloseStatus) {
+	if v != nil && v.CloseStatus != nil {
+		return *v.CloseStatus
+	}
+	return
+}
+
+// GetResult is an internal getter (TBD...)
+func (v *ExecuteWorkflowExecutionResponse) GetResult() (o []byte) {
+	if v != nil {
+		return v.Result
+	}
+	return
+}
+
+// GetFailureReason is an internal getter (TBD...)
+func (v *ExecuteWorkflowExecutionResponse) GetFailureReason() (o string) {
+	if v != nil && v.FailureReason != nil {
+		return *v.FailureReason
+	}
+	return
+}
+
+// GetFailureDetails is an internal getter (TBD...)
+func (v *ExecuteWorkflowExecutionResponse) GetFailureDetails() (o []byte) {
+	if v != nil {
+		return v.FailureDetails
+	}
+	return
+}
+
 // ExternalWorkflowExecutionCancelRequestedEventAttributes is an internal type (TBD...)
 type ExternalWorkflowExecutionCancelRequestedEventAttributes struct {
 	InitiatedEventID  int64              `json:"initiatedEventId,omitempty"`
@@ -2774,6 +3093,8 @@ type HistoryEvent struct {
 	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
 	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesEventAttributes                  *UpsertWorkflowSearchAttributesEventAttributes                  `json:"upsertWorkflowSearchAttributesEventAttributes,omitempty"`
+	WorkflowExecutionPausedEventAttributes                         *WorkflowExecutionPausedEventAttributes                         `json:"workflowExecutionPausedEventAttributes,omitempty"`
+	WorkflowExecutionUnpausedEventAttributes                       *WorkflowExecutionUnpausedEventAttributes                       `json:"workflowExecutionUnpausedEventAttributes,omitempty"`
 }
 
 // GetTimestamp is an internal getter (TBD...)
@@ -3128,6 +3449,22 @@ func (v *HistoryEvent) GetUpsertWorkflowSearchAttributesEventAttributes() (o *Up
 	return
 }
 
+// GetWorkflowExecutionPausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionPausedEventAttributes() (o *WorkflowExecutionPausedEventAttributes) {
+	if v != nil && v.WorkflowExecutionPausedEventAttributes != nil {
+		return v.WorkflowExecutionPausedEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionUnpausedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionUnpausedEventAttributes() (o *WorkflowExecutionUnpausedEventAttributes) {
+	if v != nil && v.WorkflowExecutionUnpausedEventAttributes != nil {
+		return v.WorkflowExecutionUnpausedEventAttributes
+	}
+	return
+}
+
 // HistoryEventFilterType is an internal type (TBD...)
 type HistoryEventFilterType int32
 
@@ -3705,68 +4042,172 @@ const (
 	ParentClosePolicyTerminate
 )
 
-// PendingActivityInfo is an internal type (TBD...)
-type PendingActivityInfo struct {
-	ActivityID             string                `json:"activityID,omitempty"`
-	ActivityType           *ActivityType         `json:"activityType,omitempty"`
-	State                  *PendingActivityState `json:"state,omitempty"`
-	HeartbeatDetails       []byte                `json:"heartbeatDetails,omitempty"`
-	LastHeartbeatTimestamp *int64                `json:"lastHeartbeatTimestamp,omitempty"`
-	LastStartedTimestamp   *int64                `json:"lastStartedTimestamp,omitempty"`
-	Attempt                int32                 `json:"attempt,omitempty"`
-	MaximumAttempts        int32                 `json:"maximumAttempts,omitempty"`
-	ScheduledTimestamp     *int64                `json:"scheduledTimestamp,omitempty"`
-	ExpirationTimestamp    *int64                `json:"expirationTimestamp,omitempty"`
-	LastFailureReason      *string               `json:"lastFailureReason,omitempty"`
-	StartedWorkerIdentity  string                `json:"startedWorkerIdentity,omitempty"`
-	LastWorkerIdentity     string                `json:"lastWorkerIdentity,omitempty"`
-	LastFailureDetails     []byte                `json:"lastFailureDetails,omitempty"`
+// PauseActivityRequest is an internal type (TBD...)
+type PauseActivityRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
 }
 
-// GetActivityID is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetActivityID() (o string) {
-	if v != nil {
-		return v.ActivityID
+func (v *PauseActivityRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
 	}
-	return
+	return SerializeRequest(v)
 }
 
-// GetState is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetState() (o PendingActivityState) {
-	if v != nil && v.State != nil {
-		return *v.State
+// GetDomain is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
 	}
 	return
 }
 
-// GetHeartbeatDetails is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetHeartbeatDetails() (o []byte) {
-	if v != nil && v.HeartbeatDetails != nil {
-		return v.HeartbeatDetails
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 	return
 }
 
-// GetLastHeartbeatTimestamp is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetLastHeartbeatTimestamp() (o int64) {
-	if v != nil && v.LastHeartbeatTimestamp != nil {
-		return *v.LastHeartbeatTimestamp
+// GetActivityID is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
 	}
 	return
 }
 
-// GetAttempt is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetAttempt() (o int32) {
+// GetIdentity is an internal getter (TBD...)
+func (v *PauseActivityRequest) GetIdentity() (o string) {
 	if v != nil {
-		return v.Attempt
+		return v.Identity
 	}
 	return
 }
 
-// GetMaximumAttempts is an internal getter (TBD...)
-func (v *PendingActivityInfo) GetMaximumAttempts() (o int32) {
-	if v != nil {
-		return v.MaximumAttempts
+// PauseWorkflowExecutionRequest is an internal type (TBD...)
+type PauseWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
+}
+
+func (v *PauseWorkflowExecutionRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *PauseWorkflowExecutionRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// PendingActivityInfo is an internal type (TBD...)
+type PendingActivityInfo struct {
+	ActivityID             string                `json:"activityID,omitempty"`
+	ActivityType           *ActivityType         `json:"activityType,omitempty"`
+	State                  *PendingActivityState `json:"state,omitempty"`
+	HeartbeatDetails       []byte                `json:"heartbeatDetails,omitempty"`
+	LastHeartbeatTimestamp *int64                `json:"lastHeartbeatTimestamp,omitempty"`
+	LastStartedTimestamp   *int64                `json:"lastStartedTimestamp,omitempty"`
+	Attempt                int32                 `json:"attempt,omitempty"`
+	MaximumAttempts        int32                 `json:"maximumAttempts,omitempty"`
+	ScheduledTimestamp     *int64                `json:"scheduledTimestamp,omitempty"`
+	ExpirationTimestamp    *int64                `json:"expirationTimestamp,omitempty"`
+	LastFailureReason      *string               `json:"lastFailureReason,omitempty"`
+	StartedWorkerIdentity  string                `json:"startedWorkerIdentity,omitempty"`
+	LastWorkerIdentity     string                `json:"lastWorkerIdentity,omitempty"`
+	LastFailureDetails     []byte                `json:"lastFailureDetails,omitempty"`
+	Paused                 bool                  `json:"paused,omitempty"`
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetState is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetState() (o PendingActivityState) {
+	if v != nil && v.State != nil {
+		return *v.State
+	}
+	return
+}
+
+// GetHeartbeatDetails is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetHeartbeatDetails() (o []byte) {
+	if v != nil && v.HeartbeatDetails != nil {
+		return v.HeartbeatDetails
+	}
+	return
+}
+
+// GetLastHeartbeatTimestamp is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetLastHeartbeatTimestamp() (o int64) {
+	if v != nil && v.LastHeartbeatTimestamp != nil {
+		return *v.LastHeartbeatTimestamp
+	}
+	return
+}
+
+// GetAttempt is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetAttempt() (o int32) {
+	if v != nil {
+		return v.Attempt
+	}
+	return
+}
+
+// GetMaximumAttempts is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetMaximumAttempts() (o int32) {
+	if v != nil {
+		return v.MaximumAttempts
 	}
 	return
 }
@@ -3803,6 +4244,14 @@ func (v *PendingActivityInfo) GetLastFailureDetails() (o []byte) {
 	return
 }
 
+// GetPaused is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
+}
+
 // PendingActivityState is an internal type (TBD...)
 type PendingActivityState int32
 
@@ -4095,6 +4544,7 @@ type PollForDecisionTaskResponse struct {
 	Queries                   map[string]*WorkflowQuery `json:"queries,omitempty"`
 	NextEventID               int64                     `json:"nextEventId,omitempty"`
 	TotalHistoryBytes         int64                     `json:"historySize,omitempty"`
+	ContinueAsNewSuggested    bool                      `json:"continueAsNewSuggested,omitempty"`
 }
 
 // GetTaskToken is an internal getter (TBD...)
@@ -4152,6 +4602,14 @@ func (v *PollForDecisionTaskResponse) GetHistorySize() (o int64) {
 	return
 }
 
+// GetContinueAsNewSuggested is an internal getter (TBD...)
+func (v *PollForDecisionTaskResponse) GetContinueAsNewSuggested() (o bool) {
+	if v != nil {
+		return v.ContinueAsNewSuggested
+	}
+	return
+}
+
 // PollerInfo is an internal type (TBD...)
 type PollerInfo struct {
 	LastAccessTime *int64  `json:"lastAccessTime,omitempty"`
@@ -4954,6 +5412,53 @@ func (v *RequestCancelWorkflowExecutionRequest) GetFirstExecutionRunID() (o stri
 	return
 }
 
+// ResetActivityRequest is an internal type (TBD...)
+type ResetActivityRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+func (v *ResetActivityRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *ResetActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // ResetPointInfo is an internal type (TBD...)
 type ResetPointInfo struct {
 	BinaryChecksum           string `json:"binaryChecksum,omitempty"`
@@ -6845,115 +7350,319 @@ type TransientDecisionInfo struct {
 	StartedEvent   *HistoryEvent `json:"startedEvent,omitempty"`
 }
 
-// UpdateDomainRequest is an internal type (TBD...)
-type UpdateDomainRequest struct {
-	Name                                   string                             `json:"name,omitempty"`
-	Description                            *string                            `json:"description,omitempty"`
-	OwnerEmail                             *string                            `json:"ownerEmail,omitempty"`
-	Data                                   map[string]string                  `json:"data,omitempty"`
-	WorkflowExecutionRetentionPeriodInDays *int32                             `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
-	EmitMetric                             *bool                              `json:"emitMetric,omitempty"`
-	BadBinaries                            *BadBinaries                       `json:"badBinaries,omitempty"`
-	HistoryArchivalStatus                  *ArchivalStatus                    `json:"historyArchivalStatus,omitempty"`
-	HistoryArchivalURI                     *string                            `json:"historyArchivalURI,omitempty"`
-	VisibilityArchivalStatus               *ArchivalStatus                    `json:"visibilityArchivalStatus,omitempty"`
-	VisibilityArchivalURI                  *string                            `json:"visibilityArchivalURI,omitempty"`
-	ActiveClusterName                      *string                            `json:"activeClusterName,omitempty"`
-	Clusters                               []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
-	SecurityToken                          string                             `json:"securityToken,omitempty"`
-	DeleteBadBinary                        *string                            `json:"deleteBadBinary,omitempty"`
-	FailoverTimeoutInSeconds               *int32                             `json:"failoverTimeoutInSeconds,omitempty"`
+// UnpauseActivityRequest is an internal type (TBD...)
+type UnpauseActivityRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
 }
 
-func (v *UpdateDomainRequest) SerializeForLogging() (string, error) {
+func (v *UnpauseActivityRequest) SerializeForLogging() (string, error) {
 	if v == nil {
 		return "", nil
 	}
 	return SerializeRequest(v)
 }
 
-// GetName is an internal getter (TBD...)
-func (v *UpdateDomainRequest) GetName() (o string) {
+// GetDomain is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetDomain() (o string) {
 	if v != nil {
-		return v.Name
+		return v.Domain
 	}
 	return
 }
 
-// GetFailoverTimeoutInSeconds is an internal getter (TBD...)
-func (v *UpdateDomainRequest) GetFailoverTimeoutInSeconds() (o int32) {
-	if v != nil && v.FailoverTimeoutInSeconds != nil {
-		return *v.FailoverTimeoutInSeconds
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 	return
 }
 
-// GetHistoryArchivalURI is an internal getter (TBD...)
-func (v *UpdateDomainRequest) GetHistoryArchivalURI() (o string) {
-	if v != nil && v.HistoryArchivalURI != nil {
-		return *v.HistoryArchivalURI
+// GetActivityID is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
 	}
 	return
 }
 
-// GetVisibilityArchivalURI is an internal getter (TBD...)
-func (v *UpdateDomainRequest) GetVisibilityArchivalURI() (o string) {
-	if v != nil && v.VisibilityArchivalURI != nil {
-		return *v.VisibilityArchivalURI
+// GetIdentity is an internal getter (TBD...)
+func (v *UnpauseActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
 	}
 	return
 }
 
-// UpdateDomainResponse is an internal type (TBD...)
-type UpdateDomainResponse struct {
-	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
-	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
-	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
-	FailoverVersion          int64                           `json:"failoverVersion,omitempty"`
-	IsGlobalDomain           bool                            `json:"isGlobalDomain,omitempty"`
+// UnpauseWorkflowExecutionRequest is an internal type (TBD...)
+type UnpauseWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
 }
 
-// GetDomainInfo is an internal getter (TBD...)
-func (v *UpdateDomainResponse) GetDomainInfo() (o *DomainInfo) {
-	if v != nil && v.DomainInfo != nil {
-		return v.DomainInfo
+func (v *UnpauseWorkflowExecutionRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
 	}
-	return
+	return SerializeRequest(v)
 }
 
-// GetFailoverVersion is an internal getter (TBD...)
-func (v *UpdateDomainResponse) GetFailoverVersion() (o int64) {
+// GetDomain is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetDomain() (o string) {
 	if v != nil {
-		return v.FailoverVersion
+		return v.Domain
 	}
 	return
 }
 
-// GetIsGlobalDomain is an internal getter (TBD...)
-func (v *UpdateDomainResponse) GetIsGlobalDomain() (o bool) {
-	if v != nil {
-		return v.IsGlobalDomain
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 	return
 }
 
-// UpsertWorkflowSearchAttributesDecisionAttributes is an internal type (TBD...)
-type UpsertWorkflowSearchAttributesDecisionAttributes struct {
-	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
+// GetReason is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
 }
 
-// GetSearchAttributes is an internal getter (TBD...)
-func (v *UpsertWorkflowSearchAttributesDecisionAttributes) GetSearchAttributes() (o *SearchAttributes) {
-	if v != nil && v.SearchAttributes != nil {
-		return v.SearchAttributes
+// GetIdentity is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
 	}
 	return
 }
 
-// UpsertWorkflowSearchAttributesEventAttributes is an internal type (TBD...)
-type UpsertWorkflowSearchAttributesEventAttributes struct {
-	DecisionTaskCompletedEventID int64             `json:"decisionTaskCompletedEventId,omitempty"`
-	SearchAttributes             *SearchAttributes `json:"searchAttributes,omitempty"`
+// GetRequestID is an internal getter (TBD...)
+func (v *UnpauseWorkflowExecutionRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// UpdateActivityOptionsRequest is an internal type (TBD...)
+type UpdateActivityOptionsRequest struct {
+	Domain                        string             `json:"domain,omitempty"`
+	WorkflowExecution             *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID                    string             `json:"activityID,omitempty"`
+	ScheduleToCloseTimeoutSeconds *int32             `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	ScheduleToStartTimeoutSeconds *int32             `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	StartToCloseTimeoutSeconds    *int32             `json:"startToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds       *int32             `json:"heartbeatTimeoutSeconds,omitempty"`
+	RetryPolicy                   *RetryPolicy       `json:"retryPolicy,omitempty"`
+	Identity                      string             `json:"identity,omitempty"`
+}
+
+func (v *UpdateActivityOptionsRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetScheduleToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetScheduleToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToCloseTimeoutSeconds != nil {
+		return *v.ScheduleToCloseTimeoutSeconds
+	}
+	return
+}
+
+// GetScheduleToStartTimeoutSeconds is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetScheduleToStartTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToStartTimeoutSeconds != nil {
+		return *v.ScheduleToStartTimeoutSeconds
+	}
+	return
+}
+
+// GetStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.StartToCloseTimeoutSeconds != nil {
+		return *v.StartToCloseTimeoutSeconds
+	}
+	return
+}
+
+// GetHeartbeatTimeoutSeconds is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetHeartbeatTimeoutSeconds() (o int32) {
+	if v != nil && v.HeartbeatTimeoutSeconds != nil {
+		return *v.HeartbeatTimeoutSeconds
+	}
+	return
+}
+
+// GetRetryPolicy is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetRetryPolicy() (o *RetryPolicy) {
+	if v != nil && v.RetryPolicy != nil {
+		return v.RetryPolicy
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UpdateActivityOptionsRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// UpdateDomainRequest is an internal type (TBD...)
+type UpdateDomainRequest struct {
+	Name                                   string                             `json:"name,omitempty"`
+	Description                            *string                            `json:"description,omitempty"`
+	OwnerEmail                             *string                            `json:"ownerEmail,omitempty"`
+	Data                                   map[string]string                  `json:"data,omitempty"`
+	WorkflowExecutionRetentionPeriodInDays *int32                             `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
+	EmitMetric                             *bool                              `json:"emitMetric,omitempty"`
+	BadBinaries                            *BadBinaries                       `json:"badBinaries,omitempty"`
+	HistoryArchivalStatus                  *ArchivalStatus                    `json:"historyArchivalStatus,omitempty"`
+	HistoryArchivalURI                     *string                            `json:"historyArchivalURI,omitempty"`
+	VisibilityArchivalStatus               *ArchivalStatus                    `json:"visibilityArchivalStatus,omitempty"`
+	VisibilityArchivalURI                  *string                            `json:"visibilityArchivalURI,omitempty"`
+	ActiveClusterName                      *string                            `json:"activeClusterName,omitempty"`
+	Clusters                               []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
+	SecurityToken                          string                             `json:"securityToken,omitempty"`
+	DeleteBadBinary                        *string                            `json:"deleteBadBinary,omitempty"`
+	FailoverTimeoutInSeconds               *int32                             `json:"failoverTimeoutInSeconds,omitempty"`
+	IsGlobalDomain                         *bool                              `json:"isGlobalDomain,omitempty"`
+}
+
+func (v *UpdateDomainRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetName is an internal getter (TBD...)
+func (v *UpdateDomainRequest) GetName() (o string) {
+	if v != nil {
+		return v.Name
+	}
+	return
+}
+
+// GetFailoverTimeoutInSeconds is an internal getter (TBD...)
+func (v *UpdateDomainRequest) GetFailoverTimeoutInSeconds() (o int32) {
+	if v != nil && v.FailoverTimeoutInSeconds != nil {
+		return *v.FailoverTimeoutInSeconds
+	}
+	return
+}
+
+// GetIsGlobalDomain is an internal getter (TBD...)
+func (v *UpdateDomainRequest) GetIsGlobalDomain() (o bool) {
+	if v != nil && v.IsGlobalDomain != nil {
+		return *v.IsGlobalDomain
+	}
+	return
+}
+
+// GetHistoryArchivalURI is an internal getter (TBD...)
+func (v *UpdateDomainRequest) GetHistoryArchivalURI() (o string) {
+	if v != nil && v.HistoryArchivalURI != nil {
+		return *v.HistoryArchivalURI
+	}
+	return
+}
+
+// GetVisibilityArchivalURI is an internal getter (TBD...)
+func (v *UpdateDomainRequest) GetVisibilityArchivalURI() (o string) {
+	if v != nil && v.VisibilityArchivalURI != nil {
+		return *v.VisibilityArchivalURI
+	}
+	return
+}
+
+// UpdateDomainResponse is an internal type (TBD...)
+type UpdateDomainResponse struct {
+	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
+	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
+	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
+	FailoverVersion          int64                           `json:"failoverVersion,omitempty"`
+	IsGlobalDomain           bool                            `json:"isGlobalDomain,omitempty"`
+}
+
+// GetDomainInfo is an internal getter (TBD...)
+func (v *UpdateDomainResponse) GetDomainInfo() (o *DomainInfo) {
+	if v != nil && v.DomainInfo != nil {
+		return v.DomainInfo
+	}
+	return
+}
+
+// GetFailoverVersion is an internal getter (TBD...)
+func (v *UpdateDomainResponse) GetFailoverVersion() (o int64) {
+	if v != nil {
+		return v.FailoverVersion
+	}
+	return
+}
+
+// GetIsGlobalDomain is an internal getter (TBD...)
+func (v *UpdateDomainResponse) GetIsGlobalDomain() (o bool) {
+	if v != nil {
+		return v.IsGlobalDomain
+	}
+	return
+}
+
+// UpsertWorkflowSearchAttributesDecisionAttributes is an internal type (TBD...)
+type UpsertWorkflowSearchAttributesDecisionAttributes struct {
+	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
+}
+
+// GetSearchAttributes is an internal getter (TBD...)
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+	return
+}
+
+// UpsertWorkflowSearchAttributesEventAttributes is an internal type (TBD...)
+type UpsertWorkflowSearchAttributesEventAttributes struct {
+	DecisionTaskCompletedEventID int64             `json:"decisionTaskCompletedEventId,omitempty"`
+	SearchAttributes             *SearchAttributes `json:"searchAttributes,omitempty"`
 }
 
 // GetSearchAttributes is an internal getter (TBD...)
@@ -7273,6 +7982,7 @@ type WorkflowExecutionInfo struct {
 	IsCron            bool                          `json:"isCron,omitempty"`
 	UpdateTime        *int64                        `json:"updateTime,omitempty"`
 	PartitionConfig   map[string]string
+	IsPaused          bool `json:"isPaused,omitempty"`
 }
 
 // GetExecution is an internal getter (TBD...)
@@ -7347,6 +8057,45 @@ func (v *WorkflowExecutionInfo) GetPartitionConfig() (o map[string]string) {
 	return
 }
 
+// GetIsPaused is an internal getter (TBD...)
+func (v *WorkflowExecutionInfo) GetIsPaused() (o bool) {
+	if v != nil {
+		return v.IsPaused
+	}
+	return
+}
+
+// WorkflowExecutionPausedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionPausedEventAttributes struct {
+	Reason    string `json:"reason,omitempty"`
+	Identity  string `json:"identity,omitempty"`
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionPausedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionPausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *WorkflowExecutionPausedEventAttributes) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
 // WorkflowExecutionSignaledEventAttributes is an internal type (TBD...)
 type WorkflowExecutionSignaledEventAttributes struct {
 	SignalName string `json:"signalName,omitempty"`
@@ -7600,6 +8349,37 @@ func (v *WorkflowExecutionTimedOutEventAttributes) GetTimeoutType() (o TimeoutTy
 	return
 }
 
+// WorkflowExecutionUnpausedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionUnpausedEventAttributes struct {
+	Reason    string `json:"reason,omitempty"`
+	Identity  string `json:"identity,omitempty"`
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *WorkflowExecutionUnpausedEventAttributes) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionUnpausedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *WorkflowExecutionUnpausedEventAttributes) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
 // WorkflowIDReusePolicy is an internal type (TBD...)
 type WorkflowIDReusePolicy int32
 
@@ -8297,3 +9077,839 @@ type Any struct {
 	// To interpret, you MUST check ValueType.
 	Value []byte `json:"value"`
 }
+
+// ScheduleOverlapPolicy is an internal type (TBD...)
+type ScheduleOverlapPolicy int32
+
+// Ptr is a helper function for getting pointer value
+func (e ScheduleOverlapPolicy) Ptr() *ScheduleOverlapPolicy {
+	return &e
+}
+
+// String returns a readable string representation of ScheduleOverlapPolicy.
+func (e ScheduleOverlapPolicy) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "SKIP"
+	case 1:
+		return "BUFFER"
+	case 2:
+		return "CANCEL_OTHER"
+	case 3:
+		return "ALLOW_ALL"
+	}
+	return fmt.Sprintf("ScheduleOverlapPolicy(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *ScheduleOverlapPolicy) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "SKIP":
+		*e = ScheduleOverlapPolicySkip
+		return nil
+	case "BUFFER":
+		*e = ScheduleOverlapPolicyBuffer
+		return nil
+	case "CANCEL_OTHER":
+		*e = ScheduleOverlapPolicyCancelOther
+		return nil
+	case "ALLOW_ALL":
+		*e = ScheduleOverlapPolicyAllowAll
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ScheduleOverlapPolicy", err)
+		}
+		*e = ScheduleOverlapPolicy(val)
+		return nil
+	}
+}
+
+// MarshalText encodes ScheduleOverlapPolicy to text.
+func (e ScheduleOverlapPolicy) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// ScheduleOverlapPolicySkip is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicySkip ScheduleOverlapPolicy = iota
+	// ScheduleOverlapPolicyBuffer is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicyBuffer
+	// ScheduleOverlapPolicyCancelOther is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicyCancelOther
+	// ScheduleOverlapPolicyAllowAll is an option for ScheduleOverlapPolicy
+	ScheduleOverlapPolicyAllowAll
+)
+
+// ScheduleCalendarSpec is an internal type (TBD...)
+type ScheduleCalendarSpec struct {
+	Second     string `json:"second,omitempty"`
+	Minute     string `json:"minute,omitempty"`
+	Hour       string `json:"hour,omitempty"`
+	DayOfMonth string `json:"dayOfMonth,omitempty"`
+	Month      string `json:"month,omitempty"`
+	DayOfWeek  string `json:"dayOfWeek,omitempty"`
+}
+
+// ScheduleSpec is an internal type (TBD...)
+type ScheduleSpec struct {
+	CronExpression    string                  `json:"cronExpression,omitempty"`
+	Calendars         []*ScheduleCalendarSpec `json:"calendars,omitempty"`
+	IntervalInSeconds *int32                  `json:"intervalInSeconds,omitempty"`
+	StartTimestamp    *int64                  `json:"startTimestamp,omitempty"`
+	EndTimestamp      *int64                  `json:"endTimestamp,omitempty"`
+	JitterInSeconds   *int32                  `json:"jitterInSeconds,omitempty"`
+}
+
+// GetCronExpression is an internal getter (TBD...)
+func (v *ScheduleSpec) GetCronExpression() (o string) {
+	if v != nil {
+		return v.CronExpression
+	}
+	return
+}
+
+// GetCalendars is an internal getter (TBD...)
+func (v *ScheduleSpec) GetCalendars() (o []*ScheduleCalendarSpec) {
+	if v != nil && v.Calendars != nil {
+		return v.Calendars
+	}
+	return
+}
+
+// GetIntervalInSeconds is an internal getter (TBD...)
+func (v *ScheduleSpec) GetIntervalInSeconds() (o int32) {
+	if v != nil && v.IntervalInSeconds != nil {
+		return *v.IntervalInSeconds
+	}
+	return
+}
+
+// GetStartTimestamp is an internal getter (TBD...)
+func (v *ScheduleSpec) GetStartTimestamp() (o int64) {
+	if v != nil && v.StartTimestamp != nil {
+		return *v.StartTimestamp
+	}
+	return
+}
+
+// GetEndTimestamp is an internal getter (TBD...)
+func (v *ScheduleSpec) GetEndTimestamp() (o int64) {
+	if v != nil && v.EndTimestamp != nil {
+		return *v.EndTimestamp
+	}
+	return
+}
+
+// GetJitterInSeconds is an internal getter (TBD...)
+func (v *ScheduleSpec) GetJitterInSeconds() (o int32) {
+	if v != nil && v.JitterInSeconds != nil {
+		return *v.JitterInSeconds
+	}
+	return
+}
+
+// ScheduleStartWorkflowAction is an internal type (TBD...)
+type ScheduleStartWorkflowAction struct {
+	WorkflowType                        *WorkflowType `json:"workflowType,omitempty"`
+	TaskList                            *TaskList     `json:"taskList,omitempty"`
+	WorkflowIDPrefix                    string        `json:"workflowIdPrefix,omitempty"`
+	Input                               []byte        `json:"-"`
+	ExecutionStartToCloseTimeoutSeconds *int32        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+}
+
+// GetWorkflowType is an internal getter (TBD...)
+func (v *ScheduleStartWorkflowAction) GetWorkflowType() (o *WorkflowType) {
+	if v != nil && v.WorkflowType != nil {
+		return v.WorkflowType
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *ScheduleStartWorkflowAction) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetWorkflowIDPrefix is an internal getter (TBD...)
+func (v *ScheduleStartWorkflowAction) GetWorkflowIDPrefix() (o string) {
+	if v != nil {
+		return v.WorkflowIDPrefix
+	}
+	return
+}
+
+// GetExecutionStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ScheduleStartWorkflowAction) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
+		return *v.ExecutionStartToCloseTimeoutSeconds
+	}
+	return
+}
+
+// GetTaskStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *ScheduleStartWorkflowAction) GetTaskStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.TaskStartToCloseTimeoutSeconds != nil {
+		return *v.TaskStartToCloseTimeoutSeconds
+	}
+	return
+}
+
+// SchedulePolicies is an internal type (TBD...)
+type SchedulePolicies struct {
+	OverlapPolicy          *ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+	CatchUpWindowInSeconds *int32                 `json:"catchUpWindowInSeconds,omitempty"`
+	BufferLimit            int32                  `json:"bufferLimit,omitempty"`
+}
+
+// GetOverlapPolicy is an internal getter (TBD...)
+func (v *SchedulePolicies) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
+	if v != nil && v.OverlapPolicy != nil {
+		return *v.OverlapPolicy
+	}
+	return
+}
+
+// GetCatchUpWindowInSeconds is an internal getter (TBD...)
+func (v *SchedulePolicies) GetCatchUpWindowInSeconds() (o int32) {
+	if v != nil && v.CatchUpWindowInSeconds != nil {
+		return *v.CatchUpWindowInSeconds
+	}
+	return
+}
+
+// GetBufferLimit is an internal getter (TBD...)
+func (v *SchedulePolicies) GetBufferLimit() (o int32) {
+	if v != nil {
+		return v.BufferLimit
+	}
+	return
+}
+
+// ScheduleRun is an internal type (TBD...)
+type ScheduleRun struct {
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	NominalTimestamp  *int64             `json:"nominalTimestamp,omitempty"`
+	ActualTimestamp   *int64             `json:"actualTimestamp,omitempty"`
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ScheduleRun) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetNominalTimestamp is an internal getter (TBD...)
+func (v *ScheduleRun) GetNominalTimestamp() (o int64) {
+	if v != nil && v.NominalTimestamp != nil {
+		return *v.NominalTimestamp
+	}
+	return
+}
+
+// GetActualTimestamp is an internal getter (TBD...)
+func (v *ScheduleRun) GetActualTimestamp() (o int64) {
+	if v != nil && v.ActualTimestamp != nil {
+		return *v.ActualTimestamp
+	}
+	return
+}
+
+// ScheduleState is an internal type (TBD...)
+type ScheduleState struct {
+	Paused                 bool           `json:"paused,omitempty"`
+	Note                   string         `json:"note,omitempty"`
+	LastProcessedTimestamp *int64         `json:"lastProcessedTimestamp,omitempty"`
+	RunningRuns            []*ScheduleRun `json:"runningRuns,omitempty"`
+	LastRun                *ScheduleRun   `json:"lastRun,omitempty"`
+	BufferedRuns           int32          `json:"bufferedRuns,omitempty"`
+	PendingBackfills       int32          `json:"pendingBackfills,omitempty"`
+	TotalRuns              int64          `json:"totalRuns,omitempty"`
+	SkippedRuns            int64          `json:"skippedRuns,omitempty"`
+	MissedRuns             int64          `json:"missedRuns,omitempty"`
+	FailedStarts           int64          `json:"failedStarts,omitempty"`
+	BufferOverruns         int64          `json:"bufferOverruns,omitempty"`
+}
+
+// GetPaused is an internal getter (TBD...)
+func (v *ScheduleState) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
+}
+
+// GetLastProcessedTimestamp is an internal getter (TBD...)
+func (v *ScheduleState) GetLastProcessedTimestamp() (o int64) {
+	if v != nil && v.LastProcessedTimestamp != nil {
+		return *v.LastProcessedTimestamp
+	}
+	return
+}
+
+// GetRunningRuns is an internal getter (TBD...)
+func (v *ScheduleState) GetRunningRuns() (o []*ScheduleRun) {
+	if v != nil && v.RunningRuns != nil {
+		return v.RunningRuns
+	}
+	return
+}
+
+// GetLastRun is an internal getter (TBD...)
+func (v *ScheduleState) GetLastRun() (o *ScheduleRun) {
+	if v != nil && v.LastRun != nil {
+		return v.LastRun
+	}
+	return
+}
+
+// ScheduleListEntry is an internal type (TBD...)
+type ScheduleListEntry struct {
+	ScheduleID        string             `json:"scheduleId,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *ScheduleListEntry) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ScheduleListEntry) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// DescribeScheduleResponse is an internal type (TBD...)
+type DescribeScheduleResponse struct {
+	Spec                  *ScheduleSpec                `json:"spec,omitempty"`
+	Action                *ScheduleStartWorkflowAction `json:"action,omitempty"`
+	Policies              *SchedulePolicies            `json:"policies,omitempty"`
+	State                 *ScheduleState               `json:"state,omitempty"`
+	UpcomingRunTimestamps []int64                      `json:"upcomingRunTimestamps,omitempty"`
+}
+
+// GetSpec is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetSpec() (o *ScheduleSpec) {
+	if v != nil && v.Spec != nil {
+		return v.Spec
+	}
+	return
+}
+
+// GetAction is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetAction() (o *ScheduleStartWorkflowAction) {
+	if v != nil && v.Action != nil {
+		return v.Action
+	}
+	return
+}
+
+// GetPolicies is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetPolicies() (o *SchedulePolicies) {
+	if v != nil && v.Policies != nil {
+		return v.Policies
+	}
+	return
+}
+
+// GetState is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetState() (o *ScheduleState) {
+	if v != nil && v.State != nil {
+		return v.State
+	}
+	return
+}
+
+// GetUpcomingRunTimestamps is an internal getter (TBD...)
+func (v *DescribeScheduleResponse) GetUpcomingRunTimestamps() (o []int64) {
+	if v != nil && v.UpcomingRunTimestamps != nil {
+		return v.UpcomingRunTimestamps
+	}
+	return
+}
+
+// ListSchedulesResponse is an internal type (TBD...)
+type ListSchedulesResponse struct {
+	Schedules     []*ScheduleListEntry `json:"schedules,omitempty"`
+	NextPageToken []byte               `json:"nextPageToken,omitempty"`
+}
+
+// GetSchedules is an internal getter (TBD...)
+func (v *ListSchedulesResponse) GetSchedules() (o []*ScheduleListEntry) {
+	if v != nil && v.Schedules != nil {
+		return v.Schedules
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListSchedulesResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// CreateScheduleRequest is an internal type (TBD...)
+type CreateScheduleRequest struct {
+	Domain     string                       `json:"domain,omitempty"`
+	ScheduleID string                       `json:"scheduleId,omitempty"`
+	Spec       *ScheduleSpec                `json:"spec,omitempty"`
+	Action     *ScheduleStartWorkflowAction `json:"action,omitempty"`
+	Policies   *SchedulePolicies            `json:"policies,omitempty"`
+	Identity   string                       `json:"identity,omitempty"`
+	RequestID  string                       `json:"requestId,omitempty"`
+}
+
+func (v *CreateScheduleRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetSpec is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetSpec() (o *ScheduleSpec) {
+	if v != nil && v.Spec != nil {
+		return v.Spec
+	}
+	return
+}
+
+// GetAction is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetAction() (o *ScheduleStartWorkflowAction) {
+	if v != nil && v.Action != nil {
+		return v.Action
+	}
+	return
+}
+
+// GetPolicies is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetPolicies() (o *SchedulePolicies) {
+	if v != nil && v.Policies != nil {
+		return v.Policies
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *CreateScheduleRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// DescribeScheduleRequest is an internal type (TBD...)
+type DescribeScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleId,omitempty"`
+}
+
+func (v *DescribeScheduleRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DescribeScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *DescribeScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// UpdateScheduleRequest is an internal type (TBD...)
+type UpdateScheduleRequest struct {
+	Domain     string                       `json:"domain,omitempty"`
+	ScheduleID string                       `json:"scheduleId,omitempty"`
+	Spec       *ScheduleSpec                `json:"spec,omitempty"`
+	Action     *ScheduleStartWorkflowAction `json:"action,omitempty"`
+	Policies   *SchedulePolicies            `json:"policies,omitempty"`
+	Identity   string                       `json:"identity,omitempty"`
+}
+
+func (v *UpdateScheduleRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetSpec is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetSpec() (o *ScheduleSpec) {
+	if v != nil && v.Spec != nil {
+		return v.Spec
+	}
+	return
+}
+
+// GetAction is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetAction() (o *ScheduleStartWorkflowAction) {
+	if v != nil && v.Action != nil {
+		return v.Action
+	}
+	return
+}
+
+// GetPolicies is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetPolicies() (o *SchedulePolicies) {
+	if v != nil && v.Policies != nil {
+		return v.Policies
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UpdateScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// PauseScheduleRequest is an internal type (TBD...)
+type PauseScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleId,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+	Identity   string `json:"identity,omitempty"`
+}
+
+func (v *PauseScheduleRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *PauseScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// UnpauseScheduleRequest is an internal type (TBD...)
+type UnpauseScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleId,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+	Identity   string `json:"identity,omitempty"`
+}
+
+func (v *UnpauseScheduleRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UnpauseScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *UnpauseScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *UnpauseScheduleRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UnpauseScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// TriggerScheduleRequest is an internal type (TBD...)
+type TriggerScheduleRequest struct {
+	Domain        string                 `json:"domain,omitempty"`
+	ScheduleID    string                 `json:"scheduleId,omitempty"`
+	OverlapPolicy *ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+	Identity      string                 `json:"identity,omitempty"`
+}
+
+func (v *TriggerScheduleRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *TriggerScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *TriggerScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetOverlapPolicy is an internal getter (TBD...)
+func (v *TriggerScheduleRequest) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
+	if v != nil && v.OverlapPolicy != nil {
+		return *v.OverlapPolicy
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *TriggerScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// BackfillScheduleRequest is an internal type (TBD...)
+type BackfillScheduleRequest struct {
+	Domain         string                 `json:"domain,omitempty"`
+	ScheduleID     string                 `json:"scheduleId,omitempty"`
+	StartTimestamp *int64                 `json:"startTimestamp,omitempty"`
+	EndTimestamp   *int64                 `json:"endTimestamp,omitempty"`
+	OverlapPolicy  *ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+	Identity       string                 `json:"identity,omitempty"`
+}
+
+func (v *BackfillScheduleRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetStartTimestamp is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetStartTimestamp() (o int64) {
+	if v != nil && v.StartTimestamp != nil {
+		return *v.StartTimestamp
+	}
+	return
+}
+
+// GetEndTimestamp is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetEndTimestamp() (o int64) {
+	if v != nil && v.EndTimestamp != nil {
+		return *v.EndTimestamp
+	}
+	return
+}
+
+// GetOverlapPolicy is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
+	if v != nil && v.OverlapPolicy != nil {
+		return *v.OverlapPolicy
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *BackfillScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// DeleteScheduleRequest is an internal type (TBD...)
+type DeleteScheduleRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	ScheduleID string `json:"scheduleId,omitempty"`
+	Identity   string `json:"identity,omitempty"`
+}
+
+func (v *DeleteScheduleRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DeleteScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *DeleteScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *DeleteScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// ListSchedulesRequest is an internal type (TBD...)
+type ListSchedulesRequest struct {
+	Domain        string `json:"domain,omitempty"`
+	PageSize      int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+}
+
+func (v *ListSchedulesRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ListSchedulesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetPageSize is an internal getter (TBD...)
+func (v *ListSchedulesRequest) GetPageSize() (o int32) {
+	if v != nil {
+		return v.PageSize
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListSchedulesRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
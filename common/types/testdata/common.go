@@ -37,6 +37,7 @@ const (
 	ActivityID = "ActivityID"
 	RequestID  = "RequestID"
 	TimerID    = "TimerID"
+	ScheduleID = "ScheduleID"
 
 	WorkflowTypeName     = "WorkflowTypeName"
 	ActivityTypeName     = "ActivityTypeName"
@@ -57,6 +58,7 @@ const (
 	ClientLibraryVersion = "ClientLibraryVersion"
 	SupportedVersions    = "SupportedVersions"
 	FeatureFlag          = "FeatureFlag"
+	ErrorClass           = "ErrorClass"
 
 	Attempt            = 2
 	PageSize           = 10
@@ -345,6 +347,7 @@ var (
 		AutoResetPoints:   &ResetPoints,
 		TaskList:          TaskListName,
 		PartitionConfig:   PartitionConfig,
+		IsPaused:          true,
 	}
 	CronWorkflowExecutionInfo = types.WorkflowExecutionInfo{
 		Execution:         &WorkflowExecution,
@@ -405,6 +408,7 @@ var (
 		LastWorkerIdentity:     Identity,
 		LastFailureDetails:     FailureDetails,
 		StartedWorkerIdentity:  Identity,
+		Paused:                 true,
 	}
 	PendingActivityInfoArray = []*types.PendingActivityInfo{
 		&PendingActivityInfo,
@@ -43,6 +43,7 @@ var (
 	QueryRejectCondition                       = types.QueryRejectConditionNotCompletedCleanly
 	QueryResultType                            = types.QueryResultTypeFailed
 	QueryTaskCompletedType                     = types.QueryTaskCompletedTypeFailed
+	ScheduleOverlapPolicy                      = types.ScheduleOverlapPolicyCancelOther
 	SignalExternalWorkflowExecutionFailedCause = types.SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution
 	TaskListKind                               = types.TaskListKindSticky
 	TaskListType                               = types.TaskListTypeActivity
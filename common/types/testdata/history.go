@@ -75,6 +75,8 @@ var (
 		&HistoryEvent_SignalExternalWorkflowExecutionFailed,
 		&HistoryEvent_ExternalWorkflowExecutionSignaled,
 		&HistoryEvent_UpsertWorkflowSearchAttributes,
+		&HistoryEvent_WorkflowExecutionPaused,
+		&HistoryEvent_WorkflowExecutionUnpaused,
 	}
 
 	HistoryEvent_WorkflowExecutionStarted = generateEvent(func(e *types.HistoryEvent) {
@@ -245,6 +247,14 @@ var (
 		e.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		e.UpsertWorkflowSearchAttributesEventAttributes = &UpsertWorkflowSearchAttributesEventAttributes
 	})
+	HistoryEvent_WorkflowExecutionPaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionPaused.Ptr()
+		e.WorkflowExecutionPausedEventAttributes = &WorkflowExecutionPausedEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionUnpaused = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionUnpaused.Ptr()
+		e.WorkflowExecutionUnpausedEventAttributes = &WorkflowExecutionUnpausedEventAttributes
+	})
 
 	WorkflowExecutionStartedEventAttributes = types.WorkflowExecutionStartedEventAttributes{
 		WorkflowType:                        &WorkflowType,
@@ -575,6 +585,16 @@ var (
 		DecisionTaskCompletedEventID: EventID1,
 		SearchAttributes:             &SearchAttributes,
 	}
+	WorkflowExecutionPausedEventAttributes = types.WorkflowExecutionPausedEventAttributes{
+		Reason:    Reason,
+		Identity:  Identity,
+		RequestID: RequestID,
+	}
+	WorkflowExecutionUnpausedEventAttributes = types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:    Reason,
+		Identity:  Identity,
+		RequestID: RequestID,
+	}
 	GetFailoverInfoRequest = types.GetFailoverInfoRequest{
 		DomainID: uuid.NewUUID().String(),
 	}
@@ -61,6 +61,7 @@ var (
 		FirstEventID: EventID1,
 		NextEventID:  EventID2,
 		ScheduledID:  EventID3,
+		ErrorClass:   ErrorClass,
 	}
 	ReplicationTaskInfoArray = []*types.ReplicationTaskInfo{
 		&ReplicationTaskInfo,
@@ -133,6 +134,7 @@ var (
 		LastWorkerIdentity: Identity,
 		LastFailureDetails: FailureDetails,
 		VersionHistory:     &VersionHistory,
+		Paused:             true,
 	}
 	HistoryTaskV2Attributes = types.HistoryTaskV2Attributes{
 		DomainID:            DomainID,
@@ -155,6 +157,12 @@ var (
 		LastProcessedMessageID: common.Int64Ptr(MessageID2),
 		ClusterName:            ClusterName1,
 	}
+	DLQFilter = types.DLQFilter{
+		DomainID:   DomainID,
+		WorkflowID: WorkflowID,
+		TaskType:   types.ReplicationTaskTypeHistoryV2.Ptr(),
+		ErrorClass: ErrorClass,
+	}
 	MergeDLQMessagesRequest = types.MergeDLQMessagesRequest{
 		Type:                  types.DLQTypeDomain.Ptr(),
 		ShardID:               ShardID,
@@ -162,6 +170,7 @@ var (
 		InclusiveEndMessageID: common.Int64Ptr(MessageID1),
 		MaximumPageSize:       MaxiumuPageSize,
 		NextPageToken:         Token1,
+		Filter:                &DLQFilter,
 	}
 	PurgeDLQMessagesRequest = types.PurgeDLQMessagesRequest{
 		Type:                  types.DLQTypeDomain.Ptr(),
@@ -176,6 +185,7 @@ var (
 		InclusiveEndMessageID: common.Int64Ptr(MessageID1),
 		MaximumPageSize:       MaxiumuPageSize,
 		NextPageToken:         Token1,
+		Filter:                &DLQFilter,
 	}
 	ReadDLQMessagesResponse = types.ReadDLQMessagesResponse{
 		Type:                 types.DLQTypeDomain.Ptr(),
@@ -65,15 +65,49 @@ var (
 	AdminDescribeQueueResponse = types.DescribeQueueResponse{
 		ProcessingQueueStates: []string{"state1", "state2"},
 	}
+	AdminListThrottledWorkflowIDsRequest = types.ListThrottledWorkflowIDsRequest{
+		ShardID: ShardID,
+	}
+	AdminListThrottledWorkflowIDsResponse = types.ListThrottledWorkflowIDsResponse{
+		ThrottledWorkflowIDs: []*types.ThrottledWorkflowID{
+			{
+				DomainName:             DomainName,
+				WorkflowID:             WorkflowID,
+				Limit:                  "signal",
+				Count:                  3,
+				LastThrottledTimestamp: &Timestamp1,
+			},
+		},
+	}
+	AdminGetDomainUsageRequest = types.GetDomainUsageRequest{
+		Domain:         DomainName,
+		StartTimestamp: &Timestamp1,
+		EndTimestamp:   &Timestamp2,
+	}
+	AdminGetDomainUsageResponse = types.GetDomainUsageResponse{
+		Usage: []*types.WorkflowUsage{
+			{
+				WorkflowType:    WorkflowTypeName,
+				DecisionTasks:   1,
+				ActivityTasks:   2,
+				LocalActivities: 3,
+				Signals:         4,
+				Timers:          5,
+				HistoryBytes:    6,
+			},
+		},
+	}
 	AdminDescribeWorkflowExecutionRequest = types.AdminDescribeWorkflowExecutionRequest{
-		Domain:    DomainName,
-		Execution: &WorkflowExecution,
+		Domain:             DomainName,
+		Execution:          &WorkflowExecution,
+		RebuildFromHistory: true,
 	}
 	AdminDescribeWorkflowExecutionResponse = types.AdminDescribeWorkflowExecutionResponse{
-		ShardID:                fmt.Sprint(ShardID),
-		HistoryAddr:            HostName,
-		MutableStateInCache:    "MutableStateInCache",
-		MutableStateInDatabase: "MutableStateInDatabase",
+		ShardID:                        fmt.Sprint(ShardID),
+		HistoryAddr:                    HostName,
+		MutableStateInCache:            "MutableStateInCache",
+		MutableStateInDatabase:         "MutableStateInDatabase",
+		MutableStateRebuiltFromHistory: "MutableStateRebuiltFromHistory",
 	}
 	AdminGetDLQReplicationMessagesRequest = types.GetDLQReplicationMessagesRequest{
 		TaskInfos: ReplicationTaskInfoArray,
@@ -96,6 +130,13 @@ var (
 	AdminGetReplicationMessagesResponse = types.GetReplicationMessagesResponse{
 		MessagesByShard: ReplicationMessagesMap,
 	}
+	AdminStreamReplicationMessagesRequest = types.StreamReplicationMessagesRequest{
+		Tokens:      ReplicationTokenArray,
+		ClusterName: ClusterName1,
+	}
+	AdminStreamReplicationMessagesResponse = types.StreamReplicationMessagesResponse{
+		MessagesByShard: ReplicationMessagesMap,
+	}
 	AdminGetWorkflowExecutionRawHistoryV2Request = types.GetWorkflowExecutionRawHistoryV2Request{
 		Domain:            DomainName,
 		Execution:         &WorkflowExecution,
@@ -123,6 +164,7 @@ var (
 		InclusiveEndMessageID: common.Int64Ptr(MessageID1),
 		MaximumPageSize:       PageSize,
 		NextPageToken:         NextPageToken,
+		Filter:                &DLQFilter,
 	}
 	AdminMergeDLQMessagesResponse = types.MergeDLQMessagesResponse{
 		NextPageToken: NextPageToken,
@@ -140,6 +182,7 @@ var (
 		InclusiveEndMessageID: common.Int64Ptr(MessageID1),
 		MaximumPageSize:       PageSize,
 		NextPageToken:         NextPageToken,
+		Filter:                &DLQFilter,
 	}
 	AdminReadDLQMessagesResponse = types.ReadDLQMessagesResponse{
 		Type:                 types.DLQTypeDomain.Ptr(),
@@ -173,6 +216,12 @@ var (
 		EndEventID:    common.Int64Ptr(EventID2),
 		EndVersion:    common.Int64Ptr(EventID2),
 	}
+	AdminImportWorkflowExecutionRequest = types.ImportWorkflowExecutionRequest{
+		Domain:              DomainName,
+		WorkflowExecution:   &WorkflowExecution,
+		VersionHistoryItems: VersionHistoryItemArray,
+		Events:              &DataBlob,
+	}
 	AdminResetQueueRequest = types.ResetQueueRequest{
 		ShardID:     ShardID,
 		ClusterName: ClusterName1,
@@ -79,6 +79,7 @@ var (
 		SecurityToken:                          SecurityToken,
 		DeleteBadBinary:                        common.StringPtr(DeleteBadBinary),
 		FailoverTimeoutInSeconds:               &Duration1,
+		IsGlobalDomain:                         common.BoolPtr(true),
 	}
 	UpdateDomainResponse = types.UpdateDomainResponse{
 		DomainInfo:               &DomainInfo,
@@ -186,6 +187,7 @@ var (
 		StartedTimestamp:          &Timestamp2,
 		Queries:                   WorkflowQueryMap,
 		NextEventID:               EventID3,
+		ContinueAsNewSuggested:    true,
 	}
 	RespondDecisionTaskCompletedRequest = types.RespondDecisionTaskCompletedRequest{
 		TaskToken:                  TaskToken,
@@ -328,7 +330,19 @@ var (
 		StartWorkflowExecutionRequest: &StartWorkflowExecutionRequest,
 	}
 	StartWorkflowExecutionAsyncResponse = types.StartWorkflowExecutionAsyncResponse{}
-	SignalWorkflowExecutionRequest      = types.SignalWorkflowExecutionRequest{
+	ExecuteWorkflowExecutionRequest     = types.ExecuteWorkflowExecutionRequest{
+		StartRequest:    &StartWorkflowExecutionRequest,
+		AttachToRunning: true,
+	}
+	ExecuteWorkflowExecutionResponse = types.ExecuteWorkflowExecutionResponse{
+		RunID:          RunID,
+		Attached:       true,
+		CloseStatus:    &WorkflowExecutionCloseStatus,
+		Result:         Payload1,
+		FailureReason:  common.StringPtr(FailureReason),
+		FailureDetails: FailureDetails,
+	}
+	SignalWorkflowExecutionRequest = types.SignalWorkflowExecutionRequest{
 		Domain:            DomainName,
 		WorkflowExecution: &WorkflowExecution,
 		SignalName:        SignalName,
@@ -380,6 +394,49 @@ var (
 		Identity:            Identity,
 		FirstExecutionRunID: RunID,
 	}
+	PauseWorkflowExecutionRequest = types.PauseWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Reason:            Reason,
+		Identity:          Identity,
+		RequestID:         RequestID,
+	}
+	UnpauseWorkflowExecutionRequest = types.UnpauseWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Reason:            Reason,
+		Identity:          Identity,
+		RequestID:         RequestID,
+	}
+	PauseActivityRequest = types.PauseActivityRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+		Identity:          Identity,
+	}
+	UnpauseActivityRequest = types.UnpauseActivityRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+		Identity:          Identity,
+	}
+	ResetActivityRequest = types.ResetActivityRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		ActivityID:        ActivityID,
+		Identity:          Identity,
+	}
+	UpdateActivityOptionsRequest = types.UpdateActivityOptionsRequest{
+		Domain:                        DomainName,
+		WorkflowExecution:             &WorkflowExecution,
+		ActivityID:                    ActivityID,
+		ScheduleToCloseTimeoutSeconds: &Duration1,
+		ScheduleToStartTimeoutSeconds: &Duration2,
+		StartToCloseTimeoutSeconds:    &Duration1,
+		HeartbeatTimeoutSeconds:       &Duration2,
+		RetryPolicy:                   &RetryPolicy,
+		Identity:                      Identity,
+	}
 	DescribeWorkflowExecutionRequest = types.DescribeWorkflowExecutionRequest{
 		Domain:    DomainName,
 		Execution: &WorkflowExecution,
@@ -441,6 +498,118 @@ var (
 		Archived:      true,
 	}
 
+	ScheduleSpec = types.ScheduleSpec{
+		CronExpression: "0 * * * *",
+		Calendars: []*types.ScheduleCalendarSpec{
+			{Minute: "30", Hour: "9", DayOfWeek: "1-5"},
+		},
+		IntervalInSeconds: &Duration1,
+		StartTimestamp:    &Timestamp1,
+		EndTimestamp:      &Timestamp2,
+		JitterInSeconds:   &Duration2,
+	}
+	ScheduleStartWorkflowAction = types.ScheduleStartWorkflowAction{
+		WorkflowType:                        &WorkflowType,
+		TaskList:                            &TaskList,
+		WorkflowIDPrefix:                    WorkflowID,
+		Input:                               Payload1,
+		ExecutionStartToCloseTimeoutSeconds: &Duration1,
+		TaskStartToCloseTimeoutSeconds:      &Duration2,
+	}
+	SchedulePolicies = types.SchedulePolicies{
+		OverlapPolicy:          &ScheduleOverlapPolicy,
+		CatchUpWindowInSeconds: &Duration3,
+		BufferLimit:            10,
+	}
+	ScheduleRun = types.ScheduleRun{
+		WorkflowExecution: &WorkflowExecution,
+		NominalTimestamp:  &Timestamp1,
+		ActualTimestamp:   &Timestamp2,
+	}
+	CreateScheduleRequest = types.CreateScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Spec:       &ScheduleSpec,
+		Action:     &ScheduleStartWorkflowAction,
+		Policies:   &SchedulePolicies,
+		Identity:   Identity,
+		RequestID:  RequestID,
+	}
+	DescribeScheduleRequest = types.DescribeScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+	}
+	DescribeScheduleResponse = types.DescribeScheduleResponse{
+		Spec:     &ScheduleSpec,
+		Action:   &ScheduleStartWorkflowAction,
+		Policies: &SchedulePolicies,
+		State: &types.ScheduleState{
+			Paused:                 true,
+			Note:                   Reason,
+			LastProcessedTimestamp: &Timestamp3,
+			RunningRuns:            []*types.ScheduleRun{&ScheduleRun},
+			LastRun:                &ScheduleRun,
+			BufferedRuns:           1,
+			PendingBackfills:       2,
+			TotalRuns:              3,
+			SkippedRuns:            4,
+			MissedRuns:             5,
+			FailedStarts:           6,
+			BufferOverruns:         7,
+		},
+		UpcomingRunTimestamps: []int64{Timestamp4, Timestamp5},
+	}
+	UpdateScheduleRequest = types.UpdateScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Spec:       &ScheduleSpec,
+		Action:     &ScheduleStartWorkflowAction,
+		Policies:   &SchedulePolicies,
+		Identity:   Identity,
+	}
+	PauseScheduleRequest = types.PauseScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Reason:     Reason,
+		Identity:   Identity,
+	}
+	UnpauseScheduleRequest = types.UnpauseScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Reason:     Reason,
+		Identity:   Identity,
+	}
+	TriggerScheduleRequest = types.TriggerScheduleRequest{
+		Domain:        DomainName,
+		ScheduleID:    ScheduleID,
+		OverlapPolicy: &ScheduleOverlapPolicy,
+		Identity:      Identity,
+	}
+	BackfillScheduleRequest = types.BackfillScheduleRequest{
+		Domain:         DomainName,
+		ScheduleID:     ScheduleID,
+		StartTimestamp: &Timestamp1,
+		EndTimestamp:   &Timestamp2,
+		OverlapPolicy:  &ScheduleOverlapPolicy,
+		Identity:       Identity,
+	}
+	DeleteScheduleRequest = types.DeleteScheduleRequest{
+		Domain:     DomainName,
+		ScheduleID: ScheduleID,
+		Identity:   Identity,
+	}
+	ListSchedulesRequest = types.ListSchedulesRequest{
+		Domain:        DomainName,
+		PageSize:      PageSize,
+		NextPageToken: NextPageToken,
+	}
+	ListSchedulesResponse = types.ListSchedulesResponse{
+		Schedules: []*types.ScheduleListEntry{
+			{ScheduleID: ScheduleID, WorkflowExecution: &WorkflowExecution},
+		},
+		NextPageToken: NextPageToken,
+	}
+
 	DescribeDomainResponseArray = []*types.DescribeDomainResponse{
 		&DescribeDomainResponse,
 	}
@@ -30,15 +30,19 @@ var (
 	HistoryDescribeHistoryHostRequest  = types.DescribeHistoryHostRequest{}
 	HistoryDescribeHistoryHostResponse = AdminDescribeHistoryHostResponse
 	HistoryDescribeMutableStateRequest = types.DescribeMutableStateRequest{
-		DomainUUID: DomainID,
-		Execution:  &WorkflowExecution,
+		DomainUUID:         DomainID,
+		Execution:          &WorkflowExecution,
+		RebuildFromHistory: true,
 	}
 	HistoryDescribeMutableStateResponse = types.DescribeMutableStateResponse{
-		MutableStateInCache:    "MutableStateInCache",
-		MutableStateInDatabase: "MutableStateInDatabase",
+		MutableStateInCache:            "MutableStateInCache",
+		MutableStateInDatabase:         "MutableStateInDatabase",
+		MutableStateRebuiltFromHistory: "MutableStateRebuiltFromHistory",
 	}
 	HistoryDescribeQueueRequest             = AdminDescribeQueueRequest
 	HistoryDescribeQueueResponse            = AdminDescribeQueueResponse
+	HistoryListThrottledWorkflowIDsRequest  = AdminListThrottledWorkflowIDsRequest
+	HistoryListThrottledWorkflowIDsResponse = AdminListThrottledWorkflowIDsResponse
 	HistoryDescribeWorkflowExecutionRequest = types.HistoryDescribeWorkflowExecutionRequest{
 		DomainUUID: DomainID,
 		Request:    &DescribeWorkflowExecutionRequest,
@@ -173,6 +177,7 @@ var (
 		StartedTimestamp:          &Timestamp2,
 		Queries:                   WorkflowQueryMap,
 		HistorySize:               HistorySizeInBytes,
+		ContinueAsNewSuggested:    true,
 	}
 	HistoryRefreshWorkflowTasksRequest = types.HistoryRefreshWorkflowTasksRequest{
 		DomainUIID: DomainID,
@@ -198,6 +203,30 @@ var (
 		ExternalWorkflowExecution: &WorkflowExecution,
 		ChildWorkflowOnly:         true,
 	}
+	HistoryPauseWorkflowExecutionRequest = types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   DomainID,
+		PauseRequest: &PauseWorkflowExecutionRequest,
+	}
+	HistoryUnpauseWorkflowExecutionRequest = types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     DomainID,
+		UnpauseRequest: &UnpauseWorkflowExecutionRequest,
+	}
+	HistoryPauseActivityRequest = types.HistoryPauseActivityRequest{
+		DomainUUID:   DomainID,
+		PauseRequest: &PauseActivityRequest,
+	}
+	HistoryUnpauseActivityRequest = types.HistoryUnpauseActivityRequest{
+		DomainUUID:     DomainID,
+		UnpauseRequest: &UnpauseActivityRequest,
+	}
+	HistoryResetActivityRequest = types.HistoryResetActivityRequest{
+		DomainUUID:   DomainID,
+		ResetRequest: &ResetActivityRequest,
+	}
+	HistoryUpdateActivityOptionsRequest = types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID:    DomainID,
+		UpdateRequest: &UpdateActivityOptionsRequest,
+	}
 	HistoryResetQueueRequest          = AdminResetQueueRequest
 	HistoryResetStickyTaskListRequest = types.HistoryResetStickyTaskListRequest{
 		DomainUUID: DomainID,
@@ -285,6 +314,7 @@ var (
 		LastWorkerIdentity: Identity,
 		LastFailureDetails: FailureDetails,
 		VersionHistory:     &VersionHistory,
+		Paused:             true,
 	}
 	HistorySyncShardStatusRequest = types.SyncShardStatusRequest{
 		SourceCluster: ClusterName1,
@@ -248,8 +248,8 @@ func ToServiceTransientError(err error) error {
 func FrontendRetry(err error) bool {
 	var sbErr *types.ServiceBusyError
 	if errors.As(err, &sbErr) {
-		// If the service busy error is due to workflow id rate limiting, proxy it to the caller
-		return sbErr.Reason != WorkflowIDRateLimitReason
+		// If the service busy error is due to workflow id rate limiting or pending signals limit, proxy it to the caller
+		return sbErr.Reason != WorkflowIDRateLimitReason && sbErr.Reason != WorkflowPendingSignalsLimitReason
 	}
 	return IsServiceTransientError(err)
 }
@@ -424,6 +424,7 @@ func CreateMatchingPollForDecisionTaskResponse(historyResponse *types.RecordDeci
 		StartedTimestamp:          historyResponse.StartedTimestamp,
 		Queries:                   historyResponse.Queries,
 		TotalHistoryBytes:         historyResponse.HistorySize,
+		ContinueAsNewSuggested:    historyResponse.ContinueAsNewSuggested,
 	}
 	if historyResponse.GetPreviousStartedEventID() != EmptyEventID {
 		matchingResp.PreviousStartedEventID = historyResponse.PreviousStartedEventID
@@ -742,6 +743,8 @@ func GetSizeOfHistoryEvent(event *types.HistoryEvent) uint64 {
 		res += len(event.WorkflowExecutionSignaledEventAttributes.Input)
 	case types.EventTypeWorkflowExecutionTerminated:
 		res += len(event.WorkflowExecutionTerminatedEventAttributes.Details)
+	case types.EventTypeWorkflowExecutionPaused:
+	case types.EventTypeWorkflowExecutionUnpaused:
 	case types.EventTypeWorkflowExecutionContinuedAsNew:
 		res += len(event.WorkflowExecutionContinuedAsNewEventAttributes.Input)
 		if event.WorkflowExecutionContinuedAsNewEventAttributes.Memo != nil {
@@ -913,24 +916,44 @@ func ConvertDynamicConfigMapPropertyToIntMap(dcValue map[string]interface{}) (ma
 			return nil, fmt.Errorf("failed to convert key %v, error: %v", key, err)
 		}
 
-		var intValue int
-		switch value := value.(type) {
-		case float64:
-			intValue = int(value)
-		case int:
-			intValue = value
-		case int32:
-			intValue = int(value)
-		case int64:
-			intValue = int(value)
-		default:
-			return nil, fmt.Errorf("unknown value %v with type %T", value, value)
+		intValue, err := convertDynamicConfigValueToInt(value)
+		if err != nil {
+			return nil, err
 		}
 		intMap[intKey] = intValue
 	}
 	return intMap, nil
 }
 
+// ConvertDynamicConfigMapPropertyToStringIntMap convert a map property from dynamic config to a map
+// whose type for key is string and for value is int
+func ConvertDynamicConfigMapPropertyToStringIntMap(dcValue map[string]interface{}) (map[string]int, error) {
+	intMap := make(map[string]int)
+	for key, value := range dcValue {
+		intValue, err := convertDynamicConfigValueToInt(value)
+		if err != nil {
+			return nil, err
+		}
+		intMap[strings.TrimSpace(key)] = intValue
+	}
+	return intMap, nil
+}
+
+func convertDynamicConfigValueToInt(value interface{}) (int, error) {
+	switch value := value.(type) {
+	case float64:
+		return int(value), nil
+	case int:
+		return value, nil
+	case int32:
+		return int(value), nil
+	case int64:
+		return int(value), nil
+	default:
+		return 0, fmt.Errorf("unknown value %v with type %T", value, value)
+	}
+}
+
 // IsStickyTaskConditionError is error from matching engine
 func IsStickyTaskConditionError(err error) bool {
 	if e, ok := err.(*types.InternalServiceError); ok {
@@ -107,6 +107,11 @@ func TestFrontendRetry(t *testing.T) {
 			err:  &types.ServiceBusyError{Reason: WorkflowIDRateLimitReason},
 			want: false,
 		},
+		{
+			name: "ServiceBusyError due to workflow pending signals limit",
+			err:  &types.ServiceBusyError{Reason: WorkflowPendingSignalsLimitReason},
+			want: false,
+		},
 		{
 			name: "ServiceBusyError not due to workflow id rate limiting",
 			err:  &types.ServiceBusyError{Reason: "some other reason"},
@@ -162,6 +167,22 @@ func TestConvertDynamicConfigMapPropertyToIntMap(t *testing.T) {
 	}
 }
 
+func TestConvertDynamicConfigMapPropertyToStringIntMap(t *testing.T) {
+	dcValue := map[string]interface{}{
+		"a": int(0),
+		"b": int32(1),
+		"c": int64(2),
+		"d": float64(3.0),
+	}
+
+	intMap, err := ConvertDynamicConfigMapPropertyToStringIntMap(dcValue)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"a": 0, "b": 1, "c": 2, "d": 3}, intMap)
+
+	_, err = ConvertDynamicConfigMapPropertyToStringIntMap(map[string]interface{}{"a": "1"})
+	require.Error(t, err)
+}
+
 func TestCreateHistoryStartWorkflowRequest_ExpirationTimeWithCron(t *testing.T) {
 	domainID := uuid.New()
 	request := &types.StartWorkflowExecutionRequest{
@@ -0,0 +1,93 @@
+// The MIT License (MIT)
+// 
+// Copyright (c) 2021 Uber Technologies, Inc.
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/admin/v1/cluster.proto
+
+package adminv1
+
+var yarpcFileDescriptorClosure06d518675d94fcb7 = [][]byte{
+	// uber/cadence/admin/v1/cluster.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5d, 0x6b, 0xe3, 0x46,
+		0x14, 0xc5, 0x56, 0x1c, 0x3b, 0x63, 0xe7, 0x6b, 0xd2, 0xb4, 0x22, 0xa5, 0xc4, 0x55, 0x68, 0x71,
+		0x28, 0x95, 0x48, 0x4a, 0x28, 0xfd, 0xa0, 0xb4, 0x4e, 0x5a, 0xea, 0x42, 0xdb, 0x45, 0xeb, 0x65,
+		0x61, 0x5f, 0xc4, 0x48, 0xba, 0x96, 0x07, 0x4b, 0x33, 0x46, 0x33, 0x72, 0x10, 0xec, 0xe3, 0xbe,
+		0xee, 0xff, 0xd9, 0xfd, 0x15, 0xfb, 0x97, 0x96, 0x99, 0xd1, 0x88, 0x2c, 0x9b, 0xb0, 0x79, 0x9b,
+		0x7b, 0xee, 0x39, 0x57, 0xe7, 0xce, 0xdc, 0x2b, 0x74, 0x56, 0xc5, 0x50, 0x06, 0x09, 0x49, 0x81,
+		0x25, 0x10, 0x90, 0xb4, 0xa0, 0x2c, 0xd8, 0x5c, 0x04, 0x49, 0x5e, 0x09, 0x09, 0xa5, 0xbf, 0x2e,
+		0xb9, 0xe4, 0xf8, 0x58, 0x91, 0xfc, 0x86, 0xe4, 0x6b, 0x92, 0xbf, 0xb9, 0x38, 0x39, 0xcd, 0x38,
+		0xcf, 0x72, 0x08, 0x34, 0x29, 0xae, 0x16, 0x81, 0xa4, 0x05, 0x08, 0x49, 0x8a, 0xb5, 0xd1, 0x79,
+		0xdf, 0xa2, 0xc1, 0xdf, 0x5c, 0xc8, 0x19, 0x5b, 0x70, 0x7c, 0x82, 0x06, 0x34, 0x05, 0x26, 0xa9,
+		0xac, 0xdd, 0xce, 0xb8, 0x33, 0xd9, 0x09, 0xdb, 0xd8, 0x7b, 0x89, 0x06, 0x21, 0x65, 0x99, 0xe6,
+		0x61, 0xb4, 0x55, 0xf2, 0x1c, 0x1a, 0x8e, 0x3e, 0xe3, 0xaf, 0xd1, 0xa8, 0x80, 0x22, 0x86, 0x32,
+		0x4a, 0x78, 0xc5, 0xa4, 0xdb, 0x1d, 0x77, 0x26, 0xbd, 0x70, 0x68, 0xb0, 0x6b, 0x05, 0xe1, 0x9f,
+		0x50, 0xdf, 0x84, 0xc2, 0x75, 0xc6, 0xce, 0x64, 0x78, 0x79, 0xea, 0xdf, 0x6b, 0xda, 0xb7, 0x86,
+		0x42, 0xcb, 0xf7, 0xde, 0x74, 0xd0, 0xde, 0xbf, 0xe6, 0xbc, 0xa4, 0x6b, 0x6d, 0x62, 0x8a, 0x46,
+		0x49, 0x55, 0x96, 0xc0, 0x64, 0xb4, 0xe4, 0x42, 0x6a, 0x33, 0x8f, 0x28, 0x39, 0x6c, 0x44, 0x0a,
+		0xc0, 0xdf, 0xa1, 0xc3, 0x12, 0x48, 0xb2, 0x24, 0x71, 0x0e, 0x91, 0xf5, 0xd6, 0x1d, 0x3b, 0x93,
+		0x9d, 0xf0, 0xa0, 0x4d, 0x34, 0xdf, 0xc5, 0x57, 0xa8, 0x57, 0x52, 0x96, 0x7d, 0xca, 0xbc, 0xbd,
+		0xa5, 0xd0, 0xb0, 0xbd, 0xd7, 0x1d, 0xb4, 0x7f, 0xc3, 0x0b, 0x42, 0xd9, 0x35, 0x49, 0x96, 0xa0,
+		0xbd, 0xff, 0x8c, 0xbe, 0x64, 0x55, 0x11, 0xf1, 0x45, 0x44, 0x25, 0x14, 0x22, 0xa2, 0x2c, 0x4a,
+		0x54, 0x32, 0x8a, 0xeb, 0x88, 0xa6, 0xba, 0x15, 0x27, 0x3c, 0x66, 0x55, 0xf1, 0xff, 0x62, 0xa6,
+		0x08, 0x33, 0xa3, 0x9d, 0xd6, 0xb3, 0x14, 0xff, 0x86, 0xbe, 0x7a, 0x50, 0xcb, 0x48, 0x01, 0xfa,
+		0xe6, 0x9d, 0xf0, 0x8b, 0x7b, 0xd4, 0xff, 0x91, 0x02, 0xbc, 0x77, 0x1d, 0x74, 0x34, 0x5f, 0x96,
+		0x5c, 0xca, 0x1c, 0xd2, 0xe7, 0xbc, 0x5c, 0x2d, 0x72, 0x7e, 0x3b, 0xbb, 0xc1, 0xa7, 0x68, 0x98,
+		0x6a, 0x9b, 0xa6, 0x8a, 0x79, 0x5b, 0x64, 0x20, 0x25, 0x54, 0x84, 0xdb, 0x86, 0xae, 0x4c, 0x76,
+		0x0d, 0xc1, 0x42, 0xb3, 0x14, 0x7f, 0x86, 0x7a, 0x39, 0x2d, 0xa8, 0x74, 0x1d, 0x9d, 0x32, 0x81,
+		0x42, 0xcd, 0x44, 0x6c, 0x69, 0x5f, 0x26, 0xc0, 0xff, 0xa0, 0xa3, 0x9c, 0x08, 0x19, 0x49, 0xeb,
+		0x24, 0x52, 0x83, 0xe9, 0xf6, 0xf4, 0x23, 0x9e, 0xf8, 0x66, 0x6a, 0x7d, 0x3b, 0xb5, 0xfe, 0xdc,
+		0x4e, 0x6d, 0x78, 0xa8, 0x64, 0xad, 0x7f, 0x85, 0x7b, 0xaf, 0xba, 0x68, 0xd7, 0x36, 0xf2, 0x4c,
+		0x90, 0x0c, 0xf0, 0x19, 0xda, 0x6d, 0xad, 0xca, 0x7a, 0x6d, 0xbb, 0x19, 0x59, 0x70, 0x5e, 0xaf,
+		0x01, 0x7f, 0x83, 0xf6, 0x52, 0x48, 0xa8, 0xa0, 0x9c, 0x45, 0x92, 0x88, 0x95, 0x68, 0x6e, 0x6e,
+		0xd7, 0xa2, 0x73, 0x05, 0x2a, 0x1a, 0x49, 0x24, 0xdd, 0x50, 0x59, 0x37, 0x34, 0xc7, 0xd0, 0x2c,
+		0x6a, 0x68, 0xe7, 0xe8, 0x20, 0xe7, 0x09, 0xc9, 0xa3, 0x06, 0xa6, 0x20, 0x9a, 0x8e, 0xf7, 0x35,
+		0xfe, 0x47, 0x0b, 0x63, 0x17, 0xf5, 0x05, 0xcd, 0x18, 0xc9, 0x85, 0xee, 0xd7, 0x09, 0x6d, 0x88,
+		0x3f, 0x47, 0xdb, 0xea, 0x1a, 0x4a, 0xe1, 0x6e, 0xeb, 0x44, 0x13, 0xa9, 0x7e, 0x96, 0x54, 0x48,
+		0x5e, 0xd6, 0x51, 0x5c, 0x4b, 0x10, 0x6e, 0x5f, 0xa7, 0x47, 0x0d, 0x38, 0x55, 0x98, 0xf7, 0x2b,
+		0xc2, 0x4f, 0xa0, 0x14, 0x54, 0x48, 0x35, 0x90, 0x4f, 0x41, 0x4a, 0xca, 0x32, 0x7c, 0x80, 0x9c,
+		0x15, 0xd8, 0x75, 0x56, 0x47, 0xf5, 0x20, 0x1b, 0x92, 0x57, 0xd0, 0xbc, 0xa0, 0x09, 0xbc, 0xdf,
+		0x3f, 0x50, 0xff, 0x05, 0x44, 0x56, 0x25, 0xdc, 0xa3, 0x76, 0x51, 0x1f, 0x98, 0x5a, 0x0b, 0x33,
+		0x01, 0x83, 0xd0, 0x86, 0xde, 0xdb, 0x0e, 0xda, 0xbf, 0x53, 0x42, 0x0f, 0xba, 0x8b, 0xfa, 0x31,
+		0x49, 0x56, 0xc0, 0xd2, 0xa6, 0x86, 0x0d, 0xf1, 0x9f, 0x68, 0x20, 0x8c, 0x45, 0xb3, 0x71, 0xc3,
+		0xcb, 0xf3, 0x07, 0x16, 0xea, 0xe3, 0xa6, 0xc2, 0x56, 0xaa, 0xca, 0x2c, 0x8c, 0x57, 0xbb, 0x97,
+		0x8f, 0x28, 0xd3, 0x74, 0x17, 0xb6, 0xd2, 0xe9, 0x8f, 0x2f, 0xae, 0x32, 0x2a, 0x97, 0x55, 0xec,
+		0x27, 0xbc, 0x08, 0xee, 0xfe, 0x6f, 0xbf, 0xa7, 0x69, 0x1e, 0x64, 0xdc, 0xfc, 0x40, 0xdb, 0x9f,
+		0xef, 0x2f, 0xfa, 0xb0, 0xb9, 0x88, 0xb7, 0x35, 0xfe, 0xc3, 0xfb, 0x01, 0x00, 0x19, 0xd7, 0x32,
+		0x05, 0xa4, 0x05, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+}
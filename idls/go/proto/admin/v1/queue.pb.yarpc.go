@@ -0,0 +1,707 @@
+// The MIT License (MIT)
+// 
+// Copyright (c) 2021 Uber Technologies, Inc.
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/admin/v1/queue.proto
+
+package adminv1
+
+var yarpcFileDescriptorClosure8d7963584a31be0a = [][]byte{
+	// uber/cadence/admin/v1/queue.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6f, 0xdb, 0xc8,
+		0x15, 0x37, 0x25, 0xcb, 0x96, 0x9f, 0x1d, 0x9b, 0x9e, 0x24, 0x8d, 0xe2, 0xc4, 0x88, 0xa2, 0x05,
+		0x12, 0xc1, 0x9b, 0x48, 0xb5, 0xbd, 0x41, 0x16, 0xbb, 0x45, 0x5b, 0x9a, 0x62, 0x2c, 0xae, 0x65,
+		0x49, 0x18, 0x52, 0x49, 0xbc, 0x58, 0x80, 0xa0, 0xa9, 0xb1, 0x43, 0x84, 0x22, 0xb5, 0x24, 0xe5,
+		0x54, 0x1f, 0xa0, 0xd9, 0x7b, 0xb7, 0xd7, 0x5e, 0x8a, 0xde, 0x0a, 0xf4, 0xd4, 0x1e, 0x8a, 0x5e,
+		0x0b, 0xf4, 0xd4, 0x6b, 0x7b, 0xd8, 0x2f, 0xd1, 0x8f, 0x50, 0x70, 0x48, 0x4a, 0x14, 0x45, 0x59,
+		0x74, 0xe2, 0x02, 0x3d, 0xec, 0x4d, 0x7a, 0xfc, 0xcd, 0xe3, 0xef, 0xfd, 0x99, 0xdf, 0xbc, 0x91,
+		0xe0, 0xe1, 0xe0, 0x94, 0xd8, 0x55, 0x4d, 0xed, 0x12, 0x53, 0x23, 0x55, 0xb5, 0xdb, 0xd3, 0xcd,
+		0xea, 0xc5, 0x6e, 0xf5, 0xdb, 0x01, 0x19, 0x90, 0x4a, 0xdf, 0xb6, 0x5c, 0x0b, 0xdd, 0xf6, 0x20,
+		0x95, 0x00, 0x52, 0xa1, 0x90, 0xca, 0xc5, 0xee, 0xd6, 0x83, 0x73, 0xcb, 0x3a, 0x37, 0x48, 0x95,
+		0x82, 0x4e, 0x07, 0x67, 0x55, 0x57, 0xef, 0x11, 0xc7, 0x55, 0x7b, 0x7d, 0x7f, 0xdd, 0x56, 0x71,
+		0xd2, 0x75, 0x5f, 0xf7, 0x1c, 0x6b, 0x56, 0xaf, 0x67, 0x99, 0x01, 0xe2, 0x61, 0x12, 0xe2, 0x8d,
+		0xee, 0xb8, 0x96, 0x3d, 0x0c, 0x20, 0xa5, 0x24, 0xc8, 0x3b, 0xcb, 0x7e, 0x7b, 0x66, 0x58, 0xef,
+		0x7c, 0x4c, 0xe9, 0x87, 0x0c, 0xdc, 0xe2, 0x6d, 0xcb, 0x71, 0x78, 0x63, 0xe0, 0xb8, 0xc4, 0x96,
+		0x55, 0xe7, 0xad, 0x68, 0x9e, 0x59, 0xe8, 0x1e, 0xac, 0x74, 0xad, 0x9e, 0xaa, 0x9b, 0x8a, 0xde,
+		0x2d, 0x30, 0x45, 0xa6, 0xbc, 0x82, 0xf3, 0xbe, 0x41, 0xec, 0xa2, 0x0e, 0xa0, 0xd0, 0x8f, 0x42,
+		0x7e, 0x45, 0xb4, 0x81, 0xab, 0x5b, 0x66, 0x21, 0x53, 0x64, 0xca, 0xab, 0x7b, 0x8f, 0x2a, 0x93,
+		0x31, 0xf7, 0xf5, 0xca, 0xc5, 0x6e, 0xe5, 0x55, 0x00, 0x17, 0x42, 0x34, 0xde, 0x7c, 0x17, 0x37,
+		0xa1, 0x3a, 0xac, 0xb8, 0xaa, 0xf3, 0x56, 0x71, 0x87, 0x7d, 0x52, 0xc8, 0x16, 0x99, 0xf2, 0xfa,
+		0xde, 0xa7, 0x95, 0xc4, 0x0c, 0x56, 0xe2, 0x9c, 0xe5, 0x61, 0x9f, 0xe0, 0xbc, 0x1b, 0x7c, 0x42,
+		0xdb, 0x00, 0xd4, 0x93, 0xe3, 0xaa, 0x2e, 0x29, 0x2c, 0x16, 0x99, 0x72, 0x0e, 0x53, 0xdf, 0x92,
+		0x67, 0x40, 0x77, 0x60, 0x99, 0x3e, 0xd6, 0xbb, 0x85, 0x5c, 0x91, 0x29, 0x67, 0xf1, 0x92, 0xf7,
+		0x55, 0xec, 0xa2, 0x63, 0xb8, 0x75, 0xa1, 0x3b, 0xfa, 0xa9, 0x6e, 0xe8, 0xee, 0x50, 0x19, 0x55,
+		0xa5, 0xb0, 0x44, 0x43, 0xdb, 0xaa, 0xf8, 0x75, 0xab, 0x84, 0x75, 0xab, 0xc8, 0x21, 0x02, 0xdf,
+		0x1c, 0xaf, 0x1b, 0x19, 0x4b, 0x7f, 0x58, 0x84, 0x9f, 0x46, 0x99, 0x4a, 0xae, 0x6a, 0xbb, 0xfc,
+		0x1b, 0xdd, 0xe8, 0x8e, 0xf3, 0x40, 0xbe, 0x1d, 0x10, 0xc7, 0xe5, 0x5c, 0xd7, 0xd6, 0x4f, 0x07,
+		0x2e, 0x71, 0x50, 0x19, 0x58, 0x57, 0xb5, 0xcf, 0x89, 0xab, 0xc4, 0x0b, 0xb0, 0xee, 0xdb, 0x6b,
+		0x61, 0x19, 0xb6, 0x01, 0x6c, 0x7f, 0xb9, 0x87, 0xc9, 0x50, 0xcc, 0x4a, 0x60, 0x11, 0xbb, 0xe8,
+		0x09, 0x20, 0xdd, 0xd4, 0x5d, 0x5d, 0x75, 0x49, 0x57, 0x21, 0x17, 0xc4, 0xa4, 0xb0, 0x2c, 0x0d,
+		0x98, 0x1d, 0x3d, 0x11, 0xbc, 0x07, 0x62, 0x17, 0xbd, 0x67, 0x60, 0x2b, 0x0e, 0x57, 0x47, 0xac,
+		0x68, 0x0e, 0x57, 0xf7, 0xea, 0x89, 0xc5, 0x1d, 0x87, 0x35, 0x55, 0x66, 0x71, 0xe2, 0x35, 0xe3,
+		0x28, 0x71, 0x41, 0x9f, 0xf1, 0x04, 0x95, 0xe0, 0x46, 0x10, 0xbf, 0x3d, 0x30, 0xc3, 0x12, 0xad,
+		0xe0, 0x55, 0xdf, 0x88, 0x07, 0x5e, 0xe4, 0xdf, 0x31, 0xc0, 0xf6, 0x55, 0xdb, 0xd5, 0xbd, 0x77,
+		0x28, 0x9a, 0x65, 0x9e, 0xe9, 0xe7, 0x85, 0xa5, 0x62, 0xb6, 0xbc, 0xba, 0xf7, 0x4d, 0x8a, 0x8e,
+		0x49, 0x53, 0x87, 0x4a, 0x3b, 0xf4, 0xcf, 0x53, 0xf7, 0x82, 0xe9, 0xda, 0x43, 0xbc, 0xd1, 0x9f,
+		0xb4, 0x6e, 0x1d, 0xc0, 0xad, 0x24, 0x20, 0x62, 0x21, 0xfb, 0x96, 0x0c, 0x83, 0xc2, 0x79, 0x1f,
+		0xd1, 0x2d, 0xc8, 0x5d, 0xa8, 0xc6, 0x80, 0x04, 0x85, 0xf2, 0xbf, 0x7c, 0x91, 0xf9, 0x9c, 0x29,
+		0x7d, 0x05, 0xbb, 0x73, 0xd9, 0x39, 0x7d, 0xcb, 0x74, 0x48, 0x24, 0x4d, 0xb7, 0x61, 0x29, 0xc8,
+		0x8f, 0xff, 0x8e, 0x9c, 0xed, 0x65, 0xa6, 0xf4, 0xd7, 0x0c, 0x3c, 0x89, 0x3a, 0xe3, 0x55, 0x53,
+		0x23, 0xc6, 0xb5, 0xb4, 0xdb, 0x29, 0xdc, 0x0d, 0x90, 0x1f, 0xbd, 0xf9, 0xef, 0xf8, 0x8e, 0xa6,
+		0x1e, 0xc4, 0x5a, 0x3a, 0x9b, 0xae, 0xa5, 0x17, 0x67, 0xb4, 0x74, 0x05, 0x6e, 0x6a, 0x5e, 0x1a,
+		0xc7, 0x7c, 0x2d, 0xd3, 0x18, 0xd2, 0x7e, 0xca, 0xe3, 0x4d, 0x2d, 0xda, 0xb0, 0x2d, 0xd3, 0x18,
+		0x96, 0xaa, 0xf0, 0xf4, 0xd2, 0xd4, 0xc5, 0x6b, 0x50, 0xfa, 0x4b, 0x76, 0x32, 0xd9, 0x92, 0x7e,
+		0x6e, 0xaa, 0x3f, 0x26, 0x3b, 0x4d, 0xb2, 0xd1, 0x03, 0x58, 0x75, 0x68, 0xba, 0x14, 0x53, 0xed,
+		0x11, 0xaa, 0xb0, 0x2b, 0x18, 0x7c, 0x53, 0x53, 0xed, 0x11, 0xf4, 0x0b, 0x58, 0x0b, 0x00, 0xba,
+		0xd9, 0x1f, 0xb8, 0x85, 0x65, 0x1a, 0xf4, 0xfd, 0xc4, 0xa0, 0xdb, 0xea, 0xd0, 0xb0, 0xd4, 0x2e,
+		0x0e, 0x5c, 0x8a, 0xde, 0x02, 0x54, 0x80, 0x65, 0xcd, 0x32, 0x5d, 0xdb, 0x32, 0x0a, 0xf9, 0x22,
+		0x53, 0x5e, 0xc3, 0xe1, 0xd7, 0x78, 0xa1, 0xa7, 0xca, 0x36, 0x55, 0xe8, 0x7f, 0x66, 0x80, 0x8b,
+		0xae, 0xc0, 0x44, 0xb3, 0xec, 0x6e, 0xb2, 0xe4, 0xf1, 0x56, 0xaf, 0x6f, 0x10, 0x97, 0xfc, 0xbf,
+		0x57, 0xff, 0x6a, 0xc7, 0x43, 0x03, 0x58, 0xcd, 0x0f, 0xcc, 0x53, 0x5c, 0x0a, 0x0f, 0xce, 0x84,
+		0x87, 0x89, 0x44, 0xea, 0xfe, 0x28, 0x42, 0x97, 0xe3, 0x8d, 0xf1, 0x52, 0x6a, 0x28, 0xd5, 0xe0,
+		0xe0, 0xea, 0xe9, 0x9c, 0xaa, 0xca, 0x7f, 0x18, 0x28, 0x72, 0xfd, 0xbe, 0x31, 0x6c, 0xab, 0x36,
+		0x31, 0x5d, 0xde, 0xb0, 0x1c, 0xd2, 0xb6, 0x0c, 0x5d, 0x1b, 0x46, 0x92, 0xfe, 0x08, 0x36, 0xfc,
+		0xbe, 0x8c, 0xe7, 0xfc, 0x06, 0x35, 0x8f, 0x52, 0xbe, 0x03, 0x9b, 0xb1, 0xfe, 0x1d, 0x9d, 0xa9,
+		0x1b, 0x13, 0xdd, 0x2b, 0x76, 0x51, 0x11, 0xd6, 0x7c, 0x6c, 0xa0, 0xc0, 0xfe, 0xd6, 0x01, 0x6a,
+		0xf3, 0x0f, 0xa8, 0x97, 0x70, 0xb3, 0x4f, 0x49, 0x29, 0x9a, 0xc7, 0x4a, 0xe9, 0x53, 0x5a, 0x34,
+		0x63, 0xeb, 0x33, 0x4a, 0x37, 0x15, 0x04, 0xde, 0xec, 0xc7, 0x4d, 0xa5, 0xef, 0x19, 0xb8, 0x9f,
+		0x1c, 0xb2, 0x37, 0xd9, 0x0c, 0x1c, 0x74, 0x1f, 0x56, 0x82, 0x64, 0x13, 0x3f, 0xd0, 0x3c, 0x1e,
+		0x1b, 0x50, 0x07, 0xd6, 0xce, 0x54, 0xdd, 0x20, 0x5d, 0x45, 0x53, 0x07, 0x8e, 0x7f, 0x14, 0xad,
+		0xef, 0xed, 0xa5, 0x1c, 0xb2, 0x5e, 0xd0, 0xa5, 0xbc, 0xb7, 0x12, 0xaf, 0x9e, 0x8d, 0xbf, 0x94,
+		0xfe, 0xc6, 0xc0, 0x76, 0x32, 0xab, 0x60, 0x13, 0xa0, 0x63, 0xc8, 0xd1, 0xec, 0x50, 0x4a, 0xab,
+		0x7b, 0xcf, 0x67, 0xbc, 0x71, 0x5e, 0x35, 0xb1, 0xef, 0x05, 0x1d, 0xc1, 0x92, 0x43, 0xe3, 0x0d,
+		0x36, 0xc3, 0xfe, 0x95, 0xfc, 0xf9, 0xa9, 0xc2, 0x81, 0x8b, 0xd2, 0x77, 0x0c, 0xec, 0x47, 0x43,
+		0xbd, 0x34, 0x92, 0x48, 0x67, 0xb5, 0x21, 0x4f, 0xd9, 0xd8, 0xc4, 0x2c, 0x30, 0x74, 0xf6, 0xf8,
+		0xec, 0x4a, 0x34, 0x02, 0x8f, 0x78, 0xe4, 0xa5, 0xf4, 0xf7, 0x99, 0xd5, 0xc5, 0xc4, 0x19, 0x18,
+		0xd7, 0x9e, 0xc6, 0xff, 0x51, 0x3b, 0xfc, 0x96, 0x81, 0xcf, 0xd2, 0x24, 0x74, 0x6a, 0xa6, 0xf9,
+		0x26, 0xd8, 0xab, 0x36, 0x31, 0x95, 0xa0, 0xbe, 0x7e, 0x62, 0xf7, 0xaf, 0x98, 0x58, 0x2f, 0x59,
+		0x78, 0x3d, 0xf4, 0xe5, 0xd7, 0xbb, 0xf4, 0xbb, 0x65, 0xb8, 0x13, 0x8f, 0x21, 0xec, 0xcf, 0xf0,
+		0xea, 0xa1, 0x9b, 0x67, 0x56, 0x90, 0xdc, 0xb4, 0x57, 0x0f, 0xef, 0xba, 0xe4, 0x5f, 0x3d, 0xe8,
+		0xc5, 0xe9, 0x37, 0x0c, 0x14, 0x1d, 0x6f, 0x82, 0x53, 0x7c, 0x89, 0x18, 0xe9, 0x76, 0x74, 0x9a,
+		0xf6, 0xbb, 0xf6, 0xf0, 0x9a, 0x46, 0xd5, 0xfa, 0x02, 0xde, 0x76, 0xa6, 0x71, 0x91, 0xc4, 0xfe,
+		0x9a, 0x81, 0x7b, 0x1a, 0x1d, 0x67, 0x92, 0xf9, 0x64, 0x29, 0x1f, 0x3e, 0x05, 0x9f, 0x79, 0xf3,
+		0x64, 0x7d, 0x01, 0xdf, 0xd5, 0x26, 0x31, 0x31, 0x1e, 0xc1, 0xa1, 0x9e, 0xc8, 0x63, 0x31, 0x35,
+		0x8f, 0x79, 0xa3, 0x96, 0xc7, 0xc3, 0x99, 0xc4, 0x44, 0x78, 0xfc, 0x8b, 0x81, 0x2f, 0x6d, 0x7a,
+		0xe8, 0x28, 0x31, 0xd1, 0x1f, 0xd3, 0x0a, 0x55, 0x53, 0x09, 0x67, 0xa4, 0x08, 0xcf, 0x1c, 0xe5,
+		0xf9, 0x3a, 0x05, 0xcf, 0x0f, 0x9a, 0x14, 0xea, 0x0b, 0xf8, 0x99, 0xfd, 0x41, 0x23, 0xc6, 0x1f,
+		0x19, 0x78, 0xa2, 0x7a, 0x9b, 0x42, 0x49, 0x38, 0x7e, 0x92, 0x22, 0xf1, 0x6f, 0xb6, 0x5f, 0xa5,
+		0x88, 0x24, 0xa5, 0x2c, 0xd6, 0x17, 0xf0, 0x63, 0x35, 0x1d, 0xf4, 0x60, 0x0d, 0x60, 0x4c, 0xa5,
+		0xf4, 0xe7, 0x3c, 0x14, 0xa6, 0xf7, 0xa7, 0x2f, 0x12, 0xd1, 0x2b, 0x3b, 0x33, 0x71, 0x65, 0x9f,
+		0xf8, 0xd1, 0x20, 0x73, 0x7d, 0x3f, 0x1a, 0x64, 0xe3, 0x3f, 0x1a, 0xc4, 0xc5, 0x72, 0xf1, 0x5a,
+		0xc4, 0x12, 0x7d, 0x9f, 0x46, 0x2f, 0x72, 0x89, 0xb7, 0xef, 0xd4, 0x7a, 0x11, 0x17, 0xda, 0xf9,
+		0x82, 0xf1, 0x7e, 0x8e, 0x60, 0xf8, 0x6d, 0x53, 0xfb, 0x10, 0xc1, 0x48, 0x20, 0x73, 0x89, 0x62,
+		0xbc, 0x9f, 0xa3, 0x18, 0xcb, 0xa9, 0x89, 0xcc, 0x9d, 0xf2, 0x2f, 0x97, 0x8c, 0x7f, 0x7f, 0xa4,
+		0x64, 0xe4, 0x29, 0xd1, 0x93, 0x6b, 0x93, 0x8c, 0x04, 0xf6, 0x1f, 0xa8, 0x19, 0x7f, 0x62, 0xe0,
+		0xe9, 0x65, 0x9a, 0xe1, 0xbf, 0x29, 0x1a, 0xcb, 0x0a, 0x8d, 0xe5, 0xe8, 0x23, 0x44, 0x23, 0x81,
+		0x7d, 0x59, 0x4d, 0x89, 0x8d, 0xc9, 0x86, 0x95, 0xa4, 0x1a, 0x34, 0x46, 0x07, 0x49, 0x70, 0x83,
+		0x6e, 0xe9, 0xa0, 0x1e, 0xe1, 0x38, 0x51, 0x49, 0xb9, 0x69, 0xc3, 0x09, 0x6d, 0xcd, 0x8d, 0x38,
+		0xdd, 0x79, 0xcf, 0x40, 0x3e, 0x94, 0x0f, 0x74, 0x1b, 0x36, 0x65, 0x4e, 0x3a, 0x52, 0xe4, 0x93,
+		0xb6, 0xa0, 0x88, 0xcd, 0x97, 0x5c, 0x43, 0xac, 0xb1, 0x0b, 0xe8, 0x27, 0x80, 0xc6, 0x66, 0x19,
+		0x73, 0x4d, 0xe9, 0x85, 0x80, 0x59, 0x06, 0xdd, 0x84, 0x8d, 0x88, 0x5d, 0x3c, 0x16, 0x30, 0x9b,
+		0x41, 0x77, 0xe1, 0xf6, 0xd8, 0x88, 0x85, 0x76, 0x43, 0xe4, 0x39, 0x59, 0x6c, 0x35, 0xd9, 0x2c,
+		0xba, 0x07, 0x77, 0xc6, 0x8f, 0x78, 0xdc, 0x92, 0x24, 0x85, 0x6f, 0x74, 0x24, 0x59, 0xc0, 0xec,
+		0xe2, 0xce, 0x3f, 0x12, 0x7e, 0xbc, 0xa5, 0xa4, 0x3e, 0x81, 0x07, 0x13, 0x58, 0x25, 0x89, 0xe2,
+		0x2e, 0x3c, 0x9d, 0x05, 0x92, 0x64, 0x0e, 0xcb, 0x0a, 0x5f, 0x17, 0x1b, 0x35, 0x45, 0x78, 0x2d,
+		0xf0, 0x1d, 0xca, 0x86, 0x41, 0x4f, 0xa0, 0x3c, 0x6b, 0x09, 0xcf, 0x35, 0x79, 0xa1, 0x11, 0x41,
+		0x67, 0x2e, 0x43, 0x4b, 0xe2, 0x61, 0x93, 0x8b, 0xa2, 0xb3, 0xa8, 0x06, 0xbf, 0x9c, 0x85, 0xc6,
+		0x02, 0xdf, 0xc2, 0xb5, 0x80, 0xcf, 0xab, 0x16, 0x3e, 0x3a, 0x6a, 0xb4, 0x5e, 0x8d, 0x17, 0x2b,
+		0x7c, 0xeb, 0xb8, 0xdd, 0x10, 0x64, 0x81, 0x5d, 0x44, 0xcf, 0x60, 0x77, 0x96, 0x17, 0xae, 0xdd,
+		0x6e, 0x9c, 0x28, 0x6d, 0x0e, 0x0b, 0x4d, 0x59, 0xe1, 0x1b, 0x2d, 0x49, 0x50, 0xda, 0xad, 0x86,
+		0xc8, 0x9f, 0xb0, 0xb9, 0x9d, 0xdf, 0x67, 0xe1, 0xde, 0x25, 0x8a, 0x8d, 0x3e, 0x85, 0xc7, 0x09,
+		0x6e, 0x5f, 0x70, 0x62, 0x43, 0xa8, 0x29, 0x3c, 0xd7, 0x91, 0xa2, 0x89, 0x4d, 0xe6, 0x30, 0x01,
+		0xae, 0xb5, 0x8e, 0x39, 0xb1, 0xa9, 0x34, 0x5b, 0xb2, 0xc2, 0xf1, 0xb2, 0xf8, 0x52, 0x60, 0x99,
+		0x2b, 0x2e, 0x13, 0x5e, 0x8b, 0x92, 0x2c, 0xb1, 0x19, 0xf4, 0x33, 0xf8, 0x7c, 0xde, 0x32, 0x2f,
+		0x65, 0x2f, 0xbc, 0x94, 0x71, 0x0d, 0x2c, 0x70, 0xb5, 0x13, 0x05, 0x77, 0x9a, 0x4d, 0xb1, 0x79,
+		0xc8, 0x66, 0xd1, 0x73, 0xd8, 0x4f, 0xbd, 0x3a, 0xf2, 0xda, 0x45, 0xf4, 0x73, 0xf8, 0xe2, 0xca,
+		0xaf, 0x0d, 0xeb, 0x54, 0x63, 0x73, 0x33, 0xba, 0x6f, 0x62, 0x7d, 0xa7, 0xc9, 0x73, 0xb2, 0x70,
+		0xd8, 0xc2, 0xe2, 0xd7, 0x42, 0x8d, 0x5d, 0xda, 0xf9, 0x81, 0x01, 0x74, 0x48, 0xdc, 0x78, 0x6d,
+		0x1e, 0xc2, 0xf6, 0xa1, 0x20, 0x5f, 0x5a, 0x91, 0x47, 0x50, 0x4a, 0x86, 0x48, 0x02, 0x7e, 0x29,
+		0xf2, 0x82, 0x72, 0xd0, 0x91, 0x4e, 0x58, 0x66, 0xb6, 0x2b, 0x6f, 0xa7, 0xb6, 0x3a, 0x32, 0x9b,
+		0x41, 0x15, 0xd8, 0x99, 0xe1, 0xaa, 0xce, 0xe1, 0x9a, 0xd2, 0x7a, 0xd5, 0x14, 0xb0, 0x54, 0x17,
+		0xdb, 0x4a, 0xa3, 0x25, 0xc9, 0x6c, 0x16, 0x3d, 0x86, 0x4f, 0x92, 0xf1, 0x93, 0xd1, 0x2d, 0x1e,
+		0x3c, 0xff, 0xfa, 0xd9, 0xb9, 0xee, 0xbe, 0x19, 0x9c, 0x56, 0x34, 0xab, 0x57, 0x8d, 0xfe, 0x75,
+		0xf3, 0x54, 0xef, 0x1a, 0xd5, 0x73, 0xcb, 0xff, 0xb7, 0x68, 0xf4, 0x3f, 0xd3, 0x97, 0xf4, 0xc3,
+		0xc5, 0xee, 0xe9, 0x12, 0xb5, 0xef, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xe2, 0x06, 0x07,
+		0x8f, 0x1a, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/common.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x51, 0x73, 0xdb, 0xc4,
+		0x13, 0xff, 0x2b, 0x8e, 0x9d, 0x64, 0xed, 0x26, 0xfe, 0x5f, 0x48, 0xe2, 0xa4, 0x04, 0x52, 0xcd,
+		0x30, 0x0d, 0x1d, 0x90, 0x27, 0xee, 0x4b, 0x87, 0x4e, 0x01, 0x27, 0x76, 0x12, 0xb5, 0xc1, 0x36,
+		0xb2, 0x69, 0xa6, 0x30, 0x83, 0xe6, 0x2c, 0x9d, 0xdc, 0xc3, 0xd2, 0x9d, 0x38, 0x9d, 0x9c, 0xf8,
+		0x85, 0xe1, 0x93, 0xf0, 0xc0, 0xd7, 0xe1, 0x91, 0x2f, 0xc4, 0x48, 0x3a, 0xc5, 0x76, 0x71, 0xa6,
+		0x3c, 0x30, 0xbc, 0xdd, 0xed, 0xef, 0xb7, 0xbb, 0xbf, 0x3b, 0xed, 0xae, 0x0e, 0x8e, 0xe2, 0x21,
+		0x11, 0x75, 0x07, 0xbb, 0x84, 0x39, 0xa4, 0x8e, 0x43, 0x5a, 0x9f, 0x9c, 0xd4, 0x1d, 0x1e, 0x04,
+		0x9c, 0x19, 0xa1, 0xe0, 0x92, 0xa3, 0xed, 0x84, 0x61, 0x28, 0x86, 0x81, 0x43, 0x6a, 0x4c, 0x4e,
+		0x0e, 0x3e, 0x1a, 0x71, 0x3e, 0xf2, 0x49, 0x3d, 0xa5, 0x0c, 0x63, 0xaf, 0xee, 0xc6, 0x02, 0x4b,
+		0x9a, 0x3b, 0xe9, 0xaf, 0xe0, 0xff, 0xd7, 0x5c, 0x8c, 0x3d, 0x9f, 0xdf, 0xb4, 0x6f, 0x89, 0x13,
+		0x27, 0x10, 0xfa, 0x18, 0xca, 0x37, 0xca, 0x68, 0x53, 0xb7, 0xa6, 0x1d, 0x69, 0xc7, 0x1b, 0x16,
+		0xe4, 0x26, 0xd3, 0x45, 0x3b, 0x50, 0x12, 0x31, 0x4b, 0xb0, 0x95, 0x14, 0x2b, 0x8a, 0x98, 0x99,
+		0xae, 0xae, 0x43, 0x25, 0x0f, 0x36, 0x98, 0x86, 0x04, 0x21, 0x58, 0x65, 0x38, 0x20, 0x2a, 0x40,
+		0xba, 0x4e, 0x38, 0x4d, 0x47, 0xd2, 0x09, 0x95, 0xd3, 0x7b, 0x39, 0x87, 0xb0, 0xd6, 0xc3, 0x53,
+		0x9f, 0x63, 0x37, 0x81, 0x5d, 0x2c, 0x71, 0x0a, 0x57, 0xac, 0x74, 0xad, 0x3f, 0x87, 0xb5, 0x73,
+		0x4c, 0xfd, 0x58, 0x10, 0xb4, 0x0b, 0x25, 0x41, 0x70, 0xc4, 0x99, 0xf2, 0x57, 0x3b, 0x54, 0x83,
+		0x35, 0x97, 0x48, 0x4c, 0xfd, 0x28, 0x55, 0x58, 0xb1, 0xf2, 0xad, 0xfe, 0x9b, 0x06, 0xab, 0xdf,
+		0x90, 0x80, 0xa3, 0x17, 0x50, 0xf2, 0x28, 0xf1, 0xdd, 0xa8, 0xa6, 0x1d, 0x15, 0x8e, 0xcb, 0x8d,
+		0x4f, 0x8c, 0x25, 0xf7, 0x67, 0x24, 0x54, 0xe3, 0x3c, 0xe5, 0xb5, 0x99, 0x14, 0x53, 0x4b, 0x39,
+		0x1d, 0x5c, 0x43, 0x79, 0xce, 0x8c, 0xaa, 0x50, 0x18, 0x93, 0xa9, 0x52, 0x91, 0x2c, 0x51, 0x03,
+		0x8a, 0x13, 0xec, 0xc7, 0x24, 0x15, 0x50, 0x6e, 0x7c, 0xb8, 0x34, 0xbc, 0x3a, 0xa6, 0x95, 0x51,
+		0xbf, 0x58, 0x79, 0xa6, 0xe9, 0xbf, 0x6b, 0x50, 0xba, 0x24, 0xd8, 0x25, 0x02, 0x7d, 0xf5, 0x8e,
+		0xc4, 0xc7, 0x4b, 0x63, 0x64, 0xe4, 0xff, 0x56, 0xe4, 0x9f, 0x1a, 0x54, 0xfb, 0x04, 0x0b, 0xe7,
+		0x6d, 0x53, 0x4a, 0x41, 0x87, 0xb1, 0x24, 0x11, 0xb2, 0x61, 0x93, 0x32, 0x97, 0xdc, 0x12, 0xd7,
+		0x5e, 0x90, 0xfd, 0x6c, 0x69, 0xd4, 0x77, 0xdd, 0x0d, 0x33, 0xf3, 0x9d, 0x3f, 0xc7, 0x03, 0x3a,
+		0x6f, 0x3b, 0xf8, 0x11, 0xd0, 0xdf, 0x49, 0xff, 0xe2, 0xa9, 0x3c, 0x58, 0x6f, 0x61, 0x89, 0x4f,
+		0x7d, 0x3e, 0x44, 0xe7, 0xf0, 0x80, 0x30, 0x87, 0xbb, 0x94, 0x8d, 0x6c, 0x39, 0x0d, 0xb3, 0x02,
+		0xdd, 0x6c, 0x3c, 0x5a, 0x1a, 0xab, 0xad, 0x98, 0x49, 0x45, 0x5b, 0x15, 0x32, 0xb7, 0xbb, 0x2b,
+		0xe0, 0x95, 0xb9, 0x02, 0xee, 0x65, 0x4d, 0x47, 0xc4, 0x6b, 0x22, 0x22, 0xca, 0x99, 0xc9, 0x3c,
+		0x9e, 0x10, 0x69, 0x10, 0xfa, 0x79, 0x23, 0x24, 0x6b, 0xf4, 0x18, 0xb6, 0x3c, 0x82, 0x65, 0x2c,
+		0x88, 0x3d, 0xc9, 0xa8, 0xaa, 0xe1, 0x36, 0x95, 0x59, 0x05, 0xd0, 0x5f, 0xc1, 0x5e, 0x3f, 0x0e,
+		0x43, 0x2e, 0x24, 0x71, 0xcf, 0x7c, 0x4a, 0x98, 0x54, 0x48, 0x94, 0xf4, 0xea, 0x88, 0xdb, 0x91,
+		0x3b, 0x56, 0x91, 0x8b, 0x23, 0xde, 0x77, 0xc7, 0x68, 0x1f, 0xd6, 0x7f, 0xc2, 0x13, 0x9c, 0x02,
+		0x59, 0xcc, 0xb5, 0x64, 0xdf, 0x77, 0xc7, 0xfa, 0xaf, 0x05, 0x28, 0x5b, 0x44, 0x8a, 0x69, 0x8f,
+		0xfb, 0xd4, 0x99, 0xa2, 0x16, 0x54, 0x29, 0xa3, 0x92, 0x62, 0xdf, 0xa6, 0x4c, 0x12, 0x31, 0xc1,
+		0x99, 0xca, 0x72, 0x63, 0xdf, 0xc8, 0xc6, 0x8b, 0x91, 0x8f, 0x17, 0xa3, 0xa5, 0xc6, 0x8b, 0xb5,
+		0xa5, 0x5c, 0x4c, 0xe5, 0x81, 0xea, 0xb0, 0x3d, 0xc4, 0xce, 0x98, 0x7b, 0x9e, 0xed, 0x70, 0xe2,
+		0x79, 0xd4, 0x49, 0x64, 0xa6, 0xb9, 0x35, 0x0b, 0x29, 0xe8, 0x6c, 0x86, 0x24, 0x69, 0x03, 0x7c,
+		0x4b, 0x83, 0x38, 0x98, 0xa5, 0x2d, 0xbc, 0x37, 0xad, 0x72, 0xb9, 0x4b, 0xfb, 0xe9, 0x2c, 0x0a,
+		0x96, 0x92, 0x04, 0xa1, 0x8c, 0x6a, 0xab, 0x47, 0xda, 0x71, 0xf1, 0x8e, 0xda, 0x54, 0x66, 0xf4,
+		0x02, 0x1e, 0x32, 0xce, 0x6c, 0x91, 0x1c, 0x1d, 0x0f, 0x7d, 0x62, 0x13, 0x21, 0xb8, 0xb0, 0xb3,
+		0x91, 0x12, 0xd5, 0x8a, 0x47, 0x85, 0xe3, 0x0d, 0xab, 0xc6, 0x38, 0xb3, 0x72, 0x46, 0x3b, 0x21,
+		0x58, 0x19, 0x8e, 0x5e, 0xc2, 0x36, 0xb9, 0x0d, 0x69, 0x26, 0x64, 0x26, 0xb9, 0xf4, 0x3e, 0xc9,
+		0x68, 0xe6, 0x95, 0xab, 0xd6, 0x03, 0xd8, 0x33, 0x23, 0xee, 0xa7, 0xc6, 0x0b, 0xc1, 0xe3, 0xb0,
+		0x87, 0x85, 0xa4, 0xe9, 0x70, 0x5e, 0x32, 0x30, 0xd1, 0x97, 0x50, 0x8c, 0x24, 0x96, 0x59, 0xc1,
+		0x6f, 0x36, 0x8e, 0x97, 0x16, 0xe9, 0x62, 0xc0, 0x7e, 0xc2, 0xb7, 0x32, 0x37, 0x7d, 0x02, 0x0f,
+		0x17, 0xd1, 0x33, 0xce, 0x3c, 0x3a, 0x52, 0x0a, 0xd1, 0x35, 0x54, 0x69, 0x0e, 0xdb, 0xa3, 0x04,
+		0xcf, 0x5b, 0xfb, 0xb3, 0x7f, 0x90, 0xe9, 0x4e, 0xba, 0xb5, 0x45, 0x17, 0x80, 0x48, 0xff, 0x43,
+		0x83, 0x83, 0x66, 0x34, 0x65, 0x4e, 0xfe, 0xdb, 0x58, 0xcc, 0x5b, 0x83, 0x35, 0xc2, 0x92, 0x7b,
+		0xce, 0xfe, 0x41, 0xeb, 0x56, 0xbe, 0x45, 0x0d, 0xd8, 0x09, 0x05, 0x71, 0x89, 0x47, 0x19, 0x71,
+		0xed, 0x9f, 0x63, 0x12, 0x13, 0x3b, 0xbd, 0x95, 0xac, 0x94, 0xb7, 0x67, 0xe0, 0xb7, 0x09, 0xd6,
+		0x49, 0x2e, 0xe9, 0x10, 0x20, 0x23, 0xa6, 0xed, 0x5c, 0x48, 0x89, 0x1b, 0xa9, 0x25, 0x6d, 0xd4,
+		0xaf, 0xa1, 0x92, 0xc1, 0x4e, 0xaa, 0x21, 0x2d, 0x92, 0x72, 0xe3, 0x70, 0xe9, 0x01, 0xf3, 0x29,
+		0x61, 0x95, 0x53, 0x97, 0x4c, 0xf5, 0x93, 0x1b, 0xa8, 0xcc, 0x0f, 0x02, 0xb4, 0x0f, 0x3b, 0xed,
+		0xce, 0x59, 0xb7, 0x65, 0x76, 0x2e, 0xec, 0xc1, 0x9b, 0x5e, 0xdb, 0x36, 0x3b, 0xaf, 0x9b, 0x57,
+		0x66, 0xab, 0xfa, 0x3f, 0x74, 0x00, 0xbb, 0x8b, 0xd0, 0xe0, 0xd2, 0x32, 0xcf, 0x07, 0xd6, 0x75,
+		0x55, 0x43, 0xbb, 0x80, 0x16, 0xb1, 0x97, 0xfd, 0x6e, 0xa7, 0xba, 0x82, 0x6a, 0xf0, 0xc1, 0xa2,
+		0xbd, 0x67, 0x75, 0x07, 0xdd, 0xa7, 0xd5, 0xc2, 0x93, 0x5f, 0x60, 0x7b, 0xc9, 0xc7, 0x45, 0x8f,
+		0xe0, 0xd0, 0xec, 0x77, 0xaf, 0x9a, 0x03, 0xb3, 0xdb, 0xb1, 0x2f, 0xac, 0xee, 0x77, 0x3d, 0xbb,
+		0x3f, 0x68, 0x0e, 0xe6, 0x75, 0xdc, 0x4b, 0xb9, 0x6c, 0x37, 0xaf, 0x06, 0x97, 0x6f, 0xaa, 0xda,
+		0xfd, 0x94, 0x96, 0xd5, 0x34, 0x3b, 0xed, 0x56, 0x75, 0xe5, 0xf4, 0x07, 0xd8, 0x73, 0x78, 0xb0,
+		0xec, 0xa6, 0x4e, 0xcb, 0x67, 0xe9, 0x13, 0xa5, 0x97, 0x54, 0x7d, 0x4f, 0xfb, 0xfe, 0x64, 0x44,
+		0xe5, 0xdb, 0x78, 0x68, 0x38, 0x3c, 0xa8, 0xcf, 0x3f, 0x68, 0x3e, 0xa7, 0xae, 0x5f, 0x1f, 0xf1,
+		0xec, 0x99, 0xa2, 0x5e, 0x37, 0xcf, 0x71, 0x48, 0x27, 0x27, 0xc3, 0x52, 0x6a, 0x7b, 0xfa, 0x57,
+		0x00, 0x00, 0x00, 0xff, 0xff, 0x57, 0xd9, 0xb2, 0xe0, 0x01, 0x09, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/history.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0xc7,
+		0x91, 0xf6, 0xec, 0x92, 0xbb, 0xdc, 0x5a, 0x8a, 0x22, 0x5b, 0x14, 0x45, 0xea, 0x97, 0x1a, 0xc9,
+		0x12, 0x4d, 0x51, 0x4b, 0x89, 0x92, 0x25, 0x4b, 0xf2, 0xcf, 0x89, 0x14, 0x09, 0x2f, 0xc0, 0x93,
+		0x74, 0x23, 0x4a, 0xbe, 0x3b, 0x18, 0xd8, 0x1b, 0xee, 0x34, 0xc5, 0x39, 0xee, 0xee, 0xac, 0x67,
+		0x7a, 0xb9, 0xe2, 0xe1, 0xee, 0xe9, 0x1e, 0x02, 0x04, 0x31, 0x1c, 0xc3, 0x08, 0x10, 0x03, 0x31,
+		0x12, 0x04, 0x48, 0x10, 0x27, 0x01, 0x1c, 0x24, 0x08, 0xf2, 0xf7, 0x92, 0x04, 0x08, 0x1c, 0x20,
+		0x81, 0x93, 0xa7, 0xbc, 0xe4, 0x35, 0x08, 0xe2, 0xb7, 0x3c, 0xc4, 0x79, 0x0b, 0x10, 0x4c, 0x4f,
+		0xcf, 0xee, 0xce, 0x4c, 0xf7, 0x4c, 0xcf, 0x92, 0xb2, 0x13, 0x58, 0x6f, 0x9c, 0x9e, 0xaa, 0x9a,
+		0xaf, 0xbb, 0xab, 0xaa, 0xab, 0xab, 0x6a, 0x09, 0x27, 0x5b, 0xeb, 0xd8, 0x9e, 0xaf, 0xea, 0x06,
+		0x6e, 0x54, 0xf1, 0xbc, 0xde, 0x34, 0xe7, 0xb7, 0x2f, 0xce, 0x6f, 0x9a, 0x0e, 0xb1, 0xec, 0x9d,
+		0x52, 0xd3, 0xb6, 0x88, 0x85, 0x0e, 0xb8, 0x24, 0x25, 0x46, 0x52, 0xd2, 0x9b, 0x66, 0x69, 0xfb,
+		0xe2, 0xe1, 0xe3, 0x0f, 0x2d, 0xeb, 0x61, 0x0d, 0xcf, 0x53, 0x92, 0xf5, 0xd6, 0xc6, 0xbc, 0xd1,
+		0xb2, 0x75, 0x62, 0x5a, 0x0d, 0x8f, 0xe9, 0xf0, 0x89, 0xf0, 0x7b, 0x62, 0xd6, 0xb1, 0x43, 0xf4,
+		0x7a, 0x93, 0x11, 0x4c, 0xf3, 0x3e, 0x5c, 0xb5, 0xea, 0xf5, 0x8e, 0x08, 0x95, 0x47, 0x41, 0x74,
+		0x67, 0xab, 0x66, 0x3a, 0x24, 0x8e, 0xa6, 0x6d, 0xd9, 0x5b, 0x1b, 0x35, 0xab, 0xed, 0xd1, 0xa8,
+		0xb7, 0x20, 0xff, 0xb2, 0x37, 0x21, 0x74, 0x0d, 0x72, 0x78, 0x1b, 0x37, 0x88, 0x33, 0xa9, 0x4c,
+		0x67, 0x67, 0x8a, 0x0b, 0x27, 0x4b, 0x9c, 0xb9, 0x95, 0x18, 0xf5, 0xb2, 0x4b, 0xa9, 0x31, 0x06,
+		0xf5, 0xc3, 0xab, 0x30, 0xdc, 0xfb, 0x02, 0x4d, 0xc1, 0x10, 0x7d, 0x55, 0x31, 0x8d, 0x49, 0x65,
+		0x5a, 0x99, 0xc9, 0x6a, 0x79, 0xfa, 0x5c, 0x36, 0xd0, 0x35, 0x00, 0xef, 0x95, 0x3b, 0xe9, 0xc9,
+		0xcc, 0xb4, 0x32, 0x53, 0x5c, 0x38, 0x5c, 0xf2, 0x56, 0xa4, 0xe4, 0xaf, 0x48, 0x69, 0xcd, 0x5f,
+		0x11, 0xad, 0x40, 0xa9, 0xdd, 0x67, 0x34, 0x09, 0xf9, 0x6d, 0x6c, 0x3b, 0xa6, 0xd5, 0x98, 0xcc,
+		0x7a, 0x42, 0xd9, 0x23, 0x3a, 0x04, 0x79, 0x77, 0xf2, 0xee, 0xe7, 0x06, 0xe8, 0x9b, 0x9c, 0xfb,
+		0x58, 0x36, 0xd0, 0x97, 0x14, 0x38, 0xe7, 0x4f, 0xb9, 0x82, 0x1f, 0xe1, 0x6a, 0xcb, 0xdd, 0x87,
+		0x8a, 0x43, 0x74, 0x9b, 0x60, 0xa3, 0xe2, 0x21, 0xd1, 0x09, 0xb1, 0xcd, 0xf5, 0x16, 0xc1, 0xce,
+		0xe4, 0x20, 0xc5, 0xf3, 0x3c, 0x77, 0xea, 0xaf, 0x30, 0x39, 0xcb, 0xbe, 0x98, 0x7b, 0x9e, 0x14,
+		0x3a, 0xe5, 0x9b, 0x1d, 0x19, 0x2f, 0x3f, 0xa5, 0x9d, 0x6d, 0xcb, 0x91, 0xa2, 0xaf, 0x2a, 0x70,
+		0x9e, 0x03, 0xaf, 0x6a, 0xd5, 0x9b, 0x35, 0xcc, 0x05, 0x98, 0xa3, 0x00, 0x5f, 0x94, 0x03, 0xb8,
+		0xe4, 0xcb, 0x89, 0x42, 0x7c, 0xa6, 0x2d, 0x4b, 0x8c, 0xde, 0x56, 0x60, 0x96, 0x03, 0x72, 0x43,
+		0x37, 0x6b, 0x3c, 0x84, 0x79, 0x8a, 0xf0, 0x86, 0x1c, 0xc2, 0x15, 0x2a, 0x24, 0x0a, 0xef, 0x4c,
+		0x5b, 0x8a, 0x12, 0x7d, 0x85, 0xbf, 0x80, 0xae, 0x6e, 0x19, 0x15, 0xab, 0x45, 0xa2, 0xf0, 0x86,
+		0x28, 0xbc, 0x17, 0xe4, 0xe0, 0xb9, 0x6a, 0x67, 0xdc, 0x69, 0x91, 0x28, 0xc0, 0x99, 0xb6, 0x24,
+		0x2d, 0x7a, 0x4b, 0x81, 0x19, 0x03, 0x57, 0x4d, 0x87, 0x02, 0x73, 0xb5, 0xd4, 0xa9, 0x6e, 0x62,
+		0xa3, 0xc5, 0x5d, 0xbc, 0x02, 0x45, 0x77, 0x8d, 0x8b, 0xee, 0x16, 0x13, 0xb2, 0xa6, 0x3b, 0x5b,
+		0xf7, 0x7c, 0x11, 0x51, 0x64, 0xa7, 0x0d, 0x09, 0x3a, 0xf4, 0xba, 0x02, 0x67, 0x42, 0xa8, 0x44,
+		0x36, 0x01, 0x14, 0xd3, 0xd5, 0x64, 0x4c, 0x22, 0x73, 0x50, 0x8d, 0x44, 0x2a, 0xce, 0x2a, 0xc5,
+		0x18, 0x41, 0x51, 0x72, 0x95, 0x62, 0xf4, 0x3f, 0xb0, 0x4a, 0x42, 0xd5, 0x7f, 0x33, 0x82, 0x2a,
+		0x46, 0xb3, 0x86, 0x29, 0xaa, 0xe7, 0x12, 0x51, 0x89, 0x95, 0xea, 0x94, 0x91, 0x4c, 0x86, 0x3e,
+		0xab, 0xc0, 0xd3, 0x41, 0x4c, 0x22, 0x4b, 0xdc, 0x47, 0x01, 0x5d, 0x49, 0x04, 0x24, 0x32, 0xc2,
+		0x93, 0x46, 0x12, 0x11, 0xdd, 0x36, 0xbd, 0x4a, 0xcc, 0x6d, 0x93, 0xec, 0x24, 0x2a, 0xf7, 0x48,
+		0xcc, 0xb6, 0xdd, 0x64, 0x42, 0x92, 0x94, 0x5b, 0x97, 0xa0, 0xa3, 0xca, 0x1d, 0x42, 0x25, 0x52,
+		0xee, 0xfd, 0x31, 0xca, 0x1d, 0xc0, 0x24, 0x54, 0x6e, 0x3d, 0x91, 0x8a, 0xb3, 0x4a, 0x31, 0xca,
+		0x3d, 0x2a, 0xb9, 0x4a, 0x71, 0xca, 0xad, 0x4b, 0xd0, 0x51, 0x45, 0x0a, 0xa2, 0x12, 0x29, 0xd2,
+		0x58, 0x8c, 0x22, 0xf5, 0x42, 0x12, 0x2a, 0x92, 0x9e, 0x44, 0x44, 0x2d, 0x2d, 0x08, 0x26, 0xc6,
+		0xd2, 0x50, 0x8c, 0xa5, 0xf5, 0xe2, 0x89, 0xb1, 0x34, 0x3d, 0x99, 0x0c, 0xb5, 0xe1, 0xb8, 0x0b,
+		0xc2, 0x16, 0x6b, 0xcf, 0x01, 0x0a, 0xe4, 0x02, 0x17, 0x88, 0x2b, 0xd5, 0x16, 0xaa, 0xcd, 0x11,
+		0x22, 0x7e, 0x8d, 0x5e, 0x83, 0xa3, 0xde, 0x87, 0x37, 0x4c, 0x9b, 0xf7, 0xd9, 0x71, 0xfa, 0xd9,
+		0x92, 0xf8, 0xb3, 0x2b, 0x2e, 0x5f, 0xf4, 0xa3, 0x53, 0x44, 0xf4, 0x12, 0x7d, 0x5d, 0x81, 0xf9,
+		0x90, 0x8a, 0xea, 0x8d, 0x2a, 0xae, 0x55, 0x6c, 0xfc, 0x5a, 0x0b, 0x3b, 0xdc, 0xd9, 0x1f, 0xa4,
+		0x30, 0x5e, 0x4a, 0xd6, 0x54, 0x2a, 0x49, 0xf3, 0x05, 0x45, 0x71, 0xcd, 0xea, 0xd2, 0xd4, 0xe8,
+		0x7b, 0x0a, 0x5c, 0x66, 0x98, 0x7c, 0x88, 0x72, 0x4a, 0x3c, 0x41, 0xd1, 0x2e, 0x71, 0xd1, 0xb2,
+		0xaf, 0x79, 0x9f, 0x96, 0xd1, 0xe8, 0x92, 0x9d, 0x8a, 0x03, 0x7d, 0x5e, 0x81, 0xb3, 0xbc, 0xe5,
+		0xe5, 0x01, 0x3d, 0x24, 0xa9, 0xdd, 0x4b, 0x4c, 0x42, 0x82, 0x76, 0x0b, 0xc8, 0xd0, 0xff, 0xc0,
+		0x09, 0x4f, 0xc9, 0xc4, 0x48, 0x26, 0x29, 0x92, 0x8b, 0x62, 0x3d, 0x13, 0x43, 0xf0, 0x14, 0x58,
+		0xf4, 0xed, 0xcf, 0x28, 0x70, 0x9a, 0x6d, 0x1e, 0x53, 0x74, 0xc1, 0xa6, 0x4d, 0x51, 0x04, 0xcf,
+		0x72, 0x11, 0x78, 0xc2, 0x3d, 0x7d, 0x17, 0x6c, 0xd3, 0x74, 0x35, 0x81, 0x06, 0xfd, 0x1f, 0x4c,
+		0xd7, 0x75, 0x7b, 0x0b, 0xdb, 0x15, 0x1b, 0x57, 0x2d, 0xdb, 0xe0, 0x81, 0x38, 0x4c, 0x41, 0x2c,
+		0x70, 0x41, 0xfc, 0x2b, 0x65, 0xd6, 0x18, 0x6f, 0x14, 0xc1, 0xb1, 0x7a, 0x1c, 0x01, 0xfa, 0xb2,
+		0x02, 0x73, 0xbc, 0xfb, 0x89, 0xf9, 0xb0, 0xa1, 0x73, 0x17, 0xe4, 0x48, 0x9a, 0xf0, 0xf5, 0x1e,
+		0x13, 0x23, 0x13, 0xbe, 0x0a, 0x68, 0xd1, 0xd7, 0x14, 0x28, 0xf1, 0x22, 0x6c, 0x6c, 0xd7, 0xcd,
+		0x86, 0xce, 0xf5, 0x0b, 0x47, 0x63, 0xfc, 0x42, 0x34, 0xc4, 0xee, 0x08, 0xe2, 0xf8, 0x85, 0xb6,
+		0x34, 0x35, 0xfa, 0xbe, 0x02, 0x97, 0x79, 0x57, 0xa9, 0x44, 0x2f, 0x76, 0x8c, 0xa2, 0xbd, 0x25,
+		0x79, 0xa3, 0x4a, 0x72, 0x65, 0xf3, 0xed, 0x74, 0x2c, 0x22, 0x0d, 0x10, 0x1b, 0xe5, 0xf1, 0x34,
+		0x1a, 0x20, 0x36, 0xd0, 0x99, 0xb6, 0x24, 0x2d, 0xfa, 0x83, 0x02, 0xcb, 0x21, 0x8f, 0x8b, 0x1f,
+		0x11, 0x6c, 0x37, 0xf4, 0x5a, 0x85, 0x83, 0xdc, 0x6c, 0x98, 0xc4, 0xe4, 0x2b, 0xc6, 0x09, 0x0a,
+		0xfd, 0x5e, 0xb2, 0x0b, 0x5e, 0x66, 0xf2, 0x23, 0xf3, 0x29, 0xfb, 0xc2, 0xa3, 0x13, 0x7a, 0xd1,
+		0xde, 0x95, 0x04, 0xf4, 0x7b, 0x05, 0x16, 0x53, 0x4c, 0x53, 0xe4, 0xb1, 0xa6, 0xe9, 0x1c, 0xef,
+		0xee, 0x62, 0x8e, 0x22, 0x67, 0x76, 0xc3, 0xee, 0x9f, 0x1d, 0x7d, 0xa0, 0xc0, 0x0b, 0x71, 0xd3,
+		0x49, 0xb6, 0x93, 0x93, 0x74, 0x62, 0xab, 0xdc, 0x89, 0x09, 0xc1, 0x24, 0xda, 0xcb, 0x55, 0xdc,
+		0x1f, 0x2b, 0x8d, 0x03, 0xb8, 0xa9, 0x93, 0x06, 0x31, 0x1b, 0x2d, 0x6c, 0x54, 0x74, 0xa7, 0xd2,
+		0xc0, 0xed, 0xe8, 0x3c, 0xd4, 0x98, 0x38, 0x80, 0x93, 0x41, 0x61, 0xe2, 0x6e, 0x3a, 0xb7, 0x71,
+		0x9b, 0x13, 0x07, 0xb4, 0x53, 0x71, 0xa0, 0x9f, 0x2b, 0x70, 0x8d, 0x46, 0x93, 0x95, 0xea, 0xa6,
+		0x59, 0x33, 0x52, 0xda, 0xcf, 0x29, 0x0a, 0xfd, 0x65, 0x2e, 0x74, 0x1a, 0x4a, 0x2e, 0xb9, 0x42,
+		0xd3, 0x18, 0xcd, 0x25, 0x27, 0x3d, 0x1b, 0xfa, 0x91, 0x02, 0x57, 0x12, 0x26, 0x21, 0xb2, 0x8e,
+		0xd3, 0x74, 0x06, 0xcb, 0x69, 0x67, 0x20, 0x32, 0x89, 0x0b, 0x4e, 0x4a, 0x1e, 0xf4, 0x6d, 0x05,
+		0x2e, 0x0a, 0x51, 0x0b, 0xe3, 0xfc, 0xa7, 0x29, 0xec, 0x9b, 0xfc, 0x30, 0x84, 0xfb, 0x75, 0x61,
+		0xe0, 0x3f, 0x57, 0x4d, 0x41, 0x8f, 0xbe, 0xab, 0xc0, 0x25, 0x21, 0xdc, 0x98, 0x4b, 0xe4, 0x99,
+		0x18, 0x25, 0xe7, 0x03, 0x8e, 0xb9, 0x4e, 0x96, 0xaa, 0xa9, 0x38, 0xd0, 0xbb, 0x0a, 0x5c, 0x48,
+		0xad, 0x19, 0x67, 0x29, 0xe2, 0x7f, 0x49, 0x81, 0x58, 0xa4, 0x14, 0xe7, 0xaa, 0x29, 0xf4, 0xe1,
+		0x3d, 0x05, 0x16, 0xc4, 0x0b, 0x2c, 0x3c, 0x84, 0x67, 0x28, 0xda, 0xc5, 0x34, 0xeb, 0x2b, 0x3c,
+		0x89, 0xcf, 0x57, 0xd3, 0x30, 0xa0, 0xef, 0xc4, 0xa9, 0x44, 0xcc, 0xa5, 0xf9, 0x99, 0xd4, 0x90,
+		0xc5, 0xd7, 0x67, 0x01, 0x64, 0xd1, 0x45, 0xda, 0x8d, 0xcd, 0xc4, 0x90, 0x63, 0x22, 0xc9, 0xd9,
+		0x98, 0xd8, 0x4c, 0x80, 0x39, 0x26, 0x9c, 0x9c, 0xaf, 0xa6, 0x63, 0xa1, 0x87, 0xa6, 0x17, 0x8a,
+		0xf7, 0x1b, 0xf1, 0x9c, 0x8b, 0x39, 0x34, 0xbd, 0x88, 0xbb, 0x9f, 0x50, 0xe7, 0xaa, 0xd3, 0x1f,
+		0x2b, 0xfa, 0x85, 0x02, 0xd7, 0x25, 0x26, 0x24, 0xb2, 0xd1, 0x39, 0x3a, 0x9b, 0x72, 0x3f, 0xb3,
+		0x11, 0x19, 0xeb, 0x65, 0xa7, 0x0f, 0x3e, 0xf4, 0x43, 0x05, 0x9e, 0x8d, 0x9b, 0x80, 0xf8, 0xfe,
+		0x74, 0x3e, 0xe6, 0x00, 0x12, 0x82, 0x10, 0xdf, 0xa3, 0x2e, 0xe0, 0x94, 0x3c, 0xd4, 0xe1, 0xb4,
+		0x9a, 0x0e, 0xb6, 0x49, 0x17, 0xb8, 0x83, 0x75, 0xbb, 0xba, 0xd9, 0x03, 0x33, 0x8a, 0xbb, 0x14,
+		0x63, 0xbd, 0xf7, 0xa9, 0x38, 0x1f, 0xc1, 0x3d, 0x2a, 0xac, 0xfb, 0x45, 0x8e, 0xf5, 0xb6, 0xd2,
+		0x30, 0x2c, 0x0e, 0x03, 0x74, 0x81, 0xa8, 0x6f, 0x8c, 0xc0, 0x59, 0xd9, 0xd3, 0x6b, 0x05, 0xf6,
+		0x75, 0xe6, 0x48, 0x76, 0x9a, 0x98, 0xd6, 0x02, 0x45, 0x95, 0x45, 0x5f, 0xe8, 0xda, 0x4e, 0x13,
+		0x6b, 0xc3, 0xed, 0x9e, 0x27, 0xf4, 0x2a, 0x1c, 0x6c, 0xea, 0xb6, 0xbb, 0x22, 0xbd, 0x46, 0xb7,
+		0x61, 0xb1, 0xf2, 0xe1, 0x0c, 0x57, 0xde, 0x5d, 0xca, 0xd1, 0x63, 0x13, 0x1b, 0x96, 0x76, 0xa0,
+		0x19, 0x1d, 0x44, 0xd7, 0xa1, 0x40, 0x33, 0x32, 0x35, 0xd3, 0x21, 0xb4, 0xb0, 0x58, 0x5c, 0x38,
+		0xc6, 0x4f, 0x79, 0xe8, 0xce, 0xd6, 0xaa, 0xe9, 0x10, 0x6d, 0x88, 0xb0, 0xbf, 0xd0, 0x02, 0x0c,
+		0x9a, 0x8d, 0x66, 0x8b, 0xd0, 0xb2, 0x63, 0x71, 0xe1, 0xa8, 0x00, 0xc9, 0x4e, 0xcd, 0xd2, 0x0d,
+		0xcd, 0x23, 0x45, 0x3a, 0x4c, 0x87, 0x42, 0x8e, 0x0a, 0xb1, 0x2a, 0xd5, 0x9a, 0xe5, 0x60, 0xea,
+		0xbf, 0xad, 0x16, 0x61, 0x75, 0xc8, 0xa9, 0x48, 0x5d, 0xf4, 0x16, 0xab, 0x24, 0x6b, 0x47, 0x71,
+		0x60, 0xed, 0xd7, 0xac, 0x25, 0x97, 0x7f, 0xcd, 0x63, 0x47, 0xaf, 0xc0, 0x91, 0x6e, 0xda, 0x3b,
+		0x2a, 0x3d, 0x97, 0x24, 0xfd, 0x10, 0xf1, 0x93, 0xd9, 0x21, 0xc1, 0x37, 0xe0, 0x70, 0x37, 0xc2,
+		0xee, 0xce, 0xc2, 0x6e, 0x35, 0x2a, 0xa6, 0x41, 0x4b, 0x7f, 0x05, 0xed, 0x50, 0x87, 0xa2, 0xb3,
+		0xce, 0x5a, 0xab, 0x51, 0x36, 0x50, 0x19, 0x0a, 0xcc, 0x55, 0x5a, 0x36, 0xad, 0xc3, 0x8d, 0x2c,
+		0x9c, 0xe3, 0xbb, 0x76, 0x26, 0x80, 0x86, 0xd0, 0x65, 0x9f, 0x45, 0xeb, 0x72, 0xa3, 0x32, 0x8c,
+		0x75, 0x71, 0xb8, 0xee, 0xaa, 0x65, 0x63, 0x56, 0x3c, 0xe3, 0xef, 0xc1, 0x8a, 0x47, 0xa3, 0x8d,
+		0x76, 0xd8, 0xd8, 0x08, 0xd2, 0x60, 0xa2, 0xa6, 0xbb, 0x77, 0x3e, 0x2f, 0x9c, 0xa1, 0xd3, 0xc1,
+		0x4e, 0xab, 0x46, 0x58, 0xe1, 0x2b, 0x7e, 0x4f, 0xc7, 0x5d, 0xde, 0xa5, 0x0e, 0xab, 0x46, 0x39,
+		0xd1, 0x35, 0x98, 0xb2, 0x6c, 0xf3, 0xa1, 0xe9, 0x39, 0xda, 0xd0, 0x2a, 0x15, 0xe9, 0x2a, 0x4d,
+		0xf8, 0x04, 0xa1, 0x45, 0x3a, 0x0c, 0x43, 0xa6, 0x81, 0x1b, 0xc4, 0x24, 0x3b, 0xb4, 0xa2, 0x54,
+		0xd0, 0x3a, 0xcf, 0xe8, 0x12, 0x4c, 0x6c, 0x98, 0xb6, 0x43, 0xa2, 0x32, 0xf7, 0x51, 0xca, 0x03,
+		0xf4, 0x6d, 0x48, 0xe0, 0x12, 0x0c, 0xdb, 0x98, 0xd8, 0x3b, 0x95, 0xa6, 0x55, 0x33, 0xab, 0x3b,
+		0xac, 0x0a, 0x33, 0x2d, 0xb8, 0xa0, 0x12, 0x7b, 0xe7, 0x2e, 0xa5, 0xd3, 0x8a, 0x76, 0xf7, 0x01,
+		0x4d, 0x42, 0x5e, 0x27, 0x04, 0xd7, 0x9b, 0x84, 0x56, 0x4c, 0x06, 0x35, 0xff, 0x11, 0x2d, 0xc1,
+		0x7e, 0xfc, 0xa8, 0x69, 0x7a, 0x8a, 0xe3, 0x15, 0xf5, 0x47, 0x13, 0x8b, 0xfa, 0x23, 0x5d, 0x16,
+		0x5a, 0xd9, 0x3f, 0x05, 0xfb, 0xaa, 0xb6, 0x6b, 0x0d, 0xac, 0xa2, 0x43, 0x2b, 0x0e, 0x05, 0x6d,
+		0xd8, 0x1d, 0xf4, 0xab, 0x3c, 0xe8, 0xdf, 0xe1, 0x88, 0x37, 0xfb, 0x60, 0xf5, 0x6b, 0x5d, 0xaf,
+		0x6e, 0x59, 0x1b, 0x1b, 0xac, 0x28, 0x10, 0xa3, 0xd4, 0x93, 0x94, 0xbb, 0xb7, 0xf0, 0xb5, 0xe8,
+		0xb1, 0xa2, 0xf3, 0x30, 0x50, 0xc7, 0x75, 0x8b, 0xa5, 0xf3, 0xa7, 0xf8, 0x89, 0x3e, 0x5c, 0xb7,
+		0x34, 0x4a, 0x86, 0x34, 0x18, 0x8b, 0x78, 0x6c, 0x96, 0x93, 0x7f, 0x9a, 0x7f, 0x36, 0x86, 0x3c,
+		0xac, 0x36, 0xea, 0x84, 0x46, 0xd0, 0x7d, 0x98, 0x68, 0xda, 0x78, 0xbb, 0xa2, 0xb7, 0x88, 0xe5,
+		0xea, 0x1f, 0x26, 0x95, 0xa6, 0x65, 0x36, 0x88, 0x9f, 0x65, 0x17, 0xed, 0x97, 0x83, 0xc9, 0x5d,
+		0x4a, 0xa7, 0x1d, 0x70, 0xf9, 0x6f, 0xb6, 0x88, 0xd5, 0x33, 0x88, 0x2e, 0x41, 0x6e, 0x13, 0xeb,
+		0x06, 0xb6, 0x59, 0xfa, 0xfb, 0x08, 0xbf, 0xa9, 0x83, 0x92, 0x68, 0x8c, 0x14, 0xad, 0xc2, 0xb8,
+		0xb7, 0xd0, 0xdd, 0x5a, 0x1e, 0xdd, 0xd7, 0x43, 0x89, 0xfb, 0x8a, 0x28, 0x5f, 0xa7, 0x2e, 0x47,
+		0xf7, 0xf6, 0x7f, 0x61, 0xb4, 0xa9, 0xdb, 0xc4, 0xf4, 0xaf, 0xe7, 0x1b, 0xe6, 0xc3, 0xc9, 0x49,
+		0xda, 0x61, 0xf2, 0x6f, 0xbb, 0x69, 0xb3, 0x70, 0xfd, 0xbb, 0x27, 0x74, 0x89, 0xca, 0x5c, 0x6e,
+		0x10, 0x7b, 0x47, 0xdb, 0xdf, 0x0c, 0x8e, 0xa2, 0x63, 0x00, 0x7e, 0x52, 0xc7, 0x34, 0x68, 0x3a,
+		0xb9, 0xa0, 0x15, 0xd8, 0x48, 0xd9, 0x38, 0xbc, 0x08, 0xe3, 0x3c, 0x39, 0x68, 0x14, 0xb2, 0x5b,
+		0x78, 0x87, 0x9e, 0x57, 0x05, 0xcd, 0xfd, 0x13, 0x8d, 0xc3, 0xe0, 0xb6, 0x5e, 0x6b, 0x79, 0x2d,
+		0x2b, 0x05, 0xcd, 0x7b, 0xb8, 0x9e, 0x79, 0x4e, 0x51, 0xdf, 0x55, 0xe0, 0x19, 0xf9, 0xcb, 0xd1,
+		0x65, 0xc8, 0x31, 0xf7, 0xa2, 0x48, 0xb8, 0x17, 0x46, 0x8b, 0x56, 0x60, 0x3a, 0xbe, 0x3a, 0x6e,
+		0x1a, 0x14, 0x58, 0x56, 0x3b, 0x2a, 0x2e, 0x6c, 0x97, 0x0d, 0xf5, 0x1b, 0x0a, 0x9c, 0x91, 0x8c,
+		0xb1, 0xae, 0x40, 0xde, 0x77, 0xac, 0x8a, 0x84, 0x63, 0xf5, 0x89, 0xf7, 0x0c, 0xaa, 0x05, 0x33,
+		0xd2, 0x17, 0x8c, 0x25, 0x18, 0x66, 0x67, 0x5b, 0x37, 0xce, 0x18, 0x11, 0xd8, 0x0c, 0x3b, 0xca,
+		0x68, 0x98, 0x51, 0x24, 0xdd, 0x07, 0xf5, 0xd7, 0x0a, 0x9c, 0x96, 0xe9, 0xb1, 0x08, 0x06, 0x0c,
+		0x4a, 0xba, 0x80, 0xe1, 0x36, 0x4c, 0x08, 0x0e, 0xe5, 0x4c, 0x92, 0xff, 0x3a, 0xe0, 0x70, 0x0e,
+		0xe4, 0x1e, 0xc7, 0x9c, 0x0d, 0x38, 0x66, 0xf5, 0x75, 0x05, 0xd4, 0xe4, 0xf6, 0x0c, 0x34, 0x07,
+		0x28, 0x5c, 0xb2, 0xef, 0x34, 0x6d, 0x8d, 0x3a, 0x81, 0x25, 0x08, 0x9d, 0x4e, 0x99, 0xd0, 0xe9,
+		0x14, 0x34, 0xb5, 0x6c, 0xc8, 0xd4, 0xd4, 0x3f, 0x87, 0x96, 0x57, 0x68, 0x21, 0xe9, 0x10, 0xcd,
+		0xc0, 0x68, 0x30, 0x6d, 0xd3, 0x51, 0xaf, 0x11, 0xa7, 0x67, 0xc6, 0x21, 0xec, 0xd9, 0x10, 0xf6,
+		0xb3, 0xb0, 0x7f, 0xdd, 0x6c, 0xe8, 0xf6, 0x4e, 0xa5, 0xba, 0x89, 0xab, 0x5b, 0x4e, 0xab, 0x4e,
+		0x23, 0xba, 0x82, 0x36, 0xe2, 0x0d, 0x2f, 0xb1, 0x51, 0x74, 0x0e, 0xc6, 0x82, 0xc9, 0x46, 0xfc,
+		0xc8, 0x8b, 0xd6, 0x86, 0xb5, 0x51, 0xdc, 0x9b, 0x03, 0xc4, 0x8f, 0x88, 0xfa, 0xad, 0x2c, 0x9c,
+		0x92, 0xe8, 0xfc, 0x78, 0x6c, 0x33, 0x0e, 0x9b, 0x45, 0xb6, 0x0f, 0xb3, 0x40, 0xc7, 0xa1, 0xb8,
+		0xae, 0x3b, 0xd8, 0x8f, 0x34, 0xbc, 0x65, 0x29, 0xb8, 0x43, 0x5e, 0x7c, 0x71, 0x14, 0xa0, 0x81,
+		0xdb, 0xfe, 0xeb, 0x41, 0x6f, 0x61, 0x1b, 0xb8, 0xed, 0xbd, 0x9d, 0x03, 0xb4, 0x61, 0xd9, 0x5b,
+		0x0c, 0xa9, 0xdf, 0xbe, 0x97, 0xf3, 0xa6, 0xe6, 0xbe, 0xa1, 0x58, 0x1f, 0xb0, 0x3e, 0xbe, 0x09,
+		0xd7, 0x39, 0xea, 0x8e, 0xd5, 0x60, 0xa1, 0x24, 0x7b, 0x42, 0xb7, 0x60, 0xb0, 0xaa, 0xb7, 0x1c,
+		0xcc, 0xa2, 0xc6, 0x92, 0x74, 0x8f, 0xcd, 0x92, 0xcb, 0xa5, 0x79, 0xcc, 0x21, 0x05, 0x2d, 0x84,
+		0x15, 0xf4, 0xfd, 0x2c, 0x9c, 0x4c, 0x6c, 0x8b, 0x79, 0x6c, 0x7b, 0xb5, 0xe8, 0x4f, 0xd1, 0xdb,
+		0xa4, 0x39, 0xc9, 0xae, 0x9d, 0xc0, 0x04, 0x7b, 0x5c, 0xf6, 0x40, 0x1a, 0x97, 0xdd, 0x6b, 0x19,
+		0x83, 0x21, 0xcb, 0x08, 0x6d, 0x7f, 0x2e, 0x7e, 0xfb, 0xf3, 0x52, 0xdb, 0x3f, 0x24, 0xd8, 0x7e,
+		0x8e, 0x15, 0x16, 0xb8, 0x56, 0x18, 0xdc, 0x49, 0x08, 0xef, 0xe4, 0x3b, 0x39, 0x38, 0x2d, 0xd3,
+		0x50, 0x84, 0x4e, 0x40, 0xb1, 0x53, 0x95, 0x67, 0xbb, 0x58, 0xd0, 0xc0, 0x1f, 0x2a, 0x1b, 0xee,
+		0x0d, 0xb6, 0x5b, 0xb6, 0x77, 0x4d, 0x28, 0x13, 0x73, 0x83, 0xed, 0x7c, 0x92, 0xde, 0x60, 0xf5,
+		0x9e, 0x27, 0x57, 0xb1, 0x0d, 0xab, 0xae, 0x9b, 0x0d, 0xe6, 0x79, 0xd8, 0x53, 0xf0, 0x28, 0x19,
+		0xe8, 0xf3, 0xee, 0x99, 0x93, 0xbf, 0x7b, 0xae, 0xc1, 0x94, 0xaf, 0xa3, 0xd1, 0x13, 0x28, 0x9f,
+		0x74, 0x02, 0x4d, 0xf8, 0xbc, 0xa1, 0x43, 0x28, 0x24, 0x95, 0x1d, 0x70, 0x4c, 0xea, 0x50, 0x0a,
+		0xa9, 0xde, 0x95, 0x93, 0x49, 0x15, 0x1f, 0x95, 0x85, 0xbe, 0x8e, 0xca, 0x15, 0x18, 0xdb, 0xc4,
+		0xba, 0x4d, 0xd6, 0xb1, 0xde, 0x45, 0x07, 0x49, 0xa2, 0x46, 0x3b, 0x3c, 0x5d, 0x39, 0xc9, 0x01,
+		0x4e, 0x31, 0x39, 0xc0, 0x89, 0x5c, 0xcc, 0x86, 0xfb, 0xb9, 0x98, 0x75, 0x03, 0xfc, 0x7d, 0xd2,
+		0x01, 0xbe, 0xfa, 0x27, 0x05, 0xd4, 0xe4, 0xe6, 0xb6, 0x8f, 0x2d, 0x34, 0xe8, 0x0d, 0x62, 0x06,
+		0x82, 0xb7, 0xcb, 0x97, 0x60, 0x98, 0x5e, 0xce, 0x7d, 0xb7, 0x36, 0x28, 0xe1, 0xd6, 0x8a, 0x2e,
+		0x07, 0x7b, 0x50, 0x7f, 0xab, 0x04, 0x5d, 0xc1, 0x1e, 0xc7, 0xe5, 0xfc, 0x25, 0xca, 0xa4, 0x38,
+		0x0d, 0xb2, 0x89, 0xb1, 0xca, 0x40, 0x70, 0x31, 0xd5, 0xdf, 0x28, 0x70, 0x32, 0xb9, 0xe3, 0xa8,
+		0xdf, 0xf0, 0xfd, 0x93, 0x98, 0xd1, 0x4f, 0x32, 0x70, 0x4a, 0xa2, 0x6f, 0xcf, 0x9d, 0x93, 0x81,
+		0x89, 0x6e, 0xd6, 0x1c, 0xa9, 0x4d, 0xf2, 0x89, 0x1f, 0xdb, 0x9c, 0xc2, 0xf1, 0xd5, 0x40, 0x3f,
+		0xf1, 0xd5, 0xae, 0x55, 0xfc, 0x0b, 0x0a, 0xcc, 0xca, 0xb7, 0xdb, 0xc9, 0x9c, 0x79, 0x7b, 0x73,
+		0x81, 0x7b, 0x4f, 0x81, 0x94, 0x8d, 0x75, 0xc9, 0xd8, 0xc6, 0xfd, 0x28, 0x89, 0xdd, 0xc2, 0xbd,
+		0xb8, 0x47, 0x06, 0x71, 0x56, 0x02, 0xf1, 0xdb, 0x21, 0x3d, 0x14, 0x95, 0xe0, 0xfa, 0xd5, 0xc3,
+		0x15, 0x98, 0xae, 0xe9, 0xa4, 0xa7, 0xc1, 0x24, 0xdc, 0x6e, 0xd1, 0x5d, 0x59, 0x8f, 0x8e, 0xb7,
+		0x95, 0x5e, 0x54, 0xc5, 0xd1, 0xe7, 0x6c, 0x0a, 0x7d, 0x1e, 0x48, 0xb4, 0xd1, 0x50, 0x1c, 0xa8,
+		0x7e, 0xa0, 0xc0, 0x91, 0x98, 0x96, 0x56, 0x34, 0x05, 0x43, 0x5e, 0x2b, 0x5f, 0x67, 0xdf, 0xf2,
+		0xf4, 0xb9, 0x6c, 0xa0, 0x55, 0x38, 0xd8, 0x39, 0xc8, 0x37, 0x4c, 0x3b, 0xc5, 0x95, 0x17, 0xb1,
+		0x73, 0x7c, 0xc5, 0xb4, 0x71, 0x9a, 0xe3, 0x57, 0x66, 0xb3, 0xff, 0x0b, 0xa6, 0x84, 0xbd, 0xb2,
+		0x71, 0xb3, 0x91, 0x0e, 0xe9, 0xd5, 0xf7, 0x15, 0x38, 0x1a, 0xd7, 0x26, 0xb9, 0x27, 0x5f, 0xd9,
+		0xab, 0xf5, 0x88, 0x75, 0xd0, 0x3f, 0x50, 0x60, 0x3a, 0xa9, 0xdd, 0x32, 0x6e, 0x36, 0x8f, 0xd5,
+		0x6c, 0x63, 0x91, 0xff, 0x2d, 0x0f, 0x29, 0xbb, 0x7a, 0xd0, 0x3c, 0x8c, 0xd3, 0xc6, 0xa1, 0x70,
+		0x8e, 0xdd, 0x9b, 0xd3, 0x58, 0x03, 0xb7, 0x43, 0x19, 0xf6, 0x48, 0x99, 0x2b, 0xd3, 0x5f, 0x99,
+		0xeb, 0x49, 0x21, 0x4a, 0xbe, 0x10, 0x25, 0xa3, 0x3b, 0x79, 0x09, 0xdd, 0xb9, 0x03, 0x13, 0xac,
+		0x80, 0xc0, 0x30, 0x9a, 0x0d, 0x82, 0xed, 0x6d, 0xbd, 0x96, 0x7c, 0x6f, 0x19, 0x67, 0x8c, 0x14,
+		0x5e, 0x99, 0xb1, 0x05, 0x8b, 0x5c, 0x85, 0x5d, 0x15, 0xb9, 0x7a, 0x42, 0x38, 0x48, 0x13, 0xc2,
+		0x89, 0x2b, 0x5a, 0xc5, 0xbe, 0x2b, 0x5a, 0xdd, 0x7b, 0xc6, 0xb0, 0x7c, 0x21, 0xc1, 0xaf, 0xab,
+		0xec, 0xdb, 0x45, 0x5d, 0x65, 0x64, 0x57, 0x75, 0x15, 0xf5, 0x8f, 0x0a, 0xcc, 0xa7, 0x6d, 0x2d,
+		0xec, 0x78, 0x2b, 0xa5, 0xd7, 0x5b, 0xc5, 0xdd, 0x6f, 0xd6, 0xe1, 0x50, 0xa7, 0x1d, 0x21, 0x54,
+		0xa2, 0xf6, 0xec, 0x78, 0x36, 0xb6, 0xe1, 0x20, 0x58, 0xa4, 0x3e, 0x88, 0x79, 0xc3, 0xa1, 0x3b,
+		0xd4, 0x40, 0x38, 0xe7, 0xf1, 0x4d, 0x85, 0x93, 0x2f, 0x17, 0x1d, 0x3c, 0x32, 0xd6, 0xa3, 0x48,
+		0x58, 0x4f, 0x4f, 0x20, 0x94, 0x49, 0x11, 0x08, 0xa9, 0x1f, 0x29, 0x70, 0x2c, 0xb6, 0x73, 0xde,
+		0x8d, 0x04, 0x59, 0x5f, 0x7e, 0x43, 0xaf, 0xfb, 0x3b, 0x01, 0xde, 0xd0, 0x6d, 0xbd, 0x8e, 0xfb,
+		0xfd, 0xf4, 0x9e, 0x1d, 0x3a, 0x5d, 0x83, 0x18, 0x90, 0xbf, 0x78, 0xff, 0x98, 0xb7, 0x49, 0xa2,
+		0x4e, 0x91, 0x13, 0x50, 0x64, 0xbd, 0x3a, 0xbd, 0x4b, 0xe0, 0x0d, 0xd1, 0x25, 0xe8, 0xf8, 0xfc,
+		0x8c, 0xbc, 0xcf, 0x8f, 0x4b, 0x82, 0x27, 0x68, 0xd8, 0x17, 0x15, 0x98, 0x4d, 0xd1, 0x3c, 0xd5,
+		0xcd, 0xe5, 0x2a, 0x81, 0x5c, 0x6e, 0xbf, 0x1b, 0x17, 0x83, 0x5c, 0xfd, 0x59, 0x06, 0x5e, 0xdc,
+		0x5d, 0x03, 0xf9, 0x9e, 0x99, 0x44, 0x37, 0xd3, 0x97, 0x09, 0x64, 0xfa, 0xee, 0x03, 0x8a, 0x36,
+		0x2a, 0x31, 0xef, 0x70, 0x46, 0xae, 0x10, 0xaa, 0x8d, 0x45, 0xba, 0x8d, 0xd1, 0x24, 0xe4, 0xab,
+		0x56, 0x83, 0xd8, 0x56, 0x8d, 0x6e, 0xd8, 0xb0, 0xe6, 0x3f, 0xa2, 0x12, 0x1c, 0x08, 0xf5, 0xdc,
+		0x59, 0x8d, 0x9a, 0x17, 0xd7, 0x0f, 0x69, 0x63, 0x81, 0x56, 0xb8, 0x3b, 0x8d, 0xda, 0x8e, 0xfa,
+		0x56, 0x16, 0x6e, 0xec, 0xa2, 0x41, 0x1d, 0xdd, 0xef, 0xf5, 0x9a, 0x23, 0x82, 0x9f, 0x7f, 0x48,
+		0x49, 0x0e, 0xe4, 0xb4, 0xf7, 0xe8, 0x36, 0x2a, 0xcc, 0xc0, 0xf2, 0xf7, 0x65, 0x60, 0xb7, 0xfb,
+		0x32, 0x07, 0x28, 0xdc, 0x16, 0xc8, 0xaa, 0x23, 0x59, 0x6d, 0xd4, 0x0c, 0x28, 0xa1, 0x97, 0x00,
+		0xf3, 0x77, 0x31, 0x17, 0xd8, 0x45, 0xf5, 0x77, 0x0a, 0x5c, 0xed, 0xb3, 0xbb, 0x5e, 0x80, 0x41,
+		0x11, 0x60, 0xf8, 0x78, 0x15, 0x57, 0x7d, 0x23, 0x0b, 0x57, 0xfb, 0xec, 0x80, 0xfc, 0x67, 0xb5,
+		0xd5, 0x90, 0x43, 0x1f, 0x10, 0x3b, 0xf4, 0x41, 0x79, 0x87, 0x2e, 0x54, 0x1d, 0x91, 0x03, 0xc8,
+		0x8b, 0x1c, 0xc0, 0xe7, 0xb2, 0x70, 0xb9, 0x9f, 0x2e, 0x4e, 0x39, 0xcb, 0x97, 0x92, 0xfc, 0xc4,
+		0xf2, 0xbb, 0x96, 0xff, 0xa1, 0x02, 0x17, 0xd2, 0x76, 0xa4, 0xfe, 0x43, 0x9b, 0xbc, 0xf8, 0xac,
+		0x52, 0x7f, 0xa5, 0xc0, 0xf9, 0x54, 0x5d, 0xac, 0x7b, 0xe6, 0x02, 0xb8, 0x77, 0x8e, 0xcc, 0xee,
+		0xee, 0x1c, 0xef, 0x14, 0xe0, 0x52, 0x1f, 0x3f, 0xc7, 0xe9, 0xd9, 0x0e, 0x25, 0xb0, 0x1d, 0x27,
+		0xa0, 0xd8, 0xd9, 0x0e, 0xa6, 0xf3, 0x05, 0x0d, 0xfc, 0x21, 0x5e, 0x02, 0x22, 0xbb, 0x07, 0x09,
+		0x88, 0x7e, 0xab, 0x91, 0x83, 0x7b, 0x9b, 0x80, 0xc8, 0x3d, 0xd6, 0x04, 0x44, 0xbe, 0xef, 0x04,
+		0xc4, 0x03, 0x60, 0xcd, 0xc4, 0x4c, 0x22, 0x2b, 0xe2, 0x79, 0x0d, 0x0a, 0x67, 0x62, 0x3a, 0x92,
+		0xa9, 0x14, 0x56, 0xca, 0x1b, 0x6b, 0x86, 0x87, 0x7a, 0x8d, 0xa4, 0x10, 0xf4, 0xe7, 0x32, 0x2a,
+		0x0f, 0x12, 0x2a, 0x5f, 0x85, 0xc9, 0x1e, 0x75, 0xaa, 0xd8, 0xb8, 0xd5, 0x85, 0x5f, 0xa4, 0xf0,
+		0x67, 0x63, 0x15, 0xa7, 0x6c, 0x68, 0x2e, 0x0b, 0x9b, 0xc2, 0xc1, 0x36, 0x6f, 0x38, 0x52, 0xdc,
+		0xdc, 0xd7, 0x4f, 0x71, 0x33, 0xd2, 0x16, 0x3a, 0xc2, 0x69, 0x0b, 0xed, 0x5e, 0xc4, 0xf6, 0xa7,
+		0xcf, 0x4c, 0x8c, 0xee, 0x22, 0x33, 0x31, 0xb6, 0xbb, 0x8e, 0xcf, 0xeb, 0x50, 0x34, 0x70, 0x4d,
+		0xdf, 0xf1, 0x54, 0x33, 0xb9, 0x7d, 0x15, 0x28, 0x35, 0x55, 0x45, 0xf4, 0x3c, 0x0c, 0xff, 0xb7,
+		0x49, 0x88, 0xff, 0xaf, 0x29, 0x3a, 0x8d, 0xab, 0x42, 0xe6, 0xa2, 0x47, 0x4e, 0xb9, 0xd5, 0x37,
+		0xb3, 0x70, 0x21, 0xed, 0x8f, 0xed, 0x3e, 0x79, 0xe7, 0xb4, 0xea, 0x47, 0x19, 0x5e, 0x95, 0xed,
+		0x4a, 0xea, 0x5f, 0x8a, 0x05, 0x82, 0x8b, 0x1e, 0x33, 0x1b, 0x0c, 0x9a, 0x19, 0xff, 0x08, 0xcd,
+		0x09, 0x8e, 0xd0, 0x3d, 0xca, 0x43, 0xaa, 0xbf, 0xcc, 0xc0, 0x5c, 0x9a, 0x5f, 0x12, 0x0a, 0xf7,
+		0x83, 0x7f, 0x76, 0x67, 0x76, 0x7b, 0x76, 0xef, 0xd5, 0x2e, 0xf2, 0x57, 0x77, 0x40, 0xb0, 0xba,
+		0x5d, 0xdb, 0x1e, 0x94, 0x4f, 0xb2, 0x7c, 0x94, 0x81, 0x94, 0xbf, 0x71, 0xfc, 0x74, 0x2c, 0x26,
+		0xaf, 0xa4, 0x34, 0xc8, 0x2d, 0x29, 0x75, 0x7b, 0x21, 0x72, 0xf2, 0xbd, 0x10, 0xea, 0x5f, 0x32,
+		0x70, 0x6e, 0x2f, 0x3c, 0xca, 0xa7, 0x74, 0xd1, 0x7b, 0xb2, 0xfd, 0xb9, 0x14, 0xd9, 0x7e, 0xf5,
+		0xaf, 0x19, 0x38, 0x9f, 0xea, 0x27, 0xa7, 0x4f, 0x16, 0x3e, 0xb2, 0xf0, 0x7e, 0x42, 0x32, 0x97,
+		0x26, 0x89, 0xfd, 0xff, 0x59, 0xd1, 0xc2, 0x8b, 0xfa, 0x57, 0x9e, 0x2c, 0x7c, 0x6c, 0xfb, 0x4c,
+		0xae, 0x9f, 0xae, 0xfd, 0x9f, 0x66, 0x60, 0x3e, 0xe5, 0x4f, 0x81, 0x9f, 0xec, 0x43, 0x60, 0x1f,
+		0x66, 0x09, 0xec, 0xa7, 0x7f, 0xae, 0x98, 0x35, 0x82, 0x6d, 0xfa, 0xa9, 0x63, 0x30, 0xb5, 0xfc,
+		0x60, 0xf9, 0xf6, 0x5a, 0x65, 0xa5, 0xbc, 0xba, 0xb6, 0xac, 0x55, 0xd6, 0xfe, 0xe3, 0xee, 0x72,
+		0xa5, 0x7c, 0xfb, 0xc1, 0xcd, 0xd5, 0xf2, 0xad, 0xd1, 0xa7, 0xd0, 0x09, 0x38, 0x12, 0x7d, 0x7d,
+		0x73, 0x75, 0xb5, 0x42, 0x47, 0x47, 0x15, 0x74, 0x12, 0x8e, 0x45, 0x09, 0x96, 0x56, 0xef, 0xdc,
+		0x5b, 0x66, 0x24, 0x99, 0xc5, 0x57, 0xe1, 0x50, 0xd5, 0xaa, 0xf3, 0xd6, 0x60, 0xd1, 0xff, 0x67,
+		0xb2, 0x77, 0xdd, 0x38, 0xf6, 0xae, 0xf2, 0x9f, 0x17, 0x1f, 0x9a, 0x64, 0xb3, 0xb5, 0x5e, 0xaa,
+		0x5a, 0xf5, 0xf9, 0xde, 0x7f, 0x6a, 0x7b, 0xde, 0x34, 0x6a, 0xf3, 0x0f, 0x2d, 0xef, 0x1f, 0xe9,
+		0xb2, 0xff, 0x70, 0x7b, 0x43, 0x6f, 0x9a, 0xdb, 0x17, 0xd7, 0x73, 0x74, 0xec, 0xd2, 0xdf, 0x03,
+		0x00, 0x00, 0xff, 0xff, 0xbe, 0xbb, 0xb6, 0xc2, 0xc4, 0x57, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/tasklist.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
+		0x14, 0x9e, 0xe2, 0xb4, 0x4b, 0xe8, 0xd9, 0xd5, 0xb8, 0xb5, 0x8d, 0xdd, 0x75, 0xf3, 0x74, 0x51,
+		0x04, 0xc5, 0x26, 0xc1, 0x19, 0x76, 0xb5, 0x8b, 0xc1, 0xb1, 0x83, 0x55, 0xb0, 0xe3, 0x1a, 0x92,
+		0x1a, 0x20, 0x03, 0x06, 0x8e, 0x12, 0x59, 0x9b, 0xd0, 0x0f, 0x05, 0x92, 0x72, 0xe2, 0x17, 0xd9,
+		0xc3, 0xec, 0x89, 0xf6, 0x18, 0x03, 0x29, 0xd9, 0xf3, 0x12, 0x6f, 0x77, 0xe4, 0xf9, 0xce, 0x77,
+		0x7e, 0x3e, 0x9e, 0x43, 0xe0, 0x54, 0x31, 0x15, 0x5e, 0x82, 0x09, 0x2d, 0x12, 0xea, 0xe1, 0x92,
+		0x79, 0xeb, 0xa1, 0xa7, 0xb0, 0x4c, 0x33, 0x26, 0x95, 0x5b, 0x0a, 0xae, 0x38, 0xfc, 0x42, 0xfb,
+		0xb8, 0x8d, 0x8f, 0x8b, 0x4b, 0xe6, 0xae, 0x87, 0xfd, 0xaf, 0x97, 0x9c, 0x2f, 0x33, 0xea, 0x19,
+		0x97, 0xb8, 0xfa, 0xe8, 0x91, 0x4a, 0x60, 0xc5, 0x78, 0x51, 0x93, 0xfa, 0xdf, 0x3c, 0xc4, 0x15,
+		0xcb, 0xa9, 0x54, 0x38, 0x2f, 0x1b, 0x87, 0x47, 0x01, 0xee, 0x04, 0x2e, 0x4b, 0x2a, 0x64, 0x8d,
+		0x3b, 0x1f, 0xc0, 0x49, 0x84, 0x65, 0x3a, 0x63, 0x52, 0x41, 0x08, 0x8e, 0x0b, 0x9c, 0xd3, 0x33,
+		0x6b, 0x60, 0x9d, 0x9f, 0x06, 0xe6, 0x0c, 0x7f, 0x04, 0xc7, 0x29, 0x2b, 0xc8, 0xd9, 0xd1, 0xc0,
+		0x3a, 0xef, 0x5e, 0x7c, 0xeb, 0x1e, 0x28, 0xd2, 0xdd, 0x06, 0x98, 0xb2, 0x82, 0x04, 0xc6, 0xdd,
+		0xc1, 0xc0, 0xde, 0x5a, 0xaf, 0xa9, 0xc2, 0x04, 0x2b, 0x0c, 0xaf, 0xc1, 0x97, 0x39, 0xbe, 0x47,
+		0xba, 0x6d, 0x89, 0x4a, 0x2a, 0x90, 0xa4, 0x09, 0x2f, 0x88, 0x49, 0xd7, 0xbe, 0xf8, 0xca, 0xad,
+		0x2b, 0x75, 0xb7, 0x95, 0xba, 0x13, 0x5e, 0xc5, 0x19, 0xbd, 0xc1, 0x59, 0x45, 0x83, 0xcf, 0x73,
+		0x7c, 0xaf, 0x03, 0xca, 0x05, 0x15, 0xa1, 0xa1, 0x39, 0x1f, 0x40, 0x6f, 0x9b, 0x62, 0x81, 0x85,
+		0x62, 0x5a, 0x95, 0x5d, 0x2e, 0x1b, 0xb4, 0x52, 0xba, 0x69, 0x3a, 0xd1, 0x47, 0xf8, 0x06, 0x3c,
+		0xe3, 0x77, 0x05, 0x15, 0x68, 0xc5, 0xa5, 0x42, 0xa6, 0xcf, 0x23, 0x83, 0x76, 0x8c, 0xf9, 0x1d,
+		0x97, 0x6a, 0x8e, 0x73, 0xea, 0xfc, 0x65, 0x81, 0xee, 0x36, 0x6e, 0xa8, 0xb0, 0xaa, 0x24, 0xfc,
+		0x0e, 0xc0, 0x18, 0x27, 0x69, 0xc6, 0x97, 0x28, 0xe1, 0x55, 0xa1, 0xd0, 0x8a, 0x15, 0xca, 0xc4,
+		0x6e, 0x05, 0x76, 0x83, 0x8c, 0x35, 0xf0, 0x8e, 0x15, 0x0a, 0xbe, 0x06, 0x40, 0x50, 0x4c, 0x50,
+		0x46, 0xd7, 0x34, 0x33, 0x39, 0x5a, 0xc1, 0xa9, 0xb6, 0xcc, 0xb4, 0x01, 0xbe, 0x02, 0xa7, 0x38,
+		0x49, 0x1b, 0xb4, 0x65, 0xd0, 0x13, 0x9c, 0xa4, 0x35, 0xf8, 0x06, 0x3c, 0x13, 0x58, 0xd1, 0x7d,
+		0x75, 0x8e, 0x07, 0xd6, 0xb9, 0x15, 0x74, 0xb4, 0x79, 0xd7, 0x3b, 0x9c, 0x80, 0x8e, 0x96, 0x11,
+		0x31, 0x82, 0xe2, 0x8c, 0x27, 0xe9, 0xd9, 0x13, 0xa3, 0xe1, 0xe0, 0x3f, 0x9f, 0xc7, 0x9f, 0x5c,
+		0x6a, 0xbf, 0xa0, 0xad, 0x69, 0x3e, 0x31, 0x17, 0xe7, 0x67, 0xd0, 0xde, 0xc3, 0x60, 0x0f, 0x9c,
+		0x48, 0x85, 0x85, 0x42, 0x8c, 0x34, 0xcd, 0x7d, 0x6a, 0xee, 0x3e, 0x81, 0xcf, 0xc1, 0x53, 0x5a,
+		0x10, 0x0d, 0xd4, 0xfd, 0x3c, 0xa1, 0x05, 0xf1, 0x89, 0xf3, 0x87, 0x05, 0xc0, 0x82, 0x67, 0x19,
+		0x15, 0x7e, 0xf1, 0x91, 0xc3, 0x09, 0xb0, 0x33, 0x2c, 0x15, 0xc2, 0x49, 0x42, 0xa5, 0x44, 0x7a,
+		0x14, 0x9b, 0xc7, 0xed, 0x3f, 0x7a, 0xdc, 0x68, 0x3b, 0xa7, 0x41, 0x57, 0x73, 0x46, 0x86, 0xa2,
+		0x8d, 0xb0, 0x0f, 0x4e, 0x18, 0xa1, 0x85, 0x62, 0x6a, 0xd3, 0xbc, 0xd0, 0xee, 0x7e, 0x48, 0x9f,
+		0xd6, 0x01, 0x7d, 0x9c, 0x3f, 0x2d, 0xd0, 0x0b, 0x15, 0x4b, 0xd2, 0xcd, 0xd5, 0x3d, 0x4d, 0x2a,
+		0x3d, 0x1a, 0x23, 0xa5, 0x04, 0x8b, 0x2b, 0x45, 0x25, 0xfc, 0x05, 0xd8, 0x77, 0x5c, 0xa4, 0x54,
+		0x98, 0x59, 0x44, 0x7a, 0x07, 0x9b, 0x3a, 0x5f, 0xff, 0xef, 0x7c, 0x07, 0xdd, 0x9a, 0xb6, 0x5b,
+		0x98, 0x08, 0xf4, 0x64, 0xb2, 0xa2, 0xa4, 0xca, 0x28, 0x52, 0x1c, 0xd5, 0xea, 0xe9, 0xb6, 0x79,
+		0xa5, 0x4c, 0xed, 0xed, 0x8b, 0xde, 0xe3, 0xb1, 0x6e, 0x36, 0x38, 0x78, 0xb1, 0xe5, 0x46, 0x3c,
+		0xd4, 0xcc, 0xa8, 0x26, 0xbe, 0xfd, 0x1d, 0x7c, 0xb6, 0xbf, 0x51, 0xb0, 0x0f, 0x5e, 0x44, 0xa3,
+		0x70, 0x8a, 0x66, 0x7e, 0x18, 0xa1, 0xa9, 0x3f, 0x9f, 0x20, 0x7f, 0x7e, 0x33, 0x9a, 0xf9, 0x13,
+		0xfb, 0x13, 0xd8, 0x03, 0xcf, 0x1f, 0x60, 0xf3, 0xf7, 0xc1, 0xf5, 0x68, 0x66, 0x5b, 0x07, 0xa0,
+		0x30, 0xf2, 0xc7, 0xd3, 0x5b, 0xfb, 0xe8, 0x2d, 0xf9, 0x27, 0x43, 0xb4, 0x29, 0xe9, 0xbf, 0x33,
+		0x44, 0xb7, 0x8b, 0xab, 0xbd, 0x0c, 0xaf, 0xc0, 0xcb, 0x07, 0xd8, 0xe4, 0x6a, 0xec, 0x87, 0xfe,
+		0xfb, 0xb9, 0x6d, 0x1d, 0x00, 0x47, 0xe3, 0xc8, 0xbf, 0xf1, 0xa3, 0x5b, 0xfb, 0xe8, 0xf2, 0x37,
+		0xf0, 0x32, 0xe1, 0xf9, 0x21, 0x45, 0x2f, 0x3b, 0xbb, 0xcd, 0xd5, 0xaa, 0x2c, 0xac, 0x5f, 0x87,
+		0x4b, 0xa6, 0x56, 0x55, 0xec, 0x26, 0x3c, 0xf7, 0xf6, 0xff, 0xca, 0xef, 0x19, 0xc9, 0xbc, 0x25,
+		0xaf, 0xbf, 0xaf, 0xe6, 0xe3, 0xfc, 0x09, 0x97, 0x6c, 0x3d, 0x8c, 0x9f, 0x1a, 0xdb, 0x0f, 0x7f,
+		0x07, 0x00, 0x00, 0xff, 0xff, 0x41, 0xb6, 0x75, 0xa3, 0x5c, 0x05, 0x00, 0x00,
+	},
+	// google/protobuf/wrappers.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0x2f, 0x4a, 0x2c,
+		0x28, 0x48, 0x2d, 0x2a, 0xd6, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0xca,
+		0x5c, 0xdc, 0x2e, 0xf9, 0xa5, 0x49, 0x39, 0xa9, 0x61, 0x89, 0x39, 0xa5, 0xa9, 0x42, 0x22, 0x5c,
+		0xac, 0x65, 0x20, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x63, 0x10, 0x84, 0xa3, 0xa4, 0xc4, 0xc5,
+		0xe5, 0x96, 0x93, 0x9f, 0x58, 0x82, 0x45, 0x0d, 0x13, 0x92, 0x1a, 0xcf, 0xbc, 0x12, 0x33, 0x13,
+		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
+		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
+		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x33,
+		0x23, 0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xf0, 0x3a, 0xf1, 0x86, 0x43, 0xc3, 0x3f, 0x00,
+		0x24, 0x12, 0xc0, 0x18, 0x65, 0x08, 0x55, 0x91, 0x9e, 0x9f, 0x93, 0x98, 0x97, 0xae, 0x97, 0x5f,
+		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
+		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
+		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x92, 0x48, 0x30, 0x06,
+		0x02, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/workflow.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x73, 0xdb, 0xc8,
+		0xd1, 0x7e, 0x41, 0x4a, 0xb2, 0xd4, 0xd4, 0x07, 0x34, 0x92, 0x2c, 0x5a, 0xde, 0xb5, 0x65, 0xee,
+		0xda, 0x2b, 0xf3, 0x5d, 0x49, 0x2b, 0xef, 0x87, 0xd7, 0x56, 0x1c, 0x07, 0x02, 0x21, 0x0b, 0x36,
+		0x05, 0x32, 0x43, 0xd0, 0xb2, 0xb6, 0x92, 0xa0, 0x20, 0x72, 0x24, 0x21, 0x26, 0x01, 0x16, 0x30,
+		0xb4, 0xad, 0x7b, 0xaa, 0x72, 0x4e, 0x0e, 0xa9, 0x54, 0x4e, 0xf9, 0x01, 0x49, 0xa5, 0x52, 0x39,
+		0xa7, 0x72, 0xcb, 0x2d, 0xd7, 0xfc, 0x87, 0xfc, 0x8b, 0xd4, 0x0c, 0x06, 0x24, 0xf8, 0x09, 0x2a,
+		0xa9, 0xda, 0xdc, 0x84, 0x9e, 0xe7, 0x69, 0xf4, 0xf4, 0x74, 0x3f, 0x3d, 0x84, 0x20, 0xd7, 0x3e,
+		0x23, 0xfe, 0x6e, 0xcd, 0xae, 0x13, 0xb7, 0x46, 0x76, 0xed, 0x96, 0xb3, 0xfb, 0x6e, 0x6f, 0xf7,
+		0xbd, 0xe7, 0xbf, 0x3d, 0x6f, 0x78, 0xef, 0x77, 0x5a, 0xbe, 0x47, 0x3d, 0xb4, 0xc2, 0x30, 0x3b,
+		0x02, 0xb3, 0x63, 0xb7, 0x9c, 0x9d, 0x77, 0x7b, 0x1b, 0x77, 0x2e, 0x3c, 0xef, 0xa2, 0x41, 0x76,
+		0x39, 0xe4, 0xac, 0x7d, 0xbe, 0x5b, 0x6f, 0xfb, 0x36, 0x75, 0x3c, 0x37, 0x24, 0x6d, 0xdc, 0xed,
+		0x5f, 0xa7, 0x4e, 0x93, 0x04, 0xd4, 0x6e, 0xb6, 0x04, 0x60, 0x73, 0xd8, 0x9b, 0x6b, 0x5e, 0xb3,
+		0xd9, 0x71, 0x31, 0x34, 0x36, 0x6a, 0x07, 0x6f, 0x1b, 0x4e, 0x40, 0x43, 0x4c, 0xee, 0x8f, 0xb3,
+		0xb0, 0x76, 0x22, 0xc2, 0xd5, 0x3e, 0x90, 0x5a, 0x9b, 0x85, 0xa0, 0xbb, 0xe7, 0x1e, 0xaa, 0x02,
+		0x8a, 0xf6, 0x61, 0x91, 0x68, 0x25, 0x2b, 0x6d, 0x4a, 0x5b, 0x99, 0x47, 0x0f, 0x76, 0x86, 0x6c,
+		0x69, 0x67, 0xc0, 0x0f, 0x5e, 0x7e, 0xdf, 0x6f, 0x42, 0x5f, 0xc3, 0x14, 0xbd, 0x6a, 0x91, 0x6c,
+		0x8a, 0x3b, 0xba, 0x37, 0xd6, 0x91, 0x79, 0xd5, 0x22, 0x98, 0xc3, 0xd1, 0x13, 0x80, 0x80, 0xda,
+		0x3e, 0xb5, 0x58, 0x1a, 0xb2, 0x69, 0x4e, 0xde, 0xd8, 0x09, 0x73, 0xb4, 0x13, 0xe5, 0x68, 0xc7,
+		0x8c, 0x72, 0x84, 0xe7, 0x38, 0x9a, 0x3d, 0x33, 0x6a, 0xad, 0xe1, 0x05, 0x24, 0xa4, 0x4e, 0x25,
+		0x53, 0x39, 0x9a, 0x53, 0x4d, 0x98, 0x0f, 0xa9, 0x01, 0xb5, 0x69, 0x3b, 0xc8, 0x4e, 0x6f, 0x4a,
+		0x5b, 0x8b, 0x8f, 0xf6, 0x26, 0xdb, 0xbd, 0xca, 0x98, 0x15, 0x4e, 0xc4, 0x99, 0x5a, 0xf7, 0x01,
+		0xdd, 0x87, 0xc5, 0x4b, 0x27, 0xa0, 0x9e, 0x7f, 0x65, 0x35, 0x88, 0x7b, 0x41, 0x2f, 0xb3, 0x33,
+		0x9b, 0xd2, 0x56, 0x1a, 0x2f, 0x08, 0x6b, 0x91, 0x1b, 0xd1, 0x4f, 0x60, 0xad, 0x65, 0xfb, 0xc4,
+		0xa5, 0xdd, 0xf4, 0x5b, 0x8e, 0x7b, 0xee, 0x65, 0x6f, 0xf0, 0x2d, 0x6c, 0x0d, 0x8d, 0xa2, 0xcc,
+		0x19, 0x3d, 0x27, 0x89, 0x57, 0x5a, 0x83, 0x46, 0xa4, 0xc0, 0x62, 0xd7, 0x2d, 0xcf, 0xcc, 0x6c,
+		0x62, 0x66, 0x16, 0x3a, 0x0c, 0x9e, 0x9d, 0x6d, 0x98, 0x6a, 0x92, 0xa6, 0x97, 0x9d, 0xe3, 0xc4,
+		0x5b, 0x43, 0xe3, 0x39, 0x26, 0x4d, 0x0f, 0x73, 0x18, 0xc2, 0xb0, 0x1c, 0x10, 0xdb, 0xaf, 0x5d,
+		0x5a, 0x36, 0xa5, 0xbe, 0x73, 0xd6, 0xa6, 0x24, 0xc8, 0x02, 0xe7, 0xde, 0x1f, 0xca, 0xad, 0x70,
+		0xb4, 0xd2, 0x01, 0x63, 0x39, 0xe8, 0xb3, 0xa0, 0x22, 0x2c, 0xdb, 0x6d, 0xea, 0x59, 0x3e, 0x09,
+		0x08, 0xb5, 0x5a, 0x9e, 0xe3, 0xd2, 0x20, 0x9b, 0xe1, 0x3e, 0x37, 0x87, 0xfa, 0xc4, 0x0c, 0x58,
+		0xe6, 0x38, 0xbc, 0xc4, 0xa8, 0x31, 0x03, 0xba, 0x0d, 0x73, 0xac, 0x3d, 0x2c, 0xd6, 0x1f, 0xd9,
+		0xf9, 0x4d, 0x69, 0x6b, 0x0e, 0xcf, 0x32, 0x43, 0xd1, 0x09, 0x28, 0x5a, 0x87, 0x1b, 0x4e, 0x60,
+		0xd5, 0x7c, 0xcf, 0xcd, 0x2e, 0x6c, 0x4a, 0x5b, 0xb3, 0x78, 0xc6, 0x09, 0x54, 0xdf, 0x73, 0xd1,
+		0x3e, 0x64, 0xda, 0xad, 0xba, 0x4d, 0x45, 0x81, 0x2d, 0x26, 0xa6, 0x11, 0x42, 0x38, 0xcf, 0xe1,
+		0xcf, 0x41, 0x6e, 0xd9, 0x3e, 0x75, 0xf8, 0x31, 0xd4, 0x3c, 0xf7, 0xdc, 0xb9, 0xc8, 0x2e, 0x6d,
+		0xa6, 0xb7, 0x32, 0x8f, 0x9e, 0x4f, 0x56, 0x65, 0xec, 0x30, 0xd9, 0xa9, 0x87, 0x2e, 0x54, 0xee,
+		0x41, 0x73, 0xa9, 0x7f, 0x85, 0x97, 0x5a, 0xbd, 0xd6, 0x8d, 0x03, 0x58, 0x1d, 0x06, 0x44, 0x32,
+		0xa4, 0xdf, 0x92, 0x2b, 0xde, 0xda, 0x73, 0x98, 0xfd, 0x89, 0x56, 0x61, 0xfa, 0x9d, 0xdd, 0x68,
+		0x87, 0x5d, 0x3a, 0x87, 0xc3, 0x87, 0xa7, 0xa9, 0x6f, 0xa5, 0xdc, 0x6f, 0x53, 0x70, 0x67, 0xb0,
+		0xd2, 0xb9, 0x33, 0xa1, 0x5f, 0xe8, 0x69, 0x3c, 0x8b, 0xa1, 0x5e, 0x7c, 0x3c, 0x74, 0x2f, 0xa6,
+		0x48, 0x6d, 0x2c, 0xc9, 0x36, 0x6c, 0x76, 0xab, 0x52, 0x34, 0xbc, 0x67, 0x75, 0xdb, 0xd7, 0x6b,
+		0x53, 0xa1, 0x1c, 0xb7, 0x06, 0x12, 0x5c, 0x10, 0x01, 0xe0, 0x8f, 0x3a, 0x2e, 0x2a, 0x5c, 0x04,
+		0x3c, 0x35, 0x6a, 0x68, 0xaf, 0x4d, 0xd1, 0x09, 0xdc, 0xe6, 0xe1, 0x8d, 0xf0, 0x9e, 0x4e, 0xf2,
+		0xbe, 0xce, 0xd8, 0x43, 0x1c, 0xe7, 0xfe, 0x21, 0xc1, 0xca, 0x90, 0xf6, 0x63, 0x55, 0x55, 0xf7,
+		0x9a, 0xb6, 0xe3, 0x5a, 0x4e, 0x5d, 0x24, 0x79, 0x36, 0x34, 0xe8, 0x75, 0x74, 0x17, 0x32, 0x62,
+		0xd1, 0xb5, 0x9b, 0x51, 0xbe, 0x21, 0x34, 0x19, 0x76, 0x93, 0x8c, 0x90, 0xe1, 0xf4, 0x7f, 0x2b,
+		0xc3, 0xf7, 0x60, 0xde, 0x71, 0x1d, 0xea, 0xd8, 0x94, 0xd4, 0x59, 0x5c, 0x53, 0x5c, 0x81, 0x32,
+		0x1d, 0x9b, 0x5e, 0xcf, 0xfd, 0x4a, 0x82, 0x35, 0xed, 0x03, 0x25, 0xbe, 0x6b, 0x37, 0xbe, 0x97,
+		0xd1, 0xd0, 0x1f, 0x53, 0x6a, 0x30, 0xa6, 0xdf, 0xcc, 0xc0, 0x4a, 0x99, 0xb8, 0x75, 0xc7, 0xbd,
+		0x50, 0x6a, 0xd4, 0x79, 0xe7, 0xd0, 0x2b, 0x1e, 0xd1, 0x5d, 0xc8, 0xd8, 0xe2, 0xb9, 0x9b, 0x65,
+		0x88, 0x4c, 0x7a, 0x1d, 0x1d, 0xc2, 0x42, 0x07, 0x90, 0x38, 0x7f, 0x22, 0xd7, 0x7c, 0xfe, 0xcc,
+		0xdb, 0xb1, 0x27, 0xf4, 0x1c, 0xa6, 0xd9, 0x2c, 0x08, 0x47, 0xd0, 0xe2, 0xa3, 0x87, 0xc3, 0x45,
+		0xb8, 0x37, 0x42, 0x26, 0xfb, 0x04, 0x87, 0x3c, 0xa4, 0xc3, 0xf2, 0x25, 0xb1, 0x7d, 0x7a, 0x46,
+		0x6c, 0x6a, 0xd5, 0x09, 0xb5, 0x9d, 0x46, 0x20, 0x86, 0xd2, 0x47, 0x23, 0x14, 0xfd, 0xaa, 0xe1,
+		0xd9, 0x75, 0x2c, 0x77, 0x68, 0x85, 0x90, 0x85, 0x5e, 0xc2, 0x4a, 0xc3, 0x0e, 0xa8, 0xd5, 0xf5,
+		0xc7, 0x05, 0x68, 0x3a, 0x51, 0x80, 0x96, 0x19, 0xed, 0x28, 0x62, 0x71, 0x1d, 0x3a, 0x04, 0x6e,
+		0x0c, 0xbb, 0x82, 0xd4, 0x43, 0x4f, 0x33, 0x89, 0x9e, 0x96, 0x18, 0xa9, 0x12, 0x72, 0xb8, 0x9f,
+		0x2c, 0xdc, 0xb0, 0x29, 0x25, 0xcd, 0x16, 0xe5, 0x63, 0x6a, 0x1a, 0x47, 0x8f, 0xe8, 0x21, 0xc8,
+		0x4d, 0xfb, 0x83, 0xd3, 0x6c, 0x37, 0x2d, 0x61, 0x0a, 0xf8, 0xc8, 0x99, 0xc6, 0x4b, 0xc2, 0xae,
+		0x08, 0x33, 0x9b, 0x4d, 0x41, 0xed, 0x92, 0xd4, 0xdb, 0x8d, 0x28, 0x92, 0xb9, 0xe4, 0xd9, 0xd4,
+		0x61, 0xf0, 0x38, 0x54, 0x58, 0x22, 0x1f, 0x5a, 0x4e, 0xd8, 0xb3, 0xa1, 0x0f, 0x48, 0xf4, 0xb1,
+		0xd8, 0xa5, 0x70, 0x27, 0xcf, 0x61, 0x9e, 0x27, 0xe5, 0xdc, 0x76, 0x1a, 0x6d, 0x9f, 0x88, 0xc1,
+		0x32, 0xfc, 0x98, 0x0e, 0x43, 0x0c, 0xce, 0x30, 0x86, 0x78, 0x40, 0x5f, 0xc0, 0x2a, 0x77, 0xc0,
+		0x6a, 0x9d, 0xf8, 0x96, 0x53, 0x27, 0x2e, 0x75, 0xe8, 0x95, 0x98, 0x2d, 0x88, 0xad, 0x9d, 0xf0,
+		0x25, 0x5d, 0xac, 0xa0, 0x6f, 0x60, 0x3d, 0x3a, 0x82, 0x7e, 0xd2, 0x02, 0x27, 0xad, 0x89, 0xe5,
+		0x5e, 0x5e, 0xee, 0x2f, 0x29, 0xb8, 0x25, 0xca, 0x4e, 0xbd, 0x74, 0x1a, 0xf5, 0xef, 0xa5, 0x61,
+		0x3f, 0x8f, 0xb9, 0x65, 0x4d, 0x15, 0xd7, 0x30, 0xf9, 0x7d, 0xec, 0x12, 0xc7, 0x95, 0xac, 0xbf,
+		0xbd, 0xd3, 0x03, 0xed, 0x8d, 0x5e, 0x83, 0xb8, 0xab, 0x08, 0x51, 0x6e, 0x79, 0x0d, 0xa7, 0x76,
+		0xc5, 0xdb, 0x63, 0x71, 0x44, 0xa0, 0xa1, 0xe2, 0x72, 0x21, 0x2e, 0x73, 0x34, 0x5e, 0x6e, 0xf5,
+		0x9b, 0xd0, 0x4d, 0x98, 0x09, 0x25, 0x95, 0x37, 0xc7, 0x1c, 0x16, 0x4f, 0xb9, 0xbf, 0xa7, 0x3a,
+		0x72, 0x52, 0x20, 0x35, 0x27, 0x88, 0xf2, 0xd5, 0xe9, 0x72, 0x29, 0xb9, 0xcb, 0x23, 0x62, 0x4f,
+		0x97, 0x0f, 0x56, 0x70, 0xea, 0xba, 0x15, 0xfc, 0x0c, 0xe6, 0x7b, 0x9a, 0x31, 0xf9, 0xce, 0x9b,
+		0x09, 0x86, 0x37, 0xe2, 0x54, 0x6f, 0x23, 0x62, 0x58, 0xf7, 0x7c, 0xe7, 0xc2, 0x71, 0xed, 0x86,
+		0xd5, 0x17, 0x64, 0xb2, 0x74, 0xac, 0x45, 0xd4, 0x4a, 0x3c, 0xd8, 0xdc, 0x5f, 0x53, 0x70, 0x2b,
+		0x92, 0xbb, 0xa2, 0x57, 0xb3, 0x1b, 0x05, 0x27, 0x68, 0xd9, 0xb4, 0x76, 0x39, 0x99, 0x3a, 0xff,
+		0xef, 0xd3, 0xf5, 0x33, 0xb8, 0xd3, 0x1b, 0x81, 0xe5, 0x9d, 0x5b, 0xf4, 0xd2, 0x09, 0xac, 0x78,
+		0x16, 0xc7, 0x3b, 0xdc, 0xe8, 0x89, 0xa8, 0x74, 0x6e, 0x5e, 0x3a, 0x81, 0xd0, 0x34, 0xf4, 0x31,
+		0x00, 0xbf, 0x75, 0x50, 0xef, 0x2d, 0x09, 0xab, 0x70, 0x1e, 0xf3, 0x6b, 0x92, 0xc9, 0x0c, 0xb9,
+		0x97, 0x90, 0x89, 0x5f, 0x44, 0xf7, 0x61, 0x46, 0xdc, 0x65, 0x25, 0x7e, 0x17, 0xfc, 0x24, 0xe1,
+		0x2e, 0xcb, 0xaf, 0xf9, 0x82, 0x92, 0xfb, 0x53, 0x0a, 0x16, 0x7b, 0x97, 0xd0, 0x67, 0xb0, 0x74,
+		0xe6, 0xb8, 0xb6, 0x7f, 0x65, 0xd5, 0x2e, 0x49, 0xed, 0x6d, 0xd0, 0x6e, 0x8a, 0x43, 0x58, 0x0c,
+		0xcd, 0xaa, 0xb0, 0xa2, 0x35, 0x98, 0xf1, 0xdb, 0x6e, 0x34, 0x7c, 0xe7, 0xf0, 0xb4, 0xdf, 0x66,
+		0xb7, 0x94, 0x67, 0x70, 0xfb, 0xdc, 0xf1, 0x03, 0x36, 0xb0, 0xc2, 0x62, 0xb7, 0x6a, 0x5e, 0xb3,
+		0xd5, 0x20, 0x3d, 0x9d, 0x9c, 0xe5, 0x90, 0xa8, 0x1d, 0xd4, 0x08, 0xc0, 0xe9, 0xf3, 0x35, 0x9f,
+		0xd8, 0x9d, 0xb3, 0x49, 0x4e, 0x65, 0x46, 0xe0, 0x85, 0x0c, 0x2f, 0x70, 0x61, 0x76, 0xdc, 0x8b,
+		0x49, 0xcb, 0x74, 0x3e, 0x22, 0x70, 0x07, 0x77, 0x00, 0xf8, 0x0f, 0x04, 0x6a, 0x9f, 0x35, 0xc2,
+		0xa9, 0x36, 0x8b, 0x63, 0x96, 0xfc, 0x9f, 0x25, 0x58, 0x1d, 0x36, 0xb3, 0x51, 0x0e, 0xee, 0x94,
+		0x35, 0xa3, 0xa0, 0x1b, 0x2f, 0x2c, 0x45, 0x35, 0xf5, 0xd7, 0xba, 0x79, 0x6a, 0x55, 0x4c, 0xc5,
+		0xd4, 0x2c, 0xdd, 0x78, 0xad, 0x14, 0xf5, 0x82, 0xfc, 0x7f, 0xe8, 0x53, 0xd8, 0x1c, 0x81, 0xa9,
+		0xa8, 0x47, 0x5a, 0xa1, 0x5a, 0xd4, 0x0a, 0xb2, 0x34, 0xc6, 0x53, 0xc5, 0x54, 0xb0, 0xa9, 0x15,
+		0xe4, 0x14, 0xfa, 0x7f, 0xf8, 0x6c, 0x04, 0x46, 0x55, 0x0c, 0x55, 0x2b, 0x5a, 0x58, 0xfb, 0x71,
+		0x55, 0xab, 0x30, 0x70, 0x3a, 0xff, 0x8b, 0x6e, 0xcc, 0x3d, 0x0a, 0x14, 0x7f, 0x53, 0x41, 0x53,
+		0xf5, 0x8a, 0x5e, 0x32, 0xc6, 0xc5, 0xdc, 0x87, 0x19, 0x11, 0x73, 0x3f, 0x2a, 0x8a, 0x39, 0xff,
+		0xcb, 0x54, 0xf7, 0xfb, 0x81, 0x5e, 0xc7, 0xa4, 0xdd, 0xd1, 0xdc, 0x4f, 0x61, 0xf3, 0xa4, 0x84,
+		0x5f, 0x1d, 0x16, 0x4b, 0x27, 0x96, 0x5e, 0xb0, 0xb0, 0x56, 0xad, 0x68, 0x56, 0xb9, 0x54, 0xd4,
+		0xd5, 0xd3, 0x58, 0x24, 0xdf, 0xc2, 0x57, 0x23, 0x51, 0x4a, 0x91, 0x59, 0x0b, 0xd5, 0x72, 0x51,
+		0x57, 0xd9, 0x5b, 0x0f, 0x15, 0xbd, 0xa8, 0x15, 0xac, 0x92, 0x51, 0x3c, 0x95, 0x25, 0xf4, 0x39,
+		0x6c, 0x4d, 0xca, 0x94, 0x53, 0x68, 0x1b, 0x1e, 0x8e, 0x44, 0x63, 0xed, 0xa5, 0xa6, 0x9a, 0x31,
+		0x78, 0x1a, 0xed, 0xc1, 0xf6, 0x48, 0xb8, 0xa9, 0xe1, 0x63, 0xdd, 0xe0, 0x09, 0x3d, 0xb4, 0x70,
+		0xd5, 0x30, 0x74, 0xe3, 0x85, 0x3c, 0x95, 0xff, 0xbd, 0x04, 0xcb, 0x03, 0xc3, 0x08, 0xdd, 0x85,
+		0xdb, 0x65, 0x05, 0x6b, 0x86, 0x69, 0xa9, 0xc5, 0xd2, 0xb0, 0x04, 0x8c, 0x00, 0x28, 0x07, 0x8a,
+		0x51, 0x28, 0x19, 0xb2, 0x84, 0x1e, 0x40, 0x6e, 0x18, 0x40, 0xd4, 0x82, 0x28, 0x0d, 0x39, 0x85,
+		0xee, 0xc1, 0xc7, 0xc3, 0x70, 0x9d, 0x68, 0xe5, 0x74, 0xfe, 0x5f, 0x29, 0xf8, 0x68, 0xdc, 0x67,
+		0x0a, 0x56, 0x81, 0x9d, 0x6d, 0x6b, 0x6f, 0x34, 0xb5, 0x6a, 0xb2, 0x33, 0x0f, 0xfd, 0xb1, 0x93,
+		0xaf, 0x56, 0x62, 0x91, 0xc7, 0x53, 0x3a, 0x02, 0xac, 0x96, 0x8e, 0xcb, 0x45, 0xcd, 0xe4, 0xd5,
+		0x94, 0x87, 0x07, 0x49, 0xf0, 0xf0, 0x80, 0xe5, 0x54, 0xcf, 0xd9, 0x8e, 0x72, 0xcd, 0xf7, 0xcd,
+		0x5a, 0x01, 0xed, 0x40, 0x3e, 0x09, 0xdd, 0xc9, 0x42, 0x41, 0x9e, 0x42, 0x5f, 0xc1, 0x17, 0xc9,
+		0x81, 0x1b, 0xa6, 0x6e, 0x54, 0xb5, 0x82, 0xa5, 0x54, 0x2c, 0x43, 0x3b, 0x91, 0xa7, 0x27, 0xd9,
+		0xae, 0xa9, 0x1f, 0xb3, 0xfa, 0xac, 0x9a, 0xf2, 0x4c, 0xfe, 0x6f, 0x12, 0xdc, 0x54, 0x3d, 0x97,
+		0x3a, 0x6e, 0x9b, 0x28, 0x81, 0x41, 0xde, 0xeb, 0xe1, 0x3d, 0xc7, 0xf3, 0xd1, 0x7d, 0xb8, 0x17,
+		0xf9, 0x17, 0xee, 0x2d, 0xdd, 0xd0, 0x4d, 0x5d, 0x31, 0x4b, 0x38, 0x96, 0xdf, 0xb1, 0x30, 0xd6,
+		0x90, 0x05, 0x0d, 0x87, 0x79, 0x1d, 0x0d, 0xc3, 0x9a, 0x89, 0x4f, 0x45, 0x29, 0x84, 0x0a, 0x33,
+		0x1a, 0xab, 0x62, 0xd6, 0xdf, 0xa2, 0xff, 0xe5, 0x74, 0xfe, 0x0f, 0x12, 0x64, 0xc4, 0x6f, 0x5b,
+		0xfe, 0xd3, 0x27, 0x0b, 0xab, 0x6c, 0x83, 0xa5, 0xaa, 0x69, 0x99, 0xa7, 0x65, 0xad, 0xb7, 0x86,
+		0x7b, 0x56, 0xb8, 0x3c, 0x58, 0x66, 0x29, 0xcc, 0x4e, 0xa8, 0x24, 0xbd, 0x00, 0xf1, 0x16, 0x86,
+		0xe1, 0x60, 0x39, 0x35, 0x16, 0x13, 0xfa, 0x49, 0xa3, 0x0d, 0xb8, 0xd9, 0x83, 0x39, 0xd2, 0x14,
+		0x6c, 0x1e, 0x68, 0x8a, 0x29, 0x4f, 0xe5, 0x7f, 0x27, 0xc1, 0xad, 0x48, 0x09, 0x4d, 0x36, 0x58,
+		0x9d, 0x26, 0xa9, 0x97, 0xda, 0x54, 0xb5, 0xdb, 0x01, 0x41, 0x0f, 0xe1, 0x7e, 0x47, 0xc3, 0x4c,
+		0xa5, 0xf2, 0xaa, 0x7b, 0x56, 0x96, 0xaa, 0xb0, 0xe6, 0xee, 0xee, 0x26, 0x11, 0x2a, 0x42, 0x90,
+		0x25, 0xf4, 0x19, 0x7c, 0x32, 0x1e, 0x8a, 0xb5, 0x8a, 0x66, 0xca, 0xa9, 0xfc, 0x3f, 0x33, 0xb0,
+		0x1e, 0x0f, 0x8e, 0xfd, 0x40, 0x20, 0xf5, 0x30, 0xb4, 0x07, 0x90, 0xeb, 0x75, 0x22, 0x74, 0xae,
+		0x3f, 0xae, 0x3d, 0xd8, 0x1e, 0x83, 0xab, 0x1a, 0x47, 0x8a, 0x51, 0x60, 0xcf, 0x11, 0x48, 0x96,
+		0xd0, 0x73, 0xd8, 0x1f, 0x43, 0x39, 0x50, 0x0a, 0xdd, 0x2c, 0x77, 0x26, 0x8e, 0x62, 0x9a, 0x58,
+		0x3f, 0xa8, 0x9a, 0x5a, 0x45, 0x4e, 0x21, 0x0d, 0x94, 0x04, 0x07, 0xbd, 0x3a, 0x34, 0xd4, 0x4d,
+		0x1a, 0x3d, 0x81, 0xaf, 0x93, 0xe2, 0x08, 0x4b, 0x46, 0x3f, 0xd6, 0x70, 0x9c, 0x3a, 0x85, 0x9e,
+		0xc2, 0x37, 0x09, 0x54, 0xf1, 0xe6, 0x01, 0xee, 0x34, 0xda, 0x87, 0xc7, 0x89, 0xd1, 0xab, 0x25,
+		0x5c, 0xb0, 0x8e, 0x15, 0xfc, 0xaa, 0x97, 0x3c, 0x83, 0x74, 0xd0, 0x92, 0x5e, 0x2c, 0xd4, 0xcd,
+		0x1a, 0xa2, 0x0b, 0x31, 0x57, 0x37, 0x26, 0xc8, 0x22, 0x33, 0x24, 0xb8, 0x99, 0x45, 0x2f, 0x40,
+		0x9d, 0x2c, 0x15, 0xe3, 0x1d, 0xcd, 0xa1, 0x37, 0x60, 0x5e, 0xef, 0x54, 0xb5, 0x37, 0xa6, 0x86,
+		0x0d, 0x25, 0xc9, 0x33, 0xa0, 0x67, 0xf0, 0x24, 0x31, 0x69, 0xbd, 0xfa, 0x13, 0xa3, 0x67, 0xd0,
+		0x63, 0xf8, 0x72, 0x0c, 0x3d, 0x5e, 0x23, 0xdd, 0x5b, 0x81, 0x5e, 0x90, 0xe7, 0xd1, 0xd7, 0xb0,
+		0x37, 0x86, 0xc8, 0xbb, 0xd0, 0xaa, 0x98, 0xba, 0xfa, 0xea, 0x34, 0x5c, 0x2e, 0xea, 0x15, 0x53,
+		0x5e, 0x40, 0x3f, 0x82, 0x1f, 0x8c, 0xa1, 0x75, 0x36, 0xcb, 0xfe, 0xd0, 0x70, 0xac, 0xc5, 0x18,
+		0xac, 0x8a, 0x35, 0x79, 0x71, 0x82, 0x33, 0xa9, 0xe8, 0x2f, 0x92, 0x33, 0xb7, 0x84, 0x54, 0x78,
+		0x3e, 0x51, 0x8b, 0xa8, 0x47, 0x7a, 0xb1, 0x30, 0xdc, 0x89, 0x8c, 0xbe, 0x84, 0xdd, 0x31, 0x4e,
+		0x0e, 0x4b, 0x58, 0xd5, 0xc4, 0xc4, 0xea, 0x88, 0xc4, 0x32, 0xfa, 0x06, 0x1e, 0x8d, 0x23, 0x29,
+		0x7a, 0xb1, 0xf4, 0x5a, 0xc3, 0xfd, 0x3c, 0xc4, 0xc6, 0xe8, 0x64, 0x5b, 0xd7, 0x8d, 0x72, 0xd5,
+		0xb4, 0x2a, 0xfa, 0x77, 0x9a, 0xbc, 0xc2, 0xc6, 0x68, 0xe2, 0x49, 0x45, 0xb9, 0x92, 0x57, 0x07,
+		0xc5, 0x78, 0xe0, 0x25, 0x07, 0xba, 0xa1, 0xe0, 0x53, 0x79, 0x2d, 0xa1, 0xf6, 0x06, 0x85, 0xae,
+		0xa7, 0x84, 0x6e, 0x4e, 0xb2, 0x1d, 0x4d, 0xc1, 0xea, 0x51, 0x3c, 0xe3, 0xeb, 0x6c, 0xea, 0xdc,
+		0xe3, 0x1f, 0x5c, 0x06, 0xee, 0x55, 0x71, 0x89, 0xdf, 0x83, 0xed, 0xf0, 0xdc, 0x86, 0x54, 0xc1,
+		0x08, 0xb5, 0x3f, 0x80, 0x1f, 0x4e, 0x46, 0xe9, 0xac, 0x2b, 0x45, 0xac, 0x29, 0x85, 0xd3, 0xce,
+		0x95, 0x54, 0xca, 0xff, 0x3a, 0x05, 0x79, 0xd5, 0x76, 0x6b, 0xa4, 0x11, 0x7d, 0xc7, 0x1d, 0x1b,
+		0xe5, 0x3e, 0x3c, 0x9e, 0xa0, 0xdf, 0x47, 0xc4, 0x7b, 0x02, 0x95, 0xeb, 0x92, 0xab, 0xc6, 0x2b,
+		0xa3, 0x74, 0x62, 0x8c, 0x23, 0xc8, 0x12, 0x32, 0xe0, 0xe5, 0x75, 0x1d, 0x0f, 0xa4, 0xa4, 0x7b,
+		0x0f, 0x4d, 0xf1, 0xa4, 0x54, 0x9c, 0x0b, 0xfe, 0x51, 0x7b, 0xb2, 0xa4, 0x88, 0x32, 0xfe, 0xcf,
+		0x92, 0x72, 0x5d, 0xf2, 0xc4, 0x49, 0xb9, 0xae, 0xe3, 0x71, 0x49, 0x39, 0xf8, 0x29, 0xac, 0xd7,
+		0xbc, 0xe6, 0xb0, 0xaf, 0x0c, 0x07, 0x0b, 0x51, 0x7a, 0xca, 0xec, 0x67, 0x76, 0x59, 0xfa, 0x6e,
+		0xef, 0xc2, 0xa1, 0x97, 0xed, 0xb3, 0x9d, 0x9a, 0xd7, 0xdc, 0x8d, 0xff, 0x87, 0x79, 0xdb, 0xa9,
+		0x37, 0x76, 0x2f, 0xbc, 0xf0, 0x3f, 0xd6, 0xe2, 0xdf, 0xcd, 0xfb, 0x76, 0xcb, 0x79, 0xb7, 0x77,
+		0x36, 0xc3, 0x6d, 0x5f, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x4c, 0xea, 0x44, 0x2e, 0x1f,
+		0x00, 0x00,
+	},
+}
@@ -0,0 +1,316 @@
+// The MIT License (MIT)
+// 
+// Copyright (c) 2021 Uber Technologies, Inc.
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/admin/v1/replication.proto
+
+package adminv1
+
+var yarpcFileDescriptorClosure90118d56a5f1c507 = [][]byte{
+	// uber/cadence/admin/v1/replication.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x5b, 0x73, 0xe2, 0xc8,
+		0x15, 0x5e, 0x81, 0x01, 0x73, 0xc0, 0xc0, 0xb4, 0xbd, 0x63, 0x8d, 0x67, 0x5c, 0xc3, 0xb0, 0x73,
+		0xf5, 0x56, 0x20, 0xe3, 0xcd, 0xe6, 0x5a, 0xa9, 0x2d, 0x0d, 0xe0, 0x58, 0x19, 0x5f, 0x1b, 0xc6,
+		0x9b, 0x49, 0x55, 0x4a, 0x25, 0x4b, 0x6d, 0xa3, 0x32, 0x48, 0x54, 0x77, 0x83, 0x97, 0xb7, 0xe4,
+		0x3d, 0x55, 0x79, 0xc9, 0x5b, 0x1e, 0xf3, 0x13, 0xf2, 0x9a, 0x1f, 0x91, 0x7f, 0x31, 0x7f, 0x23,
+		0xa5, 0xee, 0x16, 0x20, 0x6e, 0xe3, 0xc4, 0x0f, 0xfb, 0x86, 0xce, 0xf9, 0xce, 0x45, 0xa7, 0x4f,
+		0x7f, 0xe7, 0x08, 0x78, 0x35, 0xb8, 0x24, 0xb4, 0xe6, 0xd8, 0x2e, 0xf1, 0x1d, 0x52, 0xb3, 0xdd,
+		0x9e, 0xe7, 0xd7, 0x86, 0x6f, 0x6b, 0x94, 0xf4, 0xbb, 0x9e, 0x63, 0x73, 0x2f, 0xf0, 0xab, 0x7d,
+		0x1a, 0xf0, 0x00, 0x7d, 0x19, 0x02, 0xab, 0x0a, 0x58, 0x15, 0xc0, 0xea, 0xf0, 0xed, 0xce, 0xd3,
+		0xeb, 0x20, 0xb8, 0xee, 0x92, 0x9a, 0x00, 0x5d, 0x0e, 0xae, 0x6a, 0xdc, 0xeb, 0x11, 0xc6, 0xed,
+		0x5e, 0x5f, 0xda, 0xed, 0x94, 0xe3, 0x01, 0xfa, 0x5e, 0xe8, 0xde, 0x09, 0x7a, 0xbd, 0xc0, 0x5f,
+		0x85, 0x70, 0x83, 0x9e, 0xed, 0x45, 0x88, 0xaf, 0x16, 0x27, 0xd9, 0xf1, 0x18, 0x0f, 0xe8, 0x48,
+		0x82, 0x2a, 0x7f, 0x4f, 0xc0, 0x26, 0x9e, 0xa4, 0x7d, 0x4c, 0x18, 0xb3, 0xaf, 0x09, 0x43, 0x2d,
+		0x78, 0x30, 0xf5, 0x36, 0x16, 0xb7, 0xd9, 0x0d, 0xd3, 0xb5, 0x72, 0xf2, 0x75, 0x6e, 0xff, 0x65,
+		0x75, 0xe1, 0x4b, 0x55, 0xa7, 0xdc, 0xb4, 0x6d, 0x76, 0x83, 0x4b, 0x34, 0x2e, 0x60, 0xe8, 0x57,
+		0xf0, 0xa8, 0x6b, 0x33, 0x6e, 0x51, 0xc2, 0xa9, 0x47, 0x86, 0xc4, 0xb5, 0x7a, 0x32, 0x9e, 0xe5,
+		0xb9, 0x7a, 0xa2, 0xac, 0xbd, 0x4e, 0xe2, 0x87, 0x21, 0x00, 0x47, 0x7a, 0x95, 0x8e, 0xe9, 0xa2,
+		0x47, 0xb0, 0xde, 0xb1, 0x99, 0xd5, 0x0b, 0x28, 0xd1, 0x93, 0x65, 0xed, 0xf5, 0x3a, 0xce, 0x74,
+		0x6c, 0x76, 0x1c, 0x50, 0x82, 0x30, 0x3c, 0x60, 0x23, 0xdf, 0xb1, 0x58, 0xc7, 0xa6, 0xae, 0xc5,
+		0xb8, 0xcd, 0x07, 0x4c, 0x5f, 0x2b, 0x6b, 0x2b, 0x52, 0x6d, 0x8d, 0x7c, 0xa7, 0x15, 0xc2, 0x5b,
+		0x02, 0x8d, 0x8b, 0x2c, 0x2e, 0xa8, 0xfc, 0x2d, 0x0d, 0xc5, 0x99, 0xf7, 0x41, 0xbf, 0x83, 0x6c,
+		0x58, 0x06, 0x8b, 0x8f, 0xfa, 0x44, 0xd7, 0xca, 0xda, 0xeb, 0xc2, 0xfe, 0xde, 0xdd, 0x4a, 0xd1,
+		0x1e, 0xf5, 0x09, 0x5e, 0xe7, 0xea, 0x17, 0x7a, 0x0e, 0x05, 0x16, 0x0c, 0xa8, 0x43, 0x44, 0x59,
+		0x27, 0xef, 0x9e, 0x97, 0xd2, 0xd0, 0xc2, 0x74, 0xd1, 0x77, 0xb0, 0xe1, 0x50, 0xa2, 0xca, 0xef,
+		0xf5, 0xe4, 0x6b, 0xe7, 0xf6, 0x77, 0xaa, 0xb2, 0x77, 0xaa, 0x51, 0xef, 0x54, 0xdb, 0x51, 0xef,
+		0xe0, 0x7c, 0x64, 0x10, 0x8a, 0x90, 0x03, 0x0f, 0x65, 0x3f, 0xc8, 0x30, 0x36, 0xe7, 0xd4, 0xbb,
+		0x1c, 0x70, 0x12, 0x15, 0xe7, 0xeb, 0x25, 0xc9, 0x37, 0x84, 0x51, 0x98, 0x85, 0x31, 0x36, 0x39,
+		0xfc, 0x02, 0x6f, 0xb9, 0x0b, 0xe4, 0xe8, 0xcf, 0x1a, 0x3c, 0x9b, 0xab, 0xfe, 0x5c, 0xc0, 0x94,
+		0x08, 0xf8, 0xb3, 0xbb, 0x9d, 0xc6, 0x5c, 0xe4, 0x5d, 0xb6, 0x0a, 0x80, 0x86, 0x20, 0x00, 0x96,
+		0xed, 0x70, 0x6f, 0xe8, 0xf1, 0xd1, 0x5c, 0xf4, 0xb4, 0x88, 0xfe, 0x76, 0x45, 0x74, 0x43, 0x99,
+		0xce, 0x85, 0xde, 0x61, 0x4b, 0xb5, 0xa8, 0x07, 0x3b, 0xea, 0x2e, 0xc9, 0x88, 0xc3, 0xfd, 0xe9,
+		0xa0, 0x19, 0x11, 0xb4, 0xba, 0x24, 0xe8, 0xa1, 0x34, 0x0c, 0x3d, 0x5e, 0xec, 0xc7, 0x22, 0x6e,
+		0x77, 0x16, 0xab, 0x50, 0x00, 0x3b, 0x57, 0xb6, 0xd7, 0x0d, 0x86, 0x84, 0x5a, 0x3d, 0x9b, 0xde,
+		0x10, 0x3a, 0x1d, 0x6e, 0x5d, 0x84, 0xab, 0x2d, 0x09, 0x77, 0xa0, 0x0c, 0x8f, 0x85, 0x5d, 0x2c,
+		0x9e, 0x7e, 0xb5, 0x44, 0xf7, 0x2e, 0x0f, 0x30, 0x09, 0x50, 0xf9, 0x77, 0x02, 0xb6, 0x16, 0x75,
+		0x06, 0x3a, 0x87, 0x92, 0x6a, 0xb3, 0xa0, 0x4f, 0xa8, 0x68, 0x3f, 0x75, 0x3b, 0x5e, 0xae, 0x6c,
+		0xb0, 0xd3, 0x08, 0x8d, 0x8b, 0x6e, 0x5c, 0x80, 0x0a, 0x90, 0x50, 0x97, 0x22, 0x8b, 0x13, 0x9e,
+		0x8b, 0xbe, 0x81, 0xb4, 0x84, 0xa8, 0x3b, 0xf0, 0x78, 0xc6, 0x71, 0xdf, 0x9b, 0xb8, 0xc5, 0x0a,
+		0x8a, 0x5e, 0x40, 0xc1, 0x09, 0xfc, 0x2b, 0xef, 0xda, 0x1a, 0x12, 0xca, 0xc2, 0xac, 0xd6, 0xc4,
+		0x2d, 0xdb, 0x90, 0xd2, 0x0b, 0x29, 0x44, 0x6f, 0xa0, 0x34, 0x2e, 0x6b, 0x04, 0x4c, 0x09, 0x60,
+		0x31, 0x92, 0x47, 0xd0, 0x5f, 0xc3, 0xa3, 0x3e, 0x25, 0x43, 0x2f, 0x18, 0x30, 0x6b, 0xce, 0x26,
+		0x2d, 0x6c, 0xb6, 0x23, 0xc0, 0x41, 0xdc, 0xb6, 0xf2, 0x0f, 0x0d, 0x76, 0x57, 0xf6, 0x79, 0x98,
+		0xaf, 0x62, 0x05, 0xa7, 0x3b, 0x60, 0x9c, 0x50, 0x51, 0xc5, 0x2c, 0xde, 0x90, 0xd2, 0xba, 0x14,
+		0x86, 0x44, 0x28, 0xaf, 0x9a, 0xaa, 0x50, 0x0a, 0x67, 0xc4, 0xb3, 0xe9, 0xa2, 0x5f, 0x42, 0x76,
+		0x3c, 0x47, 0xee, 0xc0, 0x16, 0x13, 0x70, 0xe5, 0x53, 0x0a, 0x76, 0x96, 0xdf, 0x03, 0xf4, 0x18,
+		0xb2, 0xea, 0x88, 0x3d, 0x57, 0x65, 0xb5, 0x2e, 0x05, 0xa6, 0x8b, 0x3e, 0x00, 0xba, 0x0d, 0xe8,
+		0xcd, 0x55, 0x37, 0xb8, 0xb5, 0xc8, 0x0f, 0xc4, 0x19, 0x88, 0x0e, 0x48, 0x2c, 0xe4, 0x5f, 0x79,
+		0x50, 0xdf, 0x2b, 0x78, 0x33, 0x42, 0xe3, 0x07, 0xb7, 0xb3, 0x22, 0xa4, 0x43, 0x26, 0x2a, 0x6d,
+		0x52, 0x94, 0x36, 0x7a, 0x44, 0xcf, 0x20, 0xcf, 0x9c, 0x0e, 0x71, 0x07, 0x5d, 0x22, 0xaa, 0x20,
+		0x8f, 0x35, 0x37, 0x96, 0x99, 0x2e, 0x32, 0xa0, 0x30, 0x81, 0x08, 0xf2, 0x4c, 0x7d, 0xb6, 0x1c,
+		0x1b, 0x63, 0x8b, 0x50, 0x86, 0x76, 0x01, 0x18, 0xb7, 0x29, 0x97, 0x31, 0xe4, 0xe9, 0x66, 0x95,
+		0xc4, 0x74, 0xd1, 0x6f, 0x21, 0x1f, 0xa9, 0x85, 0xff, 0xcc, 0x67, 0xfd, 0xe7, 0x14, 0x5e, 0x78,
+		0xff, 0x3d, 0x6c, 0x8a, 0x49, 0xd8, 0x21, 0x36, 0xe5, 0x97, 0xc4, 0xe6, 0xd2, 0xcb, 0xfa, 0x67,
+		0xbd, 0x3c, 0x08, 0xcd, 0x0e, 0x23, 0x2b, 0xe1, 0xeb, 0xe7, 0x90, 0x71, 0x09, 0xb7, 0xbd, 0x2e,
+		0xd3, 0xb3, 0xc2, 0xfe, 0xc9, 0xc2, 0xaa, 0x9f, 0xd9, 0xa3, 0x6e, 0x60, 0xbb, 0x38, 0x02, 0x87,
+		0x15, 0xb6, 0x39, 0x27, 0xbd, 0x3e, 0xd7, 0x41, 0x36, 0x92, 0x7a, 0x44, 0xdf, 0x41, 0x5e, 0x64,
+		0x17, 0x36, 0xf9, 0x80, 0x12, 0x3d, 0xb7, 0xc2, 0xed, 0x81, 0xc4, 0xe0, 0x5c, 0x68, 0xa1, 0x1e,
+		0xd0, 0x4f, 0x61, 0x4b, 0x38, 0x08, 0x8f, 0x95, 0x50, 0xcb, 0x73, 0x89, 0xcf, 0x3d, 0x3e, 0xd2,
+		0xf3, 0xa2, 0x77, 0x50, 0xa8, 0xfb, 0x5e, 0xa8, 0x4c, 0xa5, 0x41, 0x27, 0x50, 0x54, 0xe7, 0x6b,
+		0x29, 0x02, 0xd4, 0x37, 0x44, 0xd4, 0x17, 0x4b, 0x48, 0x44, 0x5d, 0x2c, 0x45, 0xa4, 0xb8, 0x30,
+		0x8c, 0x3d, 0xa3, 0x87, 0x90, 0xee, 0xdb, 0x03, 0x46, 0x5c, 0xbd, 0x20, 0xb6, 0x05, 0xf5, 0x54,
+		0xf9, 0x4b, 0x12, 0xb6, 0x97, 0x90, 0x2f, 0xda, 0x86, 0x4c, 0x34, 0x90, 0x35, 0x71, 0xde, 0x69,
+		0x2e, 0x47, 0x71, 0xac, 0xff, 0x13, 0x77, 0xea, 0xff, 0xe4, 0x7d, 0xfb, 0xff, 0x4f, 0xf0, 0xe5,
+		0x4c, 0x41, 0x2c, 0x8f, 0x93, 0x5e, 0x38, 0xbc, 0xc3, 0x25, 0xec, 0xcd, 0x9d, 0xca, 0x62, 0x72,
+		0xd2, 0xc3, 0x9b, 0xc3, 0x39, 0x19, 0x43, 0xdf, 0x42, 0x9a, 0x0c, 0x89, 0xcf, 0xa3, 0xd9, 0xbc,
+		0xbb, 0x98, 0x52, 0x6d, 0x6e, 0xbf, 0xeb, 0x06, 0x97, 0x58, 0x81, 0x51, 0x1d, 0x0a, 0x3e, 0xb9,
+		0xb5, 0xe8, 0xc0, 0xb7, 0x94, 0x79, 0xfa, 0x2e, 0xe6, 0x79, 0x9f, 0xdc, 0xe2, 0x81, 0xdf, 0x14,
+		0x26, 0x95, 0x7f, 0x6a, 0xa0, 0x2f, 0x9b, 0x48, 0xab, 0xb9, 0x66, 0x11, 0x59, 0x27, 0x16, 0x93,
+		0xf5, 0x7d, 0xd7, 0xa7, 0xca, 0x5f, 0x35, 0xd8, 0x8c, 0x67, 0xd9, 0x0e, 0x6e, 0x88, 0x1f, 0x26,
+		0x18, 0x11, 0xb0, 0xdc, 0x88, 0x53, 0x78, 0x5d, 0x31, 0x30, 0x43, 0x7f, 0x80, 0xe2, 0xcc, 0x90,
+		0xd6, 0x13, 0xff, 0xd7, 0x64, 0xc6, 0x85, 0xf8, 0x5c, 0xae, 0x7c, 0x8a, 0x2f, 0xea, 0x62, 0x49,
+		0xf4, 0xaf, 0x82, 0x1f, 0x85, 0x9b, 0x1f, 0x4f, 0x6f, 0xc2, 0x49, 0xc1, 0x1d, 0x93, 0xed, 0x76,
+		0xea, 0x16, 0xad, 0xc5, 0x6e, 0xd1, 0x14, 0xa3, 0xa7, 0xe2, 0x8c, 0xfe, 0x1c, 0x0a, 0x57, 0x1e,
+		0x65, 0x5c, 0xf6, 0xd4, 0x84, 0x6f, 0xf3, 0x42, 0x2a, 0xba, 0xc6, 0x74, 0x51, 0x05, 0x36, 0x7c,
+		0xf2, 0xc3, 0x14, 0x28, 0x23, 0x89, 0x3f, 0x14, 0x46, 0x98, 0xd9, 0xd9, 0xb0, 0x3e, 0x3f, 0x1b,
+		0x9e, 0x42, 0x8e, 0x50, 0x1a, 0x50, 0xcb, 0xe9, 0xda, 0x4c, 0x52, 0x66, 0x16, 0x83, 0x10, 0xd5,
+		0x43, 0x49, 0xd8, 0x9e, 0xa5, 0xe9, 0x4a, 0x8b, 0x53, 0x9f, 0x1e, 0xbb, 0x5a, 0x7c, 0xec, 0xde,
+		0xe3, 0xab, 0x26, 0x32, 0xed, 0xd3, 0xc0, 0x21, 0x8c, 0xc5, 0x4d, 0x93, 0x13, 0xd3, 0xb3, 0x48,
+		0x3f, 0x36, 0xad, 0xbc, 0x87, 0xe2, 0xcc, 0x3e, 0x11, 0x9f, 0xff, 0xda, 0xff, 0x32, 0xff, 0x7d,
+		0xd8, 0x52, 0xec, 0xd0, 0x38, 0x3a, 0xaf, 0x07, 0x03, 0x9f, 0x37, 0x7d, 0x4e, 0x47, 0x68, 0x0b,
+		0x52, 0x4e, 0xf8, 0xa4, 0xf8, 0x50, 0x3e, 0xac, 0x5a, 0x41, 0xe6, 0x97, 0x98, 0xe4, 0x82, 0x25,
+		0xa6, 0xf2, 0x2f, 0x0d, 0xb2, 0x8d, 0xa3, 0xf3, 0x03, 0xaf, 0x1b, 0xae, 0x34, 0x2b, 0x5b, 0xf8,
+		0x29, 0xe4, 0xc6, 0x2d, 0x3c, 0x66, 0x5f, 0x88, 0x44, 0xa6, 0x1b, 0xff, 0x2c, 0x4b, 0xde, 0xe3,
+		0xb3, 0x6c, 0xa6, 0x31, 0xd6, 0x66, 0x1b, 0x63, 0xef, 0x3f, 0xf3, 0x57, 0x50, 0x18, 0x3e, 0x83,
+		0x5d, 0xdc, 0x3c, 0x3b, 0x32, 0xeb, 0x46, 0xdb, 0x3c, 0x3d, 0xb1, 0xda, 0x46, 0xeb, 0xbd, 0xd5,
+		0xfe, 0x78, 0xd6, 0xb4, 0xcc, 0x93, 0x0b, 0xe3, 0xc8, 0x6c, 0x94, 0xbe, 0x40, 0x65, 0x78, 0xb2,
+		0x18, 0xd2, 0x38, 0x3d, 0x36, 0xcc, 0x93, 0x92, 0xb6, 0xdc, 0xc9, 0xa1, 0xd9, 0x6a, 0x9f, 0xe2,
+		0x8f, 0xa5, 0x04, 0xfa, 0x1a, 0x5e, 0x2d, 0x86, 0xb4, 0x3e, 0x9e, 0xd4, 0xad, 0xd6, 0xa1, 0x81,
+		0x1b, 0x56, 0xab, 0x6d, 0xb4, 0x3f, 0xb4, 0x4a, 0x49, 0xf4, 0x0a, 0xbe, 0x5a, 0x01, 0x36, 0xea,
+		0x6d, 0xf3, 0xc2, 0x6c, 0x7f, 0x2c, 0xad, 0xa1, 0x3d, 0x78, 0xb9, 0x32, 0xb0, 0x75, 0xdc, 0x6c,
+		0x1b, 0x0d, 0xa3, 0x6d, 0x94, 0x52, 0xe8, 0x39, 0x94, 0x57, 0x63, 0x2f, 0xf6, 0x4b, 0x69, 0xf4,
+		0x06, 0x5e, 0x2c, 0x46, 0x1d, 0x18, 0xe6, 0xd1, 0xe9, 0x45, 0x13, 0x5b, 0xc7, 0x06, 0x7e, 0xdf,
+		0xc4, 0xa5, 0xcc, 0x9e, 0x07, 0xc5, 0x99, 0xaf, 0x01, 0xf4, 0x04, 0x74, 0x59, 0x14, 0xeb, 0xf4,
+		0xac, 0x89, 0xa5, 0x8b, 0x49, 0x21, 0x1f, 0xc3, 0xf6, 0x9c, 0xb6, 0x8e, 0x9b, 0x46, 0xbb, 0x59,
+		0xd2, 0x16, 0x2a, 0x3f, 0x9c, 0x35, 0x42, 0x65, 0x62, 0xef, 0x04, 0x32, 0x8d, 0xa3, 0x73, 0x71,
+		0x60, 0x5b, 0x50, 0x6a, 0x1c, 0x9d, 0xcf, 0x9e, 0x91, 0x0e, 0x5b, 0x63, 0xe9, 0x54, 0xfe, 0x25,
+		0x0d, 0x6d, 0x42, 0x71, 0xac, 0x51, 0x07, 0x96, 0x78, 0xf7, 0x8b, 0x3f, 0x7e, 0x7b, 0xed, 0xf1,
+		0xce, 0xe0, 0xb2, 0xea, 0x04, 0xbd, 0xda, 0xf4, 0x7f, 0x2d, 0x3f, 0xf1, 0xdc, 0x6e, 0xed, 0x3a,
+		0x90, 0xff, 0xee, 0x8c, 0xff, 0x78, 0xf9, 0x8d, 0xf8, 0x31, 0x7c, 0x7b, 0x99, 0x16, 0xf2, 0x6f,
+		0xfe, 0x3b, 0x00, 0x2a, 0x32, 0x97, 0xab, 0x45, 0x12, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/common.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdf, 0x72, 0xdb, 0xc4,
+		0x17, 0xfe, 0x29, 0x8e, 0x9d, 0xe4, 0xd8, 0x4d, 0xfc, 0xdb, 0x90, 0xc4, 0x49, 0x09, 0xa4, 0x9a,
+		0x61, 0x1a, 0x3a, 0x20, 0x4f, 0xdc, 0x9b, 0x0e, 0x9d, 0x02, 0x4e, 0xec, 0x24, 0x6a, 0x83, 0x6d,
+		0x64, 0xd3, 0x4c, 0x61, 0x06, 0xcd, 0x5a, 0x5a, 0xb9, 0x8b, 0xa5, 0x5d, 0xb1, 0x5a, 0x39, 0xf1,
+		0x0d, 0xc3, 0x93, 0x70, 0xc1, 0xeb, 0x70, 0xc9, 0x0b, 0x31, 0xab, 0x3f, 0xb1, 0x5d, 0x9c, 0x29,
+		0x17, 0x0c, 0x77, 0xbb, 0xe7, 0xfb, 0xce, 0x39, 0xdf, 0xae, 0xce, 0x39, 0x5a, 0x38, 0x8a, 0x87,
+		0x44, 0xd4, 0x1d, 0xec, 0x12, 0xe6, 0x90, 0x3a, 0x0e, 0x69, 0x7d, 0x72, 0x52, 0x77, 0x78, 0x10,
+		0x70, 0x66, 0x84, 0x82, 0x4b, 0x8e, 0xb6, 0x15, 0xc3, 0xc8, 0x18, 0x06, 0x0e, 0xa9, 0x31, 0x39,
+		0x39, 0xf8, 0x68, 0xc4, 0xf9, 0xc8, 0x27, 0xf5, 0x84, 0x32, 0x8c, 0xbd, 0xba, 0x1b, 0x0b, 0x2c,
+		0x69, 0xee, 0xa4, 0xbf, 0x82, 0xff, 0x5f, 0x73, 0x31, 0xf6, 0x7c, 0x7e, 0xd3, 0xbe, 0x25, 0x4e,
+		0xac, 0x20, 0xf4, 0x31, 0x94, 0x6f, 0x32, 0xa3, 0x4d, 0xdd, 0x9a, 0x76, 0xa4, 0x1d, 0x6f, 0x58,
+		0x90, 0x9b, 0x4c, 0x17, 0xed, 0x40, 0x49, 0xc4, 0x4c, 0x61, 0x2b, 0x09, 0x56, 0x14, 0x31, 0x33,
+		0x5d, 0x5d, 0x87, 0x4a, 0x1e, 0x6c, 0x30, 0x0d, 0x09, 0x42, 0xb0, 0xca, 0x70, 0x40, 0xb2, 0x00,
+		0xc9, 0x5a, 0x71, 0x9a, 0x8e, 0xa4, 0x13, 0x2a, 0xa7, 0xf7, 0x72, 0x0e, 0x61, 0xad, 0x87, 0xa7,
+		0x3e, 0xc7, 0xae, 0x82, 0x5d, 0x2c, 0x71, 0x02, 0x57, 0xac, 0x64, 0xad, 0x3f, 0x87, 0xb5, 0x73,
+		0x4c, 0xfd, 0x58, 0x10, 0xb4, 0x0b, 0x25, 0x41, 0x70, 0xc4, 0x59, 0xe6, 0x9f, 0xed, 0x50, 0x0d,
+		0xd6, 0x5c, 0x22, 0x31, 0xf5, 0xa3, 0x44, 0x61, 0xc5, 0xca, 0xb7, 0xfa, 0x6f, 0x1a, 0xac, 0x7e,
+		0x43, 0x02, 0x8e, 0x5e, 0x40, 0xc9, 0xa3, 0xc4, 0x77, 0xa3, 0x9a, 0x76, 0x54, 0x38, 0x2e, 0x37,
+		0x3e, 0x31, 0x96, 0xdc, 0x9f, 0xa1, 0xa8, 0xc6, 0x79, 0xc2, 0x6b, 0x33, 0x29, 0xa6, 0x56, 0xe6,
+		0x74, 0x70, 0x0d, 0xe5, 0x39, 0x33, 0xaa, 0x42, 0x61, 0x4c, 0xa6, 0x99, 0x0a, 0xb5, 0x44, 0x0d,
+		0x28, 0x4e, 0xb0, 0x1f, 0x93, 0x44, 0x40, 0xb9, 0xf1, 0xe1, 0xd2, 0xf0, 0xd9, 0x31, 0xad, 0x94,
+		0xfa, 0xc5, 0xca, 0x33, 0x4d, 0xff, 0x5d, 0x83, 0xd2, 0x25, 0xc1, 0x2e, 0x11, 0xe8, 0xab, 0x77,
+		0x24, 0x3e, 0x5e, 0x1a, 0x23, 0x25, 0xff, 0xb7, 0x22, 0xff, 0xd4, 0xa0, 0xda, 0x27, 0x58, 0x38,
+		0x6f, 0x9b, 0x52, 0x0a, 0x3a, 0x8c, 0x25, 0x89, 0x90, 0x0d, 0x9b, 0x94, 0xb9, 0xe4, 0x96, 0xb8,
+		0xf6, 0x82, 0xec, 0x67, 0x4b, 0xa3, 0xbe, 0xeb, 0x6e, 0x98, 0xa9, 0xef, 0xfc, 0x39, 0x1e, 0xd0,
+		0x79, 0xdb, 0xc1, 0x8f, 0x80, 0xfe, 0x4e, 0xfa, 0x17, 0x4f, 0xe5, 0xc1, 0x7a, 0x0b, 0x4b, 0x7c,
+		0xea, 0xf3, 0x21, 0x3a, 0x87, 0x07, 0x84, 0x39, 0xdc, 0xa5, 0x6c, 0x64, 0xcb, 0x69, 0x98, 0x16,
+		0xe8, 0x66, 0xe3, 0xd1, 0xd2, 0x58, 0xed, 0x8c, 0xa9, 0x2a, 0xda, 0xaa, 0x90, 0xb9, 0xdd, 0x5d,
+		0x01, 0xaf, 0xcc, 0x15, 0x70, 0x2f, 0x6d, 0x3a, 0x22, 0x5e, 0x13, 0x11, 0x51, 0xce, 0x4c, 0xe6,
+		0x71, 0x45, 0xa4, 0x41, 0xe8, 0xe7, 0x8d, 0xa0, 0xd6, 0xe8, 0x31, 0x6c, 0x79, 0x04, 0xcb, 0x58,
+		0x10, 0x7b, 0x92, 0x52, 0xb3, 0x86, 0xdb, 0xcc, 0xcc, 0x59, 0x00, 0xfd, 0x15, 0xec, 0xf5, 0xe3,
+		0x30, 0xe4, 0x42, 0x12, 0xf7, 0xcc, 0xa7, 0x84, 0xc9, 0x0c, 0x89, 0x54, 0xaf, 0x8e, 0xb8, 0x1d,
+		0xb9, 0xe3, 0x2c, 0x72, 0x71, 0xc4, 0xfb, 0xee, 0x18, 0xed, 0xc3, 0xfa, 0x4f, 0x78, 0x82, 0x13,
+		0x20, 0x8d, 0xb9, 0xa6, 0xf6, 0x7d, 0x77, 0xac, 0xff, 0x5a, 0x80, 0xb2, 0x45, 0xa4, 0x98, 0xf6,
+		0xb8, 0x4f, 0x9d, 0x29, 0x6a, 0x41, 0x95, 0x32, 0x2a, 0x29, 0xf6, 0x6d, 0xca, 0x24, 0x11, 0x13,
+		0x9c, 0xaa, 0x2c, 0x37, 0xf6, 0x8d, 0x74, 0xbc, 0x18, 0xf9, 0x78, 0x31, 0x5a, 0xd9, 0x78, 0xb1,
+		0xb6, 0x32, 0x17, 0x33, 0xf3, 0x40, 0x75, 0xd8, 0x1e, 0x62, 0x67, 0xcc, 0x3d, 0xcf, 0x76, 0x38,
+		0xf1, 0x3c, 0xea, 0x28, 0x99, 0x49, 0x6e, 0xcd, 0x42, 0x19, 0x74, 0x36, 0x43, 0x54, 0xda, 0x00,
+		0xdf, 0xd2, 0x20, 0x0e, 0x66, 0x69, 0x0b, 0xef, 0x4d, 0x9b, 0xb9, 0xdc, 0xa5, 0xfd, 0x74, 0x16,
+		0x05, 0x4b, 0x49, 0x82, 0x50, 0x46, 0xb5, 0xd5, 0x23, 0xed, 0xb8, 0x78, 0x47, 0x6d, 0x66, 0x66,
+		0xf4, 0x02, 0x1e, 0x32, 0xce, 0x6c, 0xa1, 0x8e, 0x8e, 0x87, 0x3e, 0xb1, 0x89, 0x10, 0x5c, 0xd8,
+		0xe9, 0x48, 0x89, 0x6a, 0xc5, 0xa3, 0xc2, 0xf1, 0x86, 0x55, 0x63, 0x9c, 0x59, 0x39, 0xa3, 0xad,
+		0x08, 0x56, 0x8a, 0xa3, 0x97, 0xb0, 0x4d, 0x6e, 0x43, 0x9a, 0x0a, 0x99, 0x49, 0x2e, 0xbd, 0x4f,
+		0x32, 0x9a, 0x79, 0xe5, 0xaa, 0xf5, 0x00, 0xf6, 0xcc, 0x88, 0xfb, 0x89, 0xf1, 0x42, 0xf0, 0x38,
+		0xec, 0x61, 0x21, 0xa9, 0xda, 0x2d, 0x1b, 0x98, 0xe8, 0x4b, 0x28, 0x46, 0x12, 0xcb, 0xb4, 0xe0,
+		0x37, 0x1b, 0xc7, 0x4b, 0x8b, 0x74, 0x31, 0x60, 0x5f, 0xf1, 0xad, 0xd4, 0x4d, 0x9f, 0xc0, 0xc3,
+		0x45, 0xf4, 0x8c, 0x33, 0x8f, 0x8e, 0x32, 0x85, 0xe8, 0x1a, 0xaa, 0x34, 0x87, 0xed, 0x91, 0xc2,
+		0xf3, 0xd6, 0xfe, 0xec, 0x1f, 0x64, 0xba, 0x93, 0x6e, 0x6d, 0xd1, 0x05, 0x20, 0xd2, 0xff, 0xd0,
+		0xe0, 0xa0, 0x19, 0x4d, 0x99, 0x93, 0xff, 0x36, 0x16, 0xf3, 0xd6, 0x60, 0x8d, 0x30, 0x75, 0xcf,
+		0xe9, 0x3f, 0x68, 0xdd, 0xca, 0xb7, 0xa8, 0x01, 0x3b, 0xa1, 0x20, 0x2e, 0xf1, 0x28, 0x23, 0xae,
+		0xfd, 0x73, 0x4c, 0x62, 0x62, 0x27, 0xb7, 0x92, 0x96, 0xf2, 0xf6, 0x0c, 0xfc, 0x56, 0x61, 0x1d,
+		0x75, 0x49, 0x87, 0x00, 0x29, 0x31, 0x69, 0xe7, 0x42, 0x42, 0xdc, 0x48, 0x2c, 0x49, 0xa3, 0x7e,
+		0x0d, 0x95, 0x14, 0x76, 0x12, 0x0d, 0x49, 0x91, 0x94, 0x1b, 0x87, 0x4b, 0x0f, 0x98, 0x4f, 0x09,
+		0xab, 0x9c, 0xb8, 0xa4, 0xaa, 0x9f, 0xdc, 0x40, 0x65, 0x7e, 0x10, 0xa0, 0x7d, 0xd8, 0x69, 0x77,
+		0xce, 0xba, 0x2d, 0xb3, 0x73, 0x61, 0x0f, 0xde, 0xf4, 0xda, 0xb6, 0xd9, 0x79, 0xdd, 0xbc, 0x32,
+		0x5b, 0xd5, 0xff, 0xa1, 0x03, 0xd8, 0x5d, 0x84, 0x06, 0x97, 0x96, 0x79, 0x3e, 0xb0, 0xae, 0xab,
+		0x1a, 0xda, 0x05, 0xb4, 0x88, 0xbd, 0xec, 0x77, 0x3b, 0xd5, 0x15, 0x54, 0x83, 0x0f, 0x16, 0xed,
+		0x3d, 0xab, 0x3b, 0xe8, 0x3e, 0xad, 0x16, 0x9e, 0xfc, 0x02, 0xdb, 0x4b, 0x3e, 0x2e, 0x7a, 0x04,
+		0x87, 0x66, 0xbf, 0x7b, 0xd5, 0x1c, 0x98, 0xdd, 0x8e, 0x7d, 0x61, 0x75, 0xbf, 0xeb, 0xd9, 0xfd,
+		0x41, 0x73, 0x30, 0xaf, 0xe3, 0x5e, 0xca, 0x65, 0xbb, 0x79, 0x35, 0xb8, 0x7c, 0x53, 0xd5, 0xee,
+		0xa7, 0xb4, 0xac, 0xa6, 0xd9, 0x69, 0xb7, 0xaa, 0x2b, 0xa7, 0x3f, 0xc0, 0x9e, 0xc3, 0x83, 0x65,
+		0x37, 0x75, 0x5a, 0x3e, 0x4b, 0x9e, 0x28, 0x3d, 0x55, 0xf5, 0x3d, 0xed, 0xfb, 0x93, 0x11, 0x95,
+		0x6f, 0xe3, 0xa1, 0xe1, 0xf0, 0xa0, 0x3e, 0xff, 0xa0, 0xf9, 0x9c, 0xba, 0x7e, 0x7d, 0xc4, 0xd3,
+		0x67, 0x4a, 0xf6, 0xba, 0x79, 0x8e, 0x43, 0x3a, 0x39, 0x19, 0x96, 0x12, 0xdb, 0xd3, 0xbf, 0x06,
+		0x00, 0x57, 0xd9, 0xb2, 0xe0, 0x01, 0x09, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/domain.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x51, 0x6f, 0xdb, 0xb6,
+		0x13, 0xff, 0xcb, 0x4e, 0xf2, 0x4f, 0x4e, 0x8e, 0xe3, 0x32, 0x4d, 0xa3, 0x78, 0xc3, 0xa2, 0xa6,
+		0x28, 0xe0, 0x15, 0x98, 0xdc, 0x78, 0xc3, 0xd6, 0x6e, 0xd8, 0x83, 0x63, 0xa9, 0x99, 0x87, 0x2c,
+		0x0b, 0x64, 0x37, 0x03, 0x96, 0x07, 0x81, 0x96, 0x68, 0x9b, 0xa8, 0x24, 0x0a, 0x94, 0xe4, 0x34,
+		0x6f, 0xc3, 0x3e, 0xd6, 0x1e, 0xf7, 0x29, 0xf6, 0x71, 0x06, 0x51, 0x94, 0x62, 0xc7, 0x42, 0xb3,
+		0x37, 0xf2, 0xee, 0xf7, 0xfb, 0xdd, 0xf1, 0x74, 0x47, 0x0a, 0xf4, 0x74, 0x42, 0x78, 0xd7, 0xc5,
+		0x1e, 0x09, 0x5d, 0xd2, 0xc5, 0x11, 0xed, 0x2e, 0x4e, 0xbb, 0x1e, 0x0b, 0x30, 0x0d, 0x8d, 0x88,
+		0xb3, 0x84, 0xa1, 0xfd, 0x0c, 0x61, 0x48, 0x84, 0x81, 0x23, 0x6a, 0x2c, 0x4e, 0xdb, 0x5f, 0xcc,
+		0x18, 0x9b, 0xf9, 0xa4, 0x2b, 0x20, 0x93, 0x74, 0xda, 0xf5, 0x52, 0x8e, 0x13, 0xca, 0x24, 0xa9,
+		0x7d, 0xfc, 0xd0, 0x9f, 0xd0, 0x80, 0xc4, 0x09, 0x0e, 0x22, 0x09, 0xa8, 0x8c, 0xeb, 0xb2, 0x20,
+		0x28, 0x24, 0x4e, 0xfe, 0xd9, 0x81, 0x2d, 0x53, 0x24, 0x82, 0x9a, 0x50, 0xa3, 0x9e, 0xa6, 0xe8,
+		0x4a, 0x67, 0xc7, 0xae, 0x51, 0x0f, 0x21, 0xd8, 0x08, 0x71, 0x40, 0xb4, 0x9a, 0xb0, 0x88, 0x35,
+		0x7a, 0x0b, 0x5b, 0x71, 0x82, 0x93, 0x34, 0xd6, 0xea, 0xba, 0xd2, 0x69, 0xf6, 0x9e, 0x1b, 0x15,
+		0x79, 0x1b, 0xb9, 0xe0, 0x48, 0x00, 0x6d, 0x49, 0x40, 0x3a, 0xa8, 0x1e, 0x89, 0x5d, 0x4e, 0xa3,
+		0xec, 0x04, 0xda, 0x86, 0x50, 0x5d, 0x36, 0xa1, 0x63, 0x50, 0xd9, 0x6d, 0x48, 0xb8, 0x43, 0x02,
+		0x4c, 0x7d, 0x6d, 0x53, 0x20, 0x40, 0x98, 0xac, 0xcc, 0x82, 0xde, 0xc2, 0x86, 0x87, 0x13, 0xac,
+		0x6d, 0xe9, 0xf5, 0x8e, 0xda, 0x7b, 0xf9, 0x89, 0xd8, 0x86, 0x89, 0x13, 0x6c, 0x85, 0x09, 0xbf,
+		0xb3, 0x05, 0x05, 0xcd, 0xe1, 0xc5, 0x2d, 0xe3, 0x1f, 0xa6, 0x3e, 0xbb, 0x75, 0xc8, 0x47, 0xe2,
+		0xa6, 0x59, 0x44, 0x87, 0x93, 0x84, 0x84, 0x62, 0x15, 0x11, 0x4e, 0x99, 0xa7, 0xfd, 0x5f, 0x57,
+		0x3a, 0x6a, 0xef, 0xc8, 0xc8, 0x0b, 0x6b, 0x14, 0x85, 0x35, 0x4c, 0x59, 0x78, 0x5b, 0x2f, 0x54,
+		0xac, 0x42, 0xc4, 0x2e, 0x34, 0xae, 0x84, 0x04, 0x1a, 0x40, 0x63, 0x82, 0x3d, 0x67, 0x42, 0x43,
+		0xcc, 0x29, 0x89, 0xb5, 0x6d, 0x21, 0xa9, 0x57, 0x26, 0x7b, 0x86, 0xbd, 0x33, 0x89, 0xb3, 0xd5,
+		0xc9, 0xfd, 0x06, 0xdd, 0xc0, 0xe1, 0x9c, 0xc6, 0x09, 0xe3, 0x77, 0x0e, 0xe6, 0xee, 0x9c, 0x2e,
+		0xb0, 0xef, 0xc8, 0xc2, 0xef, 0x88, 0xc2, 0xbf, 0xa8, 0xd4, 0xeb, 0x4b, 0xac, 0x2c, 0xfd, 0x81,
+		0xd4, 0x58, 0x35, 0xa3, 0xd7, 0xf0, 0x74, 0x4d, 0x3c, 0xe5, 0x54, 0x03, 0x51, 0x70, 0xf4, 0x80,
+		0xf4, 0x9e, 0x53, 0x84, 0xa1, 0xbd, 0xa0, 0x31, 0x9d, 0x50, 0x9f, 0x26, 0xeb, 0x19, 0xa9, 0xff,
+		0x3d, 0x23, 0xed, 0x5e, 0xe6, 0x41, 0x52, 0xdf, 0xc2, 0x61, 0x55, 0x88, 0x2c, 0xaf, 0x86, 0xc8,
+		0xeb, 0x60, 0x9d, 0x9a, 0xa5, 0x66, 0xc0, 0x3e, 0x76, 0x13, 0xba, 0x20, 0x8e, 0xeb, 0xa7, 0x71,
+		0x42, 0xb8, 0x23, 0x9a, 0x76, 0x57, 0x70, 0x9e, 0xe4, 0xae, 0x41, 0xee, 0xb9, 0xcc, 0x3a, 0xf8,
+		0x0a, 0xb6, 0x25, 0x30, 0xd6, 0x9a, 0xa2, 0x8f, 0xbe, 0xa9, 0x4c, 0x5c, 0x72, 0x6c, 0x12, 0xf9,
+		0xd4, 0x15, 0xdf, 0x7e, 0xc0, 0xc2, 0x29, 0x9d, 0x15, 0x8d, 0x50, 0xaa, 0xa0, 0x2f, 0xa1, 0x35,
+		0xc5, 0xd4, 0x67, 0x0b, 0xc2, 0x9d, 0x05, 0xe1, 0x71, 0xd6, 0xdd, 0x7b, 0xba, 0xd2, 0xa9, 0xdb,
+		0x7b, 0x85, 0xfd, 0x3a, 0x37, 0xa3, 0x0e, 0xb4, 0x68, 0xec, 0xcc, 0x7c, 0x36, 0xc1, 0xbe, 0x93,
+		0xcf, 0xbf, 0xd6, 0xd2, 0x95, 0xce, 0xb6, 0xdd, 0xa4, 0xf1, 0xb9, 0x30, 0xcb, 0x61, 0x7c, 0x07,
+		0xbb, 0xa5, 0x28, 0x0d, 0xa7, 0x4c, 0x7b, 0x22, 0xda, 0xa8, 0x7a, 0xde, 0xde, 0x49, 0xe4, 0x30,
+		0x9c, 0x32, 0xbb, 0x31, 0x5d, 0xda, 0xa1, 0x9b, 0x2c, 0x22, 0xf3, 0x45, 0xce, 0xce, 0x8c, 0xb3,
+		0x34, 0x8a, 0x35, 0x24, 0xa4, 0x5e, 0x57, 0x4a, 0x0d, 0x0b, 0xf0, 0x79, 0x86, 0x5d, 0x3d, 0xf2,
+		0x1e, 0x5d, 0x71, 0xc6, 0xc8, 0x85, 0x03, 0x1c, 0xdf, 0x85, 0xae, 0x53, 0x8e, 0x96, 0x2b, 0x08,
+		0xda, 0xbe, 0x88, 0xd0, 0xad, 0xee, 0x88, 0x8c, 0xf1, 0x9b, 0x24, 0xac, 0x06, 0xd8, 0xc7, 0xeb,
+		0xbe, 0xf6, 0x77, 0xb0, 0x53, 0x0e, 0x33, 0x6a, 0x41, 0xfd, 0x03, 0xb9, 0x93, 0x97, 0x54, 0xb6,
+		0x44, 0x4f, 0x61, 0x73, 0x81, 0xfd, 0xb4, 0xb8, 0xa6, 0xf2, 0xcd, 0xf7, 0xb5, 0x37, 0xca, 0x89,
+		0x09, 0xc7, 0x8f, 0x7c, 0x44, 0xf4, 0x1c, 0x1a, 0x2b, 0x5d, 0x93, 0xeb, 0xaa, 0xee, 0x7d, 0xbf,
+		0x9c, 0xfc, 0xa5, 0x80, 0xba, 0x34, 0xa6, 0xe8, 0x67, 0xd8, 0x2e, 0x47, 0x5b, 0x11, 0xfd, 0x63,
+		0x3c, 0x36, 0xda, 0x46, 0xb1, 0xc8, 0x2f, 0xa4, 0x92, 0xdf, 0x76, 0x60, 0x77, 0xc5, 0x55, 0x71,
+		0xbc, 0x37, 0xcb, 0xc7, 0x53, 0x7b, 0x27, 0x9f, 0x8c, 0x75, 0x27, 0x1a, 0x60, 0xa9, 0x04, 0x7f,
+		0x2a, 0xb0, 0xbb, 0xe2, 0x44, 0xcf, 0x60, 0x8b, 0x13, 0x1c, 0xb3, 0x50, 0x06, 0x91, 0x3b, 0xd4,
+		0x86, 0x6d, 0x16, 0x11, 0x8e, 0x13, 0xc6, 0x65, 0x25, 0xcb, 0x3d, 0xfa, 0x11, 0x1a, 0x2e, 0x27,
+		0x38, 0x21, 0x9e, 0x93, 0x3d, 0x30, 0xe2, 0xea, 0x57, 0x7b, 0xed, 0xb5, 0x4b, 0x72, 0x5c, 0xbc,
+		0x3e, 0xb6, 0x2a, 0xf1, 0x99, 0xe5, 0xe4, 0xef, 0x1a, 0x34, 0x96, 0x3b, 0xb4, 0x72, 0x60, 0x94,
+		0xea, 0x81, 0x19, 0x83, 0x56, 0x42, 0xe3, 0x04, 0xf3, 0xc4, 0x29, 0x9f, 0x38, 0xad, 0xf6, 0x68,
+		0x1a, 0xcf, 0x0a, 0xee, 0x28, 0xa3, 0x96, 0x76, 0x74, 0x0d, 0x47, 0xa5, 0x2a, 0xf9, 0x18, 0x51,
+		0x4e, 0x96, 0x64, 0x1f, 0x3f, 0xdd, 0x61, 0x41, 0xb6, 0x04, 0xf7, 0x5e, 0xb7, 0x07, 0x07, 0x2e,
+		0x0b, 0x22, 0x9f, 0x64, 0xa5, 0x8a, 0xe7, 0x98, 0x7b, 0x8e, 0xcb, 0xd2, 0x30, 0x11, 0x8f, 0xdd,
+		0xa6, 0xbd, 0x5f, 0x3a, 0x47, 0x99, 0x6f, 0x90, 0xb9, 0xd0, 0x4b, 0x68, 0x46, 0x24, 0xf4, 0x68,
+		0x38, 0xcb, 0x19, 0xb1, 0xb6, 0xa9, 0xd7, 0x3b, 0x9b, 0xf6, 0xae, 0xb4, 0x0a, 0x68, 0xfc, 0xea,
+		0x0f, 0x05, 0x1a, 0xcb, 0xcf, 0x2a, 0x3a, 0x82, 0x03, 0xf3, 0xd7, 0x5f, 0xfa, 0xc3, 0x4b, 0x67,
+		0x34, 0xee, 0x8f, 0xdf, 0x8f, 0x9c, 0xe1, 0xe5, 0x75, 0xff, 0x62, 0x68, 0xb6, 0xfe, 0x87, 0x3e,
+		0x07, 0x6d, 0xd5, 0x65, 0x5b, 0xe7, 0xc3, 0xd1, 0xd8, 0xb2, 0x2d, 0xb3, 0xa5, 0xac, 0x7b, 0x4d,
+		0xeb, 0xca, 0xb6, 0x06, 0xfd, 0xb1, 0x65, 0xb6, 0x6a, 0xeb, 0xb2, 0xa6, 0x75, 0x61, 0x65, 0xae,
+		0xfa, 0xab, 0x39, 0x34, 0x1f, 0xdc, 0xd9, 0x9f, 0xc1, 0x61, 0xdf, 0x1e, 0xfc, 0x34, 0xbc, 0xee,
+		0x5f, 0x54, 0x66, 0xf1, 0xd0, 0x69, 0x0e, 0x47, 0xfd, 0xb3, 0x0b, 0x91, 0x45, 0x05, 0xd5, 0xba,
+		0xcc, 0x9d, 0xb5, 0xb3, 0x1b, 0x38, 0x74, 0x59, 0x50, 0xd5, 0xea, 0x67, 0x6a, 0x5e, 0x84, 0xab,
+		0xec, 0xab, 0x5c, 0x29, 0xbf, 0x9f, 0xce, 0x68, 0x32, 0x4f, 0x27, 0x86, 0xcb, 0x82, 0xee, 0xf2,
+		0xbf, 0xce, 0x57, 0xd4, 0xf3, 0xbb, 0x33, 0x96, 0xff, 0x19, 0xc9, 0x1f, 0x9f, 0x1f, 0x70, 0x44,
+		0x17, 0xa7, 0x93, 0x2d, 0x61, 0xfb, 0xfa, 0xdf, 0x01, 0x00, 0x33, 0x96, 0xec, 0x45, 0x94, 0x09,
+		0x00, 0x00,
+	},
+	// uber/cadence/admin/v1/history.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x4d, 0x4a, 0x2d,
+		0xd2, 0x4f, 0x4e, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x4f, 0x4c, 0xc9, 0xcd, 0xcc, 0xd3, 0x2f,
+		0x33, 0xd4, 0xcf, 0xc8, 0x2c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
+		0x12, 0x05, 0x29, 0xd2, 0x83, 0x2a, 0xd2, 0x03, 0x2b, 0xd2, 0x2b, 0x33, 0x54, 0xf2, 0xe4, 0x12,
+		0x0a, 0x4b, 0x2d, 0x2a, 0xce, 0xcc, 0xcf, 0xf3, 0x80, 0x28, 0xf7, 0x2c, 0x49, 0xcd, 0x15, 0x92,
+		0xe4, 0xe2, 0x48, 0x2d, 0x4b, 0xcd, 0x2b, 0x89, 0xcf, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60,
+		0x0e, 0x62, 0x07, 0xf3, 0x3d, 0x53, 0x84, 0x24, 0xb8, 0xd8, 0xcb, 0x20, 0x1a, 0x24, 0x98, 0x20,
+		0x32, 0x50, 0xae, 0x52, 0x09, 0x17, 0x1f, 0xaa, 0x51, 0x42, 0x8a, 0x5c, 0x3c, 0x49, 0x45, 0x89,
+		0x79, 0xc9, 0x19, 0xf1, 0x25, 0xf9, 0xd9, 0xa9, 0x79, 0x60, 0xa3, 0x78, 0x82, 0xb8, 0x21, 0x62,
+		0x21, 0x20, 0x21, 0x21, 0x7b, 0x2e, 0xd6, 0xcc, 0x92, 0xd4, 0xdc, 0x62, 0x09, 0x26, 0x05, 0x66,
+		0x0d, 0x6e, 0x23, 0x4d, 0x3d, 0xac, 0xce, 0xd4, 0xc3, 0x74, 0x63, 0x10, 0x44, 0x9f, 0x93, 0x79,
+		0x94, 0x69, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x72, 0x48, 0xe8,
+		0x66, 0xa6, 0xe4, 0xe8, 0xa7, 0xe7, 0xeb, 0x83, 0xfd, 0x0f, 0x0f, 0x16, 0x6b, 0x30, 0xa3, 0xcc,
+		0x30, 0x89, 0x0d, 0x2c, 0x6e, 0x0c, 0x18, 0x00, 0x44, 0x14, 0xd7, 0xd4, 0x3e, 0x01, 0x00, 0x00,
+	},
+}
@@ -0,0 +1,3724 @@
+// The MIT License (MIT)
+// 
+// Copyright (c) 2021 Uber Technologies, Inc.
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/admin/v1/service.proto
+
+package adminv1
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/api/x/restriction"
+	"go.uber.org/yarpc/encoding/protobuf"
+	"go.uber.org/yarpc/encoding/protobuf/reflection"
+)
+
+var _ = ioutil.NopCloser
+
+// AdminAPIYARPCClient is the YARPC client-side interface for the AdminAPI service.
+type AdminAPIYARPCClient interface {
+	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest, ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error)
+	DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest, ...yarpc.CallOption) (*DescribeHistoryHostResponse, error)
+	DescribeShardDistribution(context.Context, *DescribeShardDistributionRequest, ...yarpc.CallOption) (*DescribeShardDistributionResponse, error)
+	CloseShard(context.Context, *CloseShardRequest, ...yarpc.CallOption) (*CloseShardResponse, error)
+	RemoveTask(context.Context, *RemoveTaskRequest, ...yarpc.CallOption) (*RemoveTaskResponse, error)
+	ResetQueue(context.Context, *ResetQueueRequest, ...yarpc.CallOption) (*ResetQueueResponse, error)
+	DescribeQueue(context.Context, *DescribeQueueRequest, ...yarpc.CallOption) (*DescribeQueueResponse, error)
+	ListThrottledWorkflowIDs(context.Context, *ListThrottledWorkflowIDsRequest, ...yarpc.CallOption) (*ListThrottledWorkflowIDsResponse, error)
+	GetDomainUsage(context.Context, *GetDomainUsageRequest, ...yarpc.CallOption) (*GetDomainUsageResponse, error)
+	GetWorkflowExecutionRawHistoryV2(context.Context, *GetWorkflowExecutionRawHistoryV2Request, ...yarpc.CallOption) (*GetWorkflowExecutionRawHistoryV2Response, error)
+	GetReplicationMessages(context.Context, *GetReplicationMessagesRequest, ...yarpc.CallOption) (*GetReplicationMessagesResponse, error)
+	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest, ...yarpc.CallOption) (*GetDLQReplicationMessagesResponse, error)
+	GetDomainReplicationMessages(context.Context, *GetDomainReplicationMessagesRequest, ...yarpc.CallOption) (*GetDomainReplicationMessagesResponse, error)
+	ReapplyEvents(context.Context, *ReapplyEventsRequest, ...yarpc.CallOption) (*ReapplyEventsResponse, error)
+	AddSearchAttribute(context.Context, *AddSearchAttributeRequest, ...yarpc.CallOption) (*AddSearchAttributeResponse, error)
+	DescribeCluster(context.Context, *DescribeClusterRequest, ...yarpc.CallOption) (*DescribeClusterResponse, error)
+	CountDLQMessages(context.Context, *CountDLQMessagesRequest, ...yarpc.CallOption) (*CountDLQMessagesResponse, error)
+	ReadDLQMessages(context.Context, *ReadDLQMessagesRequest, ...yarpc.CallOption) (*ReadDLQMessagesResponse, error)
+	PurgeDLQMessages(context.Context, *PurgeDLQMessagesRequest, ...yarpc.CallOption) (*PurgeDLQMessagesResponse, error)
+	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest, ...yarpc.CallOption) (*MergeDLQMessagesResponse, error)
+	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest, ...yarpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	PauseActivity(context.Context, *PauseActivityRequest, ...yarpc.CallOption) (*PauseActivityResponse, error)
+	UnpauseActivity(context.Context, *UnpauseActivityRequest, ...yarpc.CallOption) (*UnpauseActivityResponse, error)
+	ResetActivity(context.Context, *ResetActivityRequest, ...yarpc.CallOption) (*ResetActivityResponse, error)
+	UpdateActivityOptions(context.Context, *UpdateActivityOptionsRequest, ...yarpc.CallOption) (*UpdateActivityOptionsResponse, error)
+	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest, ...yarpc.CallOption) (*ResendReplicationTasksResponse, error)
+	ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest, ...yarpc.CallOption) (*ImportWorkflowExecutionResponse, error)
+	GetCrossClusterTasks(context.Context, *GetCrossClusterTasksRequest, ...yarpc.CallOption) (*GetCrossClusterTasksResponse, error)
+	RespondCrossClusterTasksCompleted(context.Context, *RespondCrossClusterTasksCompletedRequest, ...yarpc.CallOption) (*RespondCrossClusterTasksCompletedResponse, error)
+	GetDynamicConfig(context.Context, *GetDynamicConfigRequest, ...yarpc.CallOption) (*GetDynamicConfigResponse, error)
+	UpdateDynamicConfig(context.Context, *UpdateDynamicConfigRequest, ...yarpc.CallOption) (*UpdateDynamicConfigResponse, error)
+	RestoreDynamicConfig(context.Context, *RestoreDynamicConfigRequest, ...yarpc.CallOption) (*RestoreDynamicConfigResponse, error)
+	ListDynamicConfig(context.Context, *ListDynamicConfigRequest, ...yarpc.CallOption) (*ListDynamicConfigResponse, error)
+	DeleteWorkflow(context.Context, *DeleteWorkflowRequest, ...yarpc.CallOption) (*DeleteWorkflowResponse, error)
+	MaintainCorruptWorkflow(context.Context, *MaintainCorruptWorkflowRequest, ...yarpc.CallOption) (*MaintainCorruptWorkflowResponse, error)
+	GetGlobalIsolationGroups(context.Context, *GetGlobalIsolationGroupsRequest, ...yarpc.CallOption) (*GetGlobalIsolationGroupsResponse, error)
+	UpdateGlobalIsolationGroups(context.Context, *UpdateGlobalIsolationGroupsRequest, ...yarpc.CallOption) (*UpdateGlobalIsolationGroupsResponse, error)
+	GetDomainIsolationGroups(context.Context, *GetDomainIsolationGroupsRequest, ...yarpc.CallOption) (*GetDomainIsolationGroupsResponse, error)
+	UpdateDomainIsolationGroups(context.Context, *UpdateDomainIsolationGroupsRequest, ...yarpc.CallOption) (*UpdateDomainIsolationGroupsResponse, error)
+	GetDomainAsyncWorkflowConfiguraton(context.Context, *GetDomainAsyncWorkflowConfiguratonRequest, ...yarpc.CallOption) (*GetDomainAsyncWorkflowConfiguratonResponse, error)
+	UpdateDomainAsyncWorkflowConfiguraton(context.Context, *UpdateDomainAsyncWorkflowConfiguratonRequest, ...yarpc.CallOption) (*UpdateDomainAsyncWorkflowConfiguratonResponse, error)
+}
+
+func newAdminAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) AdminAPIYARPCClient {
+	return &_AdminAPIYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.admin.v1.AdminAPI",
+			ClientConfig: clientConfig,
+			AnyResolver:  anyResolver,
+			Options:      options,
+		},
+	)}
+}
+
+// NewAdminAPIYARPCClient builds a new YARPC client for the AdminAPI service.
+func NewAdminAPIYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) AdminAPIYARPCClient {
+	return newAdminAPIYARPCClient(clientConfig, nil, options...)
+}
+
+// AdminAPIYARPCServer is the YARPC server-side interface for the AdminAPI service.
+type AdminAPIYARPCServer interface {
+	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest) (*DescribeWorkflowExecutionResponse, error)
+	DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error)
+	DescribeShardDistribution(context.Context, *DescribeShardDistributionRequest) (*DescribeShardDistributionResponse, error)
+	CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error)
+	RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error)
+	ResetQueue(context.Context, *ResetQueueRequest) (*ResetQueueResponse, error)
+	DescribeQueue(context.Context, *DescribeQueueRequest) (*DescribeQueueResponse, error)
+	ListThrottledWorkflowIDs(context.Context, *ListThrottledWorkflowIDsRequest) (*ListThrottledWorkflowIDsResponse, error)
+	GetDomainUsage(context.Context, *GetDomainUsageRequest) (*GetDomainUsageResponse, error)
+	GetWorkflowExecutionRawHistoryV2(context.Context, *GetWorkflowExecutionRawHistoryV2Request) (*GetWorkflowExecutionRawHistoryV2Response, error)
+	GetReplicationMessages(context.Context, *GetReplicationMessagesRequest) (*GetReplicationMessagesResponse, error)
+	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest) (*GetDLQReplicationMessagesResponse, error)
+	GetDomainReplicationMessages(context.Context, *GetDomainReplicationMessagesRequest) (*GetDomainReplicationMessagesResponse, error)
+	ReapplyEvents(context.Context, *ReapplyEventsRequest) (*ReapplyEventsResponse, error)
+	AddSearchAttribute(context.Context, *AddSearchAttributeRequest) (*AddSearchAttributeResponse, error)
+	DescribeCluster(context.Context, *DescribeClusterRequest) (*DescribeClusterResponse, error)
+	CountDLQMessages(context.Context, *CountDLQMessagesRequest) (*CountDLQMessagesResponse, error)
+	ReadDLQMessages(context.Context, *ReadDLQMessagesRequest) (*ReadDLQMessagesResponse, error)
+	PurgeDLQMessages(context.Context, *PurgeDLQMessagesRequest) (*PurgeDLQMessagesResponse, error)
+	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
+	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	PauseActivity(context.Context, *PauseActivityRequest) (*PauseActivityResponse, error)
+	UnpauseActivity(context.Context, *UnpauseActivityRequest) (*UnpauseActivityResponse, error)
+	ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error)
+	UpdateActivityOptions(context.Context, *UpdateActivityOptionsRequest) (*UpdateActivityOptionsResponse, error)
+	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
+	ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error)
+	GetCrossClusterTasks(context.Context, *GetCrossClusterTasksRequest) (*GetCrossClusterTasksResponse, error)
+	RespondCrossClusterTasksCompleted(context.Context, *RespondCrossClusterTasksCompletedRequest) (*RespondCrossClusterTasksCompletedResponse, error)
+	GetDynamicConfig(context.Context, *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error)
+	UpdateDynamicConfig(context.Context, *UpdateDynamicConfigRequest) (*UpdateDynamicConfigResponse, error)
+	RestoreDynamicConfig(context.Context, *RestoreDynamicConfigRequest) (*RestoreDynamicConfigResponse, error)
+	ListDynamicConfig(context.Context, *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error)
+	DeleteWorkflow(context.Context, *DeleteWorkflowRequest) (*DeleteWorkflowResponse, error)
+	MaintainCorruptWorkflow(context.Context, *MaintainCorruptWorkflowRequest) (*MaintainCorruptWorkflowResponse, error)
+	GetGlobalIsolationGroups(context.Context, *GetGlobalIsolationGroupsRequest) (*GetGlobalIsolationGroupsResponse, error)
+	UpdateGlobalIsolationGroups(context.Context, *UpdateGlobalIsolationGroupsRequest) (*UpdateGlobalIsolationGroupsResponse, error)
+	GetDomainIsolationGroups(context.Context, *GetDomainIsolationGroupsRequest) (*GetDomainIsolationGroupsResponse, error)
+	UpdateDomainIsolationGroups(context.Context, *UpdateDomainIsolationGroupsRequest) (*UpdateDomainIsolationGroupsResponse, error)
+	GetDomainAsyncWorkflowConfiguraton(context.Context, *GetDomainAsyncWorkflowConfiguratonRequest) (*GetDomainAsyncWorkflowConfiguratonResponse, error)
+	UpdateDomainAsyncWorkflowConfiguraton(context.Context, *UpdateDomainAsyncWorkflowConfiguratonRequest) (*UpdateDomainAsyncWorkflowConfiguratonResponse, error)
+}
+
+type buildAdminAPIYARPCProceduresParams struct {
+	Server      AdminAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver
+}
+
+func buildAdminAPIYARPCProcedures(params buildAdminAPIYARPCProceduresParams) []transport.Procedure {
+	handler := &_AdminAPIYARPCHandler{params.Server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName: "uber.cadence.admin.v1.AdminAPI",
+			UnaryHandlerParams: []protobuf.BuildProceduresUnaryHandlerParams{
+				{
+					MethodName: "DescribeWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeWorkflowExecution,
+							NewRequest:  newAdminAPIServiceDescribeWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeHistoryHost",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeHistoryHost,
+							NewRequest:  newAdminAPIServiceDescribeHistoryHostYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeShardDistribution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeShardDistribution,
+							NewRequest:  newAdminAPIServiceDescribeShardDistributionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "CloseShard",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.CloseShard,
+							NewRequest:  newAdminAPIServiceCloseShardYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RemoveTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RemoveTask,
+							NewRequest:  newAdminAPIServiceRemoveTaskYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ResetQueue",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ResetQueue,
+							NewRequest:  newAdminAPIServiceResetQueueYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeQueue",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeQueue,
+							NewRequest:  newAdminAPIServiceDescribeQueueYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListThrottledWorkflowIDs",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListThrottledWorkflowIDs,
+							NewRequest:  newAdminAPIServiceListThrottledWorkflowIDsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetDomainUsage",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetDomainUsage,
+							NewRequest:  newAdminAPIServiceGetDomainUsageYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetWorkflowExecutionRawHistoryV2",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetWorkflowExecutionRawHistoryV2,
+							NewRequest:  newAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetReplicationMessages",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetReplicationMessages,
+							NewRequest:  newAdminAPIServiceGetReplicationMessagesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetDLQReplicationMessages",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetDLQReplicationMessages,
+							NewRequest:  newAdminAPIServiceGetDLQReplicationMessagesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetDomainReplicationMessages",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetDomainReplicationMessages,
+							NewRequest:  newAdminAPIServiceGetDomainReplicationMessagesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ReapplyEvents",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ReapplyEvents,
+							NewRequest:  newAdminAPIServiceReapplyEventsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "AddSearchAttribute",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.AddSearchAttribute,
+							NewRequest:  newAdminAPIServiceAddSearchAttributeYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeCluster",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeCluster,
+							NewRequest:  newAdminAPIServiceDescribeClusterYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "CountDLQMessages",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.CountDLQMessages,
+							NewRequest:  newAdminAPIServiceCountDLQMessagesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ReadDLQMessages",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ReadDLQMessages,
+							NewRequest:  newAdminAPIServiceReadDLQMessagesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "PurgeDLQMessages",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PurgeDLQMessages,
+							NewRequest:  newAdminAPIServicePurgeDLQMessagesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "MergeDLQMessages",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.MergeDLQMessages,
+							NewRequest:  newAdminAPIServiceMergeDLQMessagesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RefreshWorkflowTasks",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RefreshWorkflowTasks,
+							NewRequest:  newAdminAPIServiceRefreshWorkflowTasksYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "PauseActivity",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PauseActivity,
+							NewRequest:  newAdminAPIServicePauseActivityYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UnpauseActivity",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UnpauseActivity,
+							NewRequest:  newAdminAPIServiceUnpauseActivityYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ResetActivity",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ResetActivity,
+							NewRequest:  newAdminAPIServiceResetActivityYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateActivityOptions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateActivityOptions,
+							NewRequest:  newAdminAPIServiceUpdateActivityOptionsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ResendReplicationTasks",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ResendReplicationTasks,
+							NewRequest:  newAdminAPIServiceResendReplicationTasksYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ImportWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ImportWorkflowExecution,
+							NewRequest:  newAdminAPIServiceImportWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetCrossClusterTasks",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetCrossClusterTasks,
+							NewRequest:  newAdminAPIServiceGetCrossClusterTasksYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondCrossClusterTasksCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondCrossClusterTasksCompleted,
+							NewRequest:  newAdminAPIServiceRespondCrossClusterTasksCompletedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetDynamicConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetDynamicConfig,
+							NewRequest:  newAdminAPIServiceGetDynamicConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateDynamicConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateDynamicConfig,
+							NewRequest:  newAdminAPIServiceUpdateDynamicConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RestoreDynamicConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RestoreDynamicConfig,
+							NewRequest:  newAdminAPIServiceRestoreDynamicConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListDynamicConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListDynamicConfig,
+							NewRequest:  newAdminAPIServiceListDynamicConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DeleteWorkflow",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DeleteWorkflow,
+							NewRequest:  newAdminAPIServiceDeleteWorkflowYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "MaintainCorruptWorkflow",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.MaintainCorruptWorkflow,
+							NewRequest:  newAdminAPIServiceMaintainCorruptWorkflowYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetGlobalIsolationGroups",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetGlobalIsolationGroups,
+							NewRequest:  newAdminAPIServiceGetGlobalIsolationGroupsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateGlobalIsolationGroups",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateGlobalIsolationGroups,
+							NewRequest:  newAdminAPIServiceUpdateGlobalIsolationGroupsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetDomainIsolationGroups",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetDomainIsolationGroups,
+							NewRequest:  newAdminAPIServiceGetDomainIsolationGroupsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateDomainIsolationGroups",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateDomainIsolationGroups,
+							NewRequest:  newAdminAPIServiceUpdateDomainIsolationGroupsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetDomainAsyncWorkflowConfiguraton",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetDomainAsyncWorkflowConfiguraton,
+							NewRequest:  newAdminAPIServiceGetDomainAsyncWorkflowConfiguratonYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateDomainAsyncWorkflowConfiguraton",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateDomainAsyncWorkflowConfiguraton,
+							NewRequest:  newAdminAPIServiceUpdateDomainAsyncWorkflowConfiguratonYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+			},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
+		},
+	)
+}
+
+// BuildAdminAPIYARPCProcedures prepares an implementation of the AdminAPI service for YARPC registration.
+func BuildAdminAPIYARPCProcedures(server AdminAPIYARPCServer) []transport.Procedure {
+	return buildAdminAPIYARPCProcedures(buildAdminAPIYARPCProceduresParams{Server: server})
+}
+
+// FxAdminAPIYARPCClientParams defines the input
+// for NewFxAdminAPIYARPCClient. It provides the
+// paramaters to get a AdminAPIYARPCClient in an
+// Fx application.
+type FxAdminAPIYARPCClientParams struct {
+	fx.In
+
+	Provider    yarpc.ClientConfig
+	AnyResolver jsonpb.AnyResolver  `name:"yarpcfx" optional:"true"`
+	Restriction restriction.Checker `optional:"true"`
+}
+
+// FxAdminAPIYARPCClientResult defines the output
+// of NewFxAdminAPIYARPCClient. It provides a
+// AdminAPIYARPCClient to an Fx application.
+type FxAdminAPIYARPCClientResult struct {
+	fx.Out
+
+	Client AdminAPIYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxAdminAPIYARPCClient provides a AdminAPIYARPCClient
+// to an Fx application using the given name for routing.
+//
+//	fx.Provide(
+//	  adminv1.NewFxAdminAPIYARPCClient("service-name"),
+//	  ...
+//	)
+func NewFxAdminAPIYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxAdminAPIYARPCClientParams) FxAdminAPIYARPCClientResult {
+		cc := params.Provider.ClientConfig(name)
+
+		if params.Restriction != nil {
+			if namer, ok := cc.GetUnaryOutbound().(transport.Namer); ok {
+				if err := params.Restriction.Check(protobuf.Encoding, namer.TransportName()); err != nil {
+					panic(err.Error())
+				}
+			}
+		}
+
+		return FxAdminAPIYARPCClientResult{
+			Client: newAdminAPIYARPCClient(cc, params.AnyResolver, options...),
+		}
+	}
+}
+
+// FxAdminAPIYARPCProceduresParams defines the input
+// for NewFxAdminAPIYARPCProcedures. It provides the
+// paramaters to get AdminAPIYARPCServer procedures in an
+// Fx application.
+type FxAdminAPIYARPCProceduresParams struct {
+	fx.In
+
+	Server      AdminAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver `name:"yarpcfx" optional:"true"`
+}
+
+// FxAdminAPIYARPCProceduresResult defines the output
+// of NewFxAdminAPIYARPCProcedures. It provides
+// AdminAPIYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxAdminAPIYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxAdminAPIYARPCProcedures provides AdminAPIYARPCServer procedures to an Fx application.
+// It expects a AdminAPIYARPCServer to be present in the container.
+//
+//	fx.Provide(
+//	  adminv1.NewFxAdminAPIYARPCProcedures(),
+//	  ...
+//	)
+func NewFxAdminAPIYARPCProcedures() interface{} {
+	return func(params FxAdminAPIYARPCProceduresParams) FxAdminAPIYARPCProceduresResult {
+		return FxAdminAPIYARPCProceduresResult{
+			Procedures: buildAdminAPIYARPCProcedures(buildAdminAPIYARPCProceduresParams{
+				Server:      params.Server,
+				AnyResolver: params.AnyResolver,
+			}),
+			ReflectionMeta: AdminAPIReflectionMeta,
+		}
+	}
+}
+
+// AdminAPIReflectionMeta is the reflection server metadata
+// required for using the gRPC reflection protocol with YARPC.
+//
+// See https://github.com/grpc/grpc/blob/master/doc/server-reflection.md.
+var AdminAPIReflectionMeta = reflection.ServerMeta{
+	ServiceName:     "uber.cadence.admin.v1.AdminAPI",
+	FileDescriptors: yarpcFileDescriptorClosurec6fc96d64a8b67fd,
+}
+
+type _AdminAPIYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_AdminAPIYARPCCaller) DescribeWorkflowExecution(ctx context.Context, request *DescribeWorkflowExecutionRequest, options ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeWorkflowExecution", request, newAdminAPIServiceDescribeWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceDescribeWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) DescribeHistoryHost(ctx context.Context, request *DescribeHistoryHostRequest, options ...yarpc.CallOption) (*DescribeHistoryHostResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeHistoryHost", request, newAdminAPIServiceDescribeHistoryHostYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeHistoryHostResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceDescribeHistoryHostYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) DescribeShardDistribution(ctx context.Context, request *DescribeShardDistributionRequest, options ...yarpc.CallOption) (*DescribeShardDistributionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeShardDistribution", request, newAdminAPIServiceDescribeShardDistributionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeShardDistributionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceDescribeShardDistributionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) CloseShard(ctx context.Context, request *CloseShardRequest, options ...yarpc.CallOption) (*CloseShardResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "CloseShard", request, newAdminAPIServiceCloseShardYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*CloseShardResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceCloseShardYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) RemoveTask(ctx context.Context, request *RemoveTaskRequest, options ...yarpc.CallOption) (*RemoveTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RemoveTask", request, newAdminAPIServiceRemoveTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RemoveTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceRemoveTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ResetQueue(ctx context.Context, request *ResetQueueRequest, options ...yarpc.CallOption) (*ResetQueueResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResetQueue", request, newAdminAPIServiceResetQueueYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResetQueueResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceResetQueueYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) DescribeQueue(ctx context.Context, request *DescribeQueueRequest, options ...yarpc.CallOption) (*DescribeQueueResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeQueue", request, newAdminAPIServiceDescribeQueueYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeQueueResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceDescribeQueueYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ListThrottledWorkflowIDs(ctx context.Context, request *ListThrottledWorkflowIDsRequest, options ...yarpc.CallOption) (*ListThrottledWorkflowIDsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListThrottledWorkflowIDs", request, newAdminAPIServiceListThrottledWorkflowIDsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListThrottledWorkflowIDsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceListThrottledWorkflowIDsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetDomainUsage(ctx context.Context, request *GetDomainUsageRequest, options ...yarpc.CallOption) (*GetDomainUsageResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetDomainUsage", request, newAdminAPIServiceGetDomainUsageYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetDomainUsageResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetDomainUsageYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetWorkflowExecutionRawHistoryV2(ctx context.Context, request *GetWorkflowExecutionRawHistoryV2Request, options ...yarpc.CallOption) (*GetWorkflowExecutionRawHistoryV2Response, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetWorkflowExecutionRawHistoryV2", request, newAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetWorkflowExecutionRawHistoryV2Response)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetReplicationMessages(ctx context.Context, request *GetReplicationMessagesRequest, options ...yarpc.CallOption) (*GetReplicationMessagesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetReplicationMessages", request, newAdminAPIServiceGetReplicationMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetReplicationMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetReplicationMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetDLQReplicationMessages(ctx context.Context, request *GetDLQReplicationMessagesRequest, options ...yarpc.CallOption) (*GetDLQReplicationMessagesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetDLQReplicationMessages", request, newAdminAPIServiceGetDLQReplicationMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetDLQReplicationMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetDLQReplicationMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetDomainReplicationMessages(ctx context.Context, request *GetDomainReplicationMessagesRequest, options ...yarpc.CallOption) (*GetDomainReplicationMessagesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetDomainReplicationMessages", request, newAdminAPIServiceGetDomainReplicationMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetDomainReplicationMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetDomainReplicationMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ReapplyEvents(ctx context.Context, request *ReapplyEventsRequest, options ...yarpc.CallOption) (*ReapplyEventsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ReapplyEvents", request, newAdminAPIServiceReapplyEventsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ReapplyEventsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceReapplyEventsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) AddSearchAttribute(ctx context.Context, request *AddSearchAttributeRequest, options ...yarpc.CallOption) (*AddSearchAttributeResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "AddSearchAttribute", request, newAdminAPIServiceAddSearchAttributeYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*AddSearchAttributeResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceAddSearchAttributeYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) DescribeCluster(ctx context.Context, request *DescribeClusterRequest, options ...yarpc.CallOption) (*DescribeClusterResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeCluster", request, newAdminAPIServiceDescribeClusterYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeClusterResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceDescribeClusterYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) CountDLQMessages(ctx context.Context, request *CountDLQMessagesRequest, options ...yarpc.CallOption) (*CountDLQMessagesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "CountDLQMessages", request, newAdminAPIServiceCountDLQMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*CountDLQMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceCountDLQMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ReadDLQMessages(ctx context.Context, request *ReadDLQMessagesRequest, options ...yarpc.CallOption) (*ReadDLQMessagesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ReadDLQMessages", request, newAdminAPIServiceReadDLQMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ReadDLQMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceReadDLQMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) PurgeDLQMessages(ctx context.Context, request *PurgeDLQMessagesRequest, options ...yarpc.CallOption) (*PurgeDLQMessagesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PurgeDLQMessages", request, newAdminAPIServicePurgeDLQMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PurgeDLQMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServicePurgeDLQMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) MergeDLQMessages(ctx context.Context, request *MergeDLQMessagesRequest, options ...yarpc.CallOption) (*MergeDLQMessagesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "MergeDLQMessages", request, newAdminAPIServiceMergeDLQMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*MergeDLQMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceMergeDLQMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) RefreshWorkflowTasks(ctx context.Context, request *RefreshWorkflowTasksRequest, options ...yarpc.CallOption) (*RefreshWorkflowTasksResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RefreshWorkflowTasks", request, newAdminAPIServiceRefreshWorkflowTasksYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RefreshWorkflowTasksResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceRefreshWorkflowTasksYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) PauseActivity(ctx context.Context, request *PauseActivityRequest, options ...yarpc.CallOption) (*PauseActivityResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PauseActivity", request, newAdminAPIServicePauseActivityYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PauseActivityResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServicePauseActivityYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) UnpauseActivity(ctx context.Context, request *UnpauseActivityRequest, options ...yarpc.CallOption) (*UnpauseActivityResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UnpauseActivity", request, newAdminAPIServiceUnpauseActivityYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UnpauseActivityResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceUnpauseActivityYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ResetActivity(ctx context.Context, request *ResetActivityRequest, options ...yarpc.CallOption) (*ResetActivityResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResetActivity", request, newAdminAPIServiceResetActivityYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResetActivityResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceResetActivityYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) UpdateActivityOptions(ctx context.Context, request *UpdateActivityOptionsRequest, options ...yarpc.CallOption) (*UpdateActivityOptionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateActivityOptions", request, newAdminAPIServiceUpdateActivityOptionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateActivityOptionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceUpdateActivityOptionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ResendReplicationTasks(ctx context.Context, request *ResendReplicationTasksRequest, options ...yarpc.CallOption) (*ResendReplicationTasksResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResendReplicationTasks", request, newAdminAPIServiceResendReplicationTasksYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResendReplicationTasksResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceResendReplicationTasksYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ImportWorkflowExecution(ctx context.Context, request *ImportWorkflowExecutionRequest, options ...yarpc.CallOption) (*ImportWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ImportWorkflowExecution", request, newAdminAPIServiceImportWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ImportWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceImportWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetCrossClusterTasks(ctx context.Context, request *GetCrossClusterTasksRequest, options ...yarpc.CallOption) (*GetCrossClusterTasksResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetCrossClusterTasks", request, newAdminAPIServiceGetCrossClusterTasksYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetCrossClusterTasksResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetCrossClusterTasksYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) RespondCrossClusterTasksCompleted(ctx context.Context, request *RespondCrossClusterTasksCompletedRequest, options ...yarpc.CallOption) (*RespondCrossClusterTasksCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondCrossClusterTasksCompleted", request, newAdminAPIServiceRespondCrossClusterTasksCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondCrossClusterTasksCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceRespondCrossClusterTasksCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetDynamicConfig(ctx context.Context, request *GetDynamicConfigRequest, options ...yarpc.CallOption) (*GetDynamicConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetDynamicConfig", request, newAdminAPIServiceGetDynamicConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetDynamicConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetDynamicConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest, options ...yarpc.CallOption) (*UpdateDynamicConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateDynamicConfig", request, newAdminAPIServiceUpdateDynamicConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateDynamicConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceUpdateDynamicConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) RestoreDynamicConfig(ctx context.Context, request *RestoreDynamicConfigRequest, options ...yarpc.CallOption) (*RestoreDynamicConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RestoreDynamicConfig", request, newAdminAPIServiceRestoreDynamicConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RestoreDynamicConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceRestoreDynamicConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ListDynamicConfig(ctx context.Context, request *ListDynamicConfigRequest, options ...yarpc.CallOption) (*ListDynamicConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListDynamicConfig", request, newAdminAPIServiceListDynamicConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListDynamicConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceListDynamicConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) DeleteWorkflow(ctx context.Context, request *DeleteWorkflowRequest, options ...yarpc.CallOption) (*DeleteWorkflowResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DeleteWorkflow", request, newAdminAPIServiceDeleteWorkflowYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DeleteWorkflowResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceDeleteWorkflowYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) MaintainCorruptWorkflow(ctx context.Context, request *MaintainCorruptWorkflowRequest, options ...yarpc.CallOption) (*MaintainCorruptWorkflowResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "MaintainCorruptWorkflow", request, newAdminAPIServiceMaintainCorruptWorkflowYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*MaintainCorruptWorkflowResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceMaintainCorruptWorkflowYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetGlobalIsolationGroups(ctx context.Context, request *GetGlobalIsolationGroupsRequest, options ...yarpc.CallOption) (*GetGlobalIsolationGroupsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetGlobalIsolationGroups", request, newAdminAPIServiceGetGlobalIsolationGroupsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetGlobalIsolationGroupsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetGlobalIsolationGroupsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) UpdateGlobalIsolationGroups(ctx context.Context, request *UpdateGlobalIsolationGroupsRequest, options ...yarpc.CallOption) (*UpdateGlobalIsolationGroupsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateGlobalIsolationGroups", request, newAdminAPIServiceUpdateGlobalIsolationGroupsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateGlobalIsolationGroupsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceUpdateGlobalIsolationGroupsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetDomainIsolationGroups(ctx context.Context, request *GetDomainIsolationGroupsRequest, options ...yarpc.CallOption) (*GetDomainIsolationGroupsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetDomainIsolationGroups", request, newAdminAPIServiceGetDomainIsolationGroupsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetDomainIsolationGroupsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetDomainIsolationGroupsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) UpdateDomainIsolationGroups(ctx context.Context, request *UpdateDomainIsolationGroupsRequest, options ...yarpc.CallOption) (*UpdateDomainIsolationGroupsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateDomainIsolationGroups", request, newAdminAPIServiceUpdateDomainIsolationGroupsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateDomainIsolationGroupsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceUpdateDomainIsolationGroupsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetDomainAsyncWorkflowConfiguraton(ctx context.Context, request *GetDomainAsyncWorkflowConfiguratonRequest, options ...yarpc.CallOption) (*GetDomainAsyncWorkflowConfiguratonResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetDomainAsyncWorkflowConfiguraton", request, newAdminAPIServiceGetDomainAsyncWorkflowConfiguratonYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetDomainAsyncWorkflowConfiguratonResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetDomainAsyncWorkflowConfiguratonYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *UpdateDomainAsyncWorkflowConfiguratonRequest, options ...yarpc.CallOption) (*UpdateDomainAsyncWorkflowConfiguratonResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateDomainAsyncWorkflowConfiguraton", request, newAdminAPIServiceUpdateDomainAsyncWorkflowConfiguratonYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateDomainAsyncWorkflowConfiguratonResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceUpdateDomainAsyncWorkflowConfiguratonYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+type _AdminAPIYARPCHandler struct {
+	server AdminAPIYARPCServer
+}
+
+func (h *_AdminAPIYARPCHandler) DescribeWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceDescribeWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) DescribeHistoryHost(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeHistoryHostRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeHistoryHostRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceDescribeHistoryHostYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeHistoryHost(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) DescribeShardDistribution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeShardDistributionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeShardDistributionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceDescribeShardDistributionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeShardDistribution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) CloseShard(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *CloseShardRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*CloseShardRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceCloseShardYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.CloseShard(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) RemoveTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RemoveTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RemoveTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceRemoveTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RemoveTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ResetQueue(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResetQueueRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResetQueueRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceResetQueueYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResetQueue(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) DescribeQueue(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeQueueRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeQueueRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceDescribeQueueYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeQueue(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ListThrottledWorkflowIDs(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListThrottledWorkflowIDsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListThrottledWorkflowIDsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceListThrottledWorkflowIDsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListThrottledWorkflowIDs(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetDomainUsage(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetDomainUsageRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetDomainUsageRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetDomainUsageYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetDomainUsage(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetWorkflowExecutionRawHistoryV2Request
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetWorkflowExecutionRawHistoryV2Request)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetWorkflowExecutionRawHistoryV2(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetReplicationMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetReplicationMessagesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetReplicationMessagesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetReplicationMessagesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetReplicationMessages(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetDLQReplicationMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetDLQReplicationMessagesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetDLQReplicationMessagesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetDLQReplicationMessagesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetDLQReplicationMessages(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetDomainReplicationMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetDomainReplicationMessagesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetDomainReplicationMessagesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetDomainReplicationMessagesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetDomainReplicationMessages(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ReapplyEvents(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ReapplyEventsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ReapplyEventsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceReapplyEventsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ReapplyEvents(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) AddSearchAttribute(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *AddSearchAttributeRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*AddSearchAttributeRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceAddSearchAttributeYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.AddSearchAttribute(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) DescribeCluster(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeClusterRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeClusterRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceDescribeClusterYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeCluster(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) CountDLQMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *CountDLQMessagesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*CountDLQMessagesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceCountDLQMessagesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.CountDLQMessages(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ReadDLQMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ReadDLQMessagesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ReadDLQMessagesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceReadDLQMessagesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ReadDLQMessages(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) PurgeDLQMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PurgeDLQMessagesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PurgeDLQMessagesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServicePurgeDLQMessagesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PurgeDLQMessages(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) MergeDLQMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *MergeDLQMessagesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*MergeDLQMessagesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceMergeDLQMessagesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.MergeDLQMessages(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) RefreshWorkflowTasks(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RefreshWorkflowTasksRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RefreshWorkflowTasksRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceRefreshWorkflowTasksYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RefreshWorkflowTasks(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) PauseActivity(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PauseActivityRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PauseActivityRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServicePauseActivityYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PauseActivity(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) UnpauseActivity(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UnpauseActivityRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UnpauseActivityRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceUnpauseActivityYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UnpauseActivity(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ResetActivity(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResetActivityRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResetActivityRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceResetActivityYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResetActivity(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) UpdateActivityOptions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateActivityOptionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateActivityOptionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceUpdateActivityOptionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateActivityOptions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ResendReplicationTasks(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResendReplicationTasksRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResendReplicationTasksRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceResendReplicationTasksYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResendReplicationTasks(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ImportWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ImportWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ImportWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceImportWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ImportWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetCrossClusterTasks(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetCrossClusterTasksRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetCrossClusterTasksRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetCrossClusterTasksYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetCrossClusterTasks(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) RespondCrossClusterTasksCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondCrossClusterTasksCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondCrossClusterTasksCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceRespondCrossClusterTasksCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondCrossClusterTasksCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetDynamicConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetDynamicConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetDynamicConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetDynamicConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetDynamicConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) UpdateDynamicConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateDynamicConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateDynamicConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceUpdateDynamicConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateDynamicConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) RestoreDynamicConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RestoreDynamicConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RestoreDynamicConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceRestoreDynamicConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RestoreDynamicConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ListDynamicConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListDynamicConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListDynamicConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceListDynamicConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListDynamicConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) DeleteWorkflow(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DeleteWorkflowRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DeleteWorkflowRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceDeleteWorkflowYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DeleteWorkflow(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) MaintainCorruptWorkflow(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *MaintainCorruptWorkflowRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*MaintainCorruptWorkflowRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceMaintainCorruptWorkflowYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.MaintainCorruptWorkflow(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetGlobalIsolationGroups(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetGlobalIsolationGroupsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetGlobalIsolationGroupsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetGlobalIsolationGroupsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetGlobalIsolationGroups(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) UpdateGlobalIsolationGroups(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateGlobalIsolationGroupsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateGlobalIsolationGroupsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceUpdateGlobalIsolationGroupsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateGlobalIsolationGroups(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetDomainIsolationGroups(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetDomainIsolationGroupsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetDomainIsolationGroupsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetDomainIsolationGroupsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetDomainIsolationGroups(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) UpdateDomainIsolationGroups(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateDomainIsolationGroupsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateDomainIsolationGroupsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceUpdateDomainIsolationGroupsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateDomainIsolationGroups(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetDomainAsyncWorkflowConfiguraton(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetDomainAsyncWorkflowConfiguratonRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetDomainAsyncWorkflowConfiguratonRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetDomainAsyncWorkflowConfiguratonYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetDomainAsyncWorkflowConfiguraton(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateDomainAsyncWorkflowConfiguratonRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateDomainAsyncWorkflowConfiguratonRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceUpdateDomainAsyncWorkflowConfiguratonYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateDomainAsyncWorkflowConfiguraton(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func newAdminAPIServiceDescribeWorkflowExecutionYARPCRequest() proto.Message {
+	return &DescribeWorkflowExecutionRequest{}
+}
+
+func newAdminAPIServiceDescribeWorkflowExecutionYARPCResponse() proto.Message {
+	return &DescribeWorkflowExecutionResponse{}
+}
+
+func newAdminAPIServiceDescribeHistoryHostYARPCRequest() proto.Message {
+	return &DescribeHistoryHostRequest{}
+}
+
+func newAdminAPIServiceDescribeHistoryHostYARPCResponse() proto.Message {
+	return &DescribeHistoryHostResponse{}
+}
+
+func newAdminAPIServiceDescribeShardDistributionYARPCRequest() proto.Message {
+	return &DescribeShardDistributionRequest{}
+}
+
+func newAdminAPIServiceDescribeShardDistributionYARPCResponse() proto.Message {
+	return &DescribeShardDistributionResponse{}
+}
+
+func newAdminAPIServiceCloseShardYARPCRequest() proto.Message {
+	return &CloseShardRequest{}
+}
+
+func newAdminAPIServiceCloseShardYARPCResponse() proto.Message {
+	return &CloseShardResponse{}
+}
+
+func newAdminAPIServiceRemoveTaskYARPCRequest() proto.Message {
+	return &RemoveTaskRequest{}
+}
+
+func newAdminAPIServiceRemoveTaskYARPCResponse() proto.Message {
+	return &RemoveTaskResponse{}
+}
+
+func newAdminAPIServiceResetQueueYARPCRequest() proto.Message {
+	return &ResetQueueRequest{}
+}
+
+func newAdminAPIServiceResetQueueYARPCResponse() proto.Message {
+	return &ResetQueueResponse{}
+}
+
+func newAdminAPIServiceDescribeQueueYARPCRequest() proto.Message {
+	return &DescribeQueueRequest{}
+}
+
+func newAdminAPIServiceDescribeQueueYARPCResponse() proto.Message {
+	return &DescribeQueueResponse{}
+}
+
+func newAdminAPIServiceListThrottledWorkflowIDsYARPCRequest() proto.Message {
+	return &ListThrottledWorkflowIDsRequest{}
+}
+
+func newAdminAPIServiceListThrottledWorkflowIDsYARPCResponse() proto.Message {
+	return &ListThrottledWorkflowIDsResponse{}
+}
+
+func newAdminAPIServiceGetDomainUsageYARPCRequest() proto.Message {
+	return &GetDomainUsageRequest{}
+}
+
+func newAdminAPIServiceGetDomainUsageYARPCResponse() proto.Message {
+	return &GetDomainUsageResponse{}
+}
+
+func newAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCRequest() proto.Message {
+	return &GetWorkflowExecutionRawHistoryV2Request{}
+}
+
+func newAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCResponse() proto.Message {
+	return &GetWorkflowExecutionRawHistoryV2Response{}
+}
+
+func newAdminAPIServiceGetReplicationMessagesYARPCRequest() proto.Message {
+	return &GetReplicationMessagesRequest{}
+}
+
+func newAdminAPIServiceGetReplicationMessagesYARPCResponse() proto.Message {
+	return &GetReplicationMessagesResponse{}
+}
+
+func newAdminAPIServiceGetDLQReplicationMessagesYARPCRequest() proto.Message {
+	return &GetDLQReplicationMessagesRequest{}
+}
+
+func newAdminAPIServiceGetDLQReplicationMessagesYARPCResponse() proto.Message {
+	return &GetDLQReplicationMessagesResponse{}
+}
+
+func newAdminAPIServiceGetDomainReplicationMessagesYARPCRequest() proto.Message {
+	return &GetDomainReplicationMessagesRequest{}
+}
+
+func newAdminAPIServiceGetDomainReplicationMessagesYARPCResponse() proto.Message {
+	return &GetDomainReplicationMessagesResponse{}
+}
+
+func newAdminAPIServiceReapplyEventsYARPCRequest() proto.Message {
+	return &ReapplyEventsRequest{}
+}
+
+func newAdminAPIServiceReapplyEventsYARPCResponse() proto.Message {
+	return &ReapplyEventsResponse{}
+}
+
+func newAdminAPIServiceAddSearchAttributeYARPCRequest() proto.Message {
+	return &AddSearchAttributeRequest{}
+}
+
+func newAdminAPIServiceAddSearchAttributeYARPCResponse() proto.Message {
+	return &AddSearchAttributeResponse{}
+}
+
+func newAdminAPIServiceDescribeClusterYARPCRequest() proto.Message {
+	return &DescribeClusterRequest{}
+}
+
+func newAdminAPIServiceDescribeClusterYARPCResponse() proto.Message {
+	return &DescribeClusterResponse{}
+}
+
+func newAdminAPIServiceCountDLQMessagesYARPCRequest() proto.Message {
+	return &CountDLQMessagesRequest{}
+}
+
+func newAdminAPIServiceCountDLQMessagesYARPCResponse() proto.Message {
+	return &CountDLQMessagesResponse{}
+}
+
+func newAdminAPIServiceReadDLQMessagesYARPCRequest() proto.Message {
+	return &ReadDLQMessagesRequest{}
+}
+
+func newAdminAPIServiceReadDLQMessagesYARPCResponse() proto.Message {
+	return &ReadDLQMessagesResponse{}
+}
+
+func newAdminAPIServicePurgeDLQMessagesYARPCRequest() proto.Message {
+	return &PurgeDLQMessagesRequest{}
+}
+
+func newAdminAPIServicePurgeDLQMessagesYARPCResponse() proto.Message {
+	return &PurgeDLQMessagesResponse{}
+}
+
+func newAdminAPIServiceMergeDLQMessagesYARPCRequest() proto.Message {
+	return &MergeDLQMessagesRequest{}
+}
+
+func newAdminAPIServiceMergeDLQMessagesYARPCResponse() proto.Message {
+	return &MergeDLQMessagesResponse{}
+}
+
+func newAdminAPIServiceRefreshWorkflowTasksYARPCRequest() proto.Message {
+	return &RefreshWorkflowTasksRequest{}
+}
+
+func newAdminAPIServiceRefreshWorkflowTasksYARPCResponse() proto.Message {
+	return &RefreshWorkflowTasksResponse{}
+}
+
+func newAdminAPIServicePauseActivityYARPCRequest() proto.Message {
+	return &PauseActivityRequest{}
+}
+
+func newAdminAPIServicePauseActivityYARPCResponse() proto.Message {
+	return &PauseActivityResponse{}
+}
+
+func newAdminAPIServiceUnpauseActivityYARPCRequest() proto.Message {
+	return &UnpauseActivityRequest{}
+}
+
+func newAdminAPIServiceUnpauseActivityYARPCResponse() proto.Message {
+	return &UnpauseActivityResponse{}
+}
+
+func newAdminAPIServiceResetActivityYARPCRequest() proto.Message {
+	return &ResetActivityRequest{}
+}
+
+func newAdminAPIServiceResetActivityYARPCResponse() proto.Message {
+	return &ResetActivityResponse{}
+}
+
+func newAdminAPIServiceUpdateActivityOptionsYARPCRequest() proto.Message {
+	return &UpdateActivityOptionsRequest{}
+}
+
+func newAdminAPIServiceUpdateActivityOptionsYARPCResponse() proto.Message {
+	return &UpdateActivityOptionsResponse{}
+}
+
+func newAdminAPIServiceResendReplicationTasksYARPCRequest() proto.Message {
+	return &ResendReplicationTasksRequest{}
+}
+
+func newAdminAPIServiceResendReplicationTasksYARPCResponse() proto.Message {
+	return &ResendReplicationTasksResponse{}
+}
+
+func newAdminAPIServiceImportWorkflowExecutionYARPCRequest() proto.Message {
+	return &ImportWorkflowExecutionRequest{}
+}
+
+func newAdminAPIServiceImportWorkflowExecutionYARPCResponse() proto.Message {
+	return &ImportWorkflowExecutionResponse{}
+}
+
+func newAdminAPIServiceGetCrossClusterTasksYARPCRequest() proto.Message {
+	return &GetCrossClusterTasksRequest{}
+}
+
+func newAdminAPIServiceGetCrossClusterTasksYARPCResponse() proto.Message {
+	return &GetCrossClusterTasksResponse{}
+}
+
+func newAdminAPIServiceRespondCrossClusterTasksCompletedYARPCRequest() proto.Message {
+	return &RespondCrossClusterTasksCompletedRequest{}
+}
+
+func newAdminAPIServiceRespondCrossClusterTasksCompletedYARPCResponse() proto.Message {
+	return &RespondCrossClusterTasksCompletedResponse{}
+}
+
+func newAdminAPIServiceGetDynamicConfigYARPCRequest() proto.Message {
+	return &GetDynamicConfigRequest{}
+}
+
+func newAdminAPIServiceGetDynamicConfigYARPCResponse() proto.Message {
+	return &GetDynamicConfigResponse{}
+}
+
+func newAdminAPIServiceUpdateDynamicConfigYARPCRequest() proto.Message {
+	return &UpdateDynamicConfigRequest{}
+}
+
+func newAdminAPIServiceUpdateDynamicConfigYARPCResponse() proto.Message {
+	return &UpdateDynamicConfigResponse{}
+}
+
+func newAdminAPIServiceRestoreDynamicConfigYARPCRequest() proto.Message {
+	return &RestoreDynamicConfigRequest{}
+}
+
+func newAdminAPIServiceRestoreDynamicConfigYARPCResponse() proto.Message {
+	return &RestoreDynamicConfigResponse{}
+}
+
+func newAdminAPIServiceListDynamicConfigYARPCRequest() proto.Message {
+	return &ListDynamicConfigRequest{}
+}
+
+func newAdminAPIServiceListDynamicConfigYARPCResponse() proto.Message {
+	return &ListDynamicConfigResponse{}
+}
+
+func newAdminAPIServiceDeleteWorkflowYARPCRequest() proto.Message {
+	return &DeleteWorkflowRequest{}
+}
+
+func newAdminAPIServiceDeleteWorkflowYARPCResponse() proto.Message {
+	return &DeleteWorkflowResponse{}
+}
+
+func newAdminAPIServiceMaintainCorruptWorkflowYARPCRequest() proto.Message {
+	return &MaintainCorruptWorkflowRequest{}
+}
+
+func newAdminAPIServiceMaintainCorruptWorkflowYARPCResponse() proto.Message {
+	return &MaintainCorruptWorkflowResponse{}
+}
+
+func newAdminAPIServiceGetGlobalIsolationGroupsYARPCRequest() proto.Message {
+	return &GetGlobalIsolationGroupsRequest{}
+}
+
+func newAdminAPIServiceGetGlobalIsolationGroupsYARPCResponse() proto.Message {
+	return &GetGlobalIsolationGroupsResponse{}
+}
+
+func newAdminAPIServiceUpdateGlobalIsolationGroupsYARPCRequest() proto.Message {
+	return &UpdateGlobalIsolationGroupsRequest{}
+}
+
+func newAdminAPIServiceUpdateGlobalIsolationGroupsYARPCResponse() proto.Message {
+	return &UpdateGlobalIsolationGroupsResponse{}
+}
+
+func newAdminAPIServiceGetDomainIsolationGroupsYARPCRequest() proto.Message {
+	return &GetDomainIsolationGroupsRequest{}
+}
+
+func newAdminAPIServiceGetDomainIsolationGroupsYARPCResponse() proto.Message {
+	return &GetDomainIsolationGroupsResponse{}
+}
+
+func newAdminAPIServiceUpdateDomainIsolationGroupsYARPCRequest() proto.Message {
+	return &UpdateDomainIsolationGroupsRequest{}
+}
+
+func newAdminAPIServiceUpdateDomainIsolationGroupsYARPCResponse() proto.Message {
+	return &UpdateDomainIsolationGroupsResponse{}
+}
+
+func newAdminAPIServiceGetDomainAsyncWorkflowConfiguratonYARPCRequest() proto.Message {
+	return &GetDomainAsyncWorkflowConfiguratonRequest{}
+}
+
+func newAdminAPIServiceGetDomainAsyncWorkflowConfiguratonYARPCResponse() proto.Message {
+	return &GetDomainAsyncWorkflowConfiguratonResponse{}
+}
+
+func newAdminAPIServiceUpdateDomainAsyncWorkflowConfiguratonYARPCRequest() proto.Message {
+	return &UpdateDomainAsyncWorkflowConfiguratonRequest{}
+}
+
+func newAdminAPIServiceUpdateDomainAsyncWorkflowConfiguratonYARPCResponse() proto.Message {
+	return &UpdateDomainAsyncWorkflowConfiguratonResponse{}
+}
+
+var (
+	emptyAdminAPIServiceDescribeWorkflowExecutionYARPCRequest              = &DescribeWorkflowExecutionRequest{}
+	emptyAdminAPIServiceDescribeWorkflowExecutionYARPCResponse             = &DescribeWorkflowExecutionResponse{}
+	emptyAdminAPIServiceDescribeHistoryHostYARPCRequest                    = &DescribeHistoryHostRequest{}
+	emptyAdminAPIServiceDescribeHistoryHostYARPCResponse                   = &DescribeHistoryHostResponse{}
+	emptyAdminAPIServiceDescribeShardDistributionYARPCRequest              = &DescribeShardDistributionRequest{}
+	emptyAdminAPIServiceDescribeShardDistributionYARPCResponse             = &DescribeShardDistributionResponse{}
+	emptyAdminAPIServiceCloseShardYARPCRequest                             = &CloseShardRequest{}
+	emptyAdminAPIServiceCloseShardYARPCResponse                            = &CloseShardResponse{}
+	emptyAdminAPIServiceRemoveTaskYARPCRequest                             = &RemoveTaskRequest{}
+	emptyAdminAPIServiceRemoveTaskYARPCResponse                            = &RemoveTaskResponse{}
+	emptyAdminAPIServiceResetQueueYARPCRequest                             = &ResetQueueRequest{}
+	emptyAdminAPIServiceResetQueueYARPCResponse                            = &ResetQueueResponse{}
+	emptyAdminAPIServiceDescribeQueueYARPCRequest                          = &DescribeQueueRequest{}
+	emptyAdminAPIServiceDescribeQueueYARPCResponse                         = &DescribeQueueResponse{}
+	emptyAdminAPIServiceListThrottledWorkflowIDsYARPCRequest               = &ListThrottledWorkflowIDsRequest{}
+	emptyAdminAPIServiceListThrottledWorkflowIDsYARPCResponse              = &ListThrottledWorkflowIDsResponse{}
+	emptyAdminAPIServiceGetDomainUsageYARPCRequest                         = &GetDomainUsageRequest{}
+	emptyAdminAPIServiceGetDomainUsageYARPCResponse                        = &GetDomainUsageResponse{}
+	emptyAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCRequest       = &GetWorkflowExecutionRawHistoryV2Request{}
+	emptyAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCResponse      = &GetWorkflowExecutionRawHistoryV2Response{}
+	emptyAdminAPIServiceGetReplicationMessagesYARPCRequest                 = &GetReplicationMessagesRequest{}
+	emptyAdminAPIServiceGetReplicationMessagesYARPCResponse                = &GetReplicationMessagesResponse{}
+	emptyAdminAPIServiceGetDLQReplicationMessagesYARPCRequest              = &GetDLQReplicationMessagesRequest{}
+	emptyAdminAPIServiceGetDLQReplicationMessagesYARPCResponse             = &GetDLQReplicationMessagesResponse{}
+	emptyAdminAPIServiceGetDomainReplicationMessagesYARPCRequest           = &GetDomainReplicationMessagesRequest{}
+	emptyAdminAPIServiceGetDomainReplicationMessagesYARPCResponse          = &GetDomainReplicationMessagesResponse{}
+	emptyAdminAPIServiceReapplyEventsYARPCRequest                          = &ReapplyEventsRequest{}
+	emptyAdminAPIServiceReapplyEventsYARPCResponse                         = &ReapplyEventsResponse{}
+	emptyAdminAPIServiceAddSearchAttributeYARPCRequest                     = &AddSearchAttributeRequest{}
+	emptyAdminAPIServiceAddSearchAttributeYARPCResponse                    = &AddSearchAttributeResponse{}
+	emptyAdminAPIServiceDescribeClusterYARPCRequest                        = &DescribeClusterRequest{}
+	emptyAdminAPIServiceDescribeClusterYARPCResponse                       = &DescribeClusterResponse{}
+	emptyAdminAPIServiceCountDLQMessagesYARPCRequest                       = &CountDLQMessagesRequest{}
+	emptyAdminAPIServiceCountDLQMessagesYARPCResponse                      = &CountDLQMessagesResponse{}
+	emptyAdminAPIServiceReadDLQMessagesYARPCRequest                        = &ReadDLQMessagesRequest{}
+	emptyAdminAPIServiceReadDLQMessagesYARPCResponse                       = &ReadDLQMessagesResponse{}
+	emptyAdminAPIServicePurgeDLQMessagesYARPCRequest                       = &PurgeDLQMessagesRequest{}
+	emptyAdminAPIServicePurgeDLQMessagesYARPCResponse                      = &PurgeDLQMessagesResponse{}
+	emptyAdminAPIServiceMergeDLQMessagesYARPCRequest                       = &MergeDLQMessagesRequest{}
+	emptyAdminAPIServiceMergeDLQMessagesYARPCResponse                      = &MergeDLQMessagesResponse{}
+	emptyAdminAPIServiceRefreshWorkflowTasksYARPCRequest                   = &RefreshWorkflowTasksRequest{}
+	emptyAdminAPIServiceRefreshWorkflowTasksYARPCResponse                  = &RefreshWorkflowTasksResponse{}
+	emptyAdminAPIServicePauseActivityYARPCRequest                          = &PauseActivityRequest{}
+	emptyAdminAPIServicePauseActivityYARPCResponse                         = &PauseActivityResponse{}
+	emptyAdminAPIServiceUnpauseActivityYARPCRequest                        = &UnpauseActivityRequest{}
+	emptyAdminAPIServiceUnpauseActivityYARPCResponse                       = &UnpauseActivityResponse{}
+	emptyAdminAPIServiceResetActivityYARPCRequest                          = &ResetActivityRequest{}
+	emptyAdminAPIServiceResetActivityYARPCResponse                         = &ResetActivityResponse{}
+	emptyAdminAPIServiceUpdateActivityOptionsYARPCRequest                  = &UpdateActivityOptionsRequest{}
+	emptyAdminAPIServiceUpdateActivityOptionsYARPCResponse                 = &UpdateActivityOptionsResponse{}
+	emptyAdminAPIServiceResendReplicationTasksYARPCRequest                 = &ResendReplicationTasksRequest{}
+	emptyAdminAPIServiceResendReplicationTasksYARPCResponse                = &ResendReplicationTasksResponse{}
+	emptyAdminAPIServiceImportWorkflowExecutionYARPCRequest                = &ImportWorkflowExecutionRequest{}
+	emptyAdminAPIServiceImportWorkflowExecutionYARPCResponse               = &ImportWorkflowExecutionResponse{}
+	emptyAdminAPIServiceGetCrossClusterTasksYARPCRequest                   = &GetCrossClusterTasksRequest{}
+	emptyAdminAPIServiceGetCrossClusterTasksYARPCResponse                  = &GetCrossClusterTasksResponse{}
+	emptyAdminAPIServiceRespondCrossClusterTasksCompletedYARPCRequest      = &RespondCrossClusterTasksCompletedRequest{}
+	emptyAdminAPIServiceRespondCrossClusterTasksCompletedYARPCResponse     = &RespondCrossClusterTasksCompletedResponse{}
+	emptyAdminAPIServiceGetDynamicConfigYARPCRequest                       = &GetDynamicConfigRequest{}
+	emptyAdminAPIServiceGetDynamicConfigYARPCResponse                      = &GetDynamicConfigResponse{}
+	emptyAdminAPIServiceUpdateDynamicConfigYARPCRequest                    = &UpdateDynamicConfigRequest{}
+	emptyAdminAPIServiceUpdateDynamicConfigYARPCResponse                   = &UpdateDynamicConfigResponse{}
+	emptyAdminAPIServiceRestoreDynamicConfigYARPCRequest                   = &RestoreDynamicConfigRequest{}
+	emptyAdminAPIServiceRestoreDynamicConfigYARPCResponse                  = &RestoreDynamicConfigResponse{}
+	emptyAdminAPIServiceListDynamicConfigYARPCRequest                      = &ListDynamicConfigRequest{}
+	emptyAdminAPIServiceListDynamicConfigYARPCResponse                     = &ListDynamicConfigResponse{}
+	emptyAdminAPIServiceDeleteWorkflowYARPCRequest                         = &DeleteWorkflowRequest{}
+	emptyAdminAPIServiceDeleteWorkflowYARPCResponse                        = &DeleteWorkflowResponse{}
+	emptyAdminAPIServiceMaintainCorruptWorkflowYARPCRequest                = &MaintainCorruptWorkflowRequest{}
+	emptyAdminAPIServiceMaintainCorruptWorkflowYARPCResponse               = &MaintainCorruptWorkflowResponse{}
+	emptyAdminAPIServiceGetGlobalIsolationGroupsYARPCRequest               = &GetGlobalIsolationGroupsRequest{}
+	emptyAdminAPIServiceGetGlobalIsolationGroupsYARPCResponse              = &GetGlobalIsolationGroupsResponse{}
+	emptyAdminAPIServiceUpdateGlobalIsolationGroupsYARPCRequest            = &UpdateGlobalIsolationGroupsRequest{}
+	emptyAdminAPIServiceUpdateGlobalIsolationGroupsYARPCResponse           = &UpdateGlobalIsolationGroupsResponse{}
+	emptyAdminAPIServiceGetDomainIsolationGroupsYARPCRequest               = &GetDomainIsolationGroupsRequest{}
+	emptyAdminAPIServiceGetDomainIsolationGroupsYARPCResponse              = &GetDomainIsolationGroupsResponse{}
+	emptyAdminAPIServiceUpdateDomainIsolationGroupsYARPCRequest            = &UpdateDomainIsolationGroupsRequest{}
+	emptyAdminAPIServiceUpdateDomainIsolationGroupsYARPCResponse           = &UpdateDomainIsolationGroupsResponse{}
+	emptyAdminAPIServiceGetDomainAsyncWorkflowConfiguratonYARPCRequest     = &GetDomainAsyncWorkflowConfiguratonRequest{}
+	emptyAdminAPIServiceGetDomainAsyncWorkflowConfiguratonYARPCResponse    = &GetDomainAsyncWorkflowConfiguratonResponse{}
+	emptyAdminAPIServiceUpdateDomainAsyncWorkflowConfiguratonYARPCRequest  = &UpdateDomainAsyncWorkflowConfiguratonRequest{}
+	emptyAdminAPIServiceUpdateDomainAsyncWorkflowConfiguratonYARPCResponse = &UpdateDomainAsyncWorkflowConfiguratonResponse{}
+)
+
+// AdminReplicationStreamAPIYARPCClient is the YARPC client-side interface for the AdminReplicationStreamAPI service.
+type AdminReplicationStreamAPIYARPCClient interface {
+	StreamReplicationMessages(context.Context, ...yarpc.CallOption) (AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient, error)
+}
+
+// AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient sends StreamReplicationMessagesRequests and receives StreamReplicationMessagesResponses, returning io.EOF when the stream is complete.
+type AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient interface {
+	Context() context.Context
+	Send(*StreamReplicationMessagesRequest, ...yarpc.StreamOption) error
+	Recv(...yarpc.StreamOption) (*StreamReplicationMessagesResponse, error)
+	CloseSend(...yarpc.StreamOption) error
+}
+
+func newAdminReplicationStreamAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) AdminReplicationStreamAPIYARPCClient {
+	return &_AdminReplicationStreamAPIYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.admin.v1.AdminReplicationStreamAPI",
+			ClientConfig: clientConfig,
+			AnyResolver:  anyResolver,
+			Options:      options,
+		},
+	)}
+}
+
+// NewAdminReplicationStreamAPIYARPCClient builds a new YARPC client for the AdminReplicationStreamAPI service.
+func NewAdminReplicationStreamAPIYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) AdminReplicationStreamAPIYARPCClient {
+	return newAdminReplicationStreamAPIYARPCClient(clientConfig, nil, options...)
+}
+
+// AdminReplicationStreamAPIYARPCServer is the YARPC server-side interface for the AdminReplicationStreamAPI service.
+type AdminReplicationStreamAPIYARPCServer interface {
+	StreamReplicationMessages(AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer) error
+}
+
+// AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer receives StreamReplicationMessagesRequests and sends StreamReplicationMessagesResponse.
+type AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer interface {
+	Context() context.Context
+	Recv(...yarpc.StreamOption) (*StreamReplicationMessagesRequest, error)
+	Send(*StreamReplicationMessagesResponse, ...yarpc.StreamOption) error
+}
+
+type buildAdminReplicationStreamAPIYARPCProceduresParams struct {
+	Server      AdminReplicationStreamAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver
+}
+
+func buildAdminReplicationStreamAPIYARPCProcedures(params buildAdminReplicationStreamAPIYARPCProceduresParams) []transport.Procedure {
+	handler := &_AdminReplicationStreamAPIYARPCHandler{params.Server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName:         "uber.cadence.admin.v1.AdminReplicationStreamAPI",
+			UnaryHandlerParams:  []protobuf.BuildProceduresUnaryHandlerParams{},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{
+				{
+					MethodName: "StreamReplicationMessages",
+					Handler: protobuf.NewStreamHandler(
+						protobuf.StreamHandlerParams{
+							Handle: handler.StreamReplicationMessages,
+						},
+					),
+				},
+			},
+		},
+	)
+}
+
+// BuildAdminReplicationStreamAPIYARPCProcedures prepares an implementation of the AdminReplicationStreamAPI service for YARPC registration.
+func BuildAdminReplicationStreamAPIYARPCProcedures(server AdminReplicationStreamAPIYARPCServer) []transport.Procedure {
+	return buildAdminReplicationStreamAPIYARPCProcedures(buildAdminReplicationStreamAPIYARPCProceduresParams{Server: server})
+}
+
+// FxAdminReplicationStreamAPIYARPCClientParams defines the input
+// for NewFxAdminReplicationStreamAPIYARPCClient. It provides the
+// paramaters to get a AdminReplicationStreamAPIYARPCClient in an
+// Fx application.
+type FxAdminReplicationStreamAPIYARPCClientParams struct {
+	fx.In
+
+	Provider    yarpc.ClientConfig
+	AnyResolver jsonpb.AnyResolver  `name:"yarpcfx" optional:"true"`
+	Restriction restriction.Checker `optional:"true"`
+}
+
+// FxAdminReplicationStreamAPIYARPCClientResult defines the output
+// of NewFxAdminReplicationStreamAPIYARPCClient. It provides a
+// AdminReplicationStreamAPIYARPCClient to an Fx application.
+type FxAdminReplicationStreamAPIYARPCClientResult struct {
+	fx.Out
+
+	Client AdminReplicationStreamAPIYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxAdminReplicationStreamAPIYARPCClient provides a AdminReplicationStreamAPIYARPCClient
+// to an Fx application using the given name for routing.
+//
+//	fx.Provide(
+//	  adminv1.NewFxAdminReplicationStreamAPIYARPCClient("service-name"),
+//	  ...
+//	)
+func NewFxAdminReplicationStreamAPIYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxAdminReplicationStreamAPIYARPCClientParams) FxAdminReplicationStreamAPIYARPCClientResult {
+		cc := params.Provider.ClientConfig(name)
+
+		if params.Restriction != nil {
+			if namer, ok := cc.GetUnaryOutbound().(transport.Namer); ok {
+				if err := params.Restriction.Check(protobuf.Encoding, namer.TransportName()); err != nil {
+					panic(err.Error())
+				}
+			}
+		}
+
+		return FxAdminReplicationStreamAPIYARPCClientResult{
+			Client: newAdminReplicationStreamAPIYARPCClient(cc, params.AnyResolver, options...),
+		}
+	}
+}
+
+// FxAdminReplicationStreamAPIYARPCProceduresParams defines the input
+// for NewFxAdminReplicationStreamAPIYARPCProcedures. It provides the
+// paramaters to get AdminReplicationStreamAPIYARPCServer procedures in an
+// Fx application.
+type FxAdminReplicationStreamAPIYARPCProceduresParams struct {
+	fx.In
+
+	Server      AdminReplicationStreamAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver `name:"yarpcfx" optional:"true"`
+}
+
+// FxAdminReplicationStreamAPIYARPCProceduresResult defines the output
+// of NewFxAdminReplicationStreamAPIYARPCProcedures. It provides
+// AdminReplicationStreamAPIYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxAdminReplicationStreamAPIYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxAdminReplicationStreamAPIYARPCProcedures provides AdminReplicationStreamAPIYARPCServer procedures to an Fx application.
+// It expects a AdminReplicationStreamAPIYARPCServer to be present in the container.
+//
+//	fx.Provide(
+//	  adminv1.NewFxAdminReplicationStreamAPIYARPCProcedures(),
+//	  ...
+//	)
+func NewFxAdminReplicationStreamAPIYARPCProcedures() interface{} {
+	return func(params FxAdminReplicationStreamAPIYARPCProceduresParams) FxAdminReplicationStreamAPIYARPCProceduresResult {
+		return FxAdminReplicationStreamAPIYARPCProceduresResult{
+			Procedures: buildAdminReplicationStreamAPIYARPCProcedures(buildAdminReplicationStreamAPIYARPCProceduresParams{
+				Server:      params.Server,
+				AnyResolver: params.AnyResolver,
+			}),
+			ReflectionMeta: AdminReplicationStreamAPIReflectionMeta,
+		}
+	}
+}
+
+// AdminReplicationStreamAPIReflectionMeta is the reflection server metadata
+// required for using the gRPC reflection protocol with YARPC.
+//
+// See https://github.com/grpc/grpc/blob/master/doc/server-reflection.md.
+var AdminReplicationStreamAPIReflectionMeta = reflection.ServerMeta{
+	ServiceName:     "uber.cadence.admin.v1.AdminReplicationStreamAPI",
+	FileDescriptors: yarpcFileDescriptorClosurec6fc96d64a8b67fd,
+}
+
+type _AdminReplicationStreamAPIYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_AdminReplicationStreamAPIYARPCCaller) StreamReplicationMessages(ctx context.Context, options ...yarpc.CallOption) (AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient, error) {
+	stream, err := c.streamClient.CallStream(ctx, "StreamReplicationMessages", options...)
+	if err != nil {
+		return nil, err
+	}
+	return &_AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient{stream: stream}, nil
+}
+
+type _AdminReplicationStreamAPIYARPCHandler struct {
+	server AdminReplicationStreamAPIYARPCServer
+}
+
+func (h *_AdminReplicationStreamAPIYARPCHandler) StreamReplicationMessages(serverStream *protobuf.ServerStream) error {
+	return h.server.StreamReplicationMessages(&_AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer{serverStream: serverStream})
+}
+
+type _AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient struct {
+	stream *protobuf.ClientStream
+}
+
+func (c *_AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient) Context() context.Context {
+	return c.stream.Context()
+}
+
+func (c *_AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient) Send(request *StreamReplicationMessagesRequest, options ...yarpc.StreamOption) error {
+	return c.stream.Send(request, options...)
+}
+
+func (c *_AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient) Recv(options ...yarpc.StreamOption) (*StreamReplicationMessagesResponse, error) {
+	responseMessage, err := c.stream.Receive(newAdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*StreamReplicationMessagesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCClient) CloseSend(options ...yarpc.StreamOption) error {
+	return c.stream.Close(options...)
+}
+
+type _AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer struct {
+	serverStream *protobuf.ServerStream
+}
+
+func (s *_AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer) Context() context.Context {
+	return s.serverStream.Context()
+}
+
+func (s *_AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer) Recv(options ...yarpc.StreamOption) (*StreamReplicationMessagesRequest, error) {
+	requestMessage, err := s.serverStream.Receive(newAdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCRequest, options...)
+	if requestMessage == nil {
+		return nil, err
+	}
+	request, ok := requestMessage.(*StreamReplicationMessagesRequest)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCRequest, requestMessage)
+	}
+	return request, err
+}
+
+func (s *_AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer) Send(response *StreamReplicationMessagesResponse, options ...yarpc.StreamOption) error {
+	return s.serverStream.Send(response, options...)
+}
+
+func newAdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCRequest() proto.Message {
+	return &StreamReplicationMessagesRequest{}
+}
+
+func newAdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCResponse() proto.Message {
+	return &StreamReplicationMessagesResponse{}
+}
+
+var (
+	emptyAdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCRequest  = &StreamReplicationMessagesRequest{}
+	emptyAdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCResponse = &StreamReplicationMessagesResponse{}
+)
+
+var yarpcFileDescriptorClosurec6fc96d64a8b67fd = [][]byte{
+	// uber/cadence/admin/v1/service.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0x49, 0x6c, 0x1c, 0xcb,
+		0x75, 0xbf, 0x87, 0xfb, 0x1b, 0x91, 0x22, 0x5b, 0x5c, 0x9b, 0x12, 0x97, 0xfe, 0x5f, 0xff, 0x93,
+		0xb6, 0x34, 0x94, 0xa8, 0xaf, 0xfd, 0x23, 0xfa, 0xd4, 0x50, 0x94, 0xc6, 0xd1, 0xda, 0xa4, 0xe4,
+		0x20, 0xdb, 0xb8, 0x67, 0xba, 0x48, 0xb6, 0x35, 0xd3, 0x3d, 0xee, 0xaa, 0x19, 0x69, 0x8c, 0x20,
+		0x8e, 0x13, 0xdb, 0x08, 0x90, 0x0d, 0x30, 0x02, 0x04, 0x39, 0x18, 0x46, 0x10, 0x24, 0x48, 0x80,
+		0x00, 0x09, 0x72, 0xce, 0x29, 0x09, 0x72, 0xcc, 0x25, 0xe7, 0x9c, 0xb2, 0x1c, 0x82, 0x04, 0x41,
+		0x6e, 0xb9, 0x25, 0xa8, 0xa5, 0xa7, 0xd7, 0xea, 0xe9, 0xe1, 0x97, 0x4d, 0xd9, 0x37, 0x76, 0xd5,
+		0xdb, 0xeb, 0xd5, 0xab, 0x57, 0xaf, 0xde, 0x10, 0x3e, 0x6c, 0xd7, 0x90, 0xb7, 0x55, 0x37, 0x2d,
+		0xe4, 0xd4, 0xd1, 0x96, 0x69, 0x35, 0x6d, 0x67, 0xab, 0x73, 0x75, 0x0b, 0x23, 0xaf, 0x63, 0xd7,
+		0x51, 0xa9, 0xe5, 0xb9, 0xc4, 0x55, 0xe7, 0x28, 0x50, 0x49, 0x00, 0x95, 0x18, 0x50, 0xa9, 0x73,
+		0x55, 0x5b, 0x39, 0x72, 0xdd, 0xa3, 0x06, 0xda, 0x62, 0x40, 0xb5, 0xf6, 0xe1, 0x96, 0xd5, 0xf6,
+		0x4c, 0x62, 0xbb, 0x0e, 0x47, 0xd3, 0x56, 0xe3, 0xf3, 0xc4, 0x6e, 0x22, 0x4c, 0xcc, 0x66, 0x4b,
+		0x00, 0x24, 0x08, 0xbc, 0xf1, 0xcc, 0x56, 0x0b, 0x79, 0x58, 0xcc, 0xaf, 0x45, 0x85, 0x6b, 0xd9,
+		0x54, 0xb4, 0xba, 0xdb, 0x6c, 0xf6, 0x58, 0x7c, 0x94, 0x06, 0xd1, 0xb1, 0xb1, 0x5d, 0xb3, 0x1b,
+		0x36, 0xe9, 0x0a, 0x28, 0x89, 0x92, 0xf5, 0x46, 0x1b, 0x13, 0xe4, 0x65, 0x03, 0x1d, 0xdb, 0x98,
+		0xb8, 0x9e, 0x4f, 0x69, 0x3d, 0x1d, 0xe8, 0x1b, 0x6d, 0xd4, 0x16, 0xc6, 0xd2, 0x3e, 0x49, 0x07,
+		0xf1, 0x50, 0xab, 0x61, 0xd7, 0x43, 0xe6, 0xd1, 0xff, 0x4e, 0x81, 0xb5, 0x5d, 0x84, 0xeb, 0x9e,
+		0x5d, 0x43, 0x5f, 0x75, 0xbd, 0xd7, 0x87, 0x0d, 0xf7, 0xcd, 0x83, 0xb7, 0xa8, 0xde, 0xa6, 0x30,
+		0x06, 0xfa, 0x46, 0x1b, 0x61, 0xa2, 0xce, 0xc3, 0xa8, 0xe5, 0x36, 0x4d, 0xdb, 0x59, 0x54, 0xd6,
+		0x94, 0x8d, 0x09, 0x43, 0x7c, 0xa9, 0x2f, 0x41, 0x7d, 0x23, 0x70, 0xaa, 0xc8, 0x47, 0x5a, 0x2c,
+		0xac, 0x29, 0x1b, 0xc5, 0xed, 0x8f, 0x4b, 0xd1, 0xf5, 0x6a, 0xd9, 0xa5, 0xce, 0xd5, 0x52, 0x92,
+		0xc5, 0xcc, 0x9b, 0xf8, 0x90, 0x7a, 0x05, 0x66, 0x3d, 0x54, 0x6b, 0xdb, 0x0d, 0xab, 0x7a, 0xe8,
+		0xb9, 0xcd, 0xaa, 0xd0, 0x7e, 0x71, 0x68, 0x4d, 0xd9, 0x18, 0x37, 0x54, 0x31, 0xb7, 0xe7, 0xb9,
+		0xcd, 0x47, 0x7c, 0x46, 0xff, 0x41, 0x01, 0xd6, 0x33, 0xb4, 0xc0, 0x2d, 0xd7, 0xc1, 0x48, 0x5d,
+		0x82, 0x71, 0x7c, 0x6c, 0x7a, 0x56, 0xd5, 0xb6, 0x98, 0x22, 0x23, 0xc6, 0x18, 0xfb, 0xae, 0x58,
+		0xea, 0x3a, 0x9c, 0x11, 0x5c, 0xaa, 0xa6, 0x65, 0x79, 0x4c, 0x87, 0x09, 0xa3, 0x28, 0xc6, 0x76,
+		0x2c, 0xcb, 0x53, 0xaf, 0xc1, 0x7c, 0xb3, 0x4d, 0xcc, 0x5a, 0x03, 0x55, 0x31, 0x31, 0x09, 0xaa,
+		0xda, 0x4e, 0xb5, 0x6e, 0xd6, 0x8f, 0x11, 0x93, 0x6b, 0xc2, 0x38, 0x27, 0x66, 0xf7, 0xe9, 0x64,
+		0xc5, 0x29, 0xd3, 0x29, 0xf5, 0x36, 0x2c, 0x25, 0x90, 0x2c, 0x93, 0x98, 0x35, 0x13, 0xa3, 0xc5,
+		0x61, 0x86, 0x37, 0x1f, 0xc5, 0xdb, 0x15, 0xb3, 0xea, 0x57, 0x40, 0x8f, 0xa2, 0x72, 0xbd, 0x49,
+		0xd4, 0x26, 0x23, 0x8c, 0xc6, 0x4a, 0x98, 0x86, 0xc1, 0xe1, 0xc2, 0xf6, 0xf9, 0x07, 0x05, 0x34,
+		0xdf, 0x3e, 0x62, 0xec, 0x91, 0x8b, 0x89, 0xbf, 0xbe, 0x1f, 0xc2, 0x99, 0x63, 0x17, 0x13, 0xa6,
+		0x3a, 0xc2, 0x98, 0xaf, 0xf2, 0xa3, 0x0f, 0x8c, 0x22, 0x1d, 0xdd, 0xe1, 0x83, 0xea, 0x72, 0xc8,
+		0x7a, 0xd4, 0x3c, 0x23, 0x8f, 0x3e, 0x08, 0xec, 0xf7, 0xd5, 0x54, 0x4f, 0x18, 0x1a, 0xc4, 0x13,
+		0x1e, 0x7d, 0x90, 0xe2, 0x0b, 0xf7, 0x27, 0xa1, 0x68, 0x09, 0xc1, 0xab, 0xb5, 0xae, 0xfe, 0x73,
+		0x81, 0xb7, 0xee, 0x53, 0xd6, 0xbb, 0x36, 0x26, 0x9e, 0x5d, 0x8b, 0x78, 0xeb, 0x32, 0x4c, 0xb4,
+		0xcc, 0x23, 0x54, 0xc5, 0xf6, 0x37, 0x91, 0x58, 0xe7, 0x71, 0x3a, 0xb0, 0x6f, 0x7f, 0x13, 0xa9,
+		0x0b, 0x30, 0xc6, 0x26, 0x7d, 0x25, 0x8c, 0x51, 0xfa, 0x59, 0xb1, 0xf4, 0x7f, 0x57, 0x60, 0x3d,
+		0x83, 0xb4, 0x70, 0xa1, 0x0d, 0x98, 0x76, 0xda, 0xcd, 0x1a, 0xf2, 0xaa, 0xee, 0x61, 0x95, 0x29,
+		0x8f, 0x05, 0x8b, 0x29, 0x3e, 0xfe, 0xec, 0x90, 0x21, 0x63, 0xf5, 0x17, 0x61, 0x54, 0xcc, 0x17,
+		0xd6, 0x86, 0x36, 0x8a, 0xdb, 0xbb, 0xa5, 0xd4, 0xf8, 0x55, 0xea, 0xcb, 0xb3, 0xc4, 0x09, 0x3e,
+		0x70, 0x88, 0xd7, 0x35, 0x04, 0x4d, 0xed, 0x36, 0x14, 0x43, 0xc3, 0xea, 0x34, 0x0c, 0xbd, 0x46,
+		0x5d, 0x21, 0x09, 0xfd, 0x53, 0x9d, 0x85, 0x91, 0x8e, 0xd9, 0x68, 0x23, 0xe1, 0xc9, 0xfc, 0xe3,
+		0x4e, 0xe1, 0x96, 0xa2, 0x7f, 0xbb, 0x00, 0xcb, 0xa9, 0xbe, 0x30, 0xb0, 0x8a, 0xcb, 0x30, 0xe1,
+		0x7b, 0x04, 0xd7, 0x72, 0xc4, 0x18, 0x17, 0x0e, 0x81, 0xd5, 0x0a, 0x9c, 0xe1, 0x51, 0x22, 0xb4,
+		0x49, 0x92, 0xbe, 0xd0, 0xb3, 0x02, 0x03, 0x65, 0x7b, 0xa6, 0xe2, 0x1c, 0xba, 0x46, 0xd1, 0x0a,
+		0x06, 0xd4, 0x1b, 0xb0, 0xc0, 0xf9, 0xd4, 0x5d, 0x87, 0x78, 0x6e, 0xa3, 0x81, 0x3c, 0xb6, 0x25,
+		0xda, 0x58, 0x6c, 0xa1, 0x39, 0x36, 0x5d, 0xee, 0xcd, 0xee, 0xb3, 0x49, 0x75, 0x11, 0xc6, 0x7c,
+		0x8f, 0xe6, 0xdb, 0xc4, 0xff, 0xd4, 0x4b, 0x30, 0x53, 0x6e, 0xb8, 0x98, 0x1b, 0xdd, 0xf7, 0x1b,
+		0x79, 0x78, 0xd0, 0x67, 0x41, 0x0d, 0xc3, 0x73, 0x4b, 0xe9, 0xff, 0xa5, 0xc0, 0x8c, 0x81, 0x9a,
+		0x6e, 0x07, 0x1d, 0x98, 0xf8, 0x75, 0x7f, 0x32, 0xea, 0x67, 0x30, 0x41, 0x4c, 0xfc, 0xba, 0x4a,
+		0xba, 0x2d, 0xbe, 0x30, 0x53, 0xdb, 0xab, 0x12, 0x83, 0x50, 0x8a, 0x07, 0xdd, 0x16, 0x32, 0xc6,
+		0x89, 0xf8, 0x8b, 0xba, 0x2e, 0xc3, 0xb6, 0x2d, 0x66, 0xcc, 0x21, 0x63, 0x94, 0x7e, 0x56, 0x2c,
+		0xb5, 0x0c, 0x67, 0x83, 0xd3, 0xa6, 0x4a, 0xcf, 0x37, 0x66, 0x97, 0xe2, 0xb6, 0x56, 0xe2, 0x67,
+		0x5b, 0xc9, 0x3f, 0xdb, 0x4a, 0x07, 0xfe, 0xe1, 0x67, 0x4c, 0x05, 0x28, 0x74, 0x90, 0x46, 0x40,
+		0x71, 0x14, 0x55, 0x1d, 0xb3, 0x89, 0x84, 0xc5, 0x8a, 0x62, 0xec, 0xa9, 0xd9, 0x44, 0xd4, 0x0a,
+		0x61, 0x75, 0x85, 0x15, 0x7e, 0x8f, 0x59, 0x01, 0x23, 0xf2, 0xa2, 0x8d, 0xda, 0x28, 0x87, 0x15,
+		0xe2, 0x9c, 0x0a, 0x09, 0x4e, 0x51, 0x43, 0x0d, 0x0d, 0x68, 0x28, 0x2e, 0x67, 0x20, 0x90, 0x90,
+		0xf3, 0xfb, 0x0a, 0xcc, 0xfa, 0x7e, 0xff, 0xde, 0x88, 0xfa, 0x0c, 0xe6, 0x62, 0x32, 0x89, 0x5d,
+		0x78, 0x03, 0x16, 0x5a, 0x9e, 0x5b, 0x47, 0x18, 0xdb, 0xce, 0x51, 0x95, 0x1d, 0xed, 0xfc, 0x18,
+		0xa0, 0x9b, 0x71, 0x88, 0xfa, 0x7c, 0x30, 0xcd, 0x30, 0x59, 0xe8, 0xc7, 0xfa, 0x67, 0xb0, 0xfa,
+		0xd8, 0xc6, 0xe4, 0xe0, 0xd8, 0x73, 0x09, 0x69, 0x20, 0xcb, 0x0f, 0xb3, 0x95, 0x5d, 0x9c, 0xc3,
+		0xcf, 0xbf, 0xa3, 0xc0, 0x9a, 0x1c, 0x5d, 0x88, 0xf6, 0x35, 0x98, 0x27, 0xfe, 0x7c, 0xb5, 0x17,
+		0xf5, 0x6d, 0x8b, 0x4b, 0x56, 0xdc, 0xfe, 0x92, 0x4c, 0xfd, 0x24, 0x51, 0x63, 0x96, 0x24, 0x06,
+		0x2d, 0xac, 0xff, 0x91, 0x02, 0x73, 0x0f, 0x11, 0xe1, 0x41, 0xe1, 0x25, 0x36, 0x8f, 0x50, 0xbf,
+		0x4c, 0xe4, 0x36, 0x00, 0x26, 0xa6, 0x47, 0xb8, 0xf7, 0x17, 0xfa, 0x7a, 0xff, 0x04, 0x83, 0x66,
+		0x8e, 0x7f, 0x1d, 0xc6, 0x91, 0x63, 0x71, 0xc4, 0xa1, 0xbe, 0x88, 0x63, 0xc8, 0xb1, 0xe8, 0x97,
+		0x7e, 0x00, 0xf3, 0x71, 0x11, 0x85, 0x7d, 0xee, 0xc0, 0x48, 0x9b, 0x0e, 0x08, 0x73, 0x7c, 0x24,
+		0x31, 0x87, 0xaf, 0x30, 0x47, 0xe6, 0x28, 0xfa, 0xff, 0x14, 0xe0, 0x93, 0x87, 0x88, 0x24, 0x73,
+		0x18, 0xf3, 0x8d, 0x88, 0xd5, 0xaf, 0xb6, 0x4f, 0x29, 0x2b, 0xfb, 0x0a, 0x14, 0xb9, 0x89, 0x51,
+		0x07, 0x39, 0x44, 0x98, 0x6a, 0x53, 0xa2, 0xdc, 0x2b, 0xe4, 0x61, 0x7a, 0xa6, 0x73, 0x99, 0x2b,
+		0x04, 0x35, 0x0d, 0xbe, 0x40, 0x0f, 0x28, 0xb2, 0xba, 0x07, 0x13, 0xd4, 0xe6, 0x9c, 0xd2, 0xf0,
+		0xa0, 0x94, 0xe8, 0x7a, 0x71, 0x3a, 0x91, 0xa3, 0x7e, 0x24, 0x76, 0xd4, 0x7f, 0x0c, 0x67, 0x1d,
+		0xf4, 0x96, 0x54, 0x19, 0x04, 0x71, 0x5f, 0x23, 0x67, 0x71, 0x74, 0x4d, 0xd9, 0x38, 0x63, 0x4c,
+		0xd2, 0xe1, 0xe7, 0xe6, 0x11, 0x3a, 0xa0, 0x83, 0xfa, 0x7f, 0x28, 0xb0, 0xd1, 0xdf, 0xe6, 0x62,
+		0x71, 0x53, 0x88, 0x2a, 0x29, 0x44, 0xd5, 0x3d, 0x38, 0xeb, 0x27, 0x94, 0x35, 0x93, 0xd4, 0x8f,
+		0x91, 0x9f, 0x07, 0x5c, 0x48, 0x5d, 0x01, 0x9a, 0xf5, 0xdd, 0x6f, 0xb8, 0x35, 0x63, 0x4a, 0x60,
+		0xdd, 0xe7, 0x48, 0xea, 0x53, 0x38, 0xdb, 0xe1, 0x16, 0x88, 0xa4, 0xc1, 0xc5, 0xed, 0x8b, 0xb9,
+		0xec, 0x65, 0x4c, 0x75, 0x22, 0xdf, 0xfa, 0x6f, 0x28, 0x70, 0xe1, 0x21, 0x22, 0x46, 0x70, 0x11,
+		0x78, 0x82, 0x30, 0x75, 0xbd, 0x5e, 0x78, 0xb8, 0x07, 0xa3, 0x4c, 0x2f, 0x7f, 0x3b, 0x7f, 0x22,
+		0x61, 0x14, 0x22, 0xc1, 0x54, 0x36, 0x04, 0x5a, 0x8e, 0xa0, 0xa9, 0xff, 0x9f, 0x02, 0x2b, 0x32,
+		0x29, 0x84, 0xa1, 0x5d, 0x98, 0xe2, 0x51, 0xaa, 0x29, 0x66, 0x84, 0x38, 0x8f, 0x24, 0xe2, 0x64,
+		0x93, 0xe3, 0x49, 0x94, 0x3f, 0xca, 0x73, 0xa9, 0x49, 0x1c, 0x1e, 0xd3, 0x1a, 0xa0, 0x26, 0x81,
+		0x52, 0x32, 0xab, 0xcf, 0xc3, 0x99, 0x95, 0x3c, 0xda, 0xa5, 0x09, 0x13, 0xca, 0xc2, 0xbe, 0xa7,
+		0xc0, 0xda, 0x3e, 0xf1, 0x90, 0xd9, 0x3c, 0xe5, 0xa5, 0xf8, 0x6e, 0x01, 0xd6, 0x33, 0x04, 0x11,
+		0xab, 0xe1, 0x49, 0x56, 0xe3, 0x67, 0x25, 0x12, 0xf5, 0xa5, 0xf8, 0xde, 0x2d, 0x48, 0x13, 0xd6,
+		0x68, 0x3c, 0x7f, 0xfc, 0x22, 0x63, 0x3d, 0x2a, 0x00, 0x3c, 0x03, 0x73, 0x0e, 0xdd, 0x7e, 0xa7,
+		0x5d, 0x78, 0x4d, 0x68, 0x9a, 0x46, 0xb3, 0xda, 0x09, 0x22, 0xfe, 0xc2, 0xfa, 0x5b, 0x58, 0xcf,
+		0x60, 0x27, 0xac, 0xbe, 0x0f, 0x33, 0xa1, 0x1b, 0x7b, 0x95, 0x62, 0xfb, 0x6c, 0x3f, 0xce, 0xc7,
+		0xd6, 0x98, 0xf6, 0xa2, 0x03, 0x58, 0xff, 0x5f, 0x05, 0x3e, 0xec, 0x9d, 0x5c, 0x19, 0xca, 0xbe,
+		0x82, 0xa5, 0x86, 0x89, 0x49, 0xd5, 0x43, 0xc4, 0xb3, 0x51, 0x07, 0xf5, 0xd6, 0xde, 0xcf, 0x1b,
+		0x8a, 0xdb, 0xcb, 0x89, 0x83, 0xb2, 0xe2, 0x90, 0x1b, 0x9f, 0xbe, 0xa2, 0x46, 0x35, 0xe6, 0x29,
+		0xb6, 0xe1, 0x23, 0x0b, 0xea, 0x15, 0xab, 0x47, 0x57, 0xe4, 0x2f, 0x51, 0xba, 0x85, 0x9c, 0x74,
+		0x9f, 0xfb, 0xc8, 0x01, 0xdd, 0xb8, 0xaf, 0x0f, 0x25, 0x7d, 0xdd, 0x81, 0x8f, 0xb2, 0x35, 0x17,
+		0x76, 0xdf, 0x83, 0xf1, 0x90, 0x9f, 0x0f, 0xea, 0x54, 0x3d, 0x5c, 0xfd, 0x6f, 0x14, 0x98, 0x35,
+		0x90, 0xd9, 0x6a, 0x35, 0xba, 0xec, 0xbc, 0xc2, 0xa7, 0x74, 0x74, 0x5f, 0x87, 0x51, 0x76, 0xd4,
+		0x62, 0x71, 0x76, 0xf4, 0x39, 0x83, 0x04, 0xb0, 0xbe, 0x00, 0x73, 0x31, 0xe9, 0x45, 0x2a, 0xfd,
+		0xc3, 0x02, 0x2c, 0xed, 0x58, 0xd6, 0x3e, 0x32, 0xbd, 0xfa, 0xf1, 0x0e, 0xe1, 0x57, 0xd6, 0x5e,
+		0x8e, 0xd6, 0x82, 0x69, 0xcc, 0x66, 0xaa, 0xa6, 0x3f, 0x25, 0x9c, 0xf6, 0x81, 0xc4, 0x8a, 0x52,
+		0x5a, 0xa5, 0xd8, 0x30, 0x8f, 0x13, 0x67, 0x71, 0x74, 0x54, 0xbd, 0x08, 0x53, 0x18, 0xd5, 0xdb,
+		0x1e, 0xbb, 0xfe, 0xb0, 0x33, 0x99, 0x07, 0xba, 0x49, 0x7f, 0x94, 0x45, 0x45, 0xcd, 0x86, 0xd9,
+		0x34, 0x7a, 0xe1, 0x90, 0x32, 0xc1, 0x43, 0xca, 0xdd, 0x70, 0x48, 0x99, 0xda, 0xbe, 0x98, 0x6a,
+		0xaf, 0x8a, 0x63, 0xa1, 0xb7, 0xc8, 0x62, 0x5e, 0xc9, 0xd2, 0xfa, 0x50, 0x34, 0x39, 0x0f, 0x5a,
+		0x9a, 0x52, 0xc2, 0x7e, 0x8b, 0x30, 0xef, 0x67, 0xfd, 0x65, 0xee, 0x9e, 0x42, 0x5f, 0xfd, 0x2f,
+		0x87, 0x60, 0x21, 0x31, 0x25, 0xbc, 0xf2, 0x18, 0x96, 0x70, 0xbb, 0xd5, 0x72, 0x3d, 0x82, 0xac,
+		0x6a, 0xbd, 0x61, 0x23, 0x87, 0x54, 0xc5, 0xe9, 0xee, 0xbb, 0xe9, 0xa5, 0x54, 0x41, 0xf7, 0x7d,
+		0xac, 0x32, 0x43, 0x12, 0x19, 0x02, 0x36, 0x16, 0x70, 0xfa, 0x04, 0x4d, 0x3a, 0x9a, 0x88, 0x5e,
+		0xf5, 0xf1, 0xb1, 0xdd, 0x62, 0xd1, 0x4e, 0xf8, 0xa0, 0x2c, 0xe9, 0x78, 0xd2, 0x83, 0x66, 0x71,
+		0x6e, 0xaa, 0x19, 0xf9, 0x56, 0x1d, 0x98, 0x6e, 0x51, 0xda, 0x98, 0x50, 0x34, 0x4e, 0x70, 0x88,
+		0x79, 0x44, 0xb9, 0x4f, 0x55, 0x24, 0x66, 0x83, 0xd2, 0xf3, 0x80, 0x0c, 0xa5, 0x2c, 0xfc, 0xa1,
+		0x15, 0x1d, 0xd5, 0xbe, 0x0e, 0xb3, 0x69, 0x80, 0x29, 0x0b, 0xfd, 0x59, 0xf4, 0xec, 0x90, 0x45,
+		0xd5, 0x18, 0xb5, 0xf0, 0x4a, 0xdf, 0x81, 0x85, 0xb2, 0xdb, 0x76, 0x68, 0x28, 0x8f, 0x47, 0xd0,
+		0x55, 0x28, 0x1e, 0xba, 0x5e, 0x1d, 0x55, 0x0f, 0x11, 0xa9, 0x1f, 0x33, 0xb6, 0xe3, 0x06, 0xb0,
+		0xa1, 0x3d, 0x3a, 0xa2, 0x77, 0x61, 0x31, 0x89, 0x2b, 0x56, 0xfb, 0x01, 0x8c, 0xf9, 0x09, 0x1f,
+		0xdf, 0x3c, 0x5f, 0x96, 0xc8, 0x26, 0x32, 0xbb, 0xdd, 0xc7, 0x2f, 0x18, 0x2d, 0x6e, 0x12, 0x1f,
+		0x37, 0x14, 0x69, 0x0a, 0xbc, 0x66, 0xc0, 0xbf, 0xf4, 0x7f, 0x29, 0xc0, 0xbc, 0x81, 0x4c, 0x2b,
+		0x45, 0xec, 0x6d, 0x18, 0x66, 0x97, 0x59, 0x85, 0xf9, 0xfe, 0x8a, 0x6c, 0x85, 0x1e, 0xbf, 0x60,
+		0x4e, 0xcf, 0x60, 0x23, 0x77, 0xca, 0x42, 0xf4, 0x0e, 0x4d, 0x37, 0xa7, 0xdb, 0xa6, 0x66, 0x10,
+		0xa1, 0x58, 0x44, 0xe6, 0x49, 0x3e, 0x2a, 0x56, 0x58, 0x3d, 0x80, 0x45, 0xdb, 0xa1, 0x10, 0x76,
+		0x07, 0x55, 0xe9, 0xe5, 0x20, 0x74, 0x2a, 0x0c, 0xf7, 0x3f, 0x15, 0xe6, 0x7a, 0xc8, 0x0f, 0x9c,
+		0xd0, 0xa1, 0xf0, 0x2e, 0x2e, 0x08, 0xea, 0x2d, 0x18, 0x3d, 0xb4, 0x1b, 0x54, 0xf2, 0x31, 0x26,
+		0xc8, 0x9a, 0xdc, 0x24, 0x7b, 0x0c, 0xce, 0x10, 0xf0, 0xfa, 0x5f, 0x14, 0x60, 0x21, 0x61, 0x65,
+		0xb1, 0xc0, 0x27, 0x31, 0x73, 0x6a, 0x42, 0x50, 0xf8, 0x62, 0x09, 0x01, 0xbd, 0xcf, 0x27, 0x88,
+		0x86, 0xf7, 0xe8, 0x20, 0x19, 0xce, 0x6c, 0x9c, 0x3a, 0x1d, 0x4d, 0x33, 0xf4, 0x70, 0xda, 0x4d,
+		0xec, 0xdf, 0x14, 0x58, 0x78, 0xde, 0xf6, 0x8e, 0xd0, 0x4f, 0xb7, 0x57, 0xea, 0x1a, 0x2c, 0x26,
+		0xd5, 0x14, 0x67, 0xc3, 0xbf, 0x16, 0x60, 0xe1, 0x09, 0xfa, 0xa9, 0xb7, 0xc1, 0x69, 0xef, 0xcc,
+		0xfb, 0xb0, 0xf8, 0x04, 0xa5, 0x2f, 0x41, 0xde, 0x3b, 0xbe, 0xfe, 0xdb, 0x0a, 0x2c, 0x1b, 0xe8,
+		0xd0, 0x43, 0xf8, 0xd8, 0x4f, 0xc3, 0x98, 0xcf, 0x9f, 0x4e, 0x96, 0xa7, 0xaf, 0xc0, 0xf9, 0x74,
+		0x69, 0x84, 0x67, 0xfd, 0xad, 0x02, 0xb3, 0xcf, 0xcd, 0x36, 0x46, 0x3b, 0x75, 0x62, 0x77, 0x6c,
+		0xd2, 0x3d, 0xa5, 0x6c, 0x74, 0x15, 0x8a, 0xa6, 0x90, 0xc0, 0xaf, 0x65, 0x4f, 0x18, 0xe0, 0x0f,
+		0x55, 0x2c, 0x55, 0x83, 0x71, 0xdb, 0x42, 0x0e, 0xb1, 0x49, 0x57, 0x14, 0xf8, 0x7b, 0xdf, 0x34,
+		0x27, 0x8d, 0xe9, 0x20, 0xb4, 0xfb, 0x7b, 0x05, 0xe6, 0x5f, 0x3a, 0xad, 0x9f, 0x74, 0xfd, 0x96,
+		0x60, 0x21, 0xa1, 0x45, 0x68, 0xfd, 0x58, 0x5d, 0xfb, 0x27, 0x7c, 0xfd, 0x62, 0x3a, 0x08, 0xed,
+		0xfe, 0x69, 0x18, 0xce, 0xbf, 0x6c, 0x59, 0x26, 0xe9, 0x29, 0xfe, 0xac, 0x45, 0x58, 0x9a, 0xfa,
+		0x9e, 0x6a, 0x79, 0x00, 0x4b, 0xb8, 0x7e, 0x8c, 0xac, 0x76, 0x83, 0x06, 0x89, 0x6a, 0xbd, 0xe1,
+		0x62, 0xc4, 0xaa, 0xc8, 0x6e, 0xdb, 0xaf, 0x69, 0x2e, 0x25, 0xe2, 0xe2, 0xae, 0x68, 0x4e, 0x30,
+		0xe6, 0x7d, 0xdc, 0x03, 0x97, 0xbd, 0x2c, 0x1d, 0x70, 0xc4, 0x38, 0xd5, 0xa0, 0xa8, 0x4d, 0xa9,
+		0x8e, 0x0c, 0x40, 0x75, 0xdf, 0x2f, 0x70, 0x53, 0xaa, 0x4f, 0x61, 0x5e, 0x50, 0x8a, 0x0b, 0x3a,
+		0xda, 0x8f, 0xe4, 0x39, 0x5e, 0x29, 0x8f, 0x4a, 0xb9, 0x07, 0x33, 0xc7, 0xc8, 0xf4, 0x48, 0x0d,
+		0x99, 0x81, 0x74, 0x63, 0xfd, 0x48, 0x4d, 0xf7, 0x70, 0x7c, 0x3a, 0x65, 0x38, 0xe3, 0x21, 0xe2,
+		0x75, 0xab, 0x2d, 0xb7, 0x61, 0xd7, 0xbb, 0x8b, 0xe3, 0xa9, 0x51, 0x9c, 0xaf, 0x9a, 0x41, 0x01,
+		0x9f, 0x33, 0x38, 0xa3, 0xe8, 0x05, 0x1f, 0x11, 0x77, 0x9b, 0x88, 0xb9, 0xdb, 0x2a, 0x5c, 0x90,
+		0x38, 0x95, 0x70, 0xbb, 0x7f, 0x2c, 0xc0, 0x05, 0x03, 0x61, 0xe4, 0x58, 0xb1, 0x74, 0x06, 0x87,
+		0x9e, 0x93, 0xc5, 0x43, 0xa6, 0xa8, 0x7b, 0x4c, 0x18, 0xe3, 0x7c, 0xa0, 0x62, 0xfd, 0xa8, 0x9c,
+		0xef, 0x22, 0x4c, 0x79, 0xa8, 0xe9, 0x92, 0xc4, 0xc9, 0xcc, 0x47, 0xfd, 0x93, 0x39, 0x56, 0x92,
+		0x1f, 0x7e, 0x67, 0x25, 0xf9, 0x91, 0x13, 0x97, 0xe4, 0xf5, 0x35, 0x58, 0x91, 0xd9, 0x53, 0x98,
+		0xfc, 0x4f, 0x0a, 0xb0, 0x52, 0x69, 0xd2, 0x8b, 0xe7, 0xfb, 0xd2, 0x70, 0xf2, 0x4b, 0x30, 0x17,
+		0x2b, 0xb2, 0x57, 0x6d, 0x82, 0x9a, 0x58, 0x24, 0xc0, 0x03, 0xd8, 0xe1, 0x5c, 0x27, 0x31, 0x86,
+		0x43, 0xe5, 0x97, 0xe1, 0x41, 0xca, 0x2f, 0xeb, 0xb0, 0x2a, 0x35, 0x93, 0x30, 0xa5, 0x09, 0xcb,
+		0x0f, 0x11, 0x29, 0x7b, 0x2e, 0xc6, 0xc2, 0x27, 0xe2, 0xae, 0x1b, 0x3c, 0xd0, 0x2b, 0xb1, 0x07,
+		0xfa, 0x8b, 0x30, 0x45, 0x4c, 0xef, 0x08, 0x91, 0x9e, 0x8f, 0x89, 0xa2, 0x09, 0x1f, 0x15, 0xf4,
+		0xf4, 0xff, 0x1e, 0x82, 0xf3, 0xe9, 0x3c, 0x44, 0xb6, 0xf4, 0x1a, 0xa6, 0xf8, 0x95, 0xa1, 0xd6,
+		0xe5, 0xed, 0x02, 0x7d, 0x8a, 0x3d, 0x59, 0xc4, 0xd8, 0x13, 0x29, 0xbe, 0xdf, 0x65, 0x75, 0x5f,
+		0x7e, 0x93, 0x3d, 0x43, 0x42, 0x43, 0xea, 0xaf, 0xc2, 0xdc, 0xa1, 0x69, 0xd3, 0x87, 0xc7, 0xba,
+		0xd9, 0xc6, 0x28, 0xe0, 0x59, 0xc8, 0x2c, 0x47, 0x67, 0xf2, 0xdc, 0x63, 0x04, 0xcb, 0x94, 0x5e,
+		0x84, 0xb3, 0x7a, 0x98, 0x98, 0xd0, 0x5a, 0x30, 0x93, 0x10, 0x31, 0xa5, 0x24, 0xfd, 0x20, 0x5a,
+		0x56, 0xd8, 0x92, 0x88, 0x15, 0x97, 0x49, 0xac, 0x5b, 0xb8, 0x2e, 0xad, 0xb5, 0x60, 0x41, 0x22,
+		0x60, 0x0a, 0xdf, 0x7b, 0xd1, 0xba, 0xd5, 0xa6, 0xdc, 0x1c, 0x94, 0x5d, 0x88, 0x6e, 0xb8, 0xa2,
+		0xf1, 0x9f, 0x0a, 0x6c, 0x70, 0xe3, 0x58, 0x09, 0xa3, 0x95, 0xdd, 0x66, 0xab, 0x81, 0x08, 0xca,
+		0xd1, 0x34, 0x91, 0xd3, 0xc1, 0xd4, 0x57, 0xdc, 0x7f, 0xaa, 0x9e, 0x58, 0x0f, 0x7f, 0xd7, 0xe5,
+		0x37, 0x1a, 0xc7, 0xa3, 0x74, 0x83, 0x2f, 0x4c, 0xb3, 0x78, 0x56, 0x77, 0xa9, 0x3a, 0xe8, 0x8d,
+		0xb8, 0x29, 0x0f, 0xb3, 0x0a, 0xcc, 0x24, 0x1b, 0x7e, 0x8a, 0x78, 0x7a, 0xac, 0x7b, 0xb0, 0x99,
+		0x43, 0xdb, 0x5e, 0x55, 0x66, 0xc4, 0xaf, 0xc2, 0x9f, 0x6c, 0x61, 0x19, 0xb6, 0xfe, 0x6b, 0x0a,
+		0x2c, 0xd0, 0x4a, 0x74, 0xd7, 0x31, 0x9b, 0x76, 0xbd, 0xec, 0x3a, 0x87, 0xf6, 0x51, 0xa8, 0x6a,
+		0x54, 0x67, 0x03, 0xbc, 0x8c, 0xcd, 0x03, 0x20, 0xf0, 0x21, 0xd6, 0x71, 0xb0, 0x0b, 0x63, 0xfc,
+		0x12, 0xe3, 0x5f, 0xfd, 0x65, 0x17, 0xf4, 0x08, 0x79, 0x71, 0xff, 0xf1, 0x51, 0xf5, 0x67, 0xb0,
+		0x98, 0x94, 0x40, 0x68, 0x79, 0xcd, 0x77, 0x23, 0x25, 0x4f, 0xbc, 0xe2, 0xb0, 0xfa, 0xef, 0x28,
+		0xa0, 0xf1, 0xb3, 0xf6, 0x64, 0x6a, 0x3d, 0x85, 0x49, 0x01, 0xc0, 0xe8, 0xf9, 0xca, 0x6d, 0xe6,
+		0x51, 0x8e, 0xdf, 0x34, 0xcf, 0xd4, 0x83, 0x0f, 0xac, 0x5f, 0x80, 0xe5, 0x54, 0x71, 0x44, 0xe8,
+		0xfc, 0x0e, 0xbb, 0xbc, 0xd1, 0x28, 0x8d, 0x4e, 0x73, 0x19, 0xd8, 0xa5, 0x2d, 0x4d, 0x0a, 0x21,
+		0xe6, 0xf7, 0x14, 0xda, 0x21, 0x42, 0x9d, 0xd0, 0x3f, 0x05, 0x4e, 0xe9, 0x76, 0xf9, 0x43, 0x05,
+		0xe6, 0xe3, 0x82, 0x08, 0x77, 0xf9, 0x24, 0x78, 0xeb, 0xb6, 0x18, 0x84, 0x25, 0x6a, 0x9d, 0xfe,
+		0x63, 0x36, 0xc7, 0xb3, 0xd4, 0xcb, 0xa0, 0xf6, 0x24, 0xc2, 0x3d, 0xd8, 0x02, 0x83, 0x9d, 0x09,
+		0x66, 0x42, 0xe0, 0xa1, 0xbe, 0x26, 0x1f, 0x9c, 0x77, 0x81, 0xce, 0x04, 0x33, 0x02, 0x9c, 0x36,
+		0x22, 0xad, 0x3c, 0x31, 0x6d, 0x87, 0xd0, 0xc6, 0x31, 0xd7, 0xf3, 0xda, 0x2d, 0x72, 0xca, 0x36,
+		0xfb, 0x33, 0x05, 0x56, 0xa5, 0x12, 0xbd, 0x5f, 0xc6, 0xbb, 0x0b, 0x8b, 0xb4, 0xf1, 0xe7, 0x44,
+		0x5b, 0x41, 0xff, 0x1a, 0x2c, 0xa5, 0x20, 0x0b, 0x05, 0xcb, 0x30, 0x86, 0x1c, 0xe2, 0xd9, 0xbd,
+		0x37, 0xe3, 0x5c, 0x3b, 0x5a, 0x94, 0xb1, 0x05, 0xa6, 0xfe, 0x1a, 0xd4, 0xe4, 0xb4, 0xaa, 0xc2,
+		0x70, 0x48, 0x22, 0xf6, 0xb7, 0xba, 0x03, 0xa3, 0x22, 0x7e, 0x0c, 0x0d, 0x1a, 0x3f, 0x04, 0x22,
+		0x75, 0x24, 0x35, 0x39, 0x7d, 0xa2, 0xa8, 0xf8, 0x8e, 0xa2, 0xc4, 0x2f, 0xc3, 0xb9, 0x94, 0xf9,
+		0x54, 0xfd, 0xaf, 0x45, 0x53, 0x8f, 0x7c, 0xb1, 0x7b, 0x1d, 0x56, 0x1f, 0x22, 0xf2, 0xb0, 0xe1,
+		0xd6, 0xcc, 0x46, 0x05, 0xbb, 0x0d, 0x96, 0xb5, 0x3f, 0xf4, 0xdc, 0x76, 0xcb, 0xcf, 0x25, 0xf5,
+		0x6f, 0xc1, 0x9a, 0x1c, 0x44, 0x2c, 0xf5, 0x2f, 0xc0, 0xb4, 0xed, 0x4f, 0x55, 0x8f, 0xd8, 0x9c,
+		0x30, 0xd6, 0x95, 0xf4, 0x17, 0xb4, 0x08, 0x1d, 0xae, 0x9a, 0x7f, 0x59, 0x3c, 0x6b, 0x47, 0x99,
+		0xe8, 0xdf, 0x56, 0x40, 0xe7, 0x01, 0x3d, 0x4b, 0xce, 0x1f, 0xad, 0x0c, 0x17, 0xe1, 0xc3, 0x4c,
+		0x11, 0x44, 0xd0, 0xbe, 0x0d, 0xab, 0xbd, 0x77, 0x66, 0x89, 0x98, 0x92, 0x48, 0x24, 0xcc, 0x2c,
+		0x41, 0xfd, 0x71, 0x98, 0xf9, 0x0f, 0x7b, 0x66, 0x3e, 0x89, 0xfc, 0xa9, 0xb2, 0x15, 0xde, 0xb9,
+		0xf9, 0x33, 0xed, 0xa3, 0x97, 0x61, 0xb3, 0x67, 0xc3, 0x1d, 0xdc, 0x75, 0xea, 0x7e, 0xd0, 0x0d,
+		0xc8, 0xf7, 0xbd, 0x6a, 0xd2, 0x46, 0xa9, 0x2f, 0xe5, 0xa1, 0x22, 0xd6, 0xe4, 0xa5, 0x9f, 0xbd,
+		0x08, 0xe1, 0x25, 0x09, 0x22, 0x57, 0x5a, 0x42, 0x8e, 0xea, 0x1c, 0xa5, 0xa2, 0xff, 0x40, 0x81,
+		0x4b, 0x61, 0x95, 0x4f, 0xaa, 0x4e, 0x52, 0xbe, 0xc2, 0x3b, 0x91, 0x6f, 0x0b, 0x2e, 0xe7, 0x14,
+		0x8f, 0xdb, 0x69, 0xfb, 0x9f, 0x37, 0x61, 0x7c, 0x87, 0xc6, 0xbc, 0x9d, 0xe7, 0x15, 0xf5, 0x77,
+		0x15, 0x58, 0x92, 0xfe, 0x6c, 0x43, 0xbd, 0xd9, 0xe7, 0x6d, 0x58, 0x56, 0x3d, 0xd0, 0x6e, 0x0d,
+		0x8e, 0x28, 0x56, 0xf1, 0x57, 0xe0, 0x5c, 0x4a, 0x6b, 0xbc, 0x7a, 0xb5, 0x0f, 0xc1, 0xe4, 0x4f,
+		0x2a, 0xb4, 0xed, 0x41, 0x50, 0x04, 0xf7, 0xb0, 0x39, 0x12, 0x3f, 0x07, 0xe8, 0x6b, 0x0e, 0xd9,
+		0xef, 0x21, 0xb4, 0x5b, 0x83, 0x23, 0x0a, 0x81, 0x4c, 0x80, 0xa0, 0xed, 0x5d, 0xdd, 0x90, 0x5d,
+		0x76, 0xe2, 0x9d, 0xf4, 0xda, 0x66, 0x0e, 0xc8, 0x80, 0x45, 0xd0, 0x53, 0x2e, 0x65, 0x91, 0xe8,
+		0xb2, 0xd7, 0x36, 0x73, 0x40, 0x86, 0x59, 0xf8, 0xed, 0xe0, 0x19, 0x2c, 0x62, 0x2d, 0xec, 0xda,
+		0x66, 0x0e, 0x48, 0xc1, 0xe2, 0xeb, 0x30, 0x19, 0x69, 0xe3, 0x56, 0xbf, 0xdc, 0xc7, 0xe6, 0x11,
+		0x46, 0x97, 0xf2, 0x01, 0x0b, 0x5e, 0xbf, 0xa5, 0xf0, 0x54, 0x2d, 0xad, 0x47, 0x5b, 0xbd, 0x21,
+		0x21, 0xd5, 0xa7, 0x27, 0x5c, 0xbb, 0x39, 0x30, 0x9e, 0x90, 0xa6, 0x09, 0x53, 0xd1, 0x36, 0x68,
+		0xf5, 0x92, 0xbc, 0xe8, 0x90, 0x6c, 0xe8, 0xd6, 0x2e, 0xe7, 0x84, 0x16, 0xec, 0xfe, 0x58, 0x61,
+		0xe7, 0x63, 0x66, 0xaf, 0xae, 0xfa, 0x33, 0x72, 0x9a, 0x79, 0x1a, 0xab, 0xb5, 0x7b, 0x27, 0xc6,
+		0x17, 0x52, 0x7e, 0x57, 0x61, 0xcd, 0xe1, 0x29, 0xcd, 0x61, 0xea, 0xa7, 0x03, 0xb6, 0xaf, 0x72,
+		0x89, 0xae, 0x9f, 0xa8, 0xe9, 0x95, 0x05, 0x14, 0x69, 0x97, 0xa1, 0x34, 0xa0, 0xf4, 0x6b, 0x83,
+		0xd4, 0x6e, 0x0d, 0x8e, 0x28, 0x04, 0xfa, 0x03, 0x05, 0xce, 0xf7, 0x56, 0x36, 0x4d, 0xa6, 0x3b,
+		0xfd, 0xdc, 0x21, 0x43, 0xac, 0xbb, 0x27, 0xc2, 0x0d, 0x76, 0x70, 0xa4, 0xd7, 0x4d, 0xba, 0x83,
+		0xd3, 0xfa, 0xf9, 0xb4, 0x4b, 0xf9, 0x80, 0x05, 0xaf, 0x2e, 0xa8, 0xc9, 0xe6, 0x30, 0xf5, 0xca,
+		0xa0, 0xcd, 0x71, 0xda, 0xd5, 0x01, 0x30, 0x04, 0xeb, 0x16, 0x9c, 0x8d, 0xb5, 0x56, 0xa9, 0x97,
+		0xf3, 0xb6, 0x60, 0x71, 0xa6, 0xa5, 0xc1, 0x3a, 0xb6, 0x54, 0x0c, 0xd3, 0xf1, 0x1e, 0x27, 0x55,
+		0x46, 0x43, 0xd2, 0x48, 0xa5, 0x6d, 0xe5, 0x86, 0x0f, 0xd4, 0x8c, 0xb5, 0xdd, 0x48, 0xd5, 0x4c,
+		0x6f, 0x82, 0xd2, 0x4a, 0x79, 0xc1, 0x03, 0x35, 0xe3, 0x2d, 0x1d, 0x52, 0x35, 0x25, 0x2d, 0x2e,
+		0xda, 0x56, 0x6e, 0xf8, 0x80, 0xe9, 0x13, 0x94, 0x93, 0xe9, 0x13, 0x34, 0x18, 0x53, 0x69, 0x77,
+		0xc4, 0xb7, 0x60, 0x36, 0xad, 0xcd, 0x40, 0xdd, 0x96, 0x5a, 0x4c, 0xda, 0x21, 0xa1, 0x5d, 0x1b,
+		0x08, 0x27, 0xd8, 0xaa, 0x91, 0x16, 0x00, 0xe9, 0x56, 0x4d, 0x6b, 0x76, 0xd0, 0x2e, 0xe5, 0x03,
+		0x0e, 0x1c, 0x29, 0xf6, 0x1c, 0x2f, 0x75, 0xa4, 0xf4, 0xe6, 0x03, 0xad, 0x94, 0x17, 0x3c, 0x1c,
+		0x88, 0x42, 0x0f, 0xe4, 0x19, 0x81, 0x28, 0xd9, 0x0a, 0xa0, 0x5d, 0xca, 0x07, 0x2c, 0x78, 0xfd,
+		0xba, 0x02, 0x73, 0xa9, 0xcf, 0xa3, 0xaa, 0x6c, 0x61, 0xb2, 0x5e, 0xe8, 0xb5, 0x4f, 0x07, 0x43,
+		0x0a, 0x1d, 0x96, 0xe9, 0x2f, 0x86, 0xd2, 0xc3, 0x32, 0xf3, 0xc1, 0x56, 0xbb, 0x3e, 0x20, 0x96,
+		0x90, 0xe3, 0x37, 0x15, 0x58, 0x90, 0xbc, 0xb7, 0xa9, 0x32, 0x92, 0xd9, 0xcf, 0x98, 0xda, 0x8d,
+		0x41, 0xd1, 0x82, 0x2d, 0x96, 0xf6, 0x62, 0x25, 0xdd, 0x62, 0x19, 0x6f, 0x80, 0xda, 0xb5, 0x81,
+		0x70, 0x84, 0x00, 0x7f, 0xaa, 0xc0, 0x7a, 0xdf, 0x47, 0x11, 0xf5, 0x9e, 0xdc, 0xd0, 0xb9, 0x1e,
+		0x8f, 0xb4, 0xcf, 0x4f, 0x4e, 0x20, 0x88, 0x80, 0xf1, 0x57, 0x0c, 0x69, 0x04, 0x94, 0x3c, 0xb8,
+		0x68, 0x5b, 0xb9, 0xe1, 0x83, 0x5b, 0x62, 0xca, 0xcb, 0x82, 0xf4, 0x96, 0x28, 0x7f, 0x14, 0xd1,
+		0xb6, 0x07, 0x41, 0x09, 0xc7, 0xdf, 0xe4, 0x8b, 0x41, 0x46, 0xfc, 0x95, 0x3e, 0x72, 0x68, 0xd7,
+		0x06, 0xc2, 0x11, 0x02, 0x74, 0x60, 0x26, 0x51, 0xed, 0x55, 0xb7, 0x32, 0x2e, 0x10, 0xa9, 0xac,
+		0xaf, 0xe4, 0x47, 0x08, 0xae, 0x1a, 0xd1, 0x07, 0x08, 0x55, 0x7e, 0x71, 0x4a, 0x79, 0x30, 0xd1,
+		0x2e, 0xe7, 0x84, 0x0e, 0xc5, 0x03, 0x49, 0xf1, 0x5e, 0x1a, 0x0f, 0xb2, 0x9f, 0x1f, 0xb4, 0x1b,
+		0x83, 0xa2, 0x85, 0xae, 0x7c, 0xb2, 0xe2, 0xab, 0xf4, 0xca, 0xd7, 0xa7, 0xa0, 0xab, 0xdd, 0x1c,
+		0x18, 0x4f, 0x48, 0xf3, 0xfb, 0x8a, 0xff, 0xb2, 0x96, 0x2e, 0xd0, 0xed, 0x4c, 0xa7, 0xce, 0x94,
+		0xe9, 0xce, 0x49, 0x50, 0xa3, 0x46, 0x4a, 0x2d, 0x0d, 0x66, 0x19, 0x29, 0xab, 0xcc, 0xa9, 0xdd,
+		0x1c, 0x18, 0x2f, 0x61, 0xa4, 0x74, 0x81, 0xb2, 0x8d, 0x94, 0x29, 0xd3, 0x9d, 0x93, 0xa0, 0x0a,
+		0xb1, 0xfe, 0x5c, 0x01, 0xbd, 0x7f, 0x55, 0x53, 0xfd, 0xbc, 0x9f, 0xda, 0xfd, 0xea, 0x90, 0xda,
+		0xce, 0x17, 0xa0, 0x20, 0x64, 0xfd, 0x6b, 0x05, 0x2e, 0xe6, 0x2a, 0x2e, 0xaa, 0xe5, 0x1c, 0x16,
+		0xe9, 0x2b, 0xf1, 0xee, 0x17, 0x23, 0x22, 0xea, 0x9b, 0x7f, 0xa5, 0xd0, 0x9f, 0x46, 0x35, 0x23,
+		0x97, 0x4d, 0xfe, 0x63, 0x48, 0x5a, 0xf0, 0xfc, 0xbe, 0x02, 0x4b, 0xd2, 0x9f, 0x46, 0x4a, 0x2f,
+		0xe4, 0xfd, 0x7e, 0x27, 0xaa, 0xdd, 0x1a, 0x1c, 0x91, 0x8b, 0xbb, 0xa1, 0x5c, 0x51, 0xee, 0xdf,
+		0xfc, 0xf9, 0xeb, 0x47, 0x36, 0x39, 0x6e, 0xd7, 0x4a, 0x75, 0xb7, 0xb9, 0x15, 0xfe, 0xc7, 0x41,
+		0x97, 0x6d, 0xab, 0xb1, 0x75, 0xe4, 0xf2, 0x7f, 0x8d, 0xd4, 0xfb, 0x2f, 0x42, 0x77, 0xd9, 0x1f,
+		0x9d, 0xab, 0xb5, 0x51, 0x36, 0x7e, 0xed, 0xff, 0x07, 0x00, 0x79, 0x94, 0x4b, 0xf5, 0xbf, 0x49,
+		0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/wrappers.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0x2f, 0x4a, 0x2c,
+		0x28, 0x48, 0x2d, 0x2a, 0xd6, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0xca,
+		0x5c, 0xdc, 0x2e, 0xf9, 0xa5, 0x49, 0x39, 0xa9, 0x61, 0x89, 0x39, 0xa5, 0xa9, 0x42, 0x22, 0x5c,
+		0xac, 0x65, 0x20, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x63, 0x10, 0x84, 0xa3, 0xa4, 0xc4, 0xc5,
+		0xe5, 0x96, 0x93, 0x9f, 0x58, 0x82, 0x45, 0x0d, 0x13, 0x92, 0x1a, 0xcf, 0xbc, 0x12, 0x33, 0x13,
+		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
+		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
+		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x33,
+		0x23, 0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xf0, 0x3a, 0xf1, 0x86, 0x43, 0xc3, 0x3f, 0x00,
+		0x24, 0x12, 0xc0, 0x18, 0x65, 0x08, 0x55, 0x91, 0x9e, 0x9f, 0x93, 0x98, 0x97, 0xae, 0x97, 0x5f,
+		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
+		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
+		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/common.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdf, 0x72, 0xdb, 0xc4,
+		0x17, 0xfe, 0x29, 0x8e, 0x9d, 0xe4, 0xd8, 0x4d, 0xfc, 0xdb, 0x90, 0xc4, 0x49, 0x09, 0xa4, 0x9a,
+		0x61, 0x1a, 0x3a, 0x20, 0x4f, 0xdc, 0x9b, 0x0e, 0x9d, 0x02, 0x4e, 0xec, 0x24, 0x6a, 0x83, 0x6d,
+		0x64, 0xd3, 0x4c, 0x61, 0x06, 0xcd, 0x5a, 0x5a, 0xb9, 0x8b, 0xa5, 0x5d, 0xb1, 0x5a, 0x39, 0xf1,
+		0x0d, 0xc3, 0x93, 0x70, 0xc1, 0xeb, 0x70, 0xc9, 0x0b, 0x31, 0xab, 0x3f, 0xb1, 0x5d, 0x9c, 0x29,
+		0x17, 0x0c, 0x77, 0xbb, 0xe7, 0xfb, 0xce, 0x39, 0xdf, 0xae, 0xce, 0x39, 0x5a, 0x38, 0x8a, 0x87,
+		0x44, 0xd4, 0x1d, 0xec, 0x12, 0xe6, 0x90, 0x3a, 0x0e, 0x69, 0x7d, 0x72, 0x52, 0x77, 0x78, 0x10,
+		0x70, 0x66, 0x84, 0x82, 0x4b, 0x8e, 0xb6, 0x15, 0xc3, 0xc8, 0x18, 0x06, 0x0e, 0xa9, 0x31, 0x39,
+		0x39, 0xf8, 0x68, 0xc4, 0xf9, 0xc8, 0x27, 0xf5, 0x84, 0x32, 0x8c, 0xbd, 0xba, 0x1b, 0x0b, 0x2c,
+		0x69, 0xee, 0xa4, 0xbf, 0x82, 0xff, 0x5f, 0x73, 0x31, 0xf6, 0x7c, 0x7e, 0xd3, 0xbe, 0x25, 0x4e,
+		0xac, 0x20, 0xf4, 0x31, 0x94, 0x6f, 0x32, 0xa3, 0x4d, 0xdd, 0x9a, 0x76, 0xa4, 0x1d, 0x6f, 0x58,
+		0x90, 0x9b, 0x4c, 0x17, 0xed, 0x40, 0x49, 0xc4, 0x4c, 0x61, 0x2b, 0x09, 0x56, 0x14, 0x31, 0x33,
+		0x5d, 0x5d, 0x87, 0x4a, 0x1e, 0x6c, 0x30, 0x0d, 0x09, 0x42, 0xb0, 0xca, 0x70, 0x40, 0xb2, 0x00,
+		0xc9, 0x5a, 0x71, 0x9a, 0x8e, 0xa4, 0x13, 0x2a, 0xa7, 0xf7, 0x72, 0x0e, 0x61, 0xad, 0x87, 0xa7,
+		0x3e, 0xc7, 0xae, 0x82, 0x5d, 0x2c, 0x71, 0x02, 0x57, 0xac, 0x64, 0xad, 0x3f, 0x87, 0xb5, 0x73,
+		0x4c, 0xfd, 0x58, 0x10, 0xb4, 0x0b, 0x25, 0x41, 0x70, 0xc4, 0x59, 0xe6, 0x9f, 0xed, 0x50, 0x0d,
+		0xd6, 0x5c, 0x22, 0x31, 0xf5, 0xa3, 0x44, 0x61, 0xc5, 0xca, 0xb7, 0xfa, 0x6f, 0x1a, 0xac, 0x7e,
+		0x43, 0x02, 0x8e, 0x5e, 0x40, 0xc9, 0xa3, 0xc4, 0x77, 0xa3, 0x9a, 0x76, 0x54, 0x38, 0x2e, 0x37,
+		0x3e, 0x31, 0x96, 0xdc, 0x9f, 0xa1, 0xa8, 0xc6, 0x79, 0xc2, 0x6b, 0x33, 0x29, 0xa6, 0x56, 0xe6,
+		0x74, 0x70, 0x0d, 0xe5, 0x39, 0x33, 0xaa, 0x42, 0x61, 0x4c, 0xa6, 0x99, 0x0a, 0xb5, 0x44, 0x0d,
+		0x28, 0x4e, 0xb0, 0x1f, 0x93, 0x44, 0x40, 0xb9, 0xf1, 0xe1, 0xd2, 0xf0, 0xd9, 0x31, 0xad, 0x94,
+		0xfa, 0xc5, 0xca, 0x33, 0x4d, 0xff, 0x5d, 0x83, 0xd2, 0x25, 0xc1, 0x2e, 0x11, 0xe8, 0xab, 0x77,
+		0x24, 0x3e, 0x5e, 0x1a, 0x23, 0x25, 0xff, 0xb7, 0x22, 0xff, 0xd4, 0xa0, 0xda, 0x27, 0x58, 0x38,
+		0x6f, 0x9b, 0x52, 0x0a, 0x3a, 0x8c, 0x25, 0x89, 0x90, 0x0d, 0x9b, 0x94, 0xb9, 0xe4, 0x96, 0xb8,
+		0xf6, 0x82, 0xec, 0x67, 0x4b, 0xa3, 0xbe, 0xeb, 0x6e, 0x98, 0xa9, 0xef, 0xfc, 0x39, 0x1e, 0xd0,
+		0x79, 0xdb, 0xc1, 0x8f, 0x80, 0xfe, 0x4e, 0xfa, 0x17, 0x4f, 0xe5, 0xc1, 0x7a, 0x0b, 0x4b, 0x7c,
+		0xea, 0xf3, 0x21, 0x3a, 0x87, 0x07, 0x84, 0x39, 0xdc, 0xa5, 0x6c, 0x64, 0xcb, 0x69, 0x98, 0x16,
+		0xe8, 0x66, 0xe3, 0xd1, 0xd2, 0x58, 0xed, 0x8c, 0xa9, 0x2a, 0xda, 0xaa, 0x90, 0xb9, 0xdd, 0x5d,
+		0x01, 0xaf, 0xcc, 0x15, 0x70, 0x2f, 0x6d, 0x3a, 0x22, 0x5e, 0x13, 0x11, 0x51, 0xce, 0x4c, 0xe6,
+		0x71, 0x45, 0xa4, 0x41, 0xe8, 0xe7, 0x8d, 0xa0, 0xd6, 0xe8, 0x31, 0x6c, 0x79, 0x04, 0xcb, 0x58,
+		0x10, 0x7b, 0x92, 0x52, 0xb3, 0x86, 0xdb, 0xcc, 0xcc, 0x59, 0x00, 0xfd, 0x15, 0xec, 0xf5, 0xe3,
+		0x30, 0xe4, 0x42, 0x12, 0xf7, 0xcc, 0xa7, 0x84, 0xc9, 0x0c, 0x89, 0x54, 0xaf, 0x8e, 0xb8, 0x1d,
+		0xb9, 0xe3, 0x2c, 0x72, 0x71, 0xc4, 0xfb, 0xee, 0x18, 0xed, 0xc3, 0xfa, 0x4f, 0x78, 0x82, 0x13,
+		0x20, 0x8d, 0xb9, 0xa6, 0xf6, 0x7d, 0x77, 0xac, 0xff, 0x5a, 0x80, 0xb2, 0x45, 0xa4, 0x98, 0xf6,
+		0xb8, 0x4f, 0x9d, 0x29, 0x6a, 0x41, 0x95, 0x32, 0x2a, 0x29, 0xf6, 0x6d, 0xca, 0x24, 0x11, 0x13,
+		0x9c, 0xaa, 0x2c, 0x37, 0xf6, 0x8d, 0x74, 0xbc, 0x18, 0xf9, 0x78, 0x31, 0x5a, 0xd9, 0x78, 0xb1,
+		0xb6, 0x32, 0x17, 0x33, 0xf3, 0x40, 0x75, 0xd8, 0x1e, 0x62, 0x67, 0xcc, 0x3d, 0xcf, 0x76, 0x38,
+		0xf1, 0x3c, 0xea, 0x28, 0x99, 0x49, 0x6e, 0xcd, 0x42, 0x19, 0x74, 0x36, 0x43, 0x54, 0xda, 0x00,
+		0xdf, 0xd2, 0x20, 0x0e, 0x66, 0x69, 0x0b, 0xef, 0x4d, 0x9b, 0xb9, 0xdc, 0xa5, 0xfd, 0x74, 0x16,
+		0x05, 0x4b, 0x49, 0x82, 0x50, 0x46, 0xb5, 0xd5, 0x23, 0xed, 0xb8, 0x78, 0x47, 0x6d, 0x66, 0x66,
+		0xf4, 0x02, 0x1e, 0x32, 0xce, 0x6c, 0xa1, 0x8e, 0x8e, 0x87, 0x3e, 0xb1, 0x89, 0x10, 0x5c, 0xd8,
+		0xe9, 0x48, 0x89, 0x6a, 0xc5, 0xa3, 0xc2, 0xf1, 0x86, 0x55, 0x63, 0x9c, 0x59, 0x39, 0xa3, 0xad,
+		0x08, 0x56, 0x8a, 0xa3, 0x97, 0xb0, 0x4d, 0x6e, 0x43, 0x9a, 0x0a, 0x99, 0x49, 0x2e, 0xbd, 0x4f,
+		0x32, 0x9a, 0x79, 0xe5, 0xaa, 0xf5, 0x00, 0xf6, 0xcc, 0x88, 0xfb, 0x89, 0xf1, 0x42, 0xf0, 0x38,
+		0xec, 0x61, 0x21, 0xa9, 0xda, 0x2d, 0x1b, 0x98, 0xe8, 0x4b, 0x28, 0x46, 0x12, 0xcb, 0xb4, 0xe0,
+		0x37, 0x1b, 0xc7, 0x4b, 0x8b, 0x74, 0x31, 0x60, 0x5f, 0xf1, 0xad, 0xd4, 0x4d, 0x9f, 0xc0, 0xc3,
+		0x45, 0xf4, 0x8c, 0x33, 0x8f, 0x8e, 0x32, 0x85, 0xe8, 0x1a, 0xaa, 0x34, 0x87, 0xed, 0x91, 0xc2,
+		0xf3, 0xd6, 0xfe, 0xec, 0x1f, 0x64, 0xba, 0x93, 0x6e, 0x6d, 0xd1, 0x05, 0x20, 0xd2, 0xff, 0xd0,
+		0xe0, 0xa0, 0x19, 0x4d, 0x99, 0x93, 0xff, 0x36, 0x16, 0xf3, 0xd6, 0x60, 0x8d, 0x30, 0x75, 0xcf,
+		0xe9, 0x3f, 0x68, 0xdd, 0xca, 0xb7, 0xa8, 0x01, 0x3b, 0xa1, 0x20, 0x2e, 0xf1, 0x28, 0x23, 0xae,
+		0xfd, 0x73, 0x4c, 0x62, 0x62, 0x27, 0xb7, 0x92, 0x96, 0xf2, 0xf6, 0x0c, 0xfc, 0x56, 0x61, 0x1d,
+		0x75, 0x49, 0x87, 0x00, 0x29, 0x31, 0x69, 0xe7, 0x42, 0x42, 0xdc, 0x48, 0x2c, 0x49, 0xa3, 0x7e,
+		0x0d, 0x95, 0x14, 0x76, 0x12, 0x0d, 0x49, 0x91, 0x94, 0x1b, 0x87, 0x4b, 0x0f, 0x98, 0x4f, 0x09,
+		0xab, 0x9c, 0xb8, 0xa4, 0xaa, 0x9f, 0xdc, 0x40, 0x65, 0x7e, 0x10, 0xa0, 0x7d, 0xd8, 0x69, 0x77,
+		0xce, 0xba, 0x2d, 0xb3, 0x73, 0x61, 0x0f, 0xde, 0xf4, 0xda, 0xb6, 0xd9, 0x79, 0xdd, 0xbc, 0x32,
+		0x5b, 0xd5, 0xff, 0xa1, 0x03, 0xd8, 0x5d, 0x84, 0x06, 0x97, 0x96, 0x79, 0x3e, 0xb0, 0xae, 0xab,
+		0x1a, 0xda, 0x05, 0xb4, 0x88, 0xbd, 0xec, 0x77, 0x3b, 0xd5, 0x15, 0x54, 0x83, 0x0f, 0x16, 0xed,
+		0x3d, 0xab, 0x3b, 0xe8, 0x3e, 0xad, 0x16, 0x9e, 0xfc, 0x02, 0xdb, 0x4b, 0x3e, 0x2e, 0x7a, 0x04,
+		0x87, 0x66, 0xbf, 0x7b, 0xd5, 0x1c, 0x98, 0xdd, 0x8e, 0x7d, 0x61, 0x75, 0xbf, 0xeb, 0xd9, 0xfd,
+		0x41, 0x73, 0x30, 0xaf, 0xe3, 0x5e, 0xca, 0x65, 0xbb, 0x79, 0x35, 0xb8, 0x7c, 0x53, 0xd5, 0xee,
+		0xa7, 0xb4, 0xac, 0xa6, 0xd9, 0x69, 0xb7, 0xaa, 0x2b, 0xa7, 0x3f, 0xc0, 0x9e, 0xc3, 0x83, 0x65,
+		0x37, 0x75, 0x5a, 0x3e, 0x4b, 0x9e, 0x28, 0x3d, 0x55, 0xf5, 0x3d, 0xed, 0xfb, 0x93, 0x11, 0x95,
+		0x6f, 0xe3, 0xa1, 0xe1, 0xf0, 0xa0, 0x3e, 0xff, 0xa0, 0xf9, 0x9c, 0xba, 0x7e, 0x7d, 0xc4, 0xd3,
+		0x67, 0x4a, 0xf6, 0xba, 0x79, 0x8e, 0x43, 0x3a, 0x39, 0x19, 0x96, 0x12, 0xdb, 0xd3, 0xbf, 0x06,
+		0x00, 0x57, 0xd9, 0xb2, 0xe0, 0x01, 0x09, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/visibility.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
+		0x14, 0x85, 0x71, 0x69, 0x23, 0x71, 0x53, 0xa8, 0x35, 0x08, 0x02, 0xae, 0x20, 0xc8, 0x62, 0x51,
+		0x21, 0x31, 0x96, 0xcb, 0xb2, 0x0b, 0x94, 0x60, 0x83, 0x46, 0x84, 0x24, 0x38, 0x6e, 0x4a, 0xd8,
+		0x58, 0x63, 0x7b, 0x1a, 0x46, 0x8c, 0x3d, 0x96, 0x3d, 0x4e, 0xdb, 0xa7, 0xe0, 0x3d, 0x79, 0x0a,
+		0xe4, 0xbf, 0x0a, 0x09, 0x57, 0xec, 0xec, 0x73, 0xcf, 0xf9, 0x34, 0xf7, 0x07, 0x5e, 0x97, 0x21,
+		0xcb, 0xad, 0x88, 0xc6, 0x2c, 0x8d, 0x98, 0x45, 0x33, 0x6e, 0xed, 0x6c, 0x6b, 0xc7, 0x0b, 0x1e,
+		0x72, 0xc1, 0xd5, 0x0d, 0xce, 0x72, 0xa9, 0x24, 0x7a, 0x5c, 0xb9, 0x70, 0xeb, 0xc2, 0x34, 0xe3,
+		0x78, 0x67, 0x1b, 0xe3, 0xad, 0x94, 0x5b, 0xc1, 0xac, 0xda, 0x12, 0x96, 0x97, 0x96, 0xe2, 0x09,
+		0x2b, 0x14, 0x4d, 0xb2, 0x26, 0x65, 0x98, 0x7d, 0xec, 0x2b, 0x99, 0xff, 0xbc, 0x14, 0xf2, 0xaa,
+		0xf1, 0x98, 0x5f, 0x61, 0x74, 0xd1, 0x2a, 0xee, 0x35, 0x8b, 0x4a, 0xc5, 0x65, 0xfa, 0x91, 0x0b,
+		0xc5, 0x72, 0x34, 0x86, 0x61, 0x67, 0x0e, 0x78, 0xfc, 0x4c, 0x7b, 0xa5, 0x9d, 0x3c, 0xf0, 0xa0,
+		0x93, 0x48, 0x8c, 0x9e, 0xc0, 0x20, 0x2f, 0xd3, 0xaa, 0xb6, 0x57, 0xd7, 0x0e, 0xf2, 0x32, 0x25,
+		0xb1, 0x79, 0x02, 0xa8, 0x43, 0xfa, 0x37, 0x19, 0x6b, 0x69, 0x08, 0xf6, 0x53, 0x9a, 0xb0, 0x16,
+		0x53, 0x7f, 0x9b, 0xbf, 0x34, 0x38, 0x5a, 0x29, 0x9a, 0x2b, 0x9f, 0x27, 0x9d, 0xef, 0x3d, 0x3c,
+		0x64, 0x34, 0x17, 0x9c, 0x15, 0x2a, 0x50, 0xbc, 0x0d, 0x0c, 0x4f, 0x0d, 0xdc, 0x74, 0x8b, 0xbb,
+		0x6e, 0xb1, 0xdf, 0x75, 0xeb, 0x1d, 0x76, 0x81, 0x4a, 0x42, 0x67, 0x30, 0x14, 0x54, 0xdd, 0xc6,
+		0xf7, 0xfe, 0x1b, 0x87, 0xc6, 0x5e, 0x09, 0xe6, 0x06, 0x0e, 0x57, 0x8a, 0xaa, 0xb2, 0x68, 0x5f,
+		0x43, 0x60, 0x50, 0xd4, 0xff, 0xf5, 0x33, 0x1e, 0x9d, 0xda, 0xb8, 0x67, 0x13, 0xf8, 0x9f, 0x09,
+		0x7e, 0x10, 0xb2, 0x60, 0x0d, 0xc8, 0x6b, 0x01, 0x6f, 0x7e, 0x6b, 0xa0, 0x93, 0x34, 0x66, 0xd7,
+		0x2c, 0x5e, 0x53, 0x51, 0xb2, 0x6a, 0x36, 0xe8, 0x25, 0x18, 0x64, 0xee, 0xb8, 0xdf, 0x5c, 0x27,
+		0x58, 0x4f, 0x66, 0xe7, 0x6e, 0xe0, 0x6f, 0x96, 0x6e, 0x40, 0xe6, 0xeb, 0xc9, 0x8c, 0x38, 0xfa,
+		0x3d, 0xf4, 0x02, 0x9e, 0xf7, 0xd4, 0x57, 0xbe, 0x47, 0xe6, 0x9f, 0x74, 0xed, 0x8e, 0xf8, 0x67,
+		0x77, 0x73, 0xb1, 0xf0, 0x1c, 0x7d, 0x0f, 0x19, 0xf0, 0xb4, 0x17, 0xef, 0xeb, 0xf7, 0xef, 0x40,
+		0x3b, 0x8b, 0xf3, 0xe9, 0xcc, 0xd5, 0xf7, 0xd1, 0x31, 0x8c, 0x7a, 0xca, 0xd3, 0xc5, 0x62, 0xa6,
+		0x1f, 0xa0, 0x31, 0x1c, 0xf7, 0x65, 0x27, 0xbe, 0xeb, 0x93, 0x2f, 0xae, 0x3e, 0x98, 0x06, 0x30,
+		0x8a, 0x64, 0xd2, 0x37, 0xac, 0xe9, 0xd1, 0xfa, 0xf6, 0xba, 0x97, 0xd5, 0x32, 0x96, 0xda, 0x77,
+		0x7b, 0xcb, 0xd5, 0x8f, 0x32, 0xc4, 0x91, 0x4c, 0xac, 0xbf, 0x6f, 0xf6, 0x2d, 0x8f, 0x85, 0xb5,
+		0x95, 0xcd, 0x85, 0xb7, 0x07, 0x7c, 0x46, 0x33, 0xbe, 0xb3, 0xc3, 0x41, 0xad, 0xbd, 0xfb, 0x33,
+		0x00, 0x46, 0x40, 0x75, 0x5d, 0x40, 0x03, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/workflow.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5d, 0x6f, 0xdb, 0xc8,
+		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0x7a, 0x6c, 0xc7, 0x8a, 0xb3, 0x9b, 0x38, 0xda,
+		0x4d, 0xe2, 0xe8, 0x5d, 0xcb, 0xeb, 0x64, 0x93, 0x6c, 0xe2, 0xa6, 0x29, 0x4d, 0xd1, 0x31, 0x13,
+		0x99, 0x52, 0x87, 0x54, 0x1c, 0x2f, 0xda, 0x12, 0xb4, 0x44, 0xdb, 0x6c, 0x24, 0x52, 0x20, 0x47,
+		0x49, 0x7c, 0x5f, 0xa0, 0xd7, 0xed, 0x55, 0xd1, 0xab, 0xfe, 0x80, 0xa2, 0x45, 0xd1, 0xeb, 0xa2,
+		0x77, 0xbd, 0x28, 0xd0, 0xdb, 0xfe, 0x87, 0xfe, 0x8b, 0x62, 0x86, 0x43, 0x89, 0xfa, 0xa4, 0xdc,
+		0x02, 0xdb, 0x3b, 0xf3, 0xcc, 0xf3, 0x3c, 0x3c, 0x73, 0xe6, 0x7c, 0x8c, 0x68, 0xc8, 0xb5, 0x4f,
+		0x6d, 0x7f, 0xa7, 0x66, 0xd5, 0x6d, 0xb7, 0x66, 0xef, 0x58, 0x2d, 0x67, 0xe7, 0xc3, 0xee, 0xce,
+		0x47, 0xcf, 0x7f, 0x7f, 0xd6, 0xf0, 0x3e, 0x16, 0x5a, 0xbe, 0x47, 0x3c, 0xb4, 0x42, 0x31, 0x05,
+		0x8e, 0x29, 0x58, 0x2d, 0xa7, 0xf0, 0x61, 0x77, 0xe3, 0xd6, 0xb9, 0xe7, 0x9d, 0x37, 0xec, 0x1d,
+		0x06, 0x39, 0x6d, 0x9f, 0xed, 0xd4, 0xdb, 0xbe, 0x45, 0x1c, 0xcf, 0x0d, 0x49, 0x1b, 0xb7, 0xfb,
+		0xd7, 0x89, 0xd3, 0xb4, 0x03, 0x62, 0x35, 0x5b, 0x1c, 0xb0, 0x39, 0xec, 0xcd, 0x35, 0xaf, 0xd9,
+		0xec, 0x48, 0x0c, 0xf5, 0x8d, 0x58, 0xc1, 0xfb, 0x86, 0x13, 0x90, 0x10, 0x93, 0xfb, 0xfb, 0x2c,
+		0xac, 0x1d, 0x73, 0x77, 0x95, 0x4f, 0x76, 0xad, 0x4d, 0x5d, 0x50, 0xdd, 0x33, 0x0f, 0x55, 0x01,
+		0x45, 0xfb, 0x30, 0xed, 0x68, 0x25, 0x2b, 0x6c, 0x0a, 0x5b, 0x99, 0x87, 0xf7, 0x0a, 0x43, 0xb6,
+		0x54, 0x18, 0xd0, 0xc1, 0xcb, 0x1f, 0xfb, 0x4d, 0xe8, 0x31, 0x4c, 0x91, 0xcb, 0x96, 0x9d, 0x4d,
+		0x31, 0xa1, 0x3b, 0x63, 0x85, 0x8c, 0xcb, 0x96, 0x8d, 0x19, 0x1c, 0x3d, 0x03, 0x08, 0x88, 0xe5,
+		0x13, 0x93, 0x86, 0x21, 0x9b, 0x66, 0xe4, 0x8d, 0x42, 0x18, 0xa3, 0x42, 0x14, 0xa3, 0x82, 0x11,
+		0xc5, 0x08, 0xcf, 0x31, 0x34, 0x7d, 0xa6, 0xd4, 0x5a, 0xc3, 0x0b, 0xec, 0x90, 0x3a, 0x95, 0x4c,
+		0x65, 0x68, 0x46, 0x35, 0x60, 0x3e, 0xa4, 0x06, 0xc4, 0x22, 0xed, 0x20, 0x3b, 0xbd, 0x29, 0x6c,
+		0x2d, 0x3e, 0xdc, 0x9d, 0x6c, 0xf7, 0x32, 0x65, 0xea, 0x8c, 0x88, 0x33, 0xb5, 0xee, 0x03, 0xba,
+		0x0b, 0x8b, 0x17, 0x4e, 0x40, 0x3c, 0xff, 0xd2, 0x6c, 0xd8, 0xee, 0x39, 0xb9, 0xc8, 0xce, 0x6c,
+		0x0a, 0x5b, 0x69, 0xbc, 0xc0, 0xad, 0x25, 0x66, 0x44, 0x3f, 0x81, 0xb5, 0x96, 0xe5, 0xdb, 0x2e,
+		0xe9, 0x86, 0xdf, 0x74, 0xdc, 0x33, 0x2f, 0x7b, 0x8d, 0x6d, 0x61, 0x6b, 0xa8, 0x17, 0x15, 0xc6,
+		0xe8, 0x39, 0x49, 0xbc, 0xd2, 0x1a, 0x34, 0x22, 0x09, 0x16, 0xbb, 0xb2, 0x2c, 0x32, 0xb3, 0x89,
+		0x91, 0x59, 0xe8, 0x30, 0x58, 0x74, 0xb6, 0x61, 0xaa, 0x69, 0x37, 0xbd, 0xec, 0x1c, 0x23, 0xde,
+		0x18, 0xea, 0xcf, 0x91, 0xdd, 0xf4, 0x30, 0x83, 0x21, 0x0c, 0xcb, 0x81, 0x6d, 0xf9, 0xb5, 0x0b,
+		0xd3, 0x22, 0xc4, 0x77, 0x4e, 0xdb, 0xc4, 0x0e, 0xb2, 0xc0, 0xb8, 0x77, 0x87, 0x72, 0x75, 0x86,
+		0x96, 0x3a, 0x60, 0x2c, 0x06, 0x7d, 0x16, 0x54, 0x82, 0x65, 0xab, 0x4d, 0x3c, 0xd3, 0xb7, 0x03,
+		0x9b, 0x98, 0x2d, 0xcf, 0x71, 0x49, 0x90, 0xcd, 0x30, 0xcd, 0xcd, 0xa1, 0x9a, 0x98, 0x02, 0x2b,
+		0x0c, 0x87, 0x97, 0x28, 0x35, 0x66, 0x40, 0x37, 0x61, 0x8e, 0x96, 0x87, 0x49, 0xeb, 0x23, 0x3b,
+		0xbf, 0x29, 0x6c, 0xcd, 0xe1, 0x59, 0x6a, 0x28, 0x39, 0x01, 0x41, 0xeb, 0x70, 0xcd, 0x09, 0xcc,
+		0x9a, 0xef, 0xb9, 0xd9, 0x85, 0x4d, 0x61, 0x6b, 0x16, 0xcf, 0x38, 0x81, 0xec, 0x7b, 0x2e, 0xda,
+		0x83, 0x4c, 0xbb, 0x55, 0xb7, 0x08, 0x4f, 0xb0, 0xc5, 0xc4, 0x30, 0x42, 0x08, 0x67, 0x31, 0xfc,
+		0x39, 0x88, 0x2d, 0xcb, 0x27, 0x0e, 0x3b, 0x86, 0x9a, 0xe7, 0x9e, 0x39, 0xe7, 0xd9, 0xa5, 0xcd,
+		0xf4, 0x56, 0xe6, 0xe1, 0xcb, 0xc9, 0xb2, 0x8c, 0x1e, 0x66, 0xa1, 0x12, 0x49, 0xc8, 0x4c, 0x41,
+		0x71, 0x89, 0x7f, 0x89, 0x97, 0x5a, 0xbd, 0x56, 0xba, 0x3d, 0x27, 0x30, 0x5b, 0x56, 0x3b, 0xb0,
+		0xeb, 0x59, 0x91, 0xed, 0x61, 0xd6, 0x09, 0x2a, 0xec, 0x79, 0x63, 0x1f, 0x56, 0x87, 0xa9, 0x20,
+		0x11, 0xd2, 0xef, 0xed, 0x4b, 0x56, 0xf7, 0x73, 0x98, 0xfe, 0x89, 0x56, 0x61, 0xfa, 0x83, 0xd5,
+		0x68, 0x87, 0x25, 0x3c, 0x87, 0xc3, 0x87, 0xe7, 0xa9, 0x6f, 0x85, 0xdc, 0x6f, 0x52, 0x70, 0x6b,
+		0xb0, 0x0c, 0x98, 0x18, 0x6f, 0x6e, 0xe8, 0x79, 0x3c, 0xc4, 0x61, 0x33, 0xf9, 0x7c, 0xe8, 0x46,
+		0x0d, 0x1e, 0xf7, 0xd8, 0x09, 0x58, 0xb0, 0xd9, 0x4d, 0x59, 0xde, 0x0d, 0x3c, 0xb3, 0x5b, 0xdb,
+		0x5e, 0x9b, 0xf0, 0xb6, 0x72, 0x63, 0x20, 0xfa, 0x45, 0xee, 0x00, 0xfe, 0xac, 0x23, 0xa1, 0xb3,
+		0x0e, 0xe1, 0xc9, 0x51, 0xb5, 0x7b, 0x6d, 0x82, 0x8e, 0xe1, 0x26, 0x73, 0x6f, 0x84, 0x7a, 0x3a,
+		0x49, 0x7d, 0x9d, 0xb2, 0x87, 0x08, 0xe7, 0xfe, 0x21, 0xc0, 0xca, 0x90, 0xda, 0xa4, 0x67, 0x52,
+		0xf7, 0x9a, 0x96, 0xe3, 0x9a, 0x4e, 0x9d, 0x07, 0x79, 0x36, 0x34, 0xa8, 0x75, 0x74, 0x1b, 0x32,
+		0x7c, 0xd1, 0xb5, 0x9a, 0x51, 0xbc, 0x21, 0x34, 0x69, 0x56, 0xd3, 0x1e, 0xd1, 0xa3, 0xd3, 0xff,
+		0x6d, 0x8f, 0xbe, 0x03, 0xf3, 0x8e, 0xeb, 0x10, 0xc7, 0x22, 0x76, 0x9d, 0xfa, 0x35, 0xc5, 0xda,
+		0x53, 0xa6, 0x63, 0x53, 0xeb, 0xb9, 0x5f, 0x09, 0xb0, 0xa6, 0x7c, 0x22, 0xb6, 0xef, 0x5a, 0x8d,
+		0xef, 0x65, 0x6e, 0xf4, 0xfb, 0x94, 0x1a, 0xf4, 0xe9, 0x0f, 0x33, 0xb0, 0x52, 0xb1, 0xdd, 0xba,
+		0xe3, 0x9e, 0x4b, 0x35, 0xe2, 0x7c, 0x70, 0xc8, 0x25, 0xf3, 0xe8, 0x36, 0x64, 0x2c, 0xfe, 0xdc,
+		0x8d, 0x32, 0x44, 0x26, 0xb5, 0x8e, 0x0e, 0x60, 0xa1, 0x03, 0x48, 0x1c, 0x4e, 0x91, 0x34, 0x1b,
+		0x4e, 0xf3, 0x56, 0xec, 0x09, 0xbd, 0x84, 0x69, 0x3a, 0x28, 0xc2, 0xf9, 0xb4, 0xf8, 0xf0, 0xc1,
+		0xf0, 0x0e, 0xdd, 0xeb, 0x21, 0x9d, 0x09, 0x36, 0x0e, 0x79, 0x48, 0x85, 0xe5, 0x0b, 0xdb, 0xf2,
+		0xc9, 0xa9, 0x6d, 0x11, 0xb3, 0x6e, 0x13, 0xcb, 0x69, 0x04, 0x7c, 0x62, 0x7d, 0x36, 0xa2, 0xdd,
+		0x5f, 0x36, 0x3c, 0xab, 0x8e, 0xc5, 0x0e, 0xad, 0x18, 0xb2, 0xd0, 0x6b, 0x58, 0x69, 0x58, 0x01,
+		0x31, 0xbb, 0x7a, 0xac, 0x3b, 0x4d, 0x27, 0x76, 0xa7, 0x65, 0x4a, 0x3b, 0x8c, 0x58, 0xd4, 0x8e,
+		0x0e, 0x80, 0x19, 0xc3, 0xaa, 0xb0, 0xeb, 0xa1, 0xd2, 0x4c, 0xa2, 0xd2, 0x12, 0x25, 0xe9, 0x21,
+		0x87, 0xe9, 0x64, 0xe1, 0x9a, 0x45, 0x88, 0xdd, 0x6c, 0x11, 0x36, 0xc3, 0xa6, 0x71, 0xf4, 0x88,
+		0x1e, 0x80, 0xd8, 0xb4, 0x3e, 0x39, 0xcd, 0x76, 0xd3, 0xe4, 0xa6, 0x80, 0xcd, 0xa3, 0x69, 0xbc,
+		0xc4, 0xed, 0x12, 0x37, 0xd3, 0xc1, 0x15, 0xd4, 0x2e, 0xec, 0x7a, 0xbb, 0x11, 0x79, 0x32, 0x97,
+		0x3c, 0xb8, 0x3a, 0x0c, 0xe6, 0x87, 0x0c, 0x4b, 0xf6, 0xa7, 0x96, 0x13, 0xd6, 0x6c, 0xa8, 0x01,
+		0x89, 0x1a, 0x8b, 0x5d, 0x0a, 0x13, 0x79, 0x09, 0xf3, 0x2c, 0x28, 0x67, 0x96, 0xd3, 0x68, 0xfb,
+		0x76, 0x36, 0x33, 0xe6, 0x98, 0x0e, 0x42, 0x0c, 0xce, 0x50, 0x06, 0x7f, 0x40, 0x5f, 0xc3, 0x2a,
+		0x13, 0xa0, 0xb9, 0x6e, 0xfb, 0xa6, 0x53, 0xb7, 0x5d, 0xe2, 0x90, 0x4b, 0x3e, 0x78, 0x10, 0x5d,
+		0x3b, 0x66, 0x4b, 0x2a, 0x5f, 0x41, 0x4f, 0x60, 0x3d, 0x3a, 0x82, 0x7e, 0xd2, 0x02, 0x23, 0xad,
+		0xf1, 0xe5, 0x3e, 0xde, 0x75, 0x98, 0xe1, 0x5d, 0x7f, 0x31, 0x9c, 0x5c, 0xe1, 0x53, 0xee, 0xcf,
+		0x29, 0xb8, 0xc1, 0xd3, 0x51, 0xbe, 0x70, 0x1a, 0xf5, 0xef, 0xa5, 0x90, 0xbf, 0x8a, 0xc9, 0xd2,
+		0x62, 0x8b, 0xf7, 0x36, 0xf1, 0x63, 0xec, 0xe6, 0xc7, 0x3a, 0x5c, 0x7f, 0xd9, 0xa7, 0x07, 0xca,
+		0x1e, 0xbd, 0x05, 0x7e, 0xc1, 0xe1, 0xcd, 0xba, 0xe5, 0x35, 0x9c, 0xda, 0x25, 0x2b, 0x9b, 0xc5,
+		0x11, 0x8e, 0x86, 0x9d, 0x98, 0x35, 0xe8, 0x0a, 0x43, 0xe3, 0xe5, 0x56, 0xbf, 0x89, 0x46, 0x2d,
+		0x6c, 0xb5, 0xac, 0x68, 0xe6, 0x30, 0x7f, 0xca, 0xfd, 0x2d, 0xd5, 0x69, 0x33, 0x45, 0xbb, 0xe6,
+		0x04, 0x51, 0xbc, 0x3a, 0xd5, 0x2f, 0x24, 0x57, 0x7f, 0x44, 0xec, 0xa9, 0xfe, 0xc1, 0xcc, 0x4e,
+		0x5d, 0x35, 0xb3, 0x5f, 0xc0, 0x7c, 0x4f, 0x91, 0x26, 0x5f, 0x94, 0x33, 0xc1, 0xf0, 0x02, 0x9d,
+		0xea, 0x2d, 0x50, 0x0c, 0xeb, 0x9e, 0xef, 0x9c, 0x3b, 0xae, 0xd5, 0x30, 0xfb, 0x9c, 0x4c, 0x6e,
+		0x29, 0x6b, 0x11, 0x55, 0x8f, 0x3b, 0x9b, 0xfb, 0x4b, 0x0a, 0x6e, 0x44, 0x6d, 0xb0, 0xe4, 0xd5,
+		0xac, 0x46, 0xd1, 0x09, 0x5a, 0x16, 0xa9, 0x5d, 0x4c, 0xd6, 0xb5, 0xff, 0xf7, 0xe1, 0xfa, 0x19,
+		0xdc, 0xea, 0xf5, 0xc0, 0xf4, 0xce, 0x4c, 0x72, 0xe1, 0x04, 0x66, 0x3c, 0x8a, 0xe3, 0x05, 0x37,
+		0x7a, 0x3c, 0x2a, 0x9f, 0x19, 0x17, 0x4e, 0xc0, 0x7b, 0x1d, 0xfa, 0x1c, 0x80, 0xdd, 0x46, 0x88,
+		0xf7, 0xde, 0x0e, 0xb3, 0x70, 0x1e, 0xb3, 0xeb, 0x93, 0x41, 0x0d, 0xb9, 0xd7, 0x90, 0x89, 0xdf,
+		0x5e, 0xf7, 0x60, 0x86, 0x5f, 0x80, 0x05, 0x76, 0x81, 0xfc, 0x22, 0xe1, 0x02, 0xcc, 0x7e, 0x1b,
+		0x70, 0x4a, 0xee, 0x8f, 0x29, 0x58, 0xec, 0x5d, 0x42, 0xf7, 0x61, 0xe9, 0xd4, 0x71, 0x2d, 0xff,
+		0xd2, 0xac, 0x5d, 0xd8, 0xb5, 0xf7, 0x41, 0xbb, 0xc9, 0x0f, 0x61, 0x31, 0x34, 0xcb, 0xdc, 0x8a,
+		0xd6, 0x60, 0xc6, 0x6f, 0xbb, 0xd1, 0x50, 0x9e, 0xc3, 0xd3, 0x7e, 0x9b, 0xde, 0x5e, 0x5e, 0xc0,
+		0xcd, 0x33, 0xc7, 0x0f, 0xe8, 0x20, 0x0b, 0x93, 0xdd, 0xac, 0x79, 0xcd, 0x56, 0xc3, 0xee, 0xa9,
+		0xe4, 0x2c, 0x83, 0x44, 0xe5, 0x20, 0x47, 0x00, 0x46, 0x9f, 0xaf, 0xf9, 0xb6, 0xd5, 0x39, 0x9b,
+		0xe4, 0x50, 0x66, 0x38, 0x9e, 0xb7, 0xe7, 0x05, 0xd6, 0xb0, 0x1d, 0xf7, 0x7c, 0xd2, 0x34, 0x9d,
+		0x8f, 0x08, 0x4c, 0xe0, 0x16, 0x00, 0xfb, 0x55, 0x41, 0xac, 0xd3, 0x46, 0x38, 0xed, 0x66, 0x71,
+		0xcc, 0x92, 0xff, 0x93, 0x00, 0xab, 0xc3, 0x66, 0x39, 0xca, 0xc1, 0xad, 0x8a, 0xa2, 0x15, 0x55,
+		0xed, 0x95, 0x29, 0xc9, 0x86, 0xfa, 0x56, 0x35, 0x4e, 0x4c, 0xdd, 0x90, 0x0c, 0xc5, 0x54, 0xb5,
+		0xb7, 0x52, 0x49, 0x2d, 0x8a, 0xff, 0x87, 0xbe, 0x84, 0xcd, 0x11, 0x18, 0x5d, 0x3e, 0x54, 0x8a,
+		0xd5, 0x92, 0x52, 0x14, 0x85, 0x31, 0x4a, 0xba, 0x21, 0x61, 0x43, 0x29, 0x8a, 0x29, 0xf4, 0xff,
+		0x70, 0x7f, 0x04, 0x46, 0x96, 0x34, 0x59, 0x29, 0x99, 0x58, 0xf9, 0x71, 0x55, 0xd1, 0x29, 0x38,
+		0x9d, 0xff, 0x45, 0xd7, 0xe7, 0x9e, 0x0e, 0x14, 0x7f, 0x53, 0x51, 0x91, 0x55, 0x5d, 0x2d, 0x6b,
+		0xe3, 0x7c, 0xee, 0xc3, 0x8c, 0xf0, 0xb9, 0x1f, 0x15, 0xf9, 0x9c, 0xff, 0x65, 0xaa, 0xfb, 0xd1,
+		0x41, 0xad, 0x63, 0xbb, 0xdd, 0xe9, 0xb9, 0x5f, 0xc2, 0xe6, 0x71, 0x19, 0xbf, 0x39, 0x28, 0x95,
+		0x8f, 0x4d, 0xb5, 0x68, 0x62, 0xa5, 0xaa, 0x2b, 0x66, 0xa5, 0x5c, 0x52, 0xe5, 0x93, 0x98, 0x27,
+		0xdf, 0xc2, 0x37, 0x23, 0x51, 0x52, 0x89, 0x5a, 0x8b, 0xd5, 0x4a, 0x49, 0x95, 0xe9, 0x5b, 0x0f,
+		0x24, 0xb5, 0xa4, 0x14, 0xcd, 0xb2, 0x56, 0x3a, 0x11, 0x05, 0xf4, 0x15, 0x6c, 0x4d, 0xca, 0x14,
+		0x53, 0x68, 0x1b, 0x1e, 0x8c, 0x44, 0x63, 0xe5, 0xb5, 0x22, 0x1b, 0x31, 0x78, 0x1a, 0xed, 0xc2,
+		0xf6, 0x48, 0xb8, 0xa1, 0xe0, 0x23, 0x55, 0x63, 0x01, 0x3d, 0x30, 0x71, 0x55, 0xd3, 0x54, 0xed,
+		0x95, 0x38, 0x95, 0xff, 0x9d, 0x00, 0xcb, 0x03, 0xc3, 0x08, 0xdd, 0x86, 0x9b, 0x15, 0x09, 0x2b,
+		0x9a, 0x61, 0xca, 0xa5, 0xf2, 0xb0, 0x00, 0x8c, 0x00, 0x48, 0xfb, 0x92, 0x56, 0x2c, 0x6b, 0xa2,
+		0x80, 0xee, 0x41, 0x6e, 0x18, 0x80, 0xe7, 0x02, 0x4f, 0x0d, 0x31, 0x85, 0xee, 0xc0, 0xe7, 0xc3,
+		0x70, 0x1d, 0x6f, 0xc5, 0x74, 0xfe, 0x5f, 0x29, 0xf8, 0x6c, 0xdc, 0xb7, 0x0d, 0x9a, 0x81, 0x9d,
+		0x6d, 0x2b, 0xef, 0x14, 0xb9, 0x6a, 0xd0, 0x33, 0x0f, 0xf5, 0xe8, 0xc9, 0x57, 0xf5, 0x98, 0xe7,
+		0xf1, 0x90, 0x8e, 0x00, 0xcb, 0xe5, 0xa3, 0x4a, 0x49, 0x31, 0x58, 0x36, 0xe5, 0xe1, 0x5e, 0x12,
+		0x3c, 0x3c, 0x60, 0x31, 0xd5, 0x73, 0xb6, 0xa3, 0xa4, 0xd9, 0xbe, 0x69, 0x29, 0xa0, 0x02, 0xe4,
+		0x93, 0xd0, 0x9d, 0x28, 0x14, 0xc5, 0x29, 0xf4, 0x0d, 0x7c, 0x9d, 0xec, 0xb8, 0x66, 0xa8, 0x5a,
+		0x55, 0x29, 0x9a, 0x92, 0x6e, 0x6a, 0xca, 0xb1, 0x38, 0x3d, 0xc9, 0x76, 0x0d, 0xf5, 0x88, 0xe6,
+		0x67, 0xd5, 0x10, 0x67, 0xf2, 0x7f, 0x15, 0xe0, 0xba, 0xec, 0xb9, 0xc4, 0x71, 0xdb, 0xb6, 0x14,
+		0x68, 0xf6, 0x47, 0x35, 0xbc, 0xe7, 0x78, 0x3e, 0xba, 0x0b, 0x77, 0x22, 0x7d, 0x2e, 0x6f, 0xaa,
+		0x9a, 0x6a, 0xa8, 0x92, 0x51, 0xc6, 0xb1, 0xf8, 0x8e, 0x85, 0xd1, 0x82, 0x2c, 0x2a, 0x38, 0x8c,
+		0xeb, 0x68, 0x18, 0x56, 0x0c, 0x7c, 0xc2, 0x53, 0x21, 0xec, 0x30, 0xa3, 0xb1, 0x32, 0x2e, 0x6b,
+		0x9d, 0xfa, 0x17, 0xd3, 0xf9, 0xdf, 0x0b, 0x90, 0xe1, 0xbf, 0x79, 0xd9, 0x4f, 0xa2, 0x2c, 0xac,
+		0xd2, 0x0d, 0x96, 0xab, 0x86, 0x69, 0x9c, 0x54, 0x94, 0xde, 0x1c, 0xee, 0x59, 0x61, 0xed, 0xc1,
+		0x34, 0xca, 0x61, 0x74, 0xc2, 0x4e, 0xd2, 0x0b, 0xe0, 0x6f, 0xa1, 0x18, 0x06, 0x16, 0x53, 0x63,
+		0x31, 0xa1, 0x4e, 0x1a, 0x6d, 0xc0, 0xf5, 0x1e, 0xcc, 0xa1, 0x22, 0x61, 0x63, 0x5f, 0x91, 0x0c,
+		0x71, 0x2a, 0xff, 0x5b, 0x01, 0x6e, 0x44, 0x9d, 0x90, 0x7e, 0x71, 0xa0, 0xae, 0xd7, 0xcb, 0x6d,
+		0x22, 0xd3, 0x0b, 0x32, 0x7a, 0x00, 0x77, 0x3b, 0x3d, 0xcc, 0x90, 0xf4, 0x37, 0xdd, 0xb3, 0x32,
+		0x65, 0xa9, 0xaa, 0xc7, 0x77, 0x93, 0x08, 0xe5, 0x2e, 0x88, 0x02, 0xba, 0x0f, 0x5f, 0x8c, 0x87,
+		0x62, 0x45, 0x57, 0x0c, 0x31, 0x95, 0xff, 0x67, 0x06, 0xd6, 0xe3, 0xce, 0xd1, 0x1f, 0x0e, 0x76,
+		0x3d, 0x74, 0xed, 0x1e, 0xe4, 0x7a, 0x45, 0x78, 0x9f, 0xeb, 0xf7, 0x6b, 0x17, 0xb6, 0xc7, 0xe0,
+		0xaa, 0xda, 0xa1, 0xa4, 0x15, 0xe9, 0x73, 0x04, 0x12, 0x05, 0xf4, 0x12, 0xf6, 0xc6, 0x50, 0xf6,
+		0xa5, 0x62, 0x37, 0xca, 0x9d, 0x89, 0x23, 0x19, 0x06, 0x56, 0xf7, 0xab, 0x86, 0xa2, 0x8b, 0x29,
+		0xa4, 0x80, 0x94, 0x20, 0xd0, 0xdb, 0x87, 0x86, 0xca, 0xa4, 0xd1, 0x33, 0x78, 0x9c, 0xe4, 0x47,
+		0x98, 0x32, 0xea, 0x91, 0x82, 0xe3, 0xd4, 0x29, 0xf4, 0x1c, 0x9e, 0x24, 0x50, 0xf9, 0x9b, 0x07,
+		0xb8, 0xd3, 0x68, 0x0f, 0x9e, 0x26, 0x7a, 0x2f, 0x97, 0x71, 0xd1, 0x3c, 0x92, 0xf0, 0x9b, 0x5e,
+		0xf2, 0x0c, 0x52, 0x41, 0x49, 0x7a, 0x31, 0xef, 0x6e, 0xe6, 0x90, 0xbe, 0x10, 0x93, 0xba, 0x36,
+		0x41, 0x14, 0xa9, 0x21, 0x41, 0x66, 0x16, 0xbd, 0x02, 0x79, 0xb2, 0x50, 0x8c, 0x17, 0x9a, 0x43,
+		0xef, 0xc0, 0xb8, 0xda, 0xa9, 0x2a, 0xef, 0x0c, 0x05, 0x6b, 0x52, 0x92, 0x32, 0xa0, 0x17, 0xf0,
+		0x2c, 0x31, 0x68, 0xbd, 0xfd, 0x27, 0x46, 0xcf, 0xa0, 0xa7, 0xf0, 0x68, 0x0c, 0x3d, 0x9e, 0x23,
+		0xdd, 0x5b, 0x81, 0x5a, 0x14, 0xe7, 0xd1, 0x63, 0xd8, 0x1d, 0x43, 0x64, 0x55, 0x68, 0xea, 0x86,
+		0x2a, 0xbf, 0x39, 0x09, 0x97, 0x4b, 0xaa, 0x6e, 0x88, 0x0b, 0xe8, 0x47, 0xf0, 0x83, 0x31, 0xb4,
+		0xce, 0x66, 0xe9, 0x1f, 0x0a, 0x8e, 0x95, 0x18, 0x85, 0x55, 0xb1, 0x22, 0x2e, 0x4e, 0x70, 0x26,
+		0xba, 0xfa, 0x2a, 0x39, 0x72, 0x4b, 0x48, 0x86, 0x97, 0x13, 0x95, 0x88, 0x7c, 0xa8, 0x96, 0x8a,
+		0xc3, 0x45, 0x44, 0xf4, 0x08, 0x76, 0xc6, 0x88, 0x1c, 0x94, 0xb1, 0xac, 0xf0, 0x89, 0xd5, 0x69,
+		0x12, 0xcb, 0xe8, 0x09, 0x3c, 0x1c, 0x47, 0x92, 0xd4, 0x52, 0xf9, 0xad, 0x82, 0xfb, 0x79, 0x88,
+		0x8e, 0xd1, 0xc9, 0xb6, 0xae, 0x6a, 0x95, 0xaa, 0x61, 0xea, 0xea, 0x77, 0x8a, 0xb8, 0x42, 0xc7,
+		0x68, 0xe2, 0x49, 0x45, 0xb1, 0x12, 0x57, 0x07, 0x9b, 0xf1, 0xc0, 0x4b, 0xf6, 0x55, 0x4d, 0xc2,
+		0x27, 0xe2, 0x5a, 0x42, 0xee, 0x0d, 0x36, 0xba, 0x9e, 0x14, 0xba, 0x3e, 0xc9, 0x76, 0x14, 0x09,
+		0xcb, 0x87, 0xf1, 0x88, 0xaf, 0xd3, 0xa9, 0x73, 0x87, 0x7d, 0x70, 0x19, 0xb8, 0x57, 0xc5, 0x5b,
+		0xfc, 0x2e, 0x6c, 0x87, 0xe7, 0x36, 0x24, 0x0b, 0x46, 0x74, 0xfb, 0x7d, 0xf8, 0xe1, 0x64, 0x94,
+		0xce, 0xba, 0x54, 0xc2, 0x8a, 0x54, 0x3c, 0xe9, 0x5c, 0x49, 0x85, 0xfc, 0xaf, 0x53, 0x90, 0x97,
+		0x2d, 0xb7, 0x66, 0x37, 0xa2, 0xef, 0xbb, 0x63, 0xbd, 0xdc, 0x83, 0xa7, 0x13, 0xd4, 0xfb, 0x08,
+		0x7f, 0x8f, 0x41, 0xbf, 0x2a, 0xb9, 0xaa, 0xbd, 0xd1, 0xca, 0xc7, 0xda, 0x38, 0x82, 0x28, 0x20,
+		0x0d, 0x5e, 0x5f, 0x55, 0x78, 0x20, 0x24, 0xdd, 0x7b, 0x68, 0x8a, 0x05, 0x45, 0x77, 0xce, 0x5d,
+		0x6b, 0xe2, 0xa0, 0xf0, 0x34, 0xfe, 0xcf, 0x82, 0x72, 0x55, 0xf2, 0xc4, 0x41, 0xb9, 0xaa, 0xf0,
+		0xb8, 0xa0, 0xec, 0xff, 0x14, 0xd6, 0x6b, 0x5e, 0x73, 0xd8, 0x57, 0x86, 0xfd, 0x85, 0x28, 0x3c,
+		0x15, 0xfa, 0x33, 0xbb, 0x22, 0x7c, 0xb7, 0x7b, 0xee, 0x90, 0x8b, 0xf6, 0x69, 0xa1, 0xe6, 0x35,
+		0x77, 0xe2, 0xff, 0x96, 0xde, 0x76, 0xea, 0x8d, 0x9d, 0x73, 0x2f, 0xfc, 0x37, 0x37, 0xff, 0x1f,
+		0xf5, 0x9e, 0xd5, 0x72, 0x3e, 0xec, 0x9e, 0xce, 0x30, 0xdb, 0xa3, 0x7f, 0x0f, 0x00, 0x17, 0x16,
+		0xe7, 0xc7, 0x63, 0x1f, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/tasklist.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
+		0x14, 0x9e, 0xe2, 0xb4, 0x4b, 0xe8, 0xd9, 0xd5, 0xb8, 0xb5, 0x8d, 0xdd, 0x75, 0xf3, 0x74, 0x51,
+		0x04, 0xc5, 0x26, 0xc1, 0x19, 0x76, 0xb5, 0x8b, 0xc1, 0xb1, 0x83, 0x55, 0xb0, 0xe3, 0x1a, 0x92,
+		0x1a, 0x20, 0x03, 0x06, 0x8e, 0x12, 0x59, 0x9b, 0xd0, 0x0f, 0x05, 0x92, 0x72, 0xe2, 0x17, 0xd9,
+		0xc3, 0xec, 0x89, 0xf6, 0x18, 0x03, 0x29, 0xd9, 0xf3, 0x12, 0x6f, 0x77, 0xe4, 0xf9, 0xce, 0x77,
+		0x7e, 0x3e, 0x9e, 0x43, 0xe0, 0x54, 0x31, 0x15, 0x5e, 0x82, 0x09, 0x2d, 0x12, 0xea, 0xe1, 0x92,
+		0x79, 0xeb, 0xa1, 0xa7, 0xb0, 0x4c, 0x33, 0x26, 0x95, 0x5b, 0x0a, 0xae, 0x38, 0xfc, 0x42, 0xfb,
+		0xb8, 0x8d, 0x8f, 0x8b, 0x4b, 0xe6, 0xae, 0x87, 0xfd, 0xaf, 0x97, 0x9c, 0x2f, 0x33, 0xea, 0x19,
+		0x97, 0xb8, 0xfa, 0xe8, 0x91, 0x4a, 0x60, 0xc5, 0x78, 0x51, 0x93, 0xfa, 0xdf, 0x3c, 0xc4, 0x15,
+		0xcb, 0xa9, 0x54, 0x38, 0x2f, 0x1b, 0x87, 0x47, 0x01, 0xee, 0x04, 0x2e, 0x4b, 0x2a, 0x64, 0x8d,
+		0x3b, 0x1f, 0xc0, 0x49, 0x84, 0x65, 0x3a, 0x63, 0x52, 0x41, 0x08, 0x8e, 0x0b, 0x9c, 0xd3, 0x33,
+		0x6b, 0x60, 0x9d, 0x9f, 0x06, 0xe6, 0x0c, 0x7f, 0x04, 0xc7, 0x29, 0x2b, 0xc8, 0xd9, 0xd1, 0xc0,
+		0x3a, 0xef, 0x5e, 0x7c, 0xeb, 0x1e, 0x28, 0xd2, 0xdd, 0x06, 0x98, 0xb2, 0x82, 0x04, 0xc6, 0xdd,
+		0xc1, 0xc0, 0xde, 0x5a, 0xaf, 0xa9, 0xc2, 0x04, 0x2b, 0x0c, 0xaf, 0xc1, 0x97, 0x39, 0xbe, 0x47,
+		0xba, 0x6d, 0x89, 0x4a, 0x2a, 0x90, 0xa4, 0x09, 0x2f, 0x88, 0x49, 0xd7, 0xbe, 0xf8, 0xca, 0xad,
+		0x2b, 0x75, 0xb7, 0x95, 0xba, 0x13, 0x5e, 0xc5, 0x19, 0xbd, 0xc1, 0x59, 0x45, 0x83, 0xcf, 0x73,
+		0x7c, 0xaf, 0x03, 0xca, 0x05, 0x15, 0xa1, 0xa1, 0x39, 0x1f, 0x40, 0x6f, 0x9b, 0x62, 0x81, 0x85,
+		0x62, 0x5a, 0x95, 0x5d, 0x2e, 0x1b, 0xb4, 0x52, 0xba, 0x69, 0x3a, 0xd1, 0x47, 0xf8, 0x06, 0x3c,
+		0xe3, 0x77, 0x05, 0x15, 0x68, 0xc5, 0xa5, 0x42, 0xa6, 0xcf, 0x23, 0x83, 0x76, 0x8c, 0xf9, 0x1d,
+		0x97, 0x6a, 0x8e, 0x73, 0xea, 0xfc, 0x65, 0x81, 0xee, 0x36, 0x6e, 0xa8, 0xb0, 0xaa, 0x24, 0xfc,
+		0x0e, 0xc0, 0x18, 0x27, 0x69, 0xc6, 0x97, 0x28, 0xe1, 0x55, 0xa1, 0xd0, 0x8a, 0x15, 0xca, 0xc4,
+		0x6e, 0x05, 0x76, 0x83, 0x8c, 0x35, 0xf0, 0x8e, 0x15, 0x0a, 0xbe, 0x06, 0x40, 0x50, 0x4c, 0x50,
+		0x46, 0xd7, 0x34, 0x33, 0x39, 0x5a, 0xc1, 0xa9, 0xb6, 0xcc, 0xb4, 0x01, 0xbe, 0x02, 0xa7, 0x38,
+		0x49, 0x1b, 0xb4, 0x65, 0xd0, 0x13, 0x9c, 0xa4, 0x35, 0xf8, 0x06, 0x3c, 0x13, 0x58, 0xd1, 0x7d,
+		0x75, 0x8e, 0x07, 0xd6, 0xb9, 0x15, 0x74, 0xb4, 0x79, 0xd7, 0x3b, 0x9c, 0x80, 0x8e, 0x96, 0x11,
+		0x31, 0x82, 0xe2, 0x8c, 0x27, 0xe9, 0xd9, 0x13, 0xa3, 0xe1, 0xe0, 0x3f, 0x9f, 0xc7, 0x9f, 0x5c,
+		0x6a, 0xbf, 0xa0, 0xad, 0x69, 0x3e, 0x31, 0x17, 0xe7, 0x67, 0xd0, 0xde, 0xc3, 0x60, 0x0f, 0x9c,
+		0x48, 0x85, 0x85, 0x42, 0x8c, 0x34, 0xcd, 0x7d, 0x6a, 0xee, 0x3e, 0x81, 0xcf, 0xc1, 0x53, 0x5a,
+		0x10, 0x0d, 0xd4, 0xfd, 0x3c, 0xa1, 0x05, 0xf1, 0x89, 0xf3, 0x87, 0x05, 0xc0, 0x82, 0x67, 0x19,
+		0x15, 0x7e, 0xf1, 0x91, 0xc3, 0x09, 0xb0, 0x33, 0x2c, 0x15, 0xc2, 0x49, 0x42, 0xa5, 0x44, 0x7a,
+		0x14, 0x9b, 0xc7, 0xed, 0x3f, 0x7a, 0xdc, 0x68, 0x3b, 0xa7, 0x41, 0x57, 0x73, 0x46, 0x86, 0xa2,
+		0x8d, 0xb0, 0x0f, 0x4e, 0x18, 0xa1, 0x85, 0x62, 0x6a, 0xd3, 0xbc, 0xd0, 0xee, 0x7e, 0x48, 0x9f,
+		0xd6, 0x01, 0x7d, 0x9c, 0x3f, 0x2d, 0xd0, 0x0b, 0x15, 0x4b, 0xd2, 0xcd, 0xd5, 0x3d, 0x4d, 0x2a,
+		0x3d, 0x1a, 0x23, 0xa5, 0x04, 0x8b, 0x2b, 0x45, 0x25, 0xfc, 0x05, 0xd8, 0x77, 0x5c, 0xa4, 0x54,
+		0x98, 0x59, 0x44, 0x7a, 0x07, 0x9b, 0x3a, 0x5f, 0xff, 0xef, 0x7c, 0x07, 0xdd, 0x9a, 0xb6, 0x5b,
+		0x98, 0x08, 0xf4, 0x64, 0xb2, 0xa2, 0xa4, 0xca, 0x28, 0x52, 0x1c, 0xd5, 0xea, 0xe9, 0xb6, 0x79,
+		0xa5, 0x4c, 0xed, 0xed, 0x8b, 0xde, 0xe3, 0xb1, 0x6e, 0x36, 0x38, 0x78, 0xb1, 0xe5, 0x46, 0x3c,
+		0xd4, 0xcc, 0xa8, 0x26, 0xbe, 0xfd, 0x1d, 0x7c, 0xb6, 0xbf, 0x51, 0xb0, 0x0f, 0x5e, 0x44, 0xa3,
+		0x70, 0x8a, 0x66, 0x7e, 0x18, 0xa1, 0xa9, 0x3f, 0x9f, 0x20, 0x7f, 0x7e, 0x33, 0x9a, 0xf9, 0x13,
+		0xfb, 0x13, 0xd8, 0x03, 0xcf, 0x1f, 0x60, 0xf3, 0xf7, 0xc1, 0xf5, 0x68, 0x66, 0x5b, 0x07, 0xa0,
+		0x30, 0xf2, 0xc7, 0xd3, 0x5b, 0xfb, 0xe8, 0x2d, 0xf9, 0x27, 0x43, 0xb4, 0x29, 0xe9, 0xbf, 0x33,
+		0x44, 0xb7, 0x8b, 0xab, 0xbd, 0x0c, 0xaf, 0xc0, 0xcb, 0x07, 0xd8, 0xe4, 0x6a, 0xec, 0x87, 0xfe,
+		0xfb, 0xb9, 0x6d, 0x1d, 0x00, 0x47, 0xe3, 0xc8, 0xbf, 0xf1, 0xa3, 0x5b, 0xfb, 0xe8, 0xf2, 0x37,
+		0xf0, 0x32, 0xe1, 0xf9, 0x21, 0x45, 0x2f, 0x3b, 0xbb, 0xcd, 0xd5, 0xaa, 0x2c, 0xac, 0x5f, 0x87,
+		0x4b, 0xa6, 0x56, 0x55, 0xec, 0x26, 0x3c, 0xf7, 0xf6, 0xff, 0xca, 0xef, 0x19, 0xc9, 0xbc, 0x25,
+		0xaf, 0xbf, 0xaf, 0xe6, 0xe3, 0xfc, 0x09, 0x97, 0x6c, 0x3d, 0x8c, 0x9f, 0x1a, 0xdb, 0x0f, 0x7f,
+		0x0f, 0x00, 0x41, 0xb6, 0x75, 0xa3, 0x5c, 0x05, 0x00, 0x00,
+	},
+	// uber/cadence/admin/v1/cluster.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5d, 0x6b, 0xe3, 0x46,
+		0x14, 0xc5, 0x56, 0x1c, 0x3b, 0x63, 0xe7, 0x6b, 0xd2, 0xb4, 0x22, 0xa5, 0xc4, 0x55, 0x68, 0x71,
+		0x28, 0x95, 0x48, 0x4a, 0x28, 0xfd, 0xa0, 0xb4, 0x4e, 0x5a, 0xea, 0x42, 0xdb, 0x45, 0xeb, 0x65,
+		0x61, 0x5f, 0xc4, 0x48, 0xba, 0x96, 0x07, 0x4b, 0x33, 0x46, 0x33, 0x72, 0x10, 0xec, 0xe3, 0xbe,
+		0xee, 0xff, 0xd9, 0xfd, 0x15, 0xfb, 0x97, 0x96, 0x99, 0xd1, 0x88, 0x2c, 0x9b, 0xb0, 0x79, 0x9b,
+		0x7b, 0xee, 0x39, 0x57, 0xe7, 0xce, 0xdc, 0x2b, 0x74, 0x56, 0xc5, 0x50, 0x06, 0x09, 0x49, 0x81,
+		0x25, 0x10, 0x90, 0xb4, 0xa0, 0x2c, 0xd8, 0x5c, 0x04, 0x49, 0x5e, 0x09, 0x09, 0xa5, 0xbf, 0x2e,
+		0xb9, 0xe4, 0xf8, 0x58, 0x91, 0xfc, 0x86, 0xe4, 0x6b, 0x92, 0xbf, 0xb9, 0x38, 0x39, 0xcd, 0x38,
+		0xcf, 0x72, 0x08, 0x34, 0x29, 0xae, 0x16, 0x81, 0xa4, 0x05, 0x08, 0x49, 0x8a, 0xb5, 0xd1, 0x79,
+		0xdf, 0xa2, 0xc1, 0xdf, 0x5c, 0xc8, 0x19, 0x5b, 0x70, 0x7c, 0x82, 0x06, 0x34, 0x05, 0x26, 0xa9,
+		0xac, 0xdd, 0xce, 0xb8, 0x33, 0xd9, 0x09, 0xdb, 0xd8, 0x7b, 0x89, 0x06, 0x21, 0x65, 0x99, 0xe6,
+		0x61, 0xb4, 0x55, 0xf2, 0x1c, 0x1a, 0x8e, 0x3e, 0xe3, 0xaf, 0xd1, 0xa8, 0x80, 0x22, 0x86, 0x32,
+		0x4a, 0x78, 0xc5, 0xa4, 0xdb, 0x1d, 0x77, 0x26, 0xbd, 0x70, 0x68, 0xb0, 0x6b, 0x05, 0xe1, 0x9f,
+		0x50, 0xdf, 0x84, 0xc2, 0x75, 0xc6, 0xce, 0x64, 0x78, 0x79, 0xea, 0xdf, 0x6b, 0xda, 0xb7, 0x86,
+		0x42, 0xcb, 0xf7, 0xde, 0x74, 0xd0, 0xde, 0xbf, 0xe6, 0xbc, 0xa4, 0x6b, 0x6d, 0x62, 0x8a, 0x46,
+		0x49, 0x55, 0x96, 0xc0, 0x64, 0xb4, 0xe4, 0x42, 0x6a, 0x33, 0x8f, 0x28, 0x39, 0x6c, 0x44, 0x0a,
+		0xc0, 0xdf, 0xa1, 0xc3, 0x12, 0x48, 0xb2, 0x24, 0x71, 0x0e, 0x91, 0xf5, 0xd6, 0x1d, 0x3b, 0x93,
+		0x9d, 0xf0, 0xa0, 0x4d, 0x34, 0xdf, 0xc5, 0x57, 0xa8, 0x57, 0x52, 0x96, 0x7d, 0xca, 0xbc, 0xbd,
+		0xa5, 0xd0, 0xb0, 0xbd, 0xd7, 0x1d, 0xb4, 0x7f, 0xc3, 0x0b, 0x42, 0xd9, 0x35, 0x49, 0x96, 0xa0,
+		0xbd, 0xff, 0x8c, 0xbe, 0x64, 0x55, 0x11, 0xf1, 0x45, 0x44, 0x25, 0x14, 0x22, 0xa2, 0x2c, 0x4a,
+		0x54, 0x32, 0x8a, 0xeb, 0x88, 0xa6, 0xba, 0x15, 0x27, 0x3c, 0x66, 0x55, 0xf1, 0xff, 0x62, 0xa6,
+		0x08, 0x33, 0xa3, 0x9d, 0xd6, 0xb3, 0x14, 0xff, 0x86, 0xbe, 0x7a, 0x50, 0xcb, 0x48, 0x01, 0xfa,
+		0xe6, 0x9d, 0xf0, 0x8b, 0x7b, 0xd4, 0xff, 0x91, 0x02, 0xbc, 0x77, 0x1d, 0x74, 0x34, 0x5f, 0x96,
+		0x5c, 0xca, 0x1c, 0xd2, 0xe7, 0xbc, 0x5c, 0x2d, 0x72, 0x7e, 0x3b, 0xbb, 0xc1, 0xa7, 0x68, 0x98,
+		0x6a, 0x9b, 0xa6, 0x8a, 0x79, 0x5b, 0x64, 0x20, 0x25, 0x54, 0x84, 0xdb, 0x86, 0xae, 0x4c, 0x76,
+		0x0d, 0xc1, 0x42, 0xb3, 0x14, 0x7f, 0x86, 0x7a, 0x39, 0x2d, 0xa8, 0x74, 0x1d, 0x9d, 0x32, 0x81,
+		0x42, 0xcd, 0x44, 0x6c, 0x69, 0x5f, 0x26, 0xc0, 0xff, 0xa0, 0xa3, 0x9c, 0x08, 0x19, 0x49, 0xeb,
+		0x24, 0x52, 0x83, 0xe9, 0xf6, 0xf4, 0x23, 0x9e, 0xf8, 0x66, 0x6a, 0x7d, 0x3b, 0xb5, 0xfe, 0xdc,
+		0x4e, 0x6d, 0x78, 0xa8, 0x64, 0xad, 0x7f, 0x85, 0x7b, 0xaf, 0xba, 0x68, 0xd7, 0x36, 0xf2, 0x4c,
+		0x90, 0x0c, 0xf0, 0x19, 0xda, 0x6d, 0xad, 0xca, 0x7a, 0x6d, 0xbb, 0x19, 0x59, 0x70, 0x5e, 0xaf,
+		0x01, 0x7f, 0x83, 0xf6, 0x52, 0x48, 0xa8, 0xa0, 0x9c, 0x45, 0x92, 0x88, 0x95, 0x68, 0x6e, 0x6e,
+		0xd7, 0xa2, 0x73, 0x05, 0x2a, 0x1a, 0x49, 0x24, 0xdd, 0x50, 0x59, 0x37, 0x34, 0xc7, 0xd0, 0x2c,
+		0x6a, 0x68, 0xe7, 0xe8, 0x20, 0xe7, 0x09, 0xc9, 0xa3, 0x06, 0xa6, 0x20, 0x9a, 0x8e, 0xf7, 0x35,
+		0xfe, 0x47, 0x0b, 0x63, 0x17, 0xf5, 0x05, 0xcd, 0x18, 0xc9, 0x85, 0xee, 0xd7, 0x09, 0x6d, 0x88,
+		0x3f, 0x47, 0xdb, 0xea, 0x1a, 0x4a, 0xe1, 0x6e, 0xeb, 0x44, 0x13, 0xa9, 0x7e, 0x96, 0x54, 0x48,
+		0x5e, 0xd6, 0x51, 0x5c, 0x4b, 0x10, 0x6e, 0x5f, 0xa7, 0x47, 0x0d, 0x38, 0x55, 0x98, 0xf7, 0x2b,
+		0xc2, 0x4f, 0xa0, 0x14, 0x54, 0x48, 0x35, 0x90, 0x4f, 0x41, 0x4a, 0xca, 0x32, 0x7c, 0x80, 0x9c,
+		0x15, 0xd8, 0x75, 0x56, 0x47, 0xf5, 0x20, 0x1b, 0x92, 0x57, 0xd0, 0xbc, 0xa0, 0x09, 0xbc, 0xdf,
+		0x3f, 0x50, 0xff, 0x05, 0x44, 0x56, 0x25, 0xdc, 0xa3, 0x76, 0x51, 0x1f, 0x98, 0x5a, 0x0b, 0x33,
+		0x01, 0x83, 0xd0, 0x86, 0xde, 0xdb, 0x0e, 0xda, 0xbf, 0x53, 0x42, 0x0f, 0xba, 0x8b, 0xfa, 0x31,
+		0x49, 0x56, 0xc0, 0xd2, 0xa6, 0x86, 0x0d, 0xf1, 0x9f, 0x68, 0x20, 0x8c, 0x45, 0xb3, 0x71, 0xc3,
+		0xcb, 0xf3, 0x07, 0x16, 0xea, 0xe3, 0xa6, 0xc2, 0x56, 0xaa, 0xca, 0x2c, 0x8c, 0x57, 0xbb, 0x97,
+		0x8f, 0x28, 0xd3, 0x74, 0x17, 0xb6, 0xd2, 0xe9, 0x8f, 0x2f, 0xae, 0x32, 0x2a, 0x97, 0x55, 0xec,
+		0x27, 0xbc, 0x08, 0xee, 0xfe, 0x6f, 0xbf, 0xa7, 0x69, 0x1e, 0x64, 0xdc, 0xfc, 0x40, 0xdb, 0x9f,
+		0xef, 0x2f, 0xfa, 0xb0, 0xb9, 0x88, 0xb7, 0x35, 0xfe, 0xc3, 0xfb, 0x01, 0x00, 0x19, 0xd7, 0x32,
+		0x05, 0xa4, 0x05, 0x00, 0x00,
+	},
+	// uber/cadence/admin/v1/history.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x4d, 0x4a, 0x2d,
+		0xd2, 0x4f, 0x4e, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x4f, 0x4c, 0xc9, 0xcd, 0xcc, 0xd3, 0x2f,
+		0x33, 0xd4, 0xcf, 0xc8, 0x2c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
+		0x12, 0x05, 0x29, 0xd2, 0x83, 0x2a, 0xd2, 0x03, 0x2b, 0xd2, 0x2b, 0x33, 0x54, 0xf2, 0xe4, 0x12,
+		0x0a, 0x4b, 0x2d, 0x2a, 0xce, 0xcc, 0xcf, 0xf3, 0x80, 0x28, 0xf7, 0x2c, 0x49, 0xcd, 0x15, 0x92,
+		0xe4, 0xe2, 0x48, 0x2d, 0x4b, 0xcd, 0x2b, 0x89, 0xcf, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60,
+		0x0e, 0x62, 0x07, 0xf3, 0x3d, 0x53, 0x84, 0x24, 0xb8, 0xd8, 0xcb, 0x20, 0x1a, 0x24, 0x98, 0x20,
+		0x32, 0x50, 0xae, 0x52, 0x09, 0x17, 0x1f, 0xaa, 0x51, 0x42, 0x8a, 0x5c, 0x3c, 0x49, 0x45, 0x89,
+		0x79, 0xc9, 0x19, 0xf1, 0x25, 0xf9, 0xd9, 0xa9, 0x79, 0x60, 0xa3, 0x78, 0x82, 0xb8, 0x21, 0x62,
+		0x21, 0x20, 0x21, 0x21, 0x7b, 0x2e, 0xd6, 0xcc, 0x92, 0xd4, 0xdc, 0x62, 0x09, 0x26, 0x05, 0x66,
+		0x0d, 0x6e, 0x23, 0x4d, 0x3d, 0xac, 0xce, 0xd4, 0xc3, 0x74, 0x63, 0x10, 0x44, 0x9f, 0x93, 0x79,
+		0x94, 0x69, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x72, 0x48, 0xe8,
+		0x66, 0xa6, 0xe4, 0xe8, 0xa7, 0xe7, 0xeb, 0x83, 0xfd, 0x0f, 0x0f, 0x16, 0x6b, 0x30, 0xa3, 0xcc,
+		0x30, 0x89, 0x0d, 0x2c, 0x6e, 0x0c, 0x18, 0x00, 0x44, 0x14, 0xd7, 0xd4, 0x3e, 0x01, 0x00, 0x00,
+	},
+	// uber/cadence/admin/v1/queue.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6f, 0xdb, 0xc8,
+		0x15, 0x37, 0xf5, 0xc7, 0x96, 0x9f, 0xbd, 0x36, 0x3d, 0x49, 0x1a, 0xc5, 0x89, 0x11, 0x45, 0x0b,
+		0x24, 0x82, 0x37, 0x91, 0x6a, 0x7b, 0x83, 0x2c, 0x76, 0x8b, 0xb6, 0x34, 0xc5, 0x58, 0x5c, 0xcb,
+		0x92, 0x30, 0xa4, 0x92, 0x78, 0xb1, 0x00, 0x41, 0x4b, 0x63, 0x87, 0x30, 0x45, 0x6a, 0x49, 0xca,
+		0xa9, 0x3e, 0x40, 0xb3, 0xf7, 0x6e, 0xaf, 0xbd, 0x14, 0xbd, 0x15, 0xe8, 0xa9, 0x3d, 0x14, 0xbd,
+		0x16, 0xe8, 0xa9, 0xd7, 0xf6, 0x90, 0x2f, 0xd1, 0x8f, 0x50, 0x70, 0x48, 0x4a, 0x14, 0x45, 0x59,
+		0xb4, 0xe3, 0x02, 0x3d, 0xec, 0x4d, 0x7c, 0xfc, 0xbd, 0x37, 0xbf, 0xf7, 0x67, 0xde, 0xbc, 0xa1,
+		0xe0, 0xd1, 0xe0, 0x84, 0x58, 0x95, 0x8e, 0xda, 0x25, 0x46, 0x87, 0x54, 0xd4, 0x6e, 0x4f, 0x33,
+		0x2a, 0x17, 0x3b, 0x95, 0xef, 0x06, 0x64, 0x40, 0xca, 0x7d, 0xcb, 0x74, 0x4c, 0x74, 0xc7, 0x85,
+		0x94, 0x7d, 0x48, 0x99, 0x42, 0xca, 0x17, 0x3b, 0x9b, 0x0f, 0xcf, 0x4c, 0xf3, 0x4c, 0x27, 0x15,
+		0x0a, 0x3a, 0x19, 0x9c, 0x56, 0x1c, 0xad, 0x47, 0x6c, 0x47, 0xed, 0xf5, 0x3d, 0xbd, 0xcd, 0xc2,
+		0xa4, 0xe9, 0xbe, 0xe6, 0x1a, 0xee, 0x98, 0xbd, 0x9e, 0x69, 0xf8, 0x88, 0x47, 0x71, 0x88, 0xb7,
+		0x9a, 0xed, 0x98, 0xd6, 0xd0, 0x87, 0x14, 0xe3, 0x20, 0xef, 0x4c, 0xeb, 0xfc, 0x54, 0x37, 0xdf,
+		0x79, 0x98, 0xe2, 0x87, 0x14, 0xdc, 0xe6, 0x2d, 0xd3, 0xb6, 0x79, 0x7d, 0x60, 0x3b, 0xc4, 0x92,
+		0x55, 0xfb, 0x5c, 0x34, 0x4e, 0x4d, 0x74, 0x1f, 0x96, 0xbb, 0x66, 0x4f, 0xd5, 0x0c, 0x45, 0xeb,
+		0xe6, 0x99, 0x02, 0x53, 0x5a, 0xc6, 0x39, 0x4f, 0x20, 0x76, 0x51, 0x1b, 0x50, 0x60, 0x47, 0x21,
+		0xbf, 0x22, 0x9d, 0x81, 0xa3, 0x99, 0x46, 0x3e, 0x55, 0x60, 0x4a, 0x2b, 0xbb, 0x8f, 0xcb, 0x93,
+		0x3e, 0xf7, 0xb5, 0xf2, 0xc5, 0x4e, 0xf9, 0xb5, 0x0f, 0x17, 0x02, 0x34, 0xde, 0x78, 0x17, 0x15,
+		0xa1, 0x1a, 0x2c, 0x3b, 0xaa, 0x7d, 0xae, 0x38, 0xc3, 0x3e, 0xc9, 0xa7, 0x0b, 0x4c, 0x69, 0x6d,
+		0xf7, 0xb3, 0x72, 0x6c, 0x04, 0xcb, 0x51, 0xce, 0xf2, 0xb0, 0x4f, 0x70, 0xce, 0xf1, 0x7f, 0xa1,
+		0x2d, 0x00, 0x6a, 0xc9, 0x76, 0x54, 0x87, 0xe4, 0x33, 0x05, 0xa6, 0x94, 0xc5, 0xd4, 0xb6, 0xe4,
+		0x0a, 0xd0, 0x5d, 0x58, 0xa2, 0xaf, 0xb5, 0x6e, 0x3e, 0x5b, 0x60, 0x4a, 0x69, 0xbc, 0xe8, 0x3e,
+		0x8a, 0x5d, 0x74, 0x04, 0xb7, 0x2f, 0x34, 0x5b, 0x3b, 0xd1, 0x74, 0xcd, 0x19, 0x2a, 0xa3, 0xac,
+		0xe4, 0x17, 0xa9, 0x6b, 0x9b, 0x65, 0x2f, 0x6f, 0xe5, 0x20, 0x6f, 0x65, 0x39, 0x40, 0xe0, 0x5b,
+		0x63, 0xbd, 0x91, 0xb0, 0xf8, 0x87, 0x0c, 0xfc, 0x34, 0xcc, 0x54, 0x72, 0x54, 0xcb, 0xe1, 0xdf,
+		0x6a, 0x7a, 0x77, 0x1c, 0x07, 0xf2, 0xdd, 0x80, 0xd8, 0x0e, 0xe7, 0x38, 0x96, 0x76, 0x32, 0x70,
+		0x88, 0x8d, 0x4a, 0xc0, 0x3a, 0xaa, 0x75, 0x46, 0x1c, 0x25, 0x9a, 0x80, 0x35, 0x4f, 0x5e, 0x0d,
+		0xd2, 0xb0, 0x05, 0x60, 0x79, 0xea, 0x2e, 0x26, 0x45, 0x31, 0xcb, 0xbe, 0x44, 0xec, 0xa2, 0xa7,
+		0x80, 0x34, 0x43, 0x73, 0x34, 0xd5, 0x21, 0x5d, 0x85, 0x5c, 0x10, 0x83, 0xc2, 0xd2, 0xd4, 0x61,
+		0x76, 0xf4, 0x46, 0x70, 0x5f, 0x88, 0x5d, 0xf4, 0x9e, 0x81, 0xcd, 0x28, 0x5c, 0x1d, 0xb1, 0xa2,
+		0x31, 0x5c, 0xd9, 0xad, 0xc5, 0x26, 0x77, 0xec, 0xd6, 0x54, 0x9a, 0xc5, 0x89, 0x65, 0xc6, 0x5e,
+		0xe2, 0xbc, 0x36, 0xe3, 0x0d, 0x2a, 0xc2, 0x27, 0xbe, 0xff, 0xd6, 0xc0, 0x08, 0x52, 0xb4, 0x8c,
+		0x57, 0x3c, 0x21, 0x1e, 0xb8, 0x9e, 0x7f, 0xcf, 0x00, 0xdb, 0x57, 0x2d, 0x47, 0x73, 0xd7, 0x50,
+		0x3a, 0xa6, 0x71, 0xaa, 0x9d, 0xe5, 0x17, 0x0b, 0xe9, 0xd2, 0xca, 0xee, 0xb7, 0x09, 0x2a, 0x26,
+		0x49, 0x1e, 0xca, 0xad, 0xc0, 0x3e, 0x4f, 0xcd, 0x0b, 0x86, 0x63, 0x0d, 0xf1, 0x7a, 0x7f, 0x52,
+		0xba, 0xb9, 0x0f, 0xb7, 0xe3, 0x80, 0x88, 0x85, 0xf4, 0x39, 0x19, 0xfa, 0x89, 0x73, 0x7f, 0xa2,
+		0xdb, 0x90, 0xbd, 0x50, 0xf5, 0x01, 0xf1, 0x13, 0xe5, 0x3d, 0x7c, 0x99, 0xfa, 0x82, 0x29, 0x7e,
+		0x0d, 0x3b, 0x73, 0xd9, 0xd9, 0x7d, 0xd3, 0xb0, 0x49, 0x28, 0x4c, 0x77, 0x60, 0xd1, 0x8f, 0x8f,
+		0xb7, 0x46, 0xd6, 0x72, 0x23, 0x53, 0xfc, 0x6b, 0x0a, 0x9e, 0x86, 0x8d, 0xf1, 0xaa, 0xd1, 0x21,
+		0xfa, 0x8d, 0x94, 0xdb, 0x09, 0xdc, 0xf3, 0x91, 0x1f, 0xbd, 0xf9, 0xef, 0x7a, 0x86, 0xa6, 0x5e,
+		0x44, 0x4a, 0x3a, 0x9d, 0xac, 0xa4, 0x33, 0x33, 0x4a, 0xba, 0x0c, 0xb7, 0x3a, 0x6e, 0x18, 0xc7,
+		0x7c, 0x4d, 0x43, 0x1f, 0xd2, 0x7a, 0xca, 0xe1, 0x8d, 0x4e, 0xb8, 0x60, 0x9b, 0x86, 0x3e, 0x2c,
+		0x56, 0xe0, 0xd9, 0xa5, 0xa1, 0x8b, 0xe6, 0xa0, 0xf8, 0x97, 0xf4, 0x64, 0xb0, 0x25, 0xed, 0xcc,
+		0x50, 0x7f, 0x0c, 0x76, 0x92, 0x60, 0xa3, 0x87, 0xb0, 0x62, 0xd3, 0x70, 0x29, 0x86, 0xda, 0x23,
+		0xb4, 0xc3, 0x2e, 0x63, 0xf0, 0x44, 0x0d, 0xb5, 0x47, 0xd0, 0x2f, 0x60, 0xd5, 0x07, 0x68, 0x46,
+		0x7f, 0xe0, 0xe4, 0x97, 0xa8, 0xd3, 0x0f, 0x62, 0x9d, 0x6e, 0xa9, 0x43, 0xdd, 0x54, 0xbb, 0xd8,
+		0x37, 0x29, 0xba, 0x0a, 0x28, 0x0f, 0x4b, 0x1d, 0xd3, 0x70, 0x2c, 0x53, 0xcf, 0xe7, 0x0a, 0x4c,
+		0x69, 0x15, 0x07, 0x8f, 0xd1, 0x44, 0x4f, 0xa5, 0x6d, 0x2a, 0xd1, 0xff, 0x4c, 0x01, 0x17, 0xd6,
+		0xc0, 0xa4, 0x63, 0x5a, 0xdd, 0xf8, 0x96, 0xc7, 0x9b, 0xbd, 0xbe, 0x4e, 0x1c, 0xf2, 0xff, 0x9e,
+		0xfd, 0xab, 0x1d, 0x0f, 0x75, 0x60, 0x3b, 0x9e, 0x63, 0x6e, 0xc7, 0xa5, 0x70, 0xff, 0x4c, 0x78,
+		0x14, 0x4b, 0xa4, 0xe6, 0x8d, 0x22, 0x54, 0x1d, 0xaf, 0x8f, 0x55, 0xa9, 0xa0, 0x58, 0x85, 0xfd,
+		0xab, 0x87, 0x73, 0x2a, 0x2b, 0xff, 0x61, 0xa0, 0xc0, 0xf5, 0xfb, 0xfa, 0xb0, 0xa5, 0x5a, 0xc4,
+		0x70, 0x78, 0xdd, 0xb4, 0x49, 0xcb, 0xd4, 0xb5, 0xce, 0x30, 0x14, 0xf4, 0xc7, 0xb0, 0xee, 0xd5,
+		0x65, 0x34, 0xe6, 0x9f, 0x50, 0xf1, 0x28, 0xe4, 0xdb, 0xb0, 0x11, 0xa9, 0xdf, 0xd1, 0x99, 0xba,
+		0x3e, 0x51, 0xbd, 0x62, 0x17, 0x15, 0x60, 0xd5, 0xc3, 0xfa, 0x1d, 0xd8, 0xdb, 0x3a, 0x40, 0x65,
+		0xde, 0x01, 0xf5, 0x0a, 0x6e, 0xf5, 0x29, 0x29, 0xa5, 0xe3, 0xb2, 0x52, 0xfa, 0x94, 0x16, 0x8d,
+		0xd8, 0xda, 0x8c, 0xd4, 0x4d, 0x39, 0x81, 0x37, 0xfa, 0x51, 0x51, 0xf1, 0x07, 0x06, 0x1e, 0xc4,
+		0xbb, 0xec, 0x4e, 0x36, 0x03, 0x1b, 0x3d, 0x80, 0x65, 0x3f, 0xd8, 0xc4, 0x73, 0x34, 0x87, 0xc7,
+		0x02, 0xd4, 0x86, 0xd5, 0x53, 0x55, 0xd3, 0x49, 0x57, 0xe9, 0xa8, 0x03, 0xdb, 0x3b, 0x8a, 0xd6,
+		0x76, 0x77, 0x13, 0x0e, 0x59, 0x2f, 0xa9, 0x2a, 0xef, 0x6a, 0xe2, 0x95, 0xd3, 0xf1, 0x43, 0xf1,
+		0x6f, 0x0c, 0x6c, 0xc5, 0xb3, 0xf2, 0x37, 0x01, 0x3a, 0x82, 0x2c, 0x8d, 0x0e, 0xa5, 0xb4, 0xb2,
+		0xfb, 0x62, 0xc6, 0x8a, 0xf3, 0xb2, 0x89, 0x3d, 0x2b, 0xe8, 0x10, 0x16, 0x6d, 0xea, 0xaf, 0xbf,
+		0x19, 0xf6, 0xae, 0x64, 0xcf, 0x0b, 0x15, 0xf6, 0x4d, 0x14, 0xbf, 0x67, 0x60, 0x2f, 0xec, 0xea,
+		0xa5, 0x9e, 0x84, 0x2a, 0xab, 0x05, 0x39, 0xca, 0xc6, 0x22, 0x46, 0x9e, 0xa1, 0xb3, 0xc7, 0xe7,
+		0x57, 0xa2, 0xe1, 0x5b, 0xc4, 0x23, 0x2b, 0xc5, 0xbf, 0xcf, 0xcc, 0x2e, 0x26, 0xf6, 0x40, 0xbf,
+		0xf1, 0x30, 0xfe, 0x8f, 0xca, 0xe1, 0xb7, 0x0c, 0x7c, 0x9e, 0x24, 0xa0, 0x53, 0x33, 0xcd, 0xb7,
+		0xfe, 0x5e, 0xb5, 0x88, 0xa1, 0xf8, 0xf9, 0xf5, 0x02, 0xbb, 0x77, 0xc5, 0xc0, 0xba, 0xc1, 0xc2,
+		0x6b, 0x81, 0x2d, 0x2f, 0xdf, 0xc5, 0xdf, 0x2d, 0xc1, 0xdd, 0xa8, 0x0f, 0x41, 0x7d, 0x06, 0x57,
+		0x0f, 0xcd, 0x38, 0x35, 0xfd, 0xe0, 0x26, 0xbd, 0x7a, 0xb8, 0xd7, 0x25, 0xef, 0xea, 0xe1, 0xfe,
+		0x42, 0xbf, 0x61, 0xa0, 0x60, 0xbb, 0x13, 0x9c, 0xe2, 0xb5, 0x88, 0x51, 0xdf, 0x0e, 0x4f, 0xd3,
+		0x5e, 0xd5, 0x1e, 0xdc, 0xd0, 0xa8, 0x5a, 0x5b, 0xc0, 0x5b, 0xf6, 0x34, 0x2e, 0x14, 0xd8, 0x5f,
+		0x33, 0x70, 0xbf, 0x43, 0xc7, 0x99, 0x78, 0x3e, 0x69, 0xca, 0x87, 0x4f, 0xc0, 0x67, 0xde, 0x3c,
+		0x59, 0x5b, 0xc0, 0xf7, 0x3a, 0x93, 0x98, 0x08, 0x0f, 0xff, 0x50, 0x8f, 0xe5, 0x91, 0x49, 0xcc,
+		0x63, 0xde, 0xa8, 0xe5, 0xf2, 0xb0, 0x27, 0x31, 0x21, 0x1e, 0xff, 0x62, 0xe0, 0x2b, 0x8b, 0x1e,
+		0x3a, 0x4a, 0xa4, 0xe9, 0x8f, 0x69, 0x05, 0x5d, 0x53, 0x09, 0x66, 0xa4, 0x10, 0xcf, 0x2c, 0xe5,
+		0xf9, 0x26, 0x01, 0xcf, 0x6b, 0x4d, 0x0a, 0xb5, 0x05, 0xfc, 0xdc, 0xba, 0x8e, 0x22, 0xfa, 0x23,
+		0x03, 0x4f, 0x55, 0x77, 0x53, 0x28, 0x31, 0xc7, 0x4f, 0x9c, 0x27, 0xde, 0xcd, 0xf6, 0xeb, 0x04,
+		0x9e, 0x24, 0x6c, 0x8b, 0xb5, 0x05, 0xfc, 0x44, 0x4d, 0x06, 0xdd, 0x5f, 0x05, 0x18, 0x53, 0x29,
+		0xfe, 0x39, 0x07, 0xf9, 0xe9, 0xfd, 0xe9, 0x35, 0x89, 0xf0, 0x95, 0x9d, 0x99, 0xb8, 0xb2, 0x4f,
+		0x7c, 0x34, 0x48, 0xdd, 0xdc, 0x47, 0x83, 0x74, 0xf4, 0xa3, 0x41, 0xb4, 0x59, 0x66, 0x6e, 0xa4,
+		0x59, 0xa2, 0x1f, 0x92, 0xf4, 0x8b, 0x6c, 0xec, 0xed, 0x3b, 0x71, 0xbf, 0x88, 0x36, 0xda, 0xf9,
+		0x0d, 0xe3, 0xfd, 0x9c, 0x86, 0xe1, 0x95, 0x4d, 0xf5, 0x3a, 0x0d, 0x23, 0x86, 0xcc, 0x25, 0x1d,
+		0xe3, 0xfd, 0x9c, 0x8e, 0xb1, 0x94, 0x98, 0xc8, 0xdc, 0x29, 0xff, 0xf2, 0x96, 0xf1, 0xef, 0x8f,
+		0x6c, 0x19, 0x39, 0x4a, 0xf4, 0xf8, 0xc6, 0x5a, 0x46, 0x0c, 0xfb, 0x6b, 0xf6, 0x8c, 0x3f, 0x31,
+		0xf0, 0xec, 0xb2, 0x9e, 0xe1, 0xad, 0x14, 0xf6, 0x65, 0x99, 0xfa, 0x72, 0xf8, 0x11, 0x4d, 0x23,
+		0x86, 0x7d, 0x49, 0x4d, 0x88, 0x8d, 0xb4, 0x0d, 0x33, 0xae, 0x6b, 0x50, 0x1f, 0x6d, 0x24, 0xb9,
+		0xdf, 0x92, 0xec, 0xf3, 0x20, 0x1f, 0xc1, 0x38, 0x51, 0x4e, 0xb8, 0x69, 0x83, 0x09, 0x6d, 0xd5,
+		0x19, 0x3f, 0xd8, 0xdb, 0xef, 0x19, 0xc8, 0x05, 0xed, 0x03, 0xdd, 0x81, 0x0d, 0x99, 0x93, 0x0e,
+		0x15, 0xf9, 0xb8, 0x25, 0x28, 0x62, 0xe3, 0x15, 0x57, 0x17, 0xab, 0xec, 0x02, 0xfa, 0x09, 0xa0,
+		0xb1, 0x58, 0xc6, 0x5c, 0x43, 0x7a, 0x29, 0x60, 0x96, 0x41, 0xb7, 0x60, 0x3d, 0x24, 0x17, 0x8f,
+		0x04, 0xcc, 0xa6, 0xd0, 0x3d, 0xb8, 0x33, 0x16, 0x62, 0xa1, 0x55, 0x17, 0x79, 0x4e, 0x16, 0x9b,
+		0x0d, 0x36, 0x8d, 0xee, 0xc3, 0xdd, 0xf1, 0x2b, 0x1e, 0x37, 0x25, 0x49, 0xe1, 0xeb, 0x6d, 0x49,
+		0x16, 0x30, 0x9b, 0xd9, 0xfe, 0x47, 0xcc, 0xc7, 0x5b, 0x4a, 0xea, 0x53, 0x78, 0x38, 0x81, 0x55,
+		0xe2, 0x28, 0xee, 0xc0, 0xb3, 0x59, 0x20, 0x49, 0xe6, 0xb0, 0xac, 0xf0, 0x35, 0xb1, 0x5e, 0x55,
+		0x84, 0x37, 0x02, 0xdf, 0xa6, 0x6c, 0x18, 0xf4, 0x14, 0x4a, 0xb3, 0x54, 0x78, 0xae, 0xc1, 0x0b,
+		0xf5, 0x10, 0x3a, 0x75, 0x19, 0x5a, 0x12, 0x0f, 0x1a, 0x5c, 0x18, 0x9d, 0x46, 0x55, 0xf8, 0xe5,
+		0x2c, 0x34, 0x16, 0xf8, 0x26, 0xae, 0xfa, 0x7c, 0x5e, 0x37, 0xf1, 0xe1, 0x61, 0xbd, 0xf9, 0x7a,
+		0xac, 0xac, 0xf0, 0xcd, 0xa3, 0x56, 0x5d, 0x90, 0x05, 0x36, 0x83, 0x9e, 0xc3, 0xce, 0x2c, 0x2b,
+		0x5c, 0xab, 0x55, 0x3f, 0x56, 0x5a, 0x1c, 0x16, 0x1a, 0xb2, 0xc2, 0xd7, 0x9b, 0x92, 0xa0, 0xb4,
+		0x9a, 0x75, 0x91, 0x3f, 0x66, 0xb3, 0xdb, 0xbf, 0x4f, 0xc3, 0xfd, 0x4b, 0x3a, 0x36, 0xfa, 0x0c,
+		0x9e, 0xc4, 0x98, 0x7d, 0xc9, 0x89, 0x75, 0xa1, 0xaa, 0xf0, 0x5c, 0x5b, 0x0a, 0x07, 0x36, 0x9e,
+		0xc3, 0x04, 0xb8, 0xda, 0x3c, 0xe2, 0xc4, 0x86, 0xd2, 0x68, 0xca, 0x0a, 0xc7, 0xcb, 0xe2, 0x2b,
+		0x81, 0x65, 0xae, 0xa8, 0x26, 0xbc, 0x11, 0x25, 0x59, 0x62, 0x53, 0xe8, 0x67, 0xf0, 0xc5, 0x3c,
+		0x35, 0x37, 0x64, 0x2f, 0xdd, 0x90, 0x71, 0x75, 0x2c, 0x70, 0xd5, 0x63, 0x05, 0xb7, 0x1b, 0x0d,
+		0xb1, 0x71, 0xc0, 0xa6, 0xd1, 0x0b, 0xd8, 0x4b, 0xac, 0x1d, 0x5a, 0x36, 0x83, 0x7e, 0x0e, 0x5f,
+		0x5e, 0x79, 0xd9, 0x20, 0x4f, 0x55, 0x36, 0x3b, 0xa3, 0xfa, 0x26, 0xf4, 0xdb, 0x0d, 0x9e, 0x93,
+		0x85, 0x83, 0x26, 0x16, 0xbf, 0x11, 0xaa, 0xec, 0xe2, 0xf6, 0x07, 0x06, 0xd0, 0x01, 0x71, 0xa2,
+		0xb9, 0x79, 0x04, 0x5b, 0x07, 0x82, 0x7c, 0x69, 0x46, 0x1e, 0x43, 0x31, 0x1e, 0x22, 0x09, 0xf8,
+		0x95, 0xc8, 0x0b, 0xca, 0x7e, 0x5b, 0x3a, 0x66, 0x99, 0xd9, 0xa6, 0xdc, 0x9d, 0xda, 0x6c, 0xcb,
+		0x6c, 0x0a, 0x95, 0x61, 0x7b, 0x86, 0xa9, 0x1a, 0x87, 0xab, 0x4a, 0xf3, 0x75, 0x43, 0xc0, 0x52,
+		0x4d, 0x6c, 0x29, 0xf5, 0xa6, 0x24, 0xb3, 0x69, 0xf4, 0x04, 0x3e, 0x8d, 0xc7, 0x4f, 0x7a, 0x97,
+		0xd9, 0x7f, 0xf1, 0xcd, 0xf3, 0x33, 0xcd, 0x79, 0x3b, 0x38, 0x29, 0x77, 0xcc, 0x5e, 0x25, 0xfc,
+		0xd7, 0xcd, 0x33, 0xad, 0xab, 0x57, 0xce, 0x4c, 0xef, 0xdf, 0xa2, 0xd1, 0xff, 0x4c, 0x5f, 0xd1,
+		0x1f, 0x17, 0x3b, 0x27, 0x8b, 0x54, 0xbe, 0xf7, 0xdf, 0x01, 0x00, 0x4b, 0xe2, 0x06, 0x07, 0x8f,
+		0x1a, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/history.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0xc7,
+		0x91, 0xf6, 0xec, 0x92, 0x4b, 0x6d, 0x2d, 0x45, 0x91, 0x4d, 0x8a, 0x22, 0x25, 0x4a, 0xa2, 0x56,
+		0xb2, 0x44, 0x53, 0xe4, 0x52, 0xa2, 0x64, 0xc9, 0x92, 0xfc, 0x73, 0x22, 0x45, 0x42, 0x0b, 0xf0,
+		0x24, 0xde, 0x88, 0x92, 0xef, 0x0e, 0x06, 0xf6, 0x86, 0x3b, 0x4d, 0x71, 0x8e, 0xbb, 0x3b, 0xeb,
+		0x99, 0x5e, 0xae, 0x78, 0x77, 0x7e, 0xba, 0x87, 0x00, 0x41, 0x0c, 0xc7, 0x30, 0x02, 0xc4, 0x40,
+		0x8c, 0x04, 0x01, 0xf2, 0xe3, 0x24, 0x80, 0x83, 0x04, 0x41, 0xfe, 0x5e, 0x92, 0x00, 0x81, 0x03,
+		0x24, 0x70, 0xf2, 0x94, 0x97, 0xbc, 0x06, 0x41, 0xf2, 0x96, 0x87, 0x38, 0x6f, 0x01, 0x82, 0xe9,
+		0xe9, 0xd9, 0xdd, 0x99, 0xe9, 0x9e, 0xe9, 0xd9, 0xa5, 0xec, 0x04, 0xd6, 0x1b, 0xa7, 0xb7, 0xaa,
+		0xe6, 0xeb, 0xee, 0xaa, 0xae, 0xaa, 0xae, 0x1a, 0xc2, 0xa9, 0xc6, 0x26, 0xb6, 0x16, 0xca, 0x9a,
+		0x8e, 0x6b, 0x65, 0xbc, 0xa0, 0xd5, 0x8d, 0x85, 0xdd, 0x8b, 0x0b, 0xdb, 0x86, 0x4d, 0x4c, 0x6b,
+		0xaf, 0x50, 0xb7, 0x4c, 0x62, 0xa2, 0x51, 0x87, 0xa4, 0xc0, 0x48, 0x0a, 0x5a, 0xdd, 0x28, 0xec,
+		0x5e, 0x3c, 0x7a, 0xe2, 0xa1, 0x69, 0x3e, 0xac, 0xe0, 0x05, 0x4a, 0xb2, 0xd9, 0xd8, 0x5a, 0xd0,
+		0x1b, 0x96, 0x46, 0x0c, 0xb3, 0xe6, 0x32, 0x1d, 0x3d, 0x19, 0xfc, 0x9d, 0x18, 0x55, 0x6c, 0x13,
+		0xad, 0x5a, 0x67, 0x04, 0xd3, 0xbc, 0x17, 0x97, 0xcd, 0x6a, 0xb5, 0x25, 0x22, 0xcf, 0xa3, 0x20,
+		0x9a, 0xbd, 0x53, 0x31, 0x6c, 0x12, 0x45, 0xd3, 0x34, 0xad, 0x9d, 0xad, 0x8a, 0xd9, 0x74, 0x69,
+		0xf2, 0xb7, 0x60, 0xe0, 0xb6, 0x3b, 0x21, 0x74, 0x0d, 0x32, 0x78, 0x17, 0xd7, 0x88, 0x3d, 0xa1,
+		0x4c, 0xa7, 0x67, 0x72, 0x8b, 0xa7, 0x0a, 0x9c, 0xb9, 0x15, 0x18, 0xf5, 0x8a, 0x43, 0xa9, 0x32,
+		0x86, 0xfc, 0xd7, 0xae, 0xc3, 0x60, 0xe7, 0x0f, 0x68, 0x12, 0x0e, 0xd0, 0x9f, 0x4a, 0x86, 0x3e,
+		0xa1, 0x4c, 0x2b, 0x33, 0x69, 0x75, 0x80, 0x3e, 0x17, 0x75, 0x74, 0x0d, 0xc0, 0xfd, 0xc9, 0x99,
+		0xf4, 0x44, 0x6a, 0x5a, 0x99, 0xc9, 0x2d, 0x1e, 0x2d, 0xb8, 0x2b, 0x52, 0xf0, 0x56, 0xa4, 0xb0,
+		0xe1, 0xad, 0x88, 0x9a, 0xa5, 0xd4, 0xce, 0x33, 0x9a, 0x80, 0x81, 0x5d, 0x6c, 0xd9, 0x86, 0x59,
+		0x9b, 0x48, 0xbb, 0x42, 0xd9, 0x23, 0x3a, 0x02, 0x03, 0xce, 0xe4, 0x9d, 0xd7, 0xf5, 0xd1, 0x5f,
+		0x32, 0xce, 0x63, 0x51, 0x47, 0x5f, 0x50, 0xe0, 0xbc, 0x37, 0xe5, 0x12, 0x7e, 0x84, 0xcb, 0x0d,
+		0x67, 0x1f, 0x4a, 0x36, 0xd1, 0x2c, 0x82, 0xf5, 0x92, 0x8b, 0x44, 0x23, 0xc4, 0x32, 0x36, 0x1b,
+		0x04, 0xdb, 0x13, 0xfd, 0x14, 0xcf, 0xf3, 0xdc, 0xa9, 0xbf, 0xcc, 0xe4, 0xac, 0x78, 0x62, 0xee,
+		0xb9, 0x52, 0xe8, 0x94, 0x6f, 0xb6, 0x64, 0xdc, 0x7e, 0x4a, 0x3d, 0xd7, 0x94, 0x23, 0x45, 0x5f,
+		0x56, 0x60, 0x9e, 0x03, 0xaf, 0x6c, 0x56, 0xeb, 0x15, 0xcc, 0x05, 0x98, 0xa1, 0x00, 0x5f, 0x94,
+		0x03, 0xb8, 0xec, 0xc9, 0x09, 0x43, 0x7c, 0xa6, 0x29, 0x4b, 0x8c, 0xde, 0x56, 0x60, 0x96, 0x03,
+		0x72, 0x4b, 0x33, 0x2a, 0x3c, 0x84, 0x03, 0x14, 0xe1, 0x0d, 0x39, 0x84, 0xab, 0x54, 0x48, 0x18,
+		0xde, 0xd9, 0xa6, 0x14, 0x25, 0xfa, 0x12, 0x7f, 0x01, 0x1d, 0xdd, 0xd2, 0x4b, 0x66, 0x83, 0x84,
+		0xe1, 0x1d, 0xa0, 0xf0, 0x5e, 0x90, 0x83, 0xe7, 0xa8, 0x9d, 0x7e, 0xb7, 0x41, 0xc2, 0x00, 0x67,
+		0x9a, 0x92, 0xb4, 0xe8, 0x2d, 0x05, 0x66, 0x74, 0x5c, 0x36, 0x6c, 0x0a, 0xcc, 0xd1, 0x52, 0xbb,
+		0xbc, 0x8d, 0xf5, 0x06, 0x77, 0xf1, 0xb2, 0x14, 0xdd, 0x35, 0x2e, 0xba, 0x5b, 0x4c, 0xc8, 0x86,
+		0x66, 0xef, 0xdc, 0xf3, 0x44, 0x84, 0x91, 0x9d, 0xd1, 0x25, 0xe8, 0xd0, 0xeb, 0x0a, 0x9c, 0x0d,
+		0xa0, 0x12, 0xd9, 0x04, 0x50, 0x4c, 0x57, 0xe3, 0x31, 0x89, 0xcc, 0x21, 0xaf, 0xc7, 0x52, 0x71,
+		0x56, 0x29, 0xc2, 0x08, 0x72, 0x92, 0xab, 0x14, 0xa1, 0xff, 0x67, 0x74, 0x09, 0x3a, 0xf4, 0x66,
+		0x08, 0x55, 0x84, 0x66, 0x0d, 0x52, 0x54, 0xcf, 0xc5, 0xa2, 0x12, 0x2b, 0xd5, 0x69, 0x3d, 0x9e,
+		0x0c, 0x7d, 0x5a, 0x81, 0xa7, 0xfd, 0x98, 0x44, 0x96, 0x78, 0x90, 0x02, 0xba, 0x12, 0x0b, 0x48,
+		0x64, 0x84, 0xa7, 0xf4, 0x38, 0x22, 0xba, 0x6d, 0x5a, 0x99, 0x18, 0xbb, 0x06, 0xd9, 0x8b, 0x55,
+		0xee, 0xa1, 0x88, 0x6d, 0xbb, 0xc9, 0x84, 0xc4, 0x29, 0xb7, 0x26, 0x41, 0x47, 0x95, 0x3b, 0x80,
+		0x4a, 0xa4, 0xdc, 0x87, 0x22, 0x94, 0xdb, 0x87, 0x49, 0xa8, 0xdc, 0x5a, 0x2c, 0x15, 0x67, 0x95,
+		0x22, 0x94, 0x7b, 0x58, 0x72, 0x95, 0xa2, 0x94, 0x5b, 0x93, 0xa0, 0xa3, 0x8a, 0xe4, 0x47, 0x25,
+		0x52, 0xa4, 0x91, 0x08, 0x45, 0xea, 0x84, 0x24, 0x54, 0x24, 0x2d, 0x8e, 0x88, 0x5a, 0x9a, 0x1f,
+		0x4c, 0x84, 0xa5, 0xa1, 0x08, 0x4b, 0xeb, 0xc4, 0x13, 0x61, 0x69, 0x5a, 0x3c, 0x19, 0x6a, 0xc2,
+		0x09, 0x07, 0x84, 0x25, 0xd6, 0x9e, 0x51, 0x0a, 0xe4, 0x02, 0x17, 0x88, 0x23, 0xd5, 0x12, 0xaa,
+		0xcd, 0x31, 0x22, 0xfe, 0x19, 0xbd, 0x0a, 0x53, 0xee, 0x8b, 0xb7, 0x0c, 0x8b, 0xf7, 0xda, 0x31,
+		0xfa, 0xda, 0x82, 0xf8, 0xb5, 0xab, 0x86, 0x15, 0x92, 0x7a, 0xfb, 0x29, 0x75, 0x92, 0x88, 0x7e,
+		0x44, 0x5f, 0x55, 0x60, 0x21, 0xa0, 0xa2, 0x5a, 0xad, 0x8c, 0x2b, 0x25, 0x0b, 0xbf, 0xda, 0xc0,
+		0x36, 0x77, 0xf6, 0x87, 0x29, 0x8c, 0x97, 0xe2, 0x35, 0x95, 0x4a, 0x52, 0x3d, 0x41, 0x61, 0x5c,
+		0xb3, 0x9a, 0x34, 0x35, 0xfa, 0xae, 0x02, 0x97, 0x19, 0x26, 0x0f, 0xa2, 0x9c, 0x12, 0x8f, 0x53,
+		0xb4, 0xcb, 0x5c, 0xb4, 0xec, 0x6d, 0xee, 0xab, 0x65, 0x34, 0xba, 0x60, 0x25, 0xe2, 0x40, 0x9f,
+		0x55, 0xe0, 0x1c, 0x6f, 0x79, 0x79, 0x40, 0x8f, 0x48, 0x6a, 0xf7, 0x32, 0x93, 0x10, 0xa3, 0xdd,
+		0x02, 0x32, 0xf4, 0x3f, 0x70, 0xd2, 0x55, 0x32, 0x31, 0x92, 0x09, 0x8a, 0xe4, 0xa2, 0x58, 0xcf,
+		0xc4, 0x10, 0xa6, 0x48, 0xc4, 0xef, 0xe8, 0x53, 0x0a, 0x9c, 0x61, 0x9b, 0xc7, 0x14, 0x5d, 0xb0,
+		0x69, 0x93, 0x14, 0xc1, 0xb3, 0x5c, 0x04, 0xae, 0x70, 0x57, 0xdf, 0x05, 0xdb, 0x34, 0x5d, 0x8e,
+		0xa1, 0x41, 0xaf, 0xc1, 0x74, 0x55, 0xb3, 0x76, 0xb0, 0x55, 0xb2, 0x70, 0xd9, 0xb4, 0x74, 0x1e,
+		0x88, 0xa3, 0x14, 0xc4, 0x22, 0x17, 0xc4, 0xbf, 0x52, 0x66, 0x95, 0xf1, 0x86, 0x11, 0x1c, 0xaf,
+		0x46, 0x11, 0xa0, 0x2f, 0x2a, 0x30, 0xc7, 0xcb, 0x4f, 0x8c, 0x87, 0x35, 0x8d, 0xbb, 0x20, 0xc7,
+		0x92, 0x84, 0xaf, 0xf7, 0x98, 0x18, 0x99, 0xf0, 0x55, 0x40, 0x8b, 0xbe, 0xa2, 0x40, 0x81, 0x83,
+		0x90, 0x60, 0xab, 0x6a, 0xd4, 0x34, 0xee, 0xb9, 0x30, 0x15, 0x71, 0x2e, 0x84, 0x43, 0xec, 0x96,
+		0x20, 0xce, 0xb9, 0xd0, 0x94, 0xa6, 0x46, 0xdf, 0x53, 0xe0, 0x32, 0x2f, 0x95, 0x8a, 0x3d, 0xc5,
+		0x8e, 0x53, 0xb4, 0xb7, 0x24, 0x33, 0xaa, 0xb8, 0xa3, 0x6c, 0xa1, 0x99, 0x8c, 0x45, 0xa4, 0x01,
+		0x62, 0xa3, 0x3c, 0x91, 0x44, 0x03, 0xc4, 0x06, 0x3a, 0xd3, 0x94, 0xa4, 0x45, 0xbf, 0x57, 0x60,
+		0x25, 0x70, 0xe2, 0xe2, 0x47, 0x04, 0x5b, 0x35, 0xad, 0x52, 0xe2, 0x20, 0x37, 0x6a, 0x06, 0x31,
+		0xf8, 0x8a, 0x71, 0x92, 0x42, 0xbf, 0x17, 0x7f, 0x04, 0xaf, 0x30, 0xf9, 0xa1, 0xf9, 0x14, 0x3d,
+		0xe1, 0xe1, 0x09, 0xbd, 0x68, 0xf5, 0x24, 0x01, 0xfd, 0x4e, 0x81, 0xa5, 0x04, 0xd3, 0x14, 0x9d,
+		0x58, 0xd3, 0x74, 0x8e, 0xeb, 0x3d, 0xcc, 0x51, 0x74, 0x98, 0xdd, 0xb0, 0xba, 0x67, 0x47, 0x1f,
+		0x28, 0xf0, 0x42, 0xd4, 0x74, 0xe2, 0xed, 0xe4, 0x14, 0x9d, 0xd8, 0x1a, 0x77, 0x62, 0x42, 0x30,
+		0xb1, 0xf6, 0x72, 0x15, 0x77, 0xc7, 0x4a, 0xe3, 0x00, 0xde, 0x3c, 0xcc, 0x1a, 0x31, 0x6a, 0x0d,
+		0xac, 0x97, 0x34, 0xbb, 0x54, 0xc3, 0xcd, 0xf0, 0x3c, 0xf2, 0x11, 0x71, 0x40, 0x18, 0x84, 0x27,
+		0xee, 0xa6, 0x7d, 0x07, 0x37, 0xc3, 0xf0, 0x0b, 0xcd, 0x44, 0x1c, 0xe8, 0x67, 0x0a, 0x5c, 0xa3,
+		0xd1, 0x64, 0xa9, 0xbc, 0x6d, 0x54, 0xf4, 0x84, 0xf6, 0x73, 0x9a, 0x42, 0xbf, 0xcd, 0x85, 0x4e,
+		0x43, 0xc9, 0x65, 0x47, 0x68, 0x12, 0xa3, 0xb9, 0x64, 0x27, 0x67, 0x43, 0x3f, 0x54, 0xe0, 0x4a,
+		0xcc, 0x24, 0x44, 0xd6, 0x71, 0x86, 0xce, 0x60, 0x25, 0xe9, 0x0c, 0x44, 0x26, 0x71, 0xc1, 0x4e,
+		0xc8, 0x83, 0xbe, 0xa5, 0xc0, 0x45, 0x21, 0x6a, 0x61, 0x9c, 0xff, 0x34, 0x85, 0x7d, 0x93, 0x1f,
+		0x86, 0x70, 0xdf, 0x2e, 0x0c, 0xfc, 0xe7, 0xca, 0x09, 0xe8, 0xd1, 0x77, 0x14, 0xb8, 0x24, 0x84,
+		0x1b, 0x91, 0x44, 0x9e, 0x8d, 0x50, 0x72, 0x3e, 0xe0, 0x88, 0x74, 0xb2, 0x50, 0x4e, 0xc4, 0x81,
+		0xde, 0x55, 0xe0, 0x42, 0x62, 0xcd, 0x38, 0x47, 0x11, 0xff, 0x4b, 0x02, 0xc4, 0x22, 0xa5, 0x38,
+		0x5f, 0x4e, 0xa0, 0x0f, 0xef, 0x29, 0xb0, 0x28, 0x5e, 0x60, 0xa1, 0x13, 0x9e, 0xa1, 0x68, 0x97,
+		0x92, 0xac, 0xaf, 0xd0, 0x13, 0xcf, 0x97, 0x93, 0x30, 0xa0, 0x6f, 0x47, 0xa9, 0x44, 0x44, 0xd2,
+		0xfc, 0x4c, 0x62, 0xc8, 0xe2, 0xf4, 0x79, 0xbe, 0x9c, 0x84, 0x81, 0xc6, 0x66, 0x62, 0xc8, 0x11,
+		0x91, 0xe4, 0x6c, 0x44, 0x6c, 0x26, 0xc0, 0x1c, 0x11, 0x4e, 0x2e, 0x94, 0x93, 0xb1, 0x50, 0xa7,
+		0xe9, 0x86, 0xe2, 0xdd, 0x46, 0x3c, 0xe7, 0x23, 0x9c, 0xa6, 0x1b, 0x71, 0x77, 0x13, 0xea, 0x5c,
+		0xb5, 0xbb, 0x63, 0x45, 0x3f, 0x57, 0xe0, 0xba, 0xc4, 0x84, 0x44, 0x36, 0x3a, 0x47, 0x67, 0x53,
+		0xec, 0x66, 0x36, 0x22, 0x63, 0xbd, 0x6c, 0x77, 0xc1, 0x87, 0x7e, 0xa0, 0xc0, 0xb3, 0x51, 0x13,
+		0x10, 0xe7, 0x4f, 0xf3, 0x11, 0x0e, 0x48, 0x08, 0x42, 0x9c, 0x47, 0x5d, 0xc0, 0x09, 0x79, 0xe8,
+		0x81, 0xd3, 0xa8, 0xdb, 0xd8, 0x22, 0x6d, 0xe0, 0x36, 0xd6, 0xac, 0xf2, 0x76, 0x07, 0xcc, 0x30,
+		0xee, 0x42, 0x84, 0xf5, 0xde, 0xa7, 0xe2, 0x3c, 0x04, 0xf7, 0xa8, 0xb0, 0xf6, 0x1b, 0x39, 0xd6,
+		0xdb, 0x48, 0xc2, 0x20, 0xaa, 0xff, 0xd4, 0xb5, 0x86, 0xcd, 0x5b, 0xe1, 0x85, 0x24, 0xf5, 0x9f,
+		0x75, 0x2a, 0x44, 0xa6, 0xfe, 0xc3, 0xa5, 0x14, 0x65, 0x4f, 0x8d, 0x9a, 0x08, 0xdd, 0x85, 0x24,
+		0xd9, 0xd3, 0xfd, 0x5a, 0x9d, 0xf7, 0x56, 0x6e, 0xf6, 0x24, 0xa0, 0x5d, 0x1a, 0x04, 0x68, 0xbf,
+		0x3e, 0xff, 0xc6, 0x10, 0x9c, 0x93, 0xf5, 0xfd, 0xab, 0x70, 0xb0, 0x35, 0x35, 0xb2, 0x57, 0xc7,
+		0xb4, 0x92, 0x2a, 0xaa, 0xcb, 0x7a, 0x42, 0x37, 0xf6, 0xea, 0x58, 0x1d, 0x6c, 0x76, 0x3c, 0xa1,
+		0x57, 0xe0, 0x70, 0x5d, 0xb3, 0x9c, 0x75, 0xe8, 0x3c, 0xb2, 0xb6, 0x4c, 0x56, 0x7c, 0x9d, 0xe1,
+		0xca, 0x5b, 0xa7, 0x1c, 0x1d, 0x27, 0xca, 0x96, 0xa9, 0x8e, 0xd6, 0xc3, 0x83, 0xe8, 0x3a, 0x64,
+		0xe9, 0x7d, 0x56, 0xc5, 0xb0, 0x09, 0x2d, 0xcb, 0xe6, 0x16, 0x8f, 0xf3, 0x2f, 0x8c, 0x34, 0x7b,
+		0x67, 0xcd, 0xb0, 0x89, 0x7a, 0x80, 0xb0, 0xbf, 0xd0, 0x22, 0xf4, 0x1b, 0xb5, 0x7a, 0x83, 0xd0,
+		0xa2, 0x6d, 0x6e, 0x71, 0x4a, 0x80, 0x64, 0xaf, 0x62, 0x6a, 0xba, 0xea, 0x92, 0x22, 0x0d, 0xa6,
+		0x03, 0x01, 0x5b, 0x89, 0x98, 0xa5, 0x72, 0xc5, 0xb4, 0x31, 0xf5, 0x7e, 0x66, 0x83, 0xb0, 0x2a,
+		0xee, 0x64, 0xa8, 0xaa, 0x7c, 0x8b, 0xd5, 0xe1, 0xd5, 0x29, 0xec, 0x5b, 0xfb, 0x0d, 0x73, 0xd9,
+		0xe1, 0xdf, 0x70, 0xd9, 0xd1, 0xcb, 0x70, 0xac, 0x5d, 0x34, 0x08, 0x4b, 0xcf, 0xc4, 0x49, 0x3f,
+		0x42, 0xbc, 0x52, 0x40, 0x40, 0xf0, 0x0d, 0x38, 0xda, 0xce, 0x4f, 0xda, 0xb3, 0xb0, 0x1a, 0x35,
+		0xa7, 0x72, 0xed, 0x14, 0x4e, 0xb3, 0xea, 0x91, 0x16, 0x45, 0x6b, 0x9d, 0xd5, 0x46, 0xad, 0xa8,
+		0xa3, 0x22, 0x64, 0x99, 0xa3, 0x31, 0x2d, 0x5a, 0xc5, 0x1c, 0x5a, 0x3c, 0xcf, 0x77, 0x8c, 0x4c,
+		0x00, 0x4d, 0x40, 0x8a, 0x1e, 0x8b, 0xda, 0xe6, 0x46, 0x45, 0x18, 0x69, 0xe3, 0x70, 0x0e, 0xfb,
+		0x86, 0x85, 0x27, 0xb2, 0x11, 0x7b, 0xb0, 0xea, 0xd2, 0xa8, 0xc3, 0x2d, 0x36, 0x36, 0x82, 0x54,
+		0x18, 0xaf, 0x68, 0x4e, 0xc6, 0xec, 0x06, 0x83, 0x74, 0x3a, 0xd8, 0x6e, 0x54, 0xc8, 0x04, 0x44,
+		0xc8, 0xf3, 0xf6, 0x74, 0xcc, 0xe1, 0x5d, 0x6e, 0xb1, 0xaa, 0x94, 0x13, 0x5d, 0x83, 0x49, 0xd3,
+		0x32, 0x1e, 0x1a, 0xae, 0x9b, 0x0a, 0xac, 0x52, 0x8e, 0xae, 0xd2, 0xb8, 0x47, 0x10, 0x58, 0xa4,
+		0xa3, 0x70, 0xc0, 0xd0, 0x71, 0x8d, 0x18, 0x64, 0x8f, 0xd6, 0xe3, 0xb2, 0x6a, 0xeb, 0x19, 0x5d,
+		0x82, 0xf1, 0x2d, 0xc3, 0xb2, 0x49, 0x58, 0xe6, 0x41, 0x4a, 0x39, 0x4a, 0x7f, 0x0d, 0x08, 0x5c,
+		0x86, 0x41, 0x0b, 0x13, 0x6b, 0xaf, 0x54, 0x37, 0x2b, 0x46, 0x79, 0x8f, 0xd5, 0xb0, 0xa6, 0x05,
+		0xe9, 0x3d, 0xb1, 0xf6, 0xd6, 0x29, 0x9d, 0x9a, 0xb3, 0xda, 0x0f, 0x4e, 0xe3, 0x82, 0x46, 0x08,
+		0xae, 0xd6, 0x09, 0xad, 0x37, 0xf5, 0xab, 0xde, 0x23, 0x5a, 0x86, 0x43, 0xf8, 0x51, 0xdd, 0x70,
+		0x15, 0xc7, 0x6d, 0x89, 0x18, 0x8e, 0x6d, 0x89, 0x18, 0x6a, 0xb3, 0x38, 0x83, 0xe8, 0x34, 0x1c,
+		0x2c, 0x5b, 0x8e, 0x35, 0xb0, 0x7a, 0x18, 0xad, 0xd7, 0x64, 0xd5, 0x41, 0x67, 0xd0, 0xab, 0x91,
+		0xa1, 0x7f, 0x87, 0x63, 0xee, 0xec, 0xfd, 0xb5, 0xc3, 0x4d, 0xad, 0xbc, 0x63, 0x6e, 0x6d, 0x4d,
+		0xa0, 0x38, 0xa5, 0x9e, 0xa0, 0xdc, 0x9d, 0x65, 0xc3, 0x25, 0x97, 0x15, 0xcd, 0x43, 0x5f, 0x15,
+		0x57, 0x4d, 0x56, 0x0c, 0x99, 0xe4, 0x5f, 0x93, 0xe2, 0xaa, 0xa9, 0x52, 0x32, 0xa4, 0xc2, 0x48,
+		0xc8, 0xdf, 0xb1, 0x8a, 0xc6, 0xd3, 0xfc, 0xc8, 0x22, 0xe0, 0x9f, 0xd4, 0x61, 0x3b, 0x30, 0x82,
+		0xee, 0xc3, 0x78, 0xdd, 0xc2, 0xbb, 0x25, 0xad, 0x41, 0x4c, 0x47, 0xff, 0x30, 0x29, 0xd5, 0x4d,
+		0xa3, 0x46, 0xbc, 0x1a, 0x85, 0x68, 0xbf, 0x6c, 0x4c, 0xd6, 0x29, 0x9d, 0x3a, 0xea, 0xf0, 0xdf,
+		0x6c, 0x10, 0xb3, 0x63, 0x10, 0x5d, 0x82, 0xcc, 0x36, 0xd6, 0x74, 0x6c, 0xb1, 0xe2, 0xc1, 0x31,
+		0x7e, 0x4b, 0x0c, 0x25, 0x51, 0x19, 0x29, 0x5a, 0x83, 0x31, 0x77, 0xa1, 0xdb, 0x95, 0x50, 0xba,
+		0xaf, 0x47, 0x62, 0xf7, 0x15, 0x51, 0xbe, 0x56, 0x55, 0x93, 0xee, 0xed, 0xff, 0xc1, 0x70, 0x5d,
+		0xb3, 0x88, 0xe1, 0x5d, 0x6e, 0x6c, 0x19, 0x0f, 0x27, 0x26, 0x68, 0x7f, 0xce, 0xbf, 0xf5, 0xd2,
+		0xa4, 0x52, 0x58, 0xf7, 0x84, 0x2e, 0x53, 0x99, 0x2b, 0x35, 0x62, 0xed, 0xa9, 0x87, 0xea, 0xfe,
+		0x51, 0x74, 0x1c, 0xc0, 0xbb, 0x12, 0x33, 0x74, 0x7a, 0x19, 0x9f, 0x55, 0xb3, 0x6c, 0xa4, 0xa8,
+		0x1f, 0x5d, 0x82, 0x31, 0x9e, 0x1c, 0x34, 0x0c, 0xe9, 0x1d, 0xbc, 0x47, 0xfd, 0x55, 0x56, 0x75,
+		0xfe, 0x44, 0x63, 0xd0, 0xbf, 0xab, 0x55, 0x1a, 0x6e, 0xc3, 0x4f, 0x56, 0x75, 0x1f, 0xae, 0xa7,
+		0x9e, 0x53, 0xf2, 0xef, 0x2a, 0xf0, 0x8c, 0x7c, 0x6a, 0x79, 0x19, 0x32, 0xec, 0x78, 0x51, 0x24,
+		0x8e, 0x17, 0x46, 0x8b, 0x56, 0x61, 0x3a, 0xba, 0xb7, 0xc0, 0xd0, 0x29, 0xb0, 0xb4, 0x3a, 0x25,
+		0x6e, 0x0b, 0x28, 0xea, 0xf9, 0xaf, 0x2b, 0x70, 0x56, 0x32, 0x42, 0xbd, 0x02, 0x03, 0xde, 0xc1,
+		0xaa, 0x48, 0x1c, 0xac, 0x1e, 0xf1, 0xbe, 0x41, 0x35, 0x61, 0x46, 0x3a, 0x3d, 0x5b, 0x86, 0x41,
+		0xe6, 0xdb, 0xda, 0x71, 0xc6, 0x90, 0xc0, 0x66, 0x98, 0x2b, 0xa3, 0x61, 0x46, 0x8e, 0xb4, 0x1f,
+		0xf2, 0xbf, 0x52, 0xe0, 0x8c, 0x4c, 0x87, 0x8a, 0x3f, 0x60, 0x50, 0x92, 0x05, 0x0c, 0x77, 0x60,
+		0x5c, 0xe0, 0x94, 0x53, 0x71, 0xe7, 0xd7, 0xa8, 0xcd, 0x71, 0xc8, 0x1d, 0x07, 0x73, 0xda, 0x77,
+		0x30, 0xe7, 0x5f, 0x57, 0x20, 0x1f, 0xdf, 0xdc, 0x82, 0xe6, 0x00, 0x05, 0x1b, 0x1e, 0x5a, 0x2d,
+		0x6f, 0xc3, 0xb6, 0x6f, 0x09, 0x02, 0xde, 0x29, 0x15, 0xf0, 0x4e, 0x7e, 0x53, 0x4b, 0x07, 0x4c,
+		0x2d, 0xff, 0xe7, 0xc0, 0xf2, 0x0a, 0x2d, 0x24, 0x19, 0xa2, 0x19, 0x18, 0xf6, 0x5f, 0x7a, 0xb5,
+		0xd4, 0x6b, 0xc8, 0xee, 0x98, 0x71, 0x00, 0x7b, 0x3a, 0x80, 0xfd, 0x1c, 0x1c, 0xda, 0x34, 0x6a,
+		0x9a, 0xb5, 0x57, 0x2a, 0x6f, 0xe3, 0xf2, 0x8e, 0xdd, 0xa8, 0xd2, 0x88, 0x2e, 0xab, 0x0e, 0xb9,
+		0xc3, 0xcb, 0x6c, 0x14, 0x9d, 0x87, 0x11, 0xff, 0x55, 0x2d, 0x7e, 0xe4, 0x46, 0x6b, 0x83, 0xea,
+		0x30, 0xee, 0xbc, 0x41, 0xc5, 0x8f, 0x48, 0xfe, 0x9b, 0x69, 0x38, 0x2d, 0xd1, 0x37, 0xf3, 0xd8,
+		0x66, 0x1c, 0x34, 0x8b, 0x74, 0x17, 0x66, 0x81, 0x4e, 0x40, 0x6e, 0x53, 0xb3, 0xb1, 0x17, 0x69,
+		0xb8, 0xcb, 0x92, 0x75, 0x86, 0xdc, 0xf8, 0x62, 0x0a, 0xc0, 0xb9, 0xa5, 0x66, 0x3f, 0xf7, 0xbb,
+		0x0b, 0x5b, 0xc3, 0x4d, 0xf7, 0xd7, 0x39, 0x40, 0x5b, 0xa6, 0xb5, 0xc3, 0x90, 0x7a, 0xcd, 0x8f,
+		0x19, 0x77, 0x6a, 0xce, 0x2f, 0x14, 0xeb, 0x03, 0x77, 0x1c, 0x8d, 0x3b, 0x87, 0xa3, 0x66, 0x9b,
+		0x35, 0x16, 0x4a, 0xb2, 0x27, 0x74, 0x0b, 0xfa, 0xcb, 0x4e, 0x6e, 0xc2, 0xa2, 0xc6, 0x82, 0x74,
+		0x87, 0xd2, 0xb2, 0xc3, 0xa5, 0xba, 0xcc, 0x01, 0x05, 0xcd, 0x06, 0x15, 0xf4, 0xfd, 0x34, 0x9c,
+		0x8a, 0x6d, 0x2a, 0x7a, 0x6c, 0x7b, 0xb5, 0xe4, 0x4d, 0xd1, 0xdd, 0xa4, 0x39, 0xc9, 0x9e, 0x27,
+		0xdf, 0x04, 0x3b, 0x8e, 0xec, 0xbe, 0x24, 0x47, 0x76, 0xa7, 0x65, 0xf4, 0x07, 0x2c, 0x23, 0xb0,
+		0xfd, 0x99, 0xe8, 0xed, 0x1f, 0x90, 0xda, 0xfe, 0x03, 0x82, 0xed, 0xe7, 0x58, 0x61, 0x96, 0x6b,
+		0x85, 0xfe, 0x9d, 0x84, 0xe0, 0x4e, 0xbe, 0x93, 0x81, 0x33, 0x32, 0xed, 0x58, 0xe8, 0x24, 0xe4,
+		0x5a, 0x3d, 0x0d, 0x6c, 0x17, 0xb3, 0x2a, 0x78, 0x43, 0x45, 0xdd, 0xc9, 0x60, 0x5b, 0x04, 0xd4,
+		0x84, 0x52, 0x11, 0x19, 0x6c, 0xeb, 0x95, 0x34, 0x83, 0xd5, 0x3a, 0x9e, 0x1c, 0xc5, 0xd6, 0xcd,
+		0xaa, 0x66, 0xd4, 0xd8, 0xc9, 0xc3, 0x9e, 0xfc, 0xae, 0xa4, 0xaf, 0xcb, 0xdc, 0x33, 0x23, 0x9f,
+		0x7b, 0x6e, 0xc0, 0xa4, 0xa7, 0xa3, 0x61, 0x0f, 0x34, 0x10, 0xe7, 0x81, 0xc6, 0x3d, 0xde, 0x80,
+		0x13, 0x0a, 0x48, 0x65, 0x0e, 0x8e, 0x49, 0x3d, 0x90, 0x40, 0xaa, 0x9b, 0x72, 0x32, 0xa9, 0x62,
+		0x57, 0x99, 0xed, 0xca, 0x55, 0xae, 0xc2, 0xc8, 0x36, 0xd6, 0x2c, 0xb2, 0x89, 0xb5, 0x36, 0x3a,
+		0x88, 0x13, 0x35, 0xdc, 0xe2, 0x69, 0xcb, 0x89, 0x0f, 0x70, 0x72, 0xf1, 0x01, 0x4e, 0x28, 0x31,
+		0x1b, 0xec, 0x26, 0x31, 0x6b, 0x07, 0xf8, 0x07, 0xa5, 0x03, 0xfc, 0xfc, 0x1f, 0x15, 0xc8, 0xc7,
+		0xb7, 0x06, 0x7e, 0x64, 0xa1, 0x41, 0x67, 0x10, 0xd3, 0xe7, 0xcf, 0x2e, 0x5f, 0x82, 0x41, 0x9a,
+		0x9c, 0x7b, 0xc7, 0x5a, 0xbf, 0xc4, 0xb1, 0x96, 0x73, 0x38, 0xd8, 0x43, 0xfe, 0x37, 0x8a, 0xff,
+		0x28, 0xd8, 0xe7, 0xb8, 0x9c, 0xbf, 0x44, 0xa9, 0x04, 0xde, 0x20, 0x1d, 0x1b, 0xab, 0xf4, 0xf9,
+		0x17, 0x33, 0xff, 0x6b, 0x05, 0x4e, 0xc5, 0xf7, 0x6b, 0x75, 0x1b, 0xbe, 0x7f, 0x1c, 0x33, 0xfa,
+		0x71, 0x0a, 0x4e, 0x4b, 0x74, 0x3d, 0x3a, 0x73, 0xd2, 0x31, 0xd1, 0x8c, 0x8a, 0x2d, 0xb5, 0x49,
+		0x1e, 0xf1, 0x63, 0x9b, 0x53, 0x30, 0xbe, 0xea, 0xeb, 0x26, 0xbe, 0xea, 0x59, 0xc5, 0x3f, 0xa7,
+		0xc0, 0xac, 0x7c, 0xb3, 0xa2, 0x8c, 0xcf, 0xdb, 0x9f, 0x04, 0xee, 0x3d, 0x05, 0x12, 0xb6, 0x25,
+		0xc6, 0x63, 0x1b, 0xf3, 0xa2, 0x24, 0x96, 0x85, 0xd3, 0x07, 0x29, 0xc4, 0x69, 0x09, 0xc4, 0x6f,
+		0x07, 0xf4, 0x50, 0x54, 0xc0, 0xec, 0x56, 0x0f, 0x57, 0x61, 0xba, 0xa2, 0x91, 0x8e, 0xf6, 0x9c,
+		0x60, 0xb3, 0x4a, 0x7b, 0x65, 0x5d, 0x3a, 0xde, 0x56, 0xba, 0x51, 0x15, 0x47, 0x9f, 0xd3, 0x09,
+		0xf4, 0xb9, 0x2f, 0xd6, 0x46, 0x03, 0x71, 0x60, 0xfe, 0x03, 0x05, 0x8e, 0x45, 0x34, 0x04, 0x3b,
+		0x1f, 0x4c, 0xb9, 0x8d, 0x90, 0xad, 0x7d, 0x1b, 0xa0, 0xcf, 0x45, 0x1d, 0xad, 0xc1, 0xe1, 0x96,
+		0x23, 0xdf, 0x32, 0xac, 0x04, 0x29, 0x2f, 0x62, 0x7e, 0xdc, 0x69, 0xf8, 0x4d, 0xe2, 0x7e, 0x65,
+		0x36, 0xfb, 0xbf, 0x60, 0x52, 0xd8, 0x69, 0x1c, 0x35, 0x1b, 0xe9, 0x90, 0x3e, 0xff, 0xbe, 0x02,
+		0x53, 0x51, 0x4d, 0xa6, 0xfb, 0xf2, 0x96, 0xfd, 0x5a, 0x8f, 0xc8, 0x03, 0xfa, 0xfb, 0x0a, 0x4c,
+		0xc7, 0x35, 0xab, 0x46, 0xcd, 0xe6, 0xb1, 0x9a, 0x6d, 0x24, 0xf2, 0xbf, 0x0d, 0x40, 0xc2, 0x9e,
+		0x28, 0xb4, 0x00, 0x63, 0xb4, 0xed, 0x2a, 0x78, 0xc7, 0xee, 0xce, 0x69, 0xa4, 0x86, 0x9b, 0x81,
+		0x1b, 0xf6, 0x50, 0x99, 0x2b, 0xd5, 0x5d, 0x99, 0xeb, 0x49, 0x21, 0x4a, 0xbe, 0x10, 0x25, 0xa3,
+		0x3b, 0x03, 0x12, 0xba, 0x73, 0x17, 0xc6, 0x59, 0x01, 0x81, 0x61, 0x34, 0x6a, 0x04, 0x5b, 0xbb,
+		0x5a, 0x25, 0x3e, 0x6f, 0x19, 0x63, 0x8c, 0x14, 0x5e, 0x91, 0xb1, 0xf9, 0x8b, 0x5c, 0xd9, 0x9e,
+		0x8a, 0x5c, 0x1d, 0x21, 0x1c, 0x24, 0x09, 0xe1, 0xc4, 0x15, 0xad, 0x5c, 0xd7, 0x15, 0xad, 0x76,
+		0x9e, 0x31, 0x28, 0x5f, 0x48, 0xf0, 0xea, 0x2a, 0x07, 0x7b, 0xa8, 0xab, 0x0c, 0xf5, 0x54, 0x57,
+		0xc9, 0xff, 0x41, 0x81, 0x85, 0xa4, 0x8d, 0x99, 0xad, 0xd3, 0x4a, 0xe9, 0x3c, 0xad, 0xa2, 0xf2,
+		0x9b, 0x4d, 0x38, 0xd2, 0x6a, 0xe6, 0x08, 0x94, 0xa8, 0x5d, 0x3b, 0x9e, 0x8d, 0x6c, 0xd7, 0xf0,
+		0x17, 0xa9, 0x0f, 0x63, 0xde, 0x70, 0x20, 0x87, 0xea, 0x0b, 0xde, 0x79, 0x7c, 0x43, 0x81, 0x19,
+		0xc1, 0x44, 0x79, 0x85, 0xf9, 0x78, 0xeb, 0x51, 0x24, 0xac, 0xa7, 0x23, 0x10, 0x4a, 0x25, 0x08,
+		0x84, 0xf2, 0x1f, 0x2a, 0x70, 0x3c, 0xf2, 0xbb, 0x03, 0x27, 0x12, 0x64, 0x5f, 0x35, 0xd4, 0xb4,
+		0xaa, 0xb7, 0x13, 0xe0, 0x0e, 0xdd, 0xd1, 0xaa, 0xb8, 0xdb, 0x57, 0xef, 0x9b, 0xd3, 0x69, 0x1b,
+		0x44, 0x9f, 0x7c, 0xe2, 0xfd, 0x23, 0xde, 0x26, 0x89, 0xfa, 0x6c, 0x4e, 0x42, 0x8e, 0x75, 0x3a,
+		0x75, 0x2e, 0x81, 0x3b, 0x44, 0x97, 0xa0, 0x75, 0xe6, 0xa7, 0xe4, 0xcf, 0xfc, 0xa8, 0x4b, 0xf0,
+		0x18, 0x0d, 0xfb, 0xbc, 0x02, 0xb3, 0x09, 0x5a, 0xcf, 0xda, 0x77, 0xb9, 0x8a, 0xef, 0x2e, 0xb7,
+		0xdb, 0x8d, 0x8b, 0x40, 0x9e, 0xff, 0x5f, 0x4e, 0x55, 0x8b, 0xdf, 0x6e, 0x23, 0x42, 0xd5, 0x43,
+		0x61, 0xe3, 0x35, 0xce, 0x9e, 0x0a, 0x7a, 0x69, 0x1e, 0xc7, 0xeb, 0x7f, 0x9a, 0x82, 0x17, 0x7b,
+		0xfb, 0xf4, 0x60, 0xdf, 0x8e, 0x83, 0xf6, 0x2d, 0x67, 0xca, 0x77, 0xcb, 0x79, 0x1f, 0x50, 0xb8,
+		0xc5, 0x89, 0x9d, 0x8c, 0x67, 0xe5, 0x8a, 0xc0, 0xea, 0x48, 0xa8, 0x5f, 0xc9, 0xb9, 0x36, 0x2a,
+		0x9b, 0x35, 0x62, 0x99, 0x15, 0xaa, 0xac, 0x83, 0xaa, 0xf7, 0x88, 0x0a, 0x30, 0x1a, 0xe8, 0xd6,
+		0x34, 0x6b, 0x15, 0x37, 0xa7, 0x39, 0xa0, 0x8e, 0xf8, 0x9a, 0x28, 0xef, 0xd6, 0x2a, 0x7b, 0xf9,
+		0xb7, 0xd2, 0x70, 0xa3, 0x87, 0x4f, 0x1b, 0xd0, 0xfd, 0x4e, 0x8f, 0x31, 0x24, 0xf8, 0x70, 0x48,
+		0x4a, 0xb2, 0xef, 0x3e, 0x7f, 0x9f, 0x32, 0x71, 0xe1, 0xed, 0x33, 0x7f, 0x5f, 0xfa, 0x7a, 0xdd,
+		0x97, 0x39, 0x40, 0xc1, 0x86, 0x52, 0x56, 0x19, 0x4a, 0xab, 0xc3, 0x86, 0x4f, 0x09, 0xdd, 0xcb,
+		0x3f, 0x6f, 0x17, 0x33, 0xbe, 0x5d, 0xcc, 0xff, 0x56, 0x81, 0xab, 0x5d, 0x7e, 0x97, 0x21, 0xc0,
+		0xa0, 0x08, 0x30, 0x7c, 0xb4, 0x8a, 0x9b, 0x7f, 0x23, 0x0d, 0x57, 0xbb, 0xec, 0x9d, 0xfd, 0x67,
+		0xb5, 0xd5, 0x80, 0x33, 0xeb, 0x13, 0x3b, 0xb3, 0x7e, 0x79, 0x67, 0x26, 0x54, 0x1d, 0xd1, 0x01,
+		0x30, 0x20, 0x3a, 0x00, 0x3e, 0x93, 0x86, 0xcb, 0xdd, 0xf4, 0xff, 0xca, 0x59, 0xbe, 0x94, 0xe4,
+		0x27, 0x96, 0xdf, 0xb6, 0xfc, 0x3f, 0x29, 0x70, 0x21, 0x69, 0x2f, 0xf3, 0x3f, 0xb4, 0xc9, 0x8b,
+		0x7d, 0x55, 0xfe, 0x97, 0x0a, 0xcc, 0x27, 0xea, 0x7f, 0xde, 0xb7, 0x23, 0x80, 0x9b, 0x6f, 0xa5,
+		0x7a, 0xcb, 0xb7, 0xde, 0xc9, 0xc2, 0xa5, 0x2e, 0x3e, 0xe4, 0xea, 0xd8, 0x0e, 0xc5, 0xb7, 0x1d,
+		0x27, 0x21, 0xd7, 0xda, 0x0e, 0xa6, 0xf3, 0x59, 0x15, 0xbc, 0x21, 0xde, 0xe5, 0x4b, 0x7a, 0x1f,
+		0x2e, 0x5f, 0xba, 0xad, 0xc4, 0xf6, 0xef, 0xef, 0xe5, 0x4b, 0xe6, 0xb1, 0x5e, 0xbe, 0x0c, 0x74,
+		0x7d, 0xf9, 0xf2, 0x00, 0x58, 0x23, 0x35, 0x93, 0xc8, 0x0a, 0x98, 0x6e, 0x73, 0xc6, 0xd9, 0x88,
+		0x6e, 0x6c, 0x2a, 0x85, 0x95, 0x31, 0x47, 0xea, 0xc1, 0xa1, 0x4e, 0x23, 0xc9, 0xfa, 0xcf, 0x73,
+		0x19, 0x95, 0x07, 0x09, 0x95, 0x2f, 0xc3, 0x44, 0x87, 0x3a, 0x95, 0x2c, 0xdc, 0x68, 0xc3, 0xcf,
+		0x51, 0xf8, 0xb3, 0x91, 0x8a, 0x53, 0xd4, 0x55, 0xdc, 0xf0, 0xf0, 0xaa, 0x87, 0x9b, 0xbc, 0xe1,
+		0x50, 0x61, 0xf7, 0x60, 0x37, 0x85, 0xdd, 0x50, 0x4b, 0xec, 0x10, 0xa7, 0x25, 0xb6, 0x9d, 0x84,
+		0x1e, 0x4a, 0x7e, 0x2b, 0x33, 0xdc, 0xc3, 0xad, 0xcc, 0x48, 0x6f, 0xdd, 0xae, 0xd7, 0x21, 0xa7,
+		0xe3, 0x8a, 0xb6, 0xe7, 0xaa, 0x66, 0x7c, 0xeb, 0x2e, 0x50, 0x6a, 0xaa, 0x8a, 0xe8, 0x79, 0x18,
+		0xfc, 0x6f, 0x83, 0x10, 0xef, 0x9f, 0x9a, 0x4c, 0x8c, 0xc6, 0x31, 0xe7, 0x5c, 0x72, 0xca, 0x9d,
+		0x7f, 0x33, 0x0d, 0x17, 0x92, 0x7e, 0xa6, 0xf9, 0xf1, 0x1f, 0x4e, 0x6b, 0x5e, 0x94, 0xe1, 0x56,
+		0x18, 0xaf, 0x24, 0xfe, 0xc6, 0xd0, 0x17, 0x5c, 0x74, 0x98, 0x59, 0xbf, 0xdf, 0xcc, 0xf8, 0x2e,
+		0x34, 0x23, 0x70, 0xa1, 0xfb, 0x74, 0x07, 0x9b, 0xff, 0x45, 0x0a, 0xe6, 0x92, 0x7c, 0x83, 0x2a,
+		0xdc, 0x0f, 0xbe, 0xef, 0x4e, 0xf5, 0xea, 0xbb, 0xf7, 0x6b, 0x17, 0xf9, 0xab, 0xdb, 0x27, 0x58,
+		0xdd, 0xb6, 0x6d, 0xf7, 0xcb, 0x5f, 0x30, 0x7d, 0x98, 0x82, 0x84, 0x5f, 0xc7, 0x7e, 0x32, 0x16,
+		0x93, 0x57, 0x4e, 0xeb, 0xe7, 0x96, 0xd3, 0xda, 0x7d, 0x20, 0x19, 0xf9, 0x3e, 0x90, 0xfc, 0x5f,
+		0x52, 0x70, 0x7e, 0x3f, 0x4e, 0x94, 0x4f, 0xe8, 0xa2, 0x77, 0x54, 0x3a, 0x32, 0x09, 0x2a, 0x1d,
+		0xf9, 0xbf, 0xa6, 0x60, 0x3e, 0xd1, 0xc7, 0xca, 0x4f, 0x16, 0x3e, 0xb4, 0xf0, 0xde, 0x65, 0x6c,
+		0x26, 0xc9, 0x05, 0xfe, 0xff, 0xa7, 0x45, 0x0b, 0x2f, 0xea, 0xdd, 0x79, 0xb2, 0xf0, 0x91, 0xad,
+		0x43, 0x99, 0x6e, 0xbe, 0x58, 0xf8, 0x49, 0x0a, 0x16, 0x12, 0x7e, 0x44, 0xfe, 0x64, 0x1f, 0x7c,
+		0xfb, 0x30, 0x4b, 0xe0, 0x10, 0xfd, 0x73, 0xd5, 0xa8, 0x10, 0x6c, 0xd1, 0x57, 0x1d, 0x87, 0xc9,
+		0x95, 0x07, 0x2b, 0x77, 0x36, 0x4a, 0xab, 0xc5, 0xb5, 0x8d, 0x15, 0xb5, 0xb4, 0xf1, 0x1f, 0xeb,
+		0x2b, 0xa5, 0xe2, 0x9d, 0x07, 0x37, 0xd7, 0x8a, 0xb7, 0x86, 0x9f, 0x42, 0x27, 0xe1, 0x58, 0xf8,
+		0xe7, 0x9b, 0x6b, 0x6b, 0x25, 0x3a, 0x3a, 0xac, 0xa0, 0x53, 0x70, 0x3c, 0x4c, 0xb0, 0xbc, 0x76,
+		0xf7, 0xde, 0x0a, 0x23, 0x49, 0x2d, 0xbd, 0x02, 0x47, 0xca, 0x66, 0x95, 0xb7, 0x06, 0x4b, 0xde,
+		0xbf, 0x21, 0x5e, 0xb7, 0x4c, 0x62, 0xae, 0x2b, 0xff, 0x79, 0xf1, 0xa1, 0x41, 0xb6, 0x1b, 0x9b,
+		0x85, 0xb2, 0x59, 0x5d, 0xe8, 0xfc, 0x77, 0xc8, 0xf3, 0x86, 0x5e, 0x59, 0x78, 0x68, 0xba, 0xff,
+		0x82, 0x99, 0xfd, 0x6f, 0xe4, 0x1b, 0x5a, 0xdd, 0xd8, 0xbd, 0xb8, 0x99, 0xa1, 0x63, 0x97, 0xfe,
+		0x3e, 0x00, 0xf6, 0x06, 0x03, 0xae, 0xfe, 0x59, 0x00, 0x00,
+	},
+	// uber/cadence/admin/v1/replication.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x5b, 0x73, 0xe2, 0xc8,
+		0x15, 0x5e, 0x81, 0x01, 0x73, 0xc0, 0xc0, 0xb4, 0xbd, 0x63, 0x8d, 0x67, 0x5c, 0xc3, 0xb0, 0x73,
+		0xf5, 0x56, 0x20, 0xe3, 0xcd, 0xe6, 0x5a, 0xa9, 0x2d, 0x0d, 0xe0, 0x58, 0x19, 0x5f, 0x1b, 0xc6,
+		0x9b, 0x49, 0x55, 0x4a, 0x25, 0x4b, 0x6d, 0xa3, 0x32, 0x48, 0x54, 0x77, 0x83, 0x97, 0xb7, 0xe4,
+		0x3d, 0x55, 0x79, 0xc9, 0x5b, 0x1e, 0xf3, 0x13, 0xf2, 0x9a, 0x1f, 0x91, 0x7f, 0x31, 0x7f, 0x23,
+		0xa5, 0xee, 0x16, 0x20, 0x6e, 0xe3, 0xc4, 0x0f, 0xfb, 0x86, 0xce, 0xf9, 0xce, 0x45, 0xa7, 0x4f,
+		0x7f, 0xe7, 0x08, 0x78, 0x35, 0xb8, 0x24, 0xb4, 0xe6, 0xd8, 0x2e, 0xf1, 0x1d, 0x52, 0xb3, 0xdd,
+		0x9e, 0xe7, 0xd7, 0x86, 0x6f, 0x6b, 0x94, 0xf4, 0xbb, 0x9e, 0x63, 0x73, 0x2f, 0xf0, 0xab, 0x7d,
+		0x1a, 0xf0, 0x00, 0x7d, 0x19, 0x02, 0xab, 0x0a, 0x58, 0x15, 0xc0, 0xea, 0xf0, 0xed, 0xce, 0xd3,
+		0xeb, 0x20, 0xb8, 0xee, 0x92, 0x9a, 0x00, 0x5d, 0x0e, 0xae, 0x6a, 0xdc, 0xeb, 0x11, 0xc6, 0xed,
+		0x5e, 0x5f, 0xda, 0xed, 0x94, 0xe3, 0x01, 0xfa, 0x5e, 0xe8, 0xde, 0x09, 0x7a, 0xbd, 0xc0, 0x5f,
+		0x85, 0x70, 0x83, 0x9e, 0xed, 0x45, 0x88, 0xaf, 0x16, 0x27, 0xd9, 0xf1, 0x18, 0x0f, 0xe8, 0x48,
+		0x82, 0x2a, 0x7f, 0x4f, 0xc0, 0x26, 0x9e, 0xa4, 0x7d, 0x4c, 0x18, 0xb3, 0xaf, 0x09, 0x43, 0x2d,
+		0x78, 0x30, 0xf5, 0x36, 0x16, 0xb7, 0xd9, 0x0d, 0xd3, 0xb5, 0x72, 0xf2, 0x75, 0x6e, 0xff, 0x65,
+		0x75, 0xe1, 0x4b, 0x55, 0xa7, 0xdc, 0xb4, 0x6d, 0x76, 0x83, 0x4b, 0x34, 0x2e, 0x60, 0xe8, 0x57,
+		0xf0, 0xa8, 0x6b, 0x33, 0x6e, 0x51, 0xc2, 0xa9, 0x47, 0x86, 0xc4, 0xb5, 0x7a, 0x32, 0x9e, 0xe5,
+		0xb9, 0x7a, 0xa2, 0xac, 0xbd, 0x4e, 0xe2, 0x87, 0x21, 0x00, 0x47, 0x7a, 0x95, 0x8e, 0xe9, 0xa2,
+		0x47, 0xb0, 0xde, 0xb1, 0x99, 0xd5, 0x0b, 0x28, 0xd1, 0x93, 0x65, 0xed, 0xf5, 0x3a, 0xce, 0x74,
+		0x6c, 0x76, 0x1c, 0x50, 0x82, 0x30, 0x3c, 0x60, 0x23, 0xdf, 0xb1, 0x58, 0xc7, 0xa6, 0xae, 0xc5,
+		0xb8, 0xcd, 0x07, 0x4c, 0x5f, 0x2b, 0x6b, 0x2b, 0x52, 0x6d, 0x8d, 0x7c, 0xa7, 0x15, 0xc2, 0x5b,
+		0x02, 0x8d, 0x8b, 0x2c, 0x2e, 0xa8, 0xfc, 0x2d, 0x0d, 0xc5, 0x99, 0xf7, 0x41, 0xbf, 0x83, 0x6c,
+		0x58, 0x06, 0x8b, 0x8f, 0xfa, 0x44, 0xd7, 0xca, 0xda, 0xeb, 0xc2, 0xfe, 0xde, 0xdd, 0x4a, 0xd1,
+		0x1e, 0xf5, 0x09, 0x5e, 0xe7, 0xea, 0x17, 0x7a, 0x0e, 0x05, 0x16, 0x0c, 0xa8, 0x43, 0x44, 0x59,
+		0x27, 0xef, 0x9e, 0x97, 0xd2, 0xd0, 0xc2, 0x74, 0xd1, 0x77, 0xb0, 0xe1, 0x50, 0xa2, 0xca, 0xef,
+		0xf5, 0xe4, 0x6b, 0xe7, 0xf6, 0x77, 0xaa, 0xb2, 0x77, 0xaa, 0x51, 0xef, 0x54, 0xdb, 0x51, 0xef,
+		0xe0, 0x7c, 0x64, 0x10, 0x8a, 0x90, 0x03, 0x0f, 0x65, 0x3f, 0xc8, 0x30, 0x36, 0xe7, 0xd4, 0xbb,
+		0x1c, 0x70, 0x12, 0x15, 0xe7, 0xeb, 0x25, 0xc9, 0x37, 0x84, 0x51, 0x98, 0x85, 0x31, 0x36, 0x39,
+		0xfc, 0x02, 0x6f, 0xb9, 0x0b, 0xe4, 0xe8, 0xcf, 0x1a, 0x3c, 0x9b, 0xab, 0xfe, 0x5c, 0xc0, 0x94,
+		0x08, 0xf8, 0xb3, 0xbb, 0x9d, 0xc6, 0x5c, 0xe4, 0x5d, 0xb6, 0x0a, 0x80, 0x86, 0x20, 0x00, 0x96,
+		0xed, 0x70, 0x6f, 0xe8, 0xf1, 0xd1, 0x5c, 0xf4, 0xb4, 0x88, 0xfe, 0x76, 0x45, 0x74, 0x43, 0x99,
+		0xce, 0x85, 0xde, 0x61, 0x4b, 0xb5, 0xa8, 0x07, 0x3b, 0xea, 0x2e, 0xc9, 0x88, 0xc3, 0xfd, 0xe9,
+		0xa0, 0x19, 0x11, 0xb4, 0xba, 0x24, 0xe8, 0xa1, 0x34, 0x0c, 0x3d, 0x5e, 0xec, 0xc7, 0x22, 0x6e,
+		0x77, 0x16, 0xab, 0x50, 0x00, 0x3b, 0x57, 0xb6, 0xd7, 0x0d, 0x86, 0x84, 0x5a, 0x3d, 0x9b, 0xde,
+		0x10, 0x3a, 0x1d, 0x6e, 0x5d, 0x84, 0xab, 0x2d, 0x09, 0x77, 0xa0, 0x0c, 0x8f, 0x85, 0x5d, 0x2c,
+		0x9e, 0x7e, 0xb5, 0x44, 0xf7, 0x2e, 0x0f, 0x30, 0x09, 0x50, 0xf9, 0x77, 0x02, 0xb6, 0x16, 0x75,
+		0x06, 0x3a, 0x87, 0x92, 0x6a, 0xb3, 0xa0, 0x4f, 0xa8, 0x68, 0x3f, 0x75, 0x3b, 0x5e, 0xae, 0x6c,
+		0xb0, 0xd3, 0x08, 0x8d, 0x8b, 0x6e, 0x5c, 0x80, 0x0a, 0x90, 0x50, 0x97, 0x22, 0x8b, 0x13, 0x9e,
+		0x8b, 0xbe, 0x81, 0xb4, 0x84, 0xa8, 0x3b, 0xf0, 0x78, 0xc6, 0x71, 0xdf, 0x9b, 0xb8, 0xc5, 0x0a,
+		0x8a, 0x5e, 0x40, 0xc1, 0x09, 0xfc, 0x2b, 0xef, 0xda, 0x1a, 0x12, 0xca, 0xc2, 0xac, 0xd6, 0xc4,
+		0x2d, 0xdb, 0x90, 0xd2, 0x0b, 0x29, 0x44, 0x6f, 0xa0, 0x34, 0x2e, 0x6b, 0x04, 0x4c, 0x09, 0x60,
+		0x31, 0x92, 0x47, 0xd0, 0x5f, 0xc3, 0xa3, 0x3e, 0x25, 0x43, 0x2f, 0x18, 0x30, 0x6b, 0xce, 0x26,
+		0x2d, 0x6c, 0xb6, 0x23, 0xc0, 0x41, 0xdc, 0xb6, 0xf2, 0x0f, 0x0d, 0x76, 0x57, 0xf6, 0x79, 0x98,
+		0xaf, 0x62, 0x05, 0xa7, 0x3b, 0x60, 0x9c, 0x50, 0x51, 0xc5, 0x2c, 0xde, 0x90, 0xd2, 0xba, 0x14,
+		0x86, 0x44, 0x28, 0xaf, 0x9a, 0xaa, 0x50, 0x0a, 0x67, 0xc4, 0xb3, 0xe9, 0xa2, 0x5f, 0x42, 0x76,
+		0x3c, 0x47, 0xee, 0xc0, 0x16, 0x13, 0x70, 0xe5, 0x53, 0x0a, 0x76, 0x96, 0xdf, 0x03, 0xf4, 0x18,
+		0xb2, 0xea, 0x88, 0x3d, 0x57, 0x65, 0xb5, 0x2e, 0x05, 0xa6, 0x8b, 0x3e, 0x00, 0xba, 0x0d, 0xe8,
+		0xcd, 0x55, 0x37, 0xb8, 0xb5, 0xc8, 0x0f, 0xc4, 0x19, 0x88, 0x0e, 0x48, 0x2c, 0xe4, 0x5f, 0x79,
+		0x50, 0xdf, 0x2b, 0x78, 0x33, 0x42, 0xe3, 0x07, 0xb7, 0xb3, 0x22, 0xa4, 0x43, 0x26, 0x2a, 0x6d,
+		0x52, 0x94, 0x36, 0x7a, 0x44, 0xcf, 0x20, 0xcf, 0x9c, 0x0e, 0x71, 0x07, 0x5d, 0x22, 0xaa, 0x20,
+		0x8f, 0x35, 0x37, 0x96, 0x99, 0x2e, 0x32, 0xa0, 0x30, 0x81, 0x08, 0xf2, 0x4c, 0x7d, 0xb6, 0x1c,
+		0x1b, 0x63, 0x8b, 0x50, 0x86, 0x76, 0x01, 0x18, 0xb7, 0x29, 0x97, 0x31, 0xe4, 0xe9, 0x66, 0x95,
+		0xc4, 0x74, 0xd1, 0x6f, 0x21, 0x1f, 0xa9, 0x85, 0xff, 0xcc, 0x67, 0xfd, 0xe7, 0x14, 0x5e, 0x78,
+		0xff, 0x3d, 0x6c, 0x8a, 0x49, 0xd8, 0x21, 0x36, 0xe5, 0x97, 0xc4, 0xe6, 0xd2, 0xcb, 0xfa, 0x67,
+		0xbd, 0x3c, 0x08, 0xcd, 0x0e, 0x23, 0x2b, 0xe1, 0xeb, 0xe7, 0x90, 0x71, 0x09, 0xb7, 0xbd, 0x2e,
+		0xd3, 0xb3, 0xc2, 0xfe, 0xc9, 0xc2, 0xaa, 0x9f, 0xd9, 0xa3, 0x6e, 0x60, 0xbb, 0x38, 0x02, 0x87,
+		0x15, 0xb6, 0x39, 0x27, 0xbd, 0x3e, 0xd7, 0x41, 0x36, 0x92, 0x7a, 0x44, 0xdf, 0x41, 0x5e, 0x64,
+		0x17, 0x36, 0xf9, 0x80, 0x12, 0x3d, 0xb7, 0xc2, 0xed, 0x81, 0xc4, 0xe0, 0x5c, 0x68, 0xa1, 0x1e,
+		0xd0, 0x4f, 0x61, 0x4b, 0x38, 0x08, 0x8f, 0x95, 0x50, 0xcb, 0x73, 0x89, 0xcf, 0x3d, 0x3e, 0xd2,
+		0xf3, 0xa2, 0x77, 0x50, 0xa8, 0xfb, 0x5e, 0xa8, 0x4c, 0xa5, 0x41, 0x27, 0x50, 0x54, 0xe7, 0x6b,
+		0x29, 0x02, 0xd4, 0x37, 0x44, 0xd4, 0x17, 0x4b, 0x48, 0x44, 0x5d, 0x2c, 0x45, 0xa4, 0xb8, 0x30,
+		0x8c, 0x3d, 0xa3, 0x87, 0x90, 0xee, 0xdb, 0x03, 0x46, 0x5c, 0xbd, 0x20, 0xb6, 0x05, 0xf5, 0x54,
+		0xf9, 0x4b, 0x12, 0xb6, 0x97, 0x90, 0x2f, 0xda, 0x86, 0x4c, 0x34, 0x90, 0x35, 0x71, 0xde, 0x69,
+		0x2e, 0x47, 0x71, 0xac, 0xff, 0x13, 0x77, 0xea, 0xff, 0xe4, 0x7d, 0xfb, 0xff, 0x4f, 0xf0, 0xe5,
+		0x4c, 0x41, 0x2c, 0x8f, 0x93, 0x5e, 0x38, 0xbc, 0xc3, 0x25, 0xec, 0xcd, 0x9d, 0xca, 0x62, 0x72,
+		0xd2, 0xc3, 0x9b, 0xc3, 0x39, 0x19, 0x43, 0xdf, 0x42, 0x9a, 0x0c, 0x89, 0xcf, 0xa3, 0xd9, 0xbc,
+		0xbb, 0x98, 0x52, 0x6d, 0x6e, 0xbf, 0xeb, 0x06, 0x97, 0x58, 0x81, 0x51, 0x1d, 0x0a, 0x3e, 0xb9,
+		0xb5, 0xe8, 0xc0, 0xb7, 0x94, 0x79, 0xfa, 0x2e, 0xe6, 0x79, 0x9f, 0xdc, 0xe2, 0x81, 0xdf, 0x14,
+		0x26, 0x95, 0x7f, 0x6a, 0xa0, 0x2f, 0x9b, 0x48, 0xab, 0xb9, 0x66, 0x11, 0x59, 0x27, 0x16, 0x93,
+		0xf5, 0x7d, 0xd7, 0xa7, 0xca, 0x5f, 0x35, 0xd8, 0x8c, 0x67, 0xd9, 0x0e, 0x6e, 0x88, 0x1f, 0x26,
+		0x18, 0x11, 0xb0, 0xdc, 0x88, 0x53, 0x78, 0x5d, 0x31, 0x30, 0x43, 0x7f, 0x80, 0xe2, 0xcc, 0x90,
+		0xd6, 0x13, 0xff, 0xd7, 0x64, 0xc6, 0x85, 0xf8, 0x5c, 0xae, 0x7c, 0x8a, 0x2f, 0xea, 0x62, 0x49,
+		0xf4, 0xaf, 0x82, 0x1f, 0x85, 0x9b, 0x1f, 0x4f, 0x6f, 0xc2, 0x49, 0xc1, 0x1d, 0x93, 0xed, 0x76,
+		0xea, 0x16, 0xad, 0xc5, 0x6e, 0xd1, 0x14, 0xa3, 0xa7, 0xe2, 0x8c, 0xfe, 0x1c, 0x0a, 0x57, 0x1e,
+		0x65, 0x5c, 0xf6, 0xd4, 0x84, 0x6f, 0xf3, 0x42, 0x2a, 0xba, 0xc6, 0x74, 0x51, 0x05, 0x36, 0x7c,
+		0xf2, 0xc3, 0x14, 0x28, 0x23, 0x89, 0x3f, 0x14, 0x46, 0x98, 0xd9, 0xd9, 0xb0, 0x3e, 0x3f, 0x1b,
+		0x9e, 0x42, 0x8e, 0x50, 0x1a, 0x50, 0xcb, 0xe9, 0xda, 0x4c, 0x52, 0x66, 0x16, 0x83, 0x10, 0xd5,
+		0x43, 0x49, 0xd8, 0x9e, 0xa5, 0xe9, 0x4a, 0x8b, 0x53, 0x9f, 0x1e, 0xbb, 0x5a, 0x7c, 0xec, 0xde,
+		0xe3, 0xab, 0x26, 0x32, 0xed, 0xd3, 0xc0, 0x21, 0x8c, 0xc5, 0x4d, 0x93, 0x13, 0xd3, 0xb3, 0x48,
+		0x3f, 0x36, 0xad, 0xbc, 0x87, 0xe2, 0xcc, 0x3e, 0x11, 0x9f, 0xff, 0xda, 0xff, 0x32, 0xff, 0x7d,
+		0xd8, 0x52, 0xec, 0xd0, 0x38, 0x3a, 0xaf, 0x07, 0x03, 0x9f, 0x37, 0x7d, 0x4e, 0x47, 0x68, 0x0b,
+		0x52, 0x4e, 0xf8, 0xa4, 0xf8, 0x50, 0x3e, 0xac, 0x5a, 0x41, 0xe6, 0x97, 0x98, 0xe4, 0x82, 0x25,
+		0xa6, 0xf2, 0x2f, 0x0d, 0xb2, 0x8d, 0xa3, 0xf3, 0x03, 0xaf, 0x1b, 0xae, 0x34, 0x2b, 0x5b, 0xf8,
+		0x29, 0xe4, 0xc6, 0x2d, 0x3c, 0x66, 0x5f, 0x88, 0x44, 0xa6, 0x1b, 0xff, 0x2c, 0x4b, 0xde, 0xe3,
+		0xb3, 0x6c, 0xa6, 0x31, 0xd6, 0x66, 0x1b, 0x63, 0xef, 0x3f, 0xf3, 0x57, 0x50, 0x18, 0x3e, 0x83,
+		0x5d, 0xdc, 0x3c, 0x3b, 0x32, 0xeb, 0x46, 0xdb, 0x3c, 0x3d, 0xb1, 0xda, 0x46, 0xeb, 0xbd, 0xd5,
+		0xfe, 0x78, 0xd6, 0xb4, 0xcc, 0x93, 0x0b, 0xe3, 0xc8, 0x6c, 0x94, 0xbe, 0x40, 0x65, 0x78, 0xb2,
+		0x18, 0xd2, 0x38, 0x3d, 0x36, 0xcc, 0x93, 0x92, 0xb6, 0xdc, 0xc9, 0xa1, 0xd9, 0x6a, 0x9f, 0xe2,
+		0x8f, 0xa5, 0x04, 0xfa, 0x1a, 0x5e, 0x2d, 0x86, 0xb4, 0x3e, 0x9e, 0xd4, 0xad, 0xd6, 0xa1, 0x81,
+		0x1b, 0x56, 0xab, 0x6d, 0xb4, 0x3f, 0xb4, 0x4a, 0x49, 0xf4, 0x0a, 0xbe, 0x5a, 0x01, 0x36, 0xea,
+		0x6d, 0xf3, 0xc2, 0x6c, 0x7f, 0x2c, 0xad, 0xa1, 0x3d, 0x78, 0xb9, 0x32, 0xb0, 0x75, 0xdc, 0x6c,
+		0x1b, 0x0d, 0xa3, 0x6d, 0x94, 0x52, 0xe8, 0x39, 0x94, 0x57, 0x63, 0x2f, 0xf6, 0x4b, 0x69, 0xf4,
+		0x06, 0x5e, 0x2c, 0x46, 0x1d, 0x18, 0xe6, 0xd1, 0xe9, 0x45, 0x13, 0x5b, 0xc7, 0x06, 0x7e, 0xdf,
+		0xc4, 0xa5, 0xcc, 0x9e, 0x07, 0xc5, 0x99, 0xaf, 0x01, 0xf4, 0x04, 0x74, 0x59, 0x14, 0xeb, 0xf4,
+		0xac, 0x89, 0xa5, 0x8b, 0x49, 0x21, 0x1f, 0xc3, 0xf6, 0x9c, 0xb6, 0x8e, 0x9b, 0x46, 0xbb, 0x59,
+		0xd2, 0x16, 0x2a, 0x3f, 0x9c, 0x35, 0x42, 0x65, 0x62, 0xef, 0x04, 0x32, 0x8d, 0xa3, 0x73, 0x71,
+		0x60, 0x5b, 0x50, 0x6a, 0x1c, 0x9d, 0xcf, 0x9e, 0x91, 0x0e, 0x5b, 0x63, 0xe9, 0x54, 0xfe, 0x25,
+		0x0d, 0x6d, 0x42, 0x71, 0xac, 0x51, 0x07, 0x96, 0x78, 0xf7, 0x8b, 0x3f, 0x7e, 0x7b, 0xed, 0xf1,
+		0xce, 0xe0, 0xb2, 0xea, 0x04, 0xbd, 0xda, 0xf4, 0x7f, 0x2d, 0x3f, 0xf1, 0xdc, 0x6e, 0xed, 0x3a,
+		0x90, 0xff, 0xee, 0x8c, 0xff, 0x78, 0xf9, 0x8d, 0xf8, 0x31, 0x7c, 0x7b, 0x99, 0x16, 0xf2, 0x6f,
+		0xfe, 0x3b, 0x00, 0x2a, 0x32, 0x97, 0xab, 0x45, 0x12, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/domain.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x51, 0x6f, 0xdb, 0xb6,
+		0x13, 0xff, 0xcb, 0x4e, 0xf2, 0x4f, 0x4e, 0x8e, 0xe3, 0x32, 0x4d, 0xa3, 0x78, 0xc3, 0xa2, 0xa6,
+		0x28, 0xe0, 0x15, 0x98, 0xdc, 0x78, 0xc3, 0xd6, 0x6e, 0xd8, 0x83, 0x63, 0xa9, 0x99, 0x87, 0x2c,
+		0x0b, 0x64, 0x37, 0x03, 0x96, 0x07, 0x81, 0x96, 0x68, 0x9b, 0xa8, 0x24, 0x0a, 0x94, 0xe4, 0x34,
+		0x6f, 0xc3, 0x3e, 0xd6, 0x1e, 0xf7, 0x29, 0xf6, 0x71, 0x06, 0x51, 0x94, 0x62, 0xc7, 0x42, 0xb3,
+		0x37, 0xf2, 0xee, 0xf7, 0xfb, 0xdd, 0xf1, 0x74, 0x47, 0x0a, 0xf4, 0x74, 0x42, 0x78, 0xd7, 0xc5,
+		0x1e, 0x09, 0x5d, 0xd2, 0xc5, 0x11, 0xed, 0x2e, 0x4e, 0xbb, 0x1e, 0x0b, 0x30, 0x0d, 0x8d, 0x88,
+		0xb3, 0x84, 0xa1, 0xfd, 0x0c, 0x61, 0x48, 0x84, 0x81, 0x23, 0x6a, 0x2c, 0x4e, 0xdb, 0x5f, 0xcc,
+		0x18, 0x9b, 0xf9, 0xa4, 0x2b, 0x20, 0x93, 0x74, 0xda, 0xf5, 0x52, 0x8e, 0x13, 0xca, 0x24, 0xa9,
+		0x7d, 0xfc, 0xd0, 0x9f, 0xd0, 0x80, 0xc4, 0x09, 0x0e, 0x22, 0x09, 0xa8, 0x8c, 0xeb, 0xb2, 0x20,
+		0x28, 0x24, 0x4e, 0xfe, 0xd9, 0x81, 0x2d, 0x53, 0x24, 0x82, 0x9a, 0x50, 0xa3, 0x9e, 0xa6, 0xe8,
+		0x4a, 0x67, 0xc7, 0xae, 0x51, 0x0f, 0x21, 0xd8, 0x08, 0x71, 0x40, 0xb4, 0x9a, 0xb0, 0x88, 0x35,
+		0x7a, 0x0b, 0x5b, 0x71, 0x82, 0x93, 0x34, 0xd6, 0xea, 0xba, 0xd2, 0x69, 0xf6, 0x9e, 0x1b, 0x15,
+		0x79, 0x1b, 0xb9, 0xe0, 0x48, 0x00, 0x6d, 0x49, 0x40, 0x3a, 0xa8, 0x1e, 0x89, 0x5d, 0x4e, 0xa3,
+		0xec, 0x04, 0xda, 0x86, 0x50, 0x5d, 0x36, 0xa1, 0x63, 0x50, 0xd9, 0x6d, 0x48, 0xb8, 0x43, 0x02,
+		0x4c, 0x7d, 0x6d, 0x53, 0x20, 0x40, 0x98, 0xac, 0xcc, 0x82, 0xde, 0xc2, 0x86, 0x87, 0x13, 0xac,
+		0x6d, 0xe9, 0xf5, 0x8e, 0xda, 0x7b, 0xf9, 0x89, 0xd8, 0x86, 0x89, 0x13, 0x6c, 0x85, 0x09, 0xbf,
+		0xb3, 0x05, 0x05, 0xcd, 0xe1, 0xc5, 0x2d, 0xe3, 0x1f, 0xa6, 0x3e, 0xbb, 0x75, 0xc8, 0x47, 0xe2,
+		0xa6, 0x59, 0x44, 0x87, 0x93, 0x84, 0x84, 0x62, 0x15, 0x11, 0x4e, 0x99, 0xa7, 0xfd, 0x5f, 0x57,
+		0x3a, 0x6a, 0xef, 0xc8, 0xc8, 0x0b, 0x6b, 0x14, 0x85, 0x35, 0x4c, 0x59, 0x78, 0x5b, 0x2f, 0x54,
+		0xac, 0x42, 0xc4, 0x2e, 0x34, 0xae, 0x84, 0x04, 0x1a, 0x40, 0x63, 0x82, 0x3d, 0x67, 0x42, 0x43,
+		0xcc, 0x29, 0x89, 0xb5, 0x6d, 0x21, 0xa9, 0x57, 0x26, 0x7b, 0x86, 0xbd, 0x33, 0x89, 0xb3, 0xd5,
+		0xc9, 0xfd, 0x06, 0xdd, 0xc0, 0xe1, 0x9c, 0xc6, 0x09, 0xe3, 0x77, 0x0e, 0xe6, 0xee, 0x9c, 0x2e,
+		0xb0, 0xef, 0xc8, 0xc2, 0xef, 0x88, 0xc2, 0xbf, 0xa8, 0xd4, 0xeb, 0x4b, 0xac, 0x2c, 0xfd, 0x81,
+		0xd4, 0x58, 0x35, 0xa3, 0xd7, 0xf0, 0x74, 0x4d, 0x3c, 0xe5, 0x54, 0x03, 0x51, 0x70, 0xf4, 0x80,
+		0xf4, 0x9e, 0x53, 0x84, 0xa1, 0xbd, 0xa0, 0x31, 0x9d, 0x50, 0x9f, 0x26, 0xeb, 0x19, 0xa9, 0xff,
+		0x3d, 0x23, 0xed, 0x5e, 0xe6, 0x41, 0x52, 0xdf, 0xc2, 0x61, 0x55, 0x88, 0x2c, 0xaf, 0x86, 0xc8,
+		0xeb, 0x60, 0x9d, 0x9a, 0xa5, 0x66, 0xc0, 0x3e, 0x76, 0x13, 0xba, 0x20, 0x8e, 0xeb, 0xa7, 0x71,
+		0x42, 0xb8, 0x23, 0x9a, 0x76, 0x57, 0x70, 0x9e, 0xe4, 0xae, 0x41, 0xee, 0xb9, 0xcc, 0x3a, 0xf8,
+		0x0a, 0xb6, 0x25, 0x30, 0xd6, 0x9a, 0xa2, 0x8f, 0xbe, 0xa9, 0x4c, 0x5c, 0x72, 0x6c, 0x12, 0xf9,
+		0xd4, 0x15, 0xdf, 0x7e, 0xc0, 0xc2, 0x29, 0x9d, 0x15, 0x8d, 0x50, 0xaa, 0xa0, 0x2f, 0xa1, 0x35,
+		0xc5, 0xd4, 0x67, 0x0b, 0xc2, 0x9d, 0x05, 0xe1, 0x71, 0xd6, 0xdd, 0x7b, 0xba, 0xd2, 0xa9, 0xdb,
+		0x7b, 0x85, 0xfd, 0x3a, 0x37, 0xa3, 0x0e, 0xb4, 0x68, 0xec, 0xcc, 0x7c, 0x36, 0xc1, 0xbe, 0x93,
+		0xcf, 0xbf, 0xd6, 0xd2, 0x95, 0xce, 0xb6, 0xdd, 0xa4, 0xf1, 0xb9, 0x30, 0xcb, 0x61, 0x7c, 0x07,
+		0xbb, 0xa5, 0x28, 0x0d, 0xa7, 0x4c, 0x7b, 0x22, 0xda, 0xa8, 0x7a, 0xde, 0xde, 0x49, 0xe4, 0x30,
+		0x9c, 0x32, 0xbb, 0x31, 0x5d, 0xda, 0xa1, 0x9b, 0x2c, 0x22, 0xf3, 0x45, 0xce, 0xce, 0x8c, 0xb3,
+		0x34, 0x8a, 0x35, 0x24, 0xa4, 0x5e, 0x57, 0x4a, 0x0d, 0x0b, 0xf0, 0x79, 0x86, 0x5d, 0x3d, 0xf2,
+		0x1e, 0x5d, 0x71, 0xc6, 0xc8, 0x85, 0x03, 0x1c, 0xdf, 0x85, 0xae, 0x53, 0x8e, 0x96, 0x2b, 0x08,
+		0xda, 0xbe, 0x88, 0xd0, 0xad, 0xee, 0x88, 0x8c, 0xf1, 0x9b, 0x24, 0xac, 0x06, 0xd8, 0xc7, 0xeb,
+		0xbe, 0xf6, 0x77, 0xb0, 0x53, 0x0e, 0x33, 0x6a, 0x41, 0xfd, 0x03, 0xb9, 0x93, 0x97, 0x54, 0xb6,
+		0x44, 0x4f, 0x61, 0x73, 0x81, 0xfd, 0xb4, 0xb8, 0xa6, 0xf2, 0xcd, 0xf7, 0xb5, 0x37, 0xca, 0x89,
+		0x09, 0xc7, 0x8f, 0x7c, 0x44, 0xf4, 0x1c, 0x1a, 0x2b, 0x5d, 0x93, 0xeb, 0xaa, 0xee, 0x7d, 0xbf,
+		0x9c, 0xfc, 0xa5, 0x80, 0xba, 0x34, 0xa6, 0xe8, 0x67, 0xd8, 0x2e, 0x47, 0x5b, 0x11, 0xfd, 0x63,
+		0x3c, 0x36, 0xda, 0x46, 0xb1, 0xc8, 0x2f, 0xa4, 0x92, 0xdf, 0x76, 0x60, 0x77, 0xc5, 0x55, 0x71,
+		0xbc, 0x37, 0xcb, 0xc7, 0x53, 0x7b, 0x27, 0x9f, 0x8c, 0x75, 0x27, 0x1a, 0x60, 0xa9, 0x04, 0x7f,
+		0x2a, 0xb0, 0xbb, 0xe2, 0x44, 0xcf, 0x60, 0x8b, 0x13, 0x1c, 0xb3, 0x50, 0x06, 0x91, 0x3b, 0xd4,
+		0x86, 0x6d, 0x16, 0x11, 0x8e, 0x13, 0xc6, 0x65, 0x25, 0xcb, 0x3d, 0xfa, 0x11, 0x1a, 0x2e, 0x27,
+		0x38, 0x21, 0x9e, 0x93, 0x3d, 0x30, 0xe2, 0xea, 0x57, 0x7b, 0xed, 0xb5, 0x4b, 0x72, 0x5c, 0xbc,
+		0x3e, 0xb6, 0x2a, 0xf1, 0x99, 0xe5, 0xe4, 0xef, 0x1a, 0x34, 0x96, 0x3b, 0xb4, 0x72, 0x60, 0x94,
+		0xea, 0x81, 0x19, 0x83, 0x56, 0x42, 0xe3, 0x04, 0xf3, 0xc4, 0x29, 0x9f, 0x38, 0xad, 0xf6, 0x68,
+		0x1a, 0xcf, 0x0a, 0xee, 0x28, 0xa3, 0x96, 0x76, 0x74, 0x0d, 0x47, 0xa5, 0x2a, 0xf9, 0x18, 0x51,
+		0x4e, 0x96, 0x64, 0x1f, 0x3f, 0xdd, 0x61, 0x41, 0xb6, 0x04, 0xf7, 0x5e, 0xb7, 0x07, 0x07, 0x2e,
+		0x0b, 0x22, 0x9f, 0x64, 0xa5, 0x8a, 0xe7, 0x98, 0x7b, 0x8e, 0xcb, 0xd2, 0x30, 0x11, 0x8f, 0xdd,
+		0xa6, 0xbd, 0x5f, 0x3a, 0x47, 0x99, 0x6f, 0x90, 0xb9, 0xd0, 0x4b, 0x68, 0x46, 0x24, 0xf4, 0x68,
+		0x38, 0xcb, 0x19, 0xb1, 0xb6, 0xa9, 0xd7, 0x3b, 0x9b, 0xf6, 0xae, 0xb4, 0x0a, 0x68, 0xfc, 0xea,
+		0x0f, 0x05, 0x1a, 0xcb, 0xcf, 0x2a, 0x3a, 0x82, 0x03, 0xf3, 0xd7, 0x5f, 0xfa, 0xc3, 0x4b, 0x67,
+		0x34, 0xee, 0x8f, 0xdf, 0x8f, 0x9c, 0xe1, 0xe5, 0x75, 0xff, 0x62, 0x68, 0xb6, 0xfe, 0x87, 0x3e,
+		0x07, 0x6d, 0xd5, 0x65, 0x5b, 0xe7, 0xc3, 0xd1, 0xd8, 0xb2, 0x2d, 0xb3, 0xa5, 0xac, 0x7b, 0x4d,
+		0xeb, 0xca, 0xb6, 0x06, 0xfd, 0xb1, 0x65, 0xb6, 0x6a, 0xeb, 0xb2, 0xa6, 0x75, 0x61, 0x65, 0xae,
+		0xfa, 0xab, 0x39, 0x34, 0x1f, 0xdc, 0xd9, 0x9f, 0xc1, 0x61, 0xdf, 0x1e, 0xfc, 0x34, 0xbc, 0xee,
+		0x5f, 0x54, 0x66, 0xf1, 0xd0, 0x69, 0x0e, 0x47, 0xfd, 0xb3, 0x0b, 0x91, 0x45, 0x05, 0xd5, 0xba,
+		0xcc, 0x9d, 0xb5, 0xb3, 0x1b, 0x38, 0x74, 0x59, 0x50, 0xd5, 0xea, 0x67, 0x6a, 0x5e, 0x84, 0xab,
+		0xec, 0xab, 0x5c, 0x29, 0xbf, 0x9f, 0xce, 0x68, 0x32, 0x4f, 0x27, 0x86, 0xcb, 0x82, 0xee, 0xf2,
+		0xbf, 0xce, 0x57, 0xd4, 0xf3, 0xbb, 0x33, 0x96, 0xff, 0x19, 0xc9, 0x1f, 0x9f, 0x1f, 0x70, 0x44,
+		0x17, 0xa7, 0x93, 0x2d, 0x61, 0xfb, 0xfa, 0xdf, 0x01, 0x00, 0x33, 0x96, 0xec, 0x45, 0x94, 0x09,
+		0x00, 0x00,
+	},
+}
+
+func init() {
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) AdminAPIYARPCClient {
+			return NewAdminAPIYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) AdminReplicationStreamAPIYARPCClient {
+			return NewAdminReplicationStreamAPIYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
+}
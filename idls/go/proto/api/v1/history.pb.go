@@ -0,0 +1,22559 @@
+// The MIT License (MIT)
+// 
+// Copyright (c) 2021 Uber Technologies, Inc.
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: uber/cadence/api/v1/history.proto
+
+package apiv1
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type EventFilterType int32
+
+const (
+	EventFilterType_EVENT_FILTER_TYPE_INVALID     EventFilterType = 0
+	EventFilterType_EVENT_FILTER_TYPE_ALL_EVENT   EventFilterType = 1
+	EventFilterType_EVENT_FILTER_TYPE_CLOSE_EVENT EventFilterType = 2
+)
+
+var EventFilterType_name = map[int32]string{
+	0: "EVENT_FILTER_TYPE_INVALID",
+	1: "EVENT_FILTER_TYPE_ALL_EVENT",
+	2: "EVENT_FILTER_TYPE_CLOSE_EVENT",
+}
+
+var EventFilterType_value = map[string]int32{
+	"EVENT_FILTER_TYPE_INVALID":     0,
+	"EVENT_FILTER_TYPE_ALL_EVENT":   1,
+	"EVENT_FILTER_TYPE_CLOSE_EVENT": 2,
+}
+
+func (x EventFilterType) String() string {
+	return proto.EnumName(EventFilterType_name, int32(x))
+}
+
+func (EventFilterType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{0}
+}
+
+type History struct {
+	Events               []*HistoryEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *History) Reset()         { *m = History{} }
+func (m *History) String() string { return proto.CompactTextString(m) }
+func (*History) ProtoMessage()    {}
+func (*History) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{0}
+}
+func (m *History) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *History) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_History.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *History) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_History.Merge(m, src)
+}
+func (m *History) XXX_Size() int {
+	return m.Size()
+}
+func (m *History) XXX_DiscardUnknown() {
+	xxx_messageInfo_History.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_History proto.InternalMessageInfo
+
+func (m *History) GetEvents() []*HistoryEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type HistoryEvent struct {
+	EventId   int64            `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventTime *types.Timestamp `protobuf:"bytes,2,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	Version   int64            `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	TaskId    int64            `protobuf:"varint,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Types that are valid to be assigned to Attributes:
+	//	*HistoryEvent_WorkflowExecutionStartedEventAttributes
+	//	*HistoryEvent_WorkflowExecutionCompletedEventAttributes
+	//	*HistoryEvent_WorkflowExecutionFailedEventAttributes
+	//	*HistoryEvent_WorkflowExecutionTimedOutEventAttributes
+	//	*HistoryEvent_DecisionTaskScheduledEventAttributes
+	//	*HistoryEvent_DecisionTaskStartedEventAttributes
+	//	*HistoryEvent_DecisionTaskCompletedEventAttributes
+	//	*HistoryEvent_DecisionTaskTimedOutEventAttributes
+	//	*HistoryEvent_DecisionTaskFailedEventAttributes
+	//	*HistoryEvent_ActivityTaskScheduledEventAttributes
+	//	*HistoryEvent_ActivityTaskStartedEventAttributes
+	//	*HistoryEvent_ActivityTaskCompletedEventAttributes
+	//	*HistoryEvent_ActivityTaskFailedEventAttributes
+	//	*HistoryEvent_ActivityTaskTimedOutEventAttributes
+	//	*HistoryEvent_TimerStartedEventAttributes
+	//	*HistoryEvent_TimerFiredEventAttributes
+	//	*HistoryEvent_ActivityTaskCancelRequestedEventAttributes
+	//	*HistoryEvent_RequestCancelActivityTaskFailedEventAttributes
+	//	*HistoryEvent_ActivityTaskCanceledEventAttributes
+	//	*HistoryEvent_TimerCanceledEventAttributes
+	//	*HistoryEvent_CancelTimerFailedEventAttributes
+	//	*HistoryEvent_MarkerRecordedEventAttributes
+	//	*HistoryEvent_WorkflowExecutionSignaledEventAttributes
+	//	*HistoryEvent_WorkflowExecutionTerminatedEventAttributes
+	//	*HistoryEvent_WorkflowExecutionCancelRequestedEventAttributes
+	//	*HistoryEvent_WorkflowExecutionCanceledEventAttributes
+	//	*HistoryEvent_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
+	//	*HistoryEvent_RequestCancelExternalWorkflowExecutionFailedEventAttributes
+	//	*HistoryEvent_ExternalWorkflowExecutionCancelRequestedEventAttributes
+	//	*HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes
+	//	*HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes
+	//	*HistoryEvent_StartChildWorkflowExecutionFailedEventAttributes
+	//	*HistoryEvent_ChildWorkflowExecutionStartedEventAttributes
+	//	*HistoryEvent_ChildWorkflowExecutionCompletedEventAttributes
+	//	*HistoryEvent_ChildWorkflowExecutionFailedEventAttributes
+	//	*HistoryEvent_ChildWorkflowExecutionCanceledEventAttributes
+	//	*HistoryEvent_ChildWorkflowExecutionTimedOutEventAttributes
+	//	*HistoryEvent_ChildWorkflowExecutionTerminatedEventAttributes
+	//	*HistoryEvent_SignalExternalWorkflowExecutionInitiatedEventAttributes
+	//	*HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes
+	//	*HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes
+	//	*HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes
+	//	*HistoryEvent_WorkflowExecutionPausedEventAttributes
+	//	*HistoryEvent_WorkflowExecutionUnpausedEventAttributes
+	Attributes           isHistoryEvent_Attributes `protobuf_oneof:"attributes"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *HistoryEvent) Reset()         { *m = HistoryEvent{} }
+func (m *HistoryEvent) String() string { return proto.CompactTextString(m) }
+func (*HistoryEvent) ProtoMessage()    {}
+func (*HistoryEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{1}
+}
+func (m *HistoryEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryEvent.Merge(m, src)
+}
+func (m *HistoryEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryEvent proto.InternalMessageInfo
+
+type isHistoryEvent_Attributes interface {
+	isHistoryEvent_Attributes()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type HistoryEvent_WorkflowExecutionStartedEventAttributes struct {
+	WorkflowExecutionStartedEventAttributes *WorkflowExecutionStartedEventAttributes `protobuf:"bytes,5,opt,name=workflow_execution_started_event_attributes,json=workflowExecutionStartedEventAttributes,proto3,oneof" json:"workflow_execution_started_event_attributes,omitempty"`
+}
+type HistoryEvent_WorkflowExecutionCompletedEventAttributes struct {
+	WorkflowExecutionCompletedEventAttributes *WorkflowExecutionCompletedEventAttributes `protobuf:"bytes,6,opt,name=workflow_execution_completed_event_attributes,json=workflowExecutionCompletedEventAttributes,proto3,oneof" json:"workflow_execution_completed_event_attributes,omitempty"`
+}
+type HistoryEvent_WorkflowExecutionFailedEventAttributes struct {
+	WorkflowExecutionFailedEventAttributes *WorkflowExecutionFailedEventAttributes `protobuf:"bytes,7,opt,name=workflow_execution_failed_event_attributes,json=workflowExecutionFailedEventAttributes,proto3,oneof" json:"workflow_execution_failed_event_attributes,omitempty"`
+}
+type HistoryEvent_WorkflowExecutionTimedOutEventAttributes struct {
+	WorkflowExecutionTimedOutEventAttributes *WorkflowExecutionTimedOutEventAttributes `protobuf:"bytes,8,opt,name=workflow_execution_timed_out_event_attributes,json=workflowExecutionTimedOutEventAttributes,proto3,oneof" json:"workflow_execution_timed_out_event_attributes,omitempty"`
+}
+type HistoryEvent_DecisionTaskScheduledEventAttributes struct {
+	DecisionTaskScheduledEventAttributes *DecisionTaskScheduledEventAttributes `protobuf:"bytes,9,opt,name=decision_task_scheduled_event_attributes,json=decisionTaskScheduledEventAttributes,proto3,oneof" json:"decision_task_scheduled_event_attributes,omitempty"`
+}
+type HistoryEvent_DecisionTaskStartedEventAttributes struct {
+	DecisionTaskStartedEventAttributes *DecisionTaskStartedEventAttributes `protobuf:"bytes,10,opt,name=decision_task_started_event_attributes,json=decisionTaskStartedEventAttributes,proto3,oneof" json:"decision_task_started_event_attributes,omitempty"`
+}
+type HistoryEvent_DecisionTaskCompletedEventAttributes struct {
+	DecisionTaskCompletedEventAttributes *DecisionTaskCompletedEventAttributes `protobuf:"bytes,11,opt,name=decision_task_completed_event_attributes,json=decisionTaskCompletedEventAttributes,proto3,oneof" json:"decision_task_completed_event_attributes,omitempty"`
+}
+type HistoryEvent_DecisionTaskTimedOutEventAttributes struct {
+	DecisionTaskTimedOutEventAttributes *DecisionTaskTimedOutEventAttributes `protobuf:"bytes,12,opt,name=decision_task_timed_out_event_attributes,json=decisionTaskTimedOutEventAttributes,proto3,oneof" json:"decision_task_timed_out_event_attributes,omitempty"`
+}
+type HistoryEvent_DecisionTaskFailedEventAttributes struct {
+	DecisionTaskFailedEventAttributes *DecisionTaskFailedEventAttributes `protobuf:"bytes,13,opt,name=decision_task_failed_event_attributes,json=decisionTaskFailedEventAttributes,proto3,oneof" json:"decision_task_failed_event_attributes,omitempty"`
+}
+type HistoryEvent_ActivityTaskScheduledEventAttributes struct {
+	ActivityTaskScheduledEventAttributes *ActivityTaskScheduledEventAttributes `protobuf:"bytes,14,opt,name=activity_task_scheduled_event_attributes,json=activityTaskScheduledEventAttributes,proto3,oneof" json:"activity_task_scheduled_event_attributes,omitempty"`
+}
+type HistoryEvent_ActivityTaskStartedEventAttributes struct {
+	ActivityTaskStartedEventAttributes *ActivityTaskStartedEventAttributes `protobuf:"bytes,15,opt,name=activity_task_started_event_attributes,json=activityTaskStartedEventAttributes,proto3,oneof" json:"activity_task_started_event_attributes,omitempty"`
+}
+type HistoryEvent_ActivityTaskCompletedEventAttributes struct {
+	ActivityTaskCompletedEventAttributes *ActivityTaskCompletedEventAttributes `protobuf:"bytes,16,opt,name=activity_task_completed_event_attributes,json=activityTaskCompletedEventAttributes,proto3,oneof" json:"activity_task_completed_event_attributes,omitempty"`
+}
+type HistoryEvent_ActivityTaskFailedEventAttributes struct {
+	ActivityTaskFailedEventAttributes *ActivityTaskFailedEventAttributes `protobuf:"bytes,17,opt,name=activity_task_failed_event_attributes,json=activityTaskFailedEventAttributes,proto3,oneof" json:"activity_task_failed_event_attributes,omitempty"`
+}
+type HistoryEvent_ActivityTaskTimedOutEventAttributes struct {
+	ActivityTaskTimedOutEventAttributes *ActivityTaskTimedOutEventAttributes `protobuf:"bytes,18,opt,name=activity_task_timed_out_event_attributes,json=activityTaskTimedOutEventAttributes,proto3,oneof" json:"activity_task_timed_out_event_attributes,omitempty"`
+}
+type HistoryEvent_TimerStartedEventAttributes struct {
+	TimerStartedEventAttributes *TimerStartedEventAttributes `protobuf:"bytes,19,opt,name=timer_started_event_attributes,json=timerStartedEventAttributes,proto3,oneof" json:"timer_started_event_attributes,omitempty"`
+}
+type HistoryEvent_TimerFiredEventAttributes struct {
+	TimerFiredEventAttributes *TimerFiredEventAttributes `protobuf:"bytes,20,opt,name=timer_fired_event_attributes,json=timerFiredEventAttributes,proto3,oneof" json:"timer_fired_event_attributes,omitempty"`
+}
+type HistoryEvent_ActivityTaskCancelRequestedEventAttributes struct {
+	ActivityTaskCancelRequestedEventAttributes *ActivityTaskCancelRequestedEventAttributes `protobuf:"bytes,21,opt,name=activity_task_cancel_requested_event_attributes,json=activityTaskCancelRequestedEventAttributes,proto3,oneof" json:"activity_task_cancel_requested_event_attributes,omitempty"`
+}
+type HistoryEvent_RequestCancelActivityTaskFailedEventAttributes struct {
+	RequestCancelActivityTaskFailedEventAttributes *RequestCancelActivityTaskFailedEventAttributes `protobuf:"bytes,22,opt,name=request_cancel_activity_task_failed_event_attributes,json=requestCancelActivityTaskFailedEventAttributes,proto3,oneof" json:"request_cancel_activity_task_failed_event_attributes,omitempty"`
+}
+type HistoryEvent_ActivityTaskCanceledEventAttributes struct {
+	ActivityTaskCanceledEventAttributes *ActivityTaskCanceledEventAttributes `protobuf:"bytes,23,opt,name=activity_task_canceled_event_attributes,json=activityTaskCanceledEventAttributes,proto3,oneof" json:"activity_task_canceled_event_attributes,omitempty"`
+}
+type HistoryEvent_TimerCanceledEventAttributes struct {
+	TimerCanceledEventAttributes *TimerCanceledEventAttributes `protobuf:"bytes,24,opt,name=timer_canceled_event_attributes,json=timerCanceledEventAttributes,proto3,oneof" json:"timer_canceled_event_attributes,omitempty"`
+}
+type HistoryEvent_CancelTimerFailedEventAttributes struct {
+	CancelTimerFailedEventAttributes *CancelTimerFailedEventAttributes `protobuf:"bytes,25,opt,name=cancel_timer_failed_event_attributes,json=cancelTimerFailedEventAttributes,proto3,oneof" json:"cancel_timer_failed_event_attributes,omitempty"`
+}
+type HistoryEvent_MarkerRecordedEventAttributes struct {
+	MarkerRecordedEventAttributes *MarkerRecordedEventAttributes `protobuf:"bytes,26,opt,name=marker_recorded_event_attributes,json=markerRecordedEventAttributes,proto3,oneof" json:"marker_recorded_event_attributes,omitempty"`
+}
+type HistoryEvent_WorkflowExecutionSignaledEventAttributes struct {
+	WorkflowExecutionSignaledEventAttributes *WorkflowExecutionSignaledEventAttributes `protobuf:"bytes,27,opt,name=workflow_execution_signaled_event_attributes,json=workflowExecutionSignaledEventAttributes,proto3,oneof" json:"workflow_execution_signaled_event_attributes,omitempty"`
+}
+type HistoryEvent_WorkflowExecutionTerminatedEventAttributes struct {
+	WorkflowExecutionTerminatedEventAttributes *WorkflowExecutionTerminatedEventAttributes `protobuf:"bytes,28,opt,name=workflow_execution_terminated_event_attributes,json=workflowExecutionTerminatedEventAttributes,proto3,oneof" json:"workflow_execution_terminated_event_attributes,omitempty"`
+}
+type HistoryEvent_WorkflowExecutionCancelRequestedEventAttributes struct {
+	WorkflowExecutionCancelRequestedEventAttributes *WorkflowExecutionCancelRequestedEventAttributes `protobuf:"bytes,29,opt,name=workflow_execution_cancel_requested_event_attributes,json=workflowExecutionCancelRequestedEventAttributes,proto3,oneof" json:"workflow_execution_cancel_requested_event_attributes,omitempty"`
+}
+type HistoryEvent_WorkflowExecutionCanceledEventAttributes struct {
+	WorkflowExecutionCanceledEventAttributes *WorkflowExecutionCanceledEventAttributes `protobuf:"bytes,30,opt,name=workflow_execution_canceled_event_attributes,json=workflowExecutionCanceledEventAttributes,proto3,oneof" json:"workflow_execution_canceled_event_attributes,omitempty"`
+}
+type HistoryEvent_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
+	RequestCancelExternalWorkflowExecutionInitiatedEventAttributes *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes `protobuf:"bytes,31,opt,name=request_cancel_external_workflow_execution_initiated_event_attributes,json=requestCancelExternalWorkflowExecutionInitiatedEventAttributes,proto3,oneof" json:"request_cancel_external_workflow_execution_initiated_event_attributes,omitempty"`
+}
+type HistoryEvent_RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
+	RequestCancelExternalWorkflowExecutionFailedEventAttributes *RequestCancelExternalWorkflowExecutionFailedEventAttributes `protobuf:"bytes,32,opt,name=request_cancel_external_workflow_execution_failed_event_attributes,json=requestCancelExternalWorkflowExecutionFailedEventAttributes,proto3,oneof" json:"request_cancel_external_workflow_execution_failed_event_attributes,omitempty"`
+}
+type HistoryEvent_ExternalWorkflowExecutionCancelRequestedEventAttributes struct {
+	ExternalWorkflowExecutionCancelRequestedEventAttributes *ExternalWorkflowExecutionCancelRequestedEventAttributes `protobuf:"bytes,33,opt,name=external_workflow_execution_cancel_requested_event_attributes,json=externalWorkflowExecutionCancelRequestedEventAttributes,proto3,oneof" json:"external_workflow_execution_cancel_requested_event_attributes,omitempty"`
+}
+type HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes struct {
+	WorkflowExecutionContinuedAsNewEventAttributes *WorkflowExecutionContinuedAsNewEventAttributes `protobuf:"bytes,34,opt,name=workflow_execution_continued_as_new_event_attributes,json=workflowExecutionContinuedAsNewEventAttributes,proto3,oneof" json:"workflow_execution_continued_as_new_event_attributes,omitempty"`
+}
+type HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes struct {
+	StartChildWorkflowExecutionInitiatedEventAttributes *StartChildWorkflowExecutionInitiatedEventAttributes `protobuf:"bytes,35,opt,name=start_child_workflow_execution_initiated_event_attributes,json=startChildWorkflowExecutionInitiatedEventAttributes,proto3,oneof" json:"start_child_workflow_execution_initiated_event_attributes,omitempty"`
+}
+type HistoryEvent_StartChildWorkflowExecutionFailedEventAttributes struct {
+	StartChildWorkflowExecutionFailedEventAttributes *StartChildWorkflowExecutionFailedEventAttributes `protobuf:"bytes,36,opt,name=start_child_workflow_execution_failed_event_attributes,json=startChildWorkflowExecutionFailedEventAttributes,proto3,oneof" json:"start_child_workflow_execution_failed_event_attributes,omitempty"`
+}
+type HistoryEvent_ChildWorkflowExecutionStartedEventAttributes struct {
+	ChildWorkflowExecutionStartedEventAttributes *ChildWorkflowExecutionStartedEventAttributes `protobuf:"bytes,37,opt,name=child_workflow_execution_started_event_attributes,json=childWorkflowExecutionStartedEventAttributes,proto3,oneof" json:"child_workflow_execution_started_event_attributes,omitempty"`
+}
+type HistoryEvent_ChildWorkflowExecutionCompletedEventAttributes struct {
+	ChildWorkflowExecutionCompletedEventAttributes *ChildWorkflowExecutionCompletedEventAttributes `protobuf:"bytes,38,opt,name=child_workflow_execution_completed_event_attributes,json=childWorkflowExecutionCompletedEventAttributes,proto3,oneof" json:"child_workflow_execution_completed_event_attributes,omitempty"`
+}
+type HistoryEvent_ChildWorkflowExecutionFailedEventAttributes struct {
+	ChildWorkflowExecutionFailedEventAttributes *ChildWorkflowExecutionFailedEventAttributes `protobuf:"bytes,39,opt,name=child_workflow_execution_failed_event_attributes,json=childWorkflowExecutionFailedEventAttributes,proto3,oneof" json:"child_workflow_execution_failed_event_attributes,omitempty"`
+}
+type HistoryEvent_ChildWorkflowExecutionCanceledEventAttributes struct {
+	ChildWorkflowExecutionCanceledEventAttributes *ChildWorkflowExecutionCanceledEventAttributes `protobuf:"bytes,40,opt,name=child_workflow_execution_canceled_event_attributes,json=childWorkflowExecutionCanceledEventAttributes,proto3,oneof" json:"child_workflow_execution_canceled_event_attributes,omitempty"`
+}
+type HistoryEvent_ChildWorkflowExecutionTimedOutEventAttributes struct {
+	ChildWorkflowExecutionTimedOutEventAttributes *ChildWorkflowExecutionTimedOutEventAttributes `protobuf:"bytes,41,opt,name=child_workflow_execution_timed_out_event_attributes,json=childWorkflowExecutionTimedOutEventAttributes,proto3,oneof" json:"child_workflow_execution_timed_out_event_attributes,omitempty"`
+}
+type HistoryEvent_ChildWorkflowExecutionTerminatedEventAttributes struct {
+	ChildWorkflowExecutionTerminatedEventAttributes *ChildWorkflowExecutionTerminatedEventAttributes `protobuf:"bytes,42,opt,name=child_workflow_execution_terminated_event_attributes,json=childWorkflowExecutionTerminatedEventAttributes,proto3,oneof" json:"child_workflow_execution_terminated_event_attributes,omitempty"`
+}
+type HistoryEvent_SignalExternalWorkflowExecutionInitiatedEventAttributes struct {
+	SignalExternalWorkflowExecutionInitiatedEventAttributes *SignalExternalWorkflowExecutionInitiatedEventAttributes `protobuf:"bytes,43,opt,name=signal_external_workflow_execution_initiated_event_attributes,json=signalExternalWorkflowExecutionInitiatedEventAttributes,proto3,oneof" json:"signal_external_workflow_execution_initiated_event_attributes,omitempty"`
+}
+type HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes struct {
+	SignalExternalWorkflowExecutionFailedEventAttributes *SignalExternalWorkflowExecutionFailedEventAttributes `protobuf:"bytes,44,opt,name=signal_external_workflow_execution_failed_event_attributes,json=signalExternalWorkflowExecutionFailedEventAttributes,proto3,oneof" json:"signal_external_workflow_execution_failed_event_attributes,omitempty"`
+}
+type HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes struct {
+	ExternalWorkflowExecutionSignaledEventAttributes *ExternalWorkflowExecutionSignaledEventAttributes `protobuf:"bytes,45,opt,name=external_workflow_execution_signaled_event_attributes,json=externalWorkflowExecutionSignaledEventAttributes,proto3,oneof" json:"external_workflow_execution_signaled_event_attributes,omitempty"`
+}
+type HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes struct {
+	UpsertWorkflowSearchAttributesEventAttributes *UpsertWorkflowSearchAttributesEventAttributes `protobuf:"bytes,46,opt,name=upsert_workflow_search_attributes_event_attributes,json=upsertWorkflowSearchAttributesEventAttributes,proto3,oneof" json:"upsert_workflow_search_attributes_event_attributes,omitempty"`
+}
+type HistoryEvent_WorkflowExecutionPausedEventAttributes struct {
+	WorkflowExecutionPausedEventAttributes *WorkflowExecutionPausedEventAttributes `protobuf:"bytes,47,opt,name=workflow_execution_paused_event_attributes,json=workflowExecutionPausedEventAttributes,proto3,oneof" json:"workflow_execution_paused_event_attributes,omitempty"`
+}
+type HistoryEvent_WorkflowExecutionUnpausedEventAttributes struct {
+	WorkflowExecutionUnpausedEventAttributes *WorkflowExecutionUnpausedEventAttributes `protobuf:"bytes,48,opt,name=workflow_execution_unpaused_event_attributes,json=workflowExecutionUnpausedEventAttributes,proto3,oneof" json:"workflow_execution_unpaused_event_attributes,omitempty"`
+}
+
+func (*HistoryEvent_WorkflowExecutionStartedEventAttributes) isHistoryEvent_Attributes()         {}
+func (*HistoryEvent_WorkflowExecutionCompletedEventAttributes) isHistoryEvent_Attributes()       {}
+func (*HistoryEvent_WorkflowExecutionFailedEventAttributes) isHistoryEvent_Attributes()          {}
+func (*HistoryEvent_WorkflowExecutionTimedOutEventAttributes) isHistoryEvent_Attributes()        {}
+func (*HistoryEvent_DecisionTaskScheduledEventAttributes) isHistoryEvent_Attributes()            {}
+func (*HistoryEvent_DecisionTaskStartedEventAttributes) isHistoryEvent_Attributes()              {}
+func (*HistoryEvent_DecisionTaskCompletedEventAttributes) isHistoryEvent_Attributes()            {}
+func (*HistoryEvent_DecisionTaskTimedOutEventAttributes) isHistoryEvent_Attributes()             {}
+func (*HistoryEvent_DecisionTaskFailedEventAttributes) isHistoryEvent_Attributes()               {}
+func (*HistoryEvent_ActivityTaskScheduledEventAttributes) isHistoryEvent_Attributes()            {}
+func (*HistoryEvent_ActivityTaskStartedEventAttributes) isHistoryEvent_Attributes()              {}
+func (*HistoryEvent_ActivityTaskCompletedEventAttributes) isHistoryEvent_Attributes()            {}
+func (*HistoryEvent_ActivityTaskFailedEventAttributes) isHistoryEvent_Attributes()               {}
+func (*HistoryEvent_ActivityTaskTimedOutEventAttributes) isHistoryEvent_Attributes()             {}
+func (*HistoryEvent_TimerStartedEventAttributes) isHistoryEvent_Attributes()                     {}
+func (*HistoryEvent_TimerFiredEventAttributes) isHistoryEvent_Attributes()                       {}
+func (*HistoryEvent_ActivityTaskCancelRequestedEventAttributes) isHistoryEvent_Attributes()      {}
+func (*HistoryEvent_RequestCancelActivityTaskFailedEventAttributes) isHistoryEvent_Attributes()  {}
+func (*HistoryEvent_ActivityTaskCanceledEventAttributes) isHistoryEvent_Attributes()             {}
+func (*HistoryEvent_TimerCanceledEventAttributes) isHistoryEvent_Attributes()                    {}
+func (*HistoryEvent_CancelTimerFailedEventAttributes) isHistoryEvent_Attributes()                {}
+func (*HistoryEvent_MarkerRecordedEventAttributes) isHistoryEvent_Attributes()                   {}
+func (*HistoryEvent_WorkflowExecutionSignaledEventAttributes) isHistoryEvent_Attributes()        {}
+func (*HistoryEvent_WorkflowExecutionTerminatedEventAttributes) isHistoryEvent_Attributes()      {}
+func (*HistoryEvent_WorkflowExecutionCancelRequestedEventAttributes) isHistoryEvent_Attributes() {}
+func (*HistoryEvent_WorkflowExecutionCanceledEventAttributes) isHistoryEvent_Attributes()        {}
+func (*HistoryEvent_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) isHistoryEvent_Attributes() {
+}
+func (*HistoryEvent_RequestCancelExternalWorkflowExecutionFailedEventAttributes) isHistoryEvent_Attributes() {
+}
+func (*HistoryEvent_ExternalWorkflowExecutionCancelRequestedEventAttributes) isHistoryEvent_Attributes() {
+}
+func (*HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes) isHistoryEvent_Attributes() {}
+func (*HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes) isHistoryEvent_Attributes() {
+}
+func (*HistoryEvent_StartChildWorkflowExecutionFailedEventAttributes) isHistoryEvent_Attributes() {}
+func (*HistoryEvent_ChildWorkflowExecutionStartedEventAttributes) isHistoryEvent_Attributes()     {}
+func (*HistoryEvent_ChildWorkflowExecutionCompletedEventAttributes) isHistoryEvent_Attributes()   {}
+func (*HistoryEvent_ChildWorkflowExecutionFailedEventAttributes) isHistoryEvent_Attributes()      {}
+func (*HistoryEvent_ChildWorkflowExecutionCanceledEventAttributes) isHistoryEvent_Attributes()    {}
+func (*HistoryEvent_ChildWorkflowExecutionTimedOutEventAttributes) isHistoryEvent_Attributes()    {}
+func (*HistoryEvent_ChildWorkflowExecutionTerminatedEventAttributes) isHistoryEvent_Attributes()  {}
+func (*HistoryEvent_SignalExternalWorkflowExecutionInitiatedEventAttributes) isHistoryEvent_Attributes() {
+}
+func (*HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes) isHistoryEvent_Attributes() {
+}
+func (*HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes) isHistoryEvent_Attributes() {}
+func (*HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes) isHistoryEvent_Attributes()    {}
+func (*HistoryEvent_WorkflowExecutionPausedEventAttributes) isHistoryEvent_Attributes()           {}
+func (*HistoryEvent_WorkflowExecutionUnpausedEventAttributes) isHistoryEvent_Attributes()         {}
+
+func (m *HistoryEvent) GetAttributes() isHistoryEvent_Attributes {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetEventId() int64 {
+	if m != nil {
+		return m.EventId
+	}
+	return 0
+}
+
+func (m *HistoryEvent) GetEventTime() *types.Timestamp {
+	if m != nil {
+		return m.EventTime
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *HistoryEvent) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *HistoryEvent) GetWorkflowExecutionStartedEventAttributes() *WorkflowExecutionStartedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_WorkflowExecutionStartedEventAttributes); ok {
+		return x.WorkflowExecutionStartedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetWorkflowExecutionCompletedEventAttributes() *WorkflowExecutionCompletedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_WorkflowExecutionCompletedEventAttributes); ok {
+		return x.WorkflowExecutionCompletedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetWorkflowExecutionFailedEventAttributes() *WorkflowExecutionFailedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_WorkflowExecutionFailedEventAttributes); ok {
+		return x.WorkflowExecutionFailedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetWorkflowExecutionTimedOutEventAttributes() *WorkflowExecutionTimedOutEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_WorkflowExecutionTimedOutEventAttributes); ok {
+		return x.WorkflowExecutionTimedOutEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetDecisionTaskScheduledEventAttributes() *DecisionTaskScheduledEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_DecisionTaskScheduledEventAttributes); ok {
+		return x.DecisionTaskScheduledEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetDecisionTaskStartedEventAttributes() *DecisionTaskStartedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_DecisionTaskStartedEventAttributes); ok {
+		return x.DecisionTaskStartedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetDecisionTaskCompletedEventAttributes() *DecisionTaskCompletedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_DecisionTaskCompletedEventAttributes); ok {
+		return x.DecisionTaskCompletedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetDecisionTaskTimedOutEventAttributes() *DecisionTaskTimedOutEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_DecisionTaskTimedOutEventAttributes); ok {
+		return x.DecisionTaskTimedOutEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetDecisionTaskFailedEventAttributes() *DecisionTaskFailedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_DecisionTaskFailedEventAttributes); ok {
+		return x.DecisionTaskFailedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetActivityTaskScheduledEventAttributes() *ActivityTaskScheduledEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_ActivityTaskScheduledEventAttributes); ok {
+		return x.ActivityTaskScheduledEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetActivityTaskStartedEventAttributes() *ActivityTaskStartedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_ActivityTaskStartedEventAttributes); ok {
+		return x.ActivityTaskStartedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetActivityTaskCompletedEventAttributes() *ActivityTaskCompletedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_ActivityTaskCompletedEventAttributes); ok {
+		return x.ActivityTaskCompletedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetActivityTaskFailedEventAttributes() *ActivityTaskFailedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_ActivityTaskFailedEventAttributes); ok {
+		return x.ActivityTaskFailedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetActivityTaskTimedOutEventAttributes() *ActivityTaskTimedOutEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_ActivityTaskTimedOutEventAttributes); ok {
+		return x.ActivityTaskTimedOutEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetTimerStartedEventAttributes() *TimerStartedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_TimerStartedEventAttributes); ok {
+		return x.TimerStartedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetTimerFiredEventAttributes() *TimerFiredEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_TimerFiredEventAttributes); ok {
+		return x.TimerFiredEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetActivityTaskCancelRequestedEventAttributes() *ActivityTaskCancelRequestedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_ActivityTaskCancelRequestedEventAttributes); ok {
+		return x.ActivityTaskCancelRequestedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetRequestCancelActivityTaskFailedEventAttributes() *RequestCancelActivityTaskFailedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_RequestCancelActivityTaskFailedEventAttributes); ok {
+		return x.RequestCancelActivityTaskFailedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetActivityTaskCanceledEventAttributes() *ActivityTaskCanceledEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_ActivityTaskCanceledEventAttributes); ok {
+		return x.ActivityTaskCanceledEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetTimerCanceledEventAttributes() *TimerCanceledEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_TimerCanceledEventAttributes); ok {
+		return x.TimerCanceledEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetCancelTimerFailedEventAttributes() *CancelTimerFailedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_CancelTimerFailedEventAttributes); ok {
+		return x.CancelTimerFailedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetMarkerRecordedEventAttributes() *MarkerRecordedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_MarkerRecordedEventAttributes); ok {
+		return x.MarkerRecordedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetWorkflowExecutionSignaledEventAttributes() *WorkflowExecutionSignaledEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_WorkflowExecutionSignaledEventAttributes); ok {
+		return x.WorkflowExecutionSignaledEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetWorkflowExecutionTerminatedEventAttributes() *WorkflowExecutionTerminatedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_WorkflowExecutionTerminatedEventAttributes); ok {
+		return x.WorkflowExecutionTerminatedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetWorkflowExecutionCancelRequestedEventAttributes() *WorkflowExecutionCancelRequestedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_WorkflowExecutionCancelRequestedEventAttributes); ok {
+		return x.WorkflowExecutionCancelRequestedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetWorkflowExecutionCanceledEventAttributes() *WorkflowExecutionCanceledEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_WorkflowExecutionCanceledEventAttributes); ok {
+		return x.WorkflowExecutionCanceledEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetRequestCancelExternalWorkflowExecutionInitiatedEventAttributes() *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes); ok {
+		return x.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetRequestCancelExternalWorkflowExecutionFailedEventAttributes() *RequestCancelExternalWorkflowExecutionFailedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_RequestCancelExternalWorkflowExecutionFailedEventAttributes); ok {
+		return x.RequestCancelExternalWorkflowExecutionFailedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetExternalWorkflowExecutionCancelRequestedEventAttributes() *ExternalWorkflowExecutionCancelRequestedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_ExternalWorkflowExecutionCancelRequestedEventAttributes); ok {
+		return x.ExternalWorkflowExecutionCancelRequestedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetWorkflowExecutionContinuedAsNewEventAttributes() *WorkflowExecutionContinuedAsNewEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes); ok {
+		return x.WorkflowExecutionContinuedAsNewEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetStartChildWorkflowExecutionInitiatedEventAttributes() *StartChildWorkflowExecutionInitiatedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes); ok {
+		return x.StartChildWorkflowExecutionInitiatedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetStartChildWorkflowExecutionFailedEventAttributes() *StartChildWorkflowExecutionFailedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_StartChildWorkflowExecutionFailedEventAttributes); ok {
+		return x.StartChildWorkflowExecutionFailedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetChildWorkflowExecutionStartedEventAttributes() *ChildWorkflowExecutionStartedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_ChildWorkflowExecutionStartedEventAttributes); ok {
+		return x.ChildWorkflowExecutionStartedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetChildWorkflowExecutionCompletedEventAttributes() *ChildWorkflowExecutionCompletedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_ChildWorkflowExecutionCompletedEventAttributes); ok {
+		return x.ChildWorkflowExecutionCompletedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetChildWorkflowExecutionFailedEventAttributes() *ChildWorkflowExecutionFailedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_ChildWorkflowExecutionFailedEventAttributes); ok {
+		return x.ChildWorkflowExecutionFailedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetChildWorkflowExecutionCanceledEventAttributes() *ChildWorkflowExecutionCanceledEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_ChildWorkflowExecutionCanceledEventAttributes); ok {
+		return x.ChildWorkflowExecutionCanceledEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetChildWorkflowExecutionTimedOutEventAttributes() *ChildWorkflowExecutionTimedOutEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_ChildWorkflowExecutionTimedOutEventAttributes); ok {
+		return x.ChildWorkflowExecutionTimedOutEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetChildWorkflowExecutionTerminatedEventAttributes() *ChildWorkflowExecutionTerminatedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_ChildWorkflowExecutionTerminatedEventAttributes); ok {
+		return x.ChildWorkflowExecutionTerminatedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetSignalExternalWorkflowExecutionInitiatedEventAttributes() *SignalExternalWorkflowExecutionInitiatedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_SignalExternalWorkflowExecutionInitiatedEventAttributes); ok {
+		return x.SignalExternalWorkflowExecutionInitiatedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetSignalExternalWorkflowExecutionFailedEventAttributes() *SignalExternalWorkflowExecutionFailedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes); ok {
+		return x.SignalExternalWorkflowExecutionFailedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetExternalWorkflowExecutionSignaledEventAttributes() *ExternalWorkflowExecutionSignaledEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes); ok {
+		return x.ExternalWorkflowExecutionSignaledEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetUpsertWorkflowSearchAttributesEventAttributes() *UpsertWorkflowSearchAttributesEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes); ok {
+		return x.UpsertWorkflowSearchAttributesEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetWorkflowExecutionPausedEventAttributes() *WorkflowExecutionPausedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_WorkflowExecutionPausedEventAttributes); ok {
+		return x.WorkflowExecutionPausedEventAttributes
+	}
+	return nil
+}
+
+func (m *HistoryEvent) GetWorkflowExecutionUnpausedEventAttributes() *WorkflowExecutionUnpausedEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_WorkflowExecutionUnpausedEventAttributes); ok {
+		return x.WorkflowExecutionUnpausedEventAttributes
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*HistoryEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*HistoryEvent_WorkflowExecutionStartedEventAttributes)(nil),
+		(*HistoryEvent_WorkflowExecutionCompletedEventAttributes)(nil),
+		(*HistoryEvent_WorkflowExecutionFailedEventAttributes)(nil),
+		(*HistoryEvent_WorkflowExecutionTimedOutEventAttributes)(nil),
+		(*HistoryEvent_DecisionTaskScheduledEventAttributes)(nil),
+		(*HistoryEvent_DecisionTaskStartedEventAttributes)(nil),
+		(*HistoryEvent_DecisionTaskCompletedEventAttributes)(nil),
+		(*HistoryEvent_DecisionTaskTimedOutEventAttributes)(nil),
+		(*HistoryEvent_DecisionTaskFailedEventAttributes)(nil),
+		(*HistoryEvent_ActivityTaskScheduledEventAttributes)(nil),
+		(*HistoryEvent_ActivityTaskStartedEventAttributes)(nil),
+		(*HistoryEvent_ActivityTaskCompletedEventAttributes)(nil),
+		(*HistoryEvent_ActivityTaskFailedEventAttributes)(nil),
+		(*HistoryEvent_ActivityTaskTimedOutEventAttributes)(nil),
+		(*HistoryEvent_TimerStartedEventAttributes)(nil),
+		(*HistoryEvent_TimerFiredEventAttributes)(nil),
+		(*HistoryEvent_ActivityTaskCancelRequestedEventAttributes)(nil),
+		(*HistoryEvent_RequestCancelActivityTaskFailedEventAttributes)(nil),
+		(*HistoryEvent_ActivityTaskCanceledEventAttributes)(nil),
+		(*HistoryEvent_TimerCanceledEventAttributes)(nil),
+		(*HistoryEvent_CancelTimerFailedEventAttributes)(nil),
+		(*HistoryEvent_MarkerRecordedEventAttributes)(nil),
+		(*HistoryEvent_WorkflowExecutionSignaledEventAttributes)(nil),
+		(*HistoryEvent_WorkflowExecutionTerminatedEventAttributes)(nil),
+		(*HistoryEvent_WorkflowExecutionCancelRequestedEventAttributes)(nil),
+		(*HistoryEvent_WorkflowExecutionCanceledEventAttributes)(nil),
+		(*HistoryEvent_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes)(nil),
+		(*HistoryEvent_RequestCancelExternalWorkflowExecutionFailedEventAttributes)(nil),
+		(*HistoryEvent_ExternalWorkflowExecutionCancelRequestedEventAttributes)(nil),
+		(*HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes)(nil),
+		(*HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes)(nil),
+		(*HistoryEvent_StartChildWorkflowExecutionFailedEventAttributes)(nil),
+		(*HistoryEvent_ChildWorkflowExecutionStartedEventAttributes)(nil),
+		(*HistoryEvent_ChildWorkflowExecutionCompletedEventAttributes)(nil),
+		(*HistoryEvent_ChildWorkflowExecutionFailedEventAttributes)(nil),
+		(*HistoryEvent_ChildWorkflowExecutionCanceledEventAttributes)(nil),
+		(*HistoryEvent_ChildWorkflowExecutionTimedOutEventAttributes)(nil),
+		(*HistoryEvent_ChildWorkflowExecutionTerminatedEventAttributes)(nil),
+		(*HistoryEvent_SignalExternalWorkflowExecutionInitiatedEventAttributes)(nil),
+		(*HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes)(nil),
+		(*HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes)(nil),
+		(*HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes)(nil),
+		(*HistoryEvent_WorkflowExecutionPausedEventAttributes)(nil),
+		(*HistoryEvent_WorkflowExecutionUnpausedEventAttributes)(nil),
+	}
+}
+
+type WorkflowExecutionStartedEventAttributes struct {
+	WorkflowType                 *WorkflowType          `protobuf:"bytes,1,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	ParentExecutionInfo          *ParentExecutionInfo   `protobuf:"bytes,2,opt,name=parent_execution_info,json=parentExecutionInfo,proto3" json:"parent_execution_info,omitempty"`
+	TaskList                     *TaskList              `protobuf:"bytes,3,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	Input                        *Payload               `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	ExecutionStartToCloseTimeout *types.Duration        `protobuf:"bytes,5,opt,name=execution_start_to_close_timeout,json=executionStartToCloseTimeout,proto3" json:"execution_start_to_close_timeout,omitempty"`
+	TaskStartToCloseTimeout      *types.Duration        `protobuf:"bytes,6,opt,name=task_start_to_close_timeout,json=taskStartToCloseTimeout,proto3" json:"task_start_to_close_timeout,omitempty"`
+	ContinuedExecutionRunId      string                 `protobuf:"bytes,7,opt,name=continued_execution_run_id,json=continuedExecutionRunId,proto3" json:"continued_execution_run_id,omitempty"`
+	Initiator                    ContinueAsNewInitiator `protobuf:"varint,8,opt,name=initiator,proto3,enum=uber.cadence.api.v1.ContinueAsNewInitiator" json:"initiator,omitempty"`
+	ContinuedFailure             *Failure               `protobuf:"bytes,9,opt,name=continued_failure,json=continuedFailure,proto3" json:"continued_failure,omitempty"`
+	LastCompletionResult         *Payload               `protobuf:"bytes,10,opt,name=last_completion_result,json=lastCompletionResult,proto3" json:"last_completion_result,omitempty"`
+	// This is the previous runID of ContinueAsNew.
+	OriginalExecutionRunId string `protobuf:"bytes,11,opt,name=original_execution_run_id,json=originalExecutionRunId,proto3" json:"original_execution_run_id,omitempty"`
+	Identity               string `protobuf:"bytes,12,opt,name=identity,proto3" json:"identity,omitempty"`
+	// This is the very first runID along the chain of ContinueAsNew and Reset.
+	FirstExecutionRunId      string            `protobuf:"bytes,13,opt,name=first_execution_run_id,json=firstExecutionRunId,proto3" json:"first_execution_run_id,omitempty"`
+	RetryPolicy              *RetryPolicy      `protobuf:"bytes,14,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	Attempt                  int32             `protobuf:"varint,15,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	ExpirationTime           *types.Timestamp  `protobuf:"bytes,16,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	CronSchedule             string            `protobuf:"bytes,17,opt,name=cron_schedule,json=cronSchedule,proto3" json:"cron_schedule,omitempty"`
+	FirstDecisionTaskBackoff *types.Duration   `protobuf:"bytes,18,opt,name=first_decision_task_backoff,json=firstDecisionTaskBackoff,proto3" json:"first_decision_task_backoff,omitempty"`
+	Memo                     *Memo             `protobuf:"bytes,19,opt,name=memo,proto3" json:"memo,omitempty"`
+	SearchAttributes         *SearchAttributes `protobuf:"bytes,20,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	PrevAutoResetPoints      *ResetPoints      `protobuf:"bytes,21,opt,name=prev_auto_reset_points,json=prevAutoResetPoints,proto3" json:"prev_auto_reset_points,omitempty"`
+	Header                   *Header           `protobuf:"bytes,22,opt,name=header,proto3" json:"header,omitempty"`
+	FirstScheduledTime       *types.Timestamp  `protobuf:"bytes,23,opt,name=first_scheduled_time,json=firstScheduledTime,proto3" json:"first_scheduled_time,omitempty"`
+	PartitionConfig          map[string]string `protobuf:"bytes,24,rep,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RequestId                string            `protobuf:"bytes,25,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}          `json:"-"`
+	XXX_unrecognized         []byte            `json:"-"`
+	XXX_sizecache            int32             `json:"-"`
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) Reset() {
+	*m = WorkflowExecutionStartedEventAttributes{}
+}
+func (m *WorkflowExecutionStartedEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*WorkflowExecutionStartedEventAttributes) ProtoMessage()    {}
+func (*WorkflowExecutionStartedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{2}
+}
+func (m *WorkflowExecutionStartedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionStartedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionStartedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionStartedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionStartedEventAttributes.Merge(m, src)
+}
+func (m *WorkflowExecutionStartedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionStartedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionStartedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionStartedEventAttributes proto.InternalMessageInfo
+
+func (m *WorkflowExecutionStartedEventAttributes) GetWorkflowType() *WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetParentExecutionInfo() *ParentExecutionInfo {
+	if m != nil {
+		return m.ParentExecutionInfo
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetTaskList() *TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetInput() *Payload {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetExecutionStartToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.ExecutionStartToCloseTimeout
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetTaskStartToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.TaskStartToCloseTimeout
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetContinuedExecutionRunId() string {
+	if m != nil {
+		return m.ContinuedExecutionRunId
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetInitiator() ContinueAsNewInitiator {
+	if m != nil {
+		return m.Initiator
+	}
+	return ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_INVALID
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetContinuedFailure() *Failure {
+	if m != nil {
+		return m.ContinuedFailure
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetLastCompletionResult() *Payload {
+	if m != nil {
+		return m.LastCompletionResult
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetOriginalExecutionRunId() string {
+	if m != nil {
+		return m.OriginalExecutionRunId
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetFirstExecutionRunId() string {
+	if m != nil {
+		return m.FirstExecutionRunId
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetExpirationTime() *types.Timestamp {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetCronSchedule() string {
+	if m != nil {
+		return m.CronSchedule
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetFirstDecisionTaskBackoff() *types.Duration {
+	if m != nil {
+		return m.FirstDecisionTaskBackoff
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetMemo() *Memo {
+	if m != nil {
+		return m.Memo
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetSearchAttributes() *SearchAttributes {
+	if m != nil {
+		return m.SearchAttributes
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetPrevAutoResetPoints() *ResetPoints {
+	if m != nil {
+		return m.PrevAutoResetPoints
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetFirstScheduledTime() *types.Timestamp {
+	if m != nil {
+		return m.FirstScheduledTime
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetPartitionConfig() map[string]string {
+	if m != nil {
+		return m.PartitionConfig
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type WorkflowExecutionCompletedEventAttributes struct {
+	Result                       *Payload `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	DecisionTaskCompletedEventId int64    `protobuf:"varint,2,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *WorkflowExecutionCompletedEventAttributes) Reset() {
+	*m = WorkflowExecutionCompletedEventAttributes{}
+}
+func (m *WorkflowExecutionCompletedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*WorkflowExecutionCompletedEventAttributes) ProtoMessage() {}
+func (*WorkflowExecutionCompletedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{3}
+}
+func (m *WorkflowExecutionCompletedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionCompletedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionCompletedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionCompletedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionCompletedEventAttributes.Merge(m, src)
+}
+func (m *WorkflowExecutionCompletedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionCompletedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionCompletedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionCompletedEventAttributes proto.InternalMessageInfo
+
+func (m *WorkflowExecutionCompletedEventAttributes) GetResult() *Payload {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionCompletedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+type WorkflowExecutionFailedEventAttributes struct {
+	Failure                      *Failure `protobuf:"bytes,1,opt,name=failure,proto3" json:"failure,omitempty"`
+	DecisionTaskCompletedEventId int64    `protobuf:"varint,2,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *WorkflowExecutionFailedEventAttributes) Reset() {
+	*m = WorkflowExecutionFailedEventAttributes{}
+}
+func (m *WorkflowExecutionFailedEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*WorkflowExecutionFailedEventAttributes) ProtoMessage()    {}
+func (*WorkflowExecutionFailedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{4}
+}
+func (m *WorkflowExecutionFailedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionFailedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionFailedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionFailedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionFailedEventAttributes.Merge(m, src)
+}
+func (m *WorkflowExecutionFailedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionFailedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionFailedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionFailedEventAttributes proto.InternalMessageInfo
+
+func (m *WorkflowExecutionFailedEventAttributes) GetFailure() *Failure {
+	if m != nil {
+		return m.Failure
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionFailedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+type WorkflowExecutionTimedOutEventAttributes struct {
+	TimeoutType          TimeoutType `protobuf:"varint,1,opt,name=timeout_type,json=timeoutType,proto3,enum=uber.cadence.api.v1.TimeoutType" json:"timeout_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *WorkflowExecutionTimedOutEventAttributes) Reset() {
+	*m = WorkflowExecutionTimedOutEventAttributes{}
+}
+func (m *WorkflowExecutionTimedOutEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*WorkflowExecutionTimedOutEventAttributes) ProtoMessage()    {}
+func (*WorkflowExecutionTimedOutEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{5}
+}
+func (m *WorkflowExecutionTimedOutEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionTimedOutEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionTimedOutEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionTimedOutEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionTimedOutEventAttributes.Merge(m, src)
+}
+func (m *WorkflowExecutionTimedOutEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionTimedOutEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionTimedOutEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionTimedOutEventAttributes proto.InternalMessageInfo
+
+func (m *WorkflowExecutionTimedOutEventAttributes) GetTimeoutType() TimeoutType {
+	if m != nil {
+		return m.TimeoutType
+	}
+	return TimeoutType_TIMEOUT_TYPE_INVALID
+}
+
+type DecisionTaskScheduledEventAttributes struct {
+	TaskList             *TaskList       `protobuf:"bytes,1,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	StartToCloseTimeout  *types.Duration `protobuf:"bytes,2,opt,name=start_to_close_timeout,json=startToCloseTimeout,proto3" json:"start_to_close_timeout,omitempty"`
+	Attempt              int32           `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DecisionTaskScheduledEventAttributes) Reset()         { *m = DecisionTaskScheduledEventAttributes{} }
+func (m *DecisionTaskScheduledEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*DecisionTaskScheduledEventAttributes) ProtoMessage()    {}
+func (*DecisionTaskScheduledEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{6}
+}
+func (m *DecisionTaskScheduledEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecisionTaskScheduledEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecisionTaskScheduledEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecisionTaskScheduledEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecisionTaskScheduledEventAttributes.Merge(m, src)
+}
+func (m *DecisionTaskScheduledEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecisionTaskScheduledEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecisionTaskScheduledEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecisionTaskScheduledEventAttributes proto.InternalMessageInfo
+
+func (m *DecisionTaskScheduledEventAttributes) GetTaskList() *TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *DecisionTaskScheduledEventAttributes) GetStartToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.StartToCloseTimeout
+	}
+	return nil
+}
+
+func (m *DecisionTaskScheduledEventAttributes) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+type DecisionTaskStartedEventAttributes struct {
+	ScheduledEventId     int64    `protobuf:"varint,1,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
+	Identity             string   `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string   `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecisionTaskStartedEventAttributes) Reset()         { *m = DecisionTaskStartedEventAttributes{} }
+func (m *DecisionTaskStartedEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*DecisionTaskStartedEventAttributes) ProtoMessage()    {}
+func (*DecisionTaskStartedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{7}
+}
+func (m *DecisionTaskStartedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecisionTaskStartedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecisionTaskStartedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecisionTaskStartedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecisionTaskStartedEventAttributes.Merge(m, src)
+}
+func (m *DecisionTaskStartedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecisionTaskStartedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecisionTaskStartedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecisionTaskStartedEventAttributes proto.InternalMessageInfo
+
+func (m *DecisionTaskStartedEventAttributes) GetScheduledEventId() int64 {
+	if m != nil {
+		return m.ScheduledEventId
+	}
+	return 0
+}
+
+func (m *DecisionTaskStartedEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *DecisionTaskStartedEventAttributes) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type DecisionTaskCompletedEventAttributes struct {
+	ScheduledEventId     int64    `protobuf:"varint,1,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
+	StartedEventId       int64    `protobuf:"varint,2,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
+	Identity             string   `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	BinaryChecksum       string   `protobuf:"bytes,4,opt,name=binary_checksum,json=binaryChecksum,proto3" json:"binary_checksum,omitempty"`
+	ExecutionContext     []byte   `protobuf:"bytes,5,opt,name=execution_context,json=executionContext,proto3" json:"execution_context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecisionTaskCompletedEventAttributes) Reset()         { *m = DecisionTaskCompletedEventAttributes{} }
+func (m *DecisionTaskCompletedEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*DecisionTaskCompletedEventAttributes) ProtoMessage()    {}
+func (*DecisionTaskCompletedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{8}
+}
+func (m *DecisionTaskCompletedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecisionTaskCompletedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecisionTaskCompletedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecisionTaskCompletedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecisionTaskCompletedEventAttributes.Merge(m, src)
+}
+func (m *DecisionTaskCompletedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecisionTaskCompletedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecisionTaskCompletedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecisionTaskCompletedEventAttributes proto.InternalMessageInfo
+
+func (m *DecisionTaskCompletedEventAttributes) GetScheduledEventId() int64 {
+	if m != nil {
+		return m.ScheduledEventId
+	}
+	return 0
+}
+
+func (m *DecisionTaskCompletedEventAttributes) GetStartedEventId() int64 {
+	if m != nil {
+		return m.StartedEventId
+	}
+	return 0
+}
+
+func (m *DecisionTaskCompletedEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *DecisionTaskCompletedEventAttributes) GetBinaryChecksum() string {
+	if m != nil {
+		return m.BinaryChecksum
+	}
+	return ""
+}
+
+func (m *DecisionTaskCompletedEventAttributes) GetExecutionContext() []byte {
+	if m != nil {
+		return m.ExecutionContext
+	}
+	return nil
+}
+
+type DecisionTaskTimedOutEventAttributes struct {
+	ScheduledEventId int64       `protobuf:"varint,1,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
+	StartedEventId   int64       `protobuf:"varint,2,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
+	TimeoutType      TimeoutType `protobuf:"varint,3,opt,name=timeout_type,json=timeoutType,proto3,enum=uber.cadence.api.v1.TimeoutType" json:"timeout_type,omitempty"`
+	// For reset workflow.
+	BaseRunId            string                    `protobuf:"bytes,4,opt,name=base_run_id,json=baseRunId,proto3" json:"base_run_id,omitempty"`
+	NewRunId             string                    `protobuf:"bytes,5,opt,name=new_run_id,json=newRunId,proto3" json:"new_run_id,omitempty"`
+	ForkEventVersion     int64                     `protobuf:"varint,6,opt,name=fork_event_version,json=forkEventVersion,proto3" json:"fork_event_version,omitempty"`
+	Reason               string                    `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Cause                DecisionTaskTimedOutCause `protobuf:"varint,8,opt,name=cause,proto3,enum=uber.cadence.api.v1.DecisionTaskTimedOutCause" json:"cause,omitempty"`
+	RequestId            string                    `protobuf:"bytes,9,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *DecisionTaskTimedOutEventAttributes) Reset()         { *m = DecisionTaskTimedOutEventAttributes{} }
+func (m *DecisionTaskTimedOutEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*DecisionTaskTimedOutEventAttributes) ProtoMessage()    {}
+func (*DecisionTaskTimedOutEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{9}
+}
+func (m *DecisionTaskTimedOutEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecisionTaskTimedOutEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecisionTaskTimedOutEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecisionTaskTimedOutEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecisionTaskTimedOutEventAttributes.Merge(m, src)
+}
+func (m *DecisionTaskTimedOutEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecisionTaskTimedOutEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecisionTaskTimedOutEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecisionTaskTimedOutEventAttributes proto.InternalMessageInfo
+
+func (m *DecisionTaskTimedOutEventAttributes) GetScheduledEventId() int64 {
+	if m != nil {
+		return m.ScheduledEventId
+	}
+	return 0
+}
+
+func (m *DecisionTaskTimedOutEventAttributes) GetStartedEventId() int64 {
+	if m != nil {
+		return m.StartedEventId
+	}
+	return 0
+}
+
+func (m *DecisionTaskTimedOutEventAttributes) GetTimeoutType() TimeoutType {
+	if m != nil {
+		return m.TimeoutType
+	}
+	return TimeoutType_TIMEOUT_TYPE_INVALID
+}
+
+func (m *DecisionTaskTimedOutEventAttributes) GetBaseRunId() string {
+	if m != nil {
+		return m.BaseRunId
+	}
+	return ""
+}
+
+func (m *DecisionTaskTimedOutEventAttributes) GetNewRunId() string {
+	if m != nil {
+		return m.NewRunId
+	}
+	return ""
+}
+
+func (m *DecisionTaskTimedOutEventAttributes) GetForkEventVersion() int64 {
+	if m != nil {
+		return m.ForkEventVersion
+	}
+	return 0
+}
+
+func (m *DecisionTaskTimedOutEventAttributes) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DecisionTaskTimedOutEventAttributes) GetCause() DecisionTaskTimedOutCause {
+	if m != nil {
+		return m.Cause
+	}
+	return DecisionTaskTimedOutCause_DECISION_TASK_TIMED_OUT_CAUSE_INVALID
+}
+
+func (m *DecisionTaskTimedOutEventAttributes) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type DecisionTaskFailedEventAttributes struct {
+	ScheduledEventId int64                   `protobuf:"varint,1,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
+	StartedEventId   int64                   `protobuf:"varint,2,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
+	Cause            DecisionTaskFailedCause `protobuf:"varint,3,opt,name=cause,proto3,enum=uber.cadence.api.v1.DecisionTaskFailedCause" json:"cause,omitempty"`
+	Failure          *Failure                `protobuf:"bytes,4,opt,name=failure,proto3" json:"failure,omitempty"`
+	Identity         string                  `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	// For reset workflow.
+	BaseRunId            string   `protobuf:"bytes,6,opt,name=base_run_id,json=baseRunId,proto3" json:"base_run_id,omitempty"`
+	NewRunId             string   `protobuf:"bytes,7,opt,name=new_run_id,json=newRunId,proto3" json:"new_run_id,omitempty"`
+	ForkEventVersion     int64    `protobuf:"varint,8,opt,name=fork_event_version,json=forkEventVersion,proto3" json:"fork_event_version,omitempty"`
+	BinaryChecksum       string   `protobuf:"bytes,9,opt,name=binary_checksum,json=binaryChecksum,proto3" json:"binary_checksum,omitempty"`
+	RequestId            string   `protobuf:"bytes,10,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecisionTaskFailedEventAttributes) Reset()         { *m = DecisionTaskFailedEventAttributes{} }
+func (m *DecisionTaskFailedEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*DecisionTaskFailedEventAttributes) ProtoMessage()    {}
+func (*DecisionTaskFailedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{10}
+}
+func (m *DecisionTaskFailedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecisionTaskFailedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecisionTaskFailedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecisionTaskFailedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecisionTaskFailedEventAttributes.Merge(m, src)
+}
+func (m *DecisionTaskFailedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecisionTaskFailedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecisionTaskFailedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecisionTaskFailedEventAttributes proto.InternalMessageInfo
+
+func (m *DecisionTaskFailedEventAttributes) GetScheduledEventId() int64 {
+	if m != nil {
+		return m.ScheduledEventId
+	}
+	return 0
+}
+
+func (m *DecisionTaskFailedEventAttributes) GetStartedEventId() int64 {
+	if m != nil {
+		return m.StartedEventId
+	}
+	return 0
+}
+
+func (m *DecisionTaskFailedEventAttributes) GetCause() DecisionTaskFailedCause {
+	if m != nil {
+		return m.Cause
+	}
+	return DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_INVALID
+}
+
+func (m *DecisionTaskFailedEventAttributes) GetFailure() *Failure {
+	if m != nil {
+		return m.Failure
+	}
+	return nil
+}
+
+func (m *DecisionTaskFailedEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *DecisionTaskFailedEventAttributes) GetBaseRunId() string {
+	if m != nil {
+		return m.BaseRunId
+	}
+	return ""
+}
+
+func (m *DecisionTaskFailedEventAttributes) GetNewRunId() string {
+	if m != nil {
+		return m.NewRunId
+	}
+	return ""
+}
+
+func (m *DecisionTaskFailedEventAttributes) GetForkEventVersion() int64 {
+	if m != nil {
+		return m.ForkEventVersion
+	}
+	return 0
+}
+
+func (m *DecisionTaskFailedEventAttributes) GetBinaryChecksum() string {
+	if m != nil {
+		return m.BinaryChecksum
+	}
+	return ""
+}
+
+func (m *DecisionTaskFailedEventAttributes) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type ActivityTaskScheduledEventAttributes struct {
+	ActivityId                   string          `protobuf:"bytes,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	ActivityType                 *ActivityType   `protobuf:"bytes,2,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	Domain                       string          `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	TaskList                     *TaskList       `protobuf:"bytes,4,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	Input                        *Payload        `protobuf:"bytes,6,opt,name=input,proto3" json:"input,omitempty"`
+	ScheduleToCloseTimeout       *types.Duration `protobuf:"bytes,7,opt,name=schedule_to_close_timeout,json=scheduleToCloseTimeout,proto3" json:"schedule_to_close_timeout,omitempty"`
+	ScheduleToStartTimeout       *types.Duration `protobuf:"bytes,8,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3" json:"schedule_to_start_timeout,omitempty"`
+	StartToCloseTimeout          *types.Duration `protobuf:"bytes,9,opt,name=start_to_close_timeout,json=startToCloseTimeout,proto3" json:"start_to_close_timeout,omitempty"`
+	HeartbeatTimeout             *types.Duration `protobuf:"bytes,10,opt,name=heartbeat_timeout,json=heartbeatTimeout,proto3" json:"heartbeat_timeout,omitempty"`
+	DecisionTaskCompletedEventId int64           `protobuf:"varint,11,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	RetryPolicy                  *RetryPolicy    `protobuf:"bytes,12,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	Header                       *Header         `protobuf:"bytes,13,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}        `json:"-"`
+	XXX_unrecognized             []byte          `json:"-"`
+	XXX_sizecache                int32           `json:"-"`
+}
+
+func (m *ActivityTaskScheduledEventAttributes) Reset()         { *m = ActivityTaskScheduledEventAttributes{} }
+func (m *ActivityTaskScheduledEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*ActivityTaskScheduledEventAttributes) ProtoMessage()    {}
+func (*ActivityTaskScheduledEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{11}
+}
+func (m *ActivityTaskScheduledEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActivityTaskScheduledEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivityTaskScheduledEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActivityTaskScheduledEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivityTaskScheduledEventAttributes.Merge(m, src)
+}
+func (m *ActivityTaskScheduledEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActivityTaskScheduledEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivityTaskScheduledEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivityTaskScheduledEventAttributes proto.InternalMessageInfo
+
+func (m *ActivityTaskScheduledEventAttributes) GetActivityId() string {
+	if m != nil {
+		return m.ActivityId
+	}
+	return ""
+}
+
+func (m *ActivityTaskScheduledEventAttributes) GetActivityType() *ActivityType {
+	if m != nil {
+		return m.ActivityType
+	}
+	return nil
+}
+
+func (m *ActivityTaskScheduledEventAttributes) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ActivityTaskScheduledEventAttributes) GetTaskList() *TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *ActivityTaskScheduledEventAttributes) GetInput() *Payload {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *ActivityTaskScheduledEventAttributes) GetScheduleToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.ScheduleToCloseTimeout
+	}
+	return nil
+}
+
+func (m *ActivityTaskScheduledEventAttributes) GetScheduleToStartTimeout() *types.Duration {
+	if m != nil {
+		return m.ScheduleToStartTimeout
+	}
+	return nil
+}
+
+func (m *ActivityTaskScheduledEventAttributes) GetStartToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.StartToCloseTimeout
+	}
+	return nil
+}
+
+func (m *ActivityTaskScheduledEventAttributes) GetHeartbeatTimeout() *types.Duration {
+	if m != nil {
+		return m.HeartbeatTimeout
+	}
+	return nil
+}
+
+func (m *ActivityTaskScheduledEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+func (m *ActivityTaskScheduledEventAttributes) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *ActivityTaskScheduledEventAttributes) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type ActivityTaskStartedEventAttributes struct {
+	ScheduledEventId     int64    `protobuf:"varint,1,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
+	Identity             string   `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string   `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Attempt              int32    `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	LastFailure          *Failure `protobuf:"bytes,5,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActivityTaskStartedEventAttributes) Reset()         { *m = ActivityTaskStartedEventAttributes{} }
+func (m *ActivityTaskStartedEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*ActivityTaskStartedEventAttributes) ProtoMessage()    {}
+func (*ActivityTaskStartedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{12}
+}
+func (m *ActivityTaskStartedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActivityTaskStartedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivityTaskStartedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActivityTaskStartedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivityTaskStartedEventAttributes.Merge(m, src)
+}
+func (m *ActivityTaskStartedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActivityTaskStartedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivityTaskStartedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivityTaskStartedEventAttributes proto.InternalMessageInfo
+
+func (m *ActivityTaskStartedEventAttributes) GetScheduledEventId() int64 {
+	if m != nil {
+		return m.ScheduledEventId
+	}
+	return 0
+}
+
+func (m *ActivityTaskStartedEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *ActivityTaskStartedEventAttributes) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *ActivityTaskStartedEventAttributes) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *ActivityTaskStartedEventAttributes) GetLastFailure() *Failure {
+	if m != nil {
+		return m.LastFailure
+	}
+	return nil
+}
+
+type ActivityTaskCompletedEventAttributes struct {
+	Result               *Payload `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	ScheduledEventId     int64    `protobuf:"varint,2,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
+	StartedEventId       int64    `protobuf:"varint,3,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
+	Identity             string   `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActivityTaskCompletedEventAttributes) Reset()         { *m = ActivityTaskCompletedEventAttributes{} }
+func (m *ActivityTaskCompletedEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*ActivityTaskCompletedEventAttributes) ProtoMessage()    {}
+func (*ActivityTaskCompletedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{13}
+}
+func (m *ActivityTaskCompletedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActivityTaskCompletedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivityTaskCompletedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActivityTaskCompletedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivityTaskCompletedEventAttributes.Merge(m, src)
+}
+func (m *ActivityTaskCompletedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActivityTaskCompletedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivityTaskCompletedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivityTaskCompletedEventAttributes proto.InternalMessageInfo
+
+func (m *ActivityTaskCompletedEventAttributes) GetResult() *Payload {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *ActivityTaskCompletedEventAttributes) GetScheduledEventId() int64 {
+	if m != nil {
+		return m.ScheduledEventId
+	}
+	return 0
+}
+
+func (m *ActivityTaskCompletedEventAttributes) GetStartedEventId() int64 {
+	if m != nil {
+		return m.StartedEventId
+	}
+	return 0
+}
+
+func (m *ActivityTaskCompletedEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type ActivityTaskFailedEventAttributes struct {
+	Failure              *Failure `protobuf:"bytes,1,opt,name=failure,proto3" json:"failure,omitempty"`
+	ScheduledEventId     int64    `protobuf:"varint,2,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
+	StartedEventId       int64    `protobuf:"varint,3,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
+	Identity             string   `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActivityTaskFailedEventAttributes) Reset()         { *m = ActivityTaskFailedEventAttributes{} }
+func (m *ActivityTaskFailedEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*ActivityTaskFailedEventAttributes) ProtoMessage()    {}
+func (*ActivityTaskFailedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{14}
+}
+func (m *ActivityTaskFailedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActivityTaskFailedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivityTaskFailedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActivityTaskFailedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivityTaskFailedEventAttributes.Merge(m, src)
+}
+func (m *ActivityTaskFailedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActivityTaskFailedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivityTaskFailedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivityTaskFailedEventAttributes proto.InternalMessageInfo
+
+func (m *ActivityTaskFailedEventAttributes) GetFailure() *Failure {
+	if m != nil {
+		return m.Failure
+	}
+	return nil
+}
+
+func (m *ActivityTaskFailedEventAttributes) GetScheduledEventId() int64 {
+	if m != nil {
+		return m.ScheduledEventId
+	}
+	return 0
+}
+
+func (m *ActivityTaskFailedEventAttributes) GetStartedEventId() int64 {
+	if m != nil {
+		return m.StartedEventId
+	}
+	return 0
+}
+
+func (m *ActivityTaskFailedEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type ActivityTaskTimedOutEventAttributes struct {
+	Details          *Payload    `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	ScheduledEventId int64       `protobuf:"varint,2,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
+	StartedEventId   int64       `protobuf:"varint,3,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
+	TimeoutType      TimeoutType `protobuf:"varint,4,opt,name=timeout_type,json=timeoutType,proto3,enum=uber.cadence.api.v1.TimeoutType" json:"timeout_type,omitempty"`
+	// For retry activity, it may have a failure before timeout. It's important to keep those information for debug.
+	// Client can also provide the info for making next decision
+	LastFailure          *Failure `protobuf:"bytes,5,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActivityTaskTimedOutEventAttributes) Reset()         { *m = ActivityTaskTimedOutEventAttributes{} }
+func (m *ActivityTaskTimedOutEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*ActivityTaskTimedOutEventAttributes) ProtoMessage()    {}
+func (*ActivityTaskTimedOutEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{15}
+}
+func (m *ActivityTaskTimedOutEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActivityTaskTimedOutEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivityTaskTimedOutEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActivityTaskTimedOutEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivityTaskTimedOutEventAttributes.Merge(m, src)
+}
+func (m *ActivityTaskTimedOutEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActivityTaskTimedOutEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivityTaskTimedOutEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivityTaskTimedOutEventAttributes proto.InternalMessageInfo
+
+func (m *ActivityTaskTimedOutEventAttributes) GetDetails() *Payload {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *ActivityTaskTimedOutEventAttributes) GetScheduledEventId() int64 {
+	if m != nil {
+		return m.ScheduledEventId
+	}
+	return 0
+}
+
+func (m *ActivityTaskTimedOutEventAttributes) GetStartedEventId() int64 {
+	if m != nil {
+		return m.StartedEventId
+	}
+	return 0
+}
+
+func (m *ActivityTaskTimedOutEventAttributes) GetTimeoutType() TimeoutType {
+	if m != nil {
+		return m.TimeoutType
+	}
+	return TimeoutType_TIMEOUT_TYPE_INVALID
+}
+
+func (m *ActivityTaskTimedOutEventAttributes) GetLastFailure() *Failure {
+	if m != nil {
+		return m.LastFailure
+	}
+	return nil
+}
+
+type ActivityTaskCancelRequestedEventAttributes struct {
+	ActivityId                   string   `protobuf:"bytes,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	DecisionTaskCompletedEventId int64    `protobuf:"varint,2,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *ActivityTaskCancelRequestedEventAttributes) Reset() {
+	*m = ActivityTaskCancelRequestedEventAttributes{}
+}
+func (m *ActivityTaskCancelRequestedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ActivityTaskCancelRequestedEventAttributes) ProtoMessage() {}
+func (*ActivityTaskCancelRequestedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{16}
+}
+func (m *ActivityTaskCancelRequestedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActivityTaskCancelRequestedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivityTaskCancelRequestedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActivityTaskCancelRequestedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivityTaskCancelRequestedEventAttributes.Merge(m, src)
+}
+func (m *ActivityTaskCancelRequestedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActivityTaskCancelRequestedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivityTaskCancelRequestedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivityTaskCancelRequestedEventAttributes proto.InternalMessageInfo
+
+func (m *ActivityTaskCancelRequestedEventAttributes) GetActivityId() string {
+	if m != nil {
+		return m.ActivityId
+	}
+	return ""
+}
+
+func (m *ActivityTaskCancelRequestedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+type RequestCancelActivityTaskFailedEventAttributes struct {
+	ActivityId                   string   `protobuf:"bytes,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Cause                        string   `protobuf:"bytes,2,opt,name=cause,proto3" json:"cause,omitempty"`
+	DecisionTaskCompletedEventId int64    `protobuf:"varint,3,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *RequestCancelActivityTaskFailedEventAttributes) Reset() {
+	*m = RequestCancelActivityTaskFailedEventAttributes{}
+}
+func (m *RequestCancelActivityTaskFailedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RequestCancelActivityTaskFailedEventAttributes) ProtoMessage() {}
+func (*RequestCancelActivityTaskFailedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{17}
+}
+func (m *RequestCancelActivityTaskFailedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestCancelActivityTaskFailedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestCancelActivityTaskFailedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestCancelActivityTaskFailedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestCancelActivityTaskFailedEventAttributes.Merge(m, src)
+}
+func (m *RequestCancelActivityTaskFailedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestCancelActivityTaskFailedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestCancelActivityTaskFailedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestCancelActivityTaskFailedEventAttributes proto.InternalMessageInfo
+
+func (m *RequestCancelActivityTaskFailedEventAttributes) GetActivityId() string {
+	if m != nil {
+		return m.ActivityId
+	}
+	return ""
+}
+
+func (m *RequestCancelActivityTaskFailedEventAttributes) GetCause() string {
+	if m != nil {
+		return m.Cause
+	}
+	return ""
+}
+
+func (m *RequestCancelActivityTaskFailedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+type ActivityTaskCanceledEventAttributes struct {
+	Details                      *Payload `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	LatestCancelRequestedEventId int64    `protobuf:"varint,2,opt,name=latest_cancel_requested_event_id,json=latestCancelRequestedEventId,proto3" json:"latest_cancel_requested_event_id,omitempty"`
+	ScheduledEventId             int64    `protobuf:"varint,3,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
+	StartedEventId               int64    `protobuf:"varint,4,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
+	Identity                     string   `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *ActivityTaskCanceledEventAttributes) Reset()         { *m = ActivityTaskCanceledEventAttributes{} }
+func (m *ActivityTaskCanceledEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*ActivityTaskCanceledEventAttributes) ProtoMessage()    {}
+func (*ActivityTaskCanceledEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{18}
+}
+func (m *ActivityTaskCanceledEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActivityTaskCanceledEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivityTaskCanceledEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActivityTaskCanceledEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivityTaskCanceledEventAttributes.Merge(m, src)
+}
+func (m *ActivityTaskCanceledEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActivityTaskCanceledEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivityTaskCanceledEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivityTaskCanceledEventAttributes proto.InternalMessageInfo
+
+func (m *ActivityTaskCanceledEventAttributes) GetDetails() *Payload {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *ActivityTaskCanceledEventAttributes) GetLatestCancelRequestedEventId() int64 {
+	if m != nil {
+		return m.LatestCancelRequestedEventId
+	}
+	return 0
+}
+
+func (m *ActivityTaskCanceledEventAttributes) GetScheduledEventId() int64 {
+	if m != nil {
+		return m.ScheduledEventId
+	}
+	return 0
+}
+
+func (m *ActivityTaskCanceledEventAttributes) GetStartedEventId() int64 {
+	if m != nil {
+		return m.StartedEventId
+	}
+	return 0
+}
+
+func (m *ActivityTaskCanceledEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type TimerStartedEventAttributes struct {
+	TimerId                      string          `protobuf:"bytes,1,opt,name=timer_id,json=timerId,proto3" json:"timer_id,omitempty"`
+	StartToFireTimeout           *types.Duration `protobuf:"bytes,2,opt,name=start_to_fire_timeout,json=startToFireTimeout,proto3" json:"start_to_fire_timeout,omitempty"`
+	DecisionTaskCompletedEventId int64           `protobuf:"varint,3,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}        `json:"-"`
+	XXX_unrecognized             []byte          `json:"-"`
+	XXX_sizecache                int32           `json:"-"`
+}
+
+func (m *TimerStartedEventAttributes) Reset()         { *m = TimerStartedEventAttributes{} }
+func (m *TimerStartedEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*TimerStartedEventAttributes) ProtoMessage()    {}
+func (*TimerStartedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{19}
+}
+func (m *TimerStartedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimerStartedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimerStartedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimerStartedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimerStartedEventAttributes.Merge(m, src)
+}
+func (m *TimerStartedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimerStartedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimerStartedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimerStartedEventAttributes proto.InternalMessageInfo
+
+func (m *TimerStartedEventAttributes) GetTimerId() string {
+	if m != nil {
+		return m.TimerId
+	}
+	return ""
+}
+
+func (m *TimerStartedEventAttributes) GetStartToFireTimeout() *types.Duration {
+	if m != nil {
+		return m.StartToFireTimeout
+	}
+	return nil
+}
+
+func (m *TimerStartedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+type TimerFiredEventAttributes struct {
+	TimerId              string   `protobuf:"bytes,1,opt,name=timer_id,json=timerId,proto3" json:"timer_id,omitempty"`
+	StartedEventId       int64    `protobuf:"varint,2,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TimerFiredEventAttributes) Reset()         { *m = TimerFiredEventAttributes{} }
+func (m *TimerFiredEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*TimerFiredEventAttributes) ProtoMessage()    {}
+func (*TimerFiredEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{20}
+}
+func (m *TimerFiredEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimerFiredEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimerFiredEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimerFiredEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimerFiredEventAttributes.Merge(m, src)
+}
+func (m *TimerFiredEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimerFiredEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimerFiredEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimerFiredEventAttributes proto.InternalMessageInfo
+
+func (m *TimerFiredEventAttributes) GetTimerId() string {
+	if m != nil {
+		return m.TimerId
+	}
+	return ""
+}
+
+func (m *TimerFiredEventAttributes) GetStartedEventId() int64 {
+	if m != nil {
+		return m.StartedEventId
+	}
+	return 0
+}
+
+type TimerCanceledEventAttributes struct {
+	TimerId                      string   `protobuf:"bytes,1,opt,name=timer_id,json=timerId,proto3" json:"timer_id,omitempty"`
+	StartedEventId               int64    `protobuf:"varint,2,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
+	DecisionTaskCompletedEventId int64    `protobuf:"varint,3,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	Identity                     string   `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *TimerCanceledEventAttributes) Reset()         { *m = TimerCanceledEventAttributes{} }
+func (m *TimerCanceledEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*TimerCanceledEventAttributes) ProtoMessage()    {}
+func (*TimerCanceledEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{21}
+}
+func (m *TimerCanceledEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimerCanceledEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimerCanceledEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimerCanceledEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimerCanceledEventAttributes.Merge(m, src)
+}
+func (m *TimerCanceledEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimerCanceledEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimerCanceledEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimerCanceledEventAttributes proto.InternalMessageInfo
+
+func (m *TimerCanceledEventAttributes) GetTimerId() string {
+	if m != nil {
+		return m.TimerId
+	}
+	return ""
+}
+
+func (m *TimerCanceledEventAttributes) GetStartedEventId() int64 {
+	if m != nil {
+		return m.StartedEventId
+	}
+	return 0
+}
+
+func (m *TimerCanceledEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+func (m *TimerCanceledEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type CancelTimerFailedEventAttributes struct {
+	TimerId                      string   `protobuf:"bytes,1,opt,name=timer_id,json=timerId,proto3" json:"timer_id,omitempty"`
+	Cause                        string   `protobuf:"bytes,2,opt,name=cause,proto3" json:"cause,omitempty"`
+	DecisionTaskCompletedEventId int64    `protobuf:"varint,3,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	Identity                     string   `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *CancelTimerFailedEventAttributes) Reset()         { *m = CancelTimerFailedEventAttributes{} }
+func (m *CancelTimerFailedEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*CancelTimerFailedEventAttributes) ProtoMessage()    {}
+func (*CancelTimerFailedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{22}
+}
+func (m *CancelTimerFailedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelTimerFailedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelTimerFailedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelTimerFailedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelTimerFailedEventAttributes.Merge(m, src)
+}
+func (m *CancelTimerFailedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelTimerFailedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelTimerFailedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelTimerFailedEventAttributes proto.InternalMessageInfo
+
+func (m *CancelTimerFailedEventAttributes) GetTimerId() string {
+	if m != nil {
+		return m.TimerId
+	}
+	return ""
+}
+
+func (m *CancelTimerFailedEventAttributes) GetCause() string {
+	if m != nil {
+		return m.Cause
+	}
+	return ""
+}
+
+func (m *CancelTimerFailedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+func (m *CancelTimerFailedEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type WorkflowExecutionContinuedAsNewEventAttributes struct {
+	NewExecutionRunId            string                 `protobuf:"bytes,1,opt,name=new_execution_run_id,json=newExecutionRunId,proto3" json:"new_execution_run_id,omitempty"`
+	WorkflowType                 *WorkflowType          `protobuf:"bytes,2,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	TaskList                     *TaskList              `protobuf:"bytes,3,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	Input                        *Payload               `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	ExecutionStartToCloseTimeout *types.Duration        `protobuf:"bytes,5,opt,name=execution_start_to_close_timeout,json=executionStartToCloseTimeout,proto3" json:"execution_start_to_close_timeout,omitempty"`
+	TaskStartToCloseTimeout      *types.Duration        `protobuf:"bytes,6,opt,name=task_start_to_close_timeout,json=taskStartToCloseTimeout,proto3" json:"task_start_to_close_timeout,omitempty"`
+	DecisionTaskCompletedEventId int64                  `protobuf:"varint,7,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	BackoffStartInterval         *types.Duration        `protobuf:"bytes,8,opt,name=backoff_start_interval,json=backoffStartInterval,proto3" json:"backoff_start_interval,omitempty"`
+	Initiator                    ContinueAsNewInitiator `protobuf:"varint,9,opt,name=initiator,proto3,enum=uber.cadence.api.v1.ContinueAsNewInitiator" json:"initiator,omitempty"`
+	Failure                      *Failure               `protobuf:"bytes,10,opt,name=failure,proto3" json:"failure,omitempty"`
+	LastCompletionResult         *Payload               `protobuf:"bytes,11,opt,name=last_completion_result,json=lastCompletionResult,proto3" json:"last_completion_result,omitempty"`
+	Header                       *Header                `protobuf:"bytes,12,opt,name=header,proto3" json:"header,omitempty"`
+	Memo                         *Memo                  `protobuf:"bytes,13,opt,name=memo,proto3" json:"memo,omitempty"`
+	SearchAttributes             *SearchAttributes      `protobuf:"bytes,14,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}               `json:"-"`
+	XXX_unrecognized             []byte                 `json:"-"`
+	XXX_sizecache                int32                  `json:"-"`
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) Reset() {
+	*m = WorkflowExecutionContinuedAsNewEventAttributes{}
+}
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*WorkflowExecutionContinuedAsNewEventAttributes) ProtoMessage() {}
+func (*WorkflowExecutionContinuedAsNewEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{23}
+}
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionContinuedAsNewEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionContinuedAsNewEventAttributes.Merge(m, src)
+}
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionContinuedAsNewEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionContinuedAsNewEventAttributes proto.InternalMessageInfo
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) GetNewExecutionRunId() string {
+	if m != nil {
+		return m.NewExecutionRunId
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) GetWorkflowType() *WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) GetTaskList() *TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) GetInput() *Payload {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) GetExecutionStartToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.ExecutionStartToCloseTimeout
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) GetTaskStartToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.TaskStartToCloseTimeout
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) GetBackoffStartInterval() *types.Duration {
+	if m != nil {
+		return m.BackoffStartInterval
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) GetInitiator() ContinueAsNewInitiator {
+	if m != nil {
+		return m.Initiator
+	}
+	return ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_INVALID
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) GetFailure() *Failure {
+	if m != nil {
+		return m.Failure
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) GetLastCompletionResult() *Payload {
+	if m != nil {
+		return m.LastCompletionResult
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) GetMemo() *Memo {
+	if m != nil {
+		return m.Memo
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) GetSearchAttributes() *SearchAttributes {
+	if m != nil {
+		return m.SearchAttributes
+	}
+	return nil
+}
+
+type WorkflowExecutionCancelRequestedEventAttributes struct {
+	Cause                 string                 `protobuf:"bytes,1,opt,name=cause,proto3" json:"cause,omitempty"`
+	Identity              string                 `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	ExternalExecutionInfo *ExternalExecutionInfo `protobuf:"bytes,3,opt,name=external_execution_info,json=externalExecutionInfo,proto3" json:"external_execution_info,omitempty"`
+	RequestId             string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}               `json:"-"`
+	XXX_unrecognized      []byte                 `json:"-"`
+	XXX_sizecache         int32                  `json:"-"`
+}
+
+func (m *WorkflowExecutionCancelRequestedEventAttributes) Reset() {
+	*m = WorkflowExecutionCancelRequestedEventAttributes{}
+}
+func (m *WorkflowExecutionCancelRequestedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*WorkflowExecutionCancelRequestedEventAttributes) ProtoMessage() {}
+func (*WorkflowExecutionCancelRequestedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{24}
+}
+func (m *WorkflowExecutionCancelRequestedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionCancelRequestedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionCancelRequestedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionCancelRequestedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionCancelRequestedEventAttributes.Merge(m, src)
+}
+func (m *WorkflowExecutionCancelRequestedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionCancelRequestedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionCancelRequestedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionCancelRequestedEventAttributes proto.InternalMessageInfo
+
+func (m *WorkflowExecutionCancelRequestedEventAttributes) GetCause() string {
+	if m != nil {
+		return m.Cause
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionCancelRequestedEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionCancelRequestedEventAttributes) GetExternalExecutionInfo() *ExternalExecutionInfo {
+	if m != nil {
+		return m.ExternalExecutionInfo
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionCancelRequestedEventAttributes) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type WorkflowExecutionCanceledEventAttributes struct {
+	DecisionTaskCompletedEventId int64    `protobuf:"varint,1,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	Details                      *Payload `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *WorkflowExecutionCanceledEventAttributes) Reset() {
+	*m = WorkflowExecutionCanceledEventAttributes{}
+}
+func (m *WorkflowExecutionCanceledEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*WorkflowExecutionCanceledEventAttributes) ProtoMessage()    {}
+func (*WorkflowExecutionCanceledEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{25}
+}
+func (m *WorkflowExecutionCanceledEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionCanceledEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionCanceledEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionCanceledEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionCanceledEventAttributes.Merge(m, src)
+}
+func (m *WorkflowExecutionCanceledEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionCanceledEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionCanceledEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionCanceledEventAttributes proto.InternalMessageInfo
+
+func (m *WorkflowExecutionCanceledEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionCanceledEventAttributes) GetDetails() *Payload {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+type MarkerRecordedEventAttributes struct {
+	MarkerName                   string   `protobuf:"bytes,1,opt,name=marker_name,json=markerName,proto3" json:"marker_name,omitempty"`
+	Details                      *Payload `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	DecisionTaskCompletedEventId int64    `protobuf:"varint,3,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	Header                       *Header  `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *MarkerRecordedEventAttributes) Reset()         { *m = MarkerRecordedEventAttributes{} }
+func (m *MarkerRecordedEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*MarkerRecordedEventAttributes) ProtoMessage()    {}
+func (*MarkerRecordedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{26}
+}
+func (m *MarkerRecordedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkerRecordedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkerRecordedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarkerRecordedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkerRecordedEventAttributes.Merge(m, src)
+}
+func (m *MarkerRecordedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkerRecordedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkerRecordedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkerRecordedEventAttributes proto.InternalMessageInfo
+
+func (m *MarkerRecordedEventAttributes) GetMarkerName() string {
+	if m != nil {
+		return m.MarkerName
+	}
+	return ""
+}
+
+func (m *MarkerRecordedEventAttributes) GetDetails() *Payload {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *MarkerRecordedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+func (m *MarkerRecordedEventAttributes) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type WorkflowExecutionSignaledEventAttributes struct {
+	SignalName           string   `protobuf:"bytes,1,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
+	Input                *Payload `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	Identity             string   `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string   `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowExecutionSignaledEventAttributes) Reset() {
+	*m = WorkflowExecutionSignaledEventAttributes{}
+}
+func (m *WorkflowExecutionSignaledEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*WorkflowExecutionSignaledEventAttributes) ProtoMessage()    {}
+func (*WorkflowExecutionSignaledEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{27}
+}
+func (m *WorkflowExecutionSignaledEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionSignaledEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionSignaledEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionSignaledEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionSignaledEventAttributes.Merge(m, src)
+}
+func (m *WorkflowExecutionSignaledEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionSignaledEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionSignaledEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionSignaledEventAttributes proto.InternalMessageInfo
+
+func (m *WorkflowExecutionSignaledEventAttributes) GetSignalName() string {
+	if m != nil {
+		return m.SignalName
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionSignaledEventAttributes) GetInput() *Payload {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionSignaledEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionSignaledEventAttributes) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type WorkflowExecutionTerminatedEventAttributes struct {
+	Reason               string   `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Details              *Payload `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	Identity             string   `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowExecutionTerminatedEventAttributes) Reset() {
+	*m = WorkflowExecutionTerminatedEventAttributes{}
+}
+func (m *WorkflowExecutionTerminatedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*WorkflowExecutionTerminatedEventAttributes) ProtoMessage() {}
+func (*WorkflowExecutionTerminatedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{28}
+}
+func (m *WorkflowExecutionTerminatedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionTerminatedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionTerminatedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionTerminatedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionTerminatedEventAttributes.Merge(m, src)
+}
+func (m *WorkflowExecutionTerminatedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionTerminatedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionTerminatedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionTerminatedEventAttributes proto.InternalMessageInfo
+
+func (m *WorkflowExecutionTerminatedEventAttributes) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionTerminatedEventAttributes) GetDetails() *Payload {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionTerminatedEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type WorkflowExecutionPausedEventAttributes struct {
+	Reason               string   `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity             string   `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string   `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowExecutionPausedEventAttributes) Reset() {
+	*m = WorkflowExecutionPausedEventAttributes{}
+}
+func (m *WorkflowExecutionPausedEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*WorkflowExecutionPausedEventAttributes) ProtoMessage()    {}
+func (*WorkflowExecutionPausedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{29}
+}
+func (m *WorkflowExecutionPausedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionPausedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionPausedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionPausedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionPausedEventAttributes.Merge(m, src)
+}
+func (m *WorkflowExecutionPausedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionPausedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionPausedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionPausedEventAttributes proto.InternalMessageInfo
+
+func (m *WorkflowExecutionPausedEventAttributes) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionPausedEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionPausedEventAttributes) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type WorkflowExecutionUnpausedEventAttributes struct {
+	Reason               string   `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity             string   `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string   `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowExecutionUnpausedEventAttributes) Reset() {
+	*m = WorkflowExecutionUnpausedEventAttributes{}
+}
+func (m *WorkflowExecutionUnpausedEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*WorkflowExecutionUnpausedEventAttributes) ProtoMessage()    {}
+func (*WorkflowExecutionUnpausedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{30}
+}
+func (m *WorkflowExecutionUnpausedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowExecutionUnpausedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowExecutionUnpausedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowExecutionUnpausedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecutionUnpausedEventAttributes.Merge(m, src)
+}
+func (m *WorkflowExecutionUnpausedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowExecutionUnpausedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecutionUnpausedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecutionUnpausedEventAttributes proto.InternalMessageInfo
+
+func (m *WorkflowExecutionUnpausedEventAttributes) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionUnpausedEventAttributes) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionUnpausedEventAttributes) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
+	DecisionTaskCompletedEventId int64              `protobuf:"varint,1,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	Domain                       string             `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution            *WorkflowExecution `protobuf:"bytes,3,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Control                      []byte             `protobuf:"bytes,4,opt,name=control,proto3" json:"control,omitempty"`
+	ChildWorkflowOnly            bool               `protobuf:"varint,5,opt,name=child_workflow_only,json=childWorkflowOnly,proto3" json:"child_workflow_only,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}           `json:"-"`
+	XXX_unrecognized             []byte             `json:"-"`
+	XXX_sizecache                int32              `json:"-"`
+}
+
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Reset() {
+	*m = RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{}
+}
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) ProtoMessage() {}
+func (*RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{31}
+}
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.Merge(m, src)
+}
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes proto.InternalMessageInfo
+
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetControl() []byte {
+	if m != nil {
+		return m.Control
+	}
+	return nil
+}
+
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetChildWorkflowOnly() bool {
+	if m != nil {
+		return m.ChildWorkflowOnly
+	}
+	return false
+}
+
+type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
+	Cause                        CancelExternalWorkflowExecutionFailedCause `protobuf:"varint,1,opt,name=cause,proto3,enum=uber.cadence.api.v1.CancelExternalWorkflowExecutionFailedCause" json:"cause,omitempty"`
+	DecisionTaskCompletedEventId int64                                      `protobuf:"varint,2,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	Domain                       string                                     `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution            *WorkflowExecution                         `protobuf:"bytes,4,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	InitiatedEventId             int64                                      `protobuf:"varint,5,opt,name=initiated_event_id,json=initiatedEventId,proto3" json:"initiated_event_id,omitempty"`
+	Control                      []byte                                     `protobuf:"bytes,6,opt,name=control,proto3" json:"control,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                                   `json:"-"`
+	XXX_unrecognized             []byte                                     `json:"-"`
+	XXX_sizecache                int32                                      `json:"-"`
+}
+
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Reset() {
+	*m = RequestCancelExternalWorkflowExecutionFailedEventAttributes{}
+}
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RequestCancelExternalWorkflowExecutionFailedEventAttributes) ProtoMessage() {}
+func (*RequestCancelExternalWorkflowExecutionFailedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{32}
+}
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestCancelExternalWorkflowExecutionFailedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestCancelExternalWorkflowExecutionFailedEventAttributes.Merge(m, src)
+}
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestCancelExternalWorkflowExecutionFailedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestCancelExternalWorkflowExecutionFailedEventAttributes proto.InternalMessageInfo
+
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetCause() CancelExternalWorkflowExecutionFailedCause {
+	if m != nil {
+		return m.Cause
+	}
+	return CancelExternalWorkflowExecutionFailedCause_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+}
+
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetInitiatedEventId() int64 {
+	if m != nil {
+		return m.InitiatedEventId
+	}
+	return 0
+}
+
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetControl() []byte {
+	if m != nil {
+		return m.Control
+	}
+	return nil
+}
+
+type ExternalWorkflowExecutionCancelRequestedEventAttributes struct {
+	InitiatedEventId     int64              `protobuf:"varint,1,opt,name=initiated_event_id,json=initiatedEventId,proto3" json:"initiated_event_id,omitempty"`
+	Domain               string             `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,3,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) Reset() {
+	*m = ExternalWorkflowExecutionCancelRequestedEventAttributes{}
+}
+func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ExternalWorkflowExecutionCancelRequestedEventAttributes) ProtoMessage() {}
+func (*ExternalWorkflowExecutionCancelRequestedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{33}
+}
+func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalWorkflowExecutionCancelRequestedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalWorkflowExecutionCancelRequestedEventAttributes.Merge(m, src)
+}
+func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalWorkflowExecutionCancelRequestedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalWorkflowExecutionCancelRequestedEventAttributes proto.InternalMessageInfo
+
+func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) GetInitiatedEventId() int64 {
+	if m != nil {
+		return m.InitiatedEventId
+	}
+	return 0
+}
+
+func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+type SignalExternalWorkflowExecutionInitiatedEventAttributes struct {
+	DecisionTaskCompletedEventId int64              `protobuf:"varint,1,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	Domain                       string             `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution            *WorkflowExecution `protobuf:"bytes,3,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	SignalName                   string             `protobuf:"bytes,4,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
+	Input                        *Payload           `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
+	Control                      []byte             `protobuf:"bytes,6,opt,name=control,proto3" json:"control,omitempty"`
+	ChildWorkflowOnly            bool               `protobuf:"varint,7,opt,name=child_workflow_only,json=childWorkflowOnly,proto3" json:"child_workflow_only,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}           `json:"-"`
+	XXX_unrecognized             []byte             `json:"-"`
+	XXX_sizecache                int32              `json:"-"`
+}
+
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) Reset() {
+	*m = SignalExternalWorkflowExecutionInitiatedEventAttributes{}
+}
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*SignalExternalWorkflowExecutionInitiatedEventAttributes) ProtoMessage() {}
+func (*SignalExternalWorkflowExecutionInitiatedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{34}
+}
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalExternalWorkflowExecutionInitiatedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalExternalWorkflowExecutionInitiatedEventAttributes.Merge(m, src)
+}
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalExternalWorkflowExecutionInitiatedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalExternalWorkflowExecutionInitiatedEventAttributes proto.InternalMessageInfo
+
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetSignalName() string {
+	if m != nil {
+		return m.SignalName
+	}
+	return ""
+}
+
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetInput() *Payload {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetControl() []byte {
+	if m != nil {
+		return m.Control
+	}
+	return nil
+}
+
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetChildWorkflowOnly() bool {
+	if m != nil {
+		return m.ChildWorkflowOnly
+	}
+	return false
+}
+
+type SignalExternalWorkflowExecutionFailedEventAttributes struct {
+	Cause                        SignalExternalWorkflowExecutionFailedCause `protobuf:"varint,1,opt,name=cause,proto3,enum=uber.cadence.api.v1.SignalExternalWorkflowExecutionFailedCause" json:"cause,omitempty"`
+	DecisionTaskCompletedEventId int64                                      `protobuf:"varint,2,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	Domain                       string                                     `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution            *WorkflowExecution                         `protobuf:"bytes,4,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	InitiatedEventId             int64                                      `protobuf:"varint,5,opt,name=initiated_event_id,json=initiatedEventId,proto3" json:"initiated_event_id,omitempty"`
+	Control                      []byte                                     `protobuf:"bytes,6,opt,name=control,proto3" json:"control,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                                   `json:"-"`
+	XXX_unrecognized             []byte                                     `json:"-"`
+	XXX_sizecache                int32                                      `json:"-"`
+}
+
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) Reset() {
+	*m = SignalExternalWorkflowExecutionFailedEventAttributes{}
+}
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*SignalExternalWorkflowExecutionFailedEventAttributes) ProtoMessage() {}
+func (*SignalExternalWorkflowExecutionFailedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{35}
+}
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalExternalWorkflowExecutionFailedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalExternalWorkflowExecutionFailedEventAttributes.Merge(m, src)
+}
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalExternalWorkflowExecutionFailedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalExternalWorkflowExecutionFailedEventAttributes proto.InternalMessageInfo
+
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) GetCause() SignalExternalWorkflowExecutionFailedCause {
+	if m != nil {
+		return m.Cause
+	}
+	return SignalExternalWorkflowExecutionFailedCause_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+}
+
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) GetInitiatedEventId() int64 {
+	if m != nil {
+		return m.InitiatedEventId
+	}
+	return 0
+}
+
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) GetControl() []byte {
+	if m != nil {
+		return m.Control
+	}
+	return nil
+}
+
+type ExternalWorkflowExecutionSignaledEventAttributes struct {
+	InitiatedEventId     int64              `protobuf:"varint,1,opt,name=initiated_event_id,json=initiatedEventId,proto3" json:"initiated_event_id,omitempty"`
+	Domain               string             `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,3,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Control              []byte             `protobuf:"bytes,4,opt,name=control,proto3" json:"control,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ExternalWorkflowExecutionSignaledEventAttributes) Reset() {
+	*m = ExternalWorkflowExecutionSignaledEventAttributes{}
+}
+func (m *ExternalWorkflowExecutionSignaledEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ExternalWorkflowExecutionSignaledEventAttributes) ProtoMessage() {}
+func (*ExternalWorkflowExecutionSignaledEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{36}
+}
+func (m *ExternalWorkflowExecutionSignaledEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalWorkflowExecutionSignaledEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalWorkflowExecutionSignaledEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalWorkflowExecutionSignaledEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalWorkflowExecutionSignaledEventAttributes.Merge(m, src)
+}
+func (m *ExternalWorkflowExecutionSignaledEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalWorkflowExecutionSignaledEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalWorkflowExecutionSignaledEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalWorkflowExecutionSignaledEventAttributes proto.InternalMessageInfo
+
+func (m *ExternalWorkflowExecutionSignaledEventAttributes) GetInitiatedEventId() int64 {
+	if m != nil {
+		return m.InitiatedEventId
+	}
+	return 0
+}
+
+func (m *ExternalWorkflowExecutionSignaledEventAttributes) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ExternalWorkflowExecutionSignaledEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *ExternalWorkflowExecutionSignaledEventAttributes) GetControl() []byte {
+	if m != nil {
+		return m.Control
+	}
+	return nil
+}
+
+type UpsertWorkflowSearchAttributesEventAttributes struct {
+	DecisionTaskCompletedEventId int64             `protobuf:"varint,1,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	SearchAttributes             *SearchAttributes `protobuf:"bytes,2,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}          `json:"-"`
+	XXX_unrecognized             []byte            `json:"-"`
+	XXX_sizecache                int32             `json:"-"`
+}
+
+func (m *UpsertWorkflowSearchAttributesEventAttributes) Reset() {
+	*m = UpsertWorkflowSearchAttributesEventAttributes{}
+}
+func (m *UpsertWorkflowSearchAttributesEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*UpsertWorkflowSearchAttributesEventAttributes) ProtoMessage() {}
+func (*UpsertWorkflowSearchAttributesEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{37}
+}
+func (m *UpsertWorkflowSearchAttributesEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpsertWorkflowSearchAttributesEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpsertWorkflowSearchAttributesEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpsertWorkflowSearchAttributesEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertWorkflowSearchAttributesEventAttributes.Merge(m, src)
+}
+func (m *UpsertWorkflowSearchAttributesEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpsertWorkflowSearchAttributesEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertWorkflowSearchAttributesEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertWorkflowSearchAttributesEventAttributes proto.InternalMessageInfo
+
+func (m *UpsertWorkflowSearchAttributesEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+func (m *UpsertWorkflowSearchAttributesEventAttributes) GetSearchAttributes() *SearchAttributes {
+	if m != nil {
+		return m.SearchAttributes
+	}
+	return nil
+}
+
+type StartChildWorkflowExecutionInitiatedEventAttributes struct {
+	Domain                       string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowId                   string                `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	WorkflowType                 *WorkflowType         `protobuf:"bytes,3,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	TaskList                     *TaskList             `protobuf:"bytes,4,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	Input                        *Payload              `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
+	ExecutionStartToCloseTimeout *types.Duration       `protobuf:"bytes,6,opt,name=execution_start_to_close_timeout,json=executionStartToCloseTimeout,proto3" json:"execution_start_to_close_timeout,omitempty"`
+	TaskStartToCloseTimeout      *types.Duration       `protobuf:"bytes,7,opt,name=task_start_to_close_timeout,json=taskStartToCloseTimeout,proto3" json:"task_start_to_close_timeout,omitempty"`
+	ParentClosePolicy            ParentClosePolicy     `protobuf:"varint,8,opt,name=parent_close_policy,json=parentClosePolicy,proto3,enum=uber.cadence.api.v1.ParentClosePolicy" json:"parent_close_policy,omitempty"`
+	Control                      []byte                `protobuf:"bytes,9,opt,name=control,proto3" json:"control,omitempty"`
+	DecisionTaskCompletedEventId int64                 `protobuf:"varint,10,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	WorkflowIdReusePolicy        WorkflowIdReusePolicy `protobuf:"varint,11,opt,name=workflow_id_reuse_policy,json=workflowIdReusePolicy,proto3,enum=uber.cadence.api.v1.WorkflowIdReusePolicy" json:"workflow_id_reuse_policy,omitempty"`
+	RetryPolicy                  *RetryPolicy          `protobuf:"bytes,13,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	CronSchedule                 string                `protobuf:"bytes,14,opt,name=cron_schedule,json=cronSchedule,proto3" json:"cron_schedule,omitempty"`
+	Header                       *Header               `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Memo                         *Memo                 `protobuf:"bytes,16,opt,name=memo,proto3" json:"memo,omitempty"`
+	SearchAttributes             *SearchAttributes     `protobuf:"bytes,17,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	DelayStart                   *types.Duration       `protobuf:"bytes,18,opt,name=delay_start,json=delayStart,proto3" json:"delay_start,omitempty"`
+	JitterStart                  *types.Duration       `protobuf:"bytes,19,opt,name=jitter_start,json=jitterStart,proto3" json:"jitter_start,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}              `json:"-"`
+	XXX_unrecognized             []byte                `json:"-"`
+	XXX_sizecache                int32                 `json:"-"`
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) Reset() {
+	*m = StartChildWorkflowExecutionInitiatedEventAttributes{}
+}
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*StartChildWorkflowExecutionInitiatedEventAttributes) ProtoMessage() {}
+func (*StartChildWorkflowExecutionInitiatedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{38}
+}
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartChildWorkflowExecutionInitiatedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartChildWorkflowExecutionInitiatedEventAttributes.Merge(m, src)
+}
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartChildWorkflowExecutionInitiatedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartChildWorkflowExecutionInitiatedEventAttributes proto.InternalMessageInfo
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetWorkflowType() *WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetTaskList() *TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetInput() *Payload {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetExecutionStartToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.ExecutionStartToCloseTimeout
+	}
+	return nil
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetTaskStartToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.TaskStartToCloseTimeout
+	}
+	return nil
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetParentClosePolicy() ParentClosePolicy {
+	if m != nil {
+		return m.ParentClosePolicy
+	}
+	return ParentClosePolicy_PARENT_CLOSE_POLICY_INVALID
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetControl() []byte {
+	if m != nil {
+		return m.Control
+	}
+	return nil
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetWorkflowIdReusePolicy() WorkflowIdReusePolicy {
+	if m != nil {
+		return m.WorkflowIdReusePolicy
+	}
+	return WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_INVALID
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetCronSchedule() string {
+	if m != nil {
+		return m.CronSchedule
+	}
+	return ""
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetMemo() *Memo {
+	if m != nil {
+		return m.Memo
+	}
+	return nil
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetSearchAttributes() *SearchAttributes {
+	if m != nil {
+		return m.SearchAttributes
+	}
+	return nil
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetDelayStart() *types.Duration {
+	if m != nil {
+		return m.DelayStart
+	}
+	return nil
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetJitterStart() *types.Duration {
+	if m != nil {
+		return m.JitterStart
+	}
+	return nil
+}
+
+type StartChildWorkflowExecutionFailedEventAttributes struct {
+	Domain                       string                            `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowId                   string                            `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	WorkflowType                 *WorkflowType                     `protobuf:"bytes,3,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	Cause                        ChildWorkflowExecutionFailedCause `protobuf:"varint,4,opt,name=cause,proto3,enum=uber.cadence.api.v1.ChildWorkflowExecutionFailedCause" json:"cause,omitempty"`
+	Control                      []byte                            `protobuf:"bytes,5,opt,name=control,proto3" json:"control,omitempty"`
+	InitiatedEventId             int64                             `protobuf:"varint,6,opt,name=initiated_event_id,json=initiatedEventId,proto3" json:"initiated_event_id,omitempty"`
+	DecisionTaskCompletedEventId int64                             `protobuf:"varint,7,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                          `json:"-"`
+	XXX_unrecognized             []byte                            `json:"-"`
+	XXX_sizecache                int32                             `json:"-"`
+}
+
+func (m *StartChildWorkflowExecutionFailedEventAttributes) Reset() {
+	*m = StartChildWorkflowExecutionFailedEventAttributes{}
+}
+func (m *StartChildWorkflowExecutionFailedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*StartChildWorkflowExecutionFailedEventAttributes) ProtoMessage() {}
+func (*StartChildWorkflowExecutionFailedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{39}
+}
+func (m *StartChildWorkflowExecutionFailedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartChildWorkflowExecutionFailedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartChildWorkflowExecutionFailedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartChildWorkflowExecutionFailedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartChildWorkflowExecutionFailedEventAttributes.Merge(m, src)
+}
+func (m *StartChildWorkflowExecutionFailedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartChildWorkflowExecutionFailedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartChildWorkflowExecutionFailedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartChildWorkflowExecutionFailedEventAttributes proto.InternalMessageInfo
+
+func (m *StartChildWorkflowExecutionFailedEventAttributes) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *StartChildWorkflowExecutionFailedEventAttributes) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *StartChildWorkflowExecutionFailedEventAttributes) GetWorkflowType() *WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
+func (m *StartChildWorkflowExecutionFailedEventAttributes) GetCause() ChildWorkflowExecutionFailedCause {
+	if m != nil {
+		return m.Cause
+	}
+	return ChildWorkflowExecutionFailedCause_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+}
+
+func (m *StartChildWorkflowExecutionFailedEventAttributes) GetControl() []byte {
+	if m != nil {
+		return m.Control
+	}
+	return nil
+}
+
+func (m *StartChildWorkflowExecutionFailedEventAttributes) GetInitiatedEventId() int64 {
+	if m != nil {
+		return m.InitiatedEventId
+	}
+	return 0
+}
+
+func (m *StartChildWorkflowExecutionFailedEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+type ChildWorkflowExecutionStartedEventAttributes struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	WorkflowType         *WorkflowType      `protobuf:"bytes,3,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	InitiatedEventId     int64              `protobuf:"varint,4,opt,name=initiated_event_id,json=initiatedEventId,proto3" json:"initiated_event_id,omitempty"`
+	Header               *Header            `protobuf:"bytes,5,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ChildWorkflowExecutionStartedEventAttributes) Reset() {
+	*m = ChildWorkflowExecutionStartedEventAttributes{}
+}
+func (m *ChildWorkflowExecutionStartedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ChildWorkflowExecutionStartedEventAttributes) ProtoMessage() {}
+func (*ChildWorkflowExecutionStartedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{40}
+}
+func (m *ChildWorkflowExecutionStartedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChildWorkflowExecutionStartedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChildWorkflowExecutionStartedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChildWorkflowExecutionStartedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildWorkflowExecutionStartedEventAttributes.Merge(m, src)
+}
+func (m *ChildWorkflowExecutionStartedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChildWorkflowExecutionStartedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildWorkflowExecutionStartedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChildWorkflowExecutionStartedEventAttributes proto.InternalMessageInfo
+
+func (m *ChildWorkflowExecutionStartedEventAttributes) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ChildWorkflowExecutionStartedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *ChildWorkflowExecutionStartedEventAttributes) GetWorkflowType() *WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
+func (m *ChildWorkflowExecutionStartedEventAttributes) GetInitiatedEventId() int64 {
+	if m != nil {
+		return m.InitiatedEventId
+	}
+	return 0
+}
+
+func (m *ChildWorkflowExecutionStartedEventAttributes) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type ChildWorkflowExecutionCompletedEventAttributes struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	WorkflowType         *WorkflowType      `protobuf:"bytes,3,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	InitiatedEventId     int64              `protobuf:"varint,4,opt,name=initiated_event_id,json=initiatedEventId,proto3" json:"initiated_event_id,omitempty"`
+	StartedEventId       int64              `protobuf:"varint,5,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
+	Result               *Payload           `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ChildWorkflowExecutionCompletedEventAttributes) Reset() {
+	*m = ChildWorkflowExecutionCompletedEventAttributes{}
+}
+func (m *ChildWorkflowExecutionCompletedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ChildWorkflowExecutionCompletedEventAttributes) ProtoMessage() {}
+func (*ChildWorkflowExecutionCompletedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{41}
+}
+func (m *ChildWorkflowExecutionCompletedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChildWorkflowExecutionCompletedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChildWorkflowExecutionCompletedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChildWorkflowExecutionCompletedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildWorkflowExecutionCompletedEventAttributes.Merge(m, src)
+}
+func (m *ChildWorkflowExecutionCompletedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChildWorkflowExecutionCompletedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildWorkflowExecutionCompletedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChildWorkflowExecutionCompletedEventAttributes proto.InternalMessageInfo
+
+func (m *ChildWorkflowExecutionCompletedEventAttributes) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ChildWorkflowExecutionCompletedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *ChildWorkflowExecutionCompletedEventAttributes) GetWorkflowType() *WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
+func (m *ChildWorkflowExecutionCompletedEventAttributes) GetInitiatedEventId() int64 {
+	if m != nil {
+		return m.InitiatedEventId
+	}
+	return 0
+}
+
+func (m *ChildWorkflowExecutionCompletedEventAttributes) GetStartedEventId() int64 {
+	if m != nil {
+		return m.StartedEventId
+	}
+	return 0
+}
+
+func (m *ChildWorkflowExecutionCompletedEventAttributes) GetResult() *Payload {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ChildWorkflowExecutionFailedEventAttributes struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	WorkflowType         *WorkflowType      `protobuf:"bytes,3,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	InitiatedEventId     int64              `protobuf:"varint,4,opt,name=initiated_event_id,json=initiatedEventId,proto3" json:"initiated_event_id,omitempty"`
+	StartedEventId       int64              `protobuf:"varint,5,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
+	Failure              *Failure           `protobuf:"bytes,6,opt,name=failure,proto3" json:"failure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ChildWorkflowExecutionFailedEventAttributes) Reset() {
+	*m = ChildWorkflowExecutionFailedEventAttributes{}
+}
+func (m *ChildWorkflowExecutionFailedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ChildWorkflowExecutionFailedEventAttributes) ProtoMessage() {}
+func (*ChildWorkflowExecutionFailedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{42}
+}
+func (m *ChildWorkflowExecutionFailedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChildWorkflowExecutionFailedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChildWorkflowExecutionFailedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChildWorkflowExecutionFailedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildWorkflowExecutionFailedEventAttributes.Merge(m, src)
+}
+func (m *ChildWorkflowExecutionFailedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChildWorkflowExecutionFailedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildWorkflowExecutionFailedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChildWorkflowExecutionFailedEventAttributes proto.InternalMessageInfo
+
+func (m *ChildWorkflowExecutionFailedEventAttributes) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ChildWorkflowExecutionFailedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *ChildWorkflowExecutionFailedEventAttributes) GetWorkflowType() *WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
+func (m *ChildWorkflowExecutionFailedEventAttributes) GetInitiatedEventId() int64 {
+	if m != nil {
+		return m.InitiatedEventId
+	}
+	return 0
+}
+
+func (m *ChildWorkflowExecutionFailedEventAttributes) GetStartedEventId() int64 {
+	if m != nil {
+		return m.StartedEventId
+	}
+	return 0
+}
+
+func (m *ChildWorkflowExecutionFailedEventAttributes) GetFailure() *Failure {
+	if m != nil {
+		return m.Failure
+	}
+	return nil
+}
+
+type ChildWorkflowExecutionCanceledEventAttributes struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	WorkflowType         *WorkflowType      `protobuf:"bytes,3,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	InitiatedEventId     int64              `protobuf:"varint,4,opt,name=initiated_event_id,json=initiatedEventId,proto3" json:"initiated_event_id,omitempty"`
+	StartedEventId       int64              `protobuf:"varint,5,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
+	Details              *Payload           `protobuf:"bytes,6,opt,name=details,proto3" json:"details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ChildWorkflowExecutionCanceledEventAttributes) Reset() {
+	*m = ChildWorkflowExecutionCanceledEventAttributes{}
+}
+func (m *ChildWorkflowExecutionCanceledEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ChildWorkflowExecutionCanceledEventAttributes) ProtoMessage() {}
+func (*ChildWorkflowExecutionCanceledEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{43}
+}
+func (m *ChildWorkflowExecutionCanceledEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChildWorkflowExecutionCanceledEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChildWorkflowExecutionCanceledEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChildWorkflowExecutionCanceledEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildWorkflowExecutionCanceledEventAttributes.Merge(m, src)
+}
+func (m *ChildWorkflowExecutionCanceledEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChildWorkflowExecutionCanceledEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildWorkflowExecutionCanceledEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChildWorkflowExecutionCanceledEventAttributes proto.InternalMessageInfo
+
+func (m *ChildWorkflowExecutionCanceledEventAttributes) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ChildWorkflowExecutionCanceledEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *ChildWorkflowExecutionCanceledEventAttributes) GetWorkflowType() *WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
+func (m *ChildWorkflowExecutionCanceledEventAttributes) GetInitiatedEventId() int64 {
+	if m != nil {
+		return m.InitiatedEventId
+	}
+	return 0
+}
+
+func (m *ChildWorkflowExecutionCanceledEventAttributes) GetStartedEventId() int64 {
+	if m != nil {
+		return m.StartedEventId
+	}
+	return 0
+}
+
+func (m *ChildWorkflowExecutionCanceledEventAttributes) GetDetails() *Payload {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+type ChildWorkflowExecutionTimedOutEventAttributes struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	WorkflowType         *WorkflowType      `protobuf:"bytes,3,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	InitiatedEventId     int64              `protobuf:"varint,4,opt,name=initiated_event_id,json=initiatedEventId,proto3" json:"initiated_event_id,omitempty"`
+	StartedEventId       int64              `protobuf:"varint,5,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
+	TimeoutType          TimeoutType        `protobuf:"varint,6,opt,name=timeout_type,json=timeoutType,proto3,enum=uber.cadence.api.v1.TimeoutType" json:"timeout_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) Reset() {
+	*m = ChildWorkflowExecutionTimedOutEventAttributes{}
+}
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ChildWorkflowExecutionTimedOutEventAttributes) ProtoMessage() {}
+func (*ChildWorkflowExecutionTimedOutEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{44}
+}
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChildWorkflowExecutionTimedOutEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildWorkflowExecutionTimedOutEventAttributes.Merge(m, src)
+}
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildWorkflowExecutionTimedOutEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChildWorkflowExecutionTimedOutEventAttributes proto.InternalMessageInfo
+
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) GetWorkflowType() *WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) GetInitiatedEventId() int64 {
+	if m != nil {
+		return m.InitiatedEventId
+	}
+	return 0
+}
+
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) GetStartedEventId() int64 {
+	if m != nil {
+		return m.StartedEventId
+	}
+	return 0
+}
+
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) GetTimeoutType() TimeoutType {
+	if m != nil {
+		return m.TimeoutType
+	}
+	return TimeoutType_TIMEOUT_TYPE_INVALID
+}
+
+type ChildWorkflowExecutionTerminatedEventAttributes struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	WorkflowType         *WorkflowType      `protobuf:"bytes,3,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	InitiatedEventId     int64              `protobuf:"varint,4,opt,name=initiated_event_id,json=initiatedEventId,proto3" json:"initiated_event_id,omitempty"`
+	StartedEventId       int64              `protobuf:"varint,5,opt,name=started_event_id,json=startedEventId,proto3" json:"started_event_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ChildWorkflowExecutionTerminatedEventAttributes) Reset() {
+	*m = ChildWorkflowExecutionTerminatedEventAttributes{}
+}
+func (m *ChildWorkflowExecutionTerminatedEventAttributes) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ChildWorkflowExecutionTerminatedEventAttributes) ProtoMessage() {}
+func (*ChildWorkflowExecutionTerminatedEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{45}
+}
+func (m *ChildWorkflowExecutionTerminatedEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChildWorkflowExecutionTerminatedEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChildWorkflowExecutionTerminatedEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChildWorkflowExecutionTerminatedEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildWorkflowExecutionTerminatedEventAttributes.Merge(m, src)
+}
+func (m *ChildWorkflowExecutionTerminatedEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChildWorkflowExecutionTerminatedEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildWorkflowExecutionTerminatedEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChildWorkflowExecutionTerminatedEventAttributes proto.InternalMessageInfo
+
+func (m *ChildWorkflowExecutionTerminatedEventAttributes) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ChildWorkflowExecutionTerminatedEventAttributes) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *ChildWorkflowExecutionTerminatedEventAttributes) GetWorkflowType() *WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
+func (m *ChildWorkflowExecutionTerminatedEventAttributes) GetInitiatedEventId() int64 {
+	if m != nil {
+		return m.InitiatedEventId
+	}
+	return 0
+}
+
+func (m *ChildWorkflowExecutionTerminatedEventAttributes) GetStartedEventId() int64 {
+	if m != nil {
+		return m.StartedEventId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("uber.cadence.api.v1.EventFilterType", EventFilterType_name, EventFilterType_value)
+	proto.RegisterType((*History)(nil), "uber.cadence.api.v1.History")
+	proto.RegisterType((*HistoryEvent)(nil), "uber.cadence.api.v1.HistoryEvent")
+	proto.RegisterType((*WorkflowExecutionStartedEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionStartedEventAttributes")
+	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.api.v1.WorkflowExecutionStartedEventAttributes.PartitionConfigEntry")
+	proto.RegisterType((*WorkflowExecutionCompletedEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionCompletedEventAttributes")
+	proto.RegisterType((*WorkflowExecutionFailedEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionFailedEventAttributes")
+	proto.RegisterType((*WorkflowExecutionTimedOutEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionTimedOutEventAttributes")
+	proto.RegisterType((*DecisionTaskScheduledEventAttributes)(nil), "uber.cadence.api.v1.DecisionTaskScheduledEventAttributes")
+	proto.RegisterType((*DecisionTaskStartedEventAttributes)(nil), "uber.cadence.api.v1.DecisionTaskStartedEventAttributes")
+	proto.RegisterType((*DecisionTaskCompletedEventAttributes)(nil), "uber.cadence.api.v1.DecisionTaskCompletedEventAttributes")
+	proto.RegisterType((*DecisionTaskTimedOutEventAttributes)(nil), "uber.cadence.api.v1.DecisionTaskTimedOutEventAttributes")
+	proto.RegisterType((*DecisionTaskFailedEventAttributes)(nil), "uber.cadence.api.v1.DecisionTaskFailedEventAttributes")
+	proto.RegisterType((*ActivityTaskScheduledEventAttributes)(nil), "uber.cadence.api.v1.ActivityTaskScheduledEventAttributes")
+	proto.RegisterType((*ActivityTaskStartedEventAttributes)(nil), "uber.cadence.api.v1.ActivityTaskStartedEventAttributes")
+	proto.RegisterType((*ActivityTaskCompletedEventAttributes)(nil), "uber.cadence.api.v1.ActivityTaskCompletedEventAttributes")
+	proto.RegisterType((*ActivityTaskFailedEventAttributes)(nil), "uber.cadence.api.v1.ActivityTaskFailedEventAttributes")
+	proto.RegisterType((*ActivityTaskTimedOutEventAttributes)(nil), "uber.cadence.api.v1.ActivityTaskTimedOutEventAttributes")
+	proto.RegisterType((*ActivityTaskCancelRequestedEventAttributes)(nil), "uber.cadence.api.v1.ActivityTaskCancelRequestedEventAttributes")
+	proto.RegisterType((*RequestCancelActivityTaskFailedEventAttributes)(nil), "uber.cadence.api.v1.RequestCancelActivityTaskFailedEventAttributes")
+	proto.RegisterType((*ActivityTaskCanceledEventAttributes)(nil), "uber.cadence.api.v1.ActivityTaskCanceledEventAttributes")
+	proto.RegisterType((*TimerStartedEventAttributes)(nil), "uber.cadence.api.v1.TimerStartedEventAttributes")
+	proto.RegisterType((*TimerFiredEventAttributes)(nil), "uber.cadence.api.v1.TimerFiredEventAttributes")
+	proto.RegisterType((*TimerCanceledEventAttributes)(nil), "uber.cadence.api.v1.TimerCanceledEventAttributes")
+	proto.RegisterType((*CancelTimerFailedEventAttributes)(nil), "uber.cadence.api.v1.CancelTimerFailedEventAttributes")
+	proto.RegisterType((*WorkflowExecutionContinuedAsNewEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionContinuedAsNewEventAttributes")
+	proto.RegisterType((*WorkflowExecutionCancelRequestedEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionCancelRequestedEventAttributes")
+	proto.RegisterType((*WorkflowExecutionCanceledEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionCanceledEventAttributes")
+	proto.RegisterType((*MarkerRecordedEventAttributes)(nil), "uber.cadence.api.v1.MarkerRecordedEventAttributes")
+	proto.RegisterType((*WorkflowExecutionSignaledEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionSignaledEventAttributes")
+	proto.RegisterType((*WorkflowExecutionTerminatedEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionTerminatedEventAttributes")
+	proto.RegisterType((*WorkflowExecutionPausedEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionPausedEventAttributes")
+	proto.RegisterType((*WorkflowExecutionUnpausedEventAttributes)(nil), "uber.cadence.api.v1.WorkflowExecutionUnpausedEventAttributes")
+	proto.RegisterType((*RequestCancelExternalWorkflowExecutionInitiatedEventAttributes)(nil), "uber.cadence.api.v1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes")
+	proto.RegisterType((*RequestCancelExternalWorkflowExecutionFailedEventAttributes)(nil), "uber.cadence.api.v1.RequestCancelExternalWorkflowExecutionFailedEventAttributes")
+	proto.RegisterType((*ExternalWorkflowExecutionCancelRequestedEventAttributes)(nil), "uber.cadence.api.v1.ExternalWorkflowExecutionCancelRequestedEventAttributes")
+	proto.RegisterType((*SignalExternalWorkflowExecutionInitiatedEventAttributes)(nil), "uber.cadence.api.v1.SignalExternalWorkflowExecutionInitiatedEventAttributes")
+	proto.RegisterType((*SignalExternalWorkflowExecutionFailedEventAttributes)(nil), "uber.cadence.api.v1.SignalExternalWorkflowExecutionFailedEventAttributes")
+	proto.RegisterType((*ExternalWorkflowExecutionSignaledEventAttributes)(nil), "uber.cadence.api.v1.ExternalWorkflowExecutionSignaledEventAttributes")
+	proto.RegisterType((*UpsertWorkflowSearchAttributesEventAttributes)(nil), "uber.cadence.api.v1.UpsertWorkflowSearchAttributesEventAttributes")
+	proto.RegisterType((*StartChildWorkflowExecutionInitiatedEventAttributes)(nil), "uber.cadence.api.v1.StartChildWorkflowExecutionInitiatedEventAttributes")
+	proto.RegisterType((*StartChildWorkflowExecutionFailedEventAttributes)(nil), "uber.cadence.api.v1.StartChildWorkflowExecutionFailedEventAttributes")
+	proto.RegisterType((*ChildWorkflowExecutionStartedEventAttributes)(nil), "uber.cadence.api.v1.ChildWorkflowExecutionStartedEventAttributes")
+	proto.RegisterType((*ChildWorkflowExecutionCompletedEventAttributes)(nil), "uber.cadence.api.v1.ChildWorkflowExecutionCompletedEventAttributes")
+	proto.RegisterType((*ChildWorkflowExecutionFailedEventAttributes)(nil), "uber.cadence.api.v1.ChildWorkflowExecutionFailedEventAttributes")
+	proto.RegisterType((*ChildWorkflowExecutionCanceledEventAttributes)(nil), "uber.cadence.api.v1.ChildWorkflowExecutionCanceledEventAttributes")
+	proto.RegisterType((*ChildWorkflowExecutionTimedOutEventAttributes)(nil), "uber.cadence.api.v1.ChildWorkflowExecutionTimedOutEventAttributes")
+	proto.RegisterType((*ChildWorkflowExecutionTerminatedEventAttributes)(nil), "uber.cadence.api.v1.ChildWorkflowExecutionTerminatedEventAttributes")
+}
+
+func init() { proto.RegisterFile("uber/cadence/api/v1/history.proto", fileDescriptor_8237ca6511ad6c62) }
+
+var fileDescriptor_8237ca6511ad6c62 = []byte{
+	// 3815 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x57,
+	0xd5, 0xef, 0xec, 0xda, 0xeb, 0xec, 0x59, 0xc7, 0xb1, 0xaf, 0x1d, 0xc7, 0x4e, 0x9c, 0xc4, 0xd9,
+	0xa4, 0x89, 0xeb, 0xd8, 0xeb, 0xc4, 0x49, 0x93, 0x26, 0xe9, 0x9f, 0x2f, 0x76, 0x6c, 0x65, 0x25,
+	0x7f, 0x89, 0xbf, 0x89, 0x93, 0x7e, 0x20, 0xa4, 0x65, 0xbc, 0x73, 0x1d, 0x0f, 0xde, 0xdd, 0xd9,
+	0xce, 0xdc, 0xf5, 0xc6, 0x40, 0x9f, 0x78, 0x40, 0x42, 0x54, 0xa5, 0xaa, 0x90, 0xa8, 0x44, 0x05,
+	0x42, 0xe2, 0x4f, 0x01, 0xa9, 0x08, 0x84, 0xf8, 0xf7, 0x02, 0x48, 0xa8, 0x95, 0x40, 0xa5, 0x4f,
+	0xbc, 0xf0, 0x82, 0x2a, 0x84, 0xe0, 0x8d, 0x07, 0xca, 0x1b, 0x12, 0x9a, 0x3b, 0x77, 0x76, 0x77,
+	0x66, 0xee, 0x9d, 0xb9, 0xb3, 0x76, 0x5a, 0x50, 0xf3, 0xe6, 0xb9, 0x7b, 0xce, 0x99, 0xdf, 0xbd,
+	0xf7, 0x9c, 0x7b, 0xce, 0xb9, 0xe7, 0x8c, 0xe1, 0x44, 0x63, 0x1d, 0x5b, 0x73, 0x65, 0x4d, 0xc7,
+	0xb5, 0x32, 0x9e, 0xd3, 0xea, 0xc6, 0xdc, 0xf6, 0xf9, 0xb9, 0x4d, 0xc3, 0x26, 0xa6, 0xb5, 0x53,
+	0xa8, 0x5b, 0x26, 0x31, 0xd1, 0xb0, 0x43, 0x52, 0x60, 0x24, 0x05, 0xad, 0x6e, 0x14, 0xb6, 0xcf,
+	0x1f, 0x3e, 0x76, 0xdf, 0x34, 0xef, 0x57, 0xf0, 0x1c, 0x25, 0x59, 0x6f, 0x6c, 0xcc, 0xe9, 0x0d,
+	0x4b, 0x23, 0x86, 0x59, 0x73, 0x99, 0x0e, 0x1f, 0x0f, 0xfe, 0x4e, 0x8c, 0x2a, 0xb6, 0x89, 0x56,
+	0xad, 0x33, 0x82, 0x49, 0xde, 0x8b, 0xcb, 0x66, 0xb5, 0xda, 0x12, 0x91, 0xe7, 0x51, 0x10, 0xcd,
+	0xde, 0xaa, 0x18, 0x36, 0x89, 0xa2, 0x69, 0x9a, 0xd6, 0xd6, 0x46, 0xc5, 0x6c, 0xba, 0x34, 0xf9,
+	0x1b, 0xd0, 0x77, 0xd3, 0x9d, 0x10, 0xba, 0x02, 0x19, 0xbc, 0x8d, 0x6b, 0xc4, 0x1e, 0x53, 0x26,
+	0xd3, 0x53, 0xb9, 0xf9, 0x13, 0x05, 0xce, 0xdc, 0x0a, 0x8c, 0x7a, 0xc9, 0xa1, 0x54, 0x19, 0x43,
+	0xfe, 0xdb, 0x57, 0xa1, 0xbf, 0xf3, 0x07, 0x34, 0x0e, 0xfb, 0xe8, 0x4f, 0x25, 0x43, 0x1f, 0x53,
+	0x26, 0x95, 0xa9, 0xb4, 0xda, 0x47, 0x9f, 0x8b, 0x3a, 0xba, 0x02, 0xe0, 0xfe, 0xe4, 0x4c, 0x7a,
+	0x2c, 0x35, 0xa9, 0x4c, 0xe5, 0xe6, 0x0f, 0x17, 0xdc, 0x15, 0x29, 0x78, 0x2b, 0x52, 0x58, 0xf3,
+	0x56, 0x44, 0xcd, 0x52, 0x6a, 0xe7, 0x19, 0x8d, 0x41, 0xdf, 0x36, 0xb6, 0x6c, 0xc3, 0xac, 0x8d,
+	0xa5, 0x5d, 0xa1, 0xec, 0x11, 0x1d, 0x82, 0x3e, 0x67, 0xf2, 0xce, 0xeb, 0x7a, 0xe8, 0x2f, 0x19,
+	0xe7, 0xb1, 0xa8, 0xa3, 0xaf, 0x2a, 0x70, 0xd6, 0x9b, 0x72, 0x09, 0x3f, 0xc0, 0xe5, 0x86, 0xb3,
+	0x0f, 0x25, 0x9b, 0x68, 0x16, 0xc1, 0x7a, 0xc9, 0x45, 0xa2, 0x11, 0x62, 0x19, 0xeb, 0x0d, 0x82,
+	0xed, 0xb1, 0x5e, 0x8a, 0xe7, 0x69, 0xee, 0xd4, 0x9f, 0x67, 0x72, 0x96, 0x3c, 0x31, 0x77, 0x5c,
+	0x29, 0x74, 0xca, 0xd7, 0x5b, 0x32, 0x6e, 0x3e, 0xa6, 0x9e, 0x69, 0xca, 0x91, 0xa2, 0x6f, 0x28,
+	0x30, 0xcb, 0x81, 0x57, 0x36, 0xab, 0xf5, 0x0a, 0xe6, 0x02, 0xcc, 0x50, 0x80, 0xcf, 0xca, 0x01,
+	0x5c, 0xf4, 0xe4, 0x84, 0x21, 0x3e, 0xd1, 0x94, 0x25, 0x46, 0xaf, 0x29, 0x30, 0xcd, 0x01, 0xb9,
+	0xa1, 0x19, 0x15, 0x1e, 0xc2, 0x3e, 0x8a, 0xf0, 0x9a, 0x1c, 0xc2, 0x65, 0x2a, 0x24, 0x0c, 0xef,
+	0x74, 0x53, 0x8a, 0x12, 0x7d, 0x9d, 0xbf, 0x80, 0x8e, 0x6e, 0xe9, 0x25, 0xb3, 0x41, 0xc2, 0xf0,
+	0xf6, 0x51, 0x78, 0xcf, 0xc8, 0xc1, 0x73, 0xd4, 0x4e, 0xbf, 0xdd, 0x20, 0x61, 0x80, 0x53, 0x4d,
+	0x49, 0x5a, 0xf4, 0xaa, 0x02, 0x53, 0x3a, 0x2e, 0x1b, 0x36, 0x05, 0xe6, 0x68, 0xa9, 0x5d, 0xde,
+	0xc4, 0x7a, 0x83, 0xbb, 0x78, 0x59, 0x8a, 0xee, 0x0a, 0x17, 0xdd, 0x0d, 0x26, 0x64, 0x4d, 0xb3,
+	0xb7, 0xee, 0x78, 0x22, 0xc2, 0xc8, 0x4e, 0xe9, 0x12, 0x74, 0xe8, 0x25, 0x05, 0x4e, 0x07, 0x50,
+	0x89, 0x6c, 0x02, 0x28, 0xa6, 0xcb, 0xf1, 0x98, 0x44, 0xe6, 0x90, 0xd7, 0x63, 0xa9, 0x38, 0xab,
+	0x14, 0x61, 0x04, 0x39, 0xc9, 0x55, 0x8a, 0xd0, 0xff, 0x53, 0xba, 0x04, 0x1d, 0x7a, 0x25, 0x84,
+	0x2a, 0x42, 0xb3, 0xfa, 0x29, 0xaa, 0xa7, 0x62, 0x51, 0x89, 0x95, 0xea, 0xa4, 0x1e, 0x4f, 0x86,
+	0xbe, 0xa0, 0xc0, 0xe3, 0x7e, 0x4c, 0x22, 0x4b, 0xdc, 0x4f, 0x01, 0x5d, 0x8a, 0x05, 0x24, 0x32,
+	0xc2, 0x13, 0x7a, 0x1c, 0x11, 0xdd, 0x36, 0xad, 0x4c, 0x8c, 0x6d, 0x83, 0xec, 0xc4, 0x2a, 0xf7,
+	0x40, 0xc4, 0xb6, 0x5d, 0x67, 0x42, 0xe2, 0x94, 0x5b, 0x93, 0xa0, 0xa3, 0xca, 0x1d, 0x40, 0x25,
+	0x52, 0xee, 0x03, 0x11, 0xca, 0xed, 0xc3, 0x24, 0x54, 0x6e, 0x2d, 0x96, 0x8a, 0xb3, 0x4a, 0x11,
+	0xca, 0x3d, 0x28, 0xb9, 0x4a, 0x51, 0xca, 0xad, 0x49, 0xd0, 0x51, 0x45, 0xf2, 0xa3, 0x12, 0x29,
+	0xd2, 0x50, 0x84, 0x22, 0x75, 0x42, 0x12, 0x2a, 0x92, 0x16, 0x47, 0x44, 0x2d, 0xcd, 0x0f, 0x26,
+	0xc2, 0xd2, 0x50, 0x84, 0xa5, 0x75, 0xe2, 0x89, 0xb0, 0x34, 0x2d, 0x9e, 0x0c, 0x35, 0xe1, 0x98,
+	0x03, 0xc2, 0x12, 0x6b, 0xcf, 0x30, 0x05, 0x72, 0x8e, 0x0b, 0xc4, 0x91, 0x6a, 0x09, 0xd5, 0xe6,
+	0x08, 0x11, 0xff, 0x8c, 0x5e, 0x80, 0x09, 0xf7, 0xc5, 0x1b, 0x86, 0xc5, 0x7b, 0xed, 0x08, 0x7d,
+	0x6d, 0x41, 0xfc, 0xda, 0x65, 0xc3, 0x0a, 0x49, 0xbd, 0xf9, 0x98, 0x3a, 0x4e, 0x44, 0x3f, 0xa2,
+	0x6f, 0x29, 0x30, 0x17, 0x50, 0x51, 0xad, 0x56, 0xc6, 0x95, 0x92, 0x85, 0x5f, 0x68, 0x60, 0x9b,
+	0x3b, 0xfb, 0x83, 0x14, 0xc6, 0x73, 0xf1, 0x9a, 0x4a, 0x25, 0xa9, 0x9e, 0xa0, 0x30, 0xae, 0x69,
+	0x4d, 0x9a, 0x1a, 0xfd, 0x48, 0x81, 0x8b, 0x0c, 0x93, 0x07, 0x51, 0x4e, 0x89, 0x47, 0x29, 0xda,
+	0x45, 0x2e, 0x5a, 0xf6, 0x36, 0xf7, 0xd5, 0x32, 0x1a, 0x5d, 0xb0, 0x12, 0x71, 0xa0, 0x2f, 0x29,
+	0x70, 0x86, 0xb7, 0xbc, 0x3c, 0xa0, 0x87, 0x24, 0xb5, 0x7b, 0x91, 0x49, 0x88, 0xd1, 0x6e, 0x01,
+	0x19, 0xfa, 0x34, 0x1c, 0x77, 0x95, 0x4c, 0x8c, 0x64, 0x8c, 0x22, 0x39, 0x2f, 0xd6, 0x33, 0x31,
+	0x84, 0x09, 0x12, 0xf1, 0x3b, 0xfa, 0xbc, 0x02, 0xa7, 0xd8, 0xe6, 0x31, 0x45, 0x17, 0x6c, 0xda,
+	0x38, 0x45, 0xf0, 0x24, 0x17, 0x81, 0x2b, 0xdc, 0xd5, 0x77, 0xc1, 0x36, 0x4d, 0x96, 0x63, 0x68,
+	0xd0, 0x8b, 0x30, 0x59, 0xd5, 0xac, 0x2d, 0x6c, 0x95, 0x2c, 0x5c, 0x36, 0x2d, 0x9d, 0x07, 0xe2,
+	0x30, 0x05, 0x31, 0xcf, 0x05, 0xf1, 0xbf, 0x94, 0x59, 0x65, 0xbc, 0x61, 0x04, 0x47, 0xab, 0x51,
+	0x04, 0xe8, 0x6b, 0x0a, 0xcc, 0xf0, 0xf2, 0x13, 0xe3, 0x7e, 0x4d, 0xe3, 0x2e, 0xc8, 0x91, 0x24,
+	0xe1, 0xeb, 0x1d, 0x26, 0x46, 0x26, 0x7c, 0x15, 0xd0, 0xa2, 0x6f, 0x2a, 0x50, 0xe0, 0x20, 0x24,
+	0xd8, 0xaa, 0x1a, 0x35, 0x8d, 0x7b, 0x2e, 0x4c, 0x44, 0x9c, 0x0b, 0xe1, 0x10, 0xbb, 0x25, 0x88,
+	0x73, 0x2e, 0x34, 0xa5, 0xa9, 0xd1, 0x8f, 0x15, 0xb8, 0xc8, 0x4b, 0xa5, 0x62, 0x4f, 0xb1, 0xa3,
+	0x14, 0xed, 0x0d, 0xc9, 0x8c, 0x2a, 0xee, 0x28, 0x9b, 0x6b, 0x26, 0x63, 0x11, 0x69, 0x80, 0xd8,
+	0x28, 0x8f, 0x25, 0xd1, 0x00, 0xb1, 0x81, 0x4e, 0x35, 0x25, 0x69, 0xd1, 0x9f, 0x15, 0x58, 0x0a,
+	0x9c, 0xb8, 0xf8, 0x01, 0xc1, 0x56, 0x4d, 0xab, 0x94, 0x38, 0xc8, 0x8d, 0x9a, 0x41, 0x0c, 0xbe,
+	0x62, 0x1c, 0xa7, 0xd0, 0xef, 0xc4, 0x1f, 0xc1, 0x4b, 0x4c, 0x7e, 0x68, 0x3e, 0x45, 0x4f, 0x78,
+	0x78, 0x42, 0xcf, 0x5a, 0xbb, 0x92, 0x80, 0xfe, 0xa8, 0xc0, 0x42, 0x82, 0x69, 0x8a, 0x4e, 0xac,
+	0x49, 0x3a, 0xc7, 0xd5, 0x5d, 0xcc, 0x51, 0x74, 0x98, 0x5d, 0xb3, 0xba, 0x67, 0x47, 0xef, 0x28,
+	0xf0, 0x4c, 0xd4, 0x74, 0xe2, 0xed, 0xe4, 0x04, 0x9d, 0xd8, 0x0a, 0x77, 0x62, 0x42, 0x30, 0xb1,
+	0xf6, 0x72, 0x19, 0x77, 0xc7, 0x4a, 0xe3, 0x00, 0xde, 0x3c, 0xcc, 0x1a, 0x31, 0x6a, 0x0d, 0xac,
+	0x97, 0x34, 0xbb, 0x54, 0xc3, 0xcd, 0xf0, 0x3c, 0xf2, 0x11, 0x71, 0x40, 0x18, 0x84, 0x27, 0xee,
+	0xba, 0x7d, 0x0b, 0x37, 0xc3, 0xf0, 0x0b, 0xcd, 0x44, 0x1c, 0xe8, 0xd7, 0x0a, 0x5c, 0xa1, 0xd1,
+	0x64, 0xa9, 0xbc, 0x69, 0x54, 0xf4, 0x84, 0xf6, 0x73, 0x92, 0x42, 0xbf, 0xc9, 0x85, 0x4e, 0x43,
+	0xc9, 0x45, 0x47, 0x68, 0x12, 0xa3, 0xb9, 0x60, 0x27, 0x67, 0x43, 0x3f, 0x53, 0xe0, 0x52, 0xcc,
+	0x24, 0x44, 0xd6, 0x71, 0x8a, 0xce, 0x60, 0x29, 0xe9, 0x0c, 0x44, 0x26, 0x71, 0xce, 0x4e, 0xc8,
+	0x83, 0xbe, 0xaf, 0xc0, 0x79, 0x21, 0x6a, 0x61, 0x9c, 0xff, 0x38, 0x85, 0x7d, 0x9d, 0x1f, 0x86,
+	0x70, 0xdf, 0x2e, 0x0c, 0xfc, 0x67, 0xca, 0x09, 0xe8, 0xd1, 0x0f, 0x15, 0xb8, 0x20, 0x84, 0x1b,
+	0x91, 0x44, 0x9e, 0x8e, 0x50, 0x72, 0x3e, 0xe0, 0x88, 0x74, 0xb2, 0x50, 0x4e, 0xc4, 0x81, 0xde,
+	0x50, 0xe0, 0x5c, 0x62, 0xcd, 0x38, 0x43, 0x11, 0xff, 0x4f, 0x02, 0xc4, 0x22, 0xa5, 0x38, 0x5b,
+	0x4e, 0xa0, 0x0f, 0x6f, 0x2a, 0x30, 0x2f, 0x5e, 0x60, 0xa1, 0x13, 0x9e, 0xa2, 0x68, 0x17, 0x92,
+	0xac, 0xaf, 0xd0, 0x13, 0xcf, 0x96, 0x93, 0x30, 0xa0, 0x1f, 0x44, 0xa9, 0x44, 0x44, 0xd2, 0xfc,
+	0x44, 0x62, 0xc8, 0xe2, 0xf4, 0x79, 0xb6, 0x9c, 0x84, 0x81, 0xc6, 0x66, 0x62, 0xc8, 0x11, 0x91,
+	0xe4, 0x74, 0x44, 0x6c, 0x26, 0xc0, 0x1c, 0x11, 0x4e, 0xce, 0x95, 0x93, 0xb1, 0x50, 0xa7, 0xe9,
+	0x86, 0xe2, 0xdd, 0x46, 0x3c, 0x67, 0x23, 0x9c, 0xa6, 0x1b, 0x71, 0x77, 0x13, 0xea, 0x5c, 0xb6,
+	0xbb, 0x63, 0x45, 0xbf, 0x51, 0xe0, 0xaa, 0xc4, 0x84, 0x44, 0x36, 0x3a, 0x43, 0x67, 0x53, 0xec,
+	0x66, 0x36, 0x22, 0x63, 0xbd, 0x68, 0x77, 0xc1, 0x87, 0x7e, 0xaa, 0xc0, 0x93, 0x51, 0x13, 0x10,
+	0xe7, 0x4f, 0xb3, 0x11, 0x0e, 0x48, 0x08, 0x42, 0x9c, 0x47, 0x9d, 0xc3, 0x09, 0x79, 0xe8, 0x81,
+	0xd3, 0xa8, 0xdb, 0xd8, 0x22, 0x6d, 0xe0, 0x36, 0xd6, 0xac, 0xf2, 0x66, 0x07, 0xcc, 0x30, 0xee,
+	0x42, 0x84, 0xf5, 0xde, 0xa5, 0xe2, 0x3c, 0x04, 0x77, 0xa8, 0xb0, 0xf6, 0x1b, 0x39, 0xd6, 0xdb,
+	0x48, 0xc2, 0x20, 0xaa, 0xff, 0xd4, 0xb5, 0x86, 0xcd, 0x5b, 0xe1, 0xb9, 0x24, 0xf5, 0x9f, 0x55,
+	0x2a, 0x44, 0xa6, 0xfe, 0xc3, 0xa5, 0x14, 0x65, 0x4f, 0x8d, 0x9a, 0x08, 0xdd, 0xb9, 0x24, 0xd9,
+	0xd3, 0xdd, 0x5a, 0x9d, 0xf7, 0x56, 0x6e, 0xf6, 0x24, 0xa0, 0x5d, 0xe8, 0x07, 0x68, 0xbf, 0x3e,
+	0xff, 0xf2, 0x00, 0x9c, 0x91, 0xf5, 0xfd, 0xcb, 0xb0, 0xbf, 0x35, 0x35, 0xb2, 0x53, 0xc7, 0xb4,
+	0x92, 0x2a, 0xaa, 0xcb, 0x7a, 0x42, 0xd7, 0x76, 0xea, 0x58, 0xed, 0x6f, 0x76, 0x3c, 0xa1, 0x4f,
+	0xc0, 0xc1, 0xba, 0x66, 0x39, 0xeb, 0xd0, 0x79, 0x64, 0x6d, 0x98, 0xac, 0xf8, 0x3a, 0xc5, 0x95,
+	0xb7, 0x4a, 0x39, 0x3a, 0x4e, 0x94, 0x0d, 0x53, 0x1d, 0xae, 0x87, 0x07, 0xd1, 0x55, 0xc8, 0xd2,
+	0xfb, 0xac, 0x8a, 0x61, 0x13, 0x5a, 0x96, 0xcd, 0xcd, 0x1f, 0xe5, 0x5f, 0x18, 0x69, 0xf6, 0xd6,
+	0x8a, 0x61, 0x13, 0x75, 0x1f, 0x61, 0x7f, 0xa1, 0x79, 0xe8, 0x35, 0x6a, 0xf5, 0x06, 0xa1, 0x45,
+	0xdb, 0xdc, 0xfc, 0x84, 0x00, 0xc9, 0x4e, 0xc5, 0xd4, 0x74, 0xd5, 0x25, 0x45, 0x1a, 0x4c, 0x06,
+	0x02, 0xb6, 0x12, 0x31, 0x4b, 0xe5, 0x8a, 0x69, 0x63, 0xea, 0xfd, 0xcc, 0x06, 0x61, 0x55, 0xdc,
+	0xf1, 0x50, 0x55, 0xf9, 0x06, 0xab, 0xc3, 0xab, 0x13, 0xd8, 0xb7, 0xf6, 0x6b, 0xe6, 0xa2, 0xc3,
+	0xbf, 0xe6, 0xb2, 0xa3, 0xe7, 0xe1, 0x48, 0xbb, 0x68, 0x10, 0x96, 0x9e, 0x89, 0x93, 0x7e, 0x88,
+	0x78, 0xa5, 0x80, 0x80, 0xe0, 0x6b, 0x70, 0xb8, 0x9d, 0x9f, 0xb4, 0x67, 0x61, 0x35, 0x6a, 0x4e,
+	0xe5, 0xda, 0x29, 0x9c, 0x66, 0xd5, 0x43, 0x2d, 0x8a, 0xd6, 0x3a, 0xab, 0x8d, 0x5a, 0x51, 0x47,
+	0x45, 0xc8, 0x32, 0x47, 0x63, 0x5a, 0xb4, 0x8a, 0x39, 0x30, 0x7f, 0x96, 0xef, 0x18, 0x99, 0x00,
+	0x9a, 0x80, 0x14, 0x3d, 0x16, 0xb5, 0xcd, 0x8d, 0x8a, 0x30, 0xd4, 0xc6, 0xe1, 0x1c, 0xf6, 0x0d,
+	0x0b, 0x8f, 0x65, 0x23, 0xf6, 0x60, 0xd9, 0xa5, 0x51, 0x07, 0x5b, 0x6c, 0x6c, 0x04, 0xa9, 0x30,
+	0x5a, 0xd1, 0x9c, 0x8c, 0xd9, 0x0d, 0x06, 0xe9, 0x74, 0xb0, 0xdd, 0xa8, 0x90, 0x31, 0x88, 0x90,
+	0xe7, 0xed, 0xe9, 0x88, 0xc3, 0xbb, 0xd8, 0x62, 0x55, 0x29, 0x27, 0xba, 0x02, 0xe3, 0xa6, 0x65,
+	0xdc, 0x37, 0x5c, 0x37, 0x15, 0x58, 0xa5, 0x1c, 0x5d, 0xa5, 0x51, 0x8f, 0x20, 0xb0, 0x48, 0x87,
+	0x61, 0x9f, 0xa1, 0xe3, 0x1a, 0x31, 0xc8, 0x0e, 0xad, 0xc7, 0x65, 0xd5, 0xd6, 0x33, 0xba, 0x00,
+	0xa3, 0x1b, 0x86, 0x65, 0x93, 0xb0, 0xcc, 0xfd, 0x94, 0x72, 0x98, 0xfe, 0x1a, 0x10, 0xb8, 0x08,
+	0xfd, 0x16, 0x26, 0xd6, 0x4e, 0xa9, 0x6e, 0x56, 0x8c, 0xf2, 0x0e, 0xab, 0x61, 0x4d, 0x0a, 0xd2,
+	0x7b, 0x62, 0xed, 0xac, 0x52, 0x3a, 0x35, 0x67, 0xb5, 0x1f, 0x9c, 0xc6, 0x05, 0x8d, 0x10, 0x5c,
+	0xad, 0x13, 0x5a, 0x6f, 0xea, 0x55, 0xbd, 0x47, 0xb4, 0x08, 0x07, 0xf0, 0x83, 0xba, 0xe1, 0x2a,
+	0x8e, 0xdb, 0x12, 0x31, 0x18, 0xdb, 0x12, 0x31, 0xd0, 0x66, 0x71, 0x06, 0xd1, 0x49, 0xd8, 0x5f,
+	0xb6, 0x1c, 0x6b, 0x60, 0xf5, 0x30, 0x5a, 0xaf, 0xc9, 0xaa, 0xfd, 0xce, 0xa0, 0x57, 0x23, 0x43,
+	0xff, 0x0f, 0x47, 0xdc, 0xd9, 0xfb, 0x6b, 0x87, 0xeb, 0x5a, 0x79, 0xcb, 0xdc, 0xd8, 0x18, 0x43,
+	0x71, 0x4a, 0x3d, 0x46, 0xb9, 0x3b, 0xcb, 0x86, 0x0b, 0x2e, 0x2b, 0x9a, 0x85, 0x9e, 0x2a, 0xae,
+	0x9a, 0xac, 0x18, 0x32, 0xce, 0xbf, 0x26, 0xc5, 0x55, 0x53, 0xa5, 0x64, 0x48, 0x85, 0xa1, 0x90,
+	0xbf, 0x63, 0x15, 0x8d, 0xc7, 0xf9, 0x91, 0x45, 0xc0, 0x3f, 0xa9, 0x83, 0x76, 0x60, 0x04, 0xdd,
+	0x85, 0xd1, 0xba, 0x85, 0xb7, 0x4b, 0x5a, 0x83, 0x98, 0x8e, 0xfe, 0x61, 0x52, 0xaa, 0x9b, 0x46,
+	0x8d, 0x78, 0x35, 0x0a, 0xd1, 0x7e, 0xd9, 0x98, 0xac, 0x52, 0x3a, 0x75, 0xd8, 0xe1, 0xbf, 0xde,
+	0x20, 0x66, 0xc7, 0x20, 0xba, 0x00, 0x99, 0x4d, 0xac, 0xe9, 0xd8, 0x62, 0xc5, 0x83, 0x23, 0xfc,
+	0x96, 0x18, 0x4a, 0xa2, 0x32, 0x52, 0xb4, 0x02, 0x23, 0xee, 0x42, 0xb7, 0x2b, 0xa1, 0x74, 0x5f,
+	0x0f, 0xc5, 0xee, 0x2b, 0xa2, 0x7c, 0xad, 0xaa, 0x26, 0xdd, 0xdb, 0xcf, 0xc2, 0x60, 0x5d, 0xb3,
+	0x88, 0xe1, 0x5d, 0x6e, 0x6c, 0x18, 0xf7, 0xc7, 0xc6, 0x68, 0x7f, 0xce, 0xff, 0xed, 0xa6, 0x49,
+	0xa5, 0xb0, 0xea, 0x09, 0x5d, 0xa4, 0x32, 0x97, 0x6a, 0xc4, 0xda, 0x51, 0x0f, 0xd4, 0xfd, 0xa3,
+	0xe8, 0x28, 0x80, 0x77, 0x25, 0x66, 0xe8, 0xf4, 0x32, 0x3e, 0xab, 0x66, 0xd9, 0x48, 0x51, 0x3f,
+	0xbc, 0x00, 0x23, 0x3c, 0x39, 0x68, 0x10, 0xd2, 0x5b, 0x78, 0x87, 0xfa, 0xab, 0xac, 0xea, 0xfc,
+	0x89, 0x46, 0xa0, 0x77, 0x5b, 0xab, 0x34, 0xdc, 0x86, 0x9f, 0xac, 0xea, 0x3e, 0x5c, 0x4d, 0x3d,
+	0xa5, 0xe4, 0xdf, 0x50, 0xe0, 0x09, 0xf9, 0xd4, 0xf2, 0x22, 0x64, 0xd8, 0xf1, 0xa2, 0x48, 0x1c,
+	0x2f, 0x8c, 0x16, 0x2d, 0xc3, 0x64, 0x74, 0x6f, 0x81, 0xa1, 0x53, 0x60, 0x69, 0x75, 0x42, 0xdc,
+	0x16, 0x50, 0xd4, 0xf3, 0xdf, 0x51, 0xe0, 0xb4, 0x64, 0x84, 0x7a, 0x09, 0xfa, 0xbc, 0x83, 0x55,
+	0x91, 0x38, 0x58, 0x3d, 0xe2, 0x3d, 0x83, 0x6a, 0xc2, 0x94, 0x74, 0x7a, 0xb6, 0x08, 0xfd, 0xcc,
+	0xb7, 0xb5, 0xe3, 0x8c, 0x01, 0x81, 0xcd, 0x30, 0x57, 0x46, 0xc3, 0x8c, 0x1c, 0x69, 0x3f, 0xe4,
+	0x7f, 0xa7, 0xc0, 0x29, 0x99, 0x0e, 0x15, 0x7f, 0xc0, 0xa0, 0x24, 0x0b, 0x18, 0x6e, 0xc1, 0xa8,
+	0xc0, 0x29, 0xa7, 0xe2, 0xce, 0xaf, 0x61, 0x9b, 0xe3, 0x90, 0x3b, 0x0e, 0xe6, 0xb4, 0xef, 0x60,
+	0xce, 0xbf, 0xa4, 0x40, 0x3e, 0xbe, 0xb9, 0x05, 0xcd, 0x00, 0x0a, 0x36, 0x3c, 0xb4, 0x5a, 0xde,
+	0x06, 0x6d, 0xdf, 0x12, 0x04, 0xbc, 0x53, 0x2a, 0xe0, 0x9d, 0xfc, 0xa6, 0x96, 0x0e, 0x98, 0x5a,
+	0xfe, 0xef, 0x81, 0xe5, 0x15, 0x5a, 0x48, 0x32, 0x44, 0x53, 0x30, 0xe8, 0xbf, 0xf4, 0x6a, 0xa9,
+	0xd7, 0x80, 0xdd, 0x31, 0xe3, 0x00, 0xf6, 0x74, 0x00, 0xfb, 0x19, 0x38, 0xb0, 0x6e, 0xd4, 0x34,
+	0x6b, 0xa7, 0x54, 0xde, 0xc4, 0xe5, 0x2d, 0xbb, 0x51, 0xa5, 0x11, 0x5d, 0x56, 0x1d, 0x70, 0x87,
+	0x17, 0xd9, 0x28, 0x3a, 0x0b, 0x43, 0xfe, 0xab, 0x5a, 0xfc, 0xc0, 0x8d, 0xd6, 0xfa, 0xd5, 0x41,
+	0xdc, 0x79, 0x83, 0x8a, 0x1f, 0x90, 0xfc, 0xf7, 0xd2, 0x70, 0x52, 0xa2, 0x6f, 0xe6, 0xa1, 0xcd,
+	0x38, 0x68, 0x16, 0xe9, 0x2e, 0xcc, 0x02, 0x1d, 0x83, 0xdc, 0xba, 0x66, 0x63, 0x2f, 0xd2, 0x70,
+	0x97, 0x25, 0xeb, 0x0c, 0xb9, 0xf1, 0xc5, 0x04, 0x80, 0x73, 0x4b, 0xcd, 0x7e, 0xee, 0x75, 0x17,
+	0xb6, 0x86, 0x9b, 0xee, 0xaf, 0x33, 0x80, 0x36, 0x4c, 0x6b, 0x8b, 0x21, 0xf5, 0x9a, 0x1f, 0x33,
+	0xee, 0xd4, 0x9c, 0x5f, 0x28, 0xd6, 0x7b, 0xee, 0x38, 0x1a, 0x75, 0x0e, 0x47, 0xcd, 0x36, 0x6b,
+	0x2c, 0x94, 0x64, 0x4f, 0xe8, 0x06, 0xf4, 0x96, 0x9d, 0xdc, 0x84, 0x45, 0x8d, 0x05, 0xe9, 0x0e,
+	0xa5, 0x45, 0x87, 0x4b, 0x75, 0x99, 0x03, 0x0a, 0x9a, 0x0d, 0x2a, 0xe8, 0x5b, 0x69, 0x38, 0x11,
+	0xdb, 0x54, 0xf4, 0xd0, 0xf6, 0x6a, 0xc1, 0x9b, 0xa2, 0xbb, 0x49, 0x33, 0x92, 0x3d, 0x4f, 0xbe,
+	0x09, 0x76, 0x1c, 0xd9, 0x3d, 0x49, 0x8e, 0xec, 0x4e, 0xcb, 0xe8, 0x0d, 0x58, 0x46, 0x60, 0xfb,
+	0x33, 0xd1, 0xdb, 0xdf, 0x27, 0xb5, 0xfd, 0xfb, 0x04, 0xdb, 0xcf, 0xb1, 0xc2, 0x2c, 0xd7, 0x0a,
+	0xfd, 0x3b, 0x09, 0xc1, 0x9d, 0x7c, 0x3d, 0x03, 0xa7, 0x64, 0xda, 0xb1, 0xd0, 0x71, 0xc8, 0xb5,
+	0x7a, 0x1a, 0xd8, 0x2e, 0x66, 0x55, 0xf0, 0x86, 0x8a, 0xba, 0x93, 0xc1, 0xb6, 0x08, 0xa8, 0x09,
+	0xa5, 0x22, 0x32, 0xd8, 0xd6, 0x2b, 0x69, 0x06, 0xab, 0x75, 0x3c, 0x39, 0x8a, 0xad, 0x9b, 0x55,
+	0xcd, 0xa8, 0xb1, 0x93, 0x87, 0x3d, 0xf9, 0x5d, 0x49, 0x4f, 0x97, 0xb9, 0x67, 0x46, 0x3e, 0xf7,
+	0x5c, 0x83, 0x71, 0x4f, 0x47, 0xc3, 0x1e, 0xa8, 0x2f, 0xce, 0x03, 0x8d, 0x7a, 0xbc, 0x01, 0x27,
+	0x14, 0x90, 0xca, 0x1c, 0x1c, 0x93, 0xba, 0x2f, 0x81, 0x54, 0x37, 0xe5, 0x64, 0x52, 0xc5, 0xae,
+	0x32, 0xdb, 0x95, 0xab, 0x5c, 0x86, 0xa1, 0x4d, 0xac, 0x59, 0x64, 0x1d, 0x6b, 0x6d, 0x74, 0x10,
+	0x27, 0x6a, 0xb0, 0xc5, 0xd3, 0x96, 0x13, 0x1f, 0xe0, 0xe4, 0xe2, 0x03, 0x9c, 0x50, 0x62, 0xd6,
+	0xdf, 0x4d, 0x62, 0xd6, 0x0e, 0xf0, 0xf7, 0x4b, 0x07, 0xf8, 0xf9, 0xbf, 0x2a, 0x90, 0x8f, 0x6f,
+	0x0d, 0xfc, 0xc0, 0x42, 0x83, 0xce, 0x20, 0xa6, 0xc7, 0x9f, 0x5d, 0x3e, 0x07, 0xfd, 0x34, 0x39,
+	0xf7, 0x8e, 0xb5, 0x5e, 0x89, 0x63, 0x2d, 0xe7, 0x70, 0xb0, 0x87, 0xfc, 0xbb, 0x8a, 0xff, 0x28,
+	0xd8, 0xe3, 0xb8, 0x9c, 0xbf, 0x44, 0xa9, 0x04, 0xde, 0x20, 0x1d, 0x1b, 0xab, 0xf4, 0xf8, 0x17,
+	0x33, 0xff, 0x7b, 0x05, 0x4e, 0xc4, 0xf7, 0x6b, 0x75, 0x1b, 0xbe, 0x7f, 0x18, 0x33, 0xfa, 0x45,
+	0x0a, 0x4e, 0x4a, 0x74, 0x3d, 0x3a, 0x73, 0xd2, 0x31, 0xd1, 0x8c, 0x8a, 0x2d, 0xb5, 0x49, 0x1e,
+	0xf1, 0x43, 0x9b, 0x53, 0x30, 0xbe, 0xea, 0xe9, 0x26, 0xbe, 0xda, 0xb5, 0x8a, 0x7f, 0x59, 0x81,
+	0x69, 0xf9, 0x66, 0x45, 0x19, 0x9f, 0xb7, 0x37, 0x09, 0xdc, 0x9b, 0x0a, 0x24, 0x6c, 0x4b, 0x8c,
+	0xc7, 0x36, 0xe2, 0x45, 0x49, 0x2c, 0x0b, 0xa7, 0x0f, 0x52, 0x88, 0xd3, 0x12, 0x88, 0x5f, 0x0b,
+	0xe8, 0xa1, 0xa8, 0x80, 0xd9, 0xad, 0x1e, 0x2e, 0xc3, 0x64, 0x45, 0x23, 0x1d, 0xed, 0x39, 0xc1,
+	0x66, 0x95, 0xf6, 0xca, 0xba, 0x74, 0xbc, 0xad, 0x74, 0xa3, 0x2a, 0x8e, 0x3e, 0xa7, 0x13, 0xe8,
+	0x73, 0x4f, 0xac, 0x8d, 0x06, 0xe2, 0xc0, 0xfc, 0x3b, 0x0a, 0x1c, 0x89, 0x68, 0x08, 0x76, 0x3e,
+	0x98, 0x72, 0x1b, 0x21, 0x5b, 0xfb, 0xd6, 0x47, 0x9f, 0x8b, 0x3a, 0x5a, 0x81, 0x83, 0x2d, 0x47,
+	0xbe, 0x61, 0x58, 0x09, 0x52, 0x5e, 0xc4, 0xfc, 0xb8, 0xd3, 0xf0, 0x9b, 0xc4, 0xfd, 0xca, 0x6c,
+	0xf6, 0x27, 0x61, 0x5c, 0xd8, 0x69, 0x1c, 0x35, 0x1b, 0xe9, 0x90, 0x3e, 0xff, 0x96, 0x02, 0x13,
+	0x51, 0x4d, 0xa6, 0x7b, 0xf2, 0x96, 0xbd, 0x5a, 0x8f, 0xc8, 0x03, 0xfa, 0x27, 0x0a, 0x4c, 0xc6,
+	0x35, 0xab, 0x46, 0xcd, 0xe6, 0xa1, 0x9a, 0x6d, 0x24, 0xf2, 0x7f, 0xf5, 0x41, 0xc2, 0x9e, 0x28,
+	0x34, 0x07, 0x23, 0xb4, 0xed, 0x2a, 0x78, 0xc7, 0xee, 0xce, 0x69, 0xa8, 0x86, 0x9b, 0x81, 0x1b,
+	0xf6, 0x50, 0x99, 0x2b, 0xd5, 0x5d, 0x99, 0xeb, 0x51, 0x21, 0x4a, 0xbe, 0x10, 0x25, 0xa3, 0x3b,
+	0x7d, 0x12, 0xba, 0x73, 0x1b, 0x46, 0x59, 0x01, 0x81, 0x61, 0x34, 0x6a, 0x04, 0x5b, 0xdb, 0x5a,
+	0x25, 0x3e, 0x6f, 0x19, 0x61, 0x8c, 0x14, 0x5e, 0x91, 0xb1, 0xf9, 0x8b, 0x5c, 0xd9, 0x5d, 0x15,
+	0xb9, 0x3a, 0x42, 0x38, 0x48, 0x12, 0xc2, 0x89, 0x2b, 0x5a, 0xb9, 0xae, 0x2b, 0x5a, 0xed, 0x3c,
+	0xa3, 0x5f, 0xbe, 0x90, 0xe0, 0xd5, 0x55, 0xf6, 0xef, 0xa2, 0xae, 0x32, 0xb0, 0xab, 0xba, 0x4a,
+	0xfe, 0x2f, 0x0a, 0xcc, 0x25, 0x6d, 0xcc, 0x6c, 0x9d, 0x56, 0x4a, 0xe7, 0x69, 0x15, 0x95, 0xdf,
+	0xac, 0xc3, 0xa1, 0x56, 0x33, 0x47, 0xa0, 0x44, 0xed, 0xda, 0xf1, 0x74, 0x64, 0xbb, 0x86, 0xbf,
+	0x48, 0x7d, 0x10, 0xf3, 0x86, 0x03, 0x39, 0x54, 0x4f, 0xf0, 0xce, 0xe3, 0xbb, 0x0a, 0x4c, 0x09,
+	0x26, 0xca, 0x2b, 0xcc, 0xc7, 0x5b, 0x8f, 0x22, 0x61, 0x3d, 0x1d, 0x81, 0x50, 0x2a, 0x41, 0x20,
+	0x94, 0x7f, 0x5f, 0x81, 0xa3, 0x91, 0xdf, 0x1d, 0x38, 0x91, 0x20, 0xfb, 0xaa, 0xa1, 0xa6, 0x55,
+	0xbd, 0x9d, 0x00, 0x77, 0xe8, 0x96, 0x56, 0xc5, 0xdd, 0xbe, 0x7a, 0xcf, 0x9c, 0x4e, 0xdb, 0x20,
+	0x7a, 0xe4, 0x13, 0xef, 0x9f, 0xf3, 0x36, 0x49, 0xd4, 0x67, 0x73, 0x1c, 0x72, 0xac, 0xd3, 0xa9,
+	0x73, 0x09, 0xdc, 0x21, 0xba, 0x04, 0xad, 0x33, 0x3f, 0x25, 0x7f, 0xe6, 0x47, 0x5d, 0x82, 0xc7,
+	0x68, 0xd8, 0x57, 0x14, 0x98, 0x4e, 0xd0, 0x7a, 0xd6, 0xbe, 0xcb, 0x55, 0x7c, 0x77, 0xb9, 0xdd,
+	0x6e, 0x5c, 0x04, 0xf2, 0xfc, 0x67, 0x38, 0x55, 0x2d, 0x7e, 0xbb, 0x8d, 0x08, 0xd5, 0x2e, 0x0a,
+	0x1b, 0x2f, 0x72, 0xf6, 0x54, 0xd0, 0x4b, 0xf3, 0x30, 0x5e, 0xff, 0xab, 0x14, 0x3c, 0xbb, 0xbb,
+	0x4f, 0x0f, 0xf6, 0xec, 0x38, 0x68, 0xdf, 0x72, 0xa6, 0x7c, 0xb7, 0x9c, 0x77, 0x01, 0x85, 0x5b,
+	0x9c, 0xd8, 0xc9, 0x78, 0x5a, 0xae, 0x08, 0xac, 0x0e, 0x85, 0xfa, 0x95, 0x9c, 0x6b, 0xa3, 0xb2,
+	0x59, 0x23, 0x96, 0x59, 0xa1, 0xca, 0xda, 0xaf, 0x7a, 0x8f, 0xa8, 0x00, 0xc3, 0x81, 0x6e, 0x4d,
+	0xb3, 0x56, 0x71, 0x73, 0x9a, 0x7d, 0xea, 0x90, 0xaf, 0x89, 0xf2, 0x76, 0xad, 0xb2, 0x93, 0x7f,
+	0x35, 0x0d, 0xd7, 0x76, 0xf1, 0x69, 0x03, 0xba, 0xdb, 0xe9, 0x31, 0x06, 0x04, 0x1f, 0x0e, 0x49,
+	0x49, 0xf6, 0xdd, 0xe7, 0xef, 0x51, 0x26, 0x2e, 0xbc, 0x7d, 0xe6, 0xef, 0x4b, 0xcf, 0x6e, 0xf7,
+	0x65, 0x06, 0x50, 0xb0, 0xa1, 0x94, 0x55, 0x86, 0xd2, 0xea, 0xa0, 0xe1, 0x53, 0x42, 0xf7, 0xf2,
+	0xcf, 0xdb, 0xc5, 0x8c, 0x6f, 0x17, 0xf3, 0x7f, 0x50, 0xe0, 0x72, 0x97, 0xdf, 0x65, 0x08, 0x30,
+	0x28, 0x02, 0x0c, 0x1f, 0xac, 0xe2, 0xe6, 0x5f, 0x4e, 0xc3, 0xe5, 0x2e, 0x7b, 0x67, 0xff, 0x5b,
+	0x6d, 0x35, 0xe0, 0xcc, 0x7a, 0xc4, 0xce, 0xac, 0x57, 0xde, 0x99, 0x09, 0x55, 0x47, 0x74, 0x00,
+	0xf4, 0x89, 0x0e, 0x80, 0x2f, 0xa6, 0xe1, 0x62, 0x37, 0xfd, 0xbf, 0x72, 0x96, 0x2f, 0x25, 0xf9,
+	0x91, 0xe5, 0xb7, 0x2d, 0xff, 0x6f, 0x0a, 0x9c, 0x4b, 0xda, 0xcb, 0xfc, 0x1f, 0x6d, 0xf2, 0x62,
+	0x5f, 0x95, 0xff, 0xad, 0x02, 0xb3, 0x89, 0xfa, 0x9f, 0xf7, 0xec, 0x08, 0xe0, 0xe6, 0x5b, 0xa9,
+	0xdd, 0xe5, 0x5b, 0xaf, 0x67, 0xe1, 0x42, 0x17, 0x1f, 0x72, 0x75, 0x6c, 0x87, 0xe2, 0xdb, 0x8e,
+	0xe3, 0x90, 0x6b, 0x6d, 0x07, 0xd3, 0xf9, 0xac, 0x0a, 0xde, 0x10, 0xef, 0xf2, 0x25, 0xbd, 0x07,
+	0x97, 0x2f, 0xdd, 0x56, 0x62, 0x7b, 0xf7, 0xf6, 0xf2, 0x25, 0xf3, 0x50, 0x2f, 0x5f, 0xfa, 0xba,
+	0xbe, 0x7c, 0xb9, 0x07, 0xac, 0x91, 0x9a, 0x49, 0x64, 0x05, 0x4c, 0xb7, 0x39, 0xe3, 0x74, 0x44,
+	0x37, 0x36, 0x95, 0xc2, 0xca, 0x98, 0x43, 0xf5, 0xe0, 0x50, 0xa7, 0x91, 0x64, 0xfd, 0xe7, 0xb9,
+	0x8c, 0xca, 0x83, 0x84, 0xca, 0x97, 0x61, 0xac, 0x43, 0x9d, 0x4a, 0x16, 0x6e, 0xb4, 0xe1, 0xe7,
+	0x28, 0xfc, 0xe9, 0x48, 0xc5, 0x29, 0xea, 0x2a, 0x6e, 0x78, 0x78, 0xd5, 0x83, 0x4d, 0xde, 0x70,
+	0xa8, 0xb0, 0xbb, 0xbf, 0x9b, 0xc2, 0x6e, 0xa8, 0x25, 0x76, 0x80, 0xd3, 0x12, 0xdb, 0x4e, 0x42,
+	0x0f, 0x24, 0xbf, 0x95, 0x19, 0xdc, 0xc5, 0xad, 0xcc, 0xd0, 0xee, 0xba, 0x5d, 0xaf, 0x42, 0x4e,
+	0xc7, 0x15, 0x6d, 0xc7, 0x55, 0xcd, 0xf8, 0xd6, 0x5d, 0xa0, 0xd4, 0x54, 0x15, 0xd1, 0xd3, 0xd0,
+	0xff, 0x29, 0x83, 0x10, 0xef, 0x9f, 0x9a, 0x8c, 0x0d, 0xc7, 0x31, 0xe7, 0x5c, 0x72, 0xca, 0x9d,
+	0x7f, 0x25, 0x0d, 0xe7, 0x92, 0x7e, 0xa6, 0xf9, 0xe1, 0x1f, 0x4e, 0x2b, 0x5e, 0x94, 0xe1, 0x56,
+	0x18, 0x2f, 0x25, 0xfe, 0xc6, 0xd0, 0x17, 0x5c, 0x74, 0x98, 0x59, 0xaf, 0xdf, 0xcc, 0xf8, 0x2e,
+	0x34, 0x23, 0x70, 0xa1, 0x7b, 0x74, 0x07, 0x9b, 0x7f, 0x3b, 0x05, 0x33, 0x49, 0xbe, 0x41, 0x15,
+	0xee, 0x07, 0xdf, 0x77, 0xa7, 0x76, 0xeb, 0xbb, 0xf7, 0x6a, 0x17, 0xf9, 0xab, 0xdb, 0x23, 0x58,
+	0xdd, 0xb6, 0x6d, 0xf7, 0xca, 0x5f, 0x30, 0xbd, 0x9f, 0x82, 0x84, 0x5f, 0xc7, 0x7e, 0x34, 0x16,
+	0x93, 0x57, 0x4e, 0xeb, 0xe5, 0x96, 0xd3, 0xda, 0x7d, 0x20, 0x19, 0xf9, 0x3e, 0x90, 0xfc, 0x3f,
+	0x52, 0x70, 0x76, 0x2f, 0x4e, 0x94, 0x8f, 0xe8, 0xa2, 0x77, 0x54, 0x3a, 0x32, 0x09, 0x2a, 0x1d,
+	0xf9, 0x7f, 0xa6, 0x60, 0x36, 0xd1, 0xc7, 0xca, 0x8f, 0x16, 0x3e, 0xb4, 0xf0, 0xde, 0x65, 0x6c,
+	0x26, 0xc9, 0x05, 0xfe, 0xe7, 0xd2, 0xa2, 0x85, 0x17, 0xf5, 0xee, 0x3c, 0x5a, 0xf8, 0xc8, 0xd6,
+	0xa1, 0x4c, 0x37, 0x5f, 0x2c, 0xfc, 0x32, 0x05, 0x73, 0x09, 0x3f, 0x22, 0x7f, 0xb4, 0x0f, 0xbe,
+	0x7d, 0x98, 0x26, 0x70, 0x80, 0xfe, 0xb9, 0x6c, 0x54, 0x08, 0xb6, 0xe8, 0xab, 0x8e, 0xc2, 0xf8,
+	0xd2, 0xbd, 0xa5, 0x5b, 0x6b, 0xa5, 0xe5, 0xe2, 0xca, 0xda, 0x92, 0x5a, 0x5a, 0xfb, 0xd8, 0xea,
+	0x52, 0xa9, 0x78, 0xeb, 0xde, 0xf5, 0x95, 0xe2, 0x8d, 0xc1, 0xc7, 0xd0, 0x71, 0x38, 0x12, 0xfe,
+	0xf9, 0xfa, 0xca, 0x4a, 0x89, 0x8e, 0x0e, 0x2a, 0xe8, 0x04, 0x1c, 0x0d, 0x13, 0x2c, 0xae, 0xdc,
+	0xbe, 0xb3, 0xc4, 0x48, 0x52, 0x0b, 0x9b, 0x6f, 0xbf, 0x77, 0x4c, 0x79, 0xf7, 0xbd, 0x63, 0xca,
+	0x9f, 0xde, 0x3b, 0xa6, 0xc0, 0xa1, 0xb2, 0x59, 0xe5, 0xad, 0xc7, 0x82, 0xf7, 0x2f, 0x89, 0x57,
+	0x2d, 0x93, 0x98, 0xab, 0xca, 0xc7, 0xcf, 0xdf, 0x37, 0xc8, 0x66, 0x63, 0xbd, 0x50, 0x36, 0xab,
+	0x73, 0x9d, 0xff, 0x1a, 0x79, 0xd6, 0xd0, 0x2b, 0x73, 0xf7, 0x4d, 0xf7, 0xdf, 0x31, 0xb3, 0xff,
+	0x93, 0x7c, 0x4d, 0xab, 0x1b, 0xdb, 0xe7, 0xd7, 0x33, 0x74, 0xec, 0xc2, 0xbf, 0x07, 0x00, 0x95,
+	0x52, 0xff, 0xdb, 0x0a, 0x5a, 0x00, 0x00,
+}
+
+func (m *History) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *History) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *History) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Attributes != nil {
+		{
+			size := m.Attributes.Size()
+			i -= size
+			if _, err := m.Attributes.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Version != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EventTime != nil {
+		{
+			size, err := m.EventTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.EventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryEvent_WorkflowExecutionStartedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_WorkflowExecutionStartedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecutionStartedEventAttributes != nil {
+		{
+			size, err := m.WorkflowExecutionStartedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_WorkflowExecutionCompletedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_WorkflowExecutionCompletedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecutionCompletedEventAttributes != nil {
+		{
+			size, err := m.WorkflowExecutionCompletedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_WorkflowExecutionFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_WorkflowExecutionFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecutionFailedEventAttributes != nil {
+		{
+			size, err := m.WorkflowExecutionFailedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_WorkflowExecutionTimedOutEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_WorkflowExecutionTimedOutEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecutionTimedOutEventAttributes != nil {
+		{
+			size, err := m.WorkflowExecutionTimedOutEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_DecisionTaskScheduledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_DecisionTaskScheduledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DecisionTaskScheduledEventAttributes != nil {
+		{
+			size, err := m.DecisionTaskScheduledEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_DecisionTaskStartedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_DecisionTaskStartedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DecisionTaskStartedEventAttributes != nil {
+		{
+			size, err := m.DecisionTaskStartedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_DecisionTaskCompletedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_DecisionTaskCompletedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DecisionTaskCompletedEventAttributes != nil {
+		{
+			size, err := m.DecisionTaskCompletedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_DecisionTaskTimedOutEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_DecisionTaskTimedOutEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DecisionTaskTimedOutEventAttributes != nil {
+		{
+			size, err := m.DecisionTaskTimedOutEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_DecisionTaskFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_DecisionTaskFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DecisionTaskFailedEventAttributes != nil {
+		{
+			size, err := m.DecisionTaskFailedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_ActivityTaskScheduledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_ActivityTaskScheduledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ActivityTaskScheduledEventAttributes != nil {
+		{
+			size, err := m.ActivityTaskScheduledEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_ActivityTaskStartedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_ActivityTaskStartedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ActivityTaskStartedEventAttributes != nil {
+		{
+			size, err := m.ActivityTaskStartedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_ActivityTaskCompletedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_ActivityTaskCompletedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ActivityTaskCompletedEventAttributes != nil {
+		{
+			size, err := m.ActivityTaskCompletedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_ActivityTaskFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_ActivityTaskFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ActivityTaskFailedEventAttributes != nil {
+		{
+			size, err := m.ActivityTaskFailedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_ActivityTaskTimedOutEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_ActivityTaskTimedOutEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ActivityTaskTimedOutEventAttributes != nil {
+		{
+			size, err := m.ActivityTaskTimedOutEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_TimerStartedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_TimerStartedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TimerStartedEventAttributes != nil {
+		{
+			size, err := m.TimerStartedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_TimerFiredEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_TimerFiredEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TimerFiredEventAttributes != nil {
+		{
+			size, err := m.TimerFiredEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_ActivityTaskCancelRequestedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_ActivityTaskCancelRequestedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ActivityTaskCancelRequestedEventAttributes != nil {
+		{
+			size, err := m.ActivityTaskCancelRequestedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_RequestCancelActivityTaskFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_RequestCancelActivityTaskFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RequestCancelActivityTaskFailedEventAttributes != nil {
+		{
+			size, err := m.RequestCancelActivityTaskFailedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_ActivityTaskCanceledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_ActivityTaskCanceledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ActivityTaskCanceledEventAttributes != nil {
+		{
+			size, err := m.ActivityTaskCanceledEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_TimerCanceledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_TimerCanceledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TimerCanceledEventAttributes != nil {
+		{
+			size, err := m.TimerCanceledEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_CancelTimerFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_CancelTimerFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CancelTimerFailedEventAttributes != nil {
+		{
+			size, err := m.CancelTimerFailedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_MarkerRecordedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_MarkerRecordedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MarkerRecordedEventAttributes != nil {
+		{
+			size, err := m.MarkerRecordedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_WorkflowExecutionSignaledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_WorkflowExecutionSignaledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecutionSignaledEventAttributes != nil {
+		{
+			size, err := m.WorkflowExecutionSignaledEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_WorkflowExecutionTerminatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_WorkflowExecutionTerminatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecutionTerminatedEventAttributes != nil {
+		{
+			size, err := m.WorkflowExecutionTerminatedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_WorkflowExecutionCancelRequestedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_WorkflowExecutionCancelRequestedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecutionCancelRequestedEventAttributes != nil {
+		{
+			size, err := m.WorkflowExecutionCancelRequestedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_WorkflowExecutionCanceledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_WorkflowExecutionCanceledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecutionCanceledEventAttributes != nil {
+		{
+			size, err := m.WorkflowExecutionCanceledEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil {
+		{
+			size, err := m.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_RequestCancelExternalWorkflowExecutionFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_RequestCancelExternalWorkflowExecutionFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil {
+		{
+			size, err := m.RequestCancelExternalWorkflowExecutionFailedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_ExternalWorkflowExecutionCancelRequestedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_ExternalWorkflowExecutionCancelRequestedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil {
+		{
+			size, err := m.ExternalWorkflowExecutionCancelRequestedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecutionContinuedAsNewEventAttributes != nil {
+		{
+			size, err := m.WorkflowExecutionContinuedAsNewEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.StartChildWorkflowExecutionInitiatedEventAttributes != nil {
+		{
+			size, err := m.StartChildWorkflowExecutionInitiatedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_StartChildWorkflowExecutionFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_StartChildWorkflowExecutionFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.StartChildWorkflowExecutionFailedEventAttributes != nil {
+		{
+			size, err := m.StartChildWorkflowExecutionFailedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_ChildWorkflowExecutionStartedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_ChildWorkflowExecutionStartedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ChildWorkflowExecutionStartedEventAttributes != nil {
+		{
+			size, err := m.ChildWorkflowExecutionStartedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_ChildWorkflowExecutionCompletedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_ChildWorkflowExecutionCompletedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ChildWorkflowExecutionCompletedEventAttributes != nil {
+		{
+			size, err := m.ChildWorkflowExecutionCompletedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_ChildWorkflowExecutionFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_ChildWorkflowExecutionFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ChildWorkflowExecutionFailedEventAttributes != nil {
+		{
+			size, err := m.ChildWorkflowExecutionFailedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_ChildWorkflowExecutionCanceledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_ChildWorkflowExecutionCanceledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ChildWorkflowExecutionCanceledEventAttributes != nil {
+		{
+			size, err := m.ChildWorkflowExecutionCanceledEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_ChildWorkflowExecutionTimedOutEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_ChildWorkflowExecutionTimedOutEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ChildWorkflowExecutionTimedOutEventAttributes != nil {
+		{
+			size, err := m.ChildWorkflowExecutionTimedOutEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_ChildWorkflowExecutionTerminatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_ChildWorkflowExecutionTerminatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ChildWorkflowExecutionTerminatedEventAttributes != nil {
+		{
+			size, err := m.ChildWorkflowExecutionTerminatedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_SignalExternalWorkflowExecutionInitiatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_SignalExternalWorkflowExecutionInitiatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil {
+		{
+			size, err := m.SignalExternalWorkflowExecutionInitiatedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xda
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SignalExternalWorkflowExecutionFailedEventAttributes != nil {
+		{
+			size, err := m.SignalExternalWorkflowExecutionFailedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ExternalWorkflowExecutionSignaledEventAttributes != nil {
+		{
+			size, err := m.ExternalWorkflowExecutionSignaledEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpsertWorkflowSearchAttributesEventAttributes != nil {
+		{
+			size, err := m.UpsertWorkflowSearchAttributesEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_WorkflowExecutionPausedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_WorkflowExecutionPausedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecutionPausedEventAttributes != nil {
+		{
+			size, err := m.WorkflowExecutionPausedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HistoryEvent_WorkflowExecutionUnpausedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_WorkflowExecutionUnpausedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecutionUnpausedEventAttributes != nil {
+		{
+			size, err := m.WorkflowExecutionUnpausedEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
+func (m *WorkflowExecutionStartedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.PartitionConfig) > 0 {
+		for k := range m.PartitionConfig {
+			v := m.PartitionConfig[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintHistory(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintHistory(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintHistory(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.FirstScheduledTime != nil {
+		{
+			size, err := m.FirstScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.PrevAutoResetPoints != nil {
+		{
+			size, err := m.PrevAutoResetPoints.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.SearchAttributes != nil {
+		{
+			size, err := m.SearchAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.Memo != nil {
+		{
+			size, err := m.Memo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.FirstDecisionTaskBackoff != nil {
+		{
+			size, err := m.FirstDecisionTaskBackoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.CronSchedule) > 0 {
+		i -= len(m.CronSchedule)
+		copy(dAtA[i:], m.CronSchedule)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.CronSchedule)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ExpirationTime != nil {
+		{
+			size, err := m.ExpirationTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.FirstExecutionRunId) > 0 {
+		i -= len(m.FirstExecutionRunId)
+		copy(dAtA[i:], m.FirstExecutionRunId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.FirstExecutionRunId)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.OriginalExecutionRunId) > 0 {
+		i -= len(m.OriginalExecutionRunId)
+		copy(dAtA[i:], m.OriginalExecutionRunId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.OriginalExecutionRunId)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.LastCompletionResult != nil {
+		{
+			size, err := m.LastCompletionResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ContinuedFailure != nil {
+		{
+			size, err := m.ContinuedFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Initiator != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Initiator))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ContinuedExecutionRunId) > 0 {
+		i -= len(m.ContinuedExecutionRunId)
+		copy(dAtA[i:], m.ContinuedExecutionRunId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.ContinuedExecutionRunId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TaskStartToCloseTimeout != nil {
+		{
+			size, err := m.TaskStartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExecutionStartToCloseTimeout != nil {
+		{
+			size, err := m.ExecutionStartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ParentExecutionInfo != nil {
+		{
+			size, err := m.ParentExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExecutionCompletedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExecutionCompletedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExecutionCompletedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExecutionFailedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExecutionFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExecutionFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Failure != nil {
+		{
+			size, err := m.Failure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExecutionTimedOutEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExecutionTimedOutEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExecutionTimedOutEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TimeoutType != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.TimeoutType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecisionTaskScheduledEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecisionTaskScheduledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecisionTaskScheduledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartToCloseTimeout != nil {
+		{
+			size, err := m.StartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecisionTaskStartedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecisionTaskStartedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecisionTaskStartedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScheduledEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ScheduledEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecisionTaskCompletedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecisionTaskCompletedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecisionTaskCompletedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ExecutionContext) > 0 {
+		i -= len(m.ExecutionContext)
+		copy(dAtA[i:], m.ExecutionContext)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.ExecutionContext)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BinaryChecksum) > 0 {
+		i -= len(m.BinaryChecksum)
+		copy(dAtA[i:], m.BinaryChecksum)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.BinaryChecksum)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ScheduledEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ScheduledEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecisionTaskTimedOutEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecisionTaskTimedOutEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecisionTaskTimedOutEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Cause != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Cause))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ForkEventVersion != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ForkEventVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.NewRunId) > 0 {
+		i -= len(m.NewRunId)
+		copy(dAtA[i:], m.NewRunId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.NewRunId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BaseRunId) > 0 {
+		i -= len(m.BaseRunId)
+		copy(dAtA[i:], m.BaseRunId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.BaseRunId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TimeoutType != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.TimeoutType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ScheduledEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ScheduledEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecisionTaskFailedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecisionTaskFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecisionTaskFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.BinaryChecksum) > 0 {
+		i -= len(m.BinaryChecksum)
+		copy(dAtA[i:], m.BinaryChecksum)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.BinaryChecksum)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ForkEventVersion != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ForkEventVersion))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.NewRunId) > 0 {
+		i -= len(m.NewRunId)
+		copy(dAtA[i:], m.NewRunId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.NewRunId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BaseRunId) > 0 {
+		i -= len(m.BaseRunId)
+		copy(dAtA[i:], m.BaseRunId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.BaseRunId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Failure != nil {
+		{
+			size, err := m.Failure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Cause != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Cause))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ScheduledEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ScheduledEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivityTaskScheduledEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivityTaskScheduledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivityTaskScheduledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.HeartbeatTimeout != nil {
+		{
+			size, err := m.HeartbeatTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.StartToCloseTimeout != nil {
+		{
+			size, err := m.StartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ScheduleToStartTimeout != nil {
+		{
+			size, err := m.ScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		{
+			size, err := m.ScheduleToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ActivityType != nil {
+		{
+			size, err := m.ActivityType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.ActivityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivityTaskStartedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivityTaskStartedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivityTaskStartedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastFailure != nil {
+		{
+			size, err := m.LastFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScheduledEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ScheduledEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivityTaskCompletedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivityTaskCompletedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivityTaskCompletedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ScheduledEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ScheduledEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivityTaskFailedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivityTaskFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivityTaskFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ScheduledEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ScheduledEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Failure != nil {
+		{
+			size, err := m.Failure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivityTaskTimedOutEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivityTaskTimedOutEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivityTaskTimedOutEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastFailure != nil {
+		{
+			size, err := m.LastFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TimeoutType != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.TimeoutType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ScheduledEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ScheduledEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivityTaskCancelRequestedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivityTaskCancelRequestedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivityTaskCancelRequestedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.ActivityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestCancelActivityTaskFailedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCancelActivityTaskFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestCancelActivityTaskFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Cause) > 0 {
+		i -= len(m.Cause)
+		copy(dAtA[i:], m.Cause)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Cause)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.ActivityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivityTaskCanceledEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivityTaskCanceledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivityTaskCanceledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScheduledEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ScheduledEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LatestCancelRequestedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.LatestCancelRequestedEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimerStartedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimerStartedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimerStartedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartToFireTimeout != nil {
+		{
+			size, err := m.StartToFireTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TimerId) > 0 {
+		i -= len(m.TimerId)
+		copy(dAtA[i:], m.TimerId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.TimerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimerFiredEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimerFiredEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimerFiredEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TimerId) > 0 {
+		i -= len(m.TimerId)
+		copy(dAtA[i:], m.TimerId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.TimerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimerCanceledEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimerCanceledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimerCanceledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TimerId) > 0 {
+		i -= len(m.TimerId)
+		copy(dAtA[i:], m.TimerId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.TimerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelTimerFailedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelTimerFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelTimerFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Cause) > 0 {
+		i -= len(m.Cause)
+		copy(dAtA[i:], m.Cause)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Cause)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TimerId) > 0 {
+		i -= len(m.TimerId)
+		copy(dAtA[i:], m.TimerId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.TimerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SearchAttributes != nil {
+		{
+			size, err := m.SearchAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Memo != nil {
+		{
+			size, err := m.Memo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.LastCompletionResult != nil {
+		{
+			size, err := m.LastCompletionResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Failure != nil {
+		{
+			size, err := m.Failure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Initiator != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Initiator))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BackoffStartInterval != nil {
+		{
+			size, err := m.BackoffStartInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TaskStartToCloseTimeout != nil {
+		{
+			size, err := m.TaskStartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExecutionStartToCloseTimeout != nil {
+		{
+			size, err := m.ExecutionStartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NewExecutionRunId) > 0 {
+		i -= len(m.NewExecutionRunId)
+		copy(dAtA[i:], m.NewExecutionRunId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.NewExecutionRunId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExecutionCancelRequestedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExecutionCancelRequestedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExecutionCancelRequestedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExternalExecutionInfo != nil {
+		{
+			size, err := m.ExternalExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Cause) > 0 {
+		i -= len(m.Cause)
+		copy(dAtA[i:], m.Cause)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Cause)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExecutionCanceledEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExecutionCanceledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExecutionCanceledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkerRecordedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerRecordedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerRecordedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarkerName) > 0 {
+		i -= len(m.MarkerName)
+		copy(dAtA[i:], m.MarkerName)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.MarkerName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExecutionSignaledEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExecutionSignaledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExecutionSignaledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignalName) > 0 {
+		i -= len(m.SignalName)
+		copy(dAtA[i:], m.SignalName)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.SignalName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExecutionTerminatedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExecutionTerminatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExecutionTerminatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExecutionPausedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExecutionPausedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExecutionPausedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowExecutionUnpausedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowExecutionUnpausedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowExecutionUnpausedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChildWorkflowOnly {
+		i--
+		if m.ChildWorkflowOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Control) > 0 {
+		i -= len(m.Control)
+		copy(dAtA[i:], m.Control)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Control)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Control) > 0 {
+		i -= len(m.Control)
+		copy(dAtA[i:], m.Control)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Control)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.InitiatedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.InitiatedEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Cause != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Cause))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.InitiatedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.InitiatedEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChildWorkflowOnly {
+		i--
+		if m.ChildWorkflowOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Control) > 0 {
+		i -= len(m.Control)
+		copy(dAtA[i:], m.Control)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Control)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SignalName) > 0 {
+		i -= len(m.SignalName)
+		copy(dAtA[i:], m.SignalName)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.SignalName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Control) > 0 {
+		i -= len(m.Control)
+		copy(dAtA[i:], m.Control)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Control)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.InitiatedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.InitiatedEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Cause != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Cause))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExternalWorkflowExecutionSignaledEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalWorkflowExecutionSignaledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalWorkflowExecutionSignaledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Control) > 0 {
+		i -= len(m.Control)
+		copy(dAtA[i:], m.Control)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Control)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.InitiatedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.InitiatedEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpsertWorkflowSearchAttributesEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpsertWorkflowSearchAttributesEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpsertWorkflowSearchAttributesEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SearchAttributes != nil {
+		{
+			size, err := m.SearchAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.JitterStart != nil {
+		{
+			size, err := m.JitterStart.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.DelayStart != nil {
+		{
+			size, err := m.DelayStart.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.SearchAttributes != nil {
+		{
+			size, err := m.SearchAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Memo != nil {
+		{
+			size, err := m.Memo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.CronSchedule) > 0 {
+		i -= len(m.CronSchedule)
+		copy(dAtA[i:], m.CronSchedule)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.CronSchedule)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.WorkflowIdReusePolicy != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.WorkflowIdReusePolicy))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Control) > 0 {
+		i -= len(m.Control)
+		copy(dAtA[i:], m.Control)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Control)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ParentClosePolicy != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ParentClosePolicy))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TaskStartToCloseTimeout != nil {
+		{
+			size, err := m.TaskStartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExecutionStartToCloseTimeout != nil {
+		{
+			size, err := m.ExecutionStartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartChildWorkflowExecutionFailedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartChildWorkflowExecutionFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartChildWorkflowExecutionFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.InitiatedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.InitiatedEventId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Control) > 0 {
+		i -= len(m.Control)
+		copy(dAtA[i:], m.Control)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Control)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Cause != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Cause))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChildWorkflowExecutionStartedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChildWorkflowExecutionStartedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChildWorkflowExecutionStartedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.InitiatedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.InitiatedEventId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChildWorkflowExecutionCompletedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChildWorkflowExecutionCompletedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChildWorkflowExecutionCompletedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InitiatedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.InitiatedEventId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChildWorkflowExecutionFailedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChildWorkflowExecutionFailedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChildWorkflowExecutionFailedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Failure != nil {
+		{
+			size, err := m.Failure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InitiatedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.InitiatedEventId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChildWorkflowExecutionCanceledEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChildWorkflowExecutionCanceledEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChildWorkflowExecutionCanceledEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InitiatedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.InitiatedEventId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TimeoutType != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.TimeoutType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InitiatedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.InitiatedEventId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChildWorkflowExecutionTerminatedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChildWorkflowExecutionTerminatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChildWorkflowExecutionTerminatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InitiatedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.InitiatedEventId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *History) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovHistory(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HistoryEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventId != 0 {
+		n += 1 + sovHistory(uint64(m.EventId))
+	}
+	if m.EventTime != nil {
+		l = m.EventTime.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovHistory(uint64(m.Version))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovHistory(uint64(m.TaskId))
+	}
+	if m.Attributes != nil {
+		n += m.Attributes.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HistoryEvent_WorkflowExecutionStartedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecutionStartedEventAttributes != nil {
+		l = m.WorkflowExecutionStartedEventAttributes.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_WorkflowExecutionCompletedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecutionCompletedEventAttributes != nil {
+		l = m.WorkflowExecutionCompletedEventAttributes.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_WorkflowExecutionFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecutionFailedEventAttributes != nil {
+		l = m.WorkflowExecutionFailedEventAttributes.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_WorkflowExecutionTimedOutEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecutionTimedOutEventAttributes != nil {
+		l = m.WorkflowExecutionTimedOutEventAttributes.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_DecisionTaskScheduledEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DecisionTaskScheduledEventAttributes != nil {
+		l = m.DecisionTaskScheduledEventAttributes.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_DecisionTaskStartedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DecisionTaskStartedEventAttributes != nil {
+		l = m.DecisionTaskStartedEventAttributes.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_DecisionTaskCompletedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DecisionTaskCompletedEventAttributes != nil {
+		l = m.DecisionTaskCompletedEventAttributes.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_DecisionTaskTimedOutEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DecisionTaskTimedOutEventAttributes != nil {
+		l = m.DecisionTaskTimedOutEventAttributes.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_DecisionTaskFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DecisionTaskFailedEventAttributes != nil {
+		l = m.DecisionTaskFailedEventAttributes.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_ActivityTaskScheduledEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActivityTaskScheduledEventAttributes != nil {
+		l = m.ActivityTaskScheduledEventAttributes.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_ActivityTaskStartedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActivityTaskStartedEventAttributes != nil {
+		l = m.ActivityTaskStartedEventAttributes.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_ActivityTaskCompletedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActivityTaskCompletedEventAttributes != nil {
+		l = m.ActivityTaskCompletedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_ActivityTaskFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActivityTaskFailedEventAttributes != nil {
+		l = m.ActivityTaskFailedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_ActivityTaskTimedOutEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActivityTaskTimedOutEventAttributes != nil {
+		l = m.ActivityTaskTimedOutEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_TimerStartedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimerStartedEventAttributes != nil {
+		l = m.TimerStartedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_TimerFiredEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimerFiredEventAttributes != nil {
+		l = m.TimerFiredEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_ActivityTaskCancelRequestedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActivityTaskCancelRequestedEventAttributes != nil {
+		l = m.ActivityTaskCancelRequestedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_RequestCancelActivityTaskFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestCancelActivityTaskFailedEventAttributes != nil {
+		l = m.RequestCancelActivityTaskFailedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_ActivityTaskCanceledEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActivityTaskCanceledEventAttributes != nil {
+		l = m.ActivityTaskCanceledEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_TimerCanceledEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimerCanceledEventAttributes != nil {
+		l = m.TimerCanceledEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_CancelTimerFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CancelTimerFailedEventAttributes != nil {
+		l = m.CancelTimerFailedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_MarkerRecordedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarkerRecordedEventAttributes != nil {
+		l = m.MarkerRecordedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_WorkflowExecutionSignaledEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecutionSignaledEventAttributes != nil {
+		l = m.WorkflowExecutionSignaledEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_WorkflowExecutionTerminatedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecutionTerminatedEventAttributes != nil {
+		l = m.WorkflowExecutionTerminatedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_WorkflowExecutionCancelRequestedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecutionCancelRequestedEventAttributes != nil {
+		l = m.WorkflowExecutionCancelRequestedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_WorkflowExecutionCanceledEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecutionCanceledEventAttributes != nil {
+		l = m.WorkflowExecutionCanceledEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil {
+		l = m.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_RequestCancelExternalWorkflowExecutionFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil {
+		l = m.RequestCancelExternalWorkflowExecutionFailedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_ExternalWorkflowExecutionCancelRequestedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil {
+		l = m.ExternalWorkflowExecutionCancelRequestedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecutionContinuedAsNewEventAttributes != nil {
+		l = m.WorkflowExecutionContinuedAsNewEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartChildWorkflowExecutionInitiatedEventAttributes != nil {
+		l = m.StartChildWorkflowExecutionInitiatedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_StartChildWorkflowExecutionFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartChildWorkflowExecutionFailedEventAttributes != nil {
+		l = m.StartChildWorkflowExecutionFailedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_ChildWorkflowExecutionStartedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChildWorkflowExecutionStartedEventAttributes != nil {
+		l = m.ChildWorkflowExecutionStartedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_ChildWorkflowExecutionCompletedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChildWorkflowExecutionCompletedEventAttributes != nil {
+		l = m.ChildWorkflowExecutionCompletedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_ChildWorkflowExecutionFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChildWorkflowExecutionFailedEventAttributes != nil {
+		l = m.ChildWorkflowExecutionFailedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_ChildWorkflowExecutionCanceledEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChildWorkflowExecutionCanceledEventAttributes != nil {
+		l = m.ChildWorkflowExecutionCanceledEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_ChildWorkflowExecutionTimedOutEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChildWorkflowExecutionTimedOutEventAttributes != nil {
+		l = m.ChildWorkflowExecutionTimedOutEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_ChildWorkflowExecutionTerminatedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChildWorkflowExecutionTerminatedEventAttributes != nil {
+		l = m.ChildWorkflowExecutionTerminatedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_SignalExternalWorkflowExecutionInitiatedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil {
+		l = m.SignalExternalWorkflowExecutionInitiatedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignalExternalWorkflowExecutionFailedEventAttributes != nil {
+		l = m.SignalExternalWorkflowExecutionFailedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExternalWorkflowExecutionSignaledEventAttributes != nil {
+		l = m.ExternalWorkflowExecutionSignaledEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpsertWorkflowSearchAttributesEventAttributes != nil {
+		l = m.UpsertWorkflowSearchAttributesEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_WorkflowExecutionPausedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecutionPausedEventAttributes != nil {
+		l = m.WorkflowExecutionPausedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *HistoryEvent_WorkflowExecutionUnpausedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecutionUnpausedEventAttributes != nil {
+		l = m.WorkflowExecutionUnpausedEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+func (m *WorkflowExecutionStartedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ParentExecutionInfo != nil {
+		l = m.ParentExecutionInfo.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ExecutionStartToCloseTimeout != nil {
+		l = m.ExecutionStartToCloseTimeout.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.TaskStartToCloseTimeout != nil {
+		l = m.TaskStartToCloseTimeout.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.ContinuedExecutionRunId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Initiator != 0 {
+		n += 1 + sovHistory(uint64(m.Initiator))
+	}
+	if m.ContinuedFailure != nil {
+		l = m.ContinuedFailure.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.LastCompletionResult != nil {
+		l = m.LastCompletionResult.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.OriginalExecutionRunId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.FirstExecutionRunId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovHistory(uint64(m.Attempt))
+	}
+	if m.ExpirationTime != nil {
+		l = m.ExpirationTime.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	l = len(m.CronSchedule)
+	if l > 0 {
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	if m.FirstDecisionTaskBackoff != nil {
+		l = m.FirstDecisionTaskBackoff.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	if m.Memo != nil {
+		l = m.Memo.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	if m.SearchAttributes != nil {
+		l = m.SearchAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	if m.PrevAutoResetPoints != nil {
+		l = m.PrevAutoResetPoints.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	if m.FirstScheduledTime != nil {
+		l = m.FirstScheduledTime.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	if len(m.PartitionConfig) > 0 {
+		for k, v := range m.PartitionConfig {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovHistory(uint64(len(k))) + 1 + len(v) + sovHistory(uint64(len(v)))
+			n += mapEntrySize + 2 + sovHistory(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExecutionCompletedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExecutionFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Failure != nil {
+		l = m.Failure.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExecutionTimedOutEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimeoutType != 0 {
+		n += 1 + sovHistory(uint64(m.TimeoutType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DecisionTaskScheduledEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.StartToCloseTimeout != nil {
+		l = m.StartToCloseTimeout.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovHistory(uint64(m.Attempt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DecisionTaskStartedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovHistory(uint64(m.ScheduledEventId))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DecisionTaskCompletedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovHistory(uint64(m.ScheduledEventId))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.StartedEventId))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.BinaryChecksum)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.ExecutionContext)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DecisionTaskTimedOutEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovHistory(uint64(m.ScheduledEventId))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.StartedEventId))
+	}
+	if m.TimeoutType != 0 {
+		n += 1 + sovHistory(uint64(m.TimeoutType))
+	}
+	l = len(m.BaseRunId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.NewRunId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ForkEventVersion != 0 {
+		n += 1 + sovHistory(uint64(m.ForkEventVersion))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Cause != 0 {
+		n += 1 + sovHistory(uint64(m.Cause))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DecisionTaskFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovHistory(uint64(m.ScheduledEventId))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.StartedEventId))
+	}
+	if m.Cause != 0 {
+		n += 1 + sovHistory(uint64(m.Cause))
+	}
+	if m.Failure != nil {
+		l = m.Failure.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.BaseRunId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.NewRunId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ForkEventVersion != 0 {
+		n += 1 + sovHistory(uint64(m.ForkEventVersion))
+	}
+	l = len(m.BinaryChecksum)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivityTaskScheduledEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ActivityType != nil {
+		l = m.ActivityType.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		l = m.ScheduleToCloseTimeout.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ScheduleToStartTimeout != nil {
+		l = m.ScheduleToStartTimeout.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.StartToCloseTimeout != nil {
+		l = m.StartToCloseTimeout.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.HeartbeatTimeout != nil {
+		l = m.HeartbeatTimeout.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivityTaskStartedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovHistory(uint64(m.ScheduledEventId))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovHistory(uint64(m.Attempt))
+	}
+	if m.LastFailure != nil {
+		l = m.LastFailure.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivityTaskCompletedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovHistory(uint64(m.ScheduledEventId))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.StartedEventId))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivityTaskFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Failure != nil {
+		l = m.Failure.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovHistory(uint64(m.ScheduledEventId))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.StartedEventId))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivityTaskTimedOutEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovHistory(uint64(m.ScheduledEventId))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.StartedEventId))
+	}
+	if m.TimeoutType != 0 {
+		n += 1 + sovHistory(uint64(m.TimeoutType))
+	}
+	if m.LastFailure != nil {
+		l = m.LastFailure.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivityTaskCancelRequestedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestCancelActivityTaskFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Cause)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivityTaskCanceledEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.LatestCancelRequestedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.LatestCancelRequestedEventId))
+	}
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovHistory(uint64(m.ScheduledEventId))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.StartedEventId))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TimerStartedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TimerId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.StartToFireTimeout != nil {
+		l = m.StartToFireTimeout.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TimerFiredEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TimerId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.StartedEventId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TimerCanceledEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TimerId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.StartedEventId))
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancelTimerFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TimerId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Cause)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewExecutionRunId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ExecutionStartToCloseTimeout != nil {
+		l = m.ExecutionStartToCloseTimeout.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.TaskStartToCloseTimeout != nil {
+		l = m.TaskStartToCloseTimeout.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	if m.BackoffStartInterval != nil {
+		l = m.BackoffStartInterval.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Initiator != 0 {
+		n += 1 + sovHistory(uint64(m.Initiator))
+	}
+	if m.Failure != nil {
+		l = m.Failure.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.LastCompletionResult != nil {
+		l = m.LastCompletionResult.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Memo != nil {
+		l = m.Memo.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.SearchAttributes != nil {
+		l = m.SearchAttributes.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExecutionCancelRequestedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cause)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ExternalExecutionInfo != nil {
+		l = m.ExternalExecutionInfo.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExecutionCanceledEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MarkerRecordedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarkerName)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExecutionSignaledEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalName)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExecutionTerminatedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExecutionPausedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowExecutionUnpausedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Control)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ChildWorkflowOnly {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cause != 0 {
+		n += 1 + sovHistory(uint64(m.Cause))
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.InitiatedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.InitiatedEventId))
+	}
+	l = len(m.Control)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InitiatedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.InitiatedEventId))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.SignalName)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Control)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ChildWorkflowOnly {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cause != 0 {
+		n += 1 + sovHistory(uint64(m.Cause))
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.InitiatedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.InitiatedEventId))
+	}
+	l = len(m.Control)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExternalWorkflowExecutionSignaledEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InitiatedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.InitiatedEventId))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Control)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpsertWorkflowSearchAttributesEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	if m.SearchAttributes != nil {
+		l = m.SearchAttributes.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ExecutionStartToCloseTimeout != nil {
+		l = m.ExecutionStartToCloseTimeout.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.TaskStartToCloseTimeout != nil {
+		l = m.TaskStartToCloseTimeout.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ParentClosePolicy != 0 {
+		n += 1 + sovHistory(uint64(m.ParentClosePolicy))
+	}
+	l = len(m.Control)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	if m.WorkflowIdReusePolicy != 0 {
+		n += 1 + sovHistory(uint64(m.WorkflowIdReusePolicy))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.CronSchedule)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Memo != nil {
+		l = m.Memo.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	if m.SearchAttributes != nil {
+		l = m.SearchAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	if m.DelayStart != nil {
+		l = m.DelayStart.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	if m.JitterStart != nil {
+		l = m.JitterStart.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StartChildWorkflowExecutionFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Cause != 0 {
+		n += 1 + sovHistory(uint64(m.Cause))
+	}
+	l = len(m.Control)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.InitiatedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.InitiatedEventId))
+	}
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChildWorkflowExecutionStartedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.InitiatedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.InitiatedEventId))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChildWorkflowExecutionCompletedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.InitiatedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.InitiatedEventId))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.StartedEventId))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChildWorkflowExecutionFailedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.InitiatedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.InitiatedEventId))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.StartedEventId))
+	}
+	if m.Failure != nil {
+		l = m.Failure.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChildWorkflowExecutionCanceledEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.InitiatedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.InitiatedEventId))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.StartedEventId))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.InitiatedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.InitiatedEventId))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.StartedEventId))
+	}
+	if m.TimeoutType != 0 {
+		n += 1 + sovHistory(uint64(m.TimeoutType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChildWorkflowExecutionTerminatedEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.InitiatedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.InitiatedEventId))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.StartedEventId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *History) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: History: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: History: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &HistoryEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventId", wireType)
+			}
+			m.EventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EventTime == nil {
+				m.EventTime = &types.Timestamp{}
+			}
+			if err := m.EventTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionStartedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkflowExecutionStartedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_WorkflowExecutionStartedEventAttributes{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionCompletedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkflowExecutionCompletedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_WorkflowExecutionCompletedEventAttributes{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionFailedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkflowExecutionFailedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_WorkflowExecutionFailedEventAttributes{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTimedOutEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkflowExecutionTimedOutEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_WorkflowExecutionTimedOutEventAttributes{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskScheduledEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DecisionTaskScheduledEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_DecisionTaskScheduledEventAttributes{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskStartedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DecisionTaskStartedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_DecisionTaskStartedEventAttributes{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DecisionTaskCompletedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_DecisionTaskCompletedEventAttributes{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskTimedOutEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DecisionTaskTimedOutEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_DecisionTaskTimedOutEventAttributes{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskFailedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DecisionTaskFailedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_DecisionTaskFailedEventAttributes{v}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskScheduledEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActivityTaskScheduledEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_ActivityTaskScheduledEventAttributes{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskStartedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActivityTaskStartedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_ActivityTaskStartedEventAttributes{v}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskCompletedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActivityTaskCompletedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_ActivityTaskCompletedEventAttributes{v}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskFailedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActivityTaskFailedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_ActivityTaskFailedEventAttributes{v}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskTimedOutEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActivityTaskTimedOutEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_ActivityTaskTimedOutEventAttributes{v}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerStartedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TimerStartedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_TimerStartedEventAttributes{v}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerFiredEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TimerFiredEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_TimerFiredEventAttributes{v}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskCancelRequestedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActivityTaskCancelRequestedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_ActivityTaskCancelRequestedEventAttributes{v}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestCancelActivityTaskFailedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestCancelActivityTaskFailedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_RequestCancelActivityTaskFailedEventAttributes{v}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskCanceledEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActivityTaskCanceledEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_ActivityTaskCanceledEventAttributes{v}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerCanceledEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TimerCanceledEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_TimerCanceledEventAttributes{v}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelTimerFailedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CancelTimerFailedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_CancelTimerFailedEventAttributes{v}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerRecordedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MarkerRecordedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_MarkerRecordedEventAttributes{v}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionSignaledEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkflowExecutionSignaledEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_WorkflowExecutionSignaledEventAttributes{v}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTerminatedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkflowExecutionTerminatedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_WorkflowExecutionTerminatedEventAttributes{v}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionCancelRequestedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkflowExecutionCancelRequestedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_WorkflowExecutionCancelRequestedEventAttributes{v}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionCanceledEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkflowExecutionCanceledEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_WorkflowExecutionCanceledEventAttributes{v}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestCancelExternalWorkflowExecutionInitiatedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{v}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestCancelExternalWorkflowExecutionFailedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestCancelExternalWorkflowExecutionFailedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_RequestCancelExternalWorkflowExecutionFailedEventAttributes{v}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecutionCancelRequestedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExternalWorkflowExecutionCancelRequestedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_ExternalWorkflowExecutionCancelRequestedEventAttributes{v}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionContinuedAsNewEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkflowExecutionContinuedAsNewEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes{v}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartChildWorkflowExecutionInitiatedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StartChildWorkflowExecutionInitiatedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_StartChildWorkflowExecutionInitiatedEventAttributes{v}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartChildWorkflowExecutionFailedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StartChildWorkflowExecutionFailedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_StartChildWorkflowExecutionFailedEventAttributes{v}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowExecutionStartedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ChildWorkflowExecutionStartedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_ChildWorkflowExecutionStartedEventAttributes{v}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowExecutionCompletedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ChildWorkflowExecutionCompletedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_ChildWorkflowExecutionCompletedEventAttributes{v}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowExecutionFailedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ChildWorkflowExecutionFailedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_ChildWorkflowExecutionFailedEventAttributes{v}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowExecutionCanceledEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ChildWorkflowExecutionCanceledEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_ChildWorkflowExecutionCanceledEventAttributes{v}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowExecutionTimedOutEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ChildWorkflowExecutionTimedOutEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_ChildWorkflowExecutionTimedOutEventAttributes{v}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowExecutionTerminatedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ChildWorkflowExecutionTerminatedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_ChildWorkflowExecutionTerminatedEventAttributes{v}
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalExternalWorkflowExecutionInitiatedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SignalExternalWorkflowExecutionInitiatedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_SignalExternalWorkflowExecutionInitiatedEventAttributes{v}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalExternalWorkflowExecutionFailedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SignalExternalWorkflowExecutionFailedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes{v}
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecutionSignaledEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExternalWorkflowExecutionSignaledEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes{v}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpsertWorkflowSearchAttributesEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpsertWorkflowSearchAttributesEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes{v}
+			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionPausedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkflowExecutionPausedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_WorkflowExecutionPausedEventAttributes{v}
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionUnpausedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkflowExecutionUnpausedEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_WorkflowExecutionUnpausedEventAttributes{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionStartedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionStartedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionStartedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentExecutionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentExecutionInfo == nil {
+				m.ParentExecutionInfo = &ParentExecutionInfo{}
+			}
+			if err := m.ParentExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Payload{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionStartToCloseTimeout == nil {
+				m.ExecutionStartToCloseTimeout = &types.Duration{}
+			}
+			if err := m.ExecutionStartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskStartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskStartToCloseTimeout == nil {
+				m.TaskStartToCloseTimeout = &types.Duration{}
+			}
+			if err := m.TaskStartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuedExecutionRunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuedExecutionRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			m.Initiator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Initiator |= ContinueAsNewInitiator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuedFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContinuedFailure == nil {
+				m.ContinuedFailure = &Failure{}
+			}
+			if err := m.ContinuedFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCompletionResult == nil {
+				m.LastCompletionResult = &Payload{}
+			}
+			if err := m.LastCompletionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalExecutionRunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalExecutionRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstExecutionRunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstExecutionRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = &types.Timestamp{}
+			}
+			if err := m.ExpirationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronSchedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronSchedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstDecisionTaskBackoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FirstDecisionTaskBackoff == nil {
+				m.FirstDecisionTaskBackoff = &types.Duration{}
+			}
+			if err := m.FirstDecisionTaskBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memo == nil {
+				m.Memo = &Memo{}
+			}
+			if err := m.Memo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchAttributes == nil {
+				m.SearchAttributes = &SearchAttributes{}
+			}
+			if err := m.SearchAttributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevAutoResetPoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrevAutoResetPoints == nil {
+				m.PrevAutoResetPoints = &ResetPoints{}
+			}
+			if err := m.PrevAutoResetPoints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FirstScheduledTime == nil {
+				m.FirstScheduledTime = &types.Timestamp{}
+			}
+			if err := m.FirstScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHistory
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHistory
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthHistory
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthHistory
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHistory
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthHistory
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthHistory
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipHistory(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthHistory
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PartitionConfig[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionCompletedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionCompletedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionCompletedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &Payload{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionFailedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionFailedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionFailedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Failure == nil {
+				m.Failure = &Failure{}
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionTimedOutEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionTimedOutEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionTimedOutEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutType", wireType)
+			}
+			m.TimeoutType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutType |= TimeoutType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecisionTaskScheduledEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecisionTaskScheduledEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecisionTaskScheduledEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartToCloseTimeout == nil {
+				m.StartToCloseTimeout = &types.Duration{}
+			}
+			if err := m.StartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecisionTaskStartedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecisionTaskStartedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecisionTaskStartedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecisionTaskCompletedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecisionTaskCompletedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecisionTaskCompletedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryChecksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryChecksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionContext", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionContext = append(m.ExecutionContext[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExecutionContext == nil {
+				m.ExecutionContext = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecisionTaskTimedOutEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecisionTaskTimedOutEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecisionTaskTimedOutEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutType", wireType)
+			}
+			m.TimeoutType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutType |= TimeoutType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkEventVersion", wireType)
+			}
+			m.ForkEventVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForkEventVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			m.Cause = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cause |= DecisionTaskTimedOutCause(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecisionTaskFailedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecisionTaskFailedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecisionTaskFailedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			m.Cause = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cause |= DecisionTaskFailedCause(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Failure == nil {
+				m.Failure = &Failure{}
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkEventVersion", wireType)
+			}
+			m.ForkEventVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForkEventVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryChecksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryChecksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivityTaskScheduledEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivityTaskScheduledEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivityTaskScheduledEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivityType == nil {
+				m.ActivityType = &ActivityType{}
+			}
+			if err := m.ActivityType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Payload{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToCloseTimeout == nil {
+				m.ScheduleToCloseTimeout = &types.Duration{}
+			}
+			if err := m.ScheduleToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = &types.Duration{}
+			}
+			if err := m.ScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartToCloseTimeout == nil {
+				m.StartToCloseTimeout = &types.Duration{}
+			}
+			if err := m.StartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatTimeout == nil {
+				m.HeartbeatTimeout = &types.Duration{}
+			}
+			if err := m.HeartbeatTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivityTaskStartedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivityTaskStartedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivityTaskStartedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastFailure == nil {
+				m.LastFailure = &Failure{}
+			}
+			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivityTaskCompletedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivityTaskCompletedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivityTaskCompletedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &Payload{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivityTaskFailedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivityTaskFailedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivityTaskFailedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Failure == nil {
+				m.Failure = &Failure{}
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivityTaskTimedOutEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivityTaskTimedOutEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivityTaskTimedOutEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &Payload{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutType", wireType)
+			}
+			m.TimeoutType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutType |= TimeoutType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastFailure == nil {
+				m.LastFailure = &Failure{}
+			}
+			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivityTaskCancelRequestedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivityTaskCancelRequestedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivityTaskCancelRequestedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCancelActivityTaskFailedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCancelActivityTaskFailedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCancelActivityTaskFailedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cause = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivityTaskCanceledEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivityTaskCanceledEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivityTaskCanceledEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &Payload{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCancelRequestedEventId", wireType)
+			}
+			m.LatestCancelRequestedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestCancelRequestedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimerStartedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimerStartedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimerStartedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartToFireTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartToFireTimeout == nil {
+				m.StartToFireTimeout = &types.Duration{}
+			}
+			if err := m.StartToFireTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimerFiredEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimerFiredEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimerFiredEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimerCanceledEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimerCanceledEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimerCanceledEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelTimerFailedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelTimerFailedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelTimerFailedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cause = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionContinuedAsNewEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionContinuedAsNewEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionContinuedAsNewEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewExecutionRunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewExecutionRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Payload{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionStartToCloseTimeout == nil {
+				m.ExecutionStartToCloseTimeout = &types.Duration{}
+			}
+			if err := m.ExecutionStartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskStartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskStartToCloseTimeout == nil {
+				m.TaskStartToCloseTimeout = &types.Duration{}
+			}
+			if err := m.TaskStartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffStartInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BackoffStartInterval == nil {
+				m.BackoffStartInterval = &types.Duration{}
+			}
+			if err := m.BackoffStartInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			m.Initiator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Initiator |= ContinueAsNewInitiator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Failure == nil {
+				m.Failure = &Failure{}
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCompletionResult == nil {
+				m.LastCompletionResult = &Payload{}
+			}
+			if err := m.LastCompletionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memo == nil {
+				m.Memo = &Memo{}
+			}
+			if err := m.Memo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchAttributes == nil {
+				m.SearchAttributes = &SearchAttributes{}
+			}
+			if err := m.SearchAttributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionCancelRequestedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionCancelRequestedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionCancelRequestedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cause = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalExecutionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalExecutionInfo == nil {
+				m.ExternalExecutionInfo = &ExternalExecutionInfo{}
+			}
+			if err := m.ExternalExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionCanceledEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionCanceledEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionCanceledEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &Payload{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkerRecordedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkerRecordedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkerRecordedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &Payload{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionSignaledEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionSignaledEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionSignaledEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Payload{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionTerminatedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionTerminatedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionTerminatedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &Payload{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionPausedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionPausedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionPausedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionUnpausedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionUnpausedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionUnpausedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCancelExternalWorkflowExecutionInitiatedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCancelExternalWorkflowExecutionInitiatedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Control", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Control = append(m.Control[:0], dAtA[iNdEx:postIndex]...)
+			if m.Control == nil {
+				m.Control = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChildWorkflowOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCancelExternalWorkflowExecutionFailedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCancelExternalWorkflowExecutionFailedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			m.Cause = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cause |= CancelExternalWorkflowExecutionFailedCause(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedEventId", wireType)
+			}
+			m.InitiatedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Control", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Control = append(m.Control[:0], dAtA[iNdEx:postIndex]...)
+			if m.Control == nil {
+				m.Control = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExternalWorkflowExecutionCancelRequestedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalWorkflowExecutionCancelRequestedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalWorkflowExecutionCancelRequestedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedEventId", wireType)
+			}
+			m.InitiatedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalExternalWorkflowExecutionInitiatedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalExternalWorkflowExecutionInitiatedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalExternalWorkflowExecutionInitiatedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Payload{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Control", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Control = append(m.Control[:0], dAtA[iNdEx:postIndex]...)
+			if m.Control == nil {
+				m.Control = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChildWorkflowOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalExternalWorkflowExecutionFailedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalExternalWorkflowExecutionFailedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalExternalWorkflowExecutionFailedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			m.Cause = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cause |= SignalExternalWorkflowExecutionFailedCause(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedEventId", wireType)
+			}
+			m.InitiatedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Control", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Control = append(m.Control[:0], dAtA[iNdEx:postIndex]...)
+			if m.Control == nil {
+				m.Control = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExternalWorkflowExecutionSignaledEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalWorkflowExecutionSignaledEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalWorkflowExecutionSignaledEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedEventId", wireType)
+			}
+			m.InitiatedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Control", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Control = append(m.Control[:0], dAtA[iNdEx:postIndex]...)
+			if m.Control == nil {
+				m.Control = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpsertWorkflowSearchAttributesEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpsertWorkflowSearchAttributesEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpsertWorkflowSearchAttributesEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchAttributes == nil {
+				m.SearchAttributes = &SearchAttributes{}
+			}
+			if err := m.SearchAttributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartChildWorkflowExecutionInitiatedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartChildWorkflowExecutionInitiatedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Payload{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionStartToCloseTimeout == nil {
+				m.ExecutionStartToCloseTimeout = &types.Duration{}
+			}
+			if err := m.ExecutionStartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskStartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskStartToCloseTimeout == nil {
+				m.TaskStartToCloseTimeout = &types.Duration{}
+			}
+			if err := m.TaskStartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentClosePolicy", wireType)
+			}
+			m.ParentClosePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentClosePolicy |= ParentClosePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Control", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Control = append(m.Control[:0], dAtA[iNdEx:postIndex]...)
+			if m.Control == nil {
+				m.Control = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowIdReusePolicy", wireType)
+			}
+			m.WorkflowIdReusePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowIdReusePolicy |= WorkflowIdReusePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronSchedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronSchedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memo == nil {
+				m.Memo = &Memo{}
+			}
+			if err := m.Memo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchAttributes == nil {
+				m.SearchAttributes = &SearchAttributes{}
+			}
+			if err := m.SearchAttributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelayStart == nil {
+				m.DelayStart = &types.Duration{}
+			}
+			if err := m.DelayStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JitterStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JitterStart == nil {
+				m.JitterStart = &types.Duration{}
+			}
+			if err := m.JitterStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartChildWorkflowExecutionFailedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartChildWorkflowExecutionFailedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartChildWorkflowExecutionFailedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			m.Cause = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cause |= ChildWorkflowExecutionFailedCause(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Control", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Control = append(m.Control[:0], dAtA[iNdEx:postIndex]...)
+			if m.Control == nil {
+				m.Control = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedEventId", wireType)
+			}
+			m.InitiatedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChildWorkflowExecutionStartedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChildWorkflowExecutionStartedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChildWorkflowExecutionStartedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedEventId", wireType)
+			}
+			m.InitiatedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChildWorkflowExecutionCompletedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChildWorkflowExecutionCompletedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChildWorkflowExecutionCompletedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedEventId", wireType)
+			}
+			m.InitiatedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &Payload{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChildWorkflowExecutionFailedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChildWorkflowExecutionFailedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChildWorkflowExecutionFailedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedEventId", wireType)
+			}
+			m.InitiatedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Failure == nil {
+				m.Failure = &Failure{}
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChildWorkflowExecutionCanceledEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChildWorkflowExecutionCanceledEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChildWorkflowExecutionCanceledEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedEventId", wireType)
+			}
+			m.InitiatedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &Payload{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChildWorkflowExecutionTimedOutEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChildWorkflowExecutionTimedOutEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChildWorkflowExecutionTimedOutEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedEventId", wireType)
+			}
+			m.InitiatedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutType", wireType)
+			}
+			m.TimeoutType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutType |= TimeoutType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChildWorkflowExecutionTerminatedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChildWorkflowExecutionTerminatedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChildWorkflowExecutionTerminatedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedEventId", wireType)
+			}
+			m.InitiatedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
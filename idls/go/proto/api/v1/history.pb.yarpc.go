@@ -0,0 +1,592 @@
+// The MIT License (MIT)
+// 
+// Copyright (c) 2021 Uber Technologies, Inc.
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/api/v1/history.proto
+
+package apiv1
+
+var yarpcFileDescriptorClosure8237ca6511ad6c62 = [][]byte{
+	// uber/cadence/api/v1/history.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0xc7,
+		0x91, 0xf6, 0xec, 0x92, 0x4b, 0x6d, 0x2d, 0x45, 0x91, 0x4d, 0x8a, 0x22, 0x25, 0x4a, 0xa2, 0x56,
+		0xb2, 0x44, 0x53, 0xe4, 0x52, 0xa2, 0x64, 0xc9, 0x92, 0xfc, 0x73, 0x22, 0x45, 0x42, 0x0b, 0xf0,
+		0x24, 0xde, 0x88, 0x92, 0xef, 0x0e, 0x06, 0xf6, 0x86, 0x3b, 0x4d, 0x71, 0x8e, 0xbb, 0x3b, 0xeb,
+		0x99, 0x5e, 0xae, 0x78, 0x77, 0x7e, 0xba, 0x87, 0x00, 0x41, 0x0c, 0xc7, 0x30, 0x02, 0xc4, 0x40,
+		0x8c, 0x04, 0x01, 0xf2, 0xe3, 0x24, 0x80, 0x83, 0x04, 0x41, 0xfe, 0x5e, 0x92, 0x00, 0x81, 0x03,
+		0x24, 0x70, 0xf2, 0x94, 0x97, 0xbc, 0x06, 0x41, 0xf2, 0x96, 0x87, 0x38, 0x6f, 0x01, 0x82, 0xe9,
+		0xe9, 0xd9, 0xdd, 0x99, 0xe9, 0x9e, 0xe9, 0xd9, 0xa5, 0xec, 0x04, 0xd6, 0x1b, 0xa7, 0xb7, 0xaa,
+		0xe6, 0xeb, 0xee, 0xaa, 0xae, 0xaa, 0xae, 0x1a, 0xc2, 0xa9, 0xc6, 0x26, 0xb6, 0x16, 0xca, 0x9a,
+		0x8e, 0x6b, 0x65, 0xbc, 0xa0, 0xd5, 0x8d, 0x85, 0xdd, 0x8b, 0x0b, 0xdb, 0x86, 0x4d, 0x4c, 0x6b,
+		0xaf, 0x50, 0xb7, 0x4c, 0x62, 0xa2, 0x51, 0x87, 0xa4, 0xc0, 0x48, 0x0a, 0x5a, 0xdd, 0x28, 0xec,
+		0x5e, 0x3c, 0x7a, 0xe2, 0xa1, 0x69, 0x3e, 0xac, 0xe0, 0x05, 0x4a, 0xb2, 0xd9, 0xd8, 0x5a, 0xd0,
+		0x1b, 0x96, 0x46, 0x0c, 0xb3, 0xe6, 0x32, 0x1d, 0x3d, 0x19, 0xfc, 0x9d, 0x18, 0x55, 0x6c, 0x13,
+		0xad, 0x5a, 0x67, 0x04, 0xd3, 0xbc, 0x17, 0x97, 0xcd, 0x6a, 0xb5, 0x25, 0x22, 0xcf, 0xa3, 0x20,
+		0x9a, 0xbd, 0x53, 0x31, 0x6c, 0x12, 0x45, 0xd3, 0x34, 0xad, 0x9d, 0xad, 0x8a, 0xd9, 0x74, 0x69,
+		0xf2, 0xb7, 0x60, 0xe0, 0xb6, 0x3b, 0x21, 0x74, 0x0d, 0x32, 0x78, 0x17, 0xd7, 0x88, 0x3d, 0xa1,
+		0x4c, 0xa7, 0x67, 0x72, 0x8b, 0xa7, 0x0a, 0x9c, 0xb9, 0x15, 0x18, 0xf5, 0x8a, 0x43, 0xa9, 0x32,
+		0x86, 0xfc, 0xd7, 0xae, 0xc3, 0x60, 0xe7, 0x0f, 0x68, 0x12, 0x0e, 0xd0, 0x9f, 0x4a, 0x86, 0x3e,
+		0xa1, 0x4c, 0x2b, 0x33, 0x69, 0x75, 0x80, 0x3e, 0x17, 0x75, 0x74, 0x0d, 0xc0, 0xfd, 0xc9, 0x99,
+		0xf4, 0x44, 0x6a, 0x5a, 0x99, 0xc9, 0x2d, 0x1e, 0x2d, 0xb8, 0x2b, 0x52, 0xf0, 0x56, 0xa4, 0xb0,
+		0xe1, 0xad, 0x88, 0x9a, 0xa5, 0xd4, 0xce, 0x33, 0x9a, 0x80, 0x81, 0x5d, 0x6c, 0xd9, 0x86, 0x59,
+		0x9b, 0x48, 0xbb, 0x42, 0xd9, 0x23, 0x3a, 0x02, 0x03, 0xce, 0xe4, 0x9d, 0xd7, 0xf5, 0xd1, 0x5f,
+		0x32, 0xce, 0x63, 0x51, 0x47, 0x5f, 0x50, 0xe0, 0xbc, 0x37, 0xe5, 0x12, 0x7e, 0x84, 0xcb, 0x0d,
+		0x67, 0x1f, 0x4a, 0x36, 0xd1, 0x2c, 0x82, 0xf5, 0x92, 0x8b, 0x44, 0x23, 0xc4, 0x32, 0x36, 0x1b,
+		0x04, 0xdb, 0x13, 0xfd, 0x14, 0xcf, 0xf3, 0xdc, 0xa9, 0xbf, 0xcc, 0xe4, 0xac, 0x78, 0x62, 0xee,
+		0xb9, 0x52, 0xe8, 0x94, 0x6f, 0xb6, 0x64, 0xdc, 0x7e, 0x4a, 0x3d, 0xd7, 0x94, 0x23, 0x45, 0x5f,
+		0x56, 0x60, 0x9e, 0x03, 0xaf, 0x6c, 0x56, 0xeb, 0x15, 0xcc, 0x05, 0x98, 0xa1, 0x00, 0x5f, 0x94,
+		0x03, 0xb8, 0xec, 0xc9, 0x09, 0x43, 0x7c, 0xa6, 0x29, 0x4b, 0x8c, 0xde, 0x56, 0x60, 0x96, 0x03,
+		0x72, 0x4b, 0x33, 0x2a, 0x3c, 0x84, 0x03, 0x14, 0xe1, 0x0d, 0x39, 0x84, 0xab, 0x54, 0x48, 0x18,
+		0xde, 0xd9, 0xa6, 0x14, 0x25, 0xfa, 0x12, 0x7f, 0x01, 0x1d, 0xdd, 0xd2, 0x4b, 0x66, 0x83, 0x84,
+		0xe1, 0x1d, 0xa0, 0xf0, 0x5e, 0x90, 0x83, 0xe7, 0xa8, 0x9d, 0x7e, 0xb7, 0x41, 0xc2, 0x00, 0x67,
+		0x9a, 0x92, 0xb4, 0xe8, 0x2d, 0x05, 0x66, 0x74, 0x5c, 0x36, 0x6c, 0x0a, 0xcc, 0xd1, 0x52, 0xbb,
+		0xbc, 0x8d, 0xf5, 0x06, 0x77, 0xf1, 0xb2, 0x14, 0xdd, 0x35, 0x2e, 0xba, 0x5b, 0x4c, 0xc8, 0x86,
+		0x66, 0xef, 0xdc, 0xf3, 0x44, 0x84, 0x91, 0x9d, 0xd1, 0x25, 0xe8, 0xd0, 0xeb, 0x0a, 0x9c, 0x0d,
+		0xa0, 0x12, 0xd9, 0x04, 0x50, 0x4c, 0x57, 0xe3, 0x31, 0x89, 0xcc, 0x21, 0xaf, 0xc7, 0x52, 0x71,
+		0x56, 0x29, 0xc2, 0x08, 0x72, 0x92, 0xab, 0x14, 0xa1, 0xff, 0x67, 0x74, 0x09, 0x3a, 0xf4, 0x66,
+		0x08, 0x55, 0x84, 0x66, 0x0d, 0x52, 0x54, 0xcf, 0xc5, 0xa2, 0x12, 0x2b, 0xd5, 0x69, 0x3d, 0x9e,
+		0x0c, 0x7d, 0x5a, 0x81, 0xa7, 0xfd, 0x98, 0x44, 0x96, 0x78, 0x90, 0x02, 0xba, 0x12, 0x0b, 0x48,
+		0x64, 0x84, 0xa7, 0xf4, 0x38, 0x22, 0xba, 0x6d, 0x5a, 0x99, 0x18, 0xbb, 0x06, 0xd9, 0x8b, 0x55,
+		0xee, 0xa1, 0x88, 0x6d, 0xbb, 0xc9, 0x84, 0xc4, 0x29, 0xb7, 0x26, 0x41, 0x47, 0x95, 0x3b, 0x80,
+		0x4a, 0xa4, 0xdc, 0x87, 0x22, 0x94, 0xdb, 0x87, 0x49, 0xa8, 0xdc, 0x5a, 0x2c, 0x15, 0x67, 0x95,
+		0x22, 0x94, 0x7b, 0x58, 0x72, 0x95, 0xa2, 0x94, 0x5b, 0x93, 0xa0, 0xa3, 0x8a, 0xe4, 0x47, 0x25,
+		0x52, 0xa4, 0x91, 0x08, 0x45, 0xea, 0x84, 0x24, 0x54, 0x24, 0x2d, 0x8e, 0x88, 0x5a, 0x9a, 0x1f,
+		0x4c, 0x84, 0xa5, 0xa1, 0x08, 0x4b, 0xeb, 0xc4, 0x13, 0x61, 0x69, 0x5a, 0x3c, 0x19, 0x6a, 0xc2,
+		0x09, 0x07, 0x84, 0x25, 0xd6, 0x9e, 0x51, 0x0a, 0xe4, 0x02, 0x17, 0x88, 0x23, 0xd5, 0x12, 0xaa,
+		0xcd, 0x31, 0x22, 0xfe, 0x19, 0xbd, 0x0a, 0x53, 0xee, 0x8b, 0xb7, 0x0c, 0x8b, 0xf7, 0xda, 0x31,
+		0xfa, 0xda, 0x82, 0xf8, 0xb5, 0xab, 0x86, 0x15, 0x92, 0x7a, 0xfb, 0x29, 0x75, 0x92, 0x88, 0x7e,
+		0x44, 0x5f, 0x55, 0x60, 0x21, 0xa0, 0xa2, 0x5a, 0xad, 0x8c, 0x2b, 0x25, 0x0b, 0xbf, 0xda, 0xc0,
+		0x36, 0x77, 0xf6, 0x87, 0x29, 0x8c, 0x97, 0xe2, 0x35, 0x95, 0x4a, 0x52, 0x3d, 0x41, 0x61, 0x5c,
+		0xb3, 0x9a, 0x34, 0x35, 0xfa, 0xae, 0x02, 0x97, 0x19, 0x26, 0x0f, 0xa2, 0x9c, 0x12, 0x8f, 0x53,
+		0xb4, 0xcb, 0x5c, 0xb4, 0xec, 0x6d, 0xee, 0xab, 0x65, 0x34, 0xba, 0x60, 0x25, 0xe2, 0x40, 0x9f,
+		0x55, 0xe0, 0x1c, 0x6f, 0x79, 0x79, 0x40, 0x8f, 0x48, 0x6a, 0xf7, 0x32, 0x93, 0x10, 0xa3, 0xdd,
+		0x02, 0x32, 0xf4, 0x3f, 0x70, 0xd2, 0x55, 0x32, 0x31, 0x92, 0x09, 0x8a, 0xe4, 0xa2, 0x58, 0xcf,
+		0xc4, 0x10, 0xa6, 0x48, 0xc4, 0xef, 0xe8, 0x53, 0x0a, 0x9c, 0x61, 0x9b, 0xc7, 0x14, 0x5d, 0xb0,
+		0x69, 0x93, 0x14, 0xc1, 0xb3, 0x5c, 0x04, 0xae, 0x70, 0x57, 0xdf, 0x05, 0xdb, 0x34, 0x5d, 0x8e,
+		0xa1, 0x41, 0xaf, 0xc1, 0x74, 0x55, 0xb3, 0x76, 0xb0, 0x55, 0xb2, 0x70, 0xd9, 0xb4, 0x74, 0x1e,
+		0x88, 0xa3, 0x14, 0xc4, 0x22, 0x17, 0xc4, 0xbf, 0x52, 0x66, 0x95, 0xf1, 0x86, 0x11, 0x1c, 0xaf,
+		0x46, 0x11, 0xa0, 0x2f, 0x2a, 0x30, 0xc7, 0xcb, 0x4f, 0x8c, 0x87, 0x35, 0x8d, 0xbb, 0x20, 0xc7,
+		0x92, 0x84, 0xaf, 0xf7, 0x98, 0x18, 0x99, 0xf0, 0x55, 0x40, 0x8b, 0xbe, 0xa2, 0x40, 0x81, 0x83,
+		0x90, 0x60, 0xab, 0x6a, 0xd4, 0x34, 0xee, 0xb9, 0x30, 0x15, 0x71, 0x2e, 0x84, 0x43, 0xec, 0x96,
+		0x20, 0xce, 0xb9, 0xd0, 0x94, 0xa6, 0x46, 0xdf, 0x53, 0xe0, 0x32, 0x2f, 0x95, 0x8a, 0x3d, 0xc5,
+		0x8e, 0x53, 0xb4, 0xb7, 0x24, 0x33, 0xaa, 0xb8, 0xa3, 0x6c, 0xa1, 0x99, 0x8c, 0x45, 0xa4, 0x01,
+		0x62, 0xa3, 0x3c, 0x91, 0x44, 0x03, 0xc4, 0x06, 0x3a, 0xd3, 0x94, 0xa4, 0x45, 0xbf, 0x57, 0x60,
+		0x25, 0x70, 0xe2, 0xe2, 0x47, 0x04, 0x5b, 0x35, 0xad, 0x52, 0xe2, 0x20, 0x37, 0x6a, 0x06, 0x31,
+		0xf8, 0x8a, 0x71, 0x92, 0x42, 0xbf, 0x17, 0x7f, 0x04, 0xaf, 0x30, 0xf9, 0xa1, 0xf9, 0x14, 0x3d,
+		0xe1, 0xe1, 0x09, 0xbd, 0x68, 0xf5, 0x24, 0x01, 0xfd, 0x4e, 0x81, 0xa5, 0x04, 0xd3, 0x14, 0x9d,
+		0x58, 0xd3, 0x74, 0x8e, 0xeb, 0x3d, 0xcc, 0x51, 0x74, 0x98, 0xdd, 0xb0, 0xba, 0x67, 0x47, 0x1f,
+		0x28, 0xf0, 0x42, 0xd4, 0x74, 0xe2, 0xed, 0xe4, 0x14, 0x9d, 0xd8, 0x1a, 0x77, 0x62, 0x42, 0x30,
+		0xb1, 0xf6, 0x72, 0x15, 0x77, 0xc7, 0x4a, 0xe3, 0x00, 0xde, 0x3c, 0xcc, 0x1a, 0x31, 0x6a, 0x0d,
+		0xac, 0x97, 0x34, 0xbb, 0x54, 0xc3, 0xcd, 0xf0, 0x3c, 0xf2, 0x11, 0x71, 0x40, 0x18, 0x84, 0x27,
+		0xee, 0xa6, 0x7d, 0x07, 0x37, 0xc3, 0xf0, 0x0b, 0xcd, 0x44, 0x1c, 0xe8, 0x67, 0x0a, 0x5c, 0xa3,
+		0xd1, 0x64, 0xa9, 0xbc, 0x6d, 0x54, 0xf4, 0x84, 0xf6, 0x73, 0x9a, 0x42, 0xbf, 0xcd, 0x85, 0x4e,
+		0x43, 0xc9, 0x65, 0x47, 0x68, 0x12, 0xa3, 0xb9, 0x64, 0x27, 0x67, 0x43, 0x3f, 0x54, 0xe0, 0x4a,
+		0xcc, 0x24, 0x44, 0xd6, 0x71, 0x86, 0xce, 0x60, 0x25, 0xe9, 0x0c, 0x44, 0x26, 0x71, 0xc1, 0x4e,
+		0xc8, 0x83, 0xbe, 0xa5, 0xc0, 0x45, 0x21, 0x6a, 0x61, 0x9c, 0xff, 0x34, 0x85, 0x7d, 0x93, 0x1f,
+		0x86, 0x70, 0xdf, 0x2e, 0x0c, 0xfc, 0xe7, 0xca, 0x09, 0xe8, 0xd1, 0x77, 0x14, 0xb8, 0x24, 0x84,
+		0x1b, 0x91, 0x44, 0x9e, 0x8d, 0x50, 0x72, 0x3e, 0xe0, 0x88, 0x74, 0xb2, 0x50, 0x4e, 0xc4, 0x81,
+		0xde, 0x55, 0xe0, 0x42, 0x62, 0xcd, 0x38, 0x47, 0x11, 0xff, 0x4b, 0x02, 0xc4, 0x22, 0xa5, 0x38,
+		0x5f, 0x4e, 0xa0, 0x0f, 0xef, 0x29, 0xb0, 0x28, 0x5e, 0x60, 0xa1, 0x13, 0x9e, 0xa1, 0x68, 0x97,
+		0x92, 0xac, 0xaf, 0xd0, 0x13, 0xcf, 0x97, 0x93, 0x30, 0xa0, 0x6f, 0x47, 0xa9, 0x44, 0x44, 0xd2,
+		0xfc, 0x4c, 0x62, 0xc8, 0xe2, 0xf4, 0x79, 0xbe, 0x9c, 0x84, 0x81, 0xc6, 0x66, 0x62, 0xc8, 0x11,
+		0x91, 0xe4, 0x6c, 0x44, 0x6c, 0x26, 0xc0, 0x1c, 0x11, 0x4e, 0x2e, 0x94, 0x93, 0xb1, 0x50, 0xa7,
+		0xe9, 0x86, 0xe2, 0xdd, 0x46, 0x3c, 0xe7, 0x23, 0x9c, 0xa6, 0x1b, 0x71, 0x77, 0x13, 0xea, 0x5c,
+		0xb5, 0xbb, 0x63, 0x45, 0x3f, 0x57, 0xe0, 0xba, 0xc4, 0x84, 0x44, 0x36, 0x3a, 0x47, 0x67, 0x53,
+		0xec, 0x66, 0x36, 0x22, 0x63, 0xbd, 0x6c, 0x77, 0xc1, 0x87, 0x7e, 0xa0, 0xc0, 0xb3, 0x51, 0x13,
+		0x10, 0xe7, 0x4f, 0xf3, 0x11, 0x0e, 0x48, 0x08, 0x42, 0x9c, 0x47, 0x5d, 0xc0, 0x09, 0x79, 0xe8,
+		0x81, 0xd3, 0xa8, 0xdb, 0xd8, 0x22, 0x6d, 0xe0, 0x36, 0xd6, 0xac, 0xf2, 0x76, 0x07, 0xcc, 0x30,
+		0xee, 0x42, 0x84, 0xf5, 0xde, 0xa7, 0xe2, 0x3c, 0x04, 0xf7, 0xa8, 0xb0, 0xf6, 0x1b, 0x39, 0xd6,
+		0xdb, 0x48, 0xc2, 0x20, 0xaa, 0xff, 0xd4, 0xb5, 0x86, 0xcd, 0x5b, 0xe1, 0x85, 0x24, 0xf5, 0x9f,
+		0x75, 0x2a, 0x44, 0xa6, 0xfe, 0xc3, 0xa5, 0x14, 0x65, 0x4f, 0x8d, 0x9a, 0x08, 0xdd, 0x85, 0x24,
+		0xd9, 0xd3, 0xfd, 0x5a, 0x9d, 0xf7, 0x56, 0x6e, 0xf6, 0x24, 0xa0, 0x5d, 0x1a, 0x04, 0x68, 0xbf,
+		0x3e, 0xff, 0xc6, 0x10, 0x9c, 0x93, 0xf5, 0xfd, 0xab, 0x70, 0xb0, 0x35, 0x35, 0xb2, 0x57, 0xc7,
+		0xb4, 0x92, 0x2a, 0xaa, 0xcb, 0x7a, 0x42, 0x37, 0xf6, 0xea, 0x58, 0x1d, 0x6c, 0x76, 0x3c, 0xa1,
+		0x57, 0xe0, 0x70, 0x5d, 0xb3, 0x9c, 0x75, 0xe8, 0x3c, 0xb2, 0xb6, 0x4c, 0x56, 0x7c, 0x9d, 0xe1,
+		0xca, 0x5b, 0xa7, 0x1c, 0x1d, 0x27, 0xca, 0x96, 0xa9, 0x8e, 0xd6, 0xc3, 0x83, 0xe8, 0x3a, 0x64,
+		0xe9, 0x7d, 0x56, 0xc5, 0xb0, 0x09, 0x2d, 0xcb, 0xe6, 0x16, 0x8f, 0xf3, 0x2f, 0x8c, 0x34, 0x7b,
+		0x67, 0xcd, 0xb0, 0x89, 0x7a, 0x80, 0xb0, 0xbf, 0xd0, 0x22, 0xf4, 0x1b, 0xb5, 0x7a, 0x83, 0xd0,
+		0xa2, 0x6d, 0x6e, 0x71, 0x4a, 0x80, 0x64, 0xaf, 0x62, 0x6a, 0xba, 0xea, 0x92, 0x22, 0x0d, 0xa6,
+		0x03, 0x01, 0x5b, 0x89, 0x98, 0xa5, 0x72, 0xc5, 0xb4, 0x31, 0xf5, 0x7e, 0x66, 0x83, 0xb0, 0x2a,
+		0xee, 0x64, 0xa8, 0xaa, 0x7c, 0x8b, 0xd5, 0xe1, 0xd5, 0x29, 0xec, 0x5b, 0xfb, 0x0d, 0x73, 0xd9,
+		0xe1, 0xdf, 0x70, 0xd9, 0xd1, 0xcb, 0x70, 0xac, 0x5d, 0x34, 0x08, 0x4b, 0xcf, 0xc4, 0x49, 0x3f,
+		0x42, 0xbc, 0x52, 0x40, 0x40, 0xf0, 0x0d, 0x38, 0xda, 0xce, 0x4f, 0xda, 0xb3, 0xb0, 0x1a, 0x35,
+		0xa7, 0x72, 0xed, 0x14, 0x4e, 0xb3, 0xea, 0x91, 0x16, 0x45, 0x6b, 0x9d, 0xd5, 0x46, 0xad, 0xa8,
+		0xa3, 0x22, 0x64, 0x99, 0xa3, 0x31, 0x2d, 0x5a, 0xc5, 0x1c, 0x5a, 0x3c, 0xcf, 0x77, 0x8c, 0x4c,
+		0x00, 0x4d, 0x40, 0x8a, 0x1e, 0x8b, 0xda, 0xe6, 0x46, 0x45, 0x18, 0x69, 0xe3, 0x70, 0x0e, 0xfb,
+		0x86, 0x85, 0x27, 0xb2, 0x11, 0x7b, 0xb0, 0xea, 0xd2, 0xa8, 0xc3, 0x2d, 0x36, 0x36, 0x82, 0x54,
+		0x18, 0xaf, 0x68, 0x4e, 0xc6, 0xec, 0x06, 0x83, 0x74, 0x3a, 0xd8, 0x6e, 0x54, 0xc8, 0x04, 0x44,
+		0xc8, 0xf3, 0xf6, 0x74, 0xcc, 0xe1, 0x5d, 0x6e, 0xb1, 0xaa, 0x94, 0x13, 0x5d, 0x83, 0x49, 0xd3,
+		0x32, 0x1e, 0x1a, 0xae, 0x9b, 0x0a, 0xac, 0x52, 0x8e, 0xae, 0xd2, 0xb8, 0x47, 0x10, 0x58, 0xa4,
+		0xa3, 0x70, 0xc0, 0xd0, 0x71, 0x8d, 0x18, 0x64, 0x8f, 0xd6, 0xe3, 0xb2, 0x6a, 0xeb, 0x19, 0x5d,
+		0x82, 0xf1, 0x2d, 0xc3, 0xb2, 0x49, 0x58, 0xe6, 0x41, 0x4a, 0x39, 0x4a, 0x7f, 0x0d, 0x08, 0x5c,
+		0x86, 0x41, 0x0b, 0x13, 0x6b, 0xaf, 0x54, 0x37, 0x2b, 0x46, 0x79, 0x8f, 0xd5, 0xb0, 0xa6, 0x05,
+		0xe9, 0x3d, 0xb1, 0xf6, 0xd6, 0x29, 0x9d, 0x9a, 0xb3, 0xda, 0x0f, 0x4e, 0xe3, 0x82, 0x46, 0x08,
+		0xae, 0xd6, 0x09, 0xad, 0x37, 0xf5, 0xab, 0xde, 0x23, 0x5a, 0x86, 0x43, 0xf8, 0x51, 0xdd, 0x70,
+		0x15, 0xc7, 0x6d, 0x89, 0x18, 0x8e, 0x6d, 0x89, 0x18, 0x6a, 0xb3, 0x38, 0x83, 0xe8, 0x34, 0x1c,
+		0x2c, 0x5b, 0x8e, 0x35, 0xb0, 0x7a, 0x18, 0xad, 0xd7, 0x64, 0xd5, 0x41, 0x67, 0xd0, 0xab, 0x91,
+		0xa1, 0x7f, 0x87, 0x63, 0xee, 0xec, 0xfd, 0xb5, 0xc3, 0x4d, 0xad, 0xbc, 0x63, 0x6e, 0x6d, 0x4d,
+		0xa0, 0x38, 0xa5, 0x9e, 0xa0, 0xdc, 0x9d, 0x65, 0xc3, 0x25, 0x97, 0x15, 0xcd, 0x43, 0x5f, 0x15,
+		0x57, 0x4d, 0x56, 0x0c, 0x99, 0xe4, 0x5f, 0x93, 0xe2, 0xaa, 0xa9, 0x52, 0x32, 0xa4, 0xc2, 0x48,
+		0xc8, 0xdf, 0xb1, 0x8a, 0xc6, 0xd3, 0xfc, 0xc8, 0x22, 0xe0, 0x9f, 0xd4, 0x61, 0x3b, 0x30, 0x82,
+		0xee, 0xc3, 0x78, 0xdd, 0xc2, 0xbb, 0x25, 0xad, 0x41, 0x4c, 0x47, 0xff, 0x30, 0x29, 0xd5, 0x4d,
+		0xa3, 0x46, 0xbc, 0x1a, 0x85, 0x68, 0xbf, 0x6c, 0x4c, 0xd6, 0x29, 0x9d, 0x3a, 0xea, 0xf0, 0xdf,
+		0x6c, 0x10, 0xb3, 0x63, 0x10, 0x5d, 0x82, 0xcc, 0x36, 0xd6, 0x74, 0x6c, 0xb1, 0xe2, 0xc1, 0x31,
+		0x7e, 0x4b, 0x0c, 0x25, 0x51, 0x19, 0x29, 0x5a, 0x83, 0x31, 0x77, 0xa1, 0xdb, 0x95, 0x50, 0xba,
+		0xaf, 0x47, 0x62, 0xf7, 0x15, 0x51, 0xbe, 0x56, 0x55, 0x93, 0xee, 0xed, 0xff, 0xc1, 0x70, 0x5d,
+		0xb3, 0x88, 0xe1, 0x5d, 0x6e, 0x6c, 0x19, 0x0f, 0x27, 0x26, 0x68, 0x7f, 0xce, 0xbf, 0xf5, 0xd2,
+		0xa4, 0x52, 0x58, 0xf7, 0x84, 0x2e, 0x53, 0x99, 0x2b, 0x35, 0x62, 0xed, 0xa9, 0x87, 0xea, 0xfe,
+		0x51, 0x74, 0x1c, 0xc0, 0xbb, 0x12, 0x33, 0x74, 0x7a, 0x19, 0x9f, 0x55, 0xb3, 0x6c, 0xa4, 0xa8,
+		0x1f, 0x5d, 0x82, 0x31, 0x9e, 0x1c, 0x34, 0x0c, 0xe9, 0x1d, 0xbc, 0x47, 0xfd, 0x55, 0x56, 0x75,
+		0xfe, 0x44, 0x63, 0xd0, 0xbf, 0xab, 0x55, 0x1a, 0x6e, 0xc3, 0x4f, 0x56, 0x75, 0x1f, 0xae, 0xa7,
+		0x9e, 0x53, 0xf2, 0xef, 0x2a, 0xf0, 0x8c, 0x7c, 0x6a, 0x79, 0x19, 0x32, 0xec, 0x78, 0x51, 0x24,
+		0x8e, 0x17, 0x46, 0x8b, 0x56, 0x61, 0x3a, 0xba, 0xb7, 0xc0, 0xd0, 0x29, 0xb0, 0xb4, 0x3a, 0x25,
+		0x6e, 0x0b, 0x28, 0xea, 0xf9, 0xaf, 0x2b, 0x70, 0x56, 0x32, 0x42, 0xbd, 0x02, 0x03, 0xde, 0xc1,
+		0xaa, 0x48, 0x1c, 0xac, 0x1e, 0xf1, 0xbe, 0x41, 0x35, 0x61, 0x46, 0x3a, 0x3d, 0x5b, 0x86, 0x41,
+		0xe6, 0xdb, 0xda, 0x71, 0xc6, 0x90, 0xc0, 0x66, 0x98, 0x2b, 0xa3, 0x61, 0x46, 0x8e, 0xb4, 0x1f,
+		0xf2, 0xbf, 0x52, 0xe0, 0x8c, 0x4c, 0x87, 0x8a, 0x3f, 0x60, 0x50, 0x92, 0x05, 0x0c, 0x77, 0x60,
+		0x5c, 0xe0, 0x94, 0x53, 0x71, 0xe7, 0xd7, 0xa8, 0xcd, 0x71, 0xc8, 0x1d, 0x07, 0x73, 0xda, 0x77,
+		0x30, 0xe7, 0x5f, 0x57, 0x20, 0x1f, 0xdf, 0xdc, 0x82, 0xe6, 0x00, 0x05, 0x1b, 0x1e, 0x5a, 0x2d,
+		0x6f, 0xc3, 0xb6, 0x6f, 0x09, 0x02, 0xde, 0x29, 0x15, 0xf0, 0x4e, 0x7e, 0x53, 0x4b, 0x07, 0x4c,
+		0x2d, 0xff, 0xe7, 0xc0, 0xf2, 0x0a, 0x2d, 0x24, 0x19, 0xa2, 0x19, 0x18, 0xf6, 0x5f, 0x7a, 0xb5,
+		0xd4, 0x6b, 0xc8, 0xee, 0x98, 0x71, 0x00, 0x7b, 0x3a, 0x80, 0xfd, 0x1c, 0x1c, 0xda, 0x34, 0x6a,
+		0x9a, 0xb5, 0x57, 0x2a, 0x6f, 0xe3, 0xf2, 0x8e, 0xdd, 0xa8, 0xd2, 0x88, 0x2e, 0xab, 0x0e, 0xb9,
+		0xc3, 0xcb, 0x6c, 0x14, 0x9d, 0x87, 0x11, 0xff, 0x55, 0x2d, 0x7e, 0xe4, 0x46, 0x6b, 0x83, 0xea,
+		0x30, 0xee, 0xbc, 0x41, 0xc5, 0x8f, 0x48, 0xfe, 0x9b, 0x69, 0x38, 0x2d, 0xd1, 0x37, 0xf3, 0xd8,
+		0x66, 0x1c, 0x34, 0x8b, 0x74, 0x17, 0x66, 0x81, 0x4e, 0x40, 0x6e, 0x53, 0xb3, 0xb1, 0x17, 0x69,
+		0xb8, 0xcb, 0x92, 0x75, 0x86, 0xdc, 0xf8, 0x62, 0x0a, 0xc0, 0xb9, 0xa5, 0x66, 0x3f, 0xf7, 0xbb,
+		0x0b, 0x5b, 0xc3, 0x4d, 0xf7, 0xd7, 0x39, 0x40, 0x5b, 0xa6, 0xb5, 0xc3, 0x90, 0x7a, 0xcd, 0x8f,
+		0x19, 0x77, 0x6a, 0xce, 0x2f, 0x14, 0xeb, 0x03, 0x77, 0x1c, 0x8d, 0x3b, 0x87, 0xa3, 0x66, 0x9b,
+		0x35, 0x16, 0x4a, 0xb2, 0x27, 0x74, 0x0b, 0xfa, 0xcb, 0x4e, 0x6e, 0xc2, 0xa2, 0xc6, 0x82, 0x74,
+		0x87, 0xd2, 0xb2, 0xc3, 0xa5, 0xba, 0xcc, 0x01, 0x05, 0xcd, 0x06, 0x15, 0xf4, 0xfd, 0x34, 0x9c,
+		0x8a, 0x6d, 0x2a, 0x7a, 0x6c, 0x7b, 0xb5, 0xe4, 0x4d, 0xd1, 0xdd, 0xa4, 0x39, 0xc9, 0x9e, 0x27,
+		0xdf, 0x04, 0x3b, 0x8e, 0xec, 0xbe, 0x24, 0x47, 0x76, 0xa7, 0x65, 0xf4, 0x07, 0x2c, 0x23, 0xb0,
+		0xfd, 0x99, 0xe8, 0xed, 0x1f, 0x90, 0xda, 0xfe, 0x03, 0x82, 0xed, 0xe7, 0x58, 0x61, 0x96, 0x6b,
+		0x85, 0xfe, 0x9d, 0x84, 0xe0, 0x4e, 0xbe, 0x93, 0x81, 0x33, 0x32, 0xed, 0x58, 0xe8, 0x24, 0xe4,
+		0x5a, 0x3d, 0x0d, 0x6c, 0x17, 0xb3, 0x2a, 0x78, 0x43, 0x45, 0xdd, 0xc9, 0x60, 0x5b, 0x04, 0xd4,
+		0x84, 0x52, 0x11, 0x19, 0x6c, 0xeb, 0x95, 0x34, 0x83, 0xd5, 0x3a, 0x9e, 0x1c, 0xc5, 0xd6, 0xcd,
+		0xaa, 0x66, 0xd4, 0xd8, 0xc9, 0xc3, 0x9e, 0xfc, 0xae, 0xa4, 0xaf, 0xcb, 0xdc, 0x33, 0x23, 0x9f,
+		0x7b, 0x6e, 0xc0, 0xa4, 0xa7, 0xa3, 0x61, 0x0f, 0x34, 0x10, 0xe7, 0x81, 0xc6, 0x3d, 0xde, 0x80,
+		0x13, 0x0a, 0x48, 0x65, 0x0e, 0x8e, 0x49, 0x3d, 0x90, 0x40, 0xaa, 0x9b, 0x72, 0x32, 0xa9, 0x62,
+		0x57, 0x99, 0xed, 0xca, 0x55, 0xae, 0xc2, 0xc8, 0x36, 0xd6, 0x2c, 0xb2, 0x89, 0xb5, 0x36, 0x3a,
+		0x88, 0x13, 0x35, 0xdc, 0xe2, 0x69, 0xcb, 0x89, 0x0f, 0x70, 0x72, 0xf1, 0x01, 0x4e, 0x28, 0x31,
+		0x1b, 0xec, 0x26, 0x31, 0x6b, 0x07, 0xf8, 0x07, 0xa5, 0x03, 0xfc, 0xfc, 0x1f, 0x15, 0xc8, 0xc7,
+		0xb7, 0x06, 0x7e, 0x64, 0xa1, 0x41, 0x67, 0x10, 0xd3, 0xe7, 0xcf, 0x2e, 0x5f, 0x82, 0x41, 0x9a,
+		0x9c, 0x7b, 0xc7, 0x5a, 0xbf, 0xc4, 0xb1, 0x96, 0x73, 0x38, 0xd8, 0x43, 0xfe, 0x37, 0x8a, 0xff,
+		0x28, 0xd8, 0xe7, 0xb8, 0x9c, 0xbf, 0x44, 0xa9, 0x04, 0xde, 0x20, 0x1d, 0x1b, 0xab, 0xf4, 0xf9,
+		0x17, 0x33, 0xff, 0x6b, 0x05, 0x4e, 0xc5, 0xf7, 0x6b, 0x75, 0x1b, 0xbe, 0x7f, 0x1c, 0x33, 0xfa,
+		0x71, 0x0a, 0x4e, 0x4b, 0x74, 0x3d, 0x3a, 0x73, 0xd2, 0x31, 0xd1, 0x8c, 0x8a, 0x2d, 0xb5, 0x49,
+		0x1e, 0xf1, 0x63, 0x9b, 0x53, 0x30, 0xbe, 0xea, 0xeb, 0x26, 0xbe, 0xea, 0x59, 0xc5, 0x3f, 0xa7,
+		0xc0, 0xac, 0x7c, 0xb3, 0xa2, 0x8c, 0xcf, 0xdb, 0x9f, 0x04, 0xee, 0x3d, 0x05, 0x12, 0xb6, 0x25,
+		0xc6, 0x63, 0x1b, 0xf3, 0xa2, 0x24, 0x96, 0x85, 0xd3, 0x07, 0x29, 0xc4, 0x69, 0x09, 0xc4, 0x6f,
+		0x07, 0xf4, 0x50, 0x54, 0xc0, 0xec, 0x56, 0x0f, 0x57, 0x61, 0xba, 0xa2, 0x91, 0x8e, 0xf6, 0x9c,
+		0x60, 0xb3, 0x4a, 0x7b, 0x65, 0x5d, 0x3a, 0xde, 0x56, 0xba, 0x51, 0x15, 0x47, 0x9f, 0xd3, 0x09,
+		0xf4, 0xb9, 0x2f, 0xd6, 0x46, 0x03, 0x71, 0x60, 0xfe, 0x03, 0x05, 0x8e, 0x45, 0x34, 0x04, 0x3b,
+		0x1f, 0x4c, 0xb9, 0x8d, 0x90, 0xad, 0x7d, 0x1b, 0xa0, 0xcf, 0x45, 0x1d, 0xad, 0xc1, 0xe1, 0x96,
+		0x23, 0xdf, 0x32, 0xac, 0x04, 0x29, 0x2f, 0x62, 0x7e, 0xdc, 0x69, 0xf8, 0x4d, 0xe2, 0x7e, 0x65,
+		0x36, 0xfb, 0xbf, 0x60, 0x52, 0xd8, 0x69, 0x1c, 0x35, 0x1b, 0xe9, 0x90, 0x3e, 0xff, 0xbe, 0x02,
+		0x53, 0x51, 0x4d, 0xa6, 0xfb, 0xf2, 0x96, 0xfd, 0x5a, 0x8f, 0xc8, 0x03, 0xfa, 0xfb, 0x0a, 0x4c,
+		0xc7, 0x35, 0xab, 0x46, 0xcd, 0xe6, 0xb1, 0x9a, 0x6d, 0x24, 0xf2, 0xbf, 0x0d, 0x40, 0xc2, 0x9e,
+		0x28, 0xb4, 0x00, 0x63, 0xb4, 0xed, 0x2a, 0x78, 0xc7, 0xee, 0xce, 0x69, 0xa4, 0x86, 0x9b, 0x81,
+		0x1b, 0xf6, 0x50, 0x99, 0x2b, 0xd5, 0x5d, 0x99, 0xeb, 0x49, 0x21, 0x4a, 0xbe, 0x10, 0x25, 0xa3,
+		0x3b, 0x03, 0x12, 0xba, 0x73, 0x17, 0xc6, 0x59, 0x01, 0x81, 0x61, 0x34, 0x6a, 0x04, 0x5b, 0xbb,
+		0x5a, 0x25, 0x3e, 0x6f, 0x19, 0x63, 0x8c, 0x14, 0x5e, 0x91, 0xb1, 0xf9, 0x8b, 0x5c, 0xd9, 0x9e,
+		0x8a, 0x5c, 0x1d, 0x21, 0x1c, 0x24, 0x09, 0xe1, 0xc4, 0x15, 0xad, 0x5c, 0xd7, 0x15, 0xad, 0x76,
+		0x9e, 0x31, 0x28, 0x5f, 0x48, 0xf0, 0xea, 0x2a, 0x07, 0x7b, 0xa8, 0xab, 0x0c, 0xf5, 0x54, 0x57,
+		0xc9, 0xff, 0x41, 0x81, 0x85, 0xa4, 0x8d, 0x99, 0xad, 0xd3, 0x4a, 0xe9, 0x3c, 0xad, 0xa2, 0xf2,
+		0x9b, 0x4d, 0x38, 0xd2, 0x6a, 0xe6, 0x08, 0x94, 0xa8, 0x5d, 0x3b, 0x9e, 0x8d, 0x6c, 0xd7, 0xf0,
+		0x17, 0xa9, 0x0f, 0x63, 0xde, 0x70, 0x20, 0x87, 0xea, 0x0b, 0xde, 0x79, 0x7c, 0x43, 0x81, 0x19,
+		0xc1, 0x44, 0x79, 0x85, 0xf9, 0x78, 0xeb, 0x51, 0x24, 0xac, 0xa7, 0x23, 0x10, 0x4a, 0x25, 0x08,
+		0x84, 0xf2, 0x1f, 0x2a, 0x70, 0x3c, 0xf2, 0xbb, 0x03, 0x27, 0x12, 0x64, 0x5f, 0x35, 0xd4, 0xb4,
+		0xaa, 0xb7, 0x13, 0xe0, 0x0e, 0xdd, 0xd1, 0xaa, 0xb8, 0xdb, 0x57, 0xef, 0x9b, 0xd3, 0x69, 0x1b,
+		0x44, 0x9f, 0x7c, 0xe2, 0xfd, 0x23, 0xde, 0x26, 0x89, 0xfa, 0x6c, 0x4e, 0x42, 0x8e, 0x75, 0x3a,
+		0x75, 0x2e, 0x81, 0x3b, 0x44, 0x97, 0xa0, 0x75, 0xe6, 0xa7, 0xe4, 0xcf, 0xfc, 0xa8, 0x4b, 0xf0,
+		0x18, 0x0d, 0xfb, 0xbc, 0x02, 0xb3, 0x09, 0x5a, 0xcf, 0xda, 0x77, 0xb9, 0x8a, 0xef, 0x2e, 0xb7,
+		0xdb, 0x8d, 0x8b, 0x40, 0x9e, 0xff, 0x5f, 0x4e, 0x55, 0x8b, 0xdf, 0x6e, 0x23, 0x42, 0xd5, 0x43,
+		0x61, 0xe3, 0x35, 0xce, 0x9e, 0x0a, 0x7a, 0x69, 0x1e, 0xc7, 0xeb, 0x7f, 0x9a, 0x82, 0x17, 0x7b,
+		0xfb, 0xf4, 0x60, 0xdf, 0x8e, 0x83, 0xf6, 0x2d, 0x67, 0xca, 0x77, 0xcb, 0x79, 0x1f, 0x50, 0xb8,
+		0xc5, 0x89, 0x9d, 0x8c, 0x67, 0xe5, 0x8a, 0xc0, 0xea, 0x48, 0xa8, 0x5f, 0xc9, 0xb9, 0x36, 0x2a,
+		0x9b, 0x35, 0x62, 0x99, 0x15, 0xaa, 0xac, 0x83, 0xaa, 0xf7, 0x88, 0x0a, 0x30, 0x1a, 0xe8, 0xd6,
+		0x34, 0x6b, 0x15, 0x37, 0xa7, 0x39, 0xa0, 0x8e, 0xf8, 0x9a, 0x28, 0xef, 0xd6, 0x2a, 0x7b, 0xf9,
+		0xb7, 0xd2, 0x70, 0xa3, 0x87, 0x4f, 0x1b, 0xd0, 0xfd, 0x4e, 0x8f, 0x31, 0x24, 0xf8, 0x70, 0x48,
+		0x4a, 0xb2, 0xef, 0x3e, 0x7f, 0x9f, 0x32, 0x71, 0xe1, 0xed, 0x33, 0x7f, 0x5f, 0xfa, 0x7a, 0xdd,
+		0x97, 0x39, 0x40, 0xc1, 0x86, 0x52, 0x56, 0x19, 0x4a, 0xab, 0xc3, 0x86, 0x4f, 0x09, 0xdd, 0xcb,
+		0x3f, 0x6f, 0x17, 0x33, 0xbe, 0x5d, 0xcc, 0xff, 0x56, 0x81, 0xab, 0x5d, 0x7e, 0x97, 0x21, 0xc0,
+		0xa0, 0x08, 0x30, 0x7c, 0xb4, 0x8a, 0x9b, 0x7f, 0x23, 0x0d, 0x57, 0xbb, 0xec, 0x9d, 0xfd, 0x67,
+		0xb5, 0xd5, 0x80, 0x33, 0xeb, 0x13, 0x3b, 0xb3, 0x7e, 0x79, 0x67, 0x26, 0x54, 0x1d, 0xd1, 0x01,
+		0x30, 0x20, 0x3a, 0x00, 0x3e, 0x93, 0x86, 0xcb, 0xdd, 0xf4, 0xff, 0xca, 0x59, 0xbe, 0x94, 0xe4,
+		0x27, 0x96, 0xdf, 0xb6, 0xfc, 0x3f, 0x29, 0x70, 0x21, 0x69, 0x2f, 0xf3, 0x3f, 0xb4, 0xc9, 0x8b,
+		0x7d, 0x55, 0xfe, 0x97, 0x0a, 0xcc, 0x27, 0xea, 0x7f, 0xde, 0xb7, 0x23, 0x80, 0x9b, 0x6f, 0xa5,
+		0x7a, 0xcb, 0xb7, 0xde, 0xc9, 0xc2, 0xa5, 0x2e, 0x3e, 0xe4, 0xea, 0xd8, 0x0e, 0xc5, 0xb7, 0x1d,
+		0x27, 0x21, 0xd7, 0xda, 0x0e, 0xa6, 0xf3, 0x59, 0x15, 0xbc, 0x21, 0xde, 0xe5, 0x4b, 0x7a, 0x1f,
+		0x2e, 0x5f, 0xba, 0xad, 0xc4, 0xf6, 0xef, 0xef, 0xe5, 0x4b, 0xe6, 0xb1, 0x5e, 0xbe, 0x0c, 0x74,
+		0x7d, 0xf9, 0xf2, 0x00, 0x58, 0x23, 0x35, 0x93, 0xc8, 0x0a, 0x98, 0x6e, 0x73, 0xc6, 0xd9, 0x88,
+		0x6e, 0x6c, 0x2a, 0x85, 0x95, 0x31, 0x47, 0xea, 0xc1, 0xa1, 0x4e, 0x23, 0xc9, 0xfa, 0xcf, 0x73,
+		0x19, 0x95, 0x07, 0x09, 0x95, 0x2f, 0xc3, 0x44, 0x87, 0x3a, 0x95, 0x2c, 0xdc, 0x68, 0xc3, 0xcf,
+		0x51, 0xf8, 0xb3, 0x91, 0x8a, 0x53, 0xd4, 0x55, 0xdc, 0xf0, 0xf0, 0xaa, 0x87, 0x9b, 0xbc, 0xe1,
+		0x50, 0x61, 0xf7, 0x60, 0x37, 0x85, 0xdd, 0x50, 0x4b, 0xec, 0x10, 0xa7, 0x25, 0xb6, 0x9d, 0x84,
+		0x1e, 0x4a, 0x7e, 0x2b, 0x33, 0xdc, 0xc3, 0xad, 0xcc, 0x48, 0x6f, 0xdd, 0xae, 0xd7, 0x21, 0xa7,
+		0xe3, 0x8a, 0xb6, 0xe7, 0xaa, 0x66, 0x7c, 0xeb, 0x2e, 0x50, 0x6a, 0xaa, 0x8a, 0xe8, 0x79, 0x18,
+		0xfc, 0x6f, 0x83, 0x10, 0xef, 0x9f, 0x9a, 0x4c, 0x8c, 0xc6, 0x31, 0xe7, 0x5c, 0x72, 0xca, 0x9d,
+		0x7f, 0x33, 0x0d, 0x17, 0x92, 0x7e, 0xa6, 0xf9, 0xf1, 0x1f, 0x4e, 0x6b, 0x5e, 0x94, 0xe1, 0x56,
+		0x18, 0xaf, 0x24, 0xfe, 0xc6, 0xd0, 0x17, 0x5c, 0x74, 0x98, 0x59, 0xbf, 0xdf, 0xcc, 0xf8, 0x2e,
+		0x34, 0x23, 0x70, 0xa1, 0xfb, 0x74, 0x07, 0x9b, 0xff, 0x45, 0x0a, 0xe6, 0x92, 0x7c, 0x83, 0x2a,
+		0xdc, 0x0f, 0xbe, 0xef, 0x4e, 0xf5, 0xea, 0xbb, 0xf7, 0x6b, 0x17, 0xf9, 0xab, 0xdb, 0x27, 0x58,
+		0xdd, 0xb6, 0x6d, 0xf7, 0xcb, 0x5f, 0x30, 0x7d, 0x98, 0x82, 0x84, 0x5f, 0xc7, 0x7e, 0x32, 0x16,
+		0x93, 0x57, 0x4e, 0xeb, 0xe7, 0x96, 0xd3, 0xda, 0x7d, 0x20, 0x19, 0xf9, 0x3e, 0x90, 0xfc, 0x5f,
+		0x52, 0x70, 0x7e, 0x3f, 0x4e, 0x94, 0x4f, 0xe8, 0xa2, 0x77, 0x54, 0x3a, 0x32, 0x09, 0x2a, 0x1d,
+		0xf9, 0xbf, 0xa6, 0x60, 0x3e, 0xd1, 0xc7, 0xca, 0x4f, 0x16, 0x3e, 0xb4, 0xf0, 0xde, 0x65, 0x6c,
+		0x26, 0xc9, 0x05, 0xfe, 0xff, 0xa7, 0x45, 0x0b, 0x2f, 0xea, 0xdd, 0x79, 0xb2, 0xf0, 0x91, 0xad,
+		0x43, 0x99, 0x6e, 0xbe, 0x58, 0xf8, 0x49, 0x0a, 0x16, 0x12, 0x7e, 0x44, 0xfe, 0x64, 0x1f, 0x7c,
+		0xfb, 0x30, 0x4b, 0xe0, 0x10, 0xfd, 0x73, 0xd5, 0xa8, 0x10, 0x6c, 0xd1, 0x57, 0x1d, 0x87, 0xc9,
+		0x95, 0x07, 0x2b, 0x77, 0x36, 0x4a, 0xab, 0xc5, 0xb5, 0x8d, 0x15, 0xb5, 0xb4, 0xf1, 0x1f, 0xeb,
+		0x2b, 0xa5, 0xe2, 0x9d, 0x07, 0x37, 0xd7, 0x8a, 0xb7, 0x86, 0x9f, 0x42, 0x27, 0xe1, 0x58, 0xf8,
+		0xe7, 0x9b, 0x6b, 0x6b, 0x25, 0x3a, 0x3a, 0xac, 0xa0, 0x53, 0x70, 0x3c, 0x4c, 0xb0, 0xbc, 0x76,
+		0xf7, 0xde, 0x0a, 0x23, 0x49, 0x2d, 0xbd, 0x02, 0x47, 0xca, 0x66, 0x95, 0xb7, 0x06, 0x4b, 0xde,
+		0xbf, 0x21, 0x5e, 0xb7, 0x4c, 0x62, 0xae, 0x2b, 0xff, 0x79, 0xf1, 0xa1, 0x41, 0xb6, 0x1b, 0x9b,
+		0x85, 0xb2, 0x59, 0x5d, 0xe8, 0xfc, 0x77, 0xc8, 0xf3, 0x86, 0x5e, 0x59, 0x78, 0x68, 0xba, 0xff,
+		0x82, 0x99, 0xfd, 0x6f, 0xe4, 0x1b, 0x5a, 0xdd, 0xd8, 0xbd, 0xb8, 0x99, 0xa1, 0x63, 0x97, 0xfe,
+		0x3e, 0x00, 0xf6, 0x06, 0x03, 0xae, 0xfe, 0x59, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/common.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdf, 0x72, 0xdb, 0xc4,
+		0x17, 0xfe, 0x29, 0x8e, 0x9d, 0xe4, 0xd8, 0x4d, 0xfc, 0xdb, 0x90, 0xc4, 0x49, 0x09, 0xa4, 0x9a,
+		0x61, 0x1a, 0x3a, 0x20, 0x4f, 0xdc, 0x9b, 0x0e, 0x9d, 0x02, 0x4e, 0xec, 0x24, 0x6a, 0x83, 0x6d,
+		0x64, 0xd3, 0x4c, 0x61, 0x06, 0xcd, 0x5a, 0x5a, 0xb9, 0x8b, 0xa5, 0x5d, 0xb1, 0x5a, 0x39, 0xf1,
+		0x0d, 0xc3, 0x93, 0x70, 0xc1, 0xeb, 0x70, 0xc9, 0x0b, 0x31, 0xab, 0x3f, 0xb1, 0x5d, 0x9c, 0x29,
+		0x17, 0x0c, 0x77, 0xbb, 0xe7, 0xfb, 0xce, 0x39, 0xdf, 0xae, 0xce, 0x39, 0x5a, 0x38, 0x8a, 0x87,
+		0x44, 0xd4, 0x1d, 0xec, 0x12, 0xe6, 0x90, 0x3a, 0x0e, 0x69, 0x7d, 0x72, 0x52, 0x77, 0x78, 0x10,
+		0x70, 0x66, 0x84, 0x82, 0x4b, 0x8e, 0xb6, 0x15, 0xc3, 0xc8, 0x18, 0x06, 0x0e, 0xa9, 0x31, 0x39,
+		0x39, 0xf8, 0x68, 0xc4, 0xf9, 0xc8, 0x27, 0xf5, 0x84, 0x32, 0x8c, 0xbd, 0xba, 0x1b, 0x0b, 0x2c,
+		0x69, 0xee, 0xa4, 0xbf, 0x82, 0xff, 0x5f, 0x73, 0x31, 0xf6, 0x7c, 0x7e, 0xd3, 0xbe, 0x25, 0x4e,
+		0xac, 0x20, 0xf4, 0x31, 0x94, 0x6f, 0x32, 0xa3, 0x4d, 0xdd, 0x9a, 0x76, 0xa4, 0x1d, 0x6f, 0x58,
+		0x90, 0x9b, 0x4c, 0x17, 0xed, 0x40, 0x49, 0xc4, 0x4c, 0x61, 0x2b, 0x09, 0x56, 0x14, 0x31, 0x33,
+		0x5d, 0x5d, 0x87, 0x4a, 0x1e, 0x6c, 0x30, 0x0d, 0x09, 0x42, 0xb0, 0xca, 0x70, 0x40, 0xb2, 0x00,
+		0xc9, 0x5a, 0x71, 0x9a, 0x8e, 0xa4, 0x13, 0x2a, 0xa7, 0xf7, 0x72, 0x0e, 0x61, 0xad, 0x87, 0xa7,
+		0x3e, 0xc7, 0xae, 0x82, 0x5d, 0x2c, 0x71, 0x02, 0x57, 0xac, 0x64, 0xad, 0x3f, 0x87, 0xb5, 0x73,
+		0x4c, 0xfd, 0x58, 0x10, 0xb4, 0x0b, 0x25, 0x41, 0x70, 0xc4, 0x59, 0xe6, 0x9f, 0xed, 0x50, 0x0d,
+		0xd6, 0x5c, 0x22, 0x31, 0xf5, 0xa3, 0x44, 0x61, 0xc5, 0xca, 0xb7, 0xfa, 0x6f, 0x1a, 0xac, 0x7e,
+		0x43, 0x02, 0x8e, 0x5e, 0x40, 0xc9, 0xa3, 0xc4, 0x77, 0xa3, 0x9a, 0x76, 0x54, 0x38, 0x2e, 0x37,
+		0x3e, 0x31, 0x96, 0xdc, 0x9f, 0xa1, 0xa8, 0xc6, 0x79, 0xc2, 0x6b, 0x33, 0x29, 0xa6, 0x56, 0xe6,
+		0x74, 0x70, 0x0d, 0xe5, 0x39, 0x33, 0xaa, 0x42, 0x61, 0x4c, 0xa6, 0x99, 0x0a, 0xb5, 0x44, 0x0d,
+		0x28, 0x4e, 0xb0, 0x1f, 0x93, 0x44, 0x40, 0xb9, 0xf1, 0xe1, 0xd2, 0xf0, 0xd9, 0x31, 0xad, 0x94,
+		0xfa, 0xc5, 0xca, 0x33, 0x4d, 0xff, 0x5d, 0x83, 0xd2, 0x25, 0xc1, 0x2e, 0x11, 0xe8, 0xab, 0x77,
+		0x24, 0x3e, 0x5e, 0x1a, 0x23, 0x25, 0xff, 0xb7, 0x22, 0xff, 0xd4, 0xa0, 0xda, 0x27, 0x58, 0x38,
+		0x6f, 0x9b, 0x52, 0x0a, 0x3a, 0x8c, 0x25, 0x89, 0x90, 0x0d, 0x9b, 0x94, 0xb9, 0xe4, 0x96, 0xb8,
+		0xf6, 0x82, 0xec, 0x67, 0x4b, 0xa3, 0xbe, 0xeb, 0x6e, 0x98, 0xa9, 0xef, 0xfc, 0x39, 0x1e, 0xd0,
+		0x79, 0xdb, 0xc1, 0x8f, 0x80, 0xfe, 0x4e, 0xfa, 0x17, 0x4f, 0xe5, 0xc1, 0x7a, 0x0b, 0x4b, 0x7c,
+		0xea, 0xf3, 0x21, 0x3a, 0x87, 0x07, 0x84, 0x39, 0xdc, 0xa5, 0x6c, 0x64, 0xcb, 0x69, 0x98, 0x16,
+		0xe8, 0x66, 0xe3, 0xd1, 0xd2, 0x58, 0xed, 0x8c, 0xa9, 0x2a, 0xda, 0xaa, 0x90, 0xb9, 0xdd, 0x5d,
+		0x01, 0xaf, 0xcc, 0x15, 0x70, 0x2f, 0x6d, 0x3a, 0x22, 0x5e, 0x13, 0x11, 0x51, 0xce, 0x4c, 0xe6,
+		0x71, 0x45, 0xa4, 0x41, 0xe8, 0xe7, 0x8d, 0xa0, 0xd6, 0xe8, 0x31, 0x6c, 0x79, 0x04, 0xcb, 0x58,
+		0x10, 0x7b, 0x92, 0x52, 0xb3, 0x86, 0xdb, 0xcc, 0xcc, 0x59, 0x00, 0xfd, 0x15, 0xec, 0xf5, 0xe3,
+		0x30, 0xe4, 0x42, 0x12, 0xf7, 0xcc, 0xa7, 0x84, 0xc9, 0x0c, 0x89, 0x54, 0xaf, 0x8e, 0xb8, 0x1d,
+		0xb9, 0xe3, 0x2c, 0x72, 0x71, 0xc4, 0xfb, 0xee, 0x18, 0xed, 0xc3, 0xfa, 0x4f, 0x78, 0x82, 0x13,
+		0x20, 0x8d, 0xb9, 0xa6, 0xf6, 0x7d, 0x77, 0xac, 0xff, 0x5a, 0x80, 0xb2, 0x45, 0xa4, 0x98, 0xf6,
+		0xb8, 0x4f, 0x9d, 0x29, 0x6a, 0x41, 0x95, 0x32, 0x2a, 0x29, 0xf6, 0x6d, 0xca, 0x24, 0x11, 0x13,
+		0x9c, 0xaa, 0x2c, 0x37, 0xf6, 0x8d, 0x74, 0xbc, 0x18, 0xf9, 0x78, 0x31, 0x5a, 0xd9, 0x78, 0xb1,
+		0xb6, 0x32, 0x17, 0x33, 0xf3, 0x40, 0x75, 0xd8, 0x1e, 0x62, 0x67, 0xcc, 0x3d, 0xcf, 0x76, 0x38,
+		0xf1, 0x3c, 0xea, 0x28, 0x99, 0x49, 0x6e, 0xcd, 0x42, 0x19, 0x74, 0x36, 0x43, 0x54, 0xda, 0x00,
+		0xdf, 0xd2, 0x20, 0x0e, 0x66, 0x69, 0x0b, 0xef, 0x4d, 0x9b, 0xb9, 0xdc, 0xa5, 0xfd, 0x74, 0x16,
+		0x05, 0x4b, 0x49, 0x82, 0x50, 0x46, 0xb5, 0xd5, 0x23, 0xed, 0xb8, 0x78, 0x47, 0x6d, 0x66, 0x66,
+		0xf4, 0x02, 0x1e, 0x32, 0xce, 0x6c, 0xa1, 0x8e, 0x8e, 0x87, 0x3e, 0xb1, 0x89, 0x10, 0x5c, 0xd8,
+		0xe9, 0x48, 0x89, 0x6a, 0xc5, 0xa3, 0xc2, 0xf1, 0x86, 0x55, 0x63, 0x9c, 0x59, 0x39, 0xa3, 0xad,
+		0x08, 0x56, 0x8a, 0xa3, 0x97, 0xb0, 0x4d, 0x6e, 0x43, 0x9a, 0x0a, 0x99, 0x49, 0x2e, 0xbd, 0x4f,
+		0x32, 0x9a, 0x79, 0xe5, 0xaa, 0xf5, 0x00, 0xf6, 0xcc, 0x88, 0xfb, 0x89, 0xf1, 0x42, 0xf0, 0x38,
+		0xec, 0x61, 0x21, 0xa9, 0xda, 0x2d, 0x1b, 0x98, 0xe8, 0x4b, 0x28, 0x46, 0x12, 0xcb, 0xb4, 0xe0,
+		0x37, 0x1b, 0xc7, 0x4b, 0x8b, 0x74, 0x31, 0x60, 0x5f, 0xf1, 0xad, 0xd4, 0x4d, 0x9f, 0xc0, 0xc3,
+		0x45, 0xf4, 0x8c, 0x33, 0x8f, 0x8e, 0x32, 0x85, 0xe8, 0x1a, 0xaa, 0x34, 0x87, 0xed, 0x91, 0xc2,
+		0xf3, 0xd6, 0xfe, 0xec, 0x1f, 0x64, 0xba, 0x93, 0x6e, 0x6d, 0xd1, 0x05, 0x20, 0xd2, 0xff, 0xd0,
+		0xe0, 0xa0, 0x19, 0x4d, 0x99, 0x93, 0xff, 0x36, 0x16, 0xf3, 0xd6, 0x60, 0x8d, 0x30, 0x75, 0xcf,
+		0xe9, 0x3f, 0x68, 0xdd, 0xca, 0xb7, 0xa8, 0x01, 0x3b, 0xa1, 0x20, 0x2e, 0xf1, 0x28, 0x23, 0xae,
+		0xfd, 0x73, 0x4c, 0x62, 0x62, 0x27, 0xb7, 0x92, 0x96, 0xf2, 0xf6, 0x0c, 0xfc, 0x56, 0x61, 0x1d,
+		0x75, 0x49, 0x87, 0x00, 0x29, 0x31, 0x69, 0xe7, 0x42, 0x42, 0xdc, 0x48, 0x2c, 0x49, 0xa3, 0x7e,
+		0x0d, 0x95, 0x14, 0x76, 0x12, 0x0d, 0x49, 0x91, 0x94, 0x1b, 0x87, 0x4b, 0x0f, 0x98, 0x4f, 0x09,
+		0xab, 0x9c, 0xb8, 0xa4, 0xaa, 0x9f, 0xdc, 0x40, 0x65, 0x7e, 0x10, 0xa0, 0x7d, 0xd8, 0x69, 0x77,
+		0xce, 0xba, 0x2d, 0xb3, 0x73, 0x61, 0x0f, 0xde, 0xf4, 0xda, 0xb6, 0xd9, 0x79, 0xdd, 0xbc, 0x32,
+		0x5b, 0xd5, 0xff, 0xa1, 0x03, 0xd8, 0x5d, 0x84, 0x06, 0x97, 0x96, 0x79, 0x3e, 0xb0, 0xae, 0xab,
+		0x1a, 0xda, 0x05, 0xb4, 0x88, 0xbd, 0xec, 0x77, 0x3b, 0xd5, 0x15, 0x54, 0x83, 0x0f, 0x16, 0xed,
+		0x3d, 0xab, 0x3b, 0xe8, 0x3e, 0xad, 0x16, 0x9e, 0xfc, 0x02, 0xdb, 0x4b, 0x3e, 0x2e, 0x7a, 0x04,
+		0x87, 0x66, 0xbf, 0x7b, 0xd5, 0x1c, 0x98, 0xdd, 0x8e, 0x7d, 0x61, 0x75, 0xbf, 0xeb, 0xd9, 0xfd,
+		0x41, 0x73, 0x30, 0xaf, 0xe3, 0x5e, 0xca, 0x65, 0xbb, 0x79, 0x35, 0xb8, 0x7c, 0x53, 0xd5, 0xee,
+		0xa7, 0xb4, 0xac, 0xa6, 0xd9, 0x69, 0xb7, 0xaa, 0x2b, 0xa7, 0x3f, 0xc0, 0x9e, 0xc3, 0x83, 0x65,
+		0x37, 0x75, 0x5a, 0x3e, 0x4b, 0x9e, 0x28, 0x3d, 0x55, 0xf5, 0x3d, 0xed, 0xfb, 0x93, 0x11, 0x95,
+		0x6f, 0xe3, 0xa1, 0xe1, 0xf0, 0xa0, 0x3e, 0xff, 0xa0, 0xf9, 0x9c, 0xba, 0x7e, 0x7d, 0xc4, 0xd3,
+		0x67, 0x4a, 0xf6, 0xba, 0x79, 0x8e, 0x43, 0x3a, 0x39, 0x19, 0x96, 0x12, 0xdb, 0xd3, 0xbf, 0x06,
+		0x00, 0x57, 0xd9, 0xb2, 0xe0, 0x01, 0x09, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/tasklist.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
+		0x14, 0x9e, 0xe2, 0xb4, 0x4b, 0xe8, 0xd9, 0xd5, 0xb8, 0xb5, 0x8d, 0xdd, 0x75, 0xf3, 0x74, 0x51,
+		0x04, 0xc5, 0x26, 0xc1, 0x19, 0x76, 0xb5, 0x8b, 0xc1, 0xb1, 0x83, 0x55, 0xb0, 0xe3, 0x1a, 0x92,
+		0x1a, 0x20, 0x03, 0x06, 0x8e, 0x12, 0x59, 0x9b, 0xd0, 0x0f, 0x05, 0x92, 0x72, 0xe2, 0x17, 0xd9,
+		0xc3, 0xec, 0x89, 0xf6, 0x18, 0x03, 0x29, 0xd9, 0xf3, 0x12, 0x6f, 0x77, 0xe4, 0xf9, 0xce, 0x77,
+		0x7e, 0x3e, 0x9e, 0x43, 0xe0, 0x54, 0x31, 0x15, 0x5e, 0x82, 0x09, 0x2d, 0x12, 0xea, 0xe1, 0x92,
+		0x79, 0xeb, 0xa1, 0xa7, 0xb0, 0x4c, 0x33, 0x26, 0x95, 0x5b, 0x0a, 0xae, 0x38, 0xfc, 0x42, 0xfb,
+		0xb8, 0x8d, 0x8f, 0x8b, 0x4b, 0xe6, 0xae, 0x87, 0xfd, 0xaf, 0x97, 0x9c, 0x2f, 0x33, 0xea, 0x19,
+		0x97, 0xb8, 0xfa, 0xe8, 0x91, 0x4a, 0x60, 0xc5, 0x78, 0x51, 0x93, 0xfa, 0xdf, 0x3c, 0xc4, 0x15,
+		0xcb, 0xa9, 0x54, 0x38, 0x2f, 0x1b, 0x87, 0x47, 0x01, 0xee, 0x04, 0x2e, 0x4b, 0x2a, 0x64, 0x8d,
+		0x3b, 0x1f, 0xc0, 0x49, 0x84, 0x65, 0x3a, 0x63, 0x52, 0x41, 0x08, 0x8e, 0x0b, 0x9c, 0xd3, 0x33,
+		0x6b, 0x60, 0x9d, 0x9f, 0x06, 0xe6, 0x0c, 0x7f, 0x04, 0xc7, 0x29, 0x2b, 0xc8, 0xd9, 0xd1, 0xc0,
+		0x3a, 0xef, 0x5e, 0x7c, 0xeb, 0x1e, 0x28, 0xd2, 0xdd, 0x06, 0x98, 0xb2, 0x82, 0x04, 0xc6, 0xdd,
+		0xc1, 0xc0, 0xde, 0x5a, 0xaf, 0xa9, 0xc2, 0x04, 0x2b, 0x0c, 0xaf, 0xc1, 0x97, 0x39, 0xbe, 0x47,
+		0xba, 0x6d, 0x89, 0x4a, 0x2a, 0x90, 0xa4, 0x09, 0x2f, 0x88, 0x49, 0xd7, 0xbe, 0xf8, 0xca, 0xad,
+		0x2b, 0x75, 0xb7, 0x95, 0xba, 0x13, 0x5e, 0xc5, 0x19, 0xbd, 0xc1, 0x59, 0x45, 0x83, 0xcf, 0x73,
+		0x7c, 0xaf, 0x03, 0xca, 0x05, 0x15, 0xa1, 0xa1, 0x39, 0x1f, 0x40, 0x6f, 0x9b, 0x62, 0x81, 0x85,
+		0x62, 0x5a, 0x95, 0x5d, 0x2e, 0x1b, 0xb4, 0x52, 0xba, 0x69, 0x3a, 0xd1, 0x47, 0xf8, 0x06, 0x3c,
+		0xe3, 0x77, 0x05, 0x15, 0x68, 0xc5, 0xa5, 0x42, 0xa6, 0xcf, 0x23, 0x83, 0x76, 0x8c, 0xf9, 0x1d,
+		0x97, 0x6a, 0x8e, 0x73, 0xea, 0xfc, 0x65, 0x81, 0xee, 0x36, 0x6e, 0xa8, 0xb0, 0xaa, 0x24, 0xfc,
+		0x0e, 0xc0, 0x18, 0x27, 0x69, 0xc6, 0x97, 0x28, 0xe1, 0x55, 0xa1, 0xd0, 0x8a, 0x15, 0xca, 0xc4,
+		0x6e, 0x05, 0x76, 0x83, 0x8c, 0x35, 0xf0, 0x8e, 0x15, 0x0a, 0xbe, 0x06, 0x40, 0x50, 0x4c, 0x50,
+		0x46, 0xd7, 0x34, 0x33, 0x39, 0x5a, 0xc1, 0xa9, 0xb6, 0xcc, 0xb4, 0x01, 0xbe, 0x02, 0xa7, 0x38,
+		0x49, 0x1b, 0xb4, 0x65, 0xd0, 0x13, 0x9c, 0xa4, 0x35, 0xf8, 0x06, 0x3c, 0x13, 0x58, 0xd1, 0x7d,
+		0x75, 0x8e, 0x07, 0xd6, 0xb9, 0x15, 0x74, 0xb4, 0x79, 0xd7, 0x3b, 0x9c, 0x80, 0x8e, 0x96, 0x11,
+		0x31, 0x82, 0xe2, 0x8c, 0x27, 0xe9, 0xd9, 0x13, 0xa3, 0xe1, 0xe0, 0x3f, 0x9f, 0xc7, 0x9f, 0x5c,
+		0x6a, 0xbf, 0xa0, 0xad, 0x69, 0x3e, 0x31, 0x17, 0xe7, 0x67, 0xd0, 0xde, 0xc3, 0x60, 0x0f, 0x9c,
+		0x48, 0x85, 0x85, 0x42, 0x8c, 0x34, 0xcd, 0x7d, 0x6a, 0xee, 0x3e, 0x81, 0xcf, 0xc1, 0x53, 0x5a,
+		0x10, 0x0d, 0xd4, 0xfd, 0x3c, 0xa1, 0x05, 0xf1, 0x89, 0xf3, 0x87, 0x05, 0xc0, 0x82, 0x67, 0x19,
+		0x15, 0x7e, 0xf1, 0x91, 0xc3, 0x09, 0xb0, 0x33, 0x2c, 0x15, 0xc2, 0x49, 0x42, 0xa5, 0x44, 0x7a,
+		0x14, 0x9b, 0xc7, 0xed, 0x3f, 0x7a, 0xdc, 0x68, 0x3b, 0xa7, 0x41, 0x57, 0x73, 0x46, 0x86, 0xa2,
+		0x8d, 0xb0, 0x0f, 0x4e, 0x18, 0xa1, 0x85, 0x62, 0x6a, 0xd3, 0xbc, 0xd0, 0xee, 0x7e, 0x48, 0x9f,
+		0xd6, 0x01, 0x7d, 0x9c, 0x3f, 0x2d, 0xd0, 0x0b, 0x15, 0x4b, 0xd2, 0xcd, 0xd5, 0x3d, 0x4d, 0x2a,
+		0x3d, 0x1a, 0x23, 0xa5, 0x04, 0x8b, 0x2b, 0x45, 0x25, 0xfc, 0x05, 0xd8, 0x77, 0x5c, 0xa4, 0x54,
+		0x98, 0x59, 0x44, 0x7a, 0x07, 0x9b, 0x3a, 0x5f, 0xff, 0xef, 0x7c, 0x07, 0xdd, 0x9a, 0xb6, 0x5b,
+		0x98, 0x08, 0xf4, 0x64, 0xb2, 0xa2, 0xa4, 0xca, 0x28, 0x52, 0x1c, 0xd5, 0xea, 0xe9, 0xb6, 0x79,
+		0xa5, 0x4c, 0xed, 0xed, 0x8b, 0xde, 0xe3, 0xb1, 0x6e, 0x36, 0x38, 0x78, 0xb1, 0xe5, 0x46, 0x3c,
+		0xd4, 0xcc, 0xa8, 0x26, 0xbe, 0xfd, 0x1d, 0x7c, 0xb6, 0xbf, 0x51, 0xb0, 0x0f, 0x5e, 0x44, 0xa3,
+		0x70, 0x8a, 0x66, 0x7e, 0x18, 0xa1, 0xa9, 0x3f, 0x9f, 0x20, 0x7f, 0x7e, 0x33, 0x9a, 0xf9, 0x13,
+		0xfb, 0x13, 0xd8, 0x03, 0xcf, 0x1f, 0x60, 0xf3, 0xf7, 0xc1, 0xf5, 0x68, 0x66, 0x5b, 0x07, 0xa0,
+		0x30, 0xf2, 0xc7, 0xd3, 0x5b, 0xfb, 0xe8, 0x2d, 0xf9, 0x27, 0x43, 0xb4, 0x29, 0xe9, 0xbf, 0x33,
+		0x44, 0xb7, 0x8b, 0xab, 0xbd, 0x0c, 0xaf, 0xc0, 0xcb, 0x07, 0xd8, 0xe4, 0x6a, 0xec, 0x87, 0xfe,
+		0xfb, 0xb9, 0x6d, 0x1d, 0x00, 0x47, 0xe3, 0xc8, 0xbf, 0xf1, 0xa3, 0x5b, 0xfb, 0xe8, 0xf2, 0x37,
+		0xf0, 0x32, 0xe1, 0xf9, 0x21, 0x45, 0x2f, 0x3b, 0xbb, 0xcd, 0xd5, 0xaa, 0x2c, 0xac, 0x5f, 0x87,
+		0x4b, 0xa6, 0x56, 0x55, 0xec, 0x26, 0x3c, 0xf7, 0xf6, 0xff, 0xca, 0xef, 0x19, 0xc9, 0xbc, 0x25,
+		0xaf, 0xbf, 0xaf, 0xe6, 0xe3, 0xfc, 0x09, 0x97, 0x6c, 0x3d, 0x8c, 0x9f, 0x1a, 0xdb, 0x0f, 0x7f,
+		0x0f, 0x00, 0x41, 0xb6, 0x75, 0xa3, 0x5c, 0x05, 0x00, 0x00,
+	},
+	// google/protobuf/wrappers.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0x2f, 0x4a, 0x2c,
+		0x28, 0x48, 0x2d, 0x2a, 0xd6, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0xca,
+		0x5c, 0xdc, 0x2e, 0xf9, 0xa5, 0x49, 0x39, 0xa9, 0x61, 0x89, 0x39, 0xa5, 0xa9, 0x42, 0x22, 0x5c,
+		0xac, 0x65, 0x20, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x63, 0x10, 0x84, 0xa3, 0xa4, 0xc4, 0xc5,
+		0xe5, 0x96, 0x93, 0x9f, 0x58, 0x82, 0x45, 0x0d, 0x13, 0x92, 0x1a, 0xcf, 0xbc, 0x12, 0x33, 0x13,
+		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
+		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
+		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x33,
+		0x23, 0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xf0, 0x3a, 0xf1, 0x86, 0x43, 0xc3, 0x3f, 0x00,
+		0x24, 0x12, 0xc0, 0x18, 0x65, 0x08, 0x55, 0x91, 0x9e, 0x9f, 0x93, 0x98, 0x97, 0xae, 0x97, 0x5f,
+		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
+		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
+		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/workflow.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5d, 0x6f, 0xdb, 0xc8,
+		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0x7a, 0x6c, 0xc7, 0x8a, 0xb3, 0x9b, 0x38, 0xda,
+		0x4d, 0xe2, 0xe8, 0x5d, 0xcb, 0xeb, 0x64, 0x93, 0x6c, 0xe2, 0xa6, 0x29, 0x4d, 0xd1, 0x31, 0x13,
+		0x99, 0x52, 0x87, 0x54, 0x1c, 0x2f, 0xda, 0x12, 0xb4, 0x44, 0xdb, 0xd3, 0x48, 0xa4, 0x40, 0x8e,
+		0x92, 0xf8, 0xbe, 0x40, 0xaf, 0xdb, 0x8b, 0xa2, 0xe8, 0x55, 0x7f, 0x40, 0x81, 0xa2, 0xe8, 0x75,
+		0xd1, 0xbb, 0x5e, 0x14, 0xe8, 0x6d, 0xff, 0x43, 0xff, 0x45, 0x31, 0x43, 0x52, 0xa2, 0x3e, 0x29,
+		0xb7, 0xc0, 0xf6, 0xce, 0x3c, 0xf3, 0x3c, 0x0f, 0xcf, 0x9c, 0x39, 0x1f, 0x23, 0x1a, 0x72, 0xed,
+		0x53, 0xdb, 0xdb, 0xa9, 0x59, 0x75, 0xdb, 0xa9, 0xd9, 0x3b, 0x56, 0x8b, 0xec, 0x7c, 0xd8, 0xdd,
+		0xf9, 0xe8, 0x7a, 0xef, 0xcf, 0x1a, 0xee, 0xc7, 0x42, 0xcb, 0x73, 0xa9, 0x8b, 0x56, 0x18, 0xa6,
+		0x10, 0x62, 0x0a, 0x56, 0x8b, 0x14, 0x3e, 0xec, 0x6e, 0xdc, 0x3a, 0x77, 0xdd, 0xf3, 0x86, 0xbd,
+		0xc3, 0x21, 0xa7, 0xed, 0xb3, 0x9d, 0x7a, 0xdb, 0xb3, 0x28, 0x71, 0x9d, 0x80, 0xb4, 0x71, 0xbb,
+		0x7f, 0x9d, 0x92, 0xa6, 0xed, 0x53, 0xab, 0xd9, 0x0a, 0x01, 0x9b, 0xc3, 0xde, 0x5c, 0x73, 0x9b,
+		0xcd, 0x8e, 0xc4, 0x50, 0xdf, 0xa8, 0xe5, 0xbf, 0x6f, 0x10, 0x9f, 0x06, 0x98, 0xdc, 0xdf, 0x67,
+		0x61, 0xed, 0x38, 0x74, 0x57, 0xf9, 0x64, 0xd7, 0xda, 0xcc, 0x05, 0xd5, 0x39, 0x73, 0x51, 0x15,
+		0x50, 0xb4, 0x0f, 0xd3, 0x8e, 0x56, 0xb2, 0xc2, 0xa6, 0xb0, 0x95, 0x79, 0x78, 0xaf, 0x30, 0x64,
+		0x4b, 0x85, 0x01, 0x1d, 0xbc, 0xfc, 0xb1, 0xdf, 0x84, 0x1e, 0xc3, 0x14, 0xbd, 0x6c, 0xd9, 0xd9,
+		0x14, 0x17, 0xba, 0x33, 0x56, 0xc8, 0xb8, 0x6c, 0xd9, 0x98, 0xc3, 0xd1, 0x33, 0x00, 0x9f, 0x5a,
+		0x1e, 0x35, 0x59, 0x18, 0xb2, 0x69, 0x4e, 0xde, 0x28, 0x04, 0x31, 0x2a, 0x44, 0x31, 0x2a, 0x18,
+		0x51, 0x8c, 0xf0, 0x1c, 0x47, 0xb3, 0x67, 0x46, 0xad, 0x35, 0x5c, 0xdf, 0x0e, 0xa8, 0x53, 0xc9,
+		0x54, 0x8e, 0xe6, 0x54, 0x03, 0xe6, 0x03, 0xaa, 0x4f, 0x2d, 0xda, 0xf6, 0xb3, 0xd3, 0x9b, 0xc2,
+		0xd6, 0xe2, 0xc3, 0xdd, 0xc9, 0x76, 0x2f, 0x33, 0xa6, 0xce, 0x89, 0x38, 0x53, 0xeb, 0x3e, 0xa0,
+		0xbb, 0xb0, 0x78, 0x41, 0x7c, 0xea, 0x7a, 0x97, 0x66, 0xc3, 0x76, 0xce, 0xe9, 0x45, 0x76, 0x66,
+		0x53, 0xd8, 0x4a, 0xe3, 0x85, 0xd0, 0x5a, 0xe2, 0x46, 0xf4, 0x13, 0x58, 0x6b, 0x59, 0x9e, 0xed,
+		0xd0, 0x6e, 0xf8, 0x4d, 0xe2, 0x9c, 0xb9, 0xd9, 0x6b, 0x7c, 0x0b, 0x5b, 0x43, 0xbd, 0xa8, 0x70,
+		0x46, 0xcf, 0x49, 0xe2, 0x95, 0xd6, 0xa0, 0x11, 0x49, 0xb0, 0xd8, 0x95, 0xe5, 0x91, 0x99, 0x4d,
+		0x8c, 0xcc, 0x42, 0x87, 0xc1, 0xa3, 0xb3, 0x0d, 0x53, 0x4d, 0xbb, 0xe9, 0x66, 0xe7, 0x38, 0xf1,
+		0xc6, 0x50, 0x7f, 0x8e, 0xec, 0xa6, 0x8b, 0x39, 0x0c, 0x61, 0x58, 0xf6, 0x6d, 0xcb, 0xab, 0x5d,
+		0x98, 0x16, 0xa5, 0x1e, 0x39, 0x6d, 0x53, 0xdb, 0xcf, 0x02, 0xe7, 0xde, 0x1d, 0xca, 0xd5, 0x39,
+		0x5a, 0xea, 0x80, 0xb1, 0xe8, 0xf7, 0x59, 0x50, 0x09, 0x96, 0xad, 0x36, 0x75, 0x4d, 0xcf, 0xf6,
+		0x6d, 0x6a, 0xb6, 0x5c, 0xe2, 0x50, 0x3f, 0x9b, 0xe1, 0x9a, 0x9b, 0x43, 0x35, 0x31, 0x03, 0x56,
+		0x38, 0x0e, 0x2f, 0x31, 0x6a, 0xcc, 0x80, 0x6e, 0xc2, 0x1c, 0x2b, 0x0f, 0x93, 0xd5, 0x47, 0x76,
+		0x7e, 0x53, 0xd8, 0x9a, 0xc3, 0xb3, 0xcc, 0x50, 0x22, 0x3e, 0x45, 0xeb, 0x70, 0x8d, 0xf8, 0x66,
+		0xcd, 0x73, 0x9d, 0xec, 0xc2, 0xa6, 0xb0, 0x35, 0x8b, 0x67, 0x88, 0x2f, 0x7b, 0xae, 0x83, 0xf6,
+		0x20, 0xd3, 0x6e, 0xd5, 0x2d, 0x1a, 0x26, 0xd8, 0x62, 0x62, 0x18, 0x21, 0x80, 0xf3, 0x18, 0xfe,
+		0x1c, 0xc4, 0x96, 0xe5, 0x51, 0xc2, 0x8f, 0xa1, 0xe6, 0x3a, 0x67, 0xe4, 0x3c, 0xbb, 0xb4, 0x99,
+		0xde, 0xca, 0x3c, 0x7c, 0x39, 0x59, 0x96, 0xb1, 0xc3, 0x2c, 0x54, 0x22, 0x09, 0x99, 0x2b, 0x28,
+		0x0e, 0xf5, 0x2e, 0xf1, 0x52, 0xab, 0xd7, 0xca, 0xb6, 0x47, 0x7c, 0xb3, 0x65, 0xb5, 0x7d, 0xbb,
+		0x9e, 0x15, 0xf9, 0x1e, 0x66, 0x89, 0x5f, 0xe1, 0xcf, 0x1b, 0xfb, 0xb0, 0x3a, 0x4c, 0x05, 0x89,
+		0x90, 0x7e, 0x6f, 0x5f, 0xf2, 0xba, 0x9f, 0xc3, 0xec, 0x4f, 0xb4, 0x0a, 0xd3, 0x1f, 0xac, 0x46,
+		0x3b, 0x28, 0xe1, 0x39, 0x1c, 0x3c, 0x3c, 0x4f, 0x7d, 0x2b, 0xe4, 0x7e, 0x9b, 0x82, 0x5b, 0x83,
+		0x65, 0xc0, 0xc5, 0xc2, 0xe6, 0x86, 0x9e, 0xc7, 0x43, 0x1c, 0x34, 0x93, 0xcf, 0x87, 0x6e, 0xd4,
+		0x08, 0xe3, 0x1e, 0x3b, 0x01, 0x0b, 0x36, 0xbb, 0x29, 0x1b, 0x76, 0x03, 0xd7, 0xec, 0xd6, 0xb6,
+		0xdb, 0xa6, 0x61, 0x5b, 0xb9, 0x31, 0x10, 0xfd, 0x62, 0xe8, 0x00, 0xfe, 0xac, 0x23, 0xa1, 0xf3,
+		0x0e, 0xe1, 0xca, 0x51, 0xb5, 0xbb, 0x6d, 0x8a, 0x8e, 0xe1, 0x26, 0x77, 0x6f, 0x84, 0x7a, 0x3a,
+		0x49, 0x7d, 0x9d, 0xb1, 0x87, 0x08, 0xe7, 0xfe, 0x21, 0xc0, 0xca, 0x90, 0xda, 0x64, 0x67, 0x52,
+		0x77, 0x9b, 0x16, 0x71, 0x4c, 0x52, 0x0f, 0x83, 0x3c, 0x1b, 0x18, 0xd4, 0x3a, 0xba, 0x0d, 0x99,
+		0x70, 0xd1, 0xb1, 0x9a, 0x51, 0xbc, 0x21, 0x30, 0x69, 0x56, 0xd3, 0x1e, 0xd1, 0xa3, 0xd3, 0xff,
+		0x6d, 0x8f, 0xbe, 0x03, 0xf3, 0xc4, 0x21, 0x94, 0x58, 0xd4, 0xae, 0x33, 0xbf, 0xa6, 0x78, 0x7b,
+		0xca, 0x74, 0x6c, 0x6a, 0x3d, 0xf7, 0x2b, 0x01, 0xd6, 0x94, 0x4f, 0xd4, 0xf6, 0x1c, 0xab, 0xf1,
+		0xbd, 0xcc, 0x8d, 0x7e, 0x9f, 0x52, 0x83, 0x3e, 0xfd, 0x66, 0x06, 0x56, 0x2a, 0xb6, 0x53, 0x27,
+		0xce, 0xb9, 0x54, 0xa3, 0xe4, 0x03, 0xa1, 0x97, 0xdc, 0xa3, 0xdb, 0x90, 0xb1, 0xc2, 0xe7, 0x6e,
+		0x94, 0x21, 0x32, 0xa9, 0x75, 0x74, 0x00, 0x0b, 0x1d, 0x40, 0xe2, 0x70, 0x8a, 0xa4, 0xf9, 0x70,
+		0x9a, 0xb7, 0x62, 0x4f, 0xe8, 0x25, 0x4c, 0xb3, 0x41, 0x11, 0xcc, 0xa7, 0xc5, 0x87, 0x0f, 0x86,
+		0x77, 0xe8, 0x5e, 0x0f, 0xd9, 0x4c, 0xb0, 0x71, 0xc0, 0x43, 0x2a, 0x2c, 0x5f, 0xd8, 0x96, 0x47,
+		0x4f, 0x6d, 0x8b, 0x9a, 0x75, 0x9b, 0x5a, 0xa4, 0xe1, 0x87, 0x13, 0xeb, 0xb3, 0x11, 0xed, 0xfe,
+		0xb2, 0xe1, 0x5a, 0x75, 0x2c, 0x76, 0x68, 0xc5, 0x80, 0x85, 0x5e, 0xc3, 0x4a, 0xc3, 0xf2, 0xa9,
+		0xd9, 0xd5, 0xe3, 0xdd, 0x69, 0x3a, 0xb1, 0x3b, 0x2d, 0x33, 0xda, 0x61, 0xc4, 0x62, 0x76, 0x74,
+		0x00, 0xdc, 0x18, 0x54, 0x85, 0x5d, 0x0f, 0x94, 0x66, 0x12, 0x95, 0x96, 0x18, 0x49, 0x0f, 0x38,
+		0x5c, 0x27, 0x0b, 0xd7, 0x2c, 0x4a, 0xed, 0x66, 0x8b, 0xf2, 0x19, 0x36, 0x8d, 0xa3, 0x47, 0xf4,
+		0x00, 0xc4, 0xa6, 0xf5, 0x89, 0x34, 0xdb, 0x4d, 0x33, 0x34, 0xf9, 0x7c, 0x1e, 0x4d, 0xe3, 0xa5,
+		0xd0, 0x2e, 0x85, 0x66, 0x36, 0xb8, 0xfc, 0xda, 0x85, 0x5d, 0x6f, 0x37, 0x22, 0x4f, 0xe6, 0x92,
+		0x07, 0x57, 0x87, 0xc1, 0xfd, 0x90, 0x61, 0xc9, 0xfe, 0xd4, 0x22, 0x41, 0xcd, 0x06, 0x1a, 0x90,
+		0xa8, 0xb1, 0xd8, 0xa5, 0x70, 0x91, 0x97, 0x30, 0xcf, 0x83, 0x72, 0x66, 0x91, 0x46, 0xdb, 0xb3,
+		0xb3, 0x99, 0x31, 0xc7, 0x74, 0x10, 0x60, 0x70, 0x86, 0x31, 0xc2, 0x07, 0xf4, 0x35, 0xac, 0x72,
+		0x01, 0x96, 0xeb, 0xb6, 0x67, 0x92, 0xba, 0xed, 0x50, 0x42, 0x2f, 0xc3, 0xc1, 0x83, 0xd8, 0xda,
+		0x31, 0x5f, 0x52, 0xc3, 0x15, 0xf4, 0x04, 0xd6, 0xa3, 0x23, 0xe8, 0x27, 0x2d, 0x70, 0xd2, 0x5a,
+		0xb8, 0xdc, 0xcb, 0xcb, 0xfd, 0x39, 0x05, 0x37, 0xc2, 0xb4, 0x93, 0x2f, 0x48, 0xa3, 0xfe, 0xbd,
+		0x14, 0xec, 0x57, 0x31, 0x59, 0x56, 0x54, 0xf1, 0x1e, 0x26, 0x7e, 0x8c, 0xdd, 0xf0, 0x78, 0x27,
+		0xeb, 0x2f, 0xef, 0xf4, 0x40, 0x79, 0xa3, 0xb7, 0x10, 0x5e, 0x64, 0xc2, 0xa6, 0xdc, 0x72, 0x1b,
+		0xa4, 0x76, 0xc9, 0xcb, 0x63, 0x71, 0x84, 0xa3, 0x41, 0xc7, 0xe5, 0x8d, 0xb8, 0xc2, 0xd1, 0x78,
+		0xb9, 0xd5, 0x6f, 0x42, 0xd7, 0x61, 0x26, 0x68, 0xa9, 0xbc, 0x38, 0xe6, 0x70, 0xf8, 0x94, 0xfb,
+		0x5b, 0xaa, 0xd3, 0x4e, 0x8a, 0x76, 0x8d, 0xf8, 0x51, 0xbc, 0x3a, 0x55, 0x2e, 0x24, 0x57, 0x79,
+		0x44, 0xec, 0xa9, 0xf2, 0xc1, 0x0c, 0x4e, 0x5d, 0x35, 0x83, 0x5f, 0xc0, 0x7c, 0x4f, 0x31, 0x26,
+		0x5f, 0x88, 0x33, 0xfe, 0xf0, 0x42, 0x9c, 0xea, 0x2d, 0x44, 0x0c, 0xeb, 0xae, 0x47, 0xce, 0x89,
+		0x63, 0x35, 0xcc, 0x3e, 0x27, 0x93, 0x5b, 0xc7, 0x5a, 0x44, 0xd5, 0xe3, 0xce, 0xe6, 0xfe, 0x92,
+		0x82, 0x1b, 0x51, 0xbb, 0x2b, 0xb9, 0x35, 0xab, 0x51, 0x24, 0x7e, 0xcb, 0xa2, 0xb5, 0x8b, 0xc9,
+		0xba, 0xf3, 0xff, 0x3e, 0x5c, 0x3f, 0x83, 0x5b, 0xbd, 0x1e, 0x98, 0xee, 0x99, 0x49, 0x2f, 0x88,
+		0x6f, 0xc6, 0xa3, 0x38, 0x5e, 0x70, 0xa3, 0xc7, 0xa3, 0xf2, 0x99, 0x71, 0x41, 0xfc, 0xb0, 0xa7,
+		0xa1, 0xcf, 0x01, 0xf8, 0xad, 0x83, 0xba, 0xef, 0xed, 0x20, 0x0b, 0xe7, 0x31, 0xbf, 0x26, 0x19,
+		0xcc, 0x90, 0x7b, 0x0d, 0x99, 0xf8, 0x2d, 0x75, 0x0f, 0x66, 0xc2, 0x8b, 0xae, 0xc0, 0x2f, 0x8a,
+		0x5f, 0x24, 0x5c, 0x74, 0xf9, 0x6f, 0x80, 0x90, 0x92, 0xfb, 0x63, 0x0a, 0x16, 0x7b, 0x97, 0xd0,
+		0x7d, 0x58, 0x3a, 0x25, 0x8e, 0xe5, 0x5d, 0x9a, 0xb5, 0x0b, 0xbb, 0xf6, 0xde, 0x6f, 0x37, 0xc3,
+		0x43, 0x58, 0x0c, 0xcc, 0x72, 0x68, 0x45, 0x6b, 0x30, 0xe3, 0xb5, 0x9d, 0x68, 0xf8, 0xce, 0xe1,
+		0x69, 0xaf, 0xcd, 0x6e, 0x29, 0x2f, 0xe0, 0xe6, 0x19, 0xf1, 0x7c, 0x36, 0xb0, 0x82, 0x64, 0x37,
+		0x6b, 0x6e, 0xb3, 0xd5, 0xb0, 0x7b, 0x2a, 0x39, 0xcb, 0x21, 0x51, 0x39, 0xc8, 0x11, 0x80, 0xd3,
+		0xe7, 0x6b, 0x9e, 0x6d, 0x75, 0xce, 0x26, 0x39, 0x94, 0x99, 0x10, 0x1f, 0xb6, 0xe1, 0x05, 0xde,
+		0x98, 0x89, 0x73, 0x3e, 0x69, 0x9a, 0xce, 0x47, 0x04, 0x2e, 0x70, 0x0b, 0x80, 0xff, 0x7a, 0xa0,
+		0xd6, 0x69, 0x23, 0x98, 0x6a, 0xb3, 0x38, 0x66, 0xc9, 0xff, 0x49, 0x80, 0xd5, 0x61, 0x33, 0x1b,
+		0xe5, 0xe0, 0x56, 0x45, 0xd1, 0x8a, 0xaa, 0xf6, 0xca, 0x94, 0x64, 0x43, 0x7d, 0xab, 0x1a, 0x27,
+		0xa6, 0x6e, 0x48, 0x86, 0x62, 0xaa, 0xda, 0x5b, 0xa9, 0xa4, 0x16, 0xc5, 0xff, 0x43, 0x5f, 0xc2,
+		0xe6, 0x08, 0x8c, 0x2e, 0x1f, 0x2a, 0xc5, 0x6a, 0x49, 0x29, 0x8a, 0xc2, 0x18, 0x25, 0xdd, 0x90,
+		0xb0, 0xa1, 0x14, 0xc5, 0x14, 0xfa, 0x7f, 0xb8, 0x3f, 0x02, 0x23, 0x4b, 0x9a, 0xac, 0x94, 0x4c,
+		0xac, 0xfc, 0xb8, 0xaa, 0xe8, 0x0c, 0x9c, 0xce, 0xff, 0xa2, 0xeb, 0x73, 0x4f, 0x07, 0x8a, 0xbf,
+		0xa9, 0xa8, 0xc8, 0xaa, 0xae, 0x96, 0xb5, 0x71, 0x3e, 0xf7, 0x61, 0x46, 0xf8, 0xdc, 0x8f, 0x8a,
+		0x7c, 0xce, 0xff, 0x32, 0xd5, 0xfd, 0xb8, 0xa0, 0xd6, 0xb1, 0xdd, 0xee, 0xf4, 0xdc, 0x2f, 0x61,
+		0xf3, 0xb8, 0x8c, 0xdf, 0x1c, 0x94, 0xca, 0xc7, 0xa6, 0x5a, 0x34, 0xb1, 0x52, 0xd5, 0x15, 0xb3,
+		0x52, 0x2e, 0xa9, 0xf2, 0x49, 0xcc, 0x93, 0x6f, 0xe1, 0x9b, 0x91, 0x28, 0xa9, 0xc4, 0xac, 0xc5,
+		0x6a, 0xa5, 0xa4, 0xca, 0xec, 0xad, 0x07, 0x92, 0x5a, 0x52, 0x8a, 0x66, 0x59, 0x2b, 0x9d, 0x88,
+		0x02, 0xfa, 0x0a, 0xb6, 0x26, 0x65, 0x8a, 0x29, 0xb4, 0x0d, 0x0f, 0x46, 0xa2, 0xb1, 0xf2, 0x5a,
+		0x91, 0x8d, 0x18, 0x3c, 0x8d, 0x76, 0x61, 0x7b, 0x24, 0xdc, 0x50, 0xf0, 0x91, 0xaa, 0xf1, 0x80,
+		0x1e, 0x98, 0xb8, 0xaa, 0x69, 0xaa, 0xf6, 0x4a, 0x9c, 0xca, 0xff, 0x5e, 0x80, 0xe5, 0x81, 0x61,
+		0x84, 0x6e, 0xc3, 0xcd, 0x8a, 0x84, 0x15, 0xcd, 0x30, 0xe5, 0x52, 0x79, 0x58, 0x00, 0x46, 0x00,
+		0xa4, 0x7d, 0x49, 0x2b, 0x96, 0x35, 0x51, 0x40, 0xf7, 0x20, 0x37, 0x0c, 0x10, 0xe6, 0x42, 0x98,
+		0x1a, 0x62, 0x0a, 0xdd, 0x81, 0xcf, 0x87, 0xe1, 0x3a, 0xde, 0x8a, 0xe9, 0xfc, 0xbf, 0x52, 0xf0,
+		0xd9, 0xb8, 0x6f, 0x18, 0x2c, 0x03, 0x3b, 0xdb, 0x56, 0xde, 0x29, 0x72, 0xd5, 0x60, 0x67, 0x1e,
+		0xe8, 0xb1, 0x93, 0xaf, 0xea, 0x31, 0xcf, 0xe3, 0x21, 0x1d, 0x01, 0x96, 0xcb, 0x47, 0x95, 0x92,
+		0x62, 0xf0, 0x6c, 0xca, 0xc3, 0xbd, 0x24, 0x78, 0x70, 0xc0, 0x62, 0xaa, 0xe7, 0x6c, 0x47, 0x49,
+		0xf3, 0x7d, 0xb3, 0x52, 0x40, 0x05, 0xc8, 0x27, 0xa1, 0x3b, 0x51, 0x28, 0x8a, 0x53, 0xe8, 0x1b,
+		0xf8, 0x3a, 0xd9, 0x71, 0xcd, 0x50, 0xb5, 0xaa, 0x52, 0x34, 0x25, 0xdd, 0xd4, 0x94, 0x63, 0x71,
+		0x7a, 0x92, 0xed, 0x1a, 0xea, 0x11, 0xcb, 0xcf, 0xaa, 0x21, 0xce, 0xe4, 0xff, 0x2a, 0xc0, 0x75,
+		0xd9, 0x75, 0x28, 0x71, 0xda, 0xb6, 0xe4, 0x6b, 0xf6, 0x47, 0x35, 0xb8, 0xe7, 0xb8, 0x1e, 0xba,
+		0x0b, 0x77, 0x22, 0xfd, 0x50, 0xde, 0x54, 0x35, 0xd5, 0x50, 0x25, 0xa3, 0x8c, 0x63, 0xf1, 0x1d,
+		0x0b, 0x63, 0x05, 0x59, 0x54, 0x70, 0x10, 0xd7, 0xd1, 0x30, 0xac, 0x18, 0xf8, 0x24, 0x4c, 0x85,
+		0xa0, 0xc3, 0x8c, 0xc6, 0xca, 0xb8, 0xac, 0x75, 0xea, 0x5f, 0x4c, 0xe7, 0xff, 0x20, 0x40, 0x26,
+		0xfc, 0x6d, 0xcb, 0x7f, 0xfa, 0x64, 0x61, 0x95, 0x6d, 0xb0, 0x5c, 0x35, 0x4c, 0xe3, 0xa4, 0xa2,
+		0xf4, 0xe6, 0x70, 0xcf, 0x0a, 0x6f, 0x0f, 0xa6, 0x51, 0x0e, 0xa2, 0x13, 0x74, 0x92, 0x5e, 0x40,
+		0xf8, 0x16, 0x86, 0xe1, 0x60, 0x31, 0x35, 0x16, 0x13, 0xe8, 0xa4, 0xd1, 0x06, 0x5c, 0xef, 0xc1,
+		0x1c, 0x2a, 0x12, 0x36, 0xf6, 0x15, 0xc9, 0x10, 0xa7, 0xf2, 0xbf, 0x13, 0xe0, 0x46, 0xd4, 0x09,
+		0xd9, 0x97, 0x05, 0xe6, 0x7a, 0xbd, 0xdc, 0xa6, 0x32, 0xfb, 0xf8, 0x81, 0x1e, 0xc0, 0xdd, 0x4e,
+		0x0f, 0x33, 0x24, 0xfd, 0x4d, 0xf7, 0xac, 0x4c, 0x59, 0xaa, 0xea, 0xf1, 0xdd, 0x24, 0x42, 0x43,
+		0x17, 0x44, 0x01, 0xdd, 0x87, 0x2f, 0xc6, 0x43, 0xb1, 0xa2, 0x2b, 0x86, 0x98, 0xca, 0xff, 0x33,
+		0x03, 0xeb, 0x71, 0xe7, 0xd8, 0x0f, 0x04, 0xbb, 0x1e, 0xb8, 0x76, 0x0f, 0x72, 0xbd, 0x22, 0x61,
+		0x9f, 0xeb, 0xf7, 0x6b, 0x17, 0xb6, 0xc7, 0xe0, 0xaa, 0xda, 0xa1, 0xa4, 0x15, 0xd9, 0x73, 0x04,
+		0x12, 0x05, 0xf4, 0x12, 0xf6, 0xc6, 0x50, 0xf6, 0xa5, 0x62, 0x37, 0xca, 0x9d, 0x89, 0x23, 0x19,
+		0x06, 0x56, 0xf7, 0xab, 0x86, 0xa2, 0x8b, 0x29, 0xa4, 0x80, 0x94, 0x20, 0xd0, 0xdb, 0x87, 0x86,
+		0xca, 0xa4, 0xd1, 0x33, 0x78, 0x9c, 0xe4, 0x47, 0x90, 0x32, 0xea, 0x91, 0x82, 0xe3, 0xd4, 0x29,
+		0xf4, 0x1c, 0x9e, 0x24, 0x50, 0xc3, 0x37, 0x0f, 0x70, 0xa7, 0xd1, 0x1e, 0x3c, 0x4d, 0xf4, 0x5e,
+		0x2e, 0xe3, 0xa2, 0x79, 0x24, 0xe1, 0x37, 0xbd, 0xe4, 0x19, 0xa4, 0x82, 0x92, 0xf4, 0xe2, 0xb0,
+		0xbb, 0x99, 0x43, 0xfa, 0x42, 0x4c, 0xea, 0xda, 0x04, 0x51, 0x64, 0x86, 0x04, 0x99, 0x59, 0xf4,
+		0x0a, 0xe4, 0xc9, 0x42, 0x31, 0x5e, 0x68, 0x0e, 0xbd, 0x03, 0xe3, 0x6a, 0xa7, 0xaa, 0xbc, 0x33,
+		0x14, 0xac, 0x49, 0x49, 0xca, 0x80, 0x5e, 0xc0, 0xb3, 0xc4, 0xa0, 0xf5, 0xf6, 0x9f, 0x18, 0x3d,
+		0x83, 0x9e, 0xc2, 0xa3, 0x31, 0xf4, 0x78, 0x8e, 0x74, 0x6f, 0x05, 0x6a, 0x51, 0x9c, 0x47, 0x8f,
+		0x61, 0x77, 0x0c, 0x91, 0x57, 0xa1, 0xa9, 0x1b, 0xaa, 0xfc, 0xe6, 0x24, 0x58, 0x2e, 0xa9, 0xba,
+		0x21, 0x2e, 0xa0, 0x1f, 0xc1, 0x0f, 0xc6, 0xd0, 0x3a, 0x9b, 0x65, 0x7f, 0x28, 0x38, 0x56, 0x62,
+		0x0c, 0x56, 0xc5, 0x8a, 0xb8, 0x38, 0xc1, 0x99, 0xe8, 0xea, 0xab, 0xe4, 0xc8, 0x2d, 0x21, 0x19,
+		0x5e, 0x4e, 0x54, 0x22, 0xf2, 0xa1, 0x5a, 0x2a, 0x0e, 0x17, 0x11, 0xd1, 0x23, 0xd8, 0x19, 0x23,
+		0x72, 0x50, 0xc6, 0xb2, 0x12, 0x4e, 0xac, 0x4e, 0x93, 0x58, 0x46, 0x4f, 0xe0, 0xe1, 0x38, 0x92,
+		0xa4, 0x96, 0xca, 0x6f, 0x15, 0xdc, 0xcf, 0x43, 0x6c, 0x8c, 0x4e, 0xb6, 0x75, 0x55, 0xab, 0x54,
+		0x0d, 0x53, 0x57, 0xbf, 0x53, 0xc4, 0x15, 0x36, 0x46, 0x13, 0x4f, 0x2a, 0x8a, 0x95, 0xb8, 0x3a,
+		0xd8, 0x8c, 0x07, 0x5e, 0xb2, 0xaf, 0x6a, 0x12, 0x3e, 0x11, 0xd7, 0x12, 0x72, 0x6f, 0xb0, 0xd1,
+		0xf5, 0xa4, 0xd0, 0xf5, 0x49, 0xb6, 0xa3, 0x48, 0x58, 0x3e, 0x8c, 0x47, 0x7c, 0x9d, 0x4d, 0x9d,
+		0x3b, 0xfc, 0x83, 0xcb, 0xc0, 0xbd, 0x2a, 0xde, 0xe2, 0x77, 0x61, 0x3b, 0x38, 0xb7, 0x21, 0x59,
+		0x30, 0xa2, 0xdb, 0xef, 0xc3, 0x0f, 0x27, 0xa3, 0x74, 0xd6, 0xa5, 0x12, 0x56, 0xa4, 0xe2, 0x49,
+		0xe7, 0x4a, 0x2a, 0xe4, 0x7f, 0x9d, 0x82, 0xbc, 0x6c, 0x39, 0x35, 0xbb, 0x11, 0x7d, 0xc7, 0x1d,
+		0xeb, 0xe5, 0x1e, 0x3c, 0x9d, 0xa0, 0xde, 0x47, 0xf8, 0x7b, 0x0c, 0xfa, 0x55, 0xc9, 0x55, 0xed,
+		0x8d, 0x56, 0x3e, 0xd6, 0xc6, 0x11, 0x44, 0x01, 0x69, 0xf0, 0xfa, 0xaa, 0xc2, 0x03, 0x21, 0xe9,
+		0xde, 0x43, 0x53, 0x3c, 0x28, 0x3a, 0x39, 0x77, 0xac, 0x89, 0x83, 0x12, 0xa6, 0xf1, 0x7f, 0x16,
+		0x94, 0xab, 0x92, 0x27, 0x0e, 0xca, 0x55, 0x85, 0xc7, 0x05, 0x65, 0xff, 0xa7, 0xb0, 0x5e, 0x73,
+		0x9b, 0xc3, 0xbe, 0x32, 0xec, 0x2f, 0x44, 0xe1, 0xa9, 0xb0, 0x9f, 0xd9, 0x15, 0xe1, 0xbb, 0xdd,
+		0x73, 0x42, 0x2f, 0xda, 0xa7, 0x85, 0x9a, 0xdb, 0xdc, 0x89, 0xff, 0xfb, 0x79, 0x9b, 0xd4, 0x1b,
+		0x3b, 0xe7, 0x6e, 0xf0, 0xef, 0xec, 0xf0, 0x7f, 0xd1, 0x7b, 0x56, 0x8b, 0x7c, 0xd8, 0x3d, 0x9d,
+		0xe1, 0xb6, 0x47, 0xff, 0x1e, 0x00, 0x05, 0x96, 0x05, 0xc9, 0x4b, 0x1f, 0x00, 0x00,
+	},
+}
@@ -0,0 +1,3133 @@
+// The MIT License (MIT)
+// 
+// Copyright (c) 2021 Uber Technologies, Inc.
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: uber/cadence/api/v1/schedule.proto
+
+package apiv1
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ScheduleOverlapPolicy int32
+
+const (
+	ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_INVALID ScheduleOverlapPolicy = 0
+	// SCHEDULE_OVERLAP_POLICY_SKIP does not start a new run while a previous run is open.
+	ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP ScheduleOverlapPolicy = 1
+	// SCHEDULE_OVERLAP_POLICY_BUFFER starts the new run once the previous runs have closed.
+	ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_BUFFER ScheduleOverlapPolicy = 2
+	// SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER requests cancellation of the open runs and starts the new run.
+	ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER ScheduleOverlapPolicy = 3
+	// SCHEDULE_OVERLAP_POLICY_ALLOW_ALL starts the new run regardless of open runs.
+	ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_ALLOW_ALL ScheduleOverlapPolicy = 4
+)
+
+var ScheduleOverlapPolicy_name = map[int32]string{
+	0: "SCHEDULE_OVERLAP_POLICY_INVALID",
+	1: "SCHEDULE_OVERLAP_POLICY_SKIP",
+	2: "SCHEDULE_OVERLAP_POLICY_BUFFER",
+	3: "SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER",
+	4: "SCHEDULE_OVERLAP_POLICY_ALLOW_ALL",
+}
+
+var ScheduleOverlapPolicy_value = map[string]int32{
+	"SCHEDULE_OVERLAP_POLICY_INVALID":      0,
+	"SCHEDULE_OVERLAP_POLICY_SKIP":         1,
+	"SCHEDULE_OVERLAP_POLICY_BUFFER":       2,
+	"SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER": 3,
+	"SCHEDULE_OVERLAP_POLICY_ALLOW_ALL":    4,
+}
+
+func (x ScheduleOverlapPolicy) String() string {
+	return proto.EnumName(ScheduleOverlapPolicy_name, int32(x))
+}
+
+func (ScheduleOverlapPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7fe71309dc9555dc, []int{0}
+}
+
+// ScheduleCalendarSpec matches times by calendar fields. Each field takes the syntax of the
+// corresponding cron field and an empty field matches every value.
+type ScheduleCalendarSpec struct {
+	Second               string   `protobuf:"bytes,1,opt,name=second,proto3" json:"second,omitempty"`
+	Minute               string   `protobuf:"bytes,2,opt,name=minute,proto3" json:"minute,omitempty"`
+	Hour                 string   `protobuf:"bytes,3,opt,name=hour,proto3" json:"hour,omitempty"`
+	DayOfMonth           string   `protobuf:"bytes,4,opt,name=day_of_month,json=dayOfMonth,proto3" json:"day_of_month,omitempty"`
+	Month                string   `protobuf:"bytes,5,opt,name=month,proto3" json:"month,omitempty"`
+	DayOfWeek            string   `protobuf:"bytes,6,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScheduleCalendarSpec) Reset()         { *m = ScheduleCalendarSpec{} }
+func (m *ScheduleCalendarSpec) String() string { return proto.CompactTextString(m) }
+func (*ScheduleCalendarSpec) ProtoMessage()    {}
+func (*ScheduleCalendarSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fe71309dc9555dc, []int{0}
+}
+func (m *ScheduleCalendarSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleCalendarSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleCalendarSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleCalendarSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleCalendarSpec.Merge(m, src)
+}
+func (m *ScheduleCalendarSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleCalendarSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleCalendarSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleCalendarSpec proto.InternalMessageInfo
+
+func (m *ScheduleCalendarSpec) GetSecond() string {
+	if m != nil {
+		return m.Second
+	}
+	return ""
+}
+
+func (m *ScheduleCalendarSpec) GetMinute() string {
+	if m != nil {
+		return m.Minute
+	}
+	return ""
+}
+
+func (m *ScheduleCalendarSpec) GetHour() string {
+	if m != nil {
+		return m.Hour
+	}
+	return ""
+}
+
+func (m *ScheduleCalendarSpec) GetDayOfMonth() string {
+	if m != nil {
+		return m.DayOfMonth
+	}
+	return ""
+}
+
+func (m *ScheduleCalendarSpec) GetMonth() string {
+	if m != nil {
+		return m.Month
+	}
+	return ""
+}
+
+func (m *ScheduleCalendarSpec) GetDayOfWeek() string {
+	if m != nil {
+		return m.DayOfWeek
+	}
+	return ""
+}
+
+// ScheduleSpec describes the times a schedule fires at.
+type ScheduleSpec struct {
+	CronExpression       string                  `protobuf:"bytes,1,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	Calendars            []*ScheduleCalendarSpec `protobuf:"bytes,2,rep,name=calendars,proto3" json:"calendars,omitempty"`
+	Interval             *types.Duration         `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	StartTime            *types.Timestamp        `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              *types.Timestamp        `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Jitter               *types.Duration         `protobuf:"bytes,6,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ScheduleSpec) Reset()         { *m = ScheduleSpec{} }
+func (m *ScheduleSpec) String() string { return proto.CompactTextString(m) }
+func (*ScheduleSpec) ProtoMessage()    {}
+func (*ScheduleSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fe71309dc9555dc, []int{1}
+}
+func (m *ScheduleSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleSpec.Merge(m, src)
+}
+func (m *ScheduleSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleSpec proto.InternalMessageInfo
+
+func (m *ScheduleSpec) GetCronExpression() string {
+	if m != nil {
+		return m.CronExpression
+	}
+	return ""
+}
+
+func (m *ScheduleSpec) GetCalendars() []*ScheduleCalendarSpec {
+	if m != nil {
+		return m.Calendars
+	}
+	return nil
+}
+
+func (m *ScheduleSpec) GetInterval() *types.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *ScheduleSpec) GetStartTime() *types.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *ScheduleSpec) GetEndTime() *types.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *ScheduleSpec) GetJitter() *types.Duration {
+	if m != nil {
+		return m.Jitter
+	}
+	return nil
+}
+
+// ScheduleStartWorkflowAction is the workflow started every time a schedule fires.
+type ScheduleStartWorkflowAction struct {
+	WorkflowType                 *WorkflowType   `protobuf:"bytes,1,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	TaskList                     *TaskList       `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	WorkflowIdPrefix             string          `protobuf:"bytes,3,opt,name=workflow_id_prefix,json=workflowIdPrefix,proto3" json:"workflow_id_prefix,omitempty"`
+	Input                        *Payload        `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	ExecutionStartToCloseTimeout *types.Duration `protobuf:"bytes,5,opt,name=execution_start_to_close_timeout,json=executionStartToCloseTimeout,proto3" json:"execution_start_to_close_timeout,omitempty"`
+	TaskStartToCloseTimeout      *types.Duration `protobuf:"bytes,6,opt,name=task_start_to_close_timeout,json=taskStartToCloseTimeout,proto3" json:"task_start_to_close_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}        `json:"-"`
+	XXX_unrecognized             []byte          `json:"-"`
+	XXX_sizecache                int32           `json:"-"`
+}
+
+func (m *ScheduleStartWorkflowAction) Reset()         { *m = ScheduleStartWorkflowAction{} }
+func (m *ScheduleStartWorkflowAction) String() string { return proto.CompactTextString(m) }
+func (*ScheduleStartWorkflowAction) ProtoMessage()    {}
+func (*ScheduleStartWorkflowAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fe71309dc9555dc, []int{2}
+}
+func (m *ScheduleStartWorkflowAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleStartWorkflowAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleStartWorkflowAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleStartWorkflowAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleStartWorkflowAction.Merge(m, src)
+}
+func (m *ScheduleStartWorkflowAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleStartWorkflowAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleStartWorkflowAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleStartWorkflowAction proto.InternalMessageInfo
+
+func (m *ScheduleStartWorkflowAction) GetWorkflowType() *WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
+func (m *ScheduleStartWorkflowAction) GetTaskList() *TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *ScheduleStartWorkflowAction) GetWorkflowIdPrefix() string {
+	if m != nil {
+		return m.WorkflowIdPrefix
+	}
+	return ""
+}
+
+func (m *ScheduleStartWorkflowAction) GetInput() *Payload {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *ScheduleStartWorkflowAction) GetExecutionStartToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.ExecutionStartToCloseTimeout
+	}
+	return nil
+}
+
+func (m *ScheduleStartWorkflowAction) GetTaskStartToCloseTimeout() *types.Duration {
+	if m != nil {
+		return m.TaskStartToCloseTimeout
+	}
+	return nil
+}
+
+type SchedulePolicies struct {
+	OverlapPolicy        ScheduleOverlapPolicy `protobuf:"varint,1,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=uber.cadence.api.v1.ScheduleOverlapPolicy" json:"overlap_policy,omitempty"`
+	CatchUpWindow        *types.Duration       `protobuf:"bytes,2,opt,name=catch_up_window,json=catchUpWindow,proto3" json:"catch_up_window,omitempty"`
+	BufferLimit          int32                 `protobuf:"varint,3,opt,name=buffer_limit,json=bufferLimit,proto3" json:"buffer_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SchedulePolicies) Reset()         { *m = SchedulePolicies{} }
+func (m *SchedulePolicies) String() string { return proto.CompactTextString(m) }
+func (*SchedulePolicies) ProtoMessage()    {}
+func (*SchedulePolicies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fe71309dc9555dc, []int{3}
+}
+func (m *SchedulePolicies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulePolicies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchedulePolicies.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchedulePolicies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulePolicies.Merge(m, src)
+}
+func (m *SchedulePolicies) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulePolicies) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulePolicies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulePolicies proto.InternalMessageInfo
+
+func (m *SchedulePolicies) GetOverlapPolicy() ScheduleOverlapPolicy {
+	if m != nil {
+		return m.OverlapPolicy
+	}
+	return ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_INVALID
+}
+
+func (m *SchedulePolicies) GetCatchUpWindow() *types.Duration {
+	if m != nil {
+		return m.CatchUpWindow
+	}
+	return nil
+}
+
+func (m *SchedulePolicies) GetBufferLimit() int32 {
+	if m != nil {
+		return m.BufferLimit
+	}
+	return 0
+}
+
+type ScheduleRun struct {
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,1,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	NominalTime          *types.Timestamp   `protobuf:"bytes,2,opt,name=nominal_time,json=nominalTime,proto3" json:"nominal_time,omitempty"`
+	ActualTime           *types.Timestamp   `protobuf:"bytes,3,opt,name=actual_time,json=actualTime,proto3" json:"actual_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ScheduleRun) Reset()         { *m = ScheduleRun{} }
+func (m *ScheduleRun) String() string { return proto.CompactTextString(m) }
+func (*ScheduleRun) ProtoMessage()    {}
+func (*ScheduleRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fe71309dc9555dc, []int{4}
+}
+func (m *ScheduleRun) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleRun.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleRun.Merge(m, src)
+}
+func (m *ScheduleRun) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleRun.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleRun proto.InternalMessageInfo
+
+func (m *ScheduleRun) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *ScheduleRun) GetNominalTime() *types.Timestamp {
+	if m != nil {
+		return m.NominalTime
+	}
+	return nil
+}
+
+func (m *ScheduleRun) GetActualTime() *types.Timestamp {
+	if m != nil {
+		return m.ActualTime
+	}
+	return nil
+}
+
+type ScheduleState struct {
+	Paused               bool             `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	Note                 string           `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	LastProcessedTime    *types.Timestamp `protobuf:"bytes,3,opt,name=last_processed_time,json=lastProcessedTime,proto3" json:"last_processed_time,omitempty"`
+	RunningRuns          []*ScheduleRun   `protobuf:"bytes,4,rep,name=running_runs,json=runningRuns,proto3" json:"running_runs,omitempty"`
+	LastRun              *ScheduleRun     `protobuf:"bytes,5,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	BufferedRuns         int32            `protobuf:"varint,6,opt,name=buffered_runs,json=bufferedRuns,proto3" json:"buffered_runs,omitempty"`
+	PendingBackfills     int32            `protobuf:"varint,7,opt,name=pending_backfills,json=pendingBackfills,proto3" json:"pending_backfills,omitempty"`
+	TotalRuns            int64            `protobuf:"varint,8,opt,name=total_runs,json=totalRuns,proto3" json:"total_runs,omitempty"`
+	SkippedRuns          int64            `protobuf:"varint,9,opt,name=skipped_runs,json=skippedRuns,proto3" json:"skipped_runs,omitempty"`
+	MissedRuns           int64            `protobuf:"varint,10,opt,name=missed_runs,json=missedRuns,proto3" json:"missed_runs,omitempty"`
+	FailedStarts         int64            `protobuf:"varint,11,opt,name=failed_starts,json=failedStarts,proto3" json:"failed_starts,omitempty"`
+	BufferOverruns       int64            `protobuf:"varint,12,opt,name=buffer_overruns,json=bufferOverruns,proto3" json:"buffer_overruns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ScheduleState) Reset()         { *m = ScheduleState{} }
+func (m *ScheduleState) String() string { return proto.CompactTextString(m) }
+func (*ScheduleState) ProtoMessage()    {}
+func (*ScheduleState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fe71309dc9555dc, []int{5}
+}
+func (m *ScheduleState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleState.Merge(m, src)
+}
+func (m *ScheduleState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleState proto.InternalMessageInfo
+
+func (m *ScheduleState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *ScheduleState) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *ScheduleState) GetLastProcessedTime() *types.Timestamp {
+	if m != nil {
+		return m.LastProcessedTime
+	}
+	return nil
+}
+
+func (m *ScheduleState) GetRunningRuns() []*ScheduleRun {
+	if m != nil {
+		return m.RunningRuns
+	}
+	return nil
+}
+
+func (m *ScheduleState) GetLastRun() *ScheduleRun {
+	if m != nil {
+		return m.LastRun
+	}
+	return nil
+}
+
+func (m *ScheduleState) GetBufferedRuns() int32 {
+	if m != nil {
+		return m.BufferedRuns
+	}
+	return 0
+}
+
+func (m *ScheduleState) GetPendingBackfills() int32 {
+	if m != nil {
+		return m.PendingBackfills
+	}
+	return 0
+}
+
+func (m *ScheduleState) GetTotalRuns() int64 {
+	if m != nil {
+		return m.TotalRuns
+	}
+	return 0
+}
+
+func (m *ScheduleState) GetSkippedRuns() int64 {
+	if m != nil {
+		return m.SkippedRuns
+	}
+	return 0
+}
+
+func (m *ScheduleState) GetMissedRuns() int64 {
+	if m != nil {
+		return m.MissedRuns
+	}
+	return 0
+}
+
+func (m *ScheduleState) GetFailedStarts() int64 {
+	if m != nil {
+		return m.FailedStarts
+	}
+	return 0
+}
+
+func (m *ScheduleState) GetBufferOverruns() int64 {
+	if m != nil {
+		return m.BufferOverruns
+	}
+	return 0
+}
+
+type ScheduleListEntry struct {
+	ScheduleId           string             `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ScheduleListEntry) Reset()         { *m = ScheduleListEntry{} }
+func (m *ScheduleListEntry) String() string { return proto.CompactTextString(m) }
+func (*ScheduleListEntry) ProtoMessage()    {}
+func (*ScheduleListEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fe71309dc9555dc, []int{6}
+}
+func (m *ScheduleListEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleListEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleListEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleListEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleListEntry.Merge(m, src)
+}
+func (m *ScheduleListEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleListEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleListEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleListEntry proto.InternalMessageInfo
+
+func (m *ScheduleListEntry) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *ScheduleListEntry) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("uber.cadence.api.v1.ScheduleOverlapPolicy", ScheduleOverlapPolicy_name, ScheduleOverlapPolicy_value)
+	proto.RegisterType((*ScheduleCalendarSpec)(nil), "uber.cadence.api.v1.ScheduleCalendarSpec")
+	proto.RegisterType((*ScheduleSpec)(nil), "uber.cadence.api.v1.ScheduleSpec")
+	proto.RegisterType((*ScheduleStartWorkflowAction)(nil), "uber.cadence.api.v1.ScheduleStartWorkflowAction")
+	proto.RegisterType((*SchedulePolicies)(nil), "uber.cadence.api.v1.SchedulePolicies")
+	proto.RegisterType((*ScheduleRun)(nil), "uber.cadence.api.v1.ScheduleRun")
+	proto.RegisterType((*ScheduleState)(nil), "uber.cadence.api.v1.ScheduleState")
+	proto.RegisterType((*ScheduleListEntry)(nil), "uber.cadence.api.v1.ScheduleListEntry")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/api/v1/schedule.proto", fileDescriptor_7fe71309dc9555dc)
+}
+
+var fileDescriptor_7fe71309dc9555dc = []byte{
+	// 1124 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdf, 0x92, 0x1a, 0xc5,
+	0x17, 0xfe, 0x0d, 0x2c, 0x64, 0x39, 0x03, 0x1b, 0xb6, 0x93, 0x9f, 0x19, 0xf3, 0x87, 0xb0, 0xc4,
+	0x3f, 0x6b, 0x54, 0xa8, 0xc5, 0xf2, 0x42, 0x53, 0x5e, 0xb0, 0x84, 0x18, 0x14, 0x03, 0xce, 0xb2,
+	0xd9, 0xd2, 0x9b, 0xa9, 0x66, 0xa6, 0xd9, 0x6d, 0x19, 0xba, 0xa7, 0xa6, 0x7b, 0x96, 0xf0, 0x0c,
+	0xfa, 0x34, 0xbe, 0x80, 0xb7, 0x56, 0x79, 0x63, 0x95, 0xb7, 0x5e, 0xa4, 0xf2, 0x24, 0x56, 0xf7,
+	0xf4, 0xe0, 0x56, 0x84, 0x60, 0x95, 0x37, 0xd4, 0xf4, 0xd7, 0xdf, 0x77, 0xfa, 0xcc, 0xf9, 0xce,
+	0x69, 0x06, 0x1a, 0xc9, 0x84, 0xc4, 0x2d, 0x1f, 0x07, 0x84, 0xf9, 0xa4, 0x85, 0x23, 0xda, 0xba,
+	0x3c, 0x6a, 0x09, 0xff, 0x82, 0x04, 0x49, 0x48, 0x9a, 0x51, 0xcc, 0x25, 0x47, 0x37, 0x14, 0xa7,
+	0x69, 0x38, 0x4d, 0x1c, 0xd1, 0xe6, 0xe5, 0xd1, 0xed, 0xda, 0x39, 0xe7, 0xe7, 0x21, 0x69, 0x69,
+	0xca, 0x24, 0x99, 0xb6, 0x82, 0x24, 0xc6, 0x92, 0x72, 0x96, 0x8a, 0x6e, 0xdf, 0x7f, 0x7d, 0x5f,
+	0xd2, 0x39, 0x11, 0x12, 0xcf, 0x23, 0x43, 0xa8, 0xaf, 0x3b, 0xd9, 0xe7, 0xf3, 0xf9, 0x2a, 0xc4,
+	0xda, 0xdc, 0x24, 0x16, 0xb3, 0x90, 0x0a, 0x99, 0x72, 0x1a, 0x3f, 0x5b, 0x70, 0xf3, 0xc4, 0xa4,
+	0xdb, 0xc5, 0x21, 0x61, 0x01, 0x8e, 0x4f, 0x22, 0xe2, 0xa3, 0xb7, 0xa0, 0x28, 0x88, 0xcf, 0x59,
+	0xe0, 0x58, 0x75, 0xeb, 0xb0, 0xe4, 0x9a, 0x95, 0xc2, 0xe7, 0x94, 0x25, 0x92, 0x38, 0xb9, 0x14,
+	0x4f, 0x57, 0x08, 0xc1, 0xce, 0x05, 0x4f, 0x62, 0x27, 0xaf, 0x51, 0xfd, 0x8c, 0xea, 0x50, 0x0e,
+	0xf0, 0xd2, 0xe3, 0x53, 0x6f, 0xce, 0x99, 0xbc, 0x70, 0x76, 0xf4, 0x1e, 0x04, 0x78, 0x39, 0x9c,
+	0x7e, 0xa3, 0x10, 0x74, 0x13, 0x0a, 0xe9, 0x56, 0x41, 0x6f, 0xa5, 0x0b, 0x54, 0x03, 0xdb, 0xe8,
+	0x16, 0x84, 0xcc, 0x9c, 0xa2, 0xde, 0x2b, 0x69, 0xd9, 0x19, 0x21, 0xb3, 0xc6, 0x9f, 0x39, 0x28,
+	0x67, 0x49, 0xeb, 0x64, 0xdf, 0x87, 0xeb, 0x7e, 0xcc, 0x99, 0x47, 0x5e, 0x44, 0x31, 0x11, 0x82,
+	0x72, 0x66, 0xb2, 0xde, 0x53, 0x70, 0x6f, 0x85, 0xa2, 0x2f, 0xa1, 0xe4, 0x9b, 0xb7, 0x14, 0x4e,
+	0xae, 0x9e, 0x3f, 0xb4, 0xdb, 0x1f, 0x34, 0xd7, 0xd8, 0xd3, 0x5c, 0x57, 0x13, 0xf7, 0x6f, 0x2d,
+	0xfa, 0x14, 0x76, 0x29, 0x93, 0x24, 0xbe, 0xc4, 0xa1, 0x7e, 0x65, 0xbb, 0xfd, 0x76, 0x33, 0x75,
+	0xac, 0x99, 0x39, 0xd6, 0x7c, 0x6c, 0x1c, 0x75, 0x57, 0x54, 0xf4, 0x19, 0x80, 0x90, 0x38, 0x96,
+	0x9e, 0x72, 0x53, 0xd7, 0xc3, 0x6e, 0xdf, 0xfe, 0x87, 0x70, 0x9c, 0x59, 0xed, 0x96, 0x34, 0x5b,
+	0xad, 0xd5, 0x89, 0x84, 0x05, 0xa9, 0xb0, 0xb0, 0x55, 0x78, 0x8d, 0xb0, 0x40, 0xcb, 0x8e, 0xa0,
+	0xf8, 0x03, 0x95, 0x92, 0xc4, 0x4e, 0x71, 0x5b, 0x9a, 0x86, 0xd8, 0xf8, 0x25, 0x0f, 0x77, 0x56,
+	0xe5, 0x55, 0xe7, 0x9f, 0xf1, 0x78, 0x36, 0x0d, 0xf9, 0xa2, 0xe3, 0x2b, 0x1e, 0x7a, 0x02, 0x95,
+	0x85, 0x41, 0x3c, 0xb9, 0x8c, 0x88, 0xae, 0xb5, 0xdd, 0x3e, 0x58, 0x5b, 0xc8, 0x4c, 0x3b, 0x5e,
+	0x46, 0xc4, 0x2d, 0x2f, 0xae, 0xac, 0xd0, 0xe7, 0x50, 0x52, 0xdd, 0xe8, 0xa9, 0x76, 0xd4, 0xdd,
+	0x64, 0xb7, 0xef, 0xad, 0x8d, 0x31, 0xc6, 0x62, 0x36, 0xa0, 0x42, 0xba, 0xbb, 0xd2, 0x3c, 0xa1,
+	0x8f, 0x00, 0xad, 0x72, 0xa0, 0x81, 0x17, 0xc5, 0x64, 0x4a, 0x5f, 0x98, 0xe6, 0xab, 0x66, 0x3b,
+	0xfd, 0x60, 0xa4, 0x71, 0xd4, 0x86, 0x02, 0x65, 0x51, 0x22, 0x4d, 0xc5, 0xef, 0xae, 0x3d, 0x65,
+	0x84, 0x97, 0x21, 0xc7, 0x81, 0x9b, 0x52, 0x11, 0x86, 0x3a, 0x79, 0x41, 0xfc, 0x44, 0xbd, 0xb2,
+	0x67, 0x4c, 0xe3, 0x9e, 0x1f, 0x72, 0x41, 0xb4, 0x09, 0x3c, 0x91, 0x4e, 0x61, 0x5b, 0x49, 0xef,
+	0xae, 0x42, 0xe8, 0x42, 0x8e, 0x79, 0x57, 0xe9, 0xc7, 0xa9, 0x1c, 0x9d, 0xc1, 0x1d, 0x5d, 0x80,
+	0x0d, 0xd1, 0xb7, 0x1a, 0x76, 0x4b, 0xa9, 0xd7, 0x04, 0x6e, 0xfc, 0x66, 0x41, 0x35, 0x73, 0x70,
+	0xc4, 0x43, 0xea, 0x53, 0x22, 0xd0, 0xb7, 0xb0, 0xc7, 0x2f, 0x49, 0x1c, 0xe2, 0xc8, 0x8b, 0x14,
+	0xb6, 0xd4, 0xbe, 0xed, 0xb5, 0x1f, 0xbe, 0x71, 0x00, 0x86, 0xa9, 0x44, 0x47, 0x59, 0xba, 0x15,
+	0x7e, 0x75, 0x89, 0x3a, 0x70, 0xdd, 0xc7, 0xd2, 0xbf, 0xf0, 0x92, 0xc8, 0x5b, 0x50, 0x16, 0xf0,
+	0x85, 0x93, 0xdb, 0x96, 0x74, 0x45, 0x2b, 0x4e, 0xa3, 0x33, 0xcd, 0x47, 0x07, 0x50, 0x9e, 0x24,
+	0xd3, 0x29, 0x89, 0xbd, 0x90, 0xce, 0xa9, 0xd4, 0x16, 0x16, 0x5c, 0x3b, 0xc5, 0x06, 0x0a, 0x6a,
+	0xbc, 0xb4, 0xc0, 0xce, 0xd2, 0x71, 0x13, 0x86, 0x4e, 0xaf, 0x78, 0xbf, 0xaa, 0xaf, 0x69, 0xc2,
+	0xf7, 0xde, 0xd8, 0x84, 0xbd, 0x8c, 0xed, 0xee, 0x2f, 0x5e, 0x87, 0xd0, 0x17, 0x50, 0x66, 0x7c,
+	0x4e, 0x19, 0x0e, 0xd3, 0x21, 0xcb, 0x6d, 0x1d, 0x32, 0xdb, 0xf0, 0xf5, 0xa0, 0x3d, 0x02, 0x1b,
+	0xfb, 0x32, 0xc9, 0xd4, 0xf9, 0xad, 0x6a, 0x48, 0xe9, 0x0a, 0x68, 0xfc, 0xb4, 0x03, 0x95, 0x2b,
+	0x23, 0x27, 0x89, 0xba, 0x67, 0x23, 0x9c, 0x08, 0x92, 0xde, 0xbf, 0xbb, 0xae, 0x59, 0xa9, 0x7b,
+	0x96, 0xf1, 0xd5, 0xed, 0xab, 0x9f, 0xd1, 0x57, 0x70, 0x23, 0xc4, 0x42, 0x7a, 0x51, 0xcc, 0x7d,
+	0x22, 0x04, 0x09, 0xfe, 0x6d, 0x0a, 0xfb, 0x4a, 0x36, 0xca, 0x54, 0xfa, 0x35, 0xba, 0x50, 0x8e,
+	0x13, 0xc6, 0x28, 0x3b, 0xf7, 0xe2, 0x84, 0x09, 0x67, 0x47, 0x5f, 0x92, 0xf5, 0x37, 0xf6, 0x88,
+	0x9b, 0x30, 0xd7, 0x36, 0x2a, 0x37, 0x61, 0x02, 0x3d, 0x82, 0x5d, 0x9d, 0x50, 0x9c, 0x30, 0x33,
+	0x23, 0xdb, 0x03, 0x5c, 0x53, 0x0a, 0x65, 0xef, 0x03, 0xa8, 0xa4, 0xee, 0x93, 0x20, 0x4d, 0xa1,
+	0xa8, 0x5b, 0xa2, 0x9c, 0x81, 0xfa, 0x84, 0x0f, 0x61, 0x3f, 0x22, 0x2c, 0x50, 0x69, 0x4e, 0xb0,
+	0x3f, 0x9b, 0xd2, 0x30, 0x14, 0xce, 0x35, 0x4d, 0xac, 0x9a, 0x8d, 0xe3, 0x0c, 0x47, 0xf7, 0x00,
+	0x24, 0x97, 0x38, 0x4c, 0xc3, 0xed, 0xd6, 0xad, 0xc3, 0xbc, 0x5b, 0xd2, 0x88, 0x8e, 0x75, 0x00,
+	0x65, 0x31, 0xa3, 0x51, 0x94, 0x9d, 0x57, 0xd2, 0x04, 0xdb, 0x60, 0x9a, 0x72, 0x1f, 0xec, 0x39,
+	0x15, 0x22, 0x63, 0x80, 0x66, 0x40, 0x0a, 0x69, 0xc2, 0x03, 0xa8, 0x4c, 0x31, 0x0d, 0x49, 0x90,
+	0x0e, 0xb3, 0x70, 0x6c, 0x4d, 0x29, 0xa7, 0xa0, 0x9e, 0x51, 0xa1, 0xfe, 0xa6, 0x4c, 0xaf, 0xab,
+	0x31, 0xd2, 0x91, 0xca, 0x9a, 0xb6, 0x97, 0xc2, 0x43, 0x83, 0x36, 0x7e, 0xb4, 0x60, 0x3f, 0xab,
+	0x8d, 0xba, 0xee, 0x7a, 0x4c, 0xc6, 0x4b, 0x95, 0x44, 0xf6, 0x65, 0xe1, 0xd1, 0xec, 0x7f, 0x19,
+	0x32, 0xa8, 0x1f, 0x6c, 0x18, 0x8c, 0xdc, 0x7f, 0x1c, 0x8c, 0x87, 0x7f, 0x58, 0xf0, 0xff, 0xb5,
+	0xd7, 0x01, 0x7a, 0x00, 0xf7, 0x4f, 0xba, 0x4f, 0x7b, 0x8f, 0x4f, 0x07, 0x3d, 0x6f, 0xf8, 0xbc,
+	0xe7, 0x0e, 0x3a, 0x23, 0x6f, 0x34, 0x1c, 0xf4, 0xbb, 0xdf, 0x79, 0xfd, 0x67, 0xcf, 0x3b, 0x83,
+	0xfe, 0xe3, 0xea, 0xff, 0x50, 0x1d, 0xee, 0x6e, 0x22, 0x9d, 0x7c, 0xdd, 0x1f, 0x55, 0x2d, 0xd4,
+	0x80, 0xda, 0x26, 0xc6, 0xf1, 0xe9, 0x93, 0x27, 0x3d, 0xb7, 0x9a, 0x43, 0x87, 0xf0, 0xce, 0x26,
+	0x4e, 0xb7, 0xf3, 0xac, 0xdb, 0x1b, 0x78, 0xc3, 0xf1, 0xd3, 0x9e, 0x5b, 0xcd, 0xa3, 0x77, 0xe1,
+	0x60, 0x13, 0xb3, 0x33, 0x18, 0x0c, 0xcf, 0xd4, 0x6f, 0x75, 0xe7, 0x98, 0xfe, 0xfa, 0xaa, 0x66,
+	0xfd, 0xfe, 0xaa, 0x66, 0xbd, 0x7c, 0x55, 0xb3, 0xe0, 0x96, 0xcf, 0xe7, 0xeb, 0x2a, 0x74, 0xbc,
+	0x1a, 0xcb, 0x91, 0x1a, 0x9f, 0x91, 0xf5, 0xfd, 0xd1, 0x39, 0x95, 0x17, 0xc9, 0xa4, 0xe9, 0xf3,
+	0x79, 0xeb, 0xea, 0x07, 0xd6, 0xc7, 0x34, 0x08, 0x5b, 0xe7, 0x3c, 0xfd, 0x60, 0x33, 0x5f, 0x5b,
+	0x8f, 0x70, 0x44, 0x2f, 0x8f, 0x26, 0x45, 0x8d, 0x7d, 0xf2, 0xd7, 0x00, 0xe9, 0x53, 0x03, 0xcb,
+	0x2d, 0x0a, 0x00, 0x00,
+}
+
+func (m *ScheduleCalendarSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleCalendarSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleCalendarSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DayOfWeek) > 0 {
+		i -= len(m.DayOfWeek)
+		copy(dAtA[i:], m.DayOfWeek)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.DayOfWeek)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Month) > 0 {
+		i -= len(m.Month)
+		copy(dAtA[i:], m.Month)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Month)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DayOfMonth) > 0 {
+		i -= len(m.DayOfMonth)
+		copy(dAtA[i:], m.DayOfMonth)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.DayOfMonth)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hour) > 0 {
+		i -= len(m.Hour)
+		copy(dAtA[i:], m.Hour)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Hour)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Minute) > 0 {
+		i -= len(m.Minute)
+		copy(dAtA[i:], m.Minute)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Minute)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Second) > 0 {
+		i -= len(m.Second)
+		copy(dAtA[i:], m.Second)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Second)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Jitter != nil {
+		{
+			size, err := m.Jitter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EndTime != nil {
+		{
+			size, err := m.EndTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartTime != nil {
+		{
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Interval != nil {
+		{
+			size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Calendars) > 0 {
+		for iNdEx := len(m.Calendars) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calendars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CronExpression) > 0 {
+		i -= len(m.CronExpression)
+		copy(dAtA[i:], m.CronExpression)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.CronExpression)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleStartWorkflowAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleStartWorkflowAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleStartWorkflowAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TaskStartToCloseTimeout != nil {
+		{
+			size, err := m.TaskStartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExecutionStartToCloseTimeout != nil {
+		{
+			size, err := m.ExecutionStartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WorkflowIdPrefix) > 0 {
+		i -= len(m.WorkflowIdPrefix)
+		copy(dAtA[i:], m.WorkflowIdPrefix)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.WorkflowIdPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulePolicies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulePolicies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulePolicies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BufferLimit != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.BufferLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CatchUpWindow != nil {
+		{
+			size, err := m.CatchUpWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OverlapPolicy != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.OverlapPolicy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleRun) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleRun) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ActualTime != nil {
+		{
+			size, err := m.ActualTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NominalTime != nil {
+		{
+			size, err := m.NominalTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BufferOverruns != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.BufferOverruns))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.FailedStarts != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.FailedStarts))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MissedRuns != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.MissedRuns))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SkippedRuns != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.SkippedRuns))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TotalRuns != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.TotalRuns))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PendingBackfills != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.PendingBackfills))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BufferedRuns != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.BufferedRuns))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastRun != nil {
+		{
+			size, err := m.LastRun.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RunningRuns) > 0 {
+		for iNdEx := len(m.RunningRuns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RunningRuns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.LastProcessedTime != nil {
+		{
+			size, err := m.LastProcessedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleListEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleListEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleListEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduleCalendarSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Second)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Minute)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Hour)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.DayOfMonth)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Month)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.DayOfWeek)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CronExpression)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if len(m.Calendars) > 0 {
+		for _, e := range m.Calendars {
+			l = e.Size()
+			n += 1 + l + sovSchedule(uint64(l))
+		}
+	}
+	if m.Interval != nil {
+		l = m.Interval.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = m.EndTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.Jitter != nil {
+		l = m.Jitter.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleStartWorkflowAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.WorkflowIdPrefix)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.ExecutionStartToCloseTimeout != nil {
+		l = m.ExecutionStartToCloseTimeout.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.TaskStartToCloseTimeout != nil {
+		l = m.TaskStartToCloseTimeout.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchedulePolicies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OverlapPolicy != 0 {
+		n += 1 + sovSchedule(uint64(m.OverlapPolicy))
+	}
+	if m.CatchUpWindow != nil {
+		l = m.CatchUpWindow.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.BufferLimit != 0 {
+		n += 1 + sovSchedule(uint64(m.BufferLimit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleRun) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.NominalTime != nil {
+		l = m.NominalTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.ActualTime != nil {
+		l = m.ActualTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.LastProcessedTime != nil {
+		l = m.LastProcessedTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if len(m.RunningRuns) > 0 {
+		for _, e := range m.RunningRuns {
+			l = e.Size()
+			n += 1 + l + sovSchedule(uint64(l))
+		}
+	}
+	if m.LastRun != nil {
+		l = m.LastRun.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.BufferedRuns != 0 {
+		n += 1 + sovSchedule(uint64(m.BufferedRuns))
+	}
+	if m.PendingBackfills != 0 {
+		n += 1 + sovSchedule(uint64(m.PendingBackfills))
+	}
+	if m.TotalRuns != 0 {
+		n += 1 + sovSchedule(uint64(m.TotalRuns))
+	}
+	if m.SkippedRuns != 0 {
+		n += 1 + sovSchedule(uint64(m.SkippedRuns))
+	}
+	if m.MissedRuns != 0 {
+		n += 1 + sovSchedule(uint64(m.MissedRuns))
+	}
+	if m.FailedStarts != 0 {
+		n += 1 + sovSchedule(uint64(m.FailedStarts))
+	}
+	if m.BufferOverruns != 0 {
+		n += 1 + sovSchedule(uint64(m.BufferOverruns))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleListEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSchedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSchedule(x uint64) (n int) {
+	return sovSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScheduleCalendarSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleCalendarSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleCalendarSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Second", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Second = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hour", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hour = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DayOfMonth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DayOfMonth = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Month", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Month = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DayOfWeek", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DayOfWeek = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calendars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calendars = append(m.Calendars, &ScheduleCalendarSpec{})
+			if err := m.Calendars[len(m.Calendars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Interval == nil {
+				m.Interval = &types.Duration{}
+			}
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = &types.Timestamp{}
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = &types.Timestamp{}
+			}
+			if err := m.EndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Jitter == nil {
+				m.Jitter = &types.Duration{}
+			}
+			if err := m.Jitter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleStartWorkflowAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleStartWorkflowAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleStartWorkflowAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowIdPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowIdPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Payload{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionStartToCloseTimeout == nil {
+				m.ExecutionStartToCloseTimeout = &types.Duration{}
+			}
+			if err := m.ExecutionStartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskStartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskStartToCloseTimeout == nil {
+				m.TaskStartToCloseTimeout = &types.Duration{}
+			}
+			if err := m.TaskStartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulePolicies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulePolicies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulePolicies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlapPolicy", wireType)
+			}
+			m.OverlapPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OverlapPolicy |= ScheduleOverlapPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CatchUpWindow == nil {
+				m.CatchUpWindow = &types.Duration{}
+			}
+			if err := m.CatchUpWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferLimit", wireType)
+			}
+			m.BufferLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BufferLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleRun) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleRun: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleRun: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NominalTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NominalTime == nil {
+				m.NominalTime = &types.Timestamp{}
+			}
+			if err := m.NominalTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActualTime == nil {
+				m.ActualTime = &types.Timestamp{}
+			}
+			if err := m.ActualTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastProcessedTime == nil {
+				m.LastProcessedTime = &types.Timestamp{}
+			}
+			if err := m.LastProcessedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningRuns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunningRuns = append(m.RunningRuns, &ScheduleRun{})
+			if err := m.RunningRuns[len(m.RunningRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRun", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastRun == nil {
+				m.LastRun = &ScheduleRun{}
+			}
+			if err := m.LastRun.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferedRuns", wireType)
+			}
+			m.BufferedRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BufferedRuns |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBackfills", wireType)
+			}
+			m.PendingBackfills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingBackfills |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRuns", wireType)
+			}
+			m.TotalRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRuns |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedRuns", wireType)
+			}
+			m.SkippedRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedRuns |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRuns", wireType)
+			}
+			m.MissedRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedRuns |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedStarts", wireType)
+			}
+			m.FailedStarts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedStarts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferOverruns", wireType)
+			}
+			m.BufferOverruns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BufferOverruns |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleListEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleListEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleListEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSchedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSchedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSchedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSchedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSchedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSchedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSchedule = fmt.Errorf("proto: unexpected end of group")
+)
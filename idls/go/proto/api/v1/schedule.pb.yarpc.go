@@ -0,0 +1,269 @@
+// The MIT License (MIT)
+// 
+// Copyright (c) 2021 Uber Technologies, Inc.
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/api/v1/schedule.proto
+
+package apiv1
+
+var yarpcFileDescriptorClosure7fe71309dc9555dc = [][]byte{
+	// uber/cadence/api/v1/schedule.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdf, 0x72, 0x22, 0xc5,
+		0x17, 0xfe, 0x01, 0x81, 0x0d, 0x67, 0x20, 0x4b, 0x7a, 0xf7, 0xe7, 0x8e, 0xbb, 0xd9, 0x84, 0x10,
+		0xff, 0xc4, 0x55, 0xa1, 0x82, 0xe5, 0x85, 0xa6, 0xbc, 0x20, 0x84, 0xb8, 0x28, 0x2e, 0x38, 0x21,
+		0x9b, 0xd2, 0x2a, 0x6b, 0xaa, 0x99, 0x69, 0x92, 0x96, 0xa1, 0x7b, 0x6a, 0xba, 0x27, 0x84, 0x67,
+		0xd0, 0xa7, 0xf1, 0x05, 0x7c, 0x00, 0x2f, 0xbd, 0xf5, 0xc2, 0x47, 0xb1, 0xba, 0xa7, 0x07, 0x53,
+		0x2b, 0x04, 0xab, 0xbc, 0xa1, 0xa6, 0xbf, 0xfe, 0xbe, 0xd3, 0x67, 0xce, 0x77, 0x4e, 0x33, 0x50,
+		0x8b, 0x47, 0x24, 0x6a, 0x78, 0xd8, 0x27, 0xcc, 0x23, 0x0d, 0x1c, 0xd2, 0xc6, 0xcd, 0x51, 0x43,
+		0x78, 0xd7, 0xc4, 0x8f, 0x03, 0x52, 0x0f, 0x23, 0x2e, 0x39, 0x7a, 0xa4, 0x38, 0x75, 0xc3, 0xa9,
+		0xe3, 0x90, 0xd6, 0x6f, 0x8e, 0x9e, 0xee, 0x5e, 0x71, 0x7e, 0x15, 0x90, 0x86, 0xa6, 0x8c, 0xe2,
+		0x71, 0xc3, 0x8f, 0x23, 0x2c, 0x29, 0x67, 0x89, 0xe8, 0xe9, 0xde, 0x9b, 0xfb, 0x92, 0x4e, 0x89,
+		0x90, 0x78, 0x1a, 0x1a, 0x42, 0x75, 0xd9, 0xc9, 0x1e, 0x9f, 0x4e, 0x17, 0x21, 0x96, 0xe6, 0x26,
+		0xb1, 0x98, 0x04, 0x54, 0xc8, 0x84, 0x53, 0xfb, 0x25, 0x03, 0x8f, 0xcf, 0x4d, 0xba, 0x6d, 0x1c,
+		0x10, 0xe6, 0xe3, 0xe8, 0x3c, 0x24, 0x1e, 0x7a, 0x0b, 0x0a, 0x82, 0x78, 0x9c, 0xf9, 0x76, 0xa6,
+		0x9a, 0x39, 0x2c, 0x3a, 0x66, 0xa5, 0xf0, 0x29, 0x65, 0xb1, 0x24, 0x76, 0x36, 0xc1, 0x93, 0x15,
+		0x42, 0xb0, 0x71, 0xcd, 0xe3, 0xc8, 0xce, 0x69, 0x54, 0x3f, 0xa3, 0x2a, 0x94, 0x7c, 0x3c, 0x77,
+		0xf9, 0xd8, 0x9d, 0x72, 0x26, 0xaf, 0xed, 0x0d, 0xbd, 0x07, 0x3e, 0x9e, 0xf7, 0xc7, 0xdf, 0x28,
+		0x04, 0x3d, 0x86, 0x7c, 0xb2, 0x95, 0xd7, 0x5b, 0xc9, 0x02, 0xed, 0x82, 0x65, 0x74, 0x33, 0x42,
+		0x26, 0x76, 0x41, 0xef, 0x15, 0xb5, 0xec, 0x92, 0x90, 0x49, 0xed, 0x8f, 0x2c, 0x94, 0xd2, 0xa4,
+		0x75, 0xb2, 0xef, 0xc3, 0x43, 0x2f, 0xe2, 0xcc, 0x25, 0xb7, 0x61, 0x44, 0x84, 0xa0, 0x9c, 0x99,
+		0xac, 0xb7, 0x14, 0xdc, 0x59, 0xa0, 0xe8, 0x4b, 0x28, 0x7a, 0xe6, 0x2d, 0x85, 0x9d, 0xad, 0xe6,
+		0x0e, 0xad, 0xe6, 0x07, 0xf5, 0x25, 0xf6, 0xd4, 0x97, 0xd5, 0xc4, 0xf9, 0x5b, 0x8b, 0x3e, 0x85,
+		0x4d, 0xca, 0x24, 0x89, 0x6e, 0x70, 0xa0, 0x5f, 0xd9, 0x6a, 0xbe, 0x5d, 0x4f, 0x1c, 0xab, 0xa7,
+		0x8e, 0xd5, 0x4f, 0x8d, 0xa3, 0xce, 0x82, 0x8a, 0x3e, 0x03, 0x10, 0x12, 0x47, 0xd2, 0x55, 0x6e,
+		0xea, 0x7a, 0x58, 0xcd, 0xa7, 0xff, 0x10, 0x0e, 0x53, 0xab, 0x9d, 0xa2, 0x66, 0xab, 0xb5, 0x3a,
+		0x91, 0x30, 0x3f, 0x11, 0xe6, 0xd7, 0x0a, 0x1f, 0x10, 0xe6, 0x6b, 0xd9, 0x11, 0x14, 0x7e, 0xa4,
+		0x52, 0x92, 0xc8, 0x2e, 0xac, 0x4b, 0xd3, 0x10, 0x6b, 0xbf, 0xe6, 0xe0, 0xd9, 0xa2, 0xbc, 0xea,
+		0xfc, 0x4b, 0x1e, 0x4d, 0xc6, 0x01, 0x9f, 0xb5, 0x3c, 0xc5, 0x43, 0x67, 0x50, 0x9e, 0x19, 0xc4,
+		0x95, 0xf3, 0x90, 0xe8, 0x5a, 0x5b, 0xcd, 0xfd, 0xa5, 0x85, 0x4c, 0xb5, 0xc3, 0x79, 0x48, 0x9c,
+		0xd2, 0xec, 0xce, 0x0a, 0x7d, 0x0e, 0x45, 0xd5, 0x8d, 0xae, 0x6a, 0x47, 0xdd, 0x4d, 0x56, 0xf3,
+		0xf9, 0xd2, 0x18, 0x43, 0x2c, 0x26, 0x3d, 0x2a, 0xa4, 0xb3, 0x29, 0xcd, 0x13, 0xfa, 0x08, 0xd0,
+		0x22, 0x07, 0xea, 0xbb, 0x61, 0x44, 0xc6, 0xf4, 0xd6, 0x34, 0x5f, 0x25, 0xdd, 0xe9, 0xfa, 0x03,
+		0x8d, 0xa3, 0x26, 0xe4, 0x29, 0x0b, 0x63, 0x69, 0x2a, 0xbe, 0xb3, 0xf4, 0x94, 0x01, 0x9e, 0x07,
+		0x1c, 0xfb, 0x4e, 0x42, 0x45, 0x18, 0xaa, 0xe4, 0x96, 0x78, 0xb1, 0x7a, 0x65, 0xd7, 0x98, 0xc6,
+		0x5d, 0x2f, 0xe0, 0x82, 0x68, 0x13, 0x78, 0x2c, 0xed, 0xfc, 0xba, 0x92, 0xee, 0x2c, 0x42, 0xe8,
+		0x42, 0x0e, 0x79, 0x5b, 0xe9, 0x87, 0x89, 0x1c, 0x5d, 0xc2, 0x33, 0x5d, 0x80, 0x15, 0xd1, 0xd7,
+		0x1a, 0xf6, 0x44, 0xa9, 0x97, 0x04, 0xae, 0xfd, 0x96, 0x81, 0x4a, 0xea, 0xe0, 0x80, 0x07, 0xd4,
+		0xa3, 0x44, 0xa0, 0x6f, 0x61, 0x8b, 0xdf, 0x90, 0x28, 0xc0, 0xa1, 0x1b, 0x2a, 0x6c, 0xae, 0x7d,
+		0xdb, 0x6a, 0xbe, 0xb8, 0x77, 0x00, 0xfa, 0x89, 0x44, 0x47, 0x99, 0x3b, 0x65, 0x7e, 0x77, 0x89,
+		0x5a, 0xf0, 0xd0, 0xc3, 0xd2, 0xbb, 0x76, 0xe3, 0xd0, 0x9d, 0x51, 0xe6, 0xf3, 0x99, 0x9d, 0x5d,
+		0x97, 0x74, 0x59, 0x2b, 0x2e, 0xc2, 0x4b, 0xcd, 0x47, 0xfb, 0x50, 0x1a, 0xc5, 0xe3, 0x31, 0x89,
+		0xdc, 0x80, 0x4e, 0xa9, 0xd4, 0x16, 0xe6, 0x1d, 0x2b, 0xc1, 0x7a, 0x0a, 0xaa, 0xfd, 0x99, 0x01,
+		0x2b, 0x4d, 0xc7, 0x89, 0x19, 0xba, 0xb8, 0xe3, 0xfd, 0xa2, 0xbe, 0xa6, 0x09, 0xdf, 0xbb, 0xb7,
+		0x09, 0x3b, 0x29, 0xdb, 0xd9, 0x9e, 0xbd, 0x09, 0xa1, 0x2f, 0xa0, 0xc4, 0xf8, 0x94, 0x32, 0x1c,
+		0x24, 0x43, 0x96, 0x5d, 0x3b, 0x64, 0x96, 0xe1, 0xeb, 0x41, 0x3b, 0x06, 0x0b, 0x7b, 0x32, 0x4e,
+		0xd5, 0xb9, 0xb5, 0x6a, 0x48, 0xe8, 0x0a, 0xa8, 0xfd, 0xbc, 0x01, 0xe5, 0x3b, 0x23, 0x27, 0x89,
+		0xba, 0x67, 0x43, 0x1c, 0x0b, 0x92, 0xdc, 0xbf, 0x9b, 0x8e, 0x59, 0xa9, 0x7b, 0x96, 0xf1, 0xc5,
+		0xed, 0xab, 0x9f, 0xd1, 0x57, 0xf0, 0x28, 0xc0, 0x42, 0xba, 0x61, 0xc4, 0x3d, 0x22, 0x04, 0xf1,
+		0xff, 0x6d, 0x0a, 0xdb, 0x4a, 0x36, 0x48, 0x55, 0xfa, 0x35, 0xda, 0x50, 0x8a, 0x62, 0xc6, 0x28,
+		0xbb, 0x72, 0xa3, 0x98, 0x09, 0x7b, 0x43, 0x5f, 0x92, 0xd5, 0x7b, 0x7b, 0xc4, 0x89, 0x99, 0x63,
+		0x19, 0x95, 0x13, 0x33, 0x81, 0x8e, 0x61, 0x53, 0x27, 0x14, 0xc5, 0xcc, 0xcc, 0xc8, 0xfa, 0x00,
+		0x0f, 0x94, 0x42, 0xd9, 0x7b, 0x00, 0xe5, 0xc4, 0x7d, 0xe2, 0x27, 0x29, 0x14, 0x74, 0x4b, 0x94,
+		0x52, 0x50, 0x9f, 0xf0, 0x21, 0x6c, 0x87, 0x84, 0xf9, 0x2a, 0xcd, 0x11, 0xf6, 0x26, 0x63, 0x1a,
+		0x04, 0xc2, 0x7e, 0xa0, 0x89, 0x15, 0xb3, 0x71, 0x92, 0xe2, 0xe8, 0x39, 0x80, 0xe4, 0x12, 0x07,
+		0x49, 0xb8, 0xcd, 0x6a, 0xe6, 0x30, 0xe7, 0x14, 0x35, 0xa2, 0x63, 0xed, 0x43, 0x49, 0x4c, 0x68,
+		0x18, 0xa6, 0xe7, 0x15, 0x35, 0xc1, 0x32, 0x98, 0xa6, 0xec, 0x81, 0x35, 0xa5, 0x42, 0xa4, 0x0c,
+		0xd0, 0x0c, 0x48, 0x20, 0x4d, 0x38, 0x80, 0xf2, 0x18, 0xd3, 0x80, 0xf8, 0xc9, 0x30, 0x0b, 0xdb,
+		0xd2, 0x94, 0x52, 0x02, 0xea, 0x19, 0x15, 0xea, 0x6f, 0xca, 0xf4, 0xba, 0x1a, 0x23, 0x1d, 0xa9,
+		0xa4, 0x69, 0x5b, 0x09, 0xdc, 0x37, 0x68, 0xed, 0xa7, 0x0c, 0x6c, 0xa7, 0xb5, 0x51, 0xd7, 0x5d,
+		0x87, 0xc9, 0x68, 0xae, 0x92, 0x48, 0xbf, 0x2c, 0x5c, 0x9a, 0xfe, 0x2f, 0x43, 0x0a, 0x75, 0xfd,
+		0x15, 0x83, 0x91, 0xfd, 0x8f, 0x83, 0xf1, 0xe2, 0xf7, 0x0c, 0xfc, 0x7f, 0xe9, 0x75, 0x80, 0x0e,
+		0x60, 0xef, 0xbc, 0xfd, 0xb2, 0x73, 0x7a, 0xd1, 0xeb, 0xb8, 0xfd, 0xd7, 0x1d, 0xa7, 0xd7, 0x1a,
+		0xb8, 0x83, 0x7e, 0xaf, 0xdb, 0xfe, 0xce, 0xed, 0xbe, 0x7a, 0xdd, 0xea, 0x75, 0x4f, 0x2b, 0xff,
+		0x43, 0x55, 0xd8, 0x59, 0x45, 0x3a, 0xff, 0xba, 0x3b, 0xa8, 0x64, 0x50, 0x0d, 0x76, 0x57, 0x31,
+		0x4e, 0x2e, 0xce, 0xce, 0x3a, 0x4e, 0x25, 0x8b, 0x0e, 0xe1, 0x9d, 0x55, 0x9c, 0x76, 0xeb, 0x55,
+		0xbb, 0xd3, 0x73, 0xfb, 0xc3, 0x97, 0x1d, 0xa7, 0x92, 0x43, 0xef, 0xc2, 0xfe, 0x2a, 0x66, 0xab,
+		0xd7, 0xeb, 0x5f, 0xaa, 0xdf, 0xca, 0xc6, 0xc9, 0x0f, 0xf0, 0xc4, 0xe3, 0xd3, 0x65, 0x55, 0x39,
+		0x59, 0x8c, 0xe2, 0x40, 0x8d, 0xcc, 0x20, 0xf3, 0xfd, 0xd1, 0x15, 0x95, 0xd7, 0xf1, 0xa8, 0xee,
+		0xf1, 0x69, 0xe3, 0xee, 0x47, 0xd5, 0xc7, 0xd4, 0x0f, 0x1a, 0x57, 0x3c, 0xf9, 0x48, 0x33, 0x5f,
+		0x58, 0xc7, 0x38, 0xa4, 0x37, 0x47, 0xa3, 0x82, 0xc6, 0x3e, 0xf9, 0x6b, 0x00, 0xe2, 0x83, 0xac,
+		0xd7, 0x21, 0x0a, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/common.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdf, 0x72, 0xdb, 0xc4,
+		0x17, 0xfe, 0x29, 0x8e, 0x9d, 0xe4, 0xd8, 0x4d, 0xfc, 0xdb, 0x90, 0xc4, 0x49, 0x09, 0xa4, 0x9a,
+		0x61, 0x1a, 0x3a, 0x20, 0x4f, 0xdc, 0x9b, 0x0e, 0x9d, 0x02, 0x4e, 0xec, 0x24, 0x6a, 0x83, 0x6d,
+		0x64, 0xd3, 0x4c, 0x61, 0x06, 0xcd, 0x5a, 0x5a, 0xb9, 0x8b, 0xa5, 0x5d, 0xb1, 0x5a, 0x39, 0xf1,
+		0x0d, 0xc3, 0x93, 0x70, 0xc1, 0xeb, 0x70, 0xc9, 0x0b, 0x31, 0xab, 0x3f, 0xb1, 0x5d, 0x9c, 0x29,
+		0x17, 0x0c, 0x77, 0xbb, 0xe7, 0xfb, 0xce, 0x39, 0xdf, 0xae, 0xce, 0x39, 0x5a, 0x38, 0x8a, 0x87,
+		0x44, 0xd4, 0x1d, 0xec, 0x12, 0xe6, 0x90, 0x3a, 0x0e, 0x69, 0x7d, 0x72, 0x52, 0x77, 0x78, 0x10,
+		0x70, 0x66, 0x84, 0x82, 0x4b, 0x8e, 0xb6, 0x15, 0xc3, 0xc8, 0x18, 0x06, 0x0e, 0xa9, 0x31, 0x39,
+		0x39, 0xf8, 0x68, 0xc4, 0xf9, 0xc8, 0x27, 0xf5, 0x84, 0x32, 0x8c, 0xbd, 0xba, 0x1b, 0x0b, 0x2c,
+		0x69, 0xee, 0xa4, 0xbf, 0x82, 0xff, 0x5f, 0x73, 0x31, 0xf6, 0x7c, 0x7e, 0xd3, 0xbe, 0x25, 0x4e,
+		0xac, 0x20, 0xf4, 0x31, 0x94, 0x6f, 0x32, 0xa3, 0x4d, 0xdd, 0x9a, 0x76, 0xa4, 0x1d, 0x6f, 0x58,
+		0x90, 0x9b, 0x4c, 0x17, 0xed, 0x40, 0x49, 0xc4, 0x4c, 0x61, 0x2b, 0x09, 0x56, 0x14, 0x31, 0x33,
+		0x5d, 0x5d, 0x87, 0x4a, 0x1e, 0x6c, 0x30, 0x0d, 0x09, 0x42, 0xb0, 0xca, 0x70, 0x40, 0xb2, 0x00,
+		0xc9, 0x5a, 0x71, 0x9a, 0x8e, 0xa4, 0x13, 0x2a, 0xa7, 0xf7, 0x72, 0x0e, 0x61, 0xad, 0x87, 0xa7,
+		0x3e, 0xc7, 0xae, 0x82, 0x5d, 0x2c, 0x71, 0x02, 0x57, 0xac, 0x64, 0xad, 0x3f, 0x87, 0xb5, 0x73,
+		0x4c, 0xfd, 0x58, 0x10, 0xb4, 0x0b, 0x25, 0x41, 0x70, 0xc4, 0x59, 0xe6, 0x9f, 0xed, 0x50, 0x0d,
+		0xd6, 0x5c, 0x22, 0x31, 0xf5, 0xa3, 0x44, 0x61, 0xc5, 0xca, 0xb7, 0xfa, 0x6f, 0x1a, 0xac, 0x7e,
+		0x43, 0x02, 0x8e, 0x5e, 0x40, 0xc9, 0xa3, 0xc4, 0x77, 0xa3, 0x9a, 0x76, 0x54, 0x38, 0x2e, 0x37,
+		0x3e, 0x31, 0x96, 0xdc, 0x9f, 0xa1, 0xa8, 0xc6, 0x79, 0xc2, 0x6b, 0x33, 0x29, 0xa6, 0x56, 0xe6,
+		0x74, 0x70, 0x0d, 0xe5, 0x39, 0x33, 0xaa, 0x42, 0x61, 0x4c, 0xa6, 0x99, 0x0a, 0xb5, 0x44, 0x0d,
+		0x28, 0x4e, 0xb0, 0x1f, 0x93, 0x44, 0x40, 0xb9, 0xf1, 0xe1, 0xd2, 0xf0, 0xd9, 0x31, 0xad, 0x94,
+		0xfa, 0xc5, 0xca, 0x33, 0x4d, 0xff, 0x5d, 0x83, 0xd2, 0x25, 0xc1, 0x2e, 0x11, 0xe8, 0xab, 0x77,
+		0x24, 0x3e, 0x5e, 0x1a, 0x23, 0x25, 0xff, 0xb7, 0x22, 0xff, 0xd4, 0xa0, 0xda, 0x27, 0x58, 0x38,
+		0x6f, 0x9b, 0x52, 0x0a, 0x3a, 0x8c, 0x25, 0x89, 0x90, 0x0d, 0x9b, 0x94, 0xb9, 0xe4, 0x96, 0xb8,
+		0xf6, 0x82, 0xec, 0x67, 0x4b, 0xa3, 0xbe, 0xeb, 0x6e, 0x98, 0xa9, 0xef, 0xfc, 0x39, 0x1e, 0xd0,
+		0x79, 0xdb, 0xc1, 0x8f, 0x80, 0xfe, 0x4e, 0xfa, 0x17, 0x4f, 0xe5, 0xc1, 0x7a, 0x0b, 0x4b, 0x7c,
+		0xea, 0xf3, 0x21, 0x3a, 0x87, 0x07, 0x84, 0x39, 0xdc, 0xa5, 0x6c, 0x64, 0xcb, 0x69, 0x98, 0x16,
+		0xe8, 0x66, 0xe3, 0xd1, 0xd2, 0x58, 0xed, 0x8c, 0xa9, 0x2a, 0xda, 0xaa, 0x90, 0xb9, 0xdd, 0x5d,
+		0x01, 0xaf, 0xcc, 0x15, 0x70, 0x2f, 0x6d, 0x3a, 0x22, 0x5e, 0x13, 0x11, 0x51, 0xce, 0x4c, 0xe6,
+		0x71, 0x45, 0xa4, 0x41, 0xe8, 0xe7, 0x8d, 0xa0, 0xd6, 0xe8, 0x31, 0x6c, 0x79, 0x04, 0xcb, 0x58,
+		0x10, 0x7b, 0x92, 0x52, 0xb3, 0x86, 0xdb, 0xcc, 0xcc, 0x59, 0x00, 0xfd, 0x15, 0xec, 0xf5, 0xe3,
+		0x30, 0xe4, 0x42, 0x12, 0xf7, 0xcc, 0xa7, 0x84, 0xc9, 0x0c, 0x89, 0x54, 0xaf, 0x8e, 0xb8, 0x1d,
+		0xb9, 0xe3, 0x2c, 0x72, 0x71, 0xc4, 0xfb, 0xee, 0x18, 0xed, 0xc3, 0xfa, 0x4f, 0x78, 0x82, 0x13,
+		0x20, 0x8d, 0xb9, 0xa6, 0xf6, 0x7d, 0x77, 0xac, 0xff, 0x5a, 0x80, 0xb2, 0x45, 0xa4, 0x98, 0xf6,
+		0xb8, 0x4f, 0x9d, 0x29, 0x6a, 0x41, 0x95, 0x32, 0x2a, 0x29, 0xf6, 0x6d, 0xca, 0x24, 0x11, 0x13,
+		0x9c, 0xaa, 0x2c, 0x37, 0xf6, 0x8d, 0x74, 0xbc, 0x18, 0xf9, 0x78, 0x31, 0x5a, 0xd9, 0x78, 0xb1,
+		0xb6, 0x32, 0x17, 0x33, 0xf3, 0x40, 0x75, 0xd8, 0x1e, 0x62, 0x67, 0xcc, 0x3d, 0xcf, 0x76, 0x38,
+		0xf1, 0x3c, 0xea, 0x28, 0x99, 0x49, 0x6e, 0xcd, 0x42, 0x19, 0x74, 0x36, 0x43, 0x54, 0xda, 0x00,
+		0xdf, 0xd2, 0x20, 0x0e, 0x66, 0x69, 0x0b, 0xef, 0x4d, 0x9b, 0xb9, 0xdc, 0xa5, 0xfd, 0x74, 0x16,
+		0x05, 0x4b, 0x49, 0x82, 0x50, 0x46, 0xb5, 0xd5, 0x23, 0xed, 0xb8, 0x78, 0x47, 0x6d, 0x66, 0x66,
+		0xf4, 0x02, 0x1e, 0x32, 0xce, 0x6c, 0xa1, 0x8e, 0x8e, 0x87, 0x3e, 0xb1, 0x89, 0x10, 0x5c, 0xd8,
+		0xe9, 0x48, 0x89, 0x6a, 0xc5, 0xa3, 0xc2, 0xf1, 0x86, 0x55, 0x63, 0x9c, 0x59, 0x39, 0xa3, 0xad,
+		0x08, 0x56, 0x8a, 0xa3, 0x97, 0xb0, 0x4d, 0x6e, 0x43, 0x9a, 0x0a, 0x99, 0x49, 0x2e, 0xbd, 0x4f,
+		0x32, 0x9a, 0x79, 0xe5, 0xaa, 0xf5, 0x00, 0xf6, 0xcc, 0x88, 0xfb, 0x89, 0xf1, 0x42, 0xf0, 0x38,
+		0xec, 0x61, 0x21, 0xa9, 0xda, 0x2d, 0x1b, 0x98, 0xe8, 0x4b, 0x28, 0x46, 0x12, 0xcb, 0xb4, 0xe0,
+		0x37, 0x1b, 0xc7, 0x4b, 0x8b, 0x74, 0x31, 0x60, 0x5f, 0xf1, 0xad, 0xd4, 0x4d, 0x9f, 0xc0, 0xc3,
+		0x45, 0xf4, 0x8c, 0x33, 0x8f, 0x8e, 0x32, 0x85, 0xe8, 0x1a, 0xaa, 0x34, 0x87, 0xed, 0x91, 0xc2,
+		0xf3, 0xd6, 0xfe, 0xec, 0x1f, 0x64, 0xba, 0x93, 0x6e, 0x6d, 0xd1, 0x05, 0x20, 0xd2, 0xff, 0xd0,
+		0xe0, 0xa0, 0x19, 0x4d, 0x99, 0x93, 0xff, 0x36, 0x16, 0xf3, 0xd6, 0x60, 0x8d, 0x30, 0x75, 0xcf,
+		0xe9, 0x3f, 0x68, 0xdd, 0xca, 0xb7, 0xa8, 0x01, 0x3b, 0xa1, 0x20, 0x2e, 0xf1, 0x28, 0x23, 0xae,
+		0xfd, 0x73, 0x4c, 0x62, 0x62, 0x27, 0xb7, 0x92, 0x96, 0xf2, 0xf6, 0x0c, 0xfc, 0x56, 0x61, 0x1d,
+		0x75, 0x49, 0x87, 0x00, 0x29, 0x31, 0x69, 0xe7, 0x42, 0x42, 0xdc, 0x48, 0x2c, 0x49, 0xa3, 0x7e,
+		0x0d, 0x95, 0x14, 0x76, 0x12, 0x0d, 0x49, 0x91, 0x94, 0x1b, 0x87, 0x4b, 0x0f, 0x98, 0x4f, 0x09,
+		0xab, 0x9c, 0xb8, 0xa4, 0xaa, 0x9f, 0xdc, 0x40, 0x65, 0x7e, 0x10, 0xa0, 0x7d, 0xd8, 0x69, 0x77,
+		0xce, 0xba, 0x2d, 0xb3, 0x73, 0x61, 0x0f, 0xde, 0xf4, 0xda, 0xb6, 0xd9, 0x79, 0xdd, 0xbc, 0x32,
+		0x5b, 0xd5, 0xff, 0xa1, 0x03, 0xd8, 0x5d, 0x84, 0x06, 0x97, 0x96, 0x79, 0x3e, 0xb0, 0xae, 0xab,
+		0x1a, 0xda, 0x05, 0xb4, 0x88, 0xbd, 0xec, 0x77, 0x3b, 0xd5, 0x15, 0x54, 0x83, 0x0f, 0x16, 0xed,
+		0x3d, 0xab, 0x3b, 0xe8, 0x3e, 0xad, 0x16, 0x9e, 0xfc, 0x02, 0xdb, 0x4b, 0x3e, 0x2e, 0x7a, 0x04,
+		0x87, 0x66, 0xbf, 0x7b, 0xd5, 0x1c, 0x98, 0xdd, 0x8e, 0x7d, 0x61, 0x75, 0xbf, 0xeb, 0xd9, 0xfd,
+		0x41, 0x73, 0x30, 0xaf, 0xe3, 0x5e, 0xca, 0x65, 0xbb, 0x79, 0x35, 0xb8, 0x7c, 0x53, 0xd5, 0xee,
+		0xa7, 0xb4, 0xac, 0xa6, 0xd9, 0x69, 0xb7, 0xaa, 0x2b, 0xa7, 0x3f, 0xc0, 0x9e, 0xc3, 0x83, 0x65,
+		0x37, 0x75, 0x5a, 0x3e, 0x4b, 0x9e, 0x28, 0x3d, 0x55, 0xf5, 0x3d, 0xed, 0xfb, 0x93, 0x11, 0x95,
+		0x6f, 0xe3, 0xa1, 0xe1, 0xf0, 0xa0, 0x3e, 0xff, 0xa0, 0xf9, 0x9c, 0xba, 0x7e, 0x7d, 0xc4, 0xd3,
+		0x67, 0x4a, 0xf6, 0xba, 0x79, 0x8e, 0x43, 0x3a, 0x39, 0x19, 0x96, 0x12, 0xdb, 0xd3, 0xbf, 0x06,
+		0x00, 0x57, 0xd9, 0xb2, 0xe0, 0x01, 0x09, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/tasklist.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
+		0x14, 0x9e, 0xe2, 0xb4, 0x4b, 0xe8, 0xd9, 0xd5, 0xb8, 0xb5, 0x8d, 0xdd, 0x75, 0xf3, 0x74, 0x51,
+		0x04, 0xc5, 0x26, 0xc1, 0x19, 0x76, 0xb5, 0x8b, 0xc1, 0xb1, 0x83, 0x55, 0xb0, 0xe3, 0x1a, 0x92,
+		0x1a, 0x20, 0x03, 0x06, 0x8e, 0x12, 0x59, 0x9b, 0xd0, 0x0f, 0x05, 0x92, 0x72, 0xe2, 0x17, 0xd9,
+		0xc3, 0xec, 0x89, 0xf6, 0x18, 0x03, 0x29, 0xd9, 0xf3, 0x12, 0x6f, 0x77, 0xe4, 0xf9, 0xce, 0x77,
+		0x7e, 0x3e, 0x9e, 0x43, 0xe0, 0x54, 0x31, 0x15, 0x5e, 0x82, 0x09, 0x2d, 0x12, 0xea, 0xe1, 0x92,
+		0x79, 0xeb, 0xa1, 0xa7, 0xb0, 0x4c, 0x33, 0x26, 0x95, 0x5b, 0x0a, 0xae, 0x38, 0xfc, 0x42, 0xfb,
+		0xb8, 0x8d, 0x8f, 0x8b, 0x4b, 0xe6, 0xae, 0x87, 0xfd, 0xaf, 0x97, 0x9c, 0x2f, 0x33, 0xea, 0x19,
+		0x97, 0xb8, 0xfa, 0xe8, 0x91, 0x4a, 0x60, 0xc5, 0x78, 0x51, 0x93, 0xfa, 0xdf, 0x3c, 0xc4, 0x15,
+		0xcb, 0xa9, 0x54, 0x38, 0x2f, 0x1b, 0x87, 0x47, 0x01, 0xee, 0x04, 0x2e, 0x4b, 0x2a, 0x64, 0x8d,
+		0x3b, 0x1f, 0xc0, 0x49, 0x84, 0x65, 0x3a, 0x63, 0x52, 0x41, 0x08, 0x8e, 0x0b, 0x9c, 0xd3, 0x33,
+		0x6b, 0x60, 0x9d, 0x9f, 0x06, 0xe6, 0x0c, 0x7f, 0x04, 0xc7, 0x29, 0x2b, 0xc8, 0xd9, 0xd1, 0xc0,
+		0x3a, 0xef, 0x5e, 0x7c, 0xeb, 0x1e, 0x28, 0xd2, 0xdd, 0x06, 0x98, 0xb2, 0x82, 0x04, 0xc6, 0xdd,
+		0xc1, 0xc0, 0xde, 0x5a, 0xaf, 0xa9, 0xc2, 0x04, 0x2b, 0x0c, 0xaf, 0xc1, 0x97, 0x39, 0xbe, 0x47,
+		0xba, 0x6d, 0x89, 0x4a, 0x2a, 0x90, 0xa4, 0x09, 0x2f, 0x88, 0x49, 0xd7, 0xbe, 0xf8, 0xca, 0xad,
+		0x2b, 0x75, 0xb7, 0x95, 0xba, 0x13, 0x5e, 0xc5, 0x19, 0xbd, 0xc1, 0x59, 0x45, 0x83, 0xcf, 0x73,
+		0x7c, 0xaf, 0x03, 0xca, 0x05, 0x15, 0xa1, 0xa1, 0x39, 0x1f, 0x40, 0x6f, 0x9b, 0x62, 0x81, 0x85,
+		0x62, 0x5a, 0x95, 0x5d, 0x2e, 0x1b, 0xb4, 0x52, 0xba, 0x69, 0x3a, 0xd1, 0x47, 0xf8, 0x06, 0x3c,
+		0xe3, 0x77, 0x05, 0x15, 0x68, 0xc5, 0xa5, 0x42, 0xa6, 0xcf, 0x23, 0x83, 0x76, 0x8c, 0xf9, 0x1d,
+		0x97, 0x6a, 0x8e, 0x73, 0xea, 0xfc, 0x65, 0x81, 0xee, 0x36, 0x6e, 0xa8, 0xb0, 0xaa, 0x24, 0xfc,
+		0x0e, 0xc0, 0x18, 0x27, 0x69, 0xc6, 0x97, 0x28, 0xe1, 0x55, 0xa1, 0xd0, 0x8a, 0x15, 0xca, 0xc4,
+		0x6e, 0x05, 0x76, 0x83, 0x8c, 0x35, 0xf0, 0x8e, 0x15, 0x0a, 0xbe, 0x06, 0x40, 0x50, 0x4c, 0x50,
+		0x46, 0xd7, 0x34, 0x33, 0x39, 0x5a, 0xc1, 0xa9, 0xb6, 0xcc, 0xb4, 0x01, 0xbe, 0x02, 0xa7, 0x38,
+		0x49, 0x1b, 0xb4, 0x65, 0xd0, 0x13, 0x9c, 0xa4, 0x35, 0xf8, 0x06, 0x3c, 0x13, 0x58, 0xd1, 0x7d,
+		0x75, 0x8e, 0x07, 0xd6, 0xb9, 0x15, 0x74, 0xb4, 0x79, 0xd7, 0x3b, 0x9c, 0x80, 0x8e, 0x96, 0x11,
+		0x31, 0x82, 0xe2, 0x8c, 0x27, 0xe9, 0xd9, 0x13, 0xa3, 0xe1, 0xe0, 0x3f, 0x9f, 0xc7, 0x9f, 0x5c,
+		0x6a, 0xbf, 0xa0, 0xad, 0x69, 0x3e, 0x31, 0x17, 0xe7, 0x67, 0xd0, 0xde, 0xc3, 0x60, 0x0f, 0x9c,
+		0x48, 0x85, 0x85, 0x42, 0x8c, 0x34, 0xcd, 0x7d, 0x6a, 0xee, 0x3e, 0x81, 0xcf, 0xc1, 0x53, 0x5a,
+		0x10, 0x0d, 0xd4, 0xfd, 0x3c, 0xa1, 0x05, 0xf1, 0x89, 0xf3, 0x87, 0x05, 0xc0, 0x82, 0x67, 0x19,
+		0x15, 0x7e, 0xf1, 0x91, 0xc3, 0x09, 0xb0, 0x33, 0x2c, 0x15, 0xc2, 0x49, 0x42, 0xa5, 0x44, 0x7a,
+		0x14, 0x9b, 0xc7, 0xed, 0x3f, 0x7a, 0xdc, 0x68, 0x3b, 0xa7, 0x41, 0x57, 0x73, 0x46, 0x86, 0xa2,
+		0x8d, 0xb0, 0x0f, 0x4e, 0x18, 0xa1, 0x85, 0x62, 0x6a, 0xd3, 0xbc, 0xd0, 0xee, 0x7e, 0x48, 0x9f,
+		0xd6, 0x01, 0x7d, 0x9c, 0x3f, 0x2d, 0xd0, 0x0b, 0x15, 0x4b, 0xd2, 0xcd, 0xd5, 0x3d, 0x4d, 0x2a,
+		0x3d, 0x1a, 0x23, 0xa5, 0x04, 0x8b, 0x2b, 0x45, 0x25, 0xfc, 0x05, 0xd8, 0x77, 0x5c, 0xa4, 0x54,
+		0x98, 0x59, 0x44, 0x7a, 0x07, 0x9b, 0x3a, 0x5f, 0xff, 0xef, 0x7c, 0x07, 0xdd, 0x9a, 0xb6, 0x5b,
+		0x98, 0x08, 0xf4, 0x64, 0xb2, 0xa2, 0xa4, 0xca, 0x28, 0x52, 0x1c, 0xd5, 0xea, 0xe9, 0xb6, 0x79,
+		0xa5, 0x4c, 0xed, 0xed, 0x8b, 0xde, 0xe3, 0xb1, 0x6e, 0x36, 0x38, 0x78, 0xb1, 0xe5, 0x46, 0x3c,
+		0xd4, 0xcc, 0xa8, 0x26, 0xbe, 0xfd, 0x1d, 0x7c, 0xb6, 0xbf, 0x51, 0xb0, 0x0f, 0x5e, 0x44, 0xa3,
+		0x70, 0x8a, 0x66, 0x7e, 0x18, 0xa1, 0xa9, 0x3f, 0x9f, 0x20, 0x7f, 0x7e, 0x33, 0x9a, 0xf9, 0x13,
+		0xfb, 0x13, 0xd8, 0x03, 0xcf, 0x1f, 0x60, 0xf3, 0xf7, 0xc1, 0xf5, 0x68, 0x66, 0x5b, 0x07, 0xa0,
+		0x30, 0xf2, 0xc7, 0xd3, 0x5b, 0xfb, 0xe8, 0x2d, 0xf9, 0x27, 0x43, 0xb4, 0x29, 0xe9, 0xbf, 0x33,
+		0x44, 0xb7, 0x8b, 0xab, 0xbd, 0x0c, 0xaf, 0xc0, 0xcb, 0x07, 0xd8, 0xe4, 0x6a, 0xec, 0x87, 0xfe,
+		0xfb, 0xb9, 0x6d, 0x1d, 0x00, 0x47, 0xe3, 0xc8, 0xbf, 0xf1, 0xa3, 0x5b, 0xfb, 0xe8, 0xf2, 0x37,
+		0xf0, 0x32, 0xe1, 0xf9, 0x21, 0x45, 0x2f, 0x3b, 0xbb, 0xcd, 0xd5, 0xaa, 0x2c, 0xac, 0x5f, 0x87,
+		0x4b, 0xa6, 0x56, 0x55, 0xec, 0x26, 0x3c, 0xf7, 0xf6, 0xff, 0xca, 0xef, 0x19, 0xc9, 0xbc, 0x25,
+		0xaf, 0xbf, 0xaf, 0xe6, 0xe3, 0xfc, 0x09, 0x97, 0x6c, 0x3d, 0x8c, 0x9f, 0x1a, 0xdb, 0x0f, 0x7f,
+		0x0f, 0x00, 0x41, 0xb6, 0x75, 0xa3, 0x5c, 0x05, 0x00, 0x00,
+	},
+	// google/protobuf/wrappers.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0x2f, 0x4a, 0x2c,
+		0x28, 0x48, 0x2d, 0x2a, 0xd6, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0xca,
+		0x5c, 0xdc, 0x2e, 0xf9, 0xa5, 0x49, 0x39, 0xa9, 0x61, 0x89, 0x39, 0xa5, 0xa9, 0x42, 0x22, 0x5c,
+		0xac, 0x65, 0x20, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x63, 0x10, 0x84, 0xa3, 0xa4, 0xc4, 0xc5,
+		0xe5, 0x96, 0x93, 0x9f, 0x58, 0x82, 0x45, 0x0d, 0x13, 0x92, 0x1a, 0xcf, 0xbc, 0x12, 0x33, 0x13,
+		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
+		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
+		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x33,
+		0x23, 0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xf0, 0x3a, 0xf1, 0x86, 0x43, 0xc3, 0x3f, 0x00,
+		0x24, 0x12, 0xc0, 0x18, 0x65, 0x08, 0x55, 0x91, 0x9e, 0x9f, 0x93, 0x98, 0x97, 0xae, 0x97, 0x5f,
+		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
+		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
+		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
+	},
+}
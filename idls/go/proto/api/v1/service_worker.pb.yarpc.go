@@ -0,0 +1,1746 @@
+// The MIT License (MIT)
+// 
+// Copyright (c) 2021 Uber Technologies, Inc.
+// 
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// 
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+// 
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/api/v1/service_worker.proto
+
+package apiv1
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/api/x/restriction"
+	"go.uber.org/yarpc/encoding/protobuf"
+	"go.uber.org/yarpc/encoding/protobuf/reflection"
+)
+
+var _ = ioutil.NopCloser
+
+// WorkerAPIYARPCClient is the YARPC client-side interface for the WorkerAPI service.
+type WorkerAPIYARPCClient interface {
+	PollForDecisionTask(context.Context, *PollForDecisionTaskRequest, ...yarpc.CallOption) (*PollForDecisionTaskResponse, error)
+	RespondDecisionTaskCompleted(context.Context, *RespondDecisionTaskCompletedRequest, ...yarpc.CallOption) (*RespondDecisionTaskCompletedResponse, error)
+	RespondDecisionTaskFailed(context.Context, *RespondDecisionTaskFailedRequest, ...yarpc.CallOption) (*RespondDecisionTaskFailedResponse, error)
+	PollForActivityTask(context.Context, *PollForActivityTaskRequest, ...yarpc.CallOption) (*PollForActivityTaskResponse, error)
+	RespondActivityTaskCompleted(context.Context, *RespondActivityTaskCompletedRequest, ...yarpc.CallOption) (*RespondActivityTaskCompletedResponse, error)
+	RespondActivityTaskCompletedByID(context.Context, *RespondActivityTaskCompletedByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskCompletedByIDResponse, error)
+	RespondActivityTaskFailed(context.Context, *RespondActivityTaskFailedRequest, ...yarpc.CallOption) (*RespondActivityTaskFailedResponse, error)
+	RespondActivityTaskFailedByID(context.Context, *RespondActivityTaskFailedByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskFailedByIDResponse, error)
+	RespondActivityTaskCanceled(context.Context, *RespondActivityTaskCanceledRequest, ...yarpc.CallOption) (*RespondActivityTaskCanceledResponse, error)
+	RespondActivityTaskCanceledByID(context.Context, *RespondActivityTaskCanceledByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskCanceledByIDResponse, error)
+	RecordActivityTaskHeartbeat(context.Context, *RecordActivityTaskHeartbeatRequest, ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error)
+	RecordActivityTaskHeartbeatByID(context.Context, *RecordActivityTaskHeartbeatByIDRequest, ...yarpc.CallOption) (*RecordActivityTaskHeartbeatByIDResponse, error)
+	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest, ...yarpc.CallOption) (*RespondQueryTaskCompletedResponse, error)
+	ResetStickyTaskList(context.Context, *ResetStickyTaskListRequest, ...yarpc.CallOption) (*ResetStickyTaskListResponse, error)
+}
+
+func newWorkerAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) WorkerAPIYARPCClient {
+	return &_WorkerAPIYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.api.v1.WorkerAPI",
+			ClientConfig: clientConfig,
+			AnyResolver:  anyResolver,
+			Options:      options,
+		},
+	)}
+}
+
+// NewWorkerAPIYARPCClient builds a new YARPC client for the WorkerAPI service.
+func NewWorkerAPIYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) WorkerAPIYARPCClient {
+	return newWorkerAPIYARPCClient(clientConfig, nil, options...)
+}
+
+// WorkerAPIYARPCServer is the YARPC server-side interface for the WorkerAPI service.
+type WorkerAPIYARPCServer interface {
+	PollForDecisionTask(context.Context, *PollForDecisionTaskRequest) (*PollForDecisionTaskResponse, error)
+	RespondDecisionTaskCompleted(context.Context, *RespondDecisionTaskCompletedRequest) (*RespondDecisionTaskCompletedResponse, error)
+	RespondDecisionTaskFailed(context.Context, *RespondDecisionTaskFailedRequest) (*RespondDecisionTaskFailedResponse, error)
+	PollForActivityTask(context.Context, *PollForActivityTaskRequest) (*PollForActivityTaskResponse, error)
+	RespondActivityTaskCompleted(context.Context, *RespondActivityTaskCompletedRequest) (*RespondActivityTaskCompletedResponse, error)
+	RespondActivityTaskCompletedByID(context.Context, *RespondActivityTaskCompletedByIDRequest) (*RespondActivityTaskCompletedByIDResponse, error)
+	RespondActivityTaskFailed(context.Context, *RespondActivityTaskFailedRequest) (*RespondActivityTaskFailedResponse, error)
+	RespondActivityTaskFailedByID(context.Context, *RespondActivityTaskFailedByIDRequest) (*RespondActivityTaskFailedByIDResponse, error)
+	RespondActivityTaskCanceled(context.Context, *RespondActivityTaskCanceledRequest) (*RespondActivityTaskCanceledResponse, error)
+	RespondActivityTaskCanceledByID(context.Context, *RespondActivityTaskCanceledByIDRequest) (*RespondActivityTaskCanceledByIDResponse, error)
+	RecordActivityTaskHeartbeat(context.Context, *RecordActivityTaskHeartbeatRequest) (*RecordActivityTaskHeartbeatResponse, error)
+	RecordActivityTaskHeartbeatByID(context.Context, *RecordActivityTaskHeartbeatByIDRequest) (*RecordActivityTaskHeartbeatByIDResponse, error)
+	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest) (*RespondQueryTaskCompletedResponse, error)
+	ResetStickyTaskList(context.Context, *ResetStickyTaskListRequest) (*ResetStickyTaskListResponse, error)
+}
+
+type buildWorkerAPIYARPCProceduresParams struct {
+	Server      WorkerAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver
+}
+
+func buildWorkerAPIYARPCProcedures(params buildWorkerAPIYARPCProceduresParams) []transport.Procedure {
+	handler := &_WorkerAPIYARPCHandler{params.Server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName: "uber.cadence.api.v1.WorkerAPI",
+			UnaryHandlerParams: []protobuf.BuildProceduresUnaryHandlerParams{
+				{
+					MethodName: "PollForDecisionTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PollForDecisionTask,
+							NewRequest:  newWorkerAPIServicePollForDecisionTaskYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondDecisionTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondDecisionTaskCompleted,
+							NewRequest:  newWorkerAPIServiceRespondDecisionTaskCompletedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondDecisionTaskFailed",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondDecisionTaskFailed,
+							NewRequest:  newWorkerAPIServiceRespondDecisionTaskFailedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "PollForActivityTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PollForActivityTask,
+							NewRequest:  newWorkerAPIServicePollForActivityTaskYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskCompleted,
+							NewRequest:  newWorkerAPIServiceRespondActivityTaskCompletedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCompletedByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskCompletedByID,
+							NewRequest:  newWorkerAPIServiceRespondActivityTaskCompletedByIDYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskFailed",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskFailed,
+							NewRequest:  newWorkerAPIServiceRespondActivityTaskFailedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskFailedByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskFailedByID,
+							NewRequest:  newWorkerAPIServiceRespondActivityTaskFailedByIDYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCanceled",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskCanceled,
+							NewRequest:  newWorkerAPIServiceRespondActivityTaskCanceledYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCanceledByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskCanceledByID,
+							NewRequest:  newWorkerAPIServiceRespondActivityTaskCanceledByIDYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RecordActivityTaskHeartbeat",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RecordActivityTaskHeartbeat,
+							NewRequest:  newWorkerAPIServiceRecordActivityTaskHeartbeatYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RecordActivityTaskHeartbeatByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RecordActivityTaskHeartbeatByID,
+							NewRequest:  newWorkerAPIServiceRecordActivityTaskHeartbeatByIDYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondQueryTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondQueryTaskCompleted,
+							NewRequest:  newWorkerAPIServiceRespondQueryTaskCompletedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ResetStickyTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ResetStickyTaskList,
+							NewRequest:  newWorkerAPIServiceResetStickyTaskListYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+			},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
+		},
+	)
+}
+
+// BuildWorkerAPIYARPCProcedures prepares an implementation of the WorkerAPI service for YARPC registration.
+func BuildWorkerAPIYARPCProcedures(server WorkerAPIYARPCServer) []transport.Procedure {
+	return buildWorkerAPIYARPCProcedures(buildWorkerAPIYARPCProceduresParams{Server: server})
+}
+
+// FxWorkerAPIYARPCClientParams defines the input
+// for NewFxWorkerAPIYARPCClient. It provides the
+// paramaters to get a WorkerAPIYARPCClient in an
+// Fx application.
+type FxWorkerAPIYARPCClientParams struct {
+	fx.In
+
+	Provider    yarpc.ClientConfig
+	AnyResolver jsonpb.AnyResolver  `name:"yarpcfx" optional:"true"`
+	Restriction restriction.Checker `optional:"true"`
+}
+
+// FxWorkerAPIYARPCClientResult defines the output
+// of NewFxWorkerAPIYARPCClient. It provides a
+// WorkerAPIYARPCClient to an Fx application.
+type FxWorkerAPIYARPCClientResult struct {
+	fx.Out
+
+	Client WorkerAPIYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxWorkerAPIYARPCClient provides a WorkerAPIYARPCClient
+// to an Fx application using the given name for routing.
+//
+//	fx.Provide(
+//	  apiv1.NewFxWorkerAPIYARPCClient("service-name"),
+//	  ...
+//	)
+func NewFxWorkerAPIYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxWorkerAPIYARPCClientParams) FxWorkerAPIYARPCClientResult {
+		cc := params.Provider.ClientConfig(name)
+
+		if params.Restriction != nil {
+			if namer, ok := cc.GetUnaryOutbound().(transport.Namer); ok {
+				if err := params.Restriction.Check(protobuf.Encoding, namer.TransportName()); err != nil {
+					panic(err.Error())
+				}
+			}
+		}
+
+		return FxWorkerAPIYARPCClientResult{
+			Client: newWorkerAPIYARPCClient(cc, params.AnyResolver, options...),
+		}
+	}
+}
+
+// FxWorkerAPIYARPCProceduresParams defines the input
+// for NewFxWorkerAPIYARPCProcedures. It provides the
+// paramaters to get WorkerAPIYARPCServer procedures in an
+// Fx application.
+type FxWorkerAPIYARPCProceduresParams struct {
+	fx.In
+
+	Server      WorkerAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver `name:"yarpcfx" optional:"true"`
+}
+
+// FxWorkerAPIYARPCProceduresResult defines the output
+// of NewFxWorkerAPIYARPCProcedures. It provides
+// WorkerAPIYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxWorkerAPIYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxWorkerAPIYARPCProcedures provides WorkerAPIYARPCServer procedures to an Fx application.
+// It expects a WorkerAPIYARPCServer to be present in the container.
+//
+//	fx.Provide(
+//	  apiv1.NewFxWorkerAPIYARPCProcedures(),
+//	  ...
+//	)
+func NewFxWorkerAPIYARPCProcedures() interface{} {
+	return func(params FxWorkerAPIYARPCProceduresParams) FxWorkerAPIYARPCProceduresResult {
+		return FxWorkerAPIYARPCProceduresResult{
+			Procedures: buildWorkerAPIYARPCProcedures(buildWorkerAPIYARPCProceduresParams{
+				Server:      params.Server,
+				AnyResolver: params.AnyResolver,
+			}),
+			ReflectionMeta: WorkerAPIReflectionMeta,
+		}
+	}
+}
+
+// WorkerAPIReflectionMeta is the reflection server metadata
+// required for using the gRPC reflection protocol with YARPC.
+//
+// See https://github.com/grpc/grpc/blob/master/doc/server-reflection.md.
+var WorkerAPIReflectionMeta = reflection.ServerMeta{
+	ServiceName:     "uber.cadence.api.v1.WorkerAPI",
+	FileDescriptors: yarpcFileDescriptorClosure00684e887839a48c,
+}
+
+type _WorkerAPIYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_WorkerAPIYARPCCaller) PollForDecisionTask(ctx context.Context, request *PollForDecisionTaskRequest, options ...yarpc.CallOption) (*PollForDecisionTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PollForDecisionTask", request, newWorkerAPIServicePollForDecisionTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PollForDecisionTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServicePollForDecisionTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondDecisionTaskCompleted(ctx context.Context, request *RespondDecisionTaskCompletedRequest, options ...yarpc.CallOption) (*RespondDecisionTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondDecisionTaskCompleted", request, newWorkerAPIServiceRespondDecisionTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondDecisionTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondDecisionTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondDecisionTaskFailed(ctx context.Context, request *RespondDecisionTaskFailedRequest, options ...yarpc.CallOption) (*RespondDecisionTaskFailedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondDecisionTaskFailed", request, newWorkerAPIServiceRespondDecisionTaskFailedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondDecisionTaskFailedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondDecisionTaskFailedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) PollForActivityTask(ctx context.Context, request *PollForActivityTaskRequest, options ...yarpc.CallOption) (*PollForActivityTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PollForActivityTask", request, newWorkerAPIServicePollForActivityTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PollForActivityTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServicePollForActivityTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondActivityTaskCompleted(ctx context.Context, request *RespondActivityTaskCompletedRequest, options ...yarpc.CallOption) (*RespondActivityTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCompleted", request, newWorkerAPIServiceRespondActivityTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondActivityTaskCompletedByID(ctx context.Context, request *RespondActivityTaskCompletedByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskCompletedByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCompletedByID", request, newWorkerAPIServiceRespondActivityTaskCompletedByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCompletedByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskCompletedByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondActivityTaskFailed(ctx context.Context, request *RespondActivityTaskFailedRequest, options ...yarpc.CallOption) (*RespondActivityTaskFailedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskFailed", request, newWorkerAPIServiceRespondActivityTaskFailedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskFailedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskFailedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondActivityTaskFailedByID(ctx context.Context, request *RespondActivityTaskFailedByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskFailedByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskFailedByID", request, newWorkerAPIServiceRespondActivityTaskFailedByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskFailedByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskFailedByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondActivityTaskCanceled(ctx context.Context, request *RespondActivityTaskCanceledRequest, options ...yarpc.CallOption) (*RespondActivityTaskCanceledResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCanceled", request, newWorkerAPIServiceRespondActivityTaskCanceledYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCanceledResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskCanceledYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondActivityTaskCanceledByID(ctx context.Context, request *RespondActivityTaskCanceledByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskCanceledByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCanceledByID", request, newWorkerAPIServiceRespondActivityTaskCanceledByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCanceledByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskCanceledByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RecordActivityTaskHeartbeat(ctx context.Context, request *RecordActivityTaskHeartbeatRequest, options ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RecordActivityTaskHeartbeat", request, newWorkerAPIServiceRecordActivityTaskHeartbeatYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RecordActivityTaskHeartbeatResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRecordActivityTaskHeartbeatYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RecordActivityTaskHeartbeatByID(ctx context.Context, request *RecordActivityTaskHeartbeatByIDRequest, options ...yarpc.CallOption) (*RecordActivityTaskHeartbeatByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RecordActivityTaskHeartbeatByID", request, newWorkerAPIServiceRecordActivityTaskHeartbeatByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RecordActivityTaskHeartbeatByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRecordActivityTaskHeartbeatByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) RespondQueryTaskCompleted(ctx context.Context, request *RespondQueryTaskCompletedRequest, options ...yarpc.CallOption) (*RespondQueryTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondQueryTaskCompleted", request, newWorkerAPIServiceRespondQueryTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondQueryTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceRespondQueryTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkerAPIYARPCCaller) ResetStickyTaskList(ctx context.Context, request *ResetStickyTaskListRequest, options ...yarpc.CallOption) (*ResetStickyTaskListResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResetStickyTaskList", request, newWorkerAPIServiceResetStickyTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResetStickyTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkerAPIServiceResetStickyTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+type _WorkerAPIYARPCHandler struct {
+	server WorkerAPIYARPCServer
+}
+
+func (h *_WorkerAPIYARPCHandler) PollForDecisionTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PollForDecisionTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PollForDecisionTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServicePollForDecisionTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PollForDecisionTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondDecisionTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondDecisionTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondDecisionTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondDecisionTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondDecisionTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondDecisionTaskFailed(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondDecisionTaskFailedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondDecisionTaskFailedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondDecisionTaskFailedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondDecisionTaskFailed(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) PollForActivityTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PollForActivityTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PollForActivityTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServicePollForActivityTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PollForActivityTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondActivityTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondActivityTaskCompletedByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCompletedByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCompletedByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskCompletedByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCompletedByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondActivityTaskFailed(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskFailedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskFailedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskFailedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskFailed(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondActivityTaskFailedByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskFailedByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskFailedByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskFailedByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskFailedByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondActivityTaskCanceled(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCanceledRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCanceledRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskCanceledYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCanceled(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondActivityTaskCanceledByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCanceledByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCanceledByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondActivityTaskCanceledByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCanceledByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RecordActivityTaskHeartbeat(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RecordActivityTaskHeartbeatRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RecordActivityTaskHeartbeatRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRecordActivityTaskHeartbeatYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RecordActivityTaskHeartbeat(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RecordActivityTaskHeartbeatByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RecordActivityTaskHeartbeatByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RecordActivityTaskHeartbeatByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRecordActivityTaskHeartbeatByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RecordActivityTaskHeartbeatByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) RespondQueryTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondQueryTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondQueryTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceRespondQueryTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondQueryTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkerAPIYARPCHandler) ResetStickyTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResetStickyTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResetStickyTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkerAPIServiceResetStickyTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResetStickyTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func newWorkerAPIServicePollForDecisionTaskYARPCRequest() proto.Message {
+	return &PollForDecisionTaskRequest{}
+}
+
+func newWorkerAPIServicePollForDecisionTaskYARPCResponse() proto.Message {
+	return &PollForDecisionTaskResponse{}
+}
+
+func newWorkerAPIServiceRespondDecisionTaskCompletedYARPCRequest() proto.Message {
+	return &RespondDecisionTaskCompletedRequest{}
+}
+
+func newWorkerAPIServiceRespondDecisionTaskCompletedYARPCResponse() proto.Message {
+	return &RespondDecisionTaskCompletedResponse{}
+}
+
+func newWorkerAPIServiceRespondDecisionTaskFailedYARPCRequest() proto.Message {
+	return &RespondDecisionTaskFailedRequest{}
+}
+
+func newWorkerAPIServiceRespondDecisionTaskFailedYARPCResponse() proto.Message {
+	return &RespondDecisionTaskFailedResponse{}
+}
+
+func newWorkerAPIServicePollForActivityTaskYARPCRequest() proto.Message {
+	return &PollForActivityTaskRequest{}
+}
+
+func newWorkerAPIServicePollForActivityTaskYARPCResponse() proto.Message {
+	return &PollForActivityTaskResponse{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskCompletedYARPCRequest() proto.Message {
+	return &RespondActivityTaskCompletedRequest{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskCompletedYARPCResponse() proto.Message {
+	return &RespondActivityTaskCompletedResponse{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskCompletedByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskCompletedByIDRequest{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskCompletedByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskCompletedByIDResponse{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskFailedYARPCRequest() proto.Message {
+	return &RespondActivityTaskFailedRequest{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskFailedYARPCResponse() proto.Message {
+	return &RespondActivityTaskFailedResponse{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskFailedByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskFailedByIDRequest{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskFailedByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskFailedByIDResponse{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskCanceledYARPCRequest() proto.Message {
+	return &RespondActivityTaskCanceledRequest{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskCanceledYARPCResponse() proto.Message {
+	return &RespondActivityTaskCanceledResponse{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskCanceledByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskCanceledByIDRequest{}
+}
+
+func newWorkerAPIServiceRespondActivityTaskCanceledByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskCanceledByIDResponse{}
+}
+
+func newWorkerAPIServiceRecordActivityTaskHeartbeatYARPCRequest() proto.Message {
+	return &RecordActivityTaskHeartbeatRequest{}
+}
+
+func newWorkerAPIServiceRecordActivityTaskHeartbeatYARPCResponse() proto.Message {
+	return &RecordActivityTaskHeartbeatResponse{}
+}
+
+func newWorkerAPIServiceRecordActivityTaskHeartbeatByIDYARPCRequest() proto.Message {
+	return &RecordActivityTaskHeartbeatByIDRequest{}
+}
+
+func newWorkerAPIServiceRecordActivityTaskHeartbeatByIDYARPCResponse() proto.Message {
+	return &RecordActivityTaskHeartbeatByIDResponse{}
+}
+
+func newWorkerAPIServiceRespondQueryTaskCompletedYARPCRequest() proto.Message {
+	return &RespondQueryTaskCompletedRequest{}
+}
+
+func newWorkerAPIServiceRespondQueryTaskCompletedYARPCResponse() proto.Message {
+	return &RespondQueryTaskCompletedResponse{}
+}
+
+func newWorkerAPIServiceResetStickyTaskListYARPCRequest() proto.Message {
+	return &ResetStickyTaskListRequest{}
+}
+
+func newWorkerAPIServiceResetStickyTaskListYARPCResponse() proto.Message {
+	return &ResetStickyTaskListResponse{}
+}
+
+var (
+	emptyWorkerAPIServicePollForDecisionTaskYARPCRequest               = &PollForDecisionTaskRequest{}
+	emptyWorkerAPIServicePollForDecisionTaskYARPCResponse              = &PollForDecisionTaskResponse{}
+	emptyWorkerAPIServiceRespondDecisionTaskCompletedYARPCRequest      = &RespondDecisionTaskCompletedRequest{}
+	emptyWorkerAPIServiceRespondDecisionTaskCompletedYARPCResponse     = &RespondDecisionTaskCompletedResponse{}
+	emptyWorkerAPIServiceRespondDecisionTaskFailedYARPCRequest         = &RespondDecisionTaskFailedRequest{}
+	emptyWorkerAPIServiceRespondDecisionTaskFailedYARPCResponse        = &RespondDecisionTaskFailedResponse{}
+	emptyWorkerAPIServicePollForActivityTaskYARPCRequest               = &PollForActivityTaskRequest{}
+	emptyWorkerAPIServicePollForActivityTaskYARPCResponse              = &PollForActivityTaskResponse{}
+	emptyWorkerAPIServiceRespondActivityTaskCompletedYARPCRequest      = &RespondActivityTaskCompletedRequest{}
+	emptyWorkerAPIServiceRespondActivityTaskCompletedYARPCResponse     = &RespondActivityTaskCompletedResponse{}
+	emptyWorkerAPIServiceRespondActivityTaskCompletedByIDYARPCRequest  = &RespondActivityTaskCompletedByIDRequest{}
+	emptyWorkerAPIServiceRespondActivityTaskCompletedByIDYARPCResponse = &RespondActivityTaskCompletedByIDResponse{}
+	emptyWorkerAPIServiceRespondActivityTaskFailedYARPCRequest         = &RespondActivityTaskFailedRequest{}
+	emptyWorkerAPIServiceRespondActivityTaskFailedYARPCResponse        = &RespondActivityTaskFailedResponse{}
+	emptyWorkerAPIServiceRespondActivityTaskFailedByIDYARPCRequest     = &RespondActivityTaskFailedByIDRequest{}
+	emptyWorkerAPIServiceRespondActivityTaskFailedByIDYARPCResponse    = &RespondActivityTaskFailedByIDResponse{}
+	emptyWorkerAPIServiceRespondActivityTaskCanceledYARPCRequest       = &RespondActivityTaskCanceledRequest{}
+	emptyWorkerAPIServiceRespondActivityTaskCanceledYARPCResponse      = &RespondActivityTaskCanceledResponse{}
+	emptyWorkerAPIServiceRespondActivityTaskCanceledByIDYARPCRequest   = &RespondActivityTaskCanceledByIDRequest{}
+	emptyWorkerAPIServiceRespondActivityTaskCanceledByIDYARPCResponse  = &RespondActivityTaskCanceledByIDResponse{}
+	emptyWorkerAPIServiceRecordActivityTaskHeartbeatYARPCRequest       = &RecordActivityTaskHeartbeatRequest{}
+	emptyWorkerAPIServiceRecordActivityTaskHeartbeatYARPCResponse      = &RecordActivityTaskHeartbeatResponse{}
+	emptyWorkerAPIServiceRecordActivityTaskHeartbeatByIDYARPCRequest   = &RecordActivityTaskHeartbeatByIDRequest{}
+	emptyWorkerAPIServiceRecordActivityTaskHeartbeatByIDYARPCResponse  = &RecordActivityTaskHeartbeatByIDResponse{}
+	emptyWorkerAPIServiceRespondQueryTaskCompletedYARPCRequest         = &RespondQueryTaskCompletedRequest{}
+	emptyWorkerAPIServiceRespondQueryTaskCompletedYARPCResponse        = &RespondQueryTaskCompletedResponse{}
+	emptyWorkerAPIServiceResetStickyTaskListYARPCRequest               = &ResetStickyTaskListRequest{}
+	emptyWorkerAPIServiceResetStickyTaskListYARPCResponse              = &ResetStickyTaskListResponse{}
+)
+
+var yarpcFileDescriptorClosure00684e887839a48c = [][]byte{
+	// uber/cadence/api/v1/service_worker.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcb, 0x6e, 0xe3, 0xc8,
+		0xd5, 0x06, 0x65, 0xcb, 0x97, 0xe3, 0x9b, 0x5c, 0xc6, 0xef, 0x9f, 0xa6, 0xfb, 0xa2, 0x56, 0x5f,
+		0xec, 0x49, 0x26, 0x72, 0xb7, 0x67, 0xe2, 0x71, 0xdf, 0x82, 0xf8, 0xd2, 0x46, 0x3b, 0x98, 0x74,
+		0x3c, 0xb4, 0xa6, 0x07, 0x98, 0x00, 0x4d, 0x94, 0xc8, 0xb2, 0x55, 0x30, 0xc5, 0x52, 0x93, 0x45,
+		0xab, 0xb5, 0xc9, 0x22, 0xcb, 0x99, 0x2c, 0x02, 0x04, 0x99, 0x6c, 0x02, 0xf4, 0x3a, 0x0f, 0x90,
+		0xa7, 0xc9, 0x2a, 0xcb, 0x20, 0x2f, 0x11, 0xb0, 0xaa, 0x28, 0x51, 0x12, 0x45, 0x5d, 0xb2, 0xf0,
+		0x00, 0xd9, 0x99, 0xc5, 0xef, 0x7c, 0x3c, 0x75, 0xce, 0xa9, 0x53, 0x5f, 0x95, 0x05, 0xdb, 0x61,
+		0x95, 0xf8, 0x3b, 0x36, 0x76, 0x88, 0x67, 0x93, 0x1d, 0xdc, 0xa0, 0x3b, 0xd7, 0x4f, 0x76, 0x02,
+		0xe2, 0x5f, 0x53, 0x9b, 0x58, 0x4d, 0xe6, 0x5f, 0x11, 0xbf, 0xdc, 0xf0, 0x19, 0x67, 0x68, 0x2d,
+		0x42, 0x96, 0x15, 0xb2, 0x8c, 0x1b, 0xb4, 0x7c, 0xfd, 0xc4, 0xb8, 0x73, 0xc9, 0xd8, 0xa5, 0x4b,
+		0x76, 0x04, 0xa4, 0x1a, 0x5e, 0xec, 0x38, 0xa1, 0x8f, 0x39, 0x65, 0x9e, 0x34, 0x32, 0xee, 0xf6,
+		0xbe, 0xe7, 0xb4, 0x4e, 0x02, 0x8e, 0xeb, 0x0d, 0x05, 0xe8, 0x23, 0x68, 0xfa, 0xb8, 0xd1, 0x20,
+		0x7e, 0xa0, 0xde, 0x17, 0xd3, 0xfc, 0xb3, 0x59, 0xbd, 0xde, 0xfe, 0x44, 0x29, 0x0d, 0xe1, 0x10,
+		0x9b, 0x06, 0x1d, 0x37, 0xee, 0xa5, 0x61, 0x6a, 0x34, 0xe0, 0xcc, 0x6f, 0xc5, 0x9e, 0xa6, 0x41,
+		0xde, 0x87, 0xc4, 0x6f, 0x65, 0x7d, 0x87, 0xe3, 0xe0, 0xca, 0xa5, 0x01, 0xcf, 0xc2, 0x44, 0x51,
+		0xbc, 0x70, 0x59, 0x53, 0x62, 0x4a, 0x7f, 0xd7, 0xc0, 0x38, 0x63, 0xae, 0x7b, 0xc2, 0xfc, 0x63,
+		0xe5, 0x65, 0x05, 0x07, 0x57, 0x26, 0x79, 0x1f, 0x92, 0x80, 0xa3, 0x75, 0x98, 0x71, 0x58, 0x1d,
+		0x53, 0x4f, 0xd7, 0x8a, 0xda, 0xf6, 0xbc, 0xa9, 0x9e, 0xd0, 0x33, 0x98, 0x8f, 0x3e, 0x66, 0x45,
+		0x5f, 0xd3, 0x73, 0x45, 0x6d, 0x7b, 0x61, 0xf7, 0x76, 0x39, 0x25, 0x25, 0xe5, 0x88, 0xec, 0x4b,
+		0x1a, 0x70, 0x73, 0x8e, 0xab, 0xbf, 0x90, 0x01, 0x73, 0xd4, 0x21, 0x1e, 0xa7, 0xbc, 0xa5, 0x4f,
+		0x09, 0xd6, 0xf6, 0x33, 0xda, 0x82, 0x95, 0x2a, 0xf5, 0xb0, 0xdf, 0xb2, 0xec, 0x1a, 0xb1, 0xaf,
+		0x82, 0xb0, 0xae, 0x4f, 0x0b, 0xc8, 0xb2, 0x1c, 0x3e, 0x52, 0xa3, 0xa5, 0x7f, 0xcd, 0xc1, 0x66,
+		0xaa, 0xdf, 0x41, 0x83, 0x79, 0x01, 0x41, 0xb7, 0x01, 0x84, 0x83, 0x9c, 0x5d, 0x11, 0xe9, 0xfc,
+		0xa2, 0x29, 0x5c, 0xae, 0x44, 0x03, 0xe8, 0x6b, 0x40, 0x71, 0x20, 0x2c, 0xf2, 0x81, 0xd8, 0x61,
+		0x54, 0x25, 0x6a, 0x22, 0x8f, 0x52, 0x27, 0xf2, 0x8d, 0x82, 0xbf, 0x8a, 0xd1, 0xe6, 0x6a, 0xb3,
+		0x77, 0x08, 0x9d, 0xc0, 0x52, 0x9b, 0x96, 0xb7, 0x1a, 0x44, 0xcc, 0x6f, 0x61, 0xf7, 0x5e, 0x26,
+		0x63, 0xa5, 0xd5, 0x20, 0xe6, 0x62, 0x33, 0xf1, 0x84, 0xde, 0xc2, 0x46, 0xc3, 0x27, 0xd7, 0x94,
+		0x85, 0x81, 0x15, 0x70, 0xec, 0x73, 0xe2, 0x58, 0xe4, 0x9a, 0x78, 0xdc, 0xa2, 0x8e, 0x08, 0xc8,
+		0xc2, 0xee, 0x66, 0x59, 0xd6, 0x6a, 0x39, 0xae, 0xd5, 0xf2, 0xa9, 0xc7, 0xf7, 0x3e, 0x7f, 0x8b,
+		0xdd, 0x90, 0x98, 0xeb, 0xb1, 0xf5, 0xb9, 0x34, 0x7e, 0x15, 0xd9, 0x9e, 0x3a, 0x68, 0x1b, 0x0a,
+		0x7d, 0x74, 0xf9, 0xa2, 0xb6, 0x3d, 0x65, 0x2e, 0x07, 0xdd, 0x48, 0x1d, 0x66, 0x31, 0xe7, 0xa4,
+		0xde, 0xe0, 0xfa, 0x8c, 0x00, 0xc4, 0x8f, 0xe8, 0x53, 0x40, 0x55, 0x6c, 0x5f, 0xb9, 0xec, 0xd2,
+		0xb2, 0x59, 0xe8, 0x71, 0xab, 0x46, 0x3d, 0xae, 0xcf, 0x0a, 0x50, 0x41, 0xbd, 0x39, 0x8a, 0x5e,
+		0xbc, 0xa6, 0x1e, 0x47, 0x7b, 0x30, 0xab, 0x2a, 0x5b, 0x9f, 0x13, 0x7e, 0xdf, 0x4a, 0x8d, 0xc5,
+		0x6b, 0x89, 0x31, 0x63, 0x30, 0x7a, 0x04, 0x2b, 0x1e, 0xf9, 0xc0, 0xad, 0x06, 0xbe, 0x24, 0x2a,
+		0x89, 0xf3, 0x22, 0x89, 0x4b, 0xd1, 0xf0, 0x19, 0xbe, 0x24, 0x32, 0x91, 0xfb, 0x90, 0x17, 0xcb,
+		0x42, 0x07, 0xc1, 0x5e, 0xca, 0x8c, 0xf4, 0x57, 0x11, 0xd2, 0x94, 0x06, 0xe8, 0x1d, 0xdc, 0xea,
+		0x2f, 0x01, 0xab, 0x53, 0xd5, 0x0b, 0xa3, 0x54, 0xf5, 0x46, 0x5f, 0x0d, 0xc4, 0xaf, 0xd0, 0x01,
+		0x2c, 0x07, 0x76, 0x8d, 0x38, 0xa1, 0x4b, 0x1c, 0x2b, 0x6a, 0x34, 0xfa, 0xa2, 0x60, 0x34, 0xfa,
+		0x12, 0x57, 0x89, 0xbb, 0x90, 0xb9, 0xd4, 0xb6, 0x88, 0xc6, 0xd0, 0x4b, 0x58, 0x8c, 0xd3, 0x25,
+		0x08, 0x96, 0x86, 0x12, 0x2c, 0x28, 0xbc, 0x30, 0xff, 0x06, 0x66, 0xa3, 0xa9, 0x52, 0x12, 0xe8,
+		0xcb, 0xc5, 0xa9, 0xed, 0x85, 0xdd, 0x97, 0xa9, 0x93, 0xc9, 0x58, 0x46, 0xe5, 0xaf, 0xa4, 0xfd,
+		0x2b, 0x8f, 0x47, 0xc9, 0x51, 0x6c, 0xa8, 0x04, 0x22, 0x0b, 0x9d, 0x1a, 0x5a, 0x11, 0xd9, 0x5f,
+		0x88, 0x06, 0xe3, 0x02, 0x2a, 0xc3, 0x1a, 0x67, 0x1c, 0xbb, 0x96, 0xca, 0xa8, 0x55, 0x6d, 0x71,
+		0x12, 0xe8, 0x05, 0x81, 0x5c, 0x15, 0xaf, 0x54, 0xd2, 0x0f, 0xa3, 0x17, 0xe8, 0x29, 0x6c, 0xd8,
+		0xcc, 0xe3, 0xd4, 0x0b, 0x89, 0x85, 0x03, 0xcb, 0x23, 0x4d, 0x2b, 0x08, 0x2f, 0x2f, 0x49, 0xc0,
+		0x89, 0xa3, 0xaf, 0x16, 0xb5, 0xed, 0x39, 0x73, 0x3d, 0x06, 0x1c, 0x04, 0x6f, 0x48, 0xf3, 0x3c,
+		0x7e, 0x6b, 0xbc, 0x83, 0xc5, 0xa4, 0x9f, 0xa8, 0x00, 0x53, 0x57, 0xa4, 0xa5, 0x3a, 0x56, 0xf4,
+		0x67, 0x54, 0x25, 0xd7, 0xd1, 0xc2, 0xd0, 0x73, 0xa3, 0x57, 0x89, 0x30, 0x78, 0x96, 0xdb, 0xd7,
+		0x4a, 0x7f, 0xcb, 0xc3, 0x7d, 0x19, 0x11, 0x27, 0x19, 0xa4, 0x23, 0x56, 0x6f, 0xb8, 0x84, 0x13,
+		0x27, 0x6e, 0x96, 0x43, 0x7a, 0xce, 0x73, 0x98, 0x8f, 0x37, 0x82, 0x40, 0xcf, 0x15, 0xa7, 0x06,
+		0x56, 0x57, 0xfc, 0x11, 0xb3, 0x83, 0x47, 0x3f, 0x85, 0xd5, 0x4e, 0x91, 0x46, 0x71, 0x20, 0x1f,
+		0xb8, 0xe8, 0x2e, 0x8b, 0x66, 0xa1, 0xfd, 0xe2, 0x48, 0x8e, 0x77, 0x75, 0xd8, 0xe9, 0x9e, 0x0e,
+		0xfb, 0x5b, 0x58, 0x0d, 0x38, 0xb5, 0xaf, 0x5a, 0x16, 0xe6, 0xdc, 0xa7, 0xd5, 0x30, 0xca, 0x4a,
+		0x5e, 0x84, 0xa5, 0x9c, 0xea, 0xcd, 0xb9, 0x40, 0xb7, 0xeb, 0xfb, 0xa0, 0x6d, 0x65, 0x16, 0x24,
+		0x51, 0x67, 0x04, 0x7d, 0x01, 0xba, 0x4f, 0x78, 0xe8, 0x7b, 0x22, 0x7f, 0xb1, 0xf7, 0x62, 0x51,
+		0x89, 0x36, 0x32, 0x67, 0xfe, 0x9f, 0x7c, 0xff, 0x86, 0x34, 0x93, 0xa1, 0x44, 0x87, 0x70, 0xe7,
+		0x82, 0xf9, 0x36, 0xb1, 0x6c, 0x9f, 0x60, 0x4e, 0x52, 0xcc, 0x67, 0x85, 0xb9, 0x21, 0x50, 0x47,
+		0x02, 0xd4, 0xcb, 0x91, 0xb2, 0x77, 0xcc, 0xa5, 0xed, 0x1d, 0x88, 0xc1, 0x92, 0x68, 0x01, 0x96,
+		0x4f, 0x82, 0xd0, 0xe5, 0x81, 0x3e, 0x2f, 0x92, 0xf1, 0xab, 0xd4, 0xe9, 0x8f, 0x90, 0xf8, 0xb2,
+		0xac, 0x18, 0x49, 0x26, 0x97, 0xca, 0xe2, 0xfb, 0xc4, 0x90, 0x41, 0x61, 0xb5, 0x0f, 0x92, 0x52,
+		0xa5, 0xbf, 0xe8, 0xae, 0xd2, 0xed, 0x11, 0xaa, 0x54, 0x10, 0x26, 0x6b, 0xf5, 0xe3, 0x14, 0x3c,
+		0xc8, 0x76, 0x59, 0x6d, 0x90, 0x5f, 0xc3, 0x52, 0x77, 0x80, 0x35, 0xf1, 0xd1, 0xc7, 0xe3, 0xb6,
+		0x08, 0x73, 0xd1, 0x49, 0x26, 0xe1, 0xa3, 0x06, 0x77, 0xb0, 0xcd, 0xe9, 0x35, 0xe5, 0x94, 0x04,
+		0x16, 0x67, 0x96, 0x43, 0x83, 0x06, 0xe6, 0x76, 0xcd, 0x72, 0x99, 0x8d, 0x5d, 0xb7, 0xa5, 0x4a,
+		0xff, 0xdb, 0x09, 0xa2, 0xad, 0x9a, 0xd2, 0x41, 0x9b, 0xbf, 0xc2, 0x8e, 0x15, 0xfb, 0x97, 0x92,
+		0x5c, 0x46, 0x7f, 0x13, 0x0f, 0x46, 0x18, 0xbf, 0x83, 0xe2, 0x30, 0x82, 0x94, 0xdc, 0x1c, 0x77,
+		0xe7, 0x26, 0x7d, 0xa9, 0x28, 0xde, 0x96, 0xe0, 0x8a, 0x89, 0x4f, 0xbd, 0x0b, 0x96, 0xcc, 0xd0,
+		0xef, 0x73, 0x50, 0x4c, 0x99, 0xe6, 0x09, 0xa6, 0xee, 0xc8, 0xad, 0xe4, 0x10, 0xf2, 0x36, 0x0e,
+		0x03, 0xe9, 0xcd, 0xf2, 0xee, 0xa7, 0x99, 0x6d, 0xa4, 0xc3, 0x7e, 0x14, 0xd9, 0x98, 0xd2, 0x34,
+		0xda, 0x99, 0x1d, 0xc2, 0x31, 0x75, 0x03, 0x7d, 0x2a, 0x63, 0x67, 0x3e, 0xc3, 0x2d, 0x97, 0x61,
+		0xc7, 0x8c, 0xc1, 0x99, 0xcd, 0x25, 0x65, 0x09, 0xe6, 0x53, 0xe5, 0xdb, 0x7d, 0xb8, 0x97, 0x11,
+		0x03, 0x99, 0xe7, 0xd2, 0x3f, 0x3b, 0xda, 0x34, 0x8e, 0xec, 0x4d, 0x6a, 0xd3, 0x73, 0x40, 0x6d,
+		0x5e, 0xab, 0x4e, 0x38, 0x76, 0x30, 0xc7, 0x4a, 0x8d, 0x3d, 0xcc, 0xfc, 0xc0, 0xaf, 0x15, 0xd8,
+		0x2c, 0xf0, 0x9e, 0x91, 0xd2, 0xf7, 0x1d, 0x1d, 0xdb, 0x3d, 0xc7, 0x1b, 0xd5, 0xb1, 0x77, 0x61,
+		0x41, 0x2d, 0xa1, 0x56, 0xb4, 0xbd, 0xcb, 0x48, 0x40, 0x3c, 0x74, 0xea, 0x44, 0x42, 0xb7, 0x0d,
+		0x10, 0x42, 0x77, 0x3a, 0x43, 0xe8, 0xb6, 0x27, 0x26, 0x84, 0x2e, 0x4e, 0x3c, 0xa1, 0x5d, 0xc8,
+		0x53, 0xaf, 0x11, 0x72, 0x3d, 0x3f, 0x42, 0x09, 0x4a, 0x68, 0x8a, 0xb0, 0x9a, 0xf9, 0x6f, 0x85,
+		0xd5, 0xec, 0x78, 0xc2, 0xaa, 0x02, 0x1b, 0x31, 0x5f, 0xd4, 0xe1, 0x6c, 0x97, 0x05, 0x44, 0x10,
+		0xb1, 0x90, 0x2b, 0x99, 0xbb, 0xd1, 0xc7, 0x75, 0xac, 0xce, 0xa2, 0xe6, 0x7a, 0x6c, 0x5b, 0x61,
+		0x47, 0x91, 0x65, 0x45, 0x1a, 0xa2, 0x37, 0xb0, 0x2e, 0x3e, 0xd2, 0x4f, 0x39, 0x3f, 0x8c, 0x72,
+		0x4d, 0x18, 0xf6, 0xf0, 0x9d, 0xc0, 0x6a, 0x8d, 0x60, 0x9f, 0x57, 0x09, 0xe6, 0x6d, 0x2a, 0x18,
+		0x46, 0x55, 0x68, 0xdb, 0xc4, 0x3c, 0x89, 0xa3, 0x40, 0xa4, 0x89, 0xf3, 0x9d, 0xa3, 0xc0, 0x3b,
+		0xb8, 0xd3, 0x9d, 0x09, 0x8b, 0x5d, 0x58, 0xbc, 0x46, 0x03, 0x2b, 0x36, 0x18, 0x2e, 0x79, 0x8d,
+		0xae, 0xcc, 0xfc, 0xe6, 0xa2, 0x52, 0xa3, 0xc1, 0x81, 0xe2, 0x3f, 0x4d, 0xce, 0x20, 0x6e, 0x56,
+		0x4b, 0x23, 0x54, 0x4a, 0x67, 0x12, 0xc7, 0xaa, 0x6b, 0xf5, 0x9d, 0xcc, 0x96, 0x27, 0x3b, 0x99,
+		0x6d, 0xc1, 0x4a, 0x9b, 0x47, 0x75, 0x9f, 0x15, 0xd9, 0xe1, 0xe2, 0xe1, 0x63, 0x31, 0x8a, 0x3e,
+		0x83, 0x99, 0x1a, 0xc1, 0x0e, 0xf1, 0xf5, 0x82, 0x3a, 0xaf, 0xa5, 0x9e, 0x7b, 0x04, 0xc4, 0x54,
+		0xd0, 0xd2, 0x0f, 0x5a, 0x5b, 0x69, 0x26, 0xbb, 0xc1, 0xb8, 0x4a, 0xf3, 0x73, 0x98, 0x91, 0xd2,
+		0x46, 0xcf, 0x8d, 0x10, 0x2c, 0x85, 0xcd, 0xea, 0x7d, 0xa5, 0x47, 0xf0, 0x20, 0xdb, 0x2f, 0xd5,
+		0xb2, 0xbf, 0xcf, 0xc1, 0x56, 0x16, 0xf0, 0xb0, 0x75, 0x7a, 0x3c, 0xac, 0x7f, 0xdf, 0x54, 0x4f,
+		0xeb, 0x44, 0x6d, 0x7a, 0xc2, 0xa8, 0xe5, 0x7b, 0xa2, 0xf6, 0x13, 0xd8, 0x1e, 0x1e, 0x0c, 0x15,
+		0xb9, 0x3f, 0x6b, 0x50, 0x4c, 0x01, 0x8f, 0x25, 0x0b, 0xf6, 0x60, 0xf6, 0x02, 0x53, 0x37, 0xf4,
+		0x49, 0x66, 0xe2, 0x4f, 0x24, 0xc6, 0x8c, 0xc1, 0x99, 0x99, 0xef, 0xec, 0xd4, 0x69, 0x6e, 0x29,
+		0xe7, 0xbf, 0xcb, 0xc1, 0x83, 0x81, 0xa8, 0x1f, 0x73, 0xce, 0x13, 0x11, 0x9b, 0x9e, 0x34, 0x62,
+		0xbd, 0x59, 0xdf, 0x82, 0x87, 0x43, 0x62, 0xa1, 0xa2, 0xf6, 0x17, 0x0d, 0x4a, 0x69, 0xf5, 0x81,
+		0x3d, 0x9b, 0x8c, 0x95, 0xf4, 0xb8, 0x35, 0xe6, 0x26, 0xd5, 0x71, 0xbd, 0x49, 0x7f, 0x08, 0xf7,
+		0x33, 0x1d, 0x53, 0x13, 0xf8, 0x43, 0x0e, 0x1e, 0x65, 0xe0, 0x7e, 0xe4, 0x89, 0x8f, 0xa3, 0x36,
+		0x3d, 0x69, 0xd4, 0x7a, 0x13, 0xff, 0x09, 0x6c, 0x0d, 0x8d, 0x46, 0x57, 0xea, 0x6d, 0xe6, 0x77,
+		0x41, 0x5f, 0xc7, 0xbb, 0xd6, 0x0d, 0xa6, 0xfe, 0x0c, 0xee, 0x67, 0x3a, 0xa6, 0x74, 0xe9, 0x27,
+		0x50, 0xb0, 0xc5, 0xc4, 0x2c, 0x5f, 0xfa, 0x4a, 0x1c, 0xe1, 0xdf, 0x9c, 0xb9, 0x22, 0xc7, 0xcd,
+		0x78, 0x58, 0x55, 0xc9, 0x40, 0xca, 0xff, 0xb5, 0x2a, 0xa9, 0xc0, 0xd6, 0xd0, 0x68, 0x8c, 0x1f,
+		0xe4, 0x7f, 0x74, 0xb6, 0x0f, 0x71, 0x33, 0x30, 0x89, 0x6c, 0xf8, 0x65, 0x8f, 0x6c, 0x18, 0xfd,
+		0x02, 0x22, 0xde, 0x0c, 0xdf, 0xc2, 0x9a, 0xfc, 0x2f, 0x8d, 0x75, 0x4d, 0x7c, 0x71, 0xb5, 0x40,
+		0xbd, 0x0b, 0xa6, 0x4f, 0x0d, 0x49, 0x14, 0xf1, 0xdf, 0x4a, 0xb8, 0x38, 0x2b, 0xaf, 0x36, 0x7b,
+		0x87, 0x12, 0x9b, 0x50, 0xda, 0xe4, 0x62, 0xed, 0xa1, 0x81, 0x61, 0x92, 0x80, 0x70, 0x79, 0x63,
+		0xd5, 0x3e, 0xdd, 0xdd, 0x48, 0x6d, 0x95, 0x6e, 0xc3, 0x66, 0xaa, 0x33, 0xd2, 0xd9, 0xdd, 0x7f,
+		0xaf, 0xc0, 0xbc, 0x9c, 0xfa, 0xc1, 0xd9, 0x29, 0xfa, 0x00, 0x6b, 0x29, 0x57, 0x2c, 0x68, 0x67,
+		0xf4, 0xcb, 0x18, 0x31, 0x47, 0x63, 0xec, 0xdb, 0x1b, 0xf4, 0x27, 0x0d, 0x6e, 0x65, 0x5d, 0xba,
+		0xa0, 0xfd, 0x49, 0x6f, 0xc5, 0x8c, 0xa7, 0x13, 0xdf, 0xf0, 0xa0, 0xef, 0x34, 0xd8, 0x18, 0x78,
+		0x3f, 0x80, 0x7e, 0x3e, 0x2a, 0x71, 0x97, 0x78, 0x32, 0xf6, 0xc6, 0x35, 0x53, 0xce, 0x74, 0x92,
+		0x93, 0x5c, 0xb1, 0xd9, 0xc9, 0x49, 0xb9, 0xaf, 0x30, 0x1e, 0x8f, 0x6e, 0xd0, 0x9f, 0x9c, 0x54,
+		0x01, 0x99, 0x9d, 0x9c, 0xac, 0x13, 0x84, 0xf1, 0x74, 0x02, 0x4b, 0xe5, 0xd5, 0xc7, 0x74, 0xa5,
+		0xda, 0x25, 0x6b, 0xd1, 0x8b, 0xb1, 0xf9, 0x13, 0xfb, 0x80, 0xf1, 0x72, 0x42, 0xeb, 0xfe, 0xf2,
+		0xe9, 0x97, 0x60, 0xd9, 0xe5, 0x33, 0x50, 0x7b, 0x1b, 0x7b, 0xe3, 0x9a, 0x29, 0x67, 0x7e, 0xd0,
+		0xe0, 0x76, 0xa6, 0x1e, 0x44, 0x4f, 0xc7, 0x63, 0x4e, 0x06, 0xea, 0xd9, 0x24, 0xa6, 0xca, 0xb1,
+		0x3f, 0x6a, 0xb0, 0x99, 0x82, 0x8c, 0xf5, 0x0a, 0xfa, 0x62, 0xe4, 0x24, 0x74, 0x0b, 0x56, 0x63,
+		0x7f, 0x7c, 0x43, 0xe5, 0xd2, 0x5f, 0x35, 0xb8, 0x3b, 0x44, 0x42, 0xa1, 0xe7, 0xe3, 0xb2, 0x27,
+		0xe3, 0xf5, 0x62, 0x32, 0xe3, 0xae, 0x88, 0x0d, 0xdc, 0xbb, 0x07, 0x46, 0x6c, 0x98, 0xce, 0x33,
+		0xf6, 0xc7, 0x37, 0xec, 0x8a, 0x58, 0xa6, 0x9c, 0x18, 0x18, 0xb1, 0x51, 0x24, 0x99, 0xf1, 0x62,
+		0x32, 0xe3, 0xfe, 0x95, 0xd8, 0xbf, 0x73, 0x67, 0xaf, 0xc4, 0x81, 0x32, 0xc6, 0xd8, 0x1b, 0xd7,
+		0xac, 0xd3, 0xc8, 0x53, 0xb6, 0xe4, 0x01, 0x8d, 0x7c, 0xb0, 0x92, 0x30, 0x1e, 0x8f, 0x6e, 0x20,
+		0xbf, 0x7c, 0x58, 0x85, 0xff, 0xb7, 0x59, 0x3d, 0xcd, 0xec, 0x10, 0x49, 0x15, 0x70, 0x2e, 0x7f,
+		0xe4, 0x72, 0xe6, 0x33, 0xce, 0xce, 0xb4, 0x6f, 0x9f, 0x5c, 0x52, 0x5e, 0x0b, 0xab, 0x65, 0x9b,
+		0xd5, 0x77, 0x92, 0xbf, 0xe2, 0xf8, 0x19, 0x75, 0xdc, 0x9d, 0x4b, 0x26, 0x7f, 0xa0, 0xa2, 0x7e,
+		0xd2, 0xf1, 0x1c, 0x37, 0xe8, 0xf5, 0x93, 0xea, 0x8c, 0x18, 0xfb, 0xec, 0x3f, 0x03, 0x00, 0xd1,
+		0xc2, 0x2e, 0x23, 0x44, 0x23, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/wrappers.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0x2f, 0x4a, 0x2c,
+		0x28, 0x48, 0x2d, 0x2a, 0xd6, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0xca,
+		0x5c, 0xdc, 0x2e, 0xf9, 0xa5, 0x49, 0x39, 0xa9, 0x61, 0x89, 0x39, 0xa5, 0xa9, 0x42, 0x22, 0x5c,
+		0xac, 0x65, 0x20, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x63, 0x10, 0x84, 0xa3, 0xa4, 0xc4, 0xc5,
+		0xe5, 0x96, 0x93, 0x9f, 0x58, 0x82, 0x45, 0x0d, 0x13, 0x92, 0x1a, 0xcf, 0xbc, 0x12, 0x33, 0x13,
+		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
+		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
+		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x33,
+		0x23, 0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xf0, 0x3a, 0xf1, 0x86, 0x43, 0xc3, 0x3f, 0x00,
+		0x24, 0x12, 0xc0, 0x18, 0x65, 0x08, 0x55, 0x91, 0x9e, 0x9f, 0x93, 0x98, 0x97, 0xae, 0x97, 0x5f,
+		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
+		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
+		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/common.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdf, 0x72, 0xdb, 0xc4,
+		0x17, 0xfe, 0x29, 0x8e, 0x9d, 0xe4, 0xd8, 0x4d, 0xfc, 0xdb, 0x90, 0xc4, 0x49, 0x09, 0xa4, 0x9a,
+		0x61, 0x1a, 0x3a, 0x20, 0x4f, 0xdc, 0x9b, 0x0e, 0x9d, 0x02, 0x4e, 0xec, 0x24, 0x6a, 0x83, 0x6d,
+		0x64, 0xd3, 0x4c, 0x61, 0x06, 0xcd, 0x5a, 0x5a, 0xb9, 0x8b, 0xa5, 0x5d, 0xb1, 0x5a, 0x39, 0xf1,
+		0x0d, 0xc3, 0x93, 0x70, 0xc1, 0xeb, 0x70, 0xc9, 0x0b, 0x31, 0xab, 0x3f, 0xb1, 0x5d, 0x9c, 0x29,
+		0x17, 0x0c, 0x77, 0xbb, 0xe7, 0xfb, 0xce, 0x39, 0xdf, 0xae, 0xce, 0x39, 0x5a, 0x38, 0x8a, 0x87,
+		0x44, 0xd4, 0x1d, 0xec, 0x12, 0xe6, 0x90, 0x3a, 0x0e, 0x69, 0x7d, 0x72, 0x52, 0x77, 0x78, 0x10,
+		0x70, 0x66, 0x84, 0x82, 0x4b, 0x8e, 0xb6, 0x15, 0xc3, 0xc8, 0x18, 0x06, 0x0e, 0xa9, 0x31, 0x39,
+		0x39, 0xf8, 0x68, 0xc4, 0xf9, 0xc8, 0x27, 0xf5, 0x84, 0x32, 0x8c, 0xbd, 0xba, 0x1b, 0x0b, 0x2c,
+		0x69, 0xee, 0xa4, 0xbf, 0x82, 0xff, 0x5f, 0x73, 0x31, 0xf6, 0x7c, 0x7e, 0xd3, 0xbe, 0x25, 0x4e,
+		0xac, 0x20, 0xf4, 0x31, 0x94, 0x6f, 0x32, 0xa3, 0x4d, 0xdd, 0x9a, 0x76, 0xa4, 0x1d, 0x6f, 0x58,
+		0x90, 0x9b, 0x4c, 0x17, 0xed, 0x40, 0x49, 0xc4, 0x4c, 0x61, 0x2b, 0x09, 0x56, 0x14, 0x31, 0x33,
+		0x5d, 0x5d, 0x87, 0x4a, 0x1e, 0x6c, 0x30, 0x0d, 0x09, 0x42, 0xb0, 0xca, 0x70, 0x40, 0xb2, 0x00,
+		0xc9, 0x5a, 0x71, 0x9a, 0x8e, 0xa4, 0x13, 0x2a, 0xa7, 0xf7, 0x72, 0x0e, 0x61, 0xad, 0x87, 0xa7,
+		0x3e, 0xc7, 0xae, 0x82, 0x5d, 0x2c, 0x71, 0x02, 0x57, 0xac, 0x64, 0xad, 0x3f, 0x87, 0xb5, 0x73,
+		0x4c, 0xfd, 0x58, 0x10, 0xb4, 0x0b, 0x25, 0x41, 0x70, 0xc4, 0x59, 0xe6, 0x9f, 0xed, 0x50, 0x0d,
+		0xd6, 0x5c, 0x22, 0x31, 0xf5, 0xa3, 0x44, 0x61, 0xc5, 0xca, 0xb7, 0xfa, 0x6f, 0x1a, 0xac, 0x7e,
+		0x43, 0x02, 0x8e, 0x5e, 0x40, 0xc9, 0xa3, 0xc4, 0x77, 0xa3, 0x9a, 0x76, 0x54, 0x38, 0x2e, 0x37,
+		0x3e, 0x31, 0x96, 0xdc, 0x9f, 0xa1, 0xa8, 0xc6, 0x79, 0xc2, 0x6b, 0x33, 0x29, 0xa6, 0x56, 0xe6,
+		0x74, 0x70, 0x0d, 0xe5, 0x39, 0x33, 0xaa, 0x42, 0x61, 0x4c, 0xa6, 0x99, 0x0a, 0xb5, 0x44, 0x0d,
+		0x28, 0x4e, 0xb0, 0x1f, 0x93, 0x44, 0x40, 0xb9, 0xf1, 0xe1, 0xd2, 0xf0, 0xd9, 0x31, 0xad, 0x94,
+		0xfa, 0xc5, 0xca, 0x33, 0x4d, 0xff, 0x5d, 0x83, 0xd2, 0x25, 0xc1, 0x2e, 0x11, 0xe8, 0xab, 0x77,
+		0x24, 0x3e, 0x5e, 0x1a, 0x23, 0x25, 0xff, 0xb7, 0x22, 0xff, 0xd4, 0xa0, 0xda, 0x27, 0x58, 0x38,
+		0x6f, 0x9b, 0x52, 0x0a, 0x3a, 0x8c, 0x25, 0x89, 0x90, 0x0d, 0x9b, 0x94, 0xb9, 0xe4, 0x96, 0xb8,
+		0xf6, 0x82, 0xec, 0x67, 0x4b, 0xa3, 0xbe, 0xeb, 0x6e, 0x98, 0xa9, 0xef, 0xfc, 0x39, 0x1e, 0xd0,
+		0x79, 0xdb, 0xc1, 0x8f, 0x80, 0xfe, 0x4e, 0xfa, 0x17, 0x4f, 0xe5, 0xc1, 0x7a, 0x0b, 0x4b, 0x7c,
+		0xea, 0xf3, 0x21, 0x3a, 0x87, 0x07, 0x84, 0x39, 0xdc, 0xa5, 0x6c, 0x64, 0xcb, 0x69, 0x98, 0x16,
+		0xe8, 0x66, 0xe3, 0xd1, 0xd2, 0x58, 0xed, 0x8c, 0xa9, 0x2a, 0xda, 0xaa, 0x90, 0xb9, 0xdd, 0x5d,
+		0x01, 0xaf, 0xcc, 0x15, 0x70, 0x2f, 0x6d, 0x3a, 0x22, 0x5e, 0x13, 0x11, 0x51, 0xce, 0x4c, 0xe6,
+		0x71, 0x45, 0xa4, 0x41, 0xe8, 0xe7, 0x8d, 0xa0, 0xd6, 0xe8, 0x31, 0x6c, 0x79, 0x04, 0xcb, 0x58,
+		0x10, 0x7b, 0x92, 0x52, 0xb3, 0x86, 0xdb, 0xcc, 0xcc, 0x59, 0x00, 0xfd, 0x15, 0xec, 0xf5, 0xe3,
+		0x30, 0xe4, 0x42, 0x12, 0xf7, 0xcc, 0xa7, 0x84, 0xc9, 0x0c, 0x89, 0x54, 0xaf, 0x8e, 0xb8, 0x1d,
+		0xb9, 0xe3, 0x2c, 0x72, 0x71, 0xc4, 0xfb, 0xee, 0x18, 0xed, 0xc3, 0xfa, 0x4f, 0x78, 0x82, 0x13,
+		0x20, 0x8d, 0xb9, 0xa6, 0xf6, 0x7d, 0x77, 0xac, 0xff, 0x5a, 0x80, 0xb2, 0x45, 0xa4, 0x98, 0xf6,
+		0xb8, 0x4f, 0x9d, 0x29, 0x6a, 0x41, 0x95, 0x32, 0x2a, 0x29, 0xf6, 0x6d, 0xca, 0x24, 0x11, 0x13,
+		0x9c, 0xaa, 0x2c, 0x37, 0xf6, 0x8d, 0x74, 0xbc, 0x18, 0xf9, 0x78, 0x31, 0x5a, 0xd9, 0x78, 0xb1,
+		0xb6, 0x32, 0x17, 0x33, 0xf3, 0x40, 0x75, 0xd8, 0x1e, 0x62, 0x67, 0xcc, 0x3d, 0xcf, 0x76, 0x38,
+		0xf1, 0x3c, 0xea, 0x28, 0x99, 0x49, 0x6e, 0xcd, 0x42, 0x19, 0x74, 0x36, 0x43, 0x54, 0xda, 0x00,
+		0xdf, 0xd2, 0x20, 0x0e, 0x66, 0x69, 0x0b, 0xef, 0x4d, 0x9b, 0xb9, 0xdc, 0xa5, 0xfd, 0x74, 0x16,
+		0x05, 0x4b, 0x49, 0x82, 0x50, 0x46, 0xb5, 0xd5, 0x23, 0xed, 0xb8, 0x78, 0x47, 0x6d, 0x66, 0x66,
+		0xf4, 0x02, 0x1e, 0x32, 0xce, 0x6c, 0xa1, 0x8e, 0x8e, 0x87, 0x3e, 0xb1, 0x89, 0x10, 0x5c, 0xd8,
+		0xe9, 0x48, 0x89, 0x6a, 0xc5, 0xa3, 0xc2, 0xf1, 0x86, 0x55, 0x63, 0x9c, 0x59, 0x39, 0xa3, 0xad,
+		0x08, 0x56, 0x8a, 0xa3, 0x97, 0xb0, 0x4d, 0x6e, 0x43, 0x9a, 0x0a, 0x99, 0x49, 0x2e, 0xbd, 0x4f,
+		0x32, 0x9a, 0x79, 0xe5, 0xaa, 0xf5, 0x00, 0xf6, 0xcc, 0x88, 0xfb, 0x89, 0xf1, 0x42, 0xf0, 0x38,
+		0xec, 0x61, 0x21, 0xa9, 0xda, 0x2d, 0x1b, 0x98, 0xe8, 0x4b, 0x28, 0x46, 0x12, 0xcb, 0xb4, 0xe0,
+		0x37, 0x1b, 0xc7, 0x4b, 0x8b, 0x74, 0x31, 0x60, 0x5f, 0xf1, 0xad, 0xd4, 0x4d, 0x9f, 0xc0, 0xc3,
+		0x45, 0xf4, 0x8c, 0x33, 0x8f, 0x8e, 0x32, 0x85, 0xe8, 0x1a, 0xaa, 0x34, 0x87, 0xed, 0x91, 0xc2,
+		0xf3, 0xd6, 0xfe, 0xec, 0x1f, 0x64, 0xba, 0x93, 0x6e, 0x6d, 0xd1, 0x05, 0x20, 0xd2, 0xff, 0xd0,
+		0xe0, 0xa0, 0x19, 0x4d, 0x99, 0x93, 0xff, 0x36, 0x16, 0xf3, 0xd6, 0x60, 0x8d, 0x30, 0x75, 0xcf,
+		0xe9, 0x3f, 0x68, 0xdd, 0xca, 0xb7, 0xa8, 0x01, 0x3b, 0xa1, 0x20, 0x2e, 0xf1, 0x28, 0x23, 0xae,
+		0xfd, 0x73, 0x4c, 0x62, 0x62, 0x27, 0xb7, 0x92, 0x96, 0xf2, 0xf6, 0x0c, 0xfc, 0x56, 0x61, 0x1d,
+		0x75, 0x49, 0x87, 0x00, 0x29, 0x31, 0x69, 0xe7, 0x42, 0x42, 0xdc, 0x48, 0x2c, 0x49, 0xa3, 0x7e,
+		0x0d, 0x95, 0x14, 0x76, 0x12, 0x0d, 0x49, 0x91, 0x94, 0x1b, 0x87, 0x4b, 0x0f, 0x98, 0x4f, 0x09,
+		0xab, 0x9c, 0xb8, 0xa4, 0xaa, 0x9f, 0xdc, 0x40, 0x65, 0x7e, 0x10, 0xa0, 0x7d, 0xd8, 0x69, 0x77,
+		0xce, 0xba, 0x2d, 0xb3, 0x73, 0x61, 0x0f, 0xde, 0xf4, 0xda, 0xb6, 0xd9, 0x79, 0xdd, 0xbc, 0x32,
+		0x5b, 0xd5, 0xff, 0xa1, 0x03, 0xd8, 0x5d, 0x84, 0x06, 0x97, 0x96, 0x79, 0x3e, 0xb0, 0xae, 0xab,
+		0x1a, 0xda, 0x05, 0xb4, 0x88, 0xbd, 0xec, 0x77, 0x3b, 0xd5, 0x15, 0x54, 0x83, 0x0f, 0x16, 0xed,
+		0x3d, 0xab, 0x3b, 0xe8, 0x3e, 0xad, 0x16, 0x9e, 0xfc, 0x02, 0xdb, 0x4b, 0x3e, 0x2e, 0x7a, 0x04,
+		0x87, 0x66, 0xbf, 0x7b, 0xd5, 0x1c, 0x98, 0xdd, 0x8e, 0x7d, 0x61, 0x75, 0xbf, 0xeb, 0xd9, 0xfd,
+		0x41, 0x73, 0x30, 0xaf, 0xe3, 0x5e, 0xca, 0x65, 0xbb, 0x79, 0x35, 0xb8, 0x7c, 0x53, 0xd5, 0xee,
+		0xa7, 0xb4, 0xac, 0xa6, 0xd9, 0x69, 0xb7, 0xaa, 0x2b, 0xa7, 0x3f, 0xc0, 0x9e, 0xc3, 0x83, 0x65,
+		0x37, 0x75, 0x5a, 0x3e, 0x4b, 0x9e, 0x28, 0x3d, 0x55, 0xf5, 0x3d, 0xed, 0xfb, 0x93, 0x11, 0x95,
+		0x6f, 0xe3, 0xa1, 0xe1, 0xf0, 0xa0, 0x3e, 0xff, 0xa0, 0xf9, 0x9c, 0xba, 0x7e, 0x7d, 0xc4, 0xd3,
+		0x67, 0x4a, 0xf6, 0xba, 0x79, 0x8e, 0x43, 0x3a, 0x39, 0x19, 0x96, 0x12, 0xdb, 0xd3, 0xbf, 0x06,
+		0x00, 0x57, 0xd9, 0xb2, 0xe0, 0x01, 0x09, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/decision.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6e, 0x14, 0xc7,
+		0x16, 0xbe, 0xed, 0x9f, 0xf9, 0x39, 0x1e, 0x03, 0x2e, 0x83, 0xb1, 0xc1, 0x60, 0x33, 0x57, 0xd7,
+		0x5c, 0xb0, 0x3c, 0x63, 0x1b, 0x2e, 0x42, 0x80, 0xd0, 0xc5, 0x06, 0x0b, 0x4b, 0x60, 0xac, 0xc6,
+		0x04, 0x29, 0x52, 0xd4, 0x2a, 0x57, 0x97, 0xed, 0x8a, 0x7b, 0xba, 0x26, 0xd5, 0xd5, 0x1e, 0x26,
+		0x52, 0xa4, 0xac, 0x92, 0x6c, 0xf2, 0x00, 0x91, 0xb2, 0xca, 0x2a, 0xd9, 0x90, 0x6d, 0xa2, 0xac,
+		0xf2, 0x08, 0x59, 0xe4, 0x49, 0xf2, 0x02, 0x51, 0x57, 0x57, 0xf7, 0x8c, 0xc7, 0x3d, 0x3d, 0xdd,
+		0x86, 0xb0, 0x73, 0xd7, 0x9c, 0xf3, 0xd5, 0x57, 0x75, 0x4e, 0x9f, 0xef, 0xf3, 0x0c, 0x54, 0xfd,
+		0x5d, 0x2a, 0xea, 0x04, 0xdb, 0xd4, 0x25, 0xb4, 0x8e, 0x9b, 0xac, 0x7e, 0xb4, 0x52, 0xb7, 0x29,
+		0x61, 0x1e, 0xe3, 0x6e, 0xad, 0x29, 0xb8, 0xe4, 0x68, 0x32, 0x88, 0xa9, 0xe9, 0x98, 0x1a, 0x6e,
+		0xb2, 0xda, 0xd1, 0xca, 0xa5, 0xab, 0xfb, 0x9c, 0xef, 0x3b, 0xb4, 0xae, 0x42, 0x76, 0xfd, 0xbd,
+		0xba, 0xed, 0x0b, 0x2c, 0xe3, 0xa4, 0x4b, 0xf3, 0x49, 0xc0, 0x84, 0x37, 0x1a, 0x71, 0x44, 0xe2,
+		0xd6, 0x12, 0x7b, 0x87, 0x0e, 0xf3, 0x64, 0x5a, 0x4c, 0x8b, 0x8b, 0xc3, 0x3d, 0x87, 0xb7, 0xc2,
+		0x98, 0xea, 0x37, 0x13, 0x50, 0x7a, 0xac, 0x19, 0xa3, 0xef, 0x0c, 0xb8, 0xe9, 0x91, 0x03, 0x6a,
+		0xfb, 0x0e, 0xb5, 0x30, 0x91, 0xec, 0x88, 0xc9, 0xb6, 0x15, 0xa0, 0x5a, 0xd1, 0xa9, 0x2c, 0x2c,
+		0xa5, 0x60, 0xbb, 0xbe, 0xa4, 0xde, 0xb4, 0x31, 0x6f, 0xfc, 0x77, 0x6c, 0xf5, 0x7e, 0x2d, 0xe1,
+		0x84, 0xb5, 0x97, 0x1a, 0xe6, 0x91, 0x46, 0xd9, 0xc1, 0xde, 0x61, 0xb4, 0xcf, 0xa3, 0x18, 0xe2,
+		0xe9, 0xbf, 0xcc, 0x05, 0x2f, 0x53, 0x24, 0xfa, 0x1c, 0xe6, 0x3c, 0x89, 0x85, 0xb4, 0x24, 0x6b,
+		0x50, 0x91, 0xc8, 0x67, 0x48, 0xf1, 0x59, 0x49, 0xe6, 0x13, 0xe4, 0xee, 0x04, 0xa9, 0x89, 0x2c,
+		0x66, 0xbd, 0x94, 0xcf, 0xd1, 0x8f, 0x06, 0x04, 0xb7, 0xdf, 0x74, 0xa8, 0xa4, 0x56, 0x74, 0x81,
+		0x16, 0x7d, 0x43, 0x89, 0x1f, 0x14, 0x2d, 0x91, 0xcc, 0xb0, 0x22, 0xf3, 0xff, 0x44, 0x32, 0xeb,
+		0x1a, 0xeb, 0xb5, 0x86, 0x7a, 0x12, 0x21, 0x25, 0x72, 0x5b, 0x24, 0xd9, 0xc3, 0xd1, 0xf7, 0x06,
+		0x2c, 0xee, 0x61, 0xe6, 0x64, 0xa5, 0x39, 0xa2, 0x68, 0x3e, 0x48, 0xa4, 0xb9, 0x81, 0x99, 0x93,
+		0x8d, 0xe2, 0xf5, 0xbd, 0x6c, 0xa1, 0xe8, 0x27, 0x03, 0x96, 0x05, 0xfd, 0xcc, 0xa7, 0x9e, 0xb4,
+		0x08, 0x76, 0x09, 0x75, 0x32, 0xf4, 0xd9, 0x68, 0xca, 0x55, 0x9a, 0x21, 0xd8, 0xba, 0xc2, 0x1a,
+		0xd8, 0x6c, 0x8b, 0x22, 0x7b, 0x38, 0xfa, 0x02, 0xe6, 0x35, 0xc5, 0xfe, 0x2d, 0x57, 0x50, 0xd4,
+		0x56, 0x93, 0xab, 0xac, 0x92, 0xfb, 0xf7, 0xdc, 0x15, 0x92, 0x16, 0x80, 0x7e, 0x30, 0x60, 0x49,
+		0xef, 0x9f, 0xb1, 0x96, 0x45, 0x45, 0xe6, 0x61, 0x0a, 0x99, 0x6c, 0xd5, 0xbc, 0x41, 0xb2, 0x06,
+		0xa3, 0x3f, 0x0c, 0x78, 0xd8, 0x53, 0x4f, 0xfa, 0x46, 0x52, 0xe1, 0xe2, 0xcc, 0xac, 0x4b, 0x8a,
+		0xf5, 0xf3, 0xc1, 0xd5, 0x7d, 0xa2, 0x81, 0xb3, 0x1d, 0xe2, 0xae, 0x38, 0x65, 0x2e, 0xfa, 0xd2,
+		0x80, 0x6b, 0x82, 0x12, 0x2e, 0x6c, 0xab, 0x81, 0xc5, 0x61, 0x9f, 0xca, 0x97, 0x15, 0xed, 0x5b,
+		0x7d, 0x68, 0x07, 0xd9, 0xcf, 0x55, 0x72, 0x22, 0xb9, 0xab, 0x22, 0x35, 0x02, 0xfd, 0x6a, 0xc0,
+		0x1d, 0xc2, 0x5d, 0xc9, 0x5c, 0x9f, 0x5a, 0xd8, 0xb3, 0x5c, 0xda, 0xca, 0x7a, 0x9d, 0xa0, 0x78,
+		0x3d, 0xe9, 0x33, 0x77, 0x42, 0xc8, 0x47, 0xde, 0x16, 0x6d, 0x65, 0xbb, 0xc6, 0x65, 0x92, 0x33,
+		0x07, 0xfd, 0x6c, 0xc0, 0x6a, 0x38, 0xa9, 0xc9, 0x01, 0x73, 0xec, 0xac, 0xbc, 0xc7, 0x14, 0xef,
+		0xb5, 0xfe, 0xc3, 0x7b, 0x3d, 0x40, 0xcb, 0x46, 0x7a, 0xc9, 0xcb, 0x93, 0x80, 0x7e, 0x33, 0xe0,
+		0x8e, 0xc7, 0xf6, 0x5d, 0x9c, 0xbf, 0x79, 0x2b, 0x8a, 0xf5, 0x46, 0x32, 0x6b, 0x05, 0x99, 0xaf,
+		0x6b, 0x57, 0xbc, 0xbc, 0x49, 0xe8, 0x17, 0x03, 0xfe, 0xe7, 0x37, 0x3d, 0x2a, 0x64, 0x87, 0xb4,
+		0x47, 0xb1, 0x20, 0x07, 0x5d, 0x44, 0x13, 0xc9, 0x8f, 0xa7, 0xb4, 0xca, 0x2b, 0x85, 0x18, 0xed,
+		0xff, 0x52, 0xe1, 0x75, 0x36, 0x4d, 0x6e, 0x15, 0x3f, 0x67, 0xce, 0x5a, 0x05, 0xa0, 0x43, 0xa7,
+		0xfa, 0x6d, 0x01, 0x16, 0xb2, 0xd9, 0x06, 0x34, 0x07, 0x63, 0xb1, 0x6c, 0x30, 0x5b, 0x19, 0x91,
+		0xb2, 0x09, 0xd1, 0xd2, 0xa6, 0x8d, 0x36, 0x60, 0x3c, 0x0e, 0x90, 0xed, 0x26, 0xd5, 0xde, 0xe0,
+		0x5a, 0xe2, 0x59, 0xe3, 0xcd, 0xda, 0x4d, 0x6a, 0x56, 0x70, 0xd7, 0x13, 0x9a, 0x82, 0x82, 0xcd,
+		0x1b, 0x98, 0xb9, 0x4a, 0xcf, 0xcb, 0xa6, 0x7e, 0x42, 0xf7, 0xa0, 0xac, 0xe4, 0x2a, 0x70, 0x5b,
+		0x5a, 0x43, 0xaf, 0x24, 0x62, 0x07, 0x07, 0x78, 0xc6, 0x3c, 0x69, 0x96, 0xa4, 0xfe, 0x0b, 0xad,
+		0xc2, 0x28, 0x73, 0x9b, 0xbe, 0xd4, 0xba, 0x36, 0x9b, 0x98, 0xb7, 0x8d, 0xdb, 0x0e, 0xc7, 0xb6,
+		0x19, 0x86, 0xa2, 0x1d, 0x98, 0x89, 0x8d, 0x99, 0xe4, 0x16, 0x71, 0xb8, 0x47, 0x95, 0x2c, 0x71,
+		0x5f, 0x6a, 0x11, 0x9a, 0xa9, 0x85, 0xa6, 0xb2, 0x16, 0x99, 0xca, 0xda, 0x63, 0x6d, 0x2a, 0xcd,
+		0xa9, 0x28, 0x77, 0x87, 0xaf, 0x07, 0x99, 0x3b, 0x61, 0x62, 0x2f, 0x6a, 0xc7, 0x5f, 0x05, 0xa8,
+		0xc5, 0x1c, 0xa8, 0xb1, 0xbb, 0x0a, 0x50, 0xb7, 0x60, 0x4a, 0x23, 0xf5, 0x12, 0x2d, 0x0d, 0x82,
+		0x9c, 0x0c, 0x6d, 0xd8, 0x71, 0x96, 0x1b, 0x30, 0x71, 0x40, 0xb1, 0x90, 0xbb, 0x14, 0x77, 0xd8,
+		0x95, 0x07, 0x41, 0x9d, 0x8b, 0x73, 0x22, 0x9c, 0x75, 0xa8, 0x08, 0x2a, 0x45, 0xdb, 0x6a, 0x72,
+		0x87, 0x91, 0xb6, 0x9e, 0x38, 0xf3, 0x7d, 0x26, 0xb8, 0x14, 0xed, 0x6d, 0x15, 0x67, 0x8e, 0x89,
+		0xce, 0x03, 0xba, 0x05, 0x85, 0x03, 0x8a, 0x6d, 0x2a, 0xf4, 0xab, 0x7f, 0x39, 0x31, 0xfd, 0xa9,
+		0x0a, 0x31, 0x75, 0x28, 0xba, 0x0d, 0x53, 0x91, 0x48, 0x3a, 0x9c, 0x60, 0xc7, 0xb2, 0x99, 0xd7,
+		0xc4, 0x92, 0x1c, 0xa8, 0x57, 0xb0, 0x64, 0x9e, 0xd7, 0x9f, 0x3e, 0x0b, 0x3e, 0x7c, 0xac, 0x3f,
+		0xab, 0x7e, 0x6d, 0xc0, 0x6c, 0x9a, 0x6d, 0x45, 0x33, 0x50, 0x0a, 0x9d, 0x49, 0xfc, 0x0a, 0x14,
+		0xd5, 0xf3, 0xa6, 0x8d, 0x9e, 0xc1, 0x85, 0xb8, 0x06, 0x7b, 0x4c, 0x74, 0x4a, 0x30, 0x34, 0xe8,
+		0xde, 0x90, 0x2e, 0xc1, 0x06, 0x13, 0x51, 0x05, 0xaa, 0x04, 0x16, 0x73, 0x58, 0x56, 0x74, 0x1b,
+		0x0a, 0x82, 0x7a, 0xbe, 0x23, 0xa7, 0x8d, 0x0c, 0x1d, 0xae, 0x63, 0xab, 0x18, 0xae, 0x67, 0x34,
+		0x9c, 0xe8, 0x0e, 0x14, 0x03, 0xc3, 0xe9, 0x0b, 0x9a, 0xba, 0xc3, 0x46, 0x18, 0x63, 0x46, 0xc1,
+		0xd5, 0x2d, 0x58, 0xcc, 0xe1, 0x17, 0x07, 0x4e, 0x99, 0xea, 0x3d, 0xb8, 0x92, 0x6a, 0xf2, 0x52,
+		0x2a, 0x54, 0x25, 0x70, 0x23, 0xb3, 0x27, 0x0b, 0x0e, 0x6c, 0x53, 0x89, 0x99, 0xe3, 0x65, 0xba,
+		0xd2, 0x28, 0xb8, 0xfa, 0x97, 0x01, 0x77, 0x4f, 0xeb, 0xa1, 0xba, 0x66, 0x9f, 0x71, 0x6c, 0xf6,
+		0xbd, 0x02, 0x74, 0x52, 0x1d, 0x75, 0x63, 0x2d, 0x24, 0xf2, 0x3a, 0xb1, 0x9b, 0x39, 0xd1, 0xea,
+		0x5d, 0x42, 0xd3, 0x50, 0x24, 0xdc, 0x95, 0x82, 0x3b, 0x6a, 0xd6, 0x56, 0xcc, 0xe8, 0x11, 0xd5,
+		0x60, 0xb2, 0xc7, 0x4a, 0x70, 0xd7, 0x69, 0xab, 0xb1, 0x5b, 0x32, 0x27, 0x48, 0xb7, 0xcc, 0xbf,
+		0x70, 0x9d, 0x76, 0xf5, 0xad, 0x01, 0x57, 0xd3, 0x2d, 0x58, 0x50, 0x5a, 0xed, 0xed, 0x5c, 0xdc,
+		0xa0, 0x51, 0x69, 0xc3, 0xa5, 0x2d, 0xdc, 0xa0, 0xdd, 0x37, 0x3e, 0x94, 0xe3, 0xc6, 0xbb, 0xe6,
+		0xc3, 0x70, 0xe6, 0xf9, 0x50, 0x7d, 0x5b, 0x82, 0xe5, 0xbc, 0xde, 0x2c, 0x90, 0xb8, 0xf8, 0x3e,
+		0x94, 0xc4, 0x19, 0x29, 0x12, 0x17, 0x01, 0x86, 0x12, 0xd7, 0xea, 0x7a, 0x3a, 0x2e, 0x65, 0x43,
+		0xa7, 0x94, 0xb2, 0xe1, 0xec, 0x52, 0x86, 0x61, 0xbe, 0xe3, 0xa9, 0xfa, 0x08, 0xc5, 0xc8, 0xa0,
+		0x29, 0x35, 0x1b, 0x43, 0xbc, 0x4c, 0x50, 0x8c, 0xd7, 0x70, 0x59, 0x1d, 0xa9, 0x0f, 0xfa, 0xe8,
+		0x20, 0xf4, 0x8b, 0x41, 0x76, 0x12, 0xf0, 0x0b, 0x98, 0xda, 0xc5, 0xe4, 0x90, 0xef, 0xed, 0x69,
+		0x6c, 0xe6, 0x4a, 0x2a, 0x8e, 0xb0, 0x33, 0x58, 0x83, 0xcf, 0xeb, 0x44, 0x05, 0xbb, 0xa9, 0xd3,
+		0x4e, 0x68, 0x52, 0xf1, 0x34, 0x9a, 0xb4, 0x09, 0x65, 0xe6, 0x32, 0xc9, 0xb0, 0xe4, 0x42, 0x69,
+		0xec, 0x99, 0xd5, 0xc5, 0xc1, 0xfe, 0x7f, 0x33, 0x4a, 0x31, 0x3b, 0xd9, 0xdd, 0x93, 0xb5, 0x9c,
+		0x63, 0xb2, 0x22, 0x13, 0xa6, 0x1c, 0x1c, 0xfc, 0x0f, 0x18, 0xca, 0x44, 0x50, 0x5a, 0x2d, 0x01,
+		0x90, 0xa1, 0x33, 0xce, 0x07, 0xb9, 0xeb, 0x71, 0xaa, 0xa9, 0x32, 0xd1, 0xbf, 0x61, 0x9c, 0x88,
+		0xa0, 0x47, 0xb4, 0xcd, 0x50, 0x82, 0x5d, 0x36, 0x2b, 0xc1, 0x62, 0xe4, 0x13, 0x4f, 0xa7, 0xc7,
+		0x4b, 0x30, 0xd2, 0xa0, 0x0d, 0xae, 0x0d, 0xf0, 0x4c, 0x62, 0xca, 0x73, 0xda, 0xe0, 0xa6, 0x0a,
+		0x43, 0x26, 0x4c, 0x9c, 0x30, 0xd4, 0xd3, 0x67, 0x54, 0xee, 0x7f, 0x92, 0x9d, 0x7f, 0x8f, 0xf5,
+		0x35, 0xcf, 0x79, 0x3d, 0x2b, 0xe8, 0x01, 0x54, 0x3e, 0x65, 0x52, 0x52, 0x11, 0x36, 0xd2, 0xf4,
+		0xd9, 0x41, 0xfd, 0x33, 0x16, 0x86, 0xab, 0xf6, 0xa9, 0xfe, 0x59, 0x84, 0xa5, 0x5c, 0xff, 0x14,
+		0xf5, 0x1d, 0xe6, 0x73, 0x30, 0x16, 0x4f, 0x11, 0x66, 0xab, 0xf7, 0xbf, 0x6c, 0x42, 0xb4, 0x14,
+		0x3a, 0xe9, 0xe3, 0x63, 0x66, 0xf8, 0x3d, 0x8c, 0x99, 0x0f, 0xe0, 0x98, 0xb3, 0x8c, 0x99, 0xc2,
+		0x3f, 0x3a, 0x66, 0x8a, 0xa7, 0x1e, 0x33, 0x1f, 0xc1, 0x64, 0x13, 0x0b, 0xea, 0x4a, 0x8d, 0xa8,
+		0x87, 0x43, 0xf8, 0x6a, 0x2f, 0xf4, 0x39, 0x7d, 0x10, 0xaf, 0x50, 0xf4, 0x88, 0x98, 0x68, 0xf6,
+		0x2e, 0x75, 0x4b, 0x6c, 0xf9, 0xb8, 0xc4, 0x12, 0x98, 0xee, 0x6a, 0x03, 0x4b, 0x50, 0xbf, 0xb3,
+		0x2d, 0xa8, 0x6d, 0x6f, 0xa6, 0x16, 0x7c, 0xd3, 0x36, 0xa9, 0x1f, 0xed, 0x63, 0x5e, 0x68, 0x25,
+		0x2d, 0xbf, 0x1f, 0x03, 0x7e, 0x62, 0x2a, 0x54, 0x52, 0xa7, 0xc2, 0x78, 0xfe, 0xa9, 0x70, 0xe6,
+		0x1d, 0xa6, 0xc2, 0xd9, 0x77, 0x9a, 0x0a, 0xd5, 0xdf, 0x87, 0x60, 0x25, 0xf7, 0xd7, 0x06, 0x1f,
+		0xda, 0xa8, 0xcd, 0xc1, 0x98, 0xfe, 0xb6, 0x44, 0x79, 0xa7, 0xf0, 0x1f, 0x63, 0x08, 0x97, 0x94,
+		0x77, 0x8a, 0x5f, 0xd7, 0x91, 0xec, 0xaf, 0x6b, 0x57, 0x6b, 0x8e, 0x66, 0x72, 0x7f, 0x85, 0x7e,
+		0xee, 0xef, 0x2b, 0x03, 0x96, 0xf3, 0x7e, 0x7b, 0x91, 0x5c, 0x4c, 0xe3, 0x9d, 0x8a, 0xb9, 0xf6,
+		0x09, 0x5c, 0x24, 0xbc, 0x91, 0x94, 0xbd, 0x36, 0x1e, 0x51, 0xd8, 0x16, 0x5c, 0xf2, 0x6d, 0xe3,
+		0xe3, 0x95, 0x7d, 0x26, 0x0f, 0xfc, 0xdd, 0x1a, 0xe1, 0x8d, 0x7a, 0xf7, 0xaf, 0x36, 0x4b, 0xcc,
+		0x76, 0xea, 0xfb, 0x3c, 0xfc, 0xa1, 0x48, 0xff, 0x84, 0x73, 0x1f, 0x37, 0xd9, 0xd1, 0xca, 0x6e,
+		0x41, 0xad, 0xdd, 0xfa, 0x7b, 0x00, 0x7f, 0xc1, 0xe0, 0x1d, 0x85, 0x1a, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/tasklist.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
+		0x14, 0x9e, 0xe2, 0xb4, 0x4b, 0xe8, 0xd9, 0xd5, 0xb8, 0xb5, 0x8d, 0xdd, 0x75, 0xf3, 0x74, 0x51,
+		0x04, 0xc5, 0x26, 0xc1, 0x19, 0x76, 0xb5, 0x8b, 0xc1, 0xb1, 0x83, 0x55, 0xb0, 0xe3, 0x1a, 0x92,
+		0x1a, 0x20, 0x03, 0x06, 0x8e, 0x12, 0x59, 0x9b, 0xd0, 0x0f, 0x05, 0x92, 0x72, 0xe2, 0x17, 0xd9,
+		0xc3, 0xec, 0x89, 0xf6, 0x18, 0x03, 0x29, 0xd9, 0xf3, 0x12, 0x6f, 0x77, 0xe4, 0xf9, 0xce, 0x77,
+		0x7e, 0x3e, 0x9e, 0x43, 0xe0, 0x54, 0x31, 0x15, 0x5e, 0x82, 0x09, 0x2d, 0x12, 0xea, 0xe1, 0x92,
+		0x79, 0xeb, 0xa1, 0xa7, 0xb0, 0x4c, 0x33, 0x26, 0x95, 0x5b, 0x0a, 0xae, 0x38, 0xfc, 0x42, 0xfb,
+		0xb8, 0x8d, 0x8f, 0x8b, 0x4b, 0xe6, 0xae, 0x87, 0xfd, 0xaf, 0x97, 0x9c, 0x2f, 0x33, 0xea, 0x19,
+		0x97, 0xb8, 0xfa, 0xe8, 0x91, 0x4a, 0x60, 0xc5, 0x78, 0x51, 0x93, 0xfa, 0xdf, 0x3c, 0xc4, 0x15,
+		0xcb, 0xa9, 0x54, 0x38, 0x2f, 0x1b, 0x87, 0x47, 0x01, 0xee, 0x04, 0x2e, 0x4b, 0x2a, 0x64, 0x8d,
+		0x3b, 0x1f, 0xc0, 0x49, 0x84, 0x65, 0x3a, 0x63, 0x52, 0x41, 0x08, 0x8e, 0x0b, 0x9c, 0xd3, 0x33,
+		0x6b, 0x60, 0x9d, 0x9f, 0x06, 0xe6, 0x0c, 0x7f, 0x04, 0xc7, 0x29, 0x2b, 0xc8, 0xd9, 0xd1, 0xc0,
+		0x3a, 0xef, 0x5e, 0x7c, 0xeb, 0x1e, 0x28, 0xd2, 0xdd, 0x06, 0x98, 0xb2, 0x82, 0x04, 0xc6, 0xdd,
+		0xc1, 0xc0, 0xde, 0x5a, 0xaf, 0xa9, 0xc2, 0x04, 0x2b, 0x0c, 0xaf, 0xc1, 0x97, 0x39, 0xbe, 0x47,
+		0xba, 0x6d, 0x89, 0x4a, 0x2a, 0x90, 0xa4, 0x09, 0x2f, 0x88, 0x49, 0xd7, 0xbe, 0xf8, 0xca, 0xad,
+		0x2b, 0x75, 0xb7, 0x95, 0xba, 0x13, 0x5e, 0xc5, 0x19, 0xbd, 0xc1, 0x59, 0x45, 0x83, 0xcf, 0x73,
+		0x7c, 0xaf, 0x03, 0xca, 0x05, 0x15, 0xa1, 0xa1, 0x39, 0x1f, 0x40, 0x6f, 0x9b, 0x62, 0x81, 0x85,
+		0x62, 0x5a, 0x95, 0x5d, 0x2e, 0x1b, 0xb4, 0x52, 0xba, 0x69, 0x3a, 0xd1, 0x47, 0xf8, 0x06, 0x3c,
+		0xe3, 0x77, 0x05, 0x15, 0x68, 0xc5, 0xa5, 0x42, 0xa6, 0xcf, 0x23, 0x83, 0x76, 0x8c, 0xf9, 0x1d,
+		0x97, 0x6a, 0x8e, 0x73, 0xea, 0xfc, 0x65, 0x81, 0xee, 0x36, 0x6e, 0xa8, 0xb0, 0xaa, 0x24, 0xfc,
+		0x0e, 0xc0, 0x18, 0x27, 0x69, 0xc6, 0x97, 0x28, 0xe1, 0x55, 0xa1, 0xd0, 0x8a, 0x15, 0xca, 0xc4,
+		0x6e, 0x05, 0x76, 0x83, 0x8c, 0x35, 0xf0, 0x8e, 0x15, 0x0a, 0xbe, 0x06, 0x40, 0x50, 0x4c, 0x50,
+		0x46, 0xd7, 0x34, 0x33, 0x39, 0x5a, 0xc1, 0xa9, 0xb6, 0xcc, 0xb4, 0x01, 0xbe, 0x02, 0xa7, 0x38,
+		0x49, 0x1b, 0xb4, 0x65, 0xd0, 0x13, 0x9c, 0xa4, 0x35, 0xf8, 0x06, 0x3c, 0x13, 0x58, 0xd1, 0x7d,
+		0x75, 0x8e, 0x07, 0xd6, 0xb9, 0x15, 0x74, 0xb4, 0x79, 0xd7, 0x3b, 0x9c, 0x80, 0x8e, 0x96, 0x11,
+		0x31, 0x82, 0xe2, 0x8c, 0x27, 0xe9, 0xd9, 0x13, 0xa3, 0xe1, 0xe0, 0x3f, 0x9f, 0xc7, 0x9f, 0x5c,
+		0x6a, 0xbf, 0xa0, 0xad, 0x69, 0x3e, 0x31, 0x17, 0xe7, 0x67, 0xd0, 0xde, 0xc3, 0x60, 0x0f, 0x9c,
+		0x48, 0x85, 0x85, 0x42, 0x8c, 0x34, 0xcd, 0x7d, 0x6a, 0xee, 0x3e, 0x81, 0xcf, 0xc1, 0x53, 0x5a,
+		0x10, 0x0d, 0xd4, 0xfd, 0x3c, 0xa1, 0x05, 0xf1, 0x89, 0xf3, 0x87, 0x05, 0xc0, 0x82, 0x67, 0x19,
+		0x15, 0x7e, 0xf1, 0x91, 0xc3, 0x09, 0xb0, 0x33, 0x2c, 0x15, 0xc2, 0x49, 0x42, 0xa5, 0x44, 0x7a,
+		0x14, 0x9b, 0xc7, 0xed, 0x3f, 0x7a, 0xdc, 0x68, 0x3b, 0xa7, 0x41, 0x57, 0x73, 0x46, 0x86, 0xa2,
+		0x8d, 0xb0, 0x0f, 0x4e, 0x18, 0xa1, 0x85, 0x62, 0x6a, 0xd3, 0xbc, 0xd0, 0xee, 0x7e, 0x48, 0x9f,
+		0xd6, 0x01, 0x7d, 0x9c, 0x3f, 0x2d, 0xd0, 0x0b, 0x15, 0x4b, 0xd2, 0xcd, 0xd5, 0x3d, 0x4d, 0x2a,
+		0x3d, 0x1a, 0x23, 0xa5, 0x04, 0x8b, 0x2b, 0x45, 0x25, 0xfc, 0x05, 0xd8, 0x77, 0x5c, 0xa4, 0x54,
+		0x98, 0x59, 0x44, 0x7a, 0x07, 0x9b, 0x3a, 0x5f, 0xff, 0xef, 0x7c, 0x07, 0xdd, 0x9a, 0xb6, 0x5b,
+		0x98, 0x08, 0xf4, 0x64, 0xb2, 0xa2, 0xa4, 0xca, 0x28, 0x52, 0x1c, 0xd5, 0xea, 0xe9, 0xb6, 0x79,
+		0xa5, 0x4c, 0xed, 0xed, 0x8b, 0xde, 0xe3, 0xb1, 0x6e, 0x36, 0x38, 0x78, 0xb1, 0xe5, 0x46, 0x3c,
+		0xd4, 0xcc, 0xa8, 0x26, 0xbe, 0xfd, 0x1d, 0x7c, 0xb6, 0xbf, 0x51, 0xb0, 0x0f, 0x5e, 0x44, 0xa3,
+		0x70, 0x8a, 0x66, 0x7e, 0x18, 0xa1, 0xa9, 0x3f, 0x9f, 0x20, 0x7f, 0x7e, 0x33, 0x9a, 0xf9, 0x13,
+		0xfb, 0x13, 0xd8, 0x03, 0xcf, 0x1f, 0x60, 0xf3, 0xf7, 0xc1, 0xf5, 0x68, 0x66, 0x5b, 0x07, 0xa0,
+		0x30, 0xf2, 0xc7, 0xd3, 0x5b, 0xfb, 0xe8, 0x2d, 0xf9, 0x27, 0x43, 0xb4, 0x29, 0xe9, 0xbf, 0x33,
+		0x44, 0xb7, 0x8b, 0xab, 0xbd, 0x0c, 0xaf, 0xc0, 0xcb, 0x07, 0xd8, 0xe4, 0x6a, 0xec, 0x87, 0xfe,
+		0xfb, 0xb9, 0x6d, 0x1d, 0x00, 0x47, 0xe3, 0xc8, 0xbf, 0xf1, 0xa3, 0x5b, 0xfb, 0xe8, 0xf2, 0x37,
+		0xf0, 0x32, 0xe1, 0xf9, 0x21, 0x45, 0x2f, 0x3b, 0xbb, 0xcd, 0xd5, 0xaa, 0x2c, 0xac, 0x5f, 0x87,
+		0x4b, 0xa6, 0x56, 0x55, 0xec, 0x26, 0x3c, 0xf7, 0xf6, 0xff, 0xca, 0xef, 0x19, 0xc9, 0xbc, 0x25,
+		0xaf, 0xbf, 0xaf, 0xe6, 0xe3, 0xfc, 0x09, 0x97, 0x6c, 0x3d, 0x8c, 0x9f, 0x1a, 0xdb, 0x0f, 0x7f,
+		0x0f, 0x00, 0x41, 0xb6, 0x75, 0xa3, 0x5c, 0x05, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/workflow.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5d, 0x6f, 0xdb, 0xc8,
+		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0x7a, 0x6c, 0xc7, 0x8a, 0xb3, 0x9b, 0x38, 0xda,
+		0x4d, 0xe2, 0xe8, 0x5d, 0xcb, 0xeb, 0x64, 0x93, 0x6c, 0xe2, 0xa6, 0x29, 0x4d, 0xd1, 0x31, 0x13,
+		0x99, 0x52, 0x87, 0x54, 0x1c, 0x2f, 0xda, 0x12, 0xb4, 0x44, 0xdb, 0x6c, 0x24, 0x52, 0x20, 0x47,
+		0x49, 0x7c, 0x5f, 0xa0, 0xd7, 0xed, 0x55, 0xd1, 0xab, 0xfe, 0x80, 0xa2, 0x45, 0xd1, 0xeb, 0xa2,
+		0x77, 0xbd, 0x28, 0xd0, 0xdb, 0xfe, 0x87, 0xfe, 0x8b, 0x62, 0x86, 0x43, 0x89, 0xfa, 0xa4, 0xdc,
+		0x02, 0xdb, 0x3b, 0xf3, 0xcc, 0xf3, 0x3c, 0x3c, 0x73, 0xe6, 0x7c, 0x8c, 0x68, 0xc8, 0xb5, 0x4f,
+		0x6d, 0x7f, 0xa7, 0x66, 0xd5, 0x6d, 0xb7, 0x66, 0xef, 0x58, 0x2d, 0x67, 0xe7, 0xc3, 0xee, 0xce,
+		0x47, 0xcf, 0x7f, 0x7f, 0xd6, 0xf0, 0x3e, 0x16, 0x5a, 0xbe, 0x47, 0x3c, 0xb4, 0x42, 0x31, 0x05,
+		0x8e, 0x29, 0x58, 0x2d, 0xa7, 0xf0, 0x61, 0x77, 0xe3, 0xd6, 0xb9, 0xe7, 0x9d, 0x37, 0xec, 0x1d,
+		0x06, 0x39, 0x6d, 0x9f, 0xed, 0xd4, 0xdb, 0xbe, 0x45, 0x1c, 0xcf, 0x0d, 0x49, 0x1b, 0xb7, 0xfb,
+		0xd7, 0x89, 0xd3, 0xb4, 0x03, 0x62, 0x35, 0x5b, 0x1c, 0xb0, 0x39, 0xec, 0xcd, 0x35, 0xaf, 0xd9,
+		0xec, 0x48, 0x0c, 0xf5, 0x8d, 0x58, 0xc1, 0xfb, 0x86, 0x13, 0x90, 0x10, 0x93, 0xfb, 0xfb, 0x2c,
+		0xac, 0x1d, 0x73, 0x77, 0x95, 0x4f, 0x76, 0xad, 0x4d, 0x5d, 0x50, 0xdd, 0x33, 0x0f, 0x55, 0x01,
+		0x45, 0xfb, 0x30, 0xed, 0x68, 0x25, 0x2b, 0x6c, 0x0a, 0x5b, 0x99, 0x87, 0xf7, 0x0a, 0x43, 0xb6,
+		0x54, 0x18, 0xd0, 0xc1, 0xcb, 0x1f, 0xfb, 0x4d, 0xe8, 0x31, 0x4c, 0x91, 0xcb, 0x96, 0x9d, 0x4d,
+		0x31, 0xa1, 0x3b, 0x63, 0x85, 0x8c, 0xcb, 0x96, 0x8d, 0x19, 0x1c, 0x3d, 0x03, 0x08, 0x88, 0xe5,
+		0x13, 0x93, 0x86, 0x21, 0x9b, 0x66, 0xe4, 0x8d, 0x42, 0x18, 0xa3, 0x42, 0x14, 0xa3, 0x82, 0x11,
+		0xc5, 0x08, 0xcf, 0x31, 0x34, 0x7d, 0xa6, 0xd4, 0x5a, 0xc3, 0x0b, 0xec, 0x90, 0x3a, 0x95, 0x4c,
+		0x65, 0x68, 0x46, 0x35, 0x60, 0x3e, 0xa4, 0x06, 0xc4, 0x22, 0xed, 0x20, 0x3b, 0xbd, 0x29, 0x6c,
+		0x2d, 0x3e, 0xdc, 0x9d, 0x6c, 0xf7, 0x32, 0x65, 0xea, 0x8c, 0x88, 0x33, 0xb5, 0xee, 0x03, 0xba,
+		0x0b, 0x8b, 0x17, 0x4e, 0x40, 0x3c, 0xff, 0xd2, 0x6c, 0xd8, 0xee, 0x39, 0xb9, 0xc8, 0xce, 0x6c,
+		0x0a, 0x5b, 0x69, 0xbc, 0xc0, 0xad, 0x25, 0x66, 0x44, 0x3f, 0x81, 0xb5, 0x96, 0xe5, 0xdb, 0x2e,
+		0xe9, 0x86, 0xdf, 0x74, 0xdc, 0x33, 0x2f, 0x7b, 0x8d, 0x6d, 0x61, 0x6b, 0xa8, 0x17, 0x15, 0xc6,
+		0xe8, 0x39, 0x49, 0xbc, 0xd2, 0x1a, 0x34, 0x22, 0x09, 0x16, 0xbb, 0xb2, 0x2c, 0x32, 0xb3, 0x89,
+		0x91, 0x59, 0xe8, 0x30, 0x58, 0x74, 0xb6, 0x61, 0xaa, 0x69, 0x37, 0xbd, 0xec, 0x1c, 0x23, 0xde,
+		0x18, 0xea, 0xcf, 0x91, 0xdd, 0xf4, 0x30, 0x83, 0x21, 0x0c, 0xcb, 0x81, 0x6d, 0xf9, 0xb5, 0x0b,
+		0xd3, 0x22, 0xc4, 0x77, 0x4e, 0xdb, 0xc4, 0x0e, 0xb2, 0xc0, 0xb8, 0x77, 0x87, 0x72, 0x75, 0x86,
+		0x96, 0x3a, 0x60, 0x2c, 0x06, 0x7d, 0x16, 0x54, 0x82, 0x65, 0xab, 0x4d, 0x3c, 0xd3, 0xb7, 0x03,
+		0x9b, 0x98, 0x2d, 0xcf, 0x71, 0x49, 0x90, 0xcd, 0x30, 0xcd, 0xcd, 0xa1, 0x9a, 0x98, 0x02, 0x2b,
+		0x0c, 0x87, 0x97, 0x28, 0x35, 0x66, 0x40, 0x37, 0x61, 0x8e, 0x96, 0x87, 0x49, 0xeb, 0x23, 0x3b,
+		0xbf, 0x29, 0x6c, 0xcd, 0xe1, 0x59, 0x6a, 0x28, 0x39, 0x01, 0x41, 0xeb, 0x70, 0xcd, 0x09, 0xcc,
+		0x9a, 0xef, 0xb9, 0xd9, 0x85, 0x4d, 0x61, 0x6b, 0x16, 0xcf, 0x38, 0x81, 0xec, 0x7b, 0x2e, 0xda,
+		0x83, 0x4c, 0xbb, 0x55, 0xb7, 0x08, 0x4f, 0xb0, 0xc5, 0xc4, 0x30, 0x42, 0x08, 0x67, 0x31, 0xfc,
+		0x39, 0x88, 0x2d, 0xcb, 0x27, 0x0e, 0x3b, 0x86, 0x9a, 0xe7, 0x9e, 0x39, 0xe7, 0xd9, 0xa5, 0xcd,
+		0xf4, 0x56, 0xe6, 0xe1, 0xcb, 0xc9, 0xb2, 0x8c, 0x1e, 0x66, 0xa1, 0x12, 0x49, 0xc8, 0x4c, 0x41,
+		0x71, 0x89, 0x7f, 0x89, 0x97, 0x5a, 0xbd, 0x56, 0xba, 0x3d, 0x27, 0x30, 0x5b, 0x56, 0x3b, 0xb0,
+		0xeb, 0x59, 0x91, 0xed, 0x61, 0xd6, 0x09, 0x2a, 0xec, 0x79, 0x63, 0x1f, 0x56, 0x87, 0xa9, 0x20,
+		0x11, 0xd2, 0xef, 0xed, 0x4b, 0x56, 0xf7, 0x73, 0x98, 0xfe, 0x89, 0x56, 0x61, 0xfa, 0x83, 0xd5,
+		0x68, 0x87, 0x25, 0x3c, 0x87, 0xc3, 0x87, 0xe7, 0xa9, 0x6f, 0x85, 0xdc, 0x6f, 0x52, 0x70, 0x6b,
+		0xb0, 0x0c, 0x98, 0x18, 0x6f, 0x6e, 0xe8, 0x79, 0x3c, 0xc4, 0x61, 0x33, 0xf9, 0x7c, 0xe8, 0x46,
+		0x0d, 0x1e, 0xf7, 0xd8, 0x09, 0x58, 0xb0, 0xd9, 0x4d, 0x59, 0xde, 0x0d, 0x3c, 0xb3, 0x5b, 0xdb,
+		0x5e, 0x9b, 0xf0, 0xb6, 0x72, 0x63, 0x20, 0xfa, 0x45, 0xee, 0x00, 0xfe, 0xac, 0x23, 0xa1, 0xb3,
+		0x0e, 0xe1, 0xc9, 0x51, 0xb5, 0x7b, 0x6d, 0x82, 0x8e, 0xe1, 0x26, 0x73, 0x6f, 0x84, 0x7a, 0x3a,
+		0x49, 0x7d, 0x9d, 0xb2, 0x87, 0x08, 0xe7, 0xfe, 0x21, 0xc0, 0xca, 0x90, 0xda, 0xa4, 0x67, 0x52,
+		0xf7, 0x9a, 0x96, 0xe3, 0x9a, 0x4e, 0x9d, 0x07, 0x79, 0x36, 0x34, 0xa8, 0x75, 0x74, 0x1b, 0x32,
+		0x7c, 0xd1, 0xb5, 0x9a, 0x51, 0xbc, 0x21, 0x34, 0x69, 0x56, 0xd3, 0x1e, 0xd1, 0xa3, 0xd3, 0xff,
+		0x6d, 0x8f, 0xbe, 0x03, 0xf3, 0x8e, 0xeb, 0x10, 0xc7, 0x22, 0x76, 0x9d, 0xfa, 0x35, 0xc5, 0xda,
+		0x53, 0xa6, 0x63, 0x53, 0xeb, 0xb9, 0x5f, 0x09, 0xb0, 0xa6, 0x7c, 0x22, 0xb6, 0xef, 0x5a, 0x8d,
+		0xef, 0x65, 0x6e, 0xf4, 0xfb, 0x94, 0x1a, 0xf4, 0xe9, 0x0f, 0x33, 0xb0, 0x52, 0xb1, 0xdd, 0xba,
+		0xe3, 0x9e, 0x4b, 0x35, 0xe2, 0x7c, 0x70, 0xc8, 0x25, 0xf3, 0xe8, 0x36, 0x64, 0x2c, 0xfe, 0xdc,
+		0x8d, 0x32, 0x44, 0x26, 0xb5, 0x8e, 0x0e, 0x60, 0xa1, 0x03, 0x48, 0x1c, 0x4e, 0x91, 0x34, 0x1b,
+		0x4e, 0xf3, 0x56, 0xec, 0x09, 0xbd, 0x84, 0x69, 0x3a, 0x28, 0xc2, 0xf9, 0xb4, 0xf8, 0xf0, 0xc1,
+		0xf0, 0x0e, 0xdd, 0xeb, 0x21, 0x9d, 0x09, 0x36, 0x0e, 0x79, 0x48, 0x85, 0xe5, 0x0b, 0xdb, 0xf2,
+		0xc9, 0xa9, 0x6d, 0x11, 0xb3, 0x6e, 0x13, 0xcb, 0x69, 0x04, 0x7c, 0x62, 0x7d, 0x36, 0xa2, 0xdd,
+		0x5f, 0x36, 0x3c, 0xab, 0x8e, 0xc5, 0x0e, 0xad, 0x18, 0xb2, 0xd0, 0x6b, 0x58, 0x69, 0x58, 0x01,
+		0x31, 0xbb, 0x7a, 0xac, 0x3b, 0x4d, 0x27, 0x76, 0xa7, 0x65, 0x4a, 0x3b, 0x8c, 0x58, 0xd4, 0x8e,
+		0x0e, 0x80, 0x19, 0xc3, 0xaa, 0xb0, 0xeb, 0xa1, 0xd2, 0x4c, 0xa2, 0xd2, 0x12, 0x25, 0xe9, 0x21,
+		0x87, 0xe9, 0x64, 0xe1, 0x9a, 0x45, 0x88, 0xdd, 0x6c, 0x11, 0x36, 0xc3, 0xa6, 0x71, 0xf4, 0x88,
+		0x1e, 0x80, 0xd8, 0xb4, 0x3e, 0x39, 0xcd, 0x76, 0xd3, 0xe4, 0xa6, 0x80, 0xcd, 0xa3, 0x69, 0xbc,
+		0xc4, 0xed, 0x12, 0x37, 0xd3, 0xc1, 0x15, 0xd4, 0x2e, 0xec, 0x7a, 0xbb, 0x11, 0x79, 0x32, 0x97,
+		0x3c, 0xb8, 0x3a, 0x0c, 0xe6, 0x87, 0x0c, 0x4b, 0xf6, 0xa7, 0x96, 0x13, 0xd6, 0x6c, 0xa8, 0x01,
+		0x89, 0x1a, 0x8b, 0x5d, 0x0a, 0x13, 0x79, 0x09, 0xf3, 0x2c, 0x28, 0x67, 0x96, 0xd3, 0x68, 0xfb,
+		0x76, 0x36, 0x33, 0xe6, 0x98, 0x0e, 0x42, 0x0c, 0xce, 0x50, 0x06, 0x7f, 0x40, 0x5f, 0xc3, 0x2a,
+		0x13, 0xa0, 0xb9, 0x6e, 0xfb, 0xa6, 0x53, 0xb7, 0x5d, 0xe2, 0x90, 0x4b, 0x3e, 0x78, 0x10, 0x5d,
+		0x3b, 0x66, 0x4b, 0x2a, 0x5f, 0x41, 0x4f, 0x60, 0x3d, 0x3a, 0x82, 0x7e, 0xd2, 0x02, 0x23, 0xad,
+		0xf1, 0xe5, 0x3e, 0xde, 0x75, 0x98, 0xe1, 0x5d, 0x7f, 0x31, 0x9c, 0x5c, 0xe1, 0x53, 0xee, 0xcf,
+		0x29, 0xb8, 0xc1, 0xd3, 0x51, 0xbe, 0x70, 0x1a, 0xf5, 0xef, 0xa5, 0x90, 0xbf, 0x8a, 0xc9, 0xd2,
+		0x62, 0x8b, 0xf7, 0x36, 0xf1, 0x63, 0xec, 0xe6, 0xc7, 0x3a, 0x5c, 0x7f, 0xd9, 0xa7, 0x07, 0xca,
+		0x1e, 0xbd, 0x05, 0x7e, 0xc1, 0xe1, 0xcd, 0xba, 0xe5, 0x35, 0x9c, 0xda, 0x25, 0x2b, 0x9b, 0xc5,
+		0x11, 0x8e, 0x86, 0x9d, 0x98, 0x35, 0xe8, 0x0a, 0x43, 0xe3, 0xe5, 0x56, 0xbf, 0x89, 0x46, 0x2d,
+		0x6c, 0xb5, 0xac, 0x68, 0xe6, 0x30, 0x7f, 0xca, 0xfd, 0x2d, 0xd5, 0x69, 0x33, 0x45, 0xbb, 0xe6,
+		0x04, 0x51, 0xbc, 0x3a, 0xd5, 0x2f, 0x24, 0x57, 0x7f, 0x44, 0xec, 0xa9, 0xfe, 0xc1, 0xcc, 0x4e,
+		0x5d, 0x35, 0xb3, 0x5f, 0xc0, 0x7c, 0x4f, 0x91, 0x26, 0x5f, 0x94, 0x33, 0xc1, 0xf0, 0x02, 0x9d,
+		0xea, 0x2d, 0x50, 0x0c, 0xeb, 0x9e, 0xef, 0x9c, 0x3b, 0xae, 0xd5, 0x30, 0xfb, 0x9c, 0x4c, 0x6e,
+		0x29, 0x6b, 0x11, 0x55, 0x8f, 0x3b, 0x9b, 0xfb, 0x4b, 0x0a, 0x6e, 0x44, 0x6d, 0xb0, 0xe4, 0xd5,
+		0xac, 0x46, 0xd1, 0x09, 0x5a, 0x16, 0xa9, 0x5d, 0x4c, 0xd6, 0xb5, 0xff, 0xf7, 0xe1, 0xfa, 0x19,
+		0xdc, 0xea, 0xf5, 0xc0, 0xf4, 0xce, 0x4c, 0x72, 0xe1, 0x04, 0x66, 0x3c, 0x8a, 0xe3, 0x05, 0x37,
+		0x7a, 0x3c, 0x2a, 0x9f, 0x19, 0x17, 0x4e, 0xc0, 0x7b, 0x1d, 0xfa, 0x1c, 0x80, 0xdd, 0x46, 0x88,
+		0xf7, 0xde, 0x0e, 0xb3, 0x70, 0x1e, 0xb3, 0xeb, 0x93, 0x41, 0x0d, 0xb9, 0xd7, 0x90, 0x89, 0xdf,
+		0x5e, 0xf7, 0x60, 0x86, 0x5f, 0x80, 0x05, 0x76, 0x81, 0xfc, 0x22, 0xe1, 0x02, 0xcc, 0x7e, 0x1b,
+		0x70, 0x4a, 0xee, 0x8f, 0x29, 0x58, 0xec, 0x5d, 0x42, 0xf7, 0x61, 0xe9, 0xd4, 0x71, 0x2d, 0xff,
+		0xd2, 0xac, 0x5d, 0xd8, 0xb5, 0xf7, 0x41, 0xbb, 0xc9, 0x0f, 0x61, 0x31, 0x34, 0xcb, 0xdc, 0x8a,
+		0xd6, 0x60, 0xc6, 0x6f, 0xbb, 0xd1, 0x50, 0x9e, 0xc3, 0xd3, 0x7e, 0x9b, 0xde, 0x5e, 0x5e, 0xc0,
+		0xcd, 0x33, 0xc7, 0x0f, 0xe8, 0x20, 0x0b, 0x93, 0xdd, 0xac, 0x79, 0xcd, 0x56, 0xc3, 0xee, 0xa9,
+		0xe4, 0x2c, 0x83, 0x44, 0xe5, 0x20, 0x47, 0x00, 0x46, 0x9f, 0xaf, 0xf9, 0xb6, 0xd5, 0x39, 0x9b,
+		0xe4, 0x50, 0x66, 0x38, 0x9e, 0xb7, 0xe7, 0x05, 0xd6, 0xb0, 0x1d, 0xf7, 0x7c, 0xd2, 0x34, 0x9d,
+		0x8f, 0x08, 0x4c, 0xe0, 0x16, 0x00, 0xfb, 0x55, 0x41, 0xac, 0xd3, 0x46, 0x38, 0xed, 0x66, 0x71,
+		0xcc, 0x92, 0xff, 0x93, 0x00, 0xab, 0xc3, 0x66, 0x39, 0xca, 0xc1, 0xad, 0x8a, 0xa2, 0x15, 0x55,
+		0xed, 0x95, 0x29, 0xc9, 0x86, 0xfa, 0x56, 0x35, 0x4e, 0x4c, 0xdd, 0x90, 0x0c, 0xc5, 0x54, 0xb5,
+		0xb7, 0x52, 0x49, 0x2d, 0x8a, 0xff, 0x87, 0xbe, 0x84, 0xcd, 0x11, 0x18, 0x5d, 0x3e, 0x54, 0x8a,
+		0xd5, 0x92, 0x52, 0x14, 0x85, 0x31, 0x4a, 0xba, 0x21, 0x61, 0x43, 0x29, 0x8a, 0x29, 0xf4, 0xff,
+		0x70, 0x7f, 0x04, 0x46, 0x96, 0x34, 0x59, 0x29, 0x99, 0x58, 0xf9, 0x71, 0x55, 0xd1, 0x29, 0x38,
+		0x9d, 0xff, 0x45, 0xd7, 0xe7, 0x9e, 0x0e, 0x14, 0x7f, 0x53, 0x51, 0x91, 0x55, 0x5d, 0x2d, 0x6b,
+		0xe3, 0x7c, 0xee, 0xc3, 0x8c, 0xf0, 0xb9, 0x1f, 0x15, 0xf9, 0x9c, 0xff, 0x65, 0xaa, 0xfb, 0xd1,
+		0x41, 0xad, 0x63, 0xbb, 0xdd, 0xe9, 0xb9, 0x5f, 0xc2, 0xe6, 0x71, 0x19, 0xbf, 0x39, 0x28, 0x95,
+		0x8f, 0x4d, 0xb5, 0x68, 0x62, 0xa5, 0xaa, 0x2b, 0x66, 0xa5, 0x5c, 0x52, 0xe5, 0x93, 0x98, 0x27,
+		0xdf, 0xc2, 0x37, 0x23, 0x51, 0x52, 0x89, 0x5a, 0x8b, 0xd5, 0x4a, 0x49, 0x95, 0xe9, 0x5b, 0x0f,
+		0x24, 0xb5, 0xa4, 0x14, 0xcd, 0xb2, 0x56, 0x3a, 0x11, 0x05, 0xf4, 0x15, 0x6c, 0x4d, 0xca, 0x14,
+		0x53, 0x68, 0x1b, 0x1e, 0x8c, 0x44, 0x63, 0xe5, 0xb5, 0x22, 0x1b, 0x31, 0x78, 0x1a, 0xed, 0xc2,
+		0xf6, 0x48, 0xb8, 0xa1, 0xe0, 0x23, 0x55, 0x63, 0x01, 0x3d, 0x30, 0x71, 0x55, 0xd3, 0x54, 0xed,
+		0x95, 0x38, 0x95, 0xff, 0x9d, 0x00, 0xcb, 0x03, 0xc3, 0x08, 0xdd, 0x86, 0x9b, 0x15, 0x09, 0x2b,
+		0x9a, 0x61, 0xca, 0xa5, 0xf2, 0xb0, 0x00, 0x8c, 0x00, 0x48, 0xfb, 0x92, 0x56, 0x2c, 0x6b, 0xa2,
+		0x80, 0xee, 0x41, 0x6e, 0x18, 0x80, 0xe7, 0x02, 0x4f, 0x0d, 0x31, 0x85, 0xee, 0xc0, 0xe7, 0xc3,
+		0x70, 0x1d, 0x6f, 0xc5, 0x74, 0xfe, 0x5f, 0x29, 0xf8, 0x6c, 0xdc, 0xb7, 0x0d, 0x9a, 0x81, 0x9d,
+		0x6d, 0x2b, 0xef, 0x14, 0xb9, 0x6a, 0xd0, 0x33, 0x0f, 0xf5, 0xe8, 0xc9, 0x57, 0xf5, 0x98, 0xe7,
+		0xf1, 0x90, 0x8e, 0x00, 0xcb, 0xe5, 0xa3, 0x4a, 0x49, 0x31, 0x58, 0x36, 0xe5, 0xe1, 0x5e, 0x12,
+		0x3c, 0x3c, 0x60, 0x31, 0xd5, 0x73, 0xb6, 0xa3, 0xa4, 0xd9, 0xbe, 0x69, 0x29, 0xa0, 0x02, 0xe4,
+		0x93, 0xd0, 0x9d, 0x28, 0x14, 0xc5, 0x29, 0xf4, 0x0d, 0x7c, 0x9d, 0xec, 0xb8, 0x66, 0xa8, 0x5a,
+		0x55, 0x29, 0x9a, 0x92, 0x6e, 0x6a, 0xca, 0xb1, 0x38, 0x3d, 0xc9, 0x76, 0x0d, 0xf5, 0x88, 0xe6,
+		0x67, 0xd5, 0x10, 0x67, 0xf2, 0x7f, 0x15, 0xe0, 0xba, 0xec, 0xb9, 0xc4, 0x71, 0xdb, 0xb6, 0x14,
+		0x68, 0xf6, 0x47, 0x35, 0xbc, 0xe7, 0x78, 0x3e, 0xba, 0x0b, 0x77, 0x22, 0x7d, 0x2e, 0x6f, 0xaa,
+		0x9a, 0x6a, 0xa8, 0x92, 0x51, 0xc6, 0xb1, 0xf8, 0x8e, 0x85, 0xd1, 0x82, 0x2c, 0x2a, 0x38, 0x8c,
+		0xeb, 0x68, 0x18, 0x56, 0x0c, 0x7c, 0xc2, 0x53, 0x21, 0xec, 0x30, 0xa3, 0xb1, 0x32, 0x2e, 0x6b,
+		0x9d, 0xfa, 0x17, 0xd3, 0xf9, 0xdf, 0x0b, 0x90, 0xe1, 0xbf, 0x79, 0xd9, 0x4f, 0xa2, 0x2c, 0xac,
+		0xd2, 0x0d, 0x96, 0xab, 0x86, 0x69, 0x9c, 0x54, 0x94, 0xde, 0x1c, 0xee, 0x59, 0x61, 0xed, 0xc1,
+		0x34, 0xca, 0x61, 0x74, 0xc2, 0x4e, 0xd2, 0x0b, 0xe0, 0x6f, 0xa1, 0x18, 0x06, 0x16, 0x53, 0x63,
+		0x31, 0xa1, 0x4e, 0x1a, 0x6d, 0xc0, 0xf5, 0x1e, 0xcc, 0xa1, 0x22, 0x61, 0x63, 0x5f, 0x91, 0x0c,
+		0x71, 0x2a, 0xff, 0x5b, 0x01, 0x6e, 0x44, 0x9d, 0x90, 0x7e, 0x71, 0xa0, 0xae, 0xd7, 0xcb, 0x6d,
+		0x22, 0xd3, 0x0b, 0x32, 0x7a, 0x00, 0x77, 0x3b, 0x3d, 0xcc, 0x90, 0xf4, 0x37, 0xdd, 0xb3, 0x32,
+		0x65, 0xa9, 0xaa, 0xc7, 0x77, 0x93, 0x08, 0xe5, 0x2e, 0x88, 0x02, 0xba, 0x0f, 0x5f, 0x8c, 0x87,
+		0x62, 0x45, 0x57, 0x0c, 0x31, 0x95, 0xff, 0x67, 0x06, 0xd6, 0xe3, 0xce, 0xd1, 0x1f, 0x0e, 0x76,
+		0x3d, 0x74, 0xed, 0x1e, 0xe4, 0x7a, 0x45, 0x78, 0x9f, 0xeb, 0xf7, 0x6b, 0x17, 0xb6, 0xc7, 0xe0,
+		0xaa, 0xda, 0xa1, 0xa4, 0x15, 0xe9, 0x73, 0x04, 0x12, 0x05, 0xf4, 0x12, 0xf6, 0xc6, 0x50, 0xf6,
+		0xa5, 0x62, 0x37, 0xca, 0x9d, 0x89, 0x23, 0x19, 0x06, 0x56, 0xf7, 0xab, 0x86, 0xa2, 0x8b, 0x29,
+		0xa4, 0x80, 0x94, 0x20, 0xd0, 0xdb, 0x87, 0x86, 0xca, 0xa4, 0xd1, 0x33, 0x78, 0x9c, 0xe4, 0x47,
+		0x98, 0x32, 0xea, 0x91, 0x82, 0xe3, 0xd4, 0x29, 0xf4, 0x1c, 0x9e, 0x24, 0x50, 0xf9, 0x9b, 0x07,
+		0xb8, 0xd3, 0x68, 0x0f, 0x9e, 0x26, 0x7a, 0x2f, 0x97, 0x71, 0xd1, 0x3c, 0x92, 0xf0, 0x9b, 0x5e,
+		0xf2, 0x0c, 0x52, 0x41, 0x49, 0x7a, 0x31, 0xef, 0x6e, 0xe6, 0x90, 0xbe, 0x10, 0x93, 0xba, 0x36,
+		0x41, 0x14, 0xa9, 0x21, 0x41, 0x66, 0x16, 0xbd, 0x02, 0x79, 0xb2, 0x50, 0x8c, 0x17, 0x9a, 0x43,
+		0xef, 0xc0, 0xb8, 0xda, 0xa9, 0x2a, 0xef, 0x0c, 0x05, 0x6b, 0x52, 0x92, 0x32, 0xa0, 0x17, 0xf0,
+		0x2c, 0x31, 0x68, 0xbd, 0xfd, 0x27, 0x46, 0xcf, 0xa0, 0xa7, 0xf0, 0x68, 0x0c, 0x3d, 0x9e, 0x23,
+		0xdd, 0x5b, 0x81, 0x5a, 0x14, 0xe7, 0xd1, 0x63, 0xd8, 0x1d, 0x43, 0x64, 0x55, 0x68, 0xea, 0x86,
+		0x2a, 0xbf, 0x39, 0x09, 0x97, 0x4b, 0xaa, 0x6e, 0x88, 0x0b, 0xe8, 0x47, 0xf0, 0x83, 0x31, 0xb4,
+		0xce, 0x66, 0xe9, 0x1f, 0x0a, 0x8e, 0x95, 0x18, 0x85, 0x55, 0xb1, 0x22, 0x2e, 0x4e, 0x70, 0x26,
+		0xba, 0xfa, 0x2a, 0x39, 0x72, 0x4b, 0x48, 0x86, 0x97, 0x13, 0x95, 0x88, 0x7c, 0xa8, 0x96, 0x8a,
+		0xc3, 0x45, 0x44, 0xf4, 0x08, 0x76, 0xc6, 0x88, 0x1c, 0x94, 0xb1, 0xac, 0xf0, 0x89, 0xd5, 0x69,
+		0x12, 0xcb, 0xe8, 0x09, 0x3c, 0x1c, 0x47, 0x92, 0xd4, 0x52, 0xf9, 0xad, 0x82, 0xfb, 0x79, 0x88,
+		0x8e, 0xd1, 0xc9, 0xb6, 0xae, 0x6a, 0x95, 0xaa, 0x61, 0xea, 0xea, 0x77, 0x8a, 0xb8, 0x42, 0xc7,
+		0x68, 0xe2, 0x49, 0x45, 0xb1, 0x12, 0x57, 0x07, 0x9b, 0xf1, 0xc0, 0x4b, 0xf6, 0x55, 0x4d, 0xc2,
+		0x27, 0xe2, 0x5a, 0x42, 0xee, 0x0d, 0x36, 0xba, 0x9e, 0x14, 0xba, 0x3e, 0xc9, 0x76, 0x14, 0x09,
+		0xcb, 0x87, 0xf1, 0x88, 0xaf, 0xd3, 0xa9, 0x73, 0x87, 0x7d, 0x70, 0x19, 0xb8, 0x57, 0xc5, 0x5b,
+		0xfc, 0x2e, 0x6c, 0x87, 0xe7, 0x36, 0x24, 0x0b, 0x46, 0x74, 0xfb, 0x7d, 0xf8, 0xe1, 0x64, 0x94,
+		0xce, 0xba, 0x54, 0xc2, 0x8a, 0x54, 0x3c, 0xe9, 0x5c, 0x49, 0x85, 0xfc, 0xaf, 0x53, 0x90, 0x97,
+		0x2d, 0xb7, 0x66, 0x37, 0xa2, 0xef, 0xbb, 0x63, 0xbd, 0xdc, 0x83, 0xa7, 0x13, 0xd4, 0xfb, 0x08,
+		0x7f, 0x8f, 0x41, 0xbf, 0x2a, 0xb9, 0xaa, 0xbd, 0xd1, 0xca, 0xc7, 0xda, 0x38, 0x82, 0x28, 0x20,
+		0x0d, 0x5e, 0x5f, 0x55, 0x78, 0x20, 0x24, 0xdd, 0x7b, 0x68, 0x8a, 0x05, 0x45, 0x77, 0xce, 0x5d,
+		0x6b, 0xe2, 0xa0, 0xf0, 0x34, 0xfe, 0xcf, 0x82, 0x72, 0x55, 0xf2, 0xc4, 0x41, 0xb9, 0xaa, 0xf0,
+		0xb8, 0xa0, 0xec, 0xff, 0x14, 0xd6, 0x6b, 0x5e, 0x73, 0xd8, 0x57, 0x86, 0xfd, 0x85, 0x28, 0x3c,
+		0x15, 0xfa, 0x33, 0xbb, 0x22, 0x7c, 0xb7, 0x7b, 0xee, 0x90, 0x8b, 0xf6, 0x69, 0xa1, 0xe6, 0x35,
+		0x77, 0xe2, 0xff, 0x96, 0xde, 0x76, 0xea, 0x8d, 0x9d, 0x73, 0x2f, 0xfc, 0x37, 0x37, 0xff, 0x1f,
+		0xf5, 0x9e, 0xd5, 0x72, 0x3e, 0xec, 0x9e, 0xce, 0x30, 0xdb, 0xa3, 0x7f, 0x0f, 0x00, 0x17, 0x16,
+		0xe7, 0xc7, 0x63, 0x1f, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/history.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0xc7,
+		0x91, 0xf6, 0xec, 0x92, 0x4b, 0x6d, 0x2d, 0x45, 0x91, 0x4d, 0x8a, 0x22, 0x25, 0x4a, 0xa2, 0x56,
+		0xb2, 0x44, 0x53, 0xe4, 0x52, 0xa2, 0x64, 0xc9, 0x92, 0xfc, 0x73, 0x22, 0x45, 0x42, 0x0b, 0xf0,
+		0x24, 0xde, 0x88, 0x92, 0xef, 0x0e, 0x06, 0xf6, 0x86, 0x3b, 0x4d, 0x71, 0x8e, 0xbb, 0x3b, 0xeb,
+		0x99, 0x5e, 0xae, 0x78, 0x77, 0x7e, 0xba, 0x87, 0x00, 0x41, 0x0c, 0xc7, 0x30, 0x02, 0xc4, 0x40,
+		0x8c, 0x04, 0x01, 0xf2, 0xe3, 0x24, 0x80, 0x83, 0x04, 0x41, 0xfe, 0x5e, 0x92, 0x00, 0x81, 0x03,
+		0x24, 0x70, 0xf2, 0x94, 0x97, 0xbc, 0x06, 0x41, 0xf2, 0x96, 0x87, 0x38, 0x6f, 0x01, 0x82, 0xe9,
+		0xe9, 0xd9, 0xdd, 0x99, 0xe9, 0x9e, 0xe9, 0xd9, 0xa5, 0xec, 0x04, 0xd6, 0x1b, 0xa7, 0xb7, 0xaa,
+		0xe6, 0xeb, 0xee, 0xaa, 0xae, 0xaa, 0xae, 0x1a, 0xc2, 0xa9, 0xc6, 0x26, 0xb6, 0x16, 0xca, 0x9a,
+		0x8e, 0x6b, 0x65, 0xbc, 0xa0, 0xd5, 0x8d, 0x85, 0xdd, 0x8b, 0x0b, 0xdb, 0x86, 0x4d, 0x4c, 0x6b,
+		0xaf, 0x50, 0xb7, 0x4c, 0x62, 0xa2, 0x51, 0x87, 0xa4, 0xc0, 0x48, 0x0a, 0x5a, 0xdd, 0x28, 0xec,
+		0x5e, 0x3c, 0x7a, 0xe2, 0xa1, 0x69, 0x3e, 0xac, 0xe0, 0x05, 0x4a, 0xb2, 0xd9, 0xd8, 0x5a, 0xd0,
+		0x1b, 0x96, 0x46, 0x0c, 0xb3, 0xe6, 0x32, 0x1d, 0x3d, 0x19, 0xfc, 0x9d, 0x18, 0x55, 0x6c, 0x13,
+		0xad, 0x5a, 0x67, 0x04, 0xd3, 0xbc, 0x17, 0x97, 0xcd, 0x6a, 0xb5, 0x25, 0x22, 0xcf, 0xa3, 0x20,
+		0x9a, 0xbd, 0x53, 0x31, 0x6c, 0x12, 0x45, 0xd3, 0x34, 0xad, 0x9d, 0xad, 0x8a, 0xd9, 0x74, 0x69,
+		0xf2, 0xb7, 0x60, 0xe0, 0xb6, 0x3b, 0x21, 0x74, 0x0d, 0x32, 0x78, 0x17, 0xd7, 0x88, 0x3d, 0xa1,
+		0x4c, 0xa7, 0x67, 0x72, 0x8b, 0xa7, 0x0a, 0x9c, 0xb9, 0x15, 0x18, 0xf5, 0x8a, 0x43, 0xa9, 0x32,
+		0x86, 0xfc, 0xd7, 0xae, 0xc3, 0x60, 0xe7, 0x0f, 0x68, 0x12, 0x0e, 0xd0, 0x9f, 0x4a, 0x86, 0x3e,
+		0xa1, 0x4c, 0x2b, 0x33, 0x69, 0x75, 0x80, 0x3e, 0x17, 0x75, 0x74, 0x0d, 0xc0, 0xfd, 0xc9, 0x99,
+		0xf4, 0x44, 0x6a, 0x5a, 0x99, 0xc9, 0x2d, 0x1e, 0x2d, 0xb8, 0x2b, 0x52, 0xf0, 0x56, 0xa4, 0xb0,
+		0xe1, 0xad, 0x88, 0x9a, 0xa5, 0xd4, 0xce, 0x33, 0x9a, 0x80, 0x81, 0x5d, 0x6c, 0xd9, 0x86, 0x59,
+		0x9b, 0x48, 0xbb, 0x42, 0xd9, 0x23, 0x3a, 0x02, 0x03, 0xce, 0xe4, 0x9d, 0xd7, 0xf5, 0xd1, 0x5f,
+		0x32, 0xce, 0x63, 0x51, 0x47, 0x5f, 0x50, 0xe0, 0xbc, 0x37, 0xe5, 0x12, 0x7e, 0x84, 0xcb, 0x0d,
+		0x67, 0x1f, 0x4a, 0x36, 0xd1, 0x2c, 0x82, 0xf5, 0x92, 0x8b, 0x44, 0x23, 0xc4, 0x32, 0x36, 0x1b,
+		0x04, 0xdb, 0x13, 0xfd, 0x14, 0xcf, 0xf3, 0xdc, 0xa9, 0xbf, 0xcc, 0xe4, 0xac, 0x78, 0x62, 0xee,
+		0xb9, 0x52, 0xe8, 0x94, 0x6f, 0xb6, 0x64, 0xdc, 0x7e, 0x4a, 0x3d, 0xd7, 0x94, 0x23, 0x45, 0x5f,
+		0x56, 0x60, 0x9e, 0x03, 0xaf, 0x6c, 0x56, 0xeb, 0x15, 0xcc, 0x05, 0x98, 0xa1, 0x00, 0x5f, 0x94,
+		0x03, 0xb8, 0xec, 0xc9, 0x09, 0x43, 0x7c, 0xa6, 0x29, 0x4b, 0x8c, 0xde, 0x56, 0x60, 0x96, 0x03,
+		0x72, 0x4b, 0x33, 0x2a, 0x3c, 0x84, 0x03, 0x14, 0xe1, 0x0d, 0x39, 0x84, 0xab, 0x54, 0x48, 0x18,
+		0xde, 0xd9, 0xa6, 0x14, 0x25, 0xfa, 0x12, 0x7f, 0x01, 0x1d, 0xdd, 0xd2, 0x4b, 0x66, 0x83, 0x84,
+		0xe1, 0x1d, 0xa0, 0xf0, 0x5e, 0x90, 0x83, 0xe7, 0xa8, 0x9d, 0x7e, 0xb7, 0x41, 0xc2, 0x00, 0x67,
+		0x9a, 0x92, 0xb4, 0xe8, 0x2d, 0x05, 0x66, 0x74, 0x5c, 0x36, 0x6c, 0x0a, 0xcc, 0xd1, 0x52, 0xbb,
+		0xbc, 0x8d, 0xf5, 0x06, 0x77, 0xf1, 0xb2, 0x14, 0xdd, 0x35, 0x2e, 0xba, 0x5b, 0x4c, 0xc8, 0x86,
+		0x66, 0xef, 0xdc, 0xf3, 0x44, 0x84, 0x91, 0x9d, 0xd1, 0x25, 0xe8, 0xd0, 0xeb, 0x0a, 0x9c, 0x0d,
+		0xa0, 0x12, 0xd9, 0x04, 0x50, 0x4c, 0x57, 0xe3, 0x31, 0x89, 0xcc, 0x21, 0xaf, 0xc7, 0x52, 0x71,
+		0x56, 0x29, 0xc2, 0x08, 0x72, 0x92, 0xab, 0x14, 0xa1, 0xff, 0x67, 0x74, 0x09, 0x3a, 0xf4, 0x66,
+		0x08, 0x55, 0x84, 0x66, 0x0d, 0x52, 0x54, 0xcf, 0xc5, 0xa2, 0x12, 0x2b, 0xd5, 0x69, 0x3d, 0x9e,
+		0x0c, 0x7d, 0x5a, 0x81, 0xa7, 0xfd, 0x98, 0x44, 0x96, 0x78, 0x90, 0x02, 0xba, 0x12, 0x0b, 0x48,
+		0x64, 0x84, 0xa7, 0xf4, 0x38, 0x22, 0xba, 0x6d, 0x5a, 0x99, 0x18, 0xbb, 0x06, 0xd9, 0x8b, 0x55,
+		0xee, 0xa1, 0x88, 0x6d, 0xbb, 0xc9, 0x84, 0xc4, 0x29, 0xb7, 0x26, 0x41, 0x47, 0x95, 0x3b, 0x80,
+		0x4a, 0xa4, 0xdc, 0x87, 0x22, 0x94, 0xdb, 0x87, 0x49, 0xa8, 0xdc, 0x5a, 0x2c, 0x15, 0x67, 0x95,
+		0x22, 0x94, 0x7b, 0x58, 0x72, 0x95, 0xa2, 0x94, 0x5b, 0x93, 0xa0, 0xa3, 0x8a, 0xe4, 0x47, 0x25,
+		0x52, 0xa4, 0x91, 0x08, 0x45, 0xea, 0x84, 0x24, 0x54, 0x24, 0x2d, 0x8e, 0x88, 0x5a, 0x9a, 0x1f,
+		0x4c, 0x84, 0xa5, 0xa1, 0x08, 0x4b, 0xeb, 0xc4, 0x13, 0x61, 0x69, 0x5a, 0x3c, 0x19, 0x6a, 0xc2,
+		0x09, 0x07, 0x84, 0x25, 0xd6, 0x9e, 0x51, 0x0a, 0xe4, 0x02, 0x17, 0x88, 0x23, 0xd5, 0x12, 0xaa,
+		0xcd, 0x31, 0x22, 0xfe, 0x19, 0xbd, 0x0a, 0x53, 0xee, 0x8b, 0xb7, 0x0c, 0x8b, 0xf7, 0xda, 0x31,
+		0xfa, 0xda, 0x82, 0xf8, 0xb5, 0xab, 0x86, 0x15, 0x92, 0x7a, 0xfb, 0x29, 0x75, 0x92, 0x88, 0x7e,
+		0x44, 0x5f, 0x55, 0x60, 0x21, 0xa0, 0xa2, 0x5a, 0xad, 0x8c, 0x2b, 0x25, 0x0b, 0xbf, 0xda, 0xc0,
+		0x36, 0x77, 0xf6, 0x87, 0x29, 0x8c, 0x97, 0xe2, 0x35, 0x95, 0x4a, 0x52, 0x3d, 0x41, 0x61, 0x5c,
+		0xb3, 0x9a, 0x34, 0x35, 0xfa, 0xae, 0x02, 0x97, 0x19, 0x26, 0x0f, 0xa2, 0x9c, 0x12, 0x8f, 0x53,
+		0xb4, 0xcb, 0x5c, 0xb4, 0xec, 0x6d, 0xee, 0xab, 0x65, 0x34, 0xba, 0x60, 0x25, 0xe2, 0x40, 0x9f,
+		0x55, 0xe0, 0x1c, 0x6f, 0x79, 0x79, 0x40, 0x8f, 0x48, 0x6a, 0xf7, 0x32, 0x93, 0x10, 0xa3, 0xdd,
+		0x02, 0x32, 0xf4, 0x3f, 0x70, 0xd2, 0x55, 0x32, 0x31, 0x92, 0x09, 0x8a, 0xe4, 0xa2, 0x58, 0xcf,
+		0xc4, 0x10, 0xa6, 0x48, 0xc4, 0xef, 0xe8, 0x53, 0x0a, 0x9c, 0x61, 0x9b, 0xc7, 0x14, 0x5d, 0xb0,
+		0x69, 0x93, 0x14, 0xc1, 0xb3, 0x5c, 0x04, 0xae, 0x70, 0x57, 0xdf, 0x05, 0xdb, 0x34, 0x5d, 0x8e,
+		0xa1, 0x41, 0xaf, 0xc1, 0x74, 0x55, 0xb3, 0x76, 0xb0, 0x55, 0xb2, 0x70, 0xd9, 0xb4, 0x74, 0x1e,
+		0x88, 0xa3, 0x14, 0xc4, 0x22, 0x17, 0xc4, 0xbf, 0x52, 0x66, 0x95, 0xf1, 0x86, 0x11, 0x1c, 0xaf,
+		0x46, 0x11, 0xa0, 0x2f, 0x2a, 0x30, 0xc7, 0xcb, 0x4f, 0x8c, 0x87, 0x35, 0x8d, 0xbb, 0x20, 0xc7,
+		0x92, 0x84, 0xaf, 0xf7, 0x98, 0x18, 0x99, 0xf0, 0x55, 0x40, 0x8b, 0xbe, 0xa2, 0x40, 0x81, 0x83,
+		0x90, 0x60, 0xab, 0x6a, 0xd4, 0x34, 0xee, 0xb9, 0x30, 0x15, 0x71, 0x2e, 0x84, 0x43, 0xec, 0x96,
+		0x20, 0xce, 0xb9, 0xd0, 0x94, 0xa6, 0x46, 0xdf, 0x53, 0xe0, 0x32, 0x2f, 0x95, 0x8a, 0x3d, 0xc5,
+		0x8e, 0x53, 0xb4, 0xb7, 0x24, 0x33, 0xaa, 0xb8, 0xa3, 0x6c, 0xa1, 0x99, 0x8c, 0x45, 0xa4, 0x01,
+		0x62, 0xa3, 0x3c, 0x91, 0x44, 0x03, 0xc4, 0x06, 0x3a, 0xd3, 0x94, 0xa4, 0x45, 0xbf, 0x57, 0x60,
+		0x25, 0x70, 0xe2, 0xe2, 0x47, 0x04, 0x5b, 0x35, 0xad, 0x52, 0xe2, 0x20, 0x37, 0x6a, 0x06, 0x31,
+		0xf8, 0x8a, 0x71, 0x92, 0x42, 0xbf, 0x17, 0x7f, 0x04, 0xaf, 0x30, 0xf9, 0xa1, 0xf9, 0x14, 0x3d,
+		0xe1, 0xe1, 0x09, 0xbd, 0x68, 0xf5, 0x24, 0x01, 0xfd, 0x4e, 0x81, 0xa5, 0x04, 0xd3, 0x14, 0x9d,
+		0x58, 0xd3, 0x74, 0x8e, 0xeb, 0x3d, 0xcc, 0x51, 0x74, 0x98, 0xdd, 0xb0, 0xba, 0x67, 0x47, 0x1f,
+		0x28, 0xf0, 0x42, 0xd4, 0x74, 0xe2, 0xed, 0xe4, 0x14, 0x9d, 0xd8, 0x1a, 0x77, 0x62, 0x42, 0x30,
+		0xb1, 0xf6, 0x72, 0x15, 0x77, 0xc7, 0x4a, 0xe3, 0x00, 0xde, 0x3c, 0xcc, 0x1a, 0x31, 0x6a, 0x0d,
+		0xac, 0x97, 0x34, 0xbb, 0x54, 0xc3, 0xcd, 0xf0, 0x3c, 0xf2, 0x11, 0x71, 0x40, 0x18, 0x84, 0x27,
+		0xee, 0xa6, 0x7d, 0x07, 0x37, 0xc3, 0xf0, 0x0b, 0xcd, 0x44, 0x1c, 0xe8, 0x67, 0x0a, 0x5c, 0xa3,
+		0xd1, 0x64, 0xa9, 0xbc, 0x6d, 0x54, 0xf4, 0x84, 0xf6, 0x73, 0x9a, 0x42, 0xbf, 0xcd, 0x85, 0x4e,
+		0x43, 0xc9, 0x65, 0x47, 0x68, 0x12, 0xa3, 0xb9, 0x64, 0x27, 0x67, 0x43, 0x3f, 0x54, 0xe0, 0x4a,
+		0xcc, 0x24, 0x44, 0xd6, 0x71, 0x86, 0xce, 0x60, 0x25, 0xe9, 0x0c, 0x44, 0x26, 0x71, 0xc1, 0x4e,
+		0xc8, 0x83, 0xbe, 0xa5, 0xc0, 0x45, 0x21, 0x6a, 0x61, 0x9c, 0xff, 0x34, 0x85, 0x7d, 0x93, 0x1f,
+		0x86, 0x70, 0xdf, 0x2e, 0x0c, 0xfc, 0xe7, 0xca, 0x09, 0xe8, 0xd1, 0x77, 0x14, 0xb8, 0x24, 0x84,
+		0x1b, 0x91, 0x44, 0x9e, 0x8d, 0x50, 0x72, 0x3e, 0xe0, 0x88, 0x74, 0xb2, 0x50, 0x4e, 0xc4, 0x81,
+		0xde, 0x55, 0xe0, 0x42, 0x62, 0xcd, 0x38, 0x47, 0x11, 0xff, 0x4b, 0x02, 0xc4, 0x22, 0xa5, 0x38,
+		0x5f, 0x4e, 0xa0, 0x0f, 0xef, 0x29, 0xb0, 0x28, 0x5e, 0x60, 0xa1, 0x13, 0x9e, 0xa1, 0x68, 0x97,
+		0x92, 0xac, 0xaf, 0xd0, 0x13, 0xcf, 0x97, 0x93, 0x30, 0xa0, 0x6f, 0x47, 0xa9, 0x44, 0x44, 0xd2,
+		0xfc, 0x4c, 0x62, 0xc8, 0xe2, 0xf4, 0x79, 0xbe, 0x9c, 0x84, 0x81, 0xc6, 0x66, 0x62, 0xc8, 0x11,
+		0x91, 0xe4, 0x6c, 0x44, 0x6c, 0x26, 0xc0, 0x1c, 0x11, 0x4e, 0x2e, 0x94, 0x93, 0xb1, 0x50, 0xa7,
+		0xe9, 0x86, 0xe2, 0xdd, 0x46, 0x3c, 0xe7, 0x23, 0x9c, 0xa6, 0x1b, 0x71, 0x77, 0x13, 0xea, 0x5c,
+		0xb5, 0xbb, 0x63, 0x45, 0x3f, 0x57, 0xe0, 0xba, 0xc4, 0x84, 0x44, 0x36, 0x3a, 0x47, 0x67, 0x53,
+		0xec, 0x66, 0x36, 0x22, 0x63, 0xbd, 0x6c, 0x77, 0xc1, 0x87, 0x7e, 0xa0, 0xc0, 0xb3, 0x51, 0x13,
+		0x10, 0xe7, 0x4f, 0xf3, 0x11, 0x0e, 0x48, 0x08, 0x42, 0x9c, 0x47, 0x5d, 0xc0, 0x09, 0x79, 0xe8,
+		0x81, 0xd3, 0xa8, 0xdb, 0xd8, 0x22, 0x6d, 0xe0, 0x36, 0xd6, 0xac, 0xf2, 0x76, 0x07, 0xcc, 0x30,
+		0xee, 0x42, 0x84, 0xf5, 0xde, 0xa7, 0xe2, 0x3c, 0x04, 0xf7, 0xa8, 0xb0, 0xf6, 0x1b, 0x39, 0xd6,
+		0xdb, 0x48, 0xc2, 0x20, 0xaa, 0xff, 0xd4, 0xb5, 0x86, 0xcd, 0x5b, 0xe1, 0x85, 0x24, 0xf5, 0x9f,
+		0x75, 0x2a, 0x44, 0xa6, 0xfe, 0xc3, 0xa5, 0x14, 0x65, 0x4f, 0x8d, 0x9a, 0x08, 0xdd, 0x85, 0x24,
+		0xd9, 0xd3, 0xfd, 0x5a, 0x9d, 0xf7, 0x56, 0x6e, 0xf6, 0x24, 0xa0, 0x5d, 0x1a, 0x04, 0x68, 0xbf,
+		0x3e, 0xff, 0xc6, 0x10, 0x9c, 0x93, 0xf5, 0xfd, 0xab, 0x70, 0xb0, 0x35, 0x35, 0xb2, 0x57, 0xc7,
+		0xb4, 0x92, 0x2a, 0xaa, 0xcb, 0x7a, 0x42, 0x37, 0xf6, 0xea, 0x58, 0x1d, 0x6c, 0x76, 0x3c, 0xa1,
+		0x57, 0xe0, 0x70, 0x5d, 0xb3, 0x9c, 0x75, 0xe8, 0x3c, 0xb2, 0xb6, 0x4c, 0x56, 0x7c, 0x9d, 0xe1,
+		0xca, 0x5b, 0xa7, 0x1c, 0x1d, 0x27, 0xca, 0x96, 0xa9, 0x8e, 0xd6, 0xc3, 0x83, 0xe8, 0x3a, 0x64,
+		0xe9, 0x7d, 0x56, 0xc5, 0xb0, 0x09, 0x2d, 0xcb, 0xe6, 0x16, 0x8f, 0xf3, 0x2f, 0x8c, 0x34, 0x7b,
+		0x67, 0xcd, 0xb0, 0x89, 0x7a, 0x80, 0xb0, 0xbf, 0xd0, 0x22, 0xf4, 0x1b, 0xb5, 0x7a, 0x83, 0xd0,
+		0xa2, 0x6d, 0x6e, 0x71, 0x4a, 0x80, 0x64, 0xaf, 0x62, 0x6a, 0xba, 0xea, 0x92, 0x22, 0x0d, 0xa6,
+		0x03, 0x01, 0x5b, 0x89, 0x98, 0xa5, 0x72, 0xc5, 0xb4, 0x31, 0xf5, 0x7e, 0x66, 0x83, 0xb0, 0x2a,
+		0xee, 0x64, 0xa8, 0xaa, 0x7c, 0x8b, 0xd5, 0xe1, 0xd5, 0x29, 0xec, 0x5b, 0xfb, 0x0d, 0x73, 0xd9,
+		0xe1, 0xdf, 0x70, 0xd9, 0xd1, 0xcb, 0x70, 0xac, 0x5d, 0x34, 0x08, 0x4b, 0xcf, 0xc4, 0x49, 0x3f,
+		0x42, 0xbc, 0x52, 0x40, 0x40, 0xf0, 0x0d, 0x38, 0xda, 0xce, 0x4f, 0xda, 0xb3, 0xb0, 0x1a, 0x35,
+		0xa7, 0x72, 0xed, 0x14, 0x4e, 0xb3, 0xea, 0x91, 0x16, 0x45, 0x6b, 0x9d, 0xd5, 0x46, 0xad, 0xa8,
+		0xa3, 0x22, 0x64, 0x99, 0xa3, 0x31, 0x2d, 0x5a, 0xc5, 0x1c, 0x5a, 0x3c, 0xcf, 0x77, 0x8c, 0x4c,
+		0x00, 0x4d, 0x40, 0x8a, 0x1e, 0x8b, 0xda, 0xe6, 0x46, 0x45, 0x18, 0x69, 0xe3, 0x70, 0x0e, 0xfb,
+		0x86, 0x85, 0x27, 0xb2, 0x11, 0x7b, 0xb0, 0xea, 0xd2, 0xa8, 0xc3, 0x2d, 0x36, 0x36, 0x82, 0x54,
+		0x18, 0xaf, 0x68, 0x4e, 0xc6, 0xec, 0x06, 0x83, 0x74, 0x3a, 0xd8, 0x6e, 0x54, 0xc8, 0x04, 0x44,
+		0xc8, 0xf3, 0xf6, 0x74, 0xcc, 0xe1, 0x5d, 0x6e, 0xb1, 0xaa, 0x94, 0x13, 0x5d, 0x83, 0x49, 0xd3,
+		0x32, 0x1e, 0x1a, 0xae, 0x9b, 0x0a, 0xac, 0x52, 0x8e, 0xae, 0xd2, 0xb8, 0x47, 0x10, 0x58, 0xa4,
+		0xa3, 0x70, 0xc0, 0xd0, 0x71, 0x8d, 0x18, 0x64, 0x8f, 0xd6, 0xe3, 0xb2, 0x6a, 0xeb, 0x19, 0x5d,
+		0x82, 0xf1, 0x2d, 0xc3, 0xb2, 0x49, 0x58, 0xe6, 0x41, 0x4a, 0x39, 0x4a, 0x7f, 0x0d, 0x08, 0x5c,
+		0x86, 0x41, 0x0b, 0x13, 0x6b, 0xaf, 0x54, 0x37, 0x2b, 0x46, 0x79, 0x8f, 0xd5, 0xb0, 0xa6, 0x05,
+		0xe9, 0x3d, 0xb1, 0xf6, 0xd6, 0x29, 0x9d, 0x9a, 0xb3, 0xda, 0x0f, 0x4e, 0xe3, 0x82, 0x46, 0x08,
+		0xae, 0xd6, 0x09, 0xad, 0x37, 0xf5, 0xab, 0xde, 0x23, 0x5a, 0x86, 0x43, 0xf8, 0x51, 0xdd, 0x70,
+		0x15, 0xc7, 0x6d, 0x89, 0x18, 0x8e, 0x6d, 0x89, 0x18, 0x6a, 0xb3, 0x38, 0x83, 0xe8, 0x34, 0x1c,
+		0x2c, 0x5b, 0x8e, 0x35, 0xb0, 0x7a, 0x18, 0xad, 0xd7, 0x64, 0xd5, 0x41, 0x67, 0xd0, 0xab, 0x91,
+		0xa1, 0x7f, 0x87, 0x63, 0xee, 0xec, 0xfd, 0xb5, 0xc3, 0x4d, 0xad, 0xbc, 0x63, 0x6e, 0x6d, 0x4d,
+		0xa0, 0x38, 0xa5, 0x9e, 0xa0, 0xdc, 0x9d, 0x65, 0xc3, 0x25, 0x97, 0x15, 0xcd, 0x43, 0x5f, 0x15,
+		0x57, 0x4d, 0x56, 0x0c, 0x99, 0xe4, 0x5f, 0x93, 0xe2, 0xaa, 0xa9, 0x52, 0x32, 0xa4, 0xc2, 0x48,
+		0xc8, 0xdf, 0xb1, 0x8a, 0xc6, 0xd3, 0xfc, 0xc8, 0x22, 0xe0, 0x9f, 0xd4, 0x61, 0x3b, 0x30, 0x82,
+		0xee, 0xc3, 0x78, 0xdd, 0xc2, 0xbb, 0x25, 0xad, 0x41, 0x4c, 0x47, 0xff, 0x30, 0x29, 0xd5, 0x4d,
+		0xa3, 0x46, 0xbc, 0x1a, 0x85, 0x68, 0xbf, 0x6c, 0x4c, 0xd6, 0x29, 0x9d, 0x3a, 0xea, 0xf0, 0xdf,
+		0x6c, 0x10, 0xb3, 0x63, 0x10, 0x5d, 0x82, 0xcc, 0x36, 0xd6, 0x74, 0x6c, 0xb1, 0xe2, 0xc1, 0x31,
+		0x7e, 0x4b, 0x0c, 0x25, 0x51, 0x19, 0x29, 0x5a, 0x83, 0x31, 0x77, 0xa1, 0xdb, 0x95, 0x50, 0xba,
+		0xaf, 0x47, 0x62, 0xf7, 0x15, 0x51, 0xbe, 0x56, 0x55, 0x93, 0xee, 0xed, 0xff, 0xc1, 0x70, 0x5d,
+		0xb3, 0x88, 0xe1, 0x5d, 0x6e, 0x6c, 0x19, 0x0f, 0x27, 0x26, 0x68, 0x7f, 0xce, 0xbf, 0xf5, 0xd2,
+		0xa4, 0x52, 0x58, 0xf7, 0x84, 0x2e, 0x53, 0x99, 0x2b, 0x35, 0x62, 0xed, 0xa9, 0x87, 0xea, 0xfe,
+		0x51, 0x74, 0x1c, 0xc0, 0xbb, 0x12, 0x33, 0x74, 0x7a, 0x19, 0x9f, 0x55, 0xb3, 0x6c, 0xa4, 0xa8,
+		0x1f, 0x5d, 0x82, 0x31, 0x9e, 0x1c, 0x34, 0x0c, 0xe9, 0x1d, 0xbc, 0x47, 0xfd, 0x55, 0x56, 0x75,
+		0xfe, 0x44, 0x63, 0xd0, 0xbf, 0xab, 0x55, 0x1a, 0x6e, 0xc3, 0x4f, 0x56, 0x75, 0x1f, 0xae, 0xa7,
+		0x9e, 0x53, 0xf2, 0xef, 0x2a, 0xf0, 0x8c, 0x7c, 0x6a, 0x79, 0x19, 0x32, 0xec, 0x78, 0x51, 0x24,
+		0x8e, 0x17, 0x46, 0x8b, 0x56, 0x61, 0x3a, 0xba, 0xb7, 0xc0, 0xd0, 0x29, 0xb0, 0xb4, 0x3a, 0x25,
+		0x6e, 0x0b, 0x28, 0xea, 0xf9, 0xaf, 0x2b, 0x70, 0x56, 0x32, 0x42, 0xbd, 0x02, 0x03, 0xde, 0xc1,
+		0xaa, 0x48, 0x1c, 0xac, 0x1e, 0xf1, 0xbe, 0x41, 0x35, 0x61, 0x46, 0x3a, 0x3d, 0x5b, 0x86, 0x41,
+		0xe6, 0xdb, 0xda, 0x71, 0xc6, 0x90, 0xc0, 0x66, 0x98, 0x2b, 0xa3, 0x61, 0x46, 0x8e, 0xb4, 0x1f,
+		0xf2, 0xbf, 0x52, 0xe0, 0x8c, 0x4c, 0x87, 0x8a, 0x3f, 0x60, 0x50, 0x92, 0x05, 0x0c, 0x77, 0x60,
+		0x5c, 0xe0, 0x94, 0x53, 0x71, 0xe7, 0xd7, 0xa8, 0xcd, 0x71, 0xc8, 0x1d, 0x07, 0x73, 0xda, 0x77,
+		0x30, 0xe7, 0x5f, 0x57, 0x20, 0x1f, 0xdf, 0xdc, 0x82, 0xe6, 0x00, 0x05, 0x1b, 0x1e, 0x5a, 0x2d,
+		0x6f, 0xc3, 0xb6, 0x6f, 0x09, 0x02, 0xde, 0x29, 0x15, 0xf0, 0x4e, 0x7e, 0x53, 0x4b, 0x07, 0x4c,
+		0x2d, 0xff, 0xe7, 0xc0, 0xf2, 0x0a, 0x2d, 0x24, 0x19, 0xa2, 0x19, 0x18, 0xf6, 0x5f, 0x7a, 0xb5,
+		0xd4, 0x6b, 0xc8, 0xee, 0x98, 0x71, 0x00, 0x7b, 0x3a, 0x80, 0xfd, 0x1c, 0x1c, 0xda, 0x34, 0x6a,
+		0x9a, 0xb5, 0x57, 0x2a, 0x6f, 0xe3, 0xf2, 0x8e, 0xdd, 0xa8, 0xd2, 0x88, 0x2e, 0xab, 0x0e, 0xb9,
+		0xc3, 0xcb, 0x6c, 0x14, 0x9d, 0x87, 0x11, 0xff, 0x55, 0x2d, 0x7e, 0xe4, 0x46, 0x6b, 0x83, 0xea,
+		0x30, 0xee, 0xbc, 0x41, 0xc5, 0x8f, 0x48, 0xfe, 0x9b, 0x69, 0x38, 0x2d, 0xd1, 0x37, 0xf3, 0xd8,
+		0x66, 0x1c, 0x34, 0x8b, 0x74, 0x17, 0x66, 0x81, 0x4e, 0x40, 0x6e, 0x53, 0xb3, 0xb1, 0x17, 0x69,
+		0xb8, 0xcb, 0x92, 0x75, 0x86, 0xdc, 0xf8, 0x62, 0x0a, 0xc0, 0xb9, 0xa5, 0x66, 0x3f, 0xf7, 0xbb,
+		0x0b, 0x5b, 0xc3, 0x4d, 0xf7, 0xd7, 0x39, 0x40, 0x5b, 0xa6, 0xb5, 0xc3, 0x90, 0x7a, 0xcd, 0x8f,
+		0x19, 0x77, 0x6a, 0xce, 0x2f, 0x14, 0xeb, 0x03, 0x77, 0x1c, 0x8d, 0x3b, 0x87, 0xa3, 0x66, 0x9b,
+		0x35, 0x16, 0x4a, 0xb2, 0x27, 0x74, 0x0b, 0xfa, 0xcb, 0x4e, 0x6e, 0xc2, 0xa2, 0xc6, 0x82, 0x74,
+		0x87, 0xd2, 0xb2, 0xc3, 0xa5, 0xba, 0xcc, 0x01, 0x05, 0xcd, 0x06, 0x15, 0xf4, 0xfd, 0x34, 0x9c,
+		0x8a, 0x6d, 0x2a, 0x7a, 0x6c, 0x7b, 0xb5, 0xe4, 0x4d, 0xd1, 0xdd, 0xa4, 0x39, 0xc9, 0x9e, 0x27,
+		0xdf, 0x04, 0x3b, 0x8e, 0xec, 0xbe, 0x24, 0x47, 0x76, 0xa7, 0x65, 0xf4, 0x07, 0x2c, 0x23, 0xb0,
+		0xfd, 0x99, 0xe8, 0xed, 0x1f, 0x90, 0xda, 0xfe, 0x03, 0x82, 0xed, 0xe7, 0x58, 0x61, 0x96, 0x6b,
+		0x85, 0xfe, 0x9d, 0x84, 0xe0, 0x4e, 0xbe, 0x93, 0x81, 0x33, 0x32, 0xed, 0x58, 0xe8, 0x24, 0xe4,
+		0x5a, 0x3d, 0x0d, 0x6c, 0x17, 0xb3, 0x2a, 0x78, 0x43, 0x45, 0xdd, 0xc9, 0x60, 0x5b, 0x04, 0xd4,
+		0x84, 0x52, 0x11, 0x19, 0x6c, 0xeb, 0x95, 0x34, 0x83, 0xd5, 0x3a, 0x9e, 0x1c, 0xc5, 0xd6, 0xcd,
+		0xaa, 0x66, 0xd4, 0xd8, 0xc9, 0xc3, 0x9e, 0xfc, 0xae, 0xa4, 0xaf, 0xcb, 0xdc, 0x33, 0x23, 0x9f,
+		0x7b, 0x6e, 0xc0, 0xa4, 0xa7, 0xa3, 0x61, 0x0f, 0x34, 0x10, 0xe7, 0x81, 0xc6, 0x3d, 0xde, 0x80,
+		0x13, 0x0a, 0x48, 0x65, 0x0e, 0x8e, 0x49, 0x3d, 0x90, 0x40, 0xaa, 0x9b, 0x72, 0x32, 0xa9, 0x62,
+		0x57, 0x99, 0xed, 0xca, 0x55, 0xae, 0xc2, 0xc8, 0x36, 0xd6, 0x2c, 0xb2, 0x89, 0xb5, 0x36, 0x3a,
+		0x88, 0x13, 0x35, 0xdc, 0xe2, 0x69, 0xcb, 0x89, 0x0f, 0x70, 0x72, 0xf1, 0x01, 0x4e, 0x28, 0x31,
+		0x1b, 0xec, 0x26, 0x31, 0x6b, 0x07, 0xf8, 0x07, 0xa5, 0x03, 0xfc, 0xfc, 0x1f, 0x15, 0xc8, 0xc7,
+		0xb7, 0x06, 0x7e, 0x64, 0xa1, 0x41, 0x67, 0x10, 0xd3, 0xe7, 0xcf, 0x2e, 0x5f, 0x82, 0x41, 0x9a,
+		0x9c, 0x7b, 0xc7, 0x5a, 0xbf, 0xc4, 0xb1, 0x96, 0x73, 0x38, 0xd8, 0x43, 0xfe, 0x37, 0x8a, 0xff,
+		0x28, 0xd8, 0xe7, 0xb8, 0x9c, 0xbf, 0x44, 0xa9, 0x04, 0xde, 0x20, 0x1d, 0x1b, 0xab, 0xf4, 0xf9,
+		0x17, 0x33, 0xff, 0x6b, 0x05, 0x4e, 0xc5, 0xf7, 0x6b, 0x75, 0x1b, 0xbe, 0x7f, 0x1c, 0x33, 0xfa,
+		0x71, 0x0a, 0x4e, 0x4b, 0x74, 0x3d, 0x3a, 0x73, 0xd2, 0x31, 0xd1, 0x8c, 0x8a, 0x2d, 0xb5, 0x49,
+		0x1e, 0xf1, 0x63, 0x9b, 0x53, 0x30, 0xbe, 0xea, 0xeb, 0x26, 0xbe, 0xea, 0x59, 0xc5, 0x3f, 0xa7,
+		0xc0, 0xac, 0x7c, 0xb3, 0xa2, 0x8c, 0xcf, 0xdb, 0x9f, 0x04, 0xee, 0x3d, 0x05, 0x12, 0xb6, 0x25,
+		0xc6, 0x63, 0x1b, 0xf3, 0xa2, 0x24, 0x96, 0x85, 0xd3, 0x07, 0x29, 0xc4, 0x69, 0x09, 0xc4, 0x6f,
+		0x07, 0xf4, 0x50, 0x54, 0xc0, 0xec, 0x56, 0x0f, 0x57, 0x61, 0xba, 0xa2, 0x91, 0x8e, 0xf6, 0x9c,
+		0x60, 0xb3, 0x4a, 0x7b, 0x65, 0x5d, 0x3a, 0xde, 0x56, 0xba, 0x51, 0x15, 0x47, 0x9f, 0xd3, 0x09,
+		0xf4, 0xb9, 0x2f, 0xd6, 0x46, 0x03, 0x71, 0x60, 0xfe, 0x03, 0x05, 0x8e, 0x45, 0x34, 0x04, 0x3b,
+		0x1f, 0x4c, 0xb9, 0x8d, 0x90, 0xad, 0x7d, 0x1b, 0xa0, 0xcf, 0x45, 0x1d, 0xad, 0xc1, 0xe1, 0x96,
+		0x23, 0xdf, 0x32, 0xac, 0x04, 0x29, 0x2f, 0x62, 0x7e, 0xdc, 0x69, 0xf8, 0x4d, 0xe2, 0x7e, 0x65,
+		0x36, 0xfb, 0xbf, 0x60, 0x52, 0xd8, 0x69, 0x1c, 0x35, 0x1b, 0xe9, 0x90, 0x3e, 0xff, 0xbe, 0x02,
+		0x53, 0x51, 0x4d, 0xa6, 0xfb, 0xf2, 0x96, 0xfd, 0x5a, 0x8f, 0xc8, 0x03, 0xfa, 0xfb, 0x0a, 0x4c,
+		0xc7, 0x35, 0xab, 0x46, 0xcd, 0xe6, 0xb1, 0x9a, 0x6d, 0x24, 0xf2, 0xbf, 0x0d, 0x40, 0xc2, 0x9e,
+		0x28, 0xb4, 0x00, 0x63, 0xb4, 0xed, 0x2a, 0x78, 0xc7, 0xee, 0xce, 0x69, 0xa4, 0x86, 0x9b, 0x81,
+		0x1b, 0xf6, 0x50, 0x99, 0x2b, 0xd5, 0x5d, 0x99, 0xeb, 0x49, 0x21, 0x4a, 0xbe, 0x10, 0x25, 0xa3,
+		0x3b, 0x03, 0x12, 0xba, 0x73, 0x17, 0xc6, 0x59, 0x01, 0x81, 0x61, 0x34, 0x6a, 0x04, 0x5b, 0xbb,
+		0x5a, 0x25, 0x3e, 0x6f, 0x19, 0x63, 0x8c, 0x14, 0x5e, 0x91, 0xb1, 0xf9, 0x8b, 0x5c, 0xd9, 0x9e,
+		0x8a, 0x5c, 0x1d, 0x21, 0x1c, 0x24, 0x09, 0xe1, 0xc4, 0x15, 0xad, 0x5c, 0xd7, 0x15, 0xad, 0x76,
+		0x9e, 0x31, 0x28, 0x5f, 0x48, 0xf0, 0xea, 0x2a, 0x07, 0x7b, 0xa8, 0xab, 0x0c, 0xf5, 0x54, 0x57,
+		0xc9, 0xff, 0x41, 0x81, 0x85, 0xa4, 0x8d, 0x99, 0xad, 0xd3, 0x4a, 0xe9, 0x3c, 0xad, 0xa2, 0xf2,
+		0x9b, 0x4d, 0x38, 0xd2, 0x6a, 0xe6, 0x08, 0x94, 0xa8, 0x5d, 0x3b, 0x9e, 0x8d, 0x6c, 0xd7, 0xf0,
+		0x17, 0xa9, 0x0f, 0x63, 0xde, 0x70, 0x20, 0x87, 0xea, 0x0b, 0xde, 0x79, 0x7c, 0x43, 0x81, 0x19,
+		0xc1, 0x44, 0x79, 0x85, 0xf9, 0x78, 0xeb, 0x51, 0x24, 0xac, 0xa7, 0x23, 0x10, 0x4a, 0x25, 0x08,
+		0x84, 0xf2, 0x1f, 0x2a, 0x70, 0x3c, 0xf2, 0xbb, 0x03, 0x27, 0x12, 0x64, 0x5f, 0x35, 0xd4, 0xb4,
+		0xaa, 0xb7, 0x13, 0xe0, 0x0e, 0xdd, 0xd1, 0xaa, 0xb8, 0xdb, 0x57, 0xef, 0x9b, 0xd3, 0x69, 0x1b,
+		0x44, 0x9f, 0x7c, 0xe2, 0xfd, 0x23, 0xde, 0x26, 0x89, 0xfa, 0x6c, 0x4e, 0x42, 0x8e, 0x75, 0x3a,
+		0x75, 0x2e, 0x81, 0x3b, 0x44, 0x97, 0xa0, 0x75, 0xe6, 0xa7, 0xe4, 0xcf, 0xfc, 0xa8, 0x4b, 0xf0,
+		0x18, 0x0d, 0xfb, 0xbc, 0x02, 0xb3, 0x09, 0x5a, 0xcf, 0xda, 0x77, 0xb9, 0x8a, 0xef, 0x2e, 0xb7,
+		0xdb, 0x8d, 0x8b, 0x40, 0x9e, 0xff, 0x5f, 0x4e, 0x55, 0x8b, 0xdf, 0x6e, 0x23, 0x42, 0xd5, 0x43,
+		0x61, 0xe3, 0x35, 0xce, 0x9e, 0x0a, 0x7a, 0x69, 0x1e, 0xc7, 0xeb, 0x7f, 0x9a, 0x82, 0x17, 0x7b,
+		0xfb, 0xf4, 0x60, 0xdf, 0x8e, 0x83, 0xf6, 0x2d, 0x67, 0xca, 0x77, 0xcb, 0x79, 0x1f, 0x50, 0xb8,
+		0xc5, 0x89, 0x9d, 0x8c, 0x67, 0xe5, 0x8a, 0xc0, 0xea, 0x48, 0xa8, 0x5f, 0xc9, 0xb9, 0x36, 0x2a,
+		0x9b, 0x35, 0x62, 0x99, 0x15, 0xaa, 0xac, 0x83, 0xaa, 0xf7, 0x88, 0x0a, 0x30, 0x1a, 0xe8, 0xd6,
+		0x34, 0x6b, 0x15, 0x37, 0xa7, 0x39, 0xa0, 0x8e, 0xf8, 0x9a, 0x28, 0xef, 0xd6, 0x2a, 0x7b, 0xf9,
+		0xb7, 0xd2, 0x70, 0xa3, 0x87, 0x4f, 0x1b, 0xd0, 0xfd, 0x4e, 0x8f, 0x31, 0x24, 0xf8, 0x70, 0x48,
+		0x4a, 0xb2, 0xef, 0x3e, 0x7f, 0x9f, 0x32, 0x71, 0xe1, 0xed, 0x33, 0x7f, 0x5f, 0xfa, 0x7a, 0xdd,
+		0x97, 0x39, 0x40, 0xc1, 0x86, 0x52, 0x56, 0x19, 0x4a, 0xab, 0xc3, 0x86, 0x4f, 0x09, 0xdd, 0xcb,
+		0x3f, 0x6f, 0x17, 0x33, 0xbe, 0x5d, 0xcc, 0xff, 0x56, 0x81, 0xab, 0x5d, 0x7e, 0x97, 0x21, 0xc0,
+		0xa0, 0x08, 0x30, 0x7c, 0xb4, 0x8a, 0x9b, 0x7f, 0x23, 0x0d, 0x57, 0xbb, 0xec, 0x9d, 0xfd, 0x67,
+		0xb5, 0xd5, 0x80, 0x33, 0xeb, 0x13, 0x3b, 0xb3, 0x7e, 0x79, 0x67, 0x26, 0x54, 0x1d, 0xd1, 0x01,
+		0x30, 0x20, 0x3a, 0x00, 0x3e, 0x93, 0x86, 0xcb, 0xdd, 0xf4, 0xff, 0xca, 0x59, 0xbe, 0x94, 0xe4,
+		0x27, 0x96, 0xdf, 0xb6, 0xfc, 0x3f, 0x29, 0x70, 0x21, 0x69, 0x2f, 0xf3, 0x3f, 0xb4, 0xc9, 0x8b,
+		0x7d, 0x55, 0xfe, 0x97, 0x0a, 0xcc, 0x27, 0xea, 0x7f, 0xde, 0xb7, 0x23, 0x80, 0x9b, 0x6f, 0xa5,
+		0x7a, 0xcb, 0xb7, 0xde, 0xc9, 0xc2, 0xa5, 0x2e, 0x3e, 0xe4, 0xea, 0xd8, 0x0e, 0xc5, 0xb7, 0x1d,
+		0x27, 0x21, 0xd7, 0xda, 0x0e, 0xa6, 0xf3, 0x59, 0x15, 0xbc, 0x21, 0xde, 0xe5, 0x4b, 0x7a, 0x1f,
+		0x2e, 0x5f, 0xba, 0xad, 0xc4, 0xf6, 0xef, 0xef, 0xe5, 0x4b, 0xe6, 0xb1, 0x5e, 0xbe, 0x0c, 0x74,
+		0x7d, 0xf9, 0xf2, 0x00, 0x58, 0x23, 0x35, 0x93, 0xc8, 0x0a, 0x98, 0x6e, 0x73, 0xc6, 0xd9, 0x88,
+		0x6e, 0x6c, 0x2a, 0x85, 0x95, 0x31, 0x47, 0xea, 0xc1, 0xa1, 0x4e, 0x23, 0xc9, 0xfa, 0xcf, 0x73,
+		0x19, 0x95, 0x07, 0x09, 0x95, 0x2f, 0xc3, 0x44, 0x87, 0x3a, 0x95, 0x2c, 0xdc, 0x68, 0xc3, 0xcf,
+		0x51, 0xf8, 0xb3, 0x91, 0x8a, 0x53, 0xd4, 0x55, 0xdc, 0xf0, 0xf0, 0xaa, 0x87, 0x9b, 0xbc, 0xe1,
+		0x50, 0x61, 0xf7, 0x60, 0x37, 0x85, 0xdd, 0x50, 0x4b, 0xec, 0x10, 0xa7, 0x25, 0xb6, 0x9d, 0x84,
+		0x1e, 0x4a, 0x7e, 0x2b, 0x33, 0xdc, 0xc3, 0xad, 0xcc, 0x48, 0x6f, 0xdd, 0xae, 0xd7, 0x21, 0xa7,
+		0xe3, 0x8a, 0xb6, 0xe7, 0xaa, 0x66, 0x7c, 0xeb, 0x2e, 0x50, 0x6a, 0xaa, 0x8a, 0xe8, 0x79, 0x18,
+		0xfc, 0x6f, 0x83, 0x10, 0xef, 0x9f, 0x9a, 0x4c, 0x8c, 0xc6, 0x31, 0xe7, 0x5c, 0x72, 0xca, 0x9d,
+		0x7f, 0x33, 0x0d, 0x17, 0x92, 0x7e, 0xa6, 0xf9, 0xf1, 0x1f, 0x4e, 0x6b, 0x5e, 0x94, 0xe1, 0x56,
+		0x18, 0xaf, 0x24, 0xfe, 0xc6, 0xd0, 0x17, 0x5c, 0x74, 0x98, 0x59, 0xbf, 0xdf, 0xcc, 0xf8, 0x2e,
+		0x34, 0x23, 0x70, 0xa1, 0xfb, 0x74, 0x07, 0x9b, 0xff, 0x45, 0x0a, 0xe6, 0x92, 0x7c, 0x83, 0x2a,
+		0xdc, 0x0f, 0xbe, 0xef, 0x4e, 0xf5, 0xea, 0xbb, 0xf7, 0x6b, 0x17, 0xf9, 0xab, 0xdb, 0x27, 0x58,
+		0xdd, 0xb6, 0x6d, 0xf7, 0xcb, 0x5f, 0x30, 0x7d, 0x98, 0x82, 0x84, 0x5f, 0xc7, 0x7e, 0x32, 0x16,
+		0x93, 0x57, 0x4e, 0xeb, 0xe7, 0x96, 0xd3, 0xda, 0x7d, 0x20, 0x19, 0xf9, 0x3e, 0x90, 0xfc, 0x5f,
+		0x52, 0x70, 0x7e, 0x3f, 0x4e, 0x94, 0x4f, 0xe8, 0xa2, 0x77, 0x54, 0x3a, 0x32, 0x09, 0x2a, 0x1d,
+		0xf9, 0xbf, 0xa6, 0x60, 0x3e, 0xd1, 0xc7, 0xca, 0x4f, 0x16, 0x3e, 0xb4, 0xf0, 0xde, 0x65, 0x6c,
+		0x26, 0xc9, 0x05, 0xfe, 0xff, 0xa7, 0x45, 0x0b, 0x2f, 0xea, 0xdd, 0x79, 0xb2, 0xf0, 0x91, 0xad,
+		0x43, 0x99, 0x6e, 0xbe, 0x58, 0xf8, 0x49, 0x0a, 0x16, 0x12, 0x7e, 0x44, 0xfe, 0x64, 0x1f, 0x7c,
+		0xfb, 0x30, 0x4b, 0xe0, 0x10, 0xfd, 0x73, 0xd5, 0xa8, 0x10, 0x6c, 0xd1, 0x57, 0x1d, 0x87, 0xc9,
+		0x95, 0x07, 0x2b, 0x77, 0x36, 0x4a, 0xab, 0xc5, 0xb5, 0x8d, 0x15, 0xb5, 0xb4, 0xf1, 0x1f, 0xeb,
+		0x2b, 0xa5, 0xe2, 0x9d, 0x07, 0x37, 0xd7, 0x8a, 0xb7, 0x86, 0x9f, 0x42, 0x27, 0xe1, 0x58, 0xf8,
+		0xe7, 0x9b, 0x6b, 0x6b, 0x25, 0x3a, 0x3a, 0xac, 0xa0, 0x53, 0x70, 0x3c, 0x4c, 0xb0, 0xbc, 0x76,
+		0xf7, 0xde, 0x0a, 0x23, 0x49, 0x2d, 0xbd, 0x02, 0x47, 0xca, 0x66, 0x95, 0xb7, 0x06, 0x4b, 0xde,
+		0xbf, 0x21, 0x5e, 0xb7, 0x4c, 0x62, 0xae, 0x2b, 0xff, 0x79, 0xf1, 0xa1, 0x41, 0xb6, 0x1b, 0x9b,
+		0x85, 0xb2, 0x59, 0x5d, 0xe8, 0xfc, 0x77, 0xc8, 0xf3, 0x86, 0x5e, 0x59, 0x78, 0x68, 0xba, 0xff,
+		0x82, 0x99, 0xfd, 0x6f, 0xe4, 0x1b, 0x5a, 0xdd, 0xd8, 0xbd, 0xb8, 0x99, 0xa1, 0x63, 0x97, 0xfe,
+		0x3e, 0x00, 0xf6, 0x06, 0x03, 0xae, 0xfe, 0x59, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/query.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdf, 0x6f, 0x93, 0x50,
+		0x18, 0x95, 0x9a, 0x2c, 0xd9, 0xb7, 0x55, 0xc9, 0x9d, 0xc6, 0xda, 0xec, 0x47, 0xd3, 0xed, 0x61,
+		0x69, 0x14, 0xec, 0xf4, 0x6d, 0x4f, 0x8c, 0x5e, 0x0d, 0x86, 0x01, 0x03, 0xda, 0xa5, 0x7b, 0x21,
+		0x94, 0x5e, 0x2b, 0x8e, 0x72, 0xf1, 0x5e, 0x68, 0xed, 0x3f, 0xe0, 0xbb, 0x7f, 0x8d, 0xff, 0x9e,
+		0x81, 0x52, 0x5b, 0x2d, 0x33, 0xbe, 0x7d, 0x9c, 0xef, 0x1c, 0xce, 0x39, 0xb9, 0xf9, 0xe0, 0x24,
+		0x1b, 0x11, 0x26, 0x07, 0xfe, 0x98, 0xc4, 0x01, 0x91, 0xfd, 0x24, 0x94, 0x67, 0x5d, 0xf9, 0x6b,
+		0x46, 0xd8, 0x42, 0x4a, 0x18, 0x4d, 0x29, 0x3a, 0xc8, 0x09, 0x52, 0x49, 0x90, 0xfc, 0x24, 0x94,
+		0x66, 0xdd, 0x66, 0xab, 0x4a, 0x15, 0xd0, 0xe9, 0x94, 0xc6, 0x4b, 0x59, 0xb3, 0x5d, 0xc5, 0x98,
+		0x53, 0x76, 0xff, 0x29, 0xa2, 0xf3, 0x25, 0xa7, 0x7d, 0x0f, 0xf5, 0xdb, 0x12, 0xb9, 0xc9, 0x1d,
+		0xd1, 0x11, 0x40, 0x61, 0xed, 0xa5, 0x8b, 0x84, 0x34, 0x84, 0x96, 0x70, 0xbe, 0x6b, 0xef, 0x16,
+		0x88, 0xbb, 0x48, 0x08, 0xba, 0x5c, 0xad, 0x7d, 0x36, 0xe1, 0x8d, 0x5a, 0x4b, 0x38, 0xdf, 0xbb,
+		0x38, 0x94, 0x2a, 0xf2, 0x49, 0x96, 0xbf, 0x88, 0xa8, 0x3f, 0x2e, 0xc5, 0x0a, 0x9b, 0xf0, 0xf6,
+		0x4f, 0x01, 0x0e, 0xfe, 0x70, 0xb3, 0x09, 0xcf, 0xa2, 0x14, 0x61, 0xd8, 0x63, 0xc5, 0xb4, 0x36,
+		0x7d, 0x72, 0x71, 0x56, 0xf9, 0xd7, 0x0d, 0x59, 0x9e, 0xc7, 0x06, 0xf6, 0x7b, 0x46, 0xef, 0x60,
+		0xc7, 0x8f, 0xf9, 0x9c, 0xb0, 0xff, 0xca, 0x55, 0x72, 0xd1, 0x29, 0xd4, 0x09, 0x63, 0x94, 0x79,
+		0x53, 0xc2, 0xb9, 0x3f, 0x21, 0x8d, 0xc7, 0x45, 0xe7, 0xfd, 0x02, 0xbc, 0x5e, 0x62, 0x6d, 0x02,
+		0xf5, 0xd2, 0xf9, 0x0b, 0x09, 0x52, 0x32, 0x46, 0x2e, 0xec, 0x07, 0x11, 0xe5, 0xc4, 0xe3, 0xa9,
+		0x9f, 0x66, 0xbc, 0xcc, 0xdc, 0xad, 0x74, 0x5c, 0x55, 0xc6, 0xdf, 0x48, 0x90, 0xa5, 0x21, 0x8d,
+		0xd5, 0x5c, 0xe9, 0x14, 0x42, 0x7b, 0x2f, 0x58, 0x7f, 0x74, 0x62, 0x78, 0xfa, 0x57, 0x41, 0x74,
+		0x04, 0x2f, 0x6f, 0xfa, 0xd8, 0x1e, 0x7a, 0x36, 0x76, 0xfa, 0xba, 0xeb, 0xb9, 0x43, 0x0b, 0x7b,
+		0x9a, 0x31, 0x50, 0x74, 0xad, 0x27, 0x3e, 0x42, 0xc7, 0xd0, 0xdc, 0x5e, 0x2b, 0x86, 0x73, 0x8b,
+		0x6d, 0xdc, 0x13, 0x05, 0x74, 0x08, 0x8d, 0xed, 0xfd, 0x7b, 0x45, 0xd3, 0x71, 0x4f, 0xac, 0x75,
+		0x7e, 0x08, 0xf0, 0x6c, 0xa3, 0x97, 0x4a, 0xe3, 0x71, 0x98, 0x07, 0x44, 0x6d, 0x38, 0x5e, 0xc9,
+		0x3e, 0x62, 0xd5, 0xf5, 0x54, 0xd3, 0xe8, 0x69, 0xae, 0x66, 0x1a, 0x1b, 0xd6, 0xa7, 0x70, 0xf2,
+		0x00, 0xc7, 0x30, 0x5d, 0xcf, 0xb4, 0xb0, 0x21, 0x0a, 0xe8, 0x0d, 0xbc, 0xfa, 0x07, 0x49, 0x35,
+		0xaf, 0x2d, 0x1d, 0xbb, 0xb8, 0xe7, 0xa9, 0x3a, 0x56, 0x0c, 0x7d, 0x28, 0xd6, 0x3a, 0xdf, 0x05,
+		0x78, 0x5e, 0x64, 0x52, 0x69, 0xcc, 0x43, 0x9e, 0x92, 0x38, 0x58, 0xe8, 0x64, 0x46, 0xa2, 0xb5,
+		0xa1, 0x6a, 0x1a, 0x8e, 0xe6, 0xb8, 0xd8, 0x50, 0x87, 0x9e, 0x8e, 0x07, 0x58, 0xdf, 0x48, 0x75,
+		0x06, 0xad, 0x87, 0x48, 0x78, 0x80, 0x0d, 0xb7, 0xaf, 0xe8, 0xa2, 0xb0, 0xee, 0xb7, 0xcd, 0x72,
+		0x5c, 0xdb, 0x34, 0x3e, 0x88, 0xb5, 0xab, 0x3b, 0x78, 0x11, 0xd0, 0x69, 0xd5, 0x8b, 0x5e, 0x41,
+		0x11, 0xd0, 0xca, 0x2f, 0xc8, 0x12, 0xee, 0xba, 0x93, 0x30, 0xfd, 0x9c, 0x8d, 0xa4, 0x80, 0x4e,
+		0xe5, 0xcd, 0x93, 0x7b, 0x1d, 0x8e, 0x23, 0x79, 0x42, 0xe5, 0xe2, 0xd2, 0xca, 0xfb, 0xbb, 0xf4,
+		0x93, 0x70, 0xd6, 0x1d, 0xed, 0x14, 0xd8, 0xdb, 0x5f, 0x03, 0x00, 0xbd, 0x69, 0x28, 0x5b, 0xfb,
+		0x03, 0x00, 0x00,
+	},
+}
+
+func init() {
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) WorkerAPIYARPCClient {
+			return NewWorkerAPIYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
+}
@@ -273,17 +273,19 @@ func (adh *adminHandlerImpl) DescribeWorkflowExecution(
 
 	historyAddr := historyHost.GetAddress()
 	resp2, err := adh.GetHistoryClient().DescribeMutableState(ctx, &types.DescribeMutableStateRequest{
-		DomainUUID: domainID,
-		Execution:  request.Execution,
+		DomainUUID:         domainID,
+		Execution:          request.Execution,
+		RebuildFromHistory: request.RebuildFromHistory,
 	})
 	if err != nil {
 		return &types.AdminDescribeWorkflowExecutionResponse{}, err
 	}
 	return &types.AdminDescribeWorkflowExecutionResponse{
-		ShardID:                shardIDForOutput,
-		HistoryAddr:            historyAddr,
-		MutableStateInDatabase: resp2.MutableStateInDatabase,
-		MutableStateInCache:    resp2.MutableStateInCache,
+		ShardID:                        shardIDForOutput,
+		HistoryAddr:                    historyAddr,
+		MutableStateInDatabase:         resp2.MutableStateInDatabase,
+		MutableStateInCache:            resp2.MutableStateInCache,
+		MutableStateRebuiltFromHistory: resp2.MutableStateRebuiltFromHistory,
 	}, err
 }
 
@@ -653,6 +655,79 @@ func (adh *adminHandlerImpl) DescribeQueue(
 	return adh.GetHistoryClient().DescribeQueue(ctx, request)
 }
 
+// ListThrottledWorkflowIDs returns the workflow IDs recently throttled by their rate limits
+// on the history host owning the shard
+func (adh *adminHandlerImpl) ListThrottledWorkflowIDs(
+	ctx context.Context,
+	request *types.ListThrottledWorkflowIDsRequest,
+) (resp *types.ListThrottledWorkflowIDsResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminListThrottledWorkflowIDsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+
+	resp, err := adh.GetHistoryClient().ListThrottledWorkflowIDs(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// GetDomainUsage returns the workflow usage of a domain per workflow type, as reported by history hosts
+func (adh *adminHandlerImpl) GetDomainUsage(
+	ctx context.Context,
+	request *types.GetDomainUsageRequest,
+) (resp *types.GetDomainUsageResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminGetDomainUsageScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if request.StartTimestamp == nil || request.EndTimestamp == nil || request.GetStartTimestamp() >= request.GetEndTimestamp() {
+		return nil, adh.error(&types.BadRequestError{Message: "Start time of the domain usage query must be before its end time."}, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	usage, err := adh.GetDomainUsageQueue().GetDomainUsage(
+		ctx,
+		domainID,
+		time.Unix(0, request.GetStartTimestamp()),
+		time.Unix(0, request.GetEndTimestamp()),
+	)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp = &types.GetDomainUsageResponse{
+		Usage: make([]*types.WorkflowUsage, 0, len(usage)),
+	}
+	for _, workflowUsage := range usage {
+		resp.Usage = append(resp.Usage, &types.WorkflowUsage{
+			WorkflowType:    workflowUsage.WorkflowType,
+			DecisionTasks:   workflowUsage.DecisionTasks,
+			ActivityTasks:   workflowUsage.ActivityTasks,
+			LocalActivities: workflowUsage.LocalActivities,
+			Signals:         workflowUsage.Signals,
+			Timers:          workflowUsage.Timers,
+			HistoryBytes:    workflowUsage.HistoryBytes,
+		})
+	}
+	return resp, nil
+}
+
 // DescribeShardDistribution returns information about history shard distribution
 func (adh *adminHandlerImpl) DescribeShardDistribution(
 	ctx context.Context,
@@ -1101,7 +1176,8 @@ func (adh *adminHandlerImpl) ReadDLQMessages(
 					ctx,
 					request.GetInclusiveEndMessageID(),
 					int(request.GetMaximumPageSize()),
-					request.GetNextPageToken())
+					request.GetNextPageToken(),
+					request.GetFilter())
 				return err
 			}
 		}
@@ -1233,6 +1309,7 @@ func (adh *adminHandlerImpl) MergeDLQMessages(
 					request.GetInclusiveEndMessageID(),
 					int(request.GetMaximumPageSize()),
 					request.GetNextPageToken(),
+					request.GetFilter(),
 				)
 				return err
 			}
@@ -1280,6 +1357,138 @@ func (adh *adminHandlerImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// PauseActivity stops dispatching a pending activity of a workflow execution until it is unpaused
+func (adh *adminHandlerImpl) PauseActivity(
+	ctx context.Context,
+	request *types.PauseActivityRequest,
+) (err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminPauseActivityScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if err := validate.CheckExecution(request.WorkflowExecution); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.GetActivityID() == "" {
+		return adh.error(validate.ErrActivityIDNotSet, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.GetHistoryClient().PauseActivity(ctx, &types.HistoryPauseActivityRequest{
+		DomainUUID:   domainEntry.GetInfo().ID,
+		PauseRequest: request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// UnpauseActivity resumes dispatching a paused activity of a workflow execution
+func (adh *adminHandlerImpl) UnpauseActivity(
+	ctx context.Context,
+	request *types.UnpauseActivityRequest,
+) (err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminUnpauseActivityScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if err := validate.CheckExecution(request.WorkflowExecution); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.GetActivityID() == "" {
+		return adh.error(validate.ErrActivityIDNotSet, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.GetHistoryClient().UnpauseActivity(ctx, &types.HistoryUnpauseActivityRequest{
+		DomainUUID:     domainEntry.GetInfo().ID,
+		UnpauseRequest: request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ResetActivity sets the attempt of a pending activity back to zero
+func (adh *adminHandlerImpl) ResetActivity(
+	ctx context.Context,
+	request *types.ResetActivityRequest,
+) (err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminResetActivityScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if err := validate.CheckExecution(request.WorkflowExecution); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.GetActivityID() == "" {
+		return adh.error(validate.ErrActivityIDNotSet, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.GetHistoryClient().ResetActivity(ctx, &types.HistoryResetActivityRequest{
+		DomainUUID:   domainEntry.GetInfo().ID,
+		ResetRequest: request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// UpdateActivityOptions changes the timeouts and retry policy of a pending activity
+func (adh *adminHandlerImpl) UpdateActivityOptions(
+	ctx context.Context,
+	request *types.UpdateActivityOptionsRequest,
+) (err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminUpdateActivityOptionsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if err := validate.CheckExecution(request.WorkflowExecution); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.GetActivityID() == "" {
+		return adh.error(validate.ErrActivityIDNotSet, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.GetHistoryClient().UpdateActivityOptions(ctx, &types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID:    domainEntry.GetInfo().ID,
+		UpdateRequest: request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
 // ResendReplicationTasks requests replication task from remote cluster
 func (adh *adminHandlerImpl) ResendReplicationTasks(
 	ctx context.Context,
@@ -1313,6 +1522,45 @@ func (adh *adminHandlerImpl) ResendReplicationTasks(
 	)
 }
 
+// ImportWorkflowExecution applies a batch of exported history events to the workflow execution through the
+// NDC history replicator, the same way events replicated from a remote cluster are applied
+func (adh *adminHandlerImpl) ImportWorkflowExecution(
+	ctx context.Context,
+	request *types.ImportWorkflowExecutionRequest,
+) (err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminImportWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if err := validate.CheckExecution(request.WorkflowExecution); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.WorkflowExecution.GetRunID() == "" {
+		return adh.error(validate.ErrInvalidRunID, scope)
+	}
+	if len(request.GetVersionHistoryItems()) == 0 || request.GetEvents() == nil {
+		return adh.error(&types.BadRequestError{Message: "Version history items and events are required to import a workflow."}, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.GetHistoryClient().ReplicateEventsV2(ctx, &types.ReplicateEventsV2Request{
+		DomainUUID:          domainID,
+		WorkflowExecution:   request.WorkflowExecution,
+		VersionHistoryItems: request.VersionHistoryItems,
+		Events:              request.Events,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
 func (adh *adminHandlerImpl) GetCrossClusterTasks(
 	ctx context.Context,
 	request *types.GetCrossClusterTasksRequest,
@@ -26,6 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
@@ -1143,3 +1144,87 @@ func Test_UpdateDomainAsyncWorkflowConfiguraton(t *testing.T) {
 		})
 	}
 }
+
+func (s *adminHandlerSuite) Test_GetDomainUsage() {
+	request := &types.GetDomainUsageRequest{
+		Domain:         s.domainName,
+		StartTimestamp: common.Int64Ptr(100),
+		EndTimestamp:   common.Int64Ptr(200),
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil)
+	s.mockResource.DomainUsageQueue.EXPECT().GetDomainUsage(gomock.Any(), s.domainID, time.Unix(0, 100), time.Unix(0, 200)).Return([]*domain.WorkflowUsage{
+		{DomainID: s.domainID, DomainName: s.domainName, WorkflowType: "test-workflow-type", DecisionTasks: 3, HistoryBytes: 1024},
+	}, nil)
+
+	resp, err := s.handler.GetDomainUsage(context.Background(), request)
+	s.NoError(err)
+	s.Equal(&types.GetDomainUsageResponse{
+		Usage: []*types.WorkflowUsage{
+			{WorkflowType: "test-workflow-type", DecisionTasks: 3, HistoryBytes: 1024},
+		},
+	}, resp)
+}
+
+func (s *adminHandlerSuite) Test_GetDomainUsage_InvalidRequest() {
+	_, err := s.handler.GetDomainUsage(context.Background(), nil)
+	s.Equal(validate.ErrRequestNotSet, err)
+
+	_, err = s.handler.GetDomainUsage(context.Background(), &types.GetDomainUsageRequest{})
+	s.Equal(validate.ErrDomainNotSet, err)
+
+	_, err = s.handler.GetDomainUsage(context.Background(), &types.GetDomainUsageRequest{
+		Domain:         s.domainName,
+		StartTimestamp: common.Int64Ptr(200),
+		EndTimestamp:   common.Int64Ptr(100),
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *adminHandlerSuite) Test_ListThrottledWorkflowIDs() {
+	request := &types.ListThrottledWorkflowIDsRequest{ShardID: 3}
+	expected := &types.ListThrottledWorkflowIDsResponse{
+		ThrottledWorkflowIDs: []*types.ThrottledWorkflowID{
+			{DomainName: s.domainName, WorkflowID: "workflowID", Limit: "signal", Count: 2},
+		},
+	}
+	s.mockHistoryClient.EXPECT().ListThrottledWorkflowIDs(gomock.Any(), request).Return(expected, nil).Times(1)
+
+	resp, err := s.handler.ListThrottledWorkflowIDs(context.Background(), request)
+	s.NoError(err)
+	s.Equal(expected, resp)
+
+	_, err = s.handler.ListThrottledWorkflowIDs(context.Background(), nil)
+	s.Equal(validate.ErrRequestNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_ImportWorkflowExecution() {
+	request := &types.ImportWorkflowExecutionRequest{
+		Domain:              s.domainName,
+		WorkflowExecution:   &types.WorkflowExecution{WorkflowID: "workflowID", RunID: uuid.New()},
+		VersionHistoryItems: []*types.VersionHistoryItem{{EventID: 10, Version: 1}},
+		Events:              &types.DataBlob{EncodingType: types.EncodingTypeThriftRW.Ptr(), Data: []byte("events")},
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockHistoryClient.EXPECT().ReplicateEventsV2(gomock.Any(), &types.ReplicateEventsV2Request{
+		DomainUUID:          s.domainID,
+		WorkflowExecution:   request.WorkflowExecution,
+		VersionHistoryItems: request.VersionHistoryItems,
+		Events:              request.Events,
+	}).Return(nil).Times(1)
+
+	s.NoError(s.handler.ImportWorkflowExecution(context.Background(), request))
+}
+
+func (s *adminHandlerSuite) Test_ImportWorkflowExecution_InvalidRequest() {
+	err := s.handler.ImportWorkflowExecution(context.Background(), &types.ImportWorkflowExecutionRequest{
+		Domain:            s.domainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "workflowID"},
+	})
+	s.Equal(validate.ErrInvalidRunID, err)
+
+	err = s.handler.ImportWorkflowExecution(context.Background(), &types.ImportWorkflowExecutionRequest{
+		Domain:            s.domainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "workflowID", RunID: uuid.New()},
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
@@ -42,6 +42,8 @@ type Handler interface {
 	DescribeShardDistribution(context.Context, *types.DescribeShardDistributionRequest) (*types.DescribeShardDistributionResponse, error)
 	DescribeHistoryHost(context.Context, *types.DescribeHistoryHostRequest) (*types.DescribeHistoryHostResponse, error)
 	DescribeQueue(context.Context, *types.DescribeQueueRequest) (*types.DescribeQueueResponse, error)
+	ListThrottledWorkflowIDs(context.Context, *types.ListThrottledWorkflowIDsRequest) (*types.ListThrottledWorkflowIDsResponse, error)
+	GetDomainUsage(context.Context, *types.GetDomainUsageRequest) (*types.GetDomainUsageResponse, error)
 	DescribeWorkflowExecution(context.Context, *types.AdminDescribeWorkflowExecutionRequest) (*types.AdminDescribeWorkflowExecutionResponse, error)
 	GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest) (*types.GetDLQReplicationMessagesResponse, error)
 	GetDomainReplicationMessages(context.Context, *types.GetDomainReplicationMessagesRequest) (*types.GetDomainReplicationMessagesResponse, error)
@@ -53,8 +55,13 @@ type Handler interface {
 	ReadDLQMessages(context.Context, *types.ReadDLQMessagesRequest) (*types.ReadDLQMessagesResponse, error)
 	ReapplyEvents(context.Context, *types.ReapplyEventsRequest) error
 	RefreshWorkflowTasks(context.Context, *types.RefreshWorkflowTasksRequest) error
+	PauseActivity(context.Context, *types.PauseActivityRequest) error
+	UnpauseActivity(context.Context, *types.UnpauseActivityRequest) error
+	ResetActivity(context.Context, *types.ResetActivityRequest) error
+	UpdateActivityOptions(context.Context, *types.UpdateActivityOptionsRequest) error
 	RemoveTask(context.Context, *types.RemoveTaskRequest) error
 	ResendReplicationTasks(context.Context, *types.ResendReplicationTasksRequest) error
+	ImportWorkflowExecution(context.Context, *types.ImportWorkflowExecutionRequest) error
 	ResetQueue(context.Context, *types.ResetQueueRequest) error
 	GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error)
 	RespondCrossClusterTasksCompleted(context.Context, *types.RespondCrossClusterTasksCompletedRequest) (*types.RespondCrossClusterTasksCompletedResponse, error)
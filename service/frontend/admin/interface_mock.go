@@ -266,6 +266,21 @@ func (mr *MockHandlerMockRecorder) GetDomainReplicationMessages(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainReplicationMessages", reflect.TypeOf((*MockHandler)(nil).GetDomainReplicationMessages), arg0, arg1)
 }
 
+// GetDomainUsage mocks base method.
+func (m *MockHandler) GetDomainUsage(arg0 context.Context, arg1 *types.GetDomainUsageRequest) (*types.GetDomainUsageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainUsage", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetDomainUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainUsage indicates an expected call of GetDomainUsage.
+func (mr *MockHandlerMockRecorder) GetDomainUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainUsage", reflect.TypeOf((*MockHandler)(nil).GetDomainUsage), arg0, arg1)
+}
+
 // GetDynamicConfig mocks base method.
 func (m *MockHandler) GetDynamicConfig(arg0 context.Context, arg1 *types.GetDynamicConfigRequest) (*types.GetDynamicConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -326,6 +341,20 @@ func (mr *MockHandlerMockRecorder) GetWorkflowExecutionRawHistoryV2(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockHandler)(nil).GetWorkflowExecutionRawHistoryV2), arg0, arg1)
 }
 
+// ImportWorkflowExecution mocks base method.
+func (m *MockHandler) ImportWorkflowExecution(arg0 context.Context, arg1 *types.ImportWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportWorkflowExecution indicates an expected call of ImportWorkflowExecution.
+func (mr *MockHandlerMockRecorder) ImportWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).ImportWorkflowExecution), arg0, arg1)
+}
+
 // ListDynamicConfig mocks base method.
 func (m *MockHandler) ListDynamicConfig(arg0 context.Context, arg1 *types.ListDynamicConfigRequest) (*types.ListDynamicConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -341,6 +370,21 @@ func (mr *MockHandlerMockRecorder) ListDynamicConfig(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockHandler)(nil).ListDynamicConfig), arg0, arg1)
 }
 
+// ListThrottledWorkflowIDs mocks base method.
+func (m *MockHandler) ListThrottledWorkflowIDs(arg0 context.Context, arg1 *types.ListThrottledWorkflowIDsRequest) (*types.ListThrottledWorkflowIDsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListThrottledWorkflowIDs", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListThrottledWorkflowIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListThrottledWorkflowIDs indicates an expected call of ListThrottledWorkflowIDs.
+func (mr *MockHandlerMockRecorder) ListThrottledWorkflowIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListThrottledWorkflowIDs", reflect.TypeOf((*MockHandler)(nil).ListThrottledWorkflowIDs), arg0, arg1)
+}
+
 // MaintainCorruptWorkflow mocks base method.
 func (m *MockHandler) MaintainCorruptWorkflow(arg0 context.Context, arg1 *types.AdminMaintainWorkflowRequest) (*types.AdminMaintainWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -371,6 +415,20 @@ func (mr *MockHandlerMockRecorder) MergeDLQMessages(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHandler)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// PauseActivity mocks base method.
+func (m *MockHandler) PauseActivity(arg0 context.Context, arg1 *types.PauseActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockHandlerMockRecorder) PauseActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockHandler)(nil).PauseActivity), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockHandler) PurgeDLQMessages(arg0 context.Context, arg1 *types.PurgeDLQMessagesRequest) error {
 	m.ctrl.T.Helper()
@@ -456,6 +514,20 @@ func (mr *MockHandlerMockRecorder) ResendReplicationTasks(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockHandler)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// ResetActivity mocks base method.
+func (m *MockHandler) ResetActivity(arg0 context.Context, arg1 *types.ResetActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivity indicates an expected call of ResetActivity.
+func (mr *MockHandlerMockRecorder) ResetActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivity", reflect.TypeOf((*MockHandler)(nil).ResetActivity), arg0, arg1)
+}
+
 // ResetQueue mocks base method.
 func (m *MockHandler) ResetQueue(arg0 context.Context, arg1 *types.ResetQueueRequest) error {
 	m.ctrl.T.Helper()
@@ -523,6 +595,34 @@ func (mr *MockHandlerMockRecorder) Stop() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockHandler)(nil).Stop))
 }
 
+// UnpauseActivity mocks base method.
+func (m *MockHandler) UnpauseActivity(arg0 context.Context, arg1 *types.UnpauseActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockHandlerMockRecorder) UnpauseActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockHandler)(nil).UnpauseActivity), arg0, arg1)
+}
+
+// UpdateActivityOptions mocks base method.
+func (m *MockHandler) UpdateActivityOptions(arg0 context.Context, arg1 *types.UpdateActivityOptionsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockHandlerMockRecorder) UpdateActivityOptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockHandler)(nil).UpdateActivityOptions), arg0, arg1)
+}
+
 // UpdateDomainAsyncWorkflowConfiguraton mocks base method.
 func (m *MockHandler) UpdateDomainAsyncWorkflowConfiguraton(arg0 context.Context, arg1 *types.UpdateDomainAsyncWorkflowConfiguratonRequest) (*types.UpdateDomainAsyncWorkflowConfiguratonResponse, error) {
 	m.ctrl.T.Helper()
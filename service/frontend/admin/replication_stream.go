@@ -0,0 +1,192 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package admin
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/config"
+)
+
+type (
+	// ReplicationMessagesServerStream is the server side of a replication message stream
+	ReplicationMessagesServerStream interface {
+		Context() context.Context
+		Recv() (*types.StreamReplicationMessagesRequest, error)
+		Send(*types.StreamReplicationMessagesResponse) error
+	}
+
+	// ReplicationStreamHandler serves replication message streams to remote clusters
+	ReplicationStreamHandler struct {
+		handler    Handler
+		config     *config.Config
+		timeSource clock.TimeSource
+		logger     log.Logger
+	}
+
+	replicationStream struct {
+		*ReplicationStreamHandler
+
+		ctx      context.Context
+		stream   ReplicationMessagesServerStream
+		sendLock sync.Mutex
+	}
+)
+
+// NewReplicationStreamHandler creates a replication stream handler on top of the given admin handler,
+// streamed shards are polled through handler.GetReplicationMessages so they get the same authorization and metrics
+func NewReplicationStreamHandler(
+	handler Handler,
+	config *config.Config,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *ReplicationStreamHandler {
+	return &ReplicationStreamHandler{
+		handler:    handler,
+		config:     config,
+		timeSource: timeSource,
+		logger:     logger,
+	}
+}
+
+// StreamReplicationMessages polls the shards of every token batch received from the remote cluster and pushes
+// each shard back as soon as it has new tasks, or with an empty batch once the heartbeat interval passed.
+// A shard is not polled again until the remote cluster sends its next token.
+func (h *ReplicationStreamHandler) StreamReplicationMessages(stream ReplicationMessagesServerStream) error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	s := &replicationStream{
+		ReplicationStreamHandler: h,
+		ctx:                      ctx,
+		stream:                   stream,
+	}
+
+	errCh := make(chan error, 1)
+	reportErr := func(err error) {
+		select {
+		case errCh <- err:
+		default:
+		}
+	}
+	requestCh := make(chan *types.StreamReplicationMessagesRequest)
+	go func() {
+		for {
+			request, err := stream.Recv()
+			if err != nil {
+				reportErr(err)
+				return
+			}
+			select {
+			case requestCh <- request:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case request := <-requestCh:
+			if len(request.GetTokens()) == 0 {
+				continue
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := s.pollShards(request.GetClusterName(), request.GetTokens()); err != nil {
+					reportErr(err)
+				}
+			}()
+		case err := <-errCh:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (s *replicationStream) pollShards(clusterName string, tokens []*types.ReplicationToken) error {
+	startTime := s.timeSource.Now()
+	for len(tokens) > 0 {
+		response, err := s.handler.GetReplicationMessages(s.ctx, &types.GetReplicationMessagesRequest{
+			Tokens:      tokens,
+			ClusterName: clusterName,
+		})
+		if err != nil {
+			if s.ctx.Err() != nil {
+				return nil
+			}
+			if !common.IsServiceTransientError(err) {
+				return err
+			}
+			s.logger.Warn("Failed to poll replication messages for stream.", tag.ClusterName(clusterName), tag.Error(err))
+		}
+
+		heartbeat := s.timeSource.Since(startTime) >= s.config.ReplicationStreamHeartbeatInterval()
+		streamed := &types.StreamReplicationMessagesResponse{MessagesByShard: make(map[int32]*types.ReplicationMessages)}
+		var remaining []*types.ReplicationToken
+		hasTasks := false
+		for _, token := range tokens {
+			messages, ok := response.GetMessagesByShard()[token.GetShardID()]
+			if !ok || (len(messages.GetReplicationTasks()) == 0 && !heartbeat) {
+				remaining = append(remaining, token)
+				continue
+			}
+			streamed.MessagesByShard[token.GetShardID()] = messages
+			hasTasks = hasTasks || len(messages.GetReplicationTasks()) > 0
+		}
+		if len(streamed.MessagesByShard) > 0 {
+			if err := s.send(streamed); err != nil {
+				return err
+			}
+		}
+
+		tokens = remaining
+		if len(tokens) > 0 && !hasTasks {
+			select {
+			case <-s.timeSource.After(s.config.ReplicationStreamIdleWait()):
+			case <-s.ctx.Done():
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+func (s *replicationStream) send(response *types.StreamReplicationMessagesResponse) error {
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+
+	return s.stream.Send(response)
+}
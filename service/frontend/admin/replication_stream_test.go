@@ -0,0 +1,169 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package admin
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/types"
+	frontendcfg "github.com/uber/cadence/service/frontend/config"
+)
+
+type fakeReplicationMessagesServerStream struct {
+	ctx        context.Context
+	requestCh  chan *types.StreamReplicationMessagesRequest
+	responseCh chan *types.StreamReplicationMessagesResponse
+}
+
+func newFakeReplicationMessagesServerStream() *fakeReplicationMessagesServerStream {
+	return &fakeReplicationMessagesServerStream{
+		ctx:        context.Background(),
+		requestCh:  make(chan *types.StreamReplicationMessagesRequest, 10),
+		responseCh: make(chan *types.StreamReplicationMessagesResponse, 10),
+	}
+}
+
+func (s *fakeReplicationMessagesServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeReplicationMessagesServerStream) Recv() (*types.StreamReplicationMessagesRequest, error) {
+	request, ok := <-s.requestCh
+	if !ok {
+		return nil, io.EOF
+	}
+	return request, nil
+}
+
+func (s *fakeReplicationMessagesServerStream) Send(response *types.StreamReplicationMessagesResponse) error {
+	s.responseCh <- response
+	return nil
+}
+
+func newTestReplicationStreamHandler(t *testing.T, handler Handler, heartbeatInterval time.Duration) *ReplicationStreamHandler {
+	config := &frontendcfg.Config{
+		ReplicationStreamIdleWait:          dynamicconfig.GetDurationPropertyFn(time.Millisecond),
+		ReplicationStreamHeartbeatInterval: dynamicconfig.GetDurationPropertyFn(heartbeatInterval),
+	}
+	return NewReplicationStreamHandler(handler, config, clock.NewRealTimeSource(), testlogger.New(t))
+}
+
+func TestReplicationStream_PushesShardsWithTasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := NewMockHandler(ctrl)
+	stream := newFakeReplicationMessagesServerStream()
+	token1 := &types.ReplicationToken{ShardID: 1, LastRetrievedMessageID: 10}
+	token2 := &types.ReplicationToken{ShardID: 2, LastRetrievedMessageID: 20}
+	shard1 := &types.ReplicationMessages{
+		ReplicationTasks:       []*types.ReplicationTask{{SourceTaskID: 11}},
+		LastRetrievedMessageID: 11,
+	}
+	shard2 := &types.ReplicationMessages{LastRetrievedMessageID: 20}
+
+	handler.EXPECT().GetReplicationMessages(gomock.Any(), &types.GetReplicationMessagesRequest{
+		Tokens:      []*types.ReplicationToken{token1, token2},
+		ClusterName: "standby",
+	}).Return(&types.GetReplicationMessagesResponse{
+		MessagesByShard: map[int32]*types.ReplicationMessages{1: shard1, 2: shard2},
+	}, nil)
+	// shard 2 has no task and its heartbeat is not due, it keeps being polled alone
+	handler.EXPECT().GetReplicationMessages(gomock.Any(), &types.GetReplicationMessagesRequest{
+		Tokens:      []*types.ReplicationToken{token2},
+		ClusterName: "standby",
+	}).Return(&types.GetReplicationMessagesResponse{
+		MessagesByShard: map[int32]*types.ReplicationMessages{2: shard2},
+	}, nil).AnyTimes()
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- newTestReplicationStreamHandler(t, handler, time.Hour).StreamReplicationMessages(stream)
+	}()
+	stream.requestCh <- &types.StreamReplicationMessagesRequest{
+		Tokens:      []*types.ReplicationToken{token1, token2},
+		ClusterName: "standby",
+	}
+
+	assert.Equal(t, &types.StreamReplicationMessagesResponse{
+		MessagesByShard: map[int32]*types.ReplicationMessages{1: shard1},
+	}, <-stream.responseCh)
+
+	close(stream.requestCh)
+	assert.NoError(t, <-errCh)
+	assert.Empty(t, stream.responseCh)
+}
+
+func TestReplicationStream_Heartbeat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := NewMockHandler(ctrl)
+	stream := newFakeReplicationMessagesServerStream()
+	token := &types.ReplicationToken{ShardID: 1, LastRetrievedMessageID: 10}
+	messages := &types.ReplicationMessages{
+		LastRetrievedMessageID: 10,
+		SyncShardStatus:        &types.SyncShardStatus{Timestamp: common.Int64Ptr(123)},
+	}
+
+	handler.EXPECT().GetReplicationMessages(gomock.Any(), gomock.Any()).Return(&types.GetReplicationMessagesResponse{
+		MessagesByShard: map[int32]*types.ReplicationMessages{1: messages},
+	}, nil)
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- newTestReplicationStreamHandler(t, handler, 0).StreamReplicationMessages(stream)
+	}()
+	stream.requestCh <- &types.StreamReplicationMessagesRequest{
+		Tokens:      []*types.ReplicationToken{token},
+		ClusterName: "standby",
+	}
+
+	assert.Equal(t, &types.StreamReplicationMessagesResponse{
+		MessagesByShard: map[int32]*types.ReplicationMessages{1: messages},
+	}, <-stream.responseCh)
+
+	close(stream.requestCh)
+	assert.NoError(t, <-errCh)
+}
+
+func TestReplicationStream_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := NewMockHandler(ctrl)
+	stream := newFakeReplicationMessagesServerStream()
+	token := &types.ReplicationToken{ShardID: 1, LastRetrievedMessageID: 10}
+
+	handler.EXPECT().GetReplicationMessages(gomock.Any(), gomock.Any()).Return(nil, &types.ServiceBusyError{})
+	handler.EXPECT().GetReplicationMessages(gomock.Any(), gomock.Any()).Return(nil, &types.AccessDeniedError{})
+
+	stream.requestCh <- &types.StreamReplicationMessagesRequest{
+		Tokens:      []*types.ReplicationToken{token},
+		ClusterName: "standby",
+	}
+	err := newTestReplicationStreamHandler(t, handler, time.Hour).StreamReplicationMessages(stream)
+	assert.Equal(t, &types.AccessDeniedError{}, err)
+}
@@ -102,6 +102,12 @@ type (
 	HealthStatus int32
 )
 
+const (
+	// executeWorkflowExecutionTailroom is the share of the caller's remaining time kept
+	// to return the running workflow when ExecuteWorkflowExecution stops waiting
+	executeWorkflowExecutionTailroom = 0.05
+)
+
 var (
 	frontendServiceRetryPolicy = common.CreateFrontendServiceRetryPolicy()
 )
@@ -1826,6 +1832,132 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	return resp, nil
 }
 
+// ExecuteWorkflowExecution starts a workflow execution, or attaches to the running one with the same
+// workflow ID and type when AttachToRunning is set, and waits for it to close. Continue-as-new runs are
+// followed. If the caller's deadline is about to pass first, the response carries no close status.
+func (wh *WorkflowHandler) ExecuteWorkflowExecution(
+	ctx context.Context,
+	executeRequest *types.ExecuteWorkflowExecutionRequest,
+) (resp *types.ExecuteWorkflowExecutionResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if executeRequest == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	scope := getMetricsScopeWithDomain(metrics.FrontendExecuteWorkflowExecutionScope, executeRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+
+	startRequest := executeRequest.GetStartRequest()
+	resp = &types.ExecuteWorkflowExecutionResponse{}
+	startResp, startErr := wh.StartWorkflowExecution(ctx, startRequest)
+	if startErr != nil {
+		alreadyStartedErr, ok := startErr.(*types.WorkflowExecutionAlreadyStartedError)
+		if !ok || !executeRequest.GetAttachToRunning() {
+			return nil, startErr
+		}
+		resp.RunID = alreadyStartedErr.RunID
+		resp.Attached = true
+	} else {
+		resp.RunID = startResp.GetRunID()
+	}
+
+	domainName := startRequest.GetDomain()
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+	execution := &types.WorkflowExecution{
+		WorkflowID: startRequest.GetWorkflowID(),
+		RunID:      resp.RunID,
+	}
+
+	if resp.Attached {
+		msResp, err := wh.GetHistoryClient().GetMutableState(ctx, &types.GetMutableStateRequest{
+			DomainUUID: domainID,
+			Execution:  execution,
+		})
+		if err != nil {
+			return nil, err
+		}
+		// only attach to a run of the same workflow type, otherwise the caller gets the start error as before
+		if msResp.WorkflowType.GetName() != startRequest.WorkflowType.GetName() {
+			return nil, startErr
+		}
+	}
+
+	// leave some room to return the run ID to the caller if the workflow is still running at its deadline
+	waitCtx, cancel := common.CreateChildContext(ctx, executeWorkflowExecutionTailroom)
+	defer cancel()
+
+	var branchToken []byte
+	for {
+		pollResp, err := wh.GetHistoryClient().PollMutableState(waitCtx, &types.PollMutableStateRequest{
+			DomainUUID:          domainID,
+			Execution:           execution,
+			ExpectedNextEventID: common.EndEventID,
+			CurrentBranchToken:  branchToken,
+		})
+		if err != nil {
+			if branchChangedErr, ok := err.(*types.CurrentBranchChangedError); ok {
+				branchToken = branchChangedErr.CurrentBranchToken
+				continue
+			}
+			if ctx.Err() == nil && (waitCtx.Err() != nil || common.IsContextTimeoutError(err)) {
+				return resp, nil
+			}
+			return nil, err
+		}
+		branchToken = pollResp.CurrentBranchToken
+		closeState := int(pollResp.GetWorkflowCloseState())
+		if closeState == persistence.WorkflowCloseStatusNone {
+			continue
+		}
+
+		history, _, err := wh.getHistory(
+			ctx,
+			scope,
+			domainID,
+			domainName,
+			*execution,
+			pollResp.GetLastFirstEventID(),
+			pollResp.GetNextEventID(),
+			int32(wh.config.HistoryMaxPageSize(domainName)),
+			nil,
+			nil,
+			branchToken,
+		)
+		if err != nil {
+			return nil, err
+		}
+		// since getHistory func will not return empty history, so the below is safe
+		closeEvent := history.Events[len(history.Events)-1]
+
+		if closeState == persistence.WorkflowCloseStatusContinuedAsNew {
+			execution = &types.WorkflowExecution{
+				WorkflowID: execution.GetWorkflowID(),
+				RunID:      closeEvent.WorkflowExecutionContinuedAsNewEventAttributes.GetNewExecutionRunID(),
+			}
+			branchToken = nil
+			continue
+		}
+
+		resp.CloseStatus = persistence.ToInternalWorkflowExecutionCloseStatus(closeState)
+		switch closeEvent.GetEventType() {
+		case types.EventTypeWorkflowExecutionCompleted:
+			resp.Result = closeEvent.WorkflowExecutionCompletedEventAttributes.Result
+		case types.EventTypeWorkflowExecutionFailed:
+			resp.FailureReason = closeEvent.WorkflowExecutionFailedEventAttributes.Reason
+			resp.FailureDetails = closeEvent.WorkflowExecutionFailedEventAttributes.Details
+		case types.EventTypeWorkflowExecutionCanceled:
+			resp.FailureDetails = closeEvent.WorkflowExecutionCanceledEventAttributes.Details
+		case types.EventTypeWorkflowExecutionTerminated:
+			resp.FailureReason = common.StringPtr(closeEvent.WorkflowExecutionTerminatedEventAttributes.Reason)
+			resp.FailureDetails = closeEvent.WorkflowExecutionTerminatedEventAttributes.Details
+		}
+		return resp, nil
+	}
+}
+
 func (wh *WorkflowHandler) validateStartWorkflowExecutionRequest(ctx context.Context, startRequest *types.StartWorkflowExecutionRequest, scope metrics.Scope) error {
 	if startRequest == nil {
 		return validate.ErrRequestNotSet
@@ -2607,6 +2739,272 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 	return nil
 }
 
+// PauseWorkflowExecution pauses an existing workflow execution by recording WorkflowExecutionPaused event in the history.
+// Decision, activity, timer and child workflow tasks of
+// the execution are held back until it is unpaused.
+func (wh *WorkflowHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *types.PauseWorkflowExecutionRequest,
+) (retError error) {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return err
+	}
+
+	if pauseRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	domainName := pauseRequest.GetDomain()
+	wfExecution := pauseRequest.GetWorkflowExecution()
+	if domainName == "" {
+		return validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(wfExecution); err != nil {
+		return err
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return err
+	}
+
+	err = wh.GetHistoryClient().PauseWorkflowExecution(ctx, &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID:   domainID,
+		PauseRequest: pauseRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+
+	return nil
+}
+
+// UnpauseWorkflowExecution unpauses an existing workflow execution by recording WorkflowExecutionUnpaused event in the history.
+// The tasks held back while the execution
+// was paused are regenerated.
+func (wh *WorkflowHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	unpauseRequest *types.UnpauseWorkflowExecutionRequest,
+) (retError error) {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return err
+	}
+
+	if unpauseRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	domainName := unpauseRequest.GetDomain()
+	wfExecution := unpauseRequest.GetWorkflowExecution()
+	if domainName == "" {
+		return validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(wfExecution); err != nil {
+		return err
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return err
+	}
+
+	err = wh.GetHistoryClient().UnpauseWorkflowExecution(ctx, &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID:     domainID,
+		UnpauseRequest: unpauseRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+
+	return nil
+}
+
+// PauseActivity stops dispatching a pending activity of a workflow execution, including its retries, until it is
+// unpaused. An attempt that is already running is not interrupted.
+func (wh *WorkflowHandler) PauseActivity(
+	ctx context.Context,
+	pauseRequest *types.PauseActivityRequest,
+) (retError error) {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return err
+	}
+
+	if pauseRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	domainName := pauseRequest.GetDomain()
+	if domainName == "" {
+		return validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(pauseRequest.GetWorkflowExecution()); err != nil {
+		return err
+	}
+	if pauseRequest.GetActivityID() == "" {
+		return validate.ErrActivityIDNotSet
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return err
+	}
+
+	err = wh.GetHistoryClient().PauseActivity(ctx, &types.HistoryPauseActivityRequest{
+		DomainUUID:   domainID,
+		PauseRequest: pauseRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+
+	return nil
+}
+
+// UnpauseActivity resumes dispatching a paused activity of a workflow execution.
+func (wh *WorkflowHandler) UnpauseActivity(
+	ctx context.Context,
+	unpauseRequest *types.UnpauseActivityRequest,
+) (retError error) {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return err
+	}
+
+	if unpauseRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	domainName := unpauseRequest.GetDomain()
+	if domainName == "" {
+		return validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(unpauseRequest.GetWorkflowExecution()); err != nil {
+		return err
+	}
+	if unpauseRequest.GetActivityID() == "" {
+		return validate.ErrActivityIDNotSet
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return err
+	}
+
+	err = wh.GetHistoryClient().UnpauseActivity(ctx, &types.HistoryUnpauseActivityRequest{
+		DomainUUID:     domainID,
+		UnpauseRequest: unpauseRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+
+	return nil
+}
+
+// ResetActivity sets the attempt of a pending activity back to zero so that its retry policy starts over, an activity
+// waiting for its next retry is scheduled right away.
+func (wh *WorkflowHandler) ResetActivity(
+	ctx context.Context,
+	resetRequest *types.ResetActivityRequest,
+) (retError error) {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return err
+	}
+
+	if resetRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	domainName := resetRequest.GetDomain()
+	if domainName == "" {
+		return validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(resetRequest.GetWorkflowExecution()); err != nil {
+		return err
+	}
+	if resetRequest.GetActivityID() == "" {
+		return validate.ErrActivityIDNotSet
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return err
+	}
+
+	err = wh.GetHistoryClient().ResetActivity(ctx, &types.HistoryResetActivityRequest{
+		DomainUUID:   domainID,
+		ResetRequest: resetRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+
+	return nil
+}
+
+// UpdateActivityOptions changes the timeouts and retry policy of a pending activity in place.
+func (wh *WorkflowHandler) UpdateActivityOptions(
+	ctx context.Context,
+	updateRequest *types.UpdateActivityOptionsRequest,
+) (retError error) {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return err
+	}
+
+	if updateRequest == nil {
+		return validate.ErrRequestNotSet
+	}
+
+	domainName := updateRequest.GetDomain()
+	if domainName == "" {
+		return validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(updateRequest.GetWorkflowExecution()); err != nil {
+		return err
+	}
+	if updateRequest.GetActivityID() == "" {
+		return validate.ErrActivityIDNotSet
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return err
+	}
+
+	err = wh.GetHistoryClient().UpdateActivityOptions(ctx, &types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID:    domainID,
+		UpdateRequest: updateRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution to the nextFirstEventID
 // in the history and immediately terminating the current execution instance.
 func (wh *WorkflowHandler) ResetWorkflowExecution(
@@ -3813,6 +4211,7 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 		Queries:                   matchingResp.Queries,
 		NextEventID:               matchingResp.NextEventID,
 		TotalHistoryBytes:         matchingResp.TotalHistoryBytes,
+		ContinueAsNewSuggested:    matchingResp.ContinueAsNewSuggested,
 	}
 
 	return resp, nil
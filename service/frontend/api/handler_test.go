@@ -33,6 +33,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
@@ -53,6 +54,7 @@ import (
 	"github.com/uber/cadence/common/types"
 	frontendcfg "github.com/uber/cadence/service/frontend/config"
 	"github.com/uber/cadence/service/frontend/validate"
+	"github.com/uber/cadence/service/worker/scheduler"
 )
 
 const (
@@ -588,6 +590,158 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_IsolationGroupDrained(
 	s.IsType(err, &types.BadRequestError{})
 }
 
+func (s *workflowHandlerSuite) newExecuteWorkflowExecutionRequest(attachToRunning bool) *types.ExecuteWorkflowExecutionRequest {
+	return &types.ExecuteWorkflowExecutionRequest{
+		StartRequest: &types.StartWorkflowExecutionRequest{
+			Domain:     s.testDomain,
+			WorkflowID: testWorkflowID,
+			WorkflowType: &types.WorkflowType{
+				Name: "workflow-type",
+			},
+			TaskList: &types.TaskList{
+				Name: "task-list",
+			},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(10),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			RequestID:                           uuid.New(),
+		},
+		AttachToRunning: attachToRunning,
+	}
+}
+
+func (s *workflowHandlerSuite) TestExecuteWorkflowExecution_Completed() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.StartWorkflowExecutionResponse{RunID: testRunID}, nil)
+	s.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.PollMutableStateRequest, _ ...yarpc.CallOption) (*types.PollMutableStateResponse, error) {
+			s.Equal(testRunID, request.Execution.GetRunID())
+			s.Equal(common.EndEventID, request.ExpectedNextEventID)
+			return &types.PollMutableStateResponse{
+				LastFirstEventID:   5,
+				NextEventID:        6,
+				WorkflowCloseState: common.Int32Ptr(persistence.WorkflowCloseStatusCompleted),
+			}, nil
+		})
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{{
+			ID:        5,
+			EventType: types.EventTypeWorkflowExecutionCompleted.Ptr(),
+			WorkflowExecutionCompletedEventAttributes: &types.WorkflowExecutionCompletedEventAttributes{
+				Result: []byte("result"),
+			},
+		}},
+	}, nil).Once()
+
+	resp, err := wh.ExecuteWorkflowExecution(context.Background(), s.newExecuteWorkflowExecutionRequest(false))
+	s.NoError(err)
+	s.Equal(&types.ExecuteWorkflowExecutionResponse{
+		RunID:       testRunID,
+		CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+		Result:      []byte("result"),
+	}, resp)
+}
+
+func (s *workflowHandlerSuite) TestExecuteWorkflowExecution_AttachFollowsContinueAsNew() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	newRunID := uuid.New()
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.WorkflowExecutionAlreadyStartedError{RunID: testRunID})
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(&types.GetMutableStateResponse{
+		WorkflowType: &types.WorkflowType{Name: "workflow-type"},
+	}, nil)
+	gomock.InOrder(
+		s.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), gomock.Any()).Return(&types.PollMutableStateResponse{
+			LastFirstEventID:   5,
+			NextEventID:        6,
+			WorkflowCloseState: common.Int32Ptr(persistence.WorkflowCloseStatusContinuedAsNew),
+		}, nil),
+		s.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *types.PollMutableStateRequest, _ ...yarpc.CallOption) (*types.PollMutableStateResponse, error) {
+				s.Equal(newRunID, request.Execution.GetRunID())
+				return &types.PollMutableStateResponse{
+					LastFirstEventID:   2,
+					NextEventID:        3,
+					WorkflowCloseState: common.Int32Ptr(persistence.WorkflowCloseStatusFailed),
+				}, nil
+			}),
+	)
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{{
+			ID:        5,
+			EventType: types.EventTypeWorkflowExecutionContinuedAsNew.Ptr(),
+			WorkflowExecutionContinuedAsNewEventAttributes: &types.WorkflowExecutionContinuedAsNewEventAttributes{
+				NewExecutionRunID: newRunID,
+			},
+		}},
+	}, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{{
+			ID:        2,
+			EventType: types.EventTypeWorkflowExecutionFailed.Ptr(),
+			WorkflowExecutionFailedEventAttributes: &types.WorkflowExecutionFailedEventAttributes{
+				Reason:  common.StringPtr("reason"),
+				Details: []byte("details"),
+			},
+		}},
+	}, nil).Once()
+
+	resp, err := wh.ExecuteWorkflowExecution(context.Background(), s.newExecuteWorkflowExecutionRequest(true))
+	s.NoError(err)
+	s.Equal(&types.ExecuteWorkflowExecutionResponse{
+		RunID:          testRunID,
+		Attached:       true,
+		CloseStatus:    types.WorkflowExecutionCloseStatusFailed.Ptr(),
+		FailureReason:  common.StringPtr("reason"),
+		FailureDetails: []byte("details"),
+	}, resp)
+}
+
+func (s *workflowHandlerSuite) TestExecuteWorkflowExecution_AttachTypeMismatch() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	alreadyStartedErr := &types.WorkflowExecutionAlreadyStartedError{RunID: testRunID}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, alreadyStartedErr)
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(&types.GetMutableStateResponse{
+		WorkflowType: &types.WorkflowType{Name: "other-workflow-type"},
+	}, nil)
+
+	resp, err := wh.ExecuteWorkflowExecution(context.Background(), s.newExecuteWorkflowExecutionRequest(true))
+	s.Equal(alreadyStartedErr, err)
+	s.Nil(resp)
+}
+
+func (s *workflowHandlerSuite) TestExecuteWorkflowExecution_AlreadyStartedWithoutAttach() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	alreadyStartedErr := &types.WorkflowExecutionAlreadyStartedError{RunID: testRunID}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, alreadyStartedErr)
+
+	resp, err := wh.ExecuteWorkflowExecution(context.Background(), s.newExecuteWorkflowExecutionRequest(false))
+	s.Equal(alreadyStartedErr, err)
+	s.Nil(resp)
+}
+
+func (s *workflowHandlerSuite) TestExecuteWorkflowExecution_StillRunningAtDeadline() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.StartWorkflowExecutionResponse{RunID: testRunID}, nil)
+	s.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), gomock.Any()).Return(nil, context.DeadlineExceeded)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	resp, err := wh.ExecuteWorkflowExecution(ctx, s.newExecuteWorkflowExecutionRequest(false))
+	s.NoError(err)
+	s.Equal(&types.ExecuteWorkflowExecutionResponse{RunID: testRunID}, resp)
+}
+
+func (s *workflowHandlerSuite) TestExecuteWorkflowExecution_RequestNotSet() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	resp, err := wh.ExecuteWorkflowExecution(context.Background(), nil)
+	s.Equal(validate.ErrRequestNotSet, err)
+	s.Nil(resp)
+}
+
 func (s *workflowHandlerSuite) TestRecordActivityTaskHeartbeat_Success() {
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 	taskToken := common.TaskToken{
@@ -1673,6 +1827,73 @@ func (s *workflowHandlerSuite) TestListWorkflowExecutions() {
 	s.NotNil(err)
 }
 
+func (s *workflowHandlerSuite) TestCreateSchedule_Failed() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	wh := s.getWorkflowHandler(config)
+	request := &types.CreateScheduleRequest{
+		Domain:     s.testDomain,
+		ScheduleID: "sid",
+		Spec:       &types.ScheduleSpec{CronExpression: "0 * * * *"},
+		Action: &types.ScheduleStartWorkflowAction{
+			WorkflowType: &types.WorkflowType{Name: "wt"},
+			TaskList:     &types.TaskList{Name: "tl"},
+		},
+	}
+
+	err := wh.CreateSchedule(context.Background(), request)
+	s.Equal(validate.ErrScheduleDisallowedForDomain, err)
+
+	config.EnableScheduler = dc.GetBoolPropertyFnFilteredByDomain(true)
+	err = wh.CreateSchedule(context.Background(), nil)
+	s.Equal(validate.ErrRequestNotSet, err)
+
+	request.ScheduleID = ""
+	err = wh.CreateSchedule(context.Background(), request)
+	s.Equal(validate.ErrScheduleIDNotSet, err)
+
+	request.ScheduleID = "sid"
+	request.Spec = &types.ScheduleSpec{}
+	err = wh.CreateSchedule(context.Background(), request)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *workflowHandlerSuite) TestBackfillSchedule_InvalidRange() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.EnableScheduler = dc.GetBoolPropertyFnFilteredByDomain(true)
+	wh := s.getWorkflowHandler(config)
+
+	err := wh.BackfillSchedule(context.Background(), &types.BackfillScheduleRequest{
+		Domain:         s.testDomain,
+		ScheduleID:     "sid",
+		StartTimestamp: common.Int64Ptr(2),
+		EndTimestamp:   common.Int64Ptr(1),
+	})
+	s.Equal(validate.ErrInvalidBackfillRange, err)
+}
+
+func (s *workflowHandlerSuite) TestListSchedules() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.EnableScheduler = dc.GetBoolPropertyFnFilteredByDomain(true)
+	wh := s.getWorkflowHandler(config)
+
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+	s.mockVisibilityMgr.On("ListOpenWorkflowExecutionsByType", mock.Anything, mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsByTypeRequest) bool {
+		return request.DomainUUID == s.testDomainID && request.WorkflowTypeName == scheduler.WorkflowTypeName
+	})).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{Execution: &types.WorkflowExecution{WorkflowID: scheduler.GetWorkflowID("sid"), RunID: "rid"}},
+		},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+
+	resp, err := wh.ListSchedules(context.Background(), &types.ListSchedulesRequest{Domain: s.testDomain})
+	s.NoError(err)
+	s.Equal([]byte("token"), resp.NextPageToken)
+	s.Len(resp.Schedules, 1)
+	s.Equal("sid", resp.Schedules[0].ScheduleID)
+	s.Equal("rid", resp.Schedules[0].WorkflowExecution.RunID)
+}
+
 func (s *workflowHandlerSuite) TestScantWorkflowExecutions() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	wh := s.getWorkflowHandler(config)
@@ -38,11 +38,16 @@ type (
 	// Handler is interface wrapping frontend handler
 	Handler interface {
 		Health(context.Context) (*types.HealthStatus, error)
+		BackfillSchedule(context.Context, *types.BackfillScheduleRequest) error
 		CountWorkflowExecutions(context.Context, *types.CountWorkflowExecutionsRequest) (*types.CountWorkflowExecutionsResponse, error)
+		CreateSchedule(context.Context, *types.CreateScheduleRequest) error
+		DeleteSchedule(context.Context, *types.DeleteScheduleRequest) error
 		DeprecateDomain(context.Context, *types.DeprecateDomainRequest) error
 		DescribeDomain(context.Context, *types.DescribeDomainRequest) (*types.DescribeDomainResponse, error)
+		DescribeSchedule(context.Context, *types.DescribeScheduleRequest) (*types.DescribeScheduleResponse, error)
 		DescribeTaskList(context.Context, *types.DescribeTaskListRequest) (*types.DescribeTaskListResponse, error)
 		DescribeWorkflowExecution(context.Context, *types.DescribeWorkflowExecutionRequest) (*types.DescribeWorkflowExecutionResponse, error)
+		ExecuteWorkflowExecution(context.Context, *types.ExecuteWorkflowExecutionRequest) (*types.ExecuteWorkflowExecutionResponse, error)
 		GetClusterInfo(context.Context) (*types.ClusterInfo, error)
 		GetSearchAttributes(context.Context) (*types.GetSearchAttributesResponse, error)
 		GetWorkflowExecutionHistory(context.Context, *types.GetWorkflowExecutionHistoryRequest) (*types.GetWorkflowExecutionHistoryResponse, error)
@@ -50,10 +55,14 @@ type (
 		ListClosedWorkflowExecutions(context.Context, *types.ListClosedWorkflowExecutionsRequest) (*types.ListClosedWorkflowExecutionsResponse, error)
 		ListDomains(context.Context, *types.ListDomainsRequest) (*types.ListDomainsResponse, error)
 		ListOpenWorkflowExecutions(context.Context, *types.ListOpenWorkflowExecutionsRequest) (*types.ListOpenWorkflowExecutionsResponse, error)
+		ListSchedules(context.Context, *types.ListSchedulesRequest) (*types.ListSchedulesResponse, error)
 		ListTaskListPartitions(context.Context, *types.ListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error)
 		GetTaskListsByDomain(context.Context, *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
 		RefreshWorkflowTasks(context.Context, *types.RefreshWorkflowTasksRequest) error
 		ListWorkflowExecutions(context.Context, *types.ListWorkflowExecutionsRequest) (*types.ListWorkflowExecutionsResponse, error)
+		PauseActivity(context.Context, *types.PauseActivityRequest) error
+		PauseSchedule(context.Context, *types.PauseScheduleRequest) error
+		PauseWorkflowExecution(context.Context, *types.PauseWorkflowExecutionRequest) error
 		PollForActivityTask(context.Context, *types.PollForActivityTaskRequest) (*types.PollForActivityTaskResponse, error)
 		PollForDecisionTask(context.Context, *types.PollForDecisionTaskRequest) (*types.PollForDecisionTaskResponse, error)
 		QueryWorkflow(context.Context, *types.QueryWorkflowRequest) (*types.QueryWorkflowResponse, error)
@@ -61,6 +70,7 @@ type (
 		RecordActivityTaskHeartbeatByID(context.Context, *types.RecordActivityTaskHeartbeatByIDRequest) (*types.RecordActivityTaskHeartbeatResponse, error)
 		RegisterDomain(context.Context, *types.RegisterDomainRequest) error
 		RequestCancelWorkflowExecution(context.Context, *types.RequestCancelWorkflowExecutionRequest) error
+		ResetActivity(context.Context, *types.ResetActivityRequest) error
 		ResetStickyTaskList(context.Context, *types.ResetStickyTaskListRequest) (*types.ResetStickyTaskListResponse, error)
 		ResetWorkflowExecution(context.Context, *types.ResetWorkflowExecutionRequest) (*types.ResetWorkflowExecutionResponse, error)
 		RespondActivityTaskCanceled(context.Context, *types.RespondActivityTaskCanceledRequest) error
@@ -80,6 +90,12 @@ type (
 		StartWorkflowExecution(context.Context, *types.StartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 		StartWorkflowExecutionAsync(context.Context, *types.StartWorkflowExecutionAsyncRequest) (*types.StartWorkflowExecutionAsyncResponse, error)
 		TerminateWorkflowExecution(context.Context, *types.TerminateWorkflowExecutionRequest) error
+		TriggerSchedule(context.Context, *types.TriggerScheduleRequest) error
+		UnpauseActivity(context.Context, *types.UnpauseActivityRequest) error
+		UnpauseSchedule(context.Context, *types.UnpauseScheduleRequest) error
+		UnpauseWorkflowExecution(context.Context, *types.UnpauseWorkflowExecutionRequest) error
+		UpdateActivityOptions(context.Context, *types.UpdateActivityOptionsRequest) error
 		UpdateDomain(context.Context, *types.UpdateDomainRequest) (*types.UpdateDomainResponse, error)
+		UpdateSchedule(context.Context, *types.UpdateScheduleRequest) error
 	}
 )
@@ -0,0 +1,328 @@
+// Copyright (c) 2017-2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+	"github.com/uber/cadence/service/worker/scheduler"
+)
+
+// CreateSchedule creates a schedule by starting its scheduler workflow in the domain of the schedule
+func (wh *WorkflowHandler) CreateSchedule(ctx context.Context, request *types.CreateScheduleRequest) error {
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if err := wh.validateScheduleRequest(ctx, request.GetDomain(), request.GetScheduleID()); err != nil {
+		return err
+	}
+
+	params := &scheduler.WorkflowParams{
+		ScheduleID: request.GetScheduleID(),
+		Spec:       scheduler.ToScheduleSpec(request.GetSpec()),
+		Action:     scheduler.ToStartWorkflowAction(request.GetAction()),
+		Policies:   scheduler.ToSchedulePolicies(request.GetPolicies()),
+	}
+	if err := scheduler.ValidateParams(params); err != nil {
+		return &types.BadRequestError{Message: err.Error()}
+	}
+	input, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	requestID := request.GetRequestID()
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+	_, err = wh.StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{
+		Domain:                              request.GetDomain(),
+		WorkflowID:                          scheduler.GetWorkflowID(request.GetScheduleID()),
+		WorkflowType:                        &types.WorkflowType{Name: scheduler.WorkflowTypeName},
+		TaskList:                            &types.TaskList{Name: scheduler.TaskListName},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(scheduler.WorkflowStartToCloseTimeoutInSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(scheduler.DecisionTimeoutInSeconds),
+		Identity:                            request.GetIdentity(),
+		RequestID:                           requestID,
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+	})
+	return err
+}
+
+// DescribeSchedule returns the spec, action, policies and state of a schedule
+func (wh *WorkflowHandler) DescribeSchedule(ctx context.Context, request *types.DescribeScheduleRequest) (*types.DescribeScheduleResponse, error) {
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	if err := wh.validateScheduleRequest(ctx, request.GetDomain(), request.GetScheduleID()); err != nil {
+		return nil, err
+	}
+
+	result, err := wh.describeSchedule(ctx, request.GetDomain(), request.GetScheduleID())
+	if err != nil {
+		return nil, err
+	}
+	return scheduler.FromDescribeResult(result), nil
+}
+
+// UpdateSchedule replaces the spec, action or policies of a schedule, the ones not set on the request are left unchanged
+func (wh *WorkflowHandler) UpdateSchedule(ctx context.Context, request *types.UpdateScheduleRequest) error {
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if err := wh.validateScheduleRequest(ctx, request.GetDomain(), request.GetScheduleID()); err != nil {
+		return err
+	}
+	if request.Spec == nil && request.Action == nil && request.Policies == nil {
+		return &types.BadRequestError{Message: "Nothing to update, one of Spec, Action or Policies must be set."}
+	}
+
+	// validate the updated schedule here, the scheduler workflow ignores invalid updates
+	current, err := wh.describeSchedule(ctx, request.GetDomain(), request.GetScheduleID())
+	if err != nil {
+		return err
+	}
+	updated := &scheduler.WorkflowParams{
+		ScheduleID: request.GetScheduleID(),
+		Spec:       current.Spec,
+		Action:     current.Action,
+		Policies:   current.Policies,
+	}
+	signal := &scheduler.UpdateRequest{}
+	if request.Spec != nil {
+		updated.Spec = scheduler.ToScheduleSpec(request.Spec)
+		signal.Spec = &updated.Spec
+	}
+	if request.Action != nil {
+		updated.Action = scheduler.ToStartWorkflowAction(request.Action)
+		signal.Action = &updated.Action
+	}
+	if request.Policies != nil {
+		updated.Policies = scheduler.ToSchedulePolicies(request.Policies)
+		signal.Policies = &updated.Policies
+	}
+	if err := scheduler.ValidateParams(updated); err != nil {
+		return &types.BadRequestError{Message: err.Error()}
+	}
+	return wh.signalSchedule(ctx, request.GetDomain(), request.GetScheduleID(), scheduler.UpdateSignal, signal, request.GetIdentity())
+}
+
+// PauseSchedule stops a schedule from starting runs until it is unpaused
+func (wh *WorkflowHandler) PauseSchedule(ctx context.Context, request *types.PauseScheduleRequest) error {
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if err := wh.validateScheduleRequest(ctx, request.GetDomain(), request.GetScheduleID()); err != nil {
+		return err
+	}
+	signal := &scheduler.PauseRequest{Reason: request.GetReason()}
+	return wh.signalSchedule(ctx, request.GetDomain(), request.GetScheduleID(), scheduler.PauseSignal, signal, request.GetIdentity())
+}
+
+// UnpauseSchedule resumes a paused schedule, the runs missed while paused are not started
+func (wh *WorkflowHandler) UnpauseSchedule(ctx context.Context, request *types.UnpauseScheduleRequest) error {
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if err := wh.validateScheduleRequest(ctx, request.GetDomain(), request.GetScheduleID()); err != nil {
+		return err
+	}
+	signal := &scheduler.PauseRequest{Reason: request.GetReason()}
+	return wh.signalSchedule(ctx, request.GetDomain(), request.GetScheduleID(), scheduler.UnpauseSignal, signal, request.GetIdentity())
+}
+
+// TriggerSchedule starts a run of a schedule immediately
+func (wh *WorkflowHandler) TriggerSchedule(ctx context.Context, request *types.TriggerScheduleRequest) error {
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if err := wh.validateScheduleRequest(ctx, request.GetDomain(), request.GetScheduleID()); err != nil {
+		return err
+	}
+	signal := &scheduler.TriggerRequest{}
+	if request.OverlapPolicy != nil {
+		signal.OverlapPolicy = scheduler.ToOverlapPolicy(request.OverlapPolicy).Ptr()
+	}
+	return wh.signalSchedule(ctx, request.GetDomain(), request.GetScheduleID(), scheduler.TriggerSignal, signal, request.GetIdentity())
+}
+
+// BackfillSchedule starts the runs a schedule would have started in a time range
+func (wh *WorkflowHandler) BackfillSchedule(ctx context.Context, request *types.BackfillScheduleRequest) error {
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if err := wh.validateScheduleRequest(ctx, request.GetDomain(), request.GetScheduleID()); err != nil {
+		return err
+	}
+	if request.GetEndTimestamp() <= request.GetStartTimestamp() {
+		return validate.ErrInvalidBackfillRange
+	}
+	signal := &scheduler.BackfillRequest{
+		StartTime: time.Unix(0, request.GetStartTimestamp()).UTC(),
+		EndTime:   time.Unix(0, request.GetEndTimestamp()).UTC(),
+	}
+	if request.OverlapPolicy != nil {
+		signal.OverlapPolicy = scheduler.ToOverlapPolicy(request.OverlapPolicy).Ptr()
+	}
+	return wh.signalSchedule(ctx, request.GetDomain(), request.GetScheduleID(), scheduler.BackfillSignal, signal, request.GetIdentity())
+}
+
+// DeleteSchedule deletes a schedule, the runs it has started are left running
+func (wh *WorkflowHandler) DeleteSchedule(ctx context.Context, request *types.DeleteScheduleRequest) error {
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if err := wh.validateScheduleRequest(ctx, request.GetDomain(), request.GetScheduleID()); err != nil {
+		return err
+	}
+	return wh.signalSchedule(ctx, request.GetDomain(), request.GetScheduleID(), scheduler.DeleteSignal, nil, request.GetIdentity())
+}
+
+// ListSchedules lists the schedules of a domain from the visibility records of their scheduler workflows
+func (wh *WorkflowHandler) ListSchedules(ctx context.Context, request *types.ListSchedulesRequest) (*types.ListSchedulesResponse, error) {
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	if err := wh.validateScheduleDomain(ctx, request.GetDomain()); err != nil {
+		return nil, err
+	}
+
+	domainName := request.GetDomain()
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := request.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = int32(wh.config.VisibilityMaxPageSize(domainName))
+	}
+	if wh.isListRequestPageSizeTooLarge(pageSize, domainName) {
+		return nil, &types.BadRequestError{
+			Message: fmt.Sprintf("Pagesize is larger than allowed %d", wh.config.ESIndexMaxResultWindow())}
+	}
+
+	// scheduler workflows are open for as long as their schedule exists
+	response, err := wh.GetVisibilityManager().ListOpenWorkflowExecutionsByType(ctx, &persistence.ListWorkflowExecutionsByTypeRequest{
+		ListWorkflowExecutionsRequest: persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:    domainID,
+			Domain:        domainName,
+			PageSize:      int(pageSize),
+			NextPageToken: request.GetNextPageToken(),
+			EarliestTime:  0,
+			LatestTime:    time.Now().UnixNano(),
+		},
+		WorkflowTypeName: scheduler.WorkflowTypeName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &types.ListSchedulesResponse{NextPageToken: response.NextPageToken}
+	for _, execution := range response.Executions {
+		result.Schedules = append(result.Schedules, &types.ScheduleListEntry{
+			ScheduleID:        scheduler.GetScheduleID(execution.GetExecution().GetWorkflowID()),
+			WorkflowExecution: execution.GetExecution(),
+		})
+	}
+	return result, nil
+}
+
+// validateScheduleDomain checks that the service is serving and schedules are enabled for the domain
+func (wh *WorkflowHandler) validateScheduleDomain(ctx context.Context, domainName string) error {
+	if wh.isShuttingDown() {
+		return validate.ErrShuttingDown
+	}
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return err
+	}
+	if domainName == "" {
+		return validate.ErrDomainNotSet
+	}
+	if !wh.config.EnableScheduler(domainName) {
+		return validate.ErrScheduleDisallowedForDomain
+	}
+	return nil
+}
+
+func (wh *WorkflowHandler) validateScheduleRequest(ctx context.Context, domainName string, scheduleID string) error {
+	if err := wh.validateScheduleDomain(ctx, domainName); err != nil {
+		return err
+	}
+	if scheduleID == "" {
+		return validate.ErrScheduleIDNotSet
+	}
+	return nil
+}
+
+func (wh *WorkflowHandler) describeSchedule(ctx context.Context, domainName string, scheduleID string) (*scheduler.DescribeResult, error) {
+	response, err := wh.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+		Domain:    domainName,
+		Execution: &types.WorkflowExecution{WorkflowID: scheduler.GetWorkflowID(scheduleID)},
+		Query:     &types.WorkflowQuery{QueryType: scheduler.QueryTypeDescribe},
+		// a closed scheduler workflow is a deleted schedule
+		QueryRejectCondition: types.QueryRejectConditionNotOpen.Ptr(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response.GetQueryRejected() != nil {
+		return nil, &types.EntityNotExistsError{Message: "Schedule " + scheduleID + " does not exist."}
+	}
+	var result scheduler.DescribeResult
+	if err := json.Unmarshal(response.GetQueryResult(), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (wh *WorkflowHandler) signalSchedule(
+	ctx context.Context,
+	domainName string,
+	scheduleID string,
+	signalName string,
+	payload interface{},
+	identity string,
+) error {
+	var input []byte
+	if payload != nil {
+		var err error
+		if input, err = json.Marshal(payload); err != nil {
+			return err
+		}
+	}
+	return wh.SignalWorkflowExecution(ctx, &types.SignalWorkflowExecutionRequest{
+		Domain:            domainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: scheduler.GetWorkflowID(scheduleID)},
+		SignalName:        signalName,
+		Input:             input,
+		Identity:          identity,
+		RequestID:         uuid.New().String(),
+	})
+}
@@ -60,6 +60,7 @@ type Config struct {
 	EnableClientVersionCheck          dynamicconfig.BoolPropertyFn
 	EnableQueryAttributeValidation    dynamicconfig.BoolPropertyFn
 	DisallowQuery                     dynamicconfig.BoolPropertyFnWithDomainFilter
+	EnableScheduler                   dynamicconfig.BoolPropertyFnWithDomainFilter
 	ShutdownDrainDuration             dynamicconfig.DurationPropertyFn
 	Lockdown                          dynamicconfig.BoolPropertyFnWithDomainFilter
 
@@ -96,6 +97,10 @@ type Config struct {
 	DomainFailoverRefreshInterval               dynamicconfig.DurationPropertyFn
 	DomainFailoverRefreshTimerJitterCoefficient dynamicconfig.FloatPropertyFn
 
+	// Replication stream
+	ReplicationStreamIdleWait          dynamicconfig.DurationPropertyFn
+	ReplicationStreamHeartbeatInterval dynamicconfig.DurationPropertyFn
+
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes             dynamicconfig.MapPropertyFn
 	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter
@@ -169,6 +174,8 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		EnableGracefulFailover:                      dc.GetBoolProperty(dynamicconfig.EnableGracefulFailover),
 		DomainFailoverRefreshInterval:               dc.GetDurationProperty(dynamicconfig.DomainFailoverRefreshInterval),
 		DomainFailoverRefreshTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.DomainFailoverRefreshTimerJitterCoefficient),
+		ReplicationStreamIdleWait:                   dc.GetDurationProperty(dynamicconfig.FrontendReplicationStreamIdleWait),
+		ReplicationStreamHeartbeatInterval:          dc.GetDurationProperty(dynamicconfig.FrontendReplicationStreamHeartbeatInterval),
 		EnableClientVersionCheck:                    dc.GetBoolProperty(dynamicconfig.EnableClientVersionCheck),
 		EnableQueryAttributeValidation:              dc.GetBoolProperty(dynamicconfig.EnableQueryAttributeValidation),
 		ValidSearchAttributes:                       dc.GetMapProperty(dynamicconfig.ValidSearchAttributes),
@@ -177,6 +184,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		SearchAttributesTotalSizeLimit:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesTotalSizeLimit),
 		VisibilityArchivalQueryMaxPageSize:          dc.GetIntProperty(dynamicconfig.VisibilityArchivalQueryMaxPageSize),
 		DisallowQuery:                               dc.GetBoolPropertyFilteredByDomain(dynamicconfig.DisallowQuery),
+		EnableScheduler:                             dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableScheduler),
 		SendRawWorkflowHistory:                      dc.GetBoolPropertyFilteredByDomain(dynamicconfig.SendRawWorkflowHistory),
 		DecisionResultCountLimit:                    dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendDecisionResultCountLimit),
 		EmitSignalNameMetricsTag:                    dc.GetBoolPropertyFilteredByDomain(dynamicconfig.FrontendEmitSignalNameMetricsTag),
@@ -196,6 +196,10 @@ func (s *Service) Start() {
 	adminGRPCHandler := grpc.NewAdminHandler(s.adminHandler)
 	adminGRPCHandler.Register(s.GetDispatcher())
 
+	replicationStreamHandler := admin.NewReplicationStreamHandler(s.adminHandler, s.config, s.GetTimeSource(), s.GetLogger())
+	adminReplicationStreamGRPCHandler := grpc.NewAdminReplicationStreamHandler(replicationStreamHandler)
+	adminReplicationStreamGRPCHandler.Register(s.GetDispatcher())
+
 	// must start resource first
 	s.Resource.Start()
 	s.handler.Start()
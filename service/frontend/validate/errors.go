@@ -58,6 +58,9 @@ var (
 	ErrEmptyQueueType                             = &types.BadRequestError{Message: "Queue type is not set."}
 	ErrDomainInLockdown                           = &types.BadRequestError{Message: "Domain is not accepting fail overs at this time due to lockdown."}
 	ErrShuttingDown                               = &types.InternalServiceError{Message: "Shutting down"}
+	ErrScheduleIDNotSet                           = &types.BadRequestError{Message: "ScheduleID is not set on request."}
+	ErrScheduleDisallowedForDomain                = &types.BadRequestError{Message: "Schedules are not enabled for domain."}
+	ErrInvalidBackfillRange                       = &types.BadRequestError{Message: "Backfill end time must be after its start time."}
 
 	// Err for archival
 	ErrHistoryNotFound = &types.BadRequestError{Message: "Requested workflow history not found, may have passed retention period."}
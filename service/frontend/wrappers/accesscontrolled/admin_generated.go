@@ -283,6 +283,22 @@ func (a *adminHandler) GetDomainReplicationMessages(ctx context.Context, gp1 *ty
 	return a.handler.GetDomainReplicationMessages(ctx, gp1)
 }
 
+func (a *adminHandler) GetDomainUsage(ctx context.Context, gp1 *types.GetDomainUsageRequest) (gp2 *types.GetDomainUsageResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "GetDomainUsage",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: gp1,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.GetDomainUsage(ctx, gp1)
+}
+
 func (a *adminHandler) GetDynamicConfig(ctx context.Context, gp1 *types.GetDynamicConfigRequest) (gp2 *types.GetDynamicConfigResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "GetDynamicConfig",
@@ -347,6 +363,22 @@ func (a *adminHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, gp1
 	return a.handler.GetWorkflowExecutionRawHistoryV2(ctx, gp1)
 }
 
+func (a *adminHandler) ImportWorkflowExecution(ctx context.Context, ip1 *types.ImportWorkflowExecutionRequest) (err error) {
+	attr := &authorization.Attributes{
+		APIName:     "ImportWorkflowExecution",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: ip1,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.ImportWorkflowExecution(ctx, ip1)
+}
+
 func (a *adminHandler) ListDynamicConfig(ctx context.Context, lp1 *types.ListDynamicConfigRequest) (lp2 *types.ListDynamicConfigResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "ListDynamicConfig",
@@ -363,6 +395,22 @@ func (a *adminHandler) ListDynamicConfig(ctx context.Context, lp1 *types.ListDyn
 	return a.handler.ListDynamicConfig(ctx, lp1)
 }
 
+func (a *adminHandler) ListThrottledWorkflowIDs(ctx context.Context, lp1 *types.ListThrottledWorkflowIDsRequest) (lp2 *types.ListThrottledWorkflowIDsResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "ListThrottledWorkflowIDs",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: lp1,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ListThrottledWorkflowIDs(ctx, lp1)
+}
+
 func (a *adminHandler) MaintainCorruptWorkflow(ctx context.Context, ap1 *types.AdminMaintainWorkflowRequest) (ap2 *types.AdminMaintainWorkflowResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "MaintainCorruptWorkflow",
@@ -395,6 +443,22 @@ func (a *adminHandler) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQ
 	return a.handler.MergeDLQMessages(ctx, mp1)
 }
 
+func (a *adminHandler) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest) (err error) {
+	attr := &authorization.Attributes{
+		APIName:     "PauseActivity",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: pp1,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.PauseActivity(ctx, pp1)
+}
+
 func (a *adminHandler) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest) (err error) {
 	attr := &authorization.Attributes{
 		APIName:     "PurgeDLQMessages",
@@ -491,6 +555,22 @@ func (a *adminHandler) ResendReplicationTasks(ctx context.Context, rp1 *types.Re
 	return a.handler.ResendReplicationTasks(ctx, rp1)
 }
 
+func (a *adminHandler) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest) (err error) {
+	attr := &authorization.Attributes{
+		APIName:     "ResetActivity",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: rp1,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.ResetActivity(ctx, rp1)
+}
+
 func (a *adminHandler) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest) (err error) {
 	attr := &authorization.Attributes{
 		APIName:     "ResetQueue",
@@ -547,6 +627,38 @@ func (a *adminHandler) Stop() {
 	a.handler.Stop()
 }
 
+func (a *adminHandler) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest) (err error) {
+	attr := &authorization.Attributes{
+		APIName:     "UnpauseActivity",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: up1,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.UnpauseActivity(ctx, up1)
+}
+
+func (a *adminHandler) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest) (err error) {
+	attr := &authorization.Attributes{
+		APIName:     "UpdateActivityOptions",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: up1,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.UpdateActivityOptions(ctx, up1)
+}
+
 func (a *adminHandler) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, up1 *types.UpdateDomainAsyncWorkflowConfiguratonRequest) (up2 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "UpdateDomainAsyncWorkflowConfiguraton",
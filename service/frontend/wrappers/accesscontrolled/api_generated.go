@@ -61,6 +61,24 @@ func NewAPIHandler(handler api.Handler, resource resource.Resource, authorizer a
 	}
 }
 
+func (a *apiHandler) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendBackfillScheduleScope, bp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "BackfillSchedule",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: bp1,
+		DomainName:  bp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.BackfillSchedule(ctx, bp1)
+}
+
 func (a *apiHandler) CountWorkflowExecutions(ctx context.Context, cp1 *types.CountWorkflowExecutionsRequest) (cp2 *types.CountWorkflowExecutionsResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendCountWorkflowExecutionsScope, cp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -79,6 +97,43 @@ func (a *apiHandler) CountWorkflowExecutions(ctx context.Context, cp1 *types.Cou
 	return a.handler.CountWorkflowExecutions(ctx, cp1)
 }
 
+func (a *apiHandler) CreateSchedule(ctx context.Context, cp1 *types.CreateScheduleRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendCreateScheduleScope, cp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:      "CreateSchedule",
+		Permission:   authorization.PermissionWrite,
+		RequestBody:  cp1,
+		DomainName:   cp1.GetDomain(),
+		WorkflowType: cp1.GetAction().GetWorkflowType(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.CreateSchedule(ctx, cp1)
+}
+
+func (a *apiHandler) DeleteSchedule(ctx context.Context, dp1 *types.DeleteScheduleRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDeleteScheduleScope, dp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "DeleteSchedule",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: dp1,
+		DomainName:  dp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.DeleteSchedule(ctx, dp1)
+}
+
 func (a *apiHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest) (err error) {
 	scope := a.GetMetricsClient().Scope(metrics.FrontendDeprecateDomainScope)
 	attr := &authorization.Attributes{
@@ -114,6 +169,24 @@ func (a *apiHandler) DescribeDomain(ctx context.Context, dp1 *types.DescribeDoma
 	return a.handler.DescribeDomain(ctx, dp1)
 }
 
+func (a *apiHandler) DescribeSchedule(ctx context.Context, dp1 *types.DescribeScheduleRequest) (dp2 *types.DescribeScheduleResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDescribeScheduleScope, dp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "DescribeSchedule",
+		Permission:  authorization.PermissionRead,
+		RequestBody: dp1,
+		DomainName:  dp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.DescribeSchedule(ctx, dp1)
+}
+
 func (a *apiHandler) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest) (dp2 *types.DescribeTaskListResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendDescribeTaskListScope, dp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -150,6 +223,25 @@ func (a *apiHandler) DescribeWorkflowExecution(ctx context.Context, dp1 *types.D
 	return a.handler.DescribeWorkflowExecution(ctx, dp1)
 }
 
+func (a *apiHandler) ExecuteWorkflowExecution(ctx context.Context, ep1 *types.ExecuteWorkflowExecutionRequest) (ep2 *types.ExecuteWorkflowExecutionResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendExecuteWorkflowExecutionScope, ep1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:      "ExecuteWorkflowExecution",
+		Permission:   authorization.PermissionWrite,
+		RequestBody:  ep1,
+		DomainName:   ep1.GetDomain(),
+		WorkflowType: ep1.GetWorkflowType(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ExecuteWorkflowExecution(ctx, ep1)
+}
+
 func (a *apiHandler) GetClusterInfo(ctx context.Context) (cp1 *types.ClusterInfo, err error) {
 	return a.handler.GetClusterInfo(ctx)
 }
@@ -269,6 +361,24 @@ func (a *apiHandler) ListOpenWorkflowExecutions(ctx context.Context, lp1 *types.
 	return a.handler.ListOpenWorkflowExecutions(ctx, lp1)
 }
 
+func (a *apiHandler) ListSchedules(ctx context.Context, lp1 *types.ListSchedulesRequest) (lp2 *types.ListSchedulesResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendListSchedulesScope, lp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "ListSchedules",
+		Permission:  authorization.PermissionRead,
+		RequestBody: lp1,
+		DomainName:  lp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ListSchedules(ctx, lp1)
+}
+
 func (a *apiHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendListTaskListPartitionsScope, lp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -305,6 +415,60 @@ func (a *apiHandler) ListWorkflowExecutions(ctx context.Context, lp1 *types.List
 	return a.handler.ListWorkflowExecutions(ctx, lp1)
 }
 
+func (a *apiHandler) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseActivityScope, pp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "PauseActivity",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: pp1,
+		DomainName:  pp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.PauseActivity(ctx, pp1)
+}
+
+func (a *apiHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseScheduleScope, pp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "PauseSchedule",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: pp1,
+		DomainName:  pp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.PauseSchedule(ctx, pp1)
+}
+
+func (a *apiHandler) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendPauseWorkflowExecutionScope, pp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "PauseWorkflowExecution",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: pp1,
+		DomainName:  pp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.PauseWorkflowExecution(ctx, pp1)
+}
+
 func (a *apiHandler) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest) (pp2 *types.PollForActivityTaskResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendPollForActivityTaskScope, pp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -422,6 +586,24 @@ func (a *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *ty
 	return a.handler.RequestCancelWorkflowExecution(ctx, rp1)
 }
 
+func (a *apiHandler) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendResetActivityScope, rp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "ResetActivity",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: rp1,
+		DomainName:  rp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.ResetActivity(ctx, rp1)
+}
+
 func (a *apiHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendResetStickyTaskListScope, rp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -640,6 +822,96 @@ func (a *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.
 	return a.handler.TerminateWorkflowExecution(ctx, tp1)
 }
 
+func (a *apiHandler) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendTriggerScheduleScope, tp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "TriggerSchedule",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: tp1,
+		DomainName:  tp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.TriggerSchedule(ctx, tp1)
+}
+
+func (a *apiHandler) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUnpauseActivityScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "UnpauseActivity",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: up1,
+		DomainName:  up1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.UnpauseActivity(ctx, up1)
+}
+
+func (a *apiHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUnpauseScheduleScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "UnpauseSchedule",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: up1,
+		DomainName:  up1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.UnpauseSchedule(ctx, up1)
+}
+
+func (a *apiHandler) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUnpauseWorkflowExecutionScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "UnpauseWorkflowExecution",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: up1,
+		DomainName:  up1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.UnpauseWorkflowExecution(ctx, up1)
+}
+
+func (a *apiHandler) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUpdateActivityOptionsScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "UpdateActivityOptions",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: up1,
+		DomainName:  up1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.UpdateActivityOptions(ctx, up1)
+}
+
 func (a *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	scope := a.GetMetricsClient().Scope(metrics.FrontendUpdateDomainScope)
 	attr := &authorization.Attributes{
@@ -656,3 +928,22 @@ func (a *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRe
 	}
 	return a.handler.UpdateDomain(ctx, up1)
 }
+
+func (a *apiHandler) UpdateSchedule(ctx context.Context, up1 *types.UpdateScheduleRequest) (err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUpdateScheduleScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:      "UpdateSchedule",
+		Permission:   authorization.PermissionWrite,
+		RequestBody:  up1,
+		DomainName:   up1.GetDomain(),
+		WorkflowType: up1.GetAction().GetWorkflowType(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.UpdateSchedule(ctx, up1)
+}
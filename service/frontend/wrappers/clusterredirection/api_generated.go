@@ -77,6 +77,30 @@ func NewAPIHandler(
 	}
 }
 
+func (handler *clusterRedirectionHandler) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest) (err error) {
+	var apiName = "BackfillSchedule"
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionBackfillScheduleScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, bp1.GetDomain(), "", cluster, &err)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, bp1.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.BackfillSchedule(ctx, bp1)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.BackfillSchedule(ctx, bp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
 func (handler *clusterRedirectionHandler) CountWorkflowExecutions(ctx context.Context, cp1 *types.CountWorkflowExecutionsRequest) (cp2 *types.CountWorkflowExecutionsResponse, err error) {
 	var apiName = "CountWorkflowExecutions"
 	var cluster string
@@ -101,6 +125,54 @@ func (handler *clusterRedirectionHandler) CountWorkflowExecutions(ctx context.Co
 	return cp2, err
 }
 
+func (handler *clusterRedirectionHandler) CreateSchedule(ctx context.Context, cp1 *types.CreateScheduleRequest) (err error) {
+	var apiName = "CreateSchedule"
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionCreateScheduleScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, cp1.GetDomain(), "", cluster, &err)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, cp1.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.CreateSchedule(ctx, cp1)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.CreateSchedule(ctx, cp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
+func (handler *clusterRedirectionHandler) DeleteSchedule(ctx context.Context, dp1 *types.DeleteScheduleRequest) (err error) {
+	var apiName = "DeleteSchedule"
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDeleteScheduleScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, dp1.GetDomain(), "", cluster, &err)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, dp1.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.DeleteSchedule(ctx, dp1)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.DeleteSchedule(ctx, dp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
 func (handler *clusterRedirectionHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest) (err error) {
 	return handler.frontendHandler.DeprecateDomain(ctx, dp1)
 }
@@ -109,6 +181,30 @@ func (handler *clusterRedirectionHandler) DescribeDomain(ctx context.Context, dp
 	return handler.frontendHandler.DescribeDomain(ctx, dp1)
 }
 
+func (handler *clusterRedirectionHandler) DescribeSchedule(ctx context.Context, dp1 *types.DescribeScheduleRequest) (dp2 *types.DescribeScheduleResponse, err error) {
+	var apiName = "DescribeSchedule"
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDescribeScheduleScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, dp1.GetDomain(), "", cluster, &err)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, dp1.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			dp2, err = handler.frontendHandler.DescribeSchedule(ctx, dp1)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			dp2, err = remoteClient.DescribeSchedule(ctx, dp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return dp2, err
+}
+
 func (handler *clusterRedirectionHandler) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest) (dp2 *types.DescribeTaskListResponse, err error) {
 	var apiName = "DescribeTaskList"
 	var cluster string
@@ -157,6 +253,30 @@ func (handler *clusterRedirectionHandler) DescribeWorkflowExecution(ctx context.
 	return dp2, err
 }
 
+func (handler *clusterRedirectionHandler) ExecuteWorkflowExecution(ctx context.Context, ep1 *types.ExecuteWorkflowExecutionRequest) (ep2 *types.ExecuteWorkflowExecutionResponse, err error) {
+	var apiName = "ExecuteWorkflowExecution"
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionExecuteWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, ep1.GetDomain(), "", cluster, &err)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, ep1.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			ep2, err = handler.frontendHandler.ExecuteWorkflowExecution(ctx, ep1)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			ep2, err = remoteClient.ExecuteWorkflowExecution(ctx, ep1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return ep2, err
+}
+
 func (handler *clusterRedirectionHandler) GetClusterInfo(ctx context.Context) (cp1 *types.ClusterInfo, err error) {
 	return handler.frontendHandler.GetClusterInfo(ctx)
 }
@@ -293,6 +413,30 @@ func (handler *clusterRedirectionHandler) ListOpenWorkflowExecutions(ctx context
 	return lp2, err
 }
 
+func (handler *clusterRedirectionHandler) ListSchedules(ctx context.Context, lp1 *types.ListSchedulesRequest) (lp2 *types.ListSchedulesResponse, err error) {
+	var apiName = "ListSchedules"
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionListSchedulesScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, lp1.GetDomain(), "", cluster, &err)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, lp1.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			lp2, err = handler.frontendHandler.ListSchedules(ctx, lp1)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			lp2, err = remoteClient.ListSchedules(ctx, lp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return lp2, err
+}
+
 func (handler *clusterRedirectionHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	var apiName = "ListTaskListPartitions"
 	var cluster string
@@ -341,6 +485,78 @@ func (handler *clusterRedirectionHandler) ListWorkflowExecutions(ctx context.Con
 	return lp2, err
 }
 
+func (handler *clusterRedirectionHandler) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest) (err error) {
+	var apiName = "PauseActivity"
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseActivityScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, pp1.GetDomain(), "", cluster, &err)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, pp1.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.PauseActivity(ctx, pp1)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.PauseActivity(ctx, pp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
+func (handler *clusterRedirectionHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest) (err error) {
+	var apiName = "PauseSchedule"
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseScheduleScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, pp1.GetDomain(), "", cluster, &err)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, pp1.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.PauseSchedule(ctx, pp1)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.PauseSchedule(ctx, pp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
+func (handler *clusterRedirectionHandler) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest) (err error) {
+	var apiName = "PauseWorkflowExecution"
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, pp1.GetDomain(), "", cluster, &err)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, pp1.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.PauseWorkflowExecution(ctx, pp1)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.PauseWorkflowExecution(ctx, pp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
 func (handler *clusterRedirectionHandler) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest) (pp2 *types.PollForActivityTaskResponse, err error) {
 	var apiName = "PollForActivityTask"
 	var cluster string
@@ -495,6 +711,30 @@ func (handler *clusterRedirectionHandler) RequestCancelWorkflowExecution(ctx con
 	return err
 }
 
+func (handler *clusterRedirectionHandler) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest) (err error) {
+	var apiName = "ResetActivity"
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionResetActivityScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, rp1.GetDomain(), "", cluster, &err)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, rp1.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.ResetActivity(ctx, rp1)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.ResetActivity(ctx, rp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
 func (handler *clusterRedirectionHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	var apiName = "ResetStickyTaskList"
 	var cluster string
@@ -987,10 +1227,154 @@ func (handler *clusterRedirectionHandler) TerminateWorkflowExecution(ctx context
 	return err
 }
 
+func (handler *clusterRedirectionHandler) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest) (err error) {
+	var apiName = "TriggerSchedule"
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionTriggerScheduleScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, tp1.GetDomain(), "", cluster, &err)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, tp1.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.TriggerSchedule(ctx, tp1)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.TriggerSchedule(ctx, tp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
+func (handler *clusterRedirectionHandler) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest) (err error) {
+	var apiName = "UnpauseActivity"
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUnpauseActivityScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, up1.GetDomain(), "", cluster, &err)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, up1.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UnpauseActivity(ctx, up1)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.UnpauseActivity(ctx, up1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
+func (handler *clusterRedirectionHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest) (err error) {
+	var apiName = "UnpauseSchedule"
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUnpauseScheduleScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, up1.GetDomain(), "", cluster, &err)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, up1.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UnpauseSchedule(ctx, up1)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.UnpauseSchedule(ctx, up1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
+func (handler *clusterRedirectionHandler) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest) (err error) {
+	var apiName = "UnpauseWorkflowExecution"
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUnpauseWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, up1.GetDomain(), "", cluster, &err)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, up1.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UnpauseWorkflowExecution(ctx, up1)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.UnpauseWorkflowExecution(ctx, up1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
+func (handler *clusterRedirectionHandler) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest) (err error) {
+	var apiName = "UpdateActivityOptions"
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateActivityOptionsScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, up1.GetDomain(), "", cluster, &err)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, up1.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UpdateActivityOptions(ctx, up1)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.UpdateActivityOptions(ctx, up1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
 func (handler *clusterRedirectionHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	return handler.frontendHandler.UpdateDomain(ctx, up1)
 }
 
+func (handler *clusterRedirectionHandler) UpdateSchedule(ctx context.Context, up1 *types.UpdateScheduleRequest) (err error) {
+	var apiName = "UpdateSchedule"
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateScheduleScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, up1.GetDomain(), "", cluster, &err)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, up1.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UpdateSchedule(ctx, up1)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.UpdateSchedule(ctx, up1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return err
+}
+
 func (handler *clusterRedirectionHandler) QueryWorkflow(
 	ctx context.Context,
 	request *types.QueryWorkflowRequest,
@@ -37,13 +37,15 @@ const (
 	// DCRedirectionPolicyNoop means no redirection
 	DCRedirectionPolicyNoop = "noop"
 	// DCRedirectionPolicySelectedAPIsForwarding means forwarding the following non-worker APIs based domain
-	// 1. StartWorkflowExecution
+	// 1. StartWorkflowExecution and ExecuteWorkflowExecution
 	// 2. SignalWithStartWorkflowExecution
 	// 3. SignalWorkflowExecution
 	// 4. RequestCancelWorkflowExecution
-	// 5. TerminateWorkflowExecution
+	// 5. TerminateWorkflowExecution, PauseWorkflowExecution and UnpauseWorkflowExecution
 	// 6. QueryWorkflowStrongConsistency
 	// 7. ResetWorkflow
+	// 8. CreateSchedule, UpdateSchedule, PauseSchedule, UnpauseSchedule, TriggerSchedule, BackfillSchedule and DeleteSchedule
+	// 9. PauseActivity, UnpauseActivity, ResetActivity and UpdateActivityOptions
 	// please also reference selectedAPIsForwardingRedirectionPolicyAPIAllowlist and DCRedirectionPolicySelectedAPIsForwardingV2
 	DCRedirectionPolicySelectedAPIsForwarding = "selected-apis-forwarding"
 	// DCRedirectionPolicySelectedAPIsForwardingV2 forwards everything in DCRedirectionPolicySelectedAPIsForwarding,
@@ -53,15 +55,15 @@ const (
 	//
 	// This will likely replace DCRedirectionPolicySelectedAPIsForwarding soon.
 	//
-	// 1-7. from DCRedirectionPolicySelectedAPIsForwarding
-	// 8. RecordActivityTaskHeartbeat
-	// 9. RecordActivityTaskHeartbeatByID
-	// 10. RespondActivityTaskCanceled
-	// 11. RespondActivityTaskCanceledByID
-	// 12. RespondActivityTaskCompleted
-	// 13. RespondActivityTaskCompletedByID
-	// 14. RespondActivityTaskFailed
-	// 15. RespondActivityTaskFailedByID
+	// 1-9. from DCRedirectionPolicySelectedAPIsForwarding
+	// 10. RecordActivityTaskHeartbeat
+	// 11. RecordActivityTaskHeartbeatByID
+	// 12. RespondActivityTaskCanceled
+	// 13. RespondActivityTaskCanceledByID
+	// 14. RespondActivityTaskCompleted
+	// 15. RespondActivityTaskCompletedByID
+	// 16. RespondActivityTaskFailed
+	// 17. RespondActivityTaskFailedByID
 	// please also reference selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2
 	DCRedirectionPolicySelectedAPIsForwardingV2 = "selected-apis-forwarding-v2"
 	// DCRedirectionPolicyAllDomainAPIsForwarding means forwarding all the worker and non-worker APIs based domain,
@@ -102,12 +104,26 @@ type (
 // This is paired with DCRedirectionPolicySelectedAPIsForwarding - keep both lists up to date.
 var selectedAPIsForwardingRedirectionPolicyAPIAllowlist = map[string]struct{}{
 	"StartWorkflowExecution":           {},
+	"ExecuteWorkflowExecution":         {},
 	"SignalWithStartWorkflowExecution": {},
 	"SignalWorkflowExecution":          {},
 	"RequestCancelWorkflowExecution":   {},
 	"TerminateWorkflowExecution":       {},
+	"PauseWorkflowExecution":           {},
+	"UnpauseWorkflowExecution":         {},
 	"QueryWorkflowStrongConsistency":   {},
 	"ResetWorkflowExecution":           {},
+	"CreateSchedule":                   {},
+	"UpdateSchedule":                   {},
+	"PauseSchedule":                    {},
+	"UnpauseSchedule":                  {},
+	"TriggerSchedule":                  {},
+	"BackfillSchedule":                 {},
+	"DeleteSchedule":                   {},
+	"PauseActivity":                    {},
+	"UnpauseActivity":                  {},
+	"ResetActivity":                    {},
+	"UpdateActivityOptions":            {},
 }
 
 // selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 contains a list of non-worker APIs which can be redirected.
@@ -115,12 +131,26 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlist = map[string]struct{}{
 var selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 = map[string]struct{}{
 	// from selectedAPIsForwardingRedirectionPolicyAPIAllowlist
 	"StartWorkflowExecution":           {},
+	"ExecuteWorkflowExecution":         {},
 	"SignalWithStartWorkflowExecution": {},
 	"SignalWorkflowExecution":          {},
 	"RequestCancelWorkflowExecution":   {},
 	"TerminateWorkflowExecution":       {},
+	"PauseWorkflowExecution":           {},
+	"UnpauseWorkflowExecution":         {},
 	"QueryWorkflowStrongConsistency":   {},
 	"ResetWorkflowExecution":           {},
+	"CreateSchedule":                   {},
+	"UpdateSchedule":                   {},
+	"PauseSchedule":                    {},
+	"UnpauseSchedule":                  {},
+	"TriggerSchedule":                  {},
+	"BackfillSchedule":                 {},
+	"DeleteSchedule":                   {},
+	"PauseActivity":                    {},
+	"UnpauseActivity":                  {},
+	"ResetActivity":                    {},
+	"UpdateActivityOptions":            {},
 	// additional endpoints
 	"RecordActivityTaskHeartbeat":      {},
 	"RecordActivityTaskHeartbeatByID":  {},
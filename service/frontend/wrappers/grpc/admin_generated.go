@@ -113,6 +113,11 @@ func (g AdminHandler) GetDomainReplicationMessages(ctx context.Context, request
 	return proto.FromAdminGetDomainReplicationMessagesResponse(response), proto.FromError(err)
 }
 
+func (g AdminHandler) GetDomainUsage(ctx context.Context, request *adminv1.GetDomainUsageRequest) (*adminv1.GetDomainUsageResponse, error) {
+	response, err := g.h.GetDomainUsage(ctx, proto.ToAdminGetDomainUsageRequest(request))
+	return proto.FromAdminGetDomainUsageResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) GetDynamicConfig(ctx context.Context, request *adminv1.GetDynamicConfigRequest) (*adminv1.GetDynamicConfigResponse, error) {
 	response, err := g.h.GetDynamicConfig(ctx, proto.ToAdminGetDynamicConfigRequest(request))
 	return proto.FromAdminGetDynamicConfigResponse(response), proto.FromError(err)
@@ -133,11 +138,21 @@ func (g AdminHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, requ
 	return proto.FromAdminGetWorkflowExecutionRawHistoryV2Response(response), proto.FromError(err)
 }
 
+func (g AdminHandler) ImportWorkflowExecution(ctx context.Context, request *adminv1.ImportWorkflowExecutionRequest) (*adminv1.ImportWorkflowExecutionResponse, error) {
+	err := g.h.ImportWorkflowExecution(ctx, proto.ToAdminImportWorkflowExecutionRequest(request))
+	return &adminv1.ImportWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
 func (g AdminHandler) ListDynamicConfig(ctx context.Context, request *adminv1.ListDynamicConfigRequest) (*adminv1.ListDynamicConfigResponse, error) {
 	response, err := g.h.ListDynamicConfig(ctx, proto.ToAdminListDynamicConfigRequest(request))
 	return proto.FromAdminListDynamicConfigResponse(response), proto.FromError(err)
 }
 
+func (g AdminHandler) ListThrottledWorkflowIDs(ctx context.Context, request *adminv1.ListThrottledWorkflowIDsRequest) (*adminv1.ListThrottledWorkflowIDsResponse, error) {
+	response, err := g.h.ListThrottledWorkflowIDs(ctx, proto.ToAdminListThrottledWorkflowIDsRequest(request))
+	return proto.FromAdminListThrottledWorkflowIDsResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) MaintainCorruptWorkflow(ctx context.Context, request *adminv1.MaintainCorruptWorkflowRequest) (*adminv1.MaintainCorruptWorkflowResponse, error) {
 	response, err := g.h.MaintainCorruptWorkflow(ctx, proto.ToAdminMaintainCorruptWorkflowRequest(request))
 	return proto.FromAdminMaintainCorruptWorkflowResponse(response), proto.FromError(err)
@@ -148,6 +163,11 @@ func (g AdminHandler) MergeDLQMessages(ctx context.Context, request *adminv1.Mer
 	return proto.FromAdminMergeDLQMessagesResponse(response), proto.FromError(err)
 }
 
+func (g AdminHandler) PauseActivity(ctx context.Context, request *adminv1.PauseActivityRequest) (*adminv1.PauseActivityResponse, error) {
+	err := g.h.PauseActivity(ctx, proto.ToAdminPauseActivityRequest(request))
+	return &adminv1.PauseActivityResponse{}, proto.FromError(err)
+}
+
 func (g AdminHandler) PurgeDLQMessages(ctx context.Context, request *adminv1.PurgeDLQMessagesRequest) (*adminv1.PurgeDLQMessagesResponse, error) {
 	err := g.h.PurgeDLQMessages(ctx, proto.ToAdminPurgeDLQMessagesRequest(request))
 	return &adminv1.PurgeDLQMessagesResponse{}, proto.FromError(err)
@@ -178,6 +198,11 @@ func (g AdminHandler) ResendReplicationTasks(ctx context.Context, request *admin
 	return &adminv1.ResendReplicationTasksResponse{}, proto.FromError(err)
 }
 
+func (g AdminHandler) ResetActivity(ctx context.Context, request *adminv1.ResetActivityRequest) (*adminv1.ResetActivityResponse, error) {
+	err := g.h.ResetActivity(ctx, proto.ToAdminResetActivityRequest(request))
+	return &adminv1.ResetActivityResponse{}, proto.FromError(err)
+}
+
 func (g AdminHandler) ResetQueue(ctx context.Context, request *adminv1.ResetQueueRequest) (*adminv1.ResetQueueResponse, error) {
 	err := g.h.ResetQueue(ctx, proto.ToAdminResetQueueRequest(request))
 	return &adminv1.ResetQueueResponse{}, proto.FromError(err)
@@ -193,6 +218,16 @@ func (g AdminHandler) RestoreDynamicConfig(ctx context.Context, request *adminv1
 	return &adminv1.RestoreDynamicConfigResponse{}, proto.FromError(err)
 }
 
+func (g AdminHandler) UnpauseActivity(ctx context.Context, request *adminv1.UnpauseActivityRequest) (*adminv1.UnpauseActivityResponse, error) {
+	err := g.h.UnpauseActivity(ctx, proto.ToAdminUnpauseActivityRequest(request))
+	return &adminv1.UnpauseActivityResponse{}, proto.FromError(err)
+}
+
+func (g AdminHandler) UpdateActivityOptions(ctx context.Context, request *adminv1.UpdateActivityOptionsRequest) (*adminv1.UpdateActivityOptionsResponse, error) {
+	err := g.h.UpdateActivityOptions(ctx, proto.ToAdminUpdateActivityOptionsRequest(request))
+	return &adminv1.UpdateActivityOptionsResponse{}, proto.FromError(err)
+}
+
 func (g AdminHandler) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *adminv1.UpdateDomainAsyncWorkflowConfiguratonRequest) (*adminv1.UpdateDomainAsyncWorkflowConfiguratonResponse, error) {
 	response, err := g.h.UpdateDomainAsyncWorkflowConfiguraton(ctx, proto.ToAdminUpdateDomainAsyncWorkflowConfiguratonRequest(request))
 	return proto.FromAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), proto.FromError(err)
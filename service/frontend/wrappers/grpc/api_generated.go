@@ -43,11 +43,26 @@ func NewAPIHandler(h api.Handler) APIHandler {
 	return APIHandler{h}
 }
 
+func (g APIHandler) BackfillSchedule(ctx context.Context, request *apiv1.BackfillScheduleRequest) (*apiv1.BackfillScheduleResponse, error) {
+	err := g.h.BackfillSchedule(ctx, proto.ToBackfillScheduleRequest(request))
+	return &apiv1.BackfillScheduleResponse{}, proto.FromError(err)
+}
+
 func (g APIHandler) CountWorkflowExecutions(ctx context.Context, request *apiv1.CountWorkflowExecutionsRequest) (*apiv1.CountWorkflowExecutionsResponse, error) {
 	response, err := g.h.CountWorkflowExecutions(ctx, proto.ToCountWorkflowExecutionsRequest(request))
 	return proto.FromCountWorkflowExecutionsResponse(response), proto.FromError(err)
 }
 
+func (g APIHandler) CreateSchedule(ctx context.Context, request *apiv1.CreateScheduleRequest) (*apiv1.CreateScheduleResponse, error) {
+	err := g.h.CreateSchedule(ctx, proto.ToCreateScheduleRequest(request))
+	return &apiv1.CreateScheduleResponse{}, proto.FromError(err)
+}
+
+func (g APIHandler) DeleteSchedule(ctx context.Context, request *apiv1.DeleteScheduleRequest) (*apiv1.DeleteScheduleResponse, error) {
+	err := g.h.DeleteSchedule(ctx, proto.ToDeleteScheduleRequest(request))
+	return &apiv1.DeleteScheduleResponse{}, proto.FromError(err)
+}
+
 func (g APIHandler) DeprecateDomain(ctx context.Context, request *apiv1.DeprecateDomainRequest) (*apiv1.DeprecateDomainResponse, error) {
 	err := g.h.DeprecateDomain(ctx, proto.ToDeprecateDomainRequest(request))
 	return &apiv1.DeprecateDomainResponse{}, proto.FromError(err)
@@ -58,6 +73,11 @@ func (g APIHandler) DescribeDomain(ctx context.Context, request *apiv1.DescribeD
 	return proto.FromDescribeDomainResponse(response), proto.FromError(err)
 }
 
+func (g APIHandler) DescribeSchedule(ctx context.Context, request *apiv1.DescribeScheduleRequest) (*apiv1.DescribeScheduleResponse, error) {
+	response, err := g.h.DescribeSchedule(ctx, proto.ToDescribeScheduleRequest(request))
+	return proto.FromDescribeScheduleResponse(response), proto.FromError(err)
+}
+
 func (g APIHandler) DescribeTaskList(ctx context.Context, request *apiv1.DescribeTaskListRequest) (*apiv1.DescribeTaskListResponse, error) {
 	response, err := g.h.DescribeTaskList(ctx, proto.ToDescribeTaskListRequest(request))
 	return proto.FromDescribeTaskListResponse(response), proto.FromError(err)
@@ -68,6 +88,11 @@ func (g APIHandler) DescribeWorkflowExecution(ctx context.Context, request *apiv
 	return proto.FromDescribeWorkflowExecutionResponse(response), proto.FromError(err)
 }
 
+func (g APIHandler) ExecuteWorkflowExecution(ctx context.Context, request *apiv1.ExecuteWorkflowExecutionRequest) (*apiv1.ExecuteWorkflowExecutionResponse, error) {
+	response, err := g.h.ExecuteWorkflowExecution(ctx, proto.ToExecuteWorkflowExecutionRequest(request))
+	return proto.FromExecuteWorkflowExecutionResponse(response), proto.FromError(err)
+}
+
 func (g APIHandler) GetClusterInfo(ctx context.Context, request *apiv1.GetClusterInfoRequest) (*apiv1.GetClusterInfoResponse, error) {
 	response, err := g.h.GetClusterInfo(ctx)
 	return proto.FromGetClusterInfoResponse(response), proto.FromError(err)
@@ -108,6 +133,11 @@ func (g APIHandler) ListOpenWorkflowExecutions(ctx context.Context, request *api
 	return proto.FromListOpenWorkflowExecutionsResponse(response), proto.FromError(err)
 }
 
+func (g APIHandler) ListSchedules(ctx context.Context, request *apiv1.ListSchedulesRequest) (*apiv1.ListSchedulesResponse, error) {
+	response, err := g.h.ListSchedules(ctx, proto.ToListSchedulesRequest(request))
+	return proto.FromListSchedulesResponse(response), proto.FromError(err)
+}
+
 func (g APIHandler) ListTaskListPartitions(ctx context.Context, request *apiv1.ListTaskListPartitionsRequest) (*apiv1.ListTaskListPartitionsResponse, error) {
 	response, err := g.h.ListTaskListPartitions(ctx, proto.ToListTaskListPartitionsRequest(request))
 	return proto.FromListTaskListPartitionsResponse(response), proto.FromError(err)
@@ -118,6 +148,21 @@ func (g APIHandler) ListWorkflowExecutions(ctx context.Context, request *apiv1.L
 	return proto.FromListWorkflowExecutionsResponse(response), proto.FromError(err)
 }
 
+func (g APIHandler) PauseActivity(ctx context.Context, request *apiv1.PauseActivityRequest) (*apiv1.PauseActivityResponse, error) {
+	err := g.h.PauseActivity(ctx, proto.ToPauseActivityRequest(request))
+	return &apiv1.PauseActivityResponse{}, proto.FromError(err)
+}
+
+func (g APIHandler) PauseSchedule(ctx context.Context, request *apiv1.PauseScheduleRequest) (*apiv1.PauseScheduleResponse, error) {
+	err := g.h.PauseSchedule(ctx, proto.ToPauseScheduleRequest(request))
+	return &apiv1.PauseScheduleResponse{}, proto.FromError(err)
+}
+
+func (g APIHandler) PauseWorkflowExecution(ctx context.Context, request *apiv1.PauseWorkflowExecutionRequest) (*apiv1.PauseWorkflowExecutionResponse, error) {
+	err := g.h.PauseWorkflowExecution(ctx, proto.ToPauseWorkflowExecutionRequest(request))
+	return &apiv1.PauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
 func (g APIHandler) PollForActivityTask(ctx context.Context, request *apiv1.PollForActivityTaskRequest) (*apiv1.PollForActivityTaskResponse, error) {
 	response, err := g.h.PollForActivityTask(ctx, proto.ToPollForActivityTaskRequest(request))
 	return proto.FromPollForActivityTaskResponse(response), proto.FromError(err)
@@ -158,6 +203,11 @@ func (g APIHandler) RequestCancelWorkflowExecution(ctx context.Context, request
 	return &apiv1.RequestCancelWorkflowExecutionResponse{}, proto.FromError(err)
 }
 
+func (g APIHandler) ResetActivity(ctx context.Context, request *apiv1.ResetActivityRequest) (*apiv1.ResetActivityResponse, error) {
+	err := g.h.ResetActivity(ctx, proto.ToResetActivityRequest(request))
+	return &apiv1.ResetActivityResponse{}, proto.FromError(err)
+}
+
 func (g APIHandler) ResetStickyTaskList(ctx context.Context, request *apiv1.ResetStickyTaskListRequest) (*apiv1.ResetStickyTaskListResponse, error) {
 	response, err := g.h.ResetStickyTaskList(ctx, proto.ToResetStickyTaskListRequest(request))
 	return proto.FromResetStickyTaskListResponse(response), proto.FromError(err)
@@ -253,7 +303,37 @@ func (g APIHandler) TerminateWorkflowExecution(ctx context.Context, request *api
 	return &apiv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
 }
 
+func (g APIHandler) TriggerSchedule(ctx context.Context, request *apiv1.TriggerScheduleRequest) (*apiv1.TriggerScheduleResponse, error) {
+	err := g.h.TriggerSchedule(ctx, proto.ToTriggerScheduleRequest(request))
+	return &apiv1.TriggerScheduleResponse{}, proto.FromError(err)
+}
+
+func (g APIHandler) UnpauseActivity(ctx context.Context, request *apiv1.UnpauseActivityRequest) (*apiv1.UnpauseActivityResponse, error) {
+	err := g.h.UnpauseActivity(ctx, proto.ToUnpauseActivityRequest(request))
+	return &apiv1.UnpauseActivityResponse{}, proto.FromError(err)
+}
+
+func (g APIHandler) UnpauseSchedule(ctx context.Context, request *apiv1.UnpauseScheduleRequest) (*apiv1.UnpauseScheduleResponse, error) {
+	err := g.h.UnpauseSchedule(ctx, proto.ToUnpauseScheduleRequest(request))
+	return &apiv1.UnpauseScheduleResponse{}, proto.FromError(err)
+}
+
+func (g APIHandler) UnpauseWorkflowExecution(ctx context.Context, request *apiv1.UnpauseWorkflowExecutionRequest) (*apiv1.UnpauseWorkflowExecutionResponse, error) {
+	err := g.h.UnpauseWorkflowExecution(ctx, proto.ToUnpauseWorkflowExecutionRequest(request))
+	return &apiv1.UnpauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g APIHandler) UpdateActivityOptions(ctx context.Context, request *apiv1.UpdateActivityOptionsRequest) (*apiv1.UpdateActivityOptionsResponse, error) {
+	err := g.h.UpdateActivityOptions(ctx, proto.ToUpdateActivityOptionsRequest(request))
+	return &apiv1.UpdateActivityOptionsResponse{}, proto.FromError(err)
+}
+
 func (g APIHandler) UpdateDomain(ctx context.Context, request *apiv1.UpdateDomainRequest) (*apiv1.UpdateDomainResponse, error) {
 	response, err := g.h.UpdateDomain(ctx, proto.ToUpdateDomainRequest(request))
 	return proto.FromUpdateDomainResponse(response), proto.FromError(err)
 }
+
+func (g APIHandler) UpdateSchedule(ctx context.Context, request *apiv1.UpdateScheduleRequest) (*apiv1.UpdateScheduleResponse, error) {
+	err := g.h.UpdateSchedule(ctx, proto.ToUpdateScheduleRequest(request))
+	return &apiv1.UpdateScheduleResponse{}, proto.FromError(err)
+}
@@ -0,0 +1,74 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpc
+
+import (
+	"context"
+
+	adminv1 "github.com/uber/cadence-idl/go/proto/admin/v1"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/proto"
+	"github.com/uber/cadence/service/frontend/admin"
+)
+
+type (
+	// AdminReplicationStreamHandler serves the AdminReplicationStreamAPI over gRPC
+	AdminReplicationStreamHandler struct {
+		h *admin.ReplicationStreamHandler
+	}
+
+	replicationMessagesServerStream struct {
+		stream adminv1.AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer
+	}
+)
+
+func NewAdminReplicationStreamHandler(h *admin.ReplicationStreamHandler) AdminReplicationStreamHandler {
+	return AdminReplicationStreamHandler{h}
+}
+
+func (g AdminReplicationStreamHandler) Register(dispatcher *yarpc.Dispatcher) {
+	dispatcher.Register(adminv1.BuildAdminReplicationStreamAPIYARPCProcedures(g))
+}
+
+func (g AdminReplicationStreamHandler) StreamReplicationMessages(stream adminv1.AdminReplicationStreamAPIServiceStreamReplicationMessagesYARPCServer) error {
+	err := g.h.StreamReplicationMessages(replicationMessagesServerStream{stream})
+	return proto.FromError(err)
+}
+
+func (s replicationMessagesServerStream) Context() context.Context {
+	return s.stream.Context()
+}
+
+func (s replicationMessagesServerStream) Recv() (*types.StreamReplicationMessagesRequest, error) {
+	request, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return proto.ToAdminStreamReplicationMessagesRequest(request), nil
+}
+
+func (s replicationMessagesServerStream) Send(response *types.StreamReplicationMessagesResponse) error {
+	return s.stream.Send(proto.FromAdminStreamReplicationMessagesResponse(response))
+}
@@ -61,6 +61,22 @@ func NewAPIHandler(handler api.Handler, logger log.Logger, metricsClient metrics
 	}
 }
 
+func (h *apiHandler) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("BackfillSchedule")}
+	tags = append(tags, toBackfillScheduleRequestTags(bp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendBackfillScheduleScope).Tagged(metrics.DomainTag(bp1.GetDomain())).Tagged(metrics.GetContextTags(ctx)...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.BackfillSchedule(ctx, bp1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
 func (h *apiHandler) CountWorkflowExecutions(ctx context.Context, cp1 *types.CountWorkflowExecutionsRequest) (cp2 *types.CountWorkflowExecutionsResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("CountWorkflowExecutions")}
@@ -77,6 +93,38 @@ func (h *apiHandler) CountWorkflowExecutions(ctx context.Context, cp1 *types.Cou
 	}
 	return cp2, err
 }
+func (h *apiHandler) CreateSchedule(ctx context.Context, cp1 *types.CreateScheduleRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("CreateSchedule")}
+	tags = append(tags, toCreateScheduleRequestTags(cp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendCreateScheduleScope).Tagged(metrics.DomainTag(cp1.GetDomain())).Tagged(metrics.GetContextTags(ctx)...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.CreateSchedule(ctx, cp1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
+func (h *apiHandler) DeleteSchedule(ctx context.Context, dp1 *types.DeleteScheduleRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("DeleteSchedule")}
+	tags = append(tags, toDeleteScheduleRequestTags(dp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendDeleteScheduleScope).Tagged(metrics.DomainTag(dp1.GetDomain())).Tagged(metrics.GetContextTags(ctx)...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.DeleteSchedule(ctx, dp1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
 func (h *apiHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest) (err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("DeprecateDomain")}
@@ -107,6 +155,22 @@ func (h *apiHandler) DescribeDomain(ctx context.Context, dp1 *types.DescribeDoma
 	}
 	return dp2, err
 }
+func (h *apiHandler) DescribeSchedule(ctx context.Context, dp1 *types.DescribeScheduleRequest) (dp2 *types.DescribeScheduleResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("DescribeSchedule")}
+	tags = append(tags, toDescribeScheduleRequestTags(dp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendDescribeScheduleScope).Tagged(metrics.DomainTag(dp1.GetDomain())).Tagged(metrics.GetContextTags(ctx)...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	dp2, err = h.handler.DescribeSchedule(ctx, dp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return dp2, err
+}
 func (h *apiHandler) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest) (dp2 *types.DescribeTaskListResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("DescribeTaskList")}
@@ -139,6 +203,22 @@ func (h *apiHandler) DescribeWorkflowExecution(ctx context.Context, dp1 *types.D
 	}
 	return dp2, err
 }
+func (h *apiHandler) ExecuteWorkflowExecution(ctx context.Context, ep1 *types.ExecuteWorkflowExecutionRequest) (ep2 *types.ExecuteWorkflowExecutionResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("ExecuteWorkflowExecution")}
+	tags = append(tags, toExecuteWorkflowExecutionRequestTags(ep1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendExecuteWorkflowExecutionScope).Tagged(metrics.DomainTag(ep1.GetDomain())).Tagged(metrics.GetContextTags(ctx)...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	ep2, err = h.handler.ExecuteWorkflowExecution(ctx, ep1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return ep2, err
+}
 func (h *apiHandler) GetClusterInfo(ctx context.Context) (cp1 *types.ClusterInfo, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("GetClusterInfo")}
@@ -267,6 +347,22 @@ func (h *apiHandler) ListOpenWorkflowExecutions(ctx context.Context, lp1 *types.
 	}
 	return lp2, err
 }
+func (h *apiHandler) ListSchedules(ctx context.Context, lp1 *types.ListSchedulesRequest) (lp2 *types.ListSchedulesResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("ListSchedules")}
+	tags = append(tags, toListSchedulesRequestTags(lp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendListSchedulesScope).Tagged(metrics.DomainTag(lp1.GetDomain())).Tagged(metrics.GetContextTags(ctx)...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	lp2, err = h.handler.ListSchedules(ctx, lp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return lp2, err
+}
 func (h *apiHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ListTaskListPartitions")}
@@ -299,6 +395,54 @@ func (h *apiHandler) ListWorkflowExecutions(ctx context.Context, lp1 *types.List
 	}
 	return lp2, err
 }
+func (h *apiHandler) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("PauseActivity")}
+	tags = append(tags, toPauseActivityRequestTags(pp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendPauseActivityScope).Tagged(metrics.DomainTag(pp1.GetDomain())).Tagged(metrics.GetContextTags(ctx)...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.PauseActivity(ctx, pp1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
+func (h *apiHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("PauseSchedule")}
+	tags = append(tags, toPauseScheduleRequestTags(pp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendPauseScheduleScope).Tagged(metrics.DomainTag(pp1.GetDomain())).Tagged(metrics.GetContextTags(ctx)...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.PauseSchedule(ctx, pp1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
+func (h *apiHandler) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("PauseWorkflowExecution")}
+	tags = append(tags, toPauseWorkflowExecutionRequestTags(pp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendPauseWorkflowExecutionScope).Tagged(metrics.DomainTag(pp1.GetDomain())).Tagged(metrics.GetContextTags(ctx)...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.PauseWorkflowExecution(ctx, pp1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
 func (h *apiHandler) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest) (pp2 *types.PollForActivityTaskResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("PollForActivityTask")}
@@ -434,6 +578,22 @@ func (h *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *ty
 	}
 	return err
 }
+func (h *apiHandler) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("ResetActivity")}
+	tags = append(tags, toResetActivityRequestTags(rp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendResetActivityScope).Tagged(metrics.DomainTag(rp1.GetDomain())).Tagged(metrics.GetContextTags(ctx)...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.ResetActivity(ctx, rp1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
 func (h *apiHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ResetStickyTaskList")}
@@ -787,6 +947,86 @@ func (h *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.
 	}
 	return err
 }
+func (h *apiHandler) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("TriggerSchedule")}
+	tags = append(tags, toTriggerScheduleRequestTags(tp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendTriggerScheduleScope).Tagged(metrics.DomainTag(tp1.GetDomain())).Tagged(metrics.GetContextTags(ctx)...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.TriggerSchedule(ctx, tp1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
+func (h *apiHandler) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("UnpauseActivity")}
+	tags = append(tags, toUnpauseActivityRequestTags(up1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendUnpauseActivityScope).Tagged(metrics.DomainTag(up1.GetDomain())).Tagged(metrics.GetContextTags(ctx)...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.UnpauseActivity(ctx, up1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
+func (h *apiHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("UnpauseSchedule")}
+	tags = append(tags, toUnpauseScheduleRequestTags(up1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendUnpauseScheduleScope).Tagged(metrics.DomainTag(up1.GetDomain())).Tagged(metrics.GetContextTags(ctx)...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.UnpauseSchedule(ctx, up1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
+func (h *apiHandler) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("UnpauseWorkflowExecution")}
+	tags = append(tags, toUnpauseWorkflowExecutionRequestTags(up1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendUnpauseWorkflowExecutionScope).Tagged(metrics.DomainTag(up1.GetDomain())).Tagged(metrics.GetContextTags(ctx)...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.UnpauseWorkflowExecution(ctx, up1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
+func (h *apiHandler) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("UpdateActivityOptions")}
+	tags = append(tags, toUpdateActivityOptionsRequestTags(up1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendUpdateActivityOptionsScope).Tagged(metrics.DomainTag(up1.GetDomain())).Tagged(metrics.GetContextTags(ctx)...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.UpdateActivityOptions(ctx, up1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
 func (h *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("UpdateDomain")}
@@ -802,3 +1042,19 @@ func (h *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRe
 	}
 	return up2, err
 }
+func (h *apiHandler) UpdateSchedule(ctx context.Context, up1 *types.UpdateScheduleRequest) (err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("UpdateSchedule")}
+	tags = append(tags, toUpdateScheduleRequestTags(up1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendUpdateScheduleScope).Tagged(metrics.DomainTag(up1.GetDomain())).Tagged(metrics.GetContextTags(ctx)...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	err = h.handler.UpdateSchedule(ctx, up1)
+	if err != nil {
+		return h.handleErr(err, scope, logger)
+	}
+	return err
+}
@@ -131,6 +131,15 @@ func toDescribeWorkflowExecutionRequestTags(req *types.DescribeWorkflowExecution
 	}
 }
 
+func toExecuteWorkflowExecutionRequestTags(req *types.ExecuteWorkflowExecutionRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetStartRequest().GetWorkflowID()),
+		tag.WorkflowType(req.GetWorkflowType().GetName()),
+		tag.WorkflowCronSchedule(req.GetStartRequest().GetCronSchedule()),
+	}
+}
+
 func toGetTaskListsByDomainRequestTags(req *types.GetTaskListsByDomainRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
@@ -326,8 +335,122 @@ func toTerminateWorkflowExecutionRequestTags(req *types.TerminateWorkflowExecuti
 	}
 }
 
+func toPauseWorkflowExecutionRequestTags(req *types.PauseWorkflowExecutionRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
+func toUnpauseWorkflowExecutionRequestTags(req *types.UnpauseWorkflowExecutionRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
+func toPauseActivityRequestTags(req *types.PauseActivityRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+		tag.WorkflowActivityID(req.GetActivityID()),
+	}
+}
+
+func toUnpauseActivityRequestTags(req *types.UnpauseActivityRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+		tag.WorkflowActivityID(req.GetActivityID()),
+	}
+}
+
+func toResetActivityRequestTags(req *types.ResetActivityRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+		tag.WorkflowActivityID(req.GetActivityID()),
+	}
+}
+
+func toUpdateActivityOptionsRequestTags(req *types.UpdateActivityOptionsRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+		tag.WorkflowActivityID(req.GetActivityID()),
+	}
+}
+
 func toScanWorkflowExecutionsRequestTags(req *types.ListWorkflowExecutionsRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
 	}
 }
+
+func toBackfillScheduleRequestTags(req *types.BackfillScheduleRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.ScheduleID(req.GetScheduleID()),
+	}
+}
+
+func toCreateScheduleRequestTags(req *types.CreateScheduleRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.ScheduleID(req.GetScheduleID()),
+	}
+}
+
+func toDeleteScheduleRequestTags(req *types.DeleteScheduleRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.ScheduleID(req.GetScheduleID()),
+	}
+}
+
+func toDescribeScheduleRequestTags(req *types.DescribeScheduleRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.ScheduleID(req.GetScheduleID()),
+	}
+}
+
+func toListSchedulesRequestTags(req *types.ListSchedulesRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
+
+func toPauseScheduleRequestTags(req *types.PauseScheduleRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.ScheduleID(req.GetScheduleID()),
+	}
+}
+
+func toTriggerScheduleRequestTags(req *types.TriggerScheduleRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.ScheduleID(req.GetScheduleID()),
+	}
+}
+
+func toUnpauseScheduleRequestTags(req *types.UnpauseScheduleRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.ScheduleID(req.GetScheduleID()),
+	}
+}
+
+func toUpdateScheduleRequestTags(req *types.UpdateScheduleRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.ScheduleID(req.GetScheduleID()),
+	}
+}
@@ -68,6 +68,22 @@ func NewAPIHandler(
 	}
 }
 
+func (h *apiHandler) BackfillSchedule(ctx context.Context, bp1 *types.BackfillScheduleRequest) (err error) {
+	if bp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if bp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, bp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.BackfillSchedule(ctx, bp1)
+}
+
 func (h *apiHandler) CountWorkflowExecutions(ctx context.Context, cp1 *types.CountWorkflowExecutionsRequest) (cp2 *types.CountWorkflowExecutionsResponse, err error) {
 	if cp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -84,6 +100,38 @@ func (h *apiHandler) CountWorkflowExecutions(ctx context.Context, cp1 *types.Cou
 	return h.wrapped.CountWorkflowExecutions(ctx, cp1)
 }
 
+func (h *apiHandler) CreateSchedule(ctx context.Context, cp1 *types.CreateScheduleRequest) (err error) {
+	if cp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if cp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, cp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.CreateSchedule(ctx, cp1)
+}
+
+func (h *apiHandler) DeleteSchedule(ctx context.Context, dp1 *types.DeleteScheduleRequest) (err error) {
+	if dp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if dp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, dp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.DeleteSchedule(ctx, dp1)
+}
+
 func (h *apiHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDomainRequest) (err error) {
 	return h.wrapped.DeprecateDomain(ctx, dp1)
 }
@@ -92,6 +140,22 @@ func (h *apiHandler) DescribeDomain(ctx context.Context, dp1 *types.DescribeDoma
 	return h.wrapped.DescribeDomain(ctx, dp1)
 }
 
+func (h *apiHandler) DescribeSchedule(ctx context.Context, dp1 *types.DescribeScheduleRequest) (dp2 *types.DescribeScheduleResponse, err error) {
+	if dp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if dp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, dp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.DescribeSchedule(ctx, dp1)
+}
+
 func (h *apiHandler) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest) (dp2 *types.DescribeTaskListResponse, err error) {
 	if dp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -124,6 +188,22 @@ func (h *apiHandler) DescribeWorkflowExecution(ctx context.Context, dp1 *types.D
 	return h.wrapped.DescribeWorkflowExecution(ctx, dp1)
 }
 
+func (h *apiHandler) ExecuteWorkflowExecution(ctx context.Context, ep1 *types.ExecuteWorkflowExecutionRequest) (ep2 *types.ExecuteWorkflowExecutionResponse, err error) {
+	if ep1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if ep1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, ep1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.ExecuteWorkflowExecution(ctx, ep1)
+}
+
 func (h *apiHandler) GetClusterInfo(ctx context.Context) (cp1 *types.ClusterInfo, err error) {
 	return h.wrapped.GetClusterInfo(ctx)
 }
@@ -220,6 +300,22 @@ func (h *apiHandler) ListOpenWorkflowExecutions(ctx context.Context, lp1 *types.
 	return h.wrapped.ListOpenWorkflowExecutions(ctx, lp1)
 }
 
+func (h *apiHandler) ListSchedules(ctx context.Context, lp1 *types.ListSchedulesRequest) (lp2 *types.ListSchedulesResponse, err error) {
+	if lp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if lp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeVisibility, lp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.ListSchedules(ctx, lp1)
+}
+
 func (h *apiHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest) (lp2 *types.ListTaskListPartitionsResponse, err error) {
 	if lp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -252,6 +348,54 @@ func (h *apiHandler) ListWorkflowExecutions(ctx context.Context, lp1 *types.List
 	return h.wrapped.ListWorkflowExecutions(ctx, lp1)
 }
 
+func (h *apiHandler) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest) (err error) {
+	if pp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if pp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, pp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.PauseActivity(ctx, pp1)
+}
+
+func (h *apiHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest) (err error) {
+	if pp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if pp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, pp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.PauseSchedule(ctx, pp1)
+}
+
+func (h *apiHandler) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest) (err error) {
+	if pp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if pp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, pp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.PauseWorkflowExecution(ctx, pp1)
+}
+
 func (h *apiHandler) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest) (pp2 *types.PollForActivityTaskResponse, err error) {
 	if pp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -378,6 +522,22 @@ func (h *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *ty
 	return h.wrapped.RequestCancelWorkflowExecution(ctx, rp1)
 }
 
+func (h *apiHandler) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest) (err error) {
+	if rp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if rp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, rp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.ResetActivity(ctx, rp1)
+}
+
 func (h *apiHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	if rp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -744,6 +904,102 @@ func (h *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.
 	return h.wrapped.TerminateWorkflowExecution(ctx, tp1)
 }
 
+func (h *apiHandler) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest) (err error) {
+	if tp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if tp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, tp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.TriggerSchedule(ctx, tp1)
+}
+
+func (h *apiHandler) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest) (err error) {
+	if up1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if up1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, up1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.UnpauseActivity(ctx, up1)
+}
+
+func (h *apiHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest) (err error) {
+	if up1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if up1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, up1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.UnpauseSchedule(ctx, up1)
+}
+
+func (h *apiHandler) UnpauseWorkflowExecution(ctx context.Context, up1 *types.UnpauseWorkflowExecutionRequest) (err error) {
+	if up1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if up1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, up1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.UnpauseWorkflowExecution(ctx, up1)
+}
+
+func (h *apiHandler) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest) (err error) {
+	if up1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if up1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, up1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.UpdateActivityOptions(ctx, up1)
+}
+
 func (h *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	return h.wrapped.UpdateDomain(ctx, up1)
 }
+
+func (h *apiHandler) UpdateSchedule(ctx context.Context, up1 *types.UpdateScheduleRequest) (err error) {
+	if up1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if up1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, up1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.UpdateSchedule(ctx, up1)
+}
@@ -100,6 +100,11 @@ func (g AdminHandler) GetDomainReplicationMessages(ctx context.Context, Request
 	return thrift.FromAdminGetDomainReplicationMessagesResponse(response), thrift.FromError(err)
 }
 
+func (g AdminHandler) GetDomainUsage(ctx context.Context, Request *shared.GetDomainUsageRequest) (gp1 *shared.GetDomainUsageResponse, err error) {
+	response, err := g.h.GetDomainUsage(ctx, thrift.ToAdminGetDomainUsageRequest(Request))
+	return thrift.FromAdminGetDomainUsageResponse(response), thrift.FromError(err)
+}
+
 func (g AdminHandler) GetDynamicConfig(ctx context.Context, Request *admin.GetDynamicConfigRequest) (gp1 *admin.GetDynamicConfigResponse, err error) {
 	response, err := g.h.GetDynamicConfig(ctx, thrift.ToAdminGetDynamicConfigRequest(Request))
 	return thrift.FromAdminGetDynamicConfigResponse(response), thrift.FromError(err)
@@ -120,11 +125,21 @@ func (g AdminHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, GetR
 	return thrift.FromAdminGetWorkflowExecutionRawHistoryV2Response(response), thrift.FromError(err)
 }
 
+func (g AdminHandler) ImportWorkflowExecution(ctx context.Context, Request *admin.ImportWorkflowExecutionRequest) (err error) {
+	err = g.h.ImportWorkflowExecution(ctx, thrift.ToAdminImportWorkflowExecutionRequest(Request))
+	return thrift.FromError(err)
+}
+
 func (g AdminHandler) ListDynamicConfig(ctx context.Context, Request *admin.ListDynamicConfigRequest) (lp1 *admin.ListDynamicConfigResponse, err error) {
 	response, err := g.h.ListDynamicConfig(ctx, thrift.ToAdminListDynamicConfigRequest(Request))
 	return thrift.FromAdminListDynamicConfigResponse(response), thrift.FromError(err)
 }
 
+func (g AdminHandler) ListThrottledWorkflowIDs(ctx context.Context, Request *shared.ListThrottledWorkflowIDsRequest) (lp1 *shared.ListThrottledWorkflowIDsResponse, err error) {
+	response, err := g.h.ListThrottledWorkflowIDs(ctx, thrift.ToAdminListThrottledWorkflowIDsRequest(Request))
+	return thrift.FromAdminListThrottledWorkflowIDsResponse(response), thrift.FromError(err)
+}
+
 func (g AdminHandler) MaintainCorruptWorkflow(ctx context.Context, Request *admin.AdminMaintainWorkflowRequest) (ap1 *admin.AdminMaintainWorkflowResponse, err error) {
 	response, err := g.h.MaintainCorruptWorkflow(ctx, thrift.ToAdminMaintainCorruptWorkflowRequest(Request))
 	return thrift.FromAdminMaintainCorruptWorkflowResponse(response), thrift.FromError(err)
@@ -135,6 +150,11 @@ func (g AdminHandler) MergeDLQMessages(ctx context.Context, Request *replicator.
 	return thrift.FromAdminMergeDLQMessagesResponse(response), thrift.FromError(err)
 }
 
+func (g AdminHandler) PauseActivity(ctx context.Context, Request *shared.PauseActivityRequest) (err error) {
+	err = g.h.PauseActivity(ctx, thrift.ToAdminPauseActivityRequest(Request))
+	return thrift.FromError(err)
+}
+
 func (g AdminHandler) PurgeDLQMessages(ctx context.Context, Request *replicator.PurgeDLQMessagesRequest) (err error) {
 	err = g.h.PurgeDLQMessages(ctx, thrift.ToAdminPurgeDLQMessagesRequest(Request))
 	return thrift.FromError(err)
@@ -165,6 +185,11 @@ func (g AdminHandler) ResendReplicationTasks(ctx context.Context, Request *admin
 	return thrift.FromError(err)
 }
 
+func (g AdminHandler) ResetActivity(ctx context.Context, Request *shared.ResetActivityRequest) (err error) {
+	err = g.h.ResetActivity(ctx, thrift.ToAdminResetActivityRequest(Request))
+	return thrift.FromError(err)
+}
+
 func (g AdminHandler) ResetQueue(ctx context.Context, Request *shared.ResetQueueRequest) (err error) {
 	err = g.h.ResetQueue(ctx, thrift.ToAdminResetQueueRequest(Request))
 	return thrift.FromError(err)
@@ -180,6 +205,16 @@ func (g AdminHandler) RestoreDynamicConfig(ctx context.Context, Request *admin.R
 	return thrift.FromError(err)
 }
 
+func (g AdminHandler) UnpauseActivity(ctx context.Context, Request *shared.UnpauseActivityRequest) (err error) {
+	err = g.h.UnpauseActivity(ctx, thrift.ToAdminUnpauseActivityRequest(Request))
+	return thrift.FromError(err)
+}
+
+func (g AdminHandler) UpdateActivityOptions(ctx context.Context, Request *shared.UpdateActivityOptionsRequest) (err error) {
+	err = g.h.UpdateActivityOptions(ctx, thrift.ToAdminUpdateActivityOptionsRequest(Request))
+	return thrift.FromError(err)
+}
+
 func (g AdminHandler) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, Request *admin.UpdateDomainAsyncWorkflowConfiguratonRequest) (up1 *admin.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	response, err := g.h.UpdateDomainAsyncWorkflowConfiguraton(ctx, thrift.ToAdminUpdateDomainAsyncWorkflowConfiguratonRequest(Request))
 	return thrift.FromAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), thrift.FromError(err)
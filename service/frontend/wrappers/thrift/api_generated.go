@@ -33,11 +33,26 @@ import (
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
 
+func (g APIHandler) BackfillSchedule(ctx context.Context, Request *shared.BackfillScheduleRequest) (err error) {
+	err = g.h.BackfillSchedule(ctx, thrift.ToBackfillScheduleRequest(Request))
+	return thrift.FromError(err)
+}
+
 func (g APIHandler) CountWorkflowExecutions(ctx context.Context, CountRequest *shared.CountWorkflowExecutionsRequest) (cp1 *shared.CountWorkflowExecutionsResponse, err error) {
 	response, err := g.h.CountWorkflowExecutions(ctx, thrift.ToCountWorkflowExecutionsRequest(CountRequest))
 	return thrift.FromCountWorkflowExecutionsResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) CreateSchedule(ctx context.Context, Request *shared.CreateScheduleRequest) (err error) {
+	err = g.h.CreateSchedule(ctx, thrift.ToCreateScheduleRequest(Request))
+	return thrift.FromError(err)
+}
+
+func (g APIHandler) DeleteSchedule(ctx context.Context, Request *shared.DeleteScheduleRequest) (err error) {
+	err = g.h.DeleteSchedule(ctx, thrift.ToDeleteScheduleRequest(Request))
+	return thrift.FromError(err)
+}
+
 func (g APIHandler) DeprecateDomain(ctx context.Context, DeprecateRequest *shared.DeprecateDomainRequest) (err error) {
 	err = g.h.DeprecateDomain(ctx, thrift.ToDeprecateDomainRequest(DeprecateRequest))
 	return thrift.FromError(err)
@@ -48,6 +63,11 @@ func (g APIHandler) DescribeDomain(ctx context.Context, DescribeRequest *shared.
 	return thrift.FromDescribeDomainResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) DescribeSchedule(ctx context.Context, Request *shared.DescribeScheduleRequest) (dp1 *shared.DescribeScheduleResponse, err error) {
+	response, err := g.h.DescribeSchedule(ctx, thrift.ToDescribeScheduleRequest(Request))
+	return thrift.FromDescribeScheduleResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) DescribeTaskList(ctx context.Context, Request *shared.DescribeTaskListRequest) (dp1 *shared.DescribeTaskListResponse, err error) {
 	response, err := g.h.DescribeTaskList(ctx, thrift.ToDescribeTaskListRequest(Request))
 	return thrift.FromDescribeTaskListResponse(response), thrift.FromError(err)
@@ -58,6 +78,11 @@ func (g APIHandler) DescribeWorkflowExecution(ctx context.Context, DescribeReque
 	return thrift.FromDescribeWorkflowExecutionResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) ExecuteWorkflowExecution(ctx context.Context, ExecuteRequest *shared.ExecuteWorkflowExecutionRequest) (ep1 *shared.ExecuteWorkflowExecutionResponse, err error) {
+	response, err := g.h.ExecuteWorkflowExecution(ctx, thrift.ToExecuteWorkflowExecutionRequest(ExecuteRequest))
+	return thrift.FromExecuteWorkflowExecutionResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) GetClusterInfo(ctx context.Context) (cp1 *shared.ClusterInfo, err error) {
 	response, err := g.h.GetClusterInfo(ctx)
 	return thrift.FromGetClusterInfoResponse(response), thrift.FromError(err)
@@ -98,6 +123,11 @@ func (g APIHandler) ListOpenWorkflowExecutions(ctx context.Context, ListRequest
 	return thrift.FromListOpenWorkflowExecutionsResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) ListSchedules(ctx context.Context, Request *shared.ListSchedulesRequest) (lp1 *shared.ListSchedulesResponse, err error) {
+	response, err := g.h.ListSchedules(ctx, thrift.ToListSchedulesRequest(Request))
+	return thrift.FromListSchedulesResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) ListTaskListPartitions(ctx context.Context, Request *shared.ListTaskListPartitionsRequest) (lp1 *shared.ListTaskListPartitionsResponse, err error) {
 	response, err := g.h.ListTaskListPartitions(ctx, thrift.ToListTaskListPartitionsRequest(Request))
 	return thrift.FromListTaskListPartitionsResponse(response), thrift.FromError(err)
@@ -108,6 +138,21 @@ func (g APIHandler) ListWorkflowExecutions(ctx context.Context, ListRequest *sha
 	return thrift.FromListWorkflowExecutionsResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) PauseActivity(ctx context.Context, PauseRequest *shared.PauseActivityRequest) (err error) {
+	err = g.h.PauseActivity(ctx, thrift.ToPauseActivityRequest(PauseRequest))
+	return thrift.FromError(err)
+}
+
+func (g APIHandler) PauseSchedule(ctx context.Context, Request *shared.PauseScheduleRequest) (err error) {
+	err = g.h.PauseSchedule(ctx, thrift.ToPauseScheduleRequest(Request))
+	return thrift.FromError(err)
+}
+
+func (g APIHandler) PauseWorkflowExecution(ctx context.Context, PauseRequest *shared.PauseWorkflowExecutionRequest) (err error) {
+	err = g.h.PauseWorkflowExecution(ctx, thrift.ToPauseWorkflowExecutionRequest(PauseRequest))
+	return thrift.FromError(err)
+}
+
 func (g APIHandler) PollForActivityTask(ctx context.Context, PollRequest *shared.PollForActivityTaskRequest) (pp1 *shared.PollForActivityTaskResponse, err error) {
 	response, err := g.h.PollForActivityTask(ctx, thrift.ToPollForActivityTaskRequest(PollRequest))
 	return thrift.FromPollForActivityTaskResponse(response), thrift.FromError(err)
@@ -148,6 +193,11 @@ func (g APIHandler) RequestCancelWorkflowExecution(ctx context.Context, CancelRe
 	return thrift.FromError(err)
 }
 
+func (g APIHandler) ResetActivity(ctx context.Context, ResetRequest *shared.ResetActivityRequest) (err error) {
+	err = g.h.ResetActivity(ctx, thrift.ToResetActivityRequest(ResetRequest))
+	return thrift.FromError(err)
+}
+
 func (g APIHandler) ResetStickyTaskList(ctx context.Context, ResetRequest *shared.ResetStickyTaskListRequest) (rp1 *shared.ResetStickyTaskListResponse, err error) {
 	response, err := g.h.ResetStickyTaskList(ctx, thrift.ToResetStickyTaskListRequest(ResetRequest))
 	return thrift.FromResetStickyTaskListResponse(response), thrift.FromError(err)
@@ -243,7 +293,37 @@ func (g APIHandler) TerminateWorkflowExecution(ctx context.Context, TerminateReq
 	return thrift.FromError(err)
 }
 
+func (g APIHandler) TriggerSchedule(ctx context.Context, Request *shared.TriggerScheduleRequest) (err error) {
+	err = g.h.TriggerSchedule(ctx, thrift.ToTriggerScheduleRequest(Request))
+	return thrift.FromError(err)
+}
+
+func (g APIHandler) UnpauseActivity(ctx context.Context, UnpauseRequest *shared.UnpauseActivityRequest) (err error) {
+	err = g.h.UnpauseActivity(ctx, thrift.ToUnpauseActivityRequest(UnpauseRequest))
+	return thrift.FromError(err)
+}
+
+func (g APIHandler) UnpauseSchedule(ctx context.Context, Request *shared.UnpauseScheduleRequest) (err error) {
+	err = g.h.UnpauseSchedule(ctx, thrift.ToUnpauseScheduleRequest(Request))
+	return thrift.FromError(err)
+}
+
+func (g APIHandler) UnpauseWorkflowExecution(ctx context.Context, UnpauseRequest *shared.UnpauseWorkflowExecutionRequest) (err error) {
+	err = g.h.UnpauseWorkflowExecution(ctx, thrift.ToUnpauseWorkflowExecutionRequest(UnpauseRequest))
+	return thrift.FromError(err)
+}
+
+func (g APIHandler) UpdateActivityOptions(ctx context.Context, UpdateRequest *shared.UpdateActivityOptionsRequest) (err error) {
+	err = g.h.UpdateActivityOptions(ctx, thrift.ToUpdateActivityOptionsRequest(UpdateRequest))
+	return thrift.FromError(err)
+}
+
 func (g APIHandler) UpdateDomain(ctx context.Context, UpdateRequest *shared.UpdateDomainRequest) (up1 *shared.UpdateDomainResponse, err error) {
 	response, err := g.h.UpdateDomain(ctx, thrift.ToUpdateDomainRequest(UpdateRequest))
 	return thrift.FromUpdateDomainResponse(response), thrift.FromError(err)
 }
+
+func (g APIHandler) UpdateSchedule(ctx context.Context, Request *shared.UpdateScheduleRequest) (err error) {
+	err = g.h.UpdateSchedule(ctx, thrift.ToUpdateScheduleRequest(Request))
+	return thrift.FromError(err)
+}
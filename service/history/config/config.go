@@ -67,6 +67,8 @@ type Config struct {
 	HistoryCacheInitialSize dynamicconfig.IntPropertyFn
 	HistoryCacheMaxSize     dynamicconfig.IntPropertyFn
 	HistoryCacheTTL         dynamicconfig.DurationPropertyFn
+	// HistoryCacheLongSleepThreshold doesn't require shard restart
+	HistoryCacheLongSleepThreshold dynamicconfig.DurationPropertyFn
 
 	// EventsCache settings
 	// Change of these configs require shard restart
@@ -97,6 +99,8 @@ type Config struct {
 	TaskSchedulerShardQueueSize             dynamicconfig.IntPropertyFn
 	TaskSchedulerDispatcherCount            dynamicconfig.IntPropertyFn
 	TaskSchedulerRoundRobinWeights          dynamicconfig.MapPropertyFn
+	TaskSchedulerDomainRoundRobinWeight     dynamicconfig.IntPropertyFnWithDomainFilter
+	TaskSchedulerDomainMaxConcurrency       dynamicconfig.IntPropertyFnWithDomainFilter
 	TaskCriticalRetryCount                  dynamicconfig.IntPropertyFn
 	ActiveTaskRedispatchInterval            dynamicconfig.DurationPropertyFn
 	StandbyTaskRedispatchInterval           dynamicconfig.DurationPropertyFn
@@ -114,6 +118,8 @@ type Config struct {
 	QueueProcessorPendingTaskSplitThreshold            dynamicconfig.MapPropertyFn
 	QueueProcessorEnableStuckTaskSplitByDomainID       dynamicconfig.BoolPropertyFnWithDomainIDFilter
 	QueueProcessorStuckTaskSplitThreshold              dynamicconfig.MapPropertyFn
+	QueueProcessorSplitDomainLevels                    dynamicconfig.MapPropertyFn
+	QueueProcessorThrottleSplitDomainByDomainID        dynamicconfig.BoolPropertyFnWithDomainIDFilter
 	QueueProcessorSplitLookAheadDurationByDomainID     dynamicconfig.DurationPropertyFnWithDomainIDFilter
 	QueueProcessorPollBackoffInterval                  dynamicconfig.DurationPropertyFn
 	QueueProcessorPollBackoffIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
@@ -138,6 +144,7 @@ type Config struct {
 	TimerProcessorSplitQueueIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 	TimerProcessorMaxRedispatchQueueSize              dynamicconfig.IntPropertyFn
 	TimerProcessorMaxTimeShift                        dynamicconfig.DurationPropertyFn
+	TimerProcessorFarFutureTimerHorizon               dynamicconfig.DurationPropertyFn
 	TimerProcessorHistoryArchivalSizeLimit            dynamicconfig.IntPropertyFn
 	TimerProcessorArchivalTimeLimit                   dynamicconfig.DurationPropertyFn
 
@@ -200,6 +207,8 @@ type Config struct {
 	// System Limits
 	MaximumBufferedEventsBatch dynamicconfig.IntPropertyFn
 	MaximumSignalsPerExecution dynamicconfig.IntPropertyFnWithDomainFilter
+	// MaximumPendingSignalsPerExecution caps the signals waiting to be processed by a decision
+	MaximumPendingSignalsPerExecution dynamicconfig.IntPropertyFnWithDomainFilter
 
 	// ShardUpdateMinInterval the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval dynamicconfig.DurationPropertyFn
@@ -237,10 +246,10 @@ type Config struct {
 	// Size limit related settings
 	BlobSizeLimitError               dynamicconfig.IntPropertyFnWithDomainFilter
 	BlobSizeLimitWarn                dynamicconfig.IntPropertyFnWithDomainFilter
-	HistorySizeLimitError            dynamicconfig.IntPropertyFnWithDomainFilter
-	HistorySizeLimitWarn             dynamicconfig.IntPropertyFnWithDomainFilter
-	HistoryCountLimitError           dynamicconfig.IntPropertyFnWithDomainFilter
-	HistoryCountLimitWarn            dynamicconfig.IntPropertyFnWithDomainFilter
+	HistorySizeLimitError            dynamicconfig.IntPropertyFnWithWorkflowTypeFilter
+	HistorySizeLimitWarn             dynamicconfig.IntPropertyFnWithWorkflowTypeFilter
+	HistoryCountLimitError           dynamicconfig.IntPropertyFnWithWorkflowTypeFilter
+	HistoryCountLimitWarn            dynamicconfig.IntPropertyFnWithWorkflowTypeFilter
 	PendingActivitiesCountLimitError dynamicconfig.IntPropertyFn
 	PendingActivitiesCountLimitWarn  dynamicconfig.IntPropertyFn
 	PendingActivityValidationEnabled dynamicconfig.BoolPropertyFn
@@ -272,6 +281,9 @@ type Config struct {
 	ReplicationTaskFetcherTimerJitterCoefficient       dynamicconfig.FloatPropertyFn
 	ReplicationTaskFetcherErrorRetryWait               dynamicconfig.DurationPropertyFn
 	ReplicationTaskFetcherServiceBusyWait              dynamicconfig.DurationPropertyFn
+	ReplicationTaskFetcherStreamEnabled                dynamicconfig.BoolPropertyFn
+	ReplicationTaskFetcherStreamRetryWait              dynamicconfig.DurationPropertyFn
+	ReplicationMessagesLongPollTimeout                 dynamicconfig.DurationPropertyFn
 	ReplicationTaskFetcherEnableGracefulSyncShutdown   dynamicconfig.BoolPropertyFn
 	ReplicationTaskProcessorErrorRetryWait             dynamicconfig.DurationPropertyFnWithShardIDFilter
 	ReplicationTaskProcessorErrorRetryMaxAttempts      dynamicconfig.IntPropertyFnWithShardIDFilter
@@ -289,19 +301,45 @@ type Config struct {
 	EnableReplicationTaskGeneration                    dynamicconfig.BoolPropertyFnWithDomainIDAndWorkflowIDFilter
 	EnableRecordWorkflowExecutionUninitialized         dynamicconfig.BoolPropertyFnWithDomainFilter
 
+	// EnableWorkflowLifecycleEvents publishes workflow started, closed, signaled and activity failed events of a domain to the lifecycle stream
+	EnableWorkflowLifecycleEvents dynamicconfig.BoolPropertyFnWithDomainFilter
+	// EnableWorkflowUsageMetrics emits per domain and workflow type capacity usage counters
+	// and records them in the domain usage queue
+	EnableWorkflowUsageMetrics dynamicconfig.BoolPropertyFnWithDomainFilter
+	WorkflowUsageFlushInterval dynamicconfig.DurationPropertyFn
+	WorkflowUsageRetention     dynamicconfig.DurationPropertyFn
+
+	// The following are used by the replication DLQ re-driver
+	EnableReplicationDLQRedrive         dynamicconfig.BoolPropertyFn
+	ReplicationDLQRedriveInterval       dynamicconfig.DurationPropertyFnWithShardIDFilter
+	ReplicationDLQRedriveBatchSize      dynamicconfig.IntPropertyFnWithShardIDFilter
+	ReplicationDLQRedriveInitialBackoff dynamicconfig.DurationPropertyFnWithShardIDFilter
+	ReplicationDLQRedriveMaxBackoff     dynamicconfig.DurationPropertyFnWithShardIDFilter
+	ReplicationDLQRedriveDomainRPS      dynamicconfig.IntPropertyFnWithDomainFilter
+
 	// The following are used by the history workflowID cache
 	WorkflowIDCacheExternalEnabled     dynamicconfig.BoolPropertyFnWithDomainFilter
 	WorkflowIDCacheInternalEnabled     dynamicconfig.BoolPropertyFnWithDomainFilter
 	WorkflowIDExternalRateLimitEnabled dynamicconfig.BoolPropertyFnWithDomainFilter
+	WorkflowIDQueryRateLimitEnabled    dynamicconfig.BoolPropertyFnWithDomainFilter
 	WorkflowIDInternalRateLimitEnabled dynamicconfig.BoolPropertyFnWithDomainFilter
 	WorkflowIDExternalRPS              dynamicconfig.IntPropertyFnWithDomainFilter
 	WorkflowIDInternalRPS              dynamicconfig.IntPropertyFnWithDomainFilter
+	WorkflowIDSignalRPS                dynamicconfig.IntPropertyFnWithDomainFilter
+	WorkflowIDSignalWithStartRPS       dynamicconfig.IntPropertyFnWithDomainFilter
+	WorkflowIDStartRPS                 dynamicconfig.IntPropertyFnWithDomainFilter
+	WorkflowIDQueryRPS                 dynamicconfig.IntPropertyFnWithDomainFilter
+	WorkflowIDDescribeRPS              dynamicconfig.IntPropertyFnWithDomainFilter
 
 	// The following are used by consistent query
 	EnableConsistentQuery         dynamicconfig.BoolPropertyFn
 	EnableConsistentQueryByDomain dynamicconfig.BoolPropertyFnWithDomainFilter
 	MaxBufferedQueryCount         dynamicconfig.IntPropertyFn
 
+	// The following are used by the closed workflow query cache
+	EnableClosedWorkflowQueryCache  dynamicconfig.BoolPropertyFnWithDomainFilter
+	ClosedWorkflowQueryCacheMaxSize dynamicconfig.IntPropertyFnWithDomainFilter
+
 	EnableCrossClusterEngine              dynamicconfig.BoolPropertyFn
 	EnableCrossClusterOperationsForDomain dynamicconfig.BoolPropertyFnWithDomainFilter
 
@@ -376,6 +414,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, s
 		HistoryCacheInitialSize:              dc.GetIntProperty(dynamicconfig.HistoryCacheInitialSize),
 		HistoryCacheMaxSize:                  dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSize),
 		HistoryCacheTTL:                      dc.GetDurationProperty(dynamicconfig.HistoryCacheTTL),
+		HistoryCacheLongSleepThreshold:       dc.GetDurationProperty(dynamicconfig.HistoryCacheLongSleepThreshold),
 		EventsCacheInitialCount:              dc.GetIntProperty(dynamicconfig.EventsCacheInitialCount),
 		EventsCacheMaxCount:                  dc.GetIntProperty(dynamicconfig.EventsCacheMaxCount),
 		EventsCacheMaxSize:                   dc.GetIntProperty(dynamicconfig.EventsCacheMaxSize),
@@ -401,6 +440,8 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, s
 		TaskSchedulerShardQueueSize:             dc.GetIntProperty(dynamicconfig.TaskSchedulerShardQueueSize),
 		TaskSchedulerDispatcherCount:            dc.GetIntProperty(dynamicconfig.TaskSchedulerDispatcherCount),
 		TaskSchedulerRoundRobinWeights:          dc.GetMapProperty(dynamicconfig.TaskSchedulerRoundRobinWeights),
+		TaskSchedulerDomainRoundRobinWeight:     dc.GetIntPropertyFilteredByDomain(dynamicconfig.TaskSchedulerDomainRoundRobinWeight),
+		TaskSchedulerDomainMaxConcurrency:       dc.GetIntPropertyFilteredByDomain(dynamicconfig.TaskSchedulerDomainMaxConcurrency),
 		TaskCriticalRetryCount:                  dc.GetIntProperty(dynamicconfig.TaskCriticalRetryCount),
 		ActiveTaskRedispatchInterval:            dc.GetDurationProperty(dynamicconfig.ActiveTaskRedispatchInterval),
 		StandbyTaskRedispatchInterval:           dc.GetDurationProperty(dynamicconfig.StandbyTaskRedispatchInterval),
@@ -417,6 +458,8 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, s
 		QueueProcessorPendingTaskSplitThreshold:            dc.GetMapProperty(dynamicconfig.QueueProcessorPendingTaskSplitThreshold),
 		QueueProcessorEnableStuckTaskSplitByDomainID:       dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.QueueProcessorEnableStuckTaskSplitByDomainID),
 		QueueProcessorStuckTaskSplitThreshold:              dc.GetMapProperty(dynamicconfig.QueueProcessorStuckTaskSplitThreshold),
+		QueueProcessorSplitDomainLevels:                    dc.GetMapProperty(dynamicconfig.QueueProcessorSplitDomainLevels),
+		QueueProcessorThrottleSplitDomainByDomainID:        dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.QueueProcessorThrottleSplitDomainByDomainID),
 		QueueProcessorSplitLookAheadDurationByDomainID:     dc.GetDurationPropertyFilteredByDomainID(dynamicconfig.QueueProcessorSplitLookAheadDurationByDomainID),
 		QueueProcessorPollBackoffInterval:                  dc.GetDurationProperty(dynamicconfig.QueueProcessorPollBackoffInterval),
 		QueueProcessorPollBackoffIntervalJitterCoefficient: dc.GetFloat64Property(dynamicconfig.QueueProcessorPollBackoffIntervalJitterCoefficient),
@@ -440,6 +483,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, s
 		TimerProcessorSplitQueueIntervalJitterCoefficient: dc.GetFloat64Property(dynamicconfig.TimerProcessorSplitQueueIntervalJitterCoefficient),
 		TimerProcessorMaxRedispatchQueueSize:              dc.GetIntProperty(dynamicconfig.TimerProcessorMaxRedispatchQueueSize),
 		TimerProcessorMaxTimeShift:                        dc.GetDurationProperty(dynamicconfig.TimerProcessorMaxTimeShift),
+		TimerProcessorFarFutureTimerHorizon:               dc.GetDurationProperty(dynamicconfig.TimerProcessorFarFutureTimerHorizon),
 		TimerProcessorHistoryArchivalSizeLimit:            dc.GetIntProperty(dynamicconfig.TimerProcessorHistoryArchivalSizeLimit),
 		TimerProcessorArchivalTimeLimit:                   dc.GetDurationProperty(dynamicconfig.TimerProcessorArchivalTimeLimit),
 
@@ -490,13 +534,14 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, s
 		ReplicatorUpperLatency:                 dc.GetDurationProperty(dynamicconfig.ReplicatorUpperLatency),
 		ReplicatorCacheCapacity:                dc.GetIntProperty(dynamicconfig.ReplicatorCacheCapacity),
 
-		ExecutionMgrNumConns:            dc.GetIntProperty(dynamicconfig.ExecutionMgrNumConns),
-		HistoryMgrNumConns:              dc.GetIntProperty(dynamicconfig.HistoryMgrNumConns),
-		MaximumBufferedEventsBatch:      dc.GetIntProperty(dynamicconfig.MaximumBufferedEventsBatch),
-		MaximumSignalsPerExecution:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaximumSignalsPerExecution),
-		ShardUpdateMinInterval:          dc.GetDurationProperty(dynamicconfig.ShardUpdateMinInterval),
-		ShardSyncMinInterval:            dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval),
-		ShardSyncTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.TransferProcessorMaxPollIntervalJitterCoefficient),
+		ExecutionMgrNumConns:              dc.GetIntProperty(dynamicconfig.ExecutionMgrNumConns),
+		HistoryMgrNumConns:                dc.GetIntProperty(dynamicconfig.HistoryMgrNumConns),
+		MaximumBufferedEventsBatch:        dc.GetIntProperty(dynamicconfig.MaximumBufferedEventsBatch),
+		MaximumSignalsPerExecution:        dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaximumSignalsPerExecution),
+		MaximumPendingSignalsPerExecution: dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaximumPendingSignalsPerExecution),
+		ShardUpdateMinInterval:            dc.GetDurationProperty(dynamicconfig.ShardUpdateMinInterval),
+		ShardSyncMinInterval:              dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval),
+		ShardSyncTimerJitterCoefficient:   dc.GetFloat64Property(dynamicconfig.TransferProcessorMaxPollIntervalJitterCoefficient),
 
 		// history client: client/history/client.go set the client timeout 30s
 		LongPollExpirationInterval:          dc.GetDurationPropertyFilteredByDomain(dynamicconfig.HistoryLongPollExpirationInterval),
@@ -516,10 +561,10 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, s
 
 		BlobSizeLimitError:               dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitError),
 		BlobSizeLimitWarn:                dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitWarn),
-		HistorySizeLimitError:            dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistorySizeLimitError),
-		HistorySizeLimitWarn:             dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistorySizeLimitWarn),
-		HistoryCountLimitError:           dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitError),
-		HistoryCountLimitWarn:            dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitWarn),
+		HistorySizeLimitError:            dc.GetIntPropertyFilteredByWorkflowType(dynamicconfig.HistorySizeLimitError),
+		HistorySizeLimitWarn:             dc.GetIntPropertyFilteredByWorkflowType(dynamicconfig.HistorySizeLimitWarn),
+		HistoryCountLimitError:           dc.GetIntPropertyFilteredByWorkflowType(dynamicconfig.HistoryCountLimitError),
+		HistoryCountLimitWarn:            dc.GetIntPropertyFilteredByWorkflowType(dynamicconfig.HistoryCountLimitWarn),
 		PendingActivitiesCountLimitError: dc.GetIntProperty(dynamicconfig.PendingActivitiesCountLimitError),
 		PendingActivitiesCountLimitWarn:  dc.GetIntProperty(dynamicconfig.PendingActivitiesCountLimitWarn),
 		PendingActivityValidationEnabled: dc.GetBoolProperty(dynamicconfig.EnablePendingActivityValidation),
@@ -543,6 +588,9 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, s
 		ReplicationTaskFetcherTimerJitterCoefficient:       dc.GetFloat64Property(dynamicconfig.ReplicationTaskFetcherTimerJitterCoefficient),
 		ReplicationTaskFetcherErrorRetryWait:               dc.GetDurationProperty(dynamicconfig.ReplicationTaskFetcherErrorRetryWait),
 		ReplicationTaskFetcherServiceBusyWait:              dc.GetDurationProperty(dynamicconfig.ReplicationTaskFetcherServiceBusyWait),
+		ReplicationTaskFetcherStreamEnabled:                dc.GetBoolProperty(dynamicconfig.ReplicationTaskFetcherStreamEnabled),
+		ReplicationTaskFetcherStreamRetryWait:              dc.GetDurationProperty(dynamicconfig.ReplicationTaskFetcherStreamRetryWait),
+		ReplicationMessagesLongPollTimeout:                 dc.GetDurationProperty(dynamicconfig.ReplicationMessagesLongPollTimeout),
 		ReplicationTaskFetcherEnableGracefulSyncShutdown:   dc.GetBoolProperty(dynamicconfig.ReplicationTaskFetcherEnableGracefulSyncShutdown),
 		ReplicationTaskProcessorErrorRetryWait:             dc.GetDurationPropertyFilteredByShardID(dynamicconfig.ReplicationTaskProcessorErrorRetryWait),
 		ReplicationTaskProcessorErrorRetryMaxAttempts:      dc.GetIntPropertyFilteredByShardID(dynamicconfig.ReplicationTaskProcessorErrorRetryMaxAttempts),
@@ -560,18 +608,38 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, s
 		EnableReplicationTaskGeneration:                    dc.GetBoolPropertyFilteredByDomainIDAndWorkflowID(dynamicconfig.EnableReplicationTaskGeneration),
 		EnableRecordWorkflowExecutionUninitialized:         dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableRecordWorkflowExecutionUninitialized),
 
+		EnableWorkflowLifecycleEvents: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableWorkflowLifecycleEvents),
+		EnableWorkflowUsageMetrics:    dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableWorkflowUsageMetrics),
+		WorkflowUsageFlushInterval:    dc.GetDurationProperty(dynamicconfig.WorkflowUsageFlushInterval),
+		WorkflowUsageRetention:        dc.GetDurationProperty(dynamicconfig.WorkflowUsageRetention),
+
+		EnableReplicationDLQRedrive:         dc.GetBoolProperty(dynamicconfig.EnableReplicationDLQRedrive),
+		ReplicationDLQRedriveInterval:       dc.GetDurationPropertyFilteredByShardID(dynamicconfig.ReplicationDLQRedriveInterval),
+		ReplicationDLQRedriveBatchSize:      dc.GetIntPropertyFilteredByShardID(dynamicconfig.ReplicationDLQRedriveBatchSize),
+		ReplicationDLQRedriveInitialBackoff: dc.GetDurationPropertyFilteredByShardID(dynamicconfig.ReplicationDLQRedriveInitialBackoff),
+		ReplicationDLQRedriveMaxBackoff:     dc.GetDurationPropertyFilteredByShardID(dynamicconfig.ReplicationDLQRedriveMaxBackoff),
+		ReplicationDLQRedriveDomainRPS:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.ReplicationDLQRedriveDomainRPS),
+
 		WorkflowIDCacheExternalEnabled:     dc.GetBoolPropertyFilteredByDomain(dynamicconfig.WorkflowIDCacheExternalEnabled),
 		WorkflowIDCacheInternalEnabled:     dc.GetBoolPropertyFilteredByDomain(dynamicconfig.WorkflowIDCacheInternalEnabled),
 		WorkflowIDExternalRateLimitEnabled: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.WorkflowIDExternalRateLimitEnabled),
+		WorkflowIDQueryRateLimitEnabled:    dc.GetBoolPropertyFilteredByDomain(dynamicconfig.WorkflowIDQueryRateLimitEnabled),
 		WorkflowIDInternalRateLimitEnabled: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.WorkflowIDInternalRateLimitEnabled),
 		WorkflowIDExternalRPS:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.WorkflowIDExternalRPS),
 		WorkflowIDInternalRPS:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.WorkflowIDInternalRPS),
+		WorkflowIDSignalRPS:                dc.GetIntPropertyFilteredByDomain(dynamicconfig.WorkflowIDSignalRPS),
+		WorkflowIDSignalWithStartRPS:       dc.GetIntPropertyFilteredByDomain(dynamicconfig.WorkflowIDSignalWithStartRPS),
+		WorkflowIDStartRPS:                 dc.GetIntPropertyFilteredByDomain(dynamicconfig.WorkflowIDStartRPS),
+		WorkflowIDQueryRPS:                 dc.GetIntPropertyFilteredByDomain(dynamicconfig.WorkflowIDQueryRPS),
+		WorkflowIDDescribeRPS:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.WorkflowIDDescribeRPS),
 
 		EnableConsistentQuery:                 dc.GetBoolProperty(dynamicconfig.EnableConsistentQuery),
 		EnableConsistentQueryByDomain:         dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableConsistentQueryByDomain),
 		EnableCrossClusterEngine:              dc.GetBoolProperty(dynamicconfig.EnableCrossClusterEngine),
 		EnableCrossClusterOperationsForDomain: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableCrossClusterOperationsForDomain),
 		MaxBufferedQueryCount:                 dc.GetIntProperty(dynamicconfig.MaxBufferedQueryCount),
+		EnableClosedWorkflowQueryCache:        dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableClosedWorkflowQueryCache),
+		ClosedWorkflowQueryCacheMaxSize:       dc.GetIntPropertyFilteredByDomain(dynamicconfig.ClosedWorkflowQueryCacheMaxSize),
 		MutableStateChecksumGenProbability:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateChecksumGenProbability),
 		MutableStateChecksumVerifyProbability: dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateChecksumVerifyProbability),
 		MutableStateChecksumInvalidateBefore:  dc.GetFloat64Property(dynamicconfig.MutableStateChecksumInvalidateBefore),
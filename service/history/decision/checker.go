@@ -188,6 +188,17 @@ func (c *workflowSizeChecker) failWorkflowSizeExceedsLimit() (bool, error) {
 	return false, nil
 }
 
+// isContinueAsNewSuggested returns true once the workflow history exceeds the warn limit on size or event count,
+// so that the worker can continue as new before the workflow is failed by the error limit
+func isContinueAsNewSuggested(
+	historySize int64,
+	historyCount int64,
+	historySizeLimitWarn int,
+	historyCountLimitWarn int,
+) bool {
+	return historySize > int64(historySizeLimitWarn) || historyCount > int64(historyCountLimitWarn)
+}
+
 func (v *attrValidator) validateActivityScheduleAttributes(
 	domainID string,
 	targetDomainID string,
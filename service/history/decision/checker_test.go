@@ -756,3 +756,36 @@ func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_WithRetryPol
 	s.Nil(err)
 	s.Equal(expectedAttributesAfterValidation, attributes)
 }
+
+func TestIsContinueAsNewSuggested(t *testing.T) {
+	testCases := []struct {
+		name         string
+		historySize  int64
+		historyCount int64
+		expected     bool
+	}{
+		{
+			name:         "below warn limit",
+			historySize:  100,
+			historyCount: 100,
+		},
+		{
+			name:         "size exceeds warn limit",
+			historySize:  101,
+			historyCount: 10,
+			expected:     true,
+		},
+		{
+			name:         "count exceeds warn limit",
+			historySize:  10,
+			historyCount: 101,
+			expected:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, isContinueAsNewSuggested(tc.historySize, tc.historyCount, 100, 100))
+		})
+	}
+}
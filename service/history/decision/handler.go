@@ -177,6 +177,10 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return nil, workflow.ErrNotExists
 			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				// matching drops the task, it is generated again when the workflow is unpaused
+				return nil, workflow.ErrNotExists
+			}
 
 			decision, isRunning := mutableState.GetDecisionInfo(scheduleID)
 
@@ -320,6 +324,9 @@ func (handler *handlerImpl) HandleDecisionTaskCompleted(
 	clientFeatureVersion := call.Header(common.FeatureVersionHeaderName)
 	clientImpl := call.Header(common.ClientImplHeaderName)
 
+	closedWorkflowQueryResults, queryResults := query.SplitClosedWorkflowResults(request.GetQueryResults())
+	encodedClosedWorkflowQueryResults := handler.encodeClosedWorkflowQueryResults(closedWorkflowQueryResults, domainName, workflowExecution)
+
 	wfContext, release, err := handler.executionCache.GetOrCreateWorkflowExecution(ctx, domainID, workflowExecution)
 	if err != nil {
 		return nil, err
@@ -427,10 +434,10 @@ Update_History_Loop:
 			workflowSizeChecker := newWorkflowSizeChecker(
 				handler.config.BlobSizeLimitWarn(domainName),
 				handler.config.BlobSizeLimitError(domainName),
-				handler.config.HistorySizeLimitWarn(domainName),
-				handler.config.HistorySizeLimitError(domainName),
-				handler.config.HistoryCountLimitWarn(domainName),
-				handler.config.HistoryCountLimitError(domainName),
+				handler.config.HistorySizeLimitWarn(domainName, executionInfo.WorkflowTypeName),
+				handler.config.HistorySizeLimitError(domainName, executionInfo.WorkflowTypeName),
+				handler.config.HistoryCountLimitWarn(domainName, executionInfo.WorkflowTypeName),
+				handler.config.HistoryCountLimitError(domainName, executionInfo.WorkflowTypeName),
 				completedEvent.ID,
 				msBuilder,
 				executionStats,
@@ -487,6 +494,11 @@ Update_History_Loop:
 			continueAsNewBuilder = nil
 		}
 
+		// the results pre-computed by the decision are only kept if it closed the workflow
+		if encodedClosedWorkflowQueryResults != nil && !msBuilder.IsWorkflowExecutionRunning() {
+			msBuilder.GetExecutionInfo().ClosedWorkflowQueryResults = encodedClosedWorkflowQueryResults
+		}
+
 		createNewDecisionTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
 		logger.Debugf("createNewDecisionTask: %v, msBuilder.IsWorkflowExecutionRunning: %v, hasUnhandledEvents: %v, request.GetForceCreateNewDecisionTask: %v, activityNotStartedCancelled: %v",
 			createNewDecisionTask, msBuilder.IsWorkflowExecutionRunning(), hasUnhandledEvents, request.GetForceCreateNewDecisionTask(), activityNotStartedCancelled)
@@ -590,7 +602,7 @@ Update_History_Loop:
 			msBuilder,
 			clientImpl,
 			clientFeatureVersion,
-			req.GetCompleteRequest().GetQueryResults(),
+			queryResults,
 			createNewDecisionTask,
 			domainEntry,
 			decisionHeartbeating)
@@ -696,6 +708,14 @@ func (handler *handlerImpl) createRecordDecisionTaskStartedResponse(
 	}
 	response.Queries = queries
 	response.HistorySize = msBuilder.GetHistorySize()
+
+	domainName := msBuilder.GetDomainEntry().GetInfo().Name
+	response.ContinueAsNewSuggested = isContinueAsNewSuggested(
+		response.HistorySize,
+		response.NextEventID-1,
+		handler.config.HistorySizeLimitWarn(domainName, executionInfo.WorkflowTypeName),
+		handler.config.HistoryCountLimitWarn(domainName, executionInfo.WorkflowTypeName),
+	)
 	return response, nil
 }
 
@@ -845,6 +865,43 @@ func (handler *handlerImpl) handleBufferedQueries(
 	}
 }
 
+// encodeClosedWorkflowQueryResults encodes the query results pre-computed by the decision in case it closes
+// the workflow, so that queries can be answered once the workers of the workflow are gone
+func (handler *handlerImpl) encodeClosedWorkflowQueryResults(
+	closedWorkflowQueryResults map[string]*types.WorkflowQueryResult,
+	domainName string,
+	workflowExecution types.WorkflowExecution,
+) []byte {
+	if len(closedWorkflowQueryResults) == 0 || !handler.config.EnableClosedWorkflowQueryCache(domainName) {
+		return nil
+	}
+
+	encoded, dropped, err := query.EncodeClosedWorkflowResults(
+		closedWorkflowQueryResults,
+		handler.config.ClosedWorkflowQueryCacheMaxSize(domainName),
+	)
+	if err != nil {
+		handler.logger.Error("failed to record query results of closed workflow",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowID(workflowExecution.GetWorkflowID()),
+			tag.WorkflowRunID(workflowExecution.GetRunID()),
+			tag.Error(err))
+		return nil
+	}
+	for _, key := range dropped {
+		handler.metricsClient.Scope(
+			metrics.HistoryRespondDecisionTaskCompletedScope,
+			metrics.DomainTag(domainName),
+		).IncCounter(metrics.ClosedWorkflowQueryResultDroppedCount)
+		handler.throttledLogger.Warn("dropping query result of closed workflow because the results exceed the size limit",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowID(workflowExecution.GetWorkflowID()),
+			tag.WorkflowRunID(workflowExecution.GetRunID()),
+			tag.QueryID(key))
+	}
+	return encoded
+}
+
 func (handler *handlerImpl) failDecisionHelper(
 	ctx context.Context,
 	wfContext execution.Context,
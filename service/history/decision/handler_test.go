@@ -858,7 +858,8 @@ func (s *DecisionHandlerSuite) TestCreateRecordDecisionTaskStartedResponse() {
 				registry.EXPECT().GetBufferedIDs().Return([]string{"test-id", "test-id1", "test-id2"})
 				registry.EXPECT().GetQueryInput(gomock.Any()).Return(&types.WorkflowQuery{}, nil).Times(2)
 				registry.EXPECT().GetQueryInput(gomock.Any()).Return(nil, &types.InternalServiceError{Message: "query does not exist"})
-				s.mockMutableState.EXPECT().GetHistorySize()
+				s.mockMutableState.EXPECT().GetHistorySize().Return(int64(100 * 1024 * 1024))
+				s.mockMutableState.EXPECT().GetDomainEntry().Return(constants.TestLocalDomainEntry)
 			},
 			expectedErr: nil,
 			indexes:     []string{"test-id", "test-id1"},
@@ -893,6 +894,7 @@ func (s *DecisionHandlerSuite) TestCreateRecordDecisionTaskStartedResponse() {
 				s.Equal(&types.HistoryEvent{}, resp.DecisionInfo.ScheduledEvent)
 				s.Equal(&types.HistoryEvent{}, resp.DecisionInfo.StartedEvent)
 				s.Equal([]byte{}, resp.BranchToken)
+				s.True(resp.ContinueAsNewSuggested)
 				for _, index := range test.indexes {
 					_, ok := resp.Queries[index]
 					s.True(ok)
@@ -946,6 +948,30 @@ func (s *DecisionHandlerSuite) TestHandleBufferedQueries_QueryTooLarge() {
 	s.assertQueryCounts(s.queryRegistry, 0, 5, 0, 5)
 }
 
+func (s *DecisionHandlerSuite) TestEncodeClosedWorkflowQueryResults() {
+	closedWorkflowResults := s.constructQueryResults([]string{
+		query.ClosedWorkflowResultKey("a"),
+		query.ClosedWorkflowResultKey("b"),
+	}, 10)
+	workflowExecution := types.WorkflowExecution{WorkflowID: constants.TestWorkflowID, RunID: constants.TestRunID}
+
+	s.Nil(s.decisionHandler.encodeClosedWorkflowQueryResults(closedWorkflowResults, constants.TestDomainName, workflowExecution))
+
+	s.decisionHandler.throttledLogger = s.decisionHandler.logger
+	s.decisionHandler.config.EnableClosedWorkflowQueryCache = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	s.decisionHandler.config.ClosedWorkflowQueryCacheMaxSize = dynamicconfig.GetIntPropertyFilteredByDomain(15)
+	s.Nil(s.decisionHandler.encodeClosedWorkflowQueryResults(nil, constants.TestDomainName, workflowExecution))
+
+	encodedResults := s.decisionHandler.encodeClosedWorkflowQueryResults(closedWorkflowResults, constants.TestDomainName, workflowExecution)
+	answer, ok, err := query.GetClosedWorkflowResult(encodedResults, "a")
+	s.NoError(err)
+	s.True(ok)
+	s.Equal(make([]byte, 10), answer)
+	_, ok, err = query.GetClosedWorkflowResult(encodedResults, "b")
+	s.NoError(err)
+	s.False(ok)
+}
+
 func (s *DecisionHandlerSuite) TestHandleBufferedQueries_QueryRegistryFailures() {
 	tests := []struct {
 		name                 string
@@ -43,6 +43,7 @@ import (
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/dynamicconfig"
 	ce "github.com/uber/cadence/common/errors"
+	"github.com/uber/cadence/common/lifecycle"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -158,6 +159,7 @@ func NewEngineWithShardContext(
 	failoverCoordinator failover.Coordinator,
 	wfIDCache workflowcache.WFCache,
 	ratelimitInternalPerWorkflowID dynamicconfig.BoolPropertyFnWithDomainFilter,
+	lifecyclePublisher lifecycle.Publisher,
 ) engine.Engine {
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 
@@ -265,6 +267,7 @@ func NewEngineWithShardContext(
 		openExecutionCheck,
 		historyEngImpl.wfIDCache,
 		historyEngImpl.ratelimitInternalPerWorkflowID,
+		lifecyclePublisher,
 	)
 
 	historyEngImpl.timerProcessor = queue.NewTimerQueueProcessor(
@@ -878,6 +881,14 @@ func (e *historyEngineImpl) startWorkflowHelper(
 	if err != nil {
 		return nil, err
 	}
+	execution.RecordWorkflowUsage(
+		e.shard,
+		domainID,
+		domainEntry.GetInfo().Name,
+		request.WorkflowType.GetName(),
+		newWorkflowEventsSeq,
+		int64(len(historyBlob.Data)),
+	)
 
 	return &types.StartWorkflowExecutionResponse{
 		RunID: workflowExecution.RunID,
@@ -1282,6 +1293,28 @@ func (e *historyEngineImpl) QueryWorkflow(
 		return nil, &types.EntityNotExistsError{Message: "Workflow execution corrupted."}
 	}
 
+	// closed workflows may have the result pre-computed by their last decision, which doesn't need a worker
+	if !mutableState.IsWorkflowExecutionRunning() && e.config.EnableClosedWorkflowQueryCache(de.GetInfo().Name) {
+		answer, ok, err := query.GetClosedWorkflowResult(
+			mutableState.GetExecutionInfo().ClosedWorkflowQueryResults,
+			req.GetQuery().GetQueryType(),
+		)
+		if err != nil {
+			e.logger.Warn("Failed to read the query results of closed workflow, dispatching the query.",
+				tag.WorkflowDomainName(de.GetInfo().Name),
+				tag.WorkflowID(execution.GetWorkflowID()),
+				tag.WorkflowRunID(execution.GetRunID()),
+				tag.Error(err))
+		} else if ok {
+			scope.IncCounter(metrics.ClosedWorkflowQueryCacheHitCount)
+			return &types.HistoryQueryWorkflowResponse{
+				Response: &types.QueryWorkflowResponse{
+					QueryResult: answer,
+				},
+			}, nil
+		}
+	}
+
 	// There are two ways in which queries get dispatched to decider. First, queries can be dispatched on decision tasks.
 	// These decision tasks potentially contain new events and queries. The events are treated as coming before the query in time.
 	// The second way in which queries are dispatched to decider is directly through matching; in this approach queries can be
@@ -1585,9 +1618,67 @@ func (e *historyEngineImpl) DescribeMutableState(
 		return nil, err
 	}
 
+	if request.RebuildFromHistory {
+		rebuiltMutableState, err := e.rebuildMutableState(ctx, msb)
+		if err != nil {
+			return nil, err
+		}
+		response.MutableStateRebuiltFromHistory, err = e.toMutableStateJSON(rebuiltMutableState)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return response, nil
 }
 
+// rebuildMutableState replays the current branch of the workflow's history into a new mutable state,
+// nothing is persisted. Version histories are copied from the given mutable state, as only the current
+// branch is replayed and the rebuilder verifies it ends at the same event.
+func (e *historyEngineImpl) rebuildMutableState(
+	ctx context.Context,
+	mutableState execution.MutableState,
+) (execution.MutableState, error) {
+
+	versionHistories := mutableState.GetVersionHistories()
+	if versionHistories == nil {
+		return nil, &types.BadRequestError{Message: "Rebuilding mutable state requires version histories."}
+	}
+	currentVersionHistory, err := versionHistories.GetCurrentVersionHistory()
+	if err != nil {
+		return nil, err
+	}
+	lastItem, err := currentVersionHistory.GetLastItem()
+	if err != nil {
+		return nil, err
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	workflowIdentifier := definition.NewWorkflowIdentifier(
+		executionInfo.DomainID,
+		executionInfo.WorkflowID,
+		executionInfo.RunID,
+	)
+	rebuiltMutableState, _, err := execution.NewStateRebuilder(e.shard, e.logger).Rebuild(
+		ctx,
+		executionInfo.StartTimestamp,
+		workflowIdentifier,
+		currentVersionHistory.GetBranchToken(),
+		lastItem.EventID,
+		lastItem.Version,
+		workflowIdentifier,
+		currentVersionHistory.GetBranchToken(),
+		executionInfo.CreateRequestID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := rebuiltMutableState.SetVersionHistories(versionHistories.Duplicate()); err != nil {
+		return nil, err
+	}
+	return rebuiltMutableState, nil
+}
+
 func (e *historyEngineImpl) toMutableStateJSON(msb execution.MutableState) (string, error) {
 	ms := msb.CopyToPersistence()
 
@@ -1683,6 +1774,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			UpdateTime:       common.Int64Ptr(executionInfo.LastUpdatedTimestamp.UnixNano()),
 			SearchAttributes: &types.SearchAttributes{IndexedFields: executionInfo.SearchAttributes},
 			PartitionConfig:  executionInfo.PartitionConfig,
+			IsPaused:         mutableState.IsWorkflowExecutionPaused(),
 		},
 	}
 
@@ -1723,6 +1815,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 		for _, ai := range mutableState.GetPendingActivityInfos() {
 			p := &types.PendingActivityInfo{
 				ActivityID: ai.ActivityID,
+				Paused:     ai.Paused,
 			}
 			state := types.PendingActivityStateScheduled
 			if ai.CancelRequested {
@@ -1839,6 +1932,10 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return workflow.ErrNotExists
 			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				// matching drops the task, it is generated again when the workflow is unpaused
+				return workflow.ErrNotExists
+			}
 
 			scheduleID := request.GetScheduleID()
 			requestID := request.GetRequestID()
@@ -1898,6 +1995,10 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				e.logger.Debug("Potentially duplicate task.", tag.TaskID(request.GetTaskID()), tag.WorkflowScheduleID(scheduleID), tag.TaskType(persistence.TransferTaskTypeActivityTask))
 				return workflow.ErrActivityTaskNotFound
 			}
+			if ai.Paused && ai.StartedID == common.EmptyEventID {
+				// matching drops the task, it is scheduled again when the activity is unpaused
+				return workflow.ErrActivityTaskNotFound
+			}
 
 			scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, scheduleID)
 			if err != nil {
@@ -2143,6 +2244,14 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 			}
 
 			postActions := &workflow.UpdateAction{}
+			// the attempt is captured before RetryActivity moves the activity to its next attempt
+			lifecycleEvent := e.newLifecycleEvent(lifecycle.EventTypeActivityFailed, domainEntry, mutableState)
+			lifecycleEvent.EventID = scheduleID
+			lifecycleEvent.ActivityID = ai.ActivityID
+			lifecycleEvent.ActivityType = token.ActivityType
+			lifecycleEvent.Attempt = ai.Attempt
+			lifecycleEvent.FailureReason = request.GetReason()
+			lifecycleEvent.Identity = request.GetIdentity()
 			ok, err := mutableState.RetryActivity(ai, req.FailedRequest.GetReason(), req.FailedRequest.GetDetails())
 			if err != nil {
 				return nil, err
@@ -2155,6 +2264,10 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 				}
 				postActions.CreateDecision = true
 			}
+			lifecycleEvent.WillRetry = ok
+			if err := e.addLifecycleEventTask(domainEntry, mutableState, lifecycleEvent); err != nil {
+				return nil, err
+			}
 
 			activityStartedTime = ai.StartedTime
 			taskList = ai.TaskList
@@ -2500,6 +2613,9 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 					tag.WorkflowDomainID(domainID))
 				return nil, workflow.ErrSignalsLimitExceeded
 			}
+			if err := e.checkPendingSignalsLimit(domainEntry.GetInfo().Name, mutableState); err != nil {
+				return nil, err
+			}
 
 			if childWorkflowOnly {
 				parentWorkflowID := executionInfo.ParentWorkflowID
@@ -2522,6 +2638,13 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 			); err != nil {
 				return nil, &types.InternalServiceError{Message: "Unable to signal workflow execution."}
 			}
+			if err := e.addLifecycleEventTask(
+				domainEntry,
+				mutableState,
+				e.newSignaledLifecycleEvent(domainEntry, mutableState, request.GetSignalName(), request.GetIdentity()),
+			); err != nil {
+				return nil, err
+			}
 
 			return &workflow.UpdateAction{
 				Noop:           false,
@@ -2530,6 +2653,90 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 		})
 }
 
+// newLifecycleEvent creates a workflow lifecycle event for the run of the mutable state,
+// the caller sets the fields specific to the event type
+func (e *historyEngineImpl) newLifecycleEvent(
+	eventType lifecycle.EventType,
+	domainEntry *cache.DomainCacheEntry,
+	mutableState execution.MutableState,
+) *lifecycle.Event {
+	executionInfo := mutableState.GetExecutionInfo()
+	return &lifecycle.Event{
+		EventType:    eventType,
+		DomainID:     domainEntry.GetInfo().ID,
+		DomainName:   domainEntry.GetInfo().Name,
+		WorkflowID:   executionInfo.WorkflowID,
+		RunID:        executionInfo.RunID,
+		WorkflowType: executionInfo.WorkflowTypeName,
+		TaskList:     executionInfo.TaskList,
+		IsCron:       executionInfo.CronSchedule != "",
+		StartTime:    executionInfo.StartTimestamp.UnixNano(),
+	}
+}
+
+// newSignaledLifecycleEvent creates the workflow lifecycle event of a signal which was just added
+// to the mutable state, the signal count is its sequence number within the run
+func (e *historyEngineImpl) newSignaledLifecycleEvent(
+	domainEntry *cache.DomainCacheEntry,
+	mutableState execution.MutableState,
+	signalName string,
+	identity string,
+) *lifecycle.Event {
+	event := e.newLifecycleEvent(lifecycle.EventTypeWorkflowSignaled, domainEntry, mutableState)
+	event.EventID = int64(mutableState.GetExecutionInfo().SignalCount)
+	event.SignalName = signalName
+	event.Identity = identity
+	return event
+}
+
+// addLifecycleEventTask adds a transfer task which publishes the workflow lifecycle event once the
+// mutable state is persisted, if the domain opted in to the workflow lifecycle stream. The event is
+// published by the task executor, which retries the task until the publish succeeds.
+func (e *historyEngineImpl) addLifecycleEventTask(
+	domainEntry *cache.DomainCacheEntry,
+	mutableState execution.MutableState,
+	event *lifecycle.Event,
+) error {
+	if !e.config.EnableWorkflowLifecycleEvents(domainEntry.GetInfo().Name) {
+		return nil
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	mutableState.AddTransferTasks(&persistence.RecordWorkflowLifecycleEventTask{
+		TaskData: persistence.TaskData{
+			// TaskID and VisibilityTimestamp are set by shard context
+			Version: mutableState.GetCurrentVersion(),
+		},
+		LifecycleEvent: data,
+	})
+	return nil
+}
+
+// checkPendingSignalsLimit rejects a new signal if the workflow already has too many signals waiting to be
+// processed by a decision
+func (e *historyEngineImpl) checkPendingSignalsLimit(
+	domainName string,
+	mutableState execution.MutableState,
+) error {
+	maxPendingSignals := e.config.MaximumPendingSignalsPerExecution(domainName)
+	if maxPendingSignals <= 0 {
+		return nil
+	}
+	pending := mutableState.GetPendingSignalCount()
+	if pending < int64(maxPendingSignals) {
+		return nil
+	}
+	executionInfo := mutableState.GetExecutionInfo()
+	e.logger.Info("Execution limit reached for maximum pending signals",
+		tag.Counter(int(pending)),
+		tag.WorkflowID(executionInfo.WorkflowID),
+		tag.WorkflowRunID(executionInfo.RunID),
+		tag.WorkflowDomainID(executionInfo.DomainID))
+	return workflow.ErrPendingSignalsLimitExceeded
+}
+
 func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	signalWithStartRequest *types.HistorySignalWithStartWorkflowExecutionRequest,
@@ -2614,6 +2821,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 					tag.WorkflowDomainID(domainID))
 				return nil, workflow.ErrSignalsLimitExceeded
 			}
+			if err := e.checkPendingSignalsLimit(domainEntry.GetInfo().Name, mutableState); err != nil {
+				return nil, err
+			}
 
 			requestID := sRequest.GetRequestID()
 			if requestID != "" {
@@ -2628,6 +2838,13 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 			); err != nil {
 				return nil, &types.InternalServiceError{Message: "Unable to signal workflow execution."}
 			}
+			if err := e.addLifecycleEventTask(
+				domainEntry,
+				mutableState,
+				e.newSignaledLifecycleEvent(domainEntry, mutableState, sRequest.GetSignalName(), sRequest.GetIdentity()),
+			); err != nil {
+				return nil, err
+			}
 
 			// Create a transfer task to schedule a decision task
 			if !mutableState.HasPendingDecision() {
@@ -2813,6 +3030,249 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(
 		})
 }
 
+// PauseWorkflowExecution records WorkflowExecutionPaused event for a running workflow execution,
+// its decision, activity, timer and transfer tasks are not dispatched until it is unpaused
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *types.HistoryPauseWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainByID(pauseRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := pauseRequest.PauseRequest
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.WorkflowID,
+		RunID:      request.WorkflowExecution.RunID,
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		e.shard.GetDomainCache(),
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				return &workflow.UpdateAction{
+					Noop:           true,
+					CreateDecision: false,
+				}, nil
+			}
+
+			if _, err := mutableState.AddWorkflowExecutionPausedEvent(
+				request.GetReason(),
+				request.GetIdentity(),
+				request.GetRequestID(),
+			); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to pause workflow execution."}
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+// UnpauseWorkflowExecution records WorkflowExecutionUnpaused event for a paused workflow execution
+// and generates again the tasks that were dropped while it was paused
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	unpauseRequest *types.HistoryUnpauseWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainByID(unpauseRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := unpauseRequest.UnpauseRequest
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.WorkflowID,
+		RunID:      request.WorkflowExecution.RunID,
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		e.shard.GetDomainCache(),
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+			if !mutableState.IsWorkflowExecutionPaused() {
+				return &workflow.UpdateAction{
+					Noop:           true,
+					CreateDecision: false,
+				}, nil
+			}
+
+			if _, err := mutableState.AddWorkflowExecutionUnpausedEvent(
+				request.GetReason(),
+				request.GetIdentity(),
+				request.GetRequestID(),
+			); err != nil {
+				return nil, &types.InternalServiceError{Message: "Unable to unpause workflow execution."}
+			}
+			// tasks of a paused workflow are dropped when they are due,
+			// so generate them again now that it can make progress
+			if err := execution.NewMutableStateTaskRefresher(
+				e.shard.GetConfig(),
+				e.shard.GetClusterMetadata(),
+				e.shard.GetDomainCache(),
+				e.shard.GetEventsCache(),
+				e.shard.GetShardID(),
+			).RefreshTasks(ctx, mutableState.GetExecutionInfo().StartTimestamp, mutableState); err != nil {
+				return nil, err
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
+// PauseActivity holds back a pending activity from being dispatched until it is unpaused
+func (e *historyEngineImpl) PauseActivity(
+	ctx context.Context,
+	pauseRequest *types.HistoryPauseActivityRequest,
+) error {
+
+	request := pauseRequest.PauseRequest
+	return e.updatePendingActivity(
+		ctx,
+		pauseRequest.DomainUUID,
+		request.WorkflowExecution,
+		request.GetActivityID(),
+		func(mutableState execution.MutableState, ai *persistence.ActivityInfo) (bool, error) {
+			if ai.Paused {
+				return false, nil
+			}
+			return true, mutableState.PauseActivity(ai)
+		},
+	)
+}
+
+// UnpauseActivity releases a paused activity and schedules it again if it is not started
+func (e *historyEngineImpl) UnpauseActivity(
+	ctx context.Context,
+	unpauseRequest *types.HistoryUnpauseActivityRequest,
+) error {
+
+	request := unpauseRequest.UnpauseRequest
+	return e.updatePendingActivity(
+		ctx,
+		unpauseRequest.DomainUUID,
+		request.WorkflowExecution,
+		request.GetActivityID(),
+		func(mutableState execution.MutableState, ai *persistence.ActivityInfo) (bool, error) {
+			if !ai.Paused {
+				return false, nil
+			}
+			return true, mutableState.UnpauseActivity(ai)
+		},
+	)
+}
+
+// ResetActivity sets the retry attempt of a pending activity back to zero
+func (e *historyEngineImpl) ResetActivity(
+	ctx context.Context,
+	resetRequest *types.HistoryResetActivityRequest,
+) error {
+
+	request := resetRequest.ResetRequest
+	return e.updatePendingActivity(
+		ctx,
+		resetRequest.DomainUUID,
+		request.WorkflowExecution,
+		request.GetActivityID(),
+		func(mutableState execution.MutableState, ai *persistence.ActivityInfo) (bool, error) {
+			return true, mutableState.ResetActivityAttempt(ai)
+		},
+	)
+}
+
+// UpdateActivityOptions changes the timeouts and retry policy of a pending activity
+func (e *historyEngineImpl) UpdateActivityOptions(
+	ctx context.Context,
+	updateRequest *types.HistoryUpdateActivityOptionsRequest,
+) error {
+
+	request := updateRequest.UpdateRequest
+	return e.updatePendingActivity(
+		ctx,
+		updateRequest.DomainUUID,
+		request.WorkflowExecution,
+		request.GetActivityID(),
+		func(mutableState execution.MutableState, ai *persistence.ActivityInfo) (bool, error) {
+			return true, mutableState.UpdateActivityOptions(ai, &execution.ActivityOptions{
+				ScheduleToStartTimeoutSeconds: request.ScheduleToStartTimeoutSeconds,
+				ScheduleToCloseTimeoutSeconds: request.ScheduleToCloseTimeoutSeconds,
+				StartToCloseTimeoutSeconds:    request.StartToCloseTimeoutSeconds,
+				HeartbeatTimeoutSeconds:       request.HeartbeatTimeoutSeconds,
+				RetryPolicy:                   request.RetryPolicy,
+			})
+		},
+	)
+}
+
+// updatePendingActivity applies an update to a pending activity of the current run of a workflow.
+// The update returns false when the activity is already in the requested state.
+func (e *historyEngineImpl) updatePendingActivity(
+	ctx context.Context,
+	domainUUID string,
+	workflowExecution *types.WorkflowExecution,
+	activityID string,
+	update func(mutableState execution.MutableState, ai *persistence.ActivityInfo) (bool, error),
+) error {
+
+	domainEntry, err := e.getActiveDomainByID(domainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		e.shard.GetDomainCache(),
+		types.WorkflowExecution{
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+			ai, ok := mutableState.GetActivityByActivityID(activityID)
+			if !ok {
+				return nil, workflow.ErrActivityTaskNotFound
+			}
+
+			updated, err := update(mutableState, ai)
+			if err != nil {
+				return nil, err
+			}
+			if !updated {
+				return &workflow.UpdateAction{
+					Noop:           true,
+					CreateDecision: false,
+				}, nil
+			}
+			return workflow.UpdateWithoutDecision, nil
+		})
+}
+
 // RecordChildExecutionCompleted records the completion of child execution into parent execution history
 func (e *historyEngineImpl) RecordChildExecutionCompleted(
 	ctx context.Context,
@@ -3122,6 +3582,9 @@ func (e *historyEngineImpl) NotifyNewReplicationTasks(info *hcommon.NotifyTaskIn
 		}
 		e.replicationTaskStore.Put(hTask)
 	}
+	if len(info.Tasks) > 0 {
+		e.replicationAckManager.NotifyNewTasks()
+	}
 }
 
 func hydrateReplicationTask(
@@ -3208,13 +3671,37 @@ func (e *historyEngineImpl) DescribeCrossClusterQueue(
 	ctx context.Context,
 	clusterName string,
 ) (*types.DescribeQueueResponse, error) {
-	return e.describeQueue(ctx, e.crossClusterProcessor, clusterName)
+	return e.describeQueueStates(ctx, e.crossClusterProcessor, clusterName)
 }
 
+// describeQueue returns one JSON encoded queue.ProcessingQueueInfo for each processing queue
 func (e *historyEngineImpl) describeQueue(
 	ctx context.Context,
 	queueProcessor queue.Processor,
 	clusterName string,
+) (*types.DescribeQueueResponse, error) {
+	resp, err := queueProcessor.HandleAction(ctx, clusterName, queue.NewDescribeAction())
+	if err != nil {
+		return nil, err
+	}
+
+	serializedQueues := make([]string, 0, len(resp.DescribeResult.Queues))
+	for _, queueInfo := range resp.DescribeResult.Queues {
+		serializedQueue, err := json.Marshal(queueInfo)
+		if err != nil {
+			return nil, err
+		}
+		serializedQueues = append(serializedQueues, string(serializedQueue))
+	}
+	return &types.DescribeQueueResponse{
+		ProcessingQueueStates: serializedQueues,
+	}, nil
+}
+
+func (e *historyEngineImpl) describeQueueStates(
+	ctx context.Context,
+	queueProcessor queue.Processor,
+	clusterName string,
 ) (*types.DescribeQueueResponse, error) {
 	resp, err := queueProcessor.HandleAction(ctx, clusterName, queue.NewGetStateAction())
 	if err != nil {
@@ -3474,6 +3961,11 @@ func (e *historyEngineImpl) GetReplicationMessages(
 	return replicationMessages, nil
 }
 
+// GetReplicationTasksNotifyCh returns a channel which is closed once new replication tasks are written
+func (e *historyEngineImpl) GetReplicationTasksNotifyCh() <-chan struct{} {
+	return e.replicationAckManager.GetNewTasksNotifyCh()
+}
+
 func (e *historyEngineImpl) GetDLQReplicationMessages(
 	ctx context.Context,
 	taskInfos []*types.ReplicationTaskInfo,
@@ -3666,6 +4158,7 @@ func (e *historyEngineImpl) ReadDLQMessages(
 		request.GetInclusiveEndMessageID(),
 		int(request.GetMaximumPageSize()),
 		request.GetNextPageToken(),
+		request.GetFilter(),
 	)
 	if err != nil {
 		return nil, err
@@ -3701,6 +4194,7 @@ func (e *historyEngineImpl) MergeDLQMessages(
 		request.GetInclusiveEndMessageID(),
 		int(request.GetMaximumPageSize()),
 		request.GetNextPageToken(),
+		request.GetFilter(),
 	)
 	if err != nil {
 		return nil, err
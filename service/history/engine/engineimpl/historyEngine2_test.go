@@ -38,6 +38,8 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/log/testlogger"
@@ -1088,6 +1090,40 @@ func (s *engine2Suite) TestStartWorkflowExecution_BrandNew() {
 	s.NotNil(resp.RunID)
 }
 
+func (s *engine2Suite) TestStartWorkflowExecution_BrandNew_RecordWorkflowUsage() {
+	domainID := constants.TestDomainID
+	workflowType := "workflowType"
+	s.config.EnableWorkflowUsageMetrics = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	defer func() {
+		s.config.EnableWorkflowUsageMetrics = dynamicconfig.GetBoolPropertyFnFilteredByDomain(false)
+	}()
+
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{
+		DataBlob: p.DataBlob{Data: []byte{1, 2, 3}},
+	}, nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything, mock.Anything).Return(&p.CreateWorkflowExecutionResponse{}, nil).Once()
+	s.mockShard.Resource.WorkflowUsageRecorder.EXPECT().Record(&domain.WorkflowUsage{
+		DomainID:     domainID,
+		WorkflowType: workflowType,
+		HistoryBytes: 3,
+	})
+
+	_, err := s.historyEngine.StartWorkflowExecution(context.Background(), &types.HistoryStartWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		StartRequest: &types.StartWorkflowExecutionRequest{
+			Domain:                              domainID,
+			WorkflowID:                          "workflowID",
+			WorkflowType:                        &types.WorkflowType{Name: workflowType},
+			TaskList:                            &types.TaskList{Name: "testTaskList"},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            "testIdentity",
+			RequestID:                           uuid.New(),
+		},
+	})
+	s.Nil(err)
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_BrandNew_DuplicateRequestError() {
 	domainID := constants.TestDomainID
 	workflowID := "workflowID"
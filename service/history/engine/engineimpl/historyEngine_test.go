@@ -44,7 +44,9 @@ import (
 	cc "github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/lifecycle"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -478,6 +480,52 @@ func (s *engineSuite) TestQueryWorkflow_RejectBasedOnCompleted() {
 	s.Equal(types.WorkflowExecutionCloseStatusCompleted.Ptr(), resp.GetResponse().GetQueryRejected().CloseStatus)
 }
 
+func (s *engineSuite) TestQueryWorkflow_ClosedWorkflowQueryCache() {
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "TestQueryWorkflow_ClosedWorkflowQueryCache",
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		workflowExecution.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	event := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	di.StartedID = event.ID
+	event = test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, di.StartedID, nil, "some random identity")
+	test.AddCompleteWorkflowEvent(msBuilder, event.ID, nil)
+	encodedResults, _, err := query.EncodeClosedWorkflowResults(map[string]*types.WorkflowQueryResult{
+		query.ClosedWorkflowResultKey("state"): {
+			ResultType: types.QueryResultTypeAnswered.Ptr(),
+			Answer:     []byte("closed state"),
+		},
+	}, 1024)
+	s.NoError(err)
+	msBuilder.GetExecutionInfo().ClosedWorkflowQueryResults = encodedResults
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gweResponse, nil).Once()
+	s.mockHistoryEngine.config.EnableClosedWorkflowQueryCache = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+
+	request := &types.HistoryQueryWorkflowRequest{
+		DomainUUID: constants.TestDomainID,
+		Request: &types.QueryWorkflowRequest{
+			Execution: &workflowExecution,
+			Query:     &types.WorkflowQuery{QueryType: "state"},
+		},
+	}
+	resp, err := s.mockHistoryEngine.QueryWorkflow(context.Background(), request)
+	s.NoError(err)
+	s.Equal([]byte("closed state"), resp.GetResponse().GetQueryResult())
+	s.Nil(resp.GetResponse().GetQueryRejected())
+}
+
 func (s *engineSuite) TestQueryWorkflow_RejectBasedOnFailed() {
 	workflowExecution := types.WorkflowExecution{
 		WorkflowID: "TestQueryWorkflow_RejectBasedOnFailed",
@@ -3378,7 +3426,12 @@ func (s *engineSuite) TestRespondActivityTaskFailedSuccess() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+	var published []*lifecycle.Event
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		published = s.getLifecycleEvents(request.UpdateWorkflowMutation.TransferTasks)
+		return true
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockHistoryEngine.config.EnableWorkflowLifecycleEvents = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
 
 	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &types.HistoryRespondActivityTaskFailedRequest{
 		DomainUUID: constants.TestDomainID,
@@ -3390,6 +3443,16 @@ func (s *engineSuite) TestRespondActivityTaskFailedSuccess() {
 		},
 	})
 	s.Nil(err)
+	s.Len(published, 1)
+	s.Equal(lifecycle.EventTypeActivityFailed, published[0].EventType)
+	s.Equal(constants.TestDomainName, published[0].DomainName)
+	s.Equal(we.WorkflowID, published[0].WorkflowID)
+	s.Equal(we.RunID, published[0].RunID)
+	s.Equal(activityScheduledEvent.ID, published[0].EventID)
+	s.Equal(activityID, published[0].ActivityID)
+	s.Equal(failReason, published[0].FailureReason)
+	s.Equal(identity, published[0].Identity)
+	s.False(published[0].WillRetry)
 	executionBuilder := s.getBuilder(constants.TestDomainID, we)
 	s.Equal(int64(9), executionBuilder.GetExecutionInfo().NextEventID)
 	s.Equal(int64(3), executionBuilder.GetExecutionInfo().LastProcessedEvent)
@@ -4939,10 +5002,40 @@ func (s *engineSuite) TestSignalWorkflowExecution() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+	var published []*lifecycle.Event
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		published = s.getLifecycleEvents(request.UpdateWorkflowMutation.TransferTasks)
+		return true
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockHistoryEngine.config.EnableWorkflowLifecycleEvents = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
 
 	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
 	s.Nil(err)
+	s.Equal([]*lifecycle.Event{{
+		EventType:    lifecycle.EventTypeWorkflowSignaled,
+		EventID:      1,
+		DomainID:     constants.TestDomainID,
+		DomainName:   constants.TestDomainName,
+		WorkflowID:   we.WorkflowID,
+		RunID:        we.RunID,
+		WorkflowType: "wType",
+		TaskList:     tasklist,
+		StartTime:    ms.ExecutionInfo.StartTimestamp.UnixNano(),
+		SignalName:   signalName,
+		Identity:     identity,
+	}}, published)
+}
+
+func (s *engineSuite) getLifecycleEvents(transferTasks []persistence.Task) []*lifecycle.Event {
+	var events []*lifecycle.Event
+	for _, task := range transferTasks {
+		if lifecycleTask, ok := task.(*persistence.RecordWorkflowLifecycleEventTask); ok {
+			event := &lifecycle.Event{}
+			s.NoError(json.Unmarshal(lifecycleTask.LifecycleEvent, event))
+			events = append(events, event)
+		}
+	}
+	return events
 }
 
 // Test signal decision by adding request ID
@@ -5071,6 +5164,411 @@ func (s *engineSuite) TestSignalWorkflowExecution_WorkflowCompleted() {
 	s.EqualError(err, "workflow execution already completed")
 }
 
+func (s *engineSuite) TestSignalWorkflowExecution_PendingSignalsLimitExceeded() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        "my signal name",
+			Input:             []byte("test input"),
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	msBuilder.AddWorkflowExecutionSignaled("signal1", nil, identity, "")
+	msBuilder.AddWorkflowExecutionSignaled("signal2", nil, identity, "")
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockHistoryEngine.config.MaximumPendingSignalsPerExecution = dynamicconfig.GetIntPropertyFilteredByDomain(2)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Equal(workflow.ErrPendingSignalsLimitExceeded, err)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_PendingSignalsLimitNotExceeded() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        "my signal name",
+			Input:             []byte("test input"),
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	msBuilder.AddWorkflowExecutionSignaled("signal1", nil, identity, "")
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockHistoryEngine.config.MaximumPendingSignalsPerExecution = dynamicconfig.GetIntPropertyFilteredByDomain(2)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestPauseWorkflowExecution() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	pauseRequest := &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		PauseRequest: &types.PauseWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Reason:            "maintenance",
+			Identity:          identity,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.MatchedBy(func(request *persistence.AppendHistoryNodesRequest) bool {
+		return len(request.Events) == 1 && request.Events[0].GetEventType() == types.EventTypeWorkflowExecutionPaused
+	})).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return string(request.UpdateWorkflowMutation.ExecutionInfo.SearchAttributes[definition.CadencePaused]) == "true"
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), pauseRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestPauseWorkflowExecution_AlreadyPaused() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	pauseRequest := &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		PauseRequest: &types.PauseWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	ms.ExecutionInfo.SearchAttributes = map[string][]byte{definition.CadencePaused: []byte("true")}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), pauseRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestUnpauseWorkflowExecution() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	unpauseRequest := &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		UnpauseRequest: &types.UnpauseWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	ms.ExecutionInfo.SearchAttributes = map[string][]byte{definition.CadencePaused: []byte("true")}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	history := msBuilder.GetHistoryBuilder().GetHistory()
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: history.Events}, nil).Maybe()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.MatchedBy(func(request *persistence.AppendHistoryNodesRequest) bool {
+		return len(request.Events) == 1 && request.Events[0].GetEventType() == types.EventTypeWorkflowExecutionUnpaused
+	})).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		if string(request.UpdateWorkflowMutation.ExecutionInfo.SearchAttributes[definition.CadencePaused]) != "false" {
+			return false
+		}
+		// the dropped decision task is generated again
+		for _, task := range request.UpdateWorkflowMutation.TransferTasks {
+			if _, ok := task.(*persistence.DecisionTask); ok {
+				return true
+			}
+		}
+		return false
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.UnpauseWorkflowExecution(context.Background(), unpauseRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestUnpauseWorkflowExecution_NotPaused() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	unpauseRequest := &types.HistoryUnpauseWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		UnpauseRequest: &types.UnpauseWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.UnpauseWorkflowExecution(context.Background(), unpauseRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestPauseActivity() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	activityID := "activity1_id"
+	ms := s.buildMutableStateWithScheduledActivity(we, activityID)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		activityInfos := request.UpdateWorkflowMutation.UpsertActivityInfos
+		return len(activityInfos) == 1 && activityInfos[0].ActivityID == activityID && activityInfos[0].Paused
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.PauseActivity(context.Background(), &types.HistoryPauseActivityRequest{
+		DomainUUID: constants.TestDomainID,
+		PauseRequest: &types.PauseActivityRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			ActivityID:        activityID,
+		},
+	})
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestPauseActivity_NotFound() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	ms := s.buildMutableStateWithScheduledActivity(we, "activity1_id")
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.PauseActivity(context.Background(), &types.HistoryPauseActivityRequest{
+		DomainUUID: constants.TestDomainID,
+		PauseRequest: &types.PauseActivityRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			ActivityID:        "activity2_id",
+		},
+	})
+	s.Equal(workflow.ErrActivityTaskNotFound, err)
+}
+
+func (s *engineSuite) TestUnpauseActivity() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	activityID := "activity1_id"
+	ms := s.buildMutableStateWithScheduledActivity(we, activityID)
+	for _, ai := range ms.ActivityInfos {
+		ai.Paused = true
+	}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		activityInfos := request.UpdateWorkflowMutation.UpsertActivityInfos
+		if len(activityInfos) != 1 || activityInfos[0].Paused {
+			return false
+		}
+		for _, task := range request.UpdateWorkflowMutation.TimerTasks {
+			if _, ok := task.(*persistence.ActivityRetryTimerTask); ok {
+				return true
+			}
+		}
+		return false
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.UnpauseActivity(context.Background(), &types.HistoryUnpauseActivityRequest{
+		DomainUUID: constants.TestDomainID,
+		UnpauseRequest: &types.UnpauseActivityRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			ActivityID:        activityID,
+		},
+	})
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestUnpauseActivity_NotPaused() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	activityID := "activity1_id"
+	ms := s.buildMutableStateWithScheduledActivity(we, activityID)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.UnpauseActivity(context.Background(), &types.HistoryUnpauseActivityRequest{
+		DomainUUID: constants.TestDomainID,
+		UnpauseRequest: &types.UnpauseActivityRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			ActivityID:        activityID,
+		},
+	})
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestUpdateActivityOptions() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	activityID := "activity1_id"
+	ms := s.buildMutableStateWithScheduledActivity(we, activityID)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		activityInfos := request.UpdateWorkflowMutation.UpsertActivityInfos
+		return len(activityInfos) == 1 && activityInfos[0].StartToCloseTimeout == 30 && activityInfos[0].MaximumAttempts == 3
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.UpdateActivityOptions(context.Background(), &types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID: constants.TestDomainID,
+		UpdateRequest: &types.UpdateActivityOptionsRequest{
+			Domain:                     constants.TestDomainID,
+			WorkflowExecution:          &we,
+			ActivityID:                 activityID,
+			StartToCloseTimeoutSeconds: common.Int32Ptr(30),
+			RetryPolicy: &types.RetryPolicy{
+				InitialIntervalInSeconds: 1,
+				BackoffCoefficient:       2,
+				MaximumAttempts:          3,
+			},
+		},
+	})
+	s.Nil(err)
+}
+
+func (s *engineSuite) buildMutableStateWithScheduledActivity(
+	we types.WorkflowExecution,
+	activityID string,
+) *persistence.WorkflowMutableState {
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.ID, nil, identity)
+	test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, activityID,
+		"activity_type1", tl, []byte("input1"), 100, 10, 1, 0)
+
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	return ms
+}
+
 func (s *engineSuite) TestRemoveSignalMutableState() {
 	removeRequest := &types.RemoveSignalMutableStateRequest{}
 	err := s.mockHistoryEngine.RemoveSignalMutableState(context.Background(), removeRequest)
@@ -55,6 +55,12 @@ type (
 		SignalWithStartWorkflowExecution(ctx context.Context, request *types.HistorySignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 		RemoveSignalMutableState(ctx context.Context, request *types.RemoveSignalMutableStateRequest) error
 		TerminateWorkflowExecution(ctx context.Context, request *types.HistoryTerminateWorkflowExecutionRequest) error
+		PauseWorkflowExecution(ctx context.Context, request *types.HistoryPauseWorkflowExecutionRequest) error
+		UnpauseWorkflowExecution(ctx context.Context, request *types.HistoryUnpauseWorkflowExecutionRequest) error
+		PauseActivity(ctx context.Context, request *types.HistoryPauseActivityRequest) error
+		UnpauseActivity(ctx context.Context, request *types.HistoryUnpauseActivityRequest) error
+		ResetActivity(ctx context.Context, request *types.HistoryResetActivityRequest) error
+		UpdateActivityOptions(ctx context.Context, request *types.HistoryUpdateActivityOptionsRequest) error
 		ResetWorkflowExecution(ctx context.Context, request *types.HistoryResetWorkflowExecutionRequest) (*types.ResetWorkflowExecutionResponse, error)
 		ScheduleDecisionTask(ctx context.Context, request *types.ScheduleDecisionTaskRequest) error
 		RecordChildExecutionCompleted(ctx context.Context, request *types.RecordChildExecutionCompletedRequest) error
@@ -62,6 +68,7 @@ type (
 		SyncShardStatus(ctx context.Context, request *types.SyncShardStatusRequest) error
 		SyncActivity(ctx context.Context, request *types.SyncActivityRequest) error
 		GetReplicationMessages(ctx context.Context, pollingCluster string, lastReadMessageID int64) (*types.ReplicationMessages, error)
+		GetReplicationTasksNotifyCh() <-chan struct{}
 		GetDLQReplicationMessages(ctx context.Context, taskInfos []*types.ReplicationTaskInfo) ([]*types.ReplicationTask, error)
 		GetCrossClusterTasks(ctx context.Context, targetCluster string) ([]*types.CrossClusterTaskRequest, error)
 		RespondCrossClusterTasksCompleted(ctx context.Context, targetCluster string, responses []*types.CrossClusterTaskResponse) error
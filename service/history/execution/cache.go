@@ -296,6 +296,10 @@ func (c *Cache) makeReleaseFunc(
 					if err != nil || forceClearContext {
 						// TODO see issue #668, there are certain type or errors which can bypass the clear
 						context.Clear()
+					} else if c.isLongSleeping(context) {
+						// the workflow won't make progress for a long time, reload mutable state when it wakes up
+						// instead of keeping it in memory for the whole sleep
+						context.Clear()
 					}
 					context.Unlock()
 					c.Release(key)
@@ -305,6 +309,45 @@ func (c *Cache) makeReleaseFunc(
 	}
 }
 
+// isLongSleeping returns true if the workflow has nothing to do until its next user timer fires or
+// it times out, and that is at least HistoryCacheLongSleepThreshold away
+func (c *Cache) isLongSleeping(
+	context Context,
+) bool {
+
+	threshold := c.config.HistoryCacheLongSleepThreshold()
+	if threshold <= 0 {
+		return false
+	}
+
+	mutableState := context.GetWorkflowExecution()
+	if mutableState == nil ||
+		!mutableState.IsWorkflowExecutionRunning() ||
+		mutableState.HasPendingDecision() ||
+		mutableState.HasInFlightDecision() ||
+		mutableState.HasBufferedEvents() ||
+		len(mutableState.GetPendingActivityInfos()) != 0 ||
+		len(mutableState.GetPendingChildExecutionInfos()) != 0 ||
+		len(mutableState.GetPendingRequestCancelExternalInfos()) != 0 ||
+		len(mutableState.GetPendingSignalExternalInfos()) != 0 {
+		return false
+	}
+
+	var wakeUpTime time.Time
+	executionInfo := mutableState.GetExecutionInfo()
+	if executionInfo.WorkflowTimeout > 0 {
+		wakeUpTime = executionInfo.StartTimestamp.Add(time.Duration(executionInfo.WorkflowTimeout) * time.Second)
+	}
+	timerSequenceIDs := NewTimerSequence(mutableState).LoadAndSortUserTimers()
+	if len(timerSequenceIDs) != 0 && (wakeUpTime.IsZero() || timerSequenceIDs[0].Timestamp.Before(wakeUpTime)) {
+		wakeUpTime = timerSequenceIDs[0].Timestamp
+	}
+	if wakeUpTime.IsZero() {
+		return false
+	}
+	return wakeUpTime.Sub(c.shard.GetTimeSource().Now()) >= threshold
+}
+
 func (c *Cache) getCurrentExecutionWithRetry(
 	ctx context.Context,
 	request *persistence.GetCurrentExecutionRequest,
@@ -24,6 +24,7 @@ import (
 	"errors"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
@@ -178,6 +179,71 @@ func (s *historyCacheSuite) TestHistoryCacheClear() {
 	release(nil)
 }
 
+func (s *historyCacheSuite) TestHistoryCacheClear_LongSleep() {
+	s.mockShard.GetConfig().HistoryCacheLongSleepThreshold = dynamicconfig.GetDurationPropertyFn(24 * time.Hour)
+	domainID := "test_domain_id"
+	s.cache = NewCache(s.mockShard)
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain_name", nil).AnyTimes()
+
+	testCases := []struct {
+		timerExpiry     time.Duration
+		workflowTimeout time.Duration
+		pendingChild    bool
+		cleared         bool
+	}{
+		{timerExpiry: 30 * 24 * time.Hour, workflowTimeout: 90 * 24 * time.Hour, cleared: true},
+		{timerExpiry: time.Hour, workflowTimeout: 90 * 24 * time.Hour, cleared: false},
+		{timerExpiry: 30 * 24 * time.Hour, workflowTimeout: 90 * 24 * time.Hour, pendingChild: true, cleared: false},
+		{timerExpiry: 30 * 24 * time.Hour, workflowTimeout: time.Hour, cleared: false},
+		{workflowTimeout: 60 * 24 * time.Hour, cleared: true},
+		{workflowTimeout: time.Hour, cleared: false},
+	}
+
+	for _, tc := range testCases {
+		we := types.WorkflowExecution{
+			WorkflowID: "wf-cache-test-long-sleep",
+			RunID:      uuid.New(),
+		}
+		mockMS := NewMockMutableState(s.controller)
+		mockMS.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
+		mockMS.EXPECT().HasPendingDecision().Return(false).AnyTimes()
+		mockMS.EXPECT().HasInFlightDecision().Return(false).AnyTimes()
+		mockMS.EXPECT().HasBufferedEvents().Return(false).AnyTimes()
+		mockMS.EXPECT().GetPendingActivityInfos().Return(nil).AnyTimes()
+		pendingChildren := map[int64]*persistence.ChildExecutionInfo{}
+		if tc.pendingChild {
+			pendingChildren[5] = &persistence.ChildExecutionInfo{}
+		}
+		mockMS.EXPECT().GetPendingChildExecutionInfos().Return(pendingChildren).AnyTimes()
+		mockMS.EXPECT().GetPendingRequestCancelExternalInfos().Return(nil).AnyTimes()
+		mockMS.EXPECT().GetPendingSignalExternalInfos().Return(nil).AnyTimes()
+		timerInfos := map[string]*persistence.TimerInfo{}
+		if tc.timerExpiry != 0 {
+			timerInfos["timer"] = &persistence.TimerInfo{TimerID: "timer", StartedID: 5, ExpiryTime: s.mockShard.GetTimeSource().Now().Add(tc.timerExpiry)}
+		}
+		mockMS.EXPECT().GetPendingTimerInfos().Return(timerInfos).AnyTimes()
+		mockMS.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+			StartTimestamp:  s.mockShard.GetTimeSource().Now(),
+			WorkflowTimeout: int32(tc.workflowTimeout.Seconds()),
+		}).AnyTimes()
+
+		context, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground(domainID, we)
+		s.Nil(err)
+		context.(*contextImpl).mutableState = mockMS
+		release(nil)
+
+		context, release, err = s.cache.GetOrCreateWorkflowExecutionForBackground(domainID, we)
+		s.Nil(err)
+		if tc.cleared {
+			s.Nil(context.(*contextImpl).mutableState)
+		} else {
+			s.NotNil(context.(*contextImpl).mutableState)
+		}
+		context.(*contextImpl).mutableState = nil
+		release(nil)
+	}
+}
+
 func (s *historyCacheSuite) TestHistoryCacheConcurrentAccess() {
 	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(20)
 	domainID := "test_domain_id"
@@ -26,6 +26,7 @@ import (
 	checksumgen "github.com/uber/cadence/.gen/go/checksum"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
 
@@ -53,8 +54,50 @@ func verifyMutableStateChecksum(
 	return checksum.Verify(payload, csum)
 }
 
+// VerifyWorkflowMutableStateChecksum verifies the checksum stored along with a copy of
+// workflow mutable state, e.g. the one returned by DescribeMutableState
+func VerifyWorkflowMutableStateChecksum(
+	state *persistence.WorkflowMutableState,
+) error {
+	if state.ExecutionInfo == nil {
+		return fmt.Errorf("mutable state has no execution info")
+	}
+	if state.Checksum.Version != mutableStateChecksumPayloadV1 {
+		return fmt.Errorf("invalid checksum payload version %v", state.Checksum.Version)
+	}
+	payload := newChecksumPayload(
+		state.ExecutionInfo,
+		state.VersionHistories,
+		state.TimerInfos,
+		state.ActivityInfos,
+		state.ChildExecutionInfos,
+		state.SignalInfos,
+		state.RequestCancelInfos,
+	)
+	return checksum.Verify(payload, state.Checksum)
+}
+
 func newMutableStateChecksumPayload(ms MutableState) *checksumgen.MutableStateChecksumPayload {
-	executionInfo := ms.GetExecutionInfo()
+	return newChecksumPayload(
+		ms.GetExecutionInfo(),
+		ms.GetVersionHistories(),
+		ms.GetPendingTimerInfos(),
+		ms.GetPendingActivityInfos(),
+		ms.GetPendingChildExecutionInfos(),
+		ms.GetPendingSignalExternalInfos(),
+		ms.GetPendingRequestCancelExternalInfos(),
+	)
+}
+
+func newChecksumPayload(
+	executionInfo *persistence.WorkflowExecutionInfo,
+	versionHistories *persistence.VersionHistories,
+	timerInfos map[string]*persistence.TimerInfo,
+	activityInfos map[int64]*persistence.ActivityInfo,
+	childInfos map[int64]*persistence.ChildExecutionInfo,
+	signalInfos map[int64]*persistence.SignalInfo,
+	requestCancelInfos map[int64]*persistence.RequestCancelInfo,
+) *checksumgen.MutableStateChecksumPayload {
 	payload := &checksumgen.MutableStateChecksumPayload{
 		CancelRequested:      common.BoolPtr(executionInfo.CancelRequested),
 		State:                common.Int16Ptr(int16(executionInfo.State)),
@@ -69,43 +112,42 @@ func newMutableStateChecksumPayload(ms MutableState) *checksumgen.MutableStateCh
 		StickyTaskListName:   common.StringPtr(executionInfo.StickyTaskList),
 	}
 
-	versionHistories := ms.GetVersionHistories()
 	if versionHistories != nil {
 		payload.VersionHistories = thrift.FromVersionHistories(versionHistories.ToInternalType())
 	}
 
 	// for each of the pendingXXX ids below, sorting is needed to guarantee that
 	// same serialized bytes can be generated during verification
-	pendingTimerIDs := make([]int64, 0, len(ms.GetPendingTimerInfos()))
-	for _, ti := range ms.GetPendingTimerInfos() {
+	pendingTimerIDs := make([]int64, 0, len(timerInfos))
+	for _, ti := range timerInfos {
 		pendingTimerIDs = append(pendingTimerIDs, ti.StartedID)
 	}
 	common.SortInt64Slice(pendingTimerIDs)
 	payload.PendingTimerStartedIDs = pendingTimerIDs
 
-	pendingActivityIDs := make([]int64, 0, len(ms.GetPendingActivityInfos()))
-	for id := range ms.GetPendingActivityInfos() {
+	pendingActivityIDs := make([]int64, 0, len(activityInfos))
+	for id := range activityInfos {
 		pendingActivityIDs = append(pendingActivityIDs, id)
 	}
 	common.SortInt64Slice(pendingActivityIDs)
 	payload.PendingActivityScheduledIDs = pendingActivityIDs
 
-	pendingChildIDs := make([]int64, 0, len(ms.GetPendingChildExecutionInfos()))
-	for id := range ms.GetPendingChildExecutionInfos() {
+	pendingChildIDs := make([]int64, 0, len(childInfos))
+	for id := range childInfos {
 		pendingChildIDs = append(pendingChildIDs, id)
 	}
 	common.SortInt64Slice(pendingChildIDs)
 	payload.PendingChildInitiatedIDs = pendingChildIDs
 
-	signalIDs := make([]int64, 0, len(ms.GetPendingSignalExternalInfos()))
-	for id := range ms.GetPendingSignalExternalInfos() {
+	signalIDs := make([]int64, 0, len(signalInfos))
+	for id := range signalInfos {
 		signalIDs = append(signalIDs, id)
 	}
 	common.SortInt64Slice(signalIDs)
 	payload.PendingSignalInitiatedIDs = signalIDs
 
-	requestCancelIDs := make([]int64, 0, len(ms.GetPendingRequestCancelExternalInfos()))
-	for id := range ms.GetPendingRequestCancelExternalInfos() {
+	requestCancelIDs := make([]int64, 0, len(requestCancelInfos))
+	for id := range requestCancelInfos {
 		requestCancelIDs = append(requestCancelIDs, id)
 	}
 	common.SortInt64Slice(requestCancelIDs)
@@ -195,6 +195,7 @@ type (
 		conflictResolveEventReapplyFn         func(persistence.ConflictResolveWorkflowMode, []*persistence.WorkflowEvents, []*persistence.WorkflowEvents) error
 		emitLargeWorkflowShardIDStatsFn       func(int64, int64, int64, int64)
 		emitWorkflowExecutionStatsFn          func(string, *persistence.MutableStateStats, int64)
+		recordWorkflowUsageFn                 func(string, string, string, []*persistence.WorkflowEvents, int64)
 		createMutableStateFn                  func(shard.Context, log.Logger, *cache.DomainCacheEntry) MutableState
 	}
 )
@@ -252,6 +253,9 @@ func NewContext(
 		emitWorkflowExecutionStatsFn: func(domainName string, stats *persistence.MutableStateStats, historySize int64) {
 			emitWorkflowExecutionStats(shard.GetMetricsClient(), domainName, stats, historySize)
 		},
+		recordWorkflowUsageFn: func(domainID, domainName, workflowType string, workflowEventsSeq []*persistence.WorkflowEvents, historySize int64) {
+			RecordWorkflowUsage(shard, domainID, domainName, workflowType, workflowEventsSeq, historySize)
+		},
 		mergeContinueAsNewReplicationTasksFn: mergeContinueAsNewReplicationTasks,
 		createMutableStateFn:                 NewMutableStateBuilder,
 	}
@@ -755,6 +759,7 @@ func (c *contextImpl) UpdateWorkflowExecutionWithNew(
 
 	var newWorkflow *persistence.WorkflowSnapshot
 	var newWorkflowEventsSeq []*persistence.WorkflowEvents
+	var newWorkflowAppendedSize int64
 	if newContext != nil && newMutableState != nil && newWorkflowTransactionPolicy != nil {
 		defer func() {
 			if retError != nil {
@@ -796,7 +801,8 @@ func (c *contextImpl) UpdateWorkflowExecutionWithNew(
 		}
 
 		persistedBlobs = append(persistedBlobs, blob)
-		newWorkflowSizeSize += int64(len(blob.Data))
+		newWorkflowAppendedSize = int64(len(blob.Data))
+		newWorkflowSizeSize += newWorkflowAppendedSize
 		newContext.SetHistorySize(newWorkflowSizeSize)
 		newWorkflow.ExecutionStats = &persistence.ExecutionStats{
 			HistorySize: newWorkflowSizeSize,
@@ -865,6 +871,13 @@ func (c *contextImpl) UpdateWorkflowExecutionWithNew(
 			c.emitWorkflowCompletionStatsFn(domain, workflowType, c.workflowExecution.GetWorkflowID(), c.workflowExecution.GetRunID(), taskList, event)
 		}
 	}
+	// only the events written by the active cluster are usage, replicated and rebuilt events are not
+	if currentWorkflowTransactionPolicy == TransactionPolicyActive {
+		c.recordWorkflowUsageFn(c.domainID, domain, currentWorkflow.ExecutionInfo.WorkflowTypeName, currentWorkflowEventsSeq, currentWorkflowSize-oldWorkflowSize)
+	}
+	if newWorkflow != nil && *newWorkflowTransactionPolicy == TransactionPolicyActive {
+		c.recordWorkflowUsageFn(newWorkflow.ExecutionInfo.DomainID, domain, newWorkflow.ExecutionInfo.WorkflowTypeName, newWorkflowEventsSeq, newWorkflowAppendedSize)
+	}
 
 	return nil
 }
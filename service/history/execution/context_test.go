@@ -1441,6 +1441,7 @@ func TestUpdateWorkflowExecutionWithNew(t *testing.T) {
 		mockEmitWorkflowHistoryStatsFn            func(string, int, int)
 		mockEmitLargeWorkflowShardIDStatsFn       func(int64, int64, int64, int64)
 		mockEmitWorkflowCompletionStatsFn         func(string, string, string, string, string, *types.HistoryEvent)
+		mockRecordWorkflowUsageFn                 func(string, string, string, []*persistence.WorkflowEvents, int64)
 		mockMergeContinueAsNewReplicationTasksFn  func(persistence.UpdateWorkflowMode, *persistence.WorkflowMutation, *persistence.WorkflowSnapshot) error
 		mockUpdateWorkflowExecutionEventReapplyFn func(persistence.UpdateWorkflowMode, []*persistence.WorkflowEvents, []*persistence.WorkflowEvents) error
 		wantErr                                   bool
@@ -1960,6 +1961,16 @@ func TestUpdateWorkflowExecutionWithNew(t *testing.T) {
 					ID: 123,
 				}, lastEvent, "case: success")
 			},
+			mockRecordWorkflowUsageFn: func(domainID string, domainName string, workflowType string, workflowEventsSeq []*persistence.WorkflowEvents, historySize int64) {
+				assert.Equal(t, "test-domain-id", domainID, "case: success")
+				assert.Equal(t, "test-domain", domainName, "case: success")
+				assert.Len(t, workflowEventsSeq, 1, "case: success")
+				if workflowEventsSeq[0].Events[0].ID == common.FirstEventID {
+					assert.Equal(t, int64(2), historySize, "case: success")
+				} else {
+					assert.Equal(t, int64(5), historySize, "case: success")
+				}
+			},
 		},
 	}
 
@@ -1975,6 +1986,7 @@ func TestUpdateWorkflowExecutionWithNew(t *testing.T) {
 				tc.mockSetup(mockShard, mockDomainCache, mockMutableState, mockNewMutableState, mockEngine)
 			}
 			ctx := &contextImpl{
+				domainID:                              "test-domain-id",
 				logger:                                testlogger.New(t),
 				shard:                                 mockShard,
 				mutableState:                          mockMutableState,
@@ -1991,6 +2003,7 @@ func TestUpdateWorkflowExecutionWithNew(t *testing.T) {
 				updateWorkflowExecutionEventReapplyFn: tc.mockUpdateWorkflowExecutionEventReapplyFn,
 				emitLargeWorkflowShardIDStatsFn:       tc.mockEmitLargeWorkflowShardIDStatsFn,
 				emitWorkflowCompletionStatsFn:         tc.mockEmitWorkflowCompletionStatsFn,
+				recordWorkflowUsageFn:                 tc.mockRecordWorkflowUsageFn,
 			}
 			err := ctx.UpdateWorkflowExecutionWithNew(context.Background(), time.Unix(0, 0), tc.updateMode, tc.newContext, mockNewMutableState, tc.currentWorkflowTransactionPolicy, tc.newWorkflowTransactionPolicy, tc.workflowRequestMode)
 			if tc.wantErr {
@@ -24,11 +24,13 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/shard"
 )
 
 func (c *contextImpl) emitLargeWorkflowShardIDStats(blobSize int64, oldHistoryCount int64, oldHistorySize int64, newHistoryCount int64) {
@@ -43,7 +45,7 @@ func (c *contextImpl) emitLargeWorkflowShardIDStats(blobSize int64, oldHistoryCo
 			c.metricsClient.Scope(metrics.LargeExecutionBlobShardScope, metrics.ShardIDTag(shardID)).IncCounter(metrics.LargeHistoryBlobCount)
 		}
 
-		historyCountWarn := common.MinInt64(int64(c.shard.GetConfig().LargeShardHistoryEventMetricThreshold()), int64(c.shard.GetConfig().HistoryCountLimitWarn(c.GetDomainName())))
+		historyCountWarn := common.MinInt64(int64(c.shard.GetConfig().LargeShardHistoryEventMetricThreshold()), int64(c.shard.GetConfig().HistoryCountLimitWarn(c.GetDomainName(), c.getWorkflowTypeName())))
 		// check if the new history count is greater than our threshold and only count/log it once when it passes it
 		// this seems to double count and I can't figure out why but should be ok to get a rough idea and identify bad actors
 		if oldHistoryCount < historyCountWarn && newHistoryCount >= historyCountWarn {
@@ -52,7 +54,7 @@ func (c *contextImpl) emitLargeWorkflowShardIDStats(blobSize int64, oldHistoryCo
 			c.metricsClient.Scope(metrics.LargeExecutionCountShardScope, metrics.ShardIDTag(shardID)).IncCounter(metrics.LargeHistoryEventCount)
 		}
 
-		historySizeWarn := common.MinInt64(int64(c.shard.GetConfig().LargeShardHistorySizeMetricThreshold()), int64(c.shard.GetConfig().HistorySizeLimitWarn(c.GetDomainName())))
+		historySizeWarn := common.MinInt64(int64(c.shard.GetConfig().LargeShardHistorySizeMetricThreshold()), int64(c.shard.GetConfig().HistorySizeLimitWarn(c.GetDomainName(), c.getWorkflowTypeName())))
 		// check if the new history size is greater than our threshold and only count/log it once when it passes it
 		if oldHistorySize < historySizeWarn && c.stats.HistorySize >= historySizeWarn {
 			c.logger.Warn("Workflow history event size is reaching dangerous levels", tag.WorkflowDomainName(c.GetDomainName()),
@@ -62,6 +64,13 @@ func (c *contextImpl) emitLargeWorkflowShardIDStats(blobSize int64, oldHistoryCo
 	}
 }
 
+func (c *contextImpl) getWorkflowTypeName() string {
+	if c.mutableState == nil {
+		return ""
+	}
+	return c.mutableState.GetExecutionInfo().WorkflowTypeName
+}
+
 func emitWorkflowHistoryStats(
 	metricsClient metrics.Client,
 	domainName string,
@@ -145,6 +154,82 @@ func emitSessionUpdateStats(
 	countScope.IncCounter(metrics.UpdateWorkflowExecutionCount)
 }
 
+// localActivityMarkerName is the name of the marker the clients record local activity results with
+const localActivityMarkerName = "LocalActivity"
+
+// RecordWorkflowUsage emits the usage counters of the history events the active cluster wrote for a workflow
+// and records them in the domain usage queue, if workflow usage metrics are enabled for the domain.
+// Events written by replication, conflict resolution or resets are not usage and must not be recorded.
+func RecordWorkflowUsage(
+	shard shard.Context,
+	domainID string,
+	domainName string,
+	workflowType string,
+	workflowEventsSeq []*persistence.WorkflowEvents,
+	historySize int64,
+) {
+	if !shard.GetConfig().EnableWorkflowUsageMetrics(domainName) {
+		return
+	}
+	usage := newWorkflowUsage(domainID, domainName, workflowType, workflowEventsSeq, historySize)
+	emitWorkflowUsageStats(shard.GetMetricsClient(), usage)
+	shard.GetWorkflowUsageRecorder().Record(usage)
+}
+
+func newWorkflowUsage(
+	domainID string,
+	domainName string,
+	workflowType string,
+	workflowEventsSeq []*persistence.WorkflowEvents,
+	historySize int64,
+) *domain.WorkflowUsage {
+
+	usage := &domain.WorkflowUsage{
+		DomainID:     domainID,
+		DomainName:   domainName,
+		WorkflowType: workflowType,
+		HistoryBytes: historySize,
+	}
+	for _, workflowEvents := range workflowEventsSeq {
+		for _, event := range workflowEvents.Events {
+			switch event.GetEventType() {
+			case types.EventTypeDecisionTaskStarted:
+				usage.DecisionTasks++
+			case types.EventTypeActivityTaskScheduled:
+				usage.ActivityTasks++
+			case types.EventTypeMarkerRecorded:
+				if event.GetMarkerRecordedEventAttributes().GetMarkerName() == localActivityMarkerName {
+					usage.LocalActivities++
+				}
+			case types.EventTypeWorkflowExecutionSignaled:
+				usage.Signals++
+			case types.EventTypeTimerStarted:
+				usage.Timers++
+			}
+		}
+	}
+	return usage
+}
+
+func emitWorkflowUsageStats(
+	metricsClient metrics.Client,
+	usage *domain.WorkflowUsage,
+) {
+
+	scope := metricsClient.Scope(
+		metrics.WorkflowUsageStatsScope,
+		metrics.DomainTag(usage.DomainName),
+		metrics.WorkflowTypeTag(usage.WorkflowType),
+	)
+
+	scope.AddCounter(metrics.WorkflowUsageHistoryBytes, usage.HistoryBytes)
+	scope.AddCounter(metrics.WorkflowUsageDecisionTaskCount, usage.DecisionTasks)
+	scope.AddCounter(metrics.WorkflowUsageActivityTaskCount, usage.ActivityTasks)
+	scope.AddCounter(metrics.WorkflowUsageLocalActivityCount, usage.LocalActivities)
+	scope.AddCounter(metrics.WorkflowUsageSignalCount, usage.Signals)
+	scope.AddCounter(metrics.WorkflowUsageTimerCount, usage.Timers)
+}
+
 func emitWorkflowCompletionStats(
 	metricsClient metrics.Client,
 	logger log.Logger,
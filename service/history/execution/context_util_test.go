@@ -0,0 +1,93 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package execution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestEmitWorkflowUsageStats(t *testing.T) {
+	testScope := tally.NewTestScope("test", nil)
+	metricsClient := metrics.NewClient(testScope, metrics.History)
+
+	historyEvents := []*types.HistoryEvent{
+		{EventType: types.EventTypeDecisionTaskStarted.Ptr()},
+		{EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+		{EventType: types.EventTypeActivityTaskScheduled.Ptr()},
+		{EventType: types.EventTypeActivityTaskScheduled.Ptr()},
+		{
+			EventType: types.EventTypeMarkerRecorded.Ptr(),
+			MarkerRecordedEventAttributes: &types.MarkerRecordedEventAttributes{
+				MarkerName: localActivityMarkerName,
+			},
+		},
+		{
+			EventType: types.EventTypeMarkerRecorded.Ptr(),
+			MarkerRecordedEventAttributes: &types.MarkerRecordedEventAttributes{
+				MarkerName: "Version",
+			},
+		},
+		{EventType: types.EventTypeWorkflowExecutionSignaled.Ptr()},
+		{EventType: types.EventTypeTimerStarted.Ptr()},
+	}
+	usage := newWorkflowUsage("test-domain-id", "test-domain", "test-workflow-type", []*persistence.WorkflowEvents{
+		{Events: historyEvents[:4]},
+		{Events: historyEvents[4:]},
+	}, 1234)
+	assert.Equal(t, &domain.WorkflowUsage{
+		DomainID:        "test-domain-id",
+		DomainName:      "test-domain",
+		WorkflowType:    "test-workflow-type",
+		DecisionTasks:   1,
+		ActivityTasks:   2,
+		LocalActivities: 1,
+		Signals:         1,
+		Timers:          1,
+		HistoryBytes:    1234,
+	}, usage)
+
+	emitWorkflowUsageStats(metricsClient, usage)
+
+	counters := map[string]int64{}
+	for _, counter := range testScope.Snapshot().Counters() {
+		assert.Equal(t, "test-domain", counter.Tags()["domain"])
+		assert.Equal(t, "test-workflow-type", counter.Tags()["workflowType"])
+		counters[counter.Name()] += counter.Value()
+	}
+	assert.Equal(t, map[string]int64{
+		"test.workflow_usage_decision_tasks":   1,
+		"test.workflow_usage_activity_tasks":   2,
+		"test.workflow_usage_local_activities": 1,
+		"test.workflow_usage_signals":          1,
+		"test.workflow_usage_timers":           1,
+		"test.workflow_usage_history_bytes":    1234,
+	}, counters)
+}
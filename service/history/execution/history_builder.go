@@ -355,6 +355,38 @@ func (b *HistoryBuilder) AddWorkflowExecutionTerminatedEvent(
 	return b.addEventToHistory(event)
 }
 
+// AddWorkflowExecutionPausedEvent adds WorkflowExecutionPaused event to history
+func (b *HistoryBuilder) AddWorkflowExecutionPausedEvent(
+	reason string,
+	identity string,
+	requestID string,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionPaused)
+	event.WorkflowExecutionPausedEventAttributes = &types.WorkflowExecutionPausedEventAttributes{
+		Reason:    reason,
+		Identity:  identity,
+		RequestID: requestID,
+	}
+
+	return b.addEventToHistory(event)
+}
+
+// AddWorkflowExecutionUnpausedEvent adds WorkflowExecutionUnpaused event to history
+func (b *HistoryBuilder) AddWorkflowExecutionUnpausedEvent(
+	reason string,
+	identity string,
+	requestID string,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionUnpaused)
+	event.WorkflowExecutionUnpausedEventAttributes = &types.WorkflowExecutionUnpausedEventAttributes{
+		Reason:    reason,
+		Identity:  identity,
+		RequestID: requestID,
+	}
+
+	return b.addEventToHistory(event)
+}
+
 // AddContinuedAsNewEvent adds WorkflowExecutionContinuedAsNew event to history
 func (b *HistoryBuilder) AddContinuedAsNewEvent(decisionCompletedEventID int64, newRunID string,
 	attributes *types.ContinueAsNewWorkflowExecutionDecisionAttributes) *types.HistoryEvent {
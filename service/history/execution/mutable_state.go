@@ -55,6 +55,16 @@ type (
 		OriginalScheduledTimestamp int64
 	}
 
+	// ActivityOptions are the options of a pending activity that can be changed in place.
+	// Nil fields are left unchanged.
+	ActivityOptions struct {
+		ScheduleToStartTimeoutSeconds *int32
+		ScheduleToCloseTimeoutSeconds *int32
+		StartToCloseTimeoutSeconds    *int32
+		HeartbeatTimeoutSeconds       *int32
+		RetryPolicy                   *types.RetryPolicy
+	}
+
 	// MutableState contains the current workflow execution state
 	MutableState interface {
 		AddActivityTaskCancelRequestedEvent(int64, string, string) (*types.HistoryEvent, *persistence.ActivityInfo, error)
@@ -104,10 +114,15 @@ type (
 		AddWorkflowExecutionSignaled(signalName string, input []byte, identity string, reqeustID string) (*types.HistoryEvent, error)
 		AddWorkflowExecutionStartedEvent(types.WorkflowExecution, *types.HistoryStartWorkflowExecutionRequest) (*types.HistoryEvent, error)
 		AddWorkflowExecutionTerminatedEvent(firstEventID int64, reason string, details []byte, identity string) (*types.HistoryEvent, error)
+		AddWorkflowExecutionPausedEvent(reason string, identity string, requestID string) (*types.HistoryEvent, error)
+		AddWorkflowExecutionUnpausedEvent(reason string, identity string, requestID string) (*types.HistoryEvent, error)
 		ClearStickyness()
 		CheckResettable() error
 		CopyToPersistence() *persistence.WorkflowMutableState
 		RetryActivity(ai *persistence.ActivityInfo, failureReason string, failureDetails []byte) (bool, error)
+		ResetActivityAttempt(ai *persistence.ActivityInfo) error
+		PauseActivity(ai *persistence.ActivityInfo) error
+		UnpauseActivity(ai *persistence.ActivityInfo) error
 		CreateNewHistoryEvent(eventType types.EventType) *types.HistoryEvent
 		CreateNewHistoryEventWithTimestamp(eventType types.EventType, timestamp int64) *types.HistoryEvent
 		CreateTransientDecisionEvents(di *DecisionInfo, identity string) (*types.HistoryEvent, *types.HistoryEvent)
@@ -137,6 +152,7 @@ type (
 		GetLastFirstEventID() int64
 		GetLastWriteVersion() (int64, error)
 		GetNextEventID() int64
+		GetPendingSignalCount() int64
 		GetPreviousStartedEventID() int64
 		GetPendingActivityInfos() map[int64]*persistence.ActivityInfo
 		GetPendingTimerInfos() map[string]*persistence.TimerInfo
@@ -164,6 +180,7 @@ type (
 		IsSignalRequested(requestID string) bool
 		IsStickyTaskListEnabled() bool
 		IsWorkflowExecutionRunning() bool
+		IsWorkflowExecutionPaused() bool
 		IsWorkflowCompleted() bool
 		IsResourceDuplicated(resourceDedupKey definition.DeduplicationID) bool
 		UpdateDuplicatedResource(resourceDedupKey definition.DeduplicationID)
@@ -208,12 +225,15 @@ type (
 		ReplicateWorkflowExecutionSignaled(*types.HistoryEvent) error
 		ReplicateWorkflowExecutionStartedEvent(*string, types.WorkflowExecution, string, *types.HistoryEvent, bool) error
 		ReplicateWorkflowExecutionTerminatedEvent(int64, *types.HistoryEvent) error
+		ReplicateWorkflowExecutionPausedEvent(*types.HistoryEvent) error
+		ReplicateWorkflowExecutionUnpausedEvent(*types.HistoryEvent) error
 		ReplicateWorkflowExecutionTimedoutEvent(int64, *types.HistoryEvent) error
 		SetCurrentBranchToken(branchToken []byte) error
 		SetHistoryBuilder(hBuilder *HistoryBuilder)
 		SetHistoryTree(treeID string) error
 		SetVersionHistories(*persistence.VersionHistories) error
 		UpdateActivity(*persistence.ActivityInfo) error
+		UpdateActivityOptions(ai *persistence.ActivityInfo, options *ActivityOptions) error
 		UpdateActivityProgress(ai *persistence.ActivityInfo, request *types.RecordActivityTaskHeartbeatRequest)
 		UpdateDecision(*DecisionInfo)
 		UpdateUserTimer(*persistence.TimerInfo) error
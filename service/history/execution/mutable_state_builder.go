@@ -1357,6 +1357,7 @@ func (e *mutableStateBuilder) ReplicateActivityInfo(
 	ai.LastFailureReason = request.GetLastFailureReason()
 	ai.LastWorkerIdentity = request.GetLastWorkerIdentity()
 	ai.LastFailureDetails = request.GetLastFailureDetails()
+	ai.Paused = request.GetPaused()
 
 	if resetActivityTimerTaskStatus {
 		ai.TimerTaskStatus = TimerTaskStatusNone
@@ -1603,10 +1604,39 @@ func (e *mutableStateBuilder) GetPreviousStartedEventID() int64 {
 	return e.executionInfo.LastProcessedEvent
 }
 
+// GetPendingSignalCount returns the number of signals added after the last completed decision
+// task was started, including buffered ones, which the workflow hasn't processed yet
+func (e *mutableStateBuilder) GetPendingSignalCount() int64 {
+	return int64(e.executionInfo.PendingSignalCount)
+}
+
+// countBufferedSignals returns the number of signals in the buffered events
+func (e *mutableStateBuilder) countBufferedSignals() int32 {
+	var count int32
+	for _, events := range [][]*types.HistoryEvent{e.bufferedEvents, e.updateBufferedEvents} {
+		for _, event := range events {
+			if event.GetEventType() == types.EventTypeWorkflowExecutionSignaled {
+				count++
+			}
+		}
+	}
+	return count
+}
+
 func (e *mutableStateBuilder) IsWorkflowExecutionRunning() bool {
 	return e.executionInfo.IsRunning()
 }
 
+func (e *mutableStateBuilder) IsWorkflowExecutionPaused() bool {
+	var paused bool
+	if bytes, ok := e.executionInfo.SearchAttributes[definition.CadencePaused]; ok {
+		if err := json.Unmarshal(bytes, &paused); err != nil {
+			return false
+		}
+	}
+	return paused
+}
+
 func (e *mutableStateBuilder) IsWorkflowCompleted() bool {
 	return e.executionInfo.State == persistence.WorkflowStateCompleted
 }
@@ -3382,14 +3412,80 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionSignaled(
 
 	// Increment signal count in mutable state for this workflow execution
 	e.executionInfo.SignalCount++
+	e.executionInfo.PendingSignalCount++
 	e.insertWorkflowRequest(persistence.WorkflowRequest{
 		RequestID:   event.WorkflowExecutionSignaledEventAttributes.RequestID,
 		Version:     event.Version,
 		RequestType: persistence.WorkflowRequestTypeSignal,
 	})
+
 	return nil
 }
 
+func (e *mutableStateBuilder) AddWorkflowExecutionPausedEvent(
+	reason string,
+	identity string,
+	requestID string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowPaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionPausedEvent(reason, identity, requestID)
+	if err := e.ReplicateWorkflowExecutionPausedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionPausedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	return e.setWorkflowExecutionPaused(true)
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUnpausedEvent(
+	reason string,
+	identity string,
+	requestID string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUnpaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUnpausedEvent(reason, identity, requestID)
+	if err := e.ReplicateWorkflowExecutionUnpausedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUnpausedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	return e.setWorkflowExecutionPaused(false)
+}
+
+// setWorkflowExecutionPaused records the pause state as a server managed search attribute,
+// so that it is persisted, replicated and rebuilt along with the history event that changed it
+func (e *mutableStateBuilder) setWorkflowExecutionPaused(paused bool) error {
+	bytes, err := json.Marshal(paused)
+	if err != nil {
+		return err
+	}
+	if e.executionInfo.SearchAttributes == nil {
+		e.executionInfo.SearchAttributes = make(map[string][]byte)
+	}
+	e.executionInfo.SearchAttributes[definition.CadencePaused] = bytes
+	return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
+}
+
 func (e *mutableStateBuilder) AddContinueAsNewEvent(
 	ctx context.Context,
 	firstEventID int64,
@@ -3985,6 +4081,186 @@ func (e *mutableStateBuilder) RetryActivity(
 	return true, nil
 }
 
+// ResetActivityAttempt sets the attempt of a pending activity back to zero so that its retry policy
+// starts over. An activity waiting for its next retry is scheduled right away.
+func (e *mutableStateBuilder) ResetActivityAttempt(
+	ai *persistence.ActivityInfo,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskAttemptReset
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	if err := e.checkPendingActivity(ai); err != nil {
+		return err
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.Attempt = 0
+	if ai.StartedID == common.EmptyEventID && !ai.Paused {
+		ai.ScheduledTime = e.timeSource.Now()
+		if err := e.rescheduleActivity(ai); err != nil {
+			return err
+		}
+	}
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
+
+// UpdateActivityOptions changes the timeouts and retry policy of a pending activity and
+// regenerates its timer tasks. The retry expiration interval, if any, starts from now.
+func (e *mutableStateBuilder) UpdateActivityOptions(
+	ai *persistence.ActivityInfo,
+	options *ActivityOptions,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskOptionsUpdated
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	if err := e.checkPendingActivity(ai); err != nil {
+		return err
+	}
+	if err := validateActivityOptions(options); err != nil {
+		return err
+	}
+
+	if options.ScheduleToStartTimeoutSeconds != nil {
+		ai.ScheduleToStartTimeout = *options.ScheduleToStartTimeoutSeconds
+	}
+	if options.ScheduleToCloseTimeoutSeconds != nil {
+		ai.ScheduleToCloseTimeout = *options.ScheduleToCloseTimeoutSeconds
+	}
+	if options.StartToCloseTimeoutSeconds != nil {
+		ai.StartToCloseTimeout = *options.StartToCloseTimeoutSeconds
+	}
+	if options.HeartbeatTimeoutSeconds != nil {
+		ai.HeartbeatTimeout = *options.HeartbeatTimeoutSeconds
+	}
+	if policy := options.RetryPolicy; policy != nil {
+		ai.HasRetryPolicy = true
+		ai.InitialInterval = policy.GetInitialIntervalInSeconds()
+		ai.BackoffCoefficient = policy.GetBackoffCoefficient()
+		ai.MaximumInterval = policy.GetMaximumIntervalInSeconds()
+		ai.MaximumAttempts = policy.GetMaximumAttempts()
+		ai.NonRetriableErrors = policy.NonRetriableErrorReasons
+		ai.ExpirationTime = time.Time{}
+		if policy.GetExpirationIntervalInSeconds() != 0 {
+			ai.ExpirationTime = e.timeSource.Now().Add(time.Duration(policy.GetExpirationIntervalInSeconds()) * time.Second)
+		}
+	}
+
+	// existing timer tasks are validated against the activity info when they fire,
+	// so only the timer for the new timeouts needs to be created
+	ai.Version = e.GetCurrentVersion()
+	ai.TimerTaskStatus = TimerTaskStatusNone
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return e.taskGenerator.GenerateActivityTimerTasks()
+}
+
+// PauseActivity holds back a pending activity. It is not dispatched to a worker and its
+// schedule to start timeout does not apply until it is unpaused. An activity that is already
+// started keeps running, but is not retried while it is paused.
+func (e *mutableStateBuilder) PauseActivity(
+	ai *persistence.ActivityInfo,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskPaused
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	if err := e.checkPendingActivity(ai); err != nil {
+		return err
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.Paused = true
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
+
+// UnpauseActivity releases a paused activity. As its dispatch was dropped while it was paused,
+// an activity that is not started is scheduled again.
+func (e *mutableStateBuilder) UnpauseActivity(
+	ai *persistence.ActivityInfo,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskUnpaused
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	if err := e.checkPendingActivity(ai); err != nil {
+		return err
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.Paused = false
+	if ai.StartedID == common.EmptyEventID {
+		if err := e.rescheduleActivity(ai); err != nil {
+			return err
+		}
+	}
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
+
+func (e *mutableStateBuilder) checkPendingActivity(
+	ai *persistence.ActivityInfo,
+) error {
+
+	if _, ok := e.pendingActivityInfoIDs[ai.ScheduleID]; !ok {
+		e.logError(
+			fmt.Sprintf("unable to find activity ID: %v in mutable state", ai.ActivityID),
+			tag.ErrorTypeInvalidMutableStateAction,
+		)
+		return ErrMissingActivityInfo
+	}
+	return nil
+}
+
+// rescheduleActivity dispatches an activity that is not started no later than now
+// and regenerates its timer tasks
+func (e *mutableStateBuilder) rescheduleActivity(
+	ai *persistence.ActivityInfo,
+) error {
+
+	if now := e.timeSource.Now(); ai.ScheduledTime.Before(now) {
+		ai.ScheduledTime = now
+	}
+	ai.TimerTaskStatus = TimerTaskStatusNone
+	if err := e.taskGenerator.GenerateActivityRetryTasks(
+		ai.ScheduleID,
+	); err != nil {
+		return err
+	}
+	return e.taskGenerator.GenerateActivityTimerTasks()
+}
+
+func validateActivityOptions(
+	options *ActivityOptions,
+) error {
+
+	for _, timeout := range []*int32{
+		options.ScheduleToStartTimeoutSeconds,
+		options.ScheduleToCloseTimeoutSeconds,
+		options.StartToCloseTimeoutSeconds,
+		options.HeartbeatTimeoutSeconds,
+	} {
+		if timeout != nil && *timeout < 0 {
+			return &types.BadRequestError{Message: "Activity timeouts cannot be negative."}
+		}
+	}
+	return common.ValidateRetryPolicy(options.RetryPolicy)
+}
+
 // TODO mutable state should generate corresponding transfer / timer tasks according to
 //  updates accumulated, while currently all transfer / timer tasks are managed manually
 
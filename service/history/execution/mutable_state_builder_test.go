@@ -422,6 +422,7 @@ func (s *mutableStateSuite) TestChecksum() {
 			err = s.msBuilder.Load(dbState)
 			s.NoError(err)
 			s.Equal(loadErrors, loadErrorsFunc())
+			s.NoError(VerifyWorkflowMutableStateChecksum(dbState))
 
 			// generate checksum again and verify its the same
 			csum, err = tc.closeTxFunc(s.msBuilder)
@@ -431,6 +432,7 @@ func (s *mutableStateSuite) TestChecksum() {
 
 			// modify checksum and verify Load fails
 			dbState.Checksum.Value[0]++
+			s.Error(VerifyWorkflowMutableStateChecksum(dbState))
 			err = s.msBuilder.Load(dbState)
 			s.Error(err)
 			s.Equal(loadErrors+1, loadErrorsFunc())
@@ -543,6 +545,69 @@ func (s *mutableStateSuite) TestMergeMapOfByteArray() {
 	s.Equal(2, len(resultMap))
 }
 
+func (s *mutableStateSuite) TestReplicateWorkflowExecutionPausedEvent() {
+	s.msBuilder.Load(s.buildWorkflowMutableState())
+	s.False(s.msBuilder.IsWorkflowExecutionPaused())
+
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionPausedEvent(&types.HistoryEvent{
+		ID:        s.msBuilder.GetNextEventID(),
+		EventType: types.EventTypeWorkflowExecutionPaused.Ptr(),
+		WorkflowExecutionPausedEventAttributes: &types.WorkflowExecutionPausedEventAttributes{
+			Reason:    "some random reason",
+			RequestID: uuid.New(),
+		},
+	}))
+	s.True(s.msBuilder.IsWorkflowExecutionPaused())
+	s.Equal("true", string(s.msBuilder.GetExecutionInfo().SearchAttributes[definition.CadencePaused]))
+
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionSignaled(&types.HistoryEvent{
+		ID:        s.msBuilder.GetNextEventID(),
+		EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			SignalName: "some-signal",
+			RequestID:  uuid.New(),
+		},
+	}))
+	s.True(s.msBuilder.IsWorkflowExecutionPaused())
+
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionUnpausedEvent(&types.HistoryEvent{
+		ID:        s.msBuilder.GetNextEventID(),
+		EventType: types.EventTypeWorkflowExecutionUnpaused.Ptr(),
+		WorkflowExecutionUnpausedEventAttributes: &types.WorkflowExecutionUnpausedEventAttributes{
+			RequestID: uuid.New(),
+		},
+	}))
+	s.False(s.msBuilder.IsWorkflowExecutionPaused())
+	s.NotEmpty(s.msBuilder.insertTransferTasks)
+}
+
+func (s *mutableStateSuite) TestPendingSignalCount() {
+	dbState := s.buildWorkflowMutableState()
+	dbState.ExecutionInfo.PendingSignalCount = 2
+	s.msBuilder.Load(dbState)
+	s.Equal(int64(2), s.msBuilder.GetPendingSignalCount())
+
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionSignaled(&types.HistoryEvent{
+		ID:        s.msBuilder.GetNextEventID(),
+		EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			SignalName: "some-signal",
+			RequestID:  uuid.New(),
+		},
+	}))
+	s.Equal(int64(3), s.msBuilder.GetPendingSignalCount())
+
+	// only the buffered signal is left once a decision completes
+	s.NoError(s.msBuilder.ReplicateDecisionTaskCompletedEvent(&types.HistoryEvent{
+		ID:        s.msBuilder.GetNextEventID(),
+		EventType: types.EventTypeDecisionTaskCompleted.Ptr(),
+		DecisionTaskCompletedEventAttributes: &types.DecisionTaskCompletedEventAttributes{
+			StartedEventID: s.msBuilder.GetNextEventID() - 1,
+		},
+	}))
+	s.Equal(int64(1), s.msBuilder.GetPendingSignalCount())
+}
+
 func (s *mutableStateSuite) TestEventReapplied() {
 	runID := uuid.New()
 	eventID := int64(1)
@@ -876,6 +941,122 @@ func (s *mutableStateSuite) TestUpdateCurrentVersion_WorkflowClosed() {
 	s.Equal(lastWriteVersion, s.msBuilder.GetCurrentVersion())
 }
 
+func (s *mutableStateSuite) TestResetActivityAttempt() {
+	s.msBuilder.Load(s.buildWorkflowMutableState())
+
+	ai, ok := s.msBuilder.GetActivityInfo(5)
+	s.True(ok)
+	ai.Attempt = 10
+	ai.ScheduledTime = time.Now().Add(time.Hour)
+	s.NoError(s.msBuilder.ResetActivityAttempt(ai))
+	s.Equal(int32(0), ai.Attempt)
+	s.True(ai.ScheduledTime.Before(time.Now().Add(time.Minute)))
+	s.Equal(ai, s.msBuilder.updateActivityInfos[5])
+	s.Contains(s.msBuilder.syncActivityTasks, int64(5))
+	s.Contains(s.msBuilder.GetTimerTasks(), &persistence.ActivityRetryTimerTask{
+		TaskData: persistence.TaskData{
+			Version:             ai.Version,
+			VisibilityTimestamp: ai.ScheduledTime,
+		},
+		EventID: 5,
+		Attempt: 0,
+	})
+
+	s.Equal(ErrMissingActivityInfo, s.msBuilder.ResetActivityAttempt(&persistence.ActivityInfo{ScheduleID: 6}))
+}
+
+func (s *mutableStateSuite) TestPauseActivity() {
+	s.msBuilder.Load(s.buildWorkflowMutableState())
+
+	ai, ok := s.msBuilder.GetActivityInfo(5)
+	s.True(ok)
+	s.NoError(s.msBuilder.PauseActivity(ai))
+	s.True(ai.Paused)
+	s.Equal(ai, s.msBuilder.updateActivityInfos[5])
+	s.Contains(s.msBuilder.syncActivityTasks, int64(5))
+	s.Empty(s.msBuilder.GetTimerTasks())
+
+	// a paused activity has no schedule to start timeout
+	for _, timerSequenceID := range NewTimerSequence(s.msBuilder).LoadAndSortActivityTimers() {
+		s.NotEqual(TimerTypeScheduleToStart, timerSequenceID.TimerType)
+	}
+}
+
+func (s *mutableStateSuite) TestUnpauseActivity() {
+	s.msBuilder.Load(s.buildWorkflowMutableState())
+
+	ai, ok := s.msBuilder.GetActivityInfo(5)
+	s.True(ok)
+	ai.Paused = true
+	ai.ScheduledTime = time.Now().Add(-time.Hour)
+	ai.TimerTaskStatus = TimerTaskStatusCreatedScheduleToStart
+	s.NoError(s.msBuilder.UnpauseActivity(ai))
+	s.False(ai.Paused)
+	s.True(ai.ScheduledTime.After(time.Now().Add(-time.Minute)))
+	s.Equal(ai, s.msBuilder.updateActivityInfos[5])
+	s.Contains(s.msBuilder.syncActivityTasks, int64(5))
+
+	var retryTask *persistence.ActivityRetryTimerTask
+	var timeoutTask *persistence.ActivityTimeoutTask
+	for _, task := range s.msBuilder.GetTimerTasks() {
+		switch task := task.(type) {
+		case *persistence.ActivityRetryTimerTask:
+			retryTask = task
+		case *persistence.ActivityTimeoutTask:
+			timeoutTask = task
+		}
+	}
+	s.NotNil(retryTask)
+	s.Equal(ai.ScheduledTime, retryTask.VisibilityTimestamp)
+	s.NotNil(timeoutTask)
+	s.Equal(int(TimerTypeScheduleToStart), timeoutTask.TimeoutType)
+}
+
+func (s *mutableStateSuite) TestUpdateActivityOptions() {
+	s.msBuilder.Load(s.buildWorkflowMutableState())
+
+	ai, ok := s.msBuilder.GetActivityInfo(5)
+	s.True(ok)
+	ai.TimerTaskStatus = TimerTaskStatusCreatedScheduleToStart
+	err := s.msBuilder.UpdateActivityOptions(ai, &ActivityOptions{
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(10),
+		HeartbeatTimeoutSeconds:       common.Int32Ptr(5),
+		RetryPolicy: &types.RetryPolicy{
+			InitialIntervalInSeconds:    1,
+			BackoffCoefficient:          2,
+			ExpirationIntervalInSeconds: 60,
+		},
+	})
+	s.NoError(err)
+	s.Equal(int32(10), ai.ScheduleToStartTimeout)
+	s.Equal(int32(5), ai.HeartbeatTimeout)
+	s.Equal(int32(200), ai.ScheduleToCloseTimeout)
+	s.True(ai.HasRetryPolicy)
+	s.Equal(int32(1), ai.InitialInterval)
+	s.Equal(2.0, ai.BackoffCoefficient)
+	s.False(ai.ExpirationTime.IsZero())
+	s.Equal(ai, s.msBuilder.updateActivityInfos[5])
+	s.NotEqual(int32(TimerTaskStatusNone), ai.TimerTaskStatus)
+	s.NotEmpty(s.msBuilder.GetTimerTasks())
+}
+
+func (s *mutableStateSuite) TestUpdateActivityOptions_Invalid() {
+	s.msBuilder.Load(s.buildWorkflowMutableState())
+
+	ai, ok := s.msBuilder.GetActivityInfo(5)
+	s.True(ok)
+	err := s.msBuilder.UpdateActivityOptions(ai, &ActivityOptions{
+		StartToCloseTimeoutSeconds: common.Int32Ptr(-1),
+	})
+	s.IsType(&types.BadRequestError{}, err)
+
+	err = s.msBuilder.UpdateActivityOptions(ai, &ActivityOptions{
+		RetryPolicy: &types.RetryPolicy{InitialIntervalInSeconds: 1, BackoffCoefficient: 2},
+	})
+	s.IsType(&types.BadRequestError{}, err)
+	s.Empty(s.msBuilder.updateActivityInfos)
+}
+
 func (s *mutableStateSuite) newDomainCacheEntry() *cache.DomainCacheEntry {
 	return cache.NewDomainCacheEntryForTest(
 		&persistence.DomainInfo{Name: "mutableStateTest"},
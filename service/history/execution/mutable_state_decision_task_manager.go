@@ -863,6 +863,9 @@ func (m *mutableStateDecisionTaskManagerImpl) afterAddDecisionTaskCompletedEvent
 	maxResetPoints int,
 ) error {
 	m.msb.executionInfo.LastProcessedEvent = event.GetDecisionTaskCompletedEventAttributes().GetStartedEventID()
+	// all signals in history are processed by now, only the ones buffered
+	// while the decision task was in flight are still pending
+	m.msb.executionInfo.PendingSignalCount = m.msb.countBufferedSignals()
 	return m.msb.addBinaryCheckSumIfNotExists(event, maxResetPoints)
 }
 
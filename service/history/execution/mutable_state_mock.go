@@ -778,6 +778,21 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionCanceledEvent(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionCanceledEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionCanceledEvent), arg0, arg1)
 }
 
+// AddWorkflowExecutionPausedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionPausedEvent(reason, identity, requestID string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionPausedEvent", reason, identity, requestID)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionPausedEvent indicates an expected call of AddWorkflowExecutionPausedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionPausedEvent(reason, identity, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionPausedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionPausedEvent), reason, identity, requestID)
+}
+
 // AddWorkflowExecutionSignaled mocks base method.
 func (m *MockMutableState) AddWorkflowExecutionSignaled(signalName string, input []byte, identity, reqeustID string) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -823,6 +838,21 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionTerminatedEvent(firs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionTerminatedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionTerminatedEvent), firstEventID, reason, details, identity)
 }
 
+// AddWorkflowExecutionUnpausedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionUnpausedEvent(reason, identity, requestID string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionUnpausedEvent", reason, identity, requestID)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionUnpausedEvent indicates an expected call of AddWorkflowExecutionUnpausedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionUnpausedEvent(reason, identity, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionUnpausedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionUnpausedEvent), reason, identity, requestID)
+}
+
 // CheckResettable mocks base method.
 func (m *MockMutableState) CheckResettable() error {
 	m.ctrl.T.Helper()
@@ -1400,6 +1430,20 @@ func (mr *MockMutableStateMockRecorder) GetPendingRequestCancelExternalInfos() *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingRequestCancelExternalInfos", reflect.TypeOf((*MockMutableState)(nil).GetPendingRequestCancelExternalInfos))
 }
 
+// GetPendingSignalCount mocks base method.
+func (m *MockMutableState) GetPendingSignalCount() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingSignalCount")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetPendingSignalCount indicates an expected call of GetPendingSignalCount.
+func (mr *MockMutableStateMockRecorder) GetPendingSignalCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingSignalCount", reflect.TypeOf((*MockMutableState)(nil).GetPendingSignalCount))
+}
+
 // GetPendingSignalExternalInfos mocks base method.
 func (m *MockMutableState) GetPendingSignalExternalInfos() map[int64]*persistence.SignalInfo {
 	m.ctrl.T.Helper()
@@ -1800,6 +1844,20 @@ func (mr *MockMutableStateMockRecorder) IsWorkflowCompleted() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowCompleted", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowCompleted))
 }
 
+// IsWorkflowExecutionPaused mocks base method.
+func (m *MockMutableState) IsWorkflowExecutionPaused() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWorkflowExecutionPaused")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsWorkflowExecutionPaused indicates an expected call of IsWorkflowExecutionPaused.
+func (mr *MockMutableStateMockRecorder) IsWorkflowExecutionPaused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionPaused", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowExecutionPaused))
+}
+
 // IsWorkflowExecutionRunning mocks base method.
 func (m *MockMutableState) IsWorkflowExecutionRunning() bool {
 	m.ctrl.T.Helper()
@@ -1828,6 +1886,20 @@ func (mr *MockMutableStateMockRecorder) Load(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockMutableState)(nil).Load), arg0)
 }
 
+// PauseActivity mocks base method.
+func (m *MockMutableState) PauseActivity(ai *persistence.ActivityInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivity", ai)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockMutableStateMockRecorder) PauseActivity(ai interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockMutableState)(nil).PauseActivity), ai)
+}
+
 // ReplicateActivityInfo mocks base method.
 func (m *MockMutableState) ReplicateActivityInfo(arg0 *types.SyncActivityRequest, arg1 bool) error {
 	m.ctrl.T.Helper()
@@ -2353,6 +2425,20 @@ func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionFailedEvent(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionFailedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionFailedEvent), arg0, arg1)
 }
 
+// ReplicateWorkflowExecutionPausedEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowExecutionPausedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionPausedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionPausedEvent indicates an expected call of ReplicateWorkflowExecutionPausedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionPausedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionPausedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionPausedEvent), arg0)
+}
+
 // ReplicateWorkflowExecutionSignaled mocks base method.
 func (m *MockMutableState) ReplicateWorkflowExecutionSignaled(arg0 *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -2409,6 +2495,34 @@ func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionTimedoutEvent(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionTimedoutEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionTimedoutEvent), arg0, arg1)
 }
 
+// ReplicateWorkflowExecutionUnpausedEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowExecutionUnpausedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionUnpausedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionUnpausedEvent indicates an expected call of ReplicateWorkflowExecutionUnpausedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionUnpausedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionUnpausedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionUnpausedEvent), arg0)
+}
+
+// ResetActivityAttempt mocks base method.
+func (m *MockMutableState) ResetActivityAttempt(ai *persistence.ActivityInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivityAttempt", ai)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivityAttempt indicates an expected call of ResetActivityAttempt.
+func (mr *MockMutableStateMockRecorder) ResetActivityAttempt(ai interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityAttempt", reflect.TypeOf((*MockMutableState)(nil).ResetActivityAttempt), ai)
+}
+
 // RetryActivity mocks base method.
 func (m *MockMutableState) RetryActivity(ai *persistence.ActivityInfo, failureReason string, failureDetails []byte) (bool, error) {
 	m.ctrl.T.Helper()
@@ -2529,6 +2643,20 @@ func (mr *MockMutableStateMockRecorder) StartTransaction(entry, incomingTaskVers
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTransaction", reflect.TypeOf((*MockMutableState)(nil).StartTransaction), entry, incomingTaskVersion)
 }
 
+// UnpauseActivity mocks base method.
+func (m *MockMutableState) UnpauseActivity(ai *persistence.ActivityInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivity", ai)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockMutableStateMockRecorder) UnpauseActivity(ai interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockMutableState)(nil).UnpauseActivity), ai)
+}
+
 // UpdateActivity mocks base method.
 func (m *MockMutableState) UpdateActivity(arg0 *persistence.ActivityInfo) error {
 	m.ctrl.T.Helper()
@@ -2543,6 +2671,20 @@ func (mr *MockMutableStateMockRecorder) UpdateActivity(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivity", reflect.TypeOf((*MockMutableState)(nil).UpdateActivity), arg0)
 }
 
+// UpdateActivityOptions mocks base method.
+func (m *MockMutableState) UpdateActivityOptions(ai *persistence.ActivityInfo, options *ActivityOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", ai, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockMutableStateMockRecorder) UpdateActivityOptions(ai, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockMutableState)(nil).UpdateActivityOptions), ai, options)
+}
+
 // UpdateActivityProgress mocks base method.
 func (m *MockMutableState) UpdateActivityProgress(ai *persistence.ActivityInfo, request *types.RecordActivityTaskHeartbeatRequest) {
 	m.ctrl.T.Helper()
@@ -382,6 +382,9 @@ func CopyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		Memo:                               sourceInfo.Memo,
 		SearchAttributes:                   sourceInfo.SearchAttributes,
 		PartitionConfig:                    sourceInfo.PartitionConfig,
+		ConflictResolutions:                sourceInfo.ConflictResolutions,
+		PendingSignalCount:                 sourceInfo.PendingSignalCount,
+		ClosedWorkflowQueryResults:         sourceInfo.ClosedWorkflowQueryResults,
 		Attempt:                            sourceInfo.Attempt,
 		HasRetryPolicy:                     sourceInfo.HasRetryPolicy,
 		InitialInterval:                    sourceInfo.InitialInterval,
@@ -433,6 +436,7 @@ func CopyActivityInfo(sourceInfo *persistence.ActivityInfo) *persistence.Activit
 		LastFailureReason:        sourceInfo.LastFailureReason,
 		LastWorkerIdentity:       sourceInfo.LastWorkerIdentity,
 		LastFailureDetails:       sourceInfo.LastFailureDetails,
+		Paused:                   sourceInfo.Paused,
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds: sourceInfo.LastHeartbeatTimeoutVisibilityInSeconds,
 	}
@@ -422,6 +422,20 @@ func (b *stateBuilderImpl) ApplyEvents(
 				return nil, err
 			}
 
+		case types.EventTypeWorkflowExecutionPaused:
+			if err := b.mutableState.ReplicateWorkflowExecutionPausedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeWorkflowExecutionUnpaused:
+			if err := b.mutableState.ReplicateWorkflowExecutionUnpausedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
 		case types.EventTypeUpsertWorkflowSearchAttributes:
 			if err := b.mutableState.ReplicateUpsertWorkflowSearchAttributesEvent(
 				event,
@@ -508,6 +508,60 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionSignaled()
 	s.Nil(err)
 }
 
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionPaused() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "some random workflow ID",
+		RunID:      constants.TestRunID,
+	}
+
+	now := time.Now()
+	evenType := types.EventTypeWorkflowExecutionPaused
+	event := &types.HistoryEvent{
+		Version:                                version,
+		ID:                                     130,
+		Timestamp:                              common.Int64Ptr(now.UnixNano()),
+		EventType:                              &evenType,
+		WorkflowExecutionPausedEventAttributes: &types.WorkflowExecutionPausedEventAttributes{},
+	}
+	s.mockUpdateVersion(event)
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+	s.mockMutableState.EXPECT().ReplicateWorkflowExecutionPausedEvent(event).Return(nil).Times(1)
+	s.mockMutableState.EXPECT().ClearStickyness().Times(1)
+
+	_, err := s.stateBuilder.ApplyEvents(constants.TestDomainID, requestID, workflowExecution, s.toHistory(event), nil)
+	s.Nil(err)
+}
+
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionUnpaused() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "some random workflow ID",
+		RunID:      constants.TestRunID,
+	}
+
+	now := time.Now()
+	evenType := types.EventTypeWorkflowExecutionUnpaused
+	event := &types.HistoryEvent{
+		Version:                                  version,
+		ID:                                       130,
+		Timestamp:                                common.Int64Ptr(now.UnixNano()),
+		EventType:                                &evenType,
+		WorkflowExecutionUnpausedEventAttributes: &types.WorkflowExecutionUnpausedEventAttributes{},
+	}
+	s.mockUpdateVersion(event)
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+	s.mockMutableState.EXPECT().ReplicateWorkflowExecutionUnpausedEvent(event).Return(nil).Times(1)
+	s.mockMutableState.EXPECT().ClearStickyness().Times(1)
+
+	_, err := s.stateBuilder.ApplyEvents(constants.TestDomainID, requestID, workflowExecution, s.toHistory(event), nil)
+	s.Nil(err)
+}
+
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionCancelRequested() {
 	version := int64(1)
 	requestID := uuid.New()
@@ -1648,7 +1702,7 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeExternalWorkflowExecutionSi
 
 func (s *stateBuilderSuite) TestApplyEventsNewEventsNotHandled() {
 	eventTypes := types.EventTypeValues()
-	s.Equal(42, len(eventTypes), "If you see this error, you are adding new event type. "+
+	s.Equal(44, len(eventTypes), "If you see this error, you are adding new event type. "+
 		"Before updating the number to make this test pass, please make sure you update stateBuilderImpl.ApplyEvents method "+
 		"to handle the new decision type. Otherwise cross dc will not work on the new event.")
 }
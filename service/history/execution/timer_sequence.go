@@ -284,6 +284,11 @@ func (t *timerSequenceImpl) getActivityScheduleToStartTimeout(
 		return nil
 	}
 
+	// activity is not dispatched while it is paused
+	if activityInfo.Paused {
+		return nil
+	}
+
 	startTimeout := activityInfo.ScheduledTime.Add(
 		time.Duration(activityInfo.ScheduleToStartTimeout) * time.Second,
 	)
@@ -37,6 +37,7 @@ import (
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/future"
+	"github.com/uber/cadence/common/lifecycle"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/membership"
@@ -81,6 +82,7 @@ type (
 		failoverCoordinator            failover.Coordinator
 		workflowIDCache                workflowcache.WFCache
 		ratelimitInternalPerWorkflowID dynamicconfig.BoolPropertyFnWithDomainFilter
+		lifecyclePublisher             lifecycle.Publisher
 	}
 )
 
@@ -93,6 +95,7 @@ func NewHandler(
 	config *config.Config,
 	wfCache workflowcache.WFCache,
 	ratelimitInternalPerWorkflowID dynamicconfig.BoolPropertyFnWithDomainFilter,
+	lifecyclePublisher lifecycle.Publisher,
 ) Handler {
 	handler := &handlerImpl{
 		Resource:                       resource,
@@ -101,6 +104,7 @@ func NewHandler(
 		rateLimiter:                    quotas.NewDynamicRateLimiter(config.RPS.AsFloat64()),
 		workflowIDCache:                wfCache,
 		ratelimitInternalPerWorkflowID: ratelimitInternalPerWorkflowID,
+		lifecyclePublisher:             lifecyclePublisher,
 	}
 
 	// prevent us from trying to serve requests before shard controller is started and ready
@@ -229,6 +233,7 @@ func (h *handlerImpl) CreateEngine(
 		h.failoverCoordinator,
 		h.workflowIDCache,
 		h.ratelimitInternalPerWorkflowID,
+		h.lifecyclePublisher,
 	)
 }
 
@@ -841,6 +846,39 @@ func (h *handlerImpl) DescribeQueue(
 	return resp, nil
 }
 
+// ListThrottledWorkflowIDs returns the workflow IDs recently throttled by their rate limits on this host
+func (h *handlerImpl) ListThrottledWorkflowIDs(
+	ctx context.Context,
+	request *types.ListThrottledWorkflowIDsRequest,
+) (resp *types.ListThrottledWorkflowIDsResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryListThrottledWorkflowIDsScope)
+	defer sw.Stop()
+
+	// the shard is only used to find the host, the throttled workflow IDs are tracked per host
+	if _, err := h.controller.GetEngineForShard(int(request.GetShardID())); err != nil {
+		return nil, h.error(err, scope, "", "", "")
+	}
+
+	throttled := h.workflowIDCache.GetThrottledWorkflowIDs()
+	resp = &types.ListThrottledWorkflowIDsResponse{
+		ThrottledWorkflowIDs: make([]*types.ThrottledWorkflowID, 0, len(throttled)),
+	}
+	for _, workflowID := range throttled {
+		resp.ThrottledWorkflowIDs = append(resp.ThrottledWorkflowIDs, &types.ThrottledWorkflowID{
+			DomainName:             workflowID.DomainName,
+			WorkflowID:             workflowID.WorkflowID,
+			Limit:                  workflowID.Limit,
+			Count:                  workflowID.Count,
+			LastThrottledTimestamp: common.Int64Ptr(workflowID.LastThrottled.UnixNano()),
+		})
+	}
+	return resp, nil
+}
+
 // DescribeMutableState - returns the internal analysis of workflow execution state
 func (h *handlerImpl) DescribeMutableState(
 	ctx context.Context,
@@ -1214,6 +1252,252 @@ func (h *handlerImpl) TerminateWorkflowExecution(
 	return nil
 }
 
+// PauseWorkflowExecution pauses an existing workflow execution by recording WorkflowExecutionPaused event in the history.
+func (h *handlerImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *types.HistoryPauseWorkflowExecutionRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryPauseWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := wrappedRequest.PauseRequest.GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	err2 := engine.PauseWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
+// UnpauseWorkflowExecution unpauses an existing workflow execution by recording WorkflowExecutionUnpaused event in the history.
+func (h *handlerImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *types.HistoryUnpauseWorkflowExecutionRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUnpauseWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := wrappedRequest.UnpauseRequest.GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	err2 := engine.UnpauseWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
+// PauseActivity stops dispatching a pending activity of a workflow execution until it is unpaused.
+func (h *handlerImpl) PauseActivity(
+	ctx context.Context,
+	wrappedRequest *types.HistoryPauseActivityRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryPauseActivityScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := wrappedRequest.PauseRequest.GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	err2 := engine.PauseActivity(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
+// UnpauseActivity resumes dispatching a paused activity of a workflow execution.
+func (h *handlerImpl) UnpauseActivity(
+	ctx context.Context,
+	wrappedRequest *types.HistoryUnpauseActivityRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUnpauseActivityScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := wrappedRequest.UnpauseRequest.GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	err2 := engine.UnpauseActivity(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
+// ResetActivity sets the attempt of a pending activity back to zero.
+func (h *handlerImpl) ResetActivity(
+	ctx context.Context,
+	wrappedRequest *types.HistoryResetActivityRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryResetActivityScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := wrappedRequest.ResetRequest.GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	err2 := engine.ResetActivity(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
+// UpdateActivityOptions changes the timeouts and retry policy of a pending activity.
+func (h *handlerImpl) UpdateActivityOptions(
+	ctx context.Context,
+	wrappedRequest *types.HistoryUpdateActivityOptionsRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUpdateActivityOptionsScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := wrappedRequest.UpdateRequest.GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	err2 := engine.UpdateActivityOptions(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution
 // in the history and immediately terminating the execution instance.
 func (h *handlerImpl) ResetWorkflowExecution(
@@ -1587,6 +1871,11 @@ func (h *handlerImpl) GetReplicationMessages(
 	wg.Add(len(request.Tokens))
 	result := new(sync.Map)
 
+	// closed once any shard has tasks to return, so that the idle shards stop long polling
+	tasksFoundCh := make(chan struct{})
+	var tasksFoundOnce sync.Once
+	longPollTimeout := h.getReplicationMessagesLongPollTimeout(ctx)
+
 	for _, token := range request.Tokens {
 		go func(token *types.ReplicationToken) {
 			defer wg.Done()
@@ -1596,15 +1885,21 @@ func (h *handlerImpl) GetReplicationMessages(
 				h.GetLogger().Warn("History engine not found for shard", tag.Error(err))
 				return
 			}
-			tasks, err := engine.GetReplicationMessages(
+			tasks, err := h.getReplicationMessagesForShard(
 				ctx,
+				engine,
 				request.GetClusterName(),
 				token.GetLastRetrievedMessageID(),
+				longPollTimeout,
+				tasksFoundCh,
 			)
 			if err != nil {
 				h.GetLogger().Warn("Failed to get replication tasks for shard", tag.Error(err))
 				return
 			}
+			if len(tasks.ReplicationTasks) > 0 {
+				tasksFoundOnce.Do(func() { close(tasksFoundCh) })
+			}
 
 			result.Store(token.GetShardID(), tasks)
 		}(token)
@@ -1642,6 +1937,47 @@ func (h *handlerImpl) GetReplicationMessages(
 	return &types.GetReplicationMessagesResponse{MessagesByShard: messagesByShard}, nil
 }
 
+// getReplicationMessagesForShard gets the replication tasks of a shard. If the shard has no tasks,
+// it waits up to longPollTimeout for new tasks, unless another shard in the request has tasks to return.
+func (h *handlerImpl) getReplicationMessagesForShard(
+	ctx context.Context,
+	engine engine.Engine,
+	pollingCluster string,
+	lastReadMessageID int64,
+	longPollTimeout time.Duration,
+	tasksFoundCh <-chan struct{},
+) (*types.ReplicationMessages, error) {
+
+	// get the channel before reading so that tasks written during the read are not missed
+	notifyCh := engine.GetReplicationTasksNotifyCh()
+	tasks, err := engine.GetReplicationMessages(ctx, pollingCluster, lastReadMessageID)
+	if err != nil || longPollTimeout <= 0 || len(tasks.ReplicationTasks) > 0 || tasks.HasMore {
+		return tasks, err
+	}
+
+	timer := time.NewTimer(longPollTimeout)
+	defer timer.Stop()
+	select {
+	case <-notifyCh:
+		return engine.GetReplicationMessages(ctx, pollingCluster, lastReadMessageID)
+	case <-tasksFoundCh:
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+	return tasks, nil
+}
+
+// getReplicationMessagesLongPollTimeout leaves at least half of the remaining request time to build the response
+func (h *handlerImpl) getReplicationMessagesLongPollTimeout(ctx context.Context) time.Duration {
+	timeout := h.config.ReplicationMessagesLongPollTimeout()
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline) / 2; remaining < timeout {
+			timeout = remaining
+		}
+	}
+	return timeout
+}
+
 // GetDLQReplicationMessages is called by remote peers to get replicated messages for DLQ merging
 func (h *handlerImpl) GetDLQReplicationMessages(
 	ctx context.Context,
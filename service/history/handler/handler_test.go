@@ -26,6 +26,7 @@ import (
 	"math/rand"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/mock"
@@ -35,6 +36,8 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/lifecycle"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/metrics/mocks"
@@ -92,7 +95,7 @@ func (s *handlerSuite) SetupTest() {
 	s.mockShardController.EXPECT().GetEngineForShard(gomock.Any()).Return(s.mockEngine, nil).AnyTimes()
 	s.mockWFCache = workflowcache.NewMockWFCache(s.controller)
 	internalRequestRateLimitingEnabledConfig := func(domainName string) bool { return false }
-	s.handler = NewHandler(s.mockResource, config.NewForTest(), s.mockWFCache, internalRequestRateLimitingEnabledConfig).(*handlerImpl)
+	s.handler = NewHandler(s.mockResource, config.NewForTest(), s.mockWFCache, internalRequestRateLimitingEnabledConfig, lifecycle.NewNoopPublisher()).(*handlerImpl)
 	s.handler.controller = s.mockShardController
 	s.mockTokenSerializer = common.NewMockTaskTokenSerializer(s.controller)
 	s.mockRatelimiter = quotas.NewMockLimiter(s.controller)
@@ -783,6 +786,64 @@ func (s *handlerSuite) TestGetCrossClusterTasks() {
 	}
 }
 
+func (s *handlerSuite) TestGetReplicationMessages_LongPollNotified() {
+	s.handler.config.ReplicationMessagesLongPollTimeout = dynamicconfig.GetDurationPropertyFn(time.Minute)
+	notifyCh := make(chan struct{})
+	close(notifyCh)
+	replicationTask := &types.ReplicationTask{SourceTaskID: 11}
+	s.mockEngine.EXPECT().GetReplicationTasksNotifyCh().Return(notifyCh)
+	gomock.InOrder(
+		s.mockEngine.EXPECT().GetReplicationMessages(gomock.Any(), cluster.TestAlternativeClusterName, int64(10)).
+			Return(&types.ReplicationMessages{LastRetrievedMessageID: 10}, nil),
+		s.mockEngine.EXPECT().GetReplicationMessages(gomock.Any(), cluster.TestAlternativeClusterName, int64(10)).
+			Return(&types.ReplicationMessages{ReplicationTasks: []*types.ReplicationTask{replicationTask}, LastRetrievedMessageID: 11}, nil),
+	)
+
+	response, err := s.handler.GetReplicationMessages(context.Background(), &types.GetReplicationMessagesRequest{
+		Tokens:      []*types.ReplicationToken{{ShardID: 1, LastRetrievedMessageID: 10}},
+		ClusterName: cluster.TestAlternativeClusterName,
+	})
+	s.NoError(err)
+	s.Equal([]*types.ReplicationTask{replicationTask}, response.MessagesByShard[1].ReplicationTasks)
+}
+
+func (s *handlerSuite) TestGetReplicationMessages_LongPollStopsWhenOtherShardHasTasks() {
+	s.handler.config.ReplicationMessagesLongPollTimeout = dynamicconfig.GetDurationPropertyFn(time.Minute)
+	replicationTask := &types.ReplicationTask{SourceTaskID: 21}
+	s.mockEngine.EXPECT().GetReplicationTasksNotifyCh().Return(make(chan struct{})).Times(2)
+	s.mockEngine.EXPECT().GetReplicationMessages(gomock.Any(), cluster.TestAlternativeClusterName, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, lastReadMessageID int64) (*types.ReplicationMessages, error) {
+			if lastReadMessageID == 20 {
+				return &types.ReplicationMessages{ReplicationTasks: []*types.ReplicationTask{replicationTask}, LastRetrievedMessageID: 21}, nil
+			}
+			return &types.ReplicationMessages{LastRetrievedMessageID: lastReadMessageID}, nil
+		},
+	).Times(2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	response, err := s.handler.GetReplicationMessages(ctx, &types.GetReplicationMessagesRequest{
+		Tokens: []*types.ReplicationToken{
+			{ShardID: 1, LastRetrievedMessageID: 10},
+			{ShardID: 2, LastRetrievedMessageID: 20},
+		},
+		ClusterName: cluster.TestAlternativeClusterName,
+	})
+	s.NoError(err)
+	s.NoError(ctx.Err())
+	s.Empty(response.MessagesByShard[1].ReplicationTasks)
+	s.Equal([]*types.ReplicationTask{replicationTask}, response.MessagesByShard[2].ReplicationTasks)
+}
+
+func (s *handlerSuite) TestGetReplicationMessagesLongPollTimeout() {
+	s.handler.config.ReplicationMessagesLongPollTimeout = dynamicconfig.GetDurationPropertyFn(time.Minute)
+	s.Equal(time.Minute, s.handler.getReplicationMessagesLongPollTimeout(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	s.True(s.handler.getReplicationMessagesLongPollTimeout(ctx) <= 5*time.Second)
+}
+
 func (s *handlerSuite) TestRespondCrossClusterTaskCompleted_FetchNewTask() {
 	s.testRespondCrossClusterTaskCompleted(true)
 }
@@ -840,6 +901,35 @@ func (s *handlerSuite) TestStartWorkflowExecution() {
 	s.Nil(err)
 }
 
+func (s *handlerSuite) TestListThrottledWorkflowIDs() {
+	lastThrottled := time.Unix(1700000000, 0).UTC()
+	s.mockWFCache.EXPECT().GetThrottledWorkflowIDs().Return([]*workflowcache.ThrottledWorkflowID{
+		{
+			DomainName:    "test-domain",
+			WorkflowID:    testWorkflowID,
+			Limit:         workflowcache.OperationSignal.String(),
+			Count:         3,
+			LastThrottled: lastThrottled,
+		},
+	}).Times(1)
+
+	resp, err := s.handler.ListThrottledWorkflowIDs(context.Background(), &types.ListThrottledWorkflowIDsRequest{
+		ShardID: 1,
+	})
+	s.NoError(err)
+	s.Equal(&types.ListThrottledWorkflowIDsResponse{
+		ThrottledWorkflowIDs: []*types.ThrottledWorkflowID{
+			{
+				DomainName:             "test-domain",
+				WorkflowID:             testWorkflowID,
+				Limit:                  "signal",
+				Count:                  3,
+				LastThrottledTimestamp: common.Int64Ptr(lastThrottled.UnixNano()),
+			},
+		},
+	}, resp)
+}
+
 func (s *handlerSuite) TestEmitInfoOrDebugLog() {
 	// test emitInfoOrDebugLog
 	s.mockResource.Logger = testlogger.New(s.Suite.T())
@@ -47,6 +47,7 @@ type Handler interface {
 	DescribeHistoryHost(context.Context, *types.DescribeHistoryHostRequest) (*types.DescribeHistoryHostResponse, error)
 	DescribeMutableState(context.Context, *types.DescribeMutableStateRequest) (*types.DescribeMutableStateResponse, error)
 	DescribeQueue(context.Context, *types.DescribeQueueRequest) (*types.DescribeQueueResponse, error)
+	ListThrottledWorkflowIDs(context.Context, *types.ListThrottledWorkflowIDsRequest) (*types.ListThrottledWorkflowIDsResponse, error)
 	DescribeWorkflowExecution(context.Context, *types.HistoryDescribeWorkflowExecutionRequest) (*types.DescribeWorkflowExecutionResponse, error)
 	GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error)
 	CountDLQMessages(context.Context, *types.CountDLQMessagesRequest) (*types.HistoryCountDLQMessagesResponse, error)
@@ -55,6 +56,8 @@ type Handler interface {
 	GetReplicationMessages(context.Context, *types.GetReplicationMessagesRequest) (*types.GetReplicationMessagesResponse, error)
 	MergeDLQMessages(context.Context, *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error)
 	NotifyFailoverMarkers(context.Context, *types.NotifyFailoverMarkersRequest) error
+	PauseActivity(context.Context, *types.HistoryPauseActivityRequest) error
+	PauseWorkflowExecution(context.Context, *types.HistoryPauseWorkflowExecutionRequest) error
 	PollMutableState(context.Context, *types.PollMutableStateRequest) (*types.PollMutableStateResponse, error)
 	PurgeDLQMessages(context.Context, *types.PurgeDLQMessagesRequest) error
 	QueryWorkflow(context.Context, *types.HistoryQueryWorkflowRequest) (*types.HistoryQueryWorkflowResponse, error)
@@ -69,6 +72,7 @@ type Handler interface {
 	RemoveTask(context.Context, *types.RemoveTaskRequest) error
 	ReplicateEventsV2(context.Context, *types.ReplicateEventsV2Request) error
 	RequestCancelWorkflowExecution(context.Context, *types.HistoryRequestCancelWorkflowExecutionRequest) error
+	ResetActivity(context.Context, *types.HistoryResetActivityRequest) error
 	ResetQueue(context.Context, *types.ResetQueueRequest) error
 	ResetStickyTaskList(context.Context, *types.HistoryResetStickyTaskListRequest) (*types.HistoryResetStickyTaskListResponse, error)
 	ResetWorkflowExecution(context.Context, *types.HistoryResetWorkflowExecutionRequest) (*types.ResetWorkflowExecutionResponse, error)
@@ -85,6 +89,9 @@ type Handler interface {
 	SyncActivity(context.Context, *types.SyncActivityRequest) error
 	SyncShardStatus(context.Context, *types.SyncShardStatusRequest) error
 	TerminateWorkflowExecution(context.Context, *types.HistoryTerminateWorkflowExecutionRequest) error
+	UnpauseActivity(context.Context, *types.HistoryUnpauseActivityRequest) error
+	UnpauseWorkflowExecution(context.Context, *types.HistoryUnpauseWorkflowExecutionRequest) error
+	UpdateActivityOptions(context.Context, *types.HistoryUpdateActivityOptionsRequest) error
 	GetFailoverInfo(context.Context, *types.GetFailoverInfoRequest) (*types.GetFailoverInfoResponse, error)
 	RatelimitUpdate(context.Context, *types.RatelimitUpdateRequest) (*types.RatelimitUpdateResponse, error)
 }
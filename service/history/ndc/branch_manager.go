@@ -30,6 +30,9 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	cndc "github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
@@ -271,5 +274,25 @@ func (r *branchManagerImpl) createNewBranch(
 		}
 	}
 
+	if executionInfo.ConflictResolutions, err = cndc.AppendConflictResolutionRecord(
+		executionInfo.ConflictResolutions,
+		&cndc.ConflictResolutionRecord{
+			Timestamp:      r.shard.GetTimeSource().Now(),
+			Reason:         cndc.ConflictResolutionForked,
+			BranchToken:    baseBranchToken,
+			NewBranchToken: resp.NewBranchToken,
+			ForkEventID:    baseBranchLastEventID,
+		},
+	); err != nil {
+		return 0, err
+	}
+
+	r.shard.GetMetricsClient().IncCounter(metrics.HistoryReplicateEventsV2Scope, metrics.NDCBranchCreatedCount)
+	r.logger.Info("nDCBranchMgr created new branch.",
+		tag.WorkflowBranchToken(baseBranchToken),
+		tag.WorkflowNewBranchToken(resp.NewBranchToken),
+		tag.WorkflowBranchSwitchReason(cndc.ConflictResolutionForked),
+		tag.WorkflowEventID(baseBranchLastEventID),
+	)
 	return newIndex, nil
 }
@@ -29,6 +29,9 @@ import (
 
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	cndc "github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/shard"
@@ -116,6 +119,37 @@ func (r *conflictResolverImpl) prepareMutableState(
 	if err != nil {
 		return nil, false, err
 	}
+
+	targetVersionHistory, err := versionHistories.GetVersionHistory(branchIndex)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// the rebuilt mutable state only knows what is in the history of the new branch,
+	// so carry the conflict resolution records over before adding this one
+	rebuiltExecutionInfo := rebuiltMutableState.GetExecutionInfo()
+	if rebuiltExecutionInfo.ConflictResolutions, err = cndc.AppendConflictResolutionRecord(
+		r.mutableState.GetExecutionInfo().ConflictResolutions,
+		&cndc.ConflictResolutionRecord{
+			Timestamp:       r.shard.GetTimeSource().Now(),
+			Reason:          cndc.ConflictResolutionSwitched,
+			BranchToken:     currentVersionHistory.GetBranchToken(),
+			NewBranchToken:  targetVersionHistory.GetBranchToken(),
+			CurrentVersion:  currentLastItem.Version,
+			IncomingVersion: incomingVersion,
+		},
+	); err != nil {
+		return nil, false, err
+	}
+
+	r.shard.GetMetricsClient().IncCounter(metrics.HistoryReplicateEventsV2Scope, metrics.NDCBranchSwitchedCount)
+	r.logger.Info("nDCConflictResolver switched current branch.",
+		tag.WorkflowBranchToken(currentVersionHistory.GetBranchToken()),
+		tag.WorkflowNewBranchToken(targetVersionHistory.GetBranchToken()),
+		tag.WorkflowBranchSwitchReason(cndc.ConflictResolutionSwitched),
+		tag.CurrentVersion(currentLastItem.Version),
+		tag.IncomingVersion(incomingVersion),
+	)
 	return rebuiltMutableState, true, nil
 }
 
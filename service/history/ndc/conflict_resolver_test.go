@@ -31,6 +31,7 @@ import (
 
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
+	cndc "github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
@@ -126,10 +127,15 @@ func (s *conflictResolverSuite) TestRebuild() {
 
 	s.mockMutableState.EXPECT().GetUpdateCondition().Return(updateCondition).AnyTimes()
 	s.mockMutableState.EXPECT().GetVersionHistories().Return(versionHistories).AnyTimes()
+	conflictResolutions, err := cndc.AppendConflictResolutionRecord(nil, &cndc.ConflictResolutionRecord{
+		Reason: cndc.ConflictResolutionForked,
+	})
+	s.NoError(err)
 	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
-		DomainID:   s.domainID,
-		WorkflowID: s.workflowID,
-		RunID:      s.runID,
+		DomainID:            s.domainID,
+		WorkflowID:          s.workflowID,
+		RunID:               s.runID,
+		ConflictResolutions: conflictResolutions,
 	}).AnyTimes()
 
 	workflowIdentifier := definition.NewWorkflowIdentifier(
@@ -148,6 +154,12 @@ func (s *conflictResolverSuite) TestRebuild() {
 	).Times(1)
 	mockRebuildMutableState.EXPECT().SetVersionHistories(versionHistories).Return(nil).Times(1)
 	mockRebuildMutableState.EXPECT().SetUpdateCondition(updateCondition).Times(1)
+	rebuiltExecutionInfo := &persistence.WorkflowExecutionInfo{
+		DomainID:   s.domainID,
+		WorkflowID: s.workflowID,
+		RunID:      s.runID,
+	}
+	mockRebuildMutableState.EXPECT().GetExecutionInfo().Return(rebuiltExecutionInfo).AnyTimes()
 
 	s.mockStateBuilder.EXPECT().Rebuild(
 		ctx,
@@ -219,10 +231,15 @@ func (s *conflictResolverSuite) TestPrepareMutableState_Rebuild() {
 
 	s.mockMutableState.EXPECT().GetUpdateCondition().Return(updateCondition).AnyTimes()
 	s.mockMutableState.EXPECT().GetVersionHistories().Return(versionHistories).AnyTimes()
+	conflictResolutions, err := cndc.AppendConflictResolutionRecord(nil, &cndc.ConflictResolutionRecord{
+		Reason: cndc.ConflictResolutionForked,
+	})
+	s.NoError(err)
 	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
-		DomainID:   s.domainID,
-		WorkflowID: s.workflowID,
-		RunID:      s.runID,
+		DomainID:            s.domainID,
+		WorkflowID:          s.workflowID,
+		RunID:               s.runID,
+		ConflictResolutions: conflictResolutions,
 	}).AnyTimes()
 
 	workflowIdentifier := definition.NewWorkflowIdentifier(
@@ -241,6 +258,12 @@ func (s *conflictResolverSuite) TestPrepareMutableState_Rebuild() {
 	).Times(1)
 	mockRebuildMutableState.EXPECT().SetVersionHistories(versionHistories).Return(nil).Times(1)
 	mockRebuildMutableState.EXPECT().SetUpdateCondition(updateCondition).Times(1)
+	rebuiltExecutionInfo := &persistence.WorkflowExecutionInfo{
+		DomainID:   s.domainID,
+		WorkflowID: s.workflowID,
+		RunID:      s.runID,
+	}
+	mockRebuildMutableState.EXPECT().GetExecutionInfo().Return(rebuiltExecutionInfo).AnyTimes()
 
 	s.mockStateBuilder.EXPECT().Rebuild(
 		ctx,
@@ -260,4 +283,13 @@ func (s *conflictResolverSuite) TestPrepareMutableState_Rebuild() {
 	s.NoError(err)
 	s.NotNil(rebuiltMutableState)
 	s.True(isRebuilt)
+
+	records, err := cndc.GetConflictResolutionRecords(rebuiltExecutionInfo.ConflictResolutions)
+	s.NoError(err)
+	s.Len(records, 2)
+	s.Equal(cndc.ConflictResolutionForked, records[0].Reason)
+	s.Equal(cndc.ConflictResolutionSwitched, records[1].Reason)
+	s.Equal(branchToken0, records[1].BranchToken)
+	s.Equal(branchToken1, records[1].NewBranchToken)
+	s.Equal(incomingVersion, records[1].IncomingVersion)
 }
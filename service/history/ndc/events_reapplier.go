@@ -24,10 +24,12 @@ package ndc
 
 import (
 	ctx "context"
+	"time"
 
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
+	cndc "github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
 )
@@ -94,18 +96,37 @@ func (r *eventsReapplierImpl) ReapplyEvents(
 		}
 	}
 
+	record := &cndc.ConflictResolutionRecord{
+		Reason: cndc.ConflictResolutionReapplied,
+	}
 	for _, event := range reappliedEvents {
 		signal := event.GetWorkflowExecutionSignaledEventAttributes()
-		if _, err := msBuilder.AddWorkflowExecutionSignaled(
+		reappliedEvent, err := msBuilder.AddWorkflowExecutionSignaled(
 			signal.GetSignalName(),
 			signal.GetInput(),
 			signal.GetIdentity(),
 			"", // Do not set requestID for requests reapplied, because they have already been applied previously
-		); err != nil {
+		)
+		if err != nil {
 			return nil, err
 		}
 		deDupResource := definition.NewEventReappliedID(runID, event.ID, event.Version)
 		msBuilder.UpdateDuplicatedResource(deDupResource)
+		r.metricsClient.IncCounter(metrics.HistoryReapplyEventsScope, metrics.EventReappliedCount)
+		record.Timestamp = time.Unix(0, reappliedEvent.GetTimestamp())
+		record.ReappliedEvents = append(record.ReappliedEvents, cndc.ReappliedEvent{
+			RunID:      runID,
+			EventID:    event.ID,
+			Version:    event.Version,
+			SignalName: signal.GetSignalName(),
+		})
+	}
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	conflictResolutions, err := cndc.AppendConflictResolutionRecord(executionInfo.ConflictResolutions, record)
+	if err != nil {
+		return nil, err
 	}
+	executionInfo.ConflictResolutions = conflictResolutions
 	return reappliedEvents, nil
 }
@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/metrics"
+	cndc "github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/execution"
@@ -109,6 +110,14 @@ func (s *eventReapplicationSuite) TestReapplyEvents_AppliedEvent() {
 	appliedEvent, err := s.reapplication.ReapplyEvents(context.Background(), msBuilderCurrent, events, runID)
 	s.NoError(err)
 	s.Equal(1, len(appliedEvent))
+
+	records, err := cndc.GetConflictResolutionRecords(workflowExecution.ConflictResolutions)
+	s.NoError(err)
+	s.Len(records, 1)
+	s.Equal(cndc.ConflictResolutionReapplied, records[0].Reason)
+	s.Equal([]cndc.ReappliedEvent{
+		{RunID: runID, EventID: event.ID, Version: event.Version, SignalName: attr.GetSignalName()},
+	}, records[0].ReappliedEvents)
 }
 
 func (s *eventReapplicationSuite) TestReapplyEvents_Noop() {
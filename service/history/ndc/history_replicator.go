@@ -403,6 +403,9 @@ func (r *historyReplicatorImpl) applyNonStartEventsToCurrentBranch(
 		)
 		return err
 	}
+	if err := r.refreshTasksIfUnpaused(ctx, mutableState, task.getEvents()); err != nil {
+		return err
+	}
 
 	targetWorkflow := execution.NewWorkflow(
 		ctx,
@@ -453,6 +456,31 @@ func (r *historyReplicatorImpl) applyNonStartEventsToCurrentBranch(
 	return err
 }
 
+// refreshTasksIfUnpaused generates again the tasks that standby task executors dropped
+// while the workflow was paused, once the event unpausing it is replicated
+func (r *historyReplicatorImpl) refreshTasksIfUnpaused(
+	ctx ctx.Context,
+	mutableState execution.MutableState,
+	events []*types.HistoryEvent,
+) error {
+
+	if !mutableState.IsWorkflowExecutionRunning() || mutableState.IsWorkflowExecutionPaused() {
+		return nil
+	}
+	for _, event := range events {
+		if event.GetEventType() == types.EventTypeWorkflowExecutionUnpaused {
+			return execution.NewMutableStateTaskRefresher(
+				r.shard.GetConfig(),
+				r.shard.GetClusterMetadata(),
+				r.shard.GetDomainCache(),
+				r.shard.GetEventsCache(),
+				r.shard.GetShardID(),
+			).RefreshTasks(ctx, mutableState.GetExecutionInfo().StartTimestamp, mutableState)
+		}
+	}
+	return nil
+}
+
 func (r *historyReplicatorImpl) applyNonStartEventsToNoneCurrentBranch(
 	ctx ctx.Context,
 	context execution.Context,
@@ -0,0 +1,124 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+// ClosedWorkflowResultKey returns the query results key of the pre-computed result for the query type
+func ClosedWorkflowResultKey(queryType string) string {
+	return common.ClosedWorkflowQueryResultPrefix + queryType
+}
+
+// IsClosedWorkflowResultKey returns if the query results key is a pre-computed result rather than a query ID
+func IsClosedWorkflowResultKey(key string) bool {
+	return strings.HasPrefix(key, common.ClosedWorkflowQueryResultPrefix)
+}
+
+// SplitClosedWorkflowResults splits the query results of a decision into the pre-computed results
+// and the results of buffered queries
+func SplitClosedWorkflowResults(
+	queryResults map[string]*types.WorkflowQueryResult,
+) (closedWorkflowResults map[string]*types.WorkflowQueryResult, bufferedQueryResults map[string]*types.WorkflowQueryResult) {
+	for key, result := range queryResults {
+		if !IsClosedWorkflowResultKey(key) {
+			continue
+		}
+		if closedWorkflowResults == nil {
+			closedWorkflowResults = make(map[string]*types.WorkflowQueryResult)
+			bufferedQueryResults = make(map[string]*types.WorkflowQueryResult, len(queryResults))
+			for k, v := range queryResults {
+				if !IsClosedWorkflowResultKey(k) {
+					bufferedQueryResults[k] = v
+				}
+			}
+		}
+		closedWorkflowResults[key] = result
+	}
+	if closedWorkflowResults == nil {
+		return nil, queryResults
+	}
+	return closedWorkflowResults, bufferedQueryResults
+}
+
+// EncodeClosedWorkflowResults returns the answered pre-computed results by query type, JSON encoded for
+// the execution info, and the keys of the results which were dropped because they don't fit in maxSize bytes.
+// It returns nil if there is no result to keep.
+func EncodeClosedWorkflowResults(
+	closedWorkflowResults map[string]*types.WorkflowQueryResult,
+	maxSize int,
+) ([]byte, []string, error) {
+	var keys []string
+	for key, result := range closedWorkflowResults {
+		if result.GetResultType() == types.QueryResultTypeAnswered {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	answers := make(map[string][]byte, len(keys))
+	var dropped []string
+	size := 0
+	for _, key := range keys {
+		answer := closedWorkflowResults[key].GetAnswer()
+		if size+len(answer) > maxSize {
+			dropped = append(dropped, key)
+			continue
+		}
+		answers[strings.TrimPrefix(key, common.ClosedWorkflowQueryResultPrefix)] = answer
+		size += len(answer)
+	}
+	if len(answers) == 0 {
+		return nil, dropped, nil
+	}
+
+	encoded, err := json.Marshal(answers)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode closed workflow query results: %v", err)
+	}
+	return encoded, dropped, nil
+}
+
+// GetClosedWorkflowResult returns the pre-computed result for the query type from the encoded results
+// kept in the execution info of the closed workflow
+func GetClosedWorkflowResult(
+	encodedResults []byte,
+	queryType string,
+) ([]byte, bool, error) {
+	if len(encodedResults) == 0 {
+		return nil, false, nil
+	}
+	var answers map[string][]byte
+	if err := json.Unmarshal(encodedResults, &answers); err != nil {
+		return nil, false, fmt.Errorf("failed to decode closed workflow query results: %v", err)
+	}
+	answer, ok := answers[queryType]
+	return answer, ok, nil
+}
@@ -0,0 +1,96 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestClosedWorkflowResultKey(t *testing.T) {
+	key := ClosedWorkflowResultKey("state")
+	assert.Equal(t, "__cadence_query_result/state", key)
+	assert.True(t, IsClosedWorkflowResultKey(key))
+	assert.False(t, IsClosedWorkflowResultKey("some-query-id"))
+}
+
+func TestSplitClosedWorkflowResults(t *testing.T) {
+	queryResults := map[string]*types.WorkflowQueryResult{
+		"some-query-id":              {ResultType: types.QueryResultTypeAnswered.Ptr()},
+		ClosedWorkflowResultKey("a"): {ResultType: types.QueryResultTypeAnswered.Ptr()},
+	}
+	closedWorkflowResults, bufferedQueryResults := SplitClosedWorkflowResults(queryResults)
+	assert.Equal(t, map[string]*types.WorkflowQueryResult{
+		ClosedWorkflowResultKey("a"): queryResults[ClosedWorkflowResultKey("a")],
+	}, closedWorkflowResults)
+	assert.Equal(t, map[string]*types.WorkflowQueryResult{
+		"some-query-id": queryResults["some-query-id"],
+	}, bufferedQueryResults)
+	assert.Len(t, queryResults, 2)
+
+	delete(queryResults, ClosedWorkflowResultKey("a"))
+	closedWorkflowResults, bufferedQueryResults = SplitClosedWorkflowResults(queryResults)
+	assert.Nil(t, closedWorkflowResults)
+	assert.Equal(t, queryResults, bufferedQueryResults)
+}
+
+func TestEncodeClosedWorkflowResults(t *testing.T) {
+	answered := func(answer string) *types.WorkflowQueryResult {
+		return &types.WorkflowQueryResult{
+			ResultType: types.QueryResultTypeAnswered.Ptr(),
+			Answer:     []byte(answer),
+		}
+	}
+
+	encodedResults, dropped, err := EncodeClosedWorkflowResults(map[string]*types.WorkflowQueryResult{
+		ClosedWorkflowResultKey("a"):    answered("12345"),
+		ClosedWorkflowResultKey("b"):    answered("67890"),
+		ClosedWorkflowResultKey("c"):    answered("abcdef"),
+		ClosedWorkflowResultKey("fail"): {ResultType: types.QueryResultTypeFailed.Ptr(), ErrorMessage: "failed"},
+	}, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{ClosedWorkflowResultKey("c")}, dropped)
+
+	answer, ok, err := GetClosedWorkflowResult(encodedResults, "b")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("67890"), answer)
+	_, ok, err = GetClosedWorkflowResult(encodedResults, "c")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	_, ok, err = GetClosedWorkflowResult(nil, "b")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	_, _, err = GetClosedWorkflowResult([]byte("invalid"), "b")
+	assert.Error(t, err)
+
+	encodedResults, dropped, err = EncodeClosedWorkflowResults(map[string]*types.WorkflowQueryResult{
+		ClosedWorkflowResultKey("c"): answered("abcdef"),
+	}, 5)
+	assert.NoError(t, err)
+	assert.Nil(t, encodedResults)
+	assert.Equal(t, []string{ClosedWorkflowResultKey("c")}, dropped)
+}
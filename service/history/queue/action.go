@@ -20,7 +20,11 @@
 
 package queue
 
-import "github.com/uber/cadence/common/types"
+import (
+	"time"
+
+	"github.com/uber/cadence/common/types"
+)
 
 type (
 	// ActionType specifies the type of the Action
@@ -33,6 +37,7 @@ type (
 		GetStateActionAttributes *GetStateActionAttributes
 		GetTasksAttributes       *GetTasksAttributes
 		UpdateTaskAttributes     *UpdateTasksAttributes
+		DescribeAttributes       *DescribeAttributes
 		// add attributes for other action types here
 	}
 
@@ -43,6 +48,7 @@ type (
 		GetStateActionResult *GetStateActionResult
 		GetTasksResult       *GetTasksResult
 		UpdateTaskResult     *UpdateTasksResult
+		DescribeResult       *DescribeResult
 	}
 
 	// ResetActionAttributes contains the parameter for performing Reset Action
@@ -70,6 +76,57 @@ type (
 	// UpdateTasksResult is the result for performing UpdateTask Action
 	UpdateTasksResult struct {
 	}
+
+	// DescribeAttributes contains the parameter for performing Describe Action
+	DescribeAttributes struct{}
+	// DescribeResult is the result for performing Describe Action
+	DescribeResult struct {
+		Queues []*ProcessingQueueInfo
+	}
+
+	// ProcessingQueueInfo is a point-in-time snapshot of a processing queue
+	// and the outstanding tasks it tracks
+	ProcessingQueueInfo struct {
+		Level     int    `json:"level"`
+		AckLevel  string `json:"ackLevel"`
+		ReadLevel string `json:"readLevel"`
+		MaxLevel  string `json:"maxLevel"`
+		// AckKey and MaxKey are the ack and max levels of the queue in a structured form
+		AckKey       *TaskKeyInfo   `json:"ackKey,omitempty"`
+		MaxKey       *TaskKeyInfo   `json:"maxKey,omitempty"`
+		DomainIDs    []string       `json:"domainIDs,omitempty"`
+		ReverseMatch bool           `json:"reverseMatch"`
+		Active       bool           `json:"active"`
+		Throttled    bool           `json:"throttled"`
+		PendingTasks map[string]int `json:"pendingTasks,omitempty"` // domainID -> # of pending tasks
+		StuckTasks   map[string]int `json:"stuckTasks,omitempty"`   // domainID -> # of tasks over the stuck task attempt threshold
+		// RetriedTasks are the outstanding tasks of the queue which failed at least once
+		RetriedTasks []*TaskInfo `json:"retriedTasks,omitempty"`
+		// RecentTasks are the most recently acked tasks of the queue's domains up to its max level,
+		// including the ones the ack level has moved past
+		RecentTasks []*TaskInfo `json:"recentTasks,omitempty"`
+	}
+
+	// TaskKeyInfo is a task key of a processing queue, VisibilityTimestamp is only set for timer tasks
+	TaskKeyInfo struct {
+		VisibilityTimestamp *time.Time `json:"visibilityTimestamp,omitempty"`
+		TaskID              int64      `json:"taskID"`
+	}
+
+	// TaskInfo is a point-in-time snapshot of a task tracked by a processing queue
+	TaskInfo struct {
+		DomainID            string    `json:"domainID"`
+		WorkflowID          string    `json:"workflowID"`
+		RunID               string    `json:"runID"`
+		TaskID              int64     `json:"taskID"`
+		TaskType            int       `json:"taskType"`
+		VisibilityTimestamp time.Time `json:"visibilityTimestamp"`
+		Version             int64     `json:"version"`
+		Level               int       `json:"level"`
+		State               string    `json:"state"`
+		Attempt             int       `json:"attempt"`
+		LastError           string    `json:"lastError,omitempty"`
+	}
 )
 
 const (
@@ -81,6 +138,8 @@ const (
 	ActionTypeGetTasks
 	// ActionTypeUpdateTask is the ActionType to update outstanding task
 	ActionTypeUpdateTask
+	// ActionTypeDescribe is the ActionType for describing processing queues and their pending tasks
+	ActionTypeDescribe
 	// add more ActionType here
 )
 
@@ -120,3 +179,12 @@ func NewUpdateTasksAction(
 		},
 	}
 }
+
+// NewDescribeAction creates a queue action for describing processing queues
+// and the tasks they are tracking
+func NewDescribeAction() *Action {
+	return &Action{
+		ActionType:         ActionTypeDescribe,
+		DescribeAttributes: &DescribeAttributes{},
+	}
+}
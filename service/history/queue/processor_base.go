@@ -23,6 +23,7 @@ package queue
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -79,6 +80,7 @@ type (
 		actionNotifyCh chan actionNotification
 
 		processingQueueCollections []ProcessingQueueCollection
+		recentTasks                *recentTasks
 	}
 )
 
@@ -121,6 +123,7 @@ func newProcessorBase(
 		shutdownCh:                  make(chan struct{}),
 		actionNotifyCh:              make(chan actionNotification),
 		processingQueueCollections:  newProcessingQueueCollections(processingQueueStates, logger, metricsClient),
+		recentTasks:                 newRecentTasks(maxRecentTasks),
 	}
 }
 
@@ -180,12 +183,16 @@ func (p *processorBase) updateAckLevel() (bool, task.Key, error) {
 }
 
 func (p *processorBase) initializeSplitPolicy(lookAheadFunc lookAheadFunc) ProcessingQueueSplitPolicy {
+	// note the order of policies matters, check the comment for aggregated split policy
+	// domains selected by operators are always split out first, even when automatic split is disabled
+	policies := p.initializeSelectedDomainSplitPolicies()
 	if !p.options.EnableSplit() {
-		return nil
+		if len(policies) == 0 {
+			return nil
+		}
+		return NewAggregatedSplitPolicy(policies...)
 	}
 
-	// note the order of policies matters, check the comment for aggregated split policy
-	var policies []ProcessingQueueSplitPolicy
 	maxNewQueueLevel := p.options.SplitMaxLevel()
 
 	pendingTaskThresholds, err := common.ConvertDynamicConfigMapPropertyToIntMap(p.options.PendingTaskSplitThreshold())
@@ -234,6 +241,60 @@ func (p *processorBase) initializeSplitPolicy(lookAheadFunc lookAheadFunc) Proce
 	return NewAggregatedSplitPolicy(policies...)
 }
 
+func (p *processorBase) initializeSelectedDomainSplitPolicies() []ProcessingQueueSplitPolicy {
+	if p.options.SplitDomainLevels == nil {
+		return nil
+	}
+
+	splitDomainLevels, err := common.ConvertDynamicConfigMapPropertyToStringIntMap(p.options.SplitDomainLevels())
+	if err != nil {
+		p.logger.Error("Failed to convert split domain levels", tag.Error(err))
+		return nil
+	}
+
+	domainIDsByLevel := make(map[int]map[string]struct{})
+	for domainID, level := range splitDomainLevels {
+		if level <= defaultProcessingQueueLevel {
+			p.logger.Error("Invalid queue level for selected domain split", tag.WorkflowDomainID(domainID), tag.QueueLevel(level))
+			continue
+		}
+		if _, ok := domainIDsByLevel[level]; !ok {
+			domainIDsByLevel[level] = make(map[string]struct{})
+		}
+		domainIDsByLevel[level][domainID] = struct{}{}
+	}
+
+	var policies []ProcessingQueueSplitPolicy
+	for level, domainIDs := range domainIDsByLevel {
+		policies = append(policies, NewSelectedDomainSplitPolicy(
+			domainIDs,
+			level,
+			p.logger,
+			p.metricsScope,
+		))
+	}
+	return policies
+}
+
+// isThrottledQueue returns true if the queue is not the default level and all the domains
+// it contains are throttled, in which case the queue should only be polled after a backoff
+func (p *processorBase) isThrottledQueue(state ProcessingQueueState) bool {
+	if p.options.ThrottleSplitDomainByDomainID == nil || state.Level() == defaultProcessingQueueLevel {
+		return false
+	}
+
+	domainFilter := state.DomainFilter()
+	if domainFilter.ReverseMatch || len(domainFilter.DomainIDs) == 0 {
+		return false
+	}
+	for domainID := range domainFilter.DomainIDs {
+		if !p.options.ThrottleSplitDomainByDomainID(domainID) {
+			return false
+		}
+	}
+	return true
+}
+
 func (p *processorBase) splitProcessingQueueCollection(splitPolicy ProcessingQueueSplitPolicy, upsertPollTimeFn func(int, time.Time)) {
 	defer p.emitProcessingQueueMetrics()
 
@@ -325,6 +386,8 @@ func (p *processorBase) handleActionNotification(notification actionNotification
 		result, err = p.resetProcessingQueueStates()
 	case ActionTypeGetState:
 		result = p.getProcessingQueueStates()
+	case ActionTypeDescribe:
+		result = p.describeProcessingQueues()
 	default:
 		err = fmt.Errorf("unknown queue action type: %v", notification.action.ActionType)
 	}
@@ -408,6 +471,61 @@ func (p *processorBase) getProcessingQueueStates() *ActionResult {
 	}
 }
 
+func (p *processorBase) describeProcessingQueues() *ActionResult {
+	var stuckTaskThresholds map[int]int
+	if p.options.StuckTaskSplitThreshold != nil {
+		thresholds, err := common.ConvertDynamicConfigMapPropertyToIntMap(p.options.StuckTaskSplitThreshold())
+		if err != nil {
+			p.logger.Error("Failed to convert stuck task threshold", tag.Error(err))
+		} else {
+			stuckTaskThresholds = thresholds
+		}
+	}
+
+	recent := p.recentTasks.getAll()
+	var queueInfos []*ProcessingQueueInfo
+	for _, queueCollection := range p.processingQueueCollections {
+		activeQueue := queueCollection.ActiveQueue()
+		for _, queue := range queueCollection.Queues() {
+			state := queue.State()
+			domainFilter := state.DomainFilter()
+			domainIDs := make([]string, 0, len(domainFilter.DomainIDs))
+			for domainID := range domainFilter.DomainIDs {
+				domainIDs = append(domainIDs, domainID)
+			}
+			sort.Strings(domainIDs)
+
+			tasks := queue.GetTasks()
+			queueInfo := &ProcessingQueueInfo{
+				Level:        state.Level(),
+				AckLevel:     fmt.Sprintf("%+v", state.AckLevel()),
+				ReadLevel:    fmt.Sprintf("%+v", state.ReadLevel()),
+				MaxLevel:     fmt.Sprintf("%+v", state.MaxLevel()),
+				AckKey:       newTaskKeyInfo(state.AckLevel()),
+				MaxKey:       newTaskKeyInfo(state.MaxLevel()),
+				DomainIDs:    domainIDs,
+				ReverseMatch: domainFilter.ReverseMatch,
+				Active:       queue == activeQueue,
+				Throttled:    p.isThrottledQueue(state),
+				PendingTasks: pendingTasksPerDomain(tasks),
+				RetriedTasks: retriedTasks(state.Level(), tasks),
+			}
+			if threshold, ok := stuckTaskThresholds[state.Level()]; ok {
+				queueInfo.StuckTasks = stuckTasksPerDomain(tasks, threshold)
+			}
+			queueInfo.RecentTasks, recent = takeRecentTasks(recent, state)
+			queueInfos = append(queueInfos, queueInfo)
+		}
+	}
+
+	return &ActionResult{
+		ActionType: ActionTypeDescribe,
+		DescribeResult: &DescribeResult{
+			Queues: queueInfos,
+		},
+	}
+}
+
 func (p *processorBase) submitTask(task task.Task) (bool, error) {
 	submitted, err := p.taskProcessor.TrySubmit(task)
 	if err != nil {
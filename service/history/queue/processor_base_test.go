@@ -21,6 +21,7 @@
 package queue
 
 import (
+	"errors"
 	"sort"
 	"testing"
 	"time"
@@ -36,6 +37,7 @@ import (
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	t "github.com/uber/cadence/common/task"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/shard"
@@ -357,6 +359,179 @@ func (s *processorBaseSuite) TestInitializeSplitPolicy_Enabled() {
 	s.Equal(3, len(aggPolicy.policies), "got %v policies, want 3: pending task policy, stuck task policy and random split policy", len(aggPolicy.policies))
 }
 
+func (s *processorBaseSuite) TestInitializeSplitPolicy_SelectedDomains() {
+	processorBase := s.newTestProcessorBase(nil, nil, nil, nil, nil)
+
+	processorBase.options.SplitDomainLevels = dynamicconfig.GetMapPropertyFn(map[string]interface{}{
+		"testDomain1": 5,
+		"testDomain2": float64(5),
+		"testDomain3": 6,
+		"testDomain4": 0, // invalid level, ignored
+	})
+
+	splitPolicy := processorBase.initializeSplitPolicy(nil)
+	s.NotNil(splitPolicy, "got nil split policy, want non-nil")
+	aggPolicy, ok := splitPolicy.(*aggregatedSplitPolicy)
+	s.True(ok, "got %T, want *aggregatedSplitPolicy", splitPolicy)
+	s.Equal(2, len(aggPolicy.policies), "got %v policies, want 2 selected domain split policies", len(aggPolicy.policies))
+
+	domainIDsByLevel := make(map[int]map[string]struct{})
+	for _, policy := range aggPolicy.policies {
+		selectedDomainPolicy, ok := policy.(*selectedDomainSplitPolicy)
+		s.True(ok, "got %T, want *selectedDomainSplitPolicy", policy)
+		domainIDsByLevel[selectedDomainPolicy.newQueueLevel] = selectedDomainPolicy.domainIDs
+	}
+	s.Equal(map[int]map[string]struct{}{
+		5: {"testDomain1": {}, "testDomain2": {}},
+		6: {"testDomain3": {}},
+	}, domainIDsByLevel)
+}
+
+func (s *processorBaseSuite) TestIsThrottledQueue() {
+	processorBase := s.newTestProcessorBase(nil, nil, nil, nil, nil)
+	processorBase.options.ThrottleSplitDomainByDomainID = func(domainID string) bool {
+		return domainID != "testDomain2"
+	}
+
+	testCases := []struct {
+		level        int
+		domainFilter DomainFilter
+		throttled    bool
+	}{
+		{
+			level:        defaultProcessingQueueLevel,
+			domainFilter: NewDomainFilter(map[string]struct{}{"testDomain1": {}}, false),
+			throttled:    false,
+		},
+		{
+			level:        1,
+			domainFilter: NewDomainFilter(map[string]struct{}{"testDomain1": {}}, true),
+			throttled:    false,
+		},
+		{
+			level:        1,
+			domainFilter: NewDomainFilter(map[string]struct{}{"testDomain1": {}, "testDomain2": {}}, false),
+			throttled:    false,
+		},
+		{
+			level:        1,
+			domainFilter: NewDomainFilter(map[string]struct{}{"testDomain1": {}, "testDomain3": {}}, false),
+			throttled:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		state := NewProcessingQueueState(tc.level, newTransferTaskKey(0), newTransferTaskKey(100), tc.domainFilter)
+		s.Equal(tc.throttled, processorBase.isThrottledQueue(state))
+	}
+}
+
+func (s *processorBaseSuite) TestDescribeProcessingQueues() {
+	processingQueueStates := []ProcessingQueueState{
+		NewProcessingQueueState(
+			0,
+			newTransferTaskKey(0),
+			newTransferTaskKey(100),
+			NewDomainFilter(map[string]struct{}{"testDomain2": {}}, true),
+		),
+		NewProcessingQueueState(
+			1,
+			newTransferTaskKey(0),
+			newTransferTaskKey(100),
+			NewDomainFilter(map[string]struct{}{"testDomain2": {}}, false),
+		),
+	}
+	processorBase := s.newTestProcessorBase(processingQueueStates, nil, nil, nil, nil)
+	processorBase.options.StuckTaskSplitThreshold = dynamicconfig.GetMapPropertyFn(
+		map[string]interface{}{"0": 5},
+	)
+
+	visibilityTimestamp := time.Now()
+	newMockTask := func(taskID int64, state t.State, attempt int, lastErr error) *task.MockTask {
+		mockTask := task.NewMockTask(s.controller)
+		mockTask.EXPECT().GetDomainID().Return("testDomain1").AnyTimes()
+		mockTask.EXPECT().GetWorkflowID().Return("testWorkflowID").AnyTimes()
+		mockTask.EXPECT().GetRunID().Return("testRunID").AnyTimes()
+		mockTask.EXPECT().GetTaskID().Return(taskID).AnyTimes()
+		mockTask.EXPECT().GetTaskType().Return(1).AnyTimes()
+		mockTask.EXPECT().GetVisibilityTimestamp().Return(visibilityTimestamp).AnyTimes()
+		mockTask.EXPECT().GetVersion().Return(int64(2)).AnyTimes()
+		mockTask.EXPECT().State().Return(state).AnyTimes()
+		mockTask.EXPECT().GetAttempt().Return(attempt).AnyTimes()
+		mockTask.EXPECT().GetLastError().Return(lastErr).AnyTimes()
+		return mockTask
+	}
+	tasks := make(map[task.Key]task.Task)
+	for i, attempt := range []int{1, 10, 10} {
+		tasks[newTransferTaskKey(int64(i+1))] = newMockTask(int64(i+1), t.TaskStatePending, attempt, errors.New("some error"))
+	}
+	for _, queueCollection := range processorBase.processingQueueCollections {
+		if queueCollection.Level() == 0 {
+			queueCollection.AddTasks(tasks, newTransferTaskKey(10))
+		}
+	}
+	processorBase.recentTasks.add(newTransferTaskKey(0), newMockTask(0, t.TaskStateAcked, 0, nil))
+
+	result := processorBase.describeProcessingQueues()
+	s.Equal(ActionTypeDescribe, result.ActionType)
+
+	queueInfos := result.DescribeResult.Queues
+	sort.Slice(queueInfos, func(i, j int) bool {
+		return queueInfos[i].Level < queueInfos[j].Level
+	})
+	retriedTasks := queueInfos[0].RetriedTasks
+	sort.Slice(retriedTasks, func(i, j int) bool {
+		return retriedTasks[i].TaskID < retriedTasks[j].TaskID
+	})
+	newTaskInfo := func(taskID int64, state string, attempt int, lastErr string) *TaskInfo {
+		return &TaskInfo{
+			DomainID:            "testDomain1",
+			WorkflowID:          "testWorkflowID",
+			RunID:               "testRunID",
+			TaskID:              taskID,
+			TaskType:            1,
+			VisibilityTimestamp: visibilityTimestamp,
+			Version:             2,
+			State:               state,
+			Attempt:             attempt,
+			LastError:           lastErr,
+		}
+	}
+	s.Equal([]*ProcessingQueueInfo{
+		{
+			Level:        0,
+			AckLevel:     "{taskID:0}",
+			ReadLevel:    "{taskID:10}",
+			MaxLevel:     "{taskID:100}",
+			AckKey:       &TaskKeyInfo{TaskID: 0},
+			MaxKey:       &TaskKeyInfo{TaskID: 100},
+			DomainIDs:    []string{"testDomain2"},
+			ReverseMatch: true,
+			Active:       true,
+			PendingTasks: map[string]int{"testDomain1": 3},
+			StuckTasks:   map[string]int{"testDomain1": 2},
+			RetriedTasks: []*TaskInfo{
+				newTaskInfo(1, "pending", 1, "some error"),
+				newTaskInfo(2, "pending", 10, "some error"),
+				newTaskInfo(3, "pending", 10, "some error"),
+			},
+			RecentTasks: []*TaskInfo{newTaskInfo(0, "acked", 0, "")},
+		},
+		{
+			Level:        1,
+			AckLevel:     "{taskID:0}",
+			ReadLevel:    "{taskID:0}",
+			MaxLevel:     "{taskID:100}",
+			AckKey:       &TaskKeyInfo{TaskID: 0},
+			MaxKey:       &TaskKeyInfo{TaskID: 100},
+			DomainIDs:    []string{"testDomain2"},
+			ReverseMatch: false,
+			Active:       true,
+			PendingTasks: map[string]int{},
+		},
+	}, queueInfos)
+}
+
 func (s *processorBaseSuite) TestResetProcessingQueueStates() {
 	processingQueueStates := []ProcessingQueueState{
 		NewProcessingQueueState(
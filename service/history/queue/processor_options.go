@@ -44,6 +44,8 @@ type queueProcessorOptions struct {
 	PendingTaskSplitThreshold            dynamicconfig.MapPropertyFn
 	EnableStuckTaskSplitByDomainID       dynamicconfig.BoolPropertyFnWithDomainIDFilter
 	StuckTaskSplitThreshold              dynamicconfig.MapPropertyFn
+	SplitDomainLevels                    dynamicconfig.MapPropertyFn
+	ThrottleSplitDomainByDomainID        dynamicconfig.BoolPropertyFnWithDomainIDFilter
 	SplitLookAheadDurationByDomainID     dynamicconfig.DurationPropertyFnWithDomainIDFilter
 	PollBackoffInterval                  dynamicconfig.DurationPropertyFn
 	PollBackoffIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
@@ -52,6 +54,8 @@ type queueProcessorOptions struct {
 	EnableGracefulSyncShutdown           dynamicconfig.BoolPropertyFn
 	EnableValidator                      dynamicconfig.BoolPropertyFn
 	ValidationInterval                   dynamicconfig.DurationPropertyFn
+	// FarFutureTimerHorizon is only used by timer queue processors
+	FarFutureTimerHorizon dynamicconfig.DurationPropertyFn
 	// MaxPendingTaskSize is used in cross cluster queue to limit the pending task count
 	MaxPendingTaskSize dynamicconfig.IntPropertyFn
 	MetricScope        int
@@ -0,0 +1,157 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package queue
+
+import (
+	"sync"
+
+	t "github.com/uber/cadence/common/task"
+	"github.com/uber/cadence/service/history/task"
+)
+
+const (
+	// maxRecentTasks is the number of acked tasks a processor remembers
+	maxRecentTasks = 1000
+	// maxRetriedTasks is the number of retried tasks described per processing queue
+	maxRetriedTasks = 1000
+)
+
+var taskStateNames = map[t.State]string{
+	t.TaskStatePending: "pending",
+	t.TaskStateAcked:   "acked",
+	t.TaskStateNacked:  "nacked",
+}
+
+type (
+	// recentTasks remembers the most recently acked tasks of a processor, so they can
+	// still be described after the ack level moves past them and they leave their queue.
+	// Tasks are added by the task processor workers when they are acked.
+	recentTasks struct {
+		sync.Mutex
+
+		tasks []recentTask
+		next  int
+	}
+
+	recentTask struct {
+		key  task.Key
+		info *TaskInfo
+	}
+)
+
+func newRecentTasks(size int) *recentTasks {
+	return &recentTasks{
+		tasks: make([]recentTask, 0, size),
+	}
+}
+
+// add records an acked task, replacing the oldest one once full
+func (r *recentTasks) add(key task.Key, task task.Task) {
+	// the level of a task is only known when it's described
+	info := newTaskInfo(defaultProcessingQueueLevel, task)
+
+	r.Lock()
+	defer r.Unlock()
+
+	if len(r.tasks) < cap(r.tasks) {
+		r.tasks = append(r.tasks, recentTask{key: key, info: info})
+		return
+	}
+	r.tasks[r.next] = recentTask{key: key, info: info}
+	r.next = (r.next + 1) % len(r.tasks)
+}
+
+// getAll returns the recorded tasks, oldest first
+func (r *recentTasks) getAll() []recentTask {
+	r.Lock()
+	defer r.Unlock()
+
+	tasks := make([]recentTask, 0, len(r.tasks))
+	tasks = append(tasks, r.tasks[r.next:]...)
+	return append(tasks, r.tasks[:r.next]...)
+}
+
+// takeRecentTasks returns the recorded tasks owned by a processing queue, which are the ones of the
+// domains it contains up to its max level, and the remaining tasks
+func takeRecentTasks(
+	tasks []recentTask,
+	state ProcessingQueueState,
+) ([]*TaskInfo, []recentTask) {
+	var infos []*TaskInfo
+	remaining := tasks[:0]
+	for _, task := range tasks {
+		if state.MaxLevel().Less(task.key) || !state.DomainFilter().Filter(task.info.DomainID) {
+			remaining = append(remaining, task)
+			continue
+		}
+		info := *task.info
+		info.Level = state.Level()
+		infos = append(infos, &info)
+	}
+	return infos, remaining
+}
+
+func newTaskInfo(level int, task task.Task) *TaskInfo {
+	info := &TaskInfo{
+		DomainID:            task.GetDomainID(),
+		WorkflowID:          task.GetWorkflowID(),
+		RunID:               task.GetRunID(),
+		TaskID:              task.GetTaskID(),
+		TaskType:            task.GetTaskType(),
+		VisibilityTimestamp: task.GetVisibilityTimestamp(),
+		Version:             task.GetVersion(),
+		Level:               level,
+		State:               taskStateNames[task.State()],
+		Attempt:             task.GetAttempt(),
+	}
+	if err := task.GetLastError(); err != nil {
+		info.LastError = err.Error()
+	}
+	return info
+}
+
+func newTaskKeyInfo(key task.Key) *TaskKeyInfo {
+	switch k := key.(type) {
+	case transferTaskKey:
+		return &TaskKeyInfo{TaskID: k.taskID}
+	case timerTaskKey:
+		visibilityTimestamp := k.visibilityTimestamp
+		return &TaskKeyInfo{VisibilityTimestamp: &visibilityTimestamp, TaskID: k.taskID}
+	default:
+		return nil
+	}
+}
+
+// retriedTasks returns a snapshot of the outstanding tasks which failed at least once
+func retriedTasks(level int, tasks []task.Task) []*TaskInfo {
+	var infos []*TaskInfo
+	for _, task := range tasks {
+		if len(infos) == maxRetriedTasks {
+			break
+		}
+		if task.State() != t.TaskStateAcked && task.GetAttempt() > 0 {
+			infos = append(infos, newTaskInfo(level, task))
+		}
+	}
+	return infos
+}
@@ -0,0 +1,107 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	t "github.com/uber/cadence/common/task"
+	"github.com/uber/cadence/service/history/task"
+)
+
+func TestRecentTasks(test *testing.T) {
+	controller := gomock.NewController(test)
+	defer controller.Finish()
+
+	visibilityTimestamp := time.Now()
+	newMockTask := func(domainID string, taskID int64) task.Task {
+		mockTask := task.NewMockTask(controller)
+		mockTask.EXPECT().GetDomainID().Return(domainID).AnyTimes()
+		mockTask.EXPECT().GetWorkflowID().Return("testWorkflowID").AnyTimes()
+		mockTask.EXPECT().GetRunID().Return("testRunID").AnyTimes()
+		mockTask.EXPECT().GetTaskID().Return(taskID).AnyTimes()
+		mockTask.EXPECT().GetTaskType().Return(1).AnyTimes()
+		mockTask.EXPECT().GetVisibilityTimestamp().Return(visibilityTimestamp).AnyTimes()
+		mockTask.EXPECT().GetVersion().Return(int64(1)).AnyTimes()
+		mockTask.EXPECT().State().Return(t.TaskStateAcked).AnyTimes()
+		mockTask.EXPECT().GetAttempt().Return(0).AnyTimes()
+		mockTask.EXPECT().GetLastError().Return(nil).AnyTimes()
+		return mockTask
+	}
+	taskIDs := func(infos []*TaskInfo) []int64 {
+		var ids []int64
+		for _, info := range infos {
+			ids = append(ids, info.TaskID)
+		}
+		return ids
+	}
+
+	recent := newRecentTasks(3)
+	for _, taskID := range []int64{1, 2, 3, 4} {
+		recent.add(newTransferTaskKey(taskID), newMockTask("testDomain1", taskID))
+	}
+	recent.add(newTransferTaskKey(50), newMockTask("testDomain2", 50))
+
+	// the oldest tasks are dropped once full
+	tasks := recent.getAll()
+	assert.Len(test, tasks, 3)
+
+	splitQueueState := NewProcessingQueueState(
+		1,
+		newTransferTaskKey(0),
+		newTransferTaskKey(100),
+		NewDomainFilter(map[string]struct{}{"testDomain2": {}}, false),
+	)
+	firstQueueState := NewProcessingQueueState(
+		defaultProcessingQueueLevel,
+		newTransferTaskKey(0),
+		newTransferTaskKey(3),
+		NewDomainFilter(map[string]struct{}{"testDomain2": {}}, true),
+	)
+	secondQueueState := NewProcessingQueueState(
+		defaultProcessingQueueLevel,
+		newTransferTaskKey(3),
+		newTransferTaskKey(100),
+		NewDomainFilter(map[string]struct{}{"testDomain2": {}}, true),
+	)
+
+	infos, tasks := takeRecentTasks(tasks, splitQueueState)
+	assert.Equal(test, []int64{50}, taskIDs(infos))
+	assert.Equal(test, 1, infos[0].Level)
+	infos, tasks = takeRecentTasks(tasks, firstQueueState)
+	assert.Equal(test, []int64{3}, taskIDs(infos))
+	infos, tasks = takeRecentTasks(tasks, secondQueueState)
+	assert.Equal(test, []int64{4}, taskIDs(infos))
+	assert.Equal(test, defaultProcessingQueueLevel, infos[0].Level)
+	assert.Empty(test, tasks)
+}
+
+func TestNewTaskKeyInfo(test *testing.T) {
+	visibilityTimestamp := time.Now()
+	assert.Equal(test, &TaskKeyInfo{TaskID: 10}, newTaskKeyInfo(newTransferTaskKey(10)))
+	assert.Equal(test, &TaskKeyInfo{VisibilityTimestamp: &visibilityTimestamp, TaskID: 5}, newTaskKeyInfo(newTimerTaskKey(visibilityTimestamp, 5)))
+}
@@ -182,15 +182,8 @@ func (p *pendingTaskSplitPolicy) Evaluate(queue ProcessingQueue) []ProcessingQue
 		return nil
 	}
 
-	pendingTasksPerDomain := make(map[string]int) // domainID -> # of pending tasks
-	for _, task := range queueImpl.outstandingTasks {
-		if task.State() != t.TaskStateAcked {
-			pendingTasksPerDomain[task.GetDomainID()]++
-		}
-	}
-
 	domainToSplit := make(map[string]struct{})
-	for domainID, pendingTasks := range pendingTasksPerDomain {
+	for domainID, pendingTasks := range pendingTasksPerDomain(queue.GetTasks()) {
 		if pendingTasks > threshold && p.enabledByDomainID(domainID) {
 			domainToSplit[domainID] = struct{}{}
 		}
@@ -232,10 +225,8 @@ func (p *stuckTaskSplitPolicy) Evaluate(queue ProcessingQueue) []ProcessingQueue
 	}
 
 	domainToSplit := make(map[string]struct{})
-	for _, task := range queueImpl.outstandingTasks {
-		domainID := task.GetDomainID()
-		attempt := task.GetAttempt()
-		if attempt > threshold && p.enabledByDomainID(domainID) {
+	for domainID := range stuckTasksPerDomain(queue.GetTasks(), threshold) {
+		if p.enabledByDomainID(domainID) {
 			domainToSplit[domainID] = struct{}{}
 		}
 	}
@@ -264,6 +255,11 @@ func (p *stuckTaskSplitPolicy) Evaluate(queue ProcessingQueue) []ProcessingQueue
 func (p *selectedDomainSplitPolicy) Evaluate(queue ProcessingQueue) []ProcessingQueueState {
 	domainBelongsToQueue := false
 	currentQueueState := queue.State()
+	if currentQueueState.Level() == p.newQueueLevel {
+		// domains are already split out to the target level
+		return nil
+	}
+
 	currentDomainFilter := currentQueueState.DomainFilter()
 	for domainID := range p.domainIDs {
 		if currentDomainFilter.Filter(domainID) {
@@ -364,6 +360,29 @@ func (p *aggregatedSplitPolicy) Evaluate(queue ProcessingQueue) []ProcessingQueu
 	return nil
 }
 
+// pendingTasksPerDomain returns the number of tasks not yet acked for each domain
+func pendingTasksPerDomain(tasks []task.Task) map[string]int {
+	pendingTasks := make(map[string]int) // domainID -> # of pending tasks
+	for _, task := range tasks {
+		if task.State() != t.TaskStateAcked {
+			pendingTasks[task.GetDomainID()]++
+		}
+	}
+	return pendingTasks
+}
+
+// stuckTasksPerDomain returns, for each domain, the number of tasks whose
+// attempt exceeds the given threshold
+func stuckTasksPerDomain(tasks []task.Task, attemptThreshold int) map[string]int {
+	stuckTasks := make(map[string]int) // domainID -> # of stuck tasks
+	for _, task := range tasks {
+		if task.GetAttempt() > attemptThreshold {
+			stuckTasks[task.GetDomainID()]++
+		}
+	}
+	return stuckTasks
+}
+
 // splitQueueHelper assumes domainToSplit is not empty
 func splitQueueHelper(
 	queueImpl *processingQueueImpl,
@@ -545,6 +545,20 @@ func (s *splitPolicySuite) TestSelectedDomainSplitPolicy() {
 				),
 			},
 		},
+		{
+			currentState: newProcessingQueueState(
+				newQueueLevel,
+				testKey{ID: 0},
+				testKey{ID: 5},
+				testKey{ID: 10},
+				NewDomainFilter(
+					map[string]struct{}{"testDomain3": {}},
+					false,
+				),
+			),
+			domainToSplit:     map[string]struct{}{"testDomain3": {}},
+			expectedNewStates: nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -78,6 +78,8 @@ type (
 
 		processingQueueReadProgress map[int]timeTaskReadProgress
 
+		farTimers farTimerTier
+
 		updateAckLevelFn                 func() (bool, task.Key, error)
 		splitProcessingQueueCollectionFn func(splitPolicy ProcessingQueueSplitPolicy, upsertPollTimeFn func(int, time.Time))
 	}
@@ -135,6 +137,9 @@ func newTimerQueueProcessorBase(
 				taskExecutor,
 				taskProcessor,
 				processorBase.redispatcher.AddTask,
+				func(t task.Task) {
+					processorBase.recentTasks.add(newTimerTaskKey(taskInfo.GetVisibilityTimestamp(), taskInfo.GetTaskID()), t)
+				},
 				shard.GetConfig().TaskCriticalRetryCount,
 			)
 		},
@@ -338,11 +343,11 @@ func (t *timerQueueProcessorBase) processQueueCollections(levels map[int]struct{
 		} else {
 			// more tasks should be loaded for this processing queue
 			// record the current progress and update the poll time
-			if level == defaultProcessingQueueLevel || !taskChFull {
+			if level == defaultProcessingQueueLevel || (!taskChFull && !t.isThrottledQueue(activeQueue.State())) {
 				t.logger.Debugf("upserting poll time for timer queue at level %d because nextPageToken is not empty and !taskChFull", level)
 				t.upsertPollTime(level, time.Time{})
 			} else {
-				t.logger.Debugf("setting up backoff timer for timer queue at level %d because nextPageToken is not empty and taskChFull", level)
+				t.logger.Debugf("setting up backoff timer for timer queue at level %d because nextPageToken is not empty and taskChFull or queue is throttled", level)
 				t.setupBackoffTimer(level)
 			}
 			t.processingQueueReadProgress[level] = timeTaskReadProgress{
@@ -438,6 +443,7 @@ func (t *timerQueueProcessorBase) handleNewTimer() {
 
 	// New Timer has arrived.
 	t.metricsScope.IncCounter(metrics.NewTimerNotifyCounter)
+	t.farTimers.notifyNewTimer(newTime)
 	// notify all queue collections as they are waiting for the notification when there's
 	// no more task to process. For non-default queue, we choose to do periodic polling
 	// in the future, then we don't need to notify them.
@@ -450,6 +456,7 @@ func (t *timerQueueProcessorBase) handleActionNotification(notification actionNo
 	t.processorBase.handleActionNotification(notification, func() {
 		switch notification.action.ActionType {
 		case ActionTypeReset:
+			t.farTimers.reset()
 			t.upsertPollTime(defaultProcessingQueueLevel, time.Time{})
 		}
 	})
@@ -475,14 +482,25 @@ func (t *timerQueueProcessorBase) readAndFilterTasks(readLevel, maxReadLevel tas
 	}
 
 	if len(resp.NextPageToken) == 0 && lookAheadTask == nil {
-		// only look ahead within the processing queue boundary
-		lookAheadTask, err = t.readLookAheadTask(maxReadLevel, maximumTimerTaskKey)
-		if err != nil {
-			// we don't know if look ahead task exists or not, but we know if it exists,
-			// it's visibility timestamp is larger than or equal to maxReadLevel.
-			// so, create a fake look ahead task so another load can be triggered at that time.
-			lookAheadTask = &persistence.TimerTaskInfo{
-				VisibilityTimestamp: maxReadLevel.(timerTaskKey).visibilityTimestamp,
+		maxReadTimestamp := maxReadLevel.(timerTaskKey).visibilityTimestamp
+		horizon := t.farFutureTimerHorizon()
+		now := t.shard.GetCurrentTime(t.clusterName)
+		if farTimer, ok := t.farTimers.get(maxReadTimestamp, horizon, now); ok {
+			// the next timer is still far in the future, no need to look it up again
+			lookAheadTask = farTimer
+		} else {
+			// only look ahead within the processing queue boundary
+			lookAheadTask, err = t.readLookAheadTask(maxReadLevel, maximumTimerTaskKey)
+			if err != nil {
+				// we don't know if look ahead task exists or not, but we know if it exists,
+				// it's visibility timestamp is larger than or equal to maxReadLevel.
+				// so, create a fake look ahead task so another load can be triggered at that time.
+				lookAheadTask = &persistence.TimerTaskInfo{
+					VisibilityTimestamp: maxReadTimestamp,
+				}
+				t.farTimers.reset()
+			} else {
+				t.farTimers.update(lookAheadTask, maxReadTimestamp, horizon, now)
 			}
 		}
 	}
@@ -530,6 +548,13 @@ func (t *timerQueueProcessorBase) getTimerTasks(readLevel, maxReadLevel task.Key
 	return nil, err
 }
 
+func (t *timerQueueProcessorBase) farFutureTimerHorizon() time.Duration {
+	if t.options.FarFutureTimerHorizon == nil {
+		return 0
+	}
+	return t.options.FarFutureTimerHorizon()
+}
+
 func (t *timerQueueProcessorBase) isProcessNow(expiryTime time.Time) bool {
 	if expiryTime.IsZero() {
 		// return true, but somewhere probably have bug creating empty timerTask.
@@ -676,6 +701,7 @@ func newTimerQueueProcessorOptions(
 		PollBackoffInterval:                  config.QueueProcessorPollBackoffInterval,
 		PollBackoffIntervalJitterCoefficient: config.QueueProcessorPollBackoffIntervalJitterCoefficient,
 		EnableGracefulSyncShutdown:           config.QueueProcessorEnableGracefulSyncShutdown,
+		FarFutureTimerHorizon:                config.TimerProcessorFarFutureTimerHorizon,
 	}
 
 	if isFailover {
@@ -696,6 +722,8 @@ func newTimerQueueProcessorOptions(
 		options.PendingTaskSplitThreshold = config.QueueProcessorPendingTaskSplitThreshold
 		options.EnableStuckTaskSplitByDomainID = config.QueueProcessorEnableStuckTaskSplitByDomainID
 		options.StuckTaskSplitThreshold = config.QueueProcessorStuckTaskSplitThreshold
+		options.SplitDomainLevels = config.QueueProcessorSplitDomainLevels
+		options.ThrottleSplitDomainByDomainID = config.QueueProcessorThrottleSplitDomainByDomainID
 		options.SplitLookAheadDurationByDomainID = config.QueueProcessorSplitLookAheadDurationByDomainID
 
 		options.EnablePersistQueueStates = config.QueueProcessorEnablePersistQueueStates
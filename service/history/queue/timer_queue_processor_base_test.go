@@ -482,6 +482,52 @@ func (s *timerQueueProcessorBaseSuite) TestReadAndFilterTasks_LookAheadFailed_No
 	s.Nil(got.nextPageToken)
 }
 
+func (s *timerQueueProcessorBaseSuite) TestReadAndFilterTasks_FarFutureLookAhead() {
+	readLevel := newTimerTaskKey(time.Now().Add(-10*time.Second), 0)
+	maxReadLevel := newTimerTaskKey(time.Now().Add(1*time.Second), 0)
+
+	request := &persistence.GetTimerIndexTasksRequest{
+		MinTimestamp: readLevel.(timerTaskKey).visibilityTimestamp,
+		MaxTimestamp: maxReadLevel.(timerTaskKey).visibilityTimestamp,
+		BatchSize:    s.mockShard.GetConfig().TimerTaskBatchSize(),
+	}
+	lookAheadRequest := &persistence.GetTimerIndexTasksRequest{
+		MinTimestamp: maxReadLevel.(timerTaskKey).visibilityTimestamp,
+		MaxTimestamp: maximumTimerTaskKey.(timerTaskKey).visibilityTimestamp,
+		BatchSize:    1,
+	}
+	farTimer := &persistence.TimerTaskInfo{
+		VisibilityTimestamp: time.Now().Add(30 * 24 * time.Hour),
+		TaskID:              int64(59),
+	}
+
+	mockExecutionMgr := s.mockShard.Resource.ExecutionMgr
+	mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything, request).Return(&persistence.GetTimerIndexTasksResponse{}, nil).Times(3)
+	mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything, lookAheadRequest).Return(&persistence.GetTimerIndexTasksResponse{
+		Timers: []*persistence.TimerTaskInfo{farTimer},
+	}, nil).Twice()
+
+	timerQueueProcessBase, done := s.newTestTimerQueueProcessorBase(nil, nil, nil, nil, nil)
+	defer done()
+	timerQueueProcessBase.options.FarFutureTimerHorizon = dynamicconfig.GetDurationPropertyFn(24 * time.Hour)
+
+	// the far future timer is only looked up once
+	for i := 0; i != 2; i++ {
+		got, err := timerQueueProcessBase.readAndFilterTasks(readLevel, maxReadLevel, nil)
+		s.Nil(err)
+		s.Empty(got.timerTasks)
+		s.Equal(farTimer, got.lookAheadTask)
+	}
+
+	// a new timer before it invalidates the far future tier
+	timerQueueProcessBase.notifyNewTimer(time.Now())
+	timerQueueProcessBase.handleNewTimer()
+	got, err := timerQueueProcessBase.readAndFilterTasks(readLevel, maxReadLevel, nil)
+	s.Nil(err)
+	s.Equal(farTimer, got.lookAheadTask)
+	mockExecutionMgr.AssertExpectations(s.T())
+}
+
 func (s *timerQueueProcessorBaseSuite) TestNotifyNewTimes() {
 	timerQueueProcessBase, done := s.newTestTimerQueueProcessorBase(nil, nil, nil, nil, nil)
 	defer done()
@@ -0,0 +1,91 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package queue
+
+import (
+	"time"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// farTimerTier splits the timers of a timer queue processor in two tiers. The near tier is read from
+	// persistence on every poll as before. Timers further than the far future horizon beyond the max read
+	// level, e.g. months long user timers or run timeouts, form the far tier: once the earliest of them is
+	// loaded, polls reuse its fire time instead of looking it up again, until it's about to enter the near
+	// tier, a new timer is scheduled before it, or a horizon has passed since it was loaded.
+	// It's only accessed from the processor pump and needs no locking.
+	farTimerTier struct {
+		loaded   bool
+		loadedAt time.Time
+		// nextTimer is the earliest timer of the far tier, nil if the far tier is empty
+		nextTimer *persistence.TimerTaskInfo
+	}
+)
+
+// get returns the earliest far future timer and true if it's known and still in the far tier
+func (f *farTimerTier) get(
+	maxReadLevel time.Time,
+	horizon time.Duration,
+	now time.Time,
+) (*persistence.TimerTaskInfo, bool) {
+	if horizon <= 0 || !f.loaded || now.Sub(f.loadedAt) >= horizon {
+		return nil, false
+	}
+	if f.nextTimer != nil && f.nextTimer.VisibilityTimestamp.Before(maxReadLevel.Add(horizon)) {
+		return nil, false
+	}
+	return f.nextTimer, true
+}
+
+// update records the result of a look ahead read, which is the earliest timer at or after the max read level
+func (f *farTimerTier) update(
+	lookAheadTask *persistence.TimerTaskInfo,
+	maxReadLevel time.Time,
+	horizon time.Duration,
+	now time.Time,
+) {
+	if horizon <= 0 || (lookAheadTask != nil && lookAheadTask.VisibilityTimestamp.Before(maxReadLevel.Add(horizon))) {
+		f.reset()
+		return
+	}
+	f.loaded = true
+	f.loadedAt = now
+	f.nextTimer = lookAheadTask
+}
+
+// notifyNewTimer moves the earliest far future timer to the new timer if it fires before it
+func (f *farTimerTier) notifyNewTimer(newTime time.Time) {
+	if !f.loaded || newTime.IsZero() {
+		return
+	}
+	if f.nextTimer == nil || newTime.Before(f.nextTimer.VisibilityTimestamp) {
+		f.nextTimer = &persistence.TimerTaskInfo{VisibilityTimestamp: newTime}
+	}
+}
+
+func (f *farTimerTier) reset() {
+	f.loaded = false
+	f.loadedAt = time.Time{}
+	f.nextTimer = nil
+}
@@ -0,0 +1,79 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestFarTimerTier(t *testing.T) {
+	now := time.Now()
+	horizon := 24 * time.Hour
+	farTimer := &persistence.TimerTaskInfo{VisibilityTimestamp: now.Add(30 * horizon)}
+
+	tier := farTimerTier{}
+	_, ok := tier.get(now, horizon, now)
+	assert.False(t, ok)
+
+	// near timers are not remembered
+	tier.update(&persistence.TimerTaskInfo{VisibilityTimestamp: now.Add(time.Hour)}, now, horizon, now)
+	_, ok = tier.get(now, horizon, now)
+	assert.False(t, ok)
+
+	tier.update(farTimer, now, horizon, now)
+	timer, ok := tier.get(now.Add(time.Hour), horizon, now.Add(time.Hour))
+	assert.True(t, ok)
+	assert.Equal(t, farTimer, timer)
+	// reload once a horizon has passed
+	_, ok = tier.get(now.Add(time.Hour), horizon, now.Add(horizon))
+	assert.False(t, ok)
+	// the timer is about to enter the near tier
+	_, ok = tier.get(now.Add(29*horizon+time.Hour), horizon, now)
+	assert.False(t, ok)
+
+	// new far future timer before the remembered one
+	newTime := now.Add(10 * horizon)
+	tier.notifyNewTimer(newTime)
+	timer, ok = tier.get(now, horizon, now)
+	assert.True(t, ok)
+	assert.Equal(t, newTime, timer.VisibilityTimestamp)
+	// new near timer
+	tier.notifyNewTimer(now.Add(time.Minute))
+	_, ok = tier.get(now, horizon, now)
+	assert.False(t, ok)
+
+	// empty far tier
+	tier.update(nil, now, horizon, now)
+	timer, ok = tier.get(now, horizon, now)
+	assert.True(t, ok)
+	assert.Nil(t, timer)
+
+	tier.update(farTimer, now, 0, now)
+	_, ok = tier.get(now, horizon, now)
+	assert.False(t, ok)
+}
@@ -33,6 +33,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/lifecycle"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -91,6 +92,7 @@ func NewTransferQueueProcessor(
 	executionCheck invariant.Invariant,
 	wfIDCache workflowcache.WFCache,
 	ratelimitInternalPerWorkflowID dynamicconfig.BoolPropertyFnWithDomainFilter,
+	lifecyclePublisher lifecycle.Publisher,
 ) Processor {
 	logger := shard.GetLogger().WithTags(tag.ComponentTransferQueue)
 	currentClusterName := shard.GetClusterMetadata().GetCurrentClusterName()
@@ -106,6 +108,7 @@ func NewTransferQueueProcessor(
 		config,
 		wfIDCache,
 		ratelimitInternalPerWorkflowID,
+		lifecyclePublisher,
 	)
 
 	activeQueueProcessor := newTransferQueueActiveProcessor(
@@ -137,6 +140,7 @@ func NewTransferQueueProcessor(
 			logger,
 			clusterName,
 			config,
+			lifecyclePublisher,
 		)
 		standbyQueueProcessors[clusterName] = newTransferQueueStandbyProcessor(
 			clusterName,
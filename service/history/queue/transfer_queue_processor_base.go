@@ -125,6 +125,9 @@ func newTransferQueueProcessorBase(
 				taskExecutor,
 				taskProcessor,
 				processorBase.redispatcher.AddTask,
+				func(t task.Task) {
+					processorBase.recentTasks.add(newTransferTaskKey(taskInfo.GetTaskID()), t)
+				},
 				shard.GetConfig().TaskCriticalRetryCount,
 			)
 		},
@@ -455,7 +458,7 @@ func (t *transferQueueProcessorBase) processQueueCollections() {
 		newActiveQueue := queueCollection.ActiveQueue()
 		if more || (newActiveQueue != nil && newActiveQueue != activeQueue) {
 			// more tasks for the current active queue or the active queue has changed
-			if level != defaultProcessingQueueLevel && taskChFull {
+			if level != defaultProcessingQueueLevel && (taskChFull || t.isThrottledQueue(activeQueue.State())) {
 				t.setupBackoffTimer(level)
 			} else {
 				t.readyForProcess(level)
@@ -600,6 +603,8 @@ func newTransferQueueProcessorOptions(
 		options.PendingTaskSplitThreshold = config.QueueProcessorPendingTaskSplitThreshold
 		options.EnableStuckTaskSplitByDomainID = config.QueueProcessorEnableStuckTaskSplitByDomainID
 		options.StuckTaskSplitThreshold = config.QueueProcessorStuckTaskSplitThreshold
+		options.SplitDomainLevels = config.QueueProcessorSplitDomainLevels
+		options.ThrottleSplitDomainByDomainID = config.QueueProcessorThrottleSplitDomainByDomainID
 		options.SplitLookAheadDurationByDomainID = config.QueueProcessorSplitLookAheadDurationByDomainID
 
 		options.EnablePersistQueueStates = config.QueueProcessorEnablePersistQueueStates
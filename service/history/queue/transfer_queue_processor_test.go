@@ -28,6 +28,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"go.uber.org/goleak"
 
+	"github.com/uber/cadence/common/lifecycle"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/reconciliation/invariant"
 	"github.com/uber/cadence/service/history/config"
@@ -69,7 +70,8 @@ func TestTransferQueueProcessor_RequireStartStop(t *testing.T) {
 		mockArchiver,
 		mockInvariant,
 		mockWorkflowCache,
-		ratelimit)
+		ratelimit,
+		lifecycle.NewNoopPublisher())
 	processor.Start()
 	processor.Stop()
 }
@@ -176,7 +176,7 @@ func (s *transferQueueValidatorSuite) TestAckTasks_NoTaskLost() {
 				TaskID: pendingTask.GetTaskID(),
 			},
 			task.QueueTypeActiveTransfer,
-			nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil, nil, nil, nil,
 		)
 	}
 
@@ -207,7 +207,7 @@ func (s *transferQueueValidatorSuite) TestAckTasks_TaskLost() {
 				TaskID: pendingTask.GetTaskID(),
 			},
 			task.QueueTypeActiveTransfer,
-			nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil, nil, nil, nil,
 		)
 	}
 
@@ -29,20 +29,27 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/dlq"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/shard"
 )
 
 const (
 	defaultBeginningMessageID = -1
+	// maxFilteredReadPages bounds the DLQ pages scanned by one filtered read,
+	// the caller continues from the returned page token
+	maxFilteredReadPages = 10
 )
 
 var (
 	errInvalidCluster = &types.BadRequestError{Message: "Invalid target cluster name."}
+
+	errDLQTaskNotFoundInSource = &types.EntityNotExistsError{Message: "Replication task of DLQ message is not found in source cluster."}
 )
 
 type (
@@ -60,6 +67,7 @@ type (
 			lastMessageID int64,
 			pageSize int,
 			pageToken []byte,
+			filter *types.DLQFilter,
 		) ([]*types.ReplicationTask, []*types.ReplicationTaskInfo, []byte, error)
 		PurgeMessages(
 			ctx context.Context,
@@ -72,6 +80,7 @@ type (
 			lastMessageID int64,
 			pageSize int,
 			pageToken []byte,
+			filter *types.DLQFilter,
 		) ([]byte, error)
 	}
 
@@ -85,6 +94,11 @@ type (
 
 		mu           sync.Mutex
 		latestCounts map[string]int64
+
+		// only accessed by the re-drive loop
+		redriveStates       map[dlqRedriveKey]*dlqRedriveState
+		redriveCursors      map[string]int64
+		redriveRateLimiters *quotas.Collection
 	}
 )
 
@@ -106,6 +120,12 @@ func NewDLQHandler(
 		logger:        shard.GetLogger(),
 		metricsClient: shard.GetMetricsClient(),
 		done:          make(chan struct{}),
+
+		redriveStates:  map[dlqRedriveKey]*dlqRedriveState{},
+		redriveCursors: map[string]int64{},
+		redriveRateLimiters: quotas.NewCollection(
+			quotas.NewSimpleDynamicRateLimiterFactory(shard.GetConfig().ReplicationDLQRedriveDomainRPS),
+		),
 	}
 }
 
@@ -116,6 +136,7 @@ func (r *dlqHandlerImpl) Start() {
 	}
 
 	go r.emitDLQSizeMetricsLoop()
+	go r.redriveLoop()
 	r.logger.Info("DLQ handler started.")
 }
 
@@ -190,26 +211,103 @@ func (r *dlqHandlerImpl) emitDLQSizeMetricsLoop() {
 	}
 }
 
+// ReadMessages reads DLQ messages. If a filter is given, only matching messages are returned and up to
+// maxFilteredReadPages pages are scanned, so a page may be empty while there are more messages.
 func (r *dlqHandlerImpl) ReadMessages(
 	ctx context.Context,
 	sourceCluster string,
 	lastMessageID int64,
 	pageSize int,
 	pageToken []byte,
+	filter *types.DLQFilter,
 ) ([]*types.ReplicationTask, []*types.ReplicationTaskInfo, []byte, error) {
 
-	return r.readMessagesWithAckLevel(
-		ctx,
-		sourceCluster,
-		lastMessageID,
-		pageSize,
-		pageToken,
-	)
+	if dlq.IsEmptyFilter(filter) {
+		return r.readMessagesWithAckLevel(
+			ctx,
+			sourceCluster,
+			defaultBeginningMessageID,
+			lastMessageID,
+			pageSize,
+			pageToken,
+		)
+	}
+
+	var matchedTasks []*types.ReplicationTask
+	var matchedInfos []*types.ReplicationTaskInfo
+	for page := 0; page < maxFilteredReadPages && len(matchedInfos) < pageSize; page++ {
+		tasks, rawTasks, token, err := r.readMessagesWithAckLevel(ctx, sourceCluster, defaultBeginningMessageID, lastMessageID, pageSize, pageToken)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		pageTasks, pageInfos := filterMessages(filter, tasks, rawTasks)
+		matchedTasks = append(matchedTasks, pageTasks...)
+		matchedInfos = append(matchedInfos, pageInfos...)
+
+		pageToken = token
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+	return matchedTasks, matchedInfos, pageToken, nil
+}
+
+// filterMessages returns the DLQ messages matching the filter, along with their hydrated tasks
+func filterMessages(
+	filter *types.DLQFilter,
+	tasks []*types.ReplicationTask,
+	rawTasks []*types.ReplicationTaskInfo,
+) ([]*types.ReplicationTask, []*types.ReplicationTaskInfo) {
+
+	replicationTasks := map[int64]*types.ReplicationTask{}
+	for _, task := range tasks {
+		replicationTasks[task.SourceTaskID] = task
+	}
+
+	var matchedTasks []*types.ReplicationTask
+	var matchedInfos []*types.ReplicationTaskInfo
+	for _, raw := range rawTasks {
+		if !dlq.MatchesFilter(filter, raw.DomainID, raw.WorkflowID, toReplicationTaskType(raw.TaskType), raw.ErrorClass) {
+			continue
+		}
+		if task, ok := replicationTasks[raw.TaskID]; ok {
+			matchedTasks = append(matchedTasks, task)
+		}
+		matchedInfos = append(matchedInfos, raw)
+	}
+	return matchedTasks, matchedInfos
+}
+
+// getErrorClass returns the error class a DLQ message is reported with. A message whose task can't be read
+// from the source cluster anymore is reported as missing in source, whatever it failed with.
+func getErrorClass(persistedErrorClass string, hydrated bool) string {
+	switch {
+	case !hydrated:
+		return dlq.ErrorClassMissingInSource
+	case persistedErrorClass == "":
+		return dlq.ErrorClassUnknown
+	default:
+		return persistedErrorClass
+	}
+}
+
+func toReplicationTaskType(taskType int16) *types.ReplicationTaskType {
+	switch taskType {
+	case persistence.ReplicationTaskTypeHistory:
+		return types.ReplicationTaskTypeHistoryV2.Ptr()
+	case persistence.ReplicationTaskTypeSyncActivity:
+		return types.ReplicationTaskTypeSyncActivity.Ptr()
+	case persistence.ReplicationTaskTypeFailoverMarker:
+		return types.ReplicationTaskTypeFailoverMarker.Ptr()
+	default:
+		return nil
+	}
 }
 
 func (r *dlqHandlerImpl) readMessagesWithAckLevel(
 	ctx context.Context,
 	sourceCluster string,
+	readLevel int64,
 	lastMessageID int64,
 	pageSize int,
 	pageToken []byte,
@@ -220,7 +318,7 @@ func (r *dlqHandlerImpl) readMessagesWithAckLevel(
 		&persistence.GetReplicationTasksFromDLQRequest{
 			SourceClusterName: sourceCluster,
 			GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
-				ReadLevel:     defaultBeginningMessageID,
+				ReadLevel:     readLevel,
 				MaxReadLevel:  lastMessageID,
 				BatchSize:     pageSize,
 				NextPageToken: pageToken,
@@ -263,6 +361,15 @@ func (r *dlqHandlerImpl) readMessagesWithAckLevel(
 		}
 	}
 
+	hydrated := make(map[int64]struct{}, len(response.ReplicationTasks))
+	for _, task := range response.ReplicationTasks {
+		hydrated[task.SourceTaskID] = struct{}{}
+	}
+	for i, info := range taskInfo {
+		_, ok := hydrated[info.TaskID]
+		info.ErrorClass = getErrorClass(resp.Tasks[i].ErrorClass, ok)
+	}
+
 	return response.ReplicationTasks, taskInfo, resp.NextPageToken, nil
 }
 
@@ -292,15 +399,21 @@ func (r *dlqHandlerImpl) MergeMessages(
 	lastMessageID int64,
 	pageSize int,
 	pageToken []byte,
+	filter *types.DLQFilter,
 ) ([]byte, error) {
 
 	if _, ok := r.taskExecutors[sourceCluster]; !ok {
 		return nil, errInvalidCluster
 	}
 
+	if !dlq.IsEmptyFilter(filter) {
+		return r.mergeFilteredMessages(ctx, sourceCluster, filter, lastMessageID, pageSize, pageToken)
+	}
+
 	tasks, rawTasks, token, err := r.readMessagesWithAckLevel(
 		ctx,
 		sourceCluster,
+		defaultBeginningMessageID,
 		lastMessageID,
 		pageSize,
 		pageToken,
@@ -342,3 +455,49 @@ func (r *dlqHandlerImpl) MergeMessages(
 	}
 	return token, nil
 }
+
+// mergeFilteredMessages applies the messages of one DLQ page which match the filter and deletes them
+// one by one. Messages which don't match stay in the DLQ.
+func (r *dlqHandlerImpl) mergeFilteredMessages(
+	ctx context.Context,
+	sourceCluster string,
+	filter *types.DLQFilter,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]byte, error) {
+
+	tasks, rawTasks, token, err := r.readMessagesWithAckLevel(
+		ctx,
+		sourceCluster,
+		defaultBeginningMessageID,
+		lastMessageID,
+		pageSize,
+		pageToken,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	replicationTasks := map[int64]*types.ReplicationTask{}
+	for _, task := range tasks {
+		replicationTasks[task.SourceTaskID] = task
+	}
+
+	_, matchedInfos := filterMessages(filter, tasks, rawTasks)
+	for _, raw := range matchedInfos {
+		// same as an unfiltered merge, a message whose task is gone from the source cluster is dropped
+		if task, ok := replicationTasks[raw.TaskID]; ok {
+			if _, err := r.taskExecutors[sourceCluster].execute(task, true); err != nil {
+				return nil, err
+			}
+		}
+		if err := r.shard.GetExecutionManager().DeleteReplicationTaskFromDLQ(ctx, &persistence.DeleteReplicationTaskFromDLQRequest{
+			SourceClusterName: sourceCluster,
+			TaskID:            raw.TaskID,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return token, nil
+}
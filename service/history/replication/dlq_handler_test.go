@@ -32,6 +32,7 @@ import (
 
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common/dlq"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -141,12 +142,13 @@ func (s *dlqHandlerSuite) TestReadMessages_OK() {
 	s.adminClient.EXPECT().
 		GetDLQReplicationMessages(ctx, gomock.Any()).
 		Return(&types.GetDLQReplicationMessagesResponse{}, nil)
-	tasks, info, token, err := s.messageHandler.ReadMessages(ctx, s.sourceCluster, lastMessageID, pageSize, pageToken)
+	tasks, info, token, err := s.messageHandler.ReadMessages(ctx, s.sourceCluster, lastMessageID, pageSize, pageToken, nil)
 	s.NoError(err)
 	s.Nil(token)
 	s.Equal(resp.Tasks[0].GetDomainID(), info[0].GetDomainID())
 	s.Equal(resp.Tasks[0].GetWorkflowID(), info[0].GetWorkflowID())
 	s.Equal(resp.Tasks[0].GetRunID(), info[0].GetRunID())
+	s.Equal(dlq.ErrorClassMissingInSource, info[0].GetErrorClass())
 	s.Nil(tasks)
 }
 
@@ -216,12 +218,129 @@ func (s *dlqHandlerSuite) TestMergeMessages_OK() {
 			InclusiveEndTaskID:   lastMessageID,
 		}).Return(&persistence.RangeDeleteReplicationTaskFromDLQResponse{TasksCompleted: persistence.UnknownNumRowsAffected}, nil).Times(1)
 
-	token, err := s.messageHandler.MergeMessages(ctx, s.sourceCluster, lastMessageID, pageSize, pageToken)
+	token, err := s.messageHandler.MergeMessages(ctx, s.sourceCluster, lastMessageID, pageSize, pageToken, nil)
 	s.NoError(err)
 	s.Nil(token)
 	s.Equal(1, len(s.taskExecutor.executedTasks))
 }
 
+func (s *dlqHandlerSuite) TestReadMessages_Filtered() {
+	ctx := context.Background()
+	lastMessageID := int64(2)
+	pageSize := 2
+
+	resp := &persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{
+				DomainID:   uuid.New(),
+				WorkflowID: uuid.New(),
+				RunID:      uuid.New(),
+				TaskType:   persistence.ReplicationTaskTypeHistory,
+				TaskID:     1,
+				ErrorClass: dlq.ErrorClassMissingEvents,
+			},
+			{
+				DomainID:   uuid.New(),
+				WorkflowID: uuid.New(),
+				RunID:      uuid.New(),
+				TaskType:   persistence.ReplicationTaskTypeHistory,
+				TaskID:     2,
+			},
+		},
+	}
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: s.sourceCluster,
+		GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+			ReadLevel:    -1,
+			MaxReadLevel: lastMessageID,
+			BatchSize:    pageSize,
+		},
+	}).Return(resp, nil).Times(3)
+
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(s.sourceCluster).Return(s.adminClient).AnyTimes()
+	s.adminClient.EXPECT().
+		GetDLQReplicationMessages(ctx, gomock.Any()).
+		Return(&types.GetDLQReplicationMessagesResponse{
+			ReplicationTasks: []*types.ReplicationTask{
+				{TaskType: types.ReplicationTaskTypeHistoryV2.Ptr(), SourceTaskID: 1},
+			},
+		}, nil).Times(3)
+
+	for _, tc := range []struct {
+		filter        *types.DLQFilter
+		expectedTasks int
+		expectedIDs   []int64
+	}{
+		{filter: &types.DLQFilter{ErrorClass: dlq.ErrorClassMissingEvents}, expectedTasks: 1, expectedIDs: []int64{1}},
+		{filter: &types.DLQFilter{ErrorClass: dlq.ErrorClassMissingInSource}, expectedTasks: 0, expectedIDs: []int64{2}},
+		{filter: &types.DLQFilter{WorkflowID: resp.Tasks[1].WorkflowID, TaskType: types.ReplicationTaskTypeHistoryV2.Ptr()}, expectedTasks: 0, expectedIDs: []int64{2}},
+	} {
+		tasks, info, token, err := s.messageHandler.ReadMessages(ctx, s.sourceCluster, lastMessageID, pageSize, nil, tc.filter)
+		s.NoError(err)
+		s.Nil(token)
+		s.Len(tasks, tc.expectedTasks)
+		var ids []int64
+		for _, i := range info {
+			ids = append(ids, i.TaskID)
+		}
+		s.Equal(tc.expectedIDs, ids)
+	}
+}
+
+func (s *dlqHandlerSuite) TestMergeMessages_Filtered() {
+	ctx := context.Background()
+	lastMessageID := int64(2)
+	pageSize := 2
+
+	resp := &persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{
+				DomainID:   "domain-1",
+				WorkflowID: uuid.New(),
+				RunID:      uuid.New(),
+				TaskType:   persistence.ReplicationTaskTypeHistory,
+				TaskID:     1,
+			},
+			{
+				DomainID:   "domain-2",
+				WorkflowID: uuid.New(),
+				RunID:      uuid.New(),
+				TaskType:   persistence.ReplicationTaskTypeHistory,
+				TaskID:     2,
+			},
+		},
+		NextPageToken: []byte("next"),
+	}
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: s.sourceCluster,
+		GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+			ReadLevel:    -1,
+			MaxReadLevel: lastMessageID,
+			BatchSize:    pageSize,
+		},
+	}).Return(resp, nil).Times(1)
+
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(s.sourceCluster).Return(s.adminClient).AnyTimes()
+	s.adminClient.EXPECT().
+		GetDLQReplicationMessages(ctx, gomock.Any()).
+		Return(&types.GetDLQReplicationMessagesResponse{
+			ReplicationTasks: []*types.ReplicationTask{
+				{TaskType: types.ReplicationTaskTypeHistoryV2.Ptr(), SourceTaskID: 1},
+				{TaskType: types.ReplicationTaskTypeHistoryV2.Ptr(), SourceTaskID: 2},
+			},
+		}, nil)
+	s.executionManager.On("DeleteReplicationTaskFromDLQ", mock.Anything, &persistence.DeleteReplicationTaskFromDLQRequest{
+		SourceClusterName: s.sourceCluster,
+		TaskID:            1,
+	}).Return(nil).Times(1)
+
+	token, err := s.messageHandler.MergeMessages(ctx, s.sourceCluster, lastMessageID, pageSize, nil, &types.DLQFilter{DomainID: "domain-1"})
+	s.NoError(err)
+	s.Equal(1, len(s.taskExecutor.executedTasks))
+	s.Equal(int64(1), s.taskExecutor.executedTasks[0].SourceTaskID)
+	s.Equal([]byte("next"), token)
+}
+
 type fakeTaskExecutor struct {
 	scope int
 	err   error
@@ -0,0 +1,223 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	dlqRedriveTimerCoefficient = 0.05
+	// dlqRedrivePassTimeout bounds one re-drive pass over the DLQ of a source cluster
+	dlqRedrivePassTimeout = 2 * time.Minute
+)
+
+type (
+	dlqRedriveKey struct {
+		sourceCluster string
+		taskID        int64
+	}
+
+	// dlqRedriveState tracks the retries of a single DLQ message
+	dlqRedriveState struct {
+		attempts    int
+		nextAttempt time.Time
+		permanent   bool
+	}
+)
+
+func (r *dlqHandlerImpl) redriveLoop() {
+	shardID := r.shard.GetShardID()
+	getInterval := func() time.Duration {
+		return backoff.JitDuration(
+			r.shard.GetConfig().ReplicationDLQRedriveInterval(shardID),
+			dlqRedriveTimerCoefficient,
+		)
+	}
+
+	timer := time.NewTimer(getInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			if r.shard.GetConfig().EnableReplicationDLQRedrive() {
+				for sourceCluster := range r.taskExecutors {
+					ctx, cancel := context.WithTimeout(context.Background(), dlqRedrivePassTimeout)
+					if err := r.redriveMessages(ctx, sourceCluster); err != nil {
+						r.logger.Warn("Failed to re-drive replication DLQ messages.", tag.SourceCluster(sourceCluster), tag.Error(err))
+					}
+					cancel()
+				}
+			}
+			timer.Reset(getInterval())
+		case <-r.done:
+			return
+		}
+	}
+}
+
+// redriveMessages retries the DLQ messages of the source cluster which are due. A message which is applied
+// successfully is removed from the DLQ. A transient failure is retried with exponential backoff in a later
+// round. A permanent failure is reported once and the message is left in the DLQ for the operator.
+// The pass resumes after the last message of the previous pass, so a large DLQ is walked across several
+// rounds instead of retrying the same first messages every round.
+func (r *dlqHandlerImpl) redriveMessages(ctx context.Context, sourceCluster string) error {
+	shardID := r.shard.GetShardID()
+	config := r.shard.GetConfig()
+	batchSize := config.ReplicationDLQRedriveBatchSize(shardID)
+	scope := r.metricsClient.Scope(
+		metrics.ReplicationDLQStatsScope,
+		metrics.SourceClusterTag(sourceCluster),
+		metrics.InstanceTag(strconv.Itoa(shardID)),
+	)
+
+	cursor, ok := r.redriveCursors[sourceCluster]
+	if !ok {
+		cursor = defaultBeginningMessageID
+	}
+	seen := map[dlqRedriveKey]struct{}{}
+	attempted := 0
+	var pageToken []byte
+	for {
+		tasks, rawTasks, token, err := r.readMessagesWithAckLevel(ctx, sourceCluster, cursor, common.EndMessageID, batchSize, pageToken)
+		if err != nil {
+			return err
+		}
+
+		replicationTasks := map[int64]*types.ReplicationTask{}
+		for _, task := range tasks {
+			replicationTasks[task.SourceTaskID] = task
+		}
+
+		for _, raw := range rawTasks {
+			key := dlqRedriveKey{sourceCluster: sourceCluster, taskID: raw.TaskID}
+			seen[key] = struct{}{}
+			r.redriveCursors[sourceCluster] = raw.TaskID
+
+			state, ok := r.redriveStates[key]
+			if !ok {
+				state = &dlqRedriveState{}
+				r.redriveStates[key] = state
+			}
+			if state.permanent || r.shard.GetTimeSource().Now().Before(state.nextAttempt) {
+				continue
+			}
+			domainName, err := r.shard.GetDomainCache().GetDomainName(raw.DomainID)
+			if err != nil {
+				r.logger.Warn("Failed to get domain name for DLQ message.", tag.WorkflowDomainID(raw.DomainID), tag.Error(err))
+				continue
+			}
+			if !r.redriveRateLimiters.For(domainName).Allow() {
+				continue
+			}
+
+			attempted++
+			err = r.redriveMessage(ctx, sourceCluster, raw, replicationTasks[raw.TaskID])
+			select {
+			case <-r.done:
+				// the handler is stopped, the failure is not the message's fault
+				return nil
+			default:
+			}
+			switch {
+			case err == nil:
+				delete(r.redriveStates, key)
+				scope.Tagged(metrics.DomainTag(domainName)).IncCounter(metrics.ReplicationDLQRedriveSuccess)
+			case shouldRetryDLQ(err, r.done):
+				state.attempts++
+				state.nextAttempt = r.shard.GetTimeSource().Now().Add(getRedriveBackoff(
+					state.attempts,
+					config.ReplicationDLQRedriveInitialBackoff(shardID),
+					config.ReplicationDLQRedriveMaxBackoff(shardID),
+				))
+				scope.Tagged(metrics.DomainTag(domainName)).IncCounter(metrics.ReplicationDLQRedriveTransientFailure)
+			default:
+				state.permanent = true
+				scope.Tagged(metrics.DomainTag(domainName)).IncCounter(metrics.ReplicationDLQRedrivePermanentFailure)
+				r.logger.Error("Failed to re-drive replication DLQ message with permanent error.",
+					tag.WorkflowDomainName(domainName),
+					tag.WorkflowID(raw.WorkflowID),
+					tag.WorkflowRunID(raw.RunID),
+					tag.TaskID(raw.TaskID),
+					tag.Error(err),
+				)
+			}
+
+			if attempted >= batchSize {
+				return nil
+			}
+		}
+
+		if len(token) == 0 {
+			break
+		}
+		pageToken = token
+	}
+
+	// the end of the DLQ has been reached, the next pass starts over from the beginning
+	// and the messages after the cursor which were removed by operators can be forgotten
+	delete(r.redriveCursors, sourceCluster)
+	for key := range r.redriveStates {
+		if _, ok := seen[key]; !ok && key.sourceCluster == sourceCluster && key.taskID > cursor {
+			delete(r.redriveStates, key)
+		}
+	}
+	return nil
+}
+
+func (r *dlqHandlerImpl) redriveMessage(
+	ctx context.Context,
+	sourceCluster string,
+	raw *types.ReplicationTaskInfo,
+	task *types.ReplicationTask,
+) error {
+	if task == nil {
+		return errDLQTaskNotFoundInSource
+	}
+	if _, err := r.taskExecutors[sourceCluster].execute(task, true); err != nil {
+		return err
+	}
+	return r.shard.GetExecutionManager().DeleteReplicationTaskFromDLQ(ctx, &persistence.DeleteReplicationTaskFromDLQRequest{
+		SourceClusterName: sourceCluster,
+		TaskID:            raw.TaskID,
+	})
+}
+
+func getRedriveBackoff(attempts int, initialBackoff, maxBackoff time.Duration) time.Duration {
+	wait := initialBackoff
+	for i := 1; i < attempts && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxBackoff {
+		return maxBackoff
+	}
+	return wait
+}
@@ -0,0 +1,147 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func (s *dlqHandlerSuite) setupRedriveMessage(taskID int64, hydrated bool) {
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, mock.Anything).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", TaskID: taskID},
+		},
+	}, nil)
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(s.sourceCluster).Return(s.adminClient).AnyTimes()
+	response := &types.GetDLQReplicationMessagesResponse{}
+	if hydrated {
+		response.ReplicationTasks = []*types.ReplicationTask{
+			{TaskType: types.ReplicationTaskTypeHistoryV2.Ptr(), SourceTaskID: taskID},
+		}
+	}
+	s.adminClient.EXPECT().GetDLQReplicationMessages(gomock.Any(), gomock.Any()).Return(response, nil).AnyTimes()
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName("domain-id").Return("domain", nil).AnyTimes()
+}
+
+func (s *dlqHandlerSuite) TestRedriveMessages_Success() {
+	s.setupRedriveMessage(1, true)
+	s.executionManager.On("DeleteReplicationTaskFromDLQ", mock.Anything, &persistence.DeleteReplicationTaskFromDLQRequest{
+		SourceClusterName: s.sourceCluster,
+		TaskID:            1,
+	}).Return(nil).Once()
+
+	s.NoError(s.messageHandler.redriveMessages(context.Background(), s.sourceCluster))
+	s.Equal(1, len(s.taskExecutor.executedTasks))
+	s.Empty(s.messageHandler.redriveStates)
+}
+
+func (s *dlqHandlerSuite) TestRedriveMessages_TransientFailureBacksOff() {
+	s.setupRedriveMessage(1, true)
+	s.taskExecutor.err = &types.InternalServiceError{Message: "transient"}
+
+	s.NoError(s.messageHandler.redriveMessages(context.Background(), s.sourceCluster))
+	state := s.messageHandler.redriveStates[dlqRedriveKey{sourceCluster: s.sourceCluster, taskID: 1}]
+	s.Equal(1, state.attempts)
+	s.False(state.permanent)
+
+	// the message is not due yet
+	s.NoError(s.messageHandler.redriveMessages(context.Background(), s.sourceCluster))
+	s.Equal(1, len(s.taskExecutor.executedTasks))
+	s.executionManager.AssertNotCalled(s.T(), "DeleteReplicationTaskFromDLQ", mock.Anything, mock.Anything)
+}
+
+func (s *dlqHandlerSuite) TestRedriveMessages_PermanentFailure() {
+	s.setupRedriveMessage(1, false)
+
+	s.NoError(s.messageHandler.redriveMessages(context.Background(), s.sourceCluster))
+	state := s.messageHandler.redriveStates[dlqRedriveKey{sourceCluster: s.sourceCluster, taskID: 1}]
+	s.True(state.permanent)
+	s.Empty(s.taskExecutor.executedTasks)
+	s.executionManager.AssertNotCalled(s.T(), "DeleteReplicationTaskFromDLQ", mock.Anything, mock.Anything)
+}
+
+func (s *dlqHandlerSuite) TestRedriveMessages_ResumesFromCursor() {
+	s.messageHandler.redriveCursors[s.sourceCluster] = 5
+	s.messageHandler.redriveStates[dlqRedriveKey{sourceCluster: s.sourceCluster, taskID: 3}] = &dlqRedriveState{permanent: true}
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, mock.MatchedBy(func(request *persistence.GetReplicationTasksFromDLQRequest) bool {
+		return request.ReadLevel == 5
+	})).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", TaskID: 6},
+		},
+	}, nil).Once()
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(s.sourceCluster).Return(s.adminClient).AnyTimes()
+	s.adminClient.EXPECT().GetDLQReplicationMessages(gomock.Any(), gomock.Any()).Return(&types.GetDLQReplicationMessagesResponse{
+		ReplicationTasks: []*types.ReplicationTask{
+			{TaskType: types.ReplicationTaskTypeHistoryV2.Ptr(), SourceTaskID: 6},
+		},
+	}, nil)
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName("domain-id").Return("domain", nil).AnyTimes()
+	s.executionManager.On("DeleteReplicationTaskFromDLQ", mock.Anything, &persistence.DeleteReplicationTaskFromDLQRequest{
+		SourceClusterName: s.sourceCluster,
+		TaskID:            6,
+	}).Return(nil).Once()
+
+	s.NoError(s.messageHandler.redriveMessages(context.Background(), s.sourceCluster))
+	s.Equal(1, len(s.taskExecutor.executedTasks))
+	// the end of the DLQ was reached, the next pass starts from the beginning
+	s.NotContains(s.messageHandler.redriveCursors, s.sourceCluster)
+	// the message before the cursor was not scanned so its state is kept
+	s.Contains(s.messageHandler.redriveStates, dlqRedriveKey{sourceCluster: s.sourceCluster, taskID: 3})
+}
+
+func (s *dlqHandlerSuite) TestRedriveMessages_BatchLimitKeepsCursor() {
+	s.config.ReplicationDLQRedriveBatchSize = func(int) int { return 1 }
+	s.setupRedriveMessage(1, true)
+	s.executionManager.On("DeleteReplicationTaskFromDLQ", mock.Anything, mock.Anything).Return(nil).Once()
+
+	s.NoError(s.messageHandler.redriveMessages(context.Background(), s.sourceCluster))
+	s.Equal(int64(1), s.messageHandler.redriveCursors[s.sourceCluster])
+}
+
+func TestShouldRetryDLQ(t *testing.T) {
+	done := make(chan struct{})
+	assert.False(t, shouldRetryDLQ(nil, done))
+	assert.False(t, shouldRetryDLQ(errDLQTaskNotFoundInSource, done))
+	assert.False(t, shouldRetryDLQ(&types.BadRequestError{}, done))
+	assert.True(t, shouldRetryDLQ(&types.InternalServiceError{}, done))
+	assert.True(t, shouldRetryDLQ(&types.RetryTaskV2Error{}, done))
+
+	close(done)
+	assert.False(t, shouldRetryDLQ(&types.InternalServiceError{}, done))
+}
+
+func TestGetRedriveBackoff(t *testing.T) {
+	assert.Equal(t, time.Minute, getRedriveBackoff(1, time.Minute, time.Hour))
+	assert.Equal(t, 4*time.Minute, getRedriveBackoff(3, time.Minute, time.Hour))
+	assert.Equal(t, time.Hour, getRedriveBackoff(100, time.Minute, time.Hour))
+}
@@ -0,0 +1,175 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+const statusReadBatchSize = 100
+
+type (
+	// ShardStatus describes how far a remote cluster is behind on the replication tasks of one shard.
+	// It is computed on the source side, from the shard's persisted replication level and the
+	// replication tasks that are still in the replication queue.
+	ShardStatus struct {
+		ShardID       int
+		RemoteCluster string
+		// LastReplicatedTaskID is the last task ID acknowledged by the remote cluster
+		LastReplicatedTaskID int64
+		// ShardUpdatedTime is when the shard, and with it the replication level, was last persisted.
+		// It is not the time of the last replicated task, acked tasks are purged from the queue.
+		ShardUpdatedTime time.Time
+		// PendingTasks is the number of tasks not yet acknowledged, capped by the read limit
+		PendingTasks int
+		// PendingTasksTruncated is true if there are more pending tasks than PendingTasks
+		PendingTasksTruncated bool
+		// PendingTasksByDomain breaks PendingTasks down by domain ID
+		PendingTasksByDomain map[string]int
+		// LagByDomain is the age of the oldest pending task of each domain
+		LagByDomain map[string]time.Duration
+		// OldestPendingTaskTime is the creation time of the oldest pending task, zero if none
+		OldestPendingTaskTime time.Time
+		// Lag is the age of the oldest pending task, zero if the remote cluster is caught up
+		Lag time.Duration
+	}
+)
+
+// GetShardStatus reads the replication status of a shard towards each of the given remote clusters.
+// If remoteClusters is empty, all clusters the shard has a replication level for are reported.
+// At most maxPendingTasks pending tasks are read per remote cluster.
+func GetShardStatus(
+	ctx context.Context,
+	shardManager persistence.ShardManager,
+	executionManager persistence.ExecutionManager,
+	shardID int,
+	remoteClusters []string,
+	maxPendingTasks int,
+	now time.Time,
+) ([]*ShardStatus, error) {
+	resp, err := shardManager.GetShard(ctx, &persistence.GetShardRequest{ShardID: shardID})
+	if err != nil {
+		return nil, err
+	}
+	shardInfo := resp.ShardInfo
+
+	if len(remoteClusters) == 0 {
+		for remoteCluster := range shardInfo.ClusterReplicationLevel {
+			remoteClusters = append(remoteClusters, remoteCluster)
+		}
+		sort.Strings(remoteClusters)
+	}
+
+	result := make([]*ShardStatus, 0, len(remoteClusters))
+	for _, remoteCluster := range remoteClusters {
+		level, ok := shardInfo.ClusterReplicationLevel[remoteCluster]
+		if !ok {
+			// new cluster always starts from -1
+			level = -1
+		}
+		status := &ShardStatus{
+			ShardID:              shardID,
+			RemoteCluster:        remoteCluster,
+			LastReplicatedTaskID: level,
+			ShardUpdatedTime:     shardInfo.UpdatedAt,
+			PendingTasksByDomain: make(map[string]int),
+			LagByDomain:          make(map[string]time.Duration),
+		}
+		if err := readPendingTasks(ctx, executionManager, status, maxPendingTasks, now); err != nil {
+			return nil, err
+		}
+		result = append(result, status)
+	}
+	return result, nil
+}
+
+func readPendingTasks(
+	ctx context.Context,
+	executionManager persistence.ExecutionManager,
+	status *ShardStatus,
+	maxPendingTasks int,
+	now time.Time,
+) error {
+	var pageToken []byte
+	for {
+		batchSize := statusReadBatchSize
+		if remaining := maxPendingTasks - status.PendingTasks; remaining < batchSize {
+			batchSize = remaining
+		}
+		if batchSize <= 0 {
+			status.PendingTasksTruncated = true
+			return nil
+		}
+
+		resp, err := executionManager.GetReplicationTasks(ctx, &persistence.GetReplicationTasksRequest{
+			ReadLevel:     status.LastReplicatedTaskID,
+			MaxReadLevel:  math.MaxInt64,
+			BatchSize:     batchSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, task := range resp.Tasks {
+			if task.TaskID <= status.LastReplicatedTaskID {
+				continue
+			}
+			creationTime := time.Unix(0, task.CreationTime)
+			if status.OldestPendingTaskTime.IsZero() || creationTime.Before(status.OldestPendingTaskTime) {
+				status.OldestPendingTaskTime = creationTime
+				status.Lag = now.Sub(creationTime)
+			}
+			if lag := now.Sub(creationTime); lag > status.LagByDomain[task.DomainID] {
+				status.LagByDomain[task.DomainID] = lag
+			}
+			status.PendingTasks++
+			status.PendingTasksByDomain[task.DomainID]++
+		}
+
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+// EstimateCatchUpTime estimates how long the remote cluster needs to catch up, based on how much the lag
+// shrank since a previous sample of the same shard. It returns false if the lag is not shrinking.
+func (s *ShardStatus) EstimateCatchUpTime(previous *ShardStatus, now time.Time, previousTime time.Time) (time.Duration, bool) {
+	if s.Lag == 0 {
+		return 0, true
+	}
+	interval := now.Sub(previousTime)
+	if previous == nil || interval <= 0 || previous.Lag <= s.Lag {
+		return 0, false
+	}
+	// lag reduced per unit of wall clock time
+	rate := float64(previous.Lag-s.Lag) / float64(interval)
+	return time.Duration(float64(s.Lag) / rate), true
+}
@@ -0,0 +1,141 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestGetShardStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	shardManager := persistence.NewMockShardManager(ctrl)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	now := time.Unix(1000, 0)
+	updatedAt := now.Add(-time.Second)
+
+	shardManager.EXPECT().GetShard(gomock.Any(), &persistence.GetShardRequest{ShardID: 1}).Return(&persistence.GetShardResponse{
+		ShardInfo: &persistence.ShardInfo{
+			ShardID:                 1,
+			UpdatedAt:               updatedAt,
+			ClusterReplicationLevel: map[string]int64{cluster2: 10},
+		},
+	}, nil)
+	executionManager.EXPECT().GetReplicationTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetReplicationTasksRequest) (*persistence.GetReplicationTasksResponse, error) {
+			if request.ReadLevel == 10 {
+				return &persistence.GetReplicationTasksResponse{Tasks: []*persistence.ReplicationTaskInfo{
+					{TaskID: 11, DomainID: "d1", CreationTime: now.Add(-time.Minute).UnixNano()},
+					{TaskID: 12, DomainID: "d2", CreationTime: now.Add(-time.Second).UnixNano()},
+				}}, nil
+			}
+			assert.Equal(t, int64(-1), request.ReadLevel)
+			assert.Equal(t, 2, request.BatchSize)
+			return &persistence.GetReplicationTasksResponse{
+				Tasks: []*persistence.ReplicationTaskInfo{
+					{TaskID: 1, DomainID: "d1", CreationTime: now.Add(-time.Hour).UnixNano()},
+					{TaskID: 2, DomainID: "d1", CreationTime: now.Add(-time.Minute).UnixNano()},
+				},
+				NextPageToken: []byte("token"),
+			}, nil
+		}).Times(2)
+
+	statuses, err := GetShardStatus(context.Background(), shardManager, executionManager, 1, []string{cluster2, cluster3}, 2, now)
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+
+	assert.Equal(t, &ShardStatus{
+		ShardID:               1,
+		RemoteCluster:         cluster2,
+		LastReplicatedTaskID:  10,
+		ShardUpdatedTime:      updatedAt,
+		PendingTasks:          2,
+		PendingTasksByDomain:  map[string]int{"d1": 1, "d2": 1},
+		LagByDomain:           map[string]time.Duration{"d1": time.Minute, "d2": time.Second},
+		OldestPendingTaskTime: now.Add(-time.Minute),
+		Lag:                   time.Minute,
+	}, statuses[0])
+
+	assert.Equal(t, &ShardStatus{
+		ShardID:               1,
+		RemoteCluster:         cluster3,
+		LastReplicatedTaskID:  -1,
+		ShardUpdatedTime:      updatedAt,
+		PendingTasks:          2,
+		PendingTasksTruncated: true,
+		PendingTasksByDomain:  map[string]int{"d1": 2},
+		LagByDomain:           map[string]time.Duration{"d1": time.Hour},
+		OldestPendingTaskTime: now.Add(-time.Hour),
+		Lag:                   time.Hour,
+	}, statuses[1])
+}
+
+func TestGetShardStatus_AllRemoteClusters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	shardManager := persistence.NewMockShardManager(ctrl)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	now := time.Unix(1000, 0)
+
+	shardManager.EXPECT().GetShard(gomock.Any(), gomock.Any()).Return(&persistence.GetShardResponse{
+		ShardInfo: &persistence.ShardInfo{
+			ClusterReplicationLevel: map[string]int64{cluster3: 5, cluster2: 10},
+		},
+	}, nil)
+	executionManager.EXPECT().GetReplicationTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetReplicationTasksResponse{}, nil).Times(2)
+
+	statuses, err := GetShardStatus(context.Background(), shardManager, executionManager, 1, nil, 10, now)
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	assert.Equal(t, cluster2, statuses[0].RemoteCluster)
+	assert.Equal(t, int64(10), statuses[0].LastReplicatedTaskID)
+	assert.Equal(t, time.Duration(0), statuses[0].Lag)
+	assert.Equal(t, cluster3, statuses[1].RemoteCluster)
+	assert.Equal(t, int64(5), statuses[1].LastReplicatedTaskID)
+}
+
+func TestShardStatus_EstimateCatchUpTime(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	caughtUp := &ShardStatus{}
+	eta, ok := caughtUp.EstimateCatchUpTime(nil, now, now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), eta)
+
+	current := &ShardStatus{Lag: time.Minute}
+	_, ok = current.EstimateCatchUpTime(nil, now, now.Add(-time.Minute))
+	assert.False(t, ok)
+	_, ok = current.EstimateCatchUpTime(&ShardStatus{Lag: time.Minute}, now, now.Add(-time.Minute))
+	assert.False(t, ok)
+
+	// lag went down by 30s within 10s, so 60s of lag is gone in 20s
+	eta, ok = current.EstimateCatchUpTime(&ShardStatus{Lag: 90 * time.Second}, now, now.Add(-10*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, 20*time.Second, eta)
+}
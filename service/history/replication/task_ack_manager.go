@@ -25,6 +25,7 @@ package replication
 import (
 	"context"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/uber/cadence/common"
@@ -43,8 +44,15 @@ type (
 		scope  metrics.Scope
 		logger log.Logger
 
-		reader taskReader
-		store  *TaskStore
+		reader   taskReader
+		store    *TaskStore
+		notifier *taskNotifier
+	}
+
+	// taskNotifier wakes up the pollers waiting for new replication tasks
+	taskNotifier struct {
+		sync.Mutex
+		ch chan struct{}
 	}
 
 	ackLevelStore interface {
@@ -74,12 +82,31 @@ func NewTaskAckManager(
 			metrics.ReplicatorQueueProcessorScope,
 			metrics.InstanceTag(strconv.Itoa(shardID)),
 		),
-		logger: logger.WithTags(tag.ComponentReplicationAckManager),
-		reader: reader,
-		store:  store,
+		logger:   logger.WithTags(tag.ComponentReplicationAckManager),
+		reader:   reader,
+		store:    store,
+		notifier: &taskNotifier{ch: make(chan struct{})},
 	}
 }
 
+// NotifyNewTasks wakes up the pollers waiting for new replication tasks
+func (t *TaskAckManager) NotifyNewTasks() {
+	t.notifier.Lock()
+	defer t.notifier.Unlock()
+
+	close(t.notifier.ch)
+	t.notifier.ch = make(chan struct{})
+}
+
+// GetNewTasksNotifyCh returns a channel which is closed by the next NotifyNewTasks call.
+// The channel should be obtained before reading tasks so that no notification is missed.
+func (t *TaskAckManager) GetNewTasksNotifyCh() <-chan struct{} {
+	t.notifier.Lock()
+	defer t.notifier.Unlock()
+
+	return t.notifier.ch
+}
+
 func (t *TaskAckManager) GetTasks(ctx context.Context, pollingCluster string, lastReadTaskID int64) (*types.ReplicationMessages, error) {
 	if lastReadTaskID == common.EmptyMessageID {
 		lastReadTaskID = t.ackLevels.GetClusterReplicationLevel(pollingCluster)
@@ -229,6 +229,32 @@ func TestTaskAckManager_GetTasks(t *testing.T) {
 	}
 }
 
+func TestTaskAckManager_NotifyNewTasks(t *testing.T) {
+	ackManager := NewTaskAckManager(testShardID, &fakeAckLevelStore{}, metrics.NewNoopMetricsClient(), log.NewNoop(), nil, nil)
+
+	notifyCh := ackManager.GetNewTasksNotifyCh()
+	select {
+	case <-notifyCh:
+		t.Fatal("notify channel should not be closed before new tasks")
+	default:
+	}
+
+	ackManager.NotifyNewTasks()
+	select {
+	case <-notifyCh:
+	default:
+		t.Fatal("notify channel should be closed after new tasks")
+	}
+
+	nextNotifyCh := ackManager.GetNewTasksNotifyCh()
+	assert.NotEqual(t, notifyCh, nextNotifyCh)
+	select {
+	case <-nextNotifyCh:
+		t.Fatal("new notify channel should not be closed")
+	default:
+	}
+}
+
 type fakeAckLevelStore struct {
 	remote    map[string]int64
 	readLevel int64
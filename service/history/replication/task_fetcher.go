@@ -69,6 +69,7 @@ type (
 		config         *config.Config
 		logger         log.Logger
 		remotePeer     admin.Client
+		streamClient   admin.StreamClient
 		rateLimiter    *quotas.DynamicRateLimiter
 		requestChan    chan *request
 		ctx            context.Context
@@ -104,6 +105,7 @@ func NewTaskFetchers(
 			currentCluster,
 			config,
 			remoteFrontendClient,
+			clientBean.GetRemoteAdminStreamClient(clusterName),
 		)
 		fetchers = append(fetchers, fetcher)
 	}
@@ -151,6 +153,7 @@ func newReplicationTaskFetcher(
 	currentCluster string,
 	config *config.Config,
 	sourceFrontend admin.Client,
+	sourceStream admin.StreamClient,
 ) TaskFetcher {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &taskFetcherImpl{
@@ -158,6 +161,7 @@ func newReplicationTaskFetcher(
 		config:         config,
 		logger:         logger.WithTags(tag.ClusterName(sourceCluster)),
 		remotePeer:     sourceFrontend,
+		streamClient:   sourceStream,
 		currentCluster: currentCluster,
 		sourceCluster:  sourceCluster,
 		rateLimiter:    quotas.NewDynamicRateLimiter(config.ReplicationTaskProcessorHostQPS.AsFloat64()),
@@ -174,6 +178,12 @@ func (f *taskFetcherImpl) Start() {
 		return
 	}
 
+	if f.streamClient != nil && f.config.ReplicationTaskFetcherStreamEnabled() {
+		go f.streamTasks()
+		f.logger.Info("Replication task fetcher started streaming.")
+		return
+	}
+
 	for i := 0; i < f.config.ReplicationTaskFetcherParallelism(); i++ {
 		go f.fetchTasks()
 	}
@@ -196,27 +206,113 @@ func (f *taskFetcherImpl) Stop() {
 
 // fetchTasks collects getReplicationTasks request from shards and send out aggregated request to source frontend.
 func (f *taskFetcherImpl) fetchTasks() {
+	defer close(f.stoppedCh)
+
+	f.pollTasks(make(map[int32]*request), nil)
+}
+
+// streamTasks streams replication tasks from source frontend. Whenever the stream fails, or streaming is turned off,
+// it polls source frontend for the stream retry wait before trying to open a new stream.
+func (f *taskFetcherImpl) streamTasks() {
+	defer close(f.stoppedCh)
+
+	requestByShard := make(map[int32]*request)
+	for f.ctx.Err() == nil {
+		if f.config.ReplicationTaskFetcherStreamEnabled() {
+			err := f.streamTasksOnce(requestByShard)
+			if f.ctx.Err() != nil {
+				return
+			}
+			f.logger.Warn("Replication task stream failed, falling back to polling.", tag.Error(err))
+		}
+
+		f.pollTasks(requestByShard, time.After(backoff.JitDuration(
+			f.config.ReplicationTaskFetcherStreamRetryWait(),
+			f.config.ReplicationTaskFetcherTimerJitterCoefficient(),
+		)))
+	}
+}
+
+// streamTasksOnce sends the tokens of the requests from shards to source frontend over a single stream, and
+// distributes the tasks source frontend pushes back. Tokens are aggregated the same way requests are when polling.
+// Requests still waiting for tasks when the stream fails are left in requestByShard.
+func (f *taskFetcherImpl) streamTasksOnce(requestByShard map[int32]*request) error {
+	ctx, cancel := context.WithCancel(f.ctx)
+	defer cancel()
+
+	stream, err := f.streamClient.StreamReplicationMessages(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.CloseSend()
+
+	responseCh := make(chan *types.StreamReplicationMessagesResponse)
+	errCh := make(chan error, 1)
+	go func() {
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			select {
+			case responseCh <- response:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var tokens []*types.ReplicationToken
+	for _, request := range requestByShard {
+		tokens = append(tokens, request.token)
+	}
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case request := <-f.requestChan:
+			f.addRequest(requestByShard, request)
+			tokens = append(tokens, request.token)
+
+		case <-timer.C:
+			if len(tokens) > 0 {
+				if err := stream.Send(&types.StreamReplicationMessagesRequest{
+					Tokens:      tokens,
+					ClusterName: f.currentCluster,
+				}); err != nil {
+					return err
+				}
+				tokens = nil
+			}
+			timer.Reset(backoff.JitDuration(
+				f.config.ReplicationTaskFetcherAggregationInterval(),
+				f.config.ReplicationTaskFetcherTimerJitterCoefficient(),
+			))
+		case response := <-responseCh:
+			f.distributeTasks(requestByShard, response.GetMessagesByShard(), true)
+		case err := <-errCh:
+			return err
+		case <-f.ctx.Done():
+			return nil
+		}
+	}
+}
+
+// pollTasks collects getReplicationTasks request from shards and send out aggregated request to source frontend,
+// until stopCh fires or the fetcher is stopped.
+func (f *taskFetcherImpl) pollTasks(requestByShard map[int32]*request, stopCh <-chan time.Time) {
 	timer := time.NewTimer(backoff.JitDuration(
 		f.config.ReplicationTaskFetcherAggregationInterval(),
 		f.config.ReplicationTaskFetcherTimerJitterCoefficient(),
 	))
 	defer timer.Stop()
-	defer close(f.stoppedCh)
 
-	requestByShard := make(map[int32]*request)
 	for {
 		select {
 		case request := <-f.requestChan:
 			// Here we only add the request to map. We will wait until timer fires to send the request to remote.
-			if req, ok := requestByShard[request.token.GetShardID()]; ok && req != request {
-				// since this replication task fetcher is per host
-				// and replication task processor is per shard
-				// during shard movement, duplicated requests can appear
-				// if shard moved from this host, to this host.
-				f.logger.Error("Get replication task request already exist for shard.", tag.ShardID(int(request.token.GetShardID())))
-				close(req.respChan)
-			}
-			requestByShard[request.token.GetShardID()] = request
+			f.addRequest(requestByShard, request)
 
 		case <-timer.C:
 			// When timer fires, we collect all the requests we have so far and attempt to send them to remote.
@@ -237,12 +333,26 @@ func (f *taskFetcherImpl) fetchTasks() {
 					f.config.ReplicationTaskFetcherTimerJitterCoefficient(),
 				))
 			}
+		case <-stopCh:
+			return
 		case <-f.ctx.Done():
 			return
 		}
 	}
 }
 
+func (f *taskFetcherImpl) addRequest(requestByShard map[int32]*request, request *request) {
+	if req, ok := requestByShard[request.token.GetShardID()]; ok && req != request {
+		// since this replication task fetcher is per host
+		// and replication task processor is per shard
+		// during shard movement, duplicated requests can appear
+		// if shard moved from this host, to this host.
+		f.logger.Error("Get replication task request already exist for shard.", tag.ShardID(int(request.token.GetShardID())))
+		close(req.respChan)
+	}
+	requestByShard[request.token.GetShardID()] = request
+}
+
 func (f *taskFetcherImpl) fetchAndDistributeTasks(requestByShard map[int32]*request) error {
 	if len(requestByShard) == 0 {
 		// We don't receive tasks from previous fetch so processors are all sleeping.
@@ -262,14 +372,26 @@ func (f *taskFetcherImpl) fetchAndDistributeTasks(requestByShard map[int32]*requ
 	}
 
 	f.logger.Debug("Successfully fetched replication tasks.", tag.Counter(len(messagesByShard)))
+	f.distributeTasks(requestByShard, messagesByShard, false)
+	return nil
+}
+
+func (f *taskFetcherImpl) distributeTasks(
+	requestByShard map[int32]*request,
+	messagesByShard map[int32]*types.ReplicationMessages,
+	streamed bool,
+) {
 	for shardID, tasks := range messagesByShard {
-		request := requestByShard[shardID]
+		request, ok := requestByShard[shardID]
+		if !ok {
+			// a stream can push a shard again if its token was sent twice, after its request was answered
+			continue
+		}
+		request.streamed = streamed
 		request.respChan <- tasks
 		close(request.respChan)
 		delete(requestByShard, shardID)
 	}
-
-	return nil
 }
 
 func (f *taskFetcherImpl) getMessages(requestByShard map[int32]*request) (map[int32]*types.ReplicationMessages, error) {
@@ -22,12 +22,14 @@ package replication
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/resource"
@@ -44,6 +46,7 @@ type (
 		mockResource   *resource.Test
 		config         *config.Config
 		frontendClient *admin.MockClient
+		streamClient   *admin.MockStreamClient
 		taskFetcher    *taskFetcherImpl
 	}
 )
@@ -67,6 +70,7 @@ func (s *taskFetcherSuite) SetupTest() {
 
 	s.mockResource = resource.NewTest(s.T(), s.controller, metrics.History)
 	s.frontendClient = s.mockResource.RemoteAdminClient
+	s.streamClient = admin.NewMockStreamClient(s.controller)
 	logger := log.NewNoop()
 	s.config = config.NewForTest()
 
@@ -76,6 +80,7 @@ func (s *taskFetcherSuite) SetupTest() {
 		"active",
 		s.config,
 		s.frontendClient,
+		s.streamClient,
 	).(*taskFetcherImpl)
 }
 
@@ -140,3 +145,74 @@ func (s *taskFetcherSuite) TestFetchAndDistributeTasks() {
 	respToken := <-respChan
 	s.Equal(messageByShared[0], respToken)
 }
+
+func (s *taskFetcherSuite) TestStreamTasks() {
+	s.config.ReplicationTaskFetcherStreamEnabled = dynamicconfig.GetBoolPropertyFn(true)
+	s.config.ReplicationTaskFetcherAggregationInterval = dynamicconfig.GetDurationPropertyFn(time.Millisecond)
+	token := &types.ReplicationToken{
+		ShardID:                0,
+		LastProcessedMessageID: 1,
+		LastRetrievedMessageID: 2,
+	}
+	messages := &types.ReplicationMessages{LastRetrievedMessageID: 3}
+
+	stream := admin.NewMockReplicationMessagesStream(s.controller)
+	responseCh := make(chan *types.StreamReplicationMessagesResponse, 1)
+	s.streamClient.EXPECT().StreamReplicationMessages(gomock.Any()).Return(stream, nil)
+	stream.EXPECT().Send(&types.StreamReplicationMessagesRequest{
+		Tokens:      []*types.ReplicationToken{token},
+		ClusterName: "active",
+	}).DoAndReturn(func(*types.StreamReplicationMessagesRequest) error {
+		responseCh <- &types.StreamReplicationMessagesResponse{
+			MessagesByShard: map[int32]*types.ReplicationMessages{0: messages},
+		}
+		return nil
+	})
+	stream.EXPECT().Recv().DoAndReturn(func() (*types.StreamReplicationMessagesResponse, error) {
+		return <-responseCh, nil
+	}).AnyTimes()
+	stream.EXPECT().CloseSend().Return(nil).AnyTimes()
+
+	s.taskFetcher.Start()
+	defer s.taskFetcher.Stop()
+
+	respChan := make(chan *types.ReplicationMessages, 1)
+	request := &request{
+		token:    token,
+		respChan: respChan,
+	}
+	s.taskFetcher.GetRequestChan() <- request
+	s.Equal(messages, <-respChan)
+	s.True(request.streamed)
+}
+
+func (s *taskFetcherSuite) TestStreamTasks_FallbackToPolling() {
+	s.config.ReplicationTaskFetcherStreamEnabled = dynamicconfig.GetBoolPropertyFn(true)
+	s.config.ReplicationTaskFetcherAggregationInterval = dynamicconfig.GetDurationPropertyFn(time.Millisecond)
+	token := &types.ReplicationToken{
+		ShardID:                0,
+		LastProcessedMessageID: 1,
+		LastRetrievedMessageID: 2,
+	}
+	messages := &types.ReplicationMessages{LastRetrievedMessageID: 3}
+
+	s.streamClient.EXPECT().StreamReplicationMessages(gomock.Any()).Return(nil, &types.InternalServiceError{}).AnyTimes()
+	s.frontendClient.EXPECT().GetReplicationMessages(gomock.Any(), &types.GetReplicationMessagesRequest{
+		Tokens:      []*types.ReplicationToken{token},
+		ClusterName: "active",
+	}).Return(&types.GetReplicationMessagesResponse{
+		MessagesByShard: map[int32]*types.ReplicationMessages{0: messages},
+	}, nil)
+
+	s.taskFetcher.Start()
+	defer s.taskFetcher.Stop()
+
+	respChan := make(chan *types.ReplicationMessages, 1)
+	request := &request{
+		token:    token,
+		respChan: respChan,
+	}
+	s.taskFetcher.GetRequestChan() <- request
+	s.Equal(messages, <-respChan)
+	s.False(request.streamed)
+}
@@ -171,6 +171,7 @@ func hydrateSyncActivityTask(task persistence.ReplicationTaskInfo, ms mutableSta
 			LastWorkerIdentity: activityInfo.LastWorkerIdentity,
 			LastFailureDetails: activityInfo.LastFailureDetails,
 			VersionHistory:     versionHistory,
+			Paused:             activityInfo.Paused,
 		},
 		CreationTime: common.Int64Ptr(task.CreationTime),
 	}, nil
@@ -35,6 +35,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/dlq"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -98,6 +99,9 @@ type (
 	request struct {
 		token    *types.ReplicationToken
 		respChan chan<- *types.ReplicationMessages
+		// streamed is set by the fetcher before it responds with tasks pushed by a replication stream.
+		// Streams only push a shard once it has new tasks or its heartbeat is due, so there is no need to back off.
+		streamed bool
 	}
 )
 
@@ -189,7 +193,7 @@ Loop:
 		default:
 		}
 
-		respChan := p.sendFetchMessageRequest()
+		respChan, request := p.sendFetchMessageRequest()
 
 		select {
 		case response, ok := <-respChan:
@@ -205,7 +209,7 @@ Loop:
 			)
 
 			p.taskProcessingStartWait()
-			p.processResponse(response)
+			p.processResponse(response, request.streamed)
 		case <-p.done:
 			return
 		}
@@ -272,10 +276,10 @@ func (p *taskProcessorImpl) cleanupAckedReplicationTasks() error {
 	return nil
 }
 
-func (p *taskProcessorImpl) sendFetchMessageRequest() <-chan *types.ReplicationMessages {
+func (p *taskProcessorImpl) sendFetchMessageRequest() (<-chan *types.ReplicationMessages, *request) {
 	respChan := make(chan *types.ReplicationMessages, 1)
 	// TODO: when we support prefetching, LastRetrievedMessageID can be different than LastProcessedMessageID
-	p.requestChan <- &request{
+	req := &request{
 		token: &types.ReplicationToken{
 			ShardID:                int32(p.shard.GetShardID()),
 			LastRetrievedMessageID: p.lastRetrievedMessageID,
@@ -283,10 +287,11 @@ func (p *taskProcessorImpl) sendFetchMessageRequest() <-chan *types.ReplicationM
 		},
 		respChan: respChan,
 	}
-	return respChan
+	p.requestChan <- req
+	return respChan, req
 }
 
-func (p *taskProcessorImpl) processResponse(response *types.ReplicationMessages) {
+func (p *taskProcessorImpl) processResponse(response *types.ReplicationMessages, streamed bool) {
 
 	select {
 	case p.syncShardChan <- response.GetSyncShardStatus():
@@ -310,7 +315,7 @@ func (p *taskProcessorImpl) processResponse(response *types.ReplicationMessages)
 	// Note here we check replication tasks instead of hasMore. The expectation is that in a steady state
 	// we will receive replication tasks but hasMore is false (meaning that we are always catching up).
 	// So hasMore might not be a good indicator for additional wait.
-	if len(response.ReplicationTasks) == 0 {
+	if len(response.ReplicationTasks) == 0 && !streamed {
 		backoffDuration := p.noTaskRetrier.NextBackOff()
 		time.Sleep(backoffDuration)
 	} else {
@@ -415,12 +420,13 @@ func (p *taskProcessorImpl) processSingleTask(replicationTask *types.Replication
 		p.logger.Warn("Skip adding new messages to DLQ.", tag.Error(err))
 		return err
 	default:
-		request, err := p.generateDLQRequest(replicationTask)
-		if err != nil {
-			p.logger.Error("Failed to generate DLQ replication task.", tag.Error(err))
+		request, dlqErr := p.generateDLQRequest(replicationTask)
+		if dlqErr != nil {
+			p.logger.Error("Failed to generate DLQ replication task.", tag.Error(dlqErr))
 			// We cannot deserialize the task. Dropping it.
 			return nil
 		}
+		request.TaskInfo.ErrorClass = dlq.ClassifyError(err)
 		p.logger.Error("Failed to apply replication task after retry. Putting task into DLQ.",
 			tag.WorkflowDomainID(request.TaskInfo.GetDomainID()),
 			tag.WorkflowID(request.TaskInfo.GetWorkflowID()),
@@ -483,7 +489,9 @@ func (p *taskProcessorImpl) putReplicationTaskToDLQ(request *persistence.PutRepl
 	)
 	throttleRetry := backoff.NewThrottleRetry(
 		backoff.WithRetryPolicy(p.dlqRetryPolicy),
-		backoff.WithRetryableError(p.shouldRetryDLQ),
+		backoff.WithRetryableError(func(err error) bool {
+			return shouldRetryDLQ(err, p.done)
+		}),
 	)
 	// The following is guaranteed to success or retry forever until processor is shutdown.
 	return throttleRetry.Do(context.Background(), func() error {
@@ -625,14 +633,18 @@ func isTransientRetryableError(err error) bool {
 	}
 }
 
-func (p *taskProcessorImpl) shouldRetryDLQ(err error) bool {
-	if err == nil {
+// shouldRetryDLQ returns true if a failed DLQ operation may succeed when retried. It is false for errors
+// which will not go away by retrying, and once the owner of the DLQ is shutting down.
+func shouldRetryDLQ(err error, done <-chan struct{}) bool {
+	if err == nil || err == errDLQTaskNotFoundInSource {
+		return false
+	}
+	if _, ok := err.(*types.BadRequestError); ok {
 		return false
 	}
 
 	select {
-	case <-p.done:
-		p.logger.Debug("ReplicationTaskProcessor shutting down.")
+	case <-done:
 		return false
 	default:
 		return true
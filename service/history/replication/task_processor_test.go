@@ -89,6 +89,7 @@ func (s *taskProcessorSuite) SetupTest() {
 	s.Assertions = require.New(s.T())
 
 	s.controller = gomock.NewController(s.T())
+	s.config = config.NewForTest()
 	s.mockShard = shard.NewTestContext(
 		s.T(),
 		s.controller,
@@ -107,7 +108,6 @@ func (s *taskProcessorSuite) SetupTest() {
 	s.executionManager = s.mockShard.Resource.ExecutionMgr
 
 	s.mockEngine = engine.NewMockEngine(s.controller)
-	s.config = config.NewForTest()
 	s.config.ReplicationTaskProcessorNoTaskRetryWait = dynamicconfig.GetDurationPropertyFnFilteredByShardID(1 * time.Millisecond)
 	metricsClient := metrics.NewClient(tally.NoopScope, metrics.History)
 	s.requestChan = make(chan *request, 10)
@@ -138,7 +138,7 @@ func (s *taskProcessorSuite) TestProcessResponse_NoTask() {
 		LastRetrievedMessageID: 100,
 	}
 
-	s.taskProcessor.processResponse(response)
+	s.taskProcessor.processResponse(response, false)
 	s.Equal(int64(100), s.taskProcessor.lastProcessedMessageID)
 	s.Equal(int64(100), s.taskProcessor.lastRetrievedMessageID)
 }
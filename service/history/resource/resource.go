@@ -31,19 +31,22 @@ import (
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/events"
+	"github.com/uber/cadence/service/history/workflowusage"
 )
 
 // Resource is the interface which expose common history resources
 type Resource interface {
 	resource.Resource
 	GetEventCache() events.Cache
+	GetWorkflowUsageRecorder() workflowusage.Recorder
 }
 
 type resourceImpl struct {
 	status int32
 
 	resource.Resource
-	eventCache events.Cache
+	eventCache            events.Cache
+	workflowUsageRecorder workflowusage.Recorder
 }
 
 // Start starts all resources
@@ -58,6 +61,7 @@ func (h *resourceImpl) Start() {
 	}
 
 	h.Resource.Start()
+	h.workflowUsageRecorder.Start()
 	h.GetLogger().Info("history resource started", tag.LifeCycleStarted)
 }
 
@@ -72,6 +76,7 @@ func (h *resourceImpl) Stop() {
 		return
 	}
 
+	h.workflowUsageRecorder.Stop()
 	h.Resource.Stop()
 	h.GetLogger().Info("history resource stopped", tag.LifeCycleStopped)
 }
@@ -81,6 +86,11 @@ func (h *resourceImpl) GetEventCache() events.Cache {
 	return h.eventCache
 }
 
+// GetWorkflowUsageRecorder return workflow usage recorder
+func (h *resourceImpl) GetWorkflowUsageRecorder() workflowusage.Recorder {
+	return h.workflowUsageRecorder
+}
+
 // New create a new resource containing common history dependencies
 func New(
 	params *resource.Params,
@@ -127,9 +137,19 @@ func New(
 		serviceResource.GetDomainCache(),
 	)
 
+	workflowUsageRecorder := workflowusage.NewRecorder(
+		serviceResource.GetDomainUsageQueue(),
+		serviceResource.GetHostName(),
+		config.WorkflowUsageFlushInterval,
+		config.WorkflowUsageRetention,
+		serviceResource.GetTimeSource(),
+		params.Logger,
+	)
+
 	historyResource = &resourceImpl{
-		Resource:   serviceResource,
-		eventCache: eventCache,
+		Resource:              serviceResource,
+		eventCache:            eventCache,
+		workflowUsageRecorder: workflowUsageRecorder,
 	}
 	return
 }
@@ -56,6 +56,7 @@ import (
 	persistence "github.com/uber/cadence/common/persistence"
 	client0 "github.com/uber/cadence/common/persistence/client"
 	events "github.com/uber/cadence/service/history/events"
+	workflowusage "github.com/uber/cadence/service/history/workflowusage"
 )
 
 // MockResource is a mock of Resource interface.
@@ -235,6 +236,20 @@ func (mr *MockResourceMockRecorder) GetDomainReplicationQueue() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainReplicationQueue", reflect.TypeOf((*MockResource)(nil).GetDomainReplicationQueue))
 }
 
+// GetDomainUsageQueue mocks base method.
+func (m *MockResource) GetDomainUsageQueue() domain.UsageQueue {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainUsageQueue")
+	ret0, _ := ret[0].(domain.UsageQueue)
+	return ret0
+}
+
+// GetDomainUsageQueue indicates an expected call of GetDomainUsageQueue.
+func (mr *MockResourceMockRecorder) GetDomainUsageQueue() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainUsageQueue", reflect.TypeOf((*MockResource)(nil).GetDomainUsageQueue))
+}
+
 // GetEventCache mocks base method.
 func (m *MockResource) GetEventCache() events.Cache {
 	m.ctrl.T.Helper()
@@ -642,6 +657,20 @@ func (mr *MockResourceMockRecorder) GetVisibilityManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisibilityManager", reflect.TypeOf((*MockResource)(nil).GetVisibilityManager))
 }
 
+// GetWorkflowUsageRecorder mocks base method.
+func (m *MockResource) GetWorkflowUsageRecorder() workflowusage.Recorder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowUsageRecorder")
+	ret0, _ := ret[0].(workflowusage.Recorder)
+	return ret0
+}
+
+// GetWorkflowUsageRecorder indicates an expected call of GetWorkflowUsageRecorder.
+func (mr *MockResourceMockRecorder) GetWorkflowUsageRecorder() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowUsageRecorder", reflect.TypeOf((*MockResource)(nil).GetWorkflowUsageRecorder))
+}
+
 // Start mocks base method.
 func (m *MockResource) Start() {
 	m.ctrl.T.Helper()
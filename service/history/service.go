@@ -26,7 +26,9 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/lifecycle"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/quotas"
 	commonResource "github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
@@ -111,13 +113,27 @@ func (s *Service) Start() {
 		DomainCache:                    s.Resource.GetDomainCache(),
 		Logger:                         s.Resource.GetLogger(),
 		MetricsClient:                  s.Resource.GetMetricsClient(),
+		OperationLimiterFactories: map[workflowcache.Operation]quotas.LimiterFactory{
+			workflowcache.OperationSignal:          quotas.NewSimpleDynamicRateLimiterFactory(s.config.WorkflowIDSignalRPS),
+			workflowcache.OperationSignalWithStart: quotas.NewSimpleDynamicRateLimiterFactory(s.config.WorkflowIDSignalWithStartRPS),
+			workflowcache.OperationStart:           quotas.NewSimpleDynamicRateLimiterFactory(s.config.WorkflowIDStartRPS),
+			workflowcache.OperationQuery:           quotas.NewSimpleDynamicRateLimiterFactory(s.config.WorkflowIDQueryRPS),
+			workflowcache.OperationDescribe:        quotas.NewSimpleDynamicRateLimiterFactory(s.config.WorkflowIDDescribeRPS),
+		},
 	})
 
-	rawHandler := handler.NewHandler(s.Resource, s.config, wfIDCache, s.config.WorkflowIDInternalRateLimitEnabled)
+	rawHandler := handler.NewHandler(
+		s.Resource,
+		s.config,
+		wfIDCache,
+		s.config.WorkflowIDInternalRateLimitEnabled,
+		s.newLifecyclePublisher(),
+	)
 	s.handler = ratelimited.NewHistoryHandler(
 		rawHandler,
 		wfIDCache,
 		s.config.WorkflowIDExternalRateLimitEnabled,
+		s.config.WorkflowIDQueryRateLimitEnabled,
 		s.Resource.GetDomainCache(),
 		s.Resource.GetLogger(),
 	)
@@ -175,3 +191,22 @@ func (s *Service) Stop() {
 
 	s.GetLogger().Info("history stopped")
 }
+
+// newLifecyclePublisher creates the publisher of the workflow lifecycle stream,
+// events are dropped if no kafka topic is configured for the workflow lifecycle application
+func (s *Service) newLifecyclePublisher() lifecycle.Publisher {
+	messagingClient := s.GetMessagingClient()
+	if messagingClient == nil {
+		return lifecycle.NewNoopPublisher()
+	}
+
+	producer, err := messagingClient.NewProducer(common.WorkflowLifecycleAppName)
+	if err != nil {
+		s.GetLogger().Info("workflow lifecycle stream is not configured", tag.Error(err))
+		return lifecycle.NewNoopPublisher()
+	}
+	return lifecycle.NewPublisher(
+		messaging.NewMetricProducer(producer, s.GetMetricsClient()),
+		s.config.EnableWorkflowLifecycleEvents,
+	)
+}
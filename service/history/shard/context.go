@@ -45,6 +45,7 @@ import (
 	"github.com/uber/cadence/service/history/engine"
 	"github.com/uber/cadence/service/history/events"
 	"github.com/uber/cadence/service/history/resource"
+	"github.com/uber/cadence/service/history/workflowusage"
 )
 
 type (
@@ -62,6 +63,7 @@ type (
 		GetThrottledLogger() log.Logger
 		GetMetricsClient() metrics.Client
 		GetTimeSource() clock.TimeSource
+		GetWorkflowUsageRecorder() workflowusage.Recorder
 		PreviousShardOwnerWasDifferent() bool
 
 		GetEngine() engine.Engine
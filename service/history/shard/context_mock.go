@@ -44,6 +44,7 @@ import (
 	engine "github.com/uber/cadence/service/history/engine"
 	events "github.com/uber/cadence/service/history/events"
 	resource "github.com/uber/cadence/service/history/resource"
+	workflowusage "github.com/uber/cadence/service/history/workflowusage"
 )
 
 // MockContext is a mock of Context interface.
@@ -593,6 +594,20 @@ func (mr *MockContextMockRecorder) GetWorkflowExecution(ctx, request interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecution", reflect.TypeOf((*MockContext)(nil).GetWorkflowExecution), ctx, request)
 }
 
+// GetWorkflowUsageRecorder mocks base method.
+func (m *MockContext) GetWorkflowUsageRecorder() workflowusage.Recorder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowUsageRecorder")
+	ret0, _ := ret[0].(workflowusage.Recorder)
+	return ret0
+}
+
+// GetWorkflowUsageRecorder indicates an expected call of GetWorkflowUsageRecorder.
+func (mr *MockContextMockRecorder) GetWorkflowUsageRecorder() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowUsageRecorder", reflect.TypeOf((*MockContext)(nil).GetWorkflowUsageRecorder))
+}
+
 // PreviousShardOwnerWasDifferent mocks base method.
 func (m *MockContext) PreviousShardOwnerWasDifferent() bool {
 	m.ctrl.T.Helper()
@@ -133,7 +133,7 @@ func (s *crossClusterSourceTaskExecutorSuite) TestExecute_UnexpectedTask() {
 		s.mockShard,
 		&p.TransferTaskInfo{},
 		QueueTypeActiveTransfer,
-		nil, nil, nil, nil, nil, nil,
+		nil, nil, nil, nil, nil, nil, nil,
 	)
 
 	err := s.executor.Execute(transferTask, true)
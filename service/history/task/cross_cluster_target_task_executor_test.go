@@ -95,7 +95,7 @@ func (s *crossClusterTargetTaskExecutorSuite) TestExecute_UnexpectedTask() {
 		s.mockShard,
 		&persistence.TimerTaskInfo{},
 		QueueTypeActiveTimer,
-		nil, nil, nil, nil, nil, nil,
+		nil, nil, nil, nil, nil, nil, nil,
 	)
 
 	var err error
@@ -122,6 +122,7 @@ type (
 		processingState processingState
 		priority        int
 		attempt         int
+		lastErr         error
 
 		shard         shard.Context
 		timeSource    clock.TimeSource
@@ -353,6 +354,7 @@ func (t *crossClusterSourceTask) HandleErr(
 
 			t.Lock()
 			t.attempt++
+			t.lastErr = retErr
 			attempt := t.attempt
 			t.Unlock()
 
@@ -874,6 +876,7 @@ func (t *crossClusterTargetTask) HandleErr(
 
 	t.Lock()
 	t.attempt++
+	t.lastErr = err
 	t.Unlock()
 
 	t.scope.IncCounter(metrics.TaskFailuresPerDomain)
@@ -940,6 +943,13 @@ func (t *crossClusterTaskBase) GetAttempt() int {
 	return t.attempt
 }
 
+func (t *crossClusterTaskBase) GetLastError() error {
+	t.Lock()
+	defer t.Unlock()
+
+	return t.lastErr
+}
+
 func (t *crossClusterTaskBase) GetQueueType() QueueType {
 	return QueueTypeCrossCluster
 }
@@ -51,6 +51,7 @@ type (
 		GetQueueType() QueueType
 		GetShard() shard.Context
 		GetAttempt() int
+		GetLastError() error
 		GetInfo() Info
 	}
 
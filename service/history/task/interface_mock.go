@@ -250,6 +250,20 @@ func (mr *MockTaskMockRecorder) GetInfo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInfo", reflect.TypeOf((*MockTask)(nil).GetInfo))
 }
 
+// GetLastError mocks base method.
+func (m *MockTask) GetLastError() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastError")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetLastError indicates an expected call of GetLastError.
+func (mr *MockTaskMockRecorder) GetLastError() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastError", reflect.TypeOf((*MockTask)(nil).GetLastError))
+}
+
 // GetQueueType mocks base method.
 func (m *MockTask) GetQueueType() QueueType {
 	m.ctrl.T.Helper()
@@ -548,6 +562,20 @@ func (mr *MockCrossClusterTaskMockRecorder) GetInfo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInfo", reflect.TypeOf((*MockCrossClusterTask)(nil).GetInfo))
 }
 
+// GetLastError mocks base method.
+func (m *MockCrossClusterTask) GetLastError() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastError")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetLastError indicates an expected call of GetLastError.
+func (mr *MockCrossClusterTaskMockRecorder) GetLastError() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastError", reflect.TypeOf((*MockCrossClusterTask)(nil).GetLastError))
+}
+
 // GetQueueType mocks base method.
 func (m *MockCrossClusterTask) GetQueueType() QueueType {
 	m.ctrl.T.Helper()
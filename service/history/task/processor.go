@@ -66,6 +66,9 @@ func NewProcessor(
 		config.TaskSchedulerWorkerCount,
 		config.TaskSchedulerDispatcherCount(),
 		config.TaskSchedulerRoundRobinWeights,
+		config.TaskSchedulerDomainRoundRobinWeight,
+		config.TaskSchedulerDomainMaxConcurrency,
+		getTaskDomainName,
 	)
 	if err != nil {
 		return nil, err
@@ -84,6 +87,9 @@ func NewProcessor(
 			config.TaskSchedulerShardWorkerCount,
 			1,
 			config.TaskSchedulerRoundRobinWeights,
+			config.TaskSchedulerDomainRoundRobinWeight,
+			config.TaskSchedulerDomainMaxConcurrency,
+			getTaskDomainName,
 		)
 		if err != nil {
 			return nil, err
@@ -265,6 +271,12 @@ func createTaskScheduler(
 			metricsClient,
 			options.WRRSchedulerOptions,
 		)
+	case task.SchedulerTypeDRR:
+		scheduler, err = task.NewDeficitRoundRobinTaskScheduler(
+			logger,
+			metricsClient,
+			options.DRRSchedulerOptions,
+		)
 	default:
 		// the scheduler type has already been verified when initializing the processor
 		panic(fmt.Sprintf("Unknown task scheduler type, %v", options.SchedulerType))
@@ -272,3 +284,16 @@ func createTaskScheduler(
 
 	return scheduler, err
 }
+
+// getTaskDomainName returns the domain name of a history task, or its domain ID if the name can not be resolved
+func getTaskDomainName(priorityTask task.PriorityTask) string {
+	historyTask, ok := priorityTask.(Task)
+	if !ok {
+		return ""
+	}
+	domainName, err := historyTask.GetShard().GetDomainCache().GetDomainName(historyTask.GetDomainID())
+	if err != nil {
+		return historyTask.GetDomainID()
+	}
+	return domainName
+}
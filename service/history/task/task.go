@@ -71,6 +71,7 @@ type (
 		state              ctask.State
 		priority           int
 		attempt            int
+		lastErr            error
 		timeSource         clock.TimeSource
 		submitTime         time.Time
 		logger             log.Logger
@@ -80,6 +81,7 @@ type (
 		taskExecutor       Executor
 		taskProcessor      Processor
 		redispatchFn       func(task Task)
+		ackFn              func(task Task)
 		criticalRetryCount dynamicconfig.IntPropertyFn
 
 		// TODO: following three fields should be removed after new task lifecycle is implemented
@@ -99,6 +101,7 @@ func NewTimerTask(
 	taskExecutor Executor,
 	taskProcessor Processor,
 	redispatchFn func(task Task),
+	ackFn func(task Task),
 	criticalRetryCount dynamicconfig.IntPropertyFn,
 ) Task {
 	return newTask(
@@ -112,6 +115,7 @@ func NewTimerTask(
 		taskProcessor,
 		criticalRetryCount,
 		redispatchFn,
+		ackFn,
 	)
 }
 
@@ -125,6 +129,7 @@ func NewTransferTask(
 	taskExecutor Executor,
 	taskProcessor Processor,
 	redispatchFn func(task Task),
+	ackFn func(task Task),
 	criticalRetryCount dynamicconfig.IntPropertyFn,
 ) Task {
 	return newTask(
@@ -138,6 +143,7 @@ func NewTransferTask(
 		taskProcessor,
 		criticalRetryCount,
 		redispatchFn,
+		ackFn,
 	)
 }
 
@@ -152,6 +158,7 @@ func newTask(
 	taskProcessor Processor,
 	criticalRetryCount dynamicconfig.IntPropertyFn,
 	redispatchFn func(task Task),
+	ackFn func(task Task),
 ) *taskImpl {
 	timeSource := shard.GetTimeSource()
 	var eventLogger eventLogger
@@ -177,6 +184,7 @@ func newTask(
 		timeSource:         timeSource,
 		criticalRetryCount: criticalRetryCount,
 		redispatchFn:       redispatchFn,
+		ackFn:              ackFn,
 		taskFilter:         taskFilter,
 		taskExecutor:       taskExecutor,
 		taskProcessor:      taskProcessor,
@@ -222,6 +230,7 @@ func (t *taskImpl) HandleErr(err error) (retErr error) {
 			defer t.Unlock()
 
 			t.attempt++
+			t.lastErr = retErr
 			if t.attempt > t.criticalRetryCount() {
 				t.scope.RecordTimer(metrics.TaskAttemptTimerPerDomain, time.Duration(t.attempt))
 				t.logger.Error("Critical error processing task, retrying.",
@@ -332,8 +341,6 @@ func (t *taskImpl) Ack() {
 	logEvent(t.eventLogger, "Acked task")
 
 	t.Lock()
-	defer t.Unlock()
-
 	t.state = ctask.TaskStateAcked
 	if t.shouldProcessTask {
 		t.scope.RecordTimer(metrics.TaskAttemptTimerPerDomain, time.Duration(t.attempt))
@@ -345,6 +352,12 @@ func (t *taskImpl) Ack() {
 		// only dump events when the task should be processed and has been retried
 		t.eventLogger.FlushEvents("Task processing events")
 	}
+	t.Unlock()
+
+	// called without holding the lock, as ackFn reads the task state
+	if t.ackFn != nil {
+		t.ackFn(t)
+	}
 }
 
 func (t *taskImpl) Nack() {
@@ -395,6 +408,13 @@ func (t *taskImpl) GetAttempt() int {
 	return t.attempt
 }
 
+func (t *taskImpl) GetLastError() error {
+	t.Lock()
+	defer t.Unlock()
+
+	return t.lastErr
+}
+
 func (t *taskImpl) GetInfo() Info {
 	return t.Info
 }
@@ -142,6 +142,8 @@ func (s *taskSuite) TestHandleErr_ErrTaskRetry() {
 
 	err := &redispatchError{Reason: "random-reason"}
 	s.Equal(err, taskBase.HandleErr(err))
+	s.Equal(1, taskBase.GetAttempt())
+	s.Equal(err, taskBase.GetLastError())
 }
 
 func (s *taskSuite) TestHandleErr_ErrTaskDiscarded() {
@@ -227,8 +229,13 @@ func (s *taskSuite) TestTaskState() {
 
 	s.Equal(t.TaskStatePending, taskBase.State())
 
+	var ackedState t.State
+	taskBase.ackFn = func(task Task) {
+		ackedState = task.State()
+	}
 	taskBase.Ack()
 	s.Equal(t.TaskStateAcked, taskBase.State())
+	s.Equal(t.TaskStateAcked, ackedState)
 
 	s.mockTaskProcessor.EXPECT().TrySubmit(taskBase).Return(true, nil).Times(1)
 	taskBase.Nack()
@@ -323,6 +330,7 @@ func (s *taskSuite) newTestTask(
 		s.mockTaskProcessor,
 		s.maxRetryCount,
 		redispatchFn,
+		nil,
 	)
 	taskBase.scope = s.mockShard.GetMetricsClient().Scope(0)
 	return taskBase
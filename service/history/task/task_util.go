@@ -142,6 +142,11 @@ func GetTransferTaskMetricsScope(
 			return metrics.TransferActiveTaskApplyParentClosePolicyScope
 		}
 		return metrics.TransferStandbyTaskApplyParentClosePolicyScope
+	case persistence.TransferTaskTypeRecordWorkflowLifecycleEvent:
+		if isActive {
+			return metrics.TransferActiveTaskRecordWorkflowLifecycleEventScope
+		}
+		return metrics.TransferStandbyTaskRecordWorkflowLifecycleEventScope
 	default:
 		if isActive {
 			return metrics.TransferActiveQueueProcessorScope
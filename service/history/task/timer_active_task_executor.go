@@ -152,6 +152,10 @@ func (t *timerActiveTaskExecutor) executeUserTimerTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the task is generated again when the workflow is unpaused
+		return nil
+	}
 
 	timerSequence := execution.NewTimerSequence(mutableState)
 	referenceTime := t.shard.GetTimeSource().Now()
@@ -297,6 +301,10 @@ func (t *timerActiveTaskExecutor) executeActivityTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the task is generated again when the workflow is unpaused
+		return nil
+	}
 
 	wfType := mutableState.GetWorkflowType()
 	if wfType == nil {
@@ -480,6 +488,10 @@ func (t *timerActiveTaskExecutor) executeDecisionTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the task is generated again when the workflow is unpaused
+		return nil
+	}
 
 	wfType := mutableState.GetWorkflowType()
 	if wfType == nil {
@@ -583,6 +595,10 @@ func (t *timerActiveTaskExecutor) executeWorkflowBackoffTimerTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the task is generated again when the workflow is unpaused
+		return nil
+	}
 
 	if task.TimeoutType == persistence.WorkflowBackoffTimeoutTypeRetry {
 		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowRetryBackoffTimerCount)
@@ -624,6 +640,10 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the task is generated again when the workflow is unpaused
+		return nil
+	}
 
 	// generate activity task
 	scheduledID := task.EventID
@@ -642,6 +662,10 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		}
 		return nil
 	}
+	if activityInfo.Paused {
+		// the activity is scheduled again when it is unpaused
+		return nil
+	}
 	ok, err = verifyTaskVersion(t.shard, t.logger, task.DomainID, activityInfo.Version, task.Version, task)
 	if err != nil || !ok {
 		return err
@@ -715,6 +739,10 @@ func (t *timerActiveTaskExecutor) executeWorkflowTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the task is generated again when the workflow is unpaused
+		return nil
+	}
 
 	startVersion, err := mutableState.GetStartVersion()
 	if err != nil {
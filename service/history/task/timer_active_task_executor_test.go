@@ -1244,7 +1244,7 @@ func (s *timerActiveTaskExecutorSuite) getMutableStateFromCache(
 func (s *timerActiveTaskExecutorSuite) newTimerTaskFromInfo(
 	info *persistence.TimerTaskInfo,
 ) Task {
-	return NewTimerTask(s.mockShard, info, QueueTypeActiveTimer, s.logger, nil, nil, nil, nil, nil)
+	return NewTimerTask(s.mockShard, info, QueueTypeActiveTimer, s.logger, nil, nil, nil, nil, nil, nil)
 }
 
 func (s *timerActiveTaskExecutorSuite) TestActiveTaskTimeout() {
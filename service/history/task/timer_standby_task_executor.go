@@ -447,6 +447,10 @@ func (t *timerStandbyTaskExecutor) processTimer(
 		// workflow already finished, no need to process the timer
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the task is generated again when the workflow is unpaused
+		return nil
+	}
 
 	historyResendInfo, err := actionFn(ctx, wfContext, mutableState)
 	if err != nil {
@@ -808,7 +808,7 @@ func (s *timerStandbyTaskExecutorSuite) TestProcessRetryTimeout() {
 func (s *timerStandbyTaskExecutorSuite) newTimerTaskFromInfo(
 	info *persistence.TimerTaskInfo,
 ) Task {
-	return NewTimerTask(s.mockShard, info, QueueTypeStandbyTimer, s.logger, nil, nil, nil, nil, nil)
+	return NewTimerTask(s.mockShard, info, QueueTypeStandbyTimer, s.logger, nil, nil, nil, nil, nil, nil)
 }
 
 func (s *timerStandbyTaskExecutorSuite) TestTransferTaskTimeout() {
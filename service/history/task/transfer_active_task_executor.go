@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/lifecycle"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -91,6 +92,7 @@ func NewTransferActiveTaskExecutor(
 	config *config.Config,
 	wfIDCache workflowcache.WFCache,
 	ratelimitInternalPerWorkflowID dynamicconfig.BoolPropertyFnWithDomainFilter,
+	lifecyclePublisher lifecycle.Publisher,
 ) Executor {
 
 	return &transferActiveTaskExecutor{
@@ -100,6 +102,7 @@ func NewTransferActiveTaskExecutor(
 			executionCache,
 			logger,
 			config,
+			lifecyclePublisher,
 		),
 		historyClient: shard.GetService().GetHistoryClient(),
 		parentClosePolicyClient: parentclosepolicy.NewClient(
@@ -156,6 +159,8 @@ func (t *transferActiveTaskExecutor) Execute(
 		return t.processResetWorkflow(ctx, transferTask)
 	case persistence.TransferTaskTypeUpsertWorkflowSearchAttributes:
 		return t.processUpsertWorkflowSearchAttributes(ctx, transferTask)
+	case persistence.TransferTaskTypeRecordWorkflowLifecycleEvent:
+		return t.processRecordWorkflowLifecycleEvent(ctx, transferTask)
 	default:
 		return errUnknownTransferTask
 	}
@@ -189,12 +194,20 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the task is generated again when the workflow is unpaused
+		return nil
+	}
 
 	ai, ok := mutableState.GetActivityInfo(task.ScheduleID)
 	if !ok {
 		t.logger.Debug("Potentially duplicate ", tag.TaskID(task.TaskID), tag.WorkflowScheduleID(task.ScheduleID), tag.TaskType(persistence.TransferTaskTypeActivityTask))
 		return nil
 	}
+	if ai.Paused {
+		// the activity is scheduled again when it is unpaused
+		return nil
+	}
 	ok, err = verifyTaskVersion(t.shard, t.logger, task.DomainID, ai.Version, task.Version, task)
 	if err != nil || !ok {
 		return err
@@ -238,6 +251,10 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the task is generated again when the workflow is unpaused
+		return nil
+	}
 
 	decision, found := mutableState.GetDecisionInfo(task.ScheduleID)
 	if !found {
@@ -520,6 +537,26 @@ func (t *transferActiveTaskExecutor) processCloseExecutionTaskHelper(
 		); err != nil {
 			return err
 		}
+		// the task is only acked once the lifecycle event is published, which makes the stream at-least-once.
+		// A failed publish retries the task, which writes the visibility record above again.
+		if err := t.lifecyclePublisher.Publish(ctx, &lifecycle.Event{
+			EventType:     lifecycle.EventTypeWorkflowClosed,
+			TaskID:        task.GetTaskID(),
+			DomainID:      task.DomainID,
+			DomainName:    domainName,
+			WorkflowID:    task.WorkflowID,
+			RunID:         task.RunID,
+			WorkflowType:  workflowTypeName,
+			TaskList:      executionInfo.TaskList,
+			IsCron:        isCron,
+			StartTime:     workflowStartTimestamp,
+			ExecutionTime: workflowExecutionTimestamp.UnixNano(),
+			CloseTime:     workflowCloseTimestamp,
+			CloseStatus:   workflowCloseStatus.String(),
+			HistoryLength: workflowHistoryLength,
+		}); err != nil {
+			return err
+		}
 	}
 
 	// Communicate the result to parent execution if this is Child Workflow execution
@@ -596,6 +633,10 @@ func (t *transferActiveTaskExecutor) processCancelExecution(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the task is generated again when the workflow is unpaused
+		return nil
+	}
 
 	initiatedEventID := task.ScheduleID
 	requestCancelInfo, ok := mutableState.GetRequestCancelInfo(initiatedEventID)
@@ -712,6 +753,10 @@ func (t *transferActiveTaskExecutor) processSignalExecution(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the task is generated again when the workflow is unpaused
+		return nil
+	}
 
 	initiatedEventID := task.ScheduleID
 	signalInfo, ok := mutableState.GetSignalInfo(initiatedEventID)
@@ -856,6 +901,11 @@ func (t *transferActiveTaskExecutor) processStartChildExecution(
 	workflowRunning := mutableState.IsWorkflowExecutionRunning()
 	childStarted := childInfo.StartedID != common.EmptyEventID
 
+	if workflowRunning && mutableState.IsWorkflowExecutionPaused() {
+		// the task is generated again when the workflow is unpaused
+		return nil
+	}
+
 	if !workflowRunning && (!childStarted || childInfo.ParentClosePolicy != types.ParentClosePolicyAbandon) {
 		// three cases here:
 		// case 1: workflow not running, child started, close policy is not abandon
@@ -1055,7 +1105,7 @@ func (t *transferActiveTaskExecutor) processRecordWorkflowStartedOrUpsertHelper(
 
 	if recordStart {
 		workflowStartedScope.IncCounter(metrics.WorkflowStartedCount)
-		return t.recordWorkflowStarted(
+		if err := t.recordWorkflowStarted(
 			ctx,
 			task.DomainID,
 			task.WorkflowID,
@@ -1071,7 +1121,23 @@ func (t *transferActiveTaskExecutor) processRecordWorkflowStartedOrUpsertHelper(
 			visibilityMemo,
 			updateTimestamp.UnixNano(),
 			searchAttr,
-		)
+		); err != nil {
+			return err
+		}
+		// the task is only acked once the lifecycle event is published, which makes the stream at-least-once
+		return t.lifecyclePublisher.Publish(ctx, &lifecycle.Event{
+			EventType:     lifecycle.EventTypeWorkflowStarted,
+			TaskID:        task.GetTaskID(),
+			DomainID:      task.DomainID,
+			DomainName:    domainEntry.GetInfo().Name,
+			WorkflowID:    task.WorkflowID,
+			RunID:         task.RunID,
+			WorkflowType:  wfTypeName,
+			TaskList:      executionInfo.TaskList,
+			IsCron:        isCron,
+			StartTime:     startTimestamp,
+			ExecutionTime: executionTimestamp.UnixNano(),
+		})
 	}
 	return t.upsertWorkflowExecution(
 		ctx,
@@ -22,6 +22,8 @@ package task
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"math/rand"
 	"strconv"
 	"testing"
@@ -42,6 +44,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	dc "github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/lifecycle"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -210,6 +213,7 @@ func (s *transferActiveTaskExecutorSuite) SetupTest() {
 			}
 			return false
 		},
+		lifecycle.NewNoopPublisher(),
 	).(*transferActiveTaskExecutor)
 	s.transferActiveTaskExecutor.parentClosePolicyClient = s.mockParentClosePolicyClient
 }
@@ -393,6 +397,35 @@ func (s *transferActiveTaskExecutorSuite) TestProcessDecisionTask_FirstDecision(
 	s.Nil(err)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessDecisionTask_Paused() {
+
+	workflowExecution, mutableState, err := test.StartWorkflow(s.mockShard, s.domainID)
+	s.NoError(err)
+
+	di := test.AddDecisionTaskScheduledEvent(mutableState)
+	_, err = mutableState.AddWorkflowExecutionPausedEvent("some random reason", "some random identity", "")
+	s.NoError(err)
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   s.domainID,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+		RunID:      workflowExecution.GetRunID(),
+		TaskID:     int64(59),
+		TaskList:   mutableState.GetExecutionInfo().TaskList,
+		TaskType:   persistence.TransferTaskTypeDecisionTask,
+		ScheduleID: di.ScheduleID,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, di.ScheduleID, di.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	// no decision is pushed to matching while the workflow is paused
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Nil(err)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessDecisionTask_NonFirstDecision() {
 
 	workflowExecution, mutableState, _, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
@@ -714,6 +747,63 @@ func (s *transferActiveTaskExecutorSuite) TestProcessCloseExecution_NoParent() {
 	s.Nil(err)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessCloseExecution_PublishLifecycleEvent() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
+	s.NoError(err)
+
+	event := test.AddCompleteWorkflowEvent(mutableState, decisionCompletionID, nil)
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   s.domainID,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+		RunID:      workflowExecution.GetRunID(),
+		TaskID:     int64(59),
+		TaskList:   mutableState.GetExecutionInfo().TaskList,
+		TaskType:   persistence.TransferTaskTypeCloseExecution,
+		ScheduleID: event.ID,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.Anything, mock.Anything).Return(nil).Twice()
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), true, dc.GetBoolPropertyFn(true), "disabled", "random URI"))
+	s.mockArchivalClient.On("Archive", mock.Anything, mock.Anything).Return(nil, nil).Twice()
+
+	mockPublisher := lifecycle.NewMockPublisher(s.controller)
+	s.transferActiveTaskExecutor.lifecyclePublisher = mockPublisher
+	publishErr := errors.New("some random error")
+	var published *lifecycle.Event
+	gomock.InOrder(
+		mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(publishErr),
+		mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, event *lifecycle.Event) error {
+				published = event
+				return nil
+			},
+		),
+	)
+
+	// the task is retried until the lifecycle event is published
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Equal(publishErr, err)
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Nil(err)
+
+	s.Equal(lifecycle.EventTypeWorkflowClosed, published.EventType)
+	s.Equal(int64(59), published.TaskID)
+	s.Equal(s.domainID, published.DomainID)
+	s.Equal(s.domainName, published.DomainName)
+	s.Equal(workflowExecution.GetWorkflowID(), published.WorkflowID)
+	s.Equal(workflowExecution.GetRunID(), published.RunID)
+	s.Equal(mutableState.GetExecutionInfo().WorkflowTypeName, published.WorkflowType)
+	s.Equal(event.GetTimestamp(), published.CloseTime)
+	s.Equal(types.WorkflowExecutionCloseStatusCompleted.String(), published.CloseStatus)
+	s.Equal(mutableState.GetNextEventID()-1, published.HistoryLength)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessCloseExecution_NoParent_HasFewChildren() {
 	s.testProcessCloseExecutionNoParentHasFewChildren(
 		map[string]string{
@@ -1703,6 +1793,85 @@ func (s *transferActiveTaskExecutorSuite) TestProcessRecordWorkflowStartedTask()
 	s.Nil(err)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessRecordWorkflowStartedTask_PublishLifecycleEvent() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
+	s.NoError(err)
+	startEvent, err := mutableState.GetStartEvent(context.Background())
+	s.NoError(err)
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   s.domainID,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+		RunID:      workflowExecution.GetRunID(),
+		TaskID:     int64(59),
+		TaskList:   mutableState.GetExecutionInfo().TaskList,
+		TaskType:   persistence.TransferTaskTypeRecordWorkflowStarted,
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(mutableState, decisionCompletionID, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionUninitialized", mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionStarted", mock.Anything, mock.Anything).Return(nil).Once()
+
+	mockPublisher := lifecycle.NewMockPublisher(s.controller)
+	s.transferActiveTaskExecutor.lifecyclePublisher = mockPublisher
+	mockPublisher.EXPECT().Publish(gomock.Any(), &lifecycle.Event{
+		EventType:    lifecycle.EventTypeWorkflowStarted,
+		TaskID:       int64(59),
+		DomainID:     s.domainID,
+		DomainName:   s.domainName,
+		WorkflowID:   workflowExecution.GetWorkflowID(),
+		RunID:        workflowExecution.GetRunID(),
+		WorkflowType: mutableState.GetExecutionInfo().WorkflowTypeName,
+		TaskList:     mutableState.GetExecutionInfo().TaskList,
+		StartTime:    startEvent.GetTimestamp(),
+	}).Return(nil).Times(1)
+
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Nil(err)
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessRecordWorkflowLifecycleEvent() {
+	event := &lifecycle.Event{
+		EventType:  lifecycle.EventTypeWorkflowSignaled,
+		EventID:    3,
+		DomainID:   s.domainID,
+		DomainName: s.domainName,
+		WorkflowID: "some random workflow ID",
+		RunID:      uuid.New(),
+		SignalName: "some random signal name",
+	}
+	data, err := json.Marshal(event)
+	s.NoError(err)
+	transferTask := s.newTransferTaskFromInfo(&persistence.TransferTaskInfo{
+		Version:        s.version,
+		DomainID:       s.domainID,
+		WorkflowID:     event.WorkflowID,
+		RunID:          event.RunID,
+		TaskID:         int64(59),
+		TaskType:       persistence.TransferTaskTypeRecordWorkflowLifecycleEvent,
+		LifecycleEvent: data,
+	})
+
+	mockPublisher := lifecycle.NewMockPublisher(s.controller)
+	s.transferActiveTaskExecutor.lifecyclePublisher = mockPublisher
+	publishErr := errors.New("some random error")
+	event.TaskID = int64(59)
+	gomock.InOrder(
+		mockPublisher.EXPECT().Publish(gomock.Any(), event).Return(publishErr),
+		mockPublisher.EXPECT().Publish(gomock.Any(), event).Return(nil),
+	)
+
+	// the task is retried until the lifecycle event is published
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Equal(publishErr, err)
+	err = s.transferActiveTaskExecutor.Execute(transferTask, true)
+	s.Nil(err)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttributes() {
 
 	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.mockShard, s.domainID)
@@ -1771,7 +1940,7 @@ func (s *transferActiveTaskExecutorSuite) TestAllowTask() {
 func (s *transferActiveTaskExecutorSuite) newTransferTaskFromInfo(
 	info *persistence.TransferTaskInfo,
 ) Task {
-	return NewTransferTask(s.mockShard, info, QueueTypeActiveTransfer, s.logger, nil, nil, nil, nil, nil)
+	return NewTransferTask(s.mockShard, info, QueueTypeActiveTransfer, s.logger, nil, nil, nil, nil, nil, nil)
 }
 
 func createAddActivityTaskRequest(
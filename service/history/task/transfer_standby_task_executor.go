@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/lifecycle"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -56,6 +57,7 @@ func NewTransferStandbyTaskExecutor(
 	logger log.Logger,
 	clusterName string,
 	config *config.Config,
+	lifecyclePublisher lifecycle.Publisher,
 ) Executor {
 	return &transferStandbyTaskExecutor{
 		transferTaskExecutorBase: newTransferTaskExecutorBase(
@@ -64,6 +66,7 @@ func NewTransferStandbyTaskExecutor(
 			executionCache,
 			logger,
 			config,
+			lifecyclePublisher,
 		),
 		clusterName:     clusterName,
 		historyResender: historyResender,
@@ -113,6 +116,10 @@ func (t *transferStandbyTaskExecutor) Execute(
 		return nil
 	case persistence.TransferTaskTypeUpsertWorkflowSearchAttributes:
 		return t.processUpsertWorkflowSearchAttributes(ctx, transferTask)
+	case persistence.TransferTaskTypeRecordWorkflowLifecycleEvent:
+		// the task is only created by the cluster where the event happened, so it is still
+		// published here if the domain failed over before the task was processed
+		return t.processRecordWorkflowLifecycleEvent(ctx, transferTask)
 	default:
 		return errUnknownTransferTask
 	}
@@ -129,8 +136,13 @@ func (t *transferStandbyTaskExecutor) processActivityTask(
 	processTaskIfClosed := false
 	actionFn := func(ctx context.Context, wfContext execution.Context, mutableState execution.MutableState) (interface{}, error) {
 
+		if mutableState.IsWorkflowExecutionPaused() {
+			// the task is generated again when the workflow is unpaused
+			return nil, nil
+		}
+
 		activityInfo, ok := mutableState.GetActivityInfo(transferTask.ScheduleID)
-		if !ok {
+		if !ok || activityInfo.Paused {
 			return nil, nil
 		}
 
@@ -173,6 +185,11 @@ func (t *transferStandbyTaskExecutor) processDecisionTask(
 	processTaskIfClosed := false
 	actionFn := func(ctx context.Context, wfContext execution.Context, mutableState execution.MutableState) (interface{}, error) {
 
+		if mutableState.IsWorkflowExecutionPaused() {
+			// the task is generated again when the workflow is unpaused
+			return nil, nil
+		}
+
 		decisionInfo, ok := mutableState.GetDecisionInfo(transferTask.ScheduleID)
 		if !ok {
 			return nil, nil
@@ -310,6 +327,11 @@ func (t *transferStandbyTaskExecutor) processCancelExecution(
 	processTaskIfClosed := false
 	actionFn := func(ctx context.Context, wfContext execution.Context, mutableState execution.MutableState) (interface{}, error) {
 
+		if mutableState.IsWorkflowExecutionPaused() {
+			// the task is generated again when the workflow is unpaused
+			return nil, nil
+		}
+
 		requestCancelInfo, ok := mutableState.GetRequestCancelInfo(transferTask.ScheduleID)
 		if !ok {
 			return nil, nil
@@ -347,6 +369,11 @@ func (t *transferStandbyTaskExecutor) processSignalExecution(
 	processTaskIfClosed := false
 	actionFn := func(ctx context.Context, wfContext execution.Context, mutableState execution.MutableState) (interface{}, error) {
 
+		if mutableState.IsWorkflowExecutionPaused() {
+			// the task is generated again when the workflow is unpaused
+			return nil, nil
+		}
+
 		signalInfo, ok := mutableState.GetSignalInfo(transferTask.ScheduleID)
 		if !ok {
 			return nil, nil
@@ -384,6 +411,11 @@ func (t *transferStandbyTaskExecutor) processStartChildExecution(
 	processTaskIfClosed := false
 	actionFn := func(ctx context.Context, wfContext execution.Context, mutableState execution.MutableState) (interface{}, error) {
 
+		if mutableState.IsWorkflowExecutionPaused() {
+			// the task is generated again when the workflow is unpaused
+			return nil, nil
+		}
+
 		childWorkflowInfo, ok := mutableState.GetChildExecutionInfo(transferTask.ScheduleID)
 		if !ok {
 			return nil, nil
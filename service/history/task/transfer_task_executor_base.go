@@ -22,11 +22,13 @@ package task
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/lifecycle"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -58,6 +60,8 @@ type (
 		visibilityMgr  persistence.VisibilityManager
 		config         *config.Config
 		throttleRetry  *backoff.ThrottleRetry
+
+		lifecyclePublisher lifecycle.Publisher
 	}
 )
 
@@ -67,6 +71,7 @@ func newTransferTaskExecutorBase(
 	executionCache *execution.Cache,
 	logger log.Logger,
 	config *config.Config,
+	lifecyclePublisher lifecycle.Publisher,
 ) *transferTaskExecutorBase {
 	return &transferTaskExecutorBase{
 		shard:          shard,
@@ -81,6 +86,7 @@ func newTransferTaskExecutorBase(
 			backoff.WithRetryPolicy(taskRetryPolicy),
 			backoff.WithRetryableError(common.IsServiceTransientError),
 		),
+		lifecyclePublisher: lifecyclePublisher,
 	}
 }
 
@@ -367,6 +373,21 @@ func (t *transferTaskExecutorBase) recordWorkflowClosed(
 	return nil
 }
 
+// processRecordWorkflowLifecycleEvent publishes the workflow lifecycle event stored in the task.
+// An error fails the task, which is retried until the event is published.
+func (t *transferTaskExecutorBase) processRecordWorkflowLifecycleEvent(
+	ctx context.Context,
+	task *persistence.TransferTaskInfo,
+) error {
+
+	event := &lifecycle.Event{}
+	if err := json.Unmarshal(task.LifecycleEvent, event); err != nil {
+		return err
+	}
+	event.TaskID = task.GetTaskID()
+	return t.lifecyclePublisher.Publish(ctx, event)
+}
+
 // Argument startEvent is to save additional call of msBuilder.GetStartEvent
 func getWorkflowExecutionTimestamp(
 	msBuilder execution.MutableState,
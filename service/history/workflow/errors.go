@@ -23,6 +23,7 @@ package workflow
 import (
 	"errors"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -49,6 +50,11 @@ var (
 	ErrCancellationAlreadyRequested = &types.CancellationAlreadyRequestedError{Message: "cancellation already requested for this workflow execution"}
 	// ErrSignalsLimitExceeded is the error indicating limit reached for maximum number of signal events
 	ErrSignalsLimitExceeded = &types.LimitExceededError{Message: "exceeded workflow execution limit for signal events"}
+	// ErrPendingSignalsLimitExceeded is the error indicating too many signals are waiting to be processed by a decision
+	ErrPendingSignalsLimitExceeded = &types.ServiceBusyError{
+		Message: "too many signals are waiting to be processed by the workflow",
+		Reason:  common.WorkflowPendingSignalsLimitReason,
+	}
 	// ErrQueryEnteredInvalidState is error indicating query entered invalid state
 	ErrQueryEnteredInvalidState = &types.BadRequestError{Message: "query entered invalid state, this should be impossible"}
 	// ErrQueryWorkflowBeforeFirstDecision is error indicating that query was attempted before first decision task completed
@@ -26,6 +26,8 @@ package workflowcache
 
 import (
 	"errors"
+	"sort"
+	"sync/atomic"
 	"time"
 
 	"github.com/uber/cadence/common/cache"
@@ -36,6 +38,11 @@ import (
 	"github.com/uber/cadence/common/quotas"
 )
 
+const (
+	throttledWorkflowIDsTTL      = time.Hour
+	throttledWorkflowIDsMaxCount = 1000
+)
+
 var (
 	errDomainName = errors.New("failed to get domain name from domainID")
 )
@@ -44,12 +51,60 @@ var (
 type WFCache interface {
 	AllowExternal(domainID string, workflowID string) bool
 	AllowInternal(domainID string, workflowID string) bool
+	AllowOperation(domainID string, workflowID string, operation Operation) bool
+	GetThrottledWorkflowIDs() []*ThrottledWorkflowID
+}
+
+// ThrottledWorkflowID is a workflow ID which was recently denied by one of its rate limits,
+// whether or not the rate limit is enforced by the caller
+type ThrottledWorkflowID struct {
+	DomainName string `json:"domainName"`
+	WorkflowID string `json:"workflowID"`
+	// Limit is external, internal or the name of the operation
+	Limit         string    `json:"limit"`
+	Count         int64     `json:"count"`
+	LastThrottled time.Time `json:"lastThrottled"`
+}
+
+// Operation is the type of an external request which has its own per workflow rate limit,
+// on top of the one shared by all external requests
+type Operation int
+
+const (
+	// OperationSignal is SignalWorkflowExecution
+	OperationSignal Operation = iota
+	// OperationSignalWithStart is SignalWithStartWorkflowExecution
+	OperationSignalWithStart
+	// OperationStart is StartWorkflowExecution
+	OperationStart
+	// OperationQuery is QueryWorkflow
+	OperationQuery
+	// OperationDescribe is DescribeWorkflowExecution
+	OperationDescribe
+)
+
+var operationNames = map[Operation]string{
+	OperationSignal:          "signal",
+	OperationSignalWithStart: "signal-with-start",
+	OperationStart:           "start",
+	OperationQuery:           "query",
+	OperationDescribe:        "describe",
+}
+
+// String returns the name of the operation
+func (o Operation) String() string {
+	if name, ok := operationNames[o]; ok {
+		return name
+	}
+	return "unknown"
 }
 
 type wfCache struct {
 	lru                            cache.Cache
+	throttled                      cache.Cache
 	externalLimiterFactory         quotas.LimiterFactory
 	internalLimiterFactory         quotas.LimiterFactory
+	operationLimiterFactories      map[Operation]quotas.LimiterFactory
 	workflowIDCacheExternalEnabled dynamicconfig.BoolPropertyFnWithDomainFilter
 	workflowIDCacheInternalEnabled dynamicconfig.BoolPropertyFnWithDomainFilter
 	domainCache                    cache.DomainCache
@@ -63,17 +118,31 @@ type cacheKey struct {
 	workflowID string
 }
 
+type throttledKey struct {
+	domainName string
+	workflowID string
+	limit      string
+}
+
+type throttledValue struct {
+	count         int64
+	lastThrottled int64
+}
+
 type cacheValue struct {
-	externalRateLimiter quotas.Limiter
-	internalRateLimiter quotas.Limiter
+	externalRateLimiter   quotas.Limiter
+	internalRateLimiter   quotas.Limiter
+	operationRateLimiters map[Operation]quotas.Limiter
 }
 
 // Params is the parameters for a new WFCache
 type Params struct {
-	TTL                            time.Duration
-	MaxCount                       int
-	ExternalLimiterFactory         quotas.LimiterFactory
-	InternalLimiterFactory         quotas.LimiterFactory
+	TTL                    time.Duration
+	MaxCount               int
+	ExternalLimiterFactory quotas.LimiterFactory
+	InternalLimiterFactory quotas.LimiterFactory
+	// OperationLimiterFactories are the optional per operation limiters, applied when external requests are enabled
+	OperationLimiterFactories      map[Operation]quotas.LimiterFactory
 	WorkflowIDCacheExternalEnabled dynamicconfig.BoolPropertyFnWithDomainFilter
 	WorkflowIDCacheInternalEnabled dynamicconfig.BoolPropertyFnWithDomainFilter
 	DomainCache                    cache.DomainCache
@@ -90,8 +159,14 @@ func New(params Params) WFCache {
 			MaxCount:      params.MaxCount,
 			ActivelyEvict: true,
 		}),
+		throttled: cache.New(&cache.Options{
+			TTL:      throttledWorkflowIDsTTL,
+			Pin:      false,
+			MaxCount: throttledWorkflowIDsMaxCount,
+		}),
 		externalLimiterFactory:         params.ExternalLimiterFactory,
 		internalLimiterFactory:         params.InternalLimiterFactory,
+		operationLimiterFactories:      params.OperationLimiterFactories,
 		workflowIDCacheExternalEnabled: params.WorkflowIDCacheExternalEnabled,
 		workflowIDCacheInternalEnabled: params.WorkflowIDCacheInternalEnabled,
 		domainCache:                    params.DomainCache,
@@ -109,9 +184,10 @@ type rateLimitType int
 const (
 	external rateLimitType = iota
 	internal
+	operation
 )
 
-func (c *wfCache) allow(domainID string, workflowID string, rateLimitType rateLimitType) bool {
+func (c *wfCache) allow(domainID string, workflowID string, rateLimitType rateLimitType, op Operation) bool {
 	domainName, err := c.domainCache.GetDomainName(domainID)
 	if err != nil {
 		c.logError(domainID, workflowID, errDomainName)
@@ -149,6 +225,13 @@ func (c *wfCache) allow(domainID string, workflowID string, rateLimitType rateLi
 			return false
 		}
 		return true
+	case operation:
+		limiter, ok := value.operationRateLimiters[op]
+		if ok && !limiter.Allow() {
+			c.emitRateLimitMetrics(domainID, workflowID, domainName, op.String(), metrics.WorkflowIDCacheRequestsOperationRatelimitedCounter)
+			return false
+		}
+		return true
 	default:
 		// This should never happen, and we fail open
 		c.logError(domainID, workflowID, errors.New("unknown rate limit type"))
@@ -157,12 +240,13 @@ func (c *wfCache) allow(domainID string, workflowID string, rateLimitType rateLi
 }
 
 func (c *wfCache) isWfCacheEnabled(rateLimitType rateLimitType, domainName string) bool {
-	return rateLimitType == external && c.workflowIDCacheExternalEnabled(domainName) ||
+	return (rateLimitType == external || rateLimitType == operation) && c.workflowIDCacheExternalEnabled(domainName) ||
 		rateLimitType == internal && c.workflowIDCacheInternalEnabled(domainName)
 }
 
 func (c *wfCache) emitRateLimitMetrics(domainID string, workflowID string, domainName string, callType string, metric int) {
 	c.metricsClient.Scope(metrics.HistoryClientWfIDCacheScope, metrics.DomainTag(domainName)).IncCounter(metric)
+	c.recordThrottled(domainName, workflowID, callType)
 	c.logger.Info(
 		"Rate limiting workflowID",
 		tag.RequestType(callType),
@@ -174,12 +258,61 @@ func (c *wfCache) emitRateLimitMetrics(domainID string, workflowID string, domai
 
 // AllowExternal returns true if the rate limiter for this domain/workflow allows an external request
 func (c *wfCache) AllowExternal(domainID string, workflowID string) bool {
-	return c.allow(domainID, workflowID, external)
+	return c.allow(domainID, workflowID, external, 0)
 }
 
 // AllowInternal returns true if the rate limiter for this domain/workflow allows an internal request
 func (c *wfCache) AllowInternal(domainID string, workflowID string) bool {
-	return c.allow(domainID, workflowID, internal)
+	return c.allow(domainID, workflowID, internal, 0)
+}
+
+// AllowOperation returns true if the rate limiter of the given operation for this domain/workflow allows an external request.
+// Operations without a configured limiter are always allowed.
+func (c *wfCache) AllowOperation(domainID string, workflowID string, op Operation) bool {
+	return c.allow(domainID, workflowID, operation, op)
+}
+
+// GetThrottledWorkflowIDs returns the workflow IDs denied by one of their rate limits in the last hour,
+// most recently throttled first
+func (c *wfCache) GetThrottledWorkflowIDs() []*ThrottledWorkflowID {
+	var result []*ThrottledWorkflowID
+	iter := c.throttled.Iterator()
+	defer iter.Close()
+	for iter.HasNext() {
+		entry := iter.Next()
+		key := entry.Key().(throttledKey)
+		value := entry.Value().(*throttledValue)
+		result = append(result, &ThrottledWorkflowID{
+			DomainName:    key.domainName,
+			WorkflowID:    key.workflowID,
+			Limit:         key.limit,
+			Count:         atomic.LoadInt64(&value.count),
+			LastThrottled: time.Unix(0, atomic.LoadInt64(&value.lastThrottled)),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].LastThrottled.After(result[j].LastThrottled)
+	})
+	return result
+}
+
+func (c *wfCache) recordThrottled(domainName string, workflowID string, limit string) {
+	key := throttledKey{
+		domainName: domainName,
+		workflowID: workflowID,
+		limit:      limit,
+	}
+	value, ok := c.throttled.Get(key).(*throttledValue)
+	if !ok {
+		// it should never return an error as we do not use Pin
+		valueInterface, err := c.throttled.PutIfNotExist(key, &throttledValue{})
+		if err != nil {
+			return
+		}
+		value = valueInterface.(*throttledValue)
+	}
+	atomic.AddInt64(&value.count, 1)
+	atomic.StoreInt64(&value.lastThrottled, time.Now().UnixNano())
 }
 
 func (c *wfCache) getCacheItem(domainName string, workflowID string) (*cacheValue, error) {
@@ -196,8 +329,12 @@ func (c *wfCache) getCacheItem(domainName string, workflowID string) (*cacheValu
 	}
 
 	value = &cacheValue{
-		externalRateLimiter: c.externalLimiterFactory.GetLimiter(domainName),
-		internalRateLimiter: c.internalLimiterFactory.GetLimiter(domainName),
+		externalRateLimiter:   c.externalLimiterFactory.GetLimiter(domainName),
+		internalRateLimiter:   c.internalLimiterFactory.GetLimiter(domainName),
+		operationRateLimiters: make(map[Operation]quotas.Limiter, len(c.operationLimiterFactories)),
+	}
+	for op, factory := range c.operationLimiterFactories {
+		value.operationRateLimiters[op] = factory.GetLimiter(domainName)
 	}
 	// PutIfNotExist is thread safe, and will either return the value that was already in the cache or the value we just created
 	// another thread might have inserted a value between the Get and PutIfNotExist, but that is ok
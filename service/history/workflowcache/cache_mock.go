@@ -82,3 +82,31 @@ func (mr *MockWFCacheMockRecorder) AllowInternal(arg0, arg1 interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowInternal", reflect.TypeOf((*MockWFCache)(nil).AllowInternal), arg0, arg1)
 }
+
+// AllowOperation mocks base method.
+func (m *MockWFCache) AllowOperation(arg0, arg1 string, arg2 Operation) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowOperation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// AllowOperation indicates an expected call of AllowOperation.
+func (mr *MockWFCacheMockRecorder) AllowOperation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowOperation", reflect.TypeOf((*MockWFCache)(nil).AllowOperation), arg0, arg1, arg2)
+}
+
+// GetThrottledWorkflowIDs mocks base method.
+func (m *MockWFCache) GetThrottledWorkflowIDs() []*ThrottledWorkflowID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThrottledWorkflowIDs")
+	ret0, _ := ret[0].([]*ThrottledWorkflowID)
+	return ret0
+}
+
+// GetThrottledWorkflowIDs indicates an expected call of GetThrottledWorkflowIDs.
+func (mr *MockWFCacheMockRecorder) GetThrottledWorkflowIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThrottledWorkflowIDs", reflect.TypeOf((*MockWFCache)(nil).GetThrottledWorkflowIDs))
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/log"
@@ -86,6 +87,54 @@ func TestWfCache_AllowSingleWorkflow(t *testing.T) {
 	assert.True(t, wfCache.AllowInternal(testDomainID, testWorkflowID))
 }
 
+// TestWfCache_AllowOperation tests that the cache will use the rate limiter of the operation, and allow operations without one.
+func TestWfCache_AllowOperation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	domainCache := cache.NewMockDomainCache(ctrl)
+	domainCache.EXPECT().GetDomainName(testDomainID).Return(testDomainName, nil).Times(4)
+
+	// The signal rate limiter will allow the first request, but not the second.
+	signalLimiter := quotas.NewMockLimiter(ctrl)
+	signalLimiter.EXPECT().Allow().Return(true).Times(1)
+	signalLimiter.EXPECT().Allow().Return(false).Times(1)
+
+	signalLimiterFactory := quotas.NewMockLimiterFactory(ctrl)
+	signalLimiterFactory.EXPECT().GetLimiter(testDomainName).Return(signalLimiter).Times(1)
+
+	externalLimiterFactory := quotas.NewMockLimiterFactory(ctrl)
+	externalLimiterFactory.EXPECT().GetLimiter(testDomainName).Return(quotas.NewMockLimiter(ctrl)).Times(1)
+	internalLimiterFactory := quotas.NewMockLimiterFactory(ctrl)
+	internalLimiterFactory.EXPECT().GetLimiter(testDomainName).Return(quotas.NewMockLimiter(ctrl)).Times(1)
+
+	wfCache := New(Params{
+		TTL:                            time.Minute,
+		MaxCount:                       1_000,
+		ExternalLimiterFactory:         externalLimiterFactory,
+		InternalLimiterFactory:         internalLimiterFactory,
+		OperationLimiterFactories:      map[Operation]quotas.LimiterFactory{OperationSignal: signalLimiterFactory},
+		WorkflowIDCacheExternalEnabled: func(domain string) bool { return true },
+		WorkflowIDCacheInternalEnabled: func(domain string) bool { return true },
+		Logger:                         log.NewNoop(),
+		DomainCache:                    domainCache,
+		MetricsClient:                  metrics.NewNoopMetricsClient(),
+	})
+
+	assert.True(t, wfCache.AllowOperation(testDomainID, testWorkflowID, OperationSignal))
+	assert.False(t, wfCache.AllowOperation(testDomainID, testWorkflowID, OperationSignal))
+
+	// Describe has no rate limiter, so it is always allowed
+	assert.True(t, wfCache.AllowOperation(testDomainID, testWorkflowID, OperationDescribe))
+	assert.True(t, wfCache.AllowOperation(testDomainID, testWorkflowID, OperationDescribe))
+
+	throttled := wfCache.GetThrottledWorkflowIDs()
+	require.Len(t, throttled, 1)
+	assert.Equal(t, testDomainName, throttled[0].DomainName)
+	assert.Equal(t, testWorkflowID, throttled[0].WorkflowID)
+	assert.Equal(t, OperationSignal.String(), throttled[0].Limit)
+	assert.Equal(t, int64(1), throttled[0].Count)
+}
+
 // TestWfCache_AllowMultipleWorkflow tests that the cache will use the correct rate limiter for different workflows.
 func TestWfCache_AllowMultipleWorkflow(t *testing.T) {
 	ctrl := gomock.NewController(t)
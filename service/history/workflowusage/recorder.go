@@ -0,0 +1,207 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination recorder_mock.go -self_package github.com/uber/cadence/service/history/workflowusage
+
+package workflowusage
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const (
+	flushJitterCoefficient = 0.2
+	publishTimeout         = 30 * time.Second
+	purgeInterval          = time.Hour
+	purgeTimeout           = time.Minute
+)
+
+type (
+	// Recorder sums the workflow usage of a history host in memory and periodically appends it
+	// to the domain usage queue as one report. It also purges the reports past their retention.
+	Recorder interface {
+		common.Daemon
+		Record(usage *domain.WorkflowUsage)
+	}
+
+	usageKey struct {
+		domainID     string
+		workflowType string
+	}
+
+	recorderImpl struct {
+		status        int32
+		queue         domain.UsageQueue
+		hostName      string
+		flushInterval dynamicconfig.DurationPropertyFn
+		retention     dynamicconfig.DurationPropertyFn
+		timeSource    clock.TimeSource
+		logger        log.Logger
+		shutdownCh    chan struct{}
+		shutdownWG    sync.WaitGroup
+
+		sync.Mutex
+		startTime time.Time
+		usage     map[usageKey]*domain.WorkflowUsage
+	}
+)
+
+var _ Recorder = (*recorderImpl)(nil)
+
+// NewRecorder creates a new workflow usage Recorder
+func NewRecorder(
+	queue domain.UsageQueue,
+	hostName string,
+	flushInterval dynamicconfig.DurationPropertyFn,
+	retention dynamicconfig.DurationPropertyFn,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) Recorder {
+	return &recorderImpl{
+		status:        common.DaemonStatusInitialized,
+		queue:         queue,
+		hostName:      hostName,
+		flushInterval: flushInterval,
+		retention:     retention,
+		timeSource:    timeSource,
+		logger:        logger.WithTags(tag.ComponentWorkflowUsageRecorder),
+		shutdownCh:    make(chan struct{}),
+		startTime:     timeSource.Now(),
+		usage:         make(map[usageKey]*domain.WorkflowUsage),
+	}
+}
+
+func (r *recorderImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	r.shutdownWG.Add(1)
+	go r.processLoop()
+}
+
+// Stop flushes the usage recorded since the last report
+func (r *recorderImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(r.shutdownCh)
+	r.shutdownWG.Wait()
+	r.flush()
+}
+
+func (r *recorderImpl) Record(
+	usage *domain.WorkflowUsage,
+) {
+	key := usageKey{domainID: usage.DomainID, workflowType: usage.WorkflowType}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if total, ok := r.usage[key]; ok {
+		total.Add(usage)
+		return
+	}
+	total := *usage
+	r.usage[key] = &total
+}
+
+func (r *recorderImpl) processLoop() {
+	defer r.shutdownWG.Done()
+
+	flushTimer := time.NewTimer(backoff.JitDuration(r.flushInterval(), flushJitterCoefficient))
+	defer flushTimer.Stop()
+	purgeTicker := time.NewTicker(backoff.JitDuration(purgeInterval, flushJitterCoefficient))
+	defer purgeTicker.Stop()
+
+	for {
+		select {
+		case <-r.shutdownCh:
+			return
+		case <-flushTimer.C:
+			r.flush()
+			flushTimer.Reset(backoff.JitDuration(r.flushInterval(), flushJitterCoefficient))
+		case <-purgeTicker.C:
+			r.purge()
+		}
+	}
+}
+
+func (r *recorderImpl) flush() {
+	r.Lock()
+	startTime := r.startTime
+	usage := r.usage
+	endTime := r.timeSource.Now()
+	r.startTime = endTime
+	r.usage = make(map[usageKey]*domain.WorkflowUsage)
+	r.Unlock()
+
+	if len(usage) == 0 {
+		return
+	}
+
+	report := &domain.UsageReport{
+		Host:      r.hostName,
+		StartTime: startTime.UnixNano(),
+		EndTime:   endTime.UnixNano(),
+		Usage:     make([]*domain.WorkflowUsage, 0, len(usage)),
+	}
+	for _, workflowUsage := range usage {
+		report.Usage = append(report.Usage, workflowUsage)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancel()
+	if err := r.queue.Publish(ctx, report); err != nil {
+		r.logger.Warn("Failed to publish workflow usage report, it is merged into the next one.", tag.Error(err))
+		// the next report covers the period of the failed one
+		r.Lock()
+		r.startTime = startTime
+		for key, workflowUsage := range usage {
+			if total, ok := r.usage[key]; ok {
+				workflowUsage.Add(total)
+			}
+			r.usage[key] = workflowUsage
+		}
+		r.Unlock()
+	}
+}
+
+func (r *recorderImpl) purge() {
+	ctx, cancel := context.WithTimeout(context.Background(), purgeTimeout)
+	defer cancel()
+	if err := r.queue.Purge(ctx, r.timeSource.Now().Add(-r.retention())); err != nil {
+		r.logger.Warn("Failed to purge expired workflow usage reports.", tag.Error(err))
+	}
+}
@@ -0,0 +1,104 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package workflowusage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+)
+
+func TestRecorder_Flush(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockQueue := domain.NewMockUsageQueue(ctrl)
+	timeSource := clock.NewMockedTimeSourceAt(time.Unix(100, 0))
+	recorder := NewRecorder(
+		mockQueue,
+		"host",
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		dynamicconfig.GetDurationPropertyFn(time.Hour),
+		timeSource,
+		log.NewNoop(),
+	).(*recorderImpl)
+
+	// nothing is published without usage
+	recorder.flush()
+
+	recorder.Record(&domain.WorkflowUsage{DomainID: "domainID", DomainName: "domain", WorkflowType: "a", Signals: 1, HistoryBytes: 10})
+	recorder.Record(&domain.WorkflowUsage{DomainID: "domainID", DomainName: "domain", WorkflowType: "a", Timers: 2, HistoryBytes: 5})
+	timeSource.Advance(time.Minute)
+
+	// a failed report is merged into the next one
+	mockQueue.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(errors.New("publish error"))
+	recorder.flush()
+
+	recorder.Record(&domain.WorkflowUsage{DomainID: "domainID", DomainName: "domain", WorkflowType: "a", Signals: 1})
+	recorder.Record(&domain.WorkflowUsage{DomainID: "domainID", DomainName: "domain", WorkflowType: "b", DecisionTasks: 3})
+	timeSource.Advance(time.Minute)
+
+	var published *domain.UsageReport
+	mockQueue.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, report *domain.UsageReport) error {
+			published = report
+			return nil
+		},
+	)
+	recorder.flush()
+
+	assert.Equal(t, "host", published.Host)
+	assert.Equal(t, time.Unix(100, 0).UnixNano(), published.StartTime)
+	assert.Equal(t, time.Unix(220, 0).UnixNano(), published.EndTime)
+	assert.ElementsMatch(t, []*domain.WorkflowUsage{
+		{DomainID: "domainID", DomainName: "domain", WorkflowType: "a", Signals: 2, Timers: 2, HistoryBytes: 15},
+		{DomainID: "domainID", DomainName: "domain", WorkflowType: "b", DecisionTasks: 3},
+	}, published.Usage)
+
+	// the published usage is not reported again
+	recorder.flush()
+}
+
+func TestRecorder_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockQueue := domain.NewMockUsageQueue(ctrl)
+	timeSource := clock.NewMockedTimeSourceAt(time.Unix(10000, 0))
+	recorder := NewRecorder(
+		mockQueue,
+		"host",
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		dynamicconfig.GetDurationPropertyFn(time.Hour),
+		timeSource,
+		log.NewNoop(),
+	).(*recorderImpl)
+
+	mockQueue.EXPECT().Purge(gomock.Any(), time.Unix(10000, 0).Add(-time.Hour)).Return(nil)
+	recorder.purge()
+}
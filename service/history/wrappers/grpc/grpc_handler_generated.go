@@ -97,6 +97,11 @@ func (g GRPCHandler) GetReplicationMessages(ctx context.Context, request *histor
 	return proto.FromHistoryGetReplicationMessagesResponse(response), proto.FromError(err)
 }
 
+func (g GRPCHandler) ListThrottledWorkflowIDs(ctx context.Context, request *historyv1.ListThrottledWorkflowIDsRequest) (*historyv1.ListThrottledWorkflowIDsResponse, error) {
+	response, err := g.h.ListThrottledWorkflowIDs(ctx, proto.ToHistoryListThrottledWorkflowIDsRequest(request))
+	return proto.FromHistoryListThrottledWorkflowIDsResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) MergeDLQMessages(ctx context.Context, request *historyv1.MergeDLQMessagesRequest) (*historyv1.MergeDLQMessagesResponse, error) {
 	response, err := g.h.MergeDLQMessages(ctx, proto.ToHistoryMergeDLQMessagesRequest(request))
 	return proto.FromHistoryMergeDLQMessagesResponse(response), proto.FromError(err)
@@ -107,6 +112,16 @@ func (g GRPCHandler) NotifyFailoverMarkers(ctx context.Context, request *history
 	return &historyv1.NotifyFailoverMarkersResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) PauseActivity(ctx context.Context, request *historyv1.PauseActivityRequest) (*historyv1.PauseActivityResponse, error) {
+	err := g.h.PauseActivity(ctx, proto.ToHistoryPauseActivityRequest(request))
+	return &historyv1.PauseActivityResponse{}, proto.FromError(err)
+}
+
+func (g GRPCHandler) PauseWorkflowExecution(ctx context.Context, request *historyv1.PauseWorkflowExecutionRequest) (*historyv1.PauseWorkflowExecutionResponse, error) {
+	err := g.h.PauseWorkflowExecution(ctx, proto.ToHistoryPauseWorkflowExecutionRequest(request))
+	return &historyv1.PauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) PollMutableState(ctx context.Context, request *historyv1.PollMutableStateRequest) (*historyv1.PollMutableStateResponse, error) {
 	response, err := g.h.PollMutableState(ctx, proto.ToHistoryPollMutableStateRequest(request))
 	return proto.FromHistoryPollMutableStateResponse(response), proto.FromError(err)
@@ -182,6 +197,11 @@ func (g GRPCHandler) RequestCancelWorkflowExecution(ctx context.Context, request
 	return &historyv1.RequestCancelWorkflowExecutionResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) ResetActivity(ctx context.Context, request *historyv1.ResetActivityRequest) (*historyv1.ResetActivityResponse, error) {
+	err := g.h.ResetActivity(ctx, proto.ToHistoryResetActivityRequest(request))
+	return &historyv1.ResetActivityResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) ResetQueue(ctx context.Context, request *historyv1.ResetQueueRequest) (*historyv1.ResetQueueResponse, error) {
 	err := g.h.ResetQueue(ctx, proto.ToHistoryResetQueueRequest(request))
 	return &historyv1.ResetQueueResponse{}, proto.FromError(err)
@@ -261,3 +281,18 @@ func (g GRPCHandler) TerminateWorkflowExecution(ctx context.Context, request *hi
 	err := g.h.TerminateWorkflowExecution(ctx, proto.ToHistoryTerminateWorkflowExecutionRequest(request))
 	return &historyv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
 }
+
+func (g GRPCHandler) UnpauseActivity(ctx context.Context, request *historyv1.UnpauseActivityRequest) (*historyv1.UnpauseActivityResponse, error) {
+	err := g.h.UnpauseActivity(ctx, proto.ToHistoryUnpauseActivityRequest(request))
+	return &historyv1.UnpauseActivityResponse{}, proto.FromError(err)
+}
+
+func (g GRPCHandler) UnpauseWorkflowExecution(ctx context.Context, request *historyv1.UnpauseWorkflowExecutionRequest) (*historyv1.UnpauseWorkflowExecutionResponse, error) {
+	err := g.h.UnpauseWorkflowExecution(ctx, proto.ToHistoryUnpauseWorkflowExecutionRequest(request))
+	return &historyv1.UnpauseWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
+func (g GRPCHandler) UpdateActivityOptions(ctx context.Context, request *historyv1.UpdateActivityOptionsRequest) (*historyv1.UpdateActivityOptionsResponse, error) {
+	err := g.h.UpdateActivityOptions(ctx, proto.ToHistoryUpdateActivityOptionsRequest(request))
+	return &historyv1.UpdateActivityOptionsResponse{}, proto.FromError(err)
+}
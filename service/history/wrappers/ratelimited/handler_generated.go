@@ -32,7 +32,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
@@ -47,9 +46,10 @@ type historyHandler struct {
 	wrapped                        handler.Handler
 	workflowIDCache                workflowcache.WFCache
 	ratelimitExternalPerWorkflowID dynamicconfig.BoolPropertyFnWithDomainFilter
+	ratelimitQueryPerWorkflowID    dynamicconfig.BoolPropertyFnWithDomainFilter
 	domainCache                    cache.DomainCache
 	logger                         log.Logger
-	allowFunc                      func(domainID string, workflowID string) bool
+	allowFunc                      func(domainID string, workflowID string, operation workflowcache.Operation) error
 }
 
 // NewHistoryHandler creates a new instance of Handler with ratelimiter.
@@ -57,6 +57,7 @@ func NewHistoryHandler(
 	wrapped handler.Handler,
 	workflowIDCache workflowcache.WFCache,
 	ratelimitExternalPerWorkflowID dynamicconfig.BoolPropertyFnWithDomainFilter,
+	ratelimitQueryPerWorkflowID dynamicconfig.BoolPropertyFnWithDomainFilter,
 	domainCache cache.DomainCache,
 	logger log.Logger,
 ) handler.Handler {
@@ -64,6 +65,7 @@ func NewHistoryHandler(
 		wrapped:                        wrapped,
 		workflowIDCache:                workflowIDCache,
 		ratelimitExternalPerWorkflowID: ratelimitExternalPerWorkflowID,
+		ratelimitQueryPerWorkflowID:    ratelimitQueryPerWorkflowID,
 		domainCache:                    domainCache,
 		logger:                         logger,
 	}
@@ -109,11 +111,7 @@ func (h *historyHandler) DescribeWorkflowExecution(ctx context.Context, hp1 *typ
 		return
 	}
 
-	if !h.allowFunc(hp1.GetDomainUUID(), hp1.Request.GetExecution().GetWorkflowID()) {
-		err = &types.ServiceBusyError{
-			Message: "Too many requests for the workflow ID",
-			Reason:  common.WorkflowIDRateLimitReason,
-		}
+	if err = h.allowFunc(hp1.GetDomainUUID(), hp1.Request.GetExecution().GetWorkflowID(), workflowcache.OperationDescribe); err != nil {
 		return
 	}
 	return h.wrapped.DescribeWorkflowExecution(ctx, hp1)
@@ -143,6 +141,10 @@ func (h *historyHandler) Health(ctx context.Context) (hp1 *types.HealthStatus, e
 	return h.wrapped.Health(ctx)
 }
 
+func (h *historyHandler) ListThrottledWorkflowIDs(ctx context.Context, lp1 *types.ListThrottledWorkflowIDsRequest) (lp2 *types.ListThrottledWorkflowIDsResponse, err error) {
+	return h.wrapped.ListThrottledWorkflowIDs(ctx, lp1)
+}
+
 func (h *historyHandler) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMessagesRequest) (mp2 *types.MergeDLQMessagesResponse, err error) {
 	return h.wrapped.MergeDLQMessages(ctx, mp1)
 }
@@ -151,6 +153,14 @@ func (h *historyHandler) NotifyFailoverMarkers(ctx context.Context, np1 *types.N
 	return h.wrapped.NotifyFailoverMarkers(ctx, np1)
 }
 
+func (h *historyHandler) PauseActivity(ctx context.Context, hp1 *types.HistoryPauseActivityRequest) (err error) {
+	return h.wrapped.PauseActivity(ctx, hp1)
+}
+
+func (h *historyHandler) PauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryPauseWorkflowExecutionRequest) (err error) {
+	return h.wrapped.PauseWorkflowExecution(ctx, hp1)
+}
+
 func (h *historyHandler) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest) (pp2 *types.PollMutableStateResponse, err error) {
 	return h.wrapped.PollMutableState(ctx, pp1)
 }
@@ -164,6 +174,25 @@ func (h *historyHandler) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeD
 }
 
 func (h *historyHandler) QueryWorkflow(ctx context.Context, hp1 *types.HistoryQueryWorkflowRequest) (hp2 *types.HistoryQueryWorkflowResponse, err error) {
+
+	if hp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+
+	if hp1.GetDomainUUID() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+
+	if hp1.Request.GetExecution().GetWorkflowID() == "" {
+		err = validate.ErrWorkflowIDNotSet
+		return
+	}
+
+	if err = h.allowFunc(hp1.GetDomainUUID(), hp1.Request.GetExecution().GetWorkflowID(), workflowcache.OperationQuery); err != nil {
+		return
+	}
 	return h.wrapped.QueryWorkflow(ctx, hp1)
 }
 
@@ -215,6 +244,10 @@ func (h *historyHandler) RequestCancelWorkflowExecution(ctx context.Context, hp1
 	return h.wrapped.RequestCancelWorkflowExecution(ctx, hp1)
 }
 
+func (h *historyHandler) ResetActivity(ctx context.Context, hp1 *types.HistoryResetActivityRequest) (err error) {
+	return h.wrapped.ResetActivity(ctx, hp1)
+}
+
 func (h *historyHandler) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest) (err error) {
 	return h.wrapped.ResetQueue(ctx, rp1)
 }
@@ -272,11 +305,7 @@ func (h *historyHandler) SignalWithStartWorkflowExecution(ctx context.Context, h
 		return
 	}
 
-	if !h.allowFunc(hp1.GetDomainUUID(), hp1.SignalWithStartRequest.GetWorkflowID()) {
-		err = &types.ServiceBusyError{
-			Message: "Too many requests for the workflow ID",
-			Reason:  common.WorkflowIDRateLimitReason,
-		}
+	if err = h.allowFunc(hp1.GetDomainUUID(), hp1.SignalWithStartRequest.GetWorkflowID(), workflowcache.OperationSignalWithStart); err != nil {
 		return
 	}
 	return h.wrapped.SignalWithStartWorkflowExecution(ctx, hp1)
@@ -299,11 +328,7 @@ func (h *historyHandler) SignalWorkflowExecution(ctx context.Context, hp1 *types
 		return
 	}
 
-	if !h.allowFunc(hp1.GetDomainUUID(), hp1.SignalRequest.GetWorkflowExecution().GetWorkflowID()) {
-		err = &types.ServiceBusyError{
-			Message: "Too many requests for the workflow ID",
-			Reason:  common.WorkflowIDRateLimitReason,
-		}
+	if err = h.allowFunc(hp1.GetDomainUUID(), hp1.SignalRequest.GetWorkflowExecution().GetWorkflowID(), workflowcache.OperationSignal); err != nil {
 		return
 	}
 	return h.wrapped.SignalWorkflowExecution(ctx, hp1)
@@ -331,11 +356,7 @@ func (h *historyHandler) StartWorkflowExecution(ctx context.Context, hp1 *types.
 		return
 	}
 
-	if !h.allowFunc(hp1.GetDomainUUID(), hp1.StartRequest.GetWorkflowID()) {
-		err = &types.ServiceBusyError{
-			Message: "Too many requests for the workflow ID",
-			Reason:  common.WorkflowIDRateLimitReason,
-		}
+	if err = h.allowFunc(hp1.GetDomainUUID(), hp1.StartRequest.GetWorkflowID(), workflowcache.OperationStart); err != nil {
 		return
 	}
 	return h.wrapped.StartWorkflowExecution(ctx, hp1)
@@ -357,3 +378,15 @@ func (h *historyHandler) SyncShardStatus(ctx context.Context, sp1 *types.SyncSha
 func (h *historyHandler) TerminateWorkflowExecution(ctx context.Context, hp1 *types.HistoryTerminateWorkflowExecutionRequest) (err error) {
 	return h.wrapped.TerminateWorkflowExecution(ctx, hp1)
 }
+
+func (h *historyHandler) UnpauseActivity(ctx context.Context, hp1 *types.HistoryUnpauseActivityRequest) (err error) {
+	return h.wrapped.UnpauseActivity(ctx, hp1)
+}
+
+func (h *historyHandler) UnpauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryUnpauseWorkflowExecutionRequest) (err error) {
+	return h.wrapped.UnpauseWorkflowExecution(ctx, hp1)
+}
+
+func (h *historyHandler) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest) (err error) {
+	return h.wrapped.UpdateActivityOptions(ctx, hp1)
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/handler"
+	"github.com/uber/cadence/service/history/workflowcache"
 )
 
 const (
@@ -52,6 +53,7 @@ func TestRatelimitedEndpoints_Table(t *testing.T) {
 		handlerMock,
 		nil,
 		func(domainName string) bool { return rateLimitingEnabled },
+		func(domainName string) bool { return rateLimitingEnabled },
 		nil,
 		log.NewNoop(),
 	)
@@ -59,13 +61,16 @@ func TestRatelimitedEndpoints_Table(t *testing.T) {
 	// We define the calls that should be ratelimited
 	limitedCalls := []struct {
 		name string
+		// The operation the call is rate limited as
+		operation workflowcache.Operation
 		// Defines how to call the wrapper function (correct request type, and call)
 		callWrapper func() (interface{}, error)
 		// Defines the expected call to the wrapped handler (what to call if the call is not ratelimited)
 		expectCallToEndpoint func()
 	}{
 		{
-			name: "StartWorkflowExecution",
+			name:      "StartWorkflowExecution",
+			operation: workflowcache.OperationStart,
 			callWrapper: func() (interface{}, error) {
 				startRequest := &types.HistoryStartWorkflowExecutionRequest{
 					DomainUUID:   testDomainID,
@@ -78,7 +83,8 @@ func TestRatelimitedEndpoints_Table(t *testing.T) {
 			},
 		},
 		{
-			name: "SignalWithStartWorkflowExecution",
+			name:      "SignalWithStartWorkflowExecution",
+			operation: workflowcache.OperationSignalWithStart,
 			callWrapper: func() (interface{}, error) {
 				signalWithStartRequest := &types.HistorySignalWithStartWorkflowExecutionRequest{
 					DomainUUID:             testDomainID,
@@ -92,7 +98,8 @@ func TestRatelimitedEndpoints_Table(t *testing.T) {
 			},
 		},
 		{
-			name: "SignalWorkflowExecution",
+			name:      "SignalWorkflowExecution",
+			operation: workflowcache.OperationSignal,
 			callWrapper: func() (interface{}, error) {
 				signalRequest := &types.HistorySignalWorkflowExecutionRequest{
 					DomainUUID: testDomainID,
@@ -108,7 +115,8 @@ func TestRatelimitedEndpoints_Table(t *testing.T) {
 			},
 		},
 		{
-			name: "DescribeWorkflowExecution",
+			name:      "DescribeWorkflowExecution",
+			operation: workflowcache.OperationDescribe,
 			callWrapper: func() (interface{}, error) {
 				describeRequest := &types.HistoryDescribeWorkflowExecutionRequest{
 					DomainUUID: testDomainID,
@@ -123,18 +131,43 @@ func TestRatelimitedEndpoints_Table(t *testing.T) {
 				handlerMock.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
 			},
 		},
+		{
+			name:      "QueryWorkflow",
+			operation: workflowcache.OperationQuery,
+			callWrapper: func() (interface{}, error) {
+				queryRequest := &types.HistoryQueryWorkflowRequest{
+					DomainUUID: testDomainID,
+					Request: &types.QueryWorkflowRequest{
+						Execution: &types.WorkflowExecution{WorkflowID: testWorkflowID},
+					},
+				}
+
+				return wrapper.QueryWorkflow(context.Background(), queryRequest)
+			},
+			expectCallToEndpoint: func() {
+				handlerMock.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+			},
+		},
 	}
 
 	for _, endpoint := range limitedCalls {
 		t.Run(fmt.Sprintf("%s, %s", endpoint.name, "not limited"), func(t *testing.T) {
-			wrapper.(*historyHandler).allowFunc = func(string, string) bool { return true }
+			wrapper.(*historyHandler).allowFunc = func(domainID string, workflowID string, operation workflowcache.Operation) error {
+				assert.Equal(t, endpoint.operation, operation)
+				return nil
+			}
 			endpoint.expectCallToEndpoint()
 			_, err := endpoint.callWrapper()
 			assert.NoError(t, err)
 		})
 
 		t.Run(fmt.Sprintf("%s, %s", endpoint.name, "limited"), func(t *testing.T) {
-			wrapper.(*historyHandler).allowFunc = func(string, string) bool { return false }
+			wrapper.(*historyHandler).allowFunc = func(string, string, workflowcache.Operation) error {
+				return &types.ServiceBusyError{
+					Message: "Too many requests for the workflow ID",
+					Reason:  common.WorkflowIDRateLimitReason,
+				}
+			}
 			_, err := endpoint.callWrapper()
 			var sbErr *types.ServiceBusyError
 			assert.ErrorAs(t, err, &sbErr)
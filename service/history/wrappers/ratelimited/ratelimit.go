@@ -22,18 +22,47 @@
 
 package ratelimited
 
-import "github.com/uber/cadence/common/log/tag"
+import (
+	"fmt"
 
-func (h *historyHandler) allowWfID(domainUUID, workflowID string) bool {
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/workflowcache"
+)
+
+// allowWfID returns a ServiceBusyError if either the operation's own limit or the limit shared by all
+// external requests for the workflow ID is exhausted. The limits are always checked, so that throttled
+// workflow IDs are recorded by the cache, but they are only enforced when rate limiting is enabled for the domain.
+func (h *historyHandler) allowWfID(domainUUID, workflowID string, operation workflowcache.Operation) error {
 	domainName, err := h.domainCache.GetDomainName(domainUUID)
 	if err != nil {
 		h.logger.Error("Error when getting domain name", tag.Error(err))
 		// Fail open
-		return true
+		return nil
 	}
 
-	allow := h.workflowIDCache.AllowExternal(domainUUID, workflowID)
-	enabled := h.ratelimitExternalPerWorkflowID(domainName)
+	if operation == workflowcache.OperationQuery && !h.ratelimitQueryPerWorkflowID(domainName) {
+		// queries don't consume the limits shared with the other requests unless they are enabled separately
+		return nil
+	}
 
-	return allow || !enabled
+	// the operation limit is checked first, so that throttled operations don't consume the shared limit
+	var busyErr error
+	if !h.workflowIDCache.AllowOperation(domainUUID, workflowID, operation) {
+		busyErr = &types.ServiceBusyError{
+			Message: fmt.Sprintf("Too many %v requests for the workflow ID", operation),
+			Reason:  common.WorkflowIDRateLimitReason,
+		}
+	} else if !h.workflowIDCache.AllowExternal(domainUUID, workflowID) {
+		busyErr = &types.ServiceBusyError{
+			Message: "Too many requests for the workflow ID",
+			Reason:  common.WorkflowIDRateLimitReason,
+		}
+	}
+
+	if !h.ratelimitExternalPerWorkflowID(domainName) {
+		return nil
+	}
+	return busyErr
 }
@@ -30,8 +30,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/workflowcache"
 )
 
@@ -39,27 +41,24 @@ func TestAllowWfID(t *testing.T) {
 	tests := []struct {
 		ratelimitEnabled     bool
 		workflowIDCacheAllow bool
-		expected             bool
+		expectedMessage      string
 	}{
 		{
 			ratelimitEnabled:     true,
 			workflowIDCacheAllow: true,
-			expected:             true,
 		},
 		{
 			ratelimitEnabled:     true,
 			workflowIDCacheAllow: false,
-			expected:             false,
+			expectedMessage:      "Too many requests for the workflow ID",
 		},
 		{
 			ratelimitEnabled:     false,
 			workflowIDCacheAllow: true,
-			expected:             true,
 		},
 		{
 			ratelimitEnabled:     false,
 			workflowIDCacheAllow: false,
-			expected:             true,
 		},
 	}
 
@@ -67,6 +66,7 @@ func TestAllowWfID(t *testing.T) {
 		t.Run(fmt.Sprintf("ratelimitEnabled: %t, workflowIDCacheAllow: %t", tt.ratelimitEnabled, tt.workflowIDCacheAllow), func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			workflowIDCacheMock := workflowcache.NewMockWFCache(ctrl)
+			workflowIDCacheMock.EXPECT().AllowOperation(testDomainID, testWorkflowID, workflowcache.OperationSignal).Return(true).Times(1)
 			workflowIDCacheMock.EXPECT().AllowExternal(testDomainID, testWorkflowID).Return(tt.workflowIDCacheAllow).Times(1)
 
 			domainCacheMock := cache.NewMockDomainCache(ctrl)
@@ -79,13 +79,84 @@ func TestAllowWfID(t *testing.T) {
 				ratelimitExternalPerWorkflowID: func(domain string) bool { return tt.ratelimitEnabled },
 			}
 
-			got := h.allowWfID(testDomainID, testWorkflowID)
+			err := h.allowWfID(testDomainID, testWorkflowID, workflowcache.OperationSignal)
 
-			assert.Equal(t, tt.expected, got)
+			if tt.expectedMessage == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var sbErr *types.ServiceBusyError
+			assert.ErrorAs(t, err, &sbErr)
+			assert.Equal(t, tt.expectedMessage, sbErr.Message)
+			assert.Equal(t, common.WorkflowIDRateLimitReason, sbErr.Reason)
+		})
+	}
+}
+
+func TestAllowWfID_Operation(t *testing.T) {
+	tests := []struct {
+		ratelimitEnabled bool
+		expectedMessage  string
+	}{
+		{
+			ratelimitEnabled: true,
+			expectedMessage:  "Too many signal requests for the workflow ID",
+		},
+		{
+			ratelimitEnabled: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("ratelimitEnabled: %t", tt.ratelimitEnabled), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			workflowIDCacheMock := workflowcache.NewMockWFCache(ctrl)
+			// the shared limit is not consumed by a throttled operation
+			workflowIDCacheMock.EXPECT().AllowOperation(testDomainID, testWorkflowID, workflowcache.OperationSignal).Return(false).Times(1)
+
+			domainCacheMock := cache.NewMockDomainCache(ctrl)
+			domainCacheMock.EXPECT().GetDomainName(testDomainID).Return(testDomainID, nil).Times(1)
+
+			h := &historyHandler{
+				workflowIDCache:                workflowIDCacheMock,
+				domainCache:                    domainCacheMock,
+				logger:                         log.NewNoop(),
+				ratelimitExternalPerWorkflowID: func(domain string) bool { return tt.ratelimitEnabled },
+			}
+
+			err := h.allowWfID(testDomainID, testWorkflowID, workflowcache.OperationSignal)
+
+			if tt.expectedMessage == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var sbErr *types.ServiceBusyError
+			assert.ErrorAs(t, err, &sbErr)
+			assert.Equal(t, tt.expectedMessage, sbErr.Message)
 		})
 	}
 }
 
+func TestAllowWfID_QueryDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	// no limiter is called for queries while their rate limiting is disabled
+	workflowIDCacheMock := workflowcache.NewMockWFCache(ctrl)
+
+	domainCacheMock := cache.NewMockDomainCache(ctrl)
+	domainCacheMock.EXPECT().GetDomainName(testDomainID).Return(testDomainID, nil).Times(1)
+
+	h := &historyHandler{
+		workflowIDCache:                workflowIDCacheMock,
+		domainCache:                    domainCacheMock,
+		logger:                         log.NewNoop(),
+		ratelimitExternalPerWorkflowID: func(domain string) bool { return true },
+		ratelimitQueryPerWorkflowID:    func(domain string) bool { return false },
+	}
+
+	err := h.allowWfID(testDomainID, testWorkflowID, workflowcache.OperationQuery)
+	assert.NoError(t, err)
+}
+
 func TestAllowWfID_DomainCacheError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	domainCacheMock := cache.NewMockDomainCache(ctrl)
@@ -99,8 +170,8 @@ func TestAllowWfID_DomainCacheError(t *testing.T) {
 		logger:      loggerMock,
 	}
 
-	got := h.allowWfID(testDomainID, testWorkflowID)
+	err := h.allowWfID(testDomainID, testWorkflowID, workflowcache.OperationDescribe)
 
 	// Fail open
-	assert.True(t, got)
+	assert.NoError(t, err)
 }
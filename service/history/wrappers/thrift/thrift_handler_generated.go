@@ -85,6 +85,11 @@ func (g ThriftHandler) GetReplicationMessages(ctx context.Context, Request *repl
 	return thrift.FromHistoryGetReplicationMessagesResponse(response), thrift.FromError(err)
 }
 
+func (g ThriftHandler) ListThrottledWorkflowIDs(ctx context.Context, Request *shared.ListThrottledWorkflowIDsRequest) (lp1 *shared.ListThrottledWorkflowIDsResponse, err error) {
+	response, err := g.h.ListThrottledWorkflowIDs(ctx, thrift.ToHistoryListThrottledWorkflowIDsRequest(Request))
+	return thrift.FromHistoryListThrottledWorkflowIDsResponse(response), thrift.FromError(err)
+}
+
 func (g ThriftHandler) MergeDLQMessages(ctx context.Context, Request *replicator.MergeDLQMessagesRequest) (mp1 *replicator.MergeDLQMessagesResponse, err error) {
 	response, err := g.h.MergeDLQMessages(ctx, thrift.ToHistoryMergeDLQMessagesRequest(Request))
 	return thrift.FromHistoryMergeDLQMessagesResponse(response), thrift.FromError(err)
@@ -95,6 +100,16 @@ func (g ThriftHandler) NotifyFailoverMarkers(ctx context.Context, Request *histo
 	return thrift.FromError(err)
 }
 
+func (g ThriftHandler) PauseActivity(ctx context.Context, PauseRequest *history.PauseActivityRequest) (err error) {
+	err = g.h.PauseActivity(ctx, thrift.ToHistoryPauseActivityRequest(PauseRequest))
+	return thrift.FromError(err)
+}
+
+func (g ThriftHandler) PauseWorkflowExecution(ctx context.Context, PauseRequest *history.PauseWorkflowExecutionRequest) (err error) {
+	err = g.h.PauseWorkflowExecution(ctx, thrift.ToHistoryPauseWorkflowExecutionRequest(PauseRequest))
+	return thrift.FromError(err)
+}
+
 func (g ThriftHandler) PollMutableState(ctx context.Context, PollRequest *history.PollMutableStateRequest) (pp1 *history.PollMutableStateResponse, err error) {
 	response, err := g.h.PollMutableState(ctx, thrift.ToHistoryPollMutableStateRequest(PollRequest))
 	return thrift.FromHistoryPollMutableStateResponse(response), thrift.FromError(err)
@@ -170,6 +185,11 @@ func (g ThriftHandler) RequestCancelWorkflowExecution(ctx context.Context, Cance
 	return thrift.FromError(err)
 }
 
+func (g ThriftHandler) ResetActivity(ctx context.Context, ResetRequest *history.ResetActivityRequest) (err error) {
+	err = g.h.ResetActivity(ctx, thrift.ToHistoryResetActivityRequest(ResetRequest))
+	return thrift.FromError(err)
+}
+
 func (g ThriftHandler) ResetQueue(ctx context.Context, Request *shared.ResetQueueRequest) (err error) {
 	err = g.h.ResetQueue(ctx, thrift.ToHistoryResetQueueRequest(Request))
 	return thrift.FromError(err)
@@ -249,3 +269,18 @@ func (g ThriftHandler) TerminateWorkflowExecution(ctx context.Context, Terminate
 	err = g.h.TerminateWorkflowExecution(ctx, thrift.ToHistoryTerminateWorkflowExecutionRequest(TerminateRequest))
 	return thrift.FromError(err)
 }
+
+func (g ThriftHandler) UnpauseActivity(ctx context.Context, UnpauseRequest *history.UnpauseActivityRequest) (err error) {
+	err = g.h.UnpauseActivity(ctx, thrift.ToHistoryUnpauseActivityRequest(UnpauseRequest))
+	return thrift.FromError(err)
+}
+
+func (g ThriftHandler) UnpauseWorkflowExecution(ctx context.Context, UnpauseRequest *history.UnpauseWorkflowExecutionRequest) (err error) {
+	err = g.h.UnpauseWorkflowExecution(ctx, thrift.ToHistoryUnpauseWorkflowExecutionRequest(UnpauseRequest))
+	return thrift.FromError(err)
+}
+
+func (g ThriftHandler) UpdateActivityOptions(ctx context.Context, UpdateRequest *history.UpdateActivityOptionsRequest) (err error) {
+	err = g.h.UpdateActivityOptions(ctx, thrift.ToHistoryUpdateActivityOptionsRequest(UpdateRequest))
+	return thrift.FromError(err)
+}
@@ -65,7 +65,7 @@ func TestThriftHandler(t *testing.T) {
 	t.Run("DescribeMutableState", func(t *testing.T) {
 		h.EXPECT().DescribeMutableState(ctx, &types.DescribeMutableStateRequest{}).Return(&types.DescribeMutableStateResponse{}, internalErr).Times(1)
 		resp, err := th.DescribeMutableState(ctx, &hist.DescribeMutableStateRequest{})
-		assert.Equal(t, hist.DescribeMutableStateResponse{MutableStateInCache: common.StringPtr(""), MutableStateInDatabase: common.StringPtr("")}, *resp)
+		assert.Equal(t, hist.DescribeMutableStateResponse{MutableStateInCache: common.StringPtr(""), MutableStateInDatabase: common.StringPtr(""), MutableStateRebuiltFromHistory: common.StringPtr("")}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
 	t.Run("DescribeQueue", func(t *testing.T) {
@@ -187,6 +187,7 @@ func TestThriftHandler(t *testing.T) {
 			StickyExecutionEnabled: common.BoolPtr(false),
 			EventStoreVersion:      common.Int32Ptr(0),
 			HistorySize:            common.Int64Ptr(0),
+			ContinueAsNewSuggested: common.BoolPtr(false),
 		}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
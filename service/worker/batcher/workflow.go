@@ -77,10 +77,14 @@ const (
 	BatchTypeSignal = "signal"
 	// BatchTypeReplicate is batch type for replicating workflows
 	BatchTypeReplicate = "replicate"
+	// BatchTypePause is batch type for pausing workflows
+	BatchTypePause = "pause"
+	// BatchTypeUnpause is batch type for unpausing workflows
+	BatchTypeUnpause = "unpause"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate}
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate, BatchTypePause, BatchTypeUnpause}
 
 type (
 	// TerminateParams is the parameters for terminating workflow
@@ -224,9 +228,7 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide target cluster")
 		}
 		return nil
-	case BatchTypeCancel:
-		fallthrough
-	case BatchTypeTerminate:
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypePause, BatchTypeUnpause:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
@@ -437,6 +439,34 @@ func startTaskProcessor(
 							Input:      []byte(batchParams.SignalParams.Input),
 						})
 					})
+			case BatchTypePause:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return client.PauseWorkflowExecution(ctx, &types.PauseWorkflowExecutionRequest{
+							Domain: batchParams.DomainName,
+							WorkflowExecution: &types.WorkflowExecution{
+								WorkflowID: workflowID,
+								RunID:      runID,
+							},
+							Reason:    batchParams.Reason,
+							Identity:  BatchWFTypeName,
+							RequestID: requestID,
+						})
+					})
+			case BatchTypeUnpause:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return client.UnpauseWorkflowExecution(ctx, &types.UnpauseWorkflowExecutionRequest{
+							Domain: batchParams.DomainName,
+							WorkflowExecution: &types.WorkflowExecution{
+								WorkflowID: workflowID,
+								RunID:      runID,
+							},
+							Reason:    batchParams.Reason,
+							Identity:  BatchWFTypeName,
+							RequestID: requestID,
+						})
+					})
 			case BatchTypeReplicate:
 				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
 					func(workflowID, runID string) error {
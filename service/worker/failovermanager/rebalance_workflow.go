@@ -73,12 +73,13 @@ func RebalanceWorkflow(ctx workflow.Context, params *RebalanceParams) (*Rebalanc
 			BatchFailoverSize:              params.BatchFailoverSize,
 			BatchFailoverWaitTimeInSeconds: params.BatchFailoverWaitTimeInSeconds,
 		}
-		successDomains, failedDomains := failoverDomainsByBatch(
+		successDomains, failedDomains, _ := failoverDomainsByBatch(
 			ctx,
 			domains,
 			failoverParams,
 			func() {},
 			false,
+			nil,
 		)
 		result.SuccessDomains = append(result.SuccessDomains, successDomains...)
 		result.FailedDomains = append(result.FailedDomains, failedDomains...)
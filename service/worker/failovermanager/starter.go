@@ -37,6 +37,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 )
 
 type (
@@ -46,6 +47,8 @@ type (
 		AdminOperationToken dynamicconfig.StringPropertyFn
 		// ClusterMetadata contains the metadata for this cluster
 		ClusterMetadata cluster.Metadata
+		// NumberOfShards is the number of history shards of this cluster
+		NumberOfShards int
 	}
 
 	// BootstrapParams contains the set of params needed to bootstrap
@@ -62,29 +65,37 @@ type (
 		TallyScope tally.Scope
 		// ClientBean is an instance of client.Bean for a collection of clients
 		ClientBean client.Bean
+		// ShardManager is used to read the replication levels of the shards
+		ShardManager persistence.ShardManager
+		// ExecutionManagerProvider returns the execution manager of a shard
+		ExecutionManagerProvider func(shardID int) (persistence.ExecutionManager, error)
 	}
 
 	// FailoverManager of cadence worker service
 	FailoverManager struct {
-		cfg           Config
-		svcClient     workflowserviceclient.Interface
-		clientBean    client.Bean
-		metricsClient metrics.Client
-		tallyScope    tally.Scope
-		logger        log.Logger
-		worker        worker.Worker
+		cfg                      Config
+		svcClient                workflowserviceclient.Interface
+		clientBean               client.Bean
+		shardManager             persistence.ShardManager
+		executionManagerProvider func(shardID int) (persistence.ExecutionManager, error)
+		metricsClient            metrics.Client
+		tallyScope               tally.Scope
+		logger                   log.Logger
+		worker                   worker.Worker
 	}
 )
 
 // New returns a new instance of FailoverManager
 func New(params *BootstrapParams) *FailoverManager {
 	return &FailoverManager{
-		cfg:           params.Config,
-		svcClient:     params.ServiceClient,
-		metricsClient: params.MetricsClient,
-		tallyScope:    params.TallyScope,
-		logger:        params.Logger.WithTags(tag.ComponentBatcher),
-		clientBean:    params.ClientBean,
+		cfg:                      params.Config,
+		svcClient:                params.ServiceClient,
+		metricsClient:            params.MetricsClient,
+		tallyScope:               params.TallyScope,
+		logger:                   params.Logger.WithTags(tag.ComponentBatcher),
+		clientBean:               params.ClientBean,
+		shardManager:             params.ShardManager,
+		executionManagerProvider: params.ExecutionManagerProvider,
 	}
 }
 
@@ -101,6 +112,8 @@ func (s *FailoverManager) Start() error {
 	failoverWorker.RegisterWorkflowWithOptions(RebalanceWorkflow, workflow.RegisterOptions{Name: RebalanceWorkflowTypeName})
 	failoverWorker.RegisterActivityWithOptions(FailoverActivity, activity.RegisterOptions{Name: failoverActivityName})
 	failoverWorker.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	failoverWorker.RegisterActivityWithOptions(CheckReplicationLagActivity, activity.RegisterOptions{Name: checkReplicationLagActivityName})
+	failoverWorker.RegisterActivityWithOptions(CheckTargetHealthActivity, activity.RegisterOptions{Name: checkTargetHealthActivityName})
 	failoverWorker.RegisterActivityWithOptions(GetDomainsForRebalanceActivity, activity.RegisterOptions{Name: getRebalanceDomainsActivityName})
 	s.worker = failoverWorker
 	return failoverWorker.Start()
@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/replication"
 )
 
 type (
@@ -57,6 +58,8 @@ const (
 	failoverActivityName            = "cadence-sys-failover-activity"
 	getDomainsActivityName          = "cadence-sys-getDomains-activity"
 	getRebalanceDomainsActivityName = "cadence-sys-getRebalanceDomains-activity"
+	checkReplicationLagActivityName = "cadence-sys-checkReplicationLag-activity"
+	checkTargetHealthActivityName   = "cadence-sys-checkTargetHealth-activity"
 
 	defaultBatchFailoverSize              = 20
 	defaultBatchFailoverWaitTimeInSeconds = 30
@@ -65,6 +68,12 @@ const (
 	errMsgTargetClusterIsEmpty        = "targetCluster is empty"
 	errMsgSourceClusterIsEmpty        = "sourceCluster is empty"
 	errMsgTargetClusterIsSameAsSource = "targetCluster is same as sourceCluster"
+	errMsgReplicationLagExceeded      = "replication lag exceeds threshold"
+	errMsgReplicationLagUnavailable   = "replication lag can only be checked in source cluster"
+	errMsgTargetClusterUnhealthy      = "target cluster is unhealthy"
+	errMsgDLQNotEmpty                 = "replication DLQ is not empty"
+	errMsgBatchFailureRateExceeded    = "batch failure rate exceeds threshold"
+	errMsgFailoverAborted             = "failover aborted"
 
 	// QueryType for failover workflow
 	QueryType = "state"
@@ -106,6 +115,24 @@ type (
 		DrillWaitTime time.Duration
 		// GracefulFailoverTimeoutInSeconds
 		GracefulFailoverTimeoutInSeconds *int32
+		// MaxReplicationLag blocks the failover if replication from source to target cluster
+		// lags behind more than this on any shard. Zero disables the check.
+		MaxReplicationLag time.Duration
+		// EnableHealthChecks blocks the failover if the target cluster is unhealthy or has replication DLQ
+		// messages from the source cluster, and checks the target cluster health again after each batch
+		EnableHealthChecks bool
+		// MaxBatchFailureRate stops the failover if the ratio of failed domains in a batch exceeds it.
+		// Zero disables the check.
+		MaxBatchFailureRate float64
+		// CanaryDomains are failed over first, the other domains follow after CanaryWaitTime if all checks pass
+		CanaryDomains []string
+		// CanaryWaitTime is the time to wait after failing over the canary domains
+		CanaryWaitTime time.Duration
+		// RollbackOnCheckFailure fails the domains already failed over back to the source cluster
+		// if a check fails during the failover
+		RollbackOnCheckFailure bool
+		// DryRun runs the pre-flight checks and reports the planned batches without failing over any domain
+		DryRun bool
 	}
 
 	// FailoverResult is workflow result
@@ -114,6 +141,14 @@ type (
 		FailedDomains       []string
 		SuccessResetDomains []string
 		FailedResetDomains  []string
+		// RolledBackDomains are domains failed back to source cluster after a check failed
+		RolledBackDomains []string
+		// FailedRollbackDomains are domains which could not be failed back, they may contain false positive
+		FailedRollbackDomains []string
+		// AbortReason is the reason why the failover was stopped, or would be stopped in dry run
+		AbortReason string
+		// PlannedBatches are the batches of domains the failover would run in dry run, canary domains first
+		PlannedBatches [][]string
 	}
 
 	// GetDomainsActivityParams params for activity
@@ -123,6 +158,27 @@ type (
 		Domains       []string
 	}
 
+	// CheckReplicationLagActivityParams params for activity
+	CheckReplicationLagActivityParams struct {
+		SourceCluster     string
+		TargetCluster     string
+		MaxReplicationLag time.Duration
+	}
+
+	// ReplicationLagDetails is the error details when replication lag exceeds the threshold
+	ReplicationLagDetails struct {
+		ShardID int
+		Lag     time.Duration
+	}
+
+	// CheckTargetHealthActivityParams params for activity
+	CheckTargetHealthActivityParams struct {
+		SourceCluster string
+		TargetCluster string
+		// CheckDLQ also fails the check if the target cluster has replication DLQ messages from source cluster
+		CheckDLQ bool
+	}
+
 	// FailoverActivityParams params for activity
 	FailoverActivityParams struct {
 		Domains                          []string
@@ -138,17 +194,21 @@ type (
 
 	// QueryResult for failover progress
 	QueryResult struct {
-		TotalDomains        int
-		Success             int
-		Failed              int
-		State               string
-		TargetCluster       string
-		SourceCluster       string
-		SuccessDomains      []string // SuccessDomains are guaranteed succeed processed
-		FailedDomains       []string // FailedDomains contains false positive
-		SuccessResetDomains []string // SuccessResetDomains are domains successfully reset in drill mode
-		FailedResetDomains  []string // FailedResetDomains contains false positive in drill mode
-		Operator            string
+		TotalDomains          int
+		Success               int
+		Failed                int
+		State                 string
+		TargetCluster         string
+		SourceCluster         string
+		SuccessDomains        []string // SuccessDomains are guaranteed succeed processed
+		FailedDomains         []string // FailedDomains contains false positive
+		SuccessResetDomains   []string // SuccessResetDomains are domains successfully reset in drill mode
+		FailedResetDomains    []string // FailedResetDomains contains false positive in drill mode
+		RolledBackDomains     []string // RolledBackDomains are domains failed back to source cluster after a check failed
+		FailedRollbackDomains []string // FailedRollbackDomains contains false positive
+		AbortReason           string
+		PlannedBatches        [][]string // PlannedBatches are the batches of domains in dry run
+		Operator              string
 	}
 )
 
@@ -164,22 +224,30 @@ func FailoverWorkflow(ctx workflow.Context, params *FailoverParams) (*FailoverRe
 	var successDomains []string
 	var successResetDomains []string
 	var failedResetDomains []string
+	var rolledBackDomains []string
+	var failedRollbackDomains []string
+	var abortReason string
+	var plannedBatches [][]string
 	var totalNumOfDomains int
 	wfState := WorkflowInitialized
 	operator := getOperator(ctx)
 	err = workflow.SetQueryHandler(ctx, QueryType, func(input []byte) (*QueryResult, error) {
 		return &QueryResult{
-			TotalDomains:        totalNumOfDomains,
-			Success:             len(successDomains),
-			Failed:              len(failedDomains),
-			State:               wfState,
-			TargetCluster:       params.TargetCluster,
-			SourceCluster:       params.SourceCluster,
-			SuccessDomains:      successDomains,
-			FailedDomains:       failedDomains,
-			SuccessResetDomains: successResetDomains,
-			FailedResetDomains:  failedResetDomains,
-			Operator:            operator,
+			TotalDomains:          totalNumOfDomains,
+			Success:               len(successDomains),
+			Failed:                len(failedDomains),
+			State:                 wfState,
+			TargetCluster:         params.TargetCluster,
+			SourceCluster:         params.SourceCluster,
+			SuccessDomains:        successDomains,
+			FailedDomains:         failedDomains,
+			SuccessResetDomains:   successResetDomains,
+			FailedResetDomains:    failedResetDomains,
+			RolledBackDomains:     rolledBackDomains,
+			FailedRollbackDomains: failedRollbackDomains,
+			AbortReason:           abortReason,
+			PlannedBatches:        plannedBatches,
+			Operator:              operator,
 		}, nil
 	})
 	if err != nil {
@@ -199,6 +267,24 @@ func FailoverWorkflow(ctx workflow.Context, params *FailoverParams) (*FailoverRe
 		return nil, err
 	}
 	totalNumOfDomains = len(domains)
+	canaryDomains, otherDomains := splitCanaryDomains(domains, params.CanaryDomains)
+
+	err = runPreFlightChecks(ctx, params)
+	if params.DryRun {
+		plannedBatches = append(getBatches(canaryDomains, params.BatchFailoverSize), getBatches(otherDomains, params.BatchFailoverSize)...)
+		if err != nil {
+			abortReason = err.Error()
+		}
+		wfState = WorkflowCompleted
+		return &FailoverResult{
+			AbortReason:    abortReason,
+			PlannedBatches: plannedBatches,
+		}, nil
+	}
+	if err != nil {
+		wfState = WorkflowAborted
+		return nil, err
+	}
 
 	pauseCh := workflow.GetSignalChannel(ctx, PauseSignal)
 	resumeCh := workflow.GetSignalChannel(ctx, ResumeSignal)
@@ -214,8 +300,44 @@ func FailoverWorkflow(ctx workflow.Context, params *FailoverParams) (*FailoverRe
 		wfState = WorkflowRunning
 	}
 
+	checkBatch := func(batchSuccessDomains, batchFailedDomains []string) error {
+		return checkFailoverBatch(ctx, params, batchSuccessDomains, batchFailedDomains)
+	}
+
+	// failover canary domains first
+	var checkErr error
+	if len(canaryDomains) > 0 {
+		successDomains, failedDomains, checkErr = failoverDomainsByBatch(ctx, canaryDomains, params, checkPauseSignal, false, checkBatch)
+		if checkErr == nil {
+			workflow.Sleep(ctx, params.CanaryWaitTime)
+			checkErr = checkBatch(successDomains, failedDomains)
+		}
+	}
+
 	// failover in batch
-	successDomains, failedDomains = failoverDomainsByBatch(ctx, domains, params, checkPauseSignal, false)
+	if checkErr == nil {
+		var batchSuccessDomains, batchFailedDomains []string
+		batchSuccessDomains, batchFailedDomains, checkErr = failoverDomainsByBatch(ctx, otherDomains, params, checkPauseSignal, false, checkBatch)
+		successDomains = append(successDomains, batchSuccessDomains...)
+		failedDomains = append(failedDomains, batchFailedDomains...)
+	}
+
+	if checkErr != nil {
+		abortReason = checkErr.Error()
+		if params.RollbackOnCheckFailure && len(successDomains) > 0 {
+			rolledBackDomains, failedRollbackDomains, _ = failoverDomainsByBatch(ctx, successDomains, params, checkPauseSignal, true, nil)
+		}
+		wfState = WorkflowAborted
+		// fail the workflow so that an aborted failover is never mistaken for a successful one,
+		// the partial result is kept as error details
+		return nil, cadence.NewCustomError(errMsgFailoverAborted, &FailoverResult{
+			SuccessDomains:        successDomains,
+			FailedDomains:         failedDomains,
+			RolledBackDomains:     rolledBackDomains,
+			FailedRollbackDomains: failedRollbackDomains,
+			AbortReason:           abortReason,
+		})
+	}
 
 	if params.DrillWaitTime == 0 {
 		// This is a normal failover
@@ -228,7 +350,7 @@ func FailoverWorkflow(ctx workflow.Context, params *FailoverParams) (*FailoverRe
 
 	workflow.Sleep(ctx, params.DrillWaitTime)
 	// Reset domains to original cluster
-	successResetDomains, failedResetDomains = failoverDomainsByBatch(ctx, domains, params, checkPauseSignal, true)
+	successResetDomains, failedResetDomains, _ = failoverDomainsByBatch(ctx, domains, params, checkPauseSignal, true, nil)
 	wfState = WorkflowCompleted
 
 	return &FailoverResult{
@@ -245,7 +367,8 @@ func failoverDomainsByBatch(
 	params *FailoverParams,
 	pauseSignalHandler func(),
 	reverseFailover bool,
-) (successDomains []string, failedDomains []string) {
+	checkBatch func(batchSuccessDomains, batchFailedDomains []string) error,
+) (successDomains []string, failedDomains []string, err error) {
 
 	totalNumOfDomains := len(domains)
 	batchSize := params.BatchFailoverSize
@@ -264,21 +387,101 @@ func failoverDomainsByBatch(
 			GracefulFailoverTimeoutInSeconds: params.GracefulFailoverTimeoutInSeconds,
 		}
 		var actResult FailoverActivityResult
+		var batchSuccessDomains, batchFailedDomains []string
 		err := workflow.ExecuteActivity(ao, FailoverActivity, failoverActivityParams).Get(ctx, &actResult)
 		if err != nil {
 			// Domains in failed activity can be either failovered or not, but we treated them as failed.
 			// This makes the query result for FailedDomains contains false positive results.
-			failedDomains = append(failedDomains, failoverActivityParams.Domains...)
+			batchFailedDomains = failoverActivityParams.Domains
 		} else {
-			successDomains = append(successDomains, actResult.SuccessDomains...)
-			failedDomains = append(failedDomains, actResult.FailedDomains...)
+			batchSuccessDomains = actResult.SuccessDomains
+			batchFailedDomains = actResult.FailedDomains
 		}
+		successDomains = append(successDomains, batchSuccessDomains...)
+		failedDomains = append(failedDomains, batchFailedDomains...)
 
 		if i != times-1 {
 			workflow.Sleep(ctx, time.Duration(params.BatchFailoverWaitTimeInSeconds)*time.Second)
 		}
+
+		if checkBatch != nil && len(failoverActivityParams.Domains) > 0 {
+			if err := checkBatch(batchSuccessDomains, batchFailedDomains); err != nil {
+				return successDomains, failedDomains, err
+			}
+		}
+	}
+	return successDomains, failedDomains, nil
+}
+
+// splitCanaryDomains returns the canary domains which are to be failed over and the rest of the domains
+func splitCanaryDomains(domains []string, canaryDomains []string) ([]string, []string) {
+	canarySet := make(map[string]struct{}, len(canaryDomains))
+	for _, domain := range canaryDomains {
+		canarySet[domain] = struct{}{}
+	}
+	var canary, others []string
+	for _, domain := range domains {
+		if _, ok := canarySet[domain]; ok {
+			canary = append(canary, domain)
+		} else {
+			others = append(others, domain)
+		}
 	}
-	return
+	return canary, others
+}
+
+func getBatches(domains []string, batchSize int) [][]string {
+	var batches [][]string
+	for i := 0; i < len(domains); i += batchSize {
+		batches = append(batches, domains[i:common.MinInt(i+batchSize, len(domains))])
+	}
+	return batches
+}
+
+func runPreFlightChecks(ctx workflow.Context, params *FailoverParams) error {
+	if params.MaxReplicationLag > 0 {
+		ao := workflow.WithActivityOptions(ctx, getCheckReplicationLagActivityOptions())
+		checkReplicationLagParams := &CheckReplicationLagActivityParams{
+			SourceCluster:     params.SourceCluster,
+			TargetCluster:     params.TargetCluster,
+			MaxReplicationLag: params.MaxReplicationLag,
+		}
+		if err := workflow.ExecuteActivity(ao, CheckReplicationLagActivity, checkReplicationLagParams).Get(ctx, nil); err != nil {
+			return err
+		}
+	}
+	if params.EnableHealthChecks {
+		return checkTargetHealth(ctx, params, true)
+	}
+	return nil
+}
+
+func checkFailoverBatch(
+	ctx workflow.Context,
+	params *FailoverParams,
+	batchSuccessDomains []string,
+	batchFailedDomains []string,
+) error {
+	total := len(batchSuccessDomains) + len(batchFailedDomains)
+	if params.MaxBatchFailureRate > 0 && total > 0 {
+		if failureRate := float64(len(batchFailedDomains)) / float64(total); failureRate > params.MaxBatchFailureRate {
+			return fmt.Errorf("%v: %v of %v domains failed", errMsgBatchFailureRateExceeded, len(batchFailedDomains), total)
+		}
+	}
+	if params.EnableHealthChecks {
+		return checkTargetHealth(ctx, params, false)
+	}
+	return nil
+}
+
+func checkTargetHealth(ctx workflow.Context, params *FailoverParams, checkDLQ bool) error {
+	ao := workflow.WithActivityOptions(ctx, getCheckTargetHealthActivityOptions())
+	checkTargetHealthParams := &CheckTargetHealthActivityParams{
+		SourceCluster: params.SourceCluster,
+		TargetCluster: params.TargetCluster,
+		CheckDLQ:      checkDLQ,
+	}
+	return workflow.ExecuteActivity(ao, CheckTargetHealthActivity, checkTargetHealthParams).Get(ctx, nil)
 }
 
 func getOperator(ctx workflow.Context) string {
@@ -316,6 +519,39 @@ func getGetDomainsActivityOptions() workflow.ActivityOptions {
 	}
 }
 
+func getCheckReplicationLagActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: 10 * time.Second,
+		StartToCloseTimeout:    5 * time.Minute,
+		HeartbeatTimeout:       30 * time.Second,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    2 * time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    1 * time.Minute,
+			ExpirationInterval: 10 * time.Minute,
+			NonRetriableErrorReasons: []string{
+				errMsgReplicationLagExceeded,
+				errMsgReplicationLagUnavailable},
+		},
+	}
+}
+
+func getCheckTargetHealthActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: 10 * time.Second,
+		StartToCloseTimeout:    20 * time.Second,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    2 * time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    10 * time.Second,
+			ExpirationInterval: 1 * time.Minute,
+			NonRetriableErrorReasons: []string{
+				errMsgTargetClusterUnhealthy,
+				errMsgDLQNotEmpty},
+		},
+	}
+}
+
 func getFailoverActivityOptions() workflow.ActivityOptions {
 	return workflow.ActivityOptions{
 		ScheduleToStartTimeout: 10 * time.Second,
@@ -478,6 +714,90 @@ func FailoverActivity(ctx context.Context, params *FailoverActivityParams) (*Fai
 	}, nil
 }
 
+// CheckReplicationLagActivity fails if any shard of the source cluster has replication tasks
+// which are older than the threshold and not yet replicated to the target cluster.
+func CheckReplicationLagActivity(ctx context.Context, params *CheckReplicationLagActivityParams) error {
+	manager := ctx.Value(failoverManagerContextKey).(*FailoverManager)
+	if currentCluster := manager.cfg.ClusterMetadata.GetCurrentClusterName(); currentCluster != params.SourceCluster {
+		// replication levels are only persisted by the source cluster
+		return cadence.NewCustomError(errMsgReplicationLagUnavailable, currentCluster)
+	}
+
+	startShardID := 0
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &startShardID); err != nil {
+			startShardID = 0
+		}
+	}
+	for shardID := startShardID; shardID < manager.cfg.NumberOfShards; shardID++ {
+		executionManager, err := manager.executionManagerProvider(shardID)
+		if err != nil {
+			return err
+		}
+		// the oldest pending task is enough to tell the lag
+		statuses, err := replication.GetShardStatus(
+			ctx,
+			manager.shardManager,
+			executionManager,
+			shardID,
+			[]string{params.TargetCluster},
+			1,
+			time.Now(),
+		)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			if status.Lag > params.MaxReplicationLag {
+				return cadence.NewCustomError(errMsgReplicationLagExceeded, ReplicationLagDetails{
+					ShardID: shardID,
+					Lag:     status.Lag,
+				})
+			}
+		}
+		activity.RecordHeartbeat(ctx, shardID+1)
+	}
+	return nil
+}
+
+// CheckTargetHealthActivity fails if the target cluster has no members for any of its services,
+// or if CheckDLQ is set and the target cluster has replication DLQ messages from the source cluster.
+func CheckTargetHealthActivity(ctx context.Context, params *CheckTargetHealthActivityParams) error {
+	manager := ctx.Value(failoverManagerContextKey).(*FailoverManager)
+	adminClient := manager.clientBean.GetRemoteAdminClient(params.TargetCluster)
+
+	clusterInfo, err := adminClient.DescribeCluster(ctx)
+	if err != nil {
+		return err
+	}
+	if clusterInfo.MembershipInfo == nil {
+		return cadence.NewCustomError(errMsgTargetClusterUnhealthy, "no membership info")
+	}
+	for _, ring := range clusterInfo.MembershipInfo.Rings {
+		if ring.MemberCount == 0 {
+			return cadence.NewCustomError(errMsgTargetClusterUnhealthy, fmt.Sprintf("no %v hosts", ring.Role))
+		}
+	}
+
+	if !params.CheckDLQ {
+		return nil
+	}
+	dlqCount, err := adminClient.CountDLQMessages(ctx, &types.CountDLQMessagesRequest{ForceFetch: true})
+	if err != nil {
+		return err
+	}
+	var messages int64
+	for key, count := range dlqCount.History {
+		if key.SourceCluster == params.SourceCluster {
+			messages += count
+		}
+	}
+	if messages > 0 || dlqCount.Domain > 0 {
+		return cadence.NewCustomError(errMsgDLQNotEmpty, fmt.Sprintf("%v history messages, %v domain messages", messages, dlqCount.Domain))
+	}
+	return nil
+}
+
 func cleanupChannel(channel workflow.Channel) {
 	for {
 		if hasValue := channel.ReceiveAsync(nil); !hasValue {
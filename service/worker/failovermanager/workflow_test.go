@@ -29,6 +29,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence"
 	"go.uber.org/cadence/activity"
 	"go.uber.org/cadence/testsuite"
 	"go.uber.org/cadence/worker"
@@ -36,6 +37,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/types"
 )
@@ -66,8 +68,12 @@ func (s *failoverWorkflowTestSuite) SetupTest() {
 	s.workflowEnv.RegisterWorkflowWithOptions(FailoverWorkflow, workflow.RegisterOptions{Name: FailoverWorkflowTypeName})
 	s.workflowEnv.RegisterActivityWithOptions(FailoverActivity, activity.RegisterOptions{Name: failoverActivityName})
 	s.workflowEnv.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(CheckReplicationLagActivity, activity.RegisterOptions{Name: checkReplicationLagActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(CheckTargetHealthActivity, activity.RegisterOptions{Name: checkTargetHealthActivityName})
 	s.activityEnv.RegisterActivityWithOptions(FailoverActivity, activity.RegisterOptions{Name: failoverActivityName})
 	s.activityEnv.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	s.activityEnv.RegisterActivityWithOptions(CheckReplicationLagActivity, activity.RegisterOptions{Name: checkReplicationLagActivityName})
+	s.activityEnv.RegisterActivityWithOptions(CheckTargetHealthActivity, activity.RegisterOptions{Name: checkTargetHealthActivityName})
 }
 
 func (s *failoverWorkflowTestSuite) TearDownTest() {
@@ -187,6 +193,163 @@ func (s *failoverWorkflowTestSuite) TestWorkflow_Success_Batches() {
 	s.Equal(mockFailoverActivityResult2.FailedDomains, result.FailedDomains)
 }
 
+func (s *failoverWorkflowTestSuite) TestWorkflow_ReplicationLagCheck() {
+	domains := []string{"d1"}
+	mockFailoverActivityResult := &FailoverActivityResult{
+		SuccessDomains: []string{"d1"},
+	}
+	s.workflowEnv.OnActivity(getDomainsActivityName, mock.Anything, mock.Anything).Return(domains, nil)
+	s.workflowEnv.OnActivity(checkReplicationLagActivityName, mock.Anything, &CheckReplicationLagActivityParams{
+		SourceCluster:     "s",
+		TargetCluster:     "t",
+		MaxReplicationLag: time.Minute,
+	}).Return(nil).Once()
+	s.workflowEnv.OnActivity(failoverActivityName, mock.Anything, mock.Anything).Return(mockFailoverActivityResult, nil)
+	params := &FailoverParams{
+		TargetCluster:     "t",
+		SourceCluster:     "s",
+		BatchFailoverSize: 10,
+		MaxReplicationLag: time.Minute,
+	}
+	s.workflowEnv.ExecuteWorkflow(FailoverWorkflowTypeName, params)
+	var result FailoverResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal(domains, result.SuccessDomains)
+}
+
+func (s *failoverWorkflowTestSuite) TestWorkflow_ReplicationLagExceeded() {
+	domains := []string{"d1"}
+	s.workflowEnv.OnActivity(getDomainsActivityName, mock.Anything, mock.Anything).Return(domains, nil)
+	s.workflowEnv.OnActivity(checkReplicationLagActivityName, mock.Anything, mock.Anything).
+		Return(cadence.NewCustomError(errMsgReplicationLagExceeded)).Once()
+	params := &FailoverParams{
+		TargetCluster:     "t",
+		SourceCluster:     "s",
+		BatchFailoverSize: 10,
+		MaxReplicationLag: time.Minute,
+	}
+	s.workflowEnv.ExecuteWorkflow(FailoverWorkflowTypeName, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	err := s.workflowEnv.GetWorkflowError()
+	s.Error(err)
+	s.Contains(err.Error(), errMsgReplicationLagExceeded)
+	s.assertQueryState(s.workflowEnv, WorkflowAborted)
+}
+
+func (s *failoverWorkflowTestSuite) TestWorkflow_DryRun() {
+	domains := []string{"d1", "d2", "d3"}
+	s.workflowEnv.OnActivity(getDomainsActivityName, mock.Anything, mock.Anything).Return(domains, nil)
+	s.workflowEnv.OnActivity(checkTargetHealthActivityName, mock.Anything, &CheckTargetHealthActivityParams{
+		SourceCluster: "s",
+		TargetCluster: "t",
+		CheckDLQ:      true,
+	}).Return(cadence.NewCustomError(errMsgDLQNotEmpty)).Once()
+	params := &FailoverParams{
+		TargetCluster:      "t",
+		SourceCluster:      "s",
+		BatchFailoverSize:  2,
+		CanaryDomains:      []string{"d3", "unknown"},
+		EnableHealthChecks: true,
+		DryRun:             true,
+	}
+	s.workflowEnv.ExecuteWorkflow(FailoverWorkflowTypeName, params)
+	var result FailoverResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal([][]string{{"d3"}, {"d1", "d2"}}, result.PlannedBatches)
+	s.Contains(result.AbortReason, errMsgDLQNotEmpty)
+	s.Empty(result.SuccessDomains)
+	s.Empty(result.FailedDomains)
+}
+
+func (s *failoverWorkflowTestSuite) TestWorkflow_CanaryAndRollback() {
+	domains := []string{"d1", "d2", "d3"}
+	s.workflowEnv.OnActivity(getDomainsActivityName, mock.Anything, mock.Anything).Return(domains, nil)
+	s.workflowEnv.OnActivity(checkTargetHealthActivityName, mock.Anything, &CheckTargetHealthActivityParams{
+		SourceCluster: "s",
+		TargetCluster: "t",
+		CheckDLQ:      true,
+	}).Return(nil).Once()
+	afterBatchCheck := &CheckTargetHealthActivityParams{
+		SourceCluster: "s",
+		TargetCluster: "t",
+	}
+	// after canary batch and after canary wait time
+	s.workflowEnv.OnActivity(checkTargetHealthActivityName, mock.Anything, afterBatchCheck).Return(nil).Times(2)
+	s.workflowEnv.OnActivity(checkTargetHealthActivityName, mock.Anything, afterBatchCheck).
+		Return(cadence.NewCustomError(errMsgTargetClusterUnhealthy)).Once()
+	s.workflowEnv.OnActivity(failoverActivityName, mock.Anything, &FailoverActivityParams{
+		Domains:       []string{"d1"},
+		TargetCluster: "t",
+	}).Return(&FailoverActivityResult{SuccessDomains: []string{"d1"}}, nil).Once()
+	s.workflowEnv.OnActivity(failoverActivityName, mock.Anything, &FailoverActivityParams{
+		Domains:       []string{"d2", "d3"},
+		TargetCluster: "t",
+	}).Return(&FailoverActivityResult{SuccessDomains: []string{"d2"}, FailedDomains: []string{"d3"}}, nil).Once()
+	s.workflowEnv.OnActivity(failoverActivityName, mock.Anything, &FailoverActivityParams{
+		Domains:       []string{"d1", "d2"},
+		TargetCluster: "s",
+	}).Return(&FailoverActivityResult{SuccessDomains: []string{"d1", "d2"}}, nil).Once()
+
+	params := &FailoverParams{
+		TargetCluster:          "t",
+		SourceCluster:          "s",
+		BatchFailoverSize:      10,
+		CanaryDomains:          []string{"d1"},
+		CanaryWaitTime:         time.Minute,
+		EnableHealthChecks:     true,
+		RollbackOnCheckFailure: true,
+	}
+	s.workflowEnv.ExecuteWorkflow(FailoverWorkflowTypeName, params)
+	result := s.getAbortedResult()
+	s.Equal([]string{"d1", "d2"}, result.SuccessDomains)
+	s.Equal([]string{"d3"}, result.FailedDomains)
+	s.Equal([]string{"d1", "d2"}, result.RolledBackDomains)
+	s.Empty(result.FailedRollbackDomains)
+	s.Contains(result.AbortReason, errMsgTargetClusterUnhealthy)
+	s.assertQueryState(s.workflowEnv, WorkflowAborted)
+
+	queryResult, err := s.workflowEnv.QueryWorkflow(QueryType)
+	s.NoError(err)
+	var res QueryResult
+	s.NoError(queryResult.Get(&res))
+	s.Equal([]string{"d1", "d2"}, res.RolledBackDomains)
+	s.Contains(res.AbortReason, errMsgTargetClusterUnhealthy)
+}
+
+func (s *failoverWorkflowTestSuite) TestWorkflow_BatchFailureRateExceeded() {
+	domains := []string{"d1", "d2", "d3"}
+	s.workflowEnv.OnActivity(getDomainsActivityName, mock.Anything, mock.Anything).Return(domains, nil)
+	s.workflowEnv.OnActivity(failoverActivityName, mock.Anything, &FailoverActivityParams{
+		Domains:       []string{"d1", "d2"},
+		TargetCluster: "t",
+	}).Return(&FailoverActivityResult{SuccessDomains: []string{"d1"}, FailedDomains: []string{"d2"}}, nil).Once()
+
+	params := &FailoverParams{
+		TargetCluster:       "t",
+		SourceCluster:       "s",
+		BatchFailoverSize:   2,
+		MaxBatchFailureRate: 0.1,
+	}
+	s.workflowEnv.ExecuteWorkflow(FailoverWorkflowTypeName, params)
+	result := s.getAbortedResult()
+	s.Equal([]string{"d1"}, result.SuccessDomains)
+	s.Equal([]string{"d2"}, result.FailedDomains)
+	s.Empty(result.RolledBackDomains)
+	s.Contains(result.AbortReason, errMsgBatchFailureRateExceeded)
+	s.assertQueryState(s.workflowEnv, WorkflowAborted)
+}
+
+func (s *failoverWorkflowTestSuite) getAbortedResult() *FailoverResult {
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	err := s.workflowEnv.GetWorkflowError()
+	var customErr *cadence.CustomError
+	s.True(errors.As(err, &customErr))
+	s.Equal(errMsgFailoverAborted, customErr.Reason())
+	var result FailoverResult
+	s.NoError(customErr.Details(&result))
+	return &result
+}
+
 func (s *failoverWorkflowTestSuite) TestWorkflow_Pause() {
 	domains := []string{"d1"}
 	mockFailoverActivityResult := &FailoverActivityResult{
@@ -577,6 +740,113 @@ func (s *failoverWorkflowTestSuite) TestFailoverActivity_NoPoller_Error() {
 	s.Equal([]string{"d1", "d2"}, result.FailedDomains)
 }
 
+func (s *failoverWorkflowTestSuite) TestCheckReplicationLagActivity() {
+	env, mockResource := s.prepareTestActivityEnv()
+	currentCluster := mockResource.ClusterMetadata.GetCurrentClusterName()
+	now := time.Now()
+
+	mockResource.ShardMgr.On("GetShard", mock.Anything, mock.Anything).Return(&persistence.GetShardResponse{
+		ShardInfo: &persistence.ShardInfo{ClusterReplicationLevel: map[string]int64{"t": 10}},
+	}, nil).Times(2)
+	mockResource.ExecutionMgr.On("GetReplicationTasks", mock.Anything, mock.Anything).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{{TaskID: 11, CreationTime: now.Add(-time.Second).UnixNano()}},
+	}, nil).Times(2)
+
+	_, err := env.ExecuteActivity(checkReplicationLagActivityName, &CheckReplicationLagActivityParams{
+		SourceCluster:     currentCluster,
+		TargetCluster:     "t",
+		MaxReplicationLag: time.Hour,
+	})
+	s.NoError(err)
+}
+
+func (s *failoverWorkflowTestSuite) TestCheckReplicationLagActivity_LagExceeded() {
+	env, mockResource := s.prepareTestActivityEnv()
+	currentCluster := mockResource.ClusterMetadata.GetCurrentClusterName()
+	now := time.Now()
+
+	mockResource.ShardMgr.On("GetShard", mock.Anything, mock.Anything).Return(&persistence.GetShardResponse{
+		ShardInfo: &persistence.ShardInfo{ClusterReplicationLevel: map[string]int64{"t": 10}},
+	}, nil).Once()
+	mockResource.ExecutionMgr.On("GetReplicationTasks", mock.Anything, mock.Anything).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{{TaskID: 11, CreationTime: now.Add(-time.Hour).UnixNano()}},
+	}, nil).Once()
+
+	_, err := env.ExecuteActivity(checkReplicationLagActivityName, &CheckReplicationLagActivityParams{
+		SourceCluster:     currentCluster,
+		TargetCluster:     "t",
+		MaxReplicationLag: time.Minute,
+	})
+	s.Error(err)
+	var customErr *cadence.CustomError
+	s.True(errors.As(err, &customErr))
+	s.Equal(errMsgReplicationLagExceeded, customErr.Reason())
+	var details ReplicationLagDetails
+	s.NoError(customErr.Details(&details))
+	s.Equal(0, details.ShardID)
+	s.True(details.Lag >= time.Hour)
+}
+
+func (s *failoverWorkflowTestSuite) TestCheckReplicationLagActivity_NotSourceCluster() {
+	env, _ := s.prepareTestActivityEnv()
+
+	_, err := env.ExecuteActivity(checkReplicationLagActivityName, &CheckReplicationLagActivityParams{
+		SourceCluster:     "some other cluster",
+		TargetCluster:     "t",
+		MaxReplicationLag: time.Minute,
+	})
+	s.Error(err)
+	var customErr *cadence.CustomError
+	s.True(errors.As(err, &customErr))
+	s.Equal(errMsgReplicationLagUnavailable, customErr.Reason())
+}
+
+func (s *failoverWorkflowTestSuite) TestCheckTargetHealthActivity() {
+	env, mockResource := s.prepareTestActivityEnv()
+	mockResource.RemoteAdminClient.EXPECT().DescribeCluster(gomock.Any()).Return(&types.DescribeClusterResponse{
+		MembershipInfo: &types.MembershipInfo{
+			Rings: []*types.RingInfo{{Role: "frontend", MemberCount: 1}, {Role: "history", MemberCount: 2}},
+		},
+	}, nil).Times(2)
+	mockResource.RemoteAdminClient.EXPECT().CountDLQMessages(gomock.Any(), gomock.Any()).Return(&types.CountDLQMessagesResponse{
+		History: map[types.HistoryDLQCountKey]int64{{ShardID: 1, SourceCluster: "other"}: 5},
+	}, nil).Times(1)
+
+	_, err := env.ExecuteActivity(checkTargetHealthActivityName, &CheckTargetHealthActivityParams{SourceCluster: "s", TargetCluster: "t"})
+	s.NoError(err)
+	_, err = env.ExecuteActivity(checkTargetHealthActivityName, &CheckTargetHealthActivityParams{SourceCluster: "s", TargetCluster: "t", CheckDLQ: true})
+	s.NoError(err)
+}
+
+func (s *failoverWorkflowTestSuite) TestCheckTargetHealthActivity_Unhealthy() {
+	env, mockResource := s.prepareTestActivityEnv()
+	mockResource.RemoteAdminClient.EXPECT().DescribeCluster(gomock.Any()).Return(&types.DescribeClusterResponse{
+		MembershipInfo: &types.MembershipInfo{
+			Rings: []*types.RingInfo{{Role: "frontend", MemberCount: 1}, {Role: "history", MemberCount: 0}},
+		},
+	}, nil).Times(1)
+
+	_, err := env.ExecuteActivity(checkTargetHealthActivityName, &CheckTargetHealthActivityParams{SourceCluster: "s", TargetCluster: "t"})
+	var customErr *cadence.CustomError
+	s.True(errors.As(err, &customErr))
+	s.Equal(errMsgTargetClusterUnhealthy, customErr.Reason())
+}
+
+func (s *failoverWorkflowTestSuite) TestCheckTargetHealthActivity_DLQNotEmpty() {
+	env, mockResource := s.prepareTestActivityEnv()
+	mockResource.RemoteAdminClient.EXPECT().DescribeCluster(gomock.Any()).Return(&types.DescribeClusterResponse{
+		MembershipInfo: &types.MembershipInfo{},
+	}, nil).Times(1)
+	mockResource.RemoteAdminClient.EXPECT().CountDLQMessages(gomock.Any(), gomock.Any()).Return(&types.CountDLQMessagesResponse{
+		History: map[types.HistoryDLQCountKey]int64{{ShardID: 1, SourceCluster: "s"}: 5},
+	}, nil).Times(1)
+
+	_, err := env.ExecuteActivity(checkTargetHealthActivityName, &CheckTargetHealthActivityParams{SourceCluster: "s", TargetCluster: "t", CheckDLQ: true})
+	var customErr *cadence.CustomError
+	s.True(errors.As(err, &customErr))
+	s.Equal(errMsgDLQNotEmpty, customErr.Reason())
+}
+
 func (s *failoverWorkflowTestSuite) TestGetOperator() {
 	operator := "testOperator"
 	s.workflowEnv.SetMemoOnStart(map[string]interface{}{
@@ -615,8 +885,14 @@ func (s *failoverWorkflowTestSuite) prepareTestActivityEnv() (*testsuite.TestAct
 	mockResource := resource.NewTest(s.T(), controller, metrics.Worker)
 
 	ctx := &FailoverManager{
-		svcClient:  mockResource.GetSDKClient(),
-		clientBean: mockResource.ClientBean,
+		cfg: Config{
+			ClusterMetadata: mockResource.ClusterMetadata,
+			NumberOfShards:  2,
+		},
+		svcClient:                mockResource.GetSDKClient(),
+		clientBean:               mockResource.ClientBean,
+		shardManager:             mockResource.ShardMgr,
+		executionManagerProvider: mockResource.GetExecutionManager,
 	}
 	s.activityEnv.SetTestTimeout(time.Second * 5)
 	s.activityEnv.SetWorkerOptions(worker.Options{
@@ -0,0 +1,161 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pborman/uuid"
+	"go.uber.org/cadence/activity"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const schedulerIdentity = "cadence-scheduler"
+
+type (
+	// StartWorkflowActivityParams params for the start workflow activity
+	StartWorkflowActivityParams struct {
+		ScheduleID  string
+		Action      StartWorkflowAction
+		WorkflowID  string
+		NominalTime time.Time
+	}
+
+	// RunsActivityParams params for the activities operating on open runs
+	RunsActivityParams struct {
+		Runs   []RunRef
+		Reason string
+	}
+)
+
+// StartWorkflowActivity starts one run of a schedule in the domain of the schedule. The request ID is derived from the
+// workflow ID so that retries of the activity do not start duplicate runs.
+func StartWorkflowActivity(ctx context.Context, params *StartWorkflowActivityParams) (*RunRef, error) {
+	memo, err := getRunMemo(params.ScheduleID, params.NominalTime)
+	if err != nil {
+		return nil, err
+	}
+	request := &types.StartWorkflowExecutionRequest{
+		Domain:                              activity.GetInfo(ctx).WorkflowDomain,
+		WorkflowID:                          params.WorkflowID,
+		WorkflowType:                        &types.WorkflowType{Name: params.Action.WorkflowType},
+		TaskList:                            &types.TaskList{Name: params.Action.TaskList},
+		Input:                               params.Action.Input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(params.Action.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(params.Action.TaskStartToCloseTimeoutSeconds),
+		Identity:                            schedulerIdentity,
+		RequestID:                           uuid.NewSHA1(uuid.NameSpace_OID, []byte(params.WorkflowID)).String(),
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyRejectDuplicate.Ptr(),
+		Memo:                                memo,
+	}
+	run := &RunRef{
+		WorkflowID:  params.WorkflowID,
+		NominalTime: params.NominalTime,
+		ActualTime:  time.Now(),
+	}
+	resp, err := getClient(ctx).StartWorkflowExecution(ctx, request)
+	if err != nil {
+		if alreadyStarted, ok := err.(*types.WorkflowExecutionAlreadyStartedError); ok {
+			run.RunID = alreadyStarted.RunID
+			return run, nil
+		}
+		return nil, err
+	}
+	run.RunID = resp.GetRunID()
+	return run, nil
+}
+
+// RefreshRunsActivity returns the runs which are still open
+func RefreshRunsActivity(ctx context.Context, params *RunsActivityParams) ([]RunRef, error) {
+	feClient := getClient(ctx)
+	var running []RunRef
+	for _, run := range params.Runs {
+		resp, err := feClient.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+			Domain: activity.GetInfo(ctx).WorkflowDomain,
+			Execution: &types.WorkflowExecution{
+				WorkflowID: run.WorkflowID,
+				RunID:      run.RunID,
+			},
+		})
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				continue
+			}
+			return nil, err
+		}
+		if info := resp.GetWorkflowExecutionInfo(); info == nil || info.CloseStatus == nil {
+			running = append(running, run)
+		}
+		activity.RecordHeartbeat(ctx, len(running))
+	}
+	return running, nil
+}
+
+// CancelRunsActivity requests cancellation of the given runs
+func CancelRunsActivity(ctx context.Context, params *RunsActivityParams) error {
+	feClient := getClient(ctx)
+	for _, run := range params.Runs {
+		err := feClient.RequestCancelWorkflowExecution(ctx, &types.RequestCancelWorkflowExecutionRequest{
+			Domain: activity.GetInfo(ctx).WorkflowDomain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: run.WorkflowID,
+				RunID:      run.RunID,
+			},
+			Identity:  schedulerIdentity,
+			RequestID: uuid.New(),
+			Cause:     params.Reason,
+		})
+		switch err.(type) {
+		case nil, *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyCompletedError:
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+func getRunMemo(scheduleID string, nominalTime time.Time) (*types.Memo, error) {
+	fields := map[string]interface{}{
+		MemoKeyScheduleID:  scheduleID,
+		MemoKeyNominalTime: nominalTime,
+	}
+	memo := &types.Memo{Fields: make(map[string][]byte, len(fields))}
+	for key, value := range fields {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		memo.Fields[key] = data
+	}
+	return memo, nil
+}
+
+func getClient(ctx context.Context) frontend.Client {
+	manager := ctx.Value(schedulerContextKey).(*Scheduler)
+	return manager.clientBean.GetFrontendClient()
+}
@@ -0,0 +1,216 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+// ToScheduleSpec converts the spec of a schedule API request
+func ToScheduleSpec(spec *types.ScheduleSpec) ScheduleSpec {
+	if spec == nil {
+		return ScheduleSpec{}
+	}
+	result := ScheduleSpec{
+		CronExpression: spec.CronExpression,
+		Interval:       secondsToDuration(spec.IntervalInSeconds),
+		StartTime:      unixNanoToTime(spec.StartTimestamp),
+		EndTime:        unixNanoToTime(spec.EndTimestamp),
+		Jitter:         secondsToDuration(spec.JitterInSeconds),
+	}
+	for _, calendar := range spec.Calendars {
+		if calendar == nil {
+			continue
+		}
+		result.Calendars = append(result.Calendars, CalendarSpec{
+			Second:     calendar.Second,
+			Minute:     calendar.Minute,
+			Hour:       calendar.Hour,
+			DayOfMonth: calendar.DayOfMonth,
+			Month:      calendar.Month,
+			DayOfWeek:  calendar.DayOfWeek,
+		})
+	}
+	return result
+}
+
+// FromScheduleSpec converts a spec to its API representation
+func FromScheduleSpec(spec ScheduleSpec) *types.ScheduleSpec {
+	result := &types.ScheduleSpec{
+		CronExpression:    spec.CronExpression,
+		IntervalInSeconds: durationToSeconds(spec.Interval),
+		StartTimestamp:    timeToUnixNano(spec.StartTime),
+		EndTimestamp:      timeToUnixNano(spec.EndTime),
+		JitterInSeconds:   durationToSeconds(spec.Jitter),
+	}
+	for _, calendar := range spec.Calendars {
+		result.Calendars = append(result.Calendars, &types.ScheduleCalendarSpec{
+			Second:     calendar.Second,
+			Minute:     calendar.Minute,
+			Hour:       calendar.Hour,
+			DayOfMonth: calendar.DayOfMonth,
+			Month:      calendar.Month,
+			DayOfWeek:  calendar.DayOfWeek,
+		})
+	}
+	return result
+}
+
+// ToStartWorkflowAction converts the action of a schedule API request
+func ToStartWorkflowAction(action *types.ScheduleStartWorkflowAction) StartWorkflowAction {
+	if action == nil {
+		return StartWorkflowAction{}
+	}
+	return StartWorkflowAction{
+		WorkflowType:                        action.GetWorkflowType().GetName(),
+		TaskList:                            action.GetTaskList().GetName(),
+		WorkflowIDPrefix:                    action.WorkflowIDPrefix,
+		Input:                               action.Input,
+		ExecutionStartToCloseTimeoutSeconds: action.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      action.GetTaskStartToCloseTimeoutSeconds(),
+	}
+}
+
+// FromStartWorkflowAction converts an action to its API representation
+func FromStartWorkflowAction(action StartWorkflowAction) *types.ScheduleStartWorkflowAction {
+	return &types.ScheduleStartWorkflowAction{
+		WorkflowType:                        &types.WorkflowType{Name: action.WorkflowType},
+		TaskList:                            &types.TaskList{Name: action.TaskList},
+		WorkflowIDPrefix:                    action.WorkflowIDPrefix,
+		Input:                               action.Input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(action.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(action.TaskStartToCloseTimeoutSeconds),
+	}
+}
+
+// ToSchedulePolicies converts the policies of a schedule API request
+func ToSchedulePolicies(policies *types.SchedulePolicies) SchedulePolicies {
+	if policies == nil {
+		return SchedulePolicies{}
+	}
+	return SchedulePolicies{
+		OverlapPolicy: ToOverlapPolicy(policies.OverlapPolicy),
+		CatchUpWindow: secondsToDuration(policies.CatchUpWindowInSeconds),
+		BufferLimit:   int(policies.BufferLimit),
+	}
+}
+
+// FromSchedulePolicies converts policies to their API representation
+func FromSchedulePolicies(policies SchedulePolicies) *types.SchedulePolicies {
+	return &types.SchedulePolicies{
+		OverlapPolicy:          FromOverlapPolicy(policies.OverlapPolicy),
+		CatchUpWindowInSeconds: durationToSeconds(policies.CatchUpWindow),
+		BufferLimit:            int32(policies.BufferLimit),
+	}
+}
+
+// ToOverlapPolicy converts an API overlap policy, nil defaults to OverlapPolicySkip
+func ToOverlapPolicy(policy *types.ScheduleOverlapPolicy) OverlapPolicy {
+	if policy == nil {
+		return OverlapPolicySkip
+	}
+	return OverlapPolicy(*policy)
+}
+
+// FromOverlapPolicy converts an overlap policy to its API representation
+func FromOverlapPolicy(policy OverlapPolicy) *types.ScheduleOverlapPolicy {
+	return types.ScheduleOverlapPolicy(policy).Ptr()
+}
+
+// FromDescribeResult converts the result of QueryTypeDescribe to its API representation
+func FromDescribeResult(result *DescribeResult) *types.DescribeScheduleResponse {
+	if result == nil {
+		return nil
+	}
+	state := result.State
+	response := &types.DescribeScheduleResponse{
+		Spec:     FromScheduleSpec(result.Spec),
+		Action:   FromStartWorkflowAction(result.Action),
+		Policies: FromSchedulePolicies(result.Policies),
+		State: &types.ScheduleState{
+			Paused:                 state.Paused,
+			Note:                   state.Note,
+			LastProcessedTimestamp: timeToUnixNano(state.LastProcessedTime),
+			LastRun:                fromRunRef(state.LastRun),
+			BufferedRuns:           int32(len(state.Buffered)),
+			PendingBackfills:       int32(len(state.Backfills)),
+			TotalRuns:              state.TotalRuns,
+			SkippedRuns:            state.SkippedRuns,
+			MissedRuns:             state.MissedRuns,
+			FailedStarts:           state.FailedStarts,
+			BufferOverruns:         state.BufferOverruns,
+		},
+	}
+	for i := range state.Running {
+		response.State.RunningRuns = append(response.State.RunningRuns, fromRunRef(&state.Running[i]))
+	}
+	for _, upcoming := range result.UpcomingRuns {
+		response.UpcomingRunTimestamps = append(response.UpcomingRunTimestamps, upcoming.UnixNano())
+	}
+	return response
+}
+
+func fromRunRef(run *RunRef) *types.ScheduleRun {
+	if run == nil {
+		return nil
+	}
+	return &types.ScheduleRun{
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: run.WorkflowID,
+			RunID:      run.RunID,
+		},
+		NominalTimestamp: timeToUnixNano(run.NominalTime),
+		ActualTimestamp:  timeToUnixNano(run.ActualTime),
+	}
+}
+
+func secondsToDuration(seconds *int32) time.Duration {
+	if seconds == nil {
+		return 0
+	}
+	return time.Duration(*seconds) * time.Second
+}
+
+func durationToSeconds(d time.Duration) *int32 {
+	if d == 0 {
+		return nil
+	}
+	return common.Int32Ptr(int32(d / time.Second))
+}
+
+func unixNanoToTime(timestamp *int64) time.Time {
+	if timestamp == nil || *timestamp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, *timestamp).UTC()
+}
+
+func timeToUnixNano(t time.Time) *int64 {
+	if t.IsZero() {
+		return nil
+	}
+	return common.Int64Ptr(t.UnixNano())
+}
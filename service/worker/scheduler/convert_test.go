@@ -0,0 +1,87 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestConvert_RoundTrip(t *testing.T) {
+	spec := ScheduleSpec{
+		CronExpression: "0 * * * *",
+		Calendars:      []CalendarSpec{{Hour: "1", DayOfWeek: "MON"}},
+		Interval:       time.Hour,
+		StartTime:      time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		Jitter:         time.Minute,
+	}
+	assert.Equal(t, spec, ToScheduleSpec(FromScheduleSpec(spec)))
+
+	action := StartWorkflowAction{
+		WorkflowType:                        "wt",
+		TaskList:                            "tl",
+		WorkflowIDPrefix:                    "prefix",
+		Input:                               []byte("input"),
+		ExecutionStartToCloseTimeoutSeconds: 60,
+		TaskStartToCloseTimeoutSeconds:      10,
+	}
+	assert.Equal(t, action, ToStartWorkflowAction(FromStartWorkflowAction(action)))
+
+	policies := SchedulePolicies{
+		OverlapPolicy: OverlapPolicyCancelOther,
+		CatchUpWindow: time.Minute,
+		BufferLimit:   3,
+	}
+	assert.Equal(t, policies, ToSchedulePolicies(FromSchedulePolicies(policies)))
+	assert.Equal(t, types.ScheduleOverlapPolicyCancelOther, *FromOverlapPolicy(OverlapPolicyCancelOther))
+	assert.Equal(t, OverlapPolicySkip, ToOverlapPolicy(nil))
+}
+
+func TestFromDescribeResult(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	run := RunRef{WorkflowID: "wid", RunID: "rid", NominalTime: now, ActualTime: now.Add(time.Second)}
+	result := FromDescribeResult(&DescribeResult{
+		ScheduleID: "sid",
+		Spec:       ScheduleSpec{Interval: time.Hour},
+		State: ScheduleState{
+			Paused:    true,
+			Running:   []RunRef{run},
+			LastRun:   &run,
+			Buffered:  []BufferedRun{{WorkflowID: "buffered"}},
+			TotalRuns: 2,
+		},
+		UpcomingRuns: []time.Time{now.Add(time.Hour)},
+	})
+	assert.Equal(t, int32(3600), result.Spec.GetIntervalInSeconds())
+	assert.True(t, result.State.Paused)
+	assert.Equal(t, int32(1), result.State.BufferedRuns)
+	assert.Equal(t, int64(2), result.State.TotalRuns)
+	assert.Len(t, result.State.RunningRuns, 1)
+	assert.Equal(t, "rid", result.State.LastRun.WorkflowExecution.RunID)
+	assert.Equal(t, now.UnixNano(), *result.State.LastRun.NominalTimestamp)
+	assert.Equal(t, []int64{now.Add(time.Hour).UnixNano()}, result.UpcomingRunTimestamps)
+}
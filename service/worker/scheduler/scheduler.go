@@ -0,0 +1,196 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const (
+	defaultRefreshInterval = time.Minute
+	defaultShutdownTimeout = 5 * time.Second
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap the scheduler
+	BootstrapParams struct {
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// ClientBean is an instance of client.Bean for a collection of clients
+		ClientBean client.Bean
+		// DomainCache is used to find the domains to run scheduler workflows for
+		DomainCache cache.DomainCache
+		// ClusterName is the name of the current cluster
+		ClusterName string
+		// EnabledForDomain decides whether schedules are enabled for a domain
+		EnabledForDomain dynamicconfig.BoolPropertyFnWithDomainFilter
+	}
+
+	// Scheduler runs the scheduler workflows of the domains which have schedules enabled.
+	// Scheduler workflows run in the domain of their schedule, so one worker is started
+	// for each such domain which is active in the current cluster.
+	Scheduler struct {
+		svcClient        workflowserviceclient.Interface
+		clientBean       client.Bean
+		metricsClient    metrics.Client
+		tallyScope       tally.Scope
+		logger           log.Logger
+		domainCache      cache.DomainCache
+		clusterName      string
+		enabledForDomain dynamicconfig.BoolPropertyFnWithDomainFilter
+		refreshInterval  time.Duration
+		ctx              context.Context
+		cancelFn         context.CancelFunc
+		wg               sync.WaitGroup
+		// workers are the running workers keyed by domain name, only accessed by the refresh loop
+		workers map[string]worker.Worker
+	}
+)
+
+// New returns a new instance of Scheduler
+func New(params *BootstrapParams) *Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Scheduler{
+		svcClient:        params.ServiceClient,
+		metricsClient:    params.MetricsClient,
+		tallyScope:       params.TallyScope,
+		logger:           params.Logger.WithTags(tag.ComponentScheduler),
+		clientBean:       params.ClientBean,
+		domainCache:      params.DomainCache,
+		clusterName:      params.ClusterName,
+		enabledForDomain: params.EnabledForDomain,
+		refreshInterval:  defaultRefreshInterval,
+		ctx:              ctx,
+		cancelFn:         cancel,
+		workers:          make(map[string]worker.Worker),
+	}
+}
+
+// Start starts the loop which keeps the per domain workers in sync with the domains
+func (s *Scheduler) Start() error {
+	s.wg.Add(1)
+	go s.run()
+	return nil
+}
+
+// Stop stops the refresh loop and all the workers
+func (s *Scheduler) Stop() {
+	s.cancelFn()
+	if !common.AwaitWaitGroup(&s.wg, defaultShutdownTimeout) {
+		s.logger.Warn("scheduler timed out on shutdown")
+		return
+	}
+	for domain, w := range s.workers {
+		w.Stop()
+		delete(s.workers, domain)
+	}
+}
+
+func (s *Scheduler) run() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.refreshInterval)
+	defer ticker.Stop()
+
+	s.refreshWorkers()
+	for {
+		select {
+		case <-ticker.C:
+			s.refreshWorkers()
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// refreshWorkers starts a worker for every domain which should run scheduler workflows
+// and stops the workers of the domains which no longer should
+func (s *Scheduler) refreshWorkers() {
+	wanted := make(map[string]struct{})
+	for _, entry := range s.domainCache.GetAllDomain() {
+		domain := entry.GetInfo().Name
+		if entry.IsDeprecatedOrDeleted() || !s.enabledForDomain(domain) {
+			continue
+		}
+		if active, err := entry.IsActiveIn(s.clusterName); err != nil || !active {
+			continue
+		}
+		wanted[domain] = struct{}{}
+		if _, ok := s.workers[domain]; ok {
+			continue
+		}
+		w := s.newWorker(domain)
+		if err := w.Start(); err != nil {
+			s.logger.Error("failed to start scheduler worker", tag.WorkflowDomainName(domain), tag.Error(err))
+			continue
+		}
+		s.logger.Info("started scheduler worker", tag.WorkflowDomainName(domain))
+		s.workers[domain] = w
+	}
+
+	for domain, w := range s.workers {
+		if _, ok := wanted[domain]; ok {
+			continue
+		}
+		w.Stop()
+		delete(s.workers, domain)
+		s.logger.Info("stopped scheduler worker", tag.WorkflowDomainName(domain))
+	}
+}
+
+func (s *Scheduler) newWorker(domain string) worker.Worker {
+	ctx := context.WithValue(context.Background(), schedulerContextKey, s)
+	workerOpts := worker.Options{
+		MetricsScope:              s.tallyScope.Tagged(map[string]string{"domain": domain}),
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	schedulerWorker := worker.New(s.svcClient, domain, TaskListName, workerOpts)
+	schedulerWorker.RegisterWorkflowWithOptions(ScheduleWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	schedulerWorker.RegisterActivityWithOptions(StartWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+	schedulerWorker.RegisterActivityWithOptions(RefreshRunsActivity, activity.RegisterOptions{Name: refreshRunsActivityName})
+	schedulerWorker.RegisterActivityWithOptions(CancelRunsActivity, activity.RegisterOptions{Name: cancelRunsActivityName})
+	return schedulerWorker
+}
@@ -0,0 +1,213 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron"
+)
+
+type (
+	// ScheduleSpec describes when a schedule fires. A schedule fires at the union of the times
+	// matched by CronExpression, Calendars and Interval, bounded by StartTime and EndTime.
+	ScheduleSpec struct {
+		// CronExpression is a standard five field cron expression
+		CronExpression string
+		// Calendars are calendar based specs, each field accepts the cron syntax for that field
+		Calendars []CalendarSpec
+		// Interval fires the schedule every Interval, aligned to StartTime (or the unix epoch)
+		Interval time.Duration
+		// StartTime is the earliest time the schedule may fire, zero means no lower bound
+		StartTime time.Time
+		// EndTime is the latest time the schedule may fire, zero means no upper bound
+		EndTime time.Time
+		// Jitter delays every fire time by a deterministic random amount in [0, Jitter)
+		Jitter time.Duration
+	}
+
+	// CalendarSpec matches calendar times. Empty fields match everything, except Second
+	// and Minute which default to zero.
+	CalendarSpec struct {
+		Second     string
+		Minute     string
+		Hour       string
+		DayOfMonth string
+		Month      string
+		DayOfWeek  string
+	}
+
+	// compiledSpec is a ScheduleSpec with all expressions parsed
+	compiledSpec struct {
+		spec      ScheduleSpec
+		schedules []cron.Schedule
+	}
+)
+
+var (
+	calendarParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+	errEmptySpec       = errors.New("schedule spec must have at least one of cron expression, calendar or interval")
+	errNegativeJitter  = errors.New("schedule jitter must not be negative")
+	errInvalidInterval = errors.New("schedule interval must be at least one second")
+	errInvalidBounds   = errors.New("schedule end time must be after start time")
+)
+
+// Validate checks that the spec is well formed
+func (s *ScheduleSpec) Validate() error {
+	_, err := compileSpec(*s)
+	return err
+}
+
+// String returns the calendar spec in six field cron syntax
+func (c CalendarSpec) String() string {
+	return strings.Join([]string{
+		defaultField(c.Second, "0"),
+		defaultField(c.Minute, "0"),
+		defaultField(c.Hour, "*"),
+		defaultField(c.DayOfMonth, "*"),
+		defaultField(c.Month, "*"),
+		defaultField(c.DayOfWeek, "*"),
+	}, " ")
+}
+
+func compileSpec(spec ScheduleSpec) (*compiledSpec, error) {
+	if spec.CronExpression == "" && len(spec.Calendars) == 0 && spec.Interval == 0 {
+		return nil, errEmptySpec
+	}
+	if spec.Jitter < 0 {
+		return nil, errNegativeJitter
+	}
+	if spec.Interval != 0 && spec.Interval < time.Second {
+		return nil, errInvalidInterval
+	}
+	if !spec.StartTime.IsZero() && !spec.EndTime.IsZero() && !spec.EndTime.After(spec.StartTime) {
+		return nil, errInvalidBounds
+	}
+
+	compiled := &compiledSpec{spec: spec}
+	if spec.CronExpression != "" {
+		schedule, err := cron.ParseStandard(spec.CronExpression)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %v", spec.CronExpression, err)
+		}
+		compiled.schedules = append(compiled.schedules, schedule)
+	}
+	for _, calendar := range spec.Calendars {
+		schedule, err := calendarParser.Parse(calendar.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid calendar spec %q: %v", calendar.String(), err)
+		}
+		compiled.schedules = append(compiled.schedules, schedule)
+	}
+	return compiled, nil
+}
+
+// next returns the first nominal fire time strictly after the given time, or the zero time if the
+// schedule will not fire again
+func (c *compiledSpec) next(after time.Time) time.Time {
+	after = after.UTC()
+	if !c.spec.StartTime.IsZero() && after.Before(c.spec.StartTime) {
+		// fire times are strictly after the given time, so step back to include StartTime itself
+		after = c.spec.StartTime.UTC().Add(-time.Second)
+	}
+
+	var result time.Time
+	for _, schedule := range c.schedules {
+		candidate := schedule.Next(after)
+		if candidate.IsZero() {
+			continue
+		}
+		if result.IsZero() || candidate.Before(result) {
+			result = candidate
+		}
+	}
+	if c.spec.Interval > 0 {
+		candidate := c.nextInterval(after)
+		if result.IsZero() || candidate.Before(result) {
+			result = candidate
+		}
+	}
+
+	if result.IsZero() || (!c.spec.EndTime.IsZero() && result.After(c.spec.EndTime)) {
+		return time.Time{}
+	}
+	return result
+}
+
+func (c *compiledSpec) nextInterval(after time.Time) time.Time {
+	anchor := c.spec.StartTime.UTC()
+	if anchor.IsZero() {
+		anchor = time.Unix(0, 0).UTC()
+	}
+	if after.Before(anchor) {
+		return anchor
+	}
+	periods := after.Sub(anchor)/c.spec.Interval + 1
+	return anchor.Add(periods * c.spec.Interval)
+}
+
+// between returns the nominal fire times in (start, end], at most limit of them
+func (c *compiledSpec) between(start, end time.Time, limit int) []time.Time {
+	var result []time.Time
+	for t := c.next(start); !t.IsZero() && !t.After(end); t = c.next(t) {
+		if len(result) >= limit {
+			break
+		}
+		result = append(result, t)
+	}
+	return result
+}
+
+// upcoming returns the next count nominal fire times after the given time
+func (c *compiledSpec) upcoming(after time.Time, count int) []time.Time {
+	var result []time.Time
+	for t := c.next(after); !t.IsZero() && len(result) < count; t = c.next(t) {
+		result = append(result, t)
+	}
+	return result
+}
+
+// jitter returns the deterministic delay applied to the given nominal fire time. It must be
+// deterministic since it is computed inside the scheduler workflow.
+func (c *compiledSpec) jitter(scheduleID string, nominal time.Time) time.Duration {
+	if c.spec.Jitter <= 0 {
+		return 0
+	}
+	h := fnv.New64a()
+	h.Write([]byte(scheduleID))
+	h.Write([]byte(nominal.UTC().Format(time.RFC3339)))
+	return time.Duration(h.Sum64() % uint64(c.spec.Jitter))
+}
+
+func defaultField(value, defaultValue string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,122 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileSpec_Invalid(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]ScheduleSpec{
+		"empty":           {},
+		"bad cron":        {CronExpression: "not a cron"},
+		"bad calendar":    {Calendars: []CalendarSpec{{Hour: "25"}}},
+		"negative jitter": {Interval: time.Minute, Jitter: -time.Second},
+		"short interval":  {Interval: time.Millisecond},
+		"inverted bounds": {Interval: time.Minute, StartTime: start, EndTime: start.Add(-time.Hour)},
+	}
+	for name, spec := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, spec.Validate())
+		})
+	}
+}
+
+func TestCompiledSpec_Next(t *testing.T) {
+	base := time.Date(2023, 1, 2, 10, 30, 0, 0, time.UTC) // a Monday
+	tests := map[string]struct {
+		spec     ScheduleSpec
+		after    time.Time
+		expected time.Time
+	}{
+		"cron": {
+			spec:     ScheduleSpec{CronExpression: "0 * * * *"},
+			after:    base,
+			expected: time.Date(2023, 1, 2, 11, 0, 0, 0, time.UTC),
+		},
+		"calendar": {
+			spec:     ScheduleSpec{Calendars: []CalendarSpec{{Hour: "9", DayOfWeek: "TUE"}}},
+			after:    base,
+			expected: time.Date(2023, 1, 3, 9, 0, 0, 0, time.UTC),
+		},
+		"interval aligned to start time": {
+			spec:     ScheduleSpec{Interval: 45 * time.Minute, StartTime: base.Add(-time.Hour)},
+			after:    base,
+			expected: base.Add(30 * time.Minute),
+		},
+		"earliest of union": {
+			spec: ScheduleSpec{
+				CronExpression: "0 12 * * *",
+				Calendars:      []CalendarSpec{{Minute: "45", Hour: "10"}},
+			},
+			after:    base,
+			expected: time.Date(2023, 1, 2, 10, 45, 0, 0, time.UTC),
+		},
+		"start time is inclusive": {
+			spec:     ScheduleSpec{CronExpression: "0 * * * *", StartTime: base.Add(90 * time.Minute)},
+			after:    base,
+			expected: base.Add(90 * time.Minute),
+		},
+		"past end time": {
+			spec:     ScheduleSpec{CronExpression: "0 * * * *", EndTime: base.Add(10 * time.Minute)},
+			after:    base,
+			expected: time.Time{},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			compiled, err := compileSpec(test.spec)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, compiled.next(test.after))
+		})
+	}
+}
+
+func TestCompiledSpec_BetweenAndUpcoming(t *testing.T) {
+	base := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	compiled, err := compileSpec(ScheduleSpec{Interval: time.Hour})
+	require.NoError(t, err)
+
+	times := compiled.between(base, base.Add(3*time.Hour), 10)
+	assert.Equal(t, []time.Time{base.Add(time.Hour), base.Add(2 * time.Hour), base.Add(3 * time.Hour)}, times)
+	assert.Len(t, compiled.between(base, base.Add(3*time.Hour), 2), 2)
+	assert.Len(t, compiled.upcoming(base, 5), 5)
+}
+
+func TestCompiledSpec_Jitter(t *testing.T) {
+	nominal := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	compiled, err := compileSpec(ScheduleSpec{Interval: time.Hour})
+	require.NoError(t, err)
+	assert.Zero(t, compiled.jitter("id", nominal))
+
+	compiled, err = compileSpec(ScheduleSpec{Interval: time.Hour, Jitter: time.Minute})
+	require.NoError(t, err)
+	jitter := compiled.jitter("id", nominal)
+	assert.True(t, jitter >= 0 && jitter < time.Minute)
+	assert.Equal(t, jitter, compiled.jitter("id", nominal))
+}
@@ -0,0 +1,702 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+type (
+	contextKey string
+
+	// OverlapPolicy decides what happens when a schedule fires while a previous run is still open
+	OverlapPolicy int32
+)
+
+const (
+	schedulerContextKey contextKey = "schedulerContext"
+	// TaskListName tasklist
+	TaskListName = "cadence-sys-scheduler-tasklist"
+	// WorkflowTypeName workflow type name
+	WorkflowTypeName = "cadence-sys-scheduler-workflow"
+	// WorkflowIDPrefix is the prefix of all scheduler workflow IDs
+	WorkflowIDPrefix = "cadence-scheduler"
+	// WorkflowStartToCloseTimeoutInSeconds is the run timeout of scheduler workflows, which continue as new long before it
+	WorkflowStartToCloseTimeoutInSeconds = 10 * 365 * 24 * 60 * 60
+	// DecisionTimeoutInSeconds is the decision timeout of scheduler workflows
+	DecisionTimeoutInSeconds = 10
+
+	startWorkflowActivityName = "cadence-sys-scheduler-startWorkflow-activity"
+	refreshRunsActivityName   = "cadence-sys-scheduler-refreshRuns-activity"
+	cancelRunsActivityName    = "cadence-sys-scheduler-cancelRuns-activity"
+
+	// QueryTypeDescribe query type for describing a schedule
+	QueryTypeDescribe = "describe"
+	// PauseSignal signal name for pause, takes a PauseRequest
+	PauseSignal = "pause"
+	// UnpauseSignal signal name for unpause, takes a PauseRequest
+	UnpauseSignal = "unpause"
+	// UpdateSignal signal name for update, takes an UpdateRequest
+	UpdateSignal = "update"
+	// TriggerSignal signal name for an immediate run, takes a TriggerRequest
+	TriggerSignal = "trigger"
+	// BackfillSignal signal name for backfill, takes a BackfillRequest
+	BackfillSignal = "backfill"
+	// DeleteSignal signal name for delete
+	DeleteSignal = "delete"
+
+	// MemoKeyScheduleID is the memo key of the schedule ID on started workflows
+	MemoKeyScheduleID = "CadenceScheduleID"
+	// MemoKeyNominalTime is the memo key of the nominal fire time on started workflows
+	MemoKeyNominalTime = "CadenceScheduleNominalTime"
+
+	// MaxBackfillRuns is the maximum number of runs a single backfill request may start
+	MaxBackfillRuns = 1000
+
+	defaultCatchUpWindow             = time.Minute
+	defaultBufferLimit               = 100
+	maxIterationsBeforeContinueAsNew = 100
+	maxActivitiesBeforeContinueAsNew = 500
+	backfillBatchSize                = 10
+	runningRefreshInterval           = time.Minute
+	numUpcomingRuns                  = 5
+
+	errMsgParamsIsNil       = "params is nil"
+	errMsgScheduleIDIsEmpty = "scheduleID is empty"
+	errMsgWorkflowTypeEmpty = "action workflow type is empty"
+	errMsgTaskListIsEmpty   = "action tasklist is empty"
+)
+
+const (
+	// OverlapPolicySkip does not start a new run while a previous run is open
+	OverlapPolicySkip OverlapPolicy = iota
+	// OverlapPolicyBuffer starts the new run once the previous runs have closed
+	OverlapPolicyBuffer
+	// OverlapPolicyCancelOther requests cancellation of the open runs and starts the new run
+	OverlapPolicyCancelOther
+	// OverlapPolicyAllowAll starts the new run regardless of open runs
+	OverlapPolicyAllowAll
+)
+
+type (
+	// StartWorkflowAction is the workflow started every time the schedule fires
+	StartWorkflowAction struct {
+		WorkflowType string
+		TaskList     string
+		// WorkflowIDPrefix is suffixed with the nominal fire time, or with the trigger time and
+		// sequence number for triggered runs. It defaults to the schedule ID.
+		WorkflowIDPrefix                    string
+		Input                               []byte
+		ExecutionStartToCloseTimeoutSeconds int32
+		TaskStartToCloseTimeoutSeconds      int32
+	}
+
+	// SchedulePolicies controls how the schedule handles overlapping and missed runs
+	SchedulePolicies struct {
+		OverlapPolicy OverlapPolicy
+		// CatchUpWindow is how late a run may start, e.g. after the scheduler was unavailable
+		CatchUpWindow time.Duration
+		// BufferLimit caps the number of runs buffered by OverlapPolicyBuffer
+		BufferLimit int
+	}
+
+	// RunRef identifies a workflow run started by the schedule
+	RunRef struct {
+		WorkflowID  string
+		RunID       string
+		NominalTime time.Time
+		ActualTime  time.Time
+	}
+
+	// BufferedRun is a run waiting for the open runs to close, see OverlapPolicyBuffer
+	BufferedRun struct {
+		WorkflowID  string
+		NominalTime time.Time
+	}
+
+	// BackfillProgress is a backfill request which has not started all of its runs yet
+	BackfillProgress struct {
+		// LastProcessedTime is the last nominal time of the backfill which has been processed
+		LastProcessedTime time.Time
+		EndTime           time.Time
+		OverlapPolicy     *OverlapPolicy
+		// RemainingRuns is the number of runs the backfill may still start
+		RemainingRuns int
+	}
+
+	// ScheduleState is the mutable state of a schedule, carried over on continue as new
+	ScheduleState struct {
+		Paused bool
+		// Note is the reason given for the last pause or unpause
+		Note string
+		// LastProcessedTime is the last nominal time the schedule has processed
+		LastProcessedTime time.Time
+		Running           []RunRef
+		Buffered          []BufferedRun
+		Backfills         []BackfillProgress
+		LastRun           *RunRef
+		TotalRuns         int64
+		TriggeredRuns     int64
+		SkippedRuns       int64
+		MissedRuns        int64
+		FailedStarts      int64
+		BufferOverruns    int64
+	}
+
+	// WorkflowParams is the arg for ScheduleWorkflow
+	WorkflowParams struct {
+		ScheduleID string
+		Spec       ScheduleSpec
+		Action     StartWorkflowAction
+		Policies   SchedulePolicies
+		State      ScheduleState
+	}
+
+	// PauseRequest is the payload of PauseSignal and UnpauseSignal
+	PauseRequest struct {
+		Reason string
+	}
+
+	// UpdateRequest is the payload of UpdateSignal, nil fields are left unchanged
+	UpdateRequest struct {
+		Spec     *ScheduleSpec
+		Action   *StartWorkflowAction
+		Policies *SchedulePolicies
+	}
+
+	// TriggerRequest is the payload of TriggerSignal
+	TriggerRequest struct {
+		// OverlapPolicy overrides the schedule's overlap policy for this run
+		OverlapPolicy *OverlapPolicy
+	}
+
+	// BackfillRequest is the payload of BackfillSignal, it starts the runs the schedule
+	// would have started in (StartTime, EndTime], at most MaxBackfillRuns of them
+	BackfillRequest struct {
+		StartTime time.Time
+		EndTime   time.Time
+		// OverlapPolicy overrides the schedule's overlap policy for the backfilled runs
+		OverlapPolicy *OverlapPolicy
+	}
+
+	// DescribeResult is the result of QueryTypeDescribe
+	DescribeResult struct {
+		ScheduleID   string
+		Spec         ScheduleSpec
+		Action       StartWorkflowAction
+		Policies     SchedulePolicies
+		State        ScheduleState
+		UpcomingRuns []time.Time
+	}
+
+	overlapAction int
+
+	scheduler struct {
+		ctx      workflow.Context
+		params   *WorkflowParams
+		compiled *compiledSpec
+		deleted  bool
+		logger   *zap.Logger
+		// activities is the number of activities executed by this run, which bounds its history size
+		activities int
+	}
+)
+
+const (
+	overlapActionStart overlapAction = iota
+	overlapActionSkip
+	overlapActionBuffer
+	overlapActionCancelAndStart
+)
+
+var overlapPolicyNames = map[OverlapPolicy]string{
+	OverlapPolicySkip:        "skip",
+	OverlapPolicyBuffer:      "buffer",
+	OverlapPolicyCancelOther: "cancel-other",
+	OverlapPolicyAllowAll:    "allow-all",
+}
+
+// String returns the name of the overlap policy
+func (p OverlapPolicy) String() string {
+	if name, ok := overlapPolicyNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("OverlapPolicy(%d)", int32(p))
+}
+
+// Ptr returns a pointer to the overlap policy
+func (p OverlapPolicy) Ptr() *OverlapPolicy {
+	return &p
+}
+
+// ParseOverlapPolicy parses an overlap policy from its name
+func ParseOverlapPolicy(name string) (OverlapPolicy, error) {
+	for policy, policyName := range overlapPolicyNames {
+		if strings.EqualFold(policyName, strings.TrimSpace(name)) {
+			return policy, nil
+		}
+	}
+	return OverlapPolicySkip, fmt.Errorf("unknown overlap policy %q", name)
+}
+
+// GetWorkflowID returns the ID of the scheduler workflow of a schedule, the workflow runs
+// in the domain of the schedule
+func GetWorkflowID(scheduleID string) string {
+	return WorkflowIDPrefix + ":" + scheduleID
+}
+
+// GetScheduleID returns the ID of the schedule backed by a scheduler workflow
+func GetScheduleID(workflowID string) string {
+	return strings.TrimPrefix(workflowID, WorkflowIDPrefix+":")
+}
+
+// ScheduleWorkflow is the workflow that starts the workflow of a schedule at the times matched by its spec
+func ScheduleWorkflow(ctx workflow.Context, params *WorkflowParams) error {
+	if err := ValidateParams(params); err != nil {
+		return err
+	}
+	compiled, err := compileSpec(params.Spec)
+	if err != nil {
+		return err
+	}
+	s := &scheduler{
+		ctx:      ctx,
+		params:   params,
+		compiled: compiled,
+		logger:   workflow.GetLogger(ctx).With(zap.String("schedule-id", params.ScheduleID)),
+	}
+	if s.params.State.LastProcessedTime.IsZero() {
+		s.params.State.LastProcessedTime = workflow.Now(ctx)
+	}
+
+	err = workflow.SetQueryHandler(ctx, QueryTypeDescribe, func() (*DescribeResult, error) {
+		return s.describe(), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	signals := map[string]func(workflow.Channel){
+		PauseSignal:    s.handlePause,
+		UnpauseSignal:  s.handleUnpause,
+		UpdateSignal:   s.handleUpdate,
+		TriggerSignal:  s.handleTrigger,
+		BackfillSignal: s.handleBackfill,
+		DeleteSignal:   s.handleDelete,
+	}
+	// iterate signals in a fixed order to keep the workflow deterministic
+	signalNames := []string{PauseSignal, UnpauseSignal, UpdateSignal, TriggerSignal, BackfillSignal, DeleteSignal}
+	channels := make(map[string]workflow.Channel, len(signalNames))
+	for _, name := range signalNames {
+		channels[name] = workflow.GetSignalChannel(ctx, name)
+	}
+	for i := 0; i < maxIterationsBeforeContinueAsNew && s.activities < maxActivitiesBeforeContinueAsNew && !s.deleted; i++ {
+		s.refreshRunning()
+		s.drainBuffer()
+		s.processScheduled(workflow.Now(ctx))
+		s.processBackfills()
+
+		timerCtx, cancelTimer := workflow.WithCancel(ctx)
+		selector := workflow.NewSelector(ctx)
+		if len(s.params.State.Backfills) > 0 && !s.params.State.Paused {
+			// keep starting the backfilled runs, only handling the signals which are already received
+			selector.AddDefault(func() {})
+		} else if wait, ok := s.nextWakeup(workflow.Now(ctx)); ok {
+			selector.AddFuture(workflow.NewTimer(timerCtx, wait), func(workflow.Future) {})
+		}
+		for _, name := range signalNames {
+			handler := signals[name]
+			selector.AddReceive(channels[name], func(c workflow.Channel, more bool) {
+				handler(c)
+			})
+		}
+		selector.Select(ctx)
+		cancelTimer()
+	}
+	if s.deleted {
+		s.logger.Info("schedule deleted")
+		return nil
+	}
+
+	// handle signals received since the last select so they are not lost on continue as new
+	for _, name := range signalNames {
+		for {
+			selector := workflow.NewSelector(ctx)
+			received := false
+			selector.AddReceive(channels[name], func(c workflow.Channel, more bool) {
+				received = true
+				signals[name](c)
+			})
+			selector.AddDefault(func() {})
+			selector.Select(ctx)
+			if !received {
+				break
+			}
+		}
+	}
+	if s.deleted {
+		return nil
+	}
+	return workflow.NewContinueAsNewError(ctx, WorkflowTypeName, s.params)
+}
+
+// ValidateParams validates the params of a scheduler workflow and fills in default policies
+func ValidateParams(params *WorkflowParams) error {
+	if params == nil {
+		return errors.New(errMsgParamsIsNil)
+	}
+	if params.ScheduleID == "" {
+		return errors.New(errMsgScheduleIDIsEmpty)
+	}
+	if params.Action.WorkflowType == "" {
+		return errors.New(errMsgWorkflowTypeEmpty)
+	}
+	if params.Action.TaskList == "" {
+		return errors.New(errMsgTaskListIsEmpty)
+	}
+	if params.Action.WorkflowIDPrefix == "" {
+		params.Action.WorkflowIDPrefix = params.ScheduleID
+	}
+	if params.Policies.CatchUpWindow <= 0 {
+		params.Policies.CatchUpWindow = defaultCatchUpWindow
+	}
+	if params.Policies.BufferLimit <= 0 {
+		params.Policies.BufferLimit = defaultBufferLimit
+	}
+	if _, ok := overlapPolicyNames[params.Policies.OverlapPolicy]; !ok {
+		return fmt.Errorf("unknown overlap policy %v", params.Policies.OverlapPolicy)
+	}
+	return params.Spec.Validate()
+}
+
+func resolveOverlap(policy OverlapPolicy, hasRunning bool, hasBuffered bool) overlapAction {
+	switch policy {
+	case OverlapPolicyAllowAll:
+		return overlapActionStart
+	case OverlapPolicyCancelOther:
+		if hasRunning {
+			return overlapActionCancelAndStart
+		}
+		return overlapActionStart
+	case OverlapPolicyBuffer:
+		if hasRunning || hasBuffered {
+			return overlapActionBuffer
+		}
+		return overlapActionStart
+	default:
+		if hasRunning {
+			return overlapActionSkip
+		}
+		return overlapActionStart
+	}
+}
+
+// processScheduled fires all nominal times which are due at the given time
+func (s *scheduler) processScheduled(now time.Time) {
+	state := &s.params.State
+	if state.Paused {
+		// runs which would have happened while paused are skipped rather than caught up
+		state.LastProcessedTime = now
+		return
+	}
+	for {
+		nominal := s.compiled.next(state.LastProcessedTime)
+		if nominal.IsZero() {
+			return
+		}
+		actual := nominal.Add(s.compiled.jitter(s.params.ScheduleID, nominal))
+		if actual.After(now) {
+			return
+		}
+		state.LastProcessedTime = nominal
+		if now.Sub(actual) > s.params.Policies.CatchUpWindow {
+			state.MissedRuns++
+			continue
+		}
+		s.fire(nominal, s.getScheduledWorkflowID(nominal), s.params.Policies.OverlapPolicy)
+	}
+}
+
+// processBackfills fires the next batch of runs of the pending backfills. Backfills are processed in
+// batches so that a large backfill doesn't grow the history of a single run past its limits.
+func (s *scheduler) processBackfills() {
+	state := &s.params.State
+	if state.Paused {
+		return
+	}
+	for fired := 0; len(state.Backfills) > 0 && fired < backfillBatchSize; {
+		backfill := &state.Backfills[0]
+		policy := s.params.Policies.OverlapPolicy
+		if backfill.OverlapPolicy != nil {
+			policy = *backfill.OverlapPolicy
+		}
+		limit := backfillBatchSize - fired
+		if backfill.RemainingRuns < limit {
+			limit = backfill.RemainingRuns
+		}
+		nominals := s.compiled.between(backfill.LastProcessedTime, backfill.EndTime, limit)
+		for _, nominal := range nominals {
+			s.fire(nominal, s.getScheduledWorkflowID(nominal), policy)
+			backfill.LastProcessedTime = nominal
+			backfill.RemainingRuns--
+			fired++
+		}
+		if len(nominals) == 0 || backfill.RemainingRuns <= 0 {
+			state.Backfills = state.Backfills[1:]
+		}
+	}
+}
+
+// nextWakeup returns how long the scheduler should sleep, or false if it should only wait for signals
+func (s *scheduler) nextWakeup(now time.Time) (time.Duration, bool) {
+	state := &s.params.State
+	if state.Paused {
+		return 0, false
+	}
+	var wakeup time.Time
+	if nominal := s.compiled.next(state.LastProcessedTime); !nominal.IsZero() {
+		wakeup = nominal.Add(s.compiled.jitter(s.params.ScheduleID, nominal))
+	}
+	if len(state.Buffered) > 0 {
+		// poll the open runs so that buffered runs start soon after they close
+		refresh := now.Add(runningRefreshInterval)
+		if wakeup.IsZero() || refresh.Before(wakeup) {
+			wakeup = refresh
+		}
+	}
+	if wakeup.IsZero() {
+		return 0, false
+	}
+	wait := wakeup.Sub(now)
+	if wait < time.Second {
+		wait = time.Second
+	}
+	return wait, true
+}
+
+func (s *scheduler) fire(nominal time.Time, workflowID string, policy OverlapPolicy) {
+	state := &s.params.State
+	switch resolveOverlap(policy, len(state.Running) > 0, len(state.Buffered) > 0) {
+	case overlapActionStart:
+		s.start(nominal, workflowID)
+	case overlapActionSkip:
+		state.SkippedRuns++
+	case overlapActionBuffer:
+		if len(state.Buffered) >= s.params.Policies.BufferLimit {
+			state.BufferOverruns++
+			return
+		}
+		state.Buffered = append(state.Buffered, BufferedRun{WorkflowID: workflowID, NominalTime: nominal})
+	case overlapActionCancelAndStart:
+		s.cancelRunning()
+		s.start(nominal, workflowID)
+	}
+}
+
+func (s *scheduler) drainBuffer() {
+	state := &s.params.State
+	if state.Paused {
+		return
+	}
+	for len(state.Buffered) > 0 && len(state.Running) == 0 {
+		buffered := state.Buffered[0]
+		state.Buffered = state.Buffered[1:]
+		if !s.start(buffered.NominalTime, buffered.WorkflowID) {
+			return
+		}
+	}
+}
+
+// getScheduledWorkflowID returns the workflow ID of the run of a nominal time. It only depends on the
+// nominal time, so a backfill doesn't start the runs which the schedule has already started.
+func (s *scheduler) getScheduledWorkflowID(nominal time.Time) string {
+	return fmt.Sprintf("%s-%s", s.params.Action.WorkflowIDPrefix, nominal.UTC().Format(time.RFC3339))
+}
+
+// getTriggeredWorkflowID returns the workflow ID of a triggered run, which must not collide with
+// scheduled runs or other triggered runs handled at the same time
+func (s *scheduler) getTriggeredWorkflowID(now time.Time) string {
+	s.params.State.TriggeredRuns++
+	return fmt.Sprintf("%s-triggered-%s-%d", s.params.Action.WorkflowIDPrefix, now.UTC().Format(time.RFC3339Nano), s.params.State.TriggeredRuns)
+}
+
+func (s *scheduler) start(nominal time.Time, workflowID string) bool {
+	state := &s.params.State
+	params := &StartWorkflowActivityParams{
+		ScheduleID:  s.params.ScheduleID,
+		Action:      s.params.Action,
+		WorkflowID:  workflowID,
+		NominalTime: nominal,
+	}
+	ao := workflow.WithActivityOptions(s.ctx, getActivityOptions())
+	s.activities++
+	var run RunRef
+	if err := workflow.ExecuteActivity(ao, startWorkflowActivityName, params).Get(s.ctx, &run); err != nil {
+		s.logger.Error("failed to start scheduled workflow", zap.String("workflow-id", params.WorkflowID), zap.Error(err))
+		state.FailedStarts++
+		return false
+	}
+	state.Running = append(state.Running, run)
+	state.LastRun = &run
+	state.TotalRuns++
+	return true
+}
+
+func (s *scheduler) refreshRunning() {
+	state := &s.params.State
+	if len(state.Running) == 0 {
+		return
+	}
+	params := &RunsActivityParams{
+		Runs: state.Running,
+	}
+	ao := workflow.WithActivityOptions(s.ctx, getActivityOptions())
+	s.activities++
+	var running []RunRef
+	if err := workflow.ExecuteActivity(ao, refreshRunsActivityName, params).Get(s.ctx, &running); err != nil {
+		s.logger.Warn("failed to refresh open runs", zap.Error(err))
+		return
+	}
+	state.Running = running
+}
+
+func (s *scheduler) cancelRunning() {
+	state := &s.params.State
+	params := &RunsActivityParams{
+		Runs:   state.Running,
+		Reason: fmt.Sprintf("cancelled by schedule %v due to overlap policy %v", s.params.ScheduleID, OverlapPolicyCancelOther),
+	}
+	ao := workflow.WithActivityOptions(s.ctx, getActivityOptions())
+	s.activities++
+	if err := workflow.ExecuteActivity(ao, cancelRunsActivityName, params).Get(s.ctx, nil); err != nil {
+		s.logger.Warn("failed to cancel open runs", zap.Error(err))
+	}
+}
+
+func (s *scheduler) handlePause(c workflow.Channel) {
+	var request PauseRequest
+	c.Receive(s.ctx, &request)
+	s.params.State.Paused = true
+	s.params.State.Note = request.Reason
+}
+
+func (s *scheduler) handleUnpause(c workflow.Channel) {
+	var request PauseRequest
+	c.Receive(s.ctx, &request)
+	if s.params.State.Paused {
+		s.params.State.LastProcessedTime = workflow.Now(s.ctx)
+	}
+	s.params.State.Paused = false
+	s.params.State.Note = request.Reason
+}
+
+func (s *scheduler) handleUpdate(c workflow.Channel) {
+	var request UpdateRequest
+	c.Receive(s.ctx, &request)
+
+	updated := *s.params
+	if request.Spec != nil {
+		updated.Spec = *request.Spec
+	}
+	if request.Action != nil {
+		updated.Action = *request.Action
+	}
+	if request.Policies != nil {
+		updated.Policies = *request.Policies
+	}
+	if err := ValidateParams(&updated); err != nil {
+		s.logger.Error("ignoring invalid schedule update", zap.Error(err))
+		return
+	}
+	compiled, err := compileSpec(updated.Spec)
+	if err != nil {
+		s.logger.Error("ignoring invalid schedule update", zap.Error(err))
+		return
+	}
+	*s.params = updated
+	s.compiled = compiled
+	// a new spec applies from now on, runs of the previous spec are not caught up
+	s.params.State.LastProcessedTime = workflow.Now(s.ctx)
+}
+
+func (s *scheduler) handleTrigger(c workflow.Channel) {
+	var request TriggerRequest
+	c.Receive(s.ctx, &request)
+	policy := s.params.Policies.OverlapPolicy
+	if request.OverlapPolicy != nil {
+		policy = *request.OverlapPolicy
+	}
+	now := workflow.Now(s.ctx)
+	s.fire(now, s.getTriggeredWorkflowID(now), policy)
+}
+
+func (s *scheduler) handleBackfill(c workflow.Channel) {
+	var request BackfillRequest
+	c.Receive(s.ctx, &request)
+	s.params.State.Backfills = append(s.params.State.Backfills, BackfillProgress{
+		LastProcessedTime: request.StartTime,
+		EndTime:           request.EndTime,
+		OverlapPolicy:     request.OverlapPolicy,
+		RemainingRuns:     MaxBackfillRuns,
+	})
+}
+
+func (s *scheduler) handleDelete(c workflow.Channel) {
+	c.Receive(s.ctx, nil)
+	s.deleted = true
+}
+
+func (s *scheduler) describe() *DescribeResult {
+	var upcoming []time.Time
+	if !s.params.State.Paused {
+		upcoming = s.compiled.upcoming(s.params.State.LastProcessedTime, numUpcomingRuns)
+	}
+	return &DescribeResult{
+		ScheduleID:   s.params.ScheduleID,
+		Spec:         s.params.Spec,
+		Action:       s.params.Action,
+		Policies:     s.params.Policies,
+		State:        s.params.State,
+		UpcomingRuns: upcoming,
+	}
+}
+
+func getActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: 10 * time.Second,
+		StartToCloseTimeout:    10 * time.Second,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    10 * time.Second,
+			ExpirationInterval: time.Minute,
+		},
+	}
+}
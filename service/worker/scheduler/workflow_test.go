@@ -0,0 +1,295 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+)
+
+type schedulerWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	workflowEnv *testsuite.TestWorkflowEnvironment
+	startTime   time.Time
+	started     []*StartWorkflowActivityParams
+}
+
+func TestSchedulerWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(schedulerWorkflowTestSuite))
+}
+
+func (s *schedulerWorkflowTestSuite) SetupTest() {
+	s.startTime = time.Date(2023, 1, 1, 0, 0, 30, 0, time.UTC)
+	s.started = nil
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.SetStartTime(s.startTime)
+	s.workflowEnv.RegisterWorkflowWithOptions(ScheduleWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(StartWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(RefreshRunsActivity, activity.RegisterOptions{Name: refreshRunsActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(CancelRunsActivity, activity.RegisterOptions{Name: cancelRunsActivityName})
+	s.workflowEnv.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, params *StartWorkflowActivityParams) (*RunRef, error) {
+			s.started = append(s.started, params)
+			return &RunRef{WorkflowID: params.WorkflowID, RunID: "rid", NominalTime: params.NominalTime}, nil
+		},
+	)
+}
+
+func (s *schedulerWorkflowTestSuite) newParams(policy OverlapPolicy) *WorkflowParams {
+	return &WorkflowParams{
+		ScheduleID: "sid",
+		Spec:       ScheduleSpec{Interval: time.Hour},
+		Action: StartWorkflowAction{
+			WorkflowType: "wt",
+			TaskList:     "tl",
+		},
+		Policies: SchedulePolicies{OverlapPolicy: policy},
+	}
+}
+
+func (s *schedulerWorkflowTestSuite) mockRunsClosed() {
+	s.workflowEnv.OnActivity(refreshRunsActivityName, mock.Anything, mock.Anything).Return(nil, nil)
+}
+
+func (s *schedulerWorkflowTestSuite) mockRunsOpen() {
+	s.workflowEnv.OnActivity(refreshRunsActivityName, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, params *RunsActivityParams) ([]RunRef, error) {
+			return params.Runs, nil
+		},
+	)
+}
+
+func (s *schedulerWorkflowTestSuite) signalAt(delay time.Duration, name string, arg interface{}) {
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		s.workflowEnv.SignalWorkflow(name, arg)
+	}, delay)
+}
+
+func (s *schedulerWorkflowTestSuite) describeAt(delay time.Duration) *DescribeResult {
+	result := &DescribeResult{}
+	s.workflowEnv.RegisterDelayedCallback(func() {
+		value, err := s.workflowEnv.QueryWorkflow(QueryTypeDescribe)
+		s.NoError(err)
+		s.NoError(value.Get(result))
+	}, delay)
+	return result
+}
+
+func (s *schedulerWorkflowTestSuite) TestValidateParams() {
+	s.Error(ValidateParams(nil))
+	params := s.newParams(OverlapPolicySkip)
+	params.ScheduleID = ""
+	s.Error(ValidateParams(params))
+	params = s.newParams(OverlapPolicy(100))
+	s.Error(ValidateParams(params))
+	params = s.newParams(OverlapPolicySkip)
+	params.Spec = ScheduleSpec{}
+	s.Error(ValidateParams(params))
+
+	params = s.newParams(OverlapPolicySkip)
+	s.NoError(ValidateParams(params))
+	s.Equal("sid", params.Action.WorkflowIDPrefix)
+	s.Equal(defaultCatchUpWindow, params.Policies.CatchUpWindow)
+	s.Equal(defaultBufferLimit, params.Policies.BufferLimit)
+}
+
+func (s *schedulerWorkflowTestSuite) TestResolveOverlap() {
+	s.Equal(overlapActionStart, resolveOverlap(OverlapPolicySkip, false, false))
+	s.Equal(overlapActionSkip, resolveOverlap(OverlapPolicySkip, true, false))
+	s.Equal(overlapActionStart, resolveOverlap(OverlapPolicyBuffer, false, false))
+	s.Equal(overlapActionBuffer, resolveOverlap(OverlapPolicyBuffer, false, true))
+	s.Equal(overlapActionBuffer, resolveOverlap(OverlapPolicyBuffer, true, false))
+	s.Equal(overlapActionStart, resolveOverlap(OverlapPolicyCancelOther, false, false))
+	s.Equal(overlapActionCancelAndStart, resolveOverlap(OverlapPolicyCancelOther, true, false))
+	s.Equal(overlapActionStart, resolveOverlap(OverlapPolicyAllowAll, true, true))
+}
+
+func (s *schedulerWorkflowTestSuite) TestParseOverlapPolicy() {
+	for policy, name := range overlapPolicyNames {
+		parsed, err := ParseOverlapPolicy(name)
+		s.NoError(err)
+		s.Equal(policy, parsed)
+		s.Equal(name, policy.String())
+	}
+	_, err := ParseOverlapPolicy("unknown")
+	s.Error(err)
+}
+
+func (s *schedulerWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &WorkflowParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *schedulerWorkflowTestSuite) TestWorkflow_FiresOnSchedule() {
+	s.mockRunsClosed()
+	s.signalAt(3*time.Hour+time.Minute, DeleteSignal, nil)
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams(OverlapPolicySkip))
+
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Len(s.started, 3)
+	s.Equal("sid-2023-01-01T01:00:00Z", s.started[0].WorkflowID)
+	s.Equal(time.Date(2023, 1, 1, 3, 0, 0, 0, time.UTC), s.started[2].NominalTime)
+}
+
+func (s *schedulerWorkflowTestSuite) TestWorkflow_SkipWhileRunning() {
+	s.mockRunsOpen()
+	describe := s.describeAt(3*time.Hour + time.Minute)
+	s.signalAt(3*time.Hour+2*time.Minute, DeleteSignal, nil)
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams(OverlapPolicySkip))
+
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Len(s.started, 1)
+	s.Equal(int64(1), describe.State.TotalRuns)
+	s.Equal(int64(2), describe.State.SkippedRuns)
+	s.Len(describe.State.Running, 1)
+}
+
+func (s *schedulerWorkflowTestSuite) TestWorkflow_BufferWhileRunning() {
+	s.mockRunsOpen()
+	describe := s.describeAt(3*time.Hour + time.Minute)
+	s.signalAt(3*time.Hour+2*time.Minute, DeleteSignal, nil)
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams(OverlapPolicyBuffer))
+
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Len(s.started, 1)
+	s.Len(describe.State.Buffered, 2)
+}
+
+func (s *schedulerWorkflowTestSuite) TestWorkflow_CancelOther() {
+	s.mockRunsOpen()
+	s.workflowEnv.OnActivity(cancelRunsActivityName, mock.Anything, mock.Anything).Return(nil).Times(2)
+	s.signalAt(3*time.Hour+time.Minute, DeleteSignal, nil)
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams(OverlapPolicyCancelOther))
+
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Len(s.started, 3)
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *schedulerWorkflowTestSuite) TestWorkflow_PauseAndUnpause() {
+	s.mockRunsClosed()
+	s.signalAt(30*time.Minute, PauseSignal, &PauseRequest{Reason: "incident"})
+	describe := s.describeAt(time.Hour + time.Minute)
+	s.signalAt(2*time.Hour+30*time.Minute, UnpauseSignal, &PauseRequest{Reason: "resolved"})
+	s.signalAt(3*time.Hour+time.Minute, DeleteSignal, nil)
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams(OverlapPolicySkip))
+
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.True(describe.State.Paused)
+	s.Equal("incident", describe.State.Note)
+	s.Empty(describe.UpcomingRuns)
+	s.Len(s.started, 1)
+	s.Equal(time.Date(2023, 1, 1, 3, 0, 0, 0, time.UTC), s.started[0].NominalTime)
+}
+
+func (s *schedulerWorkflowTestSuite) TestWorkflow_TriggerAndBackfill() {
+	s.mockRunsClosed()
+	s.signalAt(time.Minute, TriggerSignal, &TriggerRequest{})
+	s.signalAt(2*time.Minute, BackfillSignal, &BackfillRequest{
+		StartTime:     s.startTime.Add(-5 * time.Hour),
+		EndTime:       s.startTime,
+		OverlapPolicy: OverlapPolicyAllowAll.Ptr(),
+	})
+	s.signalAt(3*time.Minute, DeleteSignal, nil)
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams(OverlapPolicySkip))
+
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Len(s.started, 6)
+	s.Equal("sid-triggered-2023-01-01T00:01:30Z-1", s.started[0].WorkflowID)
+	s.Equal("sid-2022-12-31T20:00:00Z", s.started[1].WorkflowID)
+}
+
+func (s *schedulerWorkflowTestSuite) TestWorkflow_TriggerAtScheduledTime() {
+	s.mockRunsClosed()
+	// the trigger is handled at 01:00:00, the same time as the scheduled run
+	s.signalAt(59*time.Minute+30*time.Second, TriggerSignal, &TriggerRequest{OverlapPolicy: OverlapPolicyAllowAll.Ptr()})
+	s.signalAt(time.Hour, DeleteSignal, nil)
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams(OverlapPolicyAllowAll))
+
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Len(s.started, 2)
+	s.NotEqual(s.started[0].WorkflowID, s.started[1].WorkflowID)
+	s.Equal(s.started[0].NominalTime, s.started[1].NominalTime)
+}
+
+func (s *schedulerWorkflowTestSuite) TestWorkflow_LargeBackfillContinuesAsNew() {
+	s.mockRunsClosed()
+	params := s.newParams(OverlapPolicyAllowAll)
+	params.Spec = ScheduleSpec{Interval: time.Minute}
+	params.State.Backfills = []BackfillProgress{{
+		LastProcessedTime: s.startTime.Add(-24 * time.Hour),
+		EndTime:           s.startTime,
+		RemainingRuns:     MaxBackfillRuns,
+	}}
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	continueAsNew, ok := s.workflowEnv.GetWorkflowError().(*workflow.ContinueAsNewError)
+	s.True(ok)
+	s.True(len(s.started) < MaxBackfillRuns)
+	s.True(len(s.started) <= maxActivitiesBeforeContinueAsNew+backfillBatchSize)
+	carried, ok := continueAsNew.Args()[0].(*WorkflowParams)
+	s.True(ok)
+	s.Len(carried.State.Backfills, 1)
+	s.Equal(MaxBackfillRuns-len(s.started), carried.State.Backfills[0].RemainingRuns)
+	s.Equal(s.started[len(s.started)-1].NominalTime, carried.State.Backfills[0].LastProcessedTime)
+}
+
+func (s *schedulerWorkflowTestSuite) TestWorkflow_Update() {
+	s.mockRunsClosed()
+	s.signalAt(time.Minute, UpdateSignal, &UpdateRequest{Spec: &ScheduleSpec{Interval: 30 * time.Minute}})
+	describe := s.describeAt(2 * time.Minute)
+	s.signalAt(time.Hour+time.Minute, DeleteSignal, nil)
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams(OverlapPolicySkip))
+
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	s.Equal(30*time.Minute, describe.Spec.Interval)
+	s.Len(s.started, 2)
+}
+
+func (s *schedulerWorkflowTestSuite) TestWorkflow_ContinueAsNew() {
+	s.mockRunsClosed()
+	params := s.newParams(OverlapPolicySkip)
+	params.Spec = ScheduleSpec{Interval: time.Minute}
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	err := s.workflowEnv.GetWorkflowError()
+	s.Error(err)
+	continueAsNew, ok := err.(*workflow.ContinueAsNewError)
+	s.True(ok)
+	s.Equal(WorkflowTypeName, continueAsNew.WorkflowType().Name)
+	carried, ok := continueAsNew.Args()[0].(*WorkflowParams)
+	s.True(ok)
+	s.True(carried.State.TotalRuns > 0)
+}
@@ -49,6 +49,8 @@ import (
 	"github.com/uber/cadence/service/worker/scanner/shardscanner"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
+	"github.com/uber/cadence/service/worker/scheduler"
+	"github.com/uber/cadence/service/worker/visibilityreconciler"
 )
 
 type (
@@ -83,6 +85,8 @@ type (
 		DomainReplicationMaxRetryDuration   dynamicconfig.DurationPropertyFn
 		EnableESAnalyzer                    dynamicconfig.BoolPropertyFn
 		EnableAsyncWorkflowConsumption      dynamicconfig.BoolPropertyFn
+		EnableScheduler                     dynamicconfig.BoolPropertyFnWithDomainFilter
+		EnableVisibilityReconciler          dynamicconfig.BoolPropertyFn
 		HostName                            string
 	}
 )
@@ -154,6 +158,7 @@ func NewConfig(params *resource.Params) *Config {
 		failoverManagerCfg: &failovermanager.Config{
 			AdminOperationToken: dc.GetStringProperty(dynamicconfig.AdminOperationToken),
 			ClusterMetadata:     params.ClusterMetadata,
+			NumberOfShards:      params.PersistenceConfig.NumHistoryShards,
 		},
 		ESAnalyzerCfg: &esanalyzer.Config{
 			ESAnalyzerPause:                          dc.GetBoolProperty(dynamicconfig.ESAnalyzerPause),
@@ -180,6 +185,8 @@ func NewConfig(params *resource.Params) *Config {
 		PersistenceMaxQPS:                   dc.GetIntProperty(dynamicconfig.WorkerPersistenceMaxQPS),
 		DomainReplicationMaxRetryDuration:   dc.GetDurationProperty(dynamicconfig.WorkerReplicationTaskMaxRetryDuration),
 		EnableAsyncWorkflowConsumption:      dc.GetBoolProperty(dynamicconfig.EnableAsyncWorkflowConsumption),
+		EnableScheduler:                     dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableScheduler),
+		EnableVisibilityReconciler:          dc.GetBoolProperty(dynamicconfig.EnableVisibilityReconciler),
 		HostName:                            params.HostName,
 	}
 	advancedVisWritingMode := dc.GetStringProperty(
@@ -235,6 +242,11 @@ func (s *Service) Start() {
 	if s.config.EnableFailoverManager() {
 		s.startFailoverManager()
 	}
+	sched := s.startScheduler()
+	defer sched.Stop()
+	if s.config.EnableVisibilityReconciler() {
+		s.startVisibilityReconciler()
+	}
 
 	if s.config.EnableAsyncWorkflowConsumption() {
 		cm := s.startAsyncWorkflowConsumerManager()
@@ -387,16 +399,49 @@ func (s *Service) startArchiver() {
 
 func (s *Service) startFailoverManager() {
 	params := &failovermanager.BootstrapParams{
-		Config:        *s.config.failoverManagerCfg,
+		Config:                   *s.config.failoverManagerCfg,
+		ServiceClient:            s.params.PublicClient,
+		MetricsClient:            s.GetMetricsClient(),
+		Logger:                   s.GetLogger(),
+		TallyScope:               s.params.MetricScope,
+		ClientBean:               s.GetClientBean(),
+		ShardManager:             s.GetShardManager(),
+		ExecutionManagerProvider: s.GetExecutionManager,
+	}
+	if err := failovermanager.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting failoverManager", tag.Error(err))
+	}
+}
+
+func (s *Service) startScheduler() *scheduler.Scheduler {
+	params := &scheduler.BootstrapParams{
+		ServiceClient:    s.params.PublicClient,
+		MetricsClient:    s.GetMetricsClient(),
+		Logger:           s.GetLogger(),
+		TallyScope:       s.params.MetricScope,
+		ClientBean:       s.GetClientBean(),
+		DomainCache:      s.GetDomainCache(),
+		ClusterName:      s.GetClusterMetadata().GetCurrentClusterName(),
+		EnabledForDomain: s.config.EnableScheduler,
+	}
+	sched := scheduler.New(params)
+	if err := sched.Start(); err != nil {
+		s.GetLogger().Fatal("error starting scheduler", tag.Error(err))
+	}
+	return sched
+}
+
+func (s *Service) startVisibilityReconciler() {
+	params := &visibilityreconciler.BootstrapParams{
 		ServiceClient: s.params.PublicClient,
 		MetricsClient: s.GetMetricsClient(),
 		Logger:        s.GetLogger(),
 		TallyScope:    s.params.MetricScope,
 		ClientBean:    s.GetClientBean(),
 	}
-	if err := failovermanager.New(params).Start(); err != nil {
-		s.Stop()
-		s.GetLogger().Fatal("error starting failoverManager", tag.Error(err))
+	if err := visibilityreconciler.New(params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting visibility reconciler", tag.Error(err))
 	}
 }
 
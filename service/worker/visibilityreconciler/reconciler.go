@@ -0,0 +1,97 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package visibilityreconciler
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap the visibility reconciler
+	BootstrapParams struct {
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// ClientBean is an instance of client.Bean for a collection of clients
+		ClientBean client.Bean
+	}
+
+	// Reconciler runs the system workflow which reconciles the visibility records of a standby cluster
+	// with the active cluster
+	Reconciler struct {
+		svcClient     workflowserviceclient.Interface
+		clientBean    client.Bean
+		metricsClient metrics.Client
+		tallyScope    tally.Scope
+		logger        log.Logger
+		worker        worker.Worker
+	}
+)
+
+// New returns a new instance of Reconciler
+func New(params *BootstrapParams) *Reconciler {
+	return &Reconciler{
+		svcClient:     params.ServiceClient,
+		metricsClient: params.MetricsClient,
+		tallyScope:    params.TallyScope,
+		logger:        params.Logger,
+		clientBean:    params.ClientBean,
+	}
+}
+
+// Start starts the worker
+func (r *Reconciler) Start() error {
+	ctx := context.WithValue(context.Background(), reconcilerContextKey, r)
+	workerOpts := worker.Options{
+		MetricsScope:              r.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	reconcilerWorker := worker.New(r.svcClient, common.SystemLocalDomainName, TaskListName, workerOpts)
+	reconcilerWorker.RegisterWorkflowWithOptions(ReconcileWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	reconcilerWorker.RegisterActivityWithOptions(ReconcilePageActivity, activity.RegisterOptions{Name: reconcilePageActivityName})
+	reconcilerWorker.RegisterActivityWithOptions(ReconcileStandbyPageActivity, activity.RegisterOptions{Name: reconcileStandbyPageActivityName})
+	r.worker = reconcilerWorker
+	return reconcilerWorker.Start()
+}
+
+// Stop stops the worker
+func (r *Reconciler) Stop() {
+	r.worker.Stop()
+}
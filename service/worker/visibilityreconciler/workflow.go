@@ -0,0 +1,376 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package visibilityreconciler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	contextKey string
+
+	// Params is the input of the reconcile workflow
+	Params struct {
+		Domain         string
+		StandbyCluster string
+		// StartTime and EndTime select the workflows which started or closed in the window
+		StartTime time.Time
+		EndTime   time.Time
+		PageSize  int
+		// Repair refreshes the tasks of mismatched workflows in the standby cluster, which
+		// regenerates their visibility records from mutable state
+		Repair bool
+
+		// Phase, NextPageToken and Result carry the progress over continue as new
+		Phase         string
+		NextPageToken []byte
+		Result        Result
+	}
+
+	// Result is the outcome of the reconcile workflow
+	Result struct {
+		Compared   int
+		Missing    int
+		Mismatched int
+		// StandbyOnly are the records of the standby cluster which don't exist in the current cluster.
+		// They are reported but not repaired, as the stale record can be on either side.
+		StandbyOnly  int
+		Repaired     int
+		RepairFailed int
+		// Samples are the first mismatches found, up to maxSamples
+		Samples []Mismatch
+	}
+
+	// Mismatch is a workflow whose visibility record differs between the active and standby cluster
+	Mismatch struct {
+		WorkflowID string
+		RunID      string
+		Reason     string
+	}
+
+	// PageParams is the input of the reconcile page activity
+	PageParams struct {
+		Domain         string
+		StandbyCluster string
+		Query          string
+		PageSize       int
+		Repair         bool
+		NextPageToken  []byte
+	}
+
+	// PageResult is the outcome of reconciling one page of visibility records
+	PageResult struct {
+		Result
+		NextPageToken []byte
+	}
+)
+
+const (
+	reconcilerContextKey contextKey = "visibilityReconcilerContext"
+	// TaskListName tasklist
+	TaskListName = "cadence-sys-visibility-reconciler-tasklist"
+	// WorkflowTypeName workflow type name
+	WorkflowTypeName = "cadence-sys-visibility-reconciler-workflow"
+	// WorkflowIDPrefix is the prefix of the reconcile workflow IDs, followed by the domain name
+	WorkflowIDPrefix = "cadence-visibility-reconciler"
+	// QueryTypeResult is the query type to get the progress of the workflow
+	QueryTypeResult = "result"
+
+	reconcilePageActivityName        = "cadence-sys-visibility-reconciler-reconcilePage-activity"
+	reconcileStandbyPageActivityName = "cadence-sys-visibility-reconciler-reconcileStandbyPage-activity"
+
+	// PhaseActive compares the records of the current cluster with the standby cluster
+	PhaseActive = "active"
+	// PhaseStandby looks for records which only exist in the standby cluster
+	PhaseStandby = "standby"
+
+	defaultPageSize = 100
+	maxSamples      = 100
+	pagesPerRun     = 100
+
+	mismatchReasonMissing          = "missing in standby"
+	mismatchReasonMissingInActive  = "missing in active"
+	mismatchReasonCloseStatus      = "close status"
+	mismatchReasonCloseTime        = "close time"
+	mismatchReasonSearchAttributes = "search attributes"
+)
+
+// ReconcileWorkflow compares the visibility records of the domain in the current cluster with those in
+// the standby cluster, page by page, and optionally repairs the standby records which differ. Once all
+// records of the current cluster are compared, the records of the standby cluster are scanned for those
+// which don't exist in the current cluster.
+func ReconcileWorkflow(ctx workflow.Context, params *Params) (*Result, error) {
+	if err := validateParams(params); err != nil {
+		return nil, err
+	}
+	if err := workflow.SetQueryHandler(ctx, QueryTypeResult, func() (*Result, error) {
+		return &params.Result, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	ao := workflow.WithActivityOptions(ctx, getActivityOptions())
+	pageParams := &PageParams{
+		Domain:         params.Domain,
+		StandbyCluster: params.StandbyCluster,
+		Query:          getWindowQuery(params.StartTime, params.EndTime),
+		PageSize:       params.PageSize,
+		Repair:         params.Repair,
+	}
+	for i := 0; i < pagesPerRun; i++ {
+		pageParams.NextPageToken = params.NextPageToken
+		activityName := reconcilePageActivityName
+		if params.Phase == PhaseStandby {
+			activityName = reconcileStandbyPageActivityName
+		}
+		var page PageResult
+		if err := workflow.ExecuteActivity(ao, activityName, pageParams).Get(ctx, &page); err != nil {
+			return nil, err
+		}
+		params.Result.merge(&page.Result)
+		params.NextPageToken = page.NextPageToken
+		if len(params.NextPageToken) == 0 {
+			if params.Phase == PhaseStandby {
+				return &params.Result, nil
+			}
+			params.Phase = PhaseStandby
+		}
+	}
+	return nil, workflow.NewContinueAsNewError(ctx, WorkflowTypeName, params)
+}
+
+// ReconcilePageActivity reconciles one page of visibility records of the current cluster
+func ReconcilePageActivity(ctx context.Context, params *PageParams) (*PageResult, error) {
+	reconciler := ctx.Value(reconcilerContextKey).(*Reconciler)
+	activeClient := reconciler.clientBean.GetFrontendClient()
+	standbyClient := reconciler.clientBean.GetRemoteFrontendClient(params.StandbyCluster)
+
+	resp, err := activeClient.ListWorkflowExecutions(ctx, &types.ListWorkflowExecutionsRequest{
+		Domain:        params.Domain,
+		PageSize:      int32(params.PageSize),
+		NextPageToken: params.NextPageToken,
+		Query:         params.Query,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &PageResult{NextPageToken: resp.NextPageToken}
+	if len(resp.Executions) == 0 {
+		return result, nil
+	}
+
+	standbyResp, err := standbyClient.ListWorkflowExecutions(ctx, &types.ListWorkflowExecutionsRequest{
+		Domain:   params.Domain,
+		PageSize: int32(len(resp.Executions)),
+		Query:    getRunsQuery(resp.Executions),
+	})
+	if err != nil {
+		return nil, err
+	}
+	standbyRecords := make(map[string]*types.WorkflowExecutionInfo, len(standbyResp.Executions))
+	for _, record := range standbyResp.Executions {
+		standbyRecords[record.GetExecution().GetRunID()] = record
+	}
+
+	for _, record := range resp.Executions {
+		result.Compared++
+		execution := record.GetExecution()
+		reason := compareRecords(record, standbyRecords[execution.GetRunID()])
+		if reason == "" {
+			continue
+		}
+		if reason == mismatchReasonMissing {
+			result.Missing++
+		} else {
+			result.Mismatched++
+		}
+		result.addSample(Mismatch{WorkflowID: execution.GetWorkflowID(), RunID: execution.GetRunID(), Reason: reason})
+
+		if params.Repair {
+			if err := reconciler.clientBean.GetRemoteAdminClient(params.StandbyCluster).RefreshWorkflowTasks(ctx, &types.RefreshWorkflowTasksRequest{
+				Domain:    params.Domain,
+				Execution: execution,
+			}); err != nil {
+				// e.g. the workflow is already deleted by retention in the standby cluster
+				result.RepairFailed++
+			} else {
+				result.Repaired++
+			}
+		}
+		activity.RecordHeartbeat(ctx, result.Compared)
+	}
+
+	scope := reconciler.metricsClient.Scope(metrics.VisibilityReconcilerScope, metrics.DomainTag(params.Domain))
+	scope.AddCounter(metrics.VisibilityReconcilerComparedCount, int64(result.Compared))
+	scope.AddCounter(metrics.VisibilityReconcilerMismatchCount, int64(result.Missing+result.Mismatched))
+	scope.AddCounter(metrics.VisibilityReconcilerRepairedCount, int64(result.Repaired))
+	return result, nil
+}
+
+// ReconcileStandbyPageActivity finds the records of one page of visibility records of the standby cluster
+// which don't exist in the current cluster
+func ReconcileStandbyPageActivity(ctx context.Context, params *PageParams) (*PageResult, error) {
+	reconciler := ctx.Value(reconcilerContextKey).(*Reconciler)
+	activeClient := reconciler.clientBean.GetFrontendClient()
+	standbyClient := reconciler.clientBean.GetRemoteFrontendClient(params.StandbyCluster)
+
+	resp, err := standbyClient.ListWorkflowExecutions(ctx, &types.ListWorkflowExecutionsRequest{
+		Domain:        params.Domain,
+		PageSize:      int32(params.PageSize),
+		NextPageToken: params.NextPageToken,
+		Query:         params.Query,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &PageResult{NextPageToken: resp.NextPageToken}
+	if len(resp.Executions) == 0 {
+		return result, nil
+	}
+
+	activeResp, err := activeClient.ListWorkflowExecutions(ctx, &types.ListWorkflowExecutionsRequest{
+		Domain:   params.Domain,
+		PageSize: int32(len(resp.Executions)),
+		Query:    getRunsQuery(resp.Executions),
+	})
+	if err != nil {
+		return nil, err
+	}
+	activeRuns := make(map[string]struct{}, len(activeResp.Executions))
+	for _, record := range activeResp.Executions {
+		activeRuns[record.GetExecution().GetRunID()] = struct{}{}
+	}
+
+	for _, record := range resp.Executions {
+		execution := record.GetExecution()
+		if _, ok := activeRuns[execution.GetRunID()]; ok {
+			continue
+		}
+		result.StandbyOnly++
+		result.addSample(Mismatch{WorkflowID: execution.GetWorkflowID(), RunID: execution.GetRunID(), Reason: mismatchReasonMissingInActive})
+	}
+
+	scope := reconciler.metricsClient.Scope(metrics.VisibilityReconcilerScope, metrics.DomainTag(params.Domain))
+	scope.AddCounter(metrics.VisibilityReconcilerMismatchCount, int64(result.StandbyOnly))
+	return result, nil
+}
+
+// compareRecords returns the reason why the standby record differs from the active record, or empty if they match
+func compareRecords(active, standby *types.WorkflowExecutionInfo) string {
+	if standby == nil {
+		return mismatchReasonMissing
+	}
+	if (active.CloseStatus == nil) != (standby.CloseStatus == nil) || active.GetCloseStatus() != standby.GetCloseStatus() {
+		return mismatchReasonCloseStatus
+	}
+	if active.GetCloseTime() != standby.GetCloseTime() {
+		return mismatchReasonCloseTime
+	}
+	activeFields := active.GetSearchAttributes().GetIndexedFields()
+	standbyFields := standby.GetSearchAttributes().GetIndexedFields()
+	if len(activeFields) != len(standbyFields) {
+		return mismatchReasonSearchAttributes
+	}
+	for key, value := range activeFields {
+		if standbyValue, ok := standbyFields[key]; !ok || !bytes.Equal(value, standbyValue) {
+			return mismatchReasonSearchAttributes
+		}
+	}
+	return ""
+}
+
+func getWindowQuery(startTime, endTime time.Time) string {
+	start, end := startTime.UnixNano(), endTime.UnixNano()
+	return fmt.Sprintf("(StartTime >= %d AND StartTime < %d) OR (CloseTime >= %d AND CloseTime < %d)", start, end, start, end)
+}
+
+func getRunsQuery(records []*types.WorkflowExecutionInfo) string {
+	conditions := make([]string, 0, len(records))
+	for _, record := range records {
+		conditions = append(conditions, fmt.Sprintf("RunID = '%s'", record.GetExecution().GetRunID()))
+	}
+	return strings.Join(conditions, " OR ")
+}
+
+func (r *Result) merge(other *Result) {
+	r.Compared += other.Compared
+	r.Missing += other.Missing
+	r.Mismatched += other.Mismatched
+	r.StandbyOnly += other.StandbyOnly
+	r.Repaired += other.Repaired
+	r.RepairFailed += other.RepairFailed
+	for _, sample := range other.Samples {
+		r.addSample(sample)
+	}
+}
+
+func (r *Result) addSample(mismatch Mismatch) {
+	if len(r.Samples) < maxSamples {
+		r.Samples = append(r.Samples, mismatch)
+	}
+}
+
+func validateParams(params *Params) error {
+	if params == nil {
+		return errors.New("params is nil")
+	}
+	if params.Domain == "" || params.StandbyCluster == "" {
+		return errors.New("domain and standby cluster are required")
+	}
+	if !params.EndTime.After(params.StartTime) {
+		return errors.New("end time must be after start time")
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+	if params.Phase == "" {
+		params.Phase = PhaseActive
+	}
+	return nil
+}
+
+func getActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    5 * time.Minute,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			ExpirationInterval: 10 * time.Minute,
+		},
+	}
+}
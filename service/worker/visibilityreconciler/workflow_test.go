@@ -0,0 +1,219 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package visibilityreconciler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+type reconcilerWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	workflowEnv *testsuite.TestWorkflowEnvironment
+}
+
+func TestReconcilerWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(reconcilerWorkflowTestSuite))
+}
+
+func (s *reconcilerWorkflowTestSuite) SetupTest() {
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(ReconcileWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(ReconcilePageActivity, activity.RegisterOptions{Name: reconcilePageActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(ReconcileStandbyPageActivity, activity.RegisterOptions{Name: reconcileStandbyPageActivityName})
+}
+
+func (s *reconcilerWorkflowTestSuite) newParams() *Params {
+	return &Params{
+		Domain:         "domain",
+		StandbyCluster: "standby",
+		StartTime:      time.Unix(0, 0),
+		EndTime:        time.Unix(3600, 0),
+	}
+}
+
+func (s *reconcilerWorkflowTestSuite) TestWorkflow_MergesPages() {
+	s.workflowEnv.OnActivity(reconcilePageActivityName, mock.Anything, mock.Anything).Return(&PageResult{
+		Result:        Result{Compared: 2, Missing: 1, Samples: []Mismatch{{WorkflowID: "wid1", Reason: mismatchReasonMissing}}},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+	s.workflowEnv.OnActivity(reconcilePageActivityName, mock.Anything, mock.MatchedBy(func(params *PageParams) bool {
+		return string(params.NextPageToken) == "token"
+	})).Return(&PageResult{
+		Result: Result{Compared: 1, Mismatched: 1, Samples: []Mismatch{{WorkflowID: "wid2", Reason: mismatchReasonCloseStatus}}},
+	}, nil).Once()
+	s.workflowEnv.OnActivity(reconcileStandbyPageActivityName, mock.Anything, mock.MatchedBy(func(params *PageParams) bool {
+		return len(params.NextPageToken) == 0
+	})).Return(&PageResult{
+		Result: Result{StandbyOnly: 1, Samples: []Mismatch{{WorkflowID: "wid4", Reason: mismatchReasonMissingInActive}}},
+	}, nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, s.newParams())
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	var result Result
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal(3, result.Compared)
+	s.Equal(1, result.Missing)
+	s.Equal(1, result.Mismatched)
+	s.Equal(1, result.StandbyOnly)
+	s.Len(result.Samples, 3)
+}
+
+func (s *reconcilerWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	params := s.newParams()
+	params.EndTime = params.StartTime
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *reconcilerWorkflowTestSuite) TestReconcilePageActivity() {
+	controller := gomock.NewController(s.T())
+	clientBean := client.NewMockBean(controller)
+	activeClient := frontend.NewMockClient(controller)
+	standbyClient := frontend.NewMockClient(controller)
+	standbyAdminClient := admin.NewMockClient(controller)
+	clientBean.EXPECT().GetFrontendClient().Return(activeClient).AnyTimes()
+	clientBean.EXPECT().GetRemoteFrontendClient("standby").Return(standbyClient).AnyTimes()
+	clientBean.EXPECT().GetRemoteAdminClient("standby").Return(standbyAdminClient).AnyTimes()
+
+	closed := types.WorkflowExecutionCloseStatusCompleted.Ptr()
+	activeClient.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{Execution: &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid1"}},
+			{Execution: &types.WorkflowExecution{WorkflowID: "wid2", RunID: "rid2"}, CloseStatus: closed, CloseTime: common.Int64Ptr(10)},
+			{Execution: &types.WorkflowExecution{WorkflowID: "wid3", RunID: "rid3"}},
+		},
+	}, nil).Times(1)
+	standbyClient.EXPECT().ListWorkflowExecutions(gomock.Any(), &types.ListWorkflowExecutionsRequest{
+		Domain:   "domain",
+		PageSize: 3,
+		Query:    "RunID = 'rid1' OR RunID = 'rid2' OR RunID = 'rid3'",
+	}).Return(&types.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{Execution: &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid1"}},
+			{Execution: &types.WorkflowExecution{WorkflowID: "wid2", RunID: "rid2"}},
+		},
+	}, nil).Times(1)
+	standbyAdminClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivityWithOptions(ReconcilePageActivity, activity.RegisterOptions{Name: reconcilePageActivityName})
+	reconciler := &Reconciler{clientBean: clientBean, metricsClient: metrics.NewNoopMetricsClient()}
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), reconcilerContextKey, reconciler),
+	})
+	value, err := env.ExecuteActivity(reconcilePageActivityName, &PageParams{
+		Domain:         "domain",
+		StandbyCluster: "standby",
+		PageSize:       10,
+		Repair:         true,
+	})
+	s.NoError(err)
+	var result PageResult
+	s.NoError(value.Get(&result))
+	s.Equal(3, result.Compared)
+	s.Equal(1, result.Missing)
+	s.Equal(1, result.Mismatched)
+	s.Equal(2, result.Repaired)
+}
+
+func (s *reconcilerWorkflowTestSuite) TestReconcileStandbyPageActivity() {
+	controller := gomock.NewController(s.T())
+	clientBean := client.NewMockBean(controller)
+	activeClient := frontend.NewMockClient(controller)
+	standbyClient := frontend.NewMockClient(controller)
+	clientBean.EXPECT().GetFrontendClient().Return(activeClient).AnyTimes()
+	clientBean.EXPECT().GetRemoteFrontendClient("standby").Return(standbyClient).AnyTimes()
+
+	standbyClient.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{Execution: &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid1"}},
+			{Execution: &types.WorkflowExecution{WorkflowID: "wid2", RunID: "rid2"}},
+		},
+		NextPageToken: []byte("token"),
+	}, nil).Times(1)
+	activeClient.EXPECT().ListWorkflowExecutions(gomock.Any(), &types.ListWorkflowExecutionsRequest{
+		Domain:   "domain",
+		PageSize: 2,
+		Query:    "RunID = 'rid1' OR RunID = 'rid2'",
+	}).Return(&types.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{Execution: &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid1"}},
+		},
+	}, nil).Times(1)
+
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivityWithOptions(ReconcileStandbyPageActivity, activity.RegisterOptions{Name: reconcileStandbyPageActivityName})
+	reconciler := &Reconciler{clientBean: clientBean, metricsClient: metrics.NewNoopMetricsClient()}
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), reconcilerContextKey, reconciler),
+	})
+	value, err := env.ExecuteActivity(reconcileStandbyPageActivityName, &PageParams{
+		Domain:         "domain",
+		StandbyCluster: "standby",
+		PageSize:       10,
+	})
+	s.NoError(err)
+	var result PageResult
+	s.NoError(value.Get(&result))
+	s.Equal(1, result.StandbyOnly)
+	s.Equal([]Mismatch{{WorkflowID: "wid2", RunID: "rid2", Reason: mismatchReasonMissingInActive}}, result.Samples)
+	s.Equal([]byte("token"), result.NextPageToken)
+}
+
+func TestCompareRecords(t *testing.T) {
+	closed := types.WorkflowExecutionCloseStatusCompleted.Ptr()
+	record := func(closeStatus *types.WorkflowExecutionCloseStatus, closeTime *int64, value string) *types.WorkflowExecutionInfo {
+		return &types.WorkflowExecutionInfo{
+			CloseStatus:      closeStatus,
+			CloseTime:        closeTime,
+			SearchAttributes: &types.SearchAttributes{IndexedFields: map[string][]byte{"CustomKeywordField": []byte(value)}},
+		}
+	}
+
+	assert.Equal(t, "", compareRecords(record(closed, common.Int64Ptr(1), "a"), record(closed, common.Int64Ptr(1), "a")))
+	assert.Equal(t, mismatchReasonMissing, compareRecords(record(nil, nil, "a"), nil))
+	assert.Equal(t, mismatchReasonCloseStatus, compareRecords(record(closed, common.Int64Ptr(1), "a"), record(nil, nil, "a")))
+	assert.Equal(t, mismatchReasonCloseTime, compareRecords(record(closed, common.Int64Ptr(1), "a"), record(closed, common.Int64Ptr(2), "a")))
+	assert.Equal(t, mismatchReasonSearchAttributes, compareRecords(record(nil, nil, "a"), record(nil, nil, "b")))
+}
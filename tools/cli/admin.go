@@ -28,6 +28,7 @@ import (
 
 	"github.com/urfave/cli"
 
+	"github.com/uber/cadence/common/dlq"
 	"github.com/uber/cadence/common/reconciliation/invariant"
 	"github.com/uber/cadence/service/worker/scanner/executions"
 )
@@ -84,11 +85,97 @@ func newAdminWorkflowCommands() []cli.Command {
 					Name:  FlagRunIDWithAlias,
 					Usage: "RunID",
 				},
+				cli.BoolFlag{
+					Name:  FlagDiff,
+					Usage: "Show pending activities, timers, child workflows, signals, version histories and checksum status of the cached and persisted mutable state, and the differences between them",
+				},
+				cli.BoolFlag{
+					Name:  FlagRebuild,
+					Usage: "With --diff, also rebuild the mutable state from history on the history host and compare it with the persisted mutable state",
+				},
+				getFormatFlag(),
 			},
 			Action: func(c *cli.Context) {
 				AdminDescribeWorkflow(c)
 			},
 		},
+		{
+			Name:    "ndc-history",
+			Aliases: []string{"ndc"},
+			Usage:   "Show the version history branches of a workflow and whether signals of the losing branches were reapplied",
+			Flags: append(getDBFlags(),
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminNDCHistory(c)
+			},
+		},
+		{
+			Name:  "tasks",
+			Usage: "List the transfer, timer and replication tasks of a workflow in its shard's task queues and whether they are acked, and the tasks recently executed or retried by the queue processors",
+			Flags: append(getDBFlags(),
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.IntFlag{
+					Name:  FlagBatchSizeWithAlias,
+					Usage: "Batch size used when scanning the task queues",
+					Value: 1000,
+				},
+				getFormatFlag(),
+			),
+			Action: func(c *cli.Context) {
+				AdminListWorkflowTasks(c)
+			},
+		},
+		{
+			Name:    "export",
+			Aliases: []string{"exp"},
+			Usage:   "Export the history and mutable state of a workflow, or of all open workflows of the domain, to a file",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID, all open workflows of the domain are exported if not set",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagOutputFilenameWithAlias,
+					Usage: "Export file, one JSON encoded workflow per line",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminExportWorkflow(c)
+			},
+		},
+		{
+			Name:    "import",
+			Aliases: []string{"imp"},
+			Usage:   "Import the workflows of an export file into the domain, the domain has to exist in this cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "Export file written by admin workflow export",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminImportWorkflow(c)
+			},
+		},
 		{
 			Name:    "refresh-tasks",
 			Aliases: []string{"rt"},
@@ -355,6 +442,29 @@ func newAdminHistoryHostCommands() []cli.Command {
 				AdminDescribeHistoryHost(c)
 			},
 		},
+		{
+			Name:    "throttled",
+			Aliases: []string{"thr"},
+			Usage:   "List the workflow IDs recently throttled by the per workflow ID rate limits of a history host",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagHistoryAddressWithAlias,
+					Usage: "History Host address(IP:PORT)",
+				},
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				getFormatFlag(),
+			},
+			Action: func(c *cli.Context) {
+				AdminListThrottledWorkflowIDs(c)
+			},
+		},
 		{
 			Name:    "getshard",
 			Aliases: []string{"gsh"},
@@ -468,6 +578,26 @@ func newAdminDomainCommands() []cli.Command {
 				newDomainCLI(c, false).ListDomains(c)
 			},
 		},
+		{
+			Name:  "usage",
+			Usage: "Show the workflow usage of a domain per workflow type, as recorded by the history hosts of the cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name: FlagEarliestTimeWithAlias,
+					Usage: "Start of the usage period, supported formats are '2006-01-02T15:04:05+07:00', raw UnixNano and " +
+						"time range (N<duration>), for example '15m' implies 15 minutes ago. Default is 24 hours ago",
+				},
+				cli.StringFlag{
+					Name: FlagLatestTimeWithAlias,
+					Usage: "End of the usage period, supported formats are '2006-01-02T15:04:05+07:00', raw UnixNano and " +
+						"time range (N<duration>), for example '15m' implies 15 minutes ago. Default is now",
+				},
+				getFormatFlag(),
+			},
+			Action: func(c *cli.Context) {
+				AdminDomainUsage(c)
+			},
+		},
 	}
 }
 
@@ -751,12 +881,57 @@ func newAdminClusterCommands() []cli.Command {
 				newDomainCLI(c, false).FailoverDomains(c)
 			},
 		},
+		{
+			Name:    "replication-status",
+			Aliases: []string{"rs"},
+			Usage: "Show how far remote clusters are behind on replication tasks of this cluster, per shard or per domain. " +
+				"DLQ size is the number of messages from the remote cluster which failed to apply in this cluster",
+			Flags: append(getDBFlags(),
+				cli.IntFlag{
+					Name:  FlagLowerShardBound,
+					Usage: "First shard to report",
+					Value: 0,
+				},
+				cli.IntFlag{
+					Name:  FlagUpperShardBound,
+					Usage: "Last shard to report (inclusive)",
+				},
+				cli.StringFlag{
+					Name:  FlagTargetClusterWithAlias,
+					Usage: "Optional remote cluster to report, default is all remote clusters",
+				},
+				cli.IntFlag{
+					Name:  FlagMaxPendingTasks,
+					Usage: "Maximum number of pending replication tasks to read per shard and remote cluster",
+					Value: 1000,
+				},
+				cli.IntFlag{
+					Name: FlagSampleIntervalSeconds,
+					Usage: "Optional interval in seconds between two samples. " +
+						"If set, the catch up time is estimated from how much the lag shrinks within the interval",
+				},
+				cli.BoolFlag{
+					Name:  FlagPerDomain,
+					Usage: "Aggregate the pending replication tasks per domain",
+				},
+				getFormatFlag(),
+			),
+			Action: func(c *cli.Context) {
+				AdminReplicationStatus(c)
+			},
+		},
 		{
 			Name:        "rebalance",
 			Aliases:     []string{"rb"},
 			Usage:       "Rebalance the domains active cluster",
 			Subcommands: newAdminRebalanceCommands(),
 		},
+		{
+			Name:        "reconcile-visibility",
+			Aliases:     []string{"rv"},
+			Usage:       "Compare the visibility records of a domain between this cluster and a standby cluster",
+			Subcommands: newAdminVisibilityReconcileCommands(),
+		},
 	}
 }
 
@@ -782,6 +957,27 @@ func getDLQFlags() []cli.Flag {
 	}
 }
 
+func getDLQFilterFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagDomainWithAlias,
+			Usage: "Only include messages of this domain name",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowIDWithAlias,
+			Usage: "Only include messages of this workflow ID",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskType,
+			Usage: "Only include messages of this replication task type. (Options: History, HistoryV2, SyncActivity, ...)",
+		},
+		cli.StringFlag{
+			Name:  FlagErrorClass,
+			Usage: "Only include messages which failed with this class of error. (Options: " + strings.Join(dlq.AllErrorClasses, ", ") + ")",
+		},
+	}
+}
+
 func newAdminDLQCommands() []cli.Command {
 	return []cli.Command{
 		{
@@ -808,7 +1004,7 @@ func newAdminDLQCommands() []cli.Command {
 			Name:    "read",
 			Aliases: []string{"r"},
 			Usage:   "Read DLQ Messages",
-			Flags: append(getDLQFlags(),
+			Flags: append(append(getDLQFlags(), getDLQFilterFlags()...),
 				cli.IntFlag{
 					Name:  FlagMaxMessageCountWithAlias,
 					Usage: "Max message size to fetch",
@@ -819,6 +1015,17 @@ func newAdminDLQCommands() []cli.Command {
 				AdminGetDLQMessages(c)
 			},
 		},
+		{
+			Name:    "summary",
+			Aliases: []string{"s"},
+			Usage:   "Summarize DLQ messages by domain, task type and error class",
+			Flags: append(append(getDLQFlags(), getDLQFilterFlags()...),
+				getFormatFlag(),
+			),
+			Action: func(c *cli.Context) {
+				AdminSummarizeDLQMessages(c)
+			},
+		},
 		{
 			Name:    "purge",
 			Aliases: []string{"p"},
@@ -831,8 +1038,8 @@ func newAdminDLQCommands() []cli.Command {
 		{
 			Name:    "merge",
 			Aliases: []string{"m"},
-			Usage:   "Merge DLQ messages with equal or smaller ids than the provided task id",
-			Flags:   getDLQFlags(),
+			Usage:   "Merge DLQ messages with equal or smaller ids than the provided task id. With filter flags set, only matching messages are merged and removed",
+			Flags:   append(getDLQFlags(), getDLQFilterFlags()...),
 			Action: func(c *cli.Context) {
 				AdminMergeDLQMessages(c)
 			},
@@ -854,11 +1061,38 @@ func newAdminQueueCommands() []cli.Command {
 			Name:    "describe",
 			Aliases: []string{"desc"},
 			Usage:   "describe processing queue states for transfer or timer queue processor",
-			Flags:   getQueueCommandFlags(),
+			Flags:   append(getQueueCommandFlags(), getFormatFlag()),
 			Action: func(c *cli.Context) {
 				AdminDescribeQueue(c)
 			},
 		},
+		{
+			Name:  "split-domain",
+			Usage: "split a domain out into its own transfer and timer processing queue level, requires config store based dynamic config",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagQueueLevel,
+					Usage: "processing queue level the domain will be split into, 0 to stop splitting out the domain",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminSplitQueueDomain(c)
+			},
+		},
+		{
+			Name:  "throttle-domain",
+			Usage: "only poll the processing queue level a domain is split into at the poll backoff interval, requires config store based dynamic config",
+			Action: func(c *cli.Context) {
+				AdminThrottleQueueDomain(c)
+			},
+		},
+		{
+			Name:  "unthrottle-domain",
+			Usage: "remove the throttling added by the throttle-domain command",
+			Action: func(c *cli.Context) {
+				AdminUnthrottleQueueDomain(c)
+			},
+		},
 	}
 }
 
@@ -1079,6 +1313,38 @@ func newAdminFailoverCommands() []cli.Command {
 					Usage: "Optional cron schedule on failover drill. Please specify failover drill wait time " +
 						"if this field is specific",
 				},
+				cli.IntFlag{
+					Name: FlagMaxReplicationLagSeconds,
+					Usage: "Optional maximum replication lag in seconds from source to target cluster. " +
+						"The failover is aborted if any shard lags behind more than this. " +
+						"The check requires the failover workflow to run in the source cluster",
+				},
+				cli.BoolFlag{
+					Name: FlagHealthChecks,
+					Usage: "Optional to abort the failover if the target cluster is unhealthy or has replication DLQ messages " +
+						"from the source cluster. Target cluster health is checked again after each batch",
+				},
+				cli.Float64Flag{
+					Name:  FlagMaxBatchFailureRate,
+					Usage: "Optional ratio of failed domains in a batch, e.g. 0.1, above which the failover is aborted",
+				},
+				cli.StringSliceFlag{
+					Name:  FlagCanaryDomains,
+					Usage: "Optional domains to failover first, can be set multiple times. The other domains follow after the canary wait time",
+				},
+				cli.IntFlag{
+					Name:  FlagCanaryWaitTime,
+					Usage: "Optional wait time in seconds after the canary domains are failed over",
+				},
+				cli.BoolFlag{
+					Name:  FlagRollbackOnFailure,
+					Usage: "Optional to fail the domains already failed over back to the source cluster if a check fails",
+				},
+				cli.BoolFlag{
+					Name: FlagDryRun,
+					Usage: "Optional to only run the pre-flight checks and report the planned batches. " +
+						"Use 'cadence admin cluster failover query' to see the result",
+				},
 			},
 			Action: func(c *cli.Context) {
 				AdminFailoverStart(c)
@@ -1262,6 +1528,63 @@ func newAdminRebalanceCommands() []cli.Command {
 	}
 }
 
+func newAdminVisibilityReconcileCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "start",
+			Aliases: []string{"s"},
+			Usage:   "start the visibility reconcile workflow of a domain",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDomainWithAlias,
+					Usage: "Domain to reconcile",
+				},
+				cli.StringFlag{
+					Name:  FlagStandbyCluster,
+					Usage: "Standby cluster to compare the visibility records with",
+				},
+				cli.StringFlag{
+					Name:  FlagEarliestTimeWithAlias,
+					Usage: "Reconcile workflows started or closed after this time. Supported formats are '2006-01-02T15:04:05+07:00' and raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagLatestTimeWithAlias,
+					Usage: "Reconcile workflows started or closed before this time, default is now",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Usage: "Visibility page size",
+				},
+				cli.BoolFlag{
+					Name:  FlagRepair,
+					Usage: "Refresh the tasks of mismatched workflows in the standby cluster, which regenerates their visibility records",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminStartVisibilityReconcile(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"d"},
+			Usage:   "describe the progress of the visibility reconcile workflow of a domain",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDomainWithAlias,
+					Usage: "Domain being reconciled",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID of the reconcile workflow, default is the latest run",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeVisibilityReconcile(c)
+			},
+		},
+	}
+}
+
 func newAdminConfigStoreCommands() []cli.Command {
 	return []cli.Command{
 		{
@@ -23,14 +23,20 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 	"regexp"
+	"sort"
+	"strconv"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/pborman/uuid"
 	"github.com/urfave/cli"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/replication"
 	"github.com/uber/cadence/service/worker/failovermanager"
 )
 
@@ -168,3 +174,187 @@ func validateSearchAttributeKey(name string) error {
 func isValueTypeValid(valType int) bool {
 	return valType >= 0 && valType <= 5
 }
+
+// ReplicationStatusRow is the replication status of a shard towards a remote cluster
+type ReplicationStatusRow struct {
+	ShardID              int           `header:"Shard ID" json:"shardID"`
+	RemoteCluster        string        `header:"Remote Cluster" json:"remoteCluster"`
+	LastReplicatedTaskID int64         `header:"Last Replicated Task ID" json:"lastReplicatedTaskID"`
+	ShardUpdated         time.Time     `header:"Shard Updated" json:"shardUpdated"`
+	PendingTasks         string        `header:"Pending Tasks" json:"pendingTasks"`
+	Lag                  time.Duration `header:"Lag" json:"lag"`
+	DLQSize              int64         `header:"DLQ Size" json:"dlqSize"`
+	EstimatedCatchUp     string        `header:"Estimated Catch Up" json:"estimatedCatchUp,omitempty"`
+}
+
+// DomainReplicationStatusRow is the replication status of a domain towards a remote cluster
+type DomainReplicationStatusRow struct {
+	Domain        string        `header:"Domain" json:"domain"`
+	DomainID      string        `header:"Domain ID" json:"domainID"`
+	RemoteCluster string        `header:"Remote Cluster" json:"remoteCluster"`
+	PendingTasks  int           `header:"Pending Tasks" json:"pendingTasks"`
+	LaggingShards int           `header:"Lagging Shards" json:"laggingShards"`
+	Lag           time.Duration `header:"Lag" json:"lag"`
+}
+
+// AdminReplicationStatus reports how far remote clusters are behind on replication tasks of this cluster
+func AdminReplicationStatus(c *cli.Context) {
+	lowerShardBound := c.Int(FlagLowerShardBound)
+	upperShardBound := getRequiredIntOption(c, FlagUpperShardBound)
+	maxPendingTasks := c.Int(FlagMaxPendingTasks)
+	sampleInterval := time.Duration(c.Int(FlagSampleIntervalSeconds)) * time.Second
+	var remoteClusters []string
+	if c.IsSet(FlagTargetCluster) {
+		remoteClusters = []string{c.String(FlagTargetCluster)}
+	}
+
+	shardManager := initializeShardManager(c)
+	readStatuses := func() ([]*replication.ShardStatus, time.Time) {
+		ctx, cancel := newContext(c)
+		defer cancel()
+
+		now := time.Now()
+		var statuses []*replication.ShardStatus
+		for shardID := lowerShardBound; shardID <= upperShardBound; shardID++ {
+			executionManager := initializeExecutionStore(c, shardID)
+			shardStatuses, err := replication.GetShardStatus(
+				ctx,
+				shardManager,
+				executionManager,
+				shardID,
+				remoteClusters,
+				maxPendingTasks,
+				now,
+			)
+			executionManager.Close()
+			if err != nil {
+				ErrorAndExit(fmt.Sprintf("Failed to get replication status of shard %v.", shardID), err)
+			}
+			statuses = append(statuses, shardStatuses...)
+		}
+		return statuses, now
+	}
+
+	statuses, sampleTime := readStatuses()
+	var previousStatuses []*replication.ShardStatus
+	var previousSampleTime time.Time
+	if sampleInterval > 0 {
+		time.Sleep(sampleInterval)
+		previousStatuses, previousSampleTime = statuses, sampleTime
+		statuses, sampleTime = readStatuses()
+	}
+
+	if c.Bool(FlagPerDomain) {
+		Render(c, newDomainReplicationStatusRows(c, statuses), RenderOptions{Color: true, DefaultTemplate: templateTable})
+		return
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	adminClient := cFactory.ServerAdminClient(c)
+	dlqSizes := map[types.HistoryDLQCountKey]int64{}
+	response, err := adminClient.CountDLQMessages(ctx, &types.CountDLQMessagesRequest{})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error occurred while getting DLQ count, results may be partial: %v\n", err)
+	}
+	if response != nil {
+		dlqSizes = response.History
+	}
+
+	rows := newReplicationStatusRows(statuses, previousStatuses, sampleTime, previousSampleTime, dlqSizes)
+	Render(c, rows, RenderOptions{Color: true, DefaultTemplate: templateTable})
+}
+
+func newReplicationStatusRows(
+	statuses []*replication.ShardStatus,
+	previousStatuses []*replication.ShardStatus,
+	sampleTime time.Time,
+	previousSampleTime time.Time,
+	dlqSizes map[types.HistoryDLQCountKey]int64,
+) []ReplicationStatusRow {
+	type shardCluster struct {
+		shardID int
+		cluster string
+	}
+	previous := make(map[shardCluster]*replication.ShardStatus, len(previousStatuses))
+	for _, status := range previousStatuses {
+		previous[shardCluster{status.ShardID, status.RemoteCluster}] = status
+	}
+
+	rows := make([]ReplicationStatusRow, 0, len(statuses))
+	for _, status := range statuses {
+		pendingTasks := strconv.Itoa(status.PendingTasks)
+		if status.PendingTasksTruncated {
+			pendingTasks += "+"
+		}
+		row := ReplicationStatusRow{
+			ShardID:              status.ShardID,
+			RemoteCluster:        status.RemoteCluster,
+			LastReplicatedTaskID: status.LastReplicatedTaskID,
+			ShardUpdated:         status.ShardUpdatedTime,
+			PendingTasks:         pendingTasks,
+			Lag:                  status.Lag,
+			DLQSize: dlqSizes[types.HistoryDLQCountKey{
+				ShardID:       int32(status.ShardID),
+				SourceCluster: status.RemoteCluster,
+			}],
+		}
+		if len(previousStatuses) > 0 {
+			row.EstimatedCatchUp = "unknown"
+			eta, ok := status.EstimateCatchUpTime(previous[shardCluster{status.ShardID, status.RemoteCluster}], sampleTime, previousSampleTime)
+			if ok {
+				row.EstimatedCatchUp = eta.Round(time.Second).String()
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func newDomainReplicationStatusRows(c *cli.Context, statuses []*replication.ShardStatus) []DomainReplicationStatusRow {
+	type domainCluster struct {
+		domainID string
+		cluster  string
+	}
+	byDomain := make(map[domainCluster]*DomainReplicationStatusRow)
+	for _, status := range statuses {
+		for domainID, pendingTasks := range status.PendingTasksByDomain {
+			key := domainCluster{domainID, status.RemoteCluster}
+			row, ok := byDomain[key]
+			if !ok {
+				row = &DomainReplicationStatusRow{DomainID: domainID, RemoteCluster: status.RemoteCluster}
+				byDomain[key] = row
+			}
+			row.PendingTasks += pendingTasks
+			row.LaggingShards++
+			if lag := status.LagByDomain[domainID]; lag > row.Lag {
+				row.Lag = lag
+			}
+		}
+	}
+
+	var domainManager persistence.DomainManager
+	if len(byDomain) > 0 {
+		domainManager = initializeDomainManager(c)
+	}
+	rows := make([]DomainReplicationStatusRow, 0, len(byDomain))
+	for _, row := range byDomain {
+		ctx, cancel := newContext(c)
+		resp, err := domainManager.GetDomain(ctx, &persistence.GetDomainRequest{ID: row.DomainID})
+		cancel()
+		if err == nil {
+			row.Domain = resp.Info.Name
+		}
+		rows = append(rows, *row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Lag != rows[j].Lag {
+			return rows[i].Lag > rows[j].Lag
+		}
+		if rows[i].DomainID != rows[j].DomainID {
+			return rows[i].DomainID < rows[j].DomainID
+		}
+		return rows[i].RemoteCluster < rows[j].RemoteCluster
+	})
+	return rows
+}
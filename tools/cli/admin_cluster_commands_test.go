@@ -25,6 +25,7 @@ package cli
 import (
 	"flag"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -33,6 +34,7 @@ import (
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/replication"
 )
 
 func TestAdminAddSearchAttribute_isValueTypeValid(t *testing.T) {
@@ -131,3 +133,29 @@ func TestValidSearchAttributeKey(t *testing.T) {
 	assert.Error(t, validateSearchAttributeKey("9lives"))
 	assert.Error(t, validateSearchAttributeKey("tax%"))
 }
+
+func TestNewReplicationStatusRows(t *testing.T) {
+	now := time.Unix(1000, 0)
+	updatedAt := now.Add(-time.Second)
+	statuses := []*replication.ShardStatus{
+		{ShardID: 1, RemoteCluster: "c2", LastReplicatedTaskID: 10, ShardUpdatedTime: updatedAt, PendingTasks: 5, Lag: time.Minute},
+		{ShardID: 2, RemoteCluster: "c2", LastReplicatedTaskID: 20, ShardUpdatedTime: updatedAt, PendingTasks: 100, PendingTasksTruncated: true, Lag: time.Hour},
+	}
+	dlqSizes := map[types.HistoryDLQCountKey]int64{
+		{ShardID: 2, SourceCluster: "c2"}: 3,
+	}
+
+	rows := newReplicationStatusRows(statuses, nil, now, time.Time{}, dlqSizes)
+	assert.Equal(t, []ReplicationStatusRow{
+		{ShardID: 1, RemoteCluster: "c2", LastReplicatedTaskID: 10, ShardUpdated: updatedAt, PendingTasks: "5", Lag: time.Minute},
+		{ShardID: 2, RemoteCluster: "c2", LastReplicatedTaskID: 20, ShardUpdated: updatedAt, PendingTasks: "100+", Lag: time.Hour, DLQSize: 3},
+	}, rows)
+
+	previousStatuses := []*replication.ShardStatus{
+		{ShardID: 1, RemoteCluster: "c2", Lag: 2 * time.Minute},
+		{ShardID: 2, RemoteCluster: "c2", Lag: time.Minute},
+	}
+	rows = newReplicationStatusRows(statuses, previousStatuses, now, now.Add(-time.Minute), dlqSizes)
+	assert.Equal(t, "1m0s", rows[0].EstimatedCatchUp)
+	assert.Equal(t, "unknown", rows[1].EstimatedCatchUp)
+}
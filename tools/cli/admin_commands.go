@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
@@ -120,7 +121,11 @@ func AdminShowWorkflow(c *cli.Context) {
 // AdminDescribeWorkflow describe a new workflow execution for admin
 func AdminDescribeWorkflow(c *cli.Context) {
 
-	resp := describeMutableState(c)
+	resp := describeMutableState(c, c.Bool(FlagDiff) && c.Bool(FlagRebuild))
+	if c.Bool(FlagDiff) {
+		AdminDiffMutableState(c, resp)
+		return
+	}
 	prettyPrintJSONObject(resp)
 
 	if resp != nil {
@@ -155,10 +160,18 @@ func AdminDescribeWorkflow(c *cli.Context) {
 				fmt.Println(p.GetBinaryChecksum(), p.GetRunID(), p.GetFirstDecisionCompletedID(), p.GetResettable(), createT, expireT)
 			}
 		}
+		records, err := ndc.GetConflictResolutionRecords(ms.ExecutionInfo.ConflictResolutions)
+		if err != nil {
+			ErrorAndExit("Failed to decode conflict resolution record", err)
+		}
+		if len(records) > 0 {
+			fmt.Println("conflict-resolution-record:")
+			prettyPrintJSONObject(records)
+		}
 	}
 }
 
-func describeMutableState(c *cli.Context) *types.AdminDescribeWorkflowExecutionResponse {
+func describeMutableState(c *cli.Context, rebuild bool) *types.AdminDescribeWorkflowExecutionResponse {
 	adminClient := cFactory.ServerAdminClient(c)
 
 	domain := getRequiredGlobalOption(c, FlagDomain)
@@ -176,6 +189,7 @@ func describeMutableState(c *cli.Context) *types.AdminDescribeWorkflowExecutionR
 				WorkflowID: wid,
 				RunID:      rid,
 			},
+			RebuildFromHistory: rebuild,
 		},
 	)
 	if err != nil {
@@ -243,7 +257,7 @@ func AdminDeleteWorkflow(c *cli.Context) {
 		return
 	}
 
-	resp := describeMutableState(c)
+	resp := describeMutableState(c, false)
 	msStr := resp.GetMutableStateInDatabase()
 	ms := persistence.WorkflowMutableState{}
 	err := json.Unmarshal([]byte(msStr), &ms)
@@ -517,19 +531,129 @@ func AdminDescribeShardDistribution(c *cli.Context) {
 func AdminDescribeHistoryHost(c *cli.Context) {
 	adminClient := cFactory.ServerAdminClient(c)
 
-	wid := c.String(FlagWorkflowID)
-	sid := c.Int(FlagShardID)
-	addr := c.String(FlagHistoryAddress)
 	printFully := c.Bool(FlagPrintFullyDetail)
+	req := newDescribeHistoryHostRequest(c)
 
-	if len(wid) == 0 && !c.IsSet(FlagShardID) && len(addr) == 0 {
-		ErrorAndExit("at least one of them is required to provide to lookup host: workflowID, shardID and host address", nil)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeHistoryHost(ctx, req)
+	if err != nil {
+		ErrorAndExit("Describe history host failed", err)
+	}
+
+	if !printFully {
+		resp.ShardIDs = nil
+	}
+	prettyPrintJSONObject(resp)
+}
+
+// ThrottledWorkflowIDRow is a workflow ID recently throttled by the per workflow ID rate limits of a history host
+type ThrottledWorkflowIDRow struct {
+	DomainName    string    `header:"Domain" json:"domainName"`
+	WorkflowID    string    `header:"Workflow ID" json:"workflowID"`
+	Limit         string    `header:"Limit" json:"limit"`
+	Count         int64     `header:"Count" json:"count"`
+	LastThrottled time.Time `header:"Last Throttled" json:"lastThrottled"`
+}
+
+// AdminListThrottledWorkflowIDs lists the workflow IDs recently throttled by the per workflow ID
+// rate limits of a history host, whether or not the rate limits are enforced
+func AdminListThrottledWorkflowIDs(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	req := newDescribeHistoryHostRequest(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	host, err := adminClient.DescribeHistoryHost(ctx, req)
+	if err != nil {
+		ErrorAndExit("Describe history host failed", err)
+	}
+	if len(host.ShardIDs) == 0 {
+		ErrorAndExit(fmt.Sprintf("History host %v owns no shard", host.Address), nil)
 		return
 	}
 
+	// the request is served by the host owning the shard
+	resp, err := adminClient.ListThrottledWorkflowIDs(ctx, &types.ListThrottledWorkflowIDsRequest{
+		ShardID: host.ShardIDs[0],
+	})
+	if err != nil {
+		ErrorAndExit("Failed to list throttled workflow IDs", err)
+	}
+
+	rows := make([]ThrottledWorkflowIDRow, 0, len(resp.ThrottledWorkflowIDs))
+	for _, throttled := range resp.ThrottledWorkflowIDs {
+		rows = append(rows, ThrottledWorkflowIDRow{
+			DomainName:    throttled.DomainName,
+			WorkflowID:    throttled.WorkflowID,
+			Limit:         throttled.Limit,
+			Count:         throttled.Count,
+			LastThrottled: time.Unix(0, throttled.GetLastThrottledTimestamp()),
+		})
+	}
+	Render(c, rows, RenderOptions{DefaultTemplate: templateTable, Color: true})
+}
+
+// WorkflowUsageRow is the workflow usage of a domain and workflow type recorded by the history hosts
+type WorkflowUsageRow struct {
+	WorkflowType    string `header:"Workflow Type" json:"workflowType"`
+	DecisionTasks   int64  `header:"Decision Tasks" json:"decisionTasks"`
+	ActivityTasks   int64  `header:"Activity Tasks" json:"activityTasks"`
+	LocalActivities int64  `header:"Local Activities" json:"localActivities"`
+	Signals         int64  `header:"Signals" json:"signals"`
+	Timers          int64  `header:"Timers" json:"timers"`
+	HistoryBytes    int64  `header:"History Bytes" json:"historyBytes"`
+}
+
+// AdminDomainUsage shows the workflow usage of a domain per workflow type
+func AdminDomainUsage(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domainName := getRequiredGlobalOption(c, FlagDomain)
+	now := time.Now()
+	startTime := parseTime(c.String(FlagEarliestTime), now.Add(-24*time.Hour).UnixNano())
+	endTime := parseTime(c.String(FlagLatestTime), now.UnixNano())
+
 	ctx, cancel := newContext(c)
 	defer cancel()
 
+	resp, err := adminClient.GetDomainUsage(ctx, &types.GetDomainUsageRequest{
+		Domain:         domainName,
+		StartTimestamp: common.Int64Ptr(startTime),
+		EndTimestamp:   common.Int64Ptr(endTime),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to get domain usage", err)
+	}
+
+	rows := make([]WorkflowUsageRow, 0, len(resp.Usage))
+	for _, usage := range resp.Usage {
+		rows = append(rows, WorkflowUsageRow{
+			WorkflowType:    usage.WorkflowType,
+			DecisionTasks:   usage.DecisionTasks,
+			ActivityTasks:   usage.ActivityTasks,
+			LocalActivities: usage.LocalActivities,
+			Signals:         usage.Signals,
+			Timers:          usage.Timers,
+			HistoryBytes:    usage.HistoryBytes,
+		})
+	}
+	Render(c, rows, RenderOptions{DefaultTemplate: templateTable, Color: true})
+}
+
+// newDescribeHistoryHostRequest looks up the history host by workflow ID, shard ID or address
+func newDescribeHistoryHostRequest(c *cli.Context) *types.DescribeHistoryHostRequest {
+	wid := c.String(FlagWorkflowID)
+	sid := c.Int(FlagShardID)
+	addr := c.String(FlagHistoryAddress)
+
+	if len(wid) == 0 && !c.IsSet(FlagShardID) && len(addr) == 0 {
+		ErrorAndExit("at least one of them is required to provide to lookup host: workflowID, shardID and host address", nil)
+	}
+
 	req := &types.DescribeHistoryHostRequest{}
 	if len(wid) > 0 {
 		req.ExecutionForHost = &types.WorkflowExecution{WorkflowID: wid}
@@ -540,16 +664,7 @@ func AdminDescribeHistoryHost(c *cli.Context) {
 	if len(addr) > 0 {
 		req.HostAddress = common.StringPtr(addr)
 	}
-
-	resp, err := adminClient.DescribeHistoryHost(ctx, req)
-	if err != nil {
-		ErrorAndExit("Describe history host failed", err)
-	}
-
-	if !printFully {
-		resp.ShardIDs = nil
-	}
-	prettyPrintJSONObject(resp)
+	return req
 }
 
 // AdminRefreshWorkflowTasks refreshes all the tasks of a workflow
@@ -623,7 +738,5 @@ func AdminDescribeQueue(c *cli.Context) {
 		ErrorAndExit("Failed to describe queue", err)
 	}
 
-	for _, state := range resp.ProcessingQueueStates {
-		fmt.Println(state)
-	}
+	renderProcessingQueues(c, resp.ProcessingQueueStates)
 }
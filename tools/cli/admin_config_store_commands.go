@@ -360,3 +360,74 @@ func parseInputFilterArray(inputFilters []string) ([]*types.DynamicConfigFilter,
 
 	return parsedFilters, nil
 }
+
+func getRequiredDomainID(c *cli.Context) string {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := frontendClient.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: &domain})
+	if err != nil {
+		ErrorAndExit("Failed to describe domain", err)
+	}
+	return resp.GetDomainInfo().GetUUID()
+}
+
+// addBoolDynamicConfigValue returns the values of the given bool config with a true value
+// added for the given filters, or nil if the config is already true for those filters.
+func addBoolDynamicConfigValue(
+	resp *types.ListDynamicConfigResponse,
+	configName string,
+	filters []*types.DynamicConfigFilter,
+) ([]*types.DynamicConfigValue, error) {
+	values := getDynamicConfigValues(resp, configName)
+
+	encodedTrue, err := json.Marshal(true)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		if sameFilters(value.Filters, filters) && string(value.Value.GetData()) == string(encodedTrue) {
+			return nil, nil
+		}
+	}
+
+	return append(values, &types.DynamicConfigValue{
+		Value: &types.DataBlob{
+			EncodingType: types.EncodingTypeJSON.Ptr(),
+			Data:         encodedTrue,
+		},
+		Filters: filters,
+	}), nil
+}
+
+func getDynamicConfigValues(
+	resp *types.ListDynamicConfigResponse,
+	configName string,
+) []*types.DynamicConfigValue {
+	var values []*types.DynamicConfigValue
+	if resp != nil {
+		for _, entry := range resp.Entries {
+			// the config store returns all entries when the key has no values yet
+			if entry.Name != configName {
+				continue
+			}
+			values = append(values, entry.Values...)
+		}
+	}
+	return values
+}
+
+func sameFilters(left, right []*types.DynamicConfigFilter) bool {
+	if len(left) != len(right) {
+		return false
+	}
+	for i := range left {
+		if left[i].Name != right[i].Name || string(left[i].Value.GetData()) != string(right[i].Value.GetData()) {
+			return false
+		}
+	}
+	return true
+}
@@ -32,7 +32,10 @@ import (
 
 	"github.com/urfave/cli"
 
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dlq"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
@@ -49,6 +52,7 @@ type DLQRow struct {
 	RunID           string                     `header:"Run ID" json:"runID"`
 	TaskID          int64                      `header:"Task ID" json:"taskID"`
 	TaskType        *types.ReplicationTaskType `header:"Task Type" json:"taskType"`
+	ErrorClass      string                     `header:"Error Class" json:"errorClass,omitempty"`
 	Version         int64                      `json:"version"`
 	FirstEventID    int64                      `json:"firstEventID"`
 	NextEventID     int64                      `json:"nextEventID"`
@@ -122,114 +126,243 @@ func AdminCountDLQMessages(c *cli.Context) {
 
 // AdminGetDLQMessages gets DLQ metadata
 func AdminGetDLQMessages(c *cli.Context) {
-	ctx, cancel := newContext(c)
-	defer cancel()
-
-	client := cFactory.ServerFrontendClient(c)
-	adminClient := cFactory.ServerAdminClient(c)
-
-	dlqType := toQueueType(getRequiredOption(c, FlagDLQType))
-	sourceCluster := getRequiredOption(c, FlagSourceCluster)
-
 	remainingMessageCount := common.EndMessageID
 	if c.IsSet(FlagMaxMessageCount) {
 		remainingMessageCount = c.Int64(FlagMaxMessageCount)
 	}
-	lastMessageID := common.EndMessageID
-	if c.IsSet(FlagLastMessageID) {
-		lastMessageID = c.Int64(FlagLastMessageID)
+
+	reader := newDLQReader(c)
+	table := []DLQRow{}
+	for shardID := range getShards(c) {
+		if remainingMessageCount <= 0 {
+			break
+		}
+		rows := reader.readShard(shardID, reader.filter, remainingMessageCount)
+		remainingMessageCount -= int64(len(rows))
+		table = append(table, rows...)
 	}
 
-	// Cache for domain names
-	domainNames := map[string]string{}
-	getDomainName := func(domainId string) string {
-		if domainName, ok := domainNames[domainId]; ok {
-			return domainName
+	Render(c, table, RenderOptions{DefaultTemplate: templateTable, Color: true})
+}
+
+// DLQSummaryRow groups DLQ messages of the same domain, task type and error class
+type DLQSummaryRow struct {
+	DomainName string `header:"Domain Name" json:"domainName"`
+	TaskType   string `header:"Task Type" json:"taskType"`
+	ErrorClass string `header:"Error Class" json:"errorClass"`
+	Count      int    `header:"Count" json:"count"`
+	Shards     int    `header:"Shards" json:"shards"`
+	Workflows  int    `header:"Workflows" json:"workflows"`
+}
+
+// AdminSummarizeDLQMessages groups DLQ messages of all given shards by domain, task type and error class.
+// Error classes are only known for history DLQ messages.
+func AdminSummarizeDLQMessages(c *cli.Context) {
+	type summaryKey struct {
+		domainName string
+		taskType   string
+		errorClass string
+	}
+	summaries := map[summaryKey]*DLQSummaryRow{}
+	shards := map[summaryKey]map[int]struct{}{}
+	workflows := map[summaryKey]map[string]struct{}{}
+
+	reader := newDLQReader(c)
+	for shardID := range getShards(c) {
+		for _, row := range reader.readShard(shardID, reader.filter, common.EndMessageID) {
+			key := summaryKey{domainName: row.DomainName, taskType: "unknown", errorClass: row.ErrorClass}
+			if row.TaskType != nil {
+				key.taskType = row.TaskType.String()
+			}
+			summary, ok := summaries[key]
+			if !ok {
+				summary = &DLQSummaryRow{DomainName: key.domainName, TaskType: key.taskType, ErrorClass: key.errorClass}
+				summaries[key] = summary
+				shards[key] = map[int]struct{}{}
+				workflows[key] = map[string]struct{}{}
+			}
+			summary.Count++
+			shards[key][row.ShardID] = struct{}{}
+			workflows[key][row.WorkflowID] = struct{}{}
+		}
+	}
+
+	table := make([]DLQSummaryRow, 0, len(summaries))
+	for key, summary := range summaries {
+		summary.Shards = len(shards[key])
+		summary.Workflows = len(workflows[key])
+		table = append(table, *summary)
+	}
+	sort.Slice(table, func(i, j int) bool {
+		if table[i].Count != table[j].Count {
+			return table[i].Count > table[j].Count
+		}
+		if table[i].DomainName != table[j].DomainName {
+			return table[i].DomainName < table[j].DomainName
+		}
+		if table[i].TaskType != table[j].TaskType {
+			return table[i].TaskType < table[j].TaskType
 		}
+		return table[i].ErrorClass < table[j].ErrorClass
+	})
+
+	Render(c, table, RenderOptions{DefaultTemplate: templateTable, Color: true})
+}
 
-		resp, err := client.DescribeDomain(ctx, &types.DescribeDomainRequest{UUID: common.StringPtr(domainId)})
+// newDLQFilter returns the filter of the filter flags, messages are filtered by the history or frontend service
+func newDLQFilter(c *cli.Context, dlqType *types.DLQType) *types.DLQFilter {
+	filter := &types.DLQFilter{
+		WorkflowID: c.String(FlagWorkflowID),
+		ErrorClass: c.String(FlagErrorClass),
+	}
+	if c.IsSet(FlagTaskType) {
+		var taskType types.ReplicationTaskType
+		if err := taskType.UnmarshalText([]byte(c.String(FlagTaskType))); err != nil {
+			ErrorAndExit("Invalid replication task type.", err)
+		}
+		filter.TaskType = &taskType
+	}
+	if filter.ErrorClass != "" && !isValidDLQErrorClass(filter.ErrorClass) {
+		ErrorAndExit(fmt.Sprintf("Invalid error class, valid classes are: %v.", strings.Join(dlq.AllErrorClasses, ", ")), nil)
+	}
+	if dlqType != nil && *dlqType == types.DLQTypeDomain && (filter.WorkflowID != "" || filter.TaskType != nil || filter.ErrorClass != "") {
+		ErrorAndExit("Domain DLQ messages can only be filtered by domain.", nil)
+	}
+
+	if c.IsSet(FlagDomain) {
+		ctx, cancel := newContext(c)
+		defer cancel()
+		resp, err := cFactory.ServerFrontendClient(c).DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(c.String(FlagDomain))})
 		if err != nil {
 			ErrorAndExit("failed to describe domain", err)
 		}
-		domainNames[domainId] = resp.DomainInfo.Name
-		return resp.DomainInfo.Name
+		filter.DomainID = resp.GetDomainInfo().GetUUID()
 	}
+	return filter
+}
 
-	readShard := func(shardID int) []DLQRow {
-		var rows []DLQRow
-		var pageToken []byte
+func isValidDLQErrorClass(errorClass string) bool {
+	for _, class := range dlq.AllErrorClasses {
+		if class == errorClass {
+			return true
+		}
+	}
+	return false
+}
 
-		for {
-			resp, err := adminClient.ReadDLQMessages(ctx, &types.ReadDLQMessagesRequest{
-				Type:                  dlqType,
-				SourceCluster:         sourceCluster,
-				ShardID:               int32(shardID),
-				InclusiveEndMessageID: common.Int64Ptr(lastMessageID),
-				MaximumPageSize:       defaultPageSize,
-				NextPageToken:         pageToken,
-			})
-			if err != nil {
-				ErrorAndExit(fmt.Sprintf("fail to read dlq message for shard: %d", shardID), err)
-			}
+// dlqReader reads DLQ messages of a shard
+type dlqReader struct {
+	c             *cli.Context
+	client        frontend.Client
+	adminClient   admin.Client
+	dlqType       *types.DLQType
+	sourceCluster string
+	lastMessageID int64
+	filter        *types.DLQFilter
+	// cache for domain names
+	domainNames map[string]string
+}
+
+func newDLQReader(c *cli.Context) *dlqReader {
+	lastMessageID := common.EndMessageID
+	if c.IsSet(FlagLastMessageID) {
+		lastMessageID = c.Int64(FlagLastMessageID)
+	}
+	dlqType := toQueueType(getRequiredOption(c, FlagDLQType))
+	return &dlqReader{
+		c:             c,
+		client:        cFactory.ServerFrontendClient(c),
+		adminClient:   cFactory.ServerAdminClient(c),
+		dlqType:       dlqType,
+		sourceCluster: getRequiredOption(c, FlagSourceCluster),
+		lastMessageID: lastMessageID,
+		filter:        newDLQFilter(c, dlqType),
+		domainNames:   map[string]string{},
+	}
+}
+
+func (r *dlqReader) getDomainName(domainID string) string {
+	if domainName, ok := r.domainNames[domainID]; ok {
+		return domainName
+	}
+
+	ctx, cancel := newContext(r.c)
+	defer cancel()
+	resp, err := r.client.DescribeDomain(ctx, &types.DescribeDomainRequest{UUID: common.StringPtr(domainID)})
+	if err != nil {
+		ErrorAndExit("failed to describe domain", err)
+		return ""
+	}
+	r.domainNames[domainID] = resp.DomainInfo.Name
+	return resp.DomainInfo.Name
+}
+
+// readShard returns up to maxCount messages of the shard which match the filter
+func (r *dlqReader) readShard(shardID int, filter *types.DLQFilter, maxCount int64) []DLQRow {
+	var rows []DLQRow
+	var pageToken []byte
+	for {
+		ctx, cancel := newContext(r.c)
+		resp, err := r.adminClient.ReadDLQMessages(ctx, &types.ReadDLQMessagesRequest{
+			Type:                  r.dlqType,
+			SourceCluster:         r.sourceCluster,
+			ShardID:               int32(shardID),
+			InclusiveEndMessageID: common.Int64Ptr(r.lastMessageID),
+			MaximumPageSize:       defaultPageSize,
+			NextPageToken:         pageToken,
+			Filter:                filter,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("fail to read dlq message for shard: %d", shardID), err)
+			return rows
+		}
+
+		replicationTasks := map[int64]*types.ReplicationTask{}
+		for _, task := range resp.ReplicationTasks {
+			replicationTasks[task.SourceTaskID] = task
+		}
 
-			replicationTasks := map[int64]*types.ReplicationTask{}
-			for _, task := range resp.ReplicationTasks {
-				replicationTasks[task.SourceTaskID] = task
+		for _, info := range resp.ReplicationTasksInfo {
+			task := replicationTasks[info.TaskID]
+
+			var taskType *types.ReplicationTaskType
+			if task != nil {
+				taskType = task.TaskType
 			}
 
-			for _, info := range resp.ReplicationTasksInfo {
-				task := replicationTasks[info.TaskID]
-
-				var taskType *types.ReplicationTaskType
-				if task != nil {
-					taskType = task.TaskType
-				}
-
-				events := deserializeBatchEvents(task.GetHistoryTaskV2Attributes().GetEvents())
-				newRunEvents := deserializeBatchEvents(task.GetHistoryTaskV2Attributes().GetNewRunEvents())
-
-				rows = append(rows, DLQRow{
-					ShardID:         shardID,
-					DomainName:      getDomainName(info.DomainID),
-					DomainID:        info.DomainID,
-					WorkflowID:      info.WorkflowID,
-					RunID:           info.RunID,
-					TaskType:        taskType,
-					TaskID:          info.TaskID,
-					Version:         info.Version,
-					FirstEventID:    info.FirstEventID,
-					NextEventID:     info.NextEventID,
-					ScheduledID:     info.ScheduledID,
-					ReplicationTask: task,
-					Events:          events,
-					EventIDs:        collectEventIDs(events),
-					NewRunEvents:    newRunEvents,
-					NewRunEventIDs:  collectEventIDs(newRunEvents),
-				})
-
-				remainingMessageCount--
-				if remainingMessageCount <= 0 {
-					return rows
-				}
+			row := DLQRow{
+				ShardID:         shardID,
+				DomainName:      r.getDomainName(info.DomainID),
+				DomainID:        info.DomainID,
+				WorkflowID:      info.WorkflowID,
+				RunID:           info.RunID,
+				TaskType:        taskType,
+				ErrorClass:      info.ErrorClass,
+				TaskID:          info.TaskID,
+				Version:         info.Version,
+				FirstEventID:    info.FirstEventID,
+				NextEventID:     info.NextEventID,
+				ScheduledID:     info.ScheduledID,
+				ReplicationTask: task,
+				Events:          deserializeBatchEvents(task.GetHistoryTaskV2Attributes().GetEvents()),
+				NewRunEvents:    deserializeBatchEvents(task.GetHistoryTaskV2Attributes().GetNewRunEvents()),
 			}
+			row.EventIDs = collectEventIDs(row.Events)
+			row.NewRunEventIDs = collectEventIDs(row.NewRunEvents)
+			rows = append(rows, row)
 
-			if len(resp.NextPageToken) == 0 {
-				break
+			if int64(len(rows)) >= maxCount {
+				return rows
 			}
-			pageToken = resp.NextPageToken
 		}
-		return rows
-	}
 
-	table := []DLQRow{}
-	for shardID := range getShards(c) {
-		if remainingMessageCount <= 0 {
+		if len(resp.NextPageToken) == 0 {
 			break
 		}
-		table = append(table, readShard(shardID)...)
+		pageToken = resp.NextPageToken
 	}
-
-	Render(c, table, RenderOptions{DefaultTemplate: templateTable, Color: true})
+	return rows
 }
 
 // AdminPurgeDLQMessages deletes messages from DLQ
@@ -260,24 +393,27 @@ func AdminPurgeDLQMessages(c *cli.Context) {
 	}
 }
 
-// AdminMergeDLQMessages merges message from DLQ
+// AdminMergeDLQMessages merges message from DLQ. With filter flags set, only matching messages are merged
+// and removed, the others stay in the DLQ.
 func AdminMergeDLQMessages(c *cli.Context) {
-	dlqType := getRequiredOption(c, FlagDLQType)
+	dlqType := toQueueType(getRequiredOption(c, FlagDLQType))
 	sourceCluster := getRequiredOption(c, FlagSourceCluster)
 	var lastMessageID *int64
 	if c.IsSet(FlagLastMessageID) {
 		lastMessageID = common.Int64Ptr(c.Int64(FlagLastMessageID))
 	}
+	filter := newDLQFilter(c, dlqType)
 
 	adminClient := cFactory.ServerAdminClient(c)
 ShardIDLoop:
 	for shardID := range getShards(c) {
 		request := &types.MergeDLQMessagesRequest{
-			Type:                  toQueueType(dlqType),
+			Type:                  dlqType,
 			SourceCluster:         sourceCluster,
 			ShardID:               int32(shardID),
 			InclusiveEndMessageID: lastMessageID,
 			MaximumPageSize:       defaultPageSize,
+			Filter:                filter,
 		}
 
 		for {
@@ -0,0 +1,239 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// workflowExportFormatVersion is the version of WorkflowExport, bump it on incompatible changes
+	workflowExportFormatVersion = 1
+)
+
+// WorkflowExport is the portable state of a single workflow run. An export file holds one JSON encoded
+// WorkflowExport per line.
+type WorkflowExport struct {
+	FormatVersion int    `json:"formatVersion"`
+	Domain        string `json:"domain"`
+	DomainID      string `json:"domainID"`
+	WorkflowID    string `json:"workflowID"`
+	RunID         string `json:"runID"`
+	// VersionHistory is the version history of the current branch, matching HistoryBatches
+	VersionHistory *types.VersionHistory `json:"versionHistory"`
+	// HistoryBatches are the serialized event batches of the current branch, as stored by the source cluster
+	HistoryBatches []*types.DataBlob `json:"historyBatches"`
+	// MutableState is the mutable state of the workflow as stored by the source cluster, including all its
+	// version histories
+	MutableState json.RawMessage `json:"mutableState"`
+}
+
+// AdminExportWorkflow exports one workflow, or all open workflows of the domain, to a file
+func AdminExportWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	outputFileName := getRequiredOption(c, FlagOutputFilename)
+
+	var executions []*types.WorkflowExecution
+	if c.IsSet(FlagWorkflowID) {
+		executions = append(executions, &types.WorkflowExecution{
+			WorkflowID: c.String(FlagWorkflowID),
+			RunID:      c.String(FlagRunID),
+		})
+	} else {
+		executions = listOpenExecutions(c, domain)
+	}
+
+	file, err := os.Create(outputFileName)
+	if err != nil {
+		ErrorAndExit("Failed to create export file.", err)
+	}
+	defer file.Close()
+	writer := bufio.NewWriter(file)
+
+	for _, execution := range executions {
+		export := exportWorkflow(c, domain, execution)
+		data, err := json.Marshal(export)
+		if err != nil {
+			ErrorAndExit("Failed to serialize workflow export.", err)
+		}
+		if _, err := writer.Write(append(data, '\n')); err != nil {
+			ErrorAndExit("Failed to write export file.", err)
+		}
+		fmt.Printf("Exported workflow %v, run %v with %v history batches.\n", export.WorkflowID, export.RunID, len(export.HistoryBatches))
+	}
+	if err := writer.Flush(); err != nil {
+		ErrorAndExit("Failed to write export file.", err)
+	}
+	fmt.Printf("Exported %v workflows to %v.\n", len(executions), outputFileName)
+}
+
+// AdminImportWorkflow imports the workflows of an export file. The history batches of each workflow are applied in
+// order through the same path as replicated events, so a workflow which was already imported is left unchanged.
+func AdminImportWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	inputFileName := getRequiredOption(c, FlagInputFile)
+	adminClient := cFactory.ServerAdminClient(c)
+
+	file, err := os.Open(inputFileName)
+	if err != nil {
+		ErrorAndExit("Failed to open export file.", err)
+	}
+	defer file.Close()
+	reader := bufio.NewReader(file)
+
+	imported := 0
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			ErrorAndExit("Failed to read export file.", err)
+		}
+		if len(bytes.TrimSpace(line)) > 0 {
+			var export WorkflowExport
+			if err := json.Unmarshal(line, &export); err != nil {
+				ErrorAndExit("Failed to parse workflow export.", err)
+			}
+			if export.FormatVersion != workflowExportFormatVersion {
+				ErrorAndExit(fmt.Sprintf("Workflow export format version %v is not supported.", export.FormatVersion), nil)
+			}
+			if export.VersionHistory == nil {
+				ErrorAndExit(fmt.Sprintf("Workflow %v has no version history in the export file.", export.WorkflowID), nil)
+			}
+
+			execution := &types.WorkflowExecution{WorkflowID: export.WorkflowID, RunID: export.RunID}
+			for _, batch := range export.HistoryBatches {
+				ctx, cancel := newContext(c)
+				err := adminClient.ImportWorkflowExecution(ctx, &types.ImportWorkflowExecutionRequest{
+					Domain:              domain,
+					WorkflowExecution:   execution,
+					VersionHistoryItems: export.VersionHistory.Items,
+					Events:              batch,
+				})
+				cancel()
+				if err != nil {
+					ErrorAndExit(fmt.Sprintf("Failed to import workflow %v.", export.WorkflowID), err)
+				}
+			}
+			imported++
+			fmt.Printf("Imported workflow %v, run %v with %v history batches.\n", export.WorkflowID, export.RunID, len(export.HistoryBatches))
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+	fmt.Printf("Imported %v workflows from %v.\n", imported, inputFileName)
+}
+
+func listOpenExecutions(c *cli.Context, domain string) []*types.WorkflowExecution {
+	client := cFactory.ServerFrontendClient(c)
+
+	var executions []*types.WorkflowExecution
+	var token []byte
+	for more := true; more; more = len(token) > 0 {
+		ctx, cancel := newContextForLongPoll(c)
+		response, err := client.ListOpenWorkflowExecutions(ctx, &types.ListOpenWorkflowExecutionsRequest{
+			Domain:          domain,
+			MaximumPageSize: int32(defaultPageSizeForList),
+			NextPageToken:   token,
+			StartTimeFilter: &types.StartTimeFilter{
+				EarliestTime: common.Int64Ptr(0),
+				LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+			},
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to list open workflows.", err)
+		}
+		for _, info := range response.GetExecutions() {
+			executions = append(executions, info.GetExecution())
+		}
+		token = response.NextPageToken
+	}
+	return executions
+}
+
+func exportWorkflow(c *cli.Context, domain string, execution *types.WorkflowExecution) *WorkflowExport {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	ctx, cancel := newContext(c)
+	describeResp, err := adminClient.DescribeWorkflowExecution(ctx, &types.AdminDescribeWorkflowExecutionRequest{
+		Domain:    domain,
+		Execution: execution,
+	})
+	cancel()
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to describe workflow %v.", execution.GetWorkflowID()), err)
+	}
+
+	var mutableState struct {
+		ExecutionInfo struct {
+			DomainID string
+			RunID    string
+		}
+	}
+	if err := json.Unmarshal([]byte(describeResp.GetMutableStateInDatabase()), &mutableState); err != nil {
+		ErrorAndExit("Failed to parse workflow mutable state.", err)
+	}
+
+	export := &WorkflowExport{
+		FormatVersion: workflowExportFormatVersion,
+		Domain:        domain,
+		DomainID:      mutableState.ExecutionInfo.DomainID,
+		WorkflowID:    execution.GetWorkflowID(),
+		RunID:         mutableState.ExecutionInfo.RunID,
+		MutableState:  json.RawMessage(describeResp.GetMutableStateInDatabase()),
+	}
+
+	// pin the run, so that all pages are read from the same run even if it continues as new meanwhile
+	runExecution := &types.WorkflowExecution{WorkflowID: export.WorkflowID, RunID: export.RunID}
+	var token []byte
+	for more := true; more; more = len(token) > 0 {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.GetWorkflowExecutionRawHistoryV2(ctx, &types.GetWorkflowExecutionRawHistoryV2Request{
+			Domain:          domain,
+			Execution:       runExecution,
+			MaximumPageSize: defaultPageSizeForList,
+			NextPageToken:   token,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to read history of workflow %v.", execution.GetWorkflowID()), err)
+		}
+		export.HistoryBatches = append(export.HistoryBatches, resp.HistoryBatches...)
+		if resp.VersionHistory != nil {
+			export.VersionHistory = resp.VersionHistory
+		}
+		token = resp.NextPageToken
+	}
+	return export
+}
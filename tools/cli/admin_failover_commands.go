@@ -53,6 +53,13 @@ type startParams struct {
 	domains                        []string
 	drillWaitTime                  int
 	cron                           string
+	maxReplicationLagInSeconds     int
+	healthChecks                   bool
+	maxBatchFailureRate            float64
+	canaryDomains                  []string
+	canaryWaitTimeInSeconds        int
+	rollbackOnFailure              bool
+	dryRun                         bool
 }
 
 // AdminFailoverStart start failover workflow
@@ -67,6 +74,13 @@ func AdminFailoverStart(c *cli.Context) {
 		domains:                        c.StringSlice(FlagFailoverDomains),
 		drillWaitTime:                  c.Int(FlagFailoverDrillWaitTime),
 		cron:                           c.String(FlagCronSchedule),
+		maxReplicationLagInSeconds:     c.Int(FlagMaxReplicationLagSeconds),
+		healthChecks:                   c.Bool(FlagHealthChecks),
+		maxBatchFailureRate:            c.Float64(FlagMaxBatchFailureRate),
+		canaryDomains:                  c.StringSlice(FlagCanaryDomains),
+		canaryWaitTimeInSeconds:        c.Int(FlagCanaryWaitTime),
+		rollbackOnFailure:              c.Bool(FlagRollbackOnFailure),
+		dryRun:                         c.Bool(FlagDryRun),
 	}
 	failoverStart(c, params)
 }
@@ -283,11 +297,9 @@ func failoverStart(c *cli.Context, params *startParams) {
 	if params.drillWaitTime > 0 {
 		request.WorkflowID = failovermanager.DrillWorkflowID
 		request.CronSchedule = params.cron
-	} else {
-		if len(params.cron) > 0 {
-			ErrorAndExit("The drill wait time is required when cron is specified.", nil)
-		}
-
+	} else if len(params.cron) > 0 {
+		ErrorAndExit("The drill wait time is required when cron is specified.", nil)
+	} else if !params.dryRun {
 		// block if there is an on-going failover drill
 		if err := executePauseOrResume(c, failovermanager.DrillWorkflowID, true); err != nil {
 			switch err.(type) {
@@ -311,6 +323,13 @@ func failoverStart(c *cli.Context, params *startParams) {
 		Domains:                          domains,
 		DrillWaitTime:                    drillWaitTime,
 		GracefulFailoverTimeoutInSeconds: gracefulFailoverTimeoutInSeconds,
+		MaxReplicationLag:                time.Duration(params.maxReplicationLagInSeconds) * time.Second,
+		EnableHealthChecks:               params.healthChecks,
+		MaxBatchFailureRate:              params.maxBatchFailureRate,
+		CanaryDomains:                    params.canaryDomains,
+		CanaryWaitTime:                   time.Duration(params.canaryWaitTimeInSeconds) * time.Second,
+		RollbackOnCheckFailure:           params.rollbackOnFailure,
+		DryRun:                           params.dryRun,
 	}
 	input, err := json.Marshal(foParams)
 	if err != nil {
@@ -0,0 +1,478 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+)
+
+const (
+	checksumStatusOK      = "ok"
+	checksumStatusMissing = "missing"
+
+	mutableStateDiffMissing = "<missing>"
+)
+
+// mutableStateRebuildIgnoredPaths are the fields which are not rebuilt from history
+var mutableStateRebuildIgnoredPaths = []string{
+	"Checksum",
+	"ExecutionInfo.LastUpdatedTimestamp",
+}
+
+type (
+	// MutableStateInspection is the structured view of the cached and persisted copies of a
+	// workflow's mutable state, and the differences between them. When requested, the mutable
+	// state rebuilt from history is compared with the persisted copy as well.
+	MutableStateInspection struct {
+		Cache       *MutableStateSummary         `json:"cache,omitempty"`
+		Database    *MutableStateSummary         `json:"database"`
+		Diff        []MutableStateDiffRow        `json:"diff"`
+		Rebuilt     *MutableStateSummary         `json:"rebuilt,omitempty"`
+		RebuildDiff []MutableStateRebuildDiffRow `json:"rebuildDiff,omitempty"`
+	}
+
+	// MutableStateSummary is the structured view of one copy of workflow mutable state
+	MutableStateSummary struct {
+		Execution        MutableStateExecutionRow  `json:"execution"`
+		Activities       []PendingActivityRow      `json:"activities"`
+		Timers           []PendingTimerRow         `json:"timers"`
+		ChildWorkflows   []PendingChildWorkflowRow `json:"childWorkflows"`
+		Signals          []PendingSignalRow        `json:"signals"`
+		VersionHistories []VersionHistoryRow       `json:"versionHistories"`
+	}
+
+	// MutableStateExecutionRow is the execution level information of workflow mutable state
+	MutableStateExecutionRow struct {
+		State               int    `header:"State" json:"state"`
+		CloseStatus         int    `header:"Close Status" json:"closeStatus"`
+		NextEventID         int64  `header:"Next Event ID" json:"nextEventID"`
+		LastProcessedEvent  int64  `header:"Last Processed Event" json:"lastProcessedEvent"`
+		DecisionScheduleID  int64  `header:"Decision Schedule ID" json:"decisionScheduleID"`
+		DecisionStartedID   int64  `header:"Decision Started ID" json:"decisionStartedID"`
+		DecisionAttempt     int64  `header:"Decision Attempt" json:"decisionAttempt"`
+		BufferedEventsCount int    `header:"Buffered Events" json:"bufferedEventsCount"`
+		Checksum            string `header:"Checksum" json:"checksum"`
+	}
+
+	// PendingActivityRow is a pending activity of workflow mutable state
+	PendingActivityRow struct {
+		ScheduleID      int64  `header:"Schedule ID" json:"scheduleID"`
+		ActivityID      string `header:"Activity ID" json:"activityID"`
+		StartedID       int64  `header:"Started ID" json:"startedID"`
+		Attempt         int32  `header:"Attempt" json:"attempt"`
+		CancelRequested bool   `header:"Cancel Requested" json:"cancelRequested"`
+		Version         int64  `header:"Version" json:"version"`
+	}
+
+	// PendingTimerRow is a pending user timer of workflow mutable state
+	PendingTimerRow struct {
+		TimerID    string    `header:"Timer ID" json:"timerID"`
+		StartedID  int64     `header:"Started ID" json:"startedID"`
+		ExpiryTime time.Time `header:"Expiry Time" json:"expiryTime"`
+		Version    int64     `header:"Version" json:"version"`
+	}
+
+	// PendingChildWorkflowRow is a pending child workflow of workflow mutable state
+	PendingChildWorkflowRow struct {
+		InitiatedID  int64  `header:"Initiated ID" json:"initiatedID"`
+		WorkflowID   string `header:"Workflow ID" json:"workflowID"`
+		RunID        string `header:"Run ID" json:"runID"`
+		WorkflowType string `header:"Workflow Type" json:"workflowType"`
+		Version      int64  `header:"Version" json:"version"`
+	}
+
+	// PendingSignalRow is a pending external signal of workflow mutable state
+	PendingSignalRow struct {
+		InitiatedID int64  `header:"Initiated ID" json:"initiatedID"`
+		SignalName  string `header:"Signal Name" json:"signalName"`
+		Version     int64  `header:"Version" json:"version"`
+	}
+
+	// VersionHistoryRow is a version history branch of workflow mutable state
+	VersionHistoryRow struct {
+		Index   int    `header:"Index" json:"index"`
+		Current bool   `header:"Current" json:"current"`
+		Items   string `header:"Items (EventID:Version)" json:"items"`
+	}
+
+	// MutableStateDiffRow is a field which differs between the cached and persisted mutable state
+	MutableStateDiffRow struct {
+		Path     string `header:"Path" json:"path"`
+		Cache    string `header:"Cache" json:"cache"`
+		Database string `header:"Database" json:"database"`
+	}
+
+	// MutableStateRebuildDiffRow is a field which differs between the mutable state rebuilt from history
+	// and the persisted mutable state
+	MutableStateRebuildDiffRow struct {
+		Path     string `header:"Path" json:"path"`
+		Rebuilt  string `header:"Rebuilt" json:"rebuilt"`
+		Database string `header:"Database" json:"database"`
+	}
+)
+
+// AdminDiffMutableState prints the structured view of the cached and persisted mutable state
+// of a workflow, with checksum status of each copy and the fields that differ between them.
+// If the response holds the mutable state rebuilt from history, it is compared with the
+// persisted mutable state too.
+func AdminDiffMutableState(c *cli.Context, resp *types.AdminDescribeWorkflowExecutionResponse) {
+	inspection, err := newMutableStateInspection(resp)
+	if err != nil {
+		ErrorAndExit("Failed to inspect mutable state", err)
+	}
+
+	if c.String(FlagFormat) == formatJSON {
+		prettyPrintJSONObject(inspection)
+		return
+	}
+
+	renderOpts := RenderOptions{DefaultTemplate: templateTable, Color: true, Border: true}
+	if inspection.Cache != nil {
+		fmt.Println("Mutable state in cache:")
+		renderMutableStateSummary(c, inspection.Cache, renderOpts)
+	} else {
+		fmt.Println("Mutable state is not in cache")
+	}
+	fmt.Println("Mutable state in database:")
+	renderMutableStateSummary(c, inspection.Database, renderOpts)
+
+	if inspection.Cache != nil {
+		if len(inspection.Diff) == 0 {
+			fmt.Println("Cached and persisted mutable state are identical")
+		} else {
+			fmt.Println("Differences between cached and persisted mutable state:")
+			Render(c, inspection.Diff, renderOpts)
+		}
+	}
+
+	if inspection.Rebuilt == nil {
+		return
+	}
+	fmt.Println("Mutable state rebuilt from history:")
+	renderMutableStateSummary(c, inspection.Rebuilt, renderOpts)
+	if len(inspection.RebuildDiff) == 0 {
+		fmt.Println("Rebuilt and persisted mutable state are identical")
+		return
+	}
+	fmt.Println("Differences between rebuilt and persisted mutable state:")
+	Render(c, inspection.RebuildDiff, renderOpts)
+}
+
+func newMutableStateInspection(resp *types.AdminDescribeWorkflowExecutionResponse) (*MutableStateInspection, error) {
+	dbState, err := unmarshalMutableState(resp.GetMutableStateInDatabase())
+	if err != nil {
+		return nil, err
+	}
+	inspection := &MutableStateInspection{
+		Database: newMutableStateSummary(dbState),
+	}
+
+	if resp.MutableStateInCache != "" {
+		cacheState, err := unmarshalMutableState(resp.MutableStateInCache)
+		if err != nil {
+			return nil, err
+		}
+		inspection.Cache = newMutableStateSummary(cacheState)
+		inspection.Diff, err = diffMutableState(cacheState, dbState)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.MutableStateRebuiltFromHistory != "" {
+		if err := inspection.addRebuilt(resp.MutableStateRebuiltFromHistory, dbState); err != nil {
+			return nil, err
+		}
+	}
+	return inspection, nil
+}
+
+// addRebuilt compares the mutable state rebuilt from history with the persisted mutable state,
+// ignoring the fields which are not rebuilt
+func (i *MutableStateInspection) addRebuilt(rebuiltStr string, dbState *persistence.WorkflowMutableState) error {
+	rebuiltState, err := unmarshalMutableState(rebuiltStr)
+	if err != nil {
+		return err
+	}
+	i.Rebuilt = newMutableStateSummary(rebuiltState)
+
+	diff, err := diffMutableState(rebuiltState, dbState)
+	if err != nil {
+		return err
+	}
+	i.RebuildDiff = nil
+	for _, row := range diff {
+		if isMutableStateRebuildIgnoredPath(row.Path) {
+			continue
+		}
+		i.RebuildDiff = append(i.RebuildDiff, MutableStateRebuildDiffRow{
+			Path:     row.Path,
+			Rebuilt:  row.Cache,
+			Database: row.Database,
+		})
+	}
+	return nil
+}
+
+func isMutableStateRebuildIgnoredPath(path string) bool {
+	for _, ignored := range mutableStateRebuildIgnoredPaths {
+		if path == ignored || strings.HasPrefix(path, ignored+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func unmarshalMutableState(msStr string) (*persistence.WorkflowMutableState, error) {
+	ms := &persistence.WorkflowMutableState{}
+	if err := json.Unmarshal([]byte(msStr), ms); err != nil {
+		return nil, err
+	}
+	if ms.ExecutionInfo == nil {
+		return nil, fmt.Errorf("mutable state has no execution info")
+	}
+	return ms, nil
+}
+
+func newMutableStateSummary(ms *persistence.WorkflowMutableState) *MutableStateSummary {
+	info := ms.ExecutionInfo
+	summary := &MutableStateSummary{
+		Execution: MutableStateExecutionRow{
+			State:               info.State,
+			CloseStatus:         info.CloseStatus,
+			NextEventID:         info.NextEventID,
+			LastProcessedEvent:  info.LastProcessedEvent,
+			DecisionScheduleID:  info.DecisionScheduleID,
+			DecisionStartedID:   info.DecisionStartedID,
+			DecisionAttempt:     info.DecisionAttempt,
+			BufferedEventsCount: len(ms.BufferedEvents),
+			Checksum:            getMutableStateChecksumStatus(ms),
+		},
+	}
+
+	for _, ai := range ms.ActivityInfos {
+		summary.Activities = append(summary.Activities, PendingActivityRow{
+			ScheduleID:      ai.ScheduleID,
+			ActivityID:      ai.ActivityID,
+			StartedID:       ai.StartedID,
+			Attempt:         ai.Attempt,
+			CancelRequested: ai.CancelRequested,
+			Version:         ai.Version,
+		})
+	}
+	sort.Slice(summary.Activities, func(i, j int) bool {
+		return summary.Activities[i].ScheduleID < summary.Activities[j].ScheduleID
+	})
+
+	for _, ti := range ms.TimerInfos {
+		summary.Timers = append(summary.Timers, PendingTimerRow{
+			TimerID:    ti.TimerID,
+			StartedID:  ti.StartedID,
+			ExpiryTime: ti.ExpiryTime,
+			Version:    ti.Version,
+		})
+	}
+	sort.Slice(summary.Timers, func(i, j int) bool {
+		return summary.Timers[i].StartedID < summary.Timers[j].StartedID
+	})
+
+	for _, ci := range ms.ChildExecutionInfos {
+		summary.ChildWorkflows = append(summary.ChildWorkflows, PendingChildWorkflowRow{
+			InitiatedID:  ci.InitiatedID,
+			WorkflowID:   ci.StartedWorkflowID,
+			RunID:        ci.StartedRunID,
+			WorkflowType: ci.WorkflowTypeName,
+			Version:      ci.Version,
+		})
+	}
+	sort.Slice(summary.ChildWorkflows, func(i, j int) bool {
+		return summary.ChildWorkflows[i].InitiatedID < summary.ChildWorkflows[j].InitiatedID
+	})
+
+	for _, si := range ms.SignalInfos {
+		summary.Signals = append(summary.Signals, PendingSignalRow{
+			InitiatedID: si.InitiatedID,
+			SignalName:  si.SignalName,
+			Version:     si.Version,
+		})
+	}
+	sort.Slice(summary.Signals, func(i, j int) bool {
+		return summary.Signals[i].InitiatedID < summary.Signals[j].InitiatedID
+	})
+
+	if ms.VersionHistories != nil {
+		for idx, history := range ms.VersionHistories.Histories {
+			items := make([]string, 0, len(history.Items))
+			for _, item := range history.Items {
+				items = append(items, fmt.Sprintf("%v:%v", item.EventID, item.Version))
+			}
+			summary.VersionHistories = append(summary.VersionHistories, VersionHistoryRow{
+				Index:   idx,
+				Current: idx == ms.VersionHistories.CurrentVersionHistoryIndex,
+				Items:   strings.Join(items, ", "),
+			})
+		}
+	}
+	return summary
+}
+
+func getMutableStateChecksumStatus(ms *persistence.WorkflowMutableState) string {
+	if len(ms.Checksum.Value) == 0 {
+		return checksumStatusMissing
+	}
+	if err := execution.VerifyWorkflowMutableStateChecksum(ms); err != nil {
+		return err.Error()
+	}
+	return checksumStatusOK
+}
+
+func renderMutableStateSummary(c *cli.Context, summary *MutableStateSummary, opts RenderOptions) {
+	Render(c, summary.Execution, opts)
+	if len(summary.Activities) > 0 {
+		fmt.Println("Pending activities:")
+		Render(c, summary.Activities, opts)
+	}
+	if len(summary.Timers) > 0 {
+		fmt.Println("Pending timers:")
+		Render(c, summary.Timers, opts)
+	}
+	if len(summary.ChildWorkflows) > 0 {
+		fmt.Println("Pending child workflows:")
+		Render(c, summary.ChildWorkflows, opts)
+	}
+	if len(summary.Signals) > 0 {
+		fmt.Println("Pending signals:")
+		Render(c, summary.Signals, opts)
+	}
+	if len(summary.VersionHistories) > 0 {
+		fmt.Println("Version histories:")
+		Render(c, summary.VersionHistories, opts)
+	}
+}
+
+// diffMutableState returns the fields which differ between the cached and persisted copies
+// of mutable state, identified by their JSON path, e.g. ActivityInfos.5.Attempt
+func diffMutableState(cache, database *persistence.WorkflowMutableState) ([]MutableStateDiffRow, error) {
+	cacheValue, err := toGenericJSONValue(cache)
+	if err != nil {
+		return nil, err
+	}
+	databaseValue, err := toGenericJSONValue(database)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []MutableStateDiffRow
+	diffJSONValues("", cacheValue, databaseValue, &rows)
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Path < rows[j].Path
+	})
+	return rows, nil
+}
+
+func toGenericJSONValue(obj interface{}) (interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func diffJSONValues(path string, cache, database interface{}, rows *[]MutableStateDiffRow) {
+	cacheMap, cacheIsMap := cache.(map[string]interface{})
+	databaseMap, databaseIsMap := database.(map[string]interface{})
+	if cacheIsMap && databaseIsMap {
+		for key, cacheField := range cacheMap {
+			databaseField, ok := databaseMap[key]
+			if !ok {
+				*rows = append(*rows, newMutableStateDiffRow(joinJSONPath(path, key), cacheField, nil, true, false))
+				continue
+			}
+			diffJSONValues(joinJSONPath(path, key), cacheField, databaseField, rows)
+		}
+		for key, databaseField := range databaseMap {
+			if _, ok := cacheMap[key]; !ok {
+				*rows = append(*rows, newMutableStateDiffRow(joinJSONPath(path, key), nil, databaseField, false, true))
+			}
+		}
+		return
+	}
+
+	cacheSlice, cacheIsSlice := cache.([]interface{})
+	databaseSlice, databaseIsSlice := database.([]interface{})
+	if cacheIsSlice && databaseIsSlice && len(cacheSlice) == len(databaseSlice) {
+		for idx := range cacheSlice {
+			diffJSONValues(joinJSONPath(path, fmt.Sprintf("%v", idx)), cacheSlice[idx], databaseSlice[idx], rows)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(cache, database) {
+		*rows = append(*rows, newMutableStateDiffRow(path, cache, database, true, true))
+	}
+}
+
+func newMutableStateDiffRow(path string, cache, database interface{}, inCache, inDatabase bool) MutableStateDiffRow {
+	row := MutableStateDiffRow{
+		Path:     path,
+		Cache:    mutableStateDiffMissing,
+		Database: mutableStateDiffMissing,
+	}
+	if inCache {
+		row.Cache = jsonValueToString(cache)
+	}
+	if inDatabase {
+		row.Database = jsonValueToString(database)
+	}
+	return row
+}
+
+func jsonValueToString(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+func joinJSONPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,149 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func newTestMutableState() *persistence.WorkflowMutableState {
+	return &persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:    "domain-id",
+			WorkflowID:  "wid",
+			RunID:       "rid",
+			NextEventID: 10,
+		},
+		ActivityInfos: map[int64]*persistence.ActivityInfo{
+			7: {ScheduleID: 7, ActivityID: "activity-2", StartedID: -23},
+			5: {ScheduleID: 5, ActivityID: "activity-1", StartedID: 6, Attempt: 1},
+		},
+		TimerInfos: map[string]*persistence.TimerInfo{
+			"timer-1": {TimerID: "timer-1", StartedID: 8},
+		},
+		VersionHistories: &persistence.VersionHistories{
+			CurrentVersionHistoryIndex: 0,
+			Histories: []*persistence.VersionHistory{
+				{Items: []*persistence.VersionHistoryItem{{EventID: 9, Version: 1}}},
+			},
+		},
+	}
+}
+
+func marshalTestMutableState(t *testing.T, ms *persistence.WorkflowMutableState) string {
+	data, err := json.Marshal(ms)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestNewMutableStateInspection_NotInCache(t *testing.T) {
+	resp := &types.AdminDescribeWorkflowExecutionResponse{
+		MutableStateInDatabase: marshalTestMutableState(t, newTestMutableState()),
+	}
+
+	inspection, err := newMutableStateInspection(resp)
+	require.NoError(t, err)
+	assert.Nil(t, inspection.Cache)
+	assert.Nil(t, inspection.Diff)
+
+	summary := inspection.Database
+	assert.Equal(t, int64(10), summary.Execution.NextEventID)
+	assert.Equal(t, checksumStatusMissing, summary.Execution.Checksum)
+	assert.Equal(t, []PendingActivityRow{
+		{ScheduleID: 5, ActivityID: "activity-1", StartedID: 6, Attempt: 1},
+		{ScheduleID: 7, ActivityID: "activity-2", StartedID: -23},
+	}, summary.Activities)
+	assert.Equal(t, []PendingTimerRow{{TimerID: "timer-1", StartedID: 8}}, summary.Timers)
+	assert.Equal(t, []VersionHistoryRow{{Index: 0, Current: true, Items: "9:1"}}, summary.VersionHistories)
+}
+
+func TestNewMutableStateInspection_Diff(t *testing.T) {
+	dbState := newTestMutableState()
+	cacheState := newTestMutableState()
+	cacheState.ExecutionInfo.NextEventID = 12
+	cacheState.ActivityInfos[5].Attempt = 2
+	delete(cacheState.ActivityInfos, 7)
+	cacheState.Checksum = checksum.Checksum{Version: 100, Value: []byte{1}}
+
+	resp := &types.AdminDescribeWorkflowExecutionResponse{
+		MutableStateInCache:    marshalTestMutableState(t, cacheState),
+		MutableStateInDatabase: marshalTestMutableState(t, dbState),
+	}
+
+	inspection, err := newMutableStateInspection(resp)
+	require.NoError(t, err)
+	require.NotNil(t, inspection.Cache)
+	assert.Equal(t, "invalid checksum payload version 100", inspection.Cache.Execution.Checksum)
+	require.Len(t, inspection.Diff, 5)
+	assert.Equal(t, MutableStateDiffRow{Path: "ActivityInfos.5.Attempt", Cache: "2", Database: "1"}, inspection.Diff[0])
+	assert.Equal(t, "ActivityInfos.7", inspection.Diff[1].Path)
+	assert.Equal(t, mutableStateDiffMissing, inspection.Diff[1].Cache)
+	assert.Contains(t, inspection.Diff[1].Database, `"ScheduleID":7`)
+	assert.Equal(t, []MutableStateDiffRow{
+		{Path: "Checksum.Value", Cache: `"AQ=="`, Database: "null"},
+		{Path: "Checksum.Version", Cache: "100", Database: "0"},
+		{Path: "ExecutionInfo.NextEventID", Cache: "12", Database: "10"},
+	}, inspection.Diff[2:])
+}
+
+func TestNewMutableStateInspection_InvalidState(t *testing.T) {
+	_, err := newMutableStateInspection(&types.AdminDescribeWorkflowExecutionResponse{MutableStateInDatabase: "{}"})
+	assert.Error(t, err)
+}
+
+func TestMutableStateInspection_AddRebuilt(t *testing.T) {
+	dbState := newTestMutableState()
+	dbState.Checksum = checksum.Checksum{Version: 1, Value: []byte{1}}
+	dbState.ExecutionInfo.LastUpdatedTimestamp = time.Unix(100, 0)
+	rebuiltState := newTestMutableState()
+	delete(rebuiltState.TimerInfos, "timer-1")
+
+	resp := &types.AdminDescribeWorkflowExecutionResponse{
+		MutableStateInDatabase:         marshalTestMutableState(t, dbState),
+		MutableStateRebuiltFromHistory: marshalTestMutableState(t, rebuiltState),
+	}
+	inspection, err := newMutableStateInspection(resp)
+	require.NoError(t, err)
+
+	assert.Nil(t, inspection.Cache)
+	require.NotNil(t, inspection.Rebuilt)
+	assert.Empty(t, inspection.Rebuilt.Timers)
+	// checksum and last updated time are not rebuilt from history
+	require.Len(t, inspection.RebuildDiff, 1)
+	assert.Equal(t, "TimerInfos.timer-1", inspection.RebuildDiff[0].Path)
+	assert.Equal(t, mutableStateDiffMissing, inspection.RebuildDiff[0].Rebuilt)
+	assert.Contains(t, inspection.RebuildDiff[0].Database, `"TimerID":"timer-1"`)
+
+	rebuiltState.TimerInfos = dbState.TimerInfos
+	require.NoError(t, inspection.addRebuilt(marshalTestMutableState(t, rebuiltState), dbState))
+	assert.Empty(t, inspection.RebuildDiff)
+}
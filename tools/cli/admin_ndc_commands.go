@@ -0,0 +1,274 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/ndc"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	ndcSignalStateReapplied  = "reapplied"
+	ndcSignalStateNoRecord   = "no reapply record"
+	ndcBranchTokenNotDecoded = "-"
+)
+
+type (
+	// NDCBranchRow is a version history branch of a workflow, relative to its current branch
+	NDCBranchRow struct {
+		Index       int    `header:"Index" json:"index"`
+		Current     bool   `header:"Current" json:"current"`
+		TreeID      string `header:"Tree ID" json:"treeID"`
+		BranchID    string `header:"Branch ID" json:"branchID"`
+		LastEventID int64  `header:"Last Event ID" json:"lastEventID"`
+		LastVersion int64  `header:"Last Version" json:"lastVersion"`
+		LCAEventID  int64  `header:"LCA Event ID" json:"lcaEventID"`
+		LCAVersion  int64  `header:"LCA Version" json:"lcaVersion"`
+	}
+
+	// NDCRecordRow is an entry of the conflict resolution record of a workflow
+	NDCRecordRow struct {
+		Time            time.Time `header:"Time" json:"time"`
+		Reason          string    `header:"Reason" json:"reason"`
+		BranchID        string    `header:"Branch ID" json:"branchID"`
+		NewBranchID     string    `header:"New Branch ID" json:"newBranchID"`
+		ForkEventID     int64     `header:"Fork Event ID" json:"forkEventID"`
+		CurrentVersion  int64     `header:"Current Version" json:"currentVersion"`
+		IncomingVersion int64     `header:"Incoming Version" json:"incomingVersion"`
+		ReappliedEvents int       `header:"Reapplied Events" json:"reappliedEvents"`
+	}
+
+	// NDCSignalRow is a signal written to a non-current branch after it diverged from the current branch
+	NDCSignalRow struct {
+		BranchIndex int    `header:"Branch Index" json:"branchIndex"`
+		EventID     int64  `header:"Event ID" json:"eventID"`
+		Version     int64  `header:"Version" json:"version"`
+		SignalName  string `header:"Signal Name" json:"signalName"`
+		Identity    string `header:"Identity" json:"identity"`
+		State       string `header:"State" json:"state"`
+	}
+)
+
+// AdminNDCHistory shows all version history branches of a workflow, the conflict resolution record kept by
+// this cluster and, for every branch that lost conflict resolution, whether the record lists the signals
+// written to it after the branches diverged as reapplied to the current branch.
+// Signals are reapplied by the active cluster, so only its record lists them.
+func AdminNDCHistory(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	resp := describeMutableState(c, false)
+
+	ms := persistence.WorkflowMutableState{}
+	if err := json.Unmarshal([]byte(resp.GetMutableStateInDatabase()), &ms); err != nil {
+		ErrorAndExit("json.Unmarshal err", err)
+	}
+	if ms.VersionHistories == nil {
+		ErrorAndExit("Workflow has no version histories, it does not belong to a global domain.", nil)
+	}
+	records, err := ndc.GetConflictResolutionRecords(ms.ExecutionInfo.ConflictResolutions)
+	if err != nil {
+		ErrorAndExit("Failed to decode conflict resolution record", err)
+	}
+	shardID, err := strconv.Atoi(resp.GetShardID())
+	if err != nil {
+		ErrorAndExit("strconv.Atoi(shardID) err", err)
+	}
+	currentVersionHistory, err := ms.VersionHistories.GetCurrentVersionHistory()
+	if err != nil {
+		ErrorAndExit("ms.VersionHistories.GetCurrentVersionHistory err", err)
+	}
+
+	histV2 := initializeHistoryManager(c)
+	defer histV2.Close()
+
+	var branches []NDCBranchRow
+	var signals []NDCSignalRow
+	for index, versionHistory := range ms.VersionHistories.Histories {
+		row := getNDCBranchRow(index, versionHistory, currentVersionHistory)
+		row.Current = index == ms.VersionHistories.CurrentVersionHistoryIndex
+		branches = append(branches, row)
+		if row.Current || row.LCAEventID >= row.LastEventID {
+			continue
+		}
+
+		ctx, cancel := newContext(c)
+		divergedEvents, err := readHistoryBranchEvents(
+			ctx,
+			histV2,
+			versionHistory.GetBranchToken(),
+			row.LCAEventID+1,
+			row.LastEventID+1,
+			shardID,
+			domain,
+		)
+		cancel()
+		if err != nil {
+			ErrorAndExit("ReadHistoryBranch err", err)
+		}
+		signals = append(signals, getNDCSignalRows(index, ms.ExecutionInfo.RunID, divergedEvents, records)...)
+	}
+
+	fmt.Println("Version history branches:")
+	Render(c, branches, RenderOptions{DefaultTemplate: templateTable, Color: true})
+	fmt.Println("Conflict resolution record:")
+	Render(c, getNDCRecordRows(records), RenderOptions{DefaultTemplate: templateTable, Color: true})
+	fmt.Println("Signals on non-current branches after divergence:")
+	Render(c, signals, RenderOptions{DefaultTemplate: templateTable, Color: true})
+}
+
+func getNDCBranchRow(
+	index int,
+	versionHistory *persistence.VersionHistory,
+	currentVersionHistory *persistence.VersionHistory,
+) NDCBranchRow {
+	row := NDCBranchRow{Index: index}
+
+	branchInfo := shared.HistoryBranch{}
+	if err := codec.NewThriftRWEncoder().Decode(versionHistory.GetBranchToken(), &branchInfo); err != nil {
+		ErrorAndExit("thriftrwEncoder.Decode err", err)
+	}
+	row.TreeID = branchInfo.GetTreeID()
+	row.BranchID = branchInfo.GetBranchID()
+
+	lastItem, err := versionHistory.GetLastItem()
+	if err != nil {
+		ErrorAndExit("versionHistory.GetLastItem err", err)
+	}
+	row.LastEventID = lastItem.EventID
+	row.LastVersion = lastItem.Version
+
+	lcaItem, err := versionHistory.FindLCAItem(currentVersionHistory)
+	if err != nil {
+		ErrorAndExit("versionHistory.FindLCAItem err", err)
+	}
+	row.LCAEventID = lcaItem.EventID
+	row.LCAVersion = lcaItem.Version
+	return row
+}
+
+func getNDCRecordRows(records []*ndc.ConflictResolutionRecord) []NDCRecordRow {
+	rows := make([]NDCRecordRow, 0, len(records))
+	for _, record := range records {
+		rows = append(rows, NDCRecordRow{
+			Time:            record.Timestamp,
+			Reason:          record.Reason,
+			BranchID:        getNDCBranchID(record.BranchToken),
+			NewBranchID:     getNDCBranchID(record.NewBranchToken),
+			ForkEventID:     record.ForkEventID,
+			CurrentVersion:  record.CurrentVersion,
+			IncomingVersion: record.IncomingVersion,
+			ReappliedEvents: len(record.ReappliedEvents),
+		})
+	}
+	return rows
+}
+
+func getNDCBranchID(branchToken []byte) string {
+	if len(branchToken) == 0 {
+		return ""
+	}
+	branchInfo := shared.HistoryBranch{}
+	if err := codec.NewThriftRWEncoder().Decode(branchToken, &branchInfo); err != nil {
+		return ndcBranchTokenNotDecoded
+	}
+	return branchInfo.GetBranchID()
+}
+
+// getNDCSignalRows lists the signals of divergedEvents and marks each of them as reapplied
+// if the conflict resolution record lists it as a reapplied event of runID
+func getNDCSignalRows(
+	branchIndex int,
+	runID string,
+	divergedEvents []*types.HistoryEvent,
+	records []*ndc.ConflictResolutionRecord,
+) []NDCSignalRow {
+	reapplied := map[definition.EventReappliedID]struct{}{}
+	for _, record := range records {
+		for _, event := range record.ReappliedEvents {
+			reapplied[definition.NewEventReappliedID(event.RunID, event.EventID, event.Version)] = struct{}{}
+		}
+	}
+
+	var rows []NDCSignalRow
+	for _, event := range divergedEvents {
+		if event.GetEventType() != types.EventTypeWorkflowExecutionSignaled {
+			continue
+		}
+		attributes := event.WorkflowExecutionSignaledEventAttributes
+		row := NDCSignalRow{
+			BranchIndex: branchIndex,
+			EventID:     event.ID,
+			Version:     event.Version,
+			SignalName:  attributes.GetSignalName(),
+			Identity:    attributes.GetIdentity(),
+			State:       ndcSignalStateNoRecord,
+		}
+		if _, ok := reapplied[definition.NewEventReappliedID(runID, event.ID, event.Version)]; ok {
+			row.State = ndcSignalStateReapplied
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func readHistoryBranchEvents(
+	ctx context.Context,
+	histV2 persistence.HistoryManager,
+	branchToken []byte,
+	minEventID int64,
+	maxEventID int64,
+	shardID int,
+	domainName string,
+) ([]*types.HistoryEvent, error) {
+	var events []*types.HistoryEvent
+	var token []byte
+	for more := true; more; more = len(token) > 0 {
+		resp, err := histV2.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+			BranchToken:   branchToken,
+			MinEventID:    minEventID,
+			MaxEventID:    maxEventID,
+			PageSize:      defaultPageSizeForList,
+			NextPageToken: token,
+			ShardID:       common.IntPtr(shardID),
+			DomainName:    domainName,
+		})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, resp.HistoryEvents...)
+		token = resp.NextPageToken
+	}
+	return events, nil
+}
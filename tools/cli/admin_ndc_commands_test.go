@@ -0,0 +1,131 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/ndc"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestGetNDCSignalRows(t *testing.T) {
+	signal := func(eventID int64, name string) *types.HistoryEvent {
+		return &types.HistoryEvent{
+			ID:        eventID,
+			Version:   eventID * 10,
+			EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+				SignalName: name,
+				Identity:   "worker",
+			},
+		}
+	}
+	decision := &types.HistoryEvent{ID: 7, EventType: types.EventTypeDecisionTaskScheduled.Ptr()}
+	divergedEvents := []*types.HistoryEvent{decision, signal(8, "a"), signal(9, "a"), signal(10, "b")}
+	records := []*ndc.ConflictResolutionRecord{
+		{Reason: ndc.ConflictResolutionSwitched},
+		{
+			Reason: ndc.ConflictResolutionReapplied,
+			ReappliedEvents: []ndc.ReappliedEvent{
+				{RunID: "run", EventID: 8, Version: 80, SignalName: "a"},
+				{RunID: "other-run", EventID: 9, Version: 90, SignalName: "a"},
+				{RunID: "run", EventID: 10, Version: 11, SignalName: "b"},
+			},
+		},
+	}
+
+	rows := getNDCSignalRows(1, "run", divergedEvents, records)
+	assert.Equal(t, []NDCSignalRow{
+		{BranchIndex: 1, EventID: 8, Version: 80, SignalName: "a", Identity: "worker", State: ndcSignalStateReapplied},
+		{BranchIndex: 1, EventID: 9, Version: 90, SignalName: "a", Identity: "worker", State: ndcSignalStateNoRecord},
+		{BranchIndex: 1, EventID: 10, Version: 100, SignalName: "b", Identity: "worker", State: ndcSignalStateNoRecord},
+	}, rows)
+}
+
+func TestGetNDCRecordRows(t *testing.T) {
+	branchToken, err := codec.NewThriftRWEncoder().Encode(&shared.HistoryBranch{
+		TreeID:   common.StringPtr("tree"),
+		BranchID: common.StringPtr("branch"),
+	})
+	assert.NoError(t, err)
+	now := time.Now()
+
+	rows := getNDCRecordRows([]*ndc.ConflictResolutionRecord{
+		{
+			Timestamp:       now,
+			Reason:          ndc.ConflictResolutionSwitched,
+			BranchToken:     branchToken,
+			NewBranchToken:  []byte("not a branch token"),
+			CurrentVersion:  1,
+			IncomingVersion: 2,
+		},
+		{
+			Timestamp:       now,
+			Reason:          ndc.ConflictResolutionReapplied,
+			ReappliedEvents: []ndc.ReappliedEvent{{RunID: "run", EventID: 8}},
+		},
+	})
+	assert.Equal(t, []NDCRecordRow{
+		{
+			Time:            now,
+			Reason:          ndc.ConflictResolutionSwitched,
+			BranchID:        "branch",
+			NewBranchID:     ndcBranchTokenNotDecoded,
+			CurrentVersion:  1,
+			IncomingVersion: 2,
+		},
+		{Time: now, Reason: ndc.ConflictResolutionReapplied, ReappliedEvents: 1},
+	}, rows)
+}
+
+func TestGetNDCBranchRow(t *testing.T) {
+	branchToken, err := codec.NewThriftRWEncoder().Encode(&shared.HistoryBranch{
+		TreeID:   common.StringPtr("tree"),
+		BranchID: common.StringPtr("branch"),
+	})
+	assert.NoError(t, err)
+	current := persistence.NewVersionHistory([]byte{}, []*persistence.VersionHistoryItem{
+		persistence.NewVersionHistoryItem(5, 1),
+		persistence.NewVersionHistoryItem(12, 3),
+	})
+	losing := persistence.NewVersionHistory(branchToken, []*persistence.VersionHistoryItem{
+		persistence.NewVersionHistoryItem(5, 1),
+		persistence.NewVersionHistoryItem(9, 2),
+	})
+
+	row := getNDCBranchRow(1, losing, current)
+	assert.Equal(t, "tree", row.TreeID)
+	assert.Equal(t, "branch", row.BranchID)
+	assert.Equal(t, int64(9), row.LastEventID)
+	assert.Equal(t, int64(2), row.LastVersion)
+	assert.Equal(t, int64(5), row.LCAEventID)
+	assert.Equal(t, int64(1), row.LCAVersion)
+}
@@ -0,0 +1,209 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// ProcessingQueueRow is a processing queue of a shard's transfer or timer queue processor
+	ProcessingQueueRow struct {
+		Level        int            `header:"Level" json:"level"`
+		Active       bool           `header:"Active" json:"active"`
+		Throttled    bool           `header:"Throttled" json:"throttled"`
+		AckLevel     string         `header:"Ack Level" json:"ackLevel"`
+		ReadLevel    string         `header:"Read Level" json:"readLevel"`
+		MaxLevel     string         `header:"Max Level" json:"maxLevel"`
+		DomainIDs    []string       `header:"Domain IDs" json:"domainIDs,omitempty"`
+		ReverseMatch bool           `header:"Reverse Match" json:"reverseMatch"`
+		PendingTasks map[string]int `header:"Pending Tasks" json:"pendingTasks,omitempty"`
+		StuckTasks   map[string]int `header:"Stuck Tasks" json:"stuckTasks,omitempty"`
+	}
+)
+
+// AdminSplitQueueDomain splits a domain out into its own processing queue level by updating the
+// history.queueProcessorSplitDomainLevels dynamic config. Requires the config store based dynamic config.
+func AdminSplitQueueDomain(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domainID := getRequiredDomainID(c)
+	level := getRequiredIntOption(c, FlagQueueLevel)
+	configName := dynamicconfig.QueueProcessorSplitDomainLevels.String()
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListDynamicConfig(ctx, &types.ListDynamicConfigRequest{
+		ConfigName: configName,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to get split domain levels", err)
+	}
+
+	values, err := setSplitDomainLevel(resp, domainID, level)
+	if err != nil {
+		ErrorAndExit("Failed to build split domain levels", err)
+	}
+
+	err = adminClient.UpdateDynamicConfig(ctx, &types.UpdateDynamicConfigRequest{
+		ConfigName:   configName,
+		ConfigValues: values,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to update split domain levels", err)
+	}
+	if level == 0 {
+		fmt.Printf("Domain %v will no longer be split out.\n", domainID)
+		return
+	}
+	fmt.Printf("Domain %v will be split out to queue level %v.\n", domainID, level)
+}
+
+// AdminThrottleQueueDomain makes the queue level a domain is split into only be polled at the
+// poll backoff interval. Requires the config store based dynamic config.
+func AdminThrottleQueueDomain(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domainID := getRequiredDomainID(c)
+	configName := dynamicconfig.QueueProcessorThrottleSplitDomainByDomainID.String()
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListDynamicConfig(ctx, &types.ListDynamicConfigRequest{
+		ConfigName: configName,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to get throttled domains", err)
+	}
+
+	filters, err := throttledDomainFilters(domainID)
+	if err != nil {
+		ErrorAndExit("Failed to build throttled domain filters", err)
+	}
+	values, err := addBoolDynamicConfigValue(resp, configName, filters)
+	if err != nil {
+		ErrorAndExit("Failed to build throttled domain value", err)
+	}
+	if values == nil {
+		fmt.Printf("Domain %v is already throttled.\n", domainID)
+		return
+	}
+
+	err = adminClient.UpdateDynamicConfig(ctx, &types.UpdateDynamicConfigRequest{
+		ConfigName:   configName,
+		ConfigValues: values,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to throttle domain", err)
+	}
+	fmt.Printf("Domain %v throttled.\n", domainID)
+}
+
+// AdminUnthrottleQueueDomain removes the throttling added by AdminThrottleQueueDomain
+func AdminUnthrottleQueueDomain(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domainID := getRequiredDomainID(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	filters, err := throttledDomainFilters(domainID)
+	if err != nil {
+		ErrorAndExit("Failed to build throttled domain filters", err)
+	}
+	err = adminClient.RestoreDynamicConfig(ctx, &types.RestoreDynamicConfigRequest{
+		ConfigName: dynamicconfig.QueueProcessorThrottleSplitDomainByDomainID.String(),
+		Filters:    filters,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to unthrottle domain", err)
+	}
+	fmt.Printf("Domain %v unthrottled.\n", domainID)
+}
+
+// renderProcessingQueues renders the processing queues returned by DescribeQueue as a table.
+// Queues not described in the structured format (e.g. cross-cluster queue) are printed as is.
+func renderProcessingQueues(c *cli.Context, queues []string) {
+	rows := make([]ProcessingQueueRow, 0, len(queues))
+	for _, queue := range queues {
+		var row ProcessingQueueRow
+		if err := json.Unmarshal([]byte(queue), &row); err != nil {
+			fmt.Println(queue)
+			continue
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		return
+	}
+	Render(c, rows, RenderOptions{DefaultTemplate: templateTable, Color: true, Border: true})
+}
+
+// setSplitDomainLevel returns the values of the split domain levels config with the level
+// of the given domain updated. A level of 0 removes the domain from the config.
+func setSplitDomainLevel(
+	resp *types.ListDynamicConfigResponse,
+	domainID string,
+	level int,
+) ([]*types.DynamicConfigValue, error) {
+	domainLevels := make(map[string]interface{})
+	var values []*types.DynamicConfigValue
+	for _, value := range getDynamicConfigValues(resp, dynamicconfig.QueueProcessorSplitDomainLevels.String()) {
+		if len(value.Filters) != 0 {
+			values = append(values, value)
+			continue
+		}
+		if err := json.Unmarshal(value.Value.GetData(), &domainLevels); err != nil {
+			return nil, err
+		}
+	}
+
+	if level == 0 {
+		delete(domainLevels, domainID)
+	} else {
+		domainLevels[domainID] = level
+	}
+
+	newValue, err := convertFromInputValue(&cliValue{Value: domainLevels})
+	if err != nil {
+		return nil, err
+	}
+	return append(values, newValue), nil
+}
+
+func throttledDomainFilters(domainID string) ([]*types.DynamicConfigFilter, error) {
+	domainFilter, err := convertFromInputFilter(&cliFilter{Name: dynamicconfig.DomainID.String(), Value: domainID})
+	if err != nil {
+		return nil, err
+	}
+	return []*types.DynamicConfigFilter{domainFilter}, nil
+}
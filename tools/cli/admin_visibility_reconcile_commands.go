@@ -0,0 +1,120 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/visibilityreconciler"
+)
+
+const (
+	visibilityReconcileWorkflowTimeoutInSeconds = 24 * 60 * 60
+)
+
+// AdminStartVisibilityReconcile starts the system workflow which compares the visibility records of a domain
+// between the current cluster and a standby cluster
+func AdminStartVisibilityReconcile(c *cli.Context) {
+	domain := getRequiredOption(c, FlagDomain)
+	params := &visibilityreconciler.Params{
+		Domain:         domain,
+		StandbyCluster: getRequiredOption(c, FlagStandbyCluster),
+		StartTime:      time.Unix(0, parseTime(c.String(FlagEarliestTime), 0)),
+		EndTime:        time.Unix(0, parseTime(c.String(FlagLatestTime), time.Now().UnixNano())),
+		PageSize:       c.Int(FlagPageSize),
+		Repair:         c.Bool(FlagRepair),
+	}
+	input, err := json.Marshal(params)
+	if err != nil {
+		ErrorAndExit("Failed to serialize params for visibility reconcile workflow", err)
+	}
+	memo, err := getWorkflowMemo(map[string]interface{}{
+		common.MemoKeyForOperator: getOperator(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to serialize memo", err)
+	}
+
+	workflowID := getVisibilityReconcileWorkflowID(domain)
+	request := &types.StartWorkflowExecutionRequest{
+		Domain:                              common.SystemLocalDomainName,
+		WorkflowID:                          workflowID,
+		RequestID:                           uuid.New(),
+		Identity:                            getCliIdentity(),
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(visibilityReconcileWorkflowTimeoutInSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(defaultDecisionTimeoutInSeconds)),
+		Input:                               input,
+		TaskList:                            &types.TaskList{Name: visibilityreconciler.TaskListName},
+		Memo:                                memo,
+		WorkflowType:                        &types.WorkflowType{Name: visibilityreconciler.WorkflowTypeName},
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := getCadenceClient(c).StartWorkflowExecution(ctx, request)
+	if err != nil {
+		ErrorAndExit("Failed to start visibility reconcile workflow", err)
+	}
+	fmt.Println("Visibility reconcile workflow started")
+	fmt.Println("wid: " + workflowID)
+	fmt.Println("rid: " + resp.GetRunID())
+}
+
+// AdminDescribeVisibilityReconcile prints the progress of the visibility reconcile workflow of a domain
+func AdminDescribeVisibilityReconcile(c *cli.Context) {
+	domain := getRequiredOption(c, FlagDomain)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := getCadenceClient(c).QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+		Domain: common.SystemLocalDomainName,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: getVisibilityReconcileWorkflowID(domain),
+			RunID:      c.String(FlagRunID),
+		},
+		Query: &types.WorkflowQuery{
+			QueryType: visibilityreconciler.QueryTypeResult,
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Failed to query visibility reconcile workflow", err)
+	}
+
+	var result visibilityreconciler.Result
+	if err := json.Unmarshal(resp.GetQueryResult(), &result); err != nil {
+		ErrorAndExit("Unable to deserialize query result", err)
+	}
+	prettyPrintJSONObject(result)
+}
+
+func getVisibilityReconcileWorkflowID(domain string) string {
+	return visibilityreconciler.WorkflowIDPrefix + "-" + domain
+}
@@ -0,0 +1,568 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	workflowTaskQueueTransfer    = "transfer"
+	workflowTaskQueueTimer       = "timer"
+	workflowTaskQueueReplication = "replication"
+
+	workflowTaskStatusAcked   = "acked"
+	workflowTaskStatusPending = "pending"
+	workflowTaskStatusOverdue = "overdue"
+
+	// workflowTimerScanSlack is added to the latest timer expected for the workflow when scanning the timer queue
+	workflowTimerScanSlack = time.Hour
+)
+
+var (
+	transferTaskTypeNames = map[int]string{
+		persistence.TransferTaskTypeDecisionTask:                   "DecisionTask",
+		persistence.TransferTaskTypeActivityTask:                   "ActivityTask",
+		persistence.TransferTaskTypeCloseExecution:                 "CloseExecution",
+		persistence.TransferTaskTypeCancelExecution:                "CancelExecution",
+		persistence.TransferTaskTypeStartChildExecution:            "StartChildExecution",
+		persistence.TransferTaskTypeSignalExecution:                "SignalExecution",
+		persistence.TransferTaskTypeRecordWorkflowStarted:          "RecordWorkflowStarted",
+		persistence.TransferTaskTypeResetWorkflow:                  "ResetWorkflow",
+		persistence.TransferTaskTypeUpsertWorkflowSearchAttributes: "UpsertWorkflowSearchAttributes",
+		persistence.TransferTaskTypeRecordWorkflowClosed:           "RecordWorkflowClosed",
+		persistence.TransferTaskTypeRecordChildExecutionCompleted:  "RecordChildExecutionCompleted",
+		persistence.TransferTaskTypeApplyParentClosePolicy:         "ApplyParentClosePolicy",
+		persistence.TransferTaskTypeRecordWorkflowLifecycleEvent:   "RecordWorkflowLifecycleEvent",
+	}
+
+	timerTaskTypeNames = map[int]string{
+		persistence.TaskTypeDecisionTimeout:      "DecisionTimeout",
+		persistence.TaskTypeActivityTimeout:      "ActivityTimeout",
+		persistence.TaskTypeUserTimer:            "UserTimer",
+		persistence.TaskTypeWorkflowTimeout:      "WorkflowTimeout",
+		persistence.TaskTypeDeleteHistoryEvent:   "DeleteHistoryEvent",
+		persistence.TaskTypeActivityRetryTimer:   "ActivityRetryTimer",
+		persistence.TaskTypeWorkflowBackoffTimer: "WorkflowBackoffTimer",
+	}
+
+	replicationTaskTypeNames = map[int]string{
+		persistence.ReplicationTaskTypeHistory:        "History",
+		persistence.ReplicationTaskTypeSyncActivity:   "SyncActivity",
+		persistence.ReplicationTaskTypeFailoverMarker: "FailoverMarker",
+	}
+)
+
+type (
+	// WorkflowTaskRow is a task of a workflow found in one of the shard's task queues
+	WorkflowTaskRow struct {
+		Queue               string    `header:"Queue" json:"queue"`
+		TaskID              int64     `header:"Task ID" json:"taskID"`
+		TaskType            string    `header:"Task Type" json:"taskType"`
+		VisibilityTimestamp time.Time `header:"Visibility Time" json:"visibilityTimestamp"`
+		EventID             int64     `header:"Event ID" json:"eventID"`
+		Version             int64     `header:"Version" json:"version"`
+		Status              string    `header:"Status" json:"status"`
+		PendingClusters     []string  `header:"Pending Clusters" json:"pendingClusters,omitempty"`
+	}
+
+	// WorkflowQueueTaskRow is a task of a workflow recently executed or being retried by a queue processor
+	WorkflowQueueTaskRow struct {
+		Cluster             string    `header:"Cluster" json:"cluster"`
+		Queue               string    `header:"Queue" json:"queue"`
+		Level               int       `header:"Level" json:"level"`
+		TaskID              int64     `header:"Task ID" json:"taskID"`
+		TaskType            string    `header:"Task Type" json:"taskType"`
+		VisibilityTimestamp time.Time `header:"Visibility Time" json:"visibilityTimestamp"`
+		Version             int64     `header:"Version" json:"version"`
+		State               string    `header:"State" json:"state"`
+		Attempt             int       `header:"Attempt" json:"attempt"`
+		LastError           string    `header:"Last Error" json:"lastError,omitempty"`
+	}
+
+	workflowTaskFilter struct {
+		domainID   string
+		workflowID string
+		runID      string
+	}
+
+	// workflowTaskQueues are the processing queues of a transfer or timer queue
+	// processor of each cluster, as returned by DescribeQueue
+	workflowTaskQueues map[string][]*workflowTaskQueueState
+
+	workflowTaskQueueState struct {
+		Level        int                  `json:"level"`
+		AckKey       *workflowTaskKey     `json:"ackKey"`
+		MaxKey       *workflowTaskKey     `json:"maxKey"`
+		DomainIDs    []string             `json:"domainIDs"`
+		ReverseMatch bool                 `json:"reverseMatch"`
+		RetriedTasks []*workflowQueueTask `json:"retriedTasks"`
+		RecentTasks  []*workflowQueueTask `json:"recentTasks"`
+	}
+
+	workflowTaskKey struct {
+		VisibilityTimestamp *time.Time `json:"visibilityTimestamp"`
+		TaskID              int64      `json:"taskID"`
+	}
+
+	workflowQueueTask struct {
+		DomainID            string    `json:"domainID"`
+		WorkflowID          string    `json:"workflowID"`
+		RunID               string    `json:"runID"`
+		TaskID              int64     `json:"taskID"`
+		TaskType            int       `json:"taskType"`
+		VisibilityTimestamp time.Time `json:"visibilityTimestamp"`
+		Version             int64     `json:"version"`
+		Level               int       `json:"level"`
+		State               string    `json:"state"`
+		Attempt             int       `json:"attempt"`
+		LastError           string    `json:"lastError"`
+	}
+)
+
+// AdminListWorkflowTasks lists the transfer, timer and replication tasks of a workflow that still
+// exist in its shard's task queues and whether the queue processors of every cluster have acked them,
+// followed by the tasks of the workflow recently executed or being retried by the queue processors
+func AdminListWorkflowTasks(c *cli.Context) {
+	resp := describeMutableState(c, false)
+
+	ms := persistence.WorkflowMutableState{}
+	if err := json.Unmarshal([]byte(resp.GetMutableStateInDatabase()), &ms); err != nil {
+		ErrorAndExit("json.Unmarshal err", err)
+	}
+	if ms.ExecutionInfo == nil {
+		ErrorAndExit("Workflow mutable state has no execution info", nil)
+	}
+	shardID, err := strconv.Atoi(resp.GetShardID())
+	if err != nil {
+		ErrorAndExit("strconv.Atoi(shardID) err", err)
+	}
+	filter := workflowTaskFilter{
+		domainID:   ms.ExecutionInfo.DomainID,
+		workflowID: ms.ExecutionInfo.WorkflowID,
+		runID:      ms.ExecutionInfo.RunID,
+	}
+	batchSize := c.Int(FlagBatchSize)
+
+	ctx, cancel := newContext(c)
+	domainResp, err := cFactory.ServerFrontendClient(c).DescribeDomain(ctx, &types.DescribeDomainRequest{UUID: common.StringPtr(filter.domainID)})
+	cancel()
+	if err != nil {
+		ErrorAndExit("DescribeDomain err", err)
+	}
+	var clusters []string
+	for _, cluster := range domainResp.ReplicationConfiguration.GetClusters() {
+		clusters = append(clusters, cluster.GetClusterName())
+	}
+	transferQueues := describeWorkflowTaskQueues(c, shardID, clusters, common.TaskTypeTransfer)
+	timerQueues := describeWorkflowTaskQueues(c, shardID, clusters, common.TaskTypeTimer)
+
+	shardManager := initializeShardManager(c)
+	defer shardManager.Close()
+	ctx, cancel = newContext(c)
+	shardResp, err := shardManager.GetShard(ctx, &persistence.GetShardRequest{ShardID: shardID})
+	cancel()
+	if err != nil {
+		ErrorAndExit("GetShard err", err)
+	}
+	replicationAckLevels := getReplicationAckLevels(shardResp.ShardInfo, clusters)
+
+	executionManager := initializeExecutionStore(c, shardID)
+	defer executionManager.Close()
+
+	// tasks below the lowest ack level are deleted from the task queues, so only read from there on
+	var rows []WorkflowTaskRow
+	var token []byte
+	for isFirstPage := true; isFirstPage || len(token) != 0; isFirstPage = false {
+		ctx, cancel := newContext(c)
+		tasksResp, err := executionManager.GetTransferTasks(ctx, &persistence.GetTransferTasksRequest{
+			ReadLevel:     transferQueues.minAckKey(filter.domainID).TaskID,
+			MaxReadLevel:  math.MaxInt64,
+			BatchSize:     batchSize,
+			NextPageToken: token,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("GetTransferTasks err", err)
+		}
+		rows = append(rows, getTransferTaskRows(tasksResp.Tasks, filter, transferQueues)...)
+		token = tasksResp.NextPageToken
+	}
+
+	now := time.Now()
+	minTimestamp := time.Unix(0, 0)
+	if ackKey := timerQueues.minAckKey(filter.domainID); ackKey.VisibilityTimestamp != nil {
+		minTimestamp = *ackKey.VisibilityTimestamp
+	}
+	retention := time.Duration(domainResp.Configuration.GetWorkflowExecutionRetentionPeriodInDays()) * 24 * time.Hour
+	maxTimestamp := getWorkflowLastTimerTime(&ms, retention, now).Add(workflowTimerScanSlack)
+	for isFirstPage := true; isFirstPage || len(token) != 0; isFirstPage = false {
+		ctx, cancel := newContext(c)
+		timersResp, err := executionManager.GetTimerIndexTasks(ctx, &persistence.GetTimerIndexTasksRequest{
+			MinTimestamp:  minTimestamp,
+			MaxTimestamp:  maxTimestamp,
+			BatchSize:     batchSize,
+			NextPageToken: token,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("GetTimerIndexTasks err", err)
+		}
+		rows = append(rows, getTimerTaskRows(timersResp.Timers, filter, timerQueues, now)...)
+		token = timersResp.NextPageToken
+	}
+
+	minReplicationAckLevel := shardResp.ShardInfo.ReplicationAckLevel
+	for _, ackLevel := range replicationAckLevels {
+		if ackLevel < minReplicationAckLevel {
+			minReplicationAckLevel = ackLevel
+		}
+	}
+	for isFirstPage := true; isFirstPage || len(token) != 0; isFirstPage = false {
+		ctx, cancel := newContext(c)
+		tasksResp, err := executionManager.GetReplicationTasks(ctx, &persistence.GetReplicationTasksRequest{
+			ReadLevel:     minReplicationAckLevel,
+			MaxReadLevel:  math.MaxInt64,
+			BatchSize:     batchSize,
+			NextPageToken: token,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("GetReplicationTasks err", err)
+		}
+		rows = append(rows, getReplicationTaskRows(tasksResp.Tasks, filter, replicationAckLevels)...)
+		token = tasksResp.NextPageToken
+	}
+
+	Render(c, rows, RenderOptions{DefaultTemplate: templateTable, Color: true, Border: true})
+
+	queueTaskRows := append(
+		getWorkflowQueueTaskRows(workflowTaskQueueTransfer, transferQueues, filter),
+		getWorkflowQueueTaskRows(workflowTaskQueueTimer, timerQueues, filter)...,
+	)
+	if len(queueTaskRows) != 0 {
+		Render(c, queueTaskRows, RenderOptions{DefaultTemplate: templateTable, Color: true, Border: true})
+	}
+}
+
+// describeWorkflowTaskQueues describes the processing queues of the given queue type of each cluster
+func describeWorkflowTaskQueues(
+	c *cli.Context,
+	shardID int,
+	clusters []string,
+	queueType common.TaskType,
+) workflowTaskQueues {
+	adminClient := cFactory.ServerAdminClient(c)
+	queues := make(workflowTaskQueues)
+	for _, cluster := range clusters {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.DescribeQueue(ctx, &types.DescribeQueueRequest{
+			ShardID:     int32(shardID),
+			ClusterName: cluster,
+			Type:        common.Int32Ptr(int32(queueType)),
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to describe queue", err)
+		}
+		for _, queue := range resp.ProcessingQueueStates {
+			var state workflowTaskQueueState
+			if err := json.Unmarshal([]byte(queue), &state); err != nil {
+				ErrorAndExit("Failed to parse processing queue state", err)
+			}
+			queues[cluster] = append(queues[cluster], &state)
+		}
+	}
+	return queues
+}
+
+// getReplicationAckLevels returns the replication ack level of each cluster of the domain,
+// the shard's replication ack level is used for clusters without their own level
+func getReplicationAckLevels(shardInfo *persistence.ShardInfo, clusters []string) map[string]int64 {
+	ackLevels := make(map[string]int64, len(clusters))
+	for _, cluster := range clusters {
+		ackLevel, ok := shardInfo.ClusterReplicationLevel[cluster]
+		if !ok {
+			ackLevel = shardInfo.ReplicationAckLevel
+		}
+		ackLevels[cluster] = ackLevel
+	}
+	return ackLevels
+}
+
+// getWorkflowLastTimerTime returns the latest time a timer task of the workflow is expected to fire at
+func getWorkflowLastTimerTime(ms *persistence.WorkflowMutableState, retention time.Duration, now time.Time) time.Time {
+	lastTime := now
+	setLast := func(t time.Time) {
+		if t.After(lastTime) {
+			lastTime = t
+		}
+	}
+	executionInfo := ms.ExecutionInfo
+	setLast(executionInfo.StartTimestamp.Add(time.Duration(executionInfo.WorkflowTimeout) * time.Second))
+	if executionInfo.State == persistence.WorkflowStateCompleted {
+		setLast(executionInfo.LastUpdatedTimestamp.Add(retention))
+	}
+	for _, timerInfo := range ms.TimerInfos {
+		setLast(timerInfo.ExpiryTime)
+	}
+	for _, activityInfo := range ms.ActivityInfos {
+		setLast(activityInfo.ScheduledTime.Add(time.Duration(activityInfo.ScheduleToCloseTimeout) * time.Second))
+		setLast(activityInfo.ExpirationTime)
+	}
+	return lastTime
+}
+
+func (f workflowTaskFilter) match(domainID, workflowID, runID string) bool {
+	return f.domainID == domainID && f.workflowID == workflowID && f.runID == runID
+}
+
+func (s *workflowTaskQueueState) matchDomain(domainID string) bool {
+	for _, id := range s.DomainIDs {
+		if id == domainID {
+			return !s.ReverseMatch
+		}
+	}
+	return s.ReverseMatch
+}
+
+func (k *workflowTaskKey) less(other *workflowTaskKey) bool {
+	if k.VisibilityTimestamp != nil && other.VisibilityTimestamp != nil &&
+		!k.VisibilityTimestamp.Equal(*other.VisibilityTimestamp) {
+		return k.VisibilityTimestamp.Before(*other.VisibilityTimestamp)
+	}
+	return k.TaskID < other.TaskID
+}
+
+// minAckKey returns the lowest ack level of the processing queues of any cluster that include the domain
+func (q workflowTaskQueues) minAckKey(domainID string) *workflowTaskKey {
+	var minKey *workflowTaskKey
+	for _, states := range q {
+		for _, state := range states {
+			if state.AckKey == nil || !state.matchDomain(domainID) {
+				continue
+			}
+			if minKey == nil || state.AckKey.less(minKey) {
+				minKey = state.AckKey
+			}
+		}
+	}
+	if minKey == nil {
+		return &workflowTaskKey{}
+	}
+	return minKey
+}
+
+// pendingClusters returns the clusters whose queue processor has not acked the task yet. A task is
+// pending in a cluster if it's in the range of a processing queue that includes its domain, or if it's
+// above the max level of all of them and so has not been loaded yet.
+func (q workflowTaskQueues) pendingClusters(domainID string, key *workflowTaskKey) []string {
+	var clusters []string
+	for cluster, states := range q {
+		pending := true
+		for _, state := range states {
+			if state.AckKey == nil || state.MaxKey == nil || !state.matchDomain(domainID) {
+				continue
+			}
+			if !state.MaxKey.less(key) {
+				// the task is acked unless it's outstanding in one of the queues
+				pending = state.AckKey.less(key)
+				if pending {
+					break
+				}
+			}
+		}
+		if pending {
+			clusters = append(clusters, cluster)
+		}
+	}
+	sort.Strings(clusters)
+	return clusters
+}
+
+func getTransferTaskRows(
+	tasks []*persistence.TransferTaskInfo,
+	filter workflowTaskFilter,
+	queues workflowTaskQueues,
+) []WorkflowTaskRow {
+	var rows []WorkflowTaskRow
+	for _, task := range tasks {
+		if !filter.match(task.DomainID, task.WorkflowID, task.RunID) {
+			continue
+		}
+		pendingClusters := queues.pendingClusters(task.DomainID, &workflowTaskKey{TaskID: task.TaskID})
+		status := workflowTaskStatusAcked
+		if len(pendingClusters) != 0 {
+			status = workflowTaskStatusPending
+		}
+		rows = append(rows, WorkflowTaskRow{
+			Queue:               workflowTaskQueueTransfer,
+			TaskID:              task.TaskID,
+			TaskType:            getTaskTypeName(transferTaskTypeNames, task.TaskType),
+			VisibilityTimestamp: task.VisibilityTimestamp,
+			EventID:             task.ScheduleID,
+			Version:             task.Version,
+			Status:              status,
+			PendingClusters:     pendingClusters,
+		})
+	}
+	return rows
+}
+
+// getTimerTaskRows marks timers which should have fired but are not acked as overdue
+func getTimerTaskRows(
+	timers []*persistence.TimerTaskInfo,
+	filter workflowTaskFilter,
+	queues workflowTaskQueues,
+	now time.Time,
+) []WorkflowTaskRow {
+	var rows []WorkflowTaskRow
+	for _, timer := range timers {
+		if !filter.match(timer.DomainID, timer.WorkflowID, timer.RunID) {
+			continue
+		}
+		visibilityTimestamp := timer.VisibilityTimestamp
+		pendingClusters := queues.pendingClusters(timer.DomainID, &workflowTaskKey{
+			VisibilityTimestamp: &visibilityTimestamp,
+			TaskID:              timer.TaskID,
+		})
+		status := workflowTaskStatusAcked
+		if len(pendingClusters) != 0 {
+			status = workflowTaskStatusPending
+			if timer.VisibilityTimestamp.Before(now) {
+				status = workflowTaskStatusOverdue
+			}
+		}
+		rows = append(rows, WorkflowTaskRow{
+			Queue:               workflowTaskQueueTimer,
+			TaskID:              timer.TaskID,
+			TaskType:            getTaskTypeName(timerTaskTypeNames, timer.TaskType),
+			VisibilityTimestamp: timer.VisibilityTimestamp,
+			EventID:             timer.EventID,
+			Version:             timer.Version,
+			Status:              status,
+			PendingClusters:     pendingClusters,
+		})
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].VisibilityTimestamp.Before(rows[j].VisibilityTimestamp)
+	})
+	return rows
+}
+
+func getReplicationTaskRows(
+	tasks []*persistence.ReplicationTaskInfo,
+	filter workflowTaskFilter,
+	ackLevels map[string]int64,
+) []WorkflowTaskRow {
+	var rows []WorkflowTaskRow
+	for _, task := range tasks {
+		if !filter.match(task.DomainID, task.WorkflowID, task.RunID) {
+			continue
+		}
+		var pendingClusters []string
+		for cluster, ackLevel := range ackLevels {
+			if task.TaskID > ackLevel {
+				pendingClusters = append(pendingClusters, cluster)
+			}
+		}
+		sort.Strings(pendingClusters)
+		status := workflowTaskStatusAcked
+		if len(pendingClusters) != 0 {
+			status = workflowTaskStatusPending
+		}
+		row := WorkflowTaskRow{
+			Queue:           workflowTaskQueueReplication,
+			TaskID:          task.TaskID,
+			TaskType:        getTaskTypeName(replicationTaskTypeNames, task.TaskType),
+			EventID:         task.FirstEventID,
+			Version:         task.Version,
+			Status:          status,
+			PendingClusters: pendingClusters,
+		}
+		if task.CreationTime != 0 {
+			row.VisibilityTimestamp = time.Unix(0, task.CreationTime)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// getWorkflowQueueTaskRows returns the tasks of the workflow recently executed or
+// being retried by the processing queues of each cluster
+func getWorkflowQueueTaskRows(
+	queue string,
+	queues workflowTaskQueues,
+	filter workflowTaskFilter,
+) []WorkflowQueueTaskRow {
+	typeNames := transferTaskTypeNames
+	if queue == workflowTaskQueueTimer {
+		typeNames = timerTaskTypeNames
+	}
+	clusters := make([]string, 0, len(queues))
+	for cluster := range queues {
+		clusters = append(clusters, cluster)
+	}
+	sort.Strings(clusters)
+
+	var rows []WorkflowQueueTaskRow
+	for _, cluster := range clusters {
+		for _, state := range queues[cluster] {
+			for _, task := range append(state.RecentTasks, state.RetriedTasks...) {
+				if !filter.match(task.DomainID, task.WorkflowID, task.RunID) {
+					continue
+				}
+				rows = append(rows, WorkflowQueueTaskRow{
+					Cluster:             cluster,
+					Queue:               queue,
+					Level:               task.Level,
+					TaskID:              task.TaskID,
+					TaskType:            getTaskTypeName(typeNames, task.TaskType),
+					VisibilityTimestamp: task.VisibilityTimestamp,
+					Version:             task.Version,
+					State:               task.State,
+					Attempt:             task.Attempt,
+					LastError:           task.LastError,
+				})
+			}
+		}
+	}
+	return rows
+}
+
+func getTaskTypeName(names map[int]string, taskType int) string {
+	if name, ok := names[taskType]; ok {
+		return name
+	}
+	return strconv.Itoa(taskType)
+}
@@ -0,0 +1,158 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+var testWorkflowTaskFilter = workflowTaskFilter{
+	domainID:   "domain-id",
+	workflowID: "wid",
+	runID:      "rid",
+}
+
+func TestGetTransferTaskRows(t *testing.T) {
+	// the standby cluster has a split out queue for the domain which is behind
+	queues := workflowTaskQueues{
+		"active": {
+			{Level: 0, AckKey: &workflowTaskKey{TaskID: 15}, MaxKey: &workflowTaskKey{TaskID: 100}, ReverseMatch: true},
+		},
+		"standby": {
+			{Level: 0, AckKey: &workflowTaskKey{TaskID: 15}, MaxKey: &workflowTaskKey{TaskID: 100}, DomainIDs: []string{"domain-id"}, ReverseMatch: true},
+			{Level: 1, AckKey: &workflowTaskKey{TaskID: 5}, MaxKey: &workflowTaskKey{TaskID: 100}, DomainIDs: []string{"domain-id"}},
+		},
+	}
+	tasks := []*persistence.TransferTaskInfo{
+		{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", TaskID: 10, TaskType: persistence.TransferTaskTypeDecisionTask, ScheduleID: 2},
+		{DomainID: "domain-id", WorkflowID: "wid", RunID: "other-rid", TaskID: 11, TaskType: persistence.TransferTaskTypeActivityTask},
+		{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", TaskID: 20, TaskType: persistence.TransferTaskTypeActivityTask, ScheduleID: 5},
+		{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", TaskID: 200, TaskType: 100},
+	}
+
+	rows := getTransferTaskRows(tasks, testWorkflowTaskFilter, queues)
+	assert.Equal(t, []WorkflowTaskRow{
+		{Queue: workflowTaskQueueTransfer, TaskID: 10, TaskType: "DecisionTask", EventID: 2, Status: workflowTaskStatusPending, PendingClusters: []string{"standby"}},
+		{Queue: workflowTaskQueueTransfer, TaskID: 20, TaskType: "ActivityTask", EventID: 5, Status: workflowTaskStatusPending, PendingClusters: []string{"active", "standby"}},
+		{Queue: workflowTaskQueueTransfer, TaskID: 200, TaskType: "100", Status: workflowTaskStatusPending, PendingClusters: []string{"active", "standby"}},
+	}, rows)
+
+	queues["standby"][1].AckKey = &workflowTaskKey{TaskID: 15}
+	rows = getTransferTaskRows(tasks[:1], testWorkflowTaskFilter, queues)
+	assert.Equal(t, []WorkflowTaskRow{
+		{Queue: workflowTaskQueueTransfer, TaskID: 10, TaskType: "DecisionTask", EventID: 2, Status: workflowTaskStatusAcked},
+	}, rows)
+	assert.Equal(t, &workflowTaskKey{TaskID: 15}, queues.minAckKey("domain-id"))
+}
+
+func TestGetTimerTaskRows(t *testing.T) {
+	now := time.Unix(1000, 0)
+	ackLevel := now.Add(-time.Minute)
+	maxLevel := now.Add(time.Minute)
+	queues := workflowTaskQueues{
+		"active": {
+			{Level: 0, AckKey: &workflowTaskKey{VisibilityTimestamp: &ackLevel}, MaxKey: &workflowTaskKey{VisibilityTimestamp: &maxLevel}, ReverseMatch: true},
+		},
+	}
+	timers := []*persistence.TimerTaskInfo{
+		{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", TaskID: 3, TaskType: persistence.TaskTypeUserTimer, EventID: 7, VisibilityTimestamp: now.Add(time.Hour)},
+		{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", TaskID: 2, TaskType: persistence.TaskTypeUserTimer, EventID: 6, VisibilityTimestamp: now.Add(-time.Second)},
+		{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", TaskID: 1, TaskType: persistence.TaskTypeDecisionTimeout, EventID: 5, VisibilityTimestamp: now.Add(-time.Hour)},
+		{DomainID: "other-domain-id", WorkflowID: "wid", RunID: "rid", TaskID: 4, VisibilityTimestamp: now},
+	}
+
+	rows := getTimerTaskRows(timers, testWorkflowTaskFilter, queues, now)
+	assert.Equal(t, []WorkflowTaskRow{
+		{Queue: workflowTaskQueueTimer, TaskID: 1, TaskType: "DecisionTimeout", VisibilityTimestamp: now.Add(-time.Hour), EventID: 5, Status: workflowTaskStatusAcked},
+		{Queue: workflowTaskQueueTimer, TaskID: 2, TaskType: "UserTimer", VisibilityTimestamp: now.Add(-time.Second), EventID: 6, Status: workflowTaskStatusOverdue, PendingClusters: []string{"active"}},
+		{Queue: workflowTaskQueueTimer, TaskID: 3, TaskType: "UserTimer", VisibilityTimestamp: now.Add(time.Hour), EventID: 7, Status: workflowTaskStatusPending, PendingClusters: []string{"active"}},
+	}, rows)
+}
+
+func TestGetReplicationTaskRows(t *testing.T) {
+	tasks := []*persistence.ReplicationTaskInfo{
+		{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", TaskID: 10, TaskType: persistence.ReplicationTaskTypeHistory, FirstEventID: 1, Version: 2, CreationTime: 1000},
+		{DomainID: "domain-id", WorkflowID: "other-wid", RunID: "rid", TaskID: 11},
+		{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", TaskID: 12, TaskType: persistence.ReplicationTaskTypeSyncActivity, ScheduledID: 5, Version: 2},
+	}
+	ackLevels := getReplicationAckLevels(&persistence.ShardInfo{
+		ReplicationAckLevel:     12,
+		ClusterReplicationLevel: map[string]int64{"cluster1": 10},
+	}, []string{"cluster1", "cluster2"})
+	assert.Equal(t, map[string]int64{"cluster1": 10, "cluster2": 12}, ackLevels)
+
+	rows := getReplicationTaskRows(tasks, testWorkflowTaskFilter, ackLevels)
+	assert.Equal(t, []WorkflowTaskRow{
+		{Queue: workflowTaskQueueReplication, TaskID: 10, TaskType: "History", VisibilityTimestamp: time.Unix(0, 1000), EventID: 1, Version: 2, Status: workflowTaskStatusAcked},
+		{Queue: workflowTaskQueueReplication, TaskID: 12, TaskType: "SyncActivity", Version: 2, Status: workflowTaskStatusPending, PendingClusters: []string{"cluster1"}},
+	}, rows)
+}
+
+func TestGetWorkflowLastTimerTime(t *testing.T) {
+	now := time.Unix(1000, 0)
+	ms := &persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			StartTimestamp:  now.Add(-time.Minute),
+			WorkflowTimeout: 3600,
+		},
+		TimerInfos: map[string]*persistence.TimerInfo{
+			"timer": {ExpiryTime: now.Add(2 * time.Hour)},
+		},
+		ActivityInfos: map[int64]*persistence.ActivityInfo{
+			5: {ScheduledTime: now, ScheduleToCloseTimeout: 60, ExpirationTime: now.Add(3 * time.Hour)},
+		},
+	}
+	assert.Equal(t, now.Add(3*time.Hour), getWorkflowLastTimerTime(ms, 24*time.Hour, now))
+
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	ms.ExecutionInfo.LastUpdatedTimestamp = now
+	assert.Equal(t, now.Add(24*time.Hour), getWorkflowLastTimerTime(ms, 24*time.Hour, now))
+}
+
+func TestGetWorkflowQueueTaskRows(t *testing.T) {
+	queues := workflowTaskQueues{
+		"active": {
+			{
+				Level: 0,
+				RecentTasks: []*workflowQueueTask{
+					{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", TaskID: 1, TaskType: persistence.TaskTypeUserTimer, State: "acked"},
+					{DomainID: "domain-id", WorkflowID: "other-wid", RunID: "rid", TaskID: 2, State: "acked"},
+				},
+				RetriedTasks: []*workflowQueueTask{
+					{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", TaskID: 3, TaskType: persistence.TaskTypeActivityTimeout, State: "pending", Attempt: 3, LastError: "some error"},
+				},
+			},
+		},
+	}
+
+	rows := getWorkflowQueueTaskRows(workflowTaskQueueTimer, queues, testWorkflowTaskFilter)
+	assert.Equal(t, []WorkflowQueueTaskRow{
+		{Cluster: "active", Queue: workflowTaskQueueTimer, TaskID: 1, TaskType: "UserTimer", State: "acked"},
+		{Cluster: "active", Queue: workflowTaskQueueTimer, TaskID: 3, TaskType: "ActivityTimeout", State: "pending", Attempt: 3, LastError: "some error"},
+	}, rows)
+}
@@ -104,6 +104,12 @@ func NewCliApp() *cli.App {
 			Usage:       "Operate cadence tasklist",
 			Subcommands: newTaskListCommands(),
 		},
+		{
+			Name:        "schedule",
+			Aliases:     []string{"sch"},
+			Usage:       "Operate cadence workflow schedules",
+			Subcommands: newScheduleCommands(),
+		},
 		{
 			Name:    "admin",
 			Aliases: []string{"adm"},
@@ -21,6 +21,10 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -31,12 +35,17 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/suite"
 	"github.com/urfave/cli"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dlq"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/failovermanager"
+	"github.com/uber/cadence/service/worker/visibilityreconciler"
 )
 
 type cliAppSuite struct {
@@ -45,11 +54,17 @@ type cliAppSuite struct {
 	mockCtrl             *gomock.Controller
 	serverFrontendClient *frontend.MockClient
 	serverAdminClient    *admin.MockClient
+
+	serverFrontendClientForMigration *frontend.MockClient
+	serverAdminClientForMigration    *admin.MockClient
 }
 
 type clientFactoryMock struct {
 	serverFrontendClient frontend.Client
 	serverAdminClient    admin.Client
+
+	serverFrontendClientForMigration frontend.Client
+	serverAdminClientForMigration    admin.Client
 }
 
 func (m *clientFactoryMock) ServerFrontendClient(c *cli.Context) frontend.Client {
@@ -61,11 +76,11 @@ func (m *clientFactoryMock) ServerAdminClient(c *cli.Context) admin.Client {
 }
 
 func (m *clientFactoryMock) ServerFrontendClientForMigration(c *cli.Context) frontend.Client {
-	panic("not implemented")
+	return m.serverFrontendClientForMigration
 }
 
 func (m *clientFactoryMock) ServerAdminClientForMigration(c *cli.Context) admin.Client {
-	panic("not implemented")
+	return m.serverAdminClientForMigration
 }
 
 func (m *clientFactoryMock) ElasticSearchClient(c *cli.Context) *elastic.Client {
@@ -98,9 +113,13 @@ func (s *cliAppSuite) SetupTest() {
 
 	s.serverFrontendClient = frontend.NewMockClient(s.mockCtrl)
 	s.serverAdminClient = admin.NewMockClient(s.mockCtrl)
+	s.serverFrontendClientForMigration = frontend.NewMockClient(s.mockCtrl)
+	s.serverAdminClientForMigration = admin.NewMockClient(s.mockCtrl)
 	SetFactory(&clientFactoryMock{
-		serverFrontendClient: s.serverFrontendClient,
-		serverAdminClient:    s.serverAdminClient,
+		serverFrontendClient:             s.serverFrontendClient,
+		serverAdminClient:                s.serverAdminClient,
+		serverFrontendClientForMigration: s.serverFrontendClientForMigration,
+		serverAdminClientForMigration:    s.serverAdminClientForMigration,
 	})
 }
 
@@ -381,10 +400,12 @@ func (s *cliAppSuite) TestStartWorkflow_Failed() {
 }
 
 func (s *cliAppSuite) TestRunWorkflow() {
-	resp := &types.StartWorkflowExecutionResponse{RunID: uuid.New()}
-	history := getWorkflowExecutionHistoryResponse
-	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil).Times(2)
-	s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil).Times(2)
+	resp := &types.ExecuteWorkflowExecutionResponse{
+		RunID:       uuid.New(),
+		CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+		Result:      []byte("result"),
+	}
+	s.serverFrontendClient.EXPECT().ExecuteWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil).Times(2)
 	// start with wid
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "run", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "-w", "wid", "wrp", "2"})
 	s.Nil(err)
@@ -394,15 +415,72 @@ func (s *cliAppSuite) TestRunWorkflow() {
 }
 
 func (s *cliAppSuite) TestRunWorkflow_Failed() {
-	resp := &types.StartWorkflowExecutionResponse{RunID: uuid.New()}
-	history := getWorkflowExecutionHistoryResponse
-	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, &types.BadRequestError{"faked error"})
-	s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil)
+	s.serverFrontendClient.EXPECT().ExecuteWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{"faked error"})
 	// start with wid
 	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "run", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "-w", "wid"})
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestRunWorkflow_Attach() {
+	resp := &types.ExecuteWorkflowExecutionResponse{
+		RunID:         "running-rid",
+		Attached:      true,
+		CloseStatus:   types.WorkflowExecutionCloseStatusFailed.Ptr(),
+		FailureReason: common.StringPtr("reason"),
+	}
+	s.serverFrontendClient.EXPECT().ExecuteWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.ExecuteWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.ExecuteWorkflowExecutionResponse, error) {
+			s.True(request.GetAttachToRunning())
+			s.Equal("wid", request.GetStartRequest().GetWorkflowID())
+			return resp, nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "run", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "-w", "wid", "--attach"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestRunWorkflow_AlreadyStartedWithoutAttach() {
+	alreadyStartedErr := &types.WorkflowExecutionAlreadyStartedError{RunID: "running-rid"}
+	s.serverFrontendClient.EXPECT().ExecuteWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.ExecuteWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.ExecuteWorkflowExecutionResponse, error) {
+			s.False(request.GetAttachToRunning())
+			return nil, alreadyStartedErr
+		})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "run", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestRunWorkflow_KeepsWaiting() {
+	requestIDs := map[string]struct{}{}
+	gomock.InOrder(
+		s.serverFrontendClient.EXPECT().ExecuteWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *types.ExecuteWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.ExecuteWorkflowExecutionResponse, error) {
+				requestIDs[request.GetStartRequest().RequestID] = struct{}{}
+				return &types.ExecuteWorkflowExecutionResponse{RunID: "rid"}, nil
+			}),
+		s.serverFrontendClient.EXPECT().ExecuteWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *types.ExecuteWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.ExecuteWorkflowExecutionResponse, error) {
+				requestIDs[request.GetStartRequest().RequestID] = struct{}{}
+				return &types.ExecuteWorkflowExecutionResponse{
+					RunID:       "rid",
+					CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+				}, nil
+			}),
+	)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "run", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "-w", "wid"})
+	s.Nil(err)
+	s.Len(requestIDs, 1)
+}
+
+func (s *cliAppSuite) TestRunWorkflow_StillRunningAtDeadline() {
+	s.serverFrontendClient.EXPECT().ExecuteWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ *types.ExecuteWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.ExecuteWorkflowExecutionResponse, error) {
+			<-ctx.Done()
+			return &types.ExecuteWorkflowExecutionResponse{RunID: "rid"}, nil
+		})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "run", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "-w", "wid", "--wait_timeout", "1"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestTerminateWorkflow() {
 	s.serverFrontendClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "terminate", "-w", "wid"})
@@ -439,6 +517,71 @@ func (s *cliAppSuite) TestSignalWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestPauseWorkflow() {
+	s.serverFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.PauseWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+			s.Equal("wid", request.GetWorkflowExecution().GetWorkflowID())
+			s.Equal("maintenance", request.GetReason())
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid", "--reason", "maintenance"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUnpauseWorkflow() {
+	s.serverFrontendClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.UnpauseWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+			s.Equal("wid", request.GetWorkflowExecution().GetWorkflowID())
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "unpause", "-w", "wid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestPauseWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{"faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestPauseActivity() {
+	s.serverFrontendClient.EXPECT().PauseActivity(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.PauseActivityRequest, _ ...yarpc.CallOption) error {
+			s.Equal("wid", request.GetWorkflowExecution().GetWorkflowID())
+			s.Equal("aid", request.GetActivityID())
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "pause", "-w", "wid", "-aid", "aid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUnpauseActivity() {
+	s.serverFrontendClient.EXPECT().UnpauseActivity(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "unpause", "-w", "wid", "-aid", "aid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestResetActivity_Failed() {
+	s.serverFrontendClient.EXPECT().ResetActivity(gomock.Any(), gomock.Any()).Return(&types.EntityNotExistsError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "activity", "reset", "-w", "wid", "-aid", "aid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestUpdateActivityOptions() {
+	s.serverFrontendClient.EXPECT().UpdateActivityOptions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.UpdateActivityOptionsRequest, _ ...yarpc.CallOption) error {
+			s.Equal("aid", request.GetActivityID())
+			s.Equal(int32(30), request.GetStartToCloseTimeoutSeconds())
+			s.Nil(request.ScheduleToStartTimeoutSeconds)
+			s.Equal(int32(5), request.GetRetryPolicy().GetMaximumAttempts())
+			s.Equal(int32(10), request.GetRetryPolicy().GetInitialIntervalInSeconds())
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "update-options", "-w", "wid", "-aid", "aid",
+		"--start_to_close_timeout_seconds", "30", "--retry_attempts", "5"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestQueryWorkflow() {
 	resp := &types.QueryWorkflowResponse{
 		QueryResult: []byte("query-result"),
@@ -622,6 +765,103 @@ func (s *cliAppSuite) TestAdminDescribeWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestAdminDescribeQueue() {
+	s.serverAdminClient.EXPECT().DescribeQueue(gomock.Any(), gomock.Any()).Return(&types.DescribeQueueResponse{
+		ProcessingQueueStates: []string{
+			`{"level":0,"ackLevel":"{taskID:0}","readLevel":"{taskID:10}","maxLevel":"{taskID:100}","domainIDs":["domain-id"],"reverseMatch":true,"active":true,"throttled":false,"pendingTasks":{"other-domain-id":3}}`,
+			`{"level":1,"ackLevel":"{taskID:0}","readLevel":"{taskID:10}","maxLevel":"{taskID:100}","domainIDs":["domain-id"],"reverseMatch":false,"active":true,"throttled":true,"stuckTasks":{"domain-id":2}}`,
+		},
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "queue", "describe", "--shard_id", "1", "--cluster", "active", "--queue_type", "2"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminListThrottledWorkflowIDs() {
+	s.serverAdminClient.EXPECT().DescribeHistoryHost(gomock.Any(), &types.DescribeHistoryHostRequest{
+		HostAddress: common.StringPtr("127.0.0.1:7934"),
+	}).Return(&types.DescribeHistoryHostResponse{
+		ShardIDs: []int32{3, 7},
+		Address:  "127.0.0.1:7934",
+	}, nil)
+	s.serverAdminClient.EXPECT().ListThrottledWorkflowIDs(gomock.Any(), &types.ListThrottledWorkflowIDsRequest{
+		ShardID: 3,
+	}).Return(&types.ListThrottledWorkflowIDsResponse{
+		ThrottledWorkflowIDs: []*types.ThrottledWorkflowID{
+			{
+				DomainName:             "test-domain",
+				WorkflowID:             "wid",
+				Limit:                  "signal",
+				Count:                  3,
+				LastThrottledTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+			},
+		},
+	}, nil)
+	err := s.app.Run([]string{"", "admin", "history_host", "throttled", "--history_address", "127.0.0.1:7934"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDomainUsage() {
+	s.serverAdminClient.EXPECT().GetDomainUsage(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.GetDomainUsageRequest, _ ...yarpc.CallOption) (*types.GetDomainUsageResponse, error) {
+			s.Equal(domainName, request.Domain)
+			s.Equal(2*time.Hour, time.Duration(request.GetEndTimestamp()-request.GetStartTimestamp()).Round(time.Minute))
+			return &types.GetDomainUsageResponse{
+				Usage: []*types.WorkflowUsage{
+					{WorkflowType: "test-workflow-type", DecisionTasks: 3, HistoryBytes: 1024},
+				},
+			}, nil
+		},
+	)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "domain", "usage", "--earliest_time", "2h"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminSplitQueueDomain() {
+	describeResp := &types.DescribeDomainResponse{DomainInfo: &types.DomainInfo{UUID: "domain-id"}}
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeResp, nil)
+	existing, err := convertFromInputValue(&cliValue{Value: map[string]interface{}{"other-domain-id": 2}})
+	s.NoError(err)
+	s.serverAdminClient.EXPECT().ListDynamicConfig(gomock.Any(), gomock.Any()).Return(&types.ListDynamicConfigResponse{
+		Entries: []*types.DynamicConfigEntry{
+			{Name: dynamicconfig.QueueProcessorSplitDomainLevels.String(), Values: []*types.DynamicConfigValue{existing}},
+		},
+	}, nil)
+	s.serverAdminClient.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.UpdateDynamicConfigRequest, _ ...yarpc.CallOption) error {
+			s.Equal(dynamicconfig.QueueProcessorSplitDomainLevels.String(), request.ConfigName)
+			s.Len(request.ConfigValues, 1)
+			updated, err := convertToInputValue(request.ConfigValues[0])
+			s.NoError(err)
+			s.Equal(&cliValue{
+				Value:   map[string]interface{}{"other-domain-id": float64(2), "domain-id": float64(3)},
+				Filters: []*cliFilter{},
+			}, updated)
+			return nil
+		})
+	err = s.app.Run([]string{"", "--do", domainName, "admin", "queue", "split-domain", "--queue_level", "3"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminThrottleQueueDomain() {
+	describeResp := &types.DescribeDomainResponse{DomainInfo: &types.DomainInfo{UUID: "domain-id"}}
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeResp, nil)
+	s.serverAdminClient.EXPECT().ListDynamicConfig(gomock.Any(), gomock.Any()).Return(&types.ListDynamicConfigResponse{}, nil)
+	s.serverAdminClient.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.UpdateDynamicConfigRequest, _ ...yarpc.CallOption) error {
+			s.Equal(dynamicconfig.QueueProcessorThrottleSplitDomainByDomainID.String(), request.ConfigName)
+			s.Len(request.ConfigValues, 1)
+			added, err := convertToInputValue(request.ConfigValues[0])
+			s.NoError(err)
+			s.Equal(&cliValue{
+				Value:   true,
+				Filters: []*cliFilter{{Name: "domainID", Value: "domain-id"}},
+			}, added)
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "queue", "throttle-domain"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	var promptMsg string
 	promptFn = func(msg string) {
@@ -647,6 +887,73 @@ func (s *cliAppSuite) TestAdminFailover() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminFailover_SafeFailoverDryRun() {
+	resp := &types.StartWorkflowExecutionResponse{RunID: uuid.New()}
+	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+			var params failovermanager.FailoverParams
+			s.NoError(json.Unmarshal(request.Input, &params))
+			s.True(params.DryRun)
+			s.True(params.EnableHealthChecks)
+			s.True(params.RollbackOnCheckFailure)
+			s.Equal(0.1, params.MaxBatchFailureRate)
+			s.Equal([]string{"d1", "d2"}, params.CanaryDomains)
+			s.Equal(time.Minute, params.CanaryWaitTime)
+			s.Equal(2*time.Minute, params.MaxReplicationLag)
+			return resp, nil
+		})
+	// dry run does not pause the failover drill
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "cl", "fo", "start", "--tc", "standby", "--sc", "active",
+		"--dry_run", "--health_checks", "--rollback_on_failure", "--max_batch_failure_rate", "0.1",
+		"--canary_domains", "d1", "--canary_domains", "d2", "--canary_wait_second", "60", "--max_replication_lag_seconds", "120"})
+	s.Equal(0, errorCode)
+}
+
+func (s *cliAppSuite) TestCreateSchedule() {
+	s.serverFrontendClient.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.CreateScheduleRequest, _ ...yarpc.CallOption) error {
+			s.Equal(domainName, request.Domain)
+			s.Equal("sid", request.ScheduleID)
+			s.Equal("0 * * * *", request.Spec.CronExpression)
+			s.Equal("wt", request.Action.WorkflowType.Name)
+			s.Equal(types.ScheduleOverlapPolicyBuffer, *request.Policies.OverlapPolicy)
+			return nil
+		})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "schedule", "create", "--sch", "sid", "--cron", "0 * * * *",
+		"--olp", "buffer", "--wt", "wt", "--tl", "tl", "--et", "60"})
+	s.Equal(0, errorCode)
+}
+
+func (s *cliAppSuite) TestUpdateSchedule() {
+	s.serverFrontendClient.EXPECT().DescribeSchedule(gomock.Any(), gomock.Any()).Return(&types.DescribeScheduleResponse{
+		Spec:     &types.ScheduleSpec{CronExpression: "0 * * * *", JitterInSeconds: common.Int32Ptr(10)},
+		Action:   &types.ScheduleStartWorkflowAction{WorkflowType: &types.WorkflowType{Name: "wt"}, TaskList: &types.TaskList{Name: "tl"}},
+		Policies: &types.SchedulePolicies{},
+	}, nil)
+	s.serverFrontendClient.EXPECT().UpdateSchedule(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.UpdateScheduleRequest, _ ...yarpc.CallOption) error {
+			s.Equal("*/5 * * * *", request.Spec.CronExpression)
+			s.Equal(int32(10), request.Spec.GetJitterInSeconds())
+			s.Nil(request.Action)
+			s.Nil(request.Policies)
+			return nil
+		})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "schedule", "update", "--sch", "sid", "--cron", "*/5 * * * *"})
+	s.Equal(0, errorCode)
+}
+
+func (s *cliAppSuite) TestPauseSchedule() {
+	s.serverFrontendClient.EXPECT().PauseSchedule(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.PauseScheduleRequest, _ ...yarpc.CallOption) error {
+			s.Equal(domainName, request.Domain)
+			s.Equal("sid", request.ScheduleID)
+			s.Equal("incident", request.Reason)
+			return nil
+		})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "schedule", "pause", "--sch", "sid", "--reason", "incident"})
+	s.Equal(0, errorCode)
+}
+
 func (s *cliAppSuite) TestDescribeTaskList() {
 	resp := describeTaskListResponse
 	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(resp, nil)
@@ -813,13 +1120,13 @@ func (s *cliAppSuite) TestAnyToString_DecodeMapValues() {
 	execution := &types.WorkflowExecutionInfo{
 		Memo: &types.Memo{Fields: fields},
 	}
-	s.Equal("{HistoryLength:0, Memo:{Fields:map{TestKey:testValue}}, IsCron:false, PartitionConfig:map{}}", anyToString(execution, true, 0))
+	s.Equal("{HistoryLength:0, Memo:{Fields:map{TestKey:testValue}}, IsCron:false, PartitionConfig:map{}, IsPaused:false}", anyToString(execution, true, 0))
 
 	fields["TestKey2"] = []byte(`anotherTestValue`)
 	execution.Memo = &types.Memo{Fields: fields}
 	got := anyToString(execution, true, 0)
-	expected := got == "{HistoryLength:0, Memo:{Fields:map{TestKey2:anotherTestValue, TestKey:testValue}}, IsCron:false, PartitionConfig:map{}}" ||
-		got == "{HistoryLength:0, Memo:{Fields:map{TestKey:testValue, TestKey2:anotherTestValue}}, IsCron:false, PartitionConfig:map{}}"
+	expected := got == "{HistoryLength:0, Memo:{Fields:map{TestKey2:anotherTestValue, TestKey:testValue}}, IsCron:false, PartitionConfig:map{}, IsPaused:false}" ||
+		got == "{HistoryLength:0, Memo:{Fields:map{TestKey:testValue, TestKey2:anotherTestValue}}, IsCron:false, PartitionConfig:map{}, IsPaused:false}"
 	s.True(expected)
 }
 
@@ -974,3 +1281,315 @@ func (s *cliAppSuite) TestConvertArray() {
 		s.Nil(res)
 	}
 }
+
+func (s *cliAppSuite) setupDLQMessages(reads int) {
+	historyType := types.ReplicationTaskTypeHistoryV2
+	s.serverAdminClient.EXPECT().ReadDLQMessages(gomock.Any(), gomock.Any()).Return(&types.ReadDLQMessagesResponse{
+		ReplicationTasks: []*types.ReplicationTask{
+			{TaskType: &historyType, SourceTaskID: 1},
+		},
+		ReplicationTasksInfo: []*types.ReplicationTaskInfo{
+			{DomainID: "domain-id", WorkflowID: "wid1", RunID: "rid1", TaskID: 1},
+			{DomainID: "domain-id", WorkflowID: "wid2", RunID: "rid2", TaskID: 2},
+		},
+	}, nil).Times(reads)
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{Name: domainName},
+	}, nil).Times(1)
+}
+
+func (s *cliAppSuite) TestAdminGetDLQMessages_Filtered() {
+	s.serverAdminClient.EXPECT().ReadDLQMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.ReadDLQMessagesRequest, _ ...interface{}) (*types.ReadDLQMessagesResponse, error) {
+			s.Nil(request.NextPageToken)
+			s.Equal(&types.DLQFilter{WorkflowID: "wid2", ErrorClass: dlq.ErrorClassMissingEvents}, request.Filter)
+			return &types.ReadDLQMessagesResponse{}, nil
+		}).Times(1)
+	err := s.app.Run([]string{"", "admin", "dlq", "read", "--source_cluster", "c1", "--shards", "1", "--workflow_id", "wid2", "--error_class", dlq.ErrorClassMissingEvents})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminGetDLQMessages_InvalidTaskType() {
+	// osExit is mocked, so the command keeps running after reporting the error
+	s.serverAdminClient.EXPECT().ReadDLQMessages(gomock.Any(), gomock.Any()).Return(&types.ReadDLQMessagesResponse{}, nil).AnyTimes()
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "dlq", "read", "--source_cluster", "c1", "--shards", "1", "--task_type", "invalid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminGetDLQMessages_InvalidErrorClass() {
+	s.serverAdminClient.EXPECT().ReadDLQMessages(gomock.Any(), gomock.Any()).Return(&types.ReadDLQMessagesResponse{}, nil).AnyTimes()
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "dlq", "read", "--source_cluster", "c1", "--shards", "1", "--error_class", "invalid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminSummarizeDLQMessages() {
+	s.setupDLQMessages(1)
+	err := s.app.Run([]string{"", "admin", "dlq", "summary", "--source_cluster", "c1", "--shards", "1"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminMergeDLQMessages_Filtered() {
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{Name: domainName, UUID: "domain-id"},
+	}, nil).Times(1)
+	gomock.InOrder(
+		s.serverAdminClient.EXPECT().MergeDLQMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *types.MergeDLQMessagesRequest, _ ...interface{}) (*types.MergeDLQMessagesResponse, error) {
+				s.Nil(request.NextPageToken)
+				s.Equal(&types.DLQFilter{DomainID: "domain-id"}, request.Filter)
+				return &types.MergeDLQMessagesResponse{NextPageToken: []byte("next")}, nil
+			}),
+		s.serverAdminClient.EXPECT().MergeDLQMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *types.MergeDLQMessagesRequest, _ ...interface{}) (*types.MergeDLQMessagesResponse, error) {
+				s.Equal([]byte("next"), request.NextPageToken)
+				s.Equal(&types.DLQFilter{DomainID: "domain-id"}, request.Filter)
+				return &types.MergeDLQMessagesResponse{}, nil
+			}),
+	)
+	err := s.app.Run([]string{"", "admin", "dlq", "merge", "--source_cluster", "c1", "--shards", "1", "--domain", domainName})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminStartVisibilityReconcile() {
+	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.StartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+			s.Equal(common.SystemLocalDomainName, request.Domain)
+			s.Equal(visibilityreconciler.WorkflowIDPrefix+"-"+domainName, request.WorkflowID)
+			s.Equal(visibilityreconciler.WorkflowTypeName, request.WorkflowType.GetName())
+			var params visibilityreconciler.Params
+			s.NoError(json.Unmarshal(request.Input, &params))
+			s.Equal(domainName, params.Domain)
+			s.Equal("standby", params.StandbyCluster)
+			s.Equal(int64(10), params.StartTime.UnixNano())
+			s.True(params.Repair)
+			return &types.StartWorkflowExecutionResponse{RunID: "rid"}, nil
+		}).Times(1)
+	err := s.app.Run([]string{"", "admin", "cluster", "reconcile-visibility", "start", "--domain", domainName, "--standby_cluster", "standby", "--earliest_time", "10", "--repair"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDescribeVisibilityReconcile() {
+	result, err := json.Marshal(&visibilityreconciler.Result{Compared: 10, StandbyOnly: 1})
+	s.NoError(err)
+	s.serverFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(&types.QueryWorkflowResponse{
+		QueryResult: result,
+	}, nil).Times(1)
+	err = s.app.Run([]string{"", "admin", "cluster", "reconcile-visibility", "describe", "--domain", domainName})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminExportWorkflow() {
+	s.serverAdminClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.AdminDescribeWorkflowExecutionResponse{
+		MutableStateInDatabase: `{"ExecutionInfo":{"DomainID":"domain-id","RunID":"run-id"}}`,
+	}, nil).Times(1)
+	s.serverAdminClient.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionRawHistoryV2Response{
+		HistoryBatches: []*types.DataBlob{{EncodingType: types.EncodingTypeThriftRW.Ptr(), Data: []byte("batch")}},
+		VersionHistory: &types.VersionHistory{Items: []*types.VersionHistoryItem{{EventID: 1, Version: 0}}},
+	}, nil).Times(1)
+
+	outputFile := filepath.Join(s.T().TempDir(), "export.jsonl")
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "wf", "export", "-w", "test-wf-id", "--of", outputFile})
+	s.Nil(err)
+
+	data, err := os.ReadFile(outputFile)
+	s.NoError(err)
+	var export WorkflowExport
+	s.NoError(json.Unmarshal(data, &export))
+	s.Equal(workflowExportFormatVersion, export.FormatVersion)
+	s.Equal("domain-id", export.DomainID)
+	s.Equal("run-id", export.RunID)
+	s.Len(export.HistoryBatches, 1)
+	s.NotNil(export.VersionHistory)
+}
+
+func (s *cliAppSuite) TestAdminExportImportWorkflow() {
+	batches := []*types.DataBlob{
+		{EncodingType: types.EncodingTypeThriftRW.Ptr(), Data: []byte("batch-1")},
+		{EncodingType: types.EncodingTypeThriftRW.Ptr(), Data: []byte("batch-2")},
+	}
+	versionHistory := &types.VersionHistory{Items: []*types.VersionHistoryItem{{EventID: 5, Version: 0}}}
+	s.serverAdminClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.AdminDescribeWorkflowExecutionResponse{
+		MutableStateInDatabase: `{"ExecutionInfo":{"DomainID":"domain-id","RunID":"run-id"}}`,
+	}, nil).Times(1)
+	s.serverAdminClient.EXPECT().GetWorkflowExecutionRawHistoryV2(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionRawHistoryV2Response{
+		HistoryBatches: batches,
+		VersionHistory: versionHistory,
+	}, nil).Times(1)
+
+	exportFile := filepath.Join(s.T().TempDir(), "export.jsonl")
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "wf", "export", "-w", "test-wf-id", "--of", exportFile})
+	s.Nil(err)
+
+	execution := &types.WorkflowExecution{WorkflowID: "test-wf-id", RunID: "run-id"}
+	for _, batch := range batches {
+		s.serverAdminClient.EXPECT().ImportWorkflowExecution(gomock.Any(), &types.ImportWorkflowExecutionRequest{
+			Domain:              "target-domain",
+			WorkflowExecution:   execution,
+			VersionHistoryItems: versionHistory.Items,
+			Events:              batch,
+		}).Return(nil).Times(1)
+	}
+	err = s.app.Run([]string{"", "--do", "target-domain", "admin", "wf", "import", "--if", exportFile})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestMigrateDomain() {
+	checkpointFile := filepath.Join(s.T().TempDir(), "migration.json")
+	describeResp := &types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{Name: domainName, UUID: "domain-id"},
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{
+			ActiveClusterName: "source",
+			Clusters:          []*types.ClusterReplicationConfiguration{{ClusterName: "source"}},
+		},
+		IsGlobalDomain: true,
+	}
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeResp, nil).Times(1)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name:     domainName,
+		Clusters: []*types.ClusterReplicationConfiguration{{ClusterName: "source"}, {ClusterName: "target"}},
+	}).Return(&types.UpdateDomainResponse{}, nil).Times(1)
+	s.serverFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListOpenWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{Execution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"}},
+		},
+	}, nil).Times(1)
+	s.serverAdminClientForMigration.EXPECT().ResendReplicationTasks(gomock.Any(), &types.ResendReplicationTasksRequest{
+		DomainID:      "domain-id",
+		WorkflowID:    "wid",
+		RunID:         "rid",
+		RemoteCluster: "source",
+	}).Return(nil).Times(1)
+	s.serverFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{Count: 1}, nil).Times(1)
+	s.serverFrontendClientForMigration.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{Count: 1}, nil).Times(1)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name:              domainName,
+		ActiveClusterName: common.StringPtr("target"),
+	}).Return(&types.UpdateDomainResponse{}, nil).Times(1)
+	s.serverFrontendClientForMigration.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{ActiveClusterName: "target"},
+	}, nil).Times(1)
+	s.expectMigrationDemote()
+
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "migrate", "--tc", "target", "--checkpoint_file", checkpointFile})
+	s.Nil(err)
+
+	data, err := os.ReadFile(checkpointFile)
+	s.NoError(err)
+	var checkpoint DomainMigrationCheckpoint
+	s.NoError(json.Unmarshal(data, &checkpoint))
+	s.Equal(migrationStageDone, checkpoint.Stage)
+	s.Equal(1, checkpoint.Backfilled)
+}
+
+func (s *cliAppSuite) TestMigrateDomain_PromoteLocalDomain() {
+	checkpointFile := filepath.Join(s.T().TempDir(), "migration.json")
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{Name: domainName, UUID: "domain-id"},
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{
+			ActiveClusterName: "source",
+			Clusters:          []*types.ClusterReplicationConfiguration{{ClusterName: "source"}},
+		},
+		IsGlobalDomain: false,
+	}, nil).Times(1)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name:           domainName,
+		Clusters:       []*types.ClusterReplicationConfiguration{{ClusterName: "source"}, {ClusterName: "target"}},
+		IsGlobalDomain: common.BoolPtr(true),
+	}).Return(&types.UpdateDomainResponse{}, nil).Times(1)
+	s.serverFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListOpenWorkflowExecutionsResponse{}, nil).Times(1)
+	s.serverFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{}, nil).Times(1)
+	s.serverFrontendClientForMigration.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{}, nil).Times(1)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name:              domainName,
+		ActiveClusterName: common.StringPtr("target"),
+	}).Return(&types.UpdateDomainResponse{}, nil).Times(1)
+	s.serverFrontendClientForMigration.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{ActiveClusterName: "target"},
+	}, nil).Times(1)
+	s.expectMigrationDemote()
+
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "migrate", "--tc", "target", "--checkpoint_file", checkpointFile})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestMigrateDomain_ResumeDemote() {
+	checkpointFile := s.writeMigrationCheckpoint(migrationStageDemote)
+
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{
+			ActiveClusterName: "target",
+			Clusters:          []*types.ClusterReplicationConfiguration{{ClusterName: "source"}, {ClusterName: "target"}},
+		},
+		IsGlobalDomain: true,
+	}, nil).Times(1)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name:     domainName,
+		Clusters: []*types.ClusterReplicationConfiguration{{ClusterName: "target"}},
+	}).Return(&types.UpdateDomainResponse{}, nil).Times(1)
+
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "migrate", "--tc", "target", "--checkpoint_file", checkpointFile})
+	s.Nil(err)
+}
+
+// expectMigrationDemote expects the source cluster to be removed from the replication group after the failover
+func (s *cliAppSuite) expectMigrationDemote() {
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{
+			ActiveClusterName: "target",
+			Clusters:          []*types.ClusterReplicationConfiguration{{ClusterName: "source"}, {ClusterName: "target"}},
+		},
+		IsGlobalDomain: true,
+	}, nil).Times(1)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name:     domainName,
+		Clusters: []*types.ClusterReplicationConfiguration{{ClusterName: "target"}},
+	}).Return(&types.UpdateDomainResponse{}, nil).Times(1)
+}
+
+func (s *cliAppSuite) writeMigrationCheckpoint(stage string) string {
+	checkpointFile := filepath.Join(s.T().TempDir(), "migration.json")
+	data, err := json.Marshal(&DomainMigrationCheckpoint{
+		Domain:        domainName,
+		DomainID:      "domain-id",
+		SourceCluster: "source",
+		TargetCluster: "target",
+		Stage:         stage,
+	})
+	s.NoError(err)
+	s.NoError(os.WriteFile(checkpointFile, data, 0666))
+	return checkpointFile
+}
+
+func (s *cliAppSuite) TestMigrateDomain_ResumeFromCheckpoint() {
+	checkpointFile := s.writeMigrationCheckpoint(migrationStageVerify)
+
+	s.serverFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{Count: 10}, nil).Times(1)
+	s.serverFrontendClientForMigration.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{Count: 9}, nil).Times(1)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), &types.UpdateDomainRequest{
+		Name:              domainName,
+		ActiveClusterName: common.StringPtr("target"),
+	}).Return(&types.UpdateDomainResponse{}, nil).Times(1)
+	s.serverFrontendClientForMigration.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{ActiveClusterName: "target"},
+	}, nil).Times(1)
+	s.expectMigrationDemote()
+
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "migrate", "--tc", "target", "--checkpoint_file", checkpointFile, "--count_tolerance", "1"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestMigrateDomain_VerifyOutOfTolerance() {
+	checkpointFile := s.writeMigrationCheckpoint(migrationStageVerify)
+
+	// osExit is mocked, so the command keeps running after reporting the error
+	s.serverFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{Count: 10}, nil).Times(1)
+	s.serverFrontendClientForMigration.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{Count: 8}, nil).Times(1)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).Return(&types.UpdateDomainResponse{}, nil).AnyTimes()
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{}, nil).AnyTimes()
+	s.serverFrontendClientForMigration.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{ActiveClusterName: "target"},
+	}, nil).AnyTimes()
+
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "migrate", "--tc", "target", "--checkpoint_file", checkpointFile, "--count_tolerance", "1"})
+	s.Equal(1, errorCode)
+}
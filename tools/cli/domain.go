@@ -92,5 +92,14 @@ func newDomainCommands() []cli.Command {
 				NewDomainMigrationCommand(c).Validation(c)
 			},
 		},
+		{
+			Name:    "migrate",
+			Aliases: []string{"mig"},
+			Usage:   "Move a domain to another cluster: promote a local domain to global, add the cluster to the domain, backfill workflows, verify, fail over and remove the source cluster from the domain. Progress is saved to a checkpoint file and the command can be run again to resume",
+			Flags:   append(migrateDomainToClusterFlags, getDBFlags()...),
+			Action: func(c *cli.Context) {
+				MigrateDomain(c)
+			},
+		},
 	}
 }
@@ -0,0 +1,509 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/urfave/cli"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/fetcher"
+	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/scanner/executions"
+)
+
+const (
+	migrationStageReplicate      = "replicate"
+	migrationStageBackfillOpen   = "backfill-open"
+	migrationStageBackfillClosed = "backfill-closed"
+	migrationStageVerify         = "verify"
+	migrationStageFailover       = "failover"
+	migrationStageDemote         = "demote"
+	migrationStageDone           = "done"
+
+	migrationFailoverWaitTimeout = 5 * time.Minute
+	migrationFailoverPollWait    = 5 * time.Second
+)
+
+// DomainMigrationCheckpoint is the progress of a domain migration. It is saved after every step,
+// so that an interrupted migration can be resumed from where it stopped.
+type DomainMigrationCheckpoint struct {
+	Domain        string `json:"domain"`
+	DomainID      string `json:"domainID"`
+	SourceCluster string `json:"sourceCluster"`
+	TargetCluster string `json:"targetCluster"`
+	Stage         string `json:"stage"`
+	// NextPageToken is the visibility page token of the current backfill stage
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+	Backfilled    int    `json:"backfilled"`
+	// CorruptedWorkflows are the workflows which failed validation and were not backfilled, as workflowID/runID
+	CorruptedWorkflows []string `json:"corruptedWorkflows,omitempty"`
+	// FailedWorkflows are the workflows that could not be validated or backfilled, as workflowID/runID
+	FailedWorkflows []string `json:"failedWorkflows,omitempty"`
+}
+
+type domainMigration struct {
+	sourceClient, targetClient frontend.Client
+	targetAdminClient          admin.Client
+	checkpointFile             string
+	checkpoint                 *DomainMigrationCheckpoint
+	// validator is nil unless open workflows are validated before they are backfilled
+	validator *migrationValidator
+}
+
+// MigrateDomain moves a domain to the target cluster: it promotes the domain to a global domain if it is
+// local and adds the target cluster to its replication group, backfills its workflows to the target cluster,
+// verifies the open workflow counts, fails the domain over and removes the source cluster from the
+// replication group. Domain updates are sent to the source cluster, which has to be the primary cluster.
+func MigrateDomain(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	targetCluster := getRequiredOption(c, FlagTargetCluster)
+	checkpointFile := c.String(FlagCheckpointFile)
+	if checkpointFile == "" {
+		checkpointFile = domain + ".migration.json"
+	}
+
+	m := &domainMigration{
+		sourceClient:      cFactory.ServerFrontendClient(c),
+		targetClient:      cFactory.ServerFrontendClientForMigration(c),
+		targetAdminClient: cFactory.ServerAdminClientForMigration(c),
+		checkpointFile:    checkpointFile,
+	}
+	if c.Bool(FlagValidateWorkflows) {
+		m.validator = newMigrationValidator(c)
+	}
+	m.loadCheckpoint(domain, targetCluster)
+
+	for m.checkpoint.Stage != migrationStageDone {
+		fmt.Printf("Domain %v migration stage: %v\n", domain, m.checkpoint.Stage)
+		switch m.checkpoint.Stage {
+		case migrationStageReplicate:
+			m.replicate(c)
+			m.nextStage(migrationStageBackfillOpen)
+		case migrationStageBackfillOpen:
+			if m.backfill(c, false) {
+				if c.Bool(FlagIncludeClosed) {
+					m.nextStage(migrationStageBackfillClosed)
+				} else {
+					m.nextStage(migrationStageVerify)
+				}
+			}
+		case migrationStageBackfillClosed:
+			if m.backfill(c, true) {
+				m.nextStage(migrationStageVerify)
+			}
+		case migrationStageVerify:
+			m.verify(c)
+			m.nextStage(migrationStageFailover)
+		case migrationStageFailover:
+			m.failover(c)
+			m.nextStage(migrationStageDemote)
+		case migrationStageDemote:
+			m.demote(c)
+			m.nextStage(migrationStageDone)
+		default:
+			ErrorAndExit(fmt.Sprintf("Unknown migration stage %v in checkpoint file %v.", m.checkpoint.Stage, m.checkpointFile), nil)
+			return
+		}
+	}
+
+	fmt.Printf("Domain %v migrated to cluster %v. Backfilled %v workflows, %v corrupted, %v failed.\n",
+		domain, targetCluster, m.checkpoint.Backfilled, len(m.checkpoint.CorruptedWorkflows), len(m.checkpoint.FailedWorkflows))
+	for _, workflow := range m.checkpoint.CorruptedWorkflows {
+		fmt.Printf("  corrupted, not backfilled: %v\n", workflow)
+	}
+	for _, workflow := range m.checkpoint.FailedWorkflows {
+		fmt.Printf("  failed to backfill: %v\n", workflow)
+	}
+}
+
+func (m *domainMigration) loadCheckpoint(domain, targetCluster string) {
+	data, err := ioutil.ReadFile(m.checkpointFile)
+	if os.IsNotExist(err) {
+		m.checkpoint = &DomainMigrationCheckpoint{
+			Domain:        domain,
+			TargetCluster: targetCluster,
+			Stage:         migrationStageReplicate,
+		}
+		return
+	}
+	if err != nil {
+		ErrorAndExit("Failed to read migration checkpoint file.", err)
+	}
+	checkpoint := &DomainMigrationCheckpoint{}
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		ErrorAndExit("Failed to parse migration checkpoint file.", err)
+	}
+	if checkpoint.Domain != domain || checkpoint.TargetCluster != targetCluster {
+		ErrorAndExit(fmt.Sprintf("Checkpoint file %v belongs to the migration of domain %v to cluster %v.",
+			m.checkpointFile, checkpoint.Domain, checkpoint.TargetCluster), nil)
+	}
+	fmt.Printf("Resuming migration from checkpoint file %v.\n", m.checkpointFile)
+	m.checkpoint = checkpoint
+}
+
+func (m *domainMigration) saveCheckpoint() {
+	data, err := json.Marshal(m.checkpoint)
+	if err != nil {
+		ErrorAndExit("Failed to serialize migration checkpoint.", err)
+	}
+	if err := ioutil.WriteFile(m.checkpointFile, data, 0666); err != nil {
+		ErrorAndExit("Failed to write migration checkpoint file.", err)
+	}
+}
+
+func (m *domainMigration) nextStage(stage string) {
+	m.checkpoint.Stage = stage
+	m.checkpoint.NextPageToken = nil
+	m.saveCheckpoint()
+}
+
+// replicate promotes a local domain to a global domain and makes sure the target cluster is in its replication group
+func (m *domainMigration) replicate(c *cli.Context) {
+	resp := m.describeDomain(c)
+	m.checkpoint.DomainID = resp.GetDomainInfo().GetUUID()
+	m.checkpoint.SourceCluster = resp.ReplicationConfiguration.GetActiveClusterName()
+	if m.checkpoint.SourceCluster == m.checkpoint.TargetCluster {
+		ErrorAndExit(fmt.Sprintf("Domain is already active in cluster %v.", m.checkpoint.TargetCluster), nil)
+	}
+
+	clusters := resp.ReplicationConfiguration.GetClusters()
+	for _, cluster := range clusters {
+		if cluster.GetClusterName() == m.checkpoint.TargetCluster && resp.GetIsGlobalDomain() {
+			fmt.Printf("Cluster %v is already in the replication group.\n", m.checkpoint.TargetCluster)
+			return
+		}
+	}
+	request := &types.UpdateDomainRequest{
+		Name:     m.checkpoint.Domain,
+		Clusters: append(clusters, &types.ClusterReplicationConfiguration{ClusterName: m.checkpoint.TargetCluster}),
+	}
+	if !resp.GetIsGlobalDomain() {
+		request.IsGlobalDomain = common.BoolPtr(true)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if _, err := m.sourceClient.UpdateDomain(ctx, request); err != nil {
+		ErrorAndExit("Failed to add target cluster to the domain.", err)
+	}
+	if request.IsGlobalDomain != nil {
+		fmt.Printf("Promoted domain to a global domain.\n")
+	}
+	fmt.Printf("Added cluster %v to the replication group.\n", m.checkpoint.TargetCluster)
+}
+
+func (m *domainMigration) describeDomain(c *cli.Context) *types.DescribeDomainResponse {
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := m.sourceClient.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(m.checkpoint.Domain)})
+	if err != nil {
+		ErrorAndExit("Failed to describe domain.", err)
+	}
+	return resp
+}
+
+// backfill resends the workflows of the domain to the target cluster, one visibility page at a time.
+// It returns true once all pages are done.
+func (m *domainMigration) backfill(c *cli.Context, closed bool) bool {
+	executions, token := m.listWorkflows(c, closed, m.checkpoint.NextPageToken)
+	for _, execution := range executions {
+		workflow := execution.GetWorkflowID() + "/" + execution.GetRunID()
+		if m.validator != nil && !closed {
+			result, err := m.validator.validate(c, m.checkpoint.DomainID, m.checkpoint.Domain, execution)
+			if err != nil {
+				m.checkpoint.FailedWorkflows = append(m.checkpoint.FailedWorkflows, workflow)
+				fmt.Printf("Failed to validate workflow %v, run %v: %v\n", execution.GetWorkflowID(), execution.GetRunID(), err)
+				continue
+			}
+			switch result.CheckResultType {
+			case invariant.CheckResultTypeHealthy:
+			case invariant.CheckResultTypeCorrupted:
+				m.checkpoint.CorruptedWorkflows = append(m.checkpoint.CorruptedWorkflows, workflow)
+				fmt.Printf("Workflow %v, run %v is corrupted, invariant: %v\n", execution.GetWorkflowID(), execution.GetRunID(), *result.DeterminingInvariantType)
+				continue
+			default:
+				m.checkpoint.FailedWorkflows = append(m.checkpoint.FailedWorkflows, workflow)
+				fmt.Printf("Failed to validate workflow %v, run %v: %v\n", execution.GetWorkflowID(), execution.GetRunID(), result.CheckResultType)
+				continue
+			}
+		}
+
+		ctx, cancel := newContext(c)
+		err := m.targetAdminClient.ResendReplicationTasks(ctx, &types.ResendReplicationTasksRequest{
+			DomainID:      m.checkpoint.DomainID,
+			WorkflowID:    execution.GetWorkflowID(),
+			RunID:         execution.GetRunID(),
+			RemoteCluster: m.checkpoint.SourceCluster,
+		})
+		cancel()
+		if err != nil {
+			m.checkpoint.FailedWorkflows = append(m.checkpoint.FailedWorkflows, workflow)
+			fmt.Printf("Failed to backfill workflow %v, run %v: %v\n", execution.GetWorkflowID(), execution.GetRunID(), err)
+			continue
+		}
+		m.checkpoint.Backfilled++
+	}
+	m.checkpoint.NextPageToken = token
+	m.saveCheckpoint()
+	fmt.Printf("Backfilled %v workflows, %v corrupted, %v failed.\n",
+		m.checkpoint.Backfilled, len(m.checkpoint.CorruptedWorkflows), len(m.checkpoint.FailedWorkflows))
+	return len(token) == 0
+}
+
+func (m *domainMigration) listWorkflows(c *cli.Context, closed bool, token []byte) ([]*types.WorkflowExecution, []byte) {
+	ctx, cancel := newContextForLongPoll(c)
+	defer cancel()
+
+	startTimeFilter := &types.StartTimeFilter{
+		EarliestTime: common.Int64Ptr(0),
+		LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+	}
+	var infos []*types.WorkflowExecutionInfo
+	var nextPageToken []byte
+	if closed {
+		resp, err := m.sourceClient.ListClosedWorkflowExecutions(ctx, &types.ListClosedWorkflowExecutionsRequest{
+			Domain:          m.checkpoint.Domain,
+			MaximumPageSize: int32(defaultPageSizeForList),
+			NextPageToken:   token,
+			StartTimeFilter: startTimeFilter,
+		})
+		if err != nil {
+			ErrorAndExit("Failed to list closed workflows.", err)
+		}
+		infos, nextPageToken = resp.GetExecutions(), resp.NextPageToken
+	} else {
+		resp, err := m.sourceClient.ListOpenWorkflowExecutions(ctx, &types.ListOpenWorkflowExecutionsRequest{
+			Domain:          m.checkpoint.Domain,
+			MaximumPageSize: int32(defaultPageSizeForList),
+			NextPageToken:   token,
+			StartTimeFilter: startTimeFilter,
+		})
+		if err != nil {
+			ErrorAndExit("Failed to list open workflows.", err)
+		}
+		infos, nextPageToken = resp.GetExecutions(), resp.NextPageToken
+	}
+
+	executions := make([]*types.WorkflowExecution, 0, len(infos))
+	for _, info := range infos {
+		executions = append(executions, info.GetExecution())
+	}
+	return executions, nextPageToken
+}
+
+// verify compares the open workflow counts of the domain in both clusters. Workflows keep starting and
+// closing in the source cluster while the counts are taken, so the counts only have to be within the
+// tolerance given by the count tolerance flag.
+func (m *domainMigration) verify(c *cli.Context) {
+	request := &types.CountWorkflowExecutionsRequest{
+		Domain: m.checkpoint.Domain,
+		Query:  "CloseTime=missing",
+	}
+
+	ctx, cancel := newContextForLongPoll(c)
+	sourceResp, err := m.sourceClient.CountWorkflowExecutions(ctx, request)
+	cancel()
+	if err != nil {
+		ErrorAndExit("Failed to count open workflows in source cluster.", err)
+	}
+	ctx, cancel = newContextForLongPoll(c)
+	targetResp, err := m.targetClient.CountWorkflowExecutions(ctx, request)
+	cancel()
+	if err != nil {
+		ErrorAndExit("Failed to count open workflows in target cluster.", err)
+	}
+
+	fmt.Printf("Open workflows in source cluster: %v, in target cluster: %v.\n", sourceResp.GetCount(), targetResp.GetCount())
+	diff := sourceResp.GetCount() - targetResp.GetCount()
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff > int64(c.Int(FlagCountTolerance)) {
+		ErrorAndExit(fmt.Sprintf("Open workflow counts differ by %v, more than the tolerance of %v. Run the command again to resume once replication has caught up.",
+			diff, c.Int(FlagCountTolerance)), nil)
+	}
+}
+
+func (m *domainMigration) failover(c *cli.Context) {
+	m.updateDomain(c, &types.UpdateDomainRequest{
+		Name:              m.checkpoint.Domain,
+		ActiveClusterName: common.StringPtr(m.checkpoint.TargetCluster),
+	}, "Failed to fail over domain.")
+
+	deadline := time.Now().Add(migrationFailoverWaitTimeout)
+	for {
+		describeCtx, describeCancel := newContext(c)
+		resp, err := m.targetClient.DescribeDomain(describeCtx, &types.DescribeDomainRequest{Name: common.StringPtr(m.checkpoint.Domain)})
+		describeCancel()
+		if err == nil && resp.ReplicationConfiguration.GetActiveClusterName() == m.checkpoint.TargetCluster {
+			fmt.Printf("Domain is active in cluster %v.\n", m.checkpoint.TargetCluster)
+			return
+		}
+		if time.Now().After(deadline) {
+			ErrorAndExit("Target cluster did not see the failover in time. Run the command again to resume.", err)
+			return
+		}
+		time.Sleep(migrationFailoverPollWait)
+	}
+}
+
+// demote removes the source cluster from the replication group of the domain
+func (m *domainMigration) demote(c *cli.Context) {
+	resp := m.describeDomain(c)
+	var clusters []*types.ClusterReplicationConfiguration
+	for _, cluster := range resp.ReplicationConfiguration.GetClusters() {
+		if cluster.GetClusterName() != m.checkpoint.SourceCluster {
+			clusters = append(clusters, cluster)
+		}
+	}
+	if len(clusters) == len(resp.ReplicationConfiguration.GetClusters()) {
+		fmt.Printf("Cluster %v is not in the replication group.\n", m.checkpoint.SourceCluster)
+		return
+	}
+
+	m.updateDomain(c, &types.UpdateDomainRequest{
+		Name:     m.checkpoint.Domain,
+		Clusters: clusters,
+	}, "Failed to remove source cluster from the domain.")
+	fmt.Printf("Removed cluster %v from the replication group.\n", m.checkpoint.SourceCluster)
+}
+
+// updateDomain updates the domain through the source cluster. The domain handler rejects updates within
+// the failover cool down of the previous one as service busy, so those are retried until the wait timeout.
+func (m *domainMigration) updateDomain(c *cli.Context, request *types.UpdateDomainRequest, errorMessage string) {
+	deadline := time.Now().Add(migrationFailoverWaitTimeout)
+	for {
+		ctx, cancel := newContext(c)
+		_, err := m.sourceClient.UpdateDomain(ctx, request)
+		cancel()
+		if err == nil {
+			return
+		}
+		if _, ok := err.(*types.ServiceBusyError); !ok || time.Now().After(deadline) {
+			ErrorAndExit(errorMessage, err)
+			return
+		}
+		time.Sleep(migrationFailoverPollWait)
+	}
+}
+
+// migrationValidator runs the read-only invariant checks of the execution scanner against the database
+// of the source cluster. Unlike the task validator it never deletes a workflow.
+type migrationValidator struct {
+	numberOfShards int
+	domainCache    cache.DomainCache
+	historyManager persistence.HistoryManager
+	retryers       map[int]persistence.Retryer
+	invariants     []executions.InvariantFactory
+	fetcher        executions.ExecutionFetcher
+}
+
+func newMigrationValidator(c *cli.Context) *migrationValidator {
+	configuration, err := cFactory.ServerConfig(c)
+	if err != nil {
+		ErrorAndExit("Unable to load config.", err)
+	}
+	metricsClient := initializeMetricsClient()
+	logger := log.NewNoop()
+	collections := []invariant.Collection{
+		invariant.CollectionHistory,
+		invariant.CollectionMutableState,
+		invariant.CollectionStale,
+	}
+	return &migrationValidator{
+		numberOfShards: getRequiredIntOption(c, FlagNumberOfShards),
+		domainCache: cache.NewDomainCache(
+			initializeDomainManager(c),
+			initializeClusterMetadata(configuration, metricsClient, logger),
+			metricsClient,
+			logger,
+		),
+		historyManager: initializeHistoryManager(c),
+		retryers:       map[int]persistence.Retryer{},
+		invariants:     executions.ConcreteExecutionType.ToInvariants(collections, zap.NewNop()),
+		fetcher:        executions.ConcreteExecutionType.ToExecutionFetcher(),
+	}
+}
+
+// validate runs the invariant checks on an open workflow
+func (v *migrationValidator) validate(
+	c *cli.Context,
+	domainID string,
+	domainName string,
+	execution *types.WorkflowExecution,
+) (invariant.ManagerCheckResult, error) {
+	shardID := common.WorkflowIDToHistoryShard(execution.GetWorkflowID(), v.numberOfShards)
+	retryer, ok := v.retryers[shardID]
+	if !ok {
+		retryer = persistence.NewPersistenceRetryer(
+			initializeExecutionStore(c, shardID),
+			v.historyManager,
+			common.CreatePersistenceRetryPolicy(),
+		)
+		v.retryers[shardID] = retryer
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	return checkMigrationExecution(ctx, retryer, v.domainCache, v.invariants, v.fetcher, fetcher.ExecutionRequest{
+		DomainID:   domainID,
+		DomainName: domainName,
+		WorkflowID: execution.GetWorkflowID(),
+		RunID:      execution.GetRunID(),
+	})
+}
+
+func checkMigrationExecution(
+	ctx context.Context,
+	retryer persistence.Retryer,
+	domainCache cache.DomainCache,
+	invariants []executions.InvariantFactory,
+	fetchExecution executions.ExecutionFetcher,
+	request fetcher.ExecutionRequest,
+) (invariant.ManagerCheckResult, error) {
+	execution, err := fetchExecution(ctx, retryer, request)
+	if err != nil {
+		return invariant.ManagerCheckResult{}, err
+	}
+
+	var ivs []invariant.Invariant
+	for _, fn := range invariants {
+		ivs = append(ivs, fn(retryer, domainCache))
+	}
+	return invariant.NewInvariantManager(ivs).RunChecks(ctx, execution), nil
+}
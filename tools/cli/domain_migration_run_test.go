@@ -0,0 +1,81 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/reconciliation/fetcher"
+	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/service/worker/scanner/executions"
+)
+
+func TestCheckMigrationExecution(t *testing.T) {
+	request := fetcher.ExecutionRequest{DomainID: "domain-id", DomainName: "domain", WorkflowID: "wid", RunID: "rid"}
+	execution := &entity.ConcreteExecution{}
+	tests := []struct {
+		name        string
+		fetchErr    error
+		checkResult invariant.CheckResultType
+		expected    invariant.CheckResultType
+		expectOK    bool
+	}{
+		{name: "healthy", checkResult: invariant.CheckResultTypeHealthy, expected: invariant.CheckResultTypeHealthy, expectOK: true},
+		{name: "corrupted", checkResult: invariant.CheckResultTypeCorrupted, expected: invariant.CheckResultTypeCorrupted, expectOK: true},
+		{name: "fetch error", fetchErr: errors.New("db error"), expectOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			retryer := persistence.NewMockRetryer(ctrl)
+			iv := invariant.NewMockInvariant(ctrl)
+			if tt.fetchErr == nil {
+				// the checks only read, the invariants must never be asked to fix the workflow
+				iv.EXPECT().Check(gomock.Any(), execution).Return(invariant.CheckResult{
+					CheckResultType: tt.checkResult,
+					InvariantName:   invariant.HistoryExists,
+				}).Times(1)
+			}
+			fetch := func(_ context.Context, r persistence.Retryer, req fetcher.ExecutionRequest) (entity.Entity, error) {
+				assert.Equal(t, retryer, r)
+				assert.Equal(t, request, req)
+				return execution, tt.fetchErr
+			}
+			factories := []executions.InvariantFactory{
+				func(persistence.Retryer, cache.DomainCache) invariant.Invariant { return iv },
+			}
+
+			result, err := checkMigrationExecution(context.Background(), retryer, cache.NewNoOpDomainCache(), factories, fetch, request)
+			assert.Equal(t, tt.expectOK, err == nil)
+			assert.Equal(t, tt.expected, result.CheckResultType)
+		})
+	}
+}
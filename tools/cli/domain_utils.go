@@ -225,6 +225,37 @@ var (
 		getFormatFlag(),
 	}
 
+	migrateDomainToClusterFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagDestinationAddress,
+			Usage: "Target cluster cadence-frontend address in <host>:<port> format",
+		},
+		cli.StringFlag{
+			Name:  FlagTargetClusterWithAlias,
+			Usage: "Name of the target cluster",
+		},
+		cli.StringFlag{
+			Name:  FlagCheckpointFile,
+			Usage: "File to save and resume the migration progress from. Default: <domain>.migration.json",
+		},
+		cli.BoolFlag{
+			Name:  FlagIncludeClosed,
+			Usage: "Also backfill closed workflows which are still retained",
+		},
+		cli.BoolFlag{
+			Name:  FlagValidateWorkflows,
+			Usage: "Run the read-only invariant checks on open workflows before backfilling them. Corrupted workflows are reported instead of being backfilled. Requires access to the database of the source cluster",
+		},
+		cli.IntFlag{
+			Name:  FlagNumberOfShards,
+			Usage: "Number of history shards of the source cluster, required with --" + FlagValidateWorkflows,
+		},
+		cli.IntFlag{
+			Name:  FlagCountTolerance,
+			Usage: "Maximum difference between the open workflow counts of the source and target cluster accepted by the verify stage",
+		},
+	}
+
 	adminDomainCommonFlags = getDBFlags()
 
 	adminRegisterDomainFlags = append(
@@ -82,6 +82,8 @@ const (
 	FlagDecisionTimeoutWithAlias          = FlagDecisionTimeout + ", dt"
 	FlagContextTimeout                    = "context_timeout"
 	FlagContextTimeoutWithAlias           = FlagContextTimeout + ", ct"
+	FlagWaitTimeout                       = "wait_timeout"
+	FlagAttach                            = "attach"
 	FlagInput                             = "input"
 	FlagInputWithAlias                    = FlagInput + ", i"
 	FlagInputFile                         = "input_file"
@@ -226,8 +228,12 @@ const (
 	FlagSignalNameWithAlias               = FlagSignalName + ", sig"
 	FlagTaskID                            = "task_id"
 	FlagTaskType                          = "task_type"
+	FlagErrorClass                        = "error_class"
 	FlagTaskVisibilityTimestamp           = "task_timestamp"
 	FlagQueueType                         = "queue_type"
+	FlagQueueLevel                        = "queue_level"
+	FlagDiff                              = "diff"
+	FlagRebuild                           = "rebuild"
 	FlagStartingRPS                       = "starting_rps"
 	FlagRPS                               = "rps"
 	FlagRPSScaleUpSeconds                 = "rps_scale_up_seconds"
@@ -264,6 +270,9 @@ const (
 	FlagFailoverTimeoutWithAlias          = FlagFailoverTimeout + ", fts"
 	FlagActivityHeartBeatTimeout          = "heart_beat_timeout_seconds"
 	FlagActivityHeartBeatTimeoutWithAlias = FlagActivityHeartBeatTimeout + ", hbts"
+	FlagScheduleToStartTimeout            = "schedule_to_start_timeout_seconds"
+	FlagScheduleToCloseTimeout            = "schedule_to_close_timeout_seconds"
+	FlagStartToCloseTimeout               = "start_to_close_timeout_seconds"
 	FlagFailoverWaitTime                  = "failover_wait_time_second"
 	FlagFailoverWaitTimeWithAlias         = FlagFailoverWaitTime + ", fwts"
 	FlagFailoverBatchSize                 = "failover_batch_size"
@@ -302,6 +311,33 @@ const (
 	FlagIsolationGroupSetDrains           = "set-drains"
 	FlagIsolationGroupsRemoveAllDrains    = "remove-all-drains"
 	FlagSearchAttribute                   = "search_attr"
+	FlagScheduleID                        = "schedule_id"
+	FlagScheduleIDWithAlias               = FlagScheduleID + ", sch"
+	FlagCalendar                          = "calendar"
+	FlagInterval                          = "interval"
+	FlagJitter                            = "jitter"
+	FlagStartTime                         = "start_time"
+	FlagEndTime                           = "end_time"
+	FlagOverlapPolicy                     = "overlap_policy"
+	FlagOverlapPolicyWithAlias            = FlagOverlapPolicy + ", olp"
+	FlagCatchUpWindow                     = "catchup_window"
+	FlagBufferLimit                       = "buffer_limit"
+	FlagWorkflowIDPrefix                  = "workflow_id_prefix"
+	FlagPerDomain                         = "per_domain"
+	FlagMaxPendingTasks                   = "max_pending_tasks"
+	FlagSampleIntervalSeconds             = "sample_interval_seconds"
+	FlagMaxReplicationLagSeconds          = "max_replication_lag_seconds"
+	FlagHealthChecks                      = "health_checks"
+	FlagMaxBatchFailureRate               = "max_batch_failure_rate"
+	FlagCanaryDomains                     = "canary_domains"
+	FlagCanaryWaitTime                    = "canary_wait_second"
+	FlagRollbackOnFailure                 = "rollback_on_failure"
+	FlagCheckpointFile                    = "checkpoint_file"
+	FlagIncludeClosed                     = "include_closed"
+	FlagValidateWorkflows                 = "validate_workflows"
+	FlagCountTolerance                    = "count_tolerance"
+	FlagStandbyCluster                    = "standby_cluster"
+	FlagRepair                            = "repair"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -498,6 +534,15 @@ func getFlagsForRun() []cli.Flag {
 			Name:  FlagMaxFieldLengthWithAlias,
 			Usage: "Maximum length for each attribute field",
 		},
+		cli.BoolFlag{
+			Name: FlagAttach,
+			Usage: "Wait for the running workflow with the same workflow Id and type instead of failing " +
+				"when the workflow is already started",
+		},
+		cli.IntFlag{
+			Name:  FlagWaitTimeout,
+			Usage: "Optional timeout in seconds to wait for the workflow to close, the command fails once it passes",
+		},
 	}
 	flagsForRun = append(getFlagsForStart(), flagsForRun...)
 	return flagsForRun
@@ -558,6 +603,20 @@ func getFlagsForCancel() []cli.Flag {
 	})
 }
 
+func getFlagsForPause() []cli.Flag {
+	return append(flagsForExecution, cli.StringFlag{
+		Name:  FlagReasonWithAlias,
+		Usage: "The reason you want to pause the workflow",
+	})
+}
+
+func getFlagsForUnpause() []cli.Flag {
+	return append(flagsForExecution, cli.StringFlag{
+		Name:  FlagReasonWithAlias,
+		Usage: "The reason you want to unpause the workflow",
+	})
+}
+
 func getFormatFlag() cli.Flag {
 	return cli.StringFlag{
 		Name:  FlagFormat,
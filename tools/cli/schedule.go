@@ -0,0 +1,218 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import "github.com/urfave/cli"
+
+func newScheduleCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "create",
+			Aliases: []string{"c"},
+			Usage:   "Create a schedule that starts a workflow at the times matched by its spec",
+			Flags:   append(getFlagsForScheduleSpec(), getFlagsForScheduleAction()...),
+			Action: func(c *cli.Context) {
+				CreateSchedule(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe the spec, policies and state of a schedule",
+			Flags:   getFlagsForScheduleID(),
+			Action: func(c *cli.Context) {
+				DescribeSchedule(c)
+			},
+		},
+		{
+			Name:    "update",
+			Aliases: []string{"u"},
+			Usage:   "Update a schedule, only the given flags are changed",
+			Flags:   append(getFlagsForScheduleSpec(), getFlagsForScheduleAction()...),
+			Action: func(c *cli.Context) {
+				UpdateSchedule(c)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause a schedule, runs which would happen while paused are skipped",
+			Flags: append(getFlagsForScheduleID(),
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for pausing the schedule",
+				},
+			),
+			Action: func(c *cli.Context) {
+				PauseSchedule(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "Unpause a paused schedule",
+			Flags: append(getFlagsForScheduleID(),
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for unpausing the schedule",
+				},
+			),
+			Action: func(c *cli.Context) {
+				UnpauseSchedule(c)
+			},
+		},
+		{
+			Name:  "trigger",
+			Usage: "Start a run of the schedule now",
+			Flags: append(getFlagsForScheduleID(),
+				cli.StringFlag{
+					Name:  FlagOverlapPolicyWithAlias,
+					Usage: "Optional overlap policy overriding the schedule's policy for this run: skip, buffer, cancel-other or allow-all",
+				},
+			),
+			Action: func(c *cli.Context) {
+				TriggerSchedule(c)
+			},
+		},
+		{
+			Name:  "backfill",
+			Usage: "Start the runs the schedule would have started in the given time range",
+			Flags: append(getFlagsForScheduleID(),
+				cli.StringFlag{
+					Name:  FlagStartTime,
+					Usage: "Start of the backfill range (exclusive), in UTC format '2006-01-02T15:04:05Z', raw UnixNano or a time range like '3d'",
+				},
+				cli.StringFlag{
+					Name:  FlagEndTime,
+					Usage: "Optional end of the backfill range (inclusive), defaults to now",
+				},
+				cli.StringFlag{
+					Name:  FlagOverlapPolicyWithAlias,
+					Usage: "Optional overlap policy overriding the schedule's policy for the backfilled runs: skip, buffer, cancel-other or allow-all",
+				},
+			),
+			Action: func(c *cli.Context) {
+				BackfillSchedule(c)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete a schedule, open runs of the schedule are not affected",
+			Flags: getFlagsForScheduleID(),
+			Action: func(c *cli.Context) {
+				DeleteSchedule(c)
+			},
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the schedules of a domain",
+			Action: func(c *cli.Context) {
+				ListSchedules(c)
+			},
+		},
+	}
+}
+
+func getFlagsForScheduleID() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagScheduleIDWithAlias,
+			Usage: "ScheduleID",
+		},
+	}
+}
+
+func getFlagsForScheduleSpec() []cli.Flag {
+	return append(getFlagsForScheduleID(),
+		cli.StringFlag{
+			Name:  FlagCronSchedule,
+			Usage: "Cron expression of the schedule in standard five field format",
+		},
+		cli.StringSliceFlag{
+			Name: FlagCalendar,
+			Usage: "Calendar spec of the schedule in JSON, can be passed multiple times. Fields are Second, Minute, Hour, " +
+				"DayOfMonth, Month and DayOfWeek, each in cron syntax, e.g. '{\"Hour\":\"9\",\"DayOfWeek\":\"MON-FRI\"}'",
+		},
+		cli.StringFlag{
+			Name:  FlagInterval,
+			Usage: "Interval of the schedule, e.g. '90m'",
+		},
+		cli.StringFlag{
+			Name:  FlagJitter,
+			Usage: "Optional maximum random delay applied to each run, e.g. '30s'",
+		},
+		cli.StringFlag{
+			Name:  FlagStartTime,
+			Usage: "Optional time before which the schedule does not fire, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagEndTime,
+			Usage: "Optional time after which the schedule does not fire, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagOverlapPolicyWithAlias,
+			Usage: "Optional policy when a run is due while a previous run is open: skip (default), buffer, cancel-other or allow-all",
+		},
+		cli.StringFlag{
+			Name:  FlagCatchUpWindow,
+			Usage: "Optional maximum delay after which a missed run is skipped, e.g. '10m', defaults to one minute",
+		},
+		cli.IntFlag{
+			Name:  FlagBufferLimit,
+			Usage: "Optional maximum number of runs buffered by the buffer overlap policy",
+		},
+	)
+}
+
+func getFlagsForScheduleAction() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagWorkflowTypeWithAlias,
+			Usage: "WorkflowTypeName of the scheduled workflow",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskListWithAlias,
+			Usage: "TaskList of the scheduled workflow",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowIDPrefix,
+			Usage: "Optional prefix of the scheduled workflow IDs, suffixed with the nominal run time. Defaults to the schedule ID",
+		},
+		cli.IntFlag{
+			Name:  FlagExecutionTimeoutWithAlias,
+			Usage: "Execution start to close timeout of the scheduled workflow in seconds",
+		},
+		cli.IntFlag{
+			Name:  FlagDecisionTimeoutWithAlias,
+			Value: defaultDecisionTimeoutInSeconds,
+			Usage: "Decision task start to close timeout of the scheduled workflow in seconds",
+		},
+		cli.StringFlag{
+			Name:  FlagInputWithAlias,
+			Usage: "Optional input for the scheduled workflow, in JSON format",
+		},
+		cli.StringFlag{
+			Name:  FlagInputFileWithAlias,
+			Usage: "Optional input for the scheduled workflow from JSON file",
+		},
+	}
+}
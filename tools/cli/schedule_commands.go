@@ -0,0 +1,364 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/scheduler"
+)
+
+type (
+	// ScheduleRow is a row of the schedule list table
+	ScheduleRow struct {
+		ScheduleID string `header:"Schedule ID"`
+		WorkflowID string `header:"Workflow ID"`
+		RunID      string `header:"Run ID"`
+	}
+)
+
+// CreateSchedule creates a schedule
+func CreateSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	action := scheduler.StartWorkflowAction{
+		WorkflowType: getRequiredOption(c, FlagWorkflowType),
+		TaskList:     getRequiredOption(c, FlagTaskList),
+	}
+	var spec scheduler.ScheduleSpec
+	var policies scheduler.SchedulePolicies
+	applyScheduleSpecFlags(c, &spec)
+	applyScheduleActionFlags(c, &action)
+	applySchedulePolicyFlags(c, &policies)
+
+	client := getCadenceClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := client.CreateSchedule(ctx, &types.CreateScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Spec:       scheduler.FromScheduleSpec(spec),
+		Action:     scheduler.FromStartWorkflowAction(action),
+		Policies:   scheduler.FromSchedulePolicies(policies),
+		Identity:   getCliIdentity(),
+		RequestID:  uuid.New(),
+	})
+	if err != nil {
+		if _, ok := err.(*types.WorkflowExecutionAlreadyStartedError); ok {
+			ErrorAndExit(fmt.Sprintf("Schedule %s already exists", scheduleID), nil)
+		}
+		ErrorAndExit("Failed to create schedule", err)
+	}
+	fmt.Printf("Schedule %s created\n", scheduleID)
+}
+
+// DescribeSchedule prints the spec, policies and state of a schedule
+func DescribeSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	prettyPrintJSONObject(describeSchedule(c, domain, scheduleID))
+}
+
+// UpdateSchedule updates the spec, action or policies of a schedule
+func UpdateSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	current := describeSchedule(c, domain, scheduleID)
+
+	request := &types.UpdateScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Identity:   getCliIdentity(),
+	}
+	if isAnyFlagSet(c, FlagCronSchedule, FlagCalendar, FlagInterval, FlagJitter, FlagStartTime, FlagEndTime) {
+		spec := scheduler.ToScheduleSpec(current.Spec)
+		if isAnyFlagSet(c, FlagCronSchedule, FlagCalendar, FlagInterval) {
+			// the given expressions replace the current ones rather than being added to them
+			spec.CronExpression, spec.Calendars, spec.Interval = "", nil, 0
+		}
+		applyScheduleSpecFlags(c, &spec)
+		request.Spec = scheduler.FromScheduleSpec(spec)
+	}
+	if isAnyFlagSet(c, FlagWorkflowType, FlagTaskList, FlagWorkflowIDPrefix, FlagExecutionTimeout, FlagDecisionTimeout, FlagInput, FlagInputFile) {
+		action := scheduler.ToStartWorkflowAction(current.Action)
+		if c.IsSet(FlagWorkflowType) {
+			action.WorkflowType = c.String(FlagWorkflowType)
+		}
+		if c.IsSet(FlagTaskList) {
+			action.TaskList = c.String(FlagTaskList)
+		}
+		applyScheduleActionFlags(c, &action)
+		request.Action = scheduler.FromStartWorkflowAction(action)
+	}
+	if isAnyFlagSet(c, FlagOverlapPolicy, FlagCatchUpWindow, FlagBufferLimit) {
+		policies := scheduler.ToSchedulePolicies(current.Policies)
+		applySchedulePolicyFlags(c, &policies)
+		request.Policies = scheduler.FromSchedulePolicies(policies)
+	}
+	if request.Spec == nil && request.Action == nil && request.Policies == nil {
+		ErrorAndExit("Nothing to update, see help for the flags of the schedule", nil)
+	}
+
+	client := getCadenceClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if err := client.UpdateSchedule(ctx, request); err != nil {
+		ErrorAndExit("Failed to update schedule", err)
+	}
+	fmt.Printf("Schedule %s updated\n", scheduleID)
+}
+
+// PauseSchedule pauses a schedule
+func PauseSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	client := getCadenceClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := client.PauseSchedule(ctx, &types.PauseScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Reason:     c.String(FlagReason),
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to pause schedule", err)
+	}
+	fmt.Printf("Schedule %s paused\n", scheduleID)
+}
+
+// UnpauseSchedule unpauses a schedule
+func UnpauseSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	client := getCadenceClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := client.UnpauseSchedule(ctx, &types.UnpauseScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Reason:     c.String(FlagReason),
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to unpause schedule", err)
+	}
+	fmt.Printf("Schedule %s unpaused\n", scheduleID)
+}
+
+// TriggerSchedule starts a run of a schedule immediately
+func TriggerSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	request := &types.TriggerScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Identity:   getCliIdentity(),
+	}
+	if c.IsSet(FlagOverlapPolicy) {
+		request.OverlapPolicy = scheduler.FromOverlapPolicy(parseOverlapPolicy(c))
+	}
+	client := getCadenceClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if err := client.TriggerSchedule(ctx, request); err != nil {
+		ErrorAndExit("Failed to trigger schedule", err)
+	}
+	fmt.Printf("Schedule %s triggered\n", scheduleID)
+}
+
+// BackfillSchedule starts the runs a schedule would have started in a time range
+func BackfillSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	request := &types.BackfillScheduleRequest{
+		Domain:         domain,
+		ScheduleID:     scheduleID,
+		StartTimestamp: common.Int64Ptr(parseTime(getRequiredOption(c, FlagStartTime), 0)),
+		EndTimestamp:   common.Int64Ptr(parseTime(c.String(FlagEndTime), time.Now().UnixNano())),
+		Identity:       getCliIdentity(),
+	}
+	if request.GetEndTimestamp() <= request.GetStartTimestamp() {
+		ErrorAndExit("Backfill end time must be after start time", nil)
+	}
+	if c.IsSet(FlagOverlapPolicy) {
+		request.OverlapPolicy = scheduler.FromOverlapPolicy(parseOverlapPolicy(c))
+	}
+	client := getCadenceClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if err := client.BackfillSchedule(ctx, request); err != nil {
+		ErrorAndExit("Failed to backfill schedule", err)
+	}
+	fmt.Printf("Backfill of schedule %s requested, at most %d runs are started\n", scheduleID, scheduler.MaxBackfillRuns)
+}
+
+// DeleteSchedule deletes a schedule
+func DeleteSchedule(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	client := getCadenceClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := client.DeleteSchedule(ctx, &types.DeleteScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to delete schedule", err)
+	}
+	fmt.Printf("Schedule %s deleted\n", scheduleID)
+}
+
+// ListSchedules lists the schedules of a domain
+func ListSchedules(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	client := getCadenceClient(c)
+
+	var table []ScheduleRow
+	var token []byte
+	for more := true; more; more = len(token) > 0 {
+		ctx, cancel := newContextForLongPoll(c)
+		response, err := client.ListSchedules(ctx, &types.ListSchedulesRequest{
+			Domain:        domain,
+			PageSize:      int32(defaultPageSizeForList),
+			NextPageToken: token,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to list schedules", err)
+		}
+		for _, schedule := range response.GetSchedules() {
+			table = append(table, ScheduleRow{
+				ScheduleID: schedule.GetScheduleID(),
+				WorkflowID: schedule.GetWorkflowExecution().GetWorkflowID(),
+				RunID:      schedule.GetWorkflowExecution().GetRunID(),
+			})
+		}
+		token = response.GetNextPageToken()
+	}
+	RenderTable(os.Stdout, table, RenderOptions{Color: true})
+}
+
+func describeSchedule(c *cli.Context, domain string, scheduleID string) *types.DescribeScheduleResponse {
+	client := getCadenceClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.DescribeSchedule(ctx, &types.DescribeScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			ErrorAndExit(fmt.Sprintf("Schedule %s does not exist", scheduleID), nil)
+		}
+		ErrorAndExit("Failed to describe schedule", err)
+	}
+	return response
+}
+
+func applyScheduleSpecFlags(c *cli.Context, spec *scheduler.ScheduleSpec) {
+	if c.IsSet(FlagCronSchedule) {
+		spec.CronExpression = c.String(FlagCronSchedule)
+	}
+	for _, calendar := range c.StringSlice(FlagCalendar) {
+		var calendarSpec scheduler.CalendarSpec
+		if err := json.Unmarshal([]byte(calendar), &calendarSpec); err != nil {
+			ErrorAndExit(fmt.Sprintf("Invalid calendar spec %q", calendar), err)
+		}
+		spec.Calendars = append(spec.Calendars, calendarSpec)
+	}
+	if c.IsSet(FlagInterval) {
+		spec.Interval = parseScheduleDuration(c, FlagInterval)
+	}
+	if c.IsSet(FlagJitter) {
+		spec.Jitter = parseScheduleDuration(c, FlagJitter)
+	}
+	if c.IsSet(FlagStartTime) {
+		spec.StartTime = time.Unix(0, parseTime(c.String(FlagStartTime), 0)).UTC()
+	}
+	if c.IsSet(FlagEndTime) {
+		spec.EndTime = time.Unix(0, parseTime(c.String(FlagEndTime), 0)).UTC()
+	}
+}
+
+func applyScheduleActionFlags(c *cli.Context, action *scheduler.StartWorkflowAction) {
+	if c.IsSet(FlagWorkflowIDPrefix) {
+		action.WorkflowIDPrefix = c.String(FlagWorkflowIDPrefix)
+	}
+	if c.IsSet(FlagExecutionTimeout) || action.ExecutionStartToCloseTimeoutSeconds == 0 {
+		action.ExecutionStartToCloseTimeoutSeconds = int32(getRequiredIntOption(c, FlagExecutionTimeout))
+	}
+	if c.IsSet(FlagDecisionTimeout) || action.TaskStartToCloseTimeoutSeconds == 0 {
+		action.TaskStartToCloseTimeoutSeconds = int32(c.Int(FlagDecisionTimeout))
+	}
+	if isAnyFlagSet(c, FlagInput, FlagInputFile) {
+		action.Input = []byte(processJSONInput(c))
+	}
+}
+
+func applySchedulePolicyFlags(c *cli.Context, policies *scheduler.SchedulePolicies) {
+	if c.IsSet(FlagOverlapPolicy) {
+		policies.OverlapPolicy = parseOverlapPolicy(c)
+	}
+	if c.IsSet(FlagCatchUpWindow) {
+		policies.CatchUpWindow = parseScheduleDuration(c, FlagCatchUpWindow)
+	}
+	if c.IsSet(FlagBufferLimit) {
+		policies.BufferLimit = c.Int(FlagBufferLimit)
+	}
+}
+
+func parseOverlapPolicy(c *cli.Context) scheduler.OverlapPolicy {
+	policy, err := scheduler.ParseOverlapPolicy(c.String(FlagOverlapPolicy))
+	if err != nil {
+		ErrorAndExit("Invalid overlap policy", err)
+	}
+	return policy
+}
+
+func parseScheduleDuration(c *cli.Context, flagName string) time.Duration {
+	duration, err := time.ParseDuration(c.String(flagName))
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Invalid value for %s", flagName), err)
+	}
+	return duration
+}
+
+func isAnyFlagSet(c *cli.Context, flagNames ...string) bool {
+	for _, flagName := range flagNames {
+		if c.IsSet(flagName) {
+			return true
+		}
+	}
+	return false
+}
@@ -420,6 +420,12 @@ func getEventAttributes(e *types.HistoryEvent) interface{} {
 	case types.EventTypeWorkflowExecutionTerminated:
 		data = e.WorkflowExecutionTerminatedEventAttributes
 
+	case types.EventTypeWorkflowExecutionPaused:
+		data = e.WorkflowExecutionPausedEventAttributes
+
+	case types.EventTypeWorkflowExecutionUnpaused:
+		data = e.WorkflowExecutionUnpausedEventAttributes
+
 	case types.EventTypeWorkflowExecutionContinuedAsNew:
 		data = e.WorkflowExecutionContinuedAsNewEventAttributes
 
@@ -766,6 +772,15 @@ func newIndefiniteContext(c *cli.Context) (context.Context, context.CancelFunc)
 	return context.WithCancel(populateContextFromCLIContext(context.Background(), c))
 }
 
+func newContextForWait(c *cli.Context) (context.Context, context.CancelFunc) {
+	if c.IsSet(FlagWaitTimeout) {
+		ctx := populateContextFromCLIContext(context.Background(), c)
+		return context.WithTimeout(ctx, time.Duration(c.Int(FlagWaitTimeout))*time.Second)
+	}
+
+	return newIndefiniteContext(c)
+}
+
 func newTimedContext(c *cli.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
 	if overrideTimeout := c.GlobalInt(FlagContextTimeout); overrideTimeout > 0 {
 		timeout = time.Duration(overrideTimeout) * time.Second
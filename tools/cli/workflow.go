@@ -105,6 +105,22 @@ func newWorkflowCommands() []cli.Command {
 				SignalWithStartWorkflowExecution(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "pause a workflow execution, its decision, activity and timer tasks are held until it is unpaused",
+			Flags: getFlagsForPause(),
+			Action: func(c *cli.Context) {
+				PauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "unpause a paused workflow execution",
+			Flags: getFlagsForUnpause(),
+			Action: func(c *cli.Context) {
+				UnpauseWorkflow(c)
+			},
+		},
 		{
 			Name:    "terminate",
 			Aliases: []string{"term"},
@@ -447,6 +463,128 @@ func newActivityCommands() []cli.Command {
 				FailActivity(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "hold back a pending activity from being dispatched until it is unpaused",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "The activityID to operate on",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseActivity(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "release a paused activity",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "The activityID to operate on",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UnpauseActivity(c)
+			},
+		},
+		{
+			Name:  "reset",
+			Usage: "reset the retry attempt of a pending activity to zero",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "The activityID to operate on",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ResetActivity(c)
+			},
+		},
+		{
+			Name:  "update-options",
+			Usage: "update the timeouts and the retry policy of a pending activity. The retry policy is replaced when retry_attempts or retry_expiration is set",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "The activityID to operate on",
+				},
+				cli.IntFlag{
+					Name:  FlagScheduleToStartTimeout,
+					Usage: "New schedule to start timeout of the activity in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagScheduleToCloseTimeout,
+					Usage: "New schedule to close timeout of the activity in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagStartToCloseTimeout,
+					Usage: "New start to close timeout of the activity in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagActivityHeartBeatTimeout,
+					Usage: "New heartbeat timeout of the activity in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagRetryAttempts,
+					Usage: "Maximum attempts of the new retry policy",
+				},
+				cli.IntFlag{
+					Name:  FlagRetryExpiration,
+					Usage: "Expiration of the new retry policy in seconds, counted from now",
+				},
+				cli.IntFlag{
+					Name:  FlagRetryInterval,
+					Value: 10,
+					Usage: "Initial interval of the new retry policy in seconds",
+				},
+				cli.Float64Flag{
+					Name:  FlagRetryBackoff,
+					Value: 1.0,
+					Usage: "Backoff coefficient of the new retry policy. Must be or equal or greater than 1.",
+				},
+				cli.IntFlag{
+					Name:  FlagRetryMaxInterval,
+					Usage: "Maximum interval of the new retry policy in seconds",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UpdateActivityOptions(c)
+			},
+		},
 	}
 }
 
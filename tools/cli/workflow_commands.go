@@ -239,16 +239,36 @@ func startWorkflowHelper(c *cli.Context, shouldPrintProgress bool) {
 	}
 
 	runFn := func() {
-		tcCtx, cancel := newContextForLongPoll(c)
+		waitCtx, cancel := newContextForWait(c)
 		defer cancel()
-		resp, err := serviceClient.StartWorkflowExecution(tcCtx, startRequest)
-
-		if err != nil {
-			ErrorAndExit("Failed to run workflow.", err)
+		startTime := time.Now()
+		executeRequest := &types.ExecuteWorkflowExecutionRequest{
+			StartRequest:    startRequest,
+			AttachToRunning: c.Bool(FlagAttach),
+		}
+		var resp *types.ExecuteWorkflowExecutionResponse
+		// each call waits up to the long poll timeout; the request ID is kept so that
+		// later calls wait on the run started by the first one
+		for resp == nil || (resp.CloseStatus == nil && waitCtx.Err() == nil) {
+			tcCtx, tcCancel := context.WithTimeout(waitCtx, defaultContextTimeoutForLongPoll)
+			var err error
+			resp, err = serviceClient.ExecuteWorkflowExecution(tcCtx, executeRequest)
+			tcCancel()
+			if err != nil {
+				if waitCtx.Err() == context.DeadlineExceeded {
+					ErrorAndExit("Timed out waiting for workflow to close.", err)
+				}
+				ErrorAndExit("Failed to run workflow.", err)
+				return
+			}
 		}
 
 		// print execution summary
-		fmt.Println(colorMagenta("Running execution:"))
+		if resp.GetAttached() {
+			fmt.Println(colorMagenta("Attached to running execution:"))
+		} else {
+			fmt.Println(colorMagenta("Running execution:"))
+		}
 		table := tablewriter.NewWriter(os.Stdout)
 		executionData := [][]string{
 			{"Workflow Id", wid},
@@ -263,7 +283,13 @@ func startWorkflowHelper(c *cli.Context, shouldPrintProgress bool) {
 		table.AppendBulk(executionData) // Add Bulk Data
 		table.Render()
 
-		printWorkflowProgress(c, domain, wid, resp.GetRunID())
+		if resp.CloseStatus == nil {
+			ErrorAndExit("Timed out waiting for workflow to close.", nil)
+	return
+		}
+		fmt.Println(colorMagenta("\nResult:"))
+		fmt.Printf("  Run Time: %d seconds\n", int(time.Since(startTime).Seconds()))
+		printExecuteStatus(resp)
 	}
 
 	if shouldPrintProgress {
@@ -421,7 +447,7 @@ func printWorkflowProgress(c *cli.Context, domain, wid, rid string) {
 	var lastEvent *types.HistoryEvent // used for print result of this run
 	ticker := time.NewTicker(time.Second).C
 
-	tcCtx, cancel := newIndefiniteContext(c)
+	tcCtx, cancel := newContextForWait(c)
 	defer cancel()
 
 	showDetails := c.Bool(FlagShowDetail)
@@ -437,10 +463,13 @@ func printWorkflowProgress(c *cli.Context, domain, wid, rid string) {
 		}
 		for iterator.HasNext() {
 			entity, err := iterator.Next()
-			event := entity.(*types.HistoryEvent)
 			if err != nil {
+				if tcCtx.Err() == context.DeadlineExceeded {
+					ErrorAndExit("Timed out waiting for workflow to close.", err)
+				}
 				ErrorAndExit("Unable to read event.", err)
 			}
+			event := entity.(*types.HistoryEvent)
 			if isTimeElapseExist {
 				removePrevious2LinesFromTerminal()
 				isTimeElapseExist = false
@@ -569,6 +598,70 @@ func SignalWorkflow(c *cli.Context) {
 	}
 }
 
+// PauseWorkflow pauses a workflow execution
+func PauseWorkflow(c *cli.Context) {
+	wfClient := getWorkflowClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := wfClient.PauseWorkflowExecution(
+		ctx,
+		&types.PauseWorkflowExecutionRequest{
+			Domain: domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			Reason:    reason,
+			Identity:  getCliIdentity(),
+			RequestID: uuid.New(),
+		},
+	)
+
+	if err != nil {
+		ErrorAndExit("Pause workflow failed.", err)
+	} else {
+		fmt.Println("Pause workflow succeeded.")
+	}
+}
+
+// UnpauseWorkflow unpauses a paused workflow execution
+func UnpauseWorkflow(c *cli.Context) {
+	wfClient := getWorkflowClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := wfClient.UnpauseWorkflowExecution(
+		ctx,
+		&types.UnpauseWorkflowExecutionRequest{
+			Domain: domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			Reason:    reason,
+			Identity:  getCliIdentity(),
+			RequestID: uuid.New(),
+		},
+	)
+
+	if err != nil {
+		ErrorAndExit("Unpause workflow failed.", err)
+	} else {
+		fmt.Println("Unpause workflow succeeded.")
+	}
+}
+
 // SignalWithStartWorkflowExecution starts a workflow execution if not already exists and signals it
 func SignalWithStartWorkflowExecution(c *cli.Context) {
 	serviceClient := cFactory.ServerFrontendClient(c)
@@ -1017,6 +1110,31 @@ func printRunStatus(event *types.HistoryEvent) {
 	case types.EventTypeWorkflowExecutionCanceled:
 		fmt.Printf("  Status: %s\n", colorRed("CANCELED"))
 		fmt.Printf("  Detail: %s\n", string(event.WorkflowExecutionCanceledEventAttributes.Details))
+	case types.EventTypeWorkflowExecutionTerminated:
+		fmt.Printf("  Status: %s\n", colorRed("TERMINATED"))
+		fmt.Printf("  Reason: %s\n", event.WorkflowExecutionTerminatedEventAttributes.GetReason())
+		fmt.Printf("  Detail: %s\n", string(event.WorkflowExecutionTerminatedEventAttributes.Details))
+	}
+}
+
+func printExecuteStatus(resp *types.ExecuteWorkflowExecutionResponse) {
+	switch resp.GetCloseStatus() {
+	case types.WorkflowExecutionCloseStatusCompleted:
+		fmt.Printf("  Status: %s\n", colorGreen("COMPLETED"))
+		fmt.Printf("  Output: %s\n", string(resp.Result))
+	case types.WorkflowExecutionCloseStatusFailed:
+		fmt.Printf("  Status: %s\n", colorRed("FAILED"))
+		fmt.Printf("  Reason: %s\n", resp.GetFailureReason())
+		fmt.Printf("  Detail: %s\n", string(resp.FailureDetails))
+	case types.WorkflowExecutionCloseStatusTimedOut:
+		fmt.Printf("  Status: %s\n", colorRed("TIMEOUT"))
+	case types.WorkflowExecutionCloseStatusCanceled:
+		fmt.Printf("  Status: %s\n", colorRed("CANCELED"))
+		fmt.Printf("  Detail: %s\n", string(resp.FailureDetails))
+	case types.WorkflowExecutionCloseStatusTerminated:
+		fmt.Printf("  Status: %s\n", colorRed("TERMINATED"))
+		fmt.Printf("  Reason: %s\n", resp.GetFailureReason())
+		fmt.Printf("  Detail: %s\n", string(resp.FailureDetails))
 	}
 }
 
@@ -2112,6 +2230,118 @@ func FailActivity(c *cli.Context) {
 	}
 }
 
+// PauseActivity holds back a pending activity until it is unpaused
+func PauseActivity(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	execution, activityID := getActivityExecution(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	frontendClient := cFactory.ServerFrontendClient(c)
+	err := frontendClient.PauseActivity(ctx, &types.PauseActivityRequest{
+		Domain:            domain,
+		WorkflowExecution: execution,
+		ActivityID:        activityID,
+		Identity:          getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Pausing activity failed", err)
+	} else {
+		fmt.Println("Pause activity successfully.")
+	}
+}
+
+// UnpauseActivity releases a paused activity
+func UnpauseActivity(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	execution, activityID := getActivityExecution(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	frontendClient := cFactory.ServerFrontendClient(c)
+	err := frontendClient.UnpauseActivity(ctx, &types.UnpauseActivityRequest{
+		Domain:            domain,
+		WorkflowExecution: execution,
+		ActivityID:        activityID,
+		Identity:          getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Unpausing activity failed", err)
+	} else {
+		fmt.Println("Unpause activity successfully.")
+	}
+}
+
+// ResetActivity resets the retry attempt of a pending activity
+func ResetActivity(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	execution, activityID := getActivityExecution(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	frontendClient := cFactory.ServerFrontendClient(c)
+	err := frontendClient.ResetActivity(ctx, &types.ResetActivityRequest{
+		Domain:            domain,
+		WorkflowExecution: execution,
+		ActivityID:        activityID,
+		Identity:          getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Resetting activity failed", err)
+	} else {
+		fmt.Println("Reset activity successfully.")
+	}
+}
+
+// UpdateActivityOptions updates the timeouts and the retry policy of a pending activity
+func UpdateActivityOptions(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	execution, activityID := getActivityExecution(c)
+	request := &types.UpdateActivityOptionsRequest{
+		Domain:            domain,
+		WorkflowExecution: execution,
+		ActivityID:        activityID,
+		Identity:          getCliIdentity(),
+	}
+	if c.IsSet(FlagScheduleToStartTimeout) {
+		request.ScheduleToStartTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagScheduleToStartTimeout)))
+	}
+	if c.IsSet(FlagScheduleToCloseTimeout) {
+		request.ScheduleToCloseTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagScheduleToCloseTimeout)))
+	}
+	if c.IsSet(FlagStartToCloseTimeout) {
+		request.StartToCloseTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagStartToCloseTimeout)))
+	}
+	if c.IsSet(FlagActivityHeartBeatTimeout) {
+		request.HeartbeatTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagActivityHeartBeatTimeout)))
+	}
+	if c.IsSet(FlagRetryAttempts) || c.IsSet(FlagRetryExpiration) {
+		request.RetryPolicy = &types.RetryPolicy{
+			InitialIntervalInSeconds:    int32(c.Int(FlagRetryInterval)),
+			BackoffCoefficient:          c.Float64(FlagRetryBackoff),
+			MaximumIntervalInSeconds:    int32(c.Int(FlagRetryMaxInterval)),
+			MaximumAttempts:             int32(c.Int(FlagRetryAttempts)),
+			ExpirationIntervalInSeconds: int32(c.Int(FlagRetryExpiration)),
+		}
+	}
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	frontendClient := cFactory.ServerFrontendClient(c)
+	if err := frontendClient.UpdateActivityOptions(ctx, request); err != nil {
+		ErrorAndExit("Updating activity options failed", err)
+	} else {
+		fmt.Println("Update activity options successfully.")
+	}
+}
+
+func getActivityExecution(c *cli.Context) (*types.WorkflowExecution, string) {
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	activityID := getRequiredOption(c, FlagActivityID)
+	return &types.WorkflowExecution{WorkflowID: wid, RunID: rid}, activityID
+}
+
 // ObserveHistoryWithID show the process of running workflow
 func ObserveHistoryWithID(c *cli.Context) {
 	domain := getRequiredGlobalOption(c, FlagDomain)